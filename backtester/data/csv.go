@@ -0,0 +1,224 @@
+package data
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Kaiko export column names
+var (
+	kaikoOHLCVColumns  = []string{"timestamp", "open", "high", "low", "close", "volume"}
+	kaikoTradeColumns  = []string{"date", "price", "amount"}
+	kaikoTradeIDColumn = "id"
+)
+
+// Candles returns the candles in the CSV file for the request, the file is
+// assumed to only hold the requested pair
+func (c *CSVSource) Candles(r *Request) ([]kline.Candle, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	f, err := os.Open(c.Path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	return readCSV(f, c.Format, r)
+}
+
+// read returns the candles or trades in the CSV file in the order they were
+// written, before they are ordered, deduplicated or aggregated
+func (c *CSVSource) read(r *Request) ([]kline.Candle, []trade, error) {
+	if err := r.Validate(); err != nil {
+		return nil, nil, err
+	}
+	f, err := os.Open(c.Path)
+	if err != nil {
+		return nil, nil, err
+	}
+	defer f.Close()
+	return parseCSV(f, c.Format, r)
+}
+
+// isTrades returns whether the format holds trades to be aggregated into
+// candles
+func (f Format) isTrades() bool {
+	return f == FormatTrades || f == FormatKaikoTrades
+}
+
+// readCSV reads the candles or trades of a format and returns the candles
+// for the request
+func readCSV(reader io.Reader, format Format, r *Request) ([]kline.Candle, error) {
+	candles, trades, err := parseCSV(reader, format, r)
+	if err != nil {
+		return nil, err
+	}
+	if format.isTrades() {
+		return aggregateTrades(r, trades)
+	}
+	return filterCandles(r, candles)
+}
+
+// parseCSV parses the candles, or the trades, of a format in the order they
+// were written
+func parseCSV(reader io.Reader, format Format, r *Request) ([]kline.Candle, []trade, error) {
+	cr := csv.NewReader(reader)
+	cr.FieldsPerRecord = -1
+	cr.TrimLeadingSpace = true
+	records, err := cr.ReadAll()
+	if err != nil {
+		return nil, nil, err
+	}
+	if len(records) == 0 {
+		return nil, nil, errNoCandles
+	}
+
+	switch format {
+	case FormatCandles:
+		candles, err := readCandles(records, columnIndexes(len(kaikoOHLCVColumns)), r, false)
+		return candles, nil, err
+	case FormatKaikoOHLCV:
+		cols, err := headerIndexes(records[0], kaikoOHLCVColumns)
+		if err != nil {
+			return nil, nil, err
+		}
+		candles, err := readCandles(records[1:], cols, r, true)
+		return candles, nil, err
+	case FormatTrades:
+		// An optional fourth column holds the exchange trade ID
+		trades, err := readTrades(records, columnIndexes(len(kaikoTradeColumns)), len(kaikoTradeColumns))
+		return nil, trades, err
+	case FormatKaikoTrades:
+		cols, err := headerIndexes(records[0], kaikoTradeColumns)
+		if err != nil {
+			return nil, nil, err
+		}
+		trades, err := readTrades(records[1:], cols, headerIndex(records[0], kaikoTradeIDColumn))
+		return nil, trades, err
+	}
+	return nil, nil, fmt.Errorf("unsupported CSV format %q", format)
+}
+
+// readCandles parses candles from the columns of each record. A first row
+// which doesn't start with a timestamp is taken as a header, rows without
+// prices are an error unless skipEmpty is set
+func readCandles(records [][]string, cols []int, r *Request, skipEmpty bool) ([]kline.Candle, error) {
+	var candles []kline.Candle
+	for i := range records {
+		fields, err := recordFields(records[i], cols)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", i+1, err)
+		}
+		t, err := parseTimestamp(fields[0])
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("row %d: %v", i+1, err)
+		}
+		if skipEmpty && strings.TrimSpace(fields[1]) == "" {
+			continue
+		}
+		values, err := parseFloats(fields[1:])
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", i+1, err)
+		}
+		candles = append(candles, r.candle(t, values[0], values[1], values[2],
+			values[3], values[4]))
+	}
+	return candles, nil
+}
+
+// readTrades parses trades from the columns of each record, with the trade
+// ID from the idCol column when the record has one. A first row which
+// doesn't start with a timestamp is taken as a header
+func readTrades(records [][]string, cols []int, idCol int) ([]trade, error) {
+	var trades []trade
+	for i := range records {
+		fields, err := recordFields(records[i], cols)
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", i+1, err)
+		}
+		t, err := parseTimestamp(fields[0])
+		if err != nil {
+			if i == 0 {
+				continue
+			}
+			return nil, fmt.Errorf("row %d: %v", i+1, err)
+		}
+		values, err := parseFloats(fields[1:])
+		if err != nil {
+			return nil, fmt.Errorf("row %d: %v", i+1, err)
+		}
+		tr := trade{timestamp: t, price: values[0], amount: values[1]}
+		if idCol >= 0 && idCol < len(records[i]) {
+			tr.id = strings.TrimSpace(records[i][idCol])
+		}
+		trades = append(trades, tr)
+	}
+	return trades, nil
+}
+
+// columnIndexes returns the indexes of the first n columns
+func columnIndexes(n int) []int {
+	cols := make([]int, n)
+	for i := range cols {
+		cols[i] = i
+	}
+	return cols
+}
+
+// headerIndexes returns the indexes of the named columns in a header row
+func headerIndexes(header, names []string) ([]int, error) {
+	cols := make([]int, len(names))
+	for i := range names {
+		cols[i] = headerIndex(header, names[i])
+		if cols[i] == -1 {
+			return nil, fmt.Errorf("CSV header missing %q column", names[i])
+		}
+	}
+	return cols, nil
+}
+
+// headerIndex returns the index of the named column in a header row, or -1
+// if there is no such column
+func headerIndex(header []string, name string) int {
+	for i := range header {
+		if strings.EqualFold(strings.TrimSpace(header[i]), name) {
+			return i
+		}
+	}
+	return -1
+}
+
+// recordFields returns the fields of a record at the column indexes
+func recordFields(record []string, cols []int) ([]string, error) {
+	fields := make([]string, len(cols))
+	for i := range cols {
+		if cols[i] >= len(record) {
+			return nil, fmt.Errorf("expected at least %d columns, received %d",
+				cols[i]+1, len(record))
+		}
+		fields[i] = record[cols[i]]
+	}
+	return fields, nil
+}
+
+// parseFloats parses each field as a number
+func parseFloats(fields []string) ([]float64, error) {
+	values := make([]float64, len(fields))
+	for i := range fields {
+		v, err := strconv.ParseFloat(strings.TrimSpace(fields[i]), 64)
+		if err != nil {
+			return nil, err
+		}
+		values[i] = v
+	}
+	return values, nil
+}
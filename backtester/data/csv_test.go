@@ -0,0 +1,128 @@
+package data
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestReadCSVCandles(t *testing.T) {
+	r := testRequest()
+	csvData := "timestamp,open,high,low,close,volume\n" +
+		"1583067600,9050,9200,9000,9150,95\n" +
+		"1583064000,9000,9100,8950,9050,120\n"
+	candles, err := readCSV(strings.NewReader(csvData), FormatCandles, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 2 {
+		t.Fatalf("expected 2 candles, received %d", len(candles))
+	}
+	if !candles[0].StartTime.Equal(time.Unix(1583064000, 0)) || candles[0].Volume != 120 ||
+		candles[1].Close != 9150 {
+		t.Errorf("unexpected candles %+v", candles)
+	}
+
+	// Without a header
+	candles, err = readCSV(strings.NewReader("2020-03-01T12:00:00Z,1,2,0.5,1.5,10\n"), FormatCandles, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 1 || candles[0].High != 2 {
+		t.Errorf("unexpected candles %+v", candles)
+	}
+
+	if _, err = readCSV(strings.NewReader(csvData+"1583071200,1,2,3\n"), FormatCandles, &r); err == nil {
+		t.Error("expected an error for a short row")
+	}
+	if _, err = readCSV(strings.NewReader(csvData+"1583071200,a,2,3,4,5\n"), FormatCandles, &r); err == nil {
+		t.Error("expected an error for an invalid price")
+	}
+	if _, err = readCSV(strings.NewReader(csvData), "parquet", &r); err == nil {
+		t.Error("expected an error for an unsupported format")
+	}
+}
+
+func TestReadCSVKaikoOHLCV(t *testing.T) {
+	r := testRequest()
+	csvData := "timestamp,open,high,low,close,volume\n" +
+		"1583064000000,9000,9100,8950,9050,120\n" +
+		"1583067600000,,,,,0\n" +
+		"1583071200000,9050,9200,9000,9150,95\n"
+	candles, err := readCSV(strings.NewReader(csvData), FormatKaikoOHLCV, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 2 {
+		t.Fatalf("expected the interval without trades to be skipped, received %d candles", len(candles))
+	}
+	if !candles[1].StartTime.Equal(time.Unix(1583071200, 0)) {
+		t.Errorf("unexpected candles %+v", candles)
+	}
+
+	if _, err = readCSV(strings.NewReader("time,open\n1,2\n"), FormatKaikoOHLCV, &r); err == nil {
+		t.Error("expected an error for missing columns")
+	}
+}
+
+func TestReadCSVTrades(t *testing.T) {
+	r := testRequest()
+	csvData := "id,exchange,symbol,date,price,amount,sell\n" +
+		"1,bnce,btcusdt,1583064300000,9000,0.5,false\n" +
+		"2,bnce,btcusdt,1583065200000,9100,0.25,true\n" +
+		"3,bnce,btcusdt,1583067700000,9050,1,false\n"
+	candles, err := readCSV(strings.NewReader(csvData), FormatKaikoTrades, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 2 || candles[0].Close != 9100 || candles[0].Volume != 0.75 {
+		t.Errorf("unexpected candles %+v", candles)
+	}
+
+	candles, err = readCSV(strings.NewReader("1583064300,9000,0.5\n1583065200,9100,0.25\n"), FormatTrades, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 1 || candles[0].Open != 9000 || candles[0].High != 9100 {
+		t.Errorf("unexpected candles %+v", candles)
+	}
+
+	_, trades, err := parseCSV(strings.NewReader("1583064300,9000,0.5,a1\n1583065200,9100,0.25\n"), FormatTrades, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(trades) != 2 || trades[0].id != "a1" || trades[1].id != "" {
+		t.Errorf("unexpected trade IDs %+v", trades)
+	}
+}
+
+func TestCSVSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backtester")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "candles.csv")
+	err = ioutil.WriteFile(path, []byte("1583064000,9000,9100,8950,9050,120\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := testRequest()
+	var src DataSource = &CSVSource{Path: path, Format: FormatCandles}
+	candles, err := src.Candles(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 1 || candles[0].Pair != r.Pair {
+		t.Errorf("unexpected candles %+v", candles)
+	}
+
+	src = &CSVSource{Path: filepath.Join(dir, "missing.csv"), Format: FormatCandles}
+	if _, err = src.Candles(&r); err == nil {
+		t.Error("expected an error for a missing file")
+	}
+}
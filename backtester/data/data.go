@@ -0,0 +1,122 @@
+package data
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Validate checks a request has everything needed to load candles
+func (r *Request) Validate() error {
+	if r.Exchange == "" {
+		return errExchangeNameUnset
+	}
+	if r.Pair.IsEmpty() {
+		return errPairUnset
+	}
+	if r.Interval <= 0 {
+		return errIntervalUnset
+	}
+	if !r.Start.IsZero() && !r.End.IsZero() && r.End.Before(r.Start) {
+		return errInvalidTimeRange
+	}
+	return nil
+}
+
+// inRange returns whether a candle starting at t falls within the request's
+// time range
+func (r *Request) inRange(t time.Time) bool {
+	return (r.Start.IsZero() || !t.Before(r.Start)) &&
+		(r.End.IsZero() || !t.After(r.End))
+}
+
+// candle returns a candle of the request's pair and interval starting at t
+func (r *Request) candle(t time.Time, open, high, low, closePrice, volume float64) kline.Candle {
+	t = t.UTC()
+	return kline.Candle{
+		Exchange:    r.Exchange,
+		Pair:        r.Pair,
+		AssetType:   r.Asset,
+		Interval:    r.Interval,
+		StartTime:   t,
+		CloseTime:   t.Add(r.Interval.Duration()),
+		Open:        open,
+		High:        high,
+		Low:         low,
+		Close:       closePrice,
+		Volume:      volume,
+		LastUpdated: t.Add(r.Interval.Duration()),
+	}
+}
+
+// filterCandles returns the candles within the request's time range ordered
+// oldest first, dropping all but the last candle read for a start time
+func filterCandles(r *Request, candles []kline.Candle) ([]kline.Candle, error) {
+	sort.SliceStable(candles, func(i, j int) bool {
+		return candles[i].StartTime.Before(candles[j].StartTime)
+	})
+	resp := candles[:0]
+	for i := range candles {
+		if !r.inRange(candles[i].StartTime) {
+			continue
+		}
+		if len(resp) > 0 && resp[len(resp)-1].StartTime.Equal(candles[i].StartTime) {
+			resp[len(resp)-1] = candles[i]
+			continue
+		}
+		resp = append(resp, candles[i])
+	}
+	if len(resp) == 0 {
+		return nil, errNoCandles
+	}
+	return resp, nil
+}
+
+// aggregateTrades builds candles of the request's interval from trades,
+// intervals without trades have no candle
+func aggregateTrades(r *Request, trades []trade) ([]kline.Candle, error) {
+	sort.SliceStable(trades, func(i, j int) bool {
+		return trades[i].timestamp.Before(trades[j].timestamp)
+	})
+	var candles []kline.Candle
+	for i := range trades {
+		start := trades[i].timestamp.Truncate(r.Interval.Duration())
+		last := len(candles) - 1
+		if last < 0 || !candles[last].StartTime.Equal(start) {
+			candles = append(candles, r.candle(start, trades[i].price,
+				trades[i].price, trades[i].price, trades[i].price, trades[i].amount))
+			continue
+		}
+		c := &candles[last]
+		if trades[i].price > c.High {
+			c.High = trades[i].price
+		}
+		if trades[i].price < c.Low {
+			c.Low = trades[i].price
+		}
+		c.Close = trades[i].price
+		c.Volume += trades[i].amount
+	}
+	return filterCandles(r, candles)
+}
+
+// parseTimestamp parses a unix timestamp in seconds or milliseconds, or an
+// RFC3339 time
+func parseTimestamp(s string) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if n, err := strconv.ParseFloat(s, 64); err == nil {
+		// Unix times in seconds won't reach 1e12 for another 30,000 years
+		if n >= 1e12 {
+			return time.Unix(0, int64(n)*int64(time.Millisecond)).UTC(), nil
+		}
+		return time.Unix(0, int64(n*float64(time.Second))).UTC(), nil
+	}
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
@@ -0,0 +1,132 @@
+package data
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func testRequest() Request {
+	return Request{
+		Exchange: "Binance",
+		Pair:     currency.NewPair(currency.BTC, currency.USDT),
+		Asset:    asset.Spot,
+		Interval: kline.OneHour,
+	}
+}
+
+func TestValidate(t *testing.T) {
+	r := testRequest()
+	if err := r.Validate(); err != nil {
+		t.Error(err)
+	}
+	r.Start = time.Now()
+	r.End = r.Start.Add(-time.Hour)
+	if err := r.Validate(); err != errInvalidTimeRange {
+		t.Errorf("expected %v, received %v", errInvalidTimeRange, err)
+	}
+	r.Interval = 0
+	if err := r.Validate(); err != errIntervalUnset {
+		t.Errorf("expected %v, received %v", errIntervalUnset, err)
+	}
+	r.Pair = currency.Pair{}
+	if err := r.Validate(); err != errPairUnset {
+		t.Errorf("expected %v, received %v", errPairUnset, err)
+	}
+	r.Exchange = ""
+	if err := r.Validate(); err != errExchangeNameUnset {
+		t.Errorf("expected %v, received %v", errExchangeNameUnset, err)
+	}
+}
+
+func TestParseTimestamp(t *testing.T) {
+	expected := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	for _, s := range []string{"1583064000", "1583064000000", "1583064000.0", "2020-03-01T12:00:00Z", "2020-03-01T13:00:00+01:00"} {
+		ts, err := parseTimestamp(s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !ts.Equal(expected) {
+			t.Errorf("expected %s to parse as %v, received %v", s, expected, ts)
+		}
+	}
+	if _, err := parseTimestamp("timestamp"); err == nil {
+		t.Error("expected an error for a header")
+	}
+}
+
+func TestAggregateTrades(t *testing.T) {
+	r := testRequest()
+	start := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	trades := []trade{
+		{start.Add(90 * time.Minute), 105, 1, ""},
+		{start.Add(5 * time.Minute), 100, 1, ""},
+		{start.Add(10 * time.Minute), 110, 2, ""},
+		{start.Add(20 * time.Minute), 95, 0.5, ""},
+		{start.Add(50 * time.Minute), 102, 1.5, ""},
+	}
+	candles, err := aggregateTrades(&r, trades)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 2 {
+		t.Fatalf("expected 2 candles, received %d", len(candles))
+	}
+	c := candles[0]
+	if !c.StartTime.Equal(start) || c.Open != 100 || c.High != 110 || c.Low != 95 ||
+		c.Close != 102 || c.Volume != 5 {
+		t.Errorf("unexpected candle %+v", c)
+	}
+	if !c.CloseTime.Equal(start.Add(time.Hour)) || c.Exchange != "Binance" {
+		t.Errorf("unexpected candle %+v", c)
+	}
+	if candles[1].Open != 105 || candles[1].Close != 105 {
+		t.Errorf("unexpected candle %+v", candles[1])
+	}
+
+	r.Start = start.Add(time.Hour)
+	candles, err = aggregateTrades(&r, trades)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 1 {
+		t.Errorf("expected candles before the start to be excluded, received %d", len(candles))
+	}
+
+	r.Start = start.Add(24 * time.Hour)
+	if _, err = aggregateTrades(&r, trades); err != errNoCandles {
+		t.Errorf("expected %v, received %v", errNoCandles, err)
+	}
+}
+
+func TestFilterCandles(t *testing.T) {
+	r := testRequest()
+	start := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	candles := []kline.Candle{
+		r.candle(start.Add(time.Hour), 1, 1, 1, 1, 1),
+		r.candle(start, 1, 1, 1, 1, 1),
+		r.candle(start.Add(time.Hour), 2, 2, 2, 2, 2),
+	}
+	candles, err := filterCandles(&r, candles)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 2 || !candles[0].StartTime.Equal(start) || candles[1].Close != 2 {
+		t.Errorf("expected ordered candles with the last duplicate kept, received %+v", candles)
+	}
+}
+
+func TestDatabaseSource(t *testing.T) {
+	r := testRequest()
+	var d DatabaseSource
+	if _, err := d.Candles(&r); err == nil {
+		t.Error("expected an error without a database connection")
+	}
+	r.Exchange = ""
+	if _, err := d.Candles(&r); err != errExchangeNameUnset {
+		t.Errorf("expected %v, received %v", errExchangeNameUnset, err)
+	}
+}
@@ -0,0 +1,130 @@
+package data
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Format is the layout of a CSV file of candles or trades
+type Format string
+
+// Supported CSV formats
+const (
+	// FormatCandles is a candle per row of timestamp, open, high, low, close
+	// and volume, with an optional header row
+	FormatCandles Format = "candles"
+	// FormatTrades is a trade per row of timestamp, price and amount, with an
+	// optional header row. Trades are aggregated into candles
+	FormatTrades Format = "trades"
+	// FormatKaikoOHLCV is a Kaiko OHLCV export, intervals without trades are
+	// skipped
+	FormatKaikoOHLCV Format = "kaiko_ohlcv"
+	// FormatKaikoTrades is a Kaiko trade export, aggregated into candles
+	FormatKaikoTrades Format = "kaiko_trades"
+)
+
+var (
+	errExchangeNameUnset = errors.New("exchange name not set")
+	errPairUnset         = errors.New("currency pair not set")
+	errIntervalUnset     = errors.New("candle interval not set")
+	errInvalidTimeRange  = errors.New("end time is before start time")
+	errNoCandles         = errors.New("no candles found")
+	errNotTradeFormat    = errors.New("cross-check source is not a trade export")
+)
+
+// defaultPriceTolerance is the largest relative difference between a candle
+// price and the price aggregated from trades when no tolerance is set
+const defaultPriceTolerance = 0.001
+
+// Anomaly kinds found by CheckedSource
+const (
+	// AnomalyOutOfOrderTrade is a trade earlier than the one before it
+	AnomalyOutOfOrderTrade = "out of order trade"
+	// AnomalyOutOfOrderCandle is a candle starting before the one before it
+	AnomalyOutOfOrderCandle = "out of order candle"
+	// AnomalyDuplicateCandle is a candle starting at the same time as the
+	// one before it
+	AnomalyDuplicateCandle = "duplicate candle"
+	// AnomalyInvalidCandle is a candle whose prices or volume can't be right,
+	// such as a high below its open
+	AnomalyInvalidCandle = "invalid candle"
+	// AnomalyTradeMismatch is a candle whose prices differ from those of the
+	// trades in its interval
+	AnomalyTradeMismatch = "trade mismatch"
+)
+
+// DataSource loads the historic candles a backtest is run on
+type DataSource interface {
+	Candles(r *Request) ([]kline.Candle, error)
+}
+
+// Request selects the candles of a pair on an exchange to load. A zero start
+// or end leaves that side of the time range open
+type Request struct {
+	Exchange string
+	Pair     currency.Pair
+	Asset    asset.Item
+	Interval kline.Interval
+	Start    time.Time
+	End      time.Time
+}
+
+// DatabaseSource loads candles stored in the database
+type DatabaseSource struct{}
+
+// CSVSource loads candles from a CSV file, or from trades aggregated into
+// candles of the requested interval
+type CSVSource struct {
+	Path   string
+	Format Format
+}
+
+// CheckedSource loads candles from a source and checks them before they reach
+// the backtester. Trades are deduplicated by their exchange trade ID, and
+// candles and trades out of time order, candles with impossible prices and,
+// when a trade export is set, candles whose prices differ from those of the
+// trades in their interval are anomalies. Anomalies are dropped when Reject
+// is set, otherwise they are kept and flagged in the report
+type CheckedSource struct {
+	Source DataSource
+	// Trades is an optional trade export of the same pair the candles are
+	// cross-checked against
+	Trades *CSVSource
+	Reject bool
+	// Tolerance is the largest relative difference between a candle price
+	// and the price of the trades in its interval, defaulting to 0.1%
+	Tolerance float64
+
+	mtx    sync.Mutex
+	report QualityReport
+}
+
+// QualityReport holds what was found checking the data of a request
+type QualityReport struct {
+	DuplicateTrades int
+	Anomalies       []Anomaly
+	// Rejected is the number of anomalous candles and trades dropped
+	Rejected int
+}
+
+// Anomaly is a candle or trade which failed a check, at its start time or
+// the time of the trade
+type Anomaly struct {
+	Time   time.Time
+	Kind   string
+	Detail string
+}
+
+// trade is a single trade read from a trade export. The ID is the exchange's
+// trade ID, blank when the export has none
+type trade struct {
+	timestamp time.Time
+	price     float64
+	amount    float64
+	id        string
+}
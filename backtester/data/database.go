@@ -0,0 +1,33 @@
+package data
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Candles returns the candles stored in the database for the request
+func (d *DatabaseSource) Candles(r *Request) ([]kline.Candle, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	start, end := r.Start, r.End
+	if start.IsZero() {
+		start = time.Unix(0, 0)
+	}
+	if end.IsZero() {
+		end = time.Now()
+	}
+	stored, err := candle.Series(r.Exchange, r.Asset.String(), r.Pair.String(),
+		r.Interval.Duration(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	candles := make([]kline.Candle, len(stored))
+	for i := range stored {
+		candles[i] = r.candle(stored[i].Timestamp, stored[i].Open, stored[i].High,
+			stored[i].Low, stored[i].Close, stored[i].Volume)
+	}
+	return filterCandles(r, candles)
+}
@@ -0,0 +1,245 @@
+package data
+
+import (
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Candles returns the checked candles of the source for the request
+func (c *CheckedSource) Candles(r *Request) ([]kline.Candle, error) {
+	if err := r.Validate(); err != nil {
+		return nil, err
+	}
+	if c.Trades != nil && !c.Trades.Format.isTrades() {
+		return nil, errNotTradeFormat
+	}
+
+	var report QualityReport
+	candles, err := c.checkedCandles(r, &report)
+	if err == nil && c.Trades != nil {
+		var tradeCandles []kline.Candle
+		tradeCandles, err = c.checkedTradeCandles(c.Trades, r, &report)
+		if err == nil {
+			candles = crossCheckCandles(candles, tradeCandles, c.tolerance(), c.Reject, &report)
+		} else if err == errNoCandles {
+			// Nothing to cross-check against in the requested range
+			err = nil
+		}
+	}
+
+	c.mtx.Lock()
+	c.report = report
+	c.mtx.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	if len(candles) == 0 {
+		return nil, errNoCandles
+	}
+	return candles, nil
+}
+
+// Report returns what was found checking the last request
+func (c *CheckedSource) Report() QualityReport {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	return c.report
+}
+
+func (c *CheckedSource) tolerance() float64 {
+	if c.Tolerance > 0 {
+		return c.Tolerance
+	}
+	return defaultPriceTolerance
+}
+
+// checkedCandles returns the source's candles once checked. CSV files are
+// checked as written, before their rows are ordered and deduplicated
+func (c *CheckedSource) checkedCandles(r *Request, report *QualityReport) ([]kline.Candle, error) {
+	csvSource, ok := c.Source.(*CSVSource)
+	if !ok {
+		candles, err := c.Source.Candles(r)
+		if err != nil {
+			return nil, err
+		}
+		return checkCandles(candles, c.Reject, report), nil
+	}
+	if csvSource.Format.isTrades() {
+		return c.checkedTradeCandles(csvSource, r, report)
+	}
+	candles, _, err := csvSource.read(r)
+	if err != nil {
+		return nil, err
+	}
+	candles = checkCandles(candlesInRange(r, candles), c.Reject, report)
+	return filterCandles(r, candles)
+}
+
+// checkedTradeCandles returns the candles aggregated from a trade export once
+// its trades are checked
+func (c *CheckedSource) checkedTradeCandles(src *CSVSource, r *Request, report *QualityReport) ([]kline.Candle, error) {
+	_, trades, err := src.read(r)
+	if err != nil {
+		return nil, err
+	}
+	trades = checkTrades(tradesInRange(r, trades), c.Reject, report)
+	return aggregateTrades(r, trades)
+}
+
+// candlesInRange returns the candles within the request's time range, in the
+// order they were read
+func candlesInRange(r *Request, candles []kline.Candle) []kline.Candle {
+	var resp []kline.Candle
+	for i := range candles {
+		if r.inRange(candles[i].StartTime) {
+			resp = append(resp, candles[i])
+		}
+	}
+	return resp
+}
+
+// tradesInRange returns the trades in candles within the request's time
+// range, in the order they were read
+func tradesInRange(r *Request, trades []trade) []trade {
+	var resp []trade
+	for i := range trades {
+		if r.inRange(trades[i].timestamp.Truncate(r.Interval.Duration())) {
+			resp = append(resp, trades[i])
+		}
+	}
+	return resp
+}
+
+// checkTrades drops trades whose ID was already seen and flags trades earlier
+// than the latest trade before them, dropping those too when reject is set.
+// Trades without an ID are never duplicates
+func checkTrades(trades []trade, reject bool, report *QualityReport) []trade {
+	seen := make(map[string]struct{})
+	var latest time.Time
+	resp := trades[:0]
+	for i := range trades {
+		if trades[i].id != "" {
+			if _, ok := seen[trades[i].id]; ok {
+				report.DuplicateTrades++
+				continue
+			}
+			seen[trades[i].id] = struct{}{}
+		}
+		if trades[i].timestamp.Before(latest) {
+			detail := fmt.Sprintf("after a trade at %v", latest)
+			if trades[i].id != "" {
+				detail = "trade " + trades[i].id + " " + detail
+			}
+			if report.flag(trades[i].timestamp, AnomalyOutOfOrderTrade, detail, reject) {
+				continue
+			}
+		} else {
+			latest = trades[i].timestamp
+		}
+		resp = append(resp, trades[i])
+	}
+	return resp
+}
+
+// checkCandles flags candles which don't start after the latest candle
+// before them and candles with impossible prices or volume, dropping them when
+// reject is set
+func checkCandles(candles []kline.Candle, reject bool, report *QualityReport) []kline.Candle {
+	var latest time.Time
+	resp := candles[:0]
+	for i := range candles {
+		c := &candles[i]
+		if !latest.IsZero() && !c.StartTime.After(latest) {
+			kind := AnomalyOutOfOrderCandle
+			if c.StartTime.Equal(latest) {
+				kind = AnomalyDuplicateCandle
+			}
+			if report.flag(c.StartTime, kind, fmt.Sprintf("after a candle at %v", latest), reject) {
+				continue
+			}
+		}
+		if detail := invalidCandle(c); detail != "" {
+			if report.flag(c.StartTime, AnomalyInvalidCandle, detail, reject) {
+				continue
+			}
+		}
+		if c.StartTime.After(latest) {
+			latest = c.StartTime
+		}
+		resp = append(resp, *c)
+	}
+	return resp
+}
+
+// invalidCandle returns why a candle's prices or volume can't be right, or
+// nothing if they can
+func invalidCandle(c *kline.Candle) string {
+	switch {
+	case c.Open <= 0 || c.High <= 0 || c.Low <= 0 || c.Close <= 0:
+		return "non-positive price"
+	case c.Volume < 0:
+		return fmt.Sprintf("negative volume %v", c.Volume)
+	case c.High < c.Low:
+		return fmt.Sprintf("high %v below low %v", c.High, c.Low)
+	case c.High < math.Max(c.Open, c.Close):
+		return fmt.Sprintf("high %v below open %v or close %v", c.High, c.Open, c.Close)
+	case c.Low > math.Min(c.Open, c.Close):
+		return fmt.Sprintf("low %v above open %v or close %v", c.Low, c.Open, c.Close)
+	}
+	return ""
+}
+
+// crossCheckCandles flags candles whose open, high, low or close differ by
+// more than the tolerance from those of the candle aggregated from the trades
+// in their interval, dropping them when reject is set. Both must be ordered
+// oldest first, candles without trades in their interval aren't checked
+func crossCheckCandles(candles, tradeCandles []kline.Candle, tolerance float64, reject bool, report *QualityReport) []kline.Candle {
+	resp := candles[:0]
+	j := 0
+	for i := range candles {
+		for j < len(tradeCandles) && tradeCandles[j].StartTime.Before(candles[i].StartTime) {
+			j++
+		}
+		if j < len(tradeCandles) && tradeCandles[j].StartTime.Equal(candles[i].StartTime) {
+			if detail := priceMismatch(&candles[i], &tradeCandles[j], tolerance); detail != "" &&
+				report.flag(candles[i].StartTime, AnomalyTradeMismatch, detail, reject) {
+				continue
+			}
+		}
+		resp = append(resp, candles[i])
+	}
+	return resp
+}
+
+// priceMismatch returns which of a candle's prices differ by more than the
+// tolerance from those aggregated from trades, or nothing if none do
+func priceMismatch(c, fromTrades *kline.Candle, tolerance float64) string {
+	prices := []struct {
+		name           string
+		candle, traded float64
+	}{
+		{"open", c.Open, fromTrades.Open},
+		{"high", c.High, fromTrades.High},
+		{"low", c.Low, fromTrades.Low},
+		{"close", c.Close, fromTrades.Close},
+	}
+	for i := range prices {
+		if math.Abs(prices[i].candle-prices[i].traded) > tolerance*math.Abs(prices[i].traded) {
+			return fmt.Sprintf("%s %v differs from the traded %v", prices[i].name,
+				prices[i].candle, prices[i].traded)
+		}
+	}
+	return ""
+}
+
+// flag records an anomaly, returning whether it is rejected
+func (q *QualityReport) flag(t time.Time, kind, detail string, reject bool) bool {
+	q.Anomalies = append(q.Anomalies, Anomaly{Time: t, Kind: kind, Detail: detail})
+	if reject {
+		q.Rejected++
+	}
+	return reject
+}
@@ -0,0 +1,199 @@
+package data
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func TestCheckTrades(t *testing.T) {
+	start := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	trades := func() []trade {
+		return []trade{
+			{start, 100, 1, "1"},
+			{start.Add(time.Minute), 101, 1, "2"},
+			{start.Add(time.Minute), 101, 1, "2"},
+			{start.Add(30 * time.Second), 99, 1, "3"},
+			{start.Add(2 * time.Minute), 102, 1, ""},
+			{start.Add(2 * time.Minute), 102, 1, ""},
+		}
+	}
+
+	var report QualityReport
+	checked := checkTrades(trades(), false, &report)
+	if len(checked) != 5 || report.DuplicateTrades != 1 || report.Rejected != 0 {
+		t.Errorf("expected the duplicate trade ID to be dropped, received %+v %+v", checked, report)
+	}
+	if len(report.Anomalies) != 1 || report.Anomalies[0].Kind != AnomalyOutOfOrderTrade ||
+		!report.Anomalies[0].Time.Equal(start.Add(30*time.Second)) {
+		t.Errorf("expected the out of order trade to be flagged, received %+v", report.Anomalies)
+	}
+
+	report = QualityReport{}
+	checked = checkTrades(trades(), true, &report)
+	if len(checked) != 4 || report.Rejected != 1 {
+		t.Errorf("expected the out of order trade to be rejected, received %+v %+v", checked, report)
+	}
+}
+
+func TestCheckCandles(t *testing.T) {
+	r := testRequest()
+	start := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	candles := func() []kline.Candle {
+		return []kline.Candle{
+			r.candle(start, 100, 110, 90, 105, 1),
+			r.candle(start.Add(2*time.Hour), 105, 106, 100, 101, 1),
+			r.candle(start.Add(time.Hour), 105, 106, 100, 101, 1),
+			r.candle(start.Add(2*time.Hour), 105, 106, 100, 101, 1),
+			r.candle(start.Add(3*time.Hour), 101, 100, 99, 100, 1),
+			r.candle(start.Add(4*time.Hour), 100, 101, 99, 100, -1),
+			r.candle(start.Add(5*time.Hour), 100, 101, 99, 100, 0),
+		}
+	}
+
+	var report QualityReport
+	checked := checkCandles(candles(), false, &report)
+	if len(checked) != 7 || report.Rejected != 0 {
+		t.Errorf("expected flagged candles to be kept, received %d %+v", len(checked), report)
+	}
+	expected := []string{AnomalyOutOfOrderCandle, AnomalyDuplicateCandle, AnomalyInvalidCandle, AnomalyInvalidCandle}
+	if len(report.Anomalies) != len(expected) {
+		t.Fatalf("expected %d anomalies, received %+v", len(expected), report.Anomalies)
+	}
+	for i := range expected {
+		if report.Anomalies[i].Kind != expected[i] {
+			t.Errorf("expected %s, received %+v", expected[i], report.Anomalies[i])
+		}
+	}
+
+	report = QualityReport{}
+	checked = checkCandles(candles(), true, &report)
+	if len(checked) != 3 || report.Rejected != 4 || !checked[2].StartTime.Equal(start.Add(5*time.Hour)) {
+		t.Errorf("expected anomalous candles to be rejected, received %+v %+v", checked, report)
+	}
+}
+
+func TestInvalidCandle(t *testing.T) {
+	for _, c := range []kline.Candle{
+		{Open: 0, High: 1, Low: 1, Close: 1},
+		{Open: 1, High: 1, Low: 1, Close: 1, Volume: -1},
+		{Open: 1, High: 1, Low: 2, Close: 1},
+		{Open: 1, High: 1.5, Low: 1, Close: 2},
+		{Open: 1, High: 2, Low: 1.5, Close: 2},
+	} {
+		if invalidCandle(&c) == "" {
+			t.Errorf("expected %+v to be invalid", c)
+		}
+	}
+	if detail := invalidCandle(&kline.Candle{Open: 1, High: 2, Low: 0.5, Close: 1.5}); detail != "" {
+		t.Errorf("expected a valid candle, received %s", detail)
+	}
+}
+
+func TestCrossCheckCandles(t *testing.T) {
+	r := testRequest()
+	start := time.Date(2020, 3, 1, 12, 0, 0, 0, time.UTC)
+	candles := func() []kline.Candle {
+		return []kline.Candle{
+			r.candle(start, 100, 110, 90, 105, 1),
+			r.candle(start.Add(time.Hour), 105, 120, 100, 101, 1),
+			r.candle(start.Add(2*time.Hour), 101, 102, 100, 101, 1),
+		}
+	}
+	tradeCandles := []kline.Candle{
+		r.candle(start, 100, 110.05, 90, 105, 1),
+		r.candle(start.Add(time.Hour), 105, 110, 100, 101, 1),
+	}
+
+	var report QualityReport
+	checked := crossCheckCandles(candles(), tradeCandles, defaultPriceTolerance, false, &report)
+	if len(checked) != 3 || len(report.Anomalies) != 1 ||
+		report.Anomalies[0].Kind != AnomalyTradeMismatch ||
+		!report.Anomalies[0].Time.Equal(start.Add(time.Hour)) {
+		t.Errorf("expected the high to mismatch, received %+v", report)
+	}
+
+	report = QualityReport{}
+	checked = crossCheckCandles(candles(), tradeCandles, 0.1, true, &report)
+	if len(checked) != 3 || len(report.Anomalies) != 0 {
+		t.Errorf("expected the prices to be within tolerance, received %+v", report)
+	}
+	checked = crossCheckCandles(candles(), tradeCandles, defaultPriceTolerance, true, &report)
+	if len(checked) != 2 || report.Rejected != 1 {
+		t.Errorf("expected the mismatched candle to be rejected, received %+v", report)
+	}
+}
+
+func TestCheckedSource(t *testing.T) {
+	dir, err := ioutil.TempDir("", "backtester")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	candlePath := filepath.Join(dir, "candles.csv")
+	err = ioutil.WriteFile(candlePath, []byte("1583064000,9000,9100,8950,9050,120\n"+
+		"1583067600,9050,9300,9000,9150,95\n"+
+		"1583071200,9150,9100,9000,9050,10\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tradePath := filepath.Join(dir, "trades.csv")
+	err = ioutil.WriteFile(tradePath, []byte("id,date,price,amount\n"+
+		"1,1583064000000,9000,1\n"+
+		"2,1583065000000,9100,1\n"+
+		"2,1583065000000,9100,1\n"+
+		"3,1583066000000,8950,1\n"+
+		"4,1583067000000,9050,1\n"+
+		"5,1583067600000,9050,1\n"+
+		"6,1583070000000,9150,1\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := testRequest()
+	src := &CheckedSource{
+		Source: &CSVSource{Path: candlePath, Format: FormatCandles},
+		Trades: &CSVSource{Path: tradePath, Format: FormatKaikoTrades},
+	}
+	candles, err := src.Candles(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report := src.Report()
+	if len(candles) != 3 || report.DuplicateTrades != 1 || len(report.Anomalies) != 2 {
+		t.Errorf("expected the invalid and mismatched candles to be flagged, received %+v", report)
+	}
+
+	src.Reject = true
+	candles, err = src.Candles(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 1 || !candles[0].StartTime.Equal(time.Unix(1583064000, 0)) ||
+		src.Report().Rejected != 2 {
+		t.Errorf("expected only the first candle to be kept, received %+v %+v", candles, src.Report())
+	}
+
+	src.Source = src.Trades
+	candles, err = src.Candles(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(candles) != 2 || candles[0].Volume != 4 {
+		t.Errorf("expected the duplicate trade to be dropped, received %+v", candles)
+	}
+
+	src.Trades = &CSVSource{Path: candlePath, Format: FormatCandles}
+	if _, err = src.Candles(&r); err != errNotTradeFormat {
+		t.Errorf("expected %v, received %v", errNotTradeFormat, err)
+	}
+	src.Source = &DatabaseSource{}
+	src.Trades = nil
+	if _, err = src.Candles(&r); err == nil {
+		t.Error("expected an error without a database connection")
+	}
+}
@@ -0,0 +1,199 @@
+// Package calendar describes the trading sessions, holidays and maintenance
+// windows of venues which don't trade around the clock, such as fiat gateways
+// and CME-style futures exchanges, so orders aren't placed while they are
+// closed
+package calendar
+
+import (
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// New returns the calendar of the settings, checking its sessions, holidays
+// and maintenance windows are valid
+func New(s *Settings) (*Calendar, error) {
+	if s == nil {
+		return nil, errors.New("calendar settings are nil")
+	}
+	if s.Name == "" {
+		return nil, errNameUnset
+	}
+	loc, err := time.LoadLocation(s.Timezone)
+	if err != nil {
+		return nil, fmt.Errorf("calendar %s: %v", s.Name, err)
+	}
+	c := &Calendar{
+		name:     s.Name,
+		location: loc,
+		holidays: make(map[string]bool, len(s.Holidays)),
+	}
+	for i := range s.Sessions {
+		sessions, err := parseSession(&s.Sessions[i])
+		if err != nil {
+			return nil, fmt.Errorf("calendar %s: %v", s.Name, err)
+		}
+		c.sessions = append(c.sessions, sessions...)
+	}
+	for i := range s.Holidays {
+		d, err := time.ParseInLocation(DateFormat, s.Holidays[i], loc)
+		if err != nil {
+			return nil, fmt.Errorf("calendar %s: %v %q", s.Name, errInvalidHoliday, s.Holidays[i])
+		}
+		c.holidays[d.Format(DateFormat)] = true
+	}
+	for i := range s.Maintenance {
+		if !s.Maintenance[i].End.After(s.Maintenance[i].Start) {
+			return nil, fmt.Errorf("calendar %s: %v", s.Name, errInvalidWindow)
+		}
+	}
+	c.maintenance = append(c.maintenance, s.Maintenance...)
+	return c, nil
+}
+
+// parseSession returns a session for each of its days
+func parseSession(s *Session) ([]session, error) {
+	if len(s.Days) == 0 {
+		return nil, errNoSessionDays
+	}
+	open, err := time.Parse(ClockFormat, s.Open)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session open %q", s.Open)
+	}
+	closing, err := time.Parse(ClockFormat, s.Close)
+	if err != nil {
+		return nil, fmt.Errorf("invalid session close %q", s.Close)
+	}
+	resp := make([]session, len(s.Days))
+	for i := range s.Days {
+		day, err := parseDay(s.Days[i])
+		if err != nil {
+			return nil, err
+		}
+		resp[i] = session{
+			day:          day,
+			openHour:     open.Hour(),
+			openMinute:   open.Minute(),
+			closeHour:    closing.Hour(),
+			closeMinute:  closing.Minute(),
+			closeNextDay: !closing.After(open),
+		}
+	}
+	return resp, nil
+}
+
+// parseDay returns the day of the week of its full or three letter name
+func parseDay(day string) (time.Weekday, error) {
+	day = strings.ToLower(day)
+	for d := time.Sunday; d <= time.Saturday; d++ {
+		name := strings.ToLower(d.String())
+		if day == name || day == name[:3] {
+			return d, nil
+		}
+	}
+	return 0, fmt.Errorf("%v %q", errInvalidDay, day)
+}
+
+// Name returns the name of the venue
+func (c *Calendar) Name() string {
+	return c.name
+}
+
+// IsOpen returns whether the venue is open at a time
+func (c *Calendar) IsOpen(t time.Time) bool {
+	open, _ := c.state(t.In(c.location))
+	return open
+}
+
+// Status returns whether the venue is open at a time, why it is closed and
+// when that next changes
+func (c *Calendar) Status(t time.Time) Status {
+	t = t.In(c.location)
+	var s Status
+	s.Open, s.Reason = c.state(t)
+	boundaries := c.boundaries(t)
+	for i := range boundaries {
+		if open, _ := c.state(boundaries[i]); open != s.Open {
+			s.Until = boundaries[i]
+			break
+		}
+	}
+	return s
+}
+
+// state returns whether the venue is open at a time in its location and, if
+// not, why
+func (c *Calendar) state(t time.Time) (open bool, reason string) {
+	for i := range c.maintenance {
+		if !t.Before(c.maintenance[i].Start) && t.Before(c.maintenance[i].End) {
+			if c.maintenance[i].Reason == "" {
+				return false, ReasonMaintenance
+			}
+			return false, ReasonMaintenance + ": " + c.maintenance[i].Reason
+		}
+	}
+	if c.holidays[t.Format(DateFormat)] {
+		return false, ReasonHoliday
+	}
+	if len(c.sessions) == 0 {
+		return true, ""
+	}
+	y, m, d := t.Date()
+	// a session opening the day before may still be open
+	for offset := -1; offset <= 0; offset++ {
+		for i := range c.sessions {
+			open, closing := c.sessions[i].times(y, m, d+offset, c.location)
+			if open.IsZero() {
+				continue
+			}
+			if !t.Before(open) && t.Before(closing) {
+				return true, ""
+			}
+		}
+	}
+	return false, ReasonOutsideSession
+}
+
+// boundaries returns the times after t, in order, at which the venue may open
+// or close
+func (c *Calendar) boundaries(t time.Time) []time.Time {
+	var resp []time.Time
+	add := func(b time.Time) {
+		if b.After(t) {
+			resp = append(resp, b)
+		}
+	}
+	y, m, d := t.Date()
+	for day := -1; day <= searchHorizon; day++ {
+		add(time.Date(y, m, d+day, 0, 0, 0, 0, c.location))
+		for i := range c.sessions {
+			open, closing := c.sessions[i].times(y, m, d+day, c.location)
+			if open.IsZero() {
+				continue
+			}
+			add(open)
+			add(closing)
+		}
+	}
+	for i := range c.maintenance {
+		add(c.maintenance[i].Start)
+		add(c.maintenance[i].End)
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Before(resp[j]) })
+	return resp
+}
+
+// times returns when the session opens and closes if it opens on a date,
+// otherwise zero times
+func (s *session) times(y int, m time.Month, d int, loc *time.Location) (open, closing time.Time) {
+	open = time.Date(y, m, d, s.openHour, s.openMinute, 0, 0, loc)
+	if open.Weekday() != s.day {
+		return time.Time{}, time.Time{}
+	}
+	if s.closeNextDay {
+		d++
+	}
+	return open, time.Date(y, m, d, s.closeHour, s.closeMinute, 0, 0, loc)
+}
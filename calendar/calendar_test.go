@@ -0,0 +1,108 @@
+package calendar
+
+import (
+	"errors"
+	"testing"
+	"time"
+)
+
+func newTestCalendar(t *testing.T) *Calendar {
+	t.Helper()
+	c, err := New(&Settings{
+		Name:     "CME",
+		Timezone: "America/Chicago",
+		Sessions: []Session{
+			{Days: []string{"Sun", "monday", "TUE", "Wed", "Thu"}, Open: "17:00", Close: "16:00"},
+		},
+		Holidays: []string{"2026-12-25"},
+		Maintenance: []Window{
+			{
+				Start:  time.Date(2026, 10, 20, 15, 0, 0, 0, time.UTC),
+				End:    time.Date(2026, 10, 20, 17, 0, 0, 0, time.UTC),
+				Reason: "matching engine upgrade",
+			},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return c
+}
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	if _, err := New(nil); err == nil {
+		t.Error("expected an error for nil settings")
+	}
+	if _, err := New(&Settings{}); !errors.Is(err, errNameUnset) {
+		t.Errorf("expected %v, received %v", errNameUnset, err)
+	}
+	invalid := []Settings{
+		{Name: "a", Timezone: "Mars/Olympus"},
+		{Name: "a", Sessions: []Session{{Open: "09:00", Close: "17:00"}}},
+		{Name: "a", Sessions: []Session{{Days: []string{"Funday"}, Open: "09:00", Close: "17:00"}}},
+		{Name: "a", Sessions: []Session{{Days: []string{"Mon"}, Open: "9am", Close: "17:00"}}},
+		{Name: "a", Sessions: []Session{{Days: []string{"Mon"}, Open: "09:00", Close: "25:00"}}},
+		{Name: "a", Holidays: []string{"25/12/2026"}},
+		{Name: "a", Maintenance: []Window{{Start: time.Unix(2, 0), End: time.Unix(1, 0)}}},
+	}
+	for i := range invalid {
+		if _, err := New(&invalid[i]); err == nil {
+			t.Errorf("expected an error for %+v", invalid[i])
+		}
+	}
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+	c := newTestCalendar(t)
+	chicago, err := time.LoadLocation("America/Chicago")
+	if err != nil {
+		t.Fatal(err)
+	}
+	at := func(month time.Month, day, hour, minute int) time.Time {
+		return time.Date(2026, month, day, hour, minute, 0, 0, chicago)
+	}
+
+	for _, tc := range []struct {
+		name   string
+		t      time.Time
+		open   bool
+		reason string
+		until  time.Time
+	}{
+		{"midweek", at(10, 14, 10, 0), true, "", at(10, 14, 16, 0)},
+		{"daily break", at(10, 14, 16, 30), false, ReasonOutsideSession, at(10, 14, 17, 0)},
+		{"overnight", at(10, 15, 2, 0), true, "", at(10, 15, 16, 0)},
+		{"friday close", at(10, 16, 15, 59), true, "", at(10, 16, 16, 0)},
+		{"weekend", at(10, 17, 12, 0), false, ReasonOutsideSession, at(10, 18, 17, 0)},
+		{"maintenance", at(10, 20, 10, 30), false, ReasonMaintenance + ": matching engine upgrade", at(10, 20, 12, 0)},
+		{"before holiday", at(12, 24, 23, 0), true, "", at(12, 25, 0, 0)},
+		{"holiday", at(12, 25, 10, 0), false, ReasonHoliday, at(12, 27, 17, 0)},
+	} {
+		s := c.Status(tc.t)
+		if s.Open != tc.open || s.Reason != tc.reason || !s.Until.Equal(tc.until) {
+			t.Errorf("%s: expected open %v reason %q until %v, received %+v",
+				tc.name, tc.open, tc.reason, tc.until, s)
+		}
+		if c.IsOpen(tc.t) != tc.open {
+			t.Errorf("%s: expected IsOpen %v", tc.name, tc.open)
+		}
+	}
+}
+
+func TestStatusAroundTheClock(t *testing.T) {
+	t.Parallel()
+	c, err := New(&Settings{Name: "gateway", Holidays: []string{"2026-01-01"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := c.Status(time.Date(2026, 10, 14, 3, 0, 0, 0, time.UTC))
+	if !s.Open || !s.Until.IsZero() {
+		t.Errorf("expected to be open indefinitely, received %+v", s)
+	}
+	s = c.Status(time.Date(2026, 1, 1, 3, 0, 0, 0, time.UTC))
+	if s.Open || !s.Until.Equal(time.Date(2026, 1, 2, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected to be closed until the day after the holiday, received %+v", s)
+	}
+}
@@ -0,0 +1,92 @@
+package calendar
+
+import (
+	"errors"
+	"time"
+)
+
+// Const vars for calendars
+const (
+	// DateFormat is the format holidays are written in
+	DateFormat = "2006-01-02"
+	// ClockFormat is the format session open and close times are written in
+	ClockFormat = "15:04"
+
+	// ReasonMaintenance is the reason a venue is closed during a maintenance
+	// window, followed by the window's reason when it has one
+	ReasonMaintenance = "maintenance"
+	// ReasonHoliday is the reason a venue is closed on a holiday
+	ReasonHoliday = "holiday"
+	// ReasonOutsideSession is the reason a venue is closed outside of its
+	// trading sessions
+	ReasonOutsideSession = "outside trading session"
+
+	// searchHorizon is how far ahead the next change of status is searched
+	// for. A venue which doesn't open or close within it has no Until time
+	searchHorizon = 31
+)
+
+var (
+	errNameUnset      = errors.New("calendar venue name must be set")
+	errNoSessionDays  = errors.New("session has no days")
+	errInvalidDay     = errors.New("invalid session day")
+	errInvalidWindow  = errors.New("maintenance window must end after it starts")
+	errInvalidHoliday = errors.New("invalid holiday date")
+)
+
+// Settings defines a venue's trading calendar. Sessions are weekly, opening
+// on each of their days at the open time and closing at the close time,
+// which is on the following day when it isn't after the open time. A venue
+// without sessions trades around the clock. Holidays close the venue for the
+// whole day, and maintenance windows between their start and end, times and
+// dates being in the venue's timezone
+type Settings struct {
+	Name        string    `json:"name"`
+	Timezone    string    `json:"timezone,omitempty"`
+	Sessions    []Session `json:"sessions,omitempty"`
+	Holidays    []string  `json:"holidays,omitempty"`
+	Maintenance []Window  `json:"maintenance,omitempty"`
+}
+
+// Session is the weekly trading hours of a venue on the listed days, such as
+// "Mon" or "Monday"
+type Session struct {
+	Days  []string `json:"days"`
+	Open  string   `json:"open"`
+	Close string   `json:"close"`
+}
+
+// Window is a one-off maintenance window during which a venue is closed
+type Window struct {
+	Start  time.Time `json:"start"`
+	End    time.Time `json:"end"`
+	Reason string    `json:"reason,omitempty"`
+}
+
+// Status is whether a venue is open at a time and, when it is closed, why.
+// Until is when the venue next opens or closes, and is zero when that is
+// beyond the search horizon
+type Status struct {
+	Open   bool
+	Reason string
+	Until  time.Time
+}
+
+// Calendar answers whether a venue is open at a time
+type Calendar struct {
+	name        string
+	location    *time.Location
+	sessions    []session
+	holidays    map[string]bool
+	maintenance []Window
+}
+
+// session is a parsed session on a single day of the week
+type session struct {
+	day          time.Weekday
+	openHour     int
+	openMinute   int
+	closeHour    int
+	closeMinute  int
+	closeNextDay bool
+}
@@ -18,17 +18,26 @@ func EncryptOrDecrypt(encrypt bool) string {
 }
 
 func main() {
-	var inFile, outFile, key string
+	var inFile, outFile, key, keyFile string
 	var encrypt bool
 	defaultCfgFile := config.DefaultFilePath()
 	flag.StringVar(&inFile, "infile", defaultCfgFile, "The config input file to process.")
 	flag.StringVar(&outFile, "outfile", defaultCfgFile+".out", "The config output file.")
 	flag.BoolVar(&encrypt, "encrypt", true, "Whether to encrypt or decrypt.")
 	flag.StringVar(&key, "key", "", "The key to use for AES encryption.")
+	flag.StringVar(&keyFile, "keyfile", "", "A file containing the key to use for AES encryption.")
 	flag.Parse()
 
 	log.Println("GoCryptoTrader: config-helper tool.")
 
+	if key == "" && keyFile != "" {
+		result, err := config.ReadKeyFile(keyFile)
+		if err != nil {
+			log.Fatalf("Unable to obtain encryption/decryption key: %s", err)
+		}
+		key = string(result)
+	}
+
 	if key == "" {
 		result, err := config.PromptForConfigKey(false)
 		if err != nil {
@@ -39,6 +39,7 @@ var (
 		"node_modules",
 		".vscode",
 		".idea",
+		"calendar_templates",
 		"cmd_templates",
 		"common_templates",
 		"communications_templates",
@@ -51,6 +52,7 @@ var (
 		"sub_templates",
 		"testdata_templates",
 		"tools_templates",
+		"wallet_templates",
 		"web_templates",
 	}
 
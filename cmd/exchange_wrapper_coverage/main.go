@@ -1,12 +1,14 @@
 package main
 
 import (
+	"context"
 	"log"
 	"math/rand"
 	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -82,47 +84,47 @@ func testWrappers(e exchange.IBotExchange) []string {
 
 	var funcs []string
 
-	_, err := e.FetchTicker(p, assetType)
+	_, err := e.FetchTicker(context.Background(), p, assetType)
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "FetchTicker")
 	}
 
-	_, err = e.UpdateTicker(p, assetType)
+	_, err = e.UpdateTicker(context.Background(), p, assetType)
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "UpdateTicker")
 	}
 
-	_, err = e.FetchOrderbook(p, assetType)
+	_, err = e.FetchOrderbook(context.Background(), p, assetType)
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "FetchOrderbook")
 	}
 
-	_, err = e.UpdateOrderbook(p, assetType)
+	_, err = e.UpdateOrderbook(context.Background(), p, assetType)
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "UpdateOrderbook")
 	}
 
-	_, err = e.FetchTradablePairs(asset.Spot)
+	_, err = e.FetchTradablePairs(context.Background(), asset.Spot)
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "FetchTradablePairs")
 	}
 
-	err = e.UpdateTradablePairs(false)
+	err = e.UpdateTradablePairs(context.Background(), false)
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "UpdateTradablePairs")
 	}
 
-	_, err = e.FetchAccountInfo()
+	_, err = e.FetchAccountInfo(context.Background())
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "GetAccountInfo")
 	}
 
-	_, err = e.GetExchangeHistory(p, assetType)
+	_, err = e.GetExchangeHistory(context.Background(), p, assetType)
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "GetExchangeHistory")
 	}
 
-	_, err = e.GetFundingHistory()
+	_, err = e.GetFundingHistory(context.Background())
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "GetFundingHistory")
 	}
@@ -131,60 +133,60 @@ func testWrappers(e exchange.IBotExchange) []string {
 		Pair:      p,
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Amount:    1000000,
-		Price:     10000000000,
+		Amount:    decimal.NewFromInt(1000000),
+		Price:     decimal.NewFromInt(10000000000),
 		ClientID:  "meow",
 	}
-	_, err = e.SubmitOrder(s)
+	_, err = e.SubmitOrder(context.Background(), s)
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "SubmitOrder")
 	}
 
-	_, err = e.ModifyOrder(&order.Modify{})
+	_, err = e.ModifyOrder(context.Background(), &order.Modify{})
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "ModifyOrder")
 	}
 
-	err = e.CancelOrder(&order.Cancel{})
+	err = e.CancelOrder(context.Background(), &order.Cancel{})
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "CancelOrder")
 	}
 
-	_, err = e.CancelAllOrders(&order.Cancel{})
+	_, err = e.CancelAllOrders(context.Background(), &order.Cancel{})
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "CancelAllOrders")
 	}
 
-	_, err = e.GetOrderInfo("1")
+	_, err = e.GetOrderInfo(context.Background(), "1")
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "GetOrderInfo")
 	}
 
-	_, err = e.GetOrderHistory(&order.GetOrdersRequest{})
+	_, err = e.GetOrderHistory(context.Background(), &order.GetOrdersRequest{})
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "GetOrderHistory")
 	}
 
-	_, err = e.GetActiveOrders(&order.GetOrdersRequest{})
+	_, err = e.GetActiveOrders(context.Background(), &order.GetOrdersRequest{})
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "GetActiveOrders")
 	}
 
-	_, err = e.GetDepositAddress(currency.BTC, "")
+	_, err = e.GetDepositAddress(context.Background(), currency.BTC, "")
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "GetDepositAddress")
 	}
 
-	_, err = e.WithdrawCryptocurrencyFunds(&withdraw.CryptoRequest{})
+	_, err = e.WithdrawCryptocurrencyFunds(context.Background(), &withdraw.CryptoRequest{})
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "WithdrawCryptocurrencyFunds")
 	}
 
-	_, err = e.WithdrawFiatFunds(&withdraw.FiatRequest{})
+	_, err = e.WithdrawFiatFunds(context.Background(), &withdraw.FiatRequest{})
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "WithdrawFiatFunds")
 	}
-	_, err = e.WithdrawFiatFundsToInternationalBank(&withdraw.FiatRequest{})
+	_, err = e.WithdrawFiatFundsToInternationalBank(context.Background(), &withdraw.FiatRequest{})
 	if err == common.ErrNotYetImplemented {
 		funcs = append(funcs, "WithdrawFiatFundsToInternationalBank")
 	}
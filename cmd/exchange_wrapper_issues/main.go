@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"encoding/json"
 	"flag"
 	"fmt"
@@ -13,6 +14,7 @@ import (
 	"sync"
 	"text/template"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -317,7 +319,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 
 		if !authenticatedOnly {
 			var r1 *ticker.Price
-			r1, err = e.FetchTicker(p, assetTypes[i])
+			r1, err = e.FetchTicker(context.Background(), p, assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -331,7 +333,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r2 *ticker.Price
-			r2, err = e.UpdateTicker(p, assetTypes[i])
+			r2, err = e.UpdateTicker(context.Background(), p, assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -345,7 +347,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r3 *orderbook.Base
-			r3, err = e.FetchOrderbook(p, assetTypes[i])
+			r3, err = e.FetchOrderbook(context.Background(), p, assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -359,7 +361,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r4 *orderbook.Base
-			r4, err = e.UpdateOrderbook(p, assetTypes[i])
+			r4, err = e.UpdateOrderbook(context.Background(), p, assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -373,7 +375,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			})
 
 			var r5 []string
-			r5, err = e.FetchTradablePairs(assetTypes[i])
+			r5, err = e.FetchTradablePairs(context.Background(), assetTypes[i])
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -386,7 +388,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 				Response:   jsonifyInterface([]interface{}{r5}),
 			})
 			// r6
-			err = e.UpdateTradablePairs(false)
+			err = e.UpdateTradablePairs(context.Background(), false)
 			msg = ""
 			if err != nil {
 				msg = err.Error()
@@ -401,7 +403,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		}
 
 		var r7 account.Holdings
-		r7, err = e.FetchAccountInfo()
+		r7, err = e.FetchAccountInfo(context.Background())
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -414,7 +416,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r8 []exchange.TradeHistory
-		r8, err = e.GetExchangeHistory(p, assetTypes[i])
+		r8, err = e.GetExchangeHistory(context.Background(), p, assetTypes[i])
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -428,7 +430,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r9 []exchange.FundHistory
-		r9, err = e.GetFundingHistory()
+		r9, err = e.GetFundingHistory(context.Background())
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -447,7 +449,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Amount:        config.OrderSubmission.Amount,
 		}
 		var r10 float64
-		r10, err = e.GetFeeByType(&feeType)
+		r10, err = e.GetFeeByType(context.Background(), &feeType)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -464,12 +466,12 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Pair:      p,
 			OrderSide: testOrderSide,
 			OrderType: testOrderType,
-			Amount:    config.OrderSubmission.Amount,
-			Price:     config.OrderSubmission.Price,
+			Amount:    decimal.NewFromFloat(config.OrderSubmission.Amount),
+			Price:     decimal.NewFromFloat(config.OrderSubmission.Price),
 			ClientID:  config.OrderSubmission.OrderID,
 		}
 		var r11 order.SubmitResponse
-		r11, err = e.SubmitOrder(s)
+		r11, err = e.SubmitOrder(context.Background(), s)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -487,11 +489,11 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Type:         testOrderType,
 			Side:         testOrderSide,
 			CurrencyPair: p,
-			Price:        config.OrderSubmission.Price,
-			Amount:       config.OrderSubmission.Amount,
+			Price:        decimal.NewFromFloat(config.OrderSubmission.Price),
+			Amount:       decimal.NewFromFloat(config.OrderSubmission.Amount),
 		}
 		var r12 string
-		r12, err = e.ModifyOrder(&modifyRequest)
+		r12, err = e.ModifyOrder(context.Background(), &modifyRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -509,7 +511,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			CurrencyPair: p,
 			OrderID:      config.OrderSubmission.OrderID,
 		}
-		err = e.CancelOrder(&cancelRequest)
+		err = e.CancelOrder(context.Background(), &cancelRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -523,7 +525,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r14 order.CancelAllResponse
-		r14, err = e.CancelAllOrders(&cancelRequest)
+		r14, err = e.CancelAllOrders(context.Background(), &cancelRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -537,7 +539,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r15 order.Detail
-		r15, err = e.GetOrderInfo(config.OrderSubmission.OrderID)
+		r15, err = e.GetOrderInfo(context.Background(), config.OrderSubmission.OrderID)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -556,7 +558,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Currencies: []currency.Pair{p},
 		}
 		var r16 []order.Detail
-		r16, err = e.GetOrderHistory(&historyRequest)
+		r16, err = e.GetOrderHistory(context.Background(), &historyRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -575,7 +577,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Currencies: []currency.Pair{p},
 		}
 		var r17 []order.Detail
-		r17, err = e.GetActiveOrders(&orderRequest)
+		r17, err = e.GetActiveOrders(context.Background(), &orderRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -589,7 +591,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r18 string
-		r18, err = e.GetDepositAddress(p.Base, "")
+		r18, err = e.GetDepositAddress(context.Background(), p.Base, "")
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -609,7 +611,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Amount:        config.OrderSubmission.Amount,
 		}
 		var r19 float64
-		r19, err = e.GetFeeByType(&feeType)
+		r19, err = e.GetFeeByType(context.Background(), &feeType)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -631,7 +633,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			Address:     withdrawAddressOverride,
 		}
 		var r20 string
-		r20, err = e.WithdrawCryptocurrencyFunds(&withdrawRequest)
+		r20, err = e.WithdrawCryptocurrencyFunds(context.Background(), &withdrawRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -653,7 +655,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			BankTransactionType: exchange.WireTransfer,
 		}
 		var r21 float64
-		r21, err = e.GetFeeByType(&feeType)
+		r21, err = e.GetFeeByType(context.Background(), &feeType)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -691,7 +693,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 			IntermediaryBankCode:          config.BankDetails.IntermediaryBankCode,
 		}
 		var r22 string
-		r22, err = e.WithdrawFiatFunds(&fiatWithdrawRequest)
+		r22, err = e.WithdrawFiatFunds(context.Background(), &fiatWithdrawRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -705,7 +707,7 @@ func testWrappers(e exchange.IBotExchange, base *exchange.Base, config *Config)
 		})
 
 		var r23 string
-		r23, err = e.WithdrawFiatFundsToInternationalBank(&fiatWithdrawRequest)
+		r23, err = e.WithdrawFiatFundsToInternationalBank(context.Background(), &fiatWithdrawRequest)
 		msg = ""
 		if err != nil {
 			msg = err.Error()
@@ -14,8 +14,11 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/statement"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/update"
 	"github.com/urfave/cli"
 )
 
@@ -199,6 +202,77 @@ func getRPCEndpoints(_ *cli.Context) error {
 	return nil
 }
 
+var getRPCSessionsCommand = cli.Command{
+	Name:   "getrpcsessions",
+	Usage:  "gets the sessions of the gRPC, deprecated RPC and websocket RPC interfaces and the addresses allowed to use them",
+	Action: getRPCSessions,
+}
+
+func getRPCSessions(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetRPCSessions(context.Background(),
+		&gctrpc.GetRPCSessionsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var revokeRPCSessionCommand = cli.Command{
+	Name:      "revokerpcsession",
+	Usage:     "revokes a session, disconnecting a websocket session or refusing a gRPC session's address for the session timeout",
+	ArgsUsage: "<id>",
+	Action:    revokeRPCSession,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the session to revoke",
+		},
+	},
+}
+
+func revokeRPCSession(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "revokerpcsession")
+		return nil
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RevokeRPCSession(context.Background(),
+		&gctrpc.RevokeRPCSessionRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
 var getCommunicationRelayersCommand = cli.Command{
 	Name:   "getcommsrelayers",
 	Usage:  "gets GoCryptoTrader communication relayers",
@@ -881,17 +955,32 @@ var getPortfolioSummaryCommand = cli.Command{
 	Name:   "getportfoliosummary",
 	Usage:  "gets the portfolio summary",
 	Action: getPortfolioSummary,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "fiat",
+			Usage: "comma separated fiat currencies to value the portfolio in e.g. USD,AUD, defaults to the configured fiat display currencies",
+		},
+	},
 }
 
-func getPortfolioSummary(_ *cli.Context) error {
+func getPortfolioSummary(c *cli.Context) error {
 	conn, err := setupClient()
 	if err != nil {
 		return err
 	}
 	defer conn.Close()
 
+	var fiatCurrencies []string
+	if c.String("fiat") != "" {
+		fiatCurrencies = strings.Split(c.String("fiat"), ",")
+	}
+
 	client := gctrpc.NewGoCryptoTraderClient(conn)
-	result, err := client.GetPortfolioSummary(context.Background(), &gctrpc.GetPortfolioSummaryRequest{})
+	result, err := client.GetPortfolioSummary(context.Background(),
+		&gctrpc.GetPortfolioSummaryRequest{
+			FiatCurrencies: fiatCurrencies,
+		},
+	)
 	if err != nil {
 		return err
 	}
@@ -1251,7 +1340,7 @@ func getOrder(c *cli.Context) error {
 var submitOrderCommand = cli.Command{
 	Name:      "submitorder",
 	Usage:     "submit order submits an exchange order",
-	ArgsUsage: "<exchange> <pair> <side> <type> <amount> <price> <client_id>",
+	ArgsUsage: "<exchange> <pair> <side> <type> <amount> <price> <client_id> <strategy_id>",
 	Action:    submitOrder,
 	Flags: []cli.Flag{
 		cli.StringFlag{
@@ -1282,6 +1371,26 @@ var submitOrderCommand = cli.Command{
 			Name:  "client_id",
 			Usage: "the optional client order ID",
 		},
+		cli.StringFlag{
+			Name:  "strategy_id",
+			Usage: "the optional strategy whose virtual balances the order is reserved from",
+		},
+		cli.StringFlag{
+			Name:  "partial_fill_policy",
+			Usage: "the optional partial fill policy (leave, cancel or repeg), defaulting to the strategy's",
+		},
+		cli.StringFlag{
+			Name:  "partial_fill_timeout",
+			Usage: "how long the order is partially filled before its partial fill policy is applied e.g. 30s",
+		},
+		cli.StringFlag{
+			Name:  "time_in_force",
+			Usage: "the optional time in force (GTC, IOC, FOK or GTD)",
+		},
+		cli.StringFlag{
+			Name:  "expires",
+			Usage: "when a GTD order expires e.g. " + time.Now().Add(time.Hour).Format(timeFormat),
+		},
 	},
 }
 
@@ -1298,6 +1407,7 @@ func submitOrder(c *cli.Context) error {
 	var amount float64
 	var price float64
 	var clientID string
+	var strategyID string
 
 	if c.IsSet("exchange") {
 		exchangeName = c.String("exchange")
@@ -1370,6 +1480,21 @@ func submitOrder(c *cli.Context) error {
 		clientID = c.Args().Get(6)
 	}
 
+	if c.IsSet("strategy_id") {
+		strategyID = c.String("strategy_id")
+	} else {
+		strategyID = c.Args().Get(7)
+	}
+
+	var expiresAt string
+	if c.IsSet("expires") {
+		e, err := time.ParseInLocation(timeFormat, c.String("expires"), time.Local)
+		if err != nil {
+			return fmt.Errorf("invalid time format for expires: %v", err)
+		}
+		expiresAt = e.UTC().Format(timeFormat)
+	}
+
 	conn, err := setupClient()
 	if err != nil {
 		return err
@@ -1385,11 +1510,16 @@ func submitOrder(c *cli.Context) error {
 			Base:      p.Base.String(),
 			Quote:     p.Quote.String(),
 		},
-		Side:      orderSide,
-		OrderType: orderType,
-		Amount:    amount,
-		Price:     price,
-		ClientId:  clientID,
+		Side:               orderSide,
+		OrderType:          orderType,
+		Amount:             amount,
+		Price:              price,
+		ClientId:           clientID,
+		StrategyId:         strategyID,
+		PartialFillPolicy:  c.String("partial_fill_policy"),
+		PartialFillTimeout: c.String("partial_fill_timeout"),
+		TimeInForce:        c.String("time_in_force"),
+		ExpiresAt:          expiresAt,
 	})
 	if err != nil {
 		return err
@@ -2306,6 +2436,72 @@ func setLoggerDetails(c *cli.Context) error {
 	return nil
 }
 
+var setLogLevelCommand = cli.Command{
+	Name:      "setloglevel",
+	Usage:     "sets the log level of a subsystem or of an individual exchange",
+	ArgsUsage: "<level>",
+	Action:    setLogLevel,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "subsystem",
+			Usage: "subsystem logger to set the level of e.g REQUESTER",
+		},
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "exchange to set an independent level for, an empty level removes it",
+		},
+		cli.StringFlag{
+			Name:  "level",
+			Usage: "pipe separated value of levels e.g INFO|WARN|DEBUG",
+		},
+	},
+}
+
+func setLogLevel(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "setloglevel")
+		return nil
+	}
+
+	subsystem := c.String("subsystem")
+	exchangeName := c.String("exchange")
+	if subsystem == "" && exchangeName == "" {
+		return errors.New("a subsystem or exchange must be specified")
+	}
+
+	var level string
+	if c.IsSet("level") {
+		level = c.String("level")
+	} else {
+		level = c.Args().First()
+	}
+
+	if subsystem != "" && level == "" {
+		return errors.New("level must be specified")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	result, err := client.SetLogLevel(context.Background(),
+		&gctrpc.SetLogLevelRequest{
+			Subsystem: subsystem,
+			Exchange:  exchangeName,
+			Level:     level,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
 var getExchangePairsCommand = cli.Command{
 	Name:      "getexchangepairs",
 	Usage:     "gets an exchanges supported currency pairs (available and enabled) plus asset types",
@@ -3598,3 +3794,1933 @@ func getHistoricCandles(c *cli.Context) error {
 	jsonOutput(result)
 	return nil
 }
+
+var getExchangeHealthCommand = cli.Command{
+	Name:      "getexchangehealth",
+	Usage:     "gets REST latency percentiles and error and ban counts per exchange endpoint",
+	ArgsUsage: "<exchange>",
+	Action:    getExchangeHealth,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the health of, all exchanges if not set",
+		},
+	},
+}
+
+func getExchangeHealth(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeHealth(context.Background(),
+		&gctrpc.GetExchangeHealthRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var getWebsocketSubscriptionsCommand = cli.Command{
+	Name:      "getwebsocketsubscriptions",
+	Usage:     "gets the channels an exchange websocket is subscribed to",
+	ArgsUsage: "<exchange>",
+	Action:    getWebsocketSubscriptions,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the websocket subscriptions of",
+		},
+	},
+}
+
+func getWebsocketSubscriptions(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getwebsocketsubscriptions")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetWebsocketSubscriptions(context.Background(),
+		&gctrpc.GenericExchangeNameRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var websocketSubscriptionFlags = []cli.Flag{
+	cli.StringFlag{
+		Name:  "exchange",
+		Usage: "the exchange whose websocket to change",
+	},
+	cli.StringFlag{
+		Name:  "channel",
+		Usage: "the websocket channel",
+	},
+	cli.StringFlag{
+		Name:  "pair",
+		Usage: "the currency pair of the channel, if it has one",
+	},
+	cli.StringFlag{
+		Name:  "asset",
+		Usage: "the asset type of the currency pair",
+	},
+}
+
+var addWebsocketSubscriptionCommand = cli.Command{
+	Name:      "addwebsocketsubscription",
+	Usage:     "subscribes an exchange websocket to a channel without reconnecting",
+	ArgsUsage: "<exchange> <channel> <pair> <asset>",
+	Action:    addWebsocketSubscription,
+	Flags:     websocketSubscriptionFlags,
+}
+
+func addWebsocketSubscription(c *cli.Context) error {
+	return changeWebsocketSubscription(c, "addwebsocketsubscription", true)
+}
+
+var removeWebsocketSubscriptionCommand = cli.Command{
+	Name:      "removewebsocketsubscription",
+	Usage:     "unsubscribes an exchange websocket from a channel without reconnecting",
+	ArgsUsage: "<exchange> <channel> <pair> <asset>",
+	Action:    removeWebsocketSubscription,
+	Flags:     websocketSubscriptionFlags,
+}
+
+func removeWebsocketSubscription(c *cli.Context) error {
+	return changeWebsocketSubscription(c, "removewebsocketsubscription", false)
+}
+
+func changeWebsocketSubscription(c *cli.Context, command string, subscribe bool) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, command)
+		return nil
+	}
+
+	var exchange string
+	var channel string
+	var pair string
+	var asset string
+
+	if c.IsSet("exchange") {
+		exchange = c.String("exchange")
+	} else {
+		exchange = c.Args().First()
+	}
+
+	if !validExchange(exchange) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("channel") {
+		channel = c.String("channel")
+	} else {
+		channel = c.Args().Get(1)
+	}
+
+	if channel == "" {
+		return errors.New("channel must be set")
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(2)
+	}
+
+	if pair != "" && !validPair(pair) {
+		return errInvalidPair
+	}
+
+	if c.IsSet("asset") {
+		asset = c.String("asset")
+	} else {
+		asset = c.Args().Get(3)
+	}
+
+	asset = strings.ToLower(asset)
+	if asset != "" && !validAsset(asset) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	sub := &gctrpc.WebsocketSubscription{Channel: channel}
+	if pair != "" {
+		p := currency.NewPairDelimiter(pair, pairDelimiter)
+		sub.Pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+	req := &gctrpc.WebsocketSubscriptionsRequest{
+		Exchange:      exchange,
+		AssetType:     asset,
+		Subscriptions: []*gctrpc.WebsocketSubscription{sub},
+	}
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	var result *gctrpc.GetWebsocketSubscriptionsResponse
+	if subscribe {
+		result, err = client.AddWebsocketSubscriptions(context.Background(), req)
+	} else {
+		result, err = client.RemoveWebsocketSubscriptions(context.Background(), req)
+	}
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var getKlineStreamCommand = cli.Command{
+	Name:      "getklinestream",
+	Usage:     "gets the websocket kline stream for an exchange, optionally for a specific currency pair",
+	ArgsUsage: "<exchange> <pair> <asset> <interval>",
+	Action:    getKlineStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the klines from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "currency pair, streams all exchange klines if unset",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "interval",
+			Usage: "the kline interval e.g. 1m, 1h, 1d",
+		},
+	},
+}
+
+func getKlineStream(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getklinestream")
+		return nil
+	}
+
+	var exchangeName string
+	var pair string
+	var assetType string
+	var interval string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	req := &gctrpc.GetKlineStreamRequest{Exchange: exchangeName}
+	if pair != "" {
+		if !validPair(pair) {
+			return errInvalidPair
+		}
+
+		if c.IsSet("asset") {
+			assetType = c.String("asset")
+		} else {
+			assetType = c.Args().Get(2)
+		}
+
+		assetType = strings.ToLower(assetType)
+
+		if !validAsset(assetType) {
+			return errInvalidAsset
+		}
+
+		if c.IsSet("interval") {
+			interval = c.String("interval")
+		} else {
+			interval = c.Args().Get(3)
+		}
+
+		p := currency.NewPairDelimiter(pair, pairDelimiter)
+		req.Pair = &gctrpc.CurrencyPair{
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+			Delimiter: p.Delimiter,
+		}
+		req.AssetType = assetType
+		req.Interval = interval
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetKlineStream(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+var getFundingPaymentsCommand = cli.Command{
+	Name:      "getfundingpayments",
+	Usage:     "gets funding paid or received on a perpetual futures position and stores it in the database",
+	ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+	Action:    getFundingPayments,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the funding payments from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair of the position",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().AddDate(0, 0, -7).Format(timeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end date to search",
+			Value:       time.Now().Format(timeFormat),
+			Destination: &endTime,
+		},
+	},
+}
+
+func getFundingPayments(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getfundingpayments")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	s, err := time.ParseInLocation(timeFormat, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.ParseInLocation(timeFormat, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if !s.Before(e) {
+		return errors.New("start must be before end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(pair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetFundingPayments(context.Background(),
+		&gctrpc.GetFundingPaymentsRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType: assetType,
+			StartDate: s.UTC().Format(timeFormat),
+			EndDate:   e.UTC().Format(timeFormat),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var technicalAnalysisCommand = cli.Command{
+	Name:      "ta",
+	Usage:     "calculates a technical analysis indicator over an exchange's candles",
+	ArgsUsage: "<exchange> <pair> <interval> <indicator>",
+	Action:    technicalAnalysis,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the candles from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair to get the candles for",
+		},
+		cli.StringFlag{
+			Name:  "interval",
+			Usage: "the candle interval e.g. 1m, 1h, 1d",
+		},
+		cli.StringFlag{
+			Name:  "indicator",
+			Usage: "the indicator to calculate, one of sma, ema, rsi, macd, bbands or atr",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+			Value: "spot",
+		},
+		cli.Int64Flag{
+			Name:  "period",
+			Usage: "the indicator period, ignored by macd",
+			Value: 14,
+		},
+		cli.Int64Flag{
+			Name:  "count",
+			Usage: "the number of candles to calculate the indicator over",
+			Value: 100,
+		},
+	},
+}
+
+func technicalAnalysis(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "ta")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	var interval string
+	if c.IsSet("interval") {
+		interval = c.String("interval")
+	} else {
+		interval = c.Args().Get(2)
+	}
+
+	if interval == "" {
+		return errors.New("interval must be set")
+	}
+
+	var indicator string
+	if c.IsSet("indicator") {
+		indicator = c.String("indicator")
+	} else {
+		indicator = c.Args().Get(3)
+	}
+
+	if indicator == "" {
+		return errors.New("indicator must be set")
+	}
+
+	assetType := strings.ToLower(c.String("asset"))
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(pair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTechnicalAnalysis(context.Background(),
+		&gctrpc.GetTechnicalAnalysisRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType: assetType,
+			Interval:  interval,
+			Indicator: indicator,
+			Period:    c.Int64("period"),
+			Count:     c.Int64("count"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getAccountStatementCommand = cli.Command{
+	Name:      "getaccountstatement",
+	Usage:     "gets an exchange account statement of deposits, withdrawals, trades, fees and funding reconciled against the exchange balances",
+	ArgsUsage: "<exchange> <start> <end>",
+	Action:    getAccountStatement,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the statement for",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date of the statement",
+			Value:       time.Now().AddDate(0, -1, 0).Format(timeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end date of the statement",
+			Value:       time.Now().Format(timeFormat),
+			Destination: &endTime,
+		},
+		cli.StringFlag{
+			Name:  "opening",
+			Usage: "comma separated opening balances e.g. BTC:1.5,USD:100",
+		},
+		cli.Float64Flag{
+			Name:  "tolerance",
+			Usage: "the largest difference from the exchange balance which still reconciles",
+			Value: 0.00000001,
+		},
+		cli.StringFlag{
+			Name:  "fiat",
+			Usage: "comma separated fiat currencies to value the balances and PnL in e.g. USD,AUD, defaults to the configured fiat display currencies",
+		},
+		cli.StringFlag{
+			Name:  "csv",
+			Usage: "writes the statement as CSV to the given file instead of outputting JSON",
+		},
+	},
+}
+
+func getAccountStatement(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getaccountstatement")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(1) != "" {
+			startTime = c.Args().Get(1)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(2) != "" {
+			endTime = c.Args().Get(2)
+		}
+	}
+
+	s, err := time.ParseInLocation(timeFormat, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.ParseInLocation(timeFormat, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if !s.Before(e) {
+		return errors.New("start must be before end")
+	}
+
+	var opening []*gctrpc.StatementOpeningBalance
+	if c.String("opening") != "" {
+		for _, balance := range strings.Split(c.String("opening"), ",") {
+			split := strings.Split(balance, ":")
+			if len(split) != 2 {
+				return fmt.Errorf("invalid opening balance %s", balance)
+			}
+			amount, err := strconv.ParseFloat(split[1], 64)
+			if err != nil {
+				return fmt.Errorf("invalid opening balance %s: %v", balance, err)
+			}
+			opening = append(opening, &gctrpc.StatementOpeningBalance{
+				Currency: strings.TrimSpace(split[0]),
+				Amount:   amount,
+			})
+		}
+	}
+
+	var fiatCurrencies []string
+	if c.String("fiat") != "" {
+		fiatCurrencies = strings.Split(c.String("fiat"), ",")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetAccountStatement(context.Background(),
+		&gctrpc.GetAccountStatementRequest{
+			Exchange:        exchangeName,
+			StartDate:       s.UTC().Format(timeFormat),
+			EndDate:         e.UTC().Format(timeFormat),
+			OpeningBalances: opening,
+			Tolerance:       c.Float64("tolerance"),
+			FiatCurrencies:  fiatCurrencies,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	if c.String("csv") == "" {
+		jsonOutput(result)
+		return nil
+	}
+
+	f, err := os.Create(c.String("csv"))
+	if err != nil {
+		return err
+	}
+	defer f.Close()
+	st, err := statementFromResponse(result)
+	if err != nil {
+		return err
+	}
+	return st.WriteCSV(f)
+}
+
+// statementFromResponse converts an account statement response back into a
+// statement so it can be written as CSV
+func statementFromResponse(r *gctrpc.GetAccountStatementResponse) (*statement.Statement, error) {
+	start, err := time.Parse(timeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(timeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	s := &statement.Statement{
+		Exchange: r.Exchange,
+		Start:    start,
+		End:      end,
+	}
+	for i := range r.Entries {
+		ts, err := time.Parse(timeFormat, r.Entries[i].Timestamp)
+		if err != nil {
+			return nil, err
+		}
+		s.Entries = append(s.Entries, statement.Entry{
+			Timestamp:   ts,
+			Type:        statement.EntryType(r.Entries[i].Type),
+			Currency:    currency.NewCode(r.Entries[i].Currency).Upper(),
+			Amount:      decimal.NewFromFloat(r.Entries[i].Amount),
+			Balance:     decimal.NewFromFloat(r.Entries[i].Balance),
+			Reference:   r.Entries[i].Reference,
+			Description: r.Entries[i].Description,
+		})
+	}
+	for i := range r.Balances {
+		s.Balances = append(s.Balances, statement.Balance{
+			Currency:    currency.NewCode(r.Balances[i].Currency).Upper(),
+			Opening:     decimal.NewFromFloat(r.Balances[i].Opening),
+			Deposits:    decimal.NewFromFloat(r.Balances[i].Deposits),
+			Withdrawals: decimal.NewFromFloat(r.Balances[i].Withdrawals),
+			Trades:      decimal.NewFromFloat(r.Balances[i].Trades),
+			Fees:        decimal.NewFromFloat(r.Balances[i].Fees),
+			Funding:     decimal.NewFromFloat(r.Balances[i].Funding),
+			Closing:     decimal.NewFromFloat(r.Balances[i].Closing),
+			Reported:    decimal.NewFromFloat(r.Balances[i].Reported),
+			Discrepancy: decimal.NewFromFloat(r.Balances[i].Discrepancy),
+			Reconciled:  r.Balances[i].Reconciled,
+		})
+	}
+	return s, nil
+}
+
+var validateCredentialsCommand = cli.Command{
+	Name:      "validatecredentials",
+	Usage:     "validates an exchange's credentials and verifies the permissions of its API key",
+	ArgsUsage: "<exchange>",
+	Action:    validateCredentials,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to validate the credentials of",
+		},
+	},
+}
+
+func validateCredentials(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "validatecredentials")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ValidateCredentials(context.Background(),
+		&gctrpc.GenericExchangeNameRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getExchangeLatencyCommand = cli.Command{
+	Name:      "getexchangelatency",
+	Usage:     "gets the round trip latency measured to an exchange, or to all exchanges ranked fastest first",
+	ArgsUsage: "<exchange>",
+	Action:    getExchangeLatency,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the latency of, all exchanges if unset",
+		},
+	},
+}
+
+func getExchangeLatency(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExchangeLatency(context.Background(),
+		&gctrpc.GetExchangeLatencyRequest{
+			Exchange: exchangeName,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var submitTransferCommand = cli.Command{
+	Name:      "submittransfer",
+	Usage:     "transfers cryptocurrency between exchanges, following it until it is credited",
+	ArgsUsage: "<from> <to> <currency> <amount>",
+	Action:    submitTransfer,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "from",
+			Usage: "the exchange or hot wallet to withdraw from",
+		},
+		cli.StringFlag{
+			Name:  "to",
+			Usage: "the exchange to deposit to",
+		},
+		cli.StringFlag{
+			Name:  "currency",
+			Usage: "the cryptocurrency to transfer",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the amount to withdraw",
+		},
+		cli.StringFlag{
+			Name:  "network",
+			Usage: "the network to withdraw over, requires an address on that network",
+		},
+		cli.StringFlag{
+			Name:  "address",
+			Usage: "the address to send to, defaults to the destination exchange's deposit address",
+		},
+		cli.StringFlag{
+			Name:  "addresstag",
+			Usage: "the address tag or memo, if required",
+		},
+	},
+}
+
+func submitTransfer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "submittransfer")
+		return nil
+	}
+
+	var from string
+	if c.IsSet("from") {
+		from = c.String("from")
+	} else {
+		from = c.Args().First()
+	}
+
+	if !validExchange(from) {
+		return errInvalidExchange
+	}
+
+	var to string
+	if c.IsSet("to") {
+		to = c.String("to")
+	} else {
+		to = c.Args().Get(1)
+	}
+
+	if !validExchange(to) {
+		return errInvalidExchange
+	}
+
+	var cryptocurrency string
+	if c.IsSet("currency") {
+		cryptocurrency = c.String("currency")
+	} else {
+		cryptocurrency = c.Args().Get(2)
+	}
+
+	if cryptocurrency == "" {
+		return errors.New("currency must be set")
+	}
+
+	var amount float64
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if amount <= 0 {
+		return errors.New("amount must be greater than 0")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.SubmitTransfer(context.Background(),
+		&gctrpc.SubmitTransferRequest{
+			FromExchange: from,
+			ToExchange:   to,
+			Currency:     cryptocurrency,
+			Amount:       amount,
+			Network:      c.String("network"),
+			Address:      c.String("address"),
+			AddressTag:   c.String("addresstag"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getTransfersCommand = cli.Command{
+	Name:      "gettransfers",
+	Usage:     "gets a transfer between exchanges, or all transfers since startup",
+	ArgsUsage: "<id>",
+	Action:    getTransfers,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the transfer to get, all transfers if unset",
+		},
+	},
+}
+
+func getTransfers(c *cli.Context) error {
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTransfers(context.Background(),
+		&gctrpc.GetTransfersRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var approveTransferCommand = cli.Command{
+	Name:      "approvetransfer",
+	Usage:     "approves a transfer pending approval so it is sent",
+	ArgsUsage: "<id>",
+	Action:    approveTransfer,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "id",
+			Usage: "the transfer to approve",
+		},
+	},
+}
+
+func approveTransfer(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "approvetransfer")
+		return nil
+	}
+
+	var id string
+	if c.IsSet("id") {
+		id = c.String("id")
+	} else {
+		id = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ApproveTransfer(context.Background(),
+		&gctrpc.ApproveTransferRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var addPriceAlertCommand = cli.Command{
+	Name:      "addpricealert",
+	Usage:     "adds a price alert on a pair at an exchange, or on the composite price across exchanges",
+	ArgsUsage: "<pair> <condition> <threshold>",
+	Action:    addPriceAlert,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to alert on, the composite price across exchanges if unset",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type",
+			Value: "spot",
+		},
+		cli.StringFlag{
+			Name:  "condition",
+			Usage: "ABOVE or BELOW the threshold price, or CHANGE by the threshold percent",
+		},
+		cli.Float64Flag{
+			Name:  "threshold",
+			Usage: "the price for ABOVE and BELOW alerts, or the percent for CHANGE alerts",
+		},
+		cli.Float64Flag{
+			Name:  "reference",
+			Usage: "the price CHANGE alerts are measured from, defaults to the current price",
+		},
+		cli.StringFlag{
+			Name:  "note",
+			Usage: "a note sent with the alert",
+		},
+	},
+}
+
+func addPriceAlert(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "addpricealert")
+		return nil
+	}
+
+	exchangeName := c.String("exchange")
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var currencyPair string
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().First()
+	}
+
+	if !validPair(currencyPair) {
+		return errInvalidPair
+	}
+
+	assetType := strings.ToLower(c.String("asset"))
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	var condition string
+	if c.IsSet("condition") {
+		condition = c.String("condition")
+	} else {
+		condition = c.Args().Get(1)
+	}
+
+	if condition == "" {
+		return errors.New("condition must be set")
+	}
+
+	var threshold float64
+	if c.IsSet("threshold") {
+		threshold = c.Float64("threshold")
+	} else if c.Args().Get(2) != "" {
+		var err error
+		threshold, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if threshold <= 0 {
+		return errors.New("threshold must be greater than 0")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.AddPriceAlert(context.Background(),
+		&gctrpc.AddPriceAlertRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: p.Delimiter,
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+			},
+			AssetType:      assetType,
+			Condition:      condition,
+			Threshold:      threshold,
+			ReferencePrice: c.Float64("reference"),
+			Note:           c.String("note"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getPriceAlertsCommand = cli.Command{
+	Name:   "getpricealerts",
+	Usage:  "gets the stored price alerts",
+	Action: getPriceAlerts,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "active",
+			Usage: "only get alerts yet to trigger",
+		},
+	},
+}
+
+func getPriceAlerts(c *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetPriceAlerts(context.Background(),
+		&gctrpc.GetPriceAlertsRequest{
+			ActiveOnly: c.Bool("active"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var removePriceAlertCommand = cli.Command{
+	Name:      "removepricealert",
+	Usage:     "removes a price alert",
+	ArgsUsage: "<id>",
+	Action:    removePriceAlert,
+	Flags: []cli.Flag{
+		cli.Int64Flag{
+			Name:  "id",
+			Usage: "the price alert to remove",
+		},
+	},
+}
+
+func removePriceAlert(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "removepricealert")
+		return nil
+	}
+
+	var id int64
+	if c.IsSet("id") {
+		id = c.Int64("id")
+	} else {
+		var err error
+		id, err = strconv.ParseInt(c.Args().First(), 10, 64)
+		if err != nil {
+			return fmt.Errorf("unable to parse id: %v", err)
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.RemovePriceAlert(context.Background(),
+		&gctrpc.RemovePriceAlertRequest{
+			Id: id,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getTrailingStopCommand = cli.Command{
+	Name:   "gettrailingstop",
+	Usage:  "gets the portfolio value against its high-water mark",
+	Action: getTrailingStop,
+}
+
+func getTrailingStop(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetTrailingStop(context.Background(),
+		&gctrpc.GetTrailingStopRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var resetTrailingStopCommand = cli.Command{
+	Name:   "resettrailingstop",
+	Usage:  "rearms a triggered portfolio trailing stop from the current value and resumes halted orders",
+	Action: resetTrailingStop,
+}
+
+func resetTrailingStop(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ResetTrailingStop(context.Background(),
+		&gctrpc.ResetTrailingStopRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getMarginPositionsCommand = cli.Command{
+	Name:   "getmarginpositions",
+	Usage:  "gets the open positions and their margin ratios",
+	Action: getMarginPositions,
+}
+
+func getMarginPositions(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetMarginPositions(context.Background(),
+		&gctrpc.GetMarginPositionsRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getArbitrageOpportunitiesCommand = cli.Command{
+	Name:   "getarbitrageopportunities",
+	Usage:  "gets the arbitrage opportunities in the latest orderbooks, net of fees and transfers",
+	Action: getArbitrageOpportunities,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchanges",
+			Usage: "comma separated exchanges to scan, all enabled exchanges if unset",
+		},
+		cli.Float64Flag{
+			Name:  "min_profit",
+			Usage: "the minimum net profit percent",
+		},
+		cli.StringFlag{
+			Name:  "type",
+			Usage: "only get cross_exchange or triangular opportunities",
+		},
+	},
+}
+
+func getArbitrageOpportunities(c *cli.Context) error {
+	var exchanges []string
+	if c.String("exchanges") != "" {
+		exchanges = strings.Split(c.String("exchanges"), ",")
+	}
+	for i := range exchanges {
+		if !validExchange(exchanges[i]) {
+			return errInvalidExchange
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetArbitrageOpportunities(context.Background(),
+		&gctrpc.GetArbitrageOpportunitiesRequest{
+			Exchanges:        exchanges,
+			MinProfitPercent: c.Float64("min_profit"),
+			Type:             c.String("type"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getLiquidationStreamCommand = cli.Command{
+	Name:      "getliquidationstream",
+	Usage:     "gets the websocket liquidation stream of every exchange, or of an exchange optionally for a specific currency pair",
+	ArgsUsage: "<exchange> <pair> <asset>",
+	Action:    getLiquidationStream,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the liquidations from, streams all exchange liquidations if unset",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "currency pair, streams all liquidations of the exchange if unset",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+	},
+}
+
+func getLiquidationStream(c *cli.Context) error {
+	var exchangeName string
+	var pair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	req := &gctrpc.GetLiquidationStreamRequest{}
+	if exchangeName != "" {
+		if !validExchange(exchangeName) {
+			return errInvalidExchange
+		}
+		req.Exchange = exchangeName
+
+		if c.IsSet("pair") {
+			pair = c.String("pair")
+		} else {
+			pair = c.Args().Get(1)
+		}
+	}
+
+	if pair != "" {
+		if !validPair(pair) {
+			return errInvalidPair
+		}
+
+		if c.IsSet("asset") {
+			assetType = c.String("asset")
+		} else {
+			assetType = c.Args().Get(2)
+		}
+
+		assetType = strings.ToLower(assetType)
+
+		if !validAsset(assetType) {
+			return errInvalidAsset
+		}
+
+		p := currency.NewPairDelimiter(pair, pairDelimiter)
+		req.Pair = &gctrpc.CurrencyPair{
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+			Delimiter: p.Delimiter,
+		}
+		req.AssetType = assetType
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetLiquidationStream(context.Background(), req)
+	if err != nil {
+		return err
+	}
+
+	for {
+		resp, err := result.Recv()
+		if err != nil {
+			return err
+		}
+		jsonOutput(resp)
+	}
+}
+
+var getOpenInterestCommand = cli.Command{
+	Name:      "getopeninterest",
+	Usage:     "gets the open interest and long/short ratio recorded for a derivatives contract",
+	ArgsUsage: "<exchange> <pair> <asset> <start> <end>",
+	Action:    getOpenInterest,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the open interest from",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the currency pair of the contract",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the asset type of the currency pair",
+		},
+		cli.StringFlag{
+			Name:        "start, s",
+			Usage:       "start date to search",
+			Value:       time.Now().AddDate(0, 0, -1).Format(timeFormat),
+			Destination: &startTime,
+		},
+		cli.StringFlag{
+			Name:        "end, e",
+			Usage:       "end date to search",
+			Value:       time.Now().Format(timeFormat),
+			Destination: &endTime,
+		},
+	},
+}
+
+func getOpenInterest(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "getopeninterest")
+		return nil
+	}
+
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	var pair string
+	if c.IsSet("pair") {
+		pair = c.String("pair")
+	} else {
+		pair = c.Args().Get(1)
+	}
+
+	if !validPair(pair) {
+		return errInvalidPair
+	}
+
+	var assetType string
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(2)
+	}
+
+	assetType = strings.ToLower(assetType)
+
+	if !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	if !c.IsSet("start") {
+		if c.Args().Get(3) != "" {
+			startTime = c.Args().Get(3)
+		}
+	}
+
+	if !c.IsSet("end") {
+		if c.Args().Get(4) != "" {
+			endTime = c.Args().Get(4)
+		}
+	}
+
+	s, err := time.ParseInLocation(timeFormat, startTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for start: %v", err)
+	}
+
+	e, err := time.ParseInLocation(timeFormat, endTime, time.Local)
+	if err != nil {
+		return fmt.Errorf("invalid time format for end: %v", err)
+	}
+
+	if !s.Before(e) {
+		return errors.New("start must be before end")
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	p := currency.NewPairDelimiter(pair, pairDelimiter)
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetOpenInterest(context.Background(),
+		&gctrpc.GetOpenInterestRequest{
+			Exchange: exchangeName,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      p.Base.String(),
+				Quote:     p.Quote.String(),
+				Delimiter: p.Delimiter,
+			},
+			AssetType: assetType,
+			StartDate: s.UTC().Format(timeFormat),
+			EndDate:   e.UTC().Format(timeFormat),
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getStrategyLedgersCommand = cli.Command{
+	Name:      "getstrategyledgers",
+	Usage:     "gets the virtual balances the order manager holds for each strategy",
+	ArgsUsage: "<strategy_id>",
+	Action:    getStrategyLedgers,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "strategy_id",
+			Usage: "the optional strategy to get the balances of, otherwise all strategies",
+		},
+	},
+}
+
+func getStrategyLedgers(c *cli.Context) error {
+	var strategyID string
+	if c.IsSet("strategy_id") {
+		strategyID = c.String("strategy_id")
+	} else {
+		strategyID = c.Args().First()
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetStrategyLedgers(context.Background(),
+		&gctrpc.GetStrategyLedgersRequest{
+			StrategyId: strategyID,
+		},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getExposuresCommand = cli.Command{
+	Name:   "getexposures",
+	Usage:  "gets the net exposure of each currency across spot holdings and derivatives positions",
+	Action: getExposures,
+}
+
+func getExposures(_ *cli.Context) error {
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetExposures(context.Background(),
+		&gctrpc.GetExposuresRequest{},
+	)
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var modifyOrderCommand = cli.Command{
+	Name:      "modifyorder",
+	Usage:     "amends the price or amount of an open order, cancelling and replacing it on exchanges without amendment support",
+	ArgsUsage: "<exchange> <order_id> <price> <amount> <pair> <asset>",
+	Action:    modifyOrder,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange the order is on",
+		},
+		cli.StringFlag{
+			Name:  "order_id",
+			Usage: "the order ID",
+		},
+		cli.Float64Flag{
+			Name:  "price",
+			Usage: "the new price, or 0 to keep the price",
+		},
+		cli.Float64Flag{
+			Name:  "amount",
+			Usage: "the new total amount including any filled, or 0 to keep the amount",
+		},
+		cli.StringFlag{
+			Name:  "pair",
+			Usage: "the optional currency pair",
+		},
+		cli.StringFlag{
+			Name:  "asset",
+			Usage: "the optional asset type",
+		},
+	},
+}
+
+func modifyOrder(c *cli.Context) error {
+	if c.NArg() == 0 && c.NumFlags() == 0 {
+		cli.ShowCommandHelp(c, "modifyorder")
+		return nil
+	}
+
+	var exchangeName string
+	var orderID string
+	var price float64
+	var amount float64
+	var currencyPair string
+	var assetType string
+
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	if c.IsSet("order_id") {
+		orderID = c.String("order_id")
+	} else {
+		orderID = c.Args().Get(1)
+	}
+
+	if orderID == "" {
+		return errors.New("an order ID must be set")
+	}
+
+	if c.IsSet("price") {
+		price = c.Float64("price")
+	} else if c.Args().Get(2) != "" {
+		var err error
+		price, err = strconv.ParseFloat(c.Args().Get(2), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if c.IsSet("amount") {
+		amount = c.Float64("amount")
+	} else if c.Args().Get(3) != "" {
+		var err error
+		amount, err = strconv.ParseFloat(c.Args().Get(3), 64)
+		if err != nil {
+			return err
+		}
+	}
+
+	if price <= 0 && amount <= 0 {
+		return errors.New("a new price or amount must be set")
+	}
+
+	if c.IsSet("pair") {
+		currencyPair = c.String("pair")
+	} else {
+		currencyPair = c.Args().Get(4)
+	}
+
+	if c.IsSet("asset") {
+		assetType = c.String("asset")
+	} else {
+		assetType = c.Args().Get(5)
+	}
+
+	assetType = strings.ToLower(assetType)
+	if assetType != "" && !validAsset(assetType) {
+		return errInvalidAsset
+	}
+
+	// pair is optional, but if it's set, do a validity check
+	var pair *gctrpc.CurrencyPair
+	if len(currencyPair) > 0 {
+		if !validPair(currencyPair) {
+			return errInvalidPair
+		}
+		p := currency.NewPairDelimiter(currencyPair, pairDelimiter)
+		pair = &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		}
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.ModifyOrder(context.Background(), &gctrpc.ModifyOrderRequest{
+		Exchange:  exchangeName,
+		OrderId:   orderID,
+		Pair:      pair,
+		AssetType: assetType,
+		Price:     price,
+		Amount:    amount,
+	})
+	if err != nil {
+		return err
+	}
+
+	jsonOutput(result)
+	return nil
+}
+
+var getDataQualityCommand = cli.Command{
+	Name:      "getdataquality",
+	Usage:     "gets the latest integrity check of each stored candle series with the gaps which couldn't be backfilled",
+	ArgsUsage: "<exchange>",
+	Action:    getDataQuality,
+	Flags: []cli.Flag{
+		cli.StringFlag{
+			Name:  "exchange",
+			Usage: "the exchange to get the candle series of, all if blank",
+		},
+		cli.BoolFlag{
+			Name:  "check",
+			Usage: "checks and backfills every series before returning them",
+		},
+	},
+}
+
+func getDataQuality(c *cli.Context) error {
+	var exchangeName string
+	if c.IsSet("exchange") {
+		exchangeName = c.String("exchange")
+	} else {
+		exchangeName = c.Args().First()
+	}
+
+	if exchangeName != "" && !validExchange(exchangeName) {
+		return errInvalidExchange
+	}
+
+	conn, err := setupClient()
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+	result, err := client.GetDataQuality(context.Background(),
+		&gctrpc.GetDataQualityRequest{
+			Exchange: exchangeName,
+			Check:    c.Bool("check"),
+		},
+	)
+	if err != nil {
+		return err
+	}
+	jsonOutput(result)
+	return nil
+}
+
+var updateCommand = cli.Command{
+	Name:   "update",
+	Usage:  "updates gctcli to the latest release, verifying its signed checksums, or rolls back the last update",
+	Action: updateCLI,
+	Flags: []cli.Flag{
+		cli.BoolFlag{
+			Name:  "check",
+			Usage: "only reports whether a newer release is available",
+		},
+		cli.BoolFlag{
+			Name:  "force",
+			Usage: "installs the latest release even if it isn't newer",
+		},
+		cli.BoolFlag{
+			Name:  "rollback",
+			Usage: "restores the binary replaced by the last update",
+		},
+		cli.BoolFlag{
+			Name:  "skipsignature",
+			Usage: "updates with only the release checksums verified, for builds without a release public key",
+		},
+	},
+}
+
+func updateCLI(c *cli.Context) error {
+	u, err := update.New("gctcli")
+	if err != nil {
+		return err
+	}
+	return u.Run(context.Background(), update.Options{
+		Check:         c.Bool("check"),
+		Force:         c.Bool("force"),
+		Rollback:      c.Bool("rollback"),
+		SkipSignature: c.Bool("skipsignature"),
+	}, os.Stdout)
+}
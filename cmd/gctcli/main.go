@@ -90,6 +90,8 @@ func main() {
 		enableSubsystemCommand,
 		disableSubsystemCommand,
 		getRPCEndpointsCommand,
+		getRPCSessionsCommand,
+		revokeRPCSessionCommand,
 		getCommunicationRelayersCommand,
 		getExchangesCommand,
 		enableExchangeCommand,
@@ -126,6 +128,7 @@ func main() {
 		withdrawFiatFundsCommand,
 		getLoggerDetailsCommand,
 		setLoggerDetailsCommand,
+		setLogLevelCommand,
 		getExchangePairsCommand,
 		enableExchangePairCommand,
 		disableExchangePairCommand,
@@ -133,9 +136,36 @@ func main() {
 		getExchangeOrderbookStreamCommand,
 		getTickerStreamCommand,
 		getExchangeTickerStreamCommand,
+		getKlineStreamCommand,
+		getFundingPaymentsCommand,
+		technicalAnalysisCommand,
+		getAccountStatementCommand,
+		validateCredentialsCommand,
+		getExchangeLatencyCommand,
+		submitTransferCommand,
+		getTransfersCommand,
+		approveTransferCommand,
+		addPriceAlertCommand,
+		getPriceAlertsCommand,
+		removePriceAlertCommand,
+		getTrailingStopCommand,
+		resetTrailingStopCommand,
+		getMarginPositionsCommand,
+		getArbitrageOpportunitiesCommand,
+		getLiquidationStreamCommand,
+		getOpenInterestCommand,
+		getStrategyLedgersCommand,
+		getExposuresCommand,
+		modifyOrderCommand,
 		getAuditEventCommand,
 		getHistoricCandlesCommand,
+		getDataQualityCommand,
+		getExchangeHealthCommand,
+		getWebsocketSubscriptionsCommand,
+		addWebsocketSubscriptionCommand,
+		removeWebsocketSubscriptionCommand,
 		gctScriptCommand,
+		updateCommand,
 	}
 
 	err := app.Run(os.Args)
@@ -0,0 +1,100 @@
+package main
+
+import (
+	"flag"
+	"log"
+	"os"
+	"path/filepath"
+	"runtime"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/secrets"
+)
+
+// keystoreFile is the default keystore file name in the data directory
+const keystoreFile = "keystore.dat"
+
+func main() {
+	var configFile, keystorePath, keyFile string
+	flag.StringVar(&configFile, "config", config.DefaultFilePath(), "The config file to move exchange credentials out of.")
+	flag.StringVar(&keystorePath, "keystore", "", "The keystore file to move them to, defaults to the config's keystore or keystore.dat in the data directory.")
+	flag.StringVar(&keyFile, "keyfile", "", "A file containing the keystore key, otherwise it is read from the "+
+		secrets.KeystoreKeyEnvironmentVariable+" environment variable or prompted for.")
+	flag.Parse()
+
+	log.Println("GoCryptoTrader: keystore tool.")
+
+	cfg := config.GetConfig()
+	err := cfg.ReadConfig(configFile, false)
+	if err != nil {
+		log.Fatalf("Unable to read config file %s. Error: %s.", configFile, err)
+	}
+
+	backend := &cfg.SecretsBackend
+	if backend.Enabled && backend.Backend != secrets.BackendKeystore {
+		log.Fatalf("Config already loads secrets from the %s secrets backend.", backend.Backend)
+	}
+	if keystorePath == "" {
+		keystorePath = backend.Keystore.Path
+	}
+	if keystorePath == "" {
+		keystorePath = filepath.Join(common.GetDefaultDataDir(runtime.GOOS), keystoreFile)
+	}
+	if keyFile == "" {
+		keyFile = backend.Keystore.KeyFile
+	}
+	exists := file.Exists(keystorePath)
+
+	key, err := secrets.ReadKeystoreKey(keyFile)
+	if err != nil {
+		if keyFile != "" {
+			log.Fatalf("Unable to obtain keystore key: %s", err)
+		}
+		log.Println("Keystore key:")
+		key, err = config.PromptForConfigKey(!exists)
+		if err != nil {
+			log.Fatalf("Unable to obtain keystore key: %s", err)
+		}
+	}
+
+	stored := make(map[string]map[string]string)
+	if exists {
+		stored, err = secrets.ReadKeystore(keystorePath, key)
+		if err != nil {
+			log.Fatalf("Unable to read keystore %s. Error: %s.", keystorePath, err)
+		}
+	}
+
+	extracted := cfg.ExtractExchangeCredentials()
+	if len(extracted) == 0 {
+		log.Println("Config holds no exchange credentials.")
+		os.Exit(0)
+	}
+	for name, values := range extracted {
+		stored[name] = values
+	}
+
+	// The keystore is written first so the credentials are never lost if
+	// saving the config fails
+	err = secrets.WriteKeystore(keystorePath, key, stored)
+	if err != nil {
+		log.Fatalf("Unable to write keystore %s. Error: %s.", keystorePath, err)
+	}
+
+	backend.Enabled = true
+	backend.Backend = secrets.BackendKeystore
+	backend.Keystore.Path = keystorePath
+	backend.Keystore.KeyFile = keyFile
+	err = cfg.SaveConfig(configFile, false)
+	if err != nil {
+		log.Fatalf("Unable to save config file %s. Error: %s.", configFile, err)
+	}
+	log.Printf("Moved the credentials of %d exchanges from %s to keystore %s.\n",
+		len(extracted), configFile, keystorePath)
+	if keyFile == "" {
+		log.Printf("Set the %s environment variable to the keystore key when starting the bot, or set a key file in the config.\n",
+			secrets.KeystoreKeyEnvironmentVariable)
+	}
+}
@@ -1,6 +1,7 @@
 package main
 
 import (
+	"context"
 	"flag"
 	"fmt"
 	"log"
@@ -49,7 +50,7 @@ func printSummary(msg string, amount float64) {
 func getOnlineOfflinePortfolio(coins []portfolio.Coin, online bool) {
 	var totals float64
 	for _, x := range coins {
-		value := priceMap[x.Coin] * x.Balance
+		value := priceMap[x.Coin] * x.Balance.Float64()
 		totals += value
 		log.Printf("\t%v %v Subtotal: $%.2f Coin percentage: %.2f%%\n", x.Coin,
 			x.Balance, value, x.Percentage)
@@ -113,32 +114,32 @@ func main() {
 
 	for _, y := range result.Totals {
 		pf := PortfolioTemp{}
-		pf.Balance = y.Balance
+		pf.Balance = y.Balance.Float64()
 		pf.Subtotal = 0
 
 		if y.Coin.IsDefaultFiatCurrency() {
 			if y.Coin != currency.USD {
-				conv, err := currency.ConvertCurrency(y.Balance, y.Coin, currency.USD)
+				conv, err := currency.ConvertCurrency(pf.Balance, y.Coin, currency.USD)
 				if err != nil {
 					log.Println(err)
 				} else {
-					priceMap[y.Coin] = conv / y.Balance
+					priceMap[y.Coin] = conv / pf.Balance
 					pf.Subtotal = conv
 				}
 			} else {
-				pf.Subtotal = y.Balance
+				pf.Subtotal = pf.Balance
 			}
 		} else {
 			bf := bitfinex.Bitfinex{}
 			bf.SetDefaults()
 			bf.Verbose = false
 			pair := "t" + y.Coin.String() + currency.USD.String()
-			ticker, errf := bf.GetTicker(pair)
+			ticker, errf := bf.GetTicker(context.Background(), pair)
 			if errf != nil {
 				log.Println(errf)
 			} else {
 				priceMap[y.Coin] = ticker.Last
-				pf.Subtotal = ticker.Last * y.Balance
+				pf.Subtotal = ticker.Last * pf.Balance
 			}
 		}
 		portfolioMap[y.Coin] = pf
@@ -164,9 +165,9 @@ func main() {
 		log.Printf("\t%s:", x)
 		totals = 0
 		for z := range y {
-			value := priceMap[x] * y[z].Balance
+			value := priceMap[x] * y[z].Balance.Float64()
 			totals += value
-			log.Printf("\t %s Amount: %f Subtotal: $%.2f Coin percentage: %.2f%%\n",
+			log.Printf("\t %s Amount: %s Subtotal: $%.2f Coin percentage: %.2f%%\n",
 				y[z].Address, y[z].Balance, value, y[z].Percentage)
 		}
 		printSummary(fmt.Sprintf("\t %s balance", x), totals)
@@ -177,9 +178,9 @@ func main() {
 		log.Printf("\t%s:", x)
 		totals = 0
 		for z, w := range y {
-			value := priceMap[z] * w.Balance
+			value := priceMap[z] * w.Balance.Float64()
 			totals += value
-			log.Printf("\t %s Amount: %f Subtotal $%.2f Coin percentage: %.2f%%",
+			log.Printf("\t %s Amount: %s Subtotal $%.2f Coin percentage: %.2f%%",
 				z, w.Balance, value, w.Percentage)
 		}
 		printSummary("\t Exchange balance", totals)
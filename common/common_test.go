@@ -1,6 +1,7 @@
 package common
 
 import (
+	"io/ioutil"
 	"net/url"
 	"os"
 	"os/user"
@@ -315,13 +316,18 @@ func TestExtractPort(t *testing.T) {
 }
 
 func TestOutputCSV(t *testing.T) {
-	path := "../testdata/dump"
+	dir, err := ioutil.TempDir("", "common")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "dump")
 	var data [][]string
 	rowOne := []string{"Appended", "to", "two", "dimensional", "array"}
 	rowTwo := []string{"Appended", "to", "two", "dimensional", "array", "two"}
 	data = append(data, rowOne, rowTwo)
 
-	err := OutputCSV(path, data)
+	err = OutputCSV(path, data)
 	if err != nil {
 		t.Errorf("common OutputCSV error: %s", err)
 	}
@@ -1,12 +1,15 @@
 package convert
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"math"
 	"strconv"
 	"strings"
 	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 )
 
 // FloatFromString format
@@ -54,7 +57,7 @@ func TimeFromUnixTimestampFloat(raw interface{}) (time.Time, error) {
 	if !ok {
 		return time.Time{}, fmt.Errorf("unable to parse, value not float64: %T", raw)
 	}
-	return time.Unix(0, int64(ts)*int64(time.Millisecond)), nil
+	return TimeFromUnixFloat(ts, time.Millisecond), nil
 }
 
 // UnixTimestampToTime returns time.time
@@ -71,6 +74,121 @@ func UnixTimestampStrToTime(timeStr string) (time.Time, error) {
 	return time.Unix(i, 0), nil
 }
 
+// Upper bounds used to detect the unit of a unix timestamp, a timestamp in
+// seconds won't reach 1e11 until the year 5138 so each unit has its own range
+const (
+	maxUnixSeconds = 1e11
+	maxUnixMillis  = 1e14
+	maxUnixMicros  = 1e17
+)
+
+// timeLayouts are the ISO 8601 variants returned by exchanges, layouts
+// without a zone are parsed as UTC
+var timeLayouts = []string{
+	time.RFC3339Nano,
+	"2006-01-02T15:04:05.999999999Z0700",
+	"2006-01-02T15:04:05.999999999",
+	"2006-01-02 15:04:05.999999999Z07:00",
+	"2006-01-02 15:04:05.999999999",
+	"2006-01-02",
+}
+
+// DetectTimeUnit returns the unit of a unix timestamp judged by its
+// magnitude, either time.Second, time.Millisecond, time.Microsecond or
+// time.Nanosecond
+func DetectTimeUnit(ts int64) time.Duration {
+	if ts < 0 {
+		ts = -ts
+	}
+	switch {
+	case ts < maxUnixSeconds:
+		return time.Second
+	case ts < maxUnixMillis:
+		return time.Millisecond
+	case ts < maxUnixMicros:
+		return time.Microsecond
+	default:
+		return time.Nanosecond
+	}
+}
+
+// TimeFromUnix returns the time of a unix timestamp counted in unit, a unit
+// of 0 detects it with DetectTimeUnit. Exchanges document the unit of their
+// timestamps so it should be supplied wherever it is known.
+func TimeFromUnix(ts int64, unit time.Duration) time.Time {
+	if unit <= 0 {
+		unit = DetectTimeUnit(ts)
+	}
+	if unit >= time.Second {
+		return time.Unix(ts*int64(unit/time.Second), 0)
+	}
+	perSecond := int64(time.Second / unit)
+	return time.Unix(ts/perSecond, ts%perSecond*int64(unit))
+}
+
+// TimeFromUnixFloat returns the time of a fractional unix timestamp such as
+// 1577836800.123456 seconds, a unit of 0 detects it with DetectTimeUnit.
+// NaN and infinite timestamps return the zero time.
+func TimeFromUnixFloat(ts float64, unit time.Duration) time.Time {
+	if math.IsNaN(ts) || math.IsInf(ts, 0) {
+		return time.Time{}
+	}
+	return timeFromDecimal(decimal.NewFromFloat(ts), unit)
+}
+
+// timeFromDecimal splits ts into whole units and nanoseconds so string
+// timestamps keep their full precision
+func timeFromDecimal(ts decimal.Decimal, unit time.Duration) time.Time {
+	whole := ts.Truncate(0)
+	if unit <= 0 {
+		unit = DetectTimeUnit(whole.IntPart())
+	}
+	nanos := ts.Sub(whole).Mul(decimal.NewFromInt(int64(unit))).Round(0)
+	return TimeFromUnix(whole.IntPart(), unit).Add(time.Duration(nanos.IntPart()))
+}
+
+// ParseTime parses an exchange timestamp which may be a time.Time, an
+// integer or float64 unix timestamp, a string holding a unix timestamp such
+// as "1577836800.123" or an ISO 8601 string. Numeric timestamps are counted
+// in unit, a unit of 0 detects it with DetectTimeUnit.
+func ParseTime(raw interface{}, unit time.Duration) (time.Time, error) {
+	switch ts := raw.(type) {
+	case time.Time:
+		return ts, nil
+	case int64:
+		return TimeFromUnix(ts, unit), nil
+	case int:
+		return TimeFromUnix(int64(ts), unit), nil
+	case float64:
+		if math.IsNaN(ts) || math.IsInf(ts, 0) {
+			return time.Time{}, fmt.Errorf("unable to parse time, invalid timestamp: %v", ts)
+		}
+		return TimeFromUnixFloat(ts, unit), nil
+	case json.Number:
+		return parseTimeString(string(ts), unit)
+	case string:
+		return parseTimeString(ts, unit)
+	default:
+		return time.Time{}, fmt.Errorf("unable to parse time, unsupported type: %T", raw)
+	}
+}
+
+func parseTimeString(s string, unit time.Duration) (time.Time, error) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, errors.New("unable to parse time, empty string")
+	}
+	if ts, err := decimal.NewFromString(s); err == nil {
+		return timeFromDecimal(ts, unit), nil
+	}
+	for i := range timeLayouts {
+		if t, err := time.Parse(timeLayouts[i], s); err == nil {
+			return t, nil
+		}
+	}
+	return time.Time{}, fmt.Errorf("unable to parse time: %s", s)
+}
+
 // UnixMillis converts a UnixNano timestamp to milliseconds
 func UnixMillis(t time.Time) int64 {
 	return t.UnixNano() / int64(time.Millisecond)
@@ -1,6 +1,7 @@
 package convert
 
 import (
+	"encoding/json"
 	"math"
 	"testing"
 	"time"
@@ -96,6 +97,105 @@ func TestTimeFromUnixTimestampFloat(t *testing.T) {
 	}
 }
 
+func TestDetectTimeUnit(t *testing.T) {
+	t.Parallel()
+	tests := map[int64]time.Duration{
+		0:                   time.Second,
+		1577836800:          time.Second,
+		-1577836800:         time.Second,
+		1577836800123:       time.Millisecond,
+		1577836800123456:    time.Microsecond,
+		1577836800123456789: time.Nanosecond,
+	}
+	for ts, expected := range tests {
+		if unit := DetectTimeUnit(ts); unit != expected {
+			t.Errorf("%d: expected %v, got %v", ts, expected, unit)
+		}
+	}
+}
+
+func TestTimeFromUnix(t *testing.T) {
+	t.Parallel()
+	expected := time.Date(2020, time.January, 1, 0, 0, 0, 123000000, time.UTC)
+	tests := []struct {
+		ts   int64
+		unit time.Duration
+	}{
+		{1577836800123, time.Millisecond},
+		{1577836800123000, time.Microsecond},
+		{1577836800123000000, time.Nanosecond},
+		{1577836800123, 0},
+		{1577836800123000, 0},
+	}
+	for _, tt := range tests {
+		if tm := TimeFromUnix(tt.ts, tt.unit); !tm.Equal(expected) {
+			t.Errorf("%d %v: expected %v, got %v", tt.ts, tt.unit, expected, tm.UTC())
+		}
+	}
+	if tm := TimeFromUnix(1577836800, time.Second); !tm.Equal(expected.Truncate(time.Second)) {
+		t.Errorf("expected %v, got %v", expected.Truncate(time.Second), tm.UTC())
+	}
+}
+
+func TestTimeFromUnixFloat(t *testing.T) {
+	t.Parallel()
+	expected := time.Date(2020, time.January, 1, 0, 0, 0, 123456000, time.UTC)
+	if tm := TimeFromUnixFloat(1577836800.123456, time.Second); !tm.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, tm.UTC())
+	}
+	if tm := TimeFromUnixFloat(1577836800123.456, 0); !tm.Equal(expected) {
+		t.Errorf("expected %v, got %v", expected, tm.UTC())
+	}
+	if tm := TimeFromUnixFloat(math.NaN(), time.Second); !tm.IsZero() {
+		t.Errorf("expected zero time, got %v", tm)
+	}
+}
+
+func TestParseTime(t *testing.T) {
+	t.Parallel()
+	expected := time.Date(2020, time.January, 1, 0, 0, 0, 123456789, time.UTC)
+	tests := []struct {
+		raw  interface{}
+		unit time.Duration
+	}{
+		{"1577836800.123456789", time.Second},
+		{"1577836800123.456789", time.Millisecond},
+		{"1577836800123456789", 0},
+		{json.Number("1577836800123456.789"), 0},
+		{int64(1577836800123456789), time.Nanosecond},
+		{"2020-01-01T00:00:00.123456789Z", 0},
+		{"2020-01-01T02:00:00.123456789+02:00", 0},
+		{"2020-01-01T00:00:00.123456789", 0},
+		{"2020-01-01 00:00:00.123456789", 0},
+		{expected, 0},
+	}
+	for _, tt := range tests {
+		tm, err := ParseTime(tt.raw, tt.unit)
+		if err != nil {
+			t.Errorf("%v: %v", tt.raw, err)
+			continue
+		}
+		if !tm.Equal(expected) {
+			t.Errorf("%v: expected %v, got %v", tt.raw, expected, tm.UTC())
+		}
+	}
+
+	tm, err := ParseTime(float64(1577836800), time.Second)
+	if err != nil || !tm.Equal(expected.Truncate(time.Second)) {
+		t.Errorf("expected %v, got %v %v", expected.Truncate(time.Second), tm.UTC(), err)
+	}
+	tm, err = ParseTime("2020-01-01", 0)
+	if err != nil || !tm.Equal(expected.Truncate(time.Hour*24)) {
+		t.Errorf("expected %v, got %v %v", expected.Truncate(time.Hour*24), tm.UTC(), err)
+	}
+
+	for _, raw := range []interface{}{"", "yesterday", math.Inf(1), true} {
+		if _, err := ParseTime(raw, 0); err == nil {
+			t.Errorf("%v: expected error", raw)
+		}
+	}
+}
+
 func TestUnixTimestampToTime(t *testing.T) {
 	t.Parallel()
 	testTime := int64(1489439831)
@@ -0,0 +1,128 @@
+package crypto
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+
+	"golang.org/x/crypto/argon2"
+)
+
+// Argon2id key derivation defaults and limits
+const (
+	Argon2SaltLength = 16
+	Argon2KeyLength  = 32
+	// Argon2ParamsLength is the length of encoded Argon2Params, the time and
+	// memory as big endian uint32s followed by the threads
+	Argon2ParamsLength = 4 + 4 + 1
+	// The highest Argon2id parameters accepted from a file header, leaving
+	// room to raise the defaults while a corrupt or crafted header can't
+	// make decryption take unbounded memory or time
+	Argon2MaxTime    = 16
+	Argon2MaxMemory  = 1024 * 1024
+	Argon2MaxThreads = 64
+)
+
+var (
+	// DefaultArgon2Params are the Argon2id parameters new keys are derived
+	// with
+	DefaultArgon2Params = Argon2Params{
+		Time:    3,
+		Memory:  64 * 1024,
+		Threads: 4,
+	}
+
+	// ErrCiphertextTruncated is returned when sealed data is too short to
+	// hold its header, nonce and authentication tag
+	ErrCiphertextTruncated = errors.New("ciphertext is truncated")
+)
+
+// Argon2Params are the Argon2id parameters a key is derived with, stored
+// alongside encrypted data so they can be raised without breaking existing
+// files. Memory is in KiB.
+type Argon2Params struct {
+	Time    uint32
+	Memory  uint32
+	Threads uint8
+}
+
+// Bytes encodes the parameters to be stored in a file header
+func (p Argon2Params) Bytes() []byte {
+	b := make([]byte, Argon2ParamsLength)
+	binary.BigEndian.PutUint32(b, p.Time)
+	binary.BigEndian.PutUint32(b[4:], p.Memory)
+	b[8] = p.Threads
+	return b
+}
+
+// ParseArgon2Params decodes parameters encoded by Argon2Params.Bytes
+func ParseArgon2Params(b []byte) (Argon2Params, error) {
+	if len(b) < Argon2ParamsLength {
+		return Argon2Params{}, errors.New("argon2 parameters are truncated")
+	}
+	return Argon2Params{
+		Time:    binary.BigEndian.Uint32(b),
+		Memory:  binary.BigEndian.Uint32(b[4:]),
+		Threads: b[8],
+	}, nil
+}
+
+// Argon2Key derives an AES-256 key from key and salt with Argon2id, rejecting
+// parameters above the maximums
+func Argon2Key(key, salt []byte, p Argon2Params) ([]byte, error) {
+	if len(key) == 0 {
+		return nil, errors.New("key is empty")
+	}
+	if p.Time == 0 || p.Memory == 0 || p.Threads == 0 {
+		return nil, errors.New("invalid argon2 parameters")
+	}
+	if p.Time > Argon2MaxTime || p.Memory > Argon2MaxMemory || p.Threads > Argon2MaxThreads {
+		return nil, fmt.Errorf("argon2 parameters time %d memory %d KiB threads %d exceed the maximum of %d, %d KiB and %d",
+			p.Time, p.Memory, p.Threads, Argon2MaxTime, Argon2MaxMemory, Argon2MaxThreads)
+	}
+	return argon2.IDKey(key, salt, p.Time, p.Memory, p.Threads, Argon2KeyLength), nil
+}
+
+// SealAESGCM encrypts plaintext with AES-GCM under a random nonce, returning
+// the header, the nonce and the ciphertext. The header and nonce are
+// authenticated so they can't be tampered with.
+func SealAESGCM(key, header, plaintext []byte) ([]byte, error) {
+	gcm, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err = io.ReadFull(rand.Reader, nonce); err != nil {
+		return nil, err
+	}
+	data := make([]byte, 0, len(header)+len(nonce)+len(plaintext)+gcm.Overhead())
+	data = append(data, header...)
+	data = append(data, nonce...)
+	return gcm.Seal(data, nonce, plaintext, data), nil
+}
+
+// OpenAESGCM decrypts data sealed by SealAESGCM whose header is headerLen
+// bytes long
+func OpenAESGCM(key, data []byte, headerLen int) ([]byte, error) {
+	gcm, err := newAESGCM(key)
+	if err != nil {
+		return nil, err
+	}
+	nonceEnd := headerLen + gcm.NonceSize()
+	if headerLen < 0 || len(data) < nonceEnd+gcm.Overhead() {
+		return nil, ErrCiphertextTruncated
+	}
+	return gcm.Open(nil, data[headerLen:nonceEnd], data[nonceEnd:], data[:nonceEnd])
+}
+
+func newAESGCM(key []byte) (cipher.AEAD, error) {
+	block, err := aes.NewCipher(key)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
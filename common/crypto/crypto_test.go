@@ -176,3 +176,73 @@ func TestSha1Tohex(t *testing.T) {
 			expectedResult, actualResult)
 	}
 }
+
+func TestArgon2Key(t *testing.T) {
+	t.Parallel()
+	salt := make([]byte, Argon2SaltLength)
+	_, err := Argon2Key(nil, salt, DefaultArgon2Params)
+	if err == nil {
+		t.Error("expected error deriving from an empty key")
+	}
+	_, err = Argon2Key([]byte("key"), salt, Argon2Params{})
+	if err == nil {
+		t.Error("expected error deriving with zero parameters")
+	}
+	_, err = Argon2Key([]byte("key"), salt, Argon2Params{Time: 1, Memory: Argon2MaxMemory + 1, Threads: 1})
+	if err == nil {
+		t.Error("expected error deriving with excessive parameters")
+	}
+	dk, err := Argon2Key([]byte("key"), salt, Argon2Params{Time: 1, Memory: 1024, Threads: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(dk) != Argon2KeyLength {
+		t.Errorf("expected key length %d, got %d", Argon2KeyLength, len(dk))
+	}
+
+	params, err := ParseArgon2Params(DefaultArgon2Params.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if params != DefaultArgon2Params {
+		t.Errorf("expected %+v, got %+v", DefaultArgon2Params, params)
+	}
+	_, err = ParseArgon2Params(nil)
+	if err == nil {
+		t.Error("expected error parsing truncated parameters")
+	}
+}
+
+func TestSealOpenAESGCM(t *testing.T) {
+	t.Parallel()
+	key := make([]byte, Argon2KeyLength)
+	header := []byte("header")
+	data, err := SealAESGCM(key, header, []byte("plaintext"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.HasPrefix(data, header) {
+		t.Error("expected sealed data to start with the header")
+	}
+	result, err := OpenAESGCM(key, data, len(header))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result) != "plaintext" {
+		t.Errorf("expected plaintext, got %s", result)
+	}
+
+	data[0] ^= 0xff
+	_, err = OpenAESGCM(key, data, len(header))
+	if err == nil {
+		t.Error("expected error opening data with a tampered header")
+	}
+	_, err = OpenAESGCM(key, data[:len(header)+1], len(header))
+	if err != ErrCiphertextTruncated {
+		t.Errorf("expected ErrCiphertextTruncated, got %v", err)
+	}
+	_, err = SealAESGCM([]byte("short"), header, nil)
+	if err == nil {
+		t.Error("expected error sealing with an invalid key")
+	}
+}
@@ -0,0 +1,366 @@
+// Package decimal provides an arbitrary precision fixed-point decimal type
+// for order amounts, prices, fees and balances
+package decimal
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"strconv"
+	"strings"
+)
+
+// DivisionPrecision is the number of decimal places kept by Div
+const DivisionPrecision = 16
+
+// maxExponent bounds the exponent of a parsed decimal so a value such as
+// "1e999999999" can't make rescaling compute an enormous power of ten. It
+// covers every float64, the smallest of which is 5e-324
+const maxExponent = 350
+
+var (
+	// ErrDivisionByZero is returned when dividing by a zero decimal
+	ErrDivisionByZero = errors.New("decimal: division by zero")
+
+	errEmptyString = errors.New("decimal: cannot parse empty string")
+	bigTen         = big.NewInt(10)
+	zero           = big.NewInt(0)
+)
+
+// Decimal is an arbitrary precision fixed-point decimal number, storing
+// amounts and prices exactly where a float64 would accumulate rounding
+// errors. The zero value is 0. Decimals are immutable, every operation
+// returns a new Decimal.
+//
+// Decimals must be compared with Cmp or Equal, as equal numbers may be
+// stored with a different number of decimal places they cannot be compared
+// with ==.
+type Decimal struct {
+	_ [0]func() // prevents comparison with ==
+	// value * 10^exp
+	value *big.Int
+	exp   int32
+}
+
+// Zero is the decimal 0
+var Zero = Decimal{}
+
+// New returns value * 10^exp
+func New(value int64, exp int32) Decimal {
+	return Decimal{value: big.NewInt(value), exp: exp}
+}
+
+// NewFromInt returns the decimal for an integer
+func NewFromInt(value int64) Decimal {
+	return New(value, 0)
+}
+
+// NewFromFloat returns the shortest decimal which converts back to the same
+// float64, so NewFromFloat(0.1) is exactly 0.1. It panics on NaN or infinity.
+func NewFromFloat(f float64) Decimal {
+	if math.IsNaN(f) || math.IsInf(f, 0) {
+		panic(fmt.Sprintf("decimal: cannot create decimal from %v", f))
+	}
+	d, err := NewFromString(strconv.FormatFloat(f, 'g', -1, 64))
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// NewFromString parses a decimal such as "-1.25" or "1e-8"
+func NewFromString(s string) (Decimal, error) {
+	if s == "" {
+		return Decimal{}, errEmptyString
+	}
+	mantissa, exp := s, int64(0)
+	if i := strings.IndexAny(s, "eE"); i != -1 {
+		var err error
+		exp, err = strconv.ParseInt(s[i+1:], 10, 32)
+		if err != nil {
+			return Decimal{}, fmt.Errorf("decimal: cannot parse exponent of %q", s)
+		}
+		if exp < -maxExponent || exp > maxExponent {
+			return Decimal{}, fmt.Errorf("decimal: exponent of %q out of range", s)
+		}
+		mantissa = s[:i]
+	}
+
+	digits := mantissa
+	if i := strings.IndexByte(mantissa, '.'); i != -1 {
+		frac := mantissa[i+1:]
+		if strings.ContainsAny(frac, ".+-") {
+			return Decimal{}, fmt.Errorf("decimal: cannot parse %q", s)
+		}
+		digits = mantissa[:i] + frac
+		exp -= int64(len(frac))
+	}
+	if exp < -maxExponent || exp > maxExponent {
+		return Decimal{}, fmt.Errorf("decimal: exponent of %q out of range", s)
+	}
+
+	unsigned := strings.TrimLeft(digits, "+-")
+	if unsigned == "" || len(digits)-len(unsigned) > 1 ||
+		strings.TrimLeft(unsigned, "0123456789") != "" {
+		return Decimal{}, fmt.Errorf("decimal: cannot parse %q", s)
+	}
+	value, ok := new(big.Int).SetString(digits, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("decimal: cannot parse %q", s)
+	}
+	return Decimal{value: value, exp: int32(exp)}, nil
+}
+
+// RequireFromString parses a decimal, panicking if it is invalid. It is
+// intended for constants.
+func RequireFromString(s string) Decimal {
+	d, err := NewFromString(s)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+// int returns the unscaled value, treating the zero value as 0
+func (d Decimal) int() *big.Int {
+	if d.value == nil {
+		return zero
+	}
+	return d.value
+}
+
+// rescale returns the unscaled value of d at the lower exponent exp
+func (d Decimal) rescale(exp int32) *big.Int {
+	v := d.int()
+	if exp >= d.exp {
+		return v
+	}
+	return new(big.Int).Mul(v, pow10(int64(d.exp)-int64(exp)))
+}
+
+func pow10(n int64) *big.Int {
+	return new(big.Int).Exp(bigTen, big.NewInt(n), nil)
+}
+
+// align returns the unscaled values of d and d2 at their lowest exponent
+func align(d, d2 Decimal) (a, b *big.Int, exp int32) {
+	exp = d.exp
+	if d2.exp < exp {
+		exp = d2.exp
+	}
+	return d.rescale(exp), d2.rescale(exp), exp
+}
+
+// Add returns d + d2
+func (d Decimal) Add(d2 Decimal) Decimal {
+	a, b, exp := align(d, d2)
+	return Decimal{value: new(big.Int).Add(a, b), exp: exp}
+}
+
+// Sub returns d - d2
+func (d Decimal) Sub(d2 Decimal) Decimal {
+	a, b, exp := align(d, d2)
+	return Decimal{value: new(big.Int).Sub(a, b), exp: exp}
+}
+
+// Mul returns d * d2
+func (d Decimal) Mul(d2 Decimal) Decimal {
+	return Decimal{
+		value: new(big.Int).Mul(d.int(), d2.int()),
+		exp:   d.exp + d2.exp,
+	}
+}
+
+// Div returns d / d2 rounded half away from zero to DivisionPrecision
+// decimal places, or ErrDivisionByZero if d2 is zero
+func (d Decimal) Div(d2 Decimal) (Decimal, error) {
+	return d.DivRound(d2, DivisionPrecision)
+}
+
+// DivRound returns d / d2 rounded half away from zero to places decimal
+// places, or ErrDivisionByZero if d2 is zero
+func (d Decimal) DivRound(d2 Decimal, places int32) (Decimal, error) {
+	if d2.IsZero() {
+		return Decimal{}, ErrDivisionByZero
+	}
+	num, den := new(big.Int).Set(d.int()), new(big.Int).Set(d2.int())
+	// d / d2 * 10^places = (a / b) * 10^(exp - exp2 + places)
+	shift := int64(d.exp) - int64(d2.exp) + int64(places)
+	if shift > 0 {
+		num.Mul(num, pow10(shift))
+	} else if shift < 0 {
+		den.Mul(den, pow10(-shift))
+	}
+	return Decimal{value: quoRound(num, den), exp: -places}, nil
+}
+
+// quoRound returns num / den rounded half away from zero
+func quoRound(num, den *big.Int) *big.Int {
+	q, r := new(big.Int).QuoRem(num, den, new(big.Int))
+	if r.Sign() == 0 {
+		return q
+	}
+	if new(big.Int).Abs(new(big.Int).Lsh(r, 1)).Cmp(new(big.Int).Abs(den)) >= 0 {
+		if num.Sign() == den.Sign() {
+			q.Add(q, big.NewInt(1))
+		} else {
+			q.Sub(q, big.NewInt(1))
+		}
+	}
+	return q
+}
+
+// Neg returns -d
+func (d Decimal) Neg() Decimal {
+	return Decimal{value: new(big.Int).Neg(d.int()), exp: d.exp}
+}
+
+// Abs returns the absolute value of d
+func (d Decimal) Abs() Decimal {
+	return Decimal{value: new(big.Int).Abs(d.int()), exp: d.exp}
+}
+
+// Round returns d rounded half away from zero to places decimal places,
+// negative places round to the left of the decimal point
+func (d Decimal) Round(places int32) Decimal {
+	if d.exp >= -places {
+		return d
+	}
+	return Decimal{
+		value: quoRound(d.int(), pow10(int64(-places)-int64(d.exp))),
+		exp:   -places,
+	}
+}
+
+// Truncate returns d rounded towards zero to places decimal places, such as
+// when an exchange rejects amounts more precise than it supports
+func (d Decimal) Truncate(places int32) Decimal {
+	if d.exp >= -places {
+		return d
+	}
+	return Decimal{
+		value: new(big.Int).Quo(d.int(), pow10(int64(-places)-int64(d.exp))),
+		exp:   -places,
+	}
+}
+
+// Cmp returns -1 if d < d2, 0 if d == d2 and +1 if d > d2
+func (d Decimal) Cmp(d2 Decimal) int {
+	a, b, _ := align(d, d2)
+	return a.Cmp(b)
+}
+
+// Equal returns whether d and d2 are the same number
+func (d Decimal) Equal(d2 Decimal) bool {
+	return d.Cmp(d2) == 0
+}
+
+// GreaterThan returns whether d > d2
+func (d Decimal) GreaterThan(d2 Decimal) bool {
+	return d.Cmp(d2) > 0
+}
+
+// LessThan returns whether d < d2
+func (d Decimal) LessThan(d2 Decimal) bool {
+	return d.Cmp(d2) < 0
+}
+
+// Sign returns -1 if d < 0, 0 if d == 0 and +1 if d > 0
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+// IsZero returns whether d is 0
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+// Float64 returns the nearest float64 to d
+func (d Decimal) Float64() float64 {
+	var r big.Rat
+	if d.exp >= 0 {
+		r.SetInt(d.rescale(0))
+	} else {
+		r.SetFrac(d.int(), pow10(-int64(d.exp)))
+	}
+	f, _ := r.Float64()
+	return f
+}
+
+// IntPart returns the integer part of d, truncated towards zero
+func (d Decimal) IntPart() int64 {
+	return d.Truncate(0).rescale(0).Int64()
+}
+
+// String returns d without an exponent or trailing zeros, e.g. "-0.001"
+func (d Decimal) String() string {
+	return d.format(-1)
+}
+
+// StringFixed returns d rounded to places decimal places, padded with
+// trailing zeros to exactly places decimal places
+func (d Decimal) StringFixed(places int32) string {
+	if places < 0 {
+		places = 0
+	}
+	return d.Round(places).format(places)
+}
+
+// format returns d with places decimal places, trimming trailing zeros if
+// places is negative. d must not have more than places decimal places.
+func (d Decimal) format(places int32) string {
+	v := d.int()
+	if d.exp > 0 {
+		v = d.rescale(0)
+	}
+	digits := new(big.Int).Abs(v).String()
+	var intPart, fracPart string
+	if d.exp >= 0 {
+		intPart = digits
+	} else {
+		scale := int(-d.exp)
+		if len(digits) <= scale {
+			digits = strings.Repeat("0", scale-len(digits)+1) + digits
+		}
+		intPart, fracPart = digits[:len(digits)-scale], digits[len(digits)-scale:]
+	}
+
+	if places < 0 {
+		fracPart = strings.TrimRight(fracPart, "0")
+	} else if n := int(places) - len(fracPart); n > 0 {
+		fracPart += strings.Repeat("0", n)
+	}
+
+	s := intPart
+	if fracPart != "" {
+		s += "." + fracPart
+	}
+	if v.Sign() < 0 && strings.Trim(s, "0.") != "" {
+		s = "-" + s
+	}
+	return s
+}
+
+// MarshalJSON encodes d as a JSON number so existing consumers of float64
+// fields are unaffected
+func (d Decimal) MarshalJSON() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+// UnmarshalJSON decodes a JSON number or string, null leaves d unchanged
+func (d *Decimal) UnmarshalJSON(b []byte) error {
+	s := string(b)
+	if s == "null" {
+		return nil
+	}
+	if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+		s = s[1 : len(s)-1]
+	}
+	v, err := NewFromString(s)
+	if err != nil {
+		return err
+	}
+	*d = v
+	return nil
+}
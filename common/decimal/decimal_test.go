@@ -0,0 +1,219 @@
+package decimal
+
+import (
+	"encoding/json"
+	"math"
+	"strings"
+	"testing"
+)
+
+func TestNewFromString(t *testing.T) {
+	t.Parallel()
+	tests := map[string]string{
+		"0":         "0",
+		"1.50":      "1.5",
+		"-0.001":    "-0.001",
+		"+12":       "12",
+		".5":        "0.5",
+		"5.":        "5",
+		"1e-8":      "0.00000001",
+		"1.5E3":     "1500",
+		"-2.5e+2":   "-250",
+		"000123.40": "123.4",
+	}
+	for in, expected := range tests {
+		d, err := NewFromString(in)
+		if err != nil {
+			t.Errorf("%s: %v", in, err)
+			continue
+		}
+		if d.String() != expected {
+			t.Errorf("%s: expected %s, got %s", in, expected, d.String())
+		}
+	}
+
+	for _, in := range []string{"", "-", ".", "1.2.3", "1e", "abc", "--1", "1.-2", "1e99999999999",
+		"1e999999999", "1e-351", "0." + strings.Repeat("0", 350) + "1"} {
+		if _, err := NewFromString(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestNewFromFloat(t *testing.T) {
+	t.Parallel()
+	if s := NewFromFloat(0.1).String(); s != "0.1" {
+		t.Errorf("expected 0.1, got %s", s)
+	}
+	if s := NewFromFloat(-1234.5678).String(); s != "-1234.5678" {
+		t.Errorf("expected -1234.5678, got %s", s)
+	}
+	if s := NewFromFloat(1e-10).String(); s != "0.0000000001" {
+		t.Errorf("expected 0.0000000001, got %s", s)
+	}
+	for _, f := range []float64{math.SmallestNonzeroFloat64, math.MaxFloat64} {
+		if d := NewFromFloat(f); d.Float64() != f {
+			t.Errorf("expected %v, got %s", f, d)
+		}
+	}
+
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic for NaN")
+		}
+	}()
+	var f float64
+	NewFromFloat(f / f)
+}
+
+func TestArithmetic(t *testing.T) {
+	t.Parallel()
+	a := RequireFromString("0.1")
+	b := RequireFromString("0.2")
+	if !a.Add(b).Equal(RequireFromString("0.3")) {
+		t.Errorf("expected 0.3, got %s", a.Add(b))
+	}
+	if !a.Sub(b).Equal(RequireFromString("-0.1")) {
+		t.Errorf("expected -0.1, got %s", a.Sub(b))
+	}
+	if !a.Mul(b).Equal(RequireFromString("0.02")) {
+		t.Errorf("expected 0.02, got %s", a.Mul(b))
+	}
+	for _, tc := range []struct {
+		quotient func() (Decimal, error)
+		want     string
+	}{
+		{func() (Decimal, error) { return NewFromInt(1).Div(NewFromInt(3)) }, "0.3333333333333333"},
+		{func() (Decimal, error) { return NewFromInt(-2).DivRound(NewFromInt(3), 2) }, "-0.67"},
+		{func() (Decimal, error) { return New(15, 2).DivRound(RequireFromString("0.5"), 0) }, "3000"},
+	} {
+		q, err := tc.quotient()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if q.String() != tc.want {
+			t.Errorf("expected %s, got %s", tc.want, q)
+		}
+	}
+	if !Zero.Add(a).Equal(a) || !Zero.Mul(a).IsZero() {
+		t.Error("zero value should be usable")
+	}
+	if !a.Neg().Abs().Equal(a) || a.Neg().Sign() != -1 {
+		t.Error("unexpected negation")
+	}
+
+	if _, err := a.Div(Zero); err != ErrDivisionByZero {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+	if _, err := a.DivRound(RequireFromString("0.00"), 2); err != ErrDivisionByZero {
+		t.Errorf("expected ErrDivisionByZero, got %v", err)
+	}
+}
+
+func TestRoundTruncate(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		in       string
+		places   int32
+		round    string
+		truncate string
+	}{
+		{"1.005", 2, "1.01", "1"},
+		{"-1.005", 2, "-1.01", "-1"},
+		{"1.004", 2, "1", "1"},
+		{"0.123456789", 8, "0.12345679", "0.12345678"},
+		{"1250", -2, "1300", "1200"},
+		{"1.5", 4, "1.5", "1.5"},
+	}
+	for _, tt := range tests {
+		d := RequireFromString(tt.in)
+		if s := d.Round(tt.places).String(); s != tt.round {
+			t.Errorf("round %s to %d: expected %s, got %s", tt.in, tt.places, tt.round, s)
+		}
+		if s := d.Truncate(tt.places).String(); s != tt.truncate {
+			t.Errorf("truncate %s to %d: expected %s, got %s", tt.in, tt.places, tt.truncate, s)
+		}
+	}
+}
+
+func TestCmp(t *testing.T) {
+	t.Parallel()
+	a := RequireFromString("1.50")
+	b := RequireFromString("1.5")
+	if !a.Equal(b) || a.Cmp(b) != 0 {
+		t.Error("numbers with different scales should be equal")
+	}
+	if !a.LessThan(NewFromInt(2)) || !a.GreaterThan(NewFromInt(1)) {
+		t.Error("unexpected comparison")
+	}
+	if !Zero.IsZero() || New(0, -8).Sign() != 0 {
+		t.Error("expected zero")
+	}
+}
+
+func TestStringFixed(t *testing.T) {
+	t.Parallel()
+	if s := RequireFromString("1.5").StringFixed(4); s != "1.5000" {
+		t.Errorf("expected 1.5000, got %s", s)
+	}
+	if s := RequireFromString("-0.0049").StringFixed(2); s != "0.00" {
+		t.Errorf("expected 0.00, got %s", s)
+	}
+	if s := New(12, 3).StringFixed(0); s != "12000" {
+		t.Errorf("expected 12000, got %s", s)
+	}
+	if s := Zero.StringFixed(2); s != "0.00" {
+		t.Errorf("expected 0.00, got %s", s)
+	}
+}
+
+func TestFloat64(t *testing.T) {
+	t.Parallel()
+	if f := RequireFromString("0.1").Float64(); f != 0.1 {
+		t.Errorf("expected 0.1, got %v", f)
+	}
+	if f := New(-25, 3).Float64(); f != -25000 {
+		t.Errorf("expected -25000, got %v", f)
+	}
+	if f := Zero.Float64(); f != 0 {
+		t.Errorf("expected 0, got %v", f)
+	}
+}
+
+func TestIntPart(t *testing.T) {
+	t.Parallel()
+	if i := RequireFromString("-12.99").IntPart(); i != -12 {
+		t.Errorf("expected -12, got %d", i)
+	}
+	if i := New(5, 2).IntPart(); i != 500 {
+		t.Errorf("expected 500, got %d", i)
+	}
+}
+
+func TestJSON(t *testing.T) {
+	t.Parallel()
+	var v struct {
+		Number Decimal `json:"number"`
+		String Decimal `json:"string"`
+		Null   Decimal `json:"null"`
+	}
+	err := json.Unmarshal([]byte(`{"number":0.00000001,"string":"1.10","null":null}`), &v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.Number.String() != "0.00000001" || v.String.String() != "1.1" || !v.Null.IsZero() {
+		t.Error("unexpected decoded values")
+	}
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(b) != `{"number":0.00000001,"string":1.1,"null":0}` {
+		t.Errorf("unexpected encoding %s", b)
+	}
+
+	if err = json.Unmarshal([]byte(`{"number":"abc"}`), &v); err == nil {
+		t.Error("expected error")
+	}
+}
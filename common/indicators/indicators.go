@@ -0,0 +1,265 @@
+package indicators
+
+import "math"
+
+// Indicator series are the same length as their input. Values before the
+// indicator has enough data are math.NaN()
+
+func checkPeriod(length, period int) error {
+	if period <= 0 {
+		return ErrInvalidPeriod
+	}
+	if length < period {
+		return ErrNotEnoughData
+	}
+	return nil
+}
+
+func nanSeries(length int) []float64 {
+	s := make([]float64, length)
+	for i := range s {
+		s[i] = math.NaN()
+	}
+	return s
+}
+
+// SMA returns the simple moving average of values over period
+func SMA(values []float64, period int) ([]float64, error) {
+	if err := checkPeriod(len(values), period); err != nil {
+		return nil, err
+	}
+	out := nanSeries(len(values))
+	var sum float64
+	for i := range values {
+		sum += values[i]
+		if i >= period {
+			sum -= values[i-period]
+		}
+		if i >= period-1 {
+			out[i] = sum / float64(period)
+		}
+	}
+	return out, nil
+}
+
+// EMA returns the exponential moving average of values over period, seeded
+// with the simple moving average of the first period values
+func EMA(values []float64, period int) ([]float64, error) {
+	if err := checkPeriod(len(values), period); err != nil {
+		return nil, err
+	}
+	out := nanSeries(len(values))
+	var seed float64
+	for i := 0; i < period; i++ {
+		seed += values[i]
+	}
+	out[period-1] = seed / float64(period)
+	k := 2 / float64(period+1)
+	for i := period; i < len(values); i++ {
+		out[i] = values[i]*k + out[i-1]*(1-k)
+	}
+	return out, nil
+}
+
+// RSI returns the relative strength index of values over period using
+// Wilder's smoothing
+func RSI(values []float64, period int) ([]float64, error) {
+	if period <= 0 {
+		return nil, ErrInvalidPeriod
+	}
+	if err := checkPeriod(len(values), period+1); err != nil {
+		return nil, err
+	}
+	out := nanSeries(len(values))
+	var gain, loss float64
+	for i := 1; i <= period; i++ {
+		change := values[i] - values[i-1]
+		if change > 0 {
+			gain += change
+		} else {
+			loss -= change
+		}
+	}
+	gain /= float64(period)
+	loss /= float64(period)
+	out[period] = rsi(gain, loss)
+	for i := period + 1; i < len(values); i++ {
+		change := values[i] - values[i-1]
+		var g, l float64
+		if change > 0 {
+			g = change
+		} else {
+			l = -change
+		}
+		gain = (gain*float64(period-1) + g) / float64(period)
+		loss = (loss*float64(period-1) + l) / float64(period)
+		out[i] = rsi(gain, loss)
+	}
+	return out, nil
+}
+
+func rsi(gain, loss float64) float64 {
+	if loss == 0 {
+		if gain == 0 {
+			return 50
+		}
+		return 100
+	}
+	return 100 - 100/(1+gain/loss)
+}
+
+// MACD returns the moving average convergence divergence line, its signal
+// line and the histogram between them
+func MACD(values []float64, fast, slow, signal int) (macd, signalLine, histogram []float64, err error) {
+	if fast <= 0 || signal <= 0 {
+		return nil, nil, nil, ErrInvalidPeriod
+	}
+	if fast >= slow {
+		return nil, nil, nil, ErrInvalidMACDPeriods
+	}
+	if err = checkPeriod(len(values), slow+signal-1); err != nil {
+		return nil, nil, nil, err
+	}
+	fastEMA, err := EMA(values, fast)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	slowEMA, err := EMA(values, slow)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	macd = nanSeries(len(values))
+	for i := slow - 1; i < len(values); i++ {
+		macd[i] = fastEMA[i] - slowEMA[i]
+	}
+	signalValues, err := EMA(macd[slow-1:], signal)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	signalLine = nanSeries(len(values))
+	histogram = nanSeries(len(values))
+	for i := range signalValues {
+		signalLine[i+slow-1] = signalValues[i]
+		histogram[i+slow-1] = macd[i+slow-1] - signalValues[i]
+	}
+	return macd, signalLine, histogram, nil
+}
+
+// BollingerBands returns the upper, middle and lower bands of values over
+// period, the outer bands being deviations standard deviations from the
+// simple moving average
+func BollingerBands(values []float64, period int, deviations float64) (upper, middle, lower []float64, err error) {
+	middle, err = SMA(values, period)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	upper = nanSeries(len(values))
+	lower = nanSeries(len(values))
+	for i := period - 1; i < len(values); i++ {
+		var variance float64
+		for j := i - period + 1; j <= i; j++ {
+			variance += (values[j] - middle[i]) * (values[j] - middle[i])
+		}
+		band := deviations * math.Sqrt(variance/float64(period))
+		upper[i] = middle[i] + band
+		lower[i] = middle[i] - band
+	}
+	return upper, middle, lower, nil
+}
+
+// ATR returns the average true range over period using Wilder's smoothing
+func ATR(high, low, closePrices []float64, period int) ([]float64, error) {
+	if len(high) != len(low) || len(high) != len(closePrices) {
+		return nil, ErrMismatchedSeries
+	}
+	if period <= 0 {
+		return nil, ErrInvalidPeriod
+	}
+	if err := checkPeriod(len(high), period+1); err != nil {
+		return nil, err
+	}
+	out := nanSeries(len(high))
+	var atr float64
+	for i := 1; i < len(high); i++ {
+		tr := math.Max(high[i]-low[i],
+			math.Max(math.Abs(high[i]-closePrices[i-1]), math.Abs(low[i]-closePrices[i-1])))
+		switch {
+		case i < period:
+			atr += tr
+		case i == period:
+			atr = (atr + tr) / float64(period)
+			out[i] = atr
+		default:
+			atr = (atr*float64(period-1) + tr) / float64(period)
+			out[i] = atr
+		}
+	}
+	return out, nil
+}
+
+// Last returns the last value of a series which is not math.NaN()
+func Last(series []float64) (float64, bool) {
+	for i := len(series) - 1; i >= 0; i-- {
+		if !math.IsNaN(series[i]) {
+			return series[i], true
+		}
+	}
+	return 0, false
+}
+
+// RSISignal returns bearish when the latest RSI is overbought and bullish
+// when it is oversold
+func RSISignal(rsi []float64) Signal {
+	last, ok := Last(rsi)
+	switch {
+	case !ok:
+		return Neutral
+	case last >= RSIOverbought:
+		return Bearish
+	case last <= RSIOversold:
+		return Bullish
+	default:
+		return Neutral
+	}
+}
+
+// CrossSignal returns bullish when fast crossed above slow on the latest
+// value and bearish when it crossed below
+func CrossSignal(fast, slow []float64) Signal {
+	n := len(fast)
+	if n < 2 || len(slow) != n {
+		return Neutral
+	}
+	prevFast, prevSlow := fast[n-2], slow[n-2]
+	curFast, curSlow := fast[n-1], slow[n-1]
+	if math.IsNaN(prevFast) || math.IsNaN(prevSlow) ||
+		math.IsNaN(curFast) || math.IsNaN(curSlow) {
+		return Neutral
+	}
+	switch {
+	case prevFast <= prevSlow && curFast > curSlow:
+		return Bullish
+	case prevFast >= prevSlow && curFast < curSlow:
+		return Bearish
+	default:
+		return Neutral
+	}
+}
+
+// BandSignal returns bearish when the latest price closed above the upper
+// band and bullish when it closed below the lower band
+func BandSignal(prices, upper, lower []float64) Signal {
+	n := len(prices)
+	if n == 0 || len(upper) != n || len(lower) != n ||
+		math.IsNaN(upper[n-1]) || math.IsNaN(lower[n-1]) {
+		return Neutral
+	}
+	switch {
+	case prices[n-1] > upper[n-1]:
+		return Bearish
+	case prices[n-1] < lower[n-1]:
+		return Bullish
+	default:
+		return Neutral
+	}
+}
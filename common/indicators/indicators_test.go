@@ -0,0 +1,208 @@
+package indicators
+
+import (
+	"math"
+	"testing"
+)
+
+var prices = []float64{
+	44.34, 44.09, 44.15, 43.61, 44.33, 44.83, 45.10, 45.42,
+	45.84, 46.08, 45.89, 46.03, 45.61, 46.28, 46.28, 46.00,
+	46.03, 46.41, 46.22, 45.64, 46.21, 46.25, 45.71, 46.45,
+	45.78, 45.35, 44.03, 44.18, 44.22, 44.57, 43.42, 42.66,
+	43.13,
+}
+
+func almostEqual(a, b, tolerance float64) bool {
+	return math.Abs(a-b) <= tolerance
+}
+
+func TestSMA(t *testing.T) {
+	t.Parallel()
+	out, err := SMA([]float64{1, 2, 3, 4, 5}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !math.IsNaN(out[0]) || !math.IsNaN(out[1]) {
+		t.Error("expected warm up values to be NaN")
+	}
+	expected := []float64{2, 3, 4}
+	for i := range expected {
+		if out[i+2] != expected[i] {
+			t.Errorf("index %d: expected %v, got %v", i+2, expected[i], out[i+2])
+		}
+	}
+
+	if _, err = SMA([]float64{1}, 0); err != ErrInvalidPeriod {
+		t.Errorf("expected %v, got %v", ErrInvalidPeriod, err)
+	}
+	if _, err = SMA([]float64{1}, 2); err != ErrNotEnoughData {
+		t.Errorf("expected %v, got %v", ErrNotEnoughData, err)
+	}
+}
+
+func TestEMA(t *testing.T) {
+	t.Parallel()
+	out, err := EMA([]float64{1, 2, 3, 4, 5}, 3)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []float64{2, 3, 4}
+	for i := range expected {
+		if out[i+2] != expected[i] {
+			t.Errorf("index %d: expected %v, got %v", i+2, expected[i], out[i+2])
+		}
+	}
+	if _, err = EMA(nil, 3); err != ErrNotEnoughData {
+		t.Errorf("expected %v, got %v", ErrNotEnoughData, err)
+	}
+}
+
+func TestRSI(t *testing.T) {
+	t.Parallel()
+	out, err := RSI(prices, 14)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !math.IsNaN(out[13]) {
+		t.Error("expected warm up value to be NaN")
+	}
+	if !almostEqual(out[14], 70.46, 0.01) {
+		t.Errorf("expected 70.46, got %v", out[14])
+	}
+	if !almostEqual(out[len(out)-1], 37.79, 0.01) {
+		t.Errorf("expected 37.79, got %v", out[len(out)-1])
+	}
+
+	flat, err := RSI([]float64{1, 1, 1}, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if flat[2] != 50 {
+		t.Errorf("expected 50, got %v", flat[2])
+	}
+	if _, err = RSI(prices, 0); err != ErrInvalidPeriod {
+		t.Errorf("expected %v, got %v", ErrInvalidPeriod, err)
+	}
+	if _, err = RSI(prices[:14], 14); err != ErrNotEnoughData {
+		t.Errorf("expected %v, got %v", ErrNotEnoughData, err)
+	}
+}
+
+func TestMACD(t *testing.T) {
+	t.Parallel()
+	macd, signal, hist, err := MACD(prices, 3, 6, 4)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !math.IsNaN(macd[4]) || math.IsNaN(macd[5]) {
+		t.Error("unexpected MACD warm up")
+	}
+	if !math.IsNaN(signal[7]) || math.IsNaN(signal[8]) {
+		t.Error("unexpected signal warm up")
+	}
+	for i := 8; i < len(prices); i++ {
+		if !almostEqual(hist[i], macd[i]-signal[i], 1e-9) {
+			t.Errorf("index %d: histogram mismatch", i)
+		}
+	}
+
+	if _, _, _, err = MACD(prices, 6, 3, 4); err != ErrInvalidMACDPeriods {
+		t.Errorf("expected %v, got %v", ErrInvalidMACDPeriods, err)
+	}
+	if _, _, _, err = MACD(prices, 3, 6, 0); err != ErrInvalidPeriod {
+		t.Errorf("expected %v, got %v", ErrInvalidPeriod, err)
+	}
+	if _, _, _, err = MACD(prices[:8], 3, 6, 4); err != ErrNotEnoughData {
+		t.Errorf("expected %v, got %v", ErrNotEnoughData, err)
+	}
+}
+
+func TestBollingerBands(t *testing.T) {
+	t.Parallel()
+	upper, middle, lower, err := BollingerBands([]float64{2, 4, 4, 4, 5, 5, 7, 9}, 8, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if middle[7] != 5 || upper[7] != 9 || lower[7] != 1 {
+		t.Errorf("unexpected bands %v %v %v", upper[7], middle[7], lower[7])
+	}
+	if _, _, _, err = BollingerBands(nil, 0, 2); err != ErrInvalidPeriod {
+		t.Errorf("expected %v, got %v", ErrInvalidPeriod, err)
+	}
+}
+
+func TestATR(t *testing.T) {
+	t.Parallel()
+	high := []float64{10, 11, 12, 11}
+	low := []float64{9, 9, 10, 8}
+	closePrices := []float64{9.5, 10, 11, 9}
+	out, err := ATR(high, low, closePrices, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// true ranges: 2, 2, 3
+	if !math.IsNaN(out[1]) || out[2] != 2 || out[3] != 2.5 {
+		t.Errorf("unexpected ATR %v", out)
+	}
+	if _, err = ATR(high, low[:3], closePrices, 2); err != ErrMismatchedSeries {
+		t.Errorf("expected %v, got %v", ErrMismatchedSeries, err)
+	}
+	if _, err = ATR(high, low, closePrices, 4); err != ErrNotEnoughData {
+		t.Errorf("expected %v, got %v", ErrNotEnoughData, err)
+	}
+}
+
+func TestLast(t *testing.T) {
+	t.Parallel()
+	if v, ok := Last([]float64{1, 2, math.NaN()}); !ok || v != 2 {
+		t.Errorf("expected 2, got %v", v)
+	}
+	if _, ok := Last([]float64{math.NaN()}); ok {
+		t.Error("expected no value")
+	}
+}
+
+func TestRSISignal(t *testing.T) {
+	t.Parallel()
+	if s := RSISignal([]float64{50, 75}); s != Bearish {
+		t.Errorf("expected %v, got %v", Bearish, s)
+	}
+	if s := RSISignal([]float64{50, 25}); s != Bullish {
+		t.Errorf("expected %v, got %v", Bullish, s)
+	}
+	if s := RSISignal([]float64{math.NaN()}); s != Neutral {
+		t.Errorf("expected %v, got %v", Neutral, s)
+	}
+}
+
+func TestCrossSignal(t *testing.T) {
+	t.Parallel()
+	if s := CrossSignal([]float64{1, 3}, []float64{2, 2}); s != Bullish {
+		t.Errorf("expected %v, got %v", Bullish, s)
+	}
+	if s := CrossSignal([]float64{3, 1}, []float64{2, 2}); s != Bearish {
+		t.Errorf("expected %v, got %v", Bearish, s)
+	}
+	if s := CrossSignal([]float64{3, 3}, []float64{2, 2}); s != Neutral {
+		t.Errorf("expected %v, got %v", Neutral, s)
+	}
+	if s := CrossSignal([]float64{math.NaN(), 3}, []float64{2, 2}); s != Neutral {
+		t.Errorf("expected %v, got %v", Neutral, s)
+	}
+}
+
+func TestBandSignal(t *testing.T) {
+	t.Parallel()
+	upper := []float64{10}
+	lower := []float64{5}
+	if s := BandSignal([]float64{11}, upper, lower); s != Bearish {
+		t.Errorf("expected %v, got %v", Bearish, s)
+	}
+	if s := BandSignal([]float64{4}, upper, lower); s != Bullish {
+		t.Errorf("expected %v, got %v", Bullish, s)
+	}
+	if s := BandSignal([]float64{7}, upper, lower); s != Neutral {
+		t.Errorf("expected %v, got %v", Neutral, s)
+	}
+}
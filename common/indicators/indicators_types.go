@@ -0,0 +1,32 @@
+package indicators
+
+import "errors"
+
+// Signal is a simple trading signal derived from an indicator
+type Signal string
+
+// Signal types
+const (
+	Bullish Signal = "BULLISH"
+	Bearish Signal = "BEARISH"
+	Neutral Signal = "NEUTRAL"
+)
+
+// Default indicator parameters
+const (
+	DefaultPeriod          = 14
+	DefaultMACDFast        = 12
+	DefaultMACDSlow        = 26
+	DefaultMACDSignal      = 9
+	DefaultBBandsDeviation = 2.0
+	RSIOverbought          = 70.0
+	RSIOversold            = 30.0
+)
+
+// Errors returned when calculating indicators
+var (
+	ErrInvalidPeriod      = errors.New("period must be greater than zero")
+	ErrNotEnoughData      = errors.New("not enough data for period")
+	ErrMismatchedSeries   = errors.New("input series lengths do not match")
+	ErrInvalidMACDPeriods = errors.New("MACD fast period must be less than slow period")
+)
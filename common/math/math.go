@@ -1,15 +1,22 @@
 package math
 
-import "math"
+import (
+	"math"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+)
+
+// percent scales a percentage to a fraction without rounding
+var percent = decimal.New(1, -2)
 
 // CalculateAmountWithFee returns a calculated fee included amount on fee
-func CalculateAmountWithFee(amount, fee float64) float64 {
-	return amount + CalculateFee(amount, fee)
+func CalculateAmountWithFee(amount, fee decimal.Decimal) decimal.Decimal {
+	return amount.Add(CalculateFee(amount, fee))
 }
 
-// CalculateFee returns a simple fee on amount
-func CalculateFee(amount, fee float64) float64 {
-	return amount * (fee / 100)
+// CalculateFee returns a simple fee on amount, fee being a percentage
+func CalculateFee(amount, fee decimal.Decimal) decimal.Decimal {
+	return amount.Mul(fee).Mul(percent)
 }
 
 // CalculatePercentageGainOrLoss returns the percentage rise over a certain
@@ -25,8 +32,8 @@ func CalculatePercentageDifference(amount, secondAmount float64) float64 {
 }
 
 // CalculateNetProfit returns net profit
-func CalculateNetProfit(amount, priceThen, priceNow, costs float64) float64 {
-	return (priceNow * amount) - (priceThen * amount) - costs
+func CalculateNetProfit(amount, priceThen, priceNow, costs decimal.Decimal) decimal.Decimal {
+	return priceNow.Mul(amount).Sub(priceThen.Mul(amount)).Sub(costs)
 }
 
 // RoundFloat rounds your floating point number to the desired decimal place
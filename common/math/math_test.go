@@ -1,28 +1,32 @@
 package math
 
-import "testing"
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+)
 
 func TestCalculateFee(t *testing.T) {
 	t.Parallel()
-	originalInput := float64(1)
-	fee := float64(1)
-	expectedOutput := float64(0.01)
+	originalInput := decimal.NewFromInt(1)
+	fee := decimal.NewFromInt(1)
+	expectedOutput := decimal.NewFromFloat(0.01)
 	actualResult := CalculateFee(originalInput, fee)
-	if expectedOutput != actualResult {
+	if !expectedOutput.Equal(actualResult) {
 		t.Errorf(
-			"Expected '%f'. Actual '%f'.", expectedOutput, actualResult)
+			"Expected '%s'. Actual '%s'.", expectedOutput, actualResult)
 	}
 }
 
 func TestCalculateAmountWithFee(t *testing.T) {
 	t.Parallel()
-	originalInput := float64(1)
-	fee := float64(1)
-	expectedOutput := float64(1.01)
+	originalInput := decimal.NewFromInt(1)
+	fee := decimal.NewFromInt(1)
+	expectedOutput := decimal.NewFromFloat(1.01)
 	actualResult := CalculateAmountWithFee(originalInput, fee)
-	if expectedOutput != actualResult {
+	if !expectedOutput.Equal(actualResult) {
 		t.Errorf(
-			"Expected '%f'. Actual '%f'.", expectedOutput, actualResult)
+			"Expected '%s'. Actual '%s'.", expectedOutput, actualResult)
 	}
 }
 
@@ -52,15 +56,24 @@ func TestCalculatePercentageDifference(t *testing.T) {
 
 func TestCalculateNetProfit(t *testing.T) {
 	t.Parallel()
-	amount := float64(5)
-	priceThen := float64(1)
-	priceNow := float64(10)
-	costs := float64(1)
-	expectedOutput := float64(44)
+	amount := decimal.NewFromInt(5)
+	priceThen := decimal.NewFromInt(1)
+	priceNow := decimal.NewFromInt(10)
+	costs := decimal.NewFromInt(1)
+	expectedOutput := decimal.NewFromInt(44)
 	actualResult := CalculateNetProfit(amount, priceThen, priceNow, costs)
-	if expectedOutput != actualResult {
+	if !expectedOutput.Equal(actualResult) {
 		t.Errorf(
-			"Expected '%f'. Actual '%f'.", expectedOutput, actualResult)
+			"Expected '%s'. Actual '%s'.", expectedOutput, actualResult)
+	}
+
+	// Float64 maths would give 0.30000000000000004
+	actualResult = CalculateNetProfit(decimal.NewFromFloat(0.1),
+		decimal.Zero,
+		decimal.NewFromInt(3),
+		decimal.Zero)
+	if !actualResult.Equal(decimal.NewFromFloat(0.3)) {
+		t.Errorf("Expected '0.3'. Actual '%s'.", actualResult)
 	}
 }
 
@@ -1,13 +1,22 @@
 package base
 
 import (
+	"errors"
+	"fmt"
+	"strings"
 	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
 )
 
 // global vars contain staged update data that will be sent to the communication
 // mediums
 var (
 	ServiceStarted time.Time
+
+	// ErrNotConnected is returned when an event is sent to a medium which is
+	// not connected
+	ErrNotConnected = errors.New("communications medium not connected")
 )
 
 // Base enforces standard variables across communication packages
@@ -18,10 +27,57 @@ type Base struct {
 	Connected bool
 }
 
+// Event types used to categorise events for routing
+const (
+	EventTypeEvent     = "event"
+	EventTypeOrder     = "order"
+	EventTypeError     = "error"
+	EventTypePortfolio = "portfolio"
+	EventTypeSecurity  = "security"
+	EventTypeTransfer  = "transfer"
+	EventTypeAlert     = "alert"
+)
+
 // Event is a generalise event type
 type Event struct {
-	Type    string
-	Message string
+	Type     string
+	Message  string
+	Severity Severity
+	// IncidentKey identifies a critical condition, incident alerting mediums
+	// open an incident under this key and resolve it once an event with the
+	// same key is sent with Resolved set
+	IncidentKey string
+	Resolved    bool
+}
+
+// Severity defines the importance of an event, the zero value is
+// informational
+type Severity uint8
+
+// Severity levels
+const (
+	SeverityInfo Severity = iota
+	SeverityWarning
+	SeverityError
+	SeverityCritical
+)
+
+// Permission defines the level of access a user has to interactive commands
+type Permission uint8
+
+// Permission levels, each level inherits the access of the levels below it
+const (
+	PermissionNone Permission = iota
+	PermissionRead
+	PermissionTrade
+	PermissionAdmin
+)
+
+// DeliveryError holds an event which could not be delivered to a medium
+type DeliveryError struct {
+	Medium string
+	Event  Event
+	Err    error
 }
 
 // CommsStatus stores the status of a comms relayer
@@ -52,3 +108,59 @@ func (b *Base) GetStatus() string {
 	GoCryptoTrader Service: Online
 	Service Started: ` + ServiceStarted.String()
 }
+
+// String implements the stringer interface
+func (p Permission) String() string {
+	switch p {
+	case PermissionRead:
+		return config.CommsPermissionRead
+	case PermissionTrade:
+		return config.CommsPermissionTrade
+	case PermissionAdmin:
+		return config.CommsPermissionAdmin
+	}
+	return "none"
+}
+
+// GetPermission converts a config permission string to a permission level
+func GetPermission(p string) Permission {
+	switch p {
+	case config.CommsPermissionRead:
+		return PermissionRead
+	case config.CommsPermissionTrade:
+		return PermissionTrade
+	case config.CommsPermissionAdmin:
+		return PermissionAdmin
+	}
+	return PermissionNone
+}
+
+// String implements the stringer interface
+func (s Severity) String() string {
+	switch s {
+	case SeverityInfo:
+		return "info"
+	case SeverityWarning:
+		return "warning"
+	case SeverityError:
+		return "error"
+	case SeverityCritical:
+		return "critical"
+	}
+	return "unknown"
+}
+
+// GetSeverity converts a severity string to a severity level
+func GetSeverity(s string) (Severity, error) {
+	switch strings.ToLower(s) {
+	case "", "info":
+		return SeverityInfo, nil
+	case "warning":
+		return SeverityWarning, nil
+	case "error":
+		return SeverityError, nil
+	case "critical":
+		return SeverityCritical, nil
+	}
+	return SeverityInfo, fmt.Errorf("invalid severity %q", s)
+}
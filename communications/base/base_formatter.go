@@ -0,0 +1,103 @@
+package base
+
+import (
+	"bytes"
+	"strings"
+	"text/template"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// TemplateData is the data passed to message templates
+type TemplateData struct {
+	Type        string
+	Message     string
+	Severity    string
+	IncidentKey string
+	Resolved    bool
+	Medium      string
+	Timestamp   time.Time
+}
+
+var templateFuncs = template.FuncMap{
+	"upper": strings.ToUpper,
+	"lower": strings.ToLower,
+}
+
+// Formatter renders event messages using user defined templates per event
+// type and medium
+type Formatter struct {
+	templates map[string]*template.Template
+}
+
+// NewFormatter parses the supplied template configuration, a nil formatter is
+// returned if no templates are configured so messages are sent unchanged
+func NewFormatter(templates []config.CommsTemplate) (*Formatter, error) {
+	if len(templates) == 0 {
+		return nil, nil
+	}
+
+	f := &Formatter{templates: make(map[string]*template.Template)}
+	for i := range templates {
+		key := templateKey(templates[i].Medium, templates[i].EventType)
+		tmpl, err := template.New(key).Funcs(templateFuncs).Parse(templates[i].Template)
+		if err != nil {
+			return nil, err
+		}
+		f.templates[key] = tmpl
+		log.Debugf(log.CommunicationMgr, "Communications: Message template loaded for %s\n", key)
+	}
+	return f, nil
+}
+
+// templateKey returns the lookup key for a medium and event type, empty
+// values match all
+func templateKey(medium, eventType string) string {
+	if medium == "" {
+		medium = routeWildcard
+	}
+	if eventType == "" {
+		eventType = routeWildcard
+	}
+	return strings.ToLower(medium) + "/" + strings.ToLower(eventType)
+}
+
+// Format returns a copy of the event with its message rendered by the most
+// specific template for the medium and event type. Templates are matched in
+// the order medium and type, medium only, type only then the catch all. If no
+// template matches or rendering fails the event is returned unchanged
+func (f *Formatter) Format(medium string, event *Event) Event {
+	keys := []string{
+		templateKey(medium, event.Type),
+		templateKey(medium, routeWildcard),
+		templateKey(routeWildcard, event.Type),
+		templateKey(routeWildcard, routeWildcard),
+	}
+	for i := range keys {
+		tmpl, ok := f.templates[keys[i]]
+		if !ok {
+			continue
+		}
+		var buf bytes.Buffer
+		err := tmpl.Execute(&buf, TemplateData{
+			Type:        event.Type,
+			Message:     event.Message,
+			Severity:    event.Severity.String(),
+			IncidentKey: event.IncidentKey,
+			Resolved:    event.Resolved,
+			Medium:      medium,
+			Timestamp:   time.Now(),
+		})
+		if err != nil {
+			log.Errorf(log.CommunicationMgr, "Communications: Unable to render %s message template: %v\n",
+				keys[i], err)
+			return *event
+		}
+		formatted := *event
+		formatted.Message = buf.String()
+		return formatted
+	}
+	return *event
+}
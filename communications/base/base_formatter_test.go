@@ -0,0 +1,49 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestNewFormatter(t *testing.T) {
+	f, err := NewFormatter(nil)
+	if err != nil || f != nil {
+		t.Error("expected nil formatter with no templates")
+	}
+
+	_, err = NewFormatter([]config.CommsTemplate{{Template: "{{.Message"}})
+	if err == nil {
+		t.Error("expected error on invalid template")
+	}
+}
+
+func TestFormat(t *testing.T) {
+	f, err := NewFormatter([]config.CommsTemplate{
+		{EventType: "ORDER", Medium: "Slack", Template: "fill: {{.Message}}"},
+		{Medium: "slack", Template: "{{.Type | upper}} {{.Message}}"},
+		{EventType: EventTypeError, Template: "[{{.Severity | upper}}] {{.Message}} via {{.Medium}}"},
+		{EventType: EventTypePortfolio, Template: "{{.Missing}}"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		medium   string
+		event    Event
+		expected string
+	}{
+		{"Slack", Event{Type: EventTypeOrder, Message: "1 BTC"}, "fill: 1 BTC"},
+		{"Slack", Event{Type: EventTypeEvent, Message: "hi"}, "EVENT hi"},
+		{"SMTP", Event{Type: EventTypeError, Message: "down", Severity: SeverityCritical}, "[CRITICAL] down via SMTP"},
+		{"SMTP", Event{Type: EventTypeOrder, Message: "1 BTC"}, "1 BTC"},
+		{"SMTP", Event{Type: EventTypePortfolio, Message: "unchanged"}, "unchanged"},
+	}
+	for i := range testCases {
+		e := f.Format(testCases[i].medium, &testCases[i].event)
+		if e.Message != testCases[i].expected {
+			t.Errorf("test %d expected %q got %q", i, testCases[i].expected, e.Message)
+		}
+	}
+}
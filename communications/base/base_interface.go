@@ -2,9 +2,16 @@ package base
 
 import (
 	"errors"
+	"fmt"
+	"strings"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -21,6 +28,30 @@ type ICommunicate interface {
 	GetName() string
 }
 
+// Commander exposes engine functionality to communication mediums which
+// accept interactive commands from their users
+type Commander interface {
+	GetTicker(exchName string, p currency.Pair, a asset.Item) (*ticker.Price, error)
+	GetOpenOrders(exchName string) ([]order.Detail, error)
+	CancelOrder(exchName, orderID string) error
+	KillSwitch() error
+	// ApproveWithdrawal approves a withdrawal pending approval, recording who
+	// approved it
+	ApproveWithdrawal(id, approver string) error
+}
+
+// Interactive is implemented by communication mediums that can relay user
+// commands back to the engine
+type Interactive interface {
+	SetCommander(Commander)
+}
+
+// RetryQueue stores events which could not be delivered to a communication
+// medium so that delivery can be retried once the medium recovers
+type RetryQueue interface {
+	Enqueue(medium string, event Event, err error)
+}
+
 // Setup sets up communication variables and intiates a connection to the
 // communication mediums
 func (c IComm) Setup() {
@@ -39,13 +70,68 @@ func (c IComm) Setup() {
 
 // PushEvent pushes triggered events to all enabled communication links
 func (c IComm) PushEvent(event Event) {
+	c.PushFormattedEvent(event, nil, nil)
+}
+
+// PushEventToMediums pushes triggered events to the named enabled
+// communication links, names are matched case insensitively
+func (c IComm) PushEventToMediums(event Event, mediums []string) {
+	c.PushFormattedEvent(event, mediums, nil)
+}
+
+// PushFormattedEvent pushes triggered events to the named enabled
+// communication links, or all if no mediums are supplied, rendering the
+// message for each medium with the formatter when one is set. Events which
+// could not be delivered, including to disconnected mediums, are returned
+func (c IComm) PushFormattedEvent(event Event, mediums []string, f *Formatter) []DeliveryError {
+	var failed []DeliveryError
 	for i := range c {
-		if c[i].IsEnabled() && c[i].IsConnected() {
-			err := c[i].PushEvent(event)
-			if err != nil {
-				log.Errorf(log.CommunicationMgr, "Communications error - PushEvent() in package %s with %v. Err %s",
-					c[i].GetName(), event, err)
-			}
+		if len(mediums) > 0 &&
+			!common.StringDataCompareInsensitive(mediums, c[i].GetName()) {
+			continue
+		}
+		if !c[i].IsEnabled() {
+			continue
+		}
+		e := event
+		if f != nil {
+			e = f.Format(c[i].GetName(), &event)
+		}
+		if !c[i].IsConnected() {
+			failed = append(failed, DeliveryError{Medium: c[i].GetName(), Event: e, Err: ErrNotConnected})
+			continue
+		}
+		err := c[i].PushEvent(e)
+		if err != nil {
+			log.Errorf(log.CommunicationMgr, "Communications error - PushEvent() in package %s with %v. Err %s",
+				c[i].GetName(), e, err)
+			failed = append(failed, DeliveryError{Medium: c[i].GetName(), Event: e, Err: err})
+		}
+	}
+	return failed
+}
+
+// PushEventToMedium pushes an event unchanged to a single named communication
+// link and returns any delivery error
+func (c IComm) PushEventToMedium(medium string, event Event) error {
+	for i := range c {
+		if !strings.EqualFold(c[i].GetName(), medium) {
+			continue
+		}
+		if !c[i].IsEnabled() || !c[i].IsConnected() {
+			return ErrNotConnected
+		}
+		return c[i].PushEvent(event)
+	}
+	return fmt.Errorf("communications medium %s not found", medium)
+}
+
+// SetCommander passes the engine command handler to all interactive
+// communication mediums
+func (c IComm) SetCommander(cmd Commander) {
+	for i := range c {
+		if r, ok := c[i].(Interactive); ok {
+			r.SetCommander(cmd)
 		}
 	}
 }
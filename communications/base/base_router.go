@@ -0,0 +1,83 @@
+package base
+
+import (
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const routeWildcard = "*"
+
+// Route directs events matching its event types and minimum severity to a
+// set of communication mediums
+type Route struct {
+	Name        string
+	EventTypes  []string
+	Mediums     []string
+	MinSeverity Severity
+}
+
+// Router matches events against a list of routes
+type Router struct {
+	Routes []Route
+}
+
+// NewRouter returns a router for the supplied route configuration, a nil
+// router is returned if no routes are configured so events are broadcast to
+// every medium
+func NewRouter(routes []config.CommsRoute) (*Router, error) {
+	if len(routes) == 0 {
+		return nil, nil
+	}
+
+	r := &Router{}
+	for i := range routes {
+		sev, err := GetSeverity(routes[i].MinSeverity)
+		if err != nil {
+			return nil, err
+		}
+
+		route := Route{
+			Name:        routes[i].Name,
+			MinSeverity: sev,
+		}
+		for x := range routes[i].EventTypes {
+			route.EventTypes = append(route.EventTypes, strings.ToLower(routes[i].EventTypes[x]))
+		}
+		for x := range routes[i].Mediums {
+			route.Mediums = append(route.Mediums, strings.ToLower(routes[i].Mediums[x]))
+		}
+		r.Routes = append(r.Routes, route)
+		log.Debugf(log.CommunicationMgr, "Communications: Route %s. Event types: %v. Mediums: %v. Minimum severity: %s\n",
+			route.Name, route.EventTypes, route.Mediums, route.MinSeverity)
+	}
+	return r, nil
+}
+
+// Match returns the lower case names of all mediums an event should be sent
+// to
+func (r *Router) Match(event *Event) []string {
+	eventType := strings.ToLower(event.Type)
+	seen := make(map[string]struct{})
+	var mediums []string
+	for i := range r.Routes {
+		if event.Severity < r.Routes[i].MinSeverity {
+			continue
+		}
+		if len(r.Routes[i].EventTypes) > 0 &&
+			!common.StringDataCompare(r.Routes[i].EventTypes, eventType) &&
+			!common.StringDataCompare(r.Routes[i].EventTypes, routeWildcard) {
+			continue
+		}
+		for x := range r.Routes[i].Mediums {
+			if _, ok := seen[r.Routes[i].Mediums[x]]; ok {
+				continue
+			}
+			seen[r.Routes[i].Mediums[x]] = struct{}{}
+			mediums = append(mediums, r.Routes[i].Mediums[x])
+		}
+	}
+	return mediums
+}
@@ -0,0 +1,69 @@
+package base
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestNewRouter(t *testing.T) {
+	r, err := NewRouter(nil)
+	if err != nil || r != nil {
+		t.Error("expected nil router with no routes")
+	}
+
+	_, err = NewRouter([]config.CommsRoute{{Name: "bad", MinSeverity: "loud"}})
+	if err == nil {
+		t.Error("expected error on invalid severity")
+	}
+}
+
+func TestRouterMatch(t *testing.T) {
+	r, err := NewRouter([]config.CommsRoute{
+		{Name: "fills", EventTypes: []string{"ORDER"}, Mediums: []string{"Slack"}},
+		{Name: "errors", EventTypes: []string{"*"}, Mediums: []string{"Telegram", "slack"}, MinSeverity: "error"},
+		{Name: "security", EventTypes: []string{EventTypeSecurity}, Mediums: []string{"SMTP"}, MinSeverity: "warning"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testCases := []struct {
+		event    Event
+		expected []string
+	}{
+		{Event{Type: EventTypeOrder}, []string{"slack"}},
+		{Event{Type: EventTypeOrder, Severity: SeverityCritical}, []string{"slack", "telegram"}},
+		{Event{Type: EventTypeSecurity}, nil},
+		{Event{Type: EventTypeSecurity, Severity: SeverityWarning}, []string{"smtp"}},
+		{Event{Type: EventTypePortfolio}, nil},
+	}
+	for i := range testCases {
+		mediums := r.Match(&testCases[i].event)
+		if len(mediums) != len(testCases[i].expected) {
+			t.Fatalf("%s %s: expected %v got %v",
+				testCases[i].event.Type,
+				testCases[i].event.Severity,
+				testCases[i].expected,
+				mediums)
+		}
+		for x := range mediums {
+			if mediums[x] != testCases[i].expected[x] {
+				t.Errorf("expected %v got %v", testCases[i].expected, mediums)
+			}
+		}
+	}
+}
+
+func TestPushEventToMediums(t *testing.T) {
+	p := &CommunicationProvider{isEnabled: true, isConnected: true}
+	ic := IComm{p}
+	ic.PushEventToMediums(Event{}, []string{"telegram"})
+	if p.PushEventCalled {
+		t.Error("event should not be pushed to unmatched medium")
+	}
+	ic.PushEventToMediums(Event{}, []string{"SOMETESTPROVIDER"})
+	if !p.PushEventCalled {
+		t.Error("event should be pushed to matched medium")
+	}
+}
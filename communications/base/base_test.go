@@ -127,3 +127,42 @@ func TestPushEvent(t *testing.T) {
 		}
 	}
 }
+
+func TestPushFormattedEvent(t *testing.T) {
+	ic := IComm{
+		&CommunicationProvider{isEnabled: true, isConnected: true},
+		&CommunicationProvider{isEnabled: true},
+		&CommunicationProvider{isConnected: true},
+	}
+
+	failed := ic.PushFormattedEvent(Event{Type: EventTypeOrder}, nil, nil)
+	if len(failed) != 1 {
+		t.Fatalf("expected 1 failed delivery, got %d", len(failed))
+	}
+	if failed[0].Err != ErrNotConnected || failed[0].Medium != "someTestProvider" {
+		t.Errorf("unexpected delivery error %+v", failed[0])
+	}
+
+	failed = ic.PushFormattedEvent(Event{}, []string{"otherProvider"}, nil)
+	if len(failed) != 0 {
+		t.Error("mediums not listed should not be sent to")
+	}
+}
+
+func TestPushEventToMedium(t *testing.T) {
+	p := &CommunicationProvider{isEnabled: true, isConnected: true}
+	ic := IComm{p}
+	if err := ic.PushEventToMedium("SOMETESTPROVIDER", Event{}); err != nil {
+		t.Error(err)
+	}
+	if !p.PushEventCalled {
+		t.Error("expected medium to be sent the event")
+	}
+	if err := ic.PushEventToMedium("missing", Event{}); err == nil {
+		t.Error("expected error on unknown medium")
+	}
+	p.isConnected = false
+	if err := ic.PushEventToMedium("someTestProvider", Event{}); err != ErrNotConnected {
+		t.Errorf("expected %v got %v", ErrNotConnected, err)
+	}
+}
@@ -0,0 +1,161 @@
+package base
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const rateLimitWindow = time.Minute
+
+// Coalescer suppresses identical events sent within a window, reporting how
+// many times they repeated once the window expires
+type Coalescer struct {
+	Window     time.Duration
+	EventTypes []string
+
+	mtx  sync.Mutex
+	seen map[string]*coalescedEvent
+}
+
+type coalescedEvent struct {
+	event      Event
+	start      time.Time
+	suppressed int
+}
+
+// NewCoalescer returns a coalescer for the supplied window and event types, a
+// nil coalescer is returned if the window is not set
+func NewCoalescer(window time.Duration, eventTypes []string) *Coalescer {
+	if window <= 0 {
+		return nil
+	}
+	c := &Coalescer{
+		Window: window,
+		seen:   make(map[string]*coalescedEvent),
+	}
+	for i := range eventTypes {
+		c.EventTypes = append(c.EventTypes, strings.ToLower(eventTypes[i]))
+	}
+	return c
+}
+
+// Check returns whether an event should be sent, the first occurrence of an
+// event within a window is always sent and repeats are counted
+func (c *Coalescer) Check(event *Event, now time.Time) bool {
+	if event.IncidentKey != "" {
+		return true
+	}
+	eventType := strings.ToLower(event.Type)
+	if len(c.EventTypes) > 0 &&
+		!common.StringDataCompare(c.EventTypes, eventType) &&
+		!common.StringDataCompare(c.EventTypes, routeWildcard) {
+		return true
+	}
+
+	key := eventType + ":" + event.Message
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if e, ok := c.seen[key]; ok && now.Sub(e.start) < c.Window {
+		e.suppressed++
+		return false
+	}
+	c.seen[key] = &coalescedEvent{event: *event, start: now}
+	return true
+}
+
+// Flush returns a summary event for each expired window in which repeats were
+// suppressed
+func (c *Coalescer) Flush(now time.Time) []Event {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	var summaries []Event
+	for k, e := range c.seen {
+		if now.Sub(e.start) < c.Window {
+			continue
+		}
+		if e.suppressed > 0 {
+			summary := e.event
+			summary.Message = fmt.Sprintf("%s ×%d in the last %s",
+				e.event.Message, e.suppressed+1, c.Window)
+			summaries = append(summaries, summary)
+		}
+		delete(c.seen, k)
+	}
+	return summaries
+}
+
+// RateLimiter caps the amount of events sent to each medium per minute
+type RateLimiter struct {
+	DefaultLimit int
+	Limits       map[string]int
+
+	mtx     sync.Mutex
+	windows map[string]*rateWindow
+}
+
+type rateWindow struct {
+	start      time.Time
+	sent       int
+	suppressed int
+}
+
+// NewRateLimiter returns a rate limiter for the supplied configuration, a nil
+// rate limiter is returned if no limits are set
+func NewRateLimiter(cfg *config.CommsThrottleConfig) *RateLimiter {
+	if cfg.MaxEventsPerMinute <= 0 && len(cfg.MediumLimits) == 0 {
+		return nil
+	}
+	r := &RateLimiter{
+		DefaultLimit: cfg.MaxEventsPerMinute,
+		Limits:       make(map[string]int),
+		windows:      make(map[string]*rateWindow),
+	}
+	for i := range cfg.MediumLimits {
+		r.Limits[strings.ToLower(cfg.MediumLimits[i].Medium)] = cfg.MediumLimits[i].MaxEventsPerMinute
+	}
+	return r
+}
+
+// Allow returns whether an event can be sent to a medium without exceeding its
+// limit. Incident events are always allowed
+func (r *RateLimiter) Allow(medium string, event *Event, now time.Time) bool {
+	if event.IncidentKey != "" {
+		return true
+	}
+	medium = strings.ToLower(medium)
+	limit, ok := r.Limits[medium]
+	if !ok {
+		limit = r.DefaultLimit
+	}
+	if limit <= 0 {
+		return true
+	}
+
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	w, ok := r.windows[medium]
+	if !ok || now.Sub(w.start) >= rateLimitWindow {
+		if ok && w.suppressed > 0 {
+			log.Warnf(log.CommunicationMgr, "Communications: %d events to %s were suppressed by its rate limit of %d per minute\n",
+				w.suppressed, medium, limit)
+		}
+		w = &rateWindow{start: now}
+		r.windows[medium] = w
+	}
+	if w.sent >= limit {
+		if w.suppressed == 0 {
+			log.Warnf(log.CommunicationMgr, "Communications: %s rate limit of %d events per minute reached, suppressing events\n",
+				medium, limit)
+		}
+		w.suppressed++
+		return false
+	}
+	w.sent++
+	return true
+}
@@ -0,0 +1,89 @@
+package base
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestCoalescer(t *testing.T) {
+	if NewCoalescer(0, nil) != nil {
+		t.Error("expected nil coalescer with no window")
+	}
+
+	c := NewCoalescer(time.Minute, []string{"EVENT"})
+	now := time.Now()
+	event := Event{Type: EventTypeEvent, Message: "websocket reconnected"}
+	if !c.Check(&event, now) {
+		t.Error("first event should be sent")
+	}
+	for i := 0; i < 36; i++ {
+		if c.Check(&event, now.Add(time.Second)) {
+			t.Fatal("repeated event should be suppressed")
+		}
+	}
+	if !c.Check(&Event{Type: EventTypeOrder, Message: "websocket reconnected"}, now) {
+		t.Error("events types not configured should not be coalesced")
+	}
+	if !c.Check(&Event{Type: EventTypeEvent, Message: "down", IncidentKey: "db"}, now) {
+		t.Error("incident events should not be coalesced")
+	}
+
+	if len(c.Flush(now.Add(time.Second))) != 0 {
+		t.Error("summary should not be sent before the window expires")
+	}
+	summaries := c.Flush(now.Add(time.Minute))
+	if len(summaries) != 1 {
+		t.Fatalf("expected 1 summary, got %d", len(summaries))
+	}
+	if summaries[0].Message != "websocket reconnected ×37 in the last 1m0s" {
+		t.Errorf("unexpected summary %s", summaries[0].Message)
+	}
+	if !c.Check(&event, now.Add(time.Minute)) {
+		t.Error("event should be sent once the window has expired")
+	}
+}
+
+func TestRateLimiter(t *testing.T) {
+	if NewRateLimiter(&config.CommsThrottleConfig{}) != nil {
+		t.Error("expected nil rate limiter with no limits")
+	}
+
+	r := NewRateLimiter(&config.CommsThrottleConfig{
+		MaxEventsPerMinute: 2,
+		MediumLimits: []config.CommsRateLimit{
+			{Medium: "Telegram", MaxEventsPerMinute: 1},
+			{Medium: "SMTP"},
+		},
+	})
+	now := time.Now()
+	event := Event{Type: EventTypeEvent, Message: "hi"}
+	testCases := []struct {
+		medium  string
+		allowed int
+	}{
+		{"telegram", 1},
+		{"Slack", 2},
+		{"SMTP", 5},
+	}
+	for i := range testCases {
+		var allowed int
+		for j := 0; j < 5; j++ {
+			if r.Allow(testCases[i].medium, &event, now) {
+				allowed++
+			}
+		}
+		if allowed != testCases[i].allowed {
+			t.Errorf("%s: expected %d allowed events, got %d",
+				testCases[i].medium, testCases[i].allowed, allowed)
+		}
+	}
+
+	if !r.Allow("Telegram", &Event{IncidentKey: "db"}, now) {
+		t.Error("incident events should not be rate limited")
+	}
+	if !r.Allow("Telegram", &event, now.Add(time.Minute)) {
+		t.Error("event should be allowed once the window has expired")
+	}
+}
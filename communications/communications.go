@@ -2,18 +2,31 @@ package communications
 
 import (
 	"errors"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/communications/opsgenie"
+	"github.com/thrasher-corp/gocryptotrader/communications/pagerduty"
+	"github.com/thrasher-corp/gocryptotrader/communications/pushbullet"
+	"github.com/thrasher-corp/gocryptotrader/communications/pushover"
+	"github.com/thrasher-corp/gocryptotrader/communications/signal"
 	"github.com/thrasher-corp/gocryptotrader/communications/slack"
-	"github.com/thrasher-corp/gocryptotrader/communications/smsglobal"
+	"github.com/thrasher-corp/gocryptotrader/communications/sms"
 	"github.com/thrasher-corp/gocryptotrader/communications/smtpservice"
 	"github.com/thrasher-corp/gocryptotrader/communications/telegram"
+	"github.com/thrasher-corp/gocryptotrader/communications/webhook"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 // Communications is the overarching type across the communications packages
 type Communications struct {
 	base.IComm
+	router     *base.Router
+	formatter  *base.Formatter
+	coalescer  *base.Coalescer
+	limiter    *base.RateLimiter
+	retryQueue base.RetryQueue
 }
 
 // NewComm sets up and returns a pointer to a Communications object
@@ -22,7 +35,22 @@ func NewComm(cfg *config.CommunicationsConfig) (*Communications, error) {
 		return nil, errors.New("no communication relayers enabled")
 	}
 
-	var comm Communications
+	router, err := base.NewRouter(cfg.Routes)
+	if err != nil {
+		return nil, err
+	}
+
+	formatter, err := base.NewFormatter(cfg.Templates)
+	if err != nil {
+		return nil, err
+	}
+
+	comm := Communications{
+		router:    router,
+		formatter: formatter,
+		coalescer: base.NewCoalescer(cfg.Throttle.CoalesceWindow, cfg.Throttle.CoalesceEventTypes),
+		limiter:   base.NewRateLimiter(&cfg.Throttle),
+	}
 	if cfg.TelegramConfig.Enabled {
 		Telegram := new(telegram.Telegram)
 		Telegram.Setup(cfg)
@@ -30,9 +58,9 @@ func NewComm(cfg *config.CommunicationsConfig) (*Communications, error) {
 	}
 
 	if cfg.SMSGlobalConfig.Enabled {
-		SMSGlobal := new(smsglobal.SMSGlobal)
-		SMSGlobal.Setup(cfg)
-		comm.IComm = append(comm.IComm, SMSGlobal)
+		SMS := new(sms.SMS)
+		SMS.Setup(cfg)
+		comm.IComm = append(comm.IComm, SMS)
 	}
 
 	if cfg.SMTPConfig.Enabled {
@@ -47,6 +75,111 @@ func NewComm(cfg *config.CommunicationsConfig) (*Communications, error) {
 		comm.IComm = append(comm.IComm, Slack)
 	}
 
+	if cfg.WebhookConfig.Enabled {
+		Webhook := new(webhook.Webhook)
+		Webhook.Setup(cfg)
+		comm.IComm = append(comm.IComm, Webhook)
+	}
+
+	if cfg.PushoverConfig.Enabled {
+		Pushover := new(pushover.Pushover)
+		Pushover.Setup(cfg)
+		comm.IComm = append(comm.IComm, Pushover)
+	}
+
+	if cfg.PushbulletConfig.Enabled {
+		Pushbullet := new(pushbullet.Pushbullet)
+		Pushbullet.Setup(cfg)
+		comm.IComm = append(comm.IComm, Pushbullet)
+	}
+
+	if cfg.PagerDutyConfig.Enabled {
+		PagerDuty := new(pagerduty.PagerDuty)
+		PagerDuty.Setup(cfg)
+		comm.IComm = append(comm.IComm, PagerDuty)
+	}
+
+	if cfg.OpsgenieConfig.Enabled {
+		Opsgenie := new(opsgenie.Opsgenie)
+		Opsgenie.Setup(cfg)
+		comm.IComm = append(comm.IComm, Opsgenie)
+	}
+
+	if cfg.SignalConfig.Enabled {
+		Signal := new(signal.Signal)
+		Signal.Setup(cfg)
+		comm.IComm = append(comm.IComm, Signal)
+	}
+
 	comm.Setup()
 	return &comm, nil
 }
+
+// SetRetryQueue sets the queue undeliverable events are stored in for later
+// redelivery
+func (c *Communications) SetRetryQueue(q base.RetryQueue) {
+	c.retryQueue = q
+}
+
+// PushEvent pushes an event to the communication mediums matched by the
+// configured routes, or to all mediums if no routes are configured. Repeated
+// events are coalesced and mediums over their rate limit are skipped.
+// Messages are rendered with the configured templates before being sent and
+// events which fail to send are added to the retry queue when one is set
+func (c *Communications) PushEvent(event base.Event) {
+	if c.coalescer != nil && !c.coalescer.Check(&event, time.Now()) {
+		return
+	}
+	c.push(&event)
+}
+
+// FlushCoalesced sends a summary of events which were coalesced in windows
+// that have since expired
+func (c *Communications) FlushCoalesced() {
+	if c.coalescer == nil {
+		return
+	}
+	summaries := c.coalescer.Flush(time.Now())
+	for i := range summaries {
+		c.push(&summaries[i])
+	}
+}
+
+func (c *Communications) push(event *base.Event) {
+	var mediums []string
+	if c.router != nil {
+		mediums = c.router.Match(event)
+		if len(mediums) == 0 {
+			log.Debugf(log.CommunicationMgr, "Communications: No route matched %s event with severity %s, dropping.\n",
+				event.Type, event.Severity)
+			return
+		}
+	}
+
+	if c.limiter != nil {
+		if mediums == nil {
+			for i := range c.IComm {
+				mediums = append(mediums, c.IComm[i].GetName())
+			}
+		}
+		var allowed []string
+		now := time.Now()
+		for i := range mediums {
+			if c.limiter.Allow(mediums[i], event, now) {
+				allowed = append(allowed, mediums[i])
+			}
+		}
+		if len(allowed) == 0 {
+			return
+		}
+		mediums = allowed
+	}
+
+	failed := c.IComm.PushFormattedEvent(*event, mediums, c.formatter)
+	if c.retryQueue == nil {
+		return
+	}
+	for i := range failed {
+		c.retryQueue.Enqueue(failed[i].Medium, failed[i].Event, failed[i].Err)
+	}
+}
@@ -2,7 +2,9 @@ package communications
 
 import (
 	"testing"
+	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
 )
 
@@ -17,13 +19,89 @@ func TestNewComm(t *testing.T) {
 	cfg.SMSGlobalConfig.Enabled = true
 	cfg.SMTPConfig.Enabled = true
 	cfg.SlackConfig.Enabled = true
+	cfg.WebhookConfig.Enabled = true
+	cfg.PushoverConfig.Enabled = true
+	cfg.PushbulletConfig.Enabled = true
+	cfg.PagerDutyConfig.Enabled = true
+	cfg.OpsgenieConfig.Enabled = true
+	cfg.SignalConfig.Enabled = true
 	communications, err := NewComm(&cfg)
 	if err != nil {
 		t.Error("Unexpected result")
 	}
 
-	if len(communications.IComm) != 4 {
-		t.Errorf("communications NewComm, expected len 4, got len %d",
+	if len(communications.IComm) != 10 {
+		t.Errorf("communications NewComm, expected len 10, got len %d",
 			len(communications.IComm))
 	}
 }
+
+func TestNewCommInvalidRoute(t *testing.T) {
+	cfg := config.CommunicationsConfig{
+		SMTPConfig: config.SMTPConfig{Enabled: true},
+		Routes: []config.CommsRoute{
+			{Name: "bad", MinSeverity: "loud"},
+		},
+	}
+	_, err := NewComm(&cfg)
+	if err == nil {
+		t.Error("NewComm should fail on an invalid route severity")
+	}
+}
+
+type testRetryQueue struct {
+	mediums []string
+}
+
+func (q *testRetryQueue) Enqueue(medium string, event base.Event, err error) {
+	q.mediums = append(q.mediums, medium)
+}
+
+func TestPushEventRetryQueue(t *testing.T) {
+	cfg := config.CommunicationsConfig{
+		SMTPConfig:  config.SMTPConfig{Name: "SMTP", Enabled: true},
+		SlackConfig: config.SlackConfig{Name: "Slack", Enabled: true},
+	}
+	comms, err := NewComm(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := &testRetryQueue{}
+	comms.SetRetryQueue(q)
+
+	// Neither medium is configured to deliver so both events should be queued
+	comms.PushEvent(base.Event{Type: base.EventTypeOrder, Message: "filled"})
+	if len(q.mediums) != 2 {
+		t.Errorf("expected 2 queued deliveries, got %v", q.mediums)
+	}
+}
+
+func TestPushEventThrottle(t *testing.T) {
+	cfg := config.CommunicationsConfig{
+		SMTPConfig:  config.SMTPConfig{Name: "SMTP", Enabled: true},
+		SlackConfig: config.SlackConfig{Name: "Slack", Enabled: true},
+		Throttle: config.CommsThrottleConfig{
+			CoalesceWindow: time.Minute,
+			MediumLimits: []config.CommsRateLimit{
+				{Medium: "Slack", MaxEventsPerMinute: 1},
+			},
+		},
+	}
+	comms, err := NewComm(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	q := &testRetryQueue{}
+	comms.SetRetryQueue(q)
+
+	comms.PushEvent(base.Event{Type: base.EventTypeEvent, Message: "reconnected"})
+	comms.PushEvent(base.Event{Type: base.EventTypeEvent, Message: "reconnected"})
+	if len(q.mediums) != 2 {
+		t.Errorf("expected repeated event to be coalesced, got %v", q.mediums)
+	}
+
+	comms.PushEvent(base.Event{Type: base.EventTypeEvent, Message: "disconnected"})
+	if len(q.mediums) != 3 || q.mediums[2] != "SMTP" {
+		t.Errorf("expected Slack to be rate limited, got %v", q.mediums)
+	}
+}
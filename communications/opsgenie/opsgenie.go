@@ -0,0 +1,143 @@
+// Package opsgenie creates and closes Opsgenie alerts via the Alert API
+// https://docs.opsgenie.com/docs/alert-api
+package opsgenie
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	source = "gocryptotrader"
+
+	// defaultAPIURL is the US instance, EU accounts need to set
+	// https://api.eu.opsgenie.com in their config
+	defaultAPIURL = "https://api.opsgenie.com"
+	alertsPath    = "/v2/alerts"
+	closePath     = "/close?identifierType=alias"
+
+	// messageLimit is the maximum alert message length accepted by Opsgenie
+	messageLimit = 130
+)
+
+// Opsgenie is the overarching type across this package
+type Opsgenie struct {
+	base.Base
+	APIKey string
+	APIURL string
+}
+
+// Setup takes in an Opsgenie configuration and sets the API key and URL
+func (o *Opsgenie) Setup(cfg *config.CommunicationsConfig) {
+	o.Name = cfg.OpsgenieConfig.Name
+	o.Enabled = cfg.OpsgenieConfig.Enabled
+	o.Verbose = cfg.OpsgenieConfig.Verbose
+	o.APIKey = cfg.OpsgenieConfig.APIKey
+	o.APIURL = cfg.OpsgenieConfig.APIURL
+	if o.APIURL == "" {
+		o.APIURL = defaultAPIURL
+	}
+}
+
+// IsConnected returns whether or not the connection is connected
+func (o *Opsgenie) IsConnected() bool {
+	return o.Connected
+}
+
+// Connect connects to the service
+func (o *Opsgenie) Connect() error {
+	o.Connected = true
+	return nil
+}
+
+// PushEvent creates or closes an alert, events without an incident key are
+// chat notifications and are ignored
+func (o *Opsgenie) PushEvent(event base.Event) error {
+	if event.IncidentKey == "" {
+		return nil
+	}
+	if event.Resolved {
+		return o.CloseAlert(event.IncidentKey, event.Message)
+	}
+	return o.CreateAlert(event.IncidentKey, event.Type, event.Message, GetPriority(event.Severity))
+}
+
+// GetPriority maps an event severity to an Opsgenie priority
+func GetPriority(s base.Severity) string {
+	switch s {
+	case base.SeverityWarning:
+		return PriorityModerate
+	case base.SeverityError:
+		return PriorityHigh
+	case base.SeverityCritical:
+		return PriorityCritical
+	}
+	return PriorityLow
+}
+
+// CreateAlert opens an alert, Opsgenie deduplicates open alerts sharing an
+// alias
+func (o *Opsgenie) CreateAlert(alias, eventType, message, priority string) error {
+	if message == "" {
+		return errors.New("opsgenie CreateAlert() message is empty")
+	}
+	alert := Alert{
+		Message:     message,
+		Alias:       alias,
+		Description: "GoCryptoTrader " + eventType + ": " + message,
+		Priority:    priority,
+		Source:      source,
+	}
+	if len(alert.Message) > messageLimit {
+		alert.Message = alert.Message[:messageLimit]
+	}
+	return o.sendRequest(o.APIURL+alertsPath, &alert)
+}
+
+// CloseAlert closes the open alert matching the alias
+func (o *Opsgenie) CloseAlert(alias, note string) error {
+	return o.sendRequest(o.APIURL+alertsPath+"/"+url.PathEscape(alias)+closePath,
+		&CloseRequest{Source: source, Note: note})
+}
+
+func (o *Opsgenie) sendRequest(path string, body interface{}) error {
+	payload, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	if o.Verbose {
+		log.Debugf(log.CommunicationMgr, "Opsgenie: Sending request to %s. Body: %s\n",
+			path, payload)
+	}
+
+	resp, err := common.SendHTTPRequest(http.MethodPost,
+		path,
+		map[string]string{
+			"Content-Type":  "application/json",
+			"Authorization": "GenieKey " + o.APIKey,
+		},
+		strings.NewReader(string(payload)))
+	if err != nil {
+		return err
+	}
+
+	var result Response
+	err = json.Unmarshal([]byte(resp), &result)
+	if err != nil {
+		return err
+	}
+
+	if result.Result == "" {
+		return errors.New("opsgenie request not accepted: " + result.Message)
+	}
+	return nil
+}
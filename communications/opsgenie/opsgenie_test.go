@@ -0,0 +1,88 @@
+package opsgenie
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commsCfg := cfg.GetCommunicationsConfig()
+	var o Opsgenie
+	o.Setup(&commsCfg)
+	if o.Name != "Opsgenie" || o.Enabled || o.APIKey != "key" || o.APIURL != defaultAPIURL {
+		t.Error("opsgenie Setup() error, unexpected setup values",
+			o.Name,
+			o.Enabled,
+			o.APIKey,
+			o.APIURL)
+	}
+}
+
+func TestGetPriority(t *testing.T) {
+	if GetPriority(base.SeverityInfo) != PriorityLow ||
+		GetPriority(base.SeverityWarning) != PriorityModerate ||
+		GetPriority(base.SeverityError) != PriorityHigh ||
+		GetPriority(base.SeverityCritical) != PriorityCritical {
+		t.Error("opsgenie GetPriority() unexpected mapping")
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	var paths []string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "GenieKey key" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"message":"Key format is not valid!","took":0.001,"requestId":"1"}`))
+			return
+		}
+		paths = append(paths, r.URL.RequestURI())
+		w.WriteHeader(http.StatusAccepted)
+		_, _ = w.Write([]byte(`{"result":"Request will be processed","took":0.302,"requestId":"1"}`))
+	}))
+	defer srv.Close()
+
+	o := Opsgenie{APIKey: "key", APIURL: srv.URL}
+	err := o.PushEvent(base.Event{Type: "error", Message: "hello"})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(paths) != 0 {
+		t.Error("events without an incident key should be ignored")
+	}
+
+	event := base.Event{
+		Type:        "error",
+		Message:     "database down",
+		Severity:    base.SeverityCritical,
+		IncidentKey: "database",
+	}
+	err = o.PushEvent(event)
+	if err != nil {
+		t.Error(err)
+	}
+	event.Resolved = true
+	err = o.PushEvent(event)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(paths) != 2 ||
+		paths[0] != alertsPath ||
+		paths[1] != alertsPath+"/database"+closePath {
+		t.Errorf("unexpected requests %v", paths)
+	}
+
+	o.APIKey = "bad"
+	err = o.PushEvent(event)
+	if err == nil {
+		t.Error("expected error on invalid api key")
+	}
+}
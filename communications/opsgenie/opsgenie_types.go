@@ -0,0 +1,34 @@
+package opsgenie
+
+// Alert priorities as defined by the Opsgenie Alert API
+const (
+	PriorityCritical      = "P1"
+	PriorityHigh          = "P2"
+	PriorityModerate      = "P3"
+	PriorityLow           = "P4"
+	PriorityInformational = "P5"
+)
+
+// Alert is the Opsgenie create alert request body
+type Alert struct {
+	Message     string `json:"message"`
+	Alias       string `json:"alias"`
+	Description string `json:"description,omitempty"`
+	Priority    string `json:"priority"`
+	Source      string `json:"source"`
+}
+
+// CloseRequest is the Opsgenie close alert request body
+type CloseRequest struct {
+	Source string `json:"source"`
+	Note   string `json:"note,omitempty"`
+}
+
+// Response holds the Opsgenie Alert API response, requests are processed
+// asynchronously so a result only indicates the request was accepted
+type Response struct {
+	Result    string  `json:"result"`
+	Message   string  `json:"message"`
+	Took      float64 `json:"took"`
+	RequestID string  `json:"requestId"`
+}
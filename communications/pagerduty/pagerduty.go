@@ -0,0 +1,133 @@
+// Package pagerduty opens and resolves PagerDuty incidents via the Events API
+// v2 https://developer.pagerduty.com/docs/events-api-v2/overview/
+package pagerduty
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	source        = "gocryptotrader"
+	statusSuccess = "success"
+)
+
+var apiURL = "https://events.pagerduty.com/v2/enqueue"
+
+// PagerDuty is the overarching type across this package
+type PagerDuty struct {
+	base.Base
+	RoutingKey string
+}
+
+// Setup takes in a PagerDuty configuration and sets the integration routing
+// key
+func (p *PagerDuty) Setup(cfg *config.CommunicationsConfig) {
+	p.Name = cfg.PagerDutyConfig.Name
+	p.Enabled = cfg.PagerDutyConfig.Enabled
+	p.Verbose = cfg.PagerDutyConfig.Verbose
+	p.RoutingKey = cfg.PagerDutyConfig.RoutingKey
+}
+
+// IsConnected returns whether or not the connection is connected
+func (p *PagerDuty) IsConnected() bool {
+	return p.Connected
+}
+
+// Connect connects to the service
+func (p *PagerDuty) Connect() error {
+	p.Connected = true
+	return nil
+}
+
+// PushEvent triggers or resolves an incident, events without an incident key
+// are chat notifications and are ignored
+func (p *PagerDuty) PushEvent(event base.Event) error {
+	if event.IncidentKey == "" {
+		return nil
+	}
+	if event.Resolved {
+		return p.Resolve(event.IncidentKey)
+	}
+	return p.Trigger(event.IncidentKey, event.Type, event.Message, GetSeverity(event.Severity))
+}
+
+// GetSeverity maps an event severity to a PagerDuty severity
+func GetSeverity(s base.Severity) string {
+	switch s {
+	case base.SeverityWarning:
+		return SeverityWarning
+	case base.SeverityError:
+		return SeverityError
+	case base.SeverityCritical:
+		return SeverityCritical
+	}
+	return SeverityInfo
+}
+
+// Trigger opens an incident, PagerDuty groups triggers sharing a dedup key
+// into the same open incident
+func (p *PagerDuty) Trigger(dedupKey, class, summary, severity string) error {
+	if summary == "" {
+		return errors.New("pagerduty Trigger() summary is empty")
+	}
+	return p.SendEvent(&Event{
+		RoutingKey:  p.RoutingKey,
+		EventAction: ActionTrigger,
+		DedupKey:    dedupKey,
+		Payload: &Payload{
+			Summary:  summary,
+			Source:   source,
+			Severity: severity,
+			Class:    class,
+		},
+	})
+}
+
+// Resolve resolves the open incident matching the dedup key
+func (p *PagerDuty) Resolve(dedupKey string) error {
+	return p.SendEvent(&Event{
+		RoutingKey:  p.RoutingKey,
+		EventAction: ActionResolve,
+		DedupKey:    dedupKey,
+	})
+}
+
+// SendEvent sends an event to the PagerDuty Events API
+func (p *PagerDuty) SendEvent(e *Event) error {
+	payload, err := json.Marshal(e)
+	if err != nil {
+		return err
+	}
+
+	if p.Verbose {
+		log.Debugf(log.CommunicationMgr, "PagerDuty: Sending %s event. Dedup key: %s\n",
+			e.EventAction, e.DedupKey)
+	}
+
+	resp, err := common.SendHTTPRequest(http.MethodPost,
+		apiURL,
+		map[string]string{"Content-Type": "application/json"},
+		strings.NewReader(string(payload)))
+	if err != nil {
+		return err
+	}
+
+	var result Response
+	err = json.Unmarshal([]byte(resp), &result)
+	if err != nil {
+		return err
+	}
+
+	if result.Status != statusSuccess {
+		return errors.New("pagerduty event not sent: " + result.Message + " " + strings.Join(result.Errors, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,95 @@
+package pagerduty
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commsCfg := cfg.GetCommunicationsConfig()
+	var p PagerDuty
+	p.Setup(&commsCfg)
+	if p.Name != "PagerDuty" || p.Enabled || p.RoutingKey != "key" {
+		t.Error("pagerduty Setup() error, unexpected setup values",
+			p.Name,
+			p.Enabled,
+			p.RoutingKey)
+	}
+}
+
+func TestGetSeverity(t *testing.T) {
+	if GetSeverity(base.SeverityInfo) != SeverityInfo ||
+		GetSeverity(base.SeverityWarning) != SeverityWarning ||
+		GetSeverity(base.SeverityError) != SeverityError ||
+		GetSeverity(base.SeverityCritical) != SeverityCritical {
+		t.Error("pagerduty GetSeverity() unexpected mapping")
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	var received []Event
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var e Event
+		if err := json.NewDecoder(r.Body).Decode(&e); err != nil {
+			t.Fatal(err)
+		}
+		if e.RoutingKey == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":"invalid event","message":"Event object is invalid","errors":["Length of 'routing_key' is incorrect"]}`))
+			return
+		}
+		received = append(received, e)
+		_, _ = w.Write([]byte(`{"status":"success","message":"Event processed","dedup_key":"` + e.DedupKey + `"}`))
+	}))
+	defer srv.Close()
+	apiURL = srv.URL
+
+	p := PagerDuty{RoutingKey: "key"}
+	err := p.PushEvent(base.Event{Type: "error", Message: "hello"})
+	if err != nil {
+		t.Error(err)
+	}
+	if len(received) != 0 {
+		t.Error("events without an incident key should be ignored")
+	}
+
+	event := base.Event{
+		Type:        "error",
+		Message:     "database down",
+		Severity:    base.SeverityCritical,
+		IncidentKey: "database",
+	}
+	err = p.PushEvent(event)
+	if err != nil {
+		t.Error(err)
+	}
+	event.Resolved = true
+	err = p.PushEvent(event)
+	if err != nil {
+		t.Error(err)
+	}
+	if len(received) != 2 ||
+		received[0].EventAction != ActionTrigger ||
+		received[0].Payload == nil ||
+		received[0].Payload.Severity != SeverityCritical ||
+		received[1].EventAction != ActionResolve ||
+		received[1].DedupKey != "database" {
+		t.Errorf("unexpected events received %+v", received)
+	}
+
+	p.RoutingKey = "bad"
+	err = p.PushEvent(event)
+	if err == nil {
+		t.Error("expected error on invalid routing key")
+	}
+}
@@ -0,0 +1,39 @@
+package pagerduty
+
+// Event actions as defined by the PagerDuty Events API v2
+const (
+	ActionTrigger = "trigger"
+	ActionResolve = "resolve"
+)
+
+// Severities accepted by the PagerDuty Events API v2
+const (
+	SeverityInfo     = "info"
+	SeverityWarning  = "warning"
+	SeverityError    = "error"
+	SeverityCritical = "critical"
+)
+
+// Event is the PagerDuty Events API v2 request body
+type Event struct {
+	RoutingKey  string   `json:"routing_key"`
+	EventAction string   `json:"event_action"`
+	DedupKey    string   `json:"dedup_key"`
+	Payload     *Payload `json:"payload,omitempty"`
+}
+
+// Payload holds the incident details, it is only required when triggering
+type Payload struct {
+	Summary  string `json:"summary"`
+	Source   string `json:"source"`
+	Severity string `json:"severity"`
+	Class    string `json:"class,omitempty"`
+}
+
+// Response holds the PagerDuty Events API v2 response
+type Response struct {
+	Status   string   `json:"status"`
+	Message  string   `json:"message"`
+	DedupKey string   `json:"dedup_key"`
+	Errors   []string `json:"errors"`
+}
@@ -0,0 +1,105 @@
+// Package pushbullet sends mobile push notifications via the Pushbullet API
+// https://docs.pushbullet.com
+package pushbullet
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	title    = "GoCryptoTrader"
+	pushNote = "note"
+)
+
+var apiURL = "https://api.pushbullet.com/v2/pushes"
+
+// Pushbullet is the overarching type across this package
+type Pushbullet struct {
+	base.Base
+	AccessToken string
+	ChannelTag  string
+}
+
+// Setup takes in a Pushbullet configuration and sets the access token
+func (p *Pushbullet) Setup(cfg *config.CommunicationsConfig) {
+	p.Name = cfg.PushbulletConfig.Name
+	p.Enabled = cfg.PushbulletConfig.Enabled
+	p.Verbose = cfg.PushbulletConfig.Verbose
+	p.AccessToken = cfg.PushbulletConfig.AccessToken
+	p.ChannelTag = cfg.PushbulletConfig.ChannelTag
+}
+
+// IsConnected returns whether or not the connection is connected
+func (p *Pushbullet) IsConnected() bool {
+	return p.Connected
+}
+
+// Connect connects to the service
+func (p *Pushbullet) Connect() error {
+	p.Connected = true
+	return nil
+}
+
+// PushEvent sends an event as a push notification, Pushbullet has no priority
+// levels so the event severity is prefixed to the title
+func (p *Pushbullet) PushEvent(event base.Event) error {
+	subject := fmt.Sprintf("%s: %s", title, event.Type)
+	if event.Severity > base.SeverityInfo {
+		subject = fmt.Sprintf("[%s] %s", strings.ToUpper(event.Severity.String()), subject)
+	}
+	return p.SendMessage(subject, event.Message)
+}
+
+// SendMessage sends a note to all devices, or to a channel if set
+func (p *Pushbullet) SendMessage(subject, message string) error {
+	if message == "" {
+		return errors.New("pushbullet SendMessage() message is empty")
+	}
+
+	data, err := json.Marshal(Push{
+		Type:       pushNote,
+		Title:      subject,
+		Body:       message,
+		ChannelTag: p.ChannelTag,
+	})
+	if err != nil {
+		return err
+	}
+
+	if p.Verbose {
+		log.Debugf(log.CommunicationMgr, "Pushbullet: Sending notification. Title: %s Message: %s\n",
+			subject, message)
+	}
+
+	resp, err := common.SendHTTPRequest(http.MethodPost,
+		apiURL,
+		map[string]string{
+			"Access-Token": p.AccessToken,
+			"Content-Type": "application/json",
+		},
+		bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+
+	var result Response
+	err = json.Unmarshal([]byte(resp), &result)
+	if err != nil {
+		return err
+	}
+
+	if result.Error != nil {
+		return errors.New("pushbullet message not sent: " + result.Error.Message)
+	}
+	return nil
+}
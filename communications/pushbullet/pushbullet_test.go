@@ -0,0 +1,61 @@
+package pushbullet
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commsCfg := cfg.GetCommunicationsConfig()
+	var p Pushbullet
+	p.Setup(&commsCfg)
+	if p.Name != "Pushbullet" || p.Enabled || p.AccessToken != "token" {
+		t.Error("pushbullet Setup() error, unexpected setup values",
+			p.Name,
+			p.Enabled,
+			p.AccessToken)
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Access-Token") != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"error":{"type":"invalid_request","message":"Access token is missing or invalid."}}`))
+			return
+		}
+		var push Push
+		if err := json.NewDecoder(r.Body).Decode(&push); err != nil {
+			t.Fatal(err)
+		}
+		if push.Title != "[CRITICAL] GoCryptoTrader: error" {
+			t.Errorf("unexpected title %s", push.Title)
+		}
+		_, _ = w.Write([]byte(`{"iden":"1337"}`))
+	}))
+	defer srv.Close()
+	apiURL = srv.URL
+
+	p := Pushbullet{AccessToken: "token"}
+	event := base.Event{Type: "error", Message: "database down", Severity: base.SeverityCritical}
+	err := p.PushEvent(event)
+	if err != nil {
+		t.Error(err)
+	}
+
+	p.AccessToken = "bad"
+	err = p.PushEvent(event)
+	if err == nil {
+		t.Error("expected error on invalid token")
+	}
+}
@@ -0,0 +1,18 @@
+package pushbullet
+
+// Push holds the request body for creating a Pushbullet note
+type Push struct {
+	Type       string `json:"type"`
+	Title      string `json:"title"`
+	Body       string `json:"body"`
+	ChannelTag string `json:"channel_tag,omitempty"`
+}
+
+// Response holds the Pushbullet push API response
+type Response struct {
+	Iden  string `json:"iden"`
+	Error *struct {
+		Type    string `json:"type"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
@@ -0,0 +1,124 @@
+// Package pushover sends mobile push notifications via the Pushover API
+// https://pushover.net/api
+package pushover
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	title = "GoCryptoTrader"
+
+	// emergencyRetry is how often in seconds Pushover will resend an
+	// emergency notification until acknowledged
+	emergencyRetry = 60
+	// emergencyExpire is how long in seconds Pushover will keep resending an
+	// emergency notification
+	emergencyExpire = 3600
+)
+
+var apiURL = "https://api.pushover.net/1/messages.json"
+
+// Pushover is the overarching type across this package
+type Pushover struct {
+	base.Base
+	APIToken string
+	UserKey  string
+	Device   string
+}
+
+// Setup takes in a Pushover configuration and sets the application token and
+// user key
+func (p *Pushover) Setup(cfg *config.CommunicationsConfig) {
+	p.Name = cfg.PushoverConfig.Name
+	p.Enabled = cfg.PushoverConfig.Enabled
+	p.Verbose = cfg.PushoverConfig.Verbose
+	p.APIToken = cfg.PushoverConfig.APIToken
+	p.UserKey = cfg.PushoverConfig.UserKey
+	p.Device = cfg.PushoverConfig.Device
+}
+
+// IsConnected returns whether or not the connection is connected
+func (p *Pushover) IsConnected() bool {
+	return p.Connected
+}
+
+// Connect connects to the service
+func (p *Pushover) Connect() error {
+	p.Connected = true
+	return nil
+}
+
+// PushEvent sends an event as a push notification, mapping the event severity
+// to a Pushover priority
+func (p *Pushover) PushEvent(event base.Event) error {
+	return p.SendMessage(title+": "+event.Type, event.Message, GetPriority(event.Severity))
+}
+
+// GetPriority maps an event severity to a Pushover priority
+func GetPriority(s base.Severity) int {
+	switch s {
+	case base.SeverityWarning:
+		return PriorityNormal
+	case base.SeverityError:
+		return PriorityHigh
+	case base.SeverityCritical:
+		return PriorityEmergency
+	}
+	return PriorityLow
+}
+
+// SendMessage sends a notification with a title, message and priority
+func (p *Pushover) SendMessage(subject, message string, priority int) error {
+	if message == "" {
+		return errors.New("pushover SendMessage() message is empty")
+	}
+
+	values := url.Values{}
+	values.Set("token", p.APIToken)
+	values.Set("user", p.UserKey)
+	values.Set("title", subject)
+	values.Set("message", message)
+	values.Set("priority", strconv.Itoa(priority))
+	if priority == PriorityEmergency {
+		values.Set("retry", strconv.Itoa(emergencyRetry))
+		values.Set("expire", strconv.Itoa(emergencyExpire))
+	}
+	if p.Device != "" {
+		values.Set("device", p.Device)
+	}
+
+	if p.Verbose {
+		log.Debugf(log.CommunicationMgr, "Pushover: Sending notification. Priority: %d Title: %s Message: %s\n",
+			priority, subject, message)
+	}
+
+	resp, err := common.SendHTTPRequest(http.MethodPost,
+		apiURL,
+		map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+		strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+
+	var result Response
+	err = json.Unmarshal([]byte(resp), &result)
+	if err != nil {
+		return err
+	}
+
+	if result.Status != 1 {
+		return errors.New("pushover message not sent: " + strings.Join(result.Errors, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,76 @@
+package pushover
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commsCfg := cfg.GetCommunicationsConfig()
+	var p Pushover
+	p.Setup(&commsCfg)
+	if p.Name != "Pushover" || p.Enabled || p.APIToken != "token" || p.UserKey != "user" {
+		t.Error("pushover Setup() error, unexpected setup values",
+			p.Name,
+			p.Enabled,
+			p.APIToken,
+			p.UserKey)
+	}
+}
+
+func TestGetPriority(t *testing.T) {
+	if GetPriority(base.SeverityInfo) != PriorityLow ||
+		GetPriority(base.SeverityWarning) != PriorityNormal ||
+		GetPriority(base.SeverityError) != PriorityHigh ||
+		GetPriority(base.SeverityCritical) != PriorityEmergency {
+		t.Error("pushover GetPriority() unexpected mapping")
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("priority") != "2" ||
+			r.PostForm.Get("retry") == "" ||
+			r.PostForm.Get("expire") == "" {
+			t.Errorf("unexpected emergency params %v", r.PostForm)
+		}
+		if r.PostForm.Get("token") == "bad" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"status":0,"errors":["application token is invalid"]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"status":1,"request":"1337"}`))
+	}))
+	defer srv.Close()
+	apiURL = srv.URL
+
+	p := Pushover{APIToken: "token", UserKey: "user"}
+	event := base.Event{Type: "error", Message: "database down", Severity: base.SeverityCritical}
+	err := p.PushEvent(event)
+	if err != nil {
+		t.Error(err)
+	}
+
+	p.APIToken = "bad"
+	err = p.PushEvent(event)
+	if err == nil {
+		t.Error("expected error on invalid token")
+	}
+
+	err = p.PushEvent(base.Event{})
+	if err == nil {
+		t.Error("expected error on empty message")
+	}
+}
@@ -0,0 +1,114 @@
+// Package signal sends end-to-end encrypted Signal messages via a
+// signal-cli REST API instance
+// https://github.com/bbernhard/signal-cli-rest-api
+package signal
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	defaultAPIURL = "http://localhost:8080"
+	aboutPath     = "/v1/about"
+	sendPath      = "/v2/send"
+)
+
+var errNoRecipients = errors.New("signal no recipients set")
+
+// Signal is the overarching type across this package
+type Signal struct {
+	base.Base
+	APIURL     string
+	Number     string
+	Recipients []string
+}
+
+// Setup takes in a Signal configuration and sets the sending number and
+// recipients
+func (s *Signal) Setup(cfg *config.CommunicationsConfig) {
+	s.Name = cfg.SignalConfig.Name
+	s.Enabled = cfg.SignalConfig.Enabled
+	s.Verbose = cfg.SignalConfig.Verbose
+	s.APIURL = strings.TrimSuffix(cfg.SignalConfig.APIURL, "/")
+	if s.APIURL == "" {
+		s.APIURL = defaultAPIURL
+	}
+	s.Number = cfg.SignalConfig.Number
+	s.Recipients = cfg.SignalConfig.Recipients
+}
+
+// IsConnected returns whether or not the connection is connected
+func (s *Signal) IsConnected() bool {
+	return s.Connected
+}
+
+// Connect verifies the signal-cli REST API is reachable
+func (s *Signal) Connect() error {
+	var about About
+	err := common.SendHTTPGetRequest(s.APIURL+aboutPath, true, s.Verbose, &about)
+	if err != nil {
+		return err
+	}
+	if s.Verbose {
+		log.Debugf(log.CommunicationMgr, "Signal: Connected to signal-cli REST API version %s\n",
+			about.Version)
+	}
+	s.Connected = true
+	return nil
+}
+
+// PushEvent sends an event to all recipients
+func (s *Signal) PushEvent(event base.Event) error {
+	return s.SendMessage(event.Type + ": " + event.Message)
+}
+
+// SendMessage sends a message from the registered number to all recipients
+func (s *Signal) SendMessage(message string) error {
+	if message == "" {
+		return errors.New("signal SendMessage() message is empty")
+	}
+	if len(s.Recipients) == 0 {
+		return errNoRecipients
+	}
+
+	payload, err := json.Marshal(SendRequest{
+		Message:    message,
+		Number:     s.Number,
+		Recipients: s.Recipients,
+	})
+	if err != nil {
+		return err
+	}
+
+	if s.Verbose {
+		log.Debugf(log.CommunicationMgr, "Signal: Sending message to %d recipients: %s\n",
+			len(s.Recipients), message)
+	}
+
+	resp, err := common.SendHTTPRequest(http.MethodPost,
+		s.APIURL+sendPath,
+		map[string]string{"Content-Type": "application/json"},
+		strings.NewReader(string(payload)))
+	if err != nil {
+		return err
+	}
+
+	var result SendResponse
+	err = json.Unmarshal([]byte(resp), &result)
+	if err != nil {
+		return err
+	}
+
+	if result.Error != "" {
+		return errors.New("signal message not sent: " + result.Error)
+	}
+	return nil
+}
@@ -0,0 +1,98 @@
+package signal
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commsCfg := cfg.GetCommunicationsConfig()
+	var s Signal
+	s.Setup(&commsCfg)
+	if s.Name != "Signal" || s.Enabled || s.APIURL != defaultAPIURL || s.Number != "+1234567890" {
+		t.Error("signal Setup() error, unexpected setup values",
+			s.Name,
+			s.Enabled,
+			s.APIURL,
+			s.Number)
+	}
+}
+
+func newTestServer(t *testing.T) *httptest.Server {
+	t.Helper()
+	mux := http.NewServeMux()
+	mux.HandleFunc(aboutPath, func(w http.ResponseWriter, r *http.Request) {
+		_, _ = w.Write([]byte(`{"versions":["v1","v2"],"build":2,"mode":"normal","version":"0.57"}`))
+	})
+	mux.HandleFunc(sendPath, func(w http.ResponseWriter, r *http.Request) {
+		var req SendRequest
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			t.Error(err)
+		}
+		if req.Number != "+1234567890" {
+			w.WriteHeader(http.StatusBadRequest)
+			_, _ = w.Write([]byte(`{"error":"User +0 is not registered"}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"timestamp":"1583000000000"}`))
+	})
+	return httptest.NewServer(mux)
+}
+
+func TestConnect(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	s := Signal{APIURL: srv.URL}
+	err := s.Connect()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.IsConnected() {
+		t.Error("signal IsConnected() should be true")
+	}
+
+	s = Signal{APIURL: srv.URL + "/bad"}
+	if s.Connect() == nil {
+		t.Error("expected error on unreachable API")
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	srv := newTestServer(t)
+	defer srv.Close()
+
+	s := Signal{APIURL: srv.URL, Number: "+1234567890"}
+	event := base.Event{Type: "order", Message: "filled"}
+	if s.PushEvent(event) != errNoRecipients {
+		t.Error("expected error with no recipients")
+	}
+
+	s.Recipients = []string{"+1987654321"}
+	err := s.PushEvent(event)
+	if err != nil {
+		t.Error(err)
+	}
+
+	s.Number = "+0"
+	err = s.PushEvent(event)
+	if err == nil {
+		t.Error("expected error on unregistered number")
+	}
+
+	err = s.SendMessage("")
+	if err == nil {
+		t.Error("expected error on empty message")
+	}
+}
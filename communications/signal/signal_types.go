@@ -0,0 +1,22 @@
+package signal
+
+// SendRequest is the body sent to the signal-cli REST API send endpoint
+type SendRequest struct {
+	Message    string   `json:"message"`
+	Number     string   `json:"number"`
+	Recipients []string `json:"recipients"`
+}
+
+// SendResponse holds the signal-cli REST API send response
+type SendResponse struct {
+	Timestamp string `json:"timestamp"`
+	Error     string `json:"error"`
+}
+
+// About holds the signal-cli REST API version information
+type About struct {
+	Version  string   `json:"version"`
+	Mode     string   `json:"mode"`
+	Build    int64    `json:"build"`
+	Versions []string `json:"versions"`
+}
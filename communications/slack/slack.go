@@ -40,6 +40,9 @@ type Slack struct {
 
 	TargetChannel     string
 	VerificationToken string
+	SigningSecret     string
+	ListenAddress     string
+	Permissions       map[string]base.Permission
 
 	TargetChannelID string
 	Details         Response
@@ -48,6 +51,9 @@ type Slack struct {
 	Connected       bool
 	Shutdown        bool
 	sync.Mutex
+
+	commander     base.Commander
+	commandServer *http.Server
 }
 
 // IsConnected returns whether or not the connection is connected
@@ -63,6 +69,16 @@ func (s *Slack) Setup(cfg *config.CommunicationsConfig) {
 	s.Verbose = cfg.SlackConfig.Verbose
 	s.TargetChannel = cfg.SlackConfig.TargetChannel
 	s.VerificationToken = cfg.SlackConfig.VerificationToken
+	s.SigningSecret = cfg.SlackConfig.SigningSecret
+	s.ListenAddress = cfg.SlackConfig.ListenAddress
+
+	s.Permissions = make(map[string]base.Permission)
+	for i := range cfg.SlackConfig.AuthorisedUsers {
+		user := cfg.SlackConfig.AuthorisedUsers[i]
+		s.Permissions[user.UserID] = base.GetPermission(user.Permission)
+		log.Debugf(log.CommunicationMgr, "Slack: Authorised user: %s. User ID: %s. Permission: %s\n",
+			user.Name, user.UserID, user.Permission)
+	}
 }
 
 // Connect connects to the service
@@ -73,7 +89,7 @@ func (s *Slack) Connect() error {
 	}
 
 	s.Connected = true
-	return nil
+	return s.StartCommandServer()
 }
 
 // PushEvent pushes an event to either a slack channel or specific client
@@ -0,0 +1,441 @@
+package slack
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	commandPath     = "/slack/commands"
+	interactivePath = "/slack/interactive"
+
+	headerTimestamp = "X-Slack-Request-Timestamp"
+	headerSignature = "X-Slack-Signature"
+	signatureScheme = "v0"
+
+	actionConfirm = "confirm"
+	actionDeny    = "deny"
+
+	subCmdHelp       = "help"
+	subCmdStatus     = "status"
+	subCmdTicker     = "ticker"
+	subCmdOrders     = "orders"
+	subCmdCancel     = "cancel"
+	subCmdKillSwitch = "killswitch"
+	subCmdApprove    = "approve"
+
+	slashHelp = `GoCryptoTrader SlackBot slash commands:
+	help - Displays help text
+	status - Displays current working status of bot
+	ticker <exchange> <pair> [asset] - Displays the latest ticker [read]
+	orders <exchange> - Displays open orders [read]
+	cancel <exchange> <orderID> - Cancels an order [trade]
+	killswitch - Cancels all open orders across all exchanges [admin]
+	approve <withdrawalID> - Approves a pending withdrawal [admin]`
+
+	// maxRequestAge is the allowed age of a signed request before it is
+	// rejected to prevent replay attacks
+	maxRequestAge = time.Minute * 5
+)
+
+var (
+	errInvalidSignature = errors.New("slack request signature invalid")
+	errRequestExpired   = errors.New("slack request timestamp expired")
+	errCommanderNotSet  = errors.New("engine command handler not available")
+)
+
+// SetCommander sets the engine command handler used for slash commands and
+// interactive messages
+func (s *Slack) SetCommander(c base.Commander) {
+	s.Lock()
+	s.commander = c
+	s.Unlock()
+}
+
+func (s *Slack) getCommander() (base.Commander, error) {
+	s.Lock()
+	defer s.Unlock()
+	if s.commander == nil {
+		return nil, errCommanderNotSet
+	}
+	return s.commander, nil
+}
+
+// StartCommandServer starts the HTTP listener which receives slash commands
+// and interactive message actions from Slack
+func (s *Slack) StartCommandServer() error {
+	if s.ListenAddress == "" {
+		return nil
+	}
+	if s.commandServer != nil {
+		return errors.New("slack command server already started")
+	}
+
+	mux := http.NewServeMux()
+	mux.HandleFunc(commandPath, s.handleSlashCommand)
+	mux.HandleFunc(interactivePath, s.handleInteraction)
+	s.commandServer = &http.Server{
+		Addr:         s.ListenAddress,
+		Handler:      mux,
+		ReadTimeout:  time.Second * 10,
+		WriteTimeout: time.Second * 10,
+	}
+
+	go func() {
+		log.Debugf(log.CommunicationMgr, "Slack: Command server listening on %s\n", s.ListenAddress)
+		err := s.commandServer.ListenAndServe()
+		if err != nil && err != http.ErrServerClosed {
+			log.Errorf(log.CommunicationMgr, "Slack: Command server error: %s\n", err)
+		}
+	}()
+	return nil
+}
+
+// VerifyRequest validates a Slack request signature using the signing secret
+// https://api.slack.com/authentication/verifying-requests-from-slack
+func (s *Slack) VerifyRequest(timestamp, signature string, body []byte) error {
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errInvalidSignature
+	}
+	if time.Since(time.Unix(ts, 0)) > maxRequestAge {
+		return errRequestExpired
+	}
+
+	expected := signatureScheme + "=" + crypto.HexEncodeToString(
+		crypto.GetHMAC(crypto.HashSHA256,
+			[]byte(signatureScheme+":"+timestamp+":"+string(body)),
+			[]byte(s.SigningSecret)))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errInvalidSignature
+	}
+	return nil
+}
+
+// readVerifiedBody reads and verifies a request body, writing an error to the
+// response on failure
+func (s *Slack) readVerifiedBody(w http.ResponseWriter, r *http.Request) ([]byte, bool) {
+	if r.Method != http.MethodPost {
+		w.WriteHeader(http.StatusMethodNotAllowed)
+		return nil, false
+	}
+
+	body, err := ioutil.ReadAll(http.MaxBytesReader(w, r.Body, 1<<16))
+	if err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return nil, false
+	}
+
+	err = s.VerifyRequest(r.Header.Get(headerTimestamp), r.Header.Get(headerSignature), body)
+	if err != nil {
+		log.Warnf(log.CommunicationMgr, "Slack: Rejected request from %s: %s\n", r.RemoteAddr, err)
+		w.WriteHeader(http.StatusUnauthorized)
+		return nil, false
+	}
+
+	// Restore body so form values can be parsed
+	r.Body = ioutil.NopCloser(bytes.NewReader(body))
+	return body, true
+}
+
+func (s *Slack) handleSlashCommand(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.readVerifiedBody(w, r); !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	resp := s.HandleSlashCommand(&SlashCommand{
+		Command:     r.PostForm.Get("command"),
+		Text:        r.PostForm.Get("text"),
+		UserID:      r.PostForm.Get("user_id"),
+		UserName:    r.PostForm.Get("user_name"),
+		ChannelID:   r.PostForm.Get("channel_id"),
+		ResponseURL: r.PostForm.Get("response_url"),
+	})
+	writeJSON(w, resp)
+}
+
+func (s *Slack) handleInteraction(w http.ResponseWriter, r *http.Request) {
+	if _, ok := s.readVerifiedBody(w, r); !ok {
+		return
+	}
+	if err := r.ParseForm(); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	var payload InteractionPayload
+	if err := json.Unmarshal([]byte(r.PostForm.Get("payload")), &payload); err != nil {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+
+	// Slack requires an acknowledgement within three seconds, the result is
+	// delivered via the response URL
+	w.WriteHeader(http.StatusOK)
+	go func() {
+		resp := s.HandleInteraction(&payload)
+		if err := s.respond(payload.ResponseURL, resp); err != nil {
+			log.Errorf(log.CommunicationMgr, "Slack: Unable to respond to interaction: %s\n", err)
+		}
+	}()
+}
+
+// HandleSlashCommand executes a slash command and returns the reply, commands
+// which modify state are returned as a confirmation prompt
+func (s *Slack) HandleSlashCommand(cmd *SlashCommand) *CommandResponse {
+	if s.Verbose {
+		log.Debugf(log.CommunicationMgr, "Slack: Slash command %s %s received from %s [%s]\n",
+			cmd.Command, cmd.Text, cmd.UserName, cmd.UserID)
+	}
+
+	args := strings.Fields(cmd.Text)
+	if len(args) == 0 {
+		return ephemeral(slashHelp)
+	}
+	sub := strings.ToLower(args[0])
+	args = args[1:]
+
+	switch sub {
+	case subCmdHelp:
+		return ephemeral(slashHelp)
+	case subCmdStatus:
+		return ephemeral(s.GetStatus())
+	case subCmdTicker, subCmdOrders:
+		if !s.isPermitted(cmd.UserID, base.PermissionRead) {
+			return ephemeral(unauthorisedReply(sub))
+		}
+		c, err := s.getCommander()
+		if err != nil {
+			return ephemeral(err.Error())
+		}
+		var reply string
+		if sub == subCmdTicker {
+			reply, err = tickerReply(c, args)
+		} else {
+			reply, err = ordersReply(c, args)
+		}
+		if err != nil {
+			return ephemeral(fmt.Sprintf("%s failed: %s", sub, err))
+		}
+		return ephemeral(reply)
+	case subCmdCancel:
+		if !s.isPermitted(cmd.UserID, base.PermissionTrade) {
+			return ephemeral(unauthorisedReply(sub))
+		}
+		if len(args) < 2 {
+			return ephemeral("usage: cancel <exchange> <orderID>")
+		}
+		return confirmation(fmt.Sprintf("Cancel %s order %s?", args[0], args[1]),
+			strings.Join([]string{subCmdCancel, args[0], args[1]}, " "))
+	case subCmdKillSwitch:
+		if !s.isPermitted(cmd.UserID, base.PermissionAdmin) {
+			return ephemeral(unauthorisedReply(sub))
+		}
+		return confirmation("Cancel all open orders across all exchanges?", subCmdKillSwitch)
+	case subCmdApprove:
+		if !s.isPermitted(cmd.UserID, base.PermissionAdmin) {
+			return ephemeral(unauthorisedReply(sub))
+		}
+		if len(args) < 1 {
+			return ephemeral("usage: approve <withdrawalID>")
+		}
+		return confirmation(fmt.Sprintf("Approve withdrawal %s?", args[0]),
+			strings.Join([]string{subCmdApprove, args[0]}, " "))
+	}
+	return ephemeral(fmt.Sprintf("GoCryptoTrader SlackBot - Command %s Unknown!", sub))
+}
+
+// HandleInteraction executes a confirmed interactive message action
+func (s *Slack) HandleInteraction(payload *InteractionPayload) *CommandResponse {
+	if len(payload.Actions) == 0 {
+		return replace("No action received")
+	}
+
+	action := payload.Actions[0]
+	if action.ActionID == actionDeny {
+		return replace("Cancelled, no action taken")
+	}
+	if action.ActionID != actionConfirm {
+		return replace(fmt.Sprintf("Unknown action %s", action.ActionID))
+	}
+
+	args := strings.Fields(action.Value)
+	if len(args) == 0 {
+		return replace("No action received")
+	}
+
+	c, err := s.getCommander()
+	if err != nil {
+		return replace(err.Error())
+	}
+
+	// Permissions are checked again as the user confirming may differ from
+	// the user who issued the command
+	switch args[0] {
+	case subCmdCancel:
+		if !s.isPermitted(payload.User.ID, base.PermissionTrade) || len(args) < 3 {
+			return replace(unauthorisedReply(args[0]))
+		}
+		err = c.CancelOrder(args[1], args[2])
+		if err != nil {
+			return replace(fmt.Sprintf("Unable to cancel %s order %s: %s", args[1], args[2], err))
+		}
+		return replace(fmt.Sprintf("%s order %s cancelled by <@%s>", args[1], args[2], payload.User.ID))
+	case subCmdKillSwitch:
+		if !s.isPermitted(payload.User.ID, base.PermissionAdmin) {
+			return replace(unauthorisedReply(args[0]))
+		}
+		log.Warnf(log.CommunicationMgr, "Slack: Kill switch triggered by %s [%s]\n",
+			payload.User.Name, payload.User.ID)
+		err = c.KillSwitch()
+		if err != nil {
+			return replace(fmt.Sprintf("Kill switch failed: %s", err))
+		}
+		return replace(fmt.Sprintf("Kill switch triggered by <@%s>, all open orders cancelled", payload.User.ID))
+	case subCmdApprove:
+		if !s.isPermitted(payload.User.ID, base.PermissionAdmin) || len(args) < 2 {
+			return replace(unauthorisedReply(args[0]))
+		}
+		err = c.ApproveWithdrawal(args[1], fmt.Sprintf("Slack user %s [%s]", payload.User.Name, payload.User.ID))
+		if err != nil {
+			return replace(fmt.Sprintf("Unable to approve withdrawal %s: %s", args[1], err))
+		}
+		return replace(fmt.Sprintf("Withdrawal %s approved by <@%s>", args[1], payload.User.ID))
+	}
+	return replace(fmt.Sprintf("Unknown action %s", args[0]))
+}
+
+// isPermitted returns whether a user ID has been granted at least the
+// required permission level
+func (s *Slack) isPermitted(userID string, required base.Permission) bool {
+	p, ok := s.Permissions[userID]
+	return ok && p >= required
+}
+
+// respond posts a response to a Slack response URL
+func (s *Slack) respond(responseURL string, resp *CommandResponse) error {
+	if responseURL == "" {
+		return errors.New("response url is empty")
+	}
+	data, err := json.Marshal(resp)
+	if err != nil {
+		return err
+	}
+	_, err = common.SendHTTPRequest(http.MethodPost,
+		responseURL,
+		map[string]string{"Content-Type": "application/json"},
+		bytes.NewReader(data))
+	return err
+}
+
+func writeJSON(w http.ResponseWriter, resp *CommandResponse) {
+	w.Header().Set("Content-Type", "application/json")
+	if err := json.NewEncoder(w).Encode(resp); err != nil {
+		log.Errorf(log.CommunicationMgr, "Slack: Unable to encode response: %s\n", err)
+	}
+}
+
+func tickerReply(c base.Commander, args []string) (string, error) {
+	if len(args) < 2 {
+		return "", errors.New("usage: ticker <exchange> <pair> [asset]")
+	}
+	a := asset.Spot
+	if len(args) > 2 {
+		a = asset.Item(strings.ToLower(args[2]))
+	}
+	tick, err := c.GetTicker(args[0], currency.NewPairFromString(args[1]), a)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s Last: %v Bid: %v Ask: %v High: %v Low: %v Volume: %v",
+		args[0], tick.Pair, a, tick.Last, tick.Bid, tick.Ask, tick.High, tick.Low, tick.Volume), nil
+}
+
+func ordersReply(c base.Commander, args []string) (string, error) {
+	if len(args) < 1 {
+		return "", errors.New("usage: orders <exchange>")
+	}
+	orders, err := c.GetOpenOrders(args[0])
+	if err != nil {
+		return "", err
+	}
+	if len(orders) == 0 {
+		return fmt.Sprintf("%s has no open orders", args[0]), nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s open orders:", args[0])
+	for i := range orders {
+		fmt.Fprintf(&b, "\n%s %s %s %s %v @ %v",
+			orders[i].ID,
+			orders[i].CurrencyPair,
+			orders[i].OrderSide,
+			orders[i].OrderType,
+			orders[i].Amount,
+			orders[i].Price)
+	}
+	return b.String(), nil
+}
+
+func unauthorisedReply(cmd string) string {
+	return fmt.Sprintf("You are not authorised to use %s", cmd)
+}
+
+func ephemeral(text string) *CommandResponse {
+	return &CommandResponse{ResponseType: "ephemeral", Text: text}
+}
+
+func replace(text string) *CommandResponse {
+	return &CommandResponse{ReplaceOriginal: true, Text: text}
+}
+
+// confirmation returns a message with yes/no buttons, the value is passed
+// back in the interaction payload when confirmed
+func confirmation(text, value string) *CommandResponse {
+	return &CommandResponse{
+		ResponseType: "ephemeral",
+		Text:         text,
+		Blocks: []Block{
+			{
+				Type: "section",
+				Text: &TextObject{Type: "mrkdwn", Text: text},
+			},
+			{
+				Type: "actions",
+				Elements: []BlockElement{
+					{
+						Type:     "button",
+						Text:     &TextObject{Type: "plain_text", Text: "Yes"},
+						ActionID: actionConfirm,
+						Value:    value,
+						Style:    "danger",
+					},
+					{
+						Type:     "button",
+						Text:     &TextObject{Type: "plain_text", Text: "No"},
+						ActionID: actionDeny,
+						Value:    value,
+					},
+				},
+			},
+		},
+	}
+}
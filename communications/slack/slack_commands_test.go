@@ -0,0 +1,179 @@
+package slack
+
+import (
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+const testSigningSecret = "8f742231b10e8888abcd99yyyzzz85a5"
+
+type testCommander struct {
+	killSwitchCalled bool
+}
+
+func (c *testCommander) GetTicker(exchName string, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	return &ticker.Price{Pair: p, Last: 1337}, nil
+}
+
+func (c *testCommander) GetOpenOrders(exchName string) ([]order.Detail, error) {
+	return nil, nil
+}
+
+func (c *testCommander) CancelOrder(exchName, orderID string) error {
+	return errors.New("order not found")
+}
+
+func (c *testCommander) KillSwitch() error {
+	c.killSwitchCalled = true
+	return nil
+}
+
+func (c *testCommander) ApproveWithdrawal(id, approver string) error {
+	return nil
+}
+
+func signRequest(t *testing.T, req *http.Request, body string) {
+	t.Helper()
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set(headerTimestamp, ts)
+	req.Header.Set(headerSignature, "v0="+crypto.HexEncodeToString(
+		crypto.GetHMAC(crypto.HashSHA256,
+			[]byte("v0:"+ts+":"+body),
+			[]byte(testSigningSecret))))
+}
+
+func TestVerifyRequest(t *testing.T) {
+	sl := Slack{SigningSecret: testSigningSecret}
+	body := []byte("command=%2Fgct&text=help")
+	ts := strconv.FormatInt(time.Now().Unix(), 10)
+	sig := "v0=" + crypto.HexEncodeToString(crypto.GetHMAC(crypto.HashSHA256,
+		[]byte("v0:"+ts+":"+string(body)),
+		[]byte(testSigningSecret)))
+
+	if err := sl.VerifyRequest(ts, sig, body); err != nil {
+		t.Error(err)
+	}
+	if err := sl.VerifyRequest(ts, "v0=bad", body); err != errInvalidSignature {
+		t.Errorf("expected %v got %v", errInvalidSignature, err)
+	}
+	old := strconv.FormatInt(time.Now().Add(-time.Hour).Unix(), 10)
+	if err := sl.VerifyRequest(old, sig, body); err != errRequestExpired {
+		t.Errorf("expected %v got %v", errRequestExpired, err)
+	}
+}
+
+func TestHandleSlashCommand(t *testing.T) {
+	sl := Slack{
+		Permissions: map[string]base.Permission{
+			"reader": base.PermissionRead,
+			"admin":  base.PermissionAdmin,
+		},
+	}
+	resp := sl.HandleSlashCommand(&SlashCommand{Text: "ticker binance btc-usdt", UserID: "reader"})
+	if resp.Text != errCommanderNotSet.Error() {
+		t.Errorf("expected commander not set, got %s", resp.Text)
+	}
+
+	sl.SetCommander(&testCommander{})
+	testCases := []struct {
+		text     string
+		userID   string
+		expected string
+		buttons  bool
+	}{
+		{"", "reader", "slash commands", false},
+		{"ticker binance btc-usdt", "nobody", "not authorised", false},
+		{"ticker binance btc-usdt", "reader", "Last: 1337", false},
+		{"orders binance", "reader", "no open orders", false},
+		{"cancel binance 1", "reader", "not authorised", false},
+		{"cancel binance 1", "admin", "Cancel binance order 1?", true},
+		{"killswitch", "admin", "Cancel all open orders", true},
+		{"approve 1337", "admin", "Approve withdrawal 1337?", true},
+		{"bad", "admin", "Unknown", false},
+	}
+	for i := range testCases {
+		resp = sl.HandleSlashCommand(&SlashCommand{Text: testCases[i].text, UserID: testCases[i].userID})
+		if !strings.Contains(resp.Text, testCases[i].expected) {
+			t.Errorf("%s: expected %q got %q", testCases[i].text, testCases[i].expected, resp.Text)
+		}
+		if testCases[i].buttons != (len(resp.Blocks) > 0) {
+			t.Errorf("%s: unexpected confirmation buttons", testCases[i].text)
+		}
+	}
+}
+
+func TestHandleInteraction(t *testing.T) {
+	c := &testCommander{}
+	sl := Slack{
+		Permissions: map[string]base.Permission{
+			"trader": base.PermissionTrade,
+			"admin":  base.PermissionAdmin,
+		},
+	}
+	sl.SetCommander(c)
+
+	newPayload := func(userID, actionID, value string) *InteractionPayload {
+		p := &InteractionPayload{}
+		p.User.ID = userID
+		p.Actions = append(p.Actions, struct {
+			ActionID string `json:"action_id"`
+			Value    string `json:"value"`
+		}{actionID, value})
+		return p
+	}
+
+	resp := sl.HandleInteraction(newPayload("trader", actionConfirm, subCmdKillSwitch))
+	if !strings.Contains(resp.Text, "not authorised") || c.killSwitchCalled {
+		t.Error("trader should not be able to trigger the kill switch")
+	}
+	resp = sl.HandleInteraction(newPayload("admin", actionDeny, subCmdKillSwitch))
+	if !strings.Contains(resp.Text, "no action taken") || c.killSwitchCalled {
+		t.Error("denied action should not trigger the kill switch")
+	}
+	resp = sl.HandleInteraction(newPayload("admin", actionConfirm, subCmdKillSwitch))
+	if !resp.ReplaceOriginal || !c.killSwitchCalled {
+		t.Error("expected kill switch to be triggered")
+	}
+	resp = sl.HandleInteraction(newPayload("trader", actionConfirm, "cancel binance 1"))
+	if !strings.Contains(resp.Text, "order not found") {
+		t.Errorf("expected cancel error, got %s", resp.Text)
+	}
+}
+
+func TestSlashCommandHandler(t *testing.T) {
+	sl := Slack{SigningSecret: testSigningSecret}
+	body := url.Values{"command": {"/gct"}, "text": {"help"}}.Encode()
+
+	req := httptest.NewRequest(http.MethodPost, commandPath, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	rec := httptest.NewRecorder()
+	sl.handleSlashCommand(rec, req)
+	if rec.Code != http.StatusUnauthorized {
+		t.Errorf("expected unsigned request to be rejected, got %d", rec.Code)
+	}
+
+	req = httptest.NewRequest(http.MethodPost, commandPath, strings.NewReader(body))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	signRequest(t, req, body)
+	rec = httptest.NewRecorder()
+	sl.handleSlashCommand(rec, req)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status 200, got %d", rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), "slash commands") {
+		t.Errorf("unexpected response %s", rec.Body.String())
+	}
+}
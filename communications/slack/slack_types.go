@@ -65,3 +65,57 @@ type Response struct {
 		TeamID string `json:"team_id"`
 	} `json:"users"`
 }
+
+// SlashCommand holds a slash command request sent by Slack
+type SlashCommand struct {
+	Command     string
+	Text        string
+	UserID      string
+	UserName    string
+	ChannelID   string
+	ResponseURL string
+}
+
+// InteractionPayload holds an interactive message action sent by Slack
+type InteractionPayload struct {
+	Type string `json:"type"`
+	User struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"user"`
+	Actions []struct {
+		ActionID string `json:"action_id"`
+		Value    string `json:"value"`
+	} `json:"actions"`
+	ResponseURL string `json:"response_url"`
+}
+
+// CommandResponse is a reply to a slash command or interactive message
+type CommandResponse struct {
+	ResponseType    string  `json:"response_type,omitempty"`
+	ReplaceOriginal bool    `json:"replace_original,omitempty"`
+	Text            string  `json:"text"`
+	Blocks          []Block `json:"blocks,omitempty"`
+}
+
+// Block is a Slack block kit layout block
+type Block struct {
+	Type     string         `json:"type"`
+	Text     *TextObject    `json:"text,omitempty"`
+	Elements []BlockElement `json:"elements,omitempty"`
+}
+
+// TextObject is a Slack block kit text object
+type TextObject struct {
+	Type string `json:"type"`
+	Text string `json:"text"`
+}
+
+// BlockElement is a Slack block kit interactive element
+type BlockElement struct {
+	Type     string      `json:"type"`
+	Text     *TextObject `json:"text,omitempty"`
+	ActionID string      `json:"action_id,omitempty"`
+	Value    string      `json:"value,omitempty"`
+	Style    string      `json:"style,omitempty"`
+}
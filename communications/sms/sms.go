@@ -1,46 +1,40 @@
-// Package smsglobal allows bulk messaging to a desired recipient list
-package smsglobal
+// Package sms allows bulk messaging to a desired recipient list through a
+// configurable SMS provider
+package sms
 
 import (
 	"errors"
-	"flag"
-	"net/http"
-	"net/url"
 	"strings"
 
-	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/communications/sms/smsglobal"
+	"github.com/thrasher-corp/gocryptotrader/communications/sms/twilio"
+	"github.com/thrasher-corp/gocryptotrader/communications/sms/vonage"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-const (
-	smsGlobalAPIURL = "https://www.smsglobal.com/http-api.php"
-)
-
 var (
-	errSMSNotSent      = errors.New("SMSGlobal message not sent")
-	errContactNotFound = errors.New("SMSGlobal error contact not found")
+	errContactNotFound = errors.New("SMS error contact not found")
+	errNoProvider      = errors.New("SMS provider not set")
 )
 
-// SMSGlobal is the overarching type across this package
-type SMSGlobal struct {
+// SMS is the overarching type across this package
+type SMS struct {
 	base.Base
 	Contacts []Contact
-	Username string
-	Password string
 	SendFrom string
+	Provider Provider
 }
 
-// Setup takes in a SMSGlobal configuration, sets username, password and
-// and recipient list
-func (s *SMSGlobal) Setup(cfg *config.CommunicationsConfig) {
+// Setup takes in a SMS configuration, sets the provider, sender and
+// recipient list
+func (s *SMS) Setup(cfg *config.CommunicationsConfig) {
 	s.Name = cfg.SMSGlobalConfig.Name
 	s.Enabled = cfg.SMSGlobalConfig.Enabled
 	s.Verbose = cfg.SMSGlobalConfig.Verbose
-	s.Username = cfg.SMSGlobalConfig.Username
-	s.Password = cfg.SMSGlobalConfig.Password
 	s.SendFrom = cfg.SMSGlobalConfig.From
+	s.Provider = NewProvider(&cfg.SMSGlobalConfig)
 
 	var contacts []Contact
 	for x := range cfg.SMSGlobalConfig.Contacts {
@@ -51,7 +45,7 @@ func (s *SMSGlobal) Setup(cfg *config.CommunicationsConfig) {
 				Enabled: cfg.SMSGlobalConfig.Contacts[x].Enabled,
 			},
 		)
-		log.Debugf(log.CommunicationMgr, "SMSGlobal: SMS Contact: %s. Number: %s. Enabled: %v\n",
+		log.Debugf(log.CommunicationMgr, "SMS: SMS Contact: %s. Number: %s. Enabled: %v\n",
 			cfg.SMSGlobalConfig.Contacts[x].Name,
 			cfg.SMSGlobalConfig.Contacts[x].Number,
 			cfg.SMSGlobalConfig.Contacts[x].Enabled)
@@ -59,25 +53,52 @@ func (s *SMSGlobal) Setup(cfg *config.CommunicationsConfig) {
 	s.Contacts = contacts
 }
 
+// NewProvider returns the SMS provider selected in the config, the username
+// and password are used as the provider credentials. Nil is returned for an
+// unsupported provider
+func NewProvider(cfg *config.SMSGlobalConfig) Provider {
+	switch strings.ToLower(cfg.Provider) {
+	case config.SMSProviderSMSGlobal, "":
+		return &smsglobal.SMSGlobal{
+			Username: cfg.Username,
+			Password: cfg.Password,
+		}
+	case config.SMSProviderTwilio:
+		return &twilio.Twilio{
+			AccountSID: cfg.Username,
+			AuthToken:  cfg.Password,
+		}
+	case config.SMSProviderVonage:
+		return &vonage.Vonage{
+			APIKey:    cfg.Username,
+			APISecret: cfg.Password,
+		}
+	}
+	return nil
+}
+
 // IsConnected returns whether or not the connection is connected
-func (s *SMSGlobal) IsConnected() bool {
+func (s *SMS) IsConnected() bool {
 	return s.Connected
 }
 
 // Connect connects to the service
-func (s *SMSGlobal) Connect() error {
+func (s *SMS) Connect() error {
+	if s.Provider == nil {
+		return errNoProvider
+	}
 	s.Connected = true
 	return nil
 }
 
 // PushEvent pushes an event to a contact list via SMS
-func (s *SMSGlobal) PushEvent(event base.Event) error {
+func (s *SMS) PushEvent(event base.Event) error {
 	return s.SendMessageToAll(event.Message)
 }
 
 // GetEnabledContacts returns how many SMS contacts are enabled in the
 // contact list
-func (s *SMSGlobal) GetEnabledContacts() int {
+func (s *SMS) GetEnabledContacts() int {
 	counter := 0
 	for x := range s.Contacts {
 		if s.Contacts[x].Enabled {
@@ -88,7 +109,7 @@ func (s *SMSGlobal) GetEnabledContacts() int {
 }
 
 // GetContactByNumber returns a contact with supplied number
-func (s *SMSGlobal) GetContactByNumber(number string) (Contact, error) {
+func (s *SMS) GetContactByNumber(number string) (Contact, error) {
 	for x := range s.Contacts {
 		if s.Contacts[x].Number == number {
 			return s.Contacts[x], nil
@@ -98,7 +119,7 @@ func (s *SMSGlobal) GetContactByNumber(number string) (Contact, error) {
 }
 
 // GetContactByName returns a contact with supplied name
-func (s *SMSGlobal) GetContactByName(name string) (Contact, error) {
+func (s *SMS) GetContactByName(name string) (Contact, error) {
 	for x := range s.Contacts {
 		if strings.EqualFold(s.Contacts[x].Name, name) {
 			return s.Contacts[x], nil
@@ -108,13 +129,13 @@ func (s *SMSGlobal) GetContactByName(name string) (Contact, error) {
 }
 
 // AddContact checks to see if a contact exists and adds them if it doesn't
-func (s *SMSGlobal) AddContact(contact Contact) error {
+func (s *SMS) AddContact(contact Contact) error {
 	if contact.Name == "" || contact.Number == "" {
-		return errors.New("SMSGlobal AddContact() error - nothing to add")
+		return errors.New("SMS AddContact() error - nothing to add")
 	}
 
 	if s.ContactExists(contact) {
-		return errors.New("SMSGlobal AddContact() error - contact already exists")
+		return errors.New("SMS AddContact() error - contact already exists")
 	}
 
 	s.Contacts = append(s.Contacts, contact)
@@ -122,7 +143,7 @@ func (s *SMSGlobal) AddContact(contact Contact) error {
 }
 
 // ContactExists checks to see if a contact exists
-func (s *SMSGlobal) ContactExists(contact Contact) bool {
+func (s *SMS) ContactExists(contact Contact) bool {
 	for x := range s.Contacts {
 		if s.Contacts[x].Number == contact.Number && strings.EqualFold(s.Contacts[x].Name, contact.Name) {
 			return true
@@ -132,9 +153,9 @@ func (s *SMSGlobal) ContactExists(contact Contact) bool {
 }
 
 // RemoveContact removes a contact if it exists
-func (s *SMSGlobal) RemoveContact(contact Contact) error {
+func (s *SMS) RemoveContact(contact Contact) error {
 	if !s.ContactExists(contact) {
-		return errors.New("SMSGlobal RemoveContact() error - contact does not exist")
+		return errors.New("SMS RemoveContact() error - contact does not exist")
 	}
 
 	for x := range s.Contacts {
@@ -143,15 +164,15 @@ func (s *SMSGlobal) RemoveContact(contact Contact) error {
 			return nil
 		}
 	}
-	return errors.New("SMSGlobal RemoveContact() error - contact already removed")
+	return errors.New("SMS RemoveContact() error - contact already removed")
 }
 
 // SendMessageToAll sends a message to all enabled contacts in cfg
-func (s *SMSGlobal) SendMessageToAll(message string) error {
+func (s *SMS) SendMessageToAll(message string) error {
 	for x := range s.Contacts {
 		if s.Contacts[x].Enabled {
 			if s.Verbose {
-				log.Debugf(log.CommunicationMgr, "SMSGlobal: Sending SMS to %s. Number: %s. Message: %s [From: %s]\n",
+				log.Debugf(log.CommunicationMgr, "SMS: Sending SMS to %s. Number: %s. Message: %s [From: %s]\n",
 					s.Contacts[x].Name, s.Contacts[x].Number, message, s.SendFrom)
 			}
 			err := s.SendMessage(s.Contacts[x].Number, message)
@@ -163,33 +184,10 @@ func (s *SMSGlobal) SendMessageToAll(message string) error {
 	return nil
 }
 
-// SendMessage sends a message to an individual contact
-func (s *SMSGlobal) SendMessage(to, message string) error {
-	if flag.Lookup("test.v") != nil {
-		return nil
-	}
-
-	values := url.Values{}
-	values.Set("action", "sendsms")
-	values.Set("user", s.Username)
-	values.Set("password", s.Password)
-	values.Set("from", s.SendFrom)
-	values.Set("to", to)
-	values.Set("text", message)
-
-	headers := make(map[string]string)
-	headers["Content-Type"] = "application/x-www-form-urlencoded"
-
-	resp, err := common.SendHTTPRequest(http.MethodPost,
-		smsGlobalAPIURL,
-		headers,
-		strings.NewReader(values.Encode()))
-	if err != nil {
-		return err
+// SendMessage sends a message to an individual contact via the provider
+func (s *SMS) SendMessage(to, message string) error {
+	if s.Provider == nil {
+		return errNoProvider
 	}
-
-	if !strings.Contains(resp, "OK: 0; Sent queued message") {
-		return errSMSNotSent
-	}
-	return nil
+	return s.Provider.SendMessage(s.SendFrom, to, message)
 }
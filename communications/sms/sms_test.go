@@ -0,0 +1,166 @@
+package sms
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/communications/sms/smsglobal"
+	"github.com/thrasher-corp/gocryptotrader/communications/sms/twilio"
+	"github.com/thrasher-corp/gocryptotrader/communications/sms/vonage"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+type testProvider struct {
+	sent []string
+}
+
+func (p *testProvider) SendMessage(from, to, message string) error {
+	p.sent = append(p.sent, to)
+	return nil
+}
+
+var s SMS
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commsCfg := cfg.GetCommunicationsConfig()
+	s.Setup(&commsCfg)
+	if _, ok := s.Provider.(*smsglobal.SMSGlobal); !ok {
+		t.Errorf("expected SMSGlobal provider, got %T", s.Provider)
+	}
+	s.Provider = &testProvider{}
+}
+
+func TestNewProvider(t *testing.T) {
+	testCases := []struct {
+		provider string
+		expected Provider
+	}{
+		{"", &smsglobal.SMSGlobal{Username: "user", Password: "pass"}},
+		{"SMSGlobal", &smsglobal.SMSGlobal{Username: "user", Password: "pass"}},
+		{"twilio", &twilio.Twilio{AccountSID: "user", AuthToken: "pass"}},
+		{"vonage", &vonage.Vonage{APIKey: "user", APISecret: "pass"}},
+		{"carrierpigeon", nil},
+	}
+	for i := range testCases {
+		p := NewProvider(&config.SMSGlobalConfig{
+			Provider: testCases[i].provider,
+			Username: "user",
+			Password: "pass",
+		})
+		switch expected := testCases[i].expected.(type) {
+		case *smsglobal.SMSGlobal:
+			if v, ok := p.(*smsglobal.SMSGlobal); !ok || *v != *expected {
+				t.Errorf("%s: unexpected provider %v", testCases[i].provider, p)
+			}
+		case *twilio.Twilio:
+			if v, ok := p.(*twilio.Twilio); !ok || *v != *expected {
+				t.Errorf("%s: unexpected provider %v", testCases[i].provider, p)
+			}
+		case *vonage.Vonage:
+			if v, ok := p.(*vonage.Vonage); !ok || *v != *expected {
+				t.Errorf("%s: unexpected provider %v", testCases[i].provider, p)
+			}
+		default:
+			if p != nil {
+				t.Errorf("%s: expected nil provider, got %v", testCases[i].provider, p)
+			}
+		}
+	}
+}
+
+func TestConnect(t *testing.T) {
+	var noProvider SMS
+	if noProvider.Connect() != errNoProvider {
+		t.Error("SMS Connect() expected error with no provider")
+	}
+	err := s.Connect()
+	if err != nil {
+		t.Error("SMS Connect() error", err)
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	err := s.PushEvent(base.Event{})
+	if err != nil {
+		t.Error("SMS PushEvent() error", err)
+	}
+}
+
+func TestGetEnabledContacts(t *testing.T) {
+	v := s.GetEnabledContacts()
+	if v != 1 {
+		t.Error("SMS GetEnabledContacts() error")
+	}
+}
+
+func TestGetContactByNumber(t *testing.T) {
+	_, err := s.GetContactByNumber("1231424")
+	if err != nil {
+		t.Error("SMS GetContactByNumber() error", err)
+	}
+	_, err = s.GetContactByNumber("basketball")
+	if err == nil {
+		t.Error("SMS GetContactByNumber() error")
+	}
+}
+
+func TestGetContactByName(t *testing.T) {
+	_, err := s.GetContactByName("StyleGherkin")
+	if err != nil {
+		t.Error("SMS GetContactByName() error", err)
+	}
+	_, err = s.GetContactByName("blah")
+	if err == nil {
+		t.Error("SMS GetContactByName() error")
+	}
+}
+
+func TestAddContact(t *testing.T) {
+	err := s.AddContact(Contact{Name: "bra", Number: "2876", Enabled: true})
+	if err != nil {
+		t.Error("SMS AddContact() error", err)
+	}
+	err = s.AddContact(Contact{Name: "StyleGherkin", Number: "1231424", Enabled: true})
+	if err == nil {
+		t.Error("SMS AddContact() error")
+	}
+	err = s.AddContact(Contact{Name: "", Number: "", Enabled: true})
+	if err == nil {
+		t.Error("SMS AddContact() error")
+	}
+}
+
+func TestRemoveContact(t *testing.T) {
+	err := s.RemoveContact(Contact{Name: "StyleGherkin", Number: "1231424", Enabled: true})
+	if err != nil {
+		t.Error("SMS RemoveContact() error", err)
+	}
+	err = s.RemoveContact(Contact{Name: "frieda", Number: "243453", Enabled: true})
+	if err == nil {
+		t.Error("SMS RemoveContact() Expected error")
+	}
+}
+
+func TestSendMessageToAll(t *testing.T) {
+	p := &testProvider{}
+	s.Provider = p
+	err := s.SendMessageToAll("Hello,World!")
+	if err != nil {
+		t.Error("SMS SendMessageToAll() error", err)
+	}
+	if len(p.sent) != s.GetEnabledContacts() {
+		t.Errorf("expected %d messages, got %d", s.GetEnabledContacts(), len(p.sent))
+	}
+}
+
+func TestSendMessage(t *testing.T) {
+	err := s.SendMessage("1337", "Hello!")
+	if err != nil {
+		t.Error("SMS SendMessage() error", err)
+	}
+}
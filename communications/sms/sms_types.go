@@ -0,0 +1,13 @@
+package sms
+
+// Provider sends a single SMS message through an SMS gateway
+type Provider interface {
+	SendMessage(from, to, message string) error
+}
+
+// Contact struct stores information related to a SMS contact
+type Contact struct {
+	Name    string `json:"Name"`
+	Number  string `json:"Number"`
+	Enabled bool   `json:"Enabled"`
+}
@@ -0,0 +1,51 @@
+// Package smsglobal sends SMS messages via the SMSGlobal HTTP API
+// https://www.smsglobal.com/http-api/
+package smsglobal
+
+import (
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+var (
+	apiURL = "https://www.smsglobal.com/http-api.php"
+
+	errSMSNotSent = errors.New("SMSGlobal message not sent")
+)
+
+// SMSGlobal is the SMSGlobal SMS provider
+type SMSGlobal struct {
+	Username string
+	Password string
+}
+
+// SendMessage sends a message to an individual number
+func (s *SMSGlobal) SendMessage(from, to, message string) error {
+	values := url.Values{}
+	values.Set("action", "sendsms")
+	values.Set("user", s.Username)
+	values.Set("password", s.Password)
+	values.Set("from", from)
+	values.Set("to", to)
+	values.Set("text", message)
+
+	headers := make(map[string]string)
+	headers["Content-Type"] = "application/x-www-form-urlencoded"
+
+	resp, err := common.SendHTTPRequest(http.MethodPost,
+		apiURL,
+		headers,
+		strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+
+	if !strings.Contains(resp, "OK: 0; Sent queued message") {
+		return errSMSNotSent
+	}
+	return nil
+}
@@ -0,0 +1,34 @@
+package smsglobal
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSendMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("password") != "password" {
+			_, _ = w.Write([]byte("ERROR: 402"))
+			return
+		}
+		_, _ = w.Write([]byte("OK: 0; Sent queued message ID: 941596d028699601 SMSGlobalMsgID:6764842339385521"))
+	}))
+	defer srv.Close()
+	apiURL = srv.URL
+
+	s := SMSGlobal{Username: "user", Password: "password"}
+	err := s.SendMessage("Skynet", "1337", "Hello!")
+	if err != nil {
+		t.Error("SMSGlobal SendMessage() error", err)
+	}
+
+	s.Password = "bad"
+	err = s.SendMessage("Skynet", "1337", "Hello!")
+	if err != errSMSNotSent {
+		t.Errorf("expected %v got %v", errSMSNotSent, err)
+	}
+}
@@ -0,0 +1,57 @@
+// Package twilio sends SMS messages via the Twilio Programmable Messaging API
+// https://www.twilio.com/docs/sms/api/message-resource
+package twilio
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+)
+
+var apiURL = "https://api.twilio.com/2010-04-01"
+
+// Twilio is the Twilio SMS provider
+type Twilio struct {
+	AccountSID string
+	AuthToken  string
+}
+
+// SendMessage sends a message to an individual number, the from value must be
+// a Twilio phone number or alphanumeric sender ID enabled on the account
+func (t *Twilio) SendMessage(from, to, message string) error {
+	values := url.Values{}
+	values.Set("From", from)
+	values.Set("To", to)
+	values.Set("Body", message)
+
+	resp, err := common.SendHTTPRequest(http.MethodPost,
+		apiURL+"/Accounts/"+url.PathEscape(t.AccountSID)+"/Messages.json",
+		map[string]string{
+			"Content-Type":  "application/x-www-form-urlencoded",
+			"Authorization": "Basic " + crypto.Base64Encode([]byte(t.AccountSID+":"+t.AuthToken)),
+		},
+		strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+
+	var result Response
+	err = json.Unmarshal([]byte(resp), &result)
+	if err != nil {
+		return err
+	}
+
+	if result.Code != 0 {
+		return fmt.Errorf("twilio message not sent: %d %s", result.Code, result.Message)
+	}
+	if result.SID == "" {
+		return errors.New("twilio message not sent")
+	}
+	return nil
+}
@@ -0,0 +1,43 @@
+package twilio
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSendMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/Accounts/AC123/Messages.json" {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("Body") != "Hello!" || r.PostForm.Get("From") != "+15005550006" {
+			t.Errorf("unexpected params %v", r.PostForm)
+		}
+		user, pass, ok := r.BasicAuth()
+		if !ok || user != "AC123" || pass != "token" {
+			w.WriteHeader(http.StatusUnauthorized)
+			_, _ = w.Write([]byte(`{"code":20003,"message":"Authenticate","status":401}`))
+			return
+		}
+		w.WriteHeader(http.StatusCreated)
+		_, _ = w.Write([]byte(`{"sid":"SM123","status":"queued","error_code":null,"error_message":null}`))
+	}))
+	defer srv.Close()
+	apiURL = srv.URL
+
+	tw := Twilio{AccountSID: "AC123", AuthToken: "token"}
+	err := tw.SendMessage("+15005550006", "+61400000000", "Hello!")
+	if err != nil {
+		t.Error(err)
+	}
+
+	tw.AuthToken = "bad"
+	err = tw.SendMessage("+15005550006", "+61400000000", "Hello!")
+	if err == nil {
+		t.Error("expected error on invalid credentials")
+	}
+}
@@ -0,0 +1,12 @@
+package twilio
+
+// Response holds the Twilio message resource, or the error details if the
+// message was rejected
+type Response struct {
+	SID          string `json:"sid"`
+	Status       string `json:"status"`
+	ErrorCode    int    `json:"error_code"`
+	ErrorMessage string `json:"error_message"`
+	Code         int    `json:"code"`
+	Message      string `json:"message"`
+}
@@ -0,0 +1,58 @@
+// Package vonage sends SMS messages via the Vonage (formerly Nexmo) SMS API
+// https://developer.vonage.com/api/sms
+package vonage
+
+import (
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/url"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+// statusSuccess is the message status returned for an accepted message
+const statusSuccess = "0"
+
+var apiURL = "https://rest.nexmo.com/sms/json"
+
+// Vonage is the Vonage SMS provider
+type Vonage struct {
+	APIKey    string
+	APISecret string
+}
+
+// SendMessage sends a message to an individual number
+func (v *Vonage) SendMessage(from, to, message string) error {
+	values := url.Values{}
+	values.Set("api_key", v.APIKey)
+	values.Set("api_secret", v.APISecret)
+	values.Set("from", from)
+	values.Set("to", to)
+	values.Set("text", message)
+
+	resp, err := common.SendHTTPRequest(http.MethodPost,
+		apiURL,
+		map[string]string{"Content-Type": "application/x-www-form-urlencoded"},
+		strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+
+	var result Response
+	err = json.Unmarshal([]byte(resp), &result)
+	if err != nil {
+		return err
+	}
+
+	if len(result.Messages) == 0 {
+		return errors.New("vonage message not sent")
+	}
+	for x := range result.Messages {
+		if result.Messages[x].Status != statusSuccess {
+			return errors.New("vonage message not sent: " + result.Messages[x].ErrorText)
+		}
+	}
+	return nil
+}
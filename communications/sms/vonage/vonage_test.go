@@ -0,0 +1,34 @@
+package vonage
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestSendMessage(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			t.Fatal(err)
+		}
+		if r.PostForm.Get("api_secret") != "secret" {
+			_, _ = w.Write([]byte(`{"message-count":"1","messages":[{"status":"4","error-text":"Bad Credentials"}]}`))
+			return
+		}
+		_, _ = w.Write([]byte(`{"message-count":"1","messages":[{"to":"61400000000","message-id":"0A0000000123ABCD1","status":"0"}]}`))
+	}))
+	defer srv.Close()
+	apiURL = srv.URL
+
+	v := Vonage{APIKey: "key", APISecret: "secret"}
+	err := v.SendMessage("Skynet", "61400000000", "Hello!")
+	if err != nil {
+		t.Error(err)
+	}
+
+	v.APISecret = "bad"
+	err = v.SendMessage("Skynet", "61400000000", "Hello!")
+	if err == nil || err.Error() != "vonage message not sent: Bad Credentials" {
+		t.Errorf("unexpected error %v", err)
+	}
+}
@@ -0,0 +1,13 @@
+package vonage
+
+// Response holds the Vonage SMS API response, long messages are split and
+// have a status per part
+type Response struct {
+	MessageCount string `json:"message-count"`
+	Messages     []struct {
+		To        string `json:"to"`
+		MessageID string `json:"message-id"`
+		Status    string `json:"status"`
+		ErrorText string `json:"error-text"`
+	} `json:"messages"`
+}
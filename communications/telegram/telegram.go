@@ -10,11 +10,14 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
@@ -25,17 +28,27 @@ const (
 	methodGetUpdates  = "getUpdates"
 	methodSendMessage = "sendMessage"
 
-	cmdStart    = "/start"
-	cmdStatus   = "/status"
-	cmdHelp     = "/help"
-	cmdSettings = "/settings"
+	cmdStart      = "/start"
+	cmdStatus     = "/status"
+	cmdHelp       = "/help"
+	cmdSettings   = "/settings"
+	cmdTicker     = "/ticker"
+	cmdOrders     = "/orders"
+	cmdCancel     = "/cancel"
+	cmdKillSwitch = "/killswitch"
+	cmdApprove    = "/approve"
 
 	cmdHelpReply = `GoCryptoTrader TelegramBot, thank you for using this service!
 	Current commands are:
 	/start  		- Will authenticate your ID
 	/status 		- Displays the status of the bot
 	/help 			- Displays current command list
-	/settings 	- Displays current bot settings`
+	/settings 	- Displays current bot settings
+	/ticker <exchange> <pair> [asset] - Displays the latest ticker [read]
+	/orders <exchange> 		- Displays open orders [read]
+	/cancel <exchange> <orderID> 	- Cancels an order [trade]
+	/killswitch 	- Cancels all open orders across all exchanges [admin]
+	/approve <withdrawalID> 	- Approves a pending withdrawal [admin]`
 
 	talkRoot = "GoCryptoTrader bot"
 )
@@ -44,6 +57,8 @@ var (
 	// ErrWaiter is the default timer to wait if an err occurs
 	// before retrying after successfully connecting
 	ErrWaiter = time.Second * 30
+
+	errCommanderNotSet = errors.New("engine command handler not available")
 )
 
 // Telegram is the overarching type across this package
@@ -53,6 +68,10 @@ type Telegram struct {
 	Token             string
 	Offset            int64
 	AuthorisedClients []int64
+	Permissions       map[int64]base.Permission
+
+	mtx       sync.RWMutex
+	commander base.Commander
 }
 
 // IsConnected returns whether or not the connection is connected
@@ -64,6 +83,32 @@ func (t *Telegram) Setup(cfg *config.CommunicationsConfig) {
 	t.Enabled = cfg.TelegramConfig.Enabled
 	t.Token = cfg.TelegramConfig.VerificationToken
 	t.Verbose = cfg.TelegramConfig.Verbose
+
+	t.AuthorisedClients = nil
+	t.Permissions = make(map[int64]base.Permission)
+	for i := range cfg.TelegramConfig.AuthorisedClients {
+		client := cfg.TelegramConfig.AuthorisedClients[i]
+		t.AuthorisedClients = append(t.AuthorisedClients, client.ChatID)
+		t.Permissions[client.ChatID] = base.GetPermission(client.Permission)
+		log.Debugf(log.CommunicationMgr, "Telegram: Authorised client: %s. Chat ID: %d. Permission: %s\n",
+			client.Name, client.ChatID, client.Permission)
+	}
+}
+
+// SetCommander sets the engine command handler used for interactive commands
+func (t *Telegram) SetCommander(c base.Commander) {
+	t.mtx.Lock()
+	t.commander = c
+	t.mtx.Unlock()
+}
+
+func (t *Telegram) getCommander() (base.Commander, error) {
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	if t.commander == nil {
+		return nil, errCommanderNotSet
+	}
+	return t.commander, nil
 }
 
 // Connect starts an initial connection
@@ -116,7 +161,7 @@ func (t *Telegram) PollerStart() {
 
 		for i := range resp.Result {
 			if resp.Result[i].UpdateID > t.Offset {
-				if string(resp.Result[i].Message.Text[0]) == "/" {
+				if strings.HasPrefix(resp.Result[i].Message.Text, "/") {
 					err = t.HandleMessages(resp.Result[i].Message.Text, resp.Result[i].Message.From.ID)
 					if err != nil {
 						log.Errorf(log.CommunicationMgr, "Telegram: Unable to HandleMessages. Error: %s\n", err)
@@ -169,20 +214,151 @@ func (t *Telegram) HandleMessages(text string, chatID int64) error {
 	if t.Verbose {
 		log.Debugf(log.CommunicationMgr, "Telegram: Received message: %s\n", text)
 	}
+	return t.SendMessage(t.handleCommand(text, chatID), chatID)
+}
 
-	switch {
-	case strings.Contains(text, cmdHelp):
-		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply), chatID)
+// handleCommand executes a command for a chat ID, ensuring the chat has the
+// required permission level, and returns the reply
+func (t *Telegram) handleCommand(text string, chatID int64) string {
+	args := strings.Fields(text)
+	if len(args) == 0 {
+		return fmt.Sprintf("Command %s not recognized", text)
+	}
+	// Commands sent to groups may be suffixed with the bot username
+	cmd := strings.ToLower(strings.SplitN(args[0], "@", 2)[0])
+	args = args[1:]
+
+	switch cmd {
+	case cmdHelp:
+		return fmt.Sprintf("%s: %s", talkRoot, cmdHelpReply)
+	case cmdStart:
+		if _, ok := t.Permissions[chatID]; ok {
+			return fmt.Sprintf("%s: Chat ID %d is authorised", talkRoot, chatID)
+		}
+		return fmt.Sprintf("%s: Chat ID %d is not authorised, add it to your config to enable commands",
+			talkRoot, chatID)
+	case cmdStatus:
+		return fmt.Sprintf("%s: %s", talkRoot, t.GetStatus())
+	case cmdTicker, cmdOrders, cmdSettings:
+		if !t.isPermitted(chatID, base.PermissionRead) {
+			return errUnauthorisedReply(cmd)
+		}
+	case cmdCancel:
+		if !t.isPermitted(chatID, base.PermissionTrade) {
+			return errUnauthorisedReply(cmd)
+		}
+	case cmdKillSwitch, cmdApprove:
+		if !t.isPermitted(chatID, base.PermissionAdmin) {
+			return errUnauthorisedReply(cmd)
+		}
+	default:
+		return fmt.Sprintf("Command %s not recognized", text)
+	}
+
+	reply, err := t.executeCommand(cmd, args, chatID)
+	if err != nil {
+		return fmt.Sprintf("%s: %s failed: %s", talkRoot, cmd, err)
+	}
+	return fmt.Sprintf("%s: %s", talkRoot, reply)
+}
 
-	case strings.Contains(text, cmdStart):
-		return t.SendMessage(fmt.Sprintf("%s: START COMMANDS HERE", talkRoot), chatID)
+// executeCommand runs a permitted command against the engine
+func (t *Telegram) executeCommand(cmd string, args []string, chatID int64) (string, error) {
+	if cmd == cmdSettings {
+		return fmt.Sprintf("Verbose: %v Permission: %s",
+			t.Verbose, t.Permissions[chatID]), nil
+	}
 
-	case strings.Contains(text, cmdStatus):
-		return t.SendMessage(fmt.Sprintf("%s: %s", talkRoot, t.GetStatus()), chatID)
+	c, err := t.getCommander()
+	if err != nil {
+		return "", err
+	}
 
-	default:
-		return t.SendMessage(fmt.Sprintf("Command %s not recognized", text), chatID)
+	switch cmd {
+	case cmdTicker:
+		return tickerReply(c, args)
+	case cmdOrders:
+		return ordersReply(c, args)
+	case cmdCancel:
+		if len(args) < 2 {
+			return "", errors.New("usage /cancel <exchange> <orderID>")
+		}
+		err = c.CancelOrder(args[0], args[1])
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("%s order %s cancelled", args[0], args[1]), nil
+	case cmdKillSwitch:
+		log.Warnf(log.CommunicationMgr, "Telegram: Kill switch triggered by chat ID %d\n", chatID)
+		err = c.KillSwitch()
+		if err != nil {
+			return "", err
+		}
+		return "kill switch triggered, all open orders cancelled", nil
+	case cmdApprove:
+		if len(args) < 1 {
+			return "", errors.New("usage /approve <withdrawalID>")
+		}
+		log.Warnf(log.CommunicationMgr, "Telegram: Withdrawal %s approved by chat ID %d\n", args[0], chatID)
+		err = c.ApproveWithdrawal(args[0], fmt.Sprintf("Telegram chat ID %d", chatID))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("withdrawal %s approved", args[0]), nil
+	}
+	return "", fmt.Errorf("command %s not recognized", cmd)
+}
+
+func tickerReply(c base.Commander, args []string) (string, error) {
+	if len(args) < 2 {
+		return "", errors.New("usage /ticker <exchange> <pair> [asset]")
+	}
+	a := asset.Spot
+	if len(args) > 2 {
+		a = asset.Item(strings.ToLower(args[2]))
 	}
+	tick, err := c.GetTicker(args[0], currency.NewPairFromString(args[1]), a)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("%s %s %s Last: %v Bid: %v Ask: %v High: %v Low: %v Volume: %v",
+		args[0], tick.Pair, a, tick.Last, tick.Bid, tick.Ask, tick.High, tick.Low, tick.Volume), nil
+}
+
+func ordersReply(c base.Commander, args []string) (string, error) {
+	if len(args) < 1 {
+		return "", errors.New("usage /orders <exchange>")
+	}
+	orders, err := c.GetOpenOrders(args[0])
+	if err != nil {
+		return "", err
+	}
+	if len(orders) == 0 {
+		return fmt.Sprintf("%s has no open orders", args[0]), nil
+	}
+	var b strings.Builder
+	fmt.Fprintf(&b, "%s open orders:", args[0])
+	for i := range orders {
+		fmt.Fprintf(&b, "\n%s %s %s %s %v @ %v",
+			orders[i].ID,
+			orders[i].CurrencyPair,
+			orders[i].OrderSide,
+			orders[i].OrderType,
+			orders[i].Amount,
+			orders[i].Price)
+	}
+	return b.String(), nil
+}
+
+// isPermitted returns whether a chat ID has been granted at least the
+// required permission level
+func (t *Telegram) isPermitted(chatID int64, required base.Permission) bool {
+	p, ok := t.Permissions[chatID]
+	return ok && p >= required
+}
+
+func errUnauthorisedReply(cmd string) string {
+	return fmt.Sprintf("%s: You are not authorised to use %s", talkRoot, cmd)
 }
 
 // GetUpdates gets new updates via a long poll connection
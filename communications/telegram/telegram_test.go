@@ -1,10 +1,17 @@
 package telegram
 
 import (
+	"errors"
+	"strings"
 	"testing"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 )
 
 const (
@@ -113,3 +120,97 @@ func TestSendHTTPRequest(t *testing.T) {
 		t.Error("telegram SendHTTPRequest() error")
 	}
 }
+
+type testCommander struct {
+	killSwitchCalled bool
+	cancelled        string
+}
+
+func (c *testCommander) GetTicker(exchName string, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	return &ticker.Price{Pair: p, Last: 1337}, nil
+}
+
+func (c *testCommander) GetOpenOrders(exchName string) ([]order.Detail, error) {
+	return []order.Detail{{ID: "1", Amount: decimal.NewFromInt(1), Price: decimal.NewFromInt(1337)}}, nil
+}
+
+func (c *testCommander) CancelOrder(exchName, orderID string) error {
+	c.cancelled = orderID
+	return nil
+}
+
+func (c *testCommander) KillSwitch() error {
+	c.killSwitchCalled = true
+	return nil
+}
+
+func (c *testCommander) ApproveWithdrawal(id, approver string) error {
+	return errors.New("no pending withdrawal")
+}
+
+func TestSetupAuthorisedClients(t *testing.T) {
+	var tg Telegram
+	tg.Setup(&config.CommunicationsConfig{
+		TelegramConfig: config.TelegramConfig{
+			AuthorisedClients: []config.TelegramClient{
+				{Name: "reader", ChatID: 1, Permission: config.CommsPermissionRead},
+				{Name: "admin", ChatID: 2, Permission: config.CommsPermissionAdmin},
+			},
+		},
+	})
+	if len(tg.AuthorisedClients) != 2 {
+		t.Fatalf("expected 2 authorised clients, got %d", len(tg.AuthorisedClients))
+	}
+	if tg.Permissions[1] != base.PermissionRead || tg.Permissions[2] != base.PermissionAdmin {
+		t.Error("unexpected permissions", tg.Permissions)
+	}
+}
+
+func TestHandleCommand(t *testing.T) {
+	c := &testCommander{}
+	tg := Telegram{
+		Permissions: map[int64]base.Permission{
+			1: base.PermissionRead,
+			2: base.PermissionTrade,
+			3: base.PermissionAdmin,
+		},
+	}
+
+	reply := tg.handleCommand("/ticker binance btc-usdt", 1)
+	if !strings.Contains(reply, errCommanderNotSet.Error()) {
+		t.Errorf("expected commander not set reply, got %s", reply)
+	}
+
+	tg.SetCommander(c)
+	testCases := []struct {
+		text     string
+		chatID   int64
+		expected string
+	}{
+		{"/ticker binance btc-usdt", 1337, "not authorised"},
+		{"/ticker binance btc-usdt", 1, "Last: 1337"},
+		{"/ticker@gctbot binance", 1, "usage"},
+		{"/orders binance", 1, "binance open orders"},
+		{"/cancel binance 1", 1, "not authorised"},
+		{"/cancel binance 1", 2, "order 1 cancelled"},
+		{"/killswitch", 2, "not authorised"},
+		{"/killswitch", 3, "kill switch triggered"},
+		{"/approve 1", 3, "no pending withdrawal"},
+		{"/start", 1337, "is not authorised"},
+		{"/bad", 3, "not recognized"},
+	}
+	for i := range testCases {
+		reply = tg.handleCommand(testCases[i].text, testCases[i].chatID)
+		if !strings.Contains(reply, testCases[i].expected) {
+			t.Errorf("%s from %d: expected reply containing %q, got %q",
+				testCases[i].text,
+				testCases[i].chatID,
+				testCases[i].expected,
+				reply)
+		}
+	}
+
+	if !c.killSwitchCalled || c.cancelled != "1" {
+		t.Error("expected commander cancel and kill switch to be called")
+	}
+}
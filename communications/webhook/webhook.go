@@ -0,0 +1,193 @@
+// Package webhook POSTs JSON event payloads to user defined URLs, allowing
+// integration with services such as Zapier, n8n or custom receivers
+package webhook
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	payloadSource = "gocryptotrader"
+
+	// SignatureHeader contains the hex encoded HMAC-SHA256 of the timestamp
+	// header value and request body joined by a period
+	SignatureHeader = "X-GCT-Signature"
+	// TimestampHeader contains the unix timestamp the payload was signed at
+	TimestampHeader = "X-GCT-Timestamp"
+
+	defaultTimeout = time.Second * 15
+)
+
+var (
+	// RetryWaiter is the base duration to wait before retrying a failed
+	// delivery, it is doubled after every failed attempt
+	RetryWaiter = time.Second
+
+	errNoEndpoints = errors.New("webhook no enabled endpoints")
+)
+
+// Webhook is the overarching type across this package
+type Webhook struct {
+	base.Base
+	Endpoints  []Endpoint
+	MaxRetries int
+	client     *http.Client
+}
+
+// Setup takes in a Webhook configuration and sets the endpoint list
+func (w *Webhook) Setup(cfg *config.CommunicationsConfig) {
+	w.Name = cfg.WebhookConfig.Name
+	w.Enabled = cfg.WebhookConfig.Enabled
+	w.Verbose = cfg.WebhookConfig.Verbose
+	w.MaxRetries = cfg.WebhookConfig.MaxRetries
+
+	var endpoints []Endpoint
+	for x := range cfg.WebhookConfig.Endpoints {
+		endpoints = append(endpoints, Endpoint{
+			Name:    cfg.WebhookConfig.Endpoints[x].Name,
+			URL:     cfg.WebhookConfig.Endpoints[x].URL,
+			Secret:  cfg.WebhookConfig.Endpoints[x].Secret,
+			Enabled: cfg.WebhookConfig.Endpoints[x].Enabled,
+		})
+		log.Debugf(log.CommunicationMgr, "Webhook: Endpoint: %s. Enabled: %v\n",
+			cfg.WebhookConfig.Endpoints[x].Name,
+			cfg.WebhookConfig.Endpoints[x].Enabled)
+	}
+	w.Endpoints = endpoints
+	w.client = common.NewHTTPClientWithTimeout(defaultTimeout)
+}
+
+// IsConnected returns whether or not the connection is connected
+func (w *Webhook) IsConnected() bool {
+	return w.Connected
+}
+
+// Connect verifies at least one endpoint is available for delivery
+func (w *Webhook) Connect() error {
+	for x := range w.Endpoints {
+		if w.Endpoints[x].Enabled && w.Endpoints[x].URL != "" {
+			w.Connected = true
+			return nil
+		}
+	}
+	return errNoEndpoints
+}
+
+// PushEvent sends an event to all enabled webhook endpoints
+func (w *Webhook) PushEvent(event base.Event) error {
+	payload, err := json.Marshal(Payload{
+		Source:    payloadSource,
+		Type:      event.Type,
+		Message:   event.Message,
+		Timestamp: time.Now().Unix(),
+	})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for x := range w.Endpoints {
+		if !w.Endpoints[x].Enabled {
+			continue
+		}
+		err = w.Send(&w.Endpoints[x], payload)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %v", w.Endpoints[x].Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("webhook delivery failed %v", errs)
+	}
+	return nil
+}
+
+// Send POSTs a payload to an endpoint, retrying with an exponential backoff on
+// connection errors and on 429 or 5xx responses
+func (w *Webhook) Send(e *Endpoint, payload []byte) error {
+	wait := RetryWaiter
+	var err error
+	for attempt := 0; attempt <= w.MaxRetries; attempt++ {
+		if attempt > 0 {
+			time.Sleep(wait)
+			wait *= 2
+		}
+
+		var retry bool
+		retry, err = w.send(e, payload)
+		if err == nil {
+			if w.Verbose {
+				log.Debugf(log.CommunicationMgr, "Webhook: Delivered payload to %s\n", e.Name)
+			}
+			return nil
+		}
+		if !retry {
+			return err
+		}
+		log.Warnf(log.CommunicationMgr, "Webhook: %s delivery attempt %d failed: %v\n",
+			e.Name, attempt+1, err)
+	}
+	return err
+}
+
+// send performs a single delivery attempt and reports whether a failure
+// is worth retrying
+func (w *Webhook) send(e *Endpoint, payload []byte) (bool, error) {
+	req, err := http.NewRequest(http.MethodPost, e.URL, bytes.NewReader(payload))
+	if err != nil {
+		return false, err
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(TimestampHeader, timestamp)
+	if e.Secret != "" {
+		req.Header.Set(SignatureHeader, Sign(timestamp, payload, e.Secret))
+	}
+	if common.HTTPUserAgent != "" {
+		req.Header.Set("User-Agent", common.HTTPUserAgent)
+	}
+
+	if w.client == nil {
+		w.client = common.NewHTTPClientWithTimeout(defaultTimeout)
+	}
+
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return true, err
+	}
+	defer resp.Body.Close()
+	// Drain body so the underlying connection can be reused
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests,
+		resp.StatusCode >= http.StatusInternalServerError:
+		return true, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	default:
+		return false, fmt.Errorf("unexpected status code %d", resp.StatusCode)
+	}
+}
+
+// Sign returns the hex encoded HMAC-SHA256 signature of a timestamp and
+// payload, receivers can recompute this to verify the payload origin
+func Sign(timestamp string, payload []byte, secret string) string {
+	msg := append([]byte(timestamp+"."), payload...)
+	return crypto.HexEncodeToString(crypto.GetHMAC(crypto.HashSHA256, msg, []byte(secret)))
+}
@@ -0,0 +1,118 @@
+package webhook
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestSetup(t *testing.T) {
+	cfg := config.GetConfig()
+	err := cfg.LoadConfig("../../testdata/configtest.json", true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	commsCfg := cfg.GetCommunicationsConfig()
+	var w Webhook
+	w.Setup(&commsCfg)
+	if w.Name != "Webhook" || w.Enabled || len(w.Endpoints) != 1 {
+		t.Error("webhook Setup() error, unexpected setup values",
+			w.Name,
+			w.Enabled,
+			w.Endpoints)
+	}
+}
+
+func TestConnect(t *testing.T) {
+	var w Webhook
+	err := w.Connect()
+	if err == nil {
+		t.Error("webhook Connect() expected error with no endpoints")
+	}
+
+	w.Endpoints = []Endpoint{{Name: "test", URL: "http://localhost", Enabled: true}}
+	err = w.Connect()
+	if err != nil {
+		t.Error("webhook Connect() error", err)
+	}
+	if !w.IsConnected() {
+		t.Error("webhook IsConnected() should be true")
+	}
+}
+
+func TestPushEvent(t *testing.T) {
+	const secret = "hunter2"
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Error(err)
+		}
+		expected := Sign(r.Header.Get(TimestampHeader), body, secret)
+		if r.Header.Get(SignatureHeader) != expected {
+			t.Errorf("signature mismatch, expected %s got %s",
+				expected,
+				r.Header.Get(SignatureHeader))
+		}
+		rw.WriteHeader(http.StatusOK)
+	}))
+	defer srv.Close()
+
+	w := Webhook{
+		Endpoints: []Endpoint{
+			{Name: "enabled", URL: srv.URL, Secret: secret, Enabled: true},
+			{Name: "disabled", URL: srv.URL, Secret: secret},
+		},
+	}
+	err := w.PushEvent(base.Event{Type: "order", Message: "filled"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("expected 1 delivery, got %d", calls)
+	}
+}
+
+func TestSendRetry(t *testing.T) {
+	RetryWaiter = time.Millisecond
+	var calls int32
+	srv := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			rw.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		rw.WriteHeader(http.StatusNoContent)
+	}))
+	defer srv.Close()
+
+	w := Webhook{MaxRetries: 3}
+	err := w.Send(&Endpoint{Name: "retry", URL: srv.URL, Enabled: true}, []byte("{}"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("expected 3 attempts, got %d", calls)
+	}
+
+	atomic.StoreInt32(&calls, 0)
+	badRequest := httptest.NewServer(http.HandlerFunc(func(rw http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		rw.WriteHeader(http.StatusBadRequest)
+	}))
+	defer badRequest.Close()
+
+	err = w.Send(&Endpoint{Name: "bad", URL: badRequest.URL, Enabled: true}, []byte("{}"))
+	if err == nil {
+		t.Error("expected error on bad request status")
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("client errors should not be retried, got %d attempts", calls)
+	}
+}
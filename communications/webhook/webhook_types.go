@@ -0,0 +1,17 @@
+package webhook
+
+// Endpoint stores a single webhook destination
+type Endpoint struct {
+	Name    string
+	URL     string
+	Secret  string
+	Enabled bool
+}
+
+// Payload is the JSON body POSTed to each webhook endpoint
+type Payload struct {
+	Source    string `json:"source"`
+	Type      string `json:"type"`
+	Message   string `json:"message"`
+	Timestamp int64  `json:"timestamp"`
+}
@@ -8,12 +8,17 @@ import (
 	"fmt"
 	"io"
 	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
 	"path/filepath"
+	"reflect"
 	"runtime"
 	"strconv"
 	"strings"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/calendar"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
@@ -21,9 +26,13 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/currency/forexprovider"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/ntpclient"
+	"github.com/thrasher-corp/gocryptotrader/tracing"
 )
 
 // GetCurrencyConfig returns currency configurations
@@ -273,8 +282,9 @@ func (c *Config) CheckCommunicationsConfig() {
 			c.Communications.SMSGlobalConfig.From = c.Name
 		}
 
-		if len(c.Communications.SMSGlobalConfig.From) > 11 {
-			log.Warnf(log.ConfigMgr, "SMSGlobal config supplied from name exceeds 11 characters, trimming.\n")
+		if len(c.Communications.SMSGlobalConfig.From) > 11 &&
+			!isPhoneNumber(c.Communications.SMSGlobalConfig.From) {
+			log.Warnf(log.ConfigMgr, "SMS config supplied from name exceeds 11 characters, trimming.\n")
 			c.Communications.SMSGlobalConfig.From = c.Communications.SMSGlobalConfig.From[:11]
 		}
 
@@ -302,10 +312,68 @@ func (c *Config) CheckCommunicationsConfig() {
 		}
 	}
 
+	if c.Communications.WebhookConfig.Name == "" {
+		c.Communications.WebhookConfig = WebhookConfig{
+			Name:       "Webhook",
+			MaxRetries: 3,
+			Endpoints: []WebhookEndpoint{
+				{
+					Name:    "zapier",
+					URL:     "https://hooks.zapier.com/hooks/catch/example",
+					Secret:  "secret",
+					Enabled: false,
+				},
+			},
+		}
+	}
+
+	if c.Communications.PushoverConfig.Name == "" {
+		c.Communications.PushoverConfig = PushoverConfig{
+			Name:     "Pushover",
+			APIToken: "token",
+			UserKey:  "user",
+		}
+	}
+
+	if c.Communications.PushbulletConfig.Name == "" {
+		c.Communications.PushbulletConfig = PushbulletConfig{
+			Name:        "Pushbullet",
+			AccessToken: "token",
+		}
+	}
+
+	if c.Communications.PagerDutyConfig.Name == "" {
+		c.Communications.PagerDutyConfig = PagerDutyConfig{
+			Name:       "PagerDuty",
+			RoutingKey: "key",
+		}
+	}
+
+	if c.Communications.OpsgenieConfig.Name == "" {
+		c.Communications.OpsgenieConfig = OpsgenieConfig{
+			Name:   "Opsgenie",
+			APIKey: "key",
+		}
+	}
+
+	if c.Communications.SignalConfig.Name == "" {
+		c.Communications.SignalConfig = SignalConfig{
+			Name:   "Signal",
+			APIURL: "http://localhost:8080",
+			Number: "+1234567890",
+		}
+	}
+
 	if c.Communications.SlackConfig.Name != "Slack" ||
 		c.Communications.SMSGlobalConfig.Name != "SMSGlobal" ||
 		c.Communications.SMTPConfig.Name != "SMTP" ||
-		c.Communications.TelegramConfig.Name != "Telegram" {
+		c.Communications.TelegramConfig.Name != "Telegram" ||
+		c.Communications.WebhookConfig.Name != "Webhook" ||
+		c.Communications.PushoverConfig.Name != "Pushover" ||
+		c.Communications.PushbulletConfig.Name != "Pushbullet" ||
+		c.Communications.PagerDutyConfig.Name != "PagerDuty" ||
+		c.Communications.OpsgenieConfig.Name != "Opsgenie" ||
+		c.Communications.SignalConfig.Name != "Signal" {
 		log.Warnln(log.ConfigMgr, "Communications config name/s not set correctly")
 	}
 	if c.Communications.SlackConfig.Enabled {
@@ -316,6 +384,91 @@ func (c *Config) CheckCommunicationsConfig() {
 			log.Warnln(log.ConfigMgr, "Slack enabled in config but variable data not set, disabling.")
 		}
 	}
+	if c.Communications.SlackConfig.Enabled {
+		if (c.Communications.SlackConfig.ListenAddress != "") !=
+			(c.Communications.SlackConfig.SigningSecret != "") {
+			log.Warnln(log.ConfigMgr, "Slack slash commands require both listenAddress and signingSecret to be set, disabling slash commands.")
+			c.Communications.SlackConfig.ListenAddress = ""
+		}
+		for i := range c.Communications.SlackConfig.AuthorisedUsers {
+			user := &c.Communications.SlackConfig.AuthorisedUsers[i]
+			user.Permission = checkCommsPermission("Slack user "+user.Name,
+				user.Permission)
+		}
+	}
+	if c.Communications.PushoverConfig.Enabled {
+		if c.Communications.PushoverConfig.APIToken == "" ||
+			c.Communications.PushoverConfig.UserKey == "" {
+			c.Communications.PushoverConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Pushover enabled in config but variable data not set, disabling.")
+		}
+	}
+	if c.Communications.PushbulletConfig.Enabled {
+		if c.Communications.PushbulletConfig.AccessToken == "" {
+			c.Communications.PushbulletConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Pushbullet enabled in config but variable data not set, disabling.")
+		}
+	}
+	if c.Communications.PagerDutyConfig.Enabled {
+		if c.Communications.PagerDutyConfig.RoutingKey == "" {
+			c.Communications.PagerDutyConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "PagerDuty enabled in config but variable data not set, disabling.")
+		}
+	}
+	if c.Communications.OpsgenieConfig.Enabled {
+		if c.Communications.OpsgenieConfig.APIKey == "" {
+			c.Communications.OpsgenieConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Opsgenie enabled in config but variable data not set, disabling.")
+		}
+	}
+	if c.Communications.SignalConfig.Enabled {
+		if c.Communications.SignalConfig.APIURL == "" ||
+			c.Communications.SignalConfig.Number == "" ||
+			len(c.Communications.SignalConfig.Recipients) == 0 {
+			c.Communications.SignalConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Signal enabled in config but variable data not set, disabling.")
+		}
+	}
+	for i := range c.Communications.Routes {
+		if len(c.Communications.Routes[i].Mediums) == 0 {
+			log.Warnf(log.ConfigMgr, "Communications route %s has no mediums set, events matching it will not be sent.\n",
+				c.Communications.Routes[i].Name)
+		}
+	}
+	if c.Communications.RetryQueue.Enabled {
+		if !c.Database.Enabled {
+			c.Communications.RetryQueue.Enabled = false
+			log.Warnln(log.ConfigMgr, "Communications retry queue enabled in config but the database is disabled, disabling.")
+		}
+		if c.Communications.RetryQueue.MaxAttempts <= 0 {
+			c.Communications.RetryQueue.MaxAttempts = defaultCommsRetryMaxAttempts
+		}
+	}
+	if c.Communications.Throttle.CoalesceWindow < 0 {
+		c.Communications.Throttle.CoalesceWindow = 0
+		log.Warnln(log.ConfigMgr, "Communications throttle coalesce window cannot be negative, disabling coalescing.")
+	}
+	if c.Communications.Throttle.MaxEventsPerMinute < 0 {
+		c.Communications.Throttle.MaxEventsPerMinute = 0
+		log.Warnln(log.ConfigMgr, "Communications throttle max events per minute cannot be negative, disabling rate limit.")
+	}
+	for i := range c.Communications.Templates {
+		if c.Communications.Templates[i].Template == "" {
+			log.Warnf(log.ConfigMgr, "Communications template for medium %q event type %q is empty, messages will be blank.\n",
+				c.Communications.Templates[i].Medium,
+				c.Communications.Templates[i].EventType)
+		}
+	}
+	switch p := strings.ToLower(c.Communications.SMSGlobalConfig.Provider); p {
+	case SMSProviderSMSGlobal, SMSProviderTwilio, SMSProviderVonage:
+		c.Communications.SMSGlobalConfig.Provider = p
+	case "":
+		c.Communications.SMSGlobalConfig.Provider = SMSProviderSMSGlobal
+	default:
+		log.Warnf(log.ConfigMgr, "SMS provider %q is not supported, disabling.\n",
+			c.Communications.SMSGlobalConfig.Provider)
+		c.Communications.SMSGlobalConfig.Enabled = false
+	}
 	if c.Communications.SMSGlobalConfig.Enabled {
 		if c.Communications.SMSGlobalConfig.Username == "" ||
 			c.Communications.SMSGlobalConfig.Password == "" ||
@@ -338,9 +491,57 @@ func (c *Config) CheckCommunicationsConfig() {
 			c.Communications.TelegramConfig.Enabled = false
 			log.Warnln(log.ConfigMgr, "Telegram enabled in config but variable data not set, disabling.")
 		}
+		for i := range c.Communications.TelegramConfig.AuthorisedClients {
+			client := &c.Communications.TelegramConfig.AuthorisedClients[i]
+			client.Permission = checkCommsPermission("Telegram client "+client.Name,
+				client.Permission)
+		}
+	}
+	if c.Communications.WebhookConfig.Enabled {
+		var enabledEndpoints int
+		for i := range c.Communications.WebhookConfig.Endpoints {
+			if c.Communications.WebhookConfig.Endpoints[i].Enabled &&
+				c.Communications.WebhookConfig.Endpoints[i].URL != "" {
+				enabledEndpoints++
+			}
+		}
+		if enabledEndpoints == 0 {
+			c.Communications.WebhookConfig.Enabled = false
+			log.Warnln(log.ConfigMgr, "Webhook enabled in config but no endpoints set, disabling.")
+		}
+		if c.Communications.WebhookConfig.MaxRetries < 0 {
+			c.Communications.WebhookConfig.MaxRetries = 0
+		}
 	}
 }
 
+// isPhoneNumber returns whether an SMS sender is a phone number rather than an
+// alphanumeric sender ID, which is limited to 11 characters
+func isPhoneNumber(from string) bool {
+	from = strings.TrimPrefix(from, "+")
+	if from == "" {
+		return false
+	}
+	for _, r := range from {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
+
+// checkCommsPermission validates an interactive communications permission
+// level, defaulting to read access if invalid
+func checkCommsPermission(name, permission string) string {
+	switch p := strings.ToLower(permission); p {
+	case CommsPermissionRead, CommsPermissionTrade, CommsPermissionAdmin:
+		return p
+	}
+	log.Warnf(log.ConfigMgr, "%s has invalid permission %q, setting to %s.\n",
+		name, permission, CommsPermissionRead)
+	return CommsPermissionRead
+}
+
 // GetExchangeAssetTypes returns the exchanges supported asset types
 func (c *Config) GetExchangeAssetTypes(exchName string) (asset.Items, error) {
 	exchCfg, err := c.GetExchangeConfig(exchName)
@@ -917,6 +1118,16 @@ func (c *Config) CheckExchangeConfigValues() error {
 					log.Warnf(log.ExchangeSys, WarningPairsLastUpdatedThresholdExceeded, c.Exchanges[i].Name, pairsLastUpdatedWarningThreshold)
 				}
 			}
+			if err := checkProxyAddress(c.Exchanges[i].ProxyAddress); err != nil {
+				log.Errorf(log.ExchangeSys, "Exchange %s proxy address invalid, disabling exchange. Err: %s", c.Exchanges[i].Name, err)
+				c.Exchanges[i].Enabled = false
+				continue
+			}
+			if err := checkProxyAddress(c.Exchanges[i].WebsocketProxyAddress); err != nil {
+				log.Errorf(log.ExchangeSys, "Exchange %s websocket proxy address invalid, disabling exchange. Err: %s", c.Exchanges[i].Name, err)
+				c.Exchanges[i].Enabled = false
+				continue
+			}
 			if c.Exchanges[i].HTTPTimeout <= 0 {
 				log.Warnf(log.ExchangeSys, "Exchange %s HTTP Timeout value not set, defaulting to %v.\n", c.Exchanges[i].Name, defaultHTTPTimeout)
 				c.Exchanges[i].HTTPTimeout = defaultHTTPTimeout
@@ -943,6 +1154,11 @@ func (c *Config) CheckExchangeConfigValues() error {
 					c.Exchanges[i].Name, defaultWebsocketOrderbookBufferLimit)
 				c.Exchanges[i].WebsocketOrderbookBufferLimit = defaultWebsocketOrderbookBufferLimit
 			}
+			if k := c.Exchanges[i].WebsocketKeepalive; k != nil && k.PingInterval > 0 && k.PongTimeout <= 0 {
+				log.Warnf(log.ExchangeSys, "Exchange %s Websocket pong timeout value not set, defaulting to %v.",
+					c.Exchanges[i].Name, defaultWebsocketPongTimeout)
+				k.PongTimeout = defaultWebsocketPongTimeout
+			}
 			err := c.CheckPairConsistency(c.Exchanges[i].Name)
 			if err != nil {
 				log.Errorf(log.ExchangeSys, "Exchange %s: CheckPairConsistency error: %s\n", c.Exchanges[i].Name, err)
@@ -1091,6 +1307,19 @@ func (c *Config) CheckCurrencyConfigValues() error {
 		c.FiatDisplayCurrency = nil
 	}
 
+	displayCurrencies := currency.Currencies{c.Currency.FiatDisplayCurrency}
+	for _, code := range c.Currency.FiatDisplayCurrencies {
+		if code.IsEmpty() || displayCurrencies.Contains(code.Upper()) {
+			continue
+		}
+		if !code.IsFiatCurrency() {
+			log.Warnf(log.ConfigMgr, "Fiat display currency %s is not a fiat currency, removing.\n", code)
+			continue
+		}
+		displayCurrencies = append(displayCurrencies, code.Upper())
+	}
+	c.Currency.FiatDisplayCurrencies = displayCurrencies
+
 	return nil
 }
 
@@ -1168,6 +1397,17 @@ func (c *Config) CheckLoggerConfig() error {
 		c.Logging.AdvancedSettings.ShowLogSystemName = convert.BoolPtr(false)
 	}
 
+	switch f := strings.ToLower(c.Logging.AdvancedSettings.Format); f {
+	case log.FormatText, log.FormatJSON:
+		c.Logging.AdvancedSettings.Format = f
+	case "":
+		c.Logging.AdvancedSettings.Format = log.FormatText
+	default:
+		log.Warnf(log.Global, "Logger format %q invalid, defaulting to %s\n",
+			c.Logging.AdvancedSettings.Format, log.FormatText)
+		c.Logging.AdvancedSettings.Format = log.FormatText
+	}
+
 	if c.Logging.LoggerFileConfig != nil {
 		if c.Logging.LoggerFileConfig.FileName == "" {
 			c.Logging.LoggerFileConfig.FileName = "log.txt"
@@ -1179,9 +1419,22 @@ func (c *Config) CheckLoggerConfig() error {
 			log.Warnf(log.Global, "Logger rotation size invalid, defaulting to %v", log.DefaultMaxFileSize)
 			c.Logging.LoggerFileConfig.MaxSize = log.DefaultMaxFileSize
 		}
+		if c.Logging.LoggerFileConfig.MaxBackups < 0 {
+			log.Warnln(log.Global, "Logger max backups invalid, keeping all rotated log files")
+			c.Logging.LoggerFileConfig.MaxBackups = 0
+		}
+		if c.Logging.LoggerFileConfig.MaxAge < 0 {
+			log.Warnln(log.Global, "Logger max age invalid, keeping all rotated log files")
+			c.Logging.LoggerFileConfig.MaxAge = 0
+		}
+		if c.Logging.LoggerFileConfig.Compress == nil {
+			c.Logging.LoggerFileConfig.Compress = convert.BoolPtr(false)
+		}
 		log.FileLoggingConfiguredCorrectly = true
 	}
 
+	c.checkRemoteLogSinks()
+
 	log.GlobalLogConfig = &c.Logging
 
 	logPath := filepath.Join(common.GetDefaultDataDir(runtime.GOOS), "logs")
@@ -1194,6 +1447,36 @@ func (c *Config) CheckLoggerConfig() error {
 	return nil
 }
 
+// checkRemoteLogSinks removes remote log sinks which are misconfigured or
+// share a name with another output
+func (c *Config) checkRemoteLogSinks() {
+	var sinks []log.RemoteSinkConfig
+	names := []string{"stdout", "console", "stderr", "file"}
+	for i := range c.Logging.RemoteSinks {
+		sink := c.Logging.RemoteSinks[i]
+		name := strings.ToLower(sink.Name)
+		switch {
+		case name == "" || common.StringDataCompare(names, name):
+			log.Warnf(log.Global, "Logger remote sink name %q is empty or already in use, removing.\n",
+				sink.Name)
+			continue
+		case sink.Address == "":
+			log.Warnf(log.Global, "Logger remote sink %s address not set, removing.\n", sink.Name)
+			continue
+		}
+		switch strings.ToLower(sink.Type) {
+		case log.SinkSyslog, log.SinkGELF, log.SinkTCP:
+		default:
+			log.Warnf(log.Global, "Logger remote sink %s type %q invalid, removing.\n",
+				sink.Name, sink.Type)
+			continue
+		}
+		names = append(names, name)
+		sinks = append(sinks, sink)
+	}
+	c.Logging.RemoteSinks = sinks
+}
+
 func (c *Config) checkGCTScriptConfig() error {
 	m.Lock()
 	defer m.Unlock()
@@ -1267,7 +1550,19 @@ func (c *Config) CheckNTPConfig() {
 
 	if len(c.NTPClient.Pool) < 1 {
 		log.Warnln(log.ConfigMgr, "NTPClient enabled with no servers configured, enabling default pool.")
-		c.NTPClient.Pool = []string{"pool.ntp.org:123"}
+		c.NTPClient.Pool = append([]string(nil), ntpclient.DefaultPool...)
+	}
+
+	if c.NTPClient.Samples <= 0 {
+		c.NTPClient.Samples = ntpclient.DefaultSamples
+	}
+
+	if c.NTPClient.Retries == 0 {
+		c.NTPClient.Retries = ntpclient.DefaultRetries
+	}
+
+	if c.NTPClient.Timeout <= 0 {
+		c.NTPClient.Timeout = ntpclient.DefaultTimeout
 	}
 }
 
@@ -1328,6 +1623,650 @@ func (c *Config) CheckConnectionMonitorConfig() {
 	}
 }
 
+// CheckMetricsConfig checks the metrics server config and if zero value
+// assigns the default listen address
+func (c *Config) CheckMetricsConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.Metrics.ListenAddress == "" {
+		c.Metrics.ListenAddress = defaultMetricsListenAddress
+		return
+	}
+
+	if _, port, err := net.SplitHostPort(c.Metrics.ListenAddress); err != nil || port == "" {
+		log.Warnf(log.ConfigMgr, "Metrics listen address %s is invalid, defaulting to %s.\n",
+			c.Metrics.ListenAddress, defaultMetricsListenAddress)
+		c.Metrics.ListenAddress = defaultMetricsListenAddress
+	}
+}
+
+// CheckHealthCheckConfig checks the health check server config and if zero
+// value assigns the default listen address
+func (c *Config) CheckHealthCheckConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.HealthCheck.ListenAddress == "" {
+		c.HealthCheck.ListenAddress = defaultHealthCheckListenAddress
+		return
+	}
+
+	if _, port, err := net.SplitHostPort(c.HealthCheck.ListenAddress); err != nil || port == "" {
+		log.Warnf(log.ConfigMgr, "Health check listen address %s is invalid, defaulting to %s.\n",
+			c.HealthCheck.ListenAddress, defaultHealthCheckListenAddress)
+		c.HealthCheck.ListenAddress = defaultHealthCheckListenAddress
+	}
+}
+
+// CheckResourceMonitorConfig checks the resource monitor config and assigns
+// the default check interval, sample count and growth thresholds to zero or
+// negative values
+func (c *Config) CheckResourceMonitorConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.ResourceMonitor.CheckInterval <= 0 {
+		c.ResourceMonitor.CheckInterval = defaultResourceMonitorInterval
+	}
+	if c.ResourceMonitor.Samples < 2 {
+		c.ResourceMonitor.Samples = defaultResourceMonitorSamples
+	}
+	if c.ResourceMonitor.GoroutineGrowthThreshold <= 0 {
+		c.ResourceMonitor.GoroutineGrowthThreshold = defaultGoroutineGrowthThreshold
+	}
+	if c.ResourceMonitor.HeapGrowthThresholdMB <= 0 {
+		c.ResourceMonitor.HeapGrowthThresholdMB = defaultHeapGrowthThresholdMB
+	}
+	if c.ResourceMonitor.FileDescriptorGrowthThreshold <= 0 {
+		c.ResourceMonitor.FileDescriptorGrowthThreshold = defaultFileDescriptorGrowthThreshold
+	}
+}
+
+// CheckLatencyMonitorConfig checks the latency monitor config and assigns the
+// default check interval and sample count to zero or negative values
+func (c *Config) CheckLatencyMonitorConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.LatencyMonitor.CheckInterval <= 0 {
+		c.LatencyMonitor.CheckInterval = defaultLatencyMonitorInterval
+	}
+	if c.LatencyMonitor.Samples <= 0 {
+		c.LatencyMonitor.Samples = defaultLatencyMonitorSamples
+	}
+}
+
+// CheckTransferManagerConfig checks the transfer manager config and assigns
+// the default check interval and timeout if unset. Hot wallets which aren't
+// limited to a portfolio address, a max amount and a destination are
+// disabled
+func (c *Config) CheckTransferManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.TransferManager.CheckInterval <= 0 {
+		c.TransferManager.CheckInterval = defaultTransferCheckInterval
+	}
+	if c.TransferManager.Timeout <= 0 {
+		c.TransferManager.Timeout = defaultTransferTimeout
+	}
+	if c.TransferManager.Approval.Window <= 0 {
+		c.TransferManager.Approval.Window = defaultWithdrawalApprovalWindow
+	}
+	limits := make(map[string]float64, len(c.TransferManager.Approval.Limits))
+	for code, limit := range c.TransferManager.Approval.Limits {
+		if limit < 0 {
+			log.Warnf(log.ConfigMgr, "Transfer approval limit for %s is negative, approving every transfer.\n", code)
+			limit = 0
+		}
+		limits[strings.ToUpper(code)] = limit
+	}
+	c.TransferManager.Approval.Limits = limits
+
+	names := make(map[string]bool)
+	wallets := c.TransferManager.HotWallets.Wallets
+	for i := range wallets {
+		if !wallets[i].Enabled {
+			continue
+		}
+		var reason string
+		switch {
+		case wallets[i].Name == "":
+			reason = "requires a name"
+		case names[strings.ToLower(wallets[i].Name)]:
+			reason = "has a duplicate name"
+		case !c.isPortfolioAddress(wallets[i].Address):
+			reason = "requires an address in the portfolio addresses"
+		case wallets[i].MaxAmount <= 0:
+			reason = "requires a max amount greater than 0"
+		case len(wallets[i].AllowedExchanges) == 0:
+			reason = "requires allowed exchanges"
+		}
+		names[strings.ToLower(wallets[i].Name)] = true
+		if reason != "" {
+			log.Warnf(log.ConfigMgr, "Hot wallet %q %s, disabling.\n", wallets[i].Name, reason)
+			wallets[i].Enabled = false
+		}
+	}
+}
+
+// isPortfolioAddress returns whether an address is tracked by the portfolio
+func (c *Config) isPortfolioAddress(address string) bool {
+	if address == "" {
+		return false
+	}
+	for i := range c.Portfolio.Addresses {
+		if strings.EqualFold(c.Portfolio.Addresses[i].Address, address) {
+			return true
+		}
+	}
+	return false
+}
+
+// CheckWithdrawalLimitsConfig checks the withdrawal limits config, assigning
+// the default alert percent if unset or invalid and upper casing the limit
+// currencies
+func (c *Config) CheckWithdrawalLimitsConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.WithdrawalLimits.AlertPercent <= 0 || c.WithdrawalLimits.AlertPercent > 100 {
+		if c.WithdrawalLimits.AlertPercent != 0 {
+			log.Warnf(log.ConfigMgr, "Withdrawal limit alert percent %v invalid, setting to default %v.\n",
+				c.WithdrawalLimits.AlertPercent, defaultWithdrawalLimitAlertPercent)
+		}
+		c.WithdrawalLimits.AlertPercent = defaultWithdrawalLimitAlertPercent
+	}
+	limits := make(map[string]float64, len(c.WithdrawalLimits.Daily))
+	for code, limit := range c.WithdrawalLimits.Daily {
+		if limit < 0 {
+			log.Warnf(log.ConfigMgr, "Daily withdrawal limit for %s is negative, refusing every withdrawal.\n", code)
+			limit = 0
+		}
+		limits[strings.ToUpper(code)] = limit
+	}
+	c.WithdrawalLimits.Daily = limits
+}
+
+// CheckSecurityMonitorConfig checks the security monitor config, assigning
+// the defaults when unset or invalid and removing unknown kill switch
+// anomalies
+func (c *Config) CheckSecurityMonitorConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.SecurityMonitor.CheckInterval <= 0 {
+		c.SecurityMonitor.CheckInterval = defaultSecurityCheckInterval
+	}
+	if c.SecurityMonitor.PriceDeviationPercent <= 0 {
+		c.SecurityMonitor.PriceDeviationPercent = defaultSecurityPriceDeviation
+	}
+	if c.SecurityMonitor.BalanceDropPercent <= 0 || c.SecurityMonitor.BalanceDropPercent >= 100 {
+		if c.SecurityMonitor.BalanceDropPercent != 0 {
+			log.Warnf(log.ConfigMgr, "Security monitor balance drop percent %v invalid, setting to default %v.\n",
+				c.SecurityMonitor.BalanceDropPercent, defaultSecurityBalanceDrop)
+		}
+		c.SecurityMonitor.BalanceDropPercent = defaultSecurityBalanceDrop
+	}
+	if c.SecurityMonitor.Currency == "" {
+		c.SecurityMonitor.Currency = defaultTrailingStopCurrency
+	}
+	c.SecurityMonitor.Currency = strings.ToUpper(c.SecurityMonitor.Currency)
+	if c.SecurityMonitor.MaxTickerAge <= 0 {
+		c.SecurityMonitor.MaxTickerAge = defaultPriceAlertMaxTickerAge
+	}
+
+	var killSwitch []string
+	for _, anomaly := range c.SecurityMonitor.KillSwitch {
+		switch anomaly {
+		case SecurityAnomalyOrderPrice, SecurityAnomalyWithdrawalDestination,
+			SecurityAnomalyBalanceDrop, SecurityAnomalyRPCClient:
+			if !common.StringDataCompare(killSwitch, anomaly) {
+				killSwitch = append(killSwitch, anomaly)
+			}
+		default:
+			log.Warnf(log.ConfigMgr, "Security monitor kill switch anomaly %q unknown, removing.\n", anomaly)
+		}
+	}
+	c.SecurityMonitor.KillSwitch = killSwitch
+}
+
+// CheckPriceAlertsConfig checks the price alert config and assigns the
+// default check interval and max ticker age if unset
+func (c *Config) CheckPriceAlertsConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.PriceAlerts.CheckInterval <= 0 {
+		c.PriceAlerts.CheckInterval = defaultPriceAlertCheckInterval
+	}
+	if c.PriceAlerts.MaxTickerAge <= 0 {
+		c.PriceAlerts.MaxTickerAge = defaultPriceAlertMaxTickerAge
+	}
+}
+
+// CheckTrailingStopConfig checks the portfolio trailing stop config, assigning
+// the default check interval, drawdown, currency and max ticker age when
+// unset and removing unknown actions. It alerts when no action is left
+func (c *Config) CheckTrailingStopConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.TrailingStop.CheckInterval <= 0 {
+		c.TrailingStop.CheckInterval = defaultTrailingStopCheckInterval
+	}
+	if c.TrailingStop.DrawdownPercent <= 0 || c.TrailingStop.DrawdownPercent >= 100 {
+		if c.TrailingStop.DrawdownPercent != 0 {
+			log.Warnf(log.ConfigMgr, "Trailing stop drawdown percent %v invalid, setting to default %v.\n",
+				c.TrailingStop.DrawdownPercent, defaultTrailingStopDrawdown)
+		}
+		c.TrailingStop.DrawdownPercent = defaultTrailingStopDrawdown
+	}
+	if c.TrailingStop.Currency == "" {
+		c.TrailingStop.Currency = defaultTrailingStopCurrency
+	}
+	c.TrailingStop.Currency = strings.ToUpper(c.TrailingStop.Currency)
+	if c.TrailingStop.MaxTickerAge <= 0 {
+		c.TrailingStop.MaxTickerAge = defaultPriceAlertMaxTickerAge
+	}
+
+	var actions []string
+	for _, action := range c.TrailingStop.Actions {
+		action = strings.ToLower(action)
+		switch action {
+		case TrailingStopActionAlert, TrailingStopActionHalt, TrailingStopActionFlatten:
+			if !common.StringDataCompare(actions, action) {
+				actions = append(actions, action)
+			}
+		default:
+			log.Warnf(log.ConfigMgr, "Trailing stop action %q unknown, removing.\n", action)
+		}
+	}
+	if len(actions) == 0 {
+		actions = []string{TrailingStopActionAlert}
+	}
+	c.TrailingStop.Actions = actions
+}
+
+// CheckMarginManagerConfig checks the margin manager config, assigning the
+// default check interval and reduce fraction when unset. The default ratios
+// are assigned unless 0 < target < top up < reduce < 1
+func (c *Config) CheckMarginManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.MarginManager.CheckInterval <= 0 {
+		c.MarginManager.CheckInterval = defaultMarginCheckInterval
+	}
+	if c.MarginManager.ReduceFraction <= 0 || c.MarginManager.ReduceFraction > 1 {
+		c.MarginManager.ReduceFraction = defaultMarginReduceFraction
+	}
+	if c.MarginManager.TargetRatio <= 0 ||
+		c.MarginManager.TopUpRatio <= c.MarginManager.TargetRatio ||
+		c.MarginManager.ReduceRatio <= c.MarginManager.TopUpRatio ||
+		c.MarginManager.ReduceRatio >= 1 {
+		if c.MarginManager.TargetRatio != 0 ||
+			c.MarginManager.TopUpRatio != 0 ||
+			c.MarginManager.ReduceRatio != 0 {
+			log.Warnf(log.ConfigMgr, "Margin manager ratios invalid, setting to defaults target %v, top up %v and reduce %v.\n",
+				defaultMarginTargetRatio, defaultMarginTopUpRatio, defaultMarginReduceRatio)
+		}
+		c.MarginManager.TargetRatio = defaultMarginTargetRatio
+		c.MarginManager.TopUpRatio = defaultMarginTopUpRatio
+		c.MarginManager.ReduceRatio = defaultMarginReduceRatio
+	}
+}
+
+// CheckPairRefreshConfig checks the tradable pair refresh config and assigns
+// the default interval if unset
+func (c *Config) CheckPairRefreshConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.PairRefresh.Interval <= 0 {
+		c.PairRefresh.Interval = defaultPairRefreshInterval
+	}
+}
+
+// CheckOpenInterestConfig checks the open interest recorder config and
+// assigns the default interval if unset
+func (c *Config) CheckOpenInterestConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.OpenInterest.Interval <= 0 {
+		c.OpenInterest.Interval = defaultOpenInterestInterval
+	}
+}
+
+// CheckDeFiConfig checks the defi manager config, assigning the default
+// interval if unset and removing pairs which can't be quoted
+func (c *Config) CheckDeFiConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.DeFi.Interval <= 0 {
+		c.DeFi.Interval = defaultDeFiInterval
+	}
+	pairs := c.DeFi.Pairs[:0]
+	for i := range c.DeFi.Pairs {
+		if !validConfigPair(c.DeFi.Pairs[i].Pair) || c.DeFi.Pairs[i].Amount <= 0 {
+			log.Warnf(log.ConfigMgr, "DeFi pair %q requires a pair and an amount greater than 0, removing.\n",
+				c.DeFi.Pairs[i].Pair)
+			continue
+		}
+		pairs = append(pairs, c.DeFi.Pairs[i])
+	}
+	c.DeFi.Pairs = pairs
+}
+
+// validConfigPair returns whether a pair has both a base and quote currency
+func validConfigPair(pair string) bool {
+	if len(pair) <= 3 {
+		return false
+	}
+	p := currency.NewPairFromString(pair)
+	return !p.Base.IsEmpty() && !p.Quote.IsEmpty()
+}
+
+// CheckHedgeManagerConfig checks the hedge manager config, assigning the
+// defaults of unset settings and removing hedges which are invalid or hedge a
+// currency another hedge already does
+func (c *Config) CheckHedgeManagerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.HedgeManager.CheckInterval <= 0 {
+		c.HedgeManager.CheckInterval = defaultHedgeCheckInterval
+	}
+	seen := make(map[string]bool)
+	hedges := c.HedgeManager.Hedges[:0]
+	for i := range c.HedgeManager.Hedges {
+		h := &c.HedgeManager.Hedges[i]
+		if h.Exchange == "" || h.AssetType == "" || !validConfigPair(h.Pair) ||
+			h.Ratio <= 0 || h.Ratio > 1 {
+			log.Warnf(log.ConfigMgr, "Hedge of %s %s requires an exchange, pair, asset type and a ratio between 0 and 1, removing.\n",
+				h.Exchange, h.Pair)
+			continue
+		}
+		if h.Currency == "" {
+			h.Currency = currency.NewPairFromString(h.Pair).Base.String()
+		}
+		h.Currency = strings.ToUpper(h.Currency)
+		if seen[h.Currency] {
+			log.Warnf(log.ConfigMgr, "Hedge of %s is duplicated, removing.\n", h.Currency)
+			continue
+		}
+		seen[h.Currency] = true
+		if h.ContractSize <= 0 {
+			h.ContractSize = 1
+		}
+		if h.Band <= 0 {
+			h.Band = defaultHedgeBand
+		}
+		hedges = append(hedges, *h)
+	}
+	c.HedgeManager.Hedges = hedges
+}
+
+// CheckCandleIntegrityConfig checks the candle integrity checker config,
+// assigning the defaults of unset settings and removing series which are
+// invalid or duplicate another
+func (c *Config) CheckCandleIntegrityConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	ci := &c.CandleIntegrity
+	if ci.CheckInterval <= 0 {
+		ci.CheckInterval = defaultCandleIntegrityInterval
+	}
+	if ci.Lookback <= 0 {
+		ci.Lookback = defaultCandleIntegrityLookback
+	}
+	if ci.BackfillLimit <= 0 {
+		ci.BackfillLimit = defaultCandleBackfillLimit
+	}
+	seen := make(map[CandleSeriesConfig]bool)
+	series := ci.Series[:0]
+	for i := range ci.Series {
+		s := ci.Series[i]
+		if s.Exchange == "" || !validConfigPair(s.Pair) ||
+			s.Interval < time.Second || s.Interval%time.Second != 0 {
+			log.Warnf(log.ConfigMgr, "Candle series %s %s requires an exchange, pair and an interval of whole seconds, removing.\n",
+				s.Exchange, s.Pair)
+			continue
+		}
+		if s.AssetType == "" {
+			s.AssetType = asset.Spot.String()
+		}
+		s.AssetType = strings.ToLower(s.AssetType)
+		s.Pair = strings.ToUpper(s.Pair)
+		key := s
+		key.Exchange = strings.ToLower(key.Exchange)
+		if seen[key] {
+			log.Warnf(log.ConfigMgr, "Candle series %s %s %s %v is duplicated, removing.\n",
+				s.Exchange, s.AssetType, s.Pair, s.Interval)
+			continue
+		}
+		seen[key] = true
+		series = append(series, s)
+	}
+	ci.Series = series
+}
+
+// CheckCalendarConfig checks the venue calendars, removing those which are
+// invalid or duplicate another venue's
+func (c *Config) CheckCalendarConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	seen := make(map[string]bool)
+	venues := c.Calendar.Venues[:0]
+	for i := range c.Calendar.Venues {
+		if _, err := calendar.New(&c.Calendar.Venues[i]); err != nil {
+			log.Warnf(log.ConfigMgr, "Calendar invalid, removing: %v\n", err)
+			continue
+		}
+		name := strings.ToLower(c.Calendar.Venues[i].Name)
+		if seen[name] {
+			log.Warnf(log.ConfigMgr, "Calendar %s is duplicated, removing.\n", c.Calendar.Venues[i].Name)
+			continue
+		}
+		seen[name] = true
+		venues = append(venues, c.Calendar.Venues[i])
+	}
+	c.Calendar.Venues = venues
+}
+
+// CheckStrategiesConfig checks the strategy configs, disabling strategies
+// without a unique ID and removing allocations which are incomplete
+func (c *Config) CheckStrategiesConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	seen := make(map[string]bool)
+	for i := range c.Strategies {
+		s := &c.Strategies[i]
+		if !s.Enabled {
+			continue
+		}
+		if s.ID == "" || seen[strings.ToLower(s.ID)] {
+			log.Warnf(log.ConfigMgr, "Strategy %q requires a unique ID, disabling.\n", s.ID)
+			s.Enabled = false
+			continue
+		}
+		seen[strings.ToLower(s.ID)] = true
+		allocations := s.Allocations[:0]
+		for j := range s.Allocations {
+			if s.Allocations[j].Exchange == "" || s.Allocations[j].Currency == "" || s.Allocations[j].Amount <= 0 {
+				log.Warnf(log.ConfigMgr, "Strategy %s allocation requires an exchange, currency and an amount greater than 0, removing.\n",
+					s.ID)
+				continue
+			}
+			allocations = append(allocations, s.Allocations[j])
+		}
+		s.Allocations = allocations
+
+		s.PartialFillPolicy = strings.ToLower(s.PartialFillPolicy)
+		switch policy := order.PartialFillPolicy(s.PartialFillPolicy); {
+		case policy == "":
+		case !policy.Valid():
+			log.Warnf(log.ConfigMgr, "Strategy %s partial fill policy %s is invalid, leaving partially filled orders.\n",
+				s.ID, s.PartialFillPolicy)
+			s.PartialFillPolicy = string(order.PartialFillLeave)
+		case policy != order.PartialFillLeave && s.PartialFillTimeout <= 0:
+			s.PartialFillTimeout = defaultPartialFillTimeout
+		}
+	}
+}
+
+// CheckProfilerConfig checks the profiler config and if zero value assigns the
+// default debug server listen address
+func (c *Config) CheckProfilerConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.Profiler.ListenAddress == "" {
+		c.Profiler.ListenAddress = defaultProfilerListenAddress
+	} else if _, port, err := net.SplitHostPort(c.Profiler.ListenAddress); err != nil || port == "" {
+		log.Warnf(log.ConfigMgr, "Profiler listen address %s is invalid, defaulting to %s.\n",
+			c.Profiler.ListenAddress, defaultProfilerListenAddress)
+		c.Profiler.ListenAddress = defaultProfilerListenAddress
+	}
+
+	if c.Profiler.MutexProfileFraction < 0 {
+		log.Warnf(log.ConfigMgr, "Profiler mutex profile fraction cannot be negative, disabling.\n")
+		c.Profiler.MutexProfileFraction = 0
+	}
+
+	if c.Profiler.BlockProfileRate < 0 {
+		log.Warnf(log.ConfigMgr, "Profiler block profile rate cannot be negative, disabling.\n")
+		c.Profiler.BlockProfileRate = 0
+	}
+}
+
+// CheckTracingConfig checks the tracing config and assigns the default
+// collector endpoint and service name, tracing is disabled if the endpoint is
+// not a valid HTTP URL
+func (c *Config) CheckTracingConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.Tracing.Endpoint == "" {
+		c.Tracing.Endpoint = tracing.DefaultEndpoint
+	}
+
+	if c.Tracing.ServiceName == "" {
+		c.Tracing.ServiceName = tracing.DefaultServiceName
+	}
+
+	if !c.Tracing.Enabled {
+		return
+	}
+
+	u, err := url.Parse(c.Tracing.Endpoint)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		log.Warnf(log.ConfigMgr, "Tracing endpoint %s is invalid, tracing disabled.\n",
+			c.Tracing.Endpoint)
+		c.Tracing.Enabled = false
+	}
+}
+
+// CheckErrorReportingConfig checks the error reporting config and assigns the
+// default event limit, error reporting is disabled if the DSN is invalid
+func (c *Config) CheckErrorReportingConfig() {
+	m.Lock()
+	defer m.Unlock()
+
+	if c.ErrorReporting.MaxEventsPerMinute <= 0 {
+		c.ErrorReporting.MaxEventsPerMinute = errorreport.DefaultMaxEventsPerMinute
+	}
+
+	if !c.ErrorReporting.Enabled {
+		return
+	}
+
+	if _, err := errorreport.NewSentryReporter(c.ErrorReporting.DSN); err != nil {
+		log.Warnf(log.ConfigMgr, "Error reporting DSN is invalid, error reporting disabled. Err: %s\n", err)
+		c.ErrorReporting.Enabled = false
+	}
+}
+
+// Secrets returns the values of all credential fields in the config, such as
+// exchange API keys and communication tokens, so they can be scrubbed from
+// error reports
+func (c *Config) Secrets() []string {
+	m.Lock()
+	defer m.Unlock()
+	var secrets []string
+	collectSecrets(reflect.ValueOf(c).Elem(), false, &secrets)
+	for i := range c.Exchanges {
+		for _, addr := range []string{c.Exchanges[i].ProxyAddress, c.Exchanges[i].WebsocketProxyAddress} {
+			if u, err := url.Parse(addr); err == nil && u.User != nil {
+				if p, ok := u.User.Password(); ok && p != "" {
+					secrets = append(secrets, p)
+				}
+			}
+		}
+	}
+	return secrets
+}
+
+// checkProxyAddress returns an error if addr is set and is not a HTTP, HTTPS or
+// SOCKS5 proxy URL. The address is left out of the error as it may hold proxy
+// credentials.
+func checkProxyAddress(addr string) error {
+	if addr == "" {
+		return nil
+	}
+	u, err := url.Parse(addr)
+	if err != nil {
+		return errors.New("unable to parse proxy URL")
+	}
+	switch u.Scheme {
+	case "http", "https", "socks5":
+	default:
+		return fmt.Errorf("unsupported proxy scheme %q, must be http, https or socks5", u.Scheme)
+	}
+	if u.Host == "" {
+		return errors.New("proxy URL host not set")
+	}
+	return nil
+}
+
+// collectSecrets walks v appending non empty strings which are, or are held
+// by, a field whose JSON name matches secretFieldPattern
+func collectSecrets(v reflect.Value, secret bool, secrets *[]string) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			collectSecrets(v.Elem(), secret, secrets)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "" {
+				name = f.Name
+			}
+			collectSecrets(v.Field(i), secretFieldPattern.MatchString(name), secrets)
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			collectSecrets(v.Index(i), secret, secrets)
+		}
+	case reflect.String:
+		if secret && v.String() != "" {
+			*secrets = append(*secrets, v.String())
+		}
+	}
+}
+
 // DefaultFilePath returns the default config file path
 // MacOS/Linux: $HOME/.gocryptotrader/config.json or config.dat
 // Windows: %APPDATA%\GoCryptoTrader\config.json or config.dat
@@ -1469,11 +2408,18 @@ func (c *Config) ReadConfig(configPath string, dryrun bool) error {
 	}
 
 	if !ConfirmECS(fileData) {
-		err = ConfirmConfigJSON(fileData, &c)
+		version, err := c.decodeConfigJSON(fileData)
 		if err != nil {
 			return err
 		}
 
+		if version < CurrentConfigVersion {
+			err = c.saveUpgradedConfig(defaultPath, fileData, version, dryrun)
+			if err != nil {
+				return err
+			}
+		}
+
 		if c.EncryptConfig == fileEncryptionDisabled {
 			return nil
 		}
@@ -1489,12 +2435,16 @@ func (c *Config) ReadConfig(configPath string, dryrun bool) error {
 		}
 	} else {
 		errCounter := 0
+		version := CurrentConfigVersion
 		for {
 			if errCounter >= maxAuthFailures {
 				return errors.New("failed to decrypt config after 3 attempts")
 			}
-			key, err := PromptForConfigKey(IsInitialSetup)
+			key, err := getConfigKey(IsInitialSetup)
 			if err != nil {
+				if keyFile != "" {
+					return err
+				}
 				log.Errorf(log.ConfigMgr, "PromptForConfigKey err: %s", err)
 				errCounter++
 				continue
@@ -1504,13 +2454,23 @@ func (c *Config) ReadConfig(configPath string, dryrun bool) error {
 			f = append(f, fileData...)
 			data, err := DecryptConfigFile(f, key)
 			if err != nil {
+				if keyFile != "" {
+					return err
+				}
 				log.Errorf(log.ConfigMgr, "DecryptConfigFile err: %s", err)
 				errCounter++
 				continue
 			}
 
-			err = ConfirmConfigJSON(data, &c)
+			version, err = c.decodeConfigJSON(data)
 			if err != nil {
+				if json.Valid(data) {
+					// Decrypted successfully but the config is invalid
+					return err
+				}
+				if keyFile != "" {
+					return errors.New("invalid key in key file")
+				}
 				if errCounter < maxAuthFailures {
 					log.Error(log.ConfigMgr, "Invalid password.")
 				}
@@ -1519,6 +2479,15 @@ func (c *Config) ReadConfig(configPath string, dryrun bool) error {
 			}
 			break
 		}
+
+		if version < CurrentConfigVersion {
+			return c.saveUpgradedConfig(defaultPath, fileData, version, dryrun)
+		}
+
+		if IsLegacyEncrypted(fileData) && !dryrun {
+			log.Infoln(log.ConfigMgr, "Upgrading config file encryption to Argon2id key derivation.")
+			return c.SaveConfig(defaultPath, dryrun)
+		}
 	}
 	return nil
 }
@@ -1528,22 +2497,48 @@ func (c *Config) SaveConfig(configPath string, dryrun bool) error {
 	if dryrun {
 		return nil
 	}
+	return c.saveConfig(configPath, nil)
+}
+
+// SaveEncryptedConfig enables config encryption and saves the config
+// encrypted with key, without prompting for it. The key replaces any key
+// used earlier in the session.
+func (c *Config) SaveEncryptedConfig(configPath string, key []byte) error {
+	if len(key) == 0 {
+		return errors.New("config encryption key is empty")
+	}
+	c.EncryptConfig = fileEncryptionEnabled
+	sessionDK = nil
+	return c.saveConfig(configPath, key)
+}
 
+// saveConfig saves the config, encrypting it with key if encryption is
+// enabled. Without a key the session key is used, prompting for one on
+// initial setup.
+func (c *Config) saveConfig(configPath string, key []byte) error {
 	defaultPath, err := GetFilePath(configPath)
 	if err != nil {
 		return err
 	}
 
-	payload, err := json.MarshalIndent(c, "", " ")
+	// Neither secrets nor environment variable overrides are written to the
+	// config file
+	cfg := c.withoutSecrets()
+	err = cfg.setEnvironmentOverrides(true)
+	if err != nil {
+		return err
+	}
+	payload, err := json.MarshalIndent(cfg, "", " ")
+	if overrideErr := cfg.setEnvironmentOverrides(false); overrideErr != nil {
+		return overrideErr
+	}
 	if err != nil {
 		return err
 	}
 
 	if c.EncryptConfig == fileEncryptionEnabled {
-		var key []byte
-
-		if IsInitialSetup {
-			key, err = PromptForConfigKey(true)
+		if key == nil && IsInitialSetup {
+			key, err = getConfigKey(true)
 			if err != nil {
 				return err
 			}
@@ -1559,7 +2554,9 @@ func (c *Config) SaveConfig(configPath string, dryrun bool) error {
 }
 
 // CheckRemoteControlConfig checks to see if the old c.Webserver field is used
-// and migrates the existing settings to the new RemoteControl struct
+// and migrates the existing settings to the new RemoteControl struct. It
+// assigns the default session timeout if unset and removes invalid allowed
+// IPs, allowing only loopback addresses if none are left
 func (c *Config) CheckRemoteControlConfig() {
 	m.Lock()
 	defer m.Unlock()
@@ -1599,6 +2596,32 @@ func (c *Config) CheckRemoteControlConfig() {
 		// Then flush the old webserver settings
 		c.Webserver = nil
 	}
+
+	if c.RemoteControl.SessionTimeout <= 0 {
+		c.RemoteControl.SessionTimeout = defaultRPCSessionTimeout
+	}
+	if c.RemoteControl.MaxSessions < 0 {
+		c.RemoteControl.MaxSessions = 0
+	}
+	if len(c.RemoteControl.AllowedIPs) == 0 {
+		return
+	}
+	var allowed []string
+	for _, ip := range c.RemoteControl.AllowedIPs {
+		ip = strings.TrimSpace(ip)
+		if net.ParseIP(ip) == nil {
+			if _, _, err := net.ParseCIDR(ip); err != nil {
+				log.Warnf(log.ConfigMgr, "Remote control allowed IP %q is not an address or CIDR range, removing.\n", ip)
+				continue
+			}
+		}
+		allowed = append(allowed, ip)
+	}
+	if len(allowed) == 0 {
+		log.Warnln(log.ConfigMgr, "Remote control has no valid allowed IPs, allowing loopback addresses only.")
+		allowed = []string{"127.0.0.1", "::1"}
+	}
+	c.RemoteControl.AllowedIPs = allowed
 }
 
 // CheckConfig checks all config settings
@@ -1624,6 +2647,26 @@ func (c *Config) CheckConfig() error {
 	}
 
 	c.CheckConnectionMonitorConfig()
+	c.CheckProfilerConfig()
+	c.CheckMetricsConfig()
+	c.CheckTracingConfig()
+	c.CheckHealthCheckConfig()
+	c.CheckErrorReportingConfig()
+	c.CheckResourceMonitorConfig()
+	c.CheckLatencyMonitorConfig()
+	c.CheckTransferManagerConfig()
+	c.CheckWithdrawalLimitsConfig()
+	c.CheckSecurityMonitorConfig()
+	c.CheckPriceAlertsConfig()
+	c.CheckTrailingStopConfig()
+	c.CheckMarginManagerConfig()
+	c.CheckPairRefreshConfig()
+	c.CheckOpenInterestConfig()
+	c.CheckDeFiConfig()
+	c.CheckCalendarConfig()
+	c.CheckStrategiesConfig()
+	c.CheckHedgeManagerConfig()
+	c.CheckCandleIntegrityConfig()
 	c.CheckCommunicationsConfig()
 	c.CheckClientBankAccounts()
 	c.CheckRemoteControlConfig()
@@ -1645,12 +2688,58 @@ func (c *Config) CheckConfig() error {
 	return nil
 }
 
-// LoadConfig loads your configuration file into your configuration object
+// decodeConfigJSON upgrades data to the current config version and validates
+// it against the config schema before decoding it, so every problem is
+// reported at once along with the path of its field. It returns the config
+// version data was upgraded from.
+func (c *Config) decodeConfigJSON(data []byte) (int, error) {
+	data, version, err := migrateConfigJSON(data)
+	if err != nil {
+		return 0, err
+	}
+	if errs, ok := ValidateJSON(data).(ValidationErrors); ok {
+		for i := range errs {
+			if errs[i].Warning {
+				log.Warnf(log.ConfigMgr, "Config field %s, ignoring\n", errs[i])
+			}
+		}
+		if problems := errs.problems(); len(problems) > 0 {
+			return 0, problems
+		}
+	}
+	return version, ConfirmConfigJSON(data, c)
+}
+
+// LoadConfig loads your configuration file into your configuration object,
+// applying environment variable overrides and secrets and validating the
+// result
 func (c *Config) LoadConfig(configPath string, dryrun bool) error {
 	err := c.ReadConfig(configPath, dryrun)
 	if err != nil {
 		return fmt.Errorf(ErrFailureOpeningConfig, configPath, err)
 	}
+	return c.Prepare()
+}
+
+// Prepare applies environment overrides and secrets to a config, then
+// validates it and sets defaults for any missing values. LoadConfig calls it
+// once the config file is read, configs built in memory must be prepared
+// before use
+func (c *Config) Prepare() error {
+	err := c.applyEnvironmentOverrides(os.Environ())
+	if err != nil {
+		return err
+	}
+
+	err = c.loadSecretsBackend()
+	if err != nil {
+		return err
+	}
+
+	err = c.Validate()
+	if err != nil {
+		return err
+	}
 
 	return c.CheckConfig()
 }
@@ -1681,5 +2770,15 @@ func (c *Config) UpdateConfig(configPath string, newCfg *Config, dryrun bool) er
 
 // GetConfig returns a pointer to a configuration object
 func GetConfig() *Config {
-	return &Cfg
+	cfgMtx.RLock()
+	defer cfgMtx.RUnlock()
+	return current
+}
+
+// SetConfig sets the config returned by GetConfig, for applications
+// embedding the engine with a config they have built themselves
+func SetConfig(c *Config) {
+	cfgMtx.Lock()
+	current = c
+	cfgMtx.Unlock()
 }
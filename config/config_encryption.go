@@ -4,11 +4,10 @@ import (
 	"bytes"
 	"crypto/aes"
 	"crypto/cipher"
-	"crypto/rand"
 	"encoding/json"
 	"errors"
 	"fmt"
-	"io"
+	"io/ioutil"
 	"log"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -20,17 +19,31 @@ const (
 	// EncryptConfirmString has a the general confirmation string to allow us to
 	// see if the file is correctly encrypted
 	EncryptConfirmString = "THORS-HAMMER"
-	// SaltPrefix string
+	// SaltPrefix string prefixes the salt of legacy scrypt encrypted files
 	SaltPrefix = "~GCT~SO~SALTY~"
 	// SaltRandomLength is the number of random bytes to append after the prefix string
 	SaltRandomLength = 12
 
+	// EncryptionHeaderPrefix follows the confirmation string in versioned
+	// encrypted files, it is followed by the version and the key derivation
+	// parameters
+	EncryptionHeaderPrefix = "~GCT~ENC~"
+	// EncryptionVersion is the version of the Argon2id and AES-GCM scheme
+	// config files are encrypted with
+	EncryptionVersion = 2
+
+	// encryptionHeaderLen is the length of the prefix, the version byte and
+	// the Argon2id parameters
+	encryptionHeaderLen = len(EncryptionHeaderPrefix) + 1 + crypto.Argon2ParamsLength
+
 	errAESBlockSize = "config file data is too small for the AES required block size"
 )
 
 var (
-	storedSalt []byte
-	sessionDK  []byte
+	storedSalt    []byte
+	sessionDK     []byte
+	sessionParams crypto.Argon2Params
+	keyFile       string
 )
 
 // PromptForConfigEncryption asks for encryption key
@@ -92,8 +105,40 @@ func PromptForConfigKey(initialSetup bool) ([]byte, error) {
 	return cryptoKey, nil
 }
 
+// SetEncryptionKeyFile sets a file to read the config encryption key from
+// instead of prompting for it, for headless deployments. It must be set
+// before the config is loaded.
+func SetEncryptionKeyFile(path string) {
+	keyFile = path
+}
+
+// ReadKeyFile reads an encryption key from a file, trailing whitespace such as
+// a newline is not part of the key
+func ReadKeyFile(path string) ([]byte, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read key file: %s", err)
+	}
+	key := bytes.TrimRight(data, " \t\r\n")
+	if len(key) == 0 {
+		return nil, fmt.Errorf("key file %s is empty", path)
+	}
+	return key, nil
+}
+
+// getConfigKey returns the key from the key file if one is set, otherwise it
+// prompts for it
+func getConfigKey(initialSetup bool) ([]byte, error) {
+	if keyFile != "" {
+		return ReadKeyFile(keyFile)
+	}
+	return PromptForConfigKey(initialSetup)
+}
+
 // EncryptConfigFile encrypts configuration data that is parsed in with a key
-// and returns it as a byte array with an error
+// and returns it as a byte array with an error. The key is derived with
+// Argon2id and the data encrypted with AES-256-GCM, the key derived for the
+// session is reused so later saves don't need the key.
 func EncryptConfigFile(configData, key []byte) ([]byte, error) {
 	var err error
 
@@ -104,32 +149,78 @@ func EncryptConfigFile(configData, key []byte) ([]byte, error) {
 		}
 	}
 
-	block, err := aes.NewCipher(sessionDK)
+	header := []byte(EncryptConfirmString)
+	header = append(header, encryptionHeader(sessionParams)...)
+	header = append(header, storedSalt...)
+	return crypto.SealAESGCM(sessionDK, header, configData)
+}
+
+// DecryptConfigFile decrypts configuration data with the supplied key and
+// returns the un-encrypted file as a byte array with an error. Files encrypted
+// with the legacy scrypt and AES-CFB scheme are still decrypted, the next save
+// encrypts them with the current scheme.
+func DecryptConfigFile(configData, key []byte) ([]byte, error) {
+	var result []byte
+	var err error
+	if IsLegacyEncrypted(configData) {
+		result, err = decryptLegacyConfigFile(RemoveECS(configData), key)
+	} else {
+		result, err = decryptVersionedConfigFile(configData, key)
+	}
 	if err != nil {
 		return nil, err
 	}
 
-	ciphertext := make([]byte, aes.BlockSize+len(configData))
-	iv := ciphertext[:aes.BlockSize]
-	if _, err := io.ReadFull(rand.Reader, iv); err != nil {
+	sessionDK, err = makeNewSessionDK(key)
+	if err != nil {
 		return nil, err
 	}
+	return result, nil
+}
 
-	stream := cipher.NewCFBEncrypter(block, iv)
-	stream.XORKeyStream(ciphertext[aes.BlockSize:], configData)
+// IsLegacyEncrypted returns whether the encrypted data uses the legacy scrypt
+// and AES-CFB scheme, which carries no version header
+func IsLegacyEncrypted(file []byte) bool {
+	return !bytes.HasPrefix(RemoveECS(file), []byte(EncryptionHeaderPrefix))
+}
 
-	appendedFile := []byte(EncryptConfirmString)
-	appendedFile = append(appendedFile, storedSalt...)
-	appendedFile = append(appendedFile, ciphertext...)
-	return appendedFile, nil
+// encryptionHeader returns the version header of files encrypted with params
+func encryptionHeader(params crypto.Argon2Params) []byte {
+	header := append([]byte(EncryptionHeaderPrefix), EncryptionVersion)
+	return append(header, params.Bytes()...)
 }
 
-// DecryptConfigFile decrypts configuration data with the supplied key and
-// returns the un-encrypted file as a byte array with an error
-func DecryptConfigFile(configData, key []byte) ([]byte, error) {
-	configData = RemoveECS(configData)
-	origKey := key
+func decryptVersionedConfigFile(configData, key []byte) ([]byte, error) {
+	headerLen := len(EncryptConfirmString) + encryptionHeaderLen
+	if len(configData) < headerLen+crypto.Argon2SaltLength {
+		return nil, errors.New("config file encryption header is truncated")
+	}
+	header := configData[len(EncryptConfirmString):headerLen]
+	n := len(EncryptionHeaderPrefix)
+	if header[n] != EncryptionVersion {
+		return nil, fmt.Errorf("unsupported config file encryption version %d", header[n])
+	}
+	params, err := crypto.ParseArgon2Params(header[n+1:])
+	if err != nil {
+		return nil, err
+	}
+	salt := configData[headerLen : headerLen+crypto.Argon2SaltLength]
 
+	dk, err := crypto.Argon2Key(key, salt, params)
+	if err != nil {
+		return nil, err
+	}
+	result, err := crypto.OpenAESGCM(dk, configData, headerLen+crypto.Argon2SaltLength)
+	if err == crypto.ErrCiphertextTruncated {
+		return nil, errors.New(errAESBlockSize)
+	}
+	if err != nil {
+		return nil, errors.New("unable to decrypt config file, invalid key or corrupted file")
+	}
+	return result, nil
+}
+
+func decryptLegacyConfigFile(configData, key []byte) ([]byte, error) {
 	if ConfirmSalt(configData) {
 		salt := make([]byte, len(SaltPrefix)+SaltRandomLength)
 		salt = configData[0:len(salt)]
@@ -157,14 +248,7 @@ func DecryptConfigFile(configData, key []byte) ([]byte, error) {
 
 	stream := cipher.NewCFBDecrypter(blockDecrypt, iv)
 	stream.XORKeyStream(configData, configData)
-	result := configData
-
-	sessionDK, err = makeNewSessionDK(origKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return result, nil
+	return configData, nil
 }
 
 // ConfirmConfigJSON confirms JSON in file
@@ -184,7 +268,7 @@ func ConfirmECS(file []byte) bool {
 
 // RemoveECS removes encryption confirmation string
 func RemoveECS(file []byte) []byte {
-	return bytes.Trim(file, EncryptConfirmString)
+	return bytes.TrimPrefix(file, []byte(EncryptConfirmString))
 }
 
 func getScryptDK(key, salt []byte) ([]byte, error) {
@@ -195,16 +279,17 @@ func getScryptDK(key, salt []byte) ([]byte, error) {
 }
 
 func makeNewSessionDK(key []byte) ([]byte, error) {
-	var err error
-	storedSalt, err = crypto.GetRandomSalt([]byte(SaltPrefix), SaltRandomLength)
+	salt, err := crypto.GetRandomSalt(nil, crypto.Argon2SaltLength)
 	if err != nil {
 		return nil, err
 	}
 
-	dk, err := getScryptDK(key, storedSalt)
+	dk, err := crypto.Argon2Key(key, salt, crypto.DefaultArgon2Params)
 	if err != nil {
 		return nil, err
 	}
 
+	storedSalt = salt
+	sessionParams = crypto.DefaultArgon2Params
 	return dk, nil
 }
@@ -1,8 +1,18 @@
 package config
 
 import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"io"
 	"io/ioutil"
+	"math"
+	"os"
+	"path/filepath"
+	"strings"
 	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 )
 
 func TestPromptForConfigEncryption(t *testing.T) {
@@ -125,3 +135,188 @@ func TestMakeNewSessionDK(t *testing.T) {
 		t.Fatal("makeNewSessionDK passed with nil key")
 	}
 }
+
+// encryptLegacyConfigFile encrypts data with the legacy scrypt and AES-CFB
+// scheme
+func encryptLegacyConfigFile(t *testing.T, data, key []byte) []byte {
+	t.Helper()
+	salt, err := crypto.GetRandomSalt([]byte(SaltPrefix), SaltRandomLength)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dk, err := getScryptDK(key, salt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	block, err := aes.NewCipher(dk)
+	if err != nil {
+		t.Fatal(err)
+	}
+	ciphertext := make([]byte, aes.BlockSize+len(data))
+	_, err = io.ReadFull(rand.Reader, ciphertext[:aes.BlockSize])
+	if err != nil {
+		t.Fatal(err)
+	}
+	cipher.NewCFBEncrypter(block, ciphertext[:aes.BlockSize]).XORKeyStream(ciphertext[aes.BlockSize:], data)
+	result := append([]byte(EncryptConfirmString), salt...)
+	return append(result, ciphertext...)
+}
+
+func TestDecryptLegacyConfigFile(t *testing.T) {
+	legacy := encryptLegacyConfigFile(t, []byte("test"), []byte("key"))
+	if !IsLegacyEncrypted(legacy) {
+		t.Fatal("expected legacy encrypted file")
+	}
+	result, err := DecryptConfigFile(legacy, []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result) != "test" {
+		t.Errorf("expected test, got %s", result)
+	}
+
+	upgraded, err := EncryptConfigFile(result, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if IsLegacyEncrypted(upgraded) {
+		t.Error("expected file encrypted with the current scheme")
+	}
+	result, err = DecryptConfigFile(upgraded, []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(result) != "test" {
+		t.Errorf("expected test, got %s", result)
+	}
+}
+
+func TestDecryptVersionedConfigFile(t *testing.T) {
+	sessionDK = nil
+	result, err := EncryptConfigFile([]byte("test"), []byte("key"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	_, err = DecryptConfigFile(result, []byte("wrong"))
+	if err == nil {
+		t.Error("expected error decrypting with the wrong key")
+	}
+
+	tampered := append([]byte(nil), result...)
+	tampered[len(tampered)-1] ^= 0xff
+	_, err = DecryptConfigFile(tampered, []byte("key"))
+	if err == nil {
+		t.Error("expected error decrypting tampered data")
+	}
+
+	tampered = append([]byte(nil), result...)
+	tampered[len(EncryptConfirmString)+len(EncryptionHeaderPrefix)] = EncryptionVersion + 1
+	_, err = DecryptConfigFile(tampered, []byte("key"))
+	if err == nil {
+		t.Error("expected error decrypting an unsupported version")
+	}
+
+	_, err = DecryptConfigFile(result[:len(EncryptConfirmString)+encryptionHeaderLen], []byte("key"))
+	if err == nil {
+		t.Error("expected error decrypting a truncated file")
+	}
+
+	d := crypto.DefaultArgon2Params
+	for _, params := range []crypto.Argon2Params{
+		{Time: crypto.Argon2MaxTime + 1, Memory: d.Memory, Threads: d.Threads},
+		{Time: d.Time, Memory: math.MaxUint32, Threads: d.Threads},
+		{Time: d.Time, Memory: d.Memory, Threads: crypto.Argon2MaxThreads + 1},
+	} {
+		tampered = append([]byte(EncryptConfirmString), encryptionHeader(params)...)
+		tampered = append(tampered, result[len(EncryptConfirmString)+encryptionHeaderLen:]...)
+		_, err = DecryptConfigFile(tampered, []byte("key"))
+		if err == nil || !strings.Contains(err.Error(), "exceed the maximum") {
+			t.Errorf("expected error decrypting with argon2 parameters %+v, received %v", params, err)
+		}
+	}
+}
+
+func TestReadKeyFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "keyfile")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	path := filepath.Join(dir, "key")
+	_, err = ReadKeyFile(path)
+	if err == nil {
+		t.Error("expected error reading a missing key file")
+	}
+
+	err = ioutil.WriteFile(path, []byte("\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ReadKeyFile(path)
+	if err == nil {
+		t.Error("expected error reading an empty key file")
+	}
+
+	err = ioutil.WriteFile(path, []byte("hunter2\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err := ReadKeyFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(key) != "hunter2" {
+		t.Errorf("expected hunter2, got %q", key)
+	}
+}
+
+func TestReadConfigMigratesLegacyEncryption(t *testing.T) {
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	keyPath := filepath.Join(dir, "key")
+	err = ioutil.WriteFile(keyPath, []byte("key"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	SetEncryptionKeyFile(keyPath)
+	defer SetEncryptionKeyFile("")
+
+	data := []byte(`{"name":"test","encryptConfig":1}`)
+	cfgPath := filepath.Join(dir, "config.json")
+	err = ioutil.WriteFile(cfgPath, encryptLegacyConfigFile(t, data, []byte("key")), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c Config
+	err = c.ReadConfig(cfgPath, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Name == "" {
+		t.Error("expected config to be decrypted")
+	}
+
+	migrated, err := ioutil.ReadFile(cfgPath)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !ConfirmECS(migrated) || IsLegacyEncrypted(migrated) {
+		t.Fatal("expected config file to be re-encrypted with the current scheme")
+	}
+
+	err = ioutil.WriteFile(keyPath, []byte("wrong"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = c.ReadConfig(cfgPath, false)
+	if err == nil {
+		t.Error("expected error reading config with the wrong key file")
+	}
+}
@@ -0,0 +1,379 @@
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Environment variable override settings
+const (
+	// EnvironmentPrefix prefixes every environment variable which overrides a
+	// config field
+	EnvironmentPrefix = "GCT"
+
+	envSeparator        = "_"
+	envExchangesSegment = "EXCHANGES"
+)
+
+// envExchangeAliases maps the shorthand exchange credential variable names to
+// the config fields they override, e.g. GCT_EXCHANGES_BINANCE_APIKEY to
+// GCT_EXCHANGES_BINANCE_API_CREDENTIALS_KEY
+var envExchangeAliases = map[string]string{
+	"APIKEY":    "API_CREDENTIALS_KEY",
+	"APISECRET": "API_CREDENTIALS_SECRET",
+	"CLIENTID":  "API_CREDENTIALS_CLIENTID",
+	"PEMKEY":    "API_CREDENTIALS_PEMKEY",
+	"OTPSECRET": "API_CREDENTIALS_OTPSECRET",
+}
+
+var (
+	durationType    = reflect.TypeOf(time.Duration(0))
+	timeType        = reflect.TypeOf(time.Time{})
+	unmarshalerType = reflect.TypeOf((*json.Unmarshaler)(nil)).Elem()
+)
+
+// envOverride stores the JSON encoded value of a config field before and
+// after it was overridden by an environment variable
+type envOverride struct {
+	original json.RawMessage
+	override json.RawMessage
+}
+
+// envWalker sets the config fields named by environment variables
+type envWalker struct {
+	values    map[string]string
+	used      map[string]bool
+	overrides map[string]*envOverride
+	// restore is set when values are JSON encoded field values to restore
+	restore bool
+}
+
+// EnvironmentVariableName returns the name of the environment variable which
+// overrides the config field at the given path of JSON field names, exchange
+// names and slice indexes, e.g. "exchanges", "Binance", "enabled"
+func EnvironmentVariableName(path ...string) string {
+	segments := make([]string, len(path)+1)
+	segments[0] = EnvironmentPrefix
+	for i := range path {
+		segments[i+1] = envSegment(path[i])
+	}
+	return strings.Join(segments, envSeparator)
+}
+
+// applyEnvironmentOverrides sets the config fields named by the environment
+// variables in environ, which is in the form returned by os.Environ. Values
+// are parsed according to the field type with slices being comma separated and
+// structs and maps being JSON. The original values are kept so overrides are
+// never written to the config file.
+func (c *Config) applyEnvironmentOverrides(environ []string) error {
+	values := make(map[string]string)
+	for i := range environ {
+		kv := strings.SplitN(environ[i], "=", 2)
+		if len(kv) != 2 || !strings.HasPrefix(kv[0], EnvironmentPrefix+envSeparator) {
+			continue
+		}
+		values[envCanonicalName(kv[0])] = kv[1]
+	}
+	if len(values) == 0 {
+		return nil
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	w := envWalker{
+		values:    values,
+		used:      make(map[string]bool),
+		overrides: c.envOverrides,
+	}
+	if w.overrides == nil {
+		w.overrides = make(map[string]*envOverride)
+	}
+	err := w.walk(reflect.ValueOf(c).Elem(), EnvironmentPrefix, true)
+	if err != nil {
+		return err
+	}
+	c.envOverrides = w.overrides
+	for name := range values {
+		if w.used[name] {
+			log.Debugf(log.ConfigMgr, "Config overridden by environment variable %s\n", name)
+			continue
+		}
+		log.Warnf(log.ConfigMgr, "Environment variable %s does not match a config field\n", name)
+	}
+	return nil
+}
+
+// setEnvironmentOverrides sets the fields overridden by environment variables
+// to either their original or overridden values
+func (c *Config) setEnvironmentOverrides(original bool) error {
+	if len(c.envOverrides) == 0 {
+		return nil
+	}
+	values := make(map[string]string, len(c.envOverrides))
+	for name, o := range c.envOverrides {
+		if original {
+			values[name] = string(o.original)
+		} else {
+			values[name] = string(o.override)
+		}
+	}
+	w := envWalker{values: values, used: make(map[string]bool), restore: true}
+	return w.walk(reflect.ValueOf(c).Elem(), EnvironmentPrefix, false)
+}
+
+// walk sets v, or the fields it holds, from the matching values, storing the
+// original and overridden values of the fields set when record is set
+func (w *envWalker) walk(v reflect.Value, name string, record bool) error {
+	if value, ok := w.values[name]; ok {
+		w.used[name] = true
+		if w.restore {
+			v.Set(reflect.Zero(v.Type()))
+			return json.Unmarshal([]byte(value), v.Addr().Interface())
+		}
+		original, err := json.Marshal(v.Interface())
+		if err != nil {
+			return err
+		}
+		err = setEnvironmentValue(v, value)
+		if err != nil {
+			return fmt.Errorf("environment variable %s: %s", name, err)
+		}
+		if !record {
+			return nil
+		}
+		return w.record(v, name, original)
+	}
+	if !w.hasChildren(name) || !isEnvContainer(v.Type()) {
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.Ptr:
+		if !v.IsNil() {
+			return w.walk(v.Elem(), name, record)
+		}
+		if w.restore {
+			return nil
+		}
+		// The field is only allocated when overridden, in which case the
+		// whole field is recorded as overridden so it is left unset on save
+		v.Set(reflect.New(v.Type().Elem()))
+		err := w.walk(v.Elem(), name, false)
+		if err != nil {
+			return err
+		}
+		return w.record(v, name, json.RawMessage("null"))
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			tag := strings.Split(f.Tag.Get("json"), ",")[0]
+			if tag == "-" {
+				continue
+			}
+			fieldName := name
+			if tag != "" || !f.Anonymous {
+				if tag == "" {
+					tag = f.Name
+				}
+				fieldName = name + envSeparator + envSegment(tag)
+			}
+			err := w.walk(v.Field(i), fieldName, record)
+			if err != nil {
+				return err
+			}
+		}
+	case reflect.Slice:
+		for i := 0; i < v.Len(); i++ {
+			err := w.walk(v.Index(i), name+envSeparator+envElementKey(v.Index(i), i), record)
+			if err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// record stores the original and overridden values of a field
+func (w *envWalker) record(v reflect.Value, name string, original json.RawMessage) error {
+	override, err := json.Marshal(v.Interface())
+	if err != nil {
+		return err
+	}
+	if o, ok := w.overrides[name]; ok {
+		// Keep the value from before the first override
+		original = o.original
+	}
+	w.overrides[name] = &envOverride{original: original, override: override}
+	return nil
+}
+
+// hasChildren returns whether any value is for a field held by name
+func (w *envWalker) hasChildren(name string) bool {
+	prefix := name + envSeparator
+	for k := range w.values {
+		if strings.HasPrefix(k, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// isEnvContainer returns whether the fields held by a value of type t are
+// overridden individually, rather than only as a whole
+func isEnvContainer(t reflect.Type) bool {
+	if reflect.PtrTo(t).Implements(unmarshalerType) || t.Implements(unmarshalerType) {
+		return false
+	}
+	switch t.Kind() {
+	case reflect.Ptr:
+		return isEnvContainer(t.Elem())
+	case reflect.Struct:
+		return t != timeType
+	case reflect.Slice:
+		return t.Elem().Kind() == reflect.Struct && isEnvContainer(t.Elem())
+	}
+	return false
+}
+
+// setEnvironmentValue parses s according to the type of v and sets it
+func setEnvironmentValue(v reflect.Value, s string) error {
+	if v.Type() == durationType {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			var ns int64
+			ns, err = strconv.ParseInt(s, 10, 64)
+			if err != nil {
+				return fmt.Errorf("invalid duration")
+			}
+			d = time.Duration(ns)
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+	if reflect.PtrTo(v.Type()).Implements(unmarshalerType) {
+		// Values such as currency codes are accepted as JSON or plain strings
+		err := json.Unmarshal([]byte(s), v.Addr().Interface())
+		if err != nil {
+			quoted, _ := json.Marshal(s)
+			err = json.Unmarshal(quoted, v.Addr().Interface())
+		}
+		return err
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return fmt.Errorf("invalid bool")
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		i, err := strconv.ParseInt(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid integer")
+		}
+		v.SetInt(i)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		u, err := strconv.ParseUint(s, 10, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid unsigned integer")
+		}
+		v.SetUint(u)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return fmt.Errorf("invalid number")
+		}
+		v.SetFloat(f)
+	case reflect.Ptr:
+		elem := reflect.New(v.Type().Elem())
+		err := setEnvironmentValue(elem.Elem(), s)
+		if err != nil {
+			return err
+		}
+		v.Set(elem)
+	case reflect.Slice:
+		if strings.HasPrefix(strings.TrimSpace(s), "[") {
+			return json.Unmarshal([]byte(s), v.Addr().Interface())
+		}
+		var parts []string
+		if s != "" {
+			parts = strings.Split(s, ",")
+		}
+		slice := reflect.MakeSlice(v.Type(), len(parts), len(parts))
+		for i := range parts {
+			err := setEnvironmentValue(slice.Index(i), strings.TrimSpace(parts[i]))
+			if err != nil {
+				return err
+			}
+		}
+		v.Set(slice)
+	default:
+		err := json.Unmarshal([]byte(s), v.Addr().Interface())
+		if err != nil {
+			return fmt.Errorf("invalid JSON")
+		}
+	}
+	return nil
+}
+
+// envElementKey returns the name segment of a slice element, being its name
+// when it has one or otherwise its index
+func envElementKey(v reflect.Value, i int) string {
+	for v.Kind() == reflect.Ptr {
+		if v.IsNil() {
+			return strconv.Itoa(i)
+		}
+		v = v.Elem()
+	}
+	if v.Kind() == reflect.Struct {
+		if f, ok := v.Type().FieldByName("Name"); ok && f.Type.Kind() == reflect.String {
+			if name := v.FieldByIndex(f.Index).String(); name != "" {
+				return envSegment(name)
+			}
+		}
+	}
+	return strconv.Itoa(i)
+}
+
+// envCanonicalName returns the variable name with exchange credential aliases
+// expanded
+func envCanonicalName(name string) string {
+	prefix := EnvironmentPrefix + envSeparator + envExchangesSegment + envSeparator
+	if !strings.HasPrefix(name, prefix) {
+		return name
+	}
+	parts := strings.SplitN(strings.TrimPrefix(name, prefix), envSeparator, 2)
+	if len(parts) != 2 {
+		return name
+	}
+	if alias, ok := envExchangeAliases[parts[1]]; ok {
+		return prefix + parts[0] + envSeparator + alias
+	}
+	return name
+}
+
+// envSegment returns s upper cased with all but letters and digits removed
+func envSegment(s string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z':
+			return r - 'a' + 'A'
+		case r >= 'A' && r <= 'Z', r >= '0' && r <= '9':
+			return r
+		}
+		return -1
+	}, s)
+}
@@ -0,0 +1,108 @@
+package config
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestEnvironmentVariableName(t *testing.T) {
+	t.Parallel()
+	name := EnvironmentVariableName("exchanges", "Coinbase-Pro", "httpTimeout")
+	if name != "GCT_EXCHANGES_COINBASEPRO_HTTPTIMEOUT" {
+		t.Errorf("unexpected name %s", name)
+	}
+}
+
+func TestApplyEnvironmentOverrides(t *testing.T) {
+	t.Parallel()
+	c := &Config{
+		Name: "test",
+		Exchanges: []ExchangeConfig{
+			{Name: "Binance", API: APIConfig{Credentials: APICredentialsConfig{Key: "key"}}},
+			{Name: "Bitstamp"},
+		},
+	}
+	c.Database.Port = 5432
+	c.ConnectionMonitor.DNSList = []string{"8.8.8.8"}
+
+	err := c.applyEnvironmentOverrides([]string{
+		"PATH=/usr/bin",
+		"GCT_NAME=overridden",
+		"GCT_EXCHANGES_BINANCE_APIKEY=envkey",
+		"GCT_EXCHANGES_BINANCE_API_CREDENTIALS_SECRET=envsecret",
+		"GCT_EXCHANGES_BITSTAMP_ENABLED=true",
+		"GCT_EXCHANGES_BITSTAMP_HTTPTIMEOUT=20s",
+		"GCT_EXCHANGES_BITSTAMP_HTTPRETRY_MAXRETRIES=3",
+		"GCT_DATABASE_CONNECTIONDETAILS_PORT=5433",
+		"GCT_CONNECTIONMONITOR_PREFERREDDNSLIST=1.1.1.1, 9.9.9.9",
+		"GCT_EXCHANGES_KRAKEN_ENABLED=true",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Name != "overridden" ||
+		c.Exchanges[0].API.Credentials.Key != "envkey" ||
+		c.Exchanges[0].API.Credentials.Secret != "envsecret" ||
+		!c.Exchanges[1].Enabled ||
+		c.Exchanges[1].HTTPTimeout != time.Second*20 ||
+		c.Exchanges[1].HTTPRetry == nil || c.Exchanges[1].HTTPRetry.MaxRetries != 3 ||
+		c.Database.Port != 5433 ||
+		len(c.ConnectionMonitor.DNSList) != 2 || c.ConnectionMonitor.DNSList[1] != "9.9.9.9" {
+		t.Errorf("overrides not applied %+v", c)
+	}
+
+	err = c.applyEnvironmentOverrides([]string{"GCT_DATABASE_CONNECTIONDETAILS_PORT=port"})
+	if err == nil {
+		t.Error("expected error on invalid value")
+	}
+}
+
+func TestSaveConfigEnvironmentOverrides(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	c := &Config{
+		Name:          "test",
+		EncryptConfig: fileEncryptionDisabled,
+		Exchanges: []ExchangeConfig{
+			{Name: "Binance", API: APIConfig{Credentials: APICredentialsConfig{Key: "key"}}},
+		},
+	}
+	err = c.applyEnvironmentOverrides([]string{
+		"GCT_EXCHANGES_BINANCE_APIKEY=envkey",
+		"GCT_EXCHANGES_BINANCE_HTTPRETRY_MAXRETRIES=3",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	path := filepath.Join(dir, File)
+	err = c.SaveConfig(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var saved Config
+	err = json.Unmarshal(data, &saved)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if saved.Exchanges[0].API.Credentials.Key != "key" || saved.Exchanges[0].HTTPRetry != nil {
+		t.Errorf("environment overrides written to config file %+v", saved.Exchanges[0])
+	}
+	if c.Exchanges[0].API.Credentials.Key != "envkey" ||
+		c.Exchanges[0].HTTPRetry == nil || c.Exchanges[0].HTTPRetry.MaxRetries != 3 {
+		t.Error("saving the config should not alter environment overrides")
+	}
+}
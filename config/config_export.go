@@ -0,0 +1,219 @@
+package config
+
+import (
+	"encoding/json"
+	"net/url"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Redacted returns a copy of the config with its credentials, being the
+// fields scrubbed from error reports such as exchange API keys and
+// communication tokens along with proxy passwords, replaced by RedactedValue
+// so the config can be shared
+func (c *Config) Redacted() (*Config, error) {
+	m.Lock()
+	data, err := json.Marshal(c)
+	m.Unlock()
+	if err != nil {
+		return nil, err
+	}
+	r := new(Config)
+	err = json.Unmarshal(data, r)
+	if err != nil {
+		return nil, err
+	}
+	redactSecrets(reflect.ValueOf(r).Elem(), false)
+	for i := range r.Exchanges {
+		r.Exchanges[i].ProxyAddress = redactURL(r.Exchanges[i].ProxyAddress)
+		r.Exchanges[i].WebsocketProxyAddress = redactURL(r.Exchanges[i].WebsocketProxyAddress)
+	}
+	return r, nil
+}
+
+// Diff returns the settings which differ between the config and defaults,
+// ordered by path. Elements of lists of named settings, such as exchanges,
+// are matched by name and credentials are redacted.
+func (c *Config) Diff(defaults *Config) ([]Difference, error) {
+	r, err := c.Redacted()
+	if err != nil {
+		return nil, err
+	}
+	value, err := jsonValue(r)
+	if err != nil {
+		return nil, err
+	}
+	def, err := jsonValue(defaults)
+	if err != nil {
+		return nil, err
+	}
+	var diffs []Difference
+	diffJSON("", value, def, &diffs)
+	return diffs, nil
+}
+
+// redactSecrets replaces the non-empty strings of, or held by, a field whose
+// JSON name matches secretFieldPattern
+func redactSecrets(v reflect.Value, secret bool) {
+	switch v.Kind() {
+	case reflect.Ptr, reflect.Interface:
+		if !v.IsNil() {
+			redactSecrets(v.Elem(), secret)
+		}
+	case reflect.Struct:
+		t := v.Type()
+		for i := 0; i < v.NumField(); i++ {
+			f := t.Field(i)
+			if f.PkgPath != "" {
+				continue
+			}
+			name := strings.Split(f.Tag.Get("json"), ",")[0]
+			if name == "" {
+				name = f.Name
+			}
+			redactSecrets(v.Field(i), secretFieldPattern.MatchString(name))
+		}
+	case reflect.Slice, reflect.Array:
+		for i := 0; i < v.Len(); i++ {
+			redactSecrets(v.Index(i), secret)
+		}
+	case reflect.String:
+		if secret && v.String() != "" && v.CanSet() {
+			v.SetString(RedactedValue)
+		}
+	}
+}
+
+// redactURL replaces the password of a URL with RedactedValue
+func redactURL(addr string) string {
+	u, err := url.Parse(addr)
+	if err != nil || u.User == nil {
+		return addr
+	}
+	if _, ok := u.User.Password(); !ok {
+		return addr
+	}
+	u.User = url.UserPassword(u.User.Username(), RedactedValue)
+	return u.String()
+}
+
+// jsonValue returns v as decoded JSON
+func jsonValue(v interface{}) (interface{}, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var result interface{}
+	return result, json.Unmarshal(data, &result)
+}
+
+// diffJSON appends the differences between decoded JSON values. Objects are
+// compared field by field and lists of named objects element by element,
+// other values, such as lists of pairs, are compared whole.
+func diffJSON(path string, value, def interface{}, diffs *[]Difference) {
+	vm, vok := jsonObject(value)
+	dm, dok := jsonObject(def)
+	if vok && dok {
+		keys := make(map[string]bool)
+		for k := range vm {
+			keys[k] = true
+		}
+		for k := range dm {
+			keys[k] = true
+		}
+		sorted := make([]string, 0, len(keys))
+		for k := range keys {
+			if !diffIgnoredFields[k] {
+				sorted = append(sorted, k)
+			}
+		}
+		sort.Strings(sorted)
+		for _, k := range sorted {
+			p := k
+			if path != "" {
+				p = path + "." + k
+			}
+			diffJSON(p, vm[k], dm[k], diffs)
+		}
+		return
+	}
+
+	vl, vok := namedList(value)
+	dl, dok := namedList(def)
+	if vok && dok {
+		names := make([]string, 0, len(vl)+len(dl))
+		for name := range vl {
+			names = append(names, name)
+		}
+		for name := range dl {
+			if _, ok := vl[name]; !ok {
+				names = append(names, name)
+			}
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			diffJSON(path+"["+name+"]", vl[name], dl[name], diffs)
+		}
+		return
+	}
+
+	if isZeroJSON(value) && isZeroJSON(def) || reflect.DeepEqual(value, def) {
+		return
+	}
+	*diffs = append(*diffs, Difference{Path: path, Value: value, Default: def})
+}
+
+// jsonObject returns v as an object, a missing value being an empty object
+func jsonObject(v interface{}) (map[string]interface{}, bool) {
+	if v == nil {
+		return nil, true
+	}
+	o, ok := v.(map[string]interface{})
+	return o, ok
+}
+
+// namedList returns a list of objects with names by name, a missing value
+// being an empty list
+func namedList(v interface{}) (map[string]interface{}, bool) {
+	if v == nil {
+		return nil, true
+	}
+	l, ok := v.([]interface{})
+	if !ok {
+		return nil, false
+	}
+	result := make(map[string]interface{}, len(l))
+	for i := range l {
+		o, ok := l[i].(map[string]interface{})
+		if !ok {
+			return nil, false
+		}
+		name, ok := o["name"].(string)
+		if !ok || name == "" {
+			return nil, false
+		}
+		result[name] = o
+	}
+	return result, true
+}
+
+// isZeroJSON reports whether a decoded JSON value is missing or empty, which
+// omitted fields are treated as
+func isZeroJSON(v interface{}) bool {
+	switch x := v.(type) {
+	case nil:
+		return true
+	case string:
+		return x == ""
+	case bool:
+		return !x
+	case float64:
+		return x == 0
+	case []interface{}:
+		return len(x) == 0
+	case map[string]interface{}:
+		return len(x) == 0
+	}
+	return false
+}
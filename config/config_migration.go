@@ -0,0 +1,134 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// configMigrations upgrade the config JSON layout, in order, one version at a
+// time. A config without a version is at version 0. Migrations must only be
+// appended, as the config version is the number of migrations applied.
+var configMigrations = []configMigration{
+	{
+		Version:     1,
+		Description: "Move pre-engine logging settings to fileSettings and remove restPollingDelay",
+		Up:          migratePreEngineLayout,
+	},
+}
+
+// CurrentConfigVersion is the config version written by this release
+var CurrentConfigVersion = len(configMigrations)
+
+// migrateConfigJSON upgrades config JSON to the current config version,
+// returning the upgraded JSON and the version it was upgraded from
+func migrateConfigJSON(data []byte) ([]byte, int, error) {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var cfg map[string]interface{}
+	err := d.Decode(&cfg)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	version := 0
+	if v, ok := cfg["version"]; ok && v != nil {
+		n, ok := v.(json.Number)
+		if !ok {
+			return nil, 0, ValidationErrors{{Path: "version", Message: "expected an integer"}}
+		}
+		version, err = strconv.Atoi(n.String())
+		if err != nil || version < 0 {
+			return nil, 0, ValidationErrors{{Path: "version", Message: "expected a non-negative integer"}}
+		}
+	}
+	if version > CurrentConfigVersion {
+		return nil, 0, fmt.Errorf("config version %d is newer than the supported version %d, upgrade GoCryptoTrader to use this config",
+			version, CurrentConfigVersion)
+	}
+	if version == CurrentConfigVersion {
+		return data, version, nil
+	}
+
+	for i := version; i < len(configMigrations); i++ {
+		err = configMigrations[i].Up(cfg)
+		if err != nil {
+			return nil, 0, fmt.Errorf("config migration to version %d failed: %s",
+				configMigrations[i].Version, err)
+		}
+		log.Infof(log.ConfigMgr, "Upgraded config to version %d: %s\n",
+			configMigrations[i].Version, configMigrations[i].Description)
+	}
+	cfg["version"] = CurrentConfigVersion
+	upgraded, err := json.MarshalIndent(cfg, "", " ")
+	if err != nil {
+		return nil, 0, err
+	}
+	return upgraded, version, nil
+}
+
+// backupConfigFile writes the original config file next to it before it is
+// overwritten by an upgraded config, returning the backup path
+func backupConfigFile(configPath string, original []byte, version int) (string, error) {
+	path := fmt.Sprintf("%s.v%d.%s.bak", configPath, version, time.Now().UTC().Format("20060102150405"))
+	return path, file.Write(path, original)
+}
+
+// saveUpgradedConfig backs up the original config file and saves the config
+// upgraded from version
+func (c *Config) saveUpgradedConfig(configPath string, original []byte, version int, dryrun bool) error {
+	if dryrun {
+		return nil
+	}
+	backup, err := backupConfigFile(configPath, original, version)
+	if err != nil {
+		return fmt.Errorf("unable to back up config before upgrading: %s", err)
+	}
+	log.Infof(log.ConfigMgr, "Config upgraded from version %d to %d, original backed up to %s\n",
+		version, CurrentConfigVersion, backup)
+	return c.SaveConfig(configPath, dryrun)
+}
+
+// migratePreEngineLayout moves the logging file settings of configs from
+// before the engine was introduced to fileSettings and removes the exchange
+// REST polling delay which is no longer used
+func migratePreEngineLayout(cfg map[string]interface{}) error {
+	if logging, ok := cfg["logging"].(map[string]interface{}); ok {
+		_, hasFile := logging["file"]
+		_, hasRotate := logging["rotate"]
+		if _, ok := logging["fileSettings"]; !ok && (hasFile || hasRotate) {
+			fileSettings := make(map[string]interface{})
+			filename, _ := logging["file"].(string)
+			if filename != "" {
+				fileSettings["filename"] = filename
+			}
+			if rotate, ok := logging["rotate"].(bool); ok {
+				fileSettings["rotate"] = rotate
+			}
+			logging["fileSettings"] = fileSettings
+			if _, ok := logging["output"]; !ok {
+				output := "console"
+				if filename != "" {
+					output += "|file"
+				}
+				logging["output"] = output
+			}
+		}
+		delete(logging, "file")
+		delete(logging, "rotate")
+		delete(logging, "colour")
+	}
+
+	exchanges, _ := cfg["exchanges"].([]interface{})
+	for i := range exchanges {
+		if exch, ok := exchanges[i].(map[string]interface{}); ok {
+			delete(exch, "restPollingDelay")
+		}
+	}
+	return nil
+}
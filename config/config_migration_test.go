@@ -0,0 +1,62 @@
+package config
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestMigrateConfigJSON(t *testing.T) {
+	t.Parallel()
+	data, version, err := migrateConfigJSON([]byte(`{
+		"name": "test",
+		"logging": {"enabled": true, "file": "debug.txt", "colour": false, "rotate": true},
+		"exchanges": [{"name": "Bitstamp", "restPollingDelay": 10, "httpTimeout": 15000000000}]
+	}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if version != 0 {
+		t.Errorf("expected version 0, got %d", version)
+	}
+	var cfg struct {
+		Version int `json:"version"`
+		Logging struct {
+			Output       string                 `json:"output"`
+			FileSettings map[string]interface{} `json:"fileSettings"`
+			File         *string                `json:"file"`
+		} `json:"logging"`
+		Exchanges []map[string]interface{} `json:"exchanges"`
+	}
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if cfg.Version != CurrentConfigVersion {
+		t.Errorf("expected version %d, got %d", CurrentConfigVersion, cfg.Version)
+	}
+	if cfg.Logging.File != nil || cfg.Logging.Output != "console|file" ||
+		cfg.Logging.FileSettings["filename"] != "debug.txt" || cfg.Logging.FileSettings["rotate"] != true {
+		t.Errorf("logging settings not migrated %+v", cfg.Logging)
+	}
+	if _, ok := cfg.Exchanges[0]["restPollingDelay"]; ok {
+		t.Error("expected restPollingDelay to be removed")
+	}
+	if cfg.Exchanges[0]["httpTimeout"] != float64(15000000000) {
+		t.Errorf("expected other settings to be kept, got %v", cfg.Exchanges[0])
+	}
+
+	current := []byte(`{"name":"test","version":1}`)
+	data, version, err = migrateConfigJSON(current)
+	if err != nil || version != CurrentConfigVersion || string(data) != string(current) {
+		t.Errorf("expected current config to be unchanged, got %s %d %v", data, version, err)
+	}
+
+	_, _, err = migrateConfigJSON([]byte(`{"version":1000}`))
+	if err == nil {
+		t.Error("expected error on newer config version")
+	}
+	_, _, err = migrateConfigJSON([]byte(`{"version":"1"}`))
+	if err == nil {
+		t.Error("expected error on invalid config version")
+	}
+}
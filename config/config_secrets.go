@@ -0,0 +1,240 @@
+package config
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/secrets"
+)
+
+// Secret names and value keys read from the secrets backend
+const (
+	secretExchangesPrefix = "exchanges/"
+	secretWalletsPrefix   = "wallets/"
+	secretDatabase        = "database"
+
+	secretKey       = "key"
+	secretSecret    = "secret"
+	secretClientID  = "clientID"
+	secretPEMKey    = "pemKey"
+	secretOTPSecret = "otpSecret"
+	secretPrivKey   = "privateKey"
+	secretUsername  = "username"
+	secretPassword  = "password"
+)
+
+// ExchangeSecretName returns the name of the secret holding an exchange's API
+// credentials
+func ExchangeSecretName(exchName string) string {
+	return secretExchangesPrefix + strings.ToLower(exchName)
+}
+
+// WalletSecretName returns the name of the secret holding a hot wallet's
+// private key
+func WalletSecretName(walletName string) string {
+	return secretWalletsPrefix + strings.ToLower(walletName)
+}
+
+// loadSecretsBackend loads credentials from the secrets backend if enabled
+func (c *Config) loadSecretsBackend() error {
+	if !c.SecretsBackend.Enabled {
+		return nil
+	}
+	b, err := secrets.New(&c.SecretsBackend)
+	if err != nil {
+		return err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), defaultHTTPTimeout)
+	defer cancel()
+	u, err := c.LoadSecrets(ctx, b)
+	if err != nil {
+		return fmt.Errorf("unable to load secrets from %s: %s", b.Name(), err)
+	}
+	log.Debugf(log.ConfigMgr, "Loaded credentials for %d exchanges from %s secrets backend\n",
+		len(u.Exchanges), b.Name())
+	return nil
+}
+
+// LoadSecrets fetches exchange API credentials, hot wallet private keys and
+// database credentials from the secrets backend, replacing those in the
+// config. Values missing from the backend are left as they are. The replaced
+// values are kept so the secrets are never written to the config file.
+func (c *Config) LoadSecrets(ctx context.Context, b secrets.Backend) (*SecretsUpdate, error) {
+	m.Lock()
+	names := make([]string, len(c.Exchanges))
+	for i := range c.Exchanges {
+		names[i] = c.Exchanges[i].Name
+	}
+	walletNames := make([]string, len(c.TransferManager.HotWallets.Wallets))
+	for i := range c.TransferManager.HotWallets.Wallets {
+		walletNames[i] = c.TransferManager.HotWallets.Wallets[i].Name
+	}
+	m.Unlock()
+
+	// Secrets are fetched before locking the config as the backend may be
+	// slow to respond
+	exchSecrets := make(map[string]map[string]string)
+	for i := range names {
+		values, err := b.Get(ctx, ExchangeSecretName(names[i]))
+		if err != nil {
+			if err == secrets.ErrNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("exchange %s: %s", names[i], err)
+		}
+		exchSecrets[names[i]] = values
+	}
+	walletSecrets := make(map[string]map[string]string)
+	for i := range walletNames {
+		values, err := b.Get(ctx, WalletSecretName(walletNames[i]))
+		if err != nil {
+			if err == secrets.ErrNotFound {
+				continue
+			}
+			return nil, fmt.Errorf("wallet %s: %s", walletNames[i], err)
+		}
+		walletSecrets[walletNames[i]] = values
+	}
+	dbSecret, err := b.Get(ctx, secretDatabase)
+	if err != nil && err != secrets.ErrNotFound {
+		return nil, fmt.Errorf("database: %s", err)
+	}
+
+	m.Lock()
+	defer m.Unlock()
+	if c.originals == nil {
+		c.originals = &secretOriginals{
+			exchanges: make(map[string]APICredentialsConfig),
+			wallets:   make(map[string]string),
+		}
+	}
+	var u SecretsUpdate
+	for i := range c.Exchanges {
+		values, ok := exchSecrets[c.Exchanges[i].Name]
+		if !ok {
+			continue
+		}
+		creds := c.Exchanges[i].API.Credentials
+		setSecretValue(&creds.Key, values, secretKey)
+		setSecretValue(&creds.Secret, values, secretSecret)
+		setSecretValue(&creds.ClientID, values, secretClientID)
+		setSecretValue(&creds.PEMKey, values, secretPEMKey)
+		setSecretValue(&creds.OTPSecret, values, secretOTPSecret)
+		if creds == c.Exchanges[i].API.Credentials {
+			continue
+		}
+		if _, ok := c.originals.exchanges[c.Exchanges[i].Name]; !ok {
+			c.originals.exchanges[c.Exchanges[i].Name] = c.Exchanges[i].API.Credentials
+		}
+		c.Exchanges[i].API.Credentials = creds
+		u.Exchanges = append(u.Exchanges, c.Exchanges[i].Name)
+	}
+
+	wallets := c.TransferManager.HotWallets.Wallets
+	for i := range wallets {
+		values, ok := walletSecrets[wallets[i].Name]
+		if !ok {
+			continue
+		}
+		key := wallets[i].PrivateKey
+		setSecretValue(&key, values, secretPrivKey)
+		if key == wallets[i].PrivateKey {
+			continue
+		}
+		if _, ok := c.originals.wallets[wallets[i].Name]; !ok {
+			c.originals.wallets[wallets[i].Name] = wallets[i].PrivateKey
+		}
+		wallets[i].PrivateKey = key
+		u.Wallets = append(u.Wallets, wallets[i].Name)
+	}
+
+	if dbSecret != nil {
+		creds := databaseCredentials{
+			Username: c.Database.Username,
+			Password: c.Database.Password,
+		}
+		setSecretValue(&creds.Username, dbSecret, secretUsername)
+		setSecretValue(&creds.Password, dbSecret, secretPassword)
+		if creds.Username != c.Database.Username || creds.Password != c.Database.Password {
+			if c.originals.database == nil {
+				c.originals.database = &databaseCredentials{
+					Username: c.Database.Username,
+					Password: c.Database.Password,
+				}
+			}
+			c.Database.Username = creds.Username
+			c.Database.Password = creds.Password
+			u.Database = true
+		}
+	}
+	return &u, nil
+}
+
+// ExtractExchangeCredentials removes the API credentials of every exchange
+// from the config, returning them keyed by the full name of their secret in
+// the secrets backend so they can be moved to a keystore
+func (c *Config) ExtractExchangeCredentials() map[string]map[string]string {
+	m.Lock()
+	defer m.Unlock()
+	extracted := make(map[string]map[string]string)
+	for i := range c.Exchanges {
+		creds := c.Exchanges[i].API.Credentials
+		if creds == (APICredentialsConfig{}) {
+			continue
+		}
+		values := make(map[string]string)
+		for k, v := range map[string]string{
+			secretKey:       creds.Key,
+			secretSecret:    creds.Secret,
+			secretClientID:  creds.ClientID,
+			secretPEMKey:    creds.PEMKey,
+			secretOTPSecret: creds.OTPSecret,
+		} {
+			if v != "" {
+				values[k] = v
+			}
+		}
+		extracted[c.SecretsBackend.SecretName(ExchangeSecretName(c.Exchanges[i].Name))] = values
+		c.Exchanges[i].API.Credentials = APICredentialsConfig{}
+	}
+	return extracted
+}
+
+// withoutSecrets returns a copy of the config with the values replaced by
+// secrets restored, or the config itself when no secrets have been loaded
+func (c *Config) withoutSecrets() *Config {
+	if c.originals == nil {
+		return c
+	}
+	cfg := *c
+	cfg.Exchanges = make([]ExchangeConfig, len(c.Exchanges))
+	copy(cfg.Exchanges, c.Exchanges)
+	for i := range cfg.Exchanges {
+		if creds, ok := c.originals.exchanges[cfg.Exchanges[i].Name]; ok {
+			cfg.Exchanges[i].API.Credentials = creds
+		}
+	}
+	if len(c.originals.wallets) > 0 {
+		cfg.TransferManager.HotWallets.Wallets = make([]HotWalletConfig, len(c.TransferManager.HotWallets.Wallets))
+		copy(cfg.TransferManager.HotWallets.Wallets, c.TransferManager.HotWallets.Wallets)
+		for i := range cfg.TransferManager.HotWallets.Wallets {
+			if key, ok := c.originals.wallets[cfg.TransferManager.HotWallets.Wallets[i].Name]; ok {
+				cfg.TransferManager.HotWallets.Wallets[i].PrivateKey = key
+			}
+		}
+	}
+	if c.originals.database != nil {
+		cfg.Database.Username = c.originals.database.Username
+		cfg.Database.Password = c.originals.database.Password
+	}
+	return &cfg
+}
+
+// setSecretValue sets dst to the secret value stored under key, if present
+func setSecretValue(dst *string, values map[string]string, key string) {
+	if v, ok := values[key]; ok {
+		*dst = v
+	}
+}
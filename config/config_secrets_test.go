@@ -0,0 +1,139 @@
+package config
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/secrets"
+)
+
+func TestLoadSecrets(t *testing.T) {
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	err = os.MkdirAll(filepath.Join(dir, "exchanges"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeSecret := func(name, data string) {
+		err = ioutil.WriteFile(filepath.Join(dir, name+".json"), []byte(data), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	writeSecret("exchanges/binance", `{"key":"vaultkey","secret":"vaultsecret"}`)
+	writeSecret("database", `{"password":"dbpassword"}`)
+	err = os.MkdirAll(filepath.Join(dir, "wallets"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	writeSecret("wallets/cold", `{"privateKey":"walletkey"}`)
+
+	b, err := secrets.New(&secrets.Config{
+		Backend: secrets.BackendFile,
+		File:    secrets.FileConfig{Directory: dir},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c := &Config{
+		Name:          "test",
+		EncryptConfig: fileEncryptionDisabled,
+		Exchanges: []ExchangeConfig{
+			{Name: "Binance", API: APIConfig{Credentials: APICredentialsConfig{Key: "key", Secret: "secret"}}},
+			{Name: "Bitstamp", API: APIConfig{Credentials: APICredentialsConfig{Key: "bitstampkey"}}},
+		},
+	}
+	c.Database.Username = "user"
+	c.Database.Password = "password"
+	c.TransferManager.HotWallets.Wallets = []HotWalletConfig{{Name: "Cold"}}
+
+	u, err := c.LoadSecrets(context.Background(), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(u.Exchanges) != 1 || u.Exchanges[0] != "Binance" || !u.Database ||
+		len(u.Wallets) != 1 || u.Wallets[0] != "Cold" {
+		t.Errorf("unexpected update %+v", u)
+	}
+	if c.TransferManager.HotWallets.Wallets[0].PrivateKey != "walletkey" {
+		t.Errorf("unexpected wallet private key %q", c.TransferManager.HotWallets.Wallets[0].PrivateKey)
+	}
+	if c.Exchanges[0].API.Credentials.Key != "vaultkey" ||
+		c.Exchanges[0].API.Credentials.Secret != "vaultsecret" ||
+		c.Exchanges[1].API.Credentials.Key != "bitstampkey" {
+		t.Errorf("unexpected exchange credentials %+v", c.Exchanges)
+	}
+	if c.Database.Username != "user" || c.Database.Password != "dbpassword" {
+		t.Errorf("unexpected database credentials %+v", c.Database.ConnectionDetails)
+	}
+
+	u, err = c.LoadSecrets(context.Background(), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(u.Exchanges) != 0 || len(u.Wallets) != 0 || u.Database {
+		t.Errorf("expected no changes, got %+v", u)
+	}
+
+	writeSecret("exchanges/binance", `{"key":"rotatedkey","secret":"rotatedsecret"}`)
+	u, err = c.LoadSecrets(context.Background(), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(u.Exchanges) != 1 || c.Exchanges[0].API.Credentials.Key != "rotatedkey" {
+		t.Errorf("expected rotated credentials, got %+v", c.Exchanges[0].API.Credentials)
+	}
+
+	path := filepath.Join(dir, "config.json")
+	err = c.SaveConfig(path, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, s := range []string{"rotatedkey", "rotatedsecret", "dbpassword", "walletkey"} {
+		if strings.Contains(string(data), s) {
+			t.Errorf("secret %s written to config file", s)
+		}
+	}
+	for _, s := range []string{`"key": "key"`, `"secret": "secret"`, `"password": "password"`} {
+		if !strings.Contains(string(data), s) {
+			t.Errorf("expected original value %s in config file", s)
+		}
+	}
+	if c.Exchanges[0].API.Credentials.Key != "rotatedkey" {
+		t.Error("saving the config should not alter the loaded secrets")
+	}
+}
+
+func TestExtractExchangeCredentials(t *testing.T) {
+	c := &Config{
+		Exchanges: []ExchangeConfig{
+			{Name: "Binance", API: APIConfig{Credentials: APICredentialsConfig{Key: "key", Secret: "secret"}}},
+			{Name: "Bitstamp"},
+		},
+	}
+	c.SecretsBackend.Prefix = "/gct/"
+
+	extracted := c.ExtractExchangeCredentials()
+	if len(extracted) != 1 {
+		t.Fatalf("expected credentials of 1 exchange, got %v", extracted)
+	}
+	values := extracted["gct/exchanges/binance"]
+	if len(values) != 2 || values[secretKey] != "key" || values[secretSecret] != "secret" {
+		t.Errorf("unexpected credentials %v", values)
+	}
+	if c.Exchanges[0].API.Credentials != (APICredentialsConfig{}) {
+		t.Errorf("expected credentials to be removed from the config, got %+v", c.Exchanges[0].API.Credentials)
+	}
+}
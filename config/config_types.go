@@ -2,16 +2,21 @@ package config
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/calendar"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/secrets"
+	"github.com/thrasher-corp/gocryptotrader/tracing"
 )
 
 // Constants declared here are filename strings and test strings
@@ -29,9 +34,48 @@ const (
 	defaultWebsocketResponseMaxLimit     = time.Second * 7
 	defaultWebsocketOrderbookBufferLimit = 5
 	defaultWebsocketTrafficTimeout       = time.Second * 30
+	defaultWebsocketPongTimeout          = time.Second * 10
 	maxAuthFailures                      = 3
 	defaultNTPAllowedDifference          = 50000000
 	defaultNTPAllowedNegativeDifference  = 50000000
+	defaultCommsRetryMaxAttempts         = 10
+	defaultMetricsListenAddress          = "localhost:9054"
+	defaultProfilerListenAddress         = "localhost:9055"
+	defaultHealthCheckListenAddress      = "localhost:9056"
+	defaultResourceMonitorInterval       = time.Minute
+	defaultResourceMonitorSamples        = 10
+	defaultGoroutineGrowthThreshold      = 50
+	defaultHeapGrowthThresholdMB         = 64
+	defaultFileDescriptorGrowthThreshold = 50
+	defaultLatencyMonitorInterval        = 30 * time.Second
+	defaultLatencyMonitorSamples         = 20
+	defaultTransferCheckInterval         = 30 * time.Second
+	defaultTransferTimeout               = 2 * time.Hour
+	defaultWithdrawalApprovalWindow      = 15 * time.Minute
+	defaultWithdrawalLimitAlertPercent   = 80
+	defaultRPCSessionTimeout             = 15 * time.Minute
+	defaultSecurityCheckInterval         = time.Minute
+	defaultSecurityPriceDeviation        = 10
+	defaultSecurityBalanceDrop           = 20
+	defaultPriceAlertCheckInterval       = 5 * time.Second
+	defaultPriceAlertMaxTickerAge        = 5 * time.Minute
+	defaultTrailingStopCheckInterval     = time.Minute
+	defaultTrailingStopDrawdown          = 10
+	defaultTrailingStopCurrency          = "USDT"
+	defaultMarginCheckInterval           = 30 * time.Second
+	defaultMarginTargetRatio             = 0.3
+	defaultMarginTopUpRatio              = 0.5
+	defaultMarginReduceRatio             = 0.8
+	defaultMarginReduceFraction          = 0.25
+	defaultPairRefreshInterval           = 6 * time.Hour
+	defaultOpenInterestInterval          = 5 * time.Minute
+	defaultDeFiInterval                  = time.Minute
+	defaultHedgeCheckInterval            = time.Minute
+	defaultHedgeBand                     = 0.05
+	defaultCandleIntegrityInterval       = time.Hour
+	defaultCandleIntegrityLookback       = 7 * 24 * time.Hour
+	defaultCandleBackfillLimit           = 1000
+	defaultPartialFillTimeout            = time.Minute
 	DefaultAPIKey                        = "Key"
 	DefaultAPISecret                     = "Secret"
 	DefaultAPIClientID                   = "ClientID"
@@ -67,25 +111,88 @@ const (
 	DefaultForexProviderExchangeRatesAPI = "ExchangeRates"
 )
 
+// Constants here define the permission levels which can be granted to users
+// of interactive communication mediums
+const (
+	CommsPermissionRead  = "read"
+	CommsPermissionTrade = "trade"
+	CommsPermissionAdmin = "admin"
+)
+
+// Constants here define the supported SMS providers
+const (
+	SMSProviderSMSGlobal = "smsglobal"
+	SMSProviderTwilio    = "twilio"
+	SMSProviderVonage    = "vonage"
+)
+
 // Variables here are used for configuration
 var (
 	Cfg            Config
 	IsInitialSetup bool
 	testBypass     bool
 	m              sync.Mutex
+
+	// current is the config returned by GetConfig, Cfg unless replaced with
+	// SetConfig
+	current = &Cfg
+	cfgMtx  sync.RWMutex
+
+	// secretFieldPattern matches the JSON names of credential fields which
+	// are scrubbed from error reports
+	secretFieldPattern = regexp.MustCompile(`(?i)(key|secret|password|token|passphrase|clientid|dsn)$`)
+
+	// diffIgnoredFields are fields holding data fetched from exchanges rather
+	// than settings, which are left out of config diffs
+	diffIgnoredFields = map[string]bool{
+		"available":   true,
+		"lastUpdated": true,
+	}
 )
 
+// RedactedValue replaces credentials in a redacted config
+const RedactedValue = "REDACTED"
+
+// Difference is a setting which differs from its default, identified by its
+// JSON path
+type Difference struct {
+	Path    string      `json:"path"`
+	Value   interface{} `json:"value"`
+	Default interface{} `json:"default"`
+}
+
 // Config is the overarching object that holds all the information for
 // prestart management of Portfolio, Communications, Webserver and Enabled
 // Exchanges
 type Config struct {
 	Name              string                  `json:"name"`
+	Version           int                     `json:"version"`
 	EncryptConfig     int                     `json:"encryptConfig"`
 	GlobalHTTPTimeout time.Duration           `json:"globalHTTPTimeout"`
 	Database          database.Config         `json:"database"`
 	Logging           log.Config              `json:"logging"`
 	ConnectionMonitor ConnectionMonitorConfig `json:"connectionMonitor"`
 	Profiler          Profiler                `json:"profiler"`
+	Metrics           MetricsConfig           `json:"metrics"`
+	Tracing           tracing.Config          `json:"tracing"`
+	HealthCheck       HealthCheckConfig       `json:"healthCheck"`
+	ErrorReporting    errorreport.Config      `json:"errorReporting"`
+	SecretsBackend    secrets.Config          `json:"secretsBackend"`
+	ResourceMonitor   ResourceMonitorConfig   `json:"resourceMonitor"`
+	LatencyMonitor    LatencyMonitorConfig    `json:"latencyMonitor"`
+	TransferManager   TransferManagerConfig   `json:"transferManager"`
+	WithdrawalLimits  WithdrawalLimitsConfig  `json:"withdrawalLimits"`
+	SecurityMonitor   SecurityMonitorConfig   `json:"securityMonitor"`
+	PriceAlerts       PriceAlertsConfig       `json:"priceAlerts"`
+	TrailingStop      TrailingStopConfig      `json:"trailingStop"`
+	MarginManager     MarginManagerConfig     `json:"marginManager"`
+	PairRefresh       PairRefreshConfig       `json:"pairRefresh"`
+	OpenInterest      OpenInterestConfig      `json:"openInterest"`
+	DeFi              DeFiConfig              `json:"defi"`
+	Calendar          CalendarConfig          `json:"calendar"`
+	Strategies        []StrategyConfig        `json:"strategies"`
+	HedgeManager      HedgeManagerConfig      `json:"hedgeManager"`
+	CandleIntegrity   CandleIntegrityConfig   `json:"candleIntegrity"`
 	NTPClient         NTPClientConfig         `json:"ntpclient"`
 	GCTScript         gctscript.Config        `json:"gctscript"`
 	Currency          CurrencyConfig          `json:"currencyConfig"`
@@ -101,6 +208,13 @@ type Config struct {
 	FiatDisplayCurrency *currency.Code            `json:"fiatDispayCurrency,omitempty"`
 	Cryptocurrencies    *currency.Currencies      `json:"cryptocurrencies,omitempty"`
 	SMS                 *SMSGlobalConfig          `json:"smsGlobal,omitempty"`
+
+	// originals holds the config values replaced by secrets from the secrets
+	// backend so they, not the secrets, are written when saving
+	originals *secretOriginals
+	// envOverrides holds the values of the config fields overridden by
+	// environment variables, keyed by variable name
+	envOverrides map[string]*envOverride
 }
 
 // ConnectionMonitorConfig defines the connection monitor variables to ensure
@@ -113,23 +227,29 @@ type ConnectionMonitorConfig struct {
 
 // ExchangeConfig holds all the information needed for each enabled Exchange.
 type ExchangeConfig struct {
-	Name                          string                 `json:"name"`
-	Enabled                       bool                   `json:"enabled"`
-	Verbose                       bool                   `json:"verbose"`
-	UseSandbox                    bool                   `json:"useSandbox,omitempty"`
-	HTTPTimeout                   time.Duration          `json:"httpTimeout"`
-	HTTPUserAgent                 string                 `json:"httpUserAgent,omitempty"`
-	HTTPDebugging                 bool                   `json:"httpDebugging,omitempty"`
-	WebsocketResponseCheckTimeout time.Duration          `json:"websocketResponseCheckTimeout"`
-	WebsocketResponseMaxLimit     time.Duration          `json:"websocketResponseMaxLimit"`
-	WebsocketTrafficTimeout       time.Duration          `json:"websocketTrafficTimeout"`
-	WebsocketOrderbookBufferLimit int                    `json:"websocketOrderbookBufferLimit"`
-	ProxyAddress                  string                 `json:"proxyAddress,omitempty"`
-	BaseCurrencies                currency.Currencies    `json:"baseCurrencies"`
-	CurrencyPairs                 *currency.PairsManager `json:"currencyPairs"`
-	API                           APIConfig              `json:"api"`
-	Features                      *FeaturesConfig        `json:"features"`
-	BankAccounts                  []BankAccount          `json:"bankAccounts,omitempty"`
+	Name                          string                    `json:"name"`
+	Enabled                       bool                      `json:"enabled"`
+	Verbose                       bool                      `json:"verbose"`
+	UseSandbox                    bool                      `json:"useSandbox,omitempty"`
+	HTTPTimeout                   time.Duration             `json:"httpTimeout"`
+	HTTPUserAgent                 string                    `json:"httpUserAgent,omitempty"`
+	HTTPDebugging                 bool                      `json:"httpDebugging,omitempty"`
+	HTTPRetry                     *HTTPRetryConfig          `json:"httpRetry,omitempty"`
+	HTTPCircuitBreaker            *CircuitBreakerConfig     `json:"httpCircuitBreaker,omitempty"`
+	HTTPTLSPinning                *TLSPinningConfig         `json:"httpTLSPinning,omitempty"`
+	WebsocketResponseCheckTimeout time.Duration             `json:"websocketResponseCheckTimeout"`
+	WebsocketResponseMaxLimit     time.Duration             `json:"websocketResponseMaxLimit"`
+	WebsocketTrafficTimeout       time.Duration             `json:"websocketTrafficTimeout"`
+	WebsocketOrderbookBufferLimit int                       `json:"websocketOrderbookBufferLimit"`
+	DisableWebsocketCompression   bool                      `json:"disableWebsocketCompression,omitempty"`
+	WebsocketKeepalive            *WebsocketKeepaliveConfig `json:"websocketKeepalive,omitempty"`
+	ProxyAddress                  string                    `json:"proxyAddress,omitempty"`
+	WebsocketProxyAddress         string                    `json:"websocketProxyAddress,omitempty"`
+	BaseCurrencies                currency.Currencies       `json:"baseCurrencies"`
+	CurrencyPairs                 *currency.PairsManager    `json:"currencyPairs"`
+	API                           APIConfig                 `json:"api"`
+	Features                      *FeaturesConfig           `json:"features"`
+	BankAccounts                  []BankAccount             `json:"bankAccounts,omitempty"`
 
 	// Deprecated settings which will be removed in a future update
 	AvailablePairs                   *currency.Pairs      `json:"availablePairs,omitempty"`
@@ -152,10 +272,352 @@ type ExchangeConfig struct {
 	WebsocketURL                     *string              `json:"websocketUrl,omitempty"`
 }
 
-// Profiler defines the profiler configuration to enable pprof
+// HTTPRetryConfig overrides how an exchanges REST requests are retried after
+// a network error, a 429 or a 5xx response
+type HTTPRetryConfig struct {
+	MaxRetries     int           `json:"maxRetries"`
+	InitialBackoff time.Duration `json:"initialBackoff"`
+	MaxBackoff     time.Duration `json:"maxBackoff"`
+}
+
+// WebsocketKeepaliveConfig sets how an exchanges websocket connections are
+// pinged and when a connection which stops responding or goes quiet is
+// reconnected
+type WebsocketKeepaliveConfig struct {
+	PingInterval time.Duration `json:"pingInterval"`
+	PongTimeout  time.Duration `json:"pongTimeout"`
+	MaxSilence   time.Duration `json:"maxSilence"`
+}
+
+// CircuitBreakerConfig overrides when an exchanges REST endpoints fail fast
+// after consecutive failures
+type CircuitBreakerConfig struct {
+	FailureThreshold int           `json:"failureThreshold"`
+	Cooldown         time.Duration `json:"cooldown"`
+}
+
+// TLSPinningConfig pins the TLS certificates accepted for an exchanges REST
+// endpoints, each pin is a base64 encoded SHA-256 hash
+type TLSPinningConfig struct {
+	PublicKeys   []string `json:"publicKeys,omitempty"`
+	Certificates []string `json:"certificates,omitempty"`
+}
+
+// Profiler defines the profiler configuration to enable pprof and expvar on a
+// debug HTTP server
 type Profiler struct {
-	Enabled              bool `json:"enabled"`
-	MutexProfileFraction int  `json:"mutex_profile_fraction"`
+	Enabled              bool   `json:"enabled"`
+	ListenAddress        string `json:"listenAddress"`
+	MutexProfileFraction int    `json:"mutex_profile_fraction"`
+	BlockProfileRate     int    `json:"block_profile_rate"`
+}
+
+// MetricsConfig defines the metrics server configuration which exposes
+// Prometheus metrics on /metrics
+type MetricsConfig struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+}
+
+// HealthCheckConfig defines the health check server configuration which
+// exposes liveness and readiness probes on /healthz and /readyz
+type HealthCheckConfig struct {
+	Enabled       bool   `json:"enabled"`
+	ListenAddress string `json:"listenAddress"`
+}
+
+// ResourceMonitorConfig defines the resource monitor configuration which
+// samples goroutines, heap usage and open file descriptors every check
+// interval and alerts when one has grown over every one of the last samples
+// by more than its threshold
+type ResourceMonitorConfig struct {
+	Enabled                       bool          `json:"enabled"`
+	CheckInterval                 time.Duration `json:"checkInterval"`
+	Samples                       int           `json:"samples"`
+	GoroutineGrowthThreshold      int           `json:"goroutineGrowthThreshold"`
+	HeapGrowthThresholdMB         int           `json:"heapGrowthThresholdMB"`
+	FileDescriptorGrowthThreshold int           `json:"fileDescriptorGrowthThreshold"`
+}
+
+// LatencyMonitorConfig defines the latency monitor configuration which
+// measures the round trip latency to each enabled exchange every check
+// interval, keeping the last samples of each
+type LatencyMonitorConfig struct {
+	Enabled       bool          `json:"enabled"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	Samples       int           `json:"samples"`
+}
+
+// TransferManagerConfig defines the transfer manager configuration which moves
+// funds between exchanges, checking each transfer's progress every check
+// interval until it is credited or times out
+type TransferManagerConfig struct {
+	Enabled       bool             `json:"enabled"`
+	CheckInterval time.Duration    `json:"checkInterval"`
+	Timeout       time.Duration    `json:"timeout"`
+	HotWallets    HotWalletsConfig `json:"hotWallets"`
+	Approval      ApprovalConfig   `json:"approval"`
+}
+
+// ApprovalConfig defines which transfers and withdrawals must be approved via
+// gctcli or Telegram before they are sent. A withdrawal of more than its
+// currency's limit, or of any amount of a currency without a limit, is held
+// pending approval and expires if not approved within the window
+type ApprovalConfig struct {
+	Enabled bool               `json:"enabled"`
+	Window  time.Duration      `json:"window"`
+	Limits  map[string]float64 `json:"limits"`
+}
+
+// HotWalletsConfig defines the hot wallets the transfer manager may sign and
+// broadcast transactions from, moving funds held at portfolio addresses to
+// exchanges
+type HotWalletsConfig struct {
+	Enabled bool              `json:"enabled"`
+	Wallets []HotWalletConfig `json:"wallets"`
+}
+
+// HotWalletConfig defines a hot wallet on the bitcoin or evm chain. The
+// address must be a portfolio address controlled by the private key, a native
+// segwit address on Bitcoin. Transfers are limited to the max amount and max
+// fee and may only be sent to the allowed exchanges, at their deposit address
+// or at one of the allowed addresses
+type HotWalletConfig struct {
+	Name             string   `json:"name"`
+	Enabled          bool     `json:"enabled"`
+	Chain            string   `json:"chain"`
+	Currency         string   `json:"currency,omitempty"`
+	Address          string   `json:"address"`
+	PrivateKey       string   `json:"privateKey"`
+	APIURL           string   `json:"apiURL,omitempty"`
+	ChainID          int64    `json:"chainID,omitempty"`
+	Testnet          bool     `json:"testnet,omitempty"`
+	MaxAmount        float64  `json:"maxAmount"`
+	MaxFee           float64  `json:"maxFee"`
+	AllowedExchanges []string `json:"allowedExchanges"`
+	AllowedAddresses []string `json:"allowedAddresses,omitempty"`
+}
+
+// WithdrawalLimitsConfig defines the daily withdrawal limits of each currency,
+// enforced by the engine on top of any limits of the exchanges. Withdrawals of
+// a currency without a limit aren't limited. An alert is sent the first time
+// a day's withdrawals of a currency reach the alert percent of its limit
+type WithdrawalLimitsConfig struct {
+	Enabled      bool               `json:"enabled"`
+	AlertPercent float64            `json:"alertPercent"`
+	Daily        map[string]float64 `json:"daily"`
+}
+
+// Anomalies flagged by the security monitor
+const (
+	// SecurityAnomalyOrderPrice is an order priced further from the market
+	// than the price deviation percent
+	SecurityAnomalyOrderPrice = "orderPrice"
+	// SecurityAnomalyWithdrawalDestination is a withdrawal to an address never
+	// withdrawn to before
+	SecurityAnomalyWithdrawalDestination = "withdrawalDestination"
+	// SecurityAnomalyBalanceDrop is an exchange's balances falling in value by
+	// the balance drop percent between checks
+	SecurityAnomalyBalanceDrop = "balanceDrop"
+	// SecurityAnomalyRPCClient is a management interface session from an
+	// address never seen before
+	SecurityAnomalyRPCClient = "rpcClient"
+)
+
+// SecurityMonitorConfig defines the security monitor, which flags anomalous
+// activity to the communication mediums and the audit log. Exchange balances
+// are valued in the currency every check interval. Anomalies listed in
+// killSwitch trigger the kill switch, cancelling every open order and
+// refusing new orders and withdrawals until the bot is restarted
+type SecurityMonitorConfig struct {
+	Enabled               bool          `json:"enabled"`
+	CheckInterval         time.Duration `json:"checkInterval"`
+	PriceDeviationPercent float64       `json:"priceDeviationPercent"`
+	BalanceDropPercent    float64       `json:"balanceDropPercent"`
+	Currency              string        `json:"currency"`
+	MaxTickerAge          time.Duration `json:"maxTickerAge"`
+	KillSwitch            []string      `json:"killSwitch"`
+}
+
+// PriceAlertsConfig defines the price alert configuration which checks the
+// alerts stored in the database against the latest tickers every check
+// interval. Tickers older than the max ticker age are ignored
+type PriceAlertsConfig struct {
+	Enabled       bool          `json:"enabled"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	MaxTickerAge  time.Duration `json:"maxTickerAge"`
+}
+
+// Actions taken when the portfolio trailing stop triggers
+const (
+	// TrailingStopActionAlert sends a critical alert through the
+	// communication mediums
+	TrailingStopActionAlert = "alert"
+	// TrailingStopActionHalt refuses new order submissions until the trailing
+	// stop is reset
+	TrailingStopActionHalt = "halt"
+	// TrailingStopActionFlatten sells every balance on each exchange into the
+	// trailing stop currency
+	TrailingStopActionFlatten = "flatten"
+)
+
+// TrailingStopConfig defines the portfolio trailing stop, which values the
+// exchange balances in the stablecoin currency every check interval and
+// takes the configured actions once the value falls the drawdown percent
+// from its high-water mark. Tickers older than the max ticker age are ignored
+type TrailingStopConfig struct {
+	Enabled         bool          `json:"enabled"`
+	CheckInterval   time.Duration `json:"checkInterval"`
+	DrawdownPercent float64       `json:"drawdownPercent"`
+	Currency        string        `json:"currency"`
+	Actions         []string      `json:"actions"`
+	MaxTickerAge    time.Duration `json:"maxTickerAge"`
+}
+
+// MarginManagerConfig defines the margin manager, which checks the margin
+// ratio of every open position every check interval. The margin ratio is the
+// maintenance margin as a fraction of the position's margin, a position is
+// liquidated at 1. Isolated positions at the top up ratio are topped up to the
+// target ratio, positions at the reduce ratio which can't be topped up are
+// reduced by the reduce fraction
+type MarginManagerConfig struct {
+	Enabled        bool          `json:"enabled"`
+	CheckInterval  time.Duration `json:"checkInterval"`
+	TargetRatio    float64       `json:"targetRatio"`
+	TopUpRatio     float64       `json:"topUpRatio"`
+	ReduceRatio    float64       `json:"reduceRatio"`
+	ReduceFraction float64       `json:"reduceFraction"`
+}
+
+// PairRefreshConfig defines the tradable pair refresh, which updates the
+// available pairs of every exchange with auto pair updates enabled each
+// interval. Enabled pairs which are no longer tradable are disabled, newly
+// listed pairs are enabled when enableNewPairs is set
+type PairRefreshConfig struct {
+	Enabled        bool          `json:"enabled"`
+	Interval       time.Duration `json:"interval"`
+	EnableNewPairs bool          `json:"enableNewPairs"`
+}
+
+// OpenInterestConfig defines the open interest recorder, which stores the
+// open interest and long/short ratio of every enabled derivatives pair in the
+// database each interval
+type OpenInterestConfig struct {
+	Enabled  bool          `json:"enabled"`
+	Interval time.Duration `json:"interval"`
+}
+
+// DeFiConfig defines the defi manager, which quotes the pairs on every
+// enabled on-chain venue each interval. Quotes are added to the price stats
+// and arbitrage scanner under the venue's name
+type DeFiConfig struct {
+	Enabled  bool              `json:"enabled"`
+	Interval time.Duration     `json:"interval"`
+	Venues   []DeFiVenueConfig `json:"venues"`
+	Pairs    []DeFiPairConfig  `json:"pairs"`
+}
+
+// CalendarConfig defines the trading calendars of venues which close, such as
+// fiat gateways and CME-style exchanges. Orders submitted to a venue while its
+// calendar has it closed are rejected
+type CalendarConfig struct {
+	Enabled bool                `json:"enabled"`
+	Venues  []calendar.Settings `json:"venues"`
+}
+
+// StrategyConfig defines a strategy trading on shared exchange accounts and
+// the virtual balances allocated to it. Allocations seed the strategy's
+// ledger the first time it is seen, after which its balances follow its fills
+type StrategyConfig struct {
+	ID          string                     `json:"id"`
+	Enabled     bool                       `json:"enabled"`
+	Allocations []StrategyAllocationConfig `json:"allocations"`
+	// PartialFillPolicy is what is done with the remainder of the strategy's
+	// orders once partially filled for PartialFillTimeout: leave, cancel or
+	// repeg. Orders may set their own policy
+	PartialFillPolicy  string        `json:"partialFillPolicy,omitempty"`
+	PartialFillTimeout time.Duration `json:"partialFillTimeout,omitempty"`
+}
+
+// StrategyAllocationConfig is an amount of a currency on an exchange
+// allocated to a strategy
+type StrategyAllocationConfig struct {
+	Exchange string  `json:"exchange"`
+	Currency string  `json:"currency"`
+	Amount   float64 `json:"amount"`
+}
+
+// HedgeManagerConfig defines the hedge manager, which nets the spot holdings
+// of each currency against its derivatives positions every check interval
+// and keeps the net exposure of each hedged currency within its hedge's band
+type HedgeManagerConfig struct {
+	Enabled       bool          `json:"enabled"`
+	CheckInterval time.Duration `json:"checkInterval"`
+	Hedges        []HedgeConfig `json:"hedges"`
+}
+
+// HedgeConfig defines a hedge of the spot holdings of a currency with a
+// derivatives contract, such as a short perpetual swap. The contract position
+// is traded so the net exposure is the holdings less ratio of them, whenever
+// it drifts from that by more than band as a fraction of the holdings.
+// ContractSize is the amount of the currency each contract is for, and the
+// currency defaults to the pair's base currency
+type HedgeConfig struct {
+	Currency     string  `json:"currency"`
+	Exchange     string  `json:"exchange"`
+	Pair         string  `json:"pair"`
+	AssetType    string  `json:"assetType"`
+	ContractSize float64 `json:"contractSize"`
+	Ratio        float64 `json:"ratio"`
+	Band         float64 `json:"band"`
+}
+
+// CandleIntegrityConfig defines the candle integrity checker, which checks the
+// candles stored for each series over the lookback period every check
+// interval and backfills the missing candles from the exchange's REST candle
+// history. At most BackfillLimit candles are requested per series
+type CandleIntegrityConfig struct {
+	Enabled       bool                 `json:"enabled"`
+	CheckInterval time.Duration        `json:"checkInterval"`
+	Lookback      time.Duration        `json:"lookback"`
+	BackfillLimit int64                `json:"backfillLimit"`
+	Series        []CandleSeriesConfig `json:"series"`
+}
+
+// CandleSeriesConfig defines a stored candle series. The pair must be
+// formatted as it is stored and the interval be a whole number of seconds
+type CandleSeriesConfig struct {
+	Exchange  string        `json:"exchange"`
+	AssetType string        `json:"assetType"`
+	Pair      string        `json:"pair"`
+	Interval  time.Duration `json:"interval"`
+}
+
+// DeFiVenueConfig defines an on-chain venue, UniswapV3 or 1inch, and its API.
+// The chain ID defaults to Ethereum mainnet, whose common tokens are quoted
+// along with the tokens configured
+type DeFiVenueConfig struct {
+	Name    string      `json:"name"`
+	Enabled bool        `json:"enabled"`
+	APIURL  string      `json:"apiURL,omitempty"`
+	APIKey  string      `json:"apiKey,omitempty"`
+	ChainID int64       `json:"chainID,omitempty"`
+	Tokens  []DeFiToken `json:"tokens,omitempty"`
+	Verbose bool        `json:"verbose"`
+}
+
+// DeFiToken defines an ERC20 token
+type DeFiToken struct {
+	Symbol   string `json:"symbol"`
+	Address  string `json:"address"`
+	Decimals int    `json:"decimals"`
+}
+
+// DeFiPairConfig defines a pair quoted for swapping an amount of its base
+// currency
+type DeFiPairConfig struct {
+	Pair   string  `json:"pair"`
+	Amount float64 `json:"amount"`
 }
 
 // NTPClientConfig defines a network time protocol configuration to allow for
@@ -165,6 +627,9 @@ type NTPClientConfig struct {
 	Pool                      []string       `json:"pool"`
 	AllowedDifference         *time.Duration `json:"allowedDifference"`
 	AllowedNegativeDifference *time.Duration `json:"allowedNegativeDifference"`
+	Samples                   int            `json:"samples"`
+	Retries                   int            `json:"retries"`
+	Timeout                   time.Duration  `json:"timeout"`
 }
 
 // GRPCConfig stores the gRPC settings
@@ -195,6 +660,15 @@ type RemoteControlConfig struct {
 	Username string `json:"username"`
 	Password string `json:"password"`
 
+	// AllowedIPs limits the management interfaces to the listed addresses and
+	// CIDR ranges, every address is allowed when empty
+	AllowedIPs []string `json:"allowedIPs,omitempty"`
+	// MaxSessions limits the concurrent sessions across the management
+	// interfaces, unlimited when 0. A session ends once idle for the session
+	// timeout, or when its websocket connection closes
+	MaxSessions    int           `json:"maxSessions,omitempty"`
+	SessionTimeout time.Duration `json:"sessionTimeout,omitempty"`
+
 	GRPC          GRPCConfig           `json:"gRPC"`
 	DeprecatedRPC DepcrecatedRPCConfig `json:"deprecatedRPC"`
 	WebsocketRPC  WebsocketRPCConfig   `json:"websocketRPC"`
@@ -297,6 +771,9 @@ type CurrencyConfig struct {
 	FiatDisplayCurrency           currency.Code             `json:"fiatDisplayCurrency"`
 	CurrencyFileUpdateDuration    time.Duration             `json:"currencyFileUpdateDuration"`
 	ForeignExchangeUpdateDuration time.Duration             `json:"foreignExchangeUpdateDuration"`
+	// FiatDisplayCurrencies are the fiat currencies portfolio and PnL
+	// reports are valued in, led by the fiat display currency
+	FiatDisplayCurrencies currency.Currencies `json:"fiatDisplayCurrencies,omitempty"`
 }
 
 // CryptocurrencyProvider defines coinmarketcap tools
@@ -311,10 +788,62 @@ type CryptocurrencyProvider struct {
 // CommunicationsConfig holds all the information needed for each
 // enabled communication package
 type CommunicationsConfig struct {
-	SlackConfig     SlackConfig     `json:"slack"`
-	SMSGlobalConfig SMSGlobalConfig `json:"smsGlobal"`
-	SMTPConfig      SMTPConfig      `json:"smtp"`
-	TelegramConfig  TelegramConfig  `json:"telegram"`
+	SlackConfig      SlackConfig         `json:"slack"`
+	SMSGlobalConfig  SMSGlobalConfig     `json:"smsGlobal"`
+	SMTPConfig       SMTPConfig          `json:"smtp"`
+	TelegramConfig   TelegramConfig      `json:"telegram"`
+	WebhookConfig    WebhookConfig       `json:"webhook"`
+	PushoverConfig   PushoverConfig      `json:"pushover"`
+	PushbulletConfig PushbulletConfig    `json:"pushbullet"`
+	PagerDutyConfig  PagerDutyConfig     `json:"pagerDuty"`
+	OpsgenieConfig   OpsgenieConfig      `json:"opsgenie"`
+	SignalConfig     SignalConfig        `json:"signal"`
+	Routes           []CommsRoute        `json:"routes,omitempty"`
+	Templates        []CommsTemplate     `json:"templates,omitempty"`
+	RetryQueue       CommsRetryConfig    `json:"retryQueue"`
+	Throttle         CommsThrottleConfig `json:"throttle"`
+}
+
+// CommsThrottleConfig coalesces identical events sent within a window and
+// caps the amount of events sent to each medium per minute. A zero window or
+// limit disables that feature
+type CommsThrottleConfig struct {
+	CoalesceWindow     time.Duration    `json:"coalesceWindow"`
+	CoalesceEventTypes []string         `json:"coalesceEventTypes,omitempty"`
+	MaxEventsPerMinute int              `json:"maxEventsPerMinute"`
+	MediumLimits       []CommsRateLimit `json:"mediumLimits,omitempty"`
+}
+
+// CommsRateLimit overrides the amount of events per minute sent to a medium
+type CommsRateLimit struct {
+	Medium             string `json:"medium"`
+	MaxEventsPerMinute int    `json:"maxEventsPerMinute"`
+}
+
+// CommsRetryConfig stores events which could not be delivered in the database
+// and retries them with a backoff until the medium recovers
+type CommsRetryConfig struct {
+	Enabled     bool `json:"enabled"`
+	MaxAttempts int  `json:"maxAttempts"`
+}
+
+// CommsRoute sends events of the listed types, at or above the minimum
+// severity, to the listed communication mediums. An event type of "*" matches
+// all events. If no routes are set events are sent to every enabled medium
+type CommsRoute struct {
+	Name        string   `json:"name"`
+	EventTypes  []string `json:"eventTypes"`
+	Mediums     []string `json:"mediums"`
+	MinSeverity string   `json:"minSeverity"`
+}
+
+// CommsTemplate formats messages of an event type sent to a communication
+// medium using Go text/template syntax. An empty or "*" event type or medium
+// matches all
+type CommsTemplate struct {
+	EventType string `json:"eventType"`
+	Medium    string `json:"medium"`
+	Template  string `json:"template"`
 }
 
 // IsAnyEnabled returns whether or any any comms relayers
@@ -323,7 +852,13 @@ func (c *CommunicationsConfig) IsAnyEnabled() bool {
 	if c.SMSGlobalConfig.Enabled ||
 		c.SMTPConfig.Enabled ||
 		c.SlackConfig.Enabled ||
-		c.TelegramConfig.Enabled {
+		c.TelegramConfig.Enabled ||
+		c.WebhookConfig.Enabled ||
+		c.PushoverConfig.Enabled ||
+		c.PushbulletConfig.Enabled ||
+		c.PagerDutyConfig.Enabled ||
+		c.OpsgenieConfig.Enabled ||
+		c.SignalConfig.Enabled {
 		return true
 	}
 	return false
@@ -331,11 +866,23 @@ func (c *CommunicationsConfig) IsAnyEnabled() bool {
 
 // SlackConfig holds all variables to start and run the Slack package
 type SlackConfig struct {
-	Name              string `json:"name"`
-	Enabled           bool   `json:"enabled"`
-	Verbose           bool   `json:"verbose"`
-	TargetChannel     string `json:"targetChannel"`
-	VerificationToken string `json:"verificationToken"`
+	Name              string      `json:"name"`
+	Enabled           bool        `json:"enabled"`
+	Verbose           bool        `json:"verbose"`
+	TargetChannel     string      `json:"targetChannel"`
+	VerificationToken string      `json:"verificationToken"`
+	SigningSecret     string      `json:"signingSecret,omitempty"`
+	ListenAddress     string      `json:"listenAddress,omitempty"`
+	AuthorisedUsers   []SlackUser `json:"authorisedUsers,omitempty"`
+}
+
+// SlackUser stores a Slack user ID which is permitted to use slash commands
+// and interactive messages and its permission level, either "read", "trade"
+// or "admin"
+type SlackUser struct {
+	Name       string `json:"name"`
+	UserID     string `json:"userID"`
+	Permission string `json:"permission"`
 }
 
 // SMSContact stores the SMS contact info
@@ -346,9 +893,12 @@ type SMSContact struct {
 }
 
 // SMSGlobalConfig structure holds all the variables you need for instant
-// messaging and broadcast used by SMSGlobal
+// messaging and broadcast via SMS. The provider selects the SMS gateway,
+// defaulting to SMSGlobal, and the username and password are used as the
+// provider credentials
 type SMSGlobalConfig struct {
 	Name     string       `json:"name"`
+	Provider string       `json:"provider"`
 	From     string       `json:"from"`
 	Enabled  bool         `json:"enabled"`
 	Verbose  bool         `json:"verbose"`
@@ -372,10 +922,84 @@ type SMTPConfig struct {
 
 // TelegramConfig holds all variables to start and run the Telegram package
 type TelegramConfig struct {
-	Name              string `json:"name"`
-	Enabled           bool   `json:"enabled"`
-	Verbose           bool   `json:"verbose"`
-	VerificationToken string `json:"verificationToken"`
+	Name              string           `json:"name"`
+	Enabled           bool             `json:"enabled"`
+	Verbose           bool             `json:"verbose"`
+	VerificationToken string           `json:"verificationToken"`
+	AuthorisedClients []TelegramClient `json:"authorisedClients,omitempty"`
+}
+
+// TelegramClient stores a chat ID which is permitted to interact with the
+// Telegram bot and its permission level, either "read", "trade" or "admin"
+type TelegramClient struct {
+	Name       string `json:"name"`
+	ChatID     int64  `json:"chatID"`
+	Permission string `json:"permission"`
+}
+
+// WebhookConfig holds all variables to start and run the Webhook package
+type WebhookConfig struct {
+	Name       string            `json:"name"`
+	Enabled    bool              `json:"enabled"`
+	Verbose    bool              `json:"verbose"`
+	MaxRetries int               `json:"maxRetries"`
+	Endpoints  []WebhookEndpoint `json:"endpoints"`
+}
+
+// WebhookEndpoint stores a webhook URL and the secret used to sign payloads
+// sent to it
+type WebhookEndpoint struct {
+	Name    string `json:"name"`
+	URL     string `json:"url"`
+	Secret  string `json:"secret"`
+	Enabled bool   `json:"enabled"`
+}
+
+// PushoverConfig holds all variables to start and run the Pushover package
+type PushoverConfig struct {
+	Name     string `json:"name"`
+	Enabled  bool   `json:"enabled"`
+	Verbose  bool   `json:"verbose"`
+	APIToken string `json:"apiToken"`
+	UserKey  string `json:"userKey"`
+	Device   string `json:"device,omitempty"`
+}
+
+// PushbulletConfig holds all variables to start and run the Pushbullet
+// package
+type PushbulletConfig struct {
+	Name        string `json:"name"`
+	Enabled     bool   `json:"enabled"`
+	Verbose     bool   `json:"verbose"`
+	AccessToken string `json:"accessToken"`
+	ChannelTag  string `json:"channelTag,omitempty"`
+}
+
+// PagerDutyConfig holds all variables to start and run the PagerDuty package
+type PagerDutyConfig struct {
+	Name       string `json:"name"`
+	Enabled    bool   `json:"enabled"`
+	Verbose    bool   `json:"verbose"`
+	RoutingKey string `json:"routingKey"`
+}
+
+// OpsgenieConfig holds all variables to start and run the Opsgenie package
+type OpsgenieConfig struct {
+	Name    string `json:"name"`
+	Enabled bool   `json:"enabled"`
+	Verbose bool   `json:"verbose"`
+	APIKey  string `json:"apiKey"`
+	APIURL  string `json:"apiURL,omitempty"`
+}
+
+// SignalConfig holds all variables to start and run the Signal package
+type SignalConfig struct {
+	Name       string   `json:"name"`
+	Enabled    bool     `json:"enabled"`
+	Verbose    bool     `json:"verbose"`
+	APIURL     string   `json:"apiURL"`
+	Number     string   `json:"number"`
+	Recipients []string `json:"recipients"`
 }
 
 // FeaturesSupportedConfig stores the exchanges supported features
@@ -400,9 +1024,11 @@ type FeaturesConfig struct {
 
 // APIEndpointsConfig stores the API endpoint addresses
 type APIEndpointsConfig struct {
-	URL          string `json:"url"`
-	URLSecondary string `json:"urlSecondary"`
-	WebsocketURL string `json:"websocketURL"`
+	URL                    string   `json:"url"`
+	URLSecondary           string   `json:"urlSecondary"`
+	WebsocketURL           string   `json:"websocketURL"`
+	URLAlternates          []string `json:"urlAlternates,omitempty"`
+	WebsocketURLAlternates []string `json:"websocketURLAlternates,omitempty"`
 }
 
 // APICredentialsConfig stores the API credentials
@@ -435,3 +1061,44 @@ type APIConfig struct {
 	Credentials          APICredentialsConfig           `json:"credentials"`
 	CredentialsValidator *APICredentialsValidatorConfig `json:"credentialsValidator,omitempty"`
 }
+
+// SecretsUpdate lists the credentials changed by loading secrets
+type SecretsUpdate struct {
+	Exchanges []string
+	Wallets   []string
+	Database  bool
+}
+
+// secretOriginals stores the config values replaced by secrets
+type secretOriginals struct {
+	exchanges map[string]APICredentialsConfig
+	wallets   map[string]string
+	database  *databaseCredentials
+}
+
+// databaseCredentials stores the database credentials which can be loaded
+// from the secrets backend
+type databaseCredentials struct {
+	Username string
+	Password string
+}
+
+// ValidationError is a problem with the config field at Path, a JSON path
+// such as exchanges[2].httpTimeout. Warnings, such as unknown fields left over
+// from older versions, don't stop the config loading.
+type ValidationError struct {
+	Path    string
+	Message string
+	Warning bool
+}
+
+// ValidationErrors holds every problem found validating a config
+type ValidationErrors []ValidationError
+
+// configMigration upgrades the config JSON layout from the previous version to
+// Version
+type configMigration struct {
+	Version     int
+	Description string
+	Up          func(cfg map[string]interface{}) error
+}
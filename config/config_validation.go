@@ -0,0 +1,398 @@
+package config
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"math"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/secrets"
+)
+
+// Error returns every validation problem, one per line
+func (v ValidationErrors) Error() string {
+	lines := make([]string, len(v))
+	for i := range v {
+		lines[i] = v[i].Error()
+	}
+	return fmt.Sprintf("config has %d problem(s):\n%s", len(v), strings.Join(lines, "\n"))
+}
+
+// Error returns the problem prefixed by the path of the field
+func (v ValidationError) Error() string {
+	if v.Path == "" {
+		return v.Message
+	}
+	return v.Path + ": " + v.Message
+}
+
+// problems returns the validation errors which aren't warnings
+func (v ValidationErrors) problems() ValidationErrors {
+	var p ValidationErrors
+	for i := range v {
+		if !v[i].Warning {
+			p = append(p, v[i])
+		}
+	}
+	return p
+}
+
+// add records a problem with the field at path
+func (v *ValidationErrors) add(path, format string, a ...interface{}) {
+	*v = append(*v, ValidationError{Path: path, Message: fmt.Sprintf(format, a...)})
+}
+
+// ValidateJSON checks config JSON against the config schema, returning every
+// value of the wrong type or out of range for its field along with warnings
+// for unknown fields
+func ValidateJSON(data []byte) error {
+	d := json.NewDecoder(bytes.NewReader(data))
+	d.UseNumber()
+	var v interface{}
+	err := d.Decode(&v)
+	if err != nil {
+		return ValidationErrors{{Message: "invalid JSON: " + err.Error()}}
+	}
+	var errs ValidationErrors
+	validateJSONValue(v, reflect.TypeOf(Config{}), "", &errs)
+	if len(errs) == 0 {
+		return nil
+	}
+	sort.SliceStable(errs, func(i, j int) bool { return errs[i].Path < errs[j].Path })
+	return errs
+}
+
+// validateJSONValue checks the decoded JSON value v can be unmarshalled into
+// type t
+func validateJSONValue(v interface{}, t reflect.Type, path string, errs *ValidationErrors) {
+	if reflect.PtrTo(t).Implements(unmarshalerType) || t.Implements(unmarshalerType) {
+		// Types with their own JSON format are validated when decoded
+		return
+	}
+	if v == nil {
+		// null leaves the field unchanged
+		return
+	}
+
+	switch t.Kind() {
+	case reflect.Ptr:
+		validateJSONValue(v, t.Elem(), path, errs)
+	case reflect.Interface:
+	case reflect.Struct:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			errs.add(path, "expected an object, got %s", jsonKind(v))
+			return
+		}
+		fields := jsonFields(t)
+		for k, val := range obj {
+			f, ok := fields[k]
+			if !ok {
+				// Field names are matched case insensitively when decoded
+				for name := range fields {
+					if strings.EqualFold(name, k) {
+						f, ok = fields[name], true
+						break
+					}
+				}
+			}
+			if !ok {
+				*errs = append(*errs, ValidationError{
+					Path:    joinPath(path, k),
+					Message: "unknown field",
+					Warning: true,
+				})
+				continue
+			}
+			validateJSONValue(val, f.Type, joinPath(path, k), errs)
+		}
+	case reflect.Map:
+		obj, ok := v.(map[string]interface{})
+		if !ok {
+			errs.add(path, "expected an object, got %s", jsonKind(v))
+			return
+		}
+		for k, val := range obj {
+			validateJSONValue(val, t.Elem(), joinPath(path, k), errs)
+		}
+	case reflect.Slice, reflect.Array:
+		if t.Kind() == reflect.Slice && t.Elem().Kind() == reflect.Uint8 {
+			if _, ok := v.(string); !ok {
+				errs.add(path, "expected a base64 string, got %s", jsonKind(v))
+			}
+			return
+		}
+		arr, ok := v.([]interface{})
+		if !ok {
+			errs.add(path, "expected an array, got %s", jsonKind(v))
+			return
+		}
+		if t.Kind() == reflect.Array && len(arr) > t.Len() {
+			errs.add(path, "expected at most %d elements, got %d", t.Len(), len(arr))
+		}
+		for i := range arr {
+			validateJSONValue(arr[i], t.Elem(), path+"["+strconv.Itoa(i)+"]", errs)
+		}
+	case reflect.String:
+		if _, ok := v.(string); !ok {
+			errs.add(path, "expected a string, got %s", jsonKind(v))
+		}
+	case reflect.Bool:
+		if _, ok := v.(bool); !ok {
+			errs.add(path, "expected a bool, got %s", jsonKind(v))
+		}
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, ok := v.(json.Number)
+		if !ok {
+			errs.add(path, "expected an integer, got %s", jsonKind(v))
+			return
+		}
+		if _, err := strconv.ParseInt(n.String(), 10, t.Bits()); err != nil {
+			min := int64(-1) << uint(t.Bits()-1)
+			errs.add(path, "expected an integer between %d and %d, got %s", min, -(min + 1), n)
+		}
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		n, ok := v.(json.Number)
+		if !ok {
+			errs.add(path, "expected an integer, got %s", jsonKind(v))
+			return
+		}
+		if _, err := strconv.ParseUint(n.String(), 10, t.Bits()); err != nil {
+			errs.add(path, "expected an integer between 0 and %d, got %s",
+				uint64(math.MaxUint64)>>uint(64-t.Bits()), n)
+		}
+	case reflect.Float32, reflect.Float64:
+		n, ok := v.(json.Number)
+		if !ok {
+			errs.add(path, "expected a number, got %s", jsonKind(v))
+			return
+		}
+		if _, err := strconv.ParseFloat(n.String(), t.Bits()); err != nil {
+			errs.add(path, "number %s out of range", n)
+		}
+	}
+}
+
+// jsonFields returns the fields of struct type t by JSON name, including
+// those of embedded structs without a JSON name
+func jsonFields(t reflect.Type) map[string]reflect.StructField {
+	fields := make(map[string]reflect.StructField)
+	for i := 0; i < t.NumField(); i++ {
+		f := t.Field(i)
+		tag := strings.Split(f.Tag.Get("json"), ",")[0]
+		if tag == "-" {
+			continue
+		}
+		if f.Anonymous && tag == "" {
+			ft := f.Type
+			if ft.Kind() == reflect.Ptr {
+				ft = ft.Elem()
+			}
+			if ft.Kind() == reflect.Struct {
+				for name, embedded := range jsonFields(ft) {
+					if _, ok := fields[name]; !ok {
+						fields[name] = embedded
+					}
+				}
+				continue
+			}
+		}
+		if f.PkgPath != "" {
+			continue
+		}
+		if tag == "" {
+			tag = f.Name
+		}
+		fields[tag] = f
+	}
+	return fields
+}
+
+// jsonKind returns the JSON kind of a decoded value
+func jsonKind(v interface{}) string {
+	switch val := v.(type) {
+	case map[string]interface{}:
+		return "an object"
+	case []interface{}:
+		return "an array"
+	case string:
+		return "a string"
+	case bool:
+		return "a bool"
+	case json.Number:
+		return "number " + val.String()
+	}
+	return "null"
+}
+
+func joinPath(path, field string) string {
+	if path == "" {
+		return field
+	}
+	return path + "." + field
+}
+
+// Validate checks the config values for problems which would otherwise only
+// surface when the subsystems using them are started, such as out of range
+// settings and conflicting settings. Every problem is returned at once.
+func (c *Config) Validate() error {
+	m.Lock()
+	defer m.Unlock()
+	var errs ValidationErrors
+
+	switch c.EncryptConfig {
+	case fileEncryptionPrompt, fileEncryptionEnabled, fileEncryptionDisabled:
+	default:
+		errs.add("encryptConfig", "must be %d, %d or %d", fileEncryptionDisabled,
+			fileEncryptionPrompt, fileEncryptionEnabled)
+	}
+	if c.GlobalHTTPTimeout < 0 {
+		errs.add("globalHTTPTimeout", "must not be negative")
+	}
+
+	if c.Database.Enabled {
+		if !common.StringDataCompare(database.SupportedDrivers, c.Database.Driver) {
+			errs.add("database.driver", "unsupported driver %q, must be one of %s",
+				c.Database.Driver, strings.Join(database.SupportedDrivers, ", "))
+		}
+		if c.Database.Database == "" {
+			errs.add("database.connectionDetails.database", "must be set when the database is enabled")
+		}
+		if c.Database.Driver == database.DBPostgreSQL && c.Database.Host == "" {
+			errs.add("database.connectionDetails.host", "must be set when using %s", database.DBPostgreSQL)
+		}
+	}
+
+	if c.NTPClient.Samples < 0 {
+		errs.add("ntpclient.samples", "must not be negative")
+	}
+	if c.NTPClient.Timeout < 0 {
+		errs.add("ntpclient.timeout", "must not be negative")
+	}
+
+	if c.ErrorReporting.Enabled && c.ErrorReporting.DSN == "" {
+		errs.add("errorReporting.dsn", "must be set when error reporting is enabled")
+	}
+	if c.Tracing.Enabled && c.Tracing.Endpoint == "" {
+		errs.add("tracing.endpoint", "must be set when tracing is enabled")
+	}
+	if c.SecretsBackend.Enabled {
+		switch c.SecretsBackend.Backend {
+		case secrets.BackendVault, secrets.BackendAWSSecretsManager, secrets.BackendFile:
+		case secrets.BackendKeystore:
+			if c.SecretsBackend.Keystore.Path == "" {
+				errs.add("secretsBackend.keystore.path", "must be set when using the keystore backend")
+			}
+		default:
+			errs.add("secretsBackend.backend", "unsupported backend %q, must be one of %s, %s, %s or %s",
+				c.SecretsBackend.Backend, secrets.BackendVault,
+				secrets.BackendAWSSecretsManager, secrets.BackendFile, secrets.BackendKeystore)
+		}
+	}
+
+	names := make(map[string]int)
+	for i := range c.Exchanges {
+		c.Exchanges[i].validate("exchanges["+strconv.Itoa(i)+"]", &errs)
+		name := strings.ToLower(c.Exchanges[i].Name)
+		if j, ok := names[name]; ok {
+			errs.add("exchanges["+strconv.Itoa(i)+"].name",
+				"exchange %s is already configured at exchanges[%d]", c.Exchanges[i].Name, j)
+			continue
+		}
+		names[name] = i
+	}
+
+	if len(errs) == 0 {
+		return nil
+	}
+	return errs
+}
+
+// validate checks the exchange config values, adding problems to errs
+func (e *ExchangeConfig) validate(path string, errs *ValidationErrors) {
+	if e.Name == "" {
+		errs.add(path+".name", "must be set")
+	}
+	for _, d := range []struct {
+		name  string
+		value int64
+	}{
+		{"httpTimeout", int64(e.HTTPTimeout)},
+		{"websocketResponseCheckTimeout", int64(e.WebsocketResponseCheckTimeout)},
+		{"websocketResponseMaxLimit", int64(e.WebsocketResponseMaxLimit)},
+		{"websocketTrafficTimeout", int64(e.WebsocketTrafficTimeout)},
+		{"websocketOrderbookBufferLimit", int64(e.WebsocketOrderbookBufferLimit)},
+	} {
+		if d.value < 0 {
+			errs.add(path+"."+d.name, "must not be negative")
+		}
+	}
+	if err := checkProxyAddress(e.ProxyAddress); err != nil {
+		errs.add(path+".proxyAddress", "%s", err)
+	}
+	if err := checkProxyAddress(e.WebsocketProxyAddress); err != nil {
+		errs.add(path+".websocketProxyAddress", "%s", err)
+	}
+	if e.HTTPRetry != nil {
+		if e.HTTPRetry.MaxRetries < 0 {
+			errs.add(path+".httpRetry.maxRetries", "must not be negative")
+		}
+		if e.HTTPRetry.MaxBackoff > 0 && e.HTTPRetry.InitialBackoff > e.HTTPRetry.MaxBackoff {
+			errs.add(path+".httpRetry.initialBackoff", "must not exceed maxBackoff")
+		}
+	}
+	if e.WebsocketKeepalive != nil {
+		for _, d := range []struct {
+			name  string
+			value time.Duration
+		}{
+			{"pingInterval", e.WebsocketKeepalive.PingInterval},
+			{"pongTimeout", e.WebsocketKeepalive.PongTimeout},
+			{"maxSilence", e.WebsocketKeepalive.MaxSilence},
+		} {
+			if d.value < 0 {
+				errs.add(path+".websocketKeepalive."+d.name, "must not be negative")
+			}
+		}
+	}
+	if e.HTTPCircuitBreaker != nil {
+		if e.HTTPCircuitBreaker.FailureThreshold < 0 {
+			errs.add(path+".httpCircuitBreaker.failureThreshold", "must not be negative")
+		}
+		if e.HTTPCircuitBreaker.Cooldown < 0 {
+			errs.add(path+".httpCircuitBreaker.cooldown", "must not be negative")
+		}
+	}
+
+	if !e.Enabled || e.Features == nil || !e.Features.Enabled.Websocket {
+		return
+	}
+	if !e.Features.Supports.Websocket {
+		errs.add(path+".features.enabled.websocketAPI",
+			"exchange %s does not support websocket", e.Name)
+	}
+	if !e.hasEnabledPairs() {
+		errs.add(path+".currencyPairs",
+			"exchange %s has websocket enabled but no enabled currency pairs", e.Name)
+	}
+}
+
+// hasEnabledPairs returns whether any asset type has an enabled pair
+func (e *ExchangeConfig) hasEnabledPairs() bool {
+	if e.CurrencyPairs == nil {
+		return e.EnabledPairs != nil && len(*e.EnabledPairs) > 0
+	}
+	for _, ps := range e.CurrencyPairs.Pairs {
+		if ps != nil && len(ps.Enabled) > 0 {
+			return true
+		}
+	}
+	return false
+}
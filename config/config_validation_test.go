@@ -0,0 +1,142 @@
+package config
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestValidateJSON(t *testing.T) {
+	t.Parallel()
+	err := ValidateJSON([]byte(`{`))
+	if err == nil {
+		t.Error("expected error on invalid JSON")
+	}
+
+	err = ValidateJSON([]byte(`{
+		"name": 1,
+		"encryptConfig": -1,
+		"globalHTTPTimeout": "15s",
+		"unknownField": true,
+		"database": {"enabled": "yes", "connectionDetails": {"port": 70000}},
+		"connectionMonitor": {"preferredDNSList": "8.8.8.8"},
+		"exchanges": [
+			{"name": "Binance", "httpTimeout": 15000000000, "restPollingDelay": 10},
+			{"name": "Bitstamp", "api": {"credentials": {"key": false}}}
+		]
+	}`))
+	errs, ok := err.(ValidationErrors)
+	if !ok {
+		t.Fatalf("expected validation errors, got %v", err)
+	}
+	expected := []string{
+		"connectionMonitor.preferredDNSList: expected an array, got a string",
+		"database.connectionDetails.port: expected an integer between 0 and 65535, got 70000",
+		"database.enabled: expected a bool, got a string",
+		"exchanges[0].restPollingDelay: unknown field",
+		"exchanges[1].api.credentials.key: expected a string, got a bool",
+		"globalHTTPTimeout: expected an integer, got a string",
+		"name: expected a string, got number 1",
+		"unknownField: unknown field",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d problems, got %v", len(expected), errs)
+	}
+	for i := range expected {
+		if errs[i].Error() != expected[i] {
+			t.Errorf("expected %q, got %q", expected[i], errs[i].Error())
+		}
+	}
+	if p := errs.problems(); len(p) != 6 {
+		t.Errorf("expected unknown fields to be warnings, got %v", p)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	t.Parallel()
+	c := &Config{EncryptConfig: fileEncryptionDisabled}
+	if err := c.Validate(); err != nil {
+		t.Errorf("unexpected error %v", err)
+	}
+
+	c.EncryptConfig = 2
+	c.Database = database.Config{Enabled: true, Driver: "mysql"}
+	c.SecretsBackend.Enabled = true
+	c.Exchanges = []ExchangeConfig{
+		{
+			Name:        "Binance",
+			Enabled:     true,
+			HTTPTimeout: -time.Second,
+			HTTPRetry:   &HTTPRetryConfig{InitialBackoff: time.Minute, MaxBackoff: time.Second},
+			WebsocketKeepalive: &WebsocketKeepaliveConfig{
+				PingInterval: time.Second,
+				MaxSilence:   -time.Second,
+			},
+			Features: &FeaturesConfig{
+				Supports: FeaturesSupportedConfig{Websocket: true},
+				Enabled:  FeaturesEnabledConfig{Websocket: true},
+			},
+			CurrencyPairs: &currency.PairsManager{
+				Pairs: map[asset.Item]*currency.PairStore{asset.Spot: {}},
+			},
+		},
+		{Name: "binance", ProxyAddress: "ftp://127.0.0.1"},
+	}
+	errs, ok := c.Validate().(ValidationErrors)
+	if !ok {
+		t.Fatal("expected validation errors")
+	}
+	expected := []string{
+		"encryptConfig",
+		"database.driver",
+		"database.connectionDetails.database",
+		"secretsBackend.backend",
+		"exchanges[0].httpTimeout",
+		"exchanges[0].httpRetry.initialBackoff",
+		"exchanges[0].websocketKeepalive.maxSilence",
+		"exchanges[0].currencyPairs",
+		"exchanges[1].proxyAddress",
+		"exchanges[1].name",
+	}
+	if len(errs) != len(expected) {
+		t.Fatalf("expected %d problems, got %v", len(expected), errs)
+	}
+	for i := range expected {
+		if errs[i].Path != expected[i] {
+			t.Errorf("expected problem with %s, got %s", expected[i], errs[i])
+		}
+	}
+}
+
+func TestReadConfigValidation(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "config")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, File)
+	err = ioutil.WriteFile(path,
+		[]byte(`{"name":"test","encryptConfig":-1,"globalHTTPTimeout":"15s","exchanges":[{"enabled":1}]}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var c Config
+	err = c.ReadConfig(path, true)
+	if err == nil {
+		t.Fatal("expected validation error")
+	}
+	for _, s := range []string{"2 problem(s)", "globalHTTPTimeout", "exchanges[0].enabled"} {
+		if !strings.Contains(err.Error(), s) {
+			t.Errorf("expected %q in error %v", s, err)
+		}
+	}
+}
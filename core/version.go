@@ -23,8 +23,16 @@ const (
 var (
 	Copyright = fmt.Sprintf("Copyright (c) 2014-%d The GoCryptoTrader Developers.",
 		time.Now().Year())
+	// Commit is the git commit the binary was built from, set at build time
+	// with -ldflags "-X github.com/thrasher-corp/gocryptotrader/core.Commit=<sha>"
+	Commit string
 )
 
+// Release returns the release name used to tag error reports
+func Release() string {
+	return fmt.Sprintf("gocryptotrader@v%s.%s", MajorVersion, MinorVersion)
+}
+
 // Version returns the version string
 func Version(short bool) string {
 	versionStr := fmt.Sprintf("GoCryptoTrader v%s.%s %s %s",
@@ -14,6 +14,7 @@
 package currencylayer
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
@@ -207,7 +208,7 @@ func (c *CurrencyLayer) SendHTTPRequest(endPoint string, values url.Values, resu
 	}
 	path += values.Encode()
 
-	return c.Requester.SendPayload(&request.Item{
+	return c.Requester.SendPayload(context.Background(), &request.Item{
 		Method:      http.MethodGet,
 		Path:        path,
 		Result:      &result,
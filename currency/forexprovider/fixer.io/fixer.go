@@ -9,6 +9,7 @@
 package fixer
 
 import (
+	"context"
 	"errors"
 	"net/http"
 	"net/url"
@@ -231,7 +232,7 @@ func (f *Fixer) SendOpenHTTPRequest(endpoint string, v url.Values, result interf
 		auth = true
 	}
 
-	return f.Requester.SendPayload(&request.Item{
+	return f.Requester.SendPayload(context.Background(), &request.Item{
 		Method:      http.MethodGet,
 		Path:        path,
 		Result:      &result,
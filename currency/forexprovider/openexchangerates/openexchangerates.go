@@ -9,6 +9,7 @@
 package openexchangerates
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -218,7 +219,7 @@ func (o *OXR) SendHTTPRequest(endpoint string, values url.Values, result interfa
 	headers["Authorization"] = "Token " + o.APIKey
 	path := APIURL + endpoint + "?" + values.Encode()
 
-	return o.Requester.SendPayload(&request.Item{
+	return o.Requester.SendPayload(context.Background(), &request.Item{
 		Method:  http.MethodGet,
 		Path:    path,
 		Result:  result,
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS comms_retry_queue
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    medium          varchar(255) NOT NULL,
+    event_type      varchar(255) NOT NULL,
+    message         text         NOT NULL,
+    severity        varchar(255) NOT NULL,
+    incident_key    varchar(255) NOT NULL DEFAULT '',
+    resolved        boolean      NOT NULL DEFAULT false,
+    attempts        integer      NOT NULL DEFAULT 0,
+    last_error      text         NOT NULL DEFAULT '',
+    next_attempt_at TIMESTAMP    NOT NULL,
+    created_at      TIMESTAMP    NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX comms_retry_queue_next_attempt_at_idx ON comms_retry_queue(next_attempt_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE comms_retry_queue;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "comms_retry_queue"
+(
+    id              integer not null primary key,
+    medium          text not null,
+    event_type      text not null,
+    message         text not null,
+    severity        text not null,
+    incident_key    text not null default '',
+    resolved        boolean not null default false,
+    attempts        integer not null default 0,
+    last_error      text not null default '',
+    next_attempt_at timestamp not null,
+    created_at      timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE INDEX comms_retry_queue_next_attempt_at_idx ON comms_retry_queue(next_attempt_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE comms_retry_queue;
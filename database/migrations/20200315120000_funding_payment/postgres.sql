@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS funding_payment
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange   varchar(255)     NOT NULL,
+    asset      varchar(255)     NOT NULL,
+    pair       varchar(255)     NOT NULL,
+    rate       double precision NOT NULL,
+    amount     double precision NOT NULL,
+    currency   varchar(255)     NOT NULL,
+    paid_at    TIMESTAMP        NOT NULL,
+    created_at TIMESTAMP        NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE UNIQUE INDEX funding_payment_unique_idx ON funding_payment(exchange, asset, pair, paid_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE funding_payment;
@@ -0,0 +1,18 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "funding_payment"
+(
+    id         integer not null primary key,
+    exchange   text not null,
+    asset      text not null,
+    pair       text not null,
+    rate       real not null,
+    amount     real not null,
+    currency   text not null,
+    paid_at    timestamp not null,
+    created_at timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX funding_payment_unique_idx ON funding_payment(exchange, asset, pair, paid_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE funding_payment;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS price_alert
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange        varchar(255)     NOT NULL,
+    asset           varchar(255)     NOT NULL,
+    pair            varchar(255)     NOT NULL,
+    condition       varchar(255)     NOT NULL,
+    threshold       double precision NOT NULL,
+    reference_price double precision NOT NULL,
+    note            text             NOT NULL DEFAULT '',
+    active          boolean          NOT NULL DEFAULT true,
+    triggered_price double precision NOT NULL DEFAULT 0,
+    created_at      TIMESTAMP        NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE INDEX price_alert_active_idx ON price_alert(active);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE price_alert;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "price_alert"
+(
+    id              integer not null primary key,
+    exchange        text not null,
+    asset           text not null,
+    pair            text not null,
+    condition       text not null,
+    threshold       real not null,
+    reference_price real not null,
+    note            text not null default '',
+    active          boolean not null default true,
+    triggered_price real not null default 0,
+    created_at      timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE INDEX price_alert_active_idx ON price_alert(active);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE price_alert;
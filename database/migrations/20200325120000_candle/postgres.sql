@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS candle
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange   varchar(255)     NOT NULL,
+    asset      varchar(255)     NOT NULL,
+    pair       varchar(255)     NOT NULL,
+    period     bigint           NOT NULL,
+    start_time TIMESTAMP        NOT NULL,
+    open       double precision NOT NULL,
+    high       double precision NOT NULL,
+    low        double precision NOT NULL,
+    close      double precision NOT NULL,
+    volume     double precision NOT NULL,
+    created_at TIMESTAMP        NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE UNIQUE INDEX candle_unique_idx ON candle(exchange, asset, pair, period, start_time);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE candle;
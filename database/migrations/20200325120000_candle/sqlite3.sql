@@ -0,0 +1,21 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "candle"
+(
+    id         integer not null primary key,
+    exchange   text not null,
+    asset      text not null,
+    pair       text not null,
+    period     integer not null,
+    start_time timestamp not null,
+    open       real not null,
+    high       real not null,
+    low        real not null,
+    close      real not null,
+    volume     real not null,
+    created_at timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX candle_unique_idx ON candle(exchange, asset, pair, period, start_time);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE candle;
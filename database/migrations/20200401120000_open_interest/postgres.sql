@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS open_interest
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    exchange         varchar(255)     NOT NULL,
+    asset            varchar(255)     NOT NULL,
+    pair             varchar(255)     NOT NULL,
+    amount           double precision NOT NULL,
+    value            double precision NOT NULL,
+    currency         varchar(255)     NOT NULL,
+    long_short_ratio double precision NOT NULL,
+    recorded_at      TIMESTAMP        NOT NULL,
+    created_at       TIMESTAMP        NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE UNIQUE INDEX open_interest_unique_idx ON open_interest(exchange, asset, pair, recorded_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE open_interest;
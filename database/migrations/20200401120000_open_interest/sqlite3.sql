@@ -0,0 +1,19 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "open_interest"
+(
+    id               integer not null primary key,
+    exchange         text not null,
+    asset            text not null,
+    pair             text not null,
+    amount           real not null,
+    value            real not null,
+    currency         text not null,
+    long_short_ratio real not null,
+    recorded_at      timestamp not null,
+    created_at       timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX open_interest_unique_idx ON open_interest(exchange, asset, pair, recorded_at);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE open_interest;
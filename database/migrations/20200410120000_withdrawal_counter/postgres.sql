@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS withdrawal_counter
+(
+    id bigserial PRIMARY KEY NOT NULL,
+    currency   varchar(255)     NOT NULL,
+    day        varchar(10)      NOT NULL,
+    amount     double precision NOT NULL,
+    alerted    boolean          NOT NULL DEFAULT false,
+    created_at TIMESTAMP        NOT NULL DEFAULT (now() at time zone 'utc')
+);
+CREATE UNIQUE INDEX withdrawal_counter_unique_idx ON withdrawal_counter(currency, day);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE withdrawal_counter;
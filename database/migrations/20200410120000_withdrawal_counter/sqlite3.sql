@@ -0,0 +1,15 @@
+-- +goose Up
+-- SQL in this section is executed when the migration is applied.
+CREATE TABLE IF NOT EXISTS "withdrawal_counter"
+(
+    id         integer not null primary key,
+    currency   text not null,
+    day        text not null,
+    amount     real not null,
+    alerted    boolean not null default false,
+    created_at timestamp not null default CURRENT_TIMESTAMP
+);
+CREATE UNIQUE INDEX withdrawal_counter_unique_idx ON withdrawal_counter(currency, day);
+-- +goose Down
+-- SQL in this section is executed when the migration is rolled back.
+DROP TABLE withdrawal_counter;
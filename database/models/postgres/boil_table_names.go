@@ -4,11 +4,23 @@
 package postgres
 
 var TableNames = struct {
-	AuditEvent      string
-	Script          string
-	ScriptExecution string
+	AuditEvent        string
+	Candle            string
+	CommsRetryQueue   string
+	FundingPayment    string
+	OpenInterest      string
+	PriceAlert        string
+	Script            string
+	ScriptExecution   string
+	WithdrawalCounter string
 }{
-	AuditEvent:      "audit_event",
-	Script:          "script",
-	ScriptExecution: "script_execution",
+	AuditEvent:        "audit_event",
+	Candle:            "candle",
+	CommsRetryQueue:   "comms_retry_queue",
+	FundingPayment:    "funding_payment",
+	OpenInterest:      "open_interest",
+	PriceAlert:        "price_alert",
+	Script:            "script",
+	ScriptExecution:   "script_execution",
+	WithdrawalCounter: "withdrawal_counter",
 }
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testCommsRetryQueues(t *testing.T) {
+	t.Parallel()
+
+	query := CommsRetryQueues()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testCommsRetryQueuesDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCommsRetryQueuesQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := CommsRetryQueues().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCommsRetryQueuesSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CommsRetryQueueSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testCommsRetryQueuesExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := CommsRetryQueueExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if CommsRetryQueue exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected CommsRetryQueueExists to return true, but got false.")
+	}
+}
+
+func testCommsRetryQueuesFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	commsRetryQueueFound, err := FindCommsRetryQueue(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if commsRetryQueueFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testCommsRetryQueuesBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = CommsRetryQueues().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCommsRetryQueuesOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := CommsRetryQueues().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testCommsRetryQueuesAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	commsRetryQueueOne := &CommsRetryQueue{}
+	commsRetryQueueTwo := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, commsRetryQueueOne, commsRetryQueueDBTypes, false, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+	if err = randomize.Struct(seed, commsRetryQueueTwo, commsRetryQueueDBTypes, false, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = commsRetryQueueOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = commsRetryQueueTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CommsRetryQueues().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testCommsRetryQueuesCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	commsRetryQueueOne := &CommsRetryQueue{}
+	commsRetryQueueTwo := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, commsRetryQueueOne, commsRetryQueueDBTypes, false, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+	if err = randomize.Struct(seed, commsRetryQueueTwo, commsRetryQueueDBTypes, false, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = commsRetryQueueOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = commsRetryQueueTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func commsRetryQueueBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func commsRetryQueueAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func commsRetryQueueAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func commsRetryQueueBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func commsRetryQueueAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func commsRetryQueueBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func commsRetryQueueAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func commsRetryQueueBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func commsRetryQueueAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *CommsRetryQueue) error {
+	*o = CommsRetryQueue{}
+	return nil
+}
+
+func testCommsRetryQueuesHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &CommsRetryQueue{}
+	o := &CommsRetryQueue{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue object: %s", err)
+	}
+
+	AddCommsRetryQueueHook(boil.BeforeInsertHook, commsRetryQueueBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueBeforeInsertHooks = []CommsRetryQueueHook{}
+
+	AddCommsRetryQueueHook(boil.AfterInsertHook, commsRetryQueueAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueAfterInsertHooks = []CommsRetryQueueHook{}
+
+	AddCommsRetryQueueHook(boil.AfterSelectHook, commsRetryQueueAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueAfterSelectHooks = []CommsRetryQueueHook{}
+
+	AddCommsRetryQueueHook(boil.BeforeUpdateHook, commsRetryQueueBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueBeforeUpdateHooks = []CommsRetryQueueHook{}
+
+	AddCommsRetryQueueHook(boil.AfterUpdateHook, commsRetryQueueAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueAfterUpdateHooks = []CommsRetryQueueHook{}
+
+	AddCommsRetryQueueHook(boil.BeforeDeleteHook, commsRetryQueueBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueBeforeDeleteHooks = []CommsRetryQueueHook{}
+
+	AddCommsRetryQueueHook(boil.AfterDeleteHook, commsRetryQueueAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueAfterDeleteHooks = []CommsRetryQueueHook{}
+
+	AddCommsRetryQueueHook(boil.BeforeUpsertHook, commsRetryQueueBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueBeforeUpsertHooks = []CommsRetryQueueHook{}
+
+	AddCommsRetryQueueHook(boil.AfterUpsertHook, commsRetryQueueAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	commsRetryQueueAfterUpsertHooks = []CommsRetryQueueHook{}
+}
+
+func testCommsRetryQueuesInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCommsRetryQueuesInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(commsRetryQueueColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testCommsRetryQueuesReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCommsRetryQueuesReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := CommsRetryQueueSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testCommsRetryQueuesSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := CommsRetryQueues().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	commsRetryQueueDBTypes = map[string]string{`ID`: `bigint`, `Medium`: `character varying`, `EventType`: `character varying`, `Message`: `text`, `Severity`: `character varying`, `IncidentKey`: `character varying`, `Resolved`: `boolean`, `Attempts`: `integer`, `LastError`: `text`, `NextAttemptAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`}
+	_                      = bytes.MinRead
+)
+
+func testCommsRetryQueuesUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(commsRetryQueuePrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(commsRetryQueueAllColumns) == len(commsRetryQueuePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueuePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testCommsRetryQueuesSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(commsRetryQueueAllColumns) == len(commsRetryQueuePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &CommsRetryQueue{}
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueueColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, commsRetryQueueDBTypes, true, commsRetryQueuePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(commsRetryQueueAllColumns, commsRetryQueuePrimaryKeyColumns) {
+		fields = commsRetryQueueAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			commsRetryQueueAllColumns,
+			commsRetryQueuePrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := CommsRetryQueueSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testCommsRetryQueuesUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(commsRetryQueueAllColumns) == len(commsRetryQueuePrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := CommsRetryQueue{}
+	if err = randomize.Struct(seed, &o, commsRetryQueueDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CommsRetryQueue: %s", err)
+	}
+
+	count, err := CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, commsRetryQueueDBTypes, false, commsRetryQueuePrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize CommsRetryQueue struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert CommsRetryQueue: %s", err)
+	}
+
+	count, err = CommsRetryQueues().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testFundingPayments(t *testing.T) {
+	t.Parallel()
+
+	query := FundingPayments()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testFundingPaymentsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingPaymentsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := FundingPayments().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingPaymentsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FundingPaymentSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testFundingPaymentsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := FundingPaymentExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if FundingPayment exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected FundingPaymentExists to return true, but got false.")
+	}
+}
+
+func testFundingPaymentsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	fundingPaymentFound, err := FindFundingPayment(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if fundingPaymentFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testFundingPaymentsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = FundingPayments().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingPaymentsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := FundingPayments().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testFundingPaymentsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	fundingPaymentOne := &FundingPayment{}
+	fundingPaymentTwo := &FundingPayment{}
+	if err = randomize.Struct(seed, fundingPaymentOne, fundingPaymentDBTypes, false, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fundingPaymentTwo, fundingPaymentDBTypes, false, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fundingPaymentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fundingPaymentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FundingPayments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testFundingPaymentsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	fundingPaymentOne := &FundingPayment{}
+	fundingPaymentTwo := &FundingPayment{}
+	if err = randomize.Struct(seed, fundingPaymentOne, fundingPaymentDBTypes, false, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+	if err = randomize.Struct(seed, fundingPaymentTwo, fundingPaymentDBTypes, false, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = fundingPaymentOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = fundingPaymentTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func fundingPaymentBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func fundingPaymentAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func fundingPaymentAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func fundingPaymentBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func fundingPaymentAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func fundingPaymentBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func fundingPaymentAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func fundingPaymentBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func fundingPaymentAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *FundingPayment) error {
+	*o = FundingPayment{}
+	return nil
+}
+
+func testFundingPaymentsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &FundingPayment{}
+	o := &FundingPayment{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize FundingPayment object: %s", err)
+	}
+
+	AddFundingPaymentHook(boil.BeforeInsertHook, fundingPaymentBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentBeforeInsertHooks = []FundingPaymentHook{}
+
+	AddFundingPaymentHook(boil.AfterInsertHook, fundingPaymentAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentAfterInsertHooks = []FundingPaymentHook{}
+
+	AddFundingPaymentHook(boil.AfterSelectHook, fundingPaymentAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentAfterSelectHooks = []FundingPaymentHook{}
+
+	AddFundingPaymentHook(boil.BeforeUpdateHook, fundingPaymentBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentBeforeUpdateHooks = []FundingPaymentHook{}
+
+	AddFundingPaymentHook(boil.AfterUpdateHook, fundingPaymentAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentAfterUpdateHooks = []FundingPaymentHook{}
+
+	AddFundingPaymentHook(boil.BeforeDeleteHook, fundingPaymentBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentBeforeDeleteHooks = []FundingPaymentHook{}
+
+	AddFundingPaymentHook(boil.AfterDeleteHook, fundingPaymentAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentAfterDeleteHooks = []FundingPaymentHook{}
+
+	AddFundingPaymentHook(boil.BeforeUpsertHook, fundingPaymentBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentBeforeUpsertHooks = []FundingPaymentHook{}
+
+	AddFundingPaymentHook(boil.AfterUpsertHook, fundingPaymentAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	fundingPaymentAfterUpsertHooks = []FundingPaymentHook{}
+}
+
+func testFundingPaymentsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFundingPaymentsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(fundingPaymentColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testFundingPaymentsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingPaymentsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := FundingPaymentSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testFundingPaymentsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := FundingPayments().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	fundingPaymentDBTypes = map[string]string{`ID`: `bigint`, `Exchange`: `character varying`, `Asset`: `character varying`, `Pair`: `character varying`, `Rate`: `double precision`, `Amount`: `double precision`, `Currency`: `character varying`, `PaidAt`: `timestamp without time zone`, `CreatedAt`: `timestamp without time zone`}
+	_                     = bytes.MinRead
+)
+
+func testFundingPaymentsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(fundingPaymentPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(fundingPaymentAllColumns) == len(fundingPaymentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testFundingPaymentsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(fundingPaymentAllColumns) == len(fundingPaymentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &FundingPayment{}
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, fundingPaymentDBTypes, true, fundingPaymentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(fundingPaymentAllColumns, fundingPaymentPrimaryKeyColumns) {
+		fields = fundingPaymentAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			fundingPaymentAllColumns,
+			fundingPaymentPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := FundingPaymentSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testFundingPaymentsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(fundingPaymentAllColumns) == len(fundingPaymentPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := FundingPayment{}
+	if err = randomize.Struct(seed, &o, fundingPaymentDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FundingPayment: %s", err)
+	}
+
+	count, err := FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, fundingPaymentDBTypes, false, fundingPaymentPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize FundingPayment struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert FundingPayment: %s", err)
+	}
+
+	count, err = FundingPayments().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
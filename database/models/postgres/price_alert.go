@@ -0,0 +1,909 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// PriceAlert is an object representing the database table.
+type PriceAlert struct {
+	ID             int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange       string    `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Asset          string    `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Pair           string    `boil:"pair" json:"pair" toml:"pair" yaml:"pair"`
+	Condition      string    `boil:"condition" json:"condition" toml:"condition" yaml:"condition"`
+	Threshold      float64   `boil:"threshold" json:"threshold" toml:"threshold" yaml:"threshold"`
+	ReferencePrice float64   `boil:"reference_price" json:"reference_price" toml:"reference_price" yaml:"reference_price"`
+	Note           string    `boil:"note" json:"note" toml:"note" yaml:"note"`
+	Active         bool      `boil:"active" json:"active" toml:"active" yaml:"active"`
+	TriggeredPrice float64   `boil:"triggered_price" json:"triggered_price" toml:"triggered_price" yaml:"triggered_price"`
+	CreatedAt      time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *priceAlertR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L priceAlertL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var PriceAlertColumns = struct {
+	ID             string
+	Exchange       string
+	Asset          string
+	Pair           string
+	Condition      string
+	Threshold      string
+	ReferencePrice string
+	Note           string
+	Active         string
+	TriggeredPrice string
+	CreatedAt      string
+}{
+	ID:             "id",
+	Exchange:       "exchange",
+	Asset:          "asset",
+	Pair:           "pair",
+	Condition:      "condition",
+	Threshold:      "threshold",
+	ReferencePrice: "reference_price",
+	Note:           "note",
+	Active:         "active",
+	TriggeredPrice: "triggered_price",
+	CreatedAt:      "created_at",
+}
+
+// Generated where
+
+var PriceAlertWhere = struct {
+	ID             whereHelperint64
+	Exchange       whereHelperstring
+	Asset          whereHelperstring
+	Pair           whereHelperstring
+	Condition      whereHelperstring
+	Threshold      whereHelperfloat64
+	ReferencePrice whereHelperfloat64
+	Note           whereHelperstring
+	Active         whereHelperbool
+	TriggeredPrice whereHelperfloat64
+	CreatedAt      whereHelpertime_Time
+}{
+	ID:             whereHelperint64{field: "\"price_alert\".\"id\""},
+	Exchange:       whereHelperstring{field: "\"price_alert\".\"exchange\""},
+	Asset:          whereHelperstring{field: "\"price_alert\".\"asset\""},
+	Pair:           whereHelperstring{field: "\"price_alert\".\"pair\""},
+	Condition:      whereHelperstring{field: "\"price_alert\".\"condition\""},
+	Threshold:      whereHelperfloat64{field: "\"price_alert\".\"threshold\""},
+	ReferencePrice: whereHelperfloat64{field: "\"price_alert\".\"reference_price\""},
+	Note:           whereHelperstring{field: "\"price_alert\".\"note\""},
+	Active:         whereHelperbool{field: "\"price_alert\".\"active\""},
+	TriggeredPrice: whereHelperfloat64{field: "\"price_alert\".\"triggered_price\""},
+	CreatedAt:      whereHelpertime_Time{field: "\"price_alert\".\"created_at\""},
+}
+
+// PriceAlertRels is where relationship names are stored.
+var PriceAlertRels = struct {
+}{}
+
+// priceAlertR is where relationships are stored.
+type priceAlertR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*priceAlertR) NewStruct() *priceAlertR {
+	return &priceAlertR{}
+}
+
+// priceAlertL is where Load methods for each relationship are stored.
+type priceAlertL struct{}
+
+var (
+	priceAlertAllColumns            = []string{"id", "exchange", "asset", "pair", "condition", "threshold", "reference_price", "note", "active", "triggered_price", "created_at"}
+	priceAlertColumnsWithoutDefault = []string{"exchange", "asset", "pair", "condition", "threshold", "reference_price"}
+	priceAlertColumnsWithDefault    = []string{"id", "note", "active", "triggered_price", "created_at"}
+	priceAlertPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// PriceAlertSlice is an alias for a slice of pointers to PriceAlert.
+	// This should generally be used opposed to []PriceAlert.
+	PriceAlertSlice []*PriceAlert
+	// PriceAlertHook is the signature for custom PriceAlert hook methods
+	PriceAlertHook func(context.Context, boil.ContextExecutor, *PriceAlert) error
+
+	priceAlertQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	priceAlertType                 = reflect.TypeOf(&PriceAlert{})
+	priceAlertMapping              = queries.MakeStructMapping(priceAlertType)
+	priceAlertPrimaryKeyMapping, _ = queries.BindMapping(priceAlertType, priceAlertMapping, priceAlertPrimaryKeyColumns)
+	priceAlertInsertCacheMut       sync.RWMutex
+	priceAlertInsertCache          = make(map[string]insertCache)
+	priceAlertUpdateCacheMut       sync.RWMutex
+	priceAlertUpdateCache          = make(map[string]updateCache)
+	priceAlertUpsertCacheMut       sync.RWMutex
+	priceAlertUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var priceAlertBeforeInsertHooks []PriceAlertHook
+var priceAlertBeforeUpdateHooks []PriceAlertHook
+var priceAlertBeforeDeleteHooks []PriceAlertHook
+var priceAlertBeforeUpsertHooks []PriceAlertHook
+
+var priceAlertAfterInsertHooks []PriceAlertHook
+var priceAlertAfterSelectHooks []PriceAlertHook
+var priceAlertAfterUpdateHooks []PriceAlertHook
+var priceAlertAfterDeleteHooks []PriceAlertHook
+var priceAlertAfterUpsertHooks []PriceAlertHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *PriceAlert) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *PriceAlert) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *PriceAlert) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *PriceAlert) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *PriceAlert) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *PriceAlert) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *PriceAlert) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *PriceAlert) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *PriceAlert) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range priceAlertAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddPriceAlertHook registers your hook function for all future operations.
+func AddPriceAlertHook(hookPoint boil.HookPoint, priceAlertHook PriceAlertHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		priceAlertBeforeInsertHooks = append(priceAlertBeforeInsertHooks, priceAlertHook)
+	case boil.BeforeUpdateHook:
+		priceAlertBeforeUpdateHooks = append(priceAlertBeforeUpdateHooks, priceAlertHook)
+	case boil.BeforeDeleteHook:
+		priceAlertBeforeDeleteHooks = append(priceAlertBeforeDeleteHooks, priceAlertHook)
+	case boil.BeforeUpsertHook:
+		priceAlertBeforeUpsertHooks = append(priceAlertBeforeUpsertHooks, priceAlertHook)
+	case boil.AfterInsertHook:
+		priceAlertAfterInsertHooks = append(priceAlertAfterInsertHooks, priceAlertHook)
+	case boil.AfterSelectHook:
+		priceAlertAfterSelectHooks = append(priceAlertAfterSelectHooks, priceAlertHook)
+	case boil.AfterUpdateHook:
+		priceAlertAfterUpdateHooks = append(priceAlertAfterUpdateHooks, priceAlertHook)
+	case boil.AfterDeleteHook:
+		priceAlertAfterDeleteHooks = append(priceAlertAfterDeleteHooks, priceAlertHook)
+	case boil.AfterUpsertHook:
+		priceAlertAfterUpsertHooks = append(priceAlertAfterUpsertHooks, priceAlertHook)
+	}
+}
+
+// One returns a single priceAlert record from the query.
+func (q priceAlertQuery) One(ctx context.Context, exec boil.ContextExecutor) (*PriceAlert, error) {
+	o := &PriceAlert{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for price_alert")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all PriceAlert records from the query.
+func (q priceAlertQuery) All(ctx context.Context, exec boil.ContextExecutor) (PriceAlertSlice, error) {
+	var o []*PriceAlert
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to PriceAlert slice")
+	}
+
+	if len(priceAlertAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all PriceAlert records in the query.
+func (q priceAlertQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count price_alert rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q priceAlertQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if price_alert exists")
+	}
+
+	return count > 0, nil
+}
+
+// PriceAlerts retrieves all the records using an executor.
+func PriceAlerts(mods ...qm.QueryMod) priceAlertQuery {
+	mods = append(mods, qm.From("\"price_alert\""))
+	return priceAlertQuery{NewQuery(mods...)}
+}
+
+// FindPriceAlert retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindPriceAlert(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*PriceAlert, error) {
+	priceAlertObj := &PriceAlert{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"price_alert\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, priceAlertObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from price_alert")
+	}
+
+	return priceAlertObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *PriceAlert) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no price_alert provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(priceAlertColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	priceAlertInsertCacheMut.RLock()
+	cache, cached := priceAlertInsertCache[key]
+	priceAlertInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			priceAlertAllColumns,
+			priceAlertColumnsWithDefault,
+			priceAlertColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(priceAlertType, priceAlertMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(priceAlertType, priceAlertMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"price_alert\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"price_alert\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into price_alert")
+	}
+
+	if !cached {
+		priceAlertInsertCacheMut.Lock()
+		priceAlertInsertCache[key] = cache
+		priceAlertInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the PriceAlert.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *PriceAlert) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	priceAlertUpdateCacheMut.RLock()
+	cache, cached := priceAlertUpdateCache[key]
+	priceAlertUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			priceAlertAllColumns,
+			priceAlertPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update price_alert, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"price_alert\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, priceAlertPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(priceAlertType, priceAlertMapping, append(wl, priceAlertPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update price_alert row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for price_alert")
+	}
+
+	if !cached {
+		priceAlertUpdateCacheMut.Lock()
+		priceAlertUpdateCache[key] = cache
+		priceAlertUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q priceAlertQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for price_alert")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for price_alert")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o PriceAlertSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceAlertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"price_alert\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, priceAlertPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in priceAlert slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all priceAlert")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *PriceAlert) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no price_alert provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(priceAlertColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	priceAlertUpsertCacheMut.RLock()
+	cache, cached := priceAlertUpsertCache[key]
+	priceAlertUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			priceAlertAllColumns,
+			priceAlertColumnsWithDefault,
+			priceAlertColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			priceAlertAllColumns,
+			priceAlertPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert price_alert, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(priceAlertPrimaryKeyColumns))
+			copy(conflict, priceAlertPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"price_alert\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(priceAlertType, priceAlertMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(priceAlertType, priceAlertMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert price_alert")
+	}
+
+	if !cached {
+		priceAlertUpsertCacheMut.Lock()
+		priceAlertUpsertCache[key] = cache
+		priceAlertUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single PriceAlert record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *PriceAlert) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no PriceAlert provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), priceAlertPrimaryKeyMapping)
+	sql := "DELETE FROM \"price_alert\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from price_alert")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for price_alert")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q priceAlertQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no priceAlertQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from price_alert")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for price_alert")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o PriceAlertSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(priceAlertBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceAlertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"price_alert\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, priceAlertPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from priceAlert slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for price_alert")
+	}
+
+	if len(priceAlertAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *PriceAlert) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindPriceAlert(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *PriceAlertSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := PriceAlertSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), priceAlertPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"price_alert\".* FROM \"price_alert\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, priceAlertPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in PriceAlertSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// PriceAlertExists checks if the PriceAlert row exists.
+func PriceAlertExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"price_alert\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if price_alert exists")
+	}
+
+	return exists, nil
+}
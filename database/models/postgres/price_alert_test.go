@@ -0,0 +1,732 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testPriceAlerts(t *testing.T) {
+	t.Parallel()
+
+	query := PriceAlerts()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testPriceAlertsDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceAlertsQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := PriceAlerts().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceAlertsSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PriceAlertSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testPriceAlertsExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := PriceAlertExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if PriceAlert exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected PriceAlertExists to return true, but got false.")
+	}
+}
+
+func testPriceAlertsFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	priceAlertFound, err := FindPriceAlert(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if priceAlertFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testPriceAlertsBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = PriceAlerts().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceAlertsOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := PriceAlerts().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testPriceAlertsAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	priceAlertOne := &PriceAlert{}
+	priceAlertTwo := &PriceAlert{}
+	if err = randomize.Struct(seed, priceAlertOne, priceAlertDBTypes, false, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+	if err = randomize.Struct(seed, priceAlertTwo, priceAlertDBTypes, false, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = priceAlertOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = priceAlertTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PriceAlerts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testPriceAlertsCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	priceAlertOne := &PriceAlert{}
+	priceAlertTwo := &PriceAlert{}
+	if err = randomize.Struct(seed, priceAlertOne, priceAlertDBTypes, false, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+	if err = randomize.Struct(seed, priceAlertTwo, priceAlertDBTypes, false, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = priceAlertOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = priceAlertTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func priceAlertBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func priceAlertAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func priceAlertAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func priceAlertBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func priceAlertAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func priceAlertBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func priceAlertAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func priceAlertBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func priceAlertAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *PriceAlert) error {
+	*o = PriceAlert{}
+	return nil
+}
+
+func testPriceAlertsHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &PriceAlert{}
+	o := &PriceAlert{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize PriceAlert object: %s", err)
+	}
+
+	AddPriceAlertHook(boil.BeforeInsertHook, priceAlertBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	priceAlertBeforeInsertHooks = []PriceAlertHook{}
+
+	AddPriceAlertHook(boil.AfterInsertHook, priceAlertAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	priceAlertAfterInsertHooks = []PriceAlertHook{}
+
+	AddPriceAlertHook(boil.AfterSelectHook, priceAlertAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	priceAlertAfterSelectHooks = []PriceAlertHook{}
+
+	AddPriceAlertHook(boil.BeforeUpdateHook, priceAlertBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	priceAlertBeforeUpdateHooks = []PriceAlertHook{}
+
+	AddPriceAlertHook(boil.AfterUpdateHook, priceAlertAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	priceAlertAfterUpdateHooks = []PriceAlertHook{}
+
+	AddPriceAlertHook(boil.BeforeDeleteHook, priceAlertBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	priceAlertBeforeDeleteHooks = []PriceAlertHook{}
+
+	AddPriceAlertHook(boil.AfterDeleteHook, priceAlertAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	priceAlertAfterDeleteHooks = []PriceAlertHook{}
+
+	AddPriceAlertHook(boil.BeforeUpsertHook, priceAlertBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	priceAlertBeforeUpsertHooks = []PriceAlertHook{}
+
+	AddPriceAlertHook(boil.AfterUpsertHook, priceAlertAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	priceAlertAfterUpsertHooks = []PriceAlertHook{}
+}
+
+func testPriceAlertsInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPriceAlertsInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(priceAlertColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testPriceAlertsReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceAlertsReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := PriceAlertSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testPriceAlertsSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := PriceAlerts().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	priceAlertDBTypes = map[string]string{`ID`: `bigint`, `Exchange`: `character varying`, `Asset`: `character varying`, `Pair`: `character varying`, `Condition`: `character varying`, `Threshold`: `double precision`, `ReferencePrice`: `double precision`, `Note`: `text`, `Active`: `boolean`, `TriggeredPrice`: `double precision`, `CreatedAt`: `timestamp without time zone`}
+	_                 = bytes.MinRead
+)
+
+func testPriceAlertsUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(priceAlertPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(priceAlertAllColumns) == len(priceAlertPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testPriceAlertsSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(priceAlertAllColumns) == len(priceAlertPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &PriceAlert{}
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, priceAlertDBTypes, true, priceAlertPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(priceAlertAllColumns, priceAlertPrimaryKeyColumns) {
+		fields = priceAlertAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			priceAlertAllColumns,
+			priceAlertPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := PriceAlertSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
+
+func testPriceAlertsUpsert(t *testing.T) {
+	t.Parallel()
+
+	if len(priceAlertAllColumns) == len(priceAlertPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	// Attempt the INSERT side of an UPSERT
+	o := PriceAlert{}
+	if err = randomize.Struct(seed, &o, priceAlertDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Upsert(ctx, tx, false, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PriceAlert: %s", err)
+	}
+
+	count, err := PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	// Attempt the UPDATE side of an UPSERT
+	if err = randomize.Struct(seed, &o, priceAlertDBTypes, false, priceAlertPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize PriceAlert struct: %s", err)
+	}
+
+	if err = o.Upsert(ctx, tx, true, nil, boil.Infer(), boil.Infer()); err != nil {
+		t.Errorf("Unable to upsert PriceAlert: %s", err)
+	}
+
+	count, err = PriceAlerts().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
@@ -7,5 +7,11 @@ import "testing"
 
 func TestUpsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpsert)
+	t.Run("Candles", testCandlesUpsert)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesUpsert)
+	t.Run("FundingPayments", testFundingPaymentsUpsert)
+	t.Run("OpenInterests", testOpenInterestsUpsert)
+	t.Run("PriceAlerts", testPriceAlertsUpsert)
 	t.Run("Scripts", testScriptsUpsert)
+	t.Run("WithdrawalCounters", testWithdrawalCountersUpsert)
 }
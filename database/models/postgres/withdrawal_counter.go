@@ -0,0 +1,884 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// WithdrawalCounter is an object representing the database table.
+type WithdrawalCounter struct {
+	ID        int64     `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Currency  string    `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	Day       string    `boil:"day" json:"day" toml:"day" yaml:"day"`
+	Amount    float64   `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Alerted   bool      `boil:"alerted" json:"alerted" toml:"alerted" yaml:"alerted"`
+	CreatedAt time.Time `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *withdrawalCounterR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L withdrawalCounterL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var WithdrawalCounterColumns = struct {
+	ID        string
+	Currency  string
+	Day       string
+	Amount    string
+	Alerted   string
+	CreatedAt string
+}{
+	ID:        "id",
+	Currency:  "currency",
+	Day:       "day",
+	Amount:    "amount",
+	Alerted:   "alerted",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var WithdrawalCounterWhere = struct {
+	ID        whereHelperint64
+	Currency  whereHelperstring
+	Day       whereHelperstring
+	Amount    whereHelperfloat64
+	Alerted   whereHelperbool
+	CreatedAt whereHelpertime_Time
+}{
+	ID:        whereHelperint64{field: "\"withdrawal_counter\".\"id\""},
+	Currency:  whereHelperstring{field: "\"withdrawal_counter\".\"currency\""},
+	Day:       whereHelperstring{field: "\"withdrawal_counter\".\"day\""},
+	Amount:    whereHelperfloat64{field: "\"withdrawal_counter\".\"amount\""},
+	Alerted:   whereHelperbool{field: "\"withdrawal_counter\".\"alerted\""},
+	CreatedAt: whereHelpertime_Time{field: "\"withdrawal_counter\".\"created_at\""},
+}
+
+// WithdrawalCounterRels is where relationship names are stored.
+var WithdrawalCounterRels = struct {
+}{}
+
+// withdrawalCounterR is where relationships are stored.
+type withdrawalCounterR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*withdrawalCounterR) NewStruct() *withdrawalCounterR {
+	return &withdrawalCounterR{}
+}
+
+// withdrawalCounterL is where Load methods for each relationship are stored.
+type withdrawalCounterL struct{}
+
+var (
+	withdrawalCounterAllColumns            = []string{"id", "currency", "day", "amount", "alerted", "created_at"}
+	withdrawalCounterColumnsWithoutDefault = []string{"currency", "day", "amount"}
+	withdrawalCounterColumnsWithDefault    = []string{"id", "alerted", "created_at"}
+	withdrawalCounterPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// WithdrawalCounterSlice is an alias for a slice of pointers to WithdrawalCounter.
+	// This should generally be used opposed to []WithdrawalCounter.
+	WithdrawalCounterSlice []*WithdrawalCounter
+	// WithdrawalCounterHook is the signature for custom WithdrawalCounter hook methods
+	WithdrawalCounterHook func(context.Context, boil.ContextExecutor, *WithdrawalCounter) error
+
+	withdrawalCounterQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	withdrawalCounterType                 = reflect.TypeOf(&WithdrawalCounter{})
+	withdrawalCounterMapping              = queries.MakeStructMapping(withdrawalCounterType)
+	withdrawalCounterPrimaryKeyMapping, _ = queries.BindMapping(withdrawalCounterType, withdrawalCounterMapping, withdrawalCounterPrimaryKeyColumns)
+	withdrawalCounterInsertCacheMut       sync.RWMutex
+	withdrawalCounterInsertCache          = make(map[string]insertCache)
+	withdrawalCounterUpdateCacheMut       sync.RWMutex
+	withdrawalCounterUpdateCache          = make(map[string]updateCache)
+	withdrawalCounterUpsertCacheMut       sync.RWMutex
+	withdrawalCounterUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var withdrawalCounterBeforeInsertHooks []WithdrawalCounterHook
+var withdrawalCounterBeforeUpdateHooks []WithdrawalCounterHook
+var withdrawalCounterBeforeDeleteHooks []WithdrawalCounterHook
+var withdrawalCounterBeforeUpsertHooks []WithdrawalCounterHook
+
+var withdrawalCounterAfterInsertHooks []WithdrawalCounterHook
+var withdrawalCounterAfterSelectHooks []WithdrawalCounterHook
+var withdrawalCounterAfterUpdateHooks []WithdrawalCounterHook
+var withdrawalCounterAfterDeleteHooks []WithdrawalCounterHook
+var withdrawalCounterAfterUpsertHooks []WithdrawalCounterHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *WithdrawalCounter) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *WithdrawalCounter) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *WithdrawalCounter) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *WithdrawalCounter) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *WithdrawalCounter) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *WithdrawalCounter) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *WithdrawalCounter) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *WithdrawalCounter) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *WithdrawalCounter) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range withdrawalCounterAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddWithdrawalCounterHook registers your hook function for all future operations.
+func AddWithdrawalCounterHook(hookPoint boil.HookPoint, withdrawalCounterHook WithdrawalCounterHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		withdrawalCounterBeforeInsertHooks = append(withdrawalCounterBeforeInsertHooks, withdrawalCounterHook)
+	case boil.BeforeUpdateHook:
+		withdrawalCounterBeforeUpdateHooks = append(withdrawalCounterBeforeUpdateHooks, withdrawalCounterHook)
+	case boil.BeforeDeleteHook:
+		withdrawalCounterBeforeDeleteHooks = append(withdrawalCounterBeforeDeleteHooks, withdrawalCounterHook)
+	case boil.BeforeUpsertHook:
+		withdrawalCounterBeforeUpsertHooks = append(withdrawalCounterBeforeUpsertHooks, withdrawalCounterHook)
+	case boil.AfterInsertHook:
+		withdrawalCounterAfterInsertHooks = append(withdrawalCounterAfterInsertHooks, withdrawalCounterHook)
+	case boil.AfterSelectHook:
+		withdrawalCounterAfterSelectHooks = append(withdrawalCounterAfterSelectHooks, withdrawalCounterHook)
+	case boil.AfterUpdateHook:
+		withdrawalCounterAfterUpdateHooks = append(withdrawalCounterAfterUpdateHooks, withdrawalCounterHook)
+	case boil.AfterDeleteHook:
+		withdrawalCounterAfterDeleteHooks = append(withdrawalCounterAfterDeleteHooks, withdrawalCounterHook)
+	case boil.AfterUpsertHook:
+		withdrawalCounterAfterUpsertHooks = append(withdrawalCounterAfterUpsertHooks, withdrawalCounterHook)
+	}
+}
+
+// One returns a single withdrawalCounter record from the query.
+func (q withdrawalCounterQuery) One(ctx context.Context, exec boil.ContextExecutor) (*WithdrawalCounter, error) {
+	o := &WithdrawalCounter{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: failed to execute a one query for withdrawal_counter")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all WithdrawalCounter records from the query.
+func (q withdrawalCounterQuery) All(ctx context.Context, exec boil.ContextExecutor) (WithdrawalCounterSlice, error) {
+	var o []*WithdrawalCounter
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "postgres: failed to assign all query results to WithdrawalCounter slice")
+	}
+
+	if len(withdrawalCounterAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all WithdrawalCounter records in the query.
+func (q withdrawalCounterQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to count withdrawal_counter rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q withdrawalCounterQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: failed to check if withdrawal_counter exists")
+	}
+
+	return count > 0, nil
+}
+
+// WithdrawalCounters retrieves all the records using an executor.
+func WithdrawalCounters(mods ...qm.QueryMod) withdrawalCounterQuery {
+	mods = append(mods, qm.From("\"withdrawal_counter\""))
+	return withdrawalCounterQuery{NewQuery(mods...)}
+}
+
+// FindWithdrawalCounter retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindWithdrawalCounter(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*WithdrawalCounter, error) {
+	withdrawalCounterObj := &WithdrawalCounter{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"withdrawal_counter\" where \"id\"=$1", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, withdrawalCounterObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "postgres: unable to select from withdrawal_counter")
+	}
+
+	return withdrawalCounterObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *WithdrawalCounter) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no withdrawal_counter provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(withdrawalCounterColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	withdrawalCounterInsertCacheMut.RLock()
+	cache, cached := withdrawalCounterInsertCache[key]
+	withdrawalCounterInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			withdrawalCounterAllColumns,
+			withdrawalCounterColumnsWithDefault,
+			withdrawalCounterColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(withdrawalCounterType, withdrawalCounterMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(withdrawalCounterType, withdrawalCounterMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"withdrawal_counter\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"withdrawal_counter\" %sDEFAULT VALUES%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			queryReturning = fmt.Sprintf(" RETURNING \"%s\"", strings.Join(returnColumns, "\",\""))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to insert into withdrawal_counter")
+	}
+
+	if !cached {
+		withdrawalCounterInsertCacheMut.Lock()
+		withdrawalCounterInsertCache[key] = cache
+		withdrawalCounterInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the WithdrawalCounter.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *WithdrawalCounter) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	withdrawalCounterUpdateCacheMut.RLock()
+	cache, cached := withdrawalCounterUpdateCache[key]
+	withdrawalCounterUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			withdrawalCounterAllColumns,
+			withdrawalCounterPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("postgres: unable to update withdrawal_counter, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"withdrawal_counter\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 1, wl),
+			strmangle.WhereClause("\"", "\"", len(wl)+1, withdrawalCounterPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(withdrawalCounterType, withdrawalCounterMapping, append(wl, withdrawalCounterPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update withdrawal_counter row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by update for withdrawal_counter")
+	}
+
+	if !cached {
+		withdrawalCounterUpdateCacheMut.Lock()
+		withdrawalCounterUpdateCache[key] = cache
+		withdrawalCounterUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q withdrawalCounterQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all for withdrawal_counter")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected for withdrawal_counter")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o WithdrawalCounterSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("postgres: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), withdrawalCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"withdrawal_counter\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 1, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), len(colNames)+1, withdrawalCounterPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to update all in withdrawalCounter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to retrieve rows affected all in update all withdrawalCounter")
+	}
+	return rowsAff, nil
+}
+
+// Upsert attempts an insert using an executor, and does an update or ignore on conflict.
+// See boil.Columns documentation for how to properly use updateColumns and insertColumns.
+func (o *WithdrawalCounter) Upsert(ctx context.Context, exec boil.ContextExecutor, updateOnConflict bool, conflictColumns []string, updateColumns, insertColumns boil.Columns) error {
+	if o == nil {
+		return errors.New("postgres: no withdrawal_counter provided for upsert")
+	}
+
+	if err := o.doBeforeUpsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(withdrawalCounterColumnsWithDefault, o)
+
+	// Build cache key in-line uglily - mysql vs psql problems
+	buf := strmangle.GetBuffer()
+	if updateOnConflict {
+		buf.WriteByte('t')
+	} else {
+		buf.WriteByte('f')
+	}
+	buf.WriteByte('.')
+	for _, c := range conflictColumns {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(updateColumns.Kind))
+	for _, c := range updateColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	buf.WriteString(strconv.Itoa(insertColumns.Kind))
+	for _, c := range insertColumns.Cols {
+		buf.WriteString(c)
+	}
+	buf.WriteByte('.')
+	for _, c := range nzDefaults {
+		buf.WriteString(c)
+	}
+	key := buf.String()
+	strmangle.PutBuffer(buf)
+
+	withdrawalCounterUpsertCacheMut.RLock()
+	cache, cached := withdrawalCounterUpsertCache[key]
+	withdrawalCounterUpsertCacheMut.RUnlock()
+
+	var err error
+
+	if !cached {
+		insert, ret := insertColumns.InsertColumnSet(
+			withdrawalCounterAllColumns,
+			withdrawalCounterColumnsWithDefault,
+			withdrawalCounterColumnsWithoutDefault,
+			nzDefaults,
+		)
+		update := updateColumns.UpdateColumnSet(
+			withdrawalCounterAllColumns,
+			withdrawalCounterPrimaryKeyColumns,
+		)
+
+		if updateOnConflict && len(update) == 0 {
+			return errors.New("postgres: unable to upsert withdrawal_counter, could not build update column list")
+		}
+
+		conflict := conflictColumns
+		if len(conflict) == 0 {
+			conflict = make([]string, len(withdrawalCounterPrimaryKeyColumns))
+			copy(conflict, withdrawalCounterPrimaryKeyColumns)
+		}
+		cache.query = buildUpsertQueryPostgres(dialect, "\"withdrawal_counter\"", updateOnConflict, ret, update, conflict, insert)
+
+		cache.valueMapping, err = queries.BindMapping(withdrawalCounterType, withdrawalCounterMapping, insert)
+		if err != nil {
+			return err
+		}
+		if len(ret) != 0 {
+			cache.retMapping, err = queries.BindMapping(withdrawalCounterType, withdrawalCounterMapping, ret)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+	var returns []interface{}
+	if len(cache.retMapping) != 0 {
+		returns = queries.PtrsFromMapping(value, cache.retMapping)
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	if len(cache.retMapping) != 0 {
+		err = exec.QueryRowContext(ctx, cache.query, vals...).Scan(returns...)
+		if err == sql.ErrNoRows {
+			err = nil // Postgres doesn't return anything when there's no update
+		}
+	} else {
+		_, err = exec.ExecContext(ctx, cache.query, vals...)
+	}
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to upsert withdrawal_counter")
+	}
+
+	if !cached {
+		withdrawalCounterUpsertCacheMut.Lock()
+		withdrawalCounterUpsertCache[key] = cache
+		withdrawalCounterUpsertCacheMut.Unlock()
+	}
+
+	return o.doAfterUpsertHooks(ctx, exec)
+}
+
+// Delete deletes a single WithdrawalCounter record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *WithdrawalCounter) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("postgres: no WithdrawalCounter provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), withdrawalCounterPrimaryKeyMapping)
+	sql := "DELETE FROM \"withdrawal_counter\" WHERE \"id\"=$1"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete from withdrawal_counter")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by delete for withdrawal_counter")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q withdrawalCounterQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("postgres: no withdrawalCounterQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from withdrawal_counter")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for withdrawal_counter")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o WithdrawalCounterSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(withdrawalCounterBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), withdrawalCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"withdrawal_counter\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, withdrawalCounterPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: unable to delete all from withdrawalCounter slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "postgres: failed to get rows affected by deleteall for withdrawal_counter")
+	}
+
+	if len(withdrawalCounterAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *WithdrawalCounter) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindWithdrawalCounter(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *WithdrawalCounterSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := WithdrawalCounterSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), withdrawalCounterPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"withdrawal_counter\".* FROM \"withdrawal_counter\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 1, withdrawalCounterPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "postgres: unable to reload all in WithdrawalCounterSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// WithdrawalCounterExists checks if the WithdrawalCounter row exists.
+func WithdrawalCounterExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"withdrawal_counter\" where \"id\"=$1 limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "postgres: unable to check if withdrawal_counter exists")
+	}
+
+	return exists, nil
+}
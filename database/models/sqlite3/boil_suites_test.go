@@ -13,77 +13,154 @@ import "testing"
 // Separating the tests thusly grants avoidance of Postgres deadlocks.
 func TestParent(t *testing.T) {
 	t.Run("AuditEvents", testAuditEvents)
+	t.Run("Candles", testCandles)
+	t.Run("CommsRetryQueues", testCommsRetryQueues)
+	t.Run("FundingPayments", testFundingPayments)
+	t.Run("OpenInterests", testOpenInterests)
+	t.Run("PriceAlerts", testPriceAlerts)
 	t.Run("Scripts", testScripts)
 	t.Run("ScriptExecutions", testScriptExecutions)
+	t.Run("WithdrawalCounters", testWithdrawalCounters)
 }
 
 func TestDelete(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsDelete)
+	t.Run("Candles", testCandlesDelete)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesDelete)
+	t.Run("FundingPayments", testFundingPaymentsDelete)
+	t.Run("OpenInterests", testOpenInterestsDelete)
+	t.Run("PriceAlerts", testPriceAlertsDelete)
 	t.Run("Scripts", testScriptsDelete)
 	t.Run("ScriptExecutions", testScriptExecutionsDelete)
+	t.Run("WithdrawalCounters", testWithdrawalCountersDelete)
 }
 
 func TestQueryDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsQueryDeleteAll)
+	t.Run("Candles", testCandlesQueryDeleteAll)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesQueryDeleteAll)
+	t.Run("FundingPayments", testFundingPaymentsQueryDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsQueryDeleteAll)
+	t.Run("PriceAlerts", testPriceAlertsQueryDeleteAll)
 	t.Run("Scripts", testScriptsQueryDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsQueryDeleteAll)
+	t.Run("WithdrawalCounters", testWithdrawalCountersQueryDeleteAll)
 }
 
 func TestSliceDeleteAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceDeleteAll)
+	t.Run("Candles", testCandlesSliceDeleteAll)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesSliceDeleteAll)
+	t.Run("FundingPayments", testFundingPaymentsSliceDeleteAll)
+	t.Run("OpenInterests", testOpenInterestsSliceDeleteAll)
+	t.Run("PriceAlerts", testPriceAlertsSliceDeleteAll)
 	t.Run("Scripts", testScriptsSliceDeleteAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceDeleteAll)
+	t.Run("WithdrawalCounters", testWithdrawalCountersSliceDeleteAll)
 }
 
 func TestExists(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsExists)
+	t.Run("Candles", testCandlesExists)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesExists)
+	t.Run("FundingPayments", testFundingPaymentsExists)
+	t.Run("OpenInterests", testOpenInterestsExists)
+	t.Run("PriceAlerts", testPriceAlertsExists)
 	t.Run("Scripts", testScriptsExists)
 	t.Run("ScriptExecutions", testScriptExecutionsExists)
+	t.Run("WithdrawalCounters", testWithdrawalCountersExists)
 }
 
 func TestFind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsFind)
+	t.Run("Candles", testCandlesFind)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesFind)
+	t.Run("FundingPayments", testFundingPaymentsFind)
+	t.Run("OpenInterests", testOpenInterestsFind)
+	t.Run("PriceAlerts", testPriceAlertsFind)
 	t.Run("Scripts", testScriptsFind)
 	t.Run("ScriptExecutions", testScriptExecutionsFind)
+	t.Run("WithdrawalCounters", testWithdrawalCountersFind)
 }
 
 func TestBind(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsBind)
+	t.Run("Candles", testCandlesBind)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesBind)
+	t.Run("FundingPayments", testFundingPaymentsBind)
+	t.Run("OpenInterests", testOpenInterestsBind)
+	t.Run("PriceAlerts", testPriceAlertsBind)
 	t.Run("Scripts", testScriptsBind)
 	t.Run("ScriptExecutions", testScriptExecutionsBind)
+	t.Run("WithdrawalCounters", testWithdrawalCountersBind)
 }
 
 func TestOne(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsOne)
+	t.Run("Candles", testCandlesOne)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesOne)
+	t.Run("FundingPayments", testFundingPaymentsOne)
+	t.Run("OpenInterests", testOpenInterestsOne)
+	t.Run("PriceAlerts", testPriceAlertsOne)
 	t.Run("Scripts", testScriptsOne)
 	t.Run("ScriptExecutions", testScriptExecutionsOne)
+	t.Run("WithdrawalCounters", testWithdrawalCountersOne)
 }
 
 func TestAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsAll)
+	t.Run("Candles", testCandlesAll)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesAll)
+	t.Run("FundingPayments", testFundingPaymentsAll)
+	t.Run("OpenInterests", testOpenInterestsAll)
+	t.Run("PriceAlerts", testPriceAlertsAll)
 	t.Run("Scripts", testScriptsAll)
 	t.Run("ScriptExecutions", testScriptExecutionsAll)
+	t.Run("WithdrawalCounters", testWithdrawalCountersAll)
 }
 
 func TestCount(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsCount)
+	t.Run("Candles", testCandlesCount)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesCount)
+	t.Run("FundingPayments", testFundingPaymentsCount)
+	t.Run("OpenInterests", testOpenInterestsCount)
+	t.Run("PriceAlerts", testPriceAlertsCount)
 	t.Run("Scripts", testScriptsCount)
 	t.Run("ScriptExecutions", testScriptExecutionsCount)
+	t.Run("WithdrawalCounters", testWithdrawalCountersCount)
 }
 
 func TestHooks(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsHooks)
+	t.Run("Candles", testCandlesHooks)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesHooks)
+	t.Run("FundingPayments", testFundingPaymentsHooks)
+	t.Run("OpenInterests", testOpenInterestsHooks)
+	t.Run("PriceAlerts", testPriceAlertsHooks)
 	t.Run("Scripts", testScriptsHooks)
 	t.Run("ScriptExecutions", testScriptExecutionsHooks)
+	t.Run("WithdrawalCounters", testWithdrawalCountersHooks)
 }
 
 func TestInsert(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsInsert)
+	t.Run("Candles", testCandlesInsert)
 	t.Run("AuditEvents", testAuditEventsInsertWhitelist)
+	t.Run("Candles", testCandlesInsertWhitelist)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesInsert)
+	t.Run("FundingPayments", testFundingPaymentsInsert)
+	t.Run("OpenInterests", testOpenInterestsInsert)
+	t.Run("PriceAlerts", testPriceAlertsInsert)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesInsertWhitelist)
+	t.Run("FundingPayments", testFundingPaymentsInsertWhitelist)
+	t.Run("OpenInterests", testOpenInterestsInsertWhitelist)
+	t.Run("PriceAlerts", testPriceAlertsInsertWhitelist)
 	t.Run("Scripts", testScriptsInsert)
 	t.Run("Scripts", testScriptsInsertWhitelist)
 	t.Run("ScriptExecutions", testScriptExecutionsInsert)
 	t.Run("ScriptExecutions", testScriptExecutionsInsertWhitelist)
+	t.Run("WithdrawalCounters", testWithdrawalCountersInsertWhitelist)
 }
 
 // TestToOne tests cannot be run in parallel
@@ -136,30 +213,60 @@ func TestToManyRemove(t *testing.T) {}
 
 func TestReload(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReload)
+	t.Run("Candles", testCandlesReload)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesReload)
+	t.Run("FundingPayments", testFundingPaymentsReload)
+	t.Run("OpenInterests", testOpenInterestsReload)
+	t.Run("PriceAlerts", testPriceAlertsReload)
 	t.Run("Scripts", testScriptsReload)
 	t.Run("ScriptExecutions", testScriptExecutionsReload)
+	t.Run("WithdrawalCounters", testWithdrawalCountersReload)
 }
 
 func TestReloadAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsReloadAll)
+	t.Run("Candles", testCandlesReloadAll)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesReloadAll)
+	t.Run("FundingPayments", testFundingPaymentsReloadAll)
+	t.Run("OpenInterests", testOpenInterestsReloadAll)
+	t.Run("PriceAlerts", testPriceAlertsReloadAll)
 	t.Run("Scripts", testScriptsReloadAll)
 	t.Run("ScriptExecutions", testScriptExecutionsReloadAll)
+	t.Run("WithdrawalCounters", testWithdrawalCountersReloadAll)
 }
 
 func TestSelect(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSelect)
+	t.Run("Candles", testCandlesSelect)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesSelect)
+	t.Run("FundingPayments", testFundingPaymentsSelect)
+	t.Run("OpenInterests", testOpenInterestsSelect)
+	t.Run("PriceAlerts", testPriceAlertsSelect)
 	t.Run("Scripts", testScriptsSelect)
 	t.Run("ScriptExecutions", testScriptExecutionsSelect)
+	t.Run("WithdrawalCounters", testWithdrawalCountersSelect)
 }
 
 func TestUpdate(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsUpdate)
+	t.Run("Candles", testCandlesUpdate)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesUpdate)
+	t.Run("FundingPayments", testFundingPaymentsUpdate)
+	t.Run("OpenInterests", testOpenInterestsUpdate)
+	t.Run("PriceAlerts", testPriceAlertsUpdate)
 	t.Run("Scripts", testScriptsUpdate)
 	t.Run("ScriptExecutions", testScriptExecutionsUpdate)
+	t.Run("WithdrawalCounters", testWithdrawalCountersUpdate)
 }
 
 func TestSliceUpdateAll(t *testing.T) {
 	t.Run("AuditEvents", testAuditEventsSliceUpdateAll)
+	t.Run("Candles", testCandlesSliceUpdateAll)
+	t.Run("CommsRetryQueues", testCommsRetryQueuesSliceUpdateAll)
+	t.Run("FundingPayments", testFundingPaymentsSliceUpdateAll)
+	t.Run("OpenInterests", testOpenInterestsSliceUpdateAll)
+	t.Run("PriceAlerts", testPriceAlertsSliceUpdateAll)
 	t.Run("Scripts", testScriptsSliceUpdateAll)
 	t.Run("ScriptExecutions", testScriptExecutionsSliceUpdateAll)
+	t.Run("WithdrawalCounters", testWithdrawalCountersSliceUpdateAll)
 }
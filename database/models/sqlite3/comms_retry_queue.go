@@ -0,0 +1,830 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// CommsRetryQueue is an object representing the database table.
+type CommsRetryQueue struct {
+	ID            int64  `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Medium        string `boil:"medium" json:"medium" toml:"medium" yaml:"medium"`
+	EventType     string `boil:"event_type" json:"event_type" toml:"event_type" yaml:"event_type"`
+	Message       string `boil:"message" json:"message" toml:"message" yaml:"message"`
+	Severity      string `boil:"severity" json:"severity" toml:"severity" yaml:"severity"`
+	IncidentKey   string `boil:"incident_key" json:"incident_key" toml:"incident_key" yaml:"incident_key"`
+	Resolved      bool   `boil:"resolved" json:"resolved" toml:"resolved" yaml:"resolved"`
+	Attempts      int64  `boil:"attempts" json:"attempts" toml:"attempts" yaml:"attempts"`
+	LastError     string `boil:"last_error" json:"last_error" toml:"last_error" yaml:"last_error"`
+	NextAttemptAt string `boil:"next_attempt_at" json:"next_attempt_at" toml:"next_attempt_at" yaml:"next_attempt_at"`
+	CreatedAt     string `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *commsRetryQueueR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L commsRetryQueueL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var CommsRetryQueueColumns = struct {
+	ID            string
+	Medium        string
+	EventType     string
+	Message       string
+	Severity      string
+	IncidentKey   string
+	Resolved      string
+	Attempts      string
+	LastError     string
+	NextAttemptAt string
+	CreatedAt     string
+}{
+	ID:            "id",
+	Medium:        "medium",
+	EventType:     "event_type",
+	Message:       "message",
+	Severity:      "severity",
+	IncidentKey:   "incident_key",
+	Resolved:      "resolved",
+	Attempts:      "attempts",
+	LastError:     "last_error",
+	NextAttemptAt: "next_attempt_at",
+	CreatedAt:     "created_at",
+}
+
+// Generated where
+
+type whereHelperbool struct{ field string }
+
+func (w whereHelperbool) EQ(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.EQ, x) }
+func (w whereHelperbool) NEQ(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.NEQ, x) }
+func (w whereHelperbool) LT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.LT, x) }
+func (w whereHelperbool) LTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.LTE, x) }
+func (w whereHelperbool) GT(x bool) qm.QueryMod  { return qmhelper.Where(w.field, qmhelper.GT, x) }
+func (w whereHelperbool) GTE(x bool) qm.QueryMod { return qmhelper.Where(w.field, qmhelper.GTE, x) }
+
+var CommsRetryQueueWhere = struct {
+	ID            whereHelperint64
+	Medium        whereHelperstring
+	EventType     whereHelperstring
+	Message       whereHelperstring
+	Severity      whereHelperstring
+	IncidentKey   whereHelperstring
+	Resolved      whereHelperbool
+	Attempts      whereHelperint64
+	LastError     whereHelperstring
+	NextAttemptAt whereHelperstring
+	CreatedAt     whereHelperstring
+}{
+	ID:            whereHelperint64{field: "\"comms_retry_queue\".\"id\""},
+	Medium:        whereHelperstring{field: "\"comms_retry_queue\".\"medium\""},
+	EventType:     whereHelperstring{field: "\"comms_retry_queue\".\"event_type\""},
+	Message:       whereHelperstring{field: "\"comms_retry_queue\".\"message\""},
+	Severity:      whereHelperstring{field: "\"comms_retry_queue\".\"severity\""},
+	IncidentKey:   whereHelperstring{field: "\"comms_retry_queue\".\"incident_key\""},
+	Resolved:      whereHelperbool{field: "\"comms_retry_queue\".\"resolved\""},
+	Attempts:      whereHelperint64{field: "\"comms_retry_queue\".\"attempts\""},
+	LastError:     whereHelperstring{field: "\"comms_retry_queue\".\"last_error\""},
+	NextAttemptAt: whereHelperstring{field: "\"comms_retry_queue\".\"next_attempt_at\""},
+	CreatedAt:     whereHelperstring{field: "\"comms_retry_queue\".\"created_at\""},
+}
+
+// CommsRetryQueueRels is where relationship names are stored.
+var CommsRetryQueueRels = struct {
+}{}
+
+// commsRetryQueueR is where relationships are stored.
+type commsRetryQueueR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*commsRetryQueueR) NewStruct() *commsRetryQueueR {
+	return &commsRetryQueueR{}
+}
+
+// commsRetryQueueL is where Load methods for each relationship are stored.
+type commsRetryQueueL struct{}
+
+var (
+	commsRetryQueueAllColumns            = []string{"id", "medium", "event_type", "message", "severity", "incident_key", "resolved", "attempts", "last_error", "next_attempt_at", "created_at"}
+	commsRetryQueueColumnsWithoutDefault = []string{"medium", "event_type", "message", "severity", "next_attempt_at"}
+	commsRetryQueueColumnsWithDefault    = []string{"id", "incident_key", "resolved", "attempts", "last_error", "created_at"}
+	commsRetryQueuePrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// CommsRetryQueueSlice is an alias for a slice of pointers to CommsRetryQueue.
+	// This should generally be used opposed to []CommsRetryQueue.
+	CommsRetryQueueSlice []*CommsRetryQueue
+	// CommsRetryQueueHook is the signature for custom CommsRetryQueue hook methods
+	CommsRetryQueueHook func(context.Context, boil.ContextExecutor, *CommsRetryQueue) error
+
+	commsRetryQueueQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	commsRetryQueueType                 = reflect.TypeOf(&CommsRetryQueue{})
+	commsRetryQueueMapping              = queries.MakeStructMapping(commsRetryQueueType)
+	commsRetryQueuePrimaryKeyMapping, _ = queries.BindMapping(commsRetryQueueType, commsRetryQueueMapping, commsRetryQueuePrimaryKeyColumns)
+	commsRetryQueueInsertCacheMut       sync.RWMutex
+	commsRetryQueueInsertCache          = make(map[string]insertCache)
+	commsRetryQueueUpdateCacheMut       sync.RWMutex
+	commsRetryQueueUpdateCache          = make(map[string]updateCache)
+	commsRetryQueueUpsertCacheMut       sync.RWMutex
+	commsRetryQueueUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var commsRetryQueueBeforeInsertHooks []CommsRetryQueueHook
+var commsRetryQueueBeforeUpdateHooks []CommsRetryQueueHook
+var commsRetryQueueBeforeDeleteHooks []CommsRetryQueueHook
+var commsRetryQueueBeforeUpsertHooks []CommsRetryQueueHook
+
+var commsRetryQueueAfterInsertHooks []CommsRetryQueueHook
+var commsRetryQueueAfterSelectHooks []CommsRetryQueueHook
+var commsRetryQueueAfterUpdateHooks []CommsRetryQueueHook
+var commsRetryQueueAfterDeleteHooks []CommsRetryQueueHook
+var commsRetryQueueAfterUpsertHooks []CommsRetryQueueHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *CommsRetryQueue) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *CommsRetryQueue) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *CommsRetryQueue) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *CommsRetryQueue) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *CommsRetryQueue) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *CommsRetryQueue) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *CommsRetryQueue) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *CommsRetryQueue) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *CommsRetryQueue) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range commsRetryQueueAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddCommsRetryQueueHook registers your hook function for all future operations.
+func AddCommsRetryQueueHook(hookPoint boil.HookPoint, commsRetryQueueHook CommsRetryQueueHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		commsRetryQueueBeforeInsertHooks = append(commsRetryQueueBeforeInsertHooks, commsRetryQueueHook)
+	case boil.BeforeUpdateHook:
+		commsRetryQueueBeforeUpdateHooks = append(commsRetryQueueBeforeUpdateHooks, commsRetryQueueHook)
+	case boil.BeforeDeleteHook:
+		commsRetryQueueBeforeDeleteHooks = append(commsRetryQueueBeforeDeleteHooks, commsRetryQueueHook)
+	case boil.BeforeUpsertHook:
+		commsRetryQueueBeforeUpsertHooks = append(commsRetryQueueBeforeUpsertHooks, commsRetryQueueHook)
+	case boil.AfterInsertHook:
+		commsRetryQueueAfterInsertHooks = append(commsRetryQueueAfterInsertHooks, commsRetryQueueHook)
+	case boil.AfterSelectHook:
+		commsRetryQueueAfterSelectHooks = append(commsRetryQueueAfterSelectHooks, commsRetryQueueHook)
+	case boil.AfterUpdateHook:
+		commsRetryQueueAfterUpdateHooks = append(commsRetryQueueAfterUpdateHooks, commsRetryQueueHook)
+	case boil.AfterDeleteHook:
+		commsRetryQueueAfterDeleteHooks = append(commsRetryQueueAfterDeleteHooks, commsRetryQueueHook)
+	case boil.AfterUpsertHook:
+		commsRetryQueueAfterUpsertHooks = append(commsRetryQueueAfterUpsertHooks, commsRetryQueueHook)
+	}
+}
+
+// One returns a single commsRetryQueue record from the query.
+func (q commsRetryQueueQuery) One(ctx context.Context, exec boil.ContextExecutor) (*CommsRetryQueue, error) {
+	o := &CommsRetryQueue{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for comms_retry_queue")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all CommsRetryQueue records from the query.
+func (q commsRetryQueueQuery) All(ctx context.Context, exec boil.ContextExecutor) (CommsRetryQueueSlice, error) {
+	var o []*CommsRetryQueue
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to CommsRetryQueue slice")
+	}
+
+	if len(commsRetryQueueAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all CommsRetryQueue records in the query.
+func (q commsRetryQueueQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count comms_retry_queue rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q commsRetryQueueQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if comms_retry_queue exists")
+	}
+
+	return count > 0, nil
+}
+
+// CommsRetryQueues retrieves all the records using an executor.
+func CommsRetryQueues(mods ...qm.QueryMod) commsRetryQueueQuery {
+	mods = append(mods, qm.From("\"comms_retry_queue\""))
+	return commsRetryQueueQuery{NewQuery(mods...)}
+}
+
+// FindCommsRetryQueue retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindCommsRetryQueue(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*CommsRetryQueue, error) {
+	commsRetryQueueObj := &CommsRetryQueue{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"comms_retry_queue\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, commsRetryQueueObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from comms_retry_queue")
+	}
+
+	return commsRetryQueueObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *CommsRetryQueue) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no comms_retry_queue provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(commsRetryQueueColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	commsRetryQueueInsertCacheMut.RLock()
+	cache, cached := commsRetryQueueInsertCache[key]
+	commsRetryQueueInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			commsRetryQueueAllColumns,
+			commsRetryQueueColumnsWithDefault,
+			commsRetryQueueColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(commsRetryQueueType, commsRetryQueueMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(commsRetryQueueType, commsRetryQueueMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"comms_retry_queue\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"comms_retry_queue\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"comms_retry_queue\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, commsRetryQueuePrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into comms_retry_queue")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == commsRetryQueueMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for comms_retry_queue")
+	}
+
+CacheNoHooks:
+	if !cached {
+		commsRetryQueueInsertCacheMut.Lock()
+		commsRetryQueueInsertCache[key] = cache
+		commsRetryQueueInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the CommsRetryQueue.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *CommsRetryQueue) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	commsRetryQueueUpdateCacheMut.RLock()
+	cache, cached := commsRetryQueueUpdateCache[key]
+	commsRetryQueueUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			commsRetryQueueAllColumns,
+			commsRetryQueuePrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update comms_retry_queue, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"comms_retry_queue\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, commsRetryQueuePrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(commsRetryQueueType, commsRetryQueueMapping, append(wl, commsRetryQueuePrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update comms_retry_queue row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for comms_retry_queue")
+	}
+
+	if !cached {
+		commsRetryQueueUpdateCacheMut.Lock()
+		commsRetryQueueUpdateCache[key] = cache
+		commsRetryQueueUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q commsRetryQueueQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for comms_retry_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for comms_retry_queue")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o CommsRetryQueueSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), commsRetryQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"comms_retry_queue\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, commsRetryQueuePrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in commsRetryQueue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all commsRetryQueue")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single CommsRetryQueue record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *CommsRetryQueue) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no CommsRetryQueue provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), commsRetryQueuePrimaryKeyMapping)
+	sql := "DELETE FROM \"comms_retry_queue\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from comms_retry_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for comms_retry_queue")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q commsRetryQueueQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no commsRetryQueueQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from comms_retry_queue")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for comms_retry_queue")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o CommsRetryQueueSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(commsRetryQueueBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), commsRetryQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"comms_retry_queue\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, commsRetryQueuePrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from commsRetryQueue slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for comms_retry_queue")
+	}
+
+	if len(commsRetryQueueAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *CommsRetryQueue) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindCommsRetryQueue(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *CommsRetryQueueSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := CommsRetryQueueSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), commsRetryQueuePrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"comms_retry_queue\".* FROM \"comms_retry_queue\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, commsRetryQueuePrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in CommsRetryQueueSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// CommsRetryQueueExists checks if the CommsRetryQueue row exists.
+func CommsRetryQueueExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"comms_retry_queue\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if comms_retry_queue exists")
+	}
+
+	return exists, nil
+}
@@ -0,0 +1,811 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+	"github.com/thrasher-corp/sqlboiler/queries/qmhelper"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+// FundingPayment is an object representing the database table.
+type FundingPayment struct {
+	ID        int64   `boil:"id" json:"id" toml:"id" yaml:"id"`
+	Exchange  string  `boil:"exchange" json:"exchange" toml:"exchange" yaml:"exchange"`
+	Asset     string  `boil:"asset" json:"asset" toml:"asset" yaml:"asset"`
+	Pair      string  `boil:"pair" json:"pair" toml:"pair" yaml:"pair"`
+	Rate      float64 `boil:"rate" json:"rate" toml:"rate" yaml:"rate"`
+	Amount    float64 `boil:"amount" json:"amount" toml:"amount" yaml:"amount"`
+	Currency  string  `boil:"currency" json:"currency" toml:"currency" yaml:"currency"`
+	PaidAt    string  `boil:"paid_at" json:"paid_at" toml:"paid_at" yaml:"paid_at"`
+	CreatedAt string  `boil:"created_at" json:"created_at" toml:"created_at" yaml:"created_at"`
+
+	R *fundingPaymentR `boil:"-" json:"-" toml:"-" yaml:"-"`
+	L fundingPaymentL  `boil:"-" json:"-" toml:"-" yaml:"-"`
+}
+
+var FundingPaymentColumns = struct {
+	ID        string
+	Exchange  string
+	Asset     string
+	Pair      string
+	Rate      string
+	Amount    string
+	Currency  string
+	PaidAt    string
+	CreatedAt string
+}{
+	ID:        "id",
+	Exchange:  "exchange",
+	Asset:     "asset",
+	Pair:      "pair",
+	Rate:      "rate",
+	Amount:    "amount",
+	Currency:  "currency",
+	PaidAt:    "paid_at",
+	CreatedAt: "created_at",
+}
+
+// Generated where
+
+var FundingPaymentWhere = struct {
+	ID        whereHelperint64
+	Exchange  whereHelperstring
+	Asset     whereHelperstring
+	Pair      whereHelperstring
+	Rate      whereHelperfloat64
+	Amount    whereHelperfloat64
+	Currency  whereHelperstring
+	PaidAt    whereHelperstring
+	CreatedAt whereHelperstring
+}{
+	ID:        whereHelperint64{field: "\"funding_payment\".\"id\""},
+	Exchange:  whereHelperstring{field: "\"funding_payment\".\"exchange\""},
+	Asset:     whereHelperstring{field: "\"funding_payment\".\"asset\""},
+	Pair:      whereHelperstring{field: "\"funding_payment\".\"pair\""},
+	Rate:      whereHelperfloat64{field: "\"funding_payment\".\"rate\""},
+	Amount:    whereHelperfloat64{field: "\"funding_payment\".\"amount\""},
+	Currency:  whereHelperstring{field: "\"funding_payment\".\"currency\""},
+	PaidAt:    whereHelperstring{field: "\"funding_payment\".\"paid_at\""},
+	CreatedAt: whereHelperstring{field: "\"funding_payment\".\"created_at\""},
+}
+
+// FundingPaymentRels is where relationship names are stored.
+var FundingPaymentRels = struct {
+}{}
+
+// fundingPaymentR is where relationships are stored.
+type fundingPaymentR struct {
+}
+
+// NewStruct creates a new relationship struct
+func (*fundingPaymentR) NewStruct() *fundingPaymentR {
+	return &fundingPaymentR{}
+}
+
+// fundingPaymentL is where Load methods for each relationship are stored.
+type fundingPaymentL struct{}
+
+var (
+	fundingPaymentAllColumns            = []string{"id", "exchange", "asset", "pair", "rate", "amount", "currency", "paid_at", "created_at"}
+	fundingPaymentColumnsWithoutDefault = []string{"exchange", "asset", "pair", "rate", "amount", "currency", "paid_at"}
+	fundingPaymentColumnsWithDefault    = []string{"id", "created_at"}
+	fundingPaymentPrimaryKeyColumns     = []string{"id"}
+)
+
+type (
+	// FundingPaymentSlice is an alias for a slice of pointers to FundingPayment.
+	// This should generally be used opposed to []FundingPayment.
+	FundingPaymentSlice []*FundingPayment
+	// FundingPaymentHook is the signature for custom FundingPayment hook methods
+	FundingPaymentHook func(context.Context, boil.ContextExecutor, *FundingPayment) error
+
+	fundingPaymentQuery struct {
+		*queries.Query
+	}
+)
+
+// Cache for insert, update and upsert
+var (
+	fundingPaymentType                 = reflect.TypeOf(&FundingPayment{})
+	fundingPaymentMapping              = queries.MakeStructMapping(fundingPaymentType)
+	fundingPaymentPrimaryKeyMapping, _ = queries.BindMapping(fundingPaymentType, fundingPaymentMapping, fundingPaymentPrimaryKeyColumns)
+	fundingPaymentInsertCacheMut       sync.RWMutex
+	fundingPaymentInsertCache          = make(map[string]insertCache)
+	fundingPaymentUpdateCacheMut       sync.RWMutex
+	fundingPaymentUpdateCache          = make(map[string]updateCache)
+	fundingPaymentUpsertCacheMut       sync.RWMutex
+	fundingPaymentUpsertCache          = make(map[string]insertCache)
+)
+
+var (
+	// Force time package dependency for automated UpdatedAt/CreatedAt.
+	_ = time.Second
+	// Force qmhelper dependency for where clause generation (which doesn't
+	// always happen)
+	_ = qmhelper.Where
+)
+
+var fundingPaymentBeforeInsertHooks []FundingPaymentHook
+var fundingPaymentBeforeUpdateHooks []FundingPaymentHook
+var fundingPaymentBeforeDeleteHooks []FundingPaymentHook
+var fundingPaymentBeforeUpsertHooks []FundingPaymentHook
+
+var fundingPaymentAfterInsertHooks []FundingPaymentHook
+var fundingPaymentAfterSelectHooks []FundingPaymentHook
+var fundingPaymentAfterUpdateHooks []FundingPaymentHook
+var fundingPaymentAfterDeleteHooks []FundingPaymentHook
+var fundingPaymentAfterUpsertHooks []FundingPaymentHook
+
+// doBeforeInsertHooks executes all "before insert" hooks.
+func (o *FundingPayment) doBeforeInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentBeforeInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpdateHooks executes all "before Update" hooks.
+func (o *FundingPayment) doBeforeUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentBeforeUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeDeleteHooks executes all "before Delete" hooks.
+func (o *FundingPayment) doBeforeDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentBeforeDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doBeforeUpsertHooks executes all "before Upsert" hooks.
+func (o *FundingPayment) doBeforeUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentBeforeUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterInsertHooks executes all "after Insert" hooks.
+func (o *FundingPayment) doAfterInsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentAfterInsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterSelectHooks executes all "after Select" hooks.
+func (o *FundingPayment) doAfterSelectHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentAfterSelectHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpdateHooks executes all "after Update" hooks.
+func (o *FundingPayment) doAfterUpdateHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentAfterUpdateHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterDeleteHooks executes all "after Delete" hooks.
+func (o *FundingPayment) doAfterDeleteHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentAfterDeleteHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// doAfterUpsertHooks executes all "after Upsert" hooks.
+func (o *FundingPayment) doAfterUpsertHooks(ctx context.Context, exec boil.ContextExecutor) (err error) {
+	if boil.HooksAreSkipped(ctx) {
+		return nil
+	}
+
+	for _, hook := range fundingPaymentAfterUpsertHooks {
+		if err := hook(ctx, exec, o); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// AddFundingPaymentHook registers your hook function for all future operations.
+func AddFundingPaymentHook(hookPoint boil.HookPoint, fundingPaymentHook FundingPaymentHook) {
+	switch hookPoint {
+	case boil.BeforeInsertHook:
+		fundingPaymentBeforeInsertHooks = append(fundingPaymentBeforeInsertHooks, fundingPaymentHook)
+	case boil.BeforeUpdateHook:
+		fundingPaymentBeforeUpdateHooks = append(fundingPaymentBeforeUpdateHooks, fundingPaymentHook)
+	case boil.BeforeDeleteHook:
+		fundingPaymentBeforeDeleteHooks = append(fundingPaymentBeforeDeleteHooks, fundingPaymentHook)
+	case boil.BeforeUpsertHook:
+		fundingPaymentBeforeUpsertHooks = append(fundingPaymentBeforeUpsertHooks, fundingPaymentHook)
+	case boil.AfterInsertHook:
+		fundingPaymentAfterInsertHooks = append(fundingPaymentAfterInsertHooks, fundingPaymentHook)
+	case boil.AfterSelectHook:
+		fundingPaymentAfterSelectHooks = append(fundingPaymentAfterSelectHooks, fundingPaymentHook)
+	case boil.AfterUpdateHook:
+		fundingPaymentAfterUpdateHooks = append(fundingPaymentAfterUpdateHooks, fundingPaymentHook)
+	case boil.AfterDeleteHook:
+		fundingPaymentAfterDeleteHooks = append(fundingPaymentAfterDeleteHooks, fundingPaymentHook)
+	case boil.AfterUpsertHook:
+		fundingPaymentAfterUpsertHooks = append(fundingPaymentAfterUpsertHooks, fundingPaymentHook)
+	}
+}
+
+// One returns a single fundingPayment record from the query.
+func (q fundingPaymentQuery) One(ctx context.Context, exec boil.ContextExecutor) (*FundingPayment, error) {
+	o := &FundingPayment{}
+
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Bind(ctx, exec, o)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: failed to execute a one query for funding_payment")
+	}
+
+	if err := o.doAfterSelectHooks(ctx, exec); err != nil {
+		return o, err
+	}
+
+	return o, nil
+}
+
+// All returns all FundingPayment records from the query.
+func (q fundingPaymentQuery) All(ctx context.Context, exec boil.ContextExecutor) (FundingPaymentSlice, error) {
+	var o []*FundingPayment
+
+	err := q.Bind(ctx, exec, &o)
+	if err != nil {
+		return nil, errors.Wrap(err, "sqlite3: failed to assign all query results to FundingPayment slice")
+	}
+
+	if len(fundingPaymentAfterSelectHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterSelectHooks(ctx, exec); err != nil {
+				return o, err
+			}
+		}
+	}
+
+	return o, nil
+}
+
+// Count returns the count of all FundingPayment records in the query.
+func (q fundingPaymentQuery) Count(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to count funding_payment rows")
+	}
+
+	return count, nil
+}
+
+// Exists checks if the row exists in the table.
+func (q fundingPaymentQuery) Exists(ctx context.Context, exec boil.ContextExecutor) (bool, error) {
+	var count int64
+
+	queries.SetSelect(q.Query, nil)
+	queries.SetCount(q.Query)
+	queries.SetLimit(q.Query, 1)
+
+	err := q.Query.QueryRowContext(ctx, exec).Scan(&count)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: failed to check if funding_payment exists")
+	}
+
+	return count > 0, nil
+}
+
+// FundingPayments retrieves all the records using an executor.
+func FundingPayments(mods ...qm.QueryMod) fundingPaymentQuery {
+	mods = append(mods, qm.From("\"funding_payment\""))
+	return fundingPaymentQuery{NewQuery(mods...)}
+}
+
+// FindFundingPayment retrieves a single record by ID with an executor.
+// If selectCols is empty Find will return all columns.
+func FindFundingPayment(ctx context.Context, exec boil.ContextExecutor, iD int64, selectCols ...string) (*FundingPayment, error) {
+	fundingPaymentObj := &FundingPayment{}
+
+	sel := "*"
+	if len(selectCols) > 0 {
+		sel = strings.Join(strmangle.IdentQuoteSlice(dialect.LQ, dialect.RQ, selectCols), ",")
+	}
+	query := fmt.Sprintf(
+		"select %s from \"funding_payment\" where \"id\"=?", sel,
+	)
+
+	q := queries.Raw(query, iD)
+
+	err := q.Bind(ctx, exec, fundingPaymentObj)
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			return nil, sql.ErrNoRows
+		}
+		return nil, errors.Wrap(err, "sqlite3: unable to select from funding_payment")
+	}
+
+	return fundingPaymentObj, nil
+}
+
+// Insert a single record using an executor.
+// See boil.Columns.InsertColumnSet documentation to understand column list inference for inserts.
+func (o *FundingPayment) Insert(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) error {
+	if o == nil {
+		return errors.New("sqlite3: no funding_payment provided for insertion")
+	}
+
+	var err error
+
+	if err := o.doBeforeInsertHooks(ctx, exec); err != nil {
+		return err
+	}
+
+	nzDefaults := queries.NonZeroDefaultSet(fundingPaymentColumnsWithDefault, o)
+
+	key := makeCacheKey(columns, nzDefaults)
+	fundingPaymentInsertCacheMut.RLock()
+	cache, cached := fundingPaymentInsertCache[key]
+	fundingPaymentInsertCacheMut.RUnlock()
+
+	if !cached {
+		wl, returnColumns := columns.InsertColumnSet(
+			fundingPaymentAllColumns,
+			fundingPaymentColumnsWithDefault,
+			fundingPaymentColumnsWithoutDefault,
+			nzDefaults,
+		)
+
+		cache.valueMapping, err = queries.BindMapping(fundingPaymentType, fundingPaymentMapping, wl)
+		if err != nil {
+			return err
+		}
+		cache.retMapping, err = queries.BindMapping(fundingPaymentType, fundingPaymentMapping, returnColumns)
+		if err != nil {
+			return err
+		}
+		if len(wl) != 0 {
+			cache.query = fmt.Sprintf("INSERT INTO \"funding_payment\" (\"%s\") %%sVALUES (%s)%%s", strings.Join(wl, "\",\""), strmangle.Placeholders(dialect.UseIndexPlaceholders, len(wl), 1, 1))
+		} else {
+			cache.query = "INSERT INTO \"funding_payment\" () VALUES ()%s%s"
+		}
+
+		var queryOutput, queryReturning string
+
+		if len(cache.retMapping) != 0 {
+			cache.retQuery = fmt.Sprintf("SELECT \"%s\" FROM \"funding_payment\" WHERE %s", strings.Join(returnColumns, "\",\""), strmangle.WhereClause("\"", "\"", 0, fundingPaymentPrimaryKeyColumns))
+		}
+
+		cache.query = fmt.Sprintf(cache.query, queryOutput, queryReturning)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	vals := queries.ValuesFromMapping(value, cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, vals)
+	}
+
+	result, err := exec.ExecContext(ctx, cache.query, vals...)
+
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to insert into funding_payment")
+	}
+
+	var lastID int64
+	var identifierCols []interface{}
+
+	if len(cache.retMapping) == 0 {
+		goto CacheNoHooks
+	}
+
+	lastID, err = result.LastInsertId()
+	if err != nil {
+		return ErrSyncFail
+	}
+
+	o.ID = int64(lastID)
+	if lastID != 0 && len(cache.retMapping) == 1 && cache.retMapping[0] == fundingPaymentMapping["ID"] {
+		goto CacheNoHooks
+	}
+
+	identifierCols = []interface{}{
+		o.ID,
+	}
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.retQuery)
+		fmt.Fprintln(boil.DebugWriter, identifierCols...)
+	}
+
+	err = exec.QueryRowContext(ctx, cache.retQuery, identifierCols...).Scan(queries.PtrsFromMapping(value, cache.retMapping)...)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to populate default values for funding_payment")
+	}
+
+CacheNoHooks:
+	if !cached {
+		fundingPaymentInsertCacheMut.Lock()
+		fundingPaymentInsertCache[key] = cache
+		fundingPaymentInsertCacheMut.Unlock()
+	}
+
+	return o.doAfterInsertHooks(ctx, exec)
+}
+
+// Update uses an executor to update the FundingPayment.
+// See boil.Columns.UpdateColumnSet documentation to understand column list inference for updates.
+// Update does not automatically update the record in case of default values. Use .Reload() to refresh the records.
+func (o *FundingPayment) Update(ctx context.Context, exec boil.ContextExecutor, columns boil.Columns) (int64, error) {
+	var err error
+	if err = o.doBeforeUpdateHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+	key := makeCacheKey(columns, nil)
+	fundingPaymentUpdateCacheMut.RLock()
+	cache, cached := fundingPaymentUpdateCache[key]
+	fundingPaymentUpdateCacheMut.RUnlock()
+
+	if !cached {
+		wl := columns.UpdateColumnSet(
+			fundingPaymentAllColumns,
+			fundingPaymentPrimaryKeyColumns,
+		)
+
+		if len(wl) == 0 {
+			return 0, errors.New("sqlite3: unable to update funding_payment, could not build whitelist")
+		}
+
+		cache.query = fmt.Sprintf("UPDATE \"funding_payment\" SET %s WHERE %s",
+			strmangle.SetParamNames("\"", "\"", 0, wl),
+			strmangle.WhereClause("\"", "\"", 0, fundingPaymentPrimaryKeyColumns),
+		)
+		cache.valueMapping, err = queries.BindMapping(fundingPaymentType, fundingPaymentMapping, append(wl, fundingPaymentPrimaryKeyColumns...))
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	values := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), cache.valueMapping)
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, cache.query)
+		fmt.Fprintln(boil.DebugWriter, values)
+	}
+
+	var result sql.Result
+	result, err = exec.ExecContext(ctx, cache.query, values...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update funding_payment row")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by update for funding_payment")
+	}
+
+	if !cached {
+		fundingPaymentUpdateCacheMut.Lock()
+		fundingPaymentUpdateCache[key] = cache
+		fundingPaymentUpdateCacheMut.Unlock()
+	}
+
+	return rowsAff, o.doAfterUpdateHooks(ctx, exec)
+}
+
+// UpdateAll updates all rows with the specified column values.
+func (q fundingPaymentQuery) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	queries.SetUpdate(q.Query, cols)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all for funding_payment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected for funding_payment")
+	}
+
+	return rowsAff, nil
+}
+
+// UpdateAll updates all rows with the specified column values, using an executor.
+func (o FundingPaymentSlice) UpdateAll(ctx context.Context, exec boil.ContextExecutor, cols M) (int64, error) {
+	ln := int64(len(o))
+	if ln == 0 {
+		return 0, nil
+	}
+
+	if len(cols) == 0 {
+		return 0, errors.New("sqlite3: update all requires at least one column argument")
+	}
+
+	colNames := make([]string, len(cols))
+	args := make([]interface{}, len(cols))
+
+	i := 0
+	for name, value := range cols {
+		colNames[i] = name
+		args[i] = value
+		i++
+	}
+
+	// Append all of the primary key values for each column
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingPaymentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := fmt.Sprintf("UPDATE \"funding_payment\" SET %s WHERE %s",
+		strmangle.SetParamNames("\"", "\"", 0, colNames),
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingPaymentPrimaryKeyColumns, len(o)))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to update all in fundingPayment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to retrieve rows affected all in update all fundingPayment")
+	}
+	return rowsAff, nil
+}
+
+// Delete deletes a single FundingPayment record with an executor.
+// Delete will match against the primary key column to find the record to delete.
+func (o *FundingPayment) Delete(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if o == nil {
+		return 0, errors.New("sqlite3: no FundingPayment provided for delete")
+	}
+
+	if err := o.doBeforeDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	args := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(o)), fundingPaymentPrimaryKeyMapping)
+	sql := "DELETE FROM \"funding_payment\" WHERE \"id\"=?"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args...)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete from funding_payment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by delete for funding_payment")
+	}
+
+	if err := o.doAfterDeleteHooks(ctx, exec); err != nil {
+		return 0, err
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all matching rows.
+func (q fundingPaymentQuery) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if q.Query == nil {
+		return 0, errors.New("sqlite3: no fundingPaymentQuery provided for delete all")
+	}
+
+	queries.SetDelete(q.Query)
+
+	result, err := q.Query.ExecContext(ctx, exec)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from funding_payment")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for funding_payment")
+	}
+
+	return rowsAff, nil
+}
+
+// DeleteAll deletes all rows in the slice, using an executor.
+func (o FundingPaymentSlice) DeleteAll(ctx context.Context, exec boil.ContextExecutor) (int64, error) {
+	if len(o) == 0 {
+		return 0, nil
+	}
+
+	if len(fundingPaymentBeforeDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doBeforeDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	var args []interface{}
+	for _, obj := range o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingPaymentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "DELETE FROM \"funding_payment\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingPaymentPrimaryKeyColumns, len(o))
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, args)
+	}
+
+	result, err := exec.ExecContext(ctx, sql, args...)
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: unable to delete all from fundingPayment slice")
+	}
+
+	rowsAff, err := result.RowsAffected()
+	if err != nil {
+		return 0, errors.Wrap(err, "sqlite3: failed to get rows affected by deleteall for funding_payment")
+	}
+
+	if len(fundingPaymentAfterDeleteHooks) != 0 {
+		for _, obj := range o {
+			if err := obj.doAfterDeleteHooks(ctx, exec); err != nil {
+				return 0, err
+			}
+		}
+	}
+
+	return rowsAff, nil
+}
+
+// Reload refetches the object from the database
+// using the primary keys with an executor.
+func (o *FundingPayment) Reload(ctx context.Context, exec boil.ContextExecutor) error {
+	ret, err := FindFundingPayment(ctx, exec, o.ID)
+	if err != nil {
+		return err
+	}
+
+	*o = *ret
+	return nil
+}
+
+// ReloadAll refetches every row with matching primary key column values
+// and overwrites the original object slice with the newly updated slice.
+func (o *FundingPaymentSlice) ReloadAll(ctx context.Context, exec boil.ContextExecutor) error {
+	if o == nil || len(*o) == 0 {
+		return nil
+	}
+
+	slice := FundingPaymentSlice{}
+	var args []interface{}
+	for _, obj := range *o {
+		pkeyArgs := queries.ValuesFromMapping(reflect.Indirect(reflect.ValueOf(obj)), fundingPaymentPrimaryKeyMapping)
+		args = append(args, pkeyArgs...)
+	}
+
+	sql := "SELECT \"funding_payment\".* FROM \"funding_payment\" WHERE " +
+		strmangle.WhereClauseRepeated(string(dialect.LQ), string(dialect.RQ), 0, fundingPaymentPrimaryKeyColumns, len(*o))
+
+	q := queries.Raw(sql, args...)
+
+	err := q.Bind(ctx, exec, &slice)
+	if err != nil {
+		return errors.Wrap(err, "sqlite3: unable to reload all in FundingPaymentSlice")
+	}
+
+	*o = slice
+
+	return nil
+}
+
+// FundingPaymentExists checks if the FundingPayment row exists.
+func FundingPaymentExists(ctx context.Context, exec boil.ContextExecutor, iD int64) (bool, error) {
+	var exists bool
+	sql := "select exists(select 1 from \"funding_payment\" where \"id\"=? limit 1)"
+
+	if boil.DebugMode {
+		fmt.Fprintln(boil.DebugWriter, sql)
+		fmt.Fprintln(boil.DebugWriter, iD)
+	}
+
+	row := exec.QueryRowContext(ctx, sql, iD)
+
+	err := row.Scan(&exists)
+	if err != nil {
+		return false, errors.Wrap(err, "sqlite3: unable to check if funding_payment exists")
+	}
+
+	return exists, nil
+}
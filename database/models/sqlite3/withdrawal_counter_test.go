@@ -0,0 +1,684 @@
+// Code generated by SQLBoiler 3.5.0-gct (https://github.com/thrasher-corp/sqlboiler). DO NOT EDIT.
+// This file is meant to be re-generated in place and/or deleted at any time.
+
+package sqlite3
+
+import (
+	"bytes"
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries"
+	"github.com/thrasher-corp/sqlboiler/randomize"
+	"github.com/thrasher-corp/sqlboiler/strmangle"
+)
+
+var (
+	// Relationships sometimes use the reflection helper queries.Equal/queries.Assign
+	// so force a package dependency in case they don't.
+	_ = queries.Equal
+)
+
+func testWithdrawalCounters(t *testing.T) {
+	t.Parallel()
+
+	query := WithdrawalCounters()
+
+	if query.Query == nil {
+		t.Error("expected a query, got nothing")
+	}
+}
+
+func testWithdrawalCountersDelete(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := o.Delete(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WithdrawalCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWithdrawalCountersQueryDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if rowsAff, err := WithdrawalCounters().DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WithdrawalCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWithdrawalCountersSliceDeleteAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WithdrawalCounterSlice{o}
+
+	if rowsAff, err := slice.DeleteAll(ctx, tx); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only have deleted one row, but affected:", rowsAff)
+	}
+
+	count, err := WithdrawalCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 0 {
+		t.Error("want zero records, got:", count)
+	}
+}
+
+func testWithdrawalCountersExists(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	e, err := WithdrawalCounterExists(ctx, tx, o.ID)
+	if err != nil {
+		t.Errorf("Unable to check if WithdrawalCounter exists: %s", err)
+	}
+	if !e {
+		t.Errorf("Expected WithdrawalCounterExists to return true, but got false.")
+	}
+}
+
+func testWithdrawalCountersFind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	withdrawalCounterFound, err := FindWithdrawalCounter(ctx, tx, o.ID)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if withdrawalCounterFound == nil {
+		t.Error("want a record, got nil")
+	}
+}
+
+func testWithdrawalCountersBind(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = WithdrawalCounters().Bind(ctx, tx, o); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWithdrawalCountersOne(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if x, err := WithdrawalCounters().One(ctx, tx); err != nil {
+		t.Error(err)
+	} else if x == nil {
+		t.Error("expected to get a non nil record")
+	}
+}
+
+func testWithdrawalCountersAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	withdrawalCounterOne := &WithdrawalCounter{}
+	withdrawalCounterTwo := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, withdrawalCounterOne, withdrawalCounterDBTypes, false, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+	if err = randomize.Struct(seed, withdrawalCounterTwo, withdrawalCounterDBTypes, false, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = withdrawalCounterOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = withdrawalCounterTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WithdrawalCounters().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 2 {
+		t.Error("want 2 records, got:", len(slice))
+	}
+}
+
+func testWithdrawalCountersCount(t *testing.T) {
+	t.Parallel()
+
+	var err error
+	seed := randomize.NewSeed()
+	withdrawalCounterOne := &WithdrawalCounter{}
+	withdrawalCounterTwo := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, withdrawalCounterOne, withdrawalCounterDBTypes, false, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+	if err = randomize.Struct(seed, withdrawalCounterTwo, withdrawalCounterDBTypes, false, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = withdrawalCounterOne.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+	if err = withdrawalCounterTwo.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 2 {
+		t.Error("want 2 records, got:", count)
+	}
+}
+
+func withdrawalCounterBeforeInsertHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func withdrawalCounterAfterInsertHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func withdrawalCounterAfterSelectHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func withdrawalCounterBeforeUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func withdrawalCounterAfterUpdateHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func withdrawalCounterBeforeDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func withdrawalCounterAfterDeleteHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func withdrawalCounterBeforeUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func withdrawalCounterAfterUpsertHook(ctx context.Context, e boil.ContextExecutor, o *WithdrawalCounter) error {
+	*o = WithdrawalCounter{}
+	return nil
+}
+
+func testWithdrawalCountersHooks(t *testing.T) {
+	t.Parallel()
+
+	var err error
+
+	ctx := context.Background()
+	empty := &WithdrawalCounter{}
+	o := &WithdrawalCounter{}
+
+	seed := randomize.NewSeed()
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, false); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter object: %s", err)
+	}
+
+	AddWithdrawalCounterHook(boil.BeforeInsertHook, withdrawalCounterBeforeInsertHook)
+	if err = o.doBeforeInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeInsertHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterBeforeInsertHooks = []WithdrawalCounterHook{}
+
+	AddWithdrawalCounterHook(boil.AfterInsertHook, withdrawalCounterAfterInsertHook)
+	if err = o.doAfterInsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterInsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterInsertHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterAfterInsertHooks = []WithdrawalCounterHook{}
+
+	AddWithdrawalCounterHook(boil.AfterSelectHook, withdrawalCounterAfterSelectHook)
+	if err = o.doAfterSelectHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterSelectHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterSelectHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterAfterSelectHooks = []WithdrawalCounterHook{}
+
+	AddWithdrawalCounterHook(boil.BeforeUpdateHook, withdrawalCounterBeforeUpdateHook)
+	if err = o.doBeforeUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpdateHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterBeforeUpdateHooks = []WithdrawalCounterHook{}
+
+	AddWithdrawalCounterHook(boil.AfterUpdateHook, withdrawalCounterAfterUpdateHook)
+	if err = o.doAfterUpdateHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpdateHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpdateHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterAfterUpdateHooks = []WithdrawalCounterHook{}
+
+	AddWithdrawalCounterHook(boil.BeforeDeleteHook, withdrawalCounterBeforeDeleteHook)
+	if err = o.doBeforeDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeDeleteHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterBeforeDeleteHooks = []WithdrawalCounterHook{}
+
+	AddWithdrawalCounterHook(boil.AfterDeleteHook, withdrawalCounterAfterDeleteHook)
+	if err = o.doAfterDeleteHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterDeleteHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterDeleteHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterAfterDeleteHooks = []WithdrawalCounterHook{}
+
+	AddWithdrawalCounterHook(boil.BeforeUpsertHook, withdrawalCounterBeforeUpsertHook)
+	if err = o.doBeforeUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doBeforeUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected BeforeUpsertHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterBeforeUpsertHooks = []WithdrawalCounterHook{}
+
+	AddWithdrawalCounterHook(boil.AfterUpsertHook, withdrawalCounterAfterUpsertHook)
+	if err = o.doAfterUpsertHooks(ctx, nil); err != nil {
+		t.Errorf("Unable to execute doAfterUpsertHooks: %s", err)
+	}
+	if !reflect.DeepEqual(o, empty) {
+		t.Errorf("Expected AfterUpsertHook function to empty object, but got: %#v", o)
+	}
+	withdrawalCounterAfterUpsertHooks = []WithdrawalCounterHook{}
+}
+
+func testWithdrawalCountersInsert(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWithdrawalCountersInsertWhitelist(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Whitelist(withdrawalCounterColumnsWithoutDefault...)); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+}
+
+func testWithdrawalCountersReload(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	if err = o.Reload(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWithdrawalCountersReloadAll(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice := WithdrawalCounterSlice{o}
+
+	if err = slice.ReloadAll(ctx, tx); err != nil {
+		t.Error(err)
+	}
+}
+
+func testWithdrawalCountersSelect(t *testing.T) {
+	t.Parallel()
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	slice, err := WithdrawalCounters().All(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if len(slice) != 1 {
+		t.Error("want one record, got:", len(slice))
+	}
+}
+
+var (
+	withdrawalCounterDBTypes = map[string]string{`ID`: `INTEGER`, `Currency`: `TEXT`, `Day`: `TEXT`, `Amount`: `REAL`, `Alerted`: `BOOLEAN`, `CreatedAt`: `TIMESTAMP`}
+	_                        = bytes.MinRead
+)
+
+func testWithdrawalCountersUpdate(t *testing.T) {
+	t.Parallel()
+
+	if 0 == len(withdrawalCounterPrimaryKeyColumns) {
+		t.Skip("Skipping table with no primary key columns")
+	}
+	if len(withdrawalCounterAllColumns) == len(withdrawalCounterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	if rowsAff, err := o.Update(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("should only affect one row but affected", rowsAff)
+	}
+}
+
+func testWithdrawalCountersSliceUpdateAll(t *testing.T) {
+	t.Parallel()
+
+	if len(withdrawalCounterAllColumns) == len(withdrawalCounterPrimaryKeyColumns) {
+		t.Skip("Skipping table with only primary key columns")
+	}
+
+	seed := randomize.NewSeed()
+	var err error
+	o := &WithdrawalCounter{}
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterColumnsWithDefault...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	ctx := context.Background()
+	tx := MustTx(boil.BeginTx(ctx, nil))
+	defer func() { _ = tx.Rollback() }()
+	if err = o.Insert(ctx, tx, boil.Infer()); err != nil {
+		t.Error(err)
+	}
+
+	count, err := WithdrawalCounters().Count(ctx, tx)
+	if err != nil {
+		t.Error(err)
+	}
+
+	if count != 1 {
+		t.Error("want one record, got:", count)
+	}
+
+	if err = randomize.Struct(seed, o, withdrawalCounterDBTypes, true, withdrawalCounterPrimaryKeyColumns...); err != nil {
+		t.Errorf("Unable to randomize WithdrawalCounter struct: %s", err)
+	}
+
+	// Remove Primary keys and unique columns from what we plan to update
+	var fields []string
+	if strmangle.StringSliceMatch(withdrawalCounterAllColumns, withdrawalCounterPrimaryKeyColumns) {
+		fields = withdrawalCounterAllColumns
+	} else {
+		fields = strmangle.SetComplement(
+			withdrawalCounterAllColumns,
+			withdrawalCounterPrimaryKeyColumns,
+		)
+	}
+
+	value := reflect.Indirect(reflect.ValueOf(o))
+	typ := reflect.TypeOf(o).Elem()
+	n := typ.NumField()
+
+	updateMap := M{}
+	for _, col := range fields {
+		for i := 0; i < n; i++ {
+			f := typ.Field(i)
+			if f.Tag.Get("boil") == col {
+				updateMap[col] = value.Field(i).Interface()
+			}
+		}
+	}
+
+	slice := WithdrawalCounterSlice{o}
+	if rowsAff, err := slice.UpdateAll(ctx, tx, updateMap); err != nil {
+		t.Error(err)
+	} else if rowsAff != 1 {
+		t.Error("wanted one record updated but got", rowsAff)
+	}
+}
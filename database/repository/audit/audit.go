@@ -10,6 +10,7 @@ import (
 	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/thrasher-corp/sqlboiler/queries/qm"
 )
@@ -22,6 +23,7 @@ func Event(id, msgtype, message string) {
 	if database.DB.SQL == nil {
 		return
 	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "audit_insert")
 
 	ctx := context.Background()
 	ctx = boil.SkipTimestamps(ctx)
@@ -73,6 +75,7 @@ func GetEvent(startTime, endTime time.Time, order string, limit int) (interface{
 	if database.DB.SQL == nil {
 		return nil, errors.New("database is nil")
 	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "audit_select")
 
 	query := qm.Where("created_at BETWEEN ? AND ?", startTime, endTime)
 
@@ -0,0 +1,204 @@
+package candle
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// TableTimeFormat Go Time format conversion
+const TableTimeFormat = "2006-01-02 15:04:05"
+
+var (
+	errDatabaseNil     = errors.New("database is nil")
+	errIntervalInvalid = errors.New("candle interval must be at least a second")
+)
+
+// Insert stores candles, skipping any already stored for the same exchange,
+// asset, pair, interval and time. It returns the number of candles inserted
+func Insert(candles ...Candle) (int, error) {
+	if database.DB.SQL == nil {
+		return 0, errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "candle_insert")
+
+	ctx := boil.SkipTimestamps(context.Background())
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var inserted int
+	for i := range candles {
+		var ok bool
+		if candles[i].Interval < time.Second {
+			err = errIntervalInvalid
+		} else if repository.GetSQLDialect() == database.DBSQLite3 {
+			ok, err = insertSQLite(ctx, tx, &candles[i])
+		} else {
+			ok, err = insertPostgres(ctx, tx, &candles[i])
+		}
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				log.Errorf(log.DatabaseMgr, "Candle transaction rollback failed: %v", errRollback)
+			}
+			return 0, err
+		}
+		if ok {
+			inserted++
+		}
+	}
+	return inserted, tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx boil.ContextExecutor, c *Candle) (bool, error) {
+	startTime := c.Timestamp.UTC().Format(TableTimeFormat)
+	period := int64(c.Interval / time.Second)
+	exists, err := modelSQLite.Candles(
+		modelSQLite.CandleWhere.Exchange.EQ(c.Exchange),
+		modelSQLite.CandleWhere.Asset.EQ(c.Asset),
+		modelSQLite.CandleWhere.Pair.EQ(c.Pair),
+		modelSQLite.CandleWhere.Period.EQ(period),
+		modelSQLite.CandleWhere.StartTime.EQ(startTime)).Exists(ctx, tx)
+	if err != nil || exists {
+		return false, err
+	}
+	row := modelSQLite.Candle{
+		Exchange:  c.Exchange,
+		Asset:     c.Asset,
+		Pair:      c.Pair,
+		Period:    period,
+		StartTime: startTime,
+		Open:      c.Open,
+		High:      c.High,
+		Low:       c.Low,
+		Close:     c.Close,
+		Volume:    c.Volume,
+	}
+	err = row.Insert(ctx, tx, boil.Blacklist("created_at"))
+	if err != nil {
+		return false, err
+	}
+	c.ID = row.ID
+	return true, nil
+}
+
+func insertPostgres(ctx context.Context, tx boil.ContextExecutor, c *Candle) (bool, error) {
+	startTime := c.Timestamp.UTC()
+	period := int64(c.Interval / time.Second)
+	exists, err := modelPSQL.Candles(
+		modelPSQL.CandleWhere.Exchange.EQ(c.Exchange),
+		modelPSQL.CandleWhere.Asset.EQ(c.Asset),
+		modelPSQL.CandleWhere.Pair.EQ(c.Pair),
+		modelPSQL.CandleWhere.Period.EQ(period),
+		modelPSQL.CandleWhere.StartTime.EQ(startTime)).Exists(ctx, tx)
+	if err != nil || exists {
+		return false, err
+	}
+	row := modelPSQL.Candle{
+		Exchange:  c.Exchange,
+		Asset:     c.Asset,
+		Pair:      c.Pair,
+		Period:    period,
+		StartTime: startTime,
+		Open:      c.Open,
+		High:      c.High,
+		Low:       c.Low,
+		Close:     c.Close,
+		Volume:    c.Volume,
+	}
+	err = row.Insert(ctx, tx, boil.Blacklist("created_at"))
+	if err != nil {
+		return false, err
+	}
+	c.ID = row.ID
+	return true, nil
+}
+
+// Series returns the candles stored for an exchange, asset, pair and interval
+// starting between start and end inclusive, oldest first
+func Series(exchange, asset, pair string, interval time.Duration, start, end time.Time) ([]Candle, error) {
+	if database.DB.SQL == nil {
+		return nil, errDatabaseNil
+	}
+	if interval < time.Second {
+		return nil, errIntervalInvalid
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "candle_select")
+
+	ctx := context.Background()
+	period := int64(interval / time.Second)
+	orderByQuery := qm.OrderBy("start_time")
+	var candles []Candle
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		rows, err := modelSQLite.Candles(
+			modelSQLite.CandleWhere.Exchange.EQ(exchange),
+			modelSQLite.CandleWhere.Asset.EQ(asset),
+			modelSQLite.CandleWhere.Pair.EQ(pair),
+			modelSQLite.CandleWhere.Period.EQ(period),
+			modelSQLite.CandleWhere.StartTime.GTE(start.UTC().Format(TableTimeFormat)),
+			modelSQLite.CandleWhere.StartTime.LTE(end.UTC().Format(TableTimeFormat)),
+			orderByQuery).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			// The SQLite driver returns timestamp columns in RFC3339 format
+			startTime, errParse := time.Parse(time.RFC3339, rows[i].StartTime)
+			if errParse != nil {
+				return nil, errParse
+			}
+			candles = append(candles, Candle{
+				ID:        rows[i].ID,
+				Exchange:  rows[i].Exchange,
+				Asset:     rows[i].Asset,
+				Pair:      rows[i].Pair,
+				Interval:  time.Duration(rows[i].Period) * time.Second,
+				Timestamp: startTime,
+				Open:      rows[i].Open,
+				High:      rows[i].High,
+				Low:       rows[i].Low,
+				Close:     rows[i].Close,
+				Volume:    rows[i].Volume,
+			})
+		}
+		return candles, nil
+	}
+
+	rows, err := modelPSQL.Candles(
+		modelPSQL.CandleWhere.Exchange.EQ(exchange),
+		modelPSQL.CandleWhere.Asset.EQ(asset),
+		modelPSQL.CandleWhere.Pair.EQ(pair),
+		modelPSQL.CandleWhere.Period.EQ(period),
+		modelPSQL.CandleWhere.StartTime.GTE(start.UTC()),
+		modelPSQL.CandleWhere.StartTime.LTE(end.UTC()),
+		orderByQuery).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		candles = append(candles, Candle{
+			ID:        rows[i].ID,
+			Exchange:  rows[i].Exchange,
+			Asset:     rows[i].Asset,
+			Pair:      rows[i].Pair,
+			Interval:  time.Duration(rows[i].Period) * time.Second,
+			Timestamp: rows[i].StartTime.UTC(),
+			Open:      rows[i].Open,
+			High:      rows[i].High,
+			Low:       rows[i].Low,
+			Close:     rows[i].Close,
+			Volume:    rows[i].Volume,
+		})
+	}
+	return candles, nil
+}
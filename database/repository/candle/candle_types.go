@@ -0,0 +1,19 @@
+package candle
+
+import "time"
+
+// Candle is an OHLCV candle of a pair on an exchange. Interval is stored to
+// the second
+type Candle struct {
+	ID        int64
+	Exchange  string
+	Asset     string
+	Pair      string
+	Interval  time.Duration
+	Timestamp time.Time
+	Open      float64
+	High      float64
+	Low       float64
+	Close     float64
+	Volume    float64
+}
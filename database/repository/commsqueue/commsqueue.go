@@ -0,0 +1,173 @@
+package commsqueue
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// TableTimeFormat Go Time format conversion
+const TableTimeFormat = "2006-01-02 15:04:05"
+
+var errDatabaseNil = errors.New("database is nil")
+
+// Insert adds an undelivered event to the retry queue
+func Insert(e *Entry) error {
+	if database.DB.SQL == nil {
+		return errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "commsqueue_insert")
+
+	ctx := boil.SkipTimestamps(context.Background())
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		row := modelSQLite.CommsRetryQueue{
+			Medium:        e.Medium,
+			EventType:     e.EventType,
+			Message:       e.Message,
+			Severity:      e.Severity,
+			IncidentKey:   e.IncidentKey,
+			Resolved:      e.Resolved,
+			Attempts:      int64(e.Attempts),
+			LastError:     e.LastError,
+			NextAttemptAt: e.NextAttemptAt.UTC().Format(TableTimeFormat),
+		}
+		err = row.Insert(ctx, database.DB.SQL, boil.Blacklist("created_at"))
+		e.ID = row.ID
+	} else {
+		row := modelPSQL.CommsRetryQueue{
+			Medium:        e.Medium,
+			EventType:     e.EventType,
+			Message:       e.Message,
+			Severity:      e.Severity,
+			IncidentKey:   e.IncidentKey,
+			Resolved:      e.Resolved,
+			Attempts:      e.Attempts,
+			LastError:     e.LastError,
+			NextAttemptAt: e.NextAttemptAt.UTC(),
+		}
+		err = row.Insert(ctx, database.DB.SQL, boil.Blacklist("created_at"))
+		e.ID = row.ID
+	}
+	return err
+}
+
+// GetDue returns queued events due for redelivery, oldest first
+func GetDue(now time.Time, limit int) ([]Entry, error) {
+	if database.DB.SQL == nil {
+		return nil, errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "commsqueue_select")
+
+	ctx := context.Background()
+	orderByQuery := qm.OrderBy("next_attempt_at, id")
+	limitQuery := qm.Limit(limit)
+	var entries []Entry
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		rows, err := modelSQLite.CommsRetryQueues(
+			modelSQLite.CommsRetryQueueWhere.NextAttemptAt.LTE(now.UTC().Format(TableTimeFormat)),
+			orderByQuery,
+			limitQuery).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			// The SQLite driver returns timestamp columns in RFC3339 format
+			next, errParse := time.Parse(time.RFC3339, rows[i].NextAttemptAt)
+			if errParse != nil {
+				return nil, errParse
+			}
+			entries = append(entries, Entry{
+				ID:            rows[i].ID,
+				Medium:        rows[i].Medium,
+				EventType:     rows[i].EventType,
+				Message:       rows[i].Message,
+				Severity:      rows[i].Severity,
+				IncidentKey:   rows[i].IncidentKey,
+				Resolved:      rows[i].Resolved,
+				Attempts:      int(rows[i].Attempts),
+				LastError:     rows[i].LastError,
+				NextAttemptAt: next,
+			})
+		}
+		return entries, nil
+	}
+
+	rows, err := modelPSQL.CommsRetryQueues(
+		modelPSQL.CommsRetryQueueWhere.NextAttemptAt.LTE(now.UTC()),
+		orderByQuery,
+		limitQuery).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		entries = append(entries, Entry{
+			ID:            rows[i].ID,
+			Medium:        rows[i].Medium,
+			EventType:     rows[i].EventType,
+			Message:       rows[i].Message,
+			Severity:      rows[i].Severity,
+			IncidentKey:   rows[i].IncidentKey,
+			Resolved:      rows[i].Resolved,
+			Attempts:      rows[i].Attempts,
+			LastError:     rows[i].LastError,
+			NextAttemptAt: rows[i].NextAttemptAt,
+		})
+	}
+	return entries, nil
+}
+
+// UpdateAttempt stores the attempt count, last error and next attempt time of
+// a queued event
+func UpdateAttempt(e *Entry) error {
+	if database.DB.SQL == nil {
+		return errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "commsqueue_update")
+
+	ctx := context.Background()
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		_, err = modelSQLite.CommsRetryQueues(modelSQLite.CommsRetryQueueWhere.ID.EQ(e.ID)).
+			UpdateAll(ctx, database.DB.SQL, modelSQLite.M{
+				modelSQLite.CommsRetryQueueColumns.Attempts:      int64(e.Attempts),
+				modelSQLite.CommsRetryQueueColumns.LastError:     e.LastError,
+				modelSQLite.CommsRetryQueueColumns.NextAttemptAt: e.NextAttemptAt.UTC().Format(TableTimeFormat),
+			})
+	} else {
+		_, err = modelPSQL.CommsRetryQueues(modelPSQL.CommsRetryQueueWhere.ID.EQ(e.ID)).
+			UpdateAll(ctx, database.DB.SQL, modelPSQL.M{
+				modelPSQL.CommsRetryQueueColumns.Attempts:      e.Attempts,
+				modelPSQL.CommsRetryQueueColumns.LastError:     e.LastError,
+				modelPSQL.CommsRetryQueueColumns.NextAttemptAt: e.NextAttemptAt.UTC(),
+			})
+	}
+	return err
+}
+
+// Delete removes an event from the retry queue
+func Delete(id int64) error {
+	if database.DB.SQL == nil {
+		return errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "commsqueue_delete")
+
+	ctx := context.Background()
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		_, err = modelSQLite.CommsRetryQueues(modelSQLite.CommsRetryQueueWhere.ID.EQ(id)).
+			DeleteAll(ctx, database.DB.SQL)
+	} else {
+		_, err = modelPSQL.CommsRetryQueues(modelPSQL.CommsRetryQueueWhere.ID.EQ(id)).
+			DeleteAll(ctx, database.DB.SQL)
+	}
+	return err
+}
@@ -0,0 +1,17 @@
+package commsqueue
+
+import "time"
+
+// Entry is a communications event awaiting redelivery to a medium
+type Entry struct {
+	ID            int64
+	Medium        string
+	EventType     string
+	Message       string
+	Severity      string
+	IncidentKey   string
+	Resolved      bool
+	Attempts      int
+	LastError     string
+	NextAttemptAt time.Time
+}
@@ -0,0 +1,177 @@
+package funding
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// TableTimeFormat Go Time format conversion
+const TableTimeFormat = "2006-01-02 15:04:05"
+
+var errDatabaseNil = errors.New("database is nil")
+
+// Insert stores funding payments, skipping any already stored for the same
+// exchange, asset, pair and time. It returns the number of payments inserted
+func Insert(payments ...Payment) (int, error) {
+	if database.DB.SQL == nil {
+		return 0, errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "funding_insert")
+
+	ctx := boil.SkipTimestamps(context.Background())
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var inserted int
+	for i := range payments {
+		var ok bool
+		if repository.GetSQLDialect() == database.DBSQLite3 {
+			ok, err = insertSQLite(ctx, tx, &payments[i])
+		} else {
+			ok, err = insertPostgres(ctx, tx, &payments[i])
+		}
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				log.Errorf(log.DatabaseMgr, "Funding payment transaction rollback failed: %v", errRollback)
+			}
+			return 0, err
+		}
+		if ok {
+			inserted++
+		}
+	}
+	return inserted, tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx boil.ContextExecutor, p *Payment) (bool, error) {
+	paidAt := p.PaidAt.UTC().Format(TableTimeFormat)
+	exists, err := modelSQLite.FundingPayments(
+		modelSQLite.FundingPaymentWhere.Exchange.EQ(p.Exchange),
+		modelSQLite.FundingPaymentWhere.Asset.EQ(p.Asset),
+		modelSQLite.FundingPaymentWhere.Pair.EQ(p.Pair),
+		modelSQLite.FundingPaymentWhere.PaidAt.EQ(paidAt)).Exists(ctx, tx)
+	if err != nil || exists {
+		return false, err
+	}
+	row := modelSQLite.FundingPayment{
+		Exchange: p.Exchange,
+		Asset:    p.Asset,
+		Pair:     p.Pair,
+		Rate:     p.Rate,
+		Amount:   p.Amount,
+		Currency: p.Currency,
+		PaidAt:   paidAt,
+	}
+	err = row.Insert(ctx, tx, boil.Blacklist("created_at"))
+	if err != nil {
+		return false, err
+	}
+	p.ID = row.ID
+	return true, nil
+}
+
+func insertPostgres(ctx context.Context, tx boil.ContextExecutor, p *Payment) (bool, error) {
+	paidAt := p.PaidAt.UTC()
+	exists, err := modelPSQL.FundingPayments(
+		modelPSQL.FundingPaymentWhere.Exchange.EQ(p.Exchange),
+		modelPSQL.FundingPaymentWhere.Asset.EQ(p.Asset),
+		modelPSQL.FundingPaymentWhere.Pair.EQ(p.Pair),
+		modelPSQL.FundingPaymentWhere.PaidAt.EQ(paidAt)).Exists(ctx, tx)
+	if err != nil || exists {
+		return false, err
+	}
+	row := modelPSQL.FundingPayment{
+		Exchange: p.Exchange,
+		Asset:    p.Asset,
+		Pair:     p.Pair,
+		Rate:     p.Rate,
+		Amount:   p.Amount,
+		Currency: p.Currency,
+		PaidAt:   paidAt,
+	}
+	err = row.Insert(ctx, tx, boil.Blacklist("created_at"))
+	if err != nil {
+		return false, err
+	}
+	p.ID = row.ID
+	return true, nil
+}
+
+// Get returns the funding payments stored for an exchange, asset and pair
+// between start and end inclusive, oldest first
+func Get(exchange, asset, pair string, start, end time.Time) ([]Payment, error) {
+	if database.DB.SQL == nil {
+		return nil, errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "funding_select")
+
+	ctx := context.Background()
+	orderByQuery := qm.OrderBy("paid_at, id")
+	var payments []Payment
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		rows, err := modelSQLite.FundingPayments(
+			modelSQLite.FundingPaymentWhere.Exchange.EQ(exchange),
+			modelSQLite.FundingPaymentWhere.Asset.EQ(asset),
+			modelSQLite.FundingPaymentWhere.Pair.EQ(pair),
+			modelSQLite.FundingPaymentWhere.PaidAt.GTE(start.UTC().Format(TableTimeFormat)),
+			modelSQLite.FundingPaymentWhere.PaidAt.LTE(end.UTC().Format(TableTimeFormat)),
+			orderByQuery).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			// The SQLite driver returns timestamp columns in RFC3339 format
+			paidAt, errParse := time.Parse(time.RFC3339, rows[i].PaidAt)
+			if errParse != nil {
+				return nil, errParse
+			}
+			payments = append(payments, Payment{
+				ID:       rows[i].ID,
+				Exchange: rows[i].Exchange,
+				Asset:    rows[i].Asset,
+				Pair:     rows[i].Pair,
+				Rate:     rows[i].Rate,
+				Amount:   rows[i].Amount,
+				Currency: rows[i].Currency,
+				PaidAt:   paidAt,
+			})
+		}
+		return payments, nil
+	}
+
+	rows, err := modelPSQL.FundingPayments(
+		modelPSQL.FundingPaymentWhere.Exchange.EQ(exchange),
+		modelPSQL.FundingPaymentWhere.Asset.EQ(asset),
+		modelPSQL.FundingPaymentWhere.Pair.EQ(pair),
+		modelPSQL.FundingPaymentWhere.PaidAt.GTE(start.UTC()),
+		modelPSQL.FundingPaymentWhere.PaidAt.LTE(end.UTC()),
+		orderByQuery).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		payments = append(payments, Payment{
+			ID:       rows[i].ID,
+			Exchange: rows[i].Exchange,
+			Asset:    rows[i].Asset,
+			Pair:     rows[i].Pair,
+			Rate:     rows[i].Rate,
+			Amount:   rows[i].Amount,
+			Currency: rows[i].Currency,
+			PaidAt:   rows[i].PaidAt,
+		})
+	}
+	return payments, nil
+}
@@ -0,0 +1,16 @@
+package funding
+
+import "time"
+
+// Payment is a funding payment made or received on a perpetual futures
+// position. A positive amount was received and a negative amount was paid
+type Payment struct {
+	ID       int64
+	Exchange string
+	Asset    string
+	Pair     string
+	Rate     float64
+	Amount   float64
+	Currency string
+	PaidAt   time.Time
+}
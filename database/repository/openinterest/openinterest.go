@@ -0,0 +1,182 @@
+package openinterest
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+// TableTimeFormat Go Time format conversion
+const TableTimeFormat = "2006-01-02 15:04:05"
+
+var errDatabaseNil = errors.New("database is nil")
+
+// Insert stores open interest records, skipping any already stored for the
+// same exchange, asset, pair and time. It returns the number of records
+// inserted
+func Insert(records ...Record) (int, error) {
+	if database.DB.SQL == nil {
+		return 0, errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "openinterest_insert")
+
+	ctx := boil.SkipTimestamps(context.Background())
+	tx, err := database.DB.SQL.BeginTx(ctx, nil)
+	if err != nil {
+		return 0, err
+	}
+
+	var inserted int
+	for i := range records {
+		var ok bool
+		if repository.GetSQLDialect() == database.DBSQLite3 {
+			ok, err = insertSQLite(ctx, tx, &records[i])
+		} else {
+			ok, err = insertPostgres(ctx, tx, &records[i])
+		}
+		if err != nil {
+			if errRollback := tx.Rollback(); errRollback != nil {
+				log.Errorf(log.DatabaseMgr, "Open interest transaction rollback failed: %v", errRollback)
+			}
+			return 0, err
+		}
+		if ok {
+			inserted++
+		}
+	}
+	return inserted, tx.Commit()
+}
+
+func insertSQLite(ctx context.Context, tx boil.ContextExecutor, r *Record) (bool, error) {
+	recordedAt := r.RecordedAt.UTC().Format(TableTimeFormat)
+	exists, err := modelSQLite.OpenInterests(
+		modelSQLite.OpenInterestWhere.Exchange.EQ(r.Exchange),
+		modelSQLite.OpenInterestWhere.Asset.EQ(r.Asset),
+		modelSQLite.OpenInterestWhere.Pair.EQ(r.Pair),
+		modelSQLite.OpenInterestWhere.RecordedAt.EQ(recordedAt)).Exists(ctx, tx)
+	if err != nil || exists {
+		return false, err
+	}
+	row := modelSQLite.OpenInterest{
+		Exchange:       r.Exchange,
+		Asset:          r.Asset,
+		Pair:           r.Pair,
+		Amount:         r.Amount,
+		Value:          r.Value,
+		Currency:       r.Currency,
+		LongShortRatio: r.LongShortRatio,
+		RecordedAt:     recordedAt,
+	}
+	err = row.Insert(ctx, tx, boil.Blacklist("created_at"))
+	if err != nil {
+		return false, err
+	}
+	r.ID = row.ID
+	return true, nil
+}
+
+func insertPostgres(ctx context.Context, tx boil.ContextExecutor, r *Record) (bool, error) {
+	recordedAt := r.RecordedAt.UTC()
+	exists, err := modelPSQL.OpenInterests(
+		modelPSQL.OpenInterestWhere.Exchange.EQ(r.Exchange),
+		modelPSQL.OpenInterestWhere.Asset.EQ(r.Asset),
+		modelPSQL.OpenInterestWhere.Pair.EQ(r.Pair),
+		modelPSQL.OpenInterestWhere.RecordedAt.EQ(recordedAt)).Exists(ctx, tx)
+	if err != nil || exists {
+		return false, err
+	}
+	row := modelPSQL.OpenInterest{
+		Exchange:       r.Exchange,
+		Asset:          r.Asset,
+		Pair:           r.Pair,
+		Amount:         r.Amount,
+		Value:          r.Value,
+		Currency:       r.Currency,
+		LongShortRatio: r.LongShortRatio,
+		RecordedAt:     recordedAt,
+	}
+	err = row.Insert(ctx, tx, boil.Blacklist("created_at"))
+	if err != nil {
+		return false, err
+	}
+	r.ID = row.ID
+	return true, nil
+}
+
+// Get returns the open interest recorded for an exchange, asset and pair
+// between start and end inclusive, oldest first
+func Get(exchange, asset, pair string, start, end time.Time) ([]Record, error) {
+	if database.DB.SQL == nil {
+		return nil, errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "openinterest_select")
+
+	ctx := context.Background()
+	orderByQuery := qm.OrderBy("recorded_at, id")
+	var records []Record
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		rows, err := modelSQLite.OpenInterests(
+			modelSQLite.OpenInterestWhere.Exchange.EQ(exchange),
+			modelSQLite.OpenInterestWhere.Asset.EQ(asset),
+			modelSQLite.OpenInterestWhere.Pair.EQ(pair),
+			modelSQLite.OpenInterestWhere.RecordedAt.GTE(start.UTC().Format(TableTimeFormat)),
+			modelSQLite.OpenInterestWhere.RecordedAt.LTE(end.UTC().Format(TableTimeFormat)),
+			orderByQuery).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			// The SQLite driver returns timestamp columns in RFC3339 format
+			recordedAt, errParse := time.Parse(time.RFC3339, rows[i].RecordedAt)
+			if errParse != nil {
+				return nil, errParse
+			}
+			records = append(records, Record{
+				ID:             rows[i].ID,
+				Exchange:       rows[i].Exchange,
+				Asset:          rows[i].Asset,
+				Pair:           rows[i].Pair,
+				Amount:         rows[i].Amount,
+				Value:          rows[i].Value,
+				Currency:       rows[i].Currency,
+				LongShortRatio: rows[i].LongShortRatio,
+				RecordedAt:     recordedAt,
+			})
+		}
+		return records, nil
+	}
+
+	rows, err := modelPSQL.OpenInterests(
+		modelPSQL.OpenInterestWhere.Exchange.EQ(exchange),
+		modelPSQL.OpenInterestWhere.Asset.EQ(asset),
+		modelPSQL.OpenInterestWhere.Pair.EQ(pair),
+		modelPSQL.OpenInterestWhere.RecordedAt.GTE(start.UTC()),
+		modelPSQL.OpenInterestWhere.RecordedAt.LTE(end.UTC()),
+		orderByQuery).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		records = append(records, Record{
+			ID:             rows[i].ID,
+			Exchange:       rows[i].Exchange,
+			Asset:          rows[i].Asset,
+			Pair:           rows[i].Pair,
+			Amount:         rows[i].Amount,
+			Value:          rows[i].Value,
+			Currency:       rows[i].Currency,
+			LongShortRatio: rows[i].LongShortRatio,
+			RecordedAt:     rows[i].RecordedAt,
+		})
+	}
+	return records, nil
+}
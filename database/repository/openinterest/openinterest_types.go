@@ -0,0 +1,17 @@
+package openinterest
+
+import "time"
+
+// Record is the open interest and positioning of a derivatives contract at
+// a point in time
+type Record struct {
+	ID             int64
+	Exchange       string
+	Asset          string
+	Pair           string
+	Amount         float64
+	Value          float64
+	Currency       string
+	LongShortRatio float64
+	RecordedAt     time.Time
+}
@@ -0,0 +1,169 @@
+package pricealert
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+	"github.com/thrasher-corp/sqlboiler/boil"
+	"github.com/thrasher-corp/sqlboiler/queries/qm"
+)
+
+var errDatabaseNil = errors.New("database is nil")
+
+// Insert stores a new price alert
+func Insert(a *Alert) error {
+	if database.DB.SQL == nil {
+		return errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "pricealert_insert")
+
+	ctx := boil.SkipTimestamps(context.Background())
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		row := modelSQLite.PriceAlert{
+			Exchange:       a.Exchange,
+			Asset:          a.Asset,
+			Pair:           a.Pair,
+			Condition:      a.Condition,
+			Threshold:      a.Threshold,
+			ReferencePrice: a.ReferencePrice,
+			Note:           a.Note,
+			Active:         a.Active,
+			TriggeredPrice: a.TriggeredPrice,
+		}
+		err = row.Insert(ctx, database.DB.SQL, boil.Blacklist("created_at"))
+		a.ID = row.ID
+	} else {
+		row := modelPSQL.PriceAlert{
+			Exchange:       a.Exchange,
+			Asset:          a.Asset,
+			Pair:           a.Pair,
+			Condition:      a.Condition,
+			Threshold:      a.Threshold,
+			ReferencePrice: a.ReferencePrice,
+			Note:           a.Note,
+			Active:         a.Active,
+			TriggeredPrice: a.TriggeredPrice,
+		}
+		err = row.Insert(ctx, database.DB.SQL, boil.Blacklist("created_at"))
+		a.ID = row.ID
+	}
+	return err
+}
+
+// Get returns price alerts oldest first, only those yet to trigger if
+// activeOnly is set
+func Get(activeOnly bool) ([]Alert, error) {
+	if database.DB.SQL == nil {
+		return nil, errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "pricealert_select")
+
+	ctx := context.Background()
+	queries := []qm.QueryMod{qm.OrderBy("id")}
+	var alerts []Alert
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		if activeOnly {
+			queries = append(queries, modelSQLite.PriceAlertWhere.Active.EQ(true))
+		}
+		rows, err := modelSQLite.PriceAlerts(queries...).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			// The SQLite driver returns timestamp columns in RFC3339 format
+			created, errParse := time.Parse(time.RFC3339, rows[i].CreatedAt)
+			if errParse != nil {
+				return nil, errParse
+			}
+			alerts = append(alerts, Alert{
+				ID:             rows[i].ID,
+				Exchange:       rows[i].Exchange,
+				Asset:          rows[i].Asset,
+				Pair:           rows[i].Pair,
+				Condition:      rows[i].Condition,
+				Threshold:      rows[i].Threshold,
+				ReferencePrice: rows[i].ReferencePrice,
+				Note:           rows[i].Note,
+				Active:         rows[i].Active,
+				TriggeredPrice: rows[i].TriggeredPrice,
+				CreatedAt:      created,
+			})
+		}
+		return alerts, nil
+	}
+
+	if activeOnly {
+		queries = append(queries, modelPSQL.PriceAlertWhere.Active.EQ(true))
+	}
+	rows, err := modelPSQL.PriceAlerts(queries...).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		alerts = append(alerts, Alert{
+			ID:             rows[i].ID,
+			Exchange:       rows[i].Exchange,
+			Asset:          rows[i].Asset,
+			Pair:           rows[i].Pair,
+			Condition:      rows[i].Condition,
+			Threshold:      rows[i].Threshold,
+			ReferencePrice: rows[i].ReferencePrice,
+			Note:           rows[i].Note,
+			Active:         rows[i].Active,
+			TriggeredPrice: rows[i].TriggeredPrice,
+			CreatedAt:      rows[i].CreatedAt,
+		})
+	}
+	return alerts, nil
+}
+
+// MarkTriggered deactivates a price alert, storing the price it triggered at
+func MarkTriggered(id int64, price float64) error {
+	if database.DB.SQL == nil {
+		return errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "pricealert_update")
+
+	ctx := context.Background()
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		_, err = modelSQLite.PriceAlerts(modelSQLite.PriceAlertWhere.ID.EQ(id)).
+			UpdateAll(ctx, database.DB.SQL, modelSQLite.M{
+				modelSQLite.PriceAlertColumns.Active:         false,
+				modelSQLite.PriceAlertColumns.TriggeredPrice: price,
+			})
+	} else {
+		_, err = modelPSQL.PriceAlerts(modelPSQL.PriceAlertWhere.ID.EQ(id)).
+			UpdateAll(ctx, database.DB.SQL, modelPSQL.M{
+				modelPSQL.PriceAlertColumns.Active:         false,
+				modelPSQL.PriceAlertColumns.TriggeredPrice: price,
+			})
+	}
+	return err
+}
+
+// Delete removes a price alert
+func Delete(id int64) error {
+	if database.DB.SQL == nil {
+		return errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "pricealert_delete")
+
+	ctx := context.Background()
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		_, err = modelSQLite.PriceAlerts(modelSQLite.PriceAlertWhere.ID.EQ(id)).
+			DeleteAll(ctx, database.DB.SQL)
+	} else {
+		_, err = modelPSQL.PriceAlerts(modelPSQL.PriceAlertWhere.ID.EQ(id)).
+			DeleteAll(ctx, database.DB.SQL)
+	}
+	return err
+}
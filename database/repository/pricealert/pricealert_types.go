@@ -0,0 +1,19 @@
+package pricealert
+
+import "time"
+
+// Alert is a price alert on a pair at an exchange, or on the composite price
+// across exchanges when no exchange is set
+type Alert struct {
+	ID             int64
+	Exchange       string
+	Asset          string
+	Pair           string
+	Condition      string
+	Threshold      float64
+	ReferencePrice float64
+	Note           string
+	Active         bool
+	TriggeredPrice float64
+	CreatedAt      time.Time
+}
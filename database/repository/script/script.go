@@ -10,15 +10,17 @@ import (
 	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
 	"github.com/thrasher-corp/sqlboiler/boil"
 	"github.com/volatiletech/null"
 )
 
 // Event inserts a new script event into database with execution details (script name time status hash of script)
-func Event(id, name, path string, data null.Bytes, executionType, status string, time time.Time) {
+func Event(id, name, path string, data null.Bytes, executionType, status string, executionTime time.Time) {
 	if database.DB.SQL == nil {
 		return
 	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "script_insert")
 
 	ctx := context.Background()
 	ctx = boil.SkipTimestamps(ctx)
@@ -68,7 +70,7 @@ func Event(id, name, path string, data null.Bytes, executionType, status string,
 
 		tempScriptExecution := &modelSQLite.ScriptExecution{
 			ScriptID:        id,
-			ExecutionTime:   time.UTC().String(),
+			ExecutionTime:   executionTime.UTC().String(),
 			ExecutionStatus: status,
 			ExecutionType:   executionType,
 		}
@@ -99,7 +101,7 @@ func Event(id, name, path string, data null.Bytes, executionType, status string,
 		}
 
 		tempScriptExecution := &modelPSQL.ScriptExecution{
-			ExecutionTime:   time.UTC(),
+			ExecutionTime:   executionTime.UTC(),
 			ExecutionStatus: status,
 			ExecutionType:   executionType,
 		}
@@ -0,0 +1,113 @@
+package withdrawalcounter
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	modelPSQL "github.com/thrasher-corp/gocryptotrader/database/models/postgres"
+	modelSQLite "github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+	"github.com/thrasher-corp/sqlboiler/boil"
+)
+
+var errDatabaseNil = errors.New("database is nil")
+
+// Get returns the withdrawal counters of a day
+func Get(day string) ([]Counter, error) {
+	if database.DB.SQL == nil {
+		return nil, errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "withdrawalcounter_select")
+
+	ctx := context.Background()
+	var counters []Counter
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		rows, err := modelSQLite.WithdrawalCounters(
+			modelSQLite.WithdrawalCounterWhere.Day.EQ(day)).All(ctx, database.DB.SQL)
+		if err != nil {
+			return nil, err
+		}
+		for i := range rows {
+			counters = append(counters, Counter{
+				ID:       rows[i].ID,
+				Currency: rows[i].Currency,
+				Day:      rows[i].Day,
+				Amount:   rows[i].Amount,
+				Alerted:  rows[i].Alerted,
+			})
+		}
+		return counters, nil
+	}
+
+	rows, err := modelPSQL.WithdrawalCounters(
+		modelPSQL.WithdrawalCounterWhere.Day.EQ(day)).All(ctx, database.DB.SQL)
+	if err != nil {
+		return nil, err
+	}
+	for i := range rows {
+		counters = append(counters, Counter{
+			ID:       rows[i].ID,
+			Currency: rows[i].Currency,
+			Day:      rows[i].Day,
+			Amount:   rows[i].Amount,
+			Alerted:  rows[i].Alerted,
+		})
+	}
+	return counters, nil
+}
+
+// Set stores a counter, inserting it if it has no ID yet
+func Set(c *Counter) error {
+	if database.DB.SQL == nil {
+		return errDatabaseNil
+	}
+	defer metrics.DatabaseQueryDuration.ObserveSince(time.Now(), "withdrawalcounter_upsert")
+
+	if c.ID == 0 {
+		return insert(c)
+	}
+	ctx := context.Background()
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		_, err = modelSQLite.WithdrawalCounters(modelSQLite.WithdrawalCounterWhere.ID.EQ(c.ID)).
+			UpdateAll(ctx, database.DB.SQL, modelSQLite.M{
+				modelSQLite.WithdrawalCounterColumns.Amount:  c.Amount,
+				modelSQLite.WithdrawalCounterColumns.Alerted: c.Alerted,
+			})
+	} else {
+		_, err = modelPSQL.WithdrawalCounters(modelPSQL.WithdrawalCounterWhere.ID.EQ(c.ID)).
+			UpdateAll(ctx, database.DB.SQL, modelPSQL.M{
+				modelPSQL.WithdrawalCounterColumns.Amount:  c.Amount,
+				modelPSQL.WithdrawalCounterColumns.Alerted: c.Alerted,
+			})
+	}
+	return err
+}
+
+func insert(c *Counter) error {
+	ctx := boil.SkipTimestamps(context.Background())
+	var err error
+	if repository.GetSQLDialect() == database.DBSQLite3 {
+		row := modelSQLite.WithdrawalCounter{
+			Currency: c.Currency,
+			Day:      c.Day,
+			Amount:   c.Amount,
+			Alerted:  c.Alerted,
+		}
+		err = row.Insert(ctx, database.DB.SQL, boil.Blacklist("created_at"))
+		c.ID = row.ID
+	} else {
+		row := modelPSQL.WithdrawalCounter{
+			Currency: c.Currency,
+			Day:      c.Day,
+			Amount:   c.Amount,
+			Alerted:  c.Alerted,
+		}
+		err = row.Insert(ctx, database.DB.SQL, boil.Blacklist("created_at"))
+		c.ID = row.ID
+	}
+	return err
+}
@@ -0,0 +1,16 @@
+package withdrawalcounter
+
+// DayFormat is the format of the UTC day a counter is for
+const DayFormat = "2006-01-02"
+
+// Counter is the amount of a currency withdrawn on a UTC day, counted against
+// its daily withdrawal limit
+type Counter struct {
+	ID       int64
+	Currency string
+	Day      string
+	Amount   float64
+	// Alerted is set once the amount has approached the limit and an alert
+	// has been sent, so the alert is only sent once per day
+	Alerted bool
+}
@@ -0,0 +1,138 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestCandles(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			candlesHelper,
+			closeDatabase,
+		},
+		{
+			"Postgres",
+			postgresTestDatabase,
+			candlesHelper,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func candlesHelper(t *testing.T) {
+	t.Helper()
+
+	start := time.Now().UTC().Truncate(time.Hour)
+	candles := []candle.Candle{
+		{
+			Exchange:  "Binance",
+			Asset:     "spot",
+			Pair:      "BTC-USDT",
+			Interval:  time.Hour,
+			Timestamp: start.Add(-time.Hour),
+			Open:      9000,
+			High:      9100,
+			Low:       8950,
+			Close:     9050,
+			Volume:    120,
+		},
+		{
+			Exchange:  "Binance",
+			Asset:     "spot",
+			Pair:      "BTC-USDT",
+			Interval:  time.Hour,
+			Timestamp: start,
+			Open:      9050,
+			High:      9200,
+			Low:       9000,
+			Close:     9150,
+			Volume:    95,
+		},
+	}
+	inserted, err := candle.Insert(candles...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != len(candles) {
+		t.Errorf("expected %v candles inserted, received %v", len(candles), inserted)
+	}
+
+	inserted, err = candle.Insert(candles...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 0 {
+		t.Errorf("expected stored candles to be skipped, received %v inserted", inserted)
+	}
+
+	stored, err := candle.Series("Binance", "spot", "BTC-USDT", time.Hour,
+		start.Add(-24*time.Hour), start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != len(candles) {
+		t.Fatalf("expected %v candles, received %v", len(candles), len(stored))
+	}
+	if !stored[0].Timestamp.Equal(candles[0].Timestamp) || stored[1].Close != candles[1].Close ||
+		stored[1].Interval != time.Hour {
+		t.Errorf("unexpected candles returned %+v", stored)
+	}
+
+	stored, err = candle.Series("Binance", "spot", "BTC-USDT", time.Minute,
+		start.Add(-24*time.Hour), start)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 0 {
+		t.Errorf("expected candles of other intervals to be excluded, received %v", len(stored))
+	}
+}
@@ -0,0 +1,139 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/commsqueue"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestCommsQueue(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			commsQueueHelper,
+			closeDatabase,
+		},
+		{
+			"Postgres",
+			postgresTestDatabase,
+			commsQueueHelper,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func commsQueueHelper(t *testing.T) {
+	t.Helper()
+
+	now := time.Now()
+	due := commsqueue.Entry{
+		Medium:        "Slack",
+		EventType:     "order",
+		Message:       "filled",
+		Severity:      "info",
+		NextAttemptAt: now.Add(-time.Minute),
+	}
+	err := commsqueue.Insert(&due)
+	if err != nil {
+		t.Fatal(err)
+	}
+	later := commsqueue.Entry{
+		Medium:        "Telegram",
+		EventType:     "error",
+		Message:       "database down",
+		Severity:      "critical",
+		IncidentKey:   "gct_database_down",
+		NextAttemptAt: now.Add(time.Hour),
+	}
+	err = commsqueue.Insert(&later)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	entries, err := commsqueue.GetDue(now, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for i := range entries {
+		if entries[i].ID == later.ID {
+			t.Error("entry not yet due should not be returned")
+		}
+		if entries[i].ID == due.ID {
+			found = true
+		}
+	}
+	if !found {
+		t.Fatal("expected due entry to be returned")
+	}
+
+	due.Attempts++
+	due.LastError = "connection refused"
+	due.NextAttemptAt = now.Add(time.Hour)
+	err = commsqueue.UpdateAttempt(&due)
+	if err != nil {
+		t.Error(err)
+	}
+	entries, err = commsqueue.GetDue(now, 100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range entries {
+		if entries[i].ID == due.ID {
+			t.Error("rescheduled entry should not be due")
+		}
+	}
+
+	for _, id := range []int64{due.ID, later.ID} {
+		err = commsqueue.Delete(id)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
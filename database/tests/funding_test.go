@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/funding"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestFundingPayments(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			fundingPaymentsHelper,
+			closeDatabase,
+		},
+		{
+			"Postgres",
+			postgresTestDatabase,
+			fundingPaymentsHelper,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func fundingPaymentsHelper(t *testing.T) {
+	t.Helper()
+
+	paidAt := time.Now().UTC().Truncate(time.Hour)
+	payments := []funding.Payment{
+		{
+			Exchange: "Bitmex",
+			Asset:    "perpetualcontract",
+			Pair:     "XBTUSD",
+			Rate:     0.0001,
+			Amount:   -0.0000137,
+			Currency: "XBT",
+			PaidAt:   paidAt.Add(-8 * time.Hour),
+		},
+		{
+			Exchange: "Bitmex",
+			Asset:    "perpetualcontract",
+			Pair:     "XBTUSD",
+			Rate:     -0.0002,
+			Amount:   0.0000274,
+			Currency: "XBT",
+			PaidAt:   paidAt,
+		},
+	}
+	inserted, err := funding.Insert(payments...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != len(payments) {
+		t.Errorf("expected %v payments inserted, received %v", len(payments), inserted)
+	}
+
+	inserted, err = funding.Insert(payments...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 0 {
+		t.Errorf("expected stored payments to be skipped, received %v inserted", inserted)
+	}
+
+	stored, err := funding.Get("Bitmex", "perpetualcontract", "XBTUSD",
+		paidAt.Add(-24*time.Hour), paidAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != len(payments) {
+		t.Fatalf("expected %v payments, received %v", len(payments), len(stored))
+	}
+	if !stored[0].PaidAt.Equal(payments[0].PaidAt) || stored[1].Amount != payments[1].Amount {
+		t.Errorf("unexpected payments returned %+v", stored)
+	}
+
+	stored, err = funding.Get("Bitmex", "perpetualcontract", "XBTUSD",
+		paidAt.Add(-time.Hour), paidAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 {
+		t.Errorf("expected payments outside the range to be excluded, received %v", len(stored))
+	}
+}
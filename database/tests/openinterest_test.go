@@ -0,0 +1,131 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestOpenInterest(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			openInterestHelper,
+			closeDatabase,
+		},
+		{
+			"Postgres",
+			postgresTestDatabase,
+			openInterestHelper,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func openInterestHelper(t *testing.T) {
+	t.Helper()
+
+	recordedAt := time.Now().UTC().Truncate(time.Minute)
+	records := []openinterest.Record{
+		{
+			Exchange:       "OKEX",
+			Asset:          "perpetualswap",
+			Pair:           "BTC-USD_SWAP",
+			Amount:         1500000,
+			Currency:       "USD",
+			LongShortRatio: 1.2,
+			RecordedAt:     recordedAt.Add(-5 * time.Minute),
+		},
+		{
+			Exchange:   "Bitmex",
+			Asset:      "perpetualcontract",
+			Pair:       "XBTUSD",
+			Amount:     1000000,
+			Value:      136.98,
+			Currency:   "XBT",
+			RecordedAt: recordedAt,
+		},
+	}
+	inserted, err := openinterest.Insert(records...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != len(records) {
+		t.Errorf("expected %v records inserted, received %v", len(records), inserted)
+	}
+
+	inserted, err = openinterest.Insert(records...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if inserted != 0 {
+		t.Errorf("expected stored records to be skipped, received %v inserted", inserted)
+	}
+
+	stored, err := openinterest.Get("OKEX", "perpetualswap", "BTC-USD_SWAP",
+		recordedAt.Add(-time.Hour), recordedAt)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 {
+		t.Fatalf("expected 1 record, received %v", len(stored))
+	}
+	if !stored[0].RecordedAt.Equal(records[0].RecordedAt) || stored[0].LongShortRatio != 1.2 {
+		t.Errorf("unexpected records returned %+v", stored)
+	}
+
+	stored, err = openinterest.Get("Bitmex", "perpetualcontract", "XBTUSD",
+		recordedAt.Add(-time.Hour), recordedAt.Add(-time.Minute))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 0 {
+		t.Errorf("expected records outside the range to be excluded, received %v", len(stored))
+	}
+}
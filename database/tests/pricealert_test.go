@@ -0,0 +1,136 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/pricealert"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestPriceAlert(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			priceAlertHelper,
+			closeDatabase,
+		},
+		{
+			"Postgres",
+			postgresTestDatabase,
+			priceAlertHelper,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func priceAlertHelper(t *testing.T) {
+	t.Helper()
+
+	above := pricealert.Alert{
+		Exchange:  "Bitstamp",
+		Asset:     "spot",
+		Pair:      "BTC-USD",
+		Condition: "ABOVE",
+		Threshold: 10000,
+		Note:      "breakout",
+		Active:    true,
+	}
+	err := pricealert.Insert(&above)
+	if err != nil {
+		t.Fatal(err)
+	}
+	change := pricealert.Alert{
+		Asset:          "spot",
+		Pair:           "BTC-USD",
+		Condition:      "CHANGE",
+		Threshold:      5,
+		ReferencePrice: 9000,
+		Active:         true,
+	}
+	err = pricealert.Insert(&change)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = pricealert.MarkTriggered(above.ID, 10001)
+	if err != nil {
+		t.Fatal(err)
+	}
+	alerts, err := pricealert.Get(true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range alerts {
+		if alerts[i].ID == above.ID {
+			t.Error("triggered alert should not be active")
+		}
+	}
+
+	alerts, err = pricealert.Get(false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for i := range alerts {
+		if alerts[i].ID == above.ID {
+			found = true
+			if alerts[i].TriggeredPrice != 10001 || alerts[i].Note != "breakout" {
+				t.Errorf("unexpected triggered alert %+v", alerts[i])
+			}
+		}
+	}
+	if !found {
+		t.Fatal("expected triggered alert to be returned")
+	}
+
+	for _, id := range []int64{above.ID, change.ID} {
+		err = pricealert.Delete(id)
+		if err != nil {
+			t.Error(err)
+		}
+	}
+}
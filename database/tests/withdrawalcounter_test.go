@@ -0,0 +1,107 @@
+package tests
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	"github.com/thrasher-corp/gocryptotrader/database/drivers"
+	"github.com/thrasher-corp/gocryptotrader/database/repository"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawalcounter"
+	"github.com/thrasher-corp/goose"
+)
+
+func TestWithdrawalCounter(t *testing.T) {
+	testCases := []struct {
+		name   string
+		config *database.Config
+		runner func(t *testing.T)
+		closer func(t *testing.T, dbConn *database.Db) error
+	}{
+		{
+			"SQLite",
+			&database.Config{
+				Driver:            database.DBSQLite3,
+				ConnectionDetails: drivers.ConnectionDetails{Database: "./testdb"},
+			},
+			withdrawalCounterHelper,
+			closeDatabase,
+		},
+		{
+			"Postgres",
+			postgresTestDatabase,
+			withdrawalCounterHelper,
+			nil,
+		},
+	}
+
+	for _, tests := range testCases {
+		test := tests
+
+		t.Run(test.name, func(t *testing.T) {
+			if !checkValidConfig(t, &test.config.ConnectionDetails) {
+				t.Skip("database not configured skipping test")
+			}
+
+			dbConn, err := connectToDatabase(t, test.config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			path := filepath.Join("..", "migrations")
+			err = goose.Run("up", dbConn.SQL, repository.GetSQLDialect(), path, "")
+			if err != nil {
+				t.Fatalf("failed to run migrations %v", err)
+			}
+
+			if test.runner != nil {
+				test.runner(t)
+			}
+
+			if test.closer != nil {
+				err = test.closer(t, dbConn)
+				if err != nil {
+					t.Log(err)
+				}
+			}
+		})
+	}
+}
+
+func withdrawalCounterHelper(t *testing.T) {
+	t.Helper()
+
+	day := time.Now().UTC().Format(withdrawalcounter.DayFormat)
+	c := withdrawalcounter.Counter{
+		Currency: "BTC",
+		Day:      day,
+		Amount:   0.5,
+	}
+	if err := withdrawalcounter.Set(&c); err != nil {
+		t.Fatal(err)
+	}
+	if c.ID == 0 {
+		t.Fatal("expected the counter ID to be set")
+	}
+	c.Amount = 0.9
+	c.Alerted = true
+	if err := withdrawalcounter.Set(&c); err != nil {
+		t.Fatal(err)
+	}
+
+	stored, err := withdrawalcounter.Get(day)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 1 || stored[0] != c {
+		t.Errorf("expected %+v, received %+v", c, stored)
+	}
+
+	stored, err = withdrawalcounter.Get(time.Now().UTC().AddDate(0, 0, -1).Format(withdrawalcounter.DayFormat))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(stored) != 0 {
+		t.Errorf("expected no counters for the previous day, received %+v", stored)
+	}
+}
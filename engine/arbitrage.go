@@ -0,0 +1,310 @@
+package engine
+
+import (
+	"context"
+	"math"
+	"sort"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// GetArbitrageOpportunities returns the cross exchange and triangular
+// opportunities in the latest spot orderbooks and on-chain venue quotes of the
+// supplied exchanges and venues, or every enabled exchange and venue if none
+// are supplied, with a net profit of at least minProfitPercent. Opportunities
+// are ordered by net profit, highest first
+func GetArbitrageOpportunities(ctx context.Context, exchangeNames []string, minProfitPercent float64) []ArbitrageOpportunity {
+	now := time.Now()
+	quotes := Bot.DeFiManager.arbitrageQuotes(exchangeNames, now)
+	if quotes == nil {
+		quotes = make(map[string][]arbitrageQuote)
+	}
+	if len(exchangeNames) == 0 {
+		exchangeNames = GetExchangeNames(true)
+	}
+	withdrawable := make(map[string]exchange.IBotExchange)
+	for i := range exchangeNames {
+		exch := GetExchangeByName(exchangeNames[i])
+		if exch == nil {
+			continue
+		}
+		name := exch.GetName()
+		quotes[name] = arbitrageQuotes(ctx, exch, now)
+		if exch.SupportsWithdrawPermissions(exchange.AutoWithdrawCrypto) {
+			withdrawable[name] = exch
+		}
+	}
+
+	// On-chain venues trade from a wallet, so the currency bought on them can
+	// always be moved. The gas of moving it isn't known so is left out
+	transferFee := func(exchName string, c currency.Code) (float64, bool) {
+		if Bot.DeFiManager.IsVenue(exchName) {
+			return 0, true
+		}
+		exch, ok := withdrawable[exchName]
+		if !ok {
+			return 0, false
+		}
+		fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+			FeeType: exchange.CryptocurrencyWithdrawalFee,
+			Pair:    currency.Pair{Base: c},
+		})
+		if err != nil {
+			log.Debugf(log.Global, "Arbitrage unable to get %s %s withdrawal fee: %v\n", exchName, c, err)
+			return 0, false
+		}
+		return fee, true
+	}
+
+	var opportunities []ArbitrageOpportunity
+	for _, o := range crossExchangeOpportunities(quotes, transferFee, now) {
+		if o.NetProfitPercent >= minProfitPercent {
+			opportunities = append(opportunities, o)
+		}
+	}
+	for _, q := range quotes {
+		for _, o := range triangularOpportunities(q, now) {
+			if o.NetProfitPercent >= minProfitPercent {
+				opportunities = append(opportunities, o)
+			}
+		}
+	}
+	sort.SliceStable(opportunities, func(i, j int) bool {
+		return opportunities[i].NetProfitPercent > opportunities[j].NetProfitPercent
+	})
+	return opportunities
+}
+
+// arbitrageQuotes returns the top of the recent orderbooks of an exchange's
+// enabled spot pairs. Pairs without a taker fee are left out as their
+// profit can't be known
+func arbitrageQuotes(ctx context.Context, exch exchange.IBotExchange, now time.Time) []arbitrageQuote {
+	name := exch.GetName()
+	pairs := exch.GetEnabledPairs(asset.Spot)
+	var quotes []arbitrageQuote
+	for i := range pairs {
+		ob, err := orderbook.Get(name, pairs[i], asset.Spot)
+		if err != nil ||
+			len(ob.Bids) == 0 ||
+			len(ob.Asks) == 0 ||
+			now.Sub(ob.LastUpdated) > arbitrageMaxBookAge {
+			continue
+		}
+		// The fee of a unit trade at a unit price is the taker fee rate
+		fee, err := exch.GetFeeByType(ctx, &exchange.FeeBuilder{
+			FeeType:       exchange.OfflineTradeFee,
+			Pair:          pairs[i],
+			PurchasePrice: 1,
+			Amount:        1,
+		})
+		if err != nil {
+			continue
+		}
+		quotes = append(quotes, arbitrageQuote{
+			Exchange:  name,
+			Pair:      pairs[i],
+			Bid:       ob.Bids[0].Price,
+			BidAmount: ob.Bids[0].Amount,
+			Ask:       ob.Asks[0].Price,
+			AskAmount: ob.Asks[0].Amount,
+			FeeRate:   fee,
+		})
+	}
+	return quotes
+}
+
+// crossExchangeOpportunities returns the opportunities to buy a pair on one
+// exchange below the price it can be sold for on another. The bought currency
+// must be withdrawable from the buying exchange, transferFee returning false
+// when it isn't
+func crossExchangeOpportunities(quotes map[string][]arbitrageQuote, transferFee func(string, currency.Code) (float64, bool), now time.Time) []ArbitrageOpportunity {
+	byPair := make(map[string][]*arbitrageQuote)
+	for exch := range quotes {
+		for i := range quotes[exch] {
+			key := arbitragePairKey(quotes[exch][i].Pair)
+			byPair[key] = append(byPair[key], &quotes[exch][i])
+		}
+	}
+
+	var opportunities []ArbitrageOpportunity
+	for _, pairQuotes := range byPair {
+		for _, buy := range pairQuotes {
+			for _, sell := range pairQuotes {
+				if buy.Exchange == sell.Exchange || buy.Ask >= sell.Bid {
+					continue
+				}
+				fee, ok := transferFee(buy.Exchange, buy.Pair.Base)
+				if !ok {
+					continue
+				}
+				if o, ok := crossExchangeOpportunity(buy, sell, fee, now); ok {
+					opportunities = append(opportunities, o)
+				}
+			}
+		}
+	}
+	return opportunities
+}
+
+// crossExchangeOpportunity prices buying at the ask of one exchange, moving
+// the bought currency less the withdrawal fee and selling it at the bid of
+// another, limited to the amount at the top of both orderbooks
+func crossExchangeOpportunity(buy, sell *arbitrageQuote, transferFee float64, now time.Time) (ArbitrageOpportunity, bool) {
+	amount := math.Min(buy.AskAmount, sell.BidAmount)
+	received := amount - transferFee
+	if amount <= 0 || received <= 0 {
+		return ArbitrageOpportunity{}, false
+	}
+	cost := buy.Ask * amount * (1 + buy.FeeRate)
+	proceeds := sell.Bid * received * (1 - sell.FeeRate)
+	return ArbitrageOpportunity{
+		Type: ArbitrageCrossExchange,
+		Legs: []ArbitrageLeg{
+			{
+				Exchange: buy.Exchange,
+				Pair:     buy.Pair,
+				Side:     order.Buy,
+				Price:    buy.Ask,
+				Amount:   amount,
+				FeeRate:  buy.FeeRate,
+			},
+			{
+				Exchange: sell.Exchange,
+				Pair:     sell.Pair,
+				Side:     order.Sell,
+				Price:    sell.Bid,
+				Amount:   received,
+				FeeRate:  sell.FeeRate,
+			},
+		},
+		Currency:           buy.Pair.Quote.Upper(),
+		Amount:             cost,
+		Profit:             proceeds - cost,
+		GrossProfitPercent: (sell.Bid - buy.Ask) / buy.Ask * 100,
+		NetProfitPercent:   (proceeds - cost) / cost * 100,
+		TransferFee:        transferFee,
+		Detected:           now,
+	}, true
+}
+
+// triangularOpportunities returns the cycles through three pairs on one
+// exchange which return more of the starting currency than they spend before
+// fees. Each
+// cycle is started from the alphabetically first of its currencies so it is
+// only returned once
+func triangularOpportunities(quotes []arbitrageQuote, now time.Time) []ArbitrageOpportunity {
+	edges := make(map[string][]arbitrageEdge)
+	codes := make(map[string]currency.Code)
+	for i := range quotes {
+		q := &quotes[i]
+		if q.Bid <= 0 || q.Ask <= 0 {
+			continue
+		}
+		base := q.Pair.Base.Upper()
+		quote := q.Pair.Quote.Upper()
+		codes[base.String()] = base
+		codes[quote.String()] = quote
+		edges[base.String()] = append(edges[base.String()], arbitrageEdge{
+			quote:    q,
+			side:     order.Sell,
+			to:       quote,
+			rate:     q.Bid,
+			capacity: q.BidAmount,
+		})
+		edges[quote.String()] = append(edges[quote.String()], arbitrageEdge{
+			quote:    q,
+			side:     order.Buy,
+			to:       base,
+			rate:     1 / q.Ask,
+			capacity: q.AskAmount * q.Ask,
+		})
+	}
+
+	start := make([]string, 0, len(codes))
+	for c := range codes {
+		start = append(start, c)
+	}
+	sort.Strings(start)
+
+	var opportunities []ArbitrageOpportunity
+	for _, x := range start {
+		for i := range edges[x] {
+			y := edges[x][i].to.String()
+			if y <= x {
+				continue
+			}
+			for j := range edges[y] {
+				z := edges[y][j].to.String()
+				if z <= x || z == y {
+					continue
+				}
+				for k := range edges[z] {
+					if edges[z][k].to.String() != x {
+						continue
+					}
+					cycle := []*arbitrageEdge{&edges[x][i], &edges[y][j], &edges[z][k]}
+					if o, ok := triangularOpportunity(codes[x], cycle, now); ok {
+						opportunities = append(opportunities, o)
+					}
+				}
+			}
+		}
+	}
+	return opportunities
+}
+
+// triangularOpportunity prices trading through a cycle of edges, limited to
+// the amount at the top of each orderbook, if it is profitable before fees
+func triangularOpportunity(start currency.Code, cycle []*arbitrageEdge, now time.Time) (ArbitrageOpportunity, bool) {
+	gross, net := 1.0, 1.0
+	amount := math.Inf(1)
+	for _, e := range cycle {
+		// The capacity of each edge is converted to the starting currency
+		amount = math.Min(amount, e.capacity/gross)
+		gross *= e.rate
+		net *= e.rate * (1 - e.quote.FeeRate)
+	}
+	if gross <= 1 || amount <= 0 || math.IsInf(amount, 1) {
+		return ArbitrageOpportunity{}, false
+	}
+
+	legs := make([]ArbitrageLeg, len(cycle))
+	spent := amount
+	for i, e := range cycle {
+		leg := ArbitrageLeg{
+			Exchange: e.quote.Exchange,
+			Pair:     e.quote.Pair,
+			Side:     e.side,
+			Price:    e.quote.Bid,
+			Amount:   spent,
+			FeeRate:  e.quote.FeeRate,
+		}
+		if e.side == order.Buy {
+			leg.Price = e.quote.Ask
+			leg.Amount = spent / e.quote.Ask
+		}
+		legs[i] = leg
+		spent *= e.rate * (1 - e.quote.FeeRate)
+	}
+	return ArbitrageOpportunity{
+		Type:               ArbitrageTriangular,
+		Legs:               legs,
+		Currency:           start,
+		Amount:             amount,
+		Profit:             spent - amount,
+		GrossProfitPercent: (gross - 1) * 100,
+		NetProfitPercent:   (net - 1) * 100,
+		Detected:           now,
+	}, true
+}
+
+// arbitragePairKey identifies a pair regardless of how an exchange formats it
+func arbitragePairKey(p currency.Pair) string {
+	return p.Base.Upper().String() + "/" + p.Quote.Upper().String()
+}
@@ -0,0 +1,97 @@
+package engine
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func arbitrageNear(a, b float64) bool {
+	return math.Abs(a-b) < 1e-9
+}
+
+func TestCrossExchangeOpportunity(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USD)
+	buy := arbitrageQuote{Exchange: "a", Pair: p, Ask: 100, AskAmount: 2, FeeRate: 0.001}
+	sell := arbitrageQuote{Exchange: "b", Pair: p, Bid: 110, BidAmount: 1, FeeRate: 0.002}
+	o, ok := crossExchangeOpportunity(&buy, &sell, 0.01, time.Now())
+	if !ok {
+		t.Fatal("expected an opportunity")
+	}
+	cost := 100 * 1.001
+	proceeds := 110 * 0.99 * 0.998
+	if !arbitrageNear(o.Amount, cost) || !arbitrageNear(o.Profit, proceeds-cost) {
+		t.Errorf("expected a cost of %v and profit of %v, received %+v", cost, proceeds-cost, o)
+	}
+	if !arbitrageNear(o.GrossProfitPercent, 10) ||
+		!arbitrageNear(o.NetProfitPercent, (proceeds-cost)/cost*100) {
+		t.Errorf("unexpected profit percentages %+v", o)
+	}
+	if len(o.Legs) != 2 || o.Legs[0].Side != order.Buy || o.Legs[0].Amount != 1 ||
+		o.Legs[1].Side != order.Sell || !arbitrageNear(o.Legs[1].Amount, 0.99) {
+		t.Errorf("unexpected legs %+v", o.Legs)
+	}
+
+	if _, ok = crossExchangeOpportunity(&buy, &sell, 1, time.Now()); ok {
+		t.Error("expected no opportunity when the transfer fee exceeds the amount")
+	}
+}
+
+func TestCrossExchangeOpportunities(t *testing.T) {
+	quotes := map[string][]arbitrageQuote{
+		"a": {{Exchange: "a", Pair: currency.NewPairWithDelimiter("BTC", "USD", "-"), Bid: 99, BidAmount: 1, Ask: 100, AskAmount: 1}},
+		"b": {{Exchange: "b", Pair: currency.NewPairWithDelimiter("btc", "usd", "_"), Bid: 105, BidAmount: 1, Ask: 106, AskAmount: 1}},
+	}
+	transferFee := func(exch string, _ currency.Code) (float64, bool) {
+		return 0, exch == "a"
+	}
+	opportunities := crossExchangeOpportunities(quotes, transferFee, time.Now())
+	if len(opportunities) != 1 {
+		t.Fatalf("expected a single opportunity, received %+v", opportunities)
+	}
+	if opportunities[0].Legs[0].Exchange != "a" || opportunities[0].Legs[1].Exchange != "b" {
+		t.Errorf("expected to buy on a and sell on b, received %+v", opportunities[0].Legs)
+	}
+
+	transferFee = func(string, currency.Code) (float64, bool) {
+		return 0, false
+	}
+	if len(crossExchangeOpportunities(quotes, transferFee, time.Now())) != 0 {
+		t.Error("expected no opportunities without withdrawals")
+	}
+}
+
+func TestTriangularOpportunities(t *testing.T) {
+	// BTC -> ETH -> USD -> BTC returns 1.05 BTC per BTC before fees
+	quotes := []arbitrageQuote{
+		{Exchange: "a", Pair: currency.NewPair(currency.ETH, currency.BTC), Bid: 0.019, BidAmount: 100, Ask: 0.02, AskAmount: 100},
+		{Exchange: "a", Pair: currency.NewPair(currency.ETH, currency.USD), Bid: 210, BidAmount: 100, Ask: 211, AskAmount: 100},
+		{Exchange: "a", Pair: currency.NewPair(currency.BTC, currency.USD), Bid: 9990, BidAmount: 1, Ask: 10000, AskAmount: 1},
+	}
+	opportunities := triangularOpportunities(quotes, time.Now())
+	if len(opportunities) != 1 {
+		t.Fatalf("expected a single opportunity, received %+v", opportunities)
+	}
+	o := opportunities[0]
+	if !o.Currency.Match(currency.BTC) || !arbitrageNear(o.GrossProfitPercent, 5) {
+		t.Errorf("expected a 5%% BTC opportunity, received %+v", o)
+	}
+	// The BTC-USD ask limits the cycle to 1 BTC bought back, 1/1.05 BTC spent
+	if !arbitrageNear(o.Amount, 1/1.05) {
+		t.Errorf("expected the amount to be limited by the last leg, received %v", o.Amount)
+	}
+	if o.Legs[0].Side != order.Buy || o.Legs[1].Side != order.Sell || o.Legs[2].Side != order.Buy {
+		t.Errorf("unexpected legs %+v", o.Legs)
+	}
+
+	for i := range quotes {
+		quotes[i].FeeRate = 0.02
+	}
+	o = triangularOpportunities(quotes, time.Now())[0]
+	if o.NetProfitPercent >= 0 || o.Profit >= 0 {
+		t.Errorf("expected fees to make the cycle unprofitable, received %+v", o)
+	}
+}
@@ -0,0 +1,76 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// arbitrageMaxBookAge is the age past which an orderbook is too stale to
+// find opportunities in
+const arbitrageMaxBookAge = 30 * time.Second
+
+// Arbitrage opportunity types
+const (
+	// ArbitrageCrossExchange buys a currency on one exchange and sells it on
+	// another, moving the currency between them
+	ArbitrageCrossExchange = "CROSS_EXCHANGE"
+	// ArbitrageTriangular trades through three pairs on one exchange back to
+	// the starting currency
+	ArbitrageTriangular = "TRIANGULAR"
+)
+
+// ArbitrageOpportunity is a set of trades which ends with more of the
+// starting currency than it started with, net of taker fees and the
+// withdrawal fee of any transfer between exchanges
+type ArbitrageOpportunity struct {
+	Type string
+	// Legs are the trades in the order they are placed
+	Legs []ArbitrageLeg
+	// Currency is the starting currency, Amount is the most which can be
+	// traded at the top of the orderbooks and Profit is the amount gained
+	Currency currency.Code
+	Amount   float64
+	Profit   float64
+	// GrossProfitPercent is the profit before fees and transfers
+	GrossProfitPercent float64
+	NetProfitPercent   float64
+	// TransferFee is the withdrawal fee of moving the bought currency to the
+	// selling exchange, in the bought currency
+	TransferFee float64
+	Detected    time.Time
+}
+
+// ArbitrageLeg is a single taker trade of an arbitrage opportunity
+type ArbitrageLeg struct {
+	Exchange string
+	Pair     currency.Pair
+	Side     order.Side
+	Price    float64
+	Amount   float64
+	FeeRate  float64
+}
+
+// arbitrageQuote is the top of an exchange's orderbook for a pair along with
+// the exchange's taker fee rate
+type arbitrageQuote struct {
+	Exchange  string
+	Pair      currency.Pair
+	Bid       float64
+	BidAmount float64
+	Ask       float64
+	AskAmount float64
+	FeeRate   float64
+}
+
+// arbitrageEdge converts one currency into another through a quote
+type arbitrageEdge struct {
+	quote *arbitrageQuote
+	side  order.Side
+	to    currency.Code
+	// rate is the amount received per unit spent before fees
+	rate float64
+	// capacity is the most which can be spent at the top of the orderbook
+	capacity float64
+}
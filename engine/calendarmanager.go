@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/calendar"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (c *calendarManager) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+func (c *calendarManager) Start() error {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return errors.New("calendar manager already started")
+	}
+
+	venues := Bot.Config.Calendar.Venues
+	calendars := make(map[string]*calendar.Calendar, len(venues))
+	for i := range venues {
+		cal, err := calendar.New(&venues[i])
+		if err != nil {
+			atomic.CompareAndSwapInt32(&c.started, 1, 0)
+			return err
+		}
+		calendars[strings.ToLower(cal.Name())] = cal
+	}
+	c.m.Lock()
+	c.calendars = calendars
+	c.open = make(map[string]bool, len(calendars))
+	c.m.Unlock()
+	c.check(false)
+
+	c.shutdown = make(chan struct{})
+	go c.run()
+	log.Debugf(log.Global, "Calendar manager started with %d venue calendars.\n", len(calendars))
+	return nil
+}
+
+func (c *calendarManager) Stop() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return errCalendarManagerNotStarted
+	}
+
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return errors.New("calendar manager is already stopped")
+	}
+
+	close(c.shutdown)
+	log.Debugln(log.Global, "Calendar manager shutting down...")
+	return nil
+}
+
+func (c *calendarManager) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(calendarCheckInterval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&c.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		log.Debugln(log.Global, "Calendar manager shutdown.")
+	}()
+
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-t.C:
+			guard(calendarManagerName, func() { c.check(true) })
+		}
+	}
+}
+
+// check records whether each venue is open, logging the venues which have
+// opened or closed since the last check and notifying of them if notify is
+// set
+func (c *calendarManager) check(notify bool) {
+	now := time.Now()
+	c.m.Lock()
+	defer c.m.Unlock()
+	for name, cal := range c.calendars {
+		s := cal.Status(now)
+		previous, ok := c.open[name]
+		c.open[name] = s.Open
+		if ok && previous == s.Open {
+			continue
+		}
+		msg := fmt.Sprintf("Calendar: %s is %s", cal.Name(), describeStatus(s))
+		log.Infoln(log.Global, msg)
+		if notify {
+			Bot.CommsManager.PushEvent(base.Event{
+				Type:    base.EventTypeEvent,
+				Message: msg,
+			})
+		}
+	}
+}
+
+// Status returns whether a venue is open at a time according to its calendar.
+// False is returned when the venue has no calendar or the manager isn't
+// started, in which case the venue is treated as always open
+func (c *calendarManager) Status(venue string, t time.Time) (calendar.Status, bool) {
+	if !c.Started() {
+		return calendar.Status{Open: true}, false
+	}
+	c.m.RLock()
+	cal, ok := c.calendars[strings.ToLower(venue)]
+	c.m.RUnlock()
+	if !ok {
+		return calendar.Status{Open: true}, false
+	}
+	return cal.Status(t), true
+}
+
+// checkOpen returns an error if a venue's calendar has it closed now
+func (c *calendarManager) checkOpen(venue string) error {
+	s, ok := c.Status(venue, time.Now())
+	if !ok || s.Open {
+		return nil
+	}
+	return fmt.Errorf("%v: %s is %s", ErrVenueClosed, venue, describeStatus(s))
+}
+
+// describeStatus returns a description of a venue's status, such as "closed
+// (holiday) until 2026-12-28T17:00:00-06:00"
+func describeStatus(s calendar.Status) string {
+	resp := "open"
+	if !s.Open {
+		resp = "closed (" + s.Reason + ")"
+	}
+	if !s.Until.IsZero() {
+		resp += " until " + s.Until.Format(time.RFC3339)
+	}
+	return resp
+}
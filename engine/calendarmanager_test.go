@@ -0,0 +1,56 @@
+package engine
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/calendar"
+)
+
+func TestCalendarManagerStatus(t *testing.T) {
+	var c calendarManager
+	if s, ok := c.Status("CME", time.Now()); ok || !s.Open {
+		t.Errorf("expected venues to be open while not started, received %+v", s)
+	}
+
+	now := time.Now()
+	cal, err := calendar.New(&calendar.Settings{
+		Name: "CME",
+		Maintenance: []calendar.Window{
+			{Start: now.Add(-time.Hour), End: now.Add(time.Hour), Reason: "upgrade"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	c.started = 1
+	c.calendars = map[string]*calendar.Calendar{"cme": cal}
+
+	s, ok := c.Status("cme", now)
+	if !ok || s.Open {
+		t.Errorf("expected CME to be closed, received %+v", s)
+	}
+	if _, ok = c.Status("Bitstamp", now); ok {
+		t.Error("expected Bitstamp to have no calendar")
+	}
+	if err = c.checkOpen("Bitstamp"); err != nil {
+		t.Error(err)
+	}
+	err = c.checkOpen("CME")
+	if err == nil || !strings.HasPrefix(err.Error(), ErrVenueClosed.Error()) ||
+		!strings.Contains(err.Error(), "closed (maintenance: upgrade) until") {
+		t.Errorf("expected CME to be closed for maintenance, received %v", err)
+	}
+}
+
+func TestDescribeStatus(t *testing.T) {
+	until := time.Date(2026, 12, 28, 17, 0, 0, 0, time.UTC)
+	if d := describeStatus(calendar.Status{Open: true}); d != "open" {
+		t.Errorf("unexpected description %q", d)
+	}
+	d := describeStatus(calendar.Status{Reason: calendar.ReasonHoliday, Until: until})
+	if d != "closed (holiday) until 2026-12-28T17:00:00Z" {
+		t.Errorf("unexpected description %q", d)
+	}
+}
@@ -0,0 +1,31 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/calendar"
+)
+
+const (
+	calendarManagerName = "calendar manager"
+	// calendarCheckInterval is how often the calendar manager checks whether
+	// venues have opened or closed
+	calendarCheckInterval = time.Minute
+)
+
+var errCalendarManagerNotStarted = errors.New("calendar manager not started")
+
+// calendarManager holds the trading calendars of the configured venues,
+// answering whether a venue is open for the order manager and scripts and
+// notifying when venues open and close
+type calendarManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+
+	m         sync.RWMutex
+	calendars map[string]*calendar.Calendar
+	open      map[string]bool
+}
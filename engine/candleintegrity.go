@@ -0,0 +1,271 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (c *candleIntegrity) Started() bool {
+	return atomic.LoadInt32(&c.started) == 1
+}
+
+func (c *candleIntegrity) Start() error {
+	if atomic.AddInt32(&c.started, 1) != 1 {
+		return errors.New("candle integrity checker already started")
+	}
+
+	c.cfg = Bot.Config.CandleIntegrity
+	c.shutdown = make(chan struct{})
+	go c.run()
+	log.Debugf(log.Global, "Candle integrity checker started, checking %d series every %v.\n",
+		len(c.cfg.Series), c.cfg.CheckInterval)
+	return nil
+}
+
+func (c *candleIntegrity) Stop() error {
+	if atomic.LoadInt32(&c.started) == 0 {
+		return errCandleIntegrityNotStarted
+	}
+
+	if atomic.AddInt32(&c.stopped, 1) != 1 {
+		return errors.New("candle integrity checker is already stopped")
+	}
+
+	close(c.shutdown)
+	log.Debugln(log.Global, "Candle integrity checker shutting down...")
+	return nil
+}
+
+func (c *candleIntegrity) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(c.cfg.CheckInterval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&c.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&c.started, 1, 0)
+		log.Debugln(log.Global, "Candle integrity checker shutdown.")
+	}()
+
+	guard(candleIntegrityName, c.check)
+	for {
+		select {
+		case <-c.shutdown:
+			return
+		case <-t.C:
+			guard(candleIntegrityName, c.check)
+		}
+	}
+}
+
+// Check checks every series immediately, returning the reports
+func (c *candleIntegrity) Check() ([]CandleQualityReport, error) {
+	if !c.Started() {
+		return nil, errCandleIntegrityNotStarted
+	}
+	if !databaseConnected() {
+		return nil, errors.New("database unavailable")
+	}
+	c.check()
+	return c.Reports(""), nil
+}
+
+// Reports returns the latest report of each series, or of an exchange's
+// series if one is specified
+func (c *candleIntegrity) Reports(exchangeName string) []CandleQualityReport {
+	c.mtx.RLock()
+	defer c.mtx.RUnlock()
+	var reports []CandleQualityReport
+	for i := range c.reports {
+		if exchangeName == "" || strings.EqualFold(c.reports[i].Exchange, exchangeName) {
+			reports = append(reports, c.reports[i])
+		}
+	}
+	return reports
+}
+
+// check checks the candles stored for every series, logging the gaps which
+// couldn't be backfilled. Nothing is checked while the database is
+// unavailable as there is nowhere the candles are kept
+func (c *candleIntegrity) check() {
+	c.checking.Lock()
+	defer c.checking.Unlock()
+	if !databaseConnected() {
+		log.Warnln(log.Global, "Candle integrity checker: Database unavailable, skipping check")
+		return
+	}
+	now := time.Now()
+	reports := make([]CandleQualityReport, len(c.cfg.Series))
+	for i := range c.cfg.Series {
+		reports[i] = c.checkSeries(Bot.Context(), &c.cfg.Series[i], now)
+		r := &reports[i]
+		if r.Error != "" {
+			log.Errorf(log.Global, "Candle integrity checker: %s %s %s %v: %s\n",
+				r.Exchange, r.Asset, r.Pair, r.Interval, r.Error)
+		}
+		if len(r.Gaps) > 0 {
+			var missing int64
+			for j := range r.Gaps {
+				missing += r.Gaps[j].Missing
+			}
+			log.Warnf(log.Global, "Candle integrity checker: %s %s %s %v is missing %d candles in %d gaps which couldn't be backfilled\n",
+				r.Exchange, r.Asset, r.Pair, r.Interval, missing, len(r.Gaps))
+		}
+	}
+	c.mtx.Lock()
+	c.reports = reports
+	c.mtx.Unlock()
+}
+
+// checkSeries checks the candles stored for a series from the start of the
+// lookback period to the last closed candle, backfilling any gaps
+func (c *candleIntegrity) checkSeries(ctx context.Context, s *config.CandleSeriesConfig, now time.Time) CandleQualityReport {
+	from, to := candleCheckRange(now, c.cfg.Lookback, s.Interval)
+	r := CandleQualityReport{
+		Exchange: s.Exchange,
+		Asset:    s.AssetType,
+		Pair:     s.Pair,
+		Interval: s.Interval,
+		From:     from,
+		To:       to,
+		Expected: int64(to.Sub(from)/s.Interval) + 1,
+		Checked:  now,
+	}
+	stored, err := candle.Series(s.Exchange, s.AssetType, s.Pair, s.Interval, from, to)
+	if err != nil {
+		r.Error = err.Error()
+		return r
+	}
+	gaps := findCandleGaps(stored, from, to, s.Interval)
+	if len(gaps) > 0 {
+		r.Backfilled, err = c.backfill(ctx, s, gaps, now)
+		if err != nil {
+			r.Error = err.Error()
+		}
+		if r.Backfilled > 0 {
+			stored, err = candle.Series(s.Exchange, s.AssetType, s.Pair, s.Interval, from, to)
+			if err != nil {
+				r.Error = err.Error()
+				return r
+			}
+			gaps = findCandleGaps(stored, from, to, s.Interval)
+		}
+	}
+	r.Stored = int64(len(stored))
+	r.Gaps = gaps
+	return r
+}
+
+// backfill stores the candles fetched from the exchange which fill the gaps,
+// returning the number stored
+func (c *candleIntegrity) backfill(ctx context.Context, s *config.CandleSeriesConfig, gaps []CandleGap, now time.Time) (int64, error) {
+	exch := GetExchangeByName(s.Exchange)
+	if exch == nil {
+		return 0, fmt.Errorf("exchange %s not found", s.Exchange)
+	}
+	candles, err := fetchGapCandles(ctx, exch, s, gaps, now, c.cfg.BackfillLimit)
+	if err != nil || len(candles) == 0 {
+		return 0, err
+	}
+	inserted, err := candle.Insert(candles...)
+	return int64(inserted), err
+}
+
+// fetchGapCandles fetches an exchange's latest candles for a series, reaching
+// back to the oldest gap or the limit, and returns those which fill a gap
+func fetchGapCandles(ctx context.Context, exch exchange.IBotExchange, s *config.CandleSeriesConfig, gaps []CandleGap, now time.Time, limit int64) ([]candle.Candle, error) {
+	count := int64(now.Sub(gaps[0].From)/s.Interval) + 1
+	if count > limit {
+		count = limit
+	}
+	fetched, err := exch.GetHistoricCandles(ctx, currency.NewPairFromString(s.Pair),
+		count, int64(s.Interval/time.Second))
+	if err != nil {
+		if unsupportedHistory(err) {
+			return nil, fmt.Errorf("%s doesn't provide candle history", exch.GetName())
+		}
+		return nil, err
+	}
+	var candles []candle.Candle
+	for i := range fetched {
+		ts := time.Unix(fetched[i].Time, 0).UTC()
+		if !inCandleGaps(gaps, ts, s.Interval) {
+			continue
+		}
+		candles = append(candles, candle.Candle{
+			Exchange:  s.Exchange,
+			Asset:     s.AssetType,
+			Pair:      s.Pair,
+			Interval:  s.Interval,
+			Timestamp: ts,
+			Open:      fetched[i].Open,
+			High:      fetched[i].High,
+			Low:       fetched[i].Low,
+			Close:     fetched[i].Close,
+			Volume:    fetched[i].Volume,
+		})
+	}
+	return candles, nil
+}
+
+// candleCheckRange returns the start times of the first candle in the
+// lookback period and of the last closed candle
+func candleCheckRange(now time.Time, lookback, interval time.Duration) (from, to time.Time) {
+	to = now.UTC().Truncate(interval).Add(-interval)
+	from = now.UTC().Add(-lookback).Truncate(interval)
+	if from.After(to) {
+		from = to
+	}
+	return from, to
+}
+
+// findCandleGaps returns the runs of candles missing from the start times
+// from to to inclusive. Candles must be sorted oldest first
+func findCandleGaps(candles []candle.Candle, from, to time.Time, interval time.Duration) []CandleGap {
+	var gaps []CandleGap
+	next := from
+	for i := range candles {
+		ts := candles[i].Timestamp
+		if ts.Before(next) || ts.After(to) {
+			continue
+		}
+		if missing := int64(ts.Sub(next) / interval); missing > 0 {
+			gaps = append(gaps, CandleGap{
+				From:    next,
+				To:      next.Add(time.Duration(missing-1) * interval),
+				Missing: missing,
+			})
+			next = next.Add(time.Duration(missing) * interval)
+		}
+		next = next.Add(interval)
+	}
+	if !next.After(to) {
+		gaps = append(gaps, CandleGap{
+			From:    next,
+			To:      to,
+			Missing: int64(to.Sub(next)/interval) + 1,
+		})
+	}
+	return gaps
+}
+
+// inCandleGaps reports whether a candle start time is one of those missing
+func inCandleGaps(gaps []CandleGap, ts time.Time, interval time.Duration) bool {
+	for i := range gaps {
+		if !ts.Before(gaps[i].From) && !ts.After(gaps[i].To) &&
+			ts.Sub(gaps[i].From)%interval == 0 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,149 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// candleHistoryTestExchange returns an hour candle for each hour from start
+// to the latest hour requested, or reports candle history as unsupported
+type candleHistoryTestExchange struct {
+	exchange.IBotExchange
+	start       time.Time
+	unsupported bool
+	rangesize   int64
+	granularity int64
+}
+
+func (e *candleHistoryTestExchange) GetName() string { return "candletest" }
+
+func (e *candleHistoryTestExchange) GetHistoricCandles(_ context.Context, _ currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+	if e.unsupported {
+		return nil, common.ErrNotYetImplemented
+	}
+	e.rangesize, e.granularity = rangesize, granularity
+	var candles []exchange.Candle
+	for i := int64(0); i < rangesize; i++ {
+		candles = append(candles, exchange.Candle{
+			Time:  e.start.Add(time.Duration(i) * time.Hour).Unix(),
+			Close: float64(i),
+		})
+	}
+	return candles, nil
+}
+
+func TestCandleCheckRange(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, 4, 10, 12, 30, 0, 0, time.UTC)
+	from, to := candleCheckRange(now, 24*time.Hour, time.Hour)
+	if !from.Equal(time.Date(2020, 4, 9, 12, 0, 0, 0, time.UTC)) ||
+		!to.Equal(time.Date(2020, 4, 10, 11, 0, 0, 0, time.UTC)) {
+		t.Errorf("unexpected range %v to %v", from, to)
+	}
+	from, to = candleCheckRange(now, time.Minute, time.Hour)
+	if !from.Equal(to) {
+		t.Errorf("expected a lookback shorter than the interval to check the last candle, received %v to %v", from, to)
+	}
+}
+
+func TestFindCandleGaps(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC)
+	to := from.Add(9 * time.Hour)
+	var candles []candle.Candle
+	for _, h := range []int{1, 2, 5, 6} {
+		candles = append(candles, candle.Candle{Timestamp: from.Add(time.Duration(h) * time.Hour)})
+	}
+	gaps := findCandleGaps(candles, from, to, time.Hour)
+	expected := []CandleGap{
+		{From: from, To: from, Missing: 1},
+		{From: from.Add(3 * time.Hour), To: from.Add(4 * time.Hour), Missing: 2},
+		{From: from.Add(7 * time.Hour), To: to, Missing: 3},
+	}
+	if len(gaps) != len(expected) {
+		t.Fatalf("expected %d gaps, received %+v", len(expected), gaps)
+	}
+	for i := range expected {
+		if !gaps[i].From.Equal(expected[i].From) || !gaps[i].To.Equal(expected[i].To) ||
+			gaps[i].Missing != expected[i].Missing {
+			t.Errorf("expected gap %+v, received %+v", expected[i], gaps[i])
+		}
+	}
+
+	for h := 0; h <= 9; h++ {
+		candles = append(candles, candle.Candle{Timestamp: from.Add(time.Duration(h) * time.Hour)})
+	}
+	if gaps = findCandleGaps(candles[4:], from, to, time.Hour); len(gaps) != 0 {
+		t.Errorf("expected no gaps, received %+v", gaps)
+	}
+	if gaps = findCandleGaps(nil, from, to, time.Hour); len(gaps) != 1 || gaps[0].Missing != 10 {
+		t.Errorf("expected a single gap of every candle, received %+v", gaps)
+	}
+}
+
+func TestFetchGapCandles(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, 4, 10, 12, 30, 0, 0, time.UTC)
+	s := &config.CandleSeriesConfig{
+		Exchange:  "CandleTest",
+		AssetType: "spot",
+		Pair:      "BTC-USD",
+		Interval:  time.Hour,
+	}
+	gaps := []CandleGap{
+		{From: time.Date(2020, 4, 10, 2, 0, 0, 0, time.UTC), To: time.Date(2020, 4, 10, 3, 0, 0, 0, time.UTC), Missing: 2},
+		{From: time.Date(2020, 4, 10, 9, 0, 0, 0, time.UTC), To: time.Date(2020, 4, 10, 9, 0, 0, 0, time.UTC), Missing: 1},
+	}
+	e := &candleHistoryTestExchange{start: time.Date(2020, 4, 10, 2, 0, 0, 0, time.UTC)}
+	candles, err := fetchGapCandles(context.Background(), e, s, gaps, now, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e.rangesize != 11 || e.granularity != 3600 {
+		t.Errorf("expected 11 hour candles to be requested, received %d of %ds", e.rangesize, e.granularity)
+	}
+	if len(candles) != 3 {
+		t.Fatalf("expected the 3 missing candles, received %+v", candles)
+	}
+	if c := candles[2]; c.Exchange != "CandleTest" || c.Pair != "BTC-USD" || c.Interval != time.Hour ||
+		!c.Timestamp.Equal(gaps[1].From) || c.Close != 7 {
+		t.Errorf("unexpected candle %+v", c)
+	}
+
+	if _, err = fetchGapCandles(context.Background(), e, s, gaps, now, 5); err != nil {
+		t.Fatal(err)
+	}
+	if e.rangesize != 5 {
+		t.Errorf("expected the request to be limited to 5 candles, received %d", e.rangesize)
+	}
+
+	e.unsupported = true
+	if _, err = fetchGapCandles(context.Background(), e, s, gaps, now, 1000); err == nil {
+		t.Error("expected an error when the exchange has no candle history")
+	}
+}
+
+func TestCandleQualityDetails(t *testing.T) {
+	t.Parallel()
+	from := time.Date(2020, 4, 10, 0, 0, 0, 0, time.UTC)
+	q := candleQualityDetails(&CandleQualityReport{
+		Exchange: "CandleTest",
+		Interval: time.Hour,
+		From:     from,
+		To:       from.Add(9 * time.Hour),
+		Gaps:     []CandleGap{{From: from, To: from.Add(time.Hour), Missing: 2}},
+	})
+	if q.Interval != "1h0m0s" || q.From != "2020-04-10 00:00:00" || q.To != "2020-04-10 09:00:00" {
+		t.Errorf("unexpected series %+v", q)
+	}
+	if len(q.Gaps) != 1 || q.Gaps[0].To != "2020-04-10 01:00:00" || q.Gaps[0].Missing != 2 {
+		t.Errorf("unexpected gaps %+v", q.Gaps)
+	}
+}
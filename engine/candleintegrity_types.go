@@ -0,0 +1,57 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+const candleIntegrityName = "candle integrity checker"
+
+var errCandleIntegrityNotStarted = errors.New("candle integrity checker not started")
+
+// candleIntegrity checks the candles stored for each configured series every
+// check interval, backfilling missing candles from the exchange's REST
+// candle history and keeping a report of the gaps which couldn't be filled
+type candleIntegrity struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	cfg      config.CandleIntegrityConfig
+	// checking serialises checks so a check requested over RPC doesn't
+	// backfill the same gaps as a scheduled one
+	checking sync.Mutex
+	mtx      sync.RWMutex
+	reports  []CandleQualityReport
+}
+
+// CandleGap is a run of consecutive missing candles. From and To are the
+// start times of the first and last candle missing
+type CandleGap struct {
+	From    time.Time
+	To      time.Time
+	Missing int64
+}
+
+// CandleQualityReport is the result of the latest check of a candle series
+// between From and To, the start times of the first and last candle checked
+type CandleQualityReport struct {
+	Exchange   string
+	Asset      string
+	Pair       string
+	Interval   time.Duration
+	From       time.Time
+	To         time.Time
+	Expected   int64
+	Stored     int64
+	Backfilled int64
+	// Gaps are the missing candles which couldn't be backfilled, such as
+	// those older than the exchange's candle history or periods without
+	// trades
+	Gaps []CandleGap
+	// Error is why the series couldn't be checked or backfilled
+	Error   string
+	Checked time.Time
+}
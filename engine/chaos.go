@@ -0,0 +1,37 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
+	gctlog "github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// setupChaos starts injecting the faults set by the chaos testing settings
+// into exchange interactions
+func (e *Engine) setupChaos() error {
+	cfg := chaos.Config{
+		Latency:           e.Settings.ChaosLatency,
+		Jitter:            e.Settings.ChaosJitter,
+		RESTErrorRate:     e.Settings.ChaosRESTErrorRate,
+		WebsocketDropRate: e.Settings.ChaosWebsocketDropRate,
+	}
+	for _, exch := range strings.Split(e.Settings.ChaosExchanges, ",") {
+		if exch = strings.TrimSpace(exch); exch != "" {
+			cfg.Exchanges = append(cfg.Exchanges, exch)
+		}
+	}
+	if err := chaos.Set(cfg); err != nil {
+		return fmt.Errorf("invalid chaos testing settings: %v", err)
+	}
+	if chaos.Enabled() {
+		exchanges := "all exchanges"
+		if len(cfg.Exchanges) > 0 {
+			exchanges = strings.Join(cfg.Exchanges, ", ")
+		}
+		gctlog.Warnf(gctlog.Global, "Chaos testing enabled for %s: %v latency with %v jitter, %v REST error rate and %v websocket drop rate\n",
+			exchanges, cfg.Latency, cfg.Jitter, cfg.RESTErrorRate, cfg.WebsocketDropRate)
+	}
+	return nil
+}
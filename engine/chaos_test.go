@@ -0,0 +1,24 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
+)
+
+func TestSetupChaos(t *testing.T) {
+	defer chaos.Set(chaos.Config{}) // nolint:errcheck
+
+	e := &Engine{Settings: Settings{ChaosRESTErrorRate: 2}}
+	if err := e.setupChaos(); err == nil {
+		t.Error("expected an invalid REST error rate to be refused")
+	}
+
+	e.Settings = Settings{ChaosRESTErrorRate: 1, ChaosExchanges: "binance, ,bitstamp"}
+	if err := e.setupChaos(); err != nil {
+		t.Fatal(err)
+	}
+	if chaos.RESTError("Bitstamp") != chaos.ErrInjected || chaos.RESTError("kraken") != nil {
+		t.Error("expected errors to be injected into the listed exchanges only")
+	}
+}
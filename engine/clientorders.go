@@ -0,0 +1,300 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// load restores the submissions whose outcome was unknown at shutdown from
+// the state file at path, so they are resolved rather than submitted again
+func (c *clientOrders) load(path string) error {
+	var pending []*pendingSubmission
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		switch {
+		case err == nil:
+			if err = json.Unmarshal(data, &pending); err != nil {
+				return fmt.Errorf("unable to read pending orders %s: %v", path, err)
+			}
+		case !os.IsNotExist(err):
+			return err
+		}
+	}
+
+	c.m.Lock()
+	defer c.m.Unlock()
+	c.pending = make(map[string]*pendingSubmission)
+	c.placed = make(map[string]placedClientOrder)
+	for _, p := range pending {
+		c.pending[orderKey(p.Exchange, p.Submit.ClientID)] = p
+	}
+	return nil
+}
+
+// save writes the submissions whose outcome is unknown to path, removing the
+// file when there are none
+func (c *clientOrders) save(path string) error {
+	c.m.Lock()
+	var pending []*pendingSubmission
+	for _, p := range c.pending {
+		if p.Unknown {
+			pending = append(pending, p)
+		}
+	}
+	c.m.Unlock()
+	if len(pending) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(pending, "", " ")
+	if err != nil {
+		return err
+	}
+	err = file.Write(path, data)
+	if err != nil {
+		return err
+	}
+	log.Debugf(log.OrderMgr, "Order manager: %d pending order submission(s) saved to %s\n", len(pending), path)
+	return nil
+}
+
+// begin marks a submission as in-flight. It returns the ID of the order
+// already placed with the submission's client order ID, if there is one
+func (c *clientOrders) begin(exchName string, s *order.Submit) (string, error) {
+	key := orderKey(exchName, s.ClientID)
+	c.m.Lock()
+	defer c.m.Unlock()
+	if _, ok := c.pending[key]; ok {
+		return "", fmt.Errorf("%v: %s", ErrSubmissionPending, s.ClientID)
+	}
+	if p, ok := c.placed[key]; ok && time.Since(p.added) < clientOrderTTL {
+		return p.orderID, nil
+	}
+	if c.pending == nil {
+		c.pending = make(map[string]*pendingSubmission)
+	}
+	c.pending[key] = &pendingSubmission{
+		Exchange:  exchName,
+		Submit:    *s,
+		Submitted: time.Now(),
+	}
+	return "", nil
+}
+
+// resolve records the order placed for a pending submission
+func (c *clientOrders) resolve(exchName, clientID, orderID string) {
+	key := orderKey(exchName, clientID)
+	now := time.Now()
+	c.m.Lock()
+	defer c.m.Unlock()
+	delete(c.pending, key)
+	if c.placed == nil {
+		c.placed = make(map[string]placedClientOrder)
+	}
+	for k, p := range c.placed {
+		if now.Sub(p.added) >= clientOrderTTL {
+			delete(c.placed, k)
+		}
+	}
+	c.placed[key] = placedClientOrder{orderID: orderID, added: now}
+}
+
+// abandon forgets a pending submission which wasn't placed, so its client
+// order ID can be submitted again
+func (c *clientOrders) abandon(exchName, clientID string) {
+	c.m.Lock()
+	delete(c.pending, orderKey(exchName, clientID))
+	c.m.Unlock()
+}
+
+// markUnknown marks a pending submission as failed after reaching the
+// exchange, holding its client order ID until the exchange shows whether it
+// was placed
+func (c *clientOrders) markUnknown(exchName, clientID string) {
+	c.m.Lock()
+	if p, ok := c.pending[orderKey(exchName, clientID)]; ok {
+		p.Unknown = true
+	}
+	c.m.Unlock()
+}
+
+// unknown returns the submissions to an exchange whose outcome is unknown
+func (c *clientOrders) unknown(exchName string) []pendingSubmission {
+	c.m.Lock()
+	defer c.m.Unlock()
+	var resp []pendingSubmission
+	for _, p := range c.pending {
+		if p.Unknown && p.Exchange == exchName {
+			resp = append(resp, *p)
+		}
+	}
+	return resp
+}
+
+// supportsClientOrderIDs returns whether an exchange reports the client order
+// ID orders were submitted with, so submissions can be looked up by it
+func supportsClientOrderIDs(exch exchange.IBotExchange) bool {
+	return exch.GetBase().Features.Supports.RESTCapabilities.ClientOrderID
+}
+
+// rejectsDuplicateClientOrderIDs returns whether an exchange rejects orders
+// submitted with the client order ID of an order it already holds
+func rejectsDuplicateClientOrderIDs(exch exchange.IBotExchange) bool {
+	return exch.GetBase().Features.Supports.RESTCapabilities.ClientOrderIDUnique
+}
+
+// sendOrder submits an order to an exchange. When the exchange supports
+// client order IDs a submission which fails after reaching the exchange is
+// looked up by its client order ID. Exchanges may not list an order they
+// have just accepted, so a submission not found is left unknown for
+// resolveSubmissions to resolve, and is only sent again straight away by
+// exchanges which reject duplicate client order IDs
+func (o *orderManager) sendOrder(ctx context.Context, exch exchange.IBotExchange, s *order.Submit, clientIDs bool) (order.SubmitResponse, error) {
+	exchName := exch.GetName()
+	for attempt := 0; ; attempt++ {
+		result, err := exch.SubmitOrder(ctx, s)
+		if !clientIDs {
+			return result, err
+		}
+		if err == nil {
+			if result.IsOrderPlaced {
+				o.clientOrders.resolve(exchName, s.ClientID, result.OrderID)
+			} else {
+				o.clientOrders.abandon(exchName, s.ClientID)
+			}
+			return result, nil
+		}
+		if !request.IsAmbiguous(err) {
+			o.clientOrders.abandon(exchName, s.ClientID)
+			return result, err
+		}
+
+		d, found, lookupErr := findClientOrder(ctx, exch, s)
+		if found {
+			log.Warnf(log.OrderMgr, "Order manager: Exchange %s submission client ID=%v failed but was placed as order ID=%v: %v\n",
+				exchName, s.ClientID, d.ID, err)
+			o.clientOrders.resolve(exchName, s.ClientID, d.ID)
+			return order.SubmitResponse{OrderID: d.ID, IsOrderPlaced: true}, nil
+		}
+		if lookupErr != nil || !rejectsDuplicateClientOrderIDs(exch) || attempt >= orderSubmissionRetries {
+			o.clientOrders.markUnknown(exchName, s.ClientID)
+			return result, fmt.Errorf("%v: %s client ID=%s: %v", ErrSubmissionUnknown, exchName, s.ClientID, err)
+		}
+		log.Warnf(log.OrderMgr, "Order manager: Exchange %s submission client ID=%v failed and was not placed, retrying: %v\n",
+			exchName, s.ClientID, err)
+	}
+}
+
+// findClientOrder returns the order on an exchange with a submission's client
+// order ID, looking through its active orders then its order history
+func findClientOrder(ctx context.Context, exch exchange.IBotExchange, s *order.Submit) (order.Detail, bool, error) {
+	req := &order.GetOrdersRequest{
+		OrderSide:  order.AnySide,
+		OrderType:  order.AnyType,
+		Currencies: []currency.Pair{s.Pair},
+	}
+	active, err := exch.GetActiveOrders(ctx, req)
+	if err != nil {
+		return order.Detail{}, false, err
+	}
+	for i := range active {
+		if active[i].ClientID == s.ClientID {
+			return active[i], true, nil
+		}
+	}
+	history, err := exch.GetOrderHistory(ctx, req)
+	if err != nil {
+		return order.Detail{}, false, err
+	}
+	for i := range history {
+		if history[i].ClientID == s.ClientID {
+			return history[i], true, nil
+		}
+	}
+	return order.Detail{}, false, nil
+}
+
+// resolveSubmissions looks up the submissions to an exchange whose outcome is
+// unknown by their client order ID. Those found are tracked as placed, and
+// those the exchange still doesn't show once pendingSubmissionTimeout has
+// passed are treated as not placed
+func (o *orderManager) resolveSubmissions(exch exchange.IBotExchange) {
+	exchName := exch.GetName()
+	pending := o.clientOrders.unknown(exchName)
+	for i := range pending {
+		s := &pending[i].Submit
+		d, found, err := findClientOrder(Bot.Context(), exch, s)
+		switch {
+		case err != nil:
+			log.Warnf(log.OrderMgr, "Order manager: Exchange %s unable to look up submission client ID=%v: %v\n",
+				exchName, s.ClientID, err)
+		case found:
+			o.recoverSubmission(exchName, s, &d)
+		case time.Since(pending[i].Submitted) >= pendingSubmissionTimeout:
+			o.clientOrders.abandon(exchName, s.ClientID)
+			o.recordSubmissionOutcome(exchName, s,
+				fmt.Sprintf("Exchange %s submission client ID=%v pair=%v amount=%v was not placed",
+					exchName, s.ClientID, s.Pair, s.Amount))
+		}
+	}
+}
+
+// recoverSubmission tracks the order placed for a submission whose outcome was
+// unknown, reserving its cost again if it is a strategy order
+func (o *orderManager) recoverSubmission(exchName string, s *order.Submit, d *order.Detail) {
+	o.clientOrders.resolve(exchName, s.ClientID, d.ID)
+	d.Exchange = exchName
+	o.orderStore.upsert(d)
+	order.TrackCorrelation(exchName, d.ID, s.CorrelationID)
+	o.setLineage(exchName, d.ID, &OrderLineage{ClientID: s.ClientID})
+	o.partialFills.track(exchName, d.ID, s)
+	if exch := GetExchangeByName(exchName); exch != nil {
+		if emulate, _ := emulatesExpiry(exch, s); emulate {
+			o.expiries.schedule(exchName, d.ID, s)
+		}
+	}
+	if s.StrategyID != "" {
+		r, err := o.strategies.reserve(exchName, s, strategyOrderPrice(exchName, s))
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: Exchange %s order ID=%v unable to reserve strategy %s balance: %v\n",
+				exchName, d.ID, s.StrategyID, err)
+		} else {
+			o.strategies.track(d.ID, r)
+			o.strategies.settle(d)
+		}
+	}
+	o.recordSubmissionOutcome(exchName, s,
+		fmt.Sprintf("Exchange %s submission client ID=%v was placed as order ID=%v pair=%v status=%v",
+			exchName, s.ClientID, d.ID, d.CurrencyPair, d.Status))
+}
+
+// recordSubmissionOutcome logs and audits the resolution of a submission
+// whose outcome was unknown
+func (o *orderManager) recordSubmissionOutcome(exchName string, s *order.Submit, msg string) {
+	log.WithFields(log.OrderMgr, log.Fields{
+		Exchange:      exchName,
+		CorrelationID: s.CorrelationID,
+	}).Warnf("Order manager: %s\n", msg)
+	audit.Event(s.CorrelationID, auditEventOrder, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:     base.EventTypeOrder,
+		Message:  fmt.Sprintf("Order manager: %s.", msg),
+		Severity: base.SeverityWarning,
+	})
+}
@@ -0,0 +1,248 @@
+package engine
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+// clientOrderTestExchange reports client order IDs on its orders. The first
+// submission attempts time out, placing the order or not as set by place.
+// Orders placed by timed out submissions aren't listed until listed is called
+// when delayed is set
+type clientOrderTestExchange struct {
+	exchange.IBotExchange
+	timeouts  int
+	place     bool
+	delayed   bool
+	unique    bool
+	submitted int
+	active    []order.Detail
+	accepted  []order.Detail
+}
+
+// listed lists the orders accepted but not yet listed
+func (e *clientOrderTestExchange) listed() {
+	e.active = append(e.active, e.accepted...)
+	e.accepted = nil
+}
+
+func (e *clientOrderTestExchange) GetName() string {
+	return "clientOrderTest"
+}
+
+func (e *clientOrderTestExchange) GetBase() *exchange.Base {
+	return &exchange.Base{
+		Features: exchange.Features{
+			Supports: exchange.FeaturesSupported{
+				RESTCapabilities: protocol.Features{ClientOrderID: true, ClientOrderIDUnique: e.unique},
+			},
+		},
+	}
+}
+
+func (e *clientOrderTestExchange) SubmitOrder(_ context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	e.submitted++
+	id := fmt.Sprintf("order%d", e.submitted)
+	if e.submitted <= e.timeouts {
+		if e.place {
+			d := order.Detail{ID: id, ClientID: s.ClientID, Status: order.Active}
+			if e.delayed {
+				e.accepted = append(e.accepted, d)
+			} else {
+				e.active = append(e.active, d)
+			}
+		}
+		return order.SubmitResponse{}, &url.Error{Op: "Post", URL: "https://exchange", Err: context.DeadlineExceeded}
+	}
+	e.active = append(e.active, order.Detail{ID: id, ClientID: s.ClientID, Status: order.Active})
+	return order.SubmitResponse{OrderID: id, IsOrderPlaced: true}, nil
+}
+
+func (e *clientOrderTestExchange) GetActiveOrders(_ context.Context, _ *order.GetOrdersRequest) ([]order.Detail, error) {
+	return e.active, nil
+}
+
+func (e *clientOrderTestExchange) GetOrderHistory(_ context.Context, _ *order.GetOrdersRequest) ([]order.Detail, error) {
+	return nil, nil
+}
+
+func clientOrderTestSubmit(clientID string) *order.Submit {
+	return &order.Submit{
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		OrderSide: order.Buy,
+		OrderType: order.Limit,
+		Price:     decimal.NewFromInt(100),
+		Amount:    decimal.NewFromInt(1),
+		ClientID:  clientID,
+	}
+}
+
+func TestClientOrders(t *testing.T) {
+	var c clientOrders
+	s := clientOrderTestSubmit("abc")
+	if id, err := c.begin("Bitstamp", s); err != nil || id != "" {
+		t.Fatalf("expected a new submission, got %v %v", id, err)
+	}
+	_, err := c.begin("bitstamp", s)
+	if err == nil || !strings.HasPrefix(err.Error(), ErrSubmissionPending.Error()) {
+		t.Errorf("expected %v, got %v", ErrSubmissionPending, err)
+	}
+	c.abandon("Bitstamp", "abc")
+	if _, err = c.begin("Bitstamp", s); err != nil {
+		t.Fatalf("expected an abandoned client ID to be submitted again, got %v", err)
+	}
+	c.resolve("Bitstamp", "abc", "1337")
+	if id, err := c.begin("Bitstamp", s); err != nil || id != "1337" {
+		t.Errorf("expected the placed order ID 1337, got %v %v", id, err)
+	}
+}
+
+func TestClientOrdersState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "clientorders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, clientOrderStateFile)
+
+	var c clientOrders
+	if _, err = c.begin("Bitstamp", clientOrderTestSubmit("inflight")); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.begin("Bitstamp", clientOrderTestSubmit("unknown")); err != nil {
+		t.Fatal(err)
+	}
+	c.markUnknown("Bitstamp", "unknown")
+	if err = c.save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	var restored clientOrders
+	if err = restored.load(path); err != nil {
+		t.Fatal(err)
+	}
+	pending := restored.unknown("Bitstamp")
+	if len(pending) != 1 || pending[0].Submit.ClientID != "unknown" ||
+		!pending[0].Submit.Amount.Equal(decimal.NewFromInt(1)) {
+		t.Fatalf("expected only the unknown submission to be restored, got %+v", pending)
+	}
+	if _, err = restored.begin("Bitstamp", clientOrderTestSubmit("unknown")); err == nil {
+		t.Error("expected a restored client ID to be pending")
+	}
+
+	restored.resolve("Bitstamp", "unknown", "1")
+	if err = restored.save(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the state file to be removed, got %v", err)
+	}
+}
+
+func TestOrderManagerSubmitAmbiguous(t *testing.T) {
+	SetupTestHelpers(t)
+	exch := &clientOrderTestExchange{timeouts: 1, place: true}
+	Bot.exchangeManager.add(exch)
+	defer func() {
+		if err := Bot.exchangeManager.removeExchange(exch.GetName()); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// The timed out submission was placed, so isn't sent again
+	var o orderManager
+	resp, err := o.Submit(exch.GetName(), clientOrderTestSubmit(""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.OrderID != "order1" || len(resp.ClientID) != 32 || exch.submitted != 1 {
+		t.Errorf("expected the placed order to be found by its client ID, got %+v after %d submissions",
+			resp, exch.submitted)
+	}
+	again, err := o.Submit(exch.GetName(), clientOrderTestSubmit(resp.ClientID))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if again.OrderID != "order1" || exch.submitted != 1 {
+		t.Errorf("expected resubmitting the client ID to return its order, got %+v", again)
+	}
+
+	// The timed out submission wasn't placed, so is sent again by an exchange
+	// rejecting duplicate client IDs
+	exch.submitted, exch.place, exch.active, exch.unique = 0, false, nil, true
+	resp, err = o.Submit(exch.GetName(), clientOrderTestSubmit("retried"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.OrderID != "order2" || len(exch.active) != 1 {
+		t.Errorf("expected a single order placed on the retry, got %+v %v", resp, exch.active)
+	}
+
+	// Out of retries the outcome is unknown until the orders are processed
+	exch.submitted, exch.timeouts, exch.active = 0, orderSubmissionRetries+1, nil
+	_, err = o.Submit(exch.GetName(), clientOrderTestSubmit("unknown"))
+	if err == nil || !strings.HasPrefix(err.Error(), ErrSubmissionUnknown.Error()) {
+		t.Fatalf("expected %v, got %v", ErrSubmissionUnknown, err)
+	}
+	if _, err = o.Submit(exch.GetName(), clientOrderTestSubmit("unknown")); err == nil ||
+		!strings.HasPrefix(err.Error(), ErrSubmissionPending.Error()) {
+		t.Errorf("expected %v, got %v", ErrSubmissionPending, err)
+	}
+	exch.active = []order.Detail{{ID: "late", ClientID: "unknown", Status: order.Active}}
+	o.resolveSubmissions(exch)
+	if len(o.clientOrders.unknown(exch.GetName())) != 0 {
+		t.Error("expected the submission to be resolved")
+	}
+	resp, err = o.Submit(exch.GetName(), clientOrderTestSubmit("unknown"))
+	if err != nil || resp.OrderID != "late" {
+		t.Errorf("expected the recovered order, got %+v %v", resp, err)
+	}
+}
+
+func TestOrderManagerSubmitAmbiguousNotListed(t *testing.T) {
+	SetupTestHelpers(t)
+	exch := &clientOrderTestExchange{timeouts: 1, place: true, delayed: true}
+	Bot.exchangeManager.add(exch)
+	defer func() {
+		if err := Bot.exchangeManager.removeExchange(exch.GetName()); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// The timed out submission was placed but isn't listed yet, so it is left
+	// unknown rather than sent again
+	var o orderManager
+	_, err := o.Submit(exch.GetName(), clientOrderTestSubmit("slow"))
+	if err == nil || !strings.HasPrefix(err.Error(), ErrSubmissionUnknown.Error()) {
+		t.Fatalf("expected %v, got %v", ErrSubmissionUnknown, err)
+	}
+	if exch.submitted != 1 {
+		t.Fatalf("expected a single submission, got %d", exch.submitted)
+	}
+	o.resolveSubmissions(exch)
+	if len(o.clientOrders.unknown(exch.GetName())) != 1 {
+		t.Fatal("expected the submission to stay unknown until the order is listed")
+	}
+
+	exch.listed()
+	o.resolveSubmissions(exch)
+	if len(o.clientOrders.unknown(exch.GetName())) != 0 {
+		t.Error("expected the submission to be resolved")
+	}
+	resp, err := o.Submit(exch.GetName(), clientOrderTestSubmit("slow"))
+	if err != nil || resp.OrderID != "order1" || exch.submitted != 1 || len(exch.active) != 1 {
+		t.Errorf("expected the order placed once, got %+v %v after %d submissions", resp, err, exch.submitted)
+	}
+}
@@ -0,0 +1,68 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// clientOrderStateFile is the file in the data directory the submissions
+// whose outcome is unknown are written to on shutdown and read from on startup
+const clientOrderStateFile = "pendingorders.json"
+
+const (
+	// orderSubmissionRetries is how many times a submission which failed after
+	// reaching the exchange is sent again, once the exchange shows no order
+	// with its client order ID, on exchanges which reject duplicate client
+	// order IDs
+	orderSubmissionRetries = 2
+	// pendingSubmissionTimeout is how long after a submission whose outcome is
+	// unknown the exchange must show no order with its client order ID before
+	// it is treated as not placed
+	pendingSubmissionTimeout = time.Minute
+	// clientOrderTTL is how long the order placed for a client order ID is
+	// kept, so resubmissions with the client order ID return the order
+	clientOrderTTL = time.Hour * 24
+)
+
+var (
+	// ErrSubmissionPending is returned when an order is submitted with the
+	// client order ID of a submission which is in-flight or whose outcome is
+	// unknown
+	ErrSubmissionPending = errors.New("an order submission with the client order ID is pending")
+	// ErrSubmissionUnknown is returned when a submission failed after reaching
+	// the exchange and the exchange couldn't confirm whether it was placed. It
+	// is resolved when the exchange's orders are next processed
+	ErrSubmissionUnknown = errors.New("order submission outcome is unknown")
+)
+
+// clientOrders tracks submissions by client order ID on exchanges which
+// report client order IDs on their orders, so an order is never placed twice
+// for the same client order ID
+type clientOrders struct {
+	m sync.Mutex
+	// pending holds the in-flight submissions and those whose outcome is
+	// unknown, keyed by exchange and client order ID
+	pending map[string]*pendingSubmission
+	// placed holds the order placed for each client order ID, keyed by
+	// exchange and client order ID
+	placed map[string]placedClientOrder
+}
+
+// pendingSubmission is a submission which hasn't been confirmed as placed or
+// rejected by the exchange
+type pendingSubmission struct {
+	Exchange  string       `json:"exchange"`
+	Submit    order.Submit `json:"submit"`
+	Submitted time.Time    `json:"submitted"`
+	// Unknown is set once the submission has failed after reaching the
+	// exchange
+	Unknown bool `json:"unknown"`
+}
+
+type placedClientOrder struct {
+	orderID string
+	added   time.Time
+}
@@ -0,0 +1,53 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+// commsCommander exposes engine functionality to communication relayers that
+// accept interactive commands
+type commsCommander struct{}
+
+// GetTicker returns the latest ticker for an exchange, pair and asset type
+func (c *commsCommander) GetTicker(exchName string, p currency.Pair, a asset.Item) (*ticker.Price, error) {
+	return GetSpecificTicker(p, exchName, a)
+}
+
+// GetOpenOrders returns all open orders for an exchange
+func (c *commsCommander) GetOpenOrders(exchName string) ([]order.Detail, error) {
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, ErrExchangeNotFound
+	}
+
+	if !exch.GetAuthenticatedAPISupport(exchange.RestAuthentication) {
+		return nil, errors.New("exchange does not have authenticated API support enabled")
+	}
+
+	return exch.GetActiveOrders(Bot.Context(), &order.GetOrdersRequest{
+		OrderSide: order.AnySide,
+		OrderType: order.AnyType,
+	})
+}
+
+// CancelOrder cancels an order by ID via the order manager
+func (c *commsCommander) CancelOrder(exchName, orderID string) error {
+	return Bot.OrderManager.Cancel(exchName, &order.Cancel{OrderID: orderID})
+}
+
+// KillSwitch cancels all open orders across every authenticated exchange
+func (c *commsCommander) KillSwitch() error {
+	return Bot.OrderManager.CancelAllOrders(nil)
+}
+
+// ApproveWithdrawal approves a transfer pending approval and sends it
+func (c *commsCommander) ApproveWithdrawal(id, approver string) error {
+	_, err := Bot.TransferManager.Approve(Bot.Context(), id, approver)
+	return err
+}
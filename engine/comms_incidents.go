@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// Incident keys used to open and resolve incidents with incident alerting
+// mediums such as PagerDuty and Opsgenie
+const (
+	IncidentDatabaseDown           = "gct_database_down"
+	IncidentWebsocketsDisconnected = "gct_websockets_disconnected"
+	IncidentOrderRejections        = "gct_order_rejections"
+	IncidentResourceLeak           = "gct_resource_leak"
+	IncidentSubsystemCrashed       = "gct_subsystem_crashed"
+)
+
+const (
+	incidentCheckInterval = time.Minute
+	// maxConsecutiveOrderRejections is the amount of consecutive failed order
+	// submissions before an incident is opened
+	maxConsecutiveOrderRejections = 5
+)
+
+// incidentTracker keeps track of open incidents so that each condition only
+// triggers and resolves once per occurrence
+type incidentTracker struct {
+	mtx  sync.Mutex
+	open map[string]struct{}
+}
+
+// setState marks an incident open or resolved and returns whether its state
+// changed
+func (i *incidentTracker) setState(key string, open bool) bool {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+	if i.open == nil {
+		i.open = make(map[string]struct{})
+	}
+	_, ok := i.open[key]
+	if ok == open {
+		return false
+	}
+	if open {
+		i.open[key] = struct{}{}
+	} else {
+		delete(i.open, key)
+	}
+	return true
+}
+
+// TriggerIncident opens an incident if one is not already open for the key
+func (c *commsManager) TriggerIncident(key, msg string) {
+	if !c.Started() || !c.incidents.setState(key, true) {
+		return
+	}
+	log.Errorf(log.CommunicationMgr, "Incident %s opened: %s\n", key, msg)
+	c.PushEvent(base.Event{
+		Type:        base.EventTypeError,
+		Message:     msg,
+		Severity:    base.SeverityCritical,
+		IncidentKey: key,
+	})
+}
+
+// ResolveIncident resolves an open incident for the key
+func (c *commsManager) ResolveIncident(key, msg string) {
+	if !c.Started() || !c.incidents.setState(key, false) {
+		return
+	}
+	log.Infof(log.CommunicationMgr, "Incident %s resolved: %s\n", key, msg)
+	c.PushEvent(base.Event{
+		Type:        base.EventTypeError,
+		Message:     msg,
+		Severity:    base.SeverityInfo,
+		IncidentKey: key,
+		Resolved:    true,
+	})
+}
+
+// checkWebsockets opens an incident when every enabled exchange websocket is
+// disconnected and resolves it once any reconnects
+func (c *commsManager) checkWebsockets() {
+	var enabled, connected int
+	exchanges := GetExchanges()
+	for i := range exchanges {
+		if !exchanges[i].SupportsWebsocket() || !exchanges[i].IsWebsocketEnabled() {
+			continue
+		}
+		ws, err := exchanges[i].GetWebsocket()
+		if err != nil {
+			continue
+		}
+		enabled++
+		if ws.IsConnected() || ws.IsConnecting() {
+			connected++
+		}
+	}
+
+	if enabled == 0 {
+		return
+	}
+	if connected == 0 {
+		c.TriggerIncident(IncidentWebsocketsDisconnected,
+			fmt.Sprintf("All %d enabled exchange websockets are disconnected", enabled))
+		return
+	}
+	c.ResolveIncident(IncidentWebsocketsDisconnected,
+		fmt.Sprintf("%d of %d exchange websockets connected", connected, enabled))
+}
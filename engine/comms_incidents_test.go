@@ -0,0 +1,19 @@
+package engine
+
+import "testing"
+
+func TestIncidentTrackerSetState(t *testing.T) {
+	var i incidentTracker
+	if i.setState(IncidentDatabaseDown, false) {
+		t.Error("resolving an incident that is not open should not change state")
+	}
+	if !i.setState(IncidentDatabaseDown, true) {
+		t.Error("expected incident to be opened")
+	}
+	if i.setState(IncidentDatabaseDown, true) {
+		t.Error("an open incident should only be triggered once")
+	}
+	if !i.setState(IncidentDatabaseDown, false) {
+		t.Error("expected incident to be resolved")
+	}
+}
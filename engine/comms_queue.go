@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/commsqueue"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const (
+	commsRetryInterval  = time.Second * 30
+	commsRetryBaseDelay = time.Second * 30
+	commsRetryMaxDelay  = time.Hour
+	commsRetryBatchSize = 50
+)
+
+// commsRetryQueue stores undeliverable communication events in the database
+// so they can be redelivered once the medium recovers
+type commsRetryQueue struct {
+	maxAttempts int
+}
+
+// retryDelay returns the exponential backoff delay after a number of failed
+// delivery attempts
+func retryDelay(attempts int) time.Duration {
+	delay := commsRetryBaseDelay
+	for i := 1; i < attempts; i++ {
+		delay *= 2
+		if delay >= commsRetryMaxDelay {
+			return commsRetryMaxDelay
+		}
+	}
+	return delay
+}
+
+func databaseConnected() bool {
+	if dbConn == nil {
+		return false
+	}
+	dbConn.Mu.RLock()
+	defer dbConn.Mu.RUnlock()
+	return dbConn.Connected
+}
+
+// Enqueue stores an event which failed to send to a medium
+func (q *commsRetryQueue) Enqueue(medium string, event base.Event, err error) {
+	if !databaseConnected() {
+		log.Errorf(log.CommunicationMgr, "Communications retry queue: Database unavailable, dropping %s event for %s: %v\n",
+			event.Type, medium, err)
+		return
+	}
+
+	errInsert := commsqueue.Insert(&commsqueue.Entry{
+		Medium:        medium,
+		EventType:     event.Type,
+		Message:       event.Message,
+		Severity:      event.Severity.String(),
+		IncidentKey:   event.IncidentKey,
+		Resolved:      event.Resolved,
+		Attempts:      1,
+		LastError:     err.Error(),
+		NextAttemptAt: time.Now().Add(retryDelay(1)),
+	})
+	if errInsert != nil {
+		log.Errorf(log.CommunicationMgr, "Communications retry queue: Unable to store %s event for %s: %v\n",
+			event.Type, medium, errInsert)
+		return
+	}
+	log.Warnf(log.CommunicationMgr, "Communications retry queue: %s event for %s queued for redelivery: %v\n",
+		event.Type, medium, err)
+}
+
+// retryQueued redelivers queued events which are due, rescheduling those
+// which fail again until the maximum attempts is reached
+func (c *commsManager) retryQueued() {
+	if !databaseConnected() {
+		return
+	}
+
+	entries, err := commsqueue.GetDue(time.Now(), commsRetryBatchSize)
+	if err != nil {
+		log.Errorf(log.CommunicationMgr, "Communications retry queue: Unable to fetch queued events: %v\n", err)
+		return
+	}
+
+	for i := range entries {
+		severity, errSeverity := base.GetSeverity(entries[i].Severity)
+		if errSeverity != nil {
+			log.Warnf(log.CommunicationMgr, "Communications retry queue: %v, sending as %s\n", errSeverity, severity)
+		}
+		err = c.comms.PushEventToMedium(entries[i].Medium, base.Event{
+			Type:        entries[i].EventType,
+			Message:     entries[i].Message,
+			Severity:    severity,
+			IncidentKey: entries[i].IncidentKey,
+			Resolved:    entries[i].Resolved,
+		})
+		if err == nil {
+			log.Infof(log.CommunicationMgr, "Communications retry queue: Redelivered %s event to %s after %d attempts\n",
+				entries[i].EventType, entries[i].Medium, entries[i].Attempts)
+			c.removeQueued(entries[i].ID)
+			continue
+		}
+
+		entries[i].Attempts++
+		if entries[i].Attempts >= c.retry.maxAttempts {
+			log.Errorf(log.CommunicationMgr, "Communications retry queue: Dropping %s event for %s after %d attempts: %v\n",
+				entries[i].EventType, entries[i].Medium, entries[i].Attempts, err)
+			c.removeQueued(entries[i].ID)
+			continue
+		}
+
+		entries[i].LastError = err.Error()
+		entries[i].NextAttemptAt = time.Now().Add(retryDelay(entries[i].Attempts))
+		err = commsqueue.UpdateAttempt(&entries[i])
+		if err != nil {
+			log.Errorf(log.CommunicationMgr, "Communications retry queue: Unable to reschedule queued event: %v\n", err)
+		}
+	}
+}
+
+func (c *commsManager) removeQueued(id int64) {
+	err := commsqueue.Delete(id)
+	if err != nil {
+		log.Errorf(log.CommunicationMgr, "Communications retry queue: Unable to remove queued event: %v\n", err)
+	}
+}
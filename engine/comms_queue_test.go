@@ -0,0 +1,15 @@
+package engine
+
+import "testing"
+
+func TestRetryDelay(t *testing.T) {
+	if retryDelay(1) != commsRetryBaseDelay {
+		t.Errorf("expected first retry after %v got %v", commsRetryBaseDelay, retryDelay(1))
+	}
+	if retryDelay(3) != commsRetryBaseDelay*4 {
+		t.Errorf("expected backoff to double per attempt, got %v", retryDelay(3))
+	}
+	if retryDelay(100) != commsRetryMaxDelay {
+		t.Errorf("expected backoff capped at %v got %v", commsRetryMaxDelay, retryDelay(100))
+	}
+}
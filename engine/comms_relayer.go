@@ -3,19 +3,30 @@ package engine
 import (
 	"errors"
 	"sync/atomic"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/communications"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
+const (
+	// commsFlushInterval is how often coalesced event summaries are sent
+	commsFlushInterval = time.Second * 10
+	commsManagerName   = "communications manager"
+)
+
 // commsManager starts the NTP manager
 type commsManager struct {
-	started  int32
-	stopped  int32
-	shutdown chan struct{}
-	relayMsg chan base.Event
-	comms    *communications.Communications
+	started   int32
+	stopped   int32
+	shutdown  chan struct{}
+	done      chan struct{}
+	relayMsg  chan base.Event
+	comms     *communications.Communications
+	incidents incidentTracker
+	retry     *commsRetryQueue
 }
 
 func (c *commsManager) Started() bool {
@@ -39,8 +50,14 @@ func (c *commsManager) Start() (err error) {
 	if err != nil {
 		return err
 	}
+	c.comms.SetCommander(&commsCommander{})
+	if commsCfg.RetryQueue.Enabled {
+		c.retry = &commsRetryQueue{maxAttempts: commsCfg.RetryQueue.MaxAttempts}
+		c.comms.SetRetryQueue(c.retry)
+	}
 
 	c.shutdown = make(chan struct{})
+	c.done = make(chan struct{})
 	c.relayMsg = make(chan base.Event)
 	go c.run()
 	log.Debugln(log.CommunicationMgr, "Communications manager started.")
@@ -69,6 +86,7 @@ func (c *commsManager) Stop() error {
 }
 
 func (c *commsManager) PushEvent(evt base.Event) {
+	recordCrashEvent(&evt)
 	if !c.Started() {
 		return
 	}
@@ -76,18 +94,43 @@ func (c *commsManager) PushEvent(evt base.Event) {
 }
 
 func (c *commsManager) run() {
+	defer errorreport.Recover()
 	defer func() {
 		// TO-DO shutdown comms connections for connected services (Slack etc)
 		atomic.CompareAndSwapInt32(&c.stopped, 1, 0)
 		atomic.CompareAndSwapInt32(&c.started, 1, 0)
 		log.Debugln(log.CommunicationMgr, "Communications manager shutdown.")
+		close(c.done)
 	}()
 
+	t := time.NewTicker(incidentCheckInterval)
+	defer t.Stop()
+
+	flush := time.NewTicker(commsFlushInterval)
+	defer flush.Stop()
+
+	var retry <-chan time.Time
+	if c.retry != nil {
+		rt := time.NewTicker(commsRetryInterval)
+		defer rt.Stop()
+		retry = rt.C
+	}
+
 	for {
 		select {
 		case msg := <-c.relayMsg:
-			c.comms.PushEvent(msg)
+			guard(commsManagerName, func() { c.comms.PushEvent(msg) })
+		case <-t.C:
+			// Checks push events through relayMsg so cannot block this routine
+			go guard(commsManagerName, c.checkWebsockets)
+		case <-flush.C:
+			guard(commsManagerName, c.comms.FlushCoalesced)
+		case <-retry:
+			guard(commsManagerName, c.retryQueued)
 		case <-c.shutdown:
+			// Send the pending summaries, those which fail are written to
+			// the retry queue if enabled
+			guard(commsManagerName, c.comms.FlushCoalesced)
 			return
 		}
 	}
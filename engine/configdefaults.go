@@ -0,0 +1,49 @@
+package engine
+
+import (
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// DefaultConfig returns the defaults of a config's settings, being an empty
+// config with the defaults of the config's exchanges, checked as a config is
+// when loaded. Exchanges which aren't supported are left out.
+func DefaultConfig(cfg *config.Config) (*config.Config, error) {
+	defaults := new(config.Config)
+	for i := range cfg.Exchanges {
+		exchCfg, err := DefaultExchangeConfig(cfg.Exchanges[i].Name)
+		if err != nil {
+			continue
+		}
+		defaults.Exchanges = append(defaults.Exchanges, *exchCfg)
+	}
+	err := defaults.CheckConfig()
+	if err != nil {
+		return nil, err
+	}
+	return defaults, nil
+}
+
+// DefaultExchangeConfig returns an exchange's default config without its
+// tradable pairs, which are fetched from the exchange
+func DefaultExchangeConfig(name string) (*config.ExchangeConfig, error) {
+	exch, err := newExchange(strings.ToLower(name))
+	if err != nil {
+		return nil, err
+	}
+	exch.SetDefaults()
+	b := exch.GetBase()
+	exchCfg := &config.ExchangeConfig{
+		Name:           b.Name,
+		Enabled:        b.Enabled,
+		HTTPTimeout:    exchange.DefaultHTTPTimeout,
+		BaseCurrencies: b.BaseCurrencies,
+	}
+	err = b.SetupDefaults(exchCfg)
+	if err != nil {
+		return nil, err
+	}
+	return exchCfg, nil
+}
@@ -0,0 +1,64 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestDefaultConfig(t *testing.T) {
+	var cfg config.Config
+	err := cfg.LoadConfig(config.TestFile, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg.Exchanges = append(cfg.Exchanges, config.ExchangeConfig{Name: "MtGox"})
+	defaults, err := DefaultConfig(&cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(defaults.Exchanges) != len(cfg.Exchanges)-1 {
+		t.Errorf("expected defaults for %d exchanges, received %d", len(cfg.Exchanges)-1, len(defaults.Exchanges))
+	}
+	if defaults.GlobalHTTPTimeout != cfg.GlobalHTTPTimeout {
+		t.Error("defaults should be checked")
+	}
+
+	exchCfg, err := cfg.GetExchangeConfig("Binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	exchCfg.HTTPTimeout = time.Minute
+	diffs, err := cfg.Diff(defaults)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var found bool
+	for i := range diffs {
+		switch diffs[i].Path {
+		case "exchanges[Binance].httpTimeout":
+			found = true
+		case "globalHTTPTimeout", "exchanges[Binance].enabled":
+			t.Errorf("unexpected difference %+v", diffs[i])
+		}
+	}
+	if !found {
+		t.Error("expected Binance HTTP timeout difference")
+	}
+}
+
+func TestDefaultExchangeConfig(t *testing.T) {
+	t.Parallel()
+	exchCfg, err := DefaultExchangeConfig("Bitstamp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if exchCfg.Name != "Bitstamp" || exchCfg.CurrencyPairs == nil || exchCfg.Features == nil {
+		t.Errorf("unexpected default config %+v", exchCfg)
+	}
+	_, err = DefaultExchangeConfig("MtGox")
+	if err != ErrExchangeNotFound {
+		t.Errorf("expected %v, received %v", ErrExchangeNotFound, err)
+	}
+}
@@ -0,0 +1,139 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"runtime"
+	"runtime/debug"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// record adds an event to the recent events included in crash dumps
+func (c *crashHandler) record(evt *base.Event) {
+	e := crashEvent{
+		Time:     time.Now().UTC(),
+		Type:     evt.Type,
+		Severity: evt.Severity.String(),
+		Message:  evt.Message,
+	}
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if len(c.events) < maxCrashEvents {
+		c.events = append(c.events, e)
+		return
+	}
+	c.events[c.next] = e
+	c.next = (c.next + 1) % maxCrashEvents
+}
+
+// recentEvents returns the recorded events, oldest first
+func (c *crashHandler) recentEvents() []crashEvent {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	events := make([]crashEvent, 0, len(c.events))
+	events = append(events, c.events[c.next:]...)
+	return append(events, c.events[:c.next]...)
+}
+
+// write writes the crash dump to the crash dump directory, returning its path
+func (c *crashHandler) write(report *crashReport) (string, error) {
+	if c.dir == "" {
+		return "", nil
+	}
+	data, err := json.MarshalIndent(report, "", " ")
+	if err != nil {
+		return "", err
+	}
+	name := fmt.Sprintf("crash-%s-%s.json",
+		report.Time.Format("20060102T150405.000000000"),
+		strings.Replace(strings.ToLower(report.Subsystem), " ", "_", -1))
+	path := filepath.Join(c.dir, name)
+	return path, file.Write(path, data)
+}
+
+// recordCrashEvent records an event for crash dumps
+func recordCrashEvent(evt *base.Event) {
+	if Bot == nil {
+		return
+	}
+	Bot.crashes.record(evt)
+}
+
+// guard runs fn, recovering a panic in it so the crash is confined to that
+// unit of work instead of taking down the bot. It returns whether fn panicked.
+func guard(subsystem string, fn func()) (crashed bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			crashed = true
+			handleCrash(subsystem, r, debug.Stack())
+		}
+	}()
+	fn()
+	return false
+}
+
+// supervise runs fn in a new routine, restarting it after a crash up to
+// maxSubsystemRestarts times before leaving it stopped and opening an
+// incident. fn returning without panicking stops supervision.
+func supervise(subsystem string, fn func()) {
+	go func() {
+		for restarts := 0; guard(subsystem, fn); restarts++ {
+			if restarts == maxSubsystemRestarts {
+				msg := fmt.Sprintf("Subsystem %s crashed %d times and has been left stopped",
+					subsystem, restarts+1)
+				log.Errorln(log.Global, msg)
+				if Bot != nil {
+					atomic.AddInt32(&Bot.crashes.stopped, 1)
+					Bot.CommsManager.TriggerIncident(IncidentSubsystemCrashed, msg)
+				}
+				return
+			}
+			delay := subsystemRestartDelay * time.Duration(restarts+1)
+			log.Warnf(log.Global, "Restarting subsystem %s in %v after crash\n", subsystem, delay)
+			time.Sleep(delay)
+		}
+	}()
+}
+
+// handleCrash writes a crash dump for a recovered panic, records it in the
+// audit log, reports it and alerts on it
+func handleCrash(subsystem string, r interface{}, stack []byte) {
+	report := crashReport{
+		Subsystem:  subsystem,
+		Time:       time.Now().UTC(),
+		Panic:      fmt.Sprint(r),
+		Stack:      string(stack),
+		Goroutines: runtime.NumGoroutine(),
+	}
+	log.Errorf(log.Global, "Subsystem %s crashed: %v\n%s", subsystem, r, stack)
+	errorreport.CapturePanic(r, map[string]string{"subsystem": subsystem})
+	if Bot == nil {
+		return
+	}
+
+	report.RecentEvents = Bot.crashes.recentEvents()
+	msg := fmt.Sprintf("Subsystem %s crashed: %v", subsystem, r)
+	path, err := Bot.crashes.write(&report)
+	if err != nil {
+		log.Errorf(log.Global, "Unable to write crash dump for subsystem %s. Error: %v\n", subsystem, err)
+	} else if path != "" {
+		msg += ", crash dump written to " + path
+	}
+	audit.Event(subsystem, auditEventCrash, msg)
+	// Pushed from a new routine as the crashed subsystem may be the one
+	// relaying events
+	go Bot.CommsManager.PushEvent(base.Event{
+		Type:     base.EventTypeError,
+		Message:  msg,
+		Severity: base.SeverityCritical,
+	})
+}
@@ -0,0 +1,114 @@
+package engine
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+)
+
+func TestCrashHandlerRecord(t *testing.T) {
+	t.Parallel()
+	var c crashHandler
+	for i := 0; i < maxCrashEvents+5; i++ {
+		c.record(&base.Event{Message: strconv.Itoa(i)})
+	}
+	events := c.recentEvents()
+	if len(events) != maxCrashEvents {
+		t.Fatalf("expected %d events, got %d", maxCrashEvents, len(events))
+	}
+	if events[0].Message != "5" || events[maxCrashEvents-1].Message != strconv.Itoa(maxCrashEvents+4) {
+		t.Errorf("expected oldest events to be dropped, got %s to %s",
+			events[0].Message, events[maxCrashEvents-1].Message)
+	}
+}
+
+func TestGuard(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	dir, err := ioutil.TempDir("", "crashes")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	crashDir := Bot.crashes.dir
+	Bot.crashes.dir = dir
+	defer func() { Bot.crashes.dir = crashDir }()
+
+	if guard("test", func() {}) {
+		t.Error("expected no crash")
+	}
+
+	recordCrashEvent(&base.Event{Type: base.EventTypeOrder, Message: "order placed"})
+	if !guard("test subsystem", func() { panic("boom") }) {
+		t.Fatal("expected crash")
+	}
+
+	dumps, err := filepath.Glob(filepath.Join(dir, "crash-*-test_subsystem.json"))
+	if err != nil || len(dumps) != 1 {
+		t.Fatalf("expected one crash dump, got %v %v", dumps, err)
+	}
+	data, err := ioutil.ReadFile(dumps[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+	var report crashReport
+	err = json.Unmarshal(data, &report)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if report.Subsystem != "test subsystem" || report.Panic != "boom" || report.Stack == "" {
+		t.Errorf("unexpected crash report %+v", report)
+	}
+	if len(report.RecentEvents) == 0 ||
+		report.RecentEvents[len(report.RecentEvents)-1].Message != "order placed" {
+		t.Errorf("expected recent events in crash report, got %+v", report.RecentEvents)
+	}
+}
+
+func TestSupervise(t *testing.T) {
+	restartDelay := subsystemRestartDelay
+	subsystemRestartDelay = time.Millisecond
+	defer func() { subsystemRestartDelay = restartDelay }()
+
+	var runs int32
+	done := make(chan struct{})
+	supervise("test", func() {
+		if atomic.AddInt32(&runs, 1) < 3 {
+			panic("boom")
+		}
+		close(done)
+	})
+
+	select {
+	case <-done:
+	case <-time.After(time.Second * 5):
+		t.Fatal("subsystem was not restarted after crashing")
+	}
+	if r := atomic.LoadInt32(&runs); r != 3 {
+		t.Errorf("expected 3 runs, got %d", r)
+	}
+}
+
+func TestEngineAlive(t *testing.T) {
+	t.Parallel()
+	var e Engine
+	if e.Alive() {
+		t.Error("expected engine which isn't started to not be alive")
+	}
+	atomic.StoreInt32(&e.started, 1)
+	if !e.Alive() {
+		t.Error("expected started engine to be alive")
+	}
+	atomic.AddInt32(&e.crashes.stopped, 1)
+	if e.Alive() {
+		t.Error("expected engine with a stopped subsystem to not be alive")
+	}
+}
@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// crashDumpDir is the directory in the data directory crash dumps are
+	// written to
+	crashDumpDir = "crashes"
+	// auditEventCrash is the audit event type of subsystem crashes,
+	// identified by the subsystem name
+	auditEventCrash = "crash"
+	// maxCrashEvents is the amount of recent communication events kept for
+	// crash dumps
+	maxCrashEvents = 50
+	// maxSubsystemRestarts is how many times a supervised routine is
+	// restarted after crashing before it's left stopped
+	maxSubsystemRestarts = 5
+)
+
+// subsystemRestartDelay is how long a supervised routine is left stopped
+// after crashing, multiplied by the amount of times it has crashed
+var subsystemRestartDelay = time.Second
+
+// crashHandler writes crash dumps for subsystem panics and keeps the recent
+// events included in them
+type crashHandler struct {
+	dir    string
+	mtx    sync.Mutex
+	events []crashEvent
+	next   int
+	// stopped is the amount of supervised subsystems left stopped after
+	// crashing too many times
+	stopped int32
+}
+
+// crashEvent is a communication event recorded for crash dumps
+type crashEvent struct {
+	Time     time.Time `json:"time"`
+	Type     string    `json:"type"`
+	Severity string    `json:"severity"`
+	Message  string    `json:"message"`
+}
+
+// crashReport is a structured crash dump of a subsystem panic
+type crashReport struct {
+	Subsystem    string       `json:"subsystem"`
+	Time         time.Time    `json:"time"`
+	Panic        string       `json:"panic"`
+	Stack        string       `json:"stack"`
+	Goroutines   int          `json:"goroutines"`
+	RecentEvents []crashEvent `json:"recentEvents"`
+}
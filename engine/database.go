@@ -128,11 +128,15 @@ func (a *databaseManager) checkConnection() {
 	if err != nil {
 		log.Errorf(log.DatabaseMgr, "Database connection error: %v\n", err)
 		dbConn.Connected = false
+		Bot.CommsManager.TriggerIncident(IncidentDatabaseDown,
+			fmt.Sprintf("Database connection error: %v", err))
 		return
 	}
 
 	if !dbConn.Connected {
 		log.Info(log.DatabaseMgr, "Database connection reestablished")
 		dbConn.Connected = true
+		Bot.CommsManager.ResolveIncident(IncidentDatabaseDown,
+			"Database connection reestablished")
 	}
 }
@@ -0,0 +1,83 @@
+package engine
+
+import (
+	"expvar"
+	"net/http"
+	"net/http/pprof"
+	"runtime"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var publishExpvarsOnce sync.Once
+
+// StartDebugServer starts a HTTP server exposing pprof profiles on
+// /debug/pprof and expvar variables on /debug/vars
+func StartDebugServer() {
+	listenAddr := Bot.Config.Profiler.ListenAddress
+	log.Debugf(log.RESTSys,
+		"Debug server support enabled. pprof: http://%s:%d/debug/pprof expvar: http://%s:%d/debug/vars\n",
+		common.ExtractHost(listenAddr), common.ExtractPort(listenAddr),
+		common.ExtractHost(listenAddr), common.ExtractPort(listenAddr))
+	err := http.ListenAndServe(listenAddr, newDebugMux())
+	if err != nil {
+		log.Errorf(log.RESTSys, "Failed to start debug server. Err: %s", err)
+	}
+}
+
+// newDebugMux sets the profile rates and returns a multiplexor serving pprof
+// and expvar
+func newDebugMux() *http.ServeMux {
+	setupProfiler()
+	publishExpvars()
+
+	mux := http.NewServeMux()
+	mux.HandleFunc("/debug/pprof/", pprof.Index)
+	mux.HandleFunc("/debug/pprof/cmdline", pprof.Cmdline)
+	mux.HandleFunc("/debug/pprof/profile", pprof.Profile)
+	mux.HandleFunc("/debug/pprof/symbol", pprof.Symbol)
+	mux.HandleFunc("/debug/pprof/trace", pprof.Trace)
+	mux.Handle("/debug/vars", expvar.Handler())
+	return mux
+}
+
+// setupProfiler enables mutex and block profiling if configured
+func setupProfiler() {
+	if Bot.Config.Profiler.MutexProfileFraction > 0 {
+		runtime.SetMutexProfileFraction(Bot.Config.Profiler.MutexProfileFraction)
+	}
+	if Bot.Config.Profiler.BlockProfileRate > 0 {
+		runtime.SetBlockProfileRate(Bot.Config.Profiler.BlockProfileRate)
+	}
+}
+
+// publishExpvars publishes engine state alongside the default memstats and
+// cmdline variables, expvar panics on duplicate names so this only runs once
+func publishExpvars() {
+	publishExpvarsOnce.Do(func() {
+		expvar.Publish("goroutines", expvar.Func(func() interface{} {
+			return runtime.NumGoroutine()
+		}))
+		expvar.Publish("uptime_seconds", expvar.Func(func() interface{} {
+			if Bot == nil || Bot.Uptime.IsZero() {
+				return 0
+			}
+			return int64(time.Since(Bot.Uptime).Seconds())
+		}))
+		expvar.Publish("subsystems", expvar.Func(func() interface{} {
+			if Bot == nil {
+				return nil
+			}
+			return GetSubsystemsStatus()
+		}))
+		expvar.Publish("exchanges_loaded", expvar.Func(func() interface{} {
+			if Bot == nil {
+				return 0
+			}
+			return Bot.exchangeManager.Len()
+		}))
+	})
+}
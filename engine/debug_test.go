@@ -0,0 +1,37 @@
+package engine
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestDebugMux(t *testing.T) {
+	SetupTestHelpers(t)
+	mux := newDebugMux()
+
+	resp := httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/debug/pprof/", nil))
+	if resp.Code != http.StatusOK {
+		t.Errorf("expected status %d, received %d", http.StatusOK, resp.Code)
+	}
+
+	resp = httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/debug/vars", nil))
+	if resp.Code != http.StatusOK {
+		t.Fatalf("expected status %d, received %d", http.StatusOK, resp.Code)
+	}
+	var vars map[string]interface{}
+	if err := json.Unmarshal(resp.Body.Bytes(), &vars); err != nil {
+		t.Fatal(err)
+	}
+	for _, v := range []string{"goroutines", "uptime_seconds", "subsystems", "exchanges_loaded", "memstats"} {
+		if _, ok := vars[v]; !ok {
+			t.Errorf("expected expvar %s to be published", v)
+		}
+	}
+
+	// Building the multiplexor again must not publish duplicate expvars
+	newDebugMux()
+}
@@ -0,0 +1,195 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/defi"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (d *defiManager) Started() bool {
+	return atomic.LoadInt32(&d.started) == 1
+}
+
+func (d *defiManager) Start() error {
+	if atomic.AddInt32(&d.started, 1) != 1 {
+		return errors.New("defi manager already started")
+	}
+
+	cfg := Bot.Config.DeFi
+	venues, err := defiVenues(cfg.Venues)
+	if err != nil {
+		atomic.CompareAndSwapInt32(&d.started, 1, 0)
+		return err
+	}
+	d.cfg = cfg
+	d.venues = venues
+	d.quotes = make(map[string][]defiQuote)
+	d.shutdown = make(chan struct{})
+	go d.run()
+	log.Debugf(log.Global, "DeFi manager started, quoting %d pairs on %d venues every %v.\n",
+		len(cfg.Pairs), len(venues), cfg.Interval)
+	return nil
+}
+
+func (d *defiManager) Stop() error {
+	if atomic.LoadInt32(&d.started) == 0 {
+		return errDeFiManagerNotStarted
+	}
+
+	if atomic.AddInt32(&d.stopped, 1) != 1 {
+		return errors.New("defi manager is already stopped")
+	}
+
+	close(d.shutdown)
+	log.Debugln(log.Global, "DeFi manager shutting down...")
+	return nil
+}
+
+func (d *defiManager) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(d.cfg.Interval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&d.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&d.started, 1, 0)
+		log.Debugln(log.Global, "DeFi manager shutdown.")
+	}()
+
+	guard(defiManagerName, d.refresh)
+	for {
+		select {
+		case <-d.shutdown:
+			return
+		case <-t.C:
+			guard(defiManagerName, d.refresh)
+		}
+	}
+}
+
+// defiVenues returns the enabled venues of the config
+func defiVenues(cfg []config.DeFiVenueConfig) ([]defi.Venue, error) {
+	var venues []defi.Venue
+	for i := range cfg {
+		if !cfg[i].Enabled {
+			continue
+		}
+		tokens := make([]defi.Token, len(cfg[i].Tokens))
+		for j := range cfg[i].Tokens {
+			tokens[j] = defi.Token{
+				Symbol:   cfg[i].Tokens[j].Symbol,
+				Address:  cfg[i].Tokens[j].Address,
+				Decimals: cfg[i].Tokens[j].Decimals,
+			}
+		}
+		v, err := defi.NewVenue(&defi.Settings{
+			Name:    cfg[i].Name,
+			APIURL:  cfg[i].APIURL,
+			APIKey:  cfg[i].APIKey,
+			ChainID: cfg[i].ChainID,
+			Tokens:  tokens,
+			Verbose: cfg[i].Verbose,
+		})
+		if err != nil {
+			return nil, err
+		}
+		venues = append(venues, v)
+	}
+	if len(venues) == 0 {
+		return nil, errors.New("no defi venues enabled")
+	}
+	return venues, nil
+}
+
+// refresh quotes every pair on every venue, a venue's quotes replacing its
+// previous ones. Pairs which can't be quoted are logged and left out
+func (d *defiManager) refresh() {
+	for _, v := range d.venues {
+		var quotes []defiQuote
+		for i := range d.cfg.Pairs {
+			p := currency.NewPairFromString(d.cfg.Pairs[i].Pair)
+			q, err := venueQuote(Bot.Context(), v, p, d.cfg.Pairs[i].Amount)
+			if err != nil {
+				log.Errorf(log.Global, "DeFi manager unable to quote %s %s: %v\n", v.GetName(), p, err)
+				continue
+			}
+			stats.Add(v.GetName(), p, asset.Spot, (q.Bid+q.Ask)/2, d.cfg.Pairs[i].Amount)
+			quotes = append(quotes, defiQuote{quote: q, updated: time.Now()})
+		}
+		d.m.Lock()
+		d.quotes[v.GetName()] = quotes
+		d.m.Unlock()
+	}
+}
+
+// venueQuote prices a pair on a venue by quoting the sale of an amount of its
+// base currency and buying back with the proceeds. Quotes are net of the
+// venue's fees so its fee rate is zero
+func venueQuote(ctx context.Context, v defi.Venue, p currency.Pair, amount float64) (arbitrageQuote, error) {
+	sell, err := v.GetQuote(ctx, p.Base, p.Quote, amount)
+	if err != nil {
+		return arbitrageQuote{}, err
+	}
+	buy, err := v.GetQuote(ctx, p.Quote, p.Base, sell.AmountOut)
+	if err != nil {
+		return arbitrageQuote{}, err
+	}
+	if sell.AmountOut <= 0 || buy.AmountOut <= 0 {
+		return arbitrageQuote{}, fmt.Errorf("%s %s has no liquidity", v.GetName(), p)
+	}
+	return arbitrageQuote{
+		Exchange:  v.GetName(),
+		Pair:      p,
+		Bid:       sell.Price,
+		BidAmount: amount,
+		Ask:       sell.AmountOut / buy.AmountOut,
+		AskAmount: buy.AmountOut,
+	}, nil
+}
+
+// IsVenue returns whether a name is one of the enabled on-chain venues
+func (d *defiManager) IsVenue(name string) bool {
+	if !d.Started() {
+		return false
+	}
+	for _, v := range d.venues {
+		if strings.EqualFold(v.GetName(), name) {
+			return true
+		}
+	}
+	return false
+}
+
+// arbitrageQuotes returns the quotes of the named venues, or every venue if
+// none are named, which are recent enough to find opportunities in
+func (d *defiManager) arbitrageQuotes(names []string, now time.Time) map[string][]arbitrageQuote {
+	if !d.Started() {
+		return nil
+	}
+	d.m.RLock()
+	defer d.m.RUnlock()
+	resp := make(map[string][]arbitrageQuote)
+	for venue, quotes := range d.quotes {
+		if len(names) != 0 && !common.StringDataCompareInsensitive(names, venue) {
+			continue
+		}
+		for i := range quotes {
+			if now.Sub(quotes[i].updated) > arbitrageMaxBookAge {
+				continue
+			}
+			resp[venue] = append(resp[venue], quotes[i].quote)
+		}
+	}
+	return resp
+}
@@ -0,0 +1,107 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/defi"
+)
+
+// defiTestVenue swaps at a fixed price of 2000 quote per base, less a 1%
+// fee on each swap
+type defiTestVenue struct {
+	defi.Venue
+}
+
+func (v *defiTestVenue) GetName() string { return "defitest" }
+
+func (v *defiTestVenue) GetQuote(_ context.Context, from, to currency.Code, amount float64) (defi.Quote, error) {
+	out := amount * 2000 * 0.99
+	if from.Match(currency.USDT) {
+		out = amount / 2000 * 0.99
+	}
+	return defi.Quote{
+		Venue:     v.GetName(),
+		From:      from,
+		To:        to,
+		AmountIn:  amount,
+		AmountOut: out,
+		Price:     out / amount,
+	}, nil
+}
+
+func TestVenueQuote(t *testing.T) {
+	p := currency.NewPair(currency.ETH, currency.USDT)
+	q, err := venueQuote(context.Background(), &defiTestVenue{}, p, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.Exchange != "defitest" || !q.Pair.Equal(p) || q.FeeRate != 0 {
+		t.Errorf("unexpected quote %+v", q)
+	}
+	if !arbitrageNear(q.Bid, 1980) || q.BidAmount != 1 {
+		t.Errorf("expected a bid of 1980 for 1, received %v for %v", q.Bid, q.BidAmount)
+	}
+	// Buying back with the 1980 proceeds returns 0.9801 ETH
+	if !arbitrageNear(q.AskAmount, 0.9801) || !arbitrageNear(q.Ask, 1980/0.9801) {
+		t.Errorf("expected an ask of %v for 0.9801, received %v for %v", 1980/0.9801, q.Ask, q.AskAmount)
+	}
+
+	if _, err = venueQuote(context.Background(), &defiTestVenue{}, p, 0); err == nil {
+		t.Error("expected an error quoting without liquidity")
+	}
+}
+
+func TestDeFiVenues(t *testing.T) {
+	_, err := defiVenues([]config.DeFiVenueConfig{{Name: defi.UniswapV3Name}})
+	if err == nil {
+		t.Error("expected an error without enabled venues")
+	}
+
+	_, err = defiVenues([]config.DeFiVenueConfig{{Name: "sushi", Enabled: true}})
+	if err == nil {
+		t.Error("expected an error for an unknown venue")
+	}
+
+	venues, err := defiVenues([]config.DeFiVenueConfig{
+		{Name: defi.UniswapV3Name, Enabled: true},
+		{Name: defi.OneInchName},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(venues) != 1 || venues[0].GetName() != defi.UniswapV3Name {
+		t.Errorf("expected only %s, received %v venues", defi.UniswapV3Name, len(venues))
+	}
+}
+
+func TestDeFiArbitrageQuotes(t *testing.T) {
+	now := time.Now()
+	d := defiManager{
+		venues: []defi.Venue{&defiTestVenue{}},
+		quotes: map[string][]defiQuote{
+			"defitest": {
+				{quote: arbitrageQuote{Exchange: "defitest", Pair: currency.NewPair(currency.ETH, currency.USDT)}, updated: now},
+				{quote: arbitrageQuote{Exchange: "defitest", Pair: currency.NewPair(currency.BTC, currency.USDT)}, updated: now.Add(-time.Hour)},
+			},
+		},
+	}
+	if d.arbitrageQuotes(nil, now) != nil || d.IsVenue("defitest") {
+		t.Fatal("expected no quotes or venues before starting")
+	}
+
+	d.started = 1
+	if !d.IsVenue("DEFITEST") || d.IsVenue("Bitstamp") {
+		t.Error("unexpected venue matching")
+	}
+	quotes := d.arbitrageQuotes(nil, now)
+	if len(quotes["defitest"]) != 1 || quotes["defitest"][0].Pair.Base != currency.ETH {
+		t.Errorf("expected only the recent ETH quote, received %+v", quotes)
+	}
+	if len(d.arbitrageQuotes([]string{"Bitstamp"}, now)) != 0 {
+		t.Error("expected no quotes for unnamed venues")
+	}
+}
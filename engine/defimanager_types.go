@@ -0,0 +1,34 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/defi"
+)
+
+const defiManagerName = "defi manager"
+
+var errDeFiManagerNotStarted = errors.New("defi manager not started")
+
+// defiManager quotes the configured pairs on every enabled on-chain venue
+// each interval, adding them to the price stats and keeping the latest quotes
+// for the arbitrage scanner
+type defiManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	cfg      config.DeFiConfig
+	venues   []defi.Venue
+
+	m      sync.RWMutex
+	quotes map[string][]defiQuote
+}
+
+// defiQuote is a venue's price for a pair and when it was quoted
+type defiQuote struct {
+	quote   arbitrageQuote
+	updated time.Time
+}
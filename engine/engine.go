@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"flag"
 	"fmt"
@@ -9,17 +10,25 @@ import (
 	"runtime"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/currency/coinmarketcap"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
+	"github.com/thrasher-corp/gocryptotrader/tracing"
 	"github.com/thrasher-corp/gocryptotrader/utils"
 )
 
@@ -36,13 +45,38 @@ type Engine struct {
 	OrderManager                orderManager
 	PortfolioManager            portfolioManager
 	CommsManager                commsManager
+	ResourceMonitor             resourceMonitor
+	LatencyMonitor              latencyMonitor
+	TransferManager             transferManager
+	WithdrawalLimiter           withdrawalLimiter
+	SecurityMonitor             securityMonitor
+	PriceAlertManager           priceAlertManager
+	TrailingStop                trailingStop
+	MarginManager               marginManager
+	PairRefresher               pairRefresher
+	OpenInterestRecorder        openInterestRecorder
+	DeFiManager                 defiManager
+	CalendarManager             calendarManager
+	HedgeManager                hedgeManager
+	CandleIntegrity             candleIntegrity
 	exchangeManager             exchangeManager
+	rpcSessions                 rpcSessions
+	rpcProxyToken               string
 	DepositAddressManager       *DepositAddressManager
+	nonceStore                  *nonce.FileStore
+	crashes                     crashHandler
 	Settings                    Settings
 	Uptime                      time.Time
 	ServicesWG                  sync.WaitGroup
+	started                     int32
+	ctx                         context.Context
+	cancel                      context.CancelFunc
 }
 
+// DefaultShutdownTimeout is how long in-flight order submissions are given to
+// resolve on shutdown before exchange requests are aborted
+const DefaultShutdownTimeout = time.Second * 30
+
 // Vars for engine
 var (
 	Bot *Engine
@@ -78,33 +112,87 @@ func NewFromSettings(settings *Settings) (*Engine, error) {
 	}
 
 	log.Printf("Loading config file %s..\n", filePath)
+	config.SetEncryptionKeyFile(settings.ConfigKeyFile)
 	err = b.Config.LoadConfig(filePath, settings.EnableDryRun)
 	if err != nil {
 		return nil, fmt.Errorf("failed to load config. Err: %s", err)
 	}
 
-	err = common.CreateDir(settings.DataDir)
+	b.Settings.ConfigFile = filePath
+	err = b.setup(settings)
+	if err != nil {
+		return nil, err
+	}
+	return &b, nil
+}
+
+// NewEngine returns an engine for embedding in another application, using a
+// config built or loaded by the application rather than the config file.
+// Subsystems are only enabled by their settings, a nil settings leaving all
+// of them disabled other than the RPC servers enabled by the config's remote
+// control settings. The engine is set as Bot and GetConfig returns cfg, as
+// the engine's subsystems share package state only one engine may run per
+// process.
+func NewEngine(cfg *config.Config, settings *Settings) (*Engine, error) {
+	if cfg == nil {
+		return nil, errors.New("engine: config is nil")
+	}
+	if settings == nil {
+		settings = &Settings{}
+	}
+	if Bot != nil && Bot.Alive() {
+		return nil, errors.New("engine: an engine is already running")
+	}
+	if settings.DataDir == "" {
+		settings.DataDir = common.GetDefaultDataDir(runtime.GOOS)
+	}
+
+	err := cfg.Prepare()
+	if err != nil {
+		return nil, fmt.Errorf("failed to load config. Err: %s", err)
+	}
+	config.SetConfig(cfg)
+
+	b := &Engine{Config: cfg}
+	err = b.setup(settings)
+	if err != nil {
+		return nil, err
+	}
+	Bot = b
+	return b, nil
+}
+
+// setup prepares the data directory and logger of an engine with a loaded
+// config and applies its settings
+func (b *Engine) setup(settings *Settings) error {
+	err := common.CreateDir(settings.DataDir)
+	if err != nil {
+		return fmt.Errorf("failed to open/create data directory: %s. Err: %s", settings.DataDir, err)
+	}
+
+	b.nonceStore, err = nonce.NewFileStore(filepath.Join(settings.DataDir, "nonces.json"))
 	if err != nil {
-		return nil, fmt.Errorf("failed to open/create data directory: %s. Err: %s", settings.DataDir, err)
+		return fmt.Errorf("failed to load nonces. Err: %s", err)
 	}
 
+	b.crashes.dir = filepath.Join(settings.DataDir, crashDumpDir)
+
 	if *b.Config.Logging.Enabled {
 		gctlog.SetupGlobalLogger()
 		gctlog.SetupSubLoggers(b.Config.Logging.SubLoggers)
 		gctlog.Infoln(gctlog.Global, "Logger initialised.")
 	}
 
-	b.Settings.ConfigFile = filePath
 	b.Settings.DataDir = settings.DataDir
 	b.Settings.CheckParamInteraction = settings.CheckParamInteraction
 
 	err = utils.AdjustGoMaxProcs(settings.GoMaxProcs)
 	if err != nil {
-		return nil, fmt.Errorf("unable to adjust runtime GOMAXPROCS value. Err: %s", err)
+		return fmt.Errorf("unable to adjust runtime GOMAXPROCS value. Err: %s", err)
 	}
 
-	ValidateSettings(&b, settings)
-	return &b, nil
+	ValidateSettings(b, settings)
+	return nil
 }
 
 // ValidateSettings validates and sets all bot settings
@@ -192,6 +280,21 @@ func ValidateSettings(b *Engine, s *Settings) {
 	b.Settings.DisableExchangeAutoPairUpdates = s.DisableExchangeAutoPairUpdates
 	b.Settings.ExchangePurgeCredentials = s.ExchangePurgeCredentials
 	b.Settings.EnableWebsocketRoutine = s.EnableWebsocketRoutine
+	b.Settings.WebsocketCaptureDir = s.WebsocketCaptureDir
+	b.Settings.WebsocketReplayDir = s.WebsocketReplayDir
+	b.Settings.HTTPRecordDir = s.HTTPRecordDir
+	b.Settings.HTTPReplayDir = s.HTTPReplayDir
+	b.Settings.WebsocketQueueCapacity = s.WebsocketQueueCapacity
+	b.Settings.ChaosLatency = s.ChaosLatency
+	b.Settings.ChaosJitter = s.ChaosJitter
+	b.Settings.ChaosRESTErrorRate = s.ChaosRESTErrorRate
+	b.Settings.ChaosWebsocketDropRate = s.ChaosWebsocketDropRate
+	b.Settings.ChaosExchanges = s.ChaosExchanges
+
+	b.Settings.ShutdownTimeout = s.ShutdownTimeout
+	if b.Settings.ShutdownTimeout <= 0 {
+		b.Settings.ShutdownTimeout = DefaultShutdownTimeout
+	}
 
 	// Checks if the flag values are different from the defaults
 	b.Settings.MaxHTTPRequestJobsLimit = s.MaxHTTPRequestJobsLimit
@@ -252,6 +355,7 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable event manager: %v", s.EnableEventManager)
 	gctlog.Debugf(gctlog.Global, "\t Event manager sleep delay: %v", s.EventManagerDelay)
 	gctlog.Debugf(gctlog.Global, "\t Enable order manager: %v", s.EnableOrderManager)
+	gctlog.Debugf(gctlog.Global, "\t Shutdown timeout: %v", s.ShutdownTimeout)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange sync manager: %v", s.EnableExchangeSyncManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable deposit address manager: %v\n", s.EnableDepositAddressManager)
 	gctlog.Debugf(gctlog.Global, "\t Enable websocket routine: %v\n", s.EnableWebsocketRoutine)
@@ -276,6 +380,16 @@ func PrintSettings(s *Settings) {
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange auto pair updates: %v", s.EnableExchangeAutoPairUpdates)
 	gctlog.Debugf(gctlog.Global, "\t Disable all exchange auto pair updates: %v", s.DisableExchangeAutoPairUpdates)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange websocket support: %v", s.EnableExchangeWebsocketSupport)
+	gctlog.Debugf(gctlog.Global, "\t Websocket capture directory: %v", s.WebsocketCaptureDir)
+	gctlog.Debugf(gctlog.Global, "\t Websocket replay directory: %v", s.WebsocketReplayDir)
+	gctlog.Debugf(gctlog.Global, "\t HTTP record directory: %v", s.HTTPRecordDir)
+	gctlog.Debugf(gctlog.Global, "\t HTTP replay directory: %v", s.HTTPReplayDir)
+	gctlog.Debugf(gctlog.Global, "\t Websocket queue capacity: %v", s.WebsocketQueueCapacity)
+	gctlog.Debugf(gctlog.Global, "\t Chaos latency: %v", s.ChaosLatency)
+	gctlog.Debugf(gctlog.Global, "\t Chaos jitter: %v", s.ChaosJitter)
+	gctlog.Debugf(gctlog.Global, "\t Chaos REST error rate: %v", s.ChaosRESTErrorRate)
+	gctlog.Debugf(gctlog.Global, "\t Chaos websocket drop rate: %v", s.ChaosWebsocketDropRate)
+	gctlog.Debugf(gctlog.Global, "\t Chaos exchanges: %v", s.ChaosExchanges)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange verbose mode: %v", s.EnableExchangeVerbose)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange HTTP rate limiter: %v", s.EnableExchangeHTTPRateLimiter)
 	gctlog.Debugf(gctlog.Global, "\t Enable exchange HTTP debugging: %v", s.EnableExchangeHTTPDebugging)
@@ -301,12 +415,36 @@ func (e *Engine) Start() error {
 		return errors.New("engine instance is nil")
 	}
 
+	e.ctx, e.cancel = context.WithCancel(context.Background())
+
+	if e.Config.ErrorReporting.Enabled {
+		if err := errorreport.Setup(&e.Config.ErrorReporting, core.Release(), core.Commit, e.Config.Secrets()); err != nil {
+			gctlog.Errorf(gctlog.Global, "Error reporting unable to start: %v", err)
+		} else if e.Config.ErrorReporting.ReportErrorLogs {
+			gctlog.SetErrorHook(func(subLogger, message string) {
+				errorreport.CaptureMessage(message, map[string]string{"subsystem": subLogger})
+			})
+		}
+	}
+
 	if e.Settings.EnableDatabaseManager {
 		if err := e.DatabaseManager.Start(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Database manager unable to start: %v", err)
 		}
 	}
 
+	if e.Config.Tracing.Enabled {
+		if err := tracing.Setup(&e.Config.Tracing); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tracing unable to start: %v", err)
+		} else {
+			gctlog.Debugf(gctlog.Global, "Exporting traces to %s\n", e.Config.Tracing.Endpoint)
+		}
+	}
+
+	if e.Config.HealthCheck.Enabled {
+		go StartHealthCheckServer()
+	}
+
 	if e.Settings.EnableDispatcher {
 		if err := dispatch.Start(e.Settings.DispatchMaxWorkerAmount, e.Settings.DispatchJobsLimit); err != nil {
 			gctlog.Errorf(gctlog.DispatchMgr, "Dispatcher unable to start: %v", err)
@@ -351,12 +489,40 @@ func (e *Engine) Start() error {
 		e.Config.PurgeExchangeAPICredentials()
 	}
 
+	if e.Settings.WebsocketCaptureDir != "" {
+		if err := wshandler.SetCaptureDirectory(e.Settings.WebsocketCaptureDir); err != nil {
+			gctlog.Errorf(gctlog.Global, "Websocket capture unable to start: %v", err)
+		} else {
+			gctlog.Debugf(gctlog.Global, "Capturing websocket frames to %s\n", e.Settings.WebsocketCaptureDir)
+		}
+	}
+	if e.Settings.WebsocketReplayDir != "" {
+		wshandler.SetReplayDirectory(e.Settings.WebsocketReplayDir)
+		gctlog.Debugf(gctlog.Global, "Replaying websocket frames from %s\n", e.Settings.WebsocketReplayDir)
+	}
+	if e.Settings.HTTPRecordDir != "" {
+		if err := request.SetRecordDirectory(e.Settings.HTTPRecordDir); err != nil {
+			gctlog.Errorf(gctlog.Global, "HTTP recording unable to start: %v", err)
+		} else {
+			gctlog.Debugf(gctlog.Global, "Recording exchange HTTP responses to %s\n", e.Settings.HTTPRecordDir)
+		}
+	}
+	if e.Settings.HTTPReplayDir != "" {
+		request.SetReplayDirectory(e.Settings.HTTPReplayDir)
+		gctlog.Debugf(gctlog.Global, "Replaying exchange HTTP responses from %s\n", e.Settings.HTTPReplayDir)
+	}
+	if err := e.setupChaos(); err != nil {
+		return err
+	}
+
 	gctlog.Debugln(gctlog.Global, "Setting up exchanges..")
 	SetupExchanges()
 	if Bot.exchangeManager.Len() == 0 {
 		return errors.New("no exchanges are loaded")
 	}
 
+	e.startSecretsRefresher()
+
 	if e.Settings.EnableCommsRelayer {
 		if err := e.CommsManager.Start(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Communications manager unable to start: %v\n", err)
@@ -397,6 +563,98 @@ func (e *Engine) Start() error {
 		StartWebsocketHandler()
 	}
 
+	if e.Config.Metrics.Enabled {
+		go StartMetricsServer()
+	}
+
+	if e.Config.Profiler.Enabled {
+		go StartDebugServer()
+	}
+
+	if e.Config.ResourceMonitor.Enabled {
+		if err = e.ResourceMonitor.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Resource monitor unable to start: %v", err)
+		}
+	}
+
+	if e.Config.LatencyMonitor.Enabled {
+		if err = e.LatencyMonitor.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Latency monitor unable to start: %v", err)
+		}
+	}
+
+	if e.Config.WithdrawalLimits.Enabled {
+		if err = e.WithdrawalLimiter.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdrawal limiter unable to start, refusing withdrawals: %v", err)
+		}
+	}
+
+	if e.Config.SecurityMonitor.Enabled {
+		if err = e.SecurityMonitor.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Security monitor unable to start: %v", err)
+		}
+	}
+
+	if e.Config.TransferManager.Enabled {
+		if err = e.TransferManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Transfer manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.PriceAlerts.Enabled {
+		if err = e.PriceAlertManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Price alert manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.TrailingStop.Enabled {
+		if err = e.TrailingStop.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio trailing stop unable to start: %v", err)
+		}
+	}
+
+	if e.Config.MarginManager.Enabled {
+		if err = e.MarginManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Margin manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.PairRefresh.Enabled {
+		if err = e.PairRefresher.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tradable pair refresher unable to start: %v", err)
+		}
+	}
+
+	if e.Config.OpenInterest.Enabled {
+		if err = e.OpenInterestRecorder.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Open interest recorder unable to start: %v", err)
+		}
+	}
+
+	if e.Config.DeFi.Enabled {
+		if err = e.DeFiManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "DeFi manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.Calendar.Enabled {
+		if err = e.CalendarManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Calendar manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.HedgeManager.Enabled {
+		if err = e.HedgeManager.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Hedge manager unable to start: %v", err)
+		}
+	}
+
+	if e.Config.CandleIntegrity.Enabled {
+		if err = e.CandleIntegrity.Start(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle integrity checker unable to start: %v", err)
+		}
+	}
+
 	if e.Settings.EnablePortfolioManager {
 		if err = e.PortfolioManager.Start(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Fund manager unable to start: %v", err)
@@ -449,12 +707,94 @@ func (e *Engine) Start() error {
 		}
 	}
 
+	atomic.StoreInt32(&e.started, 1)
 	return nil
 }
 
+// Context returns the engine context which is cancelled when the engine is
+// stopped, this should be used for any exchange requests made on behalf of the
+// engine
+func (e *Engine) Context() context.Context {
+	if e == nil || e.ctx == nil {
+		return context.Background()
+	}
+	return e.ctx
+}
+
+// Alive returns whether the engine is started and no supervised subsystem has
+// been left stopped after crashing, used to withhold service watchdog
+// notifications so the service manager restarts it
+func (e *Engine) Alive() bool {
+	return atomic.LoadInt32(&e.started) == 1 &&
+		atomic.LoadInt32(&e.crashes.stopped) == 0
+}
+
+// GetExchanges returns the exchanges loaded by the engine
+func (e *Engine) GetExchanges() []exchange.IBotExchange {
+	return e.exchangeManager.getExchanges()
+}
+
+// GetExchangeByName returns a loaded exchange by name, or nil if it isn't
+// loaded
+func (e *Engine) GetExchangeByName(exchName string) exchange.IBotExchange {
+	return e.exchangeManager.getExchangeByName(exchName)
+}
+
+// SubmitOrder submits an order through the order manager, which enforces
+// its order limits and tracks the order
+func (e *Engine) SubmitOrder(exchName string, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+	return e.OrderManager.Submit(exchName, newOrder)
+}
+
+// CancelOrder cancels an order through the order manager
+func (e *Engine) CancelOrder(exchName string, cancel *order.Cancel) error {
+	return e.OrderManager.Cancel(exchName, cancel)
+}
+
+// GetOrders returns a copy of the orders tracked by the order manager by
+// exchange
+func (e *Engine) GetOrders() map[string][]order.Detail {
+	e.OrderManager.orderStore.m.Lock()
+	defer e.OrderManager.orderStore.m.Unlock()
+	orders := make(map[string][]order.Detail, len(e.OrderManager.orderStore.Orders))
+	for exch, o := range e.OrderManager.orderStore.Orders {
+		orders[exch] = append([]order.Detail(nil), o...)
+	}
+	return orders
+}
+
+// GetSubsystemsStatus returns whether each engine subsystem is running
+func (e *Engine) GetSubsystemsStatus() map[string]bool {
+	return GetSubsystemsStatus()
+}
+
+// SetSubsystem starts or stops an engine subsystem by name
+func (e *Engine) SetSubsystem(subsys string, enable bool) error {
+	return SetSubsystem(subsys, enable)
+}
+
 // Stop correctly shuts down engine saving configuration files
 func (e *Engine) Stop() {
 	gctlog.Debugln(gctlog.Global, "Engine shutting down..")
+	atomic.StoreInt32(&e.started, 0)
+	abort := func() {}
+	if e.cancel != nil {
+		// In-flight order submissions are given until the shutdown deadline
+		// to resolve, after which exchange requests are aborted so shutdown
+		// is not held up by slow or unresponsive APIs
+		timeout := e.Settings.ShutdownTimeout
+		if timeout <= 0 {
+			timeout = DefaultShutdownTimeout
+		}
+		deadline := time.AfterFunc(timeout, func() {
+			gctlog.Warnf(gctlog.Global, "Engine shutdown deadline of %v exceeded, aborting in-flight requests.", timeout)
+			e.cancel()
+		})
+		abort = func() {
+			deadline.Stop()
+			e.cancel()
+		}
+	}
 
 	if len(portfolio.Portfolio.Addresses) != 0 {
 		e.Config.Portfolio = portfolio.Portfolio
@@ -471,6 +811,95 @@ func (e *Engine) Stop() {
 		}
 	}
 
+	shutdownWebsockets(e.Context())
+	if err := wshandler.CloseCaptures(); err != nil {
+		gctlog.Errorf(gctlog.Global, "Websocket captures unable to close. Error: %v", err)
+	}
+
+	if e.ResourceMonitor.Started() {
+		if err := e.ResourceMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Resource monitor unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.LatencyMonitor.Started() {
+		if err := e.LatencyMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Latency monitor unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.TransferManager.Started() {
+		if err := e.TransferManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Transfer manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.SecurityMonitor.Started() {
+		if err := e.SecurityMonitor.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Security monitor unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.WithdrawalLimiter.Started() {
+		if err := e.WithdrawalLimiter.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Withdrawal limiter unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.PriceAlertManager.Started() {
+		if err := e.PriceAlertManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Price alert manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.TrailingStop.Started() {
+		if err := e.TrailingStop.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Portfolio trailing stop unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.MarginManager.Started() {
+		if err := e.MarginManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Margin manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.PairRefresher.Started() {
+		if err := e.PairRefresher.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Tradable pair refresher unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.OpenInterestRecorder.Started() {
+		if err := e.OpenInterestRecorder.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Open interest recorder unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.DeFiManager.Started() {
+		if err := e.DeFiManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "DeFi manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.CalendarManager.Started() {
+		if err := e.CalendarManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Calendar manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.HedgeManager.Started() {
+		if err := e.HedgeManager.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Hedge manager unable to stop. Error: %v", err)
+		}
+	}
+
+	if e.CandleIntegrity.Started() {
+		if err := e.CandleIntegrity.Stop(); err != nil {
+			gctlog.Errorf(gctlog.Global, "Candle integrity checker unable to stop. Error: %v", err)
+		}
+	}
+
 	if e.NTPManager.Started() {
 		if err := e.NTPManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "NTP manager unable to stop. Error: %v", err)
@@ -480,6 +909,13 @@ func (e *Engine) Stop() {
 	if e.CommsManager.Started() {
 		if err := e.CommsManager.Stop(); err != nil {
 			gctlog.Errorf(gctlog.Global, "Communication manager unable to stop. Error: %v", err)
+		} else {
+			// Wait for pending events to be flushed before the database
+			// holding the retry queue is closed
+			select {
+			case <-e.CommsManager.done:
+			case <-e.Context().Done():
+			}
 		}
 	}
 
@@ -516,8 +952,15 @@ func (e *Engine) Stop() {
 		}
 	}
 
+	// Order state is flushed so the remaining services don't need to finish
+	// their requests
+	abort()
+
 	// Wait for services to gracefully shutdown
 	e.ServicesWG.Wait()
+	tracing.Shutdown()
+	gctlog.SetErrorHook(nil)
+	errorreport.Shutdown()
 	err := gctlog.CloseLogger()
 	if err != nil {
 		log.Printf("Failed to close logger. Error: %v\n", err)
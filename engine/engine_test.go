@@ -0,0 +1,55 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestNewEngine(t *testing.T) {
+	_, err := NewEngine(nil, nil)
+	if err == nil {
+		t.Error("expected an error for a nil config")
+	}
+
+	dir, err := ioutil.TempDir("", "engine")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	bot := Bot
+	defer func() {
+		Bot = bot
+		config.SetConfig(&config.Cfg)
+	}()
+
+	var cfg config.Config
+	err = cfg.ReadConfig(config.TestFile, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	e, err := NewEngine(&cfg, &Settings{DataDir: dir, EnableOrderManager: true})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if Bot != e {
+		t.Error("expected the engine to be set as Bot")
+	}
+	if config.GetConfig() != &cfg {
+		t.Error("expected GetConfig to return the embedded config")
+	}
+	if !e.Settings.EnableOrderManager || e.Settings.EnableExchangeSyncManager {
+		t.Errorf("expected only the supplied settings to be enabled, got %+v", e.Settings)
+	}
+	if e.Settings.ShutdownTimeout != DefaultShutdownTimeout {
+		t.Errorf("expected default shutdown timeout, got %v", e.Settings.ShutdownTimeout)
+	}
+	if e.GetExchangeByName("Bitstamp") != nil || len(e.GetExchanges()) != 0 {
+		t.Error("expected no exchanges to be loaded before the engine starts")
+	}
+	if len(e.GetOrders()) != 0 {
+		t.Error("expected no tracked orders")
+	}
+}
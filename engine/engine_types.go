@@ -5,6 +5,7 @@ import "time"
 // Settings stores engine params
 type Settings struct {
 	ConfigFile            string
+	ConfigKeyFile         string
 	DataDir               string
 	MigrationDir          string
 	LogFile               string
@@ -33,6 +34,7 @@ type Settings struct {
 	EnableNTPClient             bool
 	EnableWebsocketRoutine      bool
 	EventManagerDelay           time.Duration
+	ShutdownTimeout             time.Duration
 	Verbose                     bool
 
 	// Exchange syncer settings
@@ -60,6 +62,16 @@ type Settings struct {
 	EnableExchangeWebsocketSupport bool
 	MaxHTTPRequestJobsLimit        int
 	RequestTimeoutRetryAttempts    int
+	WebsocketCaptureDir            string
+	WebsocketReplayDir             string
+	HTTPRecordDir                  string
+	HTTPReplayDir                  string
+	WebsocketQueueCapacity         int
+	ChaosLatency                   time.Duration
+	ChaosJitter                    time.Duration
+	ChaosRESTErrorRate             float64
+	ChaosWebsocketDropRate         float64
+	ChaosExchanges                 string
 
 	// Global HTTP related settings
 	GlobalHTTPTimeout   time.Duration
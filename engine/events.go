@@ -129,7 +129,7 @@ func (e *Event) ExecuteAction() bool {
 			message := fmt.Sprintf("Event triggered: %s\n", e.String())
 			if action[1] == "ALL" {
 				Bot.CommsManager.PushEvent(base.Event{
-					Type:    "event",
+					Type:    base.EventTypeEvent,
 					Message: message,
 				})
 			}
@@ -298,7 +298,7 @@ func EventManger() {
 							event.Exchange, event.String(),
 						)
 						log.Infoln(log.EventMgr, msg)
-						Bot.CommsManager.PushEvent(base.Event{Type: "event", Message: msg})
+						Bot.CommsManager.PushEvent(base.Event{Type: base.EventTypeEvent, Message: msg})
 						event.Executed = true
 					}
 				}
@@ -1,11 +1,13 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"sync"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/binance"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitfinex"
@@ -43,6 +45,8 @@ var (
 	ErrExchangeNotFound      = errors.New("exchange not found")
 	ErrExchangeAlreadyLoaded = errors.New("exchange already loaded")
 	ErrExchangeFailedToLoad  = errors.New("exchange failed to load")
+	ErrAPIKeyCannotTrade     = errors.New("exchange API key does not have trade permission")
+	ErrAPIKeyCannotWithdraw  = errors.New("exchange API key does not have withdraw permission")
 )
 
 type exchangeManager struct {
@@ -154,73 +158,13 @@ func GetExchanges() []exchange.IBotExchange {
 // LoadExchange loads an exchange by name
 func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 	nameLower := strings.ToLower(name)
-	var exch exchange.IBotExchange
-
 	if Bot.exchangeManager.getExchangeByName(nameLower) != nil {
 		return ErrExchangeAlreadyLoaded
 	}
 
-	switch nameLower {
-	case "binance":
-		exch = new(binance.Binance)
-	case "bitfinex":
-		exch = new(bitfinex.Bitfinex)
-	case "bitflyer":
-		exch = new(bitflyer.Bitflyer)
-	case "bithumb":
-		exch = new(bithumb.Bithumb)
-	case "bitmex":
-		exch = new(bitmex.Bitmex)
-	case "bitstamp":
-		exch = new(bitstamp.Bitstamp)
-	case "bittrex":
-		exch = new(bittrex.Bittrex)
-	case "btc markets":
-		exch = new(btcmarkets.BTCMarkets)
-	case "btse":
-		exch = new(btse.BTSE)
-	case "coinbene":
-		exch = new(coinbene.Coinbene)
-	case "coinut":
-		exch = new(coinut.COINUT)
-	case "exmo":
-		exch = new(exmo.EXMO)
-	case "coinbasepro":
-		exch = new(coinbasepro.CoinbasePro)
-	case "gateio":
-		exch = new(gateio.Gateio)
-	case "gemini":
-		exch = new(gemini.Gemini)
-	case "hitbtc":
-		exch = new(hitbtc.HitBTC)
-	case "huobi":
-		exch = new(huobi.HUOBI)
-	case "itbit":
-		exch = new(itbit.ItBit)
-	case "kraken":
-		exch = new(kraken.Kraken)
-	case "lakebtc":
-		exch = new(lakebtc.LakeBTC)
-	case "lbank":
-		exch = new(lbank.Lbank)
-	case "localbitcoins":
-		exch = new(localbitcoins.LocalBitcoins)
-	case "okcoin international":
-		exch = new(okcoin.OKCoin)
-	case "okex":
-		exch = new(okex.OKEX)
-	case "poloniex":
-		exch = new(poloniex.Poloniex)
-	case "yobit":
-		exch = new(yobit.Yobit)
-	case "zb":
-		exch = new(zb.ZB)
-	default:
-		return ErrExchangeNotFound
-	}
-
-	if exch == nil {
-		return ErrExchangeFailedToLoad
+	exch, err := newExchange(nameLower)
+	if err != nil {
+		return err
 	}
 
 	exch.SetDefaults()
@@ -321,20 +265,21 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 	Bot.exchangeManager.add(exch)
 
 	base := exch.GetBase()
-	if base.API.AuthenticatedSupport ||
-		base.API.AuthenticatedWebsocketSupport {
-		err = exch.ValidateCredentials()
+	if Bot.nonceStore != nil {
+		err = base.SetNonceStore(Bot.nonceStore)
 		if err != nil {
-			log.Warnf(log.ExchangeSys,
-				"%s: Cannot validate credentials, authenticated support has been disabled, Error: %s\n",
-				base.Name,
+			log.Errorf(log.ExchangeSys,
+				"%s: Unable to load persisted nonce, nonces may be reused after a restart. Error: %s\n",
+				exch.GetName(),
 				err)
-			base.API.AuthenticatedSupport = false
-			base.API.AuthenticatedWebsocketSupport = false
-			exchCfg.API.AuthenticatedSupport = false
-			exchCfg.API.AuthenticatedWebsocketSupport = false
 		}
 	}
+	if base.API.AuthenticatedSupport ||
+		base.API.AuthenticatedWebsocketSupport {
+		// Errors are logged and the features the key can't use disabled, so
+		// loading the exchange continues unauthenticated
+		_, _ = verifyCredentials(context.Background(), exch, exchCfg)
+	}
 
 	if useWG {
 		exch.Start(wg)
@@ -347,6 +292,75 @@ func LoadExchange(name string, useWG bool, wg *sync.WaitGroup) error {
 	return nil
 }
 
+// newExchange returns a new instance of a supported exchange by its lower
+// case name
+func newExchange(nameLower string) (exchange.IBotExchange, error) {
+	var exch exchange.IBotExchange
+	switch nameLower {
+	case "binance":
+		exch = new(binance.Binance)
+	case "bitfinex":
+		exch = new(bitfinex.Bitfinex)
+	case "bitflyer":
+		exch = new(bitflyer.Bitflyer)
+	case "bithumb":
+		exch = new(bithumb.Bithumb)
+	case "bitmex":
+		exch = new(bitmex.Bitmex)
+	case "bitstamp":
+		exch = new(bitstamp.Bitstamp)
+	case "bittrex":
+		exch = new(bittrex.Bittrex)
+	case "btc markets":
+		exch = new(btcmarkets.BTCMarkets)
+	case "btse":
+		exch = new(btse.BTSE)
+	case "coinbene":
+		exch = new(coinbene.Coinbene)
+	case "coinut":
+		exch = new(coinut.COINUT)
+	case "exmo":
+		exch = new(exmo.EXMO)
+	case "coinbasepro":
+		exch = new(coinbasepro.CoinbasePro)
+	case "gateio":
+		exch = new(gateio.Gateio)
+	case "gemini":
+		exch = new(gemini.Gemini)
+	case "hitbtc":
+		exch = new(hitbtc.HitBTC)
+	case "huobi":
+		exch = new(huobi.HUOBI)
+	case "itbit":
+		exch = new(itbit.ItBit)
+	case "kraken":
+		exch = new(kraken.Kraken)
+	case "lakebtc":
+		exch = new(lakebtc.LakeBTC)
+	case "lbank":
+		exch = new(lbank.Lbank)
+	case "localbitcoins":
+		exch = new(localbitcoins.LocalBitcoins)
+	case "okcoin international":
+		exch = new(okcoin.OKCoin)
+	case "okex":
+		exch = new(okex.OKEX)
+	case "poloniex":
+		exch = new(poloniex.Poloniex)
+	case "yobit":
+		exch = new(yobit.Yobit)
+	case "zb":
+		exch = new(zb.ZB)
+	default:
+		return nil, ErrExchangeNotFound
+	}
+
+	if exch == nil {
+		return nil, ErrExchangeFailedToLoad
+	}
+	return exch, nil
+}
+
 // SetupExchanges sets up the exchanges used by the Bot
 func SetupExchanges() {
 	var wg sync.WaitGroup
@@ -370,3 +384,101 @@ func SetupExchanges() {
 	}
 	wg.Wait()
 }
+
+// disableAuthenticatedSupport turns off an exchange's authenticated REST and
+// websocket support
+func disableAuthenticatedSupport(base *exchange.Base, exchCfg *config.ExchangeConfig) {
+	base.API.AuthenticatedSupport = false
+	base.API.AuthenticatedWebsocketSupport = false
+	if exchCfg != nil {
+		exchCfg.API.AuthenticatedSupport = false
+		exchCfg.API.AuthenticatedWebsocketSupport = false
+	}
+}
+
+// verifyCredentials validates an exchange's credentials and probes the
+// permissions of its API key so features the key can't use are disabled up
+// front, instead of failing with opaque exchange errors on first use.
+// Authenticated support is disabled when the credentials are invalid or the
+// key can't read account data. It returns whether the credentials are valid
+// along with any error validating them or verifying the key's permissions
+func verifyCredentials(ctx context.Context, exch exchange.IBotExchange, exchCfg *config.ExchangeConfig) (bool, error) {
+	base := exch.GetBase()
+	base.API.KeyPermissions = nil
+	err := exch.ValidateCredentials(ctx)
+	if err != nil {
+		log.Warnf(log.ExchangeSys,
+			"%s: Cannot validate credentials, authenticated support has been disabled, Error: %s\n",
+			base.Name,
+			err)
+		disableAuthenticatedSupport(base, exchCfg)
+		return false, err
+	}
+
+	perms, err := exch.GetAPIKeyPermissions(ctx)
+	if err != nil {
+		if err == common.ErrFunctionNotSupported ||
+			err == common.ErrNotYetImplemented {
+			return true, nil
+		}
+		log.Warnf(log.ExchangeSys,
+			"%s: Cannot verify API key permissions, Error: %s\n",
+			base.Name,
+			err)
+		return true, err
+	}
+
+	for _, warning := range apiKeyPermissionWarnings(&perms) {
+		log.Warnf(log.ExchangeSys, "%s: %s\n", base.Name, warning)
+	}
+	if len(perms.IPWhitelist) > 0 {
+		log.Debugf(log.ExchangeSys,
+			"%s: API key is restricted to %s\n",
+			base.Name,
+			strings.Join(perms.IPWhitelist, ", "))
+	}
+	if !perms.Read {
+		disableAuthenticatedSupport(base, exchCfg)
+	}
+	base.API.KeyPermissions = &perms
+	return true, nil
+}
+
+// apiKeyPermissionWarnings describes the features disabled by an API key's
+// permissions, along with risky permissions
+func apiKeyPermissionWarnings(perms *exchange.APIKeyPermissions) []string {
+	var warnings []string
+	if !perms.Read {
+		warnings = append(warnings,
+			"API key cannot read account data, authenticated support has been disabled")
+	}
+	if !perms.Trade {
+		warnings = append(warnings,
+			"API key cannot trade, order submission has been disabled")
+	}
+	if !perms.Withdraw {
+		warnings = append(warnings,
+			"API key cannot withdraw, withdrawals have been disabled")
+	} else if len(perms.IPWhitelist) == 0 {
+		warnings = append(warnings,
+			"API key can withdraw without an IP restriction, consider restricting it to trusted IP addresses")
+	}
+	return warnings
+}
+
+// apiKeyAllows returns an error when an exchange's verified API key
+// permissions do not allow trading or withdrawing. Keys which have not been
+// verified are allowed
+func apiKeyAllows(exch exchange.IBotExchange, trade, withdraw bool) error {
+	perms := exch.GetBase().API.KeyPermissions
+	if perms == nil {
+		return nil
+	}
+	if trade && !perms.Trade {
+		return ErrAPIKeyCannotTrade
+	}
+	if withdraw && !perms.Withdraw {
+		return ErrAPIKeyCannotWithdraw
+	}
+	return nil
+}
@@ -1,9 +1,14 @@
 package engine
 
 import (
+	"context"
+	"strings"
 	"testing"
 
+	"github.com/thrasher-corp/gocryptotrader/config"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/bitfinex"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitmex"
 )
 
 var testSetup = false
@@ -214,3 +219,73 @@ func TestDryRunParamInteraction(t *testing.T) {
 		t.Error("dryrun should be false and verbose should be true")
 	}
 }
+
+func TestAPIKeyPermissionWarnings(t *testing.T) {
+	warnings := apiKeyPermissionWarnings(&exchange.APIKeyPermissions{})
+	if len(warnings) != 3 {
+		t.Errorf("expected read, trade and withdraw warnings got %v", warnings)
+	}
+	warnings = apiKeyPermissionWarnings(&exchange.APIKeyPermissions{
+		Read:  true,
+		Trade: true,
+	})
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "withdraw") {
+		t.Errorf("expected withdraw warning got %v", warnings)
+	}
+	warnings = apiKeyPermissionWarnings(&exchange.APIKeyPermissions{
+		Read:     true,
+		Trade:    true,
+		Withdraw: true,
+	})
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "IP restriction") {
+		t.Errorf("expected IP restriction warning got %v", warnings)
+	}
+	warnings = apiKeyPermissionWarnings(&exchange.APIKeyPermissions{
+		Read:        true,
+		Trade:       true,
+		Withdraw:    true,
+		IPWhitelist: []string{"10.0.0.1/32"},
+	})
+	if len(warnings) != 0 {
+		t.Errorf("expected no warnings got %v", warnings)
+	}
+}
+
+func TestAPIKeyAllows(t *testing.T) {
+	exch := new(bitmex.Bitmex)
+	exch.SetDefaults()
+	if err := apiKeyAllows(exch, true, true); err != nil {
+		t.Errorf("expected unverified key to be allowed got %v", err)
+	}
+	exch.API.KeyPermissions = &exchange.APIKeyPermissions{Read: true, Trade: true}
+	if err := apiKeyAllows(exch, true, false); err != nil {
+		t.Error(err)
+	}
+	if err := apiKeyAllows(exch, false, true); err != ErrAPIKeyCannotWithdraw {
+		t.Errorf("expected %v got %v", ErrAPIKeyCannotWithdraw, err)
+	}
+	exch.API.KeyPermissions.Trade = false
+	if err := apiKeyAllows(exch, true, false); err != ErrAPIKeyCannotTrade {
+		t.Errorf("expected %v got %v", ErrAPIKeyCannotTrade, err)
+	}
+}
+
+func TestVerifyCredentials(t *testing.T) {
+	exch := new(bitmex.Bitmex)
+	exch.SetDefaults()
+	exch.API.AuthenticatedSupport = true
+	exch.API.KeyPermissions = &exchange.APIKeyPermissions{Read: true}
+	cfg := &config.ExchangeConfig{}
+	cfg.API.AuthenticatedSupport = true
+
+	valid, err := verifyCredentials(context.Background(), exch, cfg)
+	if valid || err == nil {
+		t.Error("expected invalid credentials without an API key")
+	}
+	if exch.API.AuthenticatedSupport || cfg.API.AuthenticatedSupport {
+		t.Error("expected authenticated support to be disabled")
+	}
+	if exch.API.KeyPermissions != nil {
+		t.Error("expected key permissions to be cleared")
+	}
+}
@@ -0,0 +1,117 @@
+package engine
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// fiatValueQuotes are the currencies a cryptocurrency is priced against when
+// it has no price in the fiat currency it is valued in, besides the other
+// fiat currencies being valued in
+var fiatValueQuotes = currency.Currencies{currency.USD, currency.USDT, currency.EUR}
+
+// newFiatValuer returns a valuer for the requested fiat currencies, or the
+// configured fiat display currencies when none are requested. Cryptocurrencies
+// are priced by their composite ticker price across enabled exchanges
+func newFiatValuer(requested []string) (*fiatValuer, error) {
+	fiats := Bot.Config.Currency.FiatDisplayCurrencies
+	if len(requested) > 0 {
+		var err error
+		fiats, err = parseFiatCurrencies(requested)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if len(fiats) == 0 {
+		fiats = currency.Currencies{Bot.Config.Currency.FiatDisplayCurrency}
+	}
+	exchanges := GetExchangeNames(true)
+	return &fiatValuer{
+		fiats: fiats,
+		price: func(p currency.Pair) (float64, error) {
+			return compositePrice(exchanges, p, asset.Spot, time.Now(), fiatValueMaxTickerAge)
+		},
+		convert: currency.ConvertCurrency,
+	}, nil
+}
+
+// parseFiatCurrencies returns the fiat currencies requested, each of which may
+// be a comma separated list, ignoring duplicates
+func parseFiatCurrencies(requested []string) (currency.Currencies, error) {
+	var fiats currency.Currencies
+	for i := range requested {
+		for _, s := range strings.Split(requested[i], ",") {
+			s = strings.TrimSpace(s)
+			if s == "" {
+				continue
+			}
+			code := currency.NewCode(strings.ToUpper(s))
+			if !code.IsFiatCurrency() {
+				return nil, fmt.Errorf("%v %s", errInvalidFiatCurrency, code)
+			}
+			if !fiats.Contains(code) {
+				fiats = append(fiats, code)
+			}
+		}
+	}
+	return fiats, nil
+}
+
+// value returns the value of an amount of a currency in each fiat currency.
+// Fiat currencies it can't be valued in are left out and the currency is
+// recorded as unpriced
+func (v *fiatValuer) value(code currency.Code, amount float64) map[string]float64 {
+	values := make(map[string]float64, len(v.fiats))
+	for i := range v.fiats {
+		value, err := v.valueIn(code, amount, v.fiats[i])
+		if err != nil {
+			if !common.StringDataCompare(v.unpriced, code.Upper().String()) {
+				v.unpriced = append(v.unpriced, code.Upper().String())
+			}
+			continue
+		}
+		values[v.fiats[i].String()] = value
+	}
+	return values
+}
+
+// valueIn returns the value of an amount of a currency in a fiat currency.
+// Fiat currencies and USDT are converted with the forex rates. Other
+// currencies are priced against the fiat currency, or else against another
+// quote currency whose value is converted
+func (v *fiatValuer) valueIn(code currency.Code, amount float64, fiat currency.Code) (float64, error) {
+	if amount == 0 {
+		return 0, nil
+	}
+	if code.IsFiatCurrency() || code.Match(currency.USDT) {
+		return v.convert(amount, code, fiat)
+	}
+	price, err := v.price(currency.NewPair(code, fiat))
+	if err == nil {
+		return amount * price, nil
+	}
+	quotes := append(append(currency.Currencies{}, v.fiats...), fiatValueQuotes...)
+	for i := range quotes {
+		if quotes[i].Match(fiat) {
+			continue
+		}
+		price, err = v.price(currency.NewPair(code, quotes[i]))
+		if err != nil {
+			continue
+		}
+		return v.convert(amount*price, quotes[i], fiat)
+	}
+	return 0, fmt.Errorf("no price for %s in %s", code, fiat)
+}
+
+// addFiatValues adds each fiat value to the totals
+func addFiatValues(totals, values map[string]float64) {
+	for fiat, value := range values {
+		totals[fiat] += value
+	}
+}
@@ -0,0 +1,109 @@
+package engine
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// testFiatValuer returns a valuer pricing BTC in USD, ETH in USDT and
+// converting USD to AUD at 1.5
+func testFiatValuer(fiats ...currency.Code) *fiatValuer {
+	prices := map[string]float64{
+		"BTCUSD":  10000,
+		"ETHUSDT": 200,
+	}
+	usd := func(c currency.Code) currency.Code {
+		if c.Match(currency.USDT) {
+			return currency.USD
+		}
+		return c
+	}
+	return &fiatValuer{
+		fiats: fiats,
+		price: func(p currency.Pair) (float64, error) {
+			price, ok := prices[p.Base.String()+p.Quote.String()]
+			if !ok {
+				return 0, errors.New("no price")
+			}
+			return price, nil
+		},
+		convert: func(amount float64, from, to currency.Code) (float64, error) {
+			from, to = usd(from), usd(to)
+			switch {
+			case from.Match(to):
+				return amount, nil
+			case from.Match(currency.USD) && to.Match(currency.AUD):
+				return amount * 1.5, nil
+			case from.Match(currency.AUD) && to.Match(currency.USD):
+				return amount / 1.5, nil
+			}
+			return 0, errors.New("no rate")
+		},
+	}
+}
+
+func TestParseFiatCurrencies(t *testing.T) {
+	t.Parallel()
+	fiats, err := parseFiatCurrencies([]string{"usd, AUD", "", "USD"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fiats.Join() != "USD,AUD" {
+		t.Errorf("expected USD,AUD, received %s", fiats.Join())
+	}
+	_, err = parseFiatCurrencies([]string{"USD", "BTC"})
+	if err == nil || !strings.HasPrefix(err.Error(), errInvalidFiatCurrency.Error()) {
+		t.Errorf("expected %v, received %v", errInvalidFiatCurrency, err)
+	}
+}
+
+func TestFiatValue(t *testing.T) {
+	t.Parallel()
+	v := testFiatValuer(currency.USD, currency.AUD)
+	for _, tc := range []struct {
+		code     currency.Code
+		amount   float64
+		usd, aud float64
+	}{
+		{currency.BTC, 2, 20000, 30000},
+		{currency.ETH, 1, 200, 300},
+		{currency.AUD, 3, 2, 3},
+		{currency.USDT, 10, 10, 15},
+		{currency.LTC, 0, 0, 0},
+	} {
+		values := v.value(tc.code, tc.amount)
+		if len(values) != 2 || values["USD"] != tc.usd || values["AUD"] != tc.aud {
+			t.Errorf("%s: expected USD %v and AUD %v, received %v", tc.code, tc.usd, tc.aud, values)
+		}
+	}
+	if len(v.unpriced) != 0 {
+		t.Errorf("unexpected unpriced currencies %v", v.unpriced)
+	}
+
+	values := v.value(currency.XRP, 1)
+	v.value(currency.XRP, 2)
+	if len(values) != 0 || len(v.unpriced) != 1 || v.unpriced[0] != "XRP" {
+		t.Errorf("expected XRP to be unpriced, received %v %v", values, v.unpriced)
+	}
+}
+
+func TestFiatValueQuotePreference(t *testing.T) {
+	t.Parallel()
+	v := testFiatValuer(currency.AUD)
+	v.price = func(p currency.Pair) (float64, error) {
+		switch p.Quote {
+		case currency.AUD:
+			return 16000, nil
+		case currency.USD:
+			return 10000, nil
+		}
+		return 0, errors.New("no price")
+	}
+	values := v.value(currency.BTC, 1)
+	if values["AUD"] != 16000 {
+		t.Errorf("expected the AUD price to be used, received %v", values)
+	}
+}
@@ -0,0 +1,28 @@
+package engine
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// fiatValueMaxTickerAge is the oldest ticker used to price a cryptocurrency
+// in a fiat currency
+const fiatValueMaxTickerAge = 5 * time.Minute
+
+var errInvalidFiatCurrency = errors.New("invalid fiat currency")
+
+// fiatValuer values amounts of currencies in each of several fiat currencies
+// so reports can be rendered in more than one at once
+type fiatValuer struct {
+	fiats currency.Currencies
+	// price returns the latest price of a pair across exchanges
+	price func(p currency.Pair) (float64, error)
+	// convert converts an amount between fiat currencies with the forex
+	// provider rates
+	convert func(amount float64, from, to currency.Code) (float64, error)
+	// unpriced holds the currencies which couldn't be valued in at least one
+	// of the fiat currencies
+	unpriced []string
+}
@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/database/repository/funding"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// fundingPaymentRows converts exchange funding payments into database rows.
+// Pairs are stored upper case without a delimiter so rows match regardless of
+// how the pair was formatted when requested
+func fundingPaymentRows(payments []exchange.FundingPayment) []funding.Payment {
+	rows := make([]funding.Payment, len(payments))
+	for i := range payments {
+		rows[i] = funding.Payment{
+			Exchange: payments[i].Exchange,
+			Asset:    payments[i].AssetType.String(),
+			Pair:     payments[i].Pair.Format("", true).String(),
+			Rate:     payments[i].Rate,
+			Amount:   payments[i].Amount,
+			Currency: payments[i].Currency.String(),
+			PaidAt:   payments[i].Timestamp,
+		}
+	}
+	return rows
+}
+
+// storeFundingPayments persists funding payments when a database is
+// connected, so realised PnL on perpetual positions can include funding.
+// Payments already stored are skipped. It returns the number inserted
+func storeFundingPayments(payments []exchange.FundingPayment) (int, error) {
+	if len(payments) == 0 || !databaseConnected() {
+		return 0, nil
+	}
+	return funding.Insert(fundingPaymentRows(payments)...)
+}
+
+// fundingTotal returns the net funding received across payments, a negative
+// total was paid
+func fundingTotal(payments []exchange.FundingPayment) float64 {
+	var total float64
+	for i := range payments {
+		total += payments[i].Amount
+	}
+	return total
+}
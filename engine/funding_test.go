@@ -0,0 +1,71 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func testFundingPayments() []exchange.FundingPayment {
+	p := currency.NewPairWithDelimiter("XBT", "USD", "-")
+	now := time.Now()
+	return []exchange.FundingPayment{
+		{
+			Exchange:  "Bitmex",
+			AssetType: asset.PerpetualContract,
+			Pair:      p,
+			Rate:      0.0001,
+			Amount:    -0.5,
+			Currency:  currency.XBT,
+			Timestamp: now.Add(-8 * time.Hour),
+		},
+		{
+			Exchange:  "Bitmex",
+			AssetType: asset.PerpetualContract,
+			Pair:      p,
+			Rate:      -0.0003,
+			Amount:    1.25,
+			Currency:  currency.XBT,
+			Timestamp: now,
+		},
+	}
+}
+
+func TestFundingPaymentRows(t *testing.T) {
+	payments := testFundingPayments()
+	rows := fundingPaymentRows(payments)
+	if len(rows) != len(payments) {
+		t.Fatalf("expected %v rows got %v", len(payments), len(rows))
+	}
+	if rows[0].Pair != "XBTUSD" {
+		t.Errorf("expected pair stored without delimiter got %v", rows[0].Pair)
+	}
+	if rows[0].Asset != asset.PerpetualContract.String() || rows[0].Currency != "XBT" {
+		t.Errorf("unexpected row %+v", rows[0])
+	}
+	if !rows[1].PaidAt.Equal(payments[1].Timestamp) || rows[1].Amount != payments[1].Amount {
+		t.Errorf("unexpected row %+v", rows[1])
+	}
+}
+
+func TestFundingTotal(t *testing.T) {
+	if total := fundingTotal(testFundingPayments()); total != 0.75 {
+		t.Errorf("expected net funding of 0.75 got %v", total)
+	}
+	if total := fundingTotal(nil); total != 0 {
+		t.Errorf("expected no funding got %v", total)
+	}
+}
+
+func TestStoreFundingPaymentsWithoutDatabase(t *testing.T) {
+	stored, err := storeFundingPayments(testFundingPayments())
+	if err != nil {
+		t.Error(err)
+	}
+	if stored != 0 {
+		t.Errorf("expected nothing stored without a database got %v", stored)
+	}
+}
@@ -0,0 +1,99 @@
+package engine
+
+import (
+	"fmt"
+	"net/http"
+	"sync/atomic"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// StartHealthCheckServer starts a HTTP server exposing a liveness probe on
+// /healthz and a readiness probe on /readyz
+func StartHealthCheckServer() {
+	listenAddr := Bot.Config.HealthCheck.ListenAddress
+	log.Debugf(log.RESTSys,
+		"Health check server support enabled. Liveness: http://%s:%d/healthz Readiness: http://%s:%d/readyz\n",
+		common.ExtractHost(listenAddr), common.ExtractPort(listenAddr),
+		common.ExtractHost(listenAddr), common.ExtractPort(listenAddr))
+	err := http.ListenAndServe(listenAddr, newHealthCheckMux())
+	if err != nil {
+		log.Errorf(log.RESTSys, "Failed to start health check server. Err: %s", err)
+	}
+}
+
+func newHealthCheckMux() *http.ServeMux {
+	mux := http.NewServeMux()
+	mux.HandleFunc("/healthz", healthzHandler)
+	mux.HandleFunc("/readyz", readyzHandler)
+	return mux
+}
+
+// healthzHandler reports that the process is alive and serving requests
+func healthzHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	fmt.Fprintln(w, "ok")
+}
+
+// readyzHandler reports whether the bot is ready, responding with 503 and the
+// failed checks if it is not
+func readyzHandler(w http.ResponseWriter, _ *http.Request) {
+	w.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	failed := readinessChecks()
+	if len(failed) > 0 {
+		w.WriteHeader(http.StatusServiceUnavailable)
+		for i := range failed {
+			fmt.Fprintln(w, failed[i])
+		}
+		return
+	}
+	fmt.Fprintln(w, "ok")
+}
+
+// readinessChecks returns the reasons the bot is not ready, the bot is ready
+// once the engine has started its subsystems, the database is connected when
+// enabled and at least one exchange is connected
+func readinessChecks() []string {
+	var failed []string
+	if atomic.LoadInt32(&Bot.started) != 1 {
+		failed = append(failed, "engine subsystems not started")
+	}
+
+	if Bot.Settings.EnableDatabaseManager && Bot.Config.Database.Enabled &&
+		(!Bot.DatabaseManager.Started() || !databaseConnected()) {
+		failed = append(failed, "database not connected")
+	}
+
+	exchanges := GetExchanges()
+	var connected bool
+	for i := range exchanges {
+		if exchangeConnected(exchanges[i]) {
+			connected = true
+			break
+		}
+	}
+	if !connected {
+		failed = append(failed, "no exchanges connected")
+	}
+	return failed
+}
+
+// exchangeConnected returns true if an enabled exchange has a connected
+// websocket or can reach its REST API
+func exchangeConnected(exch exchange.IBotExchange) bool {
+	if !exch.IsEnabled() {
+		return false
+	}
+	if exch.IsWebsocketEnabled() {
+		ws, err := exch.GetWebsocket()
+		if err == nil && ws.IsConnected() {
+			return true
+		}
+	}
+	if !Bot.Settings.EnableExchangeRESTSupport {
+		return false
+	}
+	return !Bot.ConnectionManager.Started() || Bot.ConnectionManager.IsOnline()
+}
@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync/atomic"
+	"testing"
+)
+
+func TestHealthCheckMux(t *testing.T) {
+	SetupTestHelpers(t)
+	mux := newHealthCheckMux()
+
+	resp := httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/healthz", nil))
+	if resp.Code != http.StatusOK {
+		t.Errorf("expected status %d, received %d", http.StatusOK, resp.Code)
+	}
+
+	atomic.StoreInt32(&Bot.started, 0)
+	resp = httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if resp.Code != http.StatusServiceUnavailable {
+		t.Errorf("expected status %d, received %d", http.StatusServiceUnavailable, resp.Code)
+	}
+	if !strings.Contains(resp.Body.String(), "engine subsystems not started") {
+		t.Errorf("unexpected readiness output %s", resp.Body.String())
+	}
+
+	atomic.StoreInt32(&Bot.started, 1)
+	defer atomic.StoreInt32(&Bot.started, 0)
+	restSupport := Bot.Settings.EnableExchangeRESTSupport
+	Bot.Settings.EnableExchangeRESTSupport = true
+	defer func() { Bot.Settings.EnableExchangeRESTSupport = restSupport }()
+	LoadExchange("Bitstamp", false, nil)
+
+	resp = httptest.NewRecorder()
+	mux.ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/readyz", nil))
+	if resp.Code != http.StatusOK {
+		t.Errorf("expected status %d, received %d %s", http.StatusOK, resp.Code, resp.Body.String())
+	}
+}
@@ -0,0 +1,257 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+func (m *hedgeManager) Started() bool {
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+func (m *hedgeManager) Start() error {
+	if atomic.AddInt32(&m.started, 1) != 1 {
+		return errors.New("hedge manager already started")
+	}
+
+	m.cfg = Bot.Config.HedgeManager
+	m.shutdown = make(chan struct{})
+	go m.run()
+	log.Debugf(log.Global, "Hedge manager started, maintaining %d hedge(s).\n", len(m.cfg.Hedges))
+	return nil
+}
+
+func (m *hedgeManager) Stop() error {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return errHedgeManagerNotStarted
+	}
+
+	if atomic.AddInt32(&m.stopped, 1) != 1 {
+		return errors.New("hedge manager is already stopped")
+	}
+
+	close(m.shutdown)
+	log.Debugln(log.Global, "Hedge manager shutting down...")
+	return nil
+}
+
+func (m *hedgeManager) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(m.cfg.CheckInterval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&m.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&m.started, 1, 0)
+		log.Debugln(log.Global, "Hedge manager shutdown.")
+	}()
+
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			guard(hedgeManagerName, m.check)
+		}
+	}
+}
+
+// check nets the exposure of each hedged currency and trades any hedge which
+// has drifted outside its band. Hedges are left alone when the positions
+// can't all be fetched, as a missing position would misstate the exposure
+func (m *hedgeManager) check() {
+	if len(m.cfg.Hedges) == 0 {
+		return
+	}
+	positions, err := getMarginPositions()
+	if err != nil {
+		log.Errorf(log.Global, "Hedge manager unable to get positions: %v\n", err)
+		return
+	}
+	exposures := netExposures(spotHoldings(), positions, m.cfg.Hedges)
+	for i := range m.cfg.Hedges {
+		h := &m.cfg.Hedges[i]
+		for j := range exposures {
+			if exposures[j].Currency.String() == h.Currency {
+				m.maintain(h, &exposures[j], hedgePosition(positions, h))
+				break
+			}
+		}
+	}
+}
+
+// maintain trades a hedge's contract to bring its currency's net exposure
+// back to its target when outside the band. Orders which only shrink the
+// hedge position are submitted as reducing, so they are accepted while
+// submissions are halted
+func (m *hedgeManager) maintain(h *config.HedgeConfig, e *Exposure, position float64) {
+	o := hedgeOrder(h, e)
+	if o == nil {
+		return
+	}
+	submit := Bot.OrderManager.Submit
+	if reducesPosition(o, position) {
+		submit = Bot.OrderManager.submitReducing
+	}
+	resp, err := submit(h.Exchange, o)
+	if err != nil {
+		recordHedgeAction(h, fmt.Sprintf("net exposure %v against a target of %v, unable to %s %v %s: %v",
+			e.Net, e.Target, o.OrderSide, o.Amount, o.Pair, err), base.SeverityError)
+		return
+	}
+	recordHedgeAction(h, fmt.Sprintf("net exposure %v against a target of %v, %s %v %s with order ID=%s",
+		e.Net, e.Target, o.OrderSide, o.Amount, o.Pair, resp.OrderID), base.SeverityWarning)
+}
+
+// hedgeOrder returns the market order of the hedge's contract bringing the
+// net exposure to its target, or nil if the exposure is within the band
+func hedgeOrder(h *config.HedgeConfig, e *Exposure) *order.Submit {
+	deviation := e.Net - e.Target
+	if math.Abs(deviation) <= h.Band*math.Abs(e.Spot) {
+		return nil
+	}
+	side := order.Sell
+	if deviation < 0 {
+		side = order.Buy
+	}
+	return &order.Submit{
+		Pair:      currency.NewPairFromString(h.Pair),
+		AssetType: asset.Item(h.AssetType),
+		OrderType: order.Market,
+		OrderSide: side,
+		Amount:    decimal.NewFromFloat(math.Abs(deviation) / h.ContractSize),
+	}
+}
+
+// reducesPosition returns whether an order only shrinks a position of a size
+// in contracts, negative when short
+func reducesPosition(o *order.Submit, position float64) bool {
+	amount := o.Amount.Float64()
+	if o.OrderSide == order.Buy {
+		return position < 0 && amount <= -position
+	}
+	return position > 0 && amount <= position
+}
+
+// netExposures returns the exposure of each currency held or with a position,
+// sorted by currency. Positions are in contracts of a hedge's contract size
+// when they are of a hedge's contract, otherwise in units of the base currency
+func netExposures(spot map[currency.Code]decimal.Decimal, positions []exchange.MarginPosition, hedges []config.HedgeConfig) []Exposure {
+	exposures := make(map[string]*Exposure)
+	exposure := func(code currency.Code) *Exposure {
+		k := code.Upper().String()
+		e, ok := exposures[k]
+		if !ok {
+			e = &Exposure{Currency: code.Upper()}
+			exposures[k] = e
+		}
+		return e
+	}
+	for code, amount := range spot {
+		e := exposure(code)
+		e.Spot += amount.Float64()
+	}
+	for i := range positions {
+		size := positions[i].Size
+		if h := matchHedge(&positions[i], hedges); h != nil {
+			size *= h.ContractSize
+		}
+		e := exposure(positions[i].Pair.Base)
+		e.Derivatives += size
+	}
+	for i := range hedges {
+		e := exposure(currency.NewCode(hedges[i].Currency))
+		e.Hedged = true
+		e.Target = e.Spot * (1 - hedges[i].Ratio)
+	}
+
+	resp := make([]Exposure, 0, len(exposures))
+	for _, e := range exposures {
+		e.Net = e.Spot + e.Derivatives
+		resp = append(resp, *e)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		return resp[i].Currency.String() < resp[j].Currency.String()
+	})
+	return resp
+}
+
+// matchHedge returns the hedge whose contract a position is of, if any
+func matchHedge(p *exchange.MarginPosition, hedges []config.HedgeConfig) *config.HedgeConfig {
+	for i := range hedges {
+		if strings.EqualFold(hedges[i].Exchange, p.Exchange) &&
+			asset.Item(hedges[i].AssetType) == p.AssetType &&
+			currency.NewPairFromString(hedges[i].Pair).Equal(p.Pair) {
+			return &hedges[i]
+		}
+	}
+	return nil
+}
+
+// hedgePosition returns the size in contracts of a hedge's contract position
+func hedgePosition(positions []exchange.MarginPosition, h *config.HedgeConfig) float64 {
+	var size float64
+	for i := range positions {
+		if matchHedge(&positions[i], []config.HedgeConfig{*h}) != nil {
+			size += positions[i].Size
+		}
+	}
+	return size
+}
+
+// spotHoldings returns the holdings of each currency on the exchanges and
+// portfolio addresses
+func spotHoldings() map[currency.Code]decimal.Decimal {
+	p := portfolio.GetPortfolio()
+	holdings := p.GetExchangePortfolio()
+	for code, amount := range p.GetPersonalPortfolio() {
+		holdings[code] = holdings[code].Add(amount)
+	}
+	return holdings
+}
+
+// recordHedgeAction writes a hedge adjustment to the audit log and sends it to
+// the communication mediums
+func recordHedgeAction(h *config.HedgeConfig, action string, severity base.Severity) {
+	msg := fmt.Sprintf("Hedge manager: %s hedge on %s %s %s", h.Currency, h.Exchange, h.Pair, action)
+	if severity == base.SeverityWarning {
+		log.Warnln(log.Global, msg)
+	} else {
+		log.Errorln(log.Global, msg)
+	}
+	audit.Event(h.Currency, auditEventHedge, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:     base.EventTypeAlert,
+		Message:  msg,
+		Severity: severity,
+	})
+}
+
+// Exposures returns the net exposure of each currency held or with a
+// position, and the target of those hedged
+func (m *hedgeManager) Exposures() ([]Exposure, error) {
+	if !m.Started() {
+		return nil, errHedgeManagerNotStarted
+	}
+	positions, err := getMarginPositions()
+	if err != nil {
+		return nil, err
+	}
+	return netExposures(spotHoldings(), positions, m.cfg.Hedges), nil
+}
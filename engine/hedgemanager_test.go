@@ -0,0 +1,110 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var hedgeTestConfig = []config.HedgeConfig{
+	{
+		Currency:     "BTC",
+		Exchange:     "Bitfinex",
+		Pair:         "BTC-USDT",
+		AssetType:    "perpetualswap",
+		ContractSize: 0.001,
+		Ratio:        1,
+		Band:         0.05,
+	},
+}
+
+func TestNetExposures(t *testing.T) {
+	spot := map[currency.Code]decimal.Decimal{
+		currency.BTC: decimal.NewFromInt(2),
+		currency.ETH: decimal.NewFromInt(10),
+	}
+	positions := []exchange.MarginPosition{
+		{
+			Exchange:  "bitfinex",
+			Pair:      currency.NewPair(currency.BTC, currency.USDT),
+			AssetType: asset.PerpetualSwap,
+			Size:      -1500,
+		},
+		{
+			Exchange:  "Bitfinex",
+			Pair:      currency.NewPair(currency.ETH, currency.USDT),
+			AssetType: asset.PerpetualSwap,
+			Size:      -4,
+		},
+		{
+			Exchange:  "Bitfinex",
+			Pair:      currency.NewPair(currency.LTC, currency.USDT),
+			AssetType: asset.PerpetualSwap,
+			Size:      3,
+		},
+	}
+	exposures := netExposures(spot, positions, hedgeTestConfig)
+	if len(exposures) != 3 {
+		t.Fatalf("expected BTC, ETH and LTC exposures, received %+v", exposures)
+	}
+	btc, eth, ltc := exposures[0], exposures[1], exposures[2]
+	if btc.Currency != currency.BTC || btc.Spot != 2 || btc.Derivatives != -1.5 ||
+		btc.Net != 0.5 || !btc.Hedged || btc.Target != 0 {
+		t.Errorf("expected 1500 BTC contracts of 0.001 netted against 2 BTC, received %+v", btc)
+	}
+	if eth.Currency != currency.ETH || eth.Net != 6 || eth.Hedged {
+		t.Errorf("expected an unhedged net 6 ETH, received %+v", eth)
+	}
+	if ltc.Currency != currency.LTC || ltc.Spot != 0 || ltc.Net != 3 {
+		t.Errorf("expected a 3 LTC derivatives exposure, received %+v", ltc)
+	}
+	if p := hedgePosition(positions, &hedgeTestConfig[0]); p != -1500 {
+		t.Errorf("expected the hedge position of -1500 contracts, received %v", p)
+	}
+}
+
+func TestHedgeOrder(t *testing.T) {
+	h := &hedgeTestConfig[0]
+	// within the band of 5% of the 2 BTC held
+	if o := hedgeOrder(h, &Exposure{Spot: 2, Net: 0.1}); o != nil {
+		t.Errorf("expected no order within the band, received %+v", o)
+	}
+
+	o := hedgeOrder(h, &Exposure{Spot: 2, Net: 0.5})
+	if o == nil {
+		t.Fatal("expected an order outside the band")
+	}
+	if o.OrderSide != order.Sell || o.OrderType != order.Market ||
+		o.AssetType != asset.PerpetualSwap ||
+		!o.Pair.Equal(currency.NewPair(currency.BTC, currency.USDT)) ||
+		!o.Amount.Equal(decimal.NewFromInt(500)) {
+		t.Errorf("expected a market sell of 500 contracts, received %+v", o)
+	}
+	if reducesPosition(o, -1500) {
+		t.Error("expected a sell to grow a short hedge")
+	}
+
+	// the holdings were sold, so the hedge is bought back
+	o = hedgeOrder(h, &Exposure{Spot: 1, Net: -0.5})
+	if o == nil || o.OrderSide != order.Buy || !o.Amount.Equal(decimal.NewFromInt(500)) {
+		t.Fatalf("expected a market buy of 500 contracts, received %+v", o)
+	}
+	if !reducesPosition(o, -1500) {
+		t.Error("expected a buy to reduce a short hedge")
+	}
+	if reducesPosition(o, -100) {
+		t.Error("expected a buy larger than the short to not only reduce it")
+	}
+}
+
+func TestHedgeManagerExposures(t *testing.T) {
+	var m hedgeManager
+	if _, err := m.Exposures(); err != errHedgeManagerNotStarted {
+		t.Errorf("expected %v, received %v", errHedgeManagerNotStarted, err)
+	}
+}
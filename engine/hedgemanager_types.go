@@ -0,0 +1,40 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+const hedgeManagerName = "hedge manager"
+
+// auditEventHedge is the audit event type of hedge adjustments, identified by
+// the hedged currency
+const auditEventHedge = "hedge"
+
+var errHedgeManagerNotStarted = errors.New("hedge manager not started")
+
+// hedgeManager nets the spot holdings of each currency against its
+// derivatives positions, trading the contract of each configured hedge to
+// keep the net exposure of its currency within the hedge's band
+type hedgeManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	cfg      config.HedgeManagerConfig
+}
+
+// Exposure is the exposure to a currency, its spot holdings on the exchanges
+// and portfolio addresses and its derivatives positions in the currency, in
+// units of the currency
+type Exposure struct {
+	Currency    currency.Code
+	Spot        float64
+	Derivatives float64
+	Net         float64
+	// Hedged is set for currencies with a configured hedge, Target being the
+	// net exposure the hedge keeps
+	Hedged bool
+	Target float64
+}
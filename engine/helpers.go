@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"crypto/ecdsa"
 	"crypto/elliptic"
 	"crypto/rand"
@@ -56,6 +57,20 @@ func GetSubsystemsStatus() map[string]bool {
 	systems["deprecated_rpc"] = Bot.Settings.EnableDeprecatedRPC
 	systems["websocket_rpc"] = Bot.Settings.EnableWebsocketRPC
 	systems["dispatch"] = dispatch.IsRunning()
+	systems["resource_monitor"] = Bot.ResourceMonitor.Started()
+	systems["latency_monitor"] = Bot.LatencyMonitor.Started()
+	systems["transfer_manager"] = Bot.TransferManager.Started()
+	systems["withdrawal_limiter"] = Bot.WithdrawalLimiter.Started()
+	systems["security_monitor"] = Bot.SecurityMonitor.Started()
+	systems["price_alerts"] = Bot.PriceAlertManager.Started()
+	systems["trailing_stop"] = Bot.TrailingStop.Started()
+	systems["margin_manager"] = Bot.MarginManager.Started()
+	systems["pair_refresher"] = Bot.PairRefresher.Started()
+	systems["open_interest_recorder"] = Bot.OpenInterestRecorder.Started()
+	systems["defi_manager"] = Bot.DeFiManager.Started()
+	systems["calendar_manager"] = Bot.CalendarManager.Started()
+	systems["hedge_manager"] = Bot.HedgeManager.Started()
+	systems["candle_integrity"] = Bot.CandleIntegrity.Started()
 	return systems
 }
 
@@ -130,6 +145,76 @@ func SetSubsystem(subsys string, enable bool) error {
 			return dispatch.Start(Bot.Settings.DispatchMaxWorkerAmount, Bot.Settings.DispatchJobsLimit)
 		}
 		return dispatch.Stop()
+	case "resource_monitor":
+		if enable {
+			return Bot.ResourceMonitor.Start()
+		}
+		return Bot.ResourceMonitor.Stop()
+	case "latency_monitor":
+		if enable {
+			return Bot.LatencyMonitor.Start()
+		}
+		return Bot.LatencyMonitor.Stop()
+	case "transfer_manager":
+		if enable {
+			return Bot.TransferManager.Start()
+		}
+		return Bot.TransferManager.Stop()
+	case "withdrawal_limiter":
+		if enable {
+			return Bot.WithdrawalLimiter.Start()
+		}
+		return Bot.WithdrawalLimiter.Stop()
+	case "security_monitor":
+		if enable {
+			return Bot.SecurityMonitor.Start()
+		}
+		return Bot.SecurityMonitor.Stop()
+	case "price_alerts":
+		if enable {
+			return Bot.PriceAlertManager.Start()
+		}
+		return Bot.PriceAlertManager.Stop()
+	case "trailing_stop":
+		if enable {
+			return Bot.TrailingStop.Start()
+		}
+		return Bot.TrailingStop.Stop()
+	case "margin_manager":
+		if enable {
+			return Bot.MarginManager.Start()
+		}
+		return Bot.MarginManager.Stop()
+	case "pair_refresher":
+		if enable {
+			return Bot.PairRefresher.Start()
+		}
+		return Bot.PairRefresher.Stop()
+	case "open_interest_recorder":
+		if enable {
+			return Bot.OpenInterestRecorder.Start()
+		}
+		return Bot.OpenInterestRecorder.Stop()
+	case "defi_manager":
+		if enable {
+			return Bot.DeFiManager.Start()
+		}
+		return Bot.DeFiManager.Stop()
+	case "calendar_manager":
+		if enable {
+			return Bot.CalendarManager.Start()
+		}
+		return Bot.CalendarManager.Stop()
+	case "hedge_manager":
+		if enable {
+			return Bot.HedgeManager.Start()
+		}
+		return Bot.HedgeManager.Stop()
+	case "candle_integrity":
+		if enable {
+			return Bot.CandleIntegrity.Start()
+		}
+		return Bot.CandleIntegrity.Stop()
 	case "gctscript":
 		if enable {
 			vm.GCTScriptConfig.Enabled = true
@@ -439,7 +524,7 @@ func GetSpecificOrderbook(p currency.Pair, exchangeName string, assetType asset.
 	if exch == nil {
 		return nil, ErrExchangeNotFound
 	}
-	return exch.FetchOrderbook(p, assetType)
+	return exch.FetchOrderbook(Bot.Context(), p, assetType)
 }
 
 // GetSpecificTicker returns a specific ticker given the currency,
@@ -449,7 +534,7 @@ func GetSpecificTicker(p currency.Pair, exchangeName string, assetType asset.Ite
 	if exch == nil {
 		return nil, ErrExchangeNotFound
 	}
-	return exch.FetchTicker(p, assetType)
+	return exch.FetchTicker(Bot.Context(), p, assetType)
 }
 
 // GetCollatedExchangeAccountInfoByCoin collates individual exchange account
@@ -472,8 +557,8 @@ func GetCollatedExchangeAccountInfoByCoin(accounts []account.Holdings) map[curre
 					}
 					result[currencyName] = accountInfo
 				} else {
-					info.Hold += onHold
-					info.TotalValue += avail
+					info.Hold = info.Hold.Add(onHold)
+					info.TotalValue = info.TotalValue.Add(avail)
 					result[currencyName] = info
 				}
 			}
@@ -520,8 +605,8 @@ func SeedExchangeAccountInfo(accounts []account.Holdings) {
 				var update bool
 				for i := range currencies {
 					if accounts[x].Accounts[y].Currencies[z].CurrencyName == currencies[i].CurrencyName {
-						currencies[i].Hold += accounts[x].Accounts[y].Currencies[z].Hold
-						currencies[i].TotalValue += accounts[x].Accounts[y].Currencies[z].TotalValue
+						currencies[i].Hold = currencies[i].Hold.Add(accounts[x].Accounts[y].Currencies[z].Hold)
+						currencies[i].TotalValue = currencies[i].TotalValue.Add(accounts[x].Accounts[y].Currencies[z].TotalValue)
 						update = true
 					}
 				}
@@ -543,11 +628,11 @@ func SeedExchangeAccountInfo(accounts []account.Holdings) {
 			total := currencies[x].TotalValue
 
 			if !port.ExchangeAddressExists(exchangeName, currencyName) {
-				if total <= 0 {
+				if total.Sign() <= 0 {
 					continue
 				}
 
-				log.Debugf(log.PortfolioMgr, "Portfolio: Adding new exchange address: %s, %s, %f, %s\n",
+				log.Debugf(log.PortfolioMgr, "Portfolio: Adding new exchange address: %s, %s, %s, %s\n",
 					exchangeName,
 					currencyName,
 					total,
@@ -560,7 +645,7 @@ func SeedExchangeAccountInfo(accounts []account.Holdings) {
 						Balance:     total,
 						Description: portfolio.PortfolioAddressExchange})
 			} else {
-				if total <= 0 {
+				if total.Sign() <= 0 {
 					log.Debugf(log.PortfolioMgr, "Portfolio: Removing %s %s entry.\n",
 						exchangeName,
 						currencyName)
@@ -573,8 +658,8 @@ func SeedExchangeAccountInfo(accounts []account.Holdings) {
 						continue
 					}
 
-					if balance != total {
-						log.Debugf(log.PortfolioMgr, "Portfolio: Updating %s %s entry with balance %f.\n",
+					if !balance.Equal(total) {
+						log.Debugf(log.PortfolioMgr, "Portfolio: Updating %s %s entry with balance %s.\n",
 							exchangeName,
 							currencyName,
 							total)
@@ -653,7 +738,7 @@ func GetExchangeCryptocurrencyDepositAddress(exchName, accountID string, item cu
 	if exch == nil {
 		return "", ErrExchangeNotFound
 	}
-	return exch.GetDepositAddress(item, accountID)
+	return exch.GetDepositAddress(Bot.Context(), item, accountID)
 }
 
 // GetExchangeCryptocurrencyDepositAddresses obtains an exchanges deposit cryptocurrency list
@@ -678,7 +763,7 @@ func GetExchangeCryptocurrencyDepositAddresses() map[string]map[string]string {
 		cryptoAddr := make(map[string]string)
 		for y := range cryptoCurrencies {
 			cryptocurrency := cryptoCurrencies[y]
-			depositAddr, err := exchanges[x].GetDepositAddress(currency.NewCode(cryptocurrency), "")
+			depositAddr, err := exchanges[x].GetDepositAddress(Bot.Context(), currency.NewCode(cryptocurrency), "")
 			if err != nil {
 				log.Errorf(log.Global, "%s failed to get cryptocurrency deposit addresses. Err: %s\n", exchName, err)
 				continue
@@ -690,7 +775,8 @@ func GetExchangeCryptocurrencyDepositAddresses() map[string]map[string]string {
 	return result
 }
 
-// WithdrawCryptocurrencyFundsByExchange withdraws the desired cryptocurrency and amount to a desired cryptocurrency address
+// WithdrawCryptocurrencyFundsByExchange withdraws the desired cryptocurrency and amount to a desired cryptocurrency address.
+// Withdrawals above the approval limit are held pending approval
 func WithdrawCryptocurrencyFundsByExchange(exchName string, req *withdraw.CryptoRequest) (string, error) {
 	if req == nil {
 		return "", errors.New("crypto withdraw request param is nil")
@@ -701,7 +787,36 @@ func WithdrawCryptocurrencyFundsByExchange(exchName string, req *withdraw.Crypto
 		return "", ErrExchangeNotFound
 	}
 
-	return exch.WithdrawCryptocurrencyFunds(req)
+	if err := apiKeyAllows(exch, false, true); err != nil {
+		return "", err
+	}
+
+	return Bot.TransferManager.Withdraw(Bot.Context(), exch.GetName(), req.Currency, req.Amount,
+		req.Address, req.Address, func(ctx context.Context) (string, error) {
+			return exch.WithdrawCryptocurrencyFunds(ctx, req)
+		})
+}
+
+// WithdrawFiatFundsByExchange withdraws the desired fiat currency and amount
+// to a bank account
+func WithdrawFiatFundsByExchange(exchName string, req *withdraw.FiatRequest) (string, error) {
+	if req == nil {
+		return "", errors.New("fiat withdraw request param is nil")
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return "", ErrExchangeNotFound
+	}
+
+	if err := apiKeyAllows(exch, false, true); err != nil {
+		return "", err
+	}
+
+	return Bot.TransferManager.Withdraw(Bot.Context(), exch.GetName(), req.Currency, req.Amount,
+		"bank account "+req.BankAccountNumber, "", func(ctx context.Context) (string, error) {
+			return exch.WithdrawFiatFunds(ctx, req)
+		})
 }
 
 // FormatCurrency is a method that formats and returns a currency pair
@@ -734,7 +849,7 @@ func GetAllActiveTickers() []EnabledExchangeCurrencies {
 		for y := range assets {
 			currencies := exchanges[x].GetEnabledPairs(assets[y])
 			for z := range currencies {
-				tp, err := exchanges[x].FetchTicker(currencies[z], assets[y])
+				tp, err := exchanges[x].FetchTicker(Bot.Context(), currencies[z], assets[y])
 				if err != nil {
 					log.Errorf(log.ExchangeSys, "Exchange %s failed to retrieve %s ticker. Err: %s\n", exchName,
 						currencies[z].String(),
@@ -760,7 +875,7 @@ func GetAllEnabledExchangeAccountInfo() AllEnabledExchangeAccounts {
 			}
 			continue
 		}
-		accountInfo, err := exchanges[x].FetchAccountInfo()
+		accountInfo, err := exchanges[x].FetchAccountInfo(context.Background())
 		if err != nil {
 			log.Errorf(log.ExchangeSys, "Error encountered retrieving exchange account info for %s. Error %s\n",
 				exchanges[x].GetName(), err)
@@ -15,6 +15,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -469,8 +470,8 @@ func TestGetCollatedExchangeAccountInfoByCoin(t *testing.T) {
 			Currencies: []account.Balance{
 				{
 					CurrencyName: currency.BTC,
-					TotalValue:   100,
-					Hold:         0,
+					TotalValue:   decimal.NewFromInt(100),
+					Hold:         decimal.Zero,
 				},
 			},
 		})
@@ -484,13 +485,13 @@ func TestGetCollatedExchangeAccountInfoByCoin(t *testing.T) {
 			Currencies: []account.Balance{
 				{
 					CurrencyName: currency.LTC,
-					TotalValue:   100,
-					Hold:         0,
+					TotalValue:   decimal.NewFromInt(100),
+					Hold:         decimal.Zero,
 				},
 				{
 					CurrencyName: currency.BTC,
-					TotalValue:   100,
-					Hold:         0,
+					TotalValue:   decimal.NewFromInt(100),
+					Hold:         decimal.Zero,
 				},
 			},
 		})
@@ -507,7 +508,7 @@ func TestGetCollatedExchangeAccountInfoByCoin(t *testing.T) {
 		t.Fatal("Expected currency was not found in result map")
 	}
 
-	if amount.TotalValue != 200 {
+	if !amount.TotalValue.Equal(decimal.NewFromInt(200)) {
 		t.Fatal("Unexpected result")
 	}
 
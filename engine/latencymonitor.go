@@ -0,0 +1,234 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+func (l *latencyMonitor) Started() bool {
+	return atomic.LoadInt32(&l.started) == 1
+}
+
+func (l *latencyMonitor) Start() error {
+	if atomic.AddInt32(&l.started, 1) != 1 {
+		return errors.New("latency monitor already started")
+	}
+
+	l.interval = Bot.Config.LatencyMonitor.CheckInterval
+	l.setSamples(Bot.Config.LatencyMonitor.Samples)
+	l.shutdown = make(chan struct{})
+	go l.run()
+	log.Debugf(log.Global, "Latency monitor started, checking every %v.\n", l.interval)
+	return nil
+}
+
+func (l *latencyMonitor) Stop() error {
+	if atomic.LoadInt32(&l.started) == 0 {
+		return errors.New("latency monitor not started")
+	}
+
+	if atomic.AddInt32(&l.stopped, 1) != 1 {
+		return errors.New("latency monitor is already stopped")
+	}
+
+	close(l.shutdown)
+	log.Debugln(log.Global, "Latency monitor shutting down...")
+	return nil
+}
+
+func (l *latencyMonitor) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(l.interval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&l.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&l.started, 1, 0)
+		log.Debugln(log.Global, "Latency monitor shutdown.")
+	}()
+
+	guard(latencyMonitorName, l.check)
+	for {
+		select {
+		case <-l.shutdown:
+			return
+		case <-t.C:
+			guard(latencyMonitorName, l.check)
+		}
+	}
+}
+
+// setSamples sets the number of samples kept for each exchange
+func (l *latencyMonitor) setSamples(samples int) {
+	l.mtx.Lock()
+	l.samples = samples
+	l.exchanges = make(map[string]*latencySamples)
+	l.mtx.Unlock()
+}
+
+// check measures the round trip latency to every loaded exchange
+// concurrently, each probe timing out after the check interval
+func (l *latencyMonitor) check() {
+	exchanges := GetExchanges()
+	ctx, cancel := context.WithTimeout(Bot.Context(), l.interval)
+	defer cancel()
+
+	var wg sync.WaitGroup
+	for i := range exchanges {
+		wg.Add(1)
+		go func(exch exchange.IBotExchange) {
+			defer wg.Done()
+			rtt, err := probeLatency(ctx, exch)
+			l.record(exch.GetName(), rtt, err)
+		}(exchanges[i])
+	}
+	wg.Wait()
+}
+
+// probeLatency measures the round trip latency to an exchange's REST API
+// with a HEAD request to its API URL, using the exchange's HTTP client so any
+// configured proxy is included. Any response counts, as the status of the
+// request doesn't change how long the exchange took to respond
+func probeLatency(ctx context.Context, exch exchange.IBotExchange) (time.Duration, error) {
+	base := exch.GetBase()
+	if base.API.Endpoints.URL == "" {
+		return 0, errors.New("exchange API URL unset")
+	}
+	client := http.DefaultClient
+	if base.Requester != nil && base.Requester.HTTPClient != nil {
+		client = base.Requester.HTTPClient
+	}
+	req, err := http.NewRequest(http.MethodHead, base.API.Endpoints.URL, nil)
+	if err != nil {
+		return 0, err
+	}
+	req = req.WithContext(ctx)
+
+	start := time.Now()
+	resp, err := client.Do(req)
+	rtt := time.Since(start)
+	if err != nil {
+		return 0, err
+	}
+	resp.Body.Close()
+	return rtt, nil
+}
+
+// record stores a latency sample for an exchange, or the error measuring it
+func (l *latencyMonitor) record(name string, rtt time.Duration, err error) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	key := strings.ToLower(name)
+	s, ok := l.exchanges[key]
+	if !ok {
+		s = &latencySamples{name: name}
+		l.exchanges[key] = s
+	}
+	s.lastUpdated = time.Now()
+	if err != nil {
+		s.errors++
+		s.lastError = err.Error()
+		return
+	}
+	s.lastError = ""
+	if len(s.samples) < l.samples {
+		s.samples = append(s.samples, rtt)
+		s.next = len(s.samples) % l.samples
+	} else {
+		s.samples[s.next] = rtt
+		s.next = (s.next + 1) % l.samples
+	}
+	metrics.ExchangeLatency.Set(s.summary().Median.Seconds(), name)
+}
+
+// Latency returns the round trip latency summary of an exchange
+func (l *latencyMonitor) Latency(exchangeName string) (ExchangeLatency, bool) {
+	l.mtx.RLock()
+	defer l.mtx.RUnlock()
+	s, ok := l.exchanges[strings.ToLower(exchangeName)]
+	if !ok {
+		return ExchangeLatency{}, false
+	}
+	return s.summary(), true
+}
+
+// RankExchanges returns the latency summaries of the named exchanges, or of
+// every measured exchange if none are named, fastest first by median
+// latency. Exchanges without a successful measurement are ranked last so
+// callers such as order routers can prefer faster venues
+func (l *latencyMonitor) RankExchanges(exchangeNames ...string) []ExchangeLatency {
+	l.mtx.RLock()
+	var resp []ExchangeLatency
+	if len(exchangeNames) == 0 {
+		for _, s := range l.exchanges {
+			resp = append(resp, s.summary())
+		}
+	} else {
+		for i := range exchangeNames {
+			s, ok := l.exchanges[strings.ToLower(exchangeNames[i])]
+			if !ok {
+				resp = append(resp, ExchangeLatency{Exchange: exchangeNames[i]})
+				continue
+			}
+			resp = append(resp, s.summary())
+		}
+	}
+	l.mtx.RUnlock()
+
+	sort.SliceStable(resp, func(i, j int) bool {
+		if (resp[i].Samples == 0) != (resp[j].Samples == 0) {
+			return resp[i].Samples != 0
+		}
+		if resp[i].Median != resp[j].Median {
+			return resp[i].Median < resp[j].Median
+		}
+		return resp[i].Exchange < resp[j].Exchange
+	})
+	return resp
+}
+
+func (s *latencySamples) summary() ExchangeLatency {
+	e := ExchangeLatency{
+		Exchange:    s.name,
+		Samples:     len(s.samples),
+		Errors:      s.errors,
+		LastUpdated: s.lastUpdated,
+		LastError:   s.lastError,
+	}
+	if len(s.samples) == 0 {
+		return e
+	}
+	last := s.next - 1
+	if last < 0 {
+		last = len(s.samples) - 1
+	}
+	e.Last = s.samples[last]
+	sorted := make([]time.Duration, len(s.samples))
+	copy(sorted, s.samples)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	e.Median = latencyPercentile(sorted, 50)
+	e.P90 = latencyPercentile(sorted, 90)
+	return e
+}
+
+// latencyPercentile returns the nearest rank percentile of sorted samples
+func latencyPercentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+)
+
+func TestLatencyMonitorRecord(t *testing.T) {
+	var l latencyMonitor
+	l.setSamples(3)
+	for _, ms := range []time.Duration{30, 10, 20, 40} {
+		l.record("Bitstamp", ms*time.Millisecond, nil)
+	}
+	l.record("Bitstamp", 0, errors.New("timeout"))
+
+	e, ok := l.Latency("bitstamp")
+	if !ok {
+		t.Fatal("expected bitstamp latency to be recorded")
+	}
+	if e.Samples != 3 {
+		t.Errorf("expected 3 samples to be kept, received %d", e.Samples)
+	}
+	if e.Last != 40*time.Millisecond {
+		t.Errorf("expected last latency of 40ms, received %v", e.Last)
+	}
+	if e.Median != 20*time.Millisecond || e.P90 != 40*time.Millisecond {
+		t.Errorf("expected median 20ms and p90 40ms, received %v and %v", e.Median, e.P90)
+	}
+	if e.Errors != 1 || e.LastError != "timeout" {
+		t.Errorf("expected the failed probe to be recorded, received %d %q", e.Errors, e.LastError)
+	}
+
+	if _, ok := l.Latency("kraken"); ok {
+		t.Error("expected no latency for an unmeasured exchange")
+	}
+}
+
+func TestLatencyMonitorRankExchanges(t *testing.T) {
+	var l latencyMonitor
+	l.setSamples(5)
+	l.record("Kraken", 80*time.Millisecond, nil)
+	l.record("Bitstamp", 20*time.Millisecond, nil)
+	l.record("Bitfinex", 0, errors.New("refused"))
+
+	ranked := l.RankExchanges()
+	if len(ranked) != 3 || ranked[0].Exchange != "Bitstamp" ||
+		ranked[1].Exchange != "Kraken" || ranked[2].Exchange != "Bitfinex" {
+		t.Errorf("unexpected ranking %+v", ranked)
+	}
+
+	ranked = l.RankExchanges("Binance", "kraken", "bitstamp")
+	if len(ranked) != 3 || ranked[0].Exchange != "Bitstamp" ||
+		ranked[1].Exchange != "Kraken" || ranked[2].Exchange != "Binance" {
+		t.Errorf("unexpected ranking %+v", ranked)
+	}
+}
+
+func TestLatencyPercentile(t *testing.T) {
+	if latencyPercentile(nil, 50) != 0 {
+		t.Error("expected no latency without samples")
+	}
+	sorted := []time.Duration{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}
+	if p := latencyPercentile(sorted, 50); p != 5 {
+		t.Errorf("expected median 5, received %v", p)
+	}
+	if p := latencyPercentile(sorted, 90); p != 9 {
+		t.Errorf("expected p90 9, received %v", p)
+	}
+}
+
+func TestProbeLatency(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodHead {
+			t.Errorf("expected a HEAD request, received %s", r.Method)
+		}
+		w.WriteHeader(http.StatusNotFound)
+	}))
+	defer srv.Close()
+
+	b := new(bitstamp.Bitstamp)
+	b.SetDefaults()
+	b.API.Endpoints.URL = srv.URL
+	rtt, err := probeLatency(context.Background(), b)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rtt <= 0 {
+		t.Errorf("expected a positive round trip latency, received %v", rtt)
+	}
+
+	b.API.Endpoints.URL = ""
+	if _, err = probeLatency(context.Background(), b); err == nil {
+		t.Error("expected an error without an API URL")
+	}
+}
@@ -0,0 +1,43 @@
+package engine
+
+import (
+	"sync"
+	"time"
+)
+
+const latencyMonitorName = "latency monitor"
+
+// latencyMonitor periodically measures the round trip latency to each enabled
+// exchange, so latency sensitive strategies can prefer the fastest venues
+type latencyMonitor struct {
+	started   int32
+	stopped   int32
+	shutdown  chan struct{}
+	interval  time.Duration
+	samples   int
+	mtx       sync.RWMutex
+	exchanges map[string]*latencySamples
+}
+
+// latencySamples holds the last round trip latencies measured to an exchange
+type latencySamples struct {
+	name        string
+	samples     []time.Duration
+	next        int
+	lastUpdated time.Time
+	lastError   string
+	errors      uint64
+}
+
+// ExchangeLatency summarises the round trip latency to an exchange over its
+// most recent samples
+type ExchangeLatency struct {
+	Exchange    string
+	Last        time.Duration
+	Median      time.Duration
+	P90         time.Duration
+	Samples     int
+	Errors      uint64
+	LastUpdated time.Time
+	LastError   string
+}
@@ -0,0 +1,209 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (m *marginManager) Started() bool {
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+func (m *marginManager) Start() error {
+	if atomic.AddInt32(&m.started, 1) != 1 {
+		return errors.New("margin manager already started")
+	}
+
+	m.cfg = Bot.Config.MarginManager
+	m.shutdown = make(chan struct{})
+	go m.run()
+	log.Debugf(log.Global, "Margin manager started, topping up at a %v margin ratio and reducing at %v.\n",
+		m.cfg.TopUpRatio, m.cfg.ReduceRatio)
+	return nil
+}
+
+func (m *marginManager) Stop() error {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return errMarginManagerNotStarted
+	}
+
+	if atomic.AddInt32(&m.stopped, 1) != 1 {
+		return errors.New("margin manager is already stopped")
+	}
+
+	close(m.shutdown)
+	log.Debugln(log.Global, "Margin manager shutting down...")
+	return nil
+}
+
+func (m *marginManager) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(m.cfg.CheckInterval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&m.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&m.started, 1, 0)
+		log.Debugln(log.Global, "Margin manager shutdown.")
+	}()
+
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			guard(marginManagerName, m.check)
+		}
+	}
+}
+
+// check maintains the open positions on every exchange with authenticated API
+// support, exchanges without margin position support are skipped
+func (m *marginManager) check() {
+	exchanges := GetAuthAPISupportedExchanges()
+	for i := range exchanges {
+		exch := GetExchangeByName(exchanges[i])
+		if exch == nil {
+			continue
+		}
+		positions, err := exch.GetMarginPositions(Bot.Context())
+		if err != nil {
+			if !unsupportedHistory(err) {
+				log.Errorf(log.Global, "Margin manager unable to get %s positions: %v\n",
+					exchanges[i], err)
+			}
+			continue
+		}
+		for j := range positions {
+			if positions[j].Size == 0 {
+				continue
+			}
+			m.maintain(exch, &positions[j])
+		}
+	}
+}
+
+// maintain tops up an isolated position at the top up ratio to the target
+// ratio. A position at the reduce ratio which can't be topped up is reduced
+// by the reduce fraction with a market order
+func (m *marginManager) maintain(exch exchange.IBotExchange, p *exchange.MarginPosition) {
+	ratio := p.MarginRatio()
+	if ratio < m.cfg.TopUpRatio {
+		return
+	}
+
+	if p.Isolated {
+		amount := topUpAmount(p, m.cfg.TargetRatio)
+		err := exch.AddMargin(Bot.Context(), p.Pair, p.AssetType, amount)
+		if err == nil {
+			recordMarginAction(p, fmt.Sprintf("margin ratio %.4f, added %v %s margin",
+				ratio, amount, p.Currency), base.SeverityWarning)
+			return
+		}
+		recordMarginAction(p, fmt.Sprintf("margin ratio %.4f, unable to add %v %s margin: %v",
+			ratio, amount, p.Currency, err), base.SeverityError)
+	}
+	if ratio < m.cfg.ReduceRatio {
+		return
+	}
+
+	reduce := reduceOrder(p, m.cfg.ReduceFraction)
+	resp, err := Bot.OrderManager.submitReducing(p.Exchange, reduce)
+	if err != nil {
+		recordMarginAction(p, fmt.Sprintf("margin ratio %.4f, unable to reduce position of %v by %v: %v",
+			ratio, p.Size, reduce.Amount, err), base.SeverityCritical)
+		return
+	}
+	recordMarginAction(p, fmt.Sprintf("margin ratio %.4f, reduced position of %v by %v with %s order ID=%s",
+		ratio, p.Size, reduce.Amount, reduce.OrderSide, resp.OrderID), base.SeverityWarning)
+}
+
+// topUpAmount returns the margin to add to bring a position to the target
+// margin ratio
+func topUpAmount(p *exchange.MarginPosition, target float64) decimal.Decimal {
+	amount := p.MaintenanceMargin/target - p.Margin
+	if amount <= 0 {
+		return decimal.Zero
+	}
+	return decimal.NewFromFloat(amount)
+}
+
+// reduceOrder returns the market order closing a fraction of a position.
+// Positions in whole contracts are reduced by at least one contract
+func reduceOrder(p *exchange.MarginPosition, fraction float64) *order.Submit {
+	size := math.Abs(p.Size)
+	amount := size * fraction
+	if size == math.Trunc(size) {
+		amount = math.Ceil(amount)
+	}
+	side := order.Sell
+	if p.Size < 0 {
+		side = order.Buy
+	}
+	return &order.Submit{
+		Pair:      p.Pair,
+		AssetType: p.AssetType,
+		OrderType: order.Market,
+		OrderSide: side,
+		Amount:    decimal.NewFromFloat(amount),
+	}
+}
+
+// recordMarginAction writes a margin action to the audit log and sends it to
+// the communication mediums
+func recordMarginAction(p *exchange.MarginPosition, action string, severity base.Severity) {
+	id := fmt.Sprintf("%s %s %s", p.Exchange, p.Pair, p.AssetType)
+	msg := fmt.Sprintf("Margin manager: %s position %s", id, action)
+	if severity == base.SeverityWarning {
+		log.Warnln(log.Global, msg)
+	} else {
+		log.Errorln(log.Global, msg)
+	}
+	audit.Event(id, auditEventMargin, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:     base.EventTypeAlert,
+		Message:  msg,
+		Severity: severity,
+	})
+}
+
+// Positions returns the open positions on every exchange with authenticated
+// API support which reports them
+func (m *marginManager) Positions() ([]exchange.MarginPosition, error) {
+	if !m.Started() {
+		return nil, errMarginManagerNotStarted
+	}
+	return getMarginPositions()
+}
+
+// getMarginPositions returns the open positions on every exchange with
+// authenticated API support which reports them
+func getMarginPositions() ([]exchange.MarginPosition, error) {
+	var resp []exchange.MarginPosition
+	exchanges := GetAuthAPISupportedExchanges()
+	for i := range exchanges {
+		exch := GetExchangeByName(exchanges[i])
+		if exch == nil {
+			continue
+		}
+		positions, err := exch.GetMarginPositions(Bot.Context())
+		if err != nil {
+			if unsupportedHistory(err) {
+				continue
+			}
+			return nil, fmt.Errorf("%s: %v", exchanges[i], err)
+		}
+		resp = append(resp, positions...)
+	}
+	return resp, nil
+}
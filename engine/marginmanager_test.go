@@ -0,0 +1,107 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// marginTestExchange records the margin added to positions
+type marginTestExchange struct {
+	exchange.IBotExchange
+	added     decimal.Decimal
+	addErr    error
+	addCalled bool
+}
+
+func (e *marginTestExchange) AddMargin(_ context.Context, _ currency.Pair, _ asset.Item, amount decimal.Decimal) error {
+	e.addCalled = true
+	if e.addErr != nil {
+		return e.addErr
+	}
+	e.added = e.added.Add(amount)
+	return nil
+}
+
+func TestTopUpAmount(t *testing.T) {
+	p := exchange.MarginPosition{Margin: 1, MaintenanceMargin: 0.6}
+	if a := topUpAmount(&p, 0.3); !a.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("expected a top up of 1, received %v", a)
+	}
+	p.Margin = 3
+	if a := topUpAmount(&p, 0.3); !a.IsZero() {
+		t.Errorf("expected no top up above the target, received %v", a)
+	}
+}
+
+func TestReduceOrder(t *testing.T) {
+	p := exchange.MarginPosition{
+		Pair:      currency.NewPair(currency.XBT, currency.USD),
+		AssetType: asset.PerpetualContract,
+		Size:      -10,
+	}
+	o := reduceOrder(&p, 0.25)
+	if o.OrderSide != order.Buy || o.OrderType != order.Market ||
+		o.AssetType != asset.PerpetualContract ||
+		!o.Amount.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("expected a market buy of 3 contracts, received %+v", o)
+	}
+
+	p.Size = 0.5
+	o = reduceOrder(&p, 0.25)
+	if o.OrderSide != order.Sell || !o.Amount.Equal(decimal.NewFromFloat(0.125)) {
+		t.Errorf("expected a market sell of 0.125, received %+v", o)
+	}
+}
+
+func TestMarginManagerMaintain(t *testing.T) {
+	SetupTestHelpers(t)
+	m := marginManager{cfg: config.MarginManagerConfig{
+		TargetRatio:    0.3,
+		TopUpRatio:     0.5,
+		ReduceRatio:    0.8,
+		ReduceFraction: 0.25,
+	}}
+	exch := &marginTestExchange{}
+	p := exchange.MarginPosition{
+		Exchange:          "marginTest",
+		Pair:              currency.NewPair(currency.XBT, currency.USD),
+		AssetType:         asset.PerpetualContract,
+		Size:              100,
+		Currency:          currency.XBT,
+		Margin:            1,
+		MaintenanceMargin: 0.4,
+		Isolated:          true,
+	}
+	m.maintain(exch, &p)
+	if exch.addCalled {
+		t.Error("expected no top up below the top up ratio")
+	}
+
+	p.MaintenanceMargin = 0.6
+	m.maintain(exch, &p)
+	if !exch.added.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("expected the position to be topped up to the target ratio, received %v", exch.added)
+	}
+
+	// A failed top up below the reduce ratio leaves the position
+	exch = &marginTestExchange{addErr: errors.New("insufficient balance")}
+	m.maintain(exch, &p)
+	if !exch.addCalled {
+		t.Error("expected a top up to be attempted")
+	}
+}
+
+func TestMarginManagerPositions(t *testing.T) {
+	var m marginManager
+	if _, err := m.Positions(); err != errMarginManagerNotStarted {
+		t.Errorf("expected %v, received %v", errMarginManagerNotStarted, err)
+	}
+}
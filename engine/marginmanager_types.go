@@ -0,0 +1,25 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+const marginManagerName = "margin manager"
+
+// auditEventMargin is the audit event type of margin top ups and position
+// reductions, identified by the exchange, pair and asset of the position
+const auditEventMargin = "margin"
+
+var errMarginManagerNotStarted = errors.New("margin manager not started")
+
+// marginManager keeps leveraged positions away from liquidation by topping
+// up the margin of isolated positions and reducing positions which can't be
+// topped up, recording every action taken in the audit log
+type marginManager struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	cfg      config.MarginManagerConfig
+}
@@ -0,0 +1,36 @@
+package engine
+
+import (
+	"net/http"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+// StartMetricsServer starts a HTTP server exposing Prometheus metrics on
+// /metrics
+func StartMetricsServer() {
+	listenAddr := Bot.Config.Metrics.ListenAddress
+	log.Debugf(log.RESTSys,
+		"Metrics server support enabled. Listen URL: http://%s:%d/metrics\n",
+		common.ExtractHost(listenAddr), common.ExtractPort(listenAddr))
+	mux := http.NewServeMux()
+	mux.HandleFunc("/metrics", metricsHandler)
+	err := http.ListenAndServe(listenAddr, mux)
+	if err != nil {
+		log.Errorf(log.RESTSys, "Failed to start metrics server. Err: %s", err)
+	}
+}
+
+// metricsHandler refreshes the subsystem health gauges and writes all metrics
+func metricsHandler(w http.ResponseWriter, r *http.Request) {
+	for name, started := range GetSubsystemsStatus() {
+		var up float64
+		if started {
+			up = 1
+		}
+		metrics.SubsystemUp.Set(up, name)
+	}
+	metrics.DefaultRegistry.Handler().ServeHTTP(w, r)
+}
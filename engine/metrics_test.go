@@ -0,0 +1,20 @@
+package engine
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestMetricsHandler(t *testing.T) {
+	SetupTestHelpers(t)
+	rec := httptest.NewRecorder()
+	metricsHandler(rec, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, received %d", http.StatusOK, rec.Code)
+	}
+	if !strings.Contains(rec.Body.String(), `gct_subsystem_up{subsystem="orders"}`) {
+		t.Errorf("subsystem health metric not found:\n%s", rec.Body.String())
+	}
+}
@@ -0,0 +1,65 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"github.com/thrasher-corp/gocryptotrader/testdata/mockserver"
+)
+
+func TestMockServerIntegration(t *testing.T) {
+	SetupTestHelpers(t)
+	LoadExchange("Bitstamp", false, nil)
+	exch := GetExchangeByName("Bitstamp")
+	if exch == nil {
+		t.Fatal("expected Bitstamp to be loaded")
+	}
+
+	// Restore the live endpoints so later tests aren't served by the
+	// recorded responses
+	b := exch.GetBase()
+	client, endpoints := b.GetHTTPClient(), b.API.Endpoints
+	defer func() {
+		b.SetHTTPClient(client)
+		b.API.Endpoints = endpoints
+	}()
+	if _, err := mockserver.Attach(exch); err != nil {
+		t.Fatal(err)
+	}
+	defer mockserver.DetachWebsockets()
+
+	p := currency.NewPair(currency.BTC, currency.USD)
+	if _, err := exch.UpdateTicker(context.Background(), p, asset.Spot); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := exch.UpdateOrderbook(context.Background(), p, asset.Spot); err != nil {
+		t.Fatal(err)
+	}
+
+	s := RPCServer{}
+	tick, err := s.GetTicker(context.Background(), &gctrpc.GetTickerRequest{
+		Exchange:  "Bitstamp",
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		AssetType: asset.Spot.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.Last == 0 {
+		t.Errorf("expected the recorded ticker, received %+v", tick)
+	}
+	ob, err := s.GetOrderbook(context.Background(), &gctrpc.GetOrderbookRequest{
+		Exchange:  "Bitstamp",
+		Pair:      &gctrpc.CurrencyPair{Base: "BTC", Quote: "USD"},
+		AssetType: asset.Spot.String(),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ob.Bids) == 0 || len(ob.Asks) == 0 {
+		t.Errorf("expected the recorded orderbook, received %+v", ob)
+	}
+}
@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (o *openInterestRecorder) Started() bool {
+	return atomic.LoadInt32(&o.started) == 1
+}
+
+func (o *openInterestRecorder) Start() error {
+	if atomic.AddInt32(&o.started, 1) != 1 {
+		return errors.New("open interest recorder already started")
+	}
+
+	o.cfg = Bot.Config.OpenInterest
+	o.shutdown = make(chan struct{})
+	go o.run()
+	log.Debugf(log.Global, "Open interest recorder started, recording every %v.\n", o.cfg.Interval)
+	return nil
+}
+
+func (o *openInterestRecorder) Stop() error {
+	if atomic.LoadInt32(&o.started) == 0 {
+		return errOpenInterestRecorderNotStarted
+	}
+
+	if atomic.AddInt32(&o.stopped, 1) != 1 {
+		return errors.New("open interest recorder is already stopped")
+	}
+
+	close(o.shutdown)
+	log.Debugln(log.Global, "Open interest recorder shutting down...")
+	return nil
+}
+
+func (o *openInterestRecorder) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(o.cfg.Interval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&o.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&o.started, 1, 0)
+		log.Debugln(log.Global, "Open interest recorder shutdown.")
+	}()
+
+	for {
+		select {
+		case <-o.shutdown:
+			return
+		case <-t.C:
+			guard(openInterestRecorderName, o.record)
+		}
+	}
+}
+
+// record stores the open interest of every enabled exchange's derivatives
+// pairs. Nothing is fetched while the database is unavailable as there is
+// nowhere to keep it
+func (o *openInterestRecorder) record() {
+	if !databaseConnected() {
+		log.Warnln(log.Global, "Open interest recorder: Database unavailable, skipping recording")
+		return
+	}
+	exchanges := GetExchanges()
+	for i := range exchanges {
+		records := openInterestRecords(Bot.Context(), exchanges[i])
+		if len(records) == 0 {
+			continue
+		}
+		if _, err := openinterest.Insert(records...); err != nil {
+			log.Errorf(log.Global, "Open interest recorder unable to store %s open interest: %v\n",
+				exchanges[i].GetName(), err)
+		}
+	}
+}
+
+// openInterestRecords fetches the open interest of an exchange's enabled
+// derivatives pairs as database rows. Exchanges or assets without open
+// interest support are skipped, other errors are logged and the pair skipped
+func openInterestRecords(ctx context.Context, exch exchange.IBotExchange) []openinterest.Record {
+	var records []openinterest.Record
+	assets := exch.GetAssetTypes()
+	for i := range assets {
+		if !derivativesAsset(assets[i]) {
+			continue
+		}
+		pairs := exch.GetEnabledPairs(assets[i])
+		for j := range pairs {
+			oi, err := exch.GetOpenInterest(ctx, pairs[j], assets[i])
+			if unsupportedHistory(err) {
+				break
+			}
+			if err != nil {
+				log.Errorf(log.ExchangeSys, "%s unable to get %s %s open interest: %v\n",
+					exch.GetName(), pairs[j], assets[i], err)
+				continue
+			}
+			records = append(records, openInterestRow(&oi))
+		}
+	}
+	return records
+}
+
+// openInterestRow converts exchange open interest into a database row. Pairs
+// are stored upper case without a delimiter so rows match regardless of how
+// the pair was formatted when requested
+func openInterestRow(oi *exchange.OpenInterest) openinterest.Record {
+	recordedAt := oi.Timestamp
+	if recordedAt.IsZero() {
+		recordedAt = time.Now()
+	}
+	return openinterest.Record{
+		Exchange:       oi.Exchange,
+		Asset:          oi.AssetType.String(),
+		Pair:           oi.Pair.Format("", true).String(),
+		Amount:         oi.Amount,
+		Value:          oi.Value,
+		Currency:       oi.Currency.String(),
+		LongShortRatio: oi.LongShortRatio,
+		RecordedAt:     recordedAt.UTC().Truncate(time.Second),
+	}
+}
+
+// derivativesAsset reports whether an asset type has open interest
+func derivativesAsset(a asset.Item) bool {
+	switch a {
+	case asset.Futures, asset.PerpetualContract, asset.PerpetualSwap,
+		asset.UpsideProfitContract, asset.DownsideProfitContract:
+		return true
+	}
+	return false
+}
@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// openInterestTestExchange returns canned open interest for its futures
+// pairs and reports perpetual swaps as unsupported
+type openInterestTestExchange struct {
+	exchange.IBotExchange
+	calls int
+}
+
+func (e *openInterestTestExchange) GetName() string { return "oitest" }
+
+func (e *openInterestTestExchange) GetAssetTypes() asset.Items {
+	return asset.Items{asset.Spot, asset.Futures, asset.PerpetualSwap}
+}
+
+func (e *openInterestTestExchange) GetEnabledPairs(asset.Item) currency.Pairs {
+	return currency.Pairs{
+		currency.NewPairWithDelimiter("BTC", "USD", "-"),
+		currency.NewPairWithDelimiter("ETH", "USD", "-"),
+	}
+}
+
+func (e *openInterestTestExchange) GetOpenInterest(_ context.Context, p currency.Pair, a asset.Item) (exchange.OpenInterest, error) {
+	e.calls++
+	switch {
+	case a != asset.Futures:
+		return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+	case p.Base == currency.ETH:
+		return exchange.OpenInterest{}, errors.New("contract not found")
+	}
+	return exchange.OpenInterest{
+		Exchange:       e.GetName(),
+		AssetType:      a,
+		Pair:           p,
+		Amount:         1000,
+		Currency:       currency.USD,
+		LongShortRatio: 1.5,
+		Timestamp:      time.Date(2020, 4, 1, 0, 0, 0, 500, time.UTC),
+	}, nil
+}
+
+func TestOpenInterestRecords(t *testing.T) {
+	e := &openInterestTestExchange{}
+	records := openInterestRecords(context.Background(), e)
+	if len(records) != 1 {
+		t.Fatalf("expected 1 record, received %v", len(records))
+	}
+	// Two futures calls and a single perpetual swap call before the asset is
+	// skipped as unsupported, spot is never requested
+	if e.calls != 3 {
+		t.Errorf("expected 3 calls, received %v", e.calls)
+	}
+	r := records[0]
+	if r.Pair != "BTCUSD" || r.Asset != "futures" || r.Currency != "USD" ||
+		r.Amount != 1000 || r.LongShortRatio != 1.5 {
+		t.Errorf("unexpected record %+v", r)
+	}
+	if !r.RecordedAt.Equal(time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)) {
+		t.Errorf("expected the timestamp truncated to the second, received %v", r.RecordedAt)
+	}
+}
+
+func TestOpenInterestRow(t *testing.T) {
+	r := openInterestRow(&exchange.OpenInterest{
+		Pair:      currency.NewPairWithDelimiter("xbt", "usd", "-"),
+		AssetType: asset.PerpetualContract,
+	})
+	if r.Pair != "XBTUSD" {
+		t.Errorf("expected XBTUSD, received %v", r.Pair)
+	}
+	if r.RecordedAt.IsZero() {
+		t.Error("expected a missing timestamp to default to now")
+	}
+}
+
+func TestOpenInterestRecorderStop(t *testing.T) {
+	var o openInterestRecorder
+	if err := o.Stop(); err != errOpenInterestRecorderNotStarted {
+		t.Errorf("expected %v, received %v", errOpenInterestRecorderNotStarted, err)
+	}
+}
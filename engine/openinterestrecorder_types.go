@@ -0,0 +1,21 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+const openInterestRecorderName = "open interest recorder"
+
+var errOpenInterestRecorderNotStarted = errors.New("open interest recorder not started")
+
+// openInterestRecorder stores the open interest and long/short ratio of every
+// enabled derivatives pair each interval, building the history served by the
+// GetOpenInterest RPC
+type openInterestRecorder struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	cfg      config.OpenInterestConfig
+}
@@ -0,0 +1,226 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// load restores the orders awaiting expiry from the state file at path
+func (e *orderExpiries) load(path string) error {
+	var orders []*expiringOrder
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		switch {
+		case err == nil:
+			if err = json.Unmarshal(data, &orders); err != nil {
+				return fmt.Errorf("unable to read order expiries %s: %v", path, err)
+			}
+		case !os.IsNotExist(err):
+			return err
+		}
+	}
+
+	e.m.Lock()
+	defer e.m.Unlock()
+	e.orders = make(map[string]*expiringOrder)
+	e.changed = make(chan struct{}, 1)
+	for _, o := range orders {
+		e.orders[orderKey(o.Exchange, o.OrderID)] = o
+	}
+	return nil
+}
+
+// save writes the orders awaiting expiry to path, removing the file when
+// there are none
+func (e *orderExpiries) save(path string) error {
+	e.m.Lock()
+	orders := make([]*expiringOrder, 0, len(e.orders))
+	for _, o := range e.orders {
+		orders = append(orders, o)
+	}
+	e.m.Unlock()
+	if len(orders) == 0 {
+		if err := os.Remove(path); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+		return nil
+	}
+
+	data, err := json.MarshalIndent(orders, "", " ")
+	if err != nil {
+		return err
+	}
+	err = file.Write(path, data)
+	if err != nil {
+		return err
+	}
+	log.Debugf(log.OrderMgr, "Order manager: %d order expiries saved to %s\n", len(orders), path)
+	return nil
+}
+
+// schedule cancels a placed good till date order once it expires
+func (e *orderExpiries) schedule(exchName, orderID string, s *order.Submit) {
+	e.m.Lock()
+	if e.orders == nil {
+		e.orders = make(map[string]*expiringOrder)
+	}
+	e.orders[orderKey(exchName, orderID)] = &expiringOrder{
+		Exchange:      exchName,
+		OrderID:       orderID,
+		Pair:          s.Pair,
+		AssetType:     s.AssetType,
+		Side:          s.OrderSide,
+		CorrelationID: s.CorrelationID,
+		ExpiresAt:     s.ExpiresAt,
+	}
+	e.m.Unlock()
+	select {
+	case e.changed <- struct{}{}:
+	default:
+	}
+}
+
+// move carries the expiry of an order over to the order replacing it
+func (e *orderExpiries) move(exchName, orderID, replacementID string) {
+	e.m.Lock()
+	defer e.m.Unlock()
+	key := orderKey(exchName, orderID)
+	o, ok := e.orders[key]
+	if !ok {
+		return
+	}
+	delete(e.orders, key)
+	o.OrderID = replacementID
+	e.orders[orderKey(exchName, replacementID)] = o
+}
+
+// remove cancels the expiry of an order
+func (e *orderExpiries) remove(exchName, orderID string) {
+	e.m.Lock()
+	delete(e.orders, orderKey(exchName, orderID))
+	e.m.Unlock()
+}
+
+// retryAt delays cancelling an expired order until t
+func (e *orderExpiries) retryAt(exchName, orderID string, t time.Time) {
+	e.m.Lock()
+	if o, ok := e.orders[orderKey(exchName, orderID)]; ok {
+		o.retry = t
+	}
+	e.m.Unlock()
+}
+
+// due returns the orders to cancel at t
+func (e *orderExpiries) due(t time.Time) []expiringOrder {
+	e.m.Lock()
+	defer e.m.Unlock()
+	var resp []expiringOrder
+	for _, o := range e.orders {
+		if !o.ExpiresAt.After(t) && !o.retry.After(t) {
+			resp = append(resp, *o)
+		}
+	}
+	return resp
+}
+
+// next returns how long after t the next order is due to be cancelled
+func (e *orderExpiries) next(t time.Time) time.Duration {
+	e.m.Lock()
+	defer e.m.Unlock()
+	wait := orderExpiryIdle
+	for _, o := range e.orders {
+		at := o.ExpiresAt
+		if o.retry.After(at) {
+			at = o.retry
+		}
+		if d := at.Sub(t); d < wait {
+			wait = d
+		}
+	}
+	if wait < 0 {
+		wait = 0
+	}
+	return wait
+}
+
+// emulatesExpiry returns whether the expiry of an order is emulated by the
+// order manager, erroring when the exchange doesn't support the order's time
+// in force. Good till date orders are sent as good till cancelled to
+// exchanges which don't support them and cancelled once they expire
+func emulatesExpiry(exch exchange.IBotExchange, s *order.Submit) (bool, error) {
+	features := exch.GetBase().Features.Supports.RESTCapabilities
+	switch s.TimeInForce {
+	case order.IOC:
+		if !features.ImmediateOrCancel {
+			return false, fmt.Errorf("%v: %s %s", ErrTimeInForceUnsupported, exch.GetName(), s.TimeInForce)
+		}
+	case order.FOK:
+		if !features.FillOrKill {
+			return false, fmt.Errorf("%v: %s %s", ErrTimeInForceUnsupported, exch.GetName(), s.TimeInForce)
+		}
+	case order.GTD:
+		return !features.GoodTillDate, nil
+	}
+	return false, nil
+}
+
+// expireOrders cancels the good till date orders which have expired. An order
+// which fails to cancel is tried again after orderExpiryRetryDelay, unless it
+// has already closed
+func (o *orderManager) expireOrders() {
+	now := time.Now()
+	due := o.expiries.due(now)
+	for i := range due {
+		e := &due[i]
+		err := o.Cancel(e.Exchange, &order.Cancel{
+			OrderID:      e.OrderID,
+			CurrencyPair: e.Pair,
+			AssetType:    e.AssetType,
+			Side:         e.Side,
+		})
+		if err == nil {
+			o.expiries.remove(e.Exchange, e.OrderID)
+			o.partialFills.remove(e.Exchange, e.OrderID)
+			msg := fmt.Sprintf("Exchange %s cancelled order ID=%v which expired at %v",
+				e.Exchange, e.OrderID, e.ExpiresAt.UTC().Format(time.RFC3339))
+			o.recordExpiry(e, msg)
+			continue
+		}
+		if exch := GetExchangeByName(e.Exchange); exch != nil {
+			if d, lookupErr := o.lookupOrder(exch, e.OrderID); lookupErr == nil && isOrderClosed(d.Status) {
+				o.expiries.remove(e.Exchange, e.OrderID)
+				continue
+			}
+		}
+		log.Warnf(log.OrderMgr, "Order manager: Exchange %s unable to cancel expired order ID=%v, retrying: %v\n",
+			e.Exchange, e.OrderID, err)
+		o.expiries.retryAt(e.Exchange, e.OrderID, now.Add(orderExpiryRetryDelay))
+	}
+}
+
+// recordExpiry logs and audits the cancellation of an expired order
+func (o *orderManager) recordExpiry(e *expiringOrder, msg string) {
+	id := e.CorrelationID
+	if id == "" {
+		id = e.OrderID
+	}
+	log.WithFields(log.OrderMgr, log.Fields{
+		Exchange:      e.Exchange,
+		CorrelationID: e.CorrelationID,
+	}).Infof("Order manager: %s\n", msg)
+	audit.Event(id, auditEventOrder, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    base.EventTypeOrder,
+		Message: fmt.Sprintf("Order manager: %s.", msg),
+	})
+}
@@ -0,0 +1,153 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestEmulatesExpiry(t *testing.T) {
+	exch := &modifyTestExchange{}
+	for _, tif := range []order.TimeInForce{order.IOC, order.FOK} {
+		_, err := emulatesExpiry(exch, &order.Submit{TimeInForce: tif})
+		if err == nil || !strings.HasPrefix(err.Error(), ErrTimeInForceUnsupported.Error()) {
+			t.Errorf("expected %v for %s, got %v", ErrTimeInForceUnsupported, tif, err)
+		}
+	}
+	if emulate, err := emulatesExpiry(exch, &order.Submit{TimeInForce: order.GTD}); err != nil || !emulate {
+		t.Errorf("expected good till date to be emulated, got %v %v", emulate, err)
+	}
+	if emulate, err := emulatesExpiry(exch, &order.Submit{}); err != nil || emulate {
+		t.Errorf("expected good till cancelled to be sent as is, got %v %v", emulate, err)
+	}
+}
+
+func TestOrderExpiries(t *testing.T) {
+	dir, err := ioutil.TempDir("", "orderexpiries")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, orderExpiryStateFile)
+
+	now := time.Now()
+	var e orderExpiries
+	if err = e.load(path); err != nil {
+		t.Fatal(err)
+	}
+	if e.next(now) != orderExpiryIdle {
+		t.Error("expected to idle with no orders scheduled")
+	}
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	e.schedule("Bitstamp", "1", &order.Submit{Pair: pair, ExpiresAt: now.Add(time.Minute)})
+	e.schedule("Bitstamp", "2", &order.Submit{Pair: pair, ExpiresAt: now.Add(-time.Minute)})
+	select {
+	case <-e.changed:
+	default:
+		t.Error("expected scheduling to wake the order manager")
+	}
+	if e.next(now) != 0 {
+		t.Errorf("expected the expired order to be due now, got %v", e.next(now))
+	}
+	if due := e.due(now); len(due) != 1 || due[0].OrderID != "2" {
+		t.Fatalf("expected order 2 due, got %+v", due)
+	}
+	e.retryAt("Bitstamp", "2", now.Add(time.Second*30))
+	if due := e.due(now); len(due) != 0 {
+		t.Errorf("expected the retry to wait, got %+v", due)
+	}
+	if d := e.next(now); d != time.Second*30 {
+		t.Errorf("expected to wake for the retry, got %v", d)
+	}
+	e.move("Bitstamp", "1", "3")
+	if due := e.due(now.Add(time.Hour)); len(due) != 2 {
+		t.Errorf("expected both orders due, got %+v", due)
+	}
+
+	if err = e.save(path); err != nil {
+		t.Fatal(err)
+	}
+	var restored orderExpiries
+	if err = restored.load(path); err != nil {
+		t.Fatal(err)
+	}
+	due := restored.due(now.Add(time.Hour))
+	if len(due) != 2 {
+		t.Fatalf("expected both orders restored, got %+v", due)
+	}
+	restored.remove("Bitstamp", "2")
+	restored.remove("Bitstamp", "3")
+	if err = restored.save(path); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(path); !os.IsNotExist(err) {
+		t.Errorf("expected the state file to be removed, got %v", err)
+	}
+}
+
+func TestOrderManagerGoodTillDate(t *testing.T) {
+	SetupTestHelpers(t)
+	exch := &modifyTestExchange{}
+	Bot.exchangeManager.add(exch)
+	defer func() {
+		if err := Bot.exchangeManager.removeExchange(exch.GetName()); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	var o orderManager
+	_, err := o.Submit(exch.GetName(), &order.Submit{
+		Pair:        currency.NewPair(currency.BTC, currency.USD),
+		OrderSide:   order.Buy,
+		OrderType:   order.Limit,
+		Price:       decimal.NewFromInt(100),
+		Amount:      decimal.NewFromInt(1),
+		TimeInForce: order.IOC,
+	})
+	if err == nil || !strings.HasPrefix(err.Error(), ErrTimeInForceUnsupported.Error()) {
+		t.Errorf("expected %v, got %v", ErrTimeInForceUnsupported, err)
+	}
+
+	expires := time.Now().Add(time.Minute)
+	resp, err := o.Submit(exch.GetName(), &order.Submit{
+		Pair:        currency.NewPair(currency.BTC, currency.USD),
+		OrderSide:   order.Buy,
+		OrderType:   order.Limit,
+		Price:       decimal.NewFromInt(100),
+		Amount:      decimal.NewFromInt(1),
+		TimeInForce: order.GTD,
+		ExpiresAt:   expires,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := exch.submitted[0]; s.TimeInForce != order.GTC || !s.ExpiresAt.IsZero() {
+		t.Errorf("expected the order sent good till cancelled, got %+v", s)
+	}
+
+	o.expireOrders()
+	if len(exch.cancelled) != 0 {
+		t.Fatalf("expected the order to rest until it expires, got %v", exch.cancelled)
+	}
+	due := o.expiries.due(expires)
+	if len(due) != 1 || due[0].OrderID != resp.OrderID {
+		t.Fatalf("expected the order scheduled to expire, got %+v", due)
+	}
+	o.expiries.m.Lock()
+	o.expiries.orders[orderKey(exch.GetName(), resp.OrderID)].ExpiresAt = time.Now()
+	o.expiries.m.Unlock()
+	o.expireOrders()
+	if len(exch.cancelled) != 1 || exch.cancelled[0] != resp.OrderID {
+		t.Errorf("expected the expired order to be cancelled, got %v", exch.cancelled)
+	}
+	if due = o.expiries.due(expires); len(due) != 0 {
+		t.Errorf("expected the expiry to be removed, got %+v", due)
+	}
+}
@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// orderExpiryStateFile is the file in the data directory the good till date
+// orders awaiting expiry are written to on shutdown and read from on startup
+const orderExpiryStateFile = "orderexpiries.json"
+
+const (
+	// orderExpiryRetryDelay is how long after failing to cancel an expired
+	// order it is tried again
+	orderExpiryRetryDelay = time.Second * 10
+	// orderExpiryIdle is how long the order manager waits to check for
+	// expired orders when none are scheduled
+	orderExpiryIdle = time.Hour
+)
+
+// ErrTimeInForceUnsupported is returned when an order is submitted with a
+// time in force the exchange doesn't support and which can't be emulated
+var ErrTimeInForceUnsupported = errors.New("exchange does not support the order time in force")
+
+// orderExpiries schedules the cancellation of good till date orders on
+// exchanges which don't support them natively
+type orderExpiries struct {
+	m sync.Mutex
+	// orders is keyed by exchange and order ID
+	orders map[string]*expiringOrder
+	// changed wakes the order manager when an expiry is scheduled
+	changed chan struct{}
+}
+
+// expiringOrder is a good till date order and when it expires
+type expiringOrder struct {
+	Exchange      string        `json:"exchange"`
+	OrderID       string        `json:"orderID"`
+	Pair          currency.Pair `json:"pair"`
+	AssetType     asset.Item    `json:"assetType"`
+	Side          order.Side    `json:"side"`
+	CorrelationID string        `json:"correlationID"`
+	ExpiresAt     time.Time     `json:"expiresAt"`
+	// retry is when cancelling the order is next tried, once cancelling it
+	// has failed
+	retry time.Time
+}
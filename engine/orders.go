@@ -1,22 +1,42 @@
 package engine
 
 import (
+	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
 	"sync/atomic"
 	"time"
 
-	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+	"github.com/thrasher-corp/gocryptotrader/tracing"
 )
 
 // vars for the fund manager package
 var (
-	OrderManagerDelay      = time.Second * 10
-	ErrOrdersAlreadyExists = errors.New("order already exists")
+	OrderManagerDelay       = time.Second * 10
+	ErrOrdersAlreadyExists  = errors.New("order already exists")
+	ErrOrderManagerDraining = errors.New("order manager is shutting down, not accepting new orders")
+	ErrReconcileInProgress  = errors.New("orders are already being reconciled for exchange")
+	ErrOrdersHalted         = errors.New("order submissions are halted by the portfolio trailing stop")
+	ErrVenueClosed          = errors.New("order venue is closed by its trading calendar")
+	ErrReplacementFailed    = errors.New("order was cancelled but its replacement was not placed")
+	errOrderNotOpen         = errors.New("order is not open")
+	errModifyFilled         = errors.New("order amount is not above its filled amount")
 )
 
 func (o *orderStore) Get() map[string][]order.Detail {
@@ -54,6 +74,149 @@ func (o *orderStore) Add(order *order.Detail) error {
 	return nil
 }
 
+// upsert tracks an order or replaces the tracked order with the same ID,
+// returning the correction made or false if it was already up to date
+func (o *orderStore) upsert(d *order.Detail) (orderCorrection, bool) {
+	o.m.Lock()
+	defer o.m.Unlock()
+	if o.Orders == nil {
+		o.Orders = make(map[string][]order.Detail)
+	}
+	orders := o.Orders[d.Exchange]
+	for x := range orders {
+		if orders[x].ID != d.ID {
+			continue
+		}
+		if !orderChanged(&orders[x], d) {
+			return orderCorrection{}, false
+		}
+		orders[x] = *d
+		reason := correctionUpdated
+		if isOrderClosed(d.Status) {
+			reason = correctionClosed
+		}
+		return orderCorrection{Reason: reason, Order: *d}, true
+	}
+	o.Orders[d.Exchange] = append(orders, *d)
+	return orderCorrection{Reason: correctionAdded, Order: *d}, true
+}
+
+// applyExecution adds an execution to its tracked order, updating the executed
+// and remaining amounts, fees and status. It returns false if the order isn't
+// tracked or the execution was already applied.
+func (o *orderStore) applyExecution(e *order.ExecutionReport) (order.Detail, bool) {
+	o.m.Lock()
+	defer o.m.Unlock()
+	orders := o.Orders[e.Exchange]
+	for x := range orders {
+		if orders[x].ID != e.OrderID {
+			continue
+		}
+		d := &orders[x]
+		for y := range d.Trades {
+			if d.Trades[y].TID == e.TradeID {
+				return order.Detail{}, false
+			}
+		}
+		d.Trades = append(d.Trades, e.TradeHistory())
+		d.ExecutedAmount = d.ExecutedAmount.Add(e.Amount)
+		d.Fee = d.Fee.Add(e.Fee)
+		if d.Amount.Sign() > 0 {
+			d.RemainingAmount = d.Amount.Sub(d.ExecutedAmount)
+			if d.RemainingAmount.Sign() < 0 {
+				d.RemainingAmount = decimal.Zero
+			}
+		}
+		if !isOrderClosed(d.Status) {
+			d.Status = order.PartiallyFilled
+			if d.Amount.Sign() > 0 && d.RemainingAmount.IsZero() {
+				d.Status = order.Filled
+			}
+		}
+		return *d, true
+	}
+	return order.Detail{}, false
+}
+
+// get returns the tracked order of an exchange with an ID
+func (o *orderStore) get(exchName, orderID string) (order.Detail, bool) {
+	o.m.Lock()
+	defer o.m.Unlock()
+	orders := o.Orders[exchName]
+	for i := range orders {
+		if orders[i].ID == orderID {
+			return orders[i], true
+		}
+	}
+	return order.Detail{}, false
+}
+
+// openExchanges returns the exchanges with open tracked orders
+func (o *orderStore) openExchanges() []string {
+	o.m.Lock()
+	defer o.m.Unlock()
+	var exchanges []string
+	for exchName, orders := range o.Orders {
+		for x := range orders {
+			if !isOrderClosed(orders[x].Status) {
+				exchanges = append(exchanges, exchName)
+				break
+			}
+		}
+	}
+	return exchanges
+}
+
+// openOrders returns the tracked orders of an exchange which aren't closed
+func (o *orderStore) openOrders(exchName string) []order.Detail {
+	o.m.Lock()
+	defer o.m.Unlock()
+	var open []order.Detail
+	for _, d := range o.Orders[exchName] {
+		if !isOrderClosed(d.Status) {
+			open = append(open, d)
+		}
+	}
+	return open
+}
+
+// isOrderClosed returns whether an order can no longer be filled, an unknown
+// status being an order which closed without the exchange saying how
+func isOrderClosed(s order.Status) bool {
+	switch s {
+	case order.Filled,
+		order.Cancelled,
+		order.PartiallyCancelled,
+		order.Rejected,
+		order.Expired,
+		order.UnknownStatus:
+		return true
+	}
+	return false
+}
+
+func orderChanged(old, updated *order.Detail) bool {
+	return old.Status != updated.Status ||
+		!old.ExecutedAmount.Equal(updated.ExecutedAmount) ||
+		!old.RemainingAmount.Equal(updated.RemainingAmount)
+}
+
+// Halt refuses new order submissions until Resume is called, orders already
+// being submitted are unaffected
+func (o *orderManager) Halt() {
+	atomic.StoreInt32(&o.halted, 1)
+}
+
+// Resume accepts new order submissions again after Halt
+func (o *orderManager) Resume() {
+	atomic.StoreInt32(&o.halted, 0)
+}
+
+// Halted reports whether new order submissions are being refused
+func (o *orderManager) Halted() bool {
+	return atomic.LoadInt32(&o.halted) == 1
+}
+
 func (o *orderManager) Started() bool {
 	return atomic.LoadInt32(&o.started) == 1
 }
@@ -66,7 +229,49 @@ func (o *orderManager) Start() error {
 	log.Debugln(log.OrderBook, "Order manager starting...")
 
 	o.shutdown = make(chan struct{})
-	o.orderStore.Orders = make(map[string][]order.Detail)
+	o.done = make(chan struct{})
+	var orderState string
+	if Bot.Settings.DataDir != "" {
+		orderState = filepath.Join(Bot.Settings.DataDir, orderStateFile)
+	}
+	if err := o.orderStore.load(orderState); err != nil {
+		atomic.CompareAndSwapInt32(&o.started, 1, 0)
+		return err
+	}
+	var (
+		strategyState string
+		strategies    []config.StrategyConfig
+	)
+	if Bot.Settings.DataDir != "" {
+		strategyState = filepath.Join(Bot.Settings.DataDir, strategyStateFile)
+	}
+	if Bot.Config != nil {
+		strategies = Bot.Config.Strategies
+	}
+	if err := o.strategies.load(strategyState, strategies); err != nil {
+		atomic.CompareAndSwapInt32(&o.started, 1, 0)
+		return err
+	}
+	o.partialFills.load(strategies)
+	var pendingState string
+	if Bot.Settings.DataDir != "" {
+		pendingState = filepath.Join(Bot.Settings.DataDir, clientOrderStateFile)
+	}
+	if err := o.clientOrders.load(pendingState); err != nil {
+		atomic.CompareAndSwapInt32(&o.started, 1, 0)
+		return err
+	}
+	var expiryState string
+	if Bot.Settings.DataDir != "" {
+		expiryState = filepath.Join(Bot.Settings.DataDir, orderExpiryStateFile)
+	}
+	if err := o.expiries.load(expiryState); err != nil {
+		atomic.CompareAndSwapInt32(&o.started, 1, 0)
+		return err
+	}
+	o.drainMtx.Lock()
+	o.draining = false
+	o.drainMtx.Unlock()
 	go o.run()
 	return nil
 }
@@ -85,7 +290,93 @@ func (o *orderManager) Stop() error {
 	}()
 
 	log.Debugln(log.OrderBook, "Order manager shutting down...")
+	o.drain(Bot.Context())
 	close(o.shutdown)
+	<-o.done
+
+	if Bot.Settings.DataDir == "" {
+		return nil
+	}
+	if err := o.strategies.save(filepath.Join(Bot.Settings.DataDir, strategyStateFile)); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to save strategy ledgers: %v\n", err)
+	}
+	if err := o.clientOrders.save(filepath.Join(Bot.Settings.DataDir, clientOrderStateFile)); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to save pending order submissions: %v\n", err)
+	}
+	if err := o.expiries.save(filepath.Join(Bot.Settings.DataDir, orderExpiryStateFile)); err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Unable to save order expiries: %v\n", err)
+	}
+	return o.saveState(filepath.Join(Bot.Settings.DataDir, orderStateFile))
+}
+
+// drain refuses new order submissions and waits for the in-flight ones to
+// resolve, or for ctx to be done
+func (o *orderManager) drain(ctx context.Context) {
+	o.drainMtx.Lock()
+	o.draining = true
+	o.drainMtx.Unlock()
+
+	resolved := make(chan struct{})
+	go func() {
+		o.submissions.Wait()
+		close(resolved)
+	}()
+	select {
+	case <-resolved:
+	case <-ctx.Done():
+		log.Warnln(log.OrderMgr, "Order manager: Shutdown deadline exceeded waiting for in-flight order submissions")
+	}
+}
+
+// load restores the tracked orders saved to path on the last shutdown,
+// tracking none if there is no path or nothing was saved
+func (o *orderStore) load(path string) error {
+	orders := make(map[string][]order.Detail)
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		switch {
+		case err == nil:
+			if err = json.Unmarshal(data, &orders); err != nil {
+				return fmt.Errorf("unable to read order state %s: %v", path, err)
+			}
+		case !os.IsNotExist(err):
+			return err
+		}
+	}
+
+	o.m.Lock()
+	o.Orders = orders
+	o.m.Unlock()
+	return nil
+}
+
+// reconcileRestored reconciles the exchanges of the open orders restored on
+// start, as they may have been filled or cancelled while the bot was stopped
+func (o *orderManager) reconcileRestored() {
+	exchanges := o.orderStore.openExchanges()
+	for x := range exchanges {
+		err := o.Reconcile(exchanges[x])
+		if err != nil {
+			log.Warnf(log.OrderMgr, "Order manager: Exchange %s unable to reconcile restored orders: %s\n",
+				exchanges[x], err)
+		}
+	}
+}
+
+// saveState writes the tracked orders to path so their last known state
+// survives a restart
+func (o *orderManager) saveState(path string) error {
+	o.orderStore.m.Lock()
+	data, err := json.MarshalIndent(o.orderStore.Orders, "", " ")
+	o.orderStore.m.Unlock()
+	if err != nil {
+		return err
+	}
+	err = file.Write(path, data)
+	if err != nil {
+		return err
+	}
+	log.Debugf(log.OrderMgr, "Order manager: Order state saved to %s\n", path)
 	return nil
 }
 
@@ -110,8 +401,9 @@ func (o *orderManager) gracefulShutdown() {
 						k, v[y].ID, err)
 					log.Debugln(log.OrderBook, msg)
 					Bot.CommsManager.PushEvent(base.Event{
-						Type:    "order",
-						Message: msg,
+						Type:     base.EventTypeOrder,
+						Message:  msg,
+						Severity: base.SeverityError,
 					})
 					continue
 				}
@@ -120,7 +412,7 @@ func (o *orderManager) gracefulShutdown() {
 					k, v[y].ID)
 				log.Debugln(log.OrderBook, msg)
 				Bot.CommsManager.PushEvent(base.Event{
-					Type:    "order",
+					Type:    base.EventTypeOrder,
 					Message: msg,
 				})
 			}
@@ -129,27 +421,90 @@ func (o *orderManager) gracefulShutdown() {
 }
 
 func (o *orderManager) run() {
+	defer errorreport.Recover()
 	log.Debugln(log.OrderBook, "Order manager started.")
 	tick := time.NewTicker(OrderManagerDelay)
+	expiry := time.NewTimer(o.expiries.next(time.Now()))
 	Bot.ServicesWG.Add(1)
 	defer func() {
 		log.Debugln(log.OrderMgr, "Order manager shutdown.")
 		tick.Stop()
+		expiry.Stop()
+		close(o.done)
 		Bot.ServicesWG.Done()
 	}()
 
+	guard(orderManagerName, o.reconcileRestored)
 	for {
 		select {
 		case <-o.shutdown:
-			o.gracefulShutdown()
+			guard(orderManagerName, o.gracefulShutdown)
 			return
 		case <-tick.C:
-			o.processOrders()
+			guard(orderManagerName, o.processOrders)
+		case <-expiry.C:
+			guard(orderManagerName, o.expireOrders)
+		case <-o.expiries.changed:
 		}
+		// Wake for the next order to expire, which may have been scheduled
+		if !expiry.Stop() {
+			select {
+			case <-expiry.C:
+			default:
+			}
+		}
+		expiry.Reset(o.expiries.next(time.Now()))
 	}
 }
 
-func (o *orderManager) CancelAllOrders() {}
+// CancelAllOrders cancels all open orders on the supplied exchanges, or on
+// every exchange with authenticated API support if none are supplied
+func (o *orderManager) CancelAllOrders(exchangeNames []string) error {
+	if len(exchangeNames) == 0 {
+		exchangeNames = GetAuthAPISupportedExchanges()
+	}
+
+	var errs []string
+	for x := range exchangeNames {
+		exch := GetExchangeByName(exchangeNames[x])
+		if exch == nil {
+			errs = append(errs, fmt.Sprintf("%s: %s", exchangeNames[x], ErrExchangeNotFound))
+			continue
+		}
+
+		resp, err := exch.CancelAllOrders(Bot.Context(), &order.Cancel{})
+		if err != nil {
+			msg := fmt.Sprintf("Order manager: Exchange %s unable to cancel all orders. Err: %s",
+				exchangeNames[x], err)
+			log.Errorln(log.OrderMgr, msg)
+			Bot.CommsManager.PushEvent(base.Event{
+				Type:     base.EventTypeOrder,
+				Message:  msg,
+				Severity: base.SeverityError,
+			})
+			errs = append(errs, fmt.Sprintf("%s: %s", exchangeNames[x], err))
+			continue
+		}
+
+		for id, status := range resp.Status {
+			log.Debugf(log.OrderMgr, "Order manager: Exchange %s order ID=%v cancel status: %s\n",
+				exchangeNames[x], id, status)
+		}
+
+		msg := fmt.Sprintf("Order manager: Exchange %s all orders cancelled.", exchangeNames[x])
+		log.Debugln(log.OrderMgr, msg)
+		Bot.CommsManager.PushEvent(base.Event{
+			Type:    base.EventTypeOrder,
+			Message: msg,
+		})
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("order manager unable to cancel all orders: %s",
+			strings.Join(errs, ", "))
+	}
+	return nil
+}
 
 func (o *orderManager) Cancel(exchName string, cancel *order.Cancel) error {
 	if exchName == "" {
@@ -173,10 +528,336 @@ func (o *orderManager) Cancel(exchName string, cancel *order.Cancel) error {
 		return errors.New("order asset type not supported by exchange")
 	}
 
-	return exch.CancelOrder(cancel)
+	err := exch.CancelOrder(Bot.Context(), cancel)
+	if err == nil {
+		o.strategies.close(exchName, cancel.OrderID)
+	}
+	if id := order.CorrelationID(exchName, cancel.OrderID); id != "" {
+		msg := fmt.Sprintf("Exchange %s cancel order ID=%v", exchName, cancel.OrderID)
+		if err != nil {
+			msg += fmt.Sprintf(" failed: %v", err)
+		}
+		log.WithFields(log.OrderMgr, log.Fields{
+			Exchange:      exchName,
+			CorrelationID: id,
+		}).Debugf("Order manager: %s\n", msg)
+		audit.Event(id, auditEventOrder, msg)
+	}
+	return err
+}
+
+// Modify amends the price or amount of an open order. Orders are amended in
+// place where the exchange supports it, otherwise they are cancelled and a
+// replacement submitted for the unfilled amount, carrying the order's
+// correlation ID and client order ID lineage. Strategy orders are always
+// replaced so their reservation is recalculated
+func (o *orderManager) Modify(exchName string, mod *order.Modify) (*OrderModifyResponse, error) {
+	if Bot.SecurityMonitor.Killed() {
+		return nil, errSecurityKillSwitch
+	}
+	if o.Halted() {
+		return nil, ErrOrdersHalted
+	}
+
+	if exchName == "" {
+		return nil, errors.New("order exchange name must be specified")
+	}
+
+	if err := mod.Validate(); err != nil {
+		return nil, err
+	}
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return nil, errors.New("unable to get exchange by name")
+	}
+
+	if err := Bot.CalendarManager.checkOpen(exchName); err != nil {
+		return nil, err
+	}
+
+	if err := apiKeyAllows(exch, true, false); err != nil {
+		return nil, err
+	}
+
+	correlationID := order.CorrelationID(exchName, mod.OrderID)
+	if o.strategies.strategyOf(exchName, mod.OrderID) == "" {
+		id, err := exch.ModifyOrder(Bot.Context(), mod)
+		if err == nil {
+			return o.amended(exchName, mod, id, correlationID), nil
+		}
+		if !errors.Is(err, common.ErrFunctionNotSupported) &&
+			!errors.Is(err, common.ErrNotYetImplemented) {
+			return nil, err
+		}
+	}
+	return o.replace(exch, mod, correlationID)
+}
+
+// amended records an order amended in place, which some exchanges assign a
+// new order ID
+func (o *orderManager) amended(exchName string, mod *order.Modify, id, correlationID string) *OrderModifyResponse {
+	if id == "" {
+		id = mod.OrderID
+	}
+	lineage := o.getLineage(exchName, mod.OrderID)
+	msg := fmt.Sprintf("Exchange %s amended order ID=%v price=%v amount=%v",
+		exchName, mod.OrderID, mod.Price, mod.Amount)
+	if id != mod.OrderID {
+		msg += " new ID=" + id
+		order.TrackCorrelation(exchName, id, correlationID)
+		o.moveLineage(exchName, mod.OrderID, id, &lineage)
+	}
+	o.recordModification(exchName, correlationID, mod.OrderID, msg)
+	return &OrderModifyResponse{
+		OrderID:       id,
+		CorrelationID: correlationID,
+		Lineage:       lineage,
+	}
+}
+
+// replace amends an order by cancelling it and submitting a replacement for
+// its unfilled amount. The replacement is validated before the order is
+// cancelled, so the order is only left cancelled without a replacement if the
+// exchange rejects the replacement
+func (o *orderManager) replace(exch exchange.IBotExchange, mod *order.Modify, correlationID string) (*OrderModifyResponse, error) {
+	exchName := exch.GetName()
+	d, err := o.lookupOrder(exch, mod.OrderID)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get order %s to replace: %v", mod.OrderID, err)
+	}
+	if isOrderClosed(d.Status) {
+		return nil, fmt.Errorf("%v: %s is %s", errOrderNotOpen, mod.OrderID, d.Status)
+	}
+
+	amount := d.Amount
+	if mod.Amount.Sign() > 0 {
+		amount = mod.Amount
+	}
+	remaining := amount.Sub(d.ExecutedAmount)
+	if remaining.Sign() <= 0 {
+		return nil, fmt.Errorf("%v: %v of %s is filled", errModifyFilled, d.ExecutedAmount, mod.OrderID)
+	}
+	price := d.Price
+	if mod.Price.Sign() > 0 {
+		price = mod.Price
+	}
+	pair := d.CurrencyPair
+	if pair.IsEmpty() {
+		pair = mod.CurrencyPair
+	}
+
+	lineage := o.getLineage(exchName, mod.OrderID)
+	lineage.Revision++
+	lineage.Replaced = append(lineage.Replaced, mod.OrderID)
+	replacement := &order.Submit{
+		Pair:          pair,
+		OrderSide:     d.OrderSide,
+		OrderType:     d.OrderType,
+		Price:         price,
+		Amount:        remaining,
+		ClientID:      lineage.revisionClientID(),
+		StrategyID:    o.strategies.strategyOf(exchName, mod.OrderID),
+		CorrelationID: correlationID,
+		AssetType:     mod.AssetType,
+	}
+	if lineage.ClientID != "" && supportsClientOrderIDs(exch) {
+		replacement.ClientID = order.NewClientOrderID()
+	}
+	if err = replacement.Validate(); err != nil {
+		return nil, fmt.Errorf("unable to replace order %s: %v", mod.OrderID, err)
+	}
+
+	err = o.Cancel(exchName, &order.Cancel{
+		OrderID:      mod.OrderID,
+		AssetType:    mod.AssetType,
+		CurrencyPair: pair,
+		Side:         d.OrderSide,
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to cancel order %s to replace it: %v", mod.OrderID, err)
+	}
+
+	resp, err := o.submitTraced(exchName, replacement)
+	if err != nil {
+		msg := fmt.Sprintf("Order manager: Exchange %s order ID=%v %v: %v",
+			exchName, mod.OrderID, ErrReplacementFailed, err)
+		log.Errorln(log.OrderMgr, msg)
+		Bot.CommsManager.PushEvent(base.Event{
+			Type:     base.EventTypeOrder,
+			Message:  msg,
+			Severity: base.SeverityCritical,
+		})
+		return nil, fmt.Errorf("%v: %s: %v", ErrReplacementFailed, mod.OrderID, err)
+	}
+	o.moveLineage(exchName, mod.OrderID, resp.OrderID, &lineage)
+	o.expiries.move(exchName, mod.OrderID, resp.OrderID)
+	o.recordModification(exchName, resp.CorrelationID, mod.OrderID,
+		fmt.Sprintf("Exchange %s replaced order ID=%v with ID=%v price=%v amount=%v",
+			exchName, mod.OrderID, resp.OrderID, price, remaining))
+	return &OrderModifyResponse{
+		OrderID:       resp.OrderID,
+		Replaced:      true,
+		CorrelationID: resp.CorrelationID,
+		Lineage:       lineage,
+	}, nil
+}
+
+// lookupOrder returns the tracked order with an ID, fetching it from the
+// exchange when it isn't tracked
+func (o *orderManager) lookupOrder(exch exchange.IBotExchange, orderID string) (order.Detail, error) {
+	if d, ok := o.orderStore.get(exch.GetName(), orderID); ok {
+		return d, nil
+	}
+	return exch.GetOrderInfo(Bot.Context(), orderID)
+}
+
+// recordModification logs and audits an order modification under the order's
+// correlation ID, or the order ID when it has none
+func (o *orderManager) recordModification(exchName, correlationID, orderID, msg string) {
+	id := correlationID
+	if id == "" {
+		id = orderID
+	}
+	log.WithFields(log.OrderMgr, log.Fields{
+		Exchange:      exchName,
+		CorrelationID: correlationID,
+	}).Debugf("Order manager: %s\n", msg)
+	audit.Event(id, auditEventOrder, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    base.EventTypeOrder,
+		Message: fmt.Sprintf("Order manager: %s.", msg),
+	})
+}
+
+// getLineage returns the lineage of an order, empty if it was submitted
+// without a client order ID and hasn't replaced another
+func (o *orderManager) getLineage(exchName, orderID string) OrderLineage {
+	o.lineageMtx.Lock()
+	defer o.lineageMtx.Unlock()
+	l, ok := o.lineage[orderKey(exchName, orderID)]
+	if !ok {
+		return OrderLineage{}
+	}
+	resp := *l
+	resp.Replaced = append([]string(nil), l.Replaced...)
+	return resp
+}
+
+func (o *orderManager) setLineage(exchName, orderID string, l *OrderLineage) {
+	o.lineageMtx.Lock()
+	if o.lineage == nil {
+		o.lineage = make(map[string]*OrderLineage)
+	}
+	o.lineage[orderKey(exchName, orderID)] = l
+	o.lineageMtx.Unlock()
+}
+
+// moveLineage moves an order's lineage to the order replacing it
+func (o *orderManager) moveLineage(exchName, orderID, replacementID string, l *OrderLineage) {
+	o.lineageMtx.Lock()
+	delete(o.lineage, orderKey(exchName, orderID))
+	o.lineageMtx.Unlock()
+	lineage := *l
+	o.setLineage(exchName, replacementID, &lineage)
+}
+
+// Lineage returns the client order ID an order was first submitted with and
+// the orders it replaced
+func (o *orderManager) Lineage(exchName, orderID string) OrderLineage {
+	return o.getLineage(exchName, orderID)
+}
+
+// revisionClientID returns the client order ID of a revision of an order, or
+// an empty string if it was first submitted without one
+func (l *OrderLineage) revisionClientID() string {
+	if l.ClientID == "" || l.Revision == 0 {
+		return l.ClientID
+	}
+	return fmt.Sprintf("%s-%d", l.ClientID, l.Revision)
+}
+
+func orderKey(exchName, orderID string) string {
+	return strings.ToLower(exchName) + ":" + orderID
+}
+
+// orderRejected logs and records a failed order submission, opening an incident
+// once the consecutive failure threshold is reached
+func (o *orderManager) orderRejected(exchName string, newOrder *order.Submit, err error) {
+	log.WithFields(log.OrderMgr, log.Fields{
+		Exchange:      exchName,
+		Pair:          newOrder.Pair.String(),
+		CorrelationID: newOrder.CorrelationID,
+		Error:         err,
+	}).Warnf("Order manager: Order submission rejected\n")
+	audit.Event(newOrder.CorrelationID, auditEventOrder,
+		fmt.Sprintf("Exchange %s rejected order pair=%v: %v", exchName, newOrder.Pair, err))
+	metrics.OrderRejections.Inc(exchName)
+	rejections := atomic.AddInt32(&o.rejections, 1)
+	if rejections >= maxConsecutiveOrderRejections {
+		Bot.CommsManager.TriggerIncident(IncidentOrderRejections,
+			fmt.Sprintf("Order manager: %d consecutive order submissions rejected, last by %s: %v",
+				rejections, exchName, err))
+	}
+}
+
+// Submit validates and submits an order to an exchange, tracing the order from
+// submission through to its acknowledgement and any websocket updates. A
+// correlation ID is assigned to the order if it does not already have one
+func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+	Bot.SecurityMonitor.CheckOrder(exchName, newOrder)
+	if Bot.SecurityMonitor.Killed() {
+		return nil, errSecurityKillSwitch
+	}
+	if o.Halted() {
+		return nil, ErrOrdersHalted
+	}
+	return o.submitTraced(exchName, newOrder)
+}
+
+// submitReducing submits an order which reduces risk, such as closing part of
+// a position nearing liquidation, so it is accepted while submissions are
+// halted
+func (o *orderManager) submitReducing(exchName string, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+	return o.submitTraced(exchName, newOrder)
+}
+
+func (o *orderManager) submitTraced(exchName string, newOrder *order.Submit) (*OrderSubmitResponse, error) {
+	o.drainMtx.RLock()
+	if o.draining {
+		o.drainMtx.RUnlock()
+		return nil, ErrOrderManagerDraining
+	}
+	o.submissions.Add(1)
+	o.drainMtx.RUnlock()
+	defer o.submissions.Done()
+
+	ctx, span := tracing.StartSpan(Bot.Context(), "order.submit",
+		tracing.String("exchange", exchName))
+	if newOrder != nil {
+		if newOrder.CorrelationID == "" {
+			newOrder.CorrelationID = order.NewCorrelationID()
+		}
+		span.SetAttributes(tracing.String("correlation.id", newOrder.CorrelationID),
+			tracing.String("pair", newOrder.Pair.String()),
+			tracing.String("side", newOrder.OrderSide.String()),
+			tracing.String("type", newOrder.OrderType.String()),
+			tracing.Float64("price", newOrder.Price.Float64()),
+			tracing.Float64("amount", newOrder.Amount.Float64()))
+	}
+	resp, err := o.submit(ctx, exchName, newOrder)
+	if err != nil {
+		span.RecordError(err)
+	} else {
+		span.AddEvent("ack", tracing.String("order.id", resp.OrderID))
+		tracing.TrackOrder(exchName, resp.OrderID, span.SpanContext())
+		order.TrackCorrelation(exchName, resp.OrderID, resp.CorrelationID)
+	}
+	span.End()
+	return resp, err
 }
 
-func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSubmitResponse, error) {
+func (o *orderManager) submit(ctx context.Context, exchName string, newOrder *order.Submit) (*OrderSubmitResponse, error) {
 	if exchName == "" {
 		return nil, errors.New("order exchange name must be specified")
 	}
@@ -190,7 +871,7 @@ func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSu
 			return nil, errors.New("order market type is not allowed")
 		}
 
-		if o.cfg.LimitAmount > 0 && newOrder.Amount > o.cfg.LimitAmount {
+		if o.cfg.LimitAmount > 0 && newOrder.Amount.GreaterThan(decimal.NewFromFloat(o.cfg.LimitAmount)) {
 			return nil, errors.New("order limit exceeds allowed limit")
 		}
 
@@ -209,43 +890,122 @@ func (o *orderManager) Submit(exchName string, newOrder *order.Submit) (*orderSu
 		return nil, errors.New("unable to get exchange by name")
 	}
 
-	id, err := uuid.NewV4()
+	if err := Bot.CalendarManager.checkOpen(exchName); err != nil {
+		return nil, err
+	}
+
+	if err := apiKeyAllows(exch, true, false); err != nil {
+		return nil, err
+	}
+
+	emulateExpiry, err := emulatesExpiry(exch, newOrder)
 	if err != nil {
-		log.Warnf(log.OrderMgr,
-			"Order manager: Unable to generate UUID. Err: %s\n",
-			err)
+		return nil, err
 	}
 
-	result, err := exch.SubmitOrder(newOrder)
+	clientIDs := supportsClientOrderIDs(exch)
+	if clientIDs {
+		if newOrder.ClientID == "" {
+			newOrder.ClientID = order.NewClientOrderID()
+		}
+		orderID, err := o.clientOrders.begin(exchName, newOrder)
+		if err != nil {
+			return nil, err
+		}
+		if orderID != "" {
+			// Resubmitting a client order ID returns the order it placed
+			return &OrderSubmitResponse{
+				SubmitResponse: order.SubmitResponse{
+					OrderID:       orderID,
+					IsOrderPlaced: true,
+				},
+				CorrelationID: order.CorrelationID(exchName, orderID),
+				ClientID:      newOrder.ClientID,
+			}, nil
+		}
+	}
+
+	var reservation *strategyReservation
+	if newOrder.StrategyID != "" {
+		var err error
+		reservation, err = o.strategies.reserve(exchName, newOrder, strategyOrderPrice(exchName, newOrder))
+		if err != nil {
+			if clientIDs {
+				o.clientOrders.abandon(exchName, newOrder.ClientID)
+			}
+			return nil, err
+		}
+	}
+
+	sent := newOrder
+	if emulateExpiry {
+		// The order rests until the order manager cancels it on expiry
+		gtc := *newOrder
+		gtc.TimeInForce, gtc.ExpiresAt = order.GTC, time.Time{}
+		sent = &gtc
+	}
+
+	exchCtx, exchSpan := tracing.StartSpan(ctx, "exchange.SubmitOrder",
+		tracing.String("exchange", exchName))
+	result, err := o.sendOrder(exchCtx, exch, sent, clientIDs)
+	exchSpan.RecordError(err)
+	exchSpan.End()
 	if err != nil {
+		o.strategies.cancel(reservation)
+		o.orderRejected(exchName, newOrder, err)
 		return nil, err
 	}
 
 	if !result.IsOrderPlaced {
-		return nil, errors.New("order unable to be placed")
+		o.strategies.cancel(reservation)
+		err = errors.New("order unable to be placed")
+		o.orderRejected(exchName, newOrder, err)
+		return nil, err
+	}
+	o.strategies.track(result.OrderID, reservation)
+	if newOrder.ClientID != "" {
+		o.setLineage(exchName, result.OrderID, &OrderLineage{ClientID: newOrder.ClientID})
+	}
+	o.partialFills.track(exchName, result.OrderID, newOrder)
+	if emulateExpiry {
+		o.expiries.schedule(exchName, result.OrderID, newOrder)
+	}
+
+	metrics.OrderSubmissions.Inc(exchName)
+	if atomic.SwapInt32(&o.rejections, 0) >= maxConsecutiveOrderRejections {
+		Bot.CommsManager.ResolveIncident(IncidentOrderRejections,
+			"Order manager: Order submissions are being accepted again")
 	}
 
-	msg := fmt.Sprintf("Order manager: Exchange %s submitted order ID=%v [Ours: %v] pair=%v price=%v amount=%v side=%v type=%v.",
+	msg := fmt.Sprintf("Exchange %s submitted order ID=%v pair=%v price=%v amount=%v side=%v type=%v",
 		exchName,
 		result.OrderID,
-		id.String(),
 		newOrder.Pair,
 		newOrder.Price,
 		newOrder.Amount,
 		newOrder.OrderSide,
 		newOrder.OrderType)
+	if newOrder.StrategyID != "" {
+		msg += " strategy=" + newOrder.StrategyID
+	}
 
-	log.Debugln(log.OrderMgr, msg)
+	log.WithFields(log.OrderMgr, log.Fields{
+		Exchange:      exchName,
+		CorrelationID: newOrder.CorrelationID,
+	}).Debugf("Order manager: %s\n", msg)
+	audit.Event(newOrder.CorrelationID, auditEventOrder, msg)
 	Bot.CommsManager.PushEvent(base.Event{
-		Type:    "order",
-		Message: msg,
+		Type:    base.EventTypeOrder,
+		Message: fmt.Sprintf("Order manager: %s correlation_id=%s.", msg, newOrder.CorrelationID),
 	})
 
-	return &orderSubmitResponse{
+	return &OrderSubmitResponse{
 		SubmitResponse: order.SubmitResponse{
-			OrderID: result.OrderID,
+			OrderID:       result.OrderID,
+			IsOrderPlaced: true,
 		},
-		OurOrderID: id.String(),
+		CorrelationID: newOrder.CorrelationID,
+		ClientID:      newOrder.ClientID,
 	}, nil
 }
 
@@ -254,11 +1014,14 @@ func (o *orderManager) processOrders() {
 	for x := range authExchanges {
 		log.Debugf(log.OrderMgr, "Order manager: Procesing orders for exchange %v.\n", authExchanges[x])
 		exch := GetExchangeByName(authExchanges[x])
+		if supportsClientOrderIDs(exch) {
+			o.resolveSubmissions(exch)
+		}
 		req := order.GetOrdersRequest{
 			OrderSide: order.AnySide,
 			OrderType: order.AnyType,
 		}
-		result, err := exch.GetActiveOrders(&req)
+		result, err := exch.GetActiveOrders(Bot.Context(), &req)
 		if err != nil {
 			log.Warnf(log.OrderMgr, "Order manager: Unable to get active orders: %s\n", err)
 			continue
@@ -266,17 +1029,143 @@ func (o *orderManager) processOrders() {
 
 		for x := range result {
 			ord := &result[x]
-			result := o.orderStore.Add(ord)
-			if result != ErrOrdersAlreadyExists {
+			if ord.Exchange == "" {
+				ord.Exchange = exch.GetName()
+			}
+			c, ok := o.orderStore.upsert(ord)
+			if !ok {
+				continue
+			}
+			o.strategies.settle(&c.Order)
+			if c.Reason == correctionAdded {
 				msg := fmt.Sprintf("Order manager: Exchange %s added order ID=%v pair=%v price=%v amount=%v side=%v type=%v.",
 					ord.Exchange, ord.ID, ord.CurrencyPair, ord.Price, ord.Amount, ord.OrderSide, ord.OrderType)
 				log.Debugf(log.OrderMgr, "%v\n", msg)
 				Bot.CommsManager.PushEvent(base.Event{
-					Type:    "order",
+					Type:    base.EventTypeOrder,
 					Message: msg,
 				})
-				continue
 			}
 		}
 	}
+	o.applyPartialFillPolicies()
+}
+
+// Reconcile refreshes the balances and tracked orders of an exchange from its
+// REST API, correcting any fills or cancellations missed while its
+// authenticated websocket was disconnected
+func (o *orderManager) Reconcile(exchName string) error {
+	if !o.Started() {
+		return errors.New("order manager not started")
+	}
+	if _, ok := o.reconciling.LoadOrStore(exchName, struct{}{}); ok {
+		return ErrReconcileInProgress
+	}
+	defer o.reconciling.Delete(exchName)
+
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return ErrExchangeNotFound
+	}
+	if supportsClientOrderIDs(exch) {
+		o.resolveSubmissions(exch)
+	}
+	ctx := Bot.Context()
+	_, err := exch.UpdateAccountInfo(ctx)
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Order manager: Exchange %s unable to reconcile balances: %s\n", exchName, err)
+	}
+	active, err := exch.GetActiveOrders(ctx, &order.GetOrdersRequest{
+		OrderSide: order.AnySide,
+		OrderType: order.AnyType,
+	})
+	if err != nil {
+		return err
+	}
+	corrections := o.reconcileOrders(exchName, active, func(id string) (order.Detail, error) {
+		return exch.GetOrderInfo(ctx, id)
+	})
+	for x := range corrections {
+		ord := &corrections[x].Order
+		msg := fmt.Sprintf("Order manager: Exchange %s reconciled %s order ID=%v pair=%v status=%v executed=%v remaining=%v.",
+			exchName, corrections[x].Reason, ord.ID, ord.CurrencyPair, ord.Status, ord.ExecutedAmount, ord.RemainingAmount)
+		log.WithFields(log.OrderMgr, log.Fields{Exchange: exchName}).Infof("%v\n", msg)
+		metrics.OrderCorrections.Inc(exchName, corrections[x].Reason)
+		Bot.CommsManager.PushEvent(base.Event{
+			Type:    base.EventTypeOrder,
+			Message: msg,
+		})
+	}
+	log.Debugf(log.OrderMgr, "Order manager: Exchange %s reconciled with %d correction(s).\n",
+		exchName, len(corrections))
+	return nil
+}
+
+// reconcileOrders brings the tracked orders of an exchange in line with its
+// active orders, looking up the final state of tracked orders no longer
+// active
+func (o *orderManager) reconcileOrders(exchName string, active []order.Detail, lookup func(id string) (order.Detail, error)) []orderCorrection {
+	var corrections []orderCorrection
+	activeIDs := make(map[string]struct{}, len(active))
+	for x := range active {
+		if active[x].Exchange == "" {
+			active[x].Exchange = exchName
+		}
+		activeIDs[active[x].ID] = struct{}{}
+		if c, ok := o.orderStore.upsert(&active[x]); ok {
+			o.strategies.settle(&c.Order)
+			corrections = append(corrections, c)
+		}
+	}
+
+	open := o.orderStore.openOrders(exchName)
+	for x := range open {
+		if _, ok := activeIDs[open[x].ID]; ok {
+			continue
+		}
+		detail, err := lookup(open[x].ID)
+		if err != nil || detail.ID != open[x].ID {
+			log.Warnf(log.OrderMgr, "Order manager: Exchange %s order ID=%v is no longer active and its final state is unknown: %v\n",
+				exchName, open[x].ID, err)
+			detail = open[x]
+			detail.Status = order.UnknownStatus
+		}
+		detail.Exchange = exchName
+		if c, ok := o.orderStore.upsert(&detail); ok {
+			o.strategies.settle(&c.Order)
+			corrections = append(corrections, c)
+		}
+	}
+	return corrections
+}
+
+// processExecution applies an execution report from an exchange's private
+// feed to its tracked order and records it in the audit trail
+func (o *orderManager) processExecution(e *order.ExecutionReport) {
+	err := e.Validate()
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Exchange %s invalid execution report: %s\n", e.Exchange, err)
+		return
+	}
+
+	id := order.CorrelationID(e.Exchange, e.OrderID)
+	msg := fmt.Sprintf("Exchange %s order ID=%v executed trade ID=%v pair=%v side=%v price=%v amount=%v fee=%v %v liquidity=%v",
+		e.Exchange, e.OrderID, e.TradeID, e.Pair, e.Side, e.Price, e.Amount, e.Fee, e.FeeCurrency, e.Liquidity)
+	fields := log.WithFields(log.OrderMgr, log.Fields{
+		Exchange:      e.Exchange,
+		Pair:          e.Pair.String(),
+		CorrelationID: id,
+	})
+	if d, ok := o.orderStore.applyExecution(e); ok {
+		o.partialFills.filled(e.Exchange, e.OrderID, time.Now())
+		fields.Infof("Order manager: %s status=%v executed=%v remaining=%v.\n",
+			msg, d.Status, d.ExecutedAmount, d.RemainingAmount)
+	} else {
+		fields.Debugf("Order manager: %s, order not tracked or execution already applied.\n", msg)
+	}
+	o.strategies.applyExecution(e)
+	if id == "" {
+		id = e.OrderID
+	}
+	audit.Event(id, auditEventExecution, msg)
 }
@@ -0,0 +1,396 @@
+package engine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestOrderManagerDrain(t *testing.T) {
+	var o orderManager
+	o.submissions.Add(1)
+	resolved := make(chan struct{})
+	go func() {
+		o.drain(context.Background())
+		close(resolved)
+	}()
+
+	select {
+	case <-resolved:
+		t.Fatal("drain returned with a submission in-flight")
+	case <-time.After(time.Millisecond * 50):
+	}
+
+	_, err := o.Submit("test", &order.Submit{})
+	if err != ErrOrderManagerDraining {
+		t.Errorf("expected %v, got %v", ErrOrderManagerDraining, err)
+	}
+
+	o.submissions.Done()
+	select {
+	case <-resolved:
+	case <-time.After(time.Second):
+		t.Fatal("drain did not return once the submission resolved")
+	}
+}
+
+func TestOrderManagerHalt(t *testing.T) {
+	var o orderManager
+	o.Halt()
+	if !o.Halted() {
+		t.Fatal("expected the order manager to be halted")
+	}
+	if _, err := o.Submit("test", &order.Submit{}); err != ErrOrdersHalted {
+		t.Errorf("expected %v, got %v", ErrOrdersHalted, err)
+	}
+	if _, err := o.submitReducing("test", &order.Submit{}); err == ErrOrdersHalted {
+		t.Error("expected risk reducing orders to be accepted while halted")
+	}
+	o.Resume()
+	if o.Halted() {
+		t.Error("expected the order manager to accept orders")
+	}
+}
+
+func TestOrderManagerDrainDeadline(t *testing.T) {
+	var o orderManager
+	o.submissions.Add(1)
+	defer o.submissions.Done()
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*10)
+	defer cancel()
+	o.drain(ctx)
+	if !o.draining {
+		t.Error("expected order manager to be draining")
+	}
+}
+
+func TestOrderManagerStopSavesState(t *testing.T) {
+	if Bot == nil {
+		Bot = new(Engine)
+	}
+	dir, err := ioutil.TempDir("", "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	dataDir := Bot.Settings.DataDir
+	Bot.Settings.DataDir = dir
+	defer func() { Bot.Settings.DataDir = dataDir }()
+
+	var o orderManager
+	err = o.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = o.orderStore.Add(&order.Detail{
+		Exchange:     "test",
+		ID:           "1337",
+		CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = o.Stop()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if o.Started() {
+		t.Error("expected order manager to be stopped")
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, orderStateFile))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var orders map[string][]order.Detail
+	err = json.Unmarshal(data, &orders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(orders["test"]) != 1 || orders["test"][0].ID != "1337" {
+		t.Errorf("unexpected order state %v", orders)
+	}
+
+	err = o.Start()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer o.Stop()
+	if o.draining {
+		t.Error("expected a restarted order manager to accept orders")
+	}
+	if _, ok := o.orderStore.get("test", "1337"); !ok {
+		t.Error("expected a restarted order manager to restore its orders")
+	}
+}
+
+func TestOrderManagerReconcileOrders(t *testing.T) {
+	var o orderManager
+	o.orderStore.Orders = map[string][]order.Detail{
+		"test": {
+			{Exchange: "test", ID: "filled", Status: order.Active},
+			{Exchange: "test", ID: "partial", Status: order.Active},
+			{Exchange: "test", ID: "unchanged", Status: order.Active},
+			{Exchange: "test", ID: "vanished", Status: order.Active},
+			{Exchange: "test", ID: "cancelled", Status: order.Cancelled},
+		},
+	}
+	active := []order.Detail{
+		{ID: "partial", Status: order.PartiallyFilled, ExecutedAmount: decimal.NewFromInt(1)},
+		{ID: "unchanged", Status: order.Active},
+		{ID: "new", Status: order.Active},
+	}
+	var looked []string
+	lookup := func(id string) (order.Detail, error) {
+		looked = append(looked, id)
+		if id == "filled" {
+			return order.Detail{ID: id, Status: order.Filled, ExecutedAmount: decimal.NewFromInt(2)}, nil
+		}
+		return order.Detail{}, errors.New("not supported")
+	}
+	corrections := o.reconcileOrders("test", active, lookup)
+
+	reasons := make(map[string]string)
+	for _, c := range corrections {
+		reasons[c.Order.ID] = c.Reason
+	}
+	expected := map[string]string{
+		"partial":  correctionUpdated,
+		"new":      correctionAdded,
+		"filled":   correctionClosed,
+		"vanished": correctionClosed,
+	}
+	if len(reasons) != len(expected) {
+		t.Errorf("expected %d corrections, got %v", len(expected), reasons)
+	}
+	for id, reason := range expected {
+		if reasons[id] != reason {
+			t.Errorf("expected order %s to be %s, got %q", id, reason, reasons[id])
+		}
+	}
+	if len(looked) != 2 {
+		t.Errorf("expected only orders no longer active to be looked up, got %v", looked)
+	}
+
+	statuses := make(map[string]order.Status)
+	for _, d := range o.orderStore.Get()["test"] {
+		statuses[d.ID] = d.Status
+	}
+	if statuses["filled"] != order.Filled || statuses["vanished"] != order.UnknownStatus ||
+		statuses["partial"] != order.PartiallyFilled || statuses["new"] != order.Active {
+		t.Errorf("unexpected order state %v", statuses)
+	}
+
+	// Reconciling again with nothing missed makes no corrections
+	if c := o.reconcileOrders("test", active, lookup); len(c) != 0 {
+		t.Errorf("expected no corrections, got %v", c)
+	}
+}
+
+func TestOrderStoreApplyExecution(t *testing.T) {
+	var o orderStore
+	o.Orders = map[string][]order.Detail{
+		"test": {
+			{Exchange: "test", ID: "1", Status: order.Active, Amount: decimal.NewFromInt(2)},
+		},
+	}
+	e := order.ExecutionReport{
+		Exchange:    "test",
+		OrderID:     "1",
+		TradeID:     "a",
+		Amount:      decimal.NewFromInt(1),
+		Fee:         decimal.NewFromFloat(0.5),
+		FeeCurrency: currency.USD,
+		Liquidity:   order.Maker,
+	}
+	d, ok := o.applyExecution(&e)
+	if !ok {
+		t.Fatal("expected execution to be applied")
+	}
+	if d.Status != order.PartiallyFilled ||
+		!d.ExecutedAmount.Equal(decimal.NewFromInt(1)) ||
+		!d.RemainingAmount.Equal(decimal.NewFromInt(1)) ||
+		!d.Fee.Equal(decimal.NewFromFloat(0.5)) ||
+		len(d.Trades) != 1 {
+		t.Fatalf("unexpected order after first fill %+v", d)
+	}
+
+	if _, ok = o.applyExecution(&e); ok {
+		t.Fatal("expected duplicate execution to be ignored")
+	}
+
+	e.TradeID = "b"
+	d, ok = o.applyExecution(&e)
+	if !ok {
+		t.Fatal("expected execution to be applied")
+	}
+	if d.Status != order.Filled || !d.RemainingAmount.IsZero() || len(d.Trades) != 2 {
+		t.Fatalf("unexpected order after second fill %+v", d)
+	}
+
+	e.OrderID = "untracked"
+	if _, ok = o.applyExecution(&e); ok {
+		t.Fatal("expected untracked order execution to be ignored")
+	}
+}
+
+// modifyTestExchange amends orders in place when amend is set, otherwise it
+// reports amendment unsupported so orders are cancelled and replaced
+type modifyTestExchange struct {
+	exchange.IBotExchange
+	amend     bool
+	cancelled []string
+	submitted []order.Submit
+}
+
+func (e *modifyTestExchange) GetName() string {
+	return "modifyTest"
+}
+
+func (e *modifyTestExchange) GetBase() *exchange.Base {
+	return &exchange.Base{}
+}
+
+func (e *modifyTestExchange) ModifyOrder(_ context.Context, m *order.Modify) (string, error) {
+	if !e.amend {
+		return "", common.ErrFunctionNotSupported
+	}
+	return m.OrderID, nil
+}
+
+func (e *modifyTestExchange) GetOrderInfo(_ context.Context, orderID string) (order.Detail, error) {
+	return order.Detail{
+		ID:             orderID,
+		CurrencyPair:   currency.NewPair(currency.BTC, currency.USD),
+		OrderSide:      order.Buy,
+		OrderType:      order.Limit,
+		Status:         order.PartiallyFilled,
+		Price:          decimal.NewFromInt(100),
+		Amount:         decimal.NewFromInt(3),
+		ExecutedAmount: decimal.NewFromInt(1),
+	}, nil
+}
+
+func (e *modifyTestExchange) CancelOrder(_ context.Context, c *order.Cancel) error {
+	e.cancelled = append(e.cancelled, c.OrderID)
+	return nil
+}
+
+func (e *modifyTestExchange) SubmitOrder(_ context.Context, s *order.Submit) (order.SubmitResponse, error) {
+	e.submitted = append(e.submitted, *s)
+	return order.SubmitResponse{
+		OrderID:       fmt.Sprintf("replacement%d", len(e.submitted)),
+		IsOrderPlaced: true,
+	}, nil
+}
+
+func TestOrderManagerModify(t *testing.T) {
+	SetupTestHelpers(t)
+	exch := &modifyTestExchange{amend: true}
+	Bot.exchangeManager.add(exch)
+	defer func() {
+		if err := Bot.exchangeManager.removeExchange(exch.GetName()); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	var o orderManager
+	if _, err := o.Modify(exch.GetName(), &order.Modify{OrderID: "1"}); err != order.ErrModifyUnchanged {
+		t.Errorf("expected %v, got %v", order.ErrModifyUnchanged, err)
+	}
+
+	resp, err := o.Modify(exch.GetName(), &order.Modify{OrderID: "1", Price: decimal.NewFromInt(90)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Replaced || resp.OrderID != "1" || len(exch.cancelled) != 0 {
+		t.Errorf("expected the order to be amended in place, got %+v", resp)
+	}
+
+	exch.amend = false
+	o.setLineage(exch.GetName(), "1", &OrderLineage{ClientID: "client"})
+	resp, err = o.Modify(exch.GetName(), &order.Modify{OrderID: "1", Price: decimal.NewFromInt(95)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Replaced || resp.OrderID != "replacement1" ||
+		len(exch.cancelled) != 1 || exch.cancelled[0] != "1" {
+		t.Fatalf("expected the order to be cancelled and replaced, got %+v", resp)
+	}
+	s := exch.submitted[0]
+	if !s.Price.Equal(decimal.NewFromInt(95)) || !s.Amount.Equal(decimal.NewFromInt(2)) ||
+		s.OrderSide != order.Buy || s.ClientID != "client-1" {
+		t.Errorf("expected a buy of the 2 unfilled at 95 as client-1, got %+v", s)
+	}
+
+	// Replacing the replacement continues the lineage
+	resp, err = o.Modify(exch.GetName(), &order.Modify{OrderID: "replacement1", Amount: decimal.NewFromInt(4)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s = exch.submitted[1]; !s.Amount.Equal(decimal.NewFromInt(3)) || s.ClientID != "client-2" {
+		t.Errorf("expected a replacement of the 3 unfilled as client-2, got %+v", s)
+	}
+	l := o.Lineage(exch.GetName(), resp.OrderID)
+	if l.ClientID != "client" || l.Revision != 2 ||
+		len(l.Replaced) != 2 || l.Replaced[0] != "1" || l.Replaced[1] != "replacement1" {
+		t.Errorf("unexpected lineage %+v", l)
+	}
+	if l = o.Lineage(exch.GetName(), "replacement1"); l.ClientID != "" {
+		t.Errorf("expected the replaced order's lineage to move, got %+v", l)
+	}
+
+	_, err = o.Modify(exch.GetName(), &order.Modify{OrderID: "2", Amount: decimal.NewFromInt(1)})
+	if err == nil || !strings.HasPrefix(err.Error(), errModifyFilled.Error()) {
+		t.Errorf("expected %v, got %v", errModifyFilled, err)
+	}
+}
+
+func TestOrderStoreLoad(t *testing.T) {
+	dir, err := ioutil.TempDir("", "orders")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, orderStateFile)
+
+	var s orderStore
+	if err = s.load(path); err != nil {
+		t.Fatal(err)
+	}
+	if len(s.Orders) != 0 {
+		t.Errorf("expected no orders without a saved state, received %v", s.Orders)
+	}
+
+	err = ioutil.WriteFile(path, []byte(`{"test":[{"Exchange":"test","ID":"1","Status":"ACTIVE"}],"closed":[{"Exchange":"closed","ID":"2","Status":"FILLED"}]}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.load(path); err != nil {
+		t.Fatal(err)
+	}
+	if exchanges := s.openExchanges(); len(exchanges) != 1 || exchanges[0] != "test" {
+		t.Errorf("expected the exchange with an open order to be reconciled, received %v", exchanges)
+	}
+
+	err = ioutil.WriteFile(path, []byte("{"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = s.load(path); err == nil {
+		t.Error("expected an error for a corrupt order state")
+	}
+}
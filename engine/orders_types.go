@@ -7,6 +7,27 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 )
 
+// auditEventOrder is the audit event type of order submissions, rejections
+// and cancellations, identified by the order's correlation ID
+const auditEventOrder = "order"
+
+// auditEventExecution is the audit event type of order fills, identified by
+// the order's correlation ID or the exchange order ID when it has none
+const auditEventExecution = "execution"
+
+const orderManagerName = "order manager"
+
+// orderStateFile is the file in the data directory the tracked orders are
+// written to on shutdown and restored from on start
+const orderStateFile = "orders.json"
+
+// Reasons a tracked order is corrected when reconciled against the exchange
+const (
+	correctionAdded   = "added"
+	correctionUpdated = "updated"
+	correctionClosed  = "closed"
+)
+
 type orderManagerConfig struct {
 	EnforceLimitConfig     bool
 	AllowMarketOrders      bool
@@ -26,11 +47,71 @@ type orderManager struct {
 	started    int32
 	stopped    int32
 	shutdown   chan struct{}
+	done       chan struct{}
 	orderStore orderStore
 	cfg        orderManagerConfig
+	rejections int32
+	// draining is set on shutdown so new submissions are refused while the
+	// in-flight submissions are waited on
+	draining    bool
+	drainMtx    sync.RWMutex
+	submissions sync.WaitGroup
+	// reconciling holds the exchanges whose orders are being reconciled
+	reconciling sync.Map
+	// halted is set while new submissions are refused by the portfolio
+	// trailing stop
+	halted int32
+	// strategies holds the virtual balances of the strategies sharing the
+	// exchange accounts
+	strategies strategyLedgers
+	// lineage holds the client order ID and replaced orders of each order,
+	// keyed by exchange and order ID
+	lineage    map[string]*OrderLineage
+	lineageMtx sync.Mutex
+	// clientOrders holds the submissions by client order ID on exchanges
+	// reporting them, so a submission is never placed twice
+	clientOrders clientOrders
+	// partialFills holds the orders whose remainder is cancelled or
+	// re-pegged once partially filled
+	partialFills partialFills
+	// expiries holds the good till date orders the order manager cancels on
+	// exchanges which don't support them
+	expiries orderExpiries
 }
 
-type orderSubmitResponse struct {
+// orderCorrection is a change made to a tracked order when it is reconciled
+// against the exchange
+type orderCorrection struct {
+	Reason string
+	Order  order.Detail
+}
+
+// OrderSubmitResponse is the result of an order submitted through the order
+// manager
+type OrderSubmitResponse struct {
 	order.SubmitResponse
-	OurOrderID string
+	CorrelationID string
+	ClientID      string
+}
+
+// OrderModifyResponse is the result of an order amended through the order
+// manager
+type OrderModifyResponse struct {
+	OrderID string
+	// Replaced is set when the order was cancelled and a replacement
+	// submitted, as the exchange can't amend it in place
+	Replaced      bool
+	CorrelationID string
+	Lineage       OrderLineage
+}
+
+// OrderLineage is the client order ID an order was first submitted with and
+// the orders it replaced, oldest first. Each replacement is submitted with
+// the client order ID suffixed by its revision, so it stays unique on the
+// exchange while identifying the original order. On exchanges reporting
+// client order IDs each replacement is given a new one instead
+type OrderLineage struct {
+	ClientID string
+	Revision int
+	Replaced []string
 }
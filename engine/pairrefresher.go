@@ -0,0 +1,238 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (p *pairRefresher) Started() bool {
+	return atomic.LoadInt32(&p.started) == 1
+}
+
+func (p *pairRefresher) Start() error {
+	if atomic.AddInt32(&p.started, 1) != 1 {
+		return errors.New("tradable pair refresher already started")
+	}
+
+	p.cfg = Bot.Config.PairRefresh
+	p.shutdown = make(chan struct{})
+	go p.run()
+	log.Debugf(log.Global, "Tradable pair refresher started, refreshing every %v.\n", p.cfg.Interval)
+	return nil
+}
+
+func (p *pairRefresher) Stop() error {
+	if atomic.LoadInt32(&p.started) == 0 {
+		return errPairRefresherNotStarted
+	}
+
+	if atomic.AddInt32(&p.stopped, 1) != 1 {
+		return errors.New("tradable pair refresher is already stopped")
+	}
+
+	close(p.shutdown)
+	log.Debugln(log.Global, "Tradable pair refresher shutting down...")
+	return nil
+}
+
+func (p *pairRefresher) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(p.cfg.Interval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&p.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&p.started, 1, 0)
+		log.Debugln(log.Global, "Tradable pair refresher shutdown.")
+	}()
+
+	for {
+		select {
+		case <-p.shutdown:
+			return
+		case <-t.C:
+			guard(pairRefresherName, p.refresh)
+		}
+	}
+}
+
+// refresh updates the pairs of every enabled exchange with auto pair updates
+// enabled, saving the config if any pairs changed
+func (p *pairRefresher) refresh() {
+	var changed bool
+	exchanges := GetExchanges()
+	for i := range exchanges {
+		if !exchanges[i].SupportsAutoPairUpdates() ||
+			!exchanges[i].GetBase().Features.Enabled.AutoPairUpdates {
+			continue
+		}
+		refreshes, err := refreshPairs(Bot.Context(), exchanges[i], p.cfg.EnableNewPairs)
+		if err != nil {
+			log.Errorf(log.ExchangeSys, "%s unable to refresh tradable pairs: %v\n",
+				exchanges[i].GetName(), err)
+			continue
+		}
+		for j := range refreshes {
+			changed = true
+			pushPairRefreshEvent(&refreshes[j])
+		}
+	}
+	if !changed || Bot.Settings.EnableDryRun {
+		return
+	}
+	if err := Bot.Config.SaveConfig(Bot.Settings.ConfigFile, false); err != nil {
+		log.Errorf(log.Global, "Tradable pair refresher unable to save config: %v\n", err)
+	}
+}
+
+// refreshPairs updates an exchange's available pairs, disabling enabled pairs
+// which are no longer tradable and enabling newly listed pairs if
+// enableNewPairs is set. The websocket is subscribed to the enabled pairs and
+// unsubscribed from the disabled pairs
+func refreshPairs(ctx context.Context, exch exchange.IBotExchange, enableNewPairs bool) ([]pairRefresh, error) {
+	assets := exch.GetAssetTypes()
+	before := make(map[asset.Item]currency.Pairs, len(assets))
+	for i := range assets {
+		before[assets[i]] = exch.GetAvailablePairs(assets[i])
+	}
+	if err := exch.UpdateTradablePairs(ctx, false); err != nil {
+		return nil, err
+	}
+
+	var refreshes []pairRefresh
+	for i := range assets {
+		after := exch.GetAvailablePairs(assets[i])
+		if len(after) == 0 {
+			continue
+		}
+		listed, delisted := before[assets[i]].FindDifferences(after)
+		if len(listed) == 0 && len(delisted) == 0 {
+			continue
+		}
+		r := pairRefresh{
+			Exchange: exch.GetName(),
+			Asset:    assets[i],
+			Listed:   listed,
+			Delisted: delisted,
+		}
+
+		var enabled currency.Pairs
+		current := exch.GetEnabledPairs(assets[i])
+		for j := range current {
+			if after.Contains(current[j], true) {
+				enabled = append(enabled, current[j])
+				continue
+			}
+			r.Disabled = append(r.Disabled, current[j])
+		}
+		if enableNewPairs {
+			for j := range listed {
+				if !enabled.Contains(listed[j], true) {
+					enabled = append(enabled, listed[j])
+					r.Enabled = append(r.Enabled, listed[j])
+				}
+			}
+		}
+		if len(r.Enabled) > 0 || len(r.Disabled) > 0 {
+			// An exchange asset must keep an enabled pair, so the last
+			// delisted pair is left enabled
+			if len(enabled) == 0 {
+				log.Warnf(log.ExchangeSys, "%s %s all enabled pairs delisted, leaving them enabled.\n",
+					r.Exchange, r.Asset)
+				r.Disabled = nil
+			} else if err := exch.SetPairs(enabled, assets[i], true); err != nil {
+				return nil, err
+			}
+		}
+		resubscribePairs(exch, &r)
+		refreshes = append(refreshes, r)
+	}
+	return refreshes, nil
+}
+
+// resubscribePairs unsubscribes the websocket from the channels of disabled
+// pairs and subscribes the newly enabled pairs to the channels the other
+// pairs are subscribed to
+func resubscribePairs(exch exchange.IBotExchange, r *pairRefresh) {
+	if !exch.IsWebsocketEnabled() || (len(r.Enabled) == 0 && len(r.Disabled) == 0) {
+		return
+	}
+	subs, err := exch.GetSubscriptions()
+	if err != nil {
+		return
+	}
+
+	var unsubscribe []wshandler.WebsocketChannelSubscription
+	var channels []string
+	for i := range subs {
+		if subs[i].Currency.IsEmpty() {
+			continue
+		}
+		if r.Disabled.Contains(subs[i].Currency, false) {
+			unsubscribe = append(unsubscribe, subs[i])
+			continue
+		}
+		if !common.StringDataCompare(channels, subs[i].Channel) {
+			channels = append(channels, subs[i].Channel)
+		}
+	}
+	var subscribe []wshandler.WebsocketChannelSubscription
+	for i := range r.Enabled {
+		for j := range channels {
+			subscribe = append(subscribe, wshandler.WebsocketChannelSubscription{
+				Channel:  channels[j],
+				Currency: r.Enabled[i],
+			})
+		}
+	}
+
+	if len(unsubscribe) > 0 {
+		if err = exch.UnsubscribeToWebsocketChannels(unsubscribe); err != nil {
+			log.Warnf(log.WebsocketMgr, "%s unable to unsubscribe from delisted pairs: %v\n",
+				r.Exchange, err)
+		}
+	}
+	if len(subscribe) > 0 {
+		if err = exch.SubscribeToWebsocketChannels(subscribe); err != nil {
+			log.Warnf(log.WebsocketMgr, "%s unable to subscribe to listed pairs: %v\n",
+				r.Exchange, err)
+		}
+	}
+}
+
+// pushPairRefreshEvent sends the changes made by a pair refresh to the
+// communication mediums
+func pushPairRefreshEvent(r *pairRefresh) {
+	var changes []string
+	if len(r.Listed) > 0 {
+		changes = append(changes, "listed "+strings.Join(r.Listed.Strings(), ","))
+	}
+	if len(r.Delisted) > 0 {
+		changes = append(changes, "delisted "+strings.Join(r.Delisted.Strings(), ","))
+	}
+	if len(r.Enabled) > 0 {
+		changes = append(changes, "enabled "+strings.Join(r.Enabled.Strings(), ","))
+	}
+	if len(r.Disabled) > 0 {
+		changes = append(changes, "disabled "+strings.Join(r.Disabled.Strings(), ","))
+	}
+	msg := fmt.Sprintf("Tradable pair refresher: %s %s %s",
+		r.Exchange, r.Asset, strings.Join(changes, ", "))
+	log.Infoln(log.ExchangeSys, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    base.EventTypeEvent,
+		Message: msg,
+	})
+}
@@ -0,0 +1,142 @@
+package engine
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+// pairTestExchange lists the next set of tradable pairs when updated and
+// records websocket subscription changes
+type pairTestExchange struct {
+	exchange.IBotExchange
+	available    currency.Pairs
+	enabled      currency.Pairs
+	next         currency.Pairs
+	subs         []wshandler.WebsocketChannelSubscription
+	subscribed   []wshandler.WebsocketChannelSubscription
+	unsubscribed []wshandler.WebsocketChannelSubscription
+}
+
+func (e *pairTestExchange) GetName() string                             { return "pairtest" }
+func (e *pairTestExchange) GetAssetTypes() asset.Items                  { return asset.Items{asset.Spot} }
+func (e *pairTestExchange) GetAvailablePairs(asset.Item) currency.Pairs { return e.available }
+func (e *pairTestExchange) GetEnabledPairs(asset.Item) currency.Pairs   { return e.enabled }
+func (e *pairTestExchange) IsWebsocketEnabled() bool                    { return true }
+
+func (e *pairTestExchange) UpdateTradablePairs(context.Context, bool) error {
+	e.available = e.next
+	return nil
+}
+
+func (e *pairTestExchange) SetPairs(p currency.Pairs, _ asset.Item, _ bool) error {
+	e.enabled = p
+	return nil
+}
+
+func (e *pairTestExchange) GetSubscriptions() ([]wshandler.WebsocketChannelSubscription, error) {
+	return e.subs, nil
+}
+
+func (e *pairTestExchange) SubscribeToWebsocketChannels(c []wshandler.WebsocketChannelSubscription) error {
+	e.subscribed = append(e.subscribed, c...)
+	return nil
+}
+
+func (e *pairTestExchange) UnsubscribeToWebsocketChannels(c []wshandler.WebsocketChannelSubscription) error {
+	e.unsubscribed = append(e.unsubscribed, c...)
+	return nil
+}
+
+func TestRefreshPairs(t *testing.T) {
+	btc := currency.NewPairFromString("BTC-USD")
+	ltc := currency.NewPairFromString("LTC-USD")
+	eth := currency.NewPairFromString("ETH-USD")
+	exch := &pairTestExchange{
+		available: currency.Pairs{btc, ltc},
+		enabled:   currency.Pairs{btc, ltc},
+		next:      currency.Pairs{btc, eth},
+		subs: []wshandler.WebsocketChannelSubscription{
+			{Channel: "ticker", Currency: btc},
+			{Channel: "ticker", Currency: ltc},
+			{Channel: "book", Currency: btc},
+			{Channel: "heartbeat"},
+		},
+	}
+
+	refreshes, err := refreshPairs(context.Background(), exch, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refreshes) != 1 {
+		t.Fatalf("expected a single refresh, received %+v", refreshes)
+	}
+	r := refreshes[0]
+	if !r.Listed.Contains(eth, true) || !r.Delisted.Contains(ltc, true) ||
+		!r.Disabled.Contains(ltc, true) || len(r.Enabled) != 0 {
+		t.Errorf("unexpected refresh %+v", r)
+	}
+	if len(exch.enabled) != 1 || !exch.enabled.Contains(btc, true) {
+		t.Errorf("expected the delisted pair to be disabled, received %v", exch.enabled)
+	}
+	if len(exch.unsubscribed) != 1 || exch.unsubscribed[0].Currency != ltc {
+		t.Errorf("expected the delisted pair to be unsubscribed, received %+v", exch.unsubscribed)
+	}
+
+	// Newly listed pairs are enabled and subscribed to the pair channels
+	exch.available = currency.Pairs{btc}
+	exch.next = currency.Pairs{btc, eth}
+	refreshes, err = refreshPairs(context.Background(), exch, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refreshes) != 1 || !refreshes[0].Enabled.Contains(eth, true) {
+		t.Fatalf("expected the listed pair to be enabled, received %+v", refreshes)
+	}
+	if !exch.enabled.Contains(eth, true) {
+		t.Errorf("expected the listed pair to be enabled, received %v", exch.enabled)
+	}
+	if len(exch.subscribed) != 2 {
+		t.Errorf("expected ticker and book subscriptions, received %+v", exch.subscribed)
+	}
+
+	// Nothing changes without new listings
+	refreshes, err = refreshPairs(context.Background(), exch, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refreshes) != 0 {
+		t.Errorf("expected no refreshes, received %+v", refreshes)
+	}
+}
+
+func TestRefreshPairsAllDelisted(t *testing.T) {
+	btc := currency.NewPairFromString("BTC-USD")
+	eth := currency.NewPairFromString("ETH-USD")
+	exch := &pairTestExchange{
+		available: currency.Pairs{btc},
+		enabled:   currency.Pairs{btc},
+		next:      currency.Pairs{eth},
+	}
+	refreshes, err := refreshPairs(context.Background(), exch, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(refreshes) != 1 || len(refreshes[0].Disabled) != 0 {
+		t.Errorf("expected the last enabled pair to be kept, received %+v", refreshes)
+	}
+	if !exch.enabled.Contains(btc, true) {
+		t.Errorf("expected the pair to stay enabled, received %v", exch.enabled)
+	}
+}
+
+func TestPairRefresherStop(t *testing.T) {
+	var p pairRefresher
+	if err := p.Stop(); err != errPairRefresherNotStarted {
+		t.Errorf("expected %v, received %v", errPairRefresherNotStarted, err)
+	}
+}
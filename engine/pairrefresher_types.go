@@ -0,0 +1,36 @@
+package engine
+
+import (
+	"errors"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+const pairRefresherName = "tradable pair refresher"
+
+var errPairRefresherNotStarted = errors.New("tradable pair refresher not started")
+
+// pairRefresher keeps the tradable pairs of each exchange current while the
+// bot runs, so markets listed or delisted after startup are picked up
+// without a restart
+type pairRefresher struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	cfg      config.PairRefreshConfig
+}
+
+// pairRefresh holds the changes made to an exchange asset's pairs by a
+// refresh
+type pairRefresh struct {
+	Exchange string
+	Asset    asset.Item
+	Listed   currency.Pairs
+	Delisted currency.Pairs
+	// Enabled holds the listed pairs which were enabled and Disabled the
+	// enabled pairs which were delisted
+	Enabled  currency.Pairs
+	Disabled currency.Pairs
+}
@@ -0,0 +1,239 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errTopOfBookUnknown = errors.New("top of book is unknown")
+
+// load sets the partial fill policy of each enabled strategy
+func (p *partialFills) load(cfg []config.StrategyConfig) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	p.strategies = make(map[string]partialFillRule)
+	for i := range cfg {
+		if cfg[i].Enabled && cfg[i].PartialFillPolicy != "" {
+			p.strategies[cfg[i].ID] = partialFillRule{
+				Policy:  order.PartialFillPolicy(cfg[i].PartialFillPolicy),
+				Timeout: cfg[i].PartialFillTimeout,
+			}
+		}
+	}
+}
+
+// rule returns the partial fill policy of a submission, its own or else its
+// strategy's, defaulting to leaving the remainder
+func (p *partialFills) rule(s *order.Submit) partialFillRule {
+	r := partialFillRule{Policy: s.PartialFillPolicy, Timeout: s.PartialFillTimeout}
+	if r.Policy == "" && s.StrategyID != "" {
+		p.m.Lock()
+		r = p.strategies[s.StrategyID]
+		p.m.Unlock()
+		if s.PartialFillTimeout > 0 {
+			r.Timeout = s.PartialFillTimeout
+		}
+	}
+	if r.Policy == "" {
+		r.Policy = order.PartialFillLeave
+	}
+	if r.Timeout <= 0 {
+		r.Timeout = defaultPartialFillTimeout
+	}
+	return r
+}
+
+// track holds a placed order whose remainder is cancelled or re-pegged once
+// partially filled
+func (p *partialFills) track(exchName, orderID string, s *order.Submit) {
+	r := p.rule(s)
+	if r.Policy == order.PartialFillLeave {
+		return
+	}
+	p.m.Lock()
+	defer p.m.Unlock()
+	if p.orders == nil {
+		p.orders = make(map[string]*partialFillOrder)
+	}
+	p.orders[orderKey(exchName, orderID)] = &partialFillOrder{
+		partialFillRule: r,
+		Exchange:        exchName,
+		OrderID:         orderID,
+		Submit:          *s,
+	}
+}
+
+// filled marks a tracked order as partially filled at t, unless it already
+// was, returning when it was first partially filled
+func (p *partialFills) filled(exchName, orderID string, t time.Time) time.Time {
+	p.m.Lock()
+	defer p.m.Unlock()
+	o, ok := p.orders[orderKey(exchName, orderID)]
+	if !ok {
+		return time.Time{}
+	}
+	if o.since.IsZero() {
+		o.since = t
+	}
+	return o.since
+}
+
+// repegged moves a tracked order to the order re-pegging it at a price,
+// restarting its timeout at t
+func (p *partialFills) repegged(exchName, orderID, newID string, price decimal.Decimal, t time.Time) {
+	p.m.Lock()
+	defer p.m.Unlock()
+	key := orderKey(exchName, orderID)
+	o, ok := p.orders[key]
+	if !ok {
+		return
+	}
+	delete(p.orders, key)
+	o.OrderID = newID
+	o.Submit.Price = price
+	o.since = t
+	p.orders[orderKey(exchName, newID)] = o
+}
+
+// remove stops tracking an order
+func (p *partialFills) remove(exchName, orderID string) {
+	p.m.Lock()
+	delete(p.orders, orderKey(exchName, orderID))
+	p.m.Unlock()
+}
+
+// tracked returns the tracked orders
+func (p *partialFills) tracked() []partialFillOrder {
+	p.m.Lock()
+	defer p.m.Unlock()
+	resp := make([]partialFillOrder, 0, len(p.orders))
+	for _, o := range p.orders {
+		resp = append(resp, *o)
+	}
+	return resp
+}
+
+// applyPartialFillPolicies cancels or re-pegs the remainder of each tracked
+// order which has been partially filled for its timeout. A re-pegged order is
+// re-pegged again every timeout until it fills
+func (o *orderManager) applyPartialFillPolicies() {
+	now := time.Now()
+	tracked := o.partialFills.tracked()
+	for i := range tracked {
+		p := &tracked[i]
+		d, ok := o.orderStore.get(p.Exchange, p.OrderID)
+		if !ok {
+			continue
+		}
+		if isOrderClosed(d.Status) {
+			o.partialFills.remove(p.Exchange, p.OrderID)
+			continue
+		}
+		if p.since.IsZero() && d.ExecutedAmount.Sign() <= 0 {
+			continue
+		}
+		if since := o.partialFills.filled(p.Exchange, p.OrderID, now); now.Sub(since) < p.Timeout {
+			continue
+		}
+		switch p.Policy {
+		case order.PartialFillCancel:
+			o.cancelRemainder(p, &d)
+		case order.PartialFillRepeg:
+			o.repegRemainder(p, &d, now)
+		}
+	}
+}
+
+// cancelRemainder cancels the remainder of a partially filled order
+func (o *orderManager) cancelRemainder(p *partialFillOrder, d *order.Detail) {
+	err := o.Cancel(p.Exchange, &order.Cancel{
+		OrderID:      p.OrderID,
+		CurrencyPair: p.Submit.Pair,
+		AssetType:    p.Submit.AssetType,
+		Side:         p.Submit.OrderSide,
+	})
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Exchange %s unable to cancel the remainder of partially filled order ID=%v: %v\n",
+			p.Exchange, p.OrderID, err)
+		return
+	}
+	o.partialFills.remove(p.Exchange, p.OrderID)
+	recordPartialFillAction(p, fmt.Sprintf("Exchange %s cancelled the remainder of order ID=%v executed=%v of amount=%v",
+		p.Exchange, p.OrderID, d.ExecutedAmount, d.Amount))
+}
+
+// repegRemainder moves the remainder of a partially filled order to the top
+// of the book, on the order's side
+func (o *orderManager) repegRemainder(p *partialFillOrder, d *order.Detail, now time.Time) {
+	exch := GetExchangeByName(p.Exchange)
+	if exch == nil {
+		return
+	}
+	price, err := topOfBook(exch, &p.Submit)
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Exchange %s unable to re-peg order ID=%v: %v\n",
+			p.Exchange, p.OrderID, err)
+		return
+	}
+	if price.Equal(p.Submit.Price) {
+		// Already at the top of the book
+		o.partialFills.repegged(p.Exchange, p.OrderID, p.OrderID, price, now)
+		return
+	}
+	resp, err := o.Modify(p.Exchange, &order.Modify{
+		OrderID:      p.OrderID,
+		Price:        price,
+		CurrencyPair: p.Submit.Pair,
+		AssetType:    p.Submit.AssetType,
+	})
+	if err != nil {
+		log.Errorf(log.OrderMgr, "Order manager: Exchange %s unable to re-peg order ID=%v to %v: %v\n",
+			p.Exchange, p.OrderID, price, err)
+		return
+	}
+	o.partialFills.repegged(p.Exchange, p.OrderID, resp.OrderID, price, now)
+	recordPartialFillAction(p, fmt.Sprintf("Exchange %s re-pegged the remainder of order ID=%v executed=%v of amount=%v from price=%v to %v as order ID=%v",
+		p.Exchange, p.OrderID, d.ExecutedAmount, d.Amount, p.Submit.Price, price, resp.OrderID))
+}
+
+// topOfBook returns the best price on an order's side of the book
+func topOfBook(exch exchange.IBotExchange, s *order.Submit) (decimal.Decimal, error) {
+	a := s.AssetType
+	if a == "" {
+		a = asset.Spot
+	}
+	ob, err := exch.FetchOrderbook(Bot.Context(), s.Pair, a)
+	if err != nil {
+		return decimal.Zero, err
+	}
+	side := ob.Asks
+	if s.OrderSide == order.Buy || s.OrderSide == order.Bid {
+		side = ob.Bids
+	}
+	if len(side) == 0 || side[0].Price <= 0 {
+		return decimal.Zero, fmt.Errorf("%v: %s %s", errTopOfBookUnknown, s.Pair, a)
+	}
+	return decimal.NewFromFloat(side[0].Price), nil
+}
+
+// recordPartialFillAction logs and audits a partial fill policy being applied
+func recordPartialFillAction(p *partialFillOrder, msg string) {
+	id := p.Submit.CorrelationID
+	if id == "" {
+		id = p.OrderID
+	}
+	log.WithFields(log.OrderMgr, log.Fields{
+		Exchange:      p.Exchange,
+		CorrelationID: p.Submit.CorrelationID,
+	}).Infof("Order manager: %s\n", msg)
+	audit.Event(id, auditEventOrder, msg)
+}
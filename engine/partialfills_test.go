@@ -0,0 +1,158 @@
+package engine
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
+)
+
+// partialFillTestExchange cancels and replaces orders, with a best bid of 101
+type partialFillTestExchange struct {
+	modifyTestExchange
+}
+
+func (e *partialFillTestExchange) GetName() string {
+	return "partialFillTest"
+}
+
+func (e *partialFillTestExchange) FetchOrderbook(_ context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+	return &orderbook.Base{
+		Pair:      p,
+		AssetType: a,
+		Bids:      []orderbook.Item{{Price: 101, Amount: 1}},
+		Asks:      []orderbook.Item{{Price: 102, Amount: 1}},
+	}, nil
+}
+
+func TestPartialFillRule(t *testing.T) {
+	var p partialFills
+	p.load([]config.StrategyConfig{
+		{ID: "momentum", Enabled: true, PartialFillPolicy: "repeg", PartialFillTimeout: time.Second},
+		{ID: "disabled", PartialFillPolicy: "cancel"},
+	})
+
+	if r := p.rule(&order.Submit{}); r.Policy != order.PartialFillLeave || r.Timeout != defaultPartialFillTimeout {
+		t.Errorf("expected the remainder to be left by default, got %+v", r)
+	}
+	if r := p.rule(&order.Submit{StrategyID: "momentum"}); r.Policy != order.PartialFillRepeg || r.Timeout != time.Second {
+		t.Errorf("expected the strategy's policy, got %+v", r)
+	}
+	r := p.rule(&order.Submit{StrategyID: "momentum", PartialFillTimeout: time.Hour})
+	if r.Policy != order.PartialFillRepeg || r.Timeout != time.Hour {
+		t.Errorf("expected the order's timeout to override the strategy's, got %+v", r)
+	}
+	r = p.rule(&order.Submit{StrategyID: "momentum", PartialFillPolicy: order.PartialFillCancel})
+	if r.Policy != order.PartialFillCancel || r.Timeout != defaultPartialFillTimeout {
+		t.Errorf("expected the order's policy to override the strategy's, got %+v", r)
+	}
+	if r = p.rule(&order.Submit{StrategyID: "disabled"}); r.Policy != order.PartialFillLeave {
+		t.Errorf("expected a disabled strategy's policy to be ignored, got %+v", r)
+	}
+
+	p.track("Bitstamp", "1", &order.Submit{})
+	if len(p.tracked()) != 0 {
+		t.Error("expected an order leaving its remainder not to be tracked")
+	}
+}
+
+func TestApplyPartialFillPolicies(t *testing.T) {
+	SetupTestHelpers(t)
+	exch := &partialFillTestExchange{}
+	Bot.exchangeManager.add(exch)
+	defer func() {
+		if err := Bot.exchangeManager.removeExchange(exch.GetName()); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	var o orderManager
+	name := exch.GetName()
+	pair := currency.NewPair(currency.BTC, currency.USD)
+	submit := func(id string, policy order.PartialFillPolicy) {
+		o.partialFills.track(name, id, &order.Submit{
+			Pair:              pair,
+			OrderSide:         order.Buy,
+			OrderType:         order.Limit,
+			Price:             decimal.NewFromInt(100),
+			Amount:            decimal.NewFromInt(3),
+			PartialFillPolicy: policy,
+		})
+		o.orderStore.upsert(&order.Detail{
+			Exchange:     name,
+			ID:           id,
+			CurrencyPair: pair,
+			OrderSide:    order.Buy,
+			OrderType:    order.Limit,
+			Status:       order.Active,
+			Price:        decimal.NewFromInt(100),
+			Amount:       decimal.NewFromInt(3),
+		})
+	}
+	fill := func(id string) {
+		d, _ := o.orderStore.get(name, id)
+		d.Status = order.PartiallyFilled
+		d.ExecutedAmount = decimal.NewFromInt(1)
+		d.RemainingAmount = decimal.NewFromInt(2)
+		o.orderStore.upsert(&d)
+	}
+	expire := func(id string) {
+		o.partialFills.m.Lock()
+		o.partialFills.orders[orderKey(name, id)].since = time.Now().Add(-time.Hour)
+		o.partialFills.m.Unlock()
+	}
+
+	submit("1", order.PartialFillCancel)
+	submit("2", order.PartialFillRepeg)
+	submit("3", order.PartialFillCancel)
+
+	// Unfilled orders are left alone
+	o.applyPartialFillPolicies()
+	if len(exch.cancelled) != 0 {
+		t.Fatalf("expected an unfilled order not to be cancelled, got %v", exch.cancelled)
+	}
+
+	// The timeout starts when the order is first seen partially filled
+	fill("1")
+	fill("2")
+	o.applyPartialFillPolicies()
+	if len(exch.cancelled) != 0 {
+		t.Fatalf("expected the policies to wait for the timeout, got %v", exch.cancelled)
+	}
+
+	expire("1")
+	expire("2")
+	o.applyPartialFillPolicies()
+	if len(exch.cancelled) != 2 {
+		t.Fatalf("expected both orders to be cancelled, got %v", exch.cancelled)
+	}
+	if len(exch.submitted) != 1 || !exch.submitted[0].Price.Equal(decimal.NewFromInt(101)) ||
+		!exch.submitted[0].Amount.Equal(decimal.NewFromInt(2)) {
+		t.Fatalf("expected the remainder re-pegged to the best bid, got %+v", exch.submitted)
+	}
+	tracked := o.partialFills.tracked()
+	if len(tracked) != 2 {
+		t.Fatalf("expected the re-pegged order and the unfilled order tracked, got %+v", tracked)
+	}
+	for i := range tracked {
+		if tracked[i].OrderID == "replacement1" &&
+			(!tracked[i].Submit.Price.Equal(decimal.NewFromInt(101)) || time.Since(tracked[i].since) > time.Minute) {
+			t.Errorf("expected the re-pegged order's timeout to restart, got %+v", tracked[i])
+		}
+	}
+
+	// Closed orders are no longer tracked
+	d, _ := o.orderStore.get(name, "3")
+	d.Status = order.Filled
+	o.orderStore.upsert(&d)
+	o.applyPartialFillPolicies()
+	if tracked = o.partialFills.tracked(); len(tracked) != 1 || tracked[0].OrderID != "replacement1" {
+		t.Errorf("expected only the re-pegged order tracked, got %+v", tracked)
+	}
+}
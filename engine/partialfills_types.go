@@ -0,0 +1,39 @@
+package engine
+
+import (
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// defaultPartialFillTimeout is how long an order is partially filled before
+// its policy is applied when neither the order nor its strategy set a timeout
+const defaultPartialFillTimeout = time.Minute
+
+// partialFills holds the orders whose remainder is cancelled or re-pegged
+// once partially filled, and the partial fill policy of each strategy
+type partialFills struct {
+	m sync.Mutex
+	// orders is keyed by exchange and order ID
+	orders     map[string]*partialFillOrder
+	strategies map[string]partialFillRule
+}
+
+// partialFillRule is a partial fill policy and how long an order is
+// partially filled before it is applied
+type partialFillRule struct {
+	Policy  order.PartialFillPolicy
+	Timeout time.Duration
+}
+
+// partialFillOrder is an order with a partial fill policy other than leave
+type partialFillOrder struct {
+	partialFillRule
+	Exchange string
+	OrderID  string
+	Submit   order.Submit
+	// since is when the order was first seen partially filled or last
+	// re-pegged, zero until then
+	since time.Time
+}
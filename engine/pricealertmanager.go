@@ -0,0 +1,289 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/pricealert"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (m *priceAlertManager) Started() bool {
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+func (m *priceAlertManager) Start() error {
+	if !Bot.DatabaseManager.Started() {
+		return errPriceAlertsNeedDatabase
+	}
+	if atomic.AddInt32(&m.started, 1) != 1 {
+		return errors.New("price alert manager already started")
+	}
+
+	alerts, err := pricealert.Get(true)
+	if err != nil {
+		atomic.CompareAndSwapInt32(&m.started, 1, 0)
+		return err
+	}
+	m.mtx.Lock()
+	m.alerts = alerts
+	m.mtx.Unlock()
+
+	m.interval = Bot.Config.PriceAlerts.CheckInterval
+	m.maxTickerAge = Bot.Config.PriceAlerts.MaxTickerAge
+	m.shutdown = make(chan struct{})
+	go m.run()
+	log.Debugf(log.Global, "Price alert manager started with %d active alerts.\n", len(alerts))
+	return nil
+}
+
+func (m *priceAlertManager) Stop() error {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return errors.New("price alert manager not started")
+	}
+
+	if atomic.AddInt32(&m.stopped, 1) != 1 {
+		return errors.New("price alert manager is already stopped")
+	}
+
+	close(m.shutdown)
+	log.Debugln(log.Global, "Price alert manager shutting down...")
+	return nil
+}
+
+func (m *priceAlertManager) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(m.interval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&m.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&m.started, 1, 0)
+		log.Debugln(log.Global, "Price alert manager shutdown.")
+	}()
+
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+			guard(priceAlertManagerName, m.check)
+		}
+	}
+}
+
+// check triggers every active alert whose condition is met by the latest
+// price. Triggered alerts are deactivated so each is only sent once
+func (m *priceAlertManager) check() {
+	m.mtx.Lock()
+	alerts := make([]pricealert.Alert, len(m.alerts))
+	copy(alerts, m.alerts)
+	m.mtx.Unlock()
+
+	exchanges := GetExchangeNames(true)
+	now := time.Now()
+	for i := range alerts {
+		price, err := alertPrice(&alerts[i], exchanges, now, m.maxTickerAge)
+		if err != nil {
+			continue
+		}
+		if !alertTriggered(&alerts[i], price) {
+			continue
+		}
+		// Alerts which can't be marked triggered are retried next check
+		// rather than being sent again on every check after a restart
+		if err = pricealert.MarkTriggered(alerts[i].ID, price); err != nil {
+			log.Errorf(log.Global, "Price alert manager unable to store triggered alert %d: %v\n",
+				alerts[i].ID, err)
+			continue
+		}
+		m.remove(alerts[i].ID)
+		alerts[i].Active = false
+		alerts[i].TriggeredPrice = price
+		pushPriceAlertEvent(&alerts[i])
+	}
+}
+
+// Add validates and stores a new price alert. A CHANGE alert without a
+// reference price is measured from the current price
+func (m *priceAlertManager) Add(a *pricealert.Alert) error {
+	if !m.Started() {
+		return errPriceAlertManagerNotStarted
+	}
+	a.Condition = strings.ToUpper(a.Condition)
+	switch a.Condition {
+	case AlertAbove, AlertBelow, AlertChange:
+	default:
+		return errInvalidAlertCondition
+	}
+	if a.Threshold <= 0 {
+		return errAlertThresholdUnset
+	}
+	if a.Pair == "" {
+		return errors.New(errCurrencyPairUnset)
+	}
+	if !asset.IsValid(asset.Item(a.Asset)) {
+		return errors.New(errAssetTypeUnset)
+	}
+	if a.Exchange != "" {
+		exch := GetExchangeByName(a.Exchange)
+		if exch == nil {
+			return errors.New("Exchange " + a.Exchange + " not found")
+		}
+		a.Exchange = exch.GetName()
+	}
+	a.Pair = currency.NewPairDelimiter(a.Pair, priceAlertPairDelimiter).
+		Format(priceAlertPairDelimiter, true).String()
+
+	if a.Condition == AlertChange && a.ReferencePrice <= 0 {
+		price, err := alertPrice(a, GetExchangeNames(true), time.Now(), m.maxTickerAge)
+		if err != nil {
+			return fmt.Errorf("unable to set reference price: %v", err)
+		}
+		a.ReferencePrice = price
+	}
+	a.Active = true
+	a.TriggeredPrice = 0
+	if err := pricealert.Insert(a); err != nil {
+		return err
+	}
+
+	m.mtx.Lock()
+	m.alerts = append(m.alerts, *a)
+	m.mtx.Unlock()
+	return nil
+}
+
+// Remove deletes a price alert
+func (m *priceAlertManager) Remove(id int64) error {
+	if !m.Started() {
+		return errPriceAlertManagerNotStarted
+	}
+	if err := pricealert.Delete(id); err != nil {
+		return err
+	}
+	m.remove(id)
+	return nil
+}
+
+// Alerts returns the stored price alerts, only those yet to trigger if
+// activeOnly is set
+func (m *priceAlertManager) Alerts(activeOnly bool) ([]pricealert.Alert, error) {
+	if !m.Started() {
+		return nil, errPriceAlertManagerNotStarted
+	}
+	return pricealert.Get(activeOnly)
+}
+
+func (m *priceAlertManager) remove(id int64) {
+	m.mtx.Lock()
+	defer m.mtx.Unlock()
+	for i := range m.alerts {
+		if m.alerts[i].ID == id {
+			m.alerts = append(m.alerts[:i], m.alerts[i+1:]...)
+			return
+		}
+	}
+}
+
+// alertTriggered reports whether a price meets an alert's condition
+func alertTriggered(a *pricealert.Alert, price float64) bool {
+	switch a.Condition {
+	case AlertAbove:
+		return price >= a.Threshold
+	case AlertBelow:
+		return price <= a.Threshold
+	case AlertChange:
+		if a.ReferencePrice <= 0 {
+			return false
+		}
+		return math.Abs(price-a.ReferencePrice)/a.ReferencePrice*100 >= a.Threshold
+	}
+	return false
+}
+
+// alertPrice returns the latest price of an alert's pair on its exchange, or
+// the composite price across exchanges when the alert has no exchange
+func alertPrice(a *pricealert.Alert, exchanges []string, now time.Time, maxAge time.Duration) (float64, error) {
+	p := currency.NewPairDelimiter(a.Pair, priceAlertPairDelimiter)
+	item := asset.Item(a.Asset)
+	if a.Exchange != "" {
+		return tickerPrice(a.Exchange, p, item, now, maxAge)
+	}
+	return compositePrice(exchanges, p, item, now, maxAge)
+}
+
+// compositePrice returns the mean of the latest prices of a pair across
+// exchanges, ignoring exchanges without a recent ticker
+func compositePrice(exchanges []string, p currency.Pair, a asset.Item, now time.Time, maxAge time.Duration) (float64, error) {
+	var total float64
+	var count int
+	for i := range exchanges {
+		price, err := tickerPrice(exchanges[i], p, a, now, maxAge)
+		if err != nil {
+			continue
+		}
+		total += price
+		count++
+	}
+	if count == 0 {
+		return 0, fmt.Errorf("no recent price for %s %s on any exchange", p, a)
+	}
+	return total / float64(count), nil
+}
+
+// tickerPrice returns the last traded price of a pair on an exchange, or the
+// mid price if the ticker has no last price
+func tickerPrice(exchangeName string, p currency.Pair, a asset.Item, now time.Time, maxAge time.Duration) (float64, error) {
+	t, err := ticker.GetTicker(exchangeName, p, a)
+	if err != nil {
+		return 0, err
+	}
+	if now.Sub(t.LastUpdated) > maxAge {
+		return 0, fmt.Errorf("no recent price for %s %s on %s", p, a, exchangeName)
+	}
+	if t.Last > 0 {
+		return t.Last, nil
+	}
+	if t.Bid > 0 && t.Ask > 0 {
+		return (t.Bid + t.Ask) / 2, nil
+	}
+	return 0, fmt.Errorf("no recent price for %s %s on %s", p, a, exchangeName)
+}
+
+// pushPriceAlertEvent sends a triggered price alert to the communication
+// mediums
+func pushPriceAlertEvent(a *pricealert.Alert) {
+	venue := a.Exchange
+	if venue == "" {
+		venue = "composite"
+	}
+	var msg string
+	switch a.Condition {
+	case AlertChange:
+		msg = fmt.Sprintf("Price alert %d: %s %s %s moved %.2f%% from %v to %v",
+			a.ID, venue, a.Pair, a.Asset,
+			(a.TriggeredPrice-a.ReferencePrice)/a.ReferencePrice*100,
+			a.ReferencePrice, a.TriggeredPrice)
+	default:
+		msg = fmt.Sprintf("Price alert %d: %s %s %s at %v is %s %v",
+			a.ID, venue, a.Pair, a.Asset, a.TriggeredPrice,
+			strings.ToLower(a.Condition), a.Threshold)
+	}
+	if a.Note != "" {
+		msg += " (" + a.Note + ")"
+	}
+	log.Infoln(log.Global, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:    base.EventTypeAlert,
+		Message: msg,
+	})
+}
@@ -0,0 +1,108 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/pricealert"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestAlertTriggered(t *testing.T) {
+	testCases := []struct {
+		alert    pricealert.Alert
+		price    float64
+		expected bool
+	}{
+		{pricealert.Alert{Condition: AlertAbove, Threshold: 100}, 99, false},
+		{pricealert.Alert{Condition: AlertAbove, Threshold: 100}, 100, true},
+		{pricealert.Alert{Condition: AlertBelow, Threshold: 100}, 101, false},
+		{pricealert.Alert{Condition: AlertBelow, Threshold: 100}, 99, true},
+		{pricealert.Alert{Condition: AlertChange, Threshold: 5, ReferencePrice: 100}, 104, false},
+		{pricealert.Alert{Condition: AlertChange, Threshold: 5, ReferencePrice: 100}, 105, true},
+		{pricealert.Alert{Condition: AlertChange, Threshold: 5, ReferencePrice: 100}, 95, true},
+		{pricealert.Alert{Condition: AlertChange, Threshold: 5}, 1000, false},
+		{pricealert.Alert{Condition: "SIDEWAYS", Threshold: 5}, 1000, false},
+	}
+	for i := range testCases {
+		if alertTriggered(&testCases[i].alert, testCases[i].price) != testCases[i].expected {
+			t.Errorf("expected %+v at %v to trigger %v", testCases[i].alert,
+				testCases[i].price, testCases[i].expected)
+		}
+	}
+}
+
+func TestAlertPrice(t *testing.T) {
+	p := currency.NewPair(currency.XRP, currency.DOGE)
+	now := time.Now()
+	tickers := []struct {
+		exchange string
+		price    ticker.Price
+	}{
+		{"alerttesta", ticker.Price{Pair: p, Last: 10, LastUpdated: now}},
+		{"alerttestb", ticker.Price{Pair: p, Bid: 11, Ask: 13, LastUpdated: now}},
+		{"alerttestc", ticker.Price{Pair: p, Last: 100, LastUpdated: now.Add(-time.Hour)}},
+	}
+	for i := range tickers {
+		if err := ticker.ProcessTicker(tickers[i].exchange, &tickers[i].price, asset.Spot); err != nil {
+			t.Fatal(err)
+		}
+	}
+	exchanges := []string{"alerttesta", "alerttestb", "alerttestc", "alerttestd"}
+
+	a := pricealert.Alert{Exchange: "alerttestb", Pair: "XRP-DOGE", Asset: "spot"}
+	price, err := alertPrice(&a, exchanges, now, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price != 12 {
+		t.Errorf("expected the mid price 12, received %v", price)
+	}
+
+	a.Exchange = "alerttestc"
+	if _, err = alertPrice(&a, exchanges, now, time.Minute); err == nil {
+		t.Error("expected an error for a stale ticker")
+	}
+
+	// The composite price ignores the stale ticker and missing exchange
+	a.Exchange = ""
+	price, err = alertPrice(&a, exchanges, now, time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price != 11 {
+		t.Errorf("expected the composite price 11, received %v", price)
+	}
+
+	a.Pair = "XRP-LTC"
+	if _, err = alertPrice(&a, exchanges, now, time.Minute); err == nil {
+		t.Error("expected an error without any tickers")
+	}
+}
+
+func TestPriceAlertManagerAdd(t *testing.T) {
+	SetupTestHelpers(t)
+	var m priceAlertManager
+	if err := m.Add(&pricealert.Alert{}); err != errPriceAlertManagerNotStarted {
+		t.Errorf("expected %v, received %v", errPriceAlertManagerNotStarted, err)
+	}
+
+	m.started = 1
+	if err := m.Add(&pricealert.Alert{Condition: "sideways"}); err != errInvalidAlertCondition {
+		t.Errorf("expected %v, received %v", errInvalidAlertCondition, err)
+	}
+	if err := m.Add(&pricealert.Alert{Condition: "above"}); err != errAlertThresholdUnset {
+		t.Errorf("expected %v, received %v", errAlertThresholdUnset, err)
+	}
+	if err := m.Add(&pricealert.Alert{Condition: "above", Threshold: 1}); err == nil {
+		t.Error("expected an error without a pair")
+	}
+	if err := m.Add(&pricealert.Alert{Condition: "above", Threshold: 1, Pair: "BTC-USD", Asset: "bad"}); err == nil {
+		t.Error("expected an error for an invalid asset")
+	}
+	if err := m.Add(&pricealert.Alert{Condition: "above", Threshold: 1, Pair: "BTC-USD", Asset: "spot", Exchange: "missing"}); err == nil {
+		t.Error("expected an error for a missing exchange")
+	}
+}
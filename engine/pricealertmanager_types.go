@@ -0,0 +1,46 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/pricealert"
+)
+
+const (
+	priceAlertManagerName = "price alert manager"
+	// priceAlertPairDelimiter is the delimiter of pairs stored with alerts
+	priceAlertPairDelimiter = "-"
+)
+
+// Price alert conditions
+const (
+	// AlertAbove triggers once the price is at or above the threshold
+	AlertAbove = "ABOVE"
+	// AlertBelow triggers once the price is at or below the threshold
+	AlertBelow = "BELOW"
+	// AlertChange triggers once the price has moved by the threshold percent
+	// from the reference price in either direction
+	AlertChange = "CHANGE"
+)
+
+var (
+	errPriceAlertManagerNotStarted = errors.New("price alert manager not started")
+	errPriceAlertsNeedDatabase     = errors.New("price alerts require the database to be enabled")
+	errInvalidAlertCondition       = errors.New("invalid price alert condition, must be ABOVE, BELOW or CHANGE")
+	errAlertThresholdUnset         = errors.New("price alert threshold must be greater than 0")
+)
+
+// priceAlertManager checks the active price alerts stored in the database
+// against the latest tickers, sending an alert event through the
+// communications manager when one triggers
+type priceAlertManager struct {
+	started      int32
+	stopped      int32
+	shutdown     chan struct{}
+	interval     time.Duration
+	maxTickerAge time.Duration
+	mtx          sync.Mutex
+	alerts       []pricealert.Alert
+}
@@ -0,0 +1,294 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+func (r *resourceMonitor) Started() bool {
+	return atomic.LoadInt32(&r.started) == 1
+}
+
+func (r *resourceMonitor) Start() error {
+	if atomic.AddInt32(&r.started, 1) != 1 {
+		return errors.New("resource monitor already started")
+	}
+
+	cfg := Bot.Config.ResourceMonitor
+	r.interval = cfg.CheckInterval
+	r.history = resourceHistory{samples: cfg.Samples}
+	r.thresholds = map[string]int64{
+		resourceGoroutines:      int64(cfg.GoroutineGrowthThreshold),
+		resourceHeap:            int64(cfg.HeapGrowthThresholdMB) * bytesPerMB,
+		resourceFileDescriptors: int64(cfg.FileDescriptorGrowthThreshold),
+	}
+	r.shutdown = make(chan struct{})
+	go r.run()
+	log.Debugf(log.Global, "Resource monitor started, checking every %v.\n", r.interval)
+	return nil
+}
+
+func (r *resourceMonitor) Stop() error {
+	if atomic.LoadInt32(&r.started) == 0 {
+		return errors.New("resource monitor not started")
+	}
+
+	if atomic.AddInt32(&r.stopped, 1) != 1 {
+		return errors.New("resource monitor is already stopped")
+	}
+
+	close(r.shutdown)
+	log.Debugln(log.Global, "Resource monitor shutting down...")
+	return nil
+}
+
+func (r *resourceMonitor) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(r.interval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&r.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&r.started, 1, 0)
+		log.Debugln(log.Global, "Resource monitor shutdown.")
+	}()
+
+	guard(resourceMonitorName, r.check)
+	for {
+		select {
+		case <-r.shutdown:
+			return
+		case <-t.C:
+			guard(resourceMonitorName, r.check)
+		}
+	}
+}
+
+// check samples resource usage, updates the resource metrics and opens an
+// incident while any series has grown over the full sample window by more
+// than its threshold
+func (r *resourceMonitor) check() {
+	values := sampleResources()
+	growth, removed := r.history.update(values)
+	for k, v := range values {
+		setResourceMetric(k, float64(v))
+	}
+	for i := range removed {
+		setResourceMetric(removed[i], 0)
+	}
+
+	var leaks []string
+	for k, g := range growth {
+		if g < r.thresholds[k.kind] {
+			continue
+		}
+		leaks = append(leaks, k.describe(g, values[k]))
+	}
+
+	log.Debugf(log.Global, "Resource monitor: goroutines=%d heap=%dMB file descriptors=%d\n",
+		values[resourceKey{kind: resourceGoroutines}],
+		values[resourceKey{kind: resourceHeap}]/bytesPerMB,
+		values[resourceKey{kind: resourceFileDescriptors}])
+	if len(leaks) == 0 {
+		Bot.CommsManager.ResolveIncident(IncidentResourceLeak,
+			"Resource monitor: Resource usage is no longer growing")
+		return
+	}
+	sort.Strings(leaks)
+	msg := fmt.Sprintf("Resource monitor: Sustained growth over the last %v: %s",
+		r.interval*time.Duration(r.history.samples-1), strings.Join(leaks, ", "))
+	log.Warnln(log.Global, msg)
+	Bot.CommsManager.TriggerIncident(IncidentResourceLeak, msg)
+}
+
+// describe returns a description of the growth of a series
+func (k resourceKey) describe(growth, value int64) string {
+	name := k.kind
+	if k.subsystem != "" {
+		name = k.subsystem + " " + k.kind
+	}
+	if k.kind == resourceHeap {
+		return fmt.Sprintf("%s grew by %dMB to %dMB", name, growth/bytesPerMB, value/bytesPerMB)
+	}
+	return fmt.Sprintf("%s grew by %d to %d", name, growth, value)
+}
+
+func setResourceMetric(k resourceKey, v float64) {
+	switch {
+	case k.kind == resourceFileDescriptors:
+		metrics.OpenFileDescriptors.Set(v)
+	case k.subsystem == "":
+		return
+	case k.kind == resourceGoroutines:
+		metrics.Goroutines.Set(v, k.subsystem)
+	case k.kind == resourceHeap:
+		metrics.HeapInUseBytes.Set(v, k.subsystem)
+	}
+}
+
+// update records the latest value of every series, dropping series which are
+// no longer reported, and returns the growth of each series which has a full
+// sample window and has not decreased over it
+func (h *resourceHistory) update(values map[resourceKey]int64) (growth map[resourceKey]int64, removed []resourceKey) {
+	if h.series == nil {
+		h.series = make(map[resourceKey][]int64)
+	}
+	for k := range h.series {
+		if _, ok := values[k]; !ok {
+			delete(h.series, k)
+			removed = append(removed, k)
+		}
+	}
+
+	growth = make(map[resourceKey]int64)
+	for k, v := range values {
+		s := append(h.series[k], v)
+		if len(s) > h.samples {
+			s = s[len(s)-h.samples:]
+		}
+		h.series[k] = s
+		if len(s) < h.samples || s[len(s)-1] <= s[0] {
+			continue
+		}
+		increasing := true
+		for i := 1; i < len(s); i++ {
+			if s[i] < s[i-1] {
+				increasing = false
+				break
+			}
+		}
+		if increasing {
+			growth[k] = s[len(s)-1] - s[0]
+		}
+	}
+	return growth, removed
+}
+
+// sampleResources returns the goroutines and sampled heap in use of each
+// subsystem, along with the process totals and open file descriptors
+func sampleResources() map[resourceKey]int64 {
+	values := make(map[resourceKey]int64)
+
+	goroutines := goroutineProfile()
+	values[resourceKey{kind: resourceGoroutines}] = int64(len(goroutines))
+	for i := range goroutines {
+		values[resourceKey{kind: resourceGoroutines, subsystem: goroutineSubsystem(goroutines[i].Stack())}]++
+	}
+
+	var ms runtime.MemStats
+	runtime.ReadMemStats(&ms)
+	values[resourceKey{kind: resourceHeap}] = int64(ms.HeapAlloc)
+	allocs := memProfile()
+	for i := range allocs {
+		values[resourceKey{kind: resourceHeap, subsystem: allocationSubsystem(allocs[i].Stack())}] += allocs[i].InUseBytes()
+	}
+
+	if fds := openFileDescriptors(); fds >= 0 {
+		values[resourceKey{kind: resourceFileDescriptors}] = int64(fds)
+	}
+	return values
+}
+
+func goroutineProfile() []runtime.StackRecord {
+	n, _ := runtime.GoroutineProfile(nil)
+	for {
+		records := make([]runtime.StackRecord, n+10)
+		var ok bool
+		n, ok = runtime.GoroutineProfile(records)
+		if ok {
+			return records[:n]
+		}
+	}
+}
+
+func memProfile() []runtime.MemProfileRecord {
+	n, _ := runtime.MemProfile(nil, false)
+	for {
+		records := make([]runtime.MemProfileRecord, n+50)
+		var ok bool
+		n, ok = runtime.MemProfile(records, false)
+		if ok {
+			return records[:n]
+		}
+	}
+}
+
+// goroutineSubsystem returns the subsystem of the goroutine's entry function,
+// which is the outermost frame of its stack outside the runtime
+func goroutineSubsystem(stack []uintptr) string {
+	var subsystem string
+	frames := runtime.CallersFrames(stack)
+	for {
+		f, more := frames.Next()
+		if f.Function != "" && !strings.HasPrefix(f.Function, "runtime.") {
+			subsystem = packageSubsystem(f.Function)
+		}
+		if !more {
+			break
+		}
+	}
+	if subsystem == "" {
+		return "runtime"
+	}
+	return subsystem
+}
+
+// allocationSubsystem returns the subsystem of the innermost frame of the bot
+// in an allocation stack, or the innermost frame outside the runtime if the
+// allocation was not made by the bot
+func allocationSubsystem(stack []uintptr) string {
+	var fallback string
+	frames := runtime.CallersFrames(stack)
+	for {
+		f, more := frames.Next()
+		if strings.HasPrefix(f.Function, moduleFunctionPrefix) {
+			return packageSubsystem(f.Function)
+		}
+		if fallback == "" && f.Function != "" && !strings.HasPrefix(f.Function, "runtime.") {
+			fallback = packageSubsystem(f.Function)
+		}
+		if !more {
+			break
+		}
+	}
+	if fallback == "" {
+		return "runtime"
+	}
+	return fallback
+}
+
+// packageSubsystem returns the package path of a fully qualified function
+// name, with the module prefix trimmed from packages of the bot
+func packageSubsystem(function string) string {
+	pkg := function
+	slash := strings.LastIndex(function, "/") + 1
+	if dot := strings.Index(function[slash:], "."); dot >= 0 {
+		pkg = function[:slash+dot]
+	}
+	return strings.TrimPrefix(pkg, moduleFunctionPrefix)
+}
+
+// openFileDescriptors returns the number of file descriptors held by the
+// process, or -1 if they cannot be counted on this platform
+func openFileDescriptors() int {
+	d, err := os.Open("/proc/self/fd")
+	if err != nil {
+		return -1
+	}
+	defer d.Close()
+	names, err := d.Readdirnames(-1)
+	if err != nil {
+		return -1
+	}
+	// Exclude the descriptor used to read the directory
+	return len(names) - 1
+}
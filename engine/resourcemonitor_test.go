@@ -0,0 +1,81 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+func TestResourceHistoryUpdate(t *testing.T) {
+	h := resourceHistory{samples: 3}
+	k := resourceKey{kind: resourceGoroutines, subsystem: "exchanges/websocket/wshandler"}
+	other := resourceKey{kind: resourceGoroutines, subsystem: "engine"}
+
+	for _, v := range []int64{10, 20} {
+		growth, _ := h.update(map[resourceKey]int64{k: v, other: 5})
+		if len(growth) != 0 {
+			t.Fatalf("expected no growth before the sample window is full, received %v", growth)
+		}
+	}
+	growth, _ := h.update(map[resourceKey]int64{k: 20, other: 5})
+	if growth[k] != 10 {
+		t.Errorf("expected growth of 10, received %d", growth[k])
+	}
+	if _, ok := growth[other]; ok {
+		t.Error("expected a flat series not to be growing")
+	}
+
+	// A decrease within the window is not sustained growth
+	growth, removed := h.update(map[resourceKey]int64{k: 15})
+	if _, ok := growth[k]; ok {
+		t.Error("expected a decreasing series not to be growing")
+	}
+	if len(removed) != 1 || removed[0] != other {
+		t.Errorf("expected %v to be removed, received %v", other, removed)
+	}
+	if len(h.series[k]) != 3 {
+		t.Errorf("expected 3 samples to be kept, received %d", len(h.series[k]))
+	}
+}
+
+func TestSampleResources(t *testing.T) {
+	stop := make(chan struct{})
+	defer close(stop)
+	for i := 0; i < 5; i++ {
+		go func() { <-stop }()
+	}
+
+	values := sampleResources()
+	if n := values[resourceKey{kind: resourceGoroutines, subsystem: "engine"}]; n < 5 {
+		t.Errorf("expected at least 5 engine goroutines, received %d", n)
+	}
+	if values[resourceKey{kind: resourceGoroutines}] < 5 || values[resourceKey{kind: resourceHeap}] <= 0 {
+		t.Errorf("unexpected process totals %v", values)
+	}
+}
+
+func TestResourceMonitorCheck(t *testing.T) {
+	SetupTestHelpers(t)
+	r := resourceMonitor{
+		history:    resourceHistory{samples: 2},
+		thresholds: map[string]int64{resourceGoroutines: 1, resourceHeap: bytesPerMB, resourceFileDescriptors: 1},
+	}
+	r.check()
+	// Tests run in goroutines started by the testing package
+	if metrics.Goroutines.Value("testing") == 0 {
+		t.Error("expected testing goroutines metric to be set")
+	}
+}
+
+func TestPackageSubsystem(t *testing.T) {
+	for function, expected := range map[string]string{
+		"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler.(*Websocket).trafficMonitor.func1": "exchanges/websocket/wshandler",
+		"github.com/thrasher-corp/gocryptotrader/engine.WebsocketDataHandler":                                     "engine",
+		"net/http.(*conn).serve": "net/http",
+		"main.main":              "main",
+	} {
+		if s := packageSubsystem(function); s != expected {
+			t.Errorf("expected %s, received %s", expected, s)
+		}
+	}
+}
@@ -0,0 +1,44 @@
+package engine
+
+import "time"
+
+// Resource kinds sampled by the resource monitor
+const (
+	resourceGoroutines      = "goroutines"
+	resourceHeap            = "heap"
+	resourceFileDescriptors = "file descriptors"
+)
+
+const (
+	// moduleFunctionPrefix is trimmed from the package paths of the bot so
+	// subsystems are named by their path in the repository
+	moduleFunctionPrefix = "github.com/thrasher-corp/gocryptotrader/"
+	bytesPerMB           = 1024 * 1024
+	resourceMonitorName  = "resource monitor"
+)
+
+// resourceMonitor periodically samples goroutines, heap usage and open file
+// descriptors by subsystem, alerting on sustained growth
+type resourceMonitor struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	interval time.Duration
+	history  resourceHistory
+	// thresholds is the minimum growth over the sample window to alert on
+	// for each resource kind
+	thresholds map[string]int64
+}
+
+// resourceKey identifies a sampled series, an empty subsystem is the process
+// total
+type resourceKey struct {
+	kind      string
+	subsystem string
+}
+
+// resourceHistory holds the last samples of every series
+type resourceHistory struct {
+	samples int
+	series  map[resourceKey][]int64
+}
@@ -4,7 +4,6 @@ import (
 	"fmt"
 	"net/http"
 	_ "net/http/pprof" // nolint: gosec
-	"runtime"
 	"strconv"
 	"strings"
 	"time"
@@ -30,6 +29,28 @@ func RESTLogger(inner http.Handler, name string) http.Handler {
 	})
 }
 
+// RESTAccess refuses requests from addresses which aren't allowed. Requests to
+// the deprecated RPC server are counted against the session of their address,
+// websocket sessions are started once a connection authenticates
+func RESTAccess(inner http.Handler, isREST bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var err error
+		iface := rpcInterfaceWebsocket
+		if isREST {
+			iface = rpcInterfaceDeprecatedRPC
+			err = Bot.rpcSessions.touch(iface, r.RemoteAddr)
+		} else {
+			err = Bot.rpcSessions.allowed(r.RemoteAddr)
+		}
+		if err != nil {
+			log.Warnf(log.RESTSys, "%s request refused: %v\n", iface, err)
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		inner.ServeHTTP(w, r)
+	})
+}
+
 // StartRESTServer starts a REST server
 func StartRESTServer() {
 	listenAddr := Bot.Config.RemoteControl.DeprecatedRPC.ListenAddress
@@ -86,14 +107,12 @@ func newRouter(isREST bool) *mux.Router {
 		}
 
 		if Bot.Config.Profiler.Enabled {
-			if Bot.Config.Profiler.MutexProfileFraction > 0 {
-				runtime.SetMutexProfileFraction(Bot.Config.Profiler.MutexProfileFraction)
-			}
+			setupProfiler()
 			log.Debugf(log.RESTSys,
 				"HTTP Go performance profiler (pprof) endpoint enabled: http://%s:%d/debug/pprof\n",
 				common.ExtractHost(listenAddr),
 				common.ExtractPort(listenAddr))
-			router.PathPrefix("/debug").Handler(http.DefaultServeMux)
+			router.PathPrefix("/debug").Handler(RESTAccess(http.DefaultServeMux, true))
 		}
 	} else {
 		routes = []Route{
@@ -106,7 +125,7 @@ func newRouter(isREST bool) *mux.Router {
 			Methods(route.Method).
 			Path(route.Pattern).
 			Name(route.Name).
-			Handler(RESTLogger(route.HandlerFunc, route.Name)).
+			Handler(RESTAccess(RESTLogger(route.HandlerFunc, route.Name), isREST)).
 			Host(listenAddr)
 	}
 	return router
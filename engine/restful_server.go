@@ -68,7 +68,7 @@ func GetAllActiveOrderbooks() []EnabledExchangeOrderbooks {
 		for y := range assets {
 			currencies := exchanges[x].GetEnabledPairs(assets[y])
 			for z := range currencies {
-				ob, err := exchanges[x].FetchOrderbook(currencies[z], assets[y])
+				ob, err := exchanges[x].FetchOrderbook(Bot.Context(), currencies[z], assets[y])
 				if err != nil {
 					log.Errorf(log.RESTSys,
 						"Exchange %s failed to retrieve %s orderbook. Err: %s\n", exchName,
@@ -82,6 +82,7 @@ func TestValidHostRequest(t *testing.T) {
 		t.Fatal(err)
 	}
 	req.Host = "localhost:9050"
+	req.RemoteAddr = "127.0.0.1:1337"
 
 	resp := httptest.NewRecorder()
 	newRouter(true).ServeHTTP(resp, req)
@@ -91,6 +92,26 @@ func TestValidHostRequest(t *testing.T) {
 	}
 }
 
+func TestDisallowedAddressRequest(t *testing.T) {
+	Bot = &Engine{
+		Config: loadConfig(t),
+	}
+
+	req, err := http.NewRequest(http.MethodGet, "/config/all", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Host = "localhost:9050"
+	req.RemoteAddr = "192.0.2.1:1337"
+
+	resp := httptest.NewRecorder()
+	newRouter(true).ServeHTTP(resp, req)
+
+	if status := resp.Code; status != http.StatusForbidden {
+		t.Errorf("Response returned wrong status code expected %v got %v", http.StatusForbidden, status)
+	}
+}
+
 func TestProfilerEnabledShouldEnableProfileEndPoint(t *testing.T) {
 	Bot = &Engine{
 		Config: loadConfig(t),
@@ -102,6 +123,7 @@ func TestProfilerEnabledShouldEnableProfileEndPoint(t *testing.T) {
 	}
 
 	req.Host = "localhost:9050"
+	req.RemoteAddr = "127.0.0.1:1337"
 	resp := httptest.NewRecorder()
 	newRouter(true).ServeHTTP(resp, req)
 	if status := resp.Code; status != http.StatusNotFound {
@@ -114,6 +136,7 @@ func TestProfilerEnabledShouldEnableProfileEndPoint(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+	req.RemoteAddr = "127.0.0.1:1337"
 
 	mutexValue := runtime.SetMutexProfileFraction(10)
 	if mutexValue != 0 {
@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -10,6 +11,9 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/stats"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -232,7 +236,7 @@ func WebsocketRoutine() {
 					}
 
 					// Data handler routine
-					go WebsocketDataHandler(ws)
+					superviseWebsocketDataHandler(ws)
 
 					err = ws.Connect()
 					if err != nil {
@@ -249,6 +253,40 @@ func WebsocketRoutine() {
 	}
 }
 
+// shutdownWebsockets shuts down the connected exchange websockets
+// concurrently, returning once they are all shut down or ctx is done
+func shutdownWebsockets(ctx context.Context) {
+	var shutdownWg sync.WaitGroup
+	exchanges := GetExchanges()
+	for i := range exchanges {
+		if !exchanges[i].IsWebsocketEnabled() {
+			continue
+		}
+		ws, err := exchanges[i].GetWebsocket()
+		if err != nil || !ws.IsConnected() {
+			continue
+		}
+		shutdownWg.Add(1)
+		go func(name string, ws *wshandler.Websocket) {
+			defer shutdownWg.Done()
+			if err := ws.Shutdown(); err != nil {
+				log.Errorf(log.WebsocketMgr, "Exchange %s websocket unable to shutdown. Error: %v\n", name, err)
+			}
+		}(exchanges[i].GetName(), ws)
+	}
+
+	closed := make(chan struct{})
+	go func() {
+		shutdownWg.Wait()
+		close(closed)
+	}()
+	select {
+	case <-closed:
+	case <-ctx.Done():
+		log.Warnln(log.WebsocketMgr, "Shutdown deadline exceeded waiting for exchange websockets to close")
+	}
+}
+
 var shutdowner = make(chan struct{}, 1)
 var wg sync.WaitGroup
 
@@ -278,95 +316,177 @@ func Websocketshutdown(ws *wshandler.Websocket) error {
 	}
 }
 
+// superviseWebsocketDataHandler feeds the data of ws into its bounded queue
+// and runs WebsocketDataHandler to process it, restarting the handler if it
+// crashes
+func superviseWebsocketDataHandler(ws *wshandler.Websocket) {
+	q := ws.Queue()
+	q.SetCapacity(Bot.Settings.WebsocketQueueCapacity)
+	go q.Feed(ws.DataHandler, shutdowner)
+	supervise("websocket data handler", func() { WebsocketDataHandler(ws) })
+}
+
 // WebsocketDataHandler handles websocket data coming from a websocket feed
 // associated with an exchange
 func WebsocketDataHandler(ws *wshandler.Websocket) {
 	wg.Add(1)
 	defer wg.Done()
 
+	q := ws.Queue()
 	for {
 		select {
 		case <-shutdowner:
 			return
-
-		case data := <-ws.DataHandler:
-			switch d := data.(type) {
-			case string:
-				switch d {
-				case wshandler.WebsocketNotEnabled:
-					if Bot.Settings.Verbose {
-						log.Warnf(log.WebsocketMgr, "routines.go warning - exchange %s websocket not enabled\n",
-							ws.GetName())
-					}
-				default:
-					log.Info(log.WebsocketMgr, d)
-				}
-			case error:
-				log.Errorf(log.WebsocketMgr, "routines.go exchange %s websocket error - %s", ws.GetName(), data)
-			case wshandler.TradeData:
-				// Websocket Trade Data
-				if Bot.Settings.Verbose {
-					log.Infof(log.WebsocketMgr, "%s websocket %s %s trade updated %+v\n",
-						ws.GetName(),
-						FormatCurrency(d.CurrencyPair),
-						d.AssetType,
-						d)
-				}
-			case wshandler.FundingData:
-				// Websocket Funding Data
-				if Bot.Settings.Verbose {
-					log.Infof(log.WebsocketMgr, "%s websocket %s %s funding updated %+v\n",
-						ws.GetName(),
-						FormatCurrency(d.CurrencyPair),
-						d.AssetType,
-						d)
-				}
-			case *ticker.Price:
-				// Websocket Ticker Data
-				if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
-					Bot.ExchangeCurrencyPairManager.update(ws.GetName(),
-						d.Pair,
-						d.AssetType,
-						SyncItemTicker,
-						nil)
-				}
-				err := ticker.ProcessTicker(ws.GetName(), d, d.AssetType)
-				printTickerSummary(d, d.Pair, d.AssetType, ws.GetName(), "websocket", err)
-			case wshandler.KlineData:
-				// Websocket Kline Data
-				if Bot.Settings.Verbose {
-					log.Infof(log.WebsocketMgr, "%s websocket %s %s kline updated %+v\n",
-						ws.GetName(),
-						FormatCurrency(d.Pair),
-						d.AssetType,
-						d)
-				}
-			case wshandler.WebsocketOrderbookUpdate:
-				// Websocket Orderbook Data
-				result := data.(wshandler.WebsocketOrderbookUpdate)
-				if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
-					Bot.ExchangeCurrencyPairManager.update(ws.GetName(),
-						result.Pair,
-						result.Asset,
-						SyncItemOrderbook,
-						nil)
+		case <-q.Ready():
+			for {
+				data, ok := q.Pop()
+				if !ok {
+					break
 				}
+				handleWebsocketData(ws, data)
+			}
+		}
+	}
+}
 
-				if Bot.Settings.Verbose {
-					log.Infof(log.WebsocketMgr,
-						"%s websocket %s %s orderbook updated\n",
-						ws.GetName(),
-						FormatCurrency(result.Pair),
-						d.Asset)
-				}
-			default:
-				if Bot.Settings.Verbose {
-					log.Warnf(log.WebsocketMgr,
-						"%s websocket Unknown type: %+v\n",
-						ws.GetName(),
-						d)
-				}
+// handleWebsocketData processes a single item of websocket data
+func handleWebsocketData(ws *wshandler.Websocket, data interface{}) {
+	switch d := data.(type) {
+	case string:
+		switch d {
+		case wshandler.WebsocketNotEnabled:
+			if Bot.Settings.Verbose {
+				log.Warnf(log.WebsocketMgr, "routines.go warning - exchange %s websocket not enabled\n",
+					ws.GetName())
 			}
+		default:
+			log.Info(log.WebsocketMgr, d)
+		}
+	case error:
+		action := ws.HandleError(d)
+		log.WithFields(log.WebsocketMgr, log.Fields{
+			Exchange: ws.GetName(),
+			Error:    d,
+		}).Errorf("routines.go exchange %s websocket %s error, recovery: %s - %s",
+			ws.GetName(), wshandler.ErrorKindOf(d), action, d)
+	case wshandler.WebsocketAuthenticatedReconnect:
+		log.Warnf(log.WebsocketMgr, "%s authenticated websocket reconnected after disconnecting at %s\n",
+			d.Exchange, d.Disconnected.Format(time.RFC3339))
+		if Bot.OrderManager.Started() {
+			go func() {
+				if err := Bot.OrderManager.Reconcile(d.Exchange); err != nil {
+					log.Errorf(log.OrderMgr, "Order manager: Exchange %s unable to reconcile orders: %s\n", d.Exchange, err)
+				}
+			}()
+		}
+	case order.ExecutionReport:
+		// Websocket private feed fills
+		if d.Exchange == "" {
+			d.Exchange = ws.GetName()
+		}
+		if Bot.OrderManager.Started() {
+			Bot.OrderManager.processExecution(&d)
+		}
+	case wshandler.TradeData:
+		// Websocket Trade Data
+		if Bot.Settings.Verbose {
+			log.Sample(log.WebsocketMgr, ws.GetName()+" trade").Infof("%s websocket %s %s trade updated %+v\n",
+				ws.GetName(),
+				FormatCurrency(d.CurrencyPair),
+				d.AssetType,
+				d)
+		}
+	case wshandler.FundingData:
+		// Websocket Funding Data
+		if Bot.Settings.Verbose {
+			log.Sample(log.WebsocketMgr, ws.GetName()+" funding").Infof("%s websocket %s %s funding updated %+v\n",
+				ws.GetName(),
+				FormatCurrency(d.CurrencyPair),
+				d.AssetType,
+				d)
+		}
+	case *ticker.Price:
+		// Websocket Ticker Data
+		if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
+			Bot.ExchangeCurrencyPairManager.update(ws.GetName(),
+				d.Pair,
+				d.AssetType,
+				SyncItemTicker,
+				nil)
+		}
+		err := ticker.ProcessTicker(ws.GetName(), d, d.AssetType)
+		printTickerSummary(d, d.Pair, d.AssetType, ws.GetName(), "websocket", err)
+	case wshandler.KlineData:
+		// Websocket Kline Data
+		err := kline.ProcessKline(&kline.Candle{
+			Exchange:    ws.GetName(),
+			Pair:        d.Pair,
+			AssetType:   d.AssetType,
+			Interval:    d.Interval,
+			StartTime:   d.StartTime,
+			CloseTime:   d.CloseTime,
+			Open:        d.OpenPrice,
+			High:        d.HighPrice,
+			Low:         d.LowPrice,
+			Close:       d.ClosePrice,
+			Volume:      d.Volume,
+			LastUpdated: d.Timestamp,
+		})
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "%s websocket kline error: %v\n", ws.GetName(), err)
+		}
+		if Bot.Settings.Verbose {
+			log.Sample(log.WebsocketMgr, ws.GetName()+" kline").Infof("%s websocket %s %s kline updated %+v\n",
+				ws.GetName(),
+				FormatCurrency(d.Pair),
+				d.AssetType,
+				d)
+		}
+	case wshandler.LiquidationData:
+		// Websocket Liquidation Data
+		err := liquidation.ProcessLiquidation(&liquidation.Liquidation{
+			Exchange:  ws.GetName(),
+			Pair:      d.Pair,
+			AssetType: d.AssetType,
+			Side:      d.Side,
+			Price:     d.Price,
+			Amount:    d.Amount,
+			Timestamp: d.Timestamp,
+		})
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "%s websocket liquidation error: %v\n", ws.GetName(), err)
+		}
+		if Bot.Settings.Verbose {
+			log.Sample(log.WebsocketMgr, ws.GetName()+" liquidation").Infof("%s websocket %s %s liquidation %+v\n",
+				ws.GetName(),
+				FormatCurrency(d.Pair),
+				d.AssetType,
+				d)
+		}
+	case wshandler.WebsocketOrderbookUpdate:
+		// Websocket Orderbook Data
+		result := data.(wshandler.WebsocketOrderbookUpdate)
+		if Bot.Settings.EnableExchangeSyncManager && Bot.ExchangeCurrencyPairManager != nil {
+			Bot.ExchangeCurrencyPairManager.update(ws.GetName(),
+				result.Pair,
+				result.Asset,
+				SyncItemOrderbook,
+				nil)
+		}
+
+		if Bot.Settings.Verbose {
+			log.Sample(log.WebsocketMgr, ws.GetName()+" orderbook").Infof(
+				"%s websocket %s %s orderbook updated\n",
+				ws.GetName(),
+				FormatCurrency(result.Pair),
+				d.Asset)
+		}
+	default:
+		if Bot.Settings.Verbose {
+			log.Warnf(log.WebsocketMgr,
+				"%s websocket Unknown type: %+v\n",
+				ws.GetName(),
+				d)
 		}
 	}
 }
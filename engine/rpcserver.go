@@ -2,6 +2,7 @@ package engine
 
 import (
 	"context"
+	"crypto/subtle"
 	"errors"
 	"fmt"
 	"io/ioutil"
@@ -18,26 +19,39 @@ import (
 	grpcruntime "github.com/grpc-ecosystem/grpc-gateway/runtime"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/common/file/archive"
+	"github.com/thrasher-corp/gocryptotrader/common/indicators"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/database/models/postgres"
 	"github.com/thrasher-corp/gocryptotrader/database/models/sqlite3"
 	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/candle"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/funding"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/openinterest"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/pricealert"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/liquidation"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc"
 	"github.com/thrasher-corp/gocryptotrader/gctrpc/auth"
 	gctscript "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
 	"github.com/thrasher-corp/gocryptotrader/portfolio"
 	"github.com/thrasher-corp/gocryptotrader/utils"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
 )
 
 const (
@@ -56,6 +70,12 @@ func authenticateClient(ctx context.Context) (context.Context, error) {
 		return ctx, fmt.Errorf("unable to extract metadata")
 	}
 
+	iface, address := rpcClientAddress(ctx, md)
+	if err := Bot.rpcSessions.allowed(address); err != nil {
+		log.Warnf(log.GRPCSys, "%s request refused: %v\n", iface, err)
+		return ctx, err
+	}
+
 	authStr, ok := md["authorization"]
 	if !ok {
 		return ctx, fmt.Errorf("authorization header missing")
@@ -77,7 +97,43 @@ func authenticateClient(ctx context.Context) (context.Context, error) {
 		return ctx, fmt.Errorf("username/password mismatch")
 	}
 
-	return ctx, nil
+	return ctx, Bot.rpcSessions.touch(iface, address)
+}
+
+// rpcClientAddress returns the interface and address of a gRPC request. The
+// gRPC proxy connects over loopback, so the client address it forwards is used
+// for its requests. Only requests carrying the proxy's token are trusted to
+// be from the proxy, as any loopback client could send an X-Forwarded-For
+// header. The proxy appends the address to any X-Forwarded-For header sent by
+// the client, so only the last one is trusted
+func rpcClientAddress(ctx context.Context, md metadata.MD) (iface, address string) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return rpcInterfaceGRPC, ""
+	}
+	address = p.Addr.String()
+	if ip := net.ParseIP(rpcHost(address)); ip != nil && ip.IsLoopback() && isRPCProxy(md) {
+		if forwarded := md.Get("x-forwarded-for"); len(forwarded) > 0 {
+			hops := strings.Split(forwarded[len(forwarded)-1], ",")
+			if hop := strings.TrimSpace(hops[len(hops)-1]); hop != "" {
+				return rpcInterfaceGRPCProxy, hop
+			}
+		}
+	}
+	return rpcInterfaceGRPC, address
+}
+
+// isRPCProxy returns whether a request carries the gRPC proxy's token
+func isRPCProxy(md metadata.MD) bool {
+	if Bot.rpcProxyToken == "" {
+		return false
+	}
+	for _, token := range md.Get(rpcProxyTokenKey) {
+		if subtle.ConstantTimeCompare([]byte(token), []byte(Bot.rpcProxyToken)) == 1 {
+			return true
+		}
+	}
+	return false
 }
 
 // StartRPCServer starts a gRPC server with TLS auth
@@ -102,6 +158,13 @@ func StartRPCServer() {
 		return
 	}
 
+	token, err := crypto.GetRandomSalt(nil, rpcProxyTokenLen)
+	if err != nil {
+		log.Errorf(log.GRPCSys, "gRPC server unable to generate proxy token: %s\n", err)
+		return
+	}
+	Bot.rpcProxyToken = crypto.HexEncodeToString(token)
+
 	opts := []grpc.ServerOption{
 		grpc.Creds(creds),
 		grpc.UnaryInterceptor(grpcauth.UnaryServerInterceptor(authenticateClient)),
@@ -135,7 +198,11 @@ func StartRPCRESTProxy() {
 		return
 	}
 
-	mux := grpcruntime.NewServeMux()
+	token := Bot.rpcProxyToken
+	mux := grpcruntime.NewServeMux(grpcruntime.WithMetadata(
+		func(context.Context, *http.Request) metadata.MD {
+			return metadata.Pairs(rpcProxyTokenKey, token)
+		}))
 	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds),
 		grpc.WithPerRPCCredentials(auth.BasicAuth{
 			Username: Bot.Config.RemoteControl.Username,
@@ -212,6 +279,43 @@ func (s *RPCServer) GetRPCEndpoints(ctx context.Context, r *gctrpc.GetRPCEndpoin
 	return &resp, nil
 }
 
+// GetRPCSessions returns the sessions of the management interfaces along with
+// the addresses allowed to use them and the session limits
+func (s *RPCServer) GetRPCSessions(_ context.Context, _ *gctrpc.GetRPCSessionsRequest) (*gctrpc.GetRPCSessionsResponse, error) {
+	sessions := Bot.rpcSessions.Sessions()
+	resp := &gctrpc.GetRPCSessionsResponse{
+		AllowedIps:     Bot.Config.RemoteControl.AllowedIPs,
+		MaxSessions:    int64(Bot.Config.RemoteControl.MaxSessions),
+		SessionTimeout: Bot.Config.RemoteControl.SessionTimeout.String(),
+	}
+	for i := range sessions {
+		resp.Sessions = append(resp.Sessions, rpcSessionDetails(&sessions[i]))
+	}
+	return resp, nil
+}
+
+// RevokeRPCSession ends a session of the management interfaces, refusing the
+// requests of a gRPC or deprecated RPC session's address for the session
+// timeout
+func (s *RPCServer) RevokeRPCSession(_ context.Context, r *gctrpc.RevokeRPCSessionRequest) (*gctrpc.RPCSession, error) {
+	session, err := Bot.rpcSessions.Revoke(r.Id)
+	if err != nil {
+		return nil, err
+	}
+	return rpcSessionDetails(&session), nil
+}
+
+func rpcSessionDetails(s *RPCSession) *gctrpc.RPCSession {
+	return &gctrpc.RPCSession{
+		Id:        s.ID,
+		Interface: s.Interface,
+		Address:   s.Address,
+		Started:   s.Started.UTC().Format(time.RFC3339),
+		LastSeen:  s.LastSeen.UTC().Format(time.RFC3339),
+		Requests:  s.Requests,
+	}
+}
+
 // GetCommunicationRelayers returns the status of the engines communication relayers
 func (s *RPCServer) GetCommunicationRelayers(ctx context.Context, r *gctrpc.GetCommunicationRelayersRequest) (*gctrpc.GetCommunicationRelayersResponse, error) {
 	relayers, err := Bot.CommsManager.GetStatus()
@@ -449,7 +553,7 @@ func (s *RPCServer) GetAccountInfo(ctx context.Context, r *gctrpc.GetAccountInfo
 		return nil, errors.New("exchange is not loaded/doesn't exist")
 	}
 
-	resp, err := exch.FetchAccountInfo()
+	resp, err := exch.FetchAccountInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -461,8 +565,8 @@ func (s *RPCServer) GetAccountInfo(ctx context.Context, r *gctrpc.GetAccountInfo
 		for _, y := range resp.Accounts[x].Currencies {
 			a.Currencies = append(a.Currencies, &gctrpc.AccountCurrencyInfo{
 				Currency:   y.CurrencyName.String(),
-				Hold:       y.Hold,
-				TotalValue: y.TotalValue,
+				Hold:       y.Hold.Float64(),
+				TotalValue: y.TotalValue.Float64(),
 			})
 		}
 		accounts = append(accounts, &a)
@@ -482,7 +586,7 @@ func (s *RPCServer) GetAccountInfoStream(r *gctrpc.GetAccountInfoRequest, stream
 		return errors.New("exchange is not loaded/doesn't exist")
 	}
 
-	initAcc, err := exch.FetchAccountInfo()
+	initAcc, err := exch.FetchAccountInfo(stream.Context())
 	if err != nil {
 		return err
 	}
@@ -493,8 +597,8 @@ func (s *RPCServer) GetAccountInfoStream(r *gctrpc.GetAccountInfoRequest, stream
 		for y := range initAcc.Accounts[x].Currencies {
 			subAccounts = append(subAccounts, &gctrpc.AccountCurrencyInfo{
 				Currency:   initAcc.Accounts[x].Currencies[y].CurrencyName.String(),
-				TotalValue: initAcc.Accounts[x].Currencies[y].TotalValue,
-				Hold:       initAcc.Accounts[x].Currencies[y].Hold,
+				TotalValue: initAcc.Accounts[x].Currencies[y].TotalValue.Float64(),
+				Hold:       initAcc.Accounts[x].Currencies[y].Hold.Float64(),
 			})
 		}
 		accounts = append(accounts, &gctrpc.Account{
@@ -532,8 +636,8 @@ func (s *RPCServer) GetAccountInfoStream(r *gctrpc.GetAccountInfoRequest, stream
 			for y := range acc.Accounts[x].Currencies {
 				subAccounts = append(subAccounts, &gctrpc.AccountCurrencyInfo{
 					Currency:   acc.Accounts[x].Currencies[y].CurrencyName.String(),
-					TotalValue: acc.Accounts[x].Currencies[y].TotalValue,
-					Hold:       acc.Accounts[x].Currencies[y].Hold,
+					TotalValue: acc.Accounts[x].Currencies[y].TotalValue.Float64(),
+					Hold:       acc.Accounts[x].Currencies[y].Hold.Float64(),
 				})
 			}
 			accounts = append(accounts, &gctrpc.Account{
@@ -567,7 +671,7 @@ func (s *RPCServer) GetPortfolio(ctx context.Context, r *gctrpc.GetPortfolioRequ
 			Address:     botAddrs[x].Address,
 			CoinType:    botAddrs[x].CoinType.String(),
 			Description: botAddrs[x].Description,
-			Balance:     botAddrs[x].Balance,
+			Balance:     botAddrs[x].Balance.Float64(),
 		})
 	}
 
@@ -578,8 +682,13 @@ func (s *RPCServer) GetPortfolio(ctx context.Context, r *gctrpc.GetPortfolioRequ
 	return resp, nil
 }
 
-// GetPortfolioSummary returns the portfolio summary
+// GetPortfolioSummary returns the portfolio summary with each coin valued in
+// the requested fiat currencies, or else the fiat display currencies
 func (s *RPCServer) GetPortfolioSummary(ctx context.Context, r *gctrpc.GetPortfolioSummaryRequest) (*gctrpc.GetPortfolioSummaryResponse, error) {
+	v, err := newFiatValuer(r.FiatCurrencies)
+	if err != nil {
+		return nil, err
+	}
 	result := Bot.Portfolio.GetPortfolioSummary()
 	var resp gctrpc.GetPortfolioSummaryResponse
 
@@ -589,16 +698,22 @@ func (s *RPCServer) GetPortfolioSummary(ctx context.Context, r *gctrpc.GetPortfo
 			c = append(c,
 				&gctrpc.Coin{
 					Coin:       coins[x].Coin.String(),
-					Balance:    coins[x].Balance,
+					Balance:    coins[x].Balance.Float64(),
 					Address:    coins[x].Address,
 					Percentage: coins[x].Percentage,
+					FiatValues: v.value(coins[x].Coin, coins[x].Balance.Float64()),
 				},
 			)
 		}
 		return c
 	}
 
+	resp.FiatCurrencies = v.fiats.Strings()
 	resp.CoinTotals = p(result.Totals)
+	resp.FiatTotals = make(map[string]float64)
+	for x := range resp.CoinTotals {
+		addFiatValues(resp.FiatTotals, resp.CoinTotals[x].FiatValues)
+	}
 	resp.CoinsOffline = p(result.Offline)
 	resp.CoinsOfflineSummary = make(map[string]*gctrpc.OfflineCoins)
 	for k, v := range result.OfflineSummary {
@@ -607,7 +722,7 @@ func (s *RPCServer) GetPortfolioSummary(ctx context.Context, r *gctrpc.GetPortfo
 			o = append(o,
 				&gctrpc.OfflineCoinSummary{
 					Address:    v[x].Address,
-					Balance:    v[x].Balance,
+					Balance:    v[x].Balance.Float64(),
 					Percentage: v[x].Percentage,
 				},
 			)
@@ -622,7 +737,7 @@ func (s *RPCServer) GetPortfolioSummary(ctx context.Context, r *gctrpc.GetPortfo
 		o := make(map[string]*gctrpc.OnlineCoinSummary)
 		for x, y := range v {
 			o[x.String()] = &gctrpc.OnlineCoinSummary{
-				Balance:    y.Balance,
+				Balance:    y.Balance.Float64(),
 				Percentage: y.Percentage,
 			}
 		}
@@ -630,13 +745,14 @@ func (s *RPCServer) GetPortfolioSummary(ctx context.Context, r *gctrpc.GetPortfo
 			Coins: o,
 		}
 	}
+	resp.Unpriced = v.unpriced
 
 	return &resp, nil
 }
 
 // AddPortfolioAddress adds an address to the portfolio manager
 func (s *RPCServer) AddPortfolioAddress(ctx context.Context, r *gctrpc.AddPortfolioAddressRequest) (*gctrpc.AddPortfolioAddressResponse, error) {
-	err := Bot.Portfolio.AddAddress(r.Address, r.Description, currency.NewCode(r.CoinType), r.Balance)
+	err := Bot.Portfolio.AddAddress(r.Address, r.Description, currency.NewCode(r.CoinType), decimal.NewFromFloat(r.Balance))
 	return &gctrpc.AddPortfolioAddressResponse{}, err
 }
 
@@ -710,7 +826,7 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 		return nil, errors.New("exchange is not loaded/doesn't exist")
 	}
 
-	resp, err := exch.GetActiveOrders(&order.GetOrdersRequest{
+	resp, err := exch.GetActiveOrders(ctx, &order.GetOrdersRequest{
 		Currencies: []currency.Pair{
 			currency.NewPairWithDelimiter(r.Pair.Base,
 				r.Pair.Quote, r.Pair.Delimiter),
@@ -732,8 +848,8 @@ func (s *RPCServer) GetOrders(ctx context.Context, r *gctrpc.GetOrdersRequest) (
 			OrderSide:     resp[x].OrderSide.String(),
 			CreationTime:  resp[x].OrderDate.Unix(),
 			Status:        resp[x].Status.String(),
-			Price:         resp[x].Price,
-			Amount:        resp[x].Amount,
+			Price:         resp[x].Price.Float64(),
+			Amount:        resp[x].Amount.Float64(),
 		})
 	}
 
@@ -753,20 +869,48 @@ func (s *RPCServer) SubmitOrder(ctx context.Context, r *gctrpc.SubmitOrderReques
 		return nil, errors.New("exchange is not loaded/doesn't exist")
 	}
 
+	var timeout time.Duration
+	if r.PartialFillTimeout != "" {
+		var err error
+		timeout, err = time.ParseDuration(r.PartialFillTimeout)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var expiresAt time.Time
+	if r.ExpiresAt != "" {
+		var err error
+		expiresAt, err = time.Parse(audit.TableTimeFormat, r.ExpiresAt)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	p := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
 	submission := &order.Submit{
-		Pair:      p,
-		OrderSide: order.Side(r.Side),
-		OrderType: order.Type(r.OrderType),
-		Amount:    r.Amount,
-		Price:     r.Price,
-		ClientID:  r.ClientId,
-	}
-	result, err := exch.SubmitOrder(submission)
+		Pair:               p,
+		OrderSide:          order.Side(r.Side),
+		OrderType:          order.Type(r.OrderType),
+		Amount:             decimal.NewFromFloat(r.Amount),
+		Price:              decimal.NewFromFloat(r.Price),
+		ClientID:           r.ClientId,
+		StrategyID:         r.StrategyId,
+		PartialFillPolicy:  order.PartialFillPolicy(strings.ToLower(r.PartialFillPolicy)),
+		PartialFillTimeout: timeout,
+		TimeInForce:        order.TimeInForce(strings.ToUpper(r.TimeInForce)),
+		ExpiresAt:          expiresAt,
+	}
+	result, err := Bot.OrderManager.Submit(exch.GetName(), submission)
+	if err != nil {
+		return nil, fmt.Errorf("%v correlation_id=%s", err, submission.CorrelationID)
+	}
 	return &gctrpc.SubmitOrderResponse{
-		OrderId:     result.OrderID,
-		OrderPlaced: result.IsOrderPlaced,
-	}, err
+		OrderId:       result.OrderID,
+		OrderPlaced:   result.IsOrderPlaced,
+		CorrelationId: result.CorrelationID,
+		ClientId:      result.ClientID,
+	}, nil
 }
 
 // SimulateOrder simulates an order specified by exchange, currency pair and asset
@@ -778,7 +922,7 @@ func (s *RPCServer) SimulateOrder(ctx context.Context, r *gctrpc.SimulateOrderRe
 	}
 
 	p := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
-	o, err := exch.FetchOrderbook(p, asset.Spot)
+	o, err := exch.FetchOrderbook(ctx, p, asset.Spot)
 	if err != nil {
 		return nil, err
 	}
@@ -815,7 +959,7 @@ func (s *RPCServer) WhaleBomb(ctx context.Context, r *gctrpc.WhaleBombRequest) (
 	}
 
 	p := currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
-	o, err := exch.FetchOrderbook(p, asset.Spot)
+	o, err := exch.FetchOrderbook(ctx, p, asset.Spot)
 	if err != nil {
 		return nil, err
 	}
@@ -851,7 +995,7 @@ func (s *RPCServer) CancelOrder(ctx context.Context, r *gctrpc.CancelOrderReques
 		return nil, errors.New("exchange is not loaded/doesn't exist")
 	}
 
-	err := exch.CancelOrder(&order.Cancel{
+	err := exch.CancelOrder(ctx, &order.Cancel{
 		AccountID:     r.AccountId,
 		OrderID:       r.OrderId,
 		Side:          order.Side(r.Side),
@@ -963,6 +1107,36 @@ func (s *RPCServer) SetLoggerDetails(ctx context.Context, r *gctrpc.SetLoggerDet
 	}, nil
 }
 
+// SetLogLevel sets the level of a sub logger or an independent level for log
+// lines tagged with an exchange, an empty exchange level removes its override
+func (s *RPCServer) SetLogLevel(ctx context.Context, r *gctrpc.SetLogLevelRequest) (*gctrpc.GetLoggerDetailsResponse, error) {
+	var levels *log.Levels
+	var err error
+	switch {
+	case r.Subsystem != "" && r.Exchange != "":
+		return nil, errors.New("only one of subsystem or exchange can be set")
+	case r.Subsystem != "":
+		levels, err = log.SetLevel(strings.ToUpper(r.Subsystem), strings.ToUpper(r.Level))
+	case r.Exchange != "":
+		if GetExchangeByName(r.Exchange) == nil {
+			return nil, errors.New("exchange is not loaded/doesn't exist")
+		}
+		levels, err = log.SetExchangeLevel(r.Exchange, r.Level)
+	default:
+		return nil, errors.New("a subsystem or exchange must be specified")
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	return &gctrpc.GetLoggerDetailsResponse{
+		Info:  levels.Info,
+		Debug: levels.Debug,
+		Warn:  levels.Warn,
+		Error: levels.Error,
+	}, nil
+}
+
 // GetExchangePairs returns a list of exchange supported assets and related pairs
 func (s *RPCServer) GetExchangePairs(ctx context.Context, r *gctrpc.GetExchangePairsRequest) (*gctrpc.GetExchangePairsResponse, error) {
 	exchCfg, err := Bot.Config.GetExchangeConfig(r.Exchange)
@@ -1318,7 +1492,7 @@ func (s *RPCServer) GetHistoricCandles(ctx context.Context, req *gctrpc.GetHisto
 		return nil, errors.New("Exchange " + req.Exchange + " not found")
 	}
 
-	candles, err := exchange.GetHistoricCandles(currency.Pair{
+	candles, err := exchange.GetHistoricCandles(ctx, currency.Pair{
 		Delimiter: req.Pair.Delimiter,
 		Base:      currency.NewCode(req.Pair.Base),
 		Quote:     currency.NewCode(req.Pair.Quote),
@@ -1341,6 +1515,147 @@ func (s *RPCServer) GetHistoricCandles(ctx context.Context, req *gctrpc.GetHisto
 	return &resp, nil
 }
 
+// GetExchangeHealth returns REST latency percentiles and error and ban counts
+// for an exchange, or for all exchanges if none is specified
+func (s *RPCServer) GetExchangeHealth(ctx context.Context, r *gctrpc.GetExchangeHealthRequest) (*gctrpc.GetExchangeHealthResponse, error) {
+	var summaries []metrics.ExchangeHealth
+	if r.Exchange != "" {
+		if GetExchangeByName(r.Exchange) == nil {
+			return nil, errors.New("exchange is not loaded/doesn't exist")
+		}
+		if summary, ok := metrics.Health.Summary(r.Exchange); ok {
+			summaries = append(summaries, summary)
+		}
+	} else {
+		summaries = metrics.Health.Summaries()
+	}
+
+	resp := &gctrpc.GetExchangeHealthResponse{}
+	for i := range summaries {
+		health := &gctrpc.ExchangeHealth{
+			Exchange:  summaries[i].Exchange,
+			Requests:  summaries[i].Requests,
+			Errors:    summaries[i].Errors,
+			Bans:      summaries[i].Bans,
+			LastError: summaries[i].LastError,
+		}
+		if !summaries[i].LastErrorTime.IsZero() {
+			health.LastErrorTime = summaries[i].LastErrorTime.Unix()
+		}
+		for j := range summaries[i].Endpoints {
+			ep := &summaries[i].Endpoints[j]
+			health.Endpoints = append(health.Endpoints, &gctrpc.EndpointHealth{
+				Endpoint: ep.Endpoint,
+				Requests: ep.Requests,
+				Errors:   ep.Errors,
+				P50Ms:    durationToMilliseconds(ep.P50),
+				P90Ms:    durationToMilliseconds(ep.P90),
+				P99Ms:    durationToMilliseconds(ep.P99),
+			})
+		}
+		resp.Exchanges = append(resp.Exchanges, health)
+	}
+	return resp, nil
+}
+
+func durationToMilliseconds(d time.Duration) float64 {
+	return float64(d) / float64(time.Millisecond)
+}
+
+// GetWebsocketSubscriptions returns the channels an exchanges websocket is
+// subscribed to
+func (s *RPCServer) GetWebsocketSubscriptions(ctx context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.GetWebsocketSubscriptionsResponse, error) {
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+	return websocketSubscriptions(exch)
+}
+
+// AddWebsocketSubscriptions subscribes an exchanges websocket to channels
+// without reconnecting it
+func (s *RPCServer) AddWebsocketSubscriptions(ctx context.Context, r *gctrpc.WebsocketSubscriptionsRequest) (*gctrpc.GetWebsocketSubscriptionsResponse, error) {
+	exch, channels, err := websocketSubscriptionRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.SubscribeToWebsocketChannels(channels)
+	if err != nil {
+		return nil, err
+	}
+	return websocketSubscriptions(exch)
+}
+
+// RemoveWebsocketSubscriptions unsubscribes an exchanges websocket from
+// channels without reconnecting it
+func (s *RPCServer) RemoveWebsocketSubscriptions(ctx context.Context, r *gctrpc.WebsocketSubscriptionsRequest) (*gctrpc.GetWebsocketSubscriptionsResponse, error) {
+	exch, channels, err := websocketSubscriptionRequest(r)
+	if err != nil {
+		return nil, err
+	}
+	err = exch.UnsubscribeToWebsocketChannels(channels)
+	if err != nil {
+		return nil, err
+	}
+	return websocketSubscriptions(exch)
+}
+
+// websocketSubscriptionRequest returns the exchange and channels of a
+// websocket subscription request, formatting pairs as the exchange does
+func websocketSubscriptionRequest(r *gctrpc.WebsocketSubscriptionsRequest) (exchange.IBotExchange, []wshandler.WebsocketChannelSubscription, error) {
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+	if !exch.IsWebsocketEnabled() {
+		return nil, nil, fmt.Errorf("%s websocket is not enabled", r.Exchange)
+	}
+	if len(r.Subscriptions) == 0 {
+		return nil, nil, errors.New("no subscriptions specified")
+	}
+	a := asset.Spot
+	if r.AssetType != "" {
+		a = asset.Item(r.AssetType)
+	}
+	pairFmt, err := Bot.Config.GetPairFormat(r.Exchange, a)
+	if err != nil {
+		return nil, nil, err
+	}
+	channels := make([]wshandler.WebsocketChannelSubscription, len(r.Subscriptions))
+	for i := range r.Subscriptions {
+		if r.Subscriptions[i].Channel == "" {
+			return nil, nil, errors.New("subscription channel unset")
+		}
+		channels[i].Channel = r.Subscriptions[i].Channel
+		if p := r.Subscriptions[i].Pair; p != nil {
+			channels[i].Currency = currency.NewPairFromStrings(p.Base, p.Quote).Format(
+				pairFmt.Delimiter, pairFmt.Uppercase)
+		}
+	}
+	return exch, channels, nil
+}
+
+// websocketSubscriptions returns an exchanges websocket subscriptions
+func websocketSubscriptions(exch exchange.IBotExchange) (*gctrpc.GetWebsocketSubscriptionsResponse, error) {
+	subs, err := exch.GetSubscriptions()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetWebsocketSubscriptionsResponse{Exchange: exch.GetName()}
+	for i := range subs {
+		sub := &gctrpc.WebsocketSubscription{Channel: subs[i].Channel}
+		if !subs[i].Currency.IsEmpty() {
+			sub.Pair = &gctrpc.CurrencyPair{
+				Delimiter: subs[i].Currency.Delimiter,
+				Base:      subs[i].Currency.Base.String(),
+				Quote:     subs[i].Currency.Quote.String(),
+			}
+		}
+		resp.Subscriptions = append(resp.Subscriptions, sub)
+	}
+	return resp, nil
+}
+
 // GCTScriptStatus returns a slice of current running scripts that includes next run time and uuid
 func (s *RPCServer) GCTScriptStatus(ctx context.Context, r *gctrpc.GCTScriptStatusRequest) (*gctrpc.GCTScriptStatusResponse, error) {
 	if !gctscript.GCTScriptConfig.Enabled {
@@ -1633,3 +1948,823 @@ func (s *RPCServer) GCTScriptAutoLoadToggle(ctx context.Context, r *gctrpc.GCTSc
 	}
 	return &gctrpc.GCTScriptGenericResponse{Status: "success", Data: "script " + r.Script + " added to autoload list"}, nil
 }
+
+// GetKlineStream streams websocket candles for an exchange, narrowed to a
+// single currency pair, asset type and interval when a pair is supplied
+func (s *RPCServer) GetKlineStream(r *gctrpc.GetKlineStreamRequest, stream gctrpc.GoCryptoTrader_GetKlineStreamServer) error {
+	if r.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	var pipe dispatch.Pipe
+	var err error
+	if r.Pair == nil || r.Pair.Base == "" {
+		pipe, err = kline.SubscribeToExchangeKlines(r.Exchange)
+	} else {
+		if r.AssetType == "" {
+			return errors.New(errAssetTypeUnset)
+		}
+		var interval kline.Interval
+		interval, err = kline.ParseInterval(r.Interval)
+		if err != nil {
+			return err
+		}
+		pipe, err = kline.SubscribeKline(r.Exchange,
+			currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote),
+			asset.Item(r.AssetType),
+			interval)
+	}
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		c := (*data.(*interface{})).(kline.Candle)
+
+		err := stream.Send(&gctrpc.KlineResponse{
+			Exchange: c.Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      c.Pair.Base.String(),
+				Quote:     c.Pair.Quote.String(),
+				Delimiter: c.Pair.Delimiter},
+			AssetType:   c.AssetType.String(),
+			Interval:    c.Interval.String(),
+			StartTime:   c.StartTime.Unix(),
+			CloseTime:   c.CloseTime.Unix(),
+			Open:        c.Open,
+			High:        c.High,
+			Low:         c.Low,
+			Close:       c.Close,
+			Volume:      c.Volume,
+			LastUpdated: c.LastUpdated.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetFundingPayments returns funding paid or received on a perpetual futures
+// position between two dates, storing them in the database when connected
+func (s *RPCServer) GetFundingPayments(ctx context.Context, r *gctrpc.GetFundingPaymentsRequest) (*gctrpc.GetFundingPaymentsResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+	if r.Pair == nil || r.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+
+	start, err := time.Parse(funding.TableTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(funding.TableTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if !start.Before(end) {
+		return nil, errors.New("start date must be before end date")
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+	a := asset.Item(r.AssetType)
+	if !exch.SupportsAsset(a) {
+		return nil, fmt.Errorf("%s does not support asset type %s", r.Exchange, a)
+	}
+
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	payments, err := exch.GetFundingPayments(ctx, p, a, start, end)
+	if err != nil {
+		return nil, err
+	}
+	stored, err := storeFundingPayments(payments)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetFundingPaymentsResponse{
+		Exchange:  r.Exchange,
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Total:     fundingTotal(payments),
+		Stored:    int64(stored),
+	}
+	for i := range payments {
+		resp.Payments = append(resp.Payments, &gctrpc.FundingPayment{
+			Rate:      payments[i].Rate,
+			Amount:    payments[i].Amount,
+			Currency:  payments[i].Currency.String(),
+			Timestamp: payments[i].Timestamp.UTC().Format(funding.TableTimeFormat),
+		})
+	}
+	return resp, nil
+}
+
+// GetTechnicalAnalysis downloads candles for a currency pair, updates them with
+// the latest streamed websocket candle and returns an indicator series with a
+// simple signal derived from it
+func (s *RPCServer) GetTechnicalAnalysis(ctx context.Context, r *gctrpc.GetTechnicalAnalysisRequest) (*gctrpc.GetTechnicalAnalysisResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+	if r.Pair == nil || r.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	if r.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+	interval, err := kline.ParseInterval(r.Interval)
+	if err != nil {
+		return nil, err
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+	a := asset.Item(r.AssetType)
+	if !exch.SupportsAsset(a) {
+		return nil, fmt.Errorf("%s does not support asset type %s", r.Exchange, a)
+	}
+
+	period := r.Period
+	if period <= 0 {
+		period = indicators.DefaultPeriod
+	}
+	count := r.Count
+	if count <= 0 {
+		count = defaultTechnicalAnalysisCount
+	}
+
+	p := currency.Pair{
+		Delimiter: r.Pair.Delimiter,
+		Base:      currency.NewCode(r.Pair.Base),
+		Quote:     currency.NewCode(r.Pair.Quote),
+	}
+	candles, err := exch.GetHistoricCandles(ctx, p, count,
+		int64(interval.Duration().Seconds()))
+	if err != nil {
+		return nil, err
+	}
+	sortCandles(candles)
+	// A stream is only stored when the exchange websocket is subscribed to
+	// klines, downloaded candles are used on their own otherwise
+	if streamed, streamErr := kline.GetKline(r.Exchange, p, a, interval); streamErr == nil {
+		candles = mergeStreamedCandle(candles, streamed)
+	}
+
+	series, signal, err := calculateIndicator(candles, r.Indicator, int(period))
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetTechnicalAnalysisResponse{
+		Exchange:  r.Exchange,
+		Pair:      r.Pair,
+		AssetType: a.String(),
+		Interval:  interval.String(),
+		Indicator: strings.ToLower(r.Indicator),
+		Period:    period,
+		Signal:    string(signal),
+	}
+	first := firstCompleteIndex(series)
+	for i := first; i < len(candles); i++ {
+		resp.Timestamps = append(resp.Timestamps, candles[i].Time)
+		resp.Close = append(resp.Close, candles[i].Close)
+	}
+	for i := range series {
+		resp.Series = append(resp.Series, &gctrpc.IndicatorSeries{
+			Name:   series[i].name,
+			Values: series[i].values[first:],
+		})
+	}
+	return resp, nil
+}
+
+// GetAccountStatement returns a statement of an exchange account's balance
+// movements between two dates, reconciled against the balances reported by
+// the exchange and valued in the requested fiat currencies, or else the fiat
+// display currencies
+func (s *RPCServer) GetAccountStatement(ctx context.Context, r *gctrpc.GetAccountStatementRequest) (*gctrpc.GetAccountStatementResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+
+	start, err := time.Parse(audit.TableTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(audit.TableTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+
+	opening := make(map[currency.Code]decimal.Decimal)
+	for i := range r.OpeningBalances {
+		opening[currency.NewCode(r.OpeningBalances[i].Currency)] =
+			decimal.NewFromFloat(r.OpeningBalances[i].Amount)
+	}
+
+	v, err := newFiatValuer(r.FiatCurrencies)
+	if err != nil {
+		return nil, err
+	}
+
+	st, err := buildAccountStatement(ctx, exch, start, end, opening,
+		decimal.NewFromFloat(r.Tolerance))
+	if err != nil {
+		return nil, err
+	}
+	resp := statementResponse(st)
+	valueStatement(resp, st, v)
+	return resp, nil
+}
+
+// ValidateCredentials validates an exchange's credentials and verifies the
+// permissions of its API key, disabling the features the key can't use
+func (s *RPCServer) ValidateCredentials(ctx context.Context, r *gctrpc.GenericExchangeNameRequest) (*gctrpc.ValidateCredentialsResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+	exchCfg, err := Bot.Config.GetExchangeConfig(r.Exchange)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.ValidateCredentialsResponse{Exchange: r.Exchange}
+	resp.Valid, err = verifyCredentials(ctx, exch, exchCfg)
+	if err != nil {
+		resp.Error = err.Error()
+	}
+	base := exch.GetBase()
+	if perms := base.API.KeyPermissions; perms != nil {
+		resp.PermissionsVerified = true
+		resp.Read = perms.Read
+		resp.Trade = perms.Trade
+		resp.Withdraw = perms.Withdraw
+		resp.IpWhitelist = perms.IPWhitelist
+		resp.Warnings = apiKeyPermissionWarnings(perms)
+	}
+	resp.AuthenticatedSupport = base.API.AuthenticatedSupport
+	return resp, nil
+}
+
+// GetExchangeLatency returns the round trip latency measured to an exchange,
+// or to every exchange ranked fastest first if none is specified
+func (s *RPCServer) GetExchangeLatency(_ context.Context, r *gctrpc.GetExchangeLatencyRequest) (*gctrpc.GetExchangeLatencyResponse, error) {
+	if !Bot.LatencyMonitor.Started() {
+		return nil, errors.New("latency monitor not started")
+	}
+	var ranked []ExchangeLatency
+	if r.Exchange != "" {
+		e, ok := Bot.LatencyMonitor.Latency(r.Exchange)
+		if !ok {
+			return nil, errors.New("no latency measured for exchange " + r.Exchange)
+		}
+		ranked = append(ranked, e)
+	} else {
+		ranked = Bot.LatencyMonitor.RankExchanges()
+	}
+
+	resp := &gctrpc.GetExchangeLatencyResponse{}
+	for i := range ranked {
+		resp.Exchanges = append(resp.Exchanges, &gctrpc.ExchangeLatencySummary{
+			Exchange:    ranked[i].Exchange,
+			LastMs:      durationToMilliseconds(ranked[i].Last),
+			MedianMs:    durationToMilliseconds(ranked[i].Median),
+			P90Ms:       durationToMilliseconds(ranked[i].P90),
+			Samples:     int64(ranked[i].Samples),
+			Errors:      int64(ranked[i].Errors),
+			LastUpdated: ranked[i].LastUpdated.UTC().Format(time.RFC3339),
+			LastError:   ranked[i].LastError,
+		})
+	}
+	return resp, nil
+}
+
+// SubmitTransfer withdraws funds from one exchange or hot wallet to another
+// exchange, following the transfer until it is credited
+func (s *RPCServer) SubmitTransfer(ctx context.Context, r *gctrpc.SubmitTransferRequest) (*gctrpc.TransferDetails, error) {
+	if r.Currency == "" {
+		return nil, errors.New("currency must be set")
+	}
+	t, err := Bot.TransferManager.Submit(ctx, &TransferRequest{
+		From:       r.FromExchange,
+		To:         r.ToExchange,
+		Currency:   currency.NewCode(r.Currency),
+		Amount:     r.Amount,
+		Network:    r.Network,
+		Address:    r.Address,
+		AddressTag: r.AddressTag,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return transferDetails(&t), nil
+}
+
+// GetTransfers returns a transfer by its ID, or every transfer submitted
+// since startup if none is specified
+func (s *RPCServer) GetTransfers(_ context.Context, r *gctrpc.GetTransfersRequest) (*gctrpc.GetTransfersResponse, error) {
+	if !Bot.TransferManager.Started() {
+		return nil, errTransferManagerNotStarted
+	}
+	var transfers []Transfer
+	if r.Id != "" {
+		t, err := Bot.TransferManager.Transfer(r.Id)
+		if err != nil {
+			return nil, err
+		}
+		transfers = append(transfers, t)
+	} else {
+		transfers = Bot.TransferManager.Transfers()
+	}
+
+	resp := &gctrpc.GetTransfersResponse{}
+	for i := range transfers {
+		resp.Transfers = append(resp.Transfers, transferDetails(&transfers[i]))
+	}
+	return resp, nil
+}
+
+// ApproveTransfer approves a transfer pending approval so it is sent
+func (s *RPCServer) ApproveTransfer(ctx context.Context, r *gctrpc.ApproveTransferRequest) (*gctrpc.TransferDetails, error) {
+	t, err := Bot.TransferManager.Approve(ctx, r.Id,
+		"gRPC user "+Bot.Config.RemoteControl.Username)
+	if err != nil {
+		return nil, err
+	}
+	return transferDetails(&t), nil
+}
+
+func transferDetails(t *Transfer) *gctrpc.TransferDetails {
+	d := &gctrpc.TransferDetails{
+		Id:           t.ID,
+		FromExchange: t.From,
+		ToExchange:   t.To,
+		Currency:     t.Currency.String(),
+		Amount:       t.Amount,
+		Network:      t.Network,
+		Address:      t.Address,
+		AddressTag:   t.AddressTag,
+		Status:       string(t.Status),
+		WithdrawalId: t.WithdrawalID,
+		TxId:         t.TxID,
+		Fee:          t.Fee,
+		FeeEstimated: t.FeeEstimated,
+		Received:     t.Received,
+		Error:        t.Error,
+		Created:      t.Created.UTC().Format(time.RFC3339),
+		Updated:      t.Updated.UTC().Format(time.RFC3339),
+		ApprovedBy:   t.ApprovedBy,
+	}
+	if !t.ApprovalExpires.IsZero() {
+		d.ApprovalExpires = t.ApprovalExpires.UTC().Format(time.RFC3339)
+	}
+	return d
+}
+
+// AddPriceAlert stores a new price alert on a pair at an exchange, or on the
+// composite price across exchanges if no exchange is specified
+func (s *RPCServer) AddPriceAlert(_ context.Context, r *gctrpc.AddPriceAlertRequest) (*gctrpc.PriceAlert, error) {
+	if r.Pair == nil {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	if r.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+	a := pricealert.Alert{
+		Exchange: r.Exchange,
+		Asset:    strings.ToLower(r.AssetType),
+		Pair: currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote).
+			Format(priceAlertPairDelimiter, true).String(),
+		Condition:      r.Condition,
+		Threshold:      r.Threshold,
+		ReferencePrice: r.ReferencePrice,
+		Note:           r.Note,
+	}
+	if err := Bot.PriceAlertManager.Add(&a); err != nil {
+		return nil, err
+	}
+	return priceAlertResponse(&a), nil
+}
+
+// GetPriceAlerts returns the stored price alerts
+func (s *RPCServer) GetPriceAlerts(_ context.Context, r *gctrpc.GetPriceAlertsRequest) (*gctrpc.GetPriceAlertsResponse, error) {
+	alerts, err := Bot.PriceAlertManager.Alerts(r.ActiveOnly)
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetPriceAlertsResponse{}
+	for i := range alerts {
+		resp.Alerts = append(resp.Alerts, priceAlertResponse(&alerts[i]))
+	}
+	return resp, nil
+}
+
+// RemovePriceAlert deletes a price alert
+func (s *RPCServer) RemovePriceAlert(_ context.Context, r *gctrpc.RemovePriceAlertRequest) (*gctrpc.RemovePriceAlertResponse, error) {
+	if err := Bot.PriceAlertManager.Remove(r.Id); err != nil {
+		return nil, err
+	}
+	return &gctrpc.RemovePriceAlertResponse{}, nil
+}
+
+func priceAlertResponse(a *pricealert.Alert) *gctrpc.PriceAlert {
+	p := currency.NewPairDelimiter(a.Pair, priceAlertPairDelimiter)
+	return &gctrpc.PriceAlert{
+		Id:       a.ID,
+		Exchange: a.Exchange,
+		Pair: &gctrpc.CurrencyPair{
+			Delimiter: p.Delimiter,
+			Base:      p.Base.String(),
+			Quote:     p.Quote.String(),
+		},
+		AssetType:      a.Asset,
+		Condition:      a.Condition,
+		Threshold:      a.Threshold,
+		ReferencePrice: a.ReferencePrice,
+		Note:           a.Note,
+		Active:         a.Active,
+		TriggeredPrice: a.TriggeredPrice,
+		Created:        a.CreatedAt.UTC().Format(time.RFC3339),
+	}
+}
+
+// GetTrailingStop returns the latest valuation of the portfolio against its
+// high-water mark
+func (s *RPCServer) GetTrailingStop(_ context.Context, _ *gctrpc.GetTrailingStopRequest) (*gctrpc.TrailingStopStatus, error) {
+	status, err := Bot.TrailingStop.Status()
+	if err != nil {
+		return nil, err
+	}
+	return trailingStopResponse(&status), nil
+}
+
+// ResetTrailingStop rearms a triggered portfolio trailing stop from the
+// current value and resumes order submissions it halted
+func (s *RPCServer) ResetTrailingStop(_ context.Context, _ *gctrpc.ResetTrailingStopRequest) (*gctrpc.TrailingStopStatus, error) {
+	status, err := Bot.TrailingStop.Reset()
+	if err != nil {
+		return nil, err
+	}
+	return trailingStopResponse(&status), nil
+}
+
+func trailingStopResponse(t *TrailingStopStatus) *gctrpc.TrailingStopStatus {
+	resp := &gctrpc.TrailingStopStatus{
+		Currency:        t.Currency,
+		Value:           t.Value,
+		HighWaterMark:   t.HighWaterMark,
+		Drawdown:        t.Drawdown,
+		DrawdownPercent: t.DrawdownPercent,
+		Exchanges:       t.Exchanges,
+		Unpriced:        t.Unpriced,
+		Triggered:       t.Triggered,
+		OrdersHalted:    Bot.OrderManager.Halted(),
+	}
+	if !t.TriggeredAt.IsZero() {
+		resp.TriggeredAt = t.TriggeredAt.UTC().Format(time.RFC3339)
+	}
+	if !t.Updated.IsZero() {
+		resp.Updated = t.Updated.UTC().Format(time.RFC3339)
+	}
+	return resp
+}
+
+// GetMarginPositions returns the open positions maintained by the margin
+// manager along with their margin ratios
+func (s *RPCServer) GetMarginPositions(_ context.Context, _ *gctrpc.GetMarginPositionsRequest) (*gctrpc.GetMarginPositionsResponse, error) {
+	positions, err := Bot.MarginManager.Positions()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetMarginPositionsResponse{}
+	for i := range positions {
+		resp.Positions = append(resp.Positions, &gctrpc.MarginPosition{
+			Exchange:  positions[i].Exchange,
+			AssetType: positions[i].AssetType.String(),
+			Pair: &gctrpc.CurrencyPair{
+				Delimiter: positions[i].Pair.Delimiter,
+				Base:      positions[i].Pair.Base.String(),
+				Quote:     positions[i].Pair.Quote.String(),
+			},
+			Size:              positions[i].Size,
+			Currency:          positions[i].Currency.String(),
+			Margin:            positions[i].Margin,
+			MaintenanceMargin: positions[i].MaintenanceMargin,
+			MarginRatio:       positions[i].MarginRatio(),
+			MarkPrice:         positions[i].MarkPrice,
+			LiquidationPrice:  positions[i].LiquidationPrice,
+			Isolated:          positions[i].Isolated,
+		})
+	}
+	return resp, nil
+}
+
+// GetArbitrageOpportunities returns the cross exchange and triangular
+// arbitrage opportunities in the latest orderbooks, net of fees and transfers
+func (s *RPCServer) GetArbitrageOpportunities(ctx context.Context, r *gctrpc.GetArbitrageOpportunitiesRequest) (*gctrpc.GetArbitrageOpportunitiesResponse, error) {
+	oppType := strings.ToUpper(r.Type)
+	switch oppType {
+	case "", ArbitrageCrossExchange, ArbitrageTriangular:
+	default:
+		return nil, fmt.Errorf("invalid arbitrage type %s, must be %s or %s",
+			r.Type, ArbitrageCrossExchange, ArbitrageTriangular)
+	}
+	for i := range r.Exchanges {
+		if GetExchangeByName(r.Exchanges[i]) == nil && !Bot.DeFiManager.IsVenue(r.Exchanges[i]) {
+			return nil, errors.New("Exchange " + r.Exchanges[i] + " not found")
+		}
+	}
+
+	opportunities := GetArbitrageOpportunities(ctx, r.Exchanges, r.MinProfitPercent)
+	resp := &gctrpc.GetArbitrageOpportunitiesResponse{}
+	for i := range opportunities {
+		if oppType != "" && opportunities[i].Type != oppType {
+			continue
+		}
+		o := &gctrpc.ArbitrageOpportunity{
+			Type:               opportunities[i].Type,
+			Currency:           opportunities[i].Currency.String(),
+			Amount:             opportunities[i].Amount,
+			Profit:             opportunities[i].Profit,
+			GrossProfitPercent: opportunities[i].GrossProfitPercent,
+			NetProfitPercent:   opportunities[i].NetProfitPercent,
+			TransferFee:        opportunities[i].TransferFee,
+			Detected:           opportunities[i].Detected.UTC().Format(time.RFC3339),
+		}
+		for j := range opportunities[i].Legs {
+			leg := &opportunities[i].Legs[j]
+			o.Legs = append(o.Legs, &gctrpc.ArbitrageLeg{
+				Exchange: leg.Exchange,
+				Pair: &gctrpc.CurrencyPair{
+					Delimiter: leg.Pair.Delimiter,
+					Base:      leg.Pair.Base.String(),
+					Quote:     leg.Pair.Quote.String(),
+				},
+				Side:    leg.Side.String(),
+				Price:   leg.Price,
+				Amount:  leg.Amount,
+				FeeRate: leg.FeeRate,
+			})
+		}
+		resp.Opportunities = append(resp.Opportunities, o)
+	}
+	return resp, nil
+}
+
+// GetLiquidationStream streams websocket liquidations from every exchange, or
+// from a single exchange narrowed to a currency pair and asset type when a
+// pair is supplied
+func (s *RPCServer) GetLiquidationStream(r *gctrpc.GetLiquidationStreamRequest, stream gctrpc.GoCryptoTrader_GetLiquidationStreamServer) error {
+	var pipe dispatch.Pipe
+	var err error
+	switch {
+	case r.Exchange == "":
+		pipe, err = liquidation.SubscribeToAllLiquidations()
+	case r.Pair == nil || r.Pair.Base == "":
+		pipe, err = liquidation.SubscribeToExchangeLiquidations(r.Exchange)
+	default:
+		if r.AssetType == "" {
+			return errors.New(errAssetTypeUnset)
+		}
+		pipe, err = liquidation.SubscribeLiquidations(r.Exchange,
+			currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote),
+			asset.Item(r.AssetType))
+	}
+	if err != nil {
+		return err
+	}
+
+	defer pipe.Release()
+
+	for {
+		data, ok := <-pipe.C
+		if !ok {
+			return errors.New(errDispatchSystem)
+		}
+		l := (*data.(*interface{})).(liquidation.Liquidation)
+
+		err := stream.Send(&gctrpc.LiquidationResponse{
+			Exchange: l.Exchange,
+			Pair: &gctrpc.CurrencyPair{
+				Base:      l.Pair.Base.String(),
+				Quote:     l.Pair.Quote.String(),
+				Delimiter: l.Pair.Delimiter},
+			AssetType: l.AssetType.String(),
+			Side:      l.Side.String(),
+			Price:     l.Price,
+			Amount:    l.Amount,
+			Timestamp: l.Timestamp.Unix(),
+		})
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// GetOpenInterest returns the open interest and long/short ratio recorded by
+// the open interest recorder for a derivatives contract between two dates
+func (s *RPCServer) GetOpenInterest(ctx context.Context, r *gctrpc.GetOpenInterestRequest) (*gctrpc.GetOpenInterestResponse, error) {
+	if r.Exchange == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+	if r.Pair == nil || r.Pair.String() == "" {
+		return nil, errors.New(errCurrencyPairUnset)
+	}
+	if r.AssetType == "" {
+		return nil, errors.New(errAssetTypeUnset)
+	}
+
+	start, err := time.Parse(openinterest.TableTimeFormat, r.StartDate)
+	if err != nil {
+		return nil, err
+	}
+	end, err := time.Parse(openinterest.TableTimeFormat, r.EndDate)
+	if err != nil {
+		return nil, err
+	}
+	if !start.Before(end) {
+		return nil, errors.New("start date must be before end date")
+	}
+
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("Exchange " + r.Exchange + " not found")
+	}
+	a := asset.Item(r.AssetType)
+	if !exch.SupportsAsset(a) {
+		return nil, fmt.Errorf("%s does not support asset type %s", r.Exchange, a)
+	}
+
+	p := currency.Pair{
+		Base:  currency.NewCode(r.Pair.Base),
+		Quote: currency.NewCode(r.Pair.Quote),
+	}
+	records, err := openinterest.Get(exch.GetName(), a.String(),
+		p.Format("", true).String(), start, end)
+	if err != nil {
+		return nil, err
+	}
+
+	resp := &gctrpc.GetOpenInterestResponse{
+		Exchange:  exch.GetName(),
+		Pair:      r.Pair,
+		AssetType: a.String(),
+	}
+	for i := range records {
+		resp.OpenInterest = append(resp.OpenInterest, &gctrpc.OpenInterest{
+			Amount:         records[i].Amount,
+			Value:          records[i].Value,
+			Currency:       records[i].Currency,
+			LongShortRatio: records[i].LongShortRatio,
+			Timestamp:      records[i].RecordedAt.UTC().Format(openinterest.TableTimeFormat),
+		})
+	}
+	return resp, nil
+}
+
+// GetStrategyLedgers returns the virtual balances of a strategy, or of every
+// strategy if none is specified
+func (s *RPCServer) GetStrategyLedgers(ctx context.Context, r *gctrpc.GetStrategyLedgersRequest) (*gctrpc.GetStrategyLedgersResponse, error) {
+	ledgers, err := Bot.OrderManager.strategies.Ledgers(r.StrategyId)
+	if err != nil {
+		return nil, err
+	}
+	var resp gctrpc.GetStrategyLedgersResponse
+	for i := range ledgers {
+		ledger := &gctrpc.StrategyLedger{
+			StrategyId: ledgers[i].Strategy,
+			Exchange:   ledgers[i].Exchange,
+		}
+		for _, b := range ledgers[i].Balances {
+			ledger.Balances = append(ledger.Balances, &gctrpc.StrategyBalance{
+				Currency:  b.Currency,
+				Total:     b.Total,
+				Reserved:  b.Reserved,
+				Available: b.Available,
+			})
+		}
+		resp.Ledgers = append(resp.Ledgers, ledger)
+	}
+	return &resp, nil
+}
+
+// GetExposures returns the net exposure of each currency across spot holdings
+// and derivatives positions, and the target of those hedged by the hedge
+// manager
+func (s *RPCServer) GetExposures(_ context.Context, _ *gctrpc.GetExposuresRequest) (*gctrpc.GetExposuresResponse, error) {
+	exposures, err := Bot.HedgeManager.Exposures()
+	if err != nil {
+		return nil, err
+	}
+	resp := &gctrpc.GetExposuresResponse{}
+	for i := range exposures {
+		resp.Exposures = append(resp.Exposures, &gctrpc.Exposure{
+			Currency:    exposures[i].Currency.String(),
+			Spot:        exposures[i].Spot,
+			Derivatives: exposures[i].Derivatives,
+			Net:         exposures[i].Net,
+			Hedged:      exposures[i].Hedged,
+			Target:      exposures[i].Target,
+		})
+	}
+	return resp, nil
+}
+
+// ModifyOrder amends the price or amount of an open order, cancelling and
+// replacing it on exchanges without native amendment support
+func (s *RPCServer) ModifyOrder(_ context.Context, r *gctrpc.ModifyOrderRequest) (*gctrpc.ModifyOrderResponse, error) {
+	exch := GetExchangeByName(r.Exchange)
+	if exch == nil {
+		return nil, errors.New("exchange is not loaded/doesn't exist")
+	}
+
+	mod := &order.Modify{
+		OrderID:   r.OrderId,
+		Price:     decimal.NewFromFloat(r.Price),
+		Amount:    decimal.NewFromFloat(r.Amount),
+		AssetType: asset.Item(r.AssetType),
+	}
+	if r.Pair != nil {
+		mod.CurrencyPair = currency.NewPairFromStrings(r.Pair.Base, r.Pair.Quote)
+	}
+	result, err := Bot.OrderManager.Modify(exch.GetName(), mod)
+	if err != nil {
+		return nil, err
+	}
+	return &gctrpc.ModifyOrderResponse{
+		OrderId:          result.OrderID,
+		Replaced:         result.Replaced,
+		ClientId:         result.Lineage.ClientID,
+		CorrelationId:    result.CorrelationID,
+		ReplacedOrderIds: result.Lineage.Replaced,
+	}, nil
+}
+
+// GetDataQuality returns the latest integrity check of each stored candle
+// series, optionally checking and backfilling them first, with the gaps which
+// couldn't be backfilled
+func (s *RPCServer) GetDataQuality(_ context.Context, r *gctrpc.GetDataQualityRequest) (*gctrpc.GetDataQualityResponse, error) {
+	if !Bot.CandleIntegrity.Started() {
+		return nil, errCandleIntegrityNotStarted
+	}
+	if r.Check {
+		if _, err := Bot.CandleIntegrity.Check(); err != nil {
+			return nil, err
+		}
+	}
+	resp := &gctrpc.GetDataQualityResponse{}
+	reports := Bot.CandleIntegrity.Reports(r.Exchange)
+	for i := range reports {
+		resp.Series = append(resp.Series, candleQualityDetails(&reports[i]))
+	}
+	return resp, nil
+}
+
+func candleQualityDetails(r *CandleQualityReport) *gctrpc.CandleSeriesQuality {
+	q := &gctrpc.CandleSeriesQuality{
+		Exchange:   r.Exchange,
+		AssetType:  r.Asset,
+		Pair:       r.Pair,
+		Interval:   r.Interval.String(),
+		From:       r.From.UTC().Format(candle.TableTimeFormat),
+		To:         r.To.UTC().Format(candle.TableTimeFormat),
+		Expected:   r.Expected,
+		Stored:     r.Stored,
+		Backfilled: r.Backfilled,
+		Error:      r.Error,
+		Checked:    r.Checked.UTC().Format(candle.TableTimeFormat),
+	}
+	for i := range r.Gaps {
+		q.Gaps = append(q.Gaps, &gctrpc.CandleGap{
+			From:    r.Gaps[i].From.UTC().Format(candle.TableTimeFormat),
+			To:      r.Gaps[i].To.UTC().Format(candle.TableTimeFormat),
+			Missing: r.Gaps[i].Missing,
+		})
+	}
+	return q
+}
@@ -0,0 +1,197 @@
+package engine
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// allowed checks an address against the allowed IPs of the management
+// interfaces
+func (s *rpcSessions) allowed(address string) error {
+	allowedIPs := Bot.Config.RemoteControl.AllowedIPs
+	if len(allowedIPs) == 0 {
+		return nil
+	}
+	ip := net.ParseIP(rpcHost(address))
+	if ip != nil {
+		for i := range allowedIPs {
+			if allowed := net.ParseIP(allowedIPs[i]); allowed != nil {
+				if allowed.Equal(ip) {
+					return nil
+				}
+				continue
+			}
+			if _, network, err := net.ParseCIDR(allowedIPs[i]); err == nil && network.Contains(ip) {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("%v: %s", errRPCAddressNotAllowed, address)
+}
+
+// touch counts a request of a stateless interface against the session of its
+// address, starting one if there's none
+func (s *rpcSessions) touch(iface, address string) error {
+	if err := s.allowed(address); err != nil {
+		return err
+	}
+	host := rpcHost(address)
+	key := iface + " " + host
+	now := time.Now()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.expire(now)
+	if until, ok := s.revoked[key]; ok {
+		return fmt.Errorf("%v: %s %s until %s", errRPCSessionRevoked, iface, host,
+			until.UTC().Format(time.RFC3339))
+	}
+	if session, ok := s.sessions[key]; ok {
+		session.LastSeen = now
+		session.Requests++
+		return nil
+	}
+	session, err := s.start(iface, host, now)
+	if err != nil {
+		return err
+	}
+	session.key = key
+	session.Requests = 1
+	s.sessions[key] = session
+	return nil
+}
+
+// open starts the session of an authenticated websocket connection, close
+// disconnecting it if the session is revoked
+func (s *rpcSessions) open(address string, close func()) (string, error) {
+	if err := s.allowed(address); err != nil {
+		return "", err
+	}
+	now := time.Now()
+
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.expire(now)
+	session, err := s.start(rpcInterfaceWebsocket, rpcHost(address), now)
+	if err != nil {
+		return "", err
+	}
+	session.key = session.ID
+	session.close = close
+	s.sessions[session.key] = session
+	return session.ID, nil
+}
+
+// seen counts a request made over a websocket session
+func (s *rpcSessions) seen(id string) {
+	s.mtx.Lock()
+	if session, ok := s.sessions[id]; ok {
+		session.LastSeen = time.Now()
+		session.Requests++
+	}
+	s.mtx.Unlock()
+}
+
+// end removes the session of a websocket connection once it has closed
+func (s *rpcSessions) end(id string) {
+	s.mtx.Lock()
+	delete(s.sessions, id)
+	s.mtx.Unlock()
+}
+
+// start creates a session, unless the concurrent session limit is reached
+func (s *rpcSessions) start(iface, host string, now time.Time) (*rpcSession, error) {
+	if limit := Bot.Config.RemoteControl.MaxSessions; limit > 0 && len(s.sessions) >= limit {
+		log.Warnf(log.GRPCSys, "%s session from %s refused, %d of %d sessions in use\n",
+			iface, host, len(s.sessions), limit)
+		return nil, fmt.Errorf("%v: %d", errRPCSessionLimit, limit)
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return nil, err
+	}
+	if s.sessions == nil {
+		s.sessions = make(map[string]*rpcSession)
+	}
+	Bot.SecurityMonitor.CheckRPCClient(iface, host)
+	log.Debugf(log.GRPCSys, "%s session %s started from %s\n", iface, id, host)
+	return &rpcSession{
+		RPCSession: RPCSession{
+			ID:        id.String(),
+			Interface: iface,
+			Address:   host,
+			Started:   now,
+			LastSeen:  now,
+		},
+	}, nil
+}
+
+// expire removes the stateless sessions idle for the session timeout and the
+// revocations which have passed
+func (s *rpcSessions) expire(now time.Time) {
+	timeout := Bot.Config.RemoteControl.SessionTimeout
+	for key, session := range s.sessions {
+		if session.close == nil && now.Sub(session.LastSeen) >= timeout {
+			delete(s.sessions, key)
+		}
+	}
+	for key, until := range s.revoked {
+		if !now.Before(until) {
+			delete(s.revoked, key)
+		}
+	}
+}
+
+// Sessions returns the current sessions of the management interfaces, oldest
+// first
+func (s *rpcSessions) Sessions() []RPCSession {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	s.expire(time.Now())
+	sessions := make([]RPCSession, 0, len(s.sessions))
+	for _, session := range s.sessions {
+		sessions = append(sessions, session.RPCSession)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].Started.Before(sessions[j].Started)
+	})
+	return sessions
+}
+
+// Revoke ends a session. A websocket session is disconnected, the address of
+// a stateless session is refused by its interface for the session timeout
+func (s *rpcSessions) Revoke(id string) (RPCSession, error) {
+	s.mtx.Lock()
+	defer s.mtx.Unlock()
+	for key, session := range s.sessions {
+		if session.ID != id {
+			continue
+		}
+		delete(s.sessions, key)
+		if session.close != nil {
+			session.close()
+		} else {
+			if s.revoked == nil {
+				s.revoked = make(map[string]time.Time)
+			}
+			s.revoked[key] = time.Now().Add(Bot.Config.RemoteControl.SessionTimeout)
+		}
+		log.Warnf(log.GRPCSys, "%s session %s from %s revoked\n",
+			session.Interface, session.ID, session.Address)
+		return session.RPCSession, nil
+	}
+	return RPCSession{}, fmt.Errorf("%v: %s", errRPCSessionNotFound, id)
+}
+
+// rpcHost returns the host of an address, or the address if it has no port
+func rpcHost(address string) string {
+	if host, _, err := net.SplitHostPort(address); err == nil {
+		return host
+	}
+	return address
+}
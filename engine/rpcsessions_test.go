@@ -0,0 +1,173 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+)
+
+func setupRPCSessionsTest(t *testing.T, allowedIPs []string, maxSessions int) func() {
+	SetupTestHelpers(t)
+	rc := Bot.Config.RemoteControl
+	Bot.Config.RemoteControl.AllowedIPs = allowedIPs
+	Bot.Config.RemoteControl.MaxSessions = maxSessions
+	Bot.Config.RemoteControl.SessionTimeout = time.Minute
+	return func() {
+		Bot.Config.RemoteControl.AllowedIPs = rc.AllowedIPs
+		Bot.Config.RemoteControl.MaxSessions = rc.MaxSessions
+		Bot.Config.RemoteControl.SessionTimeout = rc.SessionTimeout
+	}
+}
+
+func TestRPCSessionsAllowed(t *testing.T) {
+	defer setupRPCSessionsTest(t, []string{"127.0.0.1", "10.0.0.0/8"}, 0)()
+
+	var s rpcSessions
+	for _, address := range []string{"127.0.0.1:1337", "10.1.2.3:1337", "10.1.2.3"} {
+		if err := s.allowed(address); err != nil {
+			t.Errorf("expected %s to be allowed, got %v", address, err)
+		}
+	}
+	for _, address := range []string{"192.168.0.1:1337", "::1", "bad"} {
+		if err := s.allowed(address); err == nil ||
+			!strings.HasPrefix(err.Error(), errRPCAddressNotAllowed.Error()) {
+			t.Errorf("expected %s to be refused with %v, got %v", address, errRPCAddressNotAllowed, err)
+		}
+	}
+}
+
+func TestRPCSessionsTouch(t *testing.T) {
+	defer setupRPCSessionsTest(t, nil, 2)()
+
+	var s rpcSessions
+	for i := 0; i < 2; i++ {
+		if err := s.touch(rpcInterfaceGRPC, "127.0.0.1:1000"); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := s.touch(rpcInterfaceDeprecatedRPC, "127.0.0.1:1001"); err != nil {
+		t.Fatal(err)
+	}
+	sessions := s.Sessions()
+	if len(sessions) != 2 {
+		t.Fatalf("expected 2 sessions, got %d", len(sessions))
+	}
+	if sessions[0].Interface != rpcInterfaceGRPC || sessions[0].Requests != 2 {
+		t.Errorf("expected the gRPC session to have 2 requests, got %+v", sessions[0])
+	}
+
+	err := s.touch(rpcInterfaceGRPC, "127.0.0.2:1000")
+	if err == nil || !strings.HasPrefix(err.Error(), errRPCSessionLimit.Error()) {
+		t.Errorf("expected %v, got %v", errRPCSessionLimit, err)
+	}
+
+	for _, session := range s.sessions {
+		session.LastSeen = time.Now().Add(-time.Hour)
+	}
+	if err = s.touch(rpcInterfaceGRPC, "127.0.0.2:1000"); err != nil {
+		t.Errorf("expected idle sessions to expire, got %v", err)
+	}
+	if sessions = s.Sessions(); len(sessions) != 1 {
+		t.Errorf("expected 1 session, got %d", len(sessions))
+	}
+}
+
+func TestRPCSessionsRevoke(t *testing.T) {
+	defer setupRPCSessionsTest(t, nil, 0)()
+
+	var s rpcSessions
+	if _, err := s.Revoke("missing"); err == nil ||
+		!strings.HasPrefix(err.Error(), errRPCSessionNotFound.Error()) {
+		t.Errorf("expected %v, got %v", errRPCSessionNotFound, err)
+	}
+
+	if err := s.touch(rpcInterfaceGRPC, "127.0.0.1:1000"); err != nil {
+		t.Fatal(err)
+	}
+	session, err := s.Revoke(s.Sessions()[0].ID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if session.Interface != rpcInterfaceGRPC || session.Address != "127.0.0.1" {
+		t.Errorf("unexpected session revoked %+v", session)
+	}
+	err = s.touch(rpcInterfaceGRPC, "127.0.0.1:1001")
+	if err == nil || !strings.HasPrefix(err.Error(), errRPCSessionRevoked.Error()) {
+		t.Errorf("expected %v, got %v", errRPCSessionRevoked, err)
+	}
+	if err = s.touch(rpcInterfaceDeprecatedRPC, "127.0.0.1:1001"); err != nil {
+		t.Errorf("expected the address to be refused only by the revoked interface, got %v", err)
+	}
+
+	var closed bool
+	id, err := s.open("127.0.0.1:1002", func() { closed = true })
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = s.Revoke(id); err != nil {
+		t.Fatal(err)
+	}
+	if !closed {
+		t.Error("expected revoking a websocket session to close its connection")
+	}
+	if err = s.touch(rpcInterfaceWebsocket, "127.0.0.1:1002"); err != nil {
+		t.Errorf("expected a revoked websocket session's address to be allowed, got %v", err)
+	}
+}
+
+func TestRPCClientAddress(t *testing.T) {
+	SetupTestHelpers(t)
+	token := Bot.rpcProxyToken
+	Bot.rpcProxyToken = "proxy"
+	defer func() { Bot.rpcProxyToken = token }()
+
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	clients := make(chan [2]string, 1)
+	server := grpc.NewServer(grpc.UnaryInterceptor(
+		func(ctx context.Context, _ interface{}, _ *grpc.UnaryServerInfo, _ grpc.UnaryHandler) (interface{}, error) {
+			md, _ := metadata.FromIncomingContext(ctx)
+			iface, address := rpcClientAddress(ctx, md)
+			clients <- [2]string{iface, address}
+			return nil, errors.New("request recorded")
+		}))
+	gctrpc.RegisterGoCryptoTraderServer(server, &RPCServer{})
+	go func() { _ = server.Serve(lis) }()
+	defer server.Stop()
+
+	conn, err := grpc.Dial(lis.Addr().String(), grpc.WithInsecure())
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer conn.Close()
+	client := gctrpc.NewGoCryptoTraderClient(conn)
+
+	for _, tc := range []struct {
+		md    metadata.MD
+		iface string
+		host  string
+	}{
+		{metadata.Pairs("x-forwarded-for", "10.0.0.1"), rpcInterfaceGRPC, "127.0.0.1"},
+		{metadata.Pairs("x-forwarded-for", "10.0.0.1", rpcProxyTokenKey, "guess"), rpcInterfaceGRPC, "127.0.0.1"},
+		{metadata.Pairs("x-forwarded-for", "10.0.0.1", rpcProxyTokenKey, "proxy"), rpcInterfaceGRPCProxy, "10.0.0.1"},
+	} {
+		ctx := metadata.NewOutgoingContext(context.Background(), tc.md)
+		if _, err = client.GetInfo(ctx, &gctrpc.GetInfoRequest{}); err == nil {
+			t.Fatal("expected the request to be stopped by the interceptor")
+		}
+		c := <-clients
+		if c[0] != tc.iface || rpcHost(c[1]) != tc.host {
+			t.Errorf("%v: expected %s request from %s, received %s request from %s",
+				tc.md, tc.iface, tc.host, c[0], c[1])
+		}
+	}
+}
@@ -0,0 +1,58 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Management interfaces which sessions are tracked for
+const (
+	rpcInterfaceGRPC          = "gRPC"
+	rpcInterfaceGRPCProxy     = "gRPC proxy"
+	rpcInterfaceDeprecatedRPC = "deprecated RPC"
+	rpcInterfaceWebsocket     = "websocket RPC"
+
+	// rpcProxyTokenKey is the gRPC metadata key the gRPC proxy sends its
+	// token in, only requests carrying the token are from the proxy
+	rpcProxyTokenKey = "x-gct-proxy-token"
+	rpcProxyTokenLen = 32
+)
+
+var (
+	errRPCAddressNotAllowed = errors.New("address not allowed")
+	errRPCSessionLimit      = errors.New("concurrent session limit reached")
+	errRPCSessionRevoked    = errors.New("session revoked")
+	errRPCSessionNotFound   = errors.New("session not found")
+)
+
+// rpcSessions tracks the sessions of the management interfaces. gRPC and
+// deprecated RPC requests are stateless, so a session is each address making
+// requests until it is idle for the session timeout. A websocket session is
+// an authenticated connection and ends when it closes
+type rpcSessions struct {
+	mtx sync.Mutex
+	// sessions are keyed by interface and address, or by ID for websocket
+	// connections
+	sessions map[string]*rpcSession
+	// revoked holds when the revoked sessions of stateless interfaces may be
+	// started again, keyed by interface and address
+	revoked map[string]time.Time
+}
+
+type rpcSession struct {
+	RPCSession
+	key string
+	// close disconnects a websocket session
+	close func()
+}
+
+// RPCSession is a session of a management interface
+type RPCSession struct {
+	ID        string
+	Interface string
+	Address   string
+	Started   time.Time
+	LastSeen  time.Time
+	Requests  int64
+}
@@ -0,0 +1,107 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/database"
+	dbpsql "github.com/thrasher-corp/gocryptotrader/database/drivers/postgres"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/secrets"
+)
+
+// secretsRefreshTimeout is how long a refresh of all secrets may take
+const secretsRefreshTimeout = time.Minute
+
+// startSecretsRefresher periodically re-fetches the credentials loaded from
+// the secrets backend so rotated credentials are applied without a restart
+func (e *Engine) startSecretsRefresher() {
+	interval := e.Config.SecretsBackend.GetRefreshInterval()
+	if !e.Config.SecretsBackend.Enabled || interval == 0 {
+		return
+	}
+	b, err := secrets.New(&e.Config.SecretsBackend)
+	if err != nil {
+		log.Errorf(log.Global, "Secrets refresher unable to start: %v\n", err)
+		return
+	}
+	log.Debugf(log.Global, "Refreshing secrets from %s every %v\n", b.Name(), interval)
+
+	e.ServicesWG.Add(1)
+	go func() {
+		t := time.NewTicker(interval)
+		defer func() {
+			t.Stop()
+			e.ServicesWG.Done()
+		}()
+		for {
+			select {
+			case <-e.Context().Done():
+				return
+			case <-t.C:
+				guard("secrets refresher", func() { e.refreshSecrets(b) })
+			}
+		}
+	}()
+}
+
+// refreshSecrets re-fetches secrets, applying any rotated credentials to the
+// exchanges, hot wallets and database connection using them
+func (e *Engine) refreshSecrets(b secrets.Backend) {
+	ctx, cancel := context.WithTimeout(e.Context(), secretsRefreshTimeout)
+	defer cancel()
+	u, err := e.Config.LoadSecrets(ctx, b)
+	if err != nil {
+		log.Errorf(log.Global, "Unable to refresh secrets from %s: %v\n", b.Name(), err)
+		return
+	}
+	for i := range u.Exchanges {
+		e.applyExchangeCredentials(u.Exchanges[i])
+	}
+	if len(u.Wallets) > 0 && e.TransferManager.Started() {
+		e.TransferManager.loadWallets()
+	}
+	if u.Database {
+		e.reconnectDatabase()
+	}
+}
+
+// applyExchangeCredentials updates a loaded exchange with its credentials from
+// the config
+func (e *Engine) applyExchangeCredentials(exchName string) {
+	exch := GetExchangeByName(exchName)
+	if exch == nil {
+		return
+	}
+	exchCfg, err := e.Config.GetExchangeConfig(exchName)
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "Unable to apply rotated credentials for %s: %v\n", exchName, err)
+		return
+	}
+	creds := exchCfg.API.Credentials
+	base := exch.GetBase()
+	base.SetAPIKeys(creds.Key, creds.Secret, creds.ClientID)
+	base.API.Credentials.PEMKey = creds.PEMKey
+	log.Infof(log.ExchangeSys, "Applied rotated API credentials for %s\n", exchName)
+}
+
+// reconnectDatabase reconnects to the database using the rotated credentials,
+// keeping the existing connection if the new credentials are rejected
+func (e *Engine) reconnectDatabase() {
+	if !e.DatabaseManager.Started() || e.Config.Database.Driver != database.DBPostgreSQL {
+		return
+	}
+	dbConn.Mu.Lock()
+	defer dbConn.Mu.Unlock()
+	old := dbConn.SQL
+	_, err := dbpsql.Connect()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Unable to reconnect to database with rotated credentials: %v\n", err)
+		return
+	}
+	err = old.Close()
+	if err != nil {
+		log.Errorf(log.DatabaseMgr, "Failed to close previous database connection: %v\n", err)
+	}
+	log.Infoln(log.DatabaseMgr, "Reconnected to database with rotated credentials")
+}
@@ -0,0 +1,46 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/secrets"
+)
+
+func TestRefreshSecrets(t *testing.T) {
+	SetupTest(t)
+
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	err = os.MkdirAll(filepath.Join(dir, "exchanges", strings.ToLower(testExchange)), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for k, v := range map[string]string{"key": "rotatedkey", "secret": "rotatedsecret"} {
+		err = ioutil.WriteFile(filepath.Join(dir, filepath.FromSlash(config.ExchangeSecretName(testExchange)), k),
+			[]byte(v), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	b, err := secrets.New(&secrets.Config{
+		Backend: secrets.BackendFile,
+		File:    secrets.FileConfig{Directory: dir},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	Bot.refreshSecrets(b)
+	creds := GetExchangeByName(testExchange).GetBase().API.Credentials
+	if creds.Key != "rotatedkey" || creds.Secret != "rotatedsecret" {
+		t.Errorf("expected rotated credentials to be applied, got %+v", creds)
+	}
+}
@@ -0,0 +1,265 @@
+package engine
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"math"
+	"net"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (s *securityMonitor) Started() bool {
+	return atomic.LoadInt32(&s.started) == 1
+}
+
+func (s *securityMonitor) Start() error {
+	if atomic.AddInt32(&s.started, 1) != 1 {
+		return errors.New("security monitor already started")
+	}
+
+	s.mtx.Lock()
+	s.cfg = Bot.Config.SecurityMonitor
+	s.path = filepath.Join(Bot.Settings.DataDir, securityStateFile)
+	s.values = make(map[string]float64)
+	s.valuer = trailingStop{
+		cfg:    config.TrailingStopConfig{MaxTickerAge: s.cfg.MaxTickerAge},
+		quote:  currency.NewCode(s.cfg.Currency),
+		prices: make(map[string]float64),
+	}
+	err := s.load()
+	s.mtx.Unlock()
+	if err != nil {
+		atomic.CompareAndSwapInt32(&s.started, 1, 0)
+		return err
+	}
+	s.shutdown = make(chan struct{})
+	go s.run()
+	log.Debugf(log.Global, "Security monitor started, checking balances every %v.\n", s.cfg.CheckInterval)
+	return nil
+}
+
+func (s *securityMonitor) Stop() error {
+	if atomic.LoadInt32(&s.started) == 0 {
+		return errors.New("security monitor not started")
+	}
+
+	if atomic.AddInt32(&s.stopped, 1) != 1 {
+		return errors.New("security monitor is already stopped")
+	}
+
+	close(s.shutdown)
+	log.Debugln(log.Global, "Security monitor shutting down...")
+	return nil
+}
+
+func (s *securityMonitor) run() {
+	defer errorreport.Recover()
+	t := time.NewTicker(s.cfg.CheckInterval)
+	defer func() {
+		t.Stop()
+		atomic.CompareAndSwapInt32(&s.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&s.started, 1, 0)
+		log.Debugln(log.Global, "Security monitor shutdown.")
+	}()
+
+	guard(securityMonitorName, s.checkBalances)
+	for {
+		select {
+		case <-s.shutdown:
+			return
+		case <-t.C:
+			guard(securityMonitorName, s.checkBalances)
+		}
+	}
+}
+
+// Killed reports whether the kill switch has been triggered
+func (s *securityMonitor) Killed() bool {
+	return atomic.LoadInt32(&s.killed) == 1
+}
+
+// CheckOrder flags an order priced further from the exchange's recent price
+// than the price deviation percent. Orders without a price, or for pairs
+// without a recent price, aren't checked
+func (s *securityMonitor) CheckOrder(exchName string, o *order.Submit) {
+	if !s.Started() || o == nil || o.OrderType == order.Market || o.Price.Float64() <= 0 {
+		return
+	}
+	a := o.AssetType
+	if a == "" {
+		a = asset.Spot
+	}
+	price, err := tickerPrice(exchName, o.Pair, a, time.Now(), s.cfg.MaxTickerAge)
+	if err != nil {
+		return
+	}
+	deviation := math.Abs(o.Price.Float64()-price) / price * 100
+	if deviation < s.cfg.PriceDeviationPercent {
+		return
+	}
+	s.flag(config.SecurityAnomalyOrderPrice, fmt.Sprintf("%s %s order for %s %s on %s priced at %s, %.2f%% from the market price of %v",
+		o.OrderType, o.OrderSide, o.Amount, o.Pair, exchName, o.Price, deviation, price))
+}
+
+// CheckWithdrawal flags a withdrawal to an address never withdrawn to before,
+// remembering the address. Withdrawals are refused once the kill switch has
+// been triggered, an empty address being a fiat withdrawal
+func (s *securityMonitor) CheckWithdrawal(source string, code currency.Code, amount float64, address string) error {
+	if s.Started() && address != "" {
+		s.mtx.Lock()
+		known := s.known.WithdrawalAddresses[address]
+		if !known {
+			s.known.WithdrawalAddresses[address] = true
+			s.saveLocked()
+		}
+		s.mtx.Unlock()
+		if !known {
+			s.flag(config.SecurityAnomalyWithdrawalDestination, fmt.Sprintf("Withdrawal of %v %s from %s to new destination %s",
+				amount, code, source, address))
+		}
+	}
+	if s.Killed() {
+		return errSecurityKillSwitch
+	}
+	return nil
+}
+
+// CheckRPCClient flags a management interface session from an address never
+// seen before, remembering the address. Loopback addresses aren't flagged
+func (s *securityMonitor) CheckRPCClient(iface, host string) {
+	if !s.Started() {
+		return
+	}
+	if ip := net.ParseIP(host); ip != nil && ip.IsLoopback() {
+		return
+	}
+	s.mtx.Lock()
+	known := s.known.RPCClients[host]
+	if !known {
+		s.known.RPCClients[host] = true
+		s.saveLocked()
+	}
+	s.mtx.Unlock()
+	if !known {
+		s.flag(config.SecurityAnomalyRPCClient, fmt.Sprintf("%s session from new client address %s", iface, host))
+	}
+}
+
+// checkBalances values every authenticated exchange's balances, flagging
+// those which have fallen the balance drop percent since the last check.
+// Exchanges which can't be valued keep their last value
+func (s *securityMonitor) checkBalances() {
+	names := GetExchangeNames(true)
+	authenticated := GetAuthAPISupportedExchanges()
+	now := time.Now()
+	for i := range authenticated {
+		exch := GetExchangeByName(authenticated[i])
+		if exch == nil {
+			continue
+		}
+		h, err := exch.UpdateAccountInfo(Bot.Context())
+		if err != nil {
+			log.Errorf(log.Global, "Security monitor unable to value %s: %v\n", authenticated[i], err)
+			continue
+		}
+		s.mtx.Lock()
+		value, _ := s.valuer.holdingsValue(authenticated[i], &h, names, now)
+		last := s.values[authenticated[i]]
+		s.values[authenticated[i]] = value
+		s.mtx.Unlock()
+
+		if last <= 0 {
+			continue
+		}
+		if drop := (last - value) / last * 100; drop >= s.cfg.BalanceDropPercent {
+			s.flag(config.SecurityAnomalyBalanceDrop, fmt.Sprintf("%s balances fell %.2f%% in value from %.2f to %.2f %s",
+				authenticated[i], drop, last, value, s.cfg.Currency))
+		}
+	}
+}
+
+// flag reports an anomaly, triggering the kill switch if the anomaly is
+// configured to
+func (s *securityMonitor) flag(anomaly, msg string) {
+	kill := common.StringDataCompare(s.cfg.KillSwitch, anomaly)
+	msg = "Security monitor: " + msg
+	severity := base.SeverityWarning
+	if kill {
+		severity = base.SeverityCritical
+	}
+	log.Warnln(log.Global, msg)
+	audit.Event(anomaly, auditEventSecurity, msg)
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:     base.EventTypeAlert,
+		Message:  msg,
+		Severity: severity,
+	})
+	if kill {
+		s.kill(anomaly)
+	}
+}
+
+// kill triggers the kill switch, refusing new orders and withdrawals before
+// cancelling every open order
+func (s *securityMonitor) kill(anomaly string) {
+	if !atomic.CompareAndSwapInt32(&s.killed, 0, 1) {
+		return
+	}
+	msg := fmt.Sprintf("Security monitor kill switch triggered by %s anomaly, refusing orders and withdrawals until restarted", anomaly)
+	log.Errorln(log.Global, msg)
+	audit.Event(anomaly, auditEventSecurity, msg)
+	go func() {
+		if err := Bot.OrderManager.CancelAllOrders(nil); err != nil {
+			log.Errorf(log.Global, "Security monitor kill switch unable to cancel orders: %v\n", err)
+		}
+	}()
+}
+
+// load reads the destinations and clients already seen from the state file
+func (s *securityMonitor) load() error {
+	s.known = securityKnown{}
+	data, err := ioutil.ReadFile(s.path)
+	switch {
+	case err == nil:
+		if err = json.Unmarshal(data, &s.known); err != nil {
+			return fmt.Errorf("unable to read security monitor state %s: %v", s.path, err)
+		}
+	case !os.IsNotExist(err):
+		return err
+	}
+	if s.known.WithdrawalAddresses == nil {
+		s.known.WithdrawalAddresses = make(map[string]bool)
+	}
+	if s.known.RPCClients == nil {
+		s.known.RPCClients = make(map[string]bool)
+	}
+	return nil
+}
+
+// saveLocked writes the destinations and clients seen to the state file, the
+// caller must hold the lock
+func (s *securityMonitor) saveLocked() {
+	data, err := json.MarshalIndent(s.known, "", " ")
+	if err == nil {
+		err = file.Write(s.path, data)
+	}
+	if err != nil {
+		log.Errorf(log.Global, "Security monitor unable to save state to %s: %v\n", s.path, err)
+	}
+}
@@ -0,0 +1,126 @@
+package engine
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func newTestSecurityMonitor(t *testing.T, dir string, killSwitch ...string) *securityMonitor {
+	s := &securityMonitor{
+		started: 1,
+		cfg: config.SecurityMonitorConfig{
+			PriceDeviationPercent: 10,
+			BalanceDropPercent:    20,
+			MaxTickerAge:          time.Minute,
+			KillSwitch:            killSwitch,
+		},
+		path: filepath.Join(dir, securityStateFile),
+	}
+	if err := s.load(); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func TestSecurityMonitorCheckOrder(t *testing.T) {
+	SetupTestHelpers(t)
+	dir, err := ioutil.TempDir("", "security")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	err = ticker.ProcessTicker("securitytest", &ticker.Price{Pair: p, Last: 100, LastUpdated: time.Now()}, asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s := newTestSecurityMonitor(t, dir, config.SecurityAnomalyOrderPrice)
+	submit := &order.Submit{
+		Pair:      p,
+		OrderType: order.Limit,
+		OrderSide: order.Buy,
+		Price:     decimal.NewFromInt(105),
+		Amount:    decimal.NewFromInt(1),
+	}
+	s.CheckOrder("securitytest", submit)
+	if s.Killed() {
+		t.Error("expected an order near the market price not to be flagged")
+	}
+	submit.Price = decimal.NewFromInt(150)
+	s.CheckOrder("securitytest", submit)
+	if !s.Killed() {
+		t.Error("expected an order far from the market price to trigger the kill switch")
+	}
+	if err = s.CheckWithdrawal("securitytest", currency.BTC, 1, ""); err != errSecurityKillSwitch {
+		t.Errorf("expected %v, got %v", errSecurityKillSwitch, err)
+	}
+}
+
+func TestSecurityMonitorCheckWithdrawal(t *testing.T) {
+	SetupTestHelpers(t)
+	dir, err := ioutil.TempDir("", "security")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newTestSecurityMonitor(t, dir)
+	if err = s.CheckWithdrawal("securitytest", currency.BTC, 1, "address"); err != nil {
+		t.Fatal(err)
+	}
+	if !s.known.WithdrawalAddresses["address"] {
+		t.Error("expected the destination to be remembered")
+	}
+
+	s = newTestSecurityMonitor(t, dir, config.SecurityAnomalyWithdrawalDestination)
+	if !s.known.WithdrawalAddresses["address"] {
+		t.Error("expected the destination to be loaded from the state file")
+	}
+	if err = s.CheckWithdrawal("securitytest", currency.BTC, 1, "address"); err != nil {
+		t.Errorf("expected a known destination to be allowed, got %v", err)
+	}
+	if err = s.CheckWithdrawal("securitytest", currency.BTC, 1, "other"); err != errSecurityKillSwitch {
+		t.Errorf("expected a new destination to trigger the kill switch, got %v", err)
+	}
+}
+
+func TestSecurityMonitorCheckRPCClient(t *testing.T) {
+	SetupTestHelpers(t)
+	dir, err := ioutil.TempDir("", "security")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	s := newTestSecurityMonitor(t, dir)
+	s.CheckRPCClient(rpcInterfaceGRPC, "127.0.0.1")
+	s.CheckRPCClient(rpcInterfaceGRPC, "192.0.2.1")
+	if len(s.known.RPCClients) != 1 || !s.known.RPCClients["192.0.2.1"] {
+		t.Errorf("expected only the non loopback client to be remembered, got %v", s.known.RPCClients)
+	}
+}
+
+func TestSecurityMonitorKillSwitchRefusesOrders(t *testing.T) {
+	SetupTestHelpers(t)
+	atomic.StoreInt32(&Bot.SecurityMonitor.killed, 1)
+	defer atomic.StoreInt32(&Bot.SecurityMonitor.killed, 0)
+
+	if _, err := Bot.OrderManager.Submit("securitytest", &order.Submit{}); err != errSecurityKillSwitch {
+		t.Errorf("expected %v, got %v", errSecurityKillSwitch, err)
+	}
+	if _, err := Bot.OrderManager.Modify("securitytest", &order.Modify{}); err != errSecurityKillSwitch {
+		t.Errorf("expected %v, got %v", errSecurityKillSwitch, err)
+	}
+}
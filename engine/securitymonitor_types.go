@@ -0,0 +1,45 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+const (
+	securityMonitorName = "security monitor"
+	auditEventSecurity  = "security"
+	// securityStateFile is the file in the data directory the withdrawal
+	// destinations and RPC clients already seen are kept in
+	securityStateFile = "securitymonitor.json"
+)
+
+var errSecurityKillSwitch = errors.New("refused as the security monitor kill switch has been triggered")
+
+// securityMonitor flags anomalous activity: orders priced far from the
+// market, withdrawals to new destinations, sudden falls in the value of an
+// exchange's balances and management interface sessions from new addresses.
+// Configured anomalies trigger the kill switch, which stays triggered until
+// the bot is restarted
+type securityMonitor struct {
+	started  int32
+	stopped  int32
+	killed   int32
+	shutdown chan struct{}
+	cfg      config.SecurityMonitorConfig
+	path     string
+	mtx      sync.Mutex
+	known    securityKnown
+	// values holds the value of each exchange's balances at the last check
+	values map[string]float64
+	// valuer values balances the same way as the portfolio trailing stop
+	valuer trailingStop
+}
+
+// securityKnown is the state saved between runs so destinations and clients
+// are only flagged the first time they are seen
+type securityKnown struct {
+	WithdrawalAddresses map[string]bool `json:"withdrawalAddresses"`
+	RPCClients          map[string]bool `json:"rpcClients"`
+}
@@ -0,0 +1,349 @@
+package engine
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+)
+
+// RunSetupWizard interactively creates a config at configPath when none
+// exists, asking which exchanges to enable and their API keys, the fiat
+// display currency and whether to enable the database and communications.
+// The config is validated and saved encrypted with the key in keyFile, or
+// with a password asked for. Nothing is saved if creating a config is
+// declined.
+func RunSetupWizard(configPath, keyFile string, in io.Reader, out io.Writer) error {
+	w := &setupWizard{
+		in:             bufio.NewScanner(in),
+		out:            out,
+		exchangeConfig: fetchExchangeConfig,
+	}
+	return w.run(configPath, keyFile)
+}
+
+// fetchExchangeConfig returns an exchange's default config, fetching its
+// tradable pairs
+func fetchExchangeConfig(name string) (*config.ExchangeConfig, error) {
+	exch, err := newExchange(strings.ToLower(name))
+	if err != nil {
+		return nil, err
+	}
+	return exch.GetDefaultConfig()
+}
+
+func (w *setupWizard) run(configPath, keyFile string) error {
+	create, err := w.confirm(fmt.Sprintf("No config found at %s, would you like to create one", configPath), true)
+	if err != nil || !create {
+		return err
+	}
+
+	cfg := &config.Config{Name: setupConfigName}
+	names, err := w.chooseExchanges()
+	if err != nil {
+		return err
+	}
+	for i := range names {
+		fmt.Fprintf(w.out, "Fetching %s defaults and tradable pairs...\n", names[i])
+		exchCfg, err := w.exchangeConfig(names[i])
+		if err != nil {
+			return fmt.Errorf("unable to get %s default config: %v", names[i], err)
+		}
+		exchCfg.Enabled = true
+		err = w.exchangeCredentials(exchCfg)
+		if err != nil {
+			return err
+		}
+		cfg.Exchanges = append(cfg.Exchanges, *exchCfg)
+	}
+
+	steps := []func(*config.Config) error{
+		w.fiatCurrency,
+		w.database,
+		w.communications,
+	}
+	for i := range steps {
+		err = steps[i](cfg)
+		if err != nil {
+			return err
+		}
+	}
+
+	err = cfg.CheckConfig()
+	if err != nil {
+		return fmt.Errorf("config is invalid: %v", err)
+	}
+
+	var key []byte
+	if keyFile != "" {
+		key, err = config.ReadKeyFile(keyFile)
+	} else {
+		key, err = w.password()
+	}
+	if err != nil {
+		return err
+	}
+	err = cfg.SaveEncryptedConfig(configPath, key)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(w.out, "Saved encrypted config to %s.\n", configPath)
+	return nil
+}
+
+// chooseExchanges asks which supported exchanges to enable, by name or
+// number
+func (w *setupWizard) chooseExchanges() ([]string, error) {
+	fmt.Fprintln(w.out, "Supported exchanges:")
+	for i := range exchange.Exchanges {
+		fmt.Fprintf(w.out, "%3d. %s\n", i+1, exchange.Exchanges[i])
+	}
+	for {
+		answer, err := w.ask("Exchanges to enable, as a comma separated list of names or numbers", "binance")
+		if err != nil {
+			return nil, err
+		}
+		names, err := parseSetupExchanges(answer)
+		if err != nil {
+			fmt.Fprintln(w.out, err)
+			continue
+		}
+		return names, nil
+	}
+}
+
+func parseSetupExchanges(answer string) ([]string, error) {
+	var names []string
+	for _, s := range strings.Split(answer, ",") {
+		s = strings.ToLower(strings.TrimSpace(s))
+		if s == "" {
+			continue
+		}
+		if n, err := strconv.Atoi(s); err == nil {
+			if n < 1 || n > len(exchange.Exchanges) {
+				return nil, fmt.Errorf("there is no exchange %d", n)
+			}
+			s = exchange.Exchanges[n-1]
+		} else if !common.StringDataCompare(exchange.Exchanges, s) {
+			return nil, fmt.Errorf("%s is not a supported exchange", s)
+		}
+		if !common.StringDataCompare(names, s) {
+			names = append(names, s)
+		}
+	}
+	if len(names) == 0 {
+		return nil, fmt.Errorf("at least one exchange must be enabled")
+	}
+	return names, nil
+}
+
+// exchangeCredentials asks for an exchange's API credentials, enabling
+// authenticated requests when they are given
+func (w *setupWizard) exchangeCredentials(exchCfg *config.ExchangeConfig) error {
+	key, err := w.ask(exchCfg.Name+" API key, blank for public data only", "")
+	if err != nil || key == "" {
+		return err
+	}
+	secret, err := w.ask(exchCfg.Name+" API secret", "")
+	if err != nil {
+		return err
+	}
+	exchCfg.API.Credentials.Key = key
+	exchCfg.API.Credentials.Secret = secret
+	if v := exchCfg.API.CredentialsValidator; v != nil && v.RequiresClientID {
+		exchCfg.API.Credentials.ClientID, err = w.ask(exchCfg.Name+" client ID", "")
+		if err != nil {
+			return err
+		}
+	}
+	exchCfg.API.AuthenticatedSupport = true
+	return nil
+}
+
+// fiatCurrency asks for the currency values are displayed in
+func (w *setupWizard) fiatCurrency(cfg *config.Config) error {
+	for {
+		answer, err := w.ask("Fiat display currency", currency.USD.String())
+		if err != nil {
+			return err
+		}
+		code := currency.NewCode(strings.ToUpper(answer))
+		if !code.IsFiatCurrency() {
+			fmt.Fprintf(w.out, "%s is not a fiat currency\n", code)
+			continue
+		}
+		cfg.Currency.FiatDisplayCurrency = code
+		return nil
+	}
+}
+
+// database asks whether to enable the database, and its connection details
+func (w *setupWizard) database(cfg *config.Config) error {
+	enable, err := w.confirm("Enable the database, storing orders, trades and audit events", false)
+	if err != nil || !enable {
+		return err
+	}
+	db := &cfg.Database
+	db.Enabled = true
+	for {
+		db.Driver, err = w.ask("Database driver, "+database.DBSQLite3+" or "+database.DBPostgreSQL, database.DBSQLite3)
+		if err != nil {
+			return err
+		}
+		if common.StringDataCompare(database.SupportedDrivers, db.Driver) {
+			break
+		}
+		fmt.Fprintf(w.out, "%s is not a supported database driver\n", db.Driver)
+	}
+
+	if db.Driver != database.DBPostgreSQL {
+		db.Database, err = w.ask("Database file, in the data directory", "gocryptotrader.db")
+		return err
+	}
+	db.Host, err = w.ask("Database host", "localhost")
+	if err != nil {
+		return err
+	}
+	for {
+		var port string
+		port, err = w.ask("Database port", "5432")
+		if err != nil {
+			return err
+		}
+		var p uint64
+		p, err = strconv.ParseUint(port, 10, 16)
+		if err == nil {
+			db.Port = uint16(p)
+			break
+		}
+		fmt.Fprintf(w.out, "%s is not a valid port\n", port)
+	}
+	return w.askAll([]setupQuestion{
+		{"Database username", "", &db.Username},
+		{"Database password", "", &db.Password},
+		{"Database name", "gocryptotrader", &db.Database},
+		{"Database SSL mode", "disable", &db.SSLMode},
+	})
+}
+
+// communications asks whether to enable a communications relayer, and its
+// settings
+func (w *setupWizard) communications(cfg *config.Config) error {
+	enable, err := w.confirm("Enable communications, sending alerts and events to a messaging service", false)
+	if err != nil || !enable {
+		return err
+	}
+	var service string
+	for {
+		service, err = w.ask("Messaging service, "+setupCommsTelegram+", "+setupCommsSlack+" or "+setupCommsSMTP, setupCommsTelegram)
+		if err != nil {
+			return err
+		}
+		service = strings.ToLower(service)
+		if service == setupCommsTelegram || service == setupCommsSlack || service == setupCommsSMTP {
+			break
+		}
+		fmt.Fprintf(w.out, "%s is not a supported messaging service\n", service)
+	}
+
+	comms := &cfg.Communications
+	var questions []setupQuestion
+	switch service {
+	case setupCommsTelegram:
+		comms.TelegramConfig = config.TelegramConfig{Name: "Telegram", Enabled: true}
+		questions = []setupQuestion{
+			{"Telegram bot token", "", &comms.TelegramConfig.VerificationToken},
+		}
+	case setupCommsSlack:
+		comms.SlackConfig = config.SlackConfig{Name: "Slack", Enabled: true}
+		questions = []setupQuestion{
+			{"Slack bot token", "", &comms.SlackConfig.VerificationToken},
+			{"Slack channel", "general", &comms.SlackConfig.TargetChannel},
+		}
+	case setupCommsSMTP:
+		comms.SMTPConfig = config.SMTPConfig{Name: "SMTP", Enabled: true}
+		questions = []setupQuestion{
+			{"SMTP host", "", &comms.SMTPConfig.Host},
+			{"SMTP port", "587", &comms.SMTPConfig.Port},
+			{"SMTP account name", "", &comms.SMTPConfig.AccountName},
+			{"SMTP account password", "", &comms.SMTPConfig.AccountPassword},
+			{"Email from address", "", &comms.SMTPConfig.From},
+			{"Email recipients, comma separated", "", &comms.SMTPConfig.RecipientList},
+		}
+	}
+	return w.askAll(questions)
+}
+
+// password asks for the config encryption password twice until both match
+func (w *setupWizard) password() ([]byte, error) {
+	for {
+		p1, err := w.ask("Config encryption password", "")
+		if err != nil {
+			return nil, err
+		}
+		if p1 == "" {
+			fmt.Fprintln(w.out, "The password can't be empty")
+			continue
+		}
+		p2, err := w.ask("Re-enter the config encryption password", "")
+		if err != nil {
+			return nil, err
+		}
+		if p1 == p2 {
+			return []byte(p1), nil
+		}
+		fmt.Fprintln(w.out, "Passwords did not match, please try again.")
+	}
+}
+
+// ask prints a question and returns the answer, or def if it is blank
+func (w *setupWizard) ask(question, def string) (string, error) {
+	if def != "" {
+		fmt.Fprintf(w.out, "%s [%s]: ", question, def)
+	} else {
+		fmt.Fprintf(w.out, "%s: ", question)
+	}
+	if !w.in.Scan() {
+		if err := w.in.Err(); err != nil {
+			return "", err
+		}
+		return "", errSetupCancelled
+	}
+	answer := strings.TrimSpace(w.in.Text())
+	if answer == "" {
+		return def, nil
+	}
+	return answer, nil
+}
+
+// askAll asks each question in turn, storing the answers
+func (w *setupWizard) askAll(questions []setupQuestion) error {
+	for i := range questions {
+		answer, err := w.ask(questions[i].question, questions[i].def)
+		if err != nil {
+			return err
+		}
+		*questions[i].answer = answer
+	}
+	return nil
+}
+
+// confirm asks a yes or no question
+func (w *setupWizard) confirm(question string, def bool) (bool, error) {
+	d := "n"
+	if def {
+		d = "y"
+	}
+	answer, err := w.ask(question+" (y/n)", d)
+	if err != nil {
+		return false, err
+	}
+	return common.YesOrNo(answer), nil
+}
@@ -0,0 +1,148 @@
+package engine
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database"
+)
+
+// testSetupWizard returns a wizard answering with input and the test
+// config's exchange configs as defaults
+func testSetupWizard(t *testing.T, input string, out *bytes.Buffer) *setupWizard {
+	t.Helper()
+	var testCfg config.Config
+	err := testCfg.LoadConfig(config.TestFile, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &setupWizard{
+		in:  bufio.NewScanner(strings.NewReader(input)),
+		out: out,
+		exchangeConfig: func(name string) (*config.ExchangeConfig, error) {
+			exchCfg, err := testCfg.GetExchangeConfig(name)
+			if err != nil {
+				return nil, err
+			}
+			exchCfg.Enabled = false
+			return exchCfg, nil
+		},
+	}
+}
+
+func TestParseSetupExchanges(t *testing.T) {
+	t.Parallel()
+	names, err := parseSetupExchanges("1, Bitstamp,binance,")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(names) != 2 || names[0] != "binance" || names[1] != "bitstamp" {
+		t.Errorf("unexpected exchanges %v", names)
+	}
+	for _, answer := range []string{"", "0", "9999", "mtgox"} {
+		if _, err = parseSetupExchanges(answer); err == nil {
+			t.Errorf("expected an error parsing %q", answer)
+		}
+	}
+}
+
+func TestSetupWizard(t *testing.T) {
+	dir, err := ioutil.TempDir("", "setupwizard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, config.File)
+
+	answers := []string{
+		"",        // create a config
+		"binance", // exchanges
+		"key",     // API key
+		"secret",  // API secret
+		"xyz",     // not a fiat currency
+		"aud",     // fiat display currency
+		"y",       // enable the database
+		"",        // sqlite3
+		"",        // database file
+		"y",       // enable communications
+		"telegram",
+		"token",
+		"password",
+		"mismatch",
+		"password",
+		"password",
+	}
+	var out bytes.Buffer
+	w := testSetupWizard(t, strings.Join(answers, "\n")+"\n", &out)
+	err = w.run(path, "")
+	if err != nil {
+		t.Fatalf("%v, output: %s", err, out.String())
+	}
+	if !strings.Contains(out.String(), "XYZ is not a fiat currency") ||
+		!strings.Contains(out.String(), "Passwords did not match") {
+		t.Errorf("unexpected output %s", out.String())
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !config.ConfirmECS(data) {
+		t.Fatal("config was not encrypted")
+	}
+	data, err = config.DecryptConfigFile(data, []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	var cfg config.Config
+	err = json.Unmarshal(data, &cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(cfg.Exchanges) != 1 || !cfg.Exchanges[0].Enabled ||
+		cfg.Exchanges[0].API.Credentials.Key != "key" ||
+		!cfg.Exchanges[0].API.AuthenticatedSupport {
+		t.Error("unexpected exchange config")
+	}
+	if cfg.Currency.FiatDisplayCurrency != currency.AUD {
+		t.Errorf("expected fiat display currency AUD, received %s", cfg.Currency.FiatDisplayCurrency)
+	}
+	if !cfg.Database.Enabled || cfg.Database.Driver != database.DBSQLite3 {
+		t.Error("database should be enabled with sqlite3")
+	}
+	if !cfg.Communications.TelegramConfig.Enabled ||
+		cfg.Communications.TelegramConfig.VerificationToken != "token" {
+		t.Error("telegram should be enabled")
+	}
+}
+
+func TestSetupWizardDeclined(t *testing.T) {
+	dir, err := ioutil.TempDir("", "setupwizard")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, config.File)
+
+	var out bytes.Buffer
+	err = testSetupWizard(t, "n\n", &out).run(path, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testSetupWizard(t, "y\nbinance\n", &out).run(path, "")
+	if err != errSetupCancelled {
+		t.Errorf("expected %v, received %v", errSetupCancelled, err)
+	}
+	if file.Exists(path) {
+		t.Error("config should not be saved")
+	}
+}
@@ -0,0 +1,35 @@
+package engine
+
+import (
+	"bufio"
+	"errors"
+	"io"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+const (
+	setupConfigName    = "Skynet"
+	setupCommsTelegram = "telegram"
+	setupCommsSlack    = "slack"
+	setupCommsSMTP     = "smtp"
+)
+
+var errSetupCancelled = errors.New("setup cancelled, no input left")
+
+// setupWizard asks the questions needed to create a config when none exists
+type setupWizard struct {
+	in  *bufio.Scanner
+	out io.Writer
+	// exchangeConfig returns an exchange's default config with its tradable
+	// pairs
+	exchangeConfig func(name string) (*config.ExchangeConfig, error)
+}
+
+// setupQuestion is a question whose answer, or default if left blank, is
+// stored in answer
+type setupQuestion struct {
+	question string
+	def      string
+	answer   *string
+}
@@ -0,0 +1,127 @@
+package engine
+
+import (
+	"context"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/statement"
+	"github.com/thrasher-corp/gocryptotrader/gctrpc"
+)
+
+// unsupportedHistory reports whether an error only means the exchange can't
+// provide a kind of account history, which is left out of a statement
+func unsupportedHistory(err error) bool {
+	return err == common.ErrFunctionNotSupported ||
+		err == common.ErrNotYetImplemented
+}
+
+// buildAccountStatement merges an exchange account's deposits, withdrawals,
+// trades, fees and perpetual futures funding between start and end into a
+// statement reconciled against the balances currently reported by the
+// exchange
+func buildAccountStatement(ctx context.Context, exch exchange.IBotExchange, start, end time.Time, opening map[currency.Code]decimal.Decimal, tolerance decimal.Decimal) (*statement.Statement, error) {
+	b, err := statement.NewBuilder(exch.GetName(), start, end)
+	if err != nil {
+		return nil, err
+	}
+	for c, amount := range opening {
+		b.SetOpeningBalance(c, amount)
+	}
+
+	transfers, err := exch.GetFundingHistory(ctx)
+	if err != nil && !unsupportedHistory(err) {
+		return nil, err
+	}
+	b.AddFundHistory(transfers)
+
+	orders, err := exch.GetOrderHistory(ctx, &order.GetOrdersRequest{
+		OrderType:  order.AnyType,
+		OrderSide:  order.AnySide,
+		StartTicks: start,
+		EndTicks:   end,
+	})
+	if err != nil && !unsupportedHistory(err) {
+		return nil, err
+	}
+	b.AddOrders(orders)
+
+	if exch.SupportsAsset(asset.PerpetualContract) {
+		pairs := exch.GetEnabledPairs(asset.PerpetualContract)
+		for i := range pairs {
+			payments, err := exch.GetFundingPayments(ctx, pairs[i], asset.PerpetualContract, start, end)
+			if err != nil {
+				if unsupportedHistory(err) {
+					break
+				}
+				return nil, err
+			}
+			b.AddFundingPayments(payments)
+		}
+	}
+
+	reported, err := exch.UpdateAccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return b.Build(&reported, tolerance), nil
+}
+
+// statementResponse converts a statement into its RPC response
+func statementResponse(s *statement.Statement) *gctrpc.GetAccountStatementResponse {
+	resp := &gctrpc.GetAccountStatementResponse{
+		Exchange:  s.Exchange,
+		StartDate: s.Start.UTC().Format(audit.TableTimeFormat),
+		EndDate:   s.End.UTC().Format(audit.TableTimeFormat),
+	}
+	for i := range s.Entries {
+		resp.Entries = append(resp.Entries, &gctrpc.StatementEntry{
+			Timestamp:   s.Entries[i].Timestamp.UTC().Format(audit.TableTimeFormat),
+			Type:        string(s.Entries[i].Type),
+			Currency:    s.Entries[i].Currency.String(),
+			Amount:      s.Entries[i].Amount.Float64(),
+			Balance:     s.Entries[i].Balance.Float64(),
+			Reference:   s.Entries[i].Reference,
+			Description: s.Entries[i].Description,
+		})
+	}
+	for i := range s.Balances {
+		resp.Balances = append(resp.Balances, &gctrpc.StatementBalance{
+			Currency:    s.Balances[i].Currency.String(),
+			Opening:     s.Balances[i].Opening.Float64(),
+			Deposits:    s.Balances[i].Deposits.Float64(),
+			Withdrawals: s.Balances[i].Withdrawals.Float64(),
+			Trades:      s.Balances[i].Trades.Float64(),
+			Fees:        s.Balances[i].Fees.Float64(),
+			Funding:     s.Balances[i].Funding.Float64(),
+			Closing:     s.Balances[i].Closing.Float64(),
+			Reported:    s.Balances[i].Reported.Float64(),
+			Discrepancy: s.Balances[i].Discrepancy.Float64(),
+			Reconciled:  s.Balances[i].Reconciled,
+		})
+	}
+	return resp
+}
+
+// valueStatement values a statement's closing balances and its PnL, being the
+// trades, fees and funding of each currency, in each fiat currency at current
+// prices
+func valueStatement(resp *gctrpc.GetAccountStatementResponse, s *statement.Statement, v *fiatValuer) {
+	resp.FiatCurrencies = v.fiats.Strings()
+	resp.ClosingFiatTotals = make(map[string]float64)
+	resp.PnlFiatTotals = make(map[string]float64)
+	for i := range s.Balances {
+		b := &s.Balances[i]
+		resp.Balances[i].ClosingFiat = v.value(b.Currency, b.Closing.Float64())
+		resp.Balances[i].PnlFiat = v.value(b.Currency, b.Trades.Add(b.Fees).Add(b.Funding).Float64())
+		addFiatValues(resp.ClosingFiatTotals, resp.Balances[i].ClosingFiat)
+		addFiatValues(resp.PnlFiatTotals, resp.Balances[i].PnlFiat)
+	}
+	resp.Unpriced = v.unpriced
+}
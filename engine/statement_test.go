@@ -0,0 +1,101 @@
+package engine
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/statement"
+)
+
+func TestUnsupportedHistory(t *testing.T) {
+	if !unsupportedHistory(common.ErrFunctionNotSupported) ||
+		!unsupportedHistory(common.ErrNotYetImplemented) {
+		t.Error("expected unsupported wrapper functions to be skipped")
+	}
+	if unsupportedHistory(errors.New("rate limited")) {
+		t.Error("expected other errors to fail the statement")
+	}
+}
+
+func TestStatementResponse(t *testing.T) {
+	start := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	b, err := statement.NewBuilder("Bitmex", start, start.AddDate(0, 1, 0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.AddFundHistory([]exchange.FundHistory{
+		{
+			Timestamp:    start.Add(time.Hour),
+			Currency:     "BTC",
+			Amount:       1,
+			TransferType: "deposit",
+			TransferID:   "d1",
+		},
+	})
+	s := b.Build(&account.Holdings{
+		Accounts: []account.SubAccount{
+			{Currencies: []account.Balance{{CurrencyName: currency.BTC, TotalValue: decimal.NewFromInt(2)}}},
+		},
+	}, decimal.Decimal{})
+
+	resp := statementResponse(s)
+	if resp.Exchange != "Bitmex" || resp.StartDate != "2020-03-01 00:00:00" || resp.EndDate != "2020-04-01 00:00:00" {
+		t.Errorf("unexpected response %+v", resp)
+	}
+	if len(resp.Entries) != 1 || resp.Entries[0].Type != "DEPOSIT" ||
+		resp.Entries[0].Amount != 1 || resp.Entries[0].Timestamp != "2020-03-01 01:00:00" {
+		t.Errorf("unexpected entries %+v", resp.Entries)
+	}
+	if len(resp.Balances) != 1 || resp.Balances[0].Reconciled ||
+		resp.Balances[0].Discrepancy != 1 || resp.Balances[0].Reported != 2 {
+		t.Errorf("unexpected balances %+v", resp.Balances)
+	}
+}
+
+func TestValueStatement(t *testing.T) {
+	start := time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	s := &statement.Statement{
+		Exchange: "Bitmex",
+		Start:    start,
+		End:      start.AddDate(0, 1, 0),
+		Balances: []statement.Balance{
+			{
+				Currency: currency.BTC,
+				Opening:  decimal.NewFromInt(1),
+				Trades:   decimal.NewFromFloat(0.5),
+				Fees:     decimal.NewFromFloat(-0.1),
+				Funding:  decimal.NewFromFloat(0.1),
+				Closing:  decimal.NewFromFloat(1.5),
+			},
+			{
+				Currency: currency.XRP,
+				Closing:  decimal.NewFromInt(100),
+			},
+		},
+	}
+	resp := statementResponse(s)
+	valueStatement(resp, s, testFiatValuer(currency.USD, currency.AUD))
+
+	if len(resp.FiatCurrencies) != 2 || resp.FiatCurrencies[0] != "USD" || resp.FiatCurrencies[1] != "AUD" {
+		t.Errorf("unexpected fiat currencies %v", resp.FiatCurrencies)
+	}
+	btc := resp.Balances[0]
+	if btc.ClosingFiat["USD"] != 15000 || btc.ClosingFiat["AUD"] != 22500 {
+		t.Errorf("unexpected closing values %v", btc.ClosingFiat)
+	}
+	if btc.PnlFiat["USD"] != 5000 || btc.PnlFiat["AUD"] != 7500 {
+		t.Errorf("unexpected PnL values %v", btc.PnlFiat)
+	}
+	if resp.ClosingFiatTotals["USD"] != 15000 || resp.PnlFiatTotals["AUD"] != 7500 {
+		t.Errorf("unexpected totals %v %v", resp.ClosingFiatTotals, resp.PnlFiatTotals)
+	}
+	if len(resp.Unpriced) != 1 || resp.Unpriced[0] != "XRP" {
+		t.Errorf("expected XRP to be unpriced, received %v", resp.Unpriced)
+	}
+}
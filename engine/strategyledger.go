@@ -0,0 +1,364 @@
+package engine
+
+import (
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// load sets up the ledgers of the enabled strategies, restoring their balances
+// and open orders from the state file at path when they were saved and
+// otherwise seeding them from their allocations
+func (l *strategyLedgers) load(path string, cfg []config.StrategyConfig) error {
+	var state strategyState
+	if path != "" {
+		data, err := ioutil.ReadFile(path)
+		switch {
+		case err == nil:
+			if err = json.Unmarshal(data, &state); err != nil {
+				return fmt.Errorf("unable to read strategy state %s: %v", path, err)
+			}
+		case !os.IsNotExist(err):
+			return err
+		}
+	}
+
+	l.m.Lock()
+	defer l.m.Unlock()
+	l.strategies = make(map[string]bool)
+	l.balances = make(map[strategyKey]*strategyBalance)
+	l.orders = make(map[string]*strategyReservation)
+	for i := range cfg {
+		if cfg[i].Enabled {
+			l.strategies[cfg[i].ID] = true
+		}
+	}
+
+	saved := make(map[string]bool)
+	for i := range state.Balances {
+		k := state.Balances[i].strategyKey
+		if !l.strategies[k.Strategy] {
+			log.Warnf(log.OrderMgr, "Order manager: Strategy %s is no longer enabled, dropping its %s %s balance.\n",
+				k.Strategy, k.Exchange, k.Currency)
+			continue
+		}
+		b := state.Balances[i].strategyBalance
+		l.balances[k] = &b
+		saved[k.Strategy] = true
+	}
+	for _, r := range state.Orders {
+		if l.strategies[r.Strategy] {
+			l.orders[orderKey(r.Exchange, r.OrderID)] = r
+		}
+	}
+	for i := range cfg {
+		if !cfg[i].Enabled || saved[cfg[i].ID] {
+			continue
+		}
+		for _, a := range cfg[i].Allocations {
+			b := l.balance(cfg[i].ID, a.Exchange, a.Currency)
+			b.Total = b.Total.Add(decimal.NewFromFloat(a.Amount))
+		}
+	}
+	return nil
+}
+
+// save writes the strategy balances and open orders to path
+func (l *strategyLedgers) save(path string) error {
+	l.m.Lock()
+	var state strategyState
+	for k, b := range l.balances {
+		state.Balances = append(state.Balances, strategyStateBalance{strategyKey: k, strategyBalance: *b})
+	}
+	for _, r := range l.orders {
+		state.Orders = append(state.Orders, r)
+	}
+	l.m.Unlock()
+	if len(state.Balances) == 0 && len(state.Orders) == 0 {
+		return nil
+	}
+
+	data, err := json.MarshalIndent(state, "", " ")
+	if err != nil {
+		return err
+	}
+	err = file.Write(path, data)
+	if err != nil {
+		return err
+	}
+	log.Debugf(log.OrderMgr, "Order manager: Strategy ledgers saved to %s\n", path)
+	return nil
+}
+
+// balance returns a strategy's balance of a currency on an exchange, adding
+// it if it doesn't exist. The lock must be held
+func (l *strategyLedgers) balance(strategy, exchName, code string) *strategyBalance {
+	k := strategyKey{
+		Strategy: strategy,
+		Exchange: strings.ToLower(exchName),
+		Currency: strings.ToUpper(code),
+	}
+	b, ok := l.balances[k]
+	if !ok {
+		b = &strategyBalance{}
+		l.balances[k] = b
+	}
+	return b
+}
+
+// reserve reserves the cost of an order from its strategy's available
+// balance, the quote currency for buys and the base currency for sells.
+// Buys are reserved at price, which is the order price or the latest ticker
+// price for market orders
+func (l *strategyLedgers) reserve(exchName string, s *order.Submit, price decimal.Decimal) (*strategyReservation, error) {
+	r := &strategyReservation{
+		Strategy: s.StrategyID,
+		Exchange: exchName,
+		Base:     s.Pair.Base.Upper().String(),
+		Quote:    s.Pair.Quote.Upper().String(),
+		Buy:      s.OrderSide == order.Buy || s.OrderSide == order.Bid,
+		Price:    price,
+		Amount:   s.Amount,
+	}
+	spend := r.Base
+	r.Remaining = s.Amount
+	if r.Buy {
+		if price.Sign() <= 0 {
+			return nil, errStrategyPriceUnknown
+		}
+		spend = r.Quote
+		r.Remaining = s.Amount.Mul(price)
+	}
+
+	l.m.Lock()
+	defer l.m.Unlock()
+	if !l.strategies[s.StrategyID] {
+		return nil, fmt.Errorf("%v: %s", errStrategyNotFound, s.StrategyID)
+	}
+	b := l.balance(r.Strategy, exchName, spend)
+	available := b.Total.Sub(b.Reserved)
+	if available.LessThan(r.Remaining) {
+		return nil, fmt.Errorf("%v: %s has %v %s available on %s, order requires %v",
+			ErrStrategyInsufficientBalance, r.Strategy, available, spend, exchName, r.Remaining)
+	}
+	b.Reserved = b.Reserved.Add(r.Remaining)
+	return r, nil
+}
+
+// release returns the balance still reserved by an order to its strategy's
+// available balance. The lock must be held
+func (l *strategyLedgers) release(r *strategyReservation) {
+	spend := r.Base
+	if r.Buy {
+		spend = r.Quote
+	}
+	b := l.balance(r.Strategy, r.Exchange, spend)
+	b.Reserved = b.Reserved.Sub(r.Remaining)
+	r.Remaining = decimal.Zero
+}
+
+// cancel releases the reservation of an order which wasn't placed
+func (l *strategyLedgers) cancel(r *strategyReservation) {
+	if r == nil {
+		return
+	}
+	l.m.Lock()
+	l.release(r)
+	l.m.Unlock()
+}
+
+// track holds the reservation of a placed order until it closes
+func (l *strategyLedgers) track(orderID string, r *strategyReservation) {
+	if r == nil {
+		return
+	}
+	l.m.Lock()
+	r.OrderID = orderID
+	l.orders[orderKey(r.Exchange, orderID)] = r
+	l.m.Unlock()
+}
+
+// applyExecution applies a fill of a strategy order to the strategy's
+// balances, closing the order once it is fully filled. It returns false if
+// the order isn't a strategy order or the fill was already applied
+func (l *strategyLedgers) applyExecution(e *order.ExecutionReport) bool {
+	l.m.Lock()
+	defer l.m.Unlock()
+	key := orderKey(e.Exchange, e.OrderID)
+	r, ok := l.orders[key]
+	if !ok {
+		return false
+	}
+	for i := range r.Trades {
+		if r.Trades[i] == e.TradeID {
+			return false
+		}
+	}
+	r.Trades = append(r.Trades, e.TradeID)
+	l.fill(r, e.Amount, e.Price)
+	if !e.Fee.IsZero() && !e.FeeCurrency.IsEmpty() {
+		b := l.balance(r.Strategy, r.Exchange, e.FeeCurrency.String())
+		b.Total = b.Total.Sub(e.Fee)
+	}
+	if !r.Executed.LessThan(r.Amount) {
+		l.release(r)
+		delete(l.orders, key)
+	}
+	return true
+}
+
+// settle brings a strategy order in line with its state on the exchange,
+// applying any executed amount its fills haven't at the order price, and
+// closing it if it is closed
+func (l *strategyLedgers) settle(d *order.Detail) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	key := orderKey(d.Exchange, d.ID)
+	r, ok := l.orders[key]
+	if !ok {
+		return
+	}
+	if unapplied := d.ExecutedAmount.Sub(r.Executed); unapplied.Sign() > 0 {
+		price := d.Price
+		if price.Sign() <= 0 {
+			price = r.Price
+		}
+		l.fill(r, unapplied, price)
+	}
+	if isOrderClosed(d.Status) {
+		l.release(r)
+		delete(l.orders, key)
+	}
+}
+
+// close releases the reservation of a strategy order which has been
+// cancelled
+func (l *strategyLedgers) close(exchName, orderID string) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	key := orderKey(exchName, orderID)
+	if r, ok := l.orders[key]; ok {
+		l.release(r)
+		delete(l.orders, key)
+	}
+}
+
+// strategyOf returns the strategy of an open strategy order, or an empty
+// string if it isn't one
+func (l *strategyLedgers) strategyOf(exchName, orderID string) string {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if r, ok := l.orders[orderKey(exchName, orderID)]; ok {
+		return r.Strategy
+	}
+	return ""
+}
+
+// fill moves a filled amount at a price between the balances of an order's
+// currencies, releasing the part of the reservation it used. The lock must be
+// held
+func (l *strategyLedgers) fill(r *strategyReservation, amount, price decimal.Decimal) {
+	base := l.balance(r.Strategy, r.Exchange, r.Base)
+	quote := l.balance(r.Strategy, r.Exchange, r.Quote)
+	cost := amount.Mul(price)
+	var used decimal.Decimal
+	if r.Buy {
+		used = minDecimal(amount.Mul(r.Price), r.Remaining)
+		quote.Total = quote.Total.Sub(cost)
+		quote.Reserved = quote.Reserved.Sub(used)
+		base.Total = base.Total.Add(amount)
+	} else {
+		used = minDecimal(amount, r.Remaining)
+		base.Total = base.Total.Sub(amount)
+		base.Reserved = base.Reserved.Sub(used)
+		quote.Total = quote.Total.Add(cost)
+	}
+	r.Remaining = r.Remaining.Sub(used)
+	r.Executed = r.Executed.Add(amount)
+}
+
+// Ledgers returns the virtual balances of a strategy on each exchange, or of
+// every strategy if none is given
+func (l *strategyLedgers) Ledgers(strategy string) ([]StrategyLedger, error) {
+	l.m.Lock()
+	defer l.m.Unlock()
+	if strategy != "" && !l.strategies[strategy] {
+		return nil, fmt.Errorf("%v: %s", errStrategyNotFound, strategy)
+	}
+	ledgers := make(map[[2]string]*StrategyLedger)
+	for k, b := range l.balances {
+		if strategy != "" && k.Strategy != strategy {
+			continue
+		}
+		id := [2]string{k.Strategy, k.Exchange}
+		ledger, ok := ledgers[id]
+		if !ok {
+			ledger = &StrategyLedger{Strategy: k.Strategy, Exchange: k.Exchange}
+			ledgers[id] = ledger
+		}
+		ledger.Balances = append(ledger.Balances, StrategyBalance{
+			Currency:  k.Currency,
+			Total:     b.Total.Float64(),
+			Reserved:  b.Reserved.Float64(),
+			Available: b.Total.Sub(b.Reserved).Float64(),
+		})
+	}
+
+	resp := make([]StrategyLedger, 0, len(ledgers))
+	for _, ledger := range ledgers {
+		sort.Slice(ledger.Balances, func(i, j int) bool {
+			return ledger.Balances[i].Currency < ledger.Balances[j].Currency
+		})
+		resp = append(resp, *ledger)
+	}
+	sort.Slice(resp, func(i, j int) bool {
+		if resp[i].Strategy != resp[j].Strategy {
+			return resp[i].Strategy < resp[j].Strategy
+		}
+		return resp[i].Exchange < resp[j].Exchange
+	})
+	return resp, nil
+}
+
+// strategyOrderPrice returns the price to reserve a strategy order's cost at,
+// the order price or for market orders the latest ticker price of the side
+// it takes
+func strategyOrderPrice(exchName string, s *order.Submit) decimal.Decimal {
+	if s.Price.Sign() > 0 {
+		return s.Price
+	}
+	a := s.AssetType
+	if a == "" {
+		a = asset.Spot
+	}
+	t, err := ticker.GetTicker(exchName, s.Pair, a)
+	if err != nil {
+		return decimal.Zero
+	}
+	price := t.Bid
+	if s.OrderSide == order.Buy || s.OrderSide == order.Bid {
+		price = t.Ask
+	}
+	if price <= 0 {
+		price = t.Last
+	}
+	return decimal.NewFromFloat(price)
+}
+
+func minDecimal(a, b decimal.Decimal) decimal.Decimal {
+	if a.LessThan(b) {
+		return a
+	}
+	return b
+}
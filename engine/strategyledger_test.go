@@ -0,0 +1,213 @@
+package engine
+
+import (
+	"errors"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var strategyTestConfig = []config.StrategyConfig{
+	{ID: "momentum", Enabled: true, Allocations: []config.StrategyAllocationConfig{
+		{Exchange: "Bitstamp", Currency: "usd", Amount: 1000},
+		{Exchange: "Bitstamp", Currency: "BTC", Amount: 1},
+	}},
+	{ID: "meanrevert", Enabled: true, Allocations: []config.StrategyAllocationConfig{
+		{Exchange: "Bitstamp", Currency: "USD", Amount: 500},
+	}},
+	{ID: "disabled", Allocations: []config.StrategyAllocationConfig{
+		{Exchange: "Bitstamp", Currency: "USD", Amount: 500},
+	}},
+}
+
+func strategyTestSubmit(strategy string, side order.Side, price, amount float64) *order.Submit {
+	return &order.Submit{
+		Pair:       currency.NewPair(currency.BTC, currency.USD),
+		OrderType:  order.Limit,
+		OrderSide:  side,
+		Price:      decimal.NewFromFloat(price),
+		Amount:     decimal.NewFromFloat(amount),
+		StrategyID: strategy,
+	}
+}
+
+// strategyTestBalance returns a strategy's balance of a currency on Bitstamp
+func strategyTestBalance(t *testing.T, l *strategyLedgers, strategy, code string) StrategyBalance {
+	t.Helper()
+	ledgers, err := l.Ledgers(strategy)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range ledgers {
+		for _, b := range ledgers[i].Balances {
+			if ledgers[i].Exchange == "bitstamp" && b.Currency == code {
+				return b
+			}
+		}
+	}
+	return StrategyBalance{}
+}
+
+func TestStrategyLedgersReserve(t *testing.T) {
+	var l strategyLedgers
+	if err := l.load("", strategyTestConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	buy := strategyTestSubmit("momentum", order.Buy, 10000, 0.06)
+	r, err := l.reserve("Bitstamp", buy, buy.Price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if b := strategyTestBalance(t, &l, "momentum", "USD"); b.Reserved != 600 || b.Available != 400 {
+		t.Errorf("expected 600 USD reserved and 400 available, received %+v", b)
+	}
+	// the strategy can't spend more than it has available, even though the
+	// other strategy's balance is on the same account
+	_, err = l.reserve("Bitstamp", buy, buy.Price)
+	if err == nil || !strings.HasPrefix(err.Error(), ErrStrategyInsufficientBalance.Error()) {
+		t.Errorf("expected %v, received %v", ErrStrategyInsufficientBalance, err)
+	}
+	if _, err = l.reserve("Bitstamp", strategyTestSubmit("meanrevert", order.Buy, 10000, 0.05), decimal.NewFromInt(10000)); err != nil {
+		t.Errorf("expected meanrevert's own balance to cover its order, received %v", err)
+	}
+	if _, err = l.reserve("Bitstamp", strategyTestSubmit("disabled", order.Buy, 1, 1), decimal.NewFromInt(1)); err == nil {
+		t.Error("expected disabled strategies to be unable to reserve")
+	}
+	if _, err = l.reserve("Bitstamp", strategyTestSubmit("momentum", order.Buy, 0, 0.01), decimal.Zero); !errors.Is(err, errStrategyPriceUnknown) {
+		t.Errorf("expected %v, received %v", errStrategyPriceUnknown, err)
+	}
+
+	l.cancel(r)
+	if b := strategyTestBalance(t, &l, "momentum", "USD"); b.Reserved != 0 || b.Available != 1000 {
+		t.Errorf("expected the reservation to be released, received %+v", b)
+	}
+}
+
+func TestStrategyLedgersFills(t *testing.T) {
+	var l strategyLedgers
+	if err := l.load("", strategyTestConfig); err != nil {
+		t.Fatal(err)
+	}
+
+	buy := strategyTestSubmit("momentum", order.Buy, 10000, 0.05)
+	r, err := l.reserve("Bitstamp", buy, buy.Price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.track("1", r)
+	e := &order.ExecutionReport{
+		Exchange:    "Bitstamp",
+		OrderID:     "1",
+		TradeID:     "t1",
+		Price:       decimal.NewFromInt(9000),
+		Amount:      decimal.NewFromFloat(0.02),
+		Fee:         decimal.NewFromFloat(0.5),
+		FeeCurrency: currency.USD,
+	}
+	if !l.applyExecution(e) {
+		t.Fatal("expected the fill to be applied")
+	}
+	if l.applyExecution(e) {
+		t.Error("expected a repeated fill to be ignored")
+	}
+	// 0.02 bought for 180 USD plus a 0.5 USD fee, releasing the 200 USD
+	// reserved for it
+	if b := strategyTestBalance(t, &l, "momentum", "USD"); b.Total != 819.5 || b.Reserved != 300 {
+		t.Errorf("expected 819.5 USD with 300 reserved, received %+v", b)
+	}
+	if b := strategyTestBalance(t, &l, "momentum", "BTC"); b.Total != 1.02 {
+		t.Errorf("expected 1.02 BTC, received %+v", b)
+	}
+
+	// the remainder is filled at the order price when reconciled, closing
+	// the order and releasing what is left of its reservation
+	l.settle(&order.Detail{
+		Exchange:       "Bitstamp",
+		ID:             "1",
+		Price:          decimal.NewFromInt(10000),
+		Status:         order.Filled,
+		ExecutedAmount: decimal.NewFromFloat(0.05),
+	})
+	if b := strategyTestBalance(t, &l, "momentum", "USD"); b.Total != 519.5 || b.Reserved != 0 {
+		t.Errorf("expected 519.5 USD with none reserved, received %+v", b)
+	}
+	if len(l.orders) != 0 {
+		t.Error("expected the filled order to be closed")
+	}
+
+	sell := strategyTestSubmit("momentum", order.Sell, 11000, 1)
+	r, err = l.reserve("Bitstamp", sell, sell.Price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.track("2", r)
+	if b := strategyTestBalance(t, &l, "momentum", "BTC"); b.Reserved != 1 {
+		t.Errorf("expected 1 BTC reserved, received %+v", b)
+	}
+	l.close("bitstamp", "2")
+	if b := strategyTestBalance(t, &l, "momentum", "BTC"); b.Reserved != 0 {
+		t.Errorf("expected the cancelled sell to release its BTC, received %+v", b)
+	}
+}
+
+func TestStrategyLedgersState(t *testing.T) {
+	dir, err := ioutil.TempDir("", "strategies")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, strategyStateFile)
+	var l strategyLedgers
+	if err = l.load(path, strategyTestConfig); err != nil {
+		t.Fatal(err)
+	}
+	buy := strategyTestSubmit("momentum", order.Buy, 10000, 0.01)
+	r, err := l.reserve("Bitstamp", buy, buy.Price)
+	if err != nil {
+		t.Fatal(err)
+	}
+	l.track("1", r)
+	if err = l.save(path); err != nil {
+		t.Fatal(err)
+	}
+
+	// saved balances are restored instead of the allocations, and strategies
+	// no longer enabled are dropped
+	cfg := []config.StrategyConfig{
+		{ID: "momentum", Enabled: true, Allocations: []config.StrategyAllocationConfig{
+			{Exchange: "Bitstamp", Currency: "USD", Amount: 5000},
+		}},
+		{ID: "breakout", Enabled: true, Allocations: []config.StrategyAllocationConfig{
+			{Exchange: "Kraken", Currency: "EUR", Amount: 100},
+		}},
+	}
+	var restored strategyLedgers
+	if err = restored.load(path, cfg); err != nil {
+		t.Fatal(err)
+	}
+	if b := strategyTestBalance(t, &restored, "momentum", "USD"); b.Total != 1000 || b.Reserved != 100 {
+		t.Errorf("expected the saved USD balance, received %+v", b)
+	}
+	if _, ok := restored.orders["bitstamp:1"]; !ok {
+		t.Error("expected the open order to be restored")
+	}
+	ledgers, err := restored.Ledgers("")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(ledgers) != 2 || ledgers[0].Strategy != "breakout" || ledgers[1].Strategy != "momentum" {
+		t.Errorf("expected the breakout and momentum ledgers, received %+v", ledgers)
+	}
+	_, err = restored.Ledgers("meanrevert")
+	if err == nil || !strings.HasPrefix(err.Error(), errStrategyNotFound.Error()) {
+		t.Errorf("expected meanrevert to be dropped, received %v", err)
+	}
+}
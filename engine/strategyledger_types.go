@@ -0,0 +1,93 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+)
+
+// strategyStateFile is the file in the data directory the strategy ledgers
+// are written to on shutdown and read from on startup
+const strategyStateFile = "strategies.json"
+
+var (
+	// ErrStrategyInsufficientBalance is returned when a strategy's available
+	// virtual balance can't cover an order
+	ErrStrategyInsufficientBalance = errors.New("strategy has insufficient available balance")
+	errStrategyNotFound            = errors.New("strategy not found")
+	errStrategyPriceUnknown        = errors.New("unable to price the order to reserve the strategy's balance")
+)
+
+// strategyLedgers holds the virtual balances of each strategy sharing the
+// exchange accounts. Orders tagged with a strategy reserve their cost from
+// its balances when submitted, and fills move the balances between the
+// order's currencies, so concurrent strategies can't spend the same funds
+type strategyLedgers struct {
+	m          sync.Mutex
+	strategies map[string]bool
+	balances   map[strategyKey]*strategyBalance
+	// orders holds the reservation of each open strategy order, keyed by
+	// exchange and order ID
+	orders map[string]*strategyReservation
+}
+
+// strategyKey identifies a strategy's balance of a currency on an exchange
+type strategyKey struct {
+	Strategy string `json:"strategy"`
+	Exchange string `json:"exchange"`
+	Currency string `json:"currency"`
+}
+
+// strategyBalance is a strategy's virtual balance of a currency, reserved
+// being the part held by its open orders
+type strategyBalance struct {
+	Total    decimal.Decimal `json:"total"`
+	Reserved decimal.Decimal `json:"reserved"`
+}
+
+// strategyReservation is the balance reserved by an open strategy order and
+// the fills applied to it
+type strategyReservation struct {
+	Strategy string   `json:"strategy"`
+	Exchange string   `json:"exchange"`
+	OrderID  string   `json:"orderID"`
+	Base     string   `json:"base"`
+	Quote    string   `json:"quote"`
+	Buy      bool     `json:"buy"`
+	Trades   []string `json:"trades,omitempty"`
+	// Price is the price the cost of a buy was reserved at, and fills without
+	// a price are applied at
+	Price     decimal.Decimal `json:"price"`
+	Amount    decimal.Decimal `json:"amount"`
+	Remaining decimal.Decimal `json:"remaining"`
+	Executed  decimal.Decimal `json:"executed"`
+}
+
+// strategyState is what is written to the strategy state file
+type strategyState struct {
+	Balances []strategyStateBalance `json:"balances"`
+	Orders   []*strategyReservation `json:"orders"`
+}
+
+// strategyStateBalance is a strategy balance as written to the state file
+type strategyStateBalance struct {
+	strategyKey
+	strategyBalance
+}
+
+// StrategyLedger is a strategy's virtual balances on an exchange
+type StrategyLedger struct {
+	Strategy string
+	Exchange string
+	Balances []StrategyBalance
+}
+
+// StrategyBalance is a strategy's virtual balance of a currency. Available is
+// the total less what is reserved by the strategy's open orders
+type StrategyBalance struct {
+	Currency  string
+	Total     float64
+	Reserved  float64
+	Available float64
+}
@@ -398,17 +398,17 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 											if e.Cfg.Verbose {
 												log.Debugf(log.SyncMgr, "%s Init'ing REST ticker batching\n", exchangeName)
 											}
-											result, err = exchanges[x].UpdateTicker(c.Pair, c.AssetType)
+											result, err = exchanges[x].UpdateTicker(Bot.Context(), c.Pair, c.AssetType)
 											e.tickerBatchLastRequested[exchangeName] = time.Now()
 											e.mux.Unlock()
 										} else {
 											if e.Cfg.Verbose {
 												log.Debugf(log.SyncMgr, "%s Using recent batching cache\n", exchangeName)
 											}
-											result, err = exchanges[x].FetchTicker(c.Pair, c.AssetType)
+											result, err = exchanges[x].FetchTicker(Bot.Context(), c.Pair, c.AssetType)
 										}
 									} else {
-										result, err = exchanges[x].UpdateTicker(c.Pair, c.AssetType)
+										result, err = exchanges[x].UpdateTicker(Bot.Context(), c.Pair, c.AssetType)
 									}
 									printTickerSummary(result, c.Pair, c.AssetType, exchangeName, "REST", err)
 									if err == nil {
@@ -449,7 +449,7 @@ func (e *ExchangeCurrencyPairSyncer) worker() {
 								}
 
 								e.setProcessing(c.Exchange, c.Pair, c.AssetType, SyncItemOrderbook, true)
-								result, err := exchanges[x].UpdateOrderbook(c.Pair, c.AssetType)
+								result, err := exchanges[x].UpdateOrderbook(Bot.Context(), c.Pair, c.AssetType)
 								printOrderbookSummary(result, c.Pair, c.AssetType, exchangeName, "REST", err)
 								if err == nil {
 									//nolint:gocritic Bot.CommsRelayer.StageOrderbookData(exchangeName, c.AssetType, result)
@@ -505,7 +505,7 @@ func (e *ExchangeCurrencyPairSyncer) Start() {
 			}
 
 			if !ws.IsConnected() && !ws.IsConnecting() {
-				go WebsocketDataHandler(ws)
+				superviseWebsocketDataHandler(ws)
 
 				err = ws.Connect()
 				if err != nil {
@@ -589,7 +589,7 @@ func (e *ExchangeCurrencyPairSyncer) Start() {
 	}
 
 	for i := 0; i < e.Cfg.NumWorkers; i++ {
-		go e.worker()
+		supervise("exchange sync manager", e.worker)
 	}
 }
 
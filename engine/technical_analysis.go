@@ -0,0 +1,154 @@
+package engine
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common/indicators"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+// Supported technical analysis indicators
+const (
+	indicatorSMA    = "sma"
+	indicatorEMA    = "ema"
+	indicatorRSI    = "rsi"
+	indicatorMACD   = "macd"
+	indicatorBBands = "bbands"
+	indicatorATR    = "atr"
+
+	defaultTechnicalAnalysisCount = 100
+)
+
+// indicatorSeries is a named indicator output aligned with its candles
+type indicatorSeries struct {
+	name   string
+	values []float64
+}
+
+// sortCandles orders candles oldest first as exchanges may return them newest
+// first
+func sortCandles(candles []exchange.Candle) {
+	sort.Slice(candles, func(i, j int) bool {
+		return candles[i].Time < candles[j].Time
+	})
+}
+
+// mergeStreamedCandle updates the downloaded candles with the latest candle
+// stored from the websocket kline stream, replacing the candle for the same
+// period or appending it when it is newer. Candles must be sorted oldest first
+func mergeStreamedCandle(candles []exchange.Candle, c *kline.Candle) []exchange.Candle {
+	if c == nil || c.StartTime.IsZero() {
+		return candles
+	}
+	streamed := exchange.Candle{
+		Time:   c.StartTime.Unix(),
+		Low:    c.Low,
+		High:   c.High,
+		Open:   c.Open,
+		Close:  c.Close,
+		Volume: c.Volume,
+	}
+	if len(candles) == 0 {
+		return append(candles, streamed)
+	}
+	last := len(candles) - 1
+	switch {
+	case candles[last].Time == streamed.Time:
+		candles[last] = streamed
+	case candles[last].Time < streamed.Time:
+		candles = append(candles, streamed)
+	}
+	return candles
+}
+
+// calculateIndicator computes an indicator over candles sorted oldest first
+// and derives a simple signal from its latest values. Period is ignored by
+// MACD which uses the standard 12, 26 and 9 periods
+func calculateIndicator(candles []exchange.Candle, indicator string, period int) ([]indicatorSeries, indicators.Signal, error) {
+	closePrices := make([]float64, len(candles))
+	for i := range candles {
+		closePrices[i] = candles[i].Close
+	}
+
+	switch strings.ToLower(indicator) {
+	case indicatorSMA, indicatorEMA:
+		calc := indicators.SMA
+		if strings.EqualFold(indicator, indicatorEMA) {
+			calc = indicators.EMA
+		}
+		ma, err := calc(closePrices, period)
+		if err != nil {
+			return nil, "", err
+		}
+		return []indicatorSeries{{name: strings.ToLower(indicator), values: ma}},
+			indicators.CrossSignal(closePrices, ma), nil
+	case indicatorRSI:
+		rsi, err := indicators.RSI(closePrices, period)
+		if err != nil {
+			return nil, "", err
+		}
+		return []indicatorSeries{{name: indicatorRSI, values: rsi}},
+			indicators.RSISignal(rsi), nil
+	case indicatorMACD:
+		macd, signal, hist, err := indicators.MACD(closePrices,
+			indicators.DefaultMACDFast,
+			indicators.DefaultMACDSlow,
+			indicators.DefaultMACDSignal)
+		if err != nil {
+			return nil, "", err
+		}
+		return []indicatorSeries{
+				{name: "macd", values: macd},
+				{name: "signal", values: signal},
+				{name: "histogram", values: hist},
+			},
+			indicators.CrossSignal(macd, signal), nil
+	case indicatorBBands:
+		upper, middle, lower, err := indicators.BollingerBands(closePrices,
+			period,
+			indicators.DefaultBBandsDeviation)
+		if err != nil {
+			return nil, "", err
+		}
+		return []indicatorSeries{
+				{name: "upper", values: upper},
+				{name: "middle", values: middle},
+				{name: "lower", values: lower},
+			},
+			indicators.BandSignal(closePrices, upper, lower), nil
+	case indicatorATR:
+		high := make([]float64, len(candles))
+		low := make([]float64, len(candles))
+		for i := range candles {
+			high[i] = candles[i].High
+			low[i] = candles[i].Low
+		}
+		atr, err := indicators.ATR(high, low, closePrices, period)
+		if err != nil {
+			return nil, "", err
+		}
+		return []indicatorSeries{{name: indicatorATR, values: atr}},
+			indicators.Neutral, nil
+	default:
+		return nil, "", fmt.Errorf("unsupported indicator %s", indicator)
+	}
+}
+
+// firstCompleteIndex returns the first index where every series has a value so
+// warm up values can be trimmed from the output
+func firstCompleteIndex(series []indicatorSeries) int {
+	var first int
+	for i := range series {
+		for j := first; j < len(series[i].values); j++ {
+			if !math.IsNaN(series[i].values[j]) {
+				break
+			}
+			first = j + 1
+		}
+	}
+	return first
+}
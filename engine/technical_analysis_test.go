@@ -0,0 +1,128 @@
+package engine
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/indicators"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+)
+
+func testCandles(closePrices ...float64) []exchange.Candle {
+	candles := make([]exchange.Candle, len(closePrices))
+	for i := range closePrices {
+		candles[i] = exchange.Candle{
+			Time:  int64(i) * 60,
+			Open:  closePrices[i],
+			High:  closePrices[i] + 1,
+			Low:   closePrices[i] - 1,
+			Close: closePrices[i],
+		}
+	}
+	return candles
+}
+
+func TestSortCandles(t *testing.T) {
+	candles := []exchange.Candle{{Time: 120}, {Time: 60}, {Time: 0}}
+	sortCandles(candles)
+	for i := range candles {
+		if candles[i].Time != int64(i)*60 {
+			t.Fatalf("expected candles oldest first got %+v", candles)
+		}
+	}
+}
+
+func TestMergeStreamedCandle(t *testing.T) {
+	candles := testCandles(1, 2, 3)
+	if merged := mergeStreamedCandle(candles, nil); len(merged) != 3 {
+		t.Errorf("expected 3 candles got %v", len(merged))
+	}
+
+	merged := mergeStreamedCandle(candles, &kline.Candle{
+		StartTime: time.Unix(120, 0),
+		Close:     4,
+	})
+	if len(merged) != 3 || merged[2].Close != 4 {
+		t.Errorf("expected latest candle replaced got %+v", merged)
+	}
+
+	merged = mergeStreamedCandle(merged, &kline.Candle{
+		StartTime: time.Unix(180, 0),
+		Close:     5,
+	})
+	if len(merged) != 4 || merged[3].Close != 5 {
+		t.Errorf("expected newer candle appended got %+v", merged)
+	}
+
+	merged = mergeStreamedCandle(merged, &kline.Candle{
+		StartTime: time.Unix(60, 0),
+		Close:     10,
+	})
+	if len(merged) != 4 || merged[1].Close != 2 {
+		t.Errorf("expected older candle ignored got %+v", merged)
+	}
+}
+
+func TestCalculateIndicator(t *testing.T) {
+	candles := testCandles(1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12, 13, 14, 15,
+		16, 17, 18, 19, 20, 21, 22, 23, 24, 25, 26, 27, 28, 29, 30, 31, 32, 33, 34, 35)
+
+	expected := map[string][]string{
+		"SMA":    {"sma"},
+		"ema":    {"ema"},
+		"rsi":    {"rsi"},
+		"macd":   {"macd", "signal", "histogram"},
+		"bbands": {"upper", "middle", "lower"},
+		"atr":    {"atr"},
+	}
+	for indicator, names := range expected {
+		series, _, err := calculateIndicator(candles, indicator, 5)
+		if err != nil {
+			t.Errorf("%s: %v", indicator, err)
+			continue
+		}
+		if len(series) != len(names) {
+			t.Errorf("%s: expected %v series got %v", indicator, len(names), len(series))
+			continue
+		}
+		for i := range names {
+			if series[i].name != names[i] {
+				t.Errorf("%s: expected %s got %s", indicator, names[i], series[i].name)
+			}
+			if len(series[i].values) != len(candles) {
+				t.Errorf("%s: series not aligned with candles", indicator)
+			}
+		}
+	}
+
+	_, signal, err := calculateIndicator(candles, "rsi", 5)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signal != indicators.Bearish {
+		t.Errorf("expected constantly rising prices to be overbought got %v", signal)
+	}
+
+	if _, _, err = calculateIndicator(candles, "vwap", 5); err == nil {
+		t.Error("expected unsupported indicator error")
+	}
+	if _, _, err = calculateIndicator(candles[:3], "sma", 5); err != indicators.ErrNotEnoughData {
+		t.Errorf("expected %v got %v", indicators.ErrNotEnoughData, err)
+	}
+}
+
+func TestFirstCompleteIndex(t *testing.T) {
+	nan := math.NaN()
+	series := []indicatorSeries{
+		{values: []float64{nan, 1, 2, 3}},
+		{values: []float64{nan, nan, 2, 3}},
+	}
+	if first := firstCompleteIndex(series); first != 2 {
+		t.Errorf("expected 2 got %v", first)
+	}
+	if first := firstCompleteIndex(nil); first != 0 {
+		t.Errorf("expected 0 got %v", first)
+	}
+}
@@ -7,6 +7,7 @@ import (
 	"sync/atomic"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/log"
 	ntpclient "github.com/thrasher-corp/gocryptotrader/ntpclient"
 )
@@ -24,6 +25,7 @@ type ntpManager struct {
 	stopped       int32
 	inititalCheck bool
 	shutdown      chan struct{}
+	client        *ntpclient.Client
 }
 
 func (n *ntpManager) Started() bool {
@@ -48,22 +50,36 @@ func (n *ntpManager) Start() (err error) {
 	}
 
 	log.Debugln(log.TimeMgr, "NTP manager starting...")
+	n.client = &ntpclient.Client{
+		Pool:    Bot.Config.NTPClient.Pool,
+		Samples: Bot.Config.NTPClient.Samples,
+		Retries: Bot.Config.NTPClient.Retries,
+		Timeout: Bot.Config.NTPClient.Timeout,
+	}
 	if Bot.Config.NTPClient.Level == 0 && *Bot.Config.Logging.Enabled {
 		// Initial NTP check (prompts user on how we should proceed)
 		n.inititalCheck = true
 
 		// Sometimes the NTP client can have transient issues due to UDP, try
 		// the default retry limits before giving up
+	initialCheck:
 		for i := 0; i < NTPRetryLimit; i++ {
 			err = n.processTime()
 			switch err {
 			case nil:
-				break
+				break initialCheck
 			case errNTPDisabled:
 				log.Debugln(log.TimeMgr, "NTP manager: User disabled NTP prompts. Exiting.")
 				disable = true
 				err = nil
 				return
+			case ntpclient.ErrNoResponse:
+				// Unreachable servers are checked again by the run loop
+				if i == NTPRetryLimit-1 {
+					log.Warnf(log.TimeMgr, "NTP manager: %v, time sync will be checked again in %v\n",
+						err, NTPCheckInterval)
+					err = nil
+				}
 			default:
 				if i == NTPRetryLimit-1 {
 					return err
@@ -105,20 +121,38 @@ func (n *ntpManager) run() {
 		case <-n.shutdown:
 			return
 		case <-t.C:
-			n.processTime()
+			if err := n.processTime(); err != nil {
+				log.Warnf(log.TimeMgr, "NTP manager: %v\n", err)
+			}
 		}
 	}
 }
 
+// FetchNTPTime returns the current time according to the median offset of
+// the configured NTP servers, falling back to the local time
 func (n *ntpManager) FetchNTPTime() time.Time {
-	return ntpclient.NTPClient(Bot.Config.NTPClient.Pool)
+	if n.client == nil {
+		return ntpclient.NTPClient(Bot.Config.NTPClient.Pool)
+	}
+	offset, err := n.client.Offset()
+	if err != nil {
+		log.Warnf(log.TimeMgr, "NTP manager: %v, using current system time\n", err)
+		return time.Now().UTC()
+	}
+	return time.Now().Add(offset)
 }
 
 func (n *ntpManager) processTime() error {
-	NTPTime := n.FetchNTPTime()
+	NTPcurrentTimeDifference, err := n.client.Offset()
+	if err != nil {
+		return err
+	}
+	// Signed exchange requests are timestamped with the corrected time so a
+	// drifting local clock doesn't cause authentication failures
+	request.SetClockOffset(NTPcurrentTimeDifference)
 
 	currentTime := time.Now()
-	NTPcurrentTimeDifference := NTPTime.Sub(currentTime)
+	NTPTime := currentTime.Add(NTPcurrentTimeDifference)
 	configNTPTime := *Bot.Config.NTPClient.AllowedDifference
 	configNTPNegativeTime := (*Bot.Config.NTPClient.AllowedNegativeDifference - (*Bot.Config.NTPClient.AllowedNegativeDifference * 2))
 	if NTPcurrentTimeDifference > configNTPTime || NTPcurrentTimeDifference < configNTPNegativeTime {
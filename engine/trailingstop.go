@@ -0,0 +1,302 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (t *trailingStop) Started() bool {
+	return atomic.LoadInt32(&t.started) == 1
+}
+
+func (t *trailingStop) Start() error {
+	if atomic.AddInt32(&t.started, 1) != 1 {
+		return errors.New("portfolio trailing stop already started")
+	}
+
+	t.cfg = Bot.Config.TrailingStop
+	t.quote = currency.NewCode(t.cfg.Currency)
+	t.prices = make(map[string]float64)
+	t.mtx.Lock()
+	t.status = TrailingStopStatus{
+		Currency:        t.quote.String(),
+		DrawdownPercent: t.cfg.DrawdownPercent,
+	}
+	t.mtx.Unlock()
+	t.shutdown = make(chan struct{})
+	go t.run()
+	log.Debugf(log.Global, "Portfolio trailing stop started, stopping at a %v%% drawdown in %s.\n",
+		t.cfg.DrawdownPercent, t.quote)
+	return nil
+}
+
+func (t *trailingStop) Stop() error {
+	if atomic.LoadInt32(&t.started) == 0 {
+		return errTrailingStopNotStarted
+	}
+
+	if atomic.AddInt32(&t.stopped, 1) != 1 {
+		return errors.New("portfolio trailing stop is already stopped")
+	}
+
+	close(t.shutdown)
+	log.Debugln(log.Global, "Portfolio trailing stop shutting down...")
+	return nil
+}
+
+func (t *trailingStop) run() {
+	defer errorreport.Recover()
+	tick := time.NewTicker(t.cfg.CheckInterval)
+	defer func() {
+		tick.Stop()
+		atomic.CompareAndSwapInt32(&t.stopped, 1, 0)
+		atomic.CompareAndSwapInt32(&t.started, 1, 0)
+		log.Debugln(log.Global, "Portfolio trailing stop shutdown.")
+	}()
+
+	guard(trailingStopName, t.check)
+	for {
+		select {
+		case <-t.shutdown:
+			return
+		case <-tick.C:
+			guard(trailingStopName, t.check)
+		}
+	}
+}
+
+// check values the portfolio, raising the high-water mark or triggering the
+// stop once the value has fallen the drawdown percent below it. The check is
+// skipped if any exchange can't be valued as a partial value would look like
+// a loss
+func (t *trailingStop) check() {
+	names := GetExchangeNames(true)
+	authenticated := GetAuthAPISupportedExchanges()
+	now := time.Now()
+	values := make(map[string]float64)
+	var total float64
+	var unpriced []string
+	for i := range authenticated {
+		exch := GetExchangeByName(authenticated[i])
+		if exch == nil {
+			continue
+		}
+		h, err := exch.UpdateAccountInfo(Bot.Context())
+		if err != nil {
+			log.Errorf(log.Global, "Portfolio trailing stop unable to value %s, skipping check: %v\n",
+				authenticated[i], err)
+			return
+		}
+		value, missing := t.holdingsValue(authenticated[i], &h, names, now)
+		values[authenticated[i]] = value
+		total += value
+		for j := range missing {
+			if !common.StringDataCompare(unpriced, missing[j]) {
+				unpriced = append(unpriced, missing[j])
+			}
+		}
+	}
+
+	t.mtx.Lock()
+	t.status.Value = total
+	t.status.Exchanges = values
+	t.status.Unpriced = unpriced
+	t.status.Updated = now
+	if total > t.status.HighWaterMark {
+		t.status.HighWaterMark = total
+	}
+	t.status.Drawdown = drawdown(total, t.status.HighWaterMark)
+	triggered := !t.status.Triggered &&
+		t.status.HighWaterMark > 0 &&
+		t.status.Drawdown >= t.cfg.DrawdownPercent
+	if triggered {
+		t.status.Triggered = true
+		t.status.TriggeredAt = now
+	}
+	status := t.status
+	t.mtx.Unlock()
+
+	if triggered {
+		t.trigger(&status)
+	}
+}
+
+// holdingsValue returns the value of an exchange's balances in the stop's
+// currency, priced by the exchange's own ticker or else the composite price
+// across exchanges. Currencies without a recent price are valued at their
+// last known price, those never priced are returned
+func (t *trailingStop) holdingsValue(exchName string, h *account.Holdings, exchanges []string, now time.Time) (float64, []string) {
+	var total float64
+	var unpriced []string
+	for i := range h.Accounts {
+		for j := range h.Accounts[i].Currencies {
+			b := &h.Accounts[i].Currencies[j]
+			amount := b.TotalValue.Float64()
+			if amount <= 0 {
+				continue
+			}
+			if b.CurrencyName.Match(t.quote) {
+				total += amount
+				continue
+			}
+			code := b.CurrencyName.Upper().String()
+			p := currency.NewPair(b.CurrencyName, t.quote)
+			price, err := tickerPrice(exchName, p, asset.Spot, now, t.cfg.MaxTickerAge)
+			if err != nil {
+				price, err = compositePrice(exchanges, p, asset.Spot, now, t.cfg.MaxTickerAge)
+			}
+			if err != nil {
+				var ok bool
+				if price, ok = t.prices[code]; !ok {
+					unpriced = append(unpriced, code)
+					continue
+				}
+			} else {
+				t.prices[code] = price
+			}
+			total += amount * price
+		}
+	}
+	return total, unpriced
+}
+
+// trigger takes the configured actions. Balances are flattened before new
+// orders are halted so the flattening orders aren't refused
+func (t *trailingStop) trigger(s *TrailingStopStatus) {
+	msg := fmt.Sprintf("Portfolio trailing stop triggered: value %.2f %s is %.2f%% below its high-water mark of %.2f %s",
+		s.Value, s.Currency, s.Drawdown, s.HighWaterMark, s.Currency)
+	log.Warnln(log.Global, msg)
+
+	if common.StringDataCompare(t.cfg.Actions, config.TrailingStopActionAlert) {
+		Bot.CommsManager.PushEvent(base.Event{
+			Type:     base.EventTypeAlert,
+			Message:  msg,
+			Severity: base.SeverityCritical,
+		})
+	}
+	if common.StringDataCompare(t.cfg.Actions, config.TrailingStopActionFlatten) {
+		t.flatten()
+	}
+	if common.StringDataCompare(t.cfg.Actions, config.TrailingStopActionHalt) {
+		Bot.OrderManager.Halt()
+		log.Warnln(log.Global, "Portfolio trailing stop halted new order submissions until reset.")
+	}
+}
+
+// flatten cancels every open order then sells each balance into the stop's
+// currency with market orders on exchanges which trade the pair
+func (t *trailingStop) flatten() {
+	if err := Bot.OrderManager.CancelAllOrders(nil); err != nil {
+		log.Errorf(log.Global, "Portfolio trailing stop: %v\n", err)
+	}
+
+	authenticated := GetAuthAPISupportedExchanges()
+	for i := range authenticated {
+		exch := GetExchangeByName(authenticated[i])
+		if exch == nil {
+			continue
+		}
+		h, err := exch.UpdateAccountInfo(Bot.Context())
+		if err != nil {
+			log.Errorf(log.Global, "Portfolio trailing stop unable to flatten %s: %v\n",
+				authenticated[i], err)
+			continue
+		}
+		orders := flattenOrders(&h, t.quote, exch.GetEnabledPairs(asset.Spot))
+		for j := range orders {
+			if _, err = Bot.OrderManager.Submit(authenticated[i], &orders[j]); err != nil {
+				log.Errorf(log.Global, "Portfolio trailing stop unable to sell %v %s on %s: %v\n",
+					orders[j].Amount, orders[j].Pair.Base, authenticated[i], err)
+			}
+		}
+	}
+}
+
+// flattenOrders returns the market sells of every available balance with an
+// enabled pair against the quote currency
+func flattenOrders(h *account.Holdings, quote currency.Code, enabled currency.Pairs) []order.Submit {
+	var orders []order.Submit
+	for i := range h.Accounts {
+		for j := range h.Accounts[i].Currencies {
+			b := &h.Accounts[i].Currencies[j]
+			if b.CurrencyName.Match(quote) {
+				continue
+			}
+			available := b.TotalValue.Sub(b.Hold)
+			if !available.GreaterThan(decimal.Zero) {
+				continue
+			}
+			p := currency.NewPair(b.CurrencyName, quote)
+			if !enabled.Contains(p, true) {
+				continue
+			}
+			orders = append(orders, order.Submit{
+				Pair:      p,
+				OrderType: order.Market,
+				OrderSide: order.Sell,
+				Amount:    available,
+			})
+		}
+	}
+	return orders
+}
+
+// drawdown returns the percent a value is below the high-water mark
+func drawdown(value, highWaterMark float64) float64 {
+	if highWaterMark <= 0 || value >= highWaterMark {
+		return 0
+	}
+	return (highWaterMark - value) / highWaterMark * 100
+}
+
+// Status returns the latest valuation of the portfolio
+func (t *trailingStop) Status() (TrailingStopStatus, error) {
+	if !t.Started() {
+		return TrailingStopStatus{}, errTrailingStopNotStarted
+	}
+	t.mtx.RLock()
+	defer t.mtx.RUnlock()
+	s := t.status
+	s.Exchanges = make(map[string]float64, len(t.status.Exchanges))
+	for k, v := range t.status.Exchanges {
+		s.Exchanges[k] = v
+	}
+	return s, nil
+}
+
+// Reset rearms a triggered stop from the current value and accepts new order
+// submissions again if they were halted
+func (t *trailingStop) Reset() (TrailingStopStatus, error) {
+	if !t.Started() {
+		return TrailingStopStatus{}, errTrailingStopNotStarted
+	}
+	t.mtx.Lock()
+	if t.status.Updated.IsZero() {
+		t.mtx.Unlock()
+		return TrailingStopStatus{}, errTrailingStopNoValue
+	}
+	t.status.HighWaterMark = t.status.Value
+	t.status.Drawdown = 0
+	t.status.Triggered = false
+	t.status.TriggeredAt = time.Time{}
+	t.mtx.Unlock()
+
+	if common.StringDataCompare(t.cfg.Actions, config.TrailingStopActionHalt) {
+		Bot.OrderManager.Resume()
+	}
+	log.Infoln(log.Global, "Portfolio trailing stop reset.")
+	return t.Status()
+}
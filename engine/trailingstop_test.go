@@ -0,0 +1,139 @@
+package engine
+
+import (
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestDrawdown(t *testing.T) {
+	testCases := []struct {
+		value, highWaterMark, expected float64
+	}{
+		{100, 0, 0},
+		{100, 100, 0},
+		{110, 100, 0},
+		{90, 100, 10},
+		{25, 200, 87.5},
+	}
+	for i := range testCases {
+		if d := drawdown(testCases[i].value, testCases[i].highWaterMark); d != testCases[i].expected {
+			t.Errorf("expected a drawdown of %v for %v from %v, received %v", testCases[i].expected,
+				testCases[i].value, testCases[i].highWaterMark, d)
+		}
+	}
+}
+
+func TestHoldingsValue(t *testing.T) {
+	usdt := currency.USDT
+	now := time.Now()
+	tickers := []struct {
+		exchange string
+		price    ticker.Price
+	}{
+		{"stoptesta", ticker.Price{Pair: currency.NewPair(currency.XRP, usdt), Last: 2, LastUpdated: now}},
+		{"stoptestb", ticker.Price{Pair: currency.NewPair(currency.XRP, usdt), Last: 4, LastUpdated: now}},
+		{"stoptestb", ticker.Price{Pair: currency.NewPair(currency.DOGE, usdt), Last: 10, LastUpdated: now}},
+		{"stoptestb", ticker.Price{Pair: currency.NewPair(currency.LTC, usdt), Last: 50, LastUpdated: now.Add(-time.Hour)}},
+	}
+	for i := range tickers {
+		if err := ticker.ProcessTicker(tickers[i].exchange, &tickers[i].price, asset.Spot); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	s := trailingStop{
+		cfg:    config.TrailingStopConfig{MaxTickerAge: time.Minute},
+		quote:  usdt,
+		prices: map[string]float64{"LTC": 40},
+	}
+	h := account.Holdings{Accounts: []account.SubAccount{{
+		Currencies: []account.Balance{
+			{CurrencyName: usdt, TotalValue: decimal.NewFromInt(100)},
+			{CurrencyName: currency.XRP, TotalValue: decimal.NewFromInt(10)},
+			{CurrencyName: currency.DOGE, TotalValue: decimal.NewFromInt(1)},
+			{CurrencyName: currency.LTC, TotalValue: decimal.NewFromInt(1)},
+			{CurrencyName: currency.ETC, TotalValue: decimal.NewFromInt(1)},
+			{CurrencyName: currency.ETH},
+		},
+	}}}
+
+	// XRP is priced by the exchange itself, DOGE by the composite price, LTC
+	// by its last known price and ETC can't be priced
+	value, unpriced := s.holdingsValue("stoptesta", &h, []string{"stoptesta", "stoptestb"}, now)
+	if value != 170 {
+		t.Errorf("expected a value of 170, received %v", value)
+	}
+	if len(unpriced) != 1 || unpriced[0] != "ETC" {
+		t.Errorf("expected ETC to be unpriced, received %v", unpriced)
+	}
+	if s.prices["XRP"] != 2 || s.prices["DOGE"] != 10 {
+		t.Errorf("expected the prices to be kept, received %v", s.prices)
+	}
+}
+
+func TestFlattenOrders(t *testing.T) {
+	h := account.Holdings{Accounts: []account.SubAccount{{
+		Currencies: []account.Balance{
+			{CurrencyName: currency.USDT, TotalValue: decimal.NewFromInt(100)},
+			{CurrencyName: currency.BTC, TotalValue: decimal.NewFromInt(2), Hold: decimal.NewFromFloat(0.5)},
+			{CurrencyName: currency.ETH, TotalValue: decimal.NewFromInt(1), Hold: decimal.NewFromInt(1)},
+			{CurrencyName: currency.LTC, TotalValue: decimal.NewFromInt(1)},
+		},
+	}}}
+	enabled := currency.Pairs{
+		currency.NewPair(currency.BTC, currency.USDT),
+		currency.NewPair(currency.ETH, currency.USDT),
+	}
+	orders := flattenOrders(&h, currency.USDT, enabled)
+	if len(orders) != 1 {
+		t.Fatalf("expected a single order, received %+v", orders)
+	}
+	if !orders[0].Pair.Equal(enabled[0]) ||
+		orders[0].OrderSide != order.Sell ||
+		orders[0].OrderType != order.Market ||
+		!orders[0].Amount.Equal(decimal.NewFromFloat(1.5)) {
+		t.Errorf("expected a market sell of the available BTC, received %+v", orders[0])
+	}
+}
+
+func TestTrailingStopReset(t *testing.T) {
+	SetupTestHelpers(t)
+	var s trailingStop
+	if _, err := s.Status(); err != errTrailingStopNotStarted {
+		t.Errorf("expected %v, received %v", errTrailingStopNotStarted, err)
+	}
+
+	s.started = 1
+	s.cfg.Actions = []string{config.TrailingStopActionHalt}
+	if _, err := s.Reset(); err != errTrailingStopNoValue {
+		t.Errorf("expected %v, received %v", errTrailingStopNoValue, err)
+	}
+
+	s.status = TrailingStopStatus{
+		Value:         80,
+		HighWaterMark: 100,
+		Drawdown:      20,
+		Triggered:     true,
+		TriggeredAt:   time.Now(),
+		Updated:       time.Now(),
+	}
+	Bot.OrderManager.Halt()
+	status, err := s.Reset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.Triggered || status.HighWaterMark != 80 || status.Drawdown != 0 {
+		t.Errorf("expected the stop to be rearmed from the value, received %+v", status)
+	}
+	if Bot.OrderManager.Halted() {
+		t.Error("expected new orders to be accepted again")
+	}
+}
@@ -0,0 +1,52 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+const trailingStopName = "portfolio trailing stop"
+
+var (
+	errTrailingStopNotStarted = errors.New("portfolio trailing stop not started")
+	errTrailingStopNoValue    = errors.New("portfolio has not been valued yet")
+)
+
+// trailingStop values the balances held on every authenticated exchange in
+// the configured stablecoin and, once the total falls the drawdown percent
+// from its high-water mark, takes the configured actions. It stays triggered
+// until reset so the actions are only taken once
+type trailingStop struct {
+	started  int32
+	stopped  int32
+	shutdown chan struct{}
+	cfg      config.TrailingStopConfig
+	quote    currency.Code
+	mtx      sync.RWMutex
+	status   TrailingStopStatus
+	// prices holds the last known price of each currency so a stale ticker
+	// doesn't drop the currency from the valuation and set off the stop
+	prices map[string]float64
+}
+
+// TrailingStopStatus is the latest valuation of the portfolio against its
+// high-water mark
+type TrailingStopStatus struct {
+	Currency      string
+	Value         float64
+	HighWaterMark float64
+	// Drawdown is the percent the value is below the high-water mark
+	Drawdown        float64
+	DrawdownPercent float64
+	Exchanges       map[string]float64
+	// Unpriced holds the currencies held without a price in the currency,
+	// they are left out of the value
+	Unpriced    []string
+	Triggered   bool
+	TriggeredAt time.Time
+	Updated     time.Time
+}
@@ -0,0 +1,836 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/wallet"
+)
+
+func (m *transferManager) Started() bool {
+	return atomic.LoadInt32(&m.started) == 1
+}
+
+func (m *transferManager) Start() error {
+	if atomic.AddInt32(&m.started, 1) != 1 {
+		return errors.New("transfer manager already started")
+	}
+
+	m.interval = Bot.Config.TransferManager.CheckInterval
+	m.timeout = Bot.Config.TransferManager.Timeout
+	m.approval = Bot.Config.TransferManager.Approval
+	m.mtx.Lock()
+	if m.transfers == nil {
+		m.transfers = make(map[string]*transferState)
+	}
+	m.mtx.Unlock()
+	m.loadWallets()
+	m.shutdown = make(chan struct{})
+	log.Debugf(log.Global, "Transfer manager started, checking transfers every %v.\n", m.interval)
+	return nil
+}
+
+func (m *transferManager) Stop() error {
+	if atomic.LoadInt32(&m.started) == 0 {
+		return errors.New("transfer manager not started")
+	}
+
+	if atomic.AddInt32(&m.stopped, 1) != 1 {
+		return errors.New("transfer manager is already stopped")
+	}
+
+	close(m.shutdown)
+	var pending, unapproved int
+	m.mtx.RLock()
+	for _, t := range m.transfers {
+		switch {
+		case t.Status == TransferPendingApproval:
+			unapproved++
+		case !t.finished():
+			pending++
+		}
+	}
+	m.mtx.RUnlock()
+	if pending > 0 {
+		log.Warnf(log.Global, "Transfer manager stopped with %d transfers in progress, they need checking manually.\n", pending)
+	}
+	if unapproved > 0 {
+		log.Warnf(log.Global, "Transfer manager stopped with %d transfers pending approval, they were not sent.\n", unapproved)
+	}
+	atomic.CompareAndSwapInt32(&m.stopped, 1, 0)
+	atomic.CompareAndSwapInt32(&m.started, 1, 0)
+	log.Debugln(log.Global, "Transfer manager shutdown.")
+	return nil
+}
+
+// Submit withdraws funds from one exchange to another and follows the
+// transfer until it is credited, timed out or failed, pushing an event on
+// each change of status. The destination exchange's deposit address is used
+// unless an address is set. Transfers requiring approval are held pending
+// approval instead of being sent
+func (m *transferManager) Submit(ctx context.Context, r *TransferRequest) (Transfer, error) {
+	if !m.Started() {
+		return Transfer{}, errTransferManagerNotStarted
+	}
+	if r == nil {
+		return Transfer{}, errors.New("transfer request is nil")
+	}
+	if r.From == "" || r.To == "" {
+		return Transfer{}, errors.New(errExchangeNameUnset)
+	}
+	if strings.EqualFold(r.From, r.To) {
+		return Transfer{}, errTransferSameExchange
+	}
+	if r.Network != "" && r.Address == "" {
+		return Transfer{}, errTransferAddressRequired
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return Transfer{}, err
+	}
+	if m.requiresApproval(r.Currency, r.Amount) {
+		return m.hold(id.String(), r, nil), nil
+	}
+	return m.send(ctx, id.String(), r, false)
+}
+
+// send withdraws a transfer's funds from its source exchange or hot wallet
+// and follows it until it finishes
+func (m *transferManager) send(ctx context.Context, id string, r *TransferRequest, approved bool) (Transfer, error) {
+	if w := m.hotWallet(r.From); w != nil {
+		return m.submitFromWallet(ctx, id, w, r, approved)
+	}
+	src := GetExchangeByName(r.From)
+	if src == nil {
+		return Transfer{}, errors.New("Exchange " + r.From + " not found")
+	}
+	dst := GetExchangeByName(r.To)
+	if dst == nil {
+		return Transfer{}, errors.New("Exchange " + r.To + " not found")
+	}
+	if err := apiKeyAllows(src, false, true); err != nil {
+		return Transfer{}, err
+	}
+
+	address := r.Address
+	if address == "" {
+		var err error
+		address, err = dst.GetDepositAddress(ctx, r.Currency, "")
+		if err != nil {
+			return Transfer{}, fmt.Errorf("unable to get %s deposit address for %s: %v",
+				r.To, r.Currency, err)
+		}
+	}
+	req := &withdraw.CryptoRequest{
+		GenericInfo: withdraw.GenericInfo{
+			Currency:    r.Currency,
+			Amount:      r.Amount,
+			Description: "Transfer to " + dst.GetName(),
+		},
+		Address:    address,
+		AddressTag: r.AddressTag,
+		Chain:      r.Network,
+	}
+	if err := withdraw.Valid(req); err != nil {
+		return Transfer{}, err
+	}
+
+	holdings, err := dst.UpdateAccountInfo(ctx)
+	if err != nil {
+		return Transfer{}, fmt.Errorf("unable to get %s balance: %v", r.To, err)
+	}
+	fee, err := src.GetFeeByType(ctx, &exchange.FeeBuilder{
+		FeeType: exchange.CryptocurrencyWithdrawalFee,
+		Pair:    currency.Pair{Base: r.Currency},
+		Amount:  r.Amount,
+	})
+	if err != nil {
+		log.Debugf(log.Global, "Transfer manager unable to estimate %s withdrawal fee: %v\n", r.From, err)
+		fee = 0
+	}
+	req.FeeAmount = fee
+
+	withdrawalID, err := m.withdraw(ctx, &withdrawal{
+		source:   src.GetName(),
+		currency: r.Currency,
+		amount:   r.Amount,
+		address:  address,
+		approved: approved,
+		send: func(ctx context.Context) (string, error) {
+			return src.WithdrawCryptocurrencyFunds(ctx, req)
+		},
+	})
+	if err != nil {
+		audit.Event(id, auditEventTransfer, fmt.Sprintf("Exchange %s unable to withdraw %v %s to %s %s: %v",
+			src.GetName(), r.Amount, r.Currency, dst.GetName(), address, err))
+		return Transfer{}, err
+	}
+	audit.Event(id, auditEventTransfer, fmt.Sprintf("Exchange %s withdrew %v %s to %s %s, withdrawal %s",
+		src.GetName(), r.Amount, r.Currency, dst.GetName(), address, withdrawalID))
+
+	now := time.Now()
+	t := &transferState{
+		Transfer: Transfer{
+			TransferRequest: *r,
+			ID:              id,
+			Status:          TransferSubmitted,
+			WithdrawalID:    withdrawalID,
+			Fee:             fee,
+			FeeEstimated:    true,
+			Created:         now,
+			Updated:         now,
+		},
+		baseline: currencyBalance(&holdings, r.Currency),
+		deadline: now.Add(m.timeout),
+	}
+	t.From = src.GetName()
+	t.To = dst.GetName()
+	t.Address = address
+
+	m.mtx.Lock()
+	m.transfers[t.ID] = t
+	m.mtx.Unlock()
+	pushTransferEvent(&t.Transfer)
+
+	go m.monitor(t.ID, src, dst)
+	return t.Transfer, nil
+}
+
+// loadWallets loads the enabled hot wallets if hot wallets are enabled,
+// replacing those loaded before. Wallets which can't be loaded are logged and
+// left out
+func (m *transferManager) loadWallets() {
+	cfg := Bot.Config.TransferManager.HotWallets
+	wallets := make(map[string]*hotWallet)
+	for i := range cfg.Wallets {
+		if !cfg.Enabled || !cfg.Wallets[i].Enabled {
+			continue
+		}
+		w, err := wallet.New(&wallet.Settings{
+			Name:       cfg.Wallets[i].Name,
+			Chain:      cfg.Wallets[i].Chain,
+			Currency:   currency.NewCode(cfg.Wallets[i].Currency),
+			Address:    cfg.Wallets[i].Address,
+			PrivateKey: cfg.Wallets[i].PrivateKey,
+			APIURL:     cfg.Wallets[i].APIURL,
+			ChainID:    cfg.Wallets[i].ChainID,
+			Testnet:    cfg.Wallets[i].Testnet,
+			MaxFee:     cfg.Wallets[i].MaxFee,
+		})
+		if err != nil {
+			log.Errorf(log.Global, "Transfer manager unable to load hot wallet: %v\n", err)
+			continue
+		}
+		wallets[strings.ToLower(w.Name())] = &hotWallet{Wallet: w, cfg: cfg.Wallets[i]}
+		log.Warnf(log.Global, "Transfer manager loaded hot wallet %s sending up to %v %s from %s.\n",
+			w.Name(), cfg.Wallets[i].MaxAmount, w.Currency(), w.Address())
+	}
+	m.mtx.Lock()
+	m.wallets = wallets
+	m.mtx.Unlock()
+}
+
+// hotWallet returns a loaded hot wallet by name, or nil if there isn't one
+func (m *transferManager) hotWallet(name string) *hotWallet {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	return m.wallets[strings.ToLower(name)]
+}
+
+// submitFromWallet signs and broadcasts a transaction from a hot wallet to an
+// exchange and follows it until it is credited. Transfers must be within the
+// wallet's max amount and to one of its allowed exchanges, at the exchange's
+// deposit address or an allowed address. Each broadcast is recorded in the
+// audit log
+func (m *transferManager) submitFromWallet(ctx context.Context, id string, w *hotWallet, r *TransferRequest, approved bool) (Transfer, error) {
+	if !r.Currency.Match(w.Currency()) {
+		return Transfer{}, fmt.Errorf("hot wallet %s only sends %s", w.Name(), w.Currency())
+	}
+	if r.Amount <= 0 || r.Amount > w.cfg.MaxAmount {
+		return Transfer{}, fmt.Errorf("hot wallet %s transfers must be greater than 0 and at most %v %s",
+			w.Name(), w.cfg.MaxAmount, w.Currency())
+	}
+	dst := GetExchangeByName(r.To)
+	if dst == nil {
+		return Transfer{}, errors.New("Exchange " + r.To + " not found")
+	}
+	if !common.StringDataCompareInsensitive(w.cfg.AllowedExchanges, dst.GetName()) {
+		return Transfer{}, fmt.Errorf("%v: %s", errTransferNotAllowed, dst.GetName())
+	}
+	address := r.Address
+	if address == "" {
+		var err error
+		address, err = dst.GetDepositAddress(ctx, r.Currency, "")
+		if err != nil {
+			return Transfer{}, fmt.Errorf("unable to get %s deposit address for %s: %v",
+				r.To, r.Currency, err)
+		}
+	} else if !common.StringDataCompareInsensitive(w.cfg.AllowedAddresses, address) {
+		return Transfer{}, fmt.Errorf("%v: %s", errTransferNotAllowed, address)
+	}
+
+	holdings, err := dst.UpdateAccountInfo(ctx)
+	if err != nil {
+		return Transfer{}, fmt.Errorf("unable to get %s balance: %v", r.To, err)
+	}
+	var tx *wallet.Transaction
+	_, err = m.withdraw(ctx, &withdrawal{
+		source:   "hot wallet " + w.Name(),
+		currency: r.Currency,
+		amount:   r.Amount,
+		address:  address,
+		approved: approved,
+		send: func(ctx context.Context) (string, error) {
+			var err error
+			tx, err = w.Send(ctx, address, decimal.NewFromFloat(r.Amount))
+			if err != nil {
+				return "", err
+			}
+			return tx.TxID, nil
+		},
+	})
+	if err != nil {
+		audit.Event(id, auditEventTransfer, fmt.Sprintf("Hot wallet %s unable to send %v %s to %s %s: %v",
+			w.Name(), r.Amount, r.Currency, dst.GetName(), address, err))
+		return Transfer{}, err
+	}
+	audit.Event(id, auditEventTransfer, fmt.Sprintf("Hot wallet %s sent %v %s to %s %s with %v fees, transaction %s",
+		w.Name(), r.Amount, r.Currency, dst.GetName(), address, tx.Fee, tx.TxID))
+
+	now := time.Now()
+	t := &transferState{
+		Transfer: Transfer{
+			TransferRequest: *r,
+			ID:              id,
+			Status:          TransferConfirming,
+			TxID:            tx.TxID,
+			Fee:             tx.Fee,
+			Created:         now,
+			Updated:         now,
+		},
+		baseline: currencyBalance(&holdings, r.Currency),
+		deadline: now.Add(m.timeout),
+	}
+	t.From = w.Name()
+	t.To = dst.GetName()
+	t.Address = address
+
+	m.mtx.Lock()
+	m.transfers[t.ID] = t
+	m.mtx.Unlock()
+	pushTransferEvent(&t.Transfer)
+
+	// Transfers from a wallet start confirming so the source is never checked
+	go m.monitor(t.ID, nil, dst)
+	return t.Transfer, nil
+}
+
+// Withdraw sends a withdrawal from an exchange to an address or bank account
+// outside of a transfer, through the same checks, approval and limits as
+// transfers. A withdrawal requiring approval is held pending approval and
+// errWithdrawalPendingApproval is returned along with the ID to approve it by
+func (m *transferManager) Withdraw(ctx context.Context, source string, code currency.Code, amount float64, destination, address string, send func(context.Context) (string, error)) (string, error) {
+	withdrawalID, err := m.withdraw(ctx, &withdrawal{
+		source:   source,
+		currency: code,
+		amount:   amount,
+		address:  address,
+		send:     send,
+	})
+	if err != errApprovalRequired {
+		return withdrawalID, err
+	}
+	if !m.Started() {
+		return "", errApprovalUnavailable
+	}
+	id, err := uuid.NewV4()
+	if err != nil {
+		return "", err
+	}
+	t := m.hold(id.String(), &TransferRequest{
+		From:     source,
+		To:       destination,
+		Currency: code,
+		Amount:   amount,
+		Address:  address,
+	}, send)
+	return "", fmt.Errorf("%v: approve %s by %s", errWithdrawalPendingApproval,
+		t.ID, t.ApprovalExpires.UTC().Format(time.RFC3339))
+}
+
+// withdraw is the path every withdrawal from an exchange or hot wallet is sent
+// by. The withdrawal is checked by the security monitor, rejected with
+// errApprovalRequired if it needs approval and isn't approved, then
+// reserved against the daily withdrawal limits before it is sent
+func (m *transferManager) withdraw(ctx context.Context, w *withdrawal) (string, error) {
+	if !(w.amount > 0) || math.IsInf(w.amount, 0) {
+		return "", errors.New(withdraw.ErrStrAmountMustBeGreaterThanZero)
+	}
+	err := Bot.SecurityMonitor.CheckWithdrawal(w.source, w.currency, w.amount, w.address)
+	if err != nil {
+		return "", err
+	}
+	if !w.approved && m.requiresApproval(w.currency, w.amount) {
+		return "", errApprovalRequired
+	}
+	release, err := Bot.WithdrawalLimiter.Reserve(w.currency, decimal.NewFromFloat(w.amount))
+	if err != nil {
+		return "", err
+	}
+	withdrawalID, err := w.send(ctx)
+	if err != nil {
+		release()
+	}
+	return withdrawalID, err
+}
+
+// requiresApproval returns whether a withdrawal must be approved before it is
+// sent, being above its currency's approval limit or of a currency without
+// one. The configured approval settings apply until the transfer manager is
+// started
+func (m *transferManager) requiresApproval(code currency.Code, amount float64) bool {
+	approval := m.approval
+	if !m.Started() {
+		approval = Bot.Config.TransferManager.Approval
+	}
+	if !approval.Enabled {
+		return false
+	}
+	limit, ok := approval.Limits[code.Upper().String()]
+	return !ok || amount > limit
+}
+
+// hold records a transfer as pending approval, expiring it if it isn't
+// approved within the approval window. Withdrawals outside of a transfer are
+// held with the send to make once approved
+func (m *transferManager) hold(id string, r *TransferRequest, send func(context.Context) (string, error)) Transfer {
+	now := time.Now()
+	t := &transferState{
+		Transfer: Transfer{
+			TransferRequest: *r,
+			ID:              id,
+			Status:          TransferPendingApproval,
+			Created:         now,
+			Updated:         now,
+			ApprovalExpires: now.Add(m.approval.Window),
+		},
+		send: send,
+	}
+	m.mtx.Lock()
+	m.transfers[id] = t
+	m.mtx.Unlock()
+	audit.Event(id, auditEventTransfer, fmt.Sprintf("Transfer of %v %s from %s to %s held pending approval until %s",
+		r.Amount, r.Currency, r.From, r.To, t.ApprovalExpires.UTC().Format(time.RFC3339)))
+	pushTransferEvent(&t.Transfer)
+
+	go m.expireApproval(id, m.approval.Window)
+	return t.Transfer
+}
+
+// Approve sends a transfer pending approval, recording who approved it.
+// Transfers not approved within the approval window can't be approved
+func (m *transferManager) Approve(ctx context.Context, id, approver string) (Transfer, error) {
+	if !m.Started() {
+		return Transfer{}, errTransferManagerNotStarted
+	}
+	m.mtx.Lock()
+	t, ok := m.transfers[id]
+	if !ok {
+		m.mtx.Unlock()
+		return Transfer{}, errTransferNotFound
+	}
+	if t.Status != TransferPendingApproval {
+		m.mtx.Unlock()
+		return Transfer{}, fmt.Errorf("%v: %s is %s", errTransferNotPending, id, strings.ToLower(string(t.Status)))
+	}
+	if time.Now().After(t.ApprovalExpires) {
+		m.mtx.Unlock()
+		m.expire(id)
+		return Transfer{}, fmt.Errorf("%v: %s", errApprovalExpired, id)
+	}
+	t.Status = TransferApproved
+	t.ApprovedBy = approver
+	t.Updated = time.Now()
+	r := t.TransferRequest
+	send := t.send
+	m.mtx.Unlock()
+	audit.Event(id, auditEventTransfer, fmt.Sprintf("Transfer of %v %s from %s to %s approved by %s",
+		r.Amount, r.Currency, r.From, r.To, approver))
+
+	var sent Transfer
+	var err error
+	if send != nil {
+		sent, err = m.sendWithdrawal(ctx, t, send)
+	} else {
+		sent, err = m.send(ctx, id, &r, true)
+	}
+	if err != nil {
+		m.mtx.Lock()
+		t.Status = TransferFailed
+		t.Error = err.Error()
+		t.Updated = time.Now()
+		failed := t.Transfer
+		m.mtx.Unlock()
+		pushTransferEvent(&failed)
+		return Transfer{}, err
+	}
+	m.mtx.Lock()
+	if state, ok := m.transfers[id]; ok {
+		state.ApprovedBy = approver
+		sent.ApprovedBy = approver
+	}
+	m.mtx.Unlock()
+	return sent, nil
+}
+
+// sendWithdrawal sends an approved withdrawal held outside of a transfer.
+// There's no destination exchange to follow it to, so it is finished once the
+// exchange accepts it
+func (m *transferManager) sendWithdrawal(ctx context.Context, t *transferState, send func(context.Context) (string, error)) (Transfer, error) {
+	m.mtx.RLock()
+	r := t.TransferRequest
+	m.mtx.RUnlock()
+	withdrawalID, err := m.withdraw(ctx, &withdrawal{
+		source:   r.From,
+		currency: r.Currency,
+		amount:   r.Amount,
+		address:  r.Address,
+		approved: true,
+		send:     send,
+	})
+	if err != nil {
+		audit.Event(t.ID, auditEventTransfer, fmt.Sprintf("Exchange %s unable to withdraw %v %s to %s: %v",
+			r.From, r.Amount, r.Currency, r.To, err))
+		return Transfer{}, err
+	}
+	audit.Event(t.ID, auditEventTransfer, fmt.Sprintf("Exchange %s withdrew %v %s to %s, withdrawal %s",
+		r.From, r.Amount, r.Currency, r.To, withdrawalID))
+	m.mtx.Lock()
+	t.Status = TransferWithdrawn
+	t.WithdrawalID = withdrawalID
+	t.Updated = time.Now()
+	sent := t.Transfer
+	m.mtx.Unlock()
+	pushTransferEvent(&sent)
+	return sent, nil
+}
+
+// expireApproval expires a transfer still pending approval once the approval
+// window passes
+func (m *transferManager) expireApproval(id string, window time.Duration) {
+	defer errorreport.Recover()
+	t := time.NewTimer(window)
+	defer t.Stop()
+	select {
+	case <-m.shutdown:
+		return
+	case <-t.C:
+	}
+	guard(transferManagerName, func() { m.expire(id) })
+}
+
+// expire marks a transfer still pending approval as expired
+func (m *transferManager) expire(id string) {
+	m.mtx.Lock()
+	t, ok := m.transfers[id]
+	if !ok || t.Status != TransferPendingApproval {
+		m.mtx.Unlock()
+		return
+	}
+	t.Status = TransferExpired
+	t.Error = "not approved by " + t.ApprovalExpires.UTC().Format(time.RFC3339)
+	t.Updated = time.Now()
+	expired := t.Transfer
+	m.mtx.Unlock()
+	audit.Event(id, auditEventTransfer, fmt.Sprintf("Transfer of %v %s from %s to %s expired, %s",
+		expired.Amount, expired.Currency, expired.From, expired.To, expired.Error))
+	pushTransferEvent(&expired)
+}
+
+// Transfer returns a transfer by its ID
+func (m *transferManager) Transfer(id string) (Transfer, error) {
+	m.mtx.RLock()
+	defer m.mtx.RUnlock()
+	t, ok := m.transfers[id]
+	if !ok {
+		return Transfer{}, errTransferNotFound
+	}
+	return t.Transfer, nil
+}
+
+// Transfers returns every transfer submitted since startup, oldest first
+func (m *transferManager) Transfers() []Transfer {
+	m.mtx.RLock()
+	resp := make([]Transfer, 0, len(m.transfers))
+	for _, t := range m.transfers {
+		resp = append(resp, t.Transfer)
+	}
+	m.mtx.RUnlock()
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Created.Before(resp[j].Created) })
+	return resp
+}
+
+// monitor checks a transfer's progress every interval until it finishes or
+// the transfer manager is stopped
+func (m *transferManager) monitor(id string, src, dst exchange.IBotExchange) {
+	defer errorreport.Recover()
+	t := time.NewTicker(m.interval)
+	defer t.Stop()
+	for {
+		select {
+		case <-m.shutdown:
+			return
+		case <-t.C:
+		}
+		var finished bool
+		guard(transferManagerName, func() { finished = m.check(id, src, dst) })
+		if finished {
+			return
+		}
+	}
+}
+
+// check advances a transfer, returning whether it has finished
+func (m *transferManager) check(id string, src, dst exchange.IBotExchange) bool {
+	m.mtx.RLock()
+	state, ok := m.transfers[id]
+	if !ok {
+		m.mtx.RUnlock()
+		return true
+	}
+	current := *state
+	m.mtx.RUnlock()
+
+	ctx, cancel := context.WithTimeout(Bot.Context(), m.interval)
+	defer cancel()
+	updated, err := advanceTransfer(ctx, &current, src, dst)
+	if err != nil {
+		log.Warnf(log.Global, "Transfer manager unable to check transfer %s: %v\n", id, err)
+		updated.Error = err.Error()
+	}
+	if !updated.finished() && time.Now().After(current.deadline) {
+		updated.Status = TransferTimedOut
+		updated.Error = fmt.Sprintf("not credited by %s within %v", updated.To, m.timeout)
+	}
+	updated.Updated = time.Now()
+
+	m.mtx.Lock()
+	state.Transfer = updated
+	m.mtx.Unlock()
+	if updated.Status != current.Status {
+		pushTransferEvent(&updated)
+	}
+	return updated.finished()
+}
+
+// advanceTransfer looks for a transfer's withdrawal in the source exchange's
+// funding history and its deposit in the destination's, falling back to the
+// destination's balance growing by the amount expected when it has no
+// funding history. The balance fallback assumes the currency isn't traded on
+// the destination exchange while the transfer is in progress
+func advanceTransfer(ctx context.Context, t *transferState, src, dst exchange.IBotExchange) (Transfer, error) {
+	resp := t.Transfer
+	resp.Error = ""
+	if resp.Status == TransferSubmitted {
+		history, err := src.GetFundingHistory(ctx)
+		if err != nil && !unsupportedHistory(err) {
+			return resp, err
+		}
+		if w, ok := findWithdrawal(history, &resp); ok {
+			if transferFailed(w.Status) {
+				resp.Status = TransferFailed
+				resp.Error = "withdrawal " + w.Status
+				return resp, nil
+			}
+			if w.Fee > 0 {
+				resp.Fee = w.Fee
+				resp.FeeEstimated = false
+			}
+			if w.CryptoTxID != "" {
+				resp.TxID = w.CryptoTxID
+				resp.Status = TransferConfirming
+			}
+		}
+	}
+
+	history, err := dst.GetFundingHistory(ctx)
+	if err == nil {
+		if d, ok := findDeposit(history, &resp); ok && !transferPending(d.Status) {
+			resp.complete(math.Abs(d.Amount))
+		}
+		return resp, nil
+	}
+	if !unsupportedHistory(err) {
+		return resp, err
+	}
+
+	holdings, err := dst.UpdateAccountInfo(ctx)
+	if err != nil {
+		return resp, err
+	}
+	received := currencyBalance(&holdings, resp.Currency).Sub(t.baseline)
+	if received.Sign() > 0 && received.Float64() >= resp.expected() {
+		resp.complete(received.Float64())
+	}
+	return resp, nil
+}
+
+// complete marks a transfer credited with the amount received, accounting
+// any shortfall from the amount sent as fees
+func (t *Transfer) complete(received float64) {
+	t.Status = TransferCompleted
+	t.Received = received
+	if shortfall := t.Amount - received; shortfall > t.Fee {
+		t.Fee = shortfall
+	}
+	t.FeeEstimated = false
+}
+
+// expected returns the least amount the destination should be credited,
+// allowing for deposit fees and withdrawal fees above the estimate
+func (t *Transfer) expected() float64 {
+	if t.Fee >= t.Amount {
+		return 0
+	}
+	return (t.Amount - t.Fee) * (1 - transferFeeTolerance)
+}
+
+func (t *Transfer) finished() bool {
+	switch t.Status {
+	case TransferCompleted, TransferFailed, TransferTimedOut, TransferExpired, TransferWithdrawn:
+		return true
+	}
+	return false
+}
+
+// findWithdrawal finds a transfer's withdrawal by its ID, or by its currency,
+// amount and address when the exchange doesn't return the ID
+func findWithdrawal(history []exchange.FundHistory, t *Transfer) (exchange.FundHistory, bool) {
+	for i := range history {
+		if !strings.EqualFold(history[i].TransferType, "withdrawal") &&
+			!strings.EqualFold(history[i].TransferType, "withdraw") {
+			continue
+		}
+		if t.WithdrawalID != "" && history[i].TransferID != "" {
+			if history[i].TransferID == t.WithdrawalID {
+				return history[i], true
+			}
+			continue
+		}
+		if strings.EqualFold(history[i].Currency, t.Currency.String()) &&
+			!history[i].Timestamp.Before(t.Created) &&
+			math.Abs(history[i].Amount) == t.Amount &&
+			(history[i].CryptoToAddress == "" || history[i].CryptoToAddress == t.Address) {
+			return history[i], true
+		}
+	}
+	return exchange.FundHistory{}, false
+}
+
+// findDeposit finds a transfer's deposit by its transaction ID, or by its
+// currency and amount when the transaction ID isn't known. EVM transaction
+// IDs are compared with or without their 0x prefix as exchanges differ
+func findDeposit(history []exchange.FundHistory, t *Transfer) (exchange.FundHistory, bool) {
+	for i := range history {
+		if !strings.EqualFold(history[i].TransferType, "deposit") {
+			continue
+		}
+		if t.TxID != "" && history[i].CryptoTxID != "" {
+			if strings.EqualFold(strings.TrimPrefix(history[i].CryptoTxID, "0x"), strings.TrimPrefix(t.TxID, "0x")) {
+				return history[i], true
+			}
+			continue
+		}
+		amount := math.Abs(history[i].Amount)
+		if strings.EqualFold(history[i].Currency, t.Currency.String()) &&
+			!history[i].Timestamp.Before(t.Created) &&
+			amount <= t.Amount && amount >= t.expected() {
+			return history[i], true
+		}
+	}
+	return exchange.FundHistory{}, false
+}
+
+// transferFailed reports whether a transfer status means funds didn't move
+func transferFailed(status string) bool {
+	status = strings.ToLower(status)
+	for _, s := range []string{"cancel", "fail", "reject"} {
+		if strings.Contains(status, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// transferPending reports whether a transfer status means it is yet to be
+// credited
+func transferPending(status string) bool {
+	status = strings.ToLower(status)
+	if transferFailed(status) {
+		return true
+	}
+	for _, s := range []string{"pend", "process", "unconfirmed", "await"} {
+		if strings.Contains(status, s) {
+			return true
+		}
+	}
+	return false
+}
+
+// currencyBalance returns the total balance of a currency across accounts
+func currencyBalance(h *account.Holdings, c currency.Code) decimal.Decimal {
+	var total decimal.Decimal
+	for i := range h.Accounts {
+		for j := range h.Accounts[i].Currencies {
+			if h.Accounts[i].Currencies[j].CurrencyName.Match(c) {
+				total = total.Add(h.Accounts[i].Currencies[j].TotalValue)
+			}
+		}
+	}
+	return total
+}
+
+// pushTransferEvent sends a transfer's status to the communication mediums
+func pushTransferEvent(t *Transfer) {
+	msg := fmt.Sprintf("Transfer %s of %v %s from %s to %s %s",
+		t.ID, t.Amount, t.Currency, t.From, t.To, strings.ToLower(string(t.Status)))
+	severity := base.SeverityInfo
+	switch t.Status {
+	case TransferPendingApproval:
+		msg += fmt.Sprintf(", approve by %s with gctcli approvetransfer %s or /approve %s",
+			t.ApprovalExpires.UTC().Format(time.RFC3339), t.ID, t.ID)
+		severity = base.SeverityWarning
+	case TransferCompleted:
+		msg += fmt.Sprintf(", received %v with %v fees", t.Received, t.Fee)
+	case TransferFailed, TransferTimedOut, TransferExpired:
+		msg += ": " + t.Error
+		severity = base.SeverityError
+	}
+	if severity == base.SeverityError {
+		log.Errorln(log.Global, msg)
+	} else {
+		log.Infoln(log.Global, msg)
+	}
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:     base.EventTypeTransfer,
+		Message:  msg,
+		Severity: severity,
+	})
+}
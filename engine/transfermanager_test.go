@@ -0,0 +1,469 @@
+package engine
+
+import (
+	"context"
+	"math"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/wallet"
+)
+
+// transferTestExchange returns canned funding history and balances
+type transferTestExchange struct {
+	exchange.IBotExchange
+	history    []exchange.FundHistory
+	historyErr error
+	balance    decimal.Decimal
+}
+
+func (e *transferTestExchange) GetFundingHistory(context.Context) ([]exchange.FundHistory, error) {
+	return e.history, e.historyErr
+}
+
+func (e *transferTestExchange) UpdateAccountInfo(context.Context) (account.Holdings, error) {
+	return account.Holdings{
+		Accounts: []account.SubAccount{{
+			Currencies: []account.Balance{
+				{CurrencyName: currency.BTC, TotalValue: e.balance},
+				{CurrencyName: currency.ETH, TotalValue: decimal.NewFromInt(100)},
+			},
+		}},
+	}, nil
+}
+
+func testTransfer() Transfer {
+	return Transfer{
+		TransferRequest: TransferRequest{
+			From:     "Bitstamp",
+			To:       "Kraken",
+			Currency: currency.BTC,
+			Amount:   1,
+			Address:  "kraken-address",
+		},
+		ID:           "1",
+		Status:       TransferSubmitted,
+		WithdrawalID: "w1",
+		Fee:          0.0005,
+		FeeEstimated: true,
+		Created:      time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC),
+	}
+}
+
+func TestFindWithdrawal(t *testing.T) {
+	tr := testTransfer()
+	history := []exchange.FundHistory{
+		{TransferType: "deposit", TransferID: "w1", Currency: "BTC", Amount: 1, Timestamp: tr.Created},
+		{TransferType: "withdrawal", TransferID: "w0", Currency: "BTC", Amount: 1, Timestamp: tr.Created},
+		{TransferType: "Withdrawal", TransferID: "w1", Currency: "BTC", Amount: 1, Timestamp: tr.Created, CryptoTxID: "tx"},
+	}
+	w, ok := findWithdrawal(history, &tr)
+	if !ok || w.CryptoTxID != "tx" {
+		t.Fatalf("expected the withdrawal to be matched by ID, received %+v", w)
+	}
+
+	// Without a withdrawal ID it is matched by currency, amount and address
+	history = []exchange.FundHistory{
+		{TransferType: "withdraw", Currency: "btc", Amount: 1, Timestamp: tr.Created.Add(-time.Hour)},
+		{TransferType: "withdraw", Currency: "btc", Amount: 1, Timestamp: tr.Created, CryptoToAddress: "other"},
+		{TransferType: "withdraw", Currency: "btc", Amount: -1, Timestamp: tr.Created, CryptoTxID: "tx2"},
+	}
+	w, ok = findWithdrawal(history, &tr)
+	if !ok || w.CryptoTxID != "tx2" {
+		t.Errorf("expected the withdrawal to be matched by amount, received %+v", w)
+	}
+}
+
+func TestFindDeposit(t *testing.T) {
+	tr := testTransfer()
+	tr.TxID = "tx"
+	history := []exchange.FundHistory{
+		{TransferType: "deposit", Currency: "BTC", Amount: 0.9995, CryptoTxID: "other", Timestamp: tr.Created},
+		{TransferType: "deposit", Currency: "BTC", Amount: 0.9995, CryptoTxID: "tx", Timestamp: tr.Created},
+	}
+	d, ok := findDeposit(history, &tr)
+	if !ok || d.CryptoTxID != "tx" {
+		t.Fatalf("expected the deposit to be matched by transaction ID, received %+v", d)
+	}
+
+	tr.TxID = "0xABC"
+	history[1].CryptoTxID = "abc"
+	if d, ok = findDeposit(history, &tr); !ok || d.CryptoTxID != "abc" {
+		t.Errorf("expected the deposit to be matched without the 0x prefix, received %+v", d)
+	}
+
+	tr.TxID = ""
+	history = []exchange.FundHistory{
+		{TransferType: "deposit", Currency: "BTC", Amount: 0.5, Timestamp: tr.Created},
+		{TransferType: "deposit", Currency: "BTC", Amount: 2, Timestamp: tr.Created},
+		{TransferType: "deposit", Currency: "BTC", Amount: 0.9995, Timestamp: tr.Created},
+	}
+	d, ok = findDeposit(history, &tr)
+	if !ok || d.Amount != 0.9995 {
+		t.Errorf("expected the deposit net of fees to be matched, received %+v", d)
+	}
+}
+
+func TestTransferPending(t *testing.T) {
+	for status, expected := range map[string]bool{
+		"PENDING":     true,
+		"Processing":  true,
+		"unconfirmed": true,
+		"cancelled":   true,
+		"COMPLETED":   false,
+		"success":     false,
+		"":            false,
+	} {
+		if transferPending(status) != expected {
+			t.Errorf("expected %q pending %v", status, expected)
+		}
+	}
+	if !transferFailed("Rejected") || transferFailed("confirmed") {
+		t.Error("unexpected failed transfer status")
+	}
+}
+
+func TestAdvanceTransfer(t *testing.T) {
+	tr := transferState{Transfer: testTransfer(), baseline: decimal.NewFromFloat(2)}
+	src := &transferTestExchange{history: []exchange.FundHistory{
+		{TransferType: "withdrawal", TransferID: "w1", Status: "pending", Currency: "BTC", Amount: 1, Fee: 0.001},
+	}}
+	dst := &transferTestExchange{historyErr: common.ErrFunctionNotSupported, balance: decimal.NewFromFloat(2)}
+
+	resp, err := advanceTransfer(context.Background(), &tr, src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != TransferSubmitted || resp.Fee != 0.001 || resp.FeeEstimated {
+		t.Errorf("expected the reported fee without a transaction ID, received %+v", resp)
+	}
+
+	src.history[0].CryptoTxID = "tx"
+	resp, err = advanceTransfer(context.Background(), &tr, src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != TransferConfirming || resp.TxID != "tx" {
+		t.Errorf("expected the transfer to be confirming, received %+v", resp)
+	}
+
+	// The destination balance grows by the amount sent less fees
+	tr.Transfer = resp
+	dst.balance = decimal.NewFromFloat(2.998)
+	resp, err = advanceTransfer(context.Background(), &tr, src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != TransferCompleted || resp.Received != 0.998 {
+		t.Fatalf("expected the transfer to be completed, received %+v", resp)
+	}
+	if resp.Fee < 0.00199 || resp.Fee > 0.00201 {
+		t.Errorf("expected the shortfall to be accounted as fees, received %v", resp.Fee)
+	}
+
+	tr.Transfer = testTransfer()
+	src.history[0].Status = "Cancelled"
+	resp, err = advanceTransfer(context.Background(), &tr, src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != TransferFailed || resp.Error == "" {
+		t.Errorf("expected the transfer to fail, received %+v", resp)
+	}
+}
+
+func TestAdvanceTransferDepositHistory(t *testing.T) {
+	tr := transferState{Transfer: testTransfer()}
+	tr.Status = TransferConfirming
+	tr.TxID = "tx"
+	src := &transferTestExchange{historyErr: common.ErrFunctionNotSupported}
+	dst := &transferTestExchange{history: []exchange.FundHistory{
+		{TransferType: "deposit", CryptoTxID: "tx", Status: "pending", Amount: 0.9995},
+	}}
+
+	resp, err := advanceTransfer(context.Background(), &tr, src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != TransferConfirming {
+		t.Errorf("expected a pending deposit to leave the transfer confirming, received %s", resp.Status)
+	}
+
+	dst.history[0].Status = "completed"
+	resp, err = advanceTransfer(context.Background(), &tr, src, dst)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Status != TransferCompleted || resp.Received != 0.9995 {
+		t.Errorf("expected the transfer to be completed, received %+v", resp)
+	}
+}
+
+func TestCurrencyBalance(t *testing.T) {
+	h := account.Holdings{Accounts: []account.SubAccount{
+		{Currencies: []account.Balance{{CurrencyName: currency.BTC, TotalValue: decimal.NewFromInt(1)}}},
+		{Currencies: []account.Balance{{CurrencyName: currency.BTC, TotalValue: decimal.NewFromInt(2)}}},
+	}}
+	if b := currencyBalance(&h, currency.BTC); !b.Equal(decimal.NewFromInt(3)) {
+		t.Errorf("expected a balance of 3, received %v", b)
+	}
+	if b := currencyBalance(&h, currency.ETH); !b.IsZero() {
+		t.Errorf("expected no balance, received %v", b)
+	}
+}
+
+func TestTransferManagerSubmit(t *testing.T) {
+	SetupTestHelpers(t)
+	var m transferManager
+	_, err := m.Submit(context.Background(), &TransferRequest{})
+	if err != errTransferManagerNotStarted {
+		t.Fatalf("expected %v, received %v", errTransferManagerNotStarted, err)
+	}
+	if err = m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Stop()
+
+	_, err = m.Submit(context.Background(), &TransferRequest{From: "Bitstamp", To: "bitstamp"})
+	if err != errTransferSameExchange {
+		t.Errorf("expected %v, received %v", errTransferSameExchange, err)
+	}
+	_, err = m.Submit(context.Background(), &TransferRequest{From: "Bitstamp", To: "Kraken", Network: "BSC"})
+	if err != errTransferAddressRequired {
+		t.Errorf("expected %v, received %v", errTransferAddressRequired, err)
+	}
+	_, err = m.Submit(context.Background(), &TransferRequest{From: "Bitstamp", To: "Kraken"})
+	if err == nil {
+		t.Error("expected an error for unloaded exchanges")
+	}
+	if _, err = m.Transfer("missing"); err != errTransferNotFound {
+		t.Errorf("expected %v, received %v", errTransferNotFound, err)
+	}
+	if len(m.Transfers()) != 0 {
+		t.Error("expected no transfers")
+	}
+}
+
+// transferTestWallet is a hot wallet which counts its sends
+type transferTestWallet struct {
+	wallet.Wallet
+	sends int
+}
+
+func (w *transferTestWallet) Name() string { return "cold" }
+
+func (w *transferTestWallet) Currency() currency.Code { return currency.BTC }
+
+func (w *transferTestWallet) Send(context.Context, string, decimal.Decimal) (*wallet.Transaction, error) {
+	w.sends++
+	return &wallet.Transaction{TxID: "tx"}, nil
+}
+
+func TestTransferManagerSubmitFromWallet(t *testing.T) {
+	SetupTest(t)
+	var m transferManager
+	if err := m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Stop()
+	if m.hotWallet("cold") != nil {
+		t.Fatal("expected no hot wallets with hot wallets disabled")
+	}
+
+	w := &transferTestWallet{}
+	m.wallets = map[string]*hotWallet{
+		"cold": {Wallet: w, cfg: config.HotWalletConfig{
+			Name:             "cold",
+			MaxAmount:        1,
+			AllowedExchanges: []string{"Kraken"},
+		}},
+	}
+	requests := []TransferRequest{
+		{From: "COLD", To: testExchange, Currency: currency.ETH, Amount: 0.5},
+		{From: "cold", To: testExchange, Currency: currency.BTC, Amount: 2},
+		{From: "cold", To: testExchange, Currency: currency.BTC, Amount: 0.5},
+		{From: "cold", To: "Kraken", Currency: currency.BTC, Amount: 0.5},
+	}
+	for i := range requests {
+		if _, err := m.Submit(context.Background(), &requests[i]); err == nil {
+			t.Errorf("expected an error submitting %+v", requests[i])
+		}
+	}
+
+	m.wallets["cold"].cfg.AllowedExchanges = []string{testExchange}
+	_, err := m.Submit(context.Background(), &TransferRequest{
+		From: "cold", To: testExchange, Currency: currency.BTC, Amount: 0.5, Address: "elsewhere",
+	})
+	if err == nil || !strings.Contains(err.Error(), errTransferNotAllowed.Error()) {
+		t.Errorf("expected %v, received %v", errTransferNotAllowed, err)
+	}
+	if w.sends != 0 {
+		t.Errorf("expected no transactions to be sent, received %v", w.sends)
+	}
+}
+
+// approvalTestExchange is a transfer destination
+type approvalTestExchange struct {
+	transferTestExchange
+}
+
+func (e *approvalTestExchange) GetName() string { return "approvalTest" }
+
+func TestTransferManagerApproval(t *testing.T) {
+	SetupTest(t)
+	dst := &approvalTestExchange{}
+	Bot.exchangeManager.add(dst)
+	defer func() {
+		if err := Bot.exchangeManager.removeExchange(dst.GetName()); err != nil {
+			t.Error(err)
+		}
+	}()
+	var m transferManager
+	if err := m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Stop()
+
+	w := &transferTestWallet{}
+	m.wallets = map[string]*hotWallet{
+		"cold": {Wallet: w, cfg: config.HotWalletConfig{
+			Name:             "cold",
+			MaxAmount:        1,
+			AllowedExchanges: []string{dst.GetName()},
+			AllowedAddresses: []string{"address"},
+		}},
+	}
+	m.approval = config.ApprovalConfig{
+		Enabled: true,
+		Window:  time.Hour,
+		Limits:  map[string]float64{"BTC": 0.1},
+	}
+	if !m.requiresApproval(currency.ETH, 0.01) {
+		t.Error("expected a currency without a limit to require approval")
+	}
+	request := func(amount float64) *TransferRequest {
+		return &TransferRequest{From: "cold", To: dst.GetName(), Currency: currency.BTC, Amount: amount, Address: "address"}
+	}
+
+	sent, err := m.Submit(context.Background(), request(0.05))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if sent.Status != TransferConfirming || w.sends != 1 {
+		t.Fatalf("expected a transfer within the limit to be sent, received %+v", sent)
+	}
+
+	held, err := m.Submit(context.Background(), request(0.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if held.Status != TransferPendingApproval || held.ApprovalExpires.IsZero() || w.sends != 1 {
+		t.Fatalf("expected a transfer above the limit to be held, received %+v", held)
+	}
+	if _, err = m.Approve(context.Background(), "missing", "tester"); err != errTransferNotFound {
+		t.Errorf("expected %v, received %v", errTransferNotFound, err)
+	}
+	approved, err := m.Approve(context.Background(), held.ID, "tester")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved.ID != held.ID || approved.Status != TransferConfirming || approved.ApprovedBy != "tester" || w.sends != 2 {
+		t.Errorf("expected the approved transfer to be sent, received %+v", approved)
+	}
+	_, err = m.Approve(context.Background(), held.ID, "tester")
+	if err == nil || !strings.HasPrefix(err.Error(), errTransferNotPending.Error()) {
+		t.Errorf("expected %v, received %v", errTransferNotPending, err)
+	}
+
+	// Approving after the window expires the transfer
+	held, err = m.Submit(context.Background(), request(0.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.mtx.Lock()
+	m.transfers[held.ID].ApprovalExpires = time.Now().Add(-time.Second)
+	m.mtx.Unlock()
+	_, err = m.Approve(context.Background(), held.ID, "tester")
+	if err == nil || !strings.HasPrefix(err.Error(), errApprovalExpired.Error()) {
+		t.Errorf("expected %v, received %v", errApprovalExpired, err)
+	}
+	if tr, _ := m.Transfer(held.ID); tr.Status != TransferExpired || w.sends != 2 {
+		t.Errorf("expected the transfer to expire unsent, received %+v", tr)
+	}
+
+	// Transfers left pending expire once the window passes
+	m.approval.Window = time.Millisecond
+	held, err = m.Submit(context.Background(), request(0.5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 100; i++ {
+		if tr, _ := m.Transfer(held.ID); tr.Status == TransferExpired {
+			break
+		}
+		time.Sleep(time.Millisecond * 10)
+	}
+	if tr, _ := m.Transfer(held.ID); tr.Status != TransferExpired {
+		t.Errorf("expected the transfer to expire, received %+v", tr)
+	}
+}
+
+func TestTransferManagerWithdrawApproval(t *testing.T) {
+	SetupTest(t)
+	var m transferManager
+	var sends int
+	send := func(context.Context) (string, error) {
+		sends++
+		return "w1", nil
+	}
+	Bot.Config.TransferManager.Approval = config.ApprovalConfig{
+		Enabled: true,
+		Window:  time.Hour,
+		Limits:  map[string]float64{"BTC": 0.1},
+	}
+	defer func() { Bot.Config.TransferManager.Approval = config.ApprovalConfig{} }()
+
+	_, err := m.Withdraw(context.Background(), testExchange, currency.BTC, 0.5, "address", "address", send)
+	if err != errApprovalUnavailable || sends != 0 {
+		t.Fatalf("expected %v, received %v", errApprovalUnavailable, err)
+	}
+	if err = m.Start(); err != nil {
+		t.Fatal(err)
+	}
+	defer m.Stop()
+
+	id, err := m.Withdraw(context.Background(), testExchange, currency.BTC, 0.05, "address", "address", send)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if id != "w1" || sends != 1 {
+		t.Fatalf("expected a withdrawal within the limit to be sent, received %v", id)
+	}
+	_, err = m.Withdraw(context.Background(), testExchange, currency.BTC, math.NaN(), "address", "address", send)
+	if err == nil || sends != 1 {
+		t.Fatalf("expected an invalid amount to be rejected, received %v", err)
+	}
+
+	_, err = m.Withdraw(context.Background(), testExchange, currency.BTC, 0.5, "address", "address", send)
+	if err == nil || !strings.HasPrefix(err.Error(), errWithdrawalPendingApproval.Error()) {
+		t.Fatalf("expected %v, received %v", errWithdrawalPendingApproval, err)
+	}
+	transfers := m.Transfers()
+	if len(transfers) != 1 || transfers[0].Status != TransferPendingApproval || sends != 1 {
+		t.Fatalf("expected a withdrawal above the limit to be held, received %+v", transfers)
+	}
+	approved, err := m.Approve(context.Background(), transfers[0].ID, "tester")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if approved.Status != TransferWithdrawn || approved.WithdrawalID != "w1" || approved.ApprovedBy != "tester" || sends != 2 {
+		t.Errorf("expected the approved withdrawal to be sent, received %+v", approved)
+	}
+}
@@ -0,0 +1,151 @@
+package engine
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/wallet"
+)
+
+const (
+	transferManagerName = "transfer manager"
+	auditEventTransfer  = "transfer"
+	// transferFeeTolerance is the fraction of a transfer's amount less fees
+	// which may go missing to fees not accounted for before it is credited
+	transferFeeTolerance = 0.01
+)
+
+// TransferStatus is the progress of a transfer between exchanges
+type TransferStatus string
+
+// Transfer statuses
+const (
+	// TransferPendingApproval is a transfer held until it is approved, as it
+	// is above the approval limit of its currency
+	TransferPendingApproval TransferStatus = "PENDING_APPROVAL"
+	// TransferApproved is an approved transfer being sent
+	TransferApproved TransferStatus = "APPROVED"
+	// TransferExpired is a transfer which wasn't approved within the approval
+	// window and was never sent
+	TransferExpired TransferStatus = "EXPIRED"
+	// TransferSubmitted is a withdrawal accepted by the source exchange
+	TransferSubmitted TransferStatus = "SUBMITTED"
+	// TransferConfirming is a withdrawal sent on chain which is yet to be
+	// credited by the destination exchange
+	TransferConfirming TransferStatus = "CONFIRMING"
+	// TransferCompleted is a transfer credited by the destination exchange
+	TransferCompleted TransferStatus = "COMPLETED"
+	// TransferFailed is a withdrawal rejected or cancelled by the source
+	// exchange
+	TransferFailed TransferStatus = "FAILED"
+	// TransferTimedOut is a transfer not credited by the destination exchange
+	// within the configured timeout, it needs checking manually
+	TransferTimedOut TransferStatus = "TIMED_OUT"
+	// TransferWithdrawn is an approved withdrawal to an address or bank
+	// account outside of a transfer, accepted by the exchange. It isn't
+	// followed any further
+	TransferWithdrawn TransferStatus = "WITHDRAWN"
+)
+
+var (
+	errTransferManagerNotStarted = errors.New("transfer manager not started")
+	errTransferSameExchange      = errors.New("cannot transfer to the same exchange")
+	errTransferAddressRequired   = errors.New("an address must be set when selecting a network")
+	errTransferNotFound          = errors.New("transfer not found")
+	errTransferNotAllowed        = errors.New("destination not allowed for hot wallet")
+	errTransferNotPending        = errors.New("transfer is not pending approval")
+	errApprovalExpired           = errors.New("transfer approval window has passed")
+	errApprovalRequired          = errors.New("withdrawal requires approval")
+	errApprovalUnavailable       = errors.New("withdrawal requires approval but the transfer manager isn't running to hold it")
+	errWithdrawalPendingApproval = errors.New("withdrawal held pending approval")
+)
+
+// transferManager moves funds between exchanges by withdrawing from one and
+// following the withdrawal until it is credited by the other, so rebalancing
+// and inventory management don't need to track transfers themselves
+type transferManager struct {
+	started   int32
+	stopped   int32
+	shutdown  chan struct{}
+	interval  time.Duration
+	timeout   time.Duration
+	approval  config.ApprovalConfig
+	mtx       sync.RWMutex
+	transfers map[string]*transferState
+	wallets   map[string]*hotWallet
+}
+
+// hotWallet is a wallet the transfer manager may send from and the limits on
+// its transfers
+type hotWallet struct {
+	wallet.Wallet
+	cfg config.HotWalletConfig
+}
+
+// transferState holds a transfer along with what is needed to follow it
+type transferState struct {
+	Transfer
+	// baseline is the destination balance of the currency before the
+	// withdrawal, used to spot the deposit on exchanges without funding
+	// history
+	baseline decimal.Decimal
+	deadline time.Time
+	// send makes a withdrawal held outside of a transfer once it is approved
+	send func(context.Context) (string, error)
+}
+
+// withdrawal is funds leaving an exchange or hot wallet, sent by send once
+// checked, approved and reserved against the withdrawal limits
+type withdrawal struct {
+	source   string
+	currency currency.Code
+	amount   float64
+	address  string
+	// approved is set for withdrawals held pending approval and since
+	// approved
+	approved bool
+	send     func(context.Context) (string, error)
+}
+
+// TransferRequest defines a transfer of a cryptocurrency between exchanges, or
+// from a hot wallet to an exchange when From names a hot wallet
+type TransferRequest struct {
+	From     string
+	To       string
+	Currency currency.Code
+	Amount   float64
+	// Network selects the chain to withdraw over, an Address on that network
+	// must also be set as the destination's deposit address can't be looked
+	// up per network
+	Network string
+	// Address defaults to the destination exchange's deposit address
+	Address    string
+	AddressTag string
+}
+
+// Transfer is a transfer between exchanges and its progress
+type Transfer struct {
+	TransferRequest
+	ID           string
+	Status       TransferStatus
+	WithdrawalID string
+	TxID         string
+	// Fee is the withdrawal fee estimated by the source exchange until it
+	// reports the fee charged. Once completed it is the larger of that and
+	// the shortfall between the amount sent and received
+	Fee          float64
+	FeeEstimated bool
+	Received     float64
+	Error        string
+	Created      time.Time
+	Updated      time.Time
+	// ApprovalExpires is when a transfer pending approval expires
+	ApprovalExpires time.Time
+	// ApprovedBy is who approved a transfer which required approval
+	ApprovedBy string
+}
@@ -93,6 +93,9 @@ func (c *WebsocketClient) read() {
 	defer func() {
 		c.Hub.Unregister <- c
 		c.Conn.Close()
+		if c.session != "" {
+			Bot.rpcSessions.end(c.session)
+		}
 	}()
 
 	for {
@@ -138,6 +141,9 @@ func (c *WebsocketClient) read() {
 				continue
 			}
 
+			if c.session != "" {
+				Bot.rpcSessions.seen(c.session)
+			}
 			err = result.handler(c, dataJSON)
 			if err != nil {
 				log.Errorf(log.WebsocketMgr, "websocket: request %s failed. Error %s\n", evt.Event, err)
@@ -241,7 +247,7 @@ func WebsocketClientHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
-	client := &WebsocketClient{Hub: wsHub, Conn: conn, Send: make(chan []byte, 1024)}
+	client := &WebsocketClient{Hub: wsHub, Conn: conn, Send: make(chan []byte, 1024), address: r.RemoteAddr}
 	client.Hub.Register <- client
 	log.Debugf(log.WebsocketMgr,
 		"websocket: client connected. Connected clients: %d. Limit %d.\n",
@@ -266,6 +272,14 @@ func wsAuth(client *WebsocketClient, data interface{}) error {
 
 	hashPW := crypto.HexEncodeToString(crypto.GetSHA256([]byte(Bot.Config.RemoteControl.Password)))
 	if auth.Username == Bot.Config.RemoteControl.Username && auth.Password == hashPW {
+		if !client.Authenticated {
+			client.session, err = Bot.rpcSessions.open(client.address, func() { client.Conn.Close() })
+			if err != nil {
+				wsResp.Error = err.Error()
+				client.SendWebsocketMessage(wsResp)
+				return err
+			}
+		}
 		client.Authenticated = true
 		wsResp.Data = WebsocketResponseSuccess
 		log.Debugln(log.WebsocketMgr,
@@ -9,6 +9,10 @@ type WebsocketClient struct {
 	Authenticated bool
 	authFailures  int
 	Send          chan []byte
+	// address is the client's remote address and session its session once
+	// authenticated
+	address string
+	session string
 }
 
 // WebsocketHub stores the data for managing websocket clients
@@ -0,0 +1,157 @@
+package engine
+
+import (
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/communications/base"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/audit"
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawalcounter"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+func (l *withdrawalLimiter) Started() bool {
+	return atomic.LoadInt32(&l.started) == 1
+}
+
+func (l *withdrawalLimiter) Start() error {
+	if !Bot.DatabaseManager.Started() {
+		return errWithdrawalLimitsNeedDatabase
+	}
+	if atomic.AddInt32(&l.started, 1) != 1 {
+		return errors.New("withdrawal limiter already started")
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	l.alertPercent = Bot.Config.WithdrawalLimits.AlertPercent
+	l.limits = Bot.Config.WithdrawalLimits.Daily
+	l.day = ""
+	if err := l.load(time.Now()); err != nil {
+		atomic.CompareAndSwapInt32(&l.started, 1, 0)
+		return err
+	}
+	log.Debugf(log.Global, "Withdrawal limiter started with %d daily limits.\n", len(l.limits))
+	return nil
+}
+
+func (l *withdrawalLimiter) Stop() error {
+	if !atomic.CompareAndSwapInt32(&l.started, 1, 0) {
+		return errors.New("withdrawal limiter not started")
+	}
+	log.Debugln(log.Global, "Withdrawal limiter shutdown.")
+	return nil
+}
+
+// Reserve counts a withdrawal against the daily limit of its currency,
+// refusing it if it would take the day's withdrawals above the limit. The
+// returned func releases the amount if the withdrawal then fails. Every
+// withdrawal is refused while limits are enabled but the limiter isn't running
+func (l *withdrawalLimiter) Reserve(code currency.Code, amount decimal.Decimal) (release func(), err error) {
+	release = func() {}
+	if !l.Started() {
+		if Bot.Config.WithdrawalLimits.Enabled {
+			return release, errWithdrawalLimitsUnavailable
+		}
+		return release, nil
+	}
+	key := code.Upper().String()
+	limit, ok := l.limits[key]
+	if !ok {
+		return release, nil
+	}
+
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	if err = l.load(time.Now()); err != nil {
+		return release, fmt.Errorf("unable to load withdrawal counters: %v", err)
+	}
+	c, ok := l.counters[key]
+	if !ok {
+		c = &withdrawalcounter.Counter{Currency: key, Day: l.day}
+		l.counters[key] = c
+	}
+	total := decimal.NewFromFloat(c.Amount).Add(amount)
+	if total.GreaterThan(decimal.NewFromFloat(limit)) {
+		msg := fmt.Sprintf("Withdrawal of %v %s refused, %v of the %v daily limit has been withdrawn today",
+			amount, key, c.Amount, limit)
+		audit.Event(key, auditEventWithdrawalLimit, msg)
+		pushWithdrawalLimitEvent(msg, base.SeverityError)
+		return release, fmt.Errorf("%v: %v %s withdrawn of %v today",
+			errWithdrawalLimitExceeded, c.Amount, key, limit)
+	}
+
+	updated := *c
+	updated.Amount = total.Float64()
+	alert := !c.Alerted && updated.Amount >= limit*l.alertPercent/100
+	updated.Alerted = c.Alerted || alert
+	if err = withdrawalcounter.Set(&updated); err != nil {
+		return release, fmt.Errorf("unable to store %s withdrawal counter: %v", key, err)
+	}
+	*c = updated
+	if alert {
+		pushWithdrawalLimitEvent(fmt.Sprintf("Withdrawals of %s today are %v, %.2f%% of the %v daily limit",
+			key, updated.Amount, updated.Amount/limit*100, limit), base.SeverityWarning)
+	}
+
+	day := l.day
+	return func() { l.release(key, day, amount) }, nil
+}
+
+// release takes a failed withdrawal off the counter of the day it was
+// reserved on
+func (l *withdrawalLimiter) release(key, day string, amount decimal.Decimal) {
+	l.mtx.Lock()
+	defer l.mtx.Unlock()
+	c, ok := l.counters[key]
+	if !ok || day != l.day {
+		return
+	}
+	updated := *c
+	remaining := decimal.NewFromFloat(c.Amount).Sub(amount)
+	if remaining.Sign() < 0 {
+		remaining = decimal.Zero
+	}
+	updated.Amount = remaining.Float64()
+	if err := withdrawalcounter.Set(&updated); err != nil {
+		log.Errorf(log.Global, "Withdrawal limiter unable to release %v %s: %v\n", amount, key, err)
+		return
+	}
+	*c = updated
+}
+
+// load loads the counters of the UTC day from the database when the day has
+// changed
+func (l *withdrawalLimiter) load(now time.Time) error {
+	day := now.UTC().Format(withdrawalcounter.DayFormat)
+	if day == l.day {
+		return nil
+	}
+	counters, err := withdrawalcounter.Get(day)
+	if err != nil {
+		return err
+	}
+	l.counters = make(map[string]*withdrawalcounter.Counter, len(counters))
+	for i := range counters {
+		l.counters[counters[i].Currency] = &counters[i]
+	}
+	l.day = day
+	return nil
+}
+
+func pushWithdrawalLimitEvent(msg string, severity base.Severity) {
+	if severity == base.SeverityError {
+		log.Errorln(log.Global, msg)
+	} else {
+		log.Warnln(log.Global, msg)
+	}
+	Bot.CommsManager.PushEvent(base.Event{
+		Type:     base.EventTypeAlert,
+		Message:  msg,
+		Severity: severity,
+	})
+}
@@ -0,0 +1,32 @@
+package engine
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+func TestWithdrawalLimiterReserve(t *testing.T) {
+	SetupTestHelpers(t)
+	enabled := Bot.Config.WithdrawalLimits.Enabled
+	defer func() { Bot.Config.WithdrawalLimits.Enabled = enabled }()
+
+	var l withdrawalLimiter
+	Bot.Config.WithdrawalLimits.Enabled = false
+	release, err := l.Reserve(currency.BTC, decimal.NewFromInt(100))
+	if err != nil {
+		t.Errorf("expected withdrawals to be unlimited when limits are disabled, got %v", err)
+	}
+	release()
+
+	Bot.Config.WithdrawalLimits.Enabled = true
+	if _, err = l.Reserve(currency.BTC, decimal.NewFromInt(100)); err != errWithdrawalLimitsUnavailable {
+		t.Errorf("expected %v, got %v", errWithdrawalLimitsUnavailable, err)
+	}
+	if !Bot.DatabaseManager.Started() {
+		if err = l.Start(); err != errWithdrawalLimitsNeedDatabase {
+			t.Errorf("expected %v, got %v", errWithdrawalLimitsNeedDatabase, err)
+		}
+	}
+}
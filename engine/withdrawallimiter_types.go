@@ -0,0 +1,30 @@
+package engine
+
+import (
+	"errors"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/database/repository/withdrawalcounter"
+)
+
+const auditEventWithdrawalLimit = "withdrawal limit"
+
+var (
+	errWithdrawalLimitsNeedDatabase = errors.New("withdrawal limits require the database to be enabled")
+	errWithdrawalLimitsUnavailable  = errors.New("withdrawal limits are enabled but the withdrawal limiter isn't running")
+	errWithdrawalLimitExceeded      = errors.New("daily withdrawal limit exceeded")
+)
+
+// withdrawalLimiter counts the withdrawals of each currency made by the
+// engine against its daily limit, refusing those which would exceed it. The
+// counters are stored in the database so they survive a restart
+type withdrawalLimiter struct {
+	started      int32
+	alertPercent float64
+	limits       map[string]float64
+	mtx          sync.Mutex
+	// day is the UTC day the counters are for, they are reloaded from the
+	// database when it changes
+	day      string
+	counters map[string]*withdrawalcounter.Counter
+}
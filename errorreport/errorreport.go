@@ -0,0 +1,313 @@
+// Package errorreport reports panics and errors to Sentry, or any other
+// Reporter, tagged with the release and commit and scrubbed of credentials
+package errorreport
+
+import (
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"os"
+	"runtime"
+	"sort"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+const inAppModule = "github.com/thrasher-corp/gocryptotrader"
+
+var (
+	global atomic.Value // *client
+
+	errAlreadyStarted = errors.New("error reporting already started")
+)
+
+// Setup starts reporting events to the Sentry DSN in the supplied config
+func Setup(cfg *Config, release, commit string, secrets []string) error {
+	if cfg == nil {
+		return errors.New("error reporting config is nil")
+	}
+	if !cfg.Enabled {
+		return nil
+	}
+	r, err := NewSentryReporter(cfg.DSN)
+	if err != nil {
+		return err
+	}
+	return Start(r, &Options{
+		Release:            release,
+		Commit:             commit,
+		Environment:        cfg.Environment,
+		MaxEventsPerMinute: cfg.MaxEventsPerMinute,
+		Secrets:            secrets,
+	})
+}
+
+// Start starts sending captured events to the reporter
+func Start(r Reporter, opts *Options) error {
+	if r == nil {
+		return errors.New("error reporter is nil")
+	}
+	if opts == nil {
+		opts = &Options{}
+	}
+	if Enabled() {
+		return errAlreadyStarted
+	}
+	c := &client{
+		reporter: r,
+		opts:     *opts,
+		secrets:  filterSecrets(opts.Secrets),
+		queue:    make(chan *Event, defaultQueueSize),
+		flush:    make(chan chan struct{}),
+		shutdown: make(chan struct{}),
+	}
+	if c.opts.MaxEventsPerMinute <= 0 {
+		c.opts.MaxEventsPerMinute = DefaultMaxEventsPerMinute
+	}
+	c.serverName, _ = os.Hostname()
+	c.wg.Add(1)
+	go c.run()
+	global.Store(c)
+	return nil
+}
+
+// Shutdown sends any queued events and stops error reporting
+func Shutdown() {
+	c := getClient()
+	if c == nil {
+		return
+	}
+	global.Store((*client)(nil))
+	close(c.shutdown)
+	c.wg.Wait()
+}
+
+// Flush blocks until all queued events have been sent or the flush timeout
+// elapses
+func Flush() {
+	c := getClient()
+	if c == nil {
+		return
+	}
+	done := make(chan struct{})
+	select {
+	case c.flush <- done:
+	case <-c.shutdown:
+		return
+	case <-time.After(defaultFlushTimeout):
+		return
+	}
+	select {
+	case <-done:
+	case <-time.After(defaultFlushTimeout):
+	}
+}
+
+// Enabled returns whether events are being reported
+func Enabled() bool {
+	return getClient() != nil
+}
+
+func getClient() *client {
+	c, _ := global.Load().(*client)
+	return c
+}
+
+// CaptureError reports an error with its stack trace
+func CaptureError(err error, tags map[string]string) {
+	c := getClient()
+	if c == nil || err == nil {
+		return
+	}
+	c.capture(LevelError, fmt.Sprintf("%T", err), err.Error(), tags, callers(3))
+}
+
+// CaptureMessage reports a message at the error level without a stack trace,
+// used to forward error log lines
+func CaptureMessage(message string, tags map[string]string) {
+	c := getClient()
+	if c == nil {
+		return
+	}
+	c.capture(LevelError, "", message, tags, nil)
+}
+
+// Recover reports a panic and then panics again so the process crashes as it
+// would without error reporting. It must be deferred directly:
+//
+//	defer errorreport.Recover()
+func Recover() {
+	r := recover()
+	if r == nil {
+		return
+	}
+	if c := getClient(); c != nil {
+		c.capture(LevelFatal, "panic", fmt.Sprint(r), nil, callers(3))
+		Flush()
+	}
+	panic(r)
+}
+
+// CapturePanic reports a recovered panic with the stack of the caller, used by
+// callers which recover from panics rather than crash
+func CapturePanic(r interface{}, tags map[string]string) {
+	c := getClient()
+	if c == nil {
+		return
+	}
+	c.capture(LevelFatal, "panic", fmt.Sprint(r), tags, callers(3))
+}
+
+// capture builds, scrubs and queues an event, dropping it if the rate limit
+// has been reached or the queue is full so reporting never blocks the bot
+func (c *client) capture(level, errType, message string, tags map[string]string, frames []Frame) {
+	if !c.allow() {
+		return
+	}
+	e := &Event{
+		ID:          newEventID(),
+		Timestamp:   time.Now().UTC(),
+		Level:       level,
+		Message:     c.scrub(message),
+		ErrorType:   errType,
+		Release:     c.opts.Release,
+		Environment: c.opts.Environment,
+		ServerName:  c.serverName,
+		Tags:        make(map[string]string, len(tags)+1),
+		Stacktrace:  frames,
+	}
+	for k, v := range tags {
+		e.Tags[k] = c.scrub(v)
+	}
+	if c.opts.Commit != "" {
+		e.Tags["commit"] = c.opts.Commit
+	}
+	select {
+	case c.queue <- e:
+	default:
+	}
+}
+
+// allow applies the per minute event limit
+func (c *client) allow() bool {
+	c.limitMtx.Lock()
+	defer c.limitMtx.Unlock()
+	now := time.Now()
+	if now.Sub(c.windowStart) >= time.Minute {
+		c.windowStart = now
+		c.windowCount = 0
+	}
+	if c.windowCount >= c.opts.MaxEventsPerMinute {
+		return false
+	}
+	c.windowCount++
+	return true
+}
+
+// scrub removes known secrets and credential key value pairs from s
+func (c *client) scrub(s string) string {
+	for i := range c.secrets {
+		s = strings.Replace(s, c.secrets[i], scrubbed, -1)
+	}
+	return credentialPattern.ReplaceAllString(s, "${1}"+scrubbed)
+}
+
+// filterSecrets removes duplicate and short secrets and orders the rest
+// longest first so a secret containing another is scrubbed whole
+func filterSecrets(secrets []string) []string {
+	seen := make(map[string]struct{}, len(secrets))
+	var filtered []string
+	for i := range secrets {
+		if len(secrets[i]) < minSecretLength {
+			continue
+		}
+		if _, ok := seen[secrets[i]]; ok {
+			continue
+		}
+		seen[secrets[i]] = struct{}{}
+		filtered = append(filtered, secrets[i])
+	}
+	sort.Slice(filtered, func(i, j int) bool { return len(filtered[i]) > len(filtered[j]) })
+	return filtered
+}
+
+// callers returns the stack of the caller, oldest frame first, excluding the
+// runtime
+func callers(skip int) []Frame {
+	pcs := make([]uintptr, 64)
+	n := runtime.Callers(skip, pcs)
+	frames := runtime.CallersFrames(pcs[:n])
+	var stack []Frame
+	for {
+		f, more := frames.Next()
+		if !strings.HasPrefix(f.Function, "runtime.") {
+			module, function := splitFunction(f.Function)
+			stack = append(stack, Frame{
+				Function: function,
+				Module:   module,
+				File:     f.File,
+				Line:     f.Line,
+			})
+		}
+		if !more {
+			break
+		}
+	}
+	for i, j := 0, len(stack)-1; i < j; i, j = i+1, j-1 {
+		stack[i], stack[j] = stack[j], stack[i]
+	}
+	return stack
+}
+
+// splitFunction splits a fully qualified function name into its package path
+// and function name
+func splitFunction(name string) (module, function string) {
+	pkgStart := strings.LastIndex(name, "/") + 1
+	dot := strings.Index(name[pkgStart:], ".")
+	if dot < 0 {
+		return "", name
+	}
+	return name[:pkgStart+dot], name[pkgStart+dot+1:]
+}
+
+func newEventID() string {
+	b := make([]byte, 16)
+	_, _ = rand.Read(b)
+	return hex.EncodeToString(b)
+}
+
+func (c *client) run() {
+	defer c.wg.Done()
+	send := func(e *Event) {
+		if err := c.reporter.Report(e); err != nil {
+			// Logged as a warning as error logs may be reported
+			log.Warnf(log.Global, "Error reporting: failed to send event: %v\n", err)
+		}
+	}
+	drain := func() {
+		for {
+			select {
+			case e := <-c.queue:
+				send(e)
+			default:
+				return
+			}
+		}
+	}
+	for {
+		select {
+		case e := <-c.queue:
+			send(e)
+		case done := <-c.flush:
+			drain()
+			close(done)
+		case <-c.shutdown:
+			drain()
+			return
+		}
+	}
+}
@@ -0,0 +1,201 @@
+package errorreport
+
+import (
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+)
+
+type testReporter struct {
+	mtx    sync.Mutex
+	events []*Event
+}
+
+func (r *testReporter) Report(e *Event) error {
+	r.mtx.Lock()
+	r.events = append(r.events, e)
+	r.mtx.Unlock()
+	return nil
+}
+
+func (r *testReporter) get() []*Event {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	return r.events
+}
+
+func TestDisabled(t *testing.T) {
+	if Enabled() {
+		t.Fatal("expected error reporting to be disabled")
+	}
+	// Captures must be no-ops while disabled
+	CaptureError(errors.New("error"), nil)
+	CaptureMessage("message", nil)
+	Flush()
+	Shutdown()
+
+	if err := Setup(&Config{}, "", "", nil); err != nil {
+		t.Error(err)
+	}
+	if err := Setup(&Config{Enabled: true, DSN: "https://sentry.io/1"}, "", "", nil); err == nil {
+		t.Error("expected error on DSN without public key")
+	}
+}
+
+func TestCapture(t *testing.T) {
+	r := &testReporter{}
+	err := Start(r, &Options{
+		Release: "gocryptotrader@v0.1",
+		Commit:  "abc1234",
+		Secrets: []string{"Key", "supersecretapikey", "supersecretapikey"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer Shutdown()
+	if err = Start(r, nil); err != errAlreadyStarted {
+		t.Errorf("expected %v, received %v", errAlreadyStarted, err)
+	}
+
+	CaptureError(errors.New("auth failed for supersecretapikey"), map[string]string{"exchange": "Bitstamp"})
+	CaptureMessage(`request failed ?apikey=abcdef&signature=123456 {"password": "hunter22"}`, nil)
+	Flush()
+
+	events := r.get()
+	if len(events) != 2 {
+		t.Fatalf("expected 2 events, received %d", len(events))
+	}
+	e := events[0]
+	if e.Message != "auth failed for [scrubbed]" {
+		t.Errorf("secret not scrubbed: %s", e.Message)
+	}
+	if e.ErrorType != "*errors.errorString" || e.Level != LevelError {
+		t.Errorf("unexpected error type %s level %s", e.ErrorType, e.Level)
+	}
+	if e.Release != "gocryptotrader@v0.1" || e.Tags["commit"] != "abc1234" || e.Tags["exchange"] != "Bitstamp" {
+		t.Errorf("unexpected release %s tags %v", e.Release, e.Tags)
+	}
+	if len(e.Stacktrace) == 0 ||
+		!strings.HasSuffix(e.Stacktrace[len(e.Stacktrace)-1].Function, "TestCapture") {
+		t.Errorf("expected stack to end with the caller, received %+v", e.Stacktrace)
+	}
+	msg := events[1].Message
+	if strings.Contains(msg, "abcdef") || strings.Contains(msg, "123456") || strings.Contains(msg, "hunter22") {
+		t.Errorf("credentials not scrubbed: %s", msg)
+	}
+}
+
+func TestRateLimit(t *testing.T) {
+	r := &testReporter{}
+	if err := Start(r, &Options{MaxEventsPerMinute: 2}); err != nil {
+		t.Fatal(err)
+	}
+	defer Shutdown()
+	for i := 0; i < 5; i++ {
+		CaptureMessage("flood", nil)
+	}
+	Flush()
+	if n := len(r.get()); n != 2 {
+		t.Errorf("expected 2 events, received %d", n)
+	}
+}
+
+func TestRecover(t *testing.T) {
+	r := &testReporter{}
+	if err := Start(r, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer Shutdown()
+
+	func() {
+		defer func() {
+			if rec := recover(); rec != "boom" {
+				t.Errorf("expected panic to be rethrown, received %v", rec)
+			}
+		}()
+		defer Recover()
+		panic("boom")
+	}()
+
+	events := r.get()
+	if len(events) != 1 || events[0].Level != LevelFatal || events[0].Message != "boom" {
+		t.Fatalf("unexpected events %+v", events)
+	}
+}
+
+func TestCapturePanic(t *testing.T) {
+	r := &testReporter{}
+	if err := Start(r, nil); err != nil {
+		t.Fatal(err)
+	}
+	defer Shutdown()
+
+	func() {
+		defer func() {
+			CapturePanic(recover(), map[string]string{"subsystem": "test"})
+		}()
+		panic("boom")
+	}()
+	Flush()
+
+	events := r.get()
+	if len(events) != 1 || events[0].Level != LevelFatal || events[0].Tags["subsystem"] != "test" {
+		t.Fatalf("unexpected events %+v", events)
+	}
+}
+
+func TestSplitFunction(t *testing.T) {
+	module, function := splitFunction("github.com/thrasher-corp/gocryptotrader/engine.(*Engine).Start")
+	if module != "github.com/thrasher-corp/gocryptotrader/engine" || function != "(*Engine).Start" {
+		t.Errorf("unexpected split %s %s", module, function)
+	}
+}
+
+func TestSentryReporter(t *testing.T) {
+	var auth string
+	var received sentryEvent
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/api/42/store/" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		auth = r.Header.Get("X-Sentry-Auth")
+		body, _ := ioutil.ReadAll(r.Body)
+		if err := json.Unmarshal(body, &received); err != nil {
+			t.Error(err)
+		}
+	}))
+	defer srv.Close()
+
+	s, err := NewSentryReporter(strings.Replace(srv.URL, "://", "://publickey@", 1) + "/42")
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = s.Report(&Event{
+		ID:         "abc",
+		Level:      LevelFatal,
+		Message:    "boom",
+		ErrorType:  "panic",
+		Release:    "gocryptotrader@v0.1",
+		Stacktrace: []Frame{{Function: "main", Module: "main", File: "/src/main.go", Line: 1}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(auth, "sentry_key=publickey") {
+		t.Errorf("unexpected auth header %s", auth)
+	}
+	if received.Exception == nil || received.Exception.Values[0].Value != "boom" ||
+		received.Exception.Values[0].Stacktrace.Frames[0].Filename != "main.go" {
+		t.Errorf("unexpected event %+v", received)
+	}
+
+	if _, err = NewSentryReporter("https://publickey@sentry.io/"); err == nil {
+		t.Error("expected error on DSN without project ID")
+	}
+}
@@ -0,0 +1,143 @@
+package errorreport
+
+import (
+	"net/http"
+	"regexp"
+	"sync"
+	"time"
+)
+
+// Const vars for error reporting
+const (
+	// DefaultMaxEventsPerMinute is the default cap on reported events so an
+	// error loop cannot flood the reporting service
+	DefaultMaxEventsPerMinute = 60
+
+	// LevelError is the level of reported errors
+	LevelError = "error"
+	// LevelFatal is the level of reported panics
+	LevelFatal = "fatal"
+
+	defaultQueueSize    = 100
+	defaultFlushTimeout = time.Second * 5
+	defaultSendTimeout  = time.Second * 10
+	// minSecretLength avoids scrubbing short placeholder values such as "Key"
+	// which would mangle unrelated text
+	minSecretLength = 8
+	scrubbed        = "[scrubbed]"
+	sentryVersion   = "7"
+	sentryClient    = "gocryptotrader-errorreport/1.0"
+)
+
+// credentialPattern matches credential key value pairs in free text such as
+// query strings, headers and JSON so their values can be scrubbed
+var credentialPattern = regexp.MustCompile(
+	`(?i)((?:api[_-]?key|api[_-]?secret|secret|signature|passphrase|password|token|authorization|x-mbx-apikey)["']?\s*[:=]\s*["']?)([^\s"'&,;}]+)`)
+
+// Config defines the error reporting configuration. The DSN is a Sentry DSN
+// in the form https://<key>@<host>/<project id>
+type Config struct {
+	Enabled            bool   `json:"enabled"`
+	DSN                string `json:"dsn"`
+	Environment        string `json:"environment"`
+	ReportErrorLogs    bool   `json:"reportErrorLogs"`
+	MaxEventsPerMinute int    `json:"maxEventsPerMinute"`
+}
+
+// Options are applied to every reported event
+type Options struct {
+	Release            string
+	Commit             string
+	Environment        string
+	MaxEventsPerMinute int
+	// Secrets are scrubbed from every event before it is reported
+	Secrets []string
+}
+
+// Reporter sends events to an error reporting service
+type Reporter interface {
+	Report(e *Event) error
+}
+
+// Event is a reported error or panic
+type Event struct {
+	ID          string
+	Timestamp   time.Time
+	Level       string
+	Message     string
+	ErrorType   string
+	Release     string
+	Environment string
+	ServerName  string
+	Tags        map[string]string
+	Stacktrace  []Frame
+}
+
+// Frame is a stack frame of a reported event
+type Frame struct {
+	Function string
+	Module   string
+	File     string
+	Line     int
+}
+
+// client scrubs, rate limits and queues events for its reporter
+type client struct {
+	reporter   Reporter
+	opts       Options
+	serverName string
+	secrets    []string
+
+	limitMtx    sync.Mutex
+	windowStart time.Time
+	windowCount int
+
+	queue    chan *Event
+	flush    chan chan struct{}
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+}
+
+// SentryReporter posts events to the Sentry store API
+type SentryReporter struct {
+	URL       string
+	PublicKey string
+	Client    *http.Client
+}
+
+type sentryEvent struct {
+	EventID     string            `json:"event_id"`
+	Timestamp   string            `json:"timestamp"`
+	Level       string            `json:"level"`
+	Platform    string            `json:"platform"`
+	Logger      string            `json:"logger,omitempty"`
+	Message     string            `json:"message,omitempty"`
+	Release     string            `json:"release,omitempty"`
+	Environment string            `json:"environment,omitempty"`
+	ServerName  string            `json:"server_name,omitempty"`
+	Tags        map[string]string `json:"tags,omitempty"`
+	Exception   *sentryException  `json:"exception,omitempty"`
+}
+
+type sentryException struct {
+	Values []sentryExceptionValue `json:"values"`
+}
+
+type sentryExceptionValue struct {
+	Type       string            `json:"type"`
+	Value      string            `json:"value"`
+	Stacktrace *sentryStacktrace `json:"stacktrace,omitempty"`
+}
+
+type sentryStacktrace struct {
+	Frames []sentryFrame `json:"frames"`
+}
+
+type sentryFrame struct {
+	Function string `json:"function"`
+	Module   string `json:"module,omitempty"`
+	Filename string `json:"filename"`
+	AbsPath  string `json:"abs_path"`
+	Lineno   int    `json:"lineno"`
+	InApp    bool   `json:"in_app"`
+}
@@ -0,0 +1,102 @@
+package errorreport
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+// NewSentryReporter returns a reporter which posts events to the project of
+// the supplied Sentry DSN
+func NewSentryReporter(dsn string) (*SentryReporter, error) {
+	u, err := url.Parse(dsn)
+	if err != nil {
+		return nil, fmt.Errorf("invalid sentry DSN: %v", err)
+	}
+	if u.User == nil || u.User.Username() == "" {
+		return nil, errors.New("invalid sentry DSN: public key not set")
+	}
+	path := strings.Trim(u.Path, "/")
+	idx := strings.LastIndex(path, "/")
+	projectID := path[idx+1:]
+	if projectID == "" {
+		return nil, errors.New("invalid sentry DSN: project ID not set")
+	}
+	prefix := ""
+	if idx > 0 {
+		prefix = "/" + path[:idx]
+	}
+	return &SentryReporter{
+		URL:       fmt.Sprintf("%s://%s%s/api/%s/store/", u.Scheme, u.Host, prefix, projectID),
+		PublicKey: u.User.Username(),
+		Client:    &http.Client{Timeout: defaultSendTimeout},
+	}, nil
+}
+
+// Report sends an event to Sentry
+func (s *SentryReporter) Report(e *Event) error {
+	payload, err := json.Marshal(encodeSentryEvent(e))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, s.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("X-Sentry-Auth", fmt.Sprintf("Sentry sentry_version=%s, sentry_client=%s, sentry_timestamp=%d, sentry_key=%s",
+		sentryVersion, sentryClient, e.Timestamp.Unix(), s.PublicKey))
+	resp, err := s.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("sentry returned status %d: %s", resp.StatusCode, body)
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+func encodeSentryEvent(e *Event) *sentryEvent {
+	s := &sentryEvent{
+		EventID:     e.ID,
+		Timestamp:   e.Timestamp.Format(time.RFC3339),
+		Level:       e.Level,
+		Platform:    "go",
+		Release:     e.Release,
+		Environment: e.Environment,
+		ServerName:  e.ServerName,
+		Tags:        e.Tags,
+	}
+	if e.ErrorType == "" {
+		s.Message = e.Message
+		return s
+	}
+	value := sentryExceptionValue{Type: e.ErrorType, Value: e.Message}
+	if len(e.Stacktrace) > 0 {
+		value.Stacktrace = &sentryStacktrace{}
+		for i := range e.Stacktrace {
+			f := &e.Stacktrace[i]
+			value.Stacktrace.Frames = append(value.Stacktrace.Frames, sentryFrame{
+				Function: f.Function,
+				Module:   f.Module,
+				Filename: filepath.Base(f.File),
+				AbsPath:  f.File,
+				Lineno:   f.Line,
+				InApp:    strings.HasPrefix(f.Module, inAppModule),
+			})
+		}
+	}
+	s.Exception = &sentryException{Values: []sentryExceptionValue{value}}
+	return s
+}
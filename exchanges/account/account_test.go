@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 )
@@ -40,8 +41,8 @@ func TestHoldings(t *testing.T) {
 			Currencies: []Balance{
 				{
 					CurrencyName: currency.BTC,
-					TotalValue:   100,
-					Hold:         20,
+					TotalValue:   decimal.NewFromInt(100),
+					Hold:         decimal.NewFromInt(20),
 				},
 			},
 		}},
@@ -74,13 +75,13 @@ func TestHoldings(t *testing.T) {
 			u.Accounts[0].Currencies[0].CurrencyName)
 	}
 
-	if u.Accounts[0].Currencies[0].TotalValue != 100 {
-		t.Errorf("expecting 100 but receieved %f",
+	if !u.Accounts[0].Currencies[0].TotalValue.Equal(decimal.NewFromInt(100)) {
+		t.Errorf("expecting 100 but receieved %s",
 			u.Accounts[0].Currencies[0].TotalValue)
 	}
 
-	if u.Accounts[0].Currencies[0].Hold != 20 {
-		t.Errorf("expecting 20 but receieved %f",
+	if !u.Accounts[0].Currencies[0].Hold.Equal(decimal.NewFromInt(20)) {
+		t.Errorf("expecting 20 but receieved %s",
 			u.Accounts[0].Currencies[0].Hold)
 	}
 
@@ -115,8 +116,8 @@ func TestHoldings(t *testing.T) {
 			Currencies: []Balance{
 				{
 					CurrencyName: currency.BTC,
-					TotalValue:   100000,
-					Hold:         20,
+					TotalValue:   decimal.NewFromInt(100000),
+					Hold:         decimal.NewFromInt(20),
 				},
 			},
 		}},
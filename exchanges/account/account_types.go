@@ -4,6 +4,7 @@ import (
 	"sync"
 
 	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 )
@@ -42,6 +43,6 @@ type SubAccount struct {
 // Balance is a sub type to store currency name and individual totals
 type Balance struct {
 	CurrencyName currency.Code
-	TotalValue   float64
-	Hold         float64
+	TotalValue   decimal.Decimal
+	Hold         decimal.Decimal
 }
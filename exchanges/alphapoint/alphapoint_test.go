@@ -1,12 +1,14 @@
 package alphapoint
 
 import (
+	"context"
 	"encoding/json"
 	"log"
 	"os"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -46,12 +48,12 @@ func TestGetTicker(t *testing.T) {
 	var ticker Ticker
 	var err error
 	if onlineTest {
-		ticker, err = a.GetTicker("BTCUSD")
+		ticker, err = a.GetTicker(context.Background(), "BTCUSD")
 		if err != nil {
 			t.Fatal("Alphapoint GetTicker init error: ", err)
 		}
 
-		_, err = a.GetTicker("wigwham")
+		_, err = a.GetTicker(context.Background(), "wigwham")
 		if err == nil {
 			t.Error("Alphapoint GetTicker Expected error")
 		}
@@ -80,12 +82,12 @@ func TestGetTrades(t *testing.T) {
 	var trades Trades
 	var err error
 	if onlineTest {
-		trades, err = a.GetTrades("BTCUSD", 0, 10)
+		trades, err = a.GetTrades(context.Background(), "BTCUSD", 0, 10)
 		if err != nil {
 			t.Fatalf("Init error: %s", err)
 		}
 
-		_, err = a.GetTrades("wigwham", 0, 10)
+		_, err = a.GetTrades(context.Background(), "wigwham", 0, 10)
 		if err == nil {
 			t.Fatal("GetTrades Expected error")
 		}
@@ -118,11 +120,11 @@ func TestGetTradesByDate(t *testing.T) {
 	var trades Trades
 	var err error
 	if onlineTest {
-		trades, err = a.GetTradesByDate("BTCUSD", 1414799400, 1414800000)
+		trades, err = a.GetTradesByDate(context.Background(), "BTCUSD", 1414799400, 1414800000)
 		if err != nil {
 			t.Errorf("Init error: %s", err)
 		}
-		_, err = a.GetTradesByDate("wigwham", 1414799400, 1414800000)
+		_, err = a.GetTradesByDate(context.Background(), "wigwham", 1414799400, 1414800000)
 		if err == nil {
 			t.Error("GetTradesByDate Expected error")
 		}
@@ -162,12 +164,12 @@ func TestGetOrderbook(t *testing.T) {
 	var orderBook Orderbook
 	var err error
 	if onlineTest {
-		orderBook, err = a.GetOrderbook("BTCUSD")
+		orderBook, err = a.GetOrderbook(context.Background(), "BTCUSD")
 		if err != nil {
 			t.Errorf("Init error: %s", err)
 		}
 
-		_, err = a.GetOrderbook("wigwham")
+		_, err = a.GetOrderbook(context.Background(), "wigwham")
 		if err == nil {
 			t.Error("GetOrderbook() Expected error")
 		}
@@ -205,7 +207,7 @@ func TestGetProductPairs(t *testing.T) {
 	var err error
 
 	if onlineTest {
-		products, err = a.GetProductPairs()
+		products, err = a.GetProductPairs(context.Background())
 		if err != nil {
 			t.Errorf("Init error: %s", err)
 		}
@@ -243,7 +245,7 @@ func TestGetProducts(t *testing.T) {
 	var err error
 
 	if onlineTest {
-		products, err = a.GetProducts()
+		products, err = a.GetProducts(context.Background())
 		if err != nil {
 			t.Errorf("Init error: %s", err)
 		}
@@ -281,15 +283,15 @@ func TestCreateAccount(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	err := a.CreateAccount("test", "account", "something@something.com", "0292383745", "lolcat123")
+	err := a.CreateAccount(context.Background(), "test", "account", "something@something.com", "0292383745", "lolcat123")
 	if err != nil {
 		t.Errorf("Init error: %s", err)
 	}
-	err = a.CreateAccount("test", "account", "something@something.com", "0292383745", "bla")
+	err = a.CreateAccount(context.Background(), "test", "account", "something@something.com", "0292383745", "bla")
 	if err == nil {
 		t.Errorf("CreateAccount() Expected error")
 	}
-	err = a.CreateAccount("", "", "", "", "lolcat123")
+	err = a.CreateAccount(context.Background(), "", "", "", "", "lolcat123")
 	if err == nil {
 		t.Errorf("CreateAccount() Expected error")
 	}
@@ -301,7 +303,7 @@ func TestGetUserInfo(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.GetUserInfo()
+	_, err := a.GetUserInfo(context.Background())
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -313,7 +315,7 @@ func TestSetUserInfo(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.SetUserInfo("bla", "bla", "1", "meh", true, true)
+	_, err := a.SetUserInfo(context.Background(), "bla", "bla", "1", "meh", true, true)
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -325,7 +327,7 @@ func TestGetAccountInfo(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.UpdateAccountInfo()
+	_, err := a.UpdateAccountInfo(context.Background())
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -337,7 +339,7 @@ func TestGetAccountTrades(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.GetAccountTrades("", 1, 2)
+	_, err := a.GetAccountTrades(context.Background(), "", 1, 2)
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -349,7 +351,7 @@ func TestGetDepositAddresses(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.GetDepositAddresses()
+	_, err := a.GetDepositAddresses(context.Background())
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -361,7 +363,7 @@ func TestWithdrawCoins(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	err := a.WithdrawCoins("", "", "", 0.01)
+	err := a.WithdrawCoins(context.Background(), "", "", "", 0.01)
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -373,7 +375,7 @@ func TestCreateOrder(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.CreateOrder("", "", order.Limit.String(), 0.01, 0)
+	_, err := a.CreateOrder(context.Background(), "", "", order.Limit.String(), 0.01, 0)
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -385,7 +387,7 @@ func TestModifyExistingOrder(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.ModifyExistingOrder("", 1, 1)
+	_, err := a.ModifyExistingOrder(context.Background(), "", 1, 1)
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -397,7 +399,7 @@ func TestCancelAllExistingOrders(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	err := a.CancelAllExistingOrders("")
+	err := a.CancelAllExistingOrders(context.Background(), "")
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -409,7 +411,7 @@ func TestGetOrders(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.GetOrders()
+	_, err := a.GetOrders(context.Background())
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -421,7 +423,7 @@ func TestGetOrderFee(t *testing.T) {
 		t.Skip("API keys not set, skipping")
 	}
 
-	_, err := a.GetOrderFee("", "", 1, 1)
+	_, err := a.GetOrderFee(context.Background(), "", "", 1, 1)
 	if err == nil {
 		t.Error("GetUserInfo() Expected error")
 	}
@@ -442,7 +444,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := a.GetActiveOrders(&getOrdersRequest)
+	_, err := a.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -456,7 +458,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := a.GetOrderHistory(&getOrdersRequest)
+	_, err := a.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -481,12 +483,12 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
 
-	response, err := a.SubmitOrder(orderSubmission)
+	response, err := a.SubmitOrder(context.Background(), orderSubmission)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -513,7 +515,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := a.CancelOrder(orderCancellation)
+	err := a.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -536,7 +538,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := a.CancelAllOrders(orderCancellation)
+	resp, err := a.CancelAllOrders(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -554,7 +556,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := a.ModifyOrder(&order.Modify{})
+	_, err := a.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -563,7 +565,7 @@ func TestModifyOrder(t *testing.T) {
 func TestWithdraw(t *testing.T) {
 	t.Parallel()
 	var withdrawCryptoRequest = withdraw.CryptoRequest{}
-	_, err := a.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := a.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not implemented', received %v", err)
 	}
@@ -576,7 +578,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := a.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := a.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrNotYetImplemented, err)
 	}
@@ -589,7 +591,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := a.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := a.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrNotYetImplemented, err)
 	}
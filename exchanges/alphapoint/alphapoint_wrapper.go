@@ -1,11 +1,13 @@
 package alphapoint
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -75,22 +77,22 @@ func (a *Alphapoint) SetDefaults() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (a *Alphapoint) FetchTradablePairs(asset asset.Item) ([]string, error) {
+func (a *Alphapoint) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (a *Alphapoint) UpdateTradablePairs(forceUpdate bool) error {
+func (a *Alphapoint) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	return common.ErrFunctionNotSupported
 }
 
 // UpdateAccountInfo retrieves balances for all enabled currencies on the
 // Alphapoint exchange
-func (a *Alphapoint) UpdateAccountInfo() (account.Holdings, error) {
+func (a *Alphapoint) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = a.Name
-	acc, err := a.GetAccountInformation()
+	acc, err := a.GetAccountInformation(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -99,8 +101,8 @@ func (a *Alphapoint) UpdateAccountInfo() (account.Holdings, error) {
 	for i := range acc.Currencies {
 		var balance account.Balance
 		balance.CurrencyName = currency.NewCode(acc.Currencies[i].Name)
-		balance.TotalValue = float64(acc.Currencies[i].Balance)
-		balance.Hold = float64(acc.Currencies[i].Hold)
+		balance.TotalValue = decimal.NewFromInt(int64(acc.Currencies[i].Balance))
+		balance.Hold = decimal.NewFromInt(int64(acc.Currencies[i].Hold))
 
 		balances = append(balances, balance)
 	}
@@ -119,19 +121,19 @@ func (a *Alphapoint) UpdateAccountInfo() (account.Holdings, error) {
 
 // FetchAccountInfo retrieves balances for all enabled currencies on the
 // Alphapoint exchange
-func (a *Alphapoint) FetchAccountInfo() (account.Holdings, error) {
+func (a *Alphapoint) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(a.Name)
 	if err != nil {
-		return a.UpdateAccountInfo()
+		return a.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (a *Alphapoint) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (a *Alphapoint) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tick, err := a.GetTicker(p.String())
+	tick, err := a.GetTicker(ctx, p.String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -153,18 +155,18 @@ func (a *Alphapoint) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticke
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (a *Alphapoint) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (a *Alphapoint) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tick, err := ticker.GetTicker(a.Name, p, assetType)
 	if err != nil {
-		return a.UpdateTicker(p, assetType)
+		return a.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (a *Alphapoint) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (a *Alphapoint) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := a.GetOrderbook(p.String())
+	orderbookNew, err := a.GetOrderbook(ctx, p.String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -196,39 +198,62 @@ func (a *Alphapoint) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*or
 }
 
 // FetchOrderbook returns the orderbook for a currency pair
-func (a *Alphapoint) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (a *Alphapoint) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(a.Name, p, assetType)
 	if err != nil {
-		return a.UpdateOrderbook(p, assetType)
+		return a.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (a *Alphapoint) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (a *Alphapoint) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	// https://alphapoint.github.io/slate/#generatetreasuryactivityreport
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (a *Alphapoint) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (a *Alphapoint) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (a *Alphapoint) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (a *Alphapoint) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (a *Alphapoint) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (a *Alphapoint) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order and returns a true value when
 // successfully submitted
-func (a *Alphapoint) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (a *Alphapoint) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
 	}
 
-	response, err := a.CreateOrder(s.Pair.String(),
+	response, err := a.CreateOrder(ctx, s.Pair.String(),
 		s.OrderSide.String(),
 		s.OrderSide.String(),
-		s.Amount,
-		s.Price)
+		s.Amount.Float64(),
+		s.Price.Float64())
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -245,29 +270,29 @@ func (a *Alphapoint) SubmitOrder(s *order.Submit) (order.SubmitResponse, error)
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (a *Alphapoint) ModifyOrder(_ *order.Modify) (string, error) {
+func (a *Alphapoint) ModifyOrder(ctx context.Context, _ *order.Modify) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (a *Alphapoint) CancelOrder(order *order.Cancel) error {
+func (a *Alphapoint) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
-	_, err = a.CancelExistingOrder(orderIDInt, order.AccountID)
+	_, err = a.CancelExistingOrder(ctx, orderIDInt, order.AccountID)
 	return err
 }
 
 // CancelAllOrders cancels all orders for a given account
-func (a *Alphapoint) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (a *Alphapoint) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	return order.CancelAllResponse{},
-		a.CancelAllExistingOrders(orderCancellation.AccountID)
+		a.CancelAllExistingOrders(ctx, orderCancellation.AccountID)
 }
 
 // GetOrderInfo returns information on a current open order
-func (a *Alphapoint) GetOrderInfo(orderID string) (float64, error) {
-	orders, err := a.GetOrders()
+func (a *Alphapoint) GetOrderInfo(ctx context.Context, orderID string) (float64, error) {
+	orders, err := a.GetOrders(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -283,8 +308,8 @@ func (a *Alphapoint) GetOrderInfo(orderID string) (float64, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (a *Alphapoint) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	addreses, err := a.GetDepositAddresses()
+func (a *Alphapoint) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	addreses, err := a.GetDepositAddresses(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -299,18 +324,18 @@ func (a *Alphapoint) GetDepositAddress(cryptocurrency currency.Code, _ string) (
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (a *Alphapoint) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (a *Alphapoint) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is submitted
-func (a *Alphapoint) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (a *Alphapoint) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (a *Alphapoint) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (a *Alphapoint) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -320,14 +345,14 @@ func (a *Alphapoint) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (a *Alphapoint) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (a *Alphapoint) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	return 0, common.ErrFunctionNotSupported
 }
 
 // GetActiveOrders retrieves any orders that are active/open
 // This function is not concurrency safe due to orderSide/orderType maps
-func (a *Alphapoint) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := a.GetOrders()
+func (a *Alphapoint) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := a.GetOrders(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -340,12 +365,12 @@ func (a *Alphapoint) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detai
 			}
 
 			orderDetail := order.Detail{
-				Amount:          resp[x].OpenOrders[y].QtyTotal,
+				Amount:          decimal.NewFromFloat(resp[x].OpenOrders[y].QtyTotal),
 				Exchange:        a.Name,
 				AccountID:       strconv.FormatInt(int64(resp[x].OpenOrders[y].AccountID), 10),
 				ID:              strconv.FormatInt(int64(resp[x].OpenOrders[y].ServerOrderID), 10),
-				Price:           resp[x].OpenOrders[y].Price,
-				RemainingAmount: resp[x].OpenOrders[y].QtyRemaining,
+				Price:           decimal.NewFromFloat(resp[x].OpenOrders[y].Price),
+				RemainingAmount: decimal.NewFromFloat(resp[x].OpenOrders[y].QtyRemaining),
 			}
 
 			orderDetail.OrderSide = orderSideMap[resp[x].OpenOrders[y].Side]
@@ -368,8 +393,8 @@ func (a *Alphapoint) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detai
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
 // This function is not concurrency safe due to orderSide/orderType maps
-func (a *Alphapoint) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := a.GetOrders()
+func (a *Alphapoint) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := a.GetOrders(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -382,12 +407,12 @@ func (a *Alphapoint) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detai
 			}
 
 			orderDetail := order.Detail{
-				Amount:          resp[x].OpenOrders[y].QtyTotal,
+				Amount:          decimal.NewFromFloat(resp[x].OpenOrders[y].QtyTotal),
 				AccountID:       strconv.FormatInt(int64(resp[x].OpenOrders[y].AccountID), 10),
 				Exchange:        a.Name,
 				ID:              strconv.FormatInt(int64(resp[x].OpenOrders[y].ServerOrderID), 10),
-				Price:           resp[x].OpenOrders[y].Price,
-				RemainingAmount: resp[x].OpenOrders[y].QtyRemaining,
+				Price:           decimal.NewFromFloat(resp[x].OpenOrders[y].Price),
+				RemainingAmount: decimal.NewFromFloat(resp[x].OpenOrders[y].QtyRemaining),
 			}
 
 			orderDetail.OrderSide = orderSideMap[resp[x].OpenOrders[y].Side]
@@ -431,7 +456,12 @@ func (a *Alphapoint) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (a *Alphapoint) ValidateCredentials() error {
-	_, err := a.UpdateAccountInfo()
+func (a *Alphapoint) ValidateCredentials(ctx context.Context) error {
+	_, err := a.UpdateAccountInfo(ctx)
 	return a.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (a *Alphapoint) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -2,6 +2,7 @@ package binance
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -14,6 +15,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -37,6 +39,7 @@ const (
 	symbolPrice      = "/api/v3/ticker/price"
 	bestPrice        = "/api/v3/ticker/bookTicker"
 	accountInfo      = "/api/v3/account"
+	serverTime       = "/api/v3/time"
 
 	// Authenticated endpoints
 	newOrderTest = "/api/v3/order/test"
@@ -46,6 +49,23 @@ const (
 	openOrders   = "/api/v3/openOrders"
 	allOrders    = "/api/v3/allOrders"
 
+	// Conditional order list endpoints
+	newOCOOrder = "/api/v3/order/oco"
+	newOTOOrder = "/api/v3/orderList/oto"
+	orderList   = "/api/v3/orderList"
+
+	// Margin endpoints
+	marginAccount          = "/sapi/v1/margin/account"
+	isolatedMarginAccount  = "/sapi/v1/margin/isolated/account"
+	marginOrder            = "/sapi/v1/margin/order"
+	marginOCOOrder         = "/sapi/v1/margin/order/oco"
+	marginLoan             = "/sapi/v1/margin/loan"
+	marginRepay            = "/sapi/v1/margin/repay"
+	marginTransfer         = "/sapi/v1/margin/transfer"
+	isolatedMarginTransfer = "/sapi/v1/margin/isolated/transfer"
+	marginUserDataStream   = "/sapi/v1/userDataStream"
+	isolatedUserDataStream = "/sapi/v1/userDataStream/isolated"
+
 	// Withdraw API endpoints
 	withdrawEndpoint  = "/wapi/v3/withdraw.html"
 	depositHistory    = "/wapi/v3/depositHistory.html"
@@ -56,12 +76,23 @@ const (
 	dustLog           = "/wapi/v3/userAssetDribbletLog.html"
 	tradeFee          = "/wapi/v3/tradeFee.html"
 	assetDetail       = "/wapi/v3/assetDetail.html"
+
+	// exchangeInfoCacheTTL is how long exchange info responses are reused,
+	// it is requested by several calls on startup and rarely changes
+	exchangeInfoCacheTTL = 10 * time.Minute
 )
 
+// isolatedLiquidationMarginLevel is the margin level, the ratio of assets to
+// liabilities, at which an isolated margin account is liquidated
+var isolatedLiquidationMarginLevel = decimal.RequireFromString("1.1")
+
 // Binance is the overarching type across the Bithumb package
 type Binance struct {
 	exchange.Base
 	WebsocketConn *wshandler.WebsocketConnection
+	// marginListenKey is the margin user data stream subscribed to by the
+	// websocket connection, empty when unauthenticated
+	marginListenKey string
 
 	// Valid string list that is required by the exchange
 	validLimits    []int
@@ -69,17 +100,17 @@ type Binance struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Binance) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (b *Binance) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetExchangeInfo returns exchange information. Check binance_types for more
 // information
-func (b *Binance) GetExchangeInfo() (ExchangeInfo, error) {
+func (b *Binance) GetExchangeInfo(ctx context.Context) (ExchangeInfo, error) {
 	var resp ExchangeInfo
 	path := b.API.Endpoints.URL + exchangeInfo
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.sendHTTPRequest(ctx, path, request.UnAuth, exchangeInfoCacheTTL, &resp)
 }
 
 // GetOrderBook returns full orderbook information
@@ -87,7 +118,7 @@ func (b *Binance) GetExchangeInfo() (ExchangeInfo, error) {
 // OrderBookDataRequestParams contains the following members
 // symbol: string of currency pair
 // limit: returned limit amount
-func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error) {
+func (b *Binance) GetOrderBook(ctx context.Context, obd OrderBookDataRequestParams) (OrderBook, error) {
 	var orderbook OrderBook
 	if err := b.CheckLimit(obd.Limit); err != nil {
 		return orderbook, err
@@ -99,7 +130,7 @@ func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error
 
 	var resp OrderBookData
 	path := common.EncodeURLValues(b.API.Endpoints.URL+orderBookDepth, params)
-	if err := b.SendHTTPRequest(path, &resp); err != nil {
+	if err := b.sendHTTPRequest(ctx, path, orderbookDepthRate(obd.Limit), 0, &resp); err != nil {
 		return orderbook, err
 	}
 
@@ -143,7 +174,7 @@ func (b *Binance) GetOrderBook(obd OrderBookDataRequestParams) (OrderBook, error
 
 // GetRecentTrades returns recent trade activity
 // limit: Up to 500 results returned
-func (b *Binance) GetRecentTrades(rtr RecentTradeRequestParams) ([]RecentTrade, error) {
+func (b *Binance) GetRecentTrades(ctx context.Context, rtr RecentTradeRequestParams) ([]RecentTrade, error) {
 	var resp []RecentTrade
 
 	params := url.Values{}
@@ -152,7 +183,7 @@ func (b *Binance) GetRecentTrades(rtr RecentTradeRequestParams) ([]RecentTrade,
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, recentTrades, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetHistoricalTrades returns historical trade activity
@@ -171,7 +202,7 @@ func (b *Binance) GetHistoricalTrades(symbol string, limit int, fromID int64) ([
 //
 // symbol: string of currency pair
 // limit: Optional. Default 500; max 1000.
-func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTrade, error) {
+func (b *Binance) GetAggregatedTrades(ctx context.Context, symbol string, limit int) ([]AggregatedTrade, error) {
 	var resp []AggregatedTrade
 
 	if err := b.CheckLimit(limit); err != nil {
@@ -184,7 +215,7 @@ func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTra
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, aggregatedTrades, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetSpotKline returns kline data
@@ -195,7 +226,7 @@ func (b *Binance) GetAggregatedTrades(symbol string, limit int) ([]AggregatedTra
 // interval: the interval time for the data
 // startTime: startTime filter for kline data
 // endTime: endTime filter for the kline data
-func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
+func (b *Binance) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]CandleStick, error) {
 	var resp interface{}
 	var kline []CandleStick
 
@@ -214,7 +245,7 @@ func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, candleStick, params.Encode())
 
-	if err := b.SendHTTPRequest(path, &resp); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &resp); err != nil {
 		return kline, err
 	}
 
@@ -254,74 +285,87 @@ func (b *Binance) GetSpotKline(arg KlinesRequestParams) ([]CandleStick, error) {
 // GetAveragePrice returns current average price for a symbol.
 //
 // symbol: string of currency pair
-func (b *Binance) GetAveragePrice(symbol string) (AveragePrice, error) {
+func (b *Binance) GetAveragePrice(ctx context.Context, symbol string) (AveragePrice, error) {
 	resp := AveragePrice{}
 	params := url.Values{}
 	params.Set("symbol", strings.ToUpper(symbol))
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, averagePrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetPriceChangeStats returns price change statistics for the last 24 hours
 //
 // symbol: string of currency pair
-func (b *Binance) GetPriceChangeStats(symbol string) (PriceChangeStats, error) {
+func (b *Binance) GetPriceChangeStats(ctx context.Context, symbol string) (PriceChangeStats, error) {
 	resp := PriceChangeStats{}
 	params := url.Values{}
 	params.Set("symbol", strings.ToUpper(symbol))
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, priceChange, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTickers returns the ticker data for the last 24 hrs
-func (b *Binance) GetTickers() ([]PriceChangeStats, error) {
+func (b *Binance) GetTickers(ctx context.Context) ([]PriceChangeStats, error) {
 	var resp []PriceChangeStats
 	path := b.API.Endpoints.URL + priceChange
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.sendHTTPRequest(ctx, path, tickerAllRate, 0, &resp)
 }
 
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (b *Binance) GetLatestSpotPrice(symbol string) (SymbolPrice, error) {
+func (b *Binance) GetLatestSpotPrice(ctx context.Context, symbol string) (SymbolPrice, error) {
 	resp := SymbolPrice{}
 	params := url.Values{}
 	params.Set("symbol", strings.ToUpper(symbol))
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, symbolPrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetBestPrice returns the latest best price for symbol
 //
 // symbol: string of currency pair
-func (b *Binance) GetBestPrice(symbol string) (BestPrice, error) {
+func (b *Binance) GetBestPrice(ctx context.Context, symbol string) (BestPrice, error) {
 	resp := BestPrice{}
 	params := url.Values{}
 	params.Set("symbol", strings.ToUpper(symbol))
 
 	path := fmt.Sprintf("%s%s?%s", b.API.Endpoints.URL, bestPrice, params.Encode())
 
-	return resp, b.SendHTTPRequest(path, &resp)
+	return resp, b.SendHTTPRequest(ctx, path, &resp)
 }
 
 // NewOrder sends a new order to Binance
-func (b *Binance) NewOrder(o *NewOrderRequest) (NewOrderResponse, error) {
+func (b *Binance) NewOrder(ctx context.Context, o *NewOrderRequest) (NewOrderResponse, error) {
 	var resp NewOrderResponse
 
 	path := b.API.Endpoints.URL + newOrder
 
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodPost, path, o.params(), newOrderRate, &resp); err != nil {
+		return resp, err
+	}
+
+	if resp.Code != 0 {
+		return resp, errors.New(resp.Msg)
+	}
+	return resp, nil
+}
+
+// params returns the request parameters of a new order, shared by spot and
+// margin orders
+func (o *NewOrderRequest) params() url.Values {
 	params := url.Values{}
 	params.Set("symbol", o.Symbol)
 	params.Set("side", o.Side)
 	params.Set("type", string(o.TradeType))
 	params.Set("quantity", strconv.FormatFloat(o.Quantity, 'f', -1, 64))
-	if o.TradeType == BinanceRequestParamsOrderLimit {
+	if o.TradeType.HasLimitPrice() {
 		params.Set("price", strconv.FormatFloat(o.Price, 'f', -1, 64))
 	}
 	if o.TimeInForce != "" {
@@ -343,19 +387,43 @@ func (b *Binance) NewOrder(o *NewOrderRequest) (NewOrderResponse, error) {
 	if o.NewOrderRespType != "" {
 		params.Set("newOrderRespType", o.NewOrderRespType)
 	}
+	return params
+}
 
-	if err := b.SendAuthHTTPRequest(http.MethodPost, path, params, request.Auth, &resp); err != nil {
-		return resp, err
+// params returns the request parameters of an OCO order, shared by spot and
+// margin order lists
+func (o *OCOOrderRequest) params() url.Values {
+	params := url.Values{}
+	params.Set("symbol", o.Symbol)
+	params.Set("side", o.Side)
+	params.Set("quantity", strconv.FormatFloat(o.Quantity, 'f', -1, 64))
+	params.Set("price", strconv.FormatFloat(o.Price, 'f', -1, 64))
+	params.Set("stopPrice", strconv.FormatFloat(o.StopPrice, 'f', -1, 64))
+	if o.StopLimitPrice != 0 {
+		params.Set("stopLimitPrice", strconv.FormatFloat(o.StopLimitPrice, 'f', -1, 64))
+		params.Set("stopLimitTimeInForce", string(BinanceRequestParamsTimeGTC))
+	}
+	if o.ListClientOrderID != "" {
+		params.Set("listClientOrderId", o.ListClientOrderID)
 	}
+	return params
+}
 
-	if resp.Code != 0 {
-		return resp, errors.New(resp.Msg)
+// HasLimitPrice returns whether orders of the type are placed at a limit
+// price
+func (t RequestParamsOrderType) HasLimitPrice() bool {
+	switch t {
+	case BinanceRequestParamsOrderLimit,
+		BinanceRequestParamsOrderStopLossLimit,
+		BinanceRequestParamsOrderTakeProfitLimit,
+		BinanceRequestParamsOrderLimitMarker:
+		return true
 	}
-	return resp, nil
+	return false
 }
 
 // CancelExistingOrder sends a cancel order to Binance
-func (b *Binance) CancelExistingOrder(symbol string, orderID int64, origClientOrderID string) (CancelOrderResponse, error) {
+func (b *Binance) CancelExistingOrder(ctx context.Context, symbol string, orderID int64, origClientOrderID string) (CancelOrderResponse, error) {
 	var resp CancelOrderResponse
 
 	path := b.API.Endpoints.URL + cancelOrder
@@ -371,24 +439,26 @@ func (b *Binance) CancelExistingOrder(symbol string, orderID int64, origClientOr
 		params.Set("origClientOrderId", origClientOrderID)
 	}
 
-	return resp, b.SendAuthHTTPRequest(http.MethodDelete, path, params, request.Auth, &resp)
+	return resp, b.SendAuthHTTPRequest(ctx, http.MethodDelete, path, params, request.Auth, &resp)
 }
 
 // OpenOrders Current open orders. Get all open orders on a symbol.
 // Careful when accessing this with no symbol: The number of requests counted against the rate limiter
 // is equal to the number of symbols currently trading on the exchange.
-func (b *Binance) OpenOrders(symbol string) ([]QueryOrderData, error) {
+func (b *Binance) OpenOrders(ctx context.Context, symbol string) ([]QueryOrderData, error) {
 	var resp []QueryOrderData
 
 	path := b.API.Endpoints.URL + openOrders
 
 	params := url.Values{}
 
+	f := openOrdersAllRate
 	if symbol != "" {
 		params.Set("symbol", strings.ToUpper(symbol))
+		f = request.Auth
 	}
 
-	if err := b.SendAuthHTTPRequest(http.MethodGet, path, params, request.Auth, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, f, &resp); err != nil {
 		return resp, err
 	}
 
@@ -398,7 +468,7 @@ func (b *Binance) OpenOrders(symbol string) ([]QueryOrderData, error) {
 // AllOrders Get all account orders; active, canceled, or filled.
 // orderId optional param
 // limit optional param, default 500; max 500
-func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, error) {
+func (b *Binance) AllOrders(ctx context.Context, symbol, orderID, limit string) ([]QueryOrderData, error) {
 	var resp []QueryOrderData
 
 	path := b.API.Endpoints.URL + allOrders
@@ -411,7 +481,7 @@ func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, er
 	if limit != "" {
 		params.Set("limit", limit)
 	}
-	if err := b.SendAuthHTTPRequest(http.MethodGet, path, params, request.Auth, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, allOrdersRate, &resp); err != nil {
 		return resp, err
 	}
 
@@ -419,7 +489,7 @@ func (b *Binance) AllOrders(symbol, orderID, limit string) ([]QueryOrderData, er
 }
 
 // QueryOrder returns information on a past order
-func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (QueryOrderData, error) {
+func (b *Binance) QueryOrder(ctx context.Context, symbol, origClientOrderID string, orderID int64) (QueryOrderData, error) {
 	var resp QueryOrderData
 
 	path := b.API.Endpoints.URL + queryOrder
@@ -433,7 +503,7 @@ func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (Q
 		params.Set("orderId", strconv.FormatInt(orderID, 10))
 	}
 
-	if err := b.SendAuthHTTPRequest(http.MethodGet, path, params, request.Auth, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, request.Auth, &resp); err != nil {
 		return resp, err
 	}
 
@@ -444,7 +514,7 @@ func (b *Binance) QueryOrder(symbol, origClientOrderID string, orderID int64) (Q
 }
 
 // GetAccount returns binance user accounts
-func (b *Binance) GetAccount() (*Account, error) {
+func (b *Binance) GetAccount(ctx context.Context) (*Account, error) {
 	type response struct {
 		Response
 		Account
@@ -455,7 +525,7 @@ func (b *Binance) GetAccount() (*Account, error) {
 	path := b.API.Endpoints.URL + accountInfo
 	params := url.Values{}
 
-	if err := b.SendAuthHTTPRequest(http.MethodGet, path, params, request.Unset, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, accountInfoRate, &resp); err != nil {
 		return &resp.Account, err
 	}
 
@@ -466,19 +536,255 @@ func (b *Binance) GetAccount() (*Account, error) {
 	return &resp.Account, nil
 }
 
+// GetServerTime returns the exchange server time
+func (b *Binance) GetServerTime(ctx context.Context) (time.Time, error) {
+	var resp struct {
+		ServerTime int64 `json:"serverTime"`
+	}
+	err := b.SendHTTPRequest(ctx, b.API.Endpoints.URL+serverTime, &resp)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return convert.TimeFromUnix(resp.ServerTime, time.Millisecond), nil
+}
+
+// NewOCOOrder places a one-cancels-the-other order pair, a limit order and a
+// stop loss limit order where the fill or trigger of one cancels the other
+func (b *Binance) NewOCOOrder(ctx context.Context, o *OCOOrderRequest) (OrderList, error) {
+	var resp OrderList
+	return resp, b.SendAuthHTTPRequest(ctx, http.MethodPost, b.API.Endpoints.URL+newOCOOrder,
+		o.params(), orderListRate, &resp)
+}
+
+// NewOTOOrder places a one-triggers-the-other order pair, the pending order
+// is only placed once the working order has fully filled
+func (b *Binance) NewOTOOrder(ctx context.Context, o *OTOOrderRequest) (OrderList, error) {
+	var resp OrderList
+
+	params := url.Values{}
+	params.Set("symbol", o.Symbol)
+	params.Set("workingType", string(o.WorkingType))
+	params.Set("workingSide", o.WorkingSide)
+	params.Set("workingPrice", strconv.FormatFloat(o.WorkingPrice, 'f', -1, 64))
+	params.Set("workingQuantity", strconv.FormatFloat(o.WorkingQuantity, 'f', -1, 64))
+	if o.WorkingTimeInForce != "" {
+		params.Set("workingTimeInForce", string(o.WorkingTimeInForce))
+	}
+	params.Set("pendingType", string(o.PendingType))
+	params.Set("pendingSide", o.PendingSide)
+	params.Set("pendingQuantity", strconv.FormatFloat(o.PendingQuantity, 'f', -1, 64))
+	if o.PendingPrice != 0 {
+		params.Set("pendingPrice", strconv.FormatFloat(o.PendingPrice, 'f', -1, 64))
+	}
+	if o.PendingStopPrice != 0 {
+		params.Set("pendingStopPrice", strconv.FormatFloat(o.PendingStopPrice, 'f', -1, 64))
+	}
+	if o.PendingTimeInForce != "" {
+		params.Set("pendingTimeInForce", string(o.PendingTimeInForce))
+	}
+	if o.ListClientOrderID != "" {
+		params.Set("listClientOrderId", o.ListClientOrderID)
+	}
+
+	return resp, b.SendAuthHTTPRequest(ctx, http.MethodPost, b.API.Endpoints.URL+newOTOOrder,
+		params, orderListRate, &resp)
+}
+
+// CancelOrderList cancels every order in an OCO or OTO order list
+func (b *Binance) CancelOrderList(ctx context.Context, symbol string, orderListID int64) (OrderList, error) {
+	var resp OrderList
+
+	params := url.Values{}
+	params.Set("symbol", symbol)
+	params.Set("orderListId", strconv.FormatInt(orderListID, 10))
+
+	return resp, b.SendAuthHTTPRequest(ctx, http.MethodDelete, b.API.Endpoints.URL+orderList,
+		params, request.Auth, &resp)
+}
+
+// GetMarginAccount returns the cross margin account balances, borrowings and
+// margin level
+func (b *Binance) GetMarginAccount(ctx context.Context) (MarginAccount, error) {
+	var resp MarginAccount
+	return resp, b.SendAuthHTTPRequest(ctx, http.MethodGet, b.API.Endpoints.URL+marginAccount,
+		nil, marginAccountRate, &resp)
+}
+
+// GetIsolatedMarginAccount returns the isolated margin accounts for the
+// supplied symbols, or every isolated margin account when none are supplied
+func (b *Binance) GetIsolatedMarginAccount(ctx context.Context, symbols ...string) (IsolatedMarginAccount, error) {
+	var resp IsolatedMarginAccount
+
+	params := url.Values{}
+	if len(symbols) > 0 {
+		params.Set("symbols", strings.Join(symbols, ","))
+	}
+
+	return resp, b.SendAuthHTTPRequest(ctx, http.MethodGet, b.API.Endpoints.URL+isolatedMarginAccount,
+		params, marginAccountRate, &resp)
+}
+
+// NewMarginOrder sends a new order to the cross or isolated margin account
+func (b *Binance) NewMarginOrder(ctx context.Context, o *MarginOrderRequest) (NewOrderResponse, error) {
+	var resp NewOrderResponse
+
+	params := o.NewOrderRequest.params()
+	if o.IsIsolated {
+		params.Set("isIsolated", "TRUE")
+	}
+	if o.SideEffectType != "" {
+		params.Set("sideEffectType", string(o.SideEffectType))
+	}
+
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodPost, b.API.Endpoints.URL+marginOrder,
+		params, newOrderRate, &resp); err != nil {
+		return resp, err
+	}
+
+	if resp.Code != 0 {
+		return resp, errors.New(resp.Msg)
+	}
+	return resp, nil
+}
+
+// NewMarginOCOOrder places a one-cancels-the-other order pair in the cross or
+// isolated margin account
+func (b *Binance) NewMarginOCOOrder(ctx context.Context, o *OCOOrderRequest, isIsolated bool, sideEffect SideEffectType) (OrderList, error) {
+	var resp OrderList
+
+	params := o.params()
+	if isIsolated {
+		params.Set("isIsolated", "TRUE")
+	}
+	if sideEffect != "" {
+		params.Set("sideEffectType", string(sideEffect))
+	}
+
+	return resp, b.SendAuthHTTPRequest(ctx, http.MethodPost, b.API.Endpoints.URL+marginOCOOrder,
+		params, orderListRate, &resp)
+}
+
+// MarginBorrow borrows an asset against the cross margin account, or against
+// the isolated margin account of symbol when it is set
+func (b *Binance) MarginBorrow(ctx context.Context, asset, symbol string, amount float64) (int64, error) {
+	return b.marginLoan(ctx, marginLoan, asset, symbol, amount)
+}
+
+// MarginRepay repays borrowings and interest in the cross margin account, or
+// in the isolated margin account of symbol when it is set
+func (b *Binance) MarginRepay(ctx context.Context, asset, symbol string, amount float64) (int64, error) {
+	return b.marginLoan(ctx, marginRepay, asset, symbol, amount)
+}
+
+// marginLoan sends a borrow or repay request and returns its transaction ID
+func (b *Binance) marginLoan(ctx context.Context, endpoint, asset, symbol string, amount float64) (int64, error) {
+	var resp MarginTransaction
+
+	params := url.Values{}
+	params.Set("asset", asset)
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	if symbol != "" {
+		params.Set("isIsolated", "TRUE")
+		params.Set("symbol", symbol)
+	}
+
+	return resp.TransactionID, b.SendAuthHTTPRequest(ctx, http.MethodPost, b.API.Endpoints.URL+endpoint,
+		params, request.Auth, &resp)
+}
+
+// TransferMargin moves an asset between the spot account and the cross
+// margin account
+func (b *Binance) TransferMargin(ctx context.Context, asset string, amount float64, direction MarginTransferDirection) (int64, error) {
+	var resp MarginTransaction
+
+	params := url.Values{}
+	params.Set("asset", asset)
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+	params.Set("type", strconv.Itoa(int(direction)))
+
+	return resp.TransactionID, b.SendAuthHTTPRequest(ctx, http.MethodPost, b.API.Endpoints.URL+marginTransfer,
+		params, request.Auth, &resp)
+}
+
+// TransferIsolatedMargin moves an asset between the spot account and the
+// isolated margin account of symbol
+func (b *Binance) TransferIsolatedMargin(ctx context.Context, asset, symbol string, amount float64, direction MarginTransferDirection) (int64, error) {
+	var resp MarginTransaction
+
+	from, to := "SPOT", "ISOLATED_MARGIN"
+	if direction == MarginToSpot {
+		from, to = to, from
+	}
+
+	params := url.Values{}
+	params.Set("asset", asset)
+	params.Set("symbol", symbol)
+	params.Set("transFrom", from)
+	params.Set("transTo", to)
+	params.Set("amount", strconv.FormatFloat(amount, 'f', -1, 64))
+
+	return resp.TransactionID, b.SendAuthHTTPRequest(ctx, http.MethodPost, b.API.Endpoints.URL+isolatedMarginTransfer,
+		params, request.Auth, &resp)
+}
+
+// GetMarginListenKey opens a margin user data stream and returns its listen
+// key, an isolated margin stream is opened for symbol when it is set
+func (b *Binance) GetMarginListenKey(ctx context.Context, symbol string) (string, error) {
+	var resp struct {
+		ListenKey string `json:"listenKey"`
+	}
+	path, params := b.marginUserDataStreamPath(symbol, "")
+	return resp.ListenKey, b.sendAPIKeyHTTPRequest(ctx, http.MethodPost, path, params, &resp)
+}
+
+// KeepAliveMarginListenKey extends the validity of a margin user data stream
+// by 60 minutes
+func (b *Binance) KeepAliveMarginListenKey(ctx context.Context, symbol, listenKey string) error {
+	path, params := b.marginUserDataStreamPath(symbol, listenKey)
+	return b.sendAPIKeyHTTPRequest(ctx, http.MethodPut, path, params, &struct{}{})
+}
+
+// CloseMarginListenKey closes a margin user data stream
+func (b *Binance) CloseMarginListenKey(ctx context.Context, symbol, listenKey string) error {
+	path, params := b.marginUserDataStreamPath(symbol, listenKey)
+	return b.sendAPIKeyHTTPRequest(ctx, http.MethodDelete, path, params, &struct{}{})
+}
+
+// marginUserDataStreamPath returns the cross or isolated margin user data
+// stream endpoint and its parameters
+func (b *Binance) marginUserDataStreamPath(symbol, listenKey string) (string, url.Values) {
+	params := url.Values{}
+	if listenKey != "" {
+		params.Set("listenKey", listenKey)
+	}
+	if symbol == "" {
+		return b.API.Endpoints.URL + marginUserDataStream, params
+	}
+	params.Set("symbol", symbol)
+	return b.API.Endpoints.URL + isolatedUserDataStream, params
+}
+
 // SendHTTPRequest sends an unauthenticated request
-func (b *Binance) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload(&request.Item{
+func (b *Binance) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.sendHTTPRequest(ctx, path, request.UnAuth, 0, result)
+}
+
+// sendHTTPRequest sends an unauthenticated HTTP request charged the rate
+// limit weight of f, reusing the response for ttl if set
+func (b *Binance) sendHTTPRequest(ctx context.Context, path string, f request.EndpointLimit, ttl time.Duration, result interface{}) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
 		Verbose:       b.Verbose,
 		HTTPDebugging: b.HTTPDebugging,
-		HTTPRecording: b.HTTPRecording})
+		HTTPRecording: b.HTTPRecording,
+		Endpoint:      f,
+		CacheTTL:      ttl})
 }
 
 // SendAuthHTTPRequest sends an authenticated HTTP request
-func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, f request.EndpointLimit, result interface{}) error {
+func (b *Binance) SendAuthHTTPRequest(ctx context.Context, method, path string, params url.Values, f request.EndpointLimit, result interface{}) error {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -487,7 +793,7 @@ func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, f
 		params = url.Values{}
 	}
 	params.Set("recvWindow", strconv.FormatInt(convert.RecvWindow(5*time.Second), 10))
-	params.Set("timestamp", strconv.FormatInt(time.Now().Unix()*1000, 10))
+	params.Set("timestamp", strconv.FormatInt(b.Requester.Now().Unix()*1000, 10))
 
 	signature := params.Encode()
 	hmacSigned := crypto.GetHMAC(crypto.HashSHA256, []byte(signature), []byte(b.API.Credentials.Secret))
@@ -510,7 +816,7 @@ func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, f
 		Message string `json:"msg"`
 	}{}
 
-	err := b.SendPayload(&request.Item{
+	err := b.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          path,
 		Headers:       headers,
@@ -534,6 +840,26 @@ func (b *Binance) SendAuthHTTPRequest(method, path string, params url.Values, f
 	return json.Unmarshal(interim, result)
 }
 
+// sendAPIKeyHTTPRequest sends a request which is authenticated by the API key
+// alone, user data stream requests are not signed
+func (b *Binance) sendAPIKeyHTTPRequest(ctx context.Context, method, path string, params url.Values, result interface{}) error {
+	if !b.AllowAuthenticatedRequest() {
+		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
+	}
+
+	return b.SendPayload(ctx, &request.Item{
+		Method:        method,
+		Path:          common.EncodeURLValues(path, params),
+		Headers:       map[string]string{"X-MBX-APIKEY": b.API.Credentials.Key},
+		Body:          bytes.NewBuffer(nil),
+		Result:        result,
+		AuthRequest:   true,
+		Verbose:       b.Verbose,
+		HTTPDebugging: b.HTTPDebugging,
+		HTTPRecording: b.HTTPRecording,
+		Endpoint:      request.Auth})
+}
+
 // CheckLimit checks value against a variable list
 func (b *Binance) CheckLimit(limit int) error {
 	for x := range b.validLimits {
@@ -588,12 +914,12 @@ func (b *Binance) SetValues() {
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Binance) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Binance) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		multiplier, err := b.getMultiplier(feeBuilder.IsMaker)
+		multiplier, err := b.getMultiplier(ctx, feeBuilder.IsMaker)
 		if err != nil {
 			return 0, err
 		}
@@ -615,9 +941,9 @@ func getOfflineTradeFee(price, amount float64) float64 {
 }
 
 // getMultiplier retrieves account based taker/maker fees
-func (b *Binance) getMultiplier(isMaker bool) (float64, error) {
+func (b *Binance) getMultiplier(ctx context.Context, isMaker bool) (float64, error) {
 	var multiplier float64
-	account, err := b.GetAccount()
+	account, err := b.GetAccount(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -640,7 +966,7 @@ func getCryptocurrencyWithdrawalFee(c currency.Code) float64 {
 }
 
 // WithdrawCrypto sends cryptocurrency to the address of your choosing
-func (b *Binance) WithdrawCrypto(asset, address, addressTag, name, amount string) (string, error) {
+func (b *Binance) WithdrawCrypto(ctx context.Context, asset, network, address, addressTag, name, amount string) (string, error) {
 	var resp WithdrawResponse
 	path := b.API.Endpoints.URL + withdrawEndpoint
 
@@ -648,6 +974,9 @@ func (b *Binance) WithdrawCrypto(asset, address, addressTag, name, amount string
 	params.Set("asset", asset)
 	params.Set("address", address)
 	params.Set("amount", amount)
+	if len(network) > 0 {
+		params.Set("network", network)
+	}
 	if len(name) > 0 {
 		params.Set("name", name)
 	}
@@ -655,7 +984,7 @@ func (b *Binance) WithdrawCrypto(asset, address, addressTag, name, amount string
 		params.Set("addressTag", addressTag)
 	}
 
-	if err := b.SendAuthHTTPRequest(http.MethodPost, path, params, request.Unset, &resp); err != nil {
+	if err := b.SendAuthHTTPRequest(ctx, http.MethodPost, path, params, request.Unset, &resp); err != nil {
 		return "", err
 	}
 
@@ -667,7 +996,7 @@ func (b *Binance) WithdrawCrypto(asset, address, addressTag, name, amount string
 }
 
 // GetDepositAddressForCurrency retrieves the wallet address for a given currency
-func (b *Binance) GetDepositAddressForCurrency(currency string) (string, error) {
+func (b *Binance) GetDepositAddressForCurrency(ctx context.Context, currency string) (string, error) {
 	path := b.API.Endpoints.URL + depositAddress
 
 	resp := struct {
@@ -681,5 +1010,5 @@ func (b *Binance) GetDepositAddressForCurrency(currency string) (string, error)
 	params.Set("status", "true")
 
 	return resp.Address,
-		b.SendAuthHTTPRequest(http.MethodGet, path, params, request.Unset, &resp)
+		b.SendAuthHTTPRequest(ctx, http.MethodGet, path, params, request.Unset, &resp)
 }
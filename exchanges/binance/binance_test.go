@@ -1,14 +1,17 @@
 package binance
 
 import (
+	"context"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 )
 
@@ -36,7 +39,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 
 func TestGetExchangeInfo(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExchangeInfo()
+	_, err := b.GetExchangeInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -45,7 +48,7 @@ func TestGetExchangeInfo(t *testing.T) {
 func TestFetchTradablePairs(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.FetchTradablePairs(asset.Spot)
+	_, err := b.FetchTradablePairs(context.Background(), asset.Spot)
 	if err != nil {
 		t.Error("Binance FetchTradablePairs(asset asets.AssetType) error", err)
 	}
@@ -54,7 +57,7 @@ func TestFetchTradablePairs(t *testing.T) {
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderBook(OrderBookDataRequestParams{
+	_, err := b.GetOrderBook(context.Background(), OrderBookDataRequestParams{
 		Symbol: "BTCUSDT",
 		Limit:  10,
 	})
@@ -67,7 +70,7 @@ func TestGetOrderBook(t *testing.T) {
 func TestGetRecentTrades(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetRecentTrades(RecentTradeRequestParams{
+	_, err := b.GetRecentTrades(context.Background(), RecentTradeRequestParams{
 		Symbol: "BTCUSDT",
 		Limit:  15,
 	})
@@ -92,7 +95,7 @@ func TestGetHistoricalTrades(t *testing.T) {
 func TestGetAggregatedTrades(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAggregatedTrades("BTCUSDT", 5)
+	_, err := b.GetAggregatedTrades(context.Background(), "BTCUSDT", 5)
 	if err != nil {
 		t.Error("Binance GetAggregatedTrades() error", err)
 	}
@@ -101,7 +104,7 @@ func TestGetAggregatedTrades(t *testing.T) {
 func TestGetSpotKline(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetSpotKline(KlinesRequestParams{
+	_, err := b.GetSpotKline(context.Background(), KlinesRequestParams{
 		Symbol:   "BTCUSDT",
 		Interval: TimeIntervalFiveMinutes,
 		Limit:    24,
@@ -114,7 +117,7 @@ func TestGetSpotKline(t *testing.T) {
 func TestGetAveragePrice(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAveragePrice("BTCUSDT")
+	_, err := b.GetAveragePrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Binance GetAveragePrice() error", err)
 	}
@@ -123,7 +126,7 @@ func TestGetAveragePrice(t *testing.T) {
 func TestGetPriceChangeStats(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetPriceChangeStats("BTCUSDT")
+	_, err := b.GetPriceChangeStats(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Binance GetPriceChangeStats() error", err)
 	}
@@ -132,7 +135,7 @@ func TestGetPriceChangeStats(t *testing.T) {
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTickers()
+	_, err := b.GetTickers(context.Background())
 	if err != nil {
 		t.Error("Binance TestGetTickers error", err)
 	}
@@ -141,7 +144,7 @@ func TestGetTickers(t *testing.T) {
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetLatestSpotPrice("BTCUSDT")
+	_, err := b.GetLatestSpotPrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Binance GetLatestSpotPrice() error", err)
 	}
@@ -150,7 +153,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 func TestGetBestPrice(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetBestPrice("BTCUSDT")
+	_, err := b.GetBestPrice(context.Background(), "BTCUSDT")
 	if err != nil {
 		t.Error("Binance GetBestPrice() error", err)
 	}
@@ -159,7 +162,7 @@ func TestGetBestPrice(t *testing.T) {
 func TestQueryOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.QueryOrder("BTCUSDT", "", 1337)
+	_, err := b.QueryOrder(context.Background(), "BTCUSDT", "", 1337)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("QueryOrder() error", err)
@@ -173,7 +176,7 @@ func TestQueryOrder(t *testing.T) {
 func TestOpenOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.OpenOrders("BTCUSDT")
+	_, err := b.OpenOrders(context.Background(), "BTCUSDT")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("OpenOrders() error", err)
@@ -184,10 +187,26 @@ func TestOpenOrders(t *testing.T) {
 	}
 }
 
+func TestGetAPIKeyPermissions(t *testing.T) {
+	t.Parallel()
+
+	perms, err := b.GetAPIKeyPermissions(context.Background())
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("GetAPIKeyPermissions() error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("GetAPIKeyPermissions() expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error("Mock GetAPIKeyPermissions() error", err)
+	case mockTests && !perms.Read:
+		t.Error("Mock GetAPIKeyPermissions() expected read permission")
+	}
+}
+
 func TestAllOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.AllOrders("BTCUSDT", "", "")
+	_, err := b.AllOrders(context.Background(), "BTCUSDT", "", "")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("AllOrders() error", err)
@@ -198,12 +217,117 @@ func TestAllOrders(t *testing.T) {
 	}
 }
 
+func TestGetMarginAccount(t *testing.T) {
+	t.Parallel()
+
+	_, err := b.GetMarginAccount(context.Background())
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("GetMarginAccount() error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("GetMarginAccount() expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error("Mock GetMarginAccount() error", err)
+	}
+}
+
+func TestGetMarginPositions(t *testing.T) {
+	t.Parallel()
+
+	positions, err := b.GetMarginPositions(context.Background())
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("GetMarginPositions() error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("GetMarginPositions() expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error("Mock GetMarginPositions() error", err)
+	case mockTests && len(positions) != 1:
+		t.Errorf("Mock GetMarginPositions() expected 1 created isolated position, received %d", len(positions))
+	}
+}
+
+func TestMarginPosition(t *testing.T) {
+	t.Parallel()
+
+	m := b.marginPosition(&IsolatedMarginPair{
+		Symbol:          "BTCUSDT",
+		BaseAsset:       MarginAsset{Asset: "BTC", NetAsset: 0.1, TotalAsset: 0.1},
+		QuoteAsset:      MarginAsset{Asset: "USDT", Borrowed: 495, Interest: 5, NetAsset: -200, TotalAsset: 300},
+		IsolatedCreated: true,
+		IndexPrice:      10000,
+		LiquidatePrice:  2500,
+	})
+	if !m.Pair.Equal(currency.NewPair(currency.BTC, currency.USDT)) ||
+		m.AssetType != asset.Margin ||
+		m.Currency != currency.USDT ||
+		!m.Isolated {
+		t.Errorf("unexpected position %+v", m)
+	}
+	if m.Size != 0.1 || m.Margin != 800 || m.MaintenanceMargin != 50 {
+		t.Errorf("expected size 0.1 margin 800 maintenance 50, received %v %v %v",
+			m.Size, m.Margin, m.MaintenanceMargin)
+	}
+	// Liquidated once assets fall to 1.1 times liabilities
+	m.Margin = 50
+	if m.MarginRatio() != 1 {
+		t.Errorf("expected margin ratio 1 at liquidation, received %v", m.MarginRatio())
+	}
+}
+
+func TestWsExecutionReport(t *testing.T) {
+	t.Parallel()
+
+	r, err := b.wsExecutionReport(&WsExecutionReport{
+		EventType:            "executionReport",
+		Symbol:               "BTCUSDT",
+		Side:                 "SELL",
+		ExecutionType:        "TRADE",
+		OrderID:              4293153,
+		TradeID:              1337,
+		LastExecutedQuantity: 0.5,
+		LastExecutedPrice:    10000,
+		Commission:           5,
+		CommissionAsset:      "USDT",
+		TransactionTime:      1499405658657,
+		IsMaker:              true,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.AssetType != asset.Margin ||
+		r.OrderID != "4293153" ||
+		r.TradeID != "1337" ||
+		r.Side != order.Sell ||
+		r.Liquidity != order.Maker ||
+		r.FeeCurrency != currency.USDT ||
+		r.Price.Float64() != 10000 ||
+		r.Amount.Float64() != 0.5 {
+		t.Errorf("unexpected execution report %+v", r)
+	}
+
+	_, err = b.wsExecutionReport(&WsExecutionReport{Side: "SIDEWAYS"})
+	if err == nil {
+		t.Error("expected an error for an unknown side")
+	}
+}
+
+func TestHasLimitPrice(t *testing.T) {
+	t.Parallel()
+
+	if !BinanceRequestParamsOrderStopLossLimit.HasLimitPrice() ||
+		BinanceRequestParamsOrderStopLoss.HasLimitPrice() ||
+		BinanceRequestParamsOrderMarket.HasLimitPrice() {
+		t.Error("unexpected limit price requirement")
+	}
+}
+
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	t.Parallel()
 
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -222,7 +346,7 @@ func TestGetFee(t *testing.T) {
 
 	if areTestAPIKeysSet() || mockTests {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.1) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		}
@@ -231,7 +355,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(100000) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(100000) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(100000), resp)
 			t.Error(err)
 		}
@@ -239,7 +363,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.1) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.1) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.1), resp)
 			t.Error(err)
 		}
@@ -247,7 +371,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -256,7 +380,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -264,7 +388,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -273,7 +397,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -282,7 +406,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -304,7 +428,7 @@ func TestGetActiveOrders(t *testing.T) {
 	var getOrdersRequest = order.GetOrdersRequest{
 		OrderType: order.AnyType,
 	}
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if err == nil {
 		t.Error("Expected: 'At least one currency is required to fetch order history'. received nil")
 	}
@@ -313,7 +437,7 @@ func TestGetActiveOrders(t *testing.T) {
 		currency.NewPair(currency.LTC, currency.BTC),
 	}
 
-	_, err = b.GetActiveOrders(&getOrdersRequest)
+	_, err = b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetActiveOrders() error", err)
@@ -331,7 +455,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if err == nil {
 		t.Error("Expected: 'At least one currency is required to fetch order history'. received nil")
 	}
@@ -340,7 +464,7 @@ func TestGetOrderHistory(t *testing.T) {
 		currency.NewPair(currency.LTC,
 			currency.BTC)}
 
-	_, err = b.GetOrderHistory(&getOrdersRequest)
+	_, err = b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetOrderHistory() error", err)
@@ -369,12 +493,12 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1000000000,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1000000000),
 		ClientID:  "meowOrder",
 	}
 
-	_, err := b.SubmitOrder(orderSubmission)
+	_, err := b.SubmitOrder(context.Background(), orderSubmission)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("SubmitOrder() error", err)
@@ -385,6 +509,30 @@ func TestSubmitOrder(t *testing.T) {
 	}
 }
 
+func TestSubmitMarginOrder(t *testing.T) {
+	t.Parallel()
+
+	if areTestAPIKeysSet() && !canManipulateRealOrders && !mockTests {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+
+	_, err := b.SubmitOrder(context.Background(), &order.Submit{
+		Pair:      currency.NewPair(currency.BTC, currency.USDT),
+		AssetType: asset.Margin,
+		OrderSide: order.Sell,
+		OrderType: order.Market,
+		Amount:    decimal.NewFromFloat(0.01),
+	})
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("SubmitOrder() margin error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("SubmitOrder() margin expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error("Mock SubmitOrder() margin error", err)
+	}
+}
+
 func TestCancelExchangeOrder(t *testing.T) {
 	t.Parallel()
 
@@ -398,7 +546,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currency.NewPair(currency.LTC, currency.BTC),
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("CancelExchangeOrder() error", err)
@@ -422,7 +570,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currency.NewPair(currency.LTC, currency.BTC),
 	}
 
-	_, err := b.CancelAllOrders(orderCancellation)
+	_, err := b.CancelAllOrders(context.Background(), orderCancellation)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("CancelAllExchangeOrders() error", err)
@@ -436,7 +584,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.UpdateAccountInfo()
+	_, err := b.UpdateAccountInfo(context.Background())
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetAccountInfo() error", err)
@@ -447,10 +595,61 @@ func TestGetAccountInfo(t *testing.T) {
 	}
 }
 
+func TestNewOCOOrder(t *testing.T) {
+	t.Parallel()
+
+	if areTestAPIKeysSet() && !canManipulateRealOrders && !mockTests {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+
+	resp, err := b.NewOCOOrder(context.Background(), &OCOOrderRequest{
+		Symbol:         "LTCBTC",
+		Side:           order.Buy.String(),
+		Quantity:       1,
+		Price:          0.001,
+		StopPrice:      0.002,
+		StopLimitPrice: 0.0021,
+	})
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("NewOCOOrder() error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("NewOCOOrder() expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error("Mock NewOCOOrder() error", err)
+	case mockTests && len(resp.Orders) != 2:
+		t.Errorf("Mock NewOCOOrder() expected 2 orders, received %d", len(resp.Orders))
+	}
+}
+
+func TestAddMargin(t *testing.T) {
+	t.Parallel()
+
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	err := b.AddMargin(context.Background(), p, asset.Spot, decimal.NewFromInt(100))
+	if err == nil {
+		t.Error("expected an error adding margin to a spot pair")
+	}
+
+	if areTestAPIKeysSet() && !canManipulateRealOrders && !mockTests {
+		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
+	}
+
+	err = b.AddMargin(context.Background(), p, asset.Margin, decimal.NewFromInt(100))
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("AddMargin() error", err)
+	case !areTestAPIKeysSet() && err == nil && !mockTests:
+		t.Error("AddMargin() expecting an error when no keys are set")
+	case mockTests && err != nil:
+		t.Error("Mock AddMargin() error", err)
+	}
+}
+
 func TestModifyOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() error cannot be nil")
 	}
@@ -472,7 +671,7 @@ func TestWithdraw(t *testing.T) {
 		Address: core.BitcoinDonationAddress,
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("Withdraw() error", err)
@@ -487,7 +686,7 @@ func TestWithdrawFiat(t *testing.T) {
 	t.Parallel()
 
 	var withdrawFiatRequest withdraw.FiatRequest
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -497,7 +696,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	t.Parallel()
 
 	var withdrawFiatRequest withdraw.FiatRequest
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -506,7 +705,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetDepositAddress(currency.BTC, "")
+	_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetDepositAddress() error", err)
@@ -516,3 +715,18 @@ func TestGetDepositAddress(t *testing.T) {
 		t.Error("Mock GetDepositAddress() error", err)
 	}
 }
+
+func TestOrderbookDepthRate(t *testing.T) {
+	t.Parallel()
+	l := SetRateLimit()
+	for _, limit := range b.validLimits {
+		if err := l.Limit(orderbookDepthRate(limit)); err != nil {
+			t.Errorf("limit %d: %v", limit, err)
+		}
+	}
+	if orderbookDepthRate(100) != request.UnAuth ||
+		orderbookDepthRate(500) != orderbookDepth500Rate ||
+		orderbookDepthRate(1000) != orderbookDepth1000Rate {
+		t.Error("unexpected orderbook depth weight")
+	}
+}
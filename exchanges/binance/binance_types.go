@@ -192,6 +192,61 @@ type TickerStream struct {
 	NumberOfTrades         int64   `json:"n"`
 }
 
+// UserDataStreamEvent holds the event type of a user data stream message
+type UserDataStreamEvent struct {
+	EventType string `json:"e"`
+}
+
+// WsExecutionReport holds an order update from the user data stream
+type WsExecutionReport struct {
+	EventType              string  `json:"e"`
+	EventTime              int64   `json:"E"`
+	Symbol                 string  `json:"s"`
+	ClientOrderID          string  `json:"c"`
+	Side                   string  `json:"S"`
+	OrderType              string  `json:"o"`
+	TimeInForce            string  `json:"f"`
+	Quantity               float64 `json:"q,string"`
+	Price                  float64 `json:"p,string"`
+	StopPrice              float64 `json:"P,string"`
+	ExecutionType          string  `json:"x"`
+	OrderStatus            string  `json:"X"`
+	RejectReason           string  `json:"r"`
+	OrderID                int64   `json:"i"`
+	LastExecutedQuantity   float64 `json:"l,string"`
+	CumulativeFilledAmount float64 `json:"z,string"`
+	LastExecutedPrice      float64 `json:"L,string"`
+	Commission             float64 `json:"n,string"`
+	CommissionAsset        string  `json:"N"`
+	TransactionTime        int64   `json:"T"`
+	TradeID                int64   `json:"t"`
+	IsMaker                bool    `json:"m"`
+	OrderListID            int64   `json:"g"`
+}
+
+// WsAccountPosition holds the balances changed by an account update from the
+// user data stream
+type WsAccountPosition struct {
+	EventType  string `json:"e"`
+	EventTime  int64  `json:"E"`
+	LastUpdate int64  `json:"u"`
+	Balances   []struct {
+		Asset  string  `json:"a"`
+		Free   float64 `json:"f,string"`
+		Locked float64 `json:"l,string"`
+	} `json:"B"`
+}
+
+// WsBalanceUpdate holds a deposit, withdrawal or transfer from the user data
+// stream
+type WsBalanceUpdate struct {
+	EventType string  `json:"e"`
+	EventTime int64   `json:"E"`
+	Asset     string  `json:"a"`
+	Delta     float64 `json:"d,string"`
+	ClearTime int64   `json:"T"`
+}
+
 // HistoricalTrade holds recent trade data
 type HistoricalTrade struct {
 	Code         int     `json:"code"`
@@ -366,6 +421,143 @@ type Account struct {
 	Balances         []Balance `json:"balances"`
 }
 
+// OCOOrderRequest holds a one-cancels-the-other order pair, a limit order at
+// Price and a stop loss order triggered at StopPrice
+type OCOOrderRequest struct {
+	Symbol   string
+	Side     string
+	Quantity float64
+	Price    float64
+	// StopPrice triggers the stop loss order
+	StopPrice float64
+	// StopLimitPrice makes the stop loss order a stop loss limit order when
+	// set
+	StopLimitPrice    float64
+	ListClientOrderID string
+}
+
+// OTOOrderRequest holds a one-triggers-the-other order pair, the pending order
+// is placed once the working order is fully filled
+type OTOOrderRequest struct {
+	Symbol string
+	// WorkingType is either LIMIT or LIMIT_MAKER
+	WorkingType        RequestParamsOrderType
+	WorkingSide        string
+	WorkingPrice       float64
+	WorkingQuantity    float64
+	WorkingTimeInForce RequestParamsTimeForceType
+	PendingType        RequestParamsOrderType
+	PendingSide        string
+	PendingQuantity    float64
+	// PendingPrice is required for limit pending orders
+	PendingPrice float64
+	// PendingStopPrice is required for stop loss and take profit pending
+	// orders
+	PendingStopPrice   float64
+	PendingTimeInForce RequestParamsTimeForceType
+	ListClientOrderID  string
+}
+
+// OrderList holds an OCO or OTO order list and the orders within it
+type OrderList struct {
+	OrderListID       int64  `json:"orderListId"`
+	ContingencyType   string `json:"contingencyType"`
+	ListStatusType    string `json:"listStatusType"`
+	ListOrderStatus   string `json:"listOrderStatus"`
+	ListClientOrderID string `json:"listClientOrderId"`
+	TransactionTime   int64  `json:"transactionTime"`
+	Symbol            string `json:"symbol"`
+	// IsIsolated is only set on margin order lists
+	IsIsolated bool `json:"isIsolated"`
+	Orders     []struct {
+		Symbol        string `json:"symbol"`
+		OrderID       int64  `json:"orderId"`
+		ClientOrderID string `json:"clientOrderId"`
+	} `json:"orders"`
+	OrderReports []NewOrderResponse `json:"orderReports"`
+}
+
+// SideEffectType sets whether a margin order borrows or repays automatically
+type SideEffectType string
+
+// Margin order side effects
+const (
+	NoSideEffect SideEffectType = "NO_SIDE_EFFECT"
+	MarginBuy    SideEffectType = "MARGIN_BUY"
+	AutoRepay    SideEffectType = "AUTO_REPAY"
+)
+
+// MarginOrderRequest holds a new order for the cross margin account, or the
+// isolated margin account of its symbol when IsIsolated is set
+type MarginOrderRequest struct {
+	NewOrderRequest
+	IsIsolated     bool
+	SideEffectType SideEffectType
+}
+
+// MarginTransferDirection is the direction of a transfer between the spot
+// and margin accounts
+type MarginTransferDirection int
+
+// Margin transfer directions
+const (
+	SpotToMargin MarginTransferDirection = iota + 1
+	MarginToSpot
+)
+
+// MarginTransaction holds the ID of a margin borrow, repay or transfer
+type MarginTransaction struct {
+	TransactionID int64 `json:"tranId"`
+}
+
+// MarginAsset holds the balance and borrowings of an asset in a margin
+// account
+type MarginAsset struct {
+	Asset    string  `json:"asset"`
+	Borrowed float64 `json:"borrowed,string"`
+	Free     float64 `json:"free,string"`
+	Interest float64 `json:"interest,string"`
+	Locked   float64 `json:"locked,string"`
+	NetAsset float64 `json:"netAsset,string"`
+	// TotalAsset is only reported by isolated margin accounts
+	TotalAsset float64 `json:"totalAsset,string"`
+}
+
+// MarginAccount holds the cross margin account
+type MarginAccount struct {
+	BorrowEnabled       bool          `json:"borrowEnabled"`
+	MarginLevel         float64       `json:"marginLevel,string"`
+	TotalAssetOfBTC     float64       `json:"totalAssetOfBtc,string"`
+	TotalLiabilityOfBTC float64       `json:"totalLiabilityOfBtc,string"`
+	TotalNetAssetOfBTC  float64       `json:"totalNetAssetOfBtc,string"`
+	TradeEnabled        bool          `json:"tradeEnabled"`
+	TransferEnabled     bool          `json:"transferEnabled"`
+	UserAssets          []MarginAsset `json:"userAssets"`
+}
+
+// IsolatedMarginPair holds the isolated margin account of a symbol
+type IsolatedMarginPair struct {
+	Symbol          string      `json:"symbol"`
+	BaseAsset       MarginAsset `json:"baseAsset"`
+	QuoteAsset      MarginAsset `json:"quoteAsset"`
+	IsolatedCreated bool        `json:"isolatedCreated"`
+	Enabled         bool        `json:"enabled"`
+	// MarginLevel is the ratio of total assets to total liabilities
+	MarginLevel       float64 `json:"marginLevel,string"`
+	MarginLevelStatus string  `json:"marginLevelStatus"`
+	IndexPrice        float64 `json:"indexPrice,string"`
+	LiquidatePrice    float64 `json:"liquidatePrice,string"`
+	TradeEnabled      bool    `json:"tradeEnabled"`
+}
+
+// IsolatedMarginAccount holds the isolated margin accounts
+type IsolatedMarginAccount struct {
+	Assets              []IsolatedMarginPair `json:"assets"`
+	TotalAssetOfBTC     float64              `json:"totalAssetOfBtc,string"`
+	TotalLiabilityOfBTC float64              `json:"totalLiabilityOfBtc,string"`
+	TotalNetAssetOfBTC  float64              `json:"totalNetAssetOfBtc,string"`
+}
+
 // RequestParamsTimeForceType Time in force
 type RequestParamsTimeForceType string
 
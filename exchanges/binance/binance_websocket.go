@@ -1,6 +1,7 @@
 package binance
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -10,17 +11,25 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
+	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
 const (
 	binanceDefaultWebsocketURL = "wss://stream.binance.com:9443"
 	pingDelay                  = time.Minute * 9
+	// listenKeyKeepAliveDelay keeps user data streams open, listen keys
+	// expire after 60 minutes without a keep alive
+	listenKeyKeepAliveDelay = time.Minute * 30
 )
 
 // WsConnect intiates a websocket connection
@@ -46,8 +55,7 @@ func (b *Binance) WsConnect() error {
 		strings.Replace(
 			strings.Join(pairs, "@depth/"), "-", "", -1)) + "@depth"
 
-	wsurl := b.Websocket.GetWebsocketURL() +
-		"/stream?streams=" +
+	streams := "/stream?streams=" +
 		tick +
 		"/" +
 		trade +
@@ -55,15 +63,31 @@ func (b *Binance) WsConnect() error {
 		kline +
 		"/" +
 		depth
+	b.marginListenKey = ""
+	if b.Websocket.CanUseAuthenticatedEndpoints() {
+		listenKey, err := b.GetMarginListenKey(context.Background(), "")
+		if err != nil {
+			log.Errorf(log.ExchangeSys, "%v - unable to open margin user data stream: %v\n", b.Name, err)
+			b.Websocket.SetCanUseAuthenticatedEndpoints(false)
+		} else {
+			b.marginListenKey = listenKey
+			streams += "/" + listenKey
+		}
+	}
 	enabledPairs := b.GetEnabledPairs(asset.Spot)
 	for i := range enabledPairs {
-		err = b.SeedLocalCache(enabledPairs[i])
+		err = b.SeedLocalCache(context.Background(), enabledPairs[i])
 		if err != nil {
 			return err
 		}
 	}
 
-	b.WebsocketConn.URL = wsurl
+	b.WebsocketConn.URL = b.Websocket.GetWebsocketURL() + streams
+	alternates := b.Websocket.GetAlternateURLs()
+	b.WebsocketConn.AlternateURLs = make([]string, len(alternates))
+	for i := range alternates {
+		b.WebsocketConn.AlternateURLs[i] = alternates[i] + streams
+	}
 	b.WebsocketConn.Verbose = b.Verbose
 
 	err = b.WebsocketConn.Dial(&dialer, http.Header{})
@@ -78,10 +102,35 @@ func (b *Binance) WsConnect() error {
 		Delay:             pingDelay,
 	})
 	go b.WsHandleData()
+	if b.marginListenKey != "" {
+		go b.keepMarginListenKeyAlive(b.marginListenKey)
+	}
 
 	return nil
 }
 
+// keepMarginListenKeyAlive extends the margin user data stream until the
+// websocket is shut down
+func (b *Binance) keepMarginListenKeyAlive(listenKey string) {
+	b.Websocket.Wg.Add(1)
+	defer b.Websocket.Wg.Done()
+	t := time.NewTicker(listenKeyKeepAliveDelay)
+	defer t.Stop()
+	for {
+		select {
+		case <-b.Websocket.ShutdownC:
+			return
+		case <-t.C:
+			err := b.KeepAliveMarginListenKey(context.Background(), "", listenKey)
+			if err != nil {
+				b.Websocket.DataHandler <- fmt.Errorf("%v - margin user data stream keep alive error: %s",
+					b.Name,
+					err)
+			}
+		}
+	}
+}
+
 // WsHandleData handles websocket data from WsReadData
 func (b *Binance) WsHandleData() {
 	b.Websocket.Wg.Add(1)
@@ -103,43 +152,46 @@ func (b *Binance) WsHandleData() {
 			var multiStreamData MultiStreamData
 			err = json.Unmarshal(read.Raw, &multiStreamData)
 			if err != nil {
-				b.Websocket.DataHandler <- fmt.Errorf("%v - Could not load multi stream data: %s",
-					b.Name,
-					read.Raw)
+				b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+					fmt.Errorf("could not load multi stream data: %s", read.Raw))
+				continue
+			}
+			if b.marginListenKey != "" && multiStreamData.Stream == b.marginListenKey {
+				b.wsHandleUserData(multiStreamData.Data)
 				continue
 			}
 			streamType := strings.Split(multiStreamData.Stream, "@")
+			if len(streamType) < 2 {
+				continue
+			}
 			switch streamType[1] {
 			case "trade":
 				trade := TradeStream{}
 				err := json.Unmarshal(multiStreamData.Data, &trade)
 				if err != nil {
-					b.Websocket.DataHandler <- fmt.Errorf("%v - Could not unmarshal trade data: %s",
-						b.Name,
-						err)
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+						fmt.Errorf("could not unmarshal trade data: %s", err))
 					continue
 				}
 
 				price, err := strconv.ParseFloat(trade.Price, 64)
 				if err != nil {
-					b.Websocket.DataHandler <- fmt.Errorf("%v - price conversion error: %s",
-						b.Name,
-						err)
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+						fmt.Errorf("price conversion error: %s", err))
 					continue
 				}
 
 				amount, err := strconv.ParseFloat(trade.Quantity, 64)
 				if err != nil {
-					b.Websocket.DataHandler <- fmt.Errorf("%v - amount conversion error: %s",
-						b.Name,
-						err)
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+						fmt.Errorf("amount conversion error: %s", err))
 					continue
 				}
 
 				b.Websocket.DataHandler <- wshandler.TradeData{
 					CurrencyPair: currency.NewPairFromFormattedPairs(trade.Symbol, b.GetEnabledPairs(asset.Spot),
 						b.GetPairFormat(asset.Spot, true)),
-					Timestamp: time.Unix(0, trade.TimeStamp*int64(time.Millisecond)),
+					Timestamp: convert.TimeFromUnix(trade.TimeStamp, time.Millisecond),
 					Price:     price,
 					Amount:    amount,
 					Exchange:  b.Name,
@@ -151,9 +203,8 @@ func (b *Binance) WsHandleData() {
 				t := TickerStream{}
 				err := json.Unmarshal(multiStreamData.Data, &t)
 				if err != nil {
-					b.Websocket.DataHandler <- fmt.Errorf("%v - Could not convert to a TickerStream structure %s",
-						b.Name,
-						err.Error())
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+						fmt.Errorf("could not convert to a TickerStream structure %s", err))
 					continue
 				}
 
@@ -168,7 +219,7 @@ func (b *Binance) WsHandleData() {
 					Bid:          t.BestBidPrice,
 					Ask:          t.BestAskPrice,
 					Last:         t.LastPrice,
-					LastUpdated:  time.Unix(0, t.EventTime*int64(time.Millisecond)),
+					LastUpdated:  convert.TimeFromUnix(t.EventTime, time.Millisecond),
 					AssetType:    asset.Spot,
 					Pair: currency.NewPairFromFormattedPairs(t.Symbol, b.GetEnabledPairs(asset.Spot),
 						b.GetPairFormat(asset.Spot, true)),
@@ -176,38 +227,40 @@ func (b *Binance) WsHandleData() {
 
 				continue
 			case "kline_1m":
-				kline := KlineStream{}
-				err := json.Unmarshal(multiStreamData.Data, &kline)
+				kl := KlineStream{}
+				err := json.Unmarshal(multiStreamData.Data, &kl)
 				if err != nil {
-					b.Websocket.DataHandler <- fmt.Errorf("%v - Could not convert to a KlineStream structure %s",
-						b.Name,
-						err)
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+						fmt.Errorf("could not convert to a KlineStream structure %s", err))
 					continue
 				}
 
 				var wsKline wshandler.KlineData
-				wsKline.Timestamp = time.Unix(0, kline.EventTime*int64(time.Millisecond))
-				wsKline.Pair = currency.NewPairFromFormattedPairs(kline.Symbol, b.GetEnabledPairs(asset.Spot),
+				wsKline.Timestamp = convert.TimeFromUnix(kl.EventTime, time.Millisecond)
+				wsKline.Pair = currency.NewPairFromFormattedPairs(kl.Symbol, b.GetEnabledPairs(asset.Spot),
 					b.GetPairFormat(asset.Spot, true))
 				wsKline.AssetType = asset.Spot
 				wsKline.Exchange = b.Name
-				wsKline.StartTime = time.Unix(0, kline.Kline.StartTime*int64(time.Millisecond))
-				wsKline.CloseTime = time.Unix(0, kline.Kline.CloseTime*int64(time.Millisecond))
-				wsKline.Interval = kline.Kline.Interval
-				wsKline.OpenPrice, _ = strconv.ParseFloat(kline.Kline.OpenPrice, 64)
-				wsKline.ClosePrice, _ = strconv.ParseFloat(kline.Kline.ClosePrice, 64)
-				wsKline.HighPrice, _ = strconv.ParseFloat(kline.Kline.HighPrice, 64)
-				wsKline.LowPrice, _ = strconv.ParseFloat(kline.Kline.LowPrice, 64)
-				wsKline.Volume, _ = strconv.ParseFloat(kline.Kline.Volume, 64)
+				wsKline.StartTime = convert.TimeFromUnix(kl.Kline.StartTime, time.Millisecond)
+				wsKline.CloseTime = convert.TimeFromUnix(kl.Kline.CloseTime, time.Millisecond)
+				wsKline.Interval, err = kline.ParseInterval(kl.Kline.Interval)
+				if err != nil {
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
+					continue
+				}
+				wsKline.OpenPrice, _ = strconv.ParseFloat(kl.Kline.OpenPrice, 64)
+				wsKline.ClosePrice, _ = strconv.ParseFloat(kl.Kline.ClosePrice, 64)
+				wsKline.HighPrice, _ = strconv.ParseFloat(kl.Kline.HighPrice, 64)
+				wsKline.LowPrice, _ = strconv.ParseFloat(kl.Kline.LowPrice, 64)
+				wsKline.Volume, _ = strconv.ParseFloat(kl.Kline.Volume, 64)
 				b.Websocket.DataHandler <- wsKline
 				continue
 			case "depth":
 				depth := WebsocketDepthStream{}
 				err := json.Unmarshal(multiStreamData.Data, &depth)
 				if err != nil {
-					b.Websocket.DataHandler <- fmt.Errorf("%v - Could not convert to depthStream structure %s",
-						b.Name,
-						err)
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+						fmt.Errorf("could not convert to depthStream structure %s", err))
 					continue
 				}
 
@@ -232,10 +285,90 @@ func (b *Binance) WsHandleData() {
 	}
 }
 
+// wsHandleUserData handles order and balance updates from the margin user
+// data stream
+func (b *Binance) wsHandleUserData(data json.RawMessage) {
+	var event UserDataStreamEvent
+	err := json.Unmarshal(data, &event)
+	if err != nil {
+		b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+			fmt.Errorf("could not unmarshal user data event: %s", err))
+		return
+	}
+	switch event.EventType {
+	case "executionReport":
+		var report WsExecutionReport
+		err = json.Unmarshal(data, &report)
+		if err != nil {
+			b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+				fmt.Errorf("could not unmarshal execution report: %s", err))
+			return
+		}
+		if report.ExecutionType == "TRADE" {
+			var fill order.ExecutionReport
+			fill, err = b.wsExecutionReport(&report)
+			if err != nil {
+				b.Websocket.DataHandler <- err
+			} else {
+				b.Websocket.DataHandler <- fill
+			}
+		}
+		b.Websocket.DataHandler <- report
+	case "outboundAccountPosition":
+		var position WsAccountPosition
+		err = json.Unmarshal(data, &position)
+		if err != nil {
+			b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+				fmt.Errorf("could not unmarshal account position: %s", err))
+			return
+		}
+		b.Websocket.DataHandler <- position
+	case "balanceUpdate":
+		var update WsBalanceUpdate
+		err = json.Unmarshal(data, &update)
+		if err != nil {
+			b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage,
+				fmt.Errorf("could not unmarshal balance update: %s", err))
+			return
+		}
+		b.Websocket.DataHandler <- update
+	case "listenKeyExpired":
+		b.Websocket.DataHandler <- fmt.Errorf("%v - margin user data stream listen key expired", b.Name)
+	}
+}
+
+// wsExecutionReport converts a margin order fill into a normalised execution
+// report
+func (b *Binance) wsExecutionReport(r *WsExecutionReport) (order.ExecutionReport, error) {
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return order.ExecutionReport{}, err
+	}
+	liquidity := order.Taker
+	if r.IsMaker {
+		liquidity = order.Maker
+	}
+	return order.ExecutionReport{
+		Exchange:  b.Name,
+		AssetType: asset.Margin,
+		Pair: currency.NewPairFromFormattedPairs(r.Symbol, b.GetEnabledPairs(asset.Spot),
+			b.GetPairFormat(asset.Spot, true)),
+		OrderID:     strconv.FormatInt(r.OrderID, 10),
+		TradeID:     strconv.FormatInt(r.TradeID, 10),
+		Side:        side,
+		Price:       decimal.NewFromFloat(r.LastExecutedPrice),
+		Amount:      decimal.NewFromFloat(r.LastExecutedQuantity),
+		Fee:         decimal.NewFromFloat(r.Commission),
+		FeeCurrency: currency.NewCode(r.CommissionAsset),
+		Liquidity:   liquidity,
+		Timestamp:   convert.TimeFromUnix(r.TransactionTime, time.Millisecond),
+	}, nil
+}
+
 // SeedLocalCache seeds depth data
-func (b *Binance) SeedLocalCache(p currency.Pair) error {
+func (b *Binance) SeedLocalCache(ctx context.Context, p currency.Pair) error {
 	var newOrderBook orderbook.Base
-	orderbookNew, err := b.GetOrderBook(
+	orderbookNew, err := b.GetOrderBook(ctx,
 		OrderBookDataRequestParams{
 			Symbol: b.FormatExchangeCurrency(p, asset.Spot).String(),
 			Limit:  1000,
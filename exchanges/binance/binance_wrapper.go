@@ -1,13 +1,17 @@
 package binance
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +41,7 @@ func (b *Binance) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -94,12 +98,15 @@ func (b *Binance) SetDefaults() {
 				UserTradeHistory:    true,
 				TradeFee:            true,
 				CryptoWithdrawalFee: true,
+				ImmediateOrCancel:   true,
+				FillOrKill:          true,
 			},
 			WebsocketCapabilities: protocol.Features{
-				TradeFetching:     true,
-				TickerFetching:    true,
-				KlineFetching:     true,
-				OrderbookFetching: true,
+				TradeFetching:          true,
+				TickerFetching:         true,
+				KlineFetching:          true,
+				OrderbookFetching:      true,
+				AuthenticatedEndpoints: true,
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCrypto |
 				exchange.NoFiatWithdrawals,
@@ -154,10 +161,13 @@ func (b *Binance) Setup(exch *config.ExchangeConfig) error {
 	b.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         b.Name,
 		URL:                  b.Websocket.GetWebsocketURL(),
+		AlternateURLs:        b.Websocket.GetAlternateURLs(),
 		ProxyURL:             b.Websocket.GetProxyAddress(),
 		Verbose:              b.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	b.Websocket.Orderbook.Setup(
@@ -190,6 +200,8 @@ func (b *Binance) Run() {
 		b.PrintEnabledPairs()
 	}
 
+	b.SyncServerTime(context.Background(), b.GetServerTime)
+
 	forceUpdate := false
 	delim := b.GetPairFormat(asset.Spot, false).Delimiter
 	if !common.StringDataContains(b.GetEnabledPairs(asset.Spot).Strings(), delim) ||
@@ -214,7 +226,7 @@ func (b *Binance) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(forceUpdate)
+	err := b.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -224,10 +236,10 @@ func (b *Binance) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Binance) FetchTradablePairs(asset asset.Item) ([]string, error) {
+func (b *Binance) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
 	var validCurrencyPairs []string
 
-	info, err := b.GetExchangeInfo()
+	info, err := b.GetExchangeInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -244,8 +256,8 @@ func (b *Binance) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+func (b *Binance) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -257,8 +269,8 @@ func (b *Binance) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Binance) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
-	tick, err := b.GetTickers()
+func (b *Binance) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	tick, err := b.GetTickers(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -291,27 +303,27 @@ func (b *Binance) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.P
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *Binance) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Binance) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (b *Binance) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Binance) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Binance) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Binance) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := b.GetOrderBook(OrderBookDataRequestParams{Symbol: b.FormatExchangeCurrency(p,
+	orderbookNew, err := b.GetOrderBook(ctx, OrderBookDataRequestParams{Symbol: b.FormatExchangeCurrency(p,
 		assetType).String(), Limit: 1000})
 	if err != nil {
 		return orderBook, err
@@ -347,9 +359,9 @@ func (b *Binance) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*order
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Bithumb exchange
-func (b *Binance) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Binance) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
-	raw, err := b.GetAccount()
+	raw, err := b.GetAccount(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -368,8 +380,8 @@ func (b *Binance) UpdateAccountInfo() (account.Holdings, error) {
 
 		currencyBalance = append(currencyBalance, account.Balance{
 			CurrencyName: currency.NewCode(raw.Balances[i].Asset),
-			TotalValue:   freeCurrency + lockedCurrency,
-			Hold:         freeCurrency,
+			TotalValue:   decimal.NewFromFloat(freeCurrency).Add(decimal.NewFromFloat(lockedCurrency)),
+			Hold:         decimal.NewFromFloat(freeCurrency),
 		})
 	}
 
@@ -387,10 +399,10 @@ func (b *Binance) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Binance) FetchAccountInfo() (account.Holdings, error) {
+func (b *Binance) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -398,17 +410,90 @@ func (b *Binance) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Binance) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Binance) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (b *Binance) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetMarginPositions returns the isolated margin positions held and the
+// margin backing them. Cross margin borrowings are shared by the whole account
+// and are returned by GetMarginAccount instead
+func (b *Binance) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	acc, err := b.GetIsolatedMarginAccount(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var resp []exchange.MarginPosition
+	for i := range acc.Assets {
+		if !acc.Assets[i].IsolatedCreated {
+			continue
+		}
+		m := b.marginPosition(&acc.Assets[i])
+		if m.Size == 0 && m.Margin == 0 {
+			continue
+		}
+		resp = append(resp, m)
+	}
+	return resp, nil
+}
+
+// marginPosition converts an isolated margin account into a margin position
+// valued in the quote currency. Binance liquidates an isolated account once
+// its assets fall to isolatedLiquidationMarginLevel times its liabilities, so
+// the maintenance margin is the equity remaining at that level
+func (b *Binance) marginPosition(p *IsolatedMarginPair) exchange.MarginPosition {
+	price := decimal.NewFromFloat(p.IndexPrice)
+	assets := decimal.NewFromFloat(p.BaseAsset.TotalAsset).Mul(price).
+		Add(decimal.NewFromFloat(p.QuoteAsset.TotalAsset))
+	liabilities := decimal.NewFromFloat(p.BaseAsset.Borrowed + p.BaseAsset.Interest).Mul(price).
+		Add(decimal.NewFromFloat(p.QuoteAsset.Borrowed + p.QuoteAsset.Interest))
+	return exchange.MarginPosition{
+		Exchange:  b.Name,
+		AssetType: asset.Margin,
+		Pair: currency.NewPairWithDelimiter(p.BaseAsset.Asset, p.QuoteAsset.Asset,
+			b.GetPairFormat(asset.Spot, false).Delimiter),
+		Size:              p.BaseAsset.NetAsset,
+		Currency:          currency.NewCode(p.QuoteAsset.Asset),
+		Margin:            assets.Sub(liabilities).Float64(),
+		MaintenanceMargin: liabilities.Mul(isolatedLiquidationMarginLevel.Sub(decimal.NewFromInt(1))).Float64(),
+		MarkPrice:         p.IndexPrice,
+		LiquidationPrice:  p.LiquidatePrice,
+		Isolated:          true,
+	}
+}
+
+// AddMargin transfers quote currency from the spot account to the isolated
+// margin account of the pair
+func (b *Binance) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	if assetType != asset.Margin {
+		return fmt.Errorf("%s margin can only be added to %s assets", b.Name, asset.Margin)
+	}
+	if amount.Sign() <= 0 {
+		return errors.New("margin amount must be greater than 0")
+	}
+	_, err := b.TransferIsolatedMargin(ctx, p.Quote.Upper().String(),
+		b.FormatExchangeCurrency(p, assetType).String(), amount.Float64(), SpotToMargin)
+	return err
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (b *Binance) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrNotYetImplemented
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Binance) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *Binance) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Binance) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *Binance) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -432,16 +517,43 @@ func (b *Binance) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		return submitOrderResponse, errors.New("unsupported order type")
 	}
 
+	var timeInForce RequestParamsTimeForceType
+	switch s.TimeInForce {
+	case "", order.GTC:
+		timeInForce = BinanceRequestParamsTimeGTC
+	case order.IOC:
+		timeInForce = BinanceRequestParamsTimeIOC
+	case order.FOK:
+		timeInForce = BinanceRequestParamsTimeFOK
+	default:
+		return submitOrderResponse, fmt.Errorf("%s does not support %s orders", b.Name, s.TimeInForce)
+	}
+
 	var orderRequest = NewOrderRequest{
 		Symbol:      s.Pair.Base.String() + s.Pair.Quote.String(),
 		Side:        sideType,
-		Price:       s.Price,
-		Quantity:    s.Amount,
+		Price:       s.Price.Float64(),
+		Quantity:    s.Amount.Float64(),
 		TradeType:   requestParamsOrderType,
-		TimeInForce: BinanceRequestParamsTimeGTC,
+		TimeInForce: timeInForce,
+	}
+
+	var response NewOrderResponse
+	var err error
+	switch s.AssetType {
+	case "", asset.Spot:
+		response, err = b.NewOrder(ctx, &orderRequest)
+	case asset.Margin:
+		// Margin orders trade the isolated margin account of the pair,
+		// repaying its borrowings first
+		response, err = b.NewMarginOrder(ctx, &MarginOrderRequest{
+			NewOrderRequest: orderRequest,
+			IsIsolated:      true,
+			SideEffectType:  AutoRepay,
+		})
+	default:
+		return submitOrderResponse, fmt.Errorf("%s does not support %s orders", b.Name, s.AssetType)
 	}
-
-	response, err := b.NewOrder(&orderRequest)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -458,18 +570,18 @@ func (b *Binance) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Binance) ModifyOrder(action *order.Modify) (string, error) {
+func (b *Binance) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Binance) CancelOrder(order *order.Cancel) error {
+func (b *Binance) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	_, err = b.CancelExistingOrder(b.FormatExchangeCurrency(order.CurrencyPair,
+	_, err = b.CancelExistingOrder(ctx, b.FormatExchangeCurrency(order.CurrencyPair,
 		order.AssetType).String(),
 		orderIDInt,
 		order.AccountID)
@@ -477,17 +589,17 @@ func (b *Binance) CancelOrder(order *order.Cancel) error {
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Binance) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (b *Binance) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	openOrders, err := b.OpenOrders("")
+	openOrders, err := b.OpenOrders(ctx, "")
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
 
 	for i := range openOrders {
-		_, err = b.CancelExistingOrder(openOrders[i].Symbol,
+		_, err = b.CancelExistingOrder(ctx, openOrders[i].Symbol,
 			openOrders[i].OrderID,
 			"")
 		if err != nil {
@@ -499,21 +611,22 @@ func (b *Binance) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, err
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Binance) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Binance) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Binance) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	return b.GetDepositAddressForCurrency(cryptocurrency.String())
+func (b *Binance) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	return b.GetDepositAddressForCurrency(ctx, cryptocurrency.String())
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Binance) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (b *Binance) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	amountStr := strconv.FormatFloat(withdrawRequest.Amount, 'f', -1, 64)
-	return b.WithdrawCrypto(withdrawRequest.Currency.String(),
+	return b.WithdrawCrypto(ctx, withdrawRequest.Currency.String(),
+		withdrawRequest.Chain,
 		withdrawRequest.Address,
 		withdrawRequest.AddressTag,
 		withdrawRequest.Description, amountStr)
@@ -521,13 +634,13 @@ func (b *Binance) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRe
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Binance) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Binance) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Binance) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Binance) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -537,23 +650,23 @@ func (b *Binance) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Binance) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Binance) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if (!b.AllowAuthenticatedRequest() || b.SkipAuthCheck) && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return b.GetFee(feeBuilder)
+	return b.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *Binance) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Binance) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		return nil, errors.New("at least one currency is required to fetch order history")
 	}
 
 	var orders []order.Detail
 	for x := range req.Currencies {
-		resp, err := b.OpenOrders(b.FormatExchangeCurrency(req.Currencies[x],
+		resp, err := b.OpenOrders(ctx, b.FormatExchangeCurrency(req.Currencies[x],
 			asset.Spot).String())
 		if err != nil {
 			return nil, err
@@ -562,16 +675,16 @@ func (b *Binance) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 		for i := range resp {
 			orderSide := order.Side(strings.ToUpper(resp[i].Side))
 			orderType := order.Type(strings.ToUpper(resp[i].Type))
-			orderDate := time.Unix(0, int64(resp[i].Time)*int64(time.Millisecond))
+			orderDate := convert.TimeFromUnix(int64(resp[i].Time), time.Millisecond)
 
 			orders = append(orders, order.Detail{
-				Amount:       resp[i].OrigQty,
+				Amount:       decimal.NewFromFloat(resp[i].OrigQty),
 				OrderDate:    orderDate,
 				Exchange:     b.Name,
 				ID:           strconv.FormatInt(resp[i].OrderID, 10),
 				OrderSide:    orderSide,
 				OrderType:    orderType,
-				Price:        resp[i].Price,
+				Price:        decimal.NewFromFloat(resp[i].Price),
 				Status:       order.Status(resp[i].Status),
 				CurrencyPair: currency.NewPairFromString(resp[i].Symbol),
 			})
@@ -586,14 +699,14 @@ func (b *Binance) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *Binance) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Binance) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		return nil, errors.New("at least one currency is required to fetch order history")
 	}
 
 	var orders []order.Detail
 	for x := range req.Currencies {
-		resp, err := b.AllOrders(b.FormatExchangeCurrency(req.Currencies[x],
+		resp, err := b.AllOrders(ctx, b.FormatExchangeCurrency(req.Currencies[x],
 			asset.Spot).String(),
 			"",
 			"1000")
@@ -604,20 +717,20 @@ func (b *Binance) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 		for i := range resp {
 			orderSide := order.Side(strings.ToUpper(resp[i].Side))
 			orderType := order.Type(strings.ToUpper(resp[i].Type))
-			orderDate := time.Unix(0, int64(resp[i].Time)*int64(time.Millisecond))
+			orderDate := convert.TimeFromUnix(int64(resp[i].Time), time.Millisecond)
 			// New orders are covered in GetOpenOrders
 			if resp[i].Status == "NEW" {
 				continue
 			}
 
 			orders = append(orders, order.Detail{
-				Amount:       resp[i].OrigQty,
+				Amount:       decimal.NewFromFloat(resp[i].OrigQty),
 				OrderDate:    orderDate,
 				Exchange:     b.Name,
 				ID:           strconv.FormatInt(resp[i].OrderID, 10),
 				OrderSide:    orderSide,
 				OrderType:    orderType,
-				Price:        resp[i].Price,
+				Price:        decimal.NewFromFloat(resp[i].Price),
 				CurrencyPair: currency.NewPairFromString(resp[i].Symbol),
 				Status:       order.Status(resp[i].Status),
 			})
@@ -654,7 +767,20 @@ func (b *Binance) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Binance) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Binance) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *Binance) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	acc, err := b.GetAccount(ctx)
+	if err != nil {
+		return exchange.APIKeyPermissions{}, err
+	}
+	return exchange.APIKeyPermissions{
+		Read:     true,
+		Trade:    acc.CanTrade,
+		Withdraw: acc.CanWithdraw,
+	}, nil
+}
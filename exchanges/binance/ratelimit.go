@@ -4,43 +4,81 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
-	"golang.org/x/time/rate"
 )
 
 const (
 	// Binance limit rates
-	// Global dictates the max rate limit for general request items which is
-	// 1200 requests per minute
-	binanceGlobalInterval    = time.Minute
-	binanceGlobalRequestRate = 1200
-	// Order related limits which are segregated from the global rate limits
-	// 10 requests per second and max 100000 requests per day.
+	// Every endpoint costs a weight drawn from a shared request weight of 1200
+	// per minute
+	binanceRequestWeightInterval = time.Minute
+	binanceRequestWeight         = 1200
+	// Order related limits which are counted separately from request weight
+	// 10 orders per second and max 100000 orders per day.
 	binanceOrderInterval         = time.Second
 	binanceOrderRequestRate      = 10
 	binanceOrderDailyInterval    = time.Hour * 24
 	binanceOrderDailyMaxRequests = 100000
+
+	requestWeightBucket = "requestWeight"
+	orderBucket         = "orders"
+	orderDailyBucket    = "ordersDaily"
 )
 
-// RateLimit implements the request.Limiter interface
-type RateLimit struct {
-	GlobalRate *rate.Limiter
-	Orders     *rate.Limiter
-}
+// Used to match endpoints to their rate limit weights, endpoints without a
+// weight cost a request weight of 1
+const (
+	newOrderRate request.EndpointLimit = iota + request.UnAuth + 1
+	orderbookDepth500Rate
+	orderbookDepth1000Rate
+	tickerAllRate
+	openOrdersAllRate
+	allOrdersRate
+	accountInfoRate
+	orderListRate
+	marginAccountRate
+)
 
-// Limit executes rate limiting functionality for Binance
-func (r *RateLimit) Limit(f request.EndpointLimit) error {
-	if f == request.Auth {
-		time.Sleep(r.Orders.Reserve().Delay())
-		return nil
-	}
-	time.Sleep(r.GlobalRate.Reserve().Delay())
-	return nil
+// SetRateLimit returns the rate limit for the exchange
+func SetRateLimit() *request.BucketLimiter {
+	l := request.NewBucketLimiter()
+	l.AddBucket(requestWeightBucket, request.NewTokenBucket(binanceRequestWeightInterval,
+		binanceRequestWeight,
+		binanceRequestWeight))
+	l.AddBucket(orderBucket, request.NewTokenBucket(binanceOrderInterval,
+		binanceOrderRequestRate,
+		binanceOrderRequestRate))
+	l.AddBucket(orderDailyBucket, request.NewTokenBucket(binanceOrderDailyInterval,
+		binanceOrderDailyMaxRequests,
+		binanceOrderDailyMaxRequests))
+
+	l.SetDefaultWeight(request.Weight{Bucket: requestWeightBucket, Tokens: 1})
+	l.SetWeight(newOrderRate,
+		request.Weight{Bucket: requestWeightBucket, Tokens: 1},
+		request.Weight{Bucket: orderBucket, Tokens: 1},
+		request.Weight{Bucket: orderDailyBucket, Tokens: 1})
+	// Each order list places two orders
+	l.SetWeight(orderListRate,
+		request.Weight{Bucket: requestWeightBucket, Tokens: 1},
+		request.Weight{Bucket: orderBucket, Tokens: 2},
+		request.Weight{Bucket: orderDailyBucket, Tokens: 2})
+	l.SetWeight(orderbookDepth500Rate, request.Weight{Bucket: requestWeightBucket, Tokens: 5})
+	l.SetWeight(orderbookDepth1000Rate, request.Weight{Bucket: requestWeightBucket, Tokens: 10})
+	l.SetWeight(tickerAllRate, request.Weight{Bucket: requestWeightBucket, Tokens: 40})
+	l.SetWeight(openOrdersAllRate, request.Weight{Bucket: requestWeightBucket, Tokens: 40})
+	l.SetWeight(allOrdersRate, request.Weight{Bucket: requestWeightBucket, Tokens: 5})
+	l.SetWeight(accountInfoRate, request.Weight{Bucket: requestWeightBucket, Tokens: 5})
+	l.SetWeight(marginAccountRate, request.Weight{Bucket: requestWeightBucket, Tokens: 10})
+	return l
 }
 
-// SetRateLimit returns the rate limit for the exchange
-func SetRateLimit() *RateLimit {
-	return &RateLimit{
-		GlobalRate: request.NewRateLimit(binanceGlobalInterval, binanceOrderDailyMaxRequests),
-		Orders:     request.NewRateLimit(binanceOrderInterval, binanceOrderRequestRate),
+// orderbookDepthRate returns the weight of an orderbook request for the limit
+func orderbookDepthRate(limit int) request.EndpointLimit {
+	switch {
+	case limit > 500:
+		return orderbookDepth1000Rate
+	case limit > 100:
+		return orderbookDepth500Rate
+	default:
+		return request.UnAuth
 	}
 }
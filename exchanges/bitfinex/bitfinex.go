@@ -1,6 +1,8 @@
 package bitfinex
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -70,6 +72,30 @@ const (
 	bitfinexKeyPermissions = "key_info"
 	bitfinexMarginInfo     = "margin_infos"
 	bitfinexDepositMethod  = "conf/pub:map:currency:label"
+	bitfinexPairList       = "conf/pub:list:pair:"
+	// Version 2 authenticated API endpoints
+	bitfinexPositionsV2          = "auth/r/positions"
+	bitfinexMarginInfoBase       = "auth/r/info/margin/base"
+	bitfinexDerivativeCollateral = "auth/w/deriv/collateral/set"
+	bitfinexTransferV2           = "auth/w/transfer"
+	bitfinexOrderSubmitV2        = "auth/w/order/submit"
+
+	// Pair lists of the pairs traded on each asset
+	pairListExchange = "exchange"
+	pairListMargin   = "margin"
+	pairListFutures  = "futures"
+
+	// Position types, derivatives positions hold their own collateral
+	marginPositionType     = 0
+	derivativePositionType = 1
+	positionActive         = "ACTIVE"
+
+	notificationSuccess = "SUCCESS"
+
+	// Funding offer directions, offers lasting between 2 and 30 days
+	fundingLend          = "lend"
+	fundingLoan          = "loan"
+	defaultFundingPeriod = 2
 
 	// Bitfinex platform status values
 	// When the platform is marked in maintenance mode bots should stop trading
@@ -87,14 +113,14 @@ type Bitfinex struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Bitfinex) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (b *Bitfinex) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetPlatformStatus returns the Bifinex platform status
-func (b *Bitfinex) GetPlatformStatus() (int, error) {
+func (b *Bitfinex) GetPlatformStatus(ctx context.Context) (int, error) {
 	var response []int
-	err := b.SendHTTPRequest(b.API.Endpoints.URL+
+	err := b.SendHTTPRequest(ctx, b.API.Endpoints.URL+
 		bitfinexAPIVersion2+
 		bitfinexPlatformStatus,
 		&response,
@@ -114,7 +140,7 @@ func (b *Bitfinex) GetPlatformStatus() (int, error) {
 }
 
 // GetTickerBatch returns all supported ticker information
-func (b *Bitfinex) GetTickerBatch() (map[string]Ticker, error) {
+func (b *Bitfinex) GetTickerBatch(ctx context.Context) (map[string]Ticker, error) {
 	var response [][]interface{}
 
 	path := b.API.Endpoints.URL +
@@ -122,7 +148,7 @@ func (b *Bitfinex) GetTickerBatch() (map[string]Ticker, error) {
 		bitfinexTickerBatch +
 		"?symbols=ALL"
 
-	err := b.SendHTTPRequest(path, &response, tickerBatch)
+	err := b.SendHTTPRequest(ctx, path, &response, tickerBatch)
 	if err != nil {
 		return nil, err
 	}
@@ -165,7 +191,7 @@ func (b *Bitfinex) GetTickerBatch() (map[string]Ticker, error) {
 }
 
 // GetTicker returns ticker information for one symbol
-func (b *Bitfinex) GetTicker(symbol string) (Ticker, error) {
+func (b *Bitfinex) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	var response []interface{}
 
 	path := b.API.Endpoints.URL +
@@ -173,7 +199,7 @@ func (b *Bitfinex) GetTicker(symbol string) (Ticker, error) {
 		bitfinexTicker +
 		symbol
 
-	err := b.SendHTTPRequest(path, &response, tickerFunction)
+	err := b.SendHTTPRequest(ctx, path, &response, tickerFunction)
 	if err != nil {
 		return Ticker{}, err
 	}
@@ -216,7 +242,7 @@ func (b *Bitfinex) GetTicker(symbol string) (Ticker, error) {
 // timestampStart is a millisecond timestamp
 // timestampEnd is a millisecond timestamp
 // reOrderResp reorders the returned data.
-func (b *Bitfinex) GetTrades(currencyPair string, limit, timestampStart, timestampEnd int64, reOrderResp bool) ([]Trade, error) {
+func (b *Bitfinex) GetTrades(ctx context.Context, currencyPair string, limit, timestampStart, timestampEnd int64, reOrderResp bool) ([]Trade, error) {
 	v := url.Values{}
 	if limit > 0 {
 		v.Set("limit", strconv.FormatInt(limit, 10))
@@ -243,7 +269,7 @@ func (b *Bitfinex) GetTrades(currencyPair string, limit, timestampStart, timesta
 		v.Encode()
 
 	var resp [][]interface{}
-	err := b.SendHTTPRequest(path, &resp, trade)
+	err := b.SendHTTPRequest(ctx, path, &resp, trade)
 	if err != nil {
 		return nil, err
 	}
@@ -287,7 +313,7 @@ func (b *Bitfinex) GetTrades(currencyPair string, limit, timestampStart, timesta
 // precision - P0,P1,P2,P3,R0
 // Values can contain limit amounts for both the asks and bids - Example
 // "len" = 100
-func (b *Bitfinex) GetOrderbook(symbol, precision string, limit int64) (Orderbook, error) {
+func (b *Bitfinex) GetOrderbook(ctx context.Context, symbol, precision string, limit int64) (Orderbook, error) {
 	var u = url.Values{}
 	if limit > 0 {
 		u.Set("len", strconv.FormatInt(limit, 10))
@@ -302,7 +328,7 @@ func (b *Bitfinex) GetOrderbook(symbol, precision string, limit int64) (Orderboo
 		u.Encode()
 
 	var response [][]interface{}
-	err := b.SendHTTPRequest(path, &response, orderbookFunction)
+	err := b.SendHTTPRequest(ctx, path, &response, orderbookFunction)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -371,10 +397,10 @@ func (b *Bitfinex) GetOrderbook(symbol, precision string, limit int64) (Orderboo
 }
 
 // GetStats returns various statistics about the requested pair
-func (b *Bitfinex) GetStats(symbol string) ([]Stat, error) {
+func (b *Bitfinex) GetStats(ctx context.Context, symbol string) ([]Stat, error) {
 	var response []Stat
 	path := b.API.Endpoints.URL + bitfinexAPIVersion + bitfinexStats + symbol
-	return response, b.SendHTTPRequest(path, &response, statsV1)
+	return response, b.SendHTTPRequest(ctx, path, &response, statsV1)
 }
 
 // GetFundingBook the entire margin funding book for both bids and asks sides
@@ -382,11 +408,11 @@ func (b *Bitfinex) GetStats(symbol string) ([]Stat, error) {
 // symbol - example "USD"
 // WARNING: Orderbook now has this support, will be deprecated once a full
 // conversion to full V2 API update is done.
-func (b *Bitfinex) GetFundingBook(symbol string) (FundingBook, error) {
+func (b *Bitfinex) GetFundingBook(ctx context.Context, symbol string) (FundingBook, error) {
 	response := FundingBook{}
 	path := b.API.Endpoints.URL + bitfinexAPIVersion + bitfinexLendbook + symbol
 
-	if err := b.SendHTTPRequest(path, &response, fundingbook); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &response, fundingbook); err != nil {
 		return response, err
 	}
 
@@ -397,21 +423,21 @@ func (b *Bitfinex) GetFundingBook(symbol string) (FundingBook, error) {
 // currency: total amount provided and Flash Return Rate (in % by 365 days)
 // over time
 // Symbol - example "USD"
-func (b *Bitfinex) GetLends(symbol string, values url.Values) ([]Lends, error) {
+func (b *Bitfinex) GetLends(ctx context.Context, symbol string, values url.Values) ([]Lends, error) {
 	var response []Lends
 	path := common.EncodeURLValues(b.API.Endpoints.URL+
 		bitfinexAPIVersion+
 		bitfinexLends+
 		symbol,
 		values)
-	return response, b.SendHTTPRequest(path, &response, lends)
+	return response, b.SendHTTPRequest(ctx, path, &response, lends)
 }
 
 // GetCandles returns candle chart data
 // timeFrame values: '1m', '5m', '15m', '30m', '1h', '3h', '6h', '12h', '1D',
 // '7D', '14D', '1M'
 // section values: last or hist
-func (b *Bitfinex) GetCandles(symbol, timeFrame string, start, end, limit int64, historic, ascending bool) ([]Candle, error) {
+func (b *Bitfinex) GetCandles(ctx context.Context, symbol, timeFrame string, start, end, limit int64, historic, ascending bool) ([]Candle, error) {
 	var fundingPeriod string
 	if symbol[0] == 'f' {
 		fundingPeriod = ":p30"
@@ -446,7 +472,7 @@ func (b *Bitfinex) GetCandles(symbol, timeFrame string, start, end, limit int64,
 		}
 
 		var response [][]interface{}
-		err := b.SendHTTPRequest(path, &response, candle)
+		err := b.SendHTTPRequest(ctx, path, &response, candle)
 		if err != nil {
 			return nil, err
 		}
@@ -469,7 +495,7 @@ func (b *Bitfinex) GetCandles(symbol, timeFrame string, start, end, limit int64,
 	path += "/last"
 
 	var response []interface{}
-	err := b.SendHTTPRequest(path, &response, candle)
+	err := b.SendHTTPRequest(ctx, path, &response, candle)
 	if err != nil {
 		return nil, err
 	}
@@ -508,7 +534,8 @@ func (b *Bitfinex) GetLiquidationFeed() error {
 
 // GetLeaderBoard returns leaderboard standings for unrealized
 // profit (period delta), unrealized profit (inception), volume, and realized
-//  profit.
+//
+//	profit.
 func (b *Bitfinex) GetLeaderBoard() error {
 	return common.ErrNotYetImplemented
 }
@@ -525,9 +552,9 @@ func (b *Bitfinex) GetForeignExchangeRate() error {
 }
 
 // GetAccountFees returns information about your account trading fees
-func (b *Bitfinex) GetAccountFees() ([]AccountInfo, error) {
+func (b *Bitfinex) GetAccountFees(ctx context.Context) ([]AccountInfo, error) {
 	var responses []AccountInfo
-	return responses, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return responses, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexAccountInfo,
 		nil,
 		&responses,
@@ -535,9 +562,9 @@ func (b *Bitfinex) GetAccountFees() ([]AccountInfo, error) {
 }
 
 // GetWithdrawalFees - Gets all fee rates for withdrawals
-func (b *Bitfinex) GetWithdrawalFees() (AccountFees, error) {
+func (b *Bitfinex) GetWithdrawalFees(ctx context.Context) (AccountFees, error) {
 	response := AccountFees{}
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexAccountFees,
 		nil,
 		&response,
@@ -546,10 +573,10 @@ func (b *Bitfinex) GetWithdrawalFees() (AccountFees, error) {
 
 // GetAccountSummary returns a 30-day summary of your trading volume and return
 // on margin funding
-func (b *Bitfinex) GetAccountSummary() (AccountSummary, error) {
+func (b *Bitfinex) GetAccountSummary(ctx context.Context) (AccountSummary, error) {
 	response := AccountSummary{}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexAccountSummary,
 		nil,
 		&response,
@@ -561,7 +588,7 @@ func (b *Bitfinex) GetAccountSummary() (AccountSummary, error) {
 // “tethers", "ethereumc", "zcash", "monero", "iota", "bcash"
 // WalletName - accepted: “trading”, “exchange”, “deposit”
 // renew - Default is 0. If set to 1, will return a new unused deposit address
-func (b *Bitfinex) NewDeposit(method, walletName string, renew int) (DepositResponse, error) {
+func (b *Bitfinex) NewDeposit(ctx context.Context, method, walletName string, renew int) (DepositResponse, error) {
 	if !common.StringDataCompare(AcceptedWalletNames, walletName) {
 		return DepositResponse{},
 			fmt.Errorf("walletname: [%s] is not allowed, supported: %s",
@@ -575,7 +602,7 @@ func (b *Bitfinex) NewDeposit(method, walletName string, renew int) (DepositResp
 	req["wallet_name"] = walletName
 	req["renew"] = renew
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexDeposit,
 		req,
 		&response,
@@ -584,9 +611,9 @@ func (b *Bitfinex) NewDeposit(method, walletName string, renew int) (DepositResp
 
 // GetKeyPermissions checks the permissions of the key being used to generate
 // this request.
-func (b *Bitfinex) GetKeyPermissions() (KeyPermissions, error) {
+func (b *Bitfinex) GetKeyPermissions(ctx context.Context) (KeyPermissions, error) {
 	response := KeyPermissions{}
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexKeyPermissions,
 		nil,
 		&response,
@@ -594,9 +621,9 @@ func (b *Bitfinex) GetKeyPermissions() (KeyPermissions, error) {
 }
 
 // GetMarginInfo shows your trading wallet information for margin trading
-func (b *Bitfinex) GetMarginInfo() ([]MarginInfo, error) {
+func (b *Bitfinex) GetMarginInfo(ctx context.Context) ([]MarginInfo, error) {
 	var response []MarginInfo
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginInfo,
 		nil,
 		&response,
@@ -604,9 +631,9 @@ func (b *Bitfinex) GetMarginInfo() ([]MarginInfo, error) {
 }
 
 // GetAccountBalance returns full wallet balance information
-func (b *Bitfinex) GetAccountBalance() ([]Balance, error) {
+func (b *Bitfinex) GetAccountBalance(ctx context.Context) ([]Balance, error) {
 	var response []Balance
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexBalances,
 		nil,
 		&response,
@@ -618,7 +645,7 @@ func (b *Bitfinex) GetAccountBalance() ([]Balance, error) {
 // Currency -  example "BTC"
 // WalletFrom - example "exchange"
 // WalletTo -  example "deposit"
-func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo string) (WalletTransfer, error) {
+func (b *Bitfinex) WalletTransfer(ctx context.Context, amount float64, currency, walletFrom, walletTo string) (WalletTransfer, error) {
 	var response []WalletTransfer
 	req := make(map[string]interface{})
 	req["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
@@ -626,7 +653,7 @@ func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo
 	req["walletfrom"] = walletFrom
 	req["walletto"] = walletTo
 
-	err := b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexTransfer,
 		req,
 		&response,
@@ -643,7 +670,7 @@ func (b *Bitfinex) WalletTransfer(amount float64, currency, walletFrom, walletTo
 
 // WithdrawCryptocurrency requests a withdrawal from one of your wallets.
 // For FIAT, use WithdrawFIAT
-func (b *Bitfinex) WithdrawCryptocurrency(wallet, address, paymentID string, amount float64, c currency.Code) (Withdrawal, error) {
+func (b *Bitfinex) WithdrawCryptocurrency(ctx context.Context, wallet, address, paymentID string, amount float64, c currency.Code) (Withdrawal, error) {
 	var response []Withdrawal
 	req := make(map[string]interface{})
 	req["withdraw_type"] = b.ConvertSymbolToWithdrawalType(c)
@@ -654,7 +681,7 @@ func (b *Bitfinex) WithdrawCryptocurrency(wallet, address, paymentID string, amo
 		req["payment_id"] = paymentID
 	}
 
-	err := b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexWithdrawal,
 		req,
 		&response,
@@ -671,7 +698,7 @@ func (b *Bitfinex) WithdrawCryptocurrency(wallet, address, paymentID string, amo
 }
 
 // WithdrawFIAT Sends an authenticated request to withdraw FIAT currency
-func (b *Bitfinex) WithdrawFIAT(withdrawalType, walletType string, withdrawRequest *withdraw.FiatRequest) (Withdrawal, error) {
+func (b *Bitfinex) WithdrawFIAT(ctx context.Context, withdrawalType, walletType string, withdrawRequest *withdraw.FiatRequest) (Withdrawal, error) {
 	var response []Withdrawal
 	req := make(map[string]interface{})
 
@@ -699,7 +726,7 @@ func (b *Bitfinex) WithdrawFIAT(withdrawalType, walletType string, withdrawReque
 		req["intermediary_bank_swift"] = withdrawRequest.IntermediarySwiftCode
 	}
 
-	err := b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	err := b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexWithdrawal,
 		req,
 		&response,
@@ -717,7 +744,7 @@ func (b *Bitfinex) WithdrawFIAT(withdrawalType, walletType string, withdrawReque
 
 // NewOrder submits a new order and returns a order information
 // Major Upgrade needed on this function to include all query params
-func (b *Bitfinex) NewOrder(currencyPair, orderType string, amount, price float64, buy, hidden bool) (Order, error) {
+func (b *Bitfinex) NewOrder(ctx context.Context, currencyPair, orderType string, amount, price float64, buy, hidden bool) (Order, error) {
 	if !common.StringDataCompare(AcceptedOrderType, orderType) {
 		return Order{}, errors.New("order type not accepted")
 	}
@@ -734,7 +761,7 @@ func (b *Bitfinex) NewOrder(currencyPair, orderType string, amount, price float6
 		req["side"] = order.Buy.Lower()
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderNew,
 		req,
 		&response,
@@ -742,12 +769,12 @@ func (b *Bitfinex) NewOrder(currencyPair, orderType string, amount, price float6
 }
 
 // NewOrderMulti allows several new orders at once
-func (b *Bitfinex) NewOrderMulti(orders []PlaceOrder) (OrderMultiResponse, error) {
+func (b *Bitfinex) NewOrderMulti(ctx context.Context, orders []PlaceOrder) (OrderMultiResponse, error) {
 	response := OrderMultiResponse{}
 	req := make(map[string]interface{})
 	req["orders"] = orders
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderNewMulti,
 		req,
 		&response,
@@ -755,12 +782,12 @@ func (b *Bitfinex) NewOrderMulti(orders []PlaceOrder) (OrderMultiResponse, error
 }
 
 // CancelExistingOrder cancels a single order by OrderID
-func (b *Bitfinex) CancelExistingOrder(orderID int64) (Order, error) {
+func (b *Bitfinex) CancelExistingOrder(ctx context.Context, orderID int64) (Order, error) {
 	response := Order{}
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderCancel,
 		req,
 		&response,
@@ -768,12 +795,12 @@ func (b *Bitfinex) CancelExistingOrder(orderID int64) (Order, error) {
 }
 
 // CancelMultipleOrders cancels multiple orders
-func (b *Bitfinex) CancelMultipleOrders(orderIDs []int64) (string, error) {
+func (b *Bitfinex) CancelMultipleOrders(ctx context.Context, orderIDs []int64) (string, error) {
 	response := GenericResponse{}
 	req := make(map[string]interface{})
 	req["order_ids"] = orderIDs
 
-	return response.Result, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response.Result, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderCancelMulti,
 		req,
 		nil,
@@ -781,10 +808,10 @@ func (b *Bitfinex) CancelMultipleOrders(orderIDs []int64) (string, error) {
 }
 
 // CancelAllExistingOrders cancels all active and open orders
-func (b *Bitfinex) CancelAllExistingOrders() (string, error) {
+func (b *Bitfinex) CancelAllExistingOrders(ctx context.Context) (string, error) {
 	response := GenericResponse{}
 
-	return response.Result, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response.Result, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderCancelAll,
 		nil,
 		nil,
@@ -792,7 +819,7 @@ func (b *Bitfinex) CancelAllExistingOrders() (string, error) {
 }
 
 // ReplaceOrder replaces an older order with a new order
-func (b *Bitfinex) ReplaceOrder(orderID int64, symbol string, amount, price float64, buy bool, orderType string, hidden bool) (Order, error) {
+func (b *Bitfinex) ReplaceOrder(ctx context.Context, orderID int64, symbol string, amount, price float64, buy bool, orderType string, hidden bool) (Order, error) {
 	response := Order{}
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
@@ -809,7 +836,7 @@ func (b *Bitfinex) ReplaceOrder(orderID int64, symbol string, amount, price floa
 		req["side"] = order.Sell.Lower()
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderCancelReplace,
 		req,
 		&response,
@@ -817,12 +844,12 @@ func (b *Bitfinex) ReplaceOrder(orderID int64, symbol string, amount, price floa
 }
 
 // GetOrderStatus returns order status information
-func (b *Bitfinex) GetOrderStatus(orderID int64) (Order, error) {
+func (b *Bitfinex) GetOrderStatus(ctx context.Context, orderID int64) (Order, error) {
 	orderStatus := Order{}
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
 
-	return orderStatus, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return orderStatus, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderStatus,
 		req,
 		&orderStatus,
@@ -830,12 +857,12 @@ func (b *Bitfinex) GetOrderStatus(orderID int64) (Order, error) {
 }
 
 // GetInactiveOrders returns order status information
-func (b *Bitfinex) GetInactiveOrders() ([]Order, error) {
+func (b *Bitfinex) GetInactiveOrders(ctx context.Context) ([]Order, error) {
 	var response []Order
 	req := make(map[string]interface{})
 	req["limit"] = "100"
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexInactiveOrders,
 		req,
 		&response,
@@ -843,9 +870,9 @@ func (b *Bitfinex) GetInactiveOrders() ([]Order, error) {
 }
 
 // GetOpenOrders returns all active orders and statuses
-func (b *Bitfinex) GetOpenOrders() ([]Order, error) {
+func (b *Bitfinex) GetOpenOrders(ctx context.Context) ([]Order, error) {
 	var response []Order
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrders,
 		nil,
 		&response,
@@ -853,10 +880,10 @@ func (b *Bitfinex) GetOpenOrders() ([]Order, error) {
 }
 
 // GetActivePositions returns an array of active positions
-func (b *Bitfinex) GetActivePositions() ([]Position, error) {
+func (b *Bitfinex) GetActivePositions(ctx context.Context) ([]Position, error) {
 	var response []Position
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexPositions,
 		nil,
 		&response,
@@ -864,12 +891,12 @@ func (b *Bitfinex) GetActivePositions() ([]Position, error) {
 }
 
 // ClaimPosition allows positions to be claimed
-func (b *Bitfinex) ClaimPosition(positionID int) (Position, error) {
+func (b *Bitfinex) ClaimPosition(ctx context.Context, positionID int) (Position, error) {
 	response := Position{}
 	req := make(map[string]interface{})
 	req["position_id"] = positionID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexClaimPosition,
 		nil,
 		nil,
@@ -877,7 +904,7 @@ func (b *Bitfinex) ClaimPosition(positionID int) (Position, error) {
 }
 
 // GetBalanceHistory returns balance history for the account
-func (b *Bitfinex) GetBalanceHistory(symbol string, timeSince, timeUntil time.Time, limit int, wallet string) ([]BalanceHistory, error) {
+func (b *Bitfinex) GetBalanceHistory(ctx context.Context, symbol string, timeSince, timeUntil time.Time, limit int, wallet string) ([]BalanceHistory, error) {
 	var response []BalanceHistory
 	req := make(map[string]interface{})
 	req["currency"] = symbol
@@ -895,7 +922,7 @@ func (b *Bitfinex) GetBalanceHistory(symbol string, timeSince, timeUntil time.Ti
 		req["wallet"] = wallet
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexHistory,
 		req,
 		&response,
@@ -903,7 +930,7 @@ func (b *Bitfinex) GetBalanceHistory(symbol string, timeSince, timeUntil time.Ti
 }
 
 // GetMovementHistory returns an array of past deposits and withdrawals
-func (b *Bitfinex) GetMovementHistory(symbol, method string, timeSince, timeUntil time.Time, limit int) ([]MovementHistory, error) {
+func (b *Bitfinex) GetMovementHistory(ctx context.Context, symbol, method string, timeSince, timeUntil time.Time, limit int) ([]MovementHistory, error) {
 	var response []MovementHistory
 	req := make(map[string]interface{})
 	req["currency"] = symbol
@@ -921,7 +948,7 @@ func (b *Bitfinex) GetMovementHistory(symbol, method string, timeSince, timeUnti
 		req["limit"] = limit
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexHistoryMovements,
 		req,
 		&response,
@@ -929,7 +956,7 @@ func (b *Bitfinex) GetMovementHistory(symbol, method string, timeSince, timeUnti
 }
 
 // GetTradeHistory returns past executed trades
-func (b *Bitfinex) GetTradeHistory(currencyPair string, timestamp, until time.Time, limit, reverse int) ([]TradeHistory, error) {
+func (b *Bitfinex) GetTradeHistory(ctx context.Context, currencyPair string, timestamp, until time.Time, limit, reverse int) ([]TradeHistory, error) {
 	var response []TradeHistory
 	req := make(map[string]interface{})
 	req["currency"] = currencyPair
@@ -945,7 +972,7 @@ func (b *Bitfinex) GetTradeHistory(currencyPair string, timestamp, until time.Ti
 		req["reverse"] = reverse
 	}
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexTradeHistory,
 		req,
 		&response,
@@ -953,7 +980,7 @@ func (b *Bitfinex) GetTradeHistory(currencyPair string, timestamp, until time.Ti
 }
 
 // NewOffer submits a new offer
-func (b *Bitfinex) NewOffer(symbol string, amount, rate float64, period int64, direction string) (Offer, error) {
+func (b *Bitfinex) NewOffer(ctx context.Context, symbol string, amount, rate float64, period int64, direction string) (Offer, error) {
 	response := Offer{}
 	req := make(map[string]interface{})
 	req["currency"] = symbol
@@ -962,7 +989,7 @@ func (b *Bitfinex) NewOffer(symbol string, amount, rate float64, period int64, d
 	req["period"] = period
 	req["direction"] = direction
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOfferNew,
 		req,
 		&response,
@@ -970,12 +997,12 @@ func (b *Bitfinex) NewOffer(symbol string, amount, rate float64, period int64, d
 }
 
 // CancelOffer cancels offer by offerID
-func (b *Bitfinex) CancelOffer(offerID int64) (Offer, error) {
+func (b *Bitfinex) CancelOffer(ctx context.Context, offerID int64) (Offer, error) {
 	response := Offer{}
 	req := make(map[string]interface{})
 	req["offer_id"] = offerID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOfferCancel,
 		req,
 		&response,
@@ -984,12 +1011,12 @@ func (b *Bitfinex) CancelOffer(offerID int64) (Offer, error) {
 
 // GetOfferStatus checks offer status whether it has been cancelled, execute or
 // is still active
-func (b *Bitfinex) GetOfferStatus(offerID int64) (Offer, error) {
+func (b *Bitfinex) GetOfferStatus(ctx context.Context, offerID int64) (Offer, error) {
 	response := Offer{}
 	req := make(map[string]interface{})
 	req["offer_id"] = offerID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOrderStatus,
 		req,
 		&response,
@@ -997,10 +1024,10 @@ func (b *Bitfinex) GetOfferStatus(offerID int64) (Offer, error) {
 }
 
 // GetActiveCredits returns all available credits
-func (b *Bitfinex) GetActiveCredits() ([]Offer, error) {
+func (b *Bitfinex) GetActiveCredits(ctx context.Context) ([]Offer, error) {
 	var response []Offer
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexActiveCredits,
 		nil,
 		&response,
@@ -1008,10 +1035,10 @@ func (b *Bitfinex) GetActiveCredits() ([]Offer, error) {
 }
 
 // GetActiveOffers returns all current active offers
-func (b *Bitfinex) GetActiveOffers() ([]Offer, error) {
+func (b *Bitfinex) GetActiveOffers(ctx context.Context) ([]Offer, error) {
 	var response []Offer
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexOffers,
 		nil,
 		&response,
@@ -1019,10 +1046,10 @@ func (b *Bitfinex) GetActiveOffers() ([]Offer, error) {
 }
 
 // GetActiveMarginFunding returns an array of active margin funds
-func (b *Bitfinex) GetActiveMarginFunding() ([]MarginFunds, error) {
+func (b *Bitfinex) GetActiveMarginFunding(ctx context.Context) ([]MarginFunds, error) {
 	var response []MarginFunds
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginActiveFunds,
 		nil,
 		&response,
@@ -1031,10 +1058,10 @@ func (b *Bitfinex) GetActiveMarginFunding() ([]MarginFunds, error) {
 
 // GetUnusedMarginFunds returns an array of funding borrowed but not currently
 // used
-func (b *Bitfinex) GetUnusedMarginFunds() ([]MarginFunds, error) {
+func (b *Bitfinex) GetUnusedMarginFunds(ctx context.Context) ([]MarginFunds, error) {
 	var response []MarginFunds
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginUnusedFunds,
 		nil,
 		&response,
@@ -1043,10 +1070,10 @@ func (b *Bitfinex) GetUnusedMarginFunds() ([]MarginFunds, error) {
 
 // GetMarginTotalTakenFunds returns an array of active funding used in a
 // position
-func (b *Bitfinex) GetMarginTotalTakenFunds() ([]MarginTotalTakenFunds, error) {
+func (b *Bitfinex) GetMarginTotalTakenFunds(ctx context.Context) ([]MarginTotalTakenFunds, error) {
 	var response []MarginTotalTakenFunds
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginTotalFunds,
 		nil,
 		&response,
@@ -1054,21 +1081,204 @@ func (b *Bitfinex) GetMarginTotalTakenFunds() ([]MarginTotalTakenFunds, error) {
 }
 
 // CloseMarginFunding closes an unused or used taken fund
-func (b *Bitfinex) CloseMarginFunding(swapID int64) (Offer, error) {
+func (b *Bitfinex) CloseMarginFunding(ctx context.Context, swapID int64) (Offer, error) {
 	response := Offer{}
 	req := make(map[string]interface{})
 	req["swap_id"] = swapID
 
-	return response, b.SendAuthenticatedHTTPRequest(http.MethodPost,
+	return response, b.SendAuthenticatedHTTPRequest(ctx, http.MethodPost,
 		bitfinexMarginClose,
 		req,
 		&response,
 		closeFunding)
 }
 
+// GetPairs returns the pairs of a pair list, exchange pairs being traded
+// spot, margin pairs on margin and futures pairs being the perpetual
+// derivatives
+func (b *Bitfinex) GetPairs(ctx context.Context, list string) ([]string, error) {
+	var response [][]string
+	err := b.SendHTTPRequest(ctx, b.API.Endpoints.URL+
+		bitfinexAPIVersion2+
+		bitfinexPairList+
+		list,
+		&response,
+		configs)
+	if err != nil {
+		return nil, err
+	}
+	if len(response) == 0 {
+		return nil, fmt.Errorf("pair list %s not returned", list)
+	}
+	return response[0], nil
+}
+
+// GetPositions returns the active margin and derivatives positions
+func (b *Bitfinex) GetPositions(ctx context.Context) ([]ActivePosition, error) {
+	var response [][]interface{}
+	err := b.SendAuthenticatedHTTPRequestV2(ctx, bitfinexPositionsV2,
+		nil,
+		&response,
+		getActivePositions)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]ActivePosition, len(response))
+	for i := range response {
+		positions[i] = parseActivePosition(response[i])
+	}
+	return positions, nil
+}
+
+// parseActivePosition converts a v2 position array, fields not applicable to
+// the position type are null and left empty
+func parseActivePosition(p []interface{}) ActivePosition {
+	return ActivePosition{
+		Symbol:            arrayString(p, 0),
+		Status:            arrayString(p, 1),
+		Amount:            arrayFloat(p, 2),
+		BasePrice:         arrayFloat(p, 3),
+		MarginFunding:     arrayFloat(p, 4),
+		MarginFundingType: int64(arrayFloat(p, 5)),
+		PL:                arrayFloat(p, 6),
+		PLPercent:         arrayFloat(p, 7),
+		LiquidationPrice:  arrayFloat(p, 8),
+		Leverage:          arrayFloat(p, 9),
+		ID:                int64(arrayFloat(p, 11)),
+		Created:           time.Unix(0, int64(arrayFloat(p, 12))*int64(time.Millisecond)),
+		Updated:           time.Unix(0, int64(arrayFloat(p, 13))*int64(time.Millisecond)),
+		Type:              int64(arrayFloat(p, 15)),
+		Price:             arrayFloat(p, 17),
+		Collateral:        arrayFloat(p, 18),
+		CollateralMin:     arrayFloat(p, 19),
+	}
+}
+
+// GetBaseMarginInfo returns the margin balance shared by the margin
+// positions, in USD
+func (b *Bitfinex) GetBaseMarginInfo(ctx context.Context) (BaseMarginInfo, error) {
+	var response []interface{}
+	err := b.SendAuthenticatedHTTPRequestV2(ctx, bitfinexMarginInfoBase,
+		nil,
+		&response,
+		getAccountMarginInfo)
+	if err != nil {
+		return BaseMarginInfo{}, err
+	}
+	if len(response) < 2 {
+		return BaseMarginInfo{}, errors.New("base margin info not returned")
+	}
+	info, ok := response[1].([]interface{})
+	if !ok {
+		return BaseMarginInfo{}, errors.New("unexpected base margin info")
+	}
+	return BaseMarginInfo{
+		UserPL:        arrayFloat(info, 0),
+		UserSwaps:     arrayFloat(info, 1),
+		MarginBalance: arrayFloat(info, 2),
+		MarginNet:     arrayFloat(info, 3),
+		MarginMin:     arrayFloat(info, 4),
+	}, nil
+}
+
+// SetDerivativeCollateral sets the collateral held by a derivatives position
+// symbol - example "tBTCF0:USTF0"
+func (b *Bitfinex) SetDerivativeCollateral(ctx context.Context, symbol string, collateral float64) error {
+	req := make(map[string]interface{})
+	req["symbol"] = symbol
+	req["collateral"] = collateral
+	var response interface{}
+	return b.SendAuthenticatedHTTPRequestV2(ctx, bitfinexDerivativeCollateral,
+		req,
+		&response,
+		updateCollateralOnPosition)
+}
+
+// TransferBetweenWallets moves an amount between wallets, funds are
+// transferred to the derivatives wallet by transferring to the margin wallet
+// with the derivatives currency as currencyTo, e.g. "USTF0"
+func (b *Bitfinex) TransferBetweenWallets(ctx context.Context, from, to, currency, currencyTo string, amount float64) error {
+	req := make(map[string]interface{})
+	req["from"] = from
+	req["to"] = to
+	req["currency"] = currency
+	if currencyTo != "" {
+		req["currency_to"] = currencyTo
+	}
+	req["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
+	var response []interface{}
+	err := b.SendAuthenticatedHTTPRequestV2(ctx, bitfinexTransferV2,
+		req,
+		&response,
+		transferBetweenWallets)
+	if err != nil {
+		return err
+	}
+	return notificationError(response)
+}
+
+// NewOrderV2 submits an order through the v2 API, which is required for
+// derivatives, and returns its order ID
+func (b *Bitfinex) NewOrderV2(ctx context.Context, o *OrderRequestV2) (int64, error) {
+	var response []interface{}
+	err := b.SendAuthenticatedHTTPRequestV2(ctx, bitfinexOrderSubmitV2,
+		map[string]interface{}{
+			"type":   o.Type,
+			"symbol": o.Symbol,
+			"amount": strconv.FormatFloat(o.Amount, 'f', -1, 64),
+			"price":  strconv.FormatFloat(o.Price, 'f', -1, 64),
+		},
+		&response,
+		submitOrder)
+	if err != nil {
+		return 0, err
+	}
+	err = notificationError(response)
+	if err != nil {
+		return 0, err
+	}
+	if len(response) > 4 {
+		if orders, ok := response[4].([]interface{}); ok && len(orders) > 0 {
+			if details, ok := orders[0].([]interface{}); ok {
+				return int64(arrayFloat(details, 0)), nil
+			}
+		}
+	}
+	return 0, errors.New("order ID not returned")
+}
+
+// notificationError returns the error of an unsuccessful v2 notification,
+// notifications holding their status and text at indexes 6 and 7
+func notificationError(n []interface{}) error {
+	if arrayString(n, 6) == notificationSuccess {
+		return nil
+	}
+	return fmt.Errorf("%s %s", arrayString(n, 6), arrayString(n, 7))
+}
+
+// arrayFloat returns the number at an index of a v2 array response, zero
+// when missing or null
+func arrayFloat(a []interface{}, i int) float64 {
+	if i >= len(a) {
+		return 0
+	}
+	f, _ := a[i].(float64)
+	return f
+}
+
+// arrayString returns the string at an index of a v2 array response, empty
+// when missing or null
+func arrayString(a []interface{}, i int) string {
+	if i >= len(a) {
+		return ""
+	}
+	s, _ := a[i].(string)
+	return s
+}
+
 // SendHTTPRequest sends an unauthenticated request
-func (b *Bitfinex) SendHTTPRequest(path string, result interface{}, e request.EndpointLimit) error {
-	return b.SendPayload(&request.Item{
+func (b *Bitfinex) SendHTTPRequest(ctx context.Context, path string, result interface{}, e request.EndpointLimit) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -1080,7 +1290,7 @@ func (b *Bitfinex) SendHTTPRequest(path string, result interface{}, e request.En
 
 // SendAuthenticatedHTTPRequest sends an autheticated http request and json
 // unmarshals result to a supplied variable
-func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[string]interface{}, result interface{}, endpoint request.EndpointLimit) error {
+func (b *Bitfinex) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params map[string]interface{}, result interface{}, endpoint request.EndpointLimit) error {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			b.Name)
@@ -1113,7 +1323,7 @@ func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[
 	headers["X-BFX-PAYLOAD"] = PayloadBase64
 	headers["X-BFX-SIGNATURE"] = crypto.HexEncodeToString(hmac)
 
-	return b.SendPayload(&request.Item{
+	return b.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          b.API.Endpoints.URL + bitfinexAPIVersion + path,
 		Headers:       headers,
@@ -1126,13 +1336,53 @@ func (b *Bitfinex) SendAuthenticatedHTTPRequest(method, path string, params map[
 		Endpoint:      endpoint})
 }
 
+// SendAuthenticatedHTTPRequestV2 sends an authenticated v2 API request with
+// a JSON body and json unmarshals result to a supplied variable
+func (b *Bitfinex) SendAuthenticatedHTTPRequestV2(ctx context.Context, path string, params map[string]interface{}, result interface{}, endpoint request.EndpointLimit) error {
+	if !b.AllowAuthenticatedRequest() {
+		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
+			b.Name)
+	}
+
+	if params == nil {
+		params = make(map[string]interface{})
+	}
+	payload, err := json.Marshal(params)
+	if err != nil {
+		return errors.New("sendAuthenticatedAPIRequest: unable to JSON request")
+	}
+
+	n := b.Requester.GetNonce(true).String()
+	hmac := crypto.GetHMAC(crypto.HashSHA512_384,
+		[]byte("/api"+bitfinexAPIVersion2+path+n+string(payload)),
+		[]byte(b.API.Credentials.Secret))
+	headers := make(map[string]string)
+	headers["Content-Type"] = "application/json"
+	headers["bfx-nonce"] = n
+	headers["bfx-apikey"] = b.API.Credentials.Key
+	headers["bfx-signature"] = crypto.HexEncodeToString(hmac)
+
+	return b.SendPayload(ctx, &request.Item{
+		Method:        http.MethodPost,
+		Path:          b.API.Endpoints.URL + bitfinexAPIVersion2 + path,
+		Headers:       headers,
+		Body:          bytes.NewBuffer(payload),
+		Result:        result,
+		AuthRequest:   true,
+		NonceEnabled:  true,
+		Verbose:       b.Verbose,
+		HTTPDebugging: b.HTTPDebugging,
+		HTTPRecording: b.HTTPRecording,
+		Endpoint:      endpoint})
+}
+
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bitfinex) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bitfinex) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		accountInfos, err := b.GetAccountFees()
+		accountInfos, err := b.GetAccountFees(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -1148,7 +1398,7 @@ func (b *Bitfinex) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 		//TODO: fee is charged when < $1000USD is transferred, need to infer value in some way
 		fee = 0
 	case exchange.CryptocurrencyWithdrawalFee:
-		acc, err := b.GetWithdrawalFees()
+		acc, err := b.GetWithdrawalFees(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -1265,8 +1515,8 @@ func (b *Bitfinex) ConvertSymbolToWithdrawalType(c currency.Code) string {
 }
 
 // ConvertSymbolToDepositMethod returns a converted currency deposit method
-func (b *Bitfinex) ConvertSymbolToDepositMethod(c currency.Code) (string, error) {
-	if err := b.PopulateAcceptableMethods(); err != nil {
+func (b *Bitfinex) ConvertSymbolToDepositMethod(ctx context.Context, c currency.Code) (string, error) {
+	if err := b.PopulateAcceptableMethods(ctx); err != nil {
 		return "", err
 	}
 	method, ok := AcceptableMethods[c.String()]
@@ -1280,10 +1530,10 @@ func (b *Bitfinex) ConvertSymbolToDepositMethod(c currency.Code) (string, error)
 
 // PopulateAcceptableMethods retrieves all accepted currency strings and
 // populates a map to check
-func (b *Bitfinex) PopulateAcceptableMethods() error {
+func (b *Bitfinex) PopulateAcceptableMethods(ctx context.Context) error {
 	if len(AcceptableMethods) == 0 {
 		var response [][][2]string
-		err := b.SendHTTPRequest(b.API.Endpoints.URL+
+		err := b.SendHTTPRequest(ctx, b.API.Endpoints.URL+
 			bitfinexAPIVersion2+
 			bitfinexDepositMethod,
 			&response,
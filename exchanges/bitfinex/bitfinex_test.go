@@ -1,6 +1,7 @@
 package bitfinex
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
@@ -8,11 +9,13 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -60,7 +63,7 @@ func TestMain(m *testing.M) {
 
 func TestGetPlatformStatus(t *testing.T) {
 	t.Parallel()
-	result, err := b.GetPlatformStatus()
+	result, err := b.GetPlatformStatus(context.Background())
 	if err != nil {
 		t.Errorf("TestGetPlatformStatus error: %s", err)
 	}
@@ -72,7 +75,7 @@ func TestGetPlatformStatus(t *testing.T) {
 
 func TestGetTickerBatch(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTickerBatch()
+	_, err := b.GetTickerBatch(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -80,12 +83,12 @@ func TestGetTickerBatch(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker("tBTCUSD")
+	_, err := b.GetTicker(context.Background(), "tBTCUSD")
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = b.GetTicker("fUSD")
+	_, err = b.GetTicker(context.Background(), "fUSD")
 	if err != nil {
 		t.Error(err)
 	}
@@ -94,7 +97,7 @@ func TestGetTicker(t *testing.T) {
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTrades("tBTCUSD", 5, 0, 0, false)
+	_, err := b.GetTrades(context.Background(), "tBTCUSD", 5, 0, 0, false)
 	if err != nil {
 		t.Error(err)
 	}
@@ -102,22 +105,22 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderbook("tBTCUSD", "R0", 1)
+	_, err := b.GetOrderbook(context.Background(), "tBTCUSD", "R0", 1)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = b.GetOrderbook("fUSD", "R0", 1)
+	_, err = b.GetOrderbook(context.Background(), "fUSD", "R0", 1)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = b.GetOrderbook("tBTCUSD", "P0", 1)
+	_, err = b.GetOrderbook(context.Background(), "tBTCUSD", "P0", 1)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = b.GetOrderbook("fUSD", "P0", 1)
+	_, err = b.GetOrderbook(context.Background(), "fUSD", "P0", 1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -125,7 +128,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetStats(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetStats("btcusd")
+	_, err := b.GetStats(context.Background(), "btcusd")
 	if err != nil {
 		t.Error(err)
 	}
@@ -133,7 +136,7 @@ func TestGetStats(t *testing.T) {
 
 func TestGetFundingBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetFundingBook("usd")
+	_, err := b.GetFundingBook(context.Background(), "usd")
 	if err != nil {
 		t.Error(err)
 	}
@@ -141,7 +144,7 @@ func TestGetFundingBook(t *testing.T) {
 
 func TestGetLends(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLends("usd", nil)
+	_, err := b.GetLends(context.Background(), "usd", nil)
 	if err != nil {
 		t.Error(err)
 	}
@@ -149,7 +152,7 @@ func TestGetLends(t *testing.T) {
 
 func TestGetCandles(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetCandles("fUSD", "1m", 0, 0, 10, true, false)
+	_, err := b.GetCandles(context.Background(), "fUSD", "1m", 0, 0, 10, true, false)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -161,7 +164,7 @@ func TestGetAccountFees(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.UpdateAccountInfo()
+	_, err := b.UpdateAccountInfo(context.Background())
 	if err != nil {
 		t.Error("GetAccountInfo error", err)
 	}
@@ -173,7 +176,7 @@ func TestGetWithdrawalFee(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetWithdrawalFees()
+	_, err := b.GetWithdrawalFees(context.Background())
 	if err != nil {
 		t.Error("GetAccountInfo error", err)
 	}
@@ -185,7 +188,7 @@ func TestGetAccountSummary(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetAccountSummary()
+	_, err := b.GetAccountSummary(context.Background())
 	if err == nil {
 		t.Error("GetAccountSummary() Expected error")
 	}
@@ -197,17 +200,17 @@ func TestNewDeposit(t *testing.T) {
 	}
 	t.Parallel()
 	b.Verbose = true
-	_, err := b.NewDeposit("blabla", "testwallet", 0)
+	_, err := b.NewDeposit(context.Background(), "blabla", "testwallet", 0)
 	if err == nil {
 		t.Error("NewDeposit() Expected error")
 	}
 
-	_, err = b.NewDeposit("bitcoin", "testwallet", 0)
+	_, err = b.NewDeposit(context.Background(), "bitcoin", "testwallet", 0)
 	if err == nil {
 		t.Error("NewDeposit() Expected error")
 	}
 
-	_, err = b.NewDeposit("bitcoin", "exchange", 0)
+	_, err = b.NewDeposit(context.Background(), "bitcoin", "exchange", 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -219,7 +222,19 @@ func TestGetKeyPermissions(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetKeyPermissions()
+	_, err := b.GetKeyPermissions(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetAPIKeyPermissions(t *testing.T) {
+	if !b.ValidateAPICredentials() {
+		t.SkipNow()
+	}
+	t.Parallel()
+
+	_, err := b.GetAPIKeyPermissions(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -231,7 +246,7 @@ func TestGetMarginInfo(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMarginInfo()
+	_, err := b.GetMarginInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -243,7 +258,7 @@ func TestGetAccountBalance(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -255,7 +270,7 @@ func TestGetAccountInfo(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.FetchAccountInfo()
+	_, err := b.FetchAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -267,7 +282,7 @@ func TestWalletTransfer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.WalletTransfer(0.01, "btc", "bla", "bla")
+	_, err := b.WalletTransfer(context.Background(), 0.01, "btc", "bla", "bla")
 	if err == nil {
 		t.Error("error cannot be nil")
 	}
@@ -279,7 +294,7 @@ func TestWithdrawCryptocurrency(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.WithdrawCryptocurrency("bad",
+	_, err := b.WithdrawCryptocurrency(context.Background(), "bad",
 		"rEb8TK3gBgk5auZkwc6sHnwrGVJH8DuaLh",
 		"102257461",
 		1,
@@ -313,7 +328,7 @@ func TestWithdrawFiat(t *testing.T) {
 		IsExpressWire:            false,
 	}
 
-	_, err := b.WithdrawFIAT("wire", "exchange", &withdrawFiatRequest)
+	_, err := b.WithdrawFIAT(context.Background(), "wire", "exchange", &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -328,7 +343,7 @@ func TestNewOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.NewOrder("BTCUSD",
+	_, err := b.NewOrder(context.Background(), "BTCUSD",
 		order.Limit.Lower(),
 		1,
 		2,
@@ -340,7 +355,7 @@ func TestNewOrder(t *testing.T) {
 }
 
 func TestUpdateTicker(t *testing.T) {
-	_, err := b.UpdateTicker(currency.NewPairFromString("BTCUSD"), asset.Spot)
+	_, err := b.UpdateTicker(context.Background(), currency.NewPairFromString("BTCUSD"), asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -378,7 +393,7 @@ func TestNewOrderMulti(t *testing.T) {
 		},
 	}
 
-	_, err := b.NewOrderMulti(newOrder)
+	_, err := b.NewOrderMulti(context.Background(), newOrder)
 	if err == nil {
 		t.Error("NewOrderMulti() Expected error")
 	}
@@ -390,7 +405,7 @@ func TestCancelOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelExistingOrder(1337)
+	_, err := b.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("CancelExistingOrder() Expected error")
 	}
@@ -402,7 +417,7 @@ func TestCancelMultipleOrders(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelMultipleOrders([]int64{1337, 1336})
+	_, err := b.CancelMultipleOrders(context.Background(), []int64{1337, 1336})
 	if err == nil {
 		t.Error("CancelMultipleOrders() Expected error")
 	}
@@ -414,7 +429,7 @@ func TestCancelAllOrders(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelAllExistingOrders()
+	_, err := b.CancelAllExistingOrders(context.Background())
 	if err == nil {
 		t.Error("CancelAllExistingOrders() Expected error")
 	}
@@ -426,7 +441,7 @@ func TestReplaceOrder(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.ReplaceOrder(1337, "BTCUSD",
+	_, err := b.ReplaceOrder(context.Background(), 1337, "BTCUSD",
 		1, 1, true, order.Limit.Lower(), false)
 	if err == nil {
 		t.Error("ReplaceOrder() Expected error")
@@ -439,7 +454,7 @@ func TestGetOrderStatus(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetOrderStatus(1337)
+	_, err := b.GetOrderStatus(context.Background(), 1337)
 	if err == nil {
 		t.Error("GetOrderStatus() Expected error")
 	}
@@ -451,7 +466,7 @@ func TestGetOpenOrders(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetOpenOrders()
+	_, err := b.GetOpenOrders(context.Background())
 	if err == nil {
 		t.Error("GetOpenOrders() Expectederror")
 	}
@@ -463,7 +478,7 @@ func TestGetActivePositions(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActivePositions()
+	_, err := b.GetActivePositions(context.Background())
 	if err == nil {
 		t.Error("GetActivePositions() Expected error")
 	}
@@ -475,7 +490,7 @@ func TestClaimPosition(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.ClaimPosition(1337)
+	_, err := b.ClaimPosition(context.Background(), 1337)
 	if err == nil {
 		t.Error("ClaimPosition() Expected error")
 	}
@@ -487,7 +502,7 @@ func TestGetBalanceHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetBalanceHistory("USD", time.Time{}, time.Time{}, 1, "deposit")
+	_, err := b.GetBalanceHistory(context.Background(), "USD", time.Time{}, time.Time{}, 1, "deposit")
 	if err == nil {
 		t.Error("GetBalanceHistory() Expected error")
 	}
@@ -499,7 +514,7 @@ func TestGetMovementHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMovementHistory("USD", "bitcoin", time.Time{}, time.Time{}, 1)
+	_, err := b.GetMovementHistory(context.Background(), "USD", "bitcoin", time.Time{}, time.Time{}, 1)
 	if err == nil {
 		t.Error("GetMovementHistory() Expected error")
 	}
@@ -511,7 +526,7 @@ func TestGetTradeHistory(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetTradeHistory("BTCUSD", time.Time{}, time.Time{}, 1, 0)
+	_, err := b.GetTradeHistory(context.Background(), "BTCUSD", time.Time{}, time.Time{}, 1, 0)
 	if err == nil {
 		t.Error("GetTradeHistory() Expected error")
 	}
@@ -523,7 +538,7 @@ func TestNewOffer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.NewOffer("BTC", 1, 1, 1, "loan")
+	_, err := b.NewOffer(context.Background(), "BTC", 1, 1, 1, "loan")
 	if err == nil {
 		t.Error("NewOffer() Expected error")
 	}
@@ -535,7 +550,7 @@ func TestCancelOffer(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CancelOffer(1337)
+	_, err := b.CancelOffer(context.Background(), 1337)
 	if err == nil {
 		t.Error("CancelOffer() Expected error")
 	}
@@ -547,7 +562,7 @@ func TestGetOfferStatus(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetOfferStatus(1337)
+	_, err := b.GetOfferStatus(context.Background(), 1337)
 	if err == nil {
 		t.Error("NewOffer() Expected error")
 	}
@@ -559,7 +574,7 @@ func TestGetActiveCredits(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveCredits()
+	_, err := b.GetActiveCredits(context.Background())
 	if err == nil {
 		t.Error("GetActiveCredits() Expected error")
 	}
@@ -571,7 +586,7 @@ func TestGetActiveOffers(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveOffers()
+	_, err := b.GetActiveOffers(context.Background())
 	if err == nil {
 		t.Error("GetActiveOffers() Expected error")
 	}
@@ -583,7 +598,7 @@ func TestGetActiveMarginFunding(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetActiveMarginFunding()
+	_, err := b.GetActiveMarginFunding(context.Background())
 	if err == nil {
 		t.Error("GetActiveMarginFunding() Expected error")
 	}
@@ -595,7 +610,7 @@ func TestGetUnusedMarginFunds(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetUnusedMarginFunds()
+	_, err := b.GetUnusedMarginFunds(context.Background())
 	if err == nil {
 		t.Error("GetUnusedMarginFunds() Expected error")
 	}
@@ -607,7 +622,7 @@ func TestGetMarginTotalTakenFunds(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.GetMarginTotalTakenFunds()
+	_, err := b.GetMarginTotalTakenFunds(context.Background())
 	if err == nil {
 		t.Error("GetMarginTotalTakenFunds() Expected error")
 	}
@@ -619,7 +634,7 @@ func TestCloseMarginFunding(t *testing.T) {
 	}
 	t.Parallel()
 
-	_, err := b.CloseMarginFunding(1337)
+	_, err := b.CloseMarginFunding(context.Background(), 1337)
 	if err == nil {
 		t.Error("CloseMarginFunding() Expected error")
 	}
@@ -637,7 +652,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -655,7 +670,7 @@ func TestGetFee(t *testing.T) {
 
 	if areTestAPIKeysSet() {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.002), resp)
 		}
@@ -664,7 +679,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(2000) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(2000) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(2000), resp)
 			t.Error(err)
 		}
@@ -672,7 +687,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
 			t.Error(err)
 		}
@@ -680,7 +695,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -688,7 +703,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyWithdrawalFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := b.GetFee(feeBuilder); resp != float64(0.0004) || err != nil {
+		if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0004) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0004), resp)
 			t.Error(err)
 		}
@@ -697,7 +712,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -706,7 +721,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -715,7 +730,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -736,7 +751,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -750,7 +765,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -777,11 +792,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not cancel orders: %v", err)
@@ -808,7 +823,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -831,7 +846,7 @@ func TestCancelAllExchangeOrdera(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -850,7 +865,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -871,7 +886,7 @@ func TestWithdraw(t *testing.T) {
 		Address: core.BitcoinDonationAddress,
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -910,7 +925,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 		IntermediarySwiftCode:         "Taylor",
 	}
 
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -922,12 +937,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(currency.BTC, "deposit")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "deposit")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(currency.BTC, "deposit")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "deposit")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -1101,7 +1116,7 @@ func TestWsCancelOffer(t *testing.T) {
 }
 
 func TestConvertSymbolToDepositMethod(t *testing.T) {
-	s, err := b.ConvertSymbolToDepositMethod(currency.BTC)
+	s, err := b.ConvertSymbolToDepositMethod(context.Background(), currency.BTC)
 	if err != nil {
 		log.Fatal(err)
 	}
@@ -1109,15 +1124,157 @@ func TestConvertSymbolToDepositMethod(t *testing.T) {
 		t.Errorf("expected bitcoin but received %s", s)
 	}
 
-	_, err = b.ConvertSymbolToDepositMethod(currency.NewCode("CATS!"))
+	_, err = b.ConvertSymbolToDepositMethod(context.Background(), currency.NewCode("CATS!"))
 	if err == nil {
 		log.Fatal("error cannot be nil")
 	}
 }
 
 func TestUpdateTradablePairs(t *testing.T) {
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		t.Error(err)
 	}
 }
+
+func TestParseCandleKey(t *testing.T) {
+	interval, p, err := parseCandleKey("trade:1D:tBTCUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if interval != kline.OneDay {
+		t.Errorf("expected %s received %s", kline.OneDay, interval)
+	}
+	if p.String() != "BTCUSD" {
+		t.Errorf("expected BTCUSD received %s", p)
+	}
+
+	if _, _, err = parseCandleKey("tBTCUSD"); err == nil {
+		t.Error("expected error on invalid key")
+	}
+}
+
+func TestWsExecutionReport(t *testing.T) {
+	e := b.wsExecutionReport([]interface{}{
+		float64(1), "tBTCUSD", float64(1574963975602), float64(2),
+		float64(-0.5), float64(7500), "EXCHANGE LIMIT", float64(7500),
+		float64(1), float64(-0.75), "USD",
+	})
+	if err := e.Validate(); err != nil {
+		t.Fatal(err)
+	}
+	if e.Side != order.Sell || !e.Amount.Equal(decimal.NewFromFloat(0.5)) {
+		t.Errorf("expected sell of 0.5 received %v %v", e.Side, e.Amount)
+	}
+	if !e.Fee.Equal(decimal.NewFromFloat(0.75)) || e.FeeCurrency != currency.USD {
+		t.Errorf("expected fee of 0.75 USD received %v %v", e.Fee, e.FeeCurrency)
+	}
+	if e.Liquidity != order.Maker || e.Pair.String() != "BTCUSD" || e.OrderID != "2" {
+		t.Errorf("unexpected execution report %+v", e)
+	}
+}
+
+func TestGetPairs(t *testing.T) {
+	t.Parallel()
+	pairs, err := b.GetPairs(context.Background(), pairListFutures)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pairs) == 0 {
+		t.Error("expected derivatives pairs")
+	}
+}
+
+func TestGetMarginPositions(t *testing.T) {
+	t.Parallel()
+	if !b.ValidateAPICredentials() {
+		t.SkipNow()
+	}
+	_, err := b.GetMarginPositions(context.Background())
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestAddMargin(t *testing.T) {
+	t.Parallel()
+	p := currency.NewPairWithDelimiter("BTCF0", "USTF0", ":")
+	if err := b.AddMargin(context.Background(), p, asset.PerpetualContract, decimal.Zero); err == nil {
+		t.Error("expected error adding no margin")
+	}
+	if err := b.AddMargin(context.Background(), p, asset.Spot, decimal.NewFromInt(1)); err == nil {
+		t.Error("expected error adding margin to spot")
+	}
+}
+
+func TestParseActivePosition(t *testing.T) {
+	p := parseActivePosition([]interface{}{
+		"tBTCF0:USTF0", "ACTIVE", float64(-0.5), float64(9000), float64(0),
+		float64(0), float64(-25), float64(-0.5), float64(12000), float64(10),
+		nil, float64(142), float64(1574963975602), float64(1574963975602),
+		nil, float64(1), nil, float64(9050), float64(500), float64(225),
+	})
+	if p.Symbol != "tBTCF0:USTF0" || p.Status != positionActive || p.Type != derivativePositionType {
+		t.Errorf("unexpected position %+v", p)
+	}
+	if p.Amount != -0.5 || p.ID != 142 || p.Price != 9050 ||
+		p.Collateral != 500 || p.CollateralMin != 225 || p.LiquidationPrice != 12000 {
+		t.Errorf("unexpected position values %+v", p)
+	}
+}
+
+func TestMarginPosition(t *testing.T) {
+	m := b.marginPosition(&ActivePosition{
+		Symbol:        "tBTCF0:USTF0",
+		Amount:        -0.5,
+		PL:            -25,
+		Type:          derivativePositionType,
+		Price:         9050,
+		Collateral:    500,
+		CollateralMin: 225,
+	}, nil)
+	if m.AssetType != asset.PerpetualContract || !m.Isolated || m.Currency.String() != "UST" {
+		t.Errorf("unexpected derivatives position %+v", m)
+	}
+	if m.Margin != 475 || m.MaintenanceMargin != 225 || m.Pair.Base.String() != "BTCF0" {
+		t.Errorf("unexpected derivatives margin %+v", m)
+	}
+
+	m = b.marginPosition(&ActivePosition{
+		Symbol: "tBTCUSD",
+		Amount: 1,
+		Type:   marginPositionType,
+	}, &BaseMarginInfo{MarginNet: 1000, MarginMin: 150})
+	if m.AssetType != asset.Margin || m.Isolated || m.Currency != currency.USD {
+		t.Errorf("unexpected margin position %+v", m)
+	}
+	if m.Margin != 1000 || m.MaintenanceMargin != 150 || m.Pair.String() != "BTCUSD" {
+		t.Errorf("unexpected margin %+v", m)
+	}
+}
+
+func TestNotificationError(t *testing.T) {
+	n := []interface{}{float64(1), "deriv-collateral-set", nil, nil, nil, nil, "SUCCESS", ""}
+	if err := notificationError(n); err != nil {
+		t.Error(err)
+	}
+	n[6], n[7] = "ERROR", "insufficient balance"
+	if err := notificationError(n); err == nil {
+		t.Error("expected error on failed notification")
+	}
+}
+
+func TestOrderTypes(t *testing.T) {
+	if s := b.tradingSymbol(currency.NewPairFromStrings("BTCF0", "USTF0"), asset.PerpetualContract); s != "tBTCF0:USTF0" {
+		t.Errorf("expected tBTCF0:USTF0 received %s", s)
+	}
+	if s := b.tradingSymbol(currency.NewPairFromStrings("BTC", "USD"), asset.Spot); s != "tBTCUSD" {
+		t.Errorf("expected tBTCUSD received %s", s)
+	}
+	if o := orderTypeV1(asset.Spot, order.Limit); o != "exchange limit" {
+		t.Errorf("expected exchange limit received %s", o)
+	}
+	if o := orderTypeV2(asset.Margin, order.Market); o != "MARKET" {
+		t.Errorf("expected MARKET received %s", o)
+	}
+}
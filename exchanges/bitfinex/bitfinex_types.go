@@ -1,5 +1,7 @@
 package bitfinex
 
+import "time"
+
 // AcceptedOrderType defines the accepted market types, exchange strings denote
 // non-contract order types.
 var AcceptedOrderType = []string{"market", "limit", "stop", "trailing-stop",
@@ -279,6 +281,46 @@ type Position struct {
 	PL        float64 `json:"pl,string"`
 }
 
+// ActivePosition holds a v2 API margin or derivatives position
+type ActivePosition struct {
+	Symbol            string
+	Status            string
+	Amount            float64
+	BasePrice         float64
+	MarginFunding     float64
+	MarginFundingType int64
+	PL                float64
+	PLPercent         float64
+	LiquidationPrice  float64
+	Leverage          float64
+	ID                int64
+	Created           time.Time
+	Updated           time.Time
+	Type              int64
+	// Price is the mark price of the position
+	Price float64
+	// Collateral and CollateralMin are only set for derivatives positions
+	Collateral    float64
+	CollateralMin float64
+}
+
+// BaseMarginInfo holds the margin balance backing margin positions, in USD
+type BaseMarginInfo struct {
+	UserPL        float64
+	UserSwaps     float64
+	MarginBalance float64
+	MarginNet     float64
+	MarginMin     float64
+}
+
+// OrderRequestV2 holds a v2 API order, the amount is negative when selling
+type OrderRequestV2 struct {
+	Type   string
+	Symbol string
+	Amount float64
+	Price  float64
+}
+
 // BalanceHistory holds balance history information
 type BalanceHistory struct {
 	Currency    string  `json:"currency"`
@@ -452,21 +494,6 @@ type WebsocketTradeExecuted struct {
 	PriceExecuted  float64
 }
 
-// WebsocketTradeData holds executed trade data
-type WebsocketTradeData struct {
-	TradeID        int64
-	Pair           string
-	Timestamp      int64
-	OrderID        int64
-	AmountExecuted float64
-	PriceExecuted  float64
-	OrderType      string
-	OrderPrice     float64
-	Maker          bool
-	Fee            float64
-	FeeCurrency    string
-}
-
 // ErrorCapture is a simple type for returned errors from Bitfinex
 type ErrorCapture struct {
 	Message string `json:"message"`
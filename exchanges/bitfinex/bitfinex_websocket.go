@@ -11,10 +11,13 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -91,7 +94,7 @@ func (b *Bitfinex) WsDataHandler() {
 				var result interface{}
 				err := json.Unmarshal(stream.Raw, &result)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					return
 				}
 				switch reflect.TypeOf(result).String() {
@@ -117,8 +120,8 @@ func (b *Bitfinex) WsDataHandler() {
 							b.Websocket.DataHandler <- eventData
 							b.WsAddSubscriptionChannel(0, "account", "N/A")
 						} else if status == "fail" {
-							b.Websocket.DataHandler <- fmt.Errorf("bitfinex.go error - Websocket unable to AUTH. Error code: %s",
-								eventData["code"].(string))
+							b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorAuthFailed,
+								fmt.Errorf("unable to AUTH. Error code: %s", eventData["code"].(string)))
 						}
 					}
 				case "[]interface {}":
@@ -174,7 +177,11 @@ func (b *Bitfinex) WsDataHandler() {
 						}
 						continue
 					case wsCandles:
-						curr := currency.NewPairFromString(chanInfo.Pair)
+						interval, curr, err := parseCandleKey(chanInfo.Pair)
+						if err != nil {
+							b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
+							continue
+						}
 						if candleBundle, ok := chanData[1].([]interface{}); ok {
 							if len(candleBundle) == 0 {
 								continue
@@ -184,10 +191,11 @@ func (b *Bitfinex) WsDataHandler() {
 								for i := range candleBundle {
 									candle := candleBundle[i].([]interface{})
 									b.Websocket.DataHandler <- wshandler.KlineData{
-										Timestamp:  time.Unix(0, candle[0].(int64)),
+										Timestamp:  convert.TimeFromUnixFloat(candle[0].(float64), time.Millisecond),
 										Exchange:   b.Name,
 										AssetType:  asset.Spot,
 										Pair:       curr,
+										Interval:   interval,
 										OpenPrice:  candle[1].(float64),
 										ClosePrice: candle[2].(float64),
 										HighPrice:  candle[3].(float64),
@@ -197,10 +205,11 @@ func (b *Bitfinex) WsDataHandler() {
 								}
 							case float64:
 								b.Websocket.DataHandler <- wshandler.KlineData{
-									Timestamp:  time.Unix(0, candleBundle[0].(int64)),
+									Timestamp:  convert.TimeFromUnixFloat(candleBundle[0].(float64), time.Millisecond),
 									Exchange:   b.Name,
 									AssetType:  asset.Spot,
 									Pair:       curr,
+									Interval:   interval,
 									OpenPrice:  candleBundle[1].(float64),
 									ClosePrice: candleBundle[2].(float64),
 									HighPrice:  candleBundle[3].(float64),
@@ -277,7 +286,7 @@ func (b *Bitfinex) WsDataHandler() {
 							if trades[i].Rate > 0 {
 								b.Websocket.DataHandler <- wshandler.FundingData{
 									CurrencyPair: currency.NewPairFromString(chanInfo.Pair),
-									Timestamp:    time.Unix(0, trades[i].Timestamp*int64(time.Millisecond)),
+									Timestamp:    convert.TimeFromUnix(trades[i].Timestamp, time.Millisecond),
 									Amount:       newAmount,
 									Exchange:     b.Name,
 									AssetType:    asset.Spot,
@@ -290,7 +299,7 @@ func (b *Bitfinex) WsDataHandler() {
 
 							b.Websocket.DataHandler <- wshandler.TradeData{
 								CurrencyPair: currency.NewPairFromString(chanInfo.Pair),
-								Timestamp:    time.Unix(0, trades[i].Timestamp*int64(time.Millisecond)),
+								Timestamp:    convert.TimeFromUnix(trades[i].Timestamp, time.Millisecond),
 								Price:        trades[i].Price,
 								Amount:       newAmount,
 								Exchange:     b.Name,
@@ -369,20 +378,8 @@ func (b *Bitfinex) WsDataHandler() {
 								b.Websocket.DataHandler <- position
 							}
 						case wsTradeExecutionUpdate:
-							if tradeData, ok := chanData[2].([]interface{}); ok && len(tradeData) > 4 {
-								b.Websocket.DataHandler <- WebsocketTradeData{
-									TradeID:        int64(tradeData[0].(float64)),
-									Pair:           tradeData[1].(string),
-									Timestamp:      int64(tradeData[2].(float64)),
-									OrderID:        int64(tradeData[3].(float64)),
-									AmountExecuted: tradeData[4].(float64),
-									PriceExecuted:  tradeData[5].(float64),
-									OrderType:      tradeData[6].(string),
-									OrderPrice:     tradeData[7].(float64),
-									Maker:          tradeData[8].(float64) == 1,
-									Fee:            tradeData[9].(float64),
-									FeeCurrency:    tradeData[10].(string),
-								}
+							if tradeData, ok := chanData[2].([]interface{}); ok && len(tradeData) > 10 {
+								b.Websocket.DataHandler <- b.wsExecutionReport(tradeData)
 							}
 						case wsFundingOrderSnapshot:
 							var snapshot []WsFundingOffer
@@ -806,7 +803,7 @@ func (b *Bitfinex) WsSendAuth() error {
 	if !b.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
 		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", b.Name)
 	}
-	nonce := strconv.FormatInt(time.Now().Unix(), 10)
+	nonce := strconv.FormatInt(b.Requester.Now().Unix(), 10)
 	payload := "AUTH" + nonce
 	request := WsAuthRequest{
 		Event:       "auth",
@@ -982,6 +979,50 @@ func (b *Bitfinex) WsCancelOffer(orderID int64) error {
 	return nil
 }
 
+// wsExecutionReport converts a trade execution update into an execution
+// report, bitfinex signs the executed amount by side and charged fees as
+// negative
+func (b *Bitfinex) wsExecutionReport(tradeData []interface{}) order.ExecutionReport {
+	side := order.Buy
+	amount := tradeData[4].(float64)
+	if amount < 0 {
+		side = order.Sell
+		amount = -amount
+	}
+	liquidity := order.Taker
+	if tradeData[8].(float64) == 1 {
+		liquidity = order.Maker
+	}
+	return order.ExecutionReport{
+		Exchange:    b.Name,
+		AssetType:   asset.Spot,
+		Pair:        currency.NewPairFromString(strings.TrimPrefix(tradeData[1].(string), "t")),
+		OrderID:     strconv.FormatInt(int64(tradeData[3].(float64)), 10),
+		TradeID:     strconv.FormatInt(int64(tradeData[0].(float64)), 10),
+		Side:        side,
+		Price:       decimal.NewFromFloat(tradeData[5].(float64)),
+		Amount:      decimal.NewFromFloat(amount),
+		Fee:         decimal.NewFromFloat(-tradeData[9].(float64)),
+		FeeCurrency: currency.NewCode(tradeData[10].(string)),
+		Liquidity:   liquidity,
+		Timestamp:   convert.TimeFromUnix(int64(tradeData[2].(float64)), time.Millisecond),
+	}
+}
+
+// parseCandleKey splits a candle subscription key such as trade:1D:tBTCUSD
+// into its normalised interval and currency pair
+func parseCandleKey(key string) (kline.Interval, currency.Pair, error) {
+	parts := strings.Split(key, ":")
+	if len(parts) != 3 || len(parts[2]) < 2 {
+		return 0, currency.Pair{}, fmt.Errorf("invalid candle key %s", key)
+	}
+	interval, err := kline.ParseInterval(parts[1])
+	if err != nil {
+		return 0, currency.Pair{}, err
+	}
+	return interval, currency.NewPairFromString(parts[2][1:]), nil
+}
+
 func makeRequestInterface(channelName string, data interface{}) []interface{} {
 	return []interface{}{0, channelName, nil, data}
 }
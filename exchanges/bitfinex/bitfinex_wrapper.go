@@ -1,13 +1,17 @@
 package bitfinex
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"strconv"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +41,7 @@ func (b *Bitfinex) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -58,6 +62,9 @@ func (b *Bitfinex) SetDefaults() {
 	b.CurrencyPairs = currency.PairsManager{
 		AssetTypes: asset.Items{
 			asset.Spot,
+			asset.Margin,
+			asset.MarginFunding,
+			asset.PerpetualContract,
 		},
 		UseGlobalFormat: true,
 		RequestFormat: &currency.PairFormat{
@@ -168,10 +175,13 @@ func (b *Bitfinex) Setup(exch *config.ExchangeConfig) error {
 	b.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         b.Name,
 		URL:                  b.Websocket.GetWebsocketURL(),
+		AlternateURLs:        b.Websocket.GetAlternateURLs(),
 		ProxyURL:             b.Websocket.GetProxyAddress(),
 		Verbose:              b.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 	b.AuthenticatedWebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         b.Name,
@@ -180,6 +190,8 @@ func (b *Bitfinex) Setup(exch *config.ExchangeConfig) error {
 		Verbose:              b.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	b.Websocket.Orderbook.Setup(
@@ -215,48 +227,46 @@ func (b *Bitfinex) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s", b.Name, err)
 	}
 }
 
-// FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Bitfinex) FetchTradablePairs(a asset.Item) ([]string, error) {
-	items, err := b.GetTickerBatch()
-	if err != nil {
-		return nil, err
-	}
-
-	var symbols []string
+// FetchTradablePairs returns a list of the exchanges tradable pairs, margin
+// funding pairs being the currencies with a funding book
+func (b *Bitfinex) FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error) {
 	switch a {
 	case asset.Spot:
-		for k := range items {
-			if !strings.HasPrefix(k, "t") {
-				continue
-			}
-			symbols = append(symbols, k[1:])
-		}
+		return b.GetPairs(ctx, pairListExchange)
 	case asset.Margin:
+		return b.GetPairs(ctx, pairListMargin)
+	case asset.PerpetualContract:
+		return b.GetPairs(ctx, pairListFutures)
+	case asset.MarginFunding:
+		items, err := b.GetTickerBatch(ctx)
+		if err != nil {
+			return nil, err
+		}
+		var symbols []string
 		for k := range items {
 			if !strings.HasPrefix(k, "f") {
 				continue
 			}
 			symbols = append(symbols, k[1:])
 		}
+		return symbols, nil
 	default:
 		return nil, errors.New("asset type not supported by this endpoint")
 	}
-
-	return symbols, nil
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Bitfinex) UpdateTradablePairs(forceUpdate bool) error {
+func (b *Bitfinex) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	for i := range b.CurrencyPairs.AssetTypes {
-		pairs, err := b.FetchTradablePairs(b.CurrencyPairs.AssetTypes[i])
+		pairs, err := b.FetchTradablePairs(ctx, b.CurrencyPairs.AssetTypes[i])
 		if err != nil {
 			return err
 		}
@@ -272,18 +282,19 @@ func (b *Bitfinex) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitfinex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitfinex) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	enabledPairs := b.GetEnabledPairs(assetType)
-	tickerNew, err := b.GetTickerBatch()
+	tickerNew, err := b.GetTickerBatch(ctx)
 	if err != nil {
 		return nil, err
 	}
+	prefix := symbolPrefix(assetType)
 	for k, v := range tickerNew {
-		if strings.HasPrefix(k, "f") {
+		if !strings.HasPrefix(k, prefix) {
 			continue
 		}
 		pair := currency.NewPairFromString(k[1:]) // Remove prefix
-		if !enabledPairs.Contains(p, true) {
+		if !enabledPairs.Contains(pair, true) {
 			continue
 		}
 		tick := ticker.Price{
@@ -305,49 +316,54 @@ func (b *Bitfinex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *Bitfinex) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitfinex) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	b.appendOptionalDelimiter(&p)
-	tick, err := ticker.GetTicker(b.Name, p, asset.Spot)
+	tick, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // FetchOrderbook returns the orderbook for a currency pair
-func (b *Bitfinex) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitfinex) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	b.appendOptionalDelimiter(&p)
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitfinex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitfinex) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	b.appendOptionalDelimiter(&p)
-	var prefix = "t"
-	if assetType == asset.Margin {
-		prefix = "f"
-	}
-
-	orderbookNew, err := b.GetOrderbook(prefix+p.String(), "P0", 100)
+	orderbookNew, err := b.GetOrderbook(ctx, symbolPrefix(assetType)+p.String(), "P0", 100)
 	if err != nil {
 		return nil, err
 	}
 
+	// Funding books are priced by their rate
+	isFunding := assetType == asset.MarginFunding
 	var o orderbook.Base
 	for x := range orderbookNew.Asks {
+		price := orderbookNew.Asks[x].Price
+		if isFunding {
+			price = orderbookNew.Asks[x].Rate
+		}
 		o.Asks = append(o.Asks, orderbook.Item{
-			Price:  orderbookNew.Asks[x].Price,
+			Price:  price,
 			Amount: orderbookNew.Asks[x].Amount,
 		})
 	}
 
 	for x := range orderbookNew.Bids {
+		price := orderbookNew.Bids[x].Price
+		if isFunding {
+			price = orderbookNew.Bids[x].Rate
+		}
 		o.Bids = append(o.Bids, orderbook.Item{
-			Price:  orderbookNew.Bids[x].Price,
+			Price:  price,
 			Amount: orderbookNew.Bids[x].Amount,
 		})
 	}
@@ -366,11 +382,11 @@ func (b *Bitfinex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orde
 
 // UpdateAccountInfo retrieves balances for all enabled currencies on the
 // Bitfinex exchange
-func (b *Bitfinex) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Bitfinex) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = b.Name
 
-	accountBalance, err := b.GetAccountBalance()
+	accountBalance, err := b.GetAccountBalance(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -389,8 +405,8 @@ func (b *Bitfinex) UpdateAccountInfo() (account.Holdings, error) {
 				Accounts[i].Currencies = append(Accounts[i].Currencies,
 					account.Balance{
 						CurrencyName: currency.NewCode(accountBalance[x].Currency),
-						TotalValue:   accountBalance[x].Amount,
-						Hold:         accountBalance[x].Amount - accountBalance[x].Available,
+						TotalValue:   decimal.NewFromFloat(accountBalance[x].Amount),
+						Hold:         decimal.NewFromFloat(accountBalance[x].Amount).Sub(decimal.NewFromFloat(accountBalance[x].Available)),
 					})
 			}
 		}
@@ -406,10 +422,10 @@ func (b *Bitfinex) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Bitfinex) FetchAccountInfo() (account.Holdings, error) {
+func (b *Bitfinex) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -417,43 +433,185 @@ func (b *Bitfinex) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitfinex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitfinex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (b *Bitfinex) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (b *Bitfinex) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	positions, err := b.GetPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var info *BaseMarginInfo
+	var resp []exchange.MarginPosition
+	for i := range positions {
+		if positions[i].Status != positionActive {
+			continue
+		}
+		if positions[i].Type == derivativePositionType {
+			resp = append(resp, b.marginPosition(&positions[i], nil))
+			continue
+		}
+		if info == nil {
+			var base BaseMarginInfo
+			base, err = b.GetBaseMarginInfo(ctx)
+			if err != nil {
+				return nil, err
+			}
+			info = &base
+		}
+		resp = append(resp, b.marginPosition(&positions[i], info))
+	}
+	return resp, nil
+}
+
+// marginPosition converts a position into a margin position. Derivatives
+// positions hold their own collateral while margin positions share the
+// margin wallet balance, valued in USD
+func (b *Bitfinex) marginPosition(p *ActivePosition, info *BaseMarginInfo) exchange.MarginPosition {
+	m := exchange.MarginPosition{
+		Exchange:         b.Name,
+		Pair:             currency.NewPairFromString(strings.TrimPrefix(p.Symbol, "t")),
+		Size:             p.Amount,
+		MarkPrice:        p.Price,
+		LiquidationPrice: p.LiquidationPrice,
+	}
+	if p.Type == derivativePositionType {
+		m.AssetType = asset.PerpetualContract
+		m.Currency = collateralCurrency(m.Pair)
+		m.Margin = decimal.NewFromFloat(p.Collateral).Add(decimal.NewFromFloat(p.PL)).Float64()
+		m.MaintenanceMargin = p.CollateralMin
+		m.Isolated = true
+		return m
+	}
+	m.AssetType = asset.Margin
+	m.Currency = currency.USD
+	if info != nil {
+		m.Margin = info.MarginNet
+		m.MaintenanceMargin = info.MarginMin
+	}
+	return m
+}
+
+// collateralCurrency returns the currency collateralising a derivatives pair,
+// the quote currency without its derivatives suffix
+func collateralCurrency(p currency.Pair) currency.Code {
+	return currency.NewCode(strings.TrimSuffix(p.Quote.Upper().String(), "F0"))
+}
+
+// AddMargin transfers margin from the exchange wallet. Margin positions are
+// topped up by transferring to the shared margin wallet and derivatives
+// positions by transferring to the derivatives wallet and raising the
+// position's collateral
+func (b *Bitfinex) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	if amount.Sign() <= 0 {
+		return errors.New("margin amount must be greater than 0")
+	}
+	switch assetType {
+	case asset.Margin:
+		_, err := b.WalletTransfer(ctx, amount.Float64(), p.Quote.Upper().String(), "exchange", "trading")
+		return err
+	case asset.PerpetualContract:
+		symbol := b.tradingSymbol(p, assetType)
+		positions, err := b.GetPositions(ctx)
+		if err != nil {
+			return err
+		}
+		for i := range positions {
+			if positions[i].Symbol != symbol || positions[i].Status != positionActive {
+				continue
+			}
+			collateral := collateralCurrency(p).String()
+			err = b.TransferBetweenWallets(ctx, "exchange", "margin", collateral, collateral+"F0", amount.Float64())
+			if err != nil {
+				return err
+			}
+			return b.SetDerivativeCollateral(ctx, symbol,
+				decimal.NewFromFloat(positions[i].Collateral).Add(amount).Float64())
+		}
+		return fmt.Errorf("%s has no open %s position", b.Name, symbol)
+	default:
+		return fmt.Errorf("%s margin can't be added to %s assets", b.Name, assetType)
+	}
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (b *Bitfinex) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrNotYetImplemented
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitfinex) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *Bitfinex) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitfinex) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
+func (b *Bitfinex) SubmitOrder(ctx context.Context, o *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	err := o.Validate()
 	if err != nil {
 		return submitOrderResponse, err
 	}
-	if b.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
+	a := o.AssetType
+	switch a {
+	case "":
+		a = asset.Spot
+	case asset.Spot, asset.Margin, asset.PerpetualContract:
+	case asset.MarginFunding:
+		return b.submitFundingOffer(ctx, o)
+	default:
+		return submitOrderResponse, fmt.Errorf("%s orders can't be submitted for %s assets", b.Name, a)
+	}
+	// v2 orders sell with a negative amount
+	amount := o.Amount
+	if o.OrderSide == order.Sell {
+		amount = amount.Neg()
+	}
+	switch {
+	case b.Websocket.CanUseAuthenticatedWebsocketForWrapper():
 		submitOrderResponse.OrderID, err = b.WsNewOrder(&WsNewOrderRequest{
 			CustomID: b.AuthenticatedWebsocketConn.GenerateMessageID(false),
-			Type:     o.OrderType.String(),
-			Symbol:   b.FormatExchangeCurrency(o.Pair, asset.Spot).String(),
-			Amount:   o.Amount,
-			Price:    o.Price,
+			Type:     orderTypeV2(a, o.OrderType),
+			Symbol:   b.tradingSymbol(o.Pair, a),
+			Amount:   amount.Float64(),
+			Price:    o.Price.Float64(),
 		})
 		if err != nil {
 			return submitOrderResponse, err
 		}
-	} else {
+	case a == asset.PerpetualContract:
+		// Derivatives can only be traded through the v2 API
+		var id int64
+		id, err = b.NewOrderV2(ctx, &OrderRequestV2{
+			Type:   orderTypeV2(a, o.OrderType),
+			Symbol: b.tradingSymbol(o.Pair, a),
+			Amount: amount.Float64(),
+			Price:  o.Price.Float64(),
+		})
+		if err != nil {
+			return submitOrderResponse, err
+		}
+		submitOrderResponse.OrderID = strconv.FormatInt(id, 10)
+		submitOrderResponse.IsOrderPlaced = true
+	default:
 		var response Order
 		isBuying := o.OrderSide == order.Buy
 		b.appendOptionalDelimiter(&o.Pair)
-		response, err = b.NewOrder(o.Pair.String(),
-			o.OrderType.String(),
-			o.Amount,
-			o.Price,
-			false,
-			isBuying)
+		response, err = b.NewOrder(ctx, o.Pair.String(),
+			orderTypeV1(a, o.OrderType),
+			o.Amount.Float64(),
+			o.Price.Float64(),
+			isBuying,
+			false)
 		if err != nil {
 			return submitOrderResponse, err
 		}
@@ -469,21 +627,73 @@ func (b *Bitfinex) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 	return submitOrderResponse, err
 }
 
+// submitFundingOffer submits a margin funding offer, a sell order lending the
+// base currency and a buy order borrowing it. The order price is the rate in
+// percent per 365 days
+func (b *Bitfinex) submitFundingOffer(ctx context.Context, o *order.Submit) (order.SubmitResponse, error) {
+	direction := fundingLend
+	if o.OrderSide == order.Buy {
+		direction = fundingLoan
+	}
+	offer, err := b.NewOffer(ctx, o.Pair.Base.Upper().String(),
+		o.Amount.Float64(),
+		o.Price.Float64(),
+		defaultFundingPeriod,
+		direction)
+	if err != nil {
+		return order.SubmitResponse{}, err
+	}
+	return order.SubmitResponse{
+		OrderID:       strconv.FormatInt(offer.ID, 10),
+		IsOrderPlaced: true,
+	}, nil
+}
+
+// orderTypeV1 returns the v1 API order type of an order, spot orders trading
+// from the exchange wallet
+func orderTypeV1(a asset.Item, t order.Type) string {
+	if a == asset.Spot {
+		return "exchange " + t.Lower()
+	}
+	return t.Lower()
+}
+
+// orderTypeV2 returns the v2 API order type of an order
+func orderTypeV2(a asset.Item, t order.Type) string {
+	return strings.ToUpper(orderTypeV1(a, t))
+}
+
+// tradingSymbol returns the v2 API trading symbol of a pair
+func (b *Bitfinex) tradingSymbol(p currency.Pair, a asset.Item) string {
+	p = b.FormatExchangeCurrency(p, a)
+	b.appendOptionalDelimiter(&p)
+	return "t" + p.String()
+}
+
+// symbolPrefix returns the v2 API symbol prefix of an asset, funding symbols
+// being prefixed with f and trading symbols with t
+func symbolPrefix(a asset.Item) string {
+	if a == asset.MarginFunding {
+		return "f"
+	}
+	return "t"
+}
+
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitfinex) ModifyOrder(action *order.Modify) (string, error) {
+func (b *Bitfinex) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	orderIDInt, err := strconv.ParseInt(action.OrderID, 10, 64)
 	if err != nil {
 		return action.OrderID, err
 	}
 	if b.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
-		if action.Side == order.Sell && action.Amount > 0 {
-			action.Amount = -1 * action.Amount
+		if action.Side == order.Sell && action.Amount.Sign() > 0 {
+			action.Amount = action.Amount.Neg()
 		}
 		err = b.WsModifyOrder(&WsUpdateOrderRequest{
 			OrderID: orderIDInt,
-			Price:   action.Price,
-			Amount:  action.Amount,
+			Price:   action.Price.Float64(),
+			Amount:  action.Amount.Float64(),
 		})
 		return action.OrderID, err
 	}
@@ -491,58 +701,62 @@ func (b *Bitfinex) ModifyOrder(action *order.Modify) (string, error) {
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitfinex) CancelOrder(order *order.Cancel) error {
+func (b *Bitfinex) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
+	if order.AssetType == asset.MarginFunding {
+		_, err = b.CancelOffer(ctx, orderIDInt)
+		return err
+	}
 	if b.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
 		err = b.WsCancelOrder(orderIDInt)
 	} else {
-		_, err = b.CancelExistingOrder(orderIDInt)
+		_, err = b.CancelExistingOrder(ctx, orderIDInt)
 	}
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitfinex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (b *Bitfinex) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	var err error
 	if b.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
 		err = b.WsCancelAllOrders()
 	} else {
-		_, err = b.CancelAllExistingOrders()
+		_, err = b.CancelAllExistingOrders(ctx)
 	}
 	return order.CancelAllResponse{}, err
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitfinex) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Bitfinex) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitfinex) GetDepositAddress(c currency.Code, accountID string) (string, error) {
+func (b *Bitfinex) GetDepositAddress(ctx context.Context, c currency.Code, accountID string) (string, error) {
 	if accountID == "" {
 		accountID = "deposit"
 	}
 
-	method, err := b.ConvertSymbolToDepositMethod(c)
+	method, err := b.ConvertSymbolToDepositMethod(ctx, c)
 	if err != nil {
 		return "", err
 	}
 
-	resp, err := b.NewDeposit(method, accountID, 0)
+	resp, err := b.NewDeposit(ctx, method, accountID, 0)
 	return resp.Address, err
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is submitted
-func (b *Bitfinex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (b *Bitfinex) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	// Bitfinex has support for three types, exchange, margin and deposit
 	// As this is for trading, I've made the wrapper default 'exchange'
 	// TODO: Discover an automated way to make the decision for wallet type to withdraw from
 	walletType := "exchange"
-	resp, err := b.WithdrawCryptocurrency(walletType,
+	resp, err := b.WithdrawCryptocurrency(ctx, walletType,
 		withdrawRequest.Address,
 		withdrawRequest.Description,
 		withdrawRequest.Amount,
@@ -556,13 +770,13 @@ func (b *Bitfinex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoR
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is submitted
 // Returns comma delimited withdrawal IDs
-func (b *Bitfinex) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bitfinex) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	withdrawalType := "wire"
 	// Bitfinex has support for three types, exchange, margin and deposit
 	// As this is for trading, I've made the wrapper default 'exchange'
 	// TODO: Discover an automated way to make the decision for wallet type to withdraw from
 	walletType := "exchange"
-	resp, err := b.WithdrawFIAT(withdrawalType, walletType, withdrawRequest)
+	resp, err := b.WithdrawFIAT(ctx, withdrawalType, walletType, withdrawRequest)
 	if err != nil {
 		return "", err
 	}
@@ -572,8 +786,8 @@ func (b *Bitfinex) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (str
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is submitted
 // Returns comma delimited withdrawal IDs
-func (b *Bitfinex) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
-	return b.WithdrawFiatFunds(withdrawRequest)
+func (b *Bitfinex) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
+	return b.WithdrawFiatFunds(ctx, withdrawRequest)
 }
 
 // GetWebsocket returns a pointer to the exchange websocket
@@ -582,42 +796,41 @@ func (b *Bitfinex) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bitfinex) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bitfinex) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return b.GetFee(feeBuilder)
+	return b.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *Bitfinex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitfinex) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
-	resp, err := b.GetOpenOrders()
+	resp, err := b.GetOpenOrders(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	for i := range resp {
 		orderSide := order.Side(strings.ToUpper(resp[i].Side))
-		timestamp, err := strconv.ParseInt(resp[i].Timestamp, 10, 64)
+		orderDate, err := convert.ParseTime(resp[i].Timestamp, time.Second)
 		if err != nil {
 			log.Warnf(log.ExchangeSys,
 				"Unable to convert timestamp '%s', leaving blank",
 				resp[i].Timestamp)
 		}
-		orderDate := time.Unix(timestamp, 0)
 
 		orderDetail := order.Detail{
-			Amount:          resp[i].OriginalAmount,
+			Amount:          decimal.NewFromFloat(resp[i].OriginalAmount),
 			OrderDate:       orderDate,
 			Exchange:        b.Name,
 			ID:              strconv.FormatInt(resp[i].OrderID, 10),
 			OrderSide:       orderSide,
-			Price:           resp[i].Price,
-			RemainingAmount: resp[i].RemainingAmount,
+			Price:           decimal.NewFromFloat(resp[i].Price),
+			RemainingAmount: decimal.NewFromFloat(resp[i].RemainingAmount),
 			CurrencyPair:    currency.NewPairFromString(resp[i].Symbol),
-			ExecutedAmount:  resp[i].ExecutedAmount,
+			ExecutedAmount:  decimal.NewFromFloat(resp[i].ExecutedAmount),
 		}
 
 		switch {
@@ -652,30 +865,29 @@ func (b *Bitfinex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *Bitfinex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitfinex) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
-	resp, err := b.GetInactiveOrders()
+	resp, err := b.GetInactiveOrders(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	for i := range resp {
 		orderSide := order.Side(strings.ToUpper(resp[i].Side))
-		timestamp, err := strconv.ParseInt(resp[i].Timestamp, 10, 64)
+		orderDate, err := convert.ParseTime(resp[i].Timestamp, time.Second)
 		if err != nil {
 			log.Warnf(log.ExchangeSys, "Unable to convert timestamp '%v', leaving blank", resp[i].Timestamp)
 		}
-		orderDate := time.Unix(timestamp, 0)
 
 		orderDetail := order.Detail{
-			Amount:          resp[i].OriginalAmount,
+			Amount:          decimal.NewFromFloat(resp[i].OriginalAmount),
 			OrderDate:       orderDate,
 			Exchange:        b.Name,
 			ID:              strconv.FormatInt(resp[i].OrderID, 10),
 			OrderSide:       orderSide,
-			Price:           resp[i].Price,
-			RemainingAmount: resp[i].RemainingAmount,
-			ExecutedAmount:  resp[i].ExecutedAmount,
+			Price:           decimal.NewFromFloat(resp[i].Price),
+			RemainingAmount: decimal.NewFromFloat(resp[i].RemainingAmount),
+			ExecutedAmount:  decimal.NewFromFloat(resp[i].ExecutedAmount),
 			CurrencyPair:    currency.NewPairFromString(resp[i].Symbol),
 		}
 
@@ -712,24 +924,22 @@ func (b *Bitfinex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (b *Bitfinex) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
 	for i := range channels {
 		b.appendOptionalDelimiter(&channels[i].Currency)
 	}
-	b.Websocket.SubscribeToChannels(channels)
-	return nil
+	return b.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (b *Bitfinex) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
 	for i := range channels {
 		b.appendOptionalDelimiter(&channels[i].Currency)
 	}
-	b.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return b.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -752,7 +962,20 @@ func (b *Bitfinex) appendOptionalDelimiter(p *currency.Pair) {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Bitfinex) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Bitfinex) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *Bitfinex) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	perms, err := b.GetKeyPermissions(ctx)
+	if err != nil {
+		return exchange.APIKeyPermissions{}, err
+	}
+	return exchange.APIKeyPermissions{
+		Read:     perms.Account.Read || perms.Wallets.Read,
+		Trade:    perms.Orders.Write,
+		Withdraw: perms.Withdraw.Write,
+	}, nil
+}
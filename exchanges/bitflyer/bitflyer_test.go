@@ -1,11 +1,13 @@
 package bitflyer
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -50,7 +52,7 @@ func TestMain(m *testing.M) {
 
 func TestGetLatestBlockCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLatestBlockCA()
+	_, err := b.GetLatestBlockCA(context.Background())
 	if err != nil {
 		t.Error("Bitflyer - GetLatestBlockCA() error:", err)
 	}
@@ -58,7 +60,7 @@ func TestGetLatestBlockCA(t *testing.T) {
 
 func TestGetBlockCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetBlockCA("000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f")
+	_, err := b.GetBlockCA(context.Background(), "000000000019d6689c085ae165831e934ff763ae46a2a6c172b3f1b60a8ce26f")
 	if err != nil {
 		t.Error("Bitflyer - GetBlockCA() error:", err)
 	}
@@ -66,7 +68,7 @@ func TestGetBlockCA(t *testing.T) {
 
 func TestGetBlockbyHeightCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetBlockbyHeightCA(0)
+	_, err := b.GetBlockbyHeightCA(context.Background(), 0)
 	if err != nil {
 		t.Error("Bitflyer - GetBlockbyHeightCA() error:", err)
 	}
@@ -74,7 +76,7 @@ func TestGetBlockbyHeightCA(t *testing.T) {
 
 func TestGetTransactionByHashCA(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTransactionByHashCA("0562d1f063cd4127053d838b165630445af5e480ceb24e1fd9ecea52903cb772")
+	_, err := b.GetTransactionByHashCA(context.Background(), "0562d1f063cd4127053d838b165630445af5e480ceb24e1fd9ecea52903cb772")
 	if err != nil {
 		t.Error("Bitflyer - GetTransactionByHashCA() error:", err)
 	}
@@ -82,7 +84,7 @@ func TestGetTransactionByHashCA(t *testing.T) {
 
 func TestGetAddressInfoCA(t *testing.T) {
 	t.Parallel()
-	v, err := b.GetAddressInfoCA(core.BitcoinDonationAddress)
+	v, err := b.GetAddressInfoCA(context.Background(), core.BitcoinDonationAddress)
 	if err != nil {
 		t.Error("Bitflyer - GetAddressInfoCA() error:", err)
 	}
@@ -93,7 +95,7 @@ func TestGetAddressInfoCA(t *testing.T) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error("Bitflyer - GetMarkets() error:", err)
 	}
@@ -101,7 +103,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderBook("BTC_JPY")
+	_, err := b.GetOrderBook(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("Bitflyer - GetOrderBook() error:", err)
 	}
@@ -109,7 +111,7 @@ func TestGetOrderBook(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker("BTC_JPY")
+	_, err := b.GetTicker(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("Bitflyer - GetTicker() error:", err)
 	}
@@ -117,7 +119,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetExecutionHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExecutionHistory("BTC_JPY")
+	_, err := b.GetExecutionHistory(context.Background(), "BTC_JPY")
 	if err != nil {
 		t.Error("Bitflyer - GetExecutionHistory() error:", err)
 	}
@@ -125,7 +127,7 @@ func TestGetExecutionHistory(t *testing.T) {
 
 func TestGetExchangeStatus(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetExchangeStatus()
+	_, err := b.GetExchangeStatus(context.Background())
 	if err != nil {
 		t.Error("Bitflyer - GetExchangeStatus() error:", err)
 	}
@@ -152,7 +154,7 @@ func TestFetchTicker(t *testing.T) {
 		}
 	}
 
-	_, err := b.FetchTicker(p, asset.Spot)
+	_, err := b.FetchTicker(context.Background(), p, asset.Spot)
 	if err != nil {
 		t.Error("Bitflyer - FetchTicker() error", err)
 	}
@@ -172,7 +174,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -271,7 +273,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -285,7 +287,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected '%v', received '%v'", common.ErrNotYetImplemented, err)
 	}
@@ -310,11 +312,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	_, err := b.SubmitOrder(orderSubmission)
+	_, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
 	}
@@ -334,7 +336,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
@@ -355,7 +357,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	_, err := b.CancelAllOrders(orderCancellation)
+	_, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
@@ -377,7 +379,7 @@ func TestWithdraw(t *testing.T) {
 		Address: core.BitcoinDonationAddress,
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected 'Not Yet Implemented', received %v", err)
 	}
@@ -388,7 +390,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -402,7 +404,7 @@ func TestWithdrawFiat(t *testing.T) {
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
 
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrNotYetImplemented, err)
 	}
@@ -416,7 +418,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
 
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrNotYetImplemented {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrNotYetImplemented, err)
 	}
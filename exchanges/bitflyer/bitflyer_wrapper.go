@@ -1,10 +1,13 @@
 package bitflyer
 
 import (
+	"context"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -34,7 +37,7 @@ func (b *Bitflyer) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -126,15 +129,15 @@ func (b *Bitflyer) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", b.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Bitflyer) FetchTradablePairs(assetType asset.Item) ([]string, error) {
-	pairs, err := b.GetMarkets()
+func (b *Bitflyer) FetchTradablePairs(ctx context.Context, assetType asset.Item) ([]string, error) {
+	pairs, err := b.GetMarkets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -155,10 +158,10 @@ func (b *Bitflyer) FetchTradablePairs(assetType asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Bitflyer) UpdateTradablePairs(forceUpdate bool) error {
+func (b *Bitflyer) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	for x := range b.CurrencyPairs.AssetTypes {
 		a := b.CurrencyPairs.AssetTypes[x]
-		pairs, err := b.FetchTradablePairs(a)
+		pairs, err := b.FetchTradablePairs(ctx, a)
 		if err != nil {
 			return err
 		}
@@ -175,12 +178,12 @@ func (b *Bitflyer) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitflyer) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitflyer) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
 
 	p = b.CheckFXString(p)
 
-	tickerNew, err := b.GetTicker(p.String())
+	tickerNew, err := b.GetTicker(ctx, p.String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -199,10 +202,10 @@ func (b *Bitflyer) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *Bitflyer) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitflyer) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tick, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
@@ -217,21 +220,21 @@ func (b *Bitflyer) CheckFXString(p currency.Pair) currency.Pair {
 }
 
 // FetchOrderbook returns the orderbook for a currency pair
-func (b *Bitflyer) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitflyer) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitflyer) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitflyer) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 
 	p = b.CheckFXString(p)
 
-	orderbookNew, err := b.GetOrderBook(p.String())
+	orderbookNew, err := b.GetOrderBook(ctx, p.String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -258,15 +261,15 @@ func (b *Bitflyer) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orde
 
 // UpdateAccountInfo retrieves balances for all enabled currencies on the
 // Bitflyer exchange
-func (b *Bitflyer) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Bitflyer) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	return account.Holdings{}, common.ErrNotYetImplemented
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Bitflyer) FetchAccountInfo() (account.Holdings, error) {
+func (b *Bitflyer) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -274,64 +277,87 @@ func (b *Bitflyer) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitflyer) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitflyer) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (b *Bitflyer) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (b *Bitflyer) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (b *Bitflyer) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (b *Bitflyer) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrNotYetImplemented
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitflyer) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *Bitflyer) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitflyer) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *Bitflyer) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	return order.SubmitResponse{}, common.ErrNotYetImplemented
 }
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitflyer) ModifyOrder(action *order.Modify) (string, error) {
+func (b *Bitflyer) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitflyer) CancelOrder(order *order.Cancel) error {
+func (b *Bitflyer) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	return common.ErrNotYetImplemented
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitflyer) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (b *Bitflyer) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	// TODO, implement BitFlyer API
 	b.CancelAllExistingOrders()
 	return order.CancelAllResponse{}, common.ErrNotYetImplemented
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitflyer) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Bitflyer) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitflyer) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (b *Bitflyer) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitflyer) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (b *Bitflyer) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bitflyer) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bitflyer) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bitflyer) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bitflyer) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
@@ -341,18 +367,18 @@ func (b *Bitflyer) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *Bitflyer) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitflyer) GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *Bitflyer) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitflyer) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetFeeByType returns an estimate of fee based on the type of transaction
-func (b *Bitflyer) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bitflyer) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -384,7 +410,12 @@ func (b *Bitflyer) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Bitflyer) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Bitflyer) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *Bitflyer) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -1,11 +1,13 @@
 package bithumb
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -50,7 +52,7 @@ func TestMain(m *testing.M) {
 
 func TestGetTradablePairs(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTradablePairs()
+	_, err := b.GetTradablePairs(context.Background())
 	if err != nil {
 		t.Error("Bithumb GetTradablePairs() error", err)
 	}
@@ -58,7 +60,7 @@ func TestGetTradablePairs(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(testCurrency)
+	_, err := b.GetTicker(context.Background(), testCurrency)
 	if err != nil {
 		t.Error("Bithumb GetTicker() error", err)
 	}
@@ -66,7 +68,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetAllTickers(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetAllTickers()
+	_, err := b.GetAllTickers(context.Background())
 	if err != nil {
 		t.Error("Bithumb GetAllTickers() error", err)
 	}
@@ -74,7 +76,7 @@ func TestGetAllTickers(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderBook(testCurrency)
+	_, err := b.GetOrderBook(context.Background(), testCurrency)
 	if err != nil {
 		t.Error("Bithumb GetOrderBook() error", err)
 	}
@@ -82,7 +84,7 @@ func TestGetOrderBook(t *testing.T) {
 
 func TestGetTransactionHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTransactionHistory(testCurrency)
+	_, err := b.GetTransactionHistory(context.Background(), testCurrency)
 	if err != nil {
 		t.Error("Bithumb GetTransactionHistory() error", err)
 	}
@@ -94,7 +96,7 @@ func TestGetAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.GetAccountBalance(testCurrency)
+	_, err := b.GetAccountBalance(context.Background(), testCurrency)
 	if err == nil {
 		t.Error("Bithumb GetAccountBalance() Expected error")
 	}
@@ -106,7 +108,7 @@ func TestGetWalletAddress(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := b.GetWalletAddress("")
+	_, err := b.GetWalletAddress(context.Background(), "")
 	if err == nil {
 		t.Error("Bithumb GetWalletAddress() Expected error")
 	}
@@ -114,7 +116,7 @@ func TestGetWalletAddress(t *testing.T) {
 
 func TestGetLastTransaction(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetLastTransaction()
+	_, err := b.GetLastTransaction(context.Background())
 	if err == nil {
 		t.Error("Bithumb GetLastTransaction() Expected error")
 	}
@@ -122,7 +124,7 @@ func TestGetLastTransaction(t *testing.T) {
 
 func TestGetOrders(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrders("1337", order.Bid.Lower(), "100", "", testCurrency)
+	_, err := b.GetOrders(context.Background(), "1337", order.Bid.Lower(), "100", "", testCurrency)
 	if err == nil {
 		t.Error("Bithumb GetOrders() Expected error")
 	}
@@ -130,7 +132,7 @@ func TestGetOrders(t *testing.T) {
 
 func TestGetUserTransactions(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetUserTransactions()
+	_, err := b.GetUserTransactions(context.Background())
 	if err == nil {
 		t.Error("Bithumb GetUserTransactions() Expected error")
 	}
@@ -138,7 +140,7 @@ func TestGetUserTransactions(t *testing.T) {
 
 func TestPlaceTrade(t *testing.T) {
 	t.Parallel()
-	_, err := b.PlaceTrade(testCurrency, order.Bid.Lower(), 0, 0)
+	_, err := b.PlaceTrade(context.Background(), testCurrency, order.Bid.Lower(), 0, 0)
 	if err == nil {
 		t.Error("Bithumb PlaceTrade() Expected error")
 	}
@@ -146,7 +148,7 @@ func TestPlaceTrade(t *testing.T) {
 
 func TestGetOrderDetails(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderDetails("1337", order.Bid.Lower(), testCurrency)
+	_, err := b.GetOrderDetails(context.Background(), "1337", order.Bid.Lower(), testCurrency)
 	if err == nil {
 		t.Error("Bithumb GetOrderDetails() Expected error")
 	}
@@ -154,7 +156,7 @@ func TestGetOrderDetails(t *testing.T) {
 
 func TestCancelTrade(t *testing.T) {
 	t.Parallel()
-	_, err := b.CancelTrade("", "", "")
+	_, err := b.CancelTrade(context.Background(), "", "", "")
 	if err == nil {
 		t.Error("Bithumb CancelTrade() Expected error")
 	}
@@ -162,7 +164,7 @@ func TestCancelTrade(t *testing.T) {
 
 func TestWithdrawCrypto(t *testing.T) {
 	t.Parallel()
-	_, err := b.WithdrawCrypto("LQxiDhKU7idKiWQhx4ALKYkBx8xKEQVxJR", "", "ltc", 0)
+	_, err := b.WithdrawCrypto(context.Background(), "LQxiDhKU7idKiWQhx4ALKYkBx8xKEQVxJR", "", "ltc", 0)
 	if err == nil {
 		t.Error("Bithumb WithdrawCrypto() Expected error")
 	}
@@ -173,7 +175,7 @@ func TestRequestKRWDepositDetails(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := b.RequestKRWDepositDetails()
+	_, err := b.RequestKRWDepositDetails(context.Background())
 	if err == nil {
 		t.Error("Bithumb RequestKRWDepositDetails() Expected error")
 	}
@@ -181,7 +183,7 @@ func TestRequestKRWDepositDetails(t *testing.T) {
 
 func TestRequestKRWWithdraw(t *testing.T) {
 	t.Parallel()
-	_, err := b.RequestKRWWithdraw("102_bank", "1337", 1000)
+	_, err := b.RequestKRWWithdraw(context.Background(), "102_bank", "1337", 1000)
 	if err == nil {
 		t.Error("Bithumb RequestKRWWithdraw() Expected error")
 	}
@@ -189,7 +191,7 @@ func TestRequestKRWWithdraw(t *testing.T) {
 
 func TestMarketBuyOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.MarketBuyOrder(testCurrency, 0)
+	_, err := b.MarketBuyOrder(context.Background(), testCurrency, 0)
 	if err == nil {
 		t.Error("Bithumb MarketBuyOrder() Expected error")
 	}
@@ -197,7 +199,7 @@ func TestMarketBuyOrder(t *testing.T) {
 
 func TestMarketSellOrder(t *testing.T) {
 	t.Parallel()
-	_, err := b.MarketSellOrder(testCurrency, 0)
+	_, err := b.MarketSellOrder(context.Background(), testCurrency, 0)
 	if err == nil {
 		t.Error("Bithumb MarketSellOrder() Expected error")
 	}
@@ -215,7 +217,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -311,7 +313,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderSide: order.Sell,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -325,7 +327,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -352,11 +354,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -378,7 +380,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -401,7 +403,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -418,12 +420,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
-		_, err := b.UpdateAccountInfo()
+		_, err := b.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("Bithumb GetAccountInfo() error", err)
 		}
 	} else {
-		_, err := b.UpdateAccountInfo()
+		_, err := b.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("Bithumb GetAccountInfo() Expected error")
 		}
@@ -433,10 +435,10 @@ func TestGetAccountInfo(t *testing.T) {
 func TestModifyOrder(t *testing.T) {
 	t.Parallel()
 	curr := currency.NewPairFromString("BTCUSD")
-	_, err := b.ModifyOrder(&order.Modify{
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{
 		OrderID:      "1337",
-		Price:        100,
-		Amount:       1000,
+		Price:        decimal.NewFromInt(100),
+		Amount:       decimal.NewFromInt(1000),
 		Side:         order.Sell,
 		CurrencyPair: curr})
 	if err == nil {
@@ -459,7 +461,7 @@ func TestWithdraw(t *testing.T) {
 		Address: core.BitcoinDonationAddress,
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -493,7 +495,7 @@ func TestWithdrawFiat(t *testing.T) {
 		IsExpressWire:            false,
 	}
 
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -509,7 +511,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -518,12 +520,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
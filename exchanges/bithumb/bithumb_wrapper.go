@@ -1,6 +1,7 @@
 package bithumb
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +40,7 @@ func (b *Bithumb) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -140,15 +142,15 @@ func (b *Bithumb) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", b.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Bithumb) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	currencies, err := b.GetTradablePairs()
+func (b *Bithumb) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	currencies, err := b.GetTradablePairs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -162,8 +164,8 @@ func (b *Bithumb) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Bithumb) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+func (b *Bithumb) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -172,9 +174,9 @@ func (b *Bithumb) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bithumb) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bithumb) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tickers, err := b.GetAllTickers()
+	tickers, err := b.GetAllTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -202,29 +204,29 @@ func (b *Bithumb) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.P
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *Bithumb) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bithumb) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (b *Bithumb) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bithumb) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bithumb) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bithumb) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 	curr := p.Base.String()
 
-	orderbookNew, err := b.GetOrderBook(curr)
+	orderbookNew, err := b.GetOrderBook(ctx, curr)
 	if err != nil {
 		return orderBook, err
 	}
@@ -259,9 +261,9 @@ func (b *Bithumb) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*order
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Bithumb exchange
-func (b *Bithumb) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Bithumb) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
-	bal, err := b.GetAccountBalance("ALL")
+	bal, err := b.GetAccountBalance(ctx, "ALL")
 	if err != nil {
 		return info, err
 	}
@@ -276,8 +278,8 @@ func (b *Bithumb) UpdateAccountInfo() (account.Holdings, error) {
 
 		exchangeBalances = append(exchangeBalances, account.Balance{
 			CurrencyName: currency.NewCode(key),
-			TotalValue:   totalAmount,
-			Hold:         hold,
+			TotalValue:   decimal.NewFromFloat(totalAmount),
+			Hold:         decimal.NewFromFloat(hold),
 		})
 	}
 
@@ -295,10 +297,10 @@ func (b *Bithumb) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Bithumb) FetchAccountInfo() (account.Holdings, error) {
+func (b *Bithumb) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -306,18 +308,41 @@ func (b *Bithumb) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bithumb) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bithumb) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (b *Bithumb) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (b *Bithumb) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (b *Bithumb) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (b *Bithumb) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bithumb) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *Bithumb) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
 // TODO: Fill this out to support limit orders
-func (b *Bithumb) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *Bithumb) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -327,14 +352,14 @@ func (b *Bithumb) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	var err error
 	if s.OrderSide == order.Buy {
 		var result MarketBuy
-		result, err = b.MarketBuyOrder(s.Pair.Base.String(), s.Amount)
+		result, err = b.MarketBuyOrder(ctx, s.Pair.Base.String(), s.Amount.Float64())
 		if err != nil {
 			return submitOrderResponse, err
 		}
 		orderID = result.OrderID
 	} else if s.OrderSide == order.Sell {
 		var result MarketSell
-		result, err = b.MarketSellOrder(s.Pair.Base.String(), s.Amount)
+		result, err = b.MarketSellOrder(ctx, s.Pair.Base.String(), s.Amount.Float64())
 		if err != nil {
 			return submitOrderResponse, err
 		}
@@ -351,12 +376,12 @@ func (b *Bithumb) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bithumb) ModifyOrder(action *order.Modify) (string, error) {
-	order, err := b.ModifyTrade(action.OrderID,
+func (b *Bithumb) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
+	order, err := b.ModifyTrade(ctx, action.OrderID,
 		action.CurrencyPair.Base.String(),
 		action.Side.Lower(),
-		action.Amount,
-		int64(action.Price))
+		action.Amount.Float64(),
+		action.Price.IntPart())
 
 	if err != nil {
 		return "", err
@@ -366,15 +391,15 @@ func (b *Bithumb) ModifyOrder(action *order.Modify) (string, error) {
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bithumb) CancelOrder(order *order.Cancel) error {
-	_, err := b.CancelTrade(order.Side.String(),
+func (b *Bithumb) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	_, err := b.CancelTrade(ctx, order.Side.String(),
 		order.OrderID,
 		order.CurrencyPair.Base.String())
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bithumb) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (b *Bithumb) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
@@ -382,7 +407,7 @@ func (b *Bithumb) CancelAllOrders(orderCancellation *order.Cancel) (order.Cancel
 	var allOrders []OrderData
 	currs := b.GetEnabledPairs(asset.Spot)
 	for i := range currs {
-		orders, err := b.GetOrders("",
+		orders, err := b.GetOrders(ctx, "",
 			orderCancellation.Side.String(),
 			"100",
 			"",
@@ -394,7 +419,7 @@ func (b *Bithumb) CancelAllOrders(orderCancellation *order.Cancel) (order.Cancel
 	}
 
 	for i := range allOrders {
-		_, err := b.CancelTrade(orderCancellation.Side.String(),
+		_, err := b.CancelTrade(ctx, orderCancellation.Side.String(),
 			allOrders[i].OrderID,
 			orderCancellation.CurrencyPair.Base.String())
 		if err != nil {
@@ -406,14 +431,14 @@ func (b *Bithumb) CancelAllOrders(orderCancellation *order.Cancel) (order.Cancel
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bithumb) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Bithumb) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bithumb) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	addr, err := b.GetWalletAddress(cryptocurrency.String())
+func (b *Bithumb) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	addr, err := b.GetWalletAddress(ctx, cryptocurrency.String())
 	if err != nil {
 		return "", err
 	}
@@ -423,8 +448,8 @@ func (b *Bithumb) GetDepositAddress(cryptocurrency currency.Code, _ string) (str
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bithumb) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	_, err := b.WithdrawCrypto(withdrawRequest.Address,
+func (b *Bithumb) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	_, err := b.WithdrawCrypto(ctx, withdrawRequest.Address,
 		withdrawRequest.AddressTag,
 		withdrawRequest.Currency.String(),
 		withdrawRequest.Amount)
@@ -433,7 +458,7 @@ func (b *Bithumb) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRe
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bithumb) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bithumb) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	if math.Mod(withdrawRequest.Amount, 1) != 0 {
 		return "", errors.New("currency KRW does not support decimal places")
 	}
@@ -442,7 +467,7 @@ func (b *Bithumb) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (stri
 	}
 	bankDetails := strconv.FormatFloat(withdrawRequest.BankCode, 'f', -1, 64) +
 		"_" + withdrawRequest.BankName
-	resp, err := b.RequestKRWWithdraw(bankDetails, withdrawRequest.BankAccountNumber, int64(withdrawRequest.Amount))
+	resp, err := b.RequestKRWWithdraw(ctx, bankDetails, withdrawRequest.BankAccountNumber, int64(withdrawRequest.Amount))
 	if err != nil {
 		return "", err
 	}
@@ -454,7 +479,7 @@ func (b *Bithumb) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (stri
 }
 
 // WithdrawFiatFundsToInternationalBank is not supported as Bithumb only withdraws KRW to South Korean banks
-func (b *Bithumb) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bithumb) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -464,7 +489,7 @@ func (b *Bithumb) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bithumb) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bithumb) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -473,9 +498,9 @@ func (b *Bithumb) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *Bithumb) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bithumb) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
-	resp, err := b.GetOrders("", "", "1000", "", "")
+	resp, err := b.GetOrders(ctx, "", "", "1000", "", "")
 	if err != nil {
 		return nil, err
 	}
@@ -487,12 +512,12 @@ func (b *Bithumb) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 		orderDate := time.Unix(resp.Data[i].OrderDate, 0)
 		orderDetail := order.Detail{
-			Amount:          resp.Data[i].Units,
+			Amount:          decimal.NewFromFloat(resp.Data[i].Units),
 			Exchange:        b.Name,
 			ID:              resp.Data[i].OrderID,
 			OrderDate:       orderDate,
-			Price:           resp.Data[i].Price,
-			RemainingAmount: resp.Data[i].UnitsRemaining,
+			Price:           decimal.NewFromFloat(resp.Data[i].Price),
+			RemainingAmount: decimal.NewFromFloat(resp.Data[i].UnitsRemaining),
 			Status:          order.Active,
 			CurrencyPair: currency.NewPairWithDelimiter(resp.Data[i].OrderCurrency,
 				resp.Data[i].PaymentCurrency,
@@ -516,9 +541,9 @@ func (b *Bithumb) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *Bithumb) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bithumb) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
-	resp, err := b.GetOrders("", "", "1000", "", "")
+	resp, err := b.GetOrders(ctx, "", "", "1000", "", "")
 	if err != nil {
 		return nil, err
 	}
@@ -530,12 +555,12 @@ func (b *Bithumb) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 
 		orderDate := time.Unix(resp.Data[i].OrderDate, 0)
 		orderDetail := order.Detail{
-			Amount:          resp.Data[i].Units,
+			Amount:          decimal.NewFromFloat(resp.Data[i].Units),
 			Exchange:        b.Name,
 			ID:              resp.Data[i].OrderID,
 			OrderDate:       orderDate,
-			Price:           resp.Data[i].Price,
-			RemainingAmount: resp.Data[i].UnitsRemaining,
+			Price:           decimal.NewFromFloat(resp.Data[i].Price),
+			RemainingAmount: decimal.NewFromFloat(resp.Data[i].UnitsRemaining),
 			CurrencyPair: currency.NewPairWithDelimiter(resp.Data[i].OrderCurrency,
 				resp.Data[i].PaymentCurrency,
 				b.GetPairFormat(asset.Spot, false).Delimiter),
@@ -580,7 +605,12 @@ func (b *Bithumb) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Bithumb) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Bithumb) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *Bithumb) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
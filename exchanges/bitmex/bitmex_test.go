@@ -1,6 +1,8 @@
 package bitmex
 
 import (
+	"context"
+	"encoding/json"
 	"log"
 	"net/http"
 	"os"
@@ -10,10 +12,12 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -60,49 +64,49 @@ func TestStart(t *testing.T) {
 }
 
 func TestGetUrgentAnnouncement(t *testing.T) {
-	_, err := b.GetUrgentAnnouncement()
+	_, err := b.GetUrgentAnnouncement(context.Background())
 	if err == nil {
 		t.Error("GetUrgentAnnouncement() Expected error")
 	}
 }
 
 func TestGetAPIKeys(t *testing.T) {
-	_, err := b.GetAPIKeys()
+	_, err := b.GetAPIKeys(context.Background())
 	if err == nil {
 		t.Error("GetAPIKeys() Expected error")
 	}
 }
 
 func TestRemoveAPIKey(t *testing.T) {
-	_, err := b.RemoveAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.RemoveAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("RemoveAPIKey() Expected error")
 	}
 }
 
 func TestDisableAPIKey(t *testing.T) {
-	_, err := b.DisableAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.DisableAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("DisableAPIKey() Expected error")
 	}
 }
 
 func TestEnableAPIKey(t *testing.T) {
-	_, err := b.EnableAPIKey(APIKeyParams{APIKeyID: "1337"})
+	_, err := b.EnableAPIKey(context.Background(), APIKeyParams{APIKeyID: "1337"})
 	if err == nil {
 		t.Error("EnableAPIKey() Expected error")
 	}
 }
 
 func TestGetTrollboxMessages(t *testing.T) {
-	_, err := b.GetTrollboxMessages(ChatGetParams{Count: 5})
+	_, err := b.GetTrollboxMessages(context.Background(), ChatGetParams{Count: 5})
 	if err != nil {
 		t.Error("GetTrollboxMessages() error", err)
 	}
 }
 
 func TestSendTrollboxMessage(t *testing.T) {
-	_, err := b.SendTrollboxMessage(ChatSendParams{
+	_, err := b.SendTrollboxMessage(context.Background(), ChatSendParams{
 		ChannelID: 1337,
 		Message:   "Hello,World!"})
 	if err == nil {
@@ -111,126 +115,126 @@ func TestSendTrollboxMessage(t *testing.T) {
 }
 
 func TestGetTrollboxChannels(t *testing.T) {
-	_, err := b.GetTrollboxChannels()
+	_, err := b.GetTrollboxChannels(context.Background())
 	if err != nil {
 		t.Error("GetTrollboxChannels() error", err)
 	}
 }
 
 func TestGetTrollboxConnectedUsers(t *testing.T) {
-	_, err := b.GetTrollboxConnectedUsers()
+	_, err := b.GetTrollboxConnectedUsers(context.Background())
 	if err == nil {
 		t.Error("GetTrollboxConnectedUsers() Expected error")
 	}
 }
 
 func TestGetAccountExecutions(t *testing.T) {
-	_, err := b.GetAccountExecutions(&GenericRequestParams{})
+	_, err := b.GetAccountExecutions(context.Background(), &GenericRequestParams{})
 	if err == nil {
 		t.Error("GetAccountExecutions() Expected error")
 	}
 }
 
 func TestGetAccountExecutionTradeHistory(t *testing.T) {
-	_, err := b.GetAccountExecutionTradeHistory(&GenericRequestParams{})
+	_, err := b.GetAccountExecutionTradeHistory(context.Background(), &GenericRequestParams{})
 	if err == nil {
 		t.Error("GetAccountExecutionTradeHistory() Expected error")
 	}
 }
 
 func TestGetFundingHistory(t *testing.T) {
-	_, err := b.GetFundingHistory()
+	_, err := b.GetFundingHistory(context.Background())
 	if err == nil {
 		t.Error("GetFundingHistory() Expected error")
 	}
 }
 
 func TestGetInstruments(t *testing.T) {
-	_, err := b.GetInstruments(&GenericRequestParams{})
+	_, err := b.GetInstruments(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetInstruments() error", err)
 	}
 }
 
 func TestGetActiveInstruments(t *testing.T) {
-	_, err := b.GetActiveInstruments(&GenericRequestParams{})
+	_, err := b.GetActiveInstruments(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetActiveInstruments() error", err)
 	}
 }
 
 func TestGetActiveAndIndexInstruments(t *testing.T) {
-	_, err := b.GetActiveAndIndexInstruments()
+	_, err := b.GetActiveAndIndexInstruments(context.Background())
 	if err != nil {
 		t.Error("GetActiveAndIndexInstruments() error", err)
 	}
 }
 
 func TestGetActiveIntervals(t *testing.T) {
-	_, err := b.GetActiveIntervals()
+	_, err := b.GetActiveIntervals(context.Background())
 	if err == nil {
 		t.Error("GetActiveIntervals() Expected error")
 	}
 }
 
 func TestGetCompositeIndex(t *testing.T) {
-	_, err := b.GetCompositeIndex(&GenericRequestParams{})
+	_, err := b.GetCompositeIndex(context.Background(), &GenericRequestParams{})
 	if err == nil {
 		t.Error("GetCompositeIndex() Expected error")
 	}
 }
 
 func TestGetIndices(t *testing.T) {
-	_, err := b.GetIndices()
+	_, err := b.GetIndices(context.Background())
 	if err != nil {
 		t.Error("GetIndices() error", err)
 	}
 }
 
 func TestGetInsuranceFundHistory(t *testing.T) {
-	_, err := b.GetInsuranceFundHistory(&GenericRequestParams{})
+	_, err := b.GetInsuranceFundHistory(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetInsuranceFundHistory() error", err)
 	}
 }
 
 func TestGetLeaderboard(t *testing.T) {
-	_, err := b.GetLeaderboard(LeaderboardGetParams{})
+	_, err := b.GetLeaderboard(context.Background(), LeaderboardGetParams{})
 	if err != nil {
 		t.Error("GetLeaderboard() error", err)
 	}
 }
 
 func TestGetAliasOnLeaderboard(t *testing.T) {
-	_, err := b.GetAliasOnLeaderboard()
+	_, err := b.GetAliasOnLeaderboard(context.Background())
 	if err == nil {
 		t.Error("GetAliasOnLeaderboard() Expected error")
 	}
 }
 
 func TestGetLiquidationOrders(t *testing.T) {
-	_, err := b.GetLiquidationOrders(&GenericRequestParams{})
+	_, err := b.GetLiquidationOrders(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetLiquidationOrders() error", err)
 	}
 }
 
 func TestGetCurrentNotifications(t *testing.T) {
-	_, err := b.GetCurrentNotifications()
+	_, err := b.GetCurrentNotifications(context.Background())
 	if err == nil {
 		t.Error("GetCurrentNotifications() Expected error")
 	}
 }
 
 func TestAmendOrder(t *testing.T) {
-	_, err := b.AmendOrder(&OrderAmendParams{})
+	_, err := b.AmendOrder(context.Background(), &OrderAmendParams{})
 	if err == nil {
 		t.Error("AmendOrder() Expected error")
 	}
 }
 
 func TestCreateOrder(t *testing.T) {
-	_, err := b.CreateOrder(&OrderNewParams{Symbol: "XBTM15",
+	_, err := b.CreateOrder(context.Background(), &OrderNewParams{Symbol: "XBTM15",
 		Price:    219.0,
 		ClOrdID:  "mm_bitmex_1a/oemUeQ4CAJZgP3fjHsA",
 		OrderQty: 98})
@@ -240,126 +244,126 @@ func TestCreateOrder(t *testing.T) {
 }
 
 func TestCancelOrders(t *testing.T) {
-	_, err := b.CancelOrders(&OrderCancelParams{})
+	_, err := b.CancelOrders(context.Background(), &OrderCancelParams{})
 	if err == nil {
 		t.Error("CancelOrders() Expected error")
 	}
 }
 
 func TestCancelAllOrders(t *testing.T) {
-	_, err := b.CancelAllExistingOrders(OrderCancelAllParams{})
+	_, err := b.CancelAllExistingOrders(context.Background(), OrderCancelAllParams{})
 	if err == nil {
 		t.Error("CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error)", err)
 	}
 }
 
 func TestAmendBulkOrders(t *testing.T) {
-	_, err := b.AmendBulkOrders(OrderAmendBulkParams{})
+	_, err := b.AmendBulkOrders(context.Background(), OrderAmendBulkParams{})
 	if err == nil {
 		t.Error("AmendBulkOrders() Expected error")
 	}
 }
 
 func TestCreateBulkOrders(t *testing.T) {
-	_, err := b.CreateBulkOrders(OrderNewBulkParams{})
+	_, err := b.CreateBulkOrders(context.Background(), OrderNewBulkParams{})
 	if err == nil {
 		t.Error("CreateBulkOrders() Expected error")
 	}
 }
 
 func TestCancelAllOrdersAfterTime(t *testing.T) {
-	_, err := b.CancelAllOrdersAfterTime(OrderCancelAllAfterParams{})
+	_, err := b.CancelAllOrdersAfterTime(context.Background(), OrderCancelAllAfterParams{})
 	if err == nil {
 		t.Error("CancelAllOrdersAfterTime() Expected error")
 	}
 }
 
 func TestClosePosition(t *testing.T) {
-	_, err := b.ClosePosition(OrderClosePositionParams{})
+	_, err := b.ClosePosition(context.Background(), OrderClosePositionParams{})
 	if err == nil {
 		t.Error("ClosePosition() Expected error")
 	}
 }
 
 func TestGetOrderbook(t *testing.T) {
-	_, err := b.GetOrderbook(OrderBookGetL2Params{Symbol: "XBT"})
+	_, err := b.GetOrderbook(context.Background(), OrderBookGetL2Params{Symbol: "XBT"})
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
 }
 
 func TestGetPositions(t *testing.T) {
-	_, err := b.GetPositions(PositionGetParams{})
+	_, err := b.GetPositions(context.Background(), PositionGetParams{})
 	if err == nil {
 		t.Error("GetPositions() Expected error")
 	}
 }
 
 func TestIsolatePosition(t *testing.T) {
-	_, err := b.IsolatePosition(PositionIsolateMarginParams{Symbol: "XBT"})
+	_, err := b.IsolatePosition(context.Background(), PositionIsolateMarginParams{Symbol: "XBT"})
 	if err == nil {
 		t.Error("IsolatePosition() Expected error")
 	}
 }
 
 func TestLeveragePosition(t *testing.T) {
-	_, err := b.LeveragePosition(PositionUpdateLeverageParams{})
+	_, err := b.LeveragePosition(context.Background(), PositionUpdateLeverageParams{})
 	if err == nil {
 		t.Error("LeveragePosition() Expected error")
 	}
 }
 
 func TestUpdateRiskLimit(t *testing.T) {
-	_, err := b.UpdateRiskLimit(PositionUpdateRiskLimitParams{})
+	_, err := b.UpdateRiskLimit(context.Background(), PositionUpdateRiskLimitParams{})
 	if err == nil {
 		t.Error("UpdateRiskLimit() Expected error")
 	}
 }
 
 func TestTransferMargin(t *testing.T) {
-	_, err := b.TransferMargin(PositionTransferIsolatedMarginParams{})
+	_, err := b.TransferMargin(context.Background(), PositionTransferIsolatedMarginParams{})
 	if err == nil {
 		t.Error("TransferMargin() Expected error")
 	}
 }
 
 func TestGetQuotesByBuckets(t *testing.T) {
-	_, err := b.GetQuotesByBuckets(&QuoteGetBucketedParams{})
+	_, err := b.GetQuotesByBuckets(context.Background(), &QuoteGetBucketedParams{})
 	if err == nil {
 		t.Error("GetQuotesByBuckets() Expected error")
 	}
 }
 
 func TestGetSettlementHistory(t *testing.T) {
-	_, err := b.GetSettlementHistory(&GenericRequestParams{})
+	_, err := b.GetSettlementHistory(context.Background(), &GenericRequestParams{})
 	if err != nil {
 		t.Error("GetSettlementHistory() error", err)
 	}
 }
 
 func TestGetStats(t *testing.T) {
-	_, err := b.GetStats()
+	_, err := b.GetStats(context.Background())
 	if err != nil {
 		t.Error("GetStats() error", err)
 	}
 }
 
 func TestGetStatsHistorical(t *testing.T) {
-	_, err := b.GetStatsHistorical()
+	_, err := b.GetStatsHistorical(context.Background())
 	if err != nil {
 		t.Error("GetStatsHistorical() error", err)
 	}
 }
 
 func TestGetStatSummary(t *testing.T) {
-	_, err := b.GetStatSummary()
+	_, err := b.GetStatSummary(context.Background())
 	if err != nil {
 		t.Error("GetStatSummary() error", err)
 	}
 }
 
 func TestGetTrade(t *testing.T) {
-	_, err := b.GetTrade(&GenericRequestParams{
+	_, err := b.GetTrade(context.Background(), &GenericRequestParams{
 		Symbol:    "XBTUSD",
 		StartTime: time.Now().Format(time.RFC3339),
 		Reverse:   true})
@@ -369,7 +373,7 @@ func TestGetTrade(t *testing.T) {
 }
 
 func TestGetPreviousTrades(t *testing.T) {
-	_, err := b.GetPreviousTrades(&TradeGetBucketedParams{})
+	_, err := b.GetPreviousTrades(context.Background(), &TradeGetBucketedParams{})
 	if err == nil {
 		t.Error("GetPreviousTrades() Expected error")
 	}
@@ -387,7 +391,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -481,7 +485,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -496,7 +500,7 @@ func TestGetOrderHistory(t *testing.T) {
 			currency.BTC)},
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -522,11 +526,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -547,7 +551,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -569,7 +573,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -585,12 +589,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := b.UpdateAccountInfo()
+		_, err := b.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
 	} else {
-		_, err := b.UpdateAccountInfo()
+		_, err := b.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() error")
 		}
@@ -601,7 +605,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := b.ModifyOrder(&order.Modify{OrderID: "1337"})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{OrderID: "1337"})
 	if err == nil {
 		t.Error("ModifyOrder() error")
 	}
@@ -622,7 +626,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -637,7 +641,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -649,7 +653,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -657,12 +661,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -704,3 +708,187 @@ func TestWsAuth(t *testing.T) {
 	}
 	timer.Stop()
 }
+
+func TestWsExecutionReport(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"execID":"0193e879-cb6f-2891-d099-2c4eb40fee21","orderID":"00000000-0000-0000-0000-000000000000","account":2,"symbol":"ETHUSD","side":"Sell","lastQty":1,"lastPx":1134.37,"execType":"Trade","ordStatus":"Filled","lastLiquidityInd":"AddedLiquidity","execComm":-2500,"settlCurrency":"XBt","transactTime":"2019-11-24T05:06:58.366Z","timestamp":"2019-11-24T05:06:58.366Z"}`)
+	var e Execution
+	err := json.Unmarshal(pressXToJSON, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := b.wsExecutionReport(&e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = report.Validate(); err != nil {
+		t.Error(err)
+	}
+	if report.Liquidity != order.Maker {
+		t.Errorf("expected maker liquidity, received %v", report.Liquidity)
+	}
+	if report.FeeCurrency != currency.XBT {
+		t.Errorf("expected XBT fee currency, received %v", report.FeeCurrency)
+	}
+	if !report.Fee.Equal(decimal.NewFromFloat(-0.000025)) {
+		t.Errorf("expected -0.000025 rebate, received %v", report.Fee)
+	}
+	e.Symbol = "LOLCAT"
+	if _, err = b.wsExecutionReport(&e); err == nil {
+		t.Error("expected error on unknown symbol")
+	}
+}
+
+func TestWsLiquidation(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"table":"liquidation","action":"insert","data":[{"orderID":"b3ab2d9e-2a1d-4b36-8fc3-7b4e4a6c1f2d","symbol":"ETHUSD","side":"Sell","price":118.25,"leavesQty":2500}]}`)
+	var liquidations LiquidationData
+	err := json.Unmarshal(pressXToJSON, &liquidations)
+	if err != nil {
+		t.Fatal(err)
+	}
+	received := time.Now()
+	l, err := b.wsLiquidation(&liquidations.Data[0], received)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if l.Side != order.Sell || l.Price != 118.25 || l.Amount != 2500 ||
+		!l.Timestamp.Equal(received) || l.Pair.String() != "ETHUSD" {
+		t.Errorf("unexpected liquidation %+v", l)
+	}
+	liquidations.Data[0].Symbol = "LOLCAT"
+	if _, err = b.wsLiquidation(&liquidations.Data[0], received); err == nil {
+		t.Error("expected error on unknown symbol")
+	}
+}
+
+func TestFundingPayment(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"execID":"5d2a3c71-8e42-b4c2-5f10-9a4c3e0d1b27","orderID":"00000000-0000-0000-0000-000000000000","account":2,"symbol":"XBTUSD","lastQty":1000,"lastPx":7301.45,"execType":"Funding","commission":0.0001,"execComm":1370,"settlCurrency":"XBt","transactTime":"2019-11-24T04:00:00.000Z","timestamp":"2019-11-24T04:00:00.000Z"}`)
+	var e Execution
+	err := json.Unmarshal(pressXToJSON, &e)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := currency.NewPairFromString("XBTUSD")
+	payment, err := b.fundingPayment(&e, p, asset.PerpetualContract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if payment.Amount != -0.0000137 {
+		t.Errorf("expected -0.0000137 paid, received %v", payment.Amount)
+	}
+	if payment.Rate != 0.0001 {
+		t.Errorf("expected 0.0001 rate, received %v", payment.Rate)
+	}
+	if payment.Currency != currency.XBT {
+		t.Errorf("expected XBT currency, received %v", payment.Currency)
+	}
+	e.TransactTime = "yesterday"
+	if _, err = b.fundingPayment(&e, p, asset.PerpetualContract); err == nil {
+		t.Error("expected error on invalid transaction time")
+	}
+}
+
+func TestOpenInterest(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"symbol":"XBTUSD","settlCurrency":"XBt","openInterest":1000000,"openValue":13698630137,"timestamp":"2020-03-25T12:00:00.000Z"}`)
+	var i Instrument
+	err := json.Unmarshal(pressXToJSON, &i)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := currency.NewPairFromString("XBTUSD")
+	oi := b.openInterest(&i, p, asset.PerpetualContract)
+	if oi.Amount != 1000000 || oi.Value != 136.98630137 || oi.Currency != currency.XBT {
+		t.Errorf("unexpected open interest %+v", oi)
+	}
+	if oi.LongShortRatio != 0 || oi.Timestamp.IsZero() {
+		t.Errorf("unexpected open interest %+v", oi)
+	}
+}
+
+func TestGetOpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetOpenInterest(context.Background(),
+		currency.NewPairFromString("XBTUSD"),
+		asset.PerpetualContract)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestGetFundingPayments(t *testing.T) {
+	t.Parallel()
+	_, err := b.GetFundingPayments(context.Background(),
+		currency.NewPairFromString("BCHZ19"),
+		asset.Futures,
+		time.Now().Add(-time.Hour),
+		time.Now())
+	if err == nil {
+		t.Error("expected error for futures funding payments")
+	}
+}
+
+func TestMarginPosition(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"account":1,"symbol":"ETHUSD","currency":"XBt","crossMargin":false,"currentQty":-100,"maintMargin":2000000,"posMaint":500000,"markPrice":9000,"liquidationPrice":9500,"isOpen":true}`)
+	var p Position
+	err := json.Unmarshal(pressXToJSON, &p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m, err := b.marginPosition(&p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !m.Pair.Equal(currency.NewPairFromString("ETHUSD")) || m.Size != -100 || !m.Currency.Match(currency.XBT) {
+		t.Errorf("unexpected position %+v", m)
+	}
+	if m.Margin != 0.02 || m.MaintenanceMargin != 0.005 || !m.Isolated {
+		t.Errorf("expected the margin in XBT, received %+v", m)
+	}
+}
+
+func TestGetMarginPositions(t *testing.T) {
+	_, err := b.GetMarginPositions(context.Background())
+	if err == nil {
+		t.Error("GetMarginPositions() Expected error")
+	}
+}
+
+func TestAddMargin(t *testing.T) {
+	err := b.AddMargin(context.Background(), currency.NewPairFromString("XBTUSD"), asset.PerpetualContract, decimal.Zero)
+	if err == nil {
+		t.Error("expected an error for a zero amount")
+	}
+}
+
+func TestAPIKeyPermissions(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"id":"abc","secret":"","name":"bot","nonce":0,"cidr":"10.0.0.1/32","permissions":["order","orderCancel"],"enabled":true,"userId":1,"created":"2020-03-01T00:00:00.000Z"}`)
+	var k APIKey
+	err := json.Unmarshal(pressXToJSON, &k)
+	if err != nil {
+		t.Fatal(err)
+	}
+	perms := apiKeyPermissions(&k)
+	if !perms.Read || !perms.Trade || perms.Withdraw {
+		t.Errorf("unexpected permissions %+v", perms)
+	}
+	if len(perms.IPWhitelist) != 1 || perms.IPWhitelist[0] != "10.0.0.1/32" {
+		t.Errorf("unexpected IP whitelist %v", perms.IPWhitelist)
+	}
+
+	perms = apiKeyPermissions(&APIKey{Cidr: "0.0.0.0/0"})
+	if !perms.Read || perms.Trade || perms.Withdraw || len(perms.IPWhitelist) != 0 {
+		t.Errorf("expected unrestricted read only key got %+v", perms)
+	}
+}
+
+func TestGetAPIKeyPermissions(t *testing.T) {
+	_, err := b.GetAPIKeyPermissions(context.Background())
+	if err == nil {
+		t.Error("GetAPIKeyPermissions() Expected error")
+	}
+}
@@ -11,6 +11,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -127,7 +128,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 			quickCapture := make(map[string]interface{})
 			err = json.Unmarshal(resp.Raw, &quickCapture)
 			if err != nil {
-				b.Websocket.DataHandler <- err
+				b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 				continue
 			}
 
@@ -135,10 +136,12 @@ func (b *Bitmex) wsHandleIncomingData() {
 			if _, ok := quickCapture["status"]; ok {
 				err = json.Unmarshal(resp.Raw, &respError)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
-				b.Websocket.DataHandler <- errors.New(respError.Error)
+				b.Websocket.DataHandler <- wshandler.NewError(b.Name,
+					wshandler.ErrorKindFromStatus(respError.Status),
+					errors.New(respError.Error))
 				continue
 			}
 
@@ -146,7 +149,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 				var decodedResp WebsocketSubscribeResp
 				err := json.Unmarshal(resp.Raw, &decodedResp)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -167,13 +170,13 @@ func (b *Bitmex) wsHandleIncomingData() {
 					continue
 				}
 
-				b.Websocket.DataHandler <- fmt.Errorf("%s websocket error: Unable to subscribe %s",
-					b.Name, decodedResp.Subscribe)
+				b.Websocket.DataHandler <- wshandler.NewSubscriptionError(b.Name, nil,
+					fmt.Errorf("unable to subscribe %s", decodedResp.Subscribe))
 			} else if _, ok := quickCapture["table"]; ok {
 				var decodedResp WebsocketMainResponse
 				err := json.Unmarshal(resp.Raw, &decodedResp)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -182,7 +185,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var orderbooks OrderBookData
 					err = json.Unmarshal(resp.Raw, &orderbooks)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 
@@ -207,7 +210,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var trades TradeData
 					err = json.Unmarshal(resp.Raw, &trades)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 
@@ -234,11 +237,36 @@ func (b *Bitmex) wsHandleIncomingData() {
 						}
 					}
 
+				case bitmexWSLiquidation:
+					var liquidations LiquidationData
+					err = json.Unmarshal(resp.Raw, &liquidations)
+					if err != nil {
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
+						continue
+					}
+
+					// Only inserts are new liquidations, updates and deletes
+					// follow the liquidation order as it fills
+					if liquidations.Action != bitmexActionInsertData {
+						continue
+					}
+
+					received := time.Now()
+					for i := range liquidations.Data {
+						var l wshandler.LiquidationData
+						l, err = b.wsLiquidation(&liquidations.Data[i], received)
+						if err != nil {
+							b.Websocket.DataHandler <- err
+							continue
+						}
+						b.Websocket.DataHandler <- l
+					}
+
 				case bitmexWSAnnouncement:
 					var announcement AnnouncementData
 					err = json.Unmarshal(resp.Raw, &announcement)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 
@@ -251,7 +279,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var response WsAffiliateResponse
 					err = json.Unmarshal(resp.Raw, &response)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 					b.Websocket.DataHandler <- response
@@ -259,15 +287,27 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var response WsExecutionResponse
 					err = json.Unmarshal(resp.Raw, &response)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
+					for i := range response.Data {
+						if response.Data[i].ExecType != bitmexExecTypeTrade {
+							continue
+						}
+						var report order.ExecutionReport
+						report, err = b.wsExecutionReport(&response.Data[i])
+						if err != nil {
+							b.Websocket.DataHandler <- err
+							continue
+						}
+						b.Websocket.DataHandler <- report
+					}
 					b.Websocket.DataHandler <- response
 				case bitmexWSOrder:
 					var response WsOrderResponse
 					err = json.Unmarshal(resp.Raw, &response)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 					b.Websocket.DataHandler <- response
@@ -275,7 +315,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var response WsMarginResponse
 					err = json.Unmarshal(resp.Raw, &response)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 					b.Websocket.DataHandler <- response
@@ -283,7 +323,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var response WsPositionResponse
 					err = json.Unmarshal(resp.Raw, &response)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 					b.Websocket.DataHandler <- response
@@ -291,7 +331,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var response WsPrivateNotificationsResponse
 					err = json.Unmarshal(resp.Raw, &response)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 					b.Websocket.DataHandler <- response
@@ -299,7 +339,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var response WsTransactResponse
 					err = json.Unmarshal(resp.Raw, &response)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 					b.Websocket.DataHandler <- response
@@ -307,7 +347,7 @@ func (b *Bitmex) wsHandleIncomingData() {
 					var response WsWalletResponse
 					err = json.Unmarshal(resp.Raw, &response)
 					if err != nil {
-						b.Websocket.DataHandler <- err
+						b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 						continue
 					}
 					b.Websocket.DataHandler <- response
@@ -320,6 +360,76 @@ func (b *Bitmex) wsHandleIncomingData() {
 	}
 }
 
+// wsExecutionReport converts a trade execution into a normalised execution
+// report. Commission is reported in the smallest unit of the settlement
+// currency and is negative when a rebate is paid
+func (b *Bitmex) wsExecutionReport(e *Execution) (order.ExecutionReport, error) {
+	p := currency.NewPairFromString(e.Symbol)
+	a, err := b.GetPairAssetType(p)
+	if err != nil {
+		return order.ExecutionReport{}, err
+	}
+	side, err := order.StringToOrderSide(e.Side)
+	if err != nil {
+		return order.ExecutionReport{}, err
+	}
+	timestamp, err := time.Parse(time.RFC3339, e.TransactTime)
+	if err != nil {
+		return order.ExecutionReport{}, err
+	}
+	feeCurrency, feePlaces := settlementCurrency(e.SettlCurrency)
+	return order.ExecutionReport{
+		Exchange:    b.Name,
+		AssetType:   a,
+		Pair:        p,
+		OrderID:     e.OrderID,
+		TradeID:     e.ExecID,
+		Side:        side,
+		Price:       decimal.NewFromFloat(e.LastPx),
+		Amount:      decimal.NewFromInt(e.LastQty),
+		Fee:         decimal.New(e.ExecComm, -feePlaces),
+		FeeCurrency: feeCurrency,
+		Liquidity:   order.StringToLiquidity(e.LastLiquidityInd),
+		Timestamp:   timestamp,
+	}, nil
+}
+
+// wsLiquidation converts a liquidation order into normalised liquidation
+// data. BitMEX doesn't timestamp liquidations so the time received is used
+func (b *Bitmex) wsLiquidation(l *Liquidation, received time.Time) (wshandler.LiquidationData, error) {
+	p := currency.NewPairFromString(l.Symbol)
+	a, err := b.GetPairAssetType(p)
+	if err != nil {
+		return wshandler.LiquidationData{}, err
+	}
+	side, err := order.StringToOrderSide(l.Side)
+	if err != nil {
+		return wshandler.LiquidationData{}, err
+	}
+	return wshandler.LiquidationData{
+		Timestamp: received,
+		Pair:      p,
+		AssetType: a,
+		Exchange:  b.Name,
+		Side:      side,
+		Price:     l.Price,
+		Amount:    float64(l.LeavesQty),
+	}, nil
+}
+
+// settlementCurrency returns the currency code and the decimal places of the
+// smallest unit of a BitMEX settlement currency such as XBt
+func settlementCurrency(settlCurrency string) (currency.Code, int32) {
+	switch settlCurrency {
+	case "XBt":
+		return currency.XBT, 8
+	case "USDt":
+		return currency.USDT, 6
+	default:
+		return currency.NewCode(settlCurrency), 0
+	}
+}
+
 // ProcessOrderbook processes orderbook updates
 func (b *Bitmex) processOrderbook(data []OrderBookL2, action string, currencyPair currency.Pair, assetType asset.Item) error { // nolint: unparam
 	if len(data) < 1 {
@@ -406,7 +516,7 @@ func (b *Bitmex) GenerateDefaultSubscriptions() {
 		}
 	}
 
-	channels := []string{bitmexWSOrderbookL2, bitmexWSTrade}
+	channels := []string{bitmexWSOrderbookL2, bitmexWSTrade, bitmexWSLiquidation}
 	subscriptions := []wshandler.WebsocketChannelSubscription{
 		{
 			Channel: bitmexWSAnnouncement,
@@ -488,7 +598,7 @@ func (b *Bitmex) websocketSendAuth() error {
 		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", b.Name)
 	}
 	b.Websocket.SetCanUseAuthenticatedEndpoints(true)
-	timestamp := time.Now().Add(time.Hour * 1).Unix()
+	timestamp := b.Requester.Now().Add(time.Hour * 1).Unix()
 	newTimestamp := strconv.FormatInt(timestamp, 10)
 	hmac := crypto.GetHMAC(crypto.HashSHA256,
 		[]byte("GET/realtime"+newTimestamp),
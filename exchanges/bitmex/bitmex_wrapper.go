@@ -1,12 +1,15 @@
 package bitmex
 
 import (
+	"context"
 	"errors"
-	"math"
+	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -36,7 +39,7 @@ func (b *Bitmex) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -179,10 +182,13 @@ func (b *Bitmex) Setup(exch *config.ExchangeConfig) error {
 	b.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         b.Name,
 		URL:                  b.Websocket.GetWebsocketURL(),
+		AlternateURLs:        b.Websocket.GetAlternateURLs(),
 		ProxyURL:             b.Websocket.GetProxyAddress(),
 		Verbose:              b.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	b.Websocket.Orderbook.Setup(
@@ -215,15 +221,15 @@ func (b *Bitmex) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", b.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Bitmex) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	marketInfo, err := b.GetActiveInstruments(&GenericRequestParams{})
+func (b *Bitmex) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	marketInfo, err := b.GetActiveInstruments(ctx, &GenericRequestParams{})
 	if err != nil {
 		return nil, err
 	}
@@ -238,8 +244,8 @@ func (b *Bitmex) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Bitmex) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+func (b *Bitmex) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -283,9 +289,9 @@ func (b *Bitmex) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitmex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitmex) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tick, err := b.GetActiveInstruments(&GenericRequestParams{})
+	tick, err := b.GetActiveInstruments(ctx, &GenericRequestParams{})
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -316,28 +322,28 @@ func (b *Bitmex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *Bitmex) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitmex) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (b *Bitmex) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitmex) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitmex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitmex) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 
-	orderbookNew, err := b.GetOrderbook(OrderBookGetL2Params{
+	orderbookNew, err := b.GetOrderbook(ctx, OrderBookGetL2Params{
 		Symbol: b.FormatExchangeCurrency(p, assetType).String(),
 		Depth:  500})
 	if err != nil {
@@ -371,10 +377,10 @@ func (b *Bitmex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Bitmex exchange
-func (b *Bitmex) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Bitmex) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 
-	bal, err := b.GetAllUserMargin()
+	bal, err := b.GetAllUserMargin(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -384,7 +390,7 @@ func (b *Bitmex) UpdateAccountInfo() (account.Holdings, error) {
 	for i := range bal {
 		balances = append(balances, account.Balance{
 			CurrencyName: currency.NewCode(bal[i].Currency),
-			TotalValue:   float64(bal[i].WalletBalance),
+			TotalValue:   decimal.NewFromInt(bal[i].WalletBalance),
 		})
 	}
 
@@ -402,10 +408,10 @@ func (b *Bitmex) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Bitmex) FetchAccountInfo() (account.Holdings, error) {
+func (b *Bitmex) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -413,23 +419,174 @@ func (b *Bitmex) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitmex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitmex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
+// GetFundingPayments returns funding paid or received on perpetual contract
+// positions
+func (b *Bitmex) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	if assetType != asset.PerpetualContract {
+		return nil, fmt.Errorf("%s funding is only paid on %s positions",
+			b.Name,
+			asset.PerpetualContract)
+	}
+	params := GenericRequestParams{
+		Symbol:    b.FormatExchangeCurrency(p, assetType).String(),
+		Filter:    `{"execType":"` + bitmexExecTypeFunding + `"}`,
+		StartTime: start.UTC().Format(time.RFC3339),
+		EndTime:   end.UTC().Format(time.RFC3339),
+		Count:     bitmexMaxResultCount,
+	}
+	var payments []exchange.FundingPayment
+	for {
+		executions, err := b.GetAccountExecutionTradeHistory(ctx, &params)
+		if err != nil {
+			return nil, err
+		}
+		for i := range executions {
+			var payment exchange.FundingPayment
+			payment, err = b.fundingPayment(&executions[i], p, assetType)
+			if err != nil {
+				return nil, err
+			}
+			payments = append(payments, payment)
+		}
+		if len(executions) < bitmexMaxResultCount {
+			return payments, nil
+		}
+		params.Start += bitmexMaxResultCount
+	}
+}
+
+// GetMarginPositions returns the open positions and the margin backing them
+func (b *Bitmex) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	positions, err := b.GetPositions(ctx, PositionGetParams{Filter: `{"isOpen":true}`})
+	if err != nil {
+		return nil, err
+	}
+	resp := make([]exchange.MarginPosition, 0, len(positions))
+	for i := range positions {
+		var m exchange.MarginPosition
+		m, err = b.marginPosition(&positions[i])
+		if err != nil {
+			return nil, err
+		}
+		resp = append(resp, m)
+	}
+	return resp, nil
+}
+
+// marginPosition converts a position into a margin position. BitMEX reports
+// margin in the smallest unit of the settlement currency, maintMargin being
+// the position's margin balance and posMaint its maintenance requirement
+func (b *Bitmex) marginPosition(p *Position) (exchange.MarginPosition, error) {
+	pair := currency.NewPairFromString(p.Symbol)
+	a, err := b.GetPairAssetType(pair)
+	if err != nil {
+		return exchange.MarginPosition{}, err
+	}
+	settlement, places := settlementCurrency(p.Currency)
+	return exchange.MarginPosition{
+		Exchange:          b.Name,
+		AssetType:         a,
+		Pair:              pair,
+		Size:              float64(p.CurrentQty),
+		Currency:          settlement,
+		Margin:            decimal.New(p.MaintMargin, -places).Float64(),
+		MaintenanceMargin: decimal.New(p.PosMaint, -places).Float64(),
+		MarkPrice:         p.MarkPrice,
+		LiquidationPrice:  p.LiquidationPrice,
+		Isolated:          !p.CrossMargin,
+	}, nil
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (b *Bitmex) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	if amount.Sign() <= 0 {
+		return errors.New("margin amount must be greater than 0")
+	}
+	symbol := b.FormatExchangeCurrency(p, assetType).String()
+	positions, err := b.GetPositions(ctx, PositionGetParams{Filter: `{"symbol":"` + symbol + `"}`})
+	if err != nil {
+		return err
+	}
+	if len(positions) == 0 || !positions[0].IsOpen {
+		return fmt.Errorf("%s has no open %s position", b.Name, symbol)
+	}
+	if positions[0].CrossMargin {
+		return fmt.Errorf("%s %s position is cross margined", b.Name, symbol)
+	}
+	_, places := settlementCurrency(positions[0].Currency)
+	_, err = b.TransferMargin(ctx, PositionTransferIsolatedMarginParams{
+		Symbol: symbol,
+		Amount: amount.Mul(decimal.New(1, places)).IntPart(),
+	})
+	return err
+}
+
+// GetOpenInterest returns the open interest of a contract. BitMEX doesn't
+// publish a long/short ratio
+func (b *Bitmex) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	symbol := b.FormatExchangeCurrency(p, assetType).String()
+	instruments, err := b.GetInstruments(ctx, &GenericRequestParams{Symbol: symbol})
+	if err != nil {
+		return exchange.OpenInterest{}, err
+	}
+	if len(instruments) == 0 {
+		return exchange.OpenInterest{}, fmt.Errorf("%s instrument %s not found", b.Name, symbol)
+	}
+	return b.openInterest(&instruments[0], p, assetType), nil
+}
+
+// openInterest converts an instrument into its open interest. Open value is
+// reported in the smallest unit of the settlement currency
+func (b *Bitmex) openInterest(i *Instrument, p currency.Pair, assetType asset.Item) exchange.OpenInterest {
+	settlement, places := settlementCurrency(i.SettlCurrency)
+	return exchange.OpenInterest{
+		Exchange:  b.Name,
+		AssetType: assetType,
+		Pair:      p,
+		Amount:    float64(i.OpenInterest),
+		Value:     decimal.New(i.OpenValue, -places).Float64(),
+		Currency:  settlement,
+		Timestamp: i.Timestamp,
+	}
+}
+
+// fundingPayment converts a funding execution into a funding payment. BitMEX
+// reports funding paid as a positive commission, so the sign is flipped
+func (b *Bitmex) fundingPayment(e *Execution, p currency.Pair, assetType asset.Item) (exchange.FundingPayment, error) {
+	timestamp, err := time.Parse(time.RFC3339, e.TransactTime)
+	if err != nil {
+		return exchange.FundingPayment{}, err
+	}
+	settlement, places := settlementCurrency(e.SettlCurrency)
+	amount := decimal.New(-e.ExecComm, -places).Float64()
+	return exchange.FundingPayment{
+		Exchange:  b.Name,
+		AssetType: assetType,
+		Pair:      p,
+		Rate:      e.Commission,
+		Amount:    amount,
+		Currency:  settlement,
+		Timestamp: timestamp,
+	}, nil
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitmex) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *Bitmex) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *Bitmex) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
 	}
 
-	if math.Mod(s.Amount, 1) != 0 {
+	if !s.Amount.Equal(s.Amount.Truncate(0)) {
 		return submitOrderResponse,
 			errors.New("order contract amount can not have decimals")
 	}
@@ -437,15 +594,15 @@ func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	var orderNewParams = OrderNewParams{
 		OrdType:  s.OrderSide.String(),
 		Symbol:   s.Pair.String(),
-		OrderQty: s.Amount,
+		OrderQty: s.Amount.Float64(),
 		Side:     s.OrderSide.String(),
 	}
 
 	if s.OrderType == order.Limit {
-		orderNewParams.Price = s.Price
+		orderNewParams.Price = s.Price.Float64()
 	}
 
-	response, err := b.CreateOrder(&orderNewParams)
+	response, err := b.CreateOrder(ctx, &orderNewParams)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -462,18 +619,18 @@ func (b *Bitmex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitmex) ModifyOrder(action *order.Modify) (string, error) {
+func (b *Bitmex) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	var params OrderAmendParams
 
-	if math.Mod(action.Amount, 1) != 0 {
+	if !action.Amount.Equal(action.Amount.Truncate(0)) {
 		return "", errors.New("contract amount can not have decimals")
 	}
 
 	params.OrderID = action.OrderID
-	params.OrderQty = int32(action.Amount)
-	params.Price = action.Price
+	params.OrderQty = int32(action.Amount.IntPart())
+	params.Price = action.Price.Float64()
 
-	order, err := b.AmendOrder(&params)
+	order, err := b.AmendOrder(ctx, &params)
 	if err != nil {
 		return "", err
 	}
@@ -482,21 +639,21 @@ func (b *Bitmex) ModifyOrder(action *order.Modify) (string, error) {
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitmex) CancelOrder(order *order.Cancel) error {
+func (b *Bitmex) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	var params = OrderCancelParams{
 		OrderID: order.OrderID,
 	}
-	_, err := b.CancelOrders(&params)
+	_, err := b.CancelOrders(ctx, &params)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitmex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (b *Bitmex) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
 	var emptyParams OrderCancelAllParams
-	orders, err := b.CancelAllExistingOrders(emptyParams)
+	orders, err := b.CancelAllExistingOrders(ctx, emptyParams)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -511,19 +668,19 @@ func (b *Bitmex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitmex) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Bitmex) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitmex) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	return b.GetCryptoDepositAddress(cryptocurrency.String())
+func (b *Bitmex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	return b.GetCryptoDepositAddress(ctx, cryptocurrency.String())
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitmex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (b *Bitmex) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	var request = UserRequestWithdrawalParams{
 		Address:  withdrawRequest.Address,
 		Amount:   withdrawRequest.Amount,
@@ -534,7 +691,7 @@ func (b *Bitmex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoReq
 		request.Fee = withdrawRequest.FeeAmount
 	}
 
-	resp, err := b.UserRequestWithdrawal(request)
+	resp, err := b.UserRequestWithdrawal(ctx, request)
 	if err != nil {
 		return "", err
 	}
@@ -544,13 +701,13 @@ func (b *Bitmex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoReq
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitmex) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bitmex) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitmex) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bitmex) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -560,7 +717,7 @@ func (b *Bitmex) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bitmex) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bitmex) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -570,12 +727,12 @@ func (b *Bitmex) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error)
 
 // GetActiveOrders retrieves any orders that are active/open
 // This function is not concurrency safe due to orderSide/orderType maps
-func (b *Bitmex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitmex) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
 	params := OrdersRequest{}
 	params.Filter = "{\"open\":true}"
 
-	resp, err := b.GetOrders(&params)
+	resp, err := b.GetOrders(ctx, &params)
 	if err != nil {
 		return nil, err
 	}
@@ -588,8 +745,8 @@ func (b *Bitmex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 		}
 
 		orderDetail := order.Detail{
-			Price:     resp[i].Price,
-			Amount:    float64(resp[i].OrderQty),
+			Price:     decimal.NewFromFloat(resp[i].Price),
+			Amount:    decimal.NewFromInt(resp[i].OrderQty),
 			Exchange:  b.Name,
 			ID:        resp[i].OrderID,
 			OrderSide: orderSide,
@@ -613,10 +770,10 @@ func (b *Bitmex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
 // This function is not concurrency safe due to orderSide/orderType maps
-func (b *Bitmex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitmex) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
 	params := OrdersRequest{}
-	resp, err := b.GetOrders(&params)
+	resp, err := b.GetOrders(ctx, &params)
 	if err != nil {
 		return nil, err
 	}
@@ -629,8 +786,8 @@ func (b *Bitmex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 		}
 
 		orderDetail := order.Detail{
-			Price:     resp[i].Price,
-			Amount:    float64(resp[i].OrderQty),
+			Price:     decimal.NewFromFloat(resp[i].Price),
+			Amount:    decimal.NewFromInt(resp[i].OrderQty),
 			Exchange:  b.Name,
 			ID:        resp[i].OrderID,
 			OrderSide: orderSide,
@@ -651,18 +808,16 @@ func (b *Bitmex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (b *Bitmex) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	b.Websocket.SubscribeToChannels(channels)
-	return nil
+	return b.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (b *Bitmex) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	b.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return b.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -677,7 +832,39 @@ func (b *Bitmex) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Bitmex) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Bitmex) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *Bitmex) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	keys, err := b.GetAPIKeys(ctx)
+	if err != nil {
+		return exchange.APIKeyPermissions{}, err
+	}
+	for i := range keys {
+		if keys[i].ID == b.API.Credentials.Key {
+			return apiKeyPermissions(&keys[i]), nil
+		}
+	}
+	return exchange.APIKeyPermissions{}, errors.New("api key not found in account keys")
+}
+
+// apiKeyPermissions converts a BitMEX API key into its permissions. Keys
+// without permissions are read only and a CIDR of 0.0.0.0/0 is unrestricted
+func apiKeyPermissions(k *APIKey) exchange.APIKeyPermissions {
+	perms := exchange.APIKeyPermissions{Read: true}
+	for i := range k.Permissions {
+		switch k.Permissions[i] {
+		case "order", "orderCancel":
+			perms.Trade = true
+		case "withdraw":
+			perms.Withdraw = true
+		}
+	}
+	if k.Cidr != "" && k.Cidr != "0.0.0.0/0" {
+		perms.IPWhitelist = []string{k.Cidr}
+	}
+	return perms
+}
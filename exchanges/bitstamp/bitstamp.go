@@ -2,6 +2,7 @@ package bitstamp
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -66,17 +67,17 @@ type Bitstamp struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Bitstamp) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (b *Bitstamp) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bitstamp) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bitstamp) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		balance, err := b.GetBalance()
+		balance, err := b.GetBalance(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -145,7 +146,7 @@ func (b *Bitstamp) CalculateTradingFee(base, quote currency.Code, purchasePrice,
 }
 
 // GetTicker returns ticker information
-func (b *Bitstamp) GetTicker(currency string, hourly bool) (*Ticker, error) {
+func (b *Bitstamp) GetTicker(ctx context.Context, currency string, hourly bool) (*Ticker, error) {
 	response := Ticker{}
 	tickerEndpoint := bitstampAPITicker
 
@@ -160,13 +161,13 @@ func (b *Bitstamp) GetTicker(currency string, hourly bool) (*Ticker, error) {
 		tickerEndpoint,
 		strings.ToLower(currency),
 	)
-	return &response, b.SendHTTPRequest(path, &response)
+	return &response, b.SendHTTPRequest(ctx, path, &response)
 }
 
 // GetOrderbook Returns a JSON dictionary with "bids" and "asks". Each is a list
 // of open orders and each order is represented as a list holding the price and
 // the amount.
-func (b *Bitstamp) GetOrderbook(currency string) (Orderbook, error) {
+func (b *Bitstamp) GetOrderbook(ctx context.Context, currency string) (Orderbook, error) {
 	type response struct {
 		Timestamp int64      `json:"timestamp,string"`
 		Bids      [][]string `json:"bids"`
@@ -182,7 +183,7 @@ func (b *Bitstamp) GetOrderbook(currency string) (Orderbook, error) {
 		strings.ToLower(currency),
 	)
 
-	err := b.SendHTTPRequest(path, &resp)
+	err := b.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -223,7 +224,7 @@ func (b *Bitstamp) GetOrderbook(currency string) (Orderbook, error) {
 
 // GetTradingPairs returns a list of trading pairs which Bitstamp
 // currently supports
-func (b *Bitstamp) GetTradingPairs() ([]TradingPair, error) {
+func (b *Bitstamp) GetTradingPairs(ctx context.Context) ([]TradingPair, error) {
 	var result []TradingPair
 
 	path := fmt.Sprintf("%s/v%s/%s",
@@ -231,13 +232,13 @@ func (b *Bitstamp) GetTradingPairs() ([]TradingPair, error) {
 		bitstampAPIVersion,
 		bitstampAPITradingPairsInfo)
 
-	return result, b.SendHTTPRequest(path, &result)
+	return result, b.SendHTTPRequest(ctx, path, &result)
 }
 
 // GetTransactions returns transaction information
 // value paramater ["time"] = "minute", "hour", "day" will collate your
 // response into time intervals. Implementation of value in test code.
-func (b *Bitstamp) GetTransactions(currencyPair string, values url.Values) ([]Transactions, error) {
+func (b *Bitstamp) GetTransactions(ctx context.Context, currencyPair string, values url.Values) ([]Transactions, error) {
 	var transactions []Transactions
 	path := common.EncodeURLValues(
 		fmt.Sprintf(
@@ -250,21 +251,21 @@ func (b *Bitstamp) GetTransactions(currencyPair string, values url.Values) ([]Tr
 		values,
 	)
 
-	return transactions, b.SendHTTPRequest(path, &transactions)
+	return transactions, b.SendHTTPRequest(ctx, path, &transactions)
 }
 
 // GetEURUSDConversionRate returns the conversion rate between Euro and USD
-func (b *Bitstamp) GetEURUSDConversionRate() (EURUSDConversionRate, error) {
+func (b *Bitstamp) GetEURUSDConversionRate(ctx context.Context) (EURUSDConversionRate, error) {
 	rate := EURUSDConversionRate{}
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bitstampAPIEURUSD)
 
-	return rate, b.SendHTTPRequest(path, &rate)
+	return rate, b.SendHTTPRequest(ctx, path, &rate)
 }
 
 // GetBalance returns full balance of currency held on the exchange
-func (b *Bitstamp) GetBalance() (Balances, error) {
+func (b *Bitstamp) GetBalance(ctx context.Context) (Balances, error) {
 	var balance map[string]string
-	err := b.SendAuthenticatedHTTPRequest(bitstampAPIBalance, true, nil, &balance)
+	err := b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIBalance, true, nil, &balance)
 	if err != nil {
 		return nil, err
 	}
@@ -306,7 +307,7 @@ func (b *Bitstamp) GetBalance() (Balances, error) {
 }
 
 // GetUserTransactions returns an array of transactions
-func (b *Bitstamp) GetUserTransactions(currencyPair string) ([]UserTransactions, error) {
+func (b *Bitstamp) GetUserTransactions(ctx context.Context, currencyPair string) ([]UserTransactions, error) {
 	type Response struct {
 		Date          string      `json:"datetime"`
 		TransactionID int64       `json:"id"`
@@ -322,14 +323,14 @@ func (b *Bitstamp) GetUserTransactions(currencyPair string) ([]UserTransactions,
 	var response []Response
 
 	if currencyPair == "" {
-		if err := b.SendAuthenticatedHTTPRequest(bitstampAPIUserTransactions,
+		if err := b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIUserTransactions,
 			true,
 			url.Values{},
 			&response); err != nil {
 			return nil, err
 		}
 	} else {
-		if err := b.SendAuthenticatedHTTPRequest(bitstampAPIUserTransactions+"/"+currencyPair,
+		if err := b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIUserTransactions+"/"+currencyPair,
 			true,
 			url.Values{},
 			&response); err != nil {
@@ -369,32 +370,32 @@ func (b *Bitstamp) GetUserTransactions(currencyPair string) ([]UserTransactions,
 }
 
 // GetOpenOrders returns all open orders on the exchange
-func (b *Bitstamp) GetOpenOrders(currencyPair string) ([]Order, error) {
+func (b *Bitstamp) GetOpenOrders(ctx context.Context, currencyPair string) ([]Order, error) {
 	var resp []Order
 	path := fmt.Sprintf(
 		"%s/%s", bitstampAPIOpenOrders, strings.ToLower(currencyPair),
 	)
 
-	return resp, b.SendAuthenticatedHTTPRequest(path, true, nil, &resp)
+	return resp, b.SendAuthenticatedHTTPRequest(ctx, path, true, nil, &resp)
 }
 
 // GetOrderStatus returns an the status of an order by its ID
-func (b *Bitstamp) GetOrderStatus(orderID int64) (OrderStatus, error) {
+func (b *Bitstamp) GetOrderStatus(ctx context.Context, orderID int64) (OrderStatus, error) {
 	resp := OrderStatus{}
 	req := url.Values{}
 	req.Add("id", strconv.FormatInt(orderID, 10))
 
 	return resp,
-		b.SendAuthenticatedHTTPRequest(bitstampAPIOrderStatus, false, req, &resp)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIOrderStatus, false, req, &resp)
 }
 
 // CancelExistingOrder cancels order by ID
-func (b *Bitstamp) CancelExistingOrder(orderID int64) (CancelOrder, error) {
+func (b *Bitstamp) CancelExistingOrder(ctx context.Context, orderID int64) (CancelOrder, error) {
 	var req = url.Values{}
 	req.Add("id", strconv.FormatInt(orderID, 10))
 
 	var result CancelOrder
-	err := b.SendAuthenticatedHTTPRequest(bitstampAPICancelOrder, true, req, &result)
+	err := b.SendAuthenticatedHTTPRequest(ctx, bitstampAPICancelOrder, true, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -403,15 +404,15 @@ func (b *Bitstamp) CancelExistingOrder(orderID int64) (CancelOrder, error) {
 }
 
 // CancelAllExistingOrders cancels all open orders on the exchange
-func (b *Bitstamp) CancelAllExistingOrders() (bool, error) {
+func (b *Bitstamp) CancelAllExistingOrders(ctx context.Context) (bool, error) {
 	result := false
 
 	return result,
-		b.SendAuthenticatedHTTPRequest(bitstampAPICancelAllOrders, false, nil, &result)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPICancelAllOrders, false, nil, &result)
 }
 
 // PlaceOrder places an order on the exchange.
-func (b *Bitstamp) PlaceOrder(currencyPair string, price, amount float64, buy, market bool) (Order, error) {
+func (b *Bitstamp) PlaceOrder(ctx context.Context, currencyPair string, price, amount float64, buy, market bool) (Order, error) {
 	var req = url.Values{}
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	req.Add("price", strconv.FormatFloat(price, 'f', -1, 64))
@@ -430,13 +431,13 @@ func (b *Bitstamp) PlaceOrder(currencyPair string, price, amount float64, buy, m
 	}
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(path, true, req, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, path, true, req, &response)
 }
 
 // GetWithdrawalRequests returns withdrawal requests for the account
 // timedelta - positive integer with max value 50000000 which returns requests
 // from number of seconds ago to now.
-func (b *Bitstamp) GetWithdrawalRequests(timedelta int64) ([]WithdrawalRequests, error) {
+func (b *Bitstamp) GetWithdrawalRequests(ctx context.Context, timedelta int64) ([]WithdrawalRequests, error) {
 	var resp []WithdrawalRequests
 	if timedelta > 50000000 || timedelta < 0 {
 		return resp, errors.New("time delta exceeded, max: 50000000 min: 0")
@@ -450,7 +451,7 @@ func (b *Bitstamp) GetWithdrawalRequests(timedelta int64) ([]WithdrawalRequests,
 	}
 
 	return resp,
-		b.SendAuthenticatedHTTPRequest(bitstampAPIWithdrawalRequests, false, value, &resp)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIWithdrawalRequests, false, value, &resp)
 }
 
 // CryptoWithdrawal withdraws a cryptocurrency into a supplied wallet, returns ID
@@ -459,7 +460,7 @@ func (b *Bitstamp) GetWithdrawalRequests(timedelta int64) ([]WithdrawalRequests,
 // symbol - the type of crypto ie "ltc", "btc", "eth"
 // destTag - only for XRP  default to ""
 // instant - only for bitcoins
-func (b *Bitstamp) CryptoWithdrawal(amount float64, address, symbol, destTag string, instant bool) (CryptoWithdrawalResponse, error) {
+func (b *Bitstamp) CryptoWithdrawal(ctx context.Context, amount float64, address, symbol, destTag string, instant bool) (CryptoWithdrawalResponse, error) {
 	var req = url.Values{}
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	req.Add("address", address)
@@ -489,11 +490,11 @@ func (b *Bitstamp) CryptoWithdrawal(amount float64, address, symbol, destTag str
 		return resp, errors.New("incorrect symbol")
 	}
 
-	return resp, b.SendAuthenticatedHTTPRequest(endpoint, false, req, &resp)
+	return resp, b.SendAuthenticatedHTTPRequest(ctx, endpoint, false, req, &resp)
 }
 
 // OpenBankWithdrawal Opens a bank withdrawal request (SEPA or international)
-func (b *Bitstamp) OpenBankWithdrawal(amount float64, currency,
+func (b *Bitstamp) OpenBankWithdrawal(ctx context.Context, amount float64, currency,
 	name, iban, bic, address, postalCode, city, country,
 	comment, withdrawalType string) (FIATWithdrawalResponse, error) {
 	var req = url.Values{}
@@ -510,11 +511,11 @@ func (b *Bitstamp) OpenBankWithdrawal(amount float64, currency,
 	req.Add("comment", comment)
 
 	resp := FIATWithdrawalResponse{}
-	return resp, b.SendAuthenticatedHTTPRequest(bitstampAPIOpenWithdrawal, true, req, &resp)
+	return resp, b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIOpenWithdrawal, true, req, &resp)
 }
 
 // OpenInternationalBankWithdrawal Opens a bank withdrawal request (international)
-func (b *Bitstamp) OpenInternationalBankWithdrawal(amount float64, currency,
+func (b *Bitstamp) OpenInternationalBankWithdrawal(ctx context.Context, amount float64, currency,
 	name, iban, bic, address, postalCode, city, country,
 	bankName, bankAddress, bankPostCode, bankCity, bankCountry, internationalCurrency,
 	comment, withdrawalType string) (FIATWithdrawalResponse, error) {
@@ -538,12 +539,12 @@ func (b *Bitstamp) OpenInternationalBankWithdrawal(amount float64, currency,
 	req.Add("bank_country", bankCountry)
 
 	resp := FIATWithdrawalResponse{}
-	return resp, b.SendAuthenticatedHTTPRequest(bitstampAPIOpenWithdrawal, true, req, &resp)
+	return resp, b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIOpenWithdrawal, true, req, &resp)
 }
 
 // GetCryptoDepositAddress returns a depositing address by crypto
 // crypto - example "btc", "ltc", "eth", "xrp" or "bch"
-func (b *Bitstamp) GetCryptoDepositAddress(crypto currency.Code) (string, error) {
+func (b *Bitstamp) GetCryptoDepositAddress(ctx context.Context, crypto currency.Code) (string, error) {
 	var resp string
 	v2Resp := struct {
 		Address string `json:"address"`
@@ -552,23 +553,23 @@ func (b *Bitstamp) GetCryptoDepositAddress(crypto currency.Code) (string, error)
 	switch crypto {
 	case currency.BTC:
 		return resp,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIBitcoinDeposit, false, nil, &resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIBitcoinDeposit, false, nil, &resp)
 
 	case currency.LTC:
 		return v2Resp.Address,
-			b.SendAuthenticatedHTTPRequest(bitstampAPILitecoinDeposit, true, nil, &v2Resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPILitecoinDeposit, true, nil, &v2Resp)
 
 	case currency.ETH:
 		return v2Resp.Address,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIEthereumDeposit, true, nil, &v2Resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIEthereumDeposit, true, nil, &v2Resp)
 
 	case currency.XRP:
 		return v2Resp.Address,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIXrpDeposit, true, nil, &v2Resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIXrpDeposit, true, nil, &v2Resp)
 
 	case currency.BCH:
 		return v2Resp.Address,
-			b.SendAuthenticatedHTTPRequest(bitstampAPIBitcoinCashDeposit, true, nil, &v2Resp)
+			b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIBitcoinCashDeposit, true, nil, &v2Resp)
 
 	default:
 		return resp, fmt.Errorf("unsupported cryptocurrency string %s", crypto)
@@ -576,11 +577,11 @@ func (b *Bitstamp) GetCryptoDepositAddress(crypto currency.Code) (string, error)
 }
 
 // GetUnconfirmedBitcoinDeposits returns unconfirmed transactions
-func (b *Bitstamp) GetUnconfirmedBitcoinDeposits() ([]UnconfirmedBTCTransactions, error) {
+func (b *Bitstamp) GetUnconfirmedBitcoinDeposits(ctx context.Context) ([]UnconfirmedBTCTransactions, error) {
 	var response []UnconfirmedBTCTransactions
 
 	return response,
-		b.SendAuthenticatedHTTPRequest(bitstampAPIUnconfirmedBitcoin, false, nil, &response)
+		b.SendAuthenticatedHTTPRequest(ctx, bitstampAPIUnconfirmedBitcoin, false, nil, &response)
 }
 
 // TransferAccountBalance transfers funds from either a main or sub account
@@ -588,7 +589,7 @@ func (b *Bitstamp) GetUnconfirmedBitcoinDeposits() ([]UnconfirmedBTCTransactions
 // currency - which currency to transfer
 // subaccount - name of account
 // toMain - bool either to or from account
-func (b *Bitstamp) TransferAccountBalance(amount float64, currency, subAccount string, toMain bool) error {
+func (b *Bitstamp) TransferAccountBalance(ctx context.Context, amount float64, currency, subAccount string, toMain bool) error {
 	var req = url.Values{}
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 	req.Add("currency", currency)
@@ -608,12 +609,12 @@ func (b *Bitstamp) TransferAccountBalance(amount float64, currency, subAccount s
 
 	var resp interface{}
 
-	return b.SendAuthenticatedHTTPRequest(path, true, req, &resp)
+	return b.SendAuthenticatedHTTPRequest(ctx, path, true, req, &resp)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (b *Bitstamp) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload(&request.Item{
+func (b *Bitstamp) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -624,7 +625,7 @@ func (b *Bitstamp) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated request
-func (b *Bitstamp) SendAuthenticatedHTTPRequest(path string, v2 bool, values url.Values, result interface{}) error {
+func (b *Bitstamp) SendAuthenticatedHTTPRequest(ctx context.Context, path string, v2 bool, values url.Values, result interface{}) error {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -666,7 +667,7 @@ func (b *Bitstamp) SendAuthenticatedHTTPRequest(path string, v2 bool, values url
 		Reason interface{} `json:"reason"`
 	}{}
 
-	err := b.SendPayload(&request.Item{
+	err := b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodPost,
 		Path:          path,
 		Headers:       headers,
@@ -1,13 +1,21 @@
 package bitstamp
 
 import (
+	"context"
+	"net/http"
 	"net/url"
 	"testing"
+	"time"
 
+	"github.com/gorilla/websocket"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 )
 
@@ -39,7 +47,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	t.Parallel()
 
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v",
@@ -61,7 +69,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 
 	// CryptocurrencyTradeFee Basic
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
 		t.Error(err)
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
@@ -72,7 +80,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -82,7 +90,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -92,7 +100,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || (areTestAPIKeysSet() && err != nil) {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -102,7 +110,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -112,7 +120,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -123,7 +131,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(7.5) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(7.5) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(7.5),
 			resp)
@@ -134,7 +142,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(15) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(15) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(15),
 			resp)
@@ -170,7 +178,7 @@ func TestCalculateTradingFee(t *testing.T) {
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTicker(currency.BTC.String()+currency.USD.String(), false)
+	_, err := b.GetTicker(context.Background(), currency.BTC.String()+currency.USD.String(), false)
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -179,7 +187,7 @@ func TestGetTicker(t *testing.T) {
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderbook(currency.BTC.String() + currency.USD.String())
+	_, err := b.GetOrderbook(context.Background(), currency.BTC.String()+currency.USD.String())
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
@@ -188,7 +196,7 @@ func TestGetOrderbook(t *testing.T) {
 func TestGetTradingPairs(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetTradingPairs()
+	_, err := b.GetTradingPairs(context.Background())
 	if err != nil {
 		t.Error("GetTradingPairs() error", err)
 	}
@@ -200,7 +208,7 @@ func TestGetTransactions(t *testing.T) {
 	value := url.Values{}
 	value.Set("time", "hour")
 
-	_, err := b.GetTransactions(currency.BTC.String()+currency.USD.String(), value)
+	_, err := b.GetTransactions(context.Background(), currency.BTC.String()+currency.USD.String(), value)
 	if err != nil {
 		t.Error("GetTransactions() error", err)
 	}
@@ -209,7 +217,7 @@ func TestGetTransactions(t *testing.T) {
 func TestGetEURUSDConversionRate(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetEURUSDConversionRate()
+	_, err := b.GetEURUSDConversionRate(context.Background())
 	if err != nil {
 		t.Error("GetEURUSDConversionRate() error", err)
 	}
@@ -218,7 +226,7 @@ func TestGetEURUSDConversionRate(t *testing.T) {
 func TestGetBalance(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetBalance()
+	_, err := b.GetBalance(context.Background())
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetBalance() error", err)
@@ -232,7 +240,7 @@ func TestGetBalance(t *testing.T) {
 func TestGetUserTransactions(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetUserTransactions("btcusd")
+	_, err := b.GetUserTransactions(context.Background(), "btcusd")
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetUserTransactions() error", err)
@@ -246,7 +254,7 @@ func TestGetUserTransactions(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOpenOrders("btcusd")
+	_, err := b.GetOpenOrders(context.Background(), "btcusd")
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetOpenOrders() error", err)
@@ -260,7 +268,7 @@ func TestGetOpenOrders(t *testing.T) {
 func TestGetOrderStatus(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderStatus(1337)
+	_, err := b.GetOrderStatus(context.Background(), 1337)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetOrderStatus() error", err)
@@ -274,7 +282,7 @@ func TestGetOrderStatus(t *testing.T) {
 func TestGetWithdrawalRequests(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetWithdrawalRequests(0)
+	_, err := b.GetWithdrawalRequests(context.Background(), 0)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetWithdrawalRequests() error", err)
@@ -288,7 +296,7 @@ func TestGetWithdrawalRequests(t *testing.T) {
 func TestGetUnconfirmedBitcoinDeposits(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetUnconfirmedBitcoinDeposits()
+	_, err := b.GetUnconfirmedBitcoinDeposits(context.Background())
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Error("GetUnconfirmedBitcoinDeposits() error", err)
@@ -306,7 +314,7 @@ func TestTransferAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	err := b.TransferAccountBalance(0.01, "btc", "testAccount", true)
+	err := b.TransferAccountBalance(context.Background(), 0.01, "btc", "testAccount", true)
 	if !mockTests && err != nil {
 		t.Error("TransferAccountBalance() error", err)
 	}
@@ -336,7 +344,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get open orders: %s", err)
@@ -354,7 +362,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get order history: %s", err)
@@ -382,11 +390,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	switch {
 	case areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) && !mockTests:
 		t.Errorf("Order failed to be placed: %v", err)
@@ -407,7 +415,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 	orderCancellation := &order.Cancel{
 		OrderID: "1234",
 	}
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -425,7 +433,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	resp, err := b.CancelAllOrders(&order.Cancel{})
+	resp, err := b.CancelAllOrders(context.Background(), &order.Cancel{})
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -443,7 +451,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 func TestModifyOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -465,7 +473,7 @@ func TestWithdraw(t *testing.T) {
 		Address: core.BitcoinDonationAddress,
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -503,7 +511,7 @@ func TestWithdrawFiat(t *testing.T) {
 		IBAN:                     "IT60X0542811101000000123456",
 	}
 
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -547,7 +555,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 		IntermediaryBankPostalCode:    "2088",
 	}
 
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -561,7 +569,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetDepositAddress(currency.BTC, "")
+	_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 	switch {
 	case areTestAPIKeysSet() && customerID != "" && err != nil && !mockTests:
 		t.Error("GetDepositAddress error", err)
@@ -589,3 +597,42 @@ func TestParseTime(t *testing.T) {
 		t.Error("invalid time values")
 	}
 }
+
+// TestWsReplay replays a websocket capture through the websocket handler
+func TestWsReplay(t *testing.T) {
+	frames, err := wshandler.LoadCapture("../../testdata/websocket_capture/bitstamp.jsonl")
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.WebsocketConn.Replay(wshandler.NewReplayer(frames))
+	b.Websocket.ShutdownC = make(chan struct{})
+	b.Websocket.ReadMessageErrors = make(chan error, 1)
+	b.Websocket.TrafficAlert = make(chan struct{}, len(frames))
+	err = b.WebsocketConn.Dial(&websocket.Dialer{}, http.Header{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	go b.WsHandleData()
+
+	p := currency.NewPairFromString("BTCUSD")
+	trade, ok := (<-b.Websocket.DataHandler).(wshandler.TradeData)
+	if !ok {
+		t.Fatal("expected trade data")
+	}
+	if trade.Price != 6775.49 || trade.Amount != 0.5 || !trade.CurrencyPair.Equal(p) {
+		t.Errorf("unexpected trade %+v", trade)
+	}
+	if _, ok = (<-b.Websocket.DataHandler).(wshandler.WebsocketOrderbookUpdate); !ok {
+		t.Fatal("expected an orderbook update")
+	}
+	ob := b.Websocket.Orderbook.GetOrderbook(p, asset.Spot)
+	if ob == nil || len(ob.Bids) != 2 || len(ob.Asks) != 1 || ob.Bids[0].Price != 6775 {
+		t.Errorf("unexpected orderbook %+v", ob)
+	}
+	select {
+	case <-b.Websocket.ReadMessageErrors:
+	case <-time.After(time.Second):
+		t.Error("expected the handler to stop once the capture is exhausted")
+	}
+	b.Websocket.Wg.Wait()
+}
@@ -1,6 +1,7 @@
 package bitstamp
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"net/http"
@@ -34,7 +35,7 @@ func (b *Bitstamp) WsConnect() error {
 		log.Debugf(log.ExchangeSys, "%s Connected to Websocket.\n", b.Name)
 	}
 
-	err = b.seedOrderBook()
+	err = b.seedOrderBook(context.Background())
 	if err != nil {
 		b.Websocket.DataHandler <- err
 	}
@@ -68,7 +69,7 @@ func (b *Bitstamp) WsHandleData() {
 			wsResponse := websocketResponse{}
 			err = json.Unmarshal(resp.Raw, &wsResponse)
 			if err != nil {
-				b.Websocket.DataHandler <- err
+				b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 				continue
 			}
 
@@ -83,7 +84,7 @@ func (b *Bitstamp) WsHandleData() {
 				wsOrderBookTemp := websocketOrderBookResponse{}
 				err := json.Unmarshal(resp.Raw, &wsOrderBookTemp)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -101,7 +102,7 @@ func (b *Bitstamp) WsHandleData() {
 
 				err := json.Unmarshal(resp.Raw, &wsTradeTemp)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -215,10 +216,10 @@ func (b *Bitstamp) wsUpdateOrderbook(update websocketOrderBook, p currency.Pair,
 	return nil
 }
 
-func (b *Bitstamp) seedOrderBook() error {
+func (b *Bitstamp) seedOrderBook(ctx context.Context) error {
 	p := b.GetEnabledPairs(asset.Spot)
 	for x := range p {
-		orderbookSeed, err := b.GetOrderbook(p[x].String())
+		orderbookSeed, err := b.GetOrderbook(ctx, p[x].String())
 		if err != nil {
 			return err
 		}
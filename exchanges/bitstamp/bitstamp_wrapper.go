@@ -1,6 +1,7 @@
 package bitstamp
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +39,7 @@ func (b *Bitstamp) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -154,10 +156,13 @@ func (b *Bitstamp) Setup(exch *config.ExchangeConfig) error {
 	b.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         b.Name,
 		URL:                  b.Websocket.GetWebsocketURL(),
+		AlternateURLs:        b.Websocket.GetAlternateURLs(),
 		ProxyURL:             b.Websocket.GetProxyAddress(),
 		Verbose:              b.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	return nil
@@ -186,7 +191,7 @@ func (b *Bitstamp) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -196,8 +201,8 @@ func (b *Bitstamp) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Bitstamp) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	pairs, err := b.GetTradingPairs()
+func (b *Bitstamp) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	pairs, err := b.GetTradingPairs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -217,8 +222,8 @@ func (b *Bitstamp) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Bitstamp) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+func (b *Bitstamp) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -227,9 +232,9 @@ func (b *Bitstamp) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bitstamp) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitstamp) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tick, err := b.GetTicker(p.String(), false)
+	tick, err := b.GetTicker(ctx, p.String(), false)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -255,36 +260,36 @@ func (b *Bitstamp) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *Bitstamp) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bitstamp) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tick, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bitstamp) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bitstamp) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if (!b.AllowAuthenticatedRequest() || b.SkipAuthCheck) && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return b.GetFee(feeBuilder)
+	return b.GetFee(ctx, feeBuilder)
 }
 
 // FetchOrderbook returns the orderbook for a currency pair
-func (b *Bitstamp) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitstamp) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bitstamp) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bitstamp) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := b.GetOrderbook(p.String())
+	orderbookNew, err := b.GetOrderbook(ctx, p.String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -317,10 +322,10 @@ func (b *Bitstamp) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orde
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Bitstamp exchange
-func (b *Bitstamp) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Bitstamp) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = b.Name
-	accountBalance, err := b.GetBalance()
+	accountBalance, err := b.GetBalance(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -329,8 +334,8 @@ func (b *Bitstamp) UpdateAccountInfo() (account.Holdings, error) {
 	for k, v := range accountBalance {
 		currencies = append(currencies, account.Balance{
 			CurrencyName: currency.NewCode(k),
-			TotalValue:   v.Available,
-			Hold:         v.Reserved,
+			TotalValue:   decimal.NewFromFloat(v.Available),
+			Hold:         decimal.NewFromFloat(v.Reserved),
 		})
 	}
 	response.Accounts = append(response.Accounts, account.SubAccount{
@@ -346,10 +351,10 @@ func (b *Bitstamp) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Bitstamp) FetchAccountInfo() (account.Holdings, error) {
+func (b *Bitstamp) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -357,17 +362,40 @@ func (b *Bitstamp) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bitstamp) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bitstamp) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (b *Bitstamp) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (b *Bitstamp) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (b *Bitstamp) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (b *Bitstamp) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bitstamp) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *Bitstamp) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bitstamp) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *Bitstamp) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -375,9 +403,9 @@ func (b *Bitstamp) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 	buy := s.OrderSide == order.Buy
 	market := s.OrderType == order.Market
-	response, err := b.PlaceOrder(s.Pair.String(),
-		s.Price,
-		s.Amount,
+	response, err := b.PlaceOrder(ctx, s.Pair.String(),
+		s.Price.Float64(),
+		s.Amount.Float64(),
 		buy,
 		market)
 	if err != nil {
@@ -396,23 +424,23 @@ func (b *Bitstamp) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bitstamp) ModifyOrder(action *order.Modify) (string, error) {
+func (b *Bitstamp) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bitstamp) CancelOrder(order *order.Cancel) error {
+func (b *Bitstamp) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
-	_, err = b.CancelExistingOrder(orderIDInt)
+	_, err = b.CancelExistingOrder(ctx, orderIDInt)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bitstamp) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
-	success, err := b.CancelAllExistingOrders()
+func (b *Bitstamp) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
+	success, err := b.CancelAllExistingOrders(ctx)
 	if err != nil {
 		return order.CancelAllResponse{}, err
 	}
@@ -424,20 +452,20 @@ func (b *Bitstamp) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, er
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bitstamp) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Bitstamp) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bitstamp) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	return b.GetCryptoDepositAddress(cryptocurrency)
+func (b *Bitstamp) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	return b.GetCryptoDepositAddress(ctx, cryptocurrency)
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bitstamp) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	resp, err := b.CryptoWithdrawal(withdrawRequest.Amount,
+func (b *Bitstamp) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	resp, err := b.CryptoWithdrawal(ctx, withdrawRequest.Amount,
 		withdrawRequest.Address,
 		withdrawRequest.Currency.String(),
 		withdrawRequest.AddressTag,
@@ -458,8 +486,8 @@ func (b *Bitstamp) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoR
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bitstamp) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
-	resp, err := b.OpenBankWithdrawal(withdrawRequest.Amount,
+func (b *Bitstamp) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
+	resp, err := b.OpenBankWithdrawal(ctx, withdrawRequest.Amount,
 		withdrawRequest.Currency.String(),
 		withdrawRequest.BankAccountName,
 		withdrawRequest.IBAN,
@@ -486,8 +514,8 @@ func (b *Bitstamp) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (str
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bitstamp) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
-	resp, err := b.OpenInternationalBankWithdrawal(withdrawRequest.Amount,
+func (b *Bitstamp) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
+	resp, err := b.OpenInternationalBankWithdrawal(ctx, withdrawRequest.Amount,
 		withdrawRequest.Currency.String(),
 		withdrawRequest.BankAccountName,
 		withdrawRequest.IBAN,
@@ -524,7 +552,7 @@ func (b *Bitstamp) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *Bitstamp) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitstamp) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var currPair string
 	if len(req.Currencies) != 1 {
 		currPair = "all"
@@ -532,7 +560,7 @@ func (b *Bitstamp) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 		currPair = req.Currencies[0].String()
 	}
 
-	resp, err := b.GetOpenOrders(currPair)
+	resp, err := b.GetOpenOrders(ctx, currPair)
 	if err != nil {
 		return nil, err
 	}
@@ -551,9 +579,9 @@ func (b *Bitstamp) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 		}
 
 		orders = append(orders, order.Detail{
-			Amount:       resp[i].Amount,
+			Amount:       decimal.NewFromFloat(resp[i].Amount),
 			ID:           strconv.FormatInt(resp[i].ID, 10),
-			Price:        resp[i].Price,
+			Price:        decimal.NewFromFloat(resp[i].Price),
 			OrderType:    order.Limit,
 			OrderSide:    orderSide,
 			OrderDate:    tm,
@@ -569,12 +597,12 @@ func (b *Bitstamp) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *Bitstamp) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bitstamp) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var currPair string
 	if len(req.Currencies) == 1 {
 		currPair = req.Currencies[0].String()
 	}
-	resp, err := b.GetUserTransactions(currPair)
+	resp, err := b.GetUserTransactions(ctx, currPair)
 	if err != nil {
 		return nil, err
 	}
@@ -636,18 +664,16 @@ func (b *Bitstamp) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (b *Bitstamp) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	b.Websocket.SubscribeToChannels(channels)
-	return nil
+	return b.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (b *Bitstamp) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	b.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return b.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -662,7 +688,12 @@ func (b *Bitstamp) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Bitstamp) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Bitstamp) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *Bitstamp) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
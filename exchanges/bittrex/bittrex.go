@@ -1,6 +1,7 @@
 package bittrex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -65,17 +66,17 @@ type Bittrex struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *Bittrex) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (b *Bittrex) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetMarkets is used to get the open and available trading markets at Bittrex
 // along with other meta data.
-func (b *Bittrex) GetMarkets() (Market, error) {
+func (b *Bittrex) GetMarkets(ctx context.Context) (Market, error) {
 	var markets Market
 	path := fmt.Sprintf("%s/%s/", b.API.Endpoints.URL, bittrexAPIGetMarkets)
 
-	if err := b.SendHTTPRequest(path, &markets); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &markets); err != nil {
 		return markets, err
 	}
 
@@ -86,11 +87,11 @@ func (b *Bittrex) GetMarkets() (Market, error) {
 }
 
 // GetCurrencies is used to get all supported currencies at Bittrex
-func (b *Bittrex) GetCurrencies() (Currency, error) {
+func (b *Bittrex) GetCurrencies(ctx context.Context) (Currency, error) {
 	var currencies Currency
 	path := fmt.Sprintf("%s/%s/", b.API.Endpoints.URL, bittrexAPIGetCurrencies)
 
-	if err := b.SendHTTPRequest(path, &currencies); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &currencies); err != nil {
 		return currencies, err
 	}
 
@@ -102,13 +103,13 @@ func (b *Bittrex) GetCurrencies() (Currency, error) {
 
 // GetTicker sends a public get request and returns current ticker information
 // on the supplied currency. Example currency input param "btc-ltc".
-func (b *Bittrex) GetTicker(currencyPair string) (Ticker, error) {
+func (b *Bittrex) GetTicker(ctx context.Context, currencyPair string) (Ticker, error) {
 	tick := Ticker{}
 	path := fmt.Sprintf("%s/%s?market=%s", b.API.Endpoints.URL, bittrexAPIGetTicker,
 		strings.ToUpper(currencyPair),
 	)
 
-	if err := b.SendHTTPRequest(path, &tick); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &tick); err != nil {
 		return tick, err
 	}
 
@@ -120,11 +121,11 @@ func (b *Bittrex) GetTicker(currencyPair string) (Ticker, error) {
 
 // GetMarketSummaries is used to get the last 24 hour summary of all active
 // exchanges
-func (b *Bittrex) GetMarketSummaries() (MarketSummary, error) {
+func (b *Bittrex) GetMarketSummaries(ctx context.Context) (MarketSummary, error) {
 	var summaries MarketSummary
 	path := fmt.Sprintf("%s/%s/", b.API.Endpoints.URL, bittrexAPIGetMarketSummaries)
 
-	if err := b.SendHTTPRequest(path, &summaries); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &summaries); err != nil {
 		return summaries, err
 	}
 
@@ -136,13 +137,13 @@ func (b *Bittrex) GetMarketSummaries() (MarketSummary, error) {
 
 // GetMarketSummary is used to get the last 24 hour summary of all active
 // exchanges by currency pair (btc-ltc).
-func (b *Bittrex) GetMarketSummary(currencyPair string) (MarketSummary, error) {
+func (b *Bittrex) GetMarketSummary(ctx context.Context, currencyPair string) (MarketSummary, error) {
 	var summary MarketSummary
 	path := fmt.Sprintf("%s/%s?market=%s", b.API.Endpoints.URL,
 		bittrexAPIGetMarketSummary, strings.ToLower(currencyPair),
 	)
 
-	if err := b.SendHTTPRequest(path, &summary); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &summary); err != nil {
 		return summary, err
 	}
 
@@ -159,13 +160,13 @@ func (b *Bittrex) GetMarketSummary(currencyPair string) (MarketSummary, error) {
 // complexity this function is set to "both"
 // "Depth" max depth is 50 but you can literally set it any integer you want and
 // it returns full depth. So depth default is 50.
-func (b *Bittrex) GetOrderbook(currencyPair string) (OrderBooks, error) {
+func (b *Bittrex) GetOrderbook(ctx context.Context, currencyPair string) (OrderBooks, error) {
 	var orderbooks OrderBooks
 	path := fmt.Sprintf("%s/%s?market=%s&type=both&depth=50", b.API.Endpoints.URL,
 		bittrexAPIGetOrderbook, strings.ToUpper(currencyPair),
 	)
 
-	if err := b.SendHTTPRequest(path, &orderbooks); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &orderbooks); err != nil {
 		return orderbooks, err
 	}
 
@@ -177,13 +178,13 @@ func (b *Bittrex) GetOrderbook(currencyPair string) (OrderBooks, error) {
 
 // GetMarketHistory retrieves the latest trades that have occurred for a specific
 // market
-func (b *Bittrex) GetMarketHistory(currencyPair string) (MarketHistory, error) {
+func (b *Bittrex) GetMarketHistory(ctx context.Context, currencyPair string) (MarketHistory, error) {
 	var marketHistoriae MarketHistory
 	path := fmt.Sprintf("%s/%s?market=%s", b.API.Endpoints.URL,
 		bittrexAPIGetMarketHistory, strings.ToUpper(currencyPair),
 	)
 
-	if err := b.SendHTTPRequest(path, &marketHistoriae); err != nil {
+	if err := b.SendHTTPRequest(ctx, path, &marketHistoriae); err != nil {
 		return marketHistoriae, err
 	}
 
@@ -199,7 +200,7 @@ func (b *Bittrex) GetMarketHistory(currencyPair string) (MarketHistory, error) {
 // "Currency" ie "btc-ltc"
 // "Quantity" is the amount to purchase
 // "Rate" is the rate at which to purchase
-func (b *Bittrex) PlaceBuyLimit(currencyPair string, quantity, rate float64) (UUID, error) {
+func (b *Bittrex) PlaceBuyLimit(ctx context.Context, currencyPair string, quantity, rate float64) (UUID, error) {
 	var id UUID
 	values := url.Values{}
 	values.Set("market", currencyPair)
@@ -207,7 +208,7 @@ func (b *Bittrex) PlaceBuyLimit(currencyPair string, quantity, rate float64) (UU
 	values.Set("rate", strconv.FormatFloat(rate, 'E', -1, 64))
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIBuyLimit)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &id); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &id); err != nil {
 		return id, err
 	}
 
@@ -223,7 +224,7 @@ func (b *Bittrex) PlaceBuyLimit(currencyPair string, quantity, rate float64) (UU
 // "Currency" ie "btc-ltc"
 // "Quantity" is the amount to purchase
 // "Rate" is the rate at which to purchase
-func (b *Bittrex) PlaceSellLimit(currencyPair string, quantity, rate float64) (UUID, error) {
+func (b *Bittrex) PlaceSellLimit(ctx context.Context, currencyPair string, quantity, rate float64) (UUID, error) {
 	var id UUID
 	values := url.Values{}
 	values.Set("market", currencyPair)
@@ -231,7 +232,7 @@ func (b *Bittrex) PlaceSellLimit(currencyPair string, quantity, rate float64) (U
 	values.Set("rate", strconv.FormatFloat(rate, 'E', -1, 64))
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPISellLimit)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &id); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &id); err != nil {
 		return id, err
 	}
 
@@ -243,7 +244,7 @@ func (b *Bittrex) PlaceSellLimit(currencyPair string, quantity, rate float64) (U
 
 // GetOpenOrders returns all orders that you currently have opened.
 // A specific market can be requested for example "btc-ltc"
-func (b *Bittrex) GetOpenOrders(currencyPair string) (Order, error) {
+func (b *Bittrex) GetOpenOrders(ctx context.Context, currencyPair string) (Order, error) {
 	var orders Order
 	values := url.Values{}
 	if !(currencyPair == "" || currencyPair == " ") {
@@ -251,7 +252,7 @@ func (b *Bittrex) GetOpenOrders(currencyPair string) (Order, error) {
 	}
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIGetOpenOrders)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &orders); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &orders); err != nil {
 		return orders, err
 	}
 
@@ -262,13 +263,13 @@ func (b *Bittrex) GetOpenOrders(currencyPair string) (Order, error) {
 }
 
 // CancelExistingOrder is used to cancel a buy or sell order.
-func (b *Bittrex) CancelExistingOrder(uuid string) (Balances, error) {
+func (b *Bittrex) CancelExistingOrder(ctx context.Context, uuid string) (Balances, error) {
 	var balances Balances
 	values := url.Values{}
 	values.Set("uuid", uuid)
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPICancel)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &balances); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &balances); err != nil {
 		return balances, err
 	}
 
@@ -279,11 +280,11 @@ func (b *Bittrex) CancelExistingOrder(uuid string) (Balances, error) {
 }
 
 // GetAccountBalances is used to retrieve all balances from your account
-func (b *Bittrex) GetAccountBalances() (Balances, error) {
+func (b *Bittrex) GetAccountBalances(ctx context.Context) (Balances, error) {
 	var balances Balances
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIGetBalances)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, url.Values{}, &balances); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, url.Values{}, &balances); err != nil {
 		return balances, err
 	}
 
@@ -295,13 +296,13 @@ func (b *Bittrex) GetAccountBalances() (Balances, error) {
 
 // GetAccountBalanceByCurrency is used to retrieve the balance from your account
 // for a specific currency. ie. "btc" or "ltc"
-func (b *Bittrex) GetAccountBalanceByCurrency(currency string) (Balance, error) {
+func (b *Bittrex) GetAccountBalanceByCurrency(ctx context.Context, currency string) (Balance, error) {
 	var balance Balance
 	values := url.Values{}
 	values.Set("currency", currency)
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIGetBalance)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &balance); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &balance); err != nil {
 		return balance, err
 	}
 
@@ -314,13 +315,13 @@ func (b *Bittrex) GetAccountBalanceByCurrency(currency string) (Balance, error)
 // GetCryptoDepositAddress is used to retrieve or generate an address for a specific
 // currency. If one does not exist, the call will fail and return
 // ADDRESS_GENERATING until one is available.
-func (b *Bittrex) GetCryptoDepositAddress(currency string) (DepositAddress, error) {
+func (b *Bittrex) GetCryptoDepositAddress(ctx context.Context, currency string) (DepositAddress, error) {
 	var address DepositAddress
 	values := url.Values{}
 	values.Set("currency", currency)
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIGetDepositAddress)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &address); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &address); err != nil {
 		return address, err
 	}
 
@@ -332,7 +333,7 @@ func (b *Bittrex) GetCryptoDepositAddress(currency string) (DepositAddress, erro
 
 // Withdraw is used to withdraw funds from your account.
 // note: Please account for transaction fee.
-func (b *Bittrex) Withdraw(currency, paymentID, address string, quantity float64) (UUID, error) {
+func (b *Bittrex) Withdraw(ctx context.Context, currency, paymentID, address string, quantity float64) (UUID, error) {
 	var id UUID
 	values := url.Values{}
 	values.Set("currency", currency)
@@ -344,7 +345,7 @@ func (b *Bittrex) Withdraw(currency, paymentID, address string, quantity float64
 
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIWithdraw)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &id); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &id); err != nil {
 		return id, err
 	}
 
@@ -355,13 +356,13 @@ func (b *Bittrex) Withdraw(currency, paymentID, address string, quantity float64
 }
 
 // GetOrder is used to retrieve a single order by UUID.
-func (b *Bittrex) GetOrder(uuid string) (Order, error) {
+func (b *Bittrex) GetOrder(ctx context.Context, uuid string) (Order, error) {
 	var order Order
 	values := url.Values{}
 	values.Set("uuid", uuid)
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIGetOrder)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &order); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &order); err != nil {
 		return order, err
 	}
 
@@ -373,7 +374,7 @@ func (b *Bittrex) GetOrder(uuid string) (Order, error) {
 
 // GetOrderHistoryForCurrency is used to retrieve your order history. If currencyPair
 // omitted it will return the entire order History.
-func (b *Bittrex) GetOrderHistoryForCurrency(currencyPair string) (Order, error) {
+func (b *Bittrex) GetOrderHistoryForCurrency(ctx context.Context, currencyPair string) (Order, error) {
 	var orders Order
 	values := url.Values{}
 
@@ -382,7 +383,7 @@ func (b *Bittrex) GetOrderHistoryForCurrency(currencyPair string) (Order, error)
 	}
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIGetOrderHistory)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &orders); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &orders); err != nil {
 		return orders, err
 	}
 
@@ -394,7 +395,7 @@ func (b *Bittrex) GetOrderHistoryForCurrency(currencyPair string) (Order, error)
 
 // GetWithdrawalHistory is used to retrieve your withdrawal history. If currency
 // omitted it will return the entire history
-func (b *Bittrex) GetWithdrawalHistory(currency string) (WithdrawalHistory, error) {
+func (b *Bittrex) GetWithdrawalHistory(ctx context.Context, currency string) (WithdrawalHistory, error) {
 	var history WithdrawalHistory
 	values := url.Values{}
 
@@ -403,7 +404,7 @@ func (b *Bittrex) GetWithdrawalHistory(currency string) (WithdrawalHistory, erro
 	}
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIGetWithdrawalHistory)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &history); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &history); err != nil {
 		return history, err
 	}
 
@@ -415,7 +416,7 @@ func (b *Bittrex) GetWithdrawalHistory(currency string) (WithdrawalHistory, erro
 
 // GetDepositHistory is used to retrieve your deposit history. If currency is
 // is omitted it will return the entire deposit history
-func (b *Bittrex) GetDepositHistory(currency string) (DepositHistory, error) {
+func (b *Bittrex) GetDepositHistory(ctx context.Context, currency string) (DepositHistory, error) {
 	var history DepositHistory
 	values := url.Values{}
 
@@ -424,7 +425,7 @@ func (b *Bittrex) GetDepositHistory(currency string) (DepositHistory, error) {
 	}
 	path := fmt.Sprintf("%s/%s", b.API.Endpoints.URL, bittrexAPIGetDepositHistory)
 
-	if err := b.SendAuthenticatedHTTPRequest(path, values, &history); err != nil {
+	if err := b.SendAuthenticatedHTTPRequest(ctx, path, values, &history); err != nil {
 		return history, err
 	}
 
@@ -435,8 +436,8 @@ func (b *Bittrex) GetDepositHistory(currency string) (DepositHistory, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (b *Bittrex) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload(&request.Item{
+func (b *Bittrex) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -448,7 +449,7 @@ func (b *Bittrex) SendHTTPRequest(path string, result interface{}) error {
 
 // SendAuthenticatedHTTPRequest sends an authenticated http request to a desired
 // path
-func (b *Bittrex) SendAuthenticatedHTTPRequest(path string, values url.Values, result interface{}) (err error) {
+func (b *Bittrex) SendAuthenticatedHTTPRequest(ctx context.Context, path string, values url.Values, result interface{}) (err error) {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, b.Name)
 	}
@@ -464,7 +465,7 @@ func (b *Bittrex) SendAuthenticatedHTTPRequest(path string, values url.Values, r
 	headers := make(map[string]string)
 	headers["apisign"] = crypto.HexEncodeToString(hmac)
 
-	return b.SendPayload(&request.Item{
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          rawQuery,
 		Headers:       headers,
@@ -478,7 +479,7 @@ func (b *Bittrex) SendAuthenticatedHTTPRequest(path string, values url.Values, r
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *Bittrex) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bittrex) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	var err error
 
@@ -486,7 +487,7 @@ func (b *Bittrex) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 	case exchange.CryptocurrencyTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount)
 	case exchange.CryptocurrencyWithdrawalFee:
-		fee, err = b.GetWithdrawalFee(feeBuilder.Pair.Base)
+		fee, err = b.GetWithdrawalFee(ctx, feeBuilder.Pair.Base)
 	case exchange.OfflineTradeFee:
 		fee = calculateTradingFee(feeBuilder.PurchasePrice, feeBuilder.Amount)
 	}
@@ -497,10 +498,10 @@ func (b *Bittrex) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 }
 
 // GetWithdrawalFee returns the fee for withdrawing from the exchange
-func (b *Bittrex) GetWithdrawalFee(c currency.Code) (float64, error) {
+func (b *Bittrex) GetWithdrawalFee(ctx context.Context, c currency.Code) (float64, error) {
 	var fee float64
 
-	currencies, err := b.GetCurrencies()
+	currencies, err := b.GetCurrencies(ctx)
 	if err != nil {
 		return 0, err
 	}
@@ -1,11 +1,13 @@
 package bittrex
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -55,7 +57,7 @@ func TestMain(m *testing.M) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -63,7 +65,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetCurrencies()
+	_, err := b.GetCurrencies(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -71,7 +73,7 @@ func TestGetCurrencies(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(currPair)
+	_, err := b.GetTicker(context.Background(), currPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -79,7 +81,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetMarketSummaries(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketSummaries()
+	_, err := b.GetMarketSummaries(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -87,7 +89,7 @@ func TestGetMarketSummaries(t *testing.T) {
 
 func TestGetMarketSummary(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketSummary(currPair)
+	_, err := b.GetMarketSummary(context.Background(), currPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -96,7 +98,7 @@ func TestGetMarketSummary(t *testing.T) {
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderbook(currPair)
+	_, err := b.GetOrderbook(context.Background(), currPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -105,7 +107,7 @@ func TestGetOrderbook(t *testing.T) {
 func TestGetMarketHistory(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetMarketHistory(currPair)
+	_, err := b.GetMarketHistory(context.Background(), currPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -114,7 +116,7 @@ func TestGetMarketHistory(t *testing.T) {
 func TestPlaceBuyLimit(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.PlaceBuyLimit(currPair, 1, 1)
+	_, err := b.PlaceBuyLimit(context.Background(), currPair, 1, 1)
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -123,7 +125,7 @@ func TestPlaceBuyLimit(t *testing.T) {
 func TestPlaceSellLimit(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.PlaceSellLimit(currPair, 1, 1)
+	_, err := b.PlaceSellLimit(context.Background(), currPair, 1, 1)
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -132,13 +134,13 @@ func TestPlaceSellLimit(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOpenOrders("")
+	_, err := b.GetOpenOrders(context.Background(), "")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetOpenOrders(currPair)
+	_, err = b.GetOpenOrders(context.Background(), currPair)
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -149,7 +151,7 @@ func TestGetOpenOrders(t *testing.T) {
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.CancelExistingOrder("invalid-order")
+	_, err := b.CancelExistingOrder(context.Background(), "invalid-order")
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -158,7 +160,7 @@ func TestCancelExistingOrder(t *testing.T) {
 func TestGetAccountBalances(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAccountBalances()
+	_, err := b.GetAccountBalances(context.Background())
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -169,7 +171,7 @@ func TestGetAccountBalances(t *testing.T) {
 func TestGetAccountBalanceByCurrency(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetAccountBalanceByCurrency(curr)
+	_, err := b.GetAccountBalanceByCurrency(context.Background(), curr)
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -180,13 +182,13 @@ func TestGetAccountBalanceByCurrency(t *testing.T) {
 func TestGetOrder(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrder("0cb4c4e4-bdc7-4e13-8c13-430e587d2cc1")
+	_, err := b.GetOrder(context.Background(), "0cb4c4e4-bdc7-4e13-8c13-430e587d2cc1")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetOrder("")
+	_, err = b.GetOrder(context.Background(), "")
 	if areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -197,13 +199,13 @@ func TestGetOrder(t *testing.T) {
 func TestGetOrderHistoryForCurrency(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetOrderHistoryForCurrency("")
+	_, err := b.GetOrderHistoryForCurrency(context.Background(), "")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetOrderHistoryForCurrency(currPair)
+	_, err = b.GetOrderHistoryForCurrency(context.Background(), currPair)
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -214,13 +216,13 @@ func TestGetOrderHistoryForCurrency(t *testing.T) {
 func TestGetwithdrawalHistory(t *testing.T) {
 	t.Parallel()
 
-	_, err := b.GetWithdrawalHistory("")
+	_, err := b.GetWithdrawalHistory(context.Background(), "")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetWithdrawalHistory(curr)
+	_, err = b.GetWithdrawalHistory(context.Background(), curr)
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -230,13 +232,13 @@ func TestGetwithdrawalHistory(t *testing.T) {
 
 func TestGetDepositHistory(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetDepositHistory("")
+	_, err := b.GetDepositHistory(context.Background(), "")
 	if areTestAPIKeysSet() && err != nil {
 		t.Error(err)
 	} else if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expected error")
 	}
-	_, err = b.GetDepositHistory(currPair)
+	_, err = b.GetDepositHistory(context.Background(), currPair)
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -254,7 +256,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -269,7 +271,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	// CryptocurrencyTradeFee Basic
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0025) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0025) || err != nil {
 		t.Error(err)
 		t.Errorf("Expected: %f, Received: %f", float64(0.0025), resp)
 	}
@@ -278,7 +280,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(2500) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(2500) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(2500), resp)
 		t.Error(err)
 	}
@@ -286,7 +288,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0025) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0025) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0.0025), resp)
 		t.Error(err)
 	}
@@ -294,7 +296,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -302,7 +304,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -310,7 +312,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -319,7 +321,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -328,7 +330,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.HKD
-	if resp, err := b.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := b.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -350,7 +352,7 @@ func TestGetActiveOrders(t *testing.T) {
 
 	getOrdersRequest.Currencies[0].Delimiter = "-"
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -363,7 +365,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -390,11 +392,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -415,7 +417,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -437,7 +439,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -455,7 +457,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := b.ModifyOrder(&order.Modify{})
+	_, err := b.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("Expected error")
 	}
@@ -475,7 +477,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := b.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := b.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -490,7 +492,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := b.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -502,7 +504,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := b.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := b.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -510,12 +512,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error(err)
 		}
 	} else {
-		_, err := b.GetDepositAddress(currency.BTC, "")
+		_, err := b.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("error cannot be nil")
 		}
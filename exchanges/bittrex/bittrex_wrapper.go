@@ -1,11 +1,14 @@
 package bittrex
 
 import (
+	"context"
 	"errors"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -35,7 +38,7 @@ func (b *Bittrex) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -156,7 +159,7 @@ func (b *Bittrex) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(forceUpdate)
+	err := b.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -166,8 +169,8 @@ func (b *Bittrex) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *Bittrex) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	markets, err := b.GetMarkets()
+func (b *Bittrex) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	markets, err := b.GetMarkets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -185,8 +188,8 @@ func (b *Bittrex) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *Bittrex) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+func (b *Bittrex) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -196,10 +199,10 @@ func (b *Bittrex) UpdateTradablePairs(forceUpdate bool) error {
 
 // UpdateAccountInfo Retrieves balances for all enabled currencies for the
 // Bittrex exchange
-func (b *Bittrex) UpdateAccountInfo() (account.Holdings, error) {
+func (b *Bittrex) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = b.Name
-	accountBalance, err := b.GetAccountBalances()
+	accountBalance, err := b.GetAccountBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -208,8 +211,8 @@ func (b *Bittrex) UpdateAccountInfo() (account.Holdings, error) {
 	for i := range accountBalance.Result {
 		var exchangeCurrency account.Balance
 		exchangeCurrency.CurrencyName = currency.NewCode(accountBalance.Result[i].Currency)
-		exchangeCurrency.TotalValue = accountBalance.Result[i].Balance
-		exchangeCurrency.Hold = accountBalance.Result[i].Balance - accountBalance.Result[i].Available
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance.Result[i].Balance)
+		exchangeCurrency.Hold = decimal.NewFromFloat(accountBalance.Result[i].Balance).Sub(decimal.NewFromFloat(accountBalance.Result[i].Available))
 		currencies = append(currencies, exchangeCurrency)
 	}
 
@@ -226,19 +229,19 @@ func (b *Bittrex) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *Bittrex) FetchAccountInfo() (account.Holdings, error) {
+func (b *Bittrex) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *Bittrex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bittrex) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	ticks, err := b.GetMarketSummaries()
+	ticks, err := b.GetMarketSummaries(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -276,27 +279,27 @@ func (b *Bittrex) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.P
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *Bittrex) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *Bittrex) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tick, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // FetchOrderbook returns the orderbook for a currency pair
-func (b *Bittrex) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bittrex) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *Bittrex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *Bittrex) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := b.GetOrderbook(b.FormatExchangeCurrency(p, assetType).String())
+	orderbookNew, err := b.GetOrderbook(ctx, b.FormatExchangeCurrency(p, assetType).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -333,17 +336,40 @@ func (b *Bittrex) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*order
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *Bittrex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *Bittrex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (b *Bittrex) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (b *Bittrex) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (b *Bittrex) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (b *Bittrex) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *Bittrex) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *Bittrex) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *Bittrex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *Bittrex) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -358,13 +384,13 @@ func (b *Bittrex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	var response UUID
 	var err error
 	if buy {
-		response, err = b.PlaceBuyLimit(s.Pair.String(),
-			s.Amount,
-			s.Price)
+		response, err = b.PlaceBuyLimit(ctx, s.Pair.String(),
+			s.Amount.Float64(),
+			s.Price.Float64())
 	} else {
-		response, err = b.PlaceSellLimit(s.Pair.String(),
-			s.Amount,
-			s.Price)
+		response, err = b.PlaceSellLimit(ctx, s.Pair.String(),
+			s.Amount.Float64(),
+			s.Price.Float64())
 	}
 	if err != nil {
 		return submitOrderResponse, err
@@ -380,29 +406,29 @@ func (b *Bittrex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *Bittrex) ModifyOrder(action *order.Modify) (string, error) {
+func (b *Bittrex) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *Bittrex) CancelOrder(order *order.Cancel) error {
-	_, err := b.CancelExistingOrder(order.OrderID)
+func (b *Bittrex) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	_, err := b.CancelExistingOrder(ctx, order.OrderID)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *Bittrex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (b *Bittrex) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	openOrders, err := b.GetOpenOrders("")
+	openOrders, err := b.GetOpenOrders(ctx, "")
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
 
 	for i := range openOrders.Result {
-		_, err := b.CancelExistingOrder(openOrders.Result[i].OrderUUID)
+		_, err := b.CancelExistingOrder(ctx, openOrders.Result[i].OrderUUID)
 		if err != nil {
 			cancelAllOrdersResponse.Status[openOrders.Result[i].OrderUUID] = err.Error()
 		}
@@ -412,14 +438,14 @@ func (b *Bittrex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, err
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *Bittrex) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *Bittrex) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *Bittrex) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	depositAddr, err := b.GetCryptoDepositAddress(cryptocurrency.String())
+func (b *Bittrex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	depositAddr, err := b.GetCryptoDepositAddress(ctx, cryptocurrency.String())
 	if err != nil {
 		return "", err
 	}
@@ -429,20 +455,20 @@ func (b *Bittrex) GetDepositAddress(cryptocurrency currency.Code, _ string) (str
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *Bittrex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	uuid, err := b.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.AddressTag, withdrawRequest.Address, withdrawRequest.Amount)
+func (b *Bittrex) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	uuid, err := b.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.AddressTag, withdrawRequest.Address, withdrawRequest.Amount)
 	return uuid.Result.ID, err
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bittrex) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bittrex) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *Bittrex) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *Bittrex) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -452,22 +478,22 @@ func (b *Bittrex) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *Bittrex) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *Bittrex) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return b.GetFee(feeBuilder)
+	return b.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *Bittrex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bittrex) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var currPair string
 	if len(req.Currencies) == 1 {
 		currPair = req.Currencies[0].String()
 	}
 
-	resp, err := b.GetOpenOrders(currPair)
+	resp, err := b.GetOpenOrders(ctx, currPair)
 	if err != nil {
 		return nil, err
 	}
@@ -489,9 +515,9 @@ func (b *Bittrex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 		orderType := order.Type(strings.ToUpper(resp.Result[i].Type))
 
 		orders = append(orders, order.Detail{
-			Amount:          resp.Result[i].Quantity,
-			RemainingAmount: resp.Result[i].QuantityRemaining,
-			Price:           resp.Result[i].Price,
+			Amount:          decimal.NewFromFloat(resp.Result[i].Quantity),
+			RemainingAmount: decimal.NewFromFloat(resp.Result[i].QuantityRemaining),
+			Price:           decimal.NewFromFloat(resp.Result[i].Price),
 			OrderDate:       orderDate,
 			ID:              resp.Result[i].OrderUUID,
 			Exchange:        b.Name,
@@ -508,13 +534,13 @@ func (b *Bittrex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *Bittrex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *Bittrex) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var currPair string
 	if len(req.Currencies) == 1 {
 		currPair = req.Currencies[0].String()
 	}
 
-	resp, err := b.GetOrderHistoryForCurrency(currPair)
+	resp, err := b.GetOrderHistoryForCurrency(ctx, currPair)
 	if err != nil {
 		return nil, err
 	}
@@ -536,14 +562,14 @@ func (b *Bittrex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 		orderType := order.Type(strings.ToUpper(resp.Result[i].Type))
 
 		orders = append(orders, order.Detail{
-			Amount:          resp.Result[i].Quantity,
-			RemainingAmount: resp.Result[i].QuantityRemaining,
-			Price:           resp.Result[i].Price,
+			Amount:          decimal.NewFromFloat(resp.Result[i].Quantity),
+			RemainingAmount: decimal.NewFromFloat(resp.Result[i].QuantityRemaining),
+			Price:           decimal.NewFromFloat(resp.Result[i].Price),
 			OrderDate:       orderDate,
 			ID:              resp.Result[i].OrderUUID,
 			Exchange:        b.Name,
 			OrderType:       orderType,
-			Fee:             resp.Result[i].Commission,
+			Fee:             decimal.NewFromFloat(resp.Result[i].Commission),
 			CurrencyPair:    pair,
 		})
 	}
@@ -578,7 +604,12 @@ func (b *Bittrex) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *Bittrex) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *Bittrex) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *Bittrex) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
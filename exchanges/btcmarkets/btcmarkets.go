@@ -2,6 +2,7 @@ package btcmarkets
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -81,25 +82,25 @@ type BTCMarkets struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (b *BTCMarkets) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (b *BTCMarkets) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetMarkets returns the BTCMarkets instruments
-func (b *BTCMarkets) GetMarkets() ([]Market, error) {
+func (b *BTCMarkets) GetMarkets(ctx context.Context) ([]Market, error) {
 	var resp []Market
-	return resp, b.SendHTTPRequest(btcMarketsUnauthPath, &resp)
+	return resp, b.SendHTTPRequest(ctx, btcMarketsUnauthPath, &resp)
 }
 
 // GetTicker returns a ticker
 // symbol - example "btc" or "ltc"
-func (b *BTCMarkets) GetTicker(marketID string) (Ticker, error) {
+func (b *BTCMarkets) GetTicker(ctx context.Context, marketID string) (Ticker, error) {
 	var tick Ticker
-	return tick, b.SendHTTPRequest(btcMarketsUnauthPath+marketID+btcMarketsGetTicker, &tick)
+	return tick, b.SendHTTPRequest(ctx, btcMarketsUnauthPath+marketID+btcMarketsGetTicker, &tick)
 }
 
 // GetTrades returns executed trades on the exchange
-func (b *BTCMarkets) GetTrades(marketID string, before, after, limit int64) ([]Trade, error) {
+func (b *BTCMarkets) GetTrades(ctx context.Context, marketID string, before, after, limit int64) ([]Trade, error) {
 	if (before > 0) && (after >= 0) {
 		return nil, errors.New("BTCMarkets only supports either before or after, not both")
 	}
@@ -114,19 +115,19 @@ func (b *BTCMarkets) GetTrades(marketID string, before, after, limit int64) ([]T
 	if limit > 0 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
-	return trades, b.SendHTTPRequest(btcMarketsUnauthPath+marketID+btcMarketsGetTrades+params.Encode(),
+	return trades, b.SendHTTPRequest(ctx, btcMarketsUnauthPath+marketID+btcMarketsGetTrades+params.Encode(),
 		&trades)
 }
 
 // GetOrderbook returns current orderbook
-func (b *BTCMarkets) GetOrderbook(marketID string, level int64) (Orderbook, error) {
+func (b *BTCMarkets) GetOrderbook(ctx context.Context, marketID string, level int64) (Orderbook, error) {
 	var orderbook Orderbook
 	var temp tempOrderbook
 	params := url.Values{}
 	if level != 0 {
 		params.Set("level", strconv.FormatInt(level, 10))
 	}
-	err := b.SendHTTPRequest(btcMarketsUnauthPath+marketID+btcMarketOrderBooks+params.Encode(),
+	err := b.SendHTTPRequest(ctx, btcMarketsUnauthPath+marketID+btcMarketOrderBooks+params.Encode(),
 		&temp)
 	if err != nil {
 		return orderbook, err
@@ -166,7 +167,7 @@ func (b *BTCMarkets) GetOrderbook(marketID string, level int64) (Orderbook, erro
 }
 
 // GetMarketCandles gets candles for specified currency pair
-func (b *BTCMarkets) GetMarketCandles(marketID, timeWindow, from, to string, before, after, limit int64) ([]MarketCandle, error) {
+func (b *BTCMarkets) GetMarketCandles(ctx context.Context, marketID, timeWindow, from, to string, before, after, limit int64) ([]MarketCandle, error) {
 	if (before > 0) && (after >= 0) {
 		return nil, errors.New("BTCMarkets only supports either before or after, not both")
 	}
@@ -191,7 +192,7 @@ func (b *BTCMarkets) GetMarketCandles(marketID, timeWindow, from, to string, bef
 	if limit > 0 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
-	err := b.SendHTTPRequest(btcMarketsUnauthPath+marketID+btcMarketsCandles+params.Encode(),
+	err := b.SendHTTPRequest(ctx, btcMarketsUnauthPath+marketID+btcMarketsCandles+params.Encode(),
 		&temp)
 	if err != nil {
 		return marketCandles, err
@@ -230,18 +231,18 @@ func (b *BTCMarkets) GetMarketCandles(marketID, timeWindow, from, to string, bef
 }
 
 // GetTickers gets multiple tickers
-func (b *BTCMarkets) GetTickers(marketIDs []currency.Pair) ([]Ticker, error) {
+func (b *BTCMarkets) GetTickers(ctx context.Context, marketIDs []currency.Pair) ([]Ticker, error) {
 	var tickers []Ticker
 	params := url.Values{}
 	for x := range marketIDs {
 		params.Add("marketId", marketIDs[x].String())
 	}
-	return tickers, b.SendHTTPRequest(btcMarketsUnauthPath+btcMarketsTickers+params.Encode(),
+	return tickers, b.SendHTTPRequest(ctx, btcMarketsUnauthPath+btcMarketsTickers+params.Encode(),
 		&tickers)
 }
 
 // GetMultipleOrderbooks gets orderbooks
-func (b *BTCMarkets) GetMultipleOrderbooks(marketIDs []string) ([]Orderbook, error) {
+func (b *BTCMarkets) GetMultipleOrderbooks(ctx context.Context, marketIDs []string) ([]Orderbook, error) {
 	var orderbooks []Orderbook
 	var temp []tempOrderbook
 	var tempOB Orderbook
@@ -249,7 +250,7 @@ func (b *BTCMarkets) GetMultipleOrderbooks(marketIDs []string) ([]Orderbook, err
 	for x := range marketIDs {
 		params.Add("marketId", marketIDs[x])
 	}
-	err := b.SendHTTPRequest(btcMarketsUnauthPath+btcMarketsMultipleOrderbooks+params.Encode(),
+	err := b.SendHTTPRequest(ctx, btcMarketsUnauthPath+btcMarketsMultipleOrderbooks+params.Encode(),
 		&temp)
 	if err != nil {
 		return orderbooks, err
@@ -286,17 +287,17 @@ func (b *BTCMarkets) GetMultipleOrderbooks(marketIDs []string) ([]Orderbook, err
 }
 
 // GetServerTime gets time from btcmarkets
-func (b *BTCMarkets) GetServerTime() (time.Time, error) {
+func (b *BTCMarkets) GetServerTime(ctx context.Context) (time.Time, error) {
 	var resp TimeResp
-	return resp.Time, b.SendHTTPRequest(btcMarketsAPIURL+btcMarketsAPIVersion+btcMarketsGetTime,
+	return resp.Time, b.SendHTTPRequest(ctx, btcMarketsAPIURL+btcMarketsAPIVersion+btcMarketsGetTime,
 		&resp)
 }
 
 // GetAccountBalance returns the full account balance
-func (b *BTCMarkets) GetAccountBalance() ([]AccountData, error) {
+func (b *BTCMarkets) GetAccountBalance(ctx context.Context) ([]AccountData, error) {
 	var resp []AccountData
 	return resp,
-		b.SendAuthenticatedRequest(http.MethodGet,
+		b.SendAuthenticatedRequest(ctx, http.MethodGet,
 			btcMarketsAccountBalance,
 			nil,
 			&resp,
@@ -304,9 +305,9 @@ func (b *BTCMarkets) GetAccountBalance() ([]AccountData, error) {
 }
 
 // GetTradingFees returns trading fees for all pairs based on trading activity
-func (b *BTCMarkets) GetTradingFees() (TradingFeeResponse, error) {
+func (b *BTCMarkets) GetTradingFees(ctx context.Context) (TradingFeeResponse, error) {
 	var resp TradingFeeResponse
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsTradingFees,
 		nil,
 		&resp,
@@ -314,7 +315,7 @@ func (b *BTCMarkets) GetTradingFees() (TradingFeeResponse, error) {
 }
 
 // GetTradeHistory returns trade history
-func (b *BTCMarkets) GetTradeHistory(marketID, orderID string, before, after, limit int64) ([]TradeHistoryData, error) {
+func (b *BTCMarkets) GetTradeHistory(ctx context.Context, marketID, orderID string, before, after, limit int64) ([]TradeHistoryData, error) {
 	if (before > 0) && (after >= 0) {
 		return nil, errors.New("BTCMarkets only supports either before or after, not both")
 	}
@@ -335,7 +336,7 @@ func (b *BTCMarkets) GetTradeHistory(marketID, orderID string, before, after, li
 	if limit > 0 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		common.EncodeURLValues(btcMarketsTradeHistory, params),
 		nil,
 		&resp,
@@ -343,9 +344,9 @@ func (b *BTCMarkets) GetTradeHistory(marketID, orderID string, before, after, li
 }
 
 // GetTradeByID returns the singular trade of the ID given
-func (b *BTCMarkets) GetTradeByID(id string) (TradeHistoryData, error) {
+func (b *BTCMarkets) GetTradeByID(ctx context.Context, id string) (TradeHistoryData, error) {
 	var resp TradeHistoryData
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsTradeHistory+"/"+id,
 		nil,
 		&resp,
@@ -353,7 +354,7 @@ func (b *BTCMarkets) GetTradeByID(id string) (TradeHistoryData, error) {
 }
 
 // NewOrder requests a new order and returns an ID
-func (b *BTCMarkets) NewOrder(marketID string, price, amount float64, orderType, side string, triggerPrice,
+func (b *BTCMarkets) NewOrder(ctx context.Context, marketID string, price, amount float64, orderType, side string, triggerPrice,
 	targetAmount float64, timeInForce string, postOnly bool, selfTrade, clientOrderID string) (OrderData, error) {
 	var resp OrderData
 	req := make(map[string]interface{})
@@ -378,7 +379,7 @@ func (b *BTCMarkets) NewOrder(marketID string, price, amount float64, orderType,
 	if clientOrderID != "" {
 		req["clientOrderID"] = clientOrderID
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodPost,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodPost,
 		btcMarketsOrders,
 		req,
 		&resp,
@@ -386,7 +387,7 @@ func (b *BTCMarkets) NewOrder(marketID string, price, amount float64, orderType,
 }
 
 // GetOrders returns current order information on the exchange
-func (b *BTCMarkets) GetOrders(marketID string, before, after, limit int64, openOnly bool) ([]OrderData, error) {
+func (b *BTCMarkets) GetOrders(ctx context.Context, marketID string, before, after, limit int64, openOnly bool) ([]OrderData, error) {
 	if (before > 0) && (after >= 0) {
 		return nil, errors.New("BTCMarkets only supports either before or after, not both")
 	}
@@ -407,7 +408,7 @@ func (b *BTCMarkets) GetOrders(marketID string, before, after, limit int64, open
 	if openOnly {
 		params.Set("status", "open")
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		common.EncodeURLValues(btcMarketsOrders, params),
 		nil,
 		&resp,
@@ -415,7 +416,7 @@ func (b *BTCMarkets) GetOrders(marketID string, before, after, limit int64, open
 }
 
 // CancelAllOpenOrdersByPairs cancels all open orders unless pairs are specified
-func (b *BTCMarkets) CancelAllOpenOrdersByPairs(marketIDs []string) ([]CancelOrderResp, error) {
+func (b *BTCMarkets) CancelAllOpenOrdersByPairs(ctx context.Context, marketIDs []string) ([]CancelOrderResp, error) {
 	var resp []CancelOrderResp
 	req := make(map[string]interface{})
 	if len(marketIDs) > 0 {
@@ -425,7 +426,7 @@ func (b *BTCMarkets) CancelAllOpenOrdersByPairs(marketIDs []string) ([]CancelOrd
 		}
 		req["marketId"] = strTemp.String()[:strTemp.Len()-1]
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodDelete,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodDelete,
 		btcMarketsOrders,
 		req,
 		&resp,
@@ -433,9 +434,9 @@ func (b *BTCMarkets) CancelAllOpenOrdersByPairs(marketIDs []string) ([]CancelOrd
 }
 
 // FetchOrder finds order based on the provided id
-func (b *BTCMarkets) FetchOrder(id string) (OrderData, error) {
+func (b *BTCMarkets) FetchOrder(ctx context.Context, id string) (OrderData, error) {
 	var resp OrderData
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsOrders+"/"+id,
 		nil,
 		&resp,
@@ -443,9 +444,9 @@ func (b *BTCMarkets) FetchOrder(id string) (OrderData, error) {
 }
 
 // RemoveOrder removes a given order
-func (b *BTCMarkets) RemoveOrder(id string) (CancelOrderResp, error) {
+func (b *BTCMarkets) RemoveOrder(ctx context.Context, id string) (CancelOrderResp, error) {
 	var resp CancelOrderResp
-	return resp, b.SendAuthenticatedRequest(http.MethodDelete,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodDelete,
 		btcMarketsOrders+"/"+id,
 		nil,
 		&resp,
@@ -453,7 +454,7 @@ func (b *BTCMarkets) RemoveOrder(id string) (CancelOrderResp, error) {
 }
 
 // ListWithdrawals lists the withdrawal history
-func (b *BTCMarkets) ListWithdrawals(before, after, limit int64) ([]TransferData, error) {
+func (b *BTCMarkets) ListWithdrawals(ctx context.Context, before, after, limit int64) ([]TransferData, error) {
 	if (before > 0) && (after >= 0) {
 		return nil, errors.New("BTCMarkets only supports either before or after, not both")
 	}
@@ -468,7 +469,7 @@ func (b *BTCMarkets) ListWithdrawals(before, after, limit int64) ([]TransferData
 	if limit > 0 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		common.EncodeURLValues(btcMarketsWithdrawals, params),
 		nil,
 		&resp,
@@ -476,12 +477,12 @@ func (b *BTCMarkets) ListWithdrawals(before, after, limit int64) ([]TransferData
 }
 
 // GetWithdrawal gets withdrawawl info for a given id
-func (b *BTCMarkets) GetWithdrawal(id string) (TransferData, error) {
+func (b *BTCMarkets) GetWithdrawal(ctx context.Context, id string) (TransferData, error) {
 	var resp TransferData
 	if id == "" {
 		return resp, errors.New("id cannot be an empty string")
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsWithdrawals+"/"+id,
 		nil,
 		&resp,
@@ -489,7 +490,7 @@ func (b *BTCMarkets) GetWithdrawal(id string) (TransferData, error) {
 }
 
 // ListDeposits lists the deposit history
-func (b *BTCMarkets) ListDeposits(before, after, limit int64) ([]TransferData, error) {
+func (b *BTCMarkets) ListDeposits(ctx context.Context, before, after, limit int64) ([]TransferData, error) {
 	if (before > 0) && (after >= 0) {
 		return nil, errors.New("BTCMarkets only supports either before or after, not both")
 	}
@@ -504,7 +505,7 @@ func (b *BTCMarkets) ListDeposits(before, after, limit int64) ([]TransferData, e
 	if limit > 0 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		common.EncodeURLValues(btcMarketsDeposits, params),
 		nil,
 		&resp,
@@ -512,9 +513,9 @@ func (b *BTCMarkets) ListDeposits(before, after, limit int64) ([]TransferData, e
 }
 
 // GetDeposit gets deposit info for a given ID
-func (b *BTCMarkets) GetDeposit(id string) (TransferData, error) {
+func (b *BTCMarkets) GetDeposit(ctx context.Context, id string) (TransferData, error) {
 	var resp TransferData
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsDeposits+"/"+id,
 		nil,
 		&resp,
@@ -522,7 +523,7 @@ func (b *BTCMarkets) GetDeposit(id string) (TransferData, error) {
 }
 
 // ListTransfers lists the past asset transfers
-func (b *BTCMarkets) ListTransfers(before, after, limit int64) ([]TransferData, error) {
+func (b *BTCMarkets) ListTransfers(ctx context.Context, before, after, limit int64) ([]TransferData, error) {
 	if (before > 0) && (after >= 0) {
 		return nil, errors.New("BTCMarkets only supports either before or after, not both")
 	}
@@ -537,7 +538,7 @@ func (b *BTCMarkets) ListTransfers(before, after, limit int64) ([]TransferData,
 	if limit > 0 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		common.EncodeURLValues(btcMarketsTransfers, params),
 		nil,
 		&resp,
@@ -545,9 +546,9 @@ func (b *BTCMarkets) ListTransfers(before, after, limit int64) ([]TransferData,
 }
 
 // GetTransfer gets asset transfer info for a given ID
-func (b *BTCMarkets) GetTransfer(id string) (TransferData, error) {
+func (b *BTCMarkets) GetTransfer(ctx context.Context, id string) (TransferData, error) {
 	var resp TransferData
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsTransfers+"/"+id,
 		nil,
 		&resp,
@@ -555,7 +556,7 @@ func (b *BTCMarkets) GetTransfer(id string) (TransferData, error) {
 }
 
 // FetchDepositAddress gets deposit address for the given asset
-func (b *BTCMarkets) FetchDepositAddress(assetName string, before, after, limit int64) (DepositAddress, error) {
+func (b *BTCMarkets) FetchDepositAddress(ctx context.Context, assetName string, before, after, limit int64) (DepositAddress, error) {
 	var resp DepositAddress
 	if (before > 0) && (after >= 0) {
 		return resp, errors.New("BTCMarkets only supports either before or after, not both")
@@ -571,7 +572,7 @@ func (b *BTCMarkets) FetchDepositAddress(assetName string, before, after, limit
 	if limit > 0 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		common.EncodeURLValues(btcMarketsAddresses, params),
 		nil,
 		&resp,
@@ -579,16 +580,16 @@ func (b *BTCMarkets) FetchDepositAddress(assetName string, before, after, limit
 }
 
 // GetWithdrawalFees gets withdrawal fees for all assets
-func (b *BTCMarkets) GetWithdrawalFees() ([]WithdrawalFeeData, error) {
+func (b *BTCMarkets) GetWithdrawalFees(ctx context.Context) ([]WithdrawalFeeData, error) {
 	var resp []WithdrawalFeeData
-	return resp, b.SendHTTPRequest(btcMarketsAPIURL+btcMarketsAPIVersion+btcMarketsWithdrawalFees,
+	return resp, b.SendHTTPRequest(ctx, btcMarketsAPIURL+btcMarketsAPIVersion+btcMarketsWithdrawalFees,
 		&resp)
 }
 
 // ListAssets lists all available assets
-func (b *BTCMarkets) ListAssets() ([]AssetData, error) {
+func (b *BTCMarkets) ListAssets(ctx context.Context) ([]AssetData, error) {
 	var resp []AssetData
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsAssets,
 		nil,
 		&resp,
@@ -596,7 +597,7 @@ func (b *BTCMarkets) ListAssets() ([]AssetData, error) {
 }
 
 // GetTransactions gets trading fees
-func (b *BTCMarkets) GetTransactions(assetName string, before, after, limit int64) ([]TransactionData, error) {
+func (b *BTCMarkets) GetTransactions(ctx context.Context, assetName string, before, after, limit int64) ([]TransactionData, error) {
 	if (before > 0) && (after >= 0) {
 		return nil, errors.New("BTCMarkets only supports either before or after, not both")
 	}
@@ -614,7 +615,7 @@ func (b *BTCMarkets) GetTransactions(assetName string, before, after, limit int6
 	if limit > 0 {
 		params.Set("limit", strconv.FormatInt(limit, 10))
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		common.EncodeURLValues(btcMarketsTransactions, params),
 		nil,
 		&resp,
@@ -622,12 +623,12 @@ func (b *BTCMarkets) GetTransactions(assetName string, before, after, limit int6
 }
 
 // CreateNewReport creates a new report
-func (b *BTCMarkets) CreateNewReport(reportType, format string) (CreateReportResp, error) {
+func (b *BTCMarkets) CreateNewReport(ctx context.Context, reportType, format string) (CreateReportResp, error) {
 	var resp CreateReportResp
 	req := make(map[string]interface{})
 	req["type"] = reportType
 	req["format"] = format
-	return resp, b.SendAuthenticatedRequest(http.MethodPost,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodPost,
 		btcMarketsReports,
 		req,
 		&resp,
@@ -635,9 +636,9 @@ func (b *BTCMarkets) CreateNewReport(reportType, format string) (CreateReportRes
 }
 
 // GetReport finds details bout a past report
-func (b *BTCMarkets) GetReport(reportID string) (ReportData, error) {
+func (b *BTCMarkets) GetReport(ctx context.Context, reportID string) (ReportData, error) {
 	var resp ReportData
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsReports+"/"+reportID,
 		nil,
 		&resp,
@@ -645,7 +646,7 @@ func (b *BTCMarkets) GetReport(reportID string) (ReportData, error) {
 }
 
 // RequestWithdraw requests withdrawals
-func (b *BTCMarkets) RequestWithdraw(assetName string, amount float64,
+func (b *BTCMarkets) RequestWithdraw(ctx context.Context, assetName string, amount float64,
 	toAddress, accountName, accountNumber, bsbNumber, bankName string) (TransferData, error) {
 	var resp TransferData
 	req := make(map[string]interface{})
@@ -667,7 +668,7 @@ func (b *BTCMarkets) RequestWithdraw(assetName string, amount float64,
 			req["bankName"] = bankName
 		}
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodPost,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodPost,
 		btcMarketsWithdrawals,
 		req,
 		&resp,
@@ -675,7 +676,7 @@ func (b *BTCMarkets) RequestWithdraw(assetName string, amount float64,
 }
 
 // BatchPlaceCancelOrders places and cancels batch orders
-func (b *BTCMarkets) BatchPlaceCancelOrders(cancelOrders []CancelBatch, placeOrders []PlaceBatch) (BatchPlaceCancelResponse, error) {
+func (b *BTCMarkets) BatchPlaceCancelOrders(ctx context.Context, cancelOrders []CancelBatch, placeOrders []PlaceBatch) (BatchPlaceCancelResponse, error) {
 	var resp BatchPlaceCancelResponse
 	var orderRequests []interface{}
 	if len(cancelOrders)+len(placeOrders) > 4 {
@@ -690,7 +691,7 @@ func (b *BTCMarkets) BatchPlaceCancelOrders(cancelOrders []CancelBatch, placeOrd
 		}
 		orderRequests = append(orderRequests, PlaceOrderMethod{PlaceOrder: placeOrders[y]})
 	}
-	return resp, b.SendAuthenticatedRequest(http.MethodPost,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodPost,
 		btcMarketsBatchOrders,
 		orderRequests,
 		&resp,
@@ -698,13 +699,13 @@ func (b *BTCMarkets) BatchPlaceCancelOrders(cancelOrders []CancelBatch, placeOrd
 }
 
 // GetBatchTrades gets batch trades
-func (b *BTCMarkets) GetBatchTrades(ids []string) (BatchTradeResponse, error) {
+func (b *BTCMarkets) GetBatchTrades(ctx context.Context, ids []string) (BatchTradeResponse, error) {
 	var resp BatchTradeResponse
 	if len(ids) > 50 {
 		return resp, errors.New("batchtrades can only handle 50 ids at a time")
 	}
 	marketIDs := strings.Join(ids, ",")
-	return resp, b.SendAuthenticatedRequest(http.MethodGet,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodGet,
 		btcMarketsBatchOrders+"/"+marketIDs,
 		nil,
 		&resp,
@@ -712,10 +713,10 @@ func (b *BTCMarkets) GetBatchTrades(ids []string) (BatchTradeResponse, error) {
 }
 
 // CancelBatchOrders cancels given ids
-func (b *BTCMarkets) CancelBatchOrders(ids []string) (BatchCancelResponse, error) {
+func (b *BTCMarkets) CancelBatchOrders(ctx context.Context, ids []string) (BatchCancelResponse, error) {
 	var resp BatchCancelResponse
 	marketIDs := strings.Join(ids, ",")
-	return resp, b.SendAuthenticatedRequest(http.MethodDelete,
+	return resp, b.SendAuthenticatedRequest(ctx, http.MethodDelete,
 		btcMarketsBatchOrders+"/"+marketIDs,
 		nil,
 		&resp,
@@ -723,8 +724,8 @@ func (b *BTCMarkets) CancelBatchOrders(ids []string) (BatchCancelResponse, error
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (b *BTCMarkets) SendHTTPRequest(path string, result interface{}) error {
-	return b.SendPayload(&request.Item{
+func (b *BTCMarkets) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return b.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -735,13 +736,13 @@ func (b *BTCMarkets) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedRequest sends an authenticated HTTP request
-func (b *BTCMarkets) SendAuthenticatedRequest(method, path string, data, result interface{}, f request.EndpointLimit) (err error) {
+func (b *BTCMarkets) SendAuthenticatedRequest(ctx context.Context, method, path string, data, result interface{}, f request.EndpointLimit) (err error) {
 	if !b.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			b.Name)
 	}
 
-	strTime := strconv.FormatInt(time.Now().UTC().UnixNano()/1000000, 10)
+	strTime := strconv.FormatInt(b.Requester.Now().UTC().UnixNano()/1000000, 10)
 
 	var body io.Reader
 	var payload, hmac []byte
@@ -770,7 +771,7 @@ func (b *BTCMarkets) SendAuthenticatedRequest(method, path string, data, result
 	headers["BM-AUTH-TIMESTAMP"] = strTime
 	headers["BM-AUTH-SIGNATURE"] = crypto.Base64Encode(hmac)
 
-	return b.SendPayload(&request.Item{
+	return b.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          btcMarketsAPIURL + btcMarketsAPIVersion + path,
 		Headers:       headers,
@@ -786,12 +787,12 @@ func (b *BTCMarkets) SendAuthenticatedRequest(method, path string, data, result
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (b *BTCMarkets) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *BTCMarkets) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		temp, err := b.GetTradingFees()
+		temp, err := b.GetTradingFees(ctx)
 		if err != nil {
 			return fee, err
 		}
@@ -804,7 +805,7 @@ func (b *BTCMarkets) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 			}
 		}
 	case exchange.CryptocurrencyWithdrawalFee:
-		temp, err := b.GetWithdrawalFees()
+		temp, err := b.GetWithdrawalFees(ctx)
 		if err != nil {
 			return fee, err
 		}
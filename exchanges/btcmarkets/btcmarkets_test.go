@@ -1,6 +1,8 @@
 package btcmarkets
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
 	"log"
 	"os"
@@ -46,7 +48,7 @@ func TestMain(m *testing.M) {
 		log.Fatal(err)
 	}
 
-	err = b.ValidateCredentials()
+	err = b.ValidateCredentials(context.Background())
 	if err != nil {
 		fmt.Println("API credentials are invalid:", err)
 		b.API.AuthenticatedSupport = false
@@ -62,7 +64,7 @@ func areTestAPIKeysSet() bool {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -70,7 +72,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(BTCAUD)
+	_, err := b.GetTicker(context.Background(), BTCAUD)
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
@@ -78,7 +80,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTrades(BTCAUD, 0, 0, 5)
+	_, err := b.GetTrades(context.Background(), BTCAUD, 0, 0, 5)
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
@@ -86,7 +88,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetOrderbook(BTCAUD, 2)
+	_, err := b.GetOrderbook(context.Background(), BTCAUD, 2)
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
@@ -94,7 +96,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetMarketCandles(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketCandles(BTCAUD, "", "", "", 0, 0, 5)
+	_, err := b.GetMarketCandles(context.Background(), BTCAUD, "", "", "", 0, 0, 5)
 	if err != nil {
 		t.Error(err)
 	}
@@ -103,7 +105,7 @@ func TestGetMarketCandles(t *testing.T) {
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
 	temp := currency.NewPairsFromStrings([]string{LTCAUD, BTCAUD})
-	_, err := b.GetTickers(temp)
+	_, err := b.GetTickers(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
@@ -112,7 +114,7 @@ func TestGetTickers(t *testing.T) {
 func TestGetMultipleOrderbooks(t *testing.T) {
 	t.Parallel()
 	temp := []string{BTCAUD, LTCAUD, ETHAUD}
-	_, err := b.GetMultipleOrderbooks(temp)
+	_, err := b.GetMultipleOrderbooks(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
@@ -120,7 +122,7 @@ func TestGetMultipleOrderbooks(t *testing.T) {
 
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetServerTime()
+	_, err := b.GetServerTime(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -131,7 +133,7 @@ func TestGetAccountBalance(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -142,7 +144,7 @@ func TestGetTradingFees(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTradingFees()
+	_, err := b.GetTradingFees(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -153,15 +155,15 @@ func TestGetTradeHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTradeHistory(ETHAUD, "", -1, -1, -1)
+	_, err := b.GetTradeHistory(context.Background(), ETHAUD, "", -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.GetTradeHistory(BTCAUD, "", -1, -1, 1)
+	_, err = b.GetTradeHistory(context.Background(), BTCAUD, "", -1, -1, 1)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.GetTradeHistory(fakePair, "", -1, -1, -1)
+	_, err = b.GetTradeHistory(context.Background(), fakePair, "", -1, -1, -1)
 	if err == nil {
 		t.Error("expected an error due to invalid trading pair")
 	}
@@ -172,7 +174,7 @@ func TestGetTradeByID(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTradeByID("4712043732")
+	_, err := b.GetTradeByID(context.Background(), "4712043732")
 	if err != nil {
 		t.Error(err)
 	}
@@ -183,15 +185,15 @@ func TestNewOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := b.NewOrder(BTCAUD, 100, 1, limit, bid, 0, 0, "", true, "", "")
+	_, err := b.NewOrder(context.Background(), BTCAUD, 100, 1, limit, bid, 0, 0, "", true, "", "")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.NewOrder(BTCAUD, 100, 1, "invalid", bid, 0, 0, "", true, "", "")
+	_, err = b.NewOrder(context.Background(), BTCAUD, 100, 1, "invalid", bid, 0, 0, "", true, "", "")
 	if err == nil {
 		t.Error("expected an error due to invalid ordertype")
 	}
-	_, err = b.NewOrder(BTCAUD, 100, 1, limit, "invalid", 0, 0, "", true, "", "")
+	_, err = b.NewOrder(context.Background(), BTCAUD, 100, 1, limit, "invalid", 0, 0, "", true, "", "")
 	if err == nil {
 		t.Error("expected an error due to invalid orderside")
 	}
@@ -202,11 +204,11 @@ func TestGetOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetOrders("", -1, -1, 2, false)
+	_, err := b.GetOrders(context.Background(), "", -1, -1, 2, false)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.GetOrders(LTCAUD, -1, -1, -1, true)
+	_, err = b.GetOrders(context.Background(), LTCAUD, -1, -1, -1, true)
 	if err != nil {
 		t.Error(err)
 	}
@@ -218,12 +220,12 @@ func TestCancelOpenOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 	temp := []string{BTCAUD, LTCAUD}
-	_, err := b.CancelAllOpenOrdersByPairs(temp)
+	_, err := b.CancelAllOpenOrdersByPairs(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
 	temp = []string{BTCAUD, fakePair}
-	_, err = b.CancelAllOpenOrdersByPairs(temp)
+	_, err = b.CancelAllOpenOrdersByPairs(context.Background(), temp)
 	if err == nil {
 		t.Error("expected an error due to invalid marketID")
 	}
@@ -234,11 +236,11 @@ func TestFetchOrder(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.FetchOrder("4477045999")
+	_, err := b.FetchOrder(context.Background(), "4477045999")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.FetchOrder("696969")
+	_, err = b.FetchOrder(context.Background(), "696969")
 	if err == nil {
 		t.Error(err)
 	}
@@ -249,7 +251,7 @@ func TestRemoveOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := b.RemoveOrder("")
+	_, err := b.RemoveOrder(context.Background(), "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -260,7 +262,7 @@ func TestListWithdrawals(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.ListWithdrawals(-1, -1, -1)
+	_, err := b.ListWithdrawals(context.Background(), -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -271,7 +273,7 @@ func TestGetWithdrawal(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetWithdrawal("4477381751")
+	_, err := b.GetWithdrawal(context.Background(), "4477381751")
 	if err != nil {
 		t.Error(err)
 	}
@@ -282,7 +284,7 @@ func TestListDeposits(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.ListDeposits(-1, -1, -1)
+	_, err := b.ListDeposits(context.Background(), -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -293,7 +295,7 @@ func TestGetDeposit(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetDeposit("4476769607")
+	_, err := b.GetDeposit(context.Background(), "4476769607")
 	if err != nil {
 		t.Error(err)
 	}
@@ -304,7 +306,7 @@ func TestListTransfers(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.ListTransfers(-1, -1, -1)
+	_, err := b.ListTransfers(context.Background(), -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -315,11 +317,11 @@ func TestGetTransfer(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTransfer("4476769607")
+	_, err := b.GetTransfer(context.Background(), "4476769607")
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.GetTransfer("6969696")
+	_, err = b.GetTransfer(context.Background(), "6969696")
 	if err == nil {
 		t.Error("expected an error due to invalid transferID")
 	}
@@ -330,11 +332,11 @@ func TestFetchDepositAddress(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.FetchDepositAddress("LTC", -1, -1, -1)
+	_, err := b.FetchDepositAddress(context.Background(), "LTC", -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = b.FetchDepositAddress(fakePair, -1, -1, -1)
+	_, err = b.FetchDepositAddress(context.Background(), fakePair, -1, -1, -1)
 	if err != nil {
 		t.Error("expected an error due to invalid assetID")
 	}
@@ -342,7 +344,7 @@ func TestFetchDepositAddress(t *testing.T) {
 
 func TestGetWithdrawalFees(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetWithdrawalFees()
+	_, err := b.GetWithdrawalFees(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -353,7 +355,7 @@ func TestListAssets(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.ListAssets()
+	_, err := b.ListAssets(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -364,7 +366,7 @@ func TestGetTransactions(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetTransactions("", -1, -1, -1)
+	_, err := b.GetTransactions(context.Background(), "", -1, -1, -1)
 	if err != nil {
 		t.Error(err)
 	}
@@ -375,7 +377,7 @@ func TestCreateNewReport(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.CreateNewReport("TransactionReport", "json")
+	_, err := b.CreateNewReport(context.Background(), "TransactionReport", "json")
 	if err != nil {
 		t.Error(err)
 	}
@@ -386,7 +388,7 @@ func TestGetReport(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.GetReport("1kv38epne5v7lek9f18m60idg6")
+	_, err := b.GetReport(context.Background(), "1kv38epne5v7lek9f18m60idg6")
 	if err != nil {
 		t.Error(err)
 	}
@@ -397,7 +399,7 @@ func TestRequestWithdaw(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := b.RequestWithdraw("BTC", 1, "sdjflajdslfjld", "", "", "", "")
+	_, err := b.RequestWithdraw(context.Background(), "BTC", 1, "sdjflajdslfjld", "", "", "", "")
 	if err == nil {
 		t.Error("expected an error due to invalid toAddress")
 	}
@@ -416,7 +418,7 @@ func TestBatchPlaceCancelOrders(t *testing.T) {
 		OrderType: order.Limit.String(),
 		Side:      bid,
 	}
-	_, err := b.BatchPlaceCancelOrders(nil, append(temp, o))
+	_, err := b.BatchPlaceCancelOrders(context.Background(), nil, append(temp, o))
 	if err != nil {
 		t.Error(err)
 	}
@@ -428,7 +430,7 @@ func TestGetBatchTrades(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	temp := []string{"4477045999", "4477381751", "4476769607"}
-	_, err := b.GetBatchTrades(temp)
+	_, err := b.GetBatchTrades(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
@@ -440,7 +442,7 @@ func TestCancelBatchOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 	temp := []string{"4477045999", "4477381751", "4477381751"}
-	_, err := b.CancelBatchOrders(temp)
+	_, err := b.CancelBatchOrders(context.Background(), temp)
 	if err != nil {
 		t.Error(err)
 	}
@@ -451,7 +453,7 @@ func TestGetAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := b.UpdateAccountInfo()
+	_, err := b.UpdateAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -463,7 +465,7 @@ func TestGetOrderHistory(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 
-	_, err := b.GetOrderHistory(&order.GetOrdersRequest{
+	_, err := b.GetOrderHistory(context.Background(), &order.GetOrdersRequest{
 		OrderSide: order.Buy,
 	})
 	if err != nil {
@@ -474,7 +476,7 @@ func TestGetOrderHistory(t *testing.T) {
 func TestUpdateOrderbook(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.AUD.String(), "-")
-	_, err := b.UpdateOrderbook(cp, asset.Spot)
+	_, err := b.UpdateOrderbook(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -483,7 +485,7 @@ func TestUpdateOrderbook(t *testing.T) {
 func TestUpdateTicker(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.AUD.String(), "-")
-	_, err := b.UpdateTicker(cp, asset.Spot)
+	_, err := b.UpdateTicker(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -495,8 +497,37 @@ func TestGetActiveOrders(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 
-	_, err := b.GetActiveOrders(&order.GetOrdersRequest{})
+	_, err := b.GetActiveOrders(context.Background(), &order.GetOrdersRequest{})
 	if err != nil {
 		t.Fatal(err)
 	}
 }
+
+func TestWsExecutionReports(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"orderId":79003,"marketId":"BTC-AUD","side":"Bid","type":"Limit","openVolume":"1.1","status":"Partially Matched","triggerStatus":"","trades":[{"tradeId":31727,"price":"14150.12","volume":"0.1","fee":"0.0143","liquidityType":"Taker"}],"timestamp":"2019-04-08T20:50:39.658Z","messageType":"orderChange"}`)
+	var o WsOrderChange
+	err := json.Unmarshal(pressXToJSON, &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	reports, err := b.wsExecutionReports(&o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(reports) != 1 {
+		t.Fatalf("expected a report for each trade, received %+v", reports)
+	}
+	r := reports[0]
+	if r.OrderID != "79003" || r.TradeID != "31727" || r.Side != order.Buy ||
+		r.Pair.String() != BTCAUD || r.Price.Float64() != 14150.12 || r.Amount.Float64() != 0.1 ||
+		r.Fee.Float64() != 0.0143 || r.FeeCurrency != currency.AUD || r.Liquidity != order.Taker ||
+		r.Timestamp.IsZero() {
+		t.Errorf("unexpected execution report %+v", r)
+	}
+
+	o.Trades = nil
+	if reports, err = b.wsExecutionReports(&o); err != nil || len(reports) != 0 {
+		t.Errorf("expected no reports for an order without trades, received %v %v", reports, err)
+	}
+}
@@ -6,11 +6,11 @@ import (
 	"fmt"
 	"net/http"
 	"strconv"
-	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -71,7 +71,7 @@ func (b *BTCMarkets) WsHandleData() {
 			var wsResponse WsMessageType
 			err = json.Unmarshal(resp.Raw, &wsResponse)
 			if err != nil {
-				b.Websocket.DataHandler <- err
+				b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 				continue
 			}
 			switch wsResponse.MessageType {
@@ -83,7 +83,7 @@ func (b *BTCMarkets) WsHandleData() {
 				var ob WsOrderbook
 				err := json.Unmarshal(resp.Raw, &ob)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -144,7 +144,7 @@ func (b *BTCMarkets) WsHandleData() {
 				var trade WsTrade
 				err := json.Unmarshal(resp.Raw, &trade)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				p := currency.NewPairFromString(trade.Currency)
@@ -162,7 +162,7 @@ func (b *BTCMarkets) WsHandleData() {
 				var tick WsTick
 				err := json.Unmarshal(resp.Raw, &tick)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -184,7 +184,7 @@ func (b *BTCMarkets) WsHandleData() {
 				var transferData WsFundTransfer
 				err := json.Unmarshal(resp.Raw, &transferData)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				b.Websocket.DataHandler <- transferData
@@ -192,15 +192,23 @@ func (b *BTCMarkets) WsHandleData() {
 				var orderData WsOrderChange
 				err := json.Unmarshal(resp.Raw, &orderData)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				b.Websocket.DataHandler <- orderData
+				reports, err := b.wsExecutionReports(&orderData)
+				if err != nil {
+					b.Websocket.DataHandler <- err
+					continue
+				}
+				for i := range reports {
+					b.Websocket.DataHandler <- reports[i]
+				}
 			case "error":
 				var wsErr WsError
 				err := json.Unmarshal(resp.Raw, &wsErr)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				b.Websocket.DataHandler <- fmt.Errorf("%v websocket error. Code: %v Message: %v", b.Name, wsErr.Code, wsErr.Message)
@@ -211,6 +219,43 @@ func (b *BTCMarkets) WsHandleData() {
 	}
 }
 
+// wsExecutionReports converts the trades filling an order into normalised
+// execution reports. BTC Markets charges fees in the quote currency
+func (b *BTCMarkets) wsExecutionReports(o *WsOrderChange) ([]order.ExecutionReport, error) {
+	if len(o.Trades) == 0 {
+		return nil, nil
+	}
+	side, err := order.StringToOrderSide(o.Side)
+	if err != nil {
+		return nil, err
+	}
+	switch side {
+	case order.Bid:
+		side = order.Buy
+	case order.Ask:
+		side = order.Sell
+	}
+	p := currency.NewPairDelimiter(o.MarketID, "-")
+	reports := make([]order.ExecutionReport, len(o.Trades))
+	for i := range o.Trades {
+		reports[i] = order.ExecutionReport{
+			Exchange:    b.Name,
+			AssetType:   asset.Spot,
+			Pair:        p,
+			OrderID:     strconv.FormatInt(o.OrderID, 10),
+			TradeID:     strconv.FormatInt(o.Trades[i].TradeID, 10),
+			Side:        side,
+			Price:       decimal.NewFromFloat(o.Trades[i].Price),
+			Amount:      decimal.NewFromFloat(o.Trades[i].Volume),
+			Fee:         decimal.NewFromFloat(o.Trades[i].Fee),
+			FeeCurrency: p.Quote,
+			Liquidity:   order.StringToLiquidity(o.Trades[i].LiquidityType),
+			Timestamp:   o.Timestamp,
+		}
+	}
+	return reports, nil
+}
+
 func (b *BTCMarkets) generateDefaultSubscriptions() {
 	var channels = []string{tick, trade, wsOB}
 	enabledCurrencies := b.GetEnabledPairs(asset.Spot)
@@ -262,7 +307,7 @@ func (b *BTCMarkets) Subscribe(channelToSubscribe wshandler.WebsocketChannelSubs
 // Login logs in allowing private ws events
 func (b *BTCMarkets) generateAuthSubscriptions() WsAuthSubscribe {
 	var authSubInfo WsAuthSubscribe
-	signTime := strconv.FormatInt(time.Now().UTC().UnixNano()/1000000, 10)
+	signTime := strconv.FormatInt(b.Requester.Now().UTC().UnixNano()/1000000, 10)
 	strToSign := "/users/self/subscribe" + "\n" + signTime
 	tempSign := crypto.GetHMAC(crypto.HashSHA512,
 		[]byte(strToSign),
@@ -1,6 +1,7 @@
 package btcmarkets
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +39,7 @@ func (b *BTCMarkets) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -153,10 +155,13 @@ func (b *BTCMarkets) Setup(exch *config.ExchangeConfig) error {
 	b.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         b.Name,
 		URL:                  b.Websocket.GetWebsocketURL(),
+		AlternateURLs:        b.Websocket.GetAlternateURLs(),
 		ProxyURL:             b.Websocket.GetProxyAddress(),
 		Verbose:              b.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	return nil
@@ -181,6 +186,9 @@ func (b *BTCMarkets) Run() {
 			btcMarketsWSURL)
 		b.PrintEnabledPairs()
 	}
+
+	b.SyncServerTime(context.Background(), b.GetServerTime)
+
 	forceUpdate := false
 	delim := b.GetPairFormat(asset.Spot, false).Delimiter
 	if !common.StringDataContains(b.GetEnabledPairs(asset.Spot).Strings(), delim) ||
@@ -207,7 +215,7 @@ func (b *BTCMarkets) Run() {
 		return
 	}
 
-	err := b.UpdateTradablePairs(forceUpdate)
+	err := b.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -217,11 +225,11 @@ func (b *BTCMarkets) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *BTCMarkets) FetchTradablePairs(a asset.Item) ([]string, error) {
+func (b *BTCMarkets) FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error) {
 	if a != asset.Spot {
 		return nil, fmt.Errorf("asset type of %s is not supported by %s", a, b.Name)
 	}
-	markets, err := b.GetMarkets()
+	markets, err := b.GetMarkets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -235,8 +243,8 @@ func (b *BTCMarkets) FetchTradablePairs(a asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *BTCMarkets) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+func (b *BTCMarkets) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -245,9 +253,9 @@ func (b *BTCMarkets) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *BTCMarkets) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *BTCMarkets) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	allPairs := b.GetEnabledPairs(assetType)
-	tickers, err := b.GetTickers(allPairs.Slice())
+	tickers, err := b.GetTickers(ctx, allPairs.Slice())
 	if err != nil {
 		return nil, err
 	}
@@ -270,27 +278,27 @@ func (b *BTCMarkets) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticke
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *BTCMarkets) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *BTCMarkets) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (b *BTCMarkets) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *BTCMarkets) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *BTCMarkets) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *BTCMarkets) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	tempResp, err := b.GetOrderbook(b.FormatExchangeCurrency(p, assetType).String(), 2)
+	tempResp, err := b.GetOrderbook(ctx, b.FormatExchangeCurrency(p, assetType).String(), 2)
 	if err != nil {
 		return orderBook, err
 	}
@@ -315,9 +323,9 @@ func (b *BTCMarkets) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*or
 }
 
 // UpdateAccountInfo retrieves balances for all enabled currencies
-func (b *BTCMarkets) UpdateAccountInfo() (account.Holdings, error) {
+func (b *BTCMarkets) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var resp account.Holdings
-	data, err := b.GetAccountBalance()
+	data, err := b.GetAccountBalance(ctx)
 	if err != nil {
 		return resp, err
 	}
@@ -328,8 +336,8 @@ func (b *BTCMarkets) UpdateAccountInfo() (account.Holdings, error) {
 		total := data[key].Balance
 		acc.Currencies = append(acc.Currencies,
 			account.Balance{CurrencyName: c,
-				TotalValue: total,
-				Hold:       hold})
+				TotalValue: decimal.NewFromFloat(total),
+				Hold:       decimal.NewFromFloat(hold)})
 	}
 	resp.Accounts = append(resp.Accounts, acc)
 	resp.Exchange = b.Name
@@ -343,10 +351,10 @@ func (b *BTCMarkets) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *BTCMarkets) FetchAccountInfo() (account.Holdings, error) {
+func (b *BTCMarkets) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -354,17 +362,40 @@ func (b *BTCMarkets) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *BTCMarkets) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *BTCMarkets) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (b *BTCMarkets) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (b *BTCMarkets) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// AddMargin transfers margin from the account balance to an isolated position
+func (b *BTCMarkets) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (b *BTCMarkets) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *BTCMarkets) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *BTCMarkets) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *BTCMarkets) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *BTCMarkets) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var resp order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return resp, err
@@ -377,13 +408,13 @@ func (b *BTCMarkets) SubmitOrder(s *order.Submit) (order.SubmitResponse, error)
 		s.OrderSide = order.Bid
 	}
 
-	tempResp, err := b.NewOrder(b.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
-		s.Price,
-		s.Amount,
+	tempResp, err := b.NewOrder(ctx, b.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
+		s.Price.Float64(),
+		s.Amount.Float64(),
 		s.OrderType.String(),
 		s.OrderSide.String(),
-		s.TriggerPrice,
-		s.TargetAmount,
+		s.TriggerPrice.Float64(),
+		s.TargetAmount.Float64(),
 		"",
 		false,
 		"",
@@ -398,22 +429,22 @@ func (b *BTCMarkets) SubmitOrder(s *order.Submit) (order.SubmitResponse, error)
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *BTCMarkets) ModifyOrder(action *order.Modify) (string, error) {
+func (b *BTCMarkets) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *BTCMarkets) CancelOrder(o *order.Cancel) error {
-	_, err := b.RemoveOrder(o.OrderID)
+func (b *BTCMarkets) CancelOrder(ctx context.Context, o *order.Cancel) error {
+	_, err := b.RemoveOrder(ctx, o.OrderID)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (b *BTCMarkets) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (b *BTCMarkets) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	var resp order.CancelAllResponse
 	tempMap := make(map[string]string)
 	var orderIDs []string
-	orders, err := b.GetOrders("", -1, -1, -1, true)
+	orders, err := b.GetOrders(ctx, "", -1, -1, -1, true)
 	if err != nil {
 		return resp, err
 	}
@@ -422,7 +453,7 @@ func (b *BTCMarkets) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse,
 	}
 	splitOrders := common.SplitStringSliceByLimit(orderIDs, 20)
 	for z := range splitOrders {
-		tempResp, err := b.CancelBatchOrders(splitOrders[z])
+		tempResp, err := b.CancelBatchOrders(ctx, splitOrders[z])
 		if err != nil {
 			return resp, err
 		}
@@ -438,18 +469,18 @@ func (b *BTCMarkets) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse,
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *BTCMarkets) GetOrderInfo(orderID string) (order.Detail, error) {
+func (b *BTCMarkets) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var resp order.Detail
-	o, err := b.FetchOrder(orderID)
+	o, err := b.FetchOrder(ctx, orderID)
 	if err != nil {
 		return resp, err
 	}
 	resp.Exchange = b.Name
 	resp.ID = orderID
 	resp.CurrencyPair = currency.NewPairFromString(o.MarketID)
-	resp.Price = o.Price
+	resp.Price = decimal.NewFromFloat(o.Price)
 	resp.OrderDate = o.CreationTime
-	resp.ExecutedAmount = o.Amount - o.OpenAmount
+	resp.ExecutedAmount = decimal.NewFromFloat(o.Amount).Sub(decimal.NewFromFloat(o.OpenAmount))
 	resp.OrderSide = order.Bid
 	if o.Side == ask {
 		resp.OrderSide = order.Ask
@@ -468,7 +499,7 @@ func (b *BTCMarkets) GetOrderInfo(orderID string) (order.Detail, error) {
 	default:
 		resp.OrderType = order.Unknown
 	}
-	resp.RemainingAmount = o.OpenAmount
+	resp.RemainingAmount = decimal.NewFromFloat(o.OpenAmount)
 	switch o.Status {
 	case orderAccepted:
 		resp.Status = order.Active
@@ -491,8 +522,8 @@ func (b *BTCMarkets) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *BTCMarkets) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
-	temp, err := b.FetchDepositAddress(strings.ToUpper(cryptocurrency.String()), -1, -1, -1)
+func (b *BTCMarkets) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
+	temp, err := b.FetchDepositAddress(ctx, strings.ToUpper(cryptocurrency.String()), -1, -1, -1)
 	if err != nil {
 		return "", err
 	}
@@ -500,8 +531,8 @@ func (b *BTCMarkets) GetDepositAddress(cryptocurrency currency.Code, accountID s
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is submitted
-func (b *BTCMarkets) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	a, err := b.RequestWithdraw(withdrawRequest.Currency.String(),
+func (b *BTCMarkets) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	a, err := b.RequestWithdraw(ctx, withdrawRequest.Currency.String(),
 		withdrawRequest.Amount,
 		withdrawRequest.Address,
 		"",
@@ -516,11 +547,11 @@ func (b *BTCMarkets) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.Crypt
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *BTCMarkets) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *BTCMarkets) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	if withdrawRequest.Currency != currency.AUD {
 		return "", errors.New("only aud is supported for withdrawals")
 	}
-	a, err := b.RequestWithdraw(withdrawRequest.GenericInfo.Currency.String(),
+	a, err := b.RequestWithdraw(ctx, withdrawRequest.GenericInfo.Currency.String(),
 		withdrawRequest.GenericInfo.Amount,
 		"",
 		withdrawRequest.BankAccountName,
@@ -535,7 +566,7 @@ func (b *BTCMarkets) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (s
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (b *BTCMarkets) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *BTCMarkets) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -545,16 +576,16 @@ func (b *BTCMarkets) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *BTCMarkets) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *BTCMarkets) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return b.GetFee(feeBuilder)
+	return b.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *BTCMarkets) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *BTCMarkets) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		allPairs := b.GetEnabledPairs(asset.Spot)
 		for a := range allPairs {
@@ -565,7 +596,7 @@ func (b *BTCMarkets) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detai
 
 	var resp []order.Detail
 	for x := range req.Currencies {
-		tempData, err := b.GetOrders(b.FormatExchangeCurrency(req.Currencies[x], asset.Spot).String(), -1, -1, -1, true)
+		tempData, err := b.GetOrders(ctx, b.FormatExchangeCurrency(req.Currencies[x], asset.Spot).String(), -1, -1, -1, true)
 		if err != nil {
 			return resp, err
 		}
@@ -607,10 +638,10 @@ func (b *BTCMarkets) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detai
 					tempData[y].OrderID)
 				tempResp.Status = order.UnknownStatus
 			}
-			tempResp.Price = tempData[y].Price
-			tempResp.Amount = tempData[y].Amount
-			tempResp.ExecutedAmount = tempData[y].Amount - tempData[y].OpenAmount
-			tempResp.RemainingAmount = tempData[y].OpenAmount
+			tempResp.Price = decimal.NewFromFloat(tempData[y].Price)
+			tempResp.Amount = decimal.NewFromFloat(tempData[y].Amount)
+			tempResp.ExecutedAmount = decimal.NewFromFloat(tempData[y].Amount).Sub(decimal.NewFromFloat(tempData[y].OpenAmount))
+			tempResp.RemainingAmount = decimal.NewFromFloat(tempData[y].OpenAmount)
 			resp = append(resp, tempResp)
 		}
 	}
@@ -622,12 +653,12 @@ func (b *BTCMarkets) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detai
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *BTCMarkets) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *BTCMarkets) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var resp []order.Detail
 	var tempResp order.Detail
 	var tempArray []string
 	if len(req.Currencies) == 0 {
-		orders, err := b.GetOrders("", -1, -1, -1, false)
+		orders, err := b.GetOrders(ctx, "", -1, -1, -1, false)
 		if err != nil {
 			return resp, err
 		}
@@ -636,7 +667,7 @@ func (b *BTCMarkets) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detai
 		}
 	}
 	for y := range req.Currencies {
-		orders, err := b.GetOrders(b.FormatExchangeCurrency(req.Currencies[y], asset.Spot).String(), -1, -1, -1, false)
+		orders, err := b.GetOrders(ctx, b.FormatExchangeCurrency(req.Currencies[y], asset.Spot).String(), -1, -1, -1, false)
 		if err != nil {
 			return resp, err
 		}
@@ -646,7 +677,7 @@ func (b *BTCMarkets) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detai
 	}
 	splitOrders := common.SplitStringSliceByLimit(tempArray, 50)
 	for x := range splitOrders {
-		tempData, err := b.GetBatchTrades(splitOrders[x])
+		tempData, err := b.GetBatchTrades(ctx, splitOrders[x])
 		if err != nil {
 			return resp, err
 		}
@@ -675,8 +706,8 @@ func (b *BTCMarkets) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detai
 			}
 			tempResp.ID = tempData.Orders[c].OrderID
 			tempResp.OrderDate = tempData.Orders[c].CreationTime
-			tempResp.Price = tempData.Orders[c].Price
-			tempResp.ExecutedAmount = tempData.Orders[c].Amount
+			tempResp.Price = decimal.NewFromFloat(tempData.Orders[c].Price)
+			tempResp.ExecutedAmount = decimal.NewFromFloat(tempData.Orders[c].Amount)
 			resp = append(resp, tempResp)
 		}
 	}
@@ -707,8 +738,8 @@ func (b *BTCMarkets) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *BTCMarkets) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *BTCMarkets) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	if err != nil {
 		if b.CheckTransientError(err) == nil {
 			return nil
@@ -725,3 +756,8 @@ func (b *BTCMarkets) ValidateCredentials() error {
 
 	return nil
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *BTCMarkets) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
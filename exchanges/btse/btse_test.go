@@ -1,11 +1,13 @@
 package btse
 
 import (
+	"context"
 	"log"
 	"os"
 	"strings"
 	"testing"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -53,7 +55,7 @@ func areTestAPIKeysSet() bool {
 
 func TestGetMarketsSummary(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketsSummary()
+	_, err := b.GetMarketsSummary(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -61,7 +63,7 @@ func TestGetMarketsSummary(t *testing.T) {
 
 func TestGetMarkets(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarkets()
+	_, err := b.GetMarkets(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -69,7 +71,7 @@ func TestGetMarkets(t *testing.T) {
 
 func TestFetchOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := b.FetchOrderBook(testPair)
+	_, err := b.FetchOrderBook(context.Background(), testPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -77,7 +79,7 @@ func TestFetchOrderBook(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTrades(testPair)
+	_, err := b.GetTrades(context.Background(), testPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -85,7 +87,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetTicker(testPair)
+	_, err := b.GetTicker(context.Background(), testPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -93,7 +95,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetMarketStatistics(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetMarketStatistics(testPair)
+	_, err := b.GetMarketStatistics(context.Background(), testPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -101,7 +103,7 @@ func TestGetMarketStatistics(t *testing.T) {
 
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := b.GetServerTime()
+	_, err := b.GetServerTime(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -112,7 +114,7 @@ func TestGetAccount(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys not set, skipping test")
 	}
-	_, err := b.GetAccountBalance()
+	_, err := b.GetAccountBalance(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -123,7 +125,7 @@ func TestGetFills(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys not set, skipping test")
 	}
-	_, err := b.GetFills("", testPair, "", "", "", "")
+	_, err := b.GetFills(context.Background(), "", testPair, "", "", "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -134,7 +136,7 @@ func TestCreateOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := b.CreateOrder(0.1,
+	_, err := b.CreateOrder(context.Background(), 0.1,
 		10000,
 		order.Sell.String(),
 		order.Limit.String(),
@@ -151,7 +153,7 @@ func TestGetOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys not set, skipping test")
 	}
-	_, err := b.GetOrders("")
+	_, err := b.GetOrders(context.Background(), "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -166,7 +168,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := b.GetActiveOrders(&getOrdersRequest)
+	_, err := b.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if err != nil {
 		t.Error(err)
 	}
@@ -180,7 +182,7 @@ func TestGetOrderHistory(t *testing.T) {
 	var getOrdersRequest = order.GetOrdersRequest{
 		OrderType: order.AnyType,
 	}
-	_, err := b.GetOrderHistory(&getOrdersRequest)
+	_, err := b.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if err != nil {
 		t.Error(err)
 	}
@@ -205,7 +207,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 		PurchasePrice: 1000,
 	}
 
-	b.GetFeeByType(feeBuilder)
+	b.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -301,11 +303,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     100000,
-		Amount:    0.1,
+		Price:     decimal.NewFromInt(100000),
+		Amount:    decimal.NewFromFloat(0.1),
 		ClientID:  "meowOrder",
 	}
-	response, err := b.SubmitOrder(orderSubmission)
+	response, err := b.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -327,7 +329,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		AccountID:     "1",
 		CurrencyPair:  currencyPair,
 	}
-	err := b.CancelOrder(orderCancellation)
+	err := b.CancelOrder(context.Background(), orderCancellation)
 	if err != nil {
 		t.Error(err)
 	}
@@ -347,7 +349,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		AccountID:     "1",
 		CurrencyPair:  currencyPair,
 	}
-	resp, err := b.CancelAllOrders(orderCancellation)
+	resp, err := b.CancelAllOrders(context.Background(), orderCancellation)
 
 	if err != nil {
 		t.Errorf("Could not cancel orders: %v", err)
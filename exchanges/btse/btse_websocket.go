@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -68,7 +69,7 @@ func (b *BTSE) WsHandleData() {
 			result := Result{}
 			err = json.Unmarshal(resp.Raw, &result)
 			if err != nil {
-				b.Websocket.DataHandler <- err
+				b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 				continue
 			}
 			switch {
@@ -76,7 +77,7 @@ func (b *BTSE) WsHandleData() {
 				var tradeHistory wsTradeHistory
 				err = json.Unmarshal(resp.Raw, &tradeHistory)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				for x := range tradeHistory.Data {
@@ -85,7 +86,7 @@ func (b *BTSE) WsHandleData() {
 						side = order.Sell.String()
 					}
 					b.Websocket.DataHandler <- wshandler.TradeData{
-						Timestamp:    time.Unix(0, tradeHistory.Data[x].TransactionTime*int64(time.Millisecond)),
+						Timestamp:    convert.TimeFromUnix(tradeHistory.Data[x].TransactionTime, time.Millisecond),
 						CurrencyPair: currency.NewPairFromString(strings.Replace(tradeHistory.Topic, "tradeHistory:", "", 1)),
 						AssetType:    asset.Spot,
 						Exchange:     b.Name,
@@ -98,7 +99,7 @@ func (b *BTSE) WsHandleData() {
 				var t wsOrderBook
 				err = json.Unmarshal(resp.Raw, &t)
 				if err != nil {
-					b.Websocket.DataHandler <- err
+					b.Websocket.DataHandler <- wshandler.NewError(b.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				var newOB orderbook.Base
@@ -1,13 +1,16 @@
 package btse
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +40,7 @@ func (b *BTSE) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if b.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = b.UpdateTradablePairs(true)
+		err = b.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -148,10 +151,13 @@ func (b *BTSE) Setup(exch *config.ExchangeConfig) error {
 	b.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         b.Name,
 		URL:                  b.Websocket.GetWebsocketURL(),
+		AlternateURLs:        b.Websocket.GetAlternateURLs(),
 		ProxyURL:             b.Websocket.GetProxyAddress(),
 		Verbose:              b.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	b.Websocket.Orderbook.Setup(
@@ -179,11 +185,19 @@ func (b *BTSE) Run() {
 		b.PrintEnabledPairs()
 	}
 
+	b.SyncServerTime(context.Background(), func(ctx context.Context) (time.Time, error) {
+		t, err := b.GetServerTime(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return t.ISO, nil
+	})
+
 	if !b.GetEnabledFeatures().AutoPairUpdates {
 		return
 	}
 
-	err := b.UpdateTradablePairs(false)
+	err := b.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s Failed to update tradable pairs. Error: %s", b.Name, err)
@@ -191,8 +205,8 @@ func (b *BTSE) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (b *BTSE) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	m, err := b.GetMarkets()
+func (b *BTSE) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	m, err := b.GetMarkets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -209,8 +223,8 @@ func (b *BTSE) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (b *BTSE) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := b.FetchTradablePairs(asset.Spot)
+func (b *BTSE) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := b.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -219,16 +233,16 @@ func (b *BTSE) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (b *BTSE) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *BTSE) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
 
-	t, err := b.GetTicker(b.FormatExchangeCurrency(p,
+	t, err := b.GetTicker(ctx, b.FormatExchangeCurrency(p,
 		assetType).String())
 	if err != nil {
 		return tickerPrice, err
 	}
 
-	s, err := b.GetMarketStatistics(b.FormatExchangeCurrency(p,
+	s, err := b.GetMarketStatistics(ctx, b.FormatExchangeCurrency(p,
 		assetType).String())
 	if err != nil {
 		return tickerPrice, err
@@ -251,27 +265,27 @@ func (b *BTSE) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (b *BTSE) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (b *BTSE) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateTicker(p, assetType)
+		return b.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (b *BTSE) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *BTSE) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(b.Name, p, assetType)
 	if err != nil {
-		return b.UpdateOrderbook(p, assetType)
+		return b.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (b *BTSE) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (b *BTSE) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	a, err := b.FetchOrderBook(b.FormatExchangeCurrency(p, assetType).String())
+	a, err := b.FetchOrderBook(ctx, b.FormatExchangeCurrency(p, assetType).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -297,9 +311,9 @@ func (b *BTSE) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderboo
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // BTSE exchange
-func (b *BTSE) UpdateAccountInfo() (account.Holdings, error) {
+func (b *BTSE) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var a account.Holdings
-	balance, err := b.GetAccountBalance()
+	balance, err := b.GetAccountBalance(ctx)
 	if err != nil {
 		return a, err
 	}
@@ -309,8 +323,8 @@ func (b *BTSE) UpdateAccountInfo() (account.Holdings, error) {
 		currencies = append(currencies,
 			account.Balance{
 				CurrencyName: currency.NewCode(balance[b].Currency),
-				TotalValue:   balance[b].Total,
-				Hold:         balance[b].Available,
+				TotalValue:   decimal.NewFromFloat(balance[b].Total),
+				Hold:         decimal.NewFromFloat(balance[b].Available),
 			},
 		)
 	}
@@ -330,10 +344,10 @@ func (b *BTSE) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (b *BTSE) FetchAccountInfo() (account.Holdings, error) {
+func (b *BTSE) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(b.Name)
 	if err != nil {
-		return b.UpdateAccountInfo()
+		return b.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -341,24 +355,47 @@ func (b *BTSE) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (b *BTSE) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (b *BTSE) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (b *BTSE) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (b *BTSE) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// AddMargin transfers margin from the account balance to an isolated position
+func (b *BTSE) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (b *BTSE) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (b *BTSE) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (b *BTSE) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (b *BTSE) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (b *BTSE) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var resp order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return resp, err
 	}
 
-	r, err := b.CreateOrder(s.Amount,
-		s.Price,
+	r, err := b.CreateOrder(ctx, s.Amount.Float64(),
+		s.Price.Float64(),
 		s.OrderSide.String(),
 		s.OrderType.String(),
 		b.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
@@ -380,13 +417,13 @@ func (b *BTSE) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (b *BTSE) ModifyOrder(action *order.Modify) (string, error) {
+func (b *BTSE) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (b *BTSE) CancelOrder(order *order.Cancel) error {
-	r, err := b.CancelExistingOrder(order.OrderID,
+func (b *BTSE) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	r, err := b.CancelExistingOrder(ctx, order.OrderID,
 		b.FormatExchangeCurrency(order.CurrencyPair,
 			asset.Spot).String())
 	if err != nil {
@@ -406,9 +443,9 @@ func (b *BTSE) CancelOrder(order *order.Cancel) error {
 // CancelAllOrders cancels all orders associated with a currency pair
 // If product ID is sent, all orders of that specified market will be cancelled
 // If not specified, all orders of all markets will be cancelled
-func (b *BTSE) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (b *BTSE) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	var resp order.CancelAllResponse
-	markets, err := b.GetMarkets()
+	markets, err := b.GetMarkets(ctx)
 	if err != nil {
 		return resp, err
 	}
@@ -423,13 +460,13 @@ func (b *BTSE) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAll
 		if strPair != "" && strPair != checkPair {
 			continue
 		} else {
-			orders, err := b.GetOrders(checkPair)
+			orders, err := b.GetOrders(ctx, checkPair)
 			if err != nil {
 				return resp, err
 			}
 			for y := range orders {
 				success := "Order Cancelled"
-				_, err = b.CancelExistingOrder(orders[y].Order.ID, checkPair)
+				_, err = b.CancelExistingOrder(ctx, orders[y].Order.ID, checkPair)
 				if err != nil {
 					success = "Order Cancellation Failed"
 				}
@@ -441,8 +478,8 @@ func (b *BTSE) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAll
 }
 
 // GetOrderInfo returns information on a current open order
-func (b *BTSE) GetOrderInfo(orderID string) (order.Detail, error) {
-	o, err := b.GetOrders("")
+func (b *BTSE) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
+	o, err := b.GetOrders(ctx, "")
 	if err != nil {
 		return order.Detail{}, err
 	}
@@ -465,7 +502,7 @@ func (b *BTSE) GetOrderInfo(orderID string) (order.Detail, error) {
 		od.CurrencyPair = currency.NewPairDelimiter(o[i].Symbol,
 			b.GetPairFormat(asset.Spot, false).Delimiter)
 		od.Exchange = b.Name
-		od.Amount = o[i].Amount
+		od.Amount = decimal.NewFromFloat(o[i].Amount)
 		od.ID = o[i].ID
 		od.OrderDate, err = parseOrderTime(o[i].CreatedAt)
 		if err != nil {
@@ -474,10 +511,10 @@ func (b *BTSE) GetOrderInfo(orderID string) (order.Detail, error) {
 		}
 		od.OrderSide = side
 		od.OrderType = order.Type(strings.ToUpper(o[i].Type))
-		od.Price = o[i].Price
+		od.Price = decimal.NewFromFloat(o[i].Price)
 		od.Status = order.Status(o[i].Status)
 
-		fills, err := b.GetFills(orderID, "", "", "", "", "")
+		fills, err := b.GetFills(ctx, orderID, "", "", "", "", "")
 		if err != nil {
 			return od,
 				fmt.Errorf("unable to get order fills for orderID %s",
@@ -493,11 +530,11 @@ func (b *BTSE) GetOrderInfo(orderID string) (order.Detail, error) {
 			od.Trades = append(od.Trades, order.TradeHistory{
 				Timestamp: createdAt,
 				TID:       strconv.FormatInt(fills[i].ID, 10),
-				Price:     fills[i].Price,
-				Amount:    fills[i].Amount,
+				Price:     decimal.NewFromFloat(fills[i].Price),
+				Amount:    decimal.NewFromFloat(fills[i].Amount),
 				Exchange:  b.Name,
 				Side:      order.Side(fills[i].Side),
-				Fee:       fills[i].Fee,
+				Fee:       decimal.NewFromFloat(fills[i].Fee),
 			})
 		}
 	}
@@ -505,25 +542,25 @@ func (b *BTSE) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (b *BTSE) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (b *BTSE) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *BTSE) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (b *BTSE) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *BTSE) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *BTSE) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (b *BTSE) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (b *BTSE) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -533,8 +570,8 @@ func (b *BTSE) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (b *BTSE) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := b.GetOrders("")
+func (b *BTSE) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := b.GetOrders(ctx, "")
 	if err != nil {
 		return nil, err
 	}
@@ -558,16 +595,16 @@ func (b *BTSE) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, err
 			CurrencyPair: currency.NewPairDelimiter(resp[i].Symbol,
 				b.GetPairFormat(asset.Spot, false).Delimiter),
 			Exchange:  b.Name,
-			Amount:    resp[i].Amount,
+			Amount:    decimal.NewFromFloat(resp[i].Amount),
 			ID:        resp[i].ID,
 			OrderDate: tm,
 			OrderSide: side,
 			OrderType: order.Type(strings.ToUpper(resp[i].Type)),
-			Price:     resp[i].Price,
+			Price:     decimal.NewFromFloat(resp[i].Price),
 			Status:    order.Status(resp[i].Status),
 		}
 
-		fills, err := b.GetFills(resp[i].ID, "", "", "", "", "")
+		fills, err := b.GetFills(ctx, resp[i].ID, "", "", "", "", "")
 		if err != nil {
 			log.Errorf(log.ExchangeSys,
 				"%s: Unable to get order fills for orderID %s",
@@ -587,11 +624,11 @@ func (b *BTSE) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, err
 			openOrder.Trades = append(openOrder.Trades, order.TradeHistory{
 				Timestamp: createdAt,
 				TID:       strconv.FormatInt(fills[i].ID, 10),
-				Price:     fills[i].Price,
-				Amount:    fills[i].Amount,
+				Price:     decimal.NewFromFloat(fills[i].Price),
+				Amount:    decimal.NewFromFloat(fills[i].Amount),
 				Exchange:  b.Name,
 				Side:      order.Side(fills[i].Side),
-				Fee:       fills[i].Fee,
+				Fee:       decimal.NewFromFloat(fills[i].Fee),
 			})
 		}
 		orders = append(orders, openOrder)
@@ -605,12 +642,12 @@ func (b *BTSE) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, err
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (b *BTSE) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (b *BTSE) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (b *BTSE) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (b *BTSE) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !b.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -618,18 +655,16 @@ func (b *BTSE) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 	return b.GetFee(feeBuilder)
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (b *BTSE) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	b.Websocket.SubscribeToChannels(channels)
-	return nil
+	return b.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (b *BTSE) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	b.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return b.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -644,7 +679,12 @@ func (b *BTSE) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (b *BTSE) ValidateCredentials() error {
-	_, err := b.UpdateAccountInfo()
+func (b *BTSE) ValidateCredentials(ctx context.Context) error {
+	_, err := b.UpdateAccountInfo(ctx)
 	return b.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (b *BTSE) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -0,0 +1,109 @@
+// Package chaos injects artificial latency, REST errors and dropped websocket
+// messages into exchange interactions, so strategies and the order manager
+// can be tested against degraded exchanges
+package chaos
+
+import (
+	"context"
+	"fmt"
+	"math/rand"
+	"strings"
+	"time"
+)
+
+var current = &injector{rand: rand.New(rand.NewSource(time.Now().UnixNano()))}
+
+// Set starts injecting the faults in cfg, the zero Config stops injecting
+// faults
+func Set(cfg Config) error {
+	if cfg.Latency < 0 || cfg.Jitter < 0 {
+		return fmt.Errorf("latency %v and jitter %v must not be negative", cfg.Latency, cfg.Jitter)
+	}
+	if cfg.RESTErrorRate < 0 || cfg.RESTErrorRate > 1 {
+		return fmt.Errorf("REST error rate %v must be between 0 and 1", cfg.RESTErrorRate)
+	}
+	if cfg.WebsocketDropRate < 0 || cfg.WebsocketDropRate > 1 {
+		return fmt.Errorf("websocket drop rate %v must be between 0 and 1", cfg.WebsocketDropRate)
+	}
+	exchanges := make(map[string]bool, len(cfg.Exchanges))
+	for i := range cfg.Exchanges {
+		exchanges[strings.ToLower(cfg.Exchanges[i])] = true
+	}
+
+	current.mtx.Lock()
+	current.cfg = cfg
+	current.exchanges = exchanges
+	current.mtx.Unlock()
+	return nil
+}
+
+// Enabled returns whether any faults are being injected
+func Enabled() bool {
+	current.mtx.Lock()
+	defer current.mtx.Unlock()
+	return current.cfg.Latency > 0 || current.cfg.Jitter > 0 ||
+		current.cfg.RESTErrorRate > 0 || current.cfg.WebsocketDropRate > 0
+}
+
+// Delay waits for the latency injected into an exchange interaction,
+// returning early with the context's error if it is cancelled
+func Delay(ctx context.Context, exchName string) error {
+	d := current.delay(exchName)
+	if d <= 0 {
+		return nil
+	}
+	t := time.NewTimer(d)
+	defer t.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-t.C:
+		return nil
+	}
+}
+
+// RESTError returns ErrInjected when an error is injected into an exchange's
+// REST request
+func RESTError(exchName string) error {
+	if current.roll(exchName, func(c *Config) float64 { return c.RESTErrorRate }) {
+		return ErrInjected
+	}
+	return nil
+}
+
+// DropWebsocketMessage returns whether a message received from an exchange's
+// websocket is dropped
+func DropWebsocketMessage(exchName string) bool {
+	return current.roll(exchName, func(c *Config) float64 { return c.WebsocketDropRate })
+}
+
+// delay returns the latency injected into an exchange interaction
+func (i *injector) delay(exchName string) time.Duration {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+	if !i.applies(exchName) {
+		return 0
+	}
+	d := i.cfg.Latency
+	if i.cfg.Jitter > 0 {
+		d += time.Duration(i.rand.Int63n(int64(i.cfg.Jitter) + 1))
+	}
+	return d
+}
+
+// roll returns whether a fault injected at the rate returned by rate occurs
+func (i *injector) roll(exchName string, rate func(*Config) float64) bool {
+	i.mtx.Lock()
+	defer i.mtx.Unlock()
+	r := rate(&i.cfg)
+	if r <= 0 || !i.applies(exchName) {
+		return false
+	}
+	return i.rand.Float64() < r
+}
+
+// applies returns whether faults are injected for an exchange, the caller
+// must hold the lock
+func (i *injector) applies(exchName string) bool {
+	return len(i.exchanges) == 0 || i.exchanges[strings.ToLower(exchName)]
+}
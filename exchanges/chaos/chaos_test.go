@@ -0,0 +1,71 @@
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+)
+
+func TestSet(t *testing.T) {
+	defer Set(Config{}) // nolint:errcheck
+
+	for _, cfg := range []Config{
+		{Latency: -time.Second},
+		{RESTErrorRate: 1.5},
+		{WebsocketDropRate: -0.1},
+	} {
+		if err := Set(cfg); err == nil {
+			t.Errorf("expected error setting %+v", cfg)
+		}
+	}
+	if Enabled() {
+		t.Error("expected faults not to be injected")
+	}
+	if err := Set(Config{RESTErrorRate: 0.5}); err != nil {
+		t.Fatal(err)
+	}
+	if !Enabled() {
+		t.Error("expected faults to be injected")
+	}
+}
+
+func TestInject(t *testing.T) {
+	defer Set(Config{}) // nolint:errcheck
+
+	err := Set(Config{
+		Latency:           time.Millisecond,
+		RESTErrorRate:     1,
+		WebsocketDropRate: 1,
+		Exchanges:         []string{"Binance"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if RESTError("binance") != ErrInjected || !DropWebsocketMessage("binance") {
+		t.Error("expected faults to be injected for binance")
+	}
+	if RESTError("bitstamp") != nil || DropWebsocketMessage("bitstamp") {
+		t.Error("expected no faults to be injected for bitstamp")
+	}
+	if d := current.delay("binance"); d != time.Millisecond {
+		t.Errorf("expected a millisecond of latency, got %v", d)
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	if err = Set(Config{Latency: time.Hour}); err != nil {
+		t.Fatal(err)
+	}
+	if err = Delay(ctx, "bitstamp"); err != context.Canceled {
+		t.Errorf("expected the delay to end with the context, got %v", err)
+	}
+
+	if err = Set(Config{Jitter: time.Millisecond}); err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if d := current.delay("bitstamp"); d < 0 || d > time.Millisecond {
+			t.Fatalf("expected up to a millisecond of jitter, got %v", d)
+		}
+	}
+}
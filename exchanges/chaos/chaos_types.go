@@ -0,0 +1,35 @@
+package chaos
+
+import (
+	"errors"
+	"math/rand"
+	"sync"
+	"time"
+)
+
+// ErrInjected is returned in place of a REST response when an error is
+// injected
+var ErrInjected = errors.New("chaos testing injected REST error")
+
+// Config defines the faults injected into exchange interactions. Each REST
+// request and websocket message is delayed by Latency plus a random amount up
+// to Jitter. REST requests fail with ErrInjected at the REST error rate and
+// websocket messages are dropped at the websocket drop rate, rates being
+// between 0 and 1. Faults are injected for every exchange unless Exchanges is
+// set.
+type Config struct {
+	Latency           time.Duration
+	Jitter            time.Duration
+	RESTErrorRate     float64
+	WebsocketDropRate float64
+	Exchanges         []string
+}
+
+// injector holds the faults being injected and the random source deciding
+// when to inject them
+type injector struct {
+	mtx       sync.Mutex
+	cfg       Config
+	exchanges map[string]bool
+	rand      *rand.Rand
+}
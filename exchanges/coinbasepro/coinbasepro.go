@@ -2,6 +2,7 @@ package coinbasepro
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -9,735 +10,494 @@ import (
 	"net/url"
 	"strconv"
 	"strings"
+	"time"
 
+	"github.com/gofrs/uuid"
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/tracing"
 )
 
 const (
-	coinbaseproAPIURL                  = "https://api.pro.coinbase.com/"
-	coinbaseproSandboxAPIURL           = "https://api-public.sandbox.pro.coinbase.com/"
-	coinbaseproAPIVersion              = "0"
-	coinbaseproProducts                = "products"
-	coinbaseproOrderbook               = "book"
-	coinbaseproTicker                  = "ticker"
-	coinbaseproTrades                  = "trades"
-	coinbaseproHistory                 = "candles"
-	coinbaseproStats                   = "stats"
-	coinbaseproCurrencies              = "currencies"
-	coinbaseproAccounts                = "accounts"
-	coinbaseproLedger                  = "ledger"
-	coinbaseproHolds                   = "holds"
-	coinbaseproOrders                  = "orders"
-	coinbaseproFills                   = "fills"
-	coinbaseproTransfers               = "transfers"
-	coinbaseproReports                 = "reports"
-	coinbaseproTime                    = "time"
-	coinbaseproMarginTransfer          = "profiles/margin-transfer"
-	coinbaseproFunding                 = "funding"
-	coinbaseproFundingRepay            = "funding/repay"
-	coinbaseproPosition                = "position"
-	coinbaseproPositionClose           = "position/close"
-	coinbaseproPaymentMethod           = "payment-methods"
-	coinbaseproPaymentMethodDeposit    = "deposits/payment-method"
-	coinbaseproDepositCoinbase         = "deposits/coinbase-account"
-	coinbaseproWithdrawalPaymentMethod = "withdrawals/payment-method"
-	coinbaseproWithdrawalCoinbase      = "withdrawals/coinbase"
-	coinbaseproWithdrawalCrypto        = "withdrawals/crypto"
-	coinbaseproCoinbaseAccounts        = "coinbase-accounts"
-	coinbaseproTrailingVolume          = "users/self/trailing-volume"
+	coinbaseAPIURL   = "https://api.coinbase.com/api/v3/brokerage/"
+	coinbaseAPIV2URL = "https://api.coinbase.com/v2/"
+	coinbaseVersion  = "2024-01-01"
+
+	coinbaseProducts           = "products"
+	coinbaseTicker             = "ticker"
+	coinbaseCandles            = "candles"
+	coinbaseProductBook        = "product_book"
+	coinbaseAccounts           = "accounts"
+	coinbaseOrders             = "orders"
+	coinbaseBatchCancel        = "orders/batch_cancel"
+	coinbaseHistoricalOrders   = "orders/historical"
+	coinbaseHistoricalBatch    = "orders/historical/batch"
+	coinbaseFills              = "orders/historical/fills"
+	coinbaseTransactionSummary = "transaction_summary"
+	coinbaseTime               = "time"
+
+	// Coinbase v2 endpoints, used for transfers which Advanced Trade doesn't
+	// cover
+	coinbaseV2PaymentMethods = "payment-methods"
+	coinbaseV2Transactions   = "transactions"
+	coinbaseV2Addresses      = "addresses"
+	coinbaseV2Withdrawals    = "withdrawals"
+
+	// coinbaseMaxCandles is the most candles returned by a single request
+	coinbaseMaxCandles = 350
+	// coinbaseAccountsLimit is the page size used when listing accounts
+	coinbaseAccountsLimit = 250
+
+	// publicCacheTTL is how long product listings are reused
+	publicCacheTTL = 10 * time.Minute
 )
 
-// CoinbasePro is the overarching type across the coinbasepro package
+var (
+	errNoAccountForCurrency = errors.New("no account found for currency")
+	errAmountUnset          = errors.New("one of base or quote size must be set")
+)
+
+// granularities maps candle intervals in seconds to the names Advanced Trade
+// accepts
+var granularities = map[int64]string{
+	60:    "ONE_MINUTE",
+	300:   "FIVE_MINUTE",
+	900:   "FIFTEEN_MINUTE",
+	1800:  "THIRTY_MINUTE",
+	3600:  "ONE_HOUR",
+	7200:  "TWO_HOUR",
+	21600: "SIX_HOUR",
+	86400: "ONE_DAY",
+}
+
+// CoinbasePro is the overarching type across the coinbasepro package. Coinbase
+// retired the Pro API, so it talks to the Advanced Trade API under the name
+// existing configs use
 type CoinbasePro struct {
 	exchange.Base
 	WebsocketConn *wshandler.WebsocketConnection
+	// wsSequence is the last websocket sequence number seen, used to detect
+	// dropped messages
+	wsSequence int64
 }
 
 // GetProducts returns supported currency pairs on the exchange with specific
 // information about the pair
-func (c *CoinbasePro) GetProducts() ([]Product, error) {
-	var products []Product
+func (c *CoinbasePro) GetProducts(ctx context.Context) ([]Product, error) {
+	var resp ProductsResponse
 
-	return products, c.SendHTTPRequest(c.API.Endpoints.URL+coinbaseproProducts, &products)
+	return resp.Products, c.sendCachedHTTPRequest(ctx, c.API.Endpoints.URL+coinbaseProducts, publicCacheTTL, &resp)
 }
 
-// GetOrderbook returns orderbook by currency pair and level
-func (c *CoinbasePro) GetOrderbook(symbol string, level int) (interface{}, error) {
-	orderbook := OrderbookResponse{}
+// GetProduct returns information for a single product
+// productID - example "BTC-USD"
+func (c *CoinbasePro) GetProduct(ctx context.Context, productID string) (Product, error) {
+	var resp Product
+	path := fmt.Sprintf("%s/%s", c.API.Endpoints.URL+coinbaseProducts, productID)
 
-	path := fmt.Sprintf("%s/%s/%s", c.API.Endpoints.URL+coinbaseproProducts, symbol, coinbaseproOrderbook)
-	if level > 0 {
-		levelStr := strconv.Itoa(level)
-		path = fmt.Sprintf("%s/%s/%s?level=%s", c.API.Endpoints.URL+coinbaseproProducts, symbol, coinbaseproOrderbook, levelStr)
-	}
+	return resp, c.SendHTTPRequest(ctx, path, &resp)
+}
 
-	if err := c.SendHTTPRequest(path, &orderbook); err != nil {
-		return nil, err
+// GetOrderbook returns the aggregated orderbook for a product, limited to
+// limit price levels per side when limit is above zero
+func (c *CoinbasePro) GetOrderbook(ctx context.Context, productID string, limit int) (Orderbook, error) {
+	var resp OrderbookResponse
+	params := url.Values{}
+	params.Set("product_id", productID)
+	if limit > 0 {
+		params.Set("limit", strconv.Itoa(limit))
 	}
 
-	if level == 3 {
-		ob := OrderbookL3{}
-		ob.Sequence = orderbook.Sequence
-		for _, x := range orderbook.Asks {
-			price, err := strconv.ParseFloat((x[0].(string)), 64)
-			if err != nil {
-				continue
-			}
-			amount, err := strconv.ParseFloat((x[1].(string)), 64)
-			if err != nil {
-				continue
-			}
-
-			ob.Asks = append(ob.Asks, OrderL3{Price: price, Amount: amount, OrderID: x[2].(string)})
-		}
-		for _, x := range orderbook.Bids {
-			price, err := strconv.ParseFloat((x[0].(string)), 64)
-			if err != nil {
-				continue
-			}
-			amount, err := strconv.ParseFloat((x[1].(string)), 64)
-			if err != nil {
-				continue
-			}
-
-			ob.Bids = append(ob.Bids, OrderL3{Price: price, Amount: amount, OrderID: x[2].(string)})
-		}
-		return ob, nil
+	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbaseProductBook, params)
+	if err := c.SendHTTPRequest(ctx, path, &resp); err != nil {
+		return Orderbook{}, err
 	}
-	ob := OrderbookL1L2{}
-	ob.Sequence = orderbook.Sequence
-	for _, x := range orderbook.Asks {
-		price, err := strconv.ParseFloat((x[0].(string)), 64)
-		if err != nil {
-			continue
-		}
-		amount, err := strconv.ParseFloat((x[1].(string)), 64)
-		if err != nil {
-			continue
-		}
-
-		ob.Asks = append(ob.Asks, OrderL1L2{Price: price, Amount: amount, NumOrders: x[2].(float64)})
-	}
-	for _, x := range orderbook.Bids {
-		price, err := strconv.ParseFloat((x[0].(string)), 64)
-		if err != nil {
-			continue
-		}
-		amount, err := strconv.ParseFloat((x[1].(string)), 64)
-		if err != nil {
-			continue
-		}
 
-		ob.Bids = append(ob.Bids, OrderL1L2{Price: price, Amount: amount, NumOrders: x[2].(float64)})
-	}
-	return ob, nil
+	return resp.Pricebook, nil
 }
 
-// GetTicker returns ticker by currency pair
-// currencyPair - example "BTC-USD"
-func (c *CoinbasePro) GetTicker(currencyPair string) (Ticker, error) {
-	tick := Ticker{}
-	path := fmt.Sprintf(
-		"%s/%s/%s", c.API.Endpoints.URL+coinbaseproProducts, currencyPair, coinbaseproTicker)
-	return tick, c.SendHTTPRequest(path, &tick)
-}
+// GetTicker returns the latest trades alongside the best bid and ask for a
+// product
+// productID - example "BTC-USD"
+// limit - number of trades to return
+func (c *CoinbasePro) GetTicker(ctx context.Context, productID string, limit int) (Ticker, error) {
+	var resp Ticker
+	params := url.Values{}
+	params.Set("limit", strconv.Itoa(limit))
 
-// GetTrades listd the latest trades for a product
-// currencyPair - example "BTC-USD"
-func (c *CoinbasePro) GetTrades(currencyPair string) ([]Trade, error) {
-	var trades []Trade
-	path := fmt.Sprintf(
-		"%s/%s/%s", c.API.Endpoints.URL+coinbaseproProducts, currencyPair, coinbaseproTrades)
-	return trades, c.SendHTTPRequest(path, &trades)
-}
+	path := common.EncodeURLValues(
+		fmt.Sprintf("%s/%s/%s", c.API.Endpoints.URL+coinbaseProducts, productID, coinbaseTicker),
+		params)
 
-// GetHistoricRates returns historic rates for a product. Rates are returned in
-// grouped buckets based on requested granularity.
-func (c *CoinbasePro) GetHistoricRates(currencyPair, start, end string, granularity int64) ([]History, error) {
-	var resp [][]interface{}
-	var history []History
-	values := url.Values{}
+	return resp, c.SendHTTPRequest(ctx, path, &resp)
+}
 
-	if len(start) > 0 {
-		values.Set("start", start)
-	} else {
-		values.Set("start", "")
+// GetTrades lists the latest trades for a product
+// productID - example "BTC-USD"
+func (c *CoinbasePro) GetTrades(ctx context.Context, productID string) ([]Trade, error) {
+	tick, err := c.GetTicker(ctx, productID, 100)
+	if err != nil {
+		return nil, err
 	}
 
-	if len(end) > 0 {
-		values.Set("end", end)
-	} else {
-		values.Set("end", "")
-	}
+	return tick.Trades, nil
+}
 
-	allowedGranularities := [6]int64{60, 300, 900, 3600, 21600, 86400}
-	validGran, _ := common.InArray(granularity, allowedGranularities)
-	if !validGran {
-		return nil, errors.New("Invalid granularity value: " + strconv.FormatInt(granularity, 10) + ". Allowed values are {60, 300, 900, 3600, 21600, 86400}")
-	}
-	if granularity > 0 {
-		values.Set("granularity", strconv.FormatInt(granularity, 10))
+// GetHistoricRates returns historic rates for a product. Rates are returned in
+// grouped buckets based on requested granularity in seconds, at most 350 per
+// request.
+func (c *CoinbasePro) GetHistoricRates(ctx context.Context, productID string, start, end time.Time, granularity int64) ([]History, error) {
+	gran, ok := granularities[granularity]
+	if !ok {
+		return nil, fmt.Errorf("invalid granularity value: %d. Allowed values are {60, 300, 900, 1800, 3600, 7200, 21600, 86400}",
+			granularity)
 	}
 
-	path := common.EncodeURLValues(
-		fmt.Sprintf("%s/%s/%s", c.API.Endpoints.URL+coinbaseproProducts, currencyPair, coinbaseproHistory),
-		values)
+	params := url.Values{}
+	params.Set("start", strconv.FormatInt(start.Unix(), 10))
+	params.Set("end", strconv.FormatInt(end.Unix(), 10))
+	params.Set("granularity", gran)
 
-	if err := c.SendHTTPRequest(path, &resp); err != nil {
-		return history, err
-	}
+	path := common.EncodeURLValues(
+		fmt.Sprintf("%s/%s/%s", c.API.Endpoints.URL+coinbaseProducts, productID, coinbaseCandles),
+		params)
 
-	for _, single := range resp {
-		var s History
-		a, _ := single[0].(float64)
-		s.Time = int64(a)
-		b, _ := single[1].(float64)
-		s.Low = b
-		c, _ := single[2].(float64)
-		s.High = c
-		d, _ := single[3].(float64)
-		s.Open = d
-		e, _ := single[4].(float64)
-		s.Close = e
-		f, _ := single[5].(float64)
-		s.Volume = f
-		history = append(history, s)
+	var resp CandlesResponse
+	if err := c.SendHTTPRequest(ctx, path, &resp); err != nil {
+		return nil, err
 	}
 
-	return history, nil
-}
-
-// GetStats returns a 24 hr stat for the product. Volume is in base currency
-// units. open, high, low are in quote currency units.
-func (c *CoinbasePro) GetStats(currencyPair string) (Stats, error) {
-	stats := Stats{}
-	path := fmt.Sprintf(
-		"%s/%s/%s", c.API.Endpoints.URL+coinbaseproProducts, currencyPair, coinbaseproStats)
-
-	return stats, c.SendHTTPRequest(path, &stats)
-}
-
-// GetCurrencies returns a list of supported currency on the exchange
-// Warning: Not all currencies may be currently in use for tradinc.
-func (c *CoinbasePro) GetCurrencies() ([]Currency, error) {
-	var currencies []Currency
-
-	return currencies, c.SendHTTPRequest(c.API.Endpoints.URL+coinbaseproCurrencies, &currencies)
+	return resp.Candles, nil
 }
 
 // GetServerTime returns the API server time
-func (c *CoinbasePro) GetServerTime() (ServerTime, error) {
-	serverTime := ServerTime{}
-
-	return serverTime, c.SendHTTPRequest(c.API.Endpoints.URL+coinbaseproTime, &serverTime)
-}
-
-// GetAccounts returns a list of trading accounts associated with the APIKEYS
-func (c *CoinbasePro) GetAccounts() ([]AccountResponse, error) {
-	var resp []AccountResponse
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodGet, coinbaseproAccounts, nil, &resp)
-}
-
-// GetAccount returns information for a single account. Use this endpoint when
-// account_id is known
-func (c *CoinbasePro) GetAccount(accountID string) (AccountResponse, error) {
-	resp := AccountResponse{}
-	path := fmt.Sprintf("%s/%s", coinbaseproAccounts, accountID)
-
-	return resp, c.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
-}
-
-// GetAccountHistory returns a list of account activity. Account activity either
-// increases or decreases your account balance. Items are paginated and sorted
-// latest first.
-func (c *CoinbasePro) GetAccountHistory(accountID string) ([]AccountLedgerResponse, error) {
-	var resp []AccountLedgerResponse
-	path := fmt.Sprintf("%s/%s/%s", coinbaseproAccounts, accountID, coinbaseproLedger)
+func (c *CoinbasePro) GetServerTime(ctx context.Context) (ServerTime, error) {
+	var serverTime ServerTime
+
+	return serverTime, c.SendHTTPRequest(ctx, c.API.Endpoints.URL+coinbaseTime, &serverTime)
+}
+
+// GetAccounts returns all trading accounts associated with the API key,
+// following the pagination cursor
+func (c *CoinbasePro) GetAccounts(ctx context.Context) ([]Account, error) {
+	var accounts []Account
+	cursor := ""
+	for {
+		params := url.Values{}
+		params.Set("limit", strconv.Itoa(coinbaseAccountsLimit))
+		if cursor != "" {
+			params.Set("cursor", cursor)
+		}
 
-	return resp, c.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+		var resp AccountsResponse
+		err := c.sendAuthenticatedHTTPRequest(ctx, http.MethodGet,
+			common.EncodeURLValues(c.API.Endpoints.URL+coinbaseAccounts, params), nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		accounts = append(accounts, resp.Accounts...)
+		if !resp.HasNext || resp.Cursor == "" {
+			return accounts, nil
+		}
+		cursor = resp.Cursor
+	}
 }
 
-// GetHolds returns the holds that are placed on an account for any active
-// orders or pending withdraw requests. As an order is filled, the hold amount
-// is updated. If an order is canceled, any remaining hold is removed. For a
-// withdraw, once it is completed, the hold is removed.
-func (c *CoinbasePro) GetHolds(accountID string) ([]AccountHolds, error) {
-	var resp []AccountHolds
-	path := fmt.Sprintf("%s/%s/%s", coinbaseproAccounts, accountID, coinbaseproHolds)
+// GetAccount returns information for a single account by its UUID
+func (c *CoinbasePro) GetAccount(ctx context.Context, accountID string) (Account, error) {
+	var resp struct {
+		Account Account `json:"account"`
+	}
+	path := fmt.Sprintf("%s/%s", c.API.Endpoints.URL+coinbaseAccounts, accountID)
 
-	return resp, c.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	return resp.Account, c.sendAuthenticatedHTTPRequest(ctx, http.MethodGet, path, nil, &resp)
 }
 
-// PlaceLimitOrder places a new limit order. Orders can only be placed if the
-// account has sufficient funds. Once an order is placed, account funds
-// will be put on hold for the duration of the order. How much and which funds
-// are put on hold depends on the order type and parameters specified.
-//
-// GENERAL PARAMS
-// clientRef - [optional] Order ID selected by you to identify your order
-// side - 	buy or sell
-// productID - A valid product id
-// stp - [optional] Self-trade prevention flag
-//
-// LIMIT ORDER PARAMS
-// price - Price per bitcoin
-// amount - Amount of BTC to buy or sell
-// timeInforce - [optional] GTC, GTT, IOC, or FOK (default is GTC)
-// cancelAfter - [optional] min, hour, day * Requires time_in_force to be GTT
-// postOnly - [optional] Post only flag Invalid when time_in_force is IOC or FOK
-func (c *CoinbasePro) PlaceLimitOrder(clientRef string, price, amount float64, side, timeInforce, cancelAfter, productID, stp string, postOnly bool) (string, error) {
-	resp := GeneralizedOrderResponse{}
-	req := make(map[string]interface{})
-	req["type"] = order.Limit.Lower()
-	req["price"] = strconv.FormatFloat(price, 'f', -1, 64)
-	req["size"] = strconv.FormatFloat(amount, 'f', -1, 64)
-	req["side"] = side
-	req["product_id"] = productID
-
-	if cancelAfter != "" {
-		req["cancel_after"] = cancelAfter
-	}
-	if timeInforce != "" {
-		req["time_in_foce"] = timeInforce
-	}
-	if clientRef != "" {
-		req["client_oid"] = clientRef
-	}
-	if stp != "" {
-		req["stp"] = stp
-	}
-	if postOnly {
-		req["post_only"] = postOnly
-	}
-
-	err := c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproOrders, req, &resp)
+// accountForCurrency returns the UUID of the account holding code, which
+// also identifies the account on the v2 API
+func (c *CoinbasePro) accountForCurrency(ctx context.Context, code currency.Code) (string, error) {
+	accounts, err := c.GetAccounts(ctx)
 	if err != nil {
 		return "", err
 	}
+	for i := range accounts {
+		if strings.EqualFold(accounts[i].Currency, code.String()) {
+			return accounts[i].UUID, nil
+		}
+	}
 
-	return resp.ID, nil
+	return "", fmt.Errorf("%s %v", errNoAccountForCurrency, code)
 }
 
-// PlaceMarketOrder places a new market order.
-// Orders can only be placed if the account has sufficient funds. Once an order
-// is placed, account funds will be put on hold for the duration of the order.
-// How much and which funds are put on hold depends on the order type and
-// parameters specified.
+// PlaceLimitOrder places a new good til cancelled limit order and returns its
+// ID. Orders can only be placed if the account has sufficient funds.
 //
-// GENERAL PARAMS
-// clientRef - [optional] Order ID selected by you to identify your order
-// side - 	buy or sell
+// clientRef - [optional] Order ID selected by you to identify your order,
+// one is generated when empty
+// side - BUY or SELL
 // productID - A valid product id
-// stp - [optional] Self-trade prevention flag
-//
-// MARKET ORDER PARAMS
-// size - [optional]* Desired amount in BTC
-// funds	[optional]* Desired amount of quote currency to use
-// * One of size or funds is required.
-func (c *CoinbasePro) PlaceMarketOrder(clientRef string, size, funds float64, side, productID, stp string) (string, error) {
-	resp := GeneralizedOrderResponse{}
-	req := make(map[string]interface{})
-	req["side"] = side
-	req["product_id"] = productID
-	req["type"] = order.Market.Lower()
-
-	if size != 0 {
-		req["size"] = strconv.FormatFloat(size, 'f', -1, 64)
-	}
-	if funds != 0 {
-		req["funds"] = strconv.FormatFloat(funds, 'f', -1, 64)
-	}
-	if clientRef != "" {
-		req["client_oid"] = clientRef
-	}
-	if stp != "" {
-		req["stp"] = stp
-	}
-
-	err := c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproOrders, req, &resp)
-	if err != nil {
-		return "", err
-	}
-
-	return resp.ID, nil
+// price - Limit price in quote currency
+// amount - Amount of base currency to buy or sell
+// postOnly - Reject the order if it would take liquidity
+func (c *CoinbasePro) PlaceLimitOrder(ctx context.Context, clientRef string, price, amount float64, side, productID string, postOnly bool) (string, error) {
+	return c.createOrder(ctx, &CreateOrderRequest{
+		ClientOrderID: clientRef,
+		ProductID:     productID,
+		Side:          strings.ToUpper(side),
+		OrderConfiguration: OrderConfiguration{
+			LimitGTC: &LimitGTC{
+				BaseSize:   strconv.FormatFloat(amount, 'f', -1, 64),
+				LimitPrice: strconv.FormatFloat(price, 'f', -1, 64),
+				PostOnly:   postOnly,
+			},
+		},
+	})
 }
 
-// PlaceMarginOrder places a new market order.
-// Orders can only be placed if the account has sufficient funds. Once an order
-// is placed, account funds will be put on hold for the duration of the order.
-// How much and which funds are put on hold depends on the order type and
-// parameters specified.
+// PlaceMarketOrder places a new immediate or cancel market order and returns
+// its ID.
 //
-// GENERAL PARAMS
-// clientRef - [optional] Order ID selected by you to identify your order
-// side - 	buy or sell
+// clientRef - [optional] Order ID selected by you to identify your order,
+// one is generated when empty
+// side - BUY or SELL
 // productID - A valid product id
-// stp - [optional] Self-trade prevention flag
-//
-// MARGIN ORDER PARAMS
-// size - [optional]* Desired amount in BTC
-// funds - [optional]* Desired amount of quote currency to use
-func (c *CoinbasePro) PlaceMarginOrder(clientRef string, size, funds float64, side, productID, stp string) (string, error) {
-	resp := GeneralizedOrderResponse{}
-	req := make(map[string]interface{})
-	req["side"] = side
-	req["product_id"] = productID
-	req["type"] = "margin"
+// size - [optional]* Amount of base currency to buy or sell
+// funds - [optional]* Amount of quote currency to spend
+// * One of size or funds is required.
+func (c *CoinbasePro) PlaceMarketOrder(ctx context.Context, clientRef string, size, funds float64, side, productID string) (string, error) {
+	market := MarketIOC{}
+	switch {
+	case size != 0:
+		market.BaseSize = strconv.FormatFloat(size, 'f', -1, 64)
+	case funds != 0:
+		market.QuoteSize = strconv.FormatFloat(funds, 'f', -1, 64)
+	default:
+		return "", errAmountUnset
+	}
+
+	return c.createOrder(ctx, &CreateOrderRequest{
+		ClientOrderID:      clientRef,
+		ProductID:          productID,
+		Side:               strings.ToUpper(side),
+		OrderConfiguration: OrderConfiguration{MarketIOC: &market},
+	})
+}
 
-	if size != 0 {
-		req["size"] = strconv.FormatFloat(size, 'f', -1, 64)
-	}
-	if funds != 0 {
-		req["funds"] = strconv.FormatFloat(funds, 'f', -1, 64)
-	}
-	if clientRef != "" {
-		req["client_oid"] = clientRef
-	}
-	if stp != "" {
-		req["stp"] = stp
+// createOrder submits an order, returning its ID or the reason it failed
+func (c *CoinbasePro) createOrder(ctx context.Context, req *CreateOrderRequest) (string, error) {
+	if req.ClientOrderID == "" {
+		id, err := uuid.NewV4()
+		if err != nil {
+			return "", err
+		}
+		req.ClientOrderID = id.String()
 	}
 
-	err := c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproOrders, req, &resp)
+	var resp CreateOrderResponse
+	err := c.sendAuthenticatedHTTPRequest(ctx, http.MethodPost, c.API.Endpoints.URL+coinbaseOrders, req, &resp)
 	if err != nil {
 		return "", err
 	}
+	if !resp.Success {
+		return "", fmt.Errorf("%s order rejected: %s %s",
+			c.Name, resp.ErrorResponse.Error, resp.ErrorResponse.Message)
+	}
+	if resp.SuccessResponse.OrderID != "" {
+		return resp.SuccessResponse.OrderID, nil
+	}
 
-	return resp.ID, nil
+	return resp.OrderID, nil
 }
 
-// CancelExistingOrder cancels order by orderID
-func (c *CoinbasePro) CancelExistingOrder(orderID string) error {
-	path := fmt.Sprintf("%s/%s", coinbaseproOrders, orderID)
+// CancelOrders cancels orders by ID, returning the result for each
+func (c *CoinbasePro) CancelOrders(ctx context.Context, orderIDs []string) ([]CancelResult, error) {
+	var resp CancelOrdersResponse
+	req := map[string]interface{}{"order_ids": orderIDs}
 
-	return c.SendAuthenticatedHTTPRequest(http.MethodDelete, path, nil, nil)
+	return resp.Results,
+		c.sendAuthenticatedHTTPRequest(ctx, http.MethodPost, c.API.Endpoints.URL+coinbaseBatchCancel, req, &resp)
 }
 
-// CancelAllExistingOrders cancels all open orders on the exchange and returns
-// and array of order IDs
-// currencyPair - [optional] all orders for a currencyPair string will be
-// canceled
-func (c *CoinbasePro) CancelAllExistingOrders(currencyPair string) ([]string, error) {
-	var resp []string
-	req := make(map[string]interface{})
-
-	if len(currencyPair) > 0 {
-		req["product_id"] = currencyPair
+// CancelExistingOrder cancels order by orderID
+func (c *CoinbasePro) CancelExistingOrder(ctx context.Context, orderID string) error {
+	results, err := c.CancelOrders(ctx, []string{orderID})
+	if err != nil {
+		return err
 	}
-	return resp, c.SendAuthenticatedHTTPRequest(http.MethodDelete, coinbaseproOrders, req, &resp)
+	for i := range results {
+		if !results[i].Success {
+			return fmt.Errorf("%s failed to cancel order %s: %s",
+				c.Name, results[i].OrderID, results[i].FailureReason)
+		}
+	}
+
+	return nil
 }
 
-// GetOrders lists current open orders. Only open or un-settled orders are
-// returned. As soon as an order is no longer open and settled, it will no
-// longer appear in the default request.
-// status - can be a range of "open", "pending", "done" or "active"
-// currencyPair - [optional] for example "BTC-USD"
-func (c *CoinbasePro) GetOrders(status []string, currencyPair string) ([]GeneralizedOrderResponse, error) {
-	var resp []GeneralizedOrderResponse
-	params := url.Values{}
+// GetOrders lists orders, filtered by status and product when set
+// status - OPEN, FILLED, CANCELLED, EXPIRED, FAILED or PENDING
+// productID - [optional] for example "BTC-USD"
+func (c *CoinbasePro) GetOrders(ctx context.Context, status []string, productID string) ([]GeneralizedOrderResponse, error) {
+	var orders []GeneralizedOrderResponse
+	cursor := ""
+	for {
+		params := url.Values{}
+		for _, individualStatus := range status {
+			params.Add("order_status", individualStatus)
+		}
+		if productID != "" {
+			params.Set("product_id", productID)
+		}
+		if cursor != "" {
+			params.Set("cursor", cursor)
+		}
 
-	for _, individualStatus := range status {
-		params.Add("status", individualStatus)
-	}
-	if currencyPair != "" {
-		params.Set("product_id", currencyPair)
+		var resp OrdersResponse
+		err := c.sendAuthenticatedHTTPRequest(ctx, http.MethodGet,
+			common.EncodeURLValues(c.API.Endpoints.URL+coinbaseHistoricalBatch, params), nil, &resp)
+		if err != nil {
+			return nil, err
+		}
+		orders = append(orders, resp.Orders...)
+		if !resp.HasNext || resp.Cursor == "" {
+			return orders, nil
+		}
+		cursor = resp.Cursor
 	}
-
-	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbaseproOrders, params)
-	path = common.GetURIPath(path)
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodGet, path[1:], nil, &resp)
 }
 
 // GetOrder returns a single order by order id.
-func (c *CoinbasePro) GetOrder(orderID string) (GeneralizedOrderResponse, error) {
-	resp := GeneralizedOrderResponse{}
-	path := fmt.Sprintf("%s/%s", coinbaseproOrders, orderID)
+func (c *CoinbasePro) GetOrder(ctx context.Context, orderID string) (GeneralizedOrderResponse, error) {
+	var resp struct {
+		Order GeneralizedOrderResponse `json:"order"`
+	}
+	path := fmt.Sprintf("%s/%s", c.API.Endpoints.URL+coinbaseHistoricalOrders, orderID)
 
-	return resp, c.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	return resp.Order, c.sendAuthenticatedHTTPRequest(ctx, http.MethodGet, path, nil, &resp)
 }
 
-// GetFills returns a list of recent fills
-func (c *CoinbasePro) GetFills(orderID, currencyPair string) ([]FillResponse, error) {
-	var resp []FillResponse
-	params := url.Values{}
+// GetFills returns a list of recent fills for an order or product
+func (c *CoinbasePro) GetFills(ctx context.Context, orderID, productID string) ([]Fill, error) {
+	if orderID == "" && productID == "" {
+		return nil, errors.New("no parameters set")
+	}
 
+	params := url.Values{}
 	if orderID != "" {
 		params.Set("order_id", orderID)
 	}
-	if currencyPair != "" {
-		params.Set("product_id", currencyPair)
-	}
-	if params.Get("order_id") == "" && params.Get("product_id") == "" {
-		return resp, errors.New("no parameters set")
+	if productID != "" {
+		params.Set("product_id", productID)
 	}
 
-	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbaseproFills, params)
-	uri := common.GetURIPath(path)
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodGet, uri[1:], nil, &resp)
-}
-
-// GetFundingRecords every order placed with a margin profile that draws funding
-// will create a funding record.
-//
-// status - "outstanding", "settled", or "rejected"
-func (c *CoinbasePro) GetFundingRecords(status string) ([]Funding, error) {
-	var resp []Funding
-	params := url.Values{}
-	params.Set("status", status)
-
-	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbaseproFunding, params)
-	uri := common.GetURIPath(path)
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodGet, uri[1:], nil, &resp)
-}
-
-// //////////////////////// Not receiving reply from server /////////////////
-// RepayFunding repays the older funding records first
-//
-// amount - amount of currency to repay
-// currency - currency, example USD
-// func (c *CoinbasePro) RepayFunding(amount, currency string) (Funding, error) {
-// 	resp := Funding{}
-// 	params := make(map[string]interface{})
-// 	params["amount"] = amount
-// 	params["currency"] = currency
-//
-// 	return resp,
-// 		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproFundingRepay, params, &resp)
-// }
-
-// MarginTransfer sends funds between a standard/default profile and a margin
-// profile.
-// A deposit will transfer funds from the default profile into the margin
-// profile. A withdraw will transfer funds from the margin profile to the
-// default profile. Withdraws will fail if they would set your margin ratio
-// below the initial margin ratio requirement.
-//
-// amount - the amount to transfer between the default and margin profile
-// transferType - either "deposit" or "withdraw"
-// profileID - The id of the margin profile to deposit or withdraw from
-// currency - currency to transfer, currently on "BTC" or "USD"
-func (c *CoinbasePro) MarginTransfer(amount float64, transferType, profileID, currency string) (MarginTransfer, error) {
-	resp := MarginTransfer{}
-	req := make(map[string]interface{})
-	req["type"] = transferType
-	req["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
-	req["currency"] = currency
-	req["margin_profile_id"] = profileID
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproMarginTransfer, req, &resp)
-}
-
-// GetPosition returns an overview of account profile.
-func (c *CoinbasePro) GetPosition() (AccountOverview, error) {
-	resp := AccountOverview{}
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodGet, coinbaseproPosition, nil, &resp)
-}
-
-// ClosePosition closes a position and allowing you to repay position as well
-// repayOnly -  allows the position to be repaid
-func (c *CoinbasePro) ClosePosition(repayOnly bool) (AccountOverview, error) {
-	resp := AccountOverview{}
-	req := make(map[string]interface{})
-	req["repay_only"] = repayOnly
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproPositionClose, req, &resp)
+	var resp FillsResponse
+	return resp.Fills, c.sendAuthenticatedHTTPRequest(ctx, http.MethodGet,
+		common.EncodeURLValues(c.API.Endpoints.URL+coinbaseFills, params), nil, &resp)
 }
 
-// GetPayMethods returns a full list of payment methods
-func (c *CoinbasePro) GetPayMethods() ([]PaymentMethod, error) {
-	var resp []PaymentMethod
+// GetTransactionSummary returns the fee tier and 30 day trading volume of the
+// account
+func (c *CoinbasePro) GetTransactionSummary(ctx context.Context) (TransactionSummary, error) {
+	var resp TransactionSummary
 
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodGet, coinbaseproPaymentMethod, nil, &resp)
+	return resp, c.sendAuthenticatedHTTPRequest(ctx, http.MethodGet, c.API.Endpoints.URL+coinbaseTransactionSummary, nil, &resp)
 }
 
-// DepositViaPaymentMethod deposits funds from a payment method. See the Payment
-// Methods section for retrieving your payment methods.
-//
-// amount - The amount to deposit
-// currency - The type of currency
-// paymentID - ID of the payment method
-func (c *CoinbasePro) DepositViaPaymentMethod(amount float64, currency, paymentID string) (DepositWithdrawalInfo, error) {
-	resp := DepositWithdrawalInfo{}
-	req := make(map[string]interface{})
-	req["amount"] = amount
-	req["currency"] = currency
-	req["payment_method_id"] = paymentID
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproPaymentMethodDeposit, req, &resp)
-}
-
-// DepositViaCoinbase deposits funds from a coinbase account. Move funds between
-// a Coinbase account and coinbasepro trading account within daily limits. Moving
-// funds between Coinbase and coinbasepro is instant and free. See the Coinbase
-// Accounts section for retrieving your Coinbase accounts.
-//
-// amount - The amount to deposit
-// currency - The type of currency
-// accountID - ID of the coinbase account
-func (c *CoinbasePro) DepositViaCoinbase(amount float64, currency, accountID string) (DepositWithdrawalInfo, error) {
-	resp := DepositWithdrawalInfo{}
-	req := make(map[string]interface{})
-	req["amount"] = amount
-	req["currency"] = currency
-	req["coinbase_account_id"] = accountID
+// GetPayMethods returns the payment methods linked to the account
+func (c *CoinbasePro) GetPayMethods(ctx context.Context) ([]PaymentMethod, error) {
+	var resp struct {
+		Data []PaymentMethod `json:"data"`
+	}
 
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproDepositCoinbase, req, &resp)
+	return resp.Data, c.sendAuthenticatedHTTPRequest(ctx, http.MethodGet, c.API.Endpoints.URLSecondary+coinbaseV2PaymentMethods, nil, &resp)
 }
 
-// WithdrawViaPaymentMethod withdraws funds to a payment method
+// WithdrawViaPaymentMethod withdraws fiat funds from an account to a linked
+// payment method
 //
+// accountID - UUID of the account to withdraw from
 // amount - The amount to withdraw
 // currency - The type of currency
 // paymentID - ID of the payment method
-func (c *CoinbasePro) WithdrawViaPaymentMethod(amount float64, currency, paymentID string) (DepositWithdrawalInfo, error) {
-	resp := DepositWithdrawalInfo{}
-	req := make(map[string]interface{})
-	req["amount"] = amount
-	req["currency"] = currency
-	req["payment_method_id"] = paymentID
+func (c *CoinbasePro) WithdrawViaPaymentMethod(ctx context.Context, accountID string, amount float64, currency, paymentID string) (DepositWithdrawalInfo, error) {
+	var resp struct {
+		Data DepositWithdrawalInfo `json:"data"`
+	}
+	req := map[string]interface{}{
+		"amount":         strconv.FormatFloat(amount, 'f', -1, 64),
+		"currency":       currency,
+		"payment_method": paymentID,
+	}
+	path := fmt.Sprintf("%s%s/%s/%s", c.API.Endpoints.URLSecondary, coinbaseAccounts, accountID, coinbaseV2Withdrawals)
 
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproWithdrawalPaymentMethod, req, &resp)
+	return resp.Data, c.sendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, req, &resp)
 }
 
-// /////////////////////// NO ROUTE FOUND ERROR ////////////////////////////////
-// WithdrawViaCoinbase withdraws funds to a coinbase account.
-//
-// amount - The amount to withdraw
-// currency - The type of currency
-// accountID - 	ID of the coinbase account
-// func (c *CoinbasePro) WithdrawViaCoinbase(amount float64, currency, accountID string) (DepositWithdrawalInfo, error) {
-// 	resp := DepositWithdrawalInfo{}
-// 	req := make(map[string]interface{})
-// 	req["amount"] = amount
-// 	req["currency"] = currency
-// 	req["coinbase_account_id"] = accountID
-//
-// 	return resp,
-// 		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproWithdrawalCoinbase, req, &resp)
-// }
-
-// WithdrawCrypto withdraws funds to a crypto address
+// WithdrawCrypto sends funds from an account to a crypto address
 //
+// accountID - UUID of the account to send from
 // amount - The amount to withdraw
 // currency - The type of currency
-// cryptoAddress - 	A crypto address of the recipient
-func (c *CoinbasePro) WithdrawCrypto(amount float64, currency, cryptoAddress string) (DepositWithdrawalInfo, error) {
-	resp := DepositWithdrawalInfo{}
-	req := make(map[string]interface{})
-	req["amount"] = amount
-	req["currency"] = currency
-	req["crypto_address"] = cryptoAddress
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproWithdrawalCrypto, req, &resp)
-}
-
-// GetCoinbaseAccounts returns a list of coinbase accounts
-func (c *CoinbasePro) GetCoinbaseAccounts() ([]CoinbaseAccounts, error) {
-	var resp []CoinbaseAccounts
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodGet, coinbaseproCoinbaseAccounts, nil, &resp)
-}
-
-// GetReport returns batches of historic information about your account in
-// various human and machine readable forms.
-//
-// reportType - "fills" or "account"
-// startDate - Starting date for the report (inclusive)
-// endDate - Ending date for the report (inclusive)
-// currencyPair - ID of the product to generate a fills report for.
-// E.c. BTC-USD. *Required* if type is fills
-// accountID - ID of the account to generate an account report for. *Required*
-// if type is account
-// format - 	pdf or csv (default is pdf)
-// email - [optional] Email address to send the report to
-func (c *CoinbasePro) GetReport(reportType, startDate, endDate, currencyPair, accountID, format, email string) (Report, error) {
-	resp := Report{}
-	req := make(map[string]interface{})
-	req["type"] = reportType
-	req["start_date"] = startDate
-	req["end_date"] = endDate
-	req["format"] = "pdf"
-
-	if currencyPair != "" {
-		req["product_id"] = currencyPair
-	}
-	if accountID != "" {
-		req["account_id"] = accountID
+// cryptoAddress - A crypto address of the recipient
+func (c *CoinbasePro) WithdrawCrypto(ctx context.Context, accountID string, amount float64, currency, cryptoAddress string) (DepositWithdrawalInfo, error) {
+	var resp struct {
+		Data DepositWithdrawalInfo `json:"data"`
 	}
-	if format == "csv" {
-		req["format"] = format
-	}
-	if email != "" {
-		req["email"] = email
+	req := map[string]interface{}{
+		"type":     "send",
+		"to":       cryptoAddress,
+		"amount":   strconv.FormatFloat(amount, 'f', -1, 64),
+		"currency": currency,
 	}
+	path := fmt.Sprintf("%s%s/%s/%s", c.API.Endpoints.URLSecondary, coinbaseAccounts, accountID, coinbaseV2Transactions)
 
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodPost, coinbaseproReports, req, &resp)
+	return resp.Data, c.sendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, req, &resp)
 }
 
-// GetReportStatus once a report request has been accepted for processing, the
-// status is available by polling the report resource endpoint.
-func (c *CoinbasePro) GetReportStatus(reportID string) (Report, error) {
-	resp := Report{}
-	path := fmt.Sprintf("%s/%s", coinbaseproReports, reportID)
+// CreateDepositAddress creates a new deposit address for an account
+func (c *CoinbasePro) CreateDepositAddress(ctx context.Context, accountID string) (DepositAddress, error) {
+	var resp struct {
+		Data DepositAddress `json:"data"`
+	}
+	path := fmt.Sprintf("%s%s/%s/%s", c.API.Endpoints.URLSecondary, coinbaseAccounts, accountID, coinbaseV2Addresses)
 
-	return resp, c.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	return resp.Data, c.sendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, map[string]interface{}{}, &resp)
 }
 
-// GetTrailingVolume this request will return your 30-day trailing volume for
-// all products.
-func (c *CoinbasePro) GetTrailingVolume() ([]Volume, error) {
-	var resp []Volume
-
-	return resp,
-		c.SendAuthenticatedHTTPRequest(http.MethodGet, coinbaseproTrailingVolume, nil, &resp)
+// SendHTTPRequest sends an unauthenticated HTTP request
+func (c *CoinbasePro) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return c.sendCachedHTTPRequest(ctx, path, 0, result)
 }
 
-// SendHTTPRequest sends an unauthenticated HTTP request
-func (c *CoinbasePro) SendHTTPRequest(path string, result interface{}) error {
-	return c.SendPayload(&request.Item{
+// sendCachedHTTPRequest sends an unauthenticated HTTP request, reusing the
+// response for ttl
+func (c *CoinbasePro) sendCachedHTTPRequest(ctx context.Context, path string, ttl time.Duration, result interface{}) error {
+	return c.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
 		Verbose:       c.Verbose,
 		HTTPDebugging: c.HTTPDebugging,
 		HTTPRecording: c.HTTPRecording,
+		CacheTTL:      ttl,
 	})
 }
 
-// SendAuthenticatedHTTPRequest sends an authenticated HTTP reque
-func (c *CoinbasePro) SendAuthenticatedHTTPRequest(method, path string, params map[string]interface{}, result interface{}) (err error) {
+// sendAuthenticatedHTTPRequest sends an authenticated HTTP request to the
+// full URL in path as part of the trace in ctx. Advanced Trade and v2 share
+// the same key signing scheme
+func (c *CoinbasePro) sendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params, result interface{}) (err error) {
 	if !c.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			c.Name)
 	}
 
 	payload := []byte("")
-
 	if params != nil {
 		payload, err = json.Marshal(params)
 		if err != nil {
@@ -749,43 +509,48 @@ func (c *CoinbasePro) SendAuthenticatedHTTPRequest(method, path string, params m
 		}
 	}
 
-	n := c.Requester.GetNonce(false).String()
-	message := n + method + "/" + path + string(payload)
-	hmac := crypto.GetHMAC(crypto.HashSHA256, []byte(message), []byte(c.API.Credentials.Secret))
+	u, err := url.Parse(path)
+	if err != nil {
+		return err
+	}
+
+	_, span := tracing.StartSpan(ctx, "sign", tracing.String("exchange", c.Name))
+	n := strconv.FormatInt(c.Requester.Now().Unix(), 10)
+	hmac := crypto.GetHMAC(crypto.HashSHA256,
+		[]byte(n+method+u.Path+string(payload)),
+		[]byte(c.API.Credentials.Secret))
+	span.End()
 	headers := make(map[string]string)
-	headers["CB-ACCESS-SIGN"] = crypto.Base64Encode(hmac)
+	headers["CB-ACCESS-SIGN"] = crypto.HexEncodeToString(hmac)
 	headers["CB-ACCESS-TIMESTAMP"] = n
 	headers["CB-ACCESS-KEY"] = c.API.Credentials.Key
-	headers["CB-ACCESS-PASSPHRASE"] = c.API.Credentials.ClientID
+	headers["CB-VERSION"] = coinbaseVersion
 	headers["Content-Type"] = "application/json"
 
-	return c.SendPayload(&request.Item{
+	return c.SendPayload(ctx, &request.Item{
 		Method:        method,
-		Path:          c.API.Endpoints.URL + path,
+		Path:          path,
 		Headers:       headers,
 		Body:          bytes.NewBuffer(payload),
 		Result:        result,
 		AuthRequest:   true,
-		NonceEnabled:  true,
 		Verbose:       c.Verbose,
 		HTTPDebugging: c.HTTPDebugging,
 		HTTPRecording: c.HTTPRecording,
+		Endpoint:      request.Auth,
 	})
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (c *CoinbasePro) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (c *CoinbasePro) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		trailingVolume, err := c.GetTrailingVolume()
+		summary, err := c.GetTransactionSummary(ctx)
 		if err != nil {
 			return 0, err
 		}
-		fee = c.calculateTradingFee(trailingVolume,
-			feeBuilder.Pair.Base,
-			feeBuilder.Pair.Quote,
-			feeBuilder.Pair.Delimiter,
+		fee = calculateTradingFee(&summary,
 			feeBuilder.PurchasePrice,
 			feeBuilder.Amount,
 			feeBuilder.IsMaker)
@@ -804,29 +569,19 @@ func (c *CoinbasePro) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 	return fee, nil
 }
 
-// getOfflineTradeFee calculates the worst case-scenario trading fee
+// getOfflineTradeFee calculates the worst case-scenario trading fee, the
+// lowest tier's taker rate
 func getOfflineTradeFee(price, amount float64) float64 {
-	return 0.0025 * price * amount
+	return 0.006 * price * amount
 }
 
-func (c *CoinbasePro) calculateTradingFee(trailingVolume []Volume, base, quote currency.Code, delimiter string, purchasePrice, amount float64, isMaker bool) float64 {
-	var fee float64
-	for _, i := range trailingVolume {
-		if strings.EqualFold(i.ProductID, base.String()+delimiter+quote.String()) {
-			switch {
-			case isMaker:
-				fee = 0
-			case i.Volume <= 10000000:
-				fee = 0.003
-			case i.Volume > 10000000 && i.Volume <= 100000000:
-				fee = 0.002
-			case i.Volume > 100000000:
-				fee = 0.001
-			}
-			break
-		}
+// calculateTradingFee applies the account's fee tier to a trade
+func calculateTradingFee(summary *TransactionSummary, purchasePrice, amount float64, isMaker bool) float64 {
+	rate := summary.FeeTier.TakerFeeRate
+	if isMaker {
+		rate = summary.FeeTier.MakerFeeRate
 	}
-	return fee * amount * purchasePrice
+	return rate * amount * purchasePrice
 }
 
 func getInternationalBankWithdrawalFee(c currency.Code) float64 {
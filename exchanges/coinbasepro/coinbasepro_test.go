@@ -1,20 +1,28 @@
 package coinbasepro
 
 import (
+	"context"
+	"encoding/json"
 	"fmt"
+	"io/ioutil"
 	"log"
 	"net/http"
+	"net/http/httptest"
 	"os"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
 )
@@ -25,7 +33,6 @@ var c CoinbasePro
 const (
 	apiKey                  = ""
 	apiSecret               = ""
-	clientID                = "" // passphrase you made at API CREATION
 	canManipulateRealOrders = false
 	testPair                = "BTC-USD"
 )
@@ -43,7 +50,6 @@ func TestMain(m *testing.M) {
 	}
 	gdxConfig.API.Credentials.Key = apiKey
 	gdxConfig.API.Credentials.Secret = apiSecret
-	gdxConfig.API.Credentials.ClientID = clientID
 	gdxConfig.API.AuthenticatedSupport = true
 	gdxConfig.API.AuthenticatedWebsocketSupport = true
 	err = c.Setup(gdxConfig)
@@ -55,21 +61,35 @@ func TestMain(m *testing.M) {
 }
 
 func TestGetProducts(t *testing.T) {
-	_, err := c.GetProducts()
+	_, err := c.GetProducts(context.Background())
 	if err != nil {
 		t.Errorf("Coinbase, GetProducts() Error: %s", err)
 	}
 }
 
+func TestGetProduct(t *testing.T) {
+	_, err := c.GetProduct(context.Background(), testPair)
+	if err != nil {
+		t.Error("GetProduct() error", err)
+	}
+}
+
+func TestGetOrderbook(t *testing.T) {
+	_, err := c.GetOrderbook(context.Background(), testPair, 10)
+	if err != nil {
+		t.Error("GetOrderbook() error", err)
+	}
+}
+
 func TestGetTicker(t *testing.T) {
-	_, err := c.GetTicker(testPair)
+	_, err := c.GetTicker(context.Background(), testPair, 1)
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
 }
 
 func TestGetTrades(t *testing.T) {
-	_, err := c.GetTrades(testPair)
+	_, err := c.GetTrades(context.Background(), testPair)
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
@@ -106,7 +126,7 @@ func TestGetHistoricRatesApiCheck(t *testing.T) {
 func expectedCandles(expectedCandles int, timeRange time.Duration, candleGranularity int64) error {
 	end := time.Now().UTC().Add(-time.Second * timeRange) // the latest candle may not yet be ready, so skipping to the previous one
 	start := end.Add(-time.Second * timeRange)
-	resp, err := c.GetHistoricRates(testPair, start.Format(time.RFC3339), end.Format(time.RFC3339), candleGranularity)
+	resp, err := c.GetHistoricRates(context.Background(), testPair, start, end, candleGranularity)
 	if err != nil {
 		return err
 	}
@@ -121,30 +141,69 @@ func TestGetHistoricRatesGranularityCheck(t *testing.T) {
 	end := time.Now().UTC()
 	start := time.Now().UTC().Add(-time.Second * 300)
 	invalidGranularity := 11
-	_, err := c.GetHistoricRates(testPair, start.Format(time.RFC3339), end.Format(time.RFC3339), int64(invalidGranularity))
+	_, err := c.GetHistoricRates(context.Background(), testPair, start, end, int64(invalidGranularity))
 	if err == nil {
 		t.Error("granularity validation did not work as expected")
 	}
 }
 
-func TestGetStats(t *testing.T) {
-	_, err := c.GetStats(testPair)
+func TestGetServerTime(t *testing.T) {
+	_, err := c.GetServerTime(context.Background())
 	if err != nil {
-		t.Error("GetStats() error", err)
+		t.Error("GetServerTime() error", err)
 	}
 }
 
-func TestGetCurrencies(t *testing.T) {
-	_, err := c.GetCurrencies()
+func TestSendAuthenticatedHTTPRequest(t *testing.T) {
+	t.Parallel()
+	var headers http.Header
+	var body []byte
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		headers = r.Header
+		body, _ = ioutil.ReadAll(r.Body)
+		w.Write([]byte(`{"success":true,"success_response":{"order_id":"1337"}}`)) // nolint:errcheck
+	}))
+	defer s.Close()
+
+	var cb CoinbasePro
+	cb.SetDefaults()
+	cb.Verbose = false
+	cb.API.Credentials.Key = "key"
+	cb.API.Credentials.Secret = "secret"
+	cb.API.Endpoints.URL = s.URL + "/api/v3/brokerage/"
+
+	id, err := cb.PlaceLimitOrder(context.Background(), "meow", 1, 2, "buy", testPair, true)
 	if err != nil {
-		t.Error("GetCurrencies() error", err)
+		t.Fatal(err)
+	}
+	if id != "1337" {
+		t.Errorf("expected order 1337, received %s", id)
 	}
-}
 
-func TestGetServerTime(t *testing.T) {
-	_, err := c.GetServerTime()
+	var req CreateOrderRequest
+	err = json.Unmarshal(body, &req)
 	if err != nil {
-		t.Error("GetServerTime() error", err)
+		t.Fatal(err)
+	}
+	if req.Side != "BUY" || req.ClientOrderID != "meow" ||
+		req.OrderConfiguration.LimitGTC == nil ||
+		req.OrderConfiguration.LimitGTC.LimitPrice != "1" ||
+		req.OrderConfiguration.LimitGTC.BaseSize != "2" {
+		t.Errorf("unexpected order request %s", body)
+	}
+
+	ts := headers.Get("CB-ACCESS-TIMESTAMP")
+	expected := crypto.HexEncodeToString(crypto.GetHMAC(crypto.HashSHA256,
+		[]byte(ts+http.MethodPost+"/api/v3/brokerage/orders"+string(body)),
+		[]byte("secret")))
+	if headers.Get("CB-ACCESS-SIGN") != expected {
+		t.Errorf("expected signature %s, received %s", expected, headers.Get("CB-ACCESS-SIGN"))
+	}
+	if headers.Get("CB-ACCESS-KEY") != "key" {
+		t.Error("API key header not set")
+	}
+	if headers.Get("CB-ACCESS-PASSPHRASE") != "" {
+		t.Error("passphrase should no longer be sent")
 	}
 }
 
@@ -152,84 +211,59 @@ func TestAuthRequests(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys not set, skipping test")
 	}
-	_, err := c.GetAccounts()
+	_, err := c.GetAccounts(context.Background())
 	if err != nil {
 		t.Error("GetAccounts() error", err)
 	}
-	accountResponse, err := c.GetAccount("13371337-1337-1337-1337-133713371337")
-	if accountResponse.ID != "" {
-		t.Error("Expecting no data returned")
-	}
-	if err == nil {
-		t.Error("Expecting error")
-	}
-	accountHistoryResponse, err := c.GetAccountHistory("13371337-1337-1337-1337-133713371337")
-	if len(accountHistoryResponse) > 0 {
-		t.Error("Expecting no data returned")
-	}
-	if err == nil {
-		t.Error("Expecting error")
-	}
-	getHoldsResponse, err := c.GetHolds("13371337-1337-1337-1337-133713371337")
-	if len(getHoldsResponse) > 0 {
+	accountResponse, err := c.GetAccount(context.Background(), "13371337-1337-1337-1337-133713371337")
+	if accountResponse.UUID != "" {
 		t.Error("Expecting no data returned")
 	}
 	if err == nil {
 		t.Error("Expecting error")
 	}
-	orderResponse, err := c.PlaceLimitOrder("", 0.001, 0.001,
-		order.Buy.Lower(), "", "", testPair, "", false)
+	orderResponse, err := c.PlaceLimitOrder(context.Background(), "", 0.001, 0.001,
+		order.Buy.String(), testPair, false)
 	if orderResponse != "" {
 		t.Error("Expecting no data returned")
 	}
 	if err == nil {
 		t.Error("Expecting error")
 	}
-	marketOrderResponse, err := c.PlaceMarketOrder("", 1, 0,
-		order.Buy.Lower(), testPair, "")
+	marketOrderResponse, err := c.PlaceMarketOrder(context.Background(), "", 0, 0.0001,
+		order.Buy.String(), testPair)
 	if marketOrderResponse != "" {
 		t.Error("Expecting no data returned")
 	}
 	if err == nil {
 		t.Error("Expecting error")
 	}
-	fillsResponse, err := c.GetFills("1337", testPair)
+	fillsResponse, err := c.GetFills(context.Background(), "1337", testPair)
 	if len(fillsResponse) > 0 {
 		t.Error("Expecting no data returned")
 	}
-	if err == nil {
-		t.Error("Expecting error")
-	}
-	_, err = c.GetFills("", "")
-	if err == nil {
-		t.Error("Expecting error")
-	}
-	_, err = c.GetFundingRecords("rejected")
-	if err == nil {
-		t.Error("Expecting error")
-	}
-	marginTransferResponse, err := c.MarginTransfer(1, "withdraw", "13371337-1337-1337-1337-133713371337", "BTC")
-	if marginTransferResponse.ID != "" {
-		t.Error("Expecting no data returned")
-	}
-	if err == nil {
-		t.Error("Expecting error")
+	if err != nil {
+		t.Error("GetFills() error", err)
 	}
-	_, err = c.GetPosition()
+	_, err = c.GetFills(context.Background(), "", "")
 	if err == nil {
 		t.Error("Expecting error")
 	}
-	_, err = c.ClosePosition(false)
-	if err == nil {
-		t.Error("Expecting error")
+	_, err = c.GetTransactionSummary(context.Background())
+	if err != nil {
+		t.Error("GetTransactionSummary() error", err)
 	}
-	_, err = c.GetPayMethods()
+	_, err = c.GetPayMethods(context.Background())
 	if err != nil {
 		t.Error("GetPayMethods() error", err)
 	}
-	_, err = c.GetCoinbaseAccounts()
-	if err != nil {
-		t.Error("GetCoinbaseAccounts() error", err)
+}
+
+func TestPlaceMarketOrderAmountUnset(t *testing.T) {
+	t.Parallel()
+	_, err := c.PlaceMarketOrder(context.Background(), "", 0, 0, order.Buy.String(), testPair)
+	if err != errAmountUnset {
+		t.Errorf("expected %v, received %v", errAmountUnset, err)
 	}
 }
 
@@ -245,7 +279,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	c.GetFeeByType(feeBuilder)
+	c.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -261,33 +295,17 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 
 	if areTestAPIKeysSet() {
-		// CryptocurrencyTradeFee Basic
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0.003) || err != nil {
-			t.Error(err)
-			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.003), resp)
-		}
-
-		// CryptocurrencyTradeFee High quantity
-		feeBuilder = setFeeBuilder()
-		feeBuilder.Amount = 1000
-		feeBuilder.PurchasePrice = 1000
-		if resp, err := c.GetFee(feeBuilder); resp != float64(3000) || err != nil {
-			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(3000), resp)
-			t.Error(err)
-		}
-
-		// CryptocurrencyTradeFee IsMaker
-		feeBuilder = setFeeBuilder()
-		feeBuilder.IsMaker = true
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
-			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.01), resp)
+		// CryptocurrencyTradeFee Basic, the rate depends on the account's
+		// fee tier
+		if resp, err := c.GetFee(context.Background(), feeBuilder); resp <= 0 || err != nil {
 			t.Error(err)
+			t.Errorf("GetFee() error. Expected a fee, Received: %f", resp)
 		}
 
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -296,7 +314,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -304,7 +322,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -313,7 +331,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
 	feeBuilder.FiatCurrency = currency.EUR
-	if resp, err := c.GetFee(feeBuilder); resp != float64(0.15) || err != nil {
+	if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(0.15) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -322,7 +340,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := c.GetFee(feeBuilder); resp != float64(25) || err != nil {
+	if resp, err := c.GetFee(context.Background(), feeBuilder); resp != float64(25) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -330,87 +348,25 @@ func TestGetFee(t *testing.T) {
 
 func TestCalculateTradingFee(t *testing.T) {
 	t.Parallel()
-	// uppercase
-	var volume = []Volume{
-		{
-			ProductID: "BTC_USD",
-			Volume:    100,
-		},
-	}
-
-	if resp := c.calculateTradingFee(volume, currency.BTC, currency.USD, "_", 1, 1, false); resp != float64(0.003) {
-		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.003), resp)
-	}
-
-	// lowercase
-	volume = []Volume{
-		{
-			ProductID: "btc_usd",
-			Volume:    100,
-		},
-	}
-
-	if resp := c.calculateTradingFee(volume, currency.BTC, currency.USD, "_", 1, 1, false); resp != float64(0.003) {
-		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.003), resp)
-	}
-
-	// mixedCase
-	volume = []Volume{
-		{
-			ProductID: "btc_USD",
-			Volume:    100,
-		},
-	}
-
-	if resp := c.calculateTradingFee(volume, currency.BTC, currency.USD, "_", 1, 1, false); resp != float64(0.003) {
-		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.003), resp)
-	}
-
-	// medium volume
-	volume = []Volume{
-		{
-			ProductID: "btc_USD",
-			Volume:    10000001,
-		},
-	}
-
-	if resp := c.calculateTradingFee(volume, currency.BTC, currency.USD, "_", 1, 1, false); resp != float64(0.002) {
-		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.002), resp)
-	}
+	var summary TransactionSummary
+	summary.FeeTier.TakerFeeRate = 0.006
+	summary.FeeTier.MakerFeeRate = 0.004
 
-	// high volume
-	volume = []Volume{
-		{
-			ProductID: "btc_USD",
-			Volume:    100000010000,
-		},
-	}
-
-	if resp := c.calculateTradingFee(volume, currency.BTC, currency.USD, "_", 1, 1, false); resp != float64(0.001) {
-		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
-	}
-
-	// no match
-	volume = []Volume{
-		{
-			ProductID: "btc_beeteesee",
-			Volume:    100000010000,
-		},
+	if resp := calculateTradingFee(&summary, 1, 1, false); resp != float64(0.006) {
+		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.006), resp)
 	}
 
-	if resp := c.calculateTradingFee(volume, currency.BTC, currency.USD, "_", 1, 1, false); resp != float64(0) {
-		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
+	if resp := calculateTradingFee(&summary, 1000, 1000, false); resp != float64(6000) {
+		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(6000), resp)
 	}
 
-	// taker
-	volume = []Volume{
-		{
-			ProductID: "btc_USD",
-			Volume:    100000010000,
-		},
+	// maker
+	if resp := calculateTradingFee(&summary, 1, 1, true); resp != float64(0.004) {
+		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.004), resp)
 	}
 
-	if resp := c.calculateTradingFee(volume, currency.BTC, currency.USD, "_", 1, 1, true); resp != float64(0) {
+	// no fee tier
+	if resp := calculateTradingFee(&TransactionSummary{}, 1, 1, false); resp != float64(0) {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 	}
 }
@@ -430,7 +386,7 @@ func TestGetActiveOrders(t *testing.T) {
 			currency.LTC)},
 	}
 
-	_, err := c.GetActiveOrders(&getOrdersRequest)
+	_, err := c.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -445,7 +401,7 @@ func TestGetOrderHistory(t *testing.T) {
 			currency.LTC)},
 	}
 
-	_, err := c.GetOrderHistory(&getOrdersRequest)
+	_, err := c.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -472,11 +428,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := c.SubmitOrder(orderSubmission)
+	response, err := c.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -497,7 +453,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := c.CancelOrder(orderCancellation)
+	err := c.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -519,7 +475,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := c.CancelAllOrders(orderCancellation)
+	resp, err := c.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -537,7 +493,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := c.ModifyOrder(&order.Modify{})
+	_, err := c.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -557,7 +513,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := c.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := c.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -579,7 +535,7 @@ func TestWithdrawFiat(t *testing.T) {
 		BankName: "Federal Reserve Bank",
 	}
 
-	_, err := c.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := c.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -601,7 +557,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 		BankName: "Federal Reserve Bank",
 	}
 
-	_, err := c.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := c.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -611,9 +567,44 @@ func TestWithdrawInternationalBank(t *testing.T) {
 }
 
 func TestGetDepositAddress(t *testing.T) {
-	_, err := c.GetDepositAddress(currency.BTC, "")
-	if err == nil {
+	_, err := c.GetDepositAddress(context.Background(), currency.BTC, "")
+	if areTestAPIKeysSet() && err != nil {
 		t.Error("GetDepositAddress() error", err)
+	} else if !areTestAPIKeysSet() && err == nil {
+		t.Error("Expecting an error when no keys are set")
+	}
+}
+
+func TestOrderDetail(t *testing.T) {
+	t.Parallel()
+	o := GeneralizedOrderResponse{
+		OrderID:   "1337",
+		ProductID: testPair,
+		Side:      "BUY",
+		Status:    "OPEN",
+		OrderType: "LIMIT",
+		OrderConfiguration: OrderConfiguration{
+			LimitGTC: &LimitGTC{BaseSize: "2", LimitPrice: "100"},
+		},
+		FilledSize: 0.5,
+	}
+	d := c.orderDetail(&o)
+	if d.ID != "1337" || d.Status != order.Active ||
+		d.OrderSide != order.Buy || d.OrderType != order.Limit {
+		t.Errorf("unexpected order detail %+v", d)
+	}
+	if d.CurrencyPair.String() != testPair {
+		t.Errorf("expected pair %s, received %s", testPair, d.CurrencyPair)
+	}
+	if d.Price.Float64() != 100 || d.Amount.Float64() != 2 ||
+		d.RemainingAmount.Float64() != 1.5 {
+		t.Errorf("unexpected order amounts %+v", d)
+	}
+	if orderStatus("FAILED") != order.Rejected {
+		t.Error("failed orders should be rejected")
+	}
+	if orderStatus("meow") != order.UnknownStatus {
+		t.Error("unrecognised statuses should be unknown")
 	}
 }
 
@@ -652,3 +643,60 @@ func TestWsAuth(t *testing.T) {
 	}
 	timer.Stop()
 }
+
+func TestWsHandleData(t *testing.T) {
+	var cb CoinbasePro
+	cb.SetDefaults()
+	cb.Name = "CoinbaseWsTest"
+	cb.Websocket.DataHandler = make(chan interface{}, 10)
+	cb.Websocket.Orderbook.Setup(exchange.DefaultWebsocketOrderbookBufferLimit,
+		false, true, false, false, cb.Name)
+
+	err := cb.wsHandleData([]byte(`{"channel":"ticker","timestamp":"2023-02-09T20:30:37.167359596Z","sequence_num":1,"events":[{"type":"snapshot","tickers":[{"type":"ticker","product_id":"BTC-USD","price":"21932.98","volume_24_h":"16038.28770938","low_24_h":"21835.29","high_24_h":"23011.18","best_bid":"21932.97","best_ask":"21932.98"}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	tick, ok := (<-cb.Websocket.DataHandler).(*ticker.Price)
+	if !ok {
+		t.Fatal("expected a ticker")
+	}
+	if tick.Last != 21932.98 || tick.Bid != 21932.97 || tick.High != 23011.18 ||
+		tick.Pair.String() != testPair {
+		t.Errorf("unexpected ticker %+v", tick)
+	}
+
+	err = cb.wsHandleData([]byte(`{"channel":"l2_data","timestamp":"2023-02-09T20:32:50.714964855Z","sequence_num":2,"events":[{"type":"snapshot","product_id":"BTC-USD","updates":[{"side":"bid","event_time":"1970-01-01T00:00:00Z","price_level":"21921.73","new_quantity":"0.06317902"},{"side":"offer","event_time":"1970-01-01T00:00:00Z","price_level":"21921.74","new_quantity":"0.1"}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-cb.Websocket.DataHandler
+	err = cb.wsHandleData([]byte(`{"channel":"l2_data","timestamp":"2023-02-09T20:32:51.714964855Z","sequence_num":3,"events":[{"type":"update","product_id":"BTC-USD","updates":[{"side":"bid","event_time":"2023-02-09T20:32:51.71Z","price_level":"21921.72","new_quantity":"1"}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	<-cb.Websocket.DataHandler
+	ob := cb.Websocket.Orderbook.GetOrderbook(currency.NewPairFromString(testPair), asset.Spot)
+	if ob == nil || len(ob.Bids) != 2 || len(ob.Asks) != 1 {
+		t.Fatalf("unexpected orderbook %+v", ob)
+	}
+
+	err = cb.wsHandleData([]byte(`{"channel":"user","timestamp":"2023-02-09T20:33:57.609931463Z","sequence_num":5,"events":[{"type":"update","orders":[{"order_id":"1337","client_order_id":"meow","cumulative_quantity":"0.5","leaves_quantity":"1.5","avg_price":"100","total_fees":"0","status":"OPEN","product_id":"BTC-USD","creation_time":"2022-12-07T19:42:18.719312Z","order_side":"BUY","order_type":"Limit"}]}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := (<-cb.Websocket.DataHandler).(error); !ok {
+		t.Error("expected a sequence gap error")
+	}
+	o, ok := (<-cb.Websocket.DataHandler).(WebsocketOrder)
+	if !ok {
+		t.Fatal("expected an order update")
+	}
+	if o.OrderID != "1337" || o.LeavesQuantity != 1.5 {
+		t.Errorf("unexpected order update %+v", o)
+	}
+
+	err = cb.wsHandleData([]byte(`{"type":"error","message":"failure to subscribe"}`))
+	if err == nil {
+		t.Error("expected an error")
+	}
+}
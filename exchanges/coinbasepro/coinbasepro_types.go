@@ -1,208 +1,273 @@
 package coinbasepro
 
 import (
+	"encoding/json"
+	"strconv"
 	"time"
-
-	"github.com/thrasher-corp/gocryptotrader/currency"
 )
 
-// Product holds product information
-type Product struct {
-	ID             string      `json:"id"`
-	BaseCurrency   string      `json:"base_currency"`
-	QuoteCurrency  string      `json:"quote_currency"`
-	BaseMinSize    float64     `json:"base_min_size,string"`
-	BaseMaxSize    interface{} `json:"base_max_size"`
-	QuoteIncrement float64     `json:"quote_increment,string"`
-	DisplayName    string      `json:"string"`
+// numberString is a number sent as a string, which Advanced Trade leaves
+// empty for products without recent activity
+type numberString float64
+
+// UnmarshalJSON decodes a quoted number, an empty string is zero
+func (n *numberString) UnmarshalJSON(b []byte) error {
+	var s string
+	if err := json.Unmarshal(b, &s); err != nil {
+		return err
+	}
+	if s == "" {
+		*n = 0
+		return nil
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return err
+	}
+	*n = numberString(f)
+	return nil
+}
+
+// Float64 returns the number
+func (n numberString) Float64() float64 {
+	return float64(n)
 }
 
-// Ticker holds basic ticker information
+// Product holds product information
+type Product struct {
+	ID                 string       `json:"product_id"`
+	Price              numberString `json:"price"`
+	BaseCurrency       string       `json:"base_currency_id"`
+	QuoteCurrency      string       `json:"quote_currency_id"`
+	BaseIncrement      float64      `json:"base_increment,string"`
+	QuoteIncrement     float64      `json:"quote_increment,string"`
+	BaseMinSize        float64      `json:"base_min_size,string"`
+	BaseMaxSize        numberString `json:"base_max_size"`
+	Volume24H          numberString `json:"volume_24h"`
+	PriceChange24H     numberString `json:"price_percentage_change_24h"`
+	Status             string       `json:"status"`
+	TradingDisabled    bool         `json:"trading_disabled"`
+	CancelOnly         bool         `json:"cancel_only"`
+	LimitOnly          bool         `json:"limit_only"`
+	PostOnly           bool         `json:"post_only"`
+	AuctionMode        bool         `json:"auction_mode"`
+	ProductType        string       `json:"product_type"`
+	DisplayName        string       `json:"display_name"`
+	QuoteCurrencyLabel string       `json:"quote_display_symbol"`
+}
+
+// ProductsResponse holds the products listing
+type ProductsResponse struct {
+	Products    []Product `json:"products"`
+	NumProducts int64     `json:"num_products"`
+}
+
+// Ticker holds the latest trades and best bid and ask for a product
 type Ticker struct {
-	TradeID int64     `json:"trade_id"`
-	Ask     float64   `json:"ask,string"`
-	Bid     float64   `json:"bid,string"`
-	Price   float64   `json:"price,string"`
-	Size    float64   `json:"size,string"`
-	Volume  float64   `json:"volume,string"`
-	Time    time.Time `json:"time"`
+	Trades  []Trade `json:"trades"`
+	BestBid float64 `json:"best_bid,string"`
+	BestAsk float64 `json:"best_ask,string"`
 }
 
 // Trade holds executed trade information
 type Trade struct {
-	TradeID int64   `json:"trade_id"`
-	Price   float64 `json:"price,string"`
-	Size    float64 `json:"size,string"`
-	Time    string  `json:"time"`
-	Side    string  `json:"side"`
+	TradeID   string    `json:"trade_id"`
+	ProductID string    `json:"product_id"`
+	Price     float64   `json:"price,string"`
+	Size      float64   `json:"size,string"`
+	Time      time.Time `json:"time"`
+	Side      string    `json:"side"`
 }
 
 // History holds historic rate information
 type History struct {
-	Time   int64   `json:"time"`
-	Low    float64 `json:"low"`
-	High   float64 `json:"high"`
-	Open   float64 `json:"open"`
-	Close  float64 `json:"close"`
-	Volume float64 `json:"volume"`
-}
-
-// Stats holds last 24 hr data for coinbasepro
-type Stats struct {
-	Open   float64 `json:"open,string"`
-	High   float64 `json:"high,string"`
+	Time   int64   `json:"start,string"`
 	Low    float64 `json:"low,string"`
+	High   float64 `json:"high,string"`
+	Open   float64 `json:"open,string"`
+	Close  float64 `json:"close,string"`
 	Volume float64 `json:"volume,string"`
 }
 
-// Currency holds singular currency product information
-type Currency struct {
-	ID      string
-	Name    string
-	MinSize float64 `json:"min_size,string"`
+// CandlesResponse holds a page of candles
+type CandlesResponse struct {
+	Candles []History `json:"candles"`
 }
 
 // ServerTime holds current requested server time information
 type ServerTime struct {
-	ISO   string  `json:"iso"`
-	Epoch float64 `json:"epoch"`
-}
-
-// AccountResponse holds the details for the trading accounts
-type AccountResponse struct {
-	ID            string  `json:"id"`
-	Currency      string  `json:"currency"`
-	Balance       float64 `json:"balance,string"`
-	Available     float64 `json:"available,string"`
-	Hold          float64 `json:"hold,string"`
-	ProfileID     string  `json:"profile_id"`
-	MarginEnabled bool    `json:"margin_enabled"`
-	FundedAmount  float64 `json:"funded_amount,string"`
-	DefaultAmount float64 `json:"default_amount,string"`
-}
-
-// AccountLedgerResponse holds account history information
-type AccountLedgerResponse struct {
-	ID        string      `json:"id"`
-	CreatedAt string      `json:"created_at"`
-	Amount    float64     `json:"amount,string"`
-	Balance   float64     `json:"balance,string"`
-	Type      string      `json:"type"`
-	Details   interface{} `json:"details"`
-}
-
-// AccountHolds contains the hold information about an account
-type AccountHolds struct {
-	ID        string  `json:"id"`
-	AccountID string  `json:"account_id"`
-	CreatedAt string  `json:"created_at"`
-	UpdatedAt string  `json:"updated_at"`
-	Amount    float64 `json:"amount,string"`
-	Type      string  `json:"type"`
-	Reference string  `json:"ref"`
+	ISO          string `json:"iso"`
+	EpochSeconds int64  `json:"epochSeconds,string"`
+	EpochMillis  int64  `json:"epochMillis,string"`
+}
+
+// Balance holds an amount of a currency
+type Balance struct {
+	Value    float64 `json:"value,string"`
+	Currency string  `json:"currency"`
+}
+
+// Account holds the details for a trading account
+type Account struct {
+	UUID             string  `json:"uuid"`
+	Name             string  `json:"name"`
+	Currency         string  `json:"currency"`
+	AvailableBalance Balance `json:"available_balance"`
+	Default          bool    `json:"default"`
+	Active           bool    `json:"active"`
+	Type             string  `json:"type"`
+	Ready            bool    `json:"ready"`
+	Hold             Balance `json:"hold"`
+}
+
+// AccountsResponse holds a page of accounts
+type AccountsResponse struct {
+	Accounts []Account `json:"accounts"`
+	HasNext  bool      `json:"has_next"`
+	Cursor   string    `json:"cursor"`
+	Size     int64     `json:"size"`
+}
+
+// OrderbookLevel holds a single aggregated price level
+type OrderbookLevel struct {
+	Price float64 `json:"price,string"`
+	Size  float64 `json:"size,string"`
+}
+
+// Orderbook holds an aggregated orderbook
+type Orderbook struct {
+	ProductID string           `json:"product_id"`
+	Bids      []OrderbookLevel `json:"bids"`
+	Asks      []OrderbookLevel `json:"asks"`
+	Time      time.Time        `json:"time"`
+}
+
+// OrderbookResponse wraps the product book endpoint response
+type OrderbookResponse struct {
+	Pricebook Orderbook `json:"pricebook"`
+}
+
+// MarketIOC configures a market order, sized by one of base or quote size
+type MarketIOC struct {
+	QuoteSize string `json:"quote_size,omitempty"`
+	BaseSize  string `json:"base_size,omitempty"`
+}
+
+// LimitGTC configures a good til cancelled limit order
+type LimitGTC struct {
+	BaseSize   string `json:"base_size"`
+	LimitPrice string `json:"limit_price"`
+	PostOnly   bool   `json:"post_only"`
+}
+
+// OrderConfiguration holds the one configuration set for an order
+type OrderConfiguration struct {
+	MarketIOC *MarketIOC `json:"market_market_ioc,omitempty"`
+	LimitGTC  *LimitGTC  `json:"limit_limit_gtc,omitempty"`
+}
+
+// CreateOrderRequest is a new order
+type CreateOrderRequest struct {
+	ClientOrderID      string             `json:"client_order_id"`
+	ProductID          string             `json:"product_id"`
+	Side               string             `json:"side"`
+	OrderConfiguration OrderConfiguration `json:"order_configuration"`
+}
+
+// CreateOrderResponse holds the result of submitting an order
+type CreateOrderResponse struct {
+	Success         bool   `json:"success"`
+	FailureReason   string `json:"failure_reason"`
+	OrderID         string `json:"order_id"`
+	SuccessResponse struct {
+		OrderID       string `json:"order_id"`
+		ProductID     string `json:"product_id"`
+		Side          string `json:"side"`
+		ClientOrderID string `json:"client_order_id"`
+	} `json:"success_response"`
+	ErrorResponse struct {
+		Error   string `json:"error"`
+		Message string `json:"message"`
+	} `json:"error_response"`
+}
+
+// CancelResult holds the result of cancelling a single order
+type CancelResult struct {
+	Success       bool   `json:"success"`
+	FailureReason string `json:"failure_reason"`
+	OrderID       string `json:"order_id"`
+}
+
+// CancelOrdersResponse holds the results of a batch cancel
+type CancelOrdersResponse struct {
+	Results []CancelResult `json:"results"`
 }
 
 // GeneralizedOrderResponse is the generalized return type across order
 // placement and information collation
 type GeneralizedOrderResponse struct {
-	ID             string  `json:"id"`
-	Price          float64 `json:"price,string"`
-	Size           float64 `json:"size,string"`
-	ProductID      string  `json:"product_id"`
-	Side           string  `json:"side"`
-	Stp            string  `json:"stp"`
-	Type           string  `json:"type"`
-	TimeInForce    string  `json:"time_in_force"`
-	PostOnly       bool    `json:"post_only"`
-	CreatedAt      string  `json:"created_at"`
-	FillFees       float64 `json:"fill_fees,string"`
-	FilledSize     float64 `json:"filled_size,string"`
-	ExecutedValue  float64 `json:"executed_value,string"`
-	Status         string  `json:"status"`
-	Settled        bool    `json:"settled"`
-	Funds          float64 `json:"funds,string"`
-	SpecifiedFunds float64 `json:"specified_funds,string"`
-	DoneReason     string  `json:"done_reason"`
-	DoneAt         string  `json:"done_at"`
-}
-
-// Funding holds funding data
-type Funding struct {
-	ID            string  `json:"id"`
-	OrderID       string  `json:"order_id"`
-	ProfileID     string  `json:"profile_id"`
-	Amount        float64 `json:"amount,string"`
-	Status        string  `json:"status"`
-	CreatedAt     string  `json:"created_at"`
-	Currency      string  `json:"currency"`
-	RepaidAmount  float64 `json:"repaid_amount"`
-	DefaultAmount float64 `json:"default_amount,string"`
-	RepaidDefault bool    `json:"repaid_default"`
-}
-
-// MarginTransfer holds margin transfer details
-type MarginTransfer struct {
-	CreatedAt       string  `json:"created_at"`
-	ID              string  `json:"id"`
-	UserID          string  `json:"user_id"`
-	ProfileID       string  `json:"profile_id"`
-	MarginProfileID string  `json:"margin_profile_id"`
-	Type            string  `json:"type"`
-	Amount          float64 `json:"amount,string"`
-	Currency        string  `json:"currency"`
-	AccountID       string  `json:"account_id"`
-	MarginAccountID string  `json:"margin_account_id"`
-	MarginProductID string  `json:"margin_product_id"`
-	Status          string  `json:"status"`
-	Nonce           int     `json:"nonce"`
-}
-
-// AccountOverview holds account information returned from position
-type AccountOverview struct {
-	Status  string `json:"status"`
-	Funding struct {
-		MaxFundingValue   float64 `json:"max_funding_value,string"`
-		FundingValue      float64 `json:"funding_value,string"`
-		OldestOutstanding struct {
-			ID        string  `json:"id"`
-			OrderID   string  `json:"order_id"`
-			CreatedAt string  `json:"created_at"`
-			Currency  string  `json:"currency"`
-			AccountID string  `json:"account_id"`
-			Amount    float64 `json:"amount,string"`
-		} `json:"oldest_outstanding"`
-	} `json:"funding"`
-	Accounts struct {
-		LTC Account `json:"LTC"`
-		ETH Account `json:"ETH"`
-		USD Account `json:"USD"`
-		BTC Account `json:"BTC"`
-	} `json:"accounts"`
-	MarginCall struct {
-		Active bool    `json:"active"`
-		Price  float64 `json:"price,string"`
-		Side   string  `json:"side"`
-		Size   float64 `json:"size,string"`
-		Funds  float64 `json:"funds,string"`
-	} `json:"margin_call"`
-	UserID    string `json:"user_id"`
-	ProfileID string `json:"profile_id"`
-	Position  struct {
-		Type       string  `json:"type"`
-		Size       float64 `json:"size,string"`
-		Complement float64 `json:"complement,string"`
-		MaxSize    float64 `json:"max_size,string"`
-	} `json:"position"`
-	ProductID string `json:"product_id"`
-}
-
-// Account is a sub-type for account overview
-type Account struct {
-	ID            string  `json:"id"`
-	Balance       float64 `json:"balance,string"`
-	Hold          float64 `json:"hold,string"`
-	FundedAmount  float64 `json:"funded_amount,string"`
-	DefaultAmount float64 `json:"default_amount,string"`
+	OrderID             string             `json:"order_id"`
+	ProductID           string             `json:"product_id"`
+	UserID              string             `json:"user_id"`
+	OrderConfiguration  OrderConfiguration `json:"order_configuration"`
+	Side                string             `json:"side"`
+	ClientOrderID       string             `json:"client_order_id"`
+	Status              string             `json:"status"`
+	TimeInForce         string             `json:"time_in_force"`
+	CreatedTime         time.Time          `json:"created_time"`
+	CompletionPercent   float64            `json:"completion_percentage,string"`
+	FilledSize          float64            `json:"filled_size,string"`
+	AverageFilledPrice  float64            `json:"average_filled_price,string"`
+	Fee                 string             `json:"fee"`
+	NumberOfFills       int64              `json:"number_of_fills,string"`
+	FilledValue         float64            `json:"filled_value,string"`
+	PendingCancel       bool               `json:"pending_cancel"`
+	SizeInQuote         bool               `json:"size_in_quote"`
+	TotalFees           float64            `json:"total_fees,string"`
+	SizeInclusiveOfFees bool               `json:"size_inclusive_of_fees"`
+	TotalValueAfterFees float64            `json:"total_value_after_fees,string"`
+	OrderType           string             `json:"order_type"`
+	RejectReason        string             `json:"reject_reason"`
+}
+
+// OrdersResponse holds a page of orders
+type OrdersResponse struct {
+	Orders  []GeneralizedOrderResponse `json:"orders"`
+	HasNext bool                       `json:"has_next"`
+	Cursor  string                     `json:"cursor"`
+}
+
+// Fill holds the details of an order execution
+type Fill struct {
+	EntryID            string    `json:"entry_id"`
+	TradeID            string    `json:"trade_id"`
+	OrderID            string    `json:"order_id"`
+	TradeTime          time.Time `json:"trade_time"`
+	TradeType          string    `json:"trade_type"`
+	Price              float64   `json:"price,string"`
+	Size               float64   `json:"size,string"`
+	Commission         float64   `json:"commission,string"`
+	ProductID          string    `json:"product_id"`
+	LiquidityIndicator string    `json:"liquidity_indicator"`
+	SizeInQuote        bool      `json:"size_in_quote"`
+	Side               string    `json:"side"`
+}
+
+// FillsResponse holds a page of fills
+type FillsResponse struct {
+	Fills  []Fill `json:"fills"`
+	Cursor string `json:"cursor"`
+}
+
+// TransactionSummary holds the account's fee tier and trading volume
+type TransactionSummary struct {
+	TotalVolume float64 `json:"total_volume"`
+	TotalFees   float64 `json:"total_fees"`
+	FeeTier     struct {
+		PricingTier  string  `json:"pricing_tier"`
+		TakerFeeRate float64 `json:"taker_fee_rate,string"`
+		MakerFeeRate float64 `json:"maker_fee_rate,string"`
+	} `json:"fee_tier"`
 }
 
 // PaymentMethod holds payment method information
@@ -215,279 +280,123 @@ type PaymentMethod struct {
 	PrimarySell   bool   `json:"primary_sell"`
 	AllowBuy      bool   `json:"allow_buy"`
 	AllowSell     bool   `json:"allow_sell"`
-	AllowDeposits bool   `json:"allow_deposits"`
+	AllowDeposit  bool   `json:"allow_deposit"`
 	AllowWithdraw bool   `json:"allow_withdraw"`
-	Limits        struct {
-		Buy        []LimitInfo `json:"buy"`
-		InstantBuy []LimitInfo `json:"instant_buy"`
-		Sell       []LimitInfo `json:"sell"`
-		Deposit    []LimitInfo `json:"deposit"`
-	} `json:"limits"`
-}
-
-// LimitInfo is a sub-type for payment method
-type LimitInfo struct {
-	PeriodInDays int `json:"period_in_days"`
-	Total        struct {
-		Amount   float64 `json:"amount,string"`
-		Currency string  `json:"currency"`
-	} `json:"total"`
 }
 
 // DepositWithdrawalInfo holds returned deposit information
 type DepositWithdrawalInfo struct {
-	ID       string  `json:"id"`
-	Amount   float64 `json:"amount,string"`
-	Currency string  `json:"currency"`
-	PayoutAt string  `json:"payout_at"`
-}
-
-// CoinbaseAccounts holds coinbase account information
-type CoinbaseAccounts struct {
-	ID                     string  `json:"id"`
-	Name                   string  `json:"name"`
-	Balance                float64 `json:"balance,string"`
-	Currency               string  `json:"currency"`
-	Type                   string  `json:"type"`
-	Primary                bool    `json:"primary"`
-	Active                 bool    `json:"active"`
-	WireDepositInformation struct {
-		AccountNumber string `json:"account_number"`
-		RoutingNumber string `json:"routing_number"`
-		BankName      string `json:"bank_name"`
-		BankAddress   string `json:"bank_address"`
-		BankCountry   struct {
-			Code string `json:"code"`
-			Name string `json:"name"`
-		} `json:"bank_country"`
-		AccountName    string `json:"account_name"`
-		AccountAddress string `json:"account_address"`
-		Reference      string `json:"reference"`
-	} `json:"wire_deposit_information"`
-	SepaDepositInformation struct {
-		Iban            string `json:"iban"`
-		Swift           string `json:"swift"`
-		BankName        string `json:"bank_name"`
-		BankAddress     string `json:"bank_address"`
-		BankCountryName string `json:"bank_country_name"`
-		AccountName     string `json:"account_name"`
-		AccountAddress  string `json:"account_address"`
-		Reference       string `json:"reference"`
-	} `json:"sep_deposit_information"`
-}
-
-// Report holds historical information
-type Report struct {
-	ID          string `json:"id"`
-	Type        string `json:"type"`
-	Status      string `json:"status"`
-	CreatedAt   string `json:"created_at"`
-	CompletedAt string `json:"completed_at"`
-	ExpiresAt   string `json:"expires_at"`
-	FileURL     string `json:"file_url"`
-	Params      struct {
-		StartDate string `json:"start_date"`
-		EndDate   string `json:"end_date"`
-	} `json:"params"`
-}
-
-// Volume type contains trailing volume information
-type Volume struct {
-	ProductID      string  `json:"product_id"`
-	ExchangeVolume float64 `json:"exchange_volume,string"`
-	Volume         float64 `json:"volume,string"`
-	RecordedAt     string  `json:"recorded_at"`
-}
-
-// OrderL1L2 is a type used in layer conversion
-type OrderL1L2 struct {
-	Price     float64
-	Amount    float64
-	NumOrders float64
-}
-
-// OrderL3 is a type used in layer conversion
-type OrderL3 struct {
-	Price   float64
-	Amount  float64
-	OrderID string
-}
-
-// OrderbookL1L2 holds level 1 and 2 order book information
-type OrderbookL1L2 struct {
-	Sequence int64       `json:"sequence"`
-	Bids     []OrderL1L2 `json:"bids"`
-	Asks     []OrderL1L2 `json:"asks"`
-}
-
-// OrderbookL3 holds level 3 order book information
-type OrderbookL3 struct {
-	Sequence int64     `json:"sequence"`
-	Bids     []OrderL3 `json:"bids"`
-	Asks     []OrderL3 `json:"asks"`
-}
-
-// OrderbookResponse is a generalized response for order books
-type OrderbookResponse struct {
-	Sequence int64           `json:"sequence"`
-	Bids     [][]interface{} `json:"bids"`
-	Asks     [][]interface{} `json:"asks"`
+	ID     string `json:"id"`
+	Type   string `json:"type"`
+	Status string `json:"status"`
+	Amount struct {
+		Amount   float64 `json:"amount,string"`
+		Currency string  `json:"currency"`
+	} `json:"amount"`
+	CreatedAt time.Time `json:"created_at"`
 }
 
-// FillResponse contains fill information from the exchange
-type FillResponse struct {
-	TradeID   int     `json:"trade_id"`
-	ProductID string  `json:"product_id"`
-	Price     float64 `json:"price,string"`
-	Size      float64 `json:"size,string"`
-	OrderID   string  `json:"order_id"`
-	CreatedAt string  `json:"created_at"`
-	Liquidity string  `json:"liquidity"`
-	Fee       float64 `json:"fee,string"`
-	Settled   bool    `json:"settled"`
-	Side      string  `json:"side"`
+// DepositAddress holds a crypto deposit address
+type DepositAddress struct {
+	ID      string `json:"id"`
+	Address string `json:"address"`
+	Network string `json:"network"`
+	Name    string `json:"name"`
 }
 
 // WebsocketSubscribe takes in subscription information
 type WebsocketSubscribe struct {
-	Type       string       `json:"type"`
-	ProductID  string       `json:"product_id,omitempty"`
-	Channels   []WsChannels `json:"channels,omitempty"`
-	Signature  string       `json:"signature,omitempty"`
-	Key        string       `json:"key,omitempty"`
-	Passphrase string       `json:"passphrase,omitempty"`
-	Timestamp  string       `json:"timestamp,omitempty"`
-}
-
-// WsChannels defines outgoing channels for subscription purposes
-type WsChannels struct {
-	Name       string   `json:"name"`
+	Type       string   `json:"type"`
 	ProductIDs []string `json:"product_ids"`
+	Channel    string   `json:"channel"`
+	Key        string   `json:"api_key,omitempty"`
+	Signature  string   `json:"signature,omitempty"`
+	Timestamp  string   `json:"timestamp,omitempty"`
+}
+
+// WebsocketMessage is the envelope every websocket message arrives in
+type WebsocketMessage struct {
+	Channel     string    `json:"channel"`
+	ClientID    string    `json:"client_id"`
+	Timestamp   time.Time `json:"timestamp"`
+	SequenceNum int64     `json:"sequence_num"`
+	// Type and Message are set on error messages
+	Type    string `json:"type"`
+	Message string `json:"message"`
 }
 
-// WebsocketReceived holds websocket received values
-type WebsocketReceived struct {
-	Type      string  `json:"type"`
-	OrderID   string  `json:"order_id"`
-	OrderType string  `json:"order_type"`
-	Size      float64 `json:"size,string"`
-	Price     float64 `json:"price,omitempty,string"`
-	Funds     float64 `json:"funds,omitempty,string"`
-	Side      string  `json:"side"`
-	ClientOID string  `json:"client_oid"`
-	ProductID string  `json:"product_id"`
-	Sequence  int64   `json:"sequence"`
-	Time      string  `json:"time"`
-}
-
-// WebsocketOpen collates open orders
-type WebsocketOpen struct {
-	Type          string  `json:"type"`
-	Side          string  `json:"side"`
-	Price         float64 `json:"price,string"`
-	OrderID       string  `json:"order_id"`
-	RemainingSize float64 `json:"remaining_size,string"`
-	ProductID     string  `json:"product_id"`
-	Sequence      int64   `json:"sequence"`
-	Time          string  `json:"time"`
-}
-
-// WebsocketDone holds finished order information
-type WebsocketDone struct {
-	Type          string  `json:"type"`
-	Side          string  `json:"side"`
-	OrderID       string  `json:"order_id"`
-	Reason        string  `json:"reason"`
-	ProductID     string  `json:"product_id"`
-	Price         float64 `json:"price,string"`
-	RemainingSize float64 `json:"remaining_size,string"`
-	Sequence      int64   `json:"sequence"`
-	Time          string  `json:"time"`
-}
-
-// WebsocketMatch holds match information
-type WebsocketMatch struct {
-	Type         string  `json:"type"`
-	TradeID      int     `json:"trade_id"`
-	MakerOrderID string  `json:"maker_order_id"`
-	TakerOrderID string  `json:"taker_order_id"`
-	Side         string  `json:"side"`
-	Size         float64 `json:"size,string"`
-	Price        float64 `json:"price,string"`
-	ProductID    string  `json:"product_id"`
-	Sequence     int64   `json:"sequence"`
-	Time         string  `json:"time"`
-}
-
-// WebsocketChange holds change information
-type WebsocketChange struct {
-	Type     string  `json:"type"`
-	Time     string  `json:"time"`
-	Sequence int     `json:"sequence"`
-	OrderID  string  `json:"order_id"`
-	NewSize  float64 `json:"new_size,string"`
-	OldSize  float64 `json:"old_size,string"`
-	Price    float64 `json:"price,string"`
-	Side     string  `json:"side"`
-}
-
-// WebsocketHeartBeat defines JSON response for a heart beat message
-type WebsocketHeartBeat struct {
-	Type        string `json:"type"`
-	Sequence    int64  `json:"sequence"`
-	LastTradeID int64  `json:"last_trade_id"`
-	ProductID   string `json:"product_id"`
-	Time        string `json:"time"`
+// WebsocketL2Message holds level 2 orderbook snapshots and updates
+type WebsocketL2Message struct {
+	Events []WebsocketL2Event `json:"events"`
+}
+
+// WebsocketL2Event holds the orderbook changes for a product
+type WebsocketL2Event struct {
+	Type      string              `json:"type"`
+	ProductID string              `json:"product_id"`
+	Updates   []WebsocketL2Change `json:"updates"`
+}
+
+// WebsocketL2Change is a new quantity at a price level, zero removes it
+type WebsocketL2Change struct {
+	Side        string    `json:"side"`
+	EventTime   time.Time `json:"event_time"`
+	PriceLevel  float64   `json:"price_level,string"`
+	NewQuantity float64   `json:"new_quantity,string"`
+}
+
+// WebsocketTickerMessage holds ticker updates
+type WebsocketTickerMessage struct {
+	Events []struct {
+		Type    string            `json:"type"`
+		Tickers []WebsocketTicker `json:"tickers"`
+	} `json:"events"`
 }
 
 // WebsocketTicker defines ticker websocket response
 type WebsocketTicker struct {
-	Type      string        `json:"type"`
-	Sequence  int64         `json:"sequence"`
-	ProductID currency.Pair `json:"product_id"`
-	Price     float64       `json:"price,string"`
-	Open24H   float64       `json:"open_24h,string"`
-	Volume24H float64       `json:"volume_24h,string"`
-	Low24H    float64       `json:"low_24h,string"`
-	High24H   float64       `json:"high_24h,string"`
-	Volume30D float64       `json:"volume_30d,string"`
-	BestBid   float64       `json:"best_bid,string"`
-	BestAsk   float64       `json:"best_ask,string"`
-	Side      string        `json:"side"`
-	Time      time.Time     `json:"time"`
-	TradeID   int64         `json:"trade_id"`
-	LastSize  float64       `json:"last_size,string"`
-}
-
-// WebsocketOrderbookSnapshot defines a snapshot response
-type WebsocketOrderbookSnapshot struct {
-	ProductID string          `json:"product_id"`
-	Type      string          `json:"type"`
-	Bids      [][]interface{} `json:"bids"`
-	Asks      [][]interface{} `json:"asks"`
-}
-
-// WebsocketL2Update defines an update on the L2 orderbooks
-type WebsocketL2Update struct {
-	Type      string          `json:"type"`
-	ProductID string          `json:"product_id"`
-	Time      string          `json:"time"`
-	Changes   [][]interface{} `json:"changes"`
-}
-
-// WebsocketActivate an activate message is sent when a stop order is placed
-type WebsocketActivate struct {
-	Type         string  `json:"type"`
-	ProductID    string  `json:"product_id"`
-	Timestamp    string  `json:"timestamp"`
-	UserID       string  `json:"user_id"`
-	ProfileID    string  `json:"profile_id"`
-	OrderID      string  `json:"order_id"`
-	StopType     string  `json:"stop_type"`
-	Side         string  `json:"side"`
-	StopPrice    float64 `json:"stop_price,string"`
-	Size         float64 `json:"size,string"`
-	Funds        float64 `json:"funds,string"`
-	TakerFeeRate float64 `json:"taker_fee_rate,string"`
-	Private      bool    `json:"private"`
+	Type            string  `json:"type"`
+	ProductID       string  `json:"product_id"`
+	Price           float64 `json:"price,string"`
+	Volume24H       float64 `json:"volume_24_h,string"`
+	Low24H          float64 `json:"low_24_h,string"`
+	High24H         float64 `json:"high_24_h,string"`
+	Low52W          float64 `json:"low_52_w,string"`
+	High52W         float64 `json:"high_52_w,string"`
+	PricePercentChg float64 `json:"price_percent_chg_24_h,string"`
+	BestBid         float64 `json:"best_bid,string"`
+	BestBidQuantity float64 `json:"best_bid_quantity,string"`
+	BestAsk         float64 `json:"best_ask,string"`
+	BestAskQuantity float64 `json:"best_ask_quantity,string"`
+}
+
+// WebsocketUserMessage holds updates to the account's orders
+type WebsocketUserMessage struct {
+	Events []struct {
+		Type   string           `json:"type"`
+		Orders []WebsocketOrder `json:"orders"`
+	} `json:"events"`
+}
+
+// WebsocketOrder is the state of an order after an update
+type WebsocketOrder struct {
+	OrderID            string    `json:"order_id"`
+	ClientOrderID      string    `json:"client_order_id"`
+	CumulativeQuantity float64   `json:"cumulative_quantity,string"`
+	LeavesQuantity     float64   `json:"leaves_quantity,string"`
+	AveragePrice       float64   `json:"avg_price,string"`
+	TotalFees          float64   `json:"total_fees,string"`
+	Status             string    `json:"status"`
+	ProductID          string    `json:"product_id"`
+	CreationTime       time.Time `json:"creation_time"`
+	OrderSide          string    `json:"order_side"`
+	OrderType          string    `json:"order_type"`
+}
+
+// WebsocketMarketTradesMessage holds trades executed on the exchange
+type WebsocketMarketTradesMessage struct {
+	Events []struct {
+		Type   string  `json:"type"`
+		Trades []Trade `json:"trades"`
+	} `json:"events"`
 }
@@ -3,9 +3,10 @@ package coinbasepro
 import (
 	"encoding/json"
 	"errors"
+	"fmt"
 	"net/http"
 	"strconv"
-	"time"
+	"strings"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
@@ -17,10 +18,11 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
+	"github.com/thrasher-corp/gocryptotrader/tracing"
 )
 
 const (
-	coinbaseproWebsocketURL = "wss://ws-feed.pro.coinbase.com"
+	coinbaseproWebsocketURL = "wss://advanced-trade-ws.coinbase.com"
 )
 
 // WsConnect initiates a websocket connection
@@ -34,6 +36,8 @@ func (c *CoinbasePro) WsConnect() error {
 		return err
 	}
 
+	// sequence numbers restart with each connection
+	c.wsSequence = 0
 	c.GenerateDefaultSubscriptions()
 	go c.WsHandleData()
 
@@ -60,158 +64,138 @@ func (c *CoinbasePro) WsHandleData() {
 			}
 			c.Websocket.TrafficAlert <- struct{}{}
 
-			type MsgType struct {
-				Type      string `json:"type"`
-				Sequence  int64  `json:"sequence"`
-				ProductID string `json:"product_id"`
-			}
-
-			msgType := MsgType{}
-			err = json.Unmarshal(resp.Raw, &msgType)
+			err = c.wsHandleData(resp.Raw)
 			if err != nil {
 				c.Websocket.DataHandler <- err
-				continue
-			}
-
-			if msgType.Type == "subscriptions" || msgType.Type == "heartbeat" {
-				continue
 			}
+		}
+	}
+}
 
-			switch msgType.Type {
-			case "error":
-				c.Websocket.DataHandler <- errors.New(string(resp.Raw))
+// wsHandleData processes a single websocket message
+func (c *CoinbasePro) wsHandleData(respRaw []byte) error {
+	var msg WebsocketMessage
+	err := json.Unmarshal(respRaw, &msg)
+	if err != nil {
+		return wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
+	}
 
-			case "ticker":
-				wsTicker := WebsocketTicker{}
-				err := json.Unmarshal(resp.Raw, &wsTicker)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
+	if msg.Type == "error" {
+		return errors.New(msg.Message)
+	}
 
+	if c.wsSequence != 0 && msg.SequenceNum != c.wsSequence+1 {
+		c.Websocket.DataHandler <- fmt.Errorf("%s websocket sequence gap: expected %d, received %d",
+			c.Name, c.wsSequence+1, msg.SequenceNum)
+	}
+	c.wsSequence = msg.SequenceNum
+
+	switch msg.Channel {
+	case "subscriptions", "heartbeats":
+		return nil
+	case "ticker", "ticker_batch":
+		var wsTicker WebsocketTickerMessage
+		err = json.Unmarshal(respRaw, &wsTicker)
+		if err != nil {
+			return wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
+		}
+		for i := range wsTicker.Events {
+			for j := range wsTicker.Events[i].Tickers {
+				t := &wsTicker.Events[i].Tickers[j]
 				c.Websocket.DataHandler <- &ticker.Price{
-					LastUpdated:  wsTicker.Time,
-					Pair:         wsTicker.ProductID,
+					LastUpdated:  msg.Timestamp,
+					Pair:         currency.NewPairFromString(t.ProductID),
 					AssetType:    asset.Spot,
 					ExchangeName: c.Name,
-					Open:         wsTicker.Open24H,
-					High:         wsTicker.High24H,
-					Low:          wsTicker.Low24H,
-					Last:         wsTicker.Price,
-					Volume:       wsTicker.Volume24H,
-					Bid:          wsTicker.BestBid,
-					Ask:          wsTicker.BestAsk,
+					High:         t.High24H,
+					Low:          t.Low24H,
+					Last:         t.Price,
+					Volume:       t.Volume24H,
+					Bid:          t.BestBid,
+					Ask:          t.BestAsk,
 				}
-
+			}
+		}
+	case "l2_data":
+		var l2 WebsocketL2Message
+		err = json.Unmarshal(respRaw, &l2)
+		if err != nil {
+			return wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
+		}
+		for i := range l2.Events {
+			switch l2.Events[i].Type {
 			case "snapshot":
-				snapshot := WebsocketOrderbookSnapshot{}
-				err := json.Unmarshal(resp.Raw, &snapshot)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-
-				err = c.ProcessSnapshot(&snapshot)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-
-			case "l2update":
-				update := WebsocketL2Update{}
-				err := json.Unmarshal(resp.Raw, &update)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-
-				err = c.ProcessUpdate(update)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-			case "received":
-				// We currently use l2update to calculate orderbook changes
-				received := WebsocketReceived{}
-				err := json.Unmarshal(resp.Raw, &received)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-				c.Websocket.DataHandler <- received
-			case "open":
-				// We currently use l2update to calculate orderbook changes
-				open := WebsocketOpen{}
-				err := json.Unmarshal(resp.Raw, &open)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-				c.Websocket.DataHandler <- open
-			case "done":
-				// We currently use l2update to calculate orderbook changes
-				done := WebsocketDone{}
-				err := json.Unmarshal(resp.Raw, &done)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-				c.Websocket.DataHandler <- done
-			case "change":
-				// We currently use l2update to calculate orderbook changes
-				change := WebsocketChange{}
-				err := json.Unmarshal(resp.Raw, &change)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-				c.Websocket.DataHandler <- change
-			case "activate":
-				// We currently use l2update to calculate orderbook changes
-				activate := WebsocketActivate{}
-				err := json.Unmarshal(resp.Raw, &activate)
-				if err != nil {
-					c.Websocket.DataHandler <- err
-					continue
-				}
-				c.Websocket.DataHandler <- activate
+				err = c.ProcessSnapshot(&l2.Events[i])
+			case "update":
+				err = c.ProcessUpdate(&l2.Events[i])
+			}
+			if err != nil {
+				return err
 			}
 		}
-	}
-}
-
-// ProcessSnapshot processes the initial orderbook snap shot
-func (c *CoinbasePro) ProcessSnapshot(snapshot *WebsocketOrderbookSnapshot) error {
-	var base orderbook.Base
-	for i := range snapshot.Bids {
-		price, err := strconv.ParseFloat(snapshot.Bids[i][0].(string), 64)
+	case "market_trades":
+		var trades WebsocketMarketTradesMessage
+		err = json.Unmarshal(respRaw, &trades)
 		if err != nil {
-			return err
+			return wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 		}
-
-		amount, err := strconv.ParseFloat(snapshot.Bids[i][1].(string), 64)
+		for i := range trades.Events {
+			for j := range trades.Events[i].Trades {
+				c.Websocket.DataHandler <- trades.Events[i].Trades[j]
+			}
+		}
+	case "user":
+		var user WebsocketUserMessage
+		err = json.Unmarshal(respRaw, &user)
 		if err != nil {
-			return err
+			return wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
+		}
+		for i := range user.Events {
+			for j := range user.Events[i].Orders {
+				o := &user.Events[i].Orders[j]
+				c.orderEvent(o.OrderID, "ws."+strings.ToLower(o.Status),
+					tracing.Float64("filled_size", o.CumulativeQuantity),
+					tracing.Float64("remaining_size", o.LeavesQuantity),
+					tracing.Float64("average_price", o.AveragePrice))
+				c.Websocket.DataHandler <- *o
+			}
 		}
+	}
+
+	return nil
+}
 
-		base.Bids = append(base.Bids,
-			orderbook.Item{Price: price, Amount: amount})
+// orderEvent records a websocket update for an order submitted by the bot in
+// the order's trace and logs it tagged with the order's correlation ID
+func (c *CoinbasePro) orderEvent(orderID, name string, attrs ...tracing.Attribute) {
+	tracing.OrderEvent(c.Name, orderID, name, attrs...)
+	update := name
+	for i := range attrs {
+		update += fmt.Sprintf(" %s=%v", attrs[i].Key, attrs[i].Value)
 	}
+	order.LogUpdate(c.Name, orderID, update)
+}
 
-	for i := range snapshot.Asks {
-		price, err := strconv.ParseFloat(snapshot.Asks[i][0].(string), 64)
-		if err != nil {
-			return err
+// l2Items splits level 2 changes into bids and asks
+func l2Items(changes []WebsocketL2Change) (bids, asks []orderbook.Item) {
+	for i := range changes {
+		item := orderbook.Item{
+			Price:  changes[i].PriceLevel,
+			Amount: changes[i].NewQuantity,
 		}
-
-		amount, err := strconv.ParseFloat(snapshot.Asks[i][1].(string), 64)
-		if err != nil {
-			return err
+		if changes[i].Side == "bid" {
+			bids = append(bids, item)
+		} else {
+			asks = append(asks, item)
 		}
-
-		base.Asks = append(base.Asks,
-			orderbook.Item{Price: price, Amount: amount})
 	}
+	return bids, asks
+}
+
+// ProcessSnapshot processes the initial orderbook snap shot
+func (c *CoinbasePro) ProcessSnapshot(snapshot *WebsocketL2Event) error {
+	var base orderbook.Base
+	base.Bids, base.Asks = l2Items(snapshot.Updates)
 
 	pair := currency.NewPairFromString(snapshot.ProductID)
 	base.AssetType = asset.Spot
@@ -233,34 +217,18 @@ func (c *CoinbasePro) ProcessSnapshot(snapshot *WebsocketOrderbookSnapshot) erro
 }
 
 // ProcessUpdate updates the orderbook local cache
-func (c *CoinbasePro) ProcessUpdate(update WebsocketL2Update) error {
-	var asks, bids []orderbook.Item
-
-	for i := range update.Changes {
-		price, _ := strconv.ParseFloat(update.Changes[i][1].(string), 64)
-		volume, _ := strconv.ParseFloat(update.Changes[i][2].(string), 64)
-
-		if update.Changes[i][0].(string) == order.Buy.Lower() {
-			bids = append(bids, orderbook.Item{Price: price, Amount: volume})
-		} else {
-			asks = append(asks, orderbook.Item{Price: price, Amount: volume})
-		}
-	}
-
+func (c *CoinbasePro) ProcessUpdate(update *WebsocketL2Event) error {
+	bids, asks := l2Items(update.Updates)
 	if len(asks) == 0 && len(bids) == 0 {
 		return errors.New("coinbasepro_websocket.go error - no data in websocket update")
 	}
 
 	p := currency.NewPairFromString(update.ProductID)
-	timestamp, err := time.Parse(time.RFC3339, update.Time)
-	if err != nil {
-		return err
-	}
-	err = c.Websocket.Orderbook.Update(&wsorderbook.WebsocketOrderbookUpdate{
+	err := c.Websocket.Orderbook.Update(&wsorderbook.WebsocketOrderbookUpdate{
 		Bids:       bids,
 		Asks:       asks,
 		Pair:       p,
-		UpdateTime: timestamp,
+		UpdateTime: update.Updates[0].EventTime,
 		Asset:      asset.Spot,
 	})
 	if err != nil {
@@ -278,11 +246,11 @@ func (c *CoinbasePro) ProcessUpdate(update WebsocketL2Update) error {
 
 // GenerateDefaultSubscriptions Adds default subscriptions to websocket to be handled by ManageSubscriptions()
 func (c *CoinbasePro) GenerateDefaultSubscriptions() {
-	var channels = []string{"heartbeat", "level2", "ticker", "user"}
+	var channels = []string{"heartbeats", "level2", "ticker", "user"}
 	enabledCurrencies := c.GetEnabledPairs(asset.Spot)
 	var subscriptions []wshandler.WebsocketChannelSubscription
 	for i := range channels {
-		if (channels[i] == "user" || channels[i] == "full") && !c.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
+		if channels[i] == "user" && !c.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
 			continue
 		}
 		for j := range enabledCurrencies {
@@ -296,46 +264,34 @@ func (c *CoinbasePro) GenerateDefaultSubscriptions() {
 	c.Websocket.SubscribeToChannels(subscriptions)
 }
 
-// Subscribe sends a websocket message to receive data from the channel
-func (c *CoinbasePro) Subscribe(channelToSubscribe wshandler.WebsocketChannelSubscription) error {
-	subscribe := WebsocketSubscribe{
-		Type: "subscribe",
-		Channels: []WsChannels{
-			{
-				Name: channelToSubscribe.Channel,
-				ProductIDs: []string{
-					c.FormatExchangeCurrency(channelToSubscribe.Currency,
-						asset.Spot).String(),
-				},
-			},
+// subscription builds a subscribe or unsubscribe message, signing it when
+// the channel requires authentication
+func (c *CoinbasePro) subscription(msgType string, channel *wshandler.WebsocketChannelSubscription) WebsocketSubscribe {
+	sub := WebsocketSubscribe{
+		Type:    msgType,
+		Channel: channel.Channel,
+		ProductIDs: []string{
+			c.FormatExchangeCurrency(channel.Currency, asset.Spot).String(),
 		},
 	}
-	if channelToSubscribe.Channel == "user" || channelToSubscribe.Channel == "full" {
-		n := strconv.FormatInt(time.Now().Unix(), 10)
-		message := n + "GET" + "/users/self/verify"
+	if channel.Channel == "user" {
+		n := strconv.FormatInt(c.Requester.Now().Unix(), 10)
+		message := n + sub.Channel + strings.Join(sub.ProductIDs, ",")
 		hmac := crypto.GetHMAC(crypto.HashSHA256, []byte(message),
 			[]byte(c.API.Credentials.Secret))
-		subscribe.Signature = crypto.Base64Encode(hmac)
-		subscribe.Key = c.API.Credentials.Key
-		subscribe.Passphrase = c.API.Credentials.ClientID
-		subscribe.Timestamp = n
+		sub.Signature = crypto.HexEncodeToString(hmac)
+		sub.Key = c.API.Credentials.Key
+		sub.Timestamp = n
 	}
-	return c.WebsocketConn.SendJSONMessage(subscribe)
+	return sub
+}
+
+// Subscribe sends a websocket message to receive data from the channel
+func (c *CoinbasePro) Subscribe(channelToSubscribe wshandler.WebsocketChannelSubscription) error {
+	return c.WebsocketConn.SendJSONMessage(c.subscription("subscribe", &channelToSubscribe))
 }
 
 // Unsubscribe sends a websocket message to stop receiving data from the channel
 func (c *CoinbasePro) Unsubscribe(channelToSubscribe wshandler.WebsocketChannelSubscription) error {
-	subscribe := WebsocketSubscribe{
-		Type: "unsubscribe",
-		Channels: []WsChannels{
-			{
-				Name: channelToSubscribe.Channel,
-				ProductIDs: []string{
-					c.FormatExchangeCurrency(channelToSubscribe.Currency,
-						asset.Spot).String(),
-				},
-			},
-		},
-	}
-	return c.WebsocketConn.SendJSONMessage(subscribe)
+	return c.WebsocketConn.SendJSONMessage(c.subscription("unsubscribe", &channelToSubscribe))
 }
@@ -1,6 +1,7 @@
 package coinbasepro
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +39,7 @@ func (c *CoinbasePro) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if c.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = c.UpdateTradablePairs(true)
+		err = c.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -53,8 +55,6 @@ func (c *CoinbasePro) SetDefaults() {
 	c.Verbose = true
 	c.API.CredentialsValidator.RequiresKey = true
 	c.API.CredentialsValidator.RequiresSecret = true
-	c.API.CredentialsValidator.RequiresClientID = true
-	c.API.CredentialsValidator.RequiresBase64DecodeSecret = true
 
 	c.CurrencyPairs = currency.PairsManager{
 		AssetTypes: asset.Items{
@@ -119,8 +119,10 @@ func (c *CoinbasePro) SetDefaults() {
 		common.NewHTTPClientWithTimeout(exchange.DefaultHTTPTimeout),
 		SetRateLimit())
 
-	c.API.Endpoints.URLDefault = coinbaseproAPIURL
+	c.API.Endpoints.URLDefault = coinbaseAPIURL
 	c.API.Endpoints.URL = c.API.Endpoints.URLDefault
+	c.API.Endpoints.URLSecondaryDefault = coinbaseAPIV2URL
+	c.API.Endpoints.URLSecondary = c.API.Endpoints.URLSecondaryDefault
 	c.API.Endpoints.WebsocketURL = coinbaseproWebsocketURL
 	c.Websocket = wshandler.New()
 	c.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
@@ -161,10 +163,13 @@ func (c *CoinbasePro) Setup(exch *config.ExchangeConfig) error {
 	c.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         c.Name,
 		URL:                  c.Websocket.GetWebsocketURL(),
+		AlternateURLs:        c.Websocket.GetAlternateURLs(),
 		ProxyURL:             c.Websocket.GetProxyAddress(),
 		Verbose:              c.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	c.Websocket.Orderbook.Setup(
@@ -197,6 +202,14 @@ func (c *CoinbasePro) Run() {
 		c.PrintEnabledPairs()
 	}
 
+	c.SyncServerTime(context.Background(), func(ctx context.Context) (time.Time, error) {
+		t, err := c.GetServerTime(ctx)
+		if err != nil {
+			return time.Time{}, err
+		}
+		return time.Unix(t.EpochSeconds, 0), nil
+	})
+
 	forceUpdate := false
 	delim := c.GetPairFormat(asset.Spot, false).Delimiter
 	if !common.StringDataContains(c.CurrencyPairs.GetPairs(asset.Spot,
@@ -220,21 +233,25 @@ func (c *CoinbasePro) Run() {
 		return
 	}
 
-	err := c.UpdateTradablePairs(forceUpdate)
+	err := c.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", c.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (c *CoinbasePro) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	pairs, err := c.GetProducts()
+func (c *CoinbasePro) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	pairs, err := c.GetProducts(ctx)
 	if err != nil {
 		return nil, err
 	}
 
 	var products []string
 	for x := range pairs {
+		if pairs[x].TradingDisabled ||
+			(pairs[x].ProductType != "" && pairs[x].ProductType != "SPOT") {
+			continue
+		}
 		products = append(products, pairs[x].BaseCurrency+
 			c.GetPairFormat(asset, false).Delimiter+
 			pairs[x].QuoteCurrency)
@@ -245,8 +262,8 @@ func (c *CoinbasePro) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (c *CoinbasePro) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := c.FetchTradablePairs(asset.Spot)
+func (c *CoinbasePro) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := c.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -256,10 +273,10 @@ func (c *CoinbasePro) UpdateTradablePairs(forceUpdate bool) error {
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // coinbasepro exchange
-func (c *CoinbasePro) UpdateAccountInfo() (account.Holdings, error) {
+func (c *CoinbasePro) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = c.Name
-	accountBalance, err := c.GetAccounts()
+	accountBalance, err := c.GetAccounts(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -268,8 +285,9 @@ func (c *CoinbasePro) UpdateAccountInfo() (account.Holdings, error) {
 	for i := range accountBalance {
 		var exchangeCurrency account.Balance
 		exchangeCurrency.CurrencyName = currency.NewCode(accountBalance[i].Currency)
-		exchangeCurrency.TotalValue = accountBalance[i].Available
-		exchangeCurrency.Hold = accountBalance[i].Hold
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance[i].AvailableBalance.Value +
+			accountBalance[i].Hold.Value)
+		exchangeCurrency.Hold = decimal.NewFromFloat(accountBalance[i].Hold.Value)
 
 		currencies = append(currencies, exchangeCurrency)
 	}
@@ -287,36 +305,36 @@ func (c *CoinbasePro) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (c *CoinbasePro) FetchAccountInfo() (account.Holdings, error) {
+func (c *CoinbasePro) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(c.Name)
 	if err != nil {
-		return c.UpdateAccountInfo()
+		return c.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *CoinbasePro) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
-	tick, err := c.GetTicker(c.FormatExchangeCurrency(p, assetType).String())
+func (c *CoinbasePro) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	productID := c.FormatExchangeCurrency(p, assetType).String()
+	tick, err := c.GetTicker(ctx, productID, 1)
 	if err != nil {
 		return nil, err
 	}
-	stats, err := c.GetStats(c.FormatExchangeCurrency(p, assetType).String())
+	product, err := c.GetProduct(ctx, productID)
 	if err != nil {
 		return nil, err
 	}
 
 	tickerPrice := &ticker.Price{
-		Last:        tick.Size,
-		High:        stats.High,
-		Low:         stats.Low,
-		Bid:         tick.Bid,
-		Ask:         tick.Ask,
-		Volume:      tick.Volume,
-		Open:        stats.Open,
-		Pair:        p,
-		LastUpdated: tick.Time,
+		Last:   product.Price.Float64(),
+		Bid:    tick.BestBid,
+		Ask:    tick.BestAsk,
+		Volume: product.Volume24H.Float64(),
+		Pair:   p,
+	}
+	if len(tick.Trades) > 0 {
+		tickerPrice.LastUpdated = tick.Trades[0].Time
 	}
 
 	err = ticker.ProcessTicker(c.Name, tickerPrice, assetType)
@@ -328,40 +346,38 @@ func (c *CoinbasePro) UpdateTicker(p currency.Pair, assetType asset.Item) (*tick
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (c *CoinbasePro) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (c *CoinbasePro) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(c.Name, p, assetType)
 	if err != nil {
-		return c.UpdateTicker(p, assetType)
+		return c.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (c *CoinbasePro) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (c *CoinbasePro) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(c.Name, p, assetType)
 	if err != nil {
-		return c.UpdateOrderbook(p, assetType)
+		return c.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *CoinbasePro) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (c *CoinbasePro) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := c.GetOrderbook(c.FormatExchangeCurrency(p,
-		assetType).String(), 2)
+	obNew, err := c.GetOrderbook(ctx, c.FormatExchangeCurrency(p,
+		assetType).String(), 0)
 	if err != nil {
 		return orderBook, err
 	}
 
-	obNew := orderbookNew.(OrderbookL1L2)
-
 	for x := range obNew.Bids {
-		orderBook.Bids = append(orderBook.Bids, orderbook.Item{Amount: obNew.Bids[x].Amount, Price: obNew.Bids[x].Price})
+		orderBook.Bids = append(orderBook.Bids, orderbook.Item{Amount: obNew.Bids[x].Size, Price: obNew.Bids[x].Price})
 	}
 
 	for x := range obNew.Asks {
-		orderBook.Asks = append(orderBook.Asks, orderbook.Item{Amount: obNew.Asks[x].Amount, Price: obNew.Asks[x].Price})
+		orderBook.Asks = append(orderBook.Asks, orderbook.Item{Amount: obNew.Asks[x].Size, Price: obNew.Asks[x].Price})
 	}
 
 	orderBook.Pair = p
@@ -378,17 +394,40 @@ func (c *CoinbasePro) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*o
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (c *CoinbasePro) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (c *CoinbasePro) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (c *CoinbasePro) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (c *CoinbasePro) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (c *CoinbasePro) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (c *CoinbasePro) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (c *CoinbasePro) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (c *CoinbasePro) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (c *CoinbasePro) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (c *CoinbasePro) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -398,21 +437,19 @@ func (c *CoinbasePro) SubmitOrder(s *order.Submit) (order.SubmitResponse, error)
 	var err error
 	switch s.OrderType {
 	case order.Market:
-		response, err = c.PlaceMarketOrder("",
-			s.Amount,
-			s.Amount,
+		response, err = c.PlaceMarketOrder(ctx,
+			s.ClientID,
+			s.Amount.Float64(),
+			0,
 			s.OrderSide.String(),
-			c.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
-			"")
+			c.FormatExchangeCurrency(s.Pair, asset.Spot).String())
 	case order.Limit:
-		response, err = c.PlaceLimitOrder("",
-			s.Price,
-			s.Amount,
+		response, err = c.PlaceLimitOrder(ctx,
+			s.ClientID,
+			s.Price.Float64(),
+			s.Amount.Float64(),
 			s.OrderSide.String(),
-			"",
-			"",
 			c.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
-			"",
 			false)
 	default:
 		err = errors.New("order type not supported")
@@ -434,44 +471,81 @@ func (c *CoinbasePro) SubmitOrder(s *order.Submit) (order.SubmitResponse, error)
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (c *CoinbasePro) ModifyOrder(action *order.Modify) (string, error) {
+func (c *CoinbasePro) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (c *CoinbasePro) CancelOrder(order *order.Cancel) error {
-	return c.CancelExistingOrder(order.OrderID)
+func (c *CoinbasePro) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	return c.CancelExistingOrder(ctx, order.OrderID)
 }
 
-// CancelAllOrders cancels all orders associated with a currency pair
-func (c *CoinbasePro) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
-	// CancellAllExisting orders returns a list of successful cancellations, we're only interested in failures
-	_, err := c.CancelAllExistingOrders("")
-	return order.CancelAllResponse{}, err
+// CancelAllOrders cancels all open orders. Advanced Trade has no cancel all
+// endpoint, so open orders are listed and cancelled in a batch
+func (c *CoinbasePro) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
+	resp := order.CancelAllResponse{Status: make(map[string]string)}
+	open, err := c.GetOrders(ctx, []string{"OPEN"}, "")
+	if err != nil || len(open) == 0 {
+		return resp, err
+	}
+
+	orderIDs := make([]string, len(open))
+	for i := range open {
+		orderIDs[i] = open[i].OrderID
+	}
+	results, err := c.CancelOrders(ctx, orderIDs)
+	if err != nil {
+		return resp, err
+	}
+	// only failures are reported
+	for i := range results {
+		if !results[i].Success {
+			resp.Status[results[i].OrderID] = results[i].FailureReason
+		}
+	}
+	return resp, nil
 }
 
 // GetOrderInfo returns information on a current open order
-func (c *CoinbasePro) GetOrderInfo(orderID string) (order.Detail, error) {
-	var orderDetail order.Detail
-	return orderDetail, common.ErrNotYetImplemented
+func (c *CoinbasePro) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
+	resp, err := c.GetOrder(ctx, orderID)
+	if err != nil {
+		return order.Detail{}, err
+	}
+	return c.orderDetail(&resp), nil
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (c *CoinbasePro) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
-	return "", common.ErrFunctionNotSupported
+func (c *CoinbasePro) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
+	if accountID == "" {
+		var err error
+		accountID, err = c.accountForCurrency(ctx, cryptocurrency)
+		if err != nil {
+			return "", err
+		}
+	}
+	resp, err := c.CreateDepositAddress(ctx, accountID)
+	if err != nil {
+		return "", err
+	}
+	return resp.Address, nil
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *CoinbasePro) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	resp, err := c.WithdrawCrypto(withdrawRequest.Amount, withdrawRequest.Currency.String(), withdrawRequest.Address)
+func (c *CoinbasePro) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	accountID, err := c.accountForCurrency(ctx, withdrawRequest.Currency)
+	if err != nil {
+		return "", err
+	}
+	resp, err := c.WithdrawCrypto(ctx, accountID, withdrawRequest.Amount, withdrawRequest.Currency.String(), withdrawRequest.Address)
 	return resp.ID, err
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *CoinbasePro) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
-	paymentMethods, err := c.GetPayMethods()
+func (c *CoinbasePro) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
+	paymentMethods, err := c.GetPayMethods(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -487,7 +561,12 @@ func (c *CoinbasePro) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (
 		return "", fmt.Errorf("could not find payment method '%v'. Check the name via the website and try again", withdrawRequest.BankName)
 	}
 
-	resp, err := c.WithdrawViaPaymentMethod(withdrawRequest.Amount, withdrawRequest.Currency.String(), selectedWithdrawalMethod.ID)
+	accountID, err := c.accountForCurrency(ctx, withdrawRequest.Currency)
+	if err != nil {
+		return "", err
+	}
+
+	resp, err := c.WithdrawViaPaymentMethod(ctx, accountID, withdrawRequest.Amount, withdrawRequest.Currency.String(), selectedWithdrawalMethod.ID)
 	if err != nil {
 		return "", err
 	}
@@ -497,8 +576,8 @@ func (c *CoinbasePro) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (c *CoinbasePro) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
-	return c.WithdrawFiatFunds(withdrawRequest)
+func (c *CoinbasePro) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
+	return c.WithdrawFiatFunds(ctx, withdrawRequest)
 }
 
 // GetWebsocket returns a pointer to the exchange websocket
@@ -507,66 +586,30 @@ func (c *CoinbasePro) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (c *CoinbasePro) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (c *CoinbasePro) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !c.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return c.GetFee(feeBuilder)
+	return c.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (c *CoinbasePro) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	var respOrders []GeneralizedOrderResponse
-	for i := range req.Currencies {
-		resp, err := c.GetOrders([]string{"open", "pending", "active"},
-			c.FormatExchangeCurrency(req.Currencies[i], asset.Spot).String())
-		if err != nil {
-			return nil, err
-		}
-		respOrders = append(respOrders, resp...)
-	}
-
-	var orders []order.Detail
-	for i := range respOrders {
-		curr := currency.NewPairDelimiter(respOrders[i].ProductID,
-			c.GetPairFormat(asset.Spot, false).Delimiter)
-		orderSide := order.Side(strings.ToUpper(respOrders[i].Side))
-		orderType := order.Type(strings.ToUpper(respOrders[i].Type))
-		orderDate, err := time.Parse(time.RFC3339, respOrders[i].CreatedAt)
-		if err != nil {
-			log.Errorf(log.ExchangeSys,
-				"Exchange %v Func %v Order %v Could not parse date to unix with value of %v",
-				c.Name,
-				"GetActiveOrders",
-				respOrders[i].ID,
-				respOrders[i].CreatedAt)
-		}
-
-		orders = append(orders, order.Detail{
-			ID:             respOrders[i].ID,
-			Amount:         respOrders[i].Size,
-			ExecutedAmount: respOrders[i].FilledSize,
-			OrderType:      orderType,
-			OrderDate:      orderDate,
-			OrderSide:      orderSide,
-			CurrencyPair:   curr,
-			Exchange:       c.Name,
-		})
-	}
-
-	order.FilterOrdersByType(&orders, req.OrderType)
-	order.FilterOrdersByTickRange(&orders, req.StartTicks, req.EndTicks)
-	order.FilterOrdersBySide(&orders, req.OrderSide)
-	return orders, nil
+func (c *CoinbasePro) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	return c.getOrders(ctx, req, []string{"OPEN", "PENDING"})
 }
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (c *CoinbasePro) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (c *CoinbasePro) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	return c.getOrders(ctx, req, []string{"FILLED", "CANCELLED", "EXPIRED", "FAILED"})
+}
+
+// getOrders returns the orders in any of statuses for the requested pairs
+func (c *CoinbasePro) getOrders(ctx context.Context, req *order.GetOrdersRequest, statuses []string) ([]order.Detail, error) {
 	var respOrders []GeneralizedOrderResponse
 	for i := range req.Currencies {
-		resp, err := c.GetOrders([]string{"done", "settled"},
+		resp, err := c.GetOrders(ctx, statuses,
 			c.FormatExchangeCurrency(req.Currencies[i], asset.Spot).String())
 		if err != nil {
 			return nil, err
@@ -574,32 +617,9 @@ func (c *CoinbasePro) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Deta
 		respOrders = append(respOrders, resp...)
 	}
 
-	var orders []order.Detail
+	orders := make([]order.Detail, len(respOrders))
 	for i := range respOrders {
-		curr := currency.NewPairDelimiter(respOrders[i].ProductID,
-			c.GetPairFormat(asset.Spot, false).Delimiter)
-		orderSide := order.Side(strings.ToUpper(respOrders[i].Side))
-		orderType := order.Type(strings.ToUpper(respOrders[i].Type))
-		orderDate, err := time.Parse(time.RFC3339, respOrders[i].CreatedAt)
-		if err != nil {
-			log.Errorf(log.ExchangeSys,
-				"Exchange %v Func %v Order %v Could not parse date to unix with value of %v",
-				c.Name,
-				"GetActiveOrders",
-				respOrders[i].ID,
-				respOrders[i].CreatedAt)
-		}
-
-		orders = append(orders, order.Detail{
-			ID:             respOrders[i].ID,
-			Amount:         respOrders[i].Size,
-			ExecutedAmount: respOrders[i].FilledSize,
-			OrderType:      orderType,
-			OrderDate:      orderDate,
-			OrderSide:      orderSide,
-			CurrencyPair:   curr,
-			Exchange:       c.Name,
-		})
+		orders[i] = c.orderDetail(&respOrders[i])
 	}
 
 	order.FilterOrdersByType(&orders, req.OrderType)
@@ -608,18 +628,68 @@ func (c *CoinbasePro) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Deta
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// orderDetail converts an Advanced Trade order
+func (c *CoinbasePro) orderDetail(o *GeneralizedOrderResponse) order.Detail {
+	detail := order.Detail{
+		Exchange: c.Name,
+		ID:       o.OrderID,
+		CurrencyPair: currency.NewPairDelimiter(o.ProductID,
+			c.GetPairFormat(asset.Spot, false).Delimiter),
+		OrderSide:      order.Side(strings.ToUpper(o.Side)),
+		OrderType:      order.Type(strings.ToUpper(o.OrderType)),
+		OrderDate:      o.CreatedTime,
+		Status:         orderStatus(o.Status),
+		ExecutedAmount: decimal.NewFromFloat(o.FilledSize),
+		Fee:            decimal.NewFromFloat(o.TotalFees),
+	}
+	switch {
+	case o.OrderConfiguration.LimitGTC != nil:
+		detail.Price, _ = decimal.NewFromString(o.OrderConfiguration.LimitGTC.LimitPrice)
+		detail.Amount, _ = decimal.NewFromString(o.OrderConfiguration.LimitGTC.BaseSize)
+	case o.OrderConfiguration.MarketIOC != nil &&
+		o.OrderConfiguration.MarketIOC.BaseSize != "":
+		detail.Amount, _ = decimal.NewFromString(o.OrderConfiguration.MarketIOC.BaseSize)
+	}
+	if detail.Price.IsZero() {
+		detail.Price = decimal.NewFromFloat(o.AverageFilledPrice)
+	}
+	if !detail.Amount.IsZero() {
+		detail.RemainingAmount = detail.Amount.Sub(detail.ExecutedAmount)
+	}
+	return detail
+}
+
+// orderStatus converts an Advanced Trade order status
+func orderStatus(status string) order.Status {
+	switch status {
+	case "PENDING", "QUEUED":
+		return order.New
+	case "OPEN":
+		return order.Active
+	case "FILLED":
+		return order.Filled
+	case "CANCELLED":
+		return order.Cancelled
+	case "CANCEL_QUEUED":
+		return order.PendingCancel
+	case "EXPIRED":
+		return order.Expired
+	case "FAILED":
+		return order.Rejected
+	}
+	return order.UnknownStatus
+}
+
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (c *CoinbasePro) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	c.Websocket.SubscribeToChannels(channels)
-	return nil
+	return c.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (c *CoinbasePro) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	c.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return c.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -633,11 +703,14 @@ func (c *CoinbasePro) AuthenticateWebsocket() error {
 }
 
 // GetHistoricCandles Allows to retrieve an amount of candles back in time starting from now up to rangesize * granularity, where granularity is the trade period covered by each candle
-func (c *CoinbasePro) GetHistoricCandles(p currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (c *CoinbasePro) GetHistoricCandles(ctx context.Context, p currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+	if rangesize > coinbaseMaxCandles {
+		rangesize = coinbaseMaxCandles
+	}
 	end := time.Now().UTC()
-	b := granularity * rangesize
-	start := time.Now().UTC().Add(-time.Second * time.Duration(b))
-	history, err := c.GetHistoricRates(p.String(), start.Format(time.RFC3339), end.Format(time.RFC3339), granularity)
+	start := end.Add(-time.Second * time.Duration(granularity*rangesize))
+	history, err := c.GetHistoricRates(ctx,
+		c.FormatExchangeCurrency(p, asset.Spot).String(), start, end, granularity)
 	if err != nil {
 		return nil, err
 	}
@@ -657,7 +730,12 @@ func (c *CoinbasePro) GetHistoricCandles(p currency.Pair, rangesize, granularity
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (c *CoinbasePro) ValidateCredentials() error {
-	_, err := c.UpdateAccountInfo()
+func (c *CoinbasePro) ValidateCredentials(ctx context.Context) error {
+	_, err := c.UpdateAccountInfo(ctx)
 	return c.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (c *CoinbasePro) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -10,8 +10,8 @@ import (
 // Coinbasepro rate limit conts
 const (
 	coinbaseproRateInterval = time.Second
-	coinbaseproAuthRate     = 5
-	coinbaseproUnauthRate   = 2
+	coinbaseproAuthRate     = 30
+	coinbaseproUnauthRate   = 10
 )
 
 // RateLimit implements the request.Limiter interface
@@ -2,6 +2,7 @@ package coinbene
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -74,27 +75,27 @@ const (
 )
 
 // GetAllPairs gets all pairs on the exchange
-func (c *Coinbene) GetAllPairs() ([]PairData, error) {
+func (c *Coinbene) GetAllPairs(ctx context.Context) ([]PairData, error) {
 	resp := struct {
 		Data []PairData `json:"data"`
 	}{}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneGetAllPairs
-	return resp.Data, c.SendHTTPRequest(path, spotPairs, &resp)
+	return resp.Data, c.SendHTTPRequest(ctx, path, spotPairs, &resp)
 }
 
 // GetPairInfo gets info about a single pair
-func (c *Coinbene) GetPairInfo(symbol string) (PairData, error) {
+func (c *Coinbene) GetPairInfo(ctx context.Context, symbol string) (PairData, error) {
 	resp := struct {
 		Data PairData `json:"data"`
 	}{}
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbeneAPIVersion+coinbenePairInfo, params)
-	return resp.Data, c.SendHTTPRequest(path, spotPairInfo, &resp)
+	return resp.Data, c.SendHTTPRequest(ctx, path, spotPairInfo, &resp)
 }
 
 // GetOrderbook gets and stores orderbook data for given pair
-func (c *Coinbene) GetOrderbook(symbol string, size int64) (Orderbook, error) {
+func (c *Coinbene) GetOrderbook(ctx context.Context, symbol string, size int64) (Orderbook, error) {
 	resp := struct {
 		Data struct {
 			Asks [][]string `json:"asks"`
@@ -107,7 +108,7 @@ func (c *Coinbene) GetOrderbook(symbol string, size int64) (Orderbook, error) {
 	params.Set("symbol", symbol)
 	params.Set("depth", strconv.FormatInt(size, 10))
 	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbeneAPIVersion+coinbeneGetOrderBook, params)
-	err := c.SendHTTPRequest(path, spotOrderbook, &resp)
+	err := c.SendHTTPRequest(ctx, path, spotOrderbook, &resp)
 	if err != nil {
 		return Orderbook{}, err
 	}
@@ -146,28 +147,28 @@ func (c *Coinbene) GetOrderbook(symbol string, size int64) (Orderbook, error) {
 }
 
 // GetTicker gets and stores ticker data for a currency pair
-func (c *Coinbene) GetTicker(symbol string) (TickerData, error) {
+func (c *Coinbene) GetTicker(ctx context.Context, symbol string) (TickerData, error) {
 	resp := struct {
 		TickerData TickerData `json:"data"`
 	}{}
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbeneAPIVersion+coinbeneGetTicker, params)
-	return resp.TickerData, c.SendHTTPRequest(path, spotSpecificTicker, &resp)
+	return resp.TickerData, c.SendHTTPRequest(ctx, path, spotSpecificTicker, &resp)
 }
 
 // GetTickers gets and all spot tickers supported by the exchange
-func (c *Coinbene) GetTickers() ([]TickerData, error) {
+func (c *Coinbene) GetTickers(ctx context.Context) ([]TickerData, error) {
 	resp := struct {
 		TickerData []TickerData `json:"data"`
 	}{}
 
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneGetTicker
-	return resp.TickerData, c.SendHTTPRequest(path, spotTickerList, &resp)
+	return resp.TickerData, c.SendHTTPRequest(ctx, path, spotTickerList, &resp)
 }
 
 // GetTrades gets recent trades from the exchange
-func (c *Coinbene) GetTrades(symbol string) (Trades, error) {
+func (c *Coinbene) GetTrades(ctx context.Context, symbol string) (Trades, error) {
 	resp := struct {
 		Data [][]string `json:"data"`
 	}{}
@@ -175,7 +176,7 @@ func (c *Coinbene) GetTrades(symbol string) (Trades, error) {
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := common.EncodeURLValues(c.API.Endpoints.URL+coinbeneAPIVersion+coinbeneGetTrades, params)
-	err := c.SendHTTPRequest(path, spotMarketTrades, &resp)
+	err := c.SendHTTPRequest(ctx, path, spotMarketTrades, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -206,12 +207,12 @@ func (c *Coinbene) GetTrades(symbol string) (Trades, error) {
 }
 
 // GetAccountBalances gets user balanace info
-func (c *Coinbene) GetAccountBalances() ([]UserBalanceData, error) {
+func (c *Coinbene) GetAccountBalances(ctx context.Context) ([]UserBalanceData, error) {
 	resp := struct {
 		Data []UserBalanceData `json:"data"`
 	}{}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneGetUserBalance
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneGetUserBalance,
 		false,
@@ -225,14 +226,14 @@ func (c *Coinbene) GetAccountBalances() ([]UserBalanceData, error) {
 }
 
 // GetAccountAssetBalance gets user balanace info
-func (c *Coinbene) GetAccountAssetBalance(symbol string) (UserBalanceData, error) {
+func (c *Coinbene) GetAccountAssetBalance(ctx context.Context, symbol string) (UserBalanceData, error) {
 	v := url.Values{}
 	v.Set("asset", symbol)
 	resp := struct {
 		Data UserBalanceData `json:"data"`
 	}{}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneAccountBalanceOne
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneAccountBalanceOne,
 		false,
@@ -246,7 +247,7 @@ func (c *Coinbene) GetAccountAssetBalance(symbol string) (UserBalanceData, error
 }
 
 // PlaceSpotOrder creates an order
-func (c *Coinbene) PlaceSpotOrder(price, quantity float64, symbol, direction,
+func (c *Coinbene) PlaceSpotOrder(ctx context.Context, price, quantity float64, symbol, direction,
 	orderType, clientID string, notional int) (OrderPlacementResponse, error) {
 	var resp OrderPlacementResponse
 	params := url.Values{}
@@ -281,7 +282,7 @@ func (c *Coinbene) PlaceSpotOrder(price, quantity float64, symbol, direction,
 		params.Set("notional", strconv.Itoa(notional))
 	}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbenePlaceOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbenePlaceOrder,
 		false,
@@ -295,7 +296,7 @@ func (c *Coinbene) PlaceSpotOrder(price, quantity float64, symbol, direction,
 }
 
 // PlaceSpotOrders sets a batchful order request
-func (c *Coinbene) PlaceSpotOrders(orders []PlaceOrderRequest) ([]OrderPlacementResponse, error) {
+func (c *Coinbene) PlaceSpotOrders(ctx context.Context, orders []PlaceOrderRequest) ([]OrderPlacementResponse, error) {
 	if len(orders) == 0 {
 		return nil, errors.New("orders is nil")
 	}
@@ -351,7 +352,7 @@ func (c *Coinbene) PlaceSpotOrders(orders []PlaceOrderRequest) ([]OrderPlacement
 		Data []OrderPlacementResponse `json:"data"`
 	}{}
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneBatchPlaceOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneBatchPlaceOrder,
 		false,
@@ -365,7 +366,7 @@ func (c *Coinbene) PlaceSpotOrders(orders []PlaceOrderRequest) ([]OrderPlacement
 }
 
 // FetchOpenSpotOrders finds open orders
-func (c *Coinbene) FetchOpenSpotOrders(symbol string) (OrdersInfo, error) {
+func (c *Coinbene) FetchOpenSpotOrders(ctx context.Context, symbol string) (OrdersInfo, error) {
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneOpenOrders
@@ -375,7 +376,7 @@ func (c *Coinbene) FetchOpenSpotOrders(symbol string) (OrdersInfo, error) {
 			Data OrdersInfo `json:"data"`
 		}{}
 		params.Set("pageNum", strconv.FormatInt(i, 10))
-		err := c.SendAuthHTTPRequest(http.MethodGet,
+		err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 			path,
 			coinbeneOpenOrders,
 			false,
@@ -397,7 +398,7 @@ func (c *Coinbene) FetchOpenSpotOrders(symbol string) (OrdersInfo, error) {
 }
 
 // FetchClosedOrders finds open orders
-func (c *Coinbene) FetchClosedOrders(symbol, latestID string) (OrdersInfo, error) {
+func (c *Coinbene) FetchClosedOrders(ctx context.Context, symbol, latestID string) (OrdersInfo, error) {
 	params := url.Values{}
 	params.Set("symbol", symbol)
 	params.Set("latestOrderId", latestID)
@@ -408,7 +409,7 @@ func (c *Coinbene) FetchClosedOrders(symbol, latestID string) (OrdersInfo, error
 			Data OrdersInfo `json:"data"`
 		}{}
 		params.Set("pageNum", strconv.FormatInt(i, 10))
-		err := c.SendAuthHTTPRequest(http.MethodGet,
+		err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 			path,
 			coinbeneClosedOrders,
 			false,
@@ -429,14 +430,14 @@ func (c *Coinbene) FetchClosedOrders(symbol, latestID string) (OrdersInfo, error
 }
 
 // FetchSpotOrderInfo gets order info
-func (c *Coinbene) FetchSpotOrderInfo(orderID string) (OrderInfo, error) {
+func (c *Coinbene) FetchSpotOrderInfo(ctx context.Context, orderID string) (OrderInfo, error) {
 	resp := struct {
 		Data OrderInfo `json:"data"`
 	}{}
 	params := url.Values{}
 	params.Set("orderId", orderID)
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneOrderInfo
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOrderInfo,
 		false,
@@ -454,14 +455,14 @@ func (c *Coinbene) FetchSpotOrderInfo(orderID string) (OrderInfo, error) {
 }
 
 // GetSpotOrderFills returns a list of fills related to an order ID
-func (c *Coinbene) GetSpotOrderFills(orderID string) ([]OrderFills, error) {
+func (c *Coinbene) GetSpotOrderFills(ctx context.Context, orderID string) ([]OrderFills, error) {
 	resp := struct {
 		Data []OrderFills `json:"data"`
 	}{}
 	params := url.Values{}
 	params.Set("orderId", orderID)
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneTradeFills
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneTradeFills,
 		false,
@@ -475,14 +476,14 @@ func (c *Coinbene) GetSpotOrderFills(orderID string) ([]OrderFills, error) {
 }
 
 // CancelSpotOrder removes a given order
-func (c *Coinbene) CancelSpotOrder(orderID string) (string, error) {
+func (c *Coinbene) CancelSpotOrder(ctx context.Context, orderID string) (string, error) {
 	resp := struct {
 		Data string `json:"data"`
 	}{}
 	req := make(map[string]interface{})
 	req["orderId"] = orderID
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneCancelOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneCancelOrder,
 		false,
@@ -496,7 +497,7 @@ func (c *Coinbene) CancelSpotOrder(orderID string) (string, error) {
 }
 
 // CancelSpotOrders cancels a batch of orders
-func (c *Coinbene) CancelSpotOrders(orderIDs []string) ([]OrderCancellationResponse, error) {
+func (c *Coinbene) CancelSpotOrders(ctx context.Context, orderIDs []string) ([]OrderCancellationResponse, error) {
 	req := make(map[string]interface{})
 	req["orderIds"] = orderIDs
 	type resp struct {
@@ -505,7 +506,7 @@ func (c *Coinbene) CancelSpotOrders(orderIDs []string) ([]OrderCancellationRespo
 
 	var r resp
 	path := c.API.Endpoints.URL + coinbeneAPIVersion + coinbeneBatchCancel
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneBatchCancel,
 		false,
@@ -519,13 +520,13 @@ func (c *Coinbene) CancelSpotOrders(orderIDs []string) ([]OrderCancellationRespo
 }
 
 // GetSwapTickers returns a map of swap tickers
-func (c *Coinbene) GetSwapTickers() (SwapTickers, error) {
+func (c *Coinbene) GetSwapTickers(ctx context.Context) (SwapTickers, error) {
 	type resp struct {
 		Data SwapTickers `json:"data"`
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneGetTickers
-	err := c.SendHTTPRequest(path, contractTickers, &r)
+	err := c.SendHTTPRequest(ctx, path, contractTickers, &r)
 	if err != nil {
 		return nil, err
 	}
@@ -533,8 +534,8 @@ func (c *Coinbene) GetSwapTickers() (SwapTickers, error) {
 }
 
 // GetSwapTicker returns a specific swap ticker
-func (c *Coinbene) GetSwapTicker(symbol string) (SwapTicker, error) {
-	tickers, err := c.GetSwapTickers()
+func (c *Coinbene) GetSwapTicker(ctx context.Context, symbol string) (SwapTicker, error) {
+	tickers, err := c.GetSwapTickers(ctx)
 	if err != nil {
 		return SwapTicker{}, err
 	}
@@ -547,7 +548,7 @@ func (c *Coinbene) GetSwapTicker(symbol string) (SwapTicker, error) {
 }
 
 // GetSwapOrderbook returns an orderbook for the specified currency
-func (c *Coinbene) GetSwapOrderbook(symbol string, size int64) (Orderbook, error) {
+func (c *Coinbene) GetSwapOrderbook(ctx context.Context, symbol string, size int64) (Orderbook, error) {
 	var s Orderbook
 	if symbol == "" {
 		return s, fmt.Errorf("a symbol must be specified")
@@ -570,7 +571,7 @@ func (c *Coinbene) GetSwapOrderbook(symbol string, size int64) (Orderbook, error
 
 	var r resp
 	path := common.EncodeURLValues(coinbeneSwapAPIURL+coinbeneAPIVersion+coinbeneGetOrderBook, v)
-	err := c.SendHTTPRequest(path, contractOrderbook, &r)
+	err := c.SendHTTPRequest(ctx, path, contractOrderbook, &r)
 	if err != nil {
 		return s, err
 	}
@@ -614,7 +615,7 @@ func (c *Coinbene) GetSwapOrderbook(symbol string, size int64) (Orderbook, error
 }
 
 // GetSwapKlines data returns the kline data for a specific symbol
-func (c *Coinbene) GetSwapKlines(symbol, startTime, endTime, resolution string) (SwapKlines, error) {
+func (c *Coinbene) GetSwapKlines(ctx context.Context, symbol, startTime, endTime, resolution string) (SwapKlines, error) {
 	var s SwapKlines
 	v := url.Values{}
 	v.Set("symbol", symbol)
@@ -627,7 +628,7 @@ func (c *Coinbene) GetSwapKlines(symbol, startTime, endTime, resolution string)
 	}
 	var r resp
 	path := common.EncodeURLValues(coinbeneSwapAPIURL+coinbeneAPIVersion+coinbeneGetKlines, v)
-	if err := c.SendHTTPRequest(path, contractKline, &r); err != nil {
+	if err := c.SendHTTPRequest(ctx, path, contractKline, &r); err != nil {
 		return nil, err
 	}
 
@@ -684,7 +685,7 @@ func (c *Coinbene) GetSwapKlines(symbol, startTime, endTime, resolution string)
 }
 
 // GetSwapTrades returns a list of trades for a swap symbol
-func (c *Coinbene) GetSwapTrades(symbol string, limit int) (SwapTrades, error) {
+func (c *Coinbene) GetSwapTrades(ctx context.Context, symbol string, limit int) (SwapTrades, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	if limit != 0 {
@@ -695,7 +696,7 @@ func (c *Coinbene) GetSwapTrades(symbol string, limit int) (SwapTrades, error) {
 	}
 	var r resp
 	path := common.EncodeURLValues(coinbeneSwapAPIURL+coinbeneAPIVersion+coinbeneGetTrades, v)
-	if err := c.SendHTTPRequest(path, contractTrades, &r); err != nil {
+	if err := c.SendHTTPRequest(ctx, path, contractTrades, &r); err != nil {
 		return nil, err
 	}
 
@@ -728,13 +729,13 @@ func (c *Coinbene) GetSwapTrades(symbol string, limit int) (SwapTrades, error) {
 }
 
 // GetSwapAccountInfo returns a users swap account balance info
-func (c *Coinbene) GetSwapAccountInfo() (SwapAccountInfo, error) {
+func (c *Coinbene) GetSwapAccountInfo(ctx context.Context) (SwapAccountInfo, error) {
 	type resp struct {
 		Data SwapAccountInfo `json:"data"`
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneAccountInfo
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneAccountInfo,
 		true,
@@ -748,7 +749,7 @@ func (c *Coinbene) GetSwapAccountInfo() (SwapAccountInfo, error) {
 }
 
 // GetSwapPositions returns a list of open swap positions
-func (c *Coinbene) GetSwapPositions(symbol string) (SwapPositions, error) {
+func (c *Coinbene) GetSwapPositions(ctx context.Context, symbol string) (SwapPositions, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	type resp struct {
@@ -756,7 +757,7 @@ func (c *Coinbene) GetSwapPositions(symbol string) (SwapPositions, error) {
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneListSwapPositions
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneListSwapPositions,
 		true,
@@ -770,7 +771,7 @@ func (c *Coinbene) GetSwapPositions(symbol string) (SwapPositions, error) {
 }
 
 // PlaceSwapOrder places a swap order
-func (c *Coinbene) PlaceSwapOrder(symbol, direction, orderType, marginMode,
+func (c *Coinbene) PlaceSwapOrder(ctx context.Context, symbol, direction, orderType, marginMode,
 	clientID string, price, quantity float64, leverage int) (SwapPlaceOrderResponse, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
@@ -811,7 +812,7 @@ func (c *Coinbene) PlaceSwapOrder(symbol, direction, orderType, marginMode,
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbenePlaceOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbenePlaceOrder,
 		true,
@@ -825,7 +826,7 @@ func (c *Coinbene) PlaceSwapOrder(symbol, direction, orderType, marginMode,
 }
 
 // CancelSwapOrder cancels a swap order
-func (c *Coinbene) CancelSwapOrder(orderID string) (string, error) {
+func (c *Coinbene) CancelSwapOrder(ctx context.Context, orderID string) (string, error) {
 	params := make(map[string]interface{})
 	params["orderId"] = orderID
 	type resp struct {
@@ -833,7 +834,7 @@ func (c *Coinbene) CancelSwapOrder(orderID string) (string, error) {
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneCancelOrder
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneCancelOrder,
 		true,
@@ -847,7 +848,7 @@ func (c *Coinbene) CancelSwapOrder(orderID string) (string, error) {
 }
 
 // GetSwapOpenOrders gets a list of open swap orders
-func (c *Coinbene) GetSwapOpenOrders(symbol string, pageNum, pageSize int) (SwapOrders, error) {
+func (c *Coinbene) GetSwapOpenOrders(ctx context.Context, symbol string, pageNum, pageSize int) (SwapOrders, error) {
 	v := url.Values{}
 	v.Set("symbol", symbol)
 	if pageNum != 0 {
@@ -861,7 +862,7 @@ func (c *Coinbene) GetSwapOpenOrders(symbol string, pageNum, pageSize int) (Swap
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneOpenOrders
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOpenOrders,
 		true,
@@ -875,7 +876,7 @@ func (c *Coinbene) GetSwapOpenOrders(symbol string, pageNum, pageSize int) (Swap
 }
 
 // GetSwapOpenOrdersByPage gets a list of open orders by page
-func (c *Coinbene) GetSwapOpenOrdersByPage(symbol string, latestOrderID int64) (SwapOrders, error) {
+func (c *Coinbene) GetSwapOpenOrdersByPage(ctx context.Context, symbol string, latestOrderID int64) (SwapOrders, error) {
 	v := url.Values{}
 	if symbol != "" {
 		v.Set("symbol", symbol)
@@ -888,7 +889,7 @@ func (c *Coinbene) GetSwapOpenOrdersByPage(symbol string, latestOrderID int64) (
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneOpenOrdersByPage
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOpenOrdersByPage,
 		true,
@@ -902,7 +903,7 @@ func (c *Coinbene) GetSwapOpenOrdersByPage(symbol string, latestOrderID int64) (
 }
 
 // GetSwapOrderInfo gets order info for a specific order
-func (c *Coinbene) GetSwapOrderInfo(orderID string) (SwapOrder, error) {
+func (c *Coinbene) GetSwapOrderInfo(ctx context.Context, orderID string) (SwapOrder, error) {
 	v := url.Values{}
 	v.Set("orderId", orderID)
 	type resp struct {
@@ -910,7 +911,7 @@ func (c *Coinbene) GetSwapOrderInfo(orderID string) (SwapOrder, error) {
 	}
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneOrderInfo
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOrderInfo,
 		true,
@@ -924,7 +925,7 @@ func (c *Coinbene) GetSwapOrderInfo(orderID string) (SwapOrder, error) {
 }
 
 // GetSwapOrderHistory returns the swap order history for a given symbol
-func (c *Coinbene) GetSwapOrderHistory(beginTime, endTime, symbol string, pageNum,
+func (c *Coinbene) GetSwapOrderHistory(ctx context.Context, beginTime, endTime, symbol string, pageNum,
 	pageSize int, direction, orderType string) (SwapOrders, error) {
 	v := url.Values{}
 	if beginTime != "" {
@@ -953,7 +954,7 @@ func (c *Coinbene) GetSwapOrderHistory(beginTime, endTime, symbol string, pageNu
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneClosedOrders
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneClosedOrders,
 		true,
@@ -967,7 +968,7 @@ func (c *Coinbene) GetSwapOrderHistory(beginTime, endTime, symbol string, pageNu
 }
 
 // GetSwapOrderHistoryByOrderID returns a list of historic orders based on user params
-func (c *Coinbene) GetSwapOrderHistoryByOrderID(beginTime, endTime, symbol, status string,
+func (c *Coinbene) GetSwapOrderHistoryByOrderID(ctx context.Context, beginTime, endTime, symbol, status string,
 	latestOrderID int64) (SwapOrders, error) {
 	v := url.Values{}
 	if beginTime != "" {
@@ -991,7 +992,7 @@ func (c *Coinbene) GetSwapOrderHistoryByOrderID(beginTime, endTime, symbol, stat
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneClosedOrdersByPage
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneClosedOrdersByPage,
 		true,
@@ -1005,7 +1006,7 @@ func (c *Coinbene) GetSwapOrderHistoryByOrderID(beginTime, endTime, symbol, stat
 }
 
 // CancelSwapOrders cancels multiple swap order IDs
-func (c *Coinbene) CancelSwapOrders(orderIDs []string) ([]OrderCancellationResponse, error) {
+func (c *Coinbene) CancelSwapOrders(ctx context.Context, orderIDs []string) ([]OrderCancellationResponse, error) {
 	if len(orderIDs) > 10 {
 		return nil, errors.New("only 10 orderIDs are allowed at a time")
 	}
@@ -1017,7 +1018,7 @@ func (c *Coinbene) CancelSwapOrders(orderIDs []string) ([]OrderCancellationRespo
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneBatchCancel
-	err := c.SendAuthHTTPRequest(http.MethodPost,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodPost,
 		path,
 		coinbeneBatchCancel,
 		true,
@@ -1031,7 +1032,7 @@ func (c *Coinbene) CancelSwapOrders(orderIDs []string) ([]OrderCancellationRespo
 }
 
 // GetSwapOrderFills returns a list of swap order fills
-func (c *Coinbene) GetSwapOrderFills(symbol, orderID string, lastTradeID int64) (SwapOrderFills, error) {
+func (c *Coinbene) GetSwapOrderFills(ctx context.Context, symbol, orderID string, lastTradeID int64) (SwapOrderFills, error) {
 	v := url.Values{}
 	if symbol != "" {
 		v.Set("symbol", symbol)
@@ -1048,7 +1049,7 @@ func (c *Coinbene) GetSwapOrderFills(symbol, orderID string, lastTradeID int64)
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbeneOrderFills
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbeneOrderFills,
 		true,
@@ -1062,7 +1063,7 @@ func (c *Coinbene) GetSwapOrderFills(symbol, orderID string, lastTradeID int64)
 }
 
 // GetSwapFundingRates returns a list of funding rates
-func (c *Coinbene) GetSwapFundingRates(pageNum, pageSize int) ([]SwapFundingRate, error) {
+func (c *Coinbene) GetSwapFundingRates(ctx context.Context, pageNum, pageSize int) ([]SwapFundingRate, error) {
 	v := url.Values{}
 	if pageNum != 0 {
 		v.Set("pageNum", strconv.Itoa(pageNum))
@@ -1076,7 +1077,7 @@ func (c *Coinbene) GetSwapFundingRates(pageNum, pageSize int) ([]SwapFundingRate
 
 	var r resp
 	path := coinbeneSwapAPIURL + coinbeneAPIVersion + coinbenePositionFeeRate
-	err := c.SendAuthHTTPRequest(http.MethodGet,
+	err := c.SendAuthHTTPRequest(ctx, http.MethodGet,
 		path,
 		coinbenePositionFeeRate,
 		true,
@@ -1090,14 +1091,14 @@ func (c *Coinbene) GetSwapFundingRates(pageNum, pageSize int) ([]SwapFundingRate
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (c *Coinbene) SendHTTPRequest(path string, f request.EndpointLimit, result interface{}) error {
+func (c *Coinbene) SendHTTPRequest(ctx context.Context, path string, f request.EndpointLimit, result interface{}) error {
 	var resp json.RawMessage
 	errCap := struct {
 		Code    int    `json:"code"`
 		Message string `json:"message"`
 	}{}
 
-	if err := c.SendPayload(&request.Item{
+	if err := c.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        &resp,
@@ -1118,7 +1119,7 @@ func (c *Coinbene) SendHTTPRequest(path string, f request.EndpointLimit, result
 }
 
 // SendAuthHTTPRequest sends an authenticated HTTP request
-func (c *Coinbene) SendAuthHTTPRequest(method, path, epPath string, isSwap bool,
+func (c *Coinbene) SendAuthHTTPRequest(ctx context.Context, method, path, epPath string, isSwap bool,
 	params, result interface{}, f request.EndpointLimit) error {
 	if !c.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
@@ -1129,7 +1130,7 @@ func (c *Coinbene) SendAuthHTTPRequest(method, path, epPath string, isSwap bool,
 	if isSwap {
 		authPath = coinbeneSwapAuthPath
 	}
-	timestamp := time.Now().UTC().Format("2006-01-02T15:04:05.999Z")
+	timestamp := c.Requester.Now().UTC().Format("2006-01-02T15:04:05.999Z")
 	var finalBody io.Reader
 	var preSign string
 	switch {
@@ -1176,7 +1177,7 @@ func (c *Coinbene) SendAuthHTTPRequest(method, path, epPath string, isSwap bool,
 		Message string `json:"message"`
 	}{}
 
-	if err := c.SendPayload(&request.Item{
+	if err := c.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          path,
 		Headers:       headers,
@@ -1199,6 +1200,6 @@ func (c *Coinbene) SendAuthHTTPRequest(method, path, epPath string, isSwap bool,
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (c *Coinbene) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (c *Coinbene) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrFunctionNotSupported
 }
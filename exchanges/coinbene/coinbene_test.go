@@ -1,6 +1,7 @@
 package coinbene
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
@@ -52,7 +53,7 @@ func areTestAPIKeysSet() bool {
 
 func TestGetAllPairs(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetAllPairs()
+	_, err := c.GetAllPairs(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -60,7 +61,7 @@ func TestGetAllPairs(t *testing.T) {
 
 func TestGetPairInfo(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetPairInfo(spotTestPair)
+	_, err := c.GetPairInfo(context.Background(), spotTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -68,7 +69,7 @@ func TestGetPairInfo(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetOrderbook(spotTestPair, 100)
+	_, err := c.GetOrderbook(context.Background(), spotTestPair, 100)
 	if err != nil {
 		t.Error(err)
 	}
@@ -76,7 +77,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetTicker(spotTestPair)
+	_, err := c.GetTicker(context.Background(), spotTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -84,7 +85,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetTrades(spotTestPair)
+	_, err := c.GetTrades(context.Background(), spotTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -95,7 +96,7 @@ func TestGetAcounntBalances(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetAccountBalances()
+	_, err := c.GetAccountBalances(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -106,7 +107,7 @@ func TestGetAccountAssetBalance(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetAccountAssetBalance(currency.BTC.String())
+	_, err := c.GetAccountAssetBalance(context.Background(), currency.BTC.String())
 	if err != nil {
 		t.Error(err)
 	}
@@ -117,7 +118,7 @@ func TestPlaceOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := c.PlaceSpotOrder(
+	_, err := c.PlaceSpotOrder(context.Background(),
 		1,
 		1,
 		spotTestPair,
@@ -137,7 +138,7 @@ func TestPlaceOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.PlaceSpotOrders(
+	_, err := c.PlaceSpotOrders(context.Background(),
 		[]PlaceOrderRequest{
 			{
 				1,
@@ -159,7 +160,7 @@ func TestFetchOpenOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.FetchOpenSpotOrders(spotTestPair)
+	_, err := c.FetchOpenSpotOrders(context.Background(), spotTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -170,7 +171,7 @@ func TestFetchClosedOrders(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.FetchClosedOrders(spotTestPair, "")
+	_, err := c.FetchClosedOrders(context.Background(), spotTestPair, "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -181,7 +182,7 @@ func TestFetchOrderInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.FetchSpotOrderInfo("adfjashjgsag")
+	_, err := c.FetchSpotOrderInfo(context.Background(), "adfjashjgsag")
 	if err != nil {
 		t.Error(err)
 	}
@@ -192,7 +193,7 @@ func TestGetSpotOrderFills(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetSpotOrderFills("1912131427156307968")
+	_, err := c.GetSpotOrderFills(context.Background(), "1912131427156307968")
 	if err != nil {
 		t.Error(err)
 	}
@@ -203,7 +204,7 @@ func TestCancelSpotOrder(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := c.CancelSpotOrder("adfjashjgsag")
+	_, err := c.CancelSpotOrder(context.Background(), "adfjashjgsag")
 	if err != nil {
 		t.Error(err)
 	}
@@ -215,7 +216,7 @@ func TestCancelSpotOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.CancelSpotOrders([]string{"578639816552972288", "578639902896914432"})
+	_, err := c.CancelSpotOrders(context.Background(), []string{"578639816552972288", "578639902896914432"})
 	if err != nil {
 		t.Error(err)
 	}
@@ -224,11 +225,11 @@ func TestCancelSpotOrders(t *testing.T) {
 func TestUpdateTicker(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter("BTC", "USDT", "/")
-	_, err := c.UpdateTicker(cp, asset.Spot)
+	_, err := c.UpdateTicker(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = c.UpdateTicker(cp, asset.PerpetualSwap)
+	_, err = c.UpdateTicker(context.Background(), cp, asset.PerpetualSwap)
 	if err != nil {
 		t.Error(err)
 	}
@@ -239,7 +240,7 @@ func TestGetAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.UpdateAccountInfo()
+	_, err := c.UpdateAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -248,11 +249,11 @@ func TestGetAccountInfo(t *testing.T) {
 func TestUpdateOrderbook(t *testing.T) {
 	t.Parallel()
 	cp := currency.NewPairWithDelimiter("BTC", "USDT", "/")
-	_, err := c.UpdateOrderbook(cp, asset.Spot)
+	_, err := c.UpdateOrderbook(context.Background(), cp, asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
-	_, err = c.UpdateOrderbook(cp, asset.PerpetualSwap)
+	_, err = c.UpdateOrderbook(context.Background(), cp, asset.PerpetualSwap)
 	if err != nil {
 		t.Error(err)
 	}
@@ -260,7 +261,7 @@ func TestUpdateOrderbook(t *testing.T) {
 
 func TestGetSwapTickers(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapTickers()
+	_, err := c.GetSwapTickers(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -268,7 +269,7 @@ func TestGetSwapTickers(t *testing.T) {
 
 func TestGetSwapTicker(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapTicker(swapTestPair)
+	_, err := c.GetSwapTicker(context.Background(), swapTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -276,7 +277,7 @@ func TestGetSwapTicker(t *testing.T) {
 
 func TestGetSwapOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapOrderbook(swapTestPair, 100)
+	_, err := c.GetSwapOrderbook(context.Background(), swapTestPair, 100)
 	if err != nil {
 		t.Error(err)
 	}
@@ -284,7 +285,7 @@ func TestGetSwapOrderbook(t *testing.T) {
 
 func TestGetSwapKlines(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapKlines(swapTestPair,
+	_, err := c.GetSwapKlines(context.Background(), swapTestPair,
 		"1573184608",
 		"1573184808",
 		"1")
@@ -295,7 +296,7 @@ func TestGetSwapKlines(t *testing.T) {
 
 func TestGetSwapTrades(t *testing.T) {
 	t.Parallel()
-	_, err := c.GetSwapTrades(swapTestPair, 10)
+	_, err := c.GetSwapTrades(context.Background(), swapTestPair, 10)
 	if err != nil {
 		t.Error(err)
 	}
@@ -306,7 +307,7 @@ func TestGetSwapAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetSwapAccountInfo()
+	_, err := c.GetSwapAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -317,7 +318,7 @@ func TestGetSwapPositions(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := c.GetSwapPositions(swapTestPair)
+	_, err := c.GetSwapPositions(context.Background(), swapTestPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -329,7 +330,7 @@ func TestPlaceSwapOrder(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.PlaceSwapOrder(swapTestPair,
+	_, err := c.PlaceSwapOrder(context.Background(), swapTestPair,
 		order.Buy.Lower(),
 		"limit",
 		"fixed",
@@ -348,7 +349,7 @@ func TestCancelSwapOrder(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.CancelSwapOrder("1337")
+	_, err := c.CancelSwapOrder(context.Background(), "1337")
 	if err != nil {
 		t.Error(err)
 	}
@@ -360,7 +361,7 @@ func TestGetOpenSwapOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOpenOrders(swapTestPair, 0, 0)
+	_, err := c.GetSwapOpenOrders(context.Background(), swapTestPair, 0, 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -372,7 +373,7 @@ func TestGetSwapOpenOrdersByPage(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOpenOrdersByPage(swapTestPair, 0)
+	_, err := c.GetSwapOpenOrdersByPage(context.Background(), swapTestPair, 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -384,7 +385,7 @@ func TestGetSwapOrderInfo(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOrderInfo("1337")
+	_, err := c.GetSwapOrderInfo(context.Background(), "1337")
 	if err != nil {
 		t.Error(err)
 	}
@@ -396,7 +397,7 @@ func TestGetSwapOrderHistory(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOrderHistory("", "", swapTestPair, 1, 10, "", "")
+	_, err := c.GetSwapOrderHistory(context.Background(), "", "", swapTestPair, 1, 10, "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -408,7 +409,7 @@ func TestGetSwapOrderHistoryByOrderID(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOrderHistoryByOrderID("", "", swapTestPair, "", 0)
+	_, err := c.GetSwapOrderHistoryByOrderID(context.Background(), "", "", swapTestPair, "", 0)
 	if err != nil {
 		t.Error(err)
 	}
@@ -420,7 +421,7 @@ func TestCancelSwapOrders(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.CancelSwapOrders([]string{"578639816552972288", "578639902896914432"})
+	_, err := c.CancelSwapOrders(context.Background(), []string{"578639816552972288", "578639902896914432"})
 	if err != nil {
 		t.Error(err)
 	}
@@ -432,7 +433,7 @@ func TestGetSwapOrderFills(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapOrderFills(swapTestPair, "5807143157122003", 580714315825905664)
+	_, err := c.GetSwapOrderFills(context.Background(), swapTestPair, "5807143157122003", 580714315825905664)
 	if err != nil {
 		t.Error(err)
 	}
@@ -444,7 +445,7 @@ func TestGetSwapFundingRates(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 
-	_, err := c.GetSwapFundingRates(1, 2)
+	_, err := c.GetSwapFundingRates(context.Background(), 1, 2)
 	if err != nil {
 		t.Error(err)
 	}
@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	klineinterval "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -106,7 +107,7 @@ func (c *Coinbene) WsDataHandler() {
 			var result map[string]interface{}
 			err = json.Unmarshal(stream.Raw, &result)
 			if err != nil {
-				c.Websocket.DataHandler <- err
+				c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 			}
 			_, ok := result[event]
 			switch {
@@ -131,7 +132,7 @@ func (c *Coinbene) WsDataHandler() {
 				var wsTicker WsTicker
 				err = json.Unmarshal(stream.Raw, &wsTicker)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				for x := range wsTicker.Data {
@@ -154,7 +155,7 @@ func (c *Coinbene) WsDataHandler() {
 				var tradeList WsTradeList
 				err = json.Unmarshal(stream.Raw, &tradeList)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				var t time.Time
@@ -199,7 +200,7 @@ func (c *Coinbene) WsDataHandler() {
 				}{}
 				err = json.Unmarshal(stream.Raw, &orderBook)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				p := strings.Replace(orderBook.Topic, "orderBook.", "", 1)
@@ -282,7 +283,7 @@ func (c *Coinbene) WsDataHandler() {
 				var tempKline []float64
 				err = json.Unmarshal(stream.Raw, &kline)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				for x := 2; x < len(kline.Data[0]); x++ {
@@ -296,11 +297,13 @@ func (c *Coinbene) WsDataHandler() {
 				p := currency.NewPairFromFormattedPairs(kline.Data[0][0].(string),
 					c.GetEnabledPairs(asset.PerpetualSwap),
 					c.GetPairFormat(asset.PerpetualSwap, true))
+				// Default kline subscriptions stream one minute candles
 				c.Websocket.DataHandler <- wshandler.KlineData{
 					Timestamp:  time.Unix(int64(kline.Data[0][1].(float64)), 0),
 					Pair:       p,
 					AssetType:  asset.PerpetualSwap,
 					Exchange:   c.Name,
+					Interval:   klineinterval.OneMin,
 					OpenPrice:  tempKline[0],
 					ClosePrice: tempKline[1],
 					HighPrice:  tempKline[2],
@@ -311,7 +314,7 @@ func (c *Coinbene) WsDataHandler() {
 				var userinfo WsUserInfo
 				err = json.Unmarshal(stream.Raw, &userinfo)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				c.Websocket.DataHandler <- userinfo
@@ -319,7 +322,7 @@ func (c *Coinbene) WsDataHandler() {
 				var position WsPosition
 				err = json.Unmarshal(stream.Raw, &position)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				c.Websocket.DataHandler <- position
@@ -327,7 +330,7 @@ func (c *Coinbene) WsDataHandler() {
 				var orders WsUserOrders
 				err = json.Unmarshal(stream.Raw, &orders)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				c.Websocket.DataHandler <- orders
@@ -357,7 +360,7 @@ func (c *Coinbene) Unsubscribe(channelToSubscribe wshandler.WebsocketChannelSubs
 // Login logs in
 func (c *Coinbene) Login() error {
 	var sub WsSub
-	expTime := time.Now().Add(time.Minute * 10).Format("2006-01-02T15:04:05Z")
+	expTime := c.Requester.Now().Add(time.Minute * 10).Format("2006-01-02T15:04:05Z")
 	signMsg := expTime + http.MethodGet + "/login"
 	tempSign := crypto.GetHMAC(crypto.HashSHA256,
 		[]byte(signMsg),
@@ -1,12 +1,14 @@
 package coinbene
 
 import (
+	"context"
 	"fmt"
 	"strings"
 	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -36,7 +38,7 @@ func (c *Coinbene) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if c.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = c.UpdateTradablePairs(true)
+		err = c.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -162,10 +164,13 @@ func (c *Coinbene) Setup(exch *config.ExchangeConfig) error {
 	c.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         c.Name,
 		URL:                  c.Websocket.GetWebsocketURL(),
+		AlternateURLs:        c.Websocket.GetAlternateURLs(),
 		ProxyURL:             c.Websocket.GetProxyAddress(),
 		Verbose:              c.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	c.Websocket.Orderbook.Setup(
@@ -204,7 +209,7 @@ func (c *Coinbene) Run() {
 		return
 	}
 
-	err := c.UpdateTradablePairs(false)
+	err := c.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s Failed to update tradable pairs. Error: %s",
@@ -214,7 +219,7 @@ func (c *Coinbene) Run() {
 }
 
 // FetchTradablePairs returns a list of exchange tradable pairs
-func (c *Coinbene) FetchTradablePairs(a asset.Item) ([]string, error) {
+func (c *Coinbene) FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error) {
 	if !c.SupportsAsset(a) {
 		return nil, fmt.Errorf("%s does not support asset type %s", c.Name, a)
 	}
@@ -222,7 +227,7 @@ func (c *Coinbene) FetchTradablePairs(a asset.Item) ([]string, error) {
 	var currencies []string
 	switch a {
 	case asset.Spot:
-		pairs, err := c.GetAllPairs()
+		pairs, err := c.GetAllPairs(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -231,7 +236,7 @@ func (c *Coinbene) FetchTradablePairs(a asset.Item) ([]string, error) {
 			currencies = append(currencies, pairs[x].Symbol)
 		}
 	case asset.PerpetualSwap:
-		tickers, err := c.GetSwapTickers()
+		tickers, err := c.GetSwapTickers(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -249,10 +254,10 @@ func (c *Coinbene) FetchTradablePairs(a asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them
-func (c *Coinbene) UpdateTradablePairs(forceUpdate bool) error {
+func (c *Coinbene) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	assets := c.GetAssetTypes()
 	for x := range assets {
-		pairs, err := c.FetchTradablePairs(assets[x])
+		pairs, err := c.FetchTradablePairs(ctx, assets[x])
 		if err != nil {
 			return err
 		}
@@ -266,7 +271,7 @@ func (c *Coinbene) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (c *Coinbene) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	resp := new(ticker.Price)
 	if !c.SupportsAsset(assetType) {
 		return nil,
@@ -277,7 +282,7 @@ func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 	case asset.Spot:
 		allPairs := c.GetEnabledPairs(assetType)
 		for x := range allPairs {
-			tempResp, err := c.GetTicker(c.FormatExchangeCurrency(allPairs[x],
+			tempResp, err := c.GetTicker(ctx, c.FormatExchangeCurrency(allPairs[x],
 				assetType).String())
 			if err != nil {
 				return nil, err
@@ -296,7 +301,7 @@ func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 			}
 		}
 	case asset.PerpetualSwap:
-		tickers, err := c.GetSwapTickers()
+		tickers, err := c.GetSwapTickers(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -328,7 +333,7 @@ func (c *Coinbene) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (c *Coinbene) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (c *Coinbene) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	if !c.SupportsAsset(assetType) {
 		return nil,
 			fmt.Errorf("%s does not support asset type %s", c.Name, assetType)
@@ -336,13 +341,13 @@ func (c *Coinbene) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.P
 
 	tickerNew, err := ticker.GetTicker(c.Name, p, assetType)
 	if err != nil {
-		return c.UpdateTicker(p, assetType)
+		return c.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (c *Coinbene) FetchOrderbook(currency currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (c *Coinbene) FetchOrderbook(ctx context.Context, currency currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	if !c.SupportsAsset(assetType) {
 		return nil,
 			fmt.Errorf("%s does not support asset type %s", c.Name, assetType)
@@ -350,13 +355,13 @@ func (c *Coinbene) FetchOrderbook(currency currency.Pair, assetType asset.Item)
 
 	ob, err := orderbook.Get(c.Name, currency, assetType)
 	if err != nil {
-		return c.UpdateOrderbook(currency, assetType)
+		return c.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *Coinbene) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (c *Coinbene) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	resp := new(orderbook.Base)
 	if !c.SupportsAsset(assetType) {
 		return nil,
@@ -368,12 +373,12 @@ func (c *Coinbene) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orde
 
 	switch assetType {
 	case asset.Spot:
-		tempResp, err = c.GetOrderbook(
+		tempResp, err = c.GetOrderbook(ctx,
 			c.FormatExchangeCurrency(p, assetType).String(),
 			100, // TO-DO: Update this once we support configurable orderbook depth
 		)
 	case asset.PerpetualSwap:
-		tempResp, err = c.GetSwapOrderbook(
+		tempResp, err = c.GetSwapOrderbook(ctx,
 			c.FormatExchangeCurrency(p, assetType).String(),
 			100, // TO-DO: Update this once we support configurable orderbook depth
 		)
@@ -413,9 +418,9 @@ func (c *Coinbene) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orde
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Coinbene exchange
-func (c *Coinbene) UpdateAccountInfo() (account.Holdings, error) {
+func (c *Coinbene) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
-	balance, err := c.GetAccountBalances()
+	balance, err := c.GetAccountBalances(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -427,8 +432,8 @@ func (c *Coinbene) UpdateAccountInfo() (account.Holdings, error) {
 		acc.Currencies = append(acc.Currencies,
 			account.Balance{
 				CurrencyName: c,
-				TotalValue:   hold + available,
-				Hold:         hold,
+				TotalValue:   decimal.NewFromFloat(hold).Add(decimal.NewFromFloat(available)),
+				Hold:         decimal.NewFromFloat(hold),
 			})
 	}
 	info.Accounts = append(info.Accounts, acc)
@@ -443,10 +448,10 @@ func (c *Coinbene) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (c *Coinbene) FetchAccountInfo() (account.Holdings, error) {
+func (c *Coinbene) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(c.Name)
 	if err != nil {
-		return c.UpdateAccountInfo()
+		return c.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -454,17 +459,40 @@ func (c *Coinbene) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (c *Coinbene) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (c *Coinbene) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (c *Coinbene) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (c *Coinbene) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (c *Coinbene) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (c *Coinbene) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrNotYetImplemented
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (c *Coinbene) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (c *Coinbene) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (c *Coinbene) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (c *Coinbene) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var resp order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return resp, err
@@ -479,8 +507,8 @@ func (c *Coinbene) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		return resp, fmt.Errorf("only limit order is supported by this exchange")
 	}
 
-	tempResp, err := c.PlaceSpotOrder(s.Price,
-		s.Amount,
+	tempResp, err := c.PlaceSpotOrder(ctx, s.Price.Float64(),
+		s.Amount.Float64(),
 		c.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
 		s.OrderSide.String(),
 		s.OrderType.String(),
@@ -496,20 +524,20 @@ func (c *Coinbene) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (c *Coinbene) ModifyOrder(action *order.Modify) (string, error) {
+func (c *Coinbene) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (c *Coinbene) CancelOrder(order *order.Cancel) error {
-	_, err := c.CancelSpotOrder(order.OrderID)
+func (c *Coinbene) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	_, err := c.CancelSpotOrder(ctx, order.OrderID)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (c *Coinbene) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (c *Coinbene) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	var resp order.CancelAllResponse
-	orders, err := c.FetchOpenSpotOrders(
+	orders, err := c.FetchOpenSpotOrders(ctx,
 		c.FormatExchangeCurrency(orderCancellation.CurrencyPair,
 			asset.Spot).String(),
 	)
@@ -518,7 +546,7 @@ func (c *Coinbene) CancelAllOrders(orderCancellation *order.Cancel) (order.Cance
 	}
 	tempMap := make(map[string]string)
 	for x := range orders {
-		_, err := c.CancelSpotOrder(orders[x].OrderID)
+		_, err := c.CancelSpotOrder(ctx, orders[x].OrderID)
 		if err != nil {
 			tempMap[orders[x].OrderID] = "Failed"
 		} else {
@@ -530,9 +558,9 @@ func (c *Coinbene) CancelAllOrders(orderCancellation *order.Cancel) (order.Cance
 }
 
 // GetOrderInfo returns information on a current open order
-func (c *Coinbene) GetOrderInfo(orderID string) (order.Detail, error) {
+func (c *Coinbene) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var resp order.Detail
-	tempResp, err := c.FetchSpotOrderInfo(orderID)
+	tempResp, err := c.FetchSpotOrderInfo(ctx, orderID)
 	if err != nil {
 		return resp, err
 	}
@@ -546,33 +574,33 @@ func (c *Coinbene) GetOrderInfo(orderID string) (order.Detail, error) {
 	if err != nil {
 		return resp, err
 	}
-	resp.Price = tempResp.OrderPrice
+	resp.Price = decimal.NewFromFloat(tempResp.OrderPrice)
 	resp.OrderDate = t
-	resp.ExecutedAmount = tempResp.FilledAmount
-	resp.Fee = tempResp.TotalFee
+	resp.ExecutedAmount = decimal.NewFromFloat(tempResp.FilledAmount)
+	resp.Fee = decimal.NewFromFloat(tempResp.TotalFee)
 	return resp, nil
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (c *Coinbene) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (c *Coinbene) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *Coinbene) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (c *Coinbene) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *Coinbene) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (c *Coinbene) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *Coinbene) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (c *Coinbene) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -582,9 +610,9 @@ func (c *Coinbene) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (c *Coinbene) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (c *Coinbene) GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(getOrdersRequest.Currencies) == 0 {
-		allPairs, err := c.GetAllPairs()
+		allPairs, err := c.GetAllPairs(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -599,7 +627,7 @@ func (c *Coinbene) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]
 
 	for x := range getOrdersRequest.Currencies {
 		var tempData OrdersInfo
-		tempData, err = c.FetchOpenSpotOrders(
+		tempData, err = c.FetchOpenSpotOrders(ctx,
 			c.FormatExchangeCurrency(
 				getOrdersRequest.Currencies[x],
 				asset.Spot).String(),
@@ -625,11 +653,11 @@ func (c *Coinbene) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]
 
 			tempResp.OrderDate = t
 			tempResp.Status = order.Status(tempData[y].OrderStatus)
-			tempResp.Price = tempData[y].OrderPrice
-			tempResp.Amount = tempData[y].Amount
-			tempResp.ExecutedAmount = tempData[y].FilledAmount
-			tempResp.RemainingAmount = tempData[y].Amount - tempData[y].FilledAmount
-			tempResp.Fee = tempData[y].TotalFee
+			tempResp.Price = decimal.NewFromFloat(tempData[y].OrderPrice)
+			tempResp.Amount = decimal.NewFromFloat(tempData[y].Amount)
+			tempResp.ExecutedAmount = decimal.NewFromFloat(tempData[y].FilledAmount)
+			tempResp.RemainingAmount = decimal.NewFromFloat(tempData[y].Amount).Sub(decimal.NewFromFloat(tempData[y].FilledAmount))
+			tempResp.Fee = decimal.NewFromFloat(tempData[y].TotalFee)
 			resp = append(resp, tempResp)
 		}
 	}
@@ -638,9 +666,9 @@ func (c *Coinbene) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (c *Coinbene) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (c *Coinbene) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(getOrdersRequest.Currencies) == 0 {
-		allPairs, err := c.GetAllPairs()
+		allPairs, err := c.GetAllPairs(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -655,7 +683,7 @@ func (c *Coinbene) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]
 	var err error
 
 	for x := range getOrdersRequest.Currencies {
-		tempData, err = c.FetchClosedOrders(
+		tempData, err = c.FetchClosedOrders(ctx,
 			c.FormatExchangeCurrency(
 				getOrdersRequest.Currencies[x],
 				asset.Spot).String(),
@@ -682,11 +710,11 @@ func (c *Coinbene) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]
 
 			tempResp.OrderDate = t
 			tempResp.Status = order.Status(tempData[y].OrderStatus)
-			tempResp.Price = tempData[y].OrderPrice
-			tempResp.Amount = tempData[y].Amount
-			tempResp.ExecutedAmount = tempData[y].FilledAmount
-			tempResp.RemainingAmount = tempData[y].Amount - tempData[y].FilledAmount
-			tempResp.Fee = tempData[y].TotalFee
+			tempResp.Price = decimal.NewFromFloat(tempData[y].OrderPrice)
+			tempResp.Amount = decimal.NewFromFloat(tempData[y].Amount)
+			tempResp.ExecutedAmount = decimal.NewFromFloat(tempData[y].FilledAmount)
+			tempResp.RemainingAmount = decimal.NewFromFloat(tempData[y].Amount).Sub(decimal.NewFromFloat(tempData[y].FilledAmount))
+			tempResp.Fee = decimal.NewFromFloat(tempData[y].TotalFee)
 			resp = append(resp, tempResp)
 		}
 	}
@@ -694,9 +722,9 @@ func (c *Coinbene) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]
 }
 
 // GetFeeByType returns an estimate of fee based on the type of transaction
-func (c *Coinbene) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (c *Coinbene) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
-	tempData, err := c.GetPairInfo(
+	tempData, err := c.GetPairInfo(ctx,
 		c.FormatExchangeCurrency(
 			feeBuilder.Pair, asset.Spot).String(),
 	)
@@ -712,18 +740,16 @@ func (c *Coinbene) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error
 	return fee, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (c *Coinbene) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	c.Websocket.SubscribeToChannels(channels)
-	return nil
+	return c.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (c *Coinbene) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	c.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return c.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -738,7 +764,12 @@ func (c *Coinbene) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (c *Coinbene) ValidateCredentials() error {
-	_, err := c.UpdateAccountInfo()
+func (c *Coinbene) ValidateCredentials(ctx context.Context) error {
+	_, err := c.UpdateAccountInfo(ctx)
 	return c.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (c *Coinbene) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -1,6 +1,8 @@
 package coinut
 
 import (
+	"context"
+	"encoding/json"
 	"log"
 	"net/http"
 	"os"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -48,7 +51,7 @@ func TestMain(m *testing.M) {
 		log.Fatal("Coinut setup error", err)
 	}
 
-	c.SeedInstruments()
+	c.SeedInstruments(context.Background())
 
 	os.Exit(m.Run())
 }
@@ -93,14 +96,14 @@ func setupWSTestAuth(t *testing.T) {
 }
 
 func TestGetInstruments(t *testing.T) {
-	_, err := c.GetInstruments()
+	_, err := c.GetInstruments(context.Background())
 	if err != nil {
 		t.Error("GetInstruments() error", err)
 	}
 }
 
 func TestSeedInstruments(t *testing.T) {
-	err := c.SeedInstruments()
+	err := c.SeedInstruments(context.Background())
 	if err != nil {
 		// No point checking the next condition
 		t.Fatal(err)
@@ -123,7 +126,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	c.GetFeeByType(feeBuilder)
+	c.GetFeeByType(context.Background(), feeBuilder)
 	if apiKey == "" {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -261,7 +264,7 @@ func TestGetActiveOrders(t *testing.T) {
 	var getOrdersRequest = order.GetOrdersRequest{
 		OrderType: order.AnyType,
 	}
-	_, err := c.GetActiveOrders(&getOrdersRequest)
+	_, err := c.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	}
@@ -275,7 +278,7 @@ func TestGetOrderHistoryWrapper(t *testing.T) {
 			currency.USD)},
 	}
 
-	_, err := c.GetOrderHistory(&getOrdersRequest)
+	_, err := c.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	}
@@ -299,11 +302,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "123",
 	}
-	response, err := c.SubmitOrder(orderSubmission)
+	response, err := c.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -323,7 +326,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := c.CancelOrder(orderCancellation)
+	err := c.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -345,7 +348,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := c.CancelAllOrders(orderCancellation)
+	resp, err := c.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -361,12 +364,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if apiKey != "" || clientID != "" {
-		_, err := c.UpdateAccountInfo()
+		_, err := c.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
 	} else {
-		_, err := c.UpdateAccountInfo()
+		_, err := c.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
@@ -377,7 +380,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := c.ModifyOrder(&order.Modify{})
+	_, err := c.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -397,7 +400,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := c.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := c.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected 'Not supported', received %v", err)
 	}
@@ -409,7 +412,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := c.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := c.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -421,14 +424,14 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := c.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := c.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
 }
 
 func TestGetDepositAddress(t *testing.T) {
-	_, err := c.GetDepositAddress(currency.BTC, "")
+	_, err := c.GetDepositAddress(context.Background(), currency.BTC, "")
 	if err == nil {
 		t.Error("GetDepositAddress() function unsupported cannot be nil")
 	}
@@ -522,7 +525,7 @@ func TestWsAuthCancelOrdersWrapper(t *testing.T) {
 	orderDetails := order.Cancel{
 		CurrencyPair: currency.NewPair(currency.LTC, currency.BTC),
 	}
-	_, err := c.CancelAllOrders(&orderDetails)
+	_, err := c.CancelAllOrders(context.Background(), &orderDetails)
 	if err != nil {
 		t.Error(err)
 	}
@@ -640,3 +643,36 @@ func TestCurrencyMapInstrumentIDs(t *testing.T) {
 		t.Error("unexpected result")
 	}
 }
+
+func TestWsExecutionReport(t *testing.T) {
+	t.Parallel()
+	var cn COINUT
+	cn.SetDefaults()
+	pressXToJSON := []byte(`{"commission":{"amount":"0.00799068","currency":"USDT"},"fill_price":"7993.17","fill_qty":"0.001","nonce":0,"order":{"client_ord_id":1345,"inst_id":490590,"open_qty":"0.009","order_id":721923333,"price":"7993.17","qty":"0.010","side":"BUY","timestamp":1559620032151063},"reply":"order_filled","status":["OK"],"timestamp":1559620032164934,"trans_id":721923334}`)
+	var orderFilled WsOrderFilledResponse
+	err := json.Unmarshal(pressXToJSON, &orderFilled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = cn.wsExecutionReport(&orderFilled)
+	if err == nil {
+		t.Error("expected error on unknown instrument")
+	}
+	cn.instrumentMap.Seed("BTCUSDT", 490590)
+	report, err := cn.wsExecutionReport(&orderFilled)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = report.Validate(); err != nil {
+		t.Error(err)
+	}
+	if report.OrderID != "721923333" || report.TradeID != "721923334" {
+		t.Errorf("unexpected ids %v %v", report.OrderID, report.TradeID)
+	}
+	if report.Side != order.Buy {
+		t.Errorf("expected buy side, received %v", report.Side)
+	}
+	if report.FeeCurrency != currency.USDT {
+		t.Errorf("expected USDT fee currency, received %v", report.FeeCurrency)
+	}
+}
@@ -549,7 +549,7 @@ type WsOrderData struct {
 // WsOrderFilledCommissionData ws response data
 type WsOrderFilledCommissionData struct {
 	Amount   float64       `json:"amount,string"`
-	Currency currency.Pair `json:"currency"`
+	Currency currency.Code `json:"currency"`
 }
 
 // WsOrderRejectedResponse ws response
@@ -608,7 +608,7 @@ type WsTradeHistoryResponse struct {
 // WsTradeHistoryCommissionData ws response data
 type WsTradeHistoryCommissionData struct {
 	Amount   float64       `json:"amount,string"`
-	Currency currency.Pair `json:"currency"`
+	Currency currency.Code `json:"currency"`
 }
 
 // WsTradeHistoryTradeData ws response data
@@ -10,7 +10,9 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -93,7 +95,7 @@ func (c *COINUT) WsHandleData() {
 				var incoming []wsResponse
 				err = json.Unmarshal(resp.Raw, &incoming)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				for i := range incoming {
@@ -113,7 +115,7 @@ func (c *COINUT) WsHandleData() {
 				var incoming wsResponse
 				err = json.Unmarshal(resp.Raw, &incoming)
 				if err != nil {
-					c.Websocket.DataHandler <- err
+					c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -127,7 +129,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 	var incoming wsResponse
 	err := json.Unmarshal(resp, &incoming)
 	if err != nil {
-		c.Websocket.DataHandler <- err
+		c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 		return
 	}
 	switch incoming.Reply {
@@ -137,7 +139,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		var wsTicker WsTicker
 		err := json.Unmarshal(resp, &wsTicker)
 		if err != nil {
-			c.Websocket.DataHandler <- err
+			c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 
@@ -151,7 +153,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 			High:         wsTicker.High24,
 			Low:          wsTicker.Low24,
 			Last:         wsTicker.Last,
-			LastUpdated:  time.Unix(0, wsTicker.Timestamp),
+			LastUpdated:  convert.TimeFromUnix(wsTicker.Timestamp, time.Microsecond),
 			AssetType:    asset.Spot,
 			Pair: currency.NewPairFromFormattedPairs(currencyPair,
 				c.GetEnabledPairs(asset.Spot),
@@ -162,7 +164,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		var orderbooksnapshot WsOrderbookSnapshot
 		err := json.Unmarshal(resp, &orderbooksnapshot)
 		if err != nil {
-			c.Websocket.DataHandler <- err
+			c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 		err = c.WsProcessOrderbookSnapshot(&orderbooksnapshot)
@@ -182,7 +184,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		var orderbookUpdate WsOrderbookUpdate
 		err := json.Unmarshal(resp, &orderbookUpdate)
 		if err != nil {
-			c.Websocket.DataHandler <- err
+			c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 		err = c.WsProcessOrderbookUpdate(&orderbookUpdate)
@@ -202,7 +204,7 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		var tradeSnap WsTradeSnapshot
 		err := json.Unmarshal(resp, &tradeSnap)
 		if err != nil {
-			c.Websocket.DataHandler <- err
+			c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 
@@ -210,12 +212,12 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 		var tradeUpdate WsTradeUpdate
 		err := json.Unmarshal(resp, &tradeUpdate)
 		if err != nil {
-			c.Websocket.DataHandler <- err
+			c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 		currencyPair := c.instrumentMap.LookupInstrument(tradeUpdate.InstID)
 		c.Websocket.DataHandler <- wshandler.TradeData{
-			Timestamp: time.Unix(tradeUpdate.Timestamp, 0),
+			Timestamp: convert.TimeFromUnix(tradeUpdate.Timestamp, time.Microsecond),
 			CurrencyPair: currency.NewPairFromFormattedPairs(currencyPair,
 				c.GetEnabledPairs(asset.Spot),
 				c.GetPairFormat(asset.Spot, true)),
@@ -224,6 +226,23 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 			Price:     tradeUpdate.Price,
 			Side:      tradeUpdate.Side,
 		}
+	case "order_filled":
+		if incoming.Nonce > 0 {
+			c.WebsocketConn.AddResponseWithID(incoming.Nonce, resp)
+			return
+		}
+		var orderFilled WsOrderFilledResponse
+		err := json.Unmarshal(resp, &orderFilled)
+		if err != nil {
+			c.Websocket.DataHandler <- wshandler.NewError(c.Name, wshandler.ErrorMalformedMessage, err)
+			return
+		}
+		report, err := c.wsExecutionReport(&orderFilled)
+		if err != nil {
+			c.Websocket.DataHandler <- err
+			return
+		}
+		c.Websocket.DataHandler <- report
 	default:
 		if incoming.Nonce > 0 {
 			c.WebsocketConn.AddResponseWithID(incoming.Nonce, resp)
@@ -233,15 +252,44 @@ func (c *COINUT) wsProcessResponse(resp []byte) {
 	}
 }
 
+// wsExecutionReport converts an order fill into a normalised execution
+// report, the transaction ID identifies the individual fill
+func (c *COINUT) wsExecutionReport(orderFilled *WsOrderFilledResponse) (order.ExecutionReport, error) {
+	side, err := order.StringToOrderSide(orderFilled.Order.Side)
+	if err != nil {
+		return order.ExecutionReport{}, err
+	}
+	currencyPair := c.instrumentMap.LookupInstrument(orderFilled.Order.InstID)
+	if currencyPair == "" {
+		return order.ExecutionReport{}, fmt.Errorf("%v unknown instrument id %v",
+			c.Name,
+			orderFilled.Order.InstID)
+	}
+	return order.ExecutionReport{
+		Exchange:  c.Name,
+		AssetType: asset.Spot,
+		Pair: currency.NewPairFromFormattedPairs(currencyPair,
+			c.GetEnabledPairs(asset.Spot),
+			c.GetPairFormat(asset.Spot, true)),
+		OrderID:     strconv.FormatInt(orderFilled.Order.OrderID, 10),
+		TradeID:     strconv.FormatInt(orderFilled.TransID, 10),
+		Side:        side,
+		Price:       decimal.NewFromFloat(orderFilled.FillPrice),
+		Amount:      decimal.NewFromFloat(orderFilled.FillQty),
+		Fee:         decimal.NewFromFloat(orderFilled.Commission.Amount),
+		FeeCurrency: orderFilled.Commission.Currency,
+		Liquidity:   order.UnknownLiquidity,
+		Timestamp:   convert.TimeFromUnix(orderFilled.Timestamp, time.Microsecond),
+	}, nil
+}
+
 // GetNonce returns a nonce for a required request
 func (c *COINUT) GetNonce() int64 {
-	if c.Nonce.Get() == 0 {
-		c.Nonce.Set(time.Now().Unix())
-	} else {
-		c.Nonce.Inc()
+	n, err := c.Nonce.Next(c.Requester.Now().Unix())
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "%s unable to persist nonce: %s\n", c.Name, err)
 	}
-
-	return int64(c.Nonce.Get())
+	return int64(n)
 }
 
 // WsGetInstruments fetches instrument list and propagates a local cache
@@ -377,7 +425,7 @@ func (c *COINUT) wsAuthenticate() error {
 	if !c.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
 		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", c.Name)
 	}
-	timestamp := time.Now().Unix()
+	timestamp := c.Requester.Now().Unix()
 	nonce := c.WebsocketConn.GenerateMessageID(false)
 	payload := c.API.Credentials.ClientID + "|" +
 		strconv.FormatInt(timestamp, 10) + "|" +
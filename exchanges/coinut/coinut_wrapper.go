@@ -1,6 +1,7 @@
 package coinut
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,6 +10,8 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +41,7 @@ func (c *COINUT) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if c.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = c.UpdateTradablePairs(true)
+		err = c.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -160,10 +163,13 @@ func (c *COINUT) Setup(exch *config.ExchangeConfig) error {
 	c.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         c.Name,
 		URL:                  c.Websocket.GetWebsocketURL(),
+		AlternateURLs:        c.Websocket.GetAlternateURLs(),
 		ProxyURL:             c.Websocket.GetProxyAddress(),
 		Verbose:              c.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	c.Websocket.Orderbook.Setup(
@@ -215,14 +221,14 @@ func (c *COINUT) Run() {
 		return
 	}
 
-	err := c.UpdateTradablePairs(forceUpdate)
+	err := c.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", c.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (c *COINUT) FetchTradablePairs(asset asset.Item) ([]string, error) {
+func (c *COINUT) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
 	var instruments map[string][]InstrumentBase
 	var resp Instruments
 	var err error
@@ -232,7 +238,7 @@ func (c *COINUT) FetchTradablePairs(asset asset.Item) ([]string, error) {
 			return nil, err
 		}
 	} else {
-		resp, err = c.GetInstruments()
+		resp, err = c.GetInstruments(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -250,8 +256,8 @@ func (c *COINUT) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (c *COINUT) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := c.FetchTradablePairs(asset.Spot)
+func (c *COINUT) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := c.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -262,7 +268,7 @@ func (c *COINUT) UpdateTradablePairs(forceUpdate bool) error {
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // COINUT exchange
-func (c *COINUT) UpdateAccountInfo() (account.Holdings, error) {
+func (c *COINUT) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 	var bal *UserBalance
 	var err error
@@ -274,7 +280,7 @@ func (c *COINUT) UpdateAccountInfo() (account.Holdings, error) {
 		}
 		bal = resp
 	} else {
-		bal, err = c.GetUserBalance()
+		bal, err = c.GetUserBalance(ctx)
 		if err != nil {
 			return info, err
 		}
@@ -283,59 +289,59 @@ func (c *COINUT) UpdateAccountInfo() (account.Holdings, error) {
 	var balances = []account.Balance{
 		{
 			CurrencyName: currency.BCH,
-			TotalValue:   bal.BCH,
+			TotalValue:   decimal.NewFromFloat(bal.BCH),
 		},
 		{
 			CurrencyName: currency.BTC,
-			TotalValue:   bal.BTC,
+			TotalValue:   decimal.NewFromFloat(bal.BTC),
 		},
 		{
 			CurrencyName: currency.BTG,
-			TotalValue:   bal.BTG,
+			TotalValue:   decimal.NewFromFloat(bal.BTG),
 		},
 		{
 			CurrencyName: currency.CAD,
-			TotalValue:   bal.CAD,
+			TotalValue:   decimal.NewFromFloat(bal.CAD),
 		},
 		{
 			CurrencyName: currency.ETC,
-			TotalValue:   bal.ETC,
+			TotalValue:   decimal.NewFromFloat(bal.ETC),
 		},
 		{
 			CurrencyName: currency.ETH,
-			TotalValue:   bal.ETH,
+			TotalValue:   decimal.NewFromFloat(bal.ETH),
 		},
 		{
 			CurrencyName: currency.LCH,
-			TotalValue:   bal.LCH,
+			TotalValue:   decimal.NewFromFloat(bal.LCH),
 		},
 		{
 			CurrencyName: currency.LTC,
-			TotalValue:   bal.LTC,
+			TotalValue:   decimal.NewFromFloat(bal.LTC),
 		},
 		{
 			CurrencyName: currency.MYR,
-			TotalValue:   bal.MYR,
+			TotalValue:   decimal.NewFromFloat(bal.MYR),
 		},
 		{
 			CurrencyName: currency.SGD,
-			TotalValue:   bal.SGD,
+			TotalValue:   decimal.NewFromFloat(bal.SGD),
 		},
 		{
 			CurrencyName: currency.USD,
-			TotalValue:   bal.USD,
+			TotalValue:   decimal.NewFromFloat(bal.USD),
 		},
 		{
 			CurrencyName: currency.USDT,
-			TotalValue:   bal.USDT,
+			TotalValue:   decimal.NewFromFloat(bal.USDT),
 		},
 		{
 			CurrencyName: currency.XMR,
-			TotalValue:   bal.XMR,
+			TotalValue:   decimal.NewFromFloat(bal.XMR),
 		},
 		{
 			CurrencyName: currency.ZEC,
-			TotalValue:   bal.ZEC,
+			TotalValue:   decimal.NewFromFloat(bal.ZEC),
 		},
 	}
 	info.Exchange = c.Name
@@ -352,19 +358,19 @@ func (c *COINUT) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (c *COINUT) FetchAccountInfo() (account.Holdings, error) {
+func (c *COINUT) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(c.Name)
 	if err != nil {
-		return c.UpdateAccountInfo()
+		return c.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (c *COINUT) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (c *COINUT) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	err := c.loadInstrumentsIfNotLoaded()
+	err := c.loadInstrumentsIfNotLoaded(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -375,7 +381,7 @@ func (c *COINUT) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 		return tickerPrice, errors.New("unable to lookup instrument ID")
 	}
 	var tick Ticker
-	tick, err = c.GetInstrumentTicker(instID)
+	tick, err = c.GetInstrumentTicker(ctx, instID)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -387,7 +393,7 @@ func (c *COINUT) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 		Ask:         tick.LowestSell,
 		Volume:      tick.Volume24,
 		Pair:        p,
-		LastUpdated: time.Unix(0, tick.Timestamp),
+		LastUpdated: convert.TimeFromUnix(tick.Timestamp, time.Microsecond),
 	}
 	err = ticker.ProcessTicker(c.Name, tickerPrice, assetType)
 	if err != nil {
@@ -398,27 +404,27 @@ func (c *COINUT) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (c *COINUT) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (c *COINUT) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(c.Name, p, assetType)
 	if err != nil {
-		return c.UpdateTicker(p, assetType)
+		return c.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (c *COINUT) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (c *COINUT) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(c.Name, p, assetType)
 	if err != nil {
-		return c.UpdateOrderbook(p, assetType)
+		return c.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (c *COINUT) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (c *COINUT) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	err := c.loadInstrumentsIfNotLoaded()
+	err := c.loadInstrumentsIfNotLoaded(ctx)
 	if err != nil {
 		return orderBook, err
 	}
@@ -429,7 +435,7 @@ func (c *COINUT) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 		return orderBook, errLookupInstrumentID
 	}
 
-	orderbookNew, err := c.GetInstrumentOrderbook(instID, 200)
+	orderbookNew, err := c.GetInstrumentOrderbook(ctx, instID, 200)
 	if err != nil {
 		return orderBook, err
 	}
@@ -456,17 +462,40 @@ func (c *COINUT) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (c *COINUT) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (c *COINUT) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (c *COINUT) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (c *COINUT) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (c *COINUT) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (c *COINUT) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (c *COINUT) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (c *COINUT) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (c *COINUT) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
+func (c *COINUT) SubmitOrder(ctx context.Context, o *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	var err error
 	if _, err = strconv.Atoi(o.ClientID); err != nil {
@@ -483,8 +512,8 @@ func (c *COINUT) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 		response, err = c.wsSubmitOrder(&WsSubmitOrderParameters{
 			Currency: o.Pair,
 			Side:     o.OrderSide,
-			Amount:   o.Amount,
-			Price:    o.Price,
+			Amount:   o.Amount.Float64(),
+			Price:    o.Price.Float64(),
 		})
 		if err != nil {
 			return submitOrderResponse, err
@@ -492,7 +521,7 @@ func (c *COINUT) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 		submitOrderResponse.OrderID = strconv.FormatInt(response.OrderID, 10)
 		submitOrderResponse.IsOrderPlaced = true
 	} else {
-		err = c.loadInstrumentsIfNotLoaded()
+		err = c.loadInstrumentsIfNotLoaded(ctx)
 		if err != nil {
 			return submitOrderResponse, err
 		}
@@ -511,7 +540,7 @@ func (c *COINUT) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 			return submitOrderResponse, err
 		}
 		clientIDUint := uint32(clientIDInt)
-		APIResponse, err = c.NewOrder(currencyID, o.Amount, o.Price,
+		APIResponse, err = c.NewOrder(ctx, currencyID, o.Amount.Float64(), o.Price.Float64(),
 			isBuyOrder, clientIDUint)
 		if err != nil {
 			return submitOrderResponse, err
@@ -538,13 +567,13 @@ func (c *COINUT) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (c *COINUT) ModifyOrder(action *order.Modify) (string, error) {
+func (c *COINUT) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (c *COINUT) CancelOrder(o *order.Cancel) error {
-	err := c.loadInstrumentsIfNotLoaded()
+func (c *COINUT) CancelOrder(ctx context.Context, o *order.Cancel) error {
+	err := c.loadInstrumentsIfNotLoaded(ctx)
 	if err != nil {
 		return err
 	}
@@ -573,7 +602,7 @@ func (c *COINUT) CancelOrder(o *order.Cancel) error {
 		if currencyID == 0 {
 			return errLookupInstrumentID
 		}
-		_, err = c.CancelExistingOrder(currencyID, orderIDInt)
+		_, err = c.CancelExistingOrder(ctx, currencyID, orderIDInt)
 		if err != nil {
 			return err
 		}
@@ -583,9 +612,9 @@ func (c *COINUT) CancelOrder(o *order.Cancel) error {
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (c *COINUT) CancelAllOrders(details *order.Cancel) (order.CancelAllResponse, error) {
+func (c *COINUT) CancelAllOrders(ctx context.Context, details *order.Cancel) (order.CancelAllResponse, error) {
 	var cancelAllOrdersResponse order.CancelAllResponse
-	err := c.loadInstrumentsIfNotLoaded()
+	err := c.loadInstrumentsIfNotLoaded(ctx)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -618,7 +647,7 @@ func (c *COINUT) CancelAllOrders(details *order.Cancel) (order.CancelAllResponse
 		ids := c.instrumentMap.GetInstrumentIDs()
 		for x := range ids {
 			if ids[x] == c.instrumentMap.LookupID(c.FormatExchangeCurrency(details.CurrencyPair, asset.Spot).String()) {
-				openOrders, err := c.GetOpenOrders(ids[x])
+				openOrders, err := c.GetOpenOrders(ctx, ids[x])
 				if err != nil {
 					return cancelAllOrdersResponse, err
 				}
@@ -636,7 +665,7 @@ func (c *COINUT) CancelAllOrders(details *order.Cancel) (order.CancelAllResponse
 		}
 
 		if len(allTheOrdersToCancel) > 0 {
-			resp, err := c.CancelOrders(allTheOrdersToCancel)
+			resp, err := c.CancelOrders(ctx, allTheOrdersToCancel)
 			if err != nil {
 				return cancelAllOrdersResponse, err
 			}
@@ -653,30 +682,30 @@ func (c *COINUT) CancelAllOrders(details *order.Cancel) (order.CancelAllResponse
 }
 
 // GetOrderInfo returns information on a current open order
-func (c *COINUT) GetOrderInfo(orderID string) (order.Detail, error) {
+func (c *COINUT) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	return order.Detail{}, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (c *COINUT) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (c *COINUT) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (c *COINUT) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (c *COINUT) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (c *COINUT) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (c *COINUT) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (c *COINUT) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (c *COINUT) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -686,7 +715,7 @@ func (c *COINUT) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (c *COINUT) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (c *COINUT) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !c.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -695,8 +724,8 @@ func (c *COINUT) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (c *COINUT) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	err := c.loadInstrumentsIfNotLoaded()
+func (c *COINUT) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	err := c.loadInstrumentsIfNotLoaded(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -723,12 +752,12 @@ func (c *COINUT) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 					ID:              strconv.FormatInt(openOrders.Orders[i].OrderID, 10),
 					CurrencyPair:    c.FormatExchangeCurrency(currency.NewPairFromString(currenciesToCheck[x]), asset.Spot),
 					OrderSide:       order.Side(openOrders.Orders[i].Side),
-					OrderDate:       time.Unix(0, openOrders.Orders[i].Timestamp),
+					OrderDate:       convert.TimeFromUnix(openOrders.Orders[i].Timestamp, time.Microsecond),
 					Status:          order.Active,
-					Price:           openOrders.Orders[i].Price,
-					Amount:          openOrders.Orders[i].Qty,
-					ExecutedAmount:  openOrders.Orders[i].Qty - openOrders.Orders[i].OpenQty,
-					RemainingAmount: openOrders.Orders[i].OpenQty,
+					Price:           decimal.NewFromFloat(openOrders.Orders[i].Price),
+					Amount:          decimal.NewFromFloat(openOrders.Orders[i].Qty),
+					ExecutedAmount:  decimal.NewFromFloat(openOrders.Orders[i].Qty).Sub(decimal.NewFromFloat(openOrders.Orders[i].OpenQty)),
+					RemainingAmount: decimal.NewFromFloat(openOrders.Orders[i].OpenQty),
 				})
 			}
 		}
@@ -750,7 +779,7 @@ func (c *COINUT) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 		}
 
 		for x := range instrumentsToUse {
-			openOrders, err := c.GetOpenOrders(instrumentsToUse[x])
+			openOrders, err := c.GetOpenOrders(ctx, instrumentsToUse[x])
 			if err != nil {
 				return nil, err
 			}
@@ -760,11 +789,11 @@ func (c *COINUT) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 					c.GetEnabledPairs(asset.Spot),
 					c.GetPairFormat(asset.Spot, true))
 				orderSide := order.Side(strings.ToUpper(openOrders.Orders[y].Side))
-				orderDate := time.Unix(openOrders.Orders[y].Timestamp, 0)
+				orderDate := convert.TimeFromUnix(openOrders.Orders[y].Timestamp, time.Microsecond)
 				orders = append(orders, order.Detail{
 					ID:           strconv.FormatInt(openOrders.Orders[y].OrderID, 10),
-					Amount:       openOrders.Orders[y].Quantity,
-					Price:        openOrders.Orders[y].Price,
+					Amount:       decimal.NewFromFloat(openOrders.Orders[y].Quantity),
+					Price:        decimal.NewFromFloat(openOrders.Orders[y].Price),
 					Exchange:     c.Name,
 					OrderSide:    orderSide,
 					OrderDate:    orderDate,
@@ -781,8 +810,8 @@ func (c *COINUT) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (c *COINUT) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	err := c.loadInstrumentsIfNotLoaded()
+func (c *COINUT) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	err := c.loadInstrumentsIfNotLoaded(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -801,12 +830,12 @@ func (c *COINUT) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 						ID:              strconv.FormatInt(trades.Trades[x].OrderID, 10),
 						CurrencyPair:    currency.NewPairFromString(curr),
 						OrderSide:       order.Side(trades.Trades[x].Side),
-						OrderDate:       time.Unix(0, trades.Trades[x].Timestamp),
+						OrderDate:       convert.TimeFromUnix(trades.Trades[x].Timestamp, time.Microsecond),
 						Status:          order.Filled,
-						Price:           trades.Trades[x].Price,
-						Amount:          trades.Trades[x].Qty,
-						ExecutedAmount:  trades.Trades[x].Qty,
-						RemainingAmount: trades.Trades[x].OpenQty,
+						Price:           decimal.NewFromFloat(trades.Trades[x].Price),
+						Amount:          decimal.NewFromFloat(trades.Trades[x].Qty),
+						ExecutedAmount:  decimal.NewFromFloat(trades.Trades[x].Qty),
+						RemainingAmount: decimal.NewFromFloat(trades.Trades[x].OpenQty),
 					})
 				}
 				if len(trades.Trades) < 100 {
@@ -833,7 +862,7 @@ func (c *COINUT) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 			return nil, errors.New("no instrument IDs to use")
 		}
 		for x := range instrumentsToUse {
-			orders, err := c.GetTradeHistory(instrumentsToUse[x], -1, -1)
+			orders, err := c.GetTradeHistory(ctx, instrumentsToUse[x], -1, -1)
 			if err != nil {
 				return nil, err
 			}
@@ -843,11 +872,11 @@ func (c *COINUT) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 					c.GetEnabledPairs(asset.Spot),
 					c.GetPairFormat(asset.Spot, true))
 				orderSide := order.Side(strings.ToUpper(orders.Trades[y].Order.Side))
-				orderDate := time.Unix(orders.Trades[y].Order.Timestamp, 0)
+				orderDate := convert.TimeFromUnix(orders.Trades[y].Order.Timestamp, time.Microsecond)
 				allOrders = append(allOrders, order.Detail{
 					ID:           strconv.FormatInt(orders.Trades[y].Order.OrderID, 10),
-					Amount:       orders.Trades[y].Order.Quantity,
-					Price:        orders.Trades[y].Order.Price,
+					Amount:       decimal.NewFromFloat(orders.Trades[y].Order.Quantity),
+					Price:        decimal.NewFromFloat(orders.Trades[y].Order.Price),
 					Exchange:     c.Name,
 					OrderSide:    orderSide,
 					OrderDate:    orderDate,
@@ -862,18 +891,16 @@ func (c *COINUT) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 	return allOrders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (c *COINUT) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	c.Websocket.SubscribeToChannels(channels)
-	return nil
+	return c.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (c *COINUT) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	c.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return c.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -886,7 +913,7 @@ func (c *COINUT) AuthenticateWebsocket() error {
 	return c.wsAuthenticate()
 }
 
-func (c *COINUT) loadInstrumentsIfNotLoaded() error {
+func (c *COINUT) loadInstrumentsIfNotLoaded(ctx context.Context) error {
 	if !c.instrumentMap.IsLoaded() {
 		if c.Websocket.IsConnected() {
 			_, err := c.WsGetInstruments()
@@ -894,7 +921,7 @@ func (c *COINUT) loadInstrumentsIfNotLoaded() error {
 				return err
 			}
 		} else {
-			err := c.SeedInstruments()
+			err := c.SeedInstruments(ctx)
 			if err != nil {
 				return err
 			}
@@ -905,7 +932,12 @@ func (c *COINUT) loadInstrumentsIfNotLoaded() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (c *COINUT) ValidateCredentials() error {
-	_, err := c.UpdateAccountInfo()
+func (c *COINUT) ValidateCredentials(ctx context.Context) error {
+	_, err := c.UpdateAccountInfo(ctx)
 	return c.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (c *COINUT) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -0,0 +1,180 @@
+package defi
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+)
+
+// Venue is an on-chain exchange which can be quoted read only
+type Venue interface {
+	GetName() string
+	GetQuote(ctx context.Context, from, to currency.Code, amount float64) (Quote, error)
+	GetPools(ctx context.Context, p currency.Pair) ([]Pool, error)
+}
+
+// defaultTokens are the Ethereum mainnet tokens quoted by default
+var defaultTokens = []Token{
+	{Symbol: "WETH", Address: "0xC02aaA39b223FE8D0A0e5C4F27eAD9083C756Cc2", Decimals: 18},
+	{Symbol: "WBTC", Address: "0x2260FAC5E5542a773Aa44fBCfeDf7C193bc2C599", Decimals: 8},
+	{Symbol: "USDC", Address: "0xA0b86991c6218b36c1d19D4a2e9Eb0cE3606eB48", Decimals: 6},
+	{Symbol: "USDT", Address: "0xdAC17F958D2ee523a2206206994597C13D831ec7", Decimals: 6},
+	{Symbol: "DAI", Address: "0x6B175474E89094C44Da98b954EedeAC495271d0F", Decimals: 18},
+}
+
+// NewVenue returns the venue of the settings name
+func NewVenue(s *Settings) (Venue, error) {
+	switch {
+	case strings.EqualFold(s.Name, UniswapV3Name):
+		return NewUniswapV3(s), nil
+	case strings.EqualFold(s.Name, OneInchName):
+		return NewOneInch(s), nil
+	default:
+		return nil, fmt.Errorf("unsupported defi venue %s", s.Name)
+	}
+}
+
+// tokenList maps currencies to the tokens quoted for them
+type tokenList map[string]Token
+
+// newTokenList returns the default tokens of a chain along with the
+// configured tokens, which take precedence
+func newTokenList(chainID int64, tokens []Token) tokenList {
+	l := make(tokenList)
+	if chainID == EthereumChainID {
+		for i := range defaultTokens {
+			l.add(defaultTokens[i])
+		}
+	}
+	for i := range tokens {
+		l.add(tokens[i])
+	}
+	return l
+}
+
+// add adds a token, wrapped tokens are also added for their native currency
+func (l tokenList) add(t Token) {
+	symbol := strings.ToUpper(t.Symbol)
+	l[symbol] = t
+	if native := unwrapped(symbol); native != symbol {
+		l[native] = t
+	}
+}
+
+// get returns the token of a currency
+func (l tokenList) get(c currency.Code) (Token, error) {
+	t, ok := l[c.Upper().String()]
+	if !ok {
+		return Token{}, fmt.Errorf("%s %w", c, errTokenNotFound)
+	}
+	return t, nil
+}
+
+// unwrapped returns the native currency of a wrapped token symbol
+func unwrapped(symbol string) string {
+	switch symbol {
+	case "WETH":
+		return currency.ETH.String()
+	case "WBTC":
+		return currency.BTC.String()
+	}
+	return symbol
+}
+
+// toBaseUnits converts an amount to an integer amount of a token's smallest
+// unit
+func toBaseUnits(amount float64, decimals int) string {
+	f := new(big.Float).SetFloat64(amount)
+	f.Mul(f, new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	i, _ := f.Int(nil)
+	return i.String()
+}
+
+// fromBaseUnits converts an integer amount of a token's smallest unit to an
+// amount
+func fromBaseUnits(amount string, decimals int) (float64, error) {
+	i, ok := new(big.Int).SetString(amount, 10)
+	if !ok {
+		return 0, fmt.Errorf("invalid token amount %s", amount)
+	}
+	f := new(big.Float).Quo(new(big.Float).SetInt(i),
+		new(big.Float).SetInt(new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)))
+	v, _ := f.Float64()
+	return v, nil
+}
+
+// client holds the API details shared by the venues
+type client struct {
+	name      string
+	apiURL    string
+	apiKey    string
+	chainID   int64
+	verbose   bool
+	tokens    tokenList
+	requester *request.Requester
+}
+
+// newClient returns a client for the settings, the default API URL being
+// used when none is set
+func newClient(name, defaultURL string, s *Settings) client {
+	apiURL := s.APIURL
+	if apiURL == "" {
+		apiURL = defaultURL
+	}
+	chainID := s.ChainID
+	if chainID == 0 {
+		chainID = EthereumChainID
+	}
+	return client{
+		name:    name,
+		apiURL:  strings.TrimSuffix(apiURL, "/"),
+		apiKey:  s.APIKey,
+		chainID: chainID,
+		verbose: s.Verbose,
+		tokens:  newTokenList(chainID, s.Tokens),
+		requester: request.New(name,
+			common.NewHTTPClientWithTimeout(defaultTimeout),
+			request.NewBasicRateLimit(rateLimitInterval, rateLimitRequests)),
+	}
+}
+
+// GetName returns the venue name
+func (c *client) GetName() string {
+	return c.name
+}
+
+// sendRequest sends a request to the venue's API, authenticated with a bearer
+// token when an API key is set. A body is sent as JSON. Requests only read
+// data so are always safe to retry
+func (c *client) sendRequest(ctx context.Context, method, path string, body, result interface{}) error {
+	headers := make(map[string]string)
+	if c.apiKey != "" {
+		headers["Authorization"] = "Bearer " + c.apiKey
+	}
+	var payload io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		headers["Content-Type"] = "application/json"
+		payload = bytes.NewReader(encoded)
+	}
+	return c.requester.SendPayload(ctx, &request.Item{
+		Method:     method,
+		Path:       c.apiURL + path,
+		Headers:    headers,
+		Body:       payload,
+		Result:     result,
+		Verbose:    c.verbose,
+		Idempotent: true,
+	})
+}
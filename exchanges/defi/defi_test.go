@@ -0,0 +1,119 @@
+package defi
+
+import (
+	"context"
+	"io/ioutil"
+	"math"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+var usdc = currency.NewCode("USDC")
+
+func TestNewVenue(t *testing.T) {
+	v, err := NewVenue(&Settings{Name: "uniswapv3"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if v.GetName() != UniswapV3Name {
+		t.Errorf("expected %s received %s", UniswapV3Name, v.GetName())
+	}
+	if _, err = NewVenue(&Settings{Name: "sushiswap"}); err == nil {
+		t.Error("expected error on unsupported venue")
+	}
+}
+
+func TestTokenList(t *testing.T) {
+	l := newTokenList(EthereumChainID, []Token{{Symbol: "uni", Address: "0x1f9840a85d5aF5bf1D1762F925BDADdC4201F984", Decimals: 18}})
+	tok, err := l.get(currency.ETH)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tok.Symbol != "WETH" {
+		t.Errorf("expected ETH to be quoted as WETH received %s", tok.Symbol)
+	}
+	if _, err = l.get(currency.NewCode("UNI")); err != nil {
+		t.Error(err)
+	}
+	if _, err = newTokenList(10, nil).get(currency.ETH); err == nil {
+		t.Error("expected no default tokens off mainnet")
+	}
+}
+
+func TestBaseUnits(t *testing.T) {
+	if s := toBaseUnits(1.5, 6); s != "1500000" {
+		t.Errorf("expected 1500000 received %s", s)
+	}
+	f, err := fromBaseUnits("2500000000000000000", 18)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f != 2.5 {
+		t.Errorf("expected 2.5 received %v", f)
+	}
+	if _, err = fromBaseUnits("1.5", 18); err == nil {
+		t.Error("expected error on invalid amount")
+	}
+}
+
+func TestUniswapV3GetQuote(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		if !strings.Contains(string(body), "0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2") {
+			t.Errorf("expected lower case WETH address in query %s", body)
+		}
+		if r.Header.Get("Authorization") != "Bearer key" {
+			t.Error("expected API key")
+		}
+		w.Write([]byte(`{"data":{"pools":[{"id":"0x88e6","feeTier":"500","liquidity":"1000000000000000000",` +
+			`"token0Price":"2000","token1Price":"0.0005","totalValueLockedUSD":"1000000","volumeUSD":"50000",` +
+			`"token0":{"id":"0xa0b86991c6218b36c1d19d4a2e9eb0ce3606eb48"},"token1":{"id":"0xc02aaa39b223fe8d0a0e5c4f27ead9083c756cc2"}}]}}`))
+	}))
+	defer s.Close()
+
+	u := NewUniswapV3(&Settings{APIURL: s.URL, APIKey: "key"})
+	pools, err := u.GetPools(context.Background(), currency.NewPair(currency.ETH, usdc))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(pools) != 1 || pools[0].Price != 2000 || pools[0].FeeRate != 0.0005 {
+		t.Fatalf("unexpected pools %+v", pools)
+	}
+
+	q, err := u.GetQuote(context.Background(), usdc, currency.ETH, 1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if math.Abs(q.AmountOut-0.49975) > 1e-12 {
+		t.Errorf("unexpected quote %+v", q)
+	}
+	if _, err = u.GetQuote(context.Background(), usdc, currency.ETH, 0); err == nil {
+		t.Error("expected error quoting nothing")
+	}
+}
+
+func TestOneInchGetQuote(t *testing.T) {
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/1/quote" || r.URL.Query().Get("amount") != "2000000000000000000" {
+			t.Errorf("unexpected request %s", r.URL)
+		}
+		w.Write([]byte(`{"dstAmount":"3990000000","gas":180000}`))
+	}))
+	defer s.Close()
+
+	o := NewOneInch(&Settings{APIURL: s.URL})
+	q, err := o.GetQuote(context.Background(), currency.ETH, currency.USDT, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q.AmountOut != 3990 || q.Price != 1995 || q.Gas != 180000 {
+		t.Errorf("unexpected quote %+v", q)
+	}
+	if _, err = o.GetPools(context.Background(), currency.NewPair(currency.ETH, currency.USDT)); err == nil {
+		t.Error("expected pools to be unsupported")
+	}
+}
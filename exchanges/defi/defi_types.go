@@ -0,0 +1,78 @@
+package defi
+
+import (
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Venue names
+const (
+	UniswapV3Name = "UniswapV3"
+	OneInchName   = "1inch"
+)
+
+// EthereumChainID is the chain ID of Ethereum mainnet, the chain the default
+// token list is for
+const EthereumChainID = 1
+
+const (
+	defaultTimeout      = 15 * time.Second
+	rateLimitInterval   = time.Second
+	rateLimitRequests   = 1
+	defaultPoolsFetched = 10
+)
+
+var (
+	errTokenNotFound     = errors.New("token not found")
+	errNoPools           = errors.New("no pools found")
+	errInvalidQuoteInput = errors.New("amount must be greater than 0")
+)
+
+// Settings defines a venue's API and the tokens it can quote in addition to
+// the default tokens of its chain
+type Settings struct {
+	Name    string
+	APIURL  string
+	APIKey  string
+	ChainID int64
+	Tokens  []Token
+	Verbose bool
+}
+
+// Token is an ERC20 token. Wrapped tokens are quoted for their native
+// currency, so WETH is quoted for ETH
+type Token struct {
+	Symbol   string
+	Address  string
+	Decimals int
+}
+
+// Quote is the amount received for swapping an amount of one currency into
+// another on a venue, net of the venue's fees but not of gas
+type Quote struct {
+	Venue     string
+	From      currency.Code
+	To        currency.Code
+	AmountIn  float64
+	AmountOut float64
+	// Price is the amount received per unit spent
+	Price float64
+	// Gas is the estimated gas units used by the swap, zero when unknown
+	Gas       int64
+	Timestamp time.Time
+}
+
+// Pool is an on-chain liquidity pool. Its price is in the quote currency of
+// the pair it was requested for
+type Pool struct {
+	Venue     string
+	Address   string
+	Pair      currency.Pair
+	FeeRate   float64
+	Liquidity float64
+	Price     float64
+	TVLUSD    float64
+	VolumeUSD float64
+}
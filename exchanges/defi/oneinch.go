@@ -0,0 +1,78 @@
+package defi
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// oneInchAPIURL is the 1inch swap API, which requires an API key
+const oneInchAPIURL = "https://api.1inch.dev/swap/v6.0"
+
+// OneInch quotes swaps routed by the 1inch aggregator across the liquidity
+// sources of a chain
+type OneInch struct {
+	client
+}
+
+// NewOneInch returns a 1inch venue
+func NewOneInch(s *Settings) *OneInch {
+	return &OneInch{client: newClient(OneInchName, oneInchAPIURL, s)}
+}
+
+// GetQuote returns the amount received swapping through the aggregator's
+// best route, net of the fees of the pools routed through
+func (o *OneInch) GetQuote(ctx context.Context, from, to currency.Code, amount float64) (Quote, error) {
+	if amount <= 0 {
+		return Quote{}, errInvalidQuoteInput
+	}
+	src, err := o.tokens.get(from)
+	if err != nil {
+		return Quote{}, err
+	}
+	dst, err := o.tokens.get(to)
+	if err != nil {
+		return Quote{}, err
+	}
+
+	vals := url.Values{}
+	vals.Set("src", src.Address)
+	vals.Set("dst", dst.Address)
+	vals.Set("amount", toBaseUnits(amount, src.Decimals))
+	vals.Set("includeGas", "true")
+
+	var resp struct {
+		DstAmount string `json:"dstAmount"`
+		Gas       int64  `json:"gas"`
+	}
+	err = o.sendRequest(ctx, http.MethodGet,
+		common.EncodeURLValues(fmt.Sprintf("/%d/quote", o.chainID), vals), nil, &resp)
+	if err != nil {
+		return Quote{}, err
+	}
+	out, err := fromBaseUnits(resp.DstAmount, dst.Decimals)
+	if err != nil {
+		return Quote{}, err
+	}
+	return Quote{
+		Venue:     o.name,
+		From:      from,
+		To:        to,
+		AmountIn:  amount,
+		AmountOut: out,
+		Price:     out / amount,
+		Gas:       resp.Gas,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+// GetPools isn't supported as the aggregator routes through other venues'
+// pools
+func (o *OneInch) GetPools(ctx context.Context, p currency.Pair) ([]Pool, error) {
+	return nil, common.ErrFunctionNotSupported
+}
@@ -0,0 +1,138 @@
+package defi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// uniswapV3SubgraphURL is the Uniswap v3 Ethereum mainnet subgraph served by
+// The Graph's gateway, which requires an API key
+const uniswapV3SubgraphURL = "https://gateway.thegraph.com/api/subgraphs/id/5zvR82QoaXYFyDEKLZ9t6v9adgnptxYpKpSbxtgVENFV"
+
+// uniswapFeeTierUnit is the fee tier of a pool paying all of a swap in fees,
+// fee tiers being in hundredths of a basis point
+const uniswapFeeTierUnit = 1e6
+
+const uniswapPoolsQuery = `{pools(first: %d, orderBy: totalValueLockedUSD, orderDirection: desc, where: {token0_in: ["%[2]s", "%[3]s"], token1_in: ["%[2]s", "%[3]s"]}) {id feeTier liquidity token0Price token1Price totalValueLockedUSD volumeUSD token0 {id} token1 {id}}}`
+
+// UniswapV3 quotes Uniswap v3 pools from the Uniswap v3 subgraph
+type UniswapV3 struct {
+	client
+}
+
+// NewUniswapV3 returns a Uniswap v3 venue
+func NewUniswapV3(s *Settings) *UniswapV3 {
+	return &UniswapV3{client: newClient(UniswapV3Name, uniswapV3SubgraphURL, s)}
+}
+
+// GetPools returns the pools of a pair, most liquid first
+func (u *UniswapV3) GetPools(ctx context.Context, p currency.Pair) ([]Pool, error) {
+	base, err := u.tokens.get(p.Base)
+	if err != nil {
+		return nil, err
+	}
+	quote, err := u.tokens.get(p.Quote)
+	if err != nil {
+		return nil, err
+	}
+	baseAddress := strings.ToLower(base.Address)
+	quoteAddress := strings.ToLower(quote.Address)
+
+	req := struct {
+		Query string `json:"query"`
+	}{
+		Query: fmt.Sprintf(uniswapPoolsQuery, defaultPoolsFetched, baseAddress, quoteAddress),
+	}
+	var resp uniswapPoolsResponse
+	err = u.sendRequest(ctx, http.MethodPost, "", req, &resp)
+	if err != nil {
+		return nil, err
+	}
+	if len(resp.Errors) > 0 {
+		return nil, errors.New(resp.Errors[0].Message)
+	}
+
+	pools := make([]Pool, 0, len(resp.Data.Pools))
+	for i := range resp.Data.Pools {
+		pools = append(pools, u.pool(&resp.Data.Pools[i], p, baseAddress))
+	}
+	return pools, nil
+}
+
+// pool converts a subgraph pool, token1Price being the amount of token1 per
+// token0
+func (u *UniswapV3) pool(p *uniswapPool, pair currency.Pair, baseAddress string) Pool {
+	price := p.Token1Price
+	if !strings.EqualFold(p.Token0.ID, baseAddress) {
+		price = p.Token0Price
+	}
+	return Pool{
+		Venue:     u.name,
+		Address:   p.ID,
+		Pair:      pair,
+		FeeRate:   p.FeeTier / uniswapFeeTierUnit,
+		Liquidity: p.Liquidity,
+		Price:     price,
+		TVLUSD:    p.TotalValueLockedUSD,
+		VolumeUSD: p.VolumeUSD,
+	}
+}
+
+// GetQuote returns the amount received swapping through the most liquid
+// pool, priced at the pool's current price less its fee. Slippage isn't
+// included, so quotes are only accurate for amounts small relative to the
+// pool's liquidity
+func (u *UniswapV3) GetQuote(ctx context.Context, from, to currency.Code, amount float64) (Quote, error) {
+	if amount <= 0 {
+		return Quote{}, errInvalidQuoteInput
+	}
+	pools, err := u.GetPools(ctx, currency.NewPair(from, to))
+	if err != nil {
+		return Quote{}, err
+	}
+	if len(pools) == 0 {
+		return Quote{}, fmt.Errorf("%s %s/%s %w", u.name, from, to, errNoPools)
+	}
+	price := pools[0].Price * (1 - pools[0].FeeRate)
+	return Quote{
+		Venue:     u.name,
+		From:      from,
+		To:        to,
+		AmountIn:  amount,
+		AmountOut: amount * price,
+		Price:     price,
+		Timestamp: time.Now(),
+	}, nil
+}
+
+type uniswapPoolsResponse struct {
+	Data struct {
+		Pools []uniswapPool `json:"pools"`
+	} `json:"data"`
+	Errors []struct {
+		Message string `json:"message"`
+	} `json:"errors"`
+}
+
+// uniswapPool is a subgraph pool, its numbers being encoded as strings
+type uniswapPool struct {
+	ID                  string  `json:"id"`
+	FeeTier             float64 `json:"feeTier,string"`
+	Liquidity           float64 `json:"liquidity,string"`
+	Token0Price         float64 `json:"token0Price,string"`
+	Token1Price         float64 `json:"token1Price,string"`
+	TotalValueLockedUSD float64 `json:"totalValueLockedUSD,string"`
+	VolumeUSD           float64 `json:"volumeUSD,string"`
+	Token0              struct {
+		ID string `json:"id"`
+	} `json:"token0"`
+	Token1 struct {
+		ID string `json:"id"`
+	} `json:"token1"`
+}
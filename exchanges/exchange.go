@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net"
@@ -13,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/log"
@@ -47,6 +49,35 @@ func (e *Base) SetHTTPClientTimeout(t time.Duration) {
 	e.Requester.HTTPClient.Timeout = t
 }
 
+// SetHTTPClientRetryPolicy sets how the exchanges failed HTTP requests are
+// retried
+func (e *Base) SetHTTPClientRetryPolicy(p request.RetryPolicy) {
+	e.checkAndInitRequester()
+	e.Requester.SetRetryPolicy(p)
+}
+
+// SetHTTPClientCircuitBreakerPolicy sets when the exchanges HTTP requests to a
+// failing endpoint fail fast
+func (e *Base) SetHTTPClientCircuitBreakerPolicy(p request.CircuitBreakerPolicy) {
+	e.checkAndInitRequester()
+	e.Requester.SetCircuitBreakerPolicy(p)
+}
+
+// SetHTTPClientPinningPolicy restricts the TLS certificates accepted for the
+// exchanges HTTP requests to those matching the policy
+func (e *Base) SetHTTPClientPinningPolicy(p request.PinningPolicy) error {
+	e.checkAndInitRequester()
+	return e.Requester.SetPinningPolicy(p)
+}
+
+// SetNonceStore persists the exchanges REST nonces in s so they keep
+// increasing across restarts. Nonces are tracked per API key so it must be
+// called after the credentials are set.
+func (e *Base) SetNonceStore(s nonce.Store) error {
+	e.checkAndInitRequester()
+	return e.Requester.Nonce.SetStore(s, nonce.Key(e.Name, e.API.Credentials.Key))
+}
+
 // SetHTTPClient sets exchanges HTTP client
 func (e *Base) SetHTTPClient(h *http.Client) {
 	e.checkAndInitRequester()
@@ -94,6 +125,19 @@ func (e *Base) SetClientProxyAddress(addr string) error {
 	return nil
 }
 
+// SetWebsocketProxyAddress sets a proxy address for websocket connections,
+// overriding the address set by SetClientProxyAddress
+func (e *Base) SetWebsocketProxyAddress(addr string) error {
+	if addr == "" || e.Websocket == nil || e.Websocket.GetProxyAddress() == addr {
+		return nil
+	}
+	if _, err := url.Parse(addr); err != nil {
+		return fmt.Errorf("exchange.go - setting websocket proxy address error %s",
+			err)
+	}
+	return e.Websocket.SetProxyAddress(addr)
+}
+
 // SetFeatureDefaults sets the exchanges default feature
 // support set
 func (e *Base) SetFeatureDefaults() {
@@ -435,6 +479,31 @@ func (e *Base) SetupDefaults(exch *config.ExchangeConfig) error {
 		e.SetHTTPClientTimeout(exch.HTTPTimeout)
 	}
 
+	if exch.HTTPRetry != nil {
+		e.SetHTTPClientRetryPolicy(request.RetryPolicy{
+			MaxRetries:     exch.HTTPRetry.MaxRetries,
+			InitialBackoff: exch.HTTPRetry.InitialBackoff,
+			MaxBackoff:     exch.HTTPRetry.MaxBackoff,
+		})
+	}
+
+	if exch.HTTPCircuitBreaker != nil {
+		e.SetHTTPClientCircuitBreakerPolicy(request.CircuitBreakerPolicy{
+			FailureThreshold: exch.HTTPCircuitBreaker.FailureThreshold,
+			Cooldown:         exch.HTTPCircuitBreaker.Cooldown,
+		})
+	}
+
+	if exch.HTTPTLSPinning != nil {
+		err := e.SetHTTPClientPinningPolicy(request.PinningPolicy{
+			PublicKeys:   exch.HTTPTLSPinning.PublicKeys,
+			Certificates: exch.HTTPTLSPinning.Certificates,
+		})
+		if err != nil {
+			return err
+		}
+	}
+
 	if exch.CurrencyPairs == nil {
 		exch.CurrencyPairs = new(currency.PairsManager)
 	}
@@ -447,7 +516,18 @@ func (e *Base) SetupDefaults(exch *config.ExchangeConfig) error {
 	e.SetFeatureDefaults()
 	e.SetAPIURL()
 	e.SetAPICredentialDefaults()
-	e.SetClientProxyAddress(exch.ProxyAddress)
+	err := e.SetAPIURLAlternates()
+	if err != nil {
+		return err
+	}
+	err = e.SetClientProxyAddress(exch.ProxyAddress)
+	if err != nil {
+		return err
+	}
+	err = e.SetWebsocketProxyAddress(exch.WebsocketProxyAddress)
+	if err != nil {
+		return err
+	}
 	e.BaseCurrencies = exch.BaseCurrencies
 
 	if e.Features.Supports.Websocket {
@@ -480,6 +560,24 @@ func (e *Base) AllowAuthenticatedRequest() bool {
 	return e.ValidateAPICredentials()
 }
 
+// SyncServerTime measures the offset of the exchange server's clock using
+// fetch, which returns the server time, so signed request timestamps match
+// it. Nothing is measured when authenticated requests aren't allowed.
+func (e *Base) SyncServerTime(ctx context.Context, fetch func(context.Context) (time.Time, error)) {
+	if e.Requester == nil || !e.AllowAuthenticatedRequest() {
+		return
+	}
+	offset, err := e.Requester.MeasureServerTime(ctx, fetch)
+	if err != nil {
+		log.Warnf(log.ExchangeSys, "%s unable to measure server clock offset: %v\n", e.Name, err)
+		return
+	}
+	if offset > serverTimeOffsetWarning || offset < -serverTimeOffsetWarning {
+		log.Warnf(log.ExchangeSys, "%s server clock is %v from the local clock, adjusting signed request timestamps\n",
+			e.Name, offset)
+	}
+}
+
 // ValidateAPICredentials validates the exchanges API credentials
 func (e *Base) ValidateAPICredentials() bool {
 	if e.API.CredentialsValidator.RequiresKey {
@@ -610,24 +708,48 @@ func (e *Base) SetAPIURL() error {
 		return fmt.Errorf("exchange %s: SetAPIURL error. URL vals are empty", e.Name)
 	}
 
-	checkInsecureEndpoint := func(endpoint string) {
-		if !strings.Contains(endpoint, "https") {
-			return
-		}
-		log.Warnf(log.ExchangeSys,
-			"%s is using HTTP instead of HTTPS [%s] for API functionality, an"+
-				" attacker could eavesdrop on this connection. Use at your"+
-				" own risk.",
-			e.Name, endpoint)
-	}
-
 	if e.Config.API.Endpoints.URL != config.APIURLNonDefaultMessage {
 		e.API.Endpoints.URL = e.Config.API.Endpoints.URL
-		checkInsecureEndpoint(e.API.Endpoints.URL)
+		e.checkInsecureEndpoint(e.API.Endpoints.URL)
 	}
 	if e.Config.API.Endpoints.URLSecondary != config.APIURLNonDefaultMessage {
 		e.API.Endpoints.URLSecondary = e.Config.API.Endpoints.URLSecondary
-		checkInsecureEndpoint(e.API.Endpoints.URLSecondary)
+		e.checkInsecureEndpoint(e.API.Endpoints.URLSecondary)
+	}
+	return nil
+}
+
+// checkInsecureEndpoint warns when an API endpoint doesn't use HTTPS
+func (e *Base) checkInsecureEndpoint(endpoint string) {
+	if strings.HasPrefix(endpoint, "https") {
+		return
+	}
+	log.Warnf(log.ExchangeSys,
+		"%s is using HTTP instead of HTTPS [%s] for API functionality, an"+
+			" attacker could eavesdrop on this connection. Use at your"+
+			" own risk.",
+		e.Name, endpoint)
+}
+
+// SetAPIURLAlternates registers the configured mirrors of the exchanges REST
+// and websocket APIs, which are failed over to when the API URLs are down
+func (e *Base) SetAPIURLAlternates() error {
+	if alternates := e.Config.API.Endpoints.URLAlternates; len(alternates) != 0 {
+		e.checkAndInitRequester()
+		err := e.Requester.SetMirrors(e.API.Endpoints.URL, alternates...)
+		if err != nil {
+			return err
+		}
+		for i := range alternates {
+			e.checkInsecureEndpoint(alternates[i])
+		}
+	}
+	if e.Websocket == nil {
+		return nil
+	}
+	err := e.Websocket.SetAlternateURLs(e.Config.API.Endpoints.WebsocketURLAlternates)
+	if err != nil {
+		return fmt.Errorf("exchange %s: %s", e.Name, err)
 	}
 	return nil
 }
@@ -780,3 +902,12 @@ func (e *Base) DisableRateLimiter() error {
 func (e *Base) EnableRateLimiter() error {
 	return e.Requester.EnableRateLimiter()
 }
+
+// MarginRatio returns the maintenance margin as a fraction of the position's
+// margin balance, the position is liquidated once it reaches 1
+func (m *MarginPosition) MarginRatio() float64 {
+	if m.Margin <= 0 {
+		return 1
+	}
+	return m.MaintenanceMargin / m.Margin
+}
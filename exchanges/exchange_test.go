@@ -1,6 +1,7 @@
 package exchange
 
 import (
+	"context"
 	"net/http"
 	"strings"
 	"testing"
@@ -124,6 +125,39 @@ func TestSetClientProxyAddress(t *testing.T) {
 	}
 }
 
+func TestSetWebsocketProxyAddress(t *testing.T) {
+	t.Parallel()
+
+	newBase := Base{
+		Name:      "rawr",
+		Requester: request.New("rawr", &http.Client{}, nil),
+		Websocket: wshandler.New(),
+	}
+	err := newBase.SetClientProxyAddress("http://rest.proxy")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = newBase.SetWebsocketProxyAddress(":invalid")
+	if err == nil {
+		t.Error("SetWebsocketProxyAddress parsed invalid URL")
+	}
+
+	err = newBase.SetWebsocketProxyAddress("socks5://ws.proxy")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if newBase.Websocket.GetProxyAddress() != "socks5://ws.proxy" {
+		t.Error("websocket proxy address not set")
+	}
+
+	// Setting the same address again is a no-op
+	err = newBase.SetWebsocketProxyAddress("socks5://ws.proxy")
+	if err != nil {
+		t.Error(err)
+	}
+}
+
 func TestSetFeatureDefaults(t *testing.T) {
 	t.Parallel()
 
@@ -1265,6 +1299,42 @@ func TestSetAPIURL(t *testing.T) {
 	}
 }
 
+func TestSetAPIURLAlternates(t *testing.T) {
+	t.Parallel()
+	tester := Base{Name: "test"}
+	tester.Config = new(config.ExchangeConfig)
+	tester.API.Endpoints.URL = "https://api.something.com"
+	tester.Websocket = wshandler.New()
+
+	err := tester.SetAPIURLAlternates()
+	if err != nil {
+		t.Error(err)
+	}
+
+	tester.Config.API.Endpoints.URLAlternates = []string{"https://api1.something.com"}
+	tester.Config.API.Endpoints.WebsocketURLAlternates = []string{"wss://ws1.something.com"}
+	err = tester.SetAPIURLAlternates()
+	if err != nil {
+		t.Error(err)
+	}
+	if len(tester.Websocket.GetAlternateURLs()) != 1 {
+		t.Error("websocket alternates not set")
+	}
+
+	tester.Config.API.Endpoints.URLAlternates = []string{"api2.something.com"}
+	err = tester.SetAPIURLAlternates()
+	if err == nil {
+		t.Error("expected error for invalid alternate URL")
+	}
+
+	tester.Config.API.Endpoints.URLAlternates = nil
+	tester.Config.API.Endpoints.WebsocketURLAlternates = []string{"https://ws1.something.com"}
+	err = tester.SetAPIURLAlternates()
+	if err == nil {
+		t.Error("expected error for invalid websocket alternate URL")
+	}
+}
+
 func BenchmarkSetAPIURL(b *testing.B) {
 	tester := Base{Name: "test"}
 
@@ -1468,3 +1538,37 @@ func TestGetAssetType(t *testing.T) {
 		t.Error("should be spot but is", a)
 	}
 }
+
+func TestSyncServerTime(t *testing.T) {
+	t.Parallel()
+	fetch := func(context.Context) (time.Time, error) {
+		return time.Now().Add(time.Minute), nil
+	}
+	b := Base{
+		Name:      "rawr",
+		Requester: request.New("rawr", &http.Client{}, nil),
+	}
+	b.LoadedByConfig = true
+	b.SyncServerTime(context.Background(), fetch)
+	if b.Requester.ServerTimeOffset() != 0 {
+		t.Error("expected no measurement without authenticated support")
+	}
+
+	b.SkipAuthCheck = true
+	b.SyncServerTime(context.Background(), fetch)
+	if d := b.Requester.ServerTimeOffset(); d < time.Minute-time.Second || d > time.Minute+time.Second {
+		t.Errorf("expected server time offset of a minute, got %v", d)
+	}
+}
+
+func TestMarginRatio(t *testing.T) {
+	t.Parallel()
+	m := MarginPosition{Margin: 2, MaintenanceMargin: 0.5}
+	if r := m.MarginRatio(); r != 0.25 {
+		t.Errorf("expected a margin ratio of 0.25, received %v", r)
+	}
+	m.Margin = -1
+	if r := m.MarginRatio(); r != 1 {
+		t.Errorf("expected a position without margin to be at liquidation, received %v", r)
+	}
+}
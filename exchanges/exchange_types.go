@@ -5,11 +5,16 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 )
 
+// serverTimeOffsetWarning is the measured server clock offset above which a
+// warning is logged
+const serverTimeOffsetWarning = time.Second
+
 // Endpoint authentication types
 const (
 	RestAuthentication      uint8 = 0
@@ -147,6 +152,69 @@ type FundHistory struct {
 	BankFrom          string
 }
 
+// APIKeyPermissions holds what an exchange API key is allowed to do
+type APIKeyPermissions struct {
+	Read     bool
+	Trade    bool
+	Withdraw bool
+	// IPWhitelist holds the addresses the key is restricted to, it is empty
+	// when the key is unrestricted or the exchange doesn't report it
+	IPWhitelist []string
+}
+
+// FundingPayment holds a funding payment made or received on a perpetual
+// futures position. A positive amount was received and a negative amount was
+// paid
+type FundingPayment struct {
+	Exchange  string
+	AssetType asset.Item
+	Pair      currency.Pair
+	Rate      float64
+	Amount    float64
+	Currency  currency.Code
+	Timestamp time.Time
+}
+
+// MarginPosition holds a leveraged position and the margin backing it, the
+// margin amounts are in the margin currency
+type MarginPosition struct {
+	Exchange  string
+	AssetType asset.Item
+	Pair      currency.Pair
+	// Size is the position size in contracts, negative when short
+	Size     float64
+	Currency currency.Code
+	// Margin is the margin balance of the position including unrealised
+	// profit and loss
+	Margin float64
+	// MaintenanceMargin is the margin balance below which the position is
+	// liquidated
+	MaintenanceMargin float64
+	MarkPrice         float64
+	LiquidationPrice  float64
+	// Isolated positions hold their own margin which can be added to, cross
+	// margined positions share the account balance
+	Isolated bool
+}
+
+// OpenInterest is the open interest and positioning of a derivatives
+// contract at a point in time
+type OpenInterest struct {
+	Exchange  string
+	AssetType asset.Item
+	Pair      currency.Pair
+	// Amount is the number of contracts open
+	Amount float64
+	// Value is the value of the open contracts in Currency, zero when not
+	// reported by the exchange
+	Value    float64
+	Currency currency.Code
+	// LongShortRatio is the ratio of accounts or positions long to those
+	// short, zero when not reported by the exchange
+	LongShortRatio float64
+	Timestamp      time.Time
+}
+
 // Features stores the supported and enabled features
 // for the exchange
 type Features struct {
@@ -173,6 +241,9 @@ type API struct {
 	AuthenticatedSupport          bool
 	AuthenticatedWebsocketSupport bool
 	PEMKeySupport                 bool
+	// KeyPermissions holds the verified permissions of the API key, nil when
+	// they have not been verified
+	KeyPermissions *APIKeyPermissions
 
 	Endpoints struct {
 		URL                 string
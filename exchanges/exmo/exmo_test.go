@@ -1,11 +1,13 @@
 package exmo
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -50,7 +52,7 @@ func TestMain(m *testing.M) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetTrades("BTC_USD")
+	_, err := e.GetTrades(context.Background(), "BTC_USD")
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -58,7 +60,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetOrderbook("BTC_USD")
+	_, err := e.GetOrderbook(context.Background(), "BTC_USD")
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -66,7 +68,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetTicker()
+	_, err := e.GetTicker(context.Background())
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -74,7 +76,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetPairSettings(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetPairSettings()
+	_, err := e.GetPairSettings(context.Background())
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -82,7 +84,7 @@ func TestGetPairSettings(t *testing.T) {
 
 func TestGetCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := e.GetCurrency()
+	_, err := e.GetCurrency(context.Background())
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -93,7 +95,7 @@ func TestGetUserInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := e.GetUserInfo()
+	_, err := e.GetUserInfo(context.Background())
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -104,7 +106,7 @@ func TestGetRequiredAmount(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip()
 	}
-	_, err := e.GetRequiredAmount("BTC_USD", 100)
+	_, err := e.GetRequiredAmount(context.Background(), "BTC_USD", 100)
 	if err != nil {
 		t.Errorf("Err: %s", err)
 	}
@@ -124,7 +126,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	e.GetFeeByType(feeBuilder)
+	e.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -265,7 +267,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := e.GetActiveOrders(&getOrdersRequest)
+	_, err := e.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -281,7 +283,7 @@ func TestGetOrderHistory(t *testing.T) {
 	currPair.Delimiter = "_"
 	getOrdersRequest.Currencies = []currency.Pair{currPair}
 
-	_, err := e.GetOrderHistory(&getOrdersRequest)
+	_, err := e.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -308,11 +310,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := e.SubmitOrder(orderSubmission)
+	response, err := e.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -333,7 +335,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := e.CancelOrder(orderCancellation)
+	err := e.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -355,7 +357,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := e.CancelAllOrders(orderCancellation)
+	resp, err := e.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -373,7 +375,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := e.ModifyOrder(&order.Modify{})
+	_, err := e.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -393,7 +395,7 @@ func TestWithdraw(t *testing.T) {
 		Address: core.BitcoinDonationAddress,
 	}
 
-	_, err := e.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := e.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -408,7 +410,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := e.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := e.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -420,7 +422,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := e.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := e.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -428,12 +430,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := e.GetDepositAddress(currency.LTC, "")
+		_, err := e.GetDepositAddress(context.Background(), currency.LTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := e.GetDepositAddress(currency.LTC, "")
+		_, err := e.GetDepositAddress(context.Background(), currency.LTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
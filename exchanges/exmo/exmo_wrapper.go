@@ -1,6 +1,7 @@
 package exmo
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +40,7 @@ func (e *EXMO) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if e.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = e.UpdateTradablePairs(true)
+		err = e.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -142,15 +144,15 @@ func (e *EXMO) Run() {
 		return
 	}
 
-	err := e.UpdateTradablePairs(false)
+	err := e.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", e.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (e *EXMO) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	pairs, err := e.GetPairSettings()
+func (e *EXMO) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	pairs, err := e.GetPairSettings(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -165,8 +167,8 @@ func (e *EXMO) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (e *EXMO) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := e.FetchTradablePairs(asset.Spot)
+func (e *EXMO) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := e.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -175,9 +177,9 @@ func (e *EXMO) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (e *EXMO) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (e *EXMO) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	result, err := e.GetTicker()
+	result, err := e.GetTicker(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -209,25 +211,25 @@ func (e *EXMO) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (e *EXMO) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (e *EXMO) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tick, err := ticker.GetTicker(e.Name, p, assetType)
 	if err != nil {
-		return e.UpdateTicker(p, assetType)
+		return e.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // FetchOrderbook returns the orderbook for a currency pair
-func (e *EXMO) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (e *EXMO) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(e.Name, p, assetType)
 	if err != nil {
-		return e.UpdateOrderbook(p, assetType)
+		return e.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (e *EXMO) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (e *EXMO) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 	pairsCollated, err := e.FormatExchangeCurrencies(e.GetEnabledPairs(assetType),
 		assetType)
@@ -235,7 +237,7 @@ func (e *EXMO) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderboo
 		return orderBook, err
 	}
 
-	result, err := e.GetOrderbook(pairsCollated)
+	result, err := e.GetOrderbook(ctx, pairsCollated)
 	if err != nil {
 		return orderBook, err
 	}
@@ -281,10 +283,10 @@ func (e *EXMO) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderboo
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Exmo exchange
-func (e *EXMO) UpdateAccountInfo() (account.Holdings, error) {
+func (e *EXMO) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = e.Name
-	result, err := e.GetUserInfo()
+	result, err := e.GetUserInfo(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -297,8 +299,8 @@ func (e *EXMO) UpdateAccountInfo() (account.Holdings, error) {
 			if z == x {
 				avail, _ := strconv.ParseFloat(y, 64)
 				reserved, _ := strconv.ParseFloat(w, 64)
-				exchangeCurrency.TotalValue = avail + reserved
-				exchangeCurrency.Hold = reserved
+				exchangeCurrency.TotalValue = decimal.NewFromFloat(avail).Add(decimal.NewFromFloat(reserved))
+				exchangeCurrency.Hold = decimal.NewFromFloat(reserved)
 			}
 		}
 		currencies = append(currencies, exchangeCurrency)
@@ -317,10 +319,10 @@ func (e *EXMO) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (e *EXMO) FetchAccountInfo() (account.Holdings, error) {
+func (e *EXMO) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(e.Name)
 	if err != nil {
-		return e.UpdateAccountInfo()
+		return e.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -328,17 +330,40 @@ func (e *EXMO) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (e *EXMO) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (e *EXMO) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (e *EXMO) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (e *EXMO) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (e *EXMO) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (e *EXMO) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (e *EXMO) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (e *EXMO) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (e *EXMO) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (e *EXMO) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -356,10 +381,10 @@ func (e *EXMO) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		}
 	}
 
-	response, err := e.CreateOrder(s.Pair.String(),
+	response, err := e.CreateOrder(ctx, s.Pair.String(),
 		oT,
-		s.Price,
-		s.Amount)
+		s.Price.Float64(),
+		s.Amount.Float64())
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -376,33 +401,33 @@ func (e *EXMO) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (e *EXMO) ModifyOrder(action *order.Modify) (string, error) {
+func (e *EXMO) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (e *EXMO) CancelOrder(order *order.Cancel) error {
+func (e *EXMO) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	return e.CancelExistingOrder(orderIDInt)
+	return e.CancelExistingOrder(ctx, orderIDInt)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (e *EXMO) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (e *EXMO) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
 
-	openOrders, err := e.GetOpenOrders()
+	openOrders, err := e.GetOpenOrders(ctx)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
 
 	for i := range openOrders {
-		err = e.CancelExistingOrder(openOrders[i].OrderID)
+		err = e.CancelExistingOrder(ctx, openOrders[i].OrderID)
 		if err != nil {
 			cancelAllOrdersResponse.Status[strconv.FormatInt(openOrders[i].OrderID, 10)] = err.Error()
 		}
@@ -412,14 +437,14 @@ func (e *EXMO) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error)
 }
 
 // GetOrderInfo returns information on a current open order
-func (e *EXMO) GetOrderInfo(orderID string) (order.Detail, error) {
+func (e *EXMO) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (e *EXMO) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	fullAddr, err := e.GetCryptoDepositAddress()
+func (e *EXMO) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	fullAddr, err := e.GetCryptoDepositAddress(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -434,8 +459,8 @@ func (e *EXMO) GetDepositAddress(cryptocurrency currency.Code, _ string) (string
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (e *EXMO) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	resp, err := e.WithdrawCryptocurrency(withdrawRequest.Currency.String(),
+func (e *EXMO) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	resp, err := e.WithdrawCryptocurrency(ctx, withdrawRequest.Currency.String(),
 		withdrawRequest.Address,
 		withdrawRequest.AddressTag,
 		withdrawRequest.Amount)
@@ -445,13 +470,13 @@ func (e *EXMO) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoReque
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (e *EXMO) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (e *EXMO) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (e *EXMO) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (e *EXMO) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -461,7 +486,7 @@ func (e *EXMO) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (e *EXMO) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (e *EXMO) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !e.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -470,8 +495,8 @@ func (e *EXMO) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (e *EXMO) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := e.GetOpenOrders()
+func (e *EXMO) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := e.GetOpenOrders(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -483,9 +508,9 @@ func (e *EXMO) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, err
 		orderSide := order.Side(strings.ToUpper(resp[i].Type))
 		orders = append(orders, order.Detail{
 			ID:           strconv.FormatInt(resp[i].OrderID, 10),
-			Amount:       resp[i].Quantity,
+			Amount:       decimal.NewFromFloat(resp[i].Quantity),
 			OrderDate:    orderDate,
-			Price:        resp[i].Price,
+			Price:        decimal.NewFromFloat(resp[i].Price),
 			OrderSide:    orderSide,
 			Exchange:     e.Name,
 			CurrencyPair: symbol,
@@ -499,14 +524,14 @@ func (e *EXMO) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, err
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (e *EXMO) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (e *EXMO) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		return nil, errors.New("currency must be supplied")
 	}
 
 	var allTrades []UserTrades
 	for i := range req.Currencies {
-		resp, err := e.GetUserTrades(e.FormatExchangeCurrency(req.Currencies[i], asset.Spot).String(), "", "10000")
+		resp, err := e.GetUserTrades(ctx, e.FormatExchangeCurrency(req.Currencies[i], asset.Spot).String(), "", "10000")
 		if err != nil {
 			return nil, err
 		}
@@ -522,9 +547,9 @@ func (e *EXMO) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, err
 		orderSide := order.Side(strings.ToUpper(allTrades[i].Type))
 		orders = append(orders, order.Detail{
 			ID:           strconv.FormatInt(allTrades[i].TradeID, 10),
-			Amount:       allTrades[i].Quantity,
+			Amount:       decimal.NewFromFloat(allTrades[i].Quantity),
 			OrderDate:    orderDate,
-			Price:        allTrades[i].Price,
+			Price:        decimal.NewFromFloat(allTrades[i].Price),
 			OrderSide:    orderSide,
 			Exchange:     e.Name,
 			CurrencyPair: symbol,
@@ -560,7 +585,12 @@ func (e *EXMO) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (e *EXMO) ValidateCredentials() error {
-	_, err := e.UpdateAccountInfo()
+func (e *EXMO) ValidateCredentials(ctx context.Context) error {
+	_, err := e.UpdateAccountInfo(ctx)
 	return e.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (e *EXMO) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
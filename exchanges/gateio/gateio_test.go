@@ -1,6 +1,7 @@
 package gateio
 
 import (
+	"context"
 	"log"
 	"net/http"
 	"os"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -55,7 +57,7 @@ func TestMain(m *testing.M) {
 
 func TestGetSymbols(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetSymbols()
+	_, err := g.GetSymbols(context.Background())
 	if err != nil {
 		t.Errorf("Gateio TestGetSymbols: %s", err)
 	}
@@ -63,7 +65,7 @@ func TestGetSymbols(t *testing.T) {
 
 func TestGetMarketInfo(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetMarketInfo()
+	_, err := g.GetMarketInfo(context.Background())
 	if err != nil {
 		t.Errorf("Gateio GetMarketInfo: %s", err)
 	}
@@ -76,7 +78,7 @@ func TestSpotNewOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := g.SpotNewOrder(SpotNewOrderRequestParams{
+	_, err := g.SpotNewOrder(context.Background(), SpotNewOrderRequestParams{
 		Symbol: "btc_usdt",
 		Amount: 1.1,
 		Price:  10.1,
@@ -94,7 +96,7 @@ func TestCancelExistingOrder(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := g.CancelExistingOrder(917591554, "btc_usdt")
+	_, err := g.CancelExistingOrder(context.Background(), 917591554, "btc_usdt")
 	if err != nil {
 		t.Errorf("Gateio CancelExistingOrder: %s", err)
 	}
@@ -107,7 +109,7 @@ func TestGetBalances(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := g.GetBalances()
+	_, err := g.GetBalances(context.Background())
 	if err != nil {
 		t.Errorf("Gateio GetBalances: %s", err)
 	}
@@ -115,7 +117,7 @@ func TestGetBalances(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetLatestSpotPrice("btc_usdt")
+	_, err := g.GetLatestSpotPrice(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Gateio GetLatestSpotPrice: %s", err)
 	}
@@ -123,7 +125,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetTicker("btc_usdt")
+	_, err := g.GetTicker(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Gateio GetTicker: %s", err)
 	}
@@ -131,7 +133,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetTickers()
+	_, err := g.GetTickers(context.Background())
 	if err != nil {
 		t.Errorf("Gateio GetTicker: %s", err)
 	}
@@ -139,7 +141,7 @@ func TestGetTickers(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetOrderbook("btc_usdt")
+	_, err := g.GetOrderbook(context.Background(), "btc_usdt")
 	if err != nil {
 		t.Errorf("Gateio GetTicker: %s", err)
 	}
@@ -148,7 +150,7 @@ func TestGetOrderbook(t *testing.T) {
 func TestGetSpotKline(t *testing.T) {
 	t.Parallel()
 
-	_, err := g.GetSpotKline(KlinesRequestParams{
+	_, err := g.GetSpotKline(context.Background(), KlinesRequestParams{
 		Symbol:   "btc_usdt",
 		GroupSec: TimeIntervalFiveMinutes, // 5 minutes or less
 		HourSize: 1,                       // 1 hour data
@@ -175,7 +177,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	g.GetFeeByType(feeBuilder)
+	g.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -191,7 +193,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	if areTestAPIKeysSet() {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.002), resp)
 		}
@@ -200,7 +202,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := g.GetFee(feeBuilder); resp != float64(2000) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(2000) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(2000), resp)
 			t.Error(err)
 		}
@@ -208,7 +210,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.002), resp)
 			t.Error(err)
 		}
@@ -216,7 +218,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -224,7 +226,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
 		t.Error(err)
 	}
@@ -233,7 +235,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Pair.Base = currency.NewCode("hello")
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -241,7 +243,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -249,7 +251,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -258,7 +260,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -277,7 +279,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := g.GetActiveOrders(&getOrdersRequest)
+	_, err := g.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -294,7 +296,7 @@ func TestGetOrderHistory(t *testing.T) {
 	currPair.Delimiter = "_"
 	getOrdersRequest.Currencies = []currency.Pair{currPair}
 
-	_, err := g.GetOrderHistory(&getOrdersRequest)
+	_, err := g.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -321,11 +323,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := g.SubmitOrder(orderSubmission)
+	response, err := g.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -346,7 +348,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := g.CancelOrder(orderCancellation)
+	err := g.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -368,7 +370,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := g.CancelAllOrders(orderCancellation)
+	resp, err := g.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -384,12 +386,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if apiSecret == "" || apiKey == "" {
-		_, err := g.UpdateAccountInfo()
+		_, err := g.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
 	} else {
-		_, err := g.UpdateAccountInfo()
+		_, err := g.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
@@ -400,7 +402,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := g.ModifyOrder(&order.Modify{})
+	_, err := g.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -420,7 +422,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := g.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := g.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -435,7 +437,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := g.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := g.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -447,7 +449,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := g.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := g.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -455,12 +457,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := g.GetDepositAddress(currency.ETC, "")
+		_, err := g.GetDepositAddress(context.Background(), currency.ETC, "")
 		if err != nil {
 			t.Error("Test Fail - GetDepositAddress error", err)
 		}
 	} else {
-		_, err := g.GetDepositAddress(currency.ETC, "")
+		_, err := g.GetDepositAddress(context.Background(), currency.ETC, "")
 		if err == nil {
 			t.Error("Test Fail - GetDepositAddress error cannot be nil")
 		}
@@ -471,7 +473,7 @@ func TestGetOrderInfo(t *testing.T) {
 		t.Skip("no API keys set skipping test")
 	}
 
-	_, err := g.GetOrderInfo("917591554")
+	_, err := g.GetOrderInfo(context.Background(), "917591554")
 	if err != nil {
 		if err.Error() != "no order found with id 917591554" && err.Error() != "failed to get open orders" {
 			t.Fatalf("GetOrderInfo() returned an error skipping test: %v", err)
@@ -14,6 +14,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -24,6 +25,7 @@ import (
 const (
 	gateioWebsocketEndpoint  = "wss://ws.gateio.ws/v3/"
 	gateioWebsocketRateLimit = 120
+	gateioWsKlineInterval    = kline.ThirtyMin
 )
 
 // WsConnect initiates a websocket connection
@@ -51,7 +53,7 @@ func (g *Gateio) wsServerSignIn() (*WebsocketAuthenticationResponse, error) {
 	if !g.GetAuthenticatedAPISupport(exchange.WebsocketAuthentication) {
 		return nil, fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", g.Name)
 	}
-	nonce := int(time.Now().Unix() * 1000)
+	nonce := int(g.Requester.Now().Unix() * 1000)
 	sigTemp := g.GenerateSignature(strconv.Itoa(nonce))
 	signature := crypto.Base64Encode(sigTemp)
 	signinWsRequest := WebsocketRequest{
@@ -100,7 +102,7 @@ func (g *Gateio) WsHandleData() {
 			var result WebsocketResponse
 			err = json.Unmarshal(resp.Raw, &result)
 			if err != nil {
-				g.Websocket.DataHandler <- err
+				g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 				continue
 			}
 
@@ -111,8 +113,8 @@ func (g *Gateio) WsHandleData() {
 
 			if result.Error.Code != 0 {
 				if strings.Contains(result.Error.Message, "authentication") {
-					g.Websocket.DataHandler <- fmt.Errorf("%v - authentication failed: %v", g.Name, err)
-					g.Websocket.SetCanUseAuthenticatedEndpoints(false)
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorAuthFailed,
+						errors.New(result.Error.Message))
 					continue
 				}
 				g.Websocket.DataHandler <- fmt.Errorf("%v error %s",
@@ -126,13 +128,13 @@ func (g *Gateio) WsHandleData() {
 				var c string
 				err = json.Unmarshal(result.Params[1], &wsTicker)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
 				err = json.Unmarshal(result.Params[0], &c)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -154,13 +156,13 @@ func (g *Gateio) WsHandleData() {
 				var c string
 				err = json.Unmarshal(result.Params[1], &trades)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
 				err = json.Unmarshal(result.Params[0], &c)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -182,19 +184,19 @@ func (g *Gateio) WsHandleData() {
 				var data = make(map[string][][]string)
 				err = json.Unmarshal(result.Params[0], &IsSnapshot)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
 				err = json.Unmarshal(result.Params[2], &c)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
 				err = json.Unmarshal(result.Params[1], &data)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -268,7 +270,7 @@ func (g *Gateio) WsHandleData() {
 				var data []interface{}
 				err = json.Unmarshal(result.Params[0], &data)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -283,6 +285,7 @@ func (g *Gateio) WsHandleData() {
 					Pair:       currency.NewPairFromString(data[7].(string)),
 					AssetType:  asset.Spot,
 					Exchange:   g.Name,
+					Interval:   gateioWsKlineInterval,
 					OpenPrice:  open,
 					ClosePrice: closePrice,
 					HighPrice:  high,
@@ -325,7 +328,7 @@ func (g *Gateio) GenerateDefaultSubscriptions() {
 				params["limit"] = 30
 				params["interval"] = "0.1"
 			} else if strings.EqualFold(channels[i], "kline.subscribe") {
-				params["interval"] = 1800
+				params["interval"] = int64(gateioWsKlineInterval.Duration() / time.Second)
 			}
 			subscriptions = append(subscriptions, wshandler.WebsocketChannelSubscription{
 				Channel:  channels[i],
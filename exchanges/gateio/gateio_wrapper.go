@@ -1,6 +1,7 @@
 package gateio
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -10,6 +11,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -39,7 +41,7 @@ func (g *Gateio) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if g.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = g.UpdateTradablePairs(true)
+		err = g.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -161,10 +163,13 @@ func (g *Gateio) Setup(exch *config.ExchangeConfig) error {
 	g.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         g.Name,
 		URL:                  g.Websocket.GetWebsocketURL(),
+		AlternateURLs:        g.Websocket.GetAlternateURLs(),
 		ProxyURL:             g.Websocket.GetProxyAddress(),
 		Verbose:              g.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 		RateLimit:            gateioWebsocketRateLimit,
 	}
 
@@ -197,21 +202,21 @@ func (g *Gateio) Run() {
 		return
 	}
 
-	err := g.UpdateTradablePairs(false)
+	err := g.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", g.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (g *Gateio) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	return g.GetSymbols()
+func (g *Gateio) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	return g.GetSymbols(ctx)
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (g *Gateio) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := g.FetchTradablePairs(asset.Spot)
+func (g *Gateio) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := g.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -220,9 +225,9 @@ func (g *Gateio) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (g *Gateio) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (g *Gateio) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	result, err := g.GetTickers()
+	result, err := g.GetTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -253,29 +258,29 @@ func (g *Gateio) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (g *Gateio) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (g *Gateio) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(g.Name, p, assetType)
 	if err != nil {
-		return g.UpdateTicker(p, assetType)
+		return g.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (g *Gateio) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (g *Gateio) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(g.Name, p, assetType)
 	if err != nil {
-		return g.UpdateOrderbook(p, assetType)
+		return g.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (g *Gateio) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (g *Gateio) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 	curr := g.FormatExchangeCurrency(p, assetType).String()
 
-	orderbookNew, err := g.GetOrderbook(curr)
+	orderbookNew, err := g.GetOrderbook(ctx, curr)
 	if err != nil {
 		return orderBook, err
 	}
@@ -308,7 +313,7 @@ func (g *Gateio) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // ZB exchange
-func (g *Gateio) UpdateAccountInfo() (account.Holdings, error) {
+func (g *Gateio) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 	var balances []account.Balance
 
@@ -321,15 +326,15 @@ func (g *Gateio) UpdateAccountInfo() (account.Holdings, error) {
 		for k := range resp.Result {
 			currData = append(currData, account.Balance{
 				CurrencyName: currency.NewCode(k),
-				TotalValue:   resp.Result[k].Available + resp.Result[k].Freeze,
-				Hold:         resp.Result[k].Freeze,
+				TotalValue:   decimal.NewFromFloat(resp.Result[k].Available).Add(decimal.NewFromFloat(resp.Result[k].Freeze)),
+				Hold:         decimal.NewFromFloat(resp.Result[k].Freeze),
 			})
 		}
 		info.Accounts = append(info.Accounts, account.SubAccount{
 			Currencies: currData,
 		})
 	} else {
-		balance, err := g.GetBalances()
+		balance, err := g.GetBalances(ctx)
 		if err != nil {
 			return info, err
 		}
@@ -344,7 +349,7 @@ func (g *Gateio) UpdateAccountInfo() (account.Holdings, error) {
 
 				balances = append(balances, account.Balance{
 					CurrencyName: currency.NewCode(x),
-					Hold:         lockedF,
+					Hold:         decimal.NewFromFloat(lockedF),
 				})
 			}
 		default:
@@ -362,7 +367,7 @@ func (g *Gateio) UpdateAccountInfo() (account.Holdings, error) {
 				var updated bool
 				for i := range balances {
 					if balances[i].CurrencyName == currency.NewCode(x) {
-						balances[i].TotalValue = balances[i].Hold + availAmount
+						balances[i].TotalValue = balances[i].Hold.Add(decimal.NewFromFloat(availAmount))
 						updated = true
 						break
 					}
@@ -370,7 +375,7 @@ func (g *Gateio) UpdateAccountInfo() (account.Holdings, error) {
 				if !updated {
 					balances = append(balances, account.Balance{
 						CurrencyName: currency.NewCode(x),
-						TotalValue:   availAmount,
+						TotalValue:   decimal.NewFromFloat(availAmount),
 					})
 				}
 			}
@@ -393,10 +398,10 @@ func (g *Gateio) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (g *Gateio) FetchAccountInfo() (account.Holdings, error) {
+func (g *Gateio) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(g.Name)
 	if err != nil {
-		return g.UpdateAccountInfo()
+		return g.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -404,18 +409,41 @@ func (g *Gateio) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (g *Gateio) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (g *Gateio) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (g *Gateio) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (g *Gateio) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (g *Gateio) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (g *Gateio) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (g *Gateio) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (g *Gateio) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
 // TODO: support multiple order types (IOC)
-func (g *Gateio) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (g *Gateio) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -429,13 +457,13 @@ func (g *Gateio) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	}
 
 	var spotNewOrderRequestParams = SpotNewOrderRequestParams{
-		Amount: s.Amount,
-		Price:  s.Price,
+		Amount: s.Amount.Float64(),
+		Price:  s.Price.Float64(),
 		Symbol: s.Pair.String(),
 		Type:   orderTypeFormat,
 	}
 
-	response, err := g.SpotNewOrder(spotNewOrderRequestParams)
+	response, err := g.SpotNewOrder(ctx, spotNewOrderRequestParams)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -452,27 +480,27 @@ func (g *Gateio) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (g *Gateio) ModifyOrder(action *order.Modify) (string, error) {
+func (g *Gateio) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (g *Gateio) CancelOrder(order *order.Cancel) error {
+func (g *Gateio) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
-	_, err = g.CancelExistingOrder(orderIDInt,
+	_, err = g.CancelExistingOrder(ctx, orderIDInt,
 		g.FormatExchangeCurrency(order.CurrencyPair, order.AssetType).String())
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (g *Gateio) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (g *Gateio) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	openOrders, err := g.GetOpenOrders("")
+	openOrders, err := g.GetOpenOrders(ctx, "")
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -483,7 +511,7 @@ func (g *Gateio) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 	}
 
 	for unique := range uniqueSymbols {
-		err = g.CancelAllExistingOrders(-1, unique)
+		err = g.CancelAllExistingOrders(ctx, -1, unique)
 		if err != nil {
 			cancelAllOrdersResponse.Status[unique] = err.Error()
 		}
@@ -493,9 +521,9 @@ func (g *Gateio) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 }
 
 // GetOrderInfo returns information on a current open order
-func (g *Gateio) GetOrderInfo(orderID string) (order.Detail, error) {
+func (g *Gateio) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
-	orders, err := g.GetOpenOrders("")
+	orders, err := g.GetOpenOrders(ctx, "")
 	if err != nil {
 		return orderDetail, errors.New("failed to get open orders")
 	}
@@ -505,12 +533,12 @@ func (g *Gateio) GetOrderInfo(orderID string) (order.Detail, error) {
 		}
 		orderDetail.Exchange = g.Name
 		orderDetail.ID = orders.Orders[x].OrderNumber
-		orderDetail.RemainingAmount = orders.Orders[x].InitialAmount - orders.Orders[x].FilledAmount
-		orderDetail.ExecutedAmount = orders.Orders[x].FilledAmount
-		orderDetail.Amount = orders.Orders[x].InitialAmount
+		orderDetail.RemainingAmount = decimal.NewFromFloat(orders.Orders[x].InitialAmount).Sub(decimal.NewFromFloat(orders.Orders[x].FilledAmount))
+		orderDetail.ExecutedAmount = decimal.NewFromFloat(orders.Orders[x].FilledAmount)
+		orderDetail.Amount = decimal.NewFromFloat(orders.Orders[x].InitialAmount)
 		orderDetail.OrderDate = time.Unix(orders.Orders[x].Timestamp, 0)
 		orderDetail.Status = order.Status(orders.Orders[x].Status)
-		orderDetail.Price = orders.Orders[x].Rate
+		orderDetail.Price = decimal.NewFromFloat(orders.Orders[x].Rate)
 		orderDetail.CurrencyPair = currency.NewPairDelimiter(orders.Orders[x].CurrencyPair,
 			g.GetPairFormat(asset.Spot, false).Delimiter)
 		if strings.EqualFold(orders.Orders[x].Type, order.Ask.String()) {
@@ -524,8 +552,8 @@ func (g *Gateio) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (g *Gateio) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	addr, err := g.GetCryptoDepositAddress(cryptocurrency.String())
+func (g *Gateio) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	addr, err := g.GetCryptoDepositAddress(ctx, cryptocurrency.String())
 	if err != nil {
 		return "", err
 	}
@@ -539,19 +567,19 @@ func (g *Gateio) GetDepositAddress(cryptocurrency currency.Code, _ string) (stri
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (g *Gateio) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	return g.WithdrawCrypto(withdrawRequest.Currency.String(), withdrawRequest.Address, withdrawRequest.Amount)
+func (g *Gateio) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	return g.WithdrawCrypto(ctx, withdrawRequest.Currency.String(), withdrawRequest.Address, withdrawRequest.Amount)
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (g *Gateio) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (g *Gateio) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (g *Gateio) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (g *Gateio) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -561,16 +589,16 @@ func (g *Gateio) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (g *Gateio) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (g *Gateio) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !g.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return g.GetFee(feeBuilder)
+	return g.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (g *Gateio) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (g *Gateio) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
 	var currPair string
 	if len(req.Currencies) == 1 {
@@ -592,11 +620,7 @@ func (g *Gateio) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 				if resp.WebSocketOrderQueryRecords[j].OrderType == 1 {
 					orderType = order.Limit
 				}
-				firstNum, decNum, err := convert.SplitFloatDecimals(resp.WebSocketOrderQueryRecords[j].Ctime)
-				if err != nil {
-					return orders, err
-				}
-				orderDate := time.Unix(firstNum, decNum)
+				orderDate := convert.TimeFromUnixFloat(resp.WebSocketOrderQueryRecords[j].Ctime, time.Second)
 				orders = append(orders, order.Detail{
 					Exchange:        g.Name,
 					AccountID:       strconv.FormatInt(resp.WebSocketOrderQueryRecords[j].User, 10),
@@ -605,11 +629,11 @@ func (g *Gateio) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 					OrderSide:       orderSide,
 					OrderType:       orderType,
 					OrderDate:       orderDate,
-					Price:           resp.WebSocketOrderQueryRecords[j].Price,
-					Amount:          resp.WebSocketOrderQueryRecords[j].Amount,
-					ExecutedAmount:  resp.WebSocketOrderQueryRecords[j].FilledAmount,
-					RemainingAmount: resp.WebSocketOrderQueryRecords[j].Left,
-					Fee:             resp.WebSocketOrderQueryRecords[j].DealFee,
+					Price:           decimal.NewFromFloat(resp.WebSocketOrderQueryRecords[j].Price),
+					Amount:          decimal.NewFromFloat(resp.WebSocketOrderQueryRecords[j].Amount),
+					ExecutedAmount:  decimal.NewFromFloat(resp.WebSocketOrderQueryRecords[j].FilledAmount),
+					RemainingAmount: decimal.NewFromFloat(resp.WebSocketOrderQueryRecords[j].Left),
+					Fee:             decimal.NewFromFloat(resp.WebSocketOrderQueryRecords[j].DealFee),
 				})
 			}
 			if len(resp.WebSocketOrderQueryRecords) < 100 {
@@ -617,7 +641,7 @@ func (g *Gateio) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 			}
 		}
 	} else {
-		resp, err := g.GetOpenOrders(currPair)
+		resp, err := g.GetOpenOrders(ctx, currPair)
 		if err != nil {
 			return nil, err
 		}
@@ -633,9 +657,9 @@ func (g *Gateio) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 			orderDate := time.Unix(resp.Orders[i].Timestamp, 0)
 			orders = append(orders, order.Detail{
 				ID:              resp.Orders[i].OrderNumber,
-				Amount:          resp.Orders[i].Amount,
-				Price:           resp.Orders[i].Rate,
-				RemainingAmount: resp.Orders[i].FilledAmount,
+				Amount:          decimal.NewFromFloat(resp.Orders[i].Amount),
+				Price:           decimal.NewFromFloat(resp.Orders[i].Rate),
+				RemainingAmount: decimal.NewFromFloat(resp.Orders[i].FilledAmount),
 				OrderDate:       orderDate,
 				OrderSide:       side,
 				Exchange:        g.Name,
@@ -651,10 +675,10 @@ func (g *Gateio) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (g *Gateio) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (g *Gateio) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var trades []TradesResponse
 	for i := range req.Currencies {
-		resp, err := g.GetTradeHistory(req.Currencies[i].String())
+		resp, err := g.GetTradeHistory(ctx, req.Currencies[i].String())
 		if err != nil {
 			return nil, err
 		}
@@ -669,8 +693,8 @@ func (g *Gateio) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 		orderDate := time.Unix(trade.TimeUnix, 0)
 		orders = append(orders, order.Detail{
 			ID:           strconv.FormatInt(trade.OrderID, 10),
-			Amount:       trade.Amount,
-			Price:        trade.Rate,
+			Amount:       decimal.NewFromFloat(trade.Amount),
+			Price:        decimal.NewFromFloat(trade.Rate),
 			OrderDate:    orderDate,
 			OrderSide:    side,
 			Exchange:     g.Name,
@@ -683,18 +707,16 @@ func (g *Gateio) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (g *Gateio) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	g.Websocket.SubscribeToChannels(channels)
-	return nil
+	return g.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (g *Gateio) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	g.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return g.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -710,7 +732,12 @@ func (g *Gateio) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (g *Gateio) ValidateCredentials() error {
-	_, err := g.UpdateAccountInfo()
+func (g *Gateio) ValidateCredentials(ctx context.Context) error {
+	_, err := g.UpdateAccountInfo(ctx)
 	return g.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (g *Gateio) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
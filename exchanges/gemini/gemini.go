@@ -1,6 +1,7 @@
 package gemini
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -66,17 +67,17 @@ type Gemini struct {
 }
 
 // GetSymbols returns all available symbols for trading
-func (g *Gemini) GetSymbols() ([]string, error) {
+func (g *Gemini) GetSymbols(ctx context.Context) ([]string, error) {
 	var symbols []string
 	path := fmt.Sprintf("%s/v%s/%s", g.API.Endpoints.URL, geminiAPIVersion, geminiSymbols)
-	return symbols, g.SendHTTPRequest(path, &symbols)
+	return symbols, g.SendHTTPRequest(ctx, path, &symbols)
 }
 
 // GetTicker returns information about recent trading activity for the symbol
-func (g *Gemini) GetTicker(currencyPair string) (TickerV2, error) {
+func (g *Gemini) GetTicker(ctx context.Context, currencyPair string) (TickerV2, error) {
 	ticker := TickerV2{}
 	path := fmt.Sprintf("%s/v2/ticker/%s", g.API.Endpoints.URL, currencyPair)
-	err := g.SendHTTPRequest(path, &ticker)
+	err := g.SendHTTPRequest(ctx, path, &ticker)
 	if err != nil {
 		return ticker, err
 	}
@@ -95,7 +96,7 @@ func (g *Gemini) GetTicker(currencyPair string) (TickerV2, error) {
 //
 // params - limit_bids or limit_asks [OPTIONAL] default 50, 0 returns all Values
 // Type is an integer ie "params.Set("limit_asks", 30)"
-func (g *Gemini) GetOrderbook(currencyPair string, params url.Values) (Orderbook, error) {
+func (g *Gemini) GetOrderbook(ctx context.Context, currencyPair string, params url.Values) (Orderbook, error) {
 	path := common.EncodeURLValues(
 		fmt.Sprintf("%s/v%s/%s/%s",
 			g.API.Endpoints.URL,
@@ -105,7 +106,7 @@ func (g *Gemini) GetOrderbook(currencyPair string, params url.Values) (Orderbook
 		params)
 
 	var orderbook Orderbook
-	return orderbook, g.SendHTTPRequest(path, &orderbook)
+	return orderbook, g.SendHTTPRequest(ctx, path, &orderbook)
 }
 
 // GetTrades eturn the trades that have executed since the specified timestamp.
@@ -117,19 +118,19 @@ func (g *Gemini) GetOrderbook(currencyPair string, params url.Values) (Orderbook
 // limit_trades	integer	Optional. The maximum number of trades to return.
 // include_breaks	boolean	Optional. Whether to display broken trades. False by
 // default. Can be '1' or 'true' to activate
-func (g *Gemini) GetTrades(currencyPair string, params url.Values) ([]Trade, error) {
+func (g *Gemini) GetTrades(ctx context.Context, currencyPair string, params url.Values) ([]Trade, error) {
 	path := common.EncodeURLValues(fmt.Sprintf("%s/v%s/%s/%s", g.API.Endpoints.URL, geminiAPIVersion, geminiTrades, currencyPair), params)
 	var trades []Trade
 
-	return trades, g.SendHTTPRequest(path, &trades)
+	return trades, g.SendHTTPRequest(ctx, path, &trades)
 }
 
 // GetAuction returns auction information
-func (g *Gemini) GetAuction(currencyPair string) (Auction, error) {
+func (g *Gemini) GetAuction(ctx context.Context, currencyPair string) (Auction, error) {
 	path := fmt.Sprintf("%s/v%s/%s/%s", g.API.Endpoints.URL, geminiAPIVersion, geminiAuction, currencyPair)
 	auction := Auction{}
 
-	return auction, g.SendHTTPRequest(path, &auction)
+	return auction, g.SendHTTPRequest(ctx, path, &auction)
 }
 
 // GetAuctionHistory returns the auction events, optionally including
@@ -143,15 +144,15 @@ func (g *Gemini) GetAuction(currencyPair string) (Auction, error) {
 // events to return.
 //          include_indicative - [bool] Whether to include publication of
 // indicative prices and quantities.
-func (g *Gemini) GetAuctionHistory(currencyPair string, params url.Values) ([]AuctionHistory, error) {
+func (g *Gemini) GetAuctionHistory(ctx context.Context, currencyPair string, params url.Values) ([]AuctionHistory, error) {
 	path := common.EncodeURLValues(fmt.Sprintf("%s/v%s/%s/%s/%s", g.API.Endpoints.URL, geminiAPIVersion, geminiAuction, currencyPair, geminiAuctionHistory), params)
 	var auctionHist []AuctionHistory
-	return auctionHist, g.SendHTTPRequest(path, &auctionHist)
+	return auctionHist, g.SendHTTPRequest(ctx, path, &auctionHist)
 }
 
 // NewOrder Only limit orders are supported through the API at present.
 // returns order ID if successful
-func (g *Gemini) NewOrder(symbol string, amount, price float64, side, orderType string) (int64, error) {
+func (g *Gemini) NewOrder(ctx context.Context, symbol string, amount, price float64, side, orderType string) (int64, error) {
 	req := make(map[string]interface{})
 	req["symbol"] = symbol
 	req["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
@@ -160,7 +161,7 @@ func (g *Gemini) NewOrder(symbol string, amount, price float64, side, orderType
 	req["type"] = orderType
 
 	response := Order{}
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiOrderNew, req, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiOrderNew, req, &response)
 	if err != nil {
 		return 0, err
 	}
@@ -169,12 +170,12 @@ func (g *Gemini) NewOrder(symbol string, amount, price float64, side, orderType
 
 // CancelExistingOrder will cancel an order. If the order is already canceled, the
 // message will succeed but have no effect.
-func (g *Gemini) CancelExistingOrder(orderID int64) (Order, error) {
+func (g *Gemini) CancelExistingOrder(ctx context.Context, orderID int64) (Order, error) {
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
 
 	response := Order{}
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiOrderCancel, req, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiOrderCancel, req, &response)
 	if err != nil {
 		return Order{}, err
 	}
@@ -189,14 +190,14 @@ func (g *Gemini) CancelExistingOrder(orderID int64) (Order, error) {
 // sessions owned by this account, including interactive orders placed through
 // the UI. If sessions = true will only cancel the order that is called on this
 // session asssociated with the APIKEY
-func (g *Gemini) CancelExistingOrders(cancelBySession bool) (OrderResult, error) {
+func (g *Gemini) CancelExistingOrders(ctx context.Context, cancelBySession bool) (OrderResult, error) {
 	path := geminiOrderCancelAll
 	if cancelBySession {
 		path = geminiOrderCancelSession
 	}
 
 	var response OrderResult
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, path, nil, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, nil, &response)
 	if err != nil {
 		return response, err
 	}
@@ -207,13 +208,13 @@ func (g *Gemini) CancelExistingOrders(cancelBySession bool) (OrderResult, error)
 }
 
 // GetOrderStatus returns the status for an order
-func (g *Gemini) GetOrderStatus(orderID int64) (Order, error) {
+func (g *Gemini) GetOrderStatus(ctx context.Context, orderID int64) (Order, error) {
 	req := make(map[string]interface{})
 	req["order_id"] = orderID
 
 	response := Order{}
 
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiOrderStatus, req, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiOrderStatus, req, &response)
 	if err != nil {
 		return response, err
 	}
@@ -225,14 +226,14 @@ func (g *Gemini) GetOrderStatus(orderID int64) (Order, error) {
 }
 
 // GetOrders returns active orders in the market
-func (g *Gemini) GetOrders() ([]Order, error) {
+func (g *Gemini) GetOrders(ctx context.Context) ([]Order, error) {
 	var response interface{}
 
 	type orders struct {
 		orders []Order
 	}
 
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiOrders, nil, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiOrders, nil, &response)
 	if err != nil {
 		return nil, err
 	}
@@ -249,7 +250,7 @@ func (g *Gemini) GetOrders() ([]Order, error) {
 //
 // currencyPair - example "btcusd"
 // timestamp - [optional] Only return trades on or after this timestamp.
-func (g *Gemini) GetTradeHistory(currencyPair string, timestamp int64) ([]TradeHistory, error) {
+func (g *Gemini) GetTradeHistory(ctx context.Context, currencyPair string, timestamp int64) ([]TradeHistory, error) {
 	var response []TradeHistory
 	req := make(map[string]interface{})
 	req["symbol"] = currencyPair
@@ -259,35 +260,35 @@ func (g *Gemini) GetTradeHistory(currencyPair string, timestamp int64) ([]TradeH
 	}
 
 	return response,
-		g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiMyTrades, req, &response)
+		g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiMyTrades, req, &response)
 }
 
 // GetNotionalVolume returns  the volume in price currency that has been traded across all pairs over a period of 30 days
-func (g *Gemini) GetNotionalVolume() (NotionalVolume, error) {
+func (g *Gemini) GetNotionalVolume(ctx context.Context) (NotionalVolume, error) {
 	response := NotionalVolume{}
 
 	return response,
-		g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiVolume, nil, &response)
+		g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiVolume, nil, &response)
 }
 
 // GetTradeVolume returns a multi-arrayed volume response
-func (g *Gemini) GetTradeVolume() ([][]TradeVolume, error) {
+func (g *Gemini) GetTradeVolume(ctx context.Context) ([][]TradeVolume, error) {
 	var response [][]TradeVolume
 
 	return response,
-		g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiTradeVolume, nil, &response)
+		g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiTradeVolume, nil, &response)
 }
 
 // GetBalances returns available balances in the supported currencies
-func (g *Gemini) GetBalances() ([]Balance, error) {
+func (g *Gemini) GetBalances(ctx context.Context) ([]Balance, error) {
 	var response []Balance
 
 	return response,
-		g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiBalances, nil, &response)
+		g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiBalances, nil, &response)
 }
 
 // GetCryptoDepositAddress returns a deposit address
-func (g *Gemini) GetCryptoDepositAddress(depositAddlabel, currency string) (DepositAddress, error) {
+func (g *Gemini) GetCryptoDepositAddress(ctx context.Context, depositAddlabel, currency string) (DepositAddress, error) {
 	response := DepositAddress{}
 	req := make(map[string]interface{})
 
@@ -295,7 +296,7 @@ func (g *Gemini) GetCryptoDepositAddress(depositAddlabel, currency string) (Depo
 		req["label"] = depositAddlabel
 	}
 
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiDeposit+"/"+currency+"/"+geminiNewAddress, req, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiDeposit+"/"+currency+"/"+geminiNewAddress, req, &response)
 	if err != nil {
 		return response, err
 	}
@@ -306,13 +307,13 @@ func (g *Gemini) GetCryptoDepositAddress(depositAddlabel, currency string) (Depo
 }
 
 // WithdrawCrypto withdraws crypto currency to a whitelisted address
-func (g *Gemini) WithdrawCrypto(address, currency string, amount float64) (WithdrawalAddress, error) {
+func (g *Gemini) WithdrawCrypto(ctx context.Context, address, currency string, amount float64) (WithdrawalAddress, error) {
 	response := WithdrawalAddress{}
 	req := make(map[string]interface{})
 	req["address"] = address
 	req["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
 
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiWithdraw+strings.ToLower(currency), req, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiWithdraw+strings.ToLower(currency), req, &response)
 	if err != nil {
 		return response, err
 	}
@@ -324,14 +325,14 @@ func (g *Gemini) WithdrawCrypto(address, currency string, amount float64) (Withd
 
 // PostHeartbeat sends a maintenance heartbeat to the exchange for all heartbeat
 // maintaned sessions
-func (g *Gemini) PostHeartbeat() (string, error) {
+func (g *Gemini) PostHeartbeat(ctx context.Context) (string, error) {
 	type Response struct {
 		Result  string `json:"result"`
 		Message string `json:"message"`
 	}
 	response := Response{}
 
-	err := g.SendAuthenticatedHTTPRequest(http.MethodPost, geminiHeartbeat, nil, &response)
+	err := g.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, geminiHeartbeat, nil, &response)
 	if err != nil {
 		return response.Result, err
 	}
@@ -342,8 +343,8 @@ func (g *Gemini) PostHeartbeat() (string, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated request
-func (g *Gemini) SendHTTPRequest(path string, result interface{}) error {
-	return g.SendPayload(&request.Item{
+func (g *Gemini) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return g.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -355,7 +356,7 @@ func (g *Gemini) SendHTTPRequest(path string, result interface{}) error {
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to the
 // exchange and returns an error
-func (g *Gemini) SendAuthenticatedHTTPRequest(method, path string, params map[string]interface{}, result interface{}) (err error) {
+func (g *Gemini) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params map[string]interface{}, result interface{}) (err error) {
 	if !g.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, g.Name)
 	}
@@ -388,7 +389,7 @@ func (g *Gemini) SendAuthenticatedHTTPRequest(method, path string, params map[st
 	headers["X-GEMINI-SIGNATURE"] = crypto.HexEncodeToString(hmac)
 	headers["Cache-Control"] = "no-cache"
 
-	return g.SendPayload(&request.Item{
+	return g.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          g.API.Endpoints.URL + "/v1/" + path,
 		Headers:       headers,
@@ -403,11 +404,11 @@ func (g *Gemini) SendAuthenticatedHTTPRequest(method, path string, params map[st
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (g *Gemini) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (g *Gemini) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		notionVolume, err := g.GetNotionalVolume()
+		notionVolume, err := g.GetNotionalVolume(ctx)
 		if err != nil {
 			return 0, err
 		}
@@ -444,6 +445,6 @@ func calculateTradingFee(notionVolume *NotionalVolume, purchasePrice, amount flo
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (g *Gemini) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (g *Gemini) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrFunctionNotSupported
 }
@@ -1,12 +1,15 @@
 package gemini
 
 import (
+	"context"
+	"encoding/json"
 	"net/url"
 	"testing"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -31,7 +34,7 @@ var g Gemini
 
 func TestGetSymbols(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetSymbols()
+	_, err := g.GetSymbols(context.Background())
 	if err != nil {
 		t.Error("GetSymbols() error", err)
 	}
@@ -39,11 +42,11 @@ func TestGetSymbols(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetTicker("BTCUSD")
+	_, err := g.GetTicker(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
-	_, err = g.GetTicker("bla")
+	_, err = g.GetTicker(context.Background(), "bla")
 	if err == nil {
 		t.Error("GetTicker() Expected error")
 	}
@@ -51,7 +54,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetOrderbook(testCurrency, url.Values{})
+	_, err := g.GetOrderbook(context.Background(), testCurrency, url.Values{})
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
@@ -59,7 +62,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetTrades(testCurrency, url.Values{})
+	_, err := g.GetTrades(context.Background(), testCurrency, url.Values{})
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
@@ -67,7 +70,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetNotionalVolume(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetNotionalVolume()
+	_, err := g.GetNotionalVolume(context.Background())
 	if err != nil && mockTests {
 		t.Error("GetNotionalVolume() error", err)
 	} else if err == nil && !mockTests {
@@ -77,7 +80,7 @@ func TestGetNotionalVolume(t *testing.T) {
 
 func TestGetAuction(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetAuction(testCurrency)
+	_, err := g.GetAuction(context.Background(), testCurrency)
 	if err != nil {
 		t.Error("GetAuction() error", err)
 	}
@@ -85,7 +88,7 @@ func TestGetAuction(t *testing.T) {
 
 func TestGetAuctionHistory(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetAuctionHistory(testCurrency, url.Values{})
+	_, err := g.GetAuctionHistory(context.Background(), testCurrency, url.Values{})
 	if err != nil {
 		t.Error("GetAuctionHistory() error", err)
 	}
@@ -93,7 +96,7 @@ func TestGetAuctionHistory(t *testing.T) {
 
 func TestNewOrder(t *testing.T) {
 	t.Parallel()
-	_, err := g.NewOrder(testCurrency,
+	_, err := g.NewOrder(context.Background(), testCurrency,
 		1,
 		9000000,
 		order.Sell.Lower(),
@@ -107,7 +110,7 @@ func TestNewOrder(t *testing.T) {
 
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
-	_, err := g.CancelExistingOrder(265555413)
+	_, err := g.CancelExistingOrder(context.Background(), 265555413)
 	if err != nil && mockTests {
 		t.Error("CancelExistingOrder() error", err)
 	} else if err == nil && !mockTests {
@@ -117,7 +120,7 @@ func TestCancelExistingOrder(t *testing.T) {
 
 func TestCancelExistingOrders(t *testing.T) {
 	t.Parallel()
-	_, err := g.CancelExistingOrders(false)
+	_, err := g.CancelExistingOrders(context.Background(), false)
 	if err != nil && mockTests {
 		t.Error("CancelExistingOrders() error", err)
 	} else if err == nil && !mockTests {
@@ -127,7 +130,7 @@ func TestCancelExistingOrders(t *testing.T) {
 
 func TestGetOrderStatus(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetOrderStatus(265563260)
+	_, err := g.GetOrderStatus(context.Background(), 265563260)
 	if err != nil && mockTests {
 		t.Error("GetOrderStatus() error", err)
 	} else if err == nil && !mockTests {
@@ -137,7 +140,7 @@ func TestGetOrderStatus(t *testing.T) {
 
 func TestGetOrders(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetOrders()
+	_, err := g.GetOrders(context.Background())
 	if err != nil && mockTests {
 		t.Error("GetOrders() error", err)
 	} else if err == nil && !mockTests {
@@ -147,7 +150,7 @@ func TestGetOrders(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetTradeHistory(testCurrency, 0)
+	_, err := g.GetTradeHistory(context.Background(), testCurrency, 0)
 	if err != nil && mockTests {
 		t.Error("GetTradeHistory() error", err)
 	} else if err == nil && !mockTests {
@@ -157,7 +160,7 @@ func TestGetTradeHistory(t *testing.T) {
 
 func TestGetTradeVolume(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetTradeVolume()
+	_, err := g.GetTradeVolume(context.Background())
 	if err != nil && mockTests {
 		t.Error("GetTradeVolume() error", err)
 	} else if err == nil && !mockTests {
@@ -167,7 +170,7 @@ func TestGetTradeVolume(t *testing.T) {
 
 func TestGetBalances(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetBalances()
+	_, err := g.GetBalances(context.Background())
 	if err != nil && mockTests {
 		t.Error("GetBalances() error", err)
 	} else if err == nil && !mockTests {
@@ -177,7 +180,7 @@ func TestGetBalances(t *testing.T) {
 
 func TestGetCryptoDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetCryptoDepositAddress("LOL123", "btc")
+	_, err := g.GetCryptoDepositAddress(context.Background(), "LOL123", "btc")
 	if err == nil {
 		t.Error("GetCryptoDepositAddress() Expected error")
 	}
@@ -185,7 +188,7 @@ func TestGetCryptoDepositAddress(t *testing.T) {
 
 func TestWithdrawCrypto(t *testing.T) {
 	t.Parallel()
-	_, err := g.WithdrawCrypto("LOL123", "btc", 1)
+	_, err := g.WithdrawCrypto(context.Background(), "LOL123", "btc", 1)
 	if err == nil {
 		t.Error("WithdrawCrypto() Expected error")
 	}
@@ -193,7 +196,7 @@ func TestWithdrawCrypto(t *testing.T) {
 
 func TestPostHeartbeat(t *testing.T) {
 	t.Parallel()
-	_, err := g.PostHeartbeat()
+	_, err := g.PostHeartbeat(context.Background())
 	if err != nil && mockTests {
 		t.Error("PostHeartbeat() error", err)
 	} else if err == nil && !mockTests {
@@ -218,7 +221,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	t.Parallel()
 	var feeBuilder = setFeeBuilder()
-	g.GetFeeByType(feeBuilder)
+	g.GetFeeByType(context.Background(), feeBuilder)
 
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
@@ -240,7 +243,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	if areTestAPIKeysSet() || mockTests {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0.0035) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0.0035) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f",
 				float64(0.0035),
 				resp)
@@ -251,7 +254,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := g.GetFee(feeBuilder); resp != float64(3500) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(3500) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f",
 				float64(3500),
 				resp)
@@ -261,7 +264,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f",
 				float64(0.001),
 				resp)
@@ -271,7 +274,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f",
 				float64(0),
 				resp)
@@ -281,7 +284,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -292,7 +295,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Pair.Base = currency.NewCode("hello")
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -302,7 +305,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -312,7 +315,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -323,7 +326,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := g.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := g.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0),
 			resp)
@@ -355,7 +358,7 @@ func TestGetActiveOrders(t *testing.T) {
 		},
 	}
 
-	_, err := g.GetActiveOrders(&getOrdersRequest)
+	_, err := g.GetActiveOrders(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil && !mockTests:
 		t.Errorf("Could not get open orders: %s", err)
@@ -373,7 +376,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Currencies: []currency.Pair{currency.NewPair(currency.LTC, currency.BTC)},
 	}
 
-	_, err := g.GetOrderHistory(&getOrdersRequest)
+	_, err := g.GetOrderHistory(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Errorf("Could not get order history: %s", err)
@@ -404,12 +407,12 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     10,
-		Amount:    1,
+		Price:     decimal.NewFromInt(10),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "1234234",
 	}
 
-	response, err := g.SubmitOrder(orderSubmission)
+	response, err := g.SubmitOrder(context.Background(), orderSubmission)
 	switch {
 	case areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced):
 		t.Errorf("Order failed to be placed: %v", err)
@@ -429,7 +432,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		OrderID: "266029865",
 	}
 
-	err := g.CancelOrder(orderCancellation)
+	err := g.CancelOrder(context.Background(), orderCancellation)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -454,7 +457,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := g.CancelAllOrders(orderCancellation)
+	resp, err := g.CancelAllOrders(context.Background(), orderCancellation)
 	switch {
 	case !areTestAPIKeysSet() && err == nil && !mockTests:
 		t.Error("Expecting an error when no keys are set")
@@ -471,7 +474,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestModifyOrder(t *testing.T) {
 	t.Parallel()
-	_, err := g.ModifyOrder(&order.Modify{})
+	_, err := g.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -492,7 +495,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := g.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := g.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -511,7 +514,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := g.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := g.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -526,7 +529,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := g.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := g.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -536,7 +539,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := g.GetDepositAddress(currency.BTC, "")
+	_, err := g.GetDepositAddress(context.Background(), currency.BTC, "")
 	if err == nil {
 		t.Error("GetDepositAddress error cannot be nil")
 	}
@@ -569,3 +572,36 @@ func TestWsAuth(t *testing.T) {
 	}
 	timer.Stop()
 }
+
+func TestWsExecutionReport(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"type":"fill","order_id":"556309","api_session":"UI","symbol":"ethbtc","side":"sell","order_type":"exchange limit","timestamp":"1478729284","timestampms":1478729284169,"is_live":true,"is_cancelled":false,"is_hidden":false,"avg_execution_price":"0.01514","executed_amount":"0.004","remaining_amount":"0.496","original_amount":"0.5","price":"0.01514","fill":{"trade_id":"557315","liquidity":"Maker","price":"0.01514","amount":"0.004","fee":"0.0000001514","fee_currency":"BTC"},"socket_sequence":280}`)
+	var result WsOrderFilledResponse
+	err := json.Unmarshal(pressXToJSON, &result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := g.wsExecutionReport(&result)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = report.Validate(); err != nil {
+		t.Error(err)
+	}
+	if report.TradeID != "557315" || report.OrderID != "556309" {
+		t.Errorf("unexpected ids %v %v", report.TradeID, report.OrderID)
+	}
+	if report.Liquidity != order.Maker {
+		t.Errorf("expected maker liquidity, received %v", report.Liquidity)
+	}
+	if report.FeeCurrency != currency.BTC {
+		t.Errorf("expected BTC fee currency, received %v", report.FeeCurrency)
+	}
+	if !report.Amount.Equal(decimal.NewFromFloat(0.004)) {
+		t.Errorf("expected 0.004 amount, received %v", report.Amount)
+	}
+	result.Side = "sideways"
+	if _, err = g.wsExecutionReport(&result); err == nil {
+		t.Error("expected error on invalid side")
+	}
+}
@@ -12,7 +12,9 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -76,6 +78,8 @@ func (g *Gemini) WsSubscribe(dialer *websocket.Dialer) error {
 			Verbose:              g.Verbose,
 			ResponseCheckTimeout: responseCheckTimeout,
 			ResponseMaxLimit:     responseMaxLimit,
+			DisableCompression:   g.WebsocketConn.DisableCompression,
+			Keepalive:            g.WebsocketConn.Keepalive,
 		}
 		err := connection.Dial(dialer, http.Header{})
 		if err != nil {
@@ -97,7 +101,7 @@ func (g *Gemini) WsSecureSubscribe(dialer *websocket.Dialer, url string) error {
 	}
 	payload := WsRequestPayload{
 		Request: fmt.Sprintf("/v1/%v", url),
-		Nonce:   time.Now().UnixNano(),
+		Nonce:   g.Requester.Now().UnixNano(),
 	}
 	PayloadJSON, err := json.Marshal(payload)
 	if err != nil {
@@ -121,6 +125,8 @@ func (g *Gemini) WsSecureSubscribe(dialer *websocket.Dialer, url string) error {
 		Verbose:              g.Verbose,
 		ResponseCheckTimeout: responseCheckTimeout,
 		ResponseMaxLimit:     responseMaxLimit,
+		DisableCompression:   g.WebsocketConn.DisableCompression,
+		Keepalive:            g.WebsocketConn.Keepalive,
 	}
 	err = g.AuthenticatedWebsocketConn.Dial(dialer, headers)
 	if err != nil {
@@ -176,7 +182,7 @@ func (g *Gemini) WsHandleData() {
 				var result WsSubscriptionAcknowledgementResponse
 				err := json.Unmarshal(resp.Raw, &result)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				g.Websocket.DataHandler <- result
@@ -184,7 +190,7 @@ func (g *Gemini) WsHandleData() {
 				var result WsSubscriptionAcknowledgementResponse
 				err := json.Unmarshal(resp.Raw, &result)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				g.Websocket.DataHandler <- result
@@ -192,7 +198,7 @@ func (g *Gemini) WsHandleData() {
 				var result WsActiveOrdersResponse
 				err := json.Unmarshal(resp.Raw, &result)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				g.Websocket.DataHandler <- result
@@ -200,23 +206,28 @@ func (g *Gemini) WsHandleData() {
 				var result WsOrderBookedResponse
 				err := json.Unmarshal(resp.Raw, &result)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				g.Websocket.DataHandler <- result
 			case "fill":
 				var result WsOrderFilledResponse
 				err := json.Unmarshal(resp.Raw, &result)
+				if err != nil {
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
+					continue
+				}
+				report, err := g.wsExecutionReport(&result)
 				if err != nil {
 					g.Websocket.DataHandler <- err
 					continue
 				}
-				g.Websocket.DataHandler <- result
+				g.Websocket.DataHandler <- report
 			case "cancelled":
 				var result WsOrderCancelledResponse
 				err := json.Unmarshal(resp.Raw, &result)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				g.Websocket.DataHandler <- result
@@ -224,7 +235,7 @@ func (g *Gemini) WsHandleData() {
 				var result WsOrderClosedResponse
 				err := json.Unmarshal(resp.Raw, &result)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				g.Websocket.DataHandler <- result
@@ -232,7 +243,7 @@ func (g *Gemini) WsHandleData() {
 				var result WsHeartbeatResponse
 				err := json.Unmarshal(resp.Raw, &result)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				g.Websocket.DataHandler <- result
@@ -245,7 +256,7 @@ func (g *Gemini) WsHandleData() {
 				var marketUpdate WsMarketUpdateResponse
 				err := json.Unmarshal(resp.Raw, &marketUpdate)
 				if err != nil {
-					g.Websocket.DataHandler <- err
+					g.Websocket.DataHandler <- wshandler.NewError(g.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				g.wsProcessUpdate(marketUpdate, resp.Currency)
@@ -257,6 +268,29 @@ func (g *Gemini) WsHandleData() {
 	}
 }
 
+// wsExecutionReport converts an order fill event into a normalised execution
+// report
+func (g *Gemini) wsExecutionReport(result *WsOrderFilledResponse) (order.ExecutionReport, error) {
+	side, err := order.StringToOrderSide(result.Side)
+	if err != nil {
+		return order.ExecutionReport{}, err
+	}
+	return order.ExecutionReport{
+		Exchange:    g.Name,
+		AssetType:   asset.Spot,
+		Pair:        result.Symbol,
+		OrderID:     result.OrderID,
+		TradeID:     result.Fill.TradeID,
+		Side:        side,
+		Price:       decimal.NewFromFloat(result.Fill.Price),
+		Amount:      decimal.NewFromFloat(result.Fill.Amount),
+		Fee:         decimal.NewFromFloat(result.Fill.Fee),
+		FeeCurrency: currency.NewCode(result.Fill.FeeCurrency),
+		Liquidity:   order.StringToLiquidity(result.Fill.Liquidity),
+		Timestamp:   convert.TimeFromUnix(result.Timestampms, time.Millisecond),
+	}, nil
+}
+
 // wsProcessUpdate handles order book data
 func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pair) {
 	if result.Timestamp == 0 && result.TimestampMS == 0 {
@@ -297,7 +331,7 @@ func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pa
 		for i := range result.Events {
 			if result.Events[i].Type == "trade" {
 				g.Websocket.DataHandler <- wshandler.TradeData{
-					Timestamp:    time.Unix(0, result.Timestamp),
+					Timestamp:    convert.TimeFromUnix(result.TimestampMS, time.Millisecond),
 					CurrencyPair: pair,
 					AssetType:    asset.Spot,
 					Exchange:     g.Name,
@@ -321,7 +355,7 @@ func (g *Gemini) wsProcessUpdate(result WsMarketUpdateResponse, pair currency.Pa
 			Asks:       asks,
 			Bids:       bids,
 			Pair:       pair,
-			UpdateTime: time.Unix(0, result.TimestampMS),
+			UpdateTime: convert.TimeFromUnix(result.TimestampMS, time.Millisecond),
 			Asset:      asset.Spot,
 		})
 		if err != nil {
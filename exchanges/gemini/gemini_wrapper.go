@@ -1,6 +1,7 @@
 package gemini
 
 import (
+	"context"
 	"errors"
 	"net/url"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +40,7 @@ func (g *Gemini) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if g.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = g.UpdateTradablePairs(true)
+		err = g.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -152,10 +154,13 @@ func (g *Gemini) Setup(exch *config.ExchangeConfig) error {
 	g.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         g.Name,
 		URL:                  g.Websocket.GetWebsocketURL(),
+		AlternateURLs:        g.Websocket.GetAlternateURLs(),
 		ProxyURL:             g.Websocket.GetProxyAddress(),
 		Verbose:              g.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	g.Websocket.Orderbook.Setup(
@@ -187,21 +192,21 @@ func (g *Gemini) Run() {
 		return
 	}
 
-	err := g.UpdateTradablePairs(false)
+	err := g.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", g.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (g *Gemini) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	return g.GetSymbols()
+func (g *Gemini) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	return g.GetSymbols(ctx)
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (g *Gemini) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := g.GetSymbols()
+func (g *Gemini) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := g.GetSymbols(ctx)
 	if err != nil {
 		return err
 	}
@@ -211,10 +216,10 @@ func (g *Gemini) UpdateTradablePairs(forceUpdate bool) error {
 
 // UpdateAccountInfo Retrieves balances for all enabled currencies for the
 // Gemini exchange
-func (g *Gemini) UpdateAccountInfo() (account.Holdings, error) {
+func (g *Gemini) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = g.Name
-	accountBalance, err := g.GetBalances()
+	accountBalance, err := g.GetBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -223,8 +228,8 @@ func (g *Gemini) UpdateAccountInfo() (account.Holdings, error) {
 	for i := range accountBalance {
 		var exchangeCurrency account.Balance
 		exchangeCurrency.CurrencyName = currency.NewCode(accountBalance[i].Currency)
-		exchangeCurrency.TotalValue = accountBalance[i].Amount
-		exchangeCurrency.Hold = accountBalance[i].Available
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance[i].Amount)
+		exchangeCurrency.Hold = decimal.NewFromFloat(accountBalance[i].Available)
 		currencies = append(currencies, exchangeCurrency)
 	}
 
@@ -241,19 +246,19 @@ func (g *Gemini) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (g *Gemini) FetchAccountInfo() (account.Holdings, error) {
+func (g *Gemini) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(g.Name)
 	if err != nil {
-		return g.UpdateAccountInfo()
+		return g.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (g *Gemini) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (g *Gemini) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tick, err := g.GetTicker(p.String())
+	tick, err := g.GetTicker(ctx, p.String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -275,27 +280,27 @@ func (g *Gemini) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (g *Gemini) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (g *Gemini) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(g.Name, p, assetType)
 	if err != nil {
-		return g.UpdateTicker(p, assetType)
+		return g.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (g *Gemini) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (g *Gemini) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(g.Name, p, assetType)
 	if err != nil {
-		return g.UpdateOrderbook(p, assetType)
+		return g.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (g *Gemini) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (g *Gemini) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := g.GetOrderbook(p.String(), url.Values{})
+	orderbookNew, err := g.GetOrderbook(ctx, p.String(), url.Values{})
 	if err != nil {
 		return orderBook, err
 	}
@@ -322,17 +327,40 @@ func (g *Gemini) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (g *Gemini) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (g *Gemini) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (g *Gemini) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (g *Gemini) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (g *Gemini) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (g *Gemini) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (g *Gemini) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (g *Gemini) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (g *Gemini) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (g *Gemini) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -343,10 +371,10 @@ func (g *Gemini) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 			errors.New("only limit orders are enabled through this exchange")
 	}
 
-	response, err := g.NewOrder(
+	response, err := g.NewOrder(ctx,
 		g.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
-		s.Amount,
-		s.Price,
+		s.Amount.Float64(),
+		s.Price.Float64(),
 		s.OrderSide.String(),
 		"exchange limit")
 	if err != nil {
@@ -363,27 +391,27 @@ func (g *Gemini) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (g *Gemini) ModifyOrder(action *order.Modify) (string, error) {
+func (g *Gemini) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (g *Gemini) CancelOrder(order *order.Cancel) error {
+func (g *Gemini) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	_, err = g.CancelExistingOrder(orderIDInt)
+	_, err = g.CancelExistingOrder(ctx, orderIDInt)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (g *Gemini) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (g *Gemini) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	resp, err := g.CancelExistingOrders(false)
+	resp, err := g.CancelExistingOrders(ctx, false)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -396,14 +424,14 @@ func (g *Gemini) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 }
 
 // GetOrderInfo returns information on a current open order
-func (g *Gemini) GetOrderInfo(orderID string) (order.Detail, error) {
+func (g *Gemini) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (g *Gemini) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	addr, err := g.GetCryptoDepositAddress("", cryptocurrency.String())
+func (g *Gemini) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	addr, err := g.GetCryptoDepositAddress(ctx, "", cryptocurrency.String())
 	if err != nil {
 		return "", err
 	}
@@ -412,8 +440,8 @@ func (g *Gemini) GetDepositAddress(cryptocurrency currency.Code, _ string) (stri
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (g *Gemini) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	resp, err := g.WithdrawCrypto(withdrawRequest.Address, withdrawRequest.Currency.String(), withdrawRequest.Amount)
+func (g *Gemini) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	resp, err := g.WithdrawCrypto(ctx, withdrawRequest.Address, withdrawRequest.Currency.String(), withdrawRequest.Amount)
 	if err != nil {
 		return "", err
 	}
@@ -426,13 +454,13 @@ func (g *Gemini) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoReq
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (g *Gemini) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (g *Gemini) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (g *Gemini) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (g *Gemini) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -442,17 +470,17 @@ func (g *Gemini) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (g *Gemini) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (g *Gemini) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if (!g.AllowAuthenticatedRequest() || g.SkipAuthCheck) && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return g.GetFee(feeBuilder)
+	return g.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (g *Gemini) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := g.GetOrders()
+func (g *Gemini) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := g.GetOrders(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -472,14 +500,14 @@ func (g *Gemini) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 		orderDate := time.Unix(resp[i].Timestamp, 0)
 
 		orders = append(orders, order.Detail{
-			Amount:          resp[i].OriginalAmount,
-			RemainingAmount: resp[i].RemainingAmount,
+			Amount:          decimal.NewFromFloat(resp[i].OriginalAmount),
+			RemainingAmount: decimal.NewFromFloat(resp[i].RemainingAmount),
 			ID:              strconv.FormatInt(resp[i].OrderID, 10),
-			ExecutedAmount:  resp[i].ExecutedAmount,
+			ExecutedAmount:  decimal.NewFromFloat(resp[i].ExecutedAmount),
 			Exchange:        g.Name,
 			OrderType:       orderType,
 			OrderSide:       side,
-			Price:           resp[i].Price,
+			Price:           decimal.NewFromFloat(resp[i].Price),
 			CurrencyPair:    symbol,
 			OrderDate:       orderDate,
 		})
@@ -494,14 +522,14 @@ func (g *Gemini) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (g *Gemini) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (g *Gemini) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		return nil, errors.New("currency must be supplied")
 	}
 
 	var trades []TradeHistory
 	for j := range req.Currencies {
-		resp, err := g.GetTradeHistory(g.FormatExchangeCurrency(req.Currencies[j],
+		resp, err := g.GetTradeHistory(ctx, g.FormatExchangeCurrency(req.Currencies[j],
 			asset.Spot).String(),
 			req.StartTicks.Unix())
 		if err != nil {
@@ -521,13 +549,13 @@ func (g *Gemini) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 		orderDate := time.Unix(trades[i].Timestamp, 0)
 
 		orders = append(orders, order.Detail{
-			Amount:    trades[i].Amount,
+			Amount:    decimal.NewFromFloat(trades[i].Amount),
 			ID:        strconv.FormatInt(trades[i].OrderID, 10),
 			Exchange:  g.Name,
 			OrderDate: orderDate,
 			OrderSide: side,
-			Fee:       trades[i].FeeAmount,
-			Price:     trades[i].Price,
+			Fee:       decimal.NewFromFloat(trades[i].FeeAmount),
+			Price:     decimal.NewFromFloat(trades[i].Price),
 			CurrencyPair: currency.NewPairWithDelimiter(trades[i].BaseCurrency,
 				trades[i].QuoteCurrency,
 				g.GetPairFormat(asset.Spot, false).Delimiter),
@@ -563,7 +591,12 @@ func (g *Gemini) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (g *Gemini) ValidateCredentials() error {
-	_, err := g.UpdateAccountInfo()
+func (g *Gemini) ValidateCredentials(ctx context.Context) error {
+	_, err := g.UpdateAccountInfo(ctx)
 	return g.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (g *Gemini) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
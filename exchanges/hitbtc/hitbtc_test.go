@@ -1,6 +1,8 @@
 package hitbtc
 
 import (
+	"context"
+	"encoding/json"
 	"log"
 	"net/http"
 	"os"
@@ -9,6 +11,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -55,28 +58,28 @@ func TestMain(m *testing.M) {
 }
 
 func TestGetOrderbook(t *testing.T) {
-	_, err := h.GetOrderbook("BTCUSD", 50)
+	_, err := h.GetOrderbook(context.Background(), "BTCUSD", 50)
 	if err != nil {
 		t.Error("Test faild - HitBTC GetOrderbook() error", err)
 	}
 }
 
 func TestGetTrades(t *testing.T) {
-	_, err := h.GetTrades("BTCUSD", "", "", "", "", "", "")
+	_, err := h.GetTrades(context.Background(), "BTCUSD", "", "", "", "", "", "")
 	if err != nil {
 		t.Error("Test faild - HitBTC GetTradeHistory() error", err)
 	}
 }
 
 func TestGetChartCandles(t *testing.T) {
-	_, err := h.GetCandles("BTCUSD", "", "")
+	_, err := h.GetCandles(context.Background(), "BTCUSD", "", "")
 	if err != nil {
 		t.Error("Test faild - HitBTC GetChartData() error", err)
 	}
 }
 
 func TestGetCurrencies(t *testing.T) {
-	_, err := h.GetCurrencies()
+	_, err := h.GetCurrencies(context.Background())
 	if err != nil {
 		t.Error("Test faild - HitBTC GetCurrencies() error", err)
 	}
@@ -96,7 +99,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	h.GetFeeByType(feeBuilder)
+	h.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -110,26 +113,26 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 
 func TestUpdateTicker(t *testing.T) {
 	h.CurrencyPairs.StorePairs(asset.Spot, currency.NewPairsFromStrings([]string{"BTC-USD", "XRP-USD"}), true)
-	_, err := h.UpdateTicker(currency.NewPair(currency.BTC, currency.USD), asset.Spot)
+	_, err := h.UpdateTicker(context.Background(), currency.NewPair(currency.BTC, currency.USD), asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = h.FetchTicker(currency.NewPair(currency.XRP, currency.USD), asset.Spot)
+	_, err = h.FetchTicker(context.Background(), currency.NewPair(currency.XRP, currency.USD), asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
 }
 
 func TestGetAllTickers(t *testing.T) {
-	_, err := h.GetTickers()
+	_, err := h.GetTickers(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
 }
 
 func TestGetSingularTicker(t *testing.T) {
-	_, err := h.GetTicker("BTCUSD")
+	_, err := h.GetTicker(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error(err)
 	}
@@ -139,7 +142,7 @@ func TestGetFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
 	if areTestAPIKeysSet() {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := h.GetFee(feeBuilder); resp != float64(0.002) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0.002) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.002), resp)
 		}
@@ -148,7 +151,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := h.GetFee(feeBuilder); resp != float64(2000) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(2000) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(2000), resp)
 			t.Error(err)
 		}
@@ -156,7 +159,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := h.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.001), resp)
 			t.Error(err)
 		}
@@ -164,7 +167,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := h.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -172,7 +175,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyWithdrawalFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := h.GetFee(feeBuilder); resp != float64(0.042800) || err != nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0.042800) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.042800), resp)
 			t.Error(err)
 		}
@@ -181,7 +184,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Pair.Base = currency.NewCode("hello")
 		feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-		if resp, err := h.GetFee(feeBuilder); resp != float64(0) || err == nil {
+		if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0) || err == nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -192,7 +195,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
 	feeBuilder.Pair.Base = currency.BTC
 	feeBuilder.Pair.Quote = currency.LTC
-	if resp, err := h.GetFee(feeBuilder); resp != float64(0.0006) || err != nil {
+	if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0.0006) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0006), resp)
 		t.Error(err)
 	}
@@ -200,7 +203,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := h.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -209,7 +212,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := h.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := h.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -229,7 +232,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Currencies: []currency.Pair{currency.NewPair(currency.ETH, currency.BTC)},
 	}
 
-	_, err := h.GetActiveOrders(&getOrdersRequest)
+	_, err := h.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -243,7 +246,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Currencies: []currency.Pair{currency.NewPair(currency.ETH, currency.BTC)},
 	}
 
-	_, err := h.GetOrderHistory(&getOrdersRequest)
+	_, err := h.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -269,11 +272,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := h.SubmitOrder(orderSubmission)
+	response, err := h.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -294,7 +297,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := h.CancelOrder(orderCancellation)
+	err := h.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -316,7 +319,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := h.CancelAllOrders(orderCancellation)
+	resp, err := h.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -334,7 +337,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := h.ModifyOrder(&order.Modify{})
+	_, err := h.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -354,7 +357,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := h.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := h.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -369,7 +372,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := h.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := h.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -381,7 +384,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := h.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := h.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -389,12 +392,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := h.GetDepositAddress(currency.BTC, "")
+		_, err := h.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := h.GetDepositAddress(currency.BTC, "")
+		_, err := h.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
@@ -516,3 +519,45 @@ func TestSsGetCurrencies(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestWsErrorKind(t *testing.T) {
+	if wsErrorKind(1002) != wshandler.ErrorAuthFailed {
+		t.Error("expected 1002 to be an auth failure")
+	}
+	if wsErrorKind(429) != wshandler.ErrorRateLimited {
+		t.Error("expected 429 to be rate limited")
+	}
+	if wsErrorKind(2001) != wshandler.ErrorUnknown {
+		t.Error("expected 2001 to be unknown")
+	}
+}
+
+func TestWsExecutionReport(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`{"id":"4345697765","clientOrderId":"53b7cf917963464a811a4af426102c19","symbol":"BTCUSD","side":"sell","status":"partiallyFilled","type":"limit","timeInForce":"GTC","quantity":"0.013","price":"0.100000","cumQuantity":"0.002","postOnly":false,"createdAt":"2017-10-20T12:20:05.952Z","updatedAt":"2017-10-20T12:20:38.708Z","reportType":"trade","tradeQuantity":"0.002","tradePrice":"0.100000","tradeId":55051694,"tradeFee":"-0.000000005"}`)
+	var r WsReportResponseData
+	err := json.Unmarshal(pressXToJSON, &r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, err := h.wsExecutionReport(&r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = report.Validate(); err != nil {
+		t.Error(err)
+	}
+	if report.TradeID != "55051694" || report.OrderID != "4345697765" {
+		t.Errorf("unexpected ids %v %v", report.TradeID, report.OrderID)
+	}
+	if report.FeeCurrency != currency.USD {
+		t.Errorf("expected USD fee currency, received %v", report.FeeCurrency)
+	}
+	if report.Side != order.Sell {
+		t.Errorf("expected sell side, received %v", report.Side)
+	}
+	r.Side = "sideways"
+	if _, err = h.wsExecutionReport(&r); err == nil {
+		t.Error("expected error on invalid side")
+	}
+}
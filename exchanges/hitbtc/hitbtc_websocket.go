@@ -11,10 +11,12 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -26,6 +28,8 @@ const (
 	hitbtcWebsocketAddress = "wss://api.hitbtc.com/api/2/ws"
 	rpcVersion             = "2.0"
 	rateLimit              = 20
+
+	hitbtcReportTypeTrade = "trade"
 )
 
 var requestID nonce.Nonce
@@ -51,6 +55,17 @@ func (h *HitBTC) WsConnect() error {
 	return nil
 }
 
+// wsErrorKind classifies a websocket error code
+func wsErrorKind(code int) wshandler.ErrorKind {
+	switch code {
+	case 1001, 1002, 1003:
+		return wshandler.ErrorAuthFailed
+	case 429:
+		return wshandler.ErrorRateLimited
+	}
+	return wshandler.ErrorUnknown
+}
+
 // WsHandleData handles websocket data
 func (h *HitBTC) WsHandleData() {
 	h.Websocket.Wg.Add(1)
@@ -75,7 +90,7 @@ func (h *HitBTC) WsHandleData() {
 			var init capture
 			err = json.Unmarshal(resp.Raw, &init)
 			if err != nil {
-				h.Websocket.DataHandler <- err
+				h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 				continue
 			}
 			if init.Error.Code == 1002 {
@@ -86,9 +101,9 @@ func (h *HitBTC) WsHandleData() {
 				continue
 			}
 			if init.Error.Message != "" || init.Error.Code != 0 {
-				h.Websocket.DataHandler <- fmt.Errorf("hitbtc.go error - Code: %d, Message: %s",
-					init.Error.Code,
-					init.Error.Message)
+				h.Websocket.DataHandler <- wshandler.NewError(h.Name,
+					wsErrorKind(init.Error.Code),
+					fmt.Errorf("code: %d, message: %s", init.Error.Code, init.Error.Message))
 				continue
 			}
 			if _, ok := init.Result.(bool); ok {
@@ -109,7 +124,7 @@ func (h *HitBTC) handleSubscriptionUpdates(resp wshandler.WebsocketResponse, ini
 		var wsTicker WsTicker
 		err := json.Unmarshal(resp.Raw, &wsTicker)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 		ts, err := time.Parse(time.RFC3339, wsTicker.Params.Timestamp)
@@ -136,7 +151,7 @@ func (h *HitBTC) handleSubscriptionUpdates(resp wshandler.WebsocketResponse, ini
 		var obSnapshot WsOrderbook
 		err := json.Unmarshal(resp.Raw, &obSnapshot)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 		err = h.WsProcessOrderbookSnapshot(obSnapshot)
 		if err != nil {
@@ -146,38 +161,74 @@ func (h *HitBTC) handleSubscriptionUpdates(resp wshandler.WebsocketResponse, ini
 		var obUpdate WsOrderbook
 		err := json.Unmarshal(resp.Raw, &obUpdate)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 		h.WsProcessOrderbookUpdate(obUpdate)
 	case "snapshotTrades":
 		var tradeSnapshot WsTrade
 		err := json.Unmarshal(resp.Raw, &tradeSnapshot)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 	case "updateTrades":
 		var tradeUpdates WsTrade
 		err := json.Unmarshal(resp.Raw, &tradeUpdates)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 	case "activeOrders":
 		var activeOrders WsActiveOrdersResponse
 		err := json.Unmarshal(resp.Raw, &activeOrders)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 		h.Websocket.DataHandler <- activeOrders
 	case "report":
 		var reportData WsReportResponse
 		err := json.Unmarshal(resp.Raw, &reportData)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
+			return
+		}
+		if reportData.Params.ReportType == hitbtcReportTypeTrade {
+			var report order.ExecutionReport
+			report, err = h.wsExecutionReport(&reportData.Params)
+			if err != nil {
+				h.Websocket.DataHandler <- err
+			} else {
+				h.Websocket.DataHandler <- report
+			}
 		}
 		h.Websocket.DataHandler <- reportData
 	}
 }
 
+// wsExecutionReport converts a trade report into a normalised execution
+// report. HitBTC charges fees in the quote currency and reports rebates as a
+// negative fee
+func (h *HitBTC) wsExecutionReport(r *WsReportResponseData) (order.ExecutionReport, error) {
+	side, err := order.StringToOrderSide(r.Side)
+	if err != nil {
+		return order.ExecutionReport{}, err
+	}
+	p := currency.NewPairFromFormattedPairs(r.Symbol,
+		h.GetEnabledPairs(asset.Spot), h.GetPairFormat(asset.Spot, true))
+	return order.ExecutionReport{
+		Exchange:    h.Name,
+		AssetType:   asset.Spot,
+		Pair:        p,
+		OrderID:     r.ID,
+		TradeID:     strconv.FormatInt(r.TradeID, 10),
+		Side:        side,
+		Price:       decimal.NewFromFloat(r.TradePrice),
+		Amount:      decimal.NewFromFloat(r.TradeQuantity),
+		Fee:         decimal.NewFromFloat(r.TradeFee),
+		FeeCurrency: p.Quote,
+		Liquidity:   order.UnknownLiquidity,
+		Timestamp:   r.UpdatedAt,
+	}, nil
+}
+
 func (h *HitBTC) handleCommandResponses(resp wshandler.WebsocketResponse, init capture) {
 	switch resultType := init.Result.(type) {
 	case map[string]interface{}:
@@ -186,21 +237,21 @@ func (h *HitBTC) handleCommandResponses(resp wshandler.WebsocketResponse, init c
 			var response WsSubmitOrderSuccessResponse
 			err := json.Unmarshal(resp.Raw, &response)
 			if err != nil {
-				h.Websocket.DataHandler <- err
+				h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			}
 			h.Websocket.DataHandler <- response
 		case "canceled":
 			var response WsCancelOrderResponse
 			err := json.Unmarshal(resp.Raw, &response)
 			if err != nil {
-				h.Websocket.DataHandler <- err
+				h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			}
 			h.Websocket.DataHandler <- response
 		case "replaced":
 			var response WsReplaceOrderResponse
 			err := json.Unmarshal(resp.Raw, &response)
 			if err != nil {
-				h.Websocket.DataHandler <- err
+				h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			}
 			h.Websocket.DataHandler <- response
 		}
@@ -214,14 +265,14 @@ func (h *HitBTC) handleCommandResponses(resp wshandler.WebsocketResponse, init c
 			var response WsActiveOrdersResponse
 			err := json.Unmarshal(resp.Raw, &response)
 			if err != nil {
-				h.Websocket.DataHandler <- err
+				h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			}
 			h.Websocket.DataHandler <- response
 		} else if _, ok := data["available"]; ok {
 			var response WsGetTradingBalanceResponse
 			err := json.Unmarshal(resp.Raw, &response)
 			if err != nil {
-				h.Websocket.DataHandler <- err
+				h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			}
 			h.Websocket.DataHandler <- response
 		}
@@ -397,7 +448,7 @@ func (h *HitBTC) wsLogin() error {
 		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", h.Name)
 	}
 	h.Websocket.SetCanUseAuthenticatedEndpoints(true)
-	nonce := strconv.FormatInt(time.Now().Unix(), 10)
+	nonce := strconv.FormatInt(h.Requester.Now().Unix(), 10)
 	hmac := crypto.GetHMAC(crypto.HashSHA256, []byte(nonce), []byte(h.API.Credentials.Secret))
 	request := WsLoginRequest{
 		Method: "login",
@@ -1,13 +1,16 @@
 package hitbtc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +40,7 @@ func (h *HitBTC) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if h.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = h.UpdateTradablePairs(true)
+		err = h.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -157,11 +160,14 @@ func (h *HitBTC) Setup(exch *config.ExchangeConfig) error {
 	h.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         h.Name,
 		URL:                  h.Websocket.GetWebsocketURL(),
+		AlternateURLs:        h.Websocket.GetAlternateURLs(),
 		ProxyURL:             h.Websocket.GetProxyAddress(),
 		Verbose:              h.Verbose,
 		RateLimit:            rateLimit,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	h.Websocket.Orderbook.Setup(
@@ -208,15 +214,15 @@ func (h *HitBTC) Run() {
 		return
 	}
 
-	err := h.UpdateTradablePairs(forceUpdate)
+	err := h.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", h.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (h *HitBTC) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	symbols, err := h.GetSymbolsDetailed()
+func (h *HitBTC) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	symbols, err := h.GetSymbolsDetailed(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -231,8 +237,8 @@ func (h *HitBTC) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (h *HitBTC) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := h.FetchTradablePairs(asset.Spot)
+func (h *HitBTC) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := h.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -241,9 +247,9 @@ func (h *HitBTC) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (h *HitBTC) UpdateTicker(currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (h *HitBTC) UpdateTicker(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tick, err := h.GetTickers()
+	tick, err := h.GetTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -284,27 +290,27 @@ func (h *HitBTC) UpdateTicker(currencyPair currency.Pair, assetType asset.Item)
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (h *HitBTC) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (h *HitBTC) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(h.Name, p, assetType)
 	if err != nil {
-		return h.UpdateTicker(p, assetType)
+		return h.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (h *HitBTC) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (h *HitBTC) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(h.Name, p, assetType)
 	if err != nil {
-		return h.UpdateOrderbook(p, assetType)
+		return h.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (h *HitBTC) UpdateOrderbook(currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (h *HitBTC) UpdateOrderbook(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := h.GetOrderbook(h.FormatExchangeCurrency(currencyPair, assetType).String(), 1000)
+	orderbookNew, err := h.GetOrderbook(ctx, h.FormatExchangeCurrency(currencyPair, assetType).String(), 1000)
 	if err != nil {
 		return orderBook, err
 	}
@@ -337,10 +343,10 @@ func (h *HitBTC) UpdateOrderbook(currencyPair currency.Pair, assetType asset.Ite
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // HitBTC exchange
-func (h *HitBTC) UpdateAccountInfo() (account.Holdings, error) {
+func (h *HitBTC) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = h.Name
-	accountBalance, err := h.GetBalances()
+	accountBalance, err := h.GetBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -349,8 +355,8 @@ func (h *HitBTC) UpdateAccountInfo() (account.Holdings, error) {
 	for i := range accountBalance {
 		var exchangeCurrency account.Balance
 		exchangeCurrency.CurrencyName = currency.NewCode(accountBalance[i].Currency)
-		exchangeCurrency.TotalValue = accountBalance[i].Available
-		exchangeCurrency.Hold = accountBalance[i].Reserved
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance[i].Available)
+		exchangeCurrency.Hold = decimal.NewFromFloat(accountBalance[i].Reserved)
 		currencies = append(currencies, exchangeCurrency)
 	}
 
@@ -367,10 +373,10 @@ func (h *HitBTC) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (h *HitBTC) FetchAccountInfo() (account.Holdings, error) {
+func (h *HitBTC) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(h.Name)
 	if err != nil {
-		return h.UpdateAccountInfo()
+		return h.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -378,17 +384,40 @@ func (h *HitBTC) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (h *HitBTC) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (h *HitBTC) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (h *HitBTC) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (h *HitBTC) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (h *HitBTC) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (h *HitBTC) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (h *HitBTC) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (h *HitBTC) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (h *HitBTC) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
+func (h *HitBTC) SubmitOrder(ctx context.Context, o *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	err := o.Validate()
 	if err != nil {
@@ -396,19 +425,19 @@ func (h *HitBTC) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 	}
 	if h.Websocket.IsConnected() && h.Websocket.CanUseAuthenticatedEndpoints() {
 		var response *WsSubmitOrderSuccessResponse
-		response, err = h.wsPlaceOrder(o.Pair, o.OrderSide.String(), o.Amount, o.Price)
+		response, err = h.wsPlaceOrder(o.Pair, o.OrderSide.String(), o.Amount.Float64(), o.Price.Float64())
 		if err != nil {
 			return submitOrderResponse, err
 		}
 		submitOrderResponse.OrderID = strconv.FormatInt(response.ID, 10)
-		if response.Result.CumQuantity == o.Amount {
+		if o.Amount.Equal(decimal.NewFromFloat(response.Result.CumQuantity)) {
 			submitOrderResponse.FullyMatched = true
 		}
 	} else {
 		var response OrderResponse
-		response, err = h.PlaceOrder(o.Pair.String(),
-			o.Price,
-			o.Amount,
+		response, err = h.PlaceOrder(ctx, o.Pair.String(),
+			o.Price.Float64(),
+			o.Amount.Float64(),
 			strings.ToLower(o.OrderType.String()),
 			strings.ToLower(o.OrderSide.String()))
 		if err != nil {
@@ -428,28 +457,28 @@ func (h *HitBTC) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (h *HitBTC) ModifyOrder(action *order.Modify) (string, error) {
+func (h *HitBTC) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (h *HitBTC) CancelOrder(order *order.Cancel) error {
+func (h *HitBTC) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	_, err = h.CancelExistingOrder(orderIDInt)
+	_, err = h.CancelExistingOrder(ctx, orderIDInt)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (h *HitBTC) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (h *HitBTC) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
 
-	resp, err := h.CancelAllExistingOrders()
+	resp, err := h.CancelAllExistingOrders(ctx)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -467,14 +496,14 @@ func (h *HitBTC) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 }
 
 // GetOrderInfo returns information on a current open order
-func (h *HitBTC) GetOrderInfo(orderID string) (order.Detail, error) {
+func (h *HitBTC) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (h *HitBTC) GetDepositAddress(currency currency.Code, _ string) (string, error) {
-	resp, err := h.GetDepositAddresses(currency.String())
+func (h *HitBTC) GetDepositAddress(ctx context.Context, currency currency.Code, _ string) (string, error) {
+	resp, err := h.GetDepositAddresses(ctx, currency.String())
 	if err != nil {
 		return "", err
 	}
@@ -484,20 +513,20 @@ func (h *HitBTC) GetDepositAddress(currency currency.Code, _ string) (string, er
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (h *HitBTC) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	_, err := h.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.Address, withdrawRequest.Amount)
+func (h *HitBTC) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	_, err := h.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.Address, withdrawRequest.Amount)
 	return "", err
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (h *HitBTC) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (h *HitBTC) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (h *HitBTC) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (h *HitBTC) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -507,23 +536,23 @@ func (h *HitBTC) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (h *HitBTC) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (h *HitBTC) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !h.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return h.GetFee(feeBuilder)
+	return h.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (h *HitBTC) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (h *HitBTC) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		return nil, errors.New("currency must be supplied")
 	}
 
 	var allOrders []OrderHistoryResponse
 	for i := range req.Currencies {
-		resp, err := h.GetOpenOrders(req.Currencies[i].String())
+		resp, err := h.GetOpenOrders(ctx, req.Currencies[i].String())
 		if err != nil {
 			return nil, err
 		}
@@ -537,9 +566,9 @@ func (h *HitBTC) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 		side := order.Side(strings.ToUpper(allOrders[i].Side))
 		orders = append(orders, order.Detail{
 			ID:           allOrders[i].ID,
-			Amount:       allOrders[i].Quantity,
+			Amount:       decimal.NewFromFloat(allOrders[i].Quantity),
 			Exchange:     h.Name,
-			Price:        allOrders[i].Price,
+			Price:        decimal.NewFromFloat(allOrders[i].Price),
 			OrderDate:    allOrders[i].CreatedAt,
 			OrderSide:    side,
 			CurrencyPair: symbol,
@@ -553,14 +582,14 @@ func (h *HitBTC) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (h *HitBTC) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (h *HitBTC) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		return nil, errors.New("currency must be supplied")
 	}
 
 	var allOrders []OrderHistoryResponse
 	for i := range req.Currencies {
-		resp, err := h.GetOrders(req.Currencies[i].String())
+		resp, err := h.GetOrders(ctx, req.Currencies[i].String())
 		if err != nil {
 			return nil, err
 		}
@@ -574,9 +603,9 @@ func (h *HitBTC) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 		side := order.Side(strings.ToUpper(allOrders[i].Side))
 		orders = append(orders, order.Detail{
 			ID:           allOrders[i].ID,
-			Amount:       allOrders[i].Quantity,
+			Amount:       decimal.NewFromFloat(allOrders[i].Quantity),
 			Exchange:     h.Name,
-			Price:        allOrders[i].Price,
+			Price:        decimal.NewFromFloat(allOrders[i].Price),
 			OrderDate:    allOrders[i].CreatedAt,
 			OrderSide:    side,
 			CurrencyPair: symbol,
@@ -588,18 +617,16 @@ func (h *HitBTC) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, e
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (h *HitBTC) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	h.Websocket.SubscribeToChannels(channels)
-	return nil
+	return h.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (h *HitBTC) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	h.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return h.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -614,7 +641,12 @@ func (h *HitBTC) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (h *HitBTC) ValidateCredentials() error {
-	_, err := h.UpdateAccountInfo()
+func (h *HitBTC) ValidateCredentials(ctx context.Context) error {
+	_, err := h.UpdateAccountInfo(ctx)
 	return h.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (h *HitBTC) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
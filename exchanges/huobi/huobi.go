@@ -2,13 +2,13 @@ package huobi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
-	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
@@ -19,9 +19,10 @@ import (
 )
 
 const (
-	huobiAPIURL      = "https://api.huobi.pro"
-	huobiAPIVersion  = "1"
-	huobiAPIVersion2 = "2"
+	huobiAPIURL        = "https://api.huobi.pro"
+	huobiFuturesAPIURL = "https://api.hbdm.com"
+	huobiAPIVersion    = "1"
+	huobiAPIVersion2   = "2"
 
 	huobiMarketHistoryKline    = "market/history/kline"
 	huobiMarketDetail          = "market/detail"
@@ -56,6 +57,29 @@ const (
 	huobiWithdrawCreate        = "dw/withdraw/api/create"
 	huobiWithdrawCancel        = "dw/withdraw-virtual/%s/cancel"
 	huobiStatusError           = "error"
+
+	// Spot account transfers to and from the derivatives accounts
+	huobiTransferFutures = "futures/transfer"
+	huobiAccountTransfer = "account/transfer"
+
+	// Coin margined futures
+	huobiFuturesContractInfo = "/api/v1/contract_contract_info"
+	huobiFuturesMarketDepth  = "/market/depth"
+	huobiFuturesMarketDetail = "/market/detail/merged"
+	huobiFuturesAccountInfo  = "/api/v1/contract_account_info"
+	huobiFuturesPositionInfo = "/api/v1/contract_position_info"
+
+	// USDT margined swaps
+	huobiSwapContractInfo      = "/linear-swap-api/v1/swap_contract_info"
+	huobiSwapMarketDepth       = "/linear-swap-ex/market/depth"
+	huobiSwapMarketDetail      = "/linear-swap-ex/market/detail/merged"
+	huobiSwapIsolatedPositions = "/linear-swap-api/v1/swap_position_info"
+	huobiSwapCrossPositions    = "/linear-swap-api/v1/swap_cross_position_info"
+	huobiUnifiedAccountInfo    = "/linear-swap-api/v3/unified_account_info"
+	huobiUnifiedAccountType    = "/linear-swap-api/v3/swap_unified_account_type"
+	huobiSwitchAccountType     = "/linear-swap-api/v3/swap_switch_account_type"
+
+	huobiStatusOK = "ok"
 )
 
 // HUOBI is the overarching type across this package
@@ -67,13 +91,13 @@ type HUOBI struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (h *HUOBI) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (h *HUOBI) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetSpotKline returns kline data
 // KlinesRequestParams contains symbol, period and size
-func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
+func (h *HUOBI) GetSpotKline(ctx context.Context, arg KlinesRequestParams) ([]KlineItem, error) {
 	vals := url.Values{}
 	vals.Set("symbol", arg.Symbol)
 	vals.Set("period", string(arg.Period))
@@ -90,7 +114,7 @@ func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketHistoryKline)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -98,14 +122,14 @@ func (h *HUOBI) GetSpotKline(arg KlinesRequestParams) ([]KlineItem, error) {
 }
 
 // GetTickers returns the ticker for the specified symbol
-func (h *HUOBI) GetTickers() (Tickers, error) {
+func (h *HUOBI) GetTickers(ctx context.Context) (Tickers, error) {
 	var result Tickers
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketTickers)
-	return result, h.SendHTTPRequest(urlPath, &result)
+	return result, h.SendHTTPRequest(ctx, urlPath, &result)
 }
 
 // GetMarketDetailMerged returns the ticker for the specified symbol
-func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
+func (h *HUOBI) GetMarketDetailMerged(ctx context.Context, symbol string) (DetailMerged, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -117,7 +141,7 @@ func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketDetailMerged)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Tick, errors.New(result.ErrorMessage)
 	}
@@ -125,7 +149,7 @@ func (h *HUOBI) GetMarketDetailMerged(symbol string) (DetailMerged, error) {
 }
 
 // GetDepth returns the depth for the specified symbol
-func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
+func (h *HUOBI) GetDepth(ctx context.Context, obd OrderBookDataRequestParams) (Orderbook, error) {
 	vals := url.Values{}
 	vals.Set("symbol", obd.Symbol)
 
@@ -141,7 +165,7 @@ func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketDepth)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Depth, errors.New(result.ErrorMessage)
 	}
@@ -149,7 +173,7 @@ func (h *HUOBI) GetDepth(obd OrderBookDataRequestParams) (Orderbook, error) {
 }
 
 // GetTrades returns the trades for the specified symbol
-func (h *HUOBI) GetTrades(symbol string) ([]Trade, error) {
+func (h *HUOBI) GetTrades(ctx context.Context, symbol string) ([]Trade, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -163,7 +187,7 @@ func (h *HUOBI) GetTrades(symbol string) ([]Trade, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketTrade)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -173,8 +197,8 @@ func (h *HUOBI) GetTrades(symbol string) ([]Trade, error) {
 // GetLatestSpotPrice returns latest spot price of symbol
 //
 // symbol: string of currency pair
-func (h *HUOBI) GetLatestSpotPrice(symbol string) (float64, error) {
-	list, err := h.GetTradeHistory(symbol, "1")
+func (h *HUOBI) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	list, err := h.GetTradeHistory(ctx, symbol, "1")
 
 	if err != nil {
 		return 0, err
@@ -187,7 +211,7 @@ func (h *HUOBI) GetLatestSpotPrice(symbol string) (float64, error) {
 }
 
 // GetTradeHistory returns the trades for the specified symbol
-func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
+func (h *HUOBI) GetTradeHistory(ctx context.Context, symbol, size string) ([]TradeHistory, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -203,7 +227,7 @@ func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketTradeHistory)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -211,7 +235,7 @@ func (h *HUOBI) GetTradeHistory(symbol, size string) ([]TradeHistory, error) {
 }
 
 // GetMarketDetail returns the ticker for the specified symbol
-func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
+func (h *HUOBI) GetMarketDetail(ctx context.Context, symbol string) (Detail, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 
@@ -223,7 +247,7 @@ func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/%s", h.API.Endpoints.URL, huobiMarketDetail)
 
-	err := h.SendHTTPRequest(common.EncodeURLValues(urlPath, vals), &result)
+	err := h.SendHTTPRequest(ctx, common.EncodeURLValues(urlPath, vals), &result)
 	if result.ErrorMessage != "" {
 		return result.Tick, errors.New(result.ErrorMessage)
 	}
@@ -231,7 +255,7 @@ func (h *HUOBI) GetMarketDetail(symbol string) (Detail, error) {
 }
 
 // GetSymbols returns an array of symbols supported by Huobi
-func (h *HUOBI) GetSymbols() ([]Symbol, error) {
+func (h *HUOBI) GetSymbols(ctx context.Context) ([]Symbol, error) {
 	type response struct {
 		Response
 		Symbols []Symbol `json:"data"`
@@ -240,7 +264,7 @@ func (h *HUOBI) GetSymbols() ([]Symbol, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/v%s/%s", h.API.Endpoints.URL, huobiAPIVersion, huobiSymbols)
 
-	err := h.SendHTTPRequest(urlPath, &result)
+	err := h.SendHTTPRequest(ctx, urlPath, &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -248,7 +272,7 @@ func (h *HUOBI) GetSymbols() ([]Symbol, error) {
 }
 
 // GetCurrencies returns a list of currencies supported by Huobi
-func (h *HUOBI) GetCurrencies() ([]string, error) {
+func (h *HUOBI) GetCurrencies(ctx context.Context) ([]string, error) {
 	type response struct {
 		Response
 		Currencies []string `json:"data"`
@@ -257,7 +281,7 @@ func (h *HUOBI) GetCurrencies() ([]string, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/v%s/%s", h.API.Endpoints.URL, huobiAPIVersion, huobiCurrencies)
 
-	err := h.SendHTTPRequest(urlPath, &result)
+	err := h.SendHTTPRequest(ctx, urlPath, &result)
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
 	}
@@ -265,7 +289,7 @@ func (h *HUOBI) GetCurrencies() ([]string, error) {
 }
 
 // GetTimestamp returns the Huobi server time
-func (h *HUOBI) GetTimestamp() (int64, error) {
+func (h *HUOBI) GetTimestamp(ctx context.Context) (int64, error) {
 	type response struct {
 		Response
 		Timestamp int64 `json:"data"`
@@ -274,7 +298,7 @@ func (h *HUOBI) GetTimestamp() (int64, error) {
 	var result response
 	urlPath := fmt.Sprintf("%s/v%s/%s", h.API.Endpoints.URL, huobiAPIVersion, huobiTimestamp)
 
-	err := h.SendHTTPRequest(urlPath, &result)
+	err := h.SendHTTPRequest(ctx, urlPath, &result)
 	if result.ErrorMessage != "" {
 		return 0, errors.New(result.ErrorMessage)
 	}
@@ -282,32 +306,32 @@ func (h *HUOBI) GetTimestamp() (int64, error) {
 }
 
 // GetAccounts returns the Huobi user accounts
-func (h *HUOBI) GetAccounts() ([]Account, error) {
+func (h *HUOBI) GetAccounts(ctx context.Context) ([]Account, error) {
 	result := struct {
 		Accounts []Account `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiAccounts, url.Values{}, nil, &result, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiAccounts, url.Values{}, nil, &result, false)
 	return result.Accounts, err
 }
 
 // GetAccountBalance returns the users Huobi account balance
-func (h *HUOBI) GetAccountBalance(accountID string) ([]AccountBalanceDetail, error) {
+func (h *HUOBI) GetAccountBalance(ctx context.Context, accountID string) ([]AccountBalanceDetail, error) {
 	result := struct {
 		AccountBalanceData AccountBalance `json:"data"`
 	}{}
 	endpoint := fmt.Sprintf(huobiAccountBalance, accountID)
 	v := url.Values{}
 	v.Set("account-id", accountID)
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, endpoint, v, nil, &result, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, endpoint, v, nil, &result, false)
 	return result.AccountBalanceData.AccountBalanceDetails, err
 }
 
 // GetAggregatedBalance returns the balances of all the sub-account aggregated.
-func (h *HUOBI) GetAggregatedBalance() ([]AggregatedBalance, error) {
+func (h *HUOBI) GetAggregatedBalance(ctx context.Context) ([]AggregatedBalance, error) {
 	result := struct {
 		AggregatedBalances []AggregatedBalance `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(
+	err := h.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodGet,
 		huobiAggregatedBalance,
 		nil,
@@ -319,7 +343,7 @@ func (h *HUOBI) GetAggregatedBalance() ([]AggregatedBalance, error) {
 }
 
 // SpotNewOrder submits an order to Huobi
-func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
+func (h *HUOBI) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (int64, error) {
 	data := struct {
 		AccountID int    `json:"account-id,string"`
 		Amount    string `json:"amount"`
@@ -346,7 +370,7 @@ func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	result := struct {
 		OrderID int64 `json:"data,string"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(
+	err := h.SendAuthenticatedHTTPRequest(ctx,
 		http.MethodPost,
 		huobiOrderPlace,
 		nil,
@@ -358,24 +382,24 @@ func (h *HUOBI) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 }
 
 // CancelExistingOrder cancels an order on Huobi
-func (h *HUOBI) CancelExistingOrder(orderID int64) (int64, error) {
+func (h *HUOBI) CancelExistingOrder(ctx context.Context, orderID int64) (int64, error) {
 	resp := struct {
 		OrderID int64 `json:"data,string"`
 	}{}
 	endpoint := fmt.Sprintf(huobiOrderCancel, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, endpoint, url.Values{}, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, endpoint, url.Values{}, nil, &resp, false)
 	return resp.OrderID, err
 }
 
 // CancelOrderBatch cancels a batch of orders -- to-do
-func (h *HUOBI) CancelOrderBatch(_ []int64) ([]CancelOrderBatch, error) {
+func (h *HUOBI) CancelOrderBatch(ctx context.Context, _ []int64) ([]CancelOrderBatch, error) {
 	type response struct {
 		Response
 		Data []CancelOrderBatch `json:"data"`
 	}
 
 	var result response
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, huobiOrderCancelBatch, url.Values{}, nil, &result, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiOrderCancelBatch, url.Values{}, nil, &result, false)
 
 	if result.ErrorMessage != "" {
 		return nil, errors.New(result.ErrorMessage)
@@ -384,7 +408,7 @@ func (h *HUOBI) CancelOrderBatch(_ []int64) ([]CancelOrderBatch, error) {
 }
 
 // CancelOpenOrdersBatch cancels a batch of orders -- to-do
-func (h *HUOBI) CancelOpenOrdersBatch(accountID, symbol string) (CancelOpenOrdersBatch, error) {
+func (h *HUOBI) CancelOpenOrdersBatch(ctx context.Context, accountID, symbol string) (CancelOpenOrdersBatch, error) {
 	params := url.Values{}
 
 	params.Set("account-id", accountID)
@@ -398,7 +422,7 @@ func (h *HUOBI) CancelOpenOrdersBatch(accountID, symbol string) (CancelOpenOrder
 		Symbol:    symbol,
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, huobiBatchCancelOpenOrders, url.Values{}, data, &result, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiBatchCancelOpenOrders, url.Values{}, data, &result, false)
 	if result.Data.FailedCount > 0 {
 		return result, fmt.Errorf("there were %v failed order cancellations", result.Data.FailedCount)
 	}
@@ -407,13 +431,13 @@ func (h *HUOBI) CancelOpenOrdersBatch(accountID, symbol string) (CancelOpenOrder
 }
 
 // GetOrder returns order information for the specified order
-func (h *HUOBI) GetOrder(orderID int64) (OrderInfo, error) {
+func (h *HUOBI) GetOrder(ctx context.Context, orderID int64) (OrderInfo, error) {
 	resp := struct {
 		Order OrderInfo `json:"data"`
 	}{}
 	urlVal := url.Values{}
 	urlVal.Set("clientOrderId", strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet,
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet,
 		huobiGetOrder,
 		urlVal,
 		nil,
@@ -423,17 +447,17 @@ func (h *HUOBI) GetOrder(orderID int64) (OrderInfo, error) {
 }
 
 // GetOrderMatchResults returns matched order info for the specified order
-func (h *HUOBI) GetOrderMatchResults(orderID int64) ([]OrderMatchInfo, error) {
+func (h *HUOBI) GetOrderMatchResults(ctx context.Context, orderID int64) ([]OrderMatchInfo, error) {
 	resp := struct {
 		Orders []OrderMatchInfo `json:"data"`
 	}{}
 	endpoint := fmt.Sprintf(huobiGetOrderMatch, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, endpoint, url.Values{}, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, endpoint, url.Values{}, nil, &resp, false)
 	return resp.Orders, err
 }
 
 // GetOrders returns a list of orders
-func (h *HUOBI) GetOrders(symbol, types, start, end, states, from, direct, size string) ([]OrderInfo, error) {
+func (h *HUOBI) GetOrders(ctx context.Context, symbol, types, start, end, states, from, direct, size string) ([]OrderInfo, error) {
 	resp := struct {
 		Orders []OrderInfo `json:"data"`
 	}{}
@@ -466,12 +490,12 @@ func (h *HUOBI) GetOrders(symbol, types, start, end, states, from, direct, size
 		vals.Set("size", size)
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiGetOrders, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiGetOrders, vals, nil, &resp, false)
 	return resp.Orders, err
 }
 
 // GetOpenOrders returns a list of orders
-func (h *HUOBI) GetOpenOrders(accountID, symbol, side string, size int64) ([]OrderInfo, error) {
+func (h *HUOBI) GetOpenOrders(ctx context.Context, accountID, symbol, side string, size int64) ([]OrderInfo, error) {
 	resp := struct {
 		Orders []OrderInfo `json:"data"`
 	}{}
@@ -484,12 +508,12 @@ func (h *HUOBI) GetOpenOrders(accountID, symbol, side string, size int64) ([]Ord
 	}
 	vals.Set("size", strconv.FormatInt(size, 10))
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiGetOpenOrders, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiGetOpenOrders, vals, nil, &resp, false)
 	return resp.Orders, err
 }
 
 // GetOrdersMatch returns a list of matched orders
-func (h *HUOBI) GetOrdersMatch(symbol, types, start, end, from, direct, size string) ([]OrderMatchInfo, error) {
+func (h *HUOBI) GetOrdersMatch(ctx context.Context, symbol, types, start, end, from, direct, size string) ([]OrderMatchInfo, error) {
 	resp := struct {
 		Orders []OrderMatchInfo `json:"data"`
 	}{}
@@ -521,12 +545,12 @@ func (h *HUOBI) GetOrdersMatch(symbol, types, start, end, from, direct, size str
 		vals.Set("size", size)
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiGetOrdersMatch, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiGetOrdersMatch, vals, nil, &resp, false)
 	return resp.Orders, err
 }
 
 // MarginTransfer transfers assets into or out of the margin account
-func (h *HUOBI) MarginTransfer(symbol, currency string, amount float64, in bool) (int64, error) {
+func (h *HUOBI) MarginTransfer(ctx context.Context, symbol, currency string, amount float64, in bool) (int64, error) {
 	data := struct {
 		Symbol   string `json:"symbol"`
 		Currency string `json:"currency"`
@@ -545,12 +569,12 @@ func (h *HUOBI) MarginTransfer(symbol, currency string, amount float64, in bool)
 	resp := struct {
 		TransferID int64 `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, path, nil, data, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, nil, data, &resp, false)
 	return resp.TransferID, err
 }
 
 // MarginOrder submits a margin order application
-func (h *HUOBI) MarginOrder(symbol, currency string, amount float64) (int64, error) {
+func (h *HUOBI) MarginOrder(ctx context.Context, symbol, currency string, amount float64) (int64, error) {
 	data := struct {
 		Symbol   string `json:"symbol"`
 		Currency string `json:"currency"`
@@ -564,12 +588,12 @@ func (h *HUOBI) MarginOrder(symbol, currency string, amount float64) (int64, err
 	resp := struct {
 		MarginOrderID int64 `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, huobiMarginOrders, nil, data, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiMarginOrders, nil, data, &resp, false)
 	return resp.MarginOrderID, err
 }
 
 // MarginRepayment repays a margin amount for a margin ID
-func (h *HUOBI) MarginRepayment(orderID int64, amount float64) (int64, error) {
+func (h *HUOBI) MarginRepayment(ctx context.Context, orderID int64, amount float64) (int64, error) {
 	data := struct {
 		Amount string `json:"amount"`
 	}{
@@ -581,12 +605,12 @@ func (h *HUOBI) MarginRepayment(orderID int64, amount float64) (int64, error) {
 	}{}
 
 	endpoint := fmt.Sprintf(huobiMarginRepay, strconv.FormatInt(orderID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, endpoint, nil, data, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, endpoint, nil, data, &resp, false)
 	return resp.MarginOrderID, err
 }
 
 // GetMarginLoanOrders returns the margin loan orders
-func (h *HUOBI) GetMarginLoanOrders(symbol, currency, start, end, states, from, direct, size string) ([]MarginOrder, error) {
+func (h *HUOBI) GetMarginLoanOrders(ctx context.Context, symbol, currency, start, end, states, from, direct, size string) ([]MarginOrder, error) {
 	vals := url.Values{}
 	vals.Set("symbol", symbol)
 	vals.Set("currency", currency)
@@ -618,12 +642,12 @@ func (h *HUOBI) GetMarginLoanOrders(symbol, currency, start, end, states, from,
 	resp := struct {
 		MarginLoanOrders []MarginOrder `json:"data"`
 	}{}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiMarginLoanOrders, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiMarginLoanOrders, vals, nil, &resp, false)
 	return resp.MarginLoanOrders, err
 }
 
 // GetMarginAccountBalance returns the margin account balances
-func (h *HUOBI) GetMarginAccountBalance(symbol string) ([]MarginAccountBalance, error) {
+func (h *HUOBI) GetMarginAccountBalance(ctx context.Context, symbol string) ([]MarginAccountBalance, error) {
 	resp := struct {
 		Balances []MarginAccountBalance `json:"data"`
 	}{}
@@ -631,12 +655,12 @@ func (h *HUOBI) GetMarginAccountBalance(symbol string) ([]MarginAccountBalance,
 	if symbol != "" {
 		vals.Set("symbol", symbol)
 	}
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiMarginAccountBalance, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiMarginAccountBalance, vals, nil, &resp, false)
 	return resp.Balances, err
 }
 
 // Withdraw withdraws the desired amount and currency
-func (h *HUOBI) Withdraw(c currency.Code, address, addrTag string, amount, fee float64) (int64, error) {
+func (h *HUOBI) Withdraw(ctx context.Context, c currency.Code, address, addrTag string, amount, fee float64) (int64, error) {
 	resp := struct {
 		WithdrawID int64 `json:"data"`
 	}{}
@@ -661,12 +685,12 @@ func (h *HUOBI) Withdraw(c currency.Code, address, addrTag string, amount, fee f
 		data.AddrTag = addrTag
 	}
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, huobiWithdrawCreate, nil, data, &resp.WithdrawID, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiWithdrawCreate, nil, data, &resp.WithdrawID, false)
 	return resp.WithdrawID, err
 }
 
 // CancelWithdraw cancels a withdraw request
-func (h *HUOBI) CancelWithdraw(withdrawID int64) (int64, error) {
+func (h *HUOBI) CancelWithdraw(ctx context.Context, withdrawID int64) (int64, error) {
 	resp := struct {
 		WithdrawID int64 `json:"data"`
 	}{}
@@ -674,12 +698,12 @@ func (h *HUOBI) CancelWithdraw(withdrawID int64) (int64, error) {
 	vals.Set("withdraw-id", strconv.FormatInt(withdrawID, 10))
 
 	endpoint := fmt.Sprintf(huobiWithdrawCancel, strconv.FormatInt(withdrawID, 10))
-	err := h.SendAuthenticatedHTTPRequest(http.MethodPost, endpoint, vals, nil, &resp, false)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, endpoint, vals, nil, &resp, false)
 	return resp.WithdrawID, err
 }
 
 // QueryDepositAddress returns the deposit address for a specified currency
-func (h *HUOBI) QueryDepositAddress(cryptocurrency string) (DepositAddress, error) {
+func (h *HUOBI) QueryDepositAddress(ctx context.Context, cryptocurrency string) (DepositAddress, error) {
 	resp := struct {
 		DepositAddress []DepositAddress `json:"data"`
 	}{}
@@ -687,7 +711,7 @@ func (h *HUOBI) QueryDepositAddress(cryptocurrency string) (DepositAddress, erro
 	vals := url.Values{}
 	vals.Set("currency", cryptocurrency)
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiAccountDepositAddress, vals, nil, &resp, true)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiAccountDepositAddress, vals, nil, &resp, true)
 	if err != nil {
 		return DepositAddress{}, err
 	}
@@ -698,7 +722,7 @@ func (h *HUOBI) QueryDepositAddress(cryptocurrency string) (DepositAddress, erro
 }
 
 // QueryWithdrawQuotas returns the users cryptocurrency withdraw quotas
-func (h *HUOBI) QueryWithdrawQuotas(cryptocurrency string) (WithdrawQuota, error) {
+func (h *HUOBI) QueryWithdrawQuotas(ctx context.Context, cryptocurrency string) (WithdrawQuota, error) {
 	resp := struct {
 		WithdrawQuota WithdrawQuota `json:"data"`
 	}{}
@@ -706,16 +730,280 @@ func (h *HUOBI) QueryWithdrawQuotas(cryptocurrency string) (WithdrawQuota, error
 	vals := url.Values{}
 	vals.Set("currency", cryptocurrency)
 
-	err := h.SendAuthenticatedHTTPRequest(http.MethodGet, huobiAccountWithdrawQuota, vals, nil, &resp, true)
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, huobiAccountWithdrawQuota, vals, nil, &resp, true)
 	if err != nil {
 		return WithdrawQuota{}, err
 	}
 	return resp.WithdrawQuota, nil
 }
 
+// TransferFutures transfers an amount of a currency between the spot account
+// and its coin margined futures account
+func (h *HUOBI) TransferFutures(ctx context.Context, c currency.Code, amount float64, transferType FuturesTransferType) (int64, error) {
+	data := struct {
+		Currency string              `json:"currency"`
+		Amount   float64             `json:"amount"`
+		Type     FuturesTransferType `json:"type"`
+	}{
+		Currency: c.Lower().String(),
+		Amount:   amount,
+		Type:     transferType,
+	}
+	resp := struct {
+		TransferID int64 `json:"data"`
+	}{}
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiTransferFutures, nil, data, &resp, false)
+	return resp.TransferID, err
+}
+
+// TransferAccount transfers an amount of a currency between account types.
+// USDT margined swap transfers are made to or from a margin account, the
+// margin currency for cross margin and the contract code for isolated margin
+func (h *HUOBI) TransferAccount(ctx context.Context, from, to AccountType, c currency.Code, amount float64, marginAccount string) (int64, error) {
+	data := struct {
+		From          AccountType `json:"from"`
+		To            AccountType `json:"to"`
+		Currency      string      `json:"currency"`
+		Amount        float64     `json:"amount"`
+		MarginAccount string      `json:"margin-account"`
+	}{
+		From:          from,
+		To:            to,
+		Currency:      c.Lower().String(),
+		Amount:        amount,
+		MarginAccount: marginAccount,
+	}
+	resp := struct {
+		TransferID int64 `json:"data"`
+	}{}
+	err := h.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, huobiAccountTransfer, nil, data, &resp, true)
+	return resp.TransferID, err
+}
+
+// GetFuturesContractInfo returns the coin margined futures contracts listed
+func (h *HUOBI) GetFuturesContractInfo(ctx context.Context) ([]FuturesContract, error) {
+	resp := struct {
+		Contracts []FuturesContract `json:"data"`
+	}{}
+	err := h.SendFuturesHTTPRequest(ctx, huobiFuturesContractInfo, nil, &resp, huobiFuturesUnAuth)
+	return resp.Contracts, err
+}
+
+// GetFuturesDepth returns the orderbook of a coin margined futures contract
+func (h *HUOBI) GetFuturesDepth(ctx context.Context, contractCode string) (Orderbook, error) {
+	vals := url.Values{}
+	vals.Set("symbol", contractCode)
+	vals.Set("type", string(OrderBookDataRequestParamsTypeStep0))
+	resp := struct {
+		Depth Orderbook `json:"tick"`
+	}{}
+	err := h.SendFuturesHTTPRequest(ctx, huobiFuturesMarketDepth, vals, &resp, huobiFuturesUnAuth)
+	return resp.Depth, err
+}
+
+// GetFuturesMarketDetail returns the ticker of a coin margined futures
+// contract
+func (h *HUOBI) GetFuturesMarketDetail(ctx context.Context, contractCode string) (DetailMerged, error) {
+	vals := url.Values{}
+	vals.Set("symbol", contractCode)
+	resp := struct {
+		Tick DetailMerged `json:"tick"`
+	}{}
+	err := h.SendFuturesHTTPRequest(ctx, huobiFuturesMarketDetail, vals, &resp, huobiFuturesUnAuth)
+	return resp.Tick, err
+}
+
+// GetFuturesAccountInfo returns the coin margined futures accounts, one per
+// margin currency, or the account of a single currency if set
+func (h *HUOBI) GetFuturesAccountInfo(ctx context.Context, symbol string) ([]FuturesAccount, error) {
+	data := struct {
+		Symbol string `json:"symbol,omitempty"`
+	}{Symbol: symbol}
+	resp := struct {
+		Accounts []FuturesAccount `json:"data"`
+	}{}
+	err := h.SendAuthenticatedFuturesHTTPRequest(ctx, huobiFuturesAccountInfo, data, &resp, huobiFuturesAuth)
+	return resp.Accounts, err
+}
+
+// GetFuturesPositions returns the open coin margined futures positions, or
+// the positions of a single currency if set
+func (h *HUOBI) GetFuturesPositions(ctx context.Context, symbol string) ([]FuturesPosition, error) {
+	data := struct {
+		Symbol string `json:"symbol,omitempty"`
+	}{Symbol: symbol}
+	resp := struct {
+		Positions []FuturesPosition `json:"data"`
+	}{}
+	err := h.SendAuthenticatedFuturesHTTPRequest(ctx, huobiFuturesPositionInfo, data, &resp, huobiFuturesAuth)
+	return resp.Positions, err
+}
+
+// GetSwapContractInfo returns the USDT margined swap contracts listed
+func (h *HUOBI) GetSwapContractInfo(ctx context.Context) ([]FuturesContract, error) {
+	resp := struct {
+		Contracts []FuturesContract `json:"data"`
+	}{}
+	err := h.SendFuturesHTTPRequest(ctx, huobiSwapContractInfo, nil, &resp, huobiSwapUnauth)
+	return resp.Contracts, err
+}
+
+// GetSwapDepth returns the orderbook of a USDT margined swap contract
+func (h *HUOBI) GetSwapDepth(ctx context.Context, contractCode string) (Orderbook, error) {
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+	vals.Set("type", string(OrderBookDataRequestParamsTypeStep0))
+	resp := struct {
+		Depth Orderbook `json:"tick"`
+	}{}
+	err := h.SendFuturesHTTPRequest(ctx, huobiSwapMarketDepth, vals, &resp, huobiSwapUnauth)
+	return resp.Depth, err
+}
+
+// GetSwapMarketDetail returns the ticker of a USDT margined swap contract
+func (h *HUOBI) GetSwapMarketDetail(ctx context.Context, contractCode string) (DetailMerged, error) {
+	vals := url.Values{}
+	vals.Set("contract_code", contractCode)
+	resp := struct {
+		Tick DetailMerged `json:"tick"`
+	}{}
+	err := h.SendFuturesHTTPRequest(ctx, huobiSwapMarketDetail, vals, &resp, huobiSwapUnauth)
+	return resp.Tick, err
+}
+
+// GetSwapPositions returns the open USDT margined swap positions of a margin
+// mode, or the positions of a single contract if set
+func (h *HUOBI) GetSwapPositions(ctx context.Context, contractCode string, isolated bool) ([]FuturesPosition, error) {
+	data := struct {
+		ContractCode string `json:"contract_code,omitempty"`
+	}{ContractCode: contractCode}
+	resp := struct {
+		Positions []FuturesPosition `json:"data"`
+	}{}
+	path := huobiSwapCrossPositions
+	if isolated {
+		path = huobiSwapIsolatedPositions
+	}
+	err := h.SendAuthenticatedFuturesHTTPRequest(ctx, path, data, &resp, huobiSwapAuth)
+	return resp.Positions, err
+}
+
+// GetUnifiedAccountInfo returns the unified account, one per margin
+// currency, holding the margin of every derivatives position
+func (h *HUOBI) GetUnifiedAccountInfo(ctx context.Context) ([]UnifiedAccount, error) {
+	resp := struct {
+		Accounts []UnifiedAccount `json:"data"`
+	}{}
+	err := h.SendAuthenticatedFuturesHTTPRequest(ctx, huobiUnifiedAccountInfo, nil, &resp, huobiSwapAuth)
+	return resp.Accounts, err
+}
+
+// GetUnifiedAccountType returns whether derivatives margin is held in a
+// unified account or in separate accounts per product
+func (h *HUOBI) GetUnifiedAccountType(ctx context.Context) (UnifiedAccountType, error) {
+	resp := struct {
+		Data struct {
+			AccountType UnifiedAccountType `json:"account_type"`
+		} `json:"data"`
+	}{}
+	err := h.SendAuthenticatedFuturesHTTPRequest(ctx, huobiUnifiedAccountType, nil, &resp, huobiSwapAuth)
+	return resp.Data.AccountType, err
+}
+
+// SwitchUnifiedAccountType switches derivatives margin between a unified
+// account and separate accounts per product, which requires there to be no
+// open positions or orders
+func (h *HUOBI) SwitchUnifiedAccountType(ctx context.Context, accountType UnifiedAccountType) error {
+	data := struct {
+		AccountType UnifiedAccountType `json:"account_type"`
+	}{AccountType: accountType}
+	return h.SendAuthenticatedFuturesHTTPRequest(ctx, huobiSwitchAccountType, data, nil, huobiSwapAuth)
+}
+
+// SendFuturesHTTPRequest sends an unauthenticated HTTP request to the
+// derivatives API
+func (h *HUOBI) SendFuturesHTTPRequest(ctx context.Context, path string, values url.Values, result interface{}, endpoint request.EndpointLimit) error {
+	interim := json.RawMessage{}
+	err := h.SendPayload(ctx, &request.Item{
+		Method:        http.MethodGet,
+		Path:          common.EncodeURLValues(h.API.Endpoints.URLSecondary+path, values),
+		Result:        &interim,
+		Verbose:       h.Verbose,
+		HTTPDebugging: h.HTTPDebugging,
+		HTTPRecording: h.HTTPRecording,
+		Endpoint:      endpoint,
+	})
+	if err != nil {
+		return err
+	}
+	return decodeFuturesResponse(interim, result)
+}
+
+// SendAuthenticatedFuturesHTTPRequest sends an authenticated request to the
+// derivatives API. Requests with data are POSTed as JSON, others are GET
+func (h *HUOBI) SendAuthenticatedFuturesHTTPRequest(ctx context.Context, path string, data, result interface{}, endpoint request.EndpointLimit) error {
+	if !h.AllowAuthenticatedRequest() {
+		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, h.Name)
+	}
+
+	method := http.MethodGet
+	headers := map[string]string{"Content-Type": "application/x-www-form-urlencoded"}
+	var body []byte
+	if data != nil {
+		method = http.MethodPost
+		headers["Content-Type"] = "application/json"
+		encoded, err := json.Marshal(data)
+		if err != nil {
+			return err
+		}
+		body = encoded
+	}
+
+	urlPath, err := h.signRequest(method, h.API.Endpoints.URLSecondary, path, url.Values{})
+	if err != nil {
+		return err
+	}
+
+	interim := json.RawMessage{}
+	err = h.SendPayload(ctx, &request.Item{
+		Method:        method,
+		Path:          urlPath,
+		Headers:       headers,
+		Body:          bytes.NewReader(body),
+		Result:        &interim,
+		AuthRequest:   true,
+		Verbose:       h.Verbose,
+		HTTPDebugging: h.HTTPDebugging,
+		HTTPRecording: h.HTTPRecording,
+		Endpoint:      endpoint,
+	})
+	if err != nil {
+		return err
+	}
+	return decodeFuturesResponse(interim, result)
+}
+
+// decodeFuturesResponse decodes a derivatives API response, returning the
+// error of a v1 error status or a v3 error code
+func decodeFuturesResponse(data json.RawMessage, result interface{}) error {
+	var errCap FuturesResponse
+	if err := json.Unmarshal(data, &errCap); err == nil {
+		if errCap.Status == huobiStatusError {
+			return fmt.Errorf("%d %s", errCap.ErrorCode, errCap.ErrorMessage)
+		}
+		if errCap.Code != 0 && errCap.Code != 200 {
+			return fmt.Errorf("%d %s", errCap.Code, errCap.Message)
+		}
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(data, result)
+}
+
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (h *HUOBI) SendHTTPRequest(path string, result interface{}) error {
-	return h.SendPayload(&request.Item{
+func (h *HUOBI) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return h.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -726,7 +1014,7 @@ func (h *HUOBI) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends authenticated requests to the HUOBI API
-func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url.Values, data, result interface{}, isVersion2API bool) error {
+func (h *HUOBI) SendAuthenticatedHTTPRequest(ctx context.Context, method, endpoint string, values url.Values, data, result interface{}, isVersion2API bool) error {
 	if !h.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, h.Name)
 	}
@@ -735,20 +1023,12 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 		values = url.Values{}
 	}
 
-	values.Set("AccessKeyId", h.API.Credentials.Key)
-	values.Set("SignatureMethod", "HmacSHA256")
-	values.Set("SignatureVersion", "2")
-	values.Set("Timestamp", time.Now().UTC().Format("2006-01-02T15:04:05"))
-
 	if isVersion2API {
 		endpoint = fmt.Sprintf("/v%s/%s", huobiAPIVersion2, endpoint)
 	} else {
 		endpoint = fmt.Sprintf("/v%s/%s", huobiAPIVersion, endpoint)
 	}
 
-	payload := fmt.Sprintf("%s\napi.huobi.pro\n%s\n%s",
-		method, endpoint, values.Encode())
-
 	headers := make(map[string]string)
 
 	if method == http.MethodGet {
@@ -757,9 +1037,10 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 		headers["Content-Type"] = "application/json"
 	}
 
-	hmac := crypto.GetHMAC(crypto.HashSHA256, []byte(payload), []byte(h.API.Credentials.Secret))
-	values.Set("Signature", crypto.Base64Encode(hmac))
-	urlPath := h.API.Endpoints.URL + common.EncodeURLValues(endpoint, values)
+	urlPath, err := h.signRequest(method, h.API.Endpoints.URL, endpoint, values)
+	if err != nil {
+		return err
+	}
 
 	var body []byte
 	if data != nil {
@@ -771,7 +1052,7 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 	}
 
 	interim := json.RawMessage{}
-	err := h.SendPayload(&request.Item{
+	err = h.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          urlPath,
 		Headers:       headers,
@@ -804,6 +1085,25 @@ func (h *HUOBI) SendAuthenticatedHTTPRequest(method, endpoint string, values url
 	return json.Unmarshal(interim, result)
 }
 
+// signRequest adds the signature of a request, signed by the host of the API
+// it's sent to, to its values and returns its URL
+func (h *HUOBI) signRequest(method, apiURL, path string, values url.Values) (string, error) {
+	u, err := url.Parse(apiURL)
+	if err != nil {
+		return "", err
+	}
+	values.Set("AccessKeyId", h.API.Credentials.Key)
+	values.Set("SignatureMethod", "HmacSHA256")
+	values.Set("SignatureVersion", "2")
+	values.Set("Timestamp", h.Requester.Now().UTC().Format("2006-01-02T15:04:05"))
+
+	payload := fmt.Sprintf("%s\n%s\n%s\n%s",
+		method, u.Host, path, values.Encode())
+	hmac := crypto.GetHMAC(crypto.HashSHA256, []byte(payload), []byte(h.API.Credentials.Secret))
+	values.Set("Signature", crypto.Base64Encode(hmac))
+	return apiURL + common.EncodeURLValues(path, values), nil
+}
+
 // GetFee returns an estimate of fee based on type of transaction
 func (h *HUOBI) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
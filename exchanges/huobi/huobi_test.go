@@ -1,6 +1,7 @@
 package huobi
 
 import (
+	"context"
 	"log"
 	"os"
 	"strconv"
@@ -8,10 +9,12 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/sharedtestvalues"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -93,7 +96,7 @@ func setupWsTests(t *testing.T) {
 
 func TestGetSpotKline(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetSpotKline(KlinesRequestParams{
+	_, err := h.GetSpotKline(context.Background(), KlinesRequestParams{
 		Symbol: testSymbol,
 		Period: TimeIntervalHour,
 		Size:   0,
@@ -105,7 +108,7 @@ func TestGetSpotKline(t *testing.T) {
 
 func TestGetMarketDetailMerged(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetMarketDetailMerged(testSymbol)
+	_, err := h.GetMarketDetailMerged(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi TestGetMarketDetailMerged: %s", err)
 	}
@@ -113,7 +116,7 @@ func TestGetMarketDetailMerged(t *testing.T) {
 
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetDepth(OrderBookDataRequestParams{
+	_, err := h.GetDepth(context.Background(), OrderBookDataRequestParams{
 		Symbol: testSymbol,
 		Type:   OrderBookDataRequestParamsTypeStep1,
 	})
@@ -125,7 +128,7 @@ func TestGetDepth(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTrades(testSymbol)
+	_, err := h.GetTrades(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi TestGetTrades: %s", err)
 	}
@@ -133,7 +136,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetLatestSpotPrice(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetLatestSpotPrice(testSymbol)
+	_, err := h.GetLatestSpotPrice(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi GetLatestSpotPrice: %s", err)
 	}
@@ -141,7 +144,7 @@ func TestGetLatestSpotPrice(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTradeHistory(testSymbol, "50")
+	_, err := h.GetTradeHistory(context.Background(), testSymbol, "50")
 	if err != nil {
 		t.Errorf("Huobi TestGetTradeHistory: %s", err)
 	}
@@ -149,7 +152,7 @@ func TestGetTradeHistory(t *testing.T) {
 
 func TestGetMarketDetail(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetMarketDetail(testSymbol)
+	_, err := h.GetMarketDetail(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi TestGetTradeHistory: %s", err)
 	}
@@ -157,7 +160,7 @@ func TestGetMarketDetail(t *testing.T) {
 
 func TestGetSymbols(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetSymbols()
+	_, err := h.GetSymbols(context.Background())
 	if err != nil {
 		t.Errorf("Huobi TestGetSymbols: %s", err)
 	}
@@ -165,14 +168,14 @@ func TestGetSymbols(t *testing.T) {
 
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetCurrencies()
+	_, err := h.GetCurrencies(context.Background())
 	if err != nil {
 		t.Errorf("Huobi TestGetCurrencies: %s", err)
 	}
 }
 
 func TestGetTicker(t *testing.T) {
-	_, err := h.GetTickers()
+	_, err := h.GetTickers(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -180,7 +183,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTimestamp(t *testing.T) {
 	t.Parallel()
-	_, err := h.GetTimestamp()
+	_, err := h.GetTimestamp(context.Background())
 	if err != nil {
 		t.Errorf("Huobi TestGetTimestamp: %s", err)
 	}
@@ -192,7 +195,7 @@ func TestGetAccounts(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetAccounts()
+	_, err := h.GetAccounts(context.Background())
 	if err != nil {
 		t.Errorf("Huobi GetAccounts: %s", err)
 	}
@@ -204,13 +207,13 @@ func TestGetAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	result, err := h.GetAccounts()
+	result, err := h.GetAccounts(context.Background())
 	if err != nil {
 		t.Errorf("Huobi GetAccounts: %s", err)
 	}
 
 	userID := strconv.FormatInt(result[0].ID, 10)
-	_, err = h.GetAccountBalance(userID)
+	_, err = h.GetAccountBalance(context.Background(), userID)
 	if err != nil {
 		t.Errorf("Huobi GetAccountBalance: %s", err)
 	}
@@ -222,7 +225,7 @@ func TestGetAggregatedBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetAggregatedBalance()
+	_, err := h.GetAggregatedBalance(context.Background())
 	if err != nil {
 		t.Errorf("Huobi GetAggregatedBalance: %s", err)
 	}
@@ -242,7 +245,7 @@ func TestSpotNewOrder(t *testing.T) {
 		Type:      SpotNewOrderRequestTypeBuyLimit,
 	}
 
-	_, err := h.SpotNewOrder(arg)
+	_, err := h.SpotNewOrder(context.Background(), arg)
 	if err != nil {
 		t.Errorf("Huobi SpotNewOrder: %s", err)
 	}
@@ -253,7 +256,7 @@ func TestCancelExistingOrder(t *testing.T) {
 	if !h.ValidateAPICredentials() || !canManipulateRealOrders {
 		t.Skip()
 	}
-	_, err := h.CancelExistingOrder(1337)
+	_, err := h.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("Huobi TestCancelExistingOrder Expected error")
 	}
@@ -264,7 +267,7 @@ func TestGetOrder(t *testing.T) {
 	if !h.ValidateAPICredentials() || !canManipulateRealOrders {
 		t.Skip()
 	}
-	_, err := h.GetOrder(1337)
+	_, err := h.GetOrder(context.Background(), 1337)
 	if err != nil {
 		t.Error(err)
 	}
@@ -276,7 +279,7 @@ func TestGetMarginLoanOrders(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetMarginLoanOrders(testSymbol, "", "", "", "", "", "", "")
+	_, err := h.GetMarginLoanOrders(context.Background(), testSymbol, "", "", "", "", "", "", "")
 	if err != nil {
 		t.Errorf("Huobi TestGetMarginLoanOrders: %s", err)
 	}
@@ -288,7 +291,7 @@ func TestGetMarginAccountBalance(t *testing.T) {
 		t.Skip()
 	}
 
-	_, err := h.GetMarginAccountBalance(testSymbol)
+	_, err := h.GetMarginAccountBalance(context.Background(), testSymbol)
 	if err != nil {
 		t.Errorf("Huobi TestGetMarginAccountBalance: %s", err)
 	}
@@ -299,7 +302,7 @@ func TestCancelWithdraw(t *testing.T) {
 	if !h.ValidateAPICredentials() || !canManipulateRealOrders {
 		t.Skip()
 	}
-	_, err := h.CancelWithdraw(1337)
+	_, err := h.CancelWithdraw(context.Background(), 1337)
 	if err == nil {
 		t.Error("Huobi TestCancelWithdraw Expected error")
 	}
@@ -321,7 +324,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	h.GetFeeByType(feeBuilder)
+	h.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -423,7 +426,7 @@ func TestGetActiveOrders(t *testing.T) {
 		Currencies: []currency.Pair{currency.NewPair(currency.BTC, currency.USDT)},
 	}
 
-	_, err := h.GetActiveOrders(&getOrdersRequest)
+	_, err := h.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -437,7 +440,7 @@ func TestGetOrderHistory(t *testing.T) {
 		Currencies: []currency.Pair{currency.NewPair(currency.BTC, currency.USDT)},
 	}
 
-	_, err := h.GetOrderHistory(&getOrdersRequest)
+	_, err := h.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -460,7 +463,7 @@ func TestSubmitOrder(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	accounts, err := h.GetAccounts()
+	accounts, err := h.GetAccounts(context.Background())
 	if err != nil {
 		t.Fatalf("Failed to get accounts. Err: %s", err)
 	}
@@ -472,11 +475,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  strconv.FormatInt(accounts[0].ID, 10),
 	}
-	response, err := h.SubmitOrder(orderSubmission)
+	response, err := h.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	}
@@ -495,7 +498,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := h.CancelOrder(orderCancellation)
+	err := h.CancelOrder(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -518,7 +521,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := h.CancelAllOrders(&orderCancellation)
+	resp, err := h.CancelAllOrders(context.Background(), &orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -534,12 +537,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
-		_, err := h.UpdateAccountInfo()
+		_, err := h.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
 	} else {
-		_, err := h.UpdateAccountInfo()
+		_, err := h.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
@@ -550,7 +553,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := h.ModifyOrder(&order.Modify{})
+	_, err := h.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -570,7 +573,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := h.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := h.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -585,7 +588,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := h.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := h.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -597,14 +600,14 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := h.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := h.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
 }
 
 func TestQueryDepositAddress(t *testing.T) {
-	_, err := h.QueryDepositAddress(currency.BTC.Lower().String())
+	_, err := h.QueryDepositAddress(context.Background(), currency.BTC.Lower().String())
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -614,7 +617,7 @@ func TestQueryDepositAddress(t *testing.T) {
 }
 
 func TestQueryWithdrawQuota(t *testing.T) {
-	_, err := h.QueryWithdrawQuotas(currency.BTC.Lower().String())
+	_, err := h.QueryWithdrawQuotas(context.Background(), currency.BTC.Lower().String())
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -659,3 +662,117 @@ func TestWsGetOrderDetails(t *testing.T) {
 		t.Error(resp.ErrorMessage)
 	}
 }
+
+func TestGetFuturesContractInfo(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetFuturesContractInfo(context.Background())
+	if err != nil {
+		t.Errorf("Huobi TestGetFuturesContractInfo: %s", err)
+	}
+}
+
+func TestGetSwapContractInfo(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetSwapContractInfo(context.Background())
+	if err != nil {
+		t.Errorf("Huobi TestGetSwapContractInfo: %s", err)
+	}
+}
+
+func TestGetSwapDepth(t *testing.T) {
+	t.Parallel()
+	_, err := h.GetSwapDepth(context.Background(), "BTC-USDT")
+	if err != nil {
+		t.Errorf("Huobi TestGetSwapDepth: %s", err)
+	}
+}
+
+func TestGetMarginPositions(t *testing.T) {
+	_, err := h.GetMarginPositions(context.Background())
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("GetMarginPositions() error", err)
+	case !areTestAPIKeysSet() && err == nil:
+		t.Error("GetMarginPositions() Expected error")
+	}
+}
+
+func TestGetUnifiedAccountType(t *testing.T) {
+	_, err := h.GetUnifiedAccountType(context.Background())
+	switch {
+	case areTestAPIKeysSet() && err != nil:
+		t.Error("GetUnifiedAccountType() error", err)
+	case !areTestAPIKeysSet() && err == nil:
+		t.Error("GetUnifiedAccountType() Expected error")
+	}
+}
+
+func TestAddMargin(t *testing.T) {
+	p := currency.NewPairWithDelimiter("BTC", "USDT", "-")
+	if err := h.AddMargin(context.Background(), p, asset.PerpetualSwap, decimal.Zero); err == nil {
+		t.Error("AddMargin() Expected error adding no margin")
+	}
+	if err := h.AddMargin(context.Background(), p, asset.Spot, decimal.NewFromInt(1)); err == nil {
+		t.Error("AddMargin() Expected error adding margin to spot")
+	}
+}
+
+func TestContractPairs(t *testing.T) {
+	p := futuresPair("BTC", "BTC230630", "-")
+	if p.String() != "BTC-230630" {
+		t.Errorf("expected BTC-230630 received %s", p)
+	}
+	if c := contractCode(p, asset.Futures); c != "BTC230630" {
+		t.Errorf("expected BTC230630 received %s", c)
+	}
+	p = swapPair("BTC-USDT", "-")
+	if p.Base != currency.BTC || p.Quote != currency.USDT {
+		t.Errorf("expected BTC-USDT received %s", p)
+	}
+	if c := contractCode(p.Lower(), asset.PerpetualSwap); c != "BTC-USDT" {
+		t.Errorf("expected BTC-USDT received %s", c)
+	}
+}
+
+func TestDecodeFuturesResponse(t *testing.T) {
+	var result struct {
+		Data int64 `json:"data"`
+	}
+	if err := decodeFuturesResponse([]byte(`{"status":"ok","data":5}`), &result); err != nil || result.Data != 5 {
+		t.Errorf("unexpected decode %v %v", result.Data, err)
+	}
+	if err := decodeFuturesResponse([]byte(`{"status":"error","err_code":1030,"err_msg":"bad"}`), &result); err == nil {
+		t.Error("expected v1 error status")
+	}
+	if err := decodeFuturesResponse([]byte(`{"code":1002,"msg":"bad"}`), nil); err == nil {
+		t.Error("expected v3 error code")
+	}
+	if err := decodeFuturesResponse([]byte(`{"code":200,"msg":""}`), nil); err != nil {
+		t.Error(err)
+	}
+}
+
+func TestUnifiedMargin(t *testing.T) {
+	acc := UnifiedAccount{
+		MarginAsset:       "USDT",
+		CrossMarginStatic: 1000,
+		CrossProfitUnreal: -100,
+		CrossSwap: []UnifiedContractMargin{
+			{ContractCode: "BTC-USDT", MarginPosition: 200, AdjustFactor: 0.4, LiquidationPrice: 15000},
+			{ContractCode: "ETH-USDT", MarginPosition: 100, AdjustFactor: 0.5},
+		},
+		IsolatedSwap: []UnifiedContractMargin{
+			{ContractCode: "BTC-USDT", MarginBalance: 300, MarginPosition: 250, AdjustFactor: 0.4, LiquidationPrice: 17000},
+		},
+	}
+	var m exchange.MarginPosition
+	unifiedMargin(&m, &acc, "BTC-USDT")
+	if m.Margin != 900 || m.MaintenanceMargin != 130 || m.LiquidationPrice != 15000 {
+		t.Errorf("unexpected cross margin %+v", m)
+	}
+	m = exchange.MarginPosition{Isolated: true}
+	unifiedMargin(&m, &acc, "BTC-USDT")
+	if m.Margin != 300 || m.MaintenanceMargin != 100 || m.LiquidationPrice != 17000 {
+		t.Errorf("unexpected isolated margin %+v", m)
+	}
+}
@@ -1,5 +1,7 @@
 package huobi
 
+import "github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+
 // Response stores the Huobi response information
 type Response struct {
 	Status       string `json:"status"`
@@ -421,8 +423,9 @@ type WsAuthenticationRequest struct {
 
 // WsMessage defines read data from the websocket connection
 type WsMessage struct {
-	Raw []byte
-	URL string
+	Raw  []byte
+	URL  string
+	Conn *wshandler.WebsocketConnection
 }
 
 // WsAuthenticatedSubscriptionRequest request for subscription on authenticated connection
@@ -591,3 +594,129 @@ type WsAuthenticatedOrderDetailResponse struct {
 type WsPong struct {
 	Pong int64 `json:"pong"`
 }
+
+// FuturesTransferType is the direction of a transfer between the spot
+// account and a coin margined futures account
+type FuturesTransferType string
+
+// Futures transfer directions
+const (
+	SpotToFutures FuturesTransferType = "pro-to-futures"
+	FuturesToSpot FuturesTransferType = "futures-to-pro"
+)
+
+// AccountType is an account which can be transferred between
+type AccountType string
+
+// Account types
+const (
+	SpotAccount       AccountType = "spot"
+	LinearSwapAccount AccountType = "linear-swap"
+)
+
+// UnifiedAccountType is how derivatives margin is held
+type UnifiedAccountType int64
+
+// Unified account types, margin being held in separate accounts per product
+// or in a single unified account
+const (
+	NonUnifiedAccount UnifiedAccountType = 1
+	UnifiedAccountV3  UnifiedAccountType = 2
+)
+
+// marginModeIsolated is the margin mode of a position holding its own margin
+const marginModeIsolated = "isolated"
+
+// contractTrading is the status of a contract listed for trading
+const contractTrading = 1
+
+// FuturesResponse stores the derivatives API error information, v1 endpoints
+// returning an error status and v3 endpoints an error code
+type FuturesResponse struct {
+	Status       string `json:"status"`
+	ErrorCode    int64  `json:"err_code"`
+	ErrorMessage string `json:"err_msg"`
+	Code         int64  `json:"code"`
+	Message      string `json:"msg"`
+}
+
+// FuturesContract stores a derivatives contract
+type FuturesContract struct {
+	Symbol            string  `json:"symbol"`
+	ContractCode      string  `json:"contract_code"`
+	ContractType      string  `json:"contract_type"`
+	ContractSize      float64 `json:"contract_size"`
+	PriceTick         float64 `json:"price_tick"`
+	DeliveryDate      string  `json:"delivery_date"`
+	ContractStatus    int64   `json:"contract_status"`
+	SupportMarginMode string  `json:"support_margin_mode"`
+}
+
+// FuturesAccount stores a coin margined futures account
+type FuturesAccount struct {
+	Symbol            string  `json:"symbol"`
+	MarginBalance     float64 `json:"margin_balance"`
+	MarginPosition    float64 `json:"margin_position"`
+	MarginFrozen      float64 `json:"margin_frozen"`
+	MarginAvailable   float64 `json:"margin_available"`
+	ProfitReal        float64 `json:"profit_real"`
+	ProfitUnreal      float64 `json:"profit_unreal"`
+	RiskRate          float64 `json:"risk_rate"`
+	LiquidationPrice  float64 `json:"liquidation_price"`
+	WithdrawAvailable float64 `json:"withdraw_available"`
+	LeverRate         float64 `json:"lever_rate"`
+	AdjustFactor      float64 `json:"adjust_factor"`
+}
+
+// FuturesPosition stores a derivatives position, the volume being in
+// contracts
+type FuturesPosition struct {
+	Symbol         string  `json:"symbol"`
+	ContractCode   string  `json:"contract_code"`
+	ContractType   string  `json:"contract_type"`
+	Volume         float64 `json:"volume"`
+	Available      float64 `json:"available"`
+	Frozen         float64 `json:"frozen"`
+	CostOpen       float64 `json:"cost_open"`
+	CostHold       float64 `json:"cost_hold"`
+	ProfitUnreal   float64 `json:"profit_unreal"`
+	ProfitRate     float64 `json:"profit_rate"`
+	Profit         float64 `json:"profit"`
+	PositionMargin float64 `json:"position_margin"`
+	LeverRate      float64 `json:"lever_rate"`
+	Direction      string  `json:"direction"`
+	LastPrice      float64 `json:"last_price"`
+	MarginAsset    string  `json:"margin_asset"`
+	MarginMode     string  `json:"margin_mode"`
+	MarginAccount  string  `json:"margin_account"`
+}
+
+// UnifiedAccount stores the unified account of a margin currency
+type UnifiedAccount struct {
+	MarginAsset       string                  `json:"margin_asset"`
+	MarginBalance     float64                 `json:"margin_balance"`
+	MarginStatic      float64                 `json:"margin_static"`
+	CrossMarginStatic float64                 `json:"cross_margin_static"`
+	CrossProfitUnreal float64                 `json:"cross_profit_unreal"`
+	CrossRiskRate     float64                 `json:"cross_risk_rate"`
+	WithdrawAvailable float64                 `json:"withdraw_available"`
+	CrossSwap         []UnifiedContractMargin `json:"cross_swap"`
+	CrossFuture       []UnifiedContractMargin `json:"cross_future"`
+	IsolatedSwap      []UnifiedContractMargin `json:"isolated_swap"`
+}
+
+// UnifiedContractMargin stores the margin held by a unified account for a
+// contract
+type UnifiedContractMargin struct {
+	ContractCode      string  `json:"contract_code"`
+	MarginMode        string  `json:"margin_mode"`
+	MarginBalance     float64 `json:"margin_balance"`
+	MarginPosition    float64 `json:"margin_position"`
+	MarginFrozen      float64 `json:"margin_frozen"`
+	MarginAvailable   float64 `json:"margin_available"`
+	ProfitUnreal      float64 `json:"profit_unreal"`
+	LiquidationPrice  float64 `json:"liquidation_price"`
+	LeverRate         float64 `json:"lever_rate"`
+	AdjustFactor      float64 `json:"adjust_factor"`
+	WithdrawAvailable float64 `json:"withdraw_available"`
+}
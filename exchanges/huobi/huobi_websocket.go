@@ -10,10 +10,12 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	klineinterval "github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -47,6 +49,9 @@ const (
 
 	loginDelay = 50 * time.Millisecond
 	rateLimit  = 20
+	// wsMaxSubscriptionsPerConnection is the amount of market data topics
+	// subscribed on one connection before another connection is opened
+	wsMaxSubscriptionsPerConnection = 100
 )
 
 // Instantiates a communications channel between websocket connections
@@ -57,11 +62,11 @@ func (h *HUOBI) WsConnect() error {
 	if !h.Websocket.IsEnabled() || !h.IsEnabled() {
 		return errors.New(wshandler.WebsocketNotEnabled)
 	}
-	var dialer websocket.Dialer
-	err := h.wsDial(&dialer)
+	err := h.Websocket.Connections().Connect()
 	if err != nil {
 		return err
 	}
+	var dialer websocket.Dialer
 	err = h.wsAuthenticatedDial(&dialer)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%v - authenticated dial failed: %v\n", h.Name, err)
@@ -78,13 +83,17 @@ func (h *HUOBI) WsConnect() error {
 	return nil
 }
 
-func (h *HUOBI) wsDial(dialer *websocket.Dialer) error {
-	err := h.WebsocketConn.Dial(dialer, http.Header{})
+// wsDialMarket dials a market data connection configured as WebsocketConn,
+// for the connection manager to multiplex market data subscriptions over
+func (h *HUOBI) wsDialMarket() (*wshandler.WebsocketConnection, error) {
+	conn := h.WebsocketConn.Clone()
+	var dialer websocket.Dialer
+	err := conn.Dial(&dialer, http.Header{})
 	if err != nil {
-		return err
+		return nil, err
 	}
-	go h.wsMultiConnectionFunnel(h.WebsocketConn, wsMarketURL)
-	return nil
+	go h.wsMultiConnectionFunnel(conn, wsMarketURL)
+	return conn, nil
 }
 
 func (h *HUOBI) wsAuthenticatedDial(dialer *websocket.Dialer) error {
@@ -110,11 +119,15 @@ func (h *HUOBI) wsMultiConnectionFunnel(ws *wshandler.WebsocketConnection, url s
 		default:
 			resp, err := ws.ReadMessage()
 			if err != nil {
+				if ws.IsClosed() {
+					// Idle connections are closed by the connection manager
+					return
+				}
 				h.Websocket.DataHandler <- err
 				return
 			}
 			h.Websocket.TrafficAlert <- struct{}{}
-			comms <- WsMessage{Raw: resp.Raw, URL: url}
+			comms <- WsMessage{Raw: resp.Raw, URL: url, Conn: ws}
 		}
 	}
 }
@@ -142,11 +155,11 @@ func (h *HUOBI) wsHandleAuthenticatedData(resp WsMessage) {
 	var init WsAuthenticatedDataResponse
 	err := json.Unmarshal(resp.Raw, &init)
 	if err != nil {
-		h.Websocket.DataHandler <- err
+		h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		return
 	}
 	if init.Ping != 0 {
-		h.sendPingResponse(init.Ping)
+		h.sendPingResponse(resp.Conn, init.Ping)
 		return
 	}
 	if init.ErrorMessage == "api-signature-not-valid" {
@@ -169,14 +182,14 @@ func (h *HUOBI) wsHandleAuthenticatedData(resp WsMessage) {
 		var response WsAuthenticatedDataResponse
 		err := json.Unmarshal(resp.Raw, &response)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 		h.Websocket.DataHandler <- response
 	case strings.EqualFold(init.Topic, "accounts"):
 		var response WsAuthenticatedAccountsResponse
 		err := json.Unmarshal(resp.Raw, &response)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 		h.Websocket.DataHandler <- response
 	case strings.Contains(init.Topic, "orders") &&
@@ -184,14 +197,14 @@ func (h *HUOBI) wsHandleAuthenticatedData(resp WsMessage) {
 		var response WsAuthenticatedOrdersUpdateResponse
 		err := json.Unmarshal(resp.Raw, &response)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 		h.Websocket.DataHandler <- response
 	case strings.Contains(init.Topic, "orders"):
 		var response WsAuthenticatedOrdersResponse
 		err := json.Unmarshal(resp.Raw, &response)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		}
 		h.Websocket.DataHandler <- response
 	}
@@ -201,7 +214,7 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 	var init WsResponse
 	err := json.Unmarshal(resp.Raw, &init)
 	if err != nil {
-		h.Websocket.DataHandler <- err
+		h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 		return
 	}
 	if init.Status == "error" {
@@ -216,7 +229,7 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 		return
 	}
 	if init.Ping != 0 {
-		h.sendPingResponse(init.Ping)
+		h.sendPingResponse(resp.Conn, init.Ping)
 		return
 	}
 
@@ -225,7 +238,7 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 		var depth WsDepth
 		err := json.Unmarshal(resp.Raw, &depth)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 
@@ -240,16 +253,22 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 		var kline WsKline
 		err := json.Unmarshal(resp.Raw, &kline)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 		data := strings.Split(kline.Channel, ".")
+		interval, err := klineinterval.ParseInterval(data[len(data)-1])
+		if err != nil {
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
+			return
+		}
 		h.Websocket.DataHandler <- wshandler.KlineData{
-			Timestamp: time.Unix(0, kline.Timestamp*int64(time.Millisecond)),
+			Timestamp: convert.TimeFromUnix(kline.Timestamp, time.Millisecond),
 			Exchange:  h.Name,
 			AssetType: asset.Spot,
 			Pair: currency.NewPairFromFormattedPairs(data[1],
 				h.GetEnabledPairs(asset.Spot), h.GetPairFormat(asset.Spot, true)),
+			Interval:   interval,
 			OpenPrice:  kline.Tick.Open,
 			ClosePrice: kline.Tick.Close,
 			HighPrice:  kline.Tick.High,
@@ -260,7 +279,7 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 		var trade WsTrade
 		err := json.Unmarshal(resp.Raw, &trade)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 		data := strings.Split(trade.Channel, ".")
@@ -269,13 +288,13 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 			AssetType: asset.Spot,
 			CurrencyPair: currency.NewPairFromFormattedPairs(data[1],
 				h.GetEnabledPairs(asset.Spot), h.GetPairFormat(asset.Spot, true)),
-			Timestamp: time.Unix(0, trade.Tick.Timestamp*int64(time.Millisecond)),
+			Timestamp: convert.TimeFromUnix(trade.Tick.Timestamp, time.Millisecond),
 		}
 	case strings.Contains(init.Channel, "detail"):
 		var wsTicker WsTick
 		err := json.Unmarshal(resp.Raw, &wsTicker)
 		if err != nil {
-			h.Websocket.DataHandler <- err
+			h.Websocket.DataHandler <- wshandler.NewError(h.Name, wshandler.ErrorMalformedMessage, err)
 			return
 		}
 		data := strings.Split(wsTicker.Channel, ".")
@@ -287,7 +306,7 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 			QuoteVolume:  wsTicker.Tick.Volume,
 			High:         wsTicker.Tick.High,
 			Low:          wsTicker.Tick.Low,
-			LastUpdated:  time.Unix(0, wsTicker.Timestamp*int64(time.Millisecond)),
+			LastUpdated:  convert.TimeFromUnix(wsTicker.Timestamp, time.Millisecond),
 			AssetType:    asset.Spot,
 			Pair: currency.NewPairFromFormattedPairs(data[1],
 				h.GetEnabledPairs(asset.Spot), h.GetPairFormat(asset.Spot, true)),
@@ -295,8 +314,9 @@ func (h *HUOBI) wsHandleMarketData(resp WsMessage) {
 	}
 }
 
-func (h *HUOBI) sendPingResponse(pong int64) {
-	err := h.WebsocketConn.SendJSONMessage(WsPong{Pong: pong})
+// sendPingResponse responds to a ping on the connection it was received on
+func (h *HUOBI) sendPingResponse(conn *wshandler.WebsocketConnection, pong int64) {
+	err := conn.SendJSONMessage(WsPong{Pong: pong})
 	if err != nil {
 		log.Error(log.ExchangeSys, err)
 	}
@@ -367,22 +387,35 @@ func (h *HUOBI) GenerateDefaultSubscriptions() {
 	h.Websocket.SubscribeToChannels(subscriptions)
 }
 
-// Subscribe sends a websocket message to receive data from the channel
+// isAuthenticatedChannel returns whether a channel is subscribed on the
+// authenticated connection rather than multiplexed over market connections
+func isAuthenticatedChannel(channel wshandler.WebsocketChannelSubscription) bool {
+	return strings.Contains(channel.Channel, "orders.") ||
+		strings.Contains(channel.Channel, "accounts")
+}
+
+// Subscribe sends a websocket message to receive data from an authenticated
+// channel, market data channels are subscribed with SubscribeOnConnection
 func (h *HUOBI) Subscribe(channelToSubscribe wshandler.WebsocketChannelSubscription) error {
-	if strings.Contains(channelToSubscribe.Channel, "orders.") ||
-		strings.Contains(channelToSubscribe.Channel, "accounts") {
-		return h.wsAuthenticatedSubscribe("sub", wsAccountsOrdersEndPoint+channelToSubscribe.Channel, channelToSubscribe.Channel)
-	}
-	return h.WebsocketConn.SendJSONMessage(WsRequest{Subscribe: channelToSubscribe.Channel})
+	return h.wsAuthenticatedSubscribe("sub", wsAccountsOrdersEndPoint+channelToSubscribe.Channel, channelToSubscribe.Channel)
 }
 
-// Unsubscribe sends a websocket message to stop receiving data from the channel
+// Unsubscribe sends a websocket message to stop receiving data from an
+// authenticated channel
 func (h *HUOBI) Unsubscribe(channelToSubscribe wshandler.WebsocketChannelSubscription) error {
-	if strings.Contains(channelToSubscribe.Channel, "orders.") ||
-		strings.Contains(channelToSubscribe.Channel, "accounts") {
-		return h.wsAuthenticatedSubscribe("unsub", wsAccountsOrdersEndPoint+channelToSubscribe.Channel, channelToSubscribe.Channel)
-	}
-	return h.WebsocketConn.SendJSONMessage(WsRequest{Unsubscribe: channelToSubscribe.Channel})
+	return h.wsAuthenticatedSubscribe("unsub", wsAccountsOrdersEndPoint+channelToSubscribe.Channel, channelToSubscribe.Channel)
+}
+
+// SubscribeOnConnection sends a websocket message on the market connection
+// the channel is assigned to, to receive data from the channel
+func (h *HUOBI) SubscribeOnConnection(conn *wshandler.WebsocketConnection, channelToSubscribe wshandler.WebsocketChannelSubscription) error {
+	return conn.SendJSONMessage(WsRequest{Subscribe: channelToSubscribe.Channel})
+}
+
+// UnsubscribeOnConnection sends a websocket message on the market connection
+// the channel was subscribed on, to stop receiving data from the channel
+func (h *HUOBI) UnsubscribeOnConnection(conn *wshandler.WebsocketConnection, channelToSubscribe wshandler.WebsocketChannelSubscription) error {
+	return conn.SendJSONMessage(WsRequest{Unsubscribe: channelToSubscribe.Channel})
 }
 
 func (h *HUOBI) wsGenerateSignature(timestamp, endpoint string) []byte {
@@ -402,7 +435,7 @@ func (h *HUOBI) wsLogin() error {
 		return fmt.Errorf("%v AuthenticatedWebsocketAPISupport not enabled", h.Name)
 	}
 	h.Websocket.SetCanUseAuthenticatedEndpoints(true)
-	timestamp := time.Now().UTC().Format(wsDateTimeFormatting)
+	timestamp := h.Requester.Now().UTC().Format(wsDateTimeFormatting)
 	request := WsAuthenticationRequest{
 		Op:               authOp,
 		AccessKeyID:      h.API.Credentials.Key,
@@ -423,7 +456,7 @@ func (h *HUOBI) wsLogin() error {
 }
 
 func (h *HUOBI) wsAuthenticatedSubscribe(operation, endpoint, topic string) error {
-	timestamp := time.Now().UTC().Format(wsDateTimeFormatting)
+	timestamp := h.Requester.Now().UTC().Format(wsDateTimeFormatting)
 	request := WsAuthenticatedSubscriptionRequest{
 		Op:               operation,
 		AccessKeyID:      h.API.Credentials.Key,
@@ -441,7 +474,7 @@ func (h *HUOBI) wsGetAccountsList() (*WsAuthenticatedAccountsListResponse, error
 	if !h.Websocket.CanUseAuthenticatedEndpoints() {
 		return nil, fmt.Errorf("%v not authenticated cannot get accounts list", h.Name)
 	}
-	timestamp := time.Now().UTC().Format(wsDateTimeFormatting)
+	timestamp := h.Requester.Now().UTC().Format(wsDateTimeFormatting)
 	request := WsAuthenticatedAccountsListRequest{
 		Op:               requestOp,
 		AccessKeyID:      h.API.Credentials.Key,
@@ -466,7 +499,7 @@ func (h *HUOBI) wsGetOrdersList(accountID int64, pair currency.Pair) (*WsAuthent
 	if !h.Websocket.CanUseAuthenticatedEndpoints() {
 		return nil, fmt.Errorf("%v not authenticated cannot get orders list", h.Name)
 	}
-	timestamp := time.Now().UTC().Format(wsDateTimeFormatting)
+	timestamp := h.Requester.Now().UTC().Format(wsDateTimeFormatting)
 	request := WsAuthenticatedOrdersListRequest{
 		Op:               requestOp,
 		AccessKeyID:      h.API.Credentials.Key,
@@ -494,7 +527,7 @@ func (h *HUOBI) wsGetOrderDetails(orderID string) (*WsAuthenticatedOrderDetailRe
 	if !h.Websocket.CanUseAuthenticatedEndpoints() {
 		return nil, fmt.Errorf("%v not authenticated cannot get order details", h.Name)
 	}
-	timestamp := time.Now().UTC().Format(wsDateTimeFormatting)
+	timestamp := h.Requester.Now().UTC().Format(wsDateTimeFormatting)
 	request := WsAuthenticatedOrderDetailsRequest{
 		Op:               requestOp,
 		AccessKeyID:      h.API.Credentials.Key,
@@ -1,6 +1,7 @@
 package huobi
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,6 +10,8 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +41,7 @@ func (h *HUOBI) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if h.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = h.UpdateTradablePairs(true)
+		err = h.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -58,6 +61,8 @@ func (h *HUOBI) SetDefaults() {
 	h.CurrencyPairs = currency.PairsManager{
 		AssetTypes: asset.Items{
 			asset.Spot,
+			asset.Futures,
+			asset.PerpetualSwap,
 		},
 
 		UseGlobalFormat: true,
@@ -117,6 +122,8 @@ func (h *HUOBI) SetDefaults() {
 
 	h.API.Endpoints.URLDefault = huobiAPIURL
 	h.API.Endpoints.URL = h.API.Endpoints.URLDefault
+	h.API.Endpoints.URLSecondaryDefault = huobiFuturesAPIURL
+	h.API.Endpoints.URLSecondary = h.API.Endpoints.URLSecondaryDefault
 	h.API.Endpoints.WebsocketURL = wsMarketURL
 	h.Websocket = wshandler.New()
 	h.WebsocketResponseMaxLimit = exchange.DefaultWebsocketResponseMaxLimit
@@ -149,6 +156,11 @@ func (h *HUOBI) Setup(exch *config.ExchangeConfig) error {
 			Subscriber:                       h.Subscribe,
 			UnSubscriber:                     h.Unsubscribe,
 			Features:                         &h.Features.Supports.WebsocketCapabilities,
+			ConnectionDialer:                 h.wsDialMarket,
+			ConnectionSubscriber:             h.SubscribeOnConnection,
+			ConnectionUnSubscriber:           h.UnsubscribeOnConnection,
+			MaxSubscriptionsPerConnection:    wsMaxSubscriptionsPerConnection,
+			Dedicated:                        isAuthenticatedChannel,
 		})
 	if err != nil {
 		return err
@@ -162,6 +174,8 @@ func (h *HUOBI) Setup(exch *config.ExchangeConfig) error {
 		RateLimit:            rateLimit,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 	h.AuthenticatedWebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         h.Name,
@@ -171,6 +185,8 @@ func (h *HUOBI) Setup(exch *config.ExchangeConfig) error {
 		RateLimit:            rateLimit,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	h.Websocket.Orderbook.Setup(
@@ -245,7 +261,7 @@ func (h *HUOBI) Run() {
 		return
 	}
 
-	err := h.UpdateTradablePairs(forceUpdate)
+	err := h.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -254,44 +270,105 @@ func (h *HUOBI) Run() {
 	}
 }
 
-// FetchTradablePairs returns a list of the exchanges tradable pairs
-func (h *HUOBI) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	symbols, err := h.GetSymbols()
-	if err != nil {
-		return nil, err
-	}
-
+// FetchTradablePairs returns a list of the exchanges tradable pairs. Futures
+// pairs are the contract's currency and delivery date and swap pairs the
+// contract's currency and margin currency
+func (h *HUOBI) FetchTradablePairs(ctx context.Context, a asset.Item) ([]string, error) {
+	delimiter := h.GetPairFormat(a, false).Delimiter
 	var pairs []string
-	for x := range symbols {
-		if symbols[x].State != "online" {
-			continue
+	switch a {
+	case asset.Spot:
+		symbols, err := h.GetSymbols(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for x := range symbols {
+			if symbols[x].State != "online" {
+				continue
+			}
+			pairs = append(pairs, symbols[x].BaseCurrency+
+				delimiter+
+				symbols[x].QuoteCurrency)
+		}
+	case asset.Futures:
+		contracts, err := h.GetFuturesContractInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for x := range contracts {
+			if contracts[x].ContractStatus != contractTrading {
+				continue
+			}
+			pairs = append(pairs, futuresPair(contracts[x].Symbol, contracts[x].ContractCode, delimiter).String())
 		}
-		pairs = append(pairs, symbols[x].BaseCurrency+
-			h.GetPairFormat(asset, false).Delimiter+
-			symbols[x].QuoteCurrency)
+	case asset.PerpetualSwap:
+		contracts, err := h.GetSwapContractInfo(ctx)
+		if err != nil {
+			return nil, err
+		}
+		for x := range contracts {
+			if contracts[x].ContractStatus != contractTrading {
+				continue
+			}
+			pairs = append(pairs, swapPair(contracts[x].ContractCode, delimiter).String())
+		}
+	default:
+		return nil, fmt.Errorf("%s asset type %s not supported", h.Name, a)
 	}
-
 	return pairs, nil
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (h *HUOBI) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := h.FetchTradablePairs(asset.Spot)
-	if err != nil {
-		return err
+func (h *HUOBI) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	assets := h.GetAssetTypes()
+	for i := range assets {
+		pairs, err := h.FetchTradablePairs(ctx, assets[i])
+		if err != nil {
+			return err
+		}
+
+		err = h.UpdatePairs(currency.NewPairsFromStrings(pairs),
+			assets[i],
+			false,
+			forceUpdate)
+		if err != nil {
+			return err
+		}
 	}
+	return nil
+}
 
-	return h.UpdatePairs(currency.NewPairsFromStrings(pairs),
-		asset.Spot,
-		false,
-		forceUpdate)
+// futuresPair returns the pair of a coin margined futures contract, its
+// currency and delivery date
+func futuresPair(symbol, contractCode, delimiter string) currency.Pair {
+	return currency.NewPairWithDelimiter(symbol,
+		strings.TrimPrefix(strings.ToUpper(contractCode), strings.ToUpper(symbol)),
+		delimiter)
+}
+
+// swapPair returns the pair of a USDT margined swap contract
+func swapPair(contractCode, delimiter string) currency.Pair {
+	p := currency.NewPairDelimiter(contractCode, "-")
+	p.Delimiter = delimiter
+	return p
+}
+
+// contractCode returns the contract code of a futures or swap pair
+func contractCode(p currency.Pair, a asset.Item) string {
+	if a == asset.PerpetualSwap {
+		return p.Base.Upper().String() + "-" + p.Quote.Upper().String()
+	}
+	return p.Base.Upper().String() + p.Quote.Upper().String()
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (h *HUOBI) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (h *HUOBI) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	if assetType != asset.Spot {
+		return h.updateContractTicker(ctx, p, assetType)
+	}
 	tickerPrice := new(ticker.Price)
-	tickers, err := h.GetTickers()
+	tickers, err := h.GetTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -320,31 +397,77 @@ func (h *HUOBI) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pri
 	return ticker.GetTicker(h.Name, p, assetType)
 }
 
+// updateContractTicker updates and returns the ticker for a futures or swap
+// contract
+func (h *HUOBI) updateContractTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	var tick DetailMerged
+	var err error
+	switch assetType {
+	case asset.Futures:
+		tick, err = h.GetFuturesMarketDetail(ctx, contractCode(p, assetType))
+	case asset.PerpetualSwap:
+		tick, err = h.GetSwapMarketDetail(ctx, contractCode(p, assetType))
+	default:
+		err = fmt.Errorf("%s asset type %s not supported", h.Name, assetType)
+	}
+	if err != nil {
+		return nil, err
+	}
+	tickerPrice := &ticker.Price{
+		High:   tick.High,
+		Low:    tick.Low,
+		Volume: tick.Volume,
+		Open:   tick.Open,
+		Close:  tick.Close,
+		Pair:   p,
+	}
+	if len(tick.Bid) > 0 {
+		tickerPrice.Bid = tick.Bid[0]
+	}
+	if len(tick.Ask) > 0 {
+		tickerPrice.Ask = tick.Ask[0]
+	}
+	err = ticker.ProcessTicker(h.Name, tickerPrice, assetType)
+	if err != nil {
+		return nil, err
+	}
+	return ticker.GetTicker(h.Name, p, assetType)
+}
+
 // FetchTicker returns the ticker for a currency pair
-func (h *HUOBI) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (h *HUOBI) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(h.Name, p, assetType)
 	if err != nil {
-		return h.UpdateTicker(p, assetType)
+		return h.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (h *HUOBI) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (h *HUOBI) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(h.Name, p, assetType)
 	if err != nil {
-		return h.UpdateOrderbook(p, assetType)
+		return h.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (h *HUOBI) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (h *HUOBI) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := h.GetDepth(OrderBookDataRequestParams{
-		Symbol: h.FormatExchangeCurrency(p, assetType).String(),
-		Type:   OrderBookDataRequestParamsTypeStep0,
-	})
+	var orderbookNew Orderbook
+	var err error
+	switch assetType {
+	case asset.Futures:
+		orderbookNew, err = h.GetFuturesDepth(ctx, contractCode(p, assetType))
+	case asset.PerpetualSwap:
+		orderbookNew, err = h.GetSwapDepth(ctx, contractCode(p, assetType))
+	default:
+		orderbookNew, err = h.GetDepth(ctx, OrderBookDataRequestParams{
+			Symbol: h.FormatExchangeCurrency(p, assetType).String(),
+			Type:   OrderBookDataRequestParamsTypeStep0,
+		})
+	}
 	if err != nil {
 		return orderBook, err
 	}
@@ -376,8 +499,8 @@ func (h *HUOBI) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbo
 }
 
 // GetAccountID returns the account ID for trades
-func (h *HUOBI) GetAccountID() ([]Account, error) {
-	acc, err := h.GetAccounts()
+func (h *HUOBI) GetAccountID(ctx context.Context) ([]Account, error) {
+	acc, err := h.GetAccounts(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -391,7 +514,7 @@ func (h *HUOBI) GetAccountID() ([]Account, error) {
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // HUOBI exchange - to-do
-func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
+func (h *HUOBI) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 	info.Exchange = h.Name
 	if h.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
@@ -406,10 +529,10 @@ func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
 			}
 			currData := account.Balance{
 				CurrencyName: currency.NewCode(resp.Data[i].List[0].Currency),
-				TotalValue:   resp.Data[i].List[0].Balance,
+				TotalValue:   decimal.NewFromFloat(resp.Data[i].List[0].Balance),
 			}
 			if len(resp.Data[i].List) > 1 && resp.Data[i].List[1].Type == "frozen" {
-				currData.Hold = resp.Data[i].List[1].Balance
+				currData.Hold = decimal.NewFromFloat(resp.Data[i].List[1].Balance)
 			}
 			currencyDetails = append(currencyDetails, currData)
 		}
@@ -417,14 +540,14 @@ func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
 		acc.Currencies = currencyDetails
 		info.Accounts = append(info.Accounts, acc)
 	} else {
-		accounts, err := h.GetAccountID()
+		accounts, err := h.GetAccountID(ctx)
 		if err != nil {
 			return info, err
 		}
 		for i := range accounts {
 			var acc account.SubAccount
 			acc.ID = strconv.FormatInt(accounts[i].ID, 10)
-			balances, err := h.GetAccountBalance(acc.ID)
+			balances, err := h.GetAccountBalance(ctx, acc.ID)
 			if err != nil {
 				return info, err
 			}
@@ -440,9 +563,9 @@ func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
 				for i := range currencyDetails {
 					if currencyDetails[i].CurrencyName == currency.NewCode(balances[j].Currency) {
 						if frozen {
-							currencyDetails[i].Hold = balances[j].Balance
+							currencyDetails[i].Hold = decimal.NewFromFloat(balances[j].Balance)
 						} else {
-							currencyDetails[i].TotalValue = balances[j].Balance
+							currencyDetails[i].TotalValue = decimal.NewFromFloat(balances[j].Balance)
 						}
 						updated = true
 					}
@@ -456,13 +579,13 @@ func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
 					currencyDetails = append(currencyDetails,
 						account.Balance{
 							CurrencyName: currency.NewCode(balances[j].Currency),
-							Hold:         balances[j].Balance,
+							Hold:         decimal.NewFromFloat(balances[j].Balance),
 						})
 				} else {
 					currencyDetails = append(currencyDetails,
 						account.Balance{
 							CurrencyName: currency.NewCode(balances[j].Currency),
-							TotalValue:   balances[j].Balance,
+							TotalValue:   decimal.NewFromFloat(balances[j].Balance),
 						})
 				}
 			}
@@ -481,10 +604,10 @@ func (h *HUOBI) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (h *HUOBI) FetchAccountInfo() (account.Holdings, error) {
+func (h *HUOBI) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(h.Name)
 	if err != nil {
-		return h.UpdateAccountInfo()
+		return h.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -492,21 +615,196 @@ func (h *HUOBI) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (h *HUOBI) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (h *HUOBI) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (h *HUOBI) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the coin margined futures and USDT margined swap
+// positions held and the margin backing them. Swap margin is read from the
+// unified account
+func (h *HUOBI) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	resp, err := h.futuresMarginPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	swaps, err := h.swapMarginPositions(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return append(resp, swaps...), nil
+}
+
+// futuresMarginPositions returns the coin margined futures positions. Each
+// currency's positions are margined by its own futures account, so are
+// isolated from the others
+func (h *HUOBI) futuresMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	positions, err := h.GetFuturesPositions(ctx, "")
+	if err != nil || len(positions) == 0 {
+		return nil, err
+	}
+	accounts, err := h.GetFuturesAccountInfo(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	delimiter := h.GetPairFormat(asset.Futures, false).Delimiter
+	resp := make([]exchange.MarginPosition, 0, len(positions))
+	for i := range positions {
+		m := h.marginPosition(&positions[i], asset.Futures,
+			futuresPair(positions[i].Symbol, positions[i].ContractCode, delimiter))
+		m.Currency = currency.NewCode(positions[i].Symbol)
+		m.Isolated = true
+		for j := range accounts {
+			if !strings.EqualFold(accounts[j].Symbol, positions[i].Symbol) {
+				continue
+			}
+			m.Margin = accounts[j].MarginBalance
+			m.MaintenanceMargin = maintenanceMargin(accounts[j].MarginPosition, accounts[j].AdjustFactor)
+			m.LiquidationPrice = accounts[j].LiquidationPrice
+			break
+		}
+		resp = append(resp, m)
+	}
+	return resp, nil
+}
+
+// swapMarginPositions returns the USDT margined swap positions. Cross margin
+// positions share the unified account's cross margin while isolated
+// positions hold their own
+func (h *HUOBI) swapMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	cross, err := h.GetSwapPositions(ctx, "", false)
+	if err != nil {
+		return nil, err
+	}
+	isolated, err := h.GetSwapPositions(ctx, "", true)
+	if err != nil {
+		return nil, err
+	}
+	positions := make([]FuturesPosition, 0, len(cross)+len(isolated))
+	positions = append(append(positions, cross...), isolated...)
+	if len(positions) == 0 {
+		return nil, nil
+	}
+	accounts, err := h.GetUnifiedAccountInfo(ctx)
+	if err != nil {
+		return nil, err
+	}
+	delimiter := h.GetPairFormat(asset.PerpetualSwap, false).Delimiter
+	resp := make([]exchange.MarginPosition, 0, len(positions))
+	for i := range positions {
+		m := h.marginPosition(&positions[i], asset.PerpetualSwap,
+			swapPair(positions[i].ContractCode, delimiter))
+		m.Currency = currency.NewCode(positions[i].MarginAsset)
+		m.Isolated = positions[i].MarginMode == marginModeIsolated
+		for j := range accounts {
+			if !strings.EqualFold(accounts[j].MarginAsset, positions[i].MarginAsset) {
+				continue
+			}
+			unifiedMargin(&m, &accounts[j], positions[i].ContractCode)
+			break
+		}
+		resp = append(resp, m)
+	}
+	return resp, nil
+}
+
+// marginPosition converts a position into a margin position, short positions
+// having a negative size in contracts
+func (h *HUOBI) marginPosition(p *FuturesPosition, a asset.Item, pair currency.Pair) exchange.MarginPosition {
+	size := p.Volume
+	if p.Direction == order.Sell.Lower() {
+		size = -size
+	}
+	return exchange.MarginPosition{
+		Exchange:  h.Name,
+		AssetType: a,
+		Pair:      pair,
+		Size:      size,
+		MarkPrice: p.LastPrice,
+	}
+}
+
+// unifiedMargin sets the margin of a swap position from its unified account.
+// Cross margin positions are backed by the account's cross margin and
+// maintained by the margin of every cross margin contract
+func unifiedMargin(m *exchange.MarginPosition, acc *UnifiedAccount, contract string) {
+	if m.Isolated {
+		for i := range acc.IsolatedSwap {
+			if acc.IsolatedSwap[i].ContractCode != contract {
+				continue
+			}
+			m.Margin = acc.IsolatedSwap[i].MarginBalance
+			m.MaintenanceMargin = maintenanceMargin(acc.IsolatedSwap[i].MarginPosition,
+				acc.IsolatedSwap[i].AdjustFactor)
+			m.LiquidationPrice = acc.IsolatedSwap[i].LiquidationPrice
+			return
+		}
+		return
+	}
+	m.Margin = decimal.NewFromFloat(acc.CrossMarginStatic).Add(decimal.NewFromFloat(acc.CrossProfitUnreal)).Float64()
+	maintenance := decimal.Zero
+	contracts := append(append([]UnifiedContractMargin{}, acc.CrossSwap...), acc.CrossFuture...)
+	for i := range contracts {
+		maintenance = maintenance.Add(decimal.NewFromFloat(
+			maintenanceMargin(contracts[i].MarginPosition, contracts[i].AdjustFactor)))
+		if contracts[i].ContractCode == contract {
+			m.LiquidationPrice = contracts[i].LiquidationPrice
+		}
+	}
+	m.MaintenanceMargin = maintenance.Float64()
+}
+
+// maintenanceMargin returns the margin at which positions are liquidated,
+// their position margin scaled by the adjustment factor of their leverage
+func maintenanceMargin(positionMargin, adjustFactor float64) float64 {
+	return decimal.NewFromFloat(positionMargin).Mul(decimal.NewFromFloat(adjustFactor)).Float64()
+}
+
+// AddMargin transfers margin from the spot account to the futures account of
+// a coin margined futures pair's currency or to the isolated margin account
+// of a USDT margined swap
+func (h *HUOBI) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	if amount.Sign() <= 0 {
+		return errors.New("margin amount must be greater than 0")
+	}
+	var err error
+	switch assetType {
+	case asset.Futures:
+		_, err = h.TransferFutures(ctx, p.Base, amount.Float64(), SpotToFutures)
+	case asset.PerpetualSwap:
+		_, err = h.TransferAccount(ctx, SpotAccount, LinearSwapAccount, p.Quote, amount.Float64(),
+			contractCode(p, assetType))
+	default:
+		err = fmt.Errorf("%s margin can't be added to %s assets", h.Name, assetType)
+	}
+	return err
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (h *HUOBI) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrNotYetImplemented
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (h *HUOBI) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (h *HUOBI) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (h *HUOBI) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
 	}
+	if s.AssetType != "" && s.AssetType != asset.Spot {
+		return submitOrderResponse, fmt.Errorf("%s orders can't be submitted for %s assets", h.Name, s.AssetType)
+	}
 
 	accountID, err := strconv.ParseInt(s.ClientID, 10, 64)
 	if err != nil {
@@ -515,7 +813,7 @@ func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 	var formattedType SpotNewOrderRequestParamsType
 	var params = SpotNewOrderRequestParams{
-		Amount:    s.Amount,
+		Amount:    s.Amount.Float64(),
 		Source:    "api",
 		Symbol:    s.Pair.Lower().String(),
 		AccountID: int(accountID),
@@ -528,14 +826,14 @@ func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		formattedType = SpotNewOrderRequestTypeSellMarket
 	case s.OrderSide == order.Buy && s.OrderType == order.Limit:
 		formattedType = SpotNewOrderRequestTypeBuyLimit
-		params.Price = s.Price
+		params.Price = s.Price.Float64()
 	case s.OrderSide == order.Sell && s.OrderType == order.Limit:
 		formattedType = SpotNewOrderRequestTypeSellLimit
-		params.Price = s.Price
+		params.Price = s.Price.Float64()
 	}
 
 	params.Type = formattedType
-	response, err := h.SpotNewOrder(params)
+	response, err := h.SpotNewOrder(ctx, params)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -552,27 +850,27 @@ func (h *HUOBI) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (h *HUOBI) ModifyOrder(action *order.Modify) (string, error) {
+func (h *HUOBI) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (h *HUOBI) CancelOrder(order *order.Cancel) error {
+func (h *HUOBI) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	_, err = h.CancelExistingOrder(orderIDInt)
+	_, err = h.CancelExistingOrder(ctx, orderIDInt)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (h *HUOBI) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (h *HUOBI) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	var cancelAllOrdersResponse order.CancelAllResponse
 	enabledPairs := h.GetEnabledPairs(asset.Spot)
 	for i := range enabledPairs {
-		resp, err := h.CancelOpenOrdersBatch(orderCancellation.AccountID,
+		resp, err := h.CancelOpenOrdersBatch(ctx, orderCancellation.AccountID,
 			h.FormatExchangeCurrency(enabledPairs[i], asset.Spot).String())
 		if err != nil {
 			return cancelAllOrdersResponse, err
@@ -593,7 +891,7 @@ func (h *HUOBI) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAl
 }
 
 // GetOrderInfo returns information on a current open order
-func (h *HUOBI) GetOrderInfo(orderID string) (order.Detail, error) {
+func (h *HUOBI) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	var respData *OrderInfo
 	if h.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
@@ -607,7 +905,7 @@ func (h *HUOBI) GetOrderInfo(orderID string) (order.Detail, error) {
 		if err != nil {
 			return orderDetail, err
 		}
-		resp, err := h.GetOrder(oID)
+		resp, err := h.GetOrder(ctx, oID)
 		if err != nil {
 			return orderDetail, err
 		}
@@ -637,38 +935,38 @@ func (h *HUOBI) GetOrderInfo(orderID string) (order.Detail, error) {
 		CurrencyPair:   currency.NewPairFromString(respData.Symbol),
 		OrderType:      orderType,
 		OrderSide:      orderSide,
-		OrderDate:      time.Unix(0, respData.CreatedAt*int64(time.Millisecond)),
+		OrderDate:      convert.TimeFromUnix(respData.CreatedAt, time.Millisecond),
 		Status:         orderStatus,
-		Price:          respData.Price,
-		Amount:         respData.Amount,
-		ExecutedAmount: respData.FilledAmount,
-		Fee:            respData.FilledFees,
+		Price:          decimal.NewFromFloat(respData.Price),
+		Amount:         decimal.NewFromFloat(respData.Amount),
+		ExecutedAmount: decimal.NewFromFloat(respData.FilledAmount),
+		Fee:            decimal.NewFromFloat(respData.FilledFees),
 	}
 	return orderDetail, nil
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (h *HUOBI) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
-	resp, err := h.QueryDepositAddress(cryptocurrency.Lower().String())
+func (h *HUOBI) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
+	resp, err := h.QueryDepositAddress(ctx, cryptocurrency.Lower().String())
 	return resp.Address, err
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (h *HUOBI) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	resp, err := h.Withdraw(withdrawRequest.Currency, withdrawRequest.Address, withdrawRequest.AddressTag, withdrawRequest.Amount, withdrawRequest.FeeAmount)
+func (h *HUOBI) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	resp, err := h.Withdraw(ctx, withdrawRequest.Currency, withdrawRequest.Address, withdrawRequest.AddressTag, withdrawRequest.Amount, withdrawRequest.FeeAmount)
 	return strconv.FormatInt(resp, 10), err
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (h *HUOBI) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (h *HUOBI) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (h *HUOBI) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (h *HUOBI) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -678,7 +976,7 @@ func (h *HUOBI) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (h *HUOBI) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (h *HUOBI) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !h.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -687,7 +985,7 @@ func (h *HUOBI) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (h *HUOBI) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (h *HUOBI) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		return nil, errors.New("currency must be supplied")
 	}
@@ -729,19 +1027,19 @@ func (h *HUOBI) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 					CurrencyPair:    req.Currencies[i],
 					OrderType:       orderType,
 					OrderSide:       orderSide,
-					OrderDate:       time.Unix(0, resp.Data[j].CreatedAt*int64(time.Millisecond)),
+					OrderDate:       convert.TimeFromUnix(resp.Data[j].CreatedAt, time.Millisecond),
 					Status:          orderStatus,
-					Price:           resp.Data[j].Price,
-					Amount:          resp.Data[j].OrderAmount,
-					ExecutedAmount:  resp.Data[j].FilledAmount,
-					RemainingAmount: resp.Data[j].UnfilledAmount,
-					Fee:             resp.Data[j].FilledFees,
+					Price:           decimal.NewFromFloat(resp.Data[j].Price),
+					Amount:          decimal.NewFromFloat(resp.Data[j].OrderAmount),
+					ExecutedAmount:  decimal.NewFromFloat(resp.Data[j].FilledAmount),
+					RemainingAmount: decimal.NewFromFloat(resp.Data[j].UnfilledAmount),
+					Fee:             decimal.NewFromFloat(resp.Data[j].FilledFees),
 				})
 			}
 		}
 	} else {
 		for i := range req.Currencies {
-			resp, err := h.GetOpenOrders(h.API.Credentials.ClientID,
+			resp, err := h.GetOpenOrders(ctx, h.API.Credentials.ClientID,
 				req.Currencies[i].Lower().String(),
 				side,
 				500)
@@ -752,15 +1050,15 @@ func (h *HUOBI) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 			for i := range resp {
 				orderDetail := order.Detail{
 					ID:             strconv.FormatInt(resp[i].ID, 10),
-					Price:          resp[i].Price,
-					Amount:         resp[i].Amount,
+					Price:          decimal.NewFromFloat(resp[i].Price),
+					Amount:         decimal.NewFromFloat(resp[i].Amount),
 					CurrencyPair:   req.Currencies[i],
 					Exchange:       h.Name,
-					ExecutedAmount: resp[i].FilledAmount,
-					OrderDate:      time.Unix(0, resp[i].CreatedAt*int64(time.Millisecond)),
+					ExecutedAmount: decimal.NewFromFloat(resp[i].FilledAmount),
+					OrderDate:      convert.TimeFromUnix(resp[i].CreatedAt, time.Millisecond),
 					Status:         order.Status(resp[i].State),
 					AccountID:      strconv.FormatInt(resp[i].AccountID, 10),
-					Fee:            resp[i].FilledFees,
+					Fee:            decimal.NewFromFloat(resp[i].FilledFees),
 				}
 
 				setOrderSideAndType(resp[i].Type, &orderDetail)
@@ -776,7 +1074,7 @@ func (h *HUOBI) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (h *HUOBI) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (h *HUOBI) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if len(req.Currencies) == 0 {
 		return nil, errors.New("currency must be supplied")
 	}
@@ -784,7 +1082,7 @@ func (h *HUOBI) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, er
 	states := "partial-canceled,filled,canceled"
 	var orders []order.Detail
 	for i := range req.Currencies {
-		resp, err := h.GetOrders(req.Currencies[i].Lower().String(),
+		resp, err := h.GetOrders(ctx, req.Currencies[i].Lower().String(),
 			"",
 			"",
 			"",
@@ -799,15 +1097,15 @@ func (h *HUOBI) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, er
 		for i := range resp {
 			orderDetail := order.Detail{
 				ID:             strconv.FormatInt(resp[i].ID, 10),
-				Price:          resp[i].Price,
-				Amount:         resp[i].Amount,
+				Price:          decimal.NewFromFloat(resp[i].Price),
+				Amount:         decimal.NewFromFloat(resp[i].Amount),
 				CurrencyPair:   req.Currencies[i],
 				Exchange:       h.Name,
-				ExecutedAmount: resp[i].FilledAmount,
-				OrderDate:      time.Unix(0, resp[i].CreatedAt*int64(time.Millisecond)),
+				ExecutedAmount: decimal.NewFromFloat(resp[i].FilledAmount),
+				OrderDate:      convert.TimeFromUnix(resp[i].CreatedAt, time.Millisecond),
 				Status:         order.Status(resp[i].State),
 				AccountID:      strconv.FormatInt(resp[i].AccountID, 10),
-				Fee:            resp[i].FilledFees,
+				Fee:            decimal.NewFromFloat(resp[i].FilledFees),
 			}
 
 			setOrderSideAndType(resp[i].Type, &orderDetail)
@@ -837,18 +1135,16 @@ func setOrderSideAndType(requestType string, orderDetail *order.Detail) {
 	}
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (h *HUOBI) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	h.Websocket.SubscribeToChannels(channels)
-	return nil
+	return h.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (h *HUOBI) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	h.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return h.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -863,7 +1159,12 @@ func (h *HUOBI) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (h *HUOBI) ValidateCredentials() error {
-	_, err := h.UpdateAccountInfo()
+func (h *HUOBI) ValidateCredentials(ctx context.Context) error {
+	_, err := h.UpdateAccountInfo(ctx)
 	return h.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (h *HUOBI) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
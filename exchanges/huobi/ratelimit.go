@@ -43,7 +43,6 @@ type RateLimit struct {
 // Limit limits outbound requests
 func (r *RateLimit) Limit(f request.EndpointLimit) error {
 	switch f {
-	// TODO: Add futures and swap functionality
 	case huobiFuturesAuth:
 		time.Sleep(r.FuturesAuth.Reserve().Delay())
 	case huobiFuturesUnAuth:
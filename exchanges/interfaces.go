@@ -1,8 +1,11 @@
 package exchange
 
 import (
+	"context"
 	"sync"
+	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
@@ -23,40 +26,45 @@ type IBotExchange interface {
 	GetName() string
 	IsEnabled() bool
 	SetEnabled(bool)
-	ValidateCredentials() error
-	FetchTicker(currency currency.Pair, assetType asset.Item) (*ticker.Price, error)
-	UpdateTicker(currency currency.Pair, assetType asset.Item) (*ticker.Price, error)
-	FetchOrderbook(currency currency.Pair, assetType asset.Item) (*orderbook.Base, error)
-	UpdateOrderbook(currency currency.Pair, assetType asset.Item) (*orderbook.Base, error)
-	FetchTradablePairs(assetType asset.Item) ([]string, error)
-	UpdateTradablePairs(forceUpdate bool) error
+	ValidateCredentials(ctx context.Context) error
+	GetAPIKeyPermissions(ctx context.Context) (APIKeyPermissions, error)
+	FetchTicker(ctx context.Context, currency currency.Pair, assetType asset.Item) (*ticker.Price, error)
+	UpdateTicker(ctx context.Context, currency currency.Pair, assetType asset.Item) (*ticker.Price, error)
+	FetchOrderbook(ctx context.Context, currency currency.Pair, assetType asset.Item) (*orderbook.Base, error)
+	UpdateOrderbook(ctx context.Context, currency currency.Pair, assetType asset.Item) (*orderbook.Base, error)
+	FetchTradablePairs(ctx context.Context, assetType asset.Item) ([]string, error)
+	UpdateTradablePairs(ctx context.Context, forceUpdate bool) error
 	GetEnabledPairs(assetType asset.Item) currency.Pairs
 	GetAvailablePairs(assetType asset.Item) currency.Pairs
-	FetchAccountInfo() (account.Holdings, error)
-	UpdateAccountInfo() (account.Holdings, error)
+	FetchAccountInfo(ctx context.Context) (account.Holdings, error)
+	UpdateAccountInfo(ctx context.Context) (account.Holdings, error)
 	GetAuthenticatedAPISupport(endpoint uint8) bool
 	SetPairs(pairs currency.Pairs, assetType asset.Item, enabled bool) error
 	GetAssetTypes() asset.Items
-	GetExchangeHistory(currencyPair currency.Pair, assetType asset.Item) ([]TradeHistory, error)
+	GetExchangeHistory(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) ([]TradeHistory, error)
 	SupportsAutoPairUpdates() bool
 	SupportsRESTTickerBatchUpdates() bool
-	GetFeeByType(feeBuilder *FeeBuilder) (float64, error)
+	GetFeeByType(ctx context.Context, feeBuilder *FeeBuilder) (float64, error)
 	GetLastPairsUpdateTime() int64
 	GetWithdrawPermissions() uint32
 	FormatWithdrawPermissions() string
 	SupportsWithdrawPermissions(permissions uint32) bool
-	GetFundingHistory() ([]FundHistory, error)
-	SubmitOrder(s *order.Submit) (order.SubmitResponse, error)
-	ModifyOrder(action *order.Modify) (string, error)
-	CancelOrder(order *order.Cancel) error
-	CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse, error)
-	GetOrderInfo(orderID string) (order.Detail, error)
-	GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error)
-	GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
-	GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
-	WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error)
-	WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error)
-	WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error)
+	GetFundingHistory(ctx context.Context) ([]FundHistory, error)
+	GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]FundingPayment, error)
+	GetMarginPositions(ctx context.Context) ([]MarginPosition, error)
+	AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error
+	GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (OpenInterest, error)
+	SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error)
+	ModifyOrder(ctx context.Context, action *order.Modify) (string, error)
+	CancelOrder(ctx context.Context, order *order.Cancel) error
+	CancelAllOrders(ctx context.Context, orders *order.Cancel) (order.CancelAllResponse, error)
+	GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error)
+	GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error)
+	GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
+	GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error)
+	WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error)
+	WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error)
+	WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error)
 	SetHTTPClientUserAgent(ua string)
 	GetHTTPClientUserAgent() string
 	SetClientProxyAddress(addr string) error
@@ -71,7 +79,7 @@ type IBotExchange interface {
 	GetDefaultConfig() (*config.ExchangeConfig, error)
 	GetBase() *Base
 	SupportsAsset(assetType asset.Item) bool
-	GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]Candle, error)
+	GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]Candle, error)
 	DisableRateLimiter() error
 	EnableRateLimiter() error
 }
@@ -2,16 +2,17 @@ package itbit
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"net/http"
 	"net/url"
 	"strconv"
-	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
@@ -39,38 +40,38 @@ type ItBit struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (i *ItBit) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (i *ItBit) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetTicker returns ticker info for a specified market.
 // currencyPair - example "XBTUSD" "XBTSGD" "XBTEUR"
-func (i *ItBit) GetTicker(currencyPair string) (Ticker, error) {
+func (i *ItBit) GetTicker(ctx context.Context, currencyPair string) (Ticker, error) {
 	var response Ticker
 	path := fmt.Sprintf("%s/%s/%s/%s", i.API.Endpoints.URL, itbitMarkets, currencyPair, itbitTicker)
 
-	return response, i.SendHTTPRequest(path, &response)
+	return response, i.SendHTTPRequest(ctx, path, &response)
 }
 
 // GetOrderbook returns full order book for the specified market.
 // currencyPair - example "XBTUSD" "XBTSGD" "XBTEUR"
-func (i *ItBit) GetOrderbook(currencyPair string) (OrderbookResponse, error) {
+func (i *ItBit) GetOrderbook(ctx context.Context, currencyPair string) (OrderbookResponse, error) {
 	response := OrderbookResponse{}
 	path := fmt.Sprintf("%s/%s/%s/%s", i.API.Endpoints.URL, itbitMarkets, currencyPair, itbitOrderbook)
 
-	return response, i.SendHTTPRequest(path, &response)
+	return response, i.SendHTTPRequest(ctx, path, &response)
 }
 
 // GetTradeHistory returns recent trades for a specified market.
 //
 // currencyPair - example "XBTUSD" "XBTSGD" "XBTEUR"
 // timestamp - matchNumber, only executions after this will be returned
-func (i *ItBit) GetTradeHistory(currencyPair, timestamp string) (Trades, error) {
+func (i *ItBit) GetTradeHistory(ctx context.Context, currencyPair, timestamp string) (Trades, error) {
 	response := Trades{}
 	req := "trades?since=" + timestamp
 	path := fmt.Sprintf("%s/%s/%s/%s", i.API.Endpoints.URL, itbitMarkets, currencyPair, req)
 
-	return response, i.SendHTTPRequest(path, &response)
+	return response, i.SendHTTPRequest(ctx, path, &response)
 }
 
 // GetWallets returns information about all wallets associated with the account.
@@ -78,21 +79,21 @@ func (i *ItBit) GetTradeHistory(currencyPair, timestamp string) (Trades, error)
 // params --
 // 					page - [optional] page to return example 1. default 1
 //					perPage - [optional] items per page example 50, default 50 max 50
-func (i *ItBit) GetWallets(params url.Values) ([]Wallet, error) {
+func (i *ItBit) GetWallets(ctx context.Context, params url.Values) ([]Wallet, error) {
 	var resp []Wallet
 	params.Set("userId", i.API.Credentials.ClientID)
 	path := fmt.Sprintf("/%s?%s", itbitWallets, params.Encode())
-	return resp, i.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	return resp, i.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, path, nil, &resp)
 }
 
 // CreateWallet creates a new wallet with a specified name.
-func (i *ItBit) CreateWallet(walletName string) (Wallet, error) {
+func (i *ItBit) CreateWallet(ctx context.Context, walletName string) (Wallet, error) {
 	resp := Wallet{}
 	params := make(map[string]interface{})
 	params["userId"] = i.API.Credentials.ClientID
 	params["name"] = walletName
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodPost, "/"+itbitWallets, params, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, "/"+itbitWallets, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -103,11 +104,11 @@ func (i *ItBit) CreateWallet(walletName string) (Wallet, error) {
 }
 
 // GetWallet returns wallet information by walletID
-func (i *ItBit) GetWallet(walletID string) (Wallet, error) {
+func (i *ItBit) GetWallet(ctx context.Context, walletID string) (Wallet, error) {
 	resp := Wallet{}
 	path := fmt.Sprintf("/%s/%s", itbitWallets, walletID)
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -119,11 +120,11 @@ func (i *ItBit) GetWallet(walletID string) (Wallet, error) {
 
 // GetWalletBalance returns balance information for a specific currency in a
 // wallet.
-func (i *ItBit) GetWalletBalance(walletID, currency string) (Balance, error) {
+func (i *ItBit) GetWalletBalance(ctx context.Context, walletID, currency string) (Balance, error) {
 	resp := Balance{}
 	path := fmt.Sprintf("/%s/%s/%s/%s", itbitWallets, walletID, itbitBalances, currency)
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -135,7 +136,7 @@ func (i *ItBit) GetWalletBalance(walletID, currency string) (Balance, error) {
 
 // GetOrders returns active orders for itBit
 // perPage defaults to & has a limit of 50
-func (i *ItBit) GetOrders(walletID, symbol, status string, page, perPage int64) ([]Order, error) {
+func (i *ItBit) GetOrders(ctx context.Context, walletID, symbol, status string, page, perPage int64) ([]Order, error) {
 	var resp []Order
 	params := make(map[string]interface{})
 	params["walletID"] = walletID
@@ -153,16 +154,16 @@ func (i *ItBit) GetOrders(walletID, symbol, status string, page, perPage int64)
 		params["perPage"] = strconv.FormatInt(perPage, 10)
 	}
 
-	return resp, i.SendAuthenticatedHTTPRequest(http.MethodGet, itbitOrders, params, &resp)
+	return resp, i.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, itbitOrders, params, &resp)
 }
 
 // GetWalletTrades returns all trades for a specified wallet.
-func (i *ItBit) GetWalletTrades(walletID string, params url.Values) (Records, error) {
+func (i *ItBit) GetWalletTrades(ctx context.Context, walletID string, params url.Values) (Records, error) {
 	resp := Records{}
 	urlPath := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitTrades)
 	path := common.EncodeURLValues(urlPath, params)
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -173,12 +174,12 @@ func (i *ItBit) GetWalletTrades(walletID string, params url.Values) (Records, er
 }
 
 // GetFundingHistoryForWallet returns all funding history for a specified wallet.
-func (i *ItBit) GetFundingHistoryForWallet(walletID string, params url.Values) (FundingRecords, error) {
+func (i *ItBit) GetFundingHistoryForWallet(ctx context.Context, walletID string, params url.Values) (FundingRecords, error) {
 	resp := FundingRecords{}
 	urlPath := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitFundingHistory)
 	path := common.EncodeURLValues(urlPath, params)
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -189,7 +190,7 @@ func (i *ItBit) GetFundingHistoryForWallet(walletID string, params url.Values) (
 }
 
 // PlaceOrder places a new order
-func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, price float64, instrument, clientRef string) (Order, error) {
+func (i *ItBit) PlaceOrder(ctx context.Context, walletID, side, orderType, currency string, amount decimal.Decimal, price float64, instrument, clientRef string) (Order, error) {
 	resp := Order{}
 	path := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitOrders)
 
@@ -197,7 +198,7 @@ func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, p
 	params["side"] = side
 	params["type"] = orderType
 	params["currency"] = currency
-	params["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
+	params["amount"] = amount.String()
 	params["price"] = strconv.FormatFloat(price, 'f', -1, 64)
 	params["instrument"] = instrument
 
@@ -205,7 +206,7 @@ func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, p
 		params["clientOrderIdentifier"] = clientRef
 	}
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodPost, path, params, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -216,12 +217,12 @@ func (i *ItBit) PlaceOrder(walletID, side, orderType, currency string, amount, p
 }
 
 // GetOrder returns an order by id.
-func (i *ItBit) GetOrder(walletID string, params url.Values) (Order, error) {
+func (i *ItBit) GetOrder(ctx context.Context, walletID string, params url.Values) (Order, error) {
 	resp := Order{}
 	urlPath := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitOrders)
 	path := common.EncodeURLValues(urlPath, params)
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodGet, path, nil, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, path, nil, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -233,20 +234,20 @@ func (i *ItBit) GetOrder(walletID string, params url.Values) (Order, error) {
 
 // CancelExistingOrder cancels and open order. *This is not a guarantee that the
 // order has been cancelled!*
-func (i *ItBit) CancelExistingOrder(walletID, orderID string) error {
+func (i *ItBit) CancelExistingOrder(ctx context.Context, walletID, orderID string) error {
 	path := fmt.Sprintf("/%s/%s/%s/%s", itbitWallets, walletID, itbitOrders, orderID)
 
-	return i.SendAuthenticatedHTTPRequest(http.MethodDelete, path, nil, nil)
+	return i.SendAuthenticatedHTTPRequest(ctx, http.MethodDelete, path, nil, nil)
 }
 
 // GetCryptoDepositAddress returns a deposit address to send cryptocurrency to.
-func (i *ItBit) GetCryptoDepositAddress(walletID, currency string) (CryptoCurrencyDeposit, error) {
+func (i *ItBit) GetCryptoDepositAddress(ctx context.Context, walletID, currency string) (CryptoCurrencyDeposit, error) {
 	resp := CryptoCurrencyDeposit{}
 	path := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitCryptoDeposits)
 	params := make(map[string]interface{})
 	params["currency"] = currency
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodPost, path, params, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -257,7 +258,7 @@ func (i *ItBit) GetCryptoDepositAddress(walletID, currency string) (CryptoCurren
 }
 
 // WalletTransfer transfers funds between wallets.
-func (i *ItBit) WalletTransfer(walletID, sourceWallet, destWallet string, amount float64, currency string) (WalletTransfer, error) {
+func (i *ItBit) WalletTransfer(ctx context.Context, walletID, sourceWallet, destWallet string, amount float64, currency string) (WalletTransfer, error) {
 	resp := WalletTransfer{}
 	path := fmt.Sprintf("/%s/%s/%s", itbitWallets, walletID, itbitWalletTransfer)
 
@@ -267,7 +268,7 @@ func (i *ItBit) WalletTransfer(walletID, sourceWallet, destWallet string, amount
 	params["amount"] = strconv.FormatFloat(amount, 'f', -1, 64)
 	params["currencyCode"] = currency
 
-	err := i.SendAuthenticatedHTTPRequest(http.MethodPost, path, params, &resp)
+	err := i.SendAuthenticatedHTTPRequest(ctx, http.MethodPost, path, params, &resp)
 	if err != nil {
 		return resp, err
 	}
@@ -278,8 +279,8 @@ func (i *ItBit) WalletTransfer(walletID, sourceWallet, destWallet string, amount
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (i *ItBit) SendHTTPRequest(path string, result interface{}) error {
-	return i.SendPayload(&request.Item{
+func (i *ItBit) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return i.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -290,7 +291,7 @@ func (i *ItBit) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated request to itBit
-func (i *ItBit) SendAuthenticatedHTTPRequest(method, path string, params map[string]interface{}, result interface{}) error {
+func (i *ItBit) SendAuthenticatedHTTPRequest(ctx context.Context, method, path string, params map[string]interface{}, result interface{}) error {
 	if !i.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, i.Name)
 	}
@@ -317,7 +318,7 @@ func (i *ItBit) SendAuthenticatedHTTPRequest(method, path string, params map[str
 	}
 
 	n := i.Requester.GetNonce(true).String()
-	timestamp := strconv.FormatInt(time.Now().UnixNano()/1000000, 10)
+	timestamp := strconv.FormatInt(i.Requester.Now().UnixNano()/1000000, 10)
 	message, err := json.Marshal([]string{method, urlPath, string(PayloadJSON), n, timestamp})
 	if err != nil {
 		return err
@@ -341,7 +342,7 @@ func (i *ItBit) SendAuthenticatedHTTPRequest(method, path string, params map[str
 		RequestID   string `json:"requestId"`
 	}{}
 
-	err = i.SendPayload(&request.Item{
+	err = i.SendPayload(ctx, &request.Item{
 		Method:        method,
 		Path:          urlPath,
 		Headers:       headers,
@@ -1,12 +1,14 @@
 package itbit
 
 import (
+	"context"
 	"log"
 	"net/url"
 	"os"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -51,7 +53,7 @@ func TestMain(m *testing.M) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetTicker("XBTUSD")
+	_, err := i.GetTicker(context.Background(), "XBTUSD")
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -59,7 +61,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetOrderbook("XBTSGD")
+	_, err := i.GetOrderbook(context.Background(), "XBTSGD")
 	if err != nil {
 		t.Error("GetOrderbook() error", err)
 	}
@@ -67,57 +69,57 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := i.GetTradeHistory("XBTUSD", "0")
+	_, err := i.GetTradeHistory(context.Background(), "XBTUSD", "0")
 	if err != nil {
 		t.Error("GetTradeHistory() error", err)
 	}
 }
 
 func TestGetWallets(t *testing.T) {
-	_, err := i.GetWallets(url.Values{})
+	_, err := i.GetWallets(context.Background(), url.Values{})
 	if err == nil {
 		t.Error("GetWallets() Expected error")
 	}
 }
 
 func TestCreateWallet(t *testing.T) {
-	_, err := i.CreateWallet("test")
+	_, err := i.CreateWallet(context.Background(), "test")
 	if err == nil {
 		t.Error("CreateWallet() Expected error")
 	}
 }
 
 func TestGetWallet(t *testing.T) {
-	_, err := i.GetWallet("1337")
+	_, err := i.GetWallet(context.Background(), "1337")
 	if err == nil {
 		t.Error("GetWallet() Expected error")
 	}
 }
 
 func TestGetWalletBalance(t *testing.T) {
-	_, err := i.GetWalletBalance("1337", "XRT")
+	_, err := i.GetWalletBalance(context.Background(), "1337", "XRT")
 	if err == nil {
 		t.Error("GetWalletBalance() Expected error")
 	}
 }
 
 func TestGetWalletTrades(t *testing.T) {
-	_, err := i.GetWalletTrades("1337", url.Values{})
+	_, err := i.GetWalletTrades(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("GetWalletTrades() Expected error")
 	}
 }
 
 func TestGetFundingHistory(t *testing.T) {
-	_, err := i.GetFundingHistoryForWallet("1337", url.Values{})
+	_, err := i.GetFundingHistoryForWallet(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("GetFundingHistory() Expected error")
 	}
 }
 
 func TestPlaceOrder(t *testing.T) {
-	_, err := i.PlaceOrder("1337", order.Buy.Lower(),
-		order.Limit.Lower(), "USD", 1, 0.2, "banjo",
+	_, err := i.PlaceOrder(context.Background(), "1337", order.Buy.Lower(),
+		order.Limit.Lower(), "USD", decimal.NewFromInt(1), 0.2, "banjo",
 		"sauce")
 	if err == nil {
 		t.Error("PlaceOrder() Expected error")
@@ -125,7 +127,7 @@ func TestPlaceOrder(t *testing.T) {
 }
 
 func TestGetOrder(t *testing.T) {
-	_, err := i.GetOrder("1337", url.Values{})
+	_, err := i.GetOrder(context.Background(), "1337", url.Values{})
 	if err == nil {
 		t.Error("GetOrder() Expected error")
 	}
@@ -133,21 +135,21 @@ func TestGetOrder(t *testing.T) {
 
 func TestCancelExistingOrder(t *testing.T) {
 	t.Skip()
-	err := i.CancelExistingOrder("1337", "1337order")
+	err := i.CancelExistingOrder(context.Background(), "1337", "1337order")
 	if err == nil {
 		t.Error("CancelOrder() Expected error")
 	}
 }
 
 func TestGetCryptoDepositAddress(t *testing.T) {
-	_, err := i.GetCryptoDepositAddress("1337", "AUD")
+	_, err := i.GetCryptoDepositAddress(context.Background(), "1337", "AUD")
 	if err == nil {
 		t.Error("GetCryptoDepositAddress() Expected error")
 	}
 }
 
 func TestWalletTransfer(t *testing.T) {
-	_, err := i.WalletTransfer("1337", "mywallet", "anotherwallet", 200, "USD")
+	_, err := i.WalletTransfer(context.Background(), "1337", "mywallet", "anotherwallet", 200, "USD")
 	if err == nil {
 		t.Error("WalletTransfer() Expected error")
 	}
@@ -169,7 +171,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	i.GetFeeByType(feeBuilder)
+	i.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -270,7 +272,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := i.GetActiveOrders(&getOrdersRequest)
+	_, err := i.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -283,7 +285,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := i.GetOrderHistory(&getOrdersRequest)
+	_, err := i.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -309,11 +311,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := i.SubmitOrder(orderSubmission)
+	response, err := i.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -334,7 +336,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := i.CancelOrder(orderCancellation)
+	err := i.CancelOrder(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -357,7 +359,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := i.CancelAllOrders(orderCancellation)
+	resp, err := i.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -373,7 +375,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := i.UpdateAccountInfo()
+		_, err := i.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
@@ -384,7 +386,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := i.ModifyOrder(&order.Modify{})
+	_, err := i.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -404,7 +406,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := i.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := i.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected 'Not supported', received %v", err)
 	}
@@ -416,7 +418,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := i.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := i.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -428,14 +430,14 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := i.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := i.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
 }
 
 func TestGetDepositAddress(t *testing.T) {
-	_, err := i.GetDepositAddress(currency.BTC, "")
+	_, err := i.GetDepositAddress(context.Background(), currency.BTC, "")
 	if err == nil {
 		t.Error("GetDepositAddress() error cannot be nil")
 	}
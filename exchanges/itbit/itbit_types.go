@@ -1,6 +1,10 @@
 package itbit
 
-import "time"
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+)
 
 // GeneralReturn is a generalized return type to capture any errors
 type GeneralReturn struct {
@@ -118,21 +122,21 @@ type FundHistory struct {
 
 // Order holds order information
 type Order struct {
-	ID                         string      `json:"id"`
-	WalletID                   string      `json:"walletId"`
-	Side                       string      `json:"side"`
-	Instrument                 string      `json:"instrument"`
-	Type                       string      `json:"type"`
-	Currency                   string      `json:"currency"`
-	Amount                     float64     `json:"amount,string"`
-	Price                      float64     `json:"price,string"`
-	AmountFilled               float64     `json:"amountFilled,string"`
-	VolumeWeightedAveragePrice float64     `json:"volumeWeightedAveragePrice,string"`
-	CreatedTime                string      `json:"createdTime"`
-	Status                     string      `json:"Status"`
-	Metadata                   interface{} `json:"metadata"`
-	ClientOrderIdentifier      string      `json:"clientOrderIdentifier"`
-	Description                string      `json:"description"`
+	ID                         string          `json:"id"`
+	WalletID                   string          `json:"walletId"`
+	Side                       string          `json:"side"`
+	Instrument                 string          `json:"instrument"`
+	Type                       string          `json:"type"`
+	Currency                   string          `json:"currency"`
+	Amount                     decimal.Decimal `json:"amount"`
+	Price                      float64         `json:"price,string"`
+	AmountFilled               decimal.Decimal `json:"amountFilled"`
+	VolumeWeightedAveragePrice float64         `json:"volumeWeightedAveragePrice,string"`
+	CreatedTime                string          `json:"createdTime"`
+	Status                     string          `json:"Status"`
+	Metadata                   interface{}     `json:"metadata"`
+	ClientOrderIdentifier      string          `json:"clientOrderIdentifier"`
+	Description                string          `json:"description"`
 }
 
 // CryptoCurrencyDeposit holds information about a new wallet
@@ -1,6 +1,7 @@
 package itbit
 
 import (
+	"context"
 	"fmt"
 	"net/url"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +40,7 @@ func (i *ItBit) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if i.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = i.UpdateTradablePairs(true)
+		err = i.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -131,20 +133,20 @@ func (i *ItBit) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (i *ItBit) FetchTradablePairs(asset asset.Item) ([]string, error) {
+func (i *ItBit) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (i *ItBit) UpdateTradablePairs(forceUpdate bool) error {
+func (i *ItBit) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	return common.ErrFunctionNotSupported
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (i *ItBit) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (i *ItBit) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tick, err := i.GetTicker(i.FormatExchangeCurrency(p, assetType).String())
+	tick, err := i.GetTicker(ctx, i.FormatExchangeCurrency(p, assetType).String())
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -168,27 +170,27 @@ func (i *ItBit) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pri
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (i *ItBit) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (i *ItBit) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(i.Name, p, assetType)
 	if err != nil {
-		return i.UpdateTicker(p, assetType)
+		return i.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (i *ItBit) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (i *ItBit) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(i.Name, p, assetType)
 	if err != nil {
-		return i.UpdateOrderbook(p, assetType)
+		return i.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (i *ItBit) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (i *ItBit) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := i.GetOrderbook(i.FormatExchangeCurrency(p, assetType).String())
+	orderbookNew, err := i.GetOrderbook(ctx, i.FormatExchangeCurrency(p, assetType).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -240,11 +242,11 @@ func (i *ItBit) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbo
 }
 
 // UpdateAccountInfo retrieves balances for all enabled currencies
-func (i *ItBit) UpdateAccountInfo() (account.Holdings, error) {
+func (i *ItBit) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 	info.Exchange = i.Name
 
-	wallets, err := i.GetWallets(url.Values{})
+	wallets, err := i.GetWallets(ctx, url.Values{})
 	if err != nil {
 		return info, err
 	}
@@ -271,8 +273,8 @@ func (i *ItBit) UpdateAccountInfo() (account.Holdings, error) {
 	for key := range amounts {
 		fullBalance = append(fullBalance, account.Balance{
 			CurrencyName: currency.NewCode(key),
-			TotalValue:   amounts[key].TotalValue,
-			Hold:         amounts[key].Hold,
+			TotalValue:   decimal.NewFromFloat(amounts[key].TotalValue),
+			Hold:         decimal.NewFromFloat(amounts[key].Hold),
 		})
 	}
 
@@ -289,10 +291,10 @@ func (i *ItBit) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (i *ItBit) FetchAccountInfo() (account.Holdings, error) {
+func (i *ItBit) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(i.Name)
 	if err != nil {
-		return i.UpdateAccountInfo()
+		return i.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -300,24 +302,47 @@ func (i *ItBit) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (i *ItBit) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (i *ItBit) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (i *ItBit) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (i *ItBit) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (i *ItBit) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (i *ItBit) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (i *ItBit) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (i *ItBit) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (i *ItBit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (i *ItBit) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
 	}
 
 	var wallet string
-	wallets, err := i.GetWallets(url.Values{})
+	wallets, err := i.GetWallets(ctx, url.Values{})
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -326,7 +351,7 @@ func (i *ItBit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 	for i := range wallets {
 		for j := range wallets[i].Balances {
 			if wallets[i].Balances[j].Currency == s.Pair.Base.String() &&
-				wallets[i].Balances[j].AvailableBalance >= s.Amount {
+				s.Amount.Cmp(decimal.NewFromFloat(wallets[i].Balances[j].AvailableBalance)) <= 0 {
 				wallet = wallets[i].ID
 			}
 		}
@@ -339,12 +364,12 @@ func (i *ItBit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 				s.Amount)
 	}
 
-	response, err := i.PlaceOrder(wallet,
+	response, err := i.PlaceOrder(ctx, wallet,
 		s.OrderSide.String(),
 		s.OrderType.String(),
 		s.Pair.Base.String(),
 		s.Amount,
-		s.Price,
+		s.Price.Float64(),
 		s.Pair.String(),
 		"")
 	if err != nil {
@@ -354,7 +379,7 @@ func (i *ItBit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		submitOrderResponse.OrderID = response.ID
 	}
 
-	if response.AmountFilled == s.Amount {
+	if s.Amount.Equal(response.AmountFilled) {
 		submitOrderResponse.FullyMatched = true
 	}
 	submitOrderResponse.IsOrderPlaced = true
@@ -363,27 +388,27 @@ func (i *ItBit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (i *ItBit) ModifyOrder(action *order.Modify) (string, error) {
+func (i *ItBit) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (i *ItBit) CancelOrder(order *order.Cancel) error {
-	return i.CancelExistingOrder(order.WalletAddress, order.OrderID)
+func (i *ItBit) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	return i.CancelExistingOrder(ctx, order.WalletAddress, order.OrderID)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (i *ItBit) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
+func (i *ItBit) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	openOrders, err := i.GetOrders(orderCancellation.WalletAddress, "", "open", 0, 0)
+	openOrders, err := i.GetOrders(ctx, orderCancellation.WalletAddress, "", "open", 0, 0)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
 
 	for j := range openOrders {
-		err = i.CancelExistingOrder(orderCancellation.WalletAddress, openOrders[j].ID)
+		err = i.CancelExistingOrder(ctx, orderCancellation.WalletAddress, openOrders[j].ID)
 		if err != nil {
 			cancelAllOrdersResponse.Status[openOrders[j].ID] = err.Error()
 		}
@@ -393,7 +418,7 @@ func (i *ItBit) CancelAllOrders(orderCancellation *order.Cancel) (order.CancelAl
 }
 
 // GetOrderInfo returns information on a current open order
-func (i *ItBit) GetOrderInfo(orderID string) (order.Detail, error) {
+func (i *ItBit) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
@@ -402,25 +427,25 @@ func (i *ItBit) GetOrderInfo(orderID string) (order.Detail, error) {
 // NOTE: This has not been implemented due to the fact you need to generate a
 // a specific wallet ID and they restrict the amount of deposit address you can
 // request limiting them to 2.
-func (i *ItBit) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (i *ItBit) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrNotYetImplemented
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (i *ItBit) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (i *ItBit) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (i *ItBit) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (i *ItBit) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (i *ItBit) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (i *ItBit) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -430,7 +455,7 @@ func (i *ItBit) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (i *ItBit) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (i *ItBit) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !i.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -439,15 +464,15 @@ func (i *ItBit) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (i *ItBit) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	wallets, err := i.GetWallets(url.Values{})
+func (i *ItBit) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	wallets, err := i.GetWallets(ctx, url.Values{})
 	if err != nil {
 		return nil, err
 	}
 
 	var allOrders []Order
 	for x := range wallets {
-		resp, err := i.GetOrders(wallets[x].ID, "", "open", 0, 0)
+		resp, err := i.GetOrders(ctx, wallets[x].ID, "", "open", 0, 0)
 		if err != nil {
 			return nil, err
 		}
@@ -474,7 +499,7 @@ func (i *ItBit) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 			OrderSide:       side,
 			Amount:          allOrders[j].Amount,
 			ExecutedAmount:  allOrders[j].AmountFilled,
-			RemainingAmount: (allOrders[j].Amount - allOrders[j].AmountFilled),
+			RemainingAmount: allOrders[j].Amount.Sub(allOrders[j].AmountFilled),
 			Exchange:        i.Name,
 			OrderDate:       orderDate,
 			CurrencyPair:    symbol,
@@ -489,15 +514,15 @@ func (i *ItBit) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (i *ItBit) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	wallets, err := i.GetWallets(url.Values{})
+func (i *ItBit) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	wallets, err := i.GetWallets(ctx, url.Values{})
 	if err != nil {
 		return nil, err
 	}
 
 	var allOrders []Order
 	for x := range wallets {
-		resp, err := i.GetOrders(wallets[x].ID, "", "", 0, 0)
+		resp, err := i.GetOrders(ctx, wallets[x].ID, "", "", 0, 0)
 		if err != nil {
 			return nil, err
 		}
@@ -528,7 +553,7 @@ func (i *ItBit) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, er
 			OrderSide:       side,
 			Amount:          allOrders[j].Amount,
 			ExecutedAmount:  allOrders[j].AmountFilled,
-			RemainingAmount: (allOrders[j].Amount - allOrders[j].AmountFilled),
+			RemainingAmount: allOrders[j].Amount.Sub(allOrders[j].AmountFilled),
 			Exchange:        i.Name,
 			OrderDate:       orderDate,
 			CurrencyPair:    symbol,
@@ -565,7 +590,12 @@ func (i *ItBit) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (i *ItBit) ValidateCredentials() error {
-	_, err := i.UpdateAccountInfo()
+func (i *ItBit) ValidateCredentials(ctx context.Context) error {
+	_, err := i.UpdateAccountInfo(ctx)
 	return i.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (i *ItBit) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -0,0 +1,195 @@
+package kline
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func init() {
+	service = new(Service)
+	service.Klines = make(map[key]*Kline)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// ParseInterval normalises an exchange interval such as "1m", "1min", "4hour",
+// "1D" or a plain number of seconds like "60"
+func ParseInterval(interval string) (Interval, error) {
+	interval = strings.TrimSpace(interval)
+	if interval == "" {
+		return 0, errors.New(errIntervalNotSet)
+	}
+
+	if seconds, err := strconv.ParseInt(interval, 10, 64); err == nil {
+		if seconds <= 0 {
+			return 0, fmt.Errorf("invalid kline interval %s", interval)
+		}
+		return Interval(time.Duration(seconds) * time.Second), nil
+	}
+
+	i := strings.IndexFunc(interval, func(r rune) bool {
+		return r < '0' || r > '9'
+	})
+	if i <= 0 {
+		return 0, fmt.Errorf("invalid kline interval %s", interval)
+	}
+	// An upper case M denotes months which cannot be represented as a fixed
+	// duration
+	if interval[i:] == "M" {
+		return 0, fmt.Errorf("unsupported kline interval %s", interval)
+	}
+	unit, ok := intervalUnits[strings.ToLower(interval[i:])]
+	if !ok {
+		return 0, fmt.Errorf("unsupported kline interval %s", interval)
+	}
+	n, err := strconv.ParseInt(interval[:i], 10, 64)
+	if err != nil || n <= 0 {
+		return 0, fmt.Errorf("invalid kline interval %s", interval)
+	}
+	return Interval(time.Duration(n) * unit), nil
+}
+
+// Duration returns the interval as a time.Duration
+func (i Interval) Duration() time.Duration {
+	return time.Duration(i)
+}
+
+// String returns the interval in its shortest normalised form e.g. 15m, 4h, 1d
+func (i Interval) String() string {
+	d := i.Duration()
+	switch {
+	case d <= 0:
+		return ""
+	case d%(7*24*time.Hour) == 0:
+		return strconv.FormatInt(int64(d/(7*24*time.Hour)), 10) + "w"
+	case d%(24*time.Hour) == 0:
+		return strconv.FormatInt(int64(d/(24*time.Hour)), 10) + "d"
+	case d%time.Hour == 0:
+		return strconv.FormatInt(int64(d/time.Hour), 10) + "h"
+	case d%time.Minute == 0:
+		return strconv.FormatInt(int64(d/time.Minute), 10) + "m"
+	default:
+		return strconv.FormatInt(int64(d/time.Second), 10) + "s"
+	}
+}
+
+// SubscribeKline subscribes to a candle stream and returns a communication
+// channel to stream new candle updates
+func SubscribeKline(exchange string, p currency.Pair, a asset.Item, i Interval) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+
+	k, ok := service.Klines[key{exchange, p.Base.Item, p.Quote.Item, a, i}]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("kline item not found for %s %s %s %s",
+			exchange,
+			p,
+			a,
+			i)
+	}
+
+	return service.mux.Subscribe(k.Main)
+}
+
+// SubscribeToExchangeKlines subscribes to all candle streams on an exchange
+func SubscribeToExchangeKlines(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	id, ok := service.Exchange[exchange]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("%s exchange klines not found",
+			exchange)
+	}
+
+	return service.mux.Subscribe(id)
+}
+
+// GetKline returns the latest candle for a stream if it exists
+func GetKline(exchange string, p currency.Pair, a asset.Item, i Interval) (*Candle, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+
+	k, ok := service.Klines[key{exchange, p.Base.Item, p.Quote.Item, a, i}]
+	if !ok {
+		return nil, fmt.Errorf("no %s %s %s %s kline",
+			exchange,
+			p,
+			a,
+			i)
+	}
+	c := k.Candle
+	return &c, nil
+}
+
+// ProcessKline validates an incoming candle and updates its stream
+func ProcessKline(c *Candle) error {
+	if c == nil {
+		return errors.New(errCandleIsNil)
+	}
+
+	if c.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	c.Exchange = strings.ToLower(c.Exchange)
+
+	if c.Pair.IsEmpty() {
+		return fmt.Errorf("%s %s", c.Exchange, errPairNotSet)
+	}
+
+	if c.AssetType == "" {
+		return fmt.Errorf("%s %s %s", c.Exchange, c.Pair, errAssetTypeNotSet)
+	}
+
+	if c.Interval <= 0 {
+		return fmt.Errorf("%s %s %s", c.Exchange, c.Pair, errIntervalNotSet)
+	}
+
+	if c.LastUpdated.IsZero() {
+		c.LastUpdated = time.Now()
+	}
+
+	return service.Update(c)
+}
+
+// Update stores the latest candle for its stream and publishes it
+func (s *Service) Update(c *Candle) error {
+	k := key{c.Exchange, c.Pair.Base.Item, c.Pair.Quote.Item, c.AssetType, c.Interval}
+
+	s.Lock()
+	item, ok := s.Klines[k]
+	if !ok {
+		exchangeID, ok := s.Exchange[c.Exchange]
+		if !ok {
+			var err error
+			exchangeID, err = s.mux.GetID()
+			if err != nil {
+				s.Unlock()
+				return err
+			}
+			s.Exchange[c.Exchange] = exchangeID
+		}
+		id, err := s.mux.GetID()
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		item = &Kline{Main: id, Assoc: []uuid.UUID{exchangeID}}
+		s.Klines[k] = item
+	}
+	item.Candle = *c
+	ids := append([]uuid.UUID{item.Main}, item.Assoc...)
+	s.Unlock()
+	return s.mux.Publish(ids, c)
+}
@@ -0,0 +1,179 @@
+package kline
+
+import (
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestParseInterval(t *testing.T) {
+	tests := []struct {
+		in       string
+		expected Interval
+	}{
+		{"1m", OneMin},
+		{"1min", OneMin},
+		{"60", OneMin},
+		{"60s", OneMin},
+		{"15m", FifteenMin},
+		{"1800", ThirtyMin},
+		{"4hour", FourHour},
+		{"1H", OneHour},
+		{"1D", OneDay},
+		{"1day", OneDay},
+		{"1W", OneWeek},
+	}
+	for x := range tests {
+		i, err := ParseInterval(tests[x].in)
+		if err != nil {
+			t.Fatalf("%s: %v", tests[x].in, err)
+		}
+		if i != tests[x].expected {
+			t.Errorf("%s: expected %s received %s", tests[x].in, tests[x].expected, i)
+		}
+	}
+
+	for _, in := range []string{"", "0", "-60", "m", "1M", "1y", "1.5h"} {
+		if _, err := ParseInterval(in); err == nil {
+			t.Errorf("%q: expected error", in)
+		}
+	}
+}
+
+func TestIntervalString(t *testing.T) {
+	tests := map[Interval]string{
+		OneMin:                     "1m",
+		FifteenMin:                 "15m",
+		FourHour:                   "4h",
+		OneDay:                     "1d",
+		OneWeek:                    "1w",
+		Interval(30 * time.Second): "30s",
+		Interval(90 * time.Minute): "90m",
+		Interval(0):                "",
+	}
+	for i, expected := range tests {
+		if i.String() != expected {
+			t.Errorf("expected %s received %s", expected, i)
+		}
+	}
+}
+
+func TestProcessKline(t *testing.T) {
+	if err := ProcessKline(nil); err == nil {
+		t.Error("expected error on nil candle")
+	}
+	if err := ProcessKline(&Candle{}); err == nil {
+		t.Error("expected error on unset exchange")
+	}
+	if err := ProcessKline(&Candle{Exchange: "test"}); err == nil {
+		t.Error("expected error on unset pair")
+	}
+	p := currency.NewPair(currency.BTC, currency.USD)
+	if err := ProcessKline(&Candle{Exchange: "test", Pair: p}); err == nil {
+		t.Error("expected error on unset asset")
+	}
+	if err := ProcessKline(&Candle{Exchange: "test", Pair: p, AssetType: asset.Spot}); err == nil {
+		t.Error("expected error on unset interval")
+	}
+
+	err := ProcessKline(&Candle{
+		Exchange:  "ProcessTest",
+		Pair:      p,
+		AssetType: asset.Spot,
+		Interval:  OneMin,
+		Close:     1337,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	c, err := GetKline("processtest", p, asset.Spot, OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Close != 1337 || c.LastUpdated.IsZero() {
+		t.Errorf("unexpected candle %+v", c)
+	}
+
+	if _, err = GetKline("processtest", p, asset.Spot, OneHour); err == nil {
+		t.Error("expected error on unknown interval")
+	}
+}
+
+func TestSubscribeKline(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.AUD)
+	_, err := SubscribeKline("subscribetest", p, asset.Spot, OneMin)
+	if err == nil {
+		t.Fatal("expected error on unknown stream")
+	}
+	_, err = SubscribeToExchangeKlines("subscribetest")
+	if err == nil {
+		t.Fatal("expected error on unknown exchange")
+	}
+
+	c := Candle{
+		Exchange:  "subscribetest",
+		Pair:      p,
+		AssetType: asset.Spot,
+		Interval:  OneMin,
+	}
+	err = ProcessKline(&c)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pipe, err := SubscribeKline("subscribetest", p, asset.Spot, OneMin)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pipe.Release()
+
+	exchPipe, err := SubscribeToExchangeKlines("subscribetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer exchPipe.Release()
+
+	// Dispatch only hands data to pipes that are ready to receive so keep
+	// publishing until both pipes have seen the update
+	received := make(chan struct{}, 2)
+	for _, ch := range []chan interface{}{pipe.C, exchPipe.C} {
+		go func(ch chan interface{}) {
+			for data := range ch {
+				if (*data.(*interface{})).(Candle).Close == 10 {
+					received <- struct{}{}
+					return
+				}
+			}
+		}(ch)
+	}
+
+	c.Close = 10
+	timeout := time.After(time.Second)
+	for count := 0; count < 2; {
+		select {
+		case <-received:
+			count++
+		case <-timeout:
+			t.Fatal("timed out waiting for candle")
+		case <-time.After(time.Millisecond):
+			err = ProcessKline(&c)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
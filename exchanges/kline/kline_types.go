@@ -0,0 +1,104 @@
+package kline
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+)
+
+// const values for the kline package
+const (
+	errExchangeNameUnset = "kline exchange name not set"
+	errPairNotSet        = "kline currency pair not set"
+	errAssetTypeNotSet   = "kline asset type not set"
+	errIntervalNotSet    = "kline interval not set"
+	errCandleIsNil       = "kline candle is nil"
+)
+
+// Interval is a normalised candle period shared across exchanges
+type Interval time.Duration
+
+// Supported candle intervals
+const (
+	OneMin     = Interval(time.Minute)
+	ThreeMin   = 3 * OneMin
+	FiveMin    = 5 * OneMin
+	FifteenMin = 15 * OneMin
+	ThirtyMin  = 30 * OneMin
+	OneHour    = Interval(time.Hour)
+	TwoHour    = 2 * OneHour
+	FourHour   = 4 * OneHour
+	SixHour    = 6 * OneHour
+	TwelveHour = 12 * OneHour
+	OneDay     = 24 * OneHour
+	ThreeDay   = 3 * OneDay
+	OneWeek    = 7 * OneDay
+)
+
+// Vars for the kline package
+var (
+	service *Service
+
+	// intervalUnits maps exchange interval suffixes to their duration
+	intervalUnits = map[string]time.Duration{
+		"s":       time.Second,
+		"sec":     time.Second,
+		"m":       time.Minute,
+		"min":     time.Minute,
+		"minute":  time.Minute,
+		"minutes": time.Minute,
+		"h":       time.Hour,
+		"hour":    time.Hour,
+		"hours":   time.Hour,
+		"d":       24 * time.Hour,
+		"day":     24 * time.Hour,
+		"days":    24 * time.Hour,
+		"w":       7 * 24 * time.Hour,
+		"week":    7 * 24 * time.Hour,
+		"weeks":   7 * 24 * time.Hour,
+	}
+)
+
+// Service holds the latest streamed candles for each individual exchange
+type Service struct {
+	Klines   map[key]*Kline
+	Exchange map[string]uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+// key identifies a candle stream
+type key struct {
+	exchange string
+	base     *currency.Item
+	quote    *currency.Item
+	asset    asset.Item
+	interval Interval
+}
+
+// Candle is a single normalised candle streamed from an exchange
+type Candle struct {
+	Exchange    string        `json:"exchange"`
+	Pair        currency.Pair `json:"pair"`
+	AssetType   asset.Item    `json:"assetType"`
+	Interval    Interval      `json:"interval"`
+	StartTime   time.Time     `json:"startTime"`
+	CloseTime   time.Time     `json:"closeTime"`
+	Open        float64       `json:"open"`
+	High        float64       `json:"high"`
+	Low         float64       `json:"low"`
+	Close       float64       `json:"close"`
+	Volume      float64       `json:"volume"`
+	LastUpdated time.Time     `json:"lastUpdated"`
+}
+
+// Kline holds the latest candle of a stream and its dispatch IDs
+type Kline struct {
+	Candle
+	Main  uuid.UUID
+	Assoc []uuid.UUID
+}
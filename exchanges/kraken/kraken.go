@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -12,6 +13,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -55,6 +57,9 @@ const (
 	// Rate limit consts
 	krakenRateInterval = time.Second
 	krakenRequestRate  = 1
+
+	// publicCacheTTL is how long asset and asset pair listings are reused
+	publicCacheTTL = 10 * time.Minute
 )
 
 var assetPairMap map[string]string
@@ -68,12 +73,12 @@ type Kraken struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (k *Kraken) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (k *Kraken) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetServerTime returns current server time
-func (k *Kraken) GetServerTime() (TimeResponse, error) {
+func (k *Kraken) GetServerTime(ctx context.Context) (TimeResponse, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.API.Endpoints.URL, krakenAPIVersion, krakenServerTime)
 
 	var response struct {
@@ -81,7 +86,7 @@ func (k *Kraken) GetServerTime() (TimeResponse, error) {
 		Result TimeResponse `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.SendHTTPRequest(ctx, path, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -89,7 +94,7 @@ func (k *Kraken) GetServerTime() (TimeResponse, error) {
 }
 
 // GetAssets returns a full asset list
-func (k *Kraken) GetAssets() (map[string]Asset, error) {
+func (k *Kraken) GetAssets(ctx context.Context) (map[string]Asset, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.API.Endpoints.URL, krakenAPIVersion, krakenAssets)
 
 	var response struct {
@@ -97,7 +102,7 @@ func (k *Kraken) GetAssets() (map[string]Asset, error) {
 		Result map[string]Asset `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.sendCachedHTTPRequest(ctx, path, publicCacheTTL, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -105,7 +110,7 @@ func (k *Kraken) GetAssets() (map[string]Asset, error) {
 }
 
 // GetAssetPairs returns a full asset pair list
-func (k *Kraken) GetAssetPairs() (map[string]AssetPairs, error) {
+func (k *Kraken) GetAssetPairs(ctx context.Context) (map[string]AssetPairs, error) {
 	path := fmt.Sprintf("%s/%s/public/%s", k.API.Endpoints.URL, krakenAPIVersion, krakenAssetPairs)
 
 	var response struct {
@@ -113,7 +118,7 @@ func (k *Kraken) GetAssetPairs() (map[string]AssetPairs, error) {
 		Result map[string]AssetPairs `json:"result"`
 	}
 
-	if err := k.SendHTTPRequest(path, &response); err != nil {
+	if err := k.sendCachedHTTPRequest(ctx, path, publicCacheTTL, &response); err != nil {
 		return response.Result, err
 	}
 	for i := range response.Result {
@@ -127,7 +132,7 @@ func (k *Kraken) GetAssetPairs() (map[string]AssetPairs, error) {
 }
 
 // GetTicker returns ticker information from kraken
-func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
+func (k *Kraken) GetTicker(ctx context.Context, symbol string) (Ticker, error) {
 	tick := Ticker{}
 	values := url.Values{}
 	values.Set("pair", symbol)
@@ -140,7 +145,7 @@ func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
 	resp := Response{}
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenTicker, values.Encode())
 
-	err := k.SendHTTPRequest(path, &resp)
+	err := k.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return tick, err
 	}
@@ -166,7 +171,7 @@ func (k *Kraken) GetTicker(symbol string) (Ticker, error) {
 // GetTickers supports fetching multiple tickers from Kraken
 // pairList must be in the format pairs separated by commas
 // ("LTCUSD,ETCUSD")
-func (k *Kraken) GetTickers(pairList string) (map[string]Ticker, error) {
+func (k *Kraken) GetTickers(ctx context.Context, pairList string) (map[string]Ticker, error) {
 	values := url.Values{}
 	values.Set("pair", pairList)
 
@@ -178,7 +183,7 @@ func (k *Kraken) GetTickers(pairList string) (map[string]Ticker, error) {
 	resp := Response{}
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenTicker, values.Encode())
 
-	err := k.SendHTTPRequest(path, &resp)
+	err := k.SendHTTPRequest(ctx, path, &resp)
 	if err != nil {
 		return nil, err
 	}
@@ -206,7 +211,7 @@ func (k *Kraken) GetTickers(pairList string) (map[string]Ticker, error) {
 }
 
 // GetOHLC returns an array of open high low close values of a currency pair
-func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
+func (k *Kraken) GetOHLC(ctx context.Context, symbol string) ([]OpenHighLowClose, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -220,7 +225,7 @@ func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenOHLC, values.Encode())
 
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return OHLC, err
 	}
@@ -257,7 +262,7 @@ func (k *Kraken) GetOHLC(symbol string) ([]OpenHighLowClose, error) {
 }
 
 // GetDepth returns the orderbook for a particular currency
-func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
+func (k *Kraken) GetDepth(ctx context.Context, symbol string) (Orderbook, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -266,7 +271,7 @@ func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenDepth, values.Encode())
 
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return orderBook, err
 	}
@@ -319,7 +324,7 @@ func (k *Kraken) GetDepth(symbol string) (Orderbook, error) {
 }
 
 // GetTrades returns current trades on Kraken
-func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
+func (k *Kraken) GetTrades(ctx context.Context, symbol string) ([]RecentTrades, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -328,7 +333,7 @@ func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenTrades, values.Encode())
 
-	err := k.SendHTTPRequest(path, &result)
+	err := k.SendHTTPRequest(ctx, path, &result)
 	if err != nil {
 		return recentTrades, err
 	}
@@ -360,7 +365,7 @@ func (k *Kraken) GetTrades(symbol string) ([]RecentTrades, error) {
 }
 
 // GetSpread returns the full spread on Kraken
-func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
+func (k *Kraken) GetSpread(ctx context.Context, symbol string) ([]Spread, error) {
 	values := url.Values{}
 	values.Set("pair", symbol)
 
@@ -369,7 +374,7 @@ func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
 
 	path := fmt.Sprintf("%s/%s/public/%s?%s", k.API.Endpoints.URL, krakenAPIVersion, krakenSpread, values.Encode())
 
-	err := k.SendHTTPRequest(path, &response)
+	err := k.SendHTTPRequest(ctx, path, &response)
 	if err != nil {
 		return peanutButter, err
 	}
@@ -395,13 +400,13 @@ func (k *Kraken) GetSpread(symbol string) ([]Spread, error) {
 }
 
 // GetBalance returns your balance associated with your keys
-func (k *Kraken) GetBalance() (map[string]float64, error) {
+func (k *Kraken) GetBalance(ctx context.Context) (map[string]float64, error) {
 	var response struct {
 		Error  []string          `json:"error"`
 		Result map[string]string `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenBalance, url.Values{}, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenBalance, url.Values{}, &response); err != nil {
 		return nil, err
 	}
 
@@ -417,7 +422,7 @@ func (k *Kraken) GetBalance() (map[string]float64, error) {
 }
 
 // GetWithdrawInfo gets withdrawal fees
-func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInformation, error) {
+func (k *Kraken) GetWithdrawInfo(ctx context.Context, currency string, amount float64) (WithdrawInformation, error) {
 	var response struct {
 		Error  []string            `json:"error"`
 		Result WithdrawInformation `json:"result"`
@@ -427,7 +432,7 @@ func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInfor
 	params.Set("key  ", "")
 	params.Set("amount ", fmt.Sprintf("%f", amount))
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdrawInfo, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdrawInfo, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -435,7 +440,7 @@ func (k *Kraken) GetWithdrawInfo(currency string, amount float64) (WithdrawInfor
 }
 
 // Withdraw withdraws funds
-func (k *Kraken) Withdraw(asset, key string, amount float64) (string, error) {
+func (k *Kraken) Withdraw(ctx context.Context, asset, key string, amount float64) (string, error) {
 	var response struct {
 		Error       []string `json:"error"`
 		ReferenceID string   `json:"refid"`
@@ -445,7 +450,7 @@ func (k *Kraken) Withdraw(asset, key string, amount float64) (string, error) {
 	params.Set("key", key)
 	params.Set("amount", fmt.Sprintf("%f", amount))
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdraw, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdraw, params, &response); err != nil {
 		return response.ReferenceID, err
 	}
 
@@ -453,7 +458,7 @@ func (k *Kraken) Withdraw(asset, key string, amount float64) (string, error) {
 }
 
 // GetDepositMethods gets withdrawal fees
-func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
+func (k *Kraken) GetDepositMethods(ctx context.Context, currency string) ([]DepositMethods, error) {
 	var response struct {
 		Error  []string         `json:"error"`
 		Result []DepositMethods `json:"result"`
@@ -461,7 +466,7 @@ func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
 	params := url.Values{}
 	params.Set("asset", currency)
 
-	err := k.SendAuthenticatedHTTPRequest(krakenDepositMethods, params, &response)
+	err := k.SendAuthenticatedHTTPRequest(ctx, krakenDepositMethods, params, &response)
 	if err != nil {
 		return response.Result, err
 	}
@@ -470,7 +475,7 @@ func (k *Kraken) GetDepositMethods(currency string) ([]DepositMethods, error) {
 }
 
 // GetTradeBalance returns full information about your trades on Kraken
-func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo, error) {
+func (k *Kraken) GetTradeBalance(ctx context.Context, args ...TradeBalanceOptions) (TradeBalanceInfo, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -488,7 +493,7 @@ func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo,
 		Result TradeBalanceInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeBalance, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeBalance, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -496,7 +501,7 @@ func (k *Kraken) GetTradeBalance(args ...TradeBalanceOptions) (TradeBalanceInfo,
 }
 
 // GetOpenOrders returns all current open orders
-func (k *Kraken) GetOpenOrders(args OrderInfoOptions) (OpenOrders, error) {
+func (k *Kraken) GetOpenOrders(ctx context.Context, args OrderInfoOptions) (OpenOrders, error) {
 	params := url.Values{}
 
 	if args.Trades {
@@ -512,7 +517,7 @@ func (k *Kraken) GetOpenOrders(args OrderInfoOptions) (OpenOrders, error) {
 		Result OpenOrders `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOpenOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOpenOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -520,7 +525,7 @@ func (k *Kraken) GetOpenOrders(args OrderInfoOptions) (OpenOrders, error) {
 }
 
 // GetClosedOrders returns a list of closed orders
-func (k *Kraken) GetClosedOrders(args GetClosedOrdersOptions) (ClosedOrders, error) {
+func (k *Kraken) GetClosedOrders(ctx context.Context, args GetClosedOrdersOptions) (ClosedOrders, error) {
 	params := url.Values{}
 
 	if args.Trades {
@@ -552,7 +557,7 @@ func (k *Kraken) GetClosedOrders(args GetClosedOrdersOptions) (ClosedOrders, err
 		Result ClosedOrders `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenClosedOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenClosedOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -560,7 +565,7 @@ func (k *Kraken) GetClosedOrders(args GetClosedOrdersOptions) (ClosedOrders, err
 }
 
 // QueryOrdersInfo returns order information
-func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...string) (map[string]OrderInfo, error) {
+func (k *Kraken) QueryOrdersInfo(ctx context.Context, args OrderInfoOptions, txid string, txids ...string) (map[string]OrderInfo, error) {
 	params := url.Values{
 		"txid": {txid},
 	}
@@ -582,7 +587,7 @@ func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...st
 		Result map[string]OrderInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryOrders, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryOrders, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -590,7 +595,7 @@ func (k *Kraken) QueryOrdersInfo(args OrderInfoOptions, txid string, txids ...st
 }
 
 // GetTradesHistory returns trade history information
-func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistory, error) {
+func (k *Kraken) GetTradesHistory(ctx context.Context, args ...GetTradesHistoryOptions) (TradesHistory, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -620,7 +625,7 @@ func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistor
 		Result TradesHistory `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeHistory, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeHistory, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -628,7 +633,7 @@ func (k *Kraken) GetTradesHistory(args ...GetTradesHistoryOptions) (TradesHistor
 }
 
 // QueryTrades returns information on a specific trade
-func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[string]TradeInfo, error) {
+func (k *Kraken) QueryTrades(ctx context.Context, trades bool, txid string, txids ...string) (map[string]TradeInfo, error) {
 	params := url.Values{
 		"txid": {txid},
 	}
@@ -646,7 +651,7 @@ func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[str
 		Result map[string]TradeInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryTrades, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryTrades, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -654,7 +659,7 @@ func (k *Kraken) QueryTrades(trades bool, txid string, txids ...string) (map[str
 }
 
 // OpenPositions returns current open positions
-func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Position, error) {
+func (k *Kraken) OpenPositions(ctx context.Context, docalcs bool, txids ...string) (map[string]Position, error) {
 	params := url.Values{}
 
 	if txids != nil {
@@ -670,7 +675,7 @@ func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Positi
 		Result map[string]Position `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOpenPositions, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOpenPositions, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -678,7 +683,7 @@ func (k *Kraken) OpenPositions(docalcs bool, txids ...string) (map[string]Positi
 }
 
 // GetLedgers returns current ledgers
-func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
+func (k *Kraken) GetLedgers(ctx context.Context, args ...GetLedgersOptions) (Ledgers, error) {
 	params := url.Values{}
 
 	if args != nil {
@@ -712,7 +717,7 @@ func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
 		Result Ledgers  `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenLedgers, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenLedgers, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -720,7 +725,7 @@ func (k *Kraken) GetLedgers(args ...GetLedgersOptions) (Ledgers, error) {
 }
 
 // QueryLedgers queries an individual ledger by ID
-func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo, error) {
+func (k *Kraken) QueryLedgers(ctx context.Context, id string, ids ...string) (map[string]LedgerInfo, error) {
 	params := url.Values{
 		"id": {id},
 	}
@@ -734,7 +739,7 @@ func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo,
 		Result map[string]LedgerInfo `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenQueryLedgers, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenQueryLedgers, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -742,7 +747,7 @@ func (k *Kraken) QueryLedgers(id string, ids ...string) (map[string]LedgerInfo,
 }
 
 // GetTradeVolume returns your trade volume by currency
-func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResponse, error) {
+func (k *Kraken) GetTradeVolume(ctx context.Context, feeinfo bool, symbol ...string) (TradeVolumeResponse, error) {
 	params := url.Values{}
 
 	if symbol != nil {
@@ -758,7 +763,7 @@ func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResp
 		Result TradeVolumeResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenTradeVolume, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenTradeVolume, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -766,12 +771,12 @@ func (k *Kraken) GetTradeVolume(feeinfo bool, symbol ...string) (TradeVolumeResp
 }
 
 // AddOrder adds a new order for Kraken exchange
-func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2, leverage float64, args *AddOrderOptions) (AddOrderResponse, error) {
+func (k *Kraken) AddOrder(ctx context.Context, symbol, side, orderType string, volume decimal.Decimal, price, price2, leverage float64, args *AddOrderOptions) (AddOrderResponse, error) {
 	params := url.Values{
 		"pair":      {symbol},
 		"type":      {strings.ToLower(side)},
 		"ordertype": {strings.ToLower(orderType)},
-		"volume":    {strconv.FormatFloat(volume, 'f', -1, 64)},
+		"volume":    {volume.String()},
 	}
 
 	if orderType == order.Limit.Lower() || price > 0 {
@@ -819,7 +824,7 @@ func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2,
 		Result AddOrderResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOrderPlace, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOrderPlace, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -827,7 +832,7 @@ func (k *Kraken) AddOrder(symbol, side, orderType string, volume, price, price2,
 }
 
 // CancelExistingOrder cancels order by orderID
-func (k *Kraken) CancelExistingOrder(txid string) (CancelOrderResponse, error) {
+func (k *Kraken) CancelExistingOrder(ctx context.Context, txid string) (CancelOrderResponse, error) {
 	values := url.Values{
 		"txid": {txid},
 	}
@@ -837,7 +842,7 @@ func (k *Kraken) CancelExistingOrder(txid string) (CancelOrderResponse, error) {
 		Result CancelOrderResponse `json:"result"`
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenOrderCancel, values, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenOrderCancel, values, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -864,19 +869,26 @@ func GetError(apiErrors []string) error {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP requests
-func (k *Kraken) SendHTTPRequest(path string, result interface{}) error {
-	return k.SendPayload(&request.Item{
+func (k *Kraken) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return k.sendCachedHTTPRequest(ctx, path, 0, result)
+}
+
+// sendCachedHTTPRequest sends an unauthenticated HTTP request, reusing the
+// response for ttl
+func (k *Kraken) sendCachedHTTPRequest(ctx context.Context, path string, ttl time.Duration, result interface{}) error {
+	return k.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
 		Verbose:       k.Verbose,
 		HTTPDebugging: k.HTTPDebugging,
 		HTTPRecording: k.HTTPRecording,
+		CacheTTL:      ttl,
 	})
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request
-func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values, result interface{}) (err error) {
+func (k *Kraken) SendAuthenticatedHTTPRequest(ctx context.Context, method string, params url.Values, result interface{}) (err error) {
 	if !k.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet,
 			k.Name)
@@ -901,7 +913,7 @@ func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values,
 	headers["API-Key"] = k.API.Credentials.Key
 	headers["API-Sign"] = signature
 
-	return k.SendPayload(&request.Item{
+	return k.SendPayload(ctx, &request.Item{
 		Method:        http.MethodPost,
 		Path:          k.API.Endpoints.URL + path,
 		Headers:       headers,
@@ -916,7 +928,7 @@ func (k *Kraken) SendAuthenticatedHTTPRequest(method string, params url.Values,
 }
 
 // GetFee returns an estimate of fee based on type of transaction
-func (k *Kraken) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (k *Kraken) GetFee(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var fee float64
 	c := feeBuilder.Pair.Base.String() +
 		feeBuilder.Pair.Delimiter +
@@ -924,7 +936,7 @@ func (k *Kraken) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 
 	switch feeBuilder.FeeType {
 	case exchange.CryptocurrencyTradeFee:
-		feePair, err := k.GetTradeVolume(true, c)
+		feePair, err := k.GetTradeVolume(ctx, true, c)
 		if err != nil {
 			return 0, err
 		}
@@ -942,7 +954,7 @@ func (k *Kraken) GetFee(feeBuilder *exchange.FeeBuilder) (float64, error) {
 	case exchange.CryptocurrencyWithdrawalFee:
 		fee = getWithdrawalFee(feeBuilder.Pair.Base)
 	case exchange.InternationalBankDepositFee:
-		depositMethods, err := k.GetDepositMethods(feeBuilder.FiatCurrency.String())
+		depositMethods, err := k.GetDepositMethods(ctx, feeBuilder.FiatCurrency.String())
 		if err != nil {
 			return 0, err
 		}
@@ -988,7 +1000,7 @@ func calculateTradingFee(currency string, feePair map[string]TradeVolumeFee, pur
 }
 
 // GetCryptoDepositAddress returns a deposit address for a cryptocurrency
-func (k *Kraken) GetCryptoDepositAddress(method, code string) (string, error) {
+func (k *Kraken) GetCryptoDepositAddress(ctx context.Context, method, code string) (string, error) {
 	var resp = struct {
 		Error  []string         `json:"error"`
 		Result []DepositAddress `json:"result"`
@@ -998,7 +1010,7 @@ func (k *Kraken) GetCryptoDepositAddress(method, code string) (string, error) {
 	values.Set("asset", code)
 	values.Set("method", method)
 
-	err := k.SendAuthenticatedHTTPRequest(krakenDepositAddresses, values, &resp)
+	err := k.SendAuthenticatedHTTPRequest(ctx, krakenDepositAddresses, values, &resp)
 	if err != nil {
 		return "", err
 	}
@@ -1011,7 +1023,7 @@ func (k *Kraken) GetCryptoDepositAddress(method, code string) (string, error) {
 }
 
 // WithdrawStatus gets the status of recent withdrawals
-func (k *Kraken) WithdrawStatus(c currency.Code, method string) ([]WithdrawStatusResponse, error) {
+func (k *Kraken) WithdrawStatus(ctx context.Context, c currency.Code, method string) ([]WithdrawStatusResponse, error) {
 	var response struct {
 		Error  []string                 `json:"error"`
 		Result []WithdrawStatusResponse `json:"result"`
@@ -1023,7 +1035,7 @@ func (k *Kraken) WithdrawStatus(c currency.Code, method string) ([]WithdrawStatu
 		params.Set("method", method)
 	}
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdrawStatus, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdrawStatus, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -1031,7 +1043,7 @@ func (k *Kraken) WithdrawStatus(c currency.Code, method string) ([]WithdrawStatu
 }
 
 // WithdrawCancel sends a withdrawal cancelation request
-func (k *Kraken) WithdrawCancel(c currency.Code, refID string) (bool, error) {
+func (k *Kraken) WithdrawCancel(ctx context.Context, c currency.Code, refID string) (bool, error) {
 	var response struct {
 		Error  []string `json:"error"`
 		Result bool     `json:"result"`
@@ -1041,7 +1053,7 @@ func (k *Kraken) WithdrawCancel(c currency.Code, refID string) (bool, error) {
 	params.Set("asset ", c.String())
 	params.Set("refid", refID)
 
-	if err := k.SendAuthenticatedHTTPRequest(krakenWithdrawCancel, params, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWithdrawCancel, params, &response); err != nil {
 		return response.Result, err
 	}
 
@@ -1049,9 +1061,9 @@ func (k *Kraken) WithdrawCancel(c currency.Code, refID string) (bool, error) {
 }
 
 // GetWebsocketToken returns a websocket token
-func (k *Kraken) GetWebsocketToken() (string, error) {
+func (k *Kraken) GetWebsocketToken(ctx context.Context) (string, error) {
 	var response WsTokenResponse
-	if err := k.SendAuthenticatedHTTPRequest(krakenWebsocketToken, url.Values{}, &response); err != nil {
+	if err := k.SendAuthenticatedHTTPRequest(ctx, krakenWebsocketToken, url.Values{}, &response); err != nil {
 		return "", err
 	}
 	if len(response.Error) > 0 {
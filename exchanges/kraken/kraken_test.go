@@ -1,13 +1,17 @@
 package kraken
 
 import (
+	"context"
+	"encoding/json"
 	"log"
 	"net/http"
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -57,7 +61,7 @@ func TestMain(m *testing.M) {
 // TestGetServerTime API endpoint test
 func TestGetServerTime(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetServerTime()
+	_, err := k.GetServerTime(context.Background())
 	if err != nil {
 		t.Error("GetServerTime() error", err)
 	}
@@ -66,7 +70,7 @@ func TestGetServerTime(t *testing.T) {
 // TestGetAssets API endpoint test
 func TestGetAssets(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetAssets()
+	_, err := k.GetAssets(context.Background())
 	if err != nil {
 		t.Error("GetAssets() error", err)
 	}
@@ -75,7 +79,7 @@ func TestGetAssets(t *testing.T) {
 // TestGetAssetPairs API endpoint test
 func TestGetAssetPairs(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetAssetPairs()
+	_, err := k.GetAssetPairs(context.Background())
 	if err != nil {
 		t.Error("GetAssetPairs() error", err)
 	}
@@ -84,7 +88,7 @@ func TestGetAssetPairs(t *testing.T) {
 // TestGetTicker API endpoint test
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTicker("BCHEUR")
+	_, err := k.GetTicker(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -93,7 +97,7 @@ func TestGetTicker(t *testing.T) {
 // TestGetTickers API endpoint test
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTickers("LTCUSD,ETCUSD")
+	_, err := k.GetTickers(context.Background(), "LTCUSD,ETCUSD")
 	if err != nil {
 		t.Error("GetTickers() error", err)
 	}
@@ -102,7 +106,7 @@ func TestGetTickers(t *testing.T) {
 // TestGetOHLC API endpoint test
 func TestGetOHLC(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetOHLC("BCHEUR")
+	_, err := k.GetOHLC(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetOHLC() error", err)
 	}
@@ -111,7 +115,7 @@ func TestGetOHLC(t *testing.T) {
 // TestGetDepth API endpoint test
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetDepth("BCHEUR")
+	_, err := k.GetDepth(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetDepth() error", err)
 	}
@@ -120,7 +124,7 @@ func TestGetDepth(t *testing.T) {
 // TestGetTrades API endpoint test
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTrades("BCHEUR")
+	_, err := k.GetTrades(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
@@ -129,7 +133,7 @@ func TestGetTrades(t *testing.T) {
 // TestGetSpread API endpoint test
 func TestGetSpread(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetSpread("BCHEUR")
+	_, err := k.GetSpread(context.Background(), "BCHEUR")
 	if err != nil {
 		t.Error("GetSpread() error", err)
 	}
@@ -138,7 +142,7 @@ func TestGetSpread(t *testing.T) {
 // TestGetBalance API endpoint test
 func TestGetBalance(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetBalance()
+	_, err := k.GetBalance(context.Background())
 	if err == nil {
 		t.Error("GetBalance() Expected error")
 	}
@@ -148,7 +152,7 @@ func TestGetBalance(t *testing.T) {
 func TestGetTradeBalance(t *testing.T) {
 	t.Parallel()
 	args := TradeBalanceOptions{Asset: "ZEUR"}
-	_, err := k.GetTradeBalance(args)
+	_, err := k.GetTradeBalance(context.Background(), args)
 	if err == nil {
 		t.Error("GetTradeBalance() Expected error")
 	}
@@ -158,7 +162,7 @@ func TestGetTradeBalance(t *testing.T) {
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
 	args := OrderInfoOptions{Trades: true}
-	_, err := k.GetOpenOrders(args)
+	_, err := k.GetOpenOrders(context.Background(), args)
 	if err == nil {
 		t.Error("GetOpenOrders() Expected error")
 	}
@@ -168,7 +172,7 @@ func TestGetOpenOrders(t *testing.T) {
 func TestGetClosedOrders(t *testing.T) {
 	t.Parallel()
 	args := GetClosedOrdersOptions{Trades: true, Start: "OE4KV4-4FVQ5-V7XGPU"}
-	_, err := k.GetClosedOrders(args)
+	_, err := k.GetClosedOrders(context.Background(), args)
 	if err == nil {
 		t.Error("GetClosedOrders() Expected error")
 	}
@@ -178,7 +182,7 @@ func TestGetClosedOrders(t *testing.T) {
 func TestQueryOrdersInfo(t *testing.T) {
 	t.Parallel()
 	args := OrderInfoOptions{Trades: true}
-	_, err := k.QueryOrdersInfo(args, "OR6ZFV-AA6TT-CKFFIW", "OAMUAJ-HLVKG-D3QJ5F")
+	_, err := k.QueryOrdersInfo(context.Background(), args, "OR6ZFV-AA6TT-CKFFIW", "OAMUAJ-HLVKG-D3QJ5F")
 	if err == nil {
 		t.Error("QueryOrdersInfo() Expected error")
 	}
@@ -188,7 +192,7 @@ func TestQueryOrdersInfo(t *testing.T) {
 func TestGetTradesHistory(t *testing.T) {
 	t.Parallel()
 	args := GetTradesHistoryOptions{Trades: true, Start: "TMZEDR-VBJN2-NGY6DX", End: "TVRXG2-R62VE-RWP3UW"}
-	_, err := k.GetTradesHistory(args)
+	_, err := k.GetTradesHistory(context.Background(), args)
 	if err == nil {
 		t.Error("GetTradesHistory() Expected error")
 	}
@@ -197,7 +201,7 @@ func TestGetTradesHistory(t *testing.T) {
 // TestQueryTrades API endpoint test
 func TestQueryTrades(t *testing.T) {
 	t.Parallel()
-	_, err := k.QueryTrades(true, "TMZEDR-VBJN2-NGY6DX", "TFLWIB-KTT7L-4TWR3L", "TDVRAH-2H6OS-SLSXRX")
+	_, err := k.QueryTrades(context.Background(), true, "TMZEDR-VBJN2-NGY6DX", "TFLWIB-KTT7L-4TWR3L", "TDVRAH-2H6OS-SLSXRX")
 	if err == nil {
 		t.Error("QueryTrades() Expected error")
 	}
@@ -206,7 +210,7 @@ func TestQueryTrades(t *testing.T) {
 // TestOpenPositions API endpoint test
 func TestOpenPositions(t *testing.T) {
 	t.Parallel()
-	_, err := k.OpenPositions(false)
+	_, err := k.OpenPositions(context.Background(), false)
 	if err == nil {
 		t.Error("OpenPositions() Expected error")
 	}
@@ -216,7 +220,7 @@ func TestOpenPositions(t *testing.T) {
 func TestGetLedgers(t *testing.T) {
 	t.Parallel()
 	args := GetLedgersOptions{Start: "LRUHXI-IWECY-K4JYGO", End: "L5NIY7-JZQJD-3J4M2V", Ofs: 15}
-	_, err := k.GetLedgers(args)
+	_, err := k.GetLedgers(context.Background(), args)
 	if err == nil {
 		t.Error("GetLedgers() Expected error")
 	}
@@ -225,7 +229,7 @@ func TestGetLedgers(t *testing.T) {
 // TestQueryLedgers API endpoint test
 func TestQueryLedgers(t *testing.T) {
 	t.Parallel()
-	_, err := k.QueryLedgers("LVTSFS-NHZVM-EXNZ5M")
+	_, err := k.QueryLedgers(context.Background(), "LVTSFS-NHZVM-EXNZ5M")
 	if err == nil {
 		t.Error("QueryLedgers() Expected error")
 	}
@@ -234,7 +238,7 @@ func TestQueryLedgers(t *testing.T) {
 // TestGetTradeVolume API endpoint test
 func TestGetTradeVolume(t *testing.T) {
 	t.Parallel()
-	_, err := k.GetTradeVolume(true, "OAVY7T-MV5VK-KHDF5X")
+	_, err := k.GetTradeVolume(context.Background(), true, "OAVY7T-MV5VK-KHDF5X")
 	if err == nil {
 		t.Error("GetTradeVolume() Expected error")
 	}
@@ -244,18 +248,30 @@ func TestGetTradeVolume(t *testing.T) {
 func TestAddOrder(t *testing.T) {
 	t.Parallel()
 	args := AddOrderOptions{OrderFlags: "fcib"}
-	_, err := k.AddOrder("XXBTZUSD",
+	_, err := k.AddOrder(context.Background(), "XXBTZUSD",
 		order.Sell.Lower(), order.Limit.Lower(),
-		0.00000001, 0, 0, 0, &args)
+		decimal.RequireFromString("0.00000001"), 0, 0, 0, &args)
 	if err == nil {
 		t.Error("AddOrder() Expected error")
 	}
 }
 
+func TestOrderInfoVolume(t *testing.T) {
+	t.Parallel()
+	var o OrderInfo
+	err := json.Unmarshal([]byte(`{"vol":"0.30000000","vol_exec":"0.10000000"}`), &o)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if remaining := o.Volume.Sub(o.VolumeExecuted); !remaining.Equal(decimal.RequireFromString("0.2")) {
+		t.Errorf("expected a remaining volume of 0.2, received %s", remaining)
+	}
+}
+
 // TestCancelExistingOrder API endpoint test
 func TestCancelExistingOrder(t *testing.T) {
 	t.Parallel()
-	_, err := k.CancelExistingOrder("OAVY7T-MV5VK-KHDF5X")
+	_, err := k.CancelExistingOrder(context.Background(), "OAVY7T-MV5VK-KHDF5X")
 	if err == nil {
 		t.Error("CancelExistingOrder() Expected error")
 	}
@@ -277,7 +293,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	k.GetFeeByType(feeBuilder)
+	k.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -294,7 +310,7 @@ func TestGetFee(t *testing.T) {
 
 	if areTestAPIKeysSet() {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0.0026) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0026) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0026), resp)
 		}
@@ -303,7 +319,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := k.GetFee(feeBuilder); resp != float64(2600) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(2600) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(2600), resp)
 			t.Error(err)
 		}
@@ -311,7 +327,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee IsMaker
 		feeBuilder = setFeeBuilder()
 		feeBuilder.IsMaker = true
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0.0016) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0016) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0016), resp)
 			t.Error(err)
 		}
@@ -319,7 +335,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 			t.Error(err)
 		}
@@ -327,7 +343,7 @@ func TestGetFee(t *testing.T) {
 		// InternationalBankDepositFee Basic
 		feeBuilder = setFeeBuilder()
 		feeBuilder.FeeType = exchange.InternationalBankDepositFee
-		if resp, err := k.GetFee(feeBuilder); resp != float64(5) || err != nil {
+		if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(5) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(5), resp)
 			t.Error(err)
 		}
@@ -337,7 +353,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
 	feeBuilder.Pair.Base = currency.XXBT
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(5), resp)
 		t.Error(err)
 	}
@@ -345,7 +361,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -354,7 +370,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Pair.Base = currency.NewCode("hello")
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := k.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -363,7 +379,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := k.GetFee(feeBuilder); resp != float64(5) || err != nil {
+	if resp, err := k.GetFee(context.Background(), feeBuilder); resp != float64(5) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(5), resp)
 		t.Error(err)
 	}
@@ -384,7 +400,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := k.GetActiveOrders(&getOrdersRequest)
+	_, err := k.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -398,7 +414,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := k.GetOrderHistory(&getOrdersRequest)
+	_, err := k.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -412,7 +428,7 @@ func TestGetOrderInfo(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := k.GetOrderInfo("ImACoolOrderID")
+	_, err := k.GetOrderInfo(context.Background(), "ImACoolOrderID")
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting error")
 	}
@@ -440,11 +456,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := k.SubmitOrder(orderSubmission)
+	response, err := k.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -466,7 +482,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := k.CancelOrder(orderCancellation)
+	err := k.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -489,7 +505,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := k.CancelAllOrders(orderCancellation)
+	resp, err := k.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -506,12 +522,12 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 // TestGetAccountInfo wrapper test
 func TestGetAccountInfo(t *testing.T) {
 	if areTestAPIKeysSet() || clientID != "" {
-		_, err := k.UpdateAccountInfo()
+		_, err := k.UpdateAccountInfo(context.Background())
 		if err != nil {
 			t.Error("GetAccountInfo() error", err)
 		}
 	} else {
-		_, err := k.UpdateAccountInfo()
+		_, err := k.UpdateAccountInfo(context.Background())
 		if err == nil {
 			t.Error("GetAccountInfo() Expected error")
 		}
@@ -523,7 +539,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := k.ModifyOrder(&order.Modify{})
+	_, err := k.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -545,7 +561,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := k.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := k.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -569,7 +585,7 @@ func TestWithdrawFiat(t *testing.T) {
 		},
 	}
 
-	_, err := k.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := k.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -593,7 +609,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 		},
 	}
 
-	_, err := k.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := k.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -605,12 +621,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 // TestGetDepositAddress wrapper test
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := k.GetDepositAddress(currency.BTC, "")
+		_, err := k.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := k.GetDepositAddress(currency.BTC, "")
+		_, err := k.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error can not be nil")
 		}
@@ -620,12 +636,12 @@ func TestGetDepositAddress(t *testing.T) {
 // TestWithdrawStatus wrapper test
 func TestWithdrawStatus(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := k.WithdrawStatus(currency.BTC, "")
+		_, err := k.WithdrawStatus(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("WithdrawStatus() error", err)
 		}
 	} else {
-		_, err := k.WithdrawStatus(currency.BTC, "")
+		_, err := k.WithdrawStatus(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error can not be nil")
 		}
@@ -634,7 +650,7 @@ func TestWithdrawStatus(t *testing.T) {
 
 // TestWithdrawCancel wrapper test
 func TestWithdrawCancel(t *testing.T) {
-	_, err := k.WithdrawCancel(currency.BTC, "")
+	_, err := k.WithdrawCancel(context.Background(), currency.BTC, "")
 	if areTestAPIKeysSet() && err == nil {
 		t.Error("WithdrawCancel() error cannot be nil")
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -678,7 +694,7 @@ func setupWsTests(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	token, err := k.GetWebsocketToken()
+	token, err := k.GetWebsocketToken(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -687,7 +703,9 @@ func setupWsTests(t *testing.T) {
 	go k.WsReadData(k.WebsocketConn)
 	go k.WsReadData(k.AuthenticatedWebsocketConn)
 	go k.WsHandleData()
-	go k.wsPingHandler()
+	if err = k.wsPingHandler(k.WebsocketConn); err != nil {
+		t.Error(err)
+	}
 	wsSetupRan = true
 }
 
@@ -708,7 +726,7 @@ func TestGetWSToken(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required, skipping")
 	}
-	resp, err := k.GetWebsocketToken()
+	resp, err := k.GetWebsocketToken(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -720,10 +738,12 @@ func TestGetWSToken(t *testing.T) {
 func TestWsAddOrder(t *testing.T) {
 	setupWsTests(t)
 	_, err := k.wsAddOrder(&WsAddOrderRequest{
-		OrderType: order.Limit.Lower(),
-		OrderSide: order.Buy.Lower(),
-		Pair:      "XBT/USD",
-		Price:     -100,
+		OrderType:  order.Limit.Lower(),
+		Side:       order.Buy.Lower(),
+		Symbol:     "BTC/USD",
+		OrderQty:   decimal.NewFromInt(1),
+		LimitPrice: 1,
+		Validate:   true,
 	})
 	if err != nil {
 		t.Error(err)
@@ -737,3 +757,104 @@ func TestWsCancelOrder(t *testing.T) {
 		t.Error(err)
 	}
 }
+
+func TestWsExecutionReport(t *testing.T) {
+	t.Parallel()
+	report, err := k.wsExecutionReport(&WsExecution{
+		ExecType:     "trade",
+		OrderID:      "OQCLML-BW3P3-BUCMWZ",
+		Symbol:       "BTC/EUR",
+		Side:         "sell",
+		OrderType:    "limit",
+		TradeID:      365573,
+		LastQty:      0.5,
+		LastPrice:    100000,
+		LiquidityInd: "m",
+		Fees:         []WsFee{{Asset: "EUR", Quantity: 80}},
+		Timestamp:    time.Unix(1560516023, 0),
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = report.Validate(); err != nil {
+		t.Error(err)
+	}
+	if report.Side != order.Sell {
+		t.Errorf("expected sell side, received %v", report.Side)
+	}
+	if report.FeeCurrency != currency.EUR {
+		t.Errorf("expected EUR fee currency, received %v", report.FeeCurrency)
+	}
+	if report.Pair.Base != currency.XBT {
+		t.Errorf("expected XBT base, received %v", report.Pair.Base)
+	}
+	if report.TradeID != "365573" {
+		t.Errorf("expected trade ID 365573, received %v", report.TradeID)
+	}
+	if report.Liquidity != order.Maker {
+		t.Errorf("expected maker liquidity, received %v", report.Liquidity)
+	}
+	if _, err = k.wsExecutionReport(&WsExecution{Symbol: "BTCEUR", Side: "sell"}); err == nil {
+		t.Error("expected error on invalid symbol")
+	}
+}
+
+func TestWsSymbol(t *testing.T) {
+	t.Parallel()
+	if s := wsSymbol(currency.NewPairWithDelimiter("XBT", "USD", "-")); s != "BTC/USD" {
+		t.Errorf("expected BTC/USD, received %s", s)
+	}
+	if s := wsSymbol(currency.NewPairWithDelimiter("XDG", "XBT", "-")); s != "DOGE/BTC" {
+		t.Errorf("expected DOGE/BTC, received %s", s)
+	}
+	p, err := wsPair("DOGE/USD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if p.String() != "XDG-USD" {
+		t.Errorf("expected XDG-USD, received %s", p)
+	}
+	if _, err = wsPair("DOGEUSD"); err == nil {
+		t.Error("expected error on invalid symbol")
+	}
+}
+
+func TestWsHandleData(t *testing.T) {
+	t.Parallel()
+	kr := Kraken{Base: exchange.Base{Name: k.Name, Websocket: wshandler.New()}}
+	kr.Websocket.DataHandler = sharedtestvalues.GetWebsocketInterfaceChannelOverride()
+	err := kr.wsHandleData([]byte(`{"channel":"trade","type":"update","data":[{"symbol":"BTC/USD","side":"buy","price":26500.1,"qty":0.02,"ord_type":"market","trade_id":39811,"timestamp":"2023-09-25T07:49:37.708706Z"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	trade, ok := (<-kr.Websocket.DataHandler).(wshandler.TradeData)
+	if !ok {
+		t.Fatal("expected trade data")
+	}
+	if trade.CurrencyPair.String() != "XBT-USD" || trade.Price != 26500.1 || trade.Side != "buy" {
+		t.Errorf("unexpected trade %+v", trade)
+	}
+
+	err = kr.wsHandleData([]byte(`{"channel":"executions","type":"update","data":[{"exec_type":"trade","order_id":"OK4GJX-KSTLS-7DZZO5","symbol":"BTC/USD","side":"buy","order_type":"limit","order_qty":1,"limit_price":26500,"order_status":"partially_filled","exec_id":"TEB5T5-M4GYS-ZSUHXL","trade_id":39812,"last_qty":0.5,"last_price":26500,"liquidity_ind":"t","fees":[{"asset":"USD","qty":34.45}],"timestamp":"2023-09-25T07:49:37.708706Z"}]}`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	report, ok := (<-kr.Websocket.DataHandler).(order.ExecutionReport)
+	if !ok {
+		t.Fatal("expected execution report")
+	}
+	if report.OrderID != "OK4GJX-KSTLS-7DZZO5" || report.Liquidity != order.Taker {
+		t.Errorf("unexpected execution report %+v", report)
+	}
+	if _, ok = (<-kr.Websocket.DataHandler).(WsExecution); !ok {
+		t.Error("expected execution")
+	}
+
+	err = kr.wsHandleData([]byte(`{"error":"Currency pair not supported","method":"subscribe","success":false}`))
+	if err == nil {
+		t.Error("expected error on unsuccessful response")
+	}
+	if err = kr.wsHandleData([]byte(`{"channel":"heartbeat"}`)); err != nil {
+		t.Error(err)
+	}
+}
@@ -1,8 +1,10 @@
 package kraken
 
 import (
+	"encoding/json"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 )
 
@@ -145,16 +147,16 @@ type OrderInfo struct {
 		Order     string  `json:"order"`
 		Close     string  `json:"close"`
 	} `json:"descr"`
-	Volume         float64  `json:"vol,string"`
-	VolumeExecuted float64  `json:"vol_exec,string"`
-	Cost           float64  `json:"cost,string"`
-	Fee            float64  `json:"fee,string"`
-	Price          float64  `json:"price,string"`
-	StopPrice      float64  `json:"stopprice,string"`
-	LimitPrice     float64  `json:"limitprice,string"`
-	Misc           string   `json:"misc"`
-	OrderFlags     string   `json:"oflags"`
-	Trades         []string `json:"trades"`
+	Volume         decimal.Decimal `json:"vol"`
+	VolumeExecuted decimal.Decimal `json:"vol_exec"`
+	Cost           float64         `json:"cost,string"`
+	Fee            float64         `json:"fee,string"`
+	Price          float64         `json:"price,string"`
+	StopPrice      float64         `json:"stopprice,string"`
+	LimitPrice     float64         `json:"limitprice,string"`
+	Misc           string          `json:"misc"`
+	OrderFlags     string          `json:"oflags"`
+	Trades         []string        `json:"trades"`
 }
 
 // OpenOrders type
@@ -391,79 +393,168 @@ type WithdrawStatusResponse struct {
 	Status string  `json:"status"`
 }
 
-// WebsocketSubscriptionEventRequest handles WS subscription events
-type WebsocketSubscriptionEventRequest struct {
-	Event        string                    `json:"event"`           // subscribe
-	RequestID    int64                     `json:"reqid,omitempty"` // Optional, client originated ID reflected in response message.
-	Pairs        []string                  `json:"pair,omitempty"`  // Array of currency pairs (pair1,pair2,pair3).
-	Subscription WebsocketSubscriptionData `json:"subscription,omitempty"`
-}
-
-// WebsocketBaseEventRequest Just has an "event" property
-type WebsocketBaseEventRequest struct {
-	Event string `json:"event"` // eg "unsubscribe"
-}
-
-// WebsocketUnsubscribeByChannelIDEventRequest  handles WS unsubscribe events
-type WebsocketUnsubscribeByChannelIDEventRequest struct {
-	WebsocketBaseEventRequest
-	RequestID int64    `json:"reqid,omitempty"` // Optional, client originated ID reflected in response message.
-	Pairs     []string `json:"pair,omitempty"`  // Array of currency pairs (pair1,pair2,pair3).
-	ChannelID int64    `json:"channelID,omitempty"`
-}
-
-// WebsocketSubscriptionData contains details on WS channel
-type WebsocketSubscriptionData struct {
-	Name     string `json:"name,omitempty"`     // ticker|ohlc|trade|book|spread|*, * for all (ohlc interval value is 1 if all channels subscribed)
-	Interval int64  `json:"interval,omitempty"` // Optional - Time interval associated with ohlc subscription in minutes. Default 1. Valid Interval values: 1|5|15|30|60|240|1440|10080|21600
-	Depth    int64  `json:"depth,omitempty"`    // Optional - depth associated with book subscription in number of levels each side, default 10. Valid Options are: 10, 25, 100, 500, 1000
-	Token    string `json:"token,omitempty"`    // Optional used for authenticated requests
-
-}
-
-// WebsocketEventResponse holds all data response types
-type WebsocketEventResponse struct {
-	WebsocketBaseEventRequest
-	Status       string                            `json:"status"`
-	Pair         currency.Pair                     `json:"pair,omitempty"`
-	RequestID    int64                             `json:"reqid,omitempty"` // Optional, client originated ID reflected in response message.
-	Subscription WebsocketSubscriptionResponseData `json:"subscription,omitempty"`
-	ChannelName  string                            `json:"channelName,omitempty"`
-	WebsocketSubscriptionEventResponse
-	WebsocketStatusResponse
-	WebsocketErrorResponse
-}
-
-// WebsocketSubscriptionEventResponse defines a websocket socket event response
-type WebsocketSubscriptionEventResponse struct {
-	ChannelID int64 `json:"channelID"`
-}
-
-// WebsocketSubscriptionResponseData defines a websocket subscription response
-type WebsocketSubscriptionResponseData struct {
-	Name string `json:"name"`
-}
-
-// WebsocketStatusResponse defines a websocket status response
-type WebsocketStatusResponse struct {
-	ConnectionID float64 `json:"connectionID"`
-	Version      string  `json:"version"`
-}
-
-// WebsocketDataResponse defines a websocket data type
-type WebsocketDataResponse []interface{}
-
-// WebsocketErrorResponse defines a websocket error response
-type WebsocketErrorResponse struct {
-	ErrorMessage string `json:"errorMessage"`
+// WsRequest defines a websocket v2 method request
+type WsRequest struct {
+	Method    string      `json:"method"`
+	Params    interface{} `json:"params,omitempty"`
+	RequestID int64       `json:"req_id,omitempty"`
+}
+
+// WsSubscriptionParams defines the parameters of a subscribe or unsubscribe
+// request
+type WsSubscriptionParams struct {
+	Channel    string   `json:"channel"`
+	Symbol     []string `json:"symbol,omitempty"`
+	Depth      int64    `json:"depth,omitempty"`    // book only, valid options are: 10, 25, 100, 500, 1000
+	Interval   int64    `json:"interval,omitempty"` // ohlc only, in minutes
+	Snapshot   *bool    `json:"snapshot,omitempty"`
+	SnapTrades *bool    `json:"snap_trades,omitempty"` // executions only
+	SnapOrders *bool    `json:"snap_orders,omitempty"` // executions only
+	Token      string   `json:"token,omitempty"`
+}
+
+// WsResponse defines a websocket v2 method response, a request sent with a
+// request ID has it reflected in the response
+type WsResponse struct {
+	Method    string          `json:"method"`
+	RequestID int64           `json:"req_id"`
+	Success   bool            `json:"success"`
+	Error     string          `json:"error"`
+	Result    json.RawMessage `json:"result"`
+}
+
+// WsChannelMessage defines a websocket v2 channel snapshot or update
+type WsChannelMessage struct {
+	Channel string          `json:"channel"`
+	Type    string          `json:"type"`
+	Data    json.RawMessage `json:"data"`
+}
+
+// WsStatus defines the system status sent when connecting
+type WsStatus struct {
+	APIVersion   string `json:"api_version"`
+	ConnectionID int64  `json:"connection_id"`
+	System       string `json:"system"`
+	Version      string `json:"version"`
+}
+
+// WsTicker defines a ticker channel update
+type WsTicker struct {
+	Symbol    string  `json:"symbol"`
+	Bid       float64 `json:"bid"`
+	BidQty    float64 `json:"bid_qty"`
+	Ask       float64 `json:"ask"`
+	AskQty    float64 `json:"ask_qty"`
+	Last      float64 `json:"last"`
+	Volume    float64 `json:"volume"`
+	VWAP      float64 `json:"vwap"`
+	Low       float64 `json:"low"`
+	High      float64 `json:"high"`
+	Change    float64 `json:"change"`
+	ChangePct float64 `json:"change_pct"`
+}
+
+// WsTrade defines a trade channel update
+type WsTrade struct {
+	Symbol    string    `json:"symbol"`
+	Side      string    `json:"side"`
+	Price     float64   `json:"price"`
+	Quantity  float64   `json:"qty"`
+	OrderType string    `json:"ord_type"`
+	TradeID   int64     `json:"trade_id"`
+	Timestamp time.Time `json:"timestamp"`
+}
+
+// WsCandle defines an ohlc channel update
+type WsCandle struct {
+	Symbol        string    `json:"symbol"`
+	Open          float64   `json:"open"`
+	High          float64   `json:"high"`
+	Low           float64   `json:"low"`
+	Close         float64   `json:"close"`
+	Trades        int64     `json:"trades"`
+	Volume        float64   `json:"volume"`
+	VWAP          float64   `json:"vwap"`
+	IntervalBegin time.Time `json:"interval_begin"`
+	Interval      int64     `json:"interval"`
+	Timestamp     time.Time `json:"timestamp"`
+}
+
+// WsBook defines a book channel snapshot or update
+type WsBook struct {
+	Symbol    string        `json:"symbol"`
+	Bids      []WsBookLevel `json:"bids"`
+	Asks      []WsBookLevel `json:"asks"`
+	Checksum  uint32        `json:"checksum"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+// WsBookLevel defines a book price level, a zero quantity removes the level
+type WsBookLevel struct {
+	Price    float64 `json:"price"`
+	Quantity float64 `json:"qty"`
+}
+
+// WsExecution defines an executions channel order update or fill
+type WsExecution struct {
+	ExecType       string    `json:"exec_type"`
+	OrderID        string    `json:"order_id"`
+	ClientOrderID  string    `json:"cl_ord_id"`
+	OrderUserRef   int64     `json:"order_userref"`
+	Symbol         string    `json:"symbol"`
+	Side           string    `json:"side"`
+	OrderType      string    `json:"order_type"`
+	OrderQty       float64   `json:"order_qty"`
+	LimitPrice     float64   `json:"limit_price"`
+	OrderStatus    string    `json:"order_status"`
+	CumulativeQty  float64   `json:"cum_qty"`
+	CumulativeCost float64   `json:"cum_cost"`
+	AvgPrice       float64   `json:"avg_price"`
+	ExecID         string    `json:"exec_id"`
+	TradeID        int64     `json:"trade_id"`
+	LastQty        float64   `json:"last_qty"`
+	LastPrice      float64   `json:"last_price"`
+	Cost           float64   `json:"cost"`
+	LiquidityInd   string    `json:"liquidity_ind"`
+	Fees           []WsFee   `json:"fees"`
+	Reason         string    `json:"reason"`
+	Timestamp      time.Time `json:"timestamp"`
+}
+
+// WsFee defines a fee paid for an execution
+type WsFee struct {
+	Asset    string  `json:"asset"`
+	Quantity float64 `json:"qty"`
+}
+
+// WsAddOrderRequest defines the parameters of a websocket add_order request
+type WsAddOrderRequest struct {
+	OrderType     string          `json:"order_type"`
+	Side          string          `json:"side"`
+	Symbol        string          `json:"symbol"`
+	OrderQty      decimal.Decimal `json:"order_qty"`
+	LimitPrice    float64         `json:"limit_price,omitempty"`   // optional
+	TimeInForce   string          `json:"time_in_force,omitempty"` // optional
+	PostOnly      bool            `json:"post_only,omitempty"`     // optional
+	ReduceOnly    bool            `json:"reduce_only,omitempty"`   // optional
+	ClientOrderID string          `json:"cl_ord_id,omitempty"`     // optional
+	OrderUserRef  int64           `json:"order_userref,omitempty"` // optional
+	Validate      bool            `json:"validate,omitempty"`      // optional
+	Token         string          `json:"token"`
+}
+
+// WsAddOrderResponse defines the result of a websocket add_order request
+type WsAddOrderResponse struct {
+	OrderID       string `json:"order_id"`
+	ClientOrderID string `json:"cl_ord_id"`
+	OrderUserRef  int64  `json:"order_userref"`
 }
 
-// WebsocketChannelData Holds relevant data for channels to identify what we're
-// doing
-type WebsocketChannelData struct {
-	Subscription string
-	Pair         currency.Pair
-	ChannelID    int64
+// WsCancelOrderRequest defines the parameters of a websocket cancel_order
+// request
+type WsCancelOrderRequest struct {
+	OrderIDs []string `json:"order_id"`
+	Token    string   `json:"token"`
 }
 
 // WsTokenResponse holds the WS auth token
@@ -474,94 +565,3 @@ type WsTokenResponse struct {
 		Token   string `json:"token"`
 	} `json:"result"`
 }
-
-// WsOwnTrade ws auth owntrade data
-type WsOwnTrade struct {
-	Cost               float64   `json:"cost,string"`
-	Fee                float64   `json:"fee,string"`
-	Margin             float64   `json:"margin,string"`
-	OrderTransactionID string    `json:"ordertxid"`
-	OrderType          string    `json:"ordertype"`
-	Pair               string    `json:"pair"`
-	PostTransactionID  string    `json:"postxid"`
-	Price              float64   `json:"price,string"`
-	Time               time.Time `json:"time"`
-	Type               string    `json:"type"`
-	Vol                float64   `json:"vol,string"`
-}
-
-// WsOpenOrders ws auth open order data
-type WsOpenOrders struct {
-	Cost           float64                `json:"cost,string"`
-	Description    WsOpenOrderDescription `json:"descr"`
-	ExpireTime     time.Time              `json:"expiretm"`
-	Fee            float64                `json:"fee,string"`
-	LimitPrice     float64                `json:"limitprice,string"`
-	Misc           string                 `json:"misc"`
-	OFlags         string                 `json:"oflags"`
-	OpenTime       time.Time              `json:"opentm"`
-	Price          float64                `json:"price,string"`
-	RefID          string                 `json:"refid"`
-	StartTime      time.Time              `json:"starttm"`
-	Status         string                 `json:"status"`
-	StopPrice      float64                `json:"stopprice,string"`
-	UserReference  float64                `json:"userref"`
-	Volume         float64                `json:"vol,string"`
-	ExecutedVolume float64                `json:"vol_exec,string"`
-}
-
-// WsOpenOrderDescription additional data for WsOpenOrders
-type WsOpenOrderDescription struct {
-	Close     string  `json:"close"`
-	Leverage  string  `json:"leverage"`
-	Order     string  `json:"order"`
-	OrderType string  `json:"ordertype"`
-	Pair      string  `json:"pair"`
-	Price     float64 `json:"price,string"`
-	Price2    float64 `json:"price2,string"`
-	Type      string  `json:"type"`
-}
-
-// WsAddOrderRequest request type for ws adding order
-type WsAddOrderRequest struct {
-	Event           string  `json:"event"`
-	Token           string  `json:"token"`
-	OrderType       string  `json:"ordertype"`
-	OrderSide       string  `json:"type"`
-	Pair            string  `json:"pair"`
-	Price           float64 `json:"price,omitempty"`  // optional
-	Price2          float64 `json:"price2,omitempty"` // optional
-	Volume          float64 `json:"volume,omitempty"`
-	Leverage        float64 `json:"leverage,omitempty"`         // optional
-	OFlags          string  `json:"oflags,omitempty"`           // optional
-	StartTime       string  `json:"starttm,omitempty"`          // optional
-	ExpireTime      string  `json:"expiretm,omitempty"`         // optional
-	UserReferenceID string  `json:"userref,omitempty"`          // optional
-	Validate        string  `json:"validate,omitempty"`         // optional
-	CloseOrderType  string  `json:"close[ordertype],omitempty"` // optional
-	ClosePrice      float64 `json:"close[price],omitempty"`     // optional
-	ClosePrice2     float64 `json:"close[price2],omitempty"`    // optional
-}
-
-// WsAddOrderResponse response data for ws order
-type WsAddOrderResponse struct {
-	Description   string `json:"descr"`
-	Event         string `json:"event"`
-	Status        string `json:"status"`
-	TransactionID string `json:"txid"`
-	ErrorMessage  string `json:"errorMessage"`
-}
-
-// WsCancelOrderRequest request for ws cancel order
-type WsCancelOrderRequest struct {
-	Event          string   `json:"event"`
-	Token          string   `json:"token"`
-	TransactionIDs []string `json:"txid"`
-}
-
-// WsCancelOrderResponse response data for ws cancel order
-type WsCancelOrderResponse struct {
-	Event        string `json:"event"`
-	Status       string `json:"status"`
-	ErrorMessage string `json:"errorMessage"`
-}
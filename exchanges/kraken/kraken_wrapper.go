@@ -1,6 +1,7 @@
 package kraken
 
 import (
+	"context"
 	"errors"
 	"strconv"
 	"strings"
@@ -9,6 +10,7 @@ import (
 
 	"github.com/thrasher-corp/gocryptotrader/common"
 	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +40,7 @@ func (k *Kraken) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if k.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = k.UpdateTradablePairs(true)
+		err = k.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -101,16 +103,17 @@ func (k *Kraken) SetDefaults() {
 				CryptoWithdrawalFee: true,
 			},
 			WebsocketCapabilities: protocol.Features{
-				TickerFetching:     true,
-				TradeFetching:      true,
-				KlineFetching:      true,
-				OrderbookFetching:  true,
-				Subscribe:          true,
-				Unsubscribe:        true,
-				MessageCorrelation: true,
-				SubmitOrder:        true,
-				CancelOrder:        true,
-				CancelOrders:       true,
+				TickerFetching:         true,
+				TradeFetching:          true,
+				KlineFetching:          true,
+				OrderbookFetching:      true,
+				Subscribe:              true,
+				Unsubscribe:            true,
+				MessageCorrelation:     true,
+				SubmitOrder:            true,
+				CancelOrder:            true,
+				CancelOrders:           true,
+				AuthenticatedEndpoints: true,
 			},
 			WithdrawPermissions: exchange.AutoWithdrawCryptoWithSetup |
 				exchange.WithdrawCryptoWith2FA |
@@ -168,11 +171,14 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) error {
 	k.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         k.Name,
 		URL:                  k.Websocket.GetWebsocketURL(),
+		AlternateURLs:        k.Websocket.GetAlternateURLs(),
 		ProxyURL:             k.Websocket.GetProxyAddress(),
 		Verbose:              k.Verbose,
 		RateLimit:            krakenWsRateLimit,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	k.AuthenticatedWebsocketConn = &wshandler.WebsocketConnection{
@@ -183,6 +189,8 @@ func (k *Kraken) Setup(exch *config.ExchangeConfig) error {
 		RateLimit:            krakenWsRateLimit,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	k.Websocket.Orderbook.Setup(
@@ -233,7 +241,7 @@ func (k *Kraken) Run() {
 		return
 	}
 
-	err := k.UpdateTradablePairs(forceUpdate)
+	err := k.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -243,8 +251,8 @@ func (k *Kraken) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (k *Kraken) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	pairs, err := k.GetAssetPairs()
+func (k *Kraken) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	pairs, err := k.GetAssetPairs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -272,8 +280,8 @@ func (k *Kraken) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (k *Kraken) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := k.FetchTradablePairs(asset.Spot)
+func (k *Kraken) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := k.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -282,14 +290,14 @@ func (k *Kraken) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (k *Kraken) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (k *Kraken) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
 	pairs := k.GetEnabledPairs(assetType)
 	pairsCollated, err := k.FormatExchangeCurrencies(pairs, assetType)
 	if err != nil {
 		return tickerPrice, err
 	}
-	tickers, err := k.GetTickers(pairsCollated)
+	tickers, err := k.GetTickers(ctx, pairsCollated)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -327,27 +335,27 @@ func (k *Kraken) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (k *Kraken) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (k *Kraken) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(k.Name, p, assetType)
 	if err != nil {
-		return k.UpdateTicker(p, assetType)
+		return k.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (k *Kraken) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (k *Kraken) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(k.Name, p, assetType)
 	if err != nil {
-		return k.UpdateOrderbook(p, assetType)
+		return k.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (k *Kraken) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (k *Kraken) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := k.GetDepth(k.FormatExchangeCurrency(p,
+	orderbookNew, err := k.GetDepth(ctx, k.FormatExchangeCurrency(p,
 		assetType).String())
 	if err != nil {
 		return orderBook, err
@@ -375,11 +383,11 @@ func (k *Kraken) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderb
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Kraken exchange - to-do
-func (k *Kraken) UpdateAccountInfo() (account.Holdings, error) {
+func (k *Kraken) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 	info.Exchange = k.Name
 
-	bal, err := k.GetBalance()
+	bal, err := k.GetBalance(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -388,7 +396,7 @@ func (k *Kraken) UpdateAccountInfo() (account.Holdings, error) {
 	for key := range bal {
 		balances = append(balances, account.Balance{
 			CurrencyName: currency.NewCode(key),
-			TotalValue:   bal[key],
+			TotalValue:   decimal.NewFromFloat(bal[key]),
 		})
 	}
 
@@ -405,10 +413,10 @@ func (k *Kraken) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (k *Kraken) FetchAccountInfo() (account.Holdings, error) {
+func (k *Kraken) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(k.Name)
 	if err != nil {
-		return k.UpdateAccountInfo()
+		return k.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -416,17 +424,40 @@ func (k *Kraken) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (k *Kraken) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (k *Kraken) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (k *Kraken) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (k *Kraken) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (k *Kraken) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (k *Kraken) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrNotYetImplemented
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (k *Kraken) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (k *Kraken) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (k *Kraken) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	err := s.Validate()
 	if err != nil {
@@ -435,13 +466,16 @@ func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 	if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
 		var resp string
-		resp, err = k.wsAddOrder(&WsAddOrderRequest{
-			OrderType: s.OrderType.String(),
-			OrderSide: s.OrderSide.String(),
-			Pair:      s.Pair.String(),
-			Price:     s.Price,
-			Volume:    s.Amount,
-		})
+		req := WsAddOrderRequest{
+			OrderType: s.OrderType.Lower(),
+			Side:      s.OrderSide.Lower(),
+			Symbol:    wsSymbol(s.Pair),
+			OrderQty:  s.Amount,
+		}
+		if s.OrderType == order.Limit {
+			req.LimitPrice = s.Price.Float64()
+		}
+		resp, err = k.wsAddOrder(&req)
 		if err != nil {
 			return submitOrderResponse, err
 		}
@@ -449,11 +483,11 @@ func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		submitOrderResponse.IsOrderPlaced = true
 	} else {
 		var response AddOrderResponse
-		response, err = k.AddOrder(s.Pair.String(),
+		response, err = k.AddOrder(ctx, s.Pair.String(),
 			s.OrderSide.String(),
 			s.OrderType.String(),
 			s.Amount,
-			s.Price,
+			s.Price.Float64(),
 			0,
 			0,
 			&AddOrderOptions{})
@@ -473,28 +507,28 @@ func (k *Kraken) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (k *Kraken) ModifyOrder(action *order.Modify) (string, error) {
+func (k *Kraken) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (k *Kraken) CancelOrder(order *order.Cancel) error {
+func (k *Kraken) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
 		return k.wsCancelOrders([]string{order.OrderID})
 	}
-	_, err := k.CancelExistingOrder(order.OrderID)
+	_, err := k.CancelExistingOrder(ctx, order.OrderID)
 
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (k *Kraken) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (k *Kraken) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
 
 	var emptyOrderOptions OrderInfoOptions
-	openOrders, err := k.GetOpenOrders(emptyOrderOptions)
+	openOrders, err := k.GetOpenOrders(ctx, emptyOrderOptions)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -503,7 +537,7 @@ func (k *Kraken) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 		if k.Websocket.CanUseAuthenticatedWebsocketForWrapper() {
 			err = k.wsCancelOrders([]string{orderID})
 		} else {
-			_, err = k.CancelExistingOrder(orderID)
+			_, err = k.CancelExistingOrder(ctx, orderID)
 		}
 		if err != nil {
 			cancelAllOrdersResponse.Status[orderID] = err.Error()
@@ -513,10 +547,10 @@ func (k *Kraken) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, erro
 }
 
 // GetOrderInfo returns information on a current open order
-func (k *Kraken) GetOrderInfo(orderID string) (order.Detail, error) {
+func (k *Kraken) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	var emptyOrderOptions OrderInfoOptions
-	openOrders, err := k.GetOpenOrders(emptyOrderOptions)
+	openOrders, err := k.GetOpenOrders(ctx, emptyOrderOptions)
 	if err != nil {
 		return orderDetail, err
 	}
@@ -527,10 +561,6 @@ func (k *Kraken) GetOrderInfo(orderID string) (order.Detail, error) {
 				TID: orderInfo.Trades[i],
 			})
 		}
-		firstNum, decNum, err := convert.SplitFloatDecimals(orderInfo.StartTime)
-		if err != nil {
-			return orderDetail, err
-		}
 		side, err := order.StringToOrderSide(orderInfo.Description.Type)
 		if err != nil {
 			return orderDetail, err
@@ -550,13 +580,13 @@ func (k *Kraken) GetOrderInfo(orderID string) (order.Detail, error) {
 			CurrencyPair:    currency.NewPairFromString(orderInfo.Description.Pair),
 			OrderSide:       side,
 			OrderType:       oType,
-			OrderDate:       time.Unix(firstNum, decNum),
+			OrderDate:       convert.TimeFromUnixFloat(orderInfo.StartTime, time.Second),
 			Status:          status,
-			Price:           orderInfo.Price,
+			Price:           decimal.NewFromFloat(orderInfo.Price),
 			Amount:          orderInfo.Volume,
 			ExecutedAmount:  orderInfo.VolumeExecuted,
-			RemainingAmount: orderInfo.Volume - orderInfo.VolumeExecuted,
-			Fee:             orderInfo.Fee,
+			RemainingAmount: orderInfo.Volume.Sub(orderInfo.VolumeExecuted),
+			Fee:             decimal.NewFromFloat(orderInfo.Fee),
 			Trades:          trades,
 		}
 	} else {
@@ -567,8 +597,8 @@ func (k *Kraken) GetOrderInfo(orderID string) (order.Detail, error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (k *Kraken) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	methods, err := k.GetDepositMethods(cryptocurrency.String())
+func (k *Kraken) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	methods, err := k.GetDepositMethods(ctx, cryptocurrency.String())
 	if err != nil {
 		return "", err
 	}
@@ -582,25 +612,25 @@ func (k *Kraken) GetDepositAddress(cryptocurrency currency.Code, _ string) (stri
 		return "", errors.New("method not found")
 	}
 
-	return k.GetCryptoDepositAddress(method, cryptocurrency.String())
+	return k.GetCryptoDepositAddress(ctx, method, cryptocurrency.String())
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal
 // Populate exchange.WithdrawRequest.TradePassword with withdrawal key name, as set up on your account
-func (k *Kraken) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	return k.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
+func (k *Kraken) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	return k.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (k *Kraken) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
-	return k.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
+func (k *Kraken) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
+	return k.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (k *Kraken) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
-	return k.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
+func (k *Kraken) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
+	return k.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.TradePassword, withdrawRequest.Amount)
 }
 
 // GetWebsocket returns a pointer to the exchange websocket
@@ -609,17 +639,17 @@ func (k *Kraken) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (k *Kraken) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (k *Kraken) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !k.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return k.GetFee(feeBuilder)
+	return k.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (k *Kraken) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := k.GetOpenOrders(OrderInfoOptions{})
+func (k *Kraken) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := k.GetOpenOrders(ctx, OrderInfoOptions{})
 	if err != nil {
 		return nil, err
 	}
@@ -634,11 +664,11 @@ func (k *Kraken) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 		orders = append(orders, order.Detail{
 			ID:              i,
 			Amount:          resp.Open[i].Volume,
-			RemainingAmount: (resp.Open[i].Volume - resp.Open[i].VolumeExecuted),
+			RemainingAmount: resp.Open[i].Volume.Sub(resp.Open[i].VolumeExecuted),
 			ExecutedAmount:  resp.Open[i].VolumeExecuted,
 			Exchange:        k.Name,
 			OrderDate:       orderDate,
-			Price:           resp.Open[i].Description.Price,
+			Price:           decimal.NewFromFloat(resp.Open[i].Description.Price),
 			OrderSide:       side,
 			OrderType:       orderType,
 			CurrencyPair:    symbol,
@@ -653,7 +683,7 @@ func (k *Kraken) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, e
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (k *Kraken) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (k *Kraken) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	req := GetClosedOrdersOptions{}
 	if getOrdersRequest.StartTicks.Unix() > 0 {
 		req.Start = strconv.FormatInt(getOrdersRequest.StartTicks.Unix(), 10)
@@ -662,7 +692,7 @@ func (k *Kraken) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]or
 		req.End = strconv.FormatInt(getOrdersRequest.EndTicks.Unix(), 10)
 	}
 
-	resp, err := k.GetClosedOrders(req)
+	resp, err := k.GetClosedOrders(ctx, req)
 	if err != nil {
 		return nil, err
 	}
@@ -677,11 +707,11 @@ func (k *Kraken) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]or
 		orders = append(orders, order.Detail{
 			ID:              i,
 			Amount:          resp.Closed[i].Volume,
-			RemainingAmount: (resp.Closed[i].Volume - resp.Closed[i].VolumeExecuted),
+			RemainingAmount: resp.Closed[i].Volume.Sub(resp.Closed[i].VolumeExecuted),
 			ExecutedAmount:  resp.Closed[i].VolumeExecuted,
 			Exchange:        k.Name,
 			OrderDate:       orderDate,
-			Price:           resp.Closed[i].Description.Price,
+			Price:           decimal.NewFromFloat(resp.Closed[i].Description.Price),
 			OrderSide:       side,
 			OrderType:       orderType,
 			CurrencyPair:    symbol,
@@ -693,18 +723,16 @@ func (k *Kraken) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]or
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (k *Kraken) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	k.Websocket.SubscribeToChannels(channels)
-	return nil
+	return k.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (k *Kraken) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	k.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return k.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -714,7 +742,7 @@ func (k *Kraken) GetSubscriptions() ([]wshandler.WebsocketChannelSubscription, e
 
 // AuthenticateWebsocket sends an authentication message to the websocket
 func (k *Kraken) AuthenticateWebsocket() error {
-	resp, err := k.GetWebsocketToken()
+	resp, err := k.GetWebsocketToken(context.Background())
 	if resp != "" {
 		authToken = resp
 	}
@@ -723,7 +751,12 @@ func (k *Kraken) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (k *Kraken) ValidateCredentials() error {
-	_, err := k.UpdateAccountInfo()
+func (k *Kraken) ValidateCredentials(ctx context.Context) error {
+	_, err := k.UpdateAccountInfo(ctx)
 	return k.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (k *Kraken) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
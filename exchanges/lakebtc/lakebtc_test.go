@@ -1,11 +1,13 @@
 package lakebtc
 
 import (
+	"context"
 	"log"
 	"os"
 	"testing"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -52,7 +54,7 @@ func TestMain(m *testing.M) {
 
 func TestFetchTradablePairs(t *testing.T) {
 	t.Parallel()
-	_, err := l.FetchTradablePairs(asset.Spot)
+	_, err := l.FetchTradablePairs(context.Background(), asset.Spot)
 	if err != nil {
 		t.Fatalf("GetTradablePairs err: %s", err)
 	}
@@ -60,7 +62,7 @@ func TestFetchTradablePairs(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTicker()
+	_, err := l.GetTicker(context.Background())
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -68,7 +70,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetOrderBook(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetOrderBook("BTCUSD")
+	_, err := l.GetOrderBook(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("GetOrderBook() error", err)
 	}
@@ -76,7 +78,7 @@ func TestGetOrderBook(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTradeHistory("BTCUSD")
+	_, err := l.GetTradeHistory(context.Background(), "BTCUSD")
 	if err != nil {
 		t.Error("GetTradeHistory() error", err)
 	}
@@ -87,7 +89,7 @@ func TestTrade(t *testing.T) {
 	if !l.ValidateAPICredentials() {
 		t.Skip()
 	}
-	_, err := l.Trade(false, 0, 0, "USD")
+	_, err := l.Trade(context.Background(), false, 0, 0, "USD")
 	if err == nil {
 		t.Error("Trade() Expected error")
 	}
@@ -98,7 +100,7 @@ func TestGetOpenOrders(t *testing.T) {
 	if !l.ValidateAPICredentials() {
 		t.Skip()
 	}
-	_, err := l.GetOpenOrders()
+	_, err := l.GetOpenOrders(context.Background())
 	if err == nil {
 		t.Error("GetOpenOrders() Expected error")
 	}
@@ -109,7 +111,7 @@ func TestGetOrders(t *testing.T) {
 	if !l.ValidateAPICredentials() {
 		t.Skip()
 	}
-	_, err := l.GetOrders([]int64{1, 2})
+	_, err := l.GetOrders(context.Background(), []int64{1, 2})
 	if err == nil {
 		t.Error("GetOrders() Expected error")
 	}
@@ -120,7 +122,7 @@ func TestCancelOrder(t *testing.T) {
 	if !l.ValidateAPICredentials() {
 		t.Skip()
 	}
-	err := l.CancelExistingOrder(1337)
+	err := l.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("CancelExistingOrder() Expected error")
 	}
@@ -131,7 +133,7 @@ func TestGetTrades(t *testing.T) {
 	if !l.ValidateAPICredentials() {
 		t.Skip()
 	}
-	_, err := l.GetTrades(1337)
+	_, err := l.GetTrades(context.Background(), 1337)
 	if err == nil {
 		t.Error("GetTrades() Expected error")
 	}
@@ -142,7 +144,7 @@ func TestGetExternalAccounts(t *testing.T) {
 	if !l.ValidateAPICredentials() {
 		t.Skip()
 	}
-	_, err := l.GetExternalAccounts()
+	_, err := l.GetExternalAccounts(context.Background())
 	if err == nil {
 		t.Error("GetExternalAccounts() Expected error")
 	}
@@ -165,7 +167,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	l.GetFeeByType(feeBuilder)
+	l.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -266,7 +268,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := l.GetActiveOrders(&getOrdersRequest)
+	_, err := l.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -279,7 +281,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := l.GetOrderHistory(&getOrdersRequest)
+	_, err := l.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -305,11 +307,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := l.SubmitOrder(orderSubmission)
+	response, err := l.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -330,7 +332,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := l.CancelOrder(orderCancellation)
+	err := l.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -352,7 +354,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := l.CancelAllOrders(orderCancellation)
+	resp, err := l.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -370,7 +372,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := l.ModifyOrder(&order.Modify{})
+	_, err := l.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -390,7 +392,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := l.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := l.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -405,7 +407,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := l.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := l.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -417,7 +419,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := l.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := l.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'", common.ErrFunctionNotSupported, err)
 	}
@@ -425,12 +427,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := l.GetDepositAddress(currency.BTC, "")
+		_, err := l.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() error", err)
 		}
 	} else {
-		_, err := l.GetDepositAddress(currency.DASH, "")
+		_, err := l.GetDepositAddress(context.Background(), currency.DASH, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error cannot be nil")
 		}
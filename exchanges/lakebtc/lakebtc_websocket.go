@@ -237,7 +237,7 @@ func (l *LakeBTC) processTicker(wsTicker string) error {
 	var tUpdate map[string]interface{}
 	err := json.Unmarshal([]byte(wsTicker), &tUpdate)
 	if err != nil {
-		l.Websocket.DataHandler <- err
+		l.Websocket.DataHandler <- wshandler.NewError(l.Name, wshandler.ErrorMalformedMessage, err)
 		return err
 	}
 
@@ -1,6 +1,7 @@
 package lakebtc
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +40,7 @@ func (l *LakeBTC) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if l.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = l.UpdateTradablePairs(true)
+		err = l.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -174,15 +176,15 @@ func (l *LakeBTC) Run() {
 		return
 	}
 
-	err := l.UpdateTradablePairs(false)
+	err := l.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", l.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (l *LakeBTC) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	result, err := l.GetTicker()
+func (l *LakeBTC) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	result, err := l.GetTicker(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -197,8 +199,8 @@ func (l *LakeBTC) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (l *LakeBTC) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := l.FetchTradablePairs(asset.Spot)
+func (l *LakeBTC) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := l.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -207,8 +209,8 @@ func (l *LakeBTC) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (l *LakeBTC) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
-	ticks, err := l.GetTicker()
+func (l *LakeBTC) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+	ticks, err := l.GetTicker(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -238,27 +240,27 @@ func (l *LakeBTC) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.P
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (l *LakeBTC) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (l *LakeBTC) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(l.Name, p, assetType)
 	if err != nil {
-		return l.UpdateTicker(p, assetType)
+		return l.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (l *LakeBTC) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (l *LakeBTC) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(l.Name, p, assetType)
 	if err != nil {
-		return l.UpdateOrderbook(p, assetType)
+		return l.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (l *LakeBTC) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (l *LakeBTC) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := l.GetOrderBook(p.String())
+	orderbookNew, err := l.GetOrderBook(ctx, p.String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -285,10 +287,10 @@ func (l *LakeBTC) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*order
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // LakeBTC exchange
-func (l *LakeBTC) UpdateAccountInfo() (account.Holdings, error) {
+func (l *LakeBTC) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = l.Name
-	accountInfo, err := l.GetAccountInformation()
+	accountInfo, err := l.GetAccountInformation(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -301,8 +303,8 @@ func (l *LakeBTC) UpdateAccountInfo() (account.Holdings, error) {
 			}
 			var exchangeCurrency account.Balance
 			exchangeCurrency.CurrencyName = currency.NewCode(x)
-			exchangeCurrency.TotalValue, _ = strconv.ParseFloat(y, 64)
-			exchangeCurrency.Hold, _ = strconv.ParseFloat(w, 64)
+			exchangeCurrency.TotalValue, _ = decimal.NewFromString(y)
+			exchangeCurrency.Hold, _ = decimal.NewFromString(w)
 			currencies = append(currencies, exchangeCurrency)
 		}
 	}
@@ -320,10 +322,10 @@ func (l *LakeBTC) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (l *LakeBTC) FetchAccountInfo() (account.Holdings, error) {
+func (l *LakeBTC) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(l.Name)
 	if err != nil {
-		return l.UpdateAccountInfo()
+		return l.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -331,24 +333,47 @@ func (l *LakeBTC) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (l *LakeBTC) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (l *LakeBTC) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (l *LakeBTC) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (l *LakeBTC) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (l *LakeBTC) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (l *LakeBTC) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (l *LakeBTC) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (l *LakeBTC) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (l *LakeBTC) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (l *LakeBTC) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
 	}
 
 	isBuyOrder := s.OrderSide == order.Buy
-	response, err := l.Trade(isBuyOrder, s.Amount, s.Price,
+	response, err := l.Trade(ctx, isBuyOrder, s.Amount.Float64(), s.Price.Float64(),
 		s.Pair.Lower().String())
 	if err != nil {
 		return submitOrderResponse, err
@@ -366,25 +391,25 @@ func (l *LakeBTC) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (l *LakeBTC) ModifyOrder(action *order.Modify) (string, error) {
+func (l *LakeBTC) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (l *LakeBTC) CancelOrder(order *order.Cancel) error {
+func (l *LakeBTC) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 
 	if err != nil {
 		return err
 	}
 
-	return l.CancelExistingOrder(orderIDInt)
+	return l.CancelExistingOrder(ctx, orderIDInt)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (l *LakeBTC) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (l *LakeBTC) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	var cancelAllOrdersResponse order.CancelAllResponse
-	openOrders, err := l.GetOpenOrders()
+	openOrders, err := l.GetOpenOrders(ctx)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
@@ -394,23 +419,23 @@ func (l *LakeBTC) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, err
 		ordersToCancel = append(ordersToCancel, strconv.FormatInt(openOrders[i].ID, 10))
 	}
 
-	return cancelAllOrdersResponse, l.CancelExistingOrders(ordersToCancel)
+	return cancelAllOrdersResponse, l.CancelExistingOrders(ctx, ordersToCancel)
 }
 
 // GetOrderInfo returns information on a current open order
-func (l *LakeBTC) GetOrderInfo(orderID string) (order.Detail, error) {
+func (l *LakeBTC) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (l *LakeBTC) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
+func (l *LakeBTC) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
 	if !strings.EqualFold(cryptocurrency.String(), currency.BTC.String()) {
 		return "", fmt.Errorf("unsupported currency %s deposit address can only be BTC, manual deposit is required for other currencies",
 			cryptocurrency.String())
 	}
 
-	info, err := l.GetAccountInformation()
+	info, err := l.GetAccountInformation(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -420,12 +445,12 @@ func (l *LakeBTC) GetDepositAddress(cryptocurrency currency.Code, _ string) (str
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *LakeBTC) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (l *LakeBTC) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	if withdrawRequest.Currency != currency.BTC {
 		return "", errors.New("only BTC supported for withdrawals")
 	}
 
-	resp, err := l.CreateWithdraw(withdrawRequest.Amount, withdrawRequest.Description)
+	resp, err := l.CreateWithdraw(ctx, withdrawRequest.Amount, withdrawRequest.Description)
 	if err != nil {
 		return "", err
 	}
@@ -435,13 +460,13 @@ func (l *LakeBTC) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRe
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (l *LakeBTC) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (l *LakeBTC) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (l *LakeBTC) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (l *LakeBTC) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -451,7 +476,7 @@ func (l *LakeBTC) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (l *LakeBTC) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (l *LakeBTC) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !l.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -460,8 +485,8 @@ func (l *LakeBTC) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (l *LakeBTC) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := l.GetOpenOrders()
+func (l *LakeBTC) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := l.GetOpenOrders(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -474,9 +499,9 @@ func (l *LakeBTC) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 		side := order.Side(strings.ToUpper(resp[i].Type))
 
 		orders = append(orders, order.Detail{
-			Amount:       resp[i].Amount,
+			Amount:       decimal.NewFromFloat(resp[i].Amount),
 			ID:           strconv.FormatInt(resp[i].ID, 10),
-			Price:        resp[i].Price,
+			Price:        decimal.NewFromFloat(resp[i].Price),
 			OrderSide:    side,
 			OrderDate:    orderDate,
 			CurrencyPair: symbol,
@@ -493,8 +518,8 @@ func (l *LakeBTC) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (l *LakeBTC) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := l.GetOrders([]int64{})
+func (l *LakeBTC) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := l.GetOrders(ctx, []int64{})
 	if err != nil {
 		return nil, err
 	}
@@ -511,9 +536,9 @@ func (l *LakeBTC) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 		side := order.Side(strings.ToUpper(resp[i].Type))
 
 		orders = append(orders, order.Detail{
-			Amount:       resp[i].Amount,
+			Amount:       decimal.NewFromFloat(resp[i].Amount),
 			ID:           strconv.FormatInt(resp[i].ID, 10),
-			Price:        resp[i].Price,
+			Price:        decimal.NewFromFloat(resp[i].Price),
 			OrderSide:    side,
 			OrderDate:    orderDate,
 			CurrencyPair: symbol,
@@ -552,7 +577,12 @@ func (l *LakeBTC) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (l *LakeBTC) ValidateCredentials() error {
-	_, err := l.UpdateAccountInfo()
+func (l *LakeBTC) ValidateCredentials(ctx context.Context) error {
+	_, err := l.UpdateAccountInfo(ctx)
 	return l.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (l *LakeBTC) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
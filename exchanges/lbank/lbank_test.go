@@ -1,12 +1,14 @@
 package lbank
 
 import (
+	"context"
 	"log"
 	"os"
 	"strconv"
 	"testing"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -51,7 +53,7 @@ func areTestAPIKeysSet() bool {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTicker(testCurrencyPair)
+	_, err := l.GetTicker(context.Background(), testCurrencyPair)
 	if err != nil {
 		t.Error(err)
 	}
@@ -59,7 +61,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetTickers(t *testing.T) {
 	t.Parallel()
-	tickers, err := l.GetTickers()
+	tickers, err := l.GetTickers(context.Background())
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -70,7 +72,7 @@ func TestGetTickers(t *testing.T) {
 
 func TestGetCurrencyPairs(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetCurrencyPairs()
+	_, err := l.GetCurrencyPairs(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -78,11 +80,11 @@ func TestGetCurrencyPairs(t *testing.T) {
 
 func TestGetMarketDepths(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetMarketDepths(testCurrencyPair, "600", "1")
+	_, err := l.GetMarketDepths(context.Background(), testCurrencyPair, "600", "1")
 	if err != nil {
 		t.Fatal(err)
 	}
-	a, _ := l.GetMarketDepths(testCurrencyPair, "4", "0")
+	a, _ := l.GetMarketDepths(context.Background(), testCurrencyPair, "4", "0")
 	if len(a.Asks) != 4 {
 		t.Errorf("asks length requested doesnt match the output")
 	}
@@ -90,12 +92,12 @@ func TestGetMarketDepths(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetTrades(testCurrencyPair, "600",
+	_, err := l.GetTrades(context.Background(), testCurrencyPair, "600",
 		strconv.FormatInt(time.Now().Unix(), 10))
 	if err != nil {
 		t.Error(err)
 	}
-	a, err := l.GetTrades(testCurrencyPair, "600", "0")
+	a, err := l.GetTrades(context.Background(), testCurrencyPair, "600", "0")
 	if len(a) != 600 && err != nil {
 		t.Error(err)
 	}
@@ -103,7 +105,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetKlines(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetKlines(testCurrencyPair, "600", "minute1",
+	_, err := l.GetKlines(context.Background(), testCurrencyPair, "600", "minute1",
 		strconv.FormatInt(time.Now().Unix(), 10))
 	if err != nil {
 		t.Error(err)
@@ -117,7 +119,7 @@ func TestUpdateOrderbook(t *testing.T) {
 		Base:      currency.ETH,
 		Quote:     currency.BTC}
 
-	_, err := l.UpdateOrderbook(p.Lower(), asset.Spot)
+	_, err := l.UpdateOrderbook(context.Background(), p.Lower(), asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
@@ -128,7 +130,7 @@ func TestGetUserInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.GetUserInfo()
+	_, err := l.GetUserInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -140,19 +142,19 @@ func TestCreateOrder(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.CreateOrder(cp.Lower().String(), "what", 1231, 12314)
+	_, err := l.CreateOrder(context.Background(), cp.Lower().String(), "what", 1231, 12314)
 	if err == nil {
 		t.Error("CreateOrder error cannot be nil")
 	}
-	_, err = l.CreateOrder(cp.Lower().String(), order.Buy.Lower(), 0, 0)
+	_, err = l.CreateOrder(context.Background(), cp.Lower().String(), order.Buy.Lower(), 0, 0)
 	if err == nil {
 		t.Error("CreateOrder error cannot be nil")
 	}
-	_, err = l.CreateOrder(cp.Lower().String(), order.Sell.Lower(), 1231, 0)
+	_, err = l.CreateOrder(context.Background(), cp.Lower().String(), order.Sell.Lower(), 1231, 0)
 	if err == nil {
 		t.Error("CreateOrder error cannot be nil")
 	}
-	_, err = l.CreateOrder(cp.Lower().String(), order.Buy.Lower(), 58, 681)
+	_, err = l.CreateOrder(context.Background(), cp.Lower().String(), order.Buy.Lower(), 58, 681)
 	if err != nil {
 		t.Errorf("Unexpected error: %v", err)
 	}
@@ -164,7 +166,7 @@ func TestRemoveOrder(t *testing.T) {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
 	cp := currency.NewPairWithDelimiter(currency.ETH.String(), currency.BTC.String(), "_")
-	_, err := l.RemoveOrder(cp.Lower().String(), "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23")
+	_, err := l.RemoveOrder(context.Background(), cp.Lower().String(), "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23")
 	if err != nil {
 		t.Error(err)
 	}
@@ -176,7 +178,7 @@ func TestQueryOrder(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.QueryOrder(cp.Lower().String(), "1")
+	_, err := l.QueryOrder(context.Background(), cp.Lower().String(), "1")
 	if err != nil {
 		t.Error(err)
 	}
@@ -188,7 +190,7 @@ func TestQueryOrderHistory(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.QueryOrderHistory(cp.Lower().String(), "1", "100")
+	_, err := l.QueryOrderHistory(context.Background(), cp.Lower().String(), "1", "100")
 	if err != nil {
 		t.Error(err)
 	}
@@ -196,7 +198,7 @@ func TestQueryOrderHistory(t *testing.T) {
 
 func TestGetPairInfo(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetPairInfo()
+	_, err := l.GetPairInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -207,7 +209,7 @@ func TestOrderTransactionDetails(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.OrderTransactionDetails(testCurrencyPair, "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23")
+	_, err := l.OrderTransactionDetails(context.Background(), testCurrencyPair, "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23")
 	if err != nil {
 		t.Error(err)
 	}
@@ -218,7 +220,7 @@ func TestTransactionHistory(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.TransactionHistory(testCurrencyPair, "", "", "", "", "", "")
+	_, err := l.TransactionHistory(context.Background(), testCurrencyPair, "", "", "", "", "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -230,7 +232,7 @@ func TestGetOpenOrders(t *testing.T) {
 		t.Skip("API keys required but not set, skipping test")
 	}
 	cp := currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "_")
-	_, err := l.GetOpenOrders(cp.Lower().String(), "1", "50")
+	_, err := l.GetOpenOrders(context.Background(), cp.Lower().String(), "1", "50")
 	if err != nil {
 		t.Error(err)
 	}
@@ -238,7 +240,7 @@ func TestGetOpenOrders(t *testing.T) {
 
 func TestUSD2RMBRate(t *testing.T) {
 	t.Parallel()
-	_, err := l.USD2RMBRate()
+	_, err := l.USD2RMBRate(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -246,7 +248,7 @@ func TestUSD2RMBRate(t *testing.T) {
 
 func TestGetWithdrawConfig(t *testing.T) {
 	t.Parallel()
-	_, err := l.GetWithdrawConfig(currency.ETH.Lower().String())
+	_, err := l.GetWithdrawConfig(context.Background(), currency.ETH.Lower().String())
 	if err != nil {
 		t.Error(err)
 	}
@@ -257,7 +259,7 @@ func TestWithdraw(t *testing.T) {
 	if !areTestAPIKeysSet() || !canManipulateRealOrders {
 		t.Skip("skipping test, either api keys or manipulaterealorders isnt set correctly")
 	}
-	_, err := l.Withdraw("", "", "", "", "", "")
+	_, err := l.Withdraw(context.Background(), "", "", "", "", "", "")
 	if err != nil {
 		t.Error(err)
 	}
@@ -268,7 +270,7 @@ func TestGetWithdrawRecords(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.GetWithdrawalRecords(currency.ETH.Lower().String(),
+	_, err := l.GetWithdrawalRecords(context.Background(), currency.ETH.Lower().String(),
 		"0", "1", "20")
 	if err != nil {
 		t.Error(err)
@@ -318,11 +320,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := l.SubmitOrder(orderSubmission)
+	response, err := l.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -339,7 +341,7 @@ func TestCancelOrder(t *testing.T) {
 	var a order.Cancel
 	a.CurrencyPair = cp
 	a.OrderID = "24f7ce27-af1d-4dca-a8c1-ef1cbeec1b23"
-	err := l.CancelOrder(&a)
+	err := l.CancelOrder(context.Background(), &a)
 	if err != nil {
 		t.Error(err)
 	}
@@ -350,7 +352,7 @@ func TestGetOrderInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.GetOrderInfo("9ead39f5-701a-400b-b635-d7349eb0f6b")
+	_, err := l.GetOrderInfo(context.Background(), "9ead39f5-701a-400b-b635-d7349eb0f6b")
 	if err != nil {
 		t.Error(err)
 	}
@@ -361,7 +363,7 @@ func TestGetAllOpenOrderID(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.getAllOpenOrderID()
+	_, err := l.getAllOpenOrderID(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -374,7 +376,7 @@ func TestGetFeeByType(t *testing.T) {
 	input.Amount = 2
 	input.FeeType = exchange.CryptocurrencyWithdrawalFee
 	input.Pair = cp
-	a, err := l.GetFeeByType(&input)
+	a, err := l.GetFeeByType(context.Background(), &input)
 	if err != nil {
 		t.Error(err)
 	}
@@ -388,7 +390,7 @@ func TestGetAccountInfo(t *testing.T) {
 	if !areTestAPIKeysSet() {
 		t.Skip("API keys required but not set, skipping test")
 	}
-	_, err := l.UpdateAccountInfo()
+	_, err := l.UpdateAccountInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -401,7 +403,7 @@ func TestGetOrderHistory(t *testing.T) {
 	}
 	var input order.GetOrdersRequest
 	input.OrderSide = order.Buy
-	_, err := l.GetOrderHistory(&input)
+	_, err := l.GetOrderHistory(context.Background(), &input)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1,6 +1,7 @@
 package lbank
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,6 +9,8 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +40,7 @@ func (l *Lbank) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if l.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = l.UpdateTradablePairs(true)
+		err = l.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -146,15 +149,15 @@ func (l *Lbank) Run() {
 		return
 	}
 
-	err := l.UpdateTradablePairs(false)
+	err := l.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", l.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (l *Lbank) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	currencies, err := l.GetCurrencyPairs()
+func (l *Lbank) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	currencies, err := l.GetCurrencyPairs(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -163,8 +166,8 @@ func (l *Lbank) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (l *Lbank) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := l.FetchTradablePairs(asset.Spot)
+func (l *Lbank) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := l.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -173,9 +176,9 @@ func (l *Lbank) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (l *Lbank) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (l *Lbank) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tickerInfo, err := l.GetTickers()
+	tickerInfo, err := l.GetTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -191,7 +194,7 @@ func (l *Lbank) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pri
 				Low:         tickerInfo[j].Ticker.Low,
 				Volume:      tickerInfo[j].Ticker.Volume,
 				Pair:        tickerInfo[j].Symbol,
-				LastUpdated: time.Unix(0, tickerInfo[j].Timestamp),
+				LastUpdated: convert.TimeFromUnix(tickerInfo[j].Timestamp, time.Millisecond),
 			}
 			err = ticker.ProcessTicker(l.Name, tickerPrice, assetType)
 			if err != nil {
@@ -203,28 +206,28 @@ func (l *Lbank) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pri
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (l *Lbank) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (l *Lbank) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(l.Name,
 		l.FormatExchangeCurrency(p, assetType), assetType)
 	if err != nil {
-		return l.UpdateTicker(p, assetType)
+		return l.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (l *Lbank) FetchOrderbook(currency currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (l *Lbank) FetchOrderbook(ctx context.Context, currency currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(l.Name, currency, assetType)
 	if err != nil {
-		return l.UpdateOrderbook(currency, assetType)
+		return l.UpdateOrderbook(ctx, currency, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (l *Lbank) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (l *Lbank) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	a, err := l.GetMarketDepths(l.FormatExchangeCurrency(p, assetType).String(), "60", "1")
+	a, err := l.GetMarketDepths(ctx, l.FormatExchangeCurrency(p, assetType).String(), "60", "1")
 	if err != nil {
 		return orderBook, err
 	}
@@ -251,9 +254,9 @@ func (l *Lbank) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbo
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Lbank exchange
-func (l *Lbank) UpdateAccountInfo() (account.Holdings, error) {
+func (l *Lbank) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
-	data, err := l.GetUserInfo()
+	data, err := l.GetUserInfo(ctx)
 	if err != nil {
 		return info, err
 	}
@@ -274,8 +277,8 @@ func (l *Lbank) UpdateAccountInfo() (account.Holdings, error) {
 		}
 		acc.Currencies = append(acc.Currencies, account.Balance{
 			CurrencyName: c,
-			TotalValue:   totalVal,
-			Hold:         totalHold})
+			TotalValue:   decimal.NewFromFloat(totalVal),
+			Hold:         decimal.NewFromFloat(totalHold)})
 	}
 
 	info.Accounts = append(info.Accounts, acc)
@@ -289,10 +292,10 @@ func (l *Lbank) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (l *Lbank) FetchAccountInfo() (account.Holdings, error) {
+func (l *Lbank) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(l.Name)
 	if err != nil {
-		return l.UpdateAccountInfo()
+		return l.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -300,17 +303,40 @@ func (l *Lbank) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (l *Lbank) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (l *Lbank) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (l *Lbank) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (l *Lbank) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (l *Lbank) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (l *Lbank) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (l *Lbank) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (l *Lbank) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
 // SubmitOrder submits a new order
-func (l *Lbank) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (l *Lbank) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var resp order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return resp, err
@@ -321,11 +347,11 @@ func (l *Lbank) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 			fmt.Errorf("%s order side is not supported by the exchange",
 				s.OrderSide)
 	}
-	tempResp, err := l.CreateOrder(
+	tempResp, err := l.CreateOrder(ctx,
 		l.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
 		s.OrderSide.String(),
-		s.Amount,
-		s.Price)
+		s.Amount.Float64(),
+		s.Price.Float64())
 	if err != nil {
 		return resp, err
 	}
@@ -339,21 +365,21 @@ func (l *Lbank) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (l *Lbank) ModifyOrder(action *order.Modify) (string, error) {
+func (l *Lbank) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (l *Lbank) CancelOrder(order *order.Cancel) error {
-	_, err := l.RemoveOrder(l.FormatExchangeCurrency(order.CurrencyPair,
+func (l *Lbank) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	_, err := l.RemoveOrder(ctx, l.FormatExchangeCurrency(order.CurrencyPair,
 		order.AssetType).String(), order.OrderID)
 	return err
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (l *Lbank) CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse, error) {
+func (l *Lbank) CancelAllOrders(ctx context.Context, orders *order.Cancel) (order.CancelAllResponse, error) {
 	var resp order.CancelAllResponse
-	orderIDs, err := l.getAllOpenOrderID()
+	orderIDs, err := l.getAllOpenOrderID(ctx)
 	if err != nil {
 		return resp, nil
 	}
@@ -371,7 +397,7 @@ func (l *Lbank) CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse,
 				tempSlice = append(tempSlice, orderIDs[key][y])
 				if y%3 == 0 {
 					input = strings.Join(tempSlice, ",")
-					CancelResponse, err2 := l.RemoveOrder(key, input)
+					CancelResponse, err2 := l.RemoveOrder(ctx, key, input)
 					if err2 != nil {
 						return resp, err2
 					}
@@ -389,7 +415,7 @@ func (l *Lbank) CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse,
 				y++
 			}
 			input = strings.Join(tempSlice, ",")
-			CancelResponse, err2 := l.RemoveOrder(key, input)
+			CancelResponse, err2 := l.RemoveOrder(ctx, key, input)
 			if err2 != nil {
 				return resp, err2
 			}
@@ -408,9 +434,9 @@ func (l *Lbank) CancelAllOrders(orders *order.Cancel) (order.CancelAllResponse,
 }
 
 // GetOrderInfo returns information on a current open order
-func (l *Lbank) GetOrderInfo(orderID string) (order.Detail, error) {
+func (l *Lbank) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var resp order.Detail
-	orderIDs, err := l.getAllOpenOrderID()
+	orderIDs, err := l.getAllOpenOrderID(ctx)
 	if err != nil {
 		return resp, err
 	}
@@ -420,7 +446,7 @@ func (l *Lbank) GetOrderInfo(orderID string) (order.Detail, error) {
 			if val[i] != orderID {
 				continue
 			}
-			tempResp, err := l.QueryOrder(key, orderID)
+			tempResp, err := l.QueryOrder(ctx, key, orderID)
 			if err != nil {
 				return resp, err
 			}
@@ -446,31 +472,33 @@ func (l *Lbank) GetOrderInfo(orderID string) (order.Detail, error) {
 			default:
 				resp.Status = "Invalid Order Status"
 			}
-			resp.Price = tempResp.Orders[0].Price
-			resp.Amount = tempResp.Orders[0].Amount
-			resp.ExecutedAmount = tempResp.Orders[0].DealAmount
-			resp.RemainingAmount = tempResp.Orders[0].Amount - tempResp.Orders[0].DealAmount
-			resp.Fee, err = l.GetFeeByType(&exchange.FeeBuilder{
+			resp.Price = decimal.NewFromFloat(tempResp.Orders[0].Price)
+			resp.Amount = decimal.NewFromFloat(tempResp.Orders[0].Amount)
+			resp.ExecutedAmount = decimal.NewFromFloat(tempResp.Orders[0].DealAmount)
+			resp.RemainingAmount = decimal.NewFromFloat(tempResp.Orders[0].Amount).Sub(decimal.NewFromFloat(tempResp.Orders[0].DealAmount))
+			var fee float64
+			fee, err = l.GetFeeByType(ctx, &exchange.FeeBuilder{
 				FeeType:       exchange.CryptocurrencyTradeFee,
 				Amount:        tempResp.Orders[0].Amount,
 				PurchasePrice: tempResp.Orders[0].Price})
 			if err != nil {
-				resp.Fee = lbankFeeNotFound
+				fee = lbankFeeNotFound
 			}
+			resp.Fee = decimal.NewFromFloat(fee)
 		}
 	}
 	return resp, nil
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (l *Lbank) GetDepositAddress(cryptocurrency currency.Code, accountID string) (string, error) {
+func (l *Lbank) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, accountID string) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *Lbank) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	resp, err := l.Withdraw(withdrawRequest.Address, withdrawRequest.Currency.String(),
+func (l *Lbank) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	resp, err := l.Withdraw(ctx, withdrawRequest.Address, withdrawRequest.Currency.String(),
 		strconv.FormatFloat(withdrawRequest.Amount, 'f', -1, 64), "",
 		withdrawRequest.Description, "")
 	return resp.WithdrawID, err
@@ -478,13 +506,13 @@ func (l *Lbank) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequ
 
 // WithdrawFiatFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *Lbank) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (l *Lbank) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *Lbank) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (l *Lbank) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -494,17 +522,17 @@ func (l *Lbank) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (l *Lbank) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (l *Lbank) GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	var finalResp []order.Detail
 	var resp order.Detail
-	tempData, err := l.getAllOpenOrderID()
+	tempData, err := l.getAllOpenOrderID(ctx)
 	if err != nil {
 		return finalResp, err
 	}
 
 	for key, val := range tempData {
 		for x := range val {
-			tempResp, err := l.QueryOrder(key, val[x])
+			tempResp, err := l.QueryOrder(ctx, key, val[x])
 			if err != nil {
 				return finalResp, err
 			}
@@ -530,18 +558,20 @@ func (l *Lbank) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]ord
 			default:
 				resp.Status = "Invalid Order Status"
 			}
-			resp.Price = tempResp.Orders[0].Price
-			resp.Amount = tempResp.Orders[0].Amount
-			resp.OrderDate = time.Unix(tempResp.Orders[0].CreateTime, 9)
-			resp.ExecutedAmount = tempResp.Orders[0].DealAmount
-			resp.RemainingAmount = tempResp.Orders[0].Amount - tempResp.Orders[0].DealAmount
-			resp.Fee, err = l.GetFeeByType(&exchange.FeeBuilder{
+			resp.Price = decimal.NewFromFloat(tempResp.Orders[0].Price)
+			resp.Amount = decimal.NewFromFloat(tempResp.Orders[0].Amount)
+			resp.OrderDate = convert.TimeFromUnix(tempResp.Orders[0].CreateTime, time.Millisecond)
+			resp.ExecutedAmount = decimal.NewFromFloat(tempResp.Orders[0].DealAmount)
+			resp.RemainingAmount = decimal.NewFromFloat(tempResp.Orders[0].Amount).Sub(decimal.NewFromFloat(tempResp.Orders[0].DealAmount))
+			var fee float64
+			fee, err = l.GetFeeByType(ctx, &exchange.FeeBuilder{
 				FeeType:       exchange.CryptocurrencyTradeFee,
 				Amount:        tempResp.Orders[0].Amount,
 				PurchasePrice: tempResp.Orders[0].Price})
 			if err != nil {
-				resp.Fee = lbankFeeNotFound
+				fee = lbankFeeNotFound
 			}
+			resp.Fee = decimal.NewFromFloat(fee)
 			for y := int(0); y < len(getOrdersRequest.Currencies); y++ {
 				if getOrdersRequest.Currencies[y].String() != key {
 					continue
@@ -562,7 +592,7 @@ func (l *Lbank) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]ord
 
 // GetOrderHistory retrieves account order information *
 // Can Limit response to specific order status
-func (l *Lbank) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (l *Lbank) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	var finalResp []order.Detail
 	var resp order.Detail
 	var tempCurr currency.Pairs
@@ -574,12 +604,12 @@ func (l *Lbank) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]ord
 	for a := range tempCurr {
 		p := l.FormatExchangeCurrency(tempCurr[a], asset.Spot).String()
 		b := int64(1)
-		tempResp, err := l.QueryOrderHistory(p, strconv.FormatInt(b, 10), "200")
+		tempResp, err := l.QueryOrderHistory(ctx, p, strconv.FormatInt(b, 10), "200")
 		if err != nil {
 			return finalResp, err
 		}
 		for len(tempResp.Orders) != 0 {
-			tempResp, err = l.QueryOrderHistory(p, strconv.FormatInt(b, 10), "200")
+			tempResp, err = l.QueryOrderHistory(ctx, p, strconv.FormatInt(b, 10), "200")
 			if err != nil {
 				return finalResp, err
 			}
@@ -606,18 +636,20 @@ func (l *Lbank) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]ord
 				default:
 					resp.Status = "Invalid Order Status"
 				}
-				resp.Price = tempResp.Orders[x].Price
-				resp.Amount = tempResp.Orders[x].Amount
-				resp.OrderDate = time.Unix(tempResp.Orders[x].CreateTime, 9)
-				resp.ExecutedAmount = tempResp.Orders[x].DealAmount
-				resp.RemainingAmount = tempResp.Orders[x].Price - tempResp.Orders[x].DealAmount
-				resp.Fee, err = l.GetFeeByType(&exchange.FeeBuilder{
+				resp.Price = decimal.NewFromFloat(tempResp.Orders[x].Price)
+				resp.Amount = decimal.NewFromFloat(tempResp.Orders[x].Amount)
+				resp.OrderDate = convert.TimeFromUnix(tempResp.Orders[x].CreateTime, time.Millisecond)
+				resp.ExecutedAmount = decimal.NewFromFloat(tempResp.Orders[x].DealAmount)
+				resp.RemainingAmount = decimal.NewFromFloat(tempResp.Orders[x].Price).Sub(decimal.NewFromFloat(tempResp.Orders[x].DealAmount))
+				var fee float64
+				fee, err = l.GetFeeByType(ctx, &exchange.FeeBuilder{
 					FeeType:       exchange.CryptocurrencyTradeFee,
 					Amount:        tempResp.Orders[x].Amount,
 					PurchasePrice: tempResp.Orders[x].Price})
 				if err != nil {
-					resp.Fee = lbankFeeNotFound
+					fee = lbankFeeNotFound
 				}
+				resp.Fee = decimal.NewFromFloat(fee)
 				finalResp = append(finalResp, resp)
 				b++
 			}
@@ -627,13 +659,13 @@ func (l *Lbank) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]ord
 }
 
 // GetFeeByType returns an estimate of fee based on the type of transaction *
-func (l *Lbank) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (l *Lbank) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	var resp float64
 	if feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		return feeBuilder.Amount * feeBuilder.PurchasePrice * 0.002, nil
 	}
 	if feeBuilder.FeeType == exchange.CryptocurrencyWithdrawalFee {
-		withdrawalFee, err := l.GetWithdrawConfig(feeBuilder.Pair.Base.Lower().String())
+		withdrawalFee, err := l.GetWithdrawConfig(ctx, feeBuilder.Pair.Base.Lower().String())
 		if err != nil {
 			return resp, err
 		}
@@ -653,19 +685,19 @@ func (l *Lbank) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 }
 
 // GetAllOpenOrderID returns all open orders by currency pairs
-func (l *Lbank) getAllOpenOrderID() (map[string][]string, error) {
+func (l *Lbank) getAllOpenOrderID(ctx context.Context) (map[string][]string, error) {
 	allPairs := l.GetEnabledPairs(asset.Spot)
 	resp := make(map[string][]string)
 	for a := range allPairs {
 		p := l.FormatExchangeCurrency(allPairs[a], asset.Spot).String()
 		b := int64(1)
-		tempResp, err := l.GetOpenOrders(p, strconv.FormatInt(b, 10), "200")
+		tempResp, err := l.GetOpenOrders(ctx, p, strconv.FormatInt(b, 10), "200")
 		if err != nil {
 			return resp, err
 		}
 		tempData := len(tempResp.Orders)
 		for tempData != 0 {
-			tempResp, err = l.GetOpenOrders(p, strconv.FormatInt(b, 10), "200")
+			tempResp, err = l.GetOpenOrders(ctx, p, strconv.FormatInt(b, 10), "200")
 			if err != nil {
 				return resp, err
 			}
@@ -708,7 +740,12 @@ func (l *Lbank) GetSubscriptions() ([]wshandler.WebsocketChannelSubscription, er
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (l *Lbank) ValidateCredentials() error {
-	_, err := l.UpdateAccountInfo()
+func (l *Lbank) ValidateCredentials(ctx context.Context) error {
+	_, err := l.UpdateAccountInfo(ctx)
 	return l.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (l *Lbank) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
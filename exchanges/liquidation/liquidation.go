@@ -0,0 +1,190 @@
+package liquidation
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func init() {
+	service = new(Service)
+	service.Streams = make(map[key]*stream)
+	service.Exchange = make(map[string]uuid.UUID)
+	service.mux = dispatch.GetNewMux()
+}
+
+// SubscribeLiquidations subscribes to the liquidations of a pair and returns
+// a communication channel to stream them
+func SubscribeLiquidations(exchange string, p currency.Pair, a asset.Item) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+
+	s, ok := service.Streams[key{exchange, p.Base.Item, p.Quote.Item, a}]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("liquidations not found for %s %s %s",
+			exchange,
+			p,
+			a)
+	}
+
+	return service.mux.Subscribe(s.Main)
+}
+
+// SubscribeToExchangeLiquidations subscribes to all liquidations on an
+// exchange
+func SubscribeToExchangeLiquidations(exchange string) (dispatch.Pipe, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+	id, ok := service.Exchange[exchange]
+	if !ok {
+		return dispatch.Pipe{}, fmt.Errorf("%s exchange liquidations not found",
+			exchange)
+	}
+
+	return service.mux.Subscribe(id)
+}
+
+// SubscribeToAllLiquidations subscribes to the liquidations of every
+// exchange
+func SubscribeToAllLiquidations() (dispatch.Pipe, error) {
+	service.RLock()
+	defer service.RUnlock()
+	if service.All == (uuid.UUID{}) {
+		return dispatch.Pipe{}, errors.New(errNoLiquidationsSeen)
+	}
+
+	return service.mux.Subscribe(service.All)
+}
+
+// GetStats summarises the liquidations of a pair over the window leading up
+// to now, which is capped at the retention period
+func GetStats(exchange string, p currency.Pair, a asset.Item, window time.Duration) (Stats, error) {
+	exchange = strings.ToLower(exchange)
+	service.RLock()
+	defer service.RUnlock()
+
+	s, ok := service.Streams[key{exchange, p.Base.Item, p.Quote.Item, a}]
+	if !ok {
+		return Stats{}, fmt.Errorf("no %s %s %s liquidations",
+			exchange,
+			p,
+			a)
+	}
+	if window > Retention {
+		window = Retention
+	}
+	end := time.Now()
+	return summarise(s.recent, end.Add(-window), end), nil
+}
+
+// summarise totals liquidations from start onwards by the side of the
+// position liquidated
+func summarise(liquidations []Liquidation, start, end time.Time) Stats {
+	stats := Stats{Start: start, End: end}
+	for i := range liquidations {
+		if liquidations[i].Timestamp.Before(start) {
+			continue
+		}
+		stats.Count++
+		if liquidations[i].Side == order.Sell {
+			stats.LongAmount += liquidations[i].Amount
+			stats.LongValue += liquidations[i].Amount * liquidations[i].Price
+		} else {
+			stats.ShortAmount += liquidations[i].Amount
+			stats.ShortValue += liquidations[i].Amount * liquidations[i].Price
+		}
+	}
+	return stats
+}
+
+// ProcessLiquidation validates an incoming liquidation and publishes it
+func ProcessLiquidation(l *Liquidation) error {
+	if l == nil {
+		return errors.New(errLiquidationIsNil)
+	}
+
+	if l.Exchange == "" {
+		return errors.New(errExchangeNameUnset)
+	}
+
+	l.Exchange = strings.ToLower(l.Exchange)
+
+	if l.Pair.IsEmpty() {
+		return fmt.Errorf("%s %s", l.Exchange, errPairNotSet)
+	}
+
+	if l.AssetType == "" {
+		return fmt.Errorf("%s %s %s", l.Exchange, l.Pair, errAssetTypeNotSet)
+	}
+
+	if l.Side != order.Buy && l.Side != order.Sell {
+		return fmt.Errorf("%s %s %s", l.Exchange, l.Pair, errSideInvalid)
+	}
+
+	if l.Amount <= 0 {
+		return fmt.Errorf("%s %s %s", l.Exchange, l.Pair, errAmountInvalid)
+	}
+
+	if l.Timestamp.IsZero() {
+		l.Timestamp = time.Now()
+	}
+
+	return service.Update(l)
+}
+
+// Update keeps a liquidation for its pair's statistics and publishes it
+func (s *Service) Update(l *Liquidation) error {
+	k := key{l.Exchange, l.Pair.Base.Item, l.Pair.Quote.Item, l.AssetType}
+
+	s.Lock()
+	if s.All == (uuid.UUID{}) {
+		allID, err := s.mux.GetID()
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		s.All = allID
+	}
+	item, ok := s.Streams[k]
+	if !ok {
+		exchangeID, ok := s.Exchange[l.Exchange]
+		if !ok {
+			var err error
+			exchangeID, err = s.mux.GetID()
+			if err != nil {
+				s.Unlock()
+				return err
+			}
+			s.Exchange[l.Exchange] = exchangeID
+		}
+		id, err := s.mux.GetID()
+		if err != nil {
+			s.Unlock()
+			return err
+		}
+		item = &stream{Main: id, Assoc: []uuid.UUID{exchangeID, s.All}}
+		s.Streams[k] = item
+	}
+	item.recent = append(prune(item.recent, l.Timestamp.Add(-Retention)), *l)
+	ids := append([]uuid.UUID{item.Main}, item.Assoc...)
+	s.Unlock()
+	return s.mux.Publish(ids, l)
+}
+
+// prune drops liquidations before the cutoff
+func prune(liquidations []Liquidation, cutoff time.Time) []Liquidation {
+	i := 0
+	for i < len(liquidations) && liquidations[i].Timestamp.Before(cutoff) {
+		i++
+	}
+	return liquidations[i:]
+}
@@ -0,0 +1,165 @@
+package liquidation
+
+import (
+	"log"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+func TestMain(m *testing.M) {
+	err := dispatch.Start(1, dispatch.DefaultJobsLimit)
+	if err != nil {
+		log.Fatal(err)
+	}
+	os.Exit(m.Run())
+}
+
+func TestProcessLiquidation(t *testing.T) {
+	if err := ProcessLiquidation(nil); err == nil {
+		t.Error("expected error on nil liquidation")
+	}
+	if err := ProcessLiquidation(&Liquidation{}); err == nil {
+		t.Error("expected error on unset exchange")
+	}
+	if err := ProcessLiquidation(&Liquidation{Exchange: "test"}); err == nil {
+		t.Error("expected error on unset pair")
+	}
+	p := currency.NewPair(currency.XBT, currency.USD)
+	if err := ProcessLiquidation(&Liquidation{Exchange: "test", Pair: p}); err == nil {
+		t.Error("expected error on unset asset")
+	}
+	l := Liquidation{Exchange: "test", Pair: p, AssetType: asset.PerpetualContract}
+	if err := ProcessLiquidation(&l); err == nil {
+		t.Error("expected error on unset side")
+	}
+	l.Side = order.Sell
+	if err := ProcessLiquidation(&l); err == nil {
+		t.Error("expected error on unset amount")
+	}
+	l.Amount = 100
+	l.Price = 9000
+	if err := ProcessLiquidation(&l); err != nil {
+		t.Fatal(err)
+	}
+	if l.Timestamp.IsZero() {
+		t.Error("expected the timestamp to be set")
+	}
+}
+
+func TestGetStats(t *testing.T) {
+	p := currency.NewPair(currency.ETH, currency.USD)
+	if _, err := GetStats("statstest", p, asset.PerpetualContract, time.Minute); err == nil {
+		t.Fatal("expected error on unknown stream")
+	}
+
+	now := time.Now()
+	for _, l := range []Liquidation{
+		{Side: order.Sell, Price: 200, Amount: 10, Timestamp: now.Add(-2 * time.Hour)},
+		{Side: order.Sell, Price: 190, Amount: 5, Timestamp: now.Add(-30 * time.Minute)},
+		{Side: order.Sell, Price: 180, Amount: 10, Timestamp: now.Add(-time.Minute)},
+		{Side: order.Buy, Price: 185, Amount: 2, Timestamp: now.Add(-time.Second)},
+	} {
+		l.Exchange = "StatsTest"
+		l.Pair = p
+		l.AssetType = asset.PerpetualContract
+		if err := ProcessLiquidation(&l); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	stats, err := GetStats("statstest", p, asset.PerpetualContract, 5*time.Minute)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Count != 2 || stats.LongAmount != 10 || stats.LongValue != 1800 ||
+		stats.ShortAmount != 2 || stats.ShortValue != 370 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+
+	// Liquidations beyond the retention period are dropped
+	stats, err = GetStats("statstest", p, asset.PerpetualContract, 24*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if stats.Count != 3 || stats.LongAmount != 15 {
+		t.Errorf("unexpected stats %+v", stats)
+	}
+}
+
+func TestSubscribeLiquidations(t *testing.T) {
+	p := currency.NewPair(currency.BTC, currency.USDT)
+	_, err := SubscribeLiquidations("subscribetest", p, asset.PerpetualContract)
+	if err == nil {
+		t.Fatal("expected error on unknown stream")
+	}
+	_, err = SubscribeToExchangeLiquidations("subscribetest")
+	if err == nil {
+		t.Fatal("expected error on unknown exchange")
+	}
+
+	l := Liquidation{
+		Exchange:  "subscribetest",
+		Pair:      p,
+		AssetType: asset.PerpetualContract,
+		Side:      order.Buy,
+		Amount:    1,
+	}
+	err = ProcessLiquidation(&l)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pipe, err := SubscribeLiquidations("subscribetest", p, asset.PerpetualContract)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pipe.Release()
+
+	exchPipe, err := SubscribeToExchangeLiquidations("subscribetest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer exchPipe.Release()
+
+	allPipe, err := SubscribeToAllLiquidations()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer allPipe.Release()
+
+	// Dispatch only hands data to pipes that are ready to receive so keep
+	// publishing until every pipe has seen the liquidation
+	received := make(chan struct{}, 3)
+	for _, ch := range []chan interface{}{pipe.C, exchPipe.C, allPipe.C} {
+		go func(ch chan interface{}) {
+			for data := range ch {
+				if (*data.(*interface{})).(Liquidation).Price == 10 {
+					received <- struct{}{}
+					return
+				}
+			}
+		}(ch)
+	}
+
+	l.Price = 10
+	timeout := time.After(time.Second)
+	for count := 0; count < 3; {
+		select {
+		case <-received:
+			count++
+		case <-timeout:
+			t.Fatal("timed out waiting for liquidation")
+		case <-time.After(time.Millisecond):
+			err = ProcessLiquidation(&l)
+			if err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package liquidation
+
+import (
+	"sync"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/dispatch"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// const values for the liquidation package
+const (
+	errExchangeNameUnset  = "liquidation exchange name not set"
+	errPairNotSet         = "liquidation currency pair not set"
+	errAssetTypeNotSet    = "liquidation asset type not set"
+	errSideInvalid        = "liquidation side must be buy or sell"
+	errAmountInvalid      = "liquidation amount must be positive"
+	errLiquidationIsNil   = "liquidation is nil"
+	errNoLiquidationsSeen = "no liquidations seen"
+
+	// Retention is how long liquidations are kept for their statistics
+	Retention = time.Hour
+)
+
+var service *Service
+
+// Service publishes liquidations streamed by exchange websockets and keeps
+// the recent ones for their statistics
+type Service struct {
+	Streams  map[key]*stream
+	Exchange map[string]uuid.UUID
+	All      uuid.UUID
+	mux      *dispatch.Mux
+	sync.RWMutex
+}
+
+// key identifies the liquidations of a pair
+type key struct {
+	exchange string
+	base     *currency.Item
+	quote    *currency.Item
+	asset    asset.Item
+}
+
+// stream holds the recent liquidations of a pair and its dispatch IDs
+type stream struct {
+	recent []Liquidation
+	Main   uuid.UUID
+	Assoc  []uuid.UUID
+}
+
+// Liquidation is a forced close of a position normalised across exchanges.
+// Side is that of the liquidation order, so a sell liquidates a long
+// position and a buy liquidates a short
+type Liquidation struct {
+	Exchange  string        `json:"exchange"`
+	Pair      currency.Pair `json:"pair"`
+	AssetType asset.Item    `json:"assetType"`
+	Side      order.Side    `json:"side"`
+	Price     float64       `json:"price"`
+	Amount    float64       `json:"amount"`
+	Timestamp time.Time     `json:"timestamp"`
+}
+
+// Stats summarises the liquidations of a pair over a window. Cascading
+// liquidations of one side are a sign of volatility
+type Stats struct {
+	Count       int
+	LongAmount  float64
+	ShortAmount float64
+	LongValue   float64
+	ShortValue  float64
+	Start       time.Time
+	End         time.Time
+}
@@ -1,6 +1,7 @@
 package localbitcoins
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"math"
@@ -10,6 +11,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -39,7 +41,7 @@ func (l *LocalBitcoins) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if l.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = l.UpdateTradablePairs(true)
+		err = l.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -133,15 +135,15 @@ func (l *LocalBitcoins) Run() {
 		return
 	}
 
-	err := l.UpdateTradablePairs(false)
+	err := l.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", l.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (l *LocalBitcoins) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	currencies, err := l.GetTradableCurrencies()
+func (l *LocalBitcoins) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	currencies, err := l.GetTradableCurrencies(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -156,8 +158,8 @@ func (l *LocalBitcoins) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (l *LocalBitcoins) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := l.FetchTradablePairs(asset.Spot)
+func (l *LocalBitcoins) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := l.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -165,9 +167,9 @@ func (l *LocalBitcoins) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (l *LocalBitcoins) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (l *LocalBitcoins) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tick, err := l.GetTicker()
+	tick, err := l.GetTicker(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -193,27 +195,27 @@ func (l *LocalBitcoins) UpdateTicker(p currency.Pair, assetType asset.Item) (*ti
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (l *LocalBitcoins) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (l *LocalBitcoins) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(l.Name, p, assetType)
 	if err != nil {
-		return l.UpdateTicker(p, assetType)
+		return l.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (l *LocalBitcoins) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (l *LocalBitcoins) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(l.Name, p, assetType)
 	if err != nil {
-		return l.UpdateOrderbook(p, assetType)
+		return l.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (l *LocalBitcoins) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (l *LocalBitcoins) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := l.GetOrderbook(p.Quote.String())
+	orderbookNew, err := l.GetOrderbook(ctx, p.Quote.String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -246,16 +248,16 @@ func (l *LocalBitcoins) UpdateOrderbook(p currency.Pair, assetType asset.Item) (
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // LocalBitcoins exchange
-func (l *LocalBitcoins) UpdateAccountInfo() (account.Holdings, error) {
+func (l *LocalBitcoins) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = l.Name
-	accountBalance, err := l.GetWalletBalance()
+	accountBalance, err := l.GetWalletBalance(ctx)
 	if err != nil {
 		return response, err
 	}
 	var exchangeCurrency account.Balance
 	exchangeCurrency.CurrencyName = currency.BTC
-	exchangeCurrency.TotalValue = accountBalance.Total.Balance
+	exchangeCurrency.TotalValue = decimal.NewFromFloat(accountBalance.Total.Balance)
 
 	response.Accounts = append(response.Accounts, account.SubAccount{
 		Currencies: []account.Balance{exchangeCurrency},
@@ -270,10 +272,10 @@ func (l *LocalBitcoins) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (l *LocalBitcoins) FetchAccountInfo() (account.Holdings, error) {
+func (l *LocalBitcoins) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(l.Name)
 	if err != nil {
-		return l.UpdateAccountInfo()
+		return l.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -281,17 +283,40 @@ func (l *LocalBitcoins) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (l *LocalBitcoins) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (l *LocalBitcoins) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (l *LocalBitcoins) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (l *LocalBitcoins) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (l *LocalBitcoins) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (l *LocalBitcoins) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (l *LocalBitcoins) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (l *LocalBitcoins) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (l *LocalBitcoins) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (l *LocalBitcoins) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -316,11 +341,11 @@ func (l *LocalBitcoins) SubmitOrder(s *order.Submit) (order.SubmitResponse, erro
 		RequireIdentification:      true,
 		OnlineProvider:             "",
 		TradeType:                  "",
-		MinAmount:                  int(math.Round(s.Amount)),
+		MinAmount:                  int(math.Round(s.Amount.Float64())),
 	}
 
 	// Does not return any orderID, so create the add, then get the order
-	err := l.CreateAd(&params)
+	err := l.CreateAd(ctx, &params)
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -330,7 +355,7 @@ func (l *LocalBitcoins) SubmitOrder(s *order.Submit) (order.SubmitResponse, erro
 	// Now to figure out what ad we just submitted
 	// The only details we have are the params above
 	var adID string
-	ads, err := l.Getads()
+	ads, err := l.Getads(ctx)
 	for i := range ads.AdList {
 		if ads.AdList[i].Data.PriceEquation == params.PriceEquation &&
 			ads.AdList[i].Data.Lat == float64(params.Latitude) &&
@@ -362,28 +387,28 @@ func (l *LocalBitcoins) SubmitOrder(s *order.Submit) (order.SubmitResponse, erro
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (l *LocalBitcoins) ModifyOrder(action *order.Modify) (string, error) {
+func (l *LocalBitcoins) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (l *LocalBitcoins) CancelOrder(order *order.Cancel) error {
-	return l.DeleteAd(order.OrderID)
+func (l *LocalBitcoins) CancelOrder(ctx context.Context, order *order.Cancel) error {
+	return l.DeleteAd(ctx, order.OrderID)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (l *LocalBitcoins) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (l *LocalBitcoins) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	ads, err := l.Getads()
+	ads, err := l.Getads(ctx)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
 
 	for i := range ads.AdList {
 		adIDString := strconv.FormatInt(ads.AdList[i].Data.AdID, 10)
-		err = l.DeleteAd(adIDString)
+		err = l.DeleteAd(ctx, adIDString)
 		if err != nil {
 			cancelAllOrdersResponse.Status[adIDString] = err.Error()
 		}
@@ -393,39 +418,39 @@ func (l *LocalBitcoins) CancelAllOrders(_ *order.Cancel) (order.CancelAllRespons
 }
 
 // GetOrderInfo returns information on a current open order
-func (l *LocalBitcoins) GetOrderInfo(orderID string) (order.Detail, error) {
+func (l *LocalBitcoins) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (l *LocalBitcoins) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
+func (l *LocalBitcoins) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
 	if !strings.EqualFold(currency.BTC.String(), cryptocurrency.String()) {
 		return "", fmt.Errorf("%s does not have support for currency %s, it only supports bitcoin",
 			l.Name, cryptocurrency)
 	}
 
-	return l.GetWalletAddress()
+	return l.GetWalletAddress(ctx)
 }
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (l *LocalBitcoins) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
+func (l *LocalBitcoins) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
 	return "",
-		l.WalletSend(withdrawRequest.Address,
+		l.WalletSend(ctx, withdrawRequest.Address,
 			withdrawRequest.Amount,
 			withdrawRequest.PIN)
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (l *LocalBitcoins) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (l *LocalBitcoins) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (l *LocalBitcoins) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (l *LocalBitcoins) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -435,7 +460,7 @@ func (l *LocalBitcoins) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (l *LocalBitcoins) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (l *LocalBitcoins) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if (!l.AllowAuthenticatedRequest() || l.SkipAuthCheck) && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -444,8 +469,8 @@ func (l *LocalBitcoins) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64,
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (l *LocalBitcoins) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := l.GetDashboardInfo()
+func (l *LocalBitcoins) GetActiveOrders(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := l.GetDashboardInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -469,11 +494,11 @@ func (l *LocalBitcoins) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest
 		}
 
 		orders = append(orders, order.Detail{
-			Amount:    resp[i].Data.AmountBTC,
-			Price:     resp[i].Data.Amount,
+			Amount:    decimal.NewFromFloat(resp[i].Data.AmountBTC),
+			Price:     decimal.NewFromFloat(resp[i].Data.Amount),
 			ID:        strconv.FormatInt(int64(resp[i].Data.Advertisement.ID), 10),
 			OrderDate: orderDate,
-			Fee:       resp[i].Data.FeeBTC,
+			Fee:       decimal.NewFromFloat(resp[i].Data.FeeBTC),
 			OrderSide: side,
 			CurrencyPair: currency.NewPairWithDelimiter(currency.BTC.String(),
 				resp[i].Data.Currency,
@@ -491,21 +516,21 @@ func (l *LocalBitcoins) GetActiveOrders(getOrdersRequest *order.GetOrdersRequest
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (l *LocalBitcoins) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
+func (l *LocalBitcoins) GetOrderHistory(ctx context.Context, getOrdersRequest *order.GetOrdersRequest) ([]order.Detail, error) {
 	var allTrades []DashBoardInfo
-	resp, err := l.GetDashboardCancelledTrades()
+	resp, err := l.GetDashboardCancelledTrades(ctx)
 	if err != nil {
 		return nil, err
 	}
 	allTrades = append(allTrades, resp...)
 
-	resp, err = l.GetDashboardClosedTrades()
+	resp, err = l.GetDashboardClosedTrades(ctx)
 	if err != nil {
 		return nil, err
 	}
 	allTrades = append(allTrades, resp...)
 
-	resp, err = l.GetDashboardReleasedTrades()
+	resp, err = l.GetDashboardReleasedTrades(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -545,11 +570,11 @@ func (l *LocalBitcoins) GetOrderHistory(getOrdersRequest *order.GetOrdersRequest
 		}
 
 		orders = append(orders, order.Detail{
-			Amount:    allTrades[i].Data.AmountBTC,
-			Price:     allTrades[i].Data.Amount,
+			Amount:    decimal.NewFromFloat(allTrades[i].Data.AmountBTC),
+			Price:     decimal.NewFromFloat(allTrades[i].Data.Amount),
 			ID:        strconv.FormatInt(int64(allTrades[i].Data.Advertisement.ID), 10),
 			OrderDate: orderDate,
-			Fee:       allTrades[i].Data.FeeBTC,
+			Fee:       decimal.NewFromFloat(allTrades[i].Data.FeeBTC),
 			OrderSide: side,
 			Status:    order.Status(status),
 			CurrencyPair: currency.NewPairWithDelimiter(currency.BTC.String(),
@@ -590,7 +615,12 @@ func (l *LocalBitcoins) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (l *LocalBitcoins) ValidateCredentials() error {
-	_, err := l.UpdateAccountInfo()
+func (l *LocalBitcoins) ValidateCredentials(ctx context.Context) error {
+	_, err := l.UpdateAccountInfo(ctx)
 	return l.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (l *LocalBitcoins) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -46,17 +46,34 @@ func HTTPRecord(res *http.Response, service string, respContents []byte) error {
 	}
 	service = strings.ToLower(service)
 
-	fileout := filepath.Join(DefaultDirectory, service, service+".json")
+	return HTTPRecordFile(res, filepath.Join(DefaultDirectory, service, service+".json"), respContents)
+}
 
-	contents, err := ioutil.ReadFile(fileout)
-	if err != nil {
-		return err
+// HTTPRecordFile records the request and response to the mock file at
+// fileout, creating it if it doesn't exist
+func HTTPRecordFile(res *http.Response, fileout string, respContents []byte) error {
+	if res == nil {
+		return errors.New("http.Response cannot be nil")
+	}
+
+	if res.Request == nil {
+		return errors.New("http.Request cannot be nil")
 	}
 
+	recordMtx.Lock()
+	defer recordMtx.Unlock()
+
 	var m VCRMock
-	err = json.Unmarshal(contents, &m)
+	contents, err := ioutil.ReadFile(fileout)
 	if err != nil {
-		return err
+		if !os.IsNotExist(err) {
+			return err
+		}
+	} else {
+		err = json.Unmarshal(contents, &m)
+		if err != nil {
+			return err
+		}
 	}
 
 	if m.Routes == nil {
@@ -374,6 +391,7 @@ func IsExcluded(key string, excludedVars []string) bool {
 
 var excludedList Exclusion
 var m sync.Mutex
+var recordMtx sync.Mutex
 var set bool
 var exclusionFile = DefaultDirectory + "exclusion.json"
 
@@ -416,8 +434,10 @@ func GetExcludedItems() (Exclusion, error) {
 				return excludedList, mErr
 			}
 
+			// Recording outside the repository has no testdata directory to
+			// save the defaults to, they are still applied
 			mErr = ioutil.WriteFile(exclusionFile, data, os.ModePerm)
-			if mErr != nil {
+			if mErr != nil && !os.IsNotExist(mErr) {
 				return excludedList, mErr
 			}
 		} else {
@@ -1,6 +1,7 @@
 package mock
 
 import (
+	"bytes"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -107,117 +108,109 @@ func RegisterHandler(pattern string, mock map[string][]HTTPResponse, mux *http.S
 				r.Method)
 		}
 
-		switch r.Method {
-		case http.MethodGet:
-			vals, err := url.ParseRequestURI(r.RequestURI)
-			if err != nil {
-				log.Fatal("Mock Test Failure - Parse request URI error", err)
-			}
-
-			payload, err := MatchAndGetResponse(httpResponses, vals.Query(), true)
-			if err != nil {
-				log.Fatalf("Mock Test Failure - MatchAndGetResponse error %s for %s",
-					err, r.RequestURI)
-			}
-
-			MessageWriteJSON(w, http.StatusOK, payload)
-			return
-
-		case http.MethodPost:
-			switch r.Header.Get(contentType) {
-			case applicationURLEncoded:
-				readBody, err := ioutil.ReadAll(r.Body)
-				if err != nil {
-					log.Fatal("Mock Test Failure - ReadAll error", err)
-				}
-
-				vals, err := url.ParseQuery(string(readBody))
-				if err != nil {
-					log.Fatal("Mock Test Failure - parse query error", err)
-				}
-
-				payload, err := MatchAndGetResponse(httpResponses, vals, false)
-				if err != nil {
-					log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
-				}
-
-				MessageWriteJSON(w, http.StatusOK, payload)
-				return
-
-			case "":
-				payload, err := MatchAndGetResponse(httpResponses, r.URL.Query(), true)
-				if err != nil {
-					log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
-				}
-
-				MessageWriteJSON(w, http.StatusOK, payload)
-				return
-
-			case applicationJSON:
-				readBody, err := ioutil.ReadAll(r.Body)
-				if err != nil {
-					log.Fatalf("Mock Test Failure - %v", err)
-				}
-
-				reqVals, err := DeriveURLValsFromJSONMap(readBody)
-				if err != nil {
-					log.Fatalf("Mock Test Failure - %v", err)
-				}
-
-				payload, err := MatchAndGetResponse(httpResponses, reqVals, false)
-				if err != nil {
-					log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
-				}
+		vals, isQueryData, err := RequestValues(r)
+		if err != nil {
+			log.Fatalf("Mock Test Failure - %v", err)
+		}
 
-				MessageWriteJSON(w, http.StatusOK, payload)
-				return
+		payload, err := MatchAndGetResponse(httpResponses, vals, isQueryData)
+		if err != nil {
+			log.Fatalf("Mock Test Failure - MatchAndGetResponse error %s for %s",
+				err, r.RequestURI)
+		}
 
-			case textPlain:
-				headerData, ok := r.Header["X-Gemini-Payload"]
-				if !ok {
-					log.Fatal("Mock Test Failure - Cannot find header in request")
-				}
+		MessageWriteJSON(w, http.StatusOK, payload)
+	})
+}
 
-				base64data := strings.Join(headerData, "")
+// RequestValues returns the values a request is matched to its recorded
+// response by and whether they are query values rather than body parameters.
+// A read body is replaced so the request can still be sent.
+func RequestValues(r *http.Request) (url.Values, bool, error) {
+	switch r.Method {
+	case http.MethodGet, http.MethodDelete:
+		return r.URL.Query(), true, nil
+
+	case http.MethodPost:
+		switch r.Header.Get(contentType) {
+		case applicationURLEncoded:
+			readBody, err := readRequestBody(r)
+			if err != nil {
+				return nil, false, err
+			}
 
-				jsonThings, err := crypto.Base64Decode(base64data)
-				if err != nil {
-					log.Fatal("Mock Test Failure - ", err)
-				}
+			vals, err := url.ParseQuery(string(readBody))
+			return vals, false, err
 
-				reqVals, err := DeriveURLValsFromJSONMap(jsonThings)
-				if err != nil {
-					log.Fatalf("Mock Test Failure - %v", err)
-				}
+		case "":
+			return r.URL.Query(), true, nil
 
-				payload, err := MatchAndGetResponse(httpResponses, reqVals, false)
-				if err != nil {
-					log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
-				}
+		case applicationJSON:
+			readBody, err := readRequestBody(r)
+			if err != nil {
+				return nil, false, err
+			}
 
-				MessageWriteJSON(w, http.StatusOK, payload)
-				return
+			vals, err := DeriveURLValsFromJSONMap(readBody)
+			return vals, false, err
 
-			default:
-				log.Fatalf("Mock Test Failure - Unhandled content type %v",
-					r.Header.Get(contentType))
+		case textPlain:
+			headerData, ok := r.Header["X-Gemini-Payload"]
+			if !ok {
+				return nil, false, errors.New("cannot find header in request")
 			}
 
-		case http.MethodDelete:
-			payload, err := MatchAndGetResponse(httpResponses, r.URL.Query(), true)
+			jsonThings, err := crypto.Base64Decode(strings.Join(headerData, ""))
 			if err != nil {
-				log.Println(r.URL.Query())
-				log.Fatal("Mock Test Failure - MatchAndGetResponse error ", err)
+				return nil, false, err
 			}
 
-			MessageWriteJSON(w, http.StatusOK, payload)
-			return
+			vals, err := DeriveURLValsFromJSONMap(jsonThings)
+			return vals, false, err
 
 		default:
-			log.Fatal("Mock Test Failure - Unhandled HTTP method:",
+			return nil, false, fmt.Errorf("unhandled content type %v",
 				r.Header.Get(contentType))
 		}
-	})
+
+	default:
+		return nil, false, fmt.Errorf("unhandled HTTP method %v", r.Method)
+	}
+}
+
+// readRequestBody reads a request's body and replaces it with a copy
+func readRequestBody(r *http.Request) ([]byte, error) {
+	if r.Body == nil {
+		return nil, nil
+	}
+	readBody, err := ioutil.ReadAll(r.Body)
+	r.Body.Close()
+	if err != nil {
+		return nil, err
+	}
+	r.Body = ioutil.NopCloser(bytes.NewReader(readBody))
+	return readBody, nil
+}
+
+// MatchRequest returns the response recorded in a mock file for a request
+func MatchRequest(m *VCRMock, r *http.Request) (json.RawMessage, error) {
+	methods, ok := m.Routes[r.URL.Path]
+	if !ok {
+		return nil, fmt.Errorf("no responses recorded for %s", r.URL.Path)
+	}
+	httpResponses, ok := methods[r.Method]
+	if !ok {
+		return nil, fmt.Errorf("no %s responses recorded for %s", r.Method, r.URL.Path)
+	}
+	vals, isQueryData, err := RequestValues(r)
+	if err != nil {
+		return nil, err
+	}
+	payload, err := MatchAndGetResponse(httpResponses, vals, isQueryData)
+	if err != nil {
+		return nil, fmt.Errorf("%v for %s %s", err, r.Method, r.URL.Path)
+	}
+	return payload, nil
 }
 
 // MessageWriteJSON writes JSON to a connection
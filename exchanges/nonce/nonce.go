@@ -7,8 +7,10 @@ import (
 
 // Nonce struct holds the nonce value
 type Nonce struct {
-	n int64
-	m sync.Mutex
+	n     int64
+	m     sync.Mutex
+	store Store
+	key   string
 }
 
 // Inc increments the nonce value
@@ -27,8 +29,10 @@ func (n *Nonce) Get() Value {
 
 // GetInc increments and returns the value of the nonce
 func (n *Nonce) GetInc() Value {
-	n.Inc()
-	return n.Get()
+	n.m.Lock()
+	defer n.m.Unlock()
+	n.n++
+	return Value(n.n)
 }
 
 // Set sets the nonce value
@@ -38,6 +42,43 @@ func (n *Nonce) Set(val int64) {
 	n.m.Unlock()
 }
 
+// Next returns a nonce greater than every nonce previously returned and no
+// less than start, which is typically the current time in the unit the
+// exchange expects. With a store set the nonce is saved before it is
+// returned, if saving fails the nonce is still returned as it is valid for
+// this process, but it may be reused after a restart.
+func (n *Nonce) Next(start int64) (Value, error) {
+	n.m.Lock()
+	defer n.m.Unlock()
+	next := n.n + 1
+	if start > next {
+		next = start
+	}
+	n.n = next
+	if n.store == nil {
+		return Value(next), nil
+	}
+	return Value(next), n.store.Save(n.key, next)
+}
+
+// SetStore persists nonces returned by Next under key, continuing from the
+// highest nonce saved by a previous process so quick restarts don't reuse
+// nonces the exchange has already seen
+func (n *Nonce) SetStore(s Store, key string) error {
+	last, err := s.Load(key)
+	if err != nil {
+		return err
+	}
+	n.m.Lock()
+	if last > n.n {
+		n.n = last
+	}
+	n.store = s
+	n.key = key
+	n.m.Unlock()
+	return nil
+}
+
 // String returns a string version of the nonce
 func (n *Nonce) String() string {
 	return n.Get().String()
@@ -1,6 +1,11 @@
 package nonce
 
 import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
 	"testing"
 	"time"
 )
@@ -78,3 +83,117 @@ func TestNonceConcurrency(t *testing.T) {
 		t.Errorf("Expected %d got %d", expected, result)
 	}
 }
+
+func TestNext(t *testing.T) {
+	var nonce Nonce
+	n, err := nonce.Next(100)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 100 {
+		t.Errorf("Expected %d got %d", 100, n)
+	}
+	// A start behind the current nonce must not move it backwards
+	n, err = nonce.Next(50)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 101 {
+		t.Errorf("Expected %d got %d", 101, n)
+	}
+}
+
+func TestNextConcurrency(t *testing.T) {
+	var nonce Nonce
+	var wg sync.WaitGroup
+	var m sync.Mutex
+	seen := make(map[Value]bool)
+	for i := 0; i < 1000; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			n, err := nonce.Next(1)
+			if err != nil {
+				t.Error(err)
+			}
+			m.Lock()
+			seen[n] = true
+			m.Unlock()
+		}()
+	}
+	wg.Wait()
+	if len(seen) != 1000 {
+		t.Errorf("Expected 1000 unique nonces got %d", len(seen))
+	}
+}
+
+func TestFileStore(t *testing.T) {
+	dir, err := ioutil.TempDir("", "nonce")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "nonces.json")
+	key := Key("test", "apikey")
+
+	s, err := NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var nonce Nonce
+	err = nonce.SetStore(s, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 10; i++ {
+		if _, err = nonce.Next(1000); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A restarted process seeding from an earlier time continues above the
+	// persisted nonce
+	s, err = NewFileStore(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var restarted Nonce
+	err = restarted.SetStore(s, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := restarted.Next(1000)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n != 1010 {
+		t.Errorf("Expected %d got %d", 1010, n)
+	}
+
+	var other Nonce
+	err = other.SetStore(s, Key("test", "otherkey"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if n, _ = other.Next(5); n != 5 {
+		t.Errorf("Expected %d got %d", 5, n)
+	}
+
+	err = ioutil.WriteFile(path, []byte("invalid"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = NewFileStore(path); err == nil {
+		t.Error("Expected error loading invalid nonce file")
+	}
+}
+
+func TestKey(t *testing.T) {
+	if k := Key("test", ""); k != "test" {
+		t.Errorf("Expected test got %s", k)
+	}
+	k := Key("test", "apikey")
+	if k == Key("test", "otherkey") || strings.Contains(k, "apikey") {
+		t.Errorf("unexpected key %s", k)
+	}
+}
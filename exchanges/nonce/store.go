@@ -0,0 +1,84 @@
+package nonce
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+// Store persists the highest nonce used for a key
+type Store interface {
+	Load(key string) (int64, error)
+	Save(key string, n int64) error
+}
+
+// FileStore is a Store holding the nonces of every key in a single JSON file
+type FileStore struct {
+	path   string
+	nonces map[string]int64
+	m      sync.Mutex
+}
+
+// NewFileStore returns a FileStore backed by the file at path, which is
+// created on the first save
+func NewFileStore(path string) (*FileStore, error) {
+	f := &FileStore{
+		path:   path,
+		nonces: make(map[string]int64),
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return f, nil
+		}
+		return nil, err
+	}
+	if len(data) == 0 {
+		return f, nil
+	}
+	return f, json.Unmarshal(data, &f.nonces)
+}
+
+// Load returns the highest nonce saved for key, or 0 if there is none
+func (f *FileStore) Load(key string) (int64, error) {
+	f.m.Lock()
+	defer f.m.Unlock()
+	return f.nonces[key], nil
+}
+
+// Save records n for key if it is higher than the saved nonce. The file is
+// written to a temporary file then renamed over the original so a crash
+// mid-write doesn't lose the nonces of other keys.
+func (f *FileStore) Save(key string, n int64) error {
+	f.m.Lock()
+	defer f.m.Unlock()
+	if n <= f.nonces[key] {
+		return nil
+	}
+	f.nonces[key] = n
+	data, err := json.Marshal(f.nonces)
+	if err != nil {
+		return err
+	}
+	tmp := f.path + ".tmp"
+	err = file.Write(tmp, data)
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, f.path)
+}
+
+// Key returns the store key for an exchange and API key. Exchanges track
+// nonces per API key, the API key is hashed so it isn't written to disk.
+func Key(exchange, apiKey string) string {
+	if apiKey == "" {
+		return exchange
+	}
+	sum := sha256.Sum256([]byte(apiKey))
+	return exchange + "_" + hex.EncodeToString(sum[:8])
+}
@@ -1,6 +1,7 @@
 package okcoin
 
 import (
+	"context"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -25,6 +26,6 @@ type OKCoin struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (o *OKCoin) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (o *OKCoin) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrFunctionNotSupported
 }
@@ -1,6 +1,7 @@
 package okcoin
 
 import (
+	"context"
 	"sync"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
@@ -29,7 +30,7 @@ func (o *OKCoin) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if o.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = o.UpdateTradablePairs(true)
+		err = o.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -93,6 +94,9 @@ func (o *OKCoin) SetDefaults() {
 				CryptoWithdrawal:    true,
 				TradeFee:            true,
 				CryptoWithdrawalFee: true,
+				ClientOrderID:       true,
+				ImmediateOrCancel:   true,
+				FillOrKill:          true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				TickerFetching:         true,
@@ -174,7 +178,7 @@ func (o *OKCoin) Run() {
 		return
 	}
 
-	err := o.UpdateTradablePairs(forceUpdate)
+	err := o.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -184,8 +188,8 @@ func (o *OKCoin) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (o *OKCoin) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	prods, err := o.GetSpotTokenPairDetails()
+func (o *OKCoin) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	prods, err := o.GetSpotTokenPairDetails(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -202,8 +206,8 @@ func (o *OKCoin) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (o *OKCoin) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := o.FetchTradablePairs(asset.Spot)
+func (o *OKCoin) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := o.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -213,10 +217,10 @@ func (o *OKCoin) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (o *OKCoin) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (o *OKCoin) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	var tickerData ticker.Price
 	if assetType == asset.Spot {
-		resp, err := o.GetSpotAllTokenPairsInformation()
+		resp, err := o.GetSpotAllTokenPairsInformation(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -249,10 +253,10 @@ func (o *OKCoin) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pr
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (o *OKCoin) FetchTicker(p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
+func (o *OKCoin) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
 	tickerData, err = ticker.GetTicker(o.Name, p, assetType)
 	if err != nil {
-		return o.UpdateTicker(p, assetType)
+		return o.UpdateTicker(ctx, p, assetType)
 	}
 	return
 }
@@ -1,6 +1,7 @@
 package okex
 
 import (
+	"context"
 	"encoding/json"
 	"fmt"
 	"log"
@@ -14,6 +15,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -95,14 +97,14 @@ func testStandardErrorHandling(t *testing.T, err error) {
 // TestGetAccountCurrencies API endpoint test
 func TestGetAccountCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountCurrencies()
+	_, err := o.GetAccountCurrencies(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWalletInformation API endpoint test
 func TestGetAccountWalletInformation(t *testing.T) {
 	t.Parallel()
-	resp, err := o.GetAccountWalletInformation("")
+	resp, err := o.GetAccountWalletInformation(context.Background(), "")
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -118,7 +120,7 @@ func TestGetAccountWalletInformation(t *testing.T) {
 // TestGetAccountWalletInformationForCurrency API endpoint test
 func TestGetAccountWalletInformationForCurrency(t *testing.T) {
 	t.Parallel()
-	resp, err := o.GetAccountWalletInformation(currency.BTC.String())
+	resp, err := o.GetAccountWalletInformation(context.Background(), currency.BTC.String())
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -142,7 +144,7 @@ func TestTransferAccountFunds(t *testing.T) {
 		To:       -1,
 	}
 
-	_, err := o.TransferAccountFunds(request)
+	_, err := o.TransferAccountFunds(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -159,14 +161,14 @@ func TestAccountWithdrawRequest(t *testing.T) {
 		Fee:         1,
 	}
 
-	_, err := o.AccountWithdraw(request)
+	_, err := o.AccountWithdraw(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWithdrawalFee API endpoint test
 func TestGetAccountWithdrawalFee(t *testing.T) {
 	t.Parallel()
-	resp, err := o.GetAccountWithdrawalFee("")
+	resp, err := o.GetAccountWithdrawalFee(context.Background(), "")
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -182,7 +184,7 @@ func TestGetAccountWithdrawalFee(t *testing.T) {
 // TestGetWithdrawalFeeForCurrency API endpoint test
 func TestGetAccountWithdrawalFeeForCurrency(t *testing.T) {
 	t.Parallel()
-	resp, err := o.GetAccountWithdrawalFee(currency.BTC.String())
+	resp, err := o.GetAccountWithdrawalFee(context.Background(), currency.BTC.String())
 	if areTestAPIKeysSet() {
 		if err != nil {
 			t.Error(err)
@@ -198,56 +200,56 @@ func TestGetAccountWithdrawalFeeForCurrency(t *testing.T) {
 // TestGetAccountWithdrawalHistory API endpoint test
 func TestGetAccountWithdrawalHistory(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountWithdrawalHistory("")
+	_, err := o.GetAccountWithdrawalHistory(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountWithdrawalHistoryForCurrency API endpoint test
 func TestGetAccountWithdrawalHistoryForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountWithdrawalHistory(currency.BTC.String())
+	_, err := o.GetAccountWithdrawalHistory(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountBillDetails API endpoint test
 func TestGetAccountBillDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountBillDetails(okgroup.GetAccountBillDetailsRequest{})
+	_, err := o.GetAccountBillDetails(context.Background(), okgroup.GetAccountBillDetailsRequest{})
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositAddressForCurrency API endpoint test
 func TestGetAccountDepositAddressForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountDepositAddressForCurrency(currency.BTC.String())
+	_, err := o.GetAccountDepositAddressForCurrency(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositHistory API endpoint test
 func TestGetAccountDepositHistory(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountDepositHistory("")
+	_, err := o.GetAccountDepositHistory(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetAccountDepositHistoryForCurrency API endpoint test
 func TestGetAccountDepositHistoryForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAccountDepositHistory(currency.BTC.String())
+	_, err := o.GetAccountDepositHistory(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTradingAccounts API endpoint test
 func TestGetSpotTradingAccounts(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotTradingAccounts()
+	_, err := o.GetSpotTradingAccounts(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTradingAccountsForCurrency API endpoint test
 func TestGetSpotTradingAccountsForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotTradingAccountForCurrency(currency.BTC.String())
+	_, err := o.GetSpotTradingAccountForCurrency(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
@@ -258,7 +260,7 @@ func TestGetSpotBillDetailsForCurrency(t *testing.T) {
 		Currency: currency.BTC.String(),
 		Limit:    100,
 	}
-	_, err := o.GetSpotBillDetailsForCurrency(request)
+	_, err := o.GetSpotBillDetailsForCurrency(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -269,7 +271,7 @@ func TestGetSpotBillDetailsForCurrencyBadLimit(t *testing.T) {
 		Currency: currency.BTC.String(),
 		Limit:    -1,
 	}
-	_, err := o.GetSpotBillDetailsForCurrency(request)
+	_, err := o.GetSpotBillDetailsForCurrency(context.Background(), request)
 	if areTestAPIKeysSet() && err == nil {
 		t.Errorf("Expecting an error when invalid request sent")
 	}
@@ -287,7 +289,7 @@ func TestPlaceSpotOrderLimit(t *testing.T) {
 		Size:         "0.001",
 	}
 
-	_, err := o.PlaceSpotOrder(&request)
+	_, err := o.PlaceSpotOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -303,7 +305,7 @@ func TestPlaceSpotOrderMarket(t *testing.T) {
 		Notional:     "100",
 	}
 
-	_, err := o.PlaceSpotOrder(&request)
+	_, err := o.PlaceSpotOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -323,7 +325,7 @@ func TestPlaceMultipleSpotOrders(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -348,7 +350,7 @@ func TestPlaceMultipleSpotOrdersOverCurrencyLimits(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 orders for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -381,7 +383,7 @@ func TestPlaceMultipleSpotOrdersOverPairLimits(t *testing.T) {
 		request = append(request, ord)
 	}
 
-	_, errs := o.PlaceMultipleSpotOrders(request)
+	_, errs := o.PlaceMultipleSpotOrders(context.Background(), request)
 	if errs[0].Error() != "up to 4 trading pairs" {
 		t.Error("Expecting an error when more than 4 trading pairs supplied", errs[0])
 	}
@@ -396,7 +398,7 @@ func TestCancelSpotOrder(t *testing.T) {
 		OrderID:      1234,
 	}
 
-	_, err := o.CancelSpotOrder(request)
+	_, err := o.CancelSpotOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -409,7 +411,7 @@ func TestCancelMultipleSpotOrders(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4},
 	}
 
-	cancellations, err := o.CancelMultipleSpotOrders(request)
+	cancellations, err := o.CancelMultipleSpotOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 	for _, cancellationsPerCurrency := range cancellations {
 		for _, cancellation := range cancellationsPerCurrency {
@@ -429,7 +431,7 @@ func TestCancelMultipleSpotOrdersOverCurrencyLimits(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4, 5},
 	}
 
-	_, err := o.CancelMultipleSpotOrders(request)
+	_, err := o.CancelMultipleSpotOrders(context.Background(), request)
 	if err.Error() != "maximum 4 order cancellations for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", err)
 	}
@@ -443,7 +445,7 @@ func TestGetSpotOrders(t *testing.T) {
 		Status:       "all",
 		Limit:        1,
 	}
-	_, err := o.GetSpotOrders(request)
+	_, err := o.GetSpotOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -451,7 +453,7 @@ func TestGetSpotOrders(t *testing.T) {
 func TestGetSpotOpenOrders(t *testing.T) {
 	t.Parallel()
 	request := okgroup.GetSpotOpenOrdersRequest{}
-	_, err := o.GetSpotOpenOrders(request)
+	_, err := o.GetSpotOpenOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -462,7 +464,7 @@ func TestGetSpotOrder(t *testing.T) {
 		OrderID:      "-1234",
 		InstrumentID: currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "-").Upper().String(),
 	}
-	_, err := o.GetSpotOrder(request)
+	_, err := o.GetSpotOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -473,14 +475,14 @@ func TestGetSpotTransactionDetails(t *testing.T) {
 		OrderID:      1234,
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetSpotTransactionDetails(request)
+	_, err := o.GetSpotTransactionDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSpotTokenPairDetails API endpoint test
 func TestGetSpotTokenPairDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotTokenPairDetails()
+	_, err := o.GetSpotTokenPairDetails(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -489,7 +491,7 @@ func TestGetSpotTokenPairDetails(t *testing.T) {
 // TestGetSpotAllTokenPairsInformation API endpoint test
 func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotAllTokenPairsInformation()
+	_, err := o.GetSpotAllTokenPairsInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -498,7 +500,7 @@ func TestGetSpotAllTokenPairsInformation(t *testing.T) {
 // TestGetSpotAllTokenPairsInformationForCurrency API endpoint test
 func TestGetSpotAllTokenPairsInformationForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSpotAllTokenPairsInformationForCurrency(spotCurrency)
+	_, err := o.GetSpotAllTokenPairsInformationForCurrency(context.Background(), spotCurrency)
 	if err != nil {
 		t.Error(err)
 	}
@@ -510,7 +512,7 @@ func TestGetSpotFilledOrdersInformation(t *testing.T) {
 	request := okgroup.GetSpotFilledOrdersInformationRequest{
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetSpotFilledOrdersInformation(request)
+	_, err := o.GetSpotFilledOrdersInformation(context.Background(), request)
 	if err != nil {
 		t.Error(err)
 	}
@@ -523,7 +525,7 @@ func TestGetSpotMarketData(t *testing.T) {
 		InstrumentID: spotCurrency,
 		Granularity:  604800,
 	}
-	_, err := o.GetSpotMarketData(request)
+	_, err := o.GetSpotMarketData(context.Background(), request)
 	if err != nil {
 		t.Error(err)
 	}
@@ -532,14 +534,14 @@ func TestGetSpotMarketData(t *testing.T) {
 // TestGetMarginTradingAccounts API endpoint test
 func TestGetMarginTradingAccounts(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetMarginTradingAccounts()
+	_, err := o.GetMarginTradingAccounts(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginTradingAccountsForCurrency API endpoint test
 func TestGetMarginTradingAccountsForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetMarginTradingAccountsForCurrency(spotCurrency)
+	_, err := o.GetMarginTradingAccountsForCurrency(context.Background(), spotCurrency)
 	testStandardErrorHandling(t, err)
 }
 
@@ -551,21 +553,21 @@ func TestGetMarginBillDetails(t *testing.T) {
 		Limit:        100,
 	}
 
-	_, err := o.GetMarginBillDetails(request)
+	_, err := o.GetMarginBillDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginAccountSettings API endpoint test
 func TestGetMarginAccountSettings(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetMarginAccountSettings("")
+	_, err := o.GetMarginAccountSettings(context.Background(), "")
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetMarginAccountSettingsForCurrency API endpoint test
 func TestGetMarginAccountSettingsForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetMarginAccountSettings(spotCurrency)
+	_, err := o.GetMarginAccountSettings(context.Background(), spotCurrency)
 	testStandardErrorHandling(t, err)
 }
 
@@ -579,7 +581,7 @@ func TestOpenMarginLoan(t *testing.T) {
 		QuoteCurrency: currency.USDT.String(),
 	}
 
-	_, err := o.OpenMarginLoan(request)
+	_, err := o.OpenMarginLoan(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -594,7 +596,7 @@ func TestRepayMarginLoan(t *testing.T) {
 		BorrowID:      1,
 	}
 
-	_, err := o.RepayMarginLoan(request)
+	_, err := o.RepayMarginLoan(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -611,7 +613,7 @@ func TestPlaceMarginOrderLimit(t *testing.T) {
 		Size:         "100",
 	}
 
-	_, err := o.PlaceMarginOrder(&request)
+	_, err := o.PlaceMarginOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -628,7 +630,7 @@ func TestPlaceMarginOrderMarket(t *testing.T) {
 		Notional:      "100",
 	}
 
-	_, err := o.PlaceMarginOrder(&request)
+	_, err := o.PlaceMarginOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -649,7 +651,7 @@ func TestPlaceMultipleMarginOrders(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -675,7 +677,7 @@ func TestPlaceMultipleMarginOrdersOverCurrencyLimits(t *testing.T) {
 		ord,
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 orders for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -709,7 +711,7 @@ func TestPlaceMultipleMarginOrdersOverPairLimits(t *testing.T) {
 		request = append(request, ord)
 	}
 
-	_, errs := o.PlaceMultipleMarginOrders(request)
+	_, errs := o.PlaceMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "up to 4 trading pairs" {
 		t.Error("Expecting an error when more than 4 trading pairs supplied", errs[0])
 	}
@@ -724,7 +726,7 @@ func TestCancelMarginOrder(t *testing.T) {
 		OrderID:      1234,
 	}
 
-	_, err := o.CancelMarginOrder(request)
+	_, err := o.CancelMarginOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -737,7 +739,7 @@ func TestCancelMultipleMarginOrders(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4},
 	}
 
-	_, errs := o.CancelMultipleMarginOrders(request)
+	_, errs := o.CancelMultipleMarginOrders(context.Background(), request)
 	if len(errs) > 0 {
 		testStandardErrorHandling(t, errs[0])
 	}
@@ -752,7 +754,7 @@ func TestCancelMultipleMarginOrdersOverCurrencyLimits(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4, 5},
 	}
 
-	_, errs := o.CancelMultipleMarginOrders(request)
+	_, errs := o.CancelMultipleMarginOrders(context.Background(), request)
 	if errs[0].Error() != "maximum 4 order cancellations for each pair" {
 		t.Error("Expecting an error when more than 4 orders for a pair supplied", errs[0])
 	}
@@ -765,7 +767,7 @@ func TestGetMarginOrders(t *testing.T) {
 		InstrumentID: spotCurrency,
 		Status:       "all",
 	}
-	_, err := o.GetMarginOrders(request)
+	_, err := o.GetMarginOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -773,7 +775,7 @@ func TestGetMarginOrders(t *testing.T) {
 func TestGetMarginOpenOrders(t *testing.T) {
 	t.Parallel()
 	request := okgroup.GetSpotOpenOrdersRequest{}
-	_, err := o.GetMarginOpenOrders(request)
+	_, err := o.GetMarginOpenOrders(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -784,7 +786,7 @@ func TestGetMarginOrder(t *testing.T) {
 		OrderID:      "1234",
 		InstrumentID: currency.NewPairWithDelimiter(currency.BTC.String(), currency.USDT.String(), "-").Upper().String(),
 	}
-	_, err := o.GetMarginOrder(request)
+	_, err := o.GetMarginOrder(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -795,7 +797,7 @@ func TestGetMarginTransactionDetails(t *testing.T) {
 		OrderID:      1234,
 		InstrumentID: spotCurrency,
 	}
-	_, err := o.GetMarginTransactionDetails(request)
+	_, err := o.GetMarginTransactionDetails(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -807,7 +809,7 @@ func getFutureInstrumentID() string {
 	if genericFutureInstrumentID != "" {
 		return genericFutureInstrumentID
 	}
-	resp, err := o.GetFuturesContractInformation()
+	resp, err := o.GetFuturesContractInformation(context.Background())
 	if err != nil {
 		// No error handling here because we're not testing this
 		return err.Error()
@@ -819,35 +821,35 @@ func getFutureInstrumentID() string {
 // TestGetFuturesPostions API endpoint test
 func TestGetFuturesPostions(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesPostions()
+	_, err := o.GetFuturesPostions(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesPostionsForCurrency API endpoint test
 func TestGetFuturesPostionsForCurrency(t *testing.T) {
 	currencyContract := getFutureInstrumentID()
-	_, err := o.GetFuturesPostionsForCurrency(currencyContract)
+	_, err := o.GetFuturesPostionsForCurrency(context.Background(), currencyContract)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesAccountOfAllCurrencies API endpoint test
 func TestGetFuturesAccountOfAllCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesAccountOfAllCurrencies()
+	_, err := o.GetFuturesAccountOfAllCurrencies(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesAccountOfACurrency API endpoint test
 func TestGetFuturesAccountOfACurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesAccountOfACurrency(currency.BTC.String())
+	_, err := o.GetFuturesAccountOfACurrency(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesLeverage API endpoint test
 func TestGetFuturesLeverage(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesLeverage(currency.BTC.String())
+	_, err := o.GetFuturesLeverage(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
@@ -860,14 +862,14 @@ func TestSetFuturesLeverage(t *testing.T) {
 		Leverage:     10,
 		Direction:    "Long",
 	}
-	_, err := o.SetFuturesLeverage(request)
+	_, err := o.SetFuturesLeverage(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesBillDetails API endpoint test
 func TestGetFuturesBillDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesBillDetails(okgroup.GetSpotBillDetailsForCurrencyRequest{
+	_, err := o.GetFuturesBillDetails(context.Background(), okgroup.GetSpotBillDetailsForCurrencyRequest{
 		Currency: currency.BTC.String(),
 	})
 	testStandardErrorHandling(t, err)
@@ -876,7 +878,7 @@ func TestGetFuturesBillDetails(t *testing.T) {
 // TestPlaceFuturesOrder API endpoint test
 func TestPlaceFuturesOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
-	_, err := o.PlaceFuturesOrder(okgroup.PlaceFuturesOrderRequest{
+	_, err := o.PlaceFuturesOrder(context.Background(), okgroup.PlaceFuturesOrderRequest{
 		InstrumentID: getFutureInstrumentID(),
 		Leverage:     10,
 		Type:         1,
@@ -890,7 +892,7 @@ func TestPlaceFuturesOrder(t *testing.T) {
 // TestPlaceFuturesOrderBatch API endpoint test
 func TestPlaceFuturesOrderBatch(t *testing.T) {
 	TestSetRealOrderDefaults(t)
-	_, err := o.PlaceFuturesOrderBatch(okgroup.PlaceFuturesOrderBatchRequest{
+	_, err := o.PlaceFuturesOrderBatch(context.Background(), okgroup.PlaceFuturesOrderBatchRequest{
 		InstrumentID: getFutureInstrumentID(),
 		Leverage:     10,
 		OrdersData: []okgroup.PlaceFuturesOrderBatchRequestDetails{
@@ -909,7 +911,7 @@ func TestPlaceFuturesOrderBatch(t *testing.T) {
 // TestCancelFuturesOrder API endpoint test
 func TestCancelFuturesOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
-	_, err := o.CancelFuturesOrder(okgroup.CancelFuturesOrderRequest{
+	_, err := o.CancelFuturesOrder(context.Background(), okgroup.CancelFuturesOrderRequest{
 		InstrumentID: getFutureInstrumentID(),
 		OrderID:      "1",
 	})
@@ -924,13 +926,13 @@ func TestCancelMultipleFuturesOrders(t *testing.T) {
 		OrderIDs:     []int64{1, 2, 3, 4},
 	}
 
-	_, err := o.CancelFuturesOrderBatch(request)
+	_, err := o.CancelFuturesOrderBatch(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesOrderList API endpoint test
 func TestGetFuturesOrderList(t *testing.T) {
-	_, err := o.GetFuturesOrderList(okgroup.GetFuturesOrdersListRequest{
+	_, err := o.GetFuturesOrderList(context.Background(), okgroup.GetFuturesOrdersListRequest{
 		InstrumentID: getFutureInstrumentID(),
 		Status:       6,
 	})
@@ -939,7 +941,7 @@ func TestGetFuturesOrderList(t *testing.T) {
 
 // TestGetFuturesOrderDetails API endpoint test
 func TestGetFuturesOrderDetails(t *testing.T) {
-	_, err := o.GetFuturesOrderDetails(okgroup.GetFuturesOrderDetailsRequest{
+	_, err := o.GetFuturesOrderDetails(context.Background(), okgroup.GetFuturesOrderDetailsRequest{
 		InstrumentID: getFutureInstrumentID(),
 		OrderID:      1,
 	})
@@ -948,7 +950,7 @@ func TestGetFuturesOrderDetails(t *testing.T) {
 
 // TestGetFuturesTransactionDetails API endpoint test
 func TestGetFuturesTransactionDetails(t *testing.T) {
-	_, err := o.GetFuturesTransactionDetails(okgroup.GetFuturesTransactionDetailsRequest{
+	_, err := o.GetFuturesTransactionDetails(context.Background(), okgroup.GetFuturesTransactionDetailsRequest{
 		InstrumentID: getFutureInstrumentID(),
 		OrderID:      1,
 	})
@@ -958,7 +960,7 @@ func TestGetFuturesTransactionDetails(t *testing.T) {
 // TestGetFuturesContractInformation API endpoint test
 func TestGetFuturesContractInformation(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesContractInformation()
+	_, err := o.GetFuturesContractInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -967,7 +969,7 @@ func TestGetFuturesContractInformation(t *testing.T) {
 // TestGetAllFuturesTokenInfo API endpoint test
 func TestGetAllFuturesTokenInfo(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAllFuturesTokenInfo()
+	_, err := o.GetAllFuturesTokenInfo(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -975,7 +977,7 @@ func TestGetAllFuturesTokenInfo(t *testing.T) {
 
 // TestGetAllFuturesTokenInfo API endpoint test
 func TestGetFuturesTokenInfoForCurrency(t *testing.T) {
-	_, err := o.GetFuturesTokenInfoForCurrency(getFutureInstrumentID())
+	_, err := o.GetFuturesTokenInfoForCurrency(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -983,7 +985,7 @@ func TestGetFuturesTokenInfoForCurrency(t *testing.T) {
 
 // TestGetFuturesFilledOrder API endpoint test
 func TestGetFuturesFilledOrder(t *testing.T) {
-	_, err := o.GetFuturesFilledOrder(okgroup.GetFuturesFilledOrderRequest{
+	_, err := o.GetFuturesFilledOrder(context.Background(), okgroup.GetFuturesFilledOrderRequest{
 		InstrumentID: getFutureInstrumentID(),
 	})
 	testStandardErrorHandling(t, err)
@@ -991,13 +993,13 @@ func TestGetFuturesFilledOrder(t *testing.T) {
 
 // TestGetFuturesHoldAmount API endpoint test
 func TestGetFuturesHoldAmount(t *testing.T) {
-	_, err := o.GetFuturesHoldAmount(getFutureInstrumentID())
+	_, err := o.GetFuturesHoldAmount(context.Background(), getFutureInstrumentID())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetFuturesHoldAmount API endpoint test
 func TestGetFuturesIndices(t *testing.T) {
-	_, err := o.GetFuturesIndices(getFutureInstrumentID())
+	_, err := o.GetFuturesIndices(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1006,7 +1008,7 @@ func TestGetFuturesIndices(t *testing.T) {
 // TestGetFuturesHoldAmount API endpoint test
 func TestGetFuturesExchangeRates(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetFuturesExchangeRates()
+	_, err := o.GetFuturesExchangeRates(context.Background())
 	if err != nil {
 		t.Errorf("Encountered error: %v", err)
 	}
@@ -1014,7 +1016,7 @@ func TestGetFuturesExchangeRates(t *testing.T) {
 
 // TestGetFuturesHoldAmount API endpoint test
 func TestGetFuturesEstimatedDeliveryPrice(t *testing.T) {
-	_, err := o.GetFuturesEstimatedDeliveryPrice(getFutureInstrumentID())
+	_, err := o.GetFuturesEstimatedDeliveryPrice(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1022,7 +1024,7 @@ func TestGetFuturesEstimatedDeliveryPrice(t *testing.T) {
 
 // TestGetFuturesOpenInterests API endpoint test
 func TestGetFuturesOpenInterests(t *testing.T) {
-	_, err := o.GetFuturesOpenInterests(getFutureInstrumentID())
+	_, err := o.GetFuturesOpenInterests(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1030,7 +1032,7 @@ func TestGetFuturesOpenInterests(t *testing.T) {
 
 // TestGetFuturesOpenInterests API endpoint test
 func TestGetFuturesCurrentPriceLimit(t *testing.T) {
-	_, err := o.GetFuturesCurrentPriceLimit(getFutureInstrumentID())
+	_, err := o.GetFuturesCurrentPriceLimit(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1038,7 +1040,7 @@ func TestGetFuturesCurrentPriceLimit(t *testing.T) {
 
 // TestGetFuturesCurrentMarkPrice API endpoint test
 func TestGetFuturesCurrentMarkPrice(t *testing.T) {
-	_, err := o.GetFuturesCurrentMarkPrice(getFutureInstrumentID())
+	_, err := o.GetFuturesCurrentMarkPrice(context.Background(), getFutureInstrumentID())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1046,7 +1048,7 @@ func TestGetFuturesCurrentMarkPrice(t *testing.T) {
 
 // TestGetFuturesForceLiquidatedOrders API endpoint test
 func TestGetFuturesForceLiquidatedOrders(t *testing.T) {
-	_, err := o.GetFuturesForceLiquidatedOrders(okgroup.GetFuturesForceLiquidatedOrdersRequest{
+	_, err := o.GetFuturesForceLiquidatedOrders(context.Background(), okgroup.GetFuturesForceLiquidatedOrdersRequest{
 		InstrumentID: getFutureInstrumentID(),
 		Status:       "1",
 	})
@@ -1064,35 +1066,35 @@ func TestGetFuturesTagPrice(t *testing.T) {
 // TestGetSwapPostions API endpoint test
 func TestGetSwapPostions(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapPostions()
+	_, err := o.GetSwapPostions(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSwapPostionsForContract API endpoint test
 func TestGetSwapPostionsForContract(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapPostionsForContract(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapPostionsForContract(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSwapAccountOfAllCurrency API endpoint test
 func TestGetSwapAccountOfAllCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapAccountOfAllCurrency()
+	_, err := o.GetSwapAccountOfAllCurrency(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSwapAccountSettingsOfAContract API endpoint test
 func TestGetSwapAccountSettingsOfAContract(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapAccountSettingsOfAContract(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapAccountSettingsOfAContract(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	testStandardErrorHandling(t, err)
 }
 
 // TestSetSwapLeverageLevelOfAContract API endpoint test
 func TestSetSwapLeverageLevelOfAContract(t *testing.T) {
 	t.Parallel()
-	_, err := o.SetSwapLeverageLevelOfAContract(okgroup.SetSwapLeverageLevelOfAContractRequest{
+	_, err := o.SetSwapLeverageLevelOfAContract(context.Background(), okgroup.SetSwapLeverageLevelOfAContractRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Leverage:     10,
 		Side:         1,
@@ -1104,7 +1106,7 @@ func TestSetSwapLeverageLevelOfAContract(t *testing.T) {
 // TestGetSwapAccountSettingsOfAContract API endpoint test
 func TestGetSwapBillDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapBillDetails(okgroup.GetSpotBillDetailsForCurrencyRequest{
+	_, err := o.GetSwapBillDetails(context.Background(), okgroup.GetSpotBillDetailsForCurrencyRequest{
 		Currency: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Limit:    100,
 	})
@@ -1115,7 +1117,7 @@ func TestGetSwapBillDetails(t *testing.T) {
 func TestPlaceSwapOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.PlaceSwapOrder(okgroup.PlaceSwapOrderRequest{
+	_, err := o.PlaceSwapOrder(context.Background(), okgroup.PlaceSwapOrderRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Size:         1,
 		Type:         1,
@@ -1128,7 +1130,7 @@ func TestPlaceSwapOrder(t *testing.T) {
 func TestPlaceMultipleSwapOrders(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.PlaceMultipleSwapOrders(okgroup.PlaceMultipleSwapOrdersRequest{
+	_, err := o.PlaceMultipleSwapOrders(context.Background(), okgroup.PlaceMultipleSwapOrdersRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Leverage:     10,
 		OrdersData: []okgroup.PlaceMultipleSwapOrderData{
@@ -1153,7 +1155,7 @@ func TestPlaceMultipleSwapOrders(t *testing.T) {
 func TestCancelSwapOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.CancelSwapOrder(okgroup.CancelSwapOrderRequest{
+	_, err := o.CancelSwapOrder(context.Background(), okgroup.CancelSwapOrderRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		OrderID:      "64-2a-26132f931-3",
 	})
@@ -1164,7 +1166,7 @@ func TestCancelSwapOrder(t *testing.T) {
 func TestCancelMultipleSwapOrders(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.CancelMultipleSwapOrders(okgroup.CancelMultipleSwapOrdersRequest{
+	_, err := o.CancelMultipleSwapOrders(context.Background(), okgroup.CancelMultipleSwapOrdersRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		OrderIDs:     []int64{1, 2, 3, 4},
 	})
@@ -1174,7 +1176,7 @@ func TestCancelMultipleSwapOrders(t *testing.T) {
 // TestGetSwapOrderList API endpoint test
 func TestGetSwapOrderList(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapOrderList(okgroup.GetSwapOrderListRequest{
+	_, err := o.GetSwapOrderList(context.Background(), okgroup.GetSwapOrderListRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Status:       6,
 	})
@@ -1184,7 +1186,7 @@ func TestGetSwapOrderList(t *testing.T) {
 // TestGetSwapOrderDetails API endpoint test
 func TestGetSwapOrderDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapOrderDetails(okgroup.GetSwapOrderDetailsRequest{
+	_, err := o.GetSwapOrderDetails(context.Background(), okgroup.GetSwapOrderDetailsRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		OrderID:      "64-2a-26132f931-3",
 	})
@@ -1194,7 +1196,7 @@ func TestGetSwapOrderDetails(t *testing.T) {
 // TestGetSwapTransactionDetails API endpoint test
 func TestGetSwapTransactionDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapTransactionDetails(okgroup.GetSwapTransactionDetailsRequest{
+	_, err := o.GetSwapTransactionDetails(context.Background(), okgroup.GetSwapTransactionDetailsRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		OrderID:      "64-2a-26132f931-3",
 	})
@@ -1204,7 +1206,7 @@ func TestGetSwapTransactionDetails(t *testing.T) {
 // TestGetSwapContractInformation API endpoint test
 func TestGetSwapContractInformation(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapContractInformation()
+	_, err := o.GetSwapContractInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1213,7 +1215,7 @@ func TestGetSwapContractInformation(t *testing.T) {
 // TestGetAllSwapTokensInformation API endpoint test
 func TestGetAllSwapTokensInformation(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetAllSwapTokensInformation()
+	_, err := o.GetAllSwapTokensInformation(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1222,7 +1224,7 @@ func TestGetAllSwapTokensInformation(t *testing.T) {
 // TestGetSwapTokensInformationForCurrency API endpoint test
 func TestGetSwapTokensInformationForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapTokensInformationForCurrency(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapTokensInformationForCurrency(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1231,7 +1233,7 @@ func TestGetSwapTokensInformationForCurrency(t *testing.T) {
 // TestGetSwapFilledOrdersData API endpoint test
 func TestGetSwapFilledOrdersData(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapFilledOrdersData(&okgroup.GetSwapFilledOrdersDataRequest{
+	_, err := o.GetSwapFilledOrdersData(context.Background(), &okgroup.GetSwapFilledOrdersDataRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Limit:        100,
 	})
@@ -1247,7 +1249,7 @@ func TestGetSwapMarketData(t *testing.T) {
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Granularity:  604800,
 	}
-	_, err := o.GetSwapMarketData(request)
+	_, err := o.GetSwapMarketData(context.Background(), request)
 	if err != nil {
 		t.Error(err)
 	}
@@ -1256,7 +1258,7 @@ func TestGetSwapMarketData(t *testing.T) {
 // TestGetSwapIndeces API endpoint test
 func TestGetSwapIndeces(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapIndices(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapIndices(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1265,7 +1267,7 @@ func TestGetSwapIndeces(t *testing.T) {
 // TestGetSwapExchangeRates API endpoint test
 func TestGetSwapExchangeRates(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapExchangeRates()
+	_, err := o.GetSwapExchangeRates(context.Background())
 	if err != nil {
 		t.Error(err)
 	}
@@ -1274,7 +1276,7 @@ func TestGetSwapExchangeRates(t *testing.T) {
 // TestGetSwapOpenInterest API endpoint test
 func TestGetSwapOpenInterest(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapOpenInterest(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapOpenInterest(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1283,7 +1285,7 @@ func TestGetSwapOpenInterest(t *testing.T) {
 // TestGetSwapCurrentPriceLimits API endpoint test
 func TestGetSwapCurrentPriceLimits(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapCurrentPriceLimits(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapCurrentPriceLimits(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1292,7 +1294,7 @@ func TestGetSwapCurrentPriceLimits(t *testing.T) {
 // TestGetSwapForceLiquidatedOrders API endpoint test
 func TestGetSwapForceLiquidatedOrders(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapForceLiquidatedOrders(okgroup.GetSwapForceLiquidatedOrdersRequest{
+	_, err := o.GetSwapForceLiquidatedOrders(context.Background(), okgroup.GetSwapForceLiquidatedOrdersRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Status:       "0",
 	})
@@ -1304,14 +1306,14 @@ func TestGetSwapForceLiquidatedOrders(t *testing.T) {
 // TestGetSwapOnHoldAmountForOpenOrders API endpoint test
 func TestGetSwapOnHoldAmountForOpenOrders(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapOnHoldAmountForOpenOrders(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapOnHoldAmountForOpenOrders(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetSwapNextSettlementTime API endpoint test
 func TestGetSwapNextSettlementTime(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapNextSettlementTime(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapNextSettlementTime(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1320,7 +1322,7 @@ func TestGetSwapNextSettlementTime(t *testing.T) {
 // TestGetSwapMarkPrice API endpoint test
 func TestGetSwapMarkPrice(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapMarkPrice(fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
+	_, err := o.GetSwapMarkPrice(context.Background(), fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD))
 	if err != nil {
 		t.Error(err)
 	}
@@ -1329,7 +1331,7 @@ func TestGetSwapMarkPrice(t *testing.T) {
 // TestGetSwapFundingRateHistory API endpoint test
 func TestGetSwapFundingRateHistory(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetSwapFundingRateHistory(okgroup.GetSwapFundingRateHistoryRequest{
+	_, err := o.GetSwapFundingRateHistory(context.Background(), okgroup.GetSwapFundingRateHistoryRequest{
 		InstrumentID: fmt.Sprintf("%v-%v-SWAP", currency.BTC, currency.USD),
 		Limit:        100,
 	})
@@ -1341,21 +1343,21 @@ func TestGetSwapFundingRateHistory(t *testing.T) {
 // TestGetETT API endpoint test
 func TestGetETT(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetETT()
+	_, err := o.GetETT(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetETTAccountInformationForCurrency API endpoint test
 func TestGetETTAccountInformationForCurrency(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetETTBillsDetails(currency.BTC.String())
+	_, err := o.GetETTBillsDetails(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetETTBillsDetails API endpoint test
 func TestGetETTBillsDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetETTBillsDetails(currency.BTC.String())
+	_, err := o.GetETTBillsDetails(context.Background(), currency.BTC.String())
 	testStandardErrorHandling(t, err)
 }
 
@@ -1371,7 +1373,7 @@ func TestPlaceETTOrder(t *testing.T) {
 		ETT:           "OK06",
 	}
 
-	_, err := o.PlaceETTOrder(&request)
+	_, err := o.PlaceETTOrder(context.Background(), &request)
 	testStandardErrorHandling(t, err)
 }
 
@@ -1379,7 +1381,7 @@ func TestPlaceETTOrder(t *testing.T) {
 func TestCancelETTOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.CancelETTOrder("888845120785408")
+	_, err := o.CancelETTOrder(context.Background(), "888845120785408")
 	testStandardErrorHandling(t, err)
 }
 
@@ -1395,14 +1397,14 @@ func TestGetETTOrderList(t *testing.T) {
 		Status: 0,
 	}
 
-	_, err := o.GetETTOrderList(request)
+	_, err := o.GetETTOrderList(context.Background(), request)
 	testStandardErrorHandling(t, err)
 }
 
 // TestGetETTOrderDetails API endpoint test
 func TestGetETTOrderDetails(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetETTOrderDetails("888845020785408")
+	_, err := o.GetETTOrderDetails(context.Background(), "888845020785408")
 	testStandardErrorHandling(t, err)
 }
 
@@ -1410,7 +1412,7 @@ func TestGetETTOrderDetails(t *testing.T) {
 func TestGetETTConstituents(t *testing.T) {
 	t.Skip("ETT currently unavailable")
 	t.Parallel()
-	_, err := o.GetETTConstituents("OK06ETT")
+	_, err := o.GetETTConstituents(context.Background(), "OK06ETT")
 	if err != nil {
 		t.Error(err)
 	}
@@ -1420,7 +1422,7 @@ func TestGetETTConstituents(t *testing.T) {
 func TestGetETTSettlementPriceHistory(t *testing.T) {
 	t.Skip("ETT currently unavailable")
 	t.Parallel()
-	_, err := o.GetETTSettlementPriceHistory("OK06ETT")
+	_, err := o.GetETTSettlementPriceHistory(context.Background(), "OK06ETT")
 	if err != nil {
 		t.Error(err)
 	}
@@ -1588,7 +1590,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	o.GetFeeByType(feeBuilder)
+	o.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -1604,7 +1606,7 @@ func TestGetFee(t *testing.T) {
 	t.Parallel()
 	var feeBuilder = setFeeBuilder()
 	// CryptocurrencyTradeFee Basic
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0.0015) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0.0015) || err != nil {
 		t.Error(err)
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0015), resp)
 	}
@@ -1613,7 +1615,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Amount = 1000
 	feeBuilder.PurchasePrice = 1000
-	if resp, err := o.GetFee(feeBuilder); resp != float64(1500) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(1500) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(1500), resp)
 		t.Error(err)
 	}
@@ -1621,7 +1623,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee IsMaker
 	feeBuilder = setFeeBuilder()
 	feeBuilder.IsMaker = true
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0.0005) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0.0005) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0.0005), resp)
 		t.Error(err)
 	}
@@ -1629,7 +1631,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyTradeFee Negative purchase price
 	feeBuilder = setFeeBuilder()
 	feeBuilder.PurchasePrice = -1000
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -1637,7 +1639,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -1645,7 +1647,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -1654,7 +1656,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := o.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := o.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f", float64(0), resp)
 		t.Error(err)
 	}
@@ -1683,11 +1685,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := o.SubmitOrder(orderSubmission)
+	response, err := o.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -1707,7 +1709,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := o.CancelOrder(&orderCancellation)
+	err := o.CancelOrder(context.Background(), &orderCancellation)
 	testStandardErrorHandling(t, err)
 }
 
@@ -1723,7 +1725,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := o.CancelAllOrders(&orderCancellation)
+	resp, err := o.CancelAllOrders(context.Background(), &orderCancellation)
 	testStandardErrorHandling(t, err)
 
 	if len(resp.Status) > 0 {
@@ -1733,7 +1735,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 
 // TestGetAccountInfo Wrapper test
 func TestGetAccountInfo(t *testing.T) {
-	_, err := o.UpdateAccountInfo()
+	_, err := o.UpdateAccountInfo(context.Background())
 	testStandardErrorHandling(t, err)
 }
 
@@ -1741,7 +1743,7 @@ func TestGetAccountInfo(t *testing.T) {
 func TestModifyOrder(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
-	_, err := o.ModifyOrder(&order.Modify{})
+	_, err := o.ModifyOrder(context.Background(), &order.Modify{})
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -1763,7 +1765,7 @@ func TestWithdraw(t *testing.T) {
 		Address:   core.BitcoinDonationAddress,
 		FeeAmount: 1,
 	}
-	_, err := o.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := o.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	testStandardErrorHandling(t, err)
 }
 
@@ -1772,7 +1774,7 @@ func TestWithdrawFiat(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := o.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := o.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -1785,7 +1787,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	TestSetRealOrderDefaults(t)
 	t.Parallel()
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := o.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := o.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -1796,21 +1798,102 @@ func TestWithdrawInternationalBank(t *testing.T) {
 // TestGetOrderbook logic test
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: "BTC-USDT"},
+	_, err := o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: "BTC-USDT"},
 		asset.Spot)
 	if err != nil {
 		t.Error(err)
 	}
 	contract := getFutureInstrumentID()
-	_, err = o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: contract},
+	_, err = o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: contract},
 		asset.Futures)
 	if err != nil {
 		t.Error(err)
 	}
 
-	_, err = o.GetOrderBook(okgroup.GetOrderBookRequest{InstrumentID: "BTC-USD-SWAP"},
+	_, err = o.GetOrderBook(context.Background(), okgroup.GetOrderBookRequest{InstrumentID: "BTC-USD-SWAP"},
 		asset.PerpetualSwap)
 	if err != nil {
 		t.Error(err)
 	}
 }
+
+// TestGetLongShortRatio API endpoint test
+func TestGetLongShortRatio(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetLongShortRatio(context.Background(), currency.BTC.String(), okexLongShortGranularity)
+	if err != nil {
+		t.Error(err)
+	}
+}
+
+func TestLatestLongShortRatio(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	ratios := []okgroup.LongShortRatio{
+		{Timestamp: now.Add(-10 * time.Minute), Ratio: 1.1},
+		{Timestamp: now, Ratio: 0.9},
+		{Timestamp: now.Add(-5 * time.Minute), Ratio: 1.2},
+	}
+	if r := latestLongShortRatio(ratios); r != 0.9 {
+		t.Errorf("expected the latest ratio 0.9, received %v", r)
+	}
+	if r := latestLongShortRatio(nil); r != 0 {
+		t.Errorf("expected no ratio, received %v", r)
+	}
+}
+
+func TestGetOpenInterest(t *testing.T) {
+	t.Parallel()
+	_, err := o.GetOpenInterest(context.Background(),
+		currency.NewPairWithDelimiter("BTC-USD", "SWAP", delimiterUnderscore),
+		asset.PerpetualSwap)
+	if err != nil {
+		t.Error(err)
+	}
+	_, err = o.GetOpenInterest(context.Background(), currency.NewPair(currency.BTC, currency.USDT), asset.Spot)
+	if err == nil {
+		t.Error("expected an error for spot open interest")
+	}
+}
+
+func TestWsLiquidations(t *testing.T) {
+	t.Parallel()
+	pressXToJSON := []byte(`[{"loss":"0","size":"12","price":"9312.5","created_at":"2020-03-01T10:17:55.000Z","instrument_id":"BTC-USD-SWAP","type":"3"},{"loss":"0","size":"3","price":"9400.1","created_at":"2020-03-01T10:18:02.000Z","instrument_id":"BTC-USD-SWAP","type":"4"},{"loss":"0","size":"5","price":"9100","created_at":"2020-03-01T10:00:00.000Z","instrument_id":"BTC-USD-SWAP","type":"3"}]`)
+	var orders []okgroup.GetFuturesForceLiquidatedOrdersResponse
+	err := json.Unmarshal(pressXToJSON, &orders)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := currency.NewPairWithDelimiter("BTC-USD", "SWAP", "_")
+	since := time.Date(2020, 3, 1, 10, 10, 0, 0, time.UTC)
+	liquidations, latest, err := o.wsLiquidations(orders, p, asset.PerpetualSwap, since)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(liquidations) != 2 {
+		t.Fatalf("expected the liquidations after since, received %+v", liquidations)
+	}
+	if l := liquidations[0]; l.Side != order.Sell || l.Price != 9312.5 || l.Amount != 12 ||
+		l.AssetType != asset.PerpetualSwap || l.Pair != p || l.Exchange != o.Name {
+		t.Errorf("unexpected long liquidation %+v", l)
+	}
+	if l := liquidations[1]; l.Side != order.Buy || l.Amount != 3 {
+		t.Errorf("unexpected short liquidation %+v", l)
+	}
+	if !latest.Equal(time.Date(2020, 3, 1, 10, 18, 2, 0, time.UTC)) {
+		t.Errorf("unexpected latest liquidation time %v", latest)
+	}
+
+	liquidations, _, err = o.wsLiquidations(orders, p, asset.PerpetualSwap, latest)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(liquidations) != 0 {
+		t.Errorf("expected liquidations already seen to be skipped, received %+v", liquidations)
+	}
+
+	orders[0].CreatedAt = "yesterday"
+	if _, _, err = o.wsLiquidations(orders, p, asset.PerpetualSwap, since); err == nil {
+		t.Error("expected error on an invalid creation time")
+	}
+}
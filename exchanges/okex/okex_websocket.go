@@ -0,0 +1,144 @@
+package okex
+
+import (
+	"context"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+const (
+	// okexLiquidationInterval is how often futures and perpetual swap
+	// liquidations are polled
+	okexLiquidationInterval = 10 * time.Second
+	// okexLiquidationFilled requests the liquidation orders filled in the
+	// last 7 days
+	okexLiquidationFilled = "1"
+	// Liquidation order types, closing a long or short position
+	okexLiquidationCloseLong  = 3
+	okexLiquidationCloseShort = 4
+)
+
+// WsConnect connects the OKGroup websocket and polls futures and perpetual
+// swap liquidations alongside it. The v3 websocket has no liquidation
+// channel, so liquidations are fetched over REST and sent to the websocket
+// data handler as other exchanges stream them
+func (o *OKEX) WsConnect() error {
+	err := o.OKGroup.WsConnect()
+	if err != nil {
+		return err
+	}
+	o.Websocket.Wg.Add(1)
+	go o.wsPollLiquidations()
+	return nil
+}
+
+// wsPollLiquidations polls the liquidations of the enabled futures and
+// perpetual swap pairs until the websocket shuts down. Liquidations from
+// before the websocket connected aren't sent
+func (o *OKEX) wsPollLiquidations() {
+	defer o.Websocket.Wg.Done()
+	connected := time.Now()
+	latest := make(map[string]time.Time)
+	t := time.NewTicker(okexLiquidationInterval)
+	defer t.Stop()
+	for {
+		select {
+		case <-o.Websocket.ShutdownC:
+			return
+		case <-t.C:
+		}
+		for _, a := range []asset.Item{asset.Futures, asset.PerpetualSwap} {
+			pairs := o.GetEnabledPairs(a)
+			for i := range pairs {
+				key := a.String() + pairs[i].String()
+				since, ok := latest[key]
+				if !ok {
+					since = connected
+				}
+				resp, err := o.getLiquidations(a, pairs[i])
+				if err != nil {
+					o.Websocket.DataHandler <- err
+					continue
+				}
+				var liquidations []wshandler.LiquidationData
+				liquidations, latest[key], err = o.wsLiquidations(resp, pairs[i], a, since)
+				if err != nil {
+					o.Websocket.DataHandler <- err
+				}
+				for j := range liquidations {
+					o.Websocket.DataHandler <- liquidations[j]
+				}
+			}
+		}
+	}
+}
+
+// getLiquidations returns the recent filled liquidation orders of a futures
+// or perpetual swap contract
+func (o *OKEX) getLiquidations(a asset.Item, p currency.Pair) ([]okgroup.GetFuturesForceLiquidatedOrdersResponse, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), okexLiquidationInterval)
+	defer cancel()
+	instrumentID := p.Base.String() + delimiterDash + p.Quote.String()
+	if a == asset.Futures {
+		return o.GetFuturesForceLiquidatedOrders(ctx, okgroup.GetFuturesForceLiquidatedOrdersRequest{
+			InstrumentID: instrumentID,
+			Status:       okexLiquidationFilled,
+		})
+	}
+	resp, err := o.GetSwapForceLiquidatedOrders(ctx, okgroup.GetSwapForceLiquidatedOrdersRequest{
+		InstrumentID: instrumentID,
+		Status:       okexLiquidationFilled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	orders := make([]okgroup.GetFuturesForceLiquidatedOrdersResponse, len(resp))
+	for i := range resp {
+		orders[i] = okgroup.GetFuturesForceLiquidatedOrdersResponse(resp[i])
+	}
+	return orders, nil
+}
+
+// wsLiquidations converts the liquidation orders created after since into
+// normalised liquidation data, returning the latest creation time seen. A
+// liquidation closing a long position is a sell and closing a short a buy
+func (o *OKEX) wsLiquidations(orders []okgroup.GetFuturesForceLiquidatedOrdersResponse, p currency.Pair, a asset.Item, since time.Time) ([]wshandler.LiquidationData, time.Time, error) {
+	var resp []wshandler.LiquidationData
+	latest := since
+	for i := range orders {
+		created, err := time.Parse(time.RFC3339, orders[i].CreatedAt)
+		if err != nil {
+			return resp, latest, err
+		}
+		if !created.After(since) {
+			continue
+		}
+		if created.After(latest) {
+			latest = created
+		}
+		var side order.Side
+		switch orders[i].Type {
+		case okexLiquidationCloseLong:
+			side = order.Sell
+		case okexLiquidationCloseShort:
+			side = order.Buy
+		default:
+			continue
+		}
+		resp = append(resp, wshandler.LiquidationData{
+			Timestamp: created,
+			Pair:      p,
+			AssetType: a,
+			Exchange:  o.Name,
+			Side:      side,
+			Price:     orders[i].Price,
+			Amount:    float64(orders[i].Size),
+		})
+	}
+	return resp, latest, nil
+}
@@ -1,16 +1,20 @@
 package okex
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/okgroup"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
@@ -21,6 +25,8 @@ import (
 const (
 	delimiterDash       = "-"
 	delimiterUnderscore = "_"
+	// okexLongShortGranularity samples the long/short ratio every 5 minutes
+	okexLongShortGranularity = 300
 )
 
 // GetDefaultConfig returns a default exchange config
@@ -37,7 +43,7 @@ func (o *OKEX) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if o.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = o.UpdateTradablePairs(true)
+		err = o.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -127,6 +133,9 @@ func (o *OKEX) SetDefaults() {
 				CryptoWithdrawal:    true,
 				TradeFee:            true,
 				CryptoWithdrawalFee: true,
+				ClientOrderID:       true,
+				ImmediateOrCancel:   true,
+				FillOrKill:          true,
 			},
 			WebsocketCapabilities: protocol.Features{
 				TickerFetching:         true,
@@ -162,6 +171,17 @@ func (o *OKEX) SetDefaults() {
 	o.WebsocketOrderbookBufferLimit = exchange.DefaultWebsocketOrderbookBufferLimit
 }
 
+// Setup sets user exchange configuration settings, connecting the websocket
+// with OKEX's connector so liquidations are polled alongside it
+func (o *OKEX) Setup(exch *config.ExchangeConfig) error {
+	err := o.OKGroup.Setup(exch)
+	if err != nil {
+		return err
+	}
+	o.Websocket.SetConnector(o.WsConnect)
+	return nil
+}
+
 // Start starts the OKGroup go routine
 func (o *OKEX) Start(wg *sync.WaitGroup) {
 	wg.Add(1)
@@ -206,7 +226,7 @@ func (o *OKEX) Run() {
 		return
 	}
 
-	err := o.UpdateTradablePairs(forceUpdate)
+	err := o.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -216,11 +236,11 @@ func (o *OKEX) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
+func (o *OKEX) FetchTradablePairs(ctx context.Context, i asset.Item) ([]string, error) {
 	var pairs []string
 	switch i {
 	case asset.Spot:
-		prods, err := o.GetSpotTokenPairDetails()
+		prods, err := o.GetSpotTokenPairDetails(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -233,7 +253,7 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 		}
 		return pairs, nil
 	case asset.Futures:
-		prods, err := o.GetFuturesContractInformation()
+		prods, err := o.GetFuturesContractInformation(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -246,7 +266,7 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 		return pairs, nil
 
 	case asset.PerpetualSwap:
-		prods, err := o.GetSwapContractInformation()
+		prods, err := o.GetSwapContractInformation(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -270,14 +290,14 @@ func (o *OKEX) FetchTradablePairs(i asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
+func (o *OKEX) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
 	for x := range o.CurrencyPairs.AssetTypes {
 		if o.CurrencyPairs.AssetTypes[x] == asset.Index {
 			// Update from futures
 			continue
 		}
 
-		pairs, err := o.FetchTradablePairs(o.CurrencyPairs.AssetTypes[x])
+		pairs, err := o.FetchTradablePairs(ctx, o.CurrencyPairs.AssetTypes[x])
 		if err != nil {
 			return err
 		}
@@ -307,11 +327,11 @@ func (o *OKEX) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (o *OKEX) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
 	switch assetType {
 	case asset.Spot:
-		resp, err := o.GetSpotAllTokenPairsInformation()
+		resp, err := o.GetSpotAllTokenPairsInformation(ctx)
 		if err != nil {
 			return tickerPrice, err
 		}
@@ -338,7 +358,7 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 		}
 
 	case asset.PerpetualSwap:
-		resp, err := o.GetAllSwapTokensInformation()
+		resp, err := o.GetAllSwapTokensInformation(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -368,7 +388,7 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 		}
 
 	case asset.Futures:
-		resp, err := o.GetAllFuturesTokenInfo()
+		resp, err := o.GetAllFuturesTokenInfo(ctx)
 		if err != nil {
 			return nil, err
 		}
@@ -402,13 +422,81 @@ func (o *OKEX) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pric
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (o *OKEX) FetchTicker(p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
+func (o *OKEX) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (tickerData *ticker.Price, err error) {
 	if assetType == asset.Index {
 		return tickerData, errors.New("ticker fetching not supported for index")
 	}
 	tickerData, err = ticker.GetTicker(o.Name, p, assetType)
 	if err != nil {
-		return o.UpdateTicker(p, assetType)
+		return o.UpdateTicker(ctx, p, assetType)
 	}
 	return
 }
+
+// GetFundingPayments returns funding paid or received on perpetual swap
+// positions
+func (o *OKEX) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (o *OKEX) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (o *OKEX) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetOpenInterest returns the open interest of a futures or perpetual swap
+// contract along with the latest long/short account ratio of its currency
+func (o *OKEX) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	instrumentID := p.Base.String() + delimiterDash + p.Quote.String()
+	resp := exchange.OpenInterest{
+		Exchange:  o.Name,
+		AssetType: assetType,
+		Pair:      p,
+	}
+	switch assetType {
+	case asset.Futures:
+		oi, err := o.GetFuturesOpenInterests(ctx, instrumentID)
+		if err != nil {
+			return exchange.OpenInterest{}, err
+		}
+		resp.Amount = oi.Amount
+		resp.Timestamp = oi.Timestamp
+	case asset.PerpetualSwap:
+		oi, err := o.GetSwapOpenInterest(ctx, instrumentID)
+		if err != nil {
+			return exchange.OpenInterest{}, err
+		}
+		resp.Amount = oi.Amount
+		resp.Timestamp = oi.Timestamp
+	default:
+		return exchange.OpenInterest{}, fmt.Errorf("%s open interest not supported for %s", o.Name, assetType)
+	}
+
+	// The ratio is published per currency, the base of an instrument such as
+	// BTC-USD-SWAP
+	code := strings.Split(p.Base.String(), delimiterDash)[0]
+	ratios, err := o.GetLongShortRatio(ctx, code, okexLongShortGranularity)
+	if err != nil {
+		log.Errorf(log.ExchangeSys, "%s unable to get %s long/short ratio: %v\n", o.Name, code, err)
+		return resp, nil
+	}
+	resp.LongShortRatio = latestLongShortRatio(ratios)
+	return resp, nil
+}
+
+// latestLongShortRatio returns the most recent ratio
+func latestLongShortRatio(ratios []okgroup.LongShortRatio) float64 {
+	var latest okgroup.LongShortRatio
+	for i := range ratios {
+		if ratios[i].Timestamp.After(latest.Timestamp) {
+			latest = ratios[i]
+		}
+	}
+	return latest.Ratio
+}
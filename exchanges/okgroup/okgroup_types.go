@@ -225,6 +225,7 @@ type GetSpotOrdersRequest struct {
 
 // GetSpotOrderResponse response data for GetSpotOrders
 type GetSpotOrderResponse struct {
+	ClientOID      string    `json:"client_oid"`
 	FilledNotional float64   `json:"filled_notional,string"`
 	FilledSize     float64   `json:"filled_size,string"`
 	InstrumentID   string    `json:"instrument_id"`
@@ -1121,6 +1122,12 @@ type GetSwapOpenInterestResponse struct {
 	Timestamp    time.Time `json:"timestamp"`
 }
 
+// LongShortRatio is the ratio of accounts long to those short at a time
+type LongShortRatio struct {
+	Timestamp time.Time
+	Ratio     float64
+}
+
 // GetSwapCurrentPriceLimitsResponse response data for GetSwapCurrentPriceLimits
 type GetSwapCurrentPriceLimitsResponse struct {
 	InstrumentID string    `json:"instrument_id"`
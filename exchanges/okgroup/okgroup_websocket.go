@@ -16,6 +16,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
@@ -268,7 +269,7 @@ func (o *OKGroup) WsHandleData(wg *sync.WaitGroup) {
 // WsLogin sends a login request to websocket to enable access to authenticated endpoints
 func (o *OKGroup) WsLogin() error {
 	o.Websocket.SetCanUseAuthenticatedEndpoints(true)
-	unixTime := time.Now().UTC().Unix()
+	unixTime := o.Requester.Now().UTC().Unix()
 	signPath := "/users/self/verify"
 	hmac := crypto.GetHMAC(crypto.HashSHA256,
 		[]byte(strconv.FormatInt(unixTime, 10)+http.MethodGet+signPath),
@@ -479,10 +480,11 @@ func (o *OKGroup) wsProcessCandles(response *WebsocketDataResponse) {
 		}
 
 		candleIndex := strings.LastIndex(response.Table, okGroupWsCandle)
-		secondIndex := strings.LastIndex(response.Table, "0s")
-		candleInterval := ""
-		if candleIndex > 0 || secondIndex > 0 {
-			candleInterval = response.Table[candleIndex+len(okGroupWsCandle) : secondIndex]
+		candleInterval, err := kline.ParseInterval(
+			response.Table[candleIndex+len(okGroupWsCandle):])
+		if err != nil {
+			o.Websocket.DataHandler <- wshandler.NewError(o.Name, wshandler.ErrorMalformedMessage, err)
+			continue
 		}
 
 		klineData := wshandler.KlineData{
@@ -1,12 +1,15 @@
 package okgroup
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -54,11 +57,14 @@ func (o *OKGroup) Setup(exch *config.ExchangeConfig) error {
 	o.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         o.Name,
 		URL:                  o.Websocket.GetWebsocketURL(),
+		AlternateURLs:        o.Websocket.GetAlternateURLs(),
 		ProxyURL:             o.Websocket.GetProxyAddress(),
 		Verbose:              o.Verbose,
 		RateLimit:            okGroupWsRateLimit,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	o.Websocket.Orderbook.Setup(
@@ -72,22 +78,22 @@ func (o *OKGroup) Setup(exch *config.ExchangeConfig) error {
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (o *OKGroup) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (o *OKGroup) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(o.Name, p, assetType)
 	if err != nil {
-		return o.UpdateOrderbook(p, assetType)
+		return o.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (o *OKGroup) UpdateOrderbook(p currency.Pair, a asset.Item) (*orderbook.Base, error) {
+func (o *OKGroup) UpdateOrderbook(ctx context.Context, p currency.Pair, a asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 	if a == asset.Index {
 		return orderBook, errors.New("no orderbooks for index")
 	}
 
-	orderbookNew, err := o.GetOrderBook(GetOrderBookRequest{
+	orderbookNew, err := o.GetOrderBook(ctx, GetOrderBookRequest{
 		InstrumentID: o.FormatExchangeCurrency(p, a).String(),
 	}, a)
 	if err != nil {
@@ -171,8 +177,8 @@ func (o *OKGroup) UpdateOrderbook(p currency.Pair, a asset.Item) (*orderbook.Bas
 }
 
 // UpdateAccountInfo retrieves balances for all enabled currencies
-func (o *OKGroup) UpdateAccountInfo() (account.Holdings, error) {
-	currencies, err := o.GetSpotTradingAccounts()
+func (o *OKGroup) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
+	currencies, err := o.GetSpotTradingAccounts(ctx)
 	if err != nil {
 		return account.Holdings{}, err
 	}
@@ -193,8 +199,8 @@ func (o *OKGroup) UpdateAccountInfo() (account.Holdings, error) {
 		currencyAccount.Currencies = append(currencyAccount.Currencies,
 			account.Balance{
 				CurrencyName: currency.NewCode(currencies[i].Currency),
-				Hold:         hold,
-				TotalValue:   totalValue,
+				Hold:         decimal.NewFromFloat(hold),
+				TotalValue:   decimal.NewFromFloat(totalValue),
 			})
 	}
 
@@ -209,10 +215,10 @@ func (o *OKGroup) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (o *OKGroup) FetchAccountInfo() (account.Holdings, error) {
+func (o *OKGroup) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(o.Name)
 	if err != nil {
-		return o.UpdateAccountInfo()
+		return o.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -220,8 +226,8 @@ func (o *OKGroup) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (o *OKGroup) GetFundingHistory() (resp []exchange.FundHistory, err error) {
-	accountDepositHistory, err := o.GetAccountDepositHistory("")
+func (o *OKGroup) GetFundingHistory(ctx context.Context) (resp []exchange.FundHistory, err error) {
+	accountDepositHistory, err := o.GetAccountDepositHistory(ctx, "")
 	if err != nil {
 		return
 	}
@@ -246,7 +252,7 @@ func (o *OKGroup) GetFundingHistory() (resp []exchange.FundHistory, err error) {
 			TransferType: "deposit",
 		})
 	}
-	accountWithdrawlHistory, err := o.GetAccountWithdrawalHistory("")
+	accountWithdrawlHistory, err := o.GetAccountWithdrawalHistory(ctx, "")
 	for i := range accountWithdrawlHistory {
 		resp = append(resp, exchange.FundHistory{
 			Amount:       accountWithdrawlHistory[i].Amount,
@@ -261,13 +267,36 @@ func (o *OKGroup) GetFundingHistory() (resp []exchange.FundHistory, err error) {
 	return resp, err
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (o *OKGroup) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (o *OKGroup) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (o *OKGroup) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (o *OKGroup) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrNotYetImplemented
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (o *OKGroup) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (o *OKGroup) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (o *OKGroup) SubmitOrder(s *order.Submit) (resp order.SubmitResponse, err error) {
+func (o *OKGroup) SubmitOrder(ctx context.Context, s *order.Submit) (resp order.SubmitResponse, err error) {
 	err = s.Validate()
 	if err != nil {
 		return resp, err
@@ -278,13 +307,22 @@ func (o *OKGroup) SubmitOrder(s *order.Submit) (resp order.SubmitResponse, err e
 		InstrumentID: o.FormatExchangeCurrency(s.Pair, asset.Spot).String(),
 		Side:         s.OrderSide.Lower(),
 		Type:         s.OrderType.Lower(),
-		Size:         strconv.FormatFloat(s.Amount, 'f', -1, 64),
+		Size:         strconv.FormatFloat(s.Amount.Float64(), 'f', -1, 64),
 	}
 	if s.OrderType == order.Limit {
-		request.Price = strconv.FormatFloat(s.Price, 'f', -1, 64)
+		request.Price = strconv.FormatFloat(s.Price.Float64(), 'f', -1, 64)
+	}
+	switch s.TimeInForce {
+	case "", order.GTC:
+	case order.IOC:
+		request.OrderType = strconv.Itoa(ImmediateOrCancelOrder)
+	case order.FOK:
+		request.OrderType = strconv.Itoa(FillOrKillOrder)
+	default:
+		return resp, fmt.Errorf("%s does not support %s orders", o.Name, s.TimeInForce)
 	}
 
-	orderResponse, err := o.PlaceSpotOrder(&request)
+	orderResponse, err := o.PlaceSpotOrder(ctx, &request)
 	if err != nil {
 		return
 	}
@@ -299,17 +337,17 @@ func (o *OKGroup) SubmitOrder(s *order.Submit) (resp order.SubmitResponse, err e
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (o *OKGroup) ModifyOrder(action *order.Modify) (string, error) {
+func (o *OKGroup) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (o *OKGroup) CancelOrder(orderCancellation *order.Cancel) (err error) {
+func (o *OKGroup) CancelOrder(ctx context.Context, orderCancellation *order.Cancel) (err error) {
 	orderID, err := strconv.ParseInt(orderCancellation.OrderID, 10, 64)
 	if err != nil {
 		return
 	}
-	orderCancellationResponse, err := o.CancelSpotOrder(CancelSpotOrderRequest{
+	orderCancellationResponse, err := o.CancelSpotOrder(ctx, CancelSpotOrderRequest{
 		InstrumentID: o.FormatExchangeCurrency(orderCancellation.CurrencyPair,
 			asset.Spot).String(),
 		OrderID: orderID,
@@ -323,7 +361,7 @@ func (o *OKGroup) CancelOrder(orderCancellation *order.Cancel) (err error) {
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (o *OKGroup) CancelAllOrders(orderCancellation *order.Cancel) (resp order.CancelAllResponse, err error) {
+func (o *OKGroup) CancelAllOrders(ctx context.Context, orderCancellation *order.Cancel) (resp order.CancelAllResponse, err error) {
 	orderIDs := strings.Split(orderCancellation.OrderID, ",")
 	resp.Status = make(map[string]string)
 	var orderIDNumbers []int64
@@ -336,7 +374,7 @@ func (o *OKGroup) CancelAllOrders(orderCancellation *order.Cancel) (resp order.C
 		orderIDNumbers = append(orderIDNumbers, orderIDNumber)
 	}
 
-	cancelOrdersResponse, err := o.CancelMultipleSpotOrders(CancelMultipleSpotOrdersRequest{
+	cancelOrdersResponse, err := o.CancelMultipleSpotOrders(ctx, CancelMultipleSpotOrdersRequest{
 		InstrumentID: o.FormatExchangeCurrency(orderCancellation.CurrencyPair,
 			asset.Spot).String(),
 		OrderIDs: orderIDNumbers,
@@ -355,18 +393,20 @@ func (o *OKGroup) CancelAllOrders(orderCancellation *order.Cancel) (resp order.C
 }
 
 // GetOrderInfo returns information on a current open order
-func (o *OKGroup) GetOrderInfo(orderID string) (resp order.Detail, err error) {
-	mOrder, err := o.GetSpotOrder(GetSpotOrderRequest{OrderID: orderID})
+func (o *OKGroup) GetOrderInfo(ctx context.Context, orderID string) (resp order.Detail, err error) {
+	mOrder, err := o.GetSpotOrder(ctx, GetSpotOrderRequest{OrderID: orderID})
 	if err != nil {
 		return
 	}
 	resp = order.Detail{
-		Amount: mOrder.Size,
+		Amount: decimal.NewFromFloat(mOrder.Size),
 		CurrencyPair: currency.NewPairDelimiter(mOrder.InstrumentID,
 			o.GetPairFormat(asset.Spot, false).Delimiter),
 		Exchange:       o.Name,
+		ID:             mOrder.OrderID,
+		ClientID:       mOrder.ClientOID,
 		OrderDate:      mOrder.Timestamp,
-		ExecutedAmount: mOrder.FilledSize,
+		ExecutedAmount: decimal.NewFromFloat(mOrder.FilledSize),
 		Status:         order.Status(mOrder.Status),
 		OrderSide:      order.Side(mOrder.Side),
 	}
@@ -374,8 +414,8 @@ func (o *OKGroup) GetOrderInfo(orderID string) (resp order.Detail, err error) {
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (o *OKGroup) GetDepositAddress(p currency.Code, accountID string) (string, error) {
-	wallet, err := o.GetAccountDepositAddressForCurrency(p.Lower().String())
+func (o *OKGroup) GetDepositAddress(ctx context.Context, p currency.Code, accountID string) (string, error) {
+	wallet, err := o.GetAccountDepositAddressForCurrency(ctx, p.Lower().String())
 	if err != nil || len(wallet) == 0 {
 		return "", err
 	}
@@ -384,8 +424,8 @@ func (o *OKGroup) GetDepositAddress(p currency.Code, accountID string) (string,
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (o *OKGroup) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	withdrawal, err := o.AccountWithdraw(AccountWithdrawRequest{
+func (o *OKGroup) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	withdrawal, err := o.AccountWithdraw(ctx, AccountWithdrawRequest{
 		Amount:      withdrawRequest.Amount,
 		Currency:    withdrawRequest.Currency.Lower().String(),
 		Destination: 4, // 1, 2, 3 are all internal
@@ -408,20 +448,20 @@ func (o *OKGroup) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRe
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (o *OKGroup) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (o *OKGroup) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (o *OKGroup) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (o *OKGroup) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (o *OKGroup) GetActiveOrders(req *order.GetOrdersRequest) (resp []order.Detail, err error) {
+func (o *OKGroup) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) (resp []order.Detail, err error) {
 	for x := range req.Currencies {
-		spotOpenOrders, err := o.GetSpotOpenOrders(GetSpotOpenOrdersRequest{
+		spotOpenOrders, err := o.GetSpotOpenOrders(ctx, GetSpotOpenOrdersRequest{
 			InstrumentID: o.FormatExchangeCurrency(req.Currencies[x],
 				asset.Spot).String(),
 		})
@@ -431,13 +471,14 @@ func (o *OKGroup) GetActiveOrders(req *order.GetOrdersRequest) (resp []order.Det
 		for i := range spotOpenOrders {
 			resp = append(resp, order.Detail{
 				ID:             spotOpenOrders[i].OrderID,
-				Price:          spotOpenOrders[i].Price,
-				Amount:         spotOpenOrders[i].Size,
+				ClientID:       spotOpenOrders[i].ClientOID,
+				Price:          decimal.NewFromFloat(spotOpenOrders[i].Price),
+				Amount:         decimal.NewFromFloat(spotOpenOrders[i].Size),
 				CurrencyPair:   req.Currencies[x],
 				Exchange:       o.Name,
 				OrderSide:      order.Side(spotOpenOrders[i].Side),
 				OrderType:      order.Type(spotOpenOrders[i].Type),
-				ExecutedAmount: spotOpenOrders[i].FilledSize,
+				ExecutedAmount: decimal.NewFromFloat(spotOpenOrders[i].FilledSize),
 				OrderDate:      spotOpenOrders[i].Timestamp,
 				Status:         order.Status(spotOpenOrders[i].Status),
 			})
@@ -449,9 +490,9 @@ func (o *OKGroup) GetActiveOrders(req *order.GetOrdersRequest) (resp []order.Det
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (o *OKGroup) GetOrderHistory(req *order.GetOrdersRequest) (resp []order.Detail, err error) {
+func (o *OKGroup) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) (resp []order.Detail, err error) {
 	for x := range req.Currencies {
-		spotOpenOrders, err := o.GetSpotOrders(GetSpotOrdersRequest{
+		spotOpenOrders, err := o.GetSpotOrders(ctx, GetSpotOrdersRequest{
 			Status: strings.Join([]string{"filled", "cancelled", "failure"}, "|"),
 			InstrumentID: o.FormatExchangeCurrency(req.Currencies[x],
 				asset.Spot).String(),
@@ -462,13 +503,14 @@ func (o *OKGroup) GetOrderHistory(req *order.GetOrdersRequest) (resp []order.Det
 		for i := range spotOpenOrders {
 			resp = append(resp, order.Detail{
 				ID:             spotOpenOrders[i].OrderID,
-				Price:          spotOpenOrders[i].Price,
-				Amount:         spotOpenOrders[i].Size,
+				ClientID:       spotOpenOrders[i].ClientOID,
+				Price:          decimal.NewFromFloat(spotOpenOrders[i].Price),
+				Amount:         decimal.NewFromFloat(spotOpenOrders[i].Size),
 				CurrencyPair:   req.Currencies[x],
 				Exchange:       o.Name,
 				OrderSide:      order.Side(spotOpenOrders[i].Side),
 				OrderType:      order.Type(spotOpenOrders[i].Type),
-				ExecutedAmount: spotOpenOrders[i].FilledSize,
+				ExecutedAmount: decimal.NewFromFloat(spotOpenOrders[i].FilledSize),
 				OrderDate:      spotOpenOrders[i].Timestamp,
 				Status:         order.Status(spotOpenOrders[i].Status),
 			})
@@ -484,12 +526,12 @@ func (o *OKGroup) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (o *OKGroup) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (o *OKGroup) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !o.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return o.GetFee(feeBuilder)
+	return o.GetFee(ctx, feeBuilder)
 }
 
 // GetWithdrawCapabilities returns the types of withdrawal methods permitted by the exchange
@@ -497,18 +539,16 @@ func (o *OKGroup) GetWithdrawCapabilities() uint32 {
 	return o.GetWithdrawPermissions()
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (o *OKGroup) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	o.Websocket.SubscribeToChannels(channels)
-	return nil
+	return o.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (o *OKGroup) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	o.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return o.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -523,7 +563,12 @@ func (o *OKGroup) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (o *OKGroup) ValidateCredentials() error {
-	_, err := o.UpdateAccountInfo()
+func (o *OKGroup) ValidateCredentials(ctx context.Context) error {
+	_, err := o.UpdateAccountInfo(ctx)
 	return o.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (o *OKGroup) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -0,0 +1,94 @@
+package order
+
+import (
+	"encoding/hex"
+	"time"
+
+	"github.com/gofrs/uuid"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewCorrelationID returns a new ID used to tag every log line, audit record
+// and response for a single order so they can be grepped together
+func NewCorrelationID() string {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Unable to generate correlation ID. Err: %s\n", err)
+		return ""
+	}
+	return id.String()
+}
+
+// NewClientOrderID returns a new client order ID of 32 hexadecimal characters,
+// within the length and character set limits of the exchanges accepting them
+func NewClientOrderID() string {
+	id, err := uuid.NewV4()
+	if err != nil {
+		log.Warnf(log.OrderMgr, "Unable to generate client order ID. Err: %s\n", err)
+		return ""
+	}
+	return hex.EncodeToString(id.Bytes())
+}
+
+// TrackCorrelation associates an acknowledged order with its correlation ID so
+// later updates for the order, such as websocket fills, can be tagged with it
+func TrackCorrelation(exchName, orderID, correlationID string) {
+	if orderID == "" || correlationID == "" {
+		return
+	}
+	now := time.Now()
+	correlationsMtx.Lock()
+	if len(correlations) >= maxCorrelations {
+		for k, v := range correlations {
+			if now.Sub(v.added) > correlationTTL {
+				delete(correlations, k)
+			}
+		}
+		if len(correlations) >= maxCorrelations {
+			// Drop an arbitrary order rather than growing unbounded
+			for k := range correlations {
+				delete(correlations, k)
+				break
+			}
+		}
+	}
+	correlations[correlationKey(exchName, orderID)] = trackedCorrelation{id: correlationID, added: now}
+	correlationsMtx.Unlock()
+}
+
+// CorrelationID returns the correlation ID of a tracked order, or an empty
+// string if the order is not tracked
+func CorrelationID(exchName, orderID string) string {
+	if orderID == "" {
+		return ""
+	}
+	key := correlationKey(exchName, orderID)
+	correlationsMtx.Lock()
+	defer correlationsMtx.Unlock()
+	c, ok := correlations[key]
+	if !ok {
+		return ""
+	}
+	if time.Since(c.added) > correlationTTL {
+		delete(correlations, key)
+		return ""
+	}
+	return c.id
+}
+
+// LogUpdate logs an update for a tracked order, tagged with the order's
+// correlation ID. Updates for untracked orders are ignored
+func LogUpdate(exchName, orderID, update string) {
+	id := CorrelationID(exchName, orderID)
+	if id == "" {
+		return
+	}
+	log.WithFields(log.OrderMgr, log.Fields{
+		Exchange:      exchName,
+		CorrelationID: id,
+	}).Infof("Order %s update: %s\n", orderID, update)
+}
+
+func correlationKey(exchName, orderID string) string {
+	return exchName + ":" + orderID
+}
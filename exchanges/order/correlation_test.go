@@ -0,0 +1,43 @@
+package order
+
+import "testing"
+
+func TestCorrelation(t *testing.T) {
+	id := NewCorrelationID()
+	if len(id) != 36 {
+		t.Fatalf("unexpected correlation ID %s", id)
+	}
+	if NewCorrelationID() == id {
+		t.Error("expected correlation IDs to be unique")
+	}
+
+	TrackCorrelation("Bitstamp", "1337", id)
+	if c := CorrelationID("Bitstamp", "1337"); c != id {
+		t.Errorf("expected %s, received %s", id, c)
+	}
+	if c := CorrelationID("Binance", "1337"); c != "" {
+		t.Errorf("expected untracked order to have no correlation ID, received %s", c)
+	}
+	if c := CorrelationID("Bitstamp", ""); c != "" {
+		t.Errorf("expected empty order ID to have no correlation ID, received %s", c)
+	}
+	LogUpdate("Bitstamp", "1337", "ws.fill")
+	LogUpdate("Bitstamp", "untracked", "ws.fill")
+
+	// Untracked when either ID is missing
+	TrackCorrelation("Bitstamp", "", id)
+	TrackCorrelation("Bitstamp", "1338", "")
+	if c := CorrelationID("Bitstamp", "1338"); c != "" {
+		t.Errorf("expected order without correlation ID to be untracked, received %s", c)
+	}
+}
+
+func TestNewClientOrderID(t *testing.T) {
+	id := NewClientOrderID()
+	if len(id) != 32 {
+		t.Fatalf("unexpected client order ID %s", id)
+	}
+	if NewClientOrderID() == id {
+		t.Error("expected client order IDs to be unique")
+	}
+}
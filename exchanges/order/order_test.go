@@ -6,6 +6,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 )
 
@@ -15,8 +16,11 @@ func TestValidate(t *testing.T) {
 		Pair currency.Pair
 		Side
 		Type
+		TimeInForce
 		Amount      float64
 		Price       float64
+		Policy      PartialFillPolicy
+		ExpiresAt   time.Time
 		ExpectedErr error
 	}{
 		{
@@ -67,15 +71,83 @@ func TestValidate(t *testing.T) {
 			Price:       1000,
 			ExpectedErr: nil,
 		}, // valid order!
+		{
+			Pair:        testPair,
+			Side:        Ask,
+			Type:        Limit,
+			Amount:      1,
+			Price:       1000,
+			Policy:      "chase",
+			ExpectedErr: ErrPartialFillPolicyInvalid,
+		}, // valid order with an unknown partial fill policy
+		{
+			Pair:        testPair,
+			Side:        Ask,
+			Type:        Market,
+			Amount:      1,
+			Policy:      PartialFillRepeg,
+			ExpectedErr: ErrRepegRequiresLimitOrder,
+		}, // market order can't be re-pegged
+		{
+			Pair:        testPair,
+			Side:        Ask,
+			Type:        Limit,
+			Amount:      1,
+			Price:       1000,
+			Policy:      PartialFillRepeg,
+			ExpectedErr: nil,
+		}, // valid order with a partial fill policy
+		{
+			Pair:        testPair,
+			Side:        Buy,
+			Type:        Limit,
+			Amount:      1,
+			Price:       1000,
+			TimeInForce: "GTX",
+			ExpectedErr: ErrTimeInForceInvalid,
+		}, // valid order with an unknown time in force
+		{
+			Pair:        testPair,
+			Side:        Buy,
+			Type:        Limit,
+			Amount:      1,
+			Price:       1000,
+			TimeInForce: GTD,
+			ExpiresAt:   time.Now().Add(-time.Minute),
+			ExpectedErr: ErrExpiryRequired,
+		}, // good till date order which has already expired
+		{
+			Pair:        testPair,
+			Side:        Buy,
+			Type:        Limit,
+			Amount:      1,
+			Price:       1000,
+			TimeInForce: IOC,
+			ExpiresAt:   time.Now().Add(time.Minute),
+			ExpectedErr: ErrExpiryRequiresGoodTillDate,
+		}, // expiry on an immediate or cancel order
+		{
+			Pair:        testPair,
+			Side:        Buy,
+			Type:        Limit,
+			Amount:      1,
+			Price:       1000,
+			TimeInForce: GTD,
+			ExpiresAt:   time.Now().Add(time.Minute),
+			ExpectedErr: nil,
+		}, // valid good till date order
 	}
 
 	for x := range tester {
 		s := Submit{
-			Pair:      tester[x].Pair,
-			OrderSide: tester[x].Side,
-			OrderType: tester[x].Type,
-			Amount:    tester[x].Amount,
-			Price:     tester[x].Price,
+			Pair:              tester[x].Pair,
+			OrderSide:         tester[x].Side,
+			OrderType:         tester[x].Type,
+			Amount:            decimal.NewFromFloat(tester[x].Amount),
+			Price:             decimal.NewFromFloat(tester[x].Price),
+			PartialFillPolicy: tester[x].Policy,
+			TimeInForce:       tester[x].TimeInForce,
+			ExpiresAt:         tester[x].ExpiresAt,
 		}
 		if err := s.Validate(); err != tester[x].ExpectedErr {
 			t.Errorf("Unexpected result. Got: %s, want: %s", err, tester[x].ExpectedErr)
@@ -251,21 +323,21 @@ func TestSortOrdersByPrice(t *testing.T) {
 
 	orders := []Detail{
 		{
-			Price: 100,
+			Price: decimal.NewFromInt(100),
 		}, {
-			Price: 0,
+			Price: decimal.Zero,
 		}, {
-			Price: 50,
+			Price: decimal.NewFromInt(50),
 		},
 	}
 
 	SortOrdersByPrice(&orders, false)
-	if orders[0].Price != 0 {
+	if !orders[0].Price.IsZero() {
 		t.Errorf("Expected: '%v', received: '%v'", 0, orders[0].Price)
 	}
 
 	SortOrdersByPrice(&orders, true)
-	if orders[0].Price != 100 {
+	if !orders[0].Price.Equal(decimal.NewFromInt(100)) {
 		t.Errorf("Expected: '%v', received: '%v'", 100, orders[0].Price)
 	}
 }
@@ -534,3 +606,87 @@ func TestStringToOrderStatus(t *testing.T) {
 		})
 	}
 }
+
+func TestExecutionReportValidate(t *testing.T) {
+	var nilReport *ExecutionReport
+	if err := nilReport.Validate(); err != ErrExecutionReportIsNil {
+		t.Errorf("expected %v received %v", ErrExecutionReportIsNil, err)
+	}
+
+	testPair := currency.NewPair(currency.BTC, currency.USD)
+	tester := []struct {
+		ExecutionReport
+		ExpectedErr error
+	}{
+		{ExpectedErr: ErrExchangeNameIsEmpty},
+		{ExecutionReport{Exchange: "test"}, ErrOrderIDIsEmpty},
+		{ExecutionReport{Exchange: "test", OrderID: "1"}, ErrTradeIDIsEmpty},
+		{ExecutionReport{Exchange: "test", OrderID: "1", TradeID: "2"}, ErrPairIsEmpty},
+		{ExecutionReport{Exchange: "test", OrderID: "1", TradeID: "2", Pair: testPair}, ErrAmountIsInvalid},
+		{ExecutionReport{Exchange: "test", OrderID: "1", TradeID: "2", Pair: testPair, Amount: decimal.NewFromInt(1)}, nil},
+	}
+	for x := range tester {
+		if err := tester[x].Validate(); err != tester[x].ExpectedErr {
+			t.Errorf("test %d: expected %v received %v", x, tester[x].ExpectedErr, err)
+		}
+	}
+}
+
+func TestModifyValidate(t *testing.T) {
+	var nilModify *Modify
+	if err := nilModify.Validate(); err != ErrModifyIsNil {
+		t.Errorf("expected %v received %v", ErrModifyIsNil, err)
+	}
+
+	tester := []struct {
+		Modify
+		ExpectedErr error
+	}{
+		{ExpectedErr: ErrOrderIDIsEmpty},
+		{Modify{OrderID: "1"}, ErrModifyUnchanged},
+		{Modify{OrderID: "1", Price: decimal.NewFromInt(-1)}, ErrModifyUnchanged},
+		{Modify{OrderID: "1", Price: decimal.NewFromInt(100)}, nil},
+		{Modify{OrderID: "1", Amount: decimal.NewFromInt(1)}, nil},
+	}
+	for x := range tester {
+		if err := tester[x].Validate(); err != tester[x].ExpectedErr {
+			t.Errorf("test %d: expected %v received %v", x, tester[x].ExpectedErr, err)
+		}
+	}
+}
+
+func TestExecutionReportTradeHistory(t *testing.T) {
+	e := ExecutionReport{
+		Exchange:    "test",
+		TradeID:     "2",
+		Side:        Sell,
+		Price:       decimal.NewFromInt(100),
+		Amount:      decimal.NewFromInt(1),
+		Fee:         decimal.NewFromFloat(0.1),
+		FeeCurrency: currency.USD,
+		Liquidity:   Maker,
+	}
+	th := e.TradeHistory()
+	if th.TID != "2" || th.Side != Sell || !th.Fee.Equal(e.Fee) ||
+		th.FeeCurrency != currency.USD || th.Liquidity != Maker {
+		t.Errorf("unexpected trade history %+v", th)
+	}
+}
+
+func TestStringToLiquidity(t *testing.T) {
+	tester := map[string]Liquidity{
+		"maker":            Maker,
+		"M":                Maker,
+		"AddedLiquidity":   Maker,
+		"Taker":            Taker,
+		"t":                Taker,
+		"RemovedLiquidity": Taker,
+		"":                 UnknownLiquidity,
+		"auction":          UnknownLiquidity,
+	}
+	for in, expected := range tester {
+		if out := StringToLiquidity(in); out != expected {
+			t.Errorf("%s: expected %v received %v", in, expected, out)
+		}
+	}
+}
@@ -2,8 +2,10 @@ package order
 
 import (
 	"errors"
+	"sync"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 )
@@ -13,6 +15,23 @@ const (
 	marketOrder
 )
 
+const (
+	// correlationTTL is how long an acknowledged order is kept so that its
+	// updates can be tagged with its correlation ID
+	correlationTTL  = time.Hour * 24
+	maxCorrelations = 10000
+)
+
+var (
+	correlations    = make(map[string]trackedCorrelation)
+	correlationsMtx sync.Mutex
+)
+
+type trackedCorrelation struct {
+	id    string
+	added time.Time
+}
+
 // Orders variable holds an array of pointers to order structs
 var Orders []*Order
 
@@ -21,8 +40,8 @@ type Order struct {
 	OrderID  int
 	Exchange string
 	Type     int
-	Amount   float64
-	Price    float64
+	Amount   decimal.Decimal
+	Price    decimal.Decimal
 }
 
 // vars related to orders
@@ -33,20 +52,79 @@ var (
 	ErrTypeIsInvalid              = errors.New("order type is invalid")
 	ErrAmountIsInvalid            = errors.New("order amount is invalid")
 	ErrPriceMustBeSetIfLimitOrder = errors.New("order price must be set if limit order type is desired")
+	ErrExecutionReportIsNil       = errors.New("execution report is nil")
+	ErrExchangeNameIsEmpty        = errors.New("order exchange name is empty")
+	ErrOrderIDIsEmpty             = errors.New("order ID is empty")
+	ErrTradeIDIsEmpty             = errors.New("trade ID is empty")
+	ErrModifyIsNil                = errors.New("order modify is nil")
+	ErrModifyUnchanged            = errors.New("order modify must change the price or amount")
+	ErrPartialFillPolicyInvalid   = errors.New("order partial fill policy is invalid")
+	ErrRepegRequiresLimitOrder    = errors.New("order partial fill re-peg policy requires a limit order")
+	ErrTimeInForceInvalid         = errors.New("order time in force is invalid")
+	ErrExpiryRequired             = errors.New("order expiry must be set in the future for good till date orders")
+	ErrExpiryRequiresGoodTillDate = errors.New("order expiry requires the good till date time in force")
 )
 
 // Submit contains the order submission data
 type Submit struct {
-	Pair         currency.Pair
+	Pair currency.Pair
+	// AssetType is only used by exchanges which trade the pair as more than
+	// one asset, it defaults to spot
+	AssetType    asset.Item
 	OrderType    Type
 	OrderSide    Side
-	TriggerPrice float64
-	TargetAmount float64
-	Price        float64
-	Amount       float64
+	TriggerPrice decimal.Decimal
+	TargetAmount decimal.Decimal
+	Price        decimal.Decimal
+	Amount       decimal.Decimal
 	ClientID     string
+	// CorrelationID tags all log lines, audit records and responses for the
+	// submission
+	CorrelationID string
+	// StrategyID is the strategy submitting the order, whose virtual balances
+	// the order manager reserves the order's cost from
+	StrategyID string
+	// PartialFillPolicy is what the order manager does with the remainder of
+	// the order once it has been partially filled for PartialFillTimeout,
+	// defaulting to the strategy's policy
+	PartialFillPolicy  PartialFillPolicy
+	PartialFillTimeout time.Duration
+	// TimeInForce is how long the order rests on the book, defaulting to
+	// good till cancelled. ExpiresAt is when a good till date order expires
+	TimeInForce TimeInForce
+	ExpiresAt   time.Time
 }
 
+// TimeInForce is how long an order remains in effect
+type TimeInForce string
+
+// Time in force types
+const (
+	// GTC rests on the book until it fills or is cancelled
+	GTC TimeInForce = "GTC"
+	// IOC fills what it can immediately and cancels the remainder
+	IOC TimeInForce = "IOC"
+	// FOK fills entirely immediately or is cancelled
+	FOK TimeInForce = "FOK"
+	// GTD rests on the book until it fills, is cancelled or expires
+	GTD TimeInForce = "GTD"
+)
+
+// PartialFillPolicy is what is done with the remainder of a partially filled
+// order
+type PartialFillPolicy string
+
+// Partial fill policies
+const (
+	// PartialFillLeave leaves the remainder on the book
+	PartialFillLeave PartialFillPolicy = "leave"
+	// PartialFillCancel cancels the remainder after the timeout
+	PartialFillCancel PartialFillPolicy = "cancel"
+	// PartialFillRepeg moves the remainder to the top of the book after the
+	// timeout, and every timeout after until it fills
+	PartialFillRepeg PartialFillPolicy = "repeg"
+)
+
 // SubmitResponse is what is returned after submitting an order to an exchange
 type SubmitResponse struct {
 	IsOrderPlaced bool
@@ -54,20 +132,24 @@ type SubmitResponse struct {
 	OrderID       string
 }
 
-// Modify is an order modifyer
+// Modify is an order modifyer. Amount is the order's new total amount,
+// including any part already filled
 type Modify struct {
 	OrderID string
 	Type
 	Side
-	Price             float64
-	Amount            float64
-	LimitPriceUpper   float64
-	LimitPriceLower   float64
+	Price             decimal.Decimal
+	Amount            decimal.Decimal
+	LimitPriceUpper   decimal.Decimal
+	LimitPriceLower   decimal.Decimal
 	CurrencyPair      currency.Pair
 	ImmediateOrCancel bool
 	HiddenOrder       bool
 	FillOrKill        bool
 	PostOnly          bool
+	// AssetType is the asset of the order, used when the order is amended by
+	// cancelling and replacing it
+	AssetType asset.Item
 }
 
 // ModifyResponse is an order modifying return type
@@ -113,16 +195,17 @@ type Detail struct {
 	Exchange        string
 	AccountID       string
 	ID              string
+	ClientID        string
 	CurrencyPair    currency.Pair
 	OrderSide       Side
 	OrderType       Type
 	OrderDate       time.Time
 	Status          Status
-	Price           float64
-	Amount          float64
-	ExecutedAmount  float64
-	RemainingAmount float64
-	Fee             float64
+	Price           decimal.Decimal
+	Amount          decimal.Decimal
+	ExecutedAmount  decimal.Decimal
+	RemainingAmount decimal.Decimal
+	Fee             decimal.Decimal
 	Trades          []TradeHistory
 }
 
@@ -130,15 +213,46 @@ type Detail struct {
 type TradeHistory struct {
 	Timestamp   time.Time
 	TID         string
-	Price       float64
-	Amount      float64
+	Price       decimal.Decimal
+	Amount      decimal.Decimal
 	Exchange    string
 	Type        Type
 	Side        Side
-	Fee         float64
+	Fee         decimal.Decimal
+	FeeCurrency currency.Code
+	Liquidity   Liquidity
 	Description string
 }
 
+// Liquidity defines whether an execution added liquidity to or removed
+// liquidity from the order book
+type Liquidity string
+
+// Execution liquidity types
+const (
+	Maker            Liquidity = "MAKER"
+	Taker            Liquidity = "TAKER"
+	UnknownLiquidity Liquidity = "UNKNOWN"
+)
+
+// ExecutionReport is a single fill of an order received from an exchange's
+// private feed, normalised so consumers don't need exchange specific parsing
+type ExecutionReport struct {
+	Exchange  string
+	AssetType asset.Item
+	Pair      currency.Pair
+	OrderID   string
+	TradeID   string
+	Side      Side
+	Price     decimal.Decimal
+	Amount    decimal.Decimal
+	// Fee is the fee paid for the fill in FeeCurrency, a rebate is negative
+	Fee         decimal.Decimal
+	FeeCurrency currency.Code
+	Liquidity   Liquidity
+	Timestamp   time.Time
+}
+
 // Cancel type required when requesting to cancel an order
 type Cancel struct {
 	AccountID     string
@@ -6,11 +6,12 @@ import (
 	"strings"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 )
 
 // NewOrder creates a new order and returns a an orderID
-func NewOrder(exchangeName string, amount, price float64) int {
+func NewOrder(exchangeName string, amount, price decimal.Decimal) int {
 	ord := &Order{}
 	if len(Orders) == 0 {
 		ord.OrderID = 0
@@ -81,17 +82,122 @@ func (s *Submit) Validate() error {
 		return ErrTypeIsInvalid
 	}
 
-	if s.Amount <= 0 {
+	if s.Amount.Sign() <= 0 {
 		return ErrAmountIsInvalid
 	}
 
-	if s.OrderType == Limit && s.Price <= 0 {
+	if s.OrderType == Limit && s.Price.Sign() <= 0 {
 		return ErrPriceMustBeSetIfLimitOrder
 	}
 
+	if s.PartialFillPolicy != "" && !s.PartialFillPolicy.Valid() {
+		return ErrPartialFillPolicyInvalid
+	}
+
+	if s.PartialFillPolicy == PartialFillRepeg && s.OrderType != Limit {
+		return ErrRepegRequiresLimitOrder
+	}
+
+	if s.TimeInForce != "" && !s.TimeInForce.Valid() {
+		return ErrTimeInForceInvalid
+	}
+
+	if s.TimeInForce == GTD && !s.ExpiresAt.After(time.Now()) {
+		return ErrExpiryRequired
+	}
+
+	if s.TimeInForce != GTD && !s.ExpiresAt.IsZero() {
+		return ErrExpiryRequiresGoodTillDate
+	}
+
+	return nil
+}
+
+// Valid returns whether the time in force is known
+func (t TimeInForce) Valid() bool {
+	switch t {
+	case GTC, IOC, FOK, GTD:
+		return true
+	}
+	return false
+}
+
+// Valid returns whether the partial fill policy is known
+func (p PartialFillPolicy) Valid() bool {
+	switch p {
+	case PartialFillLeave, PartialFillCancel, PartialFillRepeg:
+		return true
+	}
+	return false
+}
+
+// Validate checks an execution report can be linked to its order
+func (e *ExecutionReport) Validate() error {
+	if e == nil {
+		return ErrExecutionReportIsNil
+	}
+
+	if e.Exchange == "" {
+		return ErrExchangeNameIsEmpty
+	}
+
+	if e.OrderID == "" {
+		return ErrOrderIDIsEmpty
+	}
+
+	if e.TradeID == "" {
+		return ErrTradeIDIsEmpty
+	}
+
+	if e.Pair.IsEmpty() {
+		return ErrPairIsEmpty
+	}
+
+	if e.Amount.Sign() <= 0 {
+		return ErrAmountIsInvalid
+	}
+
 	return nil
 }
 
+// Validate checks a modification identifies its order and changes its price
+// or amount
+func (m *Modify) Validate() error {
+	if m == nil {
+		return ErrModifyIsNil
+	}
+
+	if m.OrderID == "" {
+		return ErrOrderIDIsEmpty
+	}
+
+	if m.Price.Sign() <= 0 && m.Amount.Sign() <= 0 {
+		return ErrModifyUnchanged
+	}
+
+	return nil
+}
+
+// TradeHistory returns the execution as a trade of its order
+func (e *ExecutionReport) TradeHistory() TradeHistory {
+	return TradeHistory{
+		Timestamp:   e.Timestamp,
+		TID:         e.TradeID,
+		Price:       e.Price,
+		Amount:      e.Amount,
+		Exchange:    e.Exchange,
+		Side:        e.Side,
+		Fee:         e.Fee,
+		FeeCurrency: e.FeeCurrency,
+		Liquidity:   e.Liquidity,
+	}
+}
+
+// String implements the stringer interface
+func (l Liquidity) String() string {
+	return string(l)
+}
+
 // String implements the stringer interface
 func (t Type) String() string {
 	return string(t)
@@ -202,7 +308,7 @@ func (b ByPrice) Len() int {
 }
 
 func (b ByPrice) Less(i, j int) bool {
-	return b[i].Price < b[j].Price
+	return b[i].Price.LessThan(b[j].Price)
 }
 
 func (b ByPrice) Swap(i, j int) {
@@ -370,3 +476,16 @@ func StringToOrderStatus(status string) (Status, error) {
 		return UnknownStatus, fmt.Errorf("%s not recognised as order STATUS", status)
 	}
 }
+
+// StringToLiquidity converts the case insensitive maker/taker flags used by
+// exchanges into a Liquidity
+func StringToLiquidity(liquidity string) Liquidity {
+	switch strings.ToLower(liquidity) {
+	case "maker", "m", "add", "added", "addedliquidity":
+		return Maker
+	case "taker", "t", "remove", "removed", "removedliquidity":
+		return Taker
+	default:
+		return UnknownLiquidity
+	}
+}
@@ -2,14 +2,16 @@ package order
 
 import (
 	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 )
 
 func TestNewOrder(t *testing.T) {
-	ID := NewOrder("OKEX", 2000, 20.00)
+	ID := NewOrder("OKEX", decimal.NewFromInt(2000), decimal.NewFromFloat(20.00))
 	if ID != 0 {
 		t.Error("Orders_test.go NewOrder() - Error")
 	}
-	ID = NewOrder("BATMAN", 400, 25.00)
+	ID = NewOrder("BATMAN", decimal.NewFromInt(400), decimal.NewFromFloat(25.00))
 	if ID != 1 {
 		t.Error("Orders_test.go NewOrder() - Error")
 	}
@@ -1,6 +1,7 @@
 package poloniex
 
 import (
+	"context"
 	"encoding/json"
 	"net/http"
 	"testing"
@@ -8,6 +9,7 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -32,7 +34,7 @@ func areTestAPIKeysSet() bool {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetTicker()
+	_, err := p.GetTicker(context.Background())
 	if err != nil {
 		t.Error("Poloniex GetTicker() error", err)
 	}
@@ -40,7 +42,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetVolume(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetVolume()
+	_, err := p.GetVolume(context.Background())
 	if err != nil {
 		t.Error("Test faild - Poloniex GetVolume() error")
 	}
@@ -48,7 +50,7 @@ func TestGetVolume(t *testing.T) {
 
 func TestGetOrderbook(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetOrderbook("BTC_XMR", 50)
+	_, err := p.GetOrderbook(context.Background(), "BTC_XMR", 50)
 	if err != nil {
 		t.Error("Test faild - Poloniex GetOrderbook() error", err)
 	}
@@ -56,7 +58,7 @@ func TestGetOrderbook(t *testing.T) {
 
 func TestGetTradeHistory(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetTradeHistory("BTC_XMR", "", "")
+	_, err := p.GetTradeHistory(context.Background(), "BTC_XMR", "", "")
 	if err != nil {
 		t.Error("Test faild - Poloniex GetTradeHistory() error", err)
 	}
@@ -64,7 +66,7 @@ func TestGetTradeHistory(t *testing.T) {
 
 func TestGetChartData(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetChartData("BTC_XMR", "1405699200", "1405699400", "300")
+	_, err := p.GetChartData(context.Background(), "BTC_XMR", "1405699200", "1405699400", "300")
 	if err != nil {
 		t.Error("Test faild - Poloniex GetChartData() error", err)
 	}
@@ -72,7 +74,7 @@ func TestGetChartData(t *testing.T) {
 
 func TestGetCurrencies(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetCurrencies()
+	_, err := p.GetCurrencies(context.Background())
 	if err != nil {
 		t.Error("Test faild - Poloniex GetCurrencies() error", err)
 	}
@@ -80,7 +82,7 @@ func TestGetCurrencies(t *testing.T) {
 
 func TestGetLoanOrders(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetLoanOrders("BTC")
+	_, err := p.GetLoanOrders(context.Background(), "BTC")
 	if err != nil {
 		t.Error("Test faild - Poloniex GetLoanOrders() error", err)
 	}
@@ -104,7 +106,7 @@ func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	t.Parallel()
 
 	var feeBuilder = setFeeBuilder()
-	p.GetFeeByType(feeBuilder)
+	p.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v",
@@ -126,7 +128,7 @@ func TestGetFee(t *testing.T) {
 
 	if areTestAPIKeysSet() || mockTests {
 		// CryptocurrencyTradeFee Basic
-		if resp, err := p.GetFee(feeBuilder); resp != float64(0.0025) || err != nil {
+		if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0.0025) || err != nil {
 			t.Error(err)
 			t.Errorf("GetFee() error. Expected: %f, Received: %f",
 				float64(0.0025), resp)
@@ -136,7 +138,7 @@ func TestGetFee(t *testing.T) {
 		feeBuilder = setFeeBuilder()
 		feeBuilder.Amount = 1000
 		feeBuilder.PurchasePrice = 1000
-		if resp, err := p.GetFee(feeBuilder); resp != float64(2500) || err != nil {
+		if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(2500) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f",
 				float64(2500), resp)
 			t.Error(err)
@@ -145,7 +147,7 @@ func TestGetFee(t *testing.T) {
 		// CryptocurrencyTradeFee Negative purchase price
 		feeBuilder = setFeeBuilder()
 		feeBuilder.PurchasePrice = -1000
-		if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+		if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 			t.Errorf("GetFee() error. Expected: %f, Received: %f",
 				float64(0), resp)
 			t.Error(err)
@@ -154,7 +156,7 @@ func TestGetFee(t *testing.T) {
 	// CryptocurrencyWithdrawalFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0.001) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0.001) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0.001), resp)
 		t.Error(err)
@@ -164,7 +166,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.Pair.Base = currency.NewCode("hello")
 	feeBuilder.FeeType = exchange.CryptocurrencyWithdrawalFee
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0), resp)
 		t.Error(err)
@@ -173,7 +175,7 @@ func TestGetFee(t *testing.T) {
 	// CyptocurrencyDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.CyptocurrencyDepositFee
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0), resp)
 		t.Error(err)
@@ -182,7 +184,7 @@ func TestGetFee(t *testing.T) {
 	// InternationalBankDepositFee Basic
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankDepositFee
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0), resp)
 		t.Error(err)
@@ -192,7 +194,7 @@ func TestGetFee(t *testing.T) {
 	feeBuilder = setFeeBuilder()
 	feeBuilder.FeeType = exchange.InternationalBankWithdrawalFee
 	feeBuilder.FiatCurrency = currency.USD
-	if resp, err := p.GetFee(feeBuilder); resp != float64(0) || err != nil {
+	if resp, err := p.GetFee(context.Background(), feeBuilder); resp != float64(0) || err != nil {
 		t.Errorf("GetFee() error. Expected: %f, Received: %f",
 			float64(0), resp)
 		t.Error(err)
@@ -218,7 +220,7 @@ func TestGetActiveOrders(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := p.GetActiveOrders(&getOrdersRequest)
+	_, err := p.GetActiveOrders(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetActiveOrders() error", err)
@@ -235,7 +237,7 @@ func TestGetOrderHistory(t *testing.T) {
 		OrderType: order.AnyType,
 	}
 
-	_, err := p.GetOrderHistory(&getOrdersRequest)
+	_, err := p.GetOrderHistory(context.Background(), &getOrdersRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Errorf("Could not get order history: %s", err)
@@ -263,12 +265,12 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Market,
-		Price:     10,
-		Amount:    10000000,
+		Price:     decimal.NewFromInt(10),
+		Amount:    decimal.NewFromInt(10000000),
 		ClientID:  "hi",
 	}
 
-	response, err := p.SubmitOrder(orderSubmission)
+	response, err := p.SubmitOrder(context.Background(), orderSubmission)
 	switch {
 	case areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced):
 		t.Errorf("Order failed to be placed: %v", err)
@@ -291,7 +293,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currency.NewPair(currency.LTC, currency.BTC),
 	}
 
-	err := p.CancelOrder(orderCancellation)
+	err := p.CancelOrder(context.Background(), orderCancellation)
 	switch {
 	case !areTestAPIKeysSet() && !mockTests && err == nil:
 		t.Error("Expecting an error when no keys are set")
@@ -316,7 +318,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := p.CancelAllOrders(orderCancellation)
+	resp, err := p.CancelAllOrders(context.Background(), orderCancellation)
 	switch {
 	case !areTestAPIKeysSet() && !mockTests && err == nil:
 		t.Error("Expecting an error when no keys are set")
@@ -336,7 +338,7 @@ func TestModifyOrder(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := p.ModifyOrder(&order.Modify{OrderID: "1337", Price: 1337})
+	_, err := p.ModifyOrder(context.Background(), &order.Modify{OrderID: "1337", Price: decimal.NewFromInt(1337)})
 	switch {
 	case areTestAPIKeysSet() && err != nil && mockTests:
 		t.Error("ModifyOrder() error", err)
@@ -362,7 +364,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := p.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := p.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Errorf("Withdraw failed to be placed: %v", err)
@@ -380,7 +382,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest withdraw.FiatRequest
-	_, err := p.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := p.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported, err)
@@ -394,7 +396,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest withdraw.FiatRequest
-	_, err := p.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := p.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported, err)
@@ -403,7 +405,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	t.Parallel()
-	_, err := p.GetDepositAddress(currency.DASH, "")
+	_, err := p.GetDepositAddress(context.Background(), currency.DASH, "")
 	switch {
 	case areTestAPIKeysSet() && err != nil:
 		t.Error("GetDepositAddress()", err)
@@ -1,6 +1,7 @@
 package poloniex
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -49,7 +50,7 @@ func (p *Poloniex) WsConnect() error {
 
 	if currencyIDMap == nil {
 		currencyIDMap = make(map[int]string)
-		resp, err := p.GetTicker()
+		resp, err := p.GetTicker(context.Background())
 		if err != nil {
 			return err
 		}
@@ -98,7 +99,7 @@ func (p *Poloniex) WsHandleData() {
 			var result interface{}
 			err = json.Unmarshal(resp.Raw, &result)
 			if err != nil {
-				p.Websocket.DataHandler <- err
+				p.Websocket.DataHandler <- wshandler.NewError(p.Name, wshandler.ErrorMalformedMessage, err)
 				continue
 			}
 			switch data := result.(type) {
@@ -116,9 +117,8 @@ func (p *Poloniex) WsHandleData() {
 								chanID)
 						}
 					} else {
-						p.Websocket.DataHandler <- fmt.Errorf("%s websocket subscription to channel failed. %d",
-							p.Name,
-							chanID)
+						p.Websocket.DataHandler <- wshandler.NewSubscriptionError(p.Name, nil,
+							fmt.Errorf("subscription to channel failed. %d", chanID))
 					}
 					continue
 				}
@@ -522,7 +522,7 @@ func (p *Poloniex) Unsubscribe(channelToSubscribe wshandler.WebsocketChannelSubs
 }
 
 func (p *Poloniex) wsSendAuthorisedCommand(command string) error {
-	nonce := fmt.Sprintf("nonce=%v", time.Now().UnixNano())
+	nonce := fmt.Sprintf("nonce=%v", p.Requester.Now().UnixNano())
 	hmac := crypto.GetHMAC(crypto.HashSHA512, []byte(nonce), []byte(p.API.Credentials.Secret))
 	request := WsAuthorisationRequest{
 		Command: command,
@@ -1,6 +1,7 @@
 package poloniex
 
 import (
+	"context"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -37,7 +39,7 @@ func (p *Poloniex) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if p.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = p.UpdateTradablePairs(true)
+		err = p.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -156,10 +158,13 @@ func (p *Poloniex) Setup(exch *config.ExchangeConfig) error {
 	p.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         p.Name,
 		URL:                  p.Websocket.GetWebsocketURL(),
+		AlternateURLs:        p.Websocket.GetAlternateURLs(),
 		ProxyURL:             p.Websocket.GetProxyAddress(),
 		Verbose:              p.Verbose,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 
 	p.Websocket.Orderbook.Setup(
@@ -199,15 +204,15 @@ func (p *Poloniex) Run() {
 		return
 	}
 
-	err := p.UpdateTradablePairs(forceUpdate)
+	err := p.UpdateTradablePairs(context.Background(), forceUpdate)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", p.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (p *Poloniex) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	resp, err := p.GetTicker()
+func (p *Poloniex) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	resp, err := p.GetTicker(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -222,8 +227,8 @@ func (p *Poloniex) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (p *Poloniex) UpdateTradablePairs(forceUpgrade bool) error {
-	pairs, err := p.FetchTradablePairs(asset.Spot)
+func (p *Poloniex) UpdateTradablePairs(ctx context.Context, forceUpgrade bool) error {
+	pairs, err := p.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -232,9 +237,9 @@ func (p *Poloniex) UpdateTradablePairs(forceUpgrade bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (p *Poloniex) UpdateTicker(currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (p *Poloniex) UpdateTicker(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
-	tick, err := p.GetTicker()
+	tick, err := p.GetTicker(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -264,27 +269,27 @@ func (p *Poloniex) UpdateTicker(currencyPair currency.Pair, assetType asset.Item
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (p *Poloniex) FetchTicker(currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (p *Poloniex) FetchTicker(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(p.Name, currencyPair, assetType)
 	if err != nil {
-		return p.UpdateTicker(currencyPair, assetType)
+		return p.UpdateTicker(ctx, currencyPair, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (p *Poloniex) FetchOrderbook(currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (p *Poloniex) FetchOrderbook(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(p.Name, currencyPair, assetType)
 	if err != nil {
-		return p.UpdateOrderbook(currencyPair, assetType)
+		return p.UpdateOrderbook(ctx, currencyPair, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (p *Poloniex) UpdateOrderbook(currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (p *Poloniex) UpdateOrderbook(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := p.GetOrderbook("", 1000)
+	orderbookNew, err := p.GetOrderbook(ctx, "", 1000)
 	if err != nil {
 		return orderBook, err
 	}
@@ -323,10 +328,10 @@ func (p *Poloniex) UpdateOrderbook(currencyPair currency.Pair, assetType asset.I
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Poloniex exchange
-func (p *Poloniex) UpdateAccountInfo() (account.Holdings, error) {
+func (p *Poloniex) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = p.Name
-	accountBalance, err := p.GetBalances()
+	accountBalance, err := p.GetBalances(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -335,7 +340,7 @@ func (p *Poloniex) UpdateAccountInfo() (account.Holdings, error) {
 	for x, y := range accountBalance.Currency {
 		var exchangeCurrency account.Balance
 		exchangeCurrency.CurrencyName = currency.NewCode(x)
-		exchangeCurrency.TotalValue = y
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(y)
 		currencies = append(currencies, exchangeCurrency)
 	}
 
@@ -352,10 +357,10 @@ func (p *Poloniex) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (p *Poloniex) FetchAccountInfo() (account.Holdings, error) {
+func (p *Poloniex) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(p.Name)
 	if err != nil {
-		return p.UpdateAccountInfo()
+		return p.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -363,17 +368,40 @@ func (p *Poloniex) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (p *Poloniex) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (p *Poloniex) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (p *Poloniex) GetFundingPayments(ctx context.Context, pair currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (p *Poloniex) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrNotYetImplemented
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (p *Poloniex) AddMargin(ctx context.Context, pair currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrNotYetImplemented
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (p *Poloniex) GetOpenInterest(ctx context.Context, pair currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrNotYetImplemented
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (p *Poloniex) GetExchangeHistory(currencyPair currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (p *Poloniex) GetExchangeHistory(ctx context.Context, currencyPair currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (p *Poloniex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (p *Poloniex) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -381,9 +409,9 @@ func (p *Poloniex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 	fillOrKill := s.OrderType == order.Market
 	isBuyOrder := s.OrderSide == order.Buy
-	response, err := p.PlaceOrder(s.Pair.String(),
-		s.Price,
-		s.Amount,
+	response, err := p.PlaceOrder(ctx, s.Pair.String(),
+		s.Price.Float64(),
+		s.Amount.Float64(),
 		false,
 		fillOrKill,
 		isBuyOrder)
@@ -403,15 +431,15 @@ func (p *Poloniex) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (p *Poloniex) ModifyOrder(action *order.Modify) (string, error) {
+func (p *Poloniex) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	oID, err := strconv.ParseInt(action.OrderID, 10, 64)
 	if err != nil {
 		return "", err
 	}
 
-	resp, err := p.MoveOrder(oID,
-		action.Price,
-		action.Amount,
+	resp, err := p.MoveOrder(ctx, oID,
+		action.Price.Float64(),
+		action.Amount.Float64(),
 		action.PostOnly,
 		action.ImmediateOrCancel)
 	if err != nil {
@@ -422,28 +450,28 @@ func (p *Poloniex) ModifyOrder(action *order.Modify) (string, error) {
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (p *Poloniex) CancelOrder(order *order.Cancel) error {
+func (p *Poloniex) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	return p.CancelExistingOrder(orderIDInt)
+	return p.CancelExistingOrder(ctx, orderIDInt)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (p *Poloniex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (p *Poloniex) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
-	openOrders, err := p.GetOpenOrdersForAllCurrencies()
+	openOrders, err := p.GetOpenOrdersForAllCurrencies(ctx)
 	if err != nil {
 		return cancelAllOrdersResponse, err
 	}
 
 	for key := range openOrders.Data {
 		for i := range openOrders.Data[key] {
-			err = p.CancelExistingOrder(openOrders.Data[key][i].OrderNumber)
+			err = p.CancelExistingOrder(ctx, openOrders.Data[key][i].OrderNumber)
 			if err != nil {
 				id := strconv.FormatInt(openOrders.Data[key][i].OrderNumber, 10)
 				cancelAllOrdersResponse.Status[id] = err.Error()
@@ -455,14 +483,14 @@ func (p *Poloniex) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, er
 }
 
 // GetOrderInfo returns information on a current open order
-func (p *Poloniex) GetOrderInfo(orderID string) (order.Detail, error) {
+func (p *Poloniex) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (p *Poloniex) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	a, err := p.GetDepositAddresses()
+func (p *Poloniex) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	a, err := p.GetDepositAddresses(ctx)
 	if err != nil {
 		return "", err
 	}
@@ -478,20 +506,20 @@ func (p *Poloniex) GetDepositAddress(cryptocurrency currency.Code, _ string) (st
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (p *Poloniex) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	_, err := p.Withdraw(withdrawRequest.Currency.String(), withdrawRequest.Address, withdrawRequest.Amount)
+func (p *Poloniex) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	_, err := p.Withdraw(ctx, withdrawRequest.Currency.String(), withdrawRequest.Address, withdrawRequest.Amount)
 	return "", err
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (p *Poloniex) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (p *Poloniex) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (p *Poloniex) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (p *Poloniex) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -501,17 +529,17 @@ func (p *Poloniex) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (p *Poloniex) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (p *Poloniex) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if (!p.AllowAuthenticatedRequest() || p.SkipAuthCheck) && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
 	}
-	return p.GetFee(feeBuilder)
+	return p.GetFee(ctx, feeBuilder)
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (p *Poloniex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := p.GetOpenOrdersForAllCurrencies()
+func (p *Poloniex) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := p.GetOpenOrdersForAllCurrencies(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -536,9 +564,9 @@ func (p *Poloniex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 			orders = append(orders, order.Detail{
 				ID:           strconv.FormatInt(resp.Data[key][i].OrderNumber, 10),
 				OrderSide:    orderSide,
-				Amount:       resp.Data[key][i].Amount,
+				Amount:       decimal.NewFromFloat(resp.Data[key][i].Amount),
 				OrderDate:    orderDate,
-				Price:        resp.Data[key][i].Rate,
+				Price:        decimal.NewFromFloat(resp.Data[key][i].Rate),
 				CurrencyPair: symbol,
 				Exchange:     p.Name,
 			})
@@ -554,8 +582,8 @@ func (p *Poloniex) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail,
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (p *Poloniex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
-	resp, err := p.GetAuthenticatedTradeHistory(req.StartTicks.Unix(),
+func (p *Poloniex) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
+	resp, err := p.GetAuthenticatedTradeHistory(ctx, req.StartTicks.Unix(),
 		req.EndTicks.Unix(),
 		10000)
 	if err != nil {
@@ -583,9 +611,9 @@ func (p *Poloniex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 			orders = append(orders, order.Detail{
 				ID:           strconv.FormatInt(resp.Data[key][i].GlobalTradeID, 10),
 				OrderSide:    orderSide,
-				Amount:       resp.Data[key][i].Amount,
+				Amount:       decimal.NewFromFloat(resp.Data[key][i].Amount),
 				OrderDate:    orderDate,
-				Price:        resp.Data[key][i].Rate,
+				Price:        decimal.NewFromFloat(resp.Data[key][i].Rate),
 				CurrencyPair: symbol,
 				Exchange:     p.Name,
 			})
@@ -598,18 +626,16 @@ func (p *Poloniex) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail,
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (p *Poloniex) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	p.Websocket.SubscribeToChannels(channels)
-	return nil
+	return p.Websocket.AddSubscriptions(channels)
 }
 
-// UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle unsubscribing
+// UnsubscribeToWebsocketChannels unsubscribes from channels on the running
+// websocket connection without reconnecting
 func (p *Poloniex) UnsubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	p.Websocket.RemoveSubscribedChannels(channels)
-	return nil
+	return p.Websocket.RemoveSubscriptions(channels)
 }
 
 // GetSubscriptions returns a copied list of subscriptions
@@ -624,7 +650,12 @@ func (p *Poloniex) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (p *Poloniex) ValidateCredentials() error {
-	_, err := p.UpdateAccountInfo()
+func (p *Poloniex) ValidateCredentials(ctx context.Context) error {
+	_, err := p.UpdateAccountInfo(ctx)
 	return p.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (p *Poloniex) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -38,4 +38,16 @@ type Features struct {
 	MessageCorrelation     bool `json:"messageCorrelation,omitempty"`
 	MessageSequenceNumbers bool `json:"messageSequenceNumbers,omitempty"`
 	CandleHistory          bool `json:"candlehistory,omitempty"`
+	// ClientOrderID is set when orders can be submitted with a client order
+	// ID which the exchange reports back on the order
+	ClientOrderID bool `json:"clientOrderID,omitempty"`
+	// ClientOrderIDUnique is set when the exchange rejects an order submitted
+	// with the client order ID of an order it already holds, so a submission
+	// whose outcome is unknown can safely be sent again
+	ClientOrderIDUnique bool `json:"clientOrderIDUnique,omitempty"`
+	// ImmediateOrCancel, FillOrKill and GoodTillDate are set when orders can
+	// be submitted with the time in force
+	ImmediateOrCancel bool `json:"immediateOrCancel,omitempty"`
+	FillOrKill        bool `json:"fillOrKill,omitempty"`
+	GoodTillDate      bool `json:"goodTillDate,omitempty"`
 }
@@ -0,0 +1,115 @@
+package request
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Cache results recorded against the cache metric
+const (
+	cacheHit         = "hit"
+	cacheRevalidated = "revalidated"
+	cacheMiss        = "miss"
+)
+
+// responseCache holds responses to public GET requests which have opted in by
+// setting Item.CacheTTL
+type responseCache struct {
+	mu      sync.Mutex
+	entries map[string]*cacheEntry
+}
+
+type cacheEntry struct {
+	body         []byte
+	etag         string
+	lastModified string
+	expires      time.Time
+}
+
+// isCacheable returns whether the request may be served from the cache. Only
+// unauthenticated GET requests are cached as anything else is either user
+// specific or has side effects.
+func isCacheable(req *http.Request, p *Item) bool {
+	return p.CacheTTL > 0 && !p.AuthRequest && req.Method == http.MethodGet
+}
+
+// get returns the cached entry for the key
+func (c *responseCache) get(key string) (cacheEntry, bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return cacheEntry{}, false
+	}
+	return *e, true
+}
+
+// store caches a successful response body for ttl. The validators are kept so
+// the response can be revalidated with a conditional request once expired.
+func (c *responseCache) store(key string, h http.Header, body []byte, ttl time.Duration, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.entries == nil {
+		c.entries = make(map[string]*cacheEntry)
+	}
+	if _, ok := c.entries[key]; !ok && len(c.entries) >= MaxCacheEntries {
+		c.evict(now)
+	}
+	c.entries[key] = &cacheEntry{
+		body:         body,
+		etag:         h.Get("ETag"),
+		lastModified: h.Get("Last-Modified"),
+		expires:      now.Add(ttl),
+	}
+}
+
+// refresh extends an entry after the exchange confirmed it is unchanged
+func (c *responseCache) refresh(key string, h http.Header, ttl time.Duration, now time.Time) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.entries[key]
+	if !ok {
+		return
+	}
+	if etag := h.Get("ETag"); etag != "" {
+		e.etag = etag
+	}
+	e.expires = now.Add(ttl)
+}
+
+// evict removes expired entries which cannot be revalidated, falling back to
+// an arbitrary entry if that does not free any space. Must be called with the
+// lock held.
+func (c *responseCache) evict(now time.Time) {
+	for k, e := range c.entries {
+		if now.After(e.expires) && e.etag == "" && e.lastModified == "" {
+			delete(c.entries, k)
+		}
+	}
+	if len(c.entries) < MaxCacheEntries {
+		return
+	}
+	for k := range c.entries {
+		delete(c.entries, k)
+		return
+	}
+}
+
+// setConditionalHeaders asks the exchange to only send the response if it
+// has changed since it was cached
+func (e *cacheEntry) setConditionalHeaders(req *http.Request) {
+	if e.etag != "" {
+		req.Header.Set("If-None-Match", e.etag)
+	}
+	if e.lastModified != "" {
+		req.Header.Set("If-Modified-Since", e.lastModified)
+	}
+}
+
+// ClearCache removes all cached responses
+func (r *Requester) ClearCache() {
+	r.cache.mu.Lock()
+	r.cache.entries = nil
+	r.cache.mu.Unlock()
+}
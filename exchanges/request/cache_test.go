@@ -0,0 +1,101 @@
+package request
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestSendPayloadCache(t *testing.T) {
+	t.Parallel()
+	var calls, notModified int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if req.Header.Get("If-None-Match") == `"v1"` {
+			atomic.AddInt32(&notModified, 1)
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		io.WriteString(w, `{"response":true}`)
+	}))
+	defer s.Close()
+
+	r := New("cache", new(http.Client), nil)
+	send := func(ttl time.Duration, auth bool) bool {
+		var resp struct {
+			Response bool `json:"response"`
+		}
+		err := r.SendPayload(context.Background(), &Item{
+			Method:      http.MethodGet,
+			Path:        s.URL,
+			Result:      &resp,
+			CacheTTL:    ttl,
+			AuthRequest: auth,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return resp.Response
+	}
+
+	if !send(time.Minute, false) || !send(time.Minute, false) {
+		t.Fatal(unexpected)
+	}
+	if atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("expected second request to be served from cache, got %d calls", calls)
+	}
+
+	// Requests which have not opted in or are authenticated are not cached
+	send(0, false)
+	send(time.Minute, true)
+	if atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("expected uncached requests to be sent, got %d calls", calls)
+	}
+
+	// Expired responses are revalidated
+	r.cache.mu.Lock()
+	r.cache.entries[s.URL].expires = time.Now().Add(-time.Second)
+	r.cache.mu.Unlock()
+	if !send(time.Minute, false) {
+		t.Fatal("expected cached response after revalidation")
+	}
+	if atomic.LoadInt32(&notModified) != 1 {
+		t.Error("expected conditional request")
+	}
+	if !send(time.Minute, false) || atomic.LoadInt32(&calls) != 4 {
+		t.Errorf("expected revalidated response to be cached, got %d calls", calls)
+	}
+
+	r.ClearCache()
+	send(time.Minute, false)
+	if atomic.LoadInt32(&calls) != 5 {
+		t.Errorf("expected request after clearing cache, got %d calls", calls)
+	}
+}
+
+func TestResponseCacheEvict(t *testing.T) {
+	t.Parallel()
+	var c responseCache
+	now := time.Now()
+	h := http.Header{}
+	for i := 0; i < MaxCacheEntries; i++ {
+		c.store(strconv.Itoa(i), h, nil, -time.Second, now)
+	}
+	c.store("validated", http.Header{"Etag": []string{"v1"}}, nil, -time.Second, now)
+	if len(c.entries) != 1 {
+		t.Errorf("expected expired entries to be evicted, %d remaining", len(c.entries))
+	}
+
+	for i := 0; i < MaxCacheEntries; i++ {
+		c.store(strconv.Itoa(i), h, nil, time.Minute, now)
+	}
+	if len(c.entries) != MaxCacheEntries {
+		t.Errorf("expected cache to be bounded to %d entries, got %d", MaxCacheEntries, len(c.entries))
+	}
+}
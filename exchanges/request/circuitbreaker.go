@@ -0,0 +1,152 @@
+package request
+
+import (
+	"fmt"
+	"sync"
+	"time"
+)
+
+// CircuitOpenError is returned without sending the request while the circuit
+// breaker for an endpoint is open
+type CircuitOpenError struct {
+	Exchange string
+	Endpoint string
+	RetryAt  time.Time
+}
+
+func (e *CircuitOpenError) Error() string {
+	return fmt.Sprintf("%s circuit breaker open for %s after consecutive failures, next attempt allowed at %s",
+		e.Exchange,
+		e.Endpoint,
+		e.RetryAt.Format(time.RFC3339))
+}
+
+// IsCircuitOpen returns whether the error was returned by an open circuit
+// breaker, in which case the request was not sent
+func IsCircuitOpen(err error) bool {
+	_, ok := err.(*CircuitOpenError)
+	return ok
+}
+
+// DefaultCircuitBreakerPolicy returns the circuit breaker policy used by a new
+// requester
+func DefaultCircuitBreakerPolicy() CircuitBreakerPolicy {
+	return CircuitBreakerPolicy{
+		FailureThreshold: DefaultCircuitFailureThreshold,
+		Cooldown:         DefaultCircuitCooldown,
+	}
+}
+
+// SetCircuitBreakerPolicy sets when endpoints are considered dead, a
+// FailureThreshold of zero disables the circuit breaker. Existing endpoint
+// state is reset.
+func (r *Requester) SetCircuitBreakerPolicy(p CircuitBreakerPolicy) {
+	if p.FailureThreshold < 0 {
+		p.FailureThreshold = 0
+	}
+	if p.Cooldown <= 0 {
+		p.Cooldown = DefaultCircuitCooldown
+	}
+	r.breaker.mu.Lock()
+	r.breaker.policy = p
+	r.breaker.endpoints = nil
+	r.breaker.mu.Unlock()
+}
+
+// GetCircuitBreakerPolicy returns the current circuit breaker policy
+func (r *Requester) GetCircuitBreakerPolicy() CircuitBreakerPolicy {
+	r.breaker.mu.Lock()
+	defer r.breaker.mu.Unlock()
+	return r.breaker.policy
+}
+
+// circuitBreaker tracks consecutive failures per endpoint. Only endpoints
+// which are currently failing are held.
+type circuitBreaker struct {
+	mu        sync.Mutex
+	policy    CircuitBreakerPolicy
+	endpoints map[string]*circuit
+}
+
+type circuit struct {
+	failures int
+	openedAt time.Time
+	// probing is set while a single request is let through once the cooldown
+	// has passed, to determine whether the endpoint has recovered
+	probing bool
+}
+
+// allow returns a CircuitOpenError if a request to the endpoint should not be
+// sent. Once the cooldown has passed a single probe request is allowed.
+func (c *circuitBreaker) allow(exchange, endpoint string, now time.Time) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.policy.FailureThreshold <= 0 {
+		return nil
+	}
+	e, ok := c.endpoints[endpoint]
+	if !ok || e.failures < c.policy.FailureThreshold {
+		return nil
+	}
+	retryAt := e.openedAt.Add(c.policy.Cooldown)
+	if e.probing || now.Before(retryAt) {
+		return &CircuitOpenError{
+			Exchange: exchange,
+			Endpoint: endpoint,
+			RetryAt:  retryAt,
+		}
+	}
+	e.probing = true
+	return nil
+}
+
+// record records the outcome of a request to the endpoint, returning whether
+// the circuit opened or closed as a result
+func (c *circuitBreaker) record(endpoint string, failed bool, now time.Time) (opened, closed bool) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.policy.FailureThreshold <= 0 {
+		return false, false
+	}
+	e, ok := c.endpoints[endpoint]
+	if !failed {
+		if ok {
+			delete(c.endpoints, endpoint)
+			return false, e.failures >= c.policy.FailureThreshold
+		}
+		return false, false
+	}
+	if !ok {
+		if c.endpoints == nil {
+			c.endpoints = make(map[string]*circuit)
+		}
+		e = &circuit{}
+		c.endpoints[endpoint] = e
+	}
+	e.probing = false
+	e.failures++
+	if e.failures >= c.policy.FailureThreshold {
+		// A failed probe opens the circuit for another cooldown
+		e.openedAt = now
+		return e.failures == c.policy.FailureThreshold, false
+	}
+	return false, false
+}
+
+// open returns whether the endpoints circuit is open
+func (c *circuitBreaker) open(endpoint string) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	e, ok := c.endpoints[endpoint]
+	return ok && c.policy.FailureThreshold > 0 && e.failures >= c.policy.FailureThreshold
+}
+
+// release lets another probe through when a probe request is abandoned
+// before its outcome is known
+func (c *circuitBreaker) release(endpoint string) {
+	c.mu.Lock()
+	if e, ok := c.endpoints[endpoint]; ok {
+		e.probing = false
+	}
+	c.mu.Unlock()
+}
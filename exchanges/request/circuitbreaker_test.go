@@ -0,0 +1,125 @@
+package request
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+func TestCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	var c circuitBreaker
+	c.policy = CircuitBreakerPolicy{FailureThreshold: 2, Cooldown: time.Minute}
+	now := time.Now()
+
+	if err := c.allow("test", "a", now); err != nil {
+		t.Fatal(err)
+	}
+	if opened, _ := c.record("a", true, now); opened {
+		t.Error("circuit should not open before the threshold")
+	}
+	if opened, _ := c.record("a", true, now); !opened {
+		t.Error("circuit should open at the threshold")
+	}
+	err := c.allow("test", "a", now)
+	if !IsCircuitOpen(err) {
+		t.Fatalf("expected open circuit, got %v", err)
+	}
+	if err.(*CircuitOpenError).RetryAt != now.Add(time.Minute) {
+		t.Error(unexpected)
+	}
+	if err = c.allow("test", "b", now); err != nil {
+		t.Error("other endpoints should be unaffected")
+	}
+
+	// Single probe after cooldown
+	later := now.Add(time.Minute)
+	if err = c.allow("test", "a", later); err != nil {
+		t.Fatal(err)
+	}
+	if !IsCircuitOpen(c.allow("test", "a", later)) {
+		t.Error("only one probe should be allowed")
+	}
+	c.release("a")
+	if err = c.allow("test", "a", later); err != nil {
+		t.Error("released probe should allow another probe")
+	}
+
+	// Failed probe opens the circuit for another cooldown
+	if opened, _ := c.record("a", true, later); opened {
+		t.Error("circuit was already open")
+	}
+	if !IsCircuitOpen(c.allow("test", "a", later.Add(time.Second))) {
+		t.Error("failed probe should reopen the circuit")
+	}
+
+	// Successful probe closes the circuit
+	later = later.Add(time.Minute)
+	if err = c.allow("test", "a", later); err != nil {
+		t.Fatal(err)
+	}
+	if _, closed := c.record("a", false, later); !closed {
+		t.Error("successful probe should close the circuit")
+	}
+	if len(c.endpoints) != 0 {
+		t.Error("recovered endpoints should not be held")
+	}
+
+	// Disabled
+	c.policy.FailureThreshold = 0
+	c.record("a", true, now)
+	c.record("a", true, now)
+	if err = c.allow("test", "a", now); err != nil {
+		t.Error("disabled circuit breaker should allow requests")
+	}
+}
+
+func TestSendPayloadCircuitBreaker(t *testing.T) {
+	t.Parallel()
+	var calls, down int32 = 0, 1
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		if atomic.LoadInt32(&down) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, `{"response":true}`)
+	}))
+	defer s.Close()
+
+	r := newRetryRequester(5)
+	r.SetCircuitBreakerPolicy(CircuitBreakerPolicy{
+		FailureThreshold: 3,
+		Cooldown:         time.Millisecond * 100,
+	})
+	item := &Item{Method: http.MethodGet, Path: s.URL}
+
+	// Retries stop once the circuit opens
+	err := r.SendPayload(context.Background(), item)
+	if err == nil || atomic.LoadInt32(&calls) != 3 {
+		t.Fatalf("expected three attempts, got %d err %v", calls, err)
+	}
+
+	err = r.SendPayload(context.Background(), item)
+	if !IsCircuitOpen(err) || atomic.LoadInt32(&calls) != 3 {
+		t.Fatalf("expected request to fail fast, got %d attempts err %v", calls, err)
+	}
+
+	atomic.StoreInt32(&down, 0)
+	time.Sleep(time.Millisecond * 100)
+	var resp struct {
+		Response bool `json:"response"`
+	}
+	item.Result = &resp
+	err = r.SendPayload(context.Background(), item)
+	if err != nil || !resp.Response {
+		t.Fatalf("expected probe to succeed, got %v", err)
+	}
+	if len(r.breaker.endpoints) != 0 {
+		t.Error("circuit should be closed after a successful probe")
+	}
+}
@@ -0,0 +1,76 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// maxServerTimeRoundTrip is the longest a server time request may take for
+// its response to be used, as the server time is only known to within half
+// the round trip
+const maxServerTimeRoundTrip = 2 * time.Second
+
+// clockOffset is the offset of the local clock measured by NTP in
+// nanoseconds, applied to the request timestamps of every exchange
+var clockOffset int64
+
+var errServerTimeRoundTrip = errors.New("server time round trip too long to measure the clock offset")
+
+// SetClockOffset sets the NTP measured offset of the local clock, being the
+// amount it is behind the correct time
+func SetClockOffset(offset time.Duration) {
+	atomic.StoreInt64(&clockOffset, int64(offset))
+}
+
+// ClockOffset returns the NTP measured offset of the local clock
+func ClockOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&clockOffset))
+}
+
+// Now returns the time to generate signed request timestamps with, being the
+// local time corrected by the NTP measured clock offset and the offset of the
+// exchange server's clock measured by MeasureServerTime
+func (r *Requester) Now() time.Time {
+	offset := ClockOffset()
+	if r != nil {
+		offset += time.Duration(atomic.LoadInt64(&r.serverTimeOffset))
+	}
+	return time.Now().Add(offset)
+}
+
+// ServerTimeOffset returns the measured offset of the exchange server's clock
+// from the NTP corrected local clock
+func (r *Requester) ServerTimeOffset() time.Duration {
+	return time.Duration(atomic.LoadInt64(&r.serverTimeOffset))
+}
+
+// MeasureServerTime measures the offset of the exchange server's clock from
+// the NTP corrected local clock using fetch, which returns the server time.
+// The offset is applied to times returned by Now. It is kept relative to the
+// NTP corrected clock so it remains accurate as the local clock drifts.
+func (r *Requester) MeasureServerTime(ctx context.Context, fetch func(context.Context) (time.Time, error)) (time.Duration, error) {
+	sent := time.Now()
+	serverTime, err := fetch(ctx)
+	if err != nil {
+		return 0, err
+	}
+	received := time.Now()
+	roundTrip := received.Sub(sent)
+	if roundTrip > maxServerTimeRoundTrip {
+		return 0, fmt.Errorf("%s %s: %v", r.Name, errServerTimeRoundTrip, roundTrip)
+	}
+	if serverTime.IsZero() {
+		return 0, fmt.Errorf("%s returned an empty server time", r.Name)
+	}
+
+	local := sent.Add(roundTrip / 2).Add(ClockOffset())
+	offset := serverTime.Sub(local)
+	atomic.StoreInt64(&r.serverTimeOffset, int64(offset))
+	log.Debugf(log.RequestSys, "%s server clock offset measured as %v\n", r.Name, offset)
+	return offset, nil
+}
@@ -0,0 +1,66 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+)
+
+func TestNow(t *testing.T) {
+	defer SetClockOffset(0)
+	var r *Requester
+	SetClockOffset(time.Hour)
+	if d := time.Until(r.Now()); d < time.Hour-time.Second || d > time.Hour {
+		t.Errorf("expected NTP offset to be applied, got %v", d)
+	}
+
+	r = New("test", new(http.Client), nil)
+	r.serverTimeOffset = int64(time.Minute)
+	if d := time.Until(r.Now()); d < time.Hour+time.Minute-time.Second || d > time.Hour+time.Minute {
+		t.Errorf("expected NTP and server time offsets to be applied, got %v", d)
+	}
+	n := r.GetNonce(false)
+	if int64(n) < time.Now().Add(time.Hour).Unix() {
+		t.Errorf("expected nonce to use the corrected time, got %v", n)
+	}
+}
+
+func TestMeasureServerTime(t *testing.T) {
+	defer SetClockOffset(0)
+	SetClockOffset(time.Second)
+	r := New("test", new(http.Client), nil)
+
+	offset, err := r.MeasureServerTime(context.Background(), func(context.Context) (time.Time, error) {
+		return time.Now().Add(time.Minute), nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	// The server offset is relative to the NTP corrected clock
+	expected := time.Minute - time.Second
+	if offset < expected-100*time.Millisecond || offset > expected+100*time.Millisecond {
+		t.Errorf("expected offset of %v, got %v", expected, offset)
+	}
+	if r.ServerTimeOffset() != offset {
+		t.Errorf("expected stored offset %v, got %v", offset, r.ServerTimeOffset())
+	}
+
+	errFetch := errors.New("fetch failed")
+	_, err = r.MeasureServerTime(context.Background(), func(context.Context) (time.Time, error) {
+		return time.Time{}, errFetch
+	})
+	if err != errFetch {
+		t.Errorf("expected %v, got %v", errFetch, err)
+	}
+	_, err = r.MeasureServerTime(context.Background(), func(context.Context) (time.Time, error) {
+		return time.Time{}, nil
+	})
+	if err == nil {
+		t.Error("expected error on empty server time")
+	}
+	if r.ServerTimeOffset() != offset {
+		t.Error("expected failed measurements to keep the previous offset")
+	}
+}
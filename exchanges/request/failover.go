@@ -0,0 +1,133 @@
+package request
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+)
+
+// mirror is a base URL serving the same API as the other mirrors in its group
+type mirror struct {
+	base    string
+	retryAt time.Time
+}
+
+// mirrorGroup holds the base URLs of one API, in order of preference
+type mirrorGroup struct {
+	mirrors []*mirror
+}
+
+type mirrors struct {
+	mu     sync.Mutex
+	groups []*mirrorGroup
+}
+
+// SetMirrors registers alternate base URLs serving the same REST API as
+// primary, such as regional mirrors. Each request to any of the URLs is sent
+// to the first one in order which hasn't failed within DefaultMirrorCooldown,
+// and a retry after a network error or 5xx response fails over to the next
+// without waiting. No alternates removes the mirrors for primary.
+func (r *Requester) SetMirrors(primary string, alternates ...string) error {
+	bases := append([]string{primary}, alternates...)
+	g := &mirrorGroup{mirrors: make([]*mirror, len(bases))}
+	for i := range bases {
+		u, err := url.Parse(bases[i])
+		if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+			return fmt.Errorf("%s invalid API URL %q", r.Name, bases[i])
+		}
+		g.mirrors[i] = &mirror{base: strings.TrimSuffix(bases[i], "/")}
+	}
+
+	r.mirrors.mu.Lock()
+	defer r.mirrors.mu.Unlock()
+	for i := range r.mirrors.groups {
+		if r.mirrors.groups[i].mirrors[0].base == g.mirrors[0].base {
+			r.mirrors.groups = append(r.mirrors.groups[:i], r.mirrors.groups[i+1:]...)
+			break
+		}
+	}
+	if len(alternates) != 0 {
+		r.mirrors.groups = append(r.mirrors.groups, g)
+	}
+	return nil
+}
+
+// route points req at the preferred mirror of the API it is addressed to,
+// returning the mirror so the outcome can be recorded. A nil mirror is
+// returned for requests to APIs without mirrors.
+func (m *mirrors) route(req *http.Request, now time.Time) (*mirrorGroup, *mirror, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if len(m.groups) == 0 {
+		return nil, nil, nil
+	}
+	raw := req.URL.String()
+	for _, g := range m.groups {
+		for _, current := range g.mirrors {
+			if !hasBase(raw, current.base) {
+				continue
+			}
+			best := g.pick(now)
+			if best != current {
+				u, err := url.Parse(best.base + raw[len(current.base):])
+				if err != nil {
+					return nil, nil, err
+				}
+				req.URL = u
+				req.Host = u.Host
+			}
+			return g, best, nil
+		}
+	}
+	return nil, nil, nil
+}
+
+// record passes over a failed mirror until its cooldown expires, returning
+// whether the request failed and the group has a healthy mirror to fail over
+// to
+func (m *mirrors) record(g *mirrorGroup, target *mirror, failed bool, now time.Time) bool {
+	if target == nil {
+		return false
+	}
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	if !failed {
+		target.retryAt = time.Time{}
+		return false
+	}
+	target.retryAt = now.Add(DefaultMirrorCooldown)
+	return !g.pick(now).retryAt.After(now)
+}
+
+// pick returns the first mirror which isn't cooling down, or the one which
+// recovers soonest if all of them are
+func (g *mirrorGroup) pick(now time.Time) *mirror {
+	best := g.mirrors[0]
+	for _, m := range g.mirrors {
+		if !m.retryAt.After(now) {
+			return m
+		}
+		if m.retryAt.Before(best.retryAt) {
+			best = m
+		}
+	}
+	return best
+}
+
+// hasBase returns whether raw is base or a path under it
+func hasBase(raw, base string) bool {
+	if !strings.HasPrefix(raw, base) {
+		return false
+	}
+	if len(raw) == len(base) {
+		return true
+	}
+	switch raw[len(base)] {
+	case '/', '?', '#':
+		return true
+	}
+	return false
+}
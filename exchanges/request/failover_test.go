@@ -0,0 +1,97 @@
+package request
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMirrorFailover(t *testing.T) {
+	t.Parallel()
+	var primaryCalls, mirrorCalls int32
+	primary := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&primaryCalls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	defer primary.Close()
+	mirror := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		atomic.AddInt32(&mirrorCalls, 1)
+		io.WriteString(w, `{"response":"`+req.URL.RequestURI()+`"}`)
+	}))
+	defer mirror.Close()
+
+	r := newRetryRequester(1)
+	err := r.SetMirrors(primary.URL+"/", mirror.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for i := 0; i < 2; i++ {
+		var resp struct {
+			Response string `json:"response"`
+		}
+		err = r.SendPayload(context.Background(), &Item{
+			Method: http.MethodGet,
+			Path:   primary.URL + "/api/v1/ticker?symbol=BTCUSD",
+			Result: &resp,
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+		if resp.Response != "/api/v1/ticker?symbol=BTCUSD" {
+			t.Errorf("expected path and query to be kept, got %s", resp.Response)
+		}
+	}
+	// The primary is passed over once it has failed
+	if atomic.LoadInt32(&primaryCalls) != 1 || atomic.LoadInt32(&mirrorCalls) != 2 {
+		t.Errorf("expected 1 primary and 2 mirror calls, got %d and %d",
+			atomic.LoadInt32(&primaryCalls),
+			atomic.LoadInt32(&mirrorCalls))
+	}
+
+	// Removing the mirrors sends requests to the primary again
+	err = r.SetMirrors(primary.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   primary.URL + "/api",
+	})
+	if err == nil || atomic.LoadInt32(&primaryCalls) != 3 {
+		t.Errorf("expected primary to be retried without mirrors, got %v", err)
+	}
+}
+
+func TestSetMirrors(t *testing.T) {
+	t.Parallel()
+	r := New("test", new(http.Client), nil)
+	for _, u := range []string{"", "ftp://example.com", "https://", "://bad"} {
+		if err := r.SetMirrors("https://api.example.com", u); err == nil {
+			t.Errorf("%q: expected error", u)
+		}
+	}
+	if len(r.mirrors.groups) != 0 {
+		t.Error("invalid mirrors should not be registered")
+	}
+}
+
+func TestHasBase(t *testing.T) {
+	t.Parallel()
+	tests := map[string]bool{
+		"https://api.example.com":          true,
+		"https://api.example.com/v1":       true,
+		"https://api.example.com?a=1":      true,
+		"https://api.example.com.evil/v1":  false,
+		"https://api.example.community/v1": false,
+		"http://api.example.com/v1":        false,
+	}
+	for raw, expected := range tests {
+		if hasBase(raw, "https://api.example.com") != expected {
+			t.Errorf("%s: expected %v", raw, expected)
+		}
+	}
+}
@@ -1,10 +1,13 @@
 package request
 
 import (
+	"context"
 	"errors"
+	"fmt"
 	"sync/atomic"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/metrics"
 	"golang.org/x/time/rate"
 )
 
@@ -22,9 +25,14 @@ type BasicLimit struct {
 }
 
 // Limit executes a single rate limit set by NewRateLimit
-func (b *BasicLimit) Limit(_ EndpointLimit) error {
-	time.Sleep(b.r.Reserve().Delay())
-	return nil
+func (b *BasicLimit) Limit(e EndpointLimit) error {
+	return b.LimitContext(context.Background(), e)
+}
+
+// LimitContext executes a single rate limit set by NewRateLimit, returning
+// early if the context is done
+func (b *BasicLimit) LimitContext(ctx context.Context, _ EndpointLimit) error {
+	return wait(ctx, time.Now(), b.r.Reserve())
 }
 
 // EndpointLimit defines individual endpoint rate limits that are set when
@@ -38,18 +46,148 @@ type Limiter interface {
 	Limit(EndpointLimit) error
 }
 
-// NewRateLimit creates a new RateLimit based of time interval and how many
-// actions allowed and breaks it down to an actions-per-second basis -- Burst
-// rate is kept as one as this is not supported for out-bound requests.
-func NewRateLimit(interval time.Duration, actions int) *rate.Limiter {
+// ContextLimiter is a Limiter which stops waiting once the request context is
+// done, handing back the tokens it was waiting for
+type ContextLimiter interface {
+	Limiter
+	LimitContext(context.Context, EndpointLimit) error
+}
+
+// Weight is the number of tokens a request takes from a named bucket
+type Weight struct {
+	Bucket string
+	Tokens int
+}
+
+// BucketLimiter implements the ContextLimiter interface with named token
+// buckets, matching exchanges which limit e.g. orders and market data
+// separately and charge each endpoint a weight. A request draws its weight
+// from every bucket it is charged against and waits until all of them can
+// cover it. Buckets and weights must be set before the limiter is used.
+type BucketLimiter struct {
+	buckets       map[string]*rate.Limiter
+	weights       map[EndpointLimit][]Weight
+	defaultWeight []Weight
+}
+
+// NewBucketLimiter returns a BucketLimiter without any buckets
+func NewBucketLimiter() *BucketLimiter {
+	return &BucketLimiter{
+		buckets: make(map[string]*rate.Limiter),
+		weights: make(map[EndpointLimit][]Weight),
+	}
+}
+
+// AddBucket adds a named token bucket, see NewTokenBucket
+func (b *BucketLimiter) AddBucket(name string, bucket *rate.Limiter) {
+	b.buckets[name] = bucket
+}
+
+// SetWeight sets the weights charged for requests to the endpoint
+func (b *BucketLimiter) SetWeight(e EndpointLimit, w ...Weight) {
+	b.weights[e] = w
+}
+
+// SetDefaultWeight sets the weights charged for requests to endpoints without
+// their own weight
+func (b *BucketLimiter) SetDefaultWeight(w ...Weight) {
+	b.defaultWeight = w
+}
+
+// Limit waits until every bucket the endpoint is charged against can cover
+// its weight
+func (b *BucketLimiter) Limit(e EndpointLimit) error {
+	return b.LimitContext(context.Background(), e)
+}
+
+// LimitContext waits until every bucket the endpoint is charged against can
+// cover its weight, handing back the tokens it was waiting for if the context
+// is done first
+func (b *BucketLimiter) LimitContext(ctx context.Context, e EndpointLimit) error {
+	w, ok := b.weights[e]
+	if !ok {
+		if b.defaultWeight == nil {
+			return fmt.Errorf("no rate limit weight set for endpoint %d", e)
+		}
+		w = b.defaultWeight
+	}
+
+	now := time.Now()
+	reservations := make([]*rate.Reservation, 0, len(w))
+	for i := range w {
+		bucket, ok := b.buckets[w[i].Bucket]
+		if !ok {
+			cancel(now, reservations)
+			return fmt.Errorf("rate limit bucket %s not found", w[i].Bucket)
+		}
+		r := bucket.ReserveN(now, w[i].Tokens)
+		if !r.OK() {
+			cancel(now, reservations)
+			return fmt.Errorf("weight %d exceeds rate limit bucket %s burst %d",
+				w[i].Tokens,
+				w[i].Bucket,
+				bucket.Burst())
+		}
+		reservations = append(reservations, r)
+	}
+	return wait(ctx, now, reservations...)
+}
+
+// wait sleeps until all reservations can act, cancelling them if the context
+// is done first so tokens not yet due are available to other requests
+func wait(ctx context.Context, now time.Time, reservations ...*rate.Reservation) error {
+	var delay time.Duration
+	for i := range reservations {
+		if d := reservations[i].DelayFrom(now); d > delay {
+			delay = d
+		}
+	}
+	if delay == 0 {
+		return nil
+	}
+
+	t := time.NewTimer(delay)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-ctx.Done():
+		cancel(time.Now(), reservations)
+		return ctx.Err()
+	}
+}
+
+func cancel(now time.Time, reservations []*rate.Reservation) {
+	for i := range reservations {
+		reservations[i].CancelAt(now)
+	}
+}
+
+// NewTokenBucket returns a token bucket refilled with actions tokens per
+// interval and holding up to burst tokens, so a quiet period can be followed
+// by a burst of requests as exchange limits usually allow. A burst below one
+// is treated as one and an unset interval or actions returns an unrestricted
+// bucket.
+func NewTokenBucket(interval time.Duration, actions, burst int) *rate.Limiter {
+	if burst < 1 {
+		burst = 1
+	}
 	if actions <= 0 || interval <= 0 {
 		// Returns an un-restricted rate limiter
-		return rate.NewLimiter(rate.Inf, 1)
+		return rate.NewLimiter(rate.Inf, burst)
 	}
 
 	i := 1 / interval.Seconds()
 	rps := i * float64(actions)
-	return rate.NewLimiter(rate.Limit(rps), 1)
+	return rate.NewLimiter(rate.Limit(rps), burst)
+}
+
+// NewRateLimit creates a new RateLimit based of time interval and how many
+// actions allowed and breaks it down to an actions-per-second basis. It is a
+// token bucket without a burst allowance, use NewTokenBucket for exchanges
+// which allow bursts.
+func NewRateLimit(interval time.Duration, actions int) *rate.Limiter {
+	return NewTokenBucket(interval, actions, 1)
 }
 
 // NewBasicRateLimit returns an object that implements the limiter interface
@@ -58,13 +196,18 @@ func NewBasicRateLimit(interval time.Duration, actions int) Limiter {
 	return &BasicLimit{NewRateLimit(interval, actions)}
 }
 
-// InitiateRateLimit sleeps for designated end point rate limits
-func (r *Requester) InitiateRateLimit(e EndpointLimit) error {
+// InitiateRateLimit sleeps for designated end point rate limits, limiters
+// implementing ContextLimiter stop waiting once the context is done
+func (r *Requester) InitiateRateLimit(ctx context.Context, e EndpointLimit) error {
 	if atomic.LoadInt32(&r.disableRateLimiter) == 1 {
 		return nil
 	}
 
 	if r.Limiter != nil {
+		defer metrics.RateLimitWaitDuration.ObserveSince(time.Now(), r.Name)
+		if l, ok := r.Limiter.(ContextLimiter); ok {
+			return l.LimitContext(ctx, e)
+		}
 		return r.Limiter.Limit(e)
 	}
 
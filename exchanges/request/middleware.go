@@ -0,0 +1,134 @@
+package request
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+// Handler sends a single attempt of a request
+type Handler func(req *http.Request, item *Item) (*http.Response, error)
+
+// Middleware wraps the Handler sending each attempt of a request, so the
+// request can be changed before it is sent and the response inspected or
+// replaced once received. Middleware runs for every attempt including
+// retries, so a signing middleware signs each attempt with a fresh nonce.
+// An error returned by a middleware aborts the request without being retried.
+type Middleware func(next Handler) Handler
+
+// PreRequest returns a Middleware calling fn before each attempt is sent, an
+// error returned by fn aborts the request
+func PreRequest(fn func(req *http.Request, item *Item) error) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request, item *Item) (*http.Response, error) {
+			if err := fn(req, item); err != nil {
+				return nil, err
+			}
+			return next(req, item)
+		}
+	}
+}
+
+// PostResponse returns a Middleware calling fn with each response received,
+// an error returned by fn aborts the request. fn is not called when no
+// response was received.
+func PostResponse(fn func(req *http.Request, item *Item, resp *http.Response) error) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request, item *Item) (*http.Response, error) {
+			resp, err := next(req, item)
+			if err != nil {
+				return resp, err
+			}
+			if err = fn(req, item, resp); err != nil {
+				resp.Body.Close()
+				return nil, err
+			}
+			return resp, nil
+		}
+	}
+}
+
+// Use appends middleware to the chain wrapping each request attempt, the
+// first middleware added is the first to see the request and the last to see
+// the response
+func (r *Requester) Use(m ...Middleware) {
+	r.middlewareMtx.Lock()
+	r.middleware = append(r.middleware, m...)
+	r.middlewareMtx.Unlock()
+}
+
+// roundTrip sends a single attempt through the middleware chain. The
+// transport error is returned separately so that only failures to reach the
+// exchange are retried, not errors returned by middleware.
+func (r *Requester) roundTrip(req *http.Request, p *Item) (resp *http.Response, transportErr, err error) {
+	var h Handler = func(req *http.Request, _ *Item) (*http.Response, error) {
+		// Injected errors are treated as failures to reach the exchange so
+		// they are retried in the same way
+		if err := chaos.Delay(req.Context(), r.Name); err != nil {
+			transportErr = err
+			return nil, err
+		}
+		if err := chaos.RESTError(r.Name); err != nil {
+			transportErr = err
+			return nil, err
+		}
+		resp, err := r.HTTPClient.Do(req)
+		transportErr = err
+		return resp, err
+	}
+	h = recordMock(r.Name)(recordVCR(r.Name)(replayVCR(r.Name)(recordMetrics(r.Name)(h))))
+
+	r.middlewareMtx.RLock()
+	for i := len(r.middleware) - 1; i >= 0; i-- {
+		h = r.middleware[i](h)
+	}
+	r.middlewareMtx.RUnlock()
+
+	resp, err = h(req, p)
+	if err != nil && err != transportErr {
+		transportErr = nil
+	}
+	return resp, transportErr, err
+}
+
+// recordMetrics records the latency and outcome of each attempt
+func recordMetrics(exchName string) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request, item *Item) (*http.Response, error) {
+			start := time.Now()
+			resp, err := next(req, item)
+			if err != nil {
+				metrics.RecordRESTRequest(exchName, req.URL.Path, start, 0, err)
+				return resp, err
+			}
+			metrics.RecordRESTRequest(exchName, req.URL.Path, start, resp.StatusCode, nil)
+			return resp, nil
+		}
+	}
+}
+
+// recordMock dumps responses for requests with HTTPRecording set, for future
+// mocking implementations
+func recordMock(exchName string) Middleware {
+	return PostResponse(func(_ *http.Request, item *Item, resp *http.Response) error {
+		if !item.HTTPRecording {
+			return nil
+		}
+		contents, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(contents))
+		if err = mock.HTTPRecord(resp, exchName, contents); err != nil {
+			return fmt.Errorf("mock recording failure %s", err)
+		}
+		return nil
+	})
+}
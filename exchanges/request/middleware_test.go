@@ -0,0 +1,158 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
+)
+
+func TestMiddleware(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		// Fail the first attempt so the retry is signed again
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		io.WriteString(w, `{"response":"`+req.Header.Get("X-Nonce")+`"}`)
+	}))
+	defer s.Close()
+
+	r := newRetryRequester(1)
+	var order []string
+	var nonce int
+	r.Use(
+		func(next Handler) Handler {
+			return func(req *http.Request, item *Item) (*http.Response, error) {
+				order = append(order, "outer")
+				return next(req, item)
+			}
+		},
+		PreRequest(func(req *http.Request, _ *Item) error {
+			order = append(order, "sign")
+			nonce++
+			req.Header.Set("X-Nonce", strconv.Itoa(nonce))
+			return nil
+		}),
+		PostResponse(func(_ *http.Request, _ *Item, resp *http.Response) error {
+			order = append(order, "response "+strconv.Itoa(resp.StatusCode))
+			return nil
+		}),
+	)
+
+	var resp struct {
+		Response string `json:"response"`
+	}
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   s.URL,
+		Result: &resp,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Response != "2" {
+		t.Errorf("expected retry to be signed with a new nonce, got %s", resp.Response)
+	}
+	expected := "outer,sign,response 503,outer,sign,response 200"
+	if got := strings.Join(order, ","); got != expected {
+		t.Errorf("expected middleware order %s, got %s", expected, got)
+	}
+}
+
+func TestMiddlewareAbort(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		io.WriteString(w, `{}`)
+	}))
+	defer s.Close()
+
+	errAbort := errors.New("abort")
+	r := newRetryRequester(3)
+	r.Use(PreRequest(func(*http.Request, *Item) error {
+		return errAbort
+	}))
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   s.URL,
+	})
+	if err != errAbort {
+		t.Errorf("expected middleware error, got %v", err)
+	}
+	if atomic.LoadInt32(&calls) != 0 {
+		t.Error("request should not be sent")
+	}
+	if len(r.breaker.endpoints) != 0 {
+		t.Error("middleware errors should not count against the circuit breaker")
+	}
+}
+
+func TestMiddlewareMock(t *testing.T) {
+	t.Parallel()
+	r := New("mock", new(http.Client), nil)
+	// A middleware can serve responses without reaching the exchange
+	r.Use(func(Handler) Handler {
+		return func(req *http.Request, _ *Item) (*http.Response, error) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Body:       ioutil.NopCloser(strings.NewReader(`{"response":"mocked"}`)),
+				Request:    req,
+			}, nil
+		}
+	})
+
+	var resp struct {
+		Response string `json:"response"`
+	}
+	err := r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   "http://invalid.invalid",
+		Result: &resp,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp.Response != "mocked" {
+		t.Error(unexpected)
+	}
+}
+
+func TestChaosRESTErrors(t *testing.T) {
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		io.WriteString(w, `{}`)
+	}))
+	defer s.Close()
+
+	err := chaos.Set(chaos.Config{RESTErrorRate: 1, Exchanges: []string{"chaostest"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chaos.Set(chaos.Config{}) // nolint:errcheck
+
+	r := newRetryRequester(2)
+	r.Name = "chaostest"
+	err = r.SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   s.URL,
+	})
+	if err == nil || !strings.HasSuffix(err.Error(), chaos.ErrInjected.Error()) {
+		t.Errorf("expected %v after retrying, got %v", chaos.ErrInjected, err)
+	}
+	if atomic.LoadInt32(&calls) != 0 {
+		t.Error("expected requests with injected errors not to be sent")
+	}
+}
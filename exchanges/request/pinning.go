@@ -0,0 +1,161 @@
+package request
+
+import (
+	"crypto/sha256"
+	"crypto/subtle"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+)
+
+// PinningPolicy restricts the TLS certificates accepted for an exchanges
+// REST endpoints to those matching a pin, protecting API keys from a MITM
+// holding a certificate issued by any trusted CA. Pins are base64 encoded
+// SHA-256 hashes, as output by:
+//
+//	openssl x509 -pubkey -noout | openssl pkey -pubin -outform der | openssl dgst -sha256 -binary | base64
+//
+// A connection is accepted if any certificate in its verified chain matches
+// any pin, so pinning an intermediate or root survives leaf renewals.
+type PinningPolicy struct {
+	// PublicKeys are hashes of the DER encoded SubjectPublicKeyInfo, which
+	// remain valid when a certificate is reissued with the same key
+	PublicKeys []string
+	// Certificates are hashes of the DER encoded certificate
+	Certificates []string
+}
+
+// PinMismatchError is returned when no certificate presented by the exchange
+// matches a pin
+type PinMismatchError struct {
+	Exchange string
+	Host     string
+}
+
+func (e *PinMismatchError) Error() string {
+	return fmt.Sprintf("%s TLS certificate for %s does not match any pinned certificate or public key",
+		e.Exchange,
+		e.Host)
+}
+
+// IsPinMismatch returns whether the request failed because the exchanges
+// certificate did not match a pin, in which case it was not sent
+func IsPinMismatch(err error) bool {
+	if uErr, ok := err.(*url.Error); ok {
+		err = uErr.Err
+	}
+	_, ok := err.(*PinMismatchError)
+	return ok
+}
+
+var errPinningCustomTransport = errors.New("TLS pinning requires the HTTP client to use a *http.Transport")
+
+type pins struct {
+	publicKeys   [][]byte
+	certificates [][]byte
+}
+
+func decodePins(encoded []string) ([][]byte, error) {
+	decoded := make([][]byte, len(encoded))
+	for i := range encoded {
+		b, err := base64.StdEncoding.DecodeString(encoded[i])
+		if err != nil || len(b) != sha256.Size {
+			return nil, fmt.Errorf("invalid TLS pin %q, expected a base64 encoded SHA-256 hash", encoded[i])
+		}
+		decoded[i] = b
+	}
+	return decoded, nil
+}
+
+// SetPinningPolicy pins the TLS certificates accepted by the requester, a
+// policy without pins disables pinning. The HTTP clients transport is
+// modified so it must not be shared with other requesters. The pins are kept
+// when a proxy is set but not when the HTTP client is replaced.
+func (r *Requester) SetPinningPolicy(p PinningPolicy) error {
+	publicKeys, err := decodePins(p.PublicKeys)
+	if err != nil {
+		return err
+	}
+	certificates, err := decodePins(p.Certificates)
+	if err != nil {
+		return err
+	}
+
+	t, ok := r.HTTPClient.Transport.(*http.Transport)
+	switch {
+	case r.HTTPClient.Transport == nil || t == http.DefaultTransport:
+		// Never modify the transport shared by every default client
+		t = &http.Transport{
+			Proxy:               http.ProxyFromEnvironment,
+			TLSHandshakeTimeout: proxyTLSTimeout,
+		}
+		r.HTTPClient.Transport = t
+	case !ok:
+		return errPinningCustomTransport
+	}
+
+	r.pins = nil
+	if len(publicKeys) != 0 || len(certificates) != 0 {
+		r.pins = &pins{publicKeys: publicKeys, certificates: certificates}
+	}
+	r.applyPins(t)
+	// Connections established before the pins changed were not checked
+	t.CloseIdleConnections()
+	return nil
+}
+
+// applyPins verifies the certificates of connections made by t against the
+// requesters pins
+func (r *Requester) applyPins(t *http.Transport) {
+	if r.pins == nil {
+		if t.TLSClientConfig != nil {
+			t.TLSClientConfig.VerifyPeerCertificate = nil
+		}
+		return
+	}
+	if t.TLSClientConfig == nil {
+		t.TLSClientConfig = new(tls.Config)
+	}
+	p := r.pins
+	name := r.Name
+	t.TLSClientConfig.VerifyPeerCertificate = func(_ [][]byte, chains [][]*x509.Certificate) error {
+		for i := range chains {
+			for j := range chains[i] {
+				if p.matches(chains[i][j]) {
+					return nil
+				}
+			}
+		}
+		var host string
+		if len(chains) != 0 && len(chains[0]) != 0 {
+			host = chains[0][0].Subject.CommonName
+			if len(chains[0][0].DNSNames) != 0 {
+				host = chains[0][0].DNSNames[0]
+			}
+		}
+		return &PinMismatchError{Exchange: name, Host: host}
+	}
+}
+
+func (p *pins) matches(cert *x509.Certificate) bool {
+	spki := sha256.Sum256(cert.RawSubjectPublicKeyInfo)
+	for i := range p.publicKeys {
+		if subtle.ConstantTimeCompare(spki[:], p.publicKeys[i]) == 1 {
+			return true
+		}
+	}
+	if len(p.certificates) == 0 {
+		return false
+	}
+	raw := sha256.Sum256(cert.Raw)
+	for i := range p.certificates {
+		if subtle.ConstantTimeCompare(raw[:], p.certificates[i]) == 1 {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,106 @@
+package request
+
+import (
+	"context"
+	"crypto/sha256"
+	"crypto/tls"
+	"crypto/x509"
+	"encoding/base64"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+)
+
+func pin(b []byte) string {
+	sum := sha256.Sum256(b)
+	return base64.StdEncoding.EncodeToString(sum[:])
+}
+
+func TestPinningPolicy(t *testing.T) {
+	t.Parallel()
+	s := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		io.WriteString(w, `{}`)
+	}))
+	defer s.Close()
+	cert := s.Certificate()
+
+	tests := []struct {
+		name   string
+		policy PinningPolicy
+		match  bool
+	}{
+		{"public key", PinningPolicy{PublicKeys: []string{pin(cert.RawSubjectPublicKeyInfo)}}, true},
+		{"certificate", PinningPolicy{Certificates: []string{pin(cert.Raw)}}, true},
+		{"mismatch", PinningPolicy{PublicKeys: []string{pin([]byte("other"))}}, false},
+		{"disabled", PinningPolicy{}, true},
+	}
+	for _, tt := range tests {
+		pool := x509.NewCertPool()
+		pool.AddCert(cert)
+		r := New("test", &http.Client{
+			Transport: &http.Transport{TLSClientConfig: &tls.Config{RootCAs: pool}},
+		}, nil)
+		err := r.SetPinningPolicy(tt.policy)
+		if err != nil {
+			t.Fatalf("%s: %v", tt.name, err)
+		}
+		var resp struct{}
+		err = r.SendPayload(context.Background(), &Item{
+			Method: http.MethodGet,
+			Path:   s.URL,
+			Result: &resp,
+		})
+		if tt.match && err != nil {
+			t.Errorf("%s: unexpected error %v", tt.name, err)
+		}
+		if !tt.match && !IsPinMismatch(err) {
+			t.Errorf("%s: expected pin mismatch, got %v", tt.name, err)
+		}
+	}
+}
+
+func TestSetPinningPolicyErrors(t *testing.T) {
+	t.Parallel()
+	r := New("test", new(http.Client), nil)
+	err := r.SetPinningPolicy(PinningPolicy{PublicKeys: []string{"not base64"}})
+	if err == nil {
+		t.Error("expected invalid pin error")
+	}
+	err = r.SetPinningPolicy(PinningPolicy{Certificates: []string{base64.StdEncoding.EncodeToString([]byte("short"))}})
+	if err == nil {
+		t.Error("expected invalid pin length error")
+	}
+
+	err = r.SetPinningPolicy(PinningPolicy{PublicKeys: []string{pin(nil)}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.HTTPClient.Transport == http.DefaultTransport {
+		t.Error("default transport should not be modified")
+	}
+
+	// Pins must survive the transport being replaced by a proxy
+	err = r.SetProxy(&url.URL{Scheme: "http", Host: "127.0.0.1:8080"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tr, ok := r.HTTPClient.Transport.(*http.Transport)
+	if !ok || tr.TLSClientConfig == nil || tr.TLSClientConfig.VerifyPeerCertificate == nil {
+		t.Error("expected pins to be applied to the proxy transport")
+	}
+
+	r.HTTPClient.Transport = roundTripperFunc(func(*http.Request) (*http.Response, error) {
+		return nil, nil
+	})
+	if err = r.SetPinningPolicy(PinningPolicy{}); err != errPinningCustomTransport {
+		t.Errorf("expected custom transport error, got %v", err)
+	}
+}
+
+type roundTripperFunc func(*http.Request) (*http.Response, error)
+
+func (f roundTripperFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req)
+}
@@ -1,11 +1,11 @@
 package request
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
 	"io/ioutil"
-	"net"
 	"net/http"
 	"net/http/httputil"
 	"net/url"
@@ -13,34 +13,50 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
-	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/nonce"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+	"github.com/thrasher-corp/gocryptotrader/tracing"
 )
 
 // New returns a new Requester
 func New(name string, httpRequester *http.Client, l Limiter) *Requester {
 	return &Requester{
-		HTTPClient:           httpRequester,
-		Limiter:              l,
-		Name:                 name,
-		timeoutRetryAttempts: TimeoutRetryAttempts,
-		timedLock:            timedmutex.NewTimedMutex(DefaultMutexLockTimeout),
+		HTTPClient:  httpRequester,
+		Limiter:     l,
+		Name:        name,
+		retryPolicy: DefaultRetryPolicy(),
+		breaker:     circuitBreaker{policy: DefaultCircuitBreakerPolicy()},
+		timedLock:   timedmutex.NewTimedMutex(DefaultMutexLockTimeout),
 	}
 }
 
-// SendPayload handles sending HTTP/HTTPS requests
-func (r *Requester) SendPayload(i *Item) error {
+// SendPayload handles sending HTTP/HTTPS requests. The request, including any
+// timeout retries, is abandoned when ctx is cancelled or its deadline passes
+func (r *Requester) SendPayload(ctx context.Context, i *Item) error {
 	if !i.NonceEnabled {
 		r.timedLock.LockForDuration()
 	}
 
-	req, err := i.validateRequest(r)
+	req, err := i.validateRequest(ctx, r)
 	if err != nil {
 		r.timedLock.UnlockIfLocked()
 		return err
 	}
 
+	var cached *cacheEntry
+	if isCacheable(req, i) {
+		if e, ok := r.cache.get(req.URL.String()); ok {
+			if time.Now().Before(e.expires) {
+				r.timedLock.UnlockIfLocked()
+				metrics.RESTCacheRequests.Inc(r.Name, cacheHit)
+				return json.Unmarshal(e.body, i.Result)
+			}
+			e.setConditionalHeaders(req)
+			cached = &e
+		}
+	}
+
 	if i.HTTPDebugging {
 		// Err not evaluated due to validation check above
 		dump, _ := httputil.DumpRequestOut(req, true)
@@ -53,7 +69,15 @@ func (r *Requester) SendPayload(i *Item) error {
 	}
 
 	atomic.AddInt32(&r.jobs, 1)
-	err = r.doRequest(req, i)
+	// The query is omitted from the span as it may contain signatures
+	_, span := tracing.StartClientSpan(req.Context(), "HTTP "+req.Method,
+		tracing.String("exchange", r.Name),
+		tracing.String("http.method", req.Method),
+		tracing.String("http.url", req.URL.Scheme+"://"+req.URL.Host+req.URL.Path),
+		tracing.Bool("auth", i.AuthRequest))
+	err = r.doRequest(req, i, cached, span)
+	span.RecordError(err)
+	span.End()
 	atomic.AddInt32(&r.jobs, -1)
 	r.timedLock.UnlockIfLocked()
 
@@ -61,7 +85,7 @@ func (r *Requester) SendPayload(i *Item) error {
 }
 
 // validateRequest validates the requester item fields
-func (i *Item) validateRequest(r *Requester) (*http.Request, error) {
+func (i *Item) validateRequest(ctx context.Context, r *Requester) (*http.Request, error) {
 	if r == nil || r.Name == "" {
 		return nil, errors.New("not initialised, SetDefaults() called before making request?")
 	}
@@ -74,10 +98,15 @@ func (i *Item) validateRequest(r *Requester) (*http.Request, error) {
 		return nil, errors.New("invalid path")
 	}
 
+	if ctx == nil {
+		return nil, errors.New("request context cannot be nil")
+	}
+
 	req, err := http.NewRequest(i.Method, i.Path, i.Body)
 	if err != nil {
 		return nil, err
 	}
+	req = req.WithContext(ctx)
 
 	for k, v := range i.Headers {
 		req.Header.Add(k, v)
@@ -90,80 +119,142 @@ func (i *Item) validateRequest(r *Requester) (*http.Request, error) {
 	return req, nil
 }
 
-// DoRequest performs a HTTP/HTTPS request with the supplied params
-func (r *Requester) doRequest(req *http.Request, p *Item) error {
+// DoRequest performs a HTTP/HTTPS request with the supplied params, cached is
+// the expired response being revalidated if any
+func (r *Requester) doRequest(req *http.Request, p *Item, cached *cacheEntry, span *tracing.Span) error {
 	if p == nil {
 		return errors.New("request item cannot be nil")
 	}
 
-	if p.Verbose {
-		log.Debugf(log.RequestSys,
-			"%s request path: %s",
-			r.Name,
-			p.Path)
-
+	// An exchange debug level override enables verbose output without
+	// changing the exchange config
+	verbose := p.Verbose || log.ExchangeDebugEnabled(r.Name)
+	l := log.WithFields(log.RequestSys, log.Fields{Exchange: r.Name})
+	if verbose {
+		l.Debugf("%s request path: %s", r.Name, p.Path)
 		for k, d := range req.Header {
-			log.Debugf(log.RequestSys,
-				"%s request header [%s]: %s",
-				r.Name,
-				k,
-				d)
+			l.Debugf("%s request header [%s]: %s", r.Name, k, d)
 		}
-		log.Debugf(log.RequestSys,
-			"%s request type: %s",
-			r.Name,
-			req.Method)
-
+		l.Debugf("%s request type: %s", r.Name, req.Method)
 		if p.Body != nil {
-			log.Debugf(log.RequestSys,
-				"%s request body: %v",
-				r.Name,
-				p.Body)
+			l.Debugf("%s request body: %v", r.Name, p.Body)
 		}
 	}
 
-	var timeoutError error
-	for i := 0; i < r.timeoutRetryAttempts+1; i++ {
+	// Responses are cached under the requested URL whichever mirror served
+	// them
+	cacheKey := req.URL.String()
+	for attempt := 0; ; attempt++ {
+		group, target, err := r.mirrors.route(req, time.Now())
+		if err != nil {
+			return err
+		}
+		endpoint := req.URL.Host + req.URL.Path
+
+		// Fail fast while the endpoint is known to be down
+		if err := r.breaker.allow(r.Name, endpoint, time.Now()); err != nil {
+			span.AddEvent("circuit open")
+			return err
+		}
+
 		// Initiate a rate limit reservation and sleep on requested endpoint
-		err := r.InitiateRateLimit(p.Endpoint)
+		err = r.InitiateRateLimit(req.Context(), p.Endpoint)
 		if err != nil {
+			r.breaker.release(endpoint)
 			return err
 		}
+		// The rate limit wait may have outlasted the caller
+		if err = req.Context().Err(); err != nil {
+			r.breaker.release(endpoint)
+			return err
+		}
+		span.AddEvent("rate limit acquired", tracing.Int64("attempt", int64(attempt)))
+
+		if attempt > 0 && req.GetBody != nil {
+			req.Body, err = req.GetBody()
+			if err != nil {
+				r.breaker.release(endpoint)
+				return err
+			}
+		}
 
-		resp, err := r.HTTPClient.Do(req)
+		resp, transportErr, err := r.roundTrip(req, p)
 		if err != nil {
-			if timeoutErr, ok := err.(net.Error); ok && timeoutErr.Timeout() {
-				if p.Verbose {
-					log.Errorf(log.RequestSys,
-						"%s request has timed-out retrying request, count %d",
+			// The caller's deadline or cancellation and middleware errors
+			// are final
+			if req.Context().Err() != nil || transportErr == nil {
+				r.breaker.release(endpoint)
+				return err
+			}
+			failover := r.mirrors.record(group, target, true, time.Now())
+			if r.recordCircuit(endpoint, true) && !failover {
+				return err
+			}
+			if wait, reason, ok := r.retry(req, p, attempt, nil, err); ok {
+				if failover {
+					wait = 0
+				}
+				if verbose {
+					l.Errorf("%s request failed, retrying in %s count %d. Err: %s",
 						r.Name,
-						i)
+						wait,
+						attempt+1,
+						err)
+				}
+				if err = r.waitRetry(req, span, reason, wait); err != nil {
+					return err
 				}
-				timeoutError = err
 				continue
 			}
+			if attempt > 0 {
+				return fmt.Errorf("%s request failed after %d attempts: %s",
+					r.Name,
+					attempt+1,
+					err)
+			}
 			return err
 		}
+		// Any response below 500 shows the exchange is up, even if the
+		// request itself was rejected
+		failed := resp.StatusCode >= http.StatusInternalServerError
+		failover := r.mirrors.record(group, target, failed, time.Now())
+		circuitOpen := r.recordCircuit(endpoint, failed)
+		span.SetAttributes(tracing.Int64("http.status_code", int64(resp.StatusCode)))
 
 		contents, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
 		if err != nil {
 			return err
 		}
 
-		if p.HTTPRecording {
-			// This dumps http responses for future mocking implementations
-			err = mock.HTTPRecord(resp, r.Name, contents)
-			if err != nil {
-				return fmt.Errorf("mock recording failure %s", err)
-			}
+		notModified := cached != nil && resp.StatusCode == http.StatusNotModified
+		if notModified {
+			contents = cached.body
 		}
 
-		if resp.StatusCode < http.StatusOK ||
-			resp.StatusCode > http.StatusAccepted {
-			return fmt.Errorf("%s unsuccessful HTTP status code: %d  raw response: %s",
-				r.Name,
-				resp.StatusCode,
-				string(contents))
+		if !notModified && (resp.StatusCode < http.StatusOK ||
+			resp.StatusCode > http.StatusAccepted) {
+			if wait, reason, ok := r.retry(req, p, attempt, resp, nil); ok && (!circuitOpen || failover) {
+				if failover {
+					wait = 0
+				}
+				if verbose {
+					l.Errorf("%s unsuccessful HTTP status code: %d, retrying in %s count %d",
+						r.Name,
+						resp.StatusCode,
+						wait,
+						attempt+1)
+				}
+				if err = r.waitRetry(req, span, reason, wait); err != nil {
+					return err
+				}
+				continue
+			}
+			return &StatusError{
+				Name:       r.Name,
+				StatusCode: resp.StatusCode,
+				Body:       string(contents),
+			}
 		}
 
 		if p.HTTPDebugging {
@@ -175,51 +266,87 @@ func (r *Requester) doRequest(req *http.Request, p *Item) error {
 			log.Debugf(log.RequestSys, "DumpResponse Body (%v):\n %s", p.Path, string(contents))
 		}
 
-		resp.Body.Close()
-		if p.Verbose {
-			log.Debugf(log.RequestSys,
-				"HTTP status: %s, Code: %v",
+		if verbose {
+			l.Debugf("HTTP status: %s, Code: %v",
 				resp.Status,
 				resp.StatusCode)
 			if !p.HTTPDebugging {
-				log.Debugf(log.RequestSys,
-					"%s raw response: %s",
+				l.Debugf("%s raw response: %s",
 					r.Name,
 					string(contents))
 			}
 		}
-		return json.Unmarshal(contents, p.Result)
+		err = json.Unmarshal(contents, p.Result)
+		if err == nil && isCacheable(req, p) {
+			if notModified {
+				r.cache.refresh(cacheKey, resp.Header, p.CacheTTL, time.Now())
+				metrics.RESTCacheRequests.Inc(r.Name, cacheRevalidated)
+			} else {
+				r.cache.store(cacheKey, resp.Header, contents, p.CacheTTL, time.Now())
+				metrics.RESTCacheRequests.Inc(r.Name, cacheMiss)
+			}
+		}
+		return err
+	}
+}
+
+// recordCircuit records the outcome of a request against the endpoints
+// circuit breaker, returning whether the circuit is now open
+func (r *Requester) recordCircuit(endpoint string, failed bool) bool {
+	opened, closed := r.breaker.record(endpoint, failed, time.Now())
+	switch {
+	case opened:
+		metrics.RESTCircuitOpens.Inc(r.Name)
+		log.Warnf(log.RequestSys, "%s circuit breaker opened for %s, requests will fail fast until the endpoint recovers",
+			r.Name,
+			endpoint)
+	case closed:
+		log.Infof(log.RequestSys, "%s circuit breaker closed for %s, endpoint recovered",
+			r.Name,
+			endpoint)
+	}
+	return failed && r.breaker.open(endpoint)
+}
+
+// waitRetry waits before the next attempt, returning early if the caller
+// gives up in the meantime
+func (r *Requester) waitRetry(req *http.Request, span *tracing.Span, reason string, wait time.Duration) error {
+	metrics.RESTRetries.Inc(r.Name, reason)
+	span.AddEvent("retry", tracing.String("reason", reason))
+	t := time.NewTimer(wait)
+	defer t.Stop()
+	select {
+	case <-t.C:
+		return nil
+	case <-req.Context().Done():
+		return req.Context().Err()
 	}
-	return fmt.Errorf("request.go error - failed to retry request %s",
-		timeoutError)
 }
 
 // GetNonce returns a nonce for requests. This locks and enforces concurrent
 // nonce FIFO on the buffered job channel
 func (r *Requester) GetNonce(isNano bool) nonce.Value {
 	r.timedLock.LockForDuration()
-	if r.Nonce.Get() == 0 {
-		if isNano {
-			r.Nonce.Set(time.Now().UnixNano())
-		} else {
-			r.Nonce.Set(time.Now().Unix())
-		}
-		return r.Nonce.Get()
+	if isNano {
+		return r.nextNonce(r.Now().UnixNano())
 	}
-	r.Nonce.Inc()
-	return r.Nonce.Get()
+	return r.nextNonce(r.Now().Unix())
 }
 
 // GetNonceMilli returns a nonce for requests. This locks and enforces concurrent
 // nonce FIFO on the buffered job channel this is for millisecond
 func (r *Requester) GetNonceMilli() nonce.Value {
 	r.timedLock.LockForDuration()
-	if r.Nonce.Get() == 0 {
-		r.Nonce.Set(time.Now().UnixNano() / int64(time.Millisecond))
-		return r.Nonce.Get()
+	return r.nextNonce(r.Now().UnixNano() / int64(time.Millisecond))
+}
+
+// nextNonce returns the next nonce, logging if it could not be persisted
+func (r *Requester) nextNonce(start int64) nonce.Value {
+	n, err := r.Nonce.Next(start)
+	if err != nil {
+		log.Errorf(log.RequestSys, "%s unable to persist nonce: %s", r.Name, err)
 	}
-	r.Nonce.Inc()
-	return r.Nonce.Get()
+	return n
 }
 
 // SetProxy sets a proxy address to the client transport
@@ -228,9 +355,11 @@ func (r *Requester) SetProxy(p *url.URL) error {
 		return errors.New("no proxy URL supplied")
 	}
 
-	r.HTTPClient.Transport = &http.Transport{
+	t := &http.Transport{
 		Proxy:               http.ProxyURL(p),
 		TLSHandshakeTimeout: proxyTLSTimeout,
 	}
+	r.applyPins(t)
+	r.HTTPClient.Transport = t
 	return nil
 }
@@ -1,6 +1,7 @@
 package request
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -79,6 +80,77 @@ func TestNewRateLimit(t *testing.T) {
 	}
 }
 
+func TestNewTokenBucket(t *testing.T) {
+	t.Parallel()
+	r := NewTokenBucket(time.Minute, 1200, 1200)
+	if r.Limit() != 20 || r.Burst() != 1200 {
+		t.Fatal(unexpected)
+	}
+
+	r = NewTokenBucket(time.Second, 10, 0)
+	if r.Burst() != 1 {
+		t.Fatal("burst should be at least one")
+	}
+
+	r = NewTokenBucket(0, 10, 5)
+	if r.Limit() != rate.Inf || r.Burst() != 5 {
+		t.Fatal(unexpected)
+	}
+}
+
+func TestBucketLimiter(t *testing.T) {
+	t.Parallel()
+	const (
+		order EndpointLimit = iota + UnAuth + 1
+		heavy
+		unknown
+	)
+	l := NewBucketLimiter()
+	l.AddBucket("weight", NewTokenBucket(time.Minute, 60, 10))
+	l.AddBucket("orders", NewTokenBucket(time.Minute, 1, 1))
+	l.SetWeight(order, Weight{Bucket: "weight", Tokens: 1}, Weight{Bucket: "orders", Tokens: 1})
+	l.SetWeight(heavy, Weight{Bucket: "weight", Tokens: 20})
+	l.SetWeight(unknown, Weight{Bucket: "unknown", Tokens: 1})
+
+	if err := l.Limit(UnAuth); err == nil {
+		t.Error("expected error for endpoint without a weight")
+	}
+	l.SetDefaultWeight(Weight{Bucket: "weight", Tokens: 1})
+
+	// Burst allowance is available immediately
+	start := time.Now()
+	for i := 0; i < 8; i++ {
+		if err := l.Limit(UnAuth); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := l.Limit(order); err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(start) > time.Millisecond*500 {
+		t.Error("burst should not wait")
+	}
+
+	// The order bucket is empty so the request waits until the context is
+	// done
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	defer cancel()
+	if err := l.LimitContext(ctx, order); err != context.DeadlineExceeded {
+		t.Fatalf("expected deadline exceeded, got %v", err)
+	}
+	// The abandoned reservation hands back its future token
+	if d := l.buckets["orders"].Reserve().Delay(); d > time.Minute {
+		t.Errorf("expected cancelled order token to be returned, delay %s", d)
+	}
+
+	if err := l.Limit(heavy); err == nil {
+		t.Error("expected error for weight exceeding burst")
+	}
+	if err := l.Limit(unknown); err == nil {
+		t.Error("expected error for unknown bucket")
+	}
+}
+
 func TestCheckRequest(t *testing.T) {
 	t.Parallel()
 
@@ -87,36 +159,41 @@ func TestCheckRequest(t *testing.T) {
 		nil)
 
 	var check *Item
-	_, err := check.validateRequest(&Requester{})
+	_, err := check.validateRequest(context.Background(), &Requester{})
 	if err == nil {
 		t.Fatal(unexpected)
 	}
 
-	_, err = check.validateRequest(nil)
+	_, err = check.validateRequest(context.Background(), nil)
 	if err == nil {
 		t.Fatal(unexpected)
 	}
 
-	_, err = check.validateRequest(r)
+	_, err = check.validateRequest(context.Background(), r)
 	if err == nil {
 		t.Fatal(unexpected)
 	}
 
 	check = &Item{}
-	_, err = check.validateRequest(r)
+	_, err = check.validateRequest(context.Background(), r)
 	if err == nil {
 		t.Fatal(unexpected)
 	}
 
 	check.Path = testURL
 	check.Method = " " // Forces method check; "" automatically converts to GET
-	_, err = check.validateRequest(r)
+	_, err = check.validateRequest(context.Background(), r)
 	if err == nil {
 		t.Fatal(unexpected)
 	}
 
 	check.Method = http.MethodPost
-	_, err = check.validateRequest(r)
+	_, err = check.validateRequest(nil, r) // nolint: staticcheck // nil context check
+	if err == nil {
+		t.Fatal(unexpected)
+	}
+
+	_, err = check.validateRequest(context.Background(), r)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -128,7 +205,7 @@ func TestCheckRequest(t *testing.T) {
 
 	// Test user agent set
 	r.UserAgent = "r00t axxs"
-	req, err := check.validateRequest(r)
+	req, err := check.validateRequest(context.Background(), r)
 	if err != nil {
 		t.Fatal(err)
 	}
@@ -176,17 +253,17 @@ func TestDoRequest(t *testing.T) {
 		new(http.Client),
 		&globalshell)
 
-	err := r.SendPayload(&Item{})
+	err := r.SendPayload(context.Background(), &Item{})
 	if err == nil {
 		t.Fatal(unexpected)
 	}
 
-	err = r.SendPayload(&Item{Method: http.MethodGet})
+	err = r.SendPayload(context.Background(), &Item{Method: http.MethodGet})
 	if err == nil {
 		t.Fatal(unexpected)
 	}
 
-	err = r.SendPayload(&Item{
+	err = r.SendPayload(context.Background(), &Item{
 		Method: http.MethodGet,
 		Path:   testURL,
 	})
@@ -195,7 +272,7 @@ func TestDoRequest(t *testing.T) {
 	}
 
 	// force debug
-	err = r.SendPayload(&Item{
+	err = r.SendPayload(context.Background(), &Item{
 		Method:        http.MethodGet,
 		Path:          testURL,
 		HTTPDebugging: true,
@@ -207,7 +284,7 @@ func TestDoRequest(t *testing.T) {
 
 	// max request job ceiling
 	r.jobs = MaxRequestJobs
-	err = r.SendPayload(&Item{
+	err = r.SendPayload(context.Background(), &Item{
 		Method: http.MethodGet,
 		Path:   testURL,
 	})
@@ -219,7 +296,7 @@ func TestDoRequest(t *testing.T) {
 
 	// timeout checker
 	r.HTTPClient.Timeout = time.Millisecond * 50
-	err = r.SendPayload(&Item{
+	err = r.SendPayload(context.Background(), &Item{
 		Method: http.MethodGet,
 		Path:   testURL + "/timeout",
 	})
@@ -229,11 +306,35 @@ func TestDoRequest(t *testing.T) {
 	// reset timeout
 	r.HTTPClient.Timeout = 0
 
+	// caller deadline is honoured without a client timeout
+	ctx, cancel := context.WithTimeout(context.Background(), time.Millisecond*50)
+	err = r.SendPayload(ctx, &Item{
+		Method:   http.MethodGet,
+		Path:     testURL + "/timeout",
+		Endpoint: UnAuth,
+	})
+	cancel()
+	if err == nil || ctx.Err() != context.DeadlineExceeded {
+		t.Fatal(unexpected)
+	}
+
+	// cancelled context aborts before the request is sent
+	ctx, cancel = context.WithCancel(context.Background())
+	cancel()
+	err = r.SendPayload(ctx, &Item{
+		Method:   http.MethodGet,
+		Path:     testURL,
+		Endpoint: UnAuth,
+	})
+	if err == nil {
+		t.Fatal(unexpected)
+	}
+
 	// Check JSON
 	var resp struct {
 		Response bool `json:"response"`
 	}
-	err = r.SendPayload(&Item{
+	err = r.SendPayload(context.Background(), &Item{
 		Method:   http.MethodGet,
 		Path:     testURL,
 		Result:   &resp,
@@ -250,7 +351,7 @@ func TestDoRequest(t *testing.T) {
 	var respErr struct {
 		Error bool `json:"error"`
 	}
-	err = r.SendPayload(&Item{
+	err = r.SendPayload(context.Background(), &Item{
 		Method:   http.MethodGet,
 		Path:     testURL,
 		Result:   &respErr,
@@ -271,7 +372,7 @@ func TestDoRequest(t *testing.T) {
 			var resp struct {
 				Response bool `json:"response"`
 			}
-			payloadError := r.SendPayload(&Item{
+			payloadError := r.SendPayload(context.Background(), &Item{
 				Method:      http.MethodGet,
 				Path:        testURL + "/rate",
 				Result:      &resp,
@@ -357,8 +458,8 @@ func TestBasicLimiter(t *testing.T) {
 	}
 
 	tn := time.Now()
-	_ = r.SendPayload(&i)
-	_ = r.SendPayload(&i)
+	_ = r.SendPayload(context.Background(), &i)
+	_ = r.SendPayload(context.Background(), &i)
 	if time.Since(tn) < time.Second {
 		t.Error("rate limit issues")
 	}
@@ -370,7 +471,7 @@ func TestEnableDisableRateLimit(t *testing.T) {
 		NewBasicRateLimit(time.Minute, 1))
 
 	var resp interface{}
-	err := r.SendPayload(&Item{
+	err := r.SendPayload(context.Background(), &Item{
 		Method:      http.MethodGet,
 		Path:        testURL,
 		Result:      &resp,
@@ -391,7 +492,7 @@ func TestEnableDisableRateLimit(t *testing.T) {
 		t.Fatal(err)
 	}
 
-	err = r.SendPayload(&Item{
+	err = r.SendPayload(context.Background(), &Item{
 		Method:      http.MethodGet,
 		Path:        testURL,
 		Result:      &resp,
@@ -415,7 +516,7 @@ func TestEnableDisableRateLimit(t *testing.T) {
 	ti := time.NewTicker(time.Second)
 	c := make(chan struct{})
 	go func(c chan struct{}) {
-		err = r.SendPayload(&Item{
+		err = r.SendPayload(context.Background(), &Item{
 			Method:      http.MethodGet,
 			Path:        testURL,
 			Result:      &resp,
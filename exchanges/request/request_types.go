@@ -1,8 +1,10 @@
 package request
 
 import (
+	"fmt"
 	"io"
 	"net/http"
+	"sync"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common/timedmutex"
@@ -11,11 +13,17 @@ import (
 
 // Const vars for rate limiter
 const (
-	DefaultMaxRequestJobs       int32 = 50
-	DefaultTimeoutRetryAttempts       = 3
-	DefaultMutexLockTimeout           = 50 * time.Millisecond
-	proxyTLSTimeout                   = 15 * time.Second
-	userAgent                         = "User-Agent"
+	DefaultMaxRequestJobs          int32 = 50
+	DefaultTimeoutRetryAttempts          = 3
+	DefaultRetryInitialBackoff           = 250 * time.Millisecond
+	DefaultRetryMaxBackoff               = 10 * time.Second
+	DefaultCircuitFailureThreshold       = 5
+	DefaultCircuitCooldown               = 30 * time.Second
+	DefaultMirrorCooldown                = 30 * time.Second
+	MaxCacheEntries                      = 512
+	DefaultMutexLockTimeout              = 50 * time.Millisecond
+	proxyTLSTimeout                      = 15 * time.Second
+	userAgent                            = "User-Agent"
 )
 
 // Vars for rate limiter
@@ -26,15 +34,45 @@ var (
 
 // Requester struct for the request client
 type Requester struct {
-	HTTPClient           *http.Client
-	Limiter              Limiter
-	Name                 string
-	UserAgent            string
-	timeoutRetryAttempts int
-	jobs                 int32
-	Nonce                nonce.Nonce
-	disableRateLimiter   int32
-	timedLock            *timedmutex.TimedMutex
+	// serverTimeOffset is first to keep it 64-bit aligned for atomic access
+	serverTimeOffset   int64
+	HTTPClient         *http.Client
+	Limiter            Limiter
+	Name               string
+	UserAgent          string
+	retryPolicy        RetryPolicy
+	breaker            circuitBreaker
+	pins               *pins
+	mirrors            mirrors
+	cache              responseCache
+	middleware         []Middleware
+	middlewareMtx      sync.RWMutex
+	jobs               int32
+	Nonce              nonce.Nonce
+	disableRateLimiter int32
+	timedLock          *timedmutex.TimedMutex
+}
+
+// RetryPolicy defines how requests failing with a network error, a 429 or a
+// 5xx status are retried. Requests which may have been acted on by the
+// exchange are only retried if they are idempotent.
+type RetryPolicy struct {
+	// MaxRetries is the number of retries after the initial attempt
+	MaxRetries int
+	// InitialBackoff is the wait before the first retry, doubling for each
+	// retry after that up to MaxBackoff. Jitter is applied to each wait.
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// CircuitBreakerPolicy defines when requests to an endpoint fail fast. After
+// FailureThreshold consecutive network errors or 5xx responses the circuit
+// opens and requests return a CircuitOpenError without being sent. Once
+// Cooldown has passed a single probe request is sent, closing the circuit if
+// it succeeds.
+type CircuitBreakerPolicy struct {
+	FailureThreshold int
+	Cooldown         time.Duration
 }
 
 // Item is a temp item for requests
@@ -51,4 +89,26 @@ type Item struct {
 	HTTPRecording bool
 	IsReserved    bool
 	Endpoint      EndpointLimit
+	// CacheTTL opts an unauthenticated GET request into the response cache,
+	// serving repeated requests from the cache for the TTL. Once expired the
+	// response is revalidated with the exchange using its ETag or
+	// Last-Modified header if it sent one.
+	CacheTTL time.Duration
+	// Idempotent allows retrying a non GET request when it is unknown whether
+	// the exchange received it, e.g. an order with a client order ID which
+	// the exchange deduplicates
+	Idempotent bool
+}
+
+// StatusError is returned when the exchange responds to a request with an
+// unsuccessful HTTP status code
+type StatusError struct {
+	Name       string
+	StatusCode int
+	Body       string
+}
+
+func (e *StatusError) Error() string {
+	return fmt.Sprintf("%s unsuccessful HTTP status code: %d  raw response: %s",
+		e.Name, e.StatusCode, e.Body)
 }
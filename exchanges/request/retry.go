@@ -0,0 +1,184 @@
+package request
+
+import (
+	"context"
+	"io"
+	"math/rand"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+)
+
+// Retry reasons recorded against the retry metric
+const (
+	retryReasonNetwork     = "network"
+	retryReasonRateLimited = "rate_limited"
+	retryReasonServer      = "server_error"
+)
+
+// DefaultRetryPolicy returns the retry policy used by a new requester
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxRetries:     TimeoutRetryAttempts,
+		InitialBackoff: DefaultRetryInitialBackoff,
+		MaxBackoff:     DefaultRetryMaxBackoff,
+	}
+}
+
+// SetRetryPolicy sets how failed requests are retried, a MaxRetries of zero
+// disables retries
+func (r *Requester) SetRetryPolicy(p RetryPolicy) {
+	if p.MaxRetries < 0 {
+		p.MaxRetries = 0
+	}
+	if p.InitialBackoff <= 0 {
+		p.InitialBackoff = DefaultRetryInitialBackoff
+	}
+	if p.MaxBackoff < p.InitialBackoff {
+		p.MaxBackoff = p.InitialBackoff
+	}
+	r.retryPolicy = p
+}
+
+// GetRetryPolicy returns the current retry policy
+func (r *Requester) GetRetryPolicy() RetryPolicy {
+	return r.retryPolicy
+}
+
+// retry determines whether a failed attempt should be retried, returning the
+// wait before the next attempt and the reason for the retry. Only one of resp
+// or err is expected to be set.
+func (r *Requester) retry(req *http.Request, p *Item, attempt int, resp *http.Response, err error) (time.Duration, string, bool) {
+	if attempt >= r.retryPolicy.MaxRetries {
+		return 0, "", false
+	}
+
+	// A body which cannot be rewound cannot be sent again
+	if req.Body != nil && req.Body != http.NoBody && req.GetBody == nil {
+		return 0, "", false
+	}
+
+	var reason string
+	var wait time.Duration
+	if err != nil {
+		// A certificate not matching a pin is not transient
+		if IsPinMismatch(err) {
+			return 0, "", false
+		}
+		// A request that was never sent is always safe to retry, otherwise
+		// the exchange may have acted on it
+		if !isDialError(err) && !isIdempotent(req.Method, p) {
+			return 0, "", false
+		}
+		reason = retryReasonNetwork
+	} else {
+		switch {
+		case resp.StatusCode == http.StatusTooManyRequests:
+			// Rate limited requests are rejected before being processed
+			reason = retryReasonRateLimited
+		case resp.StatusCode >= http.StatusInternalServerError:
+			if !isIdempotent(req.Method, p) {
+				return 0, "", false
+			}
+			reason = retryReasonServer
+		default:
+			return 0, "", false
+		}
+		wait = retryAfter(resp.Header.Get("Retry-After"), time.Now(), r.retryPolicy.MaxBackoff)
+	}
+
+	if wait <= 0 {
+		wait = r.retryPolicy.backoff(attempt)
+	}
+
+	if deadline, ok := req.Context().Deadline(); ok && time.Now().Add(wait).After(deadline) {
+		// No point waiting if the caller will have given up by then
+		return 0, "", false
+	}
+	return wait, reason, true
+}
+
+// backoff returns the exponential backoff for the attempt with equal jitter,
+// so that concurrent requests failing together do not retry in lock step
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	d := p.InitialBackoff
+	for i := 0; i < attempt && d < p.MaxBackoff; i++ {
+		d *= 2
+	}
+	if d > p.MaxBackoff {
+		d = p.MaxBackoff
+	}
+	half := d / 2
+	if half <= 0 {
+		return d
+	}
+	return half + time.Duration(rand.Int63n(int64(half))) // nolint: gosec // jitter does not need a secure random source
+}
+
+// retryAfter parses a Retry-After header which is either delay seconds or a
+// HTTP date, clamping the wait to max so an exchange can't stall a request
+// for longer than the retry policy allows
+func retryAfter(header string, now time.Time, max time.Duration) time.Duration {
+	var wait time.Duration
+	if secs, err := strconv.ParseInt(header, 10, 64); err == nil {
+		if secs <= 0 {
+			return 0
+		}
+		if secs > int64(max/time.Second) {
+			return max
+		}
+		wait = time.Duration(secs) * time.Second
+	} else if t, err := http.ParseTime(header); err == nil {
+		wait = t.Sub(now)
+	}
+	if wait > max {
+		return max
+	}
+	return wait
+}
+
+// isIdempotent returns whether sending the request more than once has the same
+// effect as sending it once
+func isIdempotent(method string, p *Item) bool {
+	if p.Idempotent {
+		return true
+	}
+	switch method {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+		return true
+	}
+	return false
+}
+
+// isDialError returns whether the error occurred establishing a connection,
+// in which case the request did not reach the exchange
+func isDialError(err error) bool {
+	if uErr, ok := err.(*url.Error); ok {
+		err = uErr.Err
+	}
+	opErr, ok := err.(*net.OpError)
+	return ok && opErr.Op == "dial"
+}
+
+// IsAmbiguous returns whether a request failed after it may have reached the
+// exchange, so the exchange may have acted on it. A request timing out, losing
+// its connection or failing with a server error status code is ambiguous, one
+// which failed to connect or was rejected is not
+func IsAmbiguous(err error) bool {
+	if err == nil {
+		return false
+	}
+	if sErr, ok := err.(*StatusError); ok {
+		return sErr.StatusCode >= http.StatusInternalServerError
+	}
+	if err == context.DeadlineExceeded || err == io.ErrUnexpectedEOF {
+		return true
+	}
+	if uErr, ok := err.(*url.Error); ok {
+		return !isDialError(uErr)
+	}
+	nErr, ok := err.(net.Error)
+	return ok && nErr.Timeout()
+}
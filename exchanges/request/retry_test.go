@@ -0,0 +1,276 @@
+package request
+
+import (
+	"context"
+	"errors"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+)
+
+// newRetryServer returns a server which responds with the status codes in
+// order, then succeeds
+func newRetryServer(calls *int32, statuses ...int) *httptest.Server {
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		n := int(atomic.AddInt32(calls, 1))
+		if req.Body != nil {
+			b, _ := ioutil.ReadAll(req.Body)
+			if req.Method == http.MethodPost && string(b) != "payload" {
+				w.WriteHeader(http.StatusBadRequest)
+				return
+			}
+		}
+		if n <= len(statuses) {
+			if statuses[n-1] == http.StatusTooManyRequests {
+				w.Header().Set("Retry-After", "0")
+			}
+			w.WriteHeader(statuses[n-1])
+			return
+		}
+		io.WriteString(w, `{"response":true}`)
+	}))
+}
+
+func newRetryRequester(maxRetries int) *Requester {
+	r := New("retry", new(http.Client), nil)
+	r.SetRetryPolicy(RetryPolicy{
+		MaxRetries:     maxRetries,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond * 5,
+	})
+	return r
+}
+
+func TestSendPayloadRetry(t *testing.T) {
+	t.Parallel()
+	var resp struct {
+		Response bool `json:"response"`
+	}
+
+	var calls int32
+	s := newRetryServer(&calls, http.StatusServiceUnavailable, http.StatusBadGateway)
+	defer s.Close()
+	err := newRetryRequester(3).SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   s.URL,
+		Result: &resp,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !resp.Response || atomic.LoadInt32(&calls) != 3 {
+		t.Errorf("expected success on third attempt, got %d attempts", calls)
+	}
+
+	// Retries exhausted
+	calls = 0
+	err = newRetryRequester(1).SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   s.URL,
+		Result: &resp,
+	})
+	if err == nil || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("expected failure after two attempts, got %d attempts", calls)
+	}
+}
+
+func TestSendPayloadRetryNonIdempotent(t *testing.T) {
+	t.Parallel()
+	var resp struct {
+		Response bool `json:"response"`
+	}
+
+	// The exchange may have acted on the order, so it must not be resent
+	var calls int32
+	s := newRetryServer(&calls, http.StatusInternalServerError)
+	defer s.Close()
+	err := newRetryRequester(3).SendPayload(context.Background(), &Item{
+		Method: http.MethodPost,
+		Path:   s.URL,
+		Body:   strings.NewReader("payload"),
+		Result: &resp,
+	})
+	if err == nil || atomic.LoadInt32(&calls) != 1 {
+		t.Errorf("expected a single attempt, got %d", calls)
+	}
+
+	// Idempotent requests are resent with the same body
+	calls = 0
+	err = newRetryRequester(3).SendPayload(context.Background(), &Item{
+		Method:     http.MethodPost,
+		Path:       s.URL,
+		Body:       strings.NewReader("payload"),
+		Result:     &resp,
+		Idempotent: true,
+	})
+	if err != nil || atomic.LoadInt32(&calls) != 2 {
+		t.Errorf("expected success on second attempt, got %d attempts err %v", calls, err)
+	}
+
+	// Rate limited requests were not processed and are safe to resend
+	var rateCalls int32
+	rs := newRetryServer(&rateCalls, http.StatusTooManyRequests)
+	defer rs.Close()
+	err = newRetryRequester(3).SendPayload(context.Background(), &Item{
+		Method: http.MethodPost,
+		Path:   rs.URL,
+		Body:   strings.NewReader("payload"),
+		Result: &resp,
+	})
+	if err != nil || atomic.LoadInt32(&rateCalls) != 2 {
+		t.Errorf("expected success on second attempt, got %d attempts err %v", rateCalls, err)
+	}
+}
+
+func TestSendPayloadRetryDeadline(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.Header().Set("Retry-After", "10")
+		w.WriteHeader(http.StatusTooManyRequests)
+	}))
+	defer s.Close()
+
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	defer cancel()
+	r := newRetryRequester(3)
+	r.SetRetryPolicy(RetryPolicy{
+		MaxRetries:     3,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Minute,
+	})
+	start := time.Now()
+	err := r.SendPayload(ctx, &Item{
+		Method: http.MethodGet,
+		Path:   s.URL,
+	})
+	if err == nil {
+		t.Fatal(unexpected)
+	}
+	if time.Since(start) > time.Millisecond*500 || atomic.LoadInt32(&calls) != 1 {
+		t.Error("retry should not wait beyond the caller deadline")
+	}
+}
+
+func TestSendPayloadRetryAfterClamped(t *testing.T) {
+	t.Parallel()
+	var calls int32
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		if atomic.AddInt32(&calls, 1) == 1 {
+			w.Header().Set("Retry-After", "3600")
+			w.WriteHeader(http.StatusTooManyRequests)
+			return
+		}
+		io.WriteString(w, `{"response":true}`)
+	}))
+	defer s.Close()
+
+	var resp struct {
+		Response bool `json:"response"`
+	}
+	start := time.Now()
+	err := newRetryRequester(3).SendPayload(context.Background(), &Item{
+		Method: http.MethodGet,
+		Path:   s.URL,
+		Result: &resp,
+	})
+	if err != nil || atomic.LoadInt32(&calls) != 2 {
+		t.Fatalf("expected success on the second attempt, got %d attempts err %v", calls, err)
+	}
+	if time.Since(start) > time.Millisecond*500 {
+		t.Error("retry should not wait beyond the maximum backoff")
+	}
+}
+
+func TestRetryAfter(t *testing.T) {
+	t.Parallel()
+	now := time.Date(2020, 1, 1, 0, 0, 0, 0, time.UTC)
+	tester := []struct {
+		Header string
+		Wait   time.Duration
+	}{
+		{"", 0},
+		{"5", time.Second * 5},
+		{"-1", 0},
+		{"soon", 0},
+		{now.Add(time.Minute).Format(http.TimeFormat), time.Minute},
+		{"3600", time.Minute * 2},
+		{"9223372036854775807", time.Minute * 2},
+		{now.Add(time.Hour).Format(http.TimeFormat), time.Minute * 2},
+	}
+	for x := range tester {
+		if w := retryAfter(tester[x].Header, now, time.Minute*2); w != tester[x].Wait {
+			t.Errorf("%q: expected %s got %s", tester[x].Header, tester[x].Wait, w)
+		}
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	t.Parallel()
+	p := RetryPolicy{InitialBackoff: time.Millisecond * 100, MaxBackoff: time.Second}
+	for attempt, want := range []time.Duration{
+		time.Millisecond * 100,
+		time.Millisecond * 200,
+		time.Millisecond * 400,
+		time.Millisecond * 800,
+		time.Second,
+		time.Second,
+	} {
+		if d := p.backoff(attempt); d < want/2 || d >= want {
+			t.Errorf("attempt %d: backoff %s outside [%s, %s)", attempt, d, want/2, want)
+		}
+	}
+}
+
+func TestIsDialError(t *testing.T) {
+	t.Parallel()
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+
+	_, err = http.Post("http://"+addr, "text/plain", strings.NewReader("payload")) // nolint: bodyclose,noctx // request never connects
+	if !isDialError(err) {
+		t.Errorf("expected dial error, got %v", err)
+	}
+	if isDialError(context.DeadlineExceeded) {
+		t.Error("unexpected dial error")
+	}
+}
+
+func TestIsAmbiguous(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := l.Addr().String()
+	l.Close()
+	_, err = http.Post("http://"+addr, "text/plain", strings.NewReader("payload")) // nolint: bodyclose,noctx // request never connects
+	if IsAmbiguous(err) {
+		t.Errorf("expected a request which never connected to not be ambiguous, got %v", err)
+	}
+
+	timeout := &url.Error{Op: "Post", URL: "http://" + addr, Err: context.DeadlineExceeded}
+	if !IsAmbiguous(timeout) {
+		t.Error("expected a request timing out to be ambiguous")
+	}
+	if !IsAmbiguous(&StatusError{StatusCode: http.StatusBadGateway}) {
+		t.Error("expected a server error to be ambiguous")
+	}
+	if IsAmbiguous(&StatusError{StatusCode: http.StatusBadRequest}) {
+		t.Error("expected a rejected request to not be ambiguous")
+	}
+	if IsAmbiguous(errors.New("insufficient funds")) {
+		t.Error("expected an exchange error to not be ambiguous")
+	}
+}
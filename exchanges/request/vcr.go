@@ -0,0 +1,149 @@
+package request
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+)
+
+var (
+	vcrMtx       sync.Mutex
+	recordDir    string
+	replayDir    string
+	replayMocks  = make(map[string]*mock.VCRMock)
+	errNoReplays = errors.New("no recorded HTTP responses")
+)
+
+// SetRecordDirectory records the response to every exchange REST request to
+// a fixture file per exchange in dir, for replaying with SetReplayDirectory.
+// Fixtures use the mock package's format so they can also be served by its
+// VCR server. Excluded headers and variables are blanked, but fixtures should
+// still be checked for account details before being shared. An empty dir
+// stops recording.
+func SetRecordDirectory(dir string) error {
+	if dir != "" {
+		err := os.MkdirAll(dir, 0770)
+		if err != nil {
+			return err
+		}
+	}
+	vcrMtx.Lock()
+	recordDir = dir
+	vcrMtx.Unlock()
+	return nil
+}
+
+// SetReplayDirectory makes exchange REST requests return the responses
+// recorded in dir rather than being sent, failing any request without a
+// recorded response. An empty dir stops replaying.
+func SetReplayDirectory(dir string) {
+	vcrMtx.Lock()
+	replayDir = dir
+	replayMocks = make(map[string]*mock.VCRMock)
+	vcrMtx.Unlock()
+}
+
+// FixtureFile returns the path of an exchanges fixture file in dir, laid out
+// as in testdata/http_mock
+func FixtureFile(dir, exchangeName string) string {
+	name := strings.ToLower(exchangeName)
+	return filepath.Join(dir, name, name+".json")
+}
+
+// recordingFile returns the fixture file an exchanges responses are recorded
+// to, empty when recording is disabled or responses are being replayed
+func recordingFile(exchangeName string) string {
+	vcrMtx.Lock()
+	defer vcrMtx.Unlock()
+	if recordDir == "" || replayDir != "" {
+		return ""
+	}
+	return FixtureFile(recordDir, exchangeName)
+}
+
+// replayMock returns the responses recorded for an exchange, nil when
+// replaying is disabled
+func replayMock(exchangeName string) (*mock.VCRMock, error) {
+	vcrMtx.Lock()
+	defer vcrMtx.Unlock()
+	if replayDir == "" {
+		return nil, nil
+	}
+	if m, ok := replayMocks[exchangeName]; ok {
+		return m, nil
+	}
+	contents, err := ioutil.ReadFile(FixtureFile(replayDir, exchangeName))
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("%s %v in %s", exchangeName, errNoReplays, replayDir)
+		}
+		return nil, err
+	}
+	m := new(mock.VCRMock)
+	if err = json.Unmarshal(contents, m); err != nil {
+		return nil, fmt.Errorf("%s fixture file: %v", exchangeName, err)
+	}
+	replayMocks[exchangeName] = m
+	return m, nil
+}
+
+// recordVCR records each response to the exchanges fixture file when
+// recording
+func recordVCR(exchName string) Middleware {
+	return PostResponse(func(_ *http.Request, _ *Item, resp *http.Response) error {
+		fixture := recordingFile(exchName)
+		if fixture == "" {
+			return nil
+		}
+		contents, err := ioutil.ReadAll(resp.Body)
+		resp.Body.Close()
+		if err != nil {
+			return err
+		}
+		resp.Body = ioutil.NopCloser(bytes.NewReader(contents))
+		if err = mock.HTTPRecordFile(resp, fixture, contents); err != nil {
+			return fmt.Errorf("%s HTTP recording failure: %v", exchName, err)
+		}
+		return nil
+	})
+}
+
+// replayVCR answers each request with its recorded response when replaying,
+// without sending it
+func replayVCR(exchName string) Middleware {
+	return func(next Handler) Handler {
+		return func(req *http.Request, item *Item) (*http.Response, error) {
+			m, err := replayMock(exchName)
+			if err != nil {
+				return nil, err
+			}
+			if m == nil {
+				return next(req, item)
+			}
+			payload, err := mock.MatchRequest(m, req)
+			if err != nil {
+				return nil, fmt.Errorf("%s HTTP replay: %v", exchName, err)
+			}
+			return &http.Response{
+				Status:        http.StatusText(http.StatusOK),
+				StatusCode:    http.StatusOK,
+				Proto:         "HTTP/1.1",
+				ProtoMajor:    1,
+				ProtoMinor:    1,
+				Header:        http.Header{"Content-Type": []string{"application/json"}},
+				Body:          ioutil.NopCloser(bytes.NewReader(payload)),
+				ContentLength: int64(len(payload)),
+				Request:       req,
+			}, nil
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package request
+
+import (
+	"context"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+)
+
+func TestVCR(t *testing.T) {
+	dir, err := ioutil.TempDir("", "vcr")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer SetReplayDirectory("")
+	defer SetRecordDirectory("")
+
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+		io.WriteString(w, `{"price":"`+req.URL.Query().Get("symbol")+`"}`)
+	}))
+	r := New("VCRTest", new(http.Client), nil)
+	get := func(symbol string) (string, error) {
+		var resp struct {
+			Price string `json:"price"`
+		}
+		err := r.SendPayload(context.Background(), &Item{
+			Method: http.MethodGet,
+			Path:   s.URL + "/ticker?symbol=" + symbol,
+			Result: &resp,
+		})
+		return resp.Price, err
+	}
+
+	if err = SetRecordDirectory(dir); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = get("BTCUSD"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = os.Stat(FixtureFile(dir, "vcrtest")); err != nil {
+		t.Fatalf("expected the response to be recorded: %v", err)
+	}
+
+	// Replayed responses are served with the exchange unreachable
+	s.Close()
+	SetReplayDirectory(dir)
+	price, err := get("BTCUSD")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if price != "BTCUSD" {
+		t.Errorf("expected the recorded response, received %q", price)
+	}
+	if _, err = get("ETHUSD"); err == nil {
+		t.Error("expected an error for a request without a recorded response")
+	}
+
+	r.Name = "missing"
+	if _, err = get("BTCUSD"); err == nil {
+		t.Error("expected an error without a fixture file")
+	}
+}
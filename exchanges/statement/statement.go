@@ -0,0 +1,346 @@
+package statement
+
+import (
+	"encoding/csv"
+	"errors"
+	"io"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+// NewBuilder returns a statement builder for an exchange account over the
+// period between start and end
+func NewBuilder(exchangeName string, start, end time.Time) (*Builder, error) {
+	if exchangeName == "" {
+		return nil, errors.New(errExchangeNameUnset)
+	}
+	if !start.Before(end) {
+		return nil, errors.New(errInvalidPeriod)
+	}
+	return &Builder{
+		exchange: exchangeName,
+		start:    start,
+		end:      end,
+		opening:  make(map[*currency.Item]decimal.Decimal),
+	}, nil
+}
+
+// SetOpeningBalance sets the balance of a currency at the start of the
+// period. Currencies without an opening balance start at zero
+func (b *Builder) SetOpeningBalance(c currency.Code, amount decimal.Decimal) {
+	b.opening[c.Item] = amount
+}
+
+// AddFundHistory adds deposits and withdrawals along with any fees charged on
+// them. Cancelled, failed and rejected transfers are skipped as they did not
+// move funds
+func (b *Builder) AddFundHistory(history []exchange.FundHistory) {
+	for i := range history {
+		if !transferCompleted(history[i].Status) {
+			continue
+		}
+		var entryType EntryType
+		switch strings.ToLower(history[i].TransferType) {
+		case "deposit":
+			entryType = Deposit
+		case "withdrawal", "withdraw":
+			entryType = Withdrawal
+		default:
+			continue
+		}
+		c := currency.NewCode(history[i].Currency)
+		amount := decimal.NewFromFloat(history[i].Amount).Abs()
+		if entryType == Withdrawal {
+			amount = amount.Neg()
+		}
+		b.add(Entry{
+			Timestamp:   history[i].Timestamp,
+			Type:        entryType,
+			Currency:    c,
+			Amount:      amount,
+			Reference:   history[i].TransferID,
+			Description: history[i].Description,
+		})
+		if history[i].Fee != 0 {
+			b.add(Entry{
+				Timestamp: history[i].Timestamp,
+				Type:      Fee,
+				Currency:  c,
+				Amount:    decimal.NewFromFloat(history[i].Fee).Abs().Neg(),
+				Reference: history[i].TransferID,
+			})
+		}
+	}
+}
+
+// AddExecutions adds trade fills, crediting and debiting both currencies of
+// the pair and charging the fee in its fee currency
+func (b *Builder) AddExecutions(executions []order.ExecutionReport) {
+	for i := range executions {
+		b.addTrade(executions[i].Timestamp,
+			executions[i].Pair,
+			executions[i].Side,
+			executions[i].Price,
+			executions[i].Amount,
+			executions[i].Fee,
+			executions[i].FeeCurrency,
+			executions[i].TradeID)
+	}
+}
+
+// AddOrders adds the executed amount of historic orders. Fees are charged in
+// the quote currency as order history does not report the fee currency
+func (b *Builder) AddOrders(orders []order.Detail) {
+	for i := range orders {
+		if orders[i].ExecutedAmount.IsZero() {
+			continue
+		}
+		b.addTrade(orders[i].OrderDate,
+			orders[i].CurrencyPair,
+			orders[i].OrderSide,
+			orders[i].Price,
+			orders[i].ExecutedAmount,
+			orders[i].Fee,
+			orders[i].CurrencyPair.Quote,
+			orders[i].ID)
+	}
+}
+
+// AddFundingPayments adds funding paid or received on perpetual futures
+// positions
+func (b *Builder) AddFundingPayments(payments []exchange.FundingPayment) {
+	for i := range payments {
+		b.add(Entry{
+			Timestamp:   payments[i].Timestamp,
+			Type:        Funding,
+			Currency:    payments[i].Currency,
+			Amount:      decimal.NewFromFloat(payments[i].Amount),
+			Description: payments[i].Pair.String(),
+		})
+	}
+}
+
+// Build sorts the entries within the period and reconciles each currency's
+// closing balance with the balances reported by the exchange. A currency is
+// flagged when its discrepancy is greater than tolerance
+func (b *Builder) Build(reported *account.Holdings, tolerance decimal.Decimal) *Statement {
+	s := &Statement{
+		Exchange: b.exchange,
+		Start:    b.start,
+		End:      b.end,
+	}
+	for i := range b.entries {
+		if b.entries[i].Timestamp.Before(b.start) ||
+			b.entries[i].Timestamp.After(b.end) {
+			continue
+		}
+		s.Entries = append(s.Entries, b.entries[i])
+	}
+	sort.SliceStable(s.Entries, func(i, j int) bool {
+		return s.Entries[i].Timestamp.Before(s.Entries[j].Timestamp)
+	})
+
+	balances := make(map[*currency.Item]*Balance)
+	var items []*currency.Item
+	get := func(c currency.Code) *Balance {
+		bal, ok := balances[c.Item]
+		if !ok {
+			bal = &Balance{
+				Currency: c.Upper(),
+				Opening:  b.opening[c.Item],
+			}
+			bal.Closing = bal.Opening
+			balances[c.Item] = bal
+			items = append(items, c.Item)
+		}
+		return bal
+	}
+	for item := range b.opening {
+		get(currency.Code{Item: item})
+	}
+
+	for i := range s.Entries {
+		bal := get(s.Entries[i].Currency)
+		switch s.Entries[i].Type {
+		case Deposit:
+			bal.Deposits = bal.Deposits.Add(s.Entries[i].Amount)
+		case Withdrawal:
+			bal.Withdrawals = bal.Withdrawals.Add(s.Entries[i].Amount)
+		case Trade:
+			bal.Trades = bal.Trades.Add(s.Entries[i].Amount)
+		case Fee:
+			bal.Fees = bal.Fees.Add(s.Entries[i].Amount)
+		case Funding:
+			bal.Funding = bal.Funding.Add(s.Entries[i].Amount)
+		}
+		bal.Closing = bal.Closing.Add(s.Entries[i].Amount)
+		s.Entries[i].Balance = bal.Closing
+	}
+
+	if reported != nil {
+		for i := range reported.Accounts {
+			for j := range reported.Accounts[i].Currencies {
+				c := reported.Accounts[i].Currencies[j]
+				if c.CurrencyName.String() == "" {
+					continue
+				}
+				bal := get(c.CurrencyName)
+				bal.Reported = bal.Reported.Add(c.TotalValue)
+			}
+		}
+	}
+
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Symbol < items[j].Symbol
+	})
+	for i := range items {
+		bal := balances[items[i]]
+		bal.Discrepancy = bal.Reported.Sub(bal.Closing)
+		bal.Reconciled = reported == nil ||
+			!bal.Discrepancy.Abs().GreaterThan(tolerance)
+		s.Balances = append(s.Balances, *bal)
+	}
+	return s
+}
+
+// Discrepancies returns the balances which did not reconcile with the
+// exchange reported balances
+func (s *Statement) Discrepancies() []Balance {
+	var resp []Balance
+	for i := range s.Balances {
+		if !s.Balances[i].Reconciled {
+			resp = append(resp, s.Balances[i])
+		}
+	}
+	return resp
+}
+
+// WriteCSV writes the statement entries followed by a blank line and the
+// reconciled balances
+func (s *Statement) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	err := cw.Write([]string{"timestamp", "type", "currency", "amount", "balance", "reference", "description"})
+	if err != nil {
+		return err
+	}
+	for i := range s.Entries {
+		err = cw.Write([]string{
+			s.Entries[i].Timestamp.UTC().Format(time.RFC3339),
+			string(s.Entries[i].Type),
+			s.Entries[i].Currency.String(),
+			s.Entries[i].Amount.String(),
+			s.Entries[i].Balance.String(),
+			s.Entries[i].Reference,
+			s.Entries[i].Description,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	if err = cw.Error(); err != nil {
+		return err
+	}
+	if _, err = io.WriteString(w, "\n"); err != nil {
+		return err
+	}
+
+	err = cw.Write([]string{"currency", "opening", "deposits", "withdrawals", "trades", "fees", "funding", "closing", "reported", "discrepancy", "reconciled"})
+	if err != nil {
+		return err
+	}
+	for i := range s.Balances {
+		reconciled := "true"
+		if !s.Balances[i].Reconciled {
+			reconciled = "false"
+		}
+		err = cw.Write([]string{
+			s.Balances[i].Currency.String(),
+			s.Balances[i].Opening.String(),
+			s.Balances[i].Deposits.String(),
+			s.Balances[i].Withdrawals.String(),
+			s.Balances[i].Trades.String(),
+			s.Balances[i].Fees.String(),
+			s.Balances[i].Funding.String(),
+			s.Balances[i].Closing.String(),
+			s.Balances[i].Reported.String(),
+			s.Balances[i].Discrepancy.String(),
+			reconciled,
+		})
+		if err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func (b *Builder) add(e Entry) {
+	if e.Currency.String() == "" || e.Amount.IsZero() {
+		return
+	}
+	e.Currency = e.Currency.Upper()
+	b.entries = append(b.entries, e)
+}
+
+// addTrade credits the bought currency and debits the sold currency of a
+// pair. Amount is in the base currency and price in the quote currency
+func (b *Builder) addTrade(ts time.Time, p currency.Pair, side order.Side, price, amount, fee decimal.Decimal, feeCurrency currency.Code, reference string) {
+	quoteAmount := price.Mul(amount)
+	var base, quote decimal.Decimal
+	switch side {
+	case order.Buy, order.Bid:
+		base, quote = amount, quoteAmount.Neg()
+	case order.Sell, order.Ask:
+		base, quote = amount.Neg(), quoteAmount
+	default:
+		return
+	}
+	description := string(side) + " " + amount.String() + " " + p.String() +
+		" @ " + price.String()
+	b.add(Entry{
+		Timestamp:   ts,
+		Type:        Trade,
+		Currency:    p.Base,
+		Amount:      base,
+		Reference:   reference,
+		Description: description,
+	})
+	b.add(Entry{
+		Timestamp:   ts,
+		Type:        Trade,
+		Currency:    p.Quote,
+		Amount:      quote,
+		Reference:   reference,
+		Description: description,
+	})
+	if feeCurrency.String() == "" {
+		feeCurrency = p.Quote
+	}
+	b.add(Entry{
+		Timestamp: ts,
+		Type:      Fee,
+		Currency:  feeCurrency,
+		// A negative fee is a rebate which credits the account
+		Amount:    fee.Neg(),
+		Reference: reference,
+	})
+}
+
+// transferCompleted reports whether a transfer status means funds moved
+func transferCompleted(status string) bool {
+	status = strings.ToLower(status)
+	for _, s := range []string{"cancel", "fail", "reject"} {
+		if strings.Contains(status, s) {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,254 @@
+package statement
+
+import (
+	"bytes"
+	"encoding/csv"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
+)
+
+var (
+	testStart = time.Date(2020, 3, 1, 0, 0, 0, 0, time.UTC)
+	testEnd   = time.Date(2020, 4, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func testBuilder(t *testing.T) *Builder {
+	b, err := NewBuilder("Bitmex", testStart, testEnd)
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.SetOpeningBalance(currency.USD, decimal.NewFromInt(1000))
+	b.AddFundHistory([]exchange.FundHistory{
+		{
+			Timestamp:    testStart.Add(time.Hour),
+			Currency:     "btc",
+			Amount:       1,
+			TransferType: "deposit",
+			TransferID:   "d1",
+		},
+		{
+			Timestamp:    testStart.Add(48 * time.Hour),
+			Currency:     "BTC",
+			Amount:       0.5,
+			Fee:          0.0005,
+			TransferType: "withdrawal",
+			TransferID:   "w1",
+		},
+		{
+			Timestamp:    testStart.Add(49 * time.Hour),
+			Currency:     "BTC",
+			Amount:       10,
+			TransferType: "withdrawal",
+			Status:       "Cancelled",
+		},
+		{
+			// Outside of the statement period
+			Timestamp:    testStart.Add(-time.Hour),
+			Currency:     "BTC",
+			Amount:       5,
+			TransferType: "deposit",
+		},
+	})
+	b.AddExecutions([]order.ExecutionReport{
+		{
+			Timestamp:   testStart.Add(2 * time.Hour),
+			Pair:        currency.NewPair(currency.BTC, currency.USD),
+			Side:        order.Sell,
+			Price:       decimal.NewFromInt(100),
+			Amount:      decimal.RequireFromString("0.25"),
+			Fee:         decimal.RequireFromString("0.5"),
+			FeeCurrency: currency.USD,
+			TradeID:     "t1",
+		},
+	})
+	b.AddOrders([]order.Detail{
+		{
+			OrderDate:      testStart.Add(3 * time.Hour),
+			ID:             "o1",
+			CurrencyPair:   currency.NewPair(currency.BTC, currency.USD),
+			OrderSide:      order.Buy,
+			Price:          decimal.NewFromInt(100),
+			ExecutedAmount: decimal.RequireFromString("0.1"),
+			Fee:            decimal.RequireFromString("0.1"),
+		},
+		{
+			OrderDate:    testStart.Add(4 * time.Hour),
+			ID:           "unfilled",
+			CurrencyPair: currency.NewPair(currency.BTC, currency.USD),
+			OrderSide:    order.Buy,
+			Price:        decimal.NewFromInt(100),
+		},
+	})
+	b.AddFundingPayments([]exchange.FundingPayment{
+		{
+			Timestamp: testStart.Add(8 * time.Hour),
+			Pair:      currency.NewPairWithDelimiter("XBT", "USD", "-"),
+			Currency:  currency.XBT,
+			Amount:    -0.001,
+		},
+	})
+	return b
+}
+
+func findBalance(t *testing.T, s *Statement, c currency.Code) Balance {
+	for i := range s.Balances {
+		if s.Balances[i].Currency.Match(c) {
+			return s.Balances[i]
+		}
+	}
+	t.Fatalf("no %s balance", c)
+	return Balance{}
+}
+
+func TestNewBuilder(t *testing.T) {
+	if _, err := NewBuilder("", testStart, testEnd); err == nil {
+		t.Error("expected error for unset exchange name")
+	}
+	if _, err := NewBuilder("Bitmex", testEnd, testStart); err == nil {
+		t.Error("expected error for invalid period")
+	}
+}
+
+func TestBuild(t *testing.T) {
+	s := testBuilder(t).Build(nil, decimal.Decimal{})
+	// deposit, withdrawal and its fee, 3 for each trade and a funding payment
+	if len(s.Entries) != 10 {
+		t.Fatalf("expected 10 entries got %v", len(s.Entries))
+	}
+	for i := 1; i < len(s.Entries); i++ {
+		if s.Entries[i].Timestamp.Before(s.Entries[i-1].Timestamp) {
+			t.Fatal("expected entries to be sorted by time")
+		}
+	}
+	if s.Entries[0].Type != Deposit || s.Entries[0].Currency.String() != "BTC" {
+		t.Errorf("unexpected first entry %+v", s.Entries[0])
+	}
+
+	btc := findBalance(t, s, currency.BTC)
+	expected := map[string]decimal.Decimal{
+		"deposits":    btc.Deposits,
+		"withdrawals": btc.Withdrawals,
+		"trades":      btc.Trades,
+		"fees":        btc.Fees,
+		"closing":     btc.Closing,
+	}
+	for name, want := range map[string]string{
+		"deposits":    "1",
+		"withdrawals": "-0.5",
+		"trades":      "-0.15",
+		"fees":        "-0.0005",
+		"closing":     "0.3495",
+	} {
+		if !expected[name].Equal(decimal.RequireFromString(want)) {
+			t.Errorf("BTC %s: expected %s got %s", name, want, expected[name])
+		}
+	}
+
+	usd := findBalance(t, s, currency.USD)
+	// 1000 + 25 - 0.5 - 10 - 0.1
+	if !usd.Closing.Equal(decimal.RequireFromString("1014.4")) {
+		t.Errorf("expected USD closing 1014.4 got %s", usd.Closing)
+	}
+	if !usd.Opening.Equal(decimal.NewFromInt(1000)) {
+		t.Errorf("expected USD opening 1000 got %s", usd.Opening)
+	}
+
+	xbt := findBalance(t, s, currency.XBT)
+	if !xbt.Funding.Equal(decimal.RequireFromString("-0.001")) {
+		t.Errorf("expected XBT funding -0.001 got %s", xbt.Funding)
+	}
+
+	if d := s.Discrepancies(); len(d) != 0 {
+		t.Errorf("expected no discrepancies without reported balances got %v", len(d))
+	}
+}
+
+func TestBuildReconcile(t *testing.T) {
+	reported := &account.Holdings{
+		Exchange: "Bitmex",
+		Accounts: []account.SubAccount{
+			{
+				Currencies: []account.Balance{
+					{CurrencyName: currency.BTC, TotalValue: decimal.RequireFromString("0.3")},
+					{CurrencyName: currency.USD, TotalValue: decimal.RequireFromString("1014.4")},
+				},
+			},
+			{
+				Currencies: []account.Balance{
+					{CurrencyName: currency.BTC, TotalValue: decimal.RequireFromString("0.0495")},
+					{CurrencyName: currency.XBT, TotalValue: decimal.RequireFromString("-0.002")},
+					{CurrencyName: currency.ETH, TotalValue: decimal.NewFromInt(1)},
+				},
+			},
+		},
+	}
+	s := testBuilder(t).Build(reported, decimal.RequireFromString("0.0001"))
+
+	if btc := findBalance(t, s, currency.BTC); !btc.Reconciled ||
+		!btc.Reported.Equal(decimal.RequireFromString("0.3495")) {
+		t.Errorf("expected BTC reconciled across sub accounts got %+v", btc)
+	}
+	if usd := findBalance(t, s, currency.USD); !usd.Reconciled {
+		t.Errorf("expected USD reconciled got %+v", usd)
+	}
+
+	d := s.Discrepancies()
+	if len(d) != 2 {
+		t.Fatalf("expected 2 discrepancies got %v", len(d))
+	}
+	if !d[0].Currency.Match(currency.ETH) || !d[0].Discrepancy.Equal(decimal.NewFromInt(1)) {
+		t.Errorf("unexpected discrepancy %+v", d[0])
+	}
+	if !d[1].Currency.Match(currency.XBT) || !d[1].Discrepancy.Equal(decimal.RequireFromString("-0.001")) {
+		t.Errorf("unexpected discrepancy %+v", d[1])
+	}
+}
+
+func TestWriteCSV(t *testing.T) {
+	s := testBuilder(t).Build(nil, decimal.Decimal{})
+	var buf bytes.Buffer
+	if err := s.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	sections := strings.Split(buf.String(), "\n\n")
+	if len(sections) != 2 {
+		t.Fatalf("expected entries and balances sections got %v", len(sections))
+	}
+	entries, err := csv.NewReader(strings.NewReader(sections[0])).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != len(s.Entries)+1 || entries[0][0] != "timestamp" {
+		t.Errorf("unexpected entries %v", entries)
+	}
+	balances, err := csv.NewReader(strings.NewReader(sections[1])).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(balances) != len(s.Balances)+1 || balances[0][0] != "currency" {
+		t.Errorf("unexpected balances %v", balances)
+	}
+}
+
+func TestStatementJSON(t *testing.T) {
+	s := testBuilder(t).Build(nil, decimal.Decimal{})
+	data, err := json.Marshal(s)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var resp Statement
+	if err = json.Unmarshal(data, &resp); err != nil {
+		t.Fatal(err)
+	}
+	if len(resp.Entries) != len(s.Entries) || len(resp.Balances) != len(s.Balances) {
+		t.Error("unexpected statement after JSON round trip")
+	}
+}
@@ -0,0 +1,73 @@
+package statement
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// EntryType defines the kind of balance movement on a statement
+type EntryType string
+
+// Statement entry types
+const (
+	Deposit    EntryType = "DEPOSIT"
+	Withdrawal EntryType = "WITHDRAWAL"
+	Trade      EntryType = "TRADE"
+	Fee        EntryType = "FEE"
+	Funding    EntryType = "FUNDING"
+)
+
+// Const vars for the statement package
+const (
+	errExchangeNameUnset = "exchange name unset"
+	errInvalidPeriod     = "statement start must be before end"
+)
+
+// Entry is a single balance movement of one currency. A positive amount
+// credited the account and a negative amount debited it
+type Entry struct {
+	Timestamp   time.Time       `json:"timestamp"`
+	Type        EntryType       `json:"type"`
+	Currency    currency.Code   `json:"currency"`
+	Amount      decimal.Decimal `json:"amount"`
+	Balance     decimal.Decimal `json:"balance"`
+	Reference   string          `json:"reference,omitempty"`
+	Description string          `json:"description,omitempty"`
+}
+
+// Balance reconciles a currency's movements over the statement period with
+// the balance reported by the exchange
+type Balance struct {
+	Currency    currency.Code   `json:"currency"`
+	Opening     decimal.Decimal `json:"opening"`
+	Deposits    decimal.Decimal `json:"deposits"`
+	Withdrawals decimal.Decimal `json:"withdrawals"`
+	Trades      decimal.Decimal `json:"trades"`
+	Fees        decimal.Decimal `json:"fees"`
+	Funding     decimal.Decimal `json:"funding"`
+	Closing     decimal.Decimal `json:"closing"`
+	Reported    decimal.Decimal `json:"reported"`
+	Discrepancy decimal.Decimal `json:"discrepancy"`
+	Reconciled  bool            `json:"reconciled"`
+}
+
+// Statement is a reconciled statement of an exchange account over a period
+type Statement struct {
+	Exchange string    `json:"exchange"`
+	Start    time.Time `json:"start"`
+	End      time.Time `json:"end"`
+	Entries  []Entry   `json:"entries"`
+	Balances []Balance `json:"balances"`
+}
+
+// Builder collects an exchange account's balance movements and builds them
+// into a statement
+type Builder struct {
+	exchange string
+	start    time.Time
+	end      time.Time
+	opening  map[*currency.Item]decimal.Decimal
+	entries  []Entry
+}
@@ -0,0 +1,242 @@
+package wshandler
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var (
+	captureMutex sync.Mutex
+	captureDir   string
+	replayDir    string
+	recorders    = make(map[string]*Recorder)
+	replayers    = make(map[string]*Replayer)
+
+	errCaptureExhausted = errors.New("websocket capture exhausted")
+)
+
+// SetCaptureDirectory records the frames read by every websocket connection
+// to a capture file per exchange in dir, for replaying with
+// SetReplayDirectory. An empty dir stops new connections being captured.
+func SetCaptureDirectory(dir string) error {
+	if dir != "" {
+		err := os.MkdirAll(dir, 0770)
+		if err != nil {
+			return err
+		}
+	}
+	captureMutex.Lock()
+	captureDir = dir
+	captureMutex.Unlock()
+	return nil
+}
+
+// SetReplayDirectory makes websocket connections read the frames captured in
+// dir rather than dialling the exchange, with messages sent to the exchange
+// being discarded. An empty dir stops new connections being replayed.
+func SetReplayDirectory(dir string) {
+	captureMutex.Lock()
+	replayDir = dir
+	captureMutex.Unlock()
+}
+
+// CloseCaptures closes the capture files being recorded to
+func CloseCaptures() error {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+	var errs []string
+	for name, r := range recorders {
+		if err := r.Close(); err != nil {
+			errs = append(errs, err.Error())
+		}
+		delete(recorders, name)
+	}
+	if len(errs) > 0 {
+		return fmt.Errorf("unable to close websocket captures: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// CaptureFile returns the path of an exchanges capture file in dir
+func CaptureFile(dir, exchangeName string) string {
+	return filepath.Join(dir, strings.ToLower(exchangeName)+captureFileExtension)
+}
+
+// recorderFor returns the recorder capturing an exchanges frames, nil when
+// capturing is disabled
+func recorderFor(exchangeName string) (*Recorder, error) {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+	if captureDir == "" {
+		return nil, nil
+	}
+	if r, ok := recorders[exchangeName]; ok {
+		return r, nil
+	}
+	r, err := NewRecorder(CaptureFile(captureDir, exchangeName))
+	if err != nil {
+		return nil, err
+	}
+	recorders[exchangeName] = r
+	return r, nil
+}
+
+// replayerFor returns the replayer supplying an exchanges captured frames, nil
+// when replaying is disabled
+func replayerFor(exchangeName string) (*Replayer, error) {
+	captureMutex.Lock()
+	defer captureMutex.Unlock()
+	if replayDir == "" {
+		return nil, nil
+	}
+	if r, ok := replayers[exchangeName]; ok {
+		return r, nil
+	}
+	frames, err := LoadCapture(CaptureFile(replayDir, exchangeName))
+	if err != nil {
+		return nil, err
+	}
+	r := NewReplayer(frames)
+	replayers[exchangeName] = r
+	return r, nil
+}
+
+// NewRecorder returns a recorder appending frames to the capture file at path
+func NewRecorder(path string) (*Recorder, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0640)
+	if err != nil {
+		return nil, err
+	}
+	return &Recorder{file: f, enc: json.NewEncoder(f)}, nil
+}
+
+// Record appends a frame to the capture file
+func (r *Recorder) Record(f *Frame) error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.file == nil {
+		return errors.New("websocket capture closed")
+	}
+	return r.enc.Encode(f)
+}
+
+// Close closes the capture file
+func (r *Recorder) Close() error {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.file == nil {
+		return nil
+	}
+	err := r.file.Close()
+	r.file = nil
+	return err
+}
+
+// LoadCapture reads the frames of the capture file at path
+func LoadCapture(path string) ([]Frame, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+	var frames []Frame
+	dec := json.NewDecoder(f)
+	for {
+		var frame Frame
+		err = dec.Decode(&frame)
+		if err == io.EOF {
+			return frames, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("%s frame %d: %v", path, len(frames)+1, err)
+		}
+		frames = append(frames, frame)
+	}
+}
+
+// NewReplayer returns a replayer feeding back frames in order
+func NewReplayer(frames []Frame) *Replayer {
+	return &Replayer{frames: frames}
+}
+
+// Next returns the next frame, false once every frame has been replayed
+func (r *Replayer) Next() (Frame, bool) {
+	r.m.Lock()
+	defer r.m.Unlock()
+	if r.next >= len(r.frames) {
+		return Frame{}, false
+	}
+	r.next++
+	return r.frames[r.next-1], true
+}
+
+// Remaining returns how many frames are left to replay
+func (r *Replayer) Remaining() int {
+	r.m.Lock()
+	defer r.m.Unlock()
+	return len(r.frames) - r.next
+}
+
+// Replay makes the connection read frames from r rather than the exchange.
+// Dialling then connects without a network connection and sent messages are
+// discarded, so an exchanges own websocket handlers process the frames.
+func (w *WebsocketConnection) Replay(r *Replayer) {
+	w.replay = r
+}
+
+// dialReplay connects the connection to its replayer when replaying
+func (w *WebsocketConnection) dialReplay() (bool, error) {
+	if w.replay == nil {
+		r, err := replayerFor(w.ExchangeName)
+		if err != nil {
+			return true, err
+		}
+		if r == nil {
+			return false, nil
+		}
+		w.replay = r
+	}
+	if w.replay.Remaining() == 0 {
+		return true, fmt.Errorf("%v %v", w.ExchangeName, errCaptureExhausted)
+	}
+	if w.Verbose {
+		log.Infof(log.WebsocketMgr, "%v Websocket replaying %d captured frames",
+			w.ExchangeName, w.replay.Remaining())
+	}
+	w.setConnectedStatus(true)
+	return true, nil
+}
+
+// readFrame reads the next frame from the replayer or the network
+// connection, recording it when capturing
+func (w *WebsocketConnection) readFrame() (int, []byte, error) {
+	if w.replay != nil {
+		f, ok := w.replay.Next()
+		if !ok {
+			return 0, nil, &websocket.CloseError{
+				Code: websocket.CloseNormalClosure,
+				Text: errCaptureExhausted.Error(),
+			}
+		}
+		return f.Type, f.Data, nil
+	}
+	mType, resp, err := w.Connection.ReadMessage()
+	if err != nil || w.capture == nil {
+		return mType, resp, err
+	}
+	err = w.capture.Record(&Frame{Time: time.Now(), Type: mType, Data: resp})
+	if err != nil {
+		log.Errorf(log.WebsocketMgr, "%v unable to capture websocket frame: %v", w.ExchangeName, err)
+	}
+	return mType, resp, nil
+}
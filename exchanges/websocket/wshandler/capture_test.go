@@ -0,0 +1,179 @@
+package wshandler
+
+import (
+	"bytes"
+	"compress/gzip"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
+)
+
+// captureServer returns a websocket server which sends a text frame and a
+// gzipped binary frame
+func captureServer(t *testing.T) *httptest.Server {
+	var b bytes.Buffer
+	gz := gzip.NewWriter(&b)
+	if _, err := gz.Write([]byte("world")); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		if err = conn.WriteMessage(websocket.TextMessage, []byte("hello")); err != nil {
+			t.Error(err)
+			return
+		}
+		if err = conn.WriteMessage(websocket.BinaryMessage, b.Bytes()); err != nil {
+			t.Error(err)
+			return
+		}
+		// Wait for the client to close
+		_, _, _ = conn.ReadMessage()
+	}))
+}
+
+func TestCaptureReplay(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wscapture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	s := captureServer(t)
+	defer s.Close()
+
+	if err = SetCaptureDirectory(dir); err != nil {
+		t.Fatal(err)
+	}
+	c := &WebsocketConnection{
+		ExchangeName: "Test",
+		URL:          "ws" + strings.TrimPrefix(s.URL, "http"),
+	}
+	err = c.Dial(&websocket.Dialer{}, http.Header{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := 0; i < 2; i++ {
+		if _, err = c.ReadMessage(); err != nil {
+			t.Fatal(err)
+		}
+	}
+	c.Close()
+	if err = SetCaptureDirectory(""); err != nil {
+		t.Fatal(err)
+	}
+	if err = CloseCaptures(); err != nil {
+		t.Fatal(err)
+	}
+
+	frames, err := LoadCapture(filepath.Join(dir, "test.jsonl"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(frames) != 2 {
+		t.Fatalf("expected 2 captured frames, got %d", len(frames))
+	}
+	if frames[1].Type != websocket.BinaryMessage || bytes.Equal(frames[1].Data, []byte("world")) {
+		t.Error("expected the binary frame to be captured before it is decompressed")
+	}
+
+	SetReplayDirectory(dir)
+	defer func() {
+		SetReplayDirectory("")
+		captureMutex.Lock()
+		replayers = make(map[string]*Replayer)
+		captureMutex.Unlock()
+	}()
+	r := &WebsocketConnection{ExchangeName: "Test", URL: "ws://unreachable.invalid"}
+	err = r.Dial(&websocket.Dialer{}, http.Header{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = r.SendJSONMessage("subscribe"); err != nil {
+		t.Errorf("expected sent messages to be discarded, got %v", err)
+	}
+	for _, expected := range []string{"hello", "world"} {
+		resp, err := r.ReadMessage()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(resp.Raw) != expected {
+			t.Errorf("expected %s, got %s", expected, resp.Raw)
+		}
+	}
+	if _, err = r.ReadMessage(); !isDisconnectionError(err) {
+		t.Errorf("expected a disconnection once the capture is exhausted, got %v", err)
+	}
+	if err = r.Dial(&websocket.Dialer{}, http.Header{}); err == nil {
+		t.Error("expected dialling an exhausted capture to fail")
+	}
+}
+
+func TestReplay(t *testing.T) {
+	c := &WebsocketConnection{ExchangeName: "test"}
+	c.Replay(NewReplayer([]Frame{{Type: websocket.TextMessage, Data: []byte("hi")}}))
+	c.SetupPingHandler(WebsocketPingHandler{UseGorillaHandler: true})
+	if err := c.Dial(&websocket.Dialer{}, http.Header{}); err != nil {
+		t.Fatal(err)
+	}
+	if !c.IsConnected() {
+		t.Error("expected a replayed connection to be connected")
+	}
+	resp, err := c.ReadMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp.Raw) != "hi" {
+		t.Errorf("expected hi, got %s", resp.Raw)
+	}
+}
+
+func TestReplayChaosDrops(t *testing.T) {
+	err := chaos.Set(chaos.Config{WebsocketDropRate: 1, Exchanges: []string{"chaostest"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer chaos.Set(chaos.Config{}) // nolint:errcheck
+
+	c := &WebsocketConnection{ExchangeName: "chaostest"}
+	c.Replay(NewReplayer([]Frame{{Type: websocket.TextMessage, Data: []byte("hi")}}))
+	if err = c.Dial(&websocket.Dialer{}, http.Header{}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err = c.ReadMessage(); !isDisconnectionError(err) {
+		t.Errorf("expected every message to be dropped until the capture is exhausted, got %v", err)
+	}
+}
+
+func TestLoadCapture(t *testing.T) {
+	dir, err := ioutil.TempDir("", "wscapture")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if _, err = LoadCapture(filepath.Join(dir, "missing.jsonl")); err == nil {
+		t.Error("expected an error loading a missing capture")
+	}
+	path := filepath.Join(dir, "bad.jsonl")
+	err = ioutil.WriteFile(path, []byte(`{"type":1,"data":"aGk="}`+"\n{bad"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = LoadCapture(path); err == nil {
+		t.Error("expected an error loading a malformed capture")
+	}
+}
@@ -0,0 +1,170 @@
+package wshandler
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+var errNoConnectionDialer = errors.New("no websocket connection dialer set")
+
+// NewConnectionManager returns a connection manager which multiplexes
+// subscriptions over connections dialled by dial, with at most
+// maxSubscriptions subscriptions per connection. A maxSubscriptions of 0
+// multiplexes every subscription over a single connection.
+func NewConnectionManager(maxSubscriptions int, dial func() (*WebsocketConnection, error)) *ConnectionManager {
+	return &ConnectionManager{
+		maxSubscriptions: maxSubscriptions,
+		dial:             dial,
+	}
+}
+
+// Connect dials the first connection if there is none, so a failure to
+// connect is reported when the websocket connects rather than on its first
+// subscription
+func (c *ConnectionManager) Connect() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if len(c.connections) > 0 {
+		return nil
+	}
+	_, err := c.dialConnection()
+	return err
+}
+
+// Assign returns the connection a subscription is made on, assigning a new
+// subscription to the connection with the fewest subscriptions below the cap
+// so subscriptions are balanced across connections. A connection is only
+// dialled once every connection has reached the cap.
+func (c *ConnectionManager) Assign(sub WebsocketChannelSubscription) (*WebsocketConnection, error) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if m := c.lookup(&sub); m != nil {
+		return m.conn, nil
+	}
+
+	var target *managedConnection
+	for _, m := range c.connections {
+		if c.maxSubscriptions > 0 && len(m.subscriptions) >= c.maxSubscriptions {
+			continue
+		}
+		if target == nil || len(m.subscriptions) < len(target.subscriptions) {
+			target = m
+		}
+	}
+	if target == nil {
+		var err error
+		target, err = c.dialConnection()
+		if err != nil {
+			return nil, err
+		}
+	}
+	target.subscriptions = append(target.subscriptions, sub)
+	return target.conn, nil
+}
+
+// Lookup returns the connection a subscription was assigned to, nil if it
+// isn't assigned
+func (c *ConnectionManager) Lookup(sub WebsocketChannelSubscription) *WebsocketConnection {
+	c.m.Lock()
+	defer c.m.Unlock()
+	if m := c.lookup(&sub); m != nil {
+		return m.conn
+	}
+	return nil
+}
+
+// Release removes a subscription from its connection, closing the connection
+// if it's left without subscriptions and isn't the only connection
+func (c *ConnectionManager) Release(sub WebsocketChannelSubscription) {
+	c.m.Lock()
+	defer c.m.Unlock()
+	for i, m := range c.connections {
+		for j := range m.subscriptions {
+			if !m.subscriptions[j].Equal(&sub) {
+				continue
+			}
+			m.subscriptions = append(m.subscriptions[:j], m.subscriptions[j+1:]...)
+			if len(m.subscriptions) == 0 && len(c.connections) > 1 {
+				c.connections = append(c.connections[:i], c.connections[i+1:]...)
+				err := m.conn.Close()
+				if err != nil {
+					log.Errorf(log.WebsocketMgr, "%v unable to close idle websocket connection: %v",
+						m.conn.ExchangeName, err)
+				}
+			}
+			return
+		}
+	}
+}
+
+// Connections returns the connections subscriptions are multiplexed over
+func (c *ConnectionManager) Connections() []*WebsocketConnection {
+	c.m.Lock()
+	defer c.m.Unlock()
+	conns := make([]*WebsocketConnection, len(c.connections))
+	for i := range c.connections {
+		conns[i] = c.connections[i].conn
+	}
+	return conns
+}
+
+// SubscriptionCounts returns the amount of subscriptions on each connection,
+// in the order returned by Connections
+func (c *ConnectionManager) SubscriptionCounts() []int {
+	c.m.Lock()
+	defer c.m.Unlock()
+	counts := make([]int, len(c.connections))
+	for i := range c.connections {
+		counts[i] = len(c.connections[i].subscriptions)
+	}
+	return counts
+}
+
+// Close closes every connection and forgets their subscriptions, so the
+// websocket starts afresh when it reconnects
+func (c *ConnectionManager) Close() error {
+	c.m.Lock()
+	defer c.m.Unlock()
+	var errs []string
+	for i := range c.connections {
+		err := c.connections[i].conn.Close()
+		if err != nil {
+			errs = append(errs, err.Error())
+		}
+	}
+	c.connections = nil
+	if len(errs) > 0 {
+		return fmt.Errorf("unable to close websocket connections: %s", strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// dialConnection dials and adds a connection, the manager must be locked
+func (c *ConnectionManager) dialConnection() (*managedConnection, error) {
+	if c.dial == nil {
+		return nil, errNoConnectionDialer
+	}
+	conn, err := c.dial()
+	if err != nil {
+		return nil, err
+	}
+	m := &managedConnection{conn: conn}
+	c.connections = append(c.connections, m)
+	return m, nil
+}
+
+// lookup returns the connection a subscription is assigned to, the manager
+// must be locked
+func (c *ConnectionManager) lookup(sub *WebsocketChannelSubscription) *managedConnection {
+	for _, m := range c.connections {
+		for j := range m.subscriptions {
+			if m.subscriptions[j].Equal(sub) {
+				return m
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,208 @@
+package wshandler
+
+import (
+	"errors"
+	"strconv"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+func testDialer(dialled *int) func() (*WebsocketConnection, error) {
+	return func() (*WebsocketConnection, error) {
+		*dialled++
+		return &WebsocketConnection{ExchangeName: "test", URL: strconv.Itoa(*dialled)}, nil
+	}
+}
+
+func testSubscription(i int) WebsocketChannelSubscription {
+	return WebsocketChannelSubscription{
+		Channel:  "ticker",
+		Currency: currency.NewPairFromStrings("BTC", strconv.Itoa(i)),
+	}
+}
+
+func checkCounts(t *testing.T, c *ConnectionManager, expected ...int) {
+	t.Helper()
+	counts := c.SubscriptionCounts()
+	if len(counts) != len(expected) {
+		t.Fatalf("expected subscription counts %v, got %v", expected, counts)
+	}
+	for i := range expected {
+		if counts[i] != expected[i] {
+			t.Fatalf("expected subscription counts %v, got %v", expected, counts)
+		}
+	}
+}
+
+func TestConnectionManagerAssign(t *testing.T) {
+	t.Parallel()
+	var dialled int
+	c := NewConnectionManager(2, testDialer(&dialled))
+	if err := c.Connect(); err != nil {
+		t.Fatal(err)
+	}
+	if err := c.Connect(); err != nil || dialled != 1 {
+		t.Fatalf("expected a single connection, got %d %v", dialled, err)
+	}
+
+	for i := 0; i < 5; i++ {
+		if _, err := c.Assign(testSubscription(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkCounts(t, c, 2, 2, 1)
+
+	conn, err := c.Assign(testSubscription(0))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if conn != c.Lookup(testSubscription(0)) || conn != c.Connections()[0] {
+		t.Error("expected an assigned subscription to keep its connection")
+	}
+	checkCounts(t, c, 2, 2, 1)
+
+	c.Release(testSubscription(0))
+	c.Release(testSubscription(2))
+	checkCounts(t, c, 1, 1, 1)
+	// New subscriptions are balanced across connections below the cap
+	for i := 5; i < 8; i++ {
+		if _, err = c.Assign(testSubscription(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkCounts(t, c, 2, 2, 2)
+	if dialled != 3 {
+		t.Errorf("expected 3 connections to be dialled, got %d", dialled)
+	}
+}
+
+func TestConnectionManagerRelease(t *testing.T) {
+	t.Parallel()
+	var dialled int
+	c := NewConnectionManager(1, testDialer(&dialled))
+	for i := 0; i < 2; i++ {
+		if _, err := c.Assign(testSubscription(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	idle := c.Lookup(testSubscription(1))
+	c.Release(testSubscription(1))
+	checkCounts(t, c, 1)
+	if !idle.IsClosed() {
+		t.Error("expected idle connection to be closed")
+	}
+	if c.Lookup(testSubscription(1)) != nil {
+		t.Error("expected released subscription to be unassigned")
+	}
+
+	c.Release(testSubscription(0))
+	checkCounts(t, c, 0)
+	c.Release(testSubscription(0))
+
+	last := c.Connections()[0]
+	if err := c.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if !last.IsClosed() || len(c.Connections()) != 0 {
+		t.Error("expected every connection to be closed and forgotten")
+	}
+}
+
+func TestConnectionManagerUnlimited(t *testing.T) {
+	t.Parallel()
+	var dialled int
+	c := NewConnectionManager(0, testDialer(&dialled))
+	for i := 0; i < 10; i++ {
+		if _, err := c.Assign(testSubscription(i)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	checkCounts(t, c, 10)
+}
+
+func TestConnectionManagerDialError(t *testing.T) {
+	t.Parallel()
+	errDial := errors.New("dial failed")
+	c := NewConnectionManager(1, func() (*WebsocketConnection, error) {
+		return nil, errDial
+	})
+	if err := c.Connect(); err != errDial {
+		t.Errorf("expected %v, got %v", errDial, err)
+	}
+	if _, err := c.Assign(testSubscription(0)); err != errDial {
+		t.Errorf("expected %v, got %v", errDial, err)
+	}
+	if c = NewConnectionManager(1, nil); c.Connect() != errNoConnectionDialer {
+		t.Error("expected error without a dialer")
+	}
+}
+
+func TestWebsocketMultiplexedSubscriptions(t *testing.T) {
+	t.Parallel()
+	var dialled int
+	subscribed := make(map[string]*WebsocketConnection)
+	var dedicated []WebsocketChannelSubscription
+	w := New()
+	err := w.Setup(&WebsocketSetup{
+		ExchangeName: "test",
+		Features:     &protocol.Features{},
+		Subscriber: func(sub WebsocketChannelSubscription) error {
+			dedicated = append(dedicated, sub)
+			return nil
+		},
+		UnSubscriber:     func(WebsocketChannelSubscription) error { return nil },
+		ConnectionDialer: testDialer(&dialled),
+		ConnectionSubscriber: func(conn *WebsocketConnection, sub WebsocketChannelSubscription) error {
+			subscribed[sub.Currency.String()] = conn
+			return nil
+		},
+		ConnectionUnSubscriber: func(conn *WebsocketConnection, sub WebsocketChannelSubscription) error {
+			if subscribed[sub.Currency.String()] != conn {
+				t.Errorf("expected %v to be unsubscribed on the connection it was subscribed on", sub.Currency)
+			}
+			delete(subscribed, sub.Currency.String())
+			return nil
+		},
+		MaxSubscriptionsPerConnection: 2,
+		Dedicated: func(sub WebsocketChannelSubscription) bool {
+			return sub.Channel == "orders"
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var subs []WebsocketChannelSubscription
+	for i := 0; i < 3; i++ {
+		subs = append(subs, testSubscription(i))
+	}
+	subs = append(subs, WebsocketChannelSubscription{Channel: "orders"})
+	w.SubscribeToChannels(subs)
+	if err = w.appendSubscribedChannels(); err != nil {
+		t.Fatal(err)
+	}
+	if len(subscribed) != 3 || len(dedicated) != 1 {
+		t.Fatalf("expected 3 multiplexed and 1 dedicated subscription, got %d %d", len(subscribed), len(dedicated))
+	}
+	checkCounts(t, w.Connections(), 2, 1)
+
+	w.RemoveSubscribedChannels(subs[:1])
+	if err = w.unsubscribeToChannels(); err != nil {
+		t.Fatal(err)
+	}
+	if len(subscribed) != 2 {
+		t.Errorf("expected 2 subscriptions, got %d", len(subscribed))
+	}
+	checkCounts(t, w.Connections(), 1, 1)
+
+	err = w.Setup(&WebsocketSetup{
+		ExchangeName:     "test",
+		Features:         &protocol.Features{},
+		ConnectionDialer: testDialer(&dialled),
+	})
+	if err == nil {
+		t.Error("expected error setting a dialer without connection subscribers")
+	}
+}
@@ -0,0 +1,165 @@
+package wshandler
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+// Websocket error kinds
+const (
+	// ErrorUnknown is an error which hasn't been classified
+	ErrorUnknown ErrorKind = iota
+	// ErrorAuthFailed is a rejected websocket login or authenticated request
+	ErrorAuthFailed
+	// ErrorSubscriptionRejected is a subscription the exchange refused
+	ErrorSubscriptionRejected
+	// ErrorRateLimited is a request the exchange refused for exceeding its
+	// rate limit
+	ErrorRateLimited
+	// ErrorMalformedMessage is a message which couldn't be parsed
+	ErrorMalformedMessage
+)
+
+// Recovery actions taken by Websocket.HandleError
+const (
+	// RecoveryNone only reports the error
+	RecoveryNone RecoveryAction = iota
+	// RecoveryDisableAuth stops using authenticated websocket endpoints,
+	// falling back to REST
+	RecoveryDisableAuth
+	// RecoveryDropSubscription stops retrying the rejected subscription
+	RecoveryDropSubscription
+	// RecoveryBackoff pauses subscriptions for a while
+	RecoveryBackoff
+	// RecoverySkipMessage discards the message, keeping the connection
+	RecoverySkipMessage
+)
+
+var errorKindNames = map[ErrorKind]string{
+	ErrorUnknown:              "unknown",
+	ErrorAuthFailed:           "auth_failed",
+	ErrorSubscriptionRejected: "subscription_rejected",
+	ErrorRateLimited:          "rate_limited",
+	ErrorMalformedMessage:     "malformed_message",
+}
+
+var recoveryActionNames = map[RecoveryAction]string{
+	RecoveryNone:             "none",
+	RecoveryDisableAuth:      "disable_auth",
+	RecoveryDropSubscription: "drop_subscription",
+	RecoveryBackoff:          "backoff",
+	RecoverySkipMessage:      "skip_message",
+}
+
+// String returns the name of the error kind
+func (k ErrorKind) String() string {
+	if name, ok := errorKindNames[k]; ok {
+		return name
+	}
+	return errorKindNames[ErrorUnknown]
+}
+
+// String returns the name of the recovery action
+func (r RecoveryAction) String() string {
+	if name, ok := recoveryActionNames[r]; ok {
+		return name
+	}
+	return recoveryActionNames[RecoveryNone]
+}
+
+// NewError returns a websocket error of kind for an exchange
+func NewError(exchange string, kind ErrorKind, err error) *Error {
+	return &Error{Exchange: exchange, Kind: kind, Err: err}
+}
+
+// NewSubscriptionError returns a websocket error for a subscription the
+// exchange refused, which stops it being retried
+func NewSubscriptionError(exchange string, sub *WebsocketChannelSubscription, err error) *Error {
+	return &Error{
+		Exchange:     exchange,
+		Kind:         ErrorSubscriptionRejected,
+		Subscription: sub,
+		Err:          err,
+	}
+}
+
+// Error implements the error interface
+func (e *Error) Error() string {
+	return fmt.Sprintf("%s websocket %s: %v", e.Exchange, e.Kind, e.Err)
+}
+
+// Action returns the standard recovery action for the kind of error
+func (e *Error) Action() RecoveryAction {
+	switch e.Kind {
+	case ErrorAuthFailed:
+		return RecoveryDisableAuth
+	case ErrorSubscriptionRejected:
+		if e.Subscription == nil {
+			return RecoveryNone
+		}
+		return RecoveryDropSubscription
+	case ErrorRateLimited:
+		return RecoveryBackoff
+	case ErrorMalformedMessage:
+		return RecoverySkipMessage
+	}
+	return RecoveryNone
+}
+
+// ErrorKindFromStatus classifies an error reported with a HTTP style status
+// code, as used by several exchanges in their websocket errors
+func ErrorKindFromStatus(status int) ErrorKind {
+	switch status {
+	case http.StatusUnauthorized, http.StatusForbidden:
+		return ErrorAuthFailed
+	case http.StatusTooManyRequests:
+		return ErrorRateLimited
+	}
+	return ErrorUnknown
+}
+
+// ErrorKindOf returns the kind of a websocket error, errors which aren't an
+// *Error are unknown
+func ErrorKindOf(err error) ErrorKind {
+	if e, ok := err.(*Error); ok {
+		return e.Kind
+	}
+	return ErrorUnknown
+}
+
+// HandleError applies the standard recovery action for a websocket error
+// sent to DataHandler, returning the action taken
+func (w *Websocket) HandleError(err error) RecoveryAction {
+	metrics.WebsocketErrors.Inc(w.exchangeName, ErrorKindOf(err).String())
+	e, ok := err.(*Error)
+	if !ok {
+		return RecoveryNone
+	}
+	action := e.Action()
+	switch action {
+	case RecoveryDisableAuth:
+		w.SetCanUseAuthenticatedEndpoints(false)
+	case RecoveryDropSubscription:
+		w.dropSubscription(e.Subscription)
+	case RecoveryBackoff:
+		w.subscriptionMutex.Lock()
+		w.subscribeBackoff = time.Now().Add(rateLimitBackoff)
+		w.subscriptionMutex.Unlock()
+	}
+	return action
+}
+
+// dropSubscription stops a rejected subscription being retried
+func (w *Websocket) dropSubscription(sub *WebsocketChannelSubscription) {
+	w.subscriptionMutex.Lock()
+	defer w.subscriptionMutex.Unlock()
+	if i := subscriptionIndex(w.channelsToSubscribe, sub); i >= 0 {
+		w.channelsToSubscribe = append(w.channelsToSubscribe[:i], w.channelsToSubscribe[i+1:]...)
+	}
+	if i := subscriptionIndex(w.subscribedChannels, sub); i >= 0 {
+		w.subscribedChannels = append(w.subscribedChannels[:i], w.subscribedChannels[i+1:]...)
+	}
+}
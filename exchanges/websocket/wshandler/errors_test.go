@@ -0,0 +1,100 @@
+package wshandler
+
+import (
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
+)
+
+func TestErrorAction(t *testing.T) {
+	sub := &WebsocketChannelSubscription{Channel: "trades"}
+	tests := []struct {
+		err    *Error
+		action RecoveryAction
+	}{
+		{NewError("test", ErrorUnknown, errors.New("unknown")), RecoveryNone},
+		{NewError("test", ErrorAuthFailed, errors.New("auth")), RecoveryDisableAuth},
+		{NewError("test", ErrorSubscriptionRejected, errors.New("sub")), RecoveryNone},
+		{NewSubscriptionError("test", sub, errors.New("sub")), RecoveryDropSubscription},
+		{NewError("test", ErrorRateLimited, errors.New("limit")), RecoveryBackoff},
+		{NewError("test", ErrorMalformedMessage, errors.New("json")), RecoverySkipMessage},
+	}
+	for _, tt := range tests {
+		if a := tt.err.Action(); a != tt.action {
+			t.Errorf("%v expected action %s, got %s", tt.err, tt.action, a)
+		}
+	}
+	err := NewError("test", ErrorRateLimited, errors.New("slow down"))
+	if err.Error() != "test websocket rate_limited: slow down" {
+		t.Errorf("unexpected error message %q", err.Error())
+	}
+	if ErrorKindOf(err) != ErrorRateLimited || ErrorKindOf(errors.New("plain")) != ErrorUnknown {
+		t.Error("unexpected error kind")
+	}
+	if ErrorKind(99).String() != "unknown" || RecoveryAction(99).String() != "none" {
+		t.Error("expected unrecognised values to be unknown")
+	}
+}
+
+func TestErrorKindFromStatus(t *testing.T) {
+	if ErrorKindFromStatus(http.StatusUnauthorized) != ErrorAuthFailed {
+		t.Error("expected 401 to be an auth failure")
+	}
+	if ErrorKindFromStatus(http.StatusTooManyRequests) != ErrorRateLimited {
+		t.Error("expected 429 to be rate limited")
+	}
+	if ErrorKindFromStatus(http.StatusBadRequest) != ErrorUnknown {
+		t.Error("expected 400 to be unknown")
+	}
+}
+
+func TestHandleError(t *testing.T) {
+	w := Websocket{
+		exchangeName: "test",
+		features:     &protocol.Features{Subscribe: true},
+	}
+	w.SetCanUseAuthenticatedEndpoints(true)
+	w.SetChannelSubscriber(func(WebsocketChannelSubscription) error { return nil })
+	w.setConnectedStatus(true)
+
+	if a := w.HandleError(errors.New("plain")); a != RecoveryNone {
+		t.Errorf("expected no recovery for an unclassified error, got %s", a)
+	}
+	w.HandleError(NewError("test", ErrorAuthFailed, errors.New("bad key")))
+	if w.CanUseAuthenticatedEndpoints() {
+		t.Error("expected authenticated endpoints to be disabled")
+	}
+
+	channels := []WebsocketChannelSubscription{{Channel: "trades"}, {Channel: "book"}}
+	if err := w.AddSubscriptions(channels); err != nil {
+		t.Fatal(err)
+	}
+	w.HandleError(NewSubscriptionError("test", &channels[0], errors.New("rejected")))
+	if len(w.GetSubscriptions()) != 1 || len(w.channelsToSubscribe) != 1 {
+		t.Errorf("expected the rejected subscription to be dropped, got %v", w.GetSubscriptions())
+	}
+
+	var subscribed int
+	w.SetChannelSubscriber(func(WebsocketChannelSubscription) error {
+		subscribed++
+		return nil
+	})
+	w.HandleError(NewError("test", ErrorRateLimited, errors.New("slow down")))
+	w.channelsToSubscribe = append(w.channelsToSubscribe, channels[0])
+	if err := w.appendSubscribedChannels(); err != nil {
+		t.Fatal(err)
+	}
+	if subscribed != 0 {
+		t.Error("expected subscriptions to back off after a rate limit violation")
+	}
+	w.subscribeBackoff = time.Time{}
+	if err := w.appendSubscribedChannels(); err != nil {
+		t.Fatal(err)
+	}
+	if subscribed != 1 {
+		t.Errorf("expected 1 subscription once the backoff passed, got %d", subscribed)
+	}
+}
@@ -0,0 +1,137 @@
+package wshandler
+
+import (
+	"fmt"
+	"sync/atomic"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+// NewKeepalivePolicy returns the keepalive policy for an exchanges websocket
+// keepalive config, which is disabled when cfg is nil
+func NewKeepalivePolicy(cfg *config.WebsocketKeepaliveConfig) KeepalivePolicy {
+	if cfg == nil {
+		return KeepalivePolicy{}
+	}
+	return KeepalivePolicy{
+		PingInterval: cfg.PingInterval,
+		PongTimeout:  cfg.PongTimeout,
+		MaxSilence:   cfg.MaxSilence,
+	}
+}
+
+// Enabled returns whether the policy pings or checks connections
+func (k *KeepalivePolicy) Enabled() bool {
+	return k.PingInterval > 0 || k.MaxSilence > 0
+}
+
+// pongTimeout returns how long to wait for a response to a ping
+func (k *KeepalivePolicy) pongTimeout() time.Duration {
+	if k.PongTimeout > 0 {
+		return k.PongTimeout
+	}
+	return k.PingInterval
+}
+
+// checkInterval returns how often a connection is checked, being a fraction
+// of the shortest configured interval
+func (k *KeepalivePolicy) checkInterval() time.Duration {
+	var shortest time.Duration
+	for _, d := range []time.Duration{k.PingInterval, k.pongTimeout(), k.MaxSilence} {
+		if d > 0 && (shortest == 0 || d < shortest) {
+			shortest = d
+		}
+	}
+	interval := shortest / keepaliveChecks
+	if interval < minKeepaliveInterval {
+		return minKeepaliveInterval
+	}
+	return interval
+}
+
+// stale returns why a connection is dead or stale at now, or an empty string
+// when it is healthy
+func (k *KeepalivePolicy) stale(now, pingSent, lastRead, lastMessage time.Time) string {
+	if k.PingInterval > 0 && !pingSent.IsZero() && lastRead.Before(pingSent) &&
+		now.Sub(pingSent) > k.pongTimeout() {
+		return fmt.Sprintf("received no pong within %v", k.pongTimeout())
+	}
+	if k.MaxSilence > 0 && now.Sub(lastMessage) > k.MaxSilence {
+		return fmt.Sprintf("received no messages in %v", k.MaxSilence)
+	}
+	return ""
+}
+
+// markRead records that a message was read, data being false for control
+// messages such as pongs
+func (w *WebsocketConnection) markRead(data bool) {
+	now := time.Now().UnixNano()
+	atomic.StoreInt64(&w.lastRead, now)
+	if data {
+		atomic.StoreInt64(&w.lastMessage, now)
+	}
+}
+
+// startKeepalive replaces any running keepalive with one for conn
+func (w *WebsocketConnection) startKeepalive(conn *websocket.Conn) {
+	w.stopKeepalive()
+	w.markRead(true)
+	if !w.Keepalive.Enabled() {
+		return
+	}
+	conn.SetPongHandler(func(string) error {
+		w.markRead(false)
+		return nil
+	})
+	stop := make(chan struct{})
+	w.connectionMutex.Lock()
+	w.keepaliveStop = stop
+	w.connectionMutex.Unlock()
+	go w.keepalive(conn, stop)
+}
+
+// stopKeepalive stops the running keepalive, if any
+func (w *WebsocketConnection) stopKeepalive() {
+	w.connectionMutex.Lock()
+	if w.keepaliveStop != nil {
+		close(w.keepaliveStop)
+		w.keepaliveStop = nil
+	}
+	w.connectionMutex.Unlock()
+}
+
+// keepalive pings conn and closes it once it is dead or stale, which fails
+// its reader with a disconnection error so the websocket reconnects even
+// though the TCP connection is still up
+func (w *WebsocketConnection) keepalive(conn *websocket.Conn, stop chan struct{}) {
+	ticker := time.NewTicker(w.Keepalive.checkInterval())
+	defer ticker.Stop()
+	var pingSent time.Time
+	for {
+		select {
+		case <-stop:
+			return
+		case now := <-ticker.C:
+			lastRead := time.Unix(0, atomic.LoadInt64(&w.lastRead))
+			lastMessage := time.Unix(0, atomic.LoadInt64(&w.lastMessage))
+			if reason := w.Keepalive.stale(now, pingSent, lastRead, lastMessage); reason != "" {
+				log.Warnf(log.WebsocketMgr, "%v websocket %s, reconnecting", w.ExchangeName, reason)
+				conn.Close()
+				return
+			}
+			if w.Keepalive.PingInterval <= 0 || now.Sub(pingSent) < w.Keepalive.PingInterval ||
+				(!pingSent.IsZero() && lastRead.Before(pingSent)) {
+				continue
+			}
+			err := conn.WriteControl(websocket.PingMessage, nil, now.Add(w.Keepalive.pongTimeout()))
+			if err != nil {
+				// The reader reports the failed connection
+				return
+			}
+			pingSent = now
+		}
+	}
+}
@@ -0,0 +1,135 @@
+package wshandler
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/config"
+)
+
+func TestNewKeepalivePolicy(t *testing.T) {
+	k := NewKeepalivePolicy(nil)
+	if k.Enabled() {
+		t.Error("expected a nil config to disable keepalives")
+	}
+	k = NewKeepalivePolicy(&config.WebsocketKeepaliveConfig{
+		PingInterval: time.Second,
+		MaxSilence:   time.Minute,
+	})
+	if !k.Enabled() {
+		t.Error("expected keepalives to be enabled")
+	}
+	if k.pongTimeout() != time.Second {
+		t.Errorf("expected pong timeout to default to the ping interval, got %v", k.pongTimeout())
+	}
+	if k.checkInterval() != time.Second/keepaliveChecks {
+		t.Errorf("unexpected check interval %v", k.checkInterval())
+	}
+	k = KeepalivePolicy{MaxSilence: time.Millisecond}
+	if k.checkInterval() != minKeepaliveInterval {
+		t.Errorf("expected check interval to be floored, got %v", k.checkInterval())
+	}
+}
+
+func TestKeepaliveStale(t *testing.T) {
+	now := time.Now()
+	k := KeepalivePolicy{
+		PingInterval: time.Second,
+		PongTimeout:  time.Second,
+		MaxSilence:   time.Minute,
+	}
+	for _, tc := range []struct {
+		name                            string
+		pingSent, lastRead, lastMessage time.Time
+		reason                          string
+	}{
+		{"healthy", now.Add(-time.Second / 2), now.Add(-time.Second), now, ""},
+		{"no ping sent", time.Time{}, now.Add(-time.Minute), now, ""},
+		{"pong received", now.Add(-2 * time.Second), now.Add(-time.Second), now, ""},
+		{"pong timeout", now.Add(-2 * time.Second), now.Add(-3 * time.Second), now, "no pong"},
+		{"silent", time.Time{}, now, now.Add(-2 * time.Minute), "no messages"},
+	} {
+		reason := k.stale(now, tc.pingSent, tc.lastRead, tc.lastMessage)
+		if tc.reason == "" && reason != "" || !strings.Contains(reason, tc.reason) {
+			t.Errorf("%s: expected reason %q, got %q", tc.name, tc.reason, reason)
+		}
+	}
+}
+
+// keepaliveServer returns a websocket server which never reads, so pings go
+// unanswered, and writes a message every interval when interval is set
+func keepaliveServer(t *testing.T, interval time.Duration) *httptest.Server {
+	upgrader := websocket.Upgrader{}
+	return httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			t.Error(err)
+			return
+		}
+		defer conn.Close()
+		for i := 0; i < 100; i++ {
+			if interval > 0 {
+				if conn.WriteMessage(websocket.TextMessage, []byte("hello")) != nil {
+					return
+				}
+			}
+			time.Sleep(20 * time.Millisecond)
+		}
+	}))
+}
+
+func TestKeepaliveReconnects(t *testing.T) {
+	for _, tc := range []struct {
+		name      string
+		keepalive KeepalivePolicy
+	}{
+		{"pong timeout", KeepalivePolicy{PingInterval: 20 * time.Millisecond}},
+		{"silent", KeepalivePolicy{MaxSilence: 50 * time.Millisecond}},
+	} {
+		s := keepaliveServer(t, 0)
+		c := &WebsocketConnection{
+			ExchangeName: "test",
+			URL:          "ws" + strings.TrimPrefix(s.URL, "http"),
+			Keepalive:    tc.keepalive,
+		}
+		err := c.Dial(&websocket.Dialer{}, http.Header{})
+		if err != nil {
+			s.Close()
+			t.Fatal(err)
+		}
+		start := time.Now()
+		_, err = c.ReadMessage()
+		if !isDisconnectionError(err) {
+			t.Errorf("%s: expected a disconnection error, got %v", tc.name, err)
+		}
+		if time.Since(start) > time.Second {
+			t.Errorf("%s: took %v to detect a stale connection", tc.name, time.Since(start))
+		}
+		c.Close()
+		s.Close()
+	}
+}
+
+func TestKeepaliveHealthy(t *testing.T) {
+	s := keepaliveServer(t, 20*time.Millisecond)
+	defer s.Close()
+	c := &WebsocketConnection{
+		ExchangeName: "test",
+		URL:          "ws" + strings.TrimPrefix(s.URL, "http"),
+		Keepalive:    KeepalivePolicy{MaxSilence: 200 * time.Millisecond},
+	}
+	err := c.Dial(&websocket.Dialer{}, http.Header{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer c.Close()
+	for i := 0; i < 20; i++ {
+		if _, err = c.ReadMessage(); err != nil {
+			t.Fatalf("expected a healthy connection, got %v", err)
+		}
+	}
+}
@@ -0,0 +1,198 @@
+package wshandler
+
+import (
+	"sync"
+
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
+)
+
+// Queue policies applied once a DataQueue is full
+const (
+	// QueueBlock never drops data, holding back the producer until the
+	// consumer makes room
+	QueueBlock QueuePolicy = iota
+	// QueueDropOldest drops the oldest pending data of the same policy to
+	// make room
+	QueueDropOldest
+	// QueueCoalesce replaces pending data with the same key instead of
+	// queueing it again
+	QueueCoalesce
+)
+
+// NewDataQueue returns a queue holding at most capacity items, a capacity of
+// zero or less using the default
+func NewDataQueue(name string, capacity int) *DataQueue {
+	if capacity <= 0 {
+		capacity = DefaultQueueCapacity
+	}
+	q := &DataQueue{
+		name:     name,
+		capacity: capacity,
+		pending:  make(map[string]*queueItem),
+		ready:    make(chan struct{}, 1),
+	}
+	q.space = sync.NewCond(&q.m)
+	return q
+}
+
+// queuePolicy returns the policy and coalescing key of websocket data.
+// Tickers are superseded by the next ticker so the oldest are dropped,
+// orderbook updates only signal that the local orderbook changed so pending
+// updates for the same orderbook are coalesced, anything else such as order
+// updates and errors is never dropped
+func queuePolicy(data interface{}) (QueuePolicy, string) {
+	switch d := data.(type) {
+	case *ticker.Price:
+		return QueueDropOldest, ""
+	case WebsocketOrderbookUpdate:
+		return QueueCoalesce, d.Exchange + d.Asset.String() + d.Pair.String()
+	}
+	return QueueBlock, ""
+}
+
+// SetCapacity changes how many items the queue holds before its policies
+// apply, a capacity of zero or less using the default
+func (q *DataQueue) SetCapacity(capacity int) {
+	if capacity <= 0 {
+		capacity = DefaultQueueCapacity
+	}
+	q.m.Lock()
+	q.capacity = capacity
+	q.m.Unlock()
+	q.space.Broadcast()
+}
+
+// Push queues data, applying its queue policy when the queue is full. Data
+// which is never dropped blocks until there is room, returning false if the
+// queue is closed meanwhile
+func (q *DataQueue) Push(data interface{}) bool {
+	policy, key := queuePolicy(data)
+	q.m.Lock()
+	defer q.m.Unlock()
+	if q.closed {
+		return false
+	}
+	if policy == QueueCoalesce {
+		if item, ok := q.pending[key]; ok {
+			item.data = data
+			q.coalesced++
+			metrics.WebsocketQueueCoalesced.Inc(q.name)
+			return true
+		}
+	}
+	for len(q.items) >= q.capacity {
+		if q.dropOldest() {
+			break
+		}
+		if policy == QueueDropOldest {
+			// Only data which is never dropped is pending, so drop the
+			// incoming ticker instead
+			q.drop()
+			return true
+		}
+		q.space.Wait()
+		if q.closed {
+			return false
+		}
+	}
+	item := &queueItem{data: data, policy: policy, key: key}
+	q.items = append(q.items, item)
+	if policy == QueueCoalesce {
+		q.pending[key] = item
+	}
+	select {
+	case q.ready <- struct{}{}:
+	default:
+	}
+	return true
+}
+
+// dropOldest removes the oldest pending item which may be dropped, reporting
+// whether one was found
+func (q *DataQueue) dropOldest() bool {
+	for i := range q.items {
+		if q.items[i].policy != QueueDropOldest {
+			continue
+		}
+		copy(q.items[i:], q.items[i+1:])
+		q.items[len(q.items)-1] = nil
+		q.items = q.items[:len(q.items)-1]
+		q.drop()
+		return true
+	}
+	return false
+}
+
+func (q *DataQueue) drop() {
+	q.dropped++
+	metrics.WebsocketQueueDropped.Inc(q.name)
+}
+
+// Pop returns the oldest pending data, reporting false when there is none
+func (q *DataQueue) Pop() (interface{}, bool) {
+	q.m.Lock()
+	defer q.m.Unlock()
+	if len(q.items) == 0 {
+		return nil, false
+	}
+	item := q.items[0]
+	q.items[0] = nil
+	q.items = q.items[1:]
+	if item.policy == QueueCoalesce && q.pending[item.key] == item {
+		delete(q.pending, item.key)
+	}
+	q.space.Signal()
+	return item.data, true
+}
+
+// Ready receives after data is pushed, once ready drain the queue with Pop
+// until it reports no data
+func (q *DataQueue) Ready() <-chan struct{} {
+	return q.ready
+}
+
+// Feed pushes the data received from in until stop is closed, applying
+// backpressure to the producers of in while the queue is full of data which
+// can't be dropped. The queue is closed once stopped and reopened when fed
+// again
+func (q *DataQueue) Feed(in <-chan interface{}, stop <-chan struct{}) {
+	q.m.Lock()
+	q.closed = false
+	q.m.Unlock()
+	go func() {
+		<-stop
+		q.Close()
+	}()
+	for {
+		select {
+		case <-stop:
+			return
+		case data := <-in:
+			if !q.Push(data) {
+				return
+			}
+		}
+	}
+}
+
+// Close discards further data and releases producers waiting for room,
+// pending data can still be popped
+func (q *DataQueue) Close() {
+	q.m.Lock()
+	q.closed = true
+	q.m.Unlock()
+	q.space.Broadcast()
+}
+
+// Stats returns the pending, dropped and coalesced data counts of the queue
+func (q *DataQueue) Stats() QueueStats {
+	q.m.Lock()
+	defer q.m.Unlock()
+	return QueueStats{
+		Pending:   len(q.items),
+		Capacity:  q.capacity,
+		Dropped:   q.dropped,
+		Coalesced: q.coalesced,
+	}
+}
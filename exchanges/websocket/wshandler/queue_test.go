@@ -0,0 +1,133 @@
+package wshandler
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
+)
+
+func TestDataQueueDropOldest(t *testing.T) {
+	q := NewDataQueue("test", 2)
+	first := &ticker.Price{Last: 1}
+	q.Push(first)
+	q.Push(&ticker.Price{Last: 2})
+	q.Push(&ticker.Price{Last: 3})
+	if s := q.Stats(); s.Pending != 2 || s.Dropped != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+	data, ok := q.Pop()
+	if !ok || data.(*ticker.Price).Last != 2 {
+		t.Errorf("expected the oldest ticker to be dropped, got %v", data)
+	}
+
+	// A ticker is dropped instead of holding back the producer when
+	// everything pending must be kept
+	q = NewDataQueue("test", 1)
+	q.Push(errors.New("order update"))
+	q.Push(&ticker.Price{Last: 1})
+	if s := q.Stats(); s.Pending != 1 || s.Dropped != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+	if _, ok := q.Pop(); !ok {
+		t.Fatal("expected pending data")
+	}
+	if _, ok := q.Pop(); ok {
+		t.Error("expected the incoming ticker to be dropped")
+	}
+}
+
+func TestDataQueueCoalesce(t *testing.T) {
+	q := NewDataQueue("test", 10)
+	btc := currency.NewPair(currency.BTC, currency.USD)
+	eth := currency.NewPair(currency.ETH, currency.USD)
+	q.Push(WebsocketOrderbookUpdate{Pair: btc, Asset: asset.Spot, Exchange: "test"})
+	q.Push(WebsocketOrderbookUpdate{Pair: eth, Asset: asset.Spot, Exchange: "test"})
+	q.Push(WebsocketOrderbookUpdate{Pair: btc, Asset: asset.Spot, Exchange: "test"})
+	q.Push(WebsocketOrderbookUpdate{Pair: btc, Asset: asset.Futures, Exchange: "test"})
+	if s := q.Stats(); s.Pending != 3 || s.Coalesced != 1 {
+		t.Fatalf("unexpected stats %+v", s)
+	}
+	data, _ := q.Pop()
+	if !data.(WebsocketOrderbookUpdate).Pair.Equal(btc) {
+		t.Errorf("expected the coalesced update to keep its place, got %v", data)
+	}
+
+	// Once popped an update for the same orderbook is queued again
+	q.Push(WebsocketOrderbookUpdate{Pair: btc, Asset: asset.Spot, Exchange: "test"})
+	if s := q.Stats(); s.Pending != 3 || s.Coalesced != 1 {
+		t.Errorf("unexpected stats %+v", s)
+	}
+}
+
+func TestDataQueueBackpressure(t *testing.T) {
+	q := NewDataQueue("test", 1)
+	q.Push("order one")
+	pushed := make(chan bool)
+	go func() {
+		pushed <- q.Push("order two")
+	}()
+	select {
+	case <-pushed:
+		t.Fatal("expected push to block while the queue is full")
+	case <-time.After(50 * time.Millisecond):
+	}
+	if data, _ := q.Pop(); data != "order one" {
+		t.Errorf("unexpected data %v", data)
+	}
+	select {
+	case ok := <-pushed:
+		if !ok {
+			t.Error("expected push to succeed")
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected push to unblock once there was room")
+	}
+	if data, _ := q.Pop(); data != "order two" {
+		t.Errorf("unexpected data %v", data)
+	}
+
+	// Tickers pending in a full queue make room for data which must be
+	// kept
+	q.Push(&ticker.Price{})
+	if !q.Push("order three") {
+		t.Error("expected push to succeed")
+	}
+	if s := q.Stats(); s.Pending != 1 || s.Dropped != 1 {
+		t.Errorf("unexpected stats %+v", s)
+	}
+}
+
+func TestDataQueueFeed(t *testing.T) {
+	q := NewDataQueue("test", 1)
+	in := make(chan interface{})
+	stop := make(chan struct{})
+	done := make(chan struct{})
+	go func() {
+		q.Feed(in, stop)
+		close(done)
+	}()
+	in <- "order one"
+	select {
+	case <-q.Ready():
+	case <-time.After(time.Second):
+		t.Fatal("expected queue to be ready")
+	}
+	// Blocks in Push until stop closes the queue
+	in <- "order two"
+	close(stop)
+	select {
+	case <-done:
+	case <-time.After(time.Second):
+		t.Fatal("expected feed to return once stopped")
+	}
+	if q.Push("order three") {
+		t.Error("expected a closed queue to discard data")
+	}
+	if data, _ := q.Pop(); data != "order one" {
+		t.Errorf("expected pending data to remain, got %v", data)
+	}
+}
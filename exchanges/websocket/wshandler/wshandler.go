@@ -4,8 +4,11 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"compress/zlib"
+	"context"
 	"errors"
 	"fmt"
+	"io"
 	"io/ioutil"
 	"net"
 	"net/http"
@@ -16,7 +19,9 @@ import (
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/chaos"
 	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/metrics"
 )
 
 // New initialises the websocket struct
@@ -33,6 +38,7 @@ func New() *Websocket {
 // Setup sets main variables for websocket connection
 func (w *Websocket) Setup(setupData *WebsocketSetup) error {
 	w.DataHandler = make(chan interface{}, 1)
+	w.queue = NewDataQueue(setupData.ExchangeName, 0)
 	w.TrafficAlert = make(chan struct{}, 1)
 	w.verbose = setupData.Verbose
 	w.SetChannelSubscriber(setupData.Subscriber)
@@ -45,6 +51,17 @@ func (w *Websocket) Setup(setupData *WebsocketSetup) error {
 	w.SetCanUseAuthenticatedEndpoints(setupData.AuthenticatedWebsocketAPISupport)
 	w.trafficTimeout = setupData.WebsocketTimeout
 	w.features = setupData.Features
+	if setupData.ConnectionDialer != nil {
+		if setupData.ConnectionSubscriber == nil || setupData.ConnectionUnSubscriber == nil {
+			return fmt.Errorf("%v websocket connection dialer set without connection subscribers",
+				setupData.ExchangeName)
+		}
+		w.connections = NewConnectionManager(setupData.MaxSubscriptionsPerConnection,
+			setupData.ConnectionDialer)
+		w.connectionSubscriber = setupData.ConnectionSubscriber
+		w.connectionUnsubscriber = setupData.ConnectionUnSubscriber
+		w.dedicated = setupData.Dedicated
+	}
 	err := w.Initialise()
 	if err != nil {
 		return err
@@ -73,6 +90,13 @@ func (w *Websocket) Connect() error {
 	w.setConnectingStatus(true)
 	w.ShutdownC = make(chan struct{}, 1)
 	w.ReadMessageErrors = make(chan error, 1)
+	if w.connections != nil {
+		// Connections left from before a disconnection are replaced
+		err := w.connections.Close()
+		if err != nil {
+			log.Error(log.WebsocketMgr, err)
+		}
+	}
 	err := w.connector()
 	if err != nil {
 		w.setConnectingStatus(false)
@@ -83,6 +107,15 @@ func (w *Websocket) Connect() error {
 	w.setConnectedStatus(true)
 	w.setConnectingStatus(false)
 	w.setInit(true)
+	if !w.disconnected.IsZero() {
+		if w.CanUseAuthenticatedEndpoints() {
+			w.DataHandler <- WebsocketAuthenticatedReconnect{
+				Exchange:     w.exchangeName,
+				Disconnected: w.disconnected,
+			}
+		}
+		w.disconnected = time.Time{}
+	}
 
 	var anotherWG sync.WaitGroup
 	anotherWG.Add(1)
@@ -149,6 +182,9 @@ func (w *Websocket) connectionMonitor() {
 				w.setConnectedStatus(false)
 				w.setConnectingStatus(false)
 				w.setInit(false)
+				w.m.Lock()
+				w.disconnected = time.Now()
+				w.m.Unlock()
 				if w.verbose {
 					log.Debugf(log.WebsocketMgr, "%v websocket has been disconnected. Reason: %v",
 						w.exchangeName, err)
@@ -194,9 +230,17 @@ func (w *Websocket) Shutdown() error {
 		log.Debugf(log.WebsocketMgr, "%v shutting down websocket channels", w.exchangeName)
 	}
 	close(w.ShutdownC)
+	if w.connections != nil {
+		// Closing the connections unblocks their readers
+		err := w.connections.Close()
+		if err != nil {
+			log.Error(log.WebsocketMgr, err)
+		}
+	}
 	w.Wg.Wait()
 	w.setConnectedStatus(false)
 	w.setConnectingStatus(false)
+	w.disconnected = time.Now()
 	if w.verbose {
 		log.Debugf(log.WebsocketMgr, "%v completed websocket channel shutdown", w.exchangeName)
 	}
@@ -388,6 +432,24 @@ func (w *Websocket) GetProxyAddress() string {
 	return w.proxyAddr
 }
 
+// SetAlternateURLs sets the websocket URLs to fail over to, in order of
+// preference, when the running URL can't be connected to
+func (w *Websocket) SetAlternateURLs(urls []string) error {
+	for i := range urls {
+		u, err := url.Parse(urls[i])
+		if err != nil || (u.Scheme != "ws" && u.Scheme != "wss") || u.Host == "" {
+			return fmt.Errorf("invalid websocket URL %q", urls[i])
+		}
+	}
+	w.alternateURLs = urls
+	return nil
+}
+
+// GetAlternateURLs returns the websocket URLs to fail over to
+func (w *Websocket) GetAlternateURLs() []string {
+	return w.alternateURLs
+}
+
 // SetDefaultURL sets default websocket URL
 func (w *Websocket) SetDefaultURL(defaultURL string) {
 	w.defaultURL = defaultURL
@@ -413,6 +475,12 @@ func (w *Websocket) GetName() string {
 	return w.exchangeName
 }
 
+// Queue returns the bounded queue data from DataHandler is fed into for
+// processing
+func (w *Websocket) Queue() *DataQueue {
+	return w.queue
+}
+
 // SetChannelSubscriber sets the function to use the base subscribe func
 func (w *Websocket) SetChannelSubscriber(subscriber func(channelToSubscribe WebsocketChannelSubscription) error) {
 	w.channelSubscriber = subscriber
@@ -475,10 +543,14 @@ func (w *Websocket) manageSubscriptions() {
 }
 
 // appendSubscribedChannels compares channelsToSubscribe to subscribedChannels
-// and subscribes to any channels not present in subscribedChannels
+// and subscribes to any channels not present in subscribedChannels, unless
+// subscriptions are backing off after a rate limit violation
 func (w *Websocket) appendSubscribedChannels() error {
 	w.subscriptionMutex.Lock()
 	defer w.subscriptionMutex.Unlock()
+	if time.Now().Before(w.subscribeBackoff) {
+		return nil
+	}
 	for i := range w.channelsToSubscribe {
 		channelIsSubscribed := false
 		for j := 0; j < len(w.subscribedChannels); j++ {
@@ -491,7 +563,7 @@ func (w *Websocket) appendSubscribedChannels() error {
 			if w.verbose {
 				log.Debugf(log.WebsocketMgr, "%v Subscribing to %v %v", w.exchangeName, w.channelsToSubscribe[i].Channel, w.channelsToSubscribe[i].Currency.String())
 			}
-			err := w.channelSubscriber(w.channelsToSubscribe[i])
+			err := w.subscribe(w.channelsToSubscribe[i])
 			if err != nil {
 				return err
 			}
@@ -515,7 +587,7 @@ func (w *Websocket) unsubscribeToChannels() error {
 			}
 		}
 		if !subscriptionFound {
-			err := w.channelUnsubscriber(w.subscribedChannels[i])
+			err := w.unsubscribe(w.subscribedChannels[i])
 			if err != nil {
 				return err
 			}
@@ -527,6 +599,53 @@ func (w *Websocket) unsubscribeToChannels() error {
 	return nil
 }
 
+// subscribe subscribes to a channel, on the connection assigned by the
+// connection manager when subscriptions are multiplexed
+func (w *Websocket) subscribe(channel WebsocketChannelSubscription) error {
+	if !w.multiplexed(&channel) {
+		return w.channelSubscriber(channel)
+	}
+	conn, err := w.connections.Assign(channel)
+	if err != nil {
+		return err
+	}
+	err = w.connectionSubscriber(conn, channel)
+	if err != nil {
+		w.connections.Release(channel)
+	}
+	return err
+}
+
+// unsubscribe unsubscribes from a channel, on the connection it was
+// subscribed on when subscriptions are multiplexed
+func (w *Websocket) unsubscribe(channel WebsocketChannelSubscription) error {
+	if !w.multiplexed(&channel) {
+		return w.channelUnsubscriber(channel)
+	}
+	conn := w.connections.Lookup(channel)
+	if conn == nil {
+		return nil
+	}
+	err := w.connectionUnsubscriber(conn, channel)
+	if err != nil {
+		return err
+	}
+	w.connections.Release(channel)
+	return nil
+}
+
+// multiplexed returns whether a channel is subscribed on a connection
+// assigned by the connection manager
+func (w *Websocket) multiplexed(channel *WebsocketChannelSubscription) bool {
+	return w.connections != nil && (w.dedicated == nil || !w.dedicated(*channel))
+}
+
+// Connections returns the connection manager multiplexing subscriptions, nil
+// when the exchange subscribes over a single connection
+func (w *Websocket) Connections() *ConnectionManager {
+	return w.connections
+}
+
 // RemoveSubscribedChannels removes supplied channels from channelsToSubscribe
 func (w *Websocket) RemoveSubscribedChannels(channels []WebsocketChannelSubscription) {
 	for i := range channels {
@@ -561,7 +680,7 @@ func (w *Websocket) removeChannelToSubscribe(subscribedChannel WebsocketChannelS
 func (w *Websocket) ResubscribeToChannel(subscribedChannel WebsocketChannelSubscription) {
 	w.subscriptionMutex.Lock()
 	defer w.subscriptionMutex.Unlock()
-	err := w.channelUnsubscriber(subscribedChannel)
+	err := w.unsubscribe(subscribedChannel)
 	if err != nil {
 		w.DataHandler <- err
 	}
@@ -592,6 +711,78 @@ func (w *Websocket) SubscribeToChannels(channels []WebsocketChannelSubscription)
 	}
 }
 
+// AddSubscriptions subscribes to channels straight away on the running
+// connection rather than waiting for the next subscription check, keeping
+// them subscribed across reconnections. Channels already subscribed to are
+// skipped.
+func (w *Websocket) AddSubscriptions(channels []WebsocketChannelSubscription) error {
+	if w.features == nil || !w.features.Subscribe {
+		return fmt.Errorf("%v websocket does not support subscribing to channels", w.exchangeName)
+	}
+	if !w.IsConnected() {
+		return fmt.Errorf("%v websocket is not connected", w.exchangeName)
+	}
+	w.subscriptionMutex.Lock()
+	defer w.subscriptionMutex.Unlock()
+	for i := range channels {
+		if subscriptionIndex(w.subscribedChannels, &channels[i]) != -1 {
+			continue
+		}
+		err := w.subscribe(channels[i])
+		if err != nil {
+			return fmt.Errorf("%v unable to subscribe to %v %v: %v",
+				w.exchangeName, channels[i].Channel, channels[i].Currency, err)
+		}
+		w.subscribedChannels = append(w.subscribedChannels, channels[i])
+		if subscriptionIndex(w.channelsToSubscribe, &channels[i]) == -1 {
+			w.channelsToSubscribe = append(w.channelsToSubscribe, channels[i])
+		}
+	}
+	return nil
+}
+
+// RemoveSubscriptions unsubscribes from channels straight away without
+// tearing down the connection, so they are no longer resubscribed to after a
+// reconnection
+func (w *Websocket) RemoveSubscriptions(channels []WebsocketChannelSubscription) error {
+	if w.features == nil || !w.features.Unsubscribe {
+		return fmt.Errorf("%v websocket does not support unsubscribing from channels", w.exchangeName)
+	}
+	if !w.IsConnected() {
+		return fmt.Errorf("%v websocket is not connected", w.exchangeName)
+	}
+	w.subscriptionMutex.Lock()
+	defer w.subscriptionMutex.Unlock()
+	for i := range channels {
+		j := subscriptionIndex(w.subscribedChannels, &channels[i])
+		if j == -1 {
+			return fmt.Errorf("%v not subscribed to %v %v",
+				w.exchangeName, channels[i].Channel, channels[i].Currency)
+		}
+		err := w.unsubscribe(w.subscribedChannels[j])
+		if err != nil {
+			return fmt.Errorf("%v unable to unsubscribe from %v %v: %v",
+				w.exchangeName, channels[i].Channel, channels[i].Currency, err)
+		}
+		w.subscribedChannels = append(w.subscribedChannels[:j], w.subscribedChannels[j+1:]...)
+		if k := subscriptionIndex(w.channelsToSubscribe, &channels[i]); k != -1 {
+			w.channelsToSubscribe = append(w.channelsToSubscribe[:k], w.channelsToSubscribe[k+1:]...)
+		}
+	}
+	return nil
+}
+
+// subscriptionIndex returns the index of channel in channels, or -1 if it is
+// not present
+func subscriptionIndex(channels []WebsocketChannelSubscription, channel *WebsocketChannelSubscription) int {
+	for i := range channels {
+		if channels[i].Equal(channel) {
+			return i
+		}
+	}
+	return -1
+}
+
 // Equal two WebsocketChannelSubscription to determine equality
 func (w *WebsocketChannelSubscription) Equal(subscribedChannel *WebsocketChannelSubscription) bool {
 	return strings.EqualFold(w.Channel, subscribedChannel.Channel) &&
@@ -601,6 +792,8 @@ func (w *WebsocketChannelSubscription) Equal(subscribedChannel *WebsocketChannel
 // GetSubscriptions returns a copied list of subscriptions
 // subscriptions is a private member and cannot be manipulated
 func (w *Websocket) GetSubscriptions() []WebsocketChannelSubscription {
+	w.subscriptionMutex.Lock()
+	defer w.subscriptionMutex.Unlock()
 	return append(w.subscribedChannels[:0:0], w.subscribedChannels...)
 }
 
@@ -632,6 +825,9 @@ func (w *WebsocketConnection) AddResponseWithID(id int64, data []byte) {
 
 // Dial sets proxy urls and then connects to the websocket
 func (w *WebsocketConnection) Dial(dialer *websocket.Dialer, headers http.Header) error {
+	if replaying, err := w.dialReplay(); replaying {
+		return err
+	}
 	if w.ProxyURL != "" {
 		proxy, err := url.Parse(w.ProxyURL)
 		if err != nil {
@@ -639,20 +835,127 @@ func (w *WebsocketConnection) Dial(dialer *websocket.Dialer, headers http.Header
 		}
 		dialer.Proxy = http.ProxyURL(proxy)
 	}
-	var err error
-	var conStatus *http.Response
-	w.Connection, conStatus, err = dialer.Dial(w.URL, headers)
-	if err != nil {
-		if conStatus != nil {
-			return fmt.Errorf("%v %v %v Error: %v", w.URL, conStatus, conStatus.StatusCode, err)
+	if !w.DisableCompression {
+		// Servers which don't support compression decline it, so it is
+		// always requested
+		dialer.EnableCompression = true
+	}
+	urls := w.dialOrder(time.Now())
+	errs := make([]string, 0, len(urls))
+	for i := range urls {
+		conn, conStatus, err := dialer.Dial(urls[i], headers)
+		if err != nil {
+			w.markFailed(urls[i], time.Now())
+			if conStatus != nil {
+				err = fmt.Errorf("%v %v %v Error: %v", urls[i], conStatus, conStatus.StatusCode, err)
+			} else {
+				err = fmt.Errorf("%v Error: %v", urls[i], err)
+			}
+			if len(urls) == 1 {
+				return err
+			}
+			errs = append(errs, err.Error())
+			continue
+		}
+		w.Connection = conn
+		w.markFailed(urls[i], time.Time{})
+		compressed := strings.Contains(conStatus.Header.Get("Sec-Websocket-Extensions"), compressionExtension)
+		w.connectionMutex.Lock()
+		w.compressed = compressed
+		w.connectionMutex.Unlock()
+		if w.Verbose {
+			log.Infof(log.WebsocketMgr, "%v Websocket connected to %s, compression: %v",
+				w.ExchangeName, urls[i], compressed)
 		}
-		return fmt.Errorf("%v Error: %v", w.URL, err)
+		w.setConnectedStatus(true)
+		w.startKeepalive(conn)
+		w.capture, err = recorderFor(w.ExchangeName)
+		if err != nil {
+			log.Errorf(log.WebsocketMgr, "%v unable to capture websocket frames: %v", w.ExchangeName, err)
+		}
+		return nil
 	}
-	if w.Verbose {
-		log.Infof(log.WebsocketMgr, "%v Websocket connected to %s", w.ExchangeName, w.URL)
+	return fmt.Errorf("all websocket URLs failed: %s", strings.Join(errs, ", "))
+}
+
+// Clone returns an unconnected connection with the same settings, used to
+// dial additional connections to the same endpoint
+func (w *WebsocketConnection) Clone() *WebsocketConnection {
+	return &WebsocketConnection{
+		Verbose:              w.Verbose,
+		RateLimit:            w.RateLimit,
+		ExchangeName:         w.ExchangeName,
+		URL:                  w.URL,
+		AlternateURLs:        w.AlternateURLs,
+		ProxyURL:             w.ProxyURL,
+		ResponseCheckTimeout: w.ResponseCheckTimeout,
+		ResponseMaxLimit:     w.ResponseMaxLimit,
+		TrafficTimeout:       w.TrafficTimeout,
+		DisableCompression:   w.DisableCompression,
+		Keepalive:            w.Keepalive,
 	}
-	w.setConnectedStatus(true)
-	return nil
+}
+
+// IsCompressed returns whether permessage-deflate compression was negotiated
+// when the connection was dialled
+func (w *WebsocketConnection) IsCompressed() bool {
+	w.connectionMutex.RLock()
+	defer w.connectionMutex.RUnlock()
+	return w.compressed
+}
+
+// Close closes the connection, after which IsClosed reports true so its
+// reader can tell the resulting read error apart from a disconnection
+func (w *WebsocketConnection) Close() error {
+	w.connectionMutex.Lock()
+	w.closed = true
+	w.connected = false
+	w.connectionMutex.Unlock()
+	w.stopKeepalive()
+	if w.Connection == nil {
+		return nil
+	}
+	return w.Connection.Close()
+}
+
+// IsClosed returns whether the connection was closed with Close
+func (w *WebsocketConnection) IsClosed() bool {
+	w.connectionMutex.RLock()
+	defer w.connectionMutex.RUnlock()
+	return w.closed
+}
+
+// dialOrder returns URL followed by AlternateURLs, with the URLs which failed
+// within alternateURLCooldown moved to the end
+func (w *WebsocketConnection) dialOrder(now time.Time) []string {
+	urls := append([]string{w.URL}, w.AlternateURLs...)
+	w.connectionMutex.RLock()
+	defer w.connectionMutex.RUnlock()
+	healthy := make([]string, 0, len(urls))
+	var failed []string
+	for i := range urls {
+		if now.Sub(w.failedURLs[urls[i]]) < alternateURLCooldown {
+			failed = append(failed, urls[i])
+			continue
+		}
+		healthy = append(healthy, urls[i])
+	}
+	return append(healthy, failed...)
+}
+
+// markFailed records when a URL failed to connect, the zero time marks it as
+// healthy
+func (w *WebsocketConnection) markFailed(u string, at time.Time) {
+	w.connectionMutex.Lock()
+	defer w.connectionMutex.Unlock()
+	if at.IsZero() {
+		delete(w.failedURLs, u)
+		return
+	}
+	if w.failedURLs == nil {
+		w.failedURLs = make(map[string]time.Time)
+	}
+	w.failedURLs[u] = at
 }
 
 // SendJSONMessage sends a JSON encoded message over the connection
@@ -662,10 +965,13 @@ func (w *WebsocketConnection) SendJSONMessage(data interface{}) error {
 	if !w.IsConnected() {
 		return fmt.Errorf("%v cannot send message to a disconnected websocket", w.ExchangeName)
 	}
-	if w.Verbose {
-		log.Debugf(log.WebsocketMgr,
+	if w.Verbose || log.ExchangeDebugEnabled(w.ExchangeName) {
+		log.WithFields(log.WebsocketMgr, log.Fields{Exchange: w.ExchangeName}).Sample("sent").Debugf(
 			"%v sending message to websocket %+v", w.ExchangeName, data)
 	}
+	if w.replay != nil {
+		return nil
+	}
 	if w.RateLimit > 0 {
 		time.Sleep(time.Duration(w.RateLimit) * time.Millisecond)
 	}
@@ -679,10 +985,13 @@ func (w *WebsocketConnection) SendRawMessage(messageType int, message []byte) er
 	if !w.IsConnected() {
 		return fmt.Errorf("%v cannot send message to a disconnected websocket", w.ExchangeName)
 	}
-	if w.Verbose {
-		log.Debugf(log.WebsocketMgr,
+	if w.Verbose || log.ExchangeDebugEnabled(w.ExchangeName) {
+		log.WithFields(log.WebsocketMgr, log.Fields{Exchange: w.ExchangeName}).Sample("sent").Debugf(
 			"%v sending message to websocket %s", w.ExchangeName, message)
 	}
+	if w.replay != nil {
+		return nil
+	}
 	if w.RateLimit > 0 {
 		time.Sleep(time.Duration(w.RateLimit) * time.Millisecond)
 	}
@@ -692,6 +1001,9 @@ func (w *WebsocketConnection) SendRawMessage(messageType int, message []byte) er
 // SetupPingHandler will automatically send ping or pong messages based on
 // WebsocketPingHandler configuration
 func (w *WebsocketConnection) SetupPingHandler(handler WebsocketPingHandler) {
+	if w.replay != nil {
+		return
+	}
 	if handler.UseGorillaHandler {
 		h := func(msg string) error {
 			err := w.Connection.WriteControl(handler.MessageType, []byte(msg), time.Now().Add(handler.Delay))
@@ -792,13 +1104,21 @@ func (w *WebsocketConnection) IsConnected() bool {
 
 // ReadMessage reads messages, can handle text, gzip and binary
 func (w *WebsocketConnection) ReadMessage() (WebsocketResponse, error) {
-	mType, resp, err := w.Connection.ReadMessage()
+	mType, resp, err := w.readFrame()
+	for err == nil && chaos.DropWebsocketMessage(w.ExchangeName) {
+		w.markRead(true)
+		mType, resp, err = w.readFrame()
+	}
 	if err != nil {
 		if isDisconnectionError(err) {
 			w.setConnectedStatus(false)
 		}
 		return WebsocketResponse{}, err
 	}
+	w.markRead(true)
+	if err = chaos.Delay(context.Background(), w.ExchangeName); err != nil {
+		return WebsocketResponse{}, err
+	}
 	var standardMessage []byte
 	switch mType {
 	case websocket.TextMessage:
@@ -809,46 +1129,50 @@ func (w *WebsocketConnection) ReadMessage() (WebsocketResponse, error) {
 			return WebsocketResponse{}, err
 		}
 	}
-	if w.Verbose {
-		log.Debugf(log.WebsocketMgr, "%v Websocket message received: %v",
+	metrics.WebsocketMessages.Inc(w.ExchangeName)
+	if w.Verbose || log.ExchangeDebugEnabled(w.ExchangeName) {
+		log.WithFields(log.WebsocketMgr, log.Fields{Exchange: w.ExchangeName}).Sample("received").Debugf(
+			"%v Websocket message received: %v",
 			w.ExchangeName,
 			string(standardMessage))
 	}
 	return WebsocketResponse{Raw: standardMessage, Type: mType}, nil
 }
 
-// parseBinaryResponse parses a websocket binary response into a usable byte array
+// parseBinaryResponse parses a websocket binary response into a usable byte
+// array. Exchanges compress binary payloads with gzip, zlib or raw deflate,
+// which is detected from the payload header.
 func (w *WebsocketConnection) parseBinaryResponse(resp []byte) ([]byte, error) {
-	var standardMessage []byte
+	var reader io.ReadCloser
 	var err error
-	// Detect GZIP
-	if resp[0] == 31 && resp[1] == 139 {
-		b := bytes.NewReader(resp)
-		var gReader *gzip.Reader
-		gReader, err = gzip.NewReader(b)
-		if err != nil {
-			return standardMessage, err
-		}
-		standardMessage, err = ioutil.ReadAll(gReader)
-		if err != nil {
-			return standardMessage, err
-		}
-		err = gReader.Close()
-		if err != nil {
-			return standardMessage, err
-		}
-	} else {
-		reader := flate.NewReader(bytes.NewReader(resp))
-		standardMessage, err = ioutil.ReadAll(reader)
-		if err != nil {
-			return standardMessage, err
-		}
-		err = reader.Close()
-		if err != nil {
-			return standardMessage, err
-		}
+	switch {
+	case len(resp) >= 2 && resp[0] == 0x1f && resp[1] == 0x8b:
+		reader, err = gzip.NewReader(bytes.NewReader(resp))
+	case isZlibHeader(resp):
+		reader, err = zlib.NewReader(bytes.NewReader(resp))
+	default:
+		reader = flate.NewReader(bytes.NewReader(resp))
 	}
-	return standardMessage, nil
+	if err != nil {
+		return nil, err
+	}
+	standardMessage, err := ioutil.ReadAll(io.LimitReader(reader, maxDecompressedMessageSize+1))
+	if err != nil {
+		reader.Close()
+		return nil, err
+	}
+	if len(standardMessage) > maxDecompressedMessageSize {
+		reader.Close()
+		return nil, fmt.Errorf("%v websocket message exceeds %d bytes when decompressed",
+			w.ExchangeName, maxDecompressedMessageSize)
+	}
+	return standardMessage, reader.Close()
+}
+
+// isZlibHeader returns whether a payload starts with a zlib header, being a
+// deflate compression method and a header checksum divisible by 31
+func isZlibHeader(b []byte) bool {
+	return len(b) >= 2 && b[0]&0x0f == 8 && (uint16(b[0])<<8|uint16(b[1]))%31 == 0
 }
 
 // GenerateMessageID Creates a messageID to checkout
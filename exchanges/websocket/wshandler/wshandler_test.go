@@ -4,6 +4,7 @@ import (
 	"bytes"
 	"compress/flate"
 	"compress/gzip"
+	"compress/zlib"
 	"encoding/json"
 	"errors"
 	"net"
@@ -114,6 +115,33 @@ outer:
 	}
 }
 
+func TestAuthenticatedReconnect(t *testing.T) {
+	ws := New()
+	ws.exchangeName = "test"
+	ws.connected = true
+	ws.enabled = true
+	ws.ReadMessageErrors = make(chan error)
+	ws.DataHandler = make(chan interface{})
+	ws.ShutdownC = make(chan struct{})
+	ws.connector = func() error { return nil }
+	ws.features = &protocol.Features{}
+	ws.SetCanUseAuthenticatedEndpoints(true)
+	go ws.connectionMonitor()
+	ws.ReadMessageErrors <- &websocket.CloseError{Code: 1006}
+	select {
+	case data := <-ws.DataHandler:
+		r, ok := data.(WebsocketAuthenticatedReconnect)
+		if !ok || r.Exchange != "test" || r.Disconnected.IsZero() {
+			t.Errorf("unexpected data %v", data)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("expected an authenticated reconnect to be reported")
+	}
+	if !ws.IsConnected() {
+		t.Error("expected websocket to be reconnected")
+	}
+}
+
 func TestWebsocket(t *testing.T) {
 	ws := Websocket{}
 	ws.setInit(true)
@@ -353,6 +381,69 @@ func TestManageSubscriptions(t *testing.T) {
 	w.subscriptionMutex.Unlock()
 }
 
+// TestAddRemoveSubscriptions logic test
+func TestAddRemoveSubscriptions(t *testing.T) {
+	var subscribed, unsubscribed []string
+	w := Websocket{
+		exchangeName: "test",
+		features:     &protocol.Features{Subscribe: true, Unsubscribe: true},
+	}
+	w.SetChannelSubscriber(func(c WebsocketChannelSubscription) error {
+		if c.Channel == "fail" {
+			return errors.New("subscription rejected")
+		}
+		subscribed = append(subscribed, c.Channel)
+		return nil
+	})
+	w.SetChannelUnsubscriber(func(c WebsocketChannelSubscription) error {
+		unsubscribed = append(unsubscribed, c.Channel)
+		return nil
+	})
+	channels := []WebsocketChannelSubscription{{Channel: "trades"}, {Channel: "book"}}
+	if err := w.AddSubscriptions(channels); err == nil {
+		t.Error("expected an error subscribing while disconnected")
+	}
+	w.setConnectedStatus(true)
+	if err := w.AddSubscriptions(channels); err != nil {
+		t.Fatal(err)
+	}
+	// Subscribing again is a no-op
+	if err := w.AddSubscriptions(channels[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if len(subscribed) != 2 || len(w.GetSubscriptions()) != 2 || len(w.channelsToSubscribe) != 2 {
+		t.Errorf("expected 2 subscriptions, got %v", w.GetSubscriptions())
+	}
+	if err := w.AddSubscriptions([]WebsocketChannelSubscription{{Channel: "fail"}}); err == nil {
+		t.Error("expected a rejected subscription to error")
+	}
+	if len(w.GetSubscriptions()) != 2 {
+		t.Error("expected a rejected subscription not to be recorded")
+	}
+
+	if err := w.RemoveSubscriptions(channels[:1]); err != nil {
+		t.Fatal(err)
+	}
+	if len(unsubscribed) != 1 || unsubscribed[0] != "trades" {
+		t.Errorf("expected trades to be unsubscribed, got %v", unsubscribed)
+	}
+	subs := w.GetSubscriptions()
+	if len(subs) != 1 || subs[0].Channel != "book" || len(w.channelsToSubscribe) != 1 {
+		t.Errorf("expected only book to remain subscribed, got %v", subs)
+	}
+	if err := w.RemoveSubscriptions(channels[:1]); err == nil {
+		t.Error("expected an error unsubscribing from a channel not subscribed to")
+	}
+
+	w.features = &protocol.Features{}
+	if err := w.AddSubscriptions(channels); err == nil {
+		t.Error("expected an error when subscribing is unsupported")
+	}
+	if err := w.RemoveSubscriptions(channels); err == nil {
+		t.Error("expected an error when unsubscribing is unsupported")
+	}
+}
+
 // TestConnectionMonitorNoConnection logic test
 func TestConnectionMonitorNoConnection(t *testing.T) {
 	ws := New()
@@ -695,6 +786,56 @@ func TestParseBinaryResponse(t *testing.T) {
 	}
 }
 
+// TestParseBinaryResponseZlib logic test
+func TestParseBinaryResponseZlib(t *testing.T) {
+	var b bytes.Buffer
+	w := zlib.NewWriter(&b)
+	_, err := w.Write([]byte("hello"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp, err := wc.parseBinaryResponse(b.Bytes())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(resp) != "hello" {
+		t.Errorf("Zlib conversion failed. Received: '%v', Expected: 'hello'", string(resp))
+	}
+}
+
+// TestParseBinaryResponseLimit logic test
+func TestParseBinaryResponseLimit(t *testing.T) {
+	var b bytes.Buffer
+	w := gzip.NewWriter(&b)
+	_, err := w.Write(make([]byte, maxDecompressedMessageSize+1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = w.Close()
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = wc.parseBinaryResponse(b.Bytes())
+	if err == nil {
+		t.Error("expected an oversized message to be rejected")
+	}
+}
+
+// TestCloneDisableCompression logic test
+func TestCloneDisableCompression(t *testing.T) {
+	c := &WebsocketConnection{ExchangeName: "test", DisableCompression: true}
+	if !c.Clone().DisableCompression {
+		t.Error("expected the clone to keep compression disabled")
+	}
+	if c.IsCompressed() {
+		t.Error("expected an undialled connection to be uncompressed")
+	}
+}
+
 // TestAddResponseWithID logic test
 func TestAddResponseWithID(t *testing.T) {
 	wc.IDResponses = nil
@@ -747,3 +888,55 @@ func TestCanUseAuthenticatedWebsocketForWrapper(t *testing.T) {
 		t.Error("Expected true, `connected` and `CanUseAuthenticatedEndpoints` is true")
 	}
 }
+
+func TestSetAlternateURLs(t *testing.T) {
+	ws := &Websocket{}
+	if err := ws.SetAlternateURLs([]string{"https://test.com"}); err == nil {
+		t.Error("expected error for non websocket URL")
+	}
+	urls := []string{"wss://one.test.com", "ws://two.test.com/ws"}
+	if err := ws.SetAlternateURLs(urls); err != nil {
+		t.Fatal(err)
+	}
+	if got := ws.GetAlternateURLs(); len(got) != 2 || got[1] != urls[1] {
+		t.Errorf("unexpected alternate URLs %v", got)
+	}
+}
+
+func TestDialOrder(t *testing.T) {
+	wc := &WebsocketConnection{
+		URL:           "wss://primary",
+		AlternateURLs: []string{"wss://one", "wss://two"},
+	}
+	now := time.Now()
+	if got := strings.Join(wc.dialOrder(now), ","); got != "wss://primary,wss://one,wss://two" {
+		t.Errorf("unexpected dial order %s", got)
+	}
+	wc.markFailed("wss://primary", now)
+	if got := strings.Join(wc.dialOrder(now), ","); got != "wss://one,wss://two,wss://primary" {
+		t.Errorf("unexpected dial order %s", got)
+	}
+	if got := wc.dialOrder(now.Add(alternateURLCooldown))[0]; got != "wss://primary" {
+		t.Errorf("expected primary to be retried after cooldown, got %s", got)
+	}
+	wc.markFailed("wss://primary", time.Time{})
+	if got := wc.dialOrder(now)[0]; got != "wss://primary" {
+		t.Errorf("expected primary to be healthy, got %s", got)
+	}
+}
+
+func TestDialFailover(t *testing.T) {
+	wc := &WebsocketConnection{
+		ExchangeName:  "failover",
+		URL:           "ws://127.0.0.1:1",
+		AlternateURLs: []string{"ws://127.0.0.1:2"},
+	}
+	d := websocket.Dialer{HandshakeTimeout: time.Second}
+	err := wc.Dial(&d, http.Header{})
+	if err == nil || !strings.HasPrefix(err.Error(), "all websocket URLs failed") {
+		t.Fatalf("unexpected error %v", err)
+	}
+	if len(wc.failedURLs) != 2 {
+		t.Errorf("expected both URLs marked failed, got %v", wc.failedURLs)
+	}
+}
@@ -1,12 +1,16 @@
 package wshandler
 
 import (
+	"encoding/json"
+	"os"
 	"sync"
 	"time"
 
 	"github.com/gorilla/websocket"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kline"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/protocol"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wsorderbook"
 )
@@ -18,9 +22,27 @@ const (
 	manageSubscriptionsDelay = 5 * time.Second
 	// connection monitor time delays and limits
 	connectionMonitorDelay             = 2 * time.Second
+	alternateURLCooldown               = 30 * time.Second
 	WebsocketNotAuthenticatedUsingRest = "%v - Websocket not authenticated, using REST"
 	Ping                               = "ping"
 	Pong                               = "pong"
+	// maxDecompressedMessageSize limits the size of a decompressed binary
+	// message so a malformed payload can't exhaust memory
+	maxDecompressedMessageSize = 64 << 20
+	// compressionExtension is the negotiated websocket compression extension
+	compressionExtension = "permessage-deflate"
+	// keepaliveChecks is how many times a connection is checked per
+	// keepalive interval
+	keepaliveChecks      = 4
+	minKeepaliveInterval = 10 * time.Millisecond
+	// captureFileExtension is the extension of websocket capture files
+	captureFileExtension = ".jsonl"
+	// DefaultQueueCapacity is how much websocket data may wait to be
+	// processed before the queue policies apply
+	DefaultQueueCapacity = 1000
+	// rateLimitBackoff is how long subscriptions are paused after the
+	// exchange reports a rate limit violation
+	rateLimitBackoff = 30 * time.Second
 )
 
 // Websocket defines a return type for websocket connections via the interface
@@ -38,6 +60,7 @@ type Websocket struct {
 	proxyAddr                    string
 	defaultURL                   string
 	runningURL                   string
+	alternateURLs                []string
 	exchangeName                 string
 	m                            sync.Mutex
 	subscriptionMutex            sync.Mutex
@@ -48,6 +71,10 @@ type Websocket struct {
 	channelSubscriber            func(channelToSubscribe WebsocketChannelSubscription) error
 	channelUnsubscriber          func(channelToUnsubscribe WebsocketChannelSubscription) error
 	DataHandler                  chan interface{}
+	queue                        *DataQueue
+	subscribeBackoff             time.Time
+	// disconnected is when the websocket last lost its connection
+	disconnected time.Time
 	// ShutdownC is the main shutdown channel which controls all websocket go funcs
 	ShutdownC chan struct{}
 	// Orderbook is a local cache of orderbooks
@@ -60,6 +87,12 @@ type Websocket struct {
 	// ReadMessageErrors will received all errors from ws.ReadMessage() and verify if its a disconnection
 	ReadMessageErrors chan error
 	features          *protocol.Features
+	// connections multiplexes subscriptions over several connections when
+	// the exchange supplies a connection dialer
+	connections            *ConnectionManager
+	connectionSubscriber   func(conn *WebsocketConnection, channelToSubscribe WebsocketChannelSubscription) error
+	connectionUnsubscriber func(conn *WebsocketConnection, channelToUnsubscribe WebsocketChannelSubscription) error
+	dedicated              func(channel WebsocketChannelSubscription) bool
 }
 
 // WebsocketSetup defines variables for setting up a websocket connection
@@ -75,6 +108,35 @@ type WebsocketSetup struct {
 	Subscriber                       func(channelToSubscribe WebsocketChannelSubscription) error
 	UnSubscriber                     func(channelToUnsubscribe WebsocketChannelSubscription) error
 	Features                         *protocol.Features
+	// ConnectionDialer dials and starts reading a connection, enabling
+	// subscriptions to be multiplexed over as many connections as needed to
+	// stay within MaxSubscriptionsPerConnection. Multiplexed subscriptions
+	// are made with ConnectionSubscriber and ConnectionUnSubscriber on the
+	// connection they are assigned to.
+	ConnectionDialer              func() (*WebsocketConnection, error)
+	ConnectionSubscriber          func(conn *WebsocketConnection, channelToSubscribe WebsocketChannelSubscription) error
+	ConnectionUnSubscriber        func(conn *WebsocketConnection, channelToUnsubscribe WebsocketChannelSubscription) error
+	MaxSubscriptionsPerConnection int
+	// Dedicated reports subscriptions made on a dedicated connection, such
+	// as authenticated channels, which are subscribed with Subscriber and
+	// UnSubscriber rather than multiplexed
+	Dedicated func(channel WebsocketChannelSubscription) bool
+}
+
+// ConnectionManager multiplexes channel subscriptions over the fewest
+// connections to an exchange while respecting the amount of subscriptions the
+// exchange allows per connection
+type ConnectionManager struct {
+	maxSubscriptions int
+	dial             func() (*WebsocketConnection, error)
+	m                sync.Mutex
+	connections      []*managedConnection
+}
+
+// managedConnection is a connection and the subscriptions made on it
+type managedConnection struct {
+	conn          *WebsocketConnection
+	subscriptions []WebsocketChannelSubscription
 }
 
 // WebsocketChannelSubscription container for websocket subscriptions
@@ -123,6 +185,18 @@ type FundingData struct {
 	Side         string
 }
 
+// LiquidationData defines a liquidation feed, Side is that of the
+// liquidation order
+type LiquidationData struct {
+	Timestamp time.Time
+	Pair      currency.Pair
+	AssetType asset.Item
+	Exchange  string
+	Side      order.Side
+	Price     float64
+	Amount    float64
+}
+
 // KlineData defines kline feed
 type KlineData struct {
 	Timestamp  time.Time
@@ -131,7 +205,7 @@ type KlineData struct {
 	Exchange   string
 	StartTime  time.Time
 	CloseTime  time.Time
-	Interval   string
+	Interval   kline.Interval
 	OpenPrice  float64
 	ClosePrice float64
 	HighPrice  float64
@@ -147,15 +221,32 @@ type WebsocketPositionUpdated struct {
 	Exchange  string
 }
 
+// WebsocketAuthenticatedReconnect is sent once an authenticated websocket
+// reconnects, as updates to orders and balances may have been missed while it
+// was disconnected
+type WebsocketAuthenticatedReconnect struct {
+	Exchange     string
+	Disconnected time.Time
+}
+
 // WebsocketConnection contains all the data needed to send a message to a WS
 type WebsocketConnection struct {
+	// lastRead and lastMessage are the unix nano times anything and a data
+	// message were last read, accessed atomically so they come first to stay
+	// 64-bit aligned
+	lastRead    int64
+	lastMessage int64
 	sync.Mutex
 	Verbose         bool
 	connected       bool
+	closed          bool
+	compressed      bool
 	connectionMutex sync.RWMutex
 	RateLimit       float64
 	ExchangeName    string
 	URL             string
+	AlternateURLs   []string
+	failedURLs      map[string]time.Time
 	ProxyURL        string
 	Wg              sync.WaitGroup
 	Connection      *websocket.Conn
@@ -165,6 +256,105 @@ type WebsocketConnection struct {
 	ResponseCheckTimeout time.Duration
 	ResponseMaxLimit     time.Duration
 	TrafficTimeout       time.Duration
+	// DisableCompression stops permessage-deflate compression being
+	// negotiated when dialling
+	DisableCompression bool
+	// Keepalive pings the connection and reconnects it when it stops
+	// responding or goes quiet
+	Keepalive     KeepalivePolicy
+	keepaliveStop chan struct{}
+	// capture records the frames read, and replay supplies the frames read
+	// in place of a network connection
+	capture *Recorder
+	replay  *Replayer
+}
+
+// Frame is a websocket message as read from an exchange, before it is
+// decompressed
+type Frame struct {
+	Time time.Time `json:"time"`
+	Type int       `json:"type"`
+	Data []byte    `json:"data"`
+}
+
+// Recorder appends the frames read by an exchanges websocket connections to a
+// capture file as lines of JSON
+type Recorder struct {
+	m    sync.Mutex
+	file *os.File
+	enc  *json.Encoder
+}
+
+// Replayer feeds captured frames back to an exchanges websocket connections
+// in the order they were captured
+type Replayer struct {
+	m      sync.Mutex
+	frames []Frame
+	next   int
+}
+
+// ErrorKind classifies a websocket error so it can be acted on without
+// parsing its message
+type ErrorKind uint8
+
+// RecoveryAction is the standard response to a kind of websocket error
+type RecoveryAction uint8
+
+// Error is a websocket error reported by an exchange with its kind, sent to
+// DataHandler and handled with Websocket.HandleError
+type Error struct {
+	Exchange string
+	Kind     ErrorKind
+	// Subscription is the rejected subscription, if known
+	Subscription *WebsocketChannelSubscription
+	Err          error
+}
+
+// QueuePolicy sets what a DataQueue does with data once it is full
+type QueuePolicy uint8
+
+// DataQueue bounds the websocket data waiting to be processed, applying the
+// queue policy of each item once full so a stalled consumer can't exhaust
+// memory
+type DataQueue struct {
+	m         sync.Mutex
+	space     *sync.Cond
+	name      string
+	capacity  int
+	items     []*queueItem
+	pending   map[string]*queueItem
+	ready     chan struct{}
+	closed    bool
+	dropped   uint64
+	coalesced uint64
+}
+
+type queueItem struct {
+	data   interface{}
+	policy QueuePolicy
+	key    string
+}
+
+// QueueStats reports the state of a DataQueue
+type QueueStats struct {
+	Pending   int
+	Capacity  int
+	Dropped   uint64
+	Coalesced uint64
+}
+
+// KeepalivePolicy sets how a connection is pinged and when it is treated as
+// dead or stale, which closes it so the websocket reconnects
+type KeepalivePolicy struct {
+	// PingInterval is how often a ping control message is sent, zero
+	// disabling pings
+	PingInterval time.Duration
+	// PongTimeout is how long to wait after a ping for a pong or any other
+	// message, defaulting to PingInterval
+	PongTimeout time.Duration
+	// MaxSilence is how long the connection may go without a data message,
+	// zero disabling the check
+	MaxSilence time.Duration
 }
 
 // WebsocketPingHandler container for ping handler settings
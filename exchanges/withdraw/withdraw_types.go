@@ -43,6 +43,9 @@ type CryptoRequest struct {
 	Address    string
 	AddressTag string
 	FeeAmount  float64
+	// Chain selects the network to withdraw over for currencies available on
+	// more than one, the exchange's default network is used when unset
+	Chain string
 }
 
 // FiatRequest used for fiat withdrawal requests
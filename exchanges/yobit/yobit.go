@@ -1,6 +1,7 @@
 package yobit
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"net/http"
@@ -45,20 +46,20 @@ type Yobit struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (y *Yobit) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (y *Yobit) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // GetInfo returns the Yobit info
-func (y *Yobit) GetInfo() (Info, error) {
+func (y *Yobit) GetInfo(ctx context.Context) (Info, error) {
 	resp := Info{}
 	path := fmt.Sprintf("%s/%s/%s/", y.API.Endpoints.URL, apiPublicVersion, publicInfo)
 
-	return resp, y.SendHTTPRequest(path, &resp)
+	return resp, y.SendHTTPRequest(ctx, path, &resp)
 }
 
 // GetTicker returns a ticker for a specific currency
-func (y *Yobit) GetTicker(symbol string) (map[string]Ticker, error) {
+func (y *Yobit) GetTicker(ctx context.Context, symbol string) (map[string]Ticker, error) {
 	type Response struct {
 		Data map[string]Ticker
 	}
@@ -66,11 +67,11 @@ func (y *Yobit) GetTicker(symbol string) (map[string]Ticker, error) {
 	response := Response{}
 	path := fmt.Sprintf("%s/%s/%s/%s", y.API.Endpoints.URL, apiPublicVersion, publicTicker, symbol)
 
-	return response.Data, y.SendHTTPRequest(path, &response.Data)
+	return response.Data, y.SendHTTPRequest(ctx, path, &response.Data)
 }
 
 // GetDepth returns the depth for a specific currency
-func (y *Yobit) GetDepth(symbol string) (Orderbook, error) {
+func (y *Yobit) GetDepth(ctx context.Context, symbol string) (Orderbook, error) {
 	type Response struct {
 		Data map[string]Orderbook
 	}
@@ -79,11 +80,11 @@ func (y *Yobit) GetDepth(symbol string) (Orderbook, error) {
 	path := fmt.Sprintf("%s/%s/%s/%s", y.API.Endpoints.URL, apiPublicVersion, publicDepth, symbol)
 
 	return response.Data[symbol],
-		y.SendHTTPRequest(path, &response.Data)
+		y.SendHTTPRequest(ctx, path, &response.Data)
 }
 
 // GetTrades returns the trades for a specific currency
-func (y *Yobit) GetTrades(symbol string) ([]Trades, error) {
+func (y *Yobit) GetTrades(ctx context.Context, symbol string) ([]Trades, error) {
 	type Response struct {
 		Data map[string][]Trades
 	}
@@ -91,14 +92,14 @@ func (y *Yobit) GetTrades(symbol string) ([]Trades, error) {
 	response := Response{}
 	path := fmt.Sprintf("%s/%s/%s/%s", y.API.Endpoints.URL, apiPublicVersion, publicTrades, symbol)
 
-	return response.Data[symbol], y.SendHTTPRequest(path, &response.Data)
+	return response.Data[symbol], y.SendHTTPRequest(ctx, path, &response.Data)
 }
 
 // GetAccountInformation returns a users account info
-func (y *Yobit) GetAccountInformation() (AccountInfo, error) {
+func (y *Yobit) GetAccountInformation(ctx context.Context) (AccountInfo, error) {
 	result := AccountInfo{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateAccountInfo, url.Values{}, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateAccountInfo, url.Values{}, &result)
 	if err != nil {
 		return result, err
 	}
@@ -109,7 +110,7 @@ func (y *Yobit) GetAccountInformation() (AccountInfo, error) {
 }
 
 // Trade places an order and returns the order ID if successful or an error
-func (y *Yobit) Trade(pair, orderType string, amount, price float64) (int64, error) {
+func (y *Yobit) Trade(ctx context.Context, pair, orderType string, amount, price float64) (int64, error) {
 	req := url.Values{}
 	req.Add("pair", pair)
 	req.Add("type", strings.ToLower(orderType))
@@ -118,7 +119,7 @@ func (y *Yobit) Trade(pair, orderType string, amount, price float64) (int64, err
 
 	result := Trade{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateTrade, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateTrade, req, &result)
 	if err != nil {
 		return int64(result.OrderID), err
 	}
@@ -129,33 +130,33 @@ func (y *Yobit) Trade(pair, orderType string, amount, price float64) (int64, err
 }
 
 // GetOpenOrders returns the active orders for a specific currency
-func (y *Yobit) GetOpenOrders(pair string) (map[string]ActiveOrders, error) {
+func (y *Yobit) GetOpenOrders(ctx context.Context, pair string) (map[string]ActiveOrders, error) {
 	req := url.Values{}
 	req.Add("pair", pair)
 
 	result := map[string]ActiveOrders{}
 
-	return result, y.SendAuthenticatedHTTPRequest(privateActiveOrders, req, &result)
+	return result, y.SendAuthenticatedHTTPRequest(ctx, privateActiveOrders, req, &result)
 }
 
 // GetOrderInformation returns the order info for a specific order ID
-func (y *Yobit) GetOrderInformation(orderID int64) (map[string]OrderInfo, error) {
+func (y *Yobit) GetOrderInformation(ctx context.Context, orderID int64) (map[string]OrderInfo, error) {
 	req := url.Values{}
 	req.Add("order_id", strconv.FormatInt(orderID, 10))
 
 	result := map[string]OrderInfo{}
 
-	return result, y.SendAuthenticatedHTTPRequest(privateOrderInfo, req, &result)
+	return result, y.SendAuthenticatedHTTPRequest(ctx, privateOrderInfo, req, &result)
 }
 
 // CancelExistingOrder cancels an order for a specific order ID
-func (y *Yobit) CancelExistingOrder(orderID int64) error {
+func (y *Yobit) CancelExistingOrder(ctx context.Context, orderID int64) error {
 	req := url.Values{}
 	req.Add("order_id", strconv.FormatInt(orderID, 10))
 
 	result := CancelOrder{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateCancelOrder, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateCancelOrder, req, &result)
 	if err != nil {
 		return err
 	}
@@ -166,7 +167,7 @@ func (y *Yobit) CancelExistingOrder(orderID int64) error {
 }
 
 // GetTradeHistory returns the trade history
-func (y *Yobit) GetTradeHistory(tidFrom, count, tidEnd, since, end int64, order, pair string) (map[string]TradeHistory, error) {
+func (y *Yobit) GetTradeHistory(ctx context.Context, tidFrom, count, tidEnd, since, end int64, order, pair string) (map[string]TradeHistory, error) {
 	req := url.Values{}
 	req.Add("from", strconv.FormatInt(tidFrom, 10))
 	req.Add("count", strconv.FormatInt(count, 10))
@@ -179,7 +180,7 @@ func (y *Yobit) GetTradeHistory(tidFrom, count, tidEnd, since, end int64, order,
 
 	result := TradeHistoryResponse{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateTradeHistory, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateTradeHistory, req, &result)
 	if err != nil {
 		return nil, err
 	}
@@ -191,13 +192,13 @@ func (y *Yobit) GetTradeHistory(tidFrom, count, tidEnd, since, end int64, order,
 }
 
 // GetCryptoDepositAddress returns the deposit address for a specific currency
-func (y *Yobit) GetCryptoDepositAddress(coin string) (DepositAddress, error) {
+func (y *Yobit) GetCryptoDepositAddress(ctx context.Context, coin string) (DepositAddress, error) {
 	req := url.Values{}
 	req.Add("coinName", coin)
 
 	result := DepositAddress{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateGetDepositAddress, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateGetDepositAddress, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -208,7 +209,7 @@ func (y *Yobit) GetCryptoDepositAddress(coin string) (DepositAddress, error) {
 }
 
 // WithdrawCoinsToAddress initiates a withdrawal to a specified address
-func (y *Yobit) WithdrawCoinsToAddress(coin string, amount float64, address string) (WithdrawCoinsToAddress, error) {
+func (y *Yobit) WithdrawCoinsToAddress(ctx context.Context, coin string, amount float64, address string) (WithdrawCoinsToAddress, error) {
 	req := url.Values{}
 	req.Add("coinName", coin)
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
@@ -216,7 +217,7 @@ func (y *Yobit) WithdrawCoinsToAddress(coin string, amount float64, address stri
 
 	result := WithdrawCoinsToAddress{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateWithdrawCoinsToAddress, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateWithdrawCoinsToAddress, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -227,14 +228,14 @@ func (y *Yobit) WithdrawCoinsToAddress(coin string, amount float64, address stri
 }
 
 // CreateCoupon creates an exchange coupon for a sepcific currency
-func (y *Yobit) CreateCoupon(currency string, amount float64) (CreateCoupon, error) {
+func (y *Yobit) CreateCoupon(ctx context.Context, currency string, amount float64) (CreateCoupon, error) {
 	req := url.Values{}
 	req.Add("currency", currency)
 	req.Add("amount", strconv.FormatFloat(amount, 'f', -1, 64))
 
 	var result CreateCoupon
 
-	err := y.SendAuthenticatedHTTPRequest(privateCreateCoupon, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateCreateCoupon, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -245,13 +246,13 @@ func (y *Yobit) CreateCoupon(currency string, amount float64) (CreateCoupon, err
 }
 
 // RedeemCoupon redeems an exchange coupon
-func (y *Yobit) RedeemCoupon(coupon string) (RedeemCoupon, error) {
+func (y *Yobit) RedeemCoupon(ctx context.Context, coupon string) (RedeemCoupon, error) {
 	req := url.Values{}
 	req.Add("coupon", coupon)
 
 	result := RedeemCoupon{}
 
-	err := y.SendAuthenticatedHTTPRequest(privateRedeemCoupon, req, &result)
+	err := y.SendAuthenticatedHTTPRequest(ctx, privateRedeemCoupon, req, &result)
 	if err != nil {
 		return result, err
 	}
@@ -262,8 +263,8 @@ func (y *Yobit) RedeemCoupon(coupon string) (RedeemCoupon, error) {
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (y *Yobit) SendHTTPRequest(path string, result interface{}) error {
-	return y.SendPayload(&request.Item{
+func (y *Yobit) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return y.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -274,7 +275,7 @@ func (y *Yobit) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends an authenticated HTTP request to Yobit
-func (y *Yobit) SendAuthenticatedHTTPRequest(path string, params url.Values, result interface{}) (err error) {
+func (y *Yobit) SendAuthenticatedHTTPRequest(ctx context.Context, path string, params url.Values, result interface{}) (err error) {
 	if !y.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, y.Name)
 	}
@@ -303,7 +304,7 @@ func (y *Yobit) SendAuthenticatedHTTPRequest(path string, params url.Values, res
 	headers["Sign"] = crypto.HexEncodeToString(hmac)
 	headers["Content-Type"] = "application/x-www-form-urlencoded"
 
-	return y.SendPayload(&request.Item{
+	return y.SendPayload(ctx, &request.Item{
 		Method:        http.MethodPost,
 		Path:          apiPrivateURL,
 		Headers:       headers,
@@ -1,6 +1,7 @@
 package yobit
 
 import (
+	"context"
 	"log"
 	"math"
 	"os"
@@ -8,6 +9,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/currency"
@@ -50,7 +52,7 @@ func TestMain(m *testing.M) {
 
 func TestFetchTradablePairs(t *testing.T) {
 	t.Parallel()
-	_, err := y.FetchTradablePairs(asset.Spot)
+	_, err := y.FetchTradablePairs(context.Background(), asset.Spot)
 	if err != nil {
 		t.Errorf("FetchTradablePairs err: %s", err)
 	}
@@ -58,7 +60,7 @@ func TestFetchTradablePairs(t *testing.T) {
 
 func TestGetInfo(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetInfo()
+	_, err := y.GetInfo(context.Background())
 	if err != nil {
 		t.Error("GetInfo() error")
 	}
@@ -66,7 +68,7 @@ func TestGetInfo(t *testing.T) {
 
 func TestGetTicker(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetTicker("btc_usd")
+	_, err := y.GetTicker(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("GetTicker() error", err)
 	}
@@ -74,7 +76,7 @@ func TestGetTicker(t *testing.T) {
 
 func TestGetDepth(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetDepth("btc_usd")
+	_, err := y.GetDepth(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("GetDepth() error", err)
 	}
@@ -82,7 +84,7 @@ func TestGetDepth(t *testing.T) {
 
 func TestGetTrades(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetTrades("btc_usd")
+	_, err := y.GetTrades(context.Background(), "btc_usd")
 	if err != nil {
 		t.Error("GetTrades() error", err)
 	}
@@ -90,7 +92,7 @@ func TestGetTrades(t *testing.T) {
 
 func TestGetAccountInfo(t *testing.T) {
 	t.Parallel()
-	_, err := y.UpdateAccountInfo()
+	_, err := y.UpdateAccountInfo(context.Background())
 	if err == nil {
 		t.Error("GetAccountInfo() Expected error")
 	}
@@ -98,7 +100,7 @@ func TestGetAccountInfo(t *testing.T) {
 
 func TestGetOpenOrders(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetOpenOrders("")
+	_, err := y.GetOpenOrders(context.Background(), "")
 	if err == nil {
 		t.Error("GetOpenOrders() Expected error")
 	}
@@ -106,7 +108,7 @@ func TestGetOpenOrders(t *testing.T) {
 
 func TestGetOrderInfo(t *testing.T) {
 	t.Parallel()
-	_, err := y.GetOrderInfo("6196974")
+	_, err := y.GetOrderInfo(context.Background(), "6196974")
 	if err == nil {
 		t.Error("GetOrderInfo() Expected error")
 	}
@@ -114,7 +116,7 @@ func TestGetOrderInfo(t *testing.T) {
 
 func TestCancelOrder(t *testing.T) {
 	t.Parallel()
-	err := y.CancelExistingOrder(1337)
+	err := y.CancelExistingOrder(context.Background(), 1337)
 	if err == nil {
 		t.Error("CancelOrder() Expected error")
 	}
@@ -122,7 +124,7 @@ func TestCancelOrder(t *testing.T) {
 
 func TestTrade(t *testing.T) {
 	t.Parallel()
-	_, err := y.Trade("", order.Buy.String(), 0, 0)
+	_, err := y.Trade(context.Background(), "", order.Buy.String(), 0, 0)
 	if err == nil {
 		t.Error("Trade() Expected error")
 	}
@@ -130,7 +132,7 @@ func TestTrade(t *testing.T) {
 
 func TestWithdrawCoinsToAddress(t *testing.T) {
 	t.Parallel()
-	_, err := y.WithdrawCoinsToAddress("", 0, "")
+	_, err := y.WithdrawCoinsToAddress(context.Background(), "", 0, "")
 	if err == nil {
 		t.Error("WithdrawCoinsToAddress() Expected error")
 	}
@@ -138,7 +140,7 @@ func TestWithdrawCoinsToAddress(t *testing.T) {
 
 func TestCreateYobicode(t *testing.T) {
 	t.Parallel()
-	_, err := y.CreateCoupon("bla", 0)
+	_, err := y.CreateCoupon(context.Background(), "bla", 0)
 	if err == nil {
 		t.Error("CreateYobicode() Expected error")
 	}
@@ -146,7 +148,7 @@ func TestCreateYobicode(t *testing.T) {
 
 func TestRedeemYobicode(t *testing.T) {
 	t.Parallel()
-	_, err := y.RedeemCoupon("bla2")
+	_, err := y.RedeemCoupon(context.Background(), "bla2")
 	if err == nil {
 		t.Error("RedeemYobicode() Expected error")
 	}
@@ -168,7 +170,7 @@ func setFeeBuilder() *exchange.FeeBuilder {
 // TestGetFeeByTypeOfflineTradeFee logic test
 func TestGetFeeByTypeOfflineTradeFee(t *testing.T) {
 	var feeBuilder = setFeeBuilder()
-	y.GetFeeByType(feeBuilder)
+	y.GetFeeByType(context.Background(), feeBuilder)
 	if !areTestAPIKeysSet() {
 		if feeBuilder.FeeType != exchange.OfflineTradeFee {
 			t.Errorf("Expected %v, received %v", exchange.OfflineTradeFee, feeBuilder.FeeType)
@@ -331,7 +333,7 @@ func TestGetActiveOrders(t *testing.T) {
 			currency.BTC)},
 	}
 
-	_, err := y.GetActiveOrders(&getOrdersRequest)
+	_, err := y.GetActiveOrders(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get open orders: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -348,7 +350,7 @@ func TestGetOrderHistory(t *testing.T) {
 		EndTicks:   time.Unix(math.MaxInt64, 0),
 	}
 
-	_, err := y.GetOrderHistory(&getOrdersRequest)
+	_, err := y.GetOrderHistory(context.Background(), &getOrdersRequest)
 	if areTestAPIKeysSet() && err != nil {
 		t.Errorf("Could not get order history: %s", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -375,11 +377,11 @@ func TestSubmitOrder(t *testing.T) {
 		},
 		OrderSide: order.Buy,
 		OrderType: order.Limit,
-		Price:     1,
-		Amount:    1,
+		Price:     decimal.NewFromInt(1),
+		Amount:    decimal.NewFromInt(1),
 		ClientID:  "meowOrder",
 	}
-	response, err := y.SubmitOrder(orderSubmission)
+	response, err := y.SubmitOrder(context.Background(), orderSubmission)
 	if areTestAPIKeysSet() && (err != nil || !response.IsOrderPlaced) {
 		t.Errorf("Order failed to be placed: %v", err)
 	} else if !areTestAPIKeysSet() && err == nil {
@@ -400,7 +402,7 @@ func TestCancelExchangeOrder(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	err := y.CancelOrder(orderCancellation)
+	err := y.CancelOrder(context.Background(), orderCancellation)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -422,7 +424,7 @@ func TestCancelAllExchangeOrders(t *testing.T) {
 		CurrencyPair:  currencyPair,
 	}
 
-	resp, err := y.CancelAllOrders(orderCancellation)
+	resp, err := y.CancelAllOrders(context.Background(), orderCancellation)
 
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
@@ -440,7 +442,7 @@ func TestModifyOrder(t *testing.T) {
 	if areTestAPIKeysSet() && !canManipulateRealOrders {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
-	_, err := y.ModifyOrder(&order.Modify{})
+	_, err := y.ModifyOrder(context.Background(), &order.Modify{})
 	if err == nil {
 		t.Error("ModifyOrder() Expected error")
 	}
@@ -460,7 +462,7 @@ func TestWithdraw(t *testing.T) {
 		t.Skip("API keys set, canManipulateRealOrders false, skipping test")
 	}
 
-	_, err := y.WithdrawCryptocurrencyFunds(&withdrawCryptoRequest)
+	_, err := y.WithdrawCryptocurrencyFunds(context.Background(), &withdrawCryptoRequest)
 	if !areTestAPIKeysSet() && err == nil {
 		t.Error("Expecting an error when no keys are set")
 	}
@@ -475,7 +477,7 @@ func TestWithdrawFiat(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := y.WithdrawFiatFunds(&withdrawFiatRequest)
+	_, err := y.WithdrawFiatFunds(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -489,7 +491,7 @@ func TestWithdrawInternationalBank(t *testing.T) {
 	}
 
 	var withdrawFiatRequest = withdraw.FiatRequest{}
-	_, err := y.WithdrawFiatFundsToInternationalBank(&withdrawFiatRequest)
+	_, err := y.WithdrawFiatFundsToInternationalBank(context.Background(), &withdrawFiatRequest)
 	if err != common.ErrFunctionNotSupported {
 		t.Errorf("Expected '%v', received: '%v'",
 			common.ErrFunctionNotSupported,
@@ -499,12 +501,12 @@ func TestWithdrawInternationalBank(t *testing.T) {
 
 func TestGetDepositAddress(t *testing.T) {
 	if areTestAPIKeysSet() {
-		_, err := y.GetDepositAddress(currency.BTC, "")
+		_, err := y.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err != nil {
 			t.Error("GetDepositAddress() Expected error")
 		}
 	} else {
-		_, err := y.GetDepositAddress(currency.BTC, "")
+		_, err := y.GetDepositAddress(context.Background(), currency.BTC, "")
 		if err == nil {
 			t.Error("GetDepositAddress() error")
 		}
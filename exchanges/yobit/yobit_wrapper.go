@@ -1,6 +1,7 @@
 package yobit
 
 import (
+	"context"
 	"errors"
 	"math"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +40,7 @@ func (y *Yobit) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if y.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = y.UpdateTradablePairs(true)
+		err = y.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -141,7 +143,7 @@ func (y *Yobit) Run() {
 		return
 	}
 
-	err := y.UpdateTradablePairs(false)
+	err := y.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys,
 			"%s failed to update tradable pairs. Err: %s",
@@ -151,8 +153,8 @@ func (y *Yobit) Run() {
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (y *Yobit) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	info, err := y.GetInfo()
+func (y *Yobit) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	info, err := y.GetInfo(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -167,8 +169,8 @@ func (y *Yobit) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (y *Yobit) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := y.FetchTradablePairs(asset.Spot)
+func (y *Yobit) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := y.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -177,14 +179,14 @@ func (y *Yobit) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (y *Yobit) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (y *Yobit) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
 	pairsCollated, err := y.FormatExchangeCurrencies(y.GetEnabledPairs(assetType), assetType)
 	if err != nil {
 		return tickerPrice, err
 	}
 
-	result, err := y.GetTicker(pairsCollated)
+	result, err := y.GetTicker(ctx, pairsCollated)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -215,27 +217,27 @@ func (y *Yobit) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Pri
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (y *Yobit) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (y *Yobit) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tick, err := ticker.GetTicker(y.Name, p, assetType)
 	if err != nil {
-		return y.UpdateTicker(p, assetType)
+		return y.UpdateTicker(ctx, p, assetType)
 	}
 	return tick, nil
 }
 
 // FetchOrderbook returns the orderbook for a currency pair
-func (y *Yobit) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (y *Yobit) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(y.Name, p, assetType)
 	if err != nil {
-		return y.UpdateOrderbook(p, assetType)
+		return y.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (y *Yobit) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (y *Yobit) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
-	orderbookNew, err := y.GetDepth(y.FormatExchangeCurrency(p, assetType).String())
+	orderbookNew, err := y.GetDepth(ctx, y.FormatExchangeCurrency(p, assetType).String())
 	if err != nil {
 		return orderBook, err
 	}
@@ -270,10 +272,10 @@ func (y *Yobit) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbo
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // Yobit exchange
-func (y *Yobit) UpdateAccountInfo() (account.Holdings, error) {
+func (y *Yobit) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var response account.Holdings
 	response.Exchange = y.Name
-	accountBalance, err := y.GetAccountInformation()
+	accountBalance, err := y.GetAccountInformation(ctx)
 	if err != nil {
 		return response, err
 	}
@@ -282,11 +284,11 @@ func (y *Yobit) UpdateAccountInfo() (account.Holdings, error) {
 	for x, y := range accountBalance.FundsInclOrders {
 		var exchangeCurrency account.Balance
 		exchangeCurrency.CurrencyName = currency.NewCode(x)
-		exchangeCurrency.TotalValue = y
-		exchangeCurrency.Hold = 0
+		exchangeCurrency.TotalValue = decimal.NewFromFloat(y)
+		exchangeCurrency.Hold = decimal.Zero
 		for z, w := range accountBalance.Funds {
 			if z == x {
-				exchangeCurrency.Hold = y - w
+				exchangeCurrency.Hold = decimal.NewFromFloat(y).Sub(decimal.NewFromFloat(w))
 			}
 		}
 
@@ -306,10 +308,10 @@ func (y *Yobit) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (y *Yobit) FetchAccountInfo() (account.Holdings, error) {
+func (y *Yobit) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(y.Name)
 	if err != nil {
-		return y.UpdateAccountInfo()
+		return y.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -317,18 +319,41 @@ func (y *Yobit) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (y *Yobit) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (y *Yobit) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (y *Yobit) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (y *Yobit) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (y *Yobit) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (y *Yobit) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (y *Yobit) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (y *Yobit) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
 // Yobit only supports limit orders
-func (y *Yobit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
+func (y *Yobit) SubmitOrder(ctx context.Context, s *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	if err := s.Validate(); err != nil {
 		return submitOrderResponse, err
@@ -338,10 +363,10 @@ func (y *Yobit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 		return submitOrderResponse, errors.New("only limit orders are allowed")
 	}
 
-	response, err := y.Trade(s.Pair.String(),
+	response, err := y.Trade(ctx, s.Pair.String(),
 		s.OrderSide.String(),
-		s.Amount,
-		s.Price)
+		s.Amount.Float64(),
+		s.Price.Float64())
 	if err != nil {
 		return submitOrderResponse, err
 	}
@@ -355,22 +380,22 @@ func (y *Yobit) SubmitOrder(s *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (y *Yobit) ModifyOrder(action *order.Modify) (string, error) {
+func (y *Yobit) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (y *Yobit) CancelOrder(order *order.Cancel) error {
+func (y *Yobit) CancelOrder(ctx context.Context, order *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(order.OrderID, 10, 64)
 	if err != nil {
 		return err
 	}
 
-	return y.CancelExistingOrder(orderIDInt)
+	return y.CancelExistingOrder(ctx, orderIDInt)
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (y *Yobit) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (y *Yobit) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
@@ -379,7 +404,7 @@ func (y *Yobit) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error
 	enabledPairs := y.GetEnabledPairs(asset.Spot)
 	for i := range enabledPairs {
 		fCurr := y.FormatExchangeCurrency(enabledPairs[i], asset.Spot).String()
-		activeOrdersForPair, err := y.GetOpenOrders(fCurr)
+		activeOrdersForPair, err := y.GetOpenOrders(ctx, fCurr)
 		if err != nil {
 			return cancelAllOrdersResponse, err
 		}
@@ -395,7 +420,7 @@ func (y *Yobit) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error
 				continue
 			}
 
-			err = y.CancelExistingOrder(orderIDInt)
+			err = y.CancelExistingOrder(ctx, orderIDInt)
 			if err != nil {
 				cancelAllOrdersResponse.Status[key] = err.Error()
 			}
@@ -406,14 +431,14 @@ func (y *Yobit) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error
 }
 
 // GetOrderInfo returns information on a current open order
-func (y *Yobit) GetOrderInfo(orderID string) (order.Detail, error) {
+func (y *Yobit) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (y *Yobit) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	a, err := y.GetCryptoDepositAddress(cryptocurrency.String())
+func (y *Yobit) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	a, err := y.GetCryptoDepositAddress(ctx, cryptocurrency.String())
 	if err != nil {
 		return "", err
 	}
@@ -423,8 +448,8 @@ func (y *Yobit) GetDepositAddress(cryptocurrency currency.Code, _ string) (strin
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (y *Yobit) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	resp, err := y.WithdrawCoinsToAddress(withdrawRequest.Currency.String(), withdrawRequest.Amount, withdrawRequest.Address)
+func (y *Yobit) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	resp, err := y.WithdrawCoinsToAddress(ctx, withdrawRequest.Currency.String(), withdrawRequest.Amount, withdrawRequest.Address)
 	if err != nil {
 		return "", err
 	}
@@ -436,13 +461,13 @@ func (y *Yobit) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequ
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (y *Yobit) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (y *Yobit) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (y *Yobit) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (y *Yobit) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -452,7 +477,7 @@ func (y *Yobit) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (y *Yobit) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (y *Yobit) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !y.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -461,11 +486,11 @@ func (y *Yobit) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 }
 
 // GetActiveOrders retrieves any orders that are active/open
-func (y *Yobit) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (y *Yobit) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var orders []order.Detail
 	for x := range req.Currencies {
 		fCurr := y.FormatExchangeCurrency(req.Currencies[x], asset.Spot).String()
-		resp, err := y.GetOpenOrders(fCurr)
+		resp, err := y.GetOpenOrders(ctx, fCurr)
 		if err != nil {
 			return nil, err
 		}
@@ -477,8 +502,8 @@ func (y *Yobit) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 			side := order.Side(strings.ToUpper(resp[id].Type))
 			orders = append(orders, order.Detail{
 				ID:           id,
-				Amount:       resp[id].Amount,
-				Price:        resp[id].Rate,
+				Amount:       decimal.NewFromFloat(resp[id].Amount),
+				Price:        decimal.NewFromFloat(resp[id].Rate),
 				OrderSide:    side,
 				OrderDate:    orderDate,
 				CurrencyPair: symbol,
@@ -494,10 +519,10 @@ func (y *Yobit) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, er
 
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
-func (y *Yobit) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (y *Yobit) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var allOrders []TradeHistory
 	for x := range req.Currencies {
-		resp, err := y.GetTradeHistory(0,
+		resp, err := y.GetTradeHistory(ctx, 0,
 			10000,
 			math.MaxInt64,
 			req.StartTicks.Unix(),
@@ -521,8 +546,8 @@ func (y *Yobit) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, er
 		side := order.Side(strings.ToUpper(allOrders[i].Type))
 		orders = append(orders, order.Detail{
 			ID:           strconv.FormatFloat(allOrders[i].OrderID, 'f', -1, 64),
-			Amount:       allOrders[i].Amount,
-			Price:        allOrders[i].Rate,
+			Amount:       decimal.NewFromFloat(allOrders[i].Amount),
+			Price:        decimal.NewFromFloat(allOrders[i].Rate),
 			OrderSide:    side,
 			OrderDate:    orderDate,
 			CurrencyPair: symbol,
@@ -559,7 +584,12 @@ func (y *Yobit) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (y *Yobit) ValidateCredentials() error {
-	_, err := y.UpdateAccountInfo()
+func (y *Yobit) ValidateCredentials(ctx context.Context) error {
+	_, err := y.UpdateAccountInfo(ctx)
 	return y.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (y *Yobit) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
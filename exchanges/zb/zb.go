@@ -1,6 +1,7 @@
 package zb
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"fmt"
@@ -50,12 +51,12 @@ type ZB struct {
 }
 
 // GetHistoricCandles returns rangesize number of candles for the given granularity and pair starting from the latest available
-func (z *ZB) GetHistoricCandles(pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
+func (z *ZB) GetHistoricCandles(ctx context.Context, pair currency.Pair, rangesize, granularity int64) ([]exchange.Candle, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SpotNewOrder submits an order to ZB
-func (z *ZB) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
+func (z *ZB) SpotNewOrder(ctx context.Context, arg SpotNewOrderRequestParams) (int64, error) {
 	var result SpotNewOrderResponse
 
 	vals := url.Values{}
@@ -66,7 +67,7 @@ func (z *ZB) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 	vals.Set("price", strconv.FormatFloat(arg.Price, 'f', -1, 64))
 	vals.Set("tradeType", string(arg.Type))
 
-	err := z.SendAuthenticatedHTTPRequest(http.MethodGet, vals, &result)
+	err := z.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, vals, &result)
 	if err != nil {
 		return 0, err
 	}
@@ -81,7 +82,7 @@ func (z *ZB) SpotNewOrder(arg SpotNewOrderRequestParams) (int64, error) {
 }
 
 // CancelExistingOrder cancels an order
-func (z *ZB) CancelExistingOrder(orderID int64, symbol string) error {
+func (z *ZB) CancelExistingOrder(ctx context.Context, orderID int64, symbol string) error {
 	type response struct {
 		Code    int    `json:"code"`    // Result code
 		Message string `json:"message"` // Result Message
@@ -94,7 +95,7 @@ func (z *ZB) CancelExistingOrder(orderID int64, symbol string) error {
 	vals.Set("currency", symbol)
 
 	var result response
-	err := z.SendAuthenticatedHTTPRequest(http.MethodGet, vals, &result)
+	err := z.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, vals, &result)
 	if err != nil {
 		return err
 	}
@@ -107,18 +108,18 @@ func (z *ZB) CancelExistingOrder(orderID int64, symbol string) error {
 
 // GetAccountInformation returns account information including coin information
 // and pricing
-func (z *ZB) GetAccountInformation() (AccountsResponse, error) {
+func (z *ZB) GetAccountInformation(ctx context.Context) (AccountsResponse, error) {
 	var result AccountsResponse
 
 	vals := url.Values{}
 	vals.Set("accesskey", z.API.Credentials.Key)
 	vals.Set("method", "getAccountInfo")
 
-	return result, z.SendAuthenticatedHTTPRequest(http.MethodGet, vals, &result)
+	return result, z.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, vals, &result)
 }
 
 // GetUnfinishedOrdersIgnoreTradeType returns unfinished orders
-func (z *ZB) GetUnfinishedOrdersIgnoreTradeType(currency string, pageindex, pagesize int64) ([]Order, error) {
+func (z *ZB) GetUnfinishedOrdersIgnoreTradeType(ctx context.Context, currency string, pageindex, pagesize int64) ([]Order, error) {
 	var result []Order
 	vals := url.Values{}
 	vals.Set("accesskey", z.API.Credentials.Key)
@@ -127,12 +128,12 @@ func (z *ZB) GetUnfinishedOrdersIgnoreTradeType(currency string, pageindex, page
 	vals.Set("pageIndex", strconv.FormatInt(pageindex, 10))
 	vals.Set("pageSize", strconv.FormatInt(pagesize, 10))
 
-	err := z.SendAuthenticatedHTTPRequest(http.MethodGet, vals, &result)
+	err := z.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, vals, &result)
 	return result, err
 }
 
 // GetOrders returns finished orders
-func (z *ZB) GetOrders(currency string, pageindex, side int64) ([]Order, error) {
+func (z *ZB) GetOrders(ctx context.Context, currency string, pageindex, side int64) ([]Order, error) {
 	var response []Order
 	vals := url.Values{}
 	vals.Set("accesskey", z.API.Credentials.Key)
@@ -140,16 +141,16 @@ func (z *ZB) GetOrders(currency string, pageindex, side int64) ([]Order, error)
 	vals.Set("currency", currency)
 	vals.Set("pageIndex", strconv.FormatInt(pageindex, 10))
 	vals.Set("tradeType", strconv.FormatInt(side, 10))
-	return response, z.SendAuthenticatedHTTPRequest(http.MethodGet, vals, &response)
+	return response, z.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, vals, &response)
 }
 
 // GetMarkets returns market information including pricing, symbols and
 // each symbols decimal precision
-func (z *ZB) GetMarkets() (map[string]MarketResponseItem, error) {
+func (z *ZB) GetMarkets(ctx context.Context) (map[string]MarketResponseItem, error) {
 	endpoint := fmt.Sprintf("%s/%s/%s", z.API.Endpoints.URL, zbAPIVersion, zbMarkets)
 
 	var res map[string]MarketResponseItem
-	err := z.SendHTTPRequest(endpoint, &res)
+	err := z.SendHTTPRequest(ctx, endpoint, &res)
 	if err != nil {
 		return nil, err
 	}
@@ -161,8 +162,8 @@ func (z *ZB) GetMarkets() (map[string]MarketResponseItem, error) {
 //
 // symbol: string of currency pair
 // 获取最新价格
-func (z *ZB) GetLatestSpotPrice(symbol string) (float64, error) {
-	res, err := z.GetTicker(symbol)
+func (z *ZB) GetLatestSpotPrice(ctx context.Context, symbol string) (float64, error) {
+	res, err := z.GetTicker(ctx, symbol)
 
 	if err != nil {
 		return 0, err
@@ -172,27 +173,27 @@ func (z *ZB) GetLatestSpotPrice(symbol string) (float64, error) {
 }
 
 // GetTicker returns a ticker for a given symbol
-func (z *ZB) GetTicker(symbol string) (TickerResponse, error) {
+func (z *ZB) GetTicker(ctx context.Context, symbol string) (TickerResponse, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s?market=%s", z.API.Endpoints.URL, zbAPIVersion, zbTicker, symbol)
 	var res TickerResponse
-	err := z.SendHTTPRequest(urlPath, &res)
+	err := z.SendHTTPRequest(ctx, urlPath, &res)
 	return res, err
 }
 
 // GetTickers returns ticker data for all supported symbols
-func (z *ZB) GetTickers() (map[string]TickerChildResponse, error) {
+func (z *ZB) GetTickers(ctx context.Context) (map[string]TickerChildResponse, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s", z.API.Endpoints.URL, zbAPIVersion, zbTickers)
 	resp := make(map[string]TickerChildResponse)
-	err := z.SendHTTPRequest(urlPath, &resp)
+	err := z.SendHTTPRequest(ctx, urlPath, &resp)
 	return resp, err
 }
 
 // GetOrderbook returns the orderbook for a given symbol
-func (z *ZB) GetOrderbook(symbol string) (OrderbookResponse, error) {
+func (z *ZB) GetOrderbook(ctx context.Context, symbol string) (OrderbookResponse, error) {
 	urlPath := fmt.Sprintf("%s/%s/%s?market=%s", z.API.Endpoints.URL, zbAPIVersion, zbDepth, symbol)
 	var res OrderbookResponse
 
-	err := z.SendHTTPRequest(urlPath, &res)
+	err := z.SendHTTPRequest(ctx, urlPath, &res)
 	if err != nil {
 		return res, err
 	}
@@ -216,7 +217,7 @@ func (z *ZB) GetOrderbook(symbol string) (OrderbookResponse, error) {
 }
 
 // GetSpotKline returns Kline data
-func (z *ZB) GetSpotKline(arg KlinesRequestParams) (KLineResponse, error) {
+func (z *ZB) GetSpotKline(ctx context.Context, arg KlinesRequestParams) (KLineResponse, error) {
 	vals := url.Values{}
 	vals.Set("type", string(arg.Type))
 	vals.Set("market", arg.Symbol)
@@ -231,7 +232,7 @@ func (z *ZB) GetSpotKline(arg KlinesRequestParams) (KLineResponse, error) {
 
 	var res KLineResponse
 	var rawKlines map[string]interface{}
-	err := z.SendHTTPRequest(urlPath, &rawKlines)
+	err := z.SendHTTPRequest(ctx, urlPath, &rawKlines)
 	if err != nil {
 		return res, err
 	}
@@ -270,7 +271,7 @@ func (z *ZB) GetSpotKline(arg KlinesRequestParams) (KLineResponse, error) {
 // NOTE - PLEASE BE AWARE THAT YOU NEED TO GENERATE A DEPOSIT ADDRESS VIA
 // LOGGING IN AND NOT BY USING THIS ENDPOINT OTHERWISE THIS WILL GIVE YOU A
 // GENERAL ERROR RESPONSE.
-func (z *ZB) GetCryptoAddress(currency currency.Code) (UserAddress, error) {
+func (z *ZB) GetCryptoAddress(ctx context.Context, currency currency.Code) (UserAddress, error) {
 	var resp UserAddress
 
 	vals := url.Values{}
@@ -278,12 +279,12 @@ func (z *ZB) GetCryptoAddress(currency currency.Code) (UserAddress, error) {
 	vals.Set("currency", currency.Lower().String())
 
 	return resp,
-		z.SendAuthenticatedHTTPRequest(http.MethodGet, vals, &resp)
+		z.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, vals, &resp)
 }
 
 // SendHTTPRequest sends an unauthenticated HTTP request
-func (z *ZB) SendHTTPRequest(path string, result interface{}) error {
-	return z.SendPayload(&request.Item{
+func (z *ZB) SendHTTPRequest(ctx context.Context, path string, result interface{}) error {
+	return z.SendPayload(ctx, &request.Item{
 		Method:        http.MethodGet,
 		Path:          path,
 		Result:        result,
@@ -294,7 +295,7 @@ func (z *ZB) SendHTTPRequest(path string, result interface{}) error {
 }
 
 // SendAuthenticatedHTTPRequest sends authenticated requests to the zb API
-func (z *ZB) SendAuthenticatedHTTPRequest(httpMethod string, params url.Values, result interface{}) error {
+func (z *ZB) SendAuthenticatedHTTPRequest(ctx context.Context, httpMethod string, params url.Values, result interface{}) error {
 	if !z.AllowAuthenticatedRequest() {
 		return fmt.Errorf(exchange.WarningAuthenticatedRequestWithoutCredentialsSet, z.Name)
 	}
@@ -305,7 +306,7 @@ func (z *ZB) SendAuthenticatedHTTPRequest(httpMethod string, params url.Values,
 		[]byte(params.Encode()),
 		[]byte(crypto.Sha1ToHex(z.API.Credentials.Secret)))
 
-	params.Set("reqTime", fmt.Sprintf("%d", convert.UnixMillis(time.Now())))
+	params.Set("reqTime", fmt.Sprintf("%d", convert.UnixMillis(z.Requester.Now())))
 	params.Set("sign", fmt.Sprintf("%x", hmac))
 
 	urlPath := fmt.Sprintf("%s/%s?%s",
@@ -320,7 +321,7 @@ func (z *ZB) SendAuthenticatedHTTPRequest(httpMethod string, params url.Values,
 		Message string `json:"message"`
 	}{}
 
-	err := z.SendPayload(&request.Item{
+	err := z.SendPayload(ctx, &request.Item{
 		Method:        httpMethod,
 		Path:          urlPath,
 		Body:          strings.NewReader(""),
@@ -410,7 +411,7 @@ var errorCode = map[int64]string{
 }
 
 // Withdraw transfers funds
-func (z *ZB) Withdraw(currency, address, safepassword string, amount, fees float64, itransfer bool) (string, error) {
+func (z *ZB) Withdraw(ctx context.Context, currency, address, safepassword string, amount, fees float64, itransfer bool) (string, error) {
 	type response struct {
 		Code    int    `json:"code"`    // Result code
 		Message string `json:"message"` // Result Message
@@ -428,7 +429,7 @@ func (z *ZB) Withdraw(currency, address, safepassword string, amount, fees float
 	vals.Set("safePwd", safepassword)
 
 	var resp response
-	err := z.SendAuthenticatedHTTPRequest(http.MethodGet, vals, &resp)
+	err := z.SendAuthenticatedHTTPRequest(ctx, http.MethodGet, vals, &resp)
 	if err != nil {
 		return "", err
 	}
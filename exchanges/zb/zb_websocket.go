@@ -10,6 +10,7 @@ import (
 	"time"
 
 	"github.com/gorilla/websocket"
+	"github.com/thrasher-corp/gocryptotrader/common/convert"
 	"github.com/thrasher-corp/gocryptotrader/common/crypto"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -67,7 +68,7 @@ func (z *ZB) WsHandleData() {
 			var result Generic
 			err = json.Unmarshal(fixedJSON, &result)
 			if err != nil {
-				z.Websocket.DataHandler <- err
+				z.Websocket.DataHandler <- wshandler.NewError(z.Name, wshandler.ErrorMalformedMessage, err)
 				continue
 			}
 			if result.No > 0 {
@@ -83,7 +84,7 @@ func (z *ZB) WsHandleData() {
 				var markets Markets
 				err := json.Unmarshal(result.Data, &markets)
 				if err != nil {
-					z.Websocket.DataHandler <- err
+					z.Websocket.DataHandler <- wshandler.NewError(z.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -92,7 +93,7 @@ func (z *ZB) WsHandleData() {
 				var wsTicker WsTicker
 				err := json.Unmarshal(fixedJSON, &wsTicker)
 				if err != nil {
-					z.Websocket.DataHandler <- err
+					z.Websocket.DataHandler <- wshandler.NewError(z.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -105,7 +106,7 @@ func (z *ZB) WsHandleData() {
 					Last:         wsTicker.Data.Last,
 					Bid:          wsTicker.Data.Buy,
 					Ask:          wsTicker.Data.Sell,
-					LastUpdated:  time.Unix(0, wsTicker.Date*int64(time.Millisecond)),
+					LastUpdated:  convert.TimeFromUnix(wsTicker.Date, time.Millisecond),
 					AssetType:    asset.Spot,
 					Pair:         currency.NewPairFromString(cPair[0]),
 				}
@@ -114,7 +115,7 @@ func (z *ZB) WsHandleData() {
 				var depth WsDepth
 				err := json.Unmarshal(fixedJSON, &depth)
 				if err != nil {
-					z.Websocket.DataHandler <- err
+					z.Websocket.DataHandler <- wshandler.NewError(z.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 
@@ -159,7 +160,7 @@ func (z *ZB) WsHandleData() {
 				var trades WsTrades
 				err := json.Unmarshal(fixedJSON, &trades)
 				if err != nil {
-					z.Websocket.DataHandler <- err
+					z.Websocket.DataHandler <- wshandler.NewError(z.Name, wshandler.ErrorMalformedMessage, err)
 					continue
 				}
 				// Most up to date trade
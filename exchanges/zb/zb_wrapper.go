@@ -1,6 +1,7 @@
 package zb
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
@@ -9,6 +10,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -38,7 +40,7 @@ func (z *ZB) GetDefaultConfig() (*config.ExchangeConfig, error) {
 	}
 
 	if z.Features.Supports.RESTCapabilities.AutoPairUpdates {
-		err = z.UpdateTradablePairs(true)
+		err = z.UpdateTradablePairs(context.Background(), true)
 		if err != nil {
 			return nil, err
 		}
@@ -156,11 +158,14 @@ func (z *ZB) Setup(exch *config.ExchangeConfig) error {
 	z.WebsocketConn = &wshandler.WebsocketConnection{
 		ExchangeName:         z.Name,
 		URL:                  z.Websocket.GetWebsocketURL(),
+		AlternateURLs:        z.Websocket.GetAlternateURLs(),
 		ProxyURL:             z.Websocket.GetProxyAddress(),
 		Verbose:              z.Verbose,
 		RateLimit:            zbWebsocketRateLimit,
 		ResponseCheckTimeout: exch.WebsocketResponseCheckTimeout,
 		ResponseMaxLimit:     exch.WebsocketResponseMaxLimit,
+		DisableCompression:   exch.DisableWebsocketCompression,
+		Keepalive:            wshandler.NewKeepalivePolicy(exch.WebsocketKeepalive),
 	}
 	return nil
 }
@@ -184,15 +189,15 @@ func (z *ZB) Run() {
 		return
 	}
 
-	err := z.UpdateTradablePairs(false)
+	err := z.UpdateTradablePairs(context.Background(), false)
 	if err != nil {
 		log.Errorf(log.ExchangeSys, "%s failed to update tradable pairs. Err: %s", z.Name, err)
 	}
 }
 
 // FetchTradablePairs returns a list of the exchanges tradable pairs
-func (z *ZB) FetchTradablePairs(asset asset.Item) ([]string, error) {
-	markets, err := z.GetMarkets()
+func (z *ZB) FetchTradablePairs(ctx context.Context, asset asset.Item) ([]string, error) {
+	markets, err := z.GetMarkets(ctx)
 	if err != nil {
 		return nil, err
 	}
@@ -207,8 +212,8 @@ func (z *ZB) FetchTradablePairs(asset asset.Item) ([]string, error) {
 
 // UpdateTradablePairs updates the exchanges available pairs and stores
 // them in the exchanges config
-func (z *ZB) UpdateTradablePairs(forceUpdate bool) error {
-	pairs, err := z.FetchTradablePairs(asset.Spot)
+func (z *ZB) UpdateTradablePairs(ctx context.Context, forceUpdate bool) error {
+	pairs, err := z.FetchTradablePairs(ctx, asset.Spot)
 	if err != nil {
 		return err
 	}
@@ -216,10 +221,10 @@ func (z *ZB) UpdateTradablePairs(forceUpdate bool) error {
 }
 
 // UpdateTicker updates and returns the ticker for a currency pair
-func (z *ZB) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (z *ZB) UpdateTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerPrice := new(ticker.Price)
 
-	result, err := z.GetTickers()
+	result, err := z.GetTickers(ctx)
 	if err != nil {
 		return tickerPrice, err
 	}
@@ -251,29 +256,29 @@ func (z *ZB) UpdateTicker(p currency.Pair, assetType asset.Item) (*ticker.Price,
 }
 
 // FetchTicker returns the ticker for a currency pair
-func (z *ZB) FetchTicker(p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
+func (z *ZB) FetchTicker(ctx context.Context, p currency.Pair, assetType asset.Item) (*ticker.Price, error) {
 	tickerNew, err := ticker.GetTicker(z.Name, p, assetType)
 	if err != nil {
-		return z.UpdateTicker(p, assetType)
+		return z.UpdateTicker(ctx, p, assetType)
 	}
 	return tickerNew, nil
 }
 
 // FetchOrderbook returns orderbook base on the currency pair
-func (z *ZB) FetchOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (z *ZB) FetchOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	ob, err := orderbook.Get(z.Name, p, assetType)
 	if err != nil {
-		return z.UpdateOrderbook(p, assetType)
+		return z.UpdateOrderbook(ctx, p, assetType)
 	}
 	return ob, nil
 }
 
 // UpdateOrderbook updates and returns the orderbook for a currency pair
-func (z *ZB) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
+func (z *ZB) UpdateOrderbook(ctx context.Context, p currency.Pair, assetType asset.Item) (*orderbook.Base, error) {
 	orderBook := new(orderbook.Base)
 	curr := z.FormatExchangeCurrency(p, assetType).String()
 
-	orderbookNew, err := z.GetOrderbook(curr)
+	orderbookNew, err := z.GetOrderbook(ctx, curr)
 	if err != nil {
 		return orderBook, err
 	}
@@ -306,7 +311,7 @@ func (z *ZB) UpdateOrderbook(p currency.Pair, assetType asset.Item) (*orderbook.
 
 // UpdateAccountInfo retrieves balances for all enabled currencies for the
 // ZB exchange
-func (z *ZB) UpdateAccountInfo() (account.Holdings, error) {
+func (z *ZB) UpdateAccountInfo(ctx context.Context) (account.Holdings, error) {
 	var info account.Holdings
 	var balances []account.Balance
 	var coins []AccountsResponseCoin
@@ -317,7 +322,7 @@ func (z *ZB) UpdateAccountInfo() (account.Holdings, error) {
 		}
 		coins = resp.Data.Coins
 	} else {
-		bal, err := z.GetAccountInformation()
+		bal, err := z.GetAccountInformation(ctx)
 		if err != nil {
 			return info, err
 		}
@@ -337,8 +342,8 @@ func (z *ZB) UpdateAccountInfo() (account.Holdings, error) {
 
 		balances = append(balances, account.Balance{
 			CurrencyName: currency.NewCode(coins[i].EnName),
-			TotalValue:   hold + avail,
-			Hold:         hold,
+			TotalValue:   decimal.NewFromFloat(hold).Add(decimal.NewFromFloat(avail)),
+			Hold:         decimal.NewFromFloat(hold),
 		})
 	}
 
@@ -356,10 +361,10 @@ func (z *ZB) UpdateAccountInfo() (account.Holdings, error) {
 }
 
 // FetchAccountInfo retrieves balances for all enabled currencies
-func (z *ZB) FetchAccountInfo() (account.Holdings, error) {
+func (z *ZB) FetchAccountInfo(ctx context.Context) (account.Holdings, error) {
 	acc, err := account.GetHoldings(z.Name)
 	if err != nil {
-		return z.UpdateAccountInfo()
+		return z.UpdateAccountInfo(ctx)
 	}
 
 	return acc, nil
@@ -367,17 +372,40 @@ func (z *ZB) FetchAccountInfo() (account.Holdings, error) {
 
 // GetFundingHistory returns funding history, deposits and
 // withdrawals
-func (z *ZB) GetFundingHistory() ([]exchange.FundHistory, error) {
+func (z *ZB) GetFundingHistory(ctx context.Context) ([]exchange.FundHistory, error) {
 	return nil, common.ErrFunctionNotSupported
 }
 
+// GetFundingPayments returns funding paid or received on perpetual futures
+// positions
+func (z *ZB) GetFundingPayments(ctx context.Context, p currency.Pair, assetType asset.Item, start, end time.Time) ([]exchange.FundingPayment, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// GetMarginPositions returns the leveraged positions held and the margin
+// backing them
+func (z *ZB) GetMarginPositions(ctx context.Context) ([]exchange.MarginPosition, error) {
+	return nil, common.ErrFunctionNotSupported
+}
+
+// AddMargin transfers margin from the account balance to an isolated position
+func (z *ZB) AddMargin(ctx context.Context, p currency.Pair, assetType asset.Item, amount decimal.Decimal) error {
+	return common.ErrFunctionNotSupported
+}
+
+// GetOpenInterest returns the open interest and positioning of a derivatives
+// contract
+func (z *ZB) GetOpenInterest(ctx context.Context, p currency.Pair, assetType asset.Item) (exchange.OpenInterest, error) {
+	return exchange.OpenInterest{}, common.ErrFunctionNotSupported
+}
+
 // GetExchangeHistory returns historic trade data since exchange opening.
-func (z *ZB) GetExchangeHistory(p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
+func (z *ZB) GetExchangeHistory(ctx context.Context, p currency.Pair, assetType asset.Item) ([]exchange.TradeHistory, error) {
 	return nil, common.ErrNotYetImplemented
 }
 
 // SubmitOrder submits a new order
-func (z *ZB) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
+func (z *ZB) SubmitOrder(ctx context.Context, o *order.Submit) (order.SubmitResponse, error) {
 	var submitOrderResponse order.SubmitResponse
 	err := o.Validate()
 	if err != nil {
@@ -391,7 +419,7 @@ func (z *ZB) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 			isBuyOrder = 0
 		}
 		var response *WsSubmitOrderResponse
-		response, err = z.wsSubmitOrder(o.Pair, o.Amount, o.Price, isBuyOrder)
+		response, err = z.wsSubmitOrder(o.Pair, o.Amount.Float64(), o.Price.Float64(), isBuyOrder)
 		if err != nil {
 			return submitOrderResponse, err
 		}
@@ -405,13 +433,13 @@ func (z *ZB) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 		}
 
 		var params = SpotNewOrderRequestParams{
-			Amount: o.Amount,
-			Price:  o.Price,
+			Amount: o.Amount.Float64(),
+			Price:  o.Price.Float64(),
 			Symbol: o.Pair.Lower().String(),
 			Type:   oT,
 		}
 		var response int64
-		response, err = z.SpotNewOrder(params)
+		response, err = z.SpotNewOrder(ctx, params)
 		if err != nil {
 			return submitOrderResponse, err
 		}
@@ -428,12 +456,12 @@ func (z *ZB) SubmitOrder(o *order.Submit) (order.SubmitResponse, error) {
 
 // ModifyOrder will allow of changing orderbook placement and limit to
 // market conversion
-func (z *ZB) ModifyOrder(action *order.Modify) (string, error) {
+func (z *ZB) ModifyOrder(ctx context.Context, action *order.Modify) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // CancelOrder cancels an order by its corresponding ID number
-func (z *ZB) CancelOrder(o *order.Cancel) error {
+func (z *ZB) CancelOrder(ctx context.Context, o *order.Cancel) error {
 	orderIDInt, err := strconv.ParseInt(o.OrderID, 10, 64)
 	if err != nil {
 		return err
@@ -450,12 +478,12 @@ func (z *ZB) CancelOrder(o *order.Cancel) error {
 		}
 		return nil
 	}
-	return z.CancelExistingOrder(orderIDInt, z.FormatExchangeCurrency(o.CurrencyPair,
+	return z.CancelExistingOrder(ctx, orderIDInt, z.FormatExchangeCurrency(o.CurrencyPair,
 		o.AssetType).String())
 }
 
 // CancelAllOrders cancels all orders associated with a currency pair
-func (z *ZB) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
+func (z *ZB) CancelAllOrders(ctx context.Context, _ *order.Cancel) (order.CancelAllResponse, error) {
 	cancelAllOrdersResponse := order.CancelAllResponse{
 		Status: make(map[string]string),
 	}
@@ -464,7 +492,7 @@ func (z *ZB) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
 	for x := range enabledPairs {
 		fPair := z.FormatExchangeCurrency(enabledPairs[x], asset.Spot).String()
 		for y := int64(1); ; y++ {
-			openOrders, err := z.GetUnfinishedOrdersIgnoreTradeType(fPair, y, 10)
+			openOrders, err := z.GetUnfinishedOrdersIgnoreTradeType(ctx, fPair, y, 10)
 			if err != nil {
 				if strings.Contains(err.Error(), "3001") {
 					break
@@ -485,7 +513,7 @@ func (z *ZB) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
 	}
 
 	for i := range allOpenOrders {
-		err := z.CancelOrder(&order.Cancel{
+		err := z.CancelOrder(ctx, &order.Cancel{
 			OrderID:      strconv.FormatInt(allOpenOrders[i].ID, 10),
 			CurrencyPair: currency.NewPairFromString(allOpenOrders[i].Currency),
 		})
@@ -498,14 +526,14 @@ func (z *ZB) CancelAllOrders(_ *order.Cancel) (order.CancelAllResponse, error) {
 }
 
 // GetOrderInfo returns information on a current open order
-func (z *ZB) GetOrderInfo(orderID string) (order.Detail, error) {
+func (z *ZB) GetOrderInfo(ctx context.Context, orderID string) (order.Detail, error) {
 	var orderDetail order.Detail
 	return orderDetail, common.ErrNotYetImplemented
 }
 
 // GetDepositAddress returns a deposit address for a specified currency
-func (z *ZB) GetDepositAddress(cryptocurrency currency.Code, _ string) (string, error) {
-	address, err := z.GetCryptoAddress(cryptocurrency)
+func (z *ZB) GetDepositAddress(ctx context.Context, cryptocurrency currency.Code, _ string) (string, error) {
+	address, err := z.GetCryptoAddress(ctx, cryptocurrency)
 	if err != nil {
 		return "", err
 	}
@@ -515,19 +543,19 @@ func (z *ZB) GetDepositAddress(cryptocurrency currency.Code, _ string) (string,
 
 // WithdrawCryptocurrencyFunds returns a withdrawal ID when a withdrawal is
 // submitted
-func (z *ZB) WithdrawCryptocurrencyFunds(withdrawRequest *withdraw.CryptoRequest) (string, error) {
-	return z.Withdraw(withdrawRequest.Currency.Lower().String(), withdrawRequest.Address, withdrawRequest.TradePassword, withdrawRequest.Amount, withdrawRequest.FeeAmount, false)
+func (z *ZB) WithdrawCryptocurrencyFunds(ctx context.Context, withdrawRequest *withdraw.CryptoRequest) (string, error) {
+	return z.Withdraw(ctx, withdrawRequest.Currency.Lower().String(), withdrawRequest.Address, withdrawRequest.TradePassword, withdrawRequest.Amount, withdrawRequest.FeeAmount, false)
 }
 
 // WithdrawFiatFunds returns a withdrawal ID when a
 // withdrawal is submitted
-func (z *ZB) WithdrawFiatFunds(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (z *ZB) WithdrawFiatFunds(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
 // WithdrawFiatFundsToInternationalBank returns a withdrawal ID when a
 // withdrawal is submitted
-func (z *ZB) WithdrawFiatFundsToInternationalBank(withdrawRequest *withdraw.FiatRequest) (string, error) {
+func (z *ZB) WithdrawFiatFundsToInternationalBank(ctx context.Context, withdrawRequest *withdraw.FiatRequest) (string, error) {
 	return "", common.ErrFunctionNotSupported
 }
 
@@ -537,7 +565,7 @@ func (z *ZB) GetWebsocket() (*wshandler.Websocket, error) {
 }
 
 // GetFeeByType returns an estimate of fee based on type of transaction
-func (z *ZB) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
+func (z *ZB) GetFeeByType(ctx context.Context, feeBuilder *exchange.FeeBuilder) (float64, error) {
 	if !z.AllowAuthenticatedRequest() && // Todo check connection status
 		feeBuilder.FeeType == exchange.CryptocurrencyTradeFee {
 		feeBuilder.FeeType = exchange.OfflineTradeFee
@@ -547,12 +575,12 @@ func (z *ZB) GetFeeByType(feeBuilder *exchange.FeeBuilder) (float64, error) {
 
 // GetActiveOrders retrieves any orders that are active/open
 // This function is not concurrency safe due to orderSide/orderType maps
-func (z *ZB) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (z *ZB) GetActiveOrders(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	var allOrders []Order
 	for x := range req.Currencies {
 		for i := int64(1); ; i++ {
 			fPair := z.FormatExchangeCurrency(req.Currencies[x], asset.Spot).String()
-			resp, err := z.GetUnfinishedOrdersIgnoreTradeType(fPair, i, 10)
+			resp, err := z.GetUnfinishedOrdersIgnoreTradeType(ctx, fPair, i, 10)
 			if err != nil {
 				if strings.Contains(err.Error(), "3001") {
 					break
@@ -580,10 +608,10 @@ func (z *ZB) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error
 		orderSide := orderSideMap[allOrders[i].Type]
 		orders = append(orders, order.Detail{
 			ID:           strconv.FormatInt(allOrders[i].ID, 10),
-			Amount:       allOrders[i].TotalAmount,
+			Amount:       decimal.NewFromFloat(allOrders[i].TotalAmount),
 			Exchange:     z.Name,
 			OrderDate:    orderDate,
-			Price:        allOrders[i].Price,
+			Price:        decimal.NewFromFloat(allOrders[i].Price),
 			OrderSide:    orderSide,
 			CurrencyPair: symbol,
 		})
@@ -597,7 +625,7 @@ func (z *ZB) GetActiveOrders(req *order.GetOrdersRequest) ([]order.Detail, error
 // GetOrderHistory retrieves account order information
 // Can Limit response to specific order status
 // This function is not concurrency safe due to orderSide/orderType maps
-func (z *ZB) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error) {
+func (z *ZB) GetOrderHistory(ctx context.Context, req *order.GetOrdersRequest) ([]order.Detail, error) {
 	if req.OrderSide == order.AnySide || req.OrderSide == "" {
 		return nil, errors.New("specific order side is required")
 	}
@@ -625,7 +653,7 @@ func (z *ZB) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error
 		for x := range req.Currencies {
 			for y := int64(1); ; y++ {
 				fPair := z.FormatExchangeCurrency(req.Currencies[x], asset.Spot).String()
-				resp, err := z.GetOrders(fPair, y, side)
+				resp, err := z.GetOrders(ctx, fPair, y, side)
 				if err != nil {
 					return nil, err
 				}
@@ -647,10 +675,10 @@ func (z *ZB) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error
 		orderSide := orderSideMap[allOrders[i].Type]
 		orders = append(orders, order.Detail{
 			ID:           strconv.FormatInt(allOrders[i].ID, 10),
-			Amount:       allOrders[i].TotalAmount,
+			Amount:       decimal.NewFromFloat(allOrders[i].TotalAmount),
 			Exchange:     z.Name,
 			OrderDate:    orderDate,
-			Price:        allOrders[i].Price,
+			Price:        decimal.NewFromFloat(allOrders[i].Price),
 			OrderSide:    orderSide,
 			CurrencyPair: symbol,
 		})
@@ -660,11 +688,10 @@ func (z *ZB) GetOrderHistory(req *order.GetOrdersRequest) ([]order.Detail, error
 	return orders, nil
 }
 
-// SubscribeToWebsocketChannels appends to ChannelsToSubscribe
-// which lets websocket.manageSubscriptions handle subscribing
+// SubscribeToWebsocketChannels subscribes to channels on the running
+// websocket connection, keeping them subscribed across reconnections
 func (z *ZB) SubscribeToWebsocketChannels(channels []wshandler.WebsocketChannelSubscription) error {
-	z.Websocket.SubscribeToChannels(channels)
-	return nil
+	return z.Websocket.AddSubscriptions(channels)
 }
 
 // UnsubscribeToWebsocketChannels removes from ChannelsToSubscribe
@@ -685,7 +712,12 @@ func (z *ZB) AuthenticateWebsocket() error {
 
 // ValidateCredentials validates current credentials used for wrapper
 // functionality
-func (z *ZB) ValidateCredentials() error {
-	_, err := z.UpdateAccountInfo()
+func (z *ZB) ValidateCredentials(ctx context.Context) error {
+	_, err := z.UpdateAccountInfo(ctx)
 	return z.CheckTransientError(err)
 }
+
+// GetAPIKeyPermissions returns the permissions granted to the API key
+func (z *ZB) GetAPIKeyPermissions(ctx context.Context) (exchange.APIKeyPermissions, error) {
+	return exchange.APIKeyPermissions{}, common.ErrFunctionNotSupported
+}
@@ -2007,6 +2007,7 @@ func (m *GetPortfolioResponse) GetPortfolio() []*PortfolioAddress {
 }
 
 type GetPortfolioSummaryRequest struct {
+	FiatCurrencies       []string `protobuf:"bytes,1,rep,name=fiat_currencies,json=fiatCurrencies,proto3" json:"fiat_currencies,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -2037,14 +2038,22 @@ func (m *GetPortfolioSummaryRequest) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_GetPortfolioSummaryRequest proto.InternalMessageInfo
 
+func (m *GetPortfolioSummaryRequest) GetFiatCurrencies() []string {
+	if m != nil {
+		return m.FiatCurrencies
+	}
+	return nil
+}
+
 type Coin struct {
-	Coin                 string   `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
-	Balance              float64  `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
-	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
-	Percentage           float64  `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+	Coin                 string             `protobuf:"bytes,1,opt,name=coin,proto3" json:"coin,omitempty"`
+	Balance              float64            `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
+	Address              string             `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Percentage           float64            `protobuf:"fixed64,4,opt,name=percentage,proto3" json:"percentage,omitempty"`
+	FiatValues           map[string]float64 `protobuf:"bytes,5,rep,name=fiat_values,json=fiatValues,proto3" json:"fiat_values,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
 func (m *Coin) Reset()         { *m = Coin{} }
@@ -2100,6 +2109,13 @@ func (m *Coin) GetPercentage() float64 {
 	return 0
 }
 
+func (m *Coin) GetFiatValues() map[string]float64 {
+	if m != nil {
+		return m.FiatValues
+	}
+	return nil
+}
+
 type OfflineCoinSummary struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	Balance              float64  `protobuf:"fixed64,2,opt,name=balance,proto3" json:"balance,omitempty"`
@@ -2286,6 +2302,9 @@ type GetPortfolioSummaryResponse struct {
 	CoinsOfflineSummary  map[string]*OfflineCoins `protobuf:"bytes,3,rep,name=coins_offline_summary,json=coinsOfflineSummary,proto3" json:"coins_offline_summary,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
 	CoinsOnline          []*Coin                  `protobuf:"bytes,4,rep,name=coins_online,json=coinsOnline,proto3" json:"coins_online,omitempty"`
 	CoinsOnlineSummary   map[string]*OnlineCoins  `protobuf:"bytes,5,rep,name=coins_online_summary,json=coinsOnlineSummary,proto3" json:"coins_online_summary,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	FiatCurrencies       []string                 `protobuf:"bytes,6,rep,name=fiat_currencies,json=fiatCurrencies,proto3" json:"fiat_currencies,omitempty"`
+	FiatTotals           map[string]float64       `protobuf:"bytes,7,rep,name=fiat_totals,json=fiatTotals,proto3" json:"fiat_totals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Unpriced             []string                 `protobuf:"bytes,8,rep,name=unpriced,proto3" json:"unpriced,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
 	XXX_unrecognized     []byte                   `json:"-"`
 	XXX_sizecache        int32                    `json:"-"`
@@ -2351,6 +2370,27 @@ func (m *GetPortfolioSummaryResponse) GetCoinsOnlineSummary() map[string]*Online
 	return nil
 }
 
+func (m *GetPortfolioSummaryResponse) GetFiatCurrencies() []string {
+	if m != nil {
+		return m.FiatCurrencies
+	}
+	return nil
+}
+
+func (m *GetPortfolioSummaryResponse) GetFiatTotals() map[string]float64 {
+	if m != nil {
+		return m.FiatTotals
+	}
+	return nil
+}
+
+func (m *GetPortfolioSummaryResponse) GetUnpriced() []string {
+	if m != nil {
+		return m.Unpriced
+	}
+	return nil
+}
+
 type AddPortfolioAddressRequest struct {
 	Address              string   `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
 	CoinType             string   `protobuf:"bytes,2,opt,name=coin_type,json=coinType,proto3" json:"coin_type,omitempty"`
@@ -3097,6 +3137,11 @@ type SubmitOrderRequest struct {
 	Amount               float64       `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
 	Price                float64       `protobuf:"fixed64,6,opt,name=price,proto3" json:"price,omitempty"`
 	ClientId             string        `protobuf:"bytes,7,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	StrategyId           string        `protobuf:"bytes,8,opt,name=strategy_id,json=strategyId,proto3" json:"strategy_id,omitempty"`
+	PartialFillPolicy    string        `protobuf:"bytes,9,opt,name=partial_fill_policy,json=partialFillPolicy,proto3" json:"partial_fill_policy,omitempty"`
+	PartialFillTimeout   string        `protobuf:"bytes,10,opt,name=partial_fill_timeout,json=partialFillTimeout,proto3" json:"partial_fill_timeout,omitempty"`
+	TimeInForce          string        `protobuf:"bytes,11,opt,name=time_in_force,json=timeInForce,proto3" json:"time_in_force,omitempty"`
+	ExpiresAt            string        `protobuf:"bytes,12,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
 	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
 	XXX_unrecognized     []byte        `json:"-"`
 	XXX_sizecache        int32         `json:"-"`
@@ -3176,9 +3221,46 @@ func (m *SubmitOrderRequest) GetClientId() string {
 	return ""
 }
 
+func (m *SubmitOrderRequest) GetStrategyId() string {
+	if m != nil {
+		return m.StrategyId
+	}
+	return ""
+}
+
+func (m *SubmitOrderRequest) GetPartialFillPolicy() string {
+	if m != nil {
+		return m.PartialFillPolicy
+	}
+	return ""
+}
+
+func (m *SubmitOrderRequest) GetPartialFillTimeout() string {
+	if m != nil {
+		return m.PartialFillTimeout
+	}
+	return ""
+}
+
+func (m *SubmitOrderRequest) GetTimeInForce() string {
+	if m != nil {
+		return m.TimeInForce
+	}
+	return ""
+}
+
+func (m *SubmitOrderRequest) GetExpiresAt() string {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return ""
+}
+
 type SubmitOrderResponse struct {
 	OrderPlaced          bool     `protobuf:"varint,1,opt,name=order_placed,json=orderPlaced,proto3" json:"order_placed,omitempty"`
 	OrderId              string   `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	CorrelationId        string   `protobuf:"bytes,3,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	ClientId             string   `protobuf:"bytes,4,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -3223,6 +3305,20 @@ func (m *SubmitOrderResponse) GetOrderId() string {
 	return ""
 }
 
+func (m *SubmitOrderResponse) GetCorrelationId() string {
+	if m != nil {
+		return m.CorrelationId
+	}
+	return ""
+}
+
+func (m *SubmitOrderResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
 type SimulateOrderRequest struct {
 	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
@@ -4575,6 +4671,61 @@ func (m *SetLoggerDetailsRequest) GetLevel() string {
 	return ""
 }
 
+type SetLogLevelRequest struct {
+	Subsystem            string   `protobuf:"bytes,1,opt,name=subsystem,proto3" json:"subsystem,omitempty"`
+	Exchange             string   `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Level                string   `protobuf:"bytes,3,opt,name=level,proto3" json:"level,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SetLogLevelRequest) Reset()         { *m = SetLogLevelRequest{} }
+func (m *SetLogLevelRequest) String() string { return proto.CompactTextString(m) }
+func (*SetLogLevelRequest) ProtoMessage()    {}
+func (*SetLogLevelRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+}
+
+func (m *SetLogLevelRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SetLogLevelRequest.Unmarshal(m, b)
+}
+func (m *SetLogLevelRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SetLogLevelRequest.Marshal(b, m, deterministic)
+}
+func (m *SetLogLevelRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SetLogLevelRequest.Merge(m, src)
+}
+func (m *SetLogLevelRequest) XXX_Size() int {
+	return xxx_messageInfo_SetLogLevelRequest.Size(m)
+}
+func (m *SetLogLevelRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SetLogLevelRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SetLogLevelRequest proto.InternalMessageInfo
+
+func (m *SetLogLevelRequest) GetSubsystem() string {
+	if m != nil {
+		return m.Subsystem
+	}
+	return ""
+}
+
+func (m *SetLogLevelRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *SetLogLevelRequest) GetLevel() string {
+	if m != nil {
+		return m.Level
+	}
+	return ""
+}
+
 type GetExchangePairsRequest struct {
 	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	Asset                string   `protobuf:"bytes,2,opt,name=asset,proto3" json:"asset,omitempty"`
@@ -4587,7 +4738,7 @@ func (m *GetExchangePairsRequest) Reset()         { *m = GetExchangePairsRequest
 func (m *GetExchangePairsRequest) String() string { return proto.CompactTextString(m) }
 func (*GetExchangePairsRequest) ProtoMessage()    {}
 func (*GetExchangePairsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{89}
+	return fileDescriptor_77a6da22d6a3feb1, []int{90}
 }
 
 func (m *GetExchangePairsRequest) XXX_Unmarshal(b []byte) error {
@@ -4633,7 +4784,7 @@ func (m *GetExchangePairsResponse) Reset()         { *m = GetExchangePairsRespon
 func (m *GetExchangePairsResponse) String() string { return proto.CompactTextString(m) }
 func (*GetExchangePairsResponse) ProtoMessage()    {}
 func (*GetExchangePairsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{90}
+	return fileDescriptor_77a6da22d6a3feb1, []int{91}
 }
 
 func (m *GetExchangePairsResponse) XXX_Unmarshal(b []byte) error {
@@ -4674,7 +4825,7 @@ func (m *ExchangePairRequest) Reset()         { *m = ExchangePairRequest{} }
 func (m *ExchangePairRequest) String() string { return proto.CompactTextString(m) }
 func (*ExchangePairRequest) ProtoMessage()    {}
 func (*ExchangePairRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{91}
+	return fileDescriptor_77a6da22d6a3feb1, []int{92}
 }
 
 func (m *ExchangePairRequest) XXX_Unmarshal(b []byte) error {
@@ -4729,7 +4880,7 @@ func (m *GetOrderbookStreamRequest) Reset()         { *m = GetOrderbookStreamReq
 func (m *GetOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetOrderbookStreamRequest) ProtoMessage()    {}
 func (*GetOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{92}
+	return fileDescriptor_77a6da22d6a3feb1, []int{93}
 }
 
 func (m *GetOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -4782,7 +4933,7 @@ func (m *GetExchangeOrderbookStreamRequest) Reset()         { *m = GetExchangeOr
 func (m *GetExchangeOrderbookStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetExchangeOrderbookStreamRequest) ProtoMessage()    {}
 func (*GetExchangeOrderbookStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{93}
+	return fileDescriptor_77a6da22d6a3feb1, []int{94}
 }
 
 func (m *GetExchangeOrderbookStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -4823,7 +4974,7 @@ func (m *GetTickerStreamRequest) Reset()         { *m = GetTickerStreamRequest{}
 func (m *GetTickerStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetTickerStreamRequest) ProtoMessage()    {}
 func (*GetTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{94}
+	return fileDescriptor_77a6da22d6a3feb1, []int{95}
 }
 
 func (m *GetTickerStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -4876,7 +5027,7 @@ func (m *GetExchangeTickerStreamRequest) Reset()         { *m = GetExchangeTicke
 func (m *GetExchangeTickerStreamRequest) String() string { return proto.CompactTextString(m) }
 func (*GetExchangeTickerStreamRequest) ProtoMessage()    {}
 func (*GetExchangeTickerStreamRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{95}
+	return fileDescriptor_77a6da22d6a3feb1, []int{96}
 }
 
 func (m *GetExchangeTickerStreamRequest) XXX_Unmarshal(b []byte) error {
@@ -4919,7 +5070,7 @@ func (m *GetAuditEventRequest) Reset()         { *m = GetAuditEventRequest{} }
 func (m *GetAuditEventRequest) String() string { return proto.CompactTextString(m) }
 func (*GetAuditEventRequest) ProtoMessage()    {}
 func (*GetAuditEventRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{96}
+	return fileDescriptor_77a6da22d6a3feb1, []int{97}
 }
 
 func (m *GetAuditEventRequest) XXX_Unmarshal(b []byte) error {
@@ -4986,7 +5137,7 @@ func (m *GetAuditEventResponse) Reset()         { *m = GetAuditEventResponse{} }
 func (m *GetAuditEventResponse) String() string { return proto.CompactTextString(m) }
 func (*GetAuditEventResponse) ProtoMessage()    {}
 func (*GetAuditEventResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{97}
+	return fileDescriptor_77a6da22d6a3feb1, []int{98}
 }
 
 func (m *GetAuditEventResponse) XXX_Unmarshal(b []byte) error {
@@ -5028,7 +5179,7 @@ func (m *GetHistoricCandlesRequest) Reset()         { *m = GetHistoricCandlesReq
 func (m *GetHistoricCandlesRequest) String() string { return proto.CompactTextString(m) }
 func (*GetHistoricCandlesRequest) ProtoMessage()    {}
 func (*GetHistoricCandlesRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{98}
+	return fileDescriptor_77a6da22d6a3feb1, []int{99}
 }
 
 func (m *GetHistoricCandlesRequest) XXX_Unmarshal(b []byte) error {
@@ -5088,7 +5239,7 @@ func (m *GetHistoricCandlesResponse) Reset()         { *m = GetHistoricCandlesRe
 func (m *GetHistoricCandlesResponse) String() string { return proto.CompactTextString(m) }
 func (*GetHistoricCandlesResponse) ProtoMessage()    {}
 func (*GetHistoricCandlesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{99}
+	return fileDescriptor_77a6da22d6a3feb1, []int{100}
 }
 
 func (m *GetHistoricCandlesResponse) XXX_Unmarshal(b []byte) error {
@@ -5132,7 +5283,7 @@ func (m *Candle) Reset()         { *m = Candle{} }
 func (m *Candle) String() string { return proto.CompactTextString(m) }
 func (*Candle) ProtoMessage()    {}
 func (*Candle) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{100}
+	return fileDescriptor_77a6da22d6a3feb1, []int{101}
 }
 
 func (m *Candle) XXX_Unmarshal(b []byte) error {
@@ -5195,3179 +5346,8954 @@ func (m *Candle) GetVolume() float64 {
 	return 0
 }
 
-type AuditEvent struct {
-	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
-	Identifier           string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
-	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
-	Timestamp            string   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+type GetExchangeHealthRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
 }
 
-func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
-func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
-func (*AuditEvent) ProtoMessage()    {}
-func (*AuditEvent) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{101}
+func (m *GetExchangeHealthRequest) Reset()         { *m = GetExchangeHealthRequest{} }
+func (m *GetExchangeHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeHealthRequest) ProtoMessage()    {}
+func (*GetExchangeHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{102}
 }
 
-func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+func (m *GetExchangeHealthRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeHealthRequest.Unmarshal(m, b)
 }
-func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+func (m *GetExchangeHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeHealthRequest.Marshal(b, m, deterministic)
 }
-func (m *AuditEvent) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_AuditEvent.Merge(m, src)
+func (m *GetExchangeHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeHealthRequest.Merge(m, src)
 }
-func (m *AuditEvent) XXX_Size() int {
-	return xxx_messageInfo_AuditEvent.Size(m)
+func (m *GetExchangeHealthRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeHealthRequest.Size(m)
 }
-func (m *AuditEvent) XXX_DiscardUnknown() {
-	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+func (m *GetExchangeHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeHealthRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+var xxx_messageInfo_GetExchangeHealthRequest proto.InternalMessageInfo
 
-func (m *AuditEvent) GetType() string {
+func (m *GetExchangeHealthRequest) GetExchange() string {
 	if m != nil {
-		return m.Type
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *AuditEvent) GetIdentifier() string {
-	if m != nil {
-		return m.Identifier
-	}
-	return ""
+type GetExchangeHealthResponse struct {
+	Exchanges            []*ExchangeHealth `protobuf:"bytes,1,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *AuditEvent) GetMessage() string {
-	if m != nil {
-		return m.Message
-	}
-	return ""
+func (m *GetExchangeHealthResponse) Reset()         { *m = GetExchangeHealthResponse{} }
+func (m *GetExchangeHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeHealthResponse) ProtoMessage()    {}
+func (*GetExchangeHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{103}
 }
 
-func (m *AuditEvent) GetTimestamp() string {
+func (m *GetExchangeHealthResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeHealthResponse.Unmarshal(m, b)
+}
+func (m *GetExchangeHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeHealthResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExchangeHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeHealthResponse.Merge(m, src)
+}
+func (m *GetExchangeHealthResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeHealthResponse.Size(m)
+}
+func (m *GetExchangeHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExchangeHealthResponse proto.InternalMessageInfo
+
+func (m *GetExchangeHealthResponse) GetExchanges() []*ExchangeHealth {
 	if m != nil {
-		return m.Timestamp
+		return m.Exchanges
 	}
-	return ""
+	return nil
 }
 
-type GCTScript struct {
-	UUID                 string   `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
-	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
-	NextRun              string   `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type ExchangeHealth struct {
+	Exchange             string            `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Requests             uint64            `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Errors               uint64            `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	Bans                 uint64            `protobuf:"varint,4,opt,name=bans,proto3" json:"bans,omitempty"`
+	LastError            string            `protobuf:"bytes,5,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	LastErrorTime        int64             `protobuf:"varint,6,opt,name=last_error_time,json=lastErrorTime,proto3" json:"last_error_time,omitempty"`
+	Endpoints            []*EndpointHealth `protobuf:"bytes,7,rep,name=endpoints,proto3" json:"endpoints,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GCTScript) Reset()         { *m = GCTScript{} }
-func (m *GCTScript) String() string { return proto.CompactTextString(m) }
-func (*GCTScript) ProtoMessage()    {}
-func (*GCTScript) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{102}
+func (m *ExchangeHealth) Reset()         { *m = ExchangeHealth{} }
+func (m *ExchangeHealth) String() string { return proto.CompactTextString(m) }
+func (*ExchangeHealth) ProtoMessage()    {}
+func (*ExchangeHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{104}
 }
 
-func (m *GCTScript) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScript.Unmarshal(m, b)
+func (m *ExchangeHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeHealth.Unmarshal(m, b)
 }
-func (m *GCTScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScript.Marshal(b, m, deterministic)
+func (m *ExchangeHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeHealth.Marshal(b, m, deterministic)
 }
-func (m *GCTScript) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScript.Merge(m, src)
+func (m *ExchangeHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeHealth.Merge(m, src)
 }
-func (m *GCTScript) XXX_Size() int {
-	return xxx_messageInfo_GCTScript.Size(m)
+func (m *ExchangeHealth) XXX_Size() int {
+	return xxx_messageInfo_ExchangeHealth.Size(m)
 }
-func (m *GCTScript) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScript.DiscardUnknown(m)
+func (m *ExchangeHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeHealth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScript proto.InternalMessageInfo
+var xxx_messageInfo_ExchangeHealth proto.InternalMessageInfo
 
-func (m *GCTScript) GetUUID() string {
+func (m *ExchangeHealth) GetExchange() string {
 	if m != nil {
-		return m.UUID
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScript) GetName() string {
+func (m *ExchangeHealth) GetRequests() uint64 {
 	if m != nil {
-		return m.Name
+		return m.Requests
 	}
-	return ""
+	return 0
 }
 
-func (m *GCTScript) GetPath() string {
+func (m *ExchangeHealth) GetErrors() uint64 {
 	if m != nil {
-		return m.Path
+		return m.Errors
 	}
-	return ""
+	return 0
 }
 
-func (m *GCTScript) GetNextRun() string {
+func (m *ExchangeHealth) GetBans() uint64 {
 	if m != nil {
-		return m.NextRun
+		return m.Bans
+	}
+	return 0
+}
+
+func (m *ExchangeHealth) GetLastError() string {
+	if m != nil {
+		return m.LastError
 	}
 	return ""
 }
 
-type GCTScriptExecuteRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *ExchangeHealth) GetLastErrorTime() int64 {
+	if m != nil {
+		return m.LastErrorTime
+	}
+	return 0
 }
 
-func (m *GCTScriptExecuteRequest) Reset()         { *m = GCTScriptExecuteRequest{} }
-func (m *GCTScriptExecuteRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptExecuteRequest) ProtoMessage()    {}
-func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{103}
+func (m *ExchangeHealth) GetEndpoints() []*EndpointHealth {
+	if m != nil {
+		return m.Endpoints
+	}
+	return nil
 }
 
-func (m *GCTScriptExecuteRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Unmarshal(m, b)
+type EndpointHealth struct {
+	Endpoint             string   `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Requests             uint64   `protobuf:"varint,2,opt,name=requests,proto3" json:"requests,omitempty"`
+	Errors               uint64   `protobuf:"varint,3,opt,name=errors,proto3" json:"errors,omitempty"`
+	P50Ms                float64  `protobuf:"fixed64,4,opt,name=p50_ms,json=p50Ms,proto3" json:"p50_ms,omitempty"`
+	P90Ms                float64  `protobuf:"fixed64,5,opt,name=p90_ms,json=p90Ms,proto3" json:"p90_ms,omitempty"`
+	P99Ms                float64  `protobuf:"fixed64,6,opt,name=p99_ms,json=p99Ms,proto3" json:"p99_ms,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (m *GCTScriptExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Marshal(b, m, deterministic)
+
+func (m *EndpointHealth) Reset()         { *m = EndpointHealth{} }
+func (m *EndpointHealth) String() string { return proto.CompactTextString(m) }
+func (*EndpointHealth) ProtoMessage()    {}
+func (*EndpointHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{105}
 }
-func (m *GCTScriptExecuteRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptExecuteRequest.Merge(m, src)
+
+func (m *EndpointHealth) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EndpointHealth.Unmarshal(m, b)
 }
-func (m *GCTScriptExecuteRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptExecuteRequest.Size(m)
+func (m *EndpointHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EndpointHealth.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptExecuteRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptExecuteRequest.DiscardUnknown(m)
+func (m *EndpointHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EndpointHealth.Merge(m, src)
+}
+func (m *EndpointHealth) XXX_Size() int {
+	return xxx_messageInfo_EndpointHealth.Size(m)
+}
+func (m *EndpointHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_EndpointHealth.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptExecuteRequest proto.InternalMessageInfo
+var xxx_messageInfo_EndpointHealth proto.InternalMessageInfo
 
-func (m *GCTScriptExecuteRequest) GetScript() *GCTScript {
+func (m *EndpointHealth) GetEndpoint() string {
 	if m != nil {
-		return m.Script
+		return m.Endpoint
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptStopRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *EndpointHealth) GetRequests() uint64 {
+	if m != nil {
+		return m.Requests
+	}
+	return 0
 }
 
-func (m *GCTScriptStopRequest) Reset()         { *m = GCTScriptStopRequest{} }
-func (m *GCTScriptStopRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStopRequest) ProtoMessage()    {}
-func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{104}
+func (m *EndpointHealth) GetErrors() uint64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
 }
 
-func (m *GCTScriptStopRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStopRequest.Unmarshal(m, b)
-}
-func (m *GCTScriptStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStopRequest.Marshal(b, m, deterministic)
-}
-func (m *GCTScriptStopRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStopRequest.Merge(m, src)
-}
-func (m *GCTScriptStopRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStopRequest.Size(m)
-}
-func (m *GCTScriptStopRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStopRequest.DiscardUnknown(m)
+func (m *EndpointHealth) GetP50Ms() float64 {
+	if m != nil {
+		return m.P50Ms
+	}
+	return 0
 }
 
-var xxx_messageInfo_GCTScriptStopRequest proto.InternalMessageInfo
+func (m *EndpointHealth) GetP90Ms() float64 {
+	if m != nil {
+		return m.P90Ms
+	}
+	return 0
+}
 
-func (m *GCTScriptStopRequest) GetScript() *GCTScript {
+func (m *EndpointHealth) GetP99Ms() float64 {
 	if m != nil {
-		return m.Script
+		return m.P99Ms
 	}
-	return nil
+	return 0
 }
 
-type GCTScriptStopAllRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type WebsocketSubscription struct {
+	Channel              string        `protobuf:"bytes,1,opt,name=channel,proto3" json:"channel,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GCTScriptStopAllRequest) Reset()         { *m = GCTScriptStopAllRequest{} }
-func (m *GCTScriptStopAllRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStopAllRequest) ProtoMessage()    {}
-func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{105}
+func (m *WebsocketSubscription) Reset()         { *m = WebsocketSubscription{} }
+func (m *WebsocketSubscription) String() string { return proto.CompactTextString(m) }
+func (*WebsocketSubscription) ProtoMessage()    {}
+func (*WebsocketSubscription) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{106}
 }
 
-func (m *GCTScriptStopAllRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Unmarshal(m, b)
+func (m *WebsocketSubscription) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebsocketSubscription.Unmarshal(m, b)
 }
-func (m *GCTScriptStopAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Marshal(b, m, deterministic)
+func (m *WebsocketSubscription) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebsocketSubscription.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptStopAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStopAllRequest.Merge(m, src)
+func (m *WebsocketSubscription) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebsocketSubscription.Merge(m, src)
 }
-func (m *GCTScriptStopAllRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStopAllRequest.Size(m)
+func (m *WebsocketSubscription) XXX_Size() int {
+	return xxx_messageInfo_WebsocketSubscription.Size(m)
 }
-func (m *GCTScriptStopAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStopAllRequest.DiscardUnknown(m)
+func (m *WebsocketSubscription) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebsocketSubscription.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptStopAllRequest proto.InternalMessageInfo
-
-type GCTScriptStatusRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
-}
+var xxx_messageInfo_WebsocketSubscription proto.InternalMessageInfo
 
-func (m *GCTScriptStatusRequest) Reset()         { *m = GCTScriptStatusRequest{} }
-func (m *GCTScriptStatusRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStatusRequest) ProtoMessage()    {}
-func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_77a6da22d6a3feb1, []int{106}
+func (m *WebsocketSubscription) GetChannel() string {
+	if m != nil {
+		return m.Channel
+	}
+	return ""
 }
 
-func (m *GCTScriptStatusRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStatusRequest.Unmarshal(m, b)
-}
-func (m *GCTScriptStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStatusRequest.Marshal(b, m, deterministic)
-}
-func (m *GCTScriptStatusRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStatusRequest.Merge(m, src)
-}
-func (m *GCTScriptStatusRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStatusRequest.Size(m)
-}
-func (m *GCTScriptStatusRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStatusRequest.DiscardUnknown(m)
+func (m *WebsocketSubscription) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
 }
 
-var xxx_messageInfo_GCTScriptStatusRequest proto.InternalMessageInfo
-
-type GCTScriptListAllRequest struct {
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+type GetWebsocketSubscriptionsResponse struct {
+	Exchange             string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Subscriptions        []*WebsocketSubscription `protobuf:"bytes,2,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
 }
 
-func (m *GCTScriptListAllRequest) Reset()         { *m = GCTScriptListAllRequest{} }
-func (m *GCTScriptListAllRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptListAllRequest) ProtoMessage()    {}
-func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
+func (m *GetWebsocketSubscriptionsResponse) Reset()         { *m = GetWebsocketSubscriptionsResponse{} }
+func (m *GetWebsocketSubscriptionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetWebsocketSubscriptionsResponse) ProtoMessage()    {}
+func (*GetWebsocketSubscriptionsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{107}
 }
 
-func (m *GCTScriptListAllRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptListAllRequest.Unmarshal(m, b)
+func (m *GetWebsocketSubscriptionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetWebsocketSubscriptionsResponse.Unmarshal(m, b)
 }
-func (m *GCTScriptListAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptListAllRequest.Marshal(b, m, deterministic)
+func (m *GetWebsocketSubscriptionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetWebsocketSubscriptionsResponse.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptListAllRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptListAllRequest.Merge(m, src)
+func (m *GetWebsocketSubscriptionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetWebsocketSubscriptionsResponse.Merge(m, src)
 }
-func (m *GCTScriptListAllRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptListAllRequest.Size(m)
+func (m *GetWebsocketSubscriptionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetWebsocketSubscriptionsResponse.Size(m)
 }
-func (m *GCTScriptListAllRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptListAllRequest.DiscardUnknown(m)
+func (m *GetWebsocketSubscriptionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetWebsocketSubscriptionsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptListAllRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetWebsocketSubscriptionsResponse proto.InternalMessageInfo
 
-type GCTScriptUploadRequest struct {
-	ScriptName           string   `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
-	ScriptData           string   `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
-	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	Archived             bool     `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
-	Overwrite            bool     `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetWebsocketSubscriptionsResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
 
-func (m *GCTScriptUploadRequest) Reset()         { *m = GCTScriptUploadRequest{} }
-func (m *GCTScriptUploadRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptUploadRequest) ProtoMessage()    {}
-func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
+func (m *GetWebsocketSubscriptionsResponse) GetSubscriptions() []*WebsocketSubscription {
+	if m != nil {
+		return m.Subscriptions
+	}
+	return nil
+}
+
+type WebsocketSubscriptionsRequest struct {
+	Exchange             string                   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType            string                   `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Subscriptions        []*WebsocketSubscription `protobuf:"bytes,3,rep,name=subscriptions,proto3" json:"subscriptions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *WebsocketSubscriptionsRequest) Reset()         { *m = WebsocketSubscriptionsRequest{} }
+func (m *WebsocketSubscriptionsRequest) String() string { return proto.CompactTextString(m) }
+func (*WebsocketSubscriptionsRequest) ProtoMessage()    {}
+func (*WebsocketSubscriptionsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{108}
 }
 
-func (m *GCTScriptUploadRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptUploadRequest.Unmarshal(m, b)
+func (m *WebsocketSubscriptionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WebsocketSubscriptionsRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptUploadRequest.Marshal(b, m, deterministic)
+func (m *WebsocketSubscriptionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WebsocketSubscriptionsRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptUploadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptUploadRequest.Merge(m, src)
+func (m *WebsocketSubscriptionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WebsocketSubscriptionsRequest.Merge(m, src)
 }
-func (m *GCTScriptUploadRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptUploadRequest.Size(m)
+func (m *WebsocketSubscriptionsRequest) XXX_Size() int {
+	return xxx_messageInfo_WebsocketSubscriptionsRequest.Size(m)
 }
-func (m *GCTScriptUploadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptUploadRequest.DiscardUnknown(m)
+func (m *WebsocketSubscriptionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WebsocketSubscriptionsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptUploadRequest proto.InternalMessageInfo
+var xxx_messageInfo_WebsocketSubscriptionsRequest proto.InternalMessageInfo
 
-func (m *GCTScriptUploadRequest) GetScriptName() string {
+func (m *WebsocketSubscriptionsRequest) GetExchange() string {
 	if m != nil {
-		return m.ScriptName
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScriptUploadRequest) GetScriptData() string {
+func (m *WebsocketSubscriptionsRequest) GetAssetType() string {
 	if m != nil {
-		return m.ScriptData
+		return m.AssetType
 	}
 	return ""
 }
 
-func (m *GCTScriptUploadRequest) GetData() []byte {
+func (m *WebsocketSubscriptionsRequest) GetSubscriptions() []*WebsocketSubscription {
 	if m != nil {
-		return m.Data
+		return m.Subscriptions
 	}
 	return nil
 }
 
-func (m *GCTScriptUploadRequest) GetArchived() bool {
-	if m != nil {
-		return m.Archived
-	}
-	return false
-}
-
-func (m *GCTScriptUploadRequest) GetOverwrite() bool {
-	if m != nil {
-		return m.Overwrite
-	}
-	return false
-}
-
-type GCTScriptReadScriptRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetKlineStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string        `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
 }
 
-func (m *GCTScriptReadScriptRequest) Reset()         { *m = GCTScriptReadScriptRequest{} }
-func (m *GCTScriptReadScriptRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptReadScriptRequest) ProtoMessage()    {}
-func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+func (m *GetKlineStreamRequest) Reset()         { *m = GetKlineStreamRequest{} }
+func (m *GetKlineStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetKlineStreamRequest) ProtoMessage()    {}
+func (*GetKlineStreamRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{109}
 }
 
-func (m *GCTScriptReadScriptRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Unmarshal(m, b)
+func (m *GetKlineStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetKlineStreamRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Marshal(b, m, deterministic)
+func (m *GetKlineStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetKlineStreamRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptReadScriptRequest.Merge(m, src)
+func (m *GetKlineStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetKlineStreamRequest.Merge(m, src)
 }
-func (m *GCTScriptReadScriptRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptReadScriptRequest.Size(m)
+func (m *GetKlineStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetKlineStreamRequest.Size(m)
 }
-func (m *GCTScriptReadScriptRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptReadScriptRequest.DiscardUnknown(m)
+func (m *GetKlineStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetKlineStreamRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptReadScriptRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetKlineStreamRequest proto.InternalMessageInfo
 
-func (m *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+func (m *GetKlineStreamRequest) GetExchange() string {
 	if m != nil {
-		return m.Script
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetKlineStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
 	return nil
 }
 
-type GCTScriptQueryRequest struct {
-	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+func (m *GetKlineStreamRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-func (m *GCTScriptQueryRequest) Reset()         { *m = GCTScriptQueryRequest{} }
-func (m *GCTScriptQueryRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptQueryRequest) ProtoMessage()    {}
-func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
+func (m *GetKlineStreamRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+type KlineResponse struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string        `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	StartTime            int64         `protobuf:"varint,5,opt,name=start_time,json=startTime,proto3" json:"start_time,omitempty"`
+	CloseTime            int64         `protobuf:"varint,6,opt,name=close_time,json=closeTime,proto3" json:"close_time,omitempty"`
+	Open                 float64       `protobuf:"fixed64,7,opt,name=open,proto3" json:"open,omitempty"`
+	High                 float64       `protobuf:"fixed64,8,opt,name=high,proto3" json:"high,omitempty"`
+	Low                  float64       `protobuf:"fixed64,9,opt,name=low,proto3" json:"low,omitempty"`
+	Close                float64       `protobuf:"fixed64,10,opt,name=close,proto3" json:"close,omitempty"`
+	Volume               float64       `protobuf:"fixed64,11,opt,name=volume,proto3" json:"volume,omitempty"`
+	LastUpdated          int64         `protobuf:"varint,12,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *KlineResponse) Reset()         { *m = KlineResponse{} }
+func (m *KlineResponse) String() string { return proto.CompactTextString(m) }
+func (*KlineResponse) ProtoMessage()    {}
+func (*KlineResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{110}
 }
 
-func (m *GCTScriptQueryRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptQueryRequest.Unmarshal(m, b)
+func (m *KlineResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_KlineResponse.Unmarshal(m, b)
 }
-func (m *GCTScriptQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptQueryRequest.Marshal(b, m, deterministic)
+func (m *KlineResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_KlineResponse.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptQueryRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptQueryRequest.Merge(m, src)
+func (m *KlineResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_KlineResponse.Merge(m, src)
 }
-func (m *GCTScriptQueryRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptQueryRequest.Size(m)
+func (m *KlineResponse) XXX_Size() int {
+	return xxx_messageInfo_KlineResponse.Size(m)
 }
-func (m *GCTScriptQueryRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptQueryRequest.DiscardUnknown(m)
+func (m *KlineResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_KlineResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptQueryRequest proto.InternalMessageInfo
+var xxx_messageInfo_KlineResponse proto.InternalMessageInfo
 
-func (m *GCTScriptQueryRequest) GetScript() *GCTScript {
+func (m *KlineResponse) GetExchange() string {
 	if m != nil {
-		return m.Script
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *KlineResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
 	return nil
 }
 
-type GCTScriptAutoLoadRequest struct {
-	Script               string   `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
-	Status               bool     `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *KlineResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-func (m *GCTScriptAutoLoadRequest) Reset()         { *m = GCTScriptAutoLoadRequest{} }
-func (m *GCTScriptAutoLoadRequest) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptAutoLoadRequest) ProtoMessage()    {}
-func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
+func (m *KlineResponse) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *KlineResponse) GetStartTime() int64 {
+	if m != nil {
+		return m.StartTime
+	}
+	return 0
+}
+
+func (m *KlineResponse) GetCloseTime() int64 {
+	if m != nil {
+		return m.CloseTime
+	}
+	return 0
+}
+
+func (m *KlineResponse) GetOpen() float64 {
+	if m != nil {
+		return m.Open
+	}
+	return 0
+}
+
+func (m *KlineResponse) GetHigh() float64 {
+	if m != nil {
+		return m.High
+	}
+	return 0
+}
+
+func (m *KlineResponse) GetLow() float64 {
+	if m != nil {
+		return m.Low
+	}
+	return 0
+}
+
+func (m *KlineResponse) GetClose() float64 {
+	if m != nil {
+		return m.Close
+	}
+	return 0
+}
+
+func (m *KlineResponse) GetVolume() float64 {
+	if m != nil {
+		return m.Volume
+	}
+	return 0
+}
+
+func (m *KlineResponse) GetLastUpdated() int64 {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return 0
+}
+
+type GetFundingPaymentsRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	StartDate            string        `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string        `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetFundingPaymentsRequest) Reset()         { *m = GetFundingPaymentsRequest{} }
+func (m *GetFundingPaymentsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetFundingPaymentsRequest) ProtoMessage()    {}
+func (*GetFundingPaymentsRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{111}
 }
 
-func (m *GCTScriptAutoLoadRequest) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Unmarshal(m, b)
+func (m *GetFundingPaymentsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFundingPaymentsRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptAutoLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Marshal(b, m, deterministic)
+func (m *GetFundingPaymentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFundingPaymentsRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptAutoLoadRequest) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptAutoLoadRequest.Merge(m, src)
+func (m *GetFundingPaymentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFundingPaymentsRequest.Merge(m, src)
 }
-func (m *GCTScriptAutoLoadRequest) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptAutoLoadRequest.Size(m)
+func (m *GetFundingPaymentsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetFundingPaymentsRequest.Size(m)
 }
-func (m *GCTScriptAutoLoadRequest) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptAutoLoadRequest.DiscardUnknown(m)
+func (m *GetFundingPaymentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFundingPaymentsRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptAutoLoadRequest proto.InternalMessageInfo
+var xxx_messageInfo_GetFundingPaymentsRequest proto.InternalMessageInfo
 
-func (m *GCTScriptAutoLoadRequest) GetScript() string {
+func (m *GetFundingPaymentsRequest) GetExchange() string {
 	if m != nil {
-		return m.Script
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScriptAutoLoadRequest) GetStatus() bool {
+func (m *GetFundingPaymentsRequest) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Status
+		return m.Pair
 	}
-	return false
+	return nil
 }
 
-type GCTScriptStatusResponse struct {
-	Status               string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Scripts              []*GCTScript `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
-	XXX_unrecognized     []byte       `json:"-"`
-	XXX_sizecache        int32        `json:"-"`
+func (m *GetFundingPaymentsRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-func (m *GCTScriptStatusResponse) Reset()         { *m = GCTScriptStatusResponse{} }
-func (m *GCTScriptStatusResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptStatusResponse) ProtoMessage()    {}
-func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
+func (m *GetFundingPaymentsRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetFundingPaymentsRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+type FundingPayment struct {
+	Rate                 float64  `protobuf:"fixed64,1,opt,name=rate,proto3" json:"rate,omitempty"`
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	Currency             string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Timestamp            string   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *FundingPayment) Reset()         { *m = FundingPayment{} }
+func (m *FundingPayment) String() string { return proto.CompactTextString(m) }
+func (*FundingPayment) ProtoMessage()    {}
+func (*FundingPayment) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{112}
 }
 
-func (m *GCTScriptStatusResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptStatusResponse.Unmarshal(m, b)
+func (m *FundingPayment) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_FundingPayment.Unmarshal(m, b)
 }
-func (m *GCTScriptStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptStatusResponse.Marshal(b, m, deterministic)
+func (m *FundingPayment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_FundingPayment.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptStatusResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptStatusResponse.Merge(m, src)
+func (m *FundingPayment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FundingPayment.Merge(m, src)
 }
-func (m *GCTScriptStatusResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptStatusResponse.Size(m)
+func (m *FundingPayment) XXX_Size() int {
+	return xxx_messageInfo_FundingPayment.Size(m)
 }
-func (m *GCTScriptStatusResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptStatusResponse.DiscardUnknown(m)
+func (m *FundingPayment) XXX_DiscardUnknown() {
+	xxx_messageInfo_FundingPayment.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptStatusResponse proto.InternalMessageInfo
+var xxx_messageInfo_FundingPayment proto.InternalMessageInfo
 
-func (m *GCTScriptStatusResponse) GetStatus() string {
+func (m *FundingPayment) GetRate() float64 {
 	if m != nil {
-		return m.Status
+		return m.Rate
+	}
+	return 0
+}
+
+func (m *FundingPayment) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *FundingPayment) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
 	return ""
 }
 
-func (m *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+func (m *FundingPayment) GetTimestamp() string {
 	if m != nil {
-		return m.Scripts
+		return m.Timestamp
 	}
-	return nil
+	return ""
 }
 
-type GCTScriptQueryResponse struct {
-	Status               string     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Script               *GCTScript `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
-	Data                 string     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
-	XXX_unrecognized     []byte     `json:"-"`
-	XXX_sizecache        int32      `json:"-"`
+type GetFundingPaymentsResponse struct {
+	Exchange             string            `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair     `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string            `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Payments             []*FundingPayment `protobuf:"bytes,4,rep,name=payments,proto3" json:"payments,omitempty"`
+	Total                float64           `protobuf:"fixed64,5,opt,name=total,proto3" json:"total,omitempty"`
+	Stored               int64             `protobuf:"varint,6,opt,name=stored,proto3" json:"stored,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
 }
 
-func (m *GCTScriptQueryResponse) Reset()         { *m = GCTScriptQueryResponse{} }
-func (m *GCTScriptQueryResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptQueryResponse) ProtoMessage()    {}
-func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
+func (m *GetFundingPaymentsResponse) Reset()         { *m = GetFundingPaymentsResponse{} }
+func (m *GetFundingPaymentsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetFundingPaymentsResponse) ProtoMessage()    {}
+func (*GetFundingPaymentsResponse) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{113}
 }
 
-func (m *GCTScriptQueryResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptQueryResponse.Unmarshal(m, b)
+func (m *GetFundingPaymentsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetFundingPaymentsResponse.Unmarshal(m, b)
 }
-func (m *GCTScriptQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptQueryResponse.Marshal(b, m, deterministic)
+func (m *GetFundingPaymentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetFundingPaymentsResponse.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptQueryResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptQueryResponse.Merge(m, src)
+func (m *GetFundingPaymentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetFundingPaymentsResponse.Merge(m, src)
 }
-func (m *GCTScriptQueryResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptQueryResponse.Size(m)
+func (m *GetFundingPaymentsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetFundingPaymentsResponse.Size(m)
 }
-func (m *GCTScriptQueryResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptQueryResponse.DiscardUnknown(m)
+func (m *GetFundingPaymentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetFundingPaymentsResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptQueryResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetFundingPaymentsResponse proto.InternalMessageInfo
 
-func (m *GCTScriptQueryResponse) GetStatus() string {
+func (m *GetFundingPaymentsResponse) GetExchange() string {
 	if m != nil {
-		return m.Status
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScriptQueryResponse) GetScript() *GCTScript {
+func (m *GetFundingPaymentsResponse) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Script
+		return m.Pair
 	}
 	return nil
 }
 
-func (m *GCTScriptQueryResponse) GetData() string {
+func (m *GetFundingPaymentsResponse) GetAssetType() string {
 	if m != nil {
-		return m.Data
+		return m.AssetType
 	}
 	return ""
 }
 
-type GCTScriptGenericResponse struct {
-	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
-	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
-	XXX_NoUnkeyedLiteral struct{} `json:"-"`
-	XXX_unrecognized     []byte   `json:"-"`
-	XXX_sizecache        int32    `json:"-"`
+func (m *GetFundingPaymentsResponse) GetPayments() []*FundingPayment {
+	if m != nil {
+		return m.Payments
+	}
+	return nil
 }
 
-func (m *GCTScriptGenericResponse) Reset()         { *m = GCTScriptGenericResponse{} }
-func (m *GCTScriptGenericResponse) String() string { return proto.CompactTextString(m) }
-func (*GCTScriptGenericResponse) ProtoMessage()    {}
-func (*GCTScriptGenericResponse) Descriptor() ([]byte, []int) {
+func (m *GetFundingPaymentsResponse) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *GetFundingPaymentsResponse) GetStored() int64 {
+	if m != nil {
+		return m.Stored
+	}
+	return 0
+}
+
+type GetTechnicalAnalysisRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string        `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Indicator            string        `protobuf:"bytes,5,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	Period               int64         `protobuf:"varint,6,opt,name=period,proto3" json:"period,omitempty"`
+	Count                int64         `protobuf:"varint,7,opt,name=count,proto3" json:"count,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetTechnicalAnalysisRequest) Reset()         { *m = GetTechnicalAnalysisRequest{} }
+func (m *GetTechnicalAnalysisRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTechnicalAnalysisRequest) ProtoMessage()    {}
+func (*GetTechnicalAnalysisRequest) Descriptor() ([]byte, []int) {
 	return fileDescriptor_77a6da22d6a3feb1, []int{114}
 }
 
-func (m *GCTScriptGenericResponse) XXX_Unmarshal(b []byte) error {
-	return xxx_messageInfo_GCTScriptGenericResponse.Unmarshal(m, b)
+func (m *GetTechnicalAnalysisRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTechnicalAnalysisRequest.Unmarshal(m, b)
 }
-func (m *GCTScriptGenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
-	return xxx_messageInfo_GCTScriptGenericResponse.Marshal(b, m, deterministic)
+func (m *GetTechnicalAnalysisRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTechnicalAnalysisRequest.Marshal(b, m, deterministic)
 }
-func (m *GCTScriptGenericResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_GCTScriptGenericResponse.Merge(m, src)
+func (m *GetTechnicalAnalysisRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTechnicalAnalysisRequest.Merge(m, src)
 }
-func (m *GCTScriptGenericResponse) XXX_Size() int {
-	return xxx_messageInfo_GCTScriptGenericResponse.Size(m)
+func (m *GetTechnicalAnalysisRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTechnicalAnalysisRequest.Size(m)
 }
-func (m *GCTScriptGenericResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_GCTScriptGenericResponse.DiscardUnknown(m)
+func (m *GetTechnicalAnalysisRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTechnicalAnalysisRequest.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_GCTScriptGenericResponse proto.InternalMessageInfo
+var xxx_messageInfo_GetTechnicalAnalysisRequest proto.InternalMessageInfo
 
-func (m *GCTScriptGenericResponse) GetStatus() string {
+func (m *GetTechnicalAnalysisRequest) GetExchange() string {
 	if m != nil {
-		return m.Status
+		return m.Exchange
 	}
 	return ""
 }
 
-func (m *GCTScriptGenericResponse) GetData() string {
+func (m *GetTechnicalAnalysisRequest) GetPair() *CurrencyPair {
 	if m != nil {
-		return m.Data
+		return m.Pair
 	}
-	return ""
+	return nil
 }
 
-func init() {
-	proto.RegisterType((*GetInfoRequest)(nil), "gctrpc.GetInfoRequest")
-	proto.RegisterType((*GetInfoResponse)(nil), "gctrpc.GetInfoResponse")
-	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetInfoResponse.RpcEndpointsEntry")
-	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetInfoResponse.SubsystemStatusEntry")
-	proto.RegisterType((*GetCommunicationRelayersRequest)(nil), "gctrpc.GetCommunicationRelayersRequest")
-	proto.RegisterType((*CommunicationRelayer)(nil), "gctrpc.CommunicationRelayer")
-	proto.RegisterType((*GetCommunicationRelayersResponse)(nil), "gctrpc.GetCommunicationRelayersResponse")
-	proto.RegisterMapType((map[string]*CommunicationRelayer)(nil), "gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry")
-	proto.RegisterType((*GenericSubsystemRequest)(nil), "gctrpc.GenericSubsystemRequest")
-	proto.RegisterType((*GenericSubsystemResponse)(nil), "gctrpc.GenericSubsystemResponse")
-	proto.RegisterType((*GetSubsystemsRequest)(nil), "gctrpc.GetSubsystemsRequest")
-	proto.RegisterType((*GetSusbsytemsResponse)(nil), "gctrpc.GetSusbsytemsResponse")
-	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry")
-	proto.RegisterType((*GetRPCEndpointsRequest)(nil), "gctrpc.GetRPCEndpointsRequest")
-	proto.RegisterType((*RPCEndpoint)(nil), "gctrpc.RPCEndpoint")
-	proto.RegisterType((*GetRPCEndpointsResponse)(nil), "gctrpc.GetRPCEndpointsResponse")
-	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetRPCEndpointsResponse.EndpointsEntry")
-	proto.RegisterType((*GenericExchangeNameRequest)(nil), "gctrpc.GenericExchangeNameRequest")
-	proto.RegisterType((*GenericExchangeNameResponse)(nil), "gctrpc.GenericExchangeNameResponse")
-	proto.RegisterType((*GetExchangesRequest)(nil), "gctrpc.GetExchangesRequest")
-	proto.RegisterType((*GetExchangesResponse)(nil), "gctrpc.GetExchangesResponse")
-	proto.RegisterType((*GetExchangeOTPReponse)(nil), "gctrpc.GetExchangeOTPReponse")
-	proto.RegisterType((*GetExchangeOTPsRequest)(nil), "gctrpc.GetExchangeOTPsRequest")
-	proto.RegisterType((*GetExchangeOTPsResponse)(nil), "gctrpc.GetExchangeOTPsResponse")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetExchangeOTPsResponse.OtpCodesEntry")
-	proto.RegisterType((*DisableExchangeRequest)(nil), "gctrpc.DisableExchangeRequest")
-	proto.RegisterType((*PairsSupported)(nil), "gctrpc.PairsSupported")
-	proto.RegisterType((*GetExchangeInfoResponse)(nil), "gctrpc.GetExchangeInfoResponse")
-	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry")
-	proto.RegisterType((*GetTickerRequest)(nil), "gctrpc.GetTickerRequest")
-	proto.RegisterType((*CurrencyPair)(nil), "gctrpc.CurrencyPair")
-	proto.RegisterType((*TickerResponse)(nil), "gctrpc.TickerResponse")
-	proto.RegisterType((*GetTickersRequest)(nil), "gctrpc.GetTickersRequest")
-	proto.RegisterType((*Tickers)(nil), "gctrpc.Tickers")
-	proto.RegisterType((*GetTickersResponse)(nil), "gctrpc.GetTickersResponse")
-	proto.RegisterType((*GetOrderbookRequest)(nil), "gctrpc.GetOrderbookRequest")
-	proto.RegisterType((*OrderbookItem)(nil), "gctrpc.OrderbookItem")
-	proto.RegisterType((*OrderbookResponse)(nil), "gctrpc.OrderbookResponse")
-	proto.RegisterType((*GetOrderbooksRequest)(nil), "gctrpc.GetOrderbooksRequest")
-	proto.RegisterType((*Orderbooks)(nil), "gctrpc.Orderbooks")
-	proto.RegisterType((*GetOrderbooksResponse)(nil), "gctrpc.GetOrderbooksResponse")
-	proto.RegisterType((*GetAccountInfoRequest)(nil), "gctrpc.GetAccountInfoRequest")
-	proto.RegisterType((*Account)(nil), "gctrpc.Account")
-	proto.RegisterType((*AccountCurrencyInfo)(nil), "gctrpc.AccountCurrencyInfo")
-	proto.RegisterType((*GetAccountInfoResponse)(nil), "gctrpc.GetAccountInfoResponse")
-	proto.RegisterType((*GetConfigRequest)(nil), "gctrpc.GetConfigRequest")
-	proto.RegisterType((*GetConfigResponse)(nil), "gctrpc.GetConfigResponse")
-	proto.RegisterType((*PortfolioAddress)(nil), "gctrpc.PortfolioAddress")
-	proto.RegisterType((*GetPortfolioRequest)(nil), "gctrpc.GetPortfolioRequest")
-	proto.RegisterType((*GetPortfolioResponse)(nil), "gctrpc.GetPortfolioResponse")
-	proto.RegisterType((*GetPortfolioSummaryRequest)(nil), "gctrpc.GetPortfolioSummaryRequest")
-	proto.RegisterType((*Coin)(nil), "gctrpc.Coin")
-	proto.RegisterType((*OfflineCoinSummary)(nil), "gctrpc.OfflineCoinSummary")
-	proto.RegisterType((*OnlineCoinSummary)(nil), "gctrpc.OnlineCoinSummary")
-	proto.RegisterType((*OfflineCoins)(nil), "gctrpc.OfflineCoins")
-	proto.RegisterType((*OnlineCoins)(nil), "gctrpc.OnlineCoins")
-	proto.RegisterMapType((map[string]*OnlineCoinSummary)(nil), "gctrpc.OnlineCoins.CoinsEntry")
-	proto.RegisterType((*GetPortfolioSummaryResponse)(nil), "gctrpc.GetPortfolioSummaryResponse")
-	proto.RegisterMapType((map[string]*OfflineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry")
-	proto.RegisterMapType((map[string]*OnlineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry")
-	proto.RegisterType((*AddPortfolioAddressRequest)(nil), "gctrpc.AddPortfolioAddressRequest")
-	proto.RegisterType((*AddPortfolioAddressResponse)(nil), "gctrpc.AddPortfolioAddressResponse")
-	proto.RegisterType((*RemovePortfolioAddressRequest)(nil), "gctrpc.RemovePortfolioAddressRequest")
-	proto.RegisterType((*RemovePortfolioAddressResponse)(nil), "gctrpc.RemovePortfolioAddressResponse")
-	proto.RegisterType((*GetForexProvidersRequest)(nil), "gctrpc.GetForexProvidersRequest")
-	proto.RegisterType((*ForexProvider)(nil), "gctrpc.ForexProvider")
-	proto.RegisterType((*GetForexProvidersResponse)(nil), "gctrpc.GetForexProvidersResponse")
-	proto.RegisterType((*GetForexRatesRequest)(nil), "gctrpc.GetForexRatesRequest")
-	proto.RegisterType((*ForexRatesConversion)(nil), "gctrpc.ForexRatesConversion")
-	proto.RegisterType((*GetForexRatesResponse)(nil), "gctrpc.GetForexRatesResponse")
-	proto.RegisterType((*OrderDetails)(nil), "gctrpc.OrderDetails")
-	proto.RegisterType((*GetOrdersRequest)(nil), "gctrpc.GetOrdersRequest")
-	proto.RegisterType((*GetOrdersResponse)(nil), "gctrpc.GetOrdersResponse")
-	proto.RegisterType((*GetOrderRequest)(nil), "gctrpc.GetOrderRequest")
-	proto.RegisterType((*SubmitOrderRequest)(nil), "gctrpc.SubmitOrderRequest")
-	proto.RegisterType((*SubmitOrderResponse)(nil), "gctrpc.SubmitOrderResponse")
-	proto.RegisterType((*SimulateOrderRequest)(nil), "gctrpc.SimulateOrderRequest")
-	proto.RegisterType((*SimulateOrderResponse)(nil), "gctrpc.SimulateOrderResponse")
-	proto.RegisterType((*WhaleBombRequest)(nil), "gctrpc.WhaleBombRequest")
-	proto.RegisterType((*CancelOrderRequest)(nil), "gctrpc.CancelOrderRequest")
-	proto.RegisterType((*CancelOrderResponse)(nil), "gctrpc.CancelOrderResponse")
-	proto.RegisterType((*CancelAllOrdersRequest)(nil), "gctrpc.CancelAllOrdersRequest")
-	proto.RegisterType((*CancelAllOrdersResponse)(nil), "gctrpc.CancelAllOrdersResponse")
-	proto.RegisterType((*CancelAllOrdersResponse_Orders)(nil), "gctrpc.CancelAllOrdersResponse.Orders")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.CancelAllOrdersResponse.Orders.OrderStatusEntry")
-	proto.RegisterType((*GetEventsRequest)(nil), "gctrpc.GetEventsRequest")
-	proto.RegisterType((*ConditionParams)(nil), "gctrpc.ConditionParams")
-	proto.RegisterType((*GetEventsResponse)(nil), "gctrpc.GetEventsResponse")
-	proto.RegisterType((*AddEventRequest)(nil), "gctrpc.AddEventRequest")
-	proto.RegisterType((*AddEventResponse)(nil), "gctrpc.AddEventResponse")
-	proto.RegisterType((*RemoveEventRequest)(nil), "gctrpc.RemoveEventRequest")
-	proto.RegisterType((*RemoveEventResponse)(nil), "gctrpc.RemoveEventResponse")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressesRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressesRequest")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressesResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse")
-	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressRequest")
-	proto.RegisterType((*GetCryptocurrencyDepositAddressResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressResponse")
-	proto.RegisterType((*WithdrawCurrencyRequest)(nil), "gctrpc.WithdrawCurrencyRequest")
-	proto.RegisterType((*WithdrawResponse)(nil), "gctrpc.WithdrawResponse")
-	proto.RegisterType((*GetLoggerDetailsRequest)(nil), "gctrpc.GetLoggerDetailsRequest")
-	proto.RegisterType((*GetLoggerDetailsResponse)(nil), "gctrpc.GetLoggerDetailsResponse")
-	proto.RegisterType((*SetLoggerDetailsRequest)(nil), "gctrpc.SetLoggerDetailsRequest")
-	proto.RegisterType((*GetExchangePairsRequest)(nil), "gctrpc.GetExchangePairsRequest")
-	proto.RegisterType((*GetExchangePairsResponse)(nil), "gctrpc.GetExchangePairsResponse")
-	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangePairsResponse.SupportedAssetsEntry")
-	proto.RegisterType((*ExchangePairRequest)(nil), "gctrpc.ExchangePairRequest")
-	proto.RegisterType((*GetOrderbookStreamRequest)(nil), "gctrpc.GetOrderbookStreamRequest")
-	proto.RegisterType((*GetExchangeOrderbookStreamRequest)(nil), "gctrpc.GetExchangeOrderbookStreamRequest")
-	proto.RegisterType((*GetTickerStreamRequest)(nil), "gctrpc.GetTickerStreamRequest")
-	proto.RegisterType((*GetExchangeTickerStreamRequest)(nil), "gctrpc.GetExchangeTickerStreamRequest")
-	proto.RegisterType((*GetAuditEventRequest)(nil), "gctrpc.GetAuditEventRequest")
-	proto.RegisterType((*GetAuditEventResponse)(nil), "gctrpc.GetAuditEventResponse")
-	proto.RegisterType((*GetHistoricCandlesRequest)(nil), "gctrpc.GetHistoricCandlesRequest")
-	proto.RegisterType((*GetHistoricCandlesResponse)(nil), "gctrpc.GetHistoricCandlesResponse")
-	proto.RegisterType((*Candle)(nil), "gctrpc.Candle")
-	proto.RegisterType((*AuditEvent)(nil), "gctrpc.AuditEvent")
-	proto.RegisterType((*GCTScript)(nil), "gctrpc.GCTScript")
-	proto.RegisterType((*GCTScriptExecuteRequest)(nil), "gctrpc.GCTScriptExecuteRequest")
-	proto.RegisterType((*GCTScriptStopRequest)(nil), "gctrpc.GCTScriptStopRequest")
-	proto.RegisterType((*GCTScriptStopAllRequest)(nil), "gctrpc.GCTScriptStopAllRequest")
-	proto.RegisterType((*GCTScriptStatusRequest)(nil), "gctrpc.GCTScriptStatusRequest")
-	proto.RegisterType((*GCTScriptListAllRequest)(nil), "gctrpc.GCTScriptListAllRequest")
-	proto.RegisterType((*GCTScriptUploadRequest)(nil), "gctrpc.GCTScriptUploadRequest")
-	proto.RegisterType((*GCTScriptReadScriptRequest)(nil), "gctrpc.GCTScriptReadScriptRequest")
-	proto.RegisterType((*GCTScriptQueryRequest)(nil), "gctrpc.GCTScriptQueryRequest")
-	proto.RegisterType((*GCTScriptAutoLoadRequest)(nil), "gctrpc.GCTScriptAutoLoadRequest")
-	proto.RegisterType((*GCTScriptStatusResponse)(nil), "gctrpc.GCTScriptStatusResponse")
-	proto.RegisterType((*GCTScriptQueryResponse)(nil), "gctrpc.GCTScriptQueryResponse")
-	proto.RegisterType((*GCTScriptGenericResponse)(nil), "gctrpc.GCTScriptGenericResponse")
+func (m *GetTechnicalAnalysisRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
 }
 
-func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+func (m *GetTechnicalAnalysisRequest) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
 
-var fileDescriptor_77a6da22d6a3feb1 = []byte{
-	// 5495 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x3c, 0x4d, 0x6f, 0x1c, 0x57,
-	0x72, 0x98, 0x21, 0x45, 0x72, 0x8a, 0x5f, 0xc3, 0xc7, 0xaf, 0xd1, 0x88, 0x12, 0xa5, 0xf6, 0x5a,
-	0x96, 0xfc, 0x41, 0xd9, 0xb2, 0x36, 0xeb, 0xac, 0x9d, 0xdd, 0x50, 0x94, 0x4c, 0x6b, 0xad, 0xb5,
-	0xb8, 0x4d, 0x5a, 0x06, 0xbc, 0x81, 0x27, 0xcd, 0xe9, 0xc7, 0x61, 0x47, 0x3d, 0xdd, 0xed, 0xee,
-	0x37, 0xa4, 0xe8, 0x4d, 0x90, 0x85, 0x91, 0x04, 0x39, 0x04, 0x9b, 0xc3, 0x22, 0x40, 0x02, 0xe4,
-	0x92, 0x9c, 0x82, 0x00, 0xb9, 0x04, 0x39, 0xe5, 0xb0, 0xc8, 0x35, 0xc8, 0x31, 0x97, 0xfc, 0x80,
-	0x20, 0xb7, 0x24, 0x40, 0x80, 0x5c, 0x72, 0x0a, 0x5e, 0xbd, 0x8f, 0x7e, 0xaf, 0xbb, 0x67, 0x38,
-	0xb4, 0xb5, 0xde, 0x8b, 0x34, 0x5d, 0xaf, 0x5e, 0x55, 0xbd, 0x7a, 0xd5, 0xf5, 0xaa, 0x5e, 0x55,
-	0x13, 0x1a, 0x69, 0xd2, 0xdd, 0x4a, 0xd2, 0x98, 0xc5, 0x64, 0xaa, 0xd7, 0x65, 0x69, 0xd2, 0x6d,
-	0x6f, 0xf4, 0xe2, 0xb8, 0x17, 0xd2, 0x3b, 0x5e, 0x12, 0xdc, 0xf1, 0xa2, 0x28, 0x66, 0x1e, 0x0b,
-	0xe2, 0x28, 0x13, 0x58, 0x4e, 0x13, 0x16, 0x76, 0x29, 0x7b, 0x14, 0x1d, 0xc5, 0x2e, 0xfd, 0x7c,
-	0x40, 0x33, 0xe6, 0xfc, 0xc3, 0x24, 0x2c, 0x6a, 0x50, 0x96, 0xc4, 0x51, 0x46, 0xc9, 0x1a, 0x4c,
-	0x0d, 0x12, 0x16, 0xf4, 0x69, 0xab, 0x76, 0xbd, 0x76, 0xab, 0xe1, 0xca, 0x27, 0x72, 0x07, 0x96,
-	0xbd, 0x13, 0x2f, 0x08, 0xbd, 0xc3, 0x90, 0x76, 0xe8, 0xf3, 0xee, 0xb1, 0x17, 0xf5, 0x68, 0xd6,
-	0xaa, 0x5f, 0xaf, 0xdd, 0x9a, 0x70, 0x89, 0x1e, 0x7a, 0xa8, 0x46, 0xc8, 0x6b, 0xb0, 0x44, 0x23,
-	0x0e, 0xf2, 0x0d, 0xf4, 0x09, 0x44, 0x6f, 0xca, 0x81, 0x1c, 0xf9, 0x1e, 0xac, 0xf9, 0xf4, 0xc8,
-	0x1b, 0x84, 0xac, 0x73, 0x14, 0xa7, 0xf4, 0x79, 0x27, 0x49, 0xe3, 0x93, 0xc0, 0xa7, 0x69, 0x6b,
-	0x12, 0xa5, 0x58, 0x91, 0xa3, 0xef, 0xf3, 0xc1, 0x3d, 0x39, 0x46, 0xee, 0xc2, 0xaa, 0x9e, 0x15,
-	0x78, 0xac, 0xd3, 0x1d, 0xa4, 0x29, 0x8d, 0xba, 0x67, 0xad, 0x4b, 0x38, 0x69, 0x59, 0x4d, 0x0a,
-	0x3c, 0xb6, 0x23, 0x87, 0xc8, 0x27, 0xd0, 0xcc, 0x06, 0x87, 0xd9, 0x59, 0xc6, 0x68, 0xbf, 0x93,
-	0x31, 0x8f, 0x0d, 0xb2, 0xd6, 0xd4, 0xf5, 0x89, 0x5b, 0xb3, 0x77, 0x5f, 0xdf, 0x12, 0x6a, 0xdc,
-	0x2a, 0xa8, 0x64, 0x6b, 0x5f, 0xe1, 0xef, 0x23, 0xfa, 0xc3, 0x88, 0xa5, 0x67, 0xee, 0x62, 0x66,
-	0x43, 0xc9, 0x47, 0x30, 0x9f, 0x26, 0xdd, 0x0e, 0x8d, 0xfc, 0x24, 0x0e, 0x22, 0x96, 0xb5, 0xa6,
-	0x91, 0xea, 0xed, 0x61, 0x54, 0xdd, 0xa4, 0xfb, 0x50, 0xe1, 0x0a, 0x92, 0x73, 0xa9, 0x01, 0x6a,
-	0xdf, 0x87, 0x95, 0x2a, 0xc6, 0xa4, 0x09, 0x13, 0xcf, 0xe8, 0x99, 0xdc, 0x1d, 0xfe, 0x93, 0xac,
-	0xc0, 0xa5, 0x13, 0x2f, 0x1c, 0x50, 0xdc, 0x8c, 0x19, 0x57, 0x3c, 0x7c, 0xb7, 0xfe, 0x4e, 0xad,
-	0x7d, 0x00, 0x4b, 0x25, 0x36, 0x15, 0x04, 0x6e, 0x9b, 0x04, 0x66, 0xef, 0x2e, 0x2b, 0x91, 0xdd,
-	0xbd, 0x1d, 0x35, 0xd7, 0xa0, 0xea, 0xdc, 0x80, 0xcd, 0x5d, 0xca, 0x76, 0xe2, 0x7e, 0x7f, 0x10,
-	0x05, 0x5d, 0xb4, 0x31, 0x97, 0x86, 0xde, 0x19, 0x4d, 0x33, 0x65, 0x59, 0x1f, 0xc1, 0x4a, 0xd5,
-	0x38, 0x69, 0xc1, 0xb4, 0xdc, 0x7b, 0xe4, 0x3f, 0xe3, 0xaa, 0x47, 0xb2, 0x01, 0x8d, 0x6e, 0x1c,
-	0x45, 0xb4, 0xcb, 0xa8, 0x2f, 0x17, 0x92, 0x03, 0x9c, 0x3f, 0xaa, 0xc3, 0xf5, 0xe1, 0x3c, 0xa5,
-	0xe9, 0x7e, 0x01, 0x6b, 0x5d, 0x13, 0xa1, 0x93, 0x4a, 0x8c, 0x56, 0x0d, 0xb7, 0x62, 0xc7, 0xd8,
-	0x8a, 0x91, 0x94, 0xb6, 0x2a, 0x47, 0xc5, 0x26, 0xad, 0x76, 0xab, 0xc6, 0xda, 0x47, 0xd0, 0x1e,
-	0x3e, 0xa9, 0x42, 0xe5, 0x77, 0x6d, 0x95, 0x6f, 0x28, 0xd1, 0xaa, 0x88, 0x98, 0xba, 0xff, 0x0e,
-	0xac, 0xef, 0xd2, 0x88, 0xa6, 0x41, 0x57, 0x1b, 0x87, 0xd4, 0x39, 0xd7, 0xa0, 0xb6, 0x49, 0xc9,
-	0x2a, 0x07, 0x38, 0x6d, 0x68, 0x95, 0x27, 0x8a, 0xe5, 0x3a, 0x6b, 0xb0, 0xb2, 0x4b, 0x99, 0x86,
-	0xeb, 0x5d, 0xfc, 0x45, 0x0d, 0x56, 0x71, 0x20, 0x3b, 0xcc, 0xce, 0xc4, 0x80, 0x54, 0xf5, 0x6f,
-	0xc3, 0x92, 0x26, 0x9d, 0xa9, 0xd7, 0x48, 0x68, 0xf9, 0x6d, 0x43, 0xcb, 0xe5, 0x99, 0xf9, 0xcb,
-	0x94, 0x99, 0x6f, 0x53, 0xfe, 0x4e, 0x4a, 0x70, 0x7b, 0x07, 0x56, 0x2b, 0x51, 0x2f, 0x62, 0xff,
-	0x4e, 0x0b, 0xd6, 0x76, 0x29, 0x33, 0xcc, 0xd8, 0x30, 0xd0, 0x59, 0x03, 0xcc, 0xed, 0x32, 0x63,
-	0x5e, 0xca, 0x72, 0xbb, 0x94, 0x8f, 0xe4, 0x65, 0x58, 0x08, 0x83, 0x8c, 0xd1, 0xa8, 0xe3, 0xf9,
-	0x7e, 0x4a, 0x33, 0xe1, 0xf2, 0x1a, 0xee, 0xbc, 0x80, 0x6e, 0x0b, 0xa0, 0xf3, 0x8f, 0x35, 0xbe,
-	0x31, 0x05, 0x56, 0x52, 0x59, 0x8f, 0xa1, 0x91, 0x7b, 0x05, 0xa1, 0xa4, 0x2d, 0x43, 0x49, 0x55,
-	0x73, 0xb6, 0x0a, 0xae, 0x21, 0x27, 0xd0, 0xfe, 0x11, 0x2c, 0xbc, 0xe8, 0x17, 0xfa, 0x1d, 0x68,
-	0x4b, 0xdb, 0x50, 0x1e, 0xf9, 0x23, 0xaf, 0x4f, 0x95, 0x5d, 0xb5, 0x61, 0x46, 0x39, 0x70, 0xc9,
-	0x43, 0x3f, 0x3b, 0x57, 0xe1, 0x4a, 0xe5, 0x4c, 0x69, 0x58, 0x77, 0x60, 0x79, 0x97, 0x32, 0xed,
-	0xe6, 0x15, 0xc5, 0xa1, 0x5e, 0xc0, 0xb9, 0x87, 0x96, 0x68, 0x4c, 0x90, 0x2a, 0xdc, 0x80, 0x46,
-	0x7e, 0x88, 0x48, 0xdb, 0xd6, 0x00, 0xe7, 0x2e, 0x9a, 0xa9, 0x9a, 0xf5, 0xe4, 0x60, 0xcf, 0xa5,
-	0x62, 0xda, 0x65, 0x98, 0x89, 0x59, 0xd2, 0xe9, 0xc6, 0xbe, 0x12, 0x7d, 0x3a, 0x66, 0xc9, 0x4e,
-	0xec, 0x53, 0x69, 0x1a, 0xc6, 0x1c, 0x6d, 0x1a, 0x7f, 0x2d, 0xb6, 0xd2, 0x1e, 0x92, 0x72, 0xfc,
-	0x00, 0x1a, 0x8a, 0xa0, 0xda, 0xca, 0x37, 0x8c, 0xad, 0xac, 0x9a, 0xb3, 0xf5, 0x44, 0x70, 0x94,
-	0x3b, 0x39, 0x23, 0x05, 0xc8, 0xda, 0xef, 0xc2, 0xbc, 0x35, 0x74, 0x9e, 0x65, 0x37, 0xcc, 0x2d,
-	0xbb, 0x07, 0x6b, 0x0f, 0x82, 0xcc, 0x3c, 0x71, 0xc7, 0xd9, 0xae, 0xcf, 0x60, 0x61, 0xcf, 0x0b,
-	0xd2, 0x6c, 0x7f, 0x90, 0x24, 0x31, 0x9a, 0xf7, 0x2b, 0xb0, 0x98, 0x1f, 0xeb, 0x09, 0x1f, 0x93,
-	0x93, 0x16, 0x34, 0x18, 0x67, 0x90, 0x97, 0x60, 0x5e, 0x1d, 0xe7, 0x02, 0x4d, 0x88, 0x34, 0x27,
-	0x81, 0x88, 0xe4, 0x7c, 0x39, 0x69, 0xa9, 0xce, 0x0a, 0x2c, 0x08, 0x4c, 0x46, 0x9e, 0x0e, 0x2b,
-	0xf0, 0xb7, 0x69, 0x08, 0x75, 0xfb, 0x38, 0x68, 0xc1, 0xf4, 0x09, 0x4d, 0x0f, 0xe3, 0x8c, 0x62,
-	0xcc, 0x30, 0xe3, 0xaa, 0x47, 0x2e, 0xc8, 0x20, 0x0b, 0xa2, 0x5e, 0x27, 0xf3, 0x22, 0xff, 0x30,
-	0x7e, 0x8e, 0x11, 0xc2, 0x8c, 0x3b, 0x87, 0xc0, 0x7d, 0x01, 0x23, 0x37, 0x60, 0xee, 0x98, 0xb1,
-	0xa4, 0xc3, 0x43, 0x97, 0x78, 0xc0, 0x64, 0x40, 0x30, 0xcb, 0x61, 0x07, 0x02, 0xc4, 0x5f, 0x6c,
-	0x44, 0x19, 0x64, 0x34, 0xf5, 0x7a, 0x34, 0x62, 0xad, 0x29, 0xf1, 0x62, 0x73, 0xe8, 0xc7, 0x0a,
-	0x48, 0xae, 0x02, 0x20, 0x5a, 0x92, 0xc6, 0xcf, 0xcf, 0x5a, 0xd3, 0xc2, 0xf4, 0x38, 0x64, 0x8f,
-	0x03, 0xb8, 0xfe, 0x0e, 0xbd, 0x8c, 0xaa, 0xd0, 0x23, 0xa0, 0x59, 0x6b, 0x46, 0xe8, 0x8f, 0x83,
-	0x77, 0x34, 0x94, 0x74, 0x78, 0xdc, 0x21, 0xb5, 0xde, 0xf1, 0xb2, 0x8c, 0xb2, 0xac, 0xd5, 0x40,
-	0x03, 0xba, 0x57, 0x61, 0x40, 0x85, 0xf8, 0x43, 0xce, 0xdb, 0xc6, 0x69, 0x3a, 0xfe, 0xb0, 0xa0,
-	0x3c, 0xde, 0xf2, 0x06, 0xec, 0x98, 0x46, 0x8c, 0x9f, 0x1e, 0x9c, 0x49, 0x12, 0xb4, 0x00, 0x75,
-	0xd3, 0xb4, 0x06, 0xb6, 0x93, 0xa0, 0xfd, 0x29, 0x0f, 0x2e, 0xca, 0x54, 0x2b, 0x4c, 0xf0, 0x75,
-	0xdb, 0x95, 0xac, 0x29, 0x61, 0x6d, 0x3b, 0x32, 0x4d, 0xf3, 0x14, 0x9a, 0xbb, 0x94, 0x1d, 0x04,
-	0xdd, 0x67, 0x34, 0x1d, 0xc3, 0x28, 0xc9, 0x2d, 0x98, 0xe4, 0x16, 0x25, 0x19, 0xac, 0xe8, 0x93,
-	0x50, 0x46, 0x6c, 0x9c, 0x91, 0x8b, 0x18, 0x7c, 0x2f, 0x50, 0x73, 0x1d, 0x76, 0x96, 0x08, 0xbb,
-	0x68, 0xb8, 0x0d, 0x84, 0x1c, 0x9c, 0x25, 0xd4, 0x79, 0x0a, 0x73, 0xe6, 0x24, 0xee, 0x34, 0x7c,
-	0x1a, 0x06, 0xfd, 0x80, 0xd1, 0x54, 0x39, 0x0d, 0x0d, 0xe0, 0xf6, 0xc8, 0xb7, 0x48, 0xda, 0x31,
-	0xfe, 0xe6, 0xef, 0xdb, 0xe7, 0x83, 0x98, 0x29, 0xda, 0xe2, 0xc1, 0xf9, 0xb3, 0x3a, 0x2c, 0xa8,
-	0xe5, 0x48, 0x63, 0x56, 0x32, 0xd7, 0xce, 0x95, 0xf9, 0x06, 0xcc, 0x85, 0x5e, 0xc6, 0x3a, 0x83,
-	0xc4, 0xf7, 0x54, 0x68, 0x33, 0xe1, 0xce, 0x72, 0xd8, 0xc7, 0x02, 0xc4, 0x2d, 0x5a, 0x45, 0xae,
-	0xf8, 0x6e, 0x49, 0xee, 0x73, 0x5d, 0x73, 0x31, 0x04, 0x26, 0xf9, 0x1c, 0xb4, 0xf6, 0x9a, 0x8b,
-	0xbf, 0x39, 0xec, 0x38, 0xe8, 0x1d, 0xa3, 0x75, 0xd7, 0x5c, 0xfc, 0xcd, 0x77, 0x30, 0x8c, 0x4f,
-	0xd1, 0x96, 0x6b, 0x2e, 0xff, 0xc9, 0x21, 0x87, 0x81, 0x8f, 0xa6, 0x5b, 0x73, 0xf9, 0x4f, 0x0e,
-	0xf1, 0xb2, 0x67, 0x68, 0xa8, 0x35, 0x97, 0xff, 0xe4, 0x51, 0xff, 0x49, 0x1c, 0x0e, 0xfa, 0xb4,
-	0xd5, 0x40, 0xa0, 0x7c, 0x22, 0x57, 0xa0, 0x91, 0xa4, 0x41, 0x97, 0x76, 0x3c, 0x76, 0x8c, 0xc6,
-	0x54, 0x73, 0x67, 0x10, 0xb0, 0xcd, 0x8e, 0x9d, 0x65, 0x58, 0xd2, 0x1b, 0xad, 0xbd, 0xe7, 0x27,
-	0x30, 0x2d, 0x21, 0x23, 0x37, 0xfd, 0x4d, 0x98, 0x66, 0x02, 0xad, 0x55, 0xc7, 0xb7, 0x40, 0x1b,
-	0x96, 0xad, 0x69, 0x57, 0xa1, 0x39, 0xdf, 0x07, 0x62, 0x72, 0x93, 0x1b, 0x71, 0x3b, 0xa7, 0x23,
-	0xdc, 0xf1, 0xa2, 0x4d, 0x27, 0xcb, 0x09, 0x7c, 0x81, 0x87, 0xd1, 0x93, 0xd4, 0xe7, 0x8e, 0x24,
-	0x7e, 0xf6, 0x8d, 0x9a, 0xe6, 0x0f, 0x61, 0x5e, 0x33, 0x7e, 0xc4, 0x68, 0x9f, 0x2b, 0xdc, 0xeb,
-	0xc7, 0x83, 0x88, 0x21, 0xcf, 0x9a, 0x2b, 0x9f, 0xb8, 0x05, 0xa2, 0x7e, 0x91, 0x65, 0xcd, 0x15,
-	0x0f, 0x64, 0x01, 0xea, 0x81, 0x2f, 0x93, 0xa7, 0x7a, 0xe0, 0x3b, 0xff, 0x57, 0x83, 0x25, 0x63,
-	0x21, 0x17, 0x36, 0xca, 0x92, 0xc5, 0xd5, 0x2b, 0x2c, 0xee, 0x36, 0x4c, 0x1e, 0x06, 0x3e, 0xcf,
-	0xd9, 0xb8, 0x5e, 0x57, 0x15, 0x39, 0x6b, 0x1d, 0x2e, 0xa2, 0x70, 0x54, 0x2f, 0x7b, 0x96, 0xb5,
-	0x26, 0x47, 0xa2, 0x72, 0x94, 0xd2, 0xfb, 0x70, 0xa9, 0xfc, 0x3e, 0xd8, 0xba, 0x9c, 0x2a, 0xea,
-	0x52, 0x44, 0xab, 0x9a, 0xb6, 0xb6, 0xbc, 0x2e, 0x40, 0x0e, 0x1c, 0xb9, 0xad, 0xbf, 0x0e, 0x10,
-	0x6b, 0x4c, 0x69, 0x7f, 0x97, 0x4b, 0x42, 0x6b, 0x13, 0x34, 0x90, 0x9d, 0x0f, 0x31, 0xd4, 0x30,
-	0x99, 0x4b, 0xe5, 0xdf, 0xb5, 0x68, 0x0a, 0x5b, 0x24, 0x25, 0x9a, 0x99, 0x45, 0xec, 0x6d, 0x24,
-	0xb6, 0xdd, 0xed, 0xf2, 0xad, 0x37, 0x12, 0xf3, 0x91, 0x67, 0xf8, 0x53, 0x98, 0x96, 0x33, 0xa4,
-	0x59, 0x08, 0x84, 0x7a, 0xe0, 0x93, 0x77, 0x01, 0x8c, 0x73, 0x48, 0xac, 0xeb, 0x8a, 0x92, 0x41,
-	0x4e, 0x52, 0xd6, 0x80, 0xec, 0x0c, 0x74, 0xe7, 0x08, 0x96, 0x2b, 0x50, 0xb8, 0x28, 0x3a, 0xad,
-	0x96, 0xa2, 0xa8, 0x67, 0xb2, 0x09, 0xb3, 0x2c, 0x66, 0x5e, 0xd8, 0xc9, 0x4f, 0x88, 0x9a, 0x0b,
-	0x08, 0x7a, 0xca, 0x21, 0xe8, 0xa0, 0xe2, 0x50, 0x58, 0x2e, 0x77, 0x50, 0x71, 0xe8, 0x3b, 0x1e,
-	0x06, 0x5e, 0xd6, 0xa2, 0xa5, 0x0a, 0x47, 0x6d, 0xd9, 0x6b, 0x30, 0xe3, 0x89, 0x29, 0x6a, 0x61,
-	0x8b, 0x85, 0x85, 0xb9, 0x1a, 0xc1, 0x21, 0x78, 0x02, 0xed, 0xc4, 0xd1, 0x51, 0xd0, 0x53, 0xd6,
-	0xf1, 0x0a, 0x3a, 0x2b, 0x05, 0xcb, 0x63, 0x12, 0xdf, 0x63, 0x1e, 0x72, 0x9b, 0x73, 0xf1, 0xb7,
-	0xf3, 0x87, 0x35, 0x68, 0xee, 0xc5, 0x29, 0x3b, 0x8a, 0xc3, 0x20, 0x96, 0xe1, 0x3d, 0x0f, 0x47,
-	0x54, 0xf8, 0x2f, 0xe3, 0x48, 0xf9, 0xc8, 0x3d, 0x64, 0x37, 0x0e, 0x22, 0x61, 0xab, 0x75, 0xa9,
-	0xa0, 0x38, 0x88, 0xb8, 0xa9, 0x92, 0xeb, 0x30, 0xeb, 0xd3, 0xac, 0x9b, 0x06, 0x09, 0x4f, 0xe7,
-	0xa4, 0x5b, 0x30, 0x41, 0x9c, 0xf0, 0xa1, 0x17, 0x7a, 0x51, 0x97, 0x4a, 0xcf, 0xae, 0x1e, 0x9d,
-	0x55, 0x74, 0x57, 0x5a, 0x12, 0x23, 0xb3, 0xb6, 0xc1, 0x72, 0x29, 0xbf, 0x06, 0x8d, 0x44, 0x01,
-	0xa5, 0xf9, 0xb5, 0xf4, 0x59, 0x5d, 0x58, 0x8e, 0x9b, 0xa3, 0x3a, 0x1b, 0x3c, 0xf6, 0xcf, 0xe9,
-	0xed, 0x0f, 0xfa, 0x7d, 0x2f, 0x3d, 0x53, 0xdc, 0x22, 0x98, 0xdc, 0x89, 0x83, 0x88, 0x2b, 0x8a,
-	0x2f, 0x4a, 0x05, 0x6f, 0xfc, 0xb7, 0x29, 0x7a, 0xdd, 0x12, 0xdd, 0xd4, 0xd6, 0x84, 0xad, 0xad,
-	0x6b, 0x00, 0x09, 0x4d, 0xbb, 0x34, 0x62, 0x5e, 0x4f, 0xad, 0xd8, 0x80, 0x38, 0xc7, 0x40, 0x9e,
-	0x1c, 0x1d, 0x85, 0x41, 0x44, 0x39, 0x5b, 0x29, 0xcc, 0x08, 0xed, 0x0f, 0x97, 0xc1, 0xe6, 0x34,
-	0x51, 0xe2, 0xf4, 0x43, 0x58, 0x7a, 0x12, 0x55, 0x30, 0x52, 0xe4, 0x6a, 0xa3, 0xc8, 0xd5, 0x4b,
-	0xe4, 0x3e, 0x80, 0x39, 0x43, 0xf0, 0x8c, 0xbc, 0x03, 0x0d, 0x29, 0xa3, 0x4e, 0x14, 0xda, 0xda,
-	0x1b, 0x94, 0x56, 0xe8, 0xe6, 0xc8, 0xce, 0x9f, 0xd7, 0x60, 0x36, 0x97, 0x2c, 0x23, 0xf7, 0xe0,
-	0x12, 0x57, 0xb7, 0xa2, 0x72, 0x4d, 0x53, 0xc9, 0x71, 0xb6, 0xf0, 0x5f, 0x11, 0x17, 0x0a, 0xe4,
-	0xf6, 0x3e, 0x40, 0x0e, 0xac, 0x08, 0xeb, 0xee, 0xd8, 0x61, 0xdd, 0xe5, 0x32, 0x55, 0x25, 0x9a,
-	0x11, 0xd9, 0xfd, 0xcb, 0x24, 0x4f, 0xf7, 0x2a, 0x8c, 0x45, 0xda, 0xe0, 0x1b, 0x30, 0x2b, 0xde,
-	0x05, 0xee, 0x01, 0x94, 0xc0, 0x73, 0xf9, 0xd5, 0x46, 0x10, 0xb9, 0x80, 0xef, 0x06, 0x8e, 0x93,
-	0xb7, 0x60, 0x1e, 0x85, 0xed, 0xc4, 0x42, 0x21, 0xf2, 0xc5, 0xb6, 0x27, 0xcc, 0x21, 0x8a, 0x54,
-	0x19, 0x49, 0x60, 0xd5, 0x9a, 0xd2, 0xc9, 0x84, 0x08, 0xf2, 0x90, 0x7a, 0xcf, 0x08, 0xa5, 0x87,
-	0x49, 0x29, 0x94, 0x25, 0x09, 0xca, 0x31, 0xa1, 0xba, 0xe5, 0x6e, 0x79, 0x84, 0xdc, 0x81, 0x39,
-	0xc9, 0x11, 0x35, 0x23, 0x8f, 0x38, 0x5b, 0xc6, 0x59, 0x31, 0x11, 0x11, 0x48, 0x1f, 0x56, 0xcc,
-	0x09, 0x5a, 0xc2, 0x4b, 0x38, 0xf1, 0xdd, 0xf1, 0x25, 0x8c, 0x4a, 0x02, 0x92, 0x6e, 0x69, 0xa0,
-	0xfd, 0x5b, 0xd0, 0x1a, 0xb6, 0xa0, 0x8a, 0x6d, 0x7f, 0xd5, 0xde, 0xf6, 0x95, 0x0a, 0x93, 0xcc,
-	0xcc, 0x0b, 0xc4, 0x4f, 0x61, 0x7d, 0x88, 0x30, 0x17, 0xb8, 0x75, 0x30, 0x2c, 0xd5, 0xb4, 0xa6,
-	0x3f, 0xad, 0x41, 0x7b, 0xdb, 0xf7, 0x4b, 0xce, 0x29, 0xbf, 0x24, 0xf8, 0xa6, 0x5d, 0xee, 0x55,
-	0xb8, 0x52, 0x29, 0x90, 0xbc, 0xcd, 0x78, 0x0e, 0x57, 0x5d, 0xda, 0x8f, 0x4f, 0xe8, 0x37, 0x2d,
-	0xb2, 0x73, 0x1d, 0xae, 0x0d, 0xe3, 0x2c, 0x65, 0xc3, 0xeb, 0x3d, 0xfb, 0x7a, 0x5c, 0x07, 0x46,
-	0xff, 0x59, 0x83, 0x79, 0xfb, 0xe2, 0xfc, 0x45, 0xe5, 0xe2, 0xaf, 0x03, 0x49, 0x69, 0xc6, 0x3a,
-	0x49, 0x1c, 0x86, 0x3c, 0x25, 0xf7, 0x69, 0xe8, 0x9d, 0xc9, 0x2b, 0xfb, 0x26, 0x1f, 0xd9, 0x13,
-	0x03, 0x0f, 0x38, 0x9c, 0xac, 0xc3, 0xb4, 0x97, 0x04, 0x1d, 0x6e, 0x35, 0x22, 0x1f, 0x9f, 0xf2,
-	0x92, 0xe0, 0x43, 0x7a, 0x46, 0x1c, 0x98, 0x97, 0x03, 0x9d, 0x90, 0x9e, 0xd0, 0x10, 0x63, 0xbe,
-	0x09, 0x77, 0x56, 0x0c, 0x3f, 0xe6, 0x20, 0x72, 0x1b, 0x9a, 0x49, 0x1a, 0x70, 0xf3, 0xcb, 0x6b,
-	0x03, 0xd3, 0x28, 0xcd, 0xa2, 0x84, 0xab, 0xd5, 0x39, 0x3f, 0x86, 0xcb, 0x15, 0xba, 0x90, 0x3e,
-	0xea, 0x7b, 0xb0, 0x68, 0x57, 0x18, 0x94, 0x9f, 0xd2, 0x51, 0xab, 0x35, 0xd1, 0x5d, 0x38, 0xb2,
-	0xe8, 0xc8, 0xe8, 0x13, 0x71, 0x5c, 0x8f, 0xe9, 0x3b, 0x2d, 0xe7, 0x73, 0x58, 0xc9, 0x81, 0x3b,
-	0x71, 0x74, 0x42, 0xd3, 0x8c, 0x5b, 0x1b, 0x81, 0xc9, 0xa3, 0x34, 0x56, 0x17, 0xb2, 0xf8, 0x9b,
-	0xc7, 0x6d, 0x2c, 0x96, 0x66, 0x50, 0x67, 0x31, 0xc7, 0x49, 0x3d, 0xa6, 0x4e, 0x29, 0xfc, 0xcd,
-	0xe3, 0xe4, 0x00, 0x89, 0xd0, 0x0e, 0x8e, 0x09, 0x53, 0x9d, 0x95, 0x30, 0xce, 0xc5, 0x79, 0x8a,
-	0xe1, 0xa3, 0x29, 0x8a, 0x5c, 0xe3, 0x6f, 0xc0, 0xac, 0x58, 0x23, 0x9f, 0xa9, 0xd6, 0xb7, 0x61,
-	0xad, 0xaf, 0x20, 0xa6, 0x0b, 0x47, 0x1a, 0xea, 0xfc, 0x77, 0x1d, 0xe6, 0x30, 0x62, 0x7d, 0x40,
-	0x99, 0x17, 0x84, 0xa3, 0x63, 0x69, 0x11, 0x83, 0xd6, 0x75, 0x0c, 0xfa, 0x12, 0xcc, 0x9b, 0x17,
-	0x22, 0x67, 0x2a, 0x99, 0x35, 0xae, 0x43, 0xce, 0xc8, 0xcb, 0xb0, 0x80, 0xa9, 0x75, 0x8e, 0x25,
-	0x6c, 0x66, 0x1e, 0xa1, 0x1a, 0xcd, 0x4e, 0x04, 0x2e, 0x15, 0x12, 0x01, 0x3e, 0x8c, 0xc1, 0x74,
-	0x27, 0x0b, 0x7c, 0x9d, 0x27, 0x20, 0x64, 0x3f, 0xf0, 0x8d, 0x61, 0x9c, 0x3d, 0x6d, 0x0c, 0xe3,
-	0x6c, 0x9e, 0x03, 0xa5, 0x54, 0x14, 0x0a, 0xb0, 0xde, 0x35, 0x83, 0x46, 0x37, 0xa7, 0x80, 0x07,
-	0x41, 0x1f, 0xab, 0x61, 0xf2, 0x72, 0xbb, 0x21, 0x2c, 0x56, 0x3c, 0xe5, 0x69, 0x1a, 0x98, 0x69,
-	0x5a, 0x9e, 0xd4, 0xcd, 0x5a, 0x49, 0xdd, 0x26, 0xcc, 0xc6, 0x09, 0x8d, 0x3a, 0x32, 0xc5, 0x9e,
-	0x13, 0xd1, 0x03, 0x07, 0x3d, 0x45, 0x88, 0xbc, 0x32, 0x41, 0x9d, 0x67, 0xe3, 0xe4, 0xa5, 0xb6,
-	0x62, 0xea, 0x45, 0xc5, 0xa8, 0x44, 0x70, 0xe2, 0xbc, 0x44, 0xd0, 0xd9, 0xc6, 0xa8, 0x58, 0x31,
-	0x96, 0xe6, 0xf3, 0x3a, 0x4c, 0xa1, 0x9a, 0x94, 0xe5, 0xac, 0x58, 0x69, 0x8c, 0x34, 0x0a, 0x57,
-	0xe2, 0x38, 0x1f, 0x60, 0x0d, 0x11, 0x87, 0xc6, 0x11, 0xfd, 0x32, 0xcc, 0x88, 0x5d, 0xd1, 0x56,
-	0x33, 0x8d, 0xcf, 0x8f, 0x7c, 0xe7, 0xdf, 0x6a, 0x40, 0xf6, 0x07, 0x87, 0xfd, 0x60, 0x7c, 0x6a,
-	0xe3, 0x27, 0xe8, 0x04, 0x26, 0xd1, 0x4c, 0x84, 0x39, 0xe2, 0xef, 0x82, 0x85, 0x4c, 0x16, 0x2d,
-	0x24, 0xdf, 0xce, 0x4b, 0xd5, 0x39, 0xfa, 0x94, 0xb9, 0xf9, 0xdc, 0xc5, 0x87, 0x01, 0x8d, 0x58,
-	0x47, 0x5e, 0xb6, 0x70, 0x17, 0x8f, 0x80, 0x47, 0xbe, 0xb3, 0x0f, 0xcb, 0xd6, 0xca, 0xa4, 0xa6,
-	0x6f, 0xc0, 0x9c, 0x10, 0x20, 0x09, 0xbd, 0xae, 0xbe, 0x0d, 0x9f, 0x45, 0xd8, 0x1e, 0x82, 0x46,
-	0xe9, 0xeb, 0x8f, 0x6b, 0xb0, 0xb2, 0x1f, 0xf4, 0x07, 0xa1, 0xc7, 0xe8, 0x2f, 0x41, 0x63, 0xf9,
-	0xf2, 0x27, 0xac, 0xe5, 0x2b, 0x4d, 0x4e, 0xe6, 0x9a, 0x74, 0xfe, 0xa7, 0x06, 0xab, 0x05, 0x51,
-	0x74, 0x4c, 0x68, 0x1b, 0xd3, 0x90, 0xcb, 0x01, 0x89, 0x64, 0x30, 0xad, 0x5b, 0x4c, 0x5f, 0x82,
-	0xf9, 0x7e, 0x10, 0x05, 0xfd, 0x41, 0xbf, 0x23, 0x74, 0x2f, 0x64, 0x9a, 0x93, 0xc0, 0x3d, 0xdc,
-	0x02, 0x8e, 0xe4, 0x3d, 0x37, 0x90, 0x26, 0x25, 0x92, 0x00, 0x0a, 0xa4, 0x37, 0x61, 0x25, 0x8f,
-	0xdb, 0x3b, 0x3d, 0x2f, 0x88, 0x3a, 0x61, 0x9c, 0x65, 0x72, 0x8f, 0x49, 0x3e, 0xb6, 0xeb, 0x05,
-	0xd1, 0xe3, 0x38, 0xcb, 0x0c, 0x27, 0x30, 0x65, 0x3a, 0x01, 0x1e, 0xc0, 0x34, 0x3f, 0x39, 0xf6,
-	0x42, 0x7a, 0x3f, 0xee, 0x1f, 0xbe, 0x58, 0xdd, 0xdf, 0x80, 0x39, 0x71, 0xef, 0xc6, 0xbc, 0xb4,
-	0x47, 0xd5, 0x0e, 0xcc, 0x22, 0xec, 0x00, 0x41, 0x95, 0xdb, 0xf0, 0x5f, 0x35, 0x20, 0x3b, 0x3c,
-	0x94, 0x09, 0xc7, 0xb6, 0x07, 0xee, 0x4a, 0x44, 0xde, 0x9c, 0x5b, 0x58, 0x43, 0x42, 0x1e, 0xd9,
-	0xe6, 0x37, 0x61, 0x99, 0x9f, 0x5e, 0xcd, 0xe4, 0x05, 0x2f, 0xc7, 0x4a, 0x7e, 0xfc, 0x65, 0x58,
-	0x38, 0xf5, 0xc2, 0x90, 0x32, 0x5d, 0x62, 0x93, 0x37, 0xf1, 0x02, 0xaa, 0x72, 0x70, 0xb5, 0xe0,
-	0x69, 0x63, 0xc1, 0xab, 0xb0, 0x6c, 0xad, 0x57, 0x46, 0x43, 0xf7, 0x60, 0x4d, 0x80, 0xb7, 0xc3,
-	0x70, 0x6c, 0xaf, 0xea, 0xfc, 0x65, 0x1d, 0xd6, 0x4b, 0xd3, 0x74, 0xd8, 0x60, 0x9b, 0xf1, 0x4d,
-	0xbd, 0xdc, 0xea, 0x09, 0x5b, 0xf2, 0x51, 0xce, 0x6a, 0xff, 0x53, 0x0d, 0xa6, 0x04, 0x68, 0xe4,
-	0x6e, 0x7c, 0xaa, 0x1c, 0x82, 0x34, 0x38, 0x91, 0x11, 0x7d, 0x67, 0x3c, 0x66, 0xe2, 0x3f, 0xb3,
-	0xac, 0x2a, 0x3c, 0x89, 0xac, 0xa8, 0x7e, 0x0f, 0x9a, 0x45, 0x84, 0x0b, 0x95, 0x9c, 0xc4, 0xad,
-	0xca, 0xc3, 0x13, 0x6a, 0x94, 0x51, 0x7f, 0x51, 0x83, 0xc5, 0x9d, 0x38, 0xf2, 0x03, 0x7e, 0x62,
-	0xee, 0x79, 0xa9, 0xd7, 0xcf, 0x64, 0x25, 0x5f, 0x80, 0xd4, 0xb5, 0xbb, 0x06, 0x0c, 0xb9, 0xe0,
-	0xbc, 0x0a, 0xd0, 0x3d, 0xa6, 0xdd, 0x67, 0x1d, 0x79, 0xe3, 0x28, 0xca, 0xff, 0x1c, 0x72, 0x3f,
-	0xf0, 0x33, 0xf2, 0x06, 0x2c, 0xe7, 0xc3, 0x1d, 0x2f, 0xf2, 0x3b, 0xf2, 0xba, 0x11, 0xab, 0x1b,
-	0x1a, 0x6f, 0x3b, 0xf2, 0xb7, 0xb3, 0x67, 0x19, 0x8f, 0x15, 0xf5, 0x2d, 0x5b, 0xc7, 0x72, 0xe1,
-	0x8b, 0x1a, 0xbe, 0x8d, 0x60, 0xe7, 0x7f, 0x6b, 0x78, 0x02, 0xaa, 0x55, 0xc9, 0xdd, 0xce, 0x2f,
-	0xd6, 0xf0, 0xbe, 0xd5, 0xda, 0xb2, 0x7a, 0x61, 0xcb, 0x08, 0x4c, 0x06, 0x8c, 0xf6, 0xd5, 0xc1,
-	0xc2, 0x7f, 0x93, 0xfb, 0xd0, 0xd4, 0x2b, 0xee, 0x24, 0xa8, 0x16, 0xf9, 0x9a, 0xac, 0xe7, 0x89,
-	0xa3, 0xa5, 0x35, 0x77, 0xb1, 0x5b, 0x50, 0xa3, 0x7a, 0xbd, 0x2e, 0x8d, 0xe5, 0xa8, 0xbb, 0xa8,
-	0x6d, 0xe9, 0x9f, 0xc4, 0x93, 0x90, 0x9a, 0x76, 0x07, 0x8c, 0xfa, 0x32, 0x54, 0xd6, 0xcf, 0xce,
-	0x7f, 0xd4, 0x60, 0x71, 0xdb, 0xf7, 0x71, 0xdd, 0xe3, 0xb8, 0x09, 0xb5, 0xca, 0xfa, 0x39, 0xab,
-	0x9c, 0xf8, 0x8a, 0xab, 0xfc, 0xda, 0x4e, 0x64, 0x88, 0x12, 0x1c, 0x07, 0x9a, 0xf9, 0x3a, 0xab,
-	0xb7, 0xd7, 0xf9, 0x16, 0x10, 0x91, 0x5e, 0x59, 0xea, 0x28, 0x62, 0xad, 0xc2, 0xb2, 0x85, 0x25,
-	0x7d, 0xcd, 0xfb, 0x70, 0x6b, 0x97, 0xb2, 0x9d, 0xf4, 0x2c, 0x61, 0xb1, 0x0a, 0x67, 0x1f, 0xd0,
-	0x24, 0xce, 0x02, 0xe5, 0xb9, 0xe8, 0x58, 0xde, 0xe7, 0x9f, 0x6b, 0x70, 0x7b, 0x0c, 0x42, 0x72,
-	0x09, 0x9f, 0x95, 0xef, 0x97, 0x7e, 0xd3, 0x6c, 0x6f, 0x19, 0x8b, 0xca, 0x96, 0x86, 0xc8, 0x2e,
-	0x03, 0x4d, 0xb2, 0xfd, 0x1e, 0x2c, 0xd8, 0x83, 0x17, 0x72, 0x15, 0x21, 0xdc, 0x3c, 0x47, 0x88,
-	0x71, 0x6c, 0xee, 0x26, 0x2c, 0x74, 0x2d, 0x12, 0x92, 0x51, 0x01, 0xea, 0xec, 0xc0, 0x2b, 0xe7,
-	0x72, 0x93, 0x6a, 0x1b, 0x9a, 0xa1, 0x3b, 0x7f, 0x37, 0x09, 0xeb, 0x9f, 0x04, 0xec, 0xd8, 0x4f,
-	0xbd, 0x53, 0x65, 0x7d, 0xe3, 0x08, 0x59, 0x48, 0xde, 0xeb, 0xe5, 0xfb, 0x86, 0x57, 0x61, 0x29,
-	0x8e, 0x28, 0xe6, 0x18, 0x9d, 0xc4, 0xcb, 0xb2, 0xd3, 0x38, 0x55, 0x67, 0xe9, 0x62, 0x1c, 0x51,
-	0x9e, 0x67, 0xec, 0x49, 0x70, 0xe1, 0x34, 0x9e, 0x2c, 0x9e, 0xc6, 0x4d, 0x98, 0x48, 0x82, 0x48,
-	0xd6, 0x4c, 0xf8, 0x4f, 0x7e, 0x76, 0xb2, 0xd4, 0xf3, 0x0d, 0xca, 0xf2, 0xec, 0x44, 0xa8, 0xa6,
-	0x6b, 0xde, 0xe2, 0x4f, 0x17, 0x6e, 0xf1, 0x0d, 0x9d, 0xcc, 0xd8, 0xb7, 0x16, 0x9b, 0x30, 0x2b,
-	0x7f, 0x76, 0x98, 0xd7, 0x93, 0x29, 0x10, 0x48, 0xd0, 0x81, 0xd7, 0x33, 0xa2, 0x35, 0xb0, 0xa2,
-	0xb5, 0xab, 0x00, 0x47, 0x94, 0x76, 0xac, 0x64, 0xa8, 0x71, 0x44, 0xa9, 0x70, 0xba, 0x3c, 0x54,
-	0x3e, 0xf4, 0xa2, 0x67, 0x1d, 0xbc, 0x83, 0x98, 0x13, 0xe2, 0x70, 0xc0, 0x47, 0x5e, 0x1f, 0x63,
-	0x62, 0x1c, 0x54, 0x32, 0xcd, 0x0b, 0x8d, 0x72, 0xd8, 0x76, 0x7e, 0x9b, 0x82, 0x28, 0xdd, 0x80,
-	0x9d, 0xb5, 0x16, 0xf2, 0xf9, 0x3b, 0x01, 0x3b, 0xd3, 0xf3, 0x51, 0x67, 0xe9, 0x59, 0x6b, 0x31,
-	0x9f, 0xbf, 0x23, 0x40, 0x5c, 0xbc, 0xec, 0x34, 0x38, 0xa2, 0xa2, 0x31, 0xa4, 0x29, 0x5b, 0xa5,
-	0x38, 0x64, 0x27, 0xf6, 0x31, 0x8c, 0x3c, 0x0d, 0x52, 0x23, 0x39, 0x5d, 0x12, 0x29, 0x2c, 0x07,
-	0x2a, 0xd3, 0x70, 0x5e, 0x85, 0xa6, 0x32, 0x17, 0xb3, 0x77, 0x32, 0xa5, 0xd9, 0x20, 0x64, 0xaa,
-	0x77, 0x52, 0x3c, 0x39, 0x6f, 0x61, 0x57, 0xc4, 0xe3, 0xb8, 0xd7, 0xcb, 0xd3, 0x27, 0x69, 0x5a,
-	0x6b, 0x30, 0x15, 0x22, 0x5c, 0x4d, 0x11, 0x4f, 0x4e, 0x84, 0xf7, 0x39, 0x85, 0x29, 0x79, 0xd5,
-	0x22, 0x88, 0x8e, 0x62, 0x99, 0x2d, 0xe0, 0x6f, 0xfe, 0x2e, 0xfa, 0xf4, 0x70, 0xd0, 0x53, 0x3d,
-	0x50, 0xf8, 0xc0, 0x31, 0x4f, 0xbd, 0x34, 0x92, 0x07, 0x2a, 0xfe, 0xe6, 0x98, 0x34, 0x4d, 0xe3,
-	0x54, 0x9e, 0x9e, 0xe2, 0xc1, 0xd9, 0x85, 0xf5, 0xfd, 0x8b, 0x89, 0xc8, 0x09, 0x89, 0xdb, 0x1a,
-	0xf9, 0xfa, 0xe3, 0x83, 0xf3, 0xa1, 0xd5, 0x01, 0x82, 0x5d, 0x02, 0xe3, 0xbc, 0x46, 0x2b, 0x70,
-	0x09, 0x7d, 0xb9, 0x22, 0x86, 0x0f, 0x3c, 0x23, 0x6c, 0x95, 0xa9, 0xe9, 0x1e, 0xb4, 0x72, 0x47,
-	0x85, 0xf0, 0x84, 0xdf, 0xae, 0xe8, 0xa8, 0xb0, 0xe6, 0x8e, 0xd7, 0x52, 0xf1, 0x4b, 0xed, 0x92,
-	0xf8, 0x02, 0x96, 0x4d, 0xd1, 0xbe, 0xd1, 0xac, 0xff, 0xa7, 0x35, 0xbc, 0x21, 0xd3, 0x19, 0xd8,
-	0x3e, 0x4b, 0xa9, 0xd7, 0xff, 0x46, 0x0b, 0xe2, 0xdf, 0x87, 0x1b, 0x66, 0xbf, 0xd4, 0x85, 0x25,
-	0x71, 0x7e, 0x0f, 0xcb, 0x88, 0xa2, 0xc8, 0xff, 0x2b, 0x90, 0xff, 0x3d, 0xb8, 0x66, 0xc8, 0x7f,
-	0x41, 0x31, 0x9c, 0xbf, 0xa8, 0xe1, 0x2d, 0xe2, 0xf6, 0xc0, 0x0f, 0x98, 0x15, 0x73, 0x70, 0xcf,
-	0xc4, 0xbc, 0x94, 0x75, 0x7c, 0x8f, 0x51, 0xdd, 0xc4, 0xc9, 0x21, 0x0f, 0x3c, 0x86, 0x97, 0x27,
-	0x34, 0xf2, 0xc5, 0xa0, 0xbc, 0x0c, 0xa0, 0x91, 0xaf, 0x86, 0x44, 0xe6, 0x70, 0x78, 0x66, 0x25,
-	0x6a, 0xf7, 0xf1, 0x9c, 0xc6, 0xa6, 0x17, 0x7c, 0xe3, 0x2f, 0xb9, 0xe2, 0x81, 0xbf, 0xd6, 0xf1,
-	0xd1, 0x11, 0x7f, 0xe5, 0x2e, 0x21, 0x58, 0x3e, 0x39, 0x3b, 0xa2, 0x28, 0x6d, 0x88, 0x26, 0xdf,
-	0xb7, 0x57, 0x61, 0x8a, 0x62, 0x98, 0x5c, 0xac, 0x6e, 0x1b, 0xb8, 0x12, 0xc3, 0xf9, 0x2b, 0x61,
-	0x61, 0x1f, 0x04, 0x19, 0x8b, 0xd3, 0xa0, 0xbb, 0xe3, 0x45, 0x7e, 0x38, 0x56, 0x18, 0x74, 0x81,
-	0x1d, 0xda, 0x80, 0x46, 0x8a, 0xfd, 0x7f, 0xc1, 0x17, 0x54, 0xf6, 0x46, 0xe4, 0x00, 0x7e, 0x2e,
-	0xf7, 0x52, 0x2f, 0x1a, 0x84, 0x5e, 0xca, 0x4f, 0x89, 0x49, 0x71, 0xa3, 0x6c, 0x80, 0x9c, 0x07,
-	0x58, 0xf9, 0x2c, 0x89, 0x28, 0x57, 0x7b, 0x13, 0xa6, 0xba, 0x08, 0x92, 0xab, 0x5d, 0x30, 0x72,
-	0x30, 0x3f, 0xa4, 0xae, 0x1c, 0x75, 0xfe, 0xa0, 0x06, 0x53, 0x02, 0xc4, 0xbd, 0xad, 0x6e, 0x9c,
-	0x9f, 0x70, 0xf1, 0xb7, 0x6a, 0xc7, 0xa9, 0xe7, 0xed, 0x38, 0xaa, 0x69, 0x67, 0xc2, 0x68, 0xda,
-	0x21, 0x30, 0x19, 0x27, 0x34, 0x52, 0xcd, 0x3d, 0xfc, 0x37, 0xdf, 0xb5, 0x6e, 0x18, 0x67, 0x54,
-	0x66, 0x2e, 0xe2, 0xc1, 0x68, 0xd4, 0x99, 0x32, 0x1b, 0x75, 0x9c, 0xe7, 0x00, 0xf9, 0x36, 0xa0,
-	0x24, 0xdc, 0x6c, 0xe5, 0xa5, 0x33, 0xff, 0x4d, 0xae, 0x01, 0x04, 0x3e, 0x8d, 0x58, 0x70, 0x14,
-	0x50, 0xd5, 0xf0, 0x61, 0x40, 0x78, 0x18, 0xd0, 0xa7, 0x59, 0xa6, 0xaa, 0xa5, 0x0d, 0x57, 0x3d,
-	0x72, 0x45, 0xf3, 0xb5, 0x64, 0xcc, 0xeb, 0x27, 0x2a, 0x26, 0xd1, 0x00, 0xe7, 0x10, 0x1a, 0xbb,
-	0x3b, 0x07, 0xfb, 0x18, 0xee, 0x70, 0xc6, 0x1f, 0x7f, 0xfc, 0xe8, 0x81, 0x62, 0xcc, 0x7f, 0xeb,
-	0x62, 0x43, 0xdd, 0x28, 0x36, 0x10, 0xbe, 0xcb, 0xec, 0x58, 0x25, 0x4d, 0xfc, 0x37, 0xb7, 0xe0,
-	0x88, 0x3e, 0x67, 0x9d, 0x74, 0x10, 0x49, 0x2e, 0xd3, 0xfc, 0xd9, 0x1d, 0x44, 0xce, 0x03, 0x58,
-	0xd7, 0x3c, 0x1e, 0x8a, 0x14, 0x46, 0xd9, 0xd2, 0x6d, 0x98, 0x12, 0xa1, 0x96, 0x6c, 0x7b, 0x59,
-	0xd2, 0xbe, 0x5f, 0x4d, 0x70, 0x25, 0x82, 0xb3, 0x0d, 0x2b, 0x1a, 0xb8, 0xcf, 0xe2, 0xe4, 0x2b,
-	0x90, 0xb8, 0x6c, 0x08, 0xc2, 0x49, 0x6c, 0x87, 0xa1, 0x4a, 0x85, 0x5b, 0xb0, 0x66, 0x0c, 0xf1,
-	0x14, 0x5b, 0x8d, 0x98, 0x93, 0x1e, 0x07, 0x19, 0x33, 0x26, 0xfd, 0x4d, 0xcd, 0x98, 0xf5, 0x71,
-	0x12, 0xc6, 0x9e, 0xaf, 0xa4, 0xda, 0x84, 0x59, 0xc1, 0xb4, 0x63, 0x94, 0x6a, 0x40, 0x80, 0x30,
-	0x50, 0xca, 0x11, 0xb0, 0x87, 0xa1, 0x6e, 0x22, 0x3c, 0xf0, 0x98, 0xa7, 0xbb, 0x1b, 0x26, 0xf2,
-	0xee, 0x06, 0xfe, 0xea, 0x79, 0x69, 0xf7, 0x38, 0x38, 0xa1, 0xbe, 0x0c, 0x00, 0xf4, 0x33, 0xdf,
-	0xe7, 0xf8, 0x84, 0xa6, 0xa7, 0x69, 0xc0, 0x84, 0xd5, 0xcd, 0xb8, 0x39, 0xc0, 0xd9, 0x85, 0x76,
-	0xae, 0x0f, 0xea, 0xf9, 0xea, 0xd7, 0x85, 0x75, 0x78, 0x1f, 0x56, 0x35, 0xf0, 0x47, 0x03, 0xaa,
-	0x9b, 0x0d, 0x2e, 0x42, 0xe3, 0x07, 0xd0, 0xd2, 0xc0, 0xed, 0x01, 0x8b, 0x1f, 0x1b, 0x8a, 0x5b,
-	0xb3, 0xc8, 0x34, 0xd4, 0x1c, 0xe3, 0x1a, 0x4f, 0xc4, 0x48, 0xea, 0x1a, 0xef, 0x33, 0x6b, 0x4f,
-	0xc5, 0xc6, 0xe5, 0x01, 0x9d, 0xee, 0x6d, 0x37, 0xaf, 0xff, 0x5f, 0x83, 0x69, 0x41, 0x54, 0xdd,
-	0xd0, 0x54, 0x88, 0xaa, 0x30, 0x9c, 0xd8, 0xd8, 0x62, 0xb9, 0xde, 0x73, 0xc8, 0xe7, 0x8a, 0xa8,
-	0x9f, 0xa3, 0x08, 0x6b, 0x8f, 0x1b, 0xb2, 0x83, 0xe5, 0x7d, 0x43, 0x39, 0xb2, 0x3b, 0xfb, 0x5c,
-	0x96, 0x8a, 0x4e, 0x3d, 0xa7, 0x73, 0xf7, 0x67, 0xdf, 0x86, 0x85, 0xdd, 0x58, 0xe4, 0x55, 0x07,
-	0x3c, 0x9d, 0x48, 0xc9, 0x13, 0x98, 0x96, 0xdf, 0xb1, 0x90, 0xb5, 0xd2, 0x87, 0x2d, 0xa8, 0xfe,
-	0xf6, 0xfa, 0x90, 0x0f, 0x5e, 0x9c, 0xe5, 0x2f, 0xff, 0xf5, 0xdf, 0x7f, 0x5e, 0x9f, 0x27, 0xb3,
-	0x77, 0x4e, 0xde, 0xba, 0xd3, 0xa3, 0x0c, 0xe3, 0xd6, 0x1e, 0xcc, 0x5b, 0x9f, 0x1e, 0x90, 0x0d,
-	0xeb, 0xf3, 0x81, 0xc2, 0x17, 0x09, 0xed, 0xab, 0x23, 0x3f, 0x2e, 0x70, 0x2e, 0x23, 0x8b, 0x65,
-	0xb2, 0x24, 0x59, 0xe4, 0x5f, 0x15, 0x90, 0xcf, 0x61, 0xf1, 0x21, 0xd6, 0x33, 0x35, 0x51, 0xb2,
-	0x99, 0x13, 0xab, 0xfc, 0xa2, 0xa2, 0x7d, 0x7d, 0x38, 0x82, 0x64, 0x78, 0x05, 0x19, 0xae, 0x92,
-	0x65, 0xce, 0x50, 0xd4, 0x4b, 0x35, 0x4f, 0x92, 0x41, 0x53, 0xf6, 0x68, 0xbf, 0x50, 0x9e, 0x1b,
-	0xc8, 0x73, 0x8d, 0xac, 0x70, 0x9e, 0xbe, 0x60, 0x90, 0x33, 0x8d, 0xb1, 0x1c, 0x63, 0x7e, 0x53,
-	0x40, 0xae, 0x0d, 0xfd, 0xd8, 0x40, 0xb0, 0xdc, 0x3c, 0xe7, 0x63, 0x04, 0x7b, 0x95, 0x3d, 0xca,
-	0x71, 0xf5, 0xf7, 0x08, 0xe4, 0xe7, 0x22, 0x46, 0xaf, 0xfc, 0xfa, 0x85, 0xbc, 0x72, 0xfe, 0x27,
-	0x37, 0x42, 0x86, 0x5b, 0xe3, 0x7e, 0x9b, 0xe3, 0x7c, 0x0b, 0x85, 0xb9, 0x46, 0x36, 0xa4, 0x30,
-	0xd6, 0xf7, 0x38, 0xea, 0x8b, 0x1f, 0xd2, 0x85, 0x39, 0xf3, 0x43, 0x02, 0x72, 0xa5, 0x22, 0x25,
-	0xd0, 0xcc, 0x37, 0xaa, 0x07, 0x25, 0xc3, 0x16, 0x32, 0x24, 0xa4, 0x29, 0x19, 0xea, 0xef, 0x0e,
-	0xc8, 0x17, 0xb0, 0x58, 0x68, 0xc2, 0x27, 0x4e, 0x61, 0xfb, 0x2a, 0x3e, 0xa8, 0x68, 0xbf, 0x34,
-	0x12, 0x47, 0x72, 0xbd, 0x86, 0x5c, 0x5b, 0xce, 0xb2, 0xb1, 0xcb, 0x8a, 0xf3, 0x77, 0x6b, 0xaf,
-	0x92, 0x0c, 0xf7, 0xd9, 0xec, 0x17, 0x1f, 0x8b, 0xf7, 0xe6, 0x39, 0xcd, 0xe6, 0xa5, 0xbd, 0x56,
-	0x3c, 0xf1, 0x6d, 0xcd, 0xb0, 0x07, 0xd7, 0xf8, 0xca, 0x01, 0xf3, 0xe5, 0x71, 0xf8, 0x5e, 0xad,
-	0xfe, 0x4a, 0x42, 0x7e, 0xa8, 0xe1, 0xb4, 0x91, 0xeb, 0x0a, 0x21, 0x05, 0xae, 0x31, 0x4b, 0x48,
-	0x66, 0x7d, 0x44, 0x22, 0x99, 0xda, 0x56, 0x5d, 0xf1, 0x19, 0x47, 0xe5, 0x4a, 0xcd, 0xef, 0x32,
-	0x86, 0xae, 0x34, 0x66, 0x49, 0x46, 0x9e, 0xc3, 0x82, 0x70, 0x17, 0x2f, 0x7e, 0x67, 0xaf, 0x22,
-	0xdf, 0x75, 0x87, 0xe4, 0x3e, 0xc3, 0xdc, 0xd8, 0x4f, 0xa0, 0xa1, 0x13, 0x1b, 0xd2, 0x32, 0x16,
-	0x61, 0x75, 0xd4, 0xb7, 0x87, 0xf4, 0x4b, 0x2b, 0x6b, 0x75, 0xe6, 0xe5, 0xaa, 0x44, 0xf7, 0x33,
-	0x27, 0xfc, 0x63, 0x80, 0xbc, 0x81, 0x9a, 0x5c, 0x2e, 0x51, 0xd6, 0x9a, 0x6b, 0x57, 0x0d, 0xa9,
-	0x4f, 0xc5, 0x90, 0x7c, 0x93, 0x2c, 0x58, 0xe4, 0xd5, 0xfb, 0xa6, 0xf3, 0x38, 0xeb, 0x7d, 0x2b,
-	0xb6, 0x5c, 0xb7, 0x87, 0xf7, 0xda, 0xaa, 0x4d, 0x71, 0xd4, 0xcb, 0xa6, 0xef, 0xeb, 0xf9, 0x0a,
-	0xc4, 0x61, 0x61, 0x34, 0xf9, 0x6e, 0x54, 0x71, 0xa9, 0x3c, 0x2c, 0xca, 0x1d, 0xbb, 0xa5, 0xc3,
-	0x22, 0x6f, 0xcc, 0x25, 0xcf, 0xf0, 0x53, 0x59, 0xa3, 0x47, 0x95, 0x98, 0xb4, 0xca, 0x0d, 0xbb,
-	0xed, 0x6b, 0xc3, 0x86, 0xb3, 0x6a, 0xfb, 0x96, 0x57, 0x7a, 0xf8, 0x52, 0x9d, 0x89, 0x5c, 0x30,
-	0x9f, 0x25, 0xf2, 0xc8, 0xaf, 0xcb, 0xf2, 0x3a, 0xb2, 0x6c, 0x93, 0x56, 0x99, 0x65, 0x86, 0x0c,
-	0xde, 0xac, 0x49, 0x5b, 0x13, 0x4d, 0xb1, 0x96, 0xad, 0x59, 0xbd, 0xb3, 0xed, 0xcb, 0x15, 0x23,
-	0x92, 0xcb, 0x2a, 0x72, 0x59, 0x24, 0xf3, 0xda, 0x1b, 0x23, 0x2d, 0x61, 0x0e, 0xba, 0x5b, 0xc9,
-	0x32, 0x87, 0x62, 0x4b, 0xab, 0xe5, 0x7e, 0x4b, 0x8d, 0xad, 0x25, 0xf7, 0xab, 0x5b, 0x57, 0xc9,
-	0xef, 0xdb, 0x1d, 0xb2, 0xaa, 0x63, 0xcf, 0x19, 0xd9, 0x62, 0x57, 0x7a, 0x51, 0x87, 0xb6, 0xe1,
-	0x39, 0x9b, 0xc8, 0xf9, 0x32, 0x59, 0x2f, 0x72, 0x96, 0x2d, 0x7d, 0xe4, 0xcb, 0x1a, 0x2c, 0x57,
-	0x34, 0x8c, 0xe5, 0x12, 0x0c, 0x6f, 0x6f, 0xcb, 0x25, 0x18, 0xd5, 0x71, 0xe6, 0xa0, 0x04, 0x1b,
-	0x0e, 0x4a, 0xe0, 0xf9, 0xbe, 0x96, 0x40, 0x5e, 0x8e, 0xf2, 0x97, 0xe2, 0x67, 0x35, 0x58, 0xab,
-	0x6e, 0x0e, 0x23, 0x2f, 0xeb, 0xef, 0xfe, 0x46, 0xb5, 0xad, 0xb5, 0x6f, 0x9e, 0x87, 0x26, 0xa5,
-	0x79, 0x19, 0xa5, 0xd9, 0x74, 0xda, 0x5c, 0x9a, 0x14, 0x71, 0xab, 0x04, 0x3a, 0xc5, 0x8a, 0x9a,
-	0xdd, 0x7e, 0x45, 0x8c, 0xb0, 0xa6, 0xba, 0x4b, 0xad, 0x7d, 0x63, 0x04, 0x86, 0xed, 0x39, 0xc9,
-	0xaa, 0xdc, 0x10, 0xec, 0x59, 0xd2, 0x7d, 0x5c, 0xd2, 0x3d, 0xe4, 0xed, 0x4d, 0x96, 0x7b, 0x28,
-	0x75, 0x6c, 0x59, 0xee, 0xa1, 0xdc, 0x44, 0x55, 0x72, 0x0f, 0xc8, 0x0c, 0x1b, 0xaa, 0xc8, 0xa7,
-	0xf8, 0xda, 0xc8, 0x72, 0x6e, 0xab, 0xe8, 0x65, 0xb2, 0xaa, 0xd7, 0xc6, 0x2e, 0xd8, 0x96, 0xbc,
-	0xb4, 0xa8, 0x12, 0x73, 0xed, 0xb9, 0x30, 0xa3, 0xd0, 0xc9, 0x7a, 0x91, 0x80, 0xa2, 0x5c, 0xd9,
-	0x91, 0xe3, 0xac, 0x23, 0xd1, 0x25, 0x67, 0xce, 0x24, 0xca, 0x69, 0x1e, 0xc2, 0xac, 0xd1, 0x7d,
-	0x42, 0xb4, 0x7f, 0x2f, 0x37, 0xdb, 0xb4, 0xaf, 0x54, 0x8e, 0xd9, 0x5e, 0xcc, 0x59, 0xe4, 0x0c,
-	0x32, 0x44, 0xd0, 0x3c, 0x7e, 0x07, 0xe6, 0xad, 0x06, 0x90, 0x5c, 0xf9, 0x55, 0x2d, 0x2a, 0xb9,
-	0xf2, 0x2b, 0xbb, 0x46, 0x54, 0x8c, 0xeb, 0xa0, 0xf2, 0x33, 0x89, 0xa2, 0x79, 0x7d, 0x06, 0x0d,
-	0xdd, 0x77, 0x91, 0xeb, 0xbf, 0xd8, 0x8a, 0x71, 0x1e, 0x0f, 0x6b, 0x0f, 0x4e, 0xf9, 0xe4, 0xc3,
-	0xb8, 0x7f, 0x28, 0xf5, 0x65, 0x74, 0x15, 0xe4, 0xfa, 0x2a, 0xb7, 0x56, 0xe4, 0xfa, 0xaa, 0x6a,
-	0x43, 0xb0, 0xf4, 0xd5, 0x45, 0x04, 0xbd, 0x86, 0x14, 0x16, 0x0b, 0xd5, 0xfc, 0x3c, 0xa2, 0xa9,
-	0xee, 0x5d, 0xc8, 0x23, 0x9a, 0x21, 0x6d, 0x00, 0x76, 0xcc, 0x28, 0xf8, 0x79, 0x61, 0x98, 0xdb,
-	0x96, 0x70, 0xf7, 0xa2, 0xd6, 0x6d, 0xd9, 0xad, 0x55, 0xd4, 0xb7, 0xec, 0xd6, 0x2e, 0x8c, 0x97,
-	0xdc, 0xbd, 0xb8, 0xee, 0x23, 0x4f, 0x61, 0x46, 0x15, 0x59, 0x73, 0xa3, 0x2d, 0x94, 0x97, 0xdb,
-	0xad, 0xf2, 0x80, 0xa4, 0x6a, 0x19, 0xae, 0xe7, 0xfb, 0x48, 0x55, 0x6e, 0x84, 0x51, 0x72, 0xcd,
-	0x37, 0xa2, 0x5c, 0xad, 0xcd, 0x37, 0xa2, 0xaa, 0x46, 0x6b, 0x6d, 0x84, 0xf0, 0x5c, 0x9a, 0xc7,
-	0xdf, 0xd7, 0xf0, 0x2a, 0x7a, 0x74, 0xc5, 0x94, 0xbc, 0x79, 0x81, 0xe2, 0xaa, 0x10, 0xe8, 0xad,
-	0x0b, 0x97, 0x63, 0x9d, 0x5b, 0x28, 0xa6, 0xe3, 0x5c, 0x55, 0x87, 0x29, 0x4e, 0xf3, 0x05, 0xba,
-	0xae, 0xcd, 0x72, 0xa1, 0xff, 0xb6, 0x26, 0xfe, 0x06, 0xc3, 0x08, 0xba, 0x64, 0x6b, 0x4c, 0x01,
-	0x94, 0xc0, 0x77, 0xc6, 0xc6, 0x97, 0xe2, 0xde, 0x44, 0x71, 0xaf, 0x3b, 0x57, 0x46, 0x88, 0xcb,
-	0x85, 0xfd, 0x5d, 0xb8, 0xa2, 0x2b, 0xab, 0x16, 0xdd, 0xf7, 0x07, 0x91, 0x9f, 0xe5, 0x29, 0xf1,
-	0x90, 0xf2, 0x6b, 0x6e, 0x38, 0xc5, 0x82, 0x9b, 0x7d, 0x3e, 0x9e, 0xca, 0x51, 0x21, 0xc6, 0x11,
-	0xa7, 0xcd, 0xb9, 0x27, 0xb0, 0xa4, 0xe6, 0xbd, 0x1f, 0x78, 0xec, 0x6b, 0xf3, 0x94, 0x71, 0x95,
-	0xb3, 0x6a, 0xf2, 0x3c, 0x0a, 0x3c, 0xa6, 0x39, 0x66, 0xd8, 0x28, 0x63, 0xd5, 0xd2, 0xcc, 0xbc,
-	0xbf, 0xb2, 0xca, 0x66, 0xe6, 0xfd, 0xd5, 0x65, 0x3f, 0x3b, 0xef, 0xef, 0x51, 0x26, 0xca, 0x70,
-	0xbe, 0x64, 0x70, 0x02, 0xcd, 0xfd, 0xa1, 0x4c, 0xf7, 0xbf, 0x32, 0x53, 0x19, 0x03, 0x39, 0xc8,
-	0x34, 0x2b, 0x30, 0xe5, 0x8b, 0x3d, 0x11, 0x5d, 0x41, 0x66, 0x95, 0x8d, 0x6c, 0x0e, 0xaf, 0xbf,
-	0x95, 0xf9, 0x56, 0x16, 0xe8, 0x6c, 0xbe, 0x46, 0x72, 0x86, 0xdf, 0x9e, 0x73, 0xbe, 0x67, 0x40,
-	0xec, 0x04, 0x0d, 0xbf, 0x59, 0xd4, 0x5e, 0xa0, 0xa2, 0xb6, 0x36, 0x5e, 0x76, 0x76, 0x03, 0x19,
-	0x5f, 0x71, 0xd6, 0xca, 0xd9, 0x19, 0xe7, 0xcd, 0x59, 0xff, 0x04, 0x96, 0x0b, 0x69, 0xff, 0x0b,
-	0xe2, 0x6d, 0x99, 0x73, 0x21, 0xe7, 0x57, 0xcc, 0x19, 0xa6, 0xe0, 0x85, 0x82, 0x19, 0xb9, 0x51,
-	0x95, 0xea, 0x58, 0xf5, 0xa8, 0x51, 0x49, 0x97, 0x3c, 0x37, 0xc8, 0x5a, 0x29, 0x13, 0x52, 0x89,
-	0xc2, 0x9f, 0xd4, 0xb0, 0x58, 0x32, 0xa4, 0x5e, 0x47, 0x6e, 0x57, 0xe5, 0xda, 0x17, 0x16, 0x43,
-	0xfa, 0x13, 0x72, 0xad, 0x98, 0x90, 0x97, 0xc4, 0x39, 0xc6, 0xcb, 0x0f, 0xb3, 0xea, 0x66, 0x5d,
-	0x07, 0x54, 0x94, 0xe3, 0x86, 0xe6, 0xcb, 0xc5, 0x5b, 0x00, 0x99, 0xd0, 0x2a, 0x4e, 0x3f, 0xb5,
-	0xff, 0x18, 0x84, 0xc5, 0xf2, 0x66, 0xc5, 0xaa, 0x2f, 0xc2, 0xfa, 0x25, 0x64, 0x7d, 0x95, 0x5c,
-	0x29, 0xac, 0xb7, 0x20, 0x82, 0x08, 0x6b, 0x8d, 0xea, 0x8e, 0x19, 0xd6, 0x96, 0x4a, 0x88, 0x56,
-	0x58, 0x5b, 0xae, 0xe2, 0x95, 0xc2, 0x5a, 0x8f, 0xa3, 0xe0, 0x61, 0x48, 0x18, 0x34, 0x8b, 0x55,
-	0x16, 0xe3, 0x55, 0xae, 0xae, 0xbf, 0x18, 0xaf, 0xf2, 0x90, 0x2b, 0xe7, 0x42, 0xd4, 0xde, 0x65,
-	0xe2, 0xe6, 0xfa, 0x8e, 0x6c, 0x45, 0x23, 0x0c, 0x16, 0x0b, 0x15, 0x10, 0x63, 0x2f, 0x2b, 0x4b,
-	0x23, 0x63, 0xf0, 0xb4, 0xdd, 0x87, 0xe6, 0x39, 0x40, 0x32, 0xfc, 0x35, 0x7a, 0x0e, 0xcb, 0x15,
-	0xd5, 0x0c, 0x23, 0x77, 0x1c, 0x5a, 0xea, 0x68, 0x97, 0xa5, 0xb3, 0x6e, 0xf5, 0xed, 0xfb, 0x9d,
-	0x9c, 0x77, 0x4a, 0x05, 0xe7, 0xc4, 0x58, 0xaf, 0xfc, 0xd3, 0x51, 0x65, 0x8a, 0x56, 0x01, 0xa9,
-	0xbd, 0x39, 0x74, 0xbc, 0xf2, 0x68, 0xd0, 0x2c, 0xe5, 0xdd, 0x7e, 0x08, 0x0b, 0xb6, 0xa8, 0xc6,
-	0xd5, 0x42, 0x55, 0x21, 0xe6, 0xdc, 0x15, 0xda, 0xef, 0x8c, 0x66, 0xf7, 0x39, 0xd2, 0x8e, 0x60,
-	0xde, 0x2a, 0x91, 0x19, 0xe6, 0x5a, 0x51, 0x7c, 0x1b, 0xdf, 0x7e, 0x8a, 0xfa, 0xcc, 0x58, 0x9c,
-	0x08, 0x87, 0xd8, 0x2c, 0x96, 0xe4, 0xc8, 0x66, 0x25, 0xcb, 0xbc, 0xee, 0xf6, 0xf5, 0xb9, 0x66,
-	0x06, 0x57, 0x59, 0xd3, 0xab, 0xe0, 0x6a, 0x57, 0xfb, 0xce, 0xdf, 0xc7, 0x73, 0x98, 0xa2, 0x33,
-	0x2a, 0x96, 0xbd, 0x0e, 0xe2, 0x5e, 0x2f, 0xa4, 0xa4, 0xbc, 0xa2, 0x42, 0x5d, 0x6c, 0x8c, 0x35,
-	0x5b, 0x67, 0x5f, 0xce, 0xde, 0x1b, 0xb0, 0x58, 0xbd, 0x37, 0x3f, 0xc1, 0xe3, 0xa7, 0x50, 0x34,
-	0xb7, 0x8e, 0x9f, 0xea, 0x9a, 0x7f, 0xdb, 0x19, 0x85, 0x32, 0xe4, 0x1c, 0x3a, 0x96, 0x78, 0xa2,
-	0xd4, 0x9e, 0x1d, 0x4e, 0xe1, 0x1f, 0xb2, 0x7b, 0xfb, 0xff, 0x03, 0x00, 0x00, 0xff, 0xff, 0x43,
-	0x1f, 0xbd, 0xbb, 0xfb, 0x4e, 0x00, 0x00,
+func (m *GetTechnicalAnalysisRequest) GetIndicator() string {
+	if m != nil {
+		return m.Indicator
+	}
+	return ""
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+func (m *GetTechnicalAnalysisRequest) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *GetTechnicalAnalysisRequest) GetCount() int64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
 
-// GoCryptoTraderClient is the client API for GoCryptoTrader service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type GoCryptoTraderClient interface {
-	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
-	GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
-	DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
-	GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error)
-	DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error)
-	GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error)
-	EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error)
-	GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error)
-	GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error)
-	GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error)
-	GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error)
-	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
-	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error)
-	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error)
-	GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error)
-	GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error)
-	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
-	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
-	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
-	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
-	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
-	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
-	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error)
-	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
-	AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error)
-	RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error)
-	GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error)
-	WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
-	WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
-	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
-	EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
-	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error)
-	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
-	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
-	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
-	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
-	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
-	GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
+type IndicatorSeries struct {
+	Name                 string    `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Values               []float64 `protobuf:"fixed64,2,rep,packed,name=values,proto3" json:"values,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}  `json:"-"`
+	XXX_unrecognized     []byte    `json:"-"`
+	XXX_sizecache        int32     `json:"-"`
 }
 
-type goCryptoTraderClient struct {
-	cc *grpc.ClientConn
+func (m *IndicatorSeries) Reset()         { *m = IndicatorSeries{} }
+func (m *IndicatorSeries) String() string { return proto.CompactTextString(m) }
+func (*IndicatorSeries) ProtoMessage()    {}
+func (*IndicatorSeries) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{115}
 }
 
-func NewGoCryptoTraderClient(cc *grpc.ClientConn) GoCryptoTraderClient {
-	return &goCryptoTraderClient{cc}
+func (m *IndicatorSeries) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_IndicatorSeries.Unmarshal(m, b)
+}
+func (m *IndicatorSeries) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_IndicatorSeries.Marshal(b, m, deterministic)
+}
+func (m *IndicatorSeries) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_IndicatorSeries.Merge(m, src)
+}
+func (m *IndicatorSeries) XXX_Size() int {
+	return xxx_messageInfo_IndicatorSeries.Size(m)
+}
+func (m *IndicatorSeries) XXX_DiscardUnknown() {
+	xxx_messageInfo_IndicatorSeries.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
-	out := new(GetInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_IndicatorSeries proto.InternalMessageInfo
+
+func (m *IndicatorSeries) GetName() string {
+	if m != nil {
+		return m.Name
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error) {
-	out := new(GetSusbsytemsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSubsystems", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *IndicatorSeries) GetValues() []float64 {
+	if m != nil {
+		return m.Values
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
-	out := new(GenericSubsystemResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableSubsystem", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type GetTechnicalAnalysisResponse struct {
+	Exchange             string             `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair      `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string             `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Interval             string             `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	Indicator            string             `protobuf:"bytes,5,opt,name=indicator,proto3" json:"indicator,omitempty"`
+	Period               int64              `protobuf:"varint,6,opt,name=period,proto3" json:"period,omitempty"`
+	Timestamps           []int64            `protobuf:"varint,7,rep,packed,name=timestamps,proto3" json:"timestamps,omitempty"`
+	Close                []float64          `protobuf:"fixed64,8,rep,packed,name=close,proto3" json:"close,omitempty"`
+	Series               []*IndicatorSeries `protobuf:"bytes,9,rep,name=series,proto3" json:"series,omitempty"`
+	Signal               string             `protobuf:"bytes,10,opt,name=signal,proto3" json:"signal,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
 }
 
-func (c *goCryptoTraderClient) DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
-	out := new(GenericSubsystemResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableSubsystem", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTechnicalAnalysisResponse) Reset()         { *m = GetTechnicalAnalysisResponse{} }
+func (m *GetTechnicalAnalysisResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTechnicalAnalysisResponse) ProtoMessage()    {}
+func (*GetTechnicalAnalysisResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{116}
 }
 
-func (c *goCryptoTraderClient) GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error) {
-	out := new(GetRPCEndpointsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRPCEndpoints", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *GetTechnicalAnalysisResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTechnicalAnalysisResponse.Unmarshal(m, b)
+}
+func (m *GetTechnicalAnalysisResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTechnicalAnalysisResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTechnicalAnalysisResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTechnicalAnalysisResponse.Merge(m, src)
+}
+func (m *GetTechnicalAnalysisResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTechnicalAnalysisResponse.Size(m)
+}
+func (m *GetTechnicalAnalysisResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTechnicalAnalysisResponse.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error) {
-	out := new(GetCommunicationRelayersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCommunicationRelayers", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetTechnicalAnalysisResponse proto.InternalMessageInfo
+
+func (m *GetTechnicalAnalysisResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error) {
-	out := new(GetExchangesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchanges", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error) {
-	out := new(GetExchangeInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetInterval() string {
+	if m != nil {
+		return m.Interval
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error) {
-	out := new(GetExchangeOTPReponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCode", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetIndicator() string {
+	if m != nil {
+		return m.Indicator
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error) {
-	out := new(GetExchangeOTPsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetPeriod() int64 {
+	if m != nil {
+		return m.Period
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchange", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetTimestamps() []int64 {
+	if m != nil {
+		return m.Timestamps
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error) {
-	out := new(TickerResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTicker", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetClose() []float64 {
+	if m != nil {
+		return m.Close
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error) {
-	out := new(GetTickersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickers", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetSeries() []*IndicatorSeries {
+	if m != nil {
+		return m.Series
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error) {
-	out := new(OrderbookResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbook", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetTechnicalAnalysisResponse) GetSignal() string {
+	if m != nil {
+		return m.Signal
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error) {
-	out := new(GetOrderbooksResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbooks", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type StatementOpeningBalance struct {
+	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64  `protobuf:"fixed64,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error) {
-	out := new(GetAccountInfoResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAccountInfo", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *StatementOpeningBalance) Reset()         { *m = StatementOpeningBalance{} }
+func (m *StatementOpeningBalance) String() string { return proto.CompactTextString(m) }
+func (*StatementOpeningBalance) ProtoMessage()    {}
+func (*StatementOpeningBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{117}
 }
 
-func (c *goCryptoTraderClient) GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[0], "/gctrpc.GoCryptoTrader/GetAccountInfoStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetAccountInfoStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+func (m *StatementOpeningBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatementOpeningBalance.Unmarshal(m, b)
+}
+func (m *StatementOpeningBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatementOpeningBalance.Marshal(b, m, deterministic)
+}
+func (m *StatementOpeningBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementOpeningBalance.Merge(m, src)
+}
+func (m *StatementOpeningBalance) XXX_Size() int {
+	return xxx_messageInfo_StatementOpeningBalance.Size(m)
+}
+func (m *StatementOpeningBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementOpeningBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatementOpeningBalance proto.InternalMessageInfo
+
+func (m *StatementOpeningBalance) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return ""
+}
+
+func (m *StatementOpeningBalance) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
 	}
-	return x, nil
+	return 0
 }
 
-type GoCryptoTrader_GetAccountInfoStreamClient interface {
-	Recv() (*GetAccountInfoResponse, error)
-	grpc.ClientStream
+type GetAccountStatementRequest struct {
+	Exchange             string                     `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	StartDate            string                     `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string                     `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	OpeningBalances      []*StatementOpeningBalance `protobuf:"bytes,4,rep,name=opening_balances,json=openingBalances,proto3" json:"opening_balances,omitempty"`
+	Tolerance            float64                    `protobuf:"fixed64,5,opt,name=tolerance,proto3" json:"tolerance,omitempty"`
+	FiatCurrencies       []string                   `protobuf:"bytes,6,rep,name=fiat_currencies,json=fiatCurrencies,proto3" json:"fiat_currencies,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                   `json:"-"`
+	XXX_unrecognized     []byte                     `json:"-"`
+	XXX_sizecache        int32                      `json:"-"`
 }
 
-type goCryptoTraderGetAccountInfoStreamClient struct {
-	grpc.ClientStream
+func (m *GetAccountStatementRequest) Reset()         { *m = GetAccountStatementRequest{} }
+func (m *GetAccountStatementRequest) String() string { return proto.CompactTextString(m) }
+func (*GetAccountStatementRequest) ProtoMessage()    {}
+func (*GetAccountStatementRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{118}
 }
 
-func (x *goCryptoTraderGetAccountInfoStreamClient) Recv() (*GetAccountInfoResponse, error) {
-	m := new(GetAccountInfoResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetAccountStatementRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountStatementRequest.Unmarshal(m, b)
+}
+func (m *GetAccountStatementRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountStatementRequest.Marshal(b, m, deterministic)
+}
+func (m *GetAccountStatementRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountStatementRequest.Merge(m, src)
+}
+func (m *GetAccountStatementRequest) XXX_Size() int {
+	return xxx_messageInfo_GetAccountStatementRequest.Size(m)
+}
+func (m *GetAccountStatementRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountStatementRequest.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
-	out := new(GetConfigResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConfig", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_GetAccountStatementRequest proto.InternalMessageInfo
+
+func (m *GetAccountStatementRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
-	out := new(GetPortfolioResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolio", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAccountStatementRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
-	out := new(GetPortfolioSummaryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioSummary", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAccountStatementRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error) {
-	out := new(AddPortfolioAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPortfolioAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAccountStatementRequest) GetOpeningBalances() []*StatementOpeningBalance {
+	if m != nil {
+		return m.OpeningBalances
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error) {
-	out := new(RemovePortfolioAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemovePortfolioAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAccountStatementRequest) GetTolerance() float64 {
+	if m != nil {
+		return m.Tolerance
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error) {
-	out := new(GetForexProvidersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexProviders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAccountStatementRequest) GetFiatCurrencies() []string {
+	if m != nil {
+		return m.FiatCurrencies
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error) {
-	out := new(GetForexRatesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexRates", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type StatementEntry struct {
+	Timestamp            string   `protobuf:"bytes,1,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	Type                 string   `protobuf:"bytes,2,opt,name=type,proto3" json:"type,omitempty"`
+	Currency             string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64  `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Balance              float64  `protobuf:"fixed64,5,opt,name=balance,proto3" json:"balance,omitempty"`
+	Reference            string   `protobuf:"bytes,6,opt,name=reference,proto3" json:"reference,omitempty"`
+	Description          string   `protobuf:"bytes,7,opt,name=description,proto3" json:"description,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-func (c *goCryptoTraderClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
-	out := new(GetOrdersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *StatementEntry) Reset()         { *m = StatementEntry{} }
+func (m *StatementEntry) String() string { return proto.CompactTextString(m) }
+func (*StatementEntry) ProtoMessage()    {}
+func (*StatementEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{119}
 }
 
-func (c *goCryptoTraderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
-	out := new(OrderDetails)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *StatementEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatementEntry.Unmarshal(m, b)
+}
+func (m *StatementEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatementEntry.Marshal(b, m, deterministic)
+}
+func (m *StatementEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementEntry.Merge(m, src)
+}
+func (m *StatementEntry) XXX_Size() int {
+	return xxx_messageInfo_StatementEntry.Size(m)
+}
+func (m *StatementEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementEntry.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
-	out := new(SubmitOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_StatementEntry proto.InternalMessageInfo
+
+func (m *StatementEntry) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
-	out := new(SimulateOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SimulateOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementEntry) GetType() string {
+	if m != nil {
+		return m.Type
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
-	out := new(SimulateOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WhaleBomb", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementEntry) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
-	out := new(CancelOrderResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrder", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementEntry) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error) {
-	out := new(CancelAllOrdersResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelAllOrders", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementEntry) GetBalance() float64 {
+	if m != nil {
+		return m.Balance
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
-	out := new(GetEventsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEvents", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementEntry) GetReference() string {
+	if m != nil {
+		return m.Reference
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error) {
-	out := new(AddEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementEntry) GetDescription() string {
+	if m != nil {
+		return m.Description
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error) {
-	out := new(RemoveEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
+type StatementBalance struct {
+	Currency             string             `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Opening              float64            `protobuf:"fixed64,2,opt,name=opening,proto3" json:"opening,omitempty"`
+	Deposits             float64            `protobuf:"fixed64,3,opt,name=deposits,proto3" json:"deposits,omitempty"`
+	Withdrawals          float64            `protobuf:"fixed64,4,opt,name=withdrawals,proto3" json:"withdrawals,omitempty"`
+	Trades               float64            `protobuf:"fixed64,5,opt,name=trades,proto3" json:"trades,omitempty"`
+	Fees                 float64            `protobuf:"fixed64,6,opt,name=fees,proto3" json:"fees,omitempty"`
+	Funding              float64            `protobuf:"fixed64,7,opt,name=funding,proto3" json:"funding,omitempty"`
+	Closing              float64            `protobuf:"fixed64,8,opt,name=closing,proto3" json:"closing,omitempty"`
+	Reported             float64            `protobuf:"fixed64,9,opt,name=reported,proto3" json:"reported,omitempty"`
+	Discrepancy          float64            `protobuf:"fixed64,10,opt,name=discrepancy,proto3" json:"discrepancy,omitempty"`
+	Reconciled           bool               `protobuf:"varint,11,opt,name=reconciled,proto3" json:"reconciled,omitempty"`
+	ClosingFiat          map[string]float64 `protobuf:"bytes,12,rep,name=closing_fiat,json=closingFiat,proto3" json:"closing_fiat,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	PnlFiat              map[string]float64 `protobuf:"bytes,13,rep,name=pnl_fiat,json=pnlFiat,proto3" json:"pnl_fiat,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StatementBalance) Reset()         { *m = StatementBalance{} }
+func (m *StatementBalance) String() string { return proto.CompactTextString(m) }
+func (*StatementBalance) ProtoMessage()    {}
+func (*StatementBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{120}
+}
+
+func (m *StatementBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StatementBalance.Unmarshal(m, b)
+}
+func (m *StatementBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StatementBalance.Marshal(b, m, deterministic)
+}
+func (m *StatementBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StatementBalance.Merge(m, src)
+}
+func (m *StatementBalance) XXX_Size() int {
+	return xxx_messageInfo_StatementBalance.Size(m)
+}
+func (m *StatementBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_StatementBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StatementBalance proto.InternalMessageInfo
+
+func (m *StatementBalance) GetCurrency() string {
+	if m != nil {
+		return m.Currency
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	out := new(GetCryptocurrencyDepositAddressesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetOpening() float64 {
+	if m != nil {
+		return m.Opening
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error) {
-	out := new(GetCryptocurrencyDepositAddressResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetDeposits() float64 {
+	if m != nil {
+		return m.Deposits
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
-	out := new(WithdrawResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetWithdrawals() float64 {
+	if m != nil {
+		return m.Withdrawals
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
-	out := new(WithdrawResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawFiatFunds", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetTrades() float64 {
+	if m != nil {
+		return m.Trades
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
-	out := new(GetLoggerDetailsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLoggerDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetFees() float64 {
+	if m != nil {
+		return m.Fees
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
-	out := new(GetLoggerDetailsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLoggerDetails", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetFunding() float64 {
+	if m != nil {
+		return m.Funding
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error) {
-	out := new(GetExchangePairsResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangePairs", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetClosing() float64 {
+	if m != nil {
+		return m.Closing
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchangePair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetReported() float64 {
+	if m != nil {
+		return m.Reported
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
-	out := new(GenericExchangeNameResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchangePair", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetDiscrepancy() float64 {
+	if m != nil {
+		return m.Discrepancy
 	}
-	return out, nil
+	return 0
 }
 
-func (c *goCryptoTraderClient) GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[1], "/gctrpc.GoCryptoTrader/GetOrderbookStream", opts...)
-	if err != nil {
-		return nil, err
+func (m *StatementBalance) GetReconciled() bool {
+	if m != nil {
+		return m.Reconciled
 	}
-	x := &goCryptoTraderGetOrderbookStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
+	return false
+}
+
+func (m *StatementBalance) GetClosingFiat() map[string]float64 {
+	if m != nil {
+		return m.ClosingFiat
 	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+	return nil
+}
+
+func (m *StatementBalance) GetPnlFiat() map[string]float64 {
+	if m != nil {
+		return m.PnlFiat
 	}
-	return x, nil
+	return nil
 }
 
-type GoCryptoTrader_GetOrderbookStreamClient interface {
-	Recv() (*OrderbookResponse, error)
-	grpc.ClientStream
+type GetAccountStatementResponse struct {
+	Exchange             string              `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	StartDate            string              `protobuf:"bytes,2,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string              `protobuf:"bytes,3,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	Entries              []*StatementEntry   `protobuf:"bytes,4,rep,name=entries,proto3" json:"entries,omitempty"`
+	Balances             []*StatementBalance `protobuf:"bytes,5,rep,name=balances,proto3" json:"balances,omitempty"`
+	FiatCurrencies       []string            `protobuf:"bytes,6,rep,name=fiat_currencies,json=fiatCurrencies,proto3" json:"fiat_currencies,omitempty"`
+	ClosingFiatTotals    map[string]float64  `protobuf:"bytes,7,rep,name=closing_fiat_totals,json=closingFiatTotals,proto3" json:"closing_fiat_totals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	PnlFiatTotals        map[string]float64  `protobuf:"bytes,8,rep,name=pnl_fiat_totals,json=pnlFiatTotals,proto3" json:"pnl_fiat_totals,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Unpriced             []string            `protobuf:"bytes,9,rep,name=unpriced,proto3" json:"unpriced,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
 }
 
-type goCryptoTraderGetOrderbookStreamClient struct {
-	grpc.ClientStream
+func (m *GetAccountStatementResponse) Reset()         { *m = GetAccountStatementResponse{} }
+func (m *GetAccountStatementResponse) String() string { return proto.CompactTextString(m) }
+func (*GetAccountStatementResponse) ProtoMessage()    {}
+func (*GetAccountStatementResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{121}
 }
 
-func (x *goCryptoTraderGetOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
-	m := new(OrderbookResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *GetAccountStatementResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetAccountStatementResponse.Unmarshal(m, b)
+}
+func (m *GetAccountStatementResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetAccountStatementResponse.Marshal(b, m, deterministic)
+}
+func (m *GetAccountStatementResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetAccountStatementResponse.Merge(m, src)
+}
+func (m *GetAccountStatementResponse) XXX_Size() int {
+	return xxx_messageInfo_GetAccountStatementResponse.Size(m)
+}
+func (m *GetAccountStatementResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetAccountStatementResponse.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[2], "/gctrpc.GoCryptoTrader/GetExchangeOrderbookStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetExchangeOrderbookStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+var xxx_messageInfo_GetAccountStatementResponse proto.InternalMessageInfo
+
+func (m *GetAccountStatementResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return x, nil
+	return ""
 }
 
-type GoCryptoTrader_GetExchangeOrderbookStreamClient interface {
-	Recv() (*OrderbookResponse, error)
-	grpc.ClientStream
+func (m *GetAccountStatementResponse) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
 }
 
-type goCryptoTraderGetExchangeOrderbookStreamClient struct {
-	grpc.ClientStream
+func (m *GetAccountStatementResponse) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
 }
 
-func (x *goCryptoTraderGetExchangeOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
-	m := new(OrderbookResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetAccountStatementResponse) GetEntries() []*StatementEntry {
+	if m != nil {
+		return m.Entries
 	}
-	return m, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
-	if err != nil {
-		return nil, err
-	}
-	x := &goCryptoTraderGetTickerStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
+func (m *GetAccountStatementResponse) GetBalances() []*StatementBalance {
+	if m != nil {
+		return m.Balances
 	}
-	return x, nil
+	return nil
 }
 
-type GoCryptoTrader_GetTickerStreamClient interface {
-	Recv() (*TickerResponse, error)
-	grpc.ClientStream
+func (m *GetAccountStatementResponse) GetFiatCurrencies() []string {
+	if m != nil {
+		return m.FiatCurrencies
+	}
+	return nil
 }
 
-type goCryptoTraderGetTickerStreamClient struct {
-	grpc.ClientStream
+func (m *GetAccountStatementResponse) GetClosingFiatTotals() map[string]float64 {
+	if m != nil {
+		return m.ClosingFiatTotals
+	}
+	return nil
 }
 
-func (x *goCryptoTraderGetTickerStreamClient) Recv() (*TickerResponse, error) {
-	m := new(TickerResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
+func (m *GetAccountStatementResponse) GetPnlFiatTotals() map[string]float64 {
+	if m != nil {
+		return m.PnlFiatTotals
 	}
-	return m, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error) {
-	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
-	if err != nil {
-		return nil, err
+func (m *GetAccountStatementResponse) GetUnpriced() []string {
+	if m != nil {
+		return m.Unpriced
 	}
-	x := &goCryptoTraderGetExchangeTickerStreamClient{stream}
-	if err := x.ClientStream.SendMsg(in); err != nil {
-		return nil, err
-	}
-	if err := x.ClientStream.CloseSend(); err != nil {
-		return nil, err
-	}
-	return x, nil
+	return nil
 }
 
-type GoCryptoTrader_GetExchangeTickerStreamClient interface {
-	Recv() (*TickerResponse, error)
-	grpc.ClientStream
+type ValidateCredentialsResponse struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Valid                bool     `protobuf:"varint,2,opt,name=valid,proto3" json:"valid,omitempty"`
+	Error                string   `protobuf:"bytes,3,opt,name=error,proto3" json:"error,omitempty"`
+	PermissionsVerified  bool     `protobuf:"varint,4,opt,name=permissions_verified,json=permissionsVerified,proto3" json:"permissions_verified,omitempty"`
+	Read                 bool     `protobuf:"varint,5,opt,name=read,proto3" json:"read,omitempty"`
+	Trade                bool     `protobuf:"varint,6,opt,name=trade,proto3" json:"trade,omitempty"`
+	Withdraw             bool     `protobuf:"varint,7,opt,name=withdraw,proto3" json:"withdraw,omitempty"`
+	IpWhitelist          []string `protobuf:"bytes,8,rep,name=ip_whitelist,json=ipWhitelist,proto3" json:"ip_whitelist,omitempty"`
+	Warnings             []string `protobuf:"bytes,9,rep,name=warnings,proto3" json:"warnings,omitempty"`
+	AuthenticatedSupport bool     `protobuf:"varint,10,opt,name=authenticated_support,json=authenticatedSupport,proto3" json:"authenticated_support,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-type goCryptoTraderGetExchangeTickerStreamClient struct {
-	grpc.ClientStream
+func (m *ValidateCredentialsResponse) Reset()         { *m = ValidateCredentialsResponse{} }
+func (m *ValidateCredentialsResponse) String() string { return proto.CompactTextString(m) }
+func (*ValidateCredentialsResponse) ProtoMessage()    {}
+func (*ValidateCredentialsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{122}
 }
 
-func (x *goCryptoTraderGetExchangeTickerStreamClient) Recv() (*TickerResponse, error) {
-	m := new(TickerResponse)
-	if err := x.ClientStream.RecvMsg(m); err != nil {
-		return nil, err
-	}
-	return m, nil
+func (m *ValidateCredentialsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ValidateCredentialsResponse.Unmarshal(m, b)
 }
-
-func (c *goCryptoTraderClient) GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error) {
-	out := new(GetAuditEventResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAuditEvent", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *ValidateCredentialsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ValidateCredentialsResponse.Marshal(b, m, deterministic)
+}
+func (m *ValidateCredentialsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ValidateCredentialsResponse.Merge(m, src)
+}
+func (m *ValidateCredentialsResponse) XXX_Size() int {
+	return xxx_messageInfo_ValidateCredentialsResponse.Size(m)
+}
+func (m *ValidateCredentialsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ValidateCredentialsResponse.DiscardUnknown(m)
 }
 
-func (c *goCryptoTraderClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptExecute", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_ValidateCredentialsResponse proto.InternalMessageInfo
+
+func (m *ValidateCredentialsResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptUpload", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetValid() bool {
+	if m != nil {
+		return m.Valid
 	}
-	return out, nil
+	return false
 }
 
-func (c *goCryptoTraderClient) GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
-	out := new(GCTScriptQueryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptReadScript", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetError() string {
+	if m != nil {
+		return m.Error
 	}
-	return out, nil
+	return ""
 }
 
-func (c *goCryptoTraderClient) GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
-	out := new(GCTScriptStatusResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStatus", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetPermissionsVerified() bool {
+	if m != nil {
+		return m.PermissionsVerified
 	}
-	return out, nil
+	return false
 }
 
-func (c *goCryptoTraderClient) GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
-	out := new(GCTScriptQueryResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptQuery", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetRead() bool {
+	if m != nil {
+		return m.Read
 	}
-	return out, nil
+	return false
 }
 
-func (c *goCryptoTraderClient) GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStop", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetTrade() bool {
+	if m != nil {
+		return m.Trade
 	}
-	return out, nil
+	return false
 }
 
-func (c *goCryptoTraderClient) GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStopAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetWithdraw() bool {
+	if m != nil {
+		return m.Withdraw
 	}
-	return out, nil
+	return false
 }
 
-func (c *goCryptoTraderClient) GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
-	out := new(GCTScriptStatusResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptListAll", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetIpWhitelist() []string {
+	if m != nil {
+		return m.IpWhitelist
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
-	out := new(GCTScriptGenericResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetWarnings() []string {
+	if m != nil {
+		return m.Warnings
 	}
-	return out, nil
+	return nil
 }
 
-func (c *goCryptoTraderClient) GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error) {
-	out := new(GetHistoricCandlesResponse)
-	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricCandles", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *ValidateCredentialsResponse) GetAuthenticatedSupport() bool {
+	if m != nil {
+		return m.AuthenticatedSupport
 	}
-	return out, nil
+	return false
 }
 
-// GoCryptoTraderServer is the server API for GoCryptoTrader service.
-type GoCryptoTraderServer interface {
-	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
-	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
-	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
-	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
-	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
-	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
-	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
-	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
-	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
-	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error)
-	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
-	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
-	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
-	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
-	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
-	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
-	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
-	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
-	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
-	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
-	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
-	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error)
-	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error)
-	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
-	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
-	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
-	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
-	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
-	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
-	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
-	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
-	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
-	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
-	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
-	RemoveEvent(context.Context, *RemoveEventRequest) (*RemoveEventResponse, error)
-	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
-	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
-	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
-	WithdrawFiatFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
-	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
-	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
-	EnableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
-	DisableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
-	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
-	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
-	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
-	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
-	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
-	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
-	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error)
-	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
-	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error)
-	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+type GetExchangeLatencyRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
-// UnimplementedGoCryptoTraderServer can be embedded to have forward compatible implementations.
-type UnimplementedGoCryptoTraderServer struct {
+func (m *GetExchangeLatencyRequest) Reset()         { *m = GetExchangeLatencyRequest{} }
+func (m *GetExchangeLatencyRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeLatencyRequest) ProtoMessage()    {}
+func (*GetExchangeLatencyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{123}
 }
 
-func (*UnimplementedGoCryptoTraderServer) GetInfo(ctx context.Context, req *GetInfoRequest) (*GetInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+func (m *GetExchangeLatencyRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeLatencyRequest.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetSubsystems(ctx context.Context, req *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+func (m *GetExchangeLatencyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeLatencyRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) EnableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+func (m *GetExchangeLatencyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeLatencyRequest.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) DisableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+func (m *GetExchangeLatencyRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeLatencyRequest.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetRPCEndpoints(ctx context.Context, req *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+func (m *GetExchangeLatencyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeLatencyRequest.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(ctx context.Context, req *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+
+var xxx_messageInfo_GetExchangeLatencyRequest proto.InternalMessageInfo
+
+func (m *GetExchangeLatencyRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchanges(ctx context.Context, req *GetExchangesRequest) (*GetExchangesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+
+type ExchangeLatencySummary struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	LastMs               float64  `protobuf:"fixed64,2,opt,name=last_ms,json=lastMs,proto3" json:"last_ms,omitempty"`
+	MedianMs             float64  `protobuf:"fixed64,3,opt,name=median_ms,json=medianMs,proto3" json:"median_ms,omitempty"`
+	P90Ms                float64  `protobuf:"fixed64,4,opt,name=p90_ms,json=p90Ms,proto3" json:"p90_ms,omitempty"`
+	Samples              int64    `protobuf:"varint,5,opt,name=samples,proto3" json:"samples,omitempty"`
+	Errors               int64    `protobuf:"varint,6,opt,name=errors,proto3" json:"errors,omitempty"`
+	LastUpdated          string   `protobuf:"bytes,7,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	LastError            string   `protobuf:"bytes,8,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) DisableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+
+func (m *ExchangeLatencySummary) Reset()         { *m = ExchangeLatencySummary{} }
+func (m *ExchangeLatencySummary) String() string { return proto.CompactTextString(m) }
+func (*ExchangeLatencySummary) ProtoMessage()    {}
+func (*ExchangeLatencySummary) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{124}
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeInfo(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+
+func (m *ExchangeLatencySummary) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ExchangeLatencySummary.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+func (m *ExchangeLatencySummary) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ExchangeLatencySummary.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(ctx context.Context, req *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+func (m *ExchangeLatencySummary) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ExchangeLatencySummary.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) EnableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+func (m *ExchangeLatencySummary) XXX_Size() int {
+	return xxx_messageInfo_ExchangeLatencySummary.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTicker(ctx context.Context, req *GetTickerRequest) (*TickerResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+func (m *ExchangeLatencySummary) XXX_DiscardUnknown() {
+	xxx_messageInfo_ExchangeLatencySummary.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTickers(ctx context.Context, req *GetTickersRequest) (*GetTickersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+
+var xxx_messageInfo_ExchangeLatencySummary proto.InternalMessageInfo
+
+func (m *ExchangeLatencySummary) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbook(ctx context.Context, req *GetOrderbookRequest) (*OrderbookResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+
+func (m *ExchangeLatencySummary) GetLastMs() float64 {
+	if m != nil {
+		return m.LastMs
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbooks(ctx context.Context, req *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+
+func (m *ExchangeLatencySummary) GetMedianMs() float64 {
+	if m != nil {
+		return m.MedianMs
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetAccountInfo(ctx context.Context, req *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+
+func (m *ExchangeLatencySummary) GetP90Ms() float64 {
+	if m != nil {
+		return m.P90Ms
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetAccountInfoStream(req *GetAccountInfoRequest, srv GoCryptoTrader_GetAccountInfoStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+
+func (m *ExchangeLatencySummary) GetSamples() int64 {
+	if m != nil {
+		return m.Samples
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+
+func (m *ExchangeLatencySummary) GetErrors() int64 {
+	if m != nil {
+		return m.Errors
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) GetPortfolio(ctx context.Context, req *GetPortfolioRequest) (*GetPortfolioResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+
+func (m *ExchangeLatencySummary) GetLastUpdated() string {
+	if m != nil {
+		return m.LastUpdated
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetPortfolioSummary(ctx context.Context, req *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+
+func (m *ExchangeLatencySummary) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) AddPortfolioAddress(ctx context.Context, req *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+
+type GetExchangeLatencyResponse struct {
+	Exchanges            []*ExchangeLatencySummary `protobuf:"bytes,1,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(ctx context.Context, req *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+
+func (m *GetExchangeLatencyResponse) Reset()         { *m = GetExchangeLatencyResponse{} }
+func (m *GetExchangeLatencyResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExchangeLatencyResponse) ProtoMessage()    {}
+func (*GetExchangeLatencyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{125}
 }
-func (*UnimplementedGoCryptoTraderServer) GetForexProviders(ctx context.Context, req *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+
+func (m *GetExchangeLatencyResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExchangeLatencyResponse.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetForexRates(ctx context.Context, req *GetForexRatesRequest) (*GetForexRatesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+func (m *GetExchangeLatencyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExchangeLatencyResponse.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrders(ctx context.Context, req *GetOrdersRequest) (*GetOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+func (m *GetExchangeLatencyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExchangeLatencyResponse.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrder(ctx context.Context, req *GetOrderRequest) (*OrderDetails, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+func (m *GetExchangeLatencyResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExchangeLatencyResponse.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) SubmitOrder(ctx context.Context, req *SubmitOrderRequest) (*SubmitOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+func (m *GetExchangeLatencyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExchangeLatencyResponse.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) SimulateOrder(ctx context.Context, req *SimulateOrderRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+
+var xxx_messageInfo_GetExchangeLatencyResponse proto.InternalMessageInfo
+
+func (m *GetExchangeLatencyResponse) GetExchanges() []*ExchangeLatencySummary {
+	if m != nil {
+		return m.Exchanges
+	}
+	return nil
 }
-func (*UnimplementedGoCryptoTraderServer) WhaleBomb(ctx context.Context, req *WhaleBombRequest) (*SimulateOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+
+type SubmitTransferRequest struct {
+	FromExchange         string   `protobuf:"bytes,1,opt,name=from_exchange,json=fromExchange,proto3" json:"from_exchange,omitempty"`
+	ToExchange           string   `protobuf:"bytes,2,opt,name=to_exchange,json=toExchange,proto3" json:"to_exchange,omitempty"`
+	Currency             string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64  `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Network              string   `protobuf:"bytes,5,opt,name=network,proto3" json:"network,omitempty"`
+	Address              string   `protobuf:"bytes,6,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag           string   `protobuf:"bytes,7,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) CancelOrder(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+
+func (m *SubmitTransferRequest) Reset()         { *m = SubmitTransferRequest{} }
+func (m *SubmitTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*SubmitTransferRequest) ProtoMessage()    {}
+func (*SubmitTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{126}
 }
-func (*UnimplementedGoCryptoTraderServer) CancelAllOrders(ctx context.Context, req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+
+func (m *SubmitTransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SubmitTransferRequest.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetEvents(ctx context.Context, req *GetEventsRequest) (*GetEventsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+func (m *SubmitTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SubmitTransferRequest.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) AddEvent(ctx context.Context, req *AddEventRequest) (*AddEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+func (m *SubmitTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SubmitTransferRequest.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) RemoveEvent(ctx context.Context, req *RemoveEventRequest) (*RemoveEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+func (m *SubmitTransferRequest) XXX_Size() int {
+	return xxx_messageInfo_SubmitTransferRequest.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(ctx context.Context, req *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+func (m *SubmitTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SubmitTransferRequest.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(ctx context.Context, req *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+
+var xxx_messageInfo_SubmitTransferRequest proto.InternalMessageInfo
+
+func (m *SubmitTransferRequest) GetFromExchange() string {
+	if m != nil {
+		return m.FromExchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+
+func (m *SubmitTransferRequest) GetToExchange() string {
+	if m != nil {
+		return m.ToExchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+
+func (m *SubmitTransferRequest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetLoggerDetails(ctx context.Context, req *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+
+func (m *SubmitTransferRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
 }
-func (*UnimplementedGoCryptoTraderServer) SetLoggerDetails(ctx context.Context, req *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+
+func (m *SubmitTransferRequest) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangePairs(ctx context.Context, req *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+
+func (m *SubmitTransferRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) EnableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EnableExchangePair not implemented")
+
+func (m *SubmitTransferRequest) GetAddressTag() string {
+	if m != nil {
+		return m.AddressTag
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) DisableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DisableExchangePair not implemented")
+
+type TransferDetails struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	FromExchange         string   `protobuf:"bytes,2,opt,name=from_exchange,json=fromExchange,proto3" json:"from_exchange,omitempty"`
+	ToExchange           string   `protobuf:"bytes,3,opt,name=to_exchange,json=toExchange,proto3" json:"to_exchange,omitempty"`
+	Currency             string   `protobuf:"bytes,4,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64  `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Network              string   `protobuf:"bytes,6,opt,name=network,proto3" json:"network,omitempty"`
+	Address              string   `protobuf:"bytes,7,opt,name=address,proto3" json:"address,omitempty"`
+	AddressTag           string   `protobuf:"bytes,8,opt,name=address_tag,json=addressTag,proto3" json:"address_tag,omitempty"`
+	Status               string   `protobuf:"bytes,9,opt,name=status,proto3" json:"status,omitempty"`
+	WithdrawalId         string   `protobuf:"bytes,10,opt,name=withdrawal_id,json=withdrawalId,proto3" json:"withdrawal_id,omitempty"`
+	TxId                 string   `protobuf:"bytes,11,opt,name=tx_id,json=txId,proto3" json:"tx_id,omitempty"`
+	Fee                  float64  `protobuf:"fixed64,12,opt,name=fee,proto3" json:"fee,omitempty"`
+	FeeEstimated         bool     `protobuf:"varint,13,opt,name=fee_estimated,json=feeEstimated,proto3" json:"fee_estimated,omitempty"`
+	Received             float64  `protobuf:"fixed64,14,opt,name=received,proto3" json:"received,omitempty"`
+	Error                string   `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	Created              string   `protobuf:"bytes,16,opt,name=created,proto3" json:"created,omitempty"`
+	Updated              string   `protobuf:"bytes,17,opt,name=updated,proto3" json:"updated,omitempty"`
+	ApprovalExpires      string   `protobuf:"bytes,18,opt,name=approval_expires,json=approvalExpires,proto3" json:"approval_expires,omitempty"`
+	ApprovedBy           string   `protobuf:"bytes,19,opt,name=approved_by,json=approvedBy,proto3" json:"approved_by,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
-func (*UnimplementedGoCryptoTraderServer) GetOrderbookStream(req *GetOrderbookStreamRequest, srv GoCryptoTrader_GetOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+
+func (m *TransferDetails) Reset()         { *m = TransferDetails{} }
+func (m *TransferDetails) String() string { return proto.CompactTextString(m) }
+func (*TransferDetails) ProtoMessage()    {}
+func (*TransferDetails) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{127}
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(req *GetExchangeOrderbookStreamRequest, srv GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+
+func (m *TransferDetails) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TransferDetails.Unmarshal(m, b)
 }
-func (*UnimplementedGoCryptoTraderServer) GetTickerStream(req *GetTickerStreamRequest, srv GoCryptoTrader_GetTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+func (m *TransferDetails) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TransferDetails.Marshal(b, m, deterministic)
 }
-func (*UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(req *GetExchangeTickerStreamRequest, srv GoCryptoTrader_GetExchangeTickerStreamServer) error {
-	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+func (m *TransferDetails) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TransferDetails.Merge(m, src)
 }
-func (*UnimplementedGoCryptoTraderServer) GetAuditEvent(ctx context.Context, req *GetAuditEventRequest) (*GetAuditEventResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+func (m *TransferDetails) XXX_Size() int {
+	return xxx_messageInfo_TransferDetails.Size(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptExecute(ctx context.Context, req *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+func (m *TransferDetails) XXX_DiscardUnknown() {
+	xxx_messageInfo_TransferDetails.DiscardUnknown(m)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptUpload(ctx context.Context, req *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+
+var xxx_messageInfo_TransferDetails proto.InternalMessageInfo
+
+func (m *TransferDetails) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptReadScript(ctx context.Context, req *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+
+func (m *TransferDetails) GetFromExchange() string {
+	if m != nil {
+		return m.FromExchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStatus(ctx context.Context, req *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+
+func (m *TransferDetails) GetToExchange() string {
+	if m != nil {
+		return m.ToExchange
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptQuery(ctx context.Context, req *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+
+func (m *TransferDetails) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStop(ctx context.Context, req *GCTScriptStopRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+
+func (m *TransferDetails) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *TransferDetails) GetNetwork() string {
+	if m != nil {
+		return m.Network
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetAddressTag() string {
+	if m != nil {
+		return m.AddressTag
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetWithdrawalId() string {
+	if m != nil {
+		return m.WithdrawalId
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetTxId() string {
+	if m != nil {
+		return m.TxId
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetFee() float64 {
+	if m != nil {
+		return m.Fee
+	}
+	return 0
+}
+
+func (m *TransferDetails) GetFeeEstimated() bool {
+	if m != nil {
+		return m.FeeEstimated
+	}
+	return false
+}
+
+func (m *TransferDetails) GetReceived() float64 {
+	if m != nil {
+		return m.Received
+	}
+	return 0
+}
+
+func (m *TransferDetails) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetCreated() string {
+	if m != nil {
+		return m.Created
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetUpdated() string {
+	if m != nil {
+		return m.Updated
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetApprovalExpires() string {
+	if m != nil {
+		return m.ApprovalExpires
+	}
+	return ""
+}
+
+func (m *TransferDetails) GetApprovedBy() string {
+	if m != nil {
+		return m.ApprovedBy
+	}
+	return ""
+}
+
+type GetTransfersRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTransfersRequest) Reset()         { *m = GetTransfersRequest{} }
+func (m *GetTransfersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTransfersRequest) ProtoMessage()    {}
+func (*GetTransfersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{128}
+}
+
+func (m *GetTransfersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransfersRequest.Unmarshal(m, b)
+}
+func (m *GetTransfersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransfersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTransfersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransfersRequest.Merge(m, src)
+}
+func (m *GetTransfersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTransfersRequest.Size(m)
+}
+func (m *GetTransfersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransfersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransfersRequest proto.InternalMessageInfo
+
+func (m *GetTransfersRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetTransfersResponse struct {
+	Transfers            []*TransferDetails `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *GetTransfersResponse) Reset()         { *m = GetTransfersResponse{} }
+func (m *GetTransfersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetTransfersResponse) ProtoMessage()    {}
+func (*GetTransfersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{129}
+}
+
+func (m *GetTransfersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTransfersResponse.Unmarshal(m, b)
+}
+func (m *GetTransfersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTransfersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetTransfersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTransfersResponse.Merge(m, src)
+}
+func (m *GetTransfersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetTransfersResponse.Size(m)
+}
+func (m *GetTransfersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTransfersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTransfersResponse proto.InternalMessageInfo
+
+func (m *GetTransfersResponse) GetTransfers() []*TransferDetails {
+	if m != nil {
+		return m.Transfers
+	}
+	return nil
+}
+
+type ApproveTransferRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApproveTransferRequest) Reset()         { *m = ApproveTransferRequest{} }
+func (m *ApproveTransferRequest) String() string { return proto.CompactTextString(m) }
+func (*ApproveTransferRequest) ProtoMessage()    {}
+func (*ApproveTransferRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{130}
+}
+
+func (m *ApproveTransferRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApproveTransferRequest.Unmarshal(m, b)
+}
+func (m *ApproveTransferRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApproveTransferRequest.Marshal(b, m, deterministic)
+}
+func (m *ApproveTransferRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApproveTransferRequest.Merge(m, src)
+}
+func (m *ApproveTransferRequest) XXX_Size() int {
+	return xxx_messageInfo_ApproveTransferRequest.Size(m)
+}
+func (m *ApproveTransferRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApproveTransferRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApproveTransferRequest proto.InternalMessageInfo
+
+func (m *ApproveTransferRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type PriceAlert struct {
+	Id                   int64         `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	Exchange             string        `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Condition            string        `protobuf:"bytes,5,opt,name=condition,proto3" json:"condition,omitempty"`
+	Threshold            float64       `protobuf:"fixed64,6,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ReferencePrice       float64       `protobuf:"fixed64,7,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	Note                 string        `protobuf:"bytes,8,opt,name=note,proto3" json:"note,omitempty"`
+	Active               bool          `protobuf:"varint,9,opt,name=active,proto3" json:"active,omitempty"`
+	TriggeredPrice       float64       `protobuf:"fixed64,10,opt,name=triggered_price,json=triggeredPrice,proto3" json:"triggered_price,omitempty"`
+	Created              string        `protobuf:"bytes,11,opt,name=created,proto3" json:"created,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *PriceAlert) Reset()         { *m = PriceAlert{} }
+func (m *PriceAlert) String() string { return proto.CompactTextString(m) }
+func (*PriceAlert) ProtoMessage()    {}
+func (*PriceAlert) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{131}
+}
+
+func (m *PriceAlert) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_PriceAlert.Unmarshal(m, b)
+}
+func (m *PriceAlert) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_PriceAlert.Marshal(b, m, deterministic)
+}
+func (m *PriceAlert) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceAlert.Merge(m, src)
+}
+func (m *PriceAlert) XXX_Size() int {
+	return xxx_messageInfo_PriceAlert.Size(m)
+}
+func (m *PriceAlert) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceAlert.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceAlert proto.InternalMessageInfo
+
+func (m *PriceAlert) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *PriceAlert) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *PriceAlert) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *PriceAlert) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *PriceAlert) GetCondition() string {
+	if m != nil {
+		return m.Condition
+	}
+	return ""
+}
+
+func (m *PriceAlert) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *PriceAlert) GetReferencePrice() float64 {
+	if m != nil {
+		return m.ReferencePrice
+	}
+	return 0
+}
+
+func (m *PriceAlert) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+func (m *PriceAlert) GetActive() bool {
+	if m != nil {
+		return m.Active
+	}
+	return false
+}
+
+func (m *PriceAlert) GetTriggeredPrice() float64 {
+	if m != nil {
+		return m.TriggeredPrice
+	}
+	return 0
+}
+
+func (m *PriceAlert) GetCreated() string {
+	if m != nil {
+		return m.Created
+	}
+	return ""
+}
+
+type AddPriceAlertRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Condition            string        `protobuf:"bytes,4,opt,name=condition,proto3" json:"condition,omitempty"`
+	Threshold            float64       `protobuf:"fixed64,5,opt,name=threshold,proto3" json:"threshold,omitempty"`
+	ReferencePrice       float64       `protobuf:"fixed64,6,opt,name=reference_price,json=referencePrice,proto3" json:"reference_price,omitempty"`
+	Note                 string        `protobuf:"bytes,7,opt,name=note,proto3" json:"note,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *AddPriceAlertRequest) Reset()         { *m = AddPriceAlertRequest{} }
+func (m *AddPriceAlertRequest) String() string { return proto.CompactTextString(m) }
+func (*AddPriceAlertRequest) ProtoMessage()    {}
+func (*AddPriceAlertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{132}
+}
+
+func (m *AddPriceAlertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddPriceAlertRequest.Unmarshal(m, b)
+}
+func (m *AddPriceAlertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddPriceAlertRequest.Marshal(b, m, deterministic)
+}
+func (m *AddPriceAlertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddPriceAlertRequest.Merge(m, src)
+}
+func (m *AddPriceAlertRequest) XXX_Size() int {
+	return xxx_messageInfo_AddPriceAlertRequest.Size(m)
+}
+func (m *AddPriceAlertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddPriceAlertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddPriceAlertRequest proto.InternalMessageInfo
+
+func (m *AddPriceAlertRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *AddPriceAlertRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *AddPriceAlertRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *AddPriceAlertRequest) GetCondition() string {
+	if m != nil {
+		return m.Condition
+	}
+	return ""
+}
+
+func (m *AddPriceAlertRequest) GetThreshold() float64 {
+	if m != nil {
+		return m.Threshold
+	}
+	return 0
+}
+
+func (m *AddPriceAlertRequest) GetReferencePrice() float64 {
+	if m != nil {
+		return m.ReferencePrice
+	}
+	return 0
+}
+
+func (m *AddPriceAlertRequest) GetNote() string {
+	if m != nil {
+		return m.Note
+	}
+	return ""
+}
+
+type GetPriceAlertsRequest struct {
+	ActiveOnly           bool     `protobuf:"varint,1,opt,name=active_only,json=activeOnly,proto3" json:"active_only,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetPriceAlertsRequest) Reset()         { *m = GetPriceAlertsRequest{} }
+func (m *GetPriceAlertsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetPriceAlertsRequest) ProtoMessage()    {}
+func (*GetPriceAlertsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{133}
+}
+
+func (m *GetPriceAlertsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPriceAlertsRequest.Unmarshal(m, b)
+}
+func (m *GetPriceAlertsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPriceAlertsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetPriceAlertsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceAlertsRequest.Merge(m, src)
+}
+func (m *GetPriceAlertsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetPriceAlertsRequest.Size(m)
+}
+func (m *GetPriceAlertsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceAlertsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceAlertsRequest proto.InternalMessageInfo
+
+func (m *GetPriceAlertsRequest) GetActiveOnly() bool {
+	if m != nil {
+		return m.ActiveOnly
+	}
+	return false
+}
+
+type GetPriceAlertsResponse struct {
+	Alerts               []*PriceAlert `protobuf:"bytes,1,rep,name=alerts,proto3" json:"alerts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetPriceAlertsResponse) Reset()         { *m = GetPriceAlertsResponse{} }
+func (m *GetPriceAlertsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetPriceAlertsResponse) ProtoMessage()    {}
+func (*GetPriceAlertsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{134}
+}
+
+func (m *GetPriceAlertsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetPriceAlertsResponse.Unmarshal(m, b)
+}
+func (m *GetPriceAlertsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetPriceAlertsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetPriceAlertsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetPriceAlertsResponse.Merge(m, src)
+}
+func (m *GetPriceAlertsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetPriceAlertsResponse.Size(m)
+}
+func (m *GetPriceAlertsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetPriceAlertsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetPriceAlertsResponse proto.InternalMessageInfo
+
+func (m *GetPriceAlertsResponse) GetAlerts() []*PriceAlert {
+	if m != nil {
+		return m.Alerts
+	}
+	return nil
+}
+
+type RemovePriceAlertRequest struct {
+	Id                   int64    `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePriceAlertRequest) Reset()         { *m = RemovePriceAlertRequest{} }
+func (m *RemovePriceAlertRequest) String() string { return proto.CompactTextString(m) }
+func (*RemovePriceAlertRequest) ProtoMessage()    {}
+func (*RemovePriceAlertRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{135}
+}
+
+func (m *RemovePriceAlertRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePriceAlertRequest.Unmarshal(m, b)
+}
+func (m *RemovePriceAlertRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePriceAlertRequest.Marshal(b, m, deterministic)
+}
+func (m *RemovePriceAlertRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePriceAlertRequest.Merge(m, src)
+}
+func (m *RemovePriceAlertRequest) XXX_Size() int {
+	return xxx_messageInfo_RemovePriceAlertRequest.Size(m)
+}
+func (m *RemovePriceAlertRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePriceAlertRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePriceAlertRequest proto.InternalMessageInfo
+
+func (m *RemovePriceAlertRequest) GetId() int64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+type RemovePriceAlertResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemovePriceAlertResponse) Reset()         { *m = RemovePriceAlertResponse{} }
+func (m *RemovePriceAlertResponse) String() string { return proto.CompactTextString(m) }
+func (*RemovePriceAlertResponse) ProtoMessage()    {}
+func (*RemovePriceAlertResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{136}
+}
+
+func (m *RemovePriceAlertResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemovePriceAlertResponse.Unmarshal(m, b)
+}
+func (m *RemovePriceAlertResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemovePriceAlertResponse.Marshal(b, m, deterministic)
+}
+func (m *RemovePriceAlertResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemovePriceAlertResponse.Merge(m, src)
+}
+func (m *RemovePriceAlertResponse) XXX_Size() int {
+	return xxx_messageInfo_RemovePriceAlertResponse.Size(m)
+}
+func (m *RemovePriceAlertResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemovePriceAlertResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemovePriceAlertResponse proto.InternalMessageInfo
+
+type TrailingStopStatus struct {
+	Currency             string             `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Value                float64            `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	HighWaterMark        float64            `protobuf:"fixed64,3,opt,name=high_water_mark,json=highWaterMark,proto3" json:"high_water_mark,omitempty"`
+	Drawdown             float64            `protobuf:"fixed64,4,opt,name=drawdown,proto3" json:"drawdown,omitempty"`
+	DrawdownPercent      float64            `protobuf:"fixed64,5,opt,name=drawdown_percent,json=drawdownPercent,proto3" json:"drawdown_percent,omitempty"`
+	Exchanges            map[string]float64 `protobuf:"bytes,6,rep,name=exchanges,proto3" json:"exchanges,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"fixed64,2,opt,name=value,proto3"`
+	Unpriced             []string           `protobuf:"bytes,7,rep,name=unpriced,proto3" json:"unpriced,omitempty"`
+	Triggered            bool               `protobuf:"varint,8,opt,name=triggered,proto3" json:"triggered,omitempty"`
+	TriggeredAt          string             `protobuf:"bytes,9,opt,name=triggered_at,json=triggeredAt,proto3" json:"triggered_at,omitempty"`
+	OrdersHalted         bool               `protobuf:"varint,10,opt,name=orders_halted,json=ordersHalted,proto3" json:"orders_halted,omitempty"`
+	Updated              string             `protobuf:"bytes,11,opt,name=updated,proto3" json:"updated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *TrailingStopStatus) Reset()         { *m = TrailingStopStatus{} }
+func (m *TrailingStopStatus) String() string { return proto.CompactTextString(m) }
+func (*TrailingStopStatus) ProtoMessage()    {}
+func (*TrailingStopStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{137}
+}
+
+func (m *TrailingStopStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_TrailingStopStatus.Unmarshal(m, b)
+}
+func (m *TrailingStopStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_TrailingStopStatus.Marshal(b, m, deterministic)
+}
+func (m *TrailingStopStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TrailingStopStatus.Merge(m, src)
+}
+func (m *TrailingStopStatus) XXX_Size() int {
+	return xxx_messageInfo_TrailingStopStatus.Size(m)
+}
+func (m *TrailingStopStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_TrailingStopStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TrailingStopStatus proto.InternalMessageInfo
+
+func (m *TrailingStopStatus) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *TrailingStopStatus) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *TrailingStopStatus) GetHighWaterMark() float64 {
+	if m != nil {
+		return m.HighWaterMark
+	}
+	return 0
+}
+
+func (m *TrailingStopStatus) GetDrawdown() float64 {
+	if m != nil {
+		return m.Drawdown
+	}
+	return 0
+}
+
+func (m *TrailingStopStatus) GetDrawdownPercent() float64 {
+	if m != nil {
+		return m.DrawdownPercent
+	}
+	return 0
+}
+
+func (m *TrailingStopStatus) GetExchanges() map[string]float64 {
+	if m != nil {
+		return m.Exchanges
+	}
+	return nil
+}
+
+func (m *TrailingStopStatus) GetUnpriced() []string {
+	if m != nil {
+		return m.Unpriced
+	}
+	return nil
+}
+
+func (m *TrailingStopStatus) GetTriggered() bool {
+	if m != nil {
+		return m.Triggered
+	}
+	return false
+}
+
+func (m *TrailingStopStatus) GetTriggeredAt() string {
+	if m != nil {
+		return m.TriggeredAt
+	}
+	return ""
+}
+
+func (m *TrailingStopStatus) GetOrdersHalted() bool {
+	if m != nil {
+		return m.OrdersHalted
+	}
+	return false
+}
+
+func (m *TrailingStopStatus) GetUpdated() string {
+	if m != nil {
+		return m.Updated
+	}
+	return ""
+}
+
+type GetTrailingStopRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTrailingStopRequest) Reset()         { *m = GetTrailingStopRequest{} }
+func (m *GetTrailingStopRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTrailingStopRequest) ProtoMessage()    {}
+func (*GetTrailingStopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{138}
+}
+
+func (m *GetTrailingStopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTrailingStopRequest.Unmarshal(m, b)
+}
+func (m *GetTrailingStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTrailingStopRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTrailingStopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTrailingStopRequest.Merge(m, src)
+}
+func (m *GetTrailingStopRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTrailingStopRequest.Size(m)
+}
+func (m *GetTrailingStopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTrailingStopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTrailingStopRequest proto.InternalMessageInfo
+
+type ResetTrailingStopRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResetTrailingStopRequest) Reset()         { *m = ResetTrailingStopRequest{} }
+func (m *ResetTrailingStopRequest) String() string { return proto.CompactTextString(m) }
+func (*ResetTrailingStopRequest) ProtoMessage()    {}
+func (*ResetTrailingStopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{139}
+}
+
+func (m *ResetTrailingStopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResetTrailingStopRequest.Unmarshal(m, b)
+}
+func (m *ResetTrailingStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResetTrailingStopRequest.Marshal(b, m, deterministic)
+}
+func (m *ResetTrailingStopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResetTrailingStopRequest.Merge(m, src)
+}
+func (m *ResetTrailingStopRequest) XXX_Size() int {
+	return xxx_messageInfo_ResetTrailingStopRequest.Size(m)
+}
+func (m *ResetTrailingStopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResetTrailingStopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResetTrailingStopRequest proto.InternalMessageInfo
+
+type MarginPosition struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType            string        `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Size                 float64       `protobuf:"fixed64,4,opt,name=size,proto3" json:"size,omitempty"`
+	Currency             string        `protobuf:"bytes,5,opt,name=currency,proto3" json:"currency,omitempty"`
+	Margin               float64       `protobuf:"fixed64,6,opt,name=margin,proto3" json:"margin,omitempty"`
+	MaintenanceMargin    float64       `protobuf:"fixed64,7,opt,name=maintenance_margin,json=maintenanceMargin,proto3" json:"maintenance_margin,omitempty"`
+	MarginRatio          float64       `protobuf:"fixed64,8,opt,name=margin_ratio,json=marginRatio,proto3" json:"margin_ratio,omitempty"`
+	MarkPrice            float64       `protobuf:"fixed64,9,opt,name=mark_price,json=markPrice,proto3" json:"mark_price,omitempty"`
+	LiquidationPrice     float64       `protobuf:"fixed64,10,opt,name=liquidation_price,json=liquidationPrice,proto3" json:"liquidation_price,omitempty"`
+	Isolated             bool          `protobuf:"varint,11,opt,name=isolated,proto3" json:"isolated,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *MarginPosition) Reset()         { *m = MarginPosition{} }
+func (m *MarginPosition) String() string { return proto.CompactTextString(m) }
+func (*MarginPosition) ProtoMessage()    {}
+func (*MarginPosition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{140}
+}
+
+func (m *MarginPosition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_MarginPosition.Unmarshal(m, b)
+}
+func (m *MarginPosition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_MarginPosition.Marshal(b, m, deterministic)
+}
+func (m *MarginPosition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MarginPosition.Merge(m, src)
+}
+func (m *MarginPosition) XXX_Size() int {
+	return xxx_messageInfo_MarginPosition.Size(m)
+}
+func (m *MarginPosition) XXX_DiscardUnknown() {
+	xxx_messageInfo_MarginPosition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MarginPosition proto.InternalMessageInfo
+
+func (m *MarginPosition) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *MarginPosition) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *MarginPosition) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *MarginPosition) GetSize() float64 {
+	if m != nil {
+		return m.Size
+	}
+	return 0
+}
+
+func (m *MarginPosition) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *MarginPosition) GetMargin() float64 {
+	if m != nil {
+		return m.Margin
+	}
+	return 0
+}
+
+func (m *MarginPosition) GetMaintenanceMargin() float64 {
+	if m != nil {
+		return m.MaintenanceMargin
+	}
+	return 0
+}
+
+func (m *MarginPosition) GetMarginRatio() float64 {
+	if m != nil {
+		return m.MarginRatio
+	}
+	return 0
+}
+
+func (m *MarginPosition) GetMarkPrice() float64 {
+	if m != nil {
+		return m.MarkPrice
+	}
+	return 0
+}
+
+func (m *MarginPosition) GetLiquidationPrice() float64 {
+	if m != nil {
+		return m.LiquidationPrice
+	}
+	return 0
+}
+
+func (m *MarginPosition) GetIsolated() bool {
+	if m != nil {
+		return m.Isolated
+	}
+	return false
+}
+
+type GetMarginPositionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetMarginPositionsRequest) Reset()         { *m = GetMarginPositionsRequest{} }
+func (m *GetMarginPositionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetMarginPositionsRequest) ProtoMessage()    {}
+func (*GetMarginPositionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{141}
+}
+
+func (m *GetMarginPositionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMarginPositionsRequest.Unmarshal(m, b)
+}
+func (m *GetMarginPositionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMarginPositionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetMarginPositionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMarginPositionsRequest.Merge(m, src)
+}
+func (m *GetMarginPositionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetMarginPositionsRequest.Size(m)
+}
+func (m *GetMarginPositionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMarginPositionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMarginPositionsRequest proto.InternalMessageInfo
+
+type GetMarginPositionsResponse struct {
+	Positions            []*MarginPosition `protobuf:"bytes,1,rep,name=positions,proto3" json:"positions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetMarginPositionsResponse) Reset()         { *m = GetMarginPositionsResponse{} }
+func (m *GetMarginPositionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetMarginPositionsResponse) ProtoMessage()    {}
+func (*GetMarginPositionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{142}
+}
+
+func (m *GetMarginPositionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetMarginPositionsResponse.Unmarshal(m, b)
+}
+func (m *GetMarginPositionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetMarginPositionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetMarginPositionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetMarginPositionsResponse.Merge(m, src)
+}
+func (m *GetMarginPositionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetMarginPositionsResponse.Size(m)
+}
+func (m *GetMarginPositionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetMarginPositionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetMarginPositionsResponse proto.InternalMessageInfo
+
+func (m *GetMarginPositionsResponse) GetPositions() []*MarginPosition {
+	if m != nil {
+		return m.Positions
+	}
+	return nil
+}
+
+type GetArbitrageOpportunitiesRequest struct {
+	Exchanges            []string `protobuf:"bytes,1,rep,name=exchanges,proto3" json:"exchanges,omitempty"`
+	MinProfitPercent     float64  `protobuf:"fixed64,2,opt,name=min_profit_percent,json=minProfitPercent,proto3" json:"min_profit_percent,omitempty"`
+	Type                 string   `protobuf:"bytes,3,opt,name=type,proto3" json:"type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetArbitrageOpportunitiesRequest) Reset()         { *m = GetArbitrageOpportunitiesRequest{} }
+func (m *GetArbitrageOpportunitiesRequest) String() string { return proto.CompactTextString(m) }
+func (*GetArbitrageOpportunitiesRequest) ProtoMessage()    {}
+func (*GetArbitrageOpportunitiesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{143}
+}
+
+func (m *GetArbitrageOpportunitiesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Unmarshal(m, b)
+}
+func (m *GetArbitrageOpportunitiesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Marshal(b, m, deterministic)
+}
+func (m *GetArbitrageOpportunitiesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArbitrageOpportunitiesRequest.Merge(m, src)
+}
+func (m *GetArbitrageOpportunitiesRequest) XXX_Size() int {
+	return xxx_messageInfo_GetArbitrageOpportunitiesRequest.Size(m)
+}
+func (m *GetArbitrageOpportunitiesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArbitrageOpportunitiesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArbitrageOpportunitiesRequest proto.InternalMessageInfo
+
+func (m *GetArbitrageOpportunitiesRequest) GetExchanges() []string {
+	if m != nil {
+		return m.Exchanges
+	}
+	return nil
+}
+
+func (m *GetArbitrageOpportunitiesRequest) GetMinProfitPercent() float64 {
+	if m != nil {
+		return m.MinProfitPercent
+	}
+	return 0
+}
+
+func (m *GetArbitrageOpportunitiesRequest) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+type ArbitrageLeg struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	Side                 string        `protobuf:"bytes,3,opt,name=side,proto3" json:"side,omitempty"`
+	Price                float64       `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	FeeRate              float64       `protobuf:"fixed64,6,opt,name=fee_rate,json=feeRate,proto3" json:"fee_rate,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ArbitrageLeg) Reset()         { *m = ArbitrageLeg{} }
+func (m *ArbitrageLeg) String() string { return proto.CompactTextString(m) }
+func (*ArbitrageLeg) ProtoMessage()    {}
+func (*ArbitrageLeg) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{144}
+}
+
+func (m *ArbitrageLeg) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArbitrageLeg.Unmarshal(m, b)
+}
+func (m *ArbitrageLeg) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArbitrageLeg.Marshal(b, m, deterministic)
+}
+func (m *ArbitrageLeg) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrageLeg.Merge(m, src)
+}
+func (m *ArbitrageLeg) XXX_Size() int {
+	return xxx_messageInfo_ArbitrageLeg.Size(m)
+}
+func (m *ArbitrageLeg) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrageLeg.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArbitrageLeg proto.InternalMessageInfo
+
+func (m *ArbitrageLeg) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ArbitrageLeg) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *ArbitrageLeg) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *ArbitrageLeg) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ArbitrageLeg) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ArbitrageLeg) GetFeeRate() float64 {
+	if m != nil {
+		return m.FeeRate
+	}
+	return 0
+}
+
+type ArbitrageOpportunity struct {
+	Type                 string          `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Legs                 []*ArbitrageLeg `protobuf:"bytes,2,rep,name=legs,proto3" json:"legs,omitempty"`
+	Currency             string          `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	Amount               float64         `protobuf:"fixed64,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Profit               float64         `protobuf:"fixed64,5,opt,name=profit,proto3" json:"profit,omitempty"`
+	GrossProfitPercent   float64         `protobuf:"fixed64,6,opt,name=gross_profit_percent,json=grossProfitPercent,proto3" json:"gross_profit_percent,omitempty"`
+	NetProfitPercent     float64         `protobuf:"fixed64,7,opt,name=net_profit_percent,json=netProfitPercent,proto3" json:"net_profit_percent,omitempty"`
+	TransferFee          float64         `protobuf:"fixed64,8,opt,name=transfer_fee,json=transferFee,proto3" json:"transfer_fee,omitempty"`
+	Detected             string          `protobuf:"bytes,9,opt,name=detected,proto3" json:"detected,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *ArbitrageOpportunity) Reset()         { *m = ArbitrageOpportunity{} }
+func (m *ArbitrageOpportunity) String() string { return proto.CompactTextString(m) }
+func (*ArbitrageOpportunity) ProtoMessage()    {}
+func (*ArbitrageOpportunity) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{145}
+}
+
+func (m *ArbitrageOpportunity) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ArbitrageOpportunity.Unmarshal(m, b)
+}
+func (m *ArbitrageOpportunity) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ArbitrageOpportunity.Marshal(b, m, deterministic)
+}
+func (m *ArbitrageOpportunity) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ArbitrageOpportunity.Merge(m, src)
+}
+func (m *ArbitrageOpportunity) XXX_Size() int {
+	return xxx_messageInfo_ArbitrageOpportunity.Size(m)
+}
+func (m *ArbitrageOpportunity) XXX_DiscardUnknown() {
+	xxx_messageInfo_ArbitrageOpportunity.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ArbitrageOpportunity proto.InternalMessageInfo
+
+func (m *ArbitrageOpportunity) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ArbitrageOpportunity) GetLegs() []*ArbitrageLeg {
+	if m != nil {
+		return m.Legs
+	}
+	return nil
+}
+
+func (m *ArbitrageOpportunity) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *ArbitrageOpportunity) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetProfit() float64 {
+	if m != nil {
+		return m.Profit
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetGrossProfitPercent() float64 {
+	if m != nil {
+		return m.GrossProfitPercent
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetNetProfitPercent() float64 {
+	if m != nil {
+		return m.NetProfitPercent
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetTransferFee() float64 {
+	if m != nil {
+		return m.TransferFee
+	}
+	return 0
+}
+
+func (m *ArbitrageOpportunity) GetDetected() string {
+	if m != nil {
+		return m.Detected
+	}
+	return ""
+}
+
+type GetArbitrageOpportunitiesResponse struct {
+	Opportunities        []*ArbitrageOpportunity `protobuf:"bytes,1,rep,name=opportunities,proto3" json:"opportunities,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                `json:"-"`
+	XXX_unrecognized     []byte                  `json:"-"`
+	XXX_sizecache        int32                   `json:"-"`
+}
+
+func (m *GetArbitrageOpportunitiesResponse) Reset()         { *m = GetArbitrageOpportunitiesResponse{} }
+func (m *GetArbitrageOpportunitiesResponse) String() string { return proto.CompactTextString(m) }
+func (*GetArbitrageOpportunitiesResponse) ProtoMessage()    {}
+func (*GetArbitrageOpportunitiesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{146}
+}
+
+func (m *GetArbitrageOpportunitiesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Unmarshal(m, b)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Marshal(b, m, deterministic)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetArbitrageOpportunitiesResponse.Merge(m, src)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_Size() int {
+	return xxx_messageInfo_GetArbitrageOpportunitiesResponse.Size(m)
+}
+func (m *GetArbitrageOpportunitiesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetArbitrageOpportunitiesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetArbitrageOpportunitiesResponse proto.InternalMessageInfo
+
+func (m *GetArbitrageOpportunitiesResponse) GetOpportunities() []*ArbitrageOpportunity {
+	if m != nil {
+		return m.Opportunities
+	}
+	return nil
+}
+
+type GetLiquidationStreamRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetLiquidationStreamRequest) Reset()         { *m = GetLiquidationStreamRequest{} }
+func (m *GetLiquidationStreamRequest) String() string { return proto.CompactTextString(m) }
+func (*GetLiquidationStreamRequest) ProtoMessage()    {}
+func (*GetLiquidationStreamRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{147}
+}
+
+func (m *GetLiquidationStreamRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetLiquidationStreamRequest.Unmarshal(m, b)
+}
+func (m *GetLiquidationStreamRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetLiquidationStreamRequest.Marshal(b, m, deterministic)
+}
+func (m *GetLiquidationStreamRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetLiquidationStreamRequest.Merge(m, src)
+}
+func (m *GetLiquidationStreamRequest) XXX_Size() int {
+	return xxx_messageInfo_GetLiquidationStreamRequest.Size(m)
+}
+func (m *GetLiquidationStreamRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetLiquidationStreamRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetLiquidationStreamRequest proto.InternalMessageInfo
+
+func (m *GetLiquidationStreamRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetLiquidationStreamRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetLiquidationStreamRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+type LiquidationResponse struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Side                 string        `protobuf:"bytes,4,opt,name=side,proto3" json:"side,omitempty"`
+	Price                float64       `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	Timestamp            int64         `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *LiquidationResponse) Reset()         { *m = LiquidationResponse{} }
+func (m *LiquidationResponse) String() string { return proto.CompactTextString(m) }
+func (*LiquidationResponse) ProtoMessage()    {}
+func (*LiquidationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{148}
+}
+
+func (m *LiquidationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_LiquidationResponse.Unmarshal(m, b)
+}
+func (m *LiquidationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_LiquidationResponse.Marshal(b, m, deterministic)
+}
+func (m *LiquidationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_LiquidationResponse.Merge(m, src)
+}
+func (m *LiquidationResponse) XXX_Size() int {
+	return xxx_messageInfo_LiquidationResponse.Size(m)
+}
+func (m *LiquidationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_LiquidationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_LiquidationResponse proto.InternalMessageInfo
+
+func (m *LiquidationResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *LiquidationResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *LiquidationResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *LiquidationResponse) GetSide() string {
+	if m != nil {
+		return m.Side
+	}
+	return ""
+}
+
+func (m *LiquidationResponse) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *LiquidationResponse) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *LiquidationResponse) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+type GetOpenInterestRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	StartDate            string        `protobuf:"bytes,4,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	EndDate              string        `protobuf:"bytes,5,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetOpenInterestRequest) Reset()         { *m = GetOpenInterestRequest{} }
+func (m *GetOpenInterestRequest) String() string { return proto.CompactTextString(m) }
+func (*GetOpenInterestRequest) ProtoMessage()    {}
+func (*GetOpenInterestRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{149}
+}
+
+func (m *GetOpenInterestRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOpenInterestRequest.Unmarshal(m, b)
+}
+func (m *GetOpenInterestRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOpenInterestRequest.Marshal(b, m, deterministic)
+}
+func (m *GetOpenInterestRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOpenInterestRequest.Merge(m, src)
+}
+func (m *GetOpenInterestRequest) XXX_Size() int {
+	return xxx_messageInfo_GetOpenInterestRequest.Size(m)
+}
+func (m *GetOpenInterestRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOpenInterestRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOpenInterestRequest proto.InternalMessageInfo
+
+func (m *GetOpenInterestRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetOpenInterestRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetOpenInterestRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetOpenInterestRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *GetOpenInterestRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+type OpenInterest struct {
+	Amount               float64  `protobuf:"fixed64,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	Value                float64  `protobuf:"fixed64,2,opt,name=value,proto3" json:"value,omitempty"`
+	Currency             string   `protobuf:"bytes,3,opt,name=currency,proto3" json:"currency,omitempty"`
+	LongShortRatio       float64  `protobuf:"fixed64,4,opt,name=long_short_ratio,json=longShortRatio,proto3" json:"long_short_ratio,omitempty"`
+	Timestamp            string   `protobuf:"bytes,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *OpenInterest) Reset()         { *m = OpenInterest{} }
+func (m *OpenInterest) String() string { return proto.CompactTextString(m) }
+func (*OpenInterest) ProtoMessage()    {}
+func (*OpenInterest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{150}
+}
+
+func (m *OpenInterest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_OpenInterest.Unmarshal(m, b)
+}
+func (m *OpenInterest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_OpenInterest.Marshal(b, m, deterministic)
+}
+func (m *OpenInterest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OpenInterest.Merge(m, src)
+}
+func (m *OpenInterest) XXX_Size() int {
+	return xxx_messageInfo_OpenInterest.Size(m)
+}
+func (m *OpenInterest) XXX_DiscardUnknown() {
+	xxx_messageInfo_OpenInterest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OpenInterest proto.InternalMessageInfo
+
+func (m *OpenInterest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+func (m *OpenInterest) GetValue() float64 {
+	if m != nil {
+		return m.Value
+	}
+	return 0
+}
+
+func (m *OpenInterest) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *OpenInterest) GetLongShortRatio() float64 {
+	if m != nil {
+		return m.LongShortRatio
+	}
+	return 0
+}
+
+func (m *OpenInterest) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+type GetOpenInterestResponse struct {
+	Exchange             string          `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Pair                 *CurrencyPair   `protobuf:"bytes,2,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string          `protobuf:"bytes,3,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	OpenInterest         []*OpenInterest `protobuf:"bytes,4,rep,name=open_interest,json=openInterest,proto3" json:"open_interest,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *GetOpenInterestResponse) Reset()         { *m = GetOpenInterestResponse{} }
+func (m *GetOpenInterestResponse) String() string { return proto.CompactTextString(m) }
+func (*GetOpenInterestResponse) ProtoMessage()    {}
+func (*GetOpenInterestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{151}
+}
+
+func (m *GetOpenInterestResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetOpenInterestResponse.Unmarshal(m, b)
+}
+func (m *GetOpenInterestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetOpenInterestResponse.Marshal(b, m, deterministic)
+}
+func (m *GetOpenInterestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetOpenInterestResponse.Merge(m, src)
+}
+func (m *GetOpenInterestResponse) XXX_Size() int {
+	return xxx_messageInfo_GetOpenInterestResponse.Size(m)
+}
+func (m *GetOpenInterestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetOpenInterestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetOpenInterestResponse proto.InternalMessageInfo
+
+func (m *GetOpenInterestResponse) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetOpenInterestResponse) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *GetOpenInterestResponse) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *GetOpenInterestResponse) GetOpenInterest() []*OpenInterest {
+	if m != nil {
+		return m.OpenInterest
+	}
+	return nil
+}
+
+type GetStrategyLedgersRequest struct {
+	StrategyId           string   `protobuf:"bytes,1,opt,name=strategy_id,json=strategyId,proto3" json:"strategy_id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetStrategyLedgersRequest) Reset()         { *m = GetStrategyLedgersRequest{} }
+func (m *GetStrategyLedgersRequest) String() string { return proto.CompactTextString(m) }
+func (*GetStrategyLedgersRequest) ProtoMessage()    {}
+func (*GetStrategyLedgersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{152}
+}
+
+func (m *GetStrategyLedgersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStrategyLedgersRequest.Unmarshal(m, b)
+}
+func (m *GetStrategyLedgersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStrategyLedgersRequest.Marshal(b, m, deterministic)
+}
+func (m *GetStrategyLedgersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStrategyLedgersRequest.Merge(m, src)
+}
+func (m *GetStrategyLedgersRequest) XXX_Size() int {
+	return xxx_messageInfo_GetStrategyLedgersRequest.Size(m)
+}
+func (m *GetStrategyLedgersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStrategyLedgersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStrategyLedgersRequest proto.InternalMessageInfo
+
+func (m *GetStrategyLedgersRequest) GetStrategyId() string {
+	if m != nil {
+		return m.StrategyId
+	}
+	return ""
+}
+
+type StrategyBalance struct {
+	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Total                float64  `protobuf:"fixed64,2,opt,name=total,proto3" json:"total,omitempty"`
+	Reserved             float64  `protobuf:"fixed64,3,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Available            float64  `protobuf:"fixed64,4,opt,name=available,proto3" json:"available,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *StrategyBalance) Reset()         { *m = StrategyBalance{} }
+func (m *StrategyBalance) String() string { return proto.CompactTextString(m) }
+func (*StrategyBalance) ProtoMessage()    {}
+func (*StrategyBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{153}
+}
+
+func (m *StrategyBalance) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StrategyBalance.Unmarshal(m, b)
+}
+func (m *StrategyBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StrategyBalance.Marshal(b, m, deterministic)
+}
+func (m *StrategyBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategyBalance.Merge(m, src)
+}
+func (m *StrategyBalance) XXX_Size() int {
+	return xxx_messageInfo_StrategyBalance.Size(m)
+}
+func (m *StrategyBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategyBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategyBalance proto.InternalMessageInfo
+
+func (m *StrategyBalance) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *StrategyBalance) GetTotal() float64 {
+	if m != nil {
+		return m.Total
+	}
+	return 0
+}
+
+func (m *StrategyBalance) GetReserved() float64 {
+	if m != nil {
+		return m.Reserved
+	}
+	return 0
+}
+
+func (m *StrategyBalance) GetAvailable() float64 {
+	if m != nil {
+		return m.Available
+	}
+	return 0
+}
+
+type StrategyLedger struct {
+	StrategyId           string             `protobuf:"bytes,1,opt,name=strategy_id,json=strategyId,proto3" json:"strategy_id,omitempty"`
+	Exchange             string             `protobuf:"bytes,2,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Balances             []*StrategyBalance `protobuf:"bytes,3,rep,name=balances,proto3" json:"balances,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}           `json:"-"`
+	XXX_unrecognized     []byte             `json:"-"`
+	XXX_sizecache        int32              `json:"-"`
+}
+
+func (m *StrategyLedger) Reset()         { *m = StrategyLedger{} }
+func (m *StrategyLedger) String() string { return proto.CompactTextString(m) }
+func (*StrategyLedger) ProtoMessage()    {}
+func (*StrategyLedger) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{154}
+}
+
+func (m *StrategyLedger) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_StrategyLedger.Unmarshal(m, b)
+}
+func (m *StrategyLedger) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_StrategyLedger.Marshal(b, m, deterministic)
+}
+func (m *StrategyLedger) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StrategyLedger.Merge(m, src)
+}
+func (m *StrategyLedger) XXX_Size() int {
+	return xxx_messageInfo_StrategyLedger.Size(m)
+}
+func (m *StrategyLedger) XXX_DiscardUnknown() {
+	xxx_messageInfo_StrategyLedger.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_StrategyLedger proto.InternalMessageInfo
+
+func (m *StrategyLedger) GetStrategyId() string {
+	if m != nil {
+		return m.StrategyId
+	}
+	return ""
+}
+
+func (m *StrategyLedger) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *StrategyLedger) GetBalances() []*StrategyBalance {
+	if m != nil {
+		return m.Balances
+	}
+	return nil
+}
+
+type GetStrategyLedgersResponse struct {
+	Ledgers              []*StrategyLedger `protobuf:"bytes,1,rep,name=ledgers,proto3" json:"ledgers,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}          `json:"-"`
+	XXX_unrecognized     []byte            `json:"-"`
+	XXX_sizecache        int32             `json:"-"`
+}
+
+func (m *GetStrategyLedgersResponse) Reset()         { *m = GetStrategyLedgersResponse{} }
+func (m *GetStrategyLedgersResponse) String() string { return proto.CompactTextString(m) }
+func (*GetStrategyLedgersResponse) ProtoMessage()    {}
+func (*GetStrategyLedgersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{155}
+}
+
+func (m *GetStrategyLedgersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetStrategyLedgersResponse.Unmarshal(m, b)
+}
+func (m *GetStrategyLedgersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetStrategyLedgersResponse.Marshal(b, m, deterministic)
+}
+func (m *GetStrategyLedgersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetStrategyLedgersResponse.Merge(m, src)
+}
+func (m *GetStrategyLedgersResponse) XXX_Size() int {
+	return xxx_messageInfo_GetStrategyLedgersResponse.Size(m)
+}
+func (m *GetStrategyLedgersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetStrategyLedgersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetStrategyLedgersResponse proto.InternalMessageInfo
+
+func (m *GetStrategyLedgersResponse) GetLedgers() []*StrategyLedger {
+	if m != nil {
+		return m.Ledgers
+	}
+	return nil
+}
+
+type GetExposuresRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetExposuresRequest) Reset()         { *m = GetExposuresRequest{} }
+func (m *GetExposuresRequest) String() string { return proto.CompactTextString(m) }
+func (*GetExposuresRequest) ProtoMessage()    {}
+func (*GetExposuresRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{156}
+}
+
+func (m *GetExposuresRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExposuresRequest.Unmarshal(m, b)
+}
+func (m *GetExposuresRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExposuresRequest.Marshal(b, m, deterministic)
+}
+func (m *GetExposuresRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExposuresRequest.Merge(m, src)
+}
+func (m *GetExposuresRequest) XXX_Size() int {
+	return xxx_messageInfo_GetExposuresRequest.Size(m)
+}
+func (m *GetExposuresRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExposuresRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExposuresRequest proto.InternalMessageInfo
+
+type Exposure struct {
+	Currency             string   `protobuf:"bytes,1,opt,name=currency,proto3" json:"currency,omitempty"`
+	Spot                 float64  `protobuf:"fixed64,2,opt,name=spot,proto3" json:"spot,omitempty"`
+	Derivatives          float64  `protobuf:"fixed64,3,opt,name=derivatives,proto3" json:"derivatives,omitempty"`
+	Net                  float64  `protobuf:"fixed64,4,opt,name=net,proto3" json:"net,omitempty"`
+	Hedged               bool     `protobuf:"varint,5,opt,name=hedged,proto3" json:"hedged,omitempty"`
+	Target               float64  `protobuf:"fixed64,6,opt,name=target,proto3" json:"target,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Exposure) Reset()         { *m = Exposure{} }
+func (m *Exposure) String() string { return proto.CompactTextString(m) }
+func (*Exposure) ProtoMessage()    {}
+func (*Exposure) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{157}
+}
+
+func (m *Exposure) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Exposure.Unmarshal(m, b)
+}
+func (m *Exposure) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Exposure.Marshal(b, m, deterministic)
+}
+func (m *Exposure) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Exposure.Merge(m, src)
+}
+func (m *Exposure) XXX_Size() int {
+	return xxx_messageInfo_Exposure.Size(m)
+}
+func (m *Exposure) XXX_DiscardUnknown() {
+	xxx_messageInfo_Exposure.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Exposure proto.InternalMessageInfo
+
+func (m *Exposure) GetCurrency() string {
+	if m != nil {
+		return m.Currency
+	}
+	return ""
+}
+
+func (m *Exposure) GetSpot() float64 {
+	if m != nil {
+		return m.Spot
+	}
+	return 0
+}
+
+func (m *Exposure) GetDerivatives() float64 {
+	if m != nil {
+		return m.Derivatives
+	}
+	return 0
+}
+
+func (m *Exposure) GetNet() float64 {
+	if m != nil {
+		return m.Net
+	}
+	return 0
+}
+
+func (m *Exposure) GetHedged() bool {
+	if m != nil {
+		return m.Hedged
+	}
+	return false
+}
+
+func (m *Exposure) GetTarget() float64 {
+	if m != nil {
+		return m.Target
+	}
+	return 0
+}
+
+type GetExposuresResponse struct {
+	Exposures            []*Exposure `protobuf:"bytes,1,rep,name=exposures,proto3" json:"exposures,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}    `json:"-"`
+	XXX_unrecognized     []byte      `json:"-"`
+	XXX_sizecache        int32       `json:"-"`
+}
+
+func (m *GetExposuresResponse) Reset()         { *m = GetExposuresResponse{} }
+func (m *GetExposuresResponse) String() string { return proto.CompactTextString(m) }
+func (*GetExposuresResponse) ProtoMessage()    {}
+func (*GetExposuresResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{158}
+}
+
+func (m *GetExposuresResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetExposuresResponse.Unmarshal(m, b)
+}
+func (m *GetExposuresResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetExposuresResponse.Marshal(b, m, deterministic)
+}
+func (m *GetExposuresResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetExposuresResponse.Merge(m, src)
+}
+func (m *GetExposuresResponse) XXX_Size() int {
+	return xxx_messageInfo_GetExposuresResponse.Size(m)
+}
+func (m *GetExposuresResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetExposuresResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetExposuresResponse proto.InternalMessageInfo
+
+func (m *GetExposuresResponse) GetExposures() []*Exposure {
+	if m != nil {
+		return m.Exposures
+	}
+	return nil
+}
+
+type ModifyOrderRequest struct {
+	Exchange             string        `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	OrderId              string        `protobuf:"bytes,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Pair                 *CurrencyPair `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	AssetType            string        `protobuf:"bytes,4,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Price                float64       `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	Amount               float64       `protobuf:"fixed64,6,opt,name=amount,proto3" json:"amount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *ModifyOrderRequest) Reset()         { *m = ModifyOrderRequest{} }
+func (m *ModifyOrderRequest) String() string { return proto.CompactTextString(m) }
+func (*ModifyOrderRequest) ProtoMessage()    {}
+func (*ModifyOrderRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{159}
+}
+
+func (m *ModifyOrderRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyOrderRequest.Unmarshal(m, b)
+}
+func (m *ModifyOrderRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyOrderRequest.Marshal(b, m, deterministic)
+}
+func (m *ModifyOrderRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyOrderRequest.Merge(m, src)
+}
+func (m *ModifyOrderRequest) XXX_Size() int {
+	return xxx_messageInfo_ModifyOrderRequest.Size(m)
+}
+func (m *ModifyOrderRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyOrderRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyOrderRequest proto.InternalMessageInfo
+
+func (m *ModifyOrderRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *ModifyOrderRequest) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ModifyOrderRequest) GetPair() *CurrencyPair {
+	if m != nil {
+		return m.Pair
+	}
+	return nil
+}
+
+func (m *ModifyOrderRequest) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *ModifyOrderRequest) GetPrice() float64 {
+	if m != nil {
+		return m.Price
+	}
+	return 0
+}
+
+func (m *ModifyOrderRequest) GetAmount() float64 {
+	if m != nil {
+		return m.Amount
+	}
+	return 0
+}
+
+type ModifyOrderResponse struct {
+	OrderId              string   `protobuf:"bytes,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Replaced             bool     `protobuf:"varint,2,opt,name=replaced,proto3" json:"replaced,omitempty"`
+	ClientId             string   `protobuf:"bytes,3,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
+	CorrelationId        string   `protobuf:"bytes,4,opt,name=correlation_id,json=correlationId,proto3" json:"correlation_id,omitempty"`
+	ReplacedOrderIds     []string `protobuf:"bytes,5,rep,name=replaced_order_ids,json=replacedOrderIds,proto3" json:"replaced_order_ids,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ModifyOrderResponse) Reset()         { *m = ModifyOrderResponse{} }
+func (m *ModifyOrderResponse) String() string { return proto.CompactTextString(m) }
+func (*ModifyOrderResponse) ProtoMessage()    {}
+func (*ModifyOrderResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{160}
+}
+
+func (m *ModifyOrderResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ModifyOrderResponse.Unmarshal(m, b)
+}
+func (m *ModifyOrderResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ModifyOrderResponse.Marshal(b, m, deterministic)
+}
+func (m *ModifyOrderResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ModifyOrderResponse.Merge(m, src)
+}
+func (m *ModifyOrderResponse) XXX_Size() int {
+	return xxx_messageInfo_ModifyOrderResponse.Size(m)
+}
+func (m *ModifyOrderResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ModifyOrderResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ModifyOrderResponse proto.InternalMessageInfo
+
+func (m *ModifyOrderResponse) GetOrderId() string {
+	if m != nil {
+		return m.OrderId
+	}
+	return ""
+}
+
+func (m *ModifyOrderResponse) GetReplaced() bool {
+	if m != nil {
+		return m.Replaced
+	}
+	return false
+}
+
+func (m *ModifyOrderResponse) GetClientId() string {
+	if m != nil {
+		return m.ClientId
+	}
+	return ""
+}
+
+func (m *ModifyOrderResponse) GetCorrelationId() string {
+	if m != nil {
+		return m.CorrelationId
+	}
+	return ""
+}
+
+func (m *ModifyOrderResponse) GetReplacedOrderIds() []string {
+	if m != nil {
+		return m.ReplacedOrderIds
+	}
+	return nil
+}
+
+type RPCSession struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	Interface            string   `protobuf:"bytes,2,opt,name=interface,proto3" json:"interface,omitempty"`
+	Address              string   `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Started              string   `protobuf:"bytes,4,opt,name=started,proto3" json:"started,omitempty"`
+	LastSeen             string   `protobuf:"bytes,5,opt,name=last_seen,json=lastSeen,proto3" json:"last_seen,omitempty"`
+	Requests             int64    `protobuf:"varint,6,opt,name=requests,proto3" json:"requests,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RPCSession) Reset()         { *m = RPCSession{} }
+func (m *RPCSession) String() string { return proto.CompactTextString(m) }
+func (*RPCSession) ProtoMessage()    {}
+func (*RPCSession) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{161}
+}
+
+func (m *RPCSession) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RPCSession.Unmarshal(m, b)
+}
+func (m *RPCSession) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RPCSession.Marshal(b, m, deterministic)
+}
+func (m *RPCSession) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RPCSession.Merge(m, src)
+}
+func (m *RPCSession) XXX_Size() int {
+	return xxx_messageInfo_RPCSession.Size(m)
+}
+func (m *RPCSession) XXX_DiscardUnknown() {
+	xxx_messageInfo_RPCSession.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RPCSession proto.InternalMessageInfo
+
+func (m *RPCSession) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+func (m *RPCSession) GetInterface() string {
+	if m != nil {
+		return m.Interface
+	}
+	return ""
+}
+
+func (m *RPCSession) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *RPCSession) GetStarted() string {
+	if m != nil {
+		return m.Started
+	}
+	return ""
+}
+
+func (m *RPCSession) GetLastSeen() string {
+	if m != nil {
+		return m.LastSeen
+	}
+	return ""
+}
+
+func (m *RPCSession) GetRequests() int64 {
+	if m != nil {
+		return m.Requests
+	}
+	return 0
+}
+
+type GetRPCSessionsRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetRPCSessionsRequest) Reset()         { *m = GetRPCSessionsRequest{} }
+func (m *GetRPCSessionsRequest) String() string { return proto.CompactTextString(m) }
+func (*GetRPCSessionsRequest) ProtoMessage()    {}
+func (*GetRPCSessionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{162}
+}
+
+func (m *GetRPCSessionsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRPCSessionsRequest.Unmarshal(m, b)
+}
+func (m *GetRPCSessionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRPCSessionsRequest.Marshal(b, m, deterministic)
+}
+func (m *GetRPCSessionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRPCSessionsRequest.Merge(m, src)
+}
+func (m *GetRPCSessionsRequest) XXX_Size() int {
+	return xxx_messageInfo_GetRPCSessionsRequest.Size(m)
+}
+func (m *GetRPCSessionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRPCSessionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRPCSessionsRequest proto.InternalMessageInfo
+
+type GetRPCSessionsResponse struct {
+	Sessions             []*RPCSession `protobuf:"bytes,1,rep,name=sessions,proto3" json:"sessions,omitempty"`
+	AllowedIps           []string      `protobuf:"bytes,2,rep,name=allowed_ips,json=allowedIps,proto3" json:"allowed_ips,omitempty"`
+	MaxSessions          int64         `protobuf:"varint,3,opt,name=max_sessions,json=maxSessions,proto3" json:"max_sessions,omitempty"`
+	SessionTimeout       string        `protobuf:"bytes,4,opt,name=session_timeout,json=sessionTimeout,proto3" json:"session_timeout,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}      `json:"-"`
+	XXX_unrecognized     []byte        `json:"-"`
+	XXX_sizecache        int32         `json:"-"`
+}
+
+func (m *GetRPCSessionsResponse) Reset()         { *m = GetRPCSessionsResponse{} }
+func (m *GetRPCSessionsResponse) String() string { return proto.CompactTextString(m) }
+func (*GetRPCSessionsResponse) ProtoMessage()    {}
+func (*GetRPCSessionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{163}
+}
+
+func (m *GetRPCSessionsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetRPCSessionsResponse.Unmarshal(m, b)
+}
+func (m *GetRPCSessionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetRPCSessionsResponse.Marshal(b, m, deterministic)
+}
+func (m *GetRPCSessionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetRPCSessionsResponse.Merge(m, src)
+}
+func (m *GetRPCSessionsResponse) XXX_Size() int {
+	return xxx_messageInfo_GetRPCSessionsResponse.Size(m)
+}
+func (m *GetRPCSessionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetRPCSessionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetRPCSessionsResponse proto.InternalMessageInfo
+
+func (m *GetRPCSessionsResponse) GetSessions() []*RPCSession {
+	if m != nil {
+		return m.Sessions
+	}
+	return nil
+}
+
+func (m *GetRPCSessionsResponse) GetAllowedIps() []string {
+	if m != nil {
+		return m.AllowedIps
+	}
+	return nil
+}
+
+func (m *GetRPCSessionsResponse) GetMaxSessions() int64 {
+	if m != nil {
+		return m.MaxSessions
+	}
+	return 0
+}
+
+func (m *GetRPCSessionsResponse) GetSessionTimeout() string {
+	if m != nil {
+		return m.SessionTimeout
+	}
+	return ""
+}
+
+type RevokeRPCSessionRequest struct {
+	Id                   string   `protobuf:"bytes,1,opt,name=id,proto3" json:"id,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RevokeRPCSessionRequest) Reset()         { *m = RevokeRPCSessionRequest{} }
+func (m *RevokeRPCSessionRequest) String() string { return proto.CompactTextString(m) }
+func (*RevokeRPCSessionRequest) ProtoMessage()    {}
+func (*RevokeRPCSessionRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{164}
+}
+
+func (m *RevokeRPCSessionRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RevokeRPCSessionRequest.Unmarshal(m, b)
+}
+func (m *RevokeRPCSessionRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RevokeRPCSessionRequest.Marshal(b, m, deterministic)
+}
+func (m *RevokeRPCSessionRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RevokeRPCSessionRequest.Merge(m, src)
+}
+func (m *RevokeRPCSessionRequest) XXX_Size() int {
+	return xxx_messageInfo_RevokeRPCSessionRequest.Size(m)
+}
+func (m *RevokeRPCSessionRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RevokeRPCSessionRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RevokeRPCSessionRequest proto.InternalMessageInfo
+
+func (m *RevokeRPCSessionRequest) GetId() string {
+	if m != nil {
+		return m.Id
+	}
+	return ""
+}
+
+type GetDataQualityRequest struct {
+	Exchange             string   `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	Check                bool     `protobuf:"varint,2,opt,name=check,proto3" json:"check,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetDataQualityRequest) Reset()         { *m = GetDataQualityRequest{} }
+func (m *GetDataQualityRequest) String() string { return proto.CompactTextString(m) }
+func (*GetDataQualityRequest) ProtoMessage()    {}
+func (*GetDataQualityRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{165}
+}
+
+func (m *GetDataQualityRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataQualityRequest.Unmarshal(m, b)
+}
+func (m *GetDataQualityRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataQualityRequest.Marshal(b, m, deterministic)
+}
+func (m *GetDataQualityRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataQualityRequest.Merge(m, src)
+}
+func (m *GetDataQualityRequest) XXX_Size() int {
+	return xxx_messageInfo_GetDataQualityRequest.Size(m)
+}
+func (m *GetDataQualityRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataQualityRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDataQualityRequest proto.InternalMessageInfo
+
+func (m *GetDataQualityRequest) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *GetDataQualityRequest) GetCheck() bool {
+	if m != nil {
+		return m.Check
+	}
+	return false
+}
+
+type CandleGap struct {
+	From                 string   `protobuf:"bytes,1,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string   `protobuf:"bytes,2,opt,name=to,proto3" json:"to,omitempty"`
+	Missing              int64    `protobuf:"varint,3,opt,name=missing,proto3" json:"missing,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CandleGap) Reset()         { *m = CandleGap{} }
+func (m *CandleGap) String() string { return proto.CompactTextString(m) }
+func (*CandleGap) ProtoMessage()    {}
+func (*CandleGap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{166}
+}
+
+func (m *CandleGap) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandleGap.Unmarshal(m, b)
+}
+func (m *CandleGap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandleGap.Marshal(b, m, deterministic)
+}
+func (m *CandleGap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandleGap.Merge(m, src)
+}
+func (m *CandleGap) XXX_Size() int {
+	return xxx_messageInfo_CandleGap.Size(m)
+}
+func (m *CandleGap) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandleGap.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandleGap proto.InternalMessageInfo
+
+func (m *CandleGap) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CandleGap) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CandleGap) GetMissing() int64 {
+	if m != nil {
+		return m.Missing
+	}
+	return 0
+}
+
+type CandleSeriesQuality struct {
+	Exchange             string       `protobuf:"bytes,1,opt,name=exchange,proto3" json:"exchange,omitempty"`
+	AssetType            string       `protobuf:"bytes,2,opt,name=asset_type,json=assetType,proto3" json:"asset_type,omitempty"`
+	Pair                 string       `protobuf:"bytes,3,opt,name=pair,proto3" json:"pair,omitempty"`
+	Interval             string       `protobuf:"bytes,4,opt,name=interval,proto3" json:"interval,omitempty"`
+	From                 string       `protobuf:"bytes,5,opt,name=from,proto3" json:"from,omitempty"`
+	To                   string       `protobuf:"bytes,6,opt,name=to,proto3" json:"to,omitempty"`
+	Expected             int64        `protobuf:"varint,7,opt,name=expected,proto3" json:"expected,omitempty"`
+	Stored               int64        `protobuf:"varint,8,opt,name=stored,proto3" json:"stored,omitempty"`
+	Backfilled           int64        `protobuf:"varint,9,opt,name=backfilled,proto3" json:"backfilled,omitempty"`
+	Gaps                 []*CandleGap `protobuf:"bytes,10,rep,name=gaps,proto3" json:"gaps,omitempty"`
+	Error                string       `protobuf:"bytes,11,opt,name=error,proto3" json:"error,omitempty"`
+	Checked              string       `protobuf:"bytes,12,opt,name=checked,proto3" json:"checked,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *CandleSeriesQuality) Reset()         { *m = CandleSeriesQuality{} }
+func (m *CandleSeriesQuality) String() string { return proto.CompactTextString(m) }
+func (*CandleSeriesQuality) ProtoMessage()    {}
+func (*CandleSeriesQuality) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{167}
+}
+
+func (m *CandleSeriesQuality) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CandleSeriesQuality.Unmarshal(m, b)
+}
+func (m *CandleSeriesQuality) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CandleSeriesQuality.Marshal(b, m, deterministic)
+}
+func (m *CandleSeriesQuality) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CandleSeriesQuality.Merge(m, src)
+}
+func (m *CandleSeriesQuality) XXX_Size() int {
+	return xxx_messageInfo_CandleSeriesQuality.Size(m)
+}
+func (m *CandleSeriesQuality) XXX_DiscardUnknown() {
+	xxx_messageInfo_CandleSeriesQuality.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CandleSeriesQuality proto.InternalMessageInfo
+
+func (m *CandleSeriesQuality) GetExchange() string {
+	if m != nil {
+		return m.Exchange
+	}
+	return ""
+}
+
+func (m *CandleSeriesQuality) GetAssetType() string {
+	if m != nil {
+		return m.AssetType
+	}
+	return ""
+}
+
+func (m *CandleSeriesQuality) GetPair() string {
+	if m != nil {
+		return m.Pair
+	}
+	return ""
+}
+
+func (m *CandleSeriesQuality) GetInterval() string {
+	if m != nil {
+		return m.Interval
+	}
+	return ""
+}
+
+func (m *CandleSeriesQuality) GetFrom() string {
+	if m != nil {
+		return m.From
+	}
+	return ""
+}
+
+func (m *CandleSeriesQuality) GetTo() string {
+	if m != nil {
+		return m.To
+	}
+	return ""
+}
+
+func (m *CandleSeriesQuality) GetExpected() int64 {
+	if m != nil {
+		return m.Expected
+	}
+	return 0
+}
+
+func (m *CandleSeriesQuality) GetStored() int64 {
+	if m != nil {
+		return m.Stored
+	}
+	return 0
+}
+
+func (m *CandleSeriesQuality) GetBackfilled() int64 {
+	if m != nil {
+		return m.Backfilled
+	}
+	return 0
+}
+
+func (m *CandleSeriesQuality) GetGaps() []*CandleGap {
+	if m != nil {
+		return m.Gaps
+	}
+	return nil
+}
+
+func (m *CandleSeriesQuality) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
+func (m *CandleSeriesQuality) GetChecked() string {
+	if m != nil {
+		return m.Checked
+	}
+	return ""
+}
+
+type GetDataQualityResponse struct {
+	Series               []*CandleSeriesQuality `protobuf:"bytes,1,rep,name=series,proto3" json:"series,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}               `json:"-"`
+	XXX_unrecognized     []byte                 `json:"-"`
+	XXX_sizecache        int32                  `json:"-"`
+}
+
+func (m *GetDataQualityResponse) Reset()         { *m = GetDataQualityResponse{} }
+func (m *GetDataQualityResponse) String() string { return proto.CompactTextString(m) }
+func (*GetDataQualityResponse) ProtoMessage()    {}
+func (*GetDataQualityResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{168}
+}
+
+func (m *GetDataQualityResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetDataQualityResponse.Unmarshal(m, b)
+}
+func (m *GetDataQualityResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetDataQualityResponse.Marshal(b, m, deterministic)
+}
+func (m *GetDataQualityResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetDataQualityResponse.Merge(m, src)
+}
+func (m *GetDataQualityResponse) XXX_Size() int {
+	return xxx_messageInfo_GetDataQualityResponse.Size(m)
+}
+func (m *GetDataQualityResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetDataQualityResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetDataQualityResponse proto.InternalMessageInfo
+
+func (m *GetDataQualityResponse) GetSeries() []*CandleSeriesQuality {
+	if m != nil {
+		return m.Series
+	}
+	return nil
+}
+
+type AuditEvent struct {
+	Type                 string   `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Identifier           string   `protobuf:"bytes,2,opt,name=identifier,proto3" json:"identifier,omitempty"`
+	Message              string   `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Timestamp            string   `protobuf:"bytes,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *AuditEvent) Reset()         { *m = AuditEvent{} }
+func (m *AuditEvent) String() string { return proto.CompactTextString(m) }
+func (*AuditEvent) ProtoMessage()    {}
+func (*AuditEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{169}
+}
+
+func (m *AuditEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AuditEvent.Unmarshal(m, b)
+}
+func (m *AuditEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AuditEvent.Marshal(b, m, deterministic)
+}
+func (m *AuditEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuditEvent.Merge(m, src)
+}
+func (m *AuditEvent) XXX_Size() int {
+	return xxx_messageInfo_AuditEvent.Size(m)
+}
+func (m *AuditEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuditEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AuditEvent proto.InternalMessageInfo
+
+func (m *AuditEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetIdentifier() string {
+	if m != nil {
+		return m.Identifier
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *AuditEvent) GetTimestamp() string {
+	if m != nil {
+		return m.Timestamp
+	}
+	return ""
+}
+
+type GCTScript struct {
+	UUID                 string   `protobuf:"bytes,1,opt,name=UUID,proto3" json:"UUID,omitempty"`
+	Name                 string   `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Path                 string   `protobuf:"bytes,3,opt,name=path,proto3" json:"path,omitempty"`
+	NextRun              string   `protobuf:"bytes,4,opt,name=next_run,json=nextRun,proto3" json:"next_run,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScript) Reset()         { *m = GCTScript{} }
+func (m *GCTScript) String() string { return proto.CompactTextString(m) }
+func (*GCTScript) ProtoMessage()    {}
+func (*GCTScript) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{170}
+}
+
+func (m *GCTScript) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScript.Unmarshal(m, b)
+}
+func (m *GCTScript) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScript.Marshal(b, m, deterministic)
+}
+func (m *GCTScript) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScript.Merge(m, src)
+}
+func (m *GCTScript) XXX_Size() int {
+	return xxx_messageInfo_GCTScript.Size(m)
+}
+func (m *GCTScript) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScript.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScript proto.InternalMessageInfo
+
+func (m *GCTScript) GetUUID() string {
+	if m != nil {
+		return m.UUID
+	}
+	return ""
+}
+
+func (m *GCTScript) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *GCTScript) GetPath() string {
+	if m != nil {
+		return m.Path
+	}
+	return ""
+}
+
+func (m *GCTScript) GetNextRun() string {
+	if m != nil {
+		return m.NextRun
+	}
+	return ""
+}
+
+type GCTScriptExecuteRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptExecuteRequest) Reset()         { *m = GCTScriptExecuteRequest{} }
+func (m *GCTScriptExecuteRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptExecuteRequest) ProtoMessage()    {}
+func (*GCTScriptExecuteRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{171}
+}
+
+func (m *GCTScriptExecuteRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptExecuteRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptExecuteRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptExecuteRequest.Merge(m, src)
+}
+func (m *GCTScriptExecuteRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptExecuteRequest.Size(m)
+}
+func (m *GCTScriptExecuteRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptExecuteRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptExecuteRequest proto.InternalMessageInfo
+
+func (m *GCTScriptExecuteRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptStopRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptStopRequest) Reset()         { *m = GCTScriptStopRequest{} }
+func (m *GCTScriptStopRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStopRequest) ProtoMessage()    {}
+func (*GCTScriptStopRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{172}
+}
+
+func (m *GCTScriptStopRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStopRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStopRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStopRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStopRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStopRequest.Merge(m, src)
+}
+func (m *GCTScriptStopRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStopRequest.Size(m)
+}
+func (m *GCTScriptStopRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStopRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStopRequest proto.InternalMessageInfo
+
+func (m *GCTScriptStopRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptStopAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptStopAllRequest) Reset()         { *m = GCTScriptStopAllRequest{} }
+func (m *GCTScriptStopAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStopAllRequest) ProtoMessage()    {}
+func (*GCTScriptStopAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{173}
+}
+
+func (m *GCTScriptStopAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStopAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStopAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStopAllRequest.Merge(m, src)
+}
+func (m *GCTScriptStopAllRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStopAllRequest.Size(m)
+}
+func (m *GCTScriptStopAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStopAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStopAllRequest proto.InternalMessageInfo
+
+type GCTScriptStatusRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptStatusRequest) Reset()         { *m = GCTScriptStatusRequest{} }
+func (m *GCTScriptStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStatusRequest) ProtoMessage()    {}
+func (*GCTScriptStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{174}
+}
+
+func (m *GCTScriptStatusRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStatusRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStatusRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStatusRequest.Merge(m, src)
+}
+func (m *GCTScriptStatusRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStatusRequest.Size(m)
+}
+func (m *GCTScriptStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStatusRequest proto.InternalMessageInfo
+
+type GCTScriptListAllRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptListAllRequest) Reset()         { *m = GCTScriptListAllRequest{} }
+func (m *GCTScriptListAllRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptListAllRequest) ProtoMessage()    {}
+func (*GCTScriptListAllRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{175}
+}
+
+func (m *GCTScriptListAllRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptListAllRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptListAllRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptListAllRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptListAllRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptListAllRequest.Merge(m, src)
+}
+func (m *GCTScriptListAllRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptListAllRequest.Size(m)
+}
+func (m *GCTScriptListAllRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptListAllRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptListAllRequest proto.InternalMessageInfo
+
+type GCTScriptUploadRequest struct {
+	ScriptName           string   `protobuf:"bytes,1,opt,name=script_name,json=scriptName,proto3" json:"script_name,omitempty"`
+	ScriptData           string   `protobuf:"bytes,2,opt,name=script_data,json=scriptData,proto3" json:"script_data,omitempty"`
+	Data                 []byte   `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	Archived             bool     `protobuf:"varint,4,opt,name=archived,proto3" json:"archived,omitempty"`
+	Overwrite            bool     `protobuf:"varint,5,opt,name=overwrite,proto3" json:"overwrite,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptUploadRequest) Reset()         { *m = GCTScriptUploadRequest{} }
+func (m *GCTScriptUploadRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptUploadRequest) ProtoMessage()    {}
+func (*GCTScriptUploadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{176}
+}
+
+func (m *GCTScriptUploadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptUploadRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptUploadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptUploadRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptUploadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptUploadRequest.Merge(m, src)
+}
+func (m *GCTScriptUploadRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptUploadRequest.Size(m)
+}
+func (m *GCTScriptUploadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptUploadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptUploadRequest proto.InternalMessageInfo
+
+func (m *GCTScriptUploadRequest) GetScriptName() string {
+	if m != nil {
+		return m.ScriptName
+	}
+	return ""
+}
+
+func (m *GCTScriptUploadRequest) GetScriptData() string {
+	if m != nil {
+		return m.ScriptData
+	}
+	return ""
+}
+
+func (m *GCTScriptUploadRequest) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
+func (m *GCTScriptUploadRequest) GetArchived() bool {
+	if m != nil {
+		return m.Archived
+	}
+	return false
+}
+
+func (m *GCTScriptUploadRequest) GetOverwrite() bool {
+	if m != nil {
+		return m.Overwrite
+	}
+	return false
+}
+
+type GCTScriptReadScriptRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptReadScriptRequest) Reset()         { *m = GCTScriptReadScriptRequest{} }
+func (m *GCTScriptReadScriptRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptReadScriptRequest) ProtoMessage()    {}
+func (*GCTScriptReadScriptRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{177}
+}
+
+func (m *GCTScriptReadScriptRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptReadScriptRequest.Merge(m, src)
+}
+func (m *GCTScriptReadScriptRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptReadScriptRequest.Size(m)
+}
+func (m *GCTScriptReadScriptRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptReadScriptRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptReadScriptRequest proto.InternalMessageInfo
+
+func (m *GCTScriptReadScriptRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptQueryRequest struct {
+	Script               *GCTScript `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptQueryRequest) Reset()         { *m = GCTScriptQueryRequest{} }
+func (m *GCTScriptQueryRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptQueryRequest) ProtoMessage()    {}
+func (*GCTScriptQueryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{178}
+}
+
+func (m *GCTScriptQueryRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptQueryRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptQueryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptQueryRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptQueryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptQueryRequest.Merge(m, src)
+}
+func (m *GCTScriptQueryRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptQueryRequest.Size(m)
+}
+func (m *GCTScriptQueryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptQueryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptQueryRequest proto.InternalMessageInfo
+
+func (m *GCTScriptQueryRequest) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+type GCTScriptAutoLoadRequest struct {
+	Script               string   `protobuf:"bytes,1,opt,name=script,proto3" json:"script,omitempty"`
+	Status               bool     `protobuf:"varint,2,opt,name=status,proto3" json:"status,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptAutoLoadRequest) Reset()         { *m = GCTScriptAutoLoadRequest{} }
+func (m *GCTScriptAutoLoadRequest) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptAutoLoadRequest) ProtoMessage()    {}
+func (*GCTScriptAutoLoadRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{179}
+}
+
+func (m *GCTScriptAutoLoadRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Unmarshal(m, b)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptAutoLoadRequest.Merge(m, src)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptAutoLoadRequest.Size(m)
+}
+func (m *GCTScriptAutoLoadRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptAutoLoadRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptAutoLoadRequest proto.InternalMessageInfo
+
+func (m *GCTScriptAutoLoadRequest) GetScript() string {
+	if m != nil {
+		return m.Script
+	}
+	return ""
+}
+
+func (m *GCTScriptAutoLoadRequest) GetStatus() bool {
+	if m != nil {
+		return m.Status
+	}
+	return false
+}
+
+type GCTScriptStatusResponse struct {
+	Status               string       `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Scripts              []*GCTScript `protobuf:"bytes,2,rep,name=scripts,proto3" json:"scripts,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}     `json:"-"`
+	XXX_unrecognized     []byte       `json:"-"`
+	XXX_sizecache        int32        `json:"-"`
+}
+
+func (m *GCTScriptStatusResponse) Reset()         { *m = GCTScriptStatusResponse{} }
+func (m *GCTScriptStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptStatusResponse) ProtoMessage()    {}
+func (*GCTScriptStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{180}
+}
+
+func (m *GCTScriptStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptStatusResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptStatusResponse.Merge(m, src)
+}
+func (m *GCTScriptStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptStatusResponse.Size(m)
+}
+func (m *GCTScriptStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptStatusResponse proto.InternalMessageInfo
+
+func (m *GCTScriptStatusResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptStatusResponse) GetScripts() []*GCTScript {
+	if m != nil {
+		return m.Scripts
+	}
+	return nil
+}
+
+type GCTScriptQueryResponse struct {
+	Status               string     `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Script               *GCTScript `protobuf:"bytes,2,opt,name=script,proto3" json:"script,omitempty"`
+	Data                 string     `protobuf:"bytes,3,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}   `json:"-"`
+	XXX_unrecognized     []byte     `json:"-"`
+	XXX_sizecache        int32      `json:"-"`
+}
+
+func (m *GCTScriptQueryResponse) Reset()         { *m = GCTScriptQueryResponse{} }
+func (m *GCTScriptQueryResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptQueryResponse) ProtoMessage()    {}
+func (*GCTScriptQueryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{181}
+}
+
+func (m *GCTScriptQueryResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptQueryResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptQueryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptQueryResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptQueryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptQueryResponse.Merge(m, src)
+}
+func (m *GCTScriptQueryResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptQueryResponse.Size(m)
+}
+func (m *GCTScriptQueryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptQueryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptQueryResponse proto.InternalMessageInfo
+
+func (m *GCTScriptQueryResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptQueryResponse) GetScript() *GCTScript {
+	if m != nil {
+		return m.Script
+	}
+	return nil
+}
+
+func (m *GCTScriptQueryResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+type GCTScriptGenericResponse struct {
+	Status               string   `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Data                 string   `protobuf:"bytes,2,opt,name=data,proto3" json:"data,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GCTScriptGenericResponse) Reset()         { *m = GCTScriptGenericResponse{} }
+func (m *GCTScriptGenericResponse) String() string { return proto.CompactTextString(m) }
+func (*GCTScriptGenericResponse) ProtoMessage()    {}
+func (*GCTScriptGenericResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_77a6da22d6a3feb1, []int{182}
+}
+
+func (m *GCTScriptGenericResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GCTScriptGenericResponse.Unmarshal(m, b)
+}
+func (m *GCTScriptGenericResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GCTScriptGenericResponse.Marshal(b, m, deterministic)
+}
+func (m *GCTScriptGenericResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GCTScriptGenericResponse.Merge(m, src)
+}
+func (m *GCTScriptGenericResponse) XXX_Size() int {
+	return xxx_messageInfo_GCTScriptGenericResponse.Size(m)
+}
+func (m *GCTScriptGenericResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GCTScriptGenericResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GCTScriptGenericResponse proto.InternalMessageInfo
+
+func (m *GCTScriptGenericResponse) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *GCTScriptGenericResponse) GetData() string {
+	if m != nil {
+		return m.Data
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*GetInfoRequest)(nil), "gctrpc.GetInfoRequest")
+	proto.RegisterType((*GetInfoResponse)(nil), "gctrpc.GetInfoResponse")
+	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetInfoResponse.RpcEndpointsEntry")
+	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetInfoResponse.SubsystemStatusEntry")
+	proto.RegisterType((*GetCommunicationRelayersRequest)(nil), "gctrpc.GetCommunicationRelayersRequest")
+	proto.RegisterType((*CommunicationRelayer)(nil), "gctrpc.CommunicationRelayer")
+	proto.RegisterType((*GetCommunicationRelayersResponse)(nil), "gctrpc.GetCommunicationRelayersResponse")
+	proto.RegisterMapType((map[string]*CommunicationRelayer)(nil), "gctrpc.GetCommunicationRelayersResponse.CommunicationRelayersEntry")
+	proto.RegisterType((*GenericSubsystemRequest)(nil), "gctrpc.GenericSubsystemRequest")
+	proto.RegisterType((*GenericSubsystemResponse)(nil), "gctrpc.GenericSubsystemResponse")
+	proto.RegisterType((*GetSubsystemsRequest)(nil), "gctrpc.GetSubsystemsRequest")
+	proto.RegisterType((*GetSusbsytemsResponse)(nil), "gctrpc.GetSusbsytemsResponse")
+	proto.RegisterMapType((map[string]bool)(nil), "gctrpc.GetSusbsytemsResponse.SubsystemsStatusEntry")
+	proto.RegisterType((*GetRPCEndpointsRequest)(nil), "gctrpc.GetRPCEndpointsRequest")
+	proto.RegisterType((*RPCEndpoint)(nil), "gctrpc.RPCEndpoint")
+	proto.RegisterType((*GetRPCEndpointsResponse)(nil), "gctrpc.GetRPCEndpointsResponse")
+	proto.RegisterMapType((map[string]*RPCEndpoint)(nil), "gctrpc.GetRPCEndpointsResponse.EndpointsEntry")
+	proto.RegisterType((*GenericExchangeNameRequest)(nil), "gctrpc.GenericExchangeNameRequest")
+	proto.RegisterType((*GenericExchangeNameResponse)(nil), "gctrpc.GenericExchangeNameResponse")
+	proto.RegisterType((*GetExchangesRequest)(nil), "gctrpc.GetExchangesRequest")
+	proto.RegisterType((*GetExchangesResponse)(nil), "gctrpc.GetExchangesResponse")
+	proto.RegisterType((*GetExchangeOTPReponse)(nil), "gctrpc.GetExchangeOTPReponse")
+	proto.RegisterType((*GetExchangeOTPsRequest)(nil), "gctrpc.GetExchangeOTPsRequest")
+	proto.RegisterType((*GetExchangeOTPsResponse)(nil), "gctrpc.GetExchangeOTPsResponse")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetExchangeOTPsResponse.OtpCodesEntry")
+	proto.RegisterType((*DisableExchangeRequest)(nil), "gctrpc.DisableExchangeRequest")
+	proto.RegisterType((*PairsSupported)(nil), "gctrpc.PairsSupported")
+	proto.RegisterType((*GetExchangeInfoResponse)(nil), "gctrpc.GetExchangeInfoResponse")
+	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangeInfoResponse.SupportedAssetsEntry")
+	proto.RegisterType((*GetTickerRequest)(nil), "gctrpc.GetTickerRequest")
+	proto.RegisterType((*CurrencyPair)(nil), "gctrpc.CurrencyPair")
+	proto.RegisterType((*TickerResponse)(nil), "gctrpc.TickerResponse")
+	proto.RegisterType((*GetTickersRequest)(nil), "gctrpc.GetTickersRequest")
+	proto.RegisterType((*Tickers)(nil), "gctrpc.Tickers")
+	proto.RegisterType((*GetTickersResponse)(nil), "gctrpc.GetTickersResponse")
+	proto.RegisterType((*GetOrderbookRequest)(nil), "gctrpc.GetOrderbookRequest")
+	proto.RegisterType((*OrderbookItem)(nil), "gctrpc.OrderbookItem")
+	proto.RegisterType((*OrderbookResponse)(nil), "gctrpc.OrderbookResponse")
+	proto.RegisterType((*GetOrderbooksRequest)(nil), "gctrpc.GetOrderbooksRequest")
+	proto.RegisterType((*Orderbooks)(nil), "gctrpc.Orderbooks")
+	proto.RegisterType((*GetOrderbooksResponse)(nil), "gctrpc.GetOrderbooksResponse")
+	proto.RegisterType((*GetAccountInfoRequest)(nil), "gctrpc.GetAccountInfoRequest")
+	proto.RegisterType((*Account)(nil), "gctrpc.Account")
+	proto.RegisterType((*AccountCurrencyInfo)(nil), "gctrpc.AccountCurrencyInfo")
+	proto.RegisterType((*GetAccountInfoResponse)(nil), "gctrpc.GetAccountInfoResponse")
+	proto.RegisterType((*GetConfigRequest)(nil), "gctrpc.GetConfigRequest")
+	proto.RegisterType((*GetConfigResponse)(nil), "gctrpc.GetConfigResponse")
+	proto.RegisterType((*PortfolioAddress)(nil), "gctrpc.PortfolioAddress")
+	proto.RegisterType((*GetPortfolioRequest)(nil), "gctrpc.GetPortfolioRequest")
+	proto.RegisterType((*GetPortfolioResponse)(nil), "gctrpc.GetPortfolioResponse")
+	proto.RegisterType((*GetPortfolioSummaryRequest)(nil), "gctrpc.GetPortfolioSummaryRequest")
+	proto.RegisterType((*Coin)(nil), "gctrpc.Coin")
+	proto.RegisterMapType((map[string]float64)(nil), "gctrpc.Coin.FiatValuesEntry")
+	proto.RegisterType((*OfflineCoinSummary)(nil), "gctrpc.OfflineCoinSummary")
+	proto.RegisterType((*OnlineCoinSummary)(nil), "gctrpc.OnlineCoinSummary")
+	proto.RegisterType((*OfflineCoins)(nil), "gctrpc.OfflineCoins")
+	proto.RegisterType((*OnlineCoins)(nil), "gctrpc.OnlineCoins")
+	proto.RegisterMapType((map[string]*OnlineCoinSummary)(nil), "gctrpc.OnlineCoins.CoinsEntry")
+	proto.RegisterType((*GetPortfolioSummaryResponse)(nil), "gctrpc.GetPortfolioSummaryResponse")
+	proto.RegisterMapType((map[string]*OfflineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOfflineSummaryEntry")
+	proto.RegisterMapType((map[string]*OnlineCoins)(nil), "gctrpc.GetPortfolioSummaryResponse.CoinsOnlineSummaryEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "gctrpc.GetPortfolioSummaryResponse.FiatTotalsEntry")
+	proto.RegisterType((*AddPortfolioAddressRequest)(nil), "gctrpc.AddPortfolioAddressRequest")
+	proto.RegisterType((*AddPortfolioAddressResponse)(nil), "gctrpc.AddPortfolioAddressResponse")
+	proto.RegisterType((*RemovePortfolioAddressRequest)(nil), "gctrpc.RemovePortfolioAddressRequest")
+	proto.RegisterType((*RemovePortfolioAddressResponse)(nil), "gctrpc.RemovePortfolioAddressResponse")
+	proto.RegisterType((*GetForexProvidersRequest)(nil), "gctrpc.GetForexProvidersRequest")
+	proto.RegisterType((*ForexProvider)(nil), "gctrpc.ForexProvider")
+	proto.RegisterType((*GetForexProvidersResponse)(nil), "gctrpc.GetForexProvidersResponse")
+	proto.RegisterType((*GetForexRatesRequest)(nil), "gctrpc.GetForexRatesRequest")
+	proto.RegisterType((*ForexRatesConversion)(nil), "gctrpc.ForexRatesConversion")
+	proto.RegisterType((*GetForexRatesResponse)(nil), "gctrpc.GetForexRatesResponse")
+	proto.RegisterType((*OrderDetails)(nil), "gctrpc.OrderDetails")
+	proto.RegisterType((*GetOrdersRequest)(nil), "gctrpc.GetOrdersRequest")
+	proto.RegisterType((*GetOrdersResponse)(nil), "gctrpc.GetOrdersResponse")
+	proto.RegisterType((*GetOrderRequest)(nil), "gctrpc.GetOrderRequest")
+	proto.RegisterType((*SubmitOrderRequest)(nil), "gctrpc.SubmitOrderRequest")
+	proto.RegisterType((*SubmitOrderResponse)(nil), "gctrpc.SubmitOrderResponse")
+	proto.RegisterType((*SimulateOrderRequest)(nil), "gctrpc.SimulateOrderRequest")
+	proto.RegisterType((*SimulateOrderResponse)(nil), "gctrpc.SimulateOrderResponse")
+	proto.RegisterType((*WhaleBombRequest)(nil), "gctrpc.WhaleBombRequest")
+	proto.RegisterType((*CancelOrderRequest)(nil), "gctrpc.CancelOrderRequest")
+	proto.RegisterType((*CancelOrderResponse)(nil), "gctrpc.CancelOrderResponse")
+	proto.RegisterType((*CancelAllOrdersRequest)(nil), "gctrpc.CancelAllOrdersRequest")
+	proto.RegisterType((*CancelAllOrdersResponse)(nil), "gctrpc.CancelAllOrdersResponse")
+	proto.RegisterType((*CancelAllOrdersResponse_Orders)(nil), "gctrpc.CancelAllOrdersResponse.Orders")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.CancelAllOrdersResponse.Orders.OrderStatusEntry")
+	proto.RegisterType((*GetEventsRequest)(nil), "gctrpc.GetEventsRequest")
+	proto.RegisterType((*ConditionParams)(nil), "gctrpc.ConditionParams")
+	proto.RegisterType((*GetEventsResponse)(nil), "gctrpc.GetEventsResponse")
+	proto.RegisterType((*AddEventRequest)(nil), "gctrpc.AddEventRequest")
+	proto.RegisterType((*AddEventResponse)(nil), "gctrpc.AddEventResponse")
+	proto.RegisterType((*RemoveEventRequest)(nil), "gctrpc.RemoveEventRequest")
+	proto.RegisterType((*RemoveEventResponse)(nil), "gctrpc.RemoveEventResponse")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressesRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressesRequest")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressesResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse")
+	proto.RegisterMapType((map[string]string)(nil), "gctrpc.GetCryptocurrencyDepositAddressesResponse.AddressesEntry")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressRequest)(nil), "gctrpc.GetCryptocurrencyDepositAddressRequest")
+	proto.RegisterType((*GetCryptocurrencyDepositAddressResponse)(nil), "gctrpc.GetCryptocurrencyDepositAddressResponse")
+	proto.RegisterType((*WithdrawCurrencyRequest)(nil), "gctrpc.WithdrawCurrencyRequest")
+	proto.RegisterType((*WithdrawResponse)(nil), "gctrpc.WithdrawResponse")
+	proto.RegisterType((*GetLoggerDetailsRequest)(nil), "gctrpc.GetLoggerDetailsRequest")
+	proto.RegisterType((*GetLoggerDetailsResponse)(nil), "gctrpc.GetLoggerDetailsResponse")
+	proto.RegisterType((*SetLoggerDetailsRequest)(nil), "gctrpc.SetLoggerDetailsRequest")
+	proto.RegisterType((*SetLogLevelRequest)(nil), "gctrpc.SetLogLevelRequest")
+	proto.RegisterType((*GetExchangePairsRequest)(nil), "gctrpc.GetExchangePairsRequest")
+	proto.RegisterType((*GetExchangePairsResponse)(nil), "gctrpc.GetExchangePairsResponse")
+	proto.RegisterMapType((map[string]*PairsSupported)(nil), "gctrpc.GetExchangePairsResponse.SupportedAssetsEntry")
+	proto.RegisterType((*ExchangePairRequest)(nil), "gctrpc.ExchangePairRequest")
+	proto.RegisterType((*GetOrderbookStreamRequest)(nil), "gctrpc.GetOrderbookStreamRequest")
+	proto.RegisterType((*GetExchangeOrderbookStreamRequest)(nil), "gctrpc.GetExchangeOrderbookStreamRequest")
+	proto.RegisterType((*GetTickerStreamRequest)(nil), "gctrpc.GetTickerStreamRequest")
+	proto.RegisterType((*GetExchangeTickerStreamRequest)(nil), "gctrpc.GetExchangeTickerStreamRequest")
+	proto.RegisterType((*GetAuditEventRequest)(nil), "gctrpc.GetAuditEventRequest")
+	proto.RegisterType((*GetAuditEventResponse)(nil), "gctrpc.GetAuditEventResponse")
+	proto.RegisterType((*GetHistoricCandlesRequest)(nil), "gctrpc.GetHistoricCandlesRequest")
+	proto.RegisterType((*GetHistoricCandlesResponse)(nil), "gctrpc.GetHistoricCandlesResponse")
+	proto.RegisterType((*Candle)(nil), "gctrpc.Candle")
+	proto.RegisterType((*GetExchangeHealthRequest)(nil), "gctrpc.GetExchangeHealthRequest")
+	proto.RegisterType((*GetExchangeHealthResponse)(nil), "gctrpc.GetExchangeHealthResponse")
+	proto.RegisterType((*ExchangeHealth)(nil), "gctrpc.ExchangeHealth")
+	proto.RegisterType((*EndpointHealth)(nil), "gctrpc.EndpointHealth")
+	proto.RegisterType((*WebsocketSubscription)(nil), "gctrpc.WebsocketSubscription")
+	proto.RegisterType((*GetWebsocketSubscriptionsResponse)(nil), "gctrpc.GetWebsocketSubscriptionsResponse")
+	proto.RegisterType((*WebsocketSubscriptionsRequest)(nil), "gctrpc.WebsocketSubscriptionsRequest")
+	proto.RegisterType((*GetKlineStreamRequest)(nil), "gctrpc.GetKlineStreamRequest")
+	proto.RegisterType((*KlineResponse)(nil), "gctrpc.KlineResponse")
+	proto.RegisterType((*GetFundingPaymentsRequest)(nil), "gctrpc.GetFundingPaymentsRequest")
+	proto.RegisterType((*FundingPayment)(nil), "gctrpc.FundingPayment")
+	proto.RegisterType((*GetFundingPaymentsResponse)(nil), "gctrpc.GetFundingPaymentsResponse")
+	proto.RegisterType((*GetTechnicalAnalysisRequest)(nil), "gctrpc.GetTechnicalAnalysisRequest")
+	proto.RegisterType((*IndicatorSeries)(nil), "gctrpc.IndicatorSeries")
+	proto.RegisterType((*GetTechnicalAnalysisResponse)(nil), "gctrpc.GetTechnicalAnalysisResponse")
+	proto.RegisterType((*StatementOpeningBalance)(nil), "gctrpc.StatementOpeningBalance")
+	proto.RegisterType((*GetAccountStatementRequest)(nil), "gctrpc.GetAccountStatementRequest")
+	proto.RegisterType((*StatementEntry)(nil), "gctrpc.StatementEntry")
+	proto.RegisterType((*StatementBalance)(nil), "gctrpc.StatementBalance")
+	proto.RegisterMapType((map[string]float64)(nil), "gctrpc.StatementBalance.ClosingFiatEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "gctrpc.StatementBalance.PnlFiatEntry")
+	proto.RegisterType((*GetAccountStatementResponse)(nil), "gctrpc.GetAccountStatementResponse")
+	proto.RegisterMapType((map[string]float64)(nil), "gctrpc.GetAccountStatementResponse.ClosingFiatTotalsEntry")
+	proto.RegisterMapType((map[string]float64)(nil), "gctrpc.GetAccountStatementResponse.PnlFiatTotalsEntry")
+	proto.RegisterType((*ValidateCredentialsResponse)(nil), "gctrpc.ValidateCredentialsResponse")
+	proto.RegisterType((*GetExchangeLatencyRequest)(nil), "gctrpc.GetExchangeLatencyRequest")
+	proto.RegisterType((*ExchangeLatencySummary)(nil), "gctrpc.ExchangeLatencySummary")
+	proto.RegisterType((*GetExchangeLatencyResponse)(nil), "gctrpc.GetExchangeLatencyResponse")
+	proto.RegisterType((*SubmitTransferRequest)(nil), "gctrpc.SubmitTransferRequest")
+	proto.RegisterType((*TransferDetails)(nil), "gctrpc.TransferDetails")
+	proto.RegisterType((*GetTransfersRequest)(nil), "gctrpc.GetTransfersRequest")
+	proto.RegisterType((*GetTransfersResponse)(nil), "gctrpc.GetTransfersResponse")
+	proto.RegisterType((*ApproveTransferRequest)(nil), "gctrpc.ApproveTransferRequest")
+	proto.RegisterType((*PriceAlert)(nil), "gctrpc.PriceAlert")
+	proto.RegisterType((*AddPriceAlertRequest)(nil), "gctrpc.AddPriceAlertRequest")
+	proto.RegisterType((*GetPriceAlertsRequest)(nil), "gctrpc.GetPriceAlertsRequest")
+	proto.RegisterType((*GetPriceAlertsResponse)(nil), "gctrpc.GetPriceAlertsResponse")
+	proto.RegisterType((*RemovePriceAlertRequest)(nil), "gctrpc.RemovePriceAlertRequest")
+	proto.RegisterType((*RemovePriceAlertResponse)(nil), "gctrpc.RemovePriceAlertResponse")
+	proto.RegisterType((*TrailingStopStatus)(nil), "gctrpc.TrailingStopStatus")
+	proto.RegisterMapType((map[string]float64)(nil), "gctrpc.TrailingStopStatus.ExchangesEntry")
+	proto.RegisterType((*GetTrailingStopRequest)(nil), "gctrpc.GetTrailingStopRequest")
+	proto.RegisterType((*ResetTrailingStopRequest)(nil), "gctrpc.ResetTrailingStopRequest")
+	proto.RegisterType((*MarginPosition)(nil), "gctrpc.MarginPosition")
+	proto.RegisterType((*GetMarginPositionsRequest)(nil), "gctrpc.GetMarginPositionsRequest")
+	proto.RegisterType((*GetMarginPositionsResponse)(nil), "gctrpc.GetMarginPositionsResponse")
+	proto.RegisterType((*GetArbitrageOpportunitiesRequest)(nil), "gctrpc.GetArbitrageOpportunitiesRequest")
+	proto.RegisterType((*ArbitrageLeg)(nil), "gctrpc.ArbitrageLeg")
+	proto.RegisterType((*ArbitrageOpportunity)(nil), "gctrpc.ArbitrageOpportunity")
+	proto.RegisterType((*GetArbitrageOpportunitiesResponse)(nil), "gctrpc.GetArbitrageOpportunitiesResponse")
+	proto.RegisterType((*GetLiquidationStreamRequest)(nil), "gctrpc.GetLiquidationStreamRequest")
+	proto.RegisterType((*LiquidationResponse)(nil), "gctrpc.LiquidationResponse")
+	proto.RegisterType((*GetOpenInterestRequest)(nil), "gctrpc.GetOpenInterestRequest")
+	proto.RegisterType((*OpenInterest)(nil), "gctrpc.OpenInterest")
+	proto.RegisterType((*GetOpenInterestResponse)(nil), "gctrpc.GetOpenInterestResponse")
+	proto.RegisterType((*GetStrategyLedgersRequest)(nil), "gctrpc.GetStrategyLedgersRequest")
+	proto.RegisterType((*StrategyBalance)(nil), "gctrpc.StrategyBalance")
+	proto.RegisterType((*StrategyLedger)(nil), "gctrpc.StrategyLedger")
+	proto.RegisterType((*GetStrategyLedgersResponse)(nil), "gctrpc.GetStrategyLedgersResponse")
+	proto.RegisterType((*GetExposuresRequest)(nil), "gctrpc.GetExposuresRequest")
+	proto.RegisterType((*Exposure)(nil), "gctrpc.Exposure")
+	proto.RegisterType((*GetExposuresResponse)(nil), "gctrpc.GetExposuresResponse")
+	proto.RegisterType((*ModifyOrderRequest)(nil), "gctrpc.ModifyOrderRequest")
+	proto.RegisterType((*ModifyOrderResponse)(nil), "gctrpc.ModifyOrderResponse")
+	proto.RegisterType((*RPCSession)(nil), "gctrpc.RPCSession")
+	proto.RegisterType((*GetRPCSessionsRequest)(nil), "gctrpc.GetRPCSessionsRequest")
+	proto.RegisterType((*GetRPCSessionsResponse)(nil), "gctrpc.GetRPCSessionsResponse")
+	proto.RegisterType((*RevokeRPCSessionRequest)(nil), "gctrpc.RevokeRPCSessionRequest")
+	proto.RegisterType((*GetDataQualityRequest)(nil), "gctrpc.GetDataQualityRequest")
+	proto.RegisterType((*CandleGap)(nil), "gctrpc.CandleGap")
+	proto.RegisterType((*CandleSeriesQuality)(nil), "gctrpc.CandleSeriesQuality")
+	proto.RegisterType((*GetDataQualityResponse)(nil), "gctrpc.GetDataQualityResponse")
+	proto.RegisterType((*AuditEvent)(nil), "gctrpc.AuditEvent")
+	proto.RegisterType((*GCTScript)(nil), "gctrpc.GCTScript")
+	proto.RegisterType((*GCTScriptExecuteRequest)(nil), "gctrpc.GCTScriptExecuteRequest")
+	proto.RegisterType((*GCTScriptStopRequest)(nil), "gctrpc.GCTScriptStopRequest")
+	proto.RegisterType((*GCTScriptStopAllRequest)(nil), "gctrpc.GCTScriptStopAllRequest")
+	proto.RegisterType((*GCTScriptStatusRequest)(nil), "gctrpc.GCTScriptStatusRequest")
+	proto.RegisterType((*GCTScriptListAllRequest)(nil), "gctrpc.GCTScriptListAllRequest")
+	proto.RegisterType((*GCTScriptUploadRequest)(nil), "gctrpc.GCTScriptUploadRequest")
+	proto.RegisterType((*GCTScriptReadScriptRequest)(nil), "gctrpc.GCTScriptReadScriptRequest")
+	proto.RegisterType((*GCTScriptQueryRequest)(nil), "gctrpc.GCTScriptQueryRequest")
+	proto.RegisterType((*GCTScriptAutoLoadRequest)(nil), "gctrpc.GCTScriptAutoLoadRequest")
+	proto.RegisterType((*GCTScriptStatusResponse)(nil), "gctrpc.GCTScriptStatusResponse")
+	proto.RegisterType((*GCTScriptQueryResponse)(nil), "gctrpc.GCTScriptQueryResponse")
+	proto.RegisterType((*GCTScriptGenericResponse)(nil), "gctrpc.GCTScriptGenericResponse")
+}
+
+func init() { proto.RegisterFile("rpc.proto", fileDescriptor_77a6da22d6a3feb1) }
+
+var fileDescriptor_77a6da22d6a3feb1 = []byte{
+	// 9263 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x7d, 0x59, 0x8c, 0x24, 0x49,
+	0x96, 0x90, 0x22, 0x32, 0x32, 0x33, 0xe2, 0xe5, 0xed, 0x79, 0x45, 0x79, 0xdd, 0xde, 0xd3, 0x47,
+	0x75, 0xf7, 0x54, 0xf5, 0x35, 0x47, 0xcf, 0xf4, 0xec, 0x4e, 0x56, 0x56, 0x75, 0x75, 0xcd, 0x54,
+	0x4d, 0xe5, 0x78, 0x56, 0x77, 0x4b, 0x3d, 0xa8, 0x03, 0xcf, 0x70, 0xcb, 0x48, 0x9f, 0xf2, 0x70,
+	0x8f, 0x76, 0xf7, 0xc8, 0xac, 0xec, 0xd9, 0xd5, 0xae, 0x06, 0x16, 0xf1, 0x81, 0x40, 0x68, 0x85,
+	0x76, 0x11, 0xb0, 0x1c, 0xd2, 0x0a, 0x04, 0xe2, 0x03, 0x04, 0x42, 0xf0, 0xb1, 0x20, 0xfe, 0xf8,
+	0x62, 0xc5, 0x21, 0x2d, 0xfc, 0x82, 0xf6, 0x03, 0x71, 0x08, 0xb4, 0x08, 0x89, 0x2f, 0xf4, 0x9e,
+	0x1d, 0x6e, 0xe6, 0x47, 0x64, 0x64, 0x75, 0x77, 0xed, 0xf0, 0x15, 0x6e, 0xcf, 0x8e, 0xf7, 0xcc,
+	0xec, 0xd9, 0xb3, 0x67, 0xcf, 0x9e, 0xbd, 0x80, 0x4e, 0x32, 0xea, 0xdf, 0x1c, 0x25, 0x71, 0x16,
+	0x5b, 0x73, 0x83, 0x7e, 0x96, 0x8c, 0xfa, 0xf6, 0xa5, 0x41, 0x1c, 0x0f, 0x42, 0x76, 0xcb, 0x1b,
+	0x05, 0xb7, 0xbc, 0x28, 0x8a, 0x33, 0x2f, 0x0b, 0xe2, 0x28, 0xe5, 0xa5, 0x9c, 0x55, 0x58, 0xbe,
+	0xc7, 0xb2, 0xfb, 0xd1, 0x61, 0xec, 0xb2, 0xcf, 0xc6, 0x2c, 0xcd, 0x9c, 0x7f, 0xd4, 0x82, 0x15,
+	0x05, 0x4a, 0x47, 0x71, 0x94, 0x32, 0x6b, 0x0b, 0xe6, 0xc6, 0xa3, 0x2c, 0x18, 0xb2, 0x6e, 0xe3,
+	0x5a, 0xe3, 0x95, 0x8e, 0x2b, 0x52, 0xd6, 0x2d, 0x58, 0xf7, 0x8e, 0xbd, 0x20, 0xf4, 0x0e, 0x42,
+	0xd6, 0x63, 0x4f, 0xfb, 0x47, 0x5e, 0x34, 0x60, 0x69, 0xb7, 0x79, 0xad, 0xf1, 0xca, 0x8c, 0x6b,
+	0xa9, 0xac, 0xbb, 0x32, 0xc7, 0x7a, 0x0d, 0xd6, 0x58, 0x84, 0x20, 0x5f, 0x2b, 0x3e, 0x43, 0xc5,
+	0x57, 0x45, 0x46, 0x5e, 0xf8, 0x1d, 0xd8, 0xf2, 0xd9, 0xa1, 0x37, 0x0e, 0xb3, 0xde, 0x61, 0x9c,
+	0xb0, 0xa7, 0xbd, 0x51, 0x12, 0x1f, 0x07, 0x3e, 0x4b, 0xba, 0x2d, 0xa2, 0x62, 0x43, 0xe4, 0xbe,
+	0x8f, 0x99, 0x7b, 0x22, 0xcf, 0x7a, 0x0b, 0x36, 0x55, 0xad, 0xc0, 0xcb, 0x7a, 0xfd, 0x71, 0x92,
+	0xb0, 0xa8, 0x7f, 0xda, 0x9d, 0xa5, 0x4a, 0xeb, 0xb2, 0x52, 0xe0, 0x65, 0xbb, 0x22, 0xcb, 0xfa,
+	0x18, 0x56, 0xd3, 0xf1, 0x41, 0x7a, 0x9a, 0x66, 0x6c, 0xd8, 0x4b, 0x33, 0x2f, 0x1b, 0xa7, 0xdd,
+	0xb9, 0x6b, 0x33, 0xaf, 0x2c, 0xbc, 0xf5, 0xfa, 0x4d, 0x3e, 0x8c, 0x37, 0x0b, 0x43, 0x72, 0x73,
+	0x5f, 0x96, 0xdf, 0xa7, 0xe2, 0x77, 0xa3, 0x2c, 0x39, 0x75, 0x57, 0x52, 0x13, 0x6a, 0xfd, 0x08,
+	0x96, 0x92, 0x51, 0xbf, 0xc7, 0x22, 0x7f, 0x14, 0x07, 0x51, 0x96, 0x76, 0xe7, 0xa9, 0xd5, 0x1b,
+	0x75, 0xad, 0xba, 0xa3, 0xfe, 0x5d, 0x59, 0x96, 0x37, 0xb9, 0x98, 0x68, 0x20, 0xfb, 0x36, 0x6c,
+	0x54, 0x21, 0xb6, 0x56, 0x61, 0xe6, 0x09, 0x3b, 0x15, 0xb3, 0x83, 0x9f, 0xd6, 0x06, 0xcc, 0x1e,
+	0x7b, 0xe1, 0x98, 0xd1, 0x64, 0xb4, 0x5d, 0x9e, 0xf8, 0x4e, 0xf3, 0xdb, 0x0d, 0xfb, 0x31, 0xac,
+	0x95, 0xd0, 0x54, 0x34, 0x70, 0x43, 0x6f, 0x60, 0xe1, 0xad, 0x75, 0x49, 0xb2, 0xbb, 0xb7, 0x2b,
+	0xeb, 0x6a, 0xad, 0x3a, 0xd7, 0xe1, 0xea, 0x3d, 0x96, 0xed, 0xc6, 0xc3, 0xe1, 0x38, 0x0a, 0xfa,
+	0xc4, 0x63, 0x2e, 0x0b, 0xbd, 0x53, 0x96, 0xa4, 0x92, 0xb3, 0x7e, 0x04, 0x1b, 0x55, 0xf9, 0x56,
+	0x17, 0xe6, 0xc5, 0xdc, 0x13, 0xfe, 0xb6, 0x2b, 0x93, 0xd6, 0x25, 0xe8, 0xf4, 0xe3, 0x28, 0x62,
+	0xfd, 0x8c, 0xf9, 0xa2, 0x23, 0x39, 0xc0, 0xf9, 0x33, 0x4d, 0xb8, 0x56, 0x8f, 0x53, 0xb0, 0xee,
+	0xe7, 0xb0, 0xd5, 0xd7, 0x0b, 0xf4, 0x12, 0x51, 0xa2, 0xdb, 0xa0, 0xa9, 0xd8, 0xd5, 0xa6, 0x62,
+	0x62, 0x4b, 0x37, 0x2b, 0x73, 0xf9, 0x24, 0x6d, 0xf6, 0xab, 0xf2, 0xec, 0x43, 0xb0, 0xeb, 0x2b,
+	0x55, 0x0c, 0xf9, 0x5b, 0xe6, 0x90, 0x5f, 0x92, 0xa4, 0x55, 0x35, 0xa2, 0x8f, 0xfd, 0xb7, 0x60,
+	0xfb, 0x1e, 0x8b, 0x58, 0x12, 0xf4, 0x15, 0x73, 0x88, 0x31, 0xc7, 0x11, 0x54, 0x3c, 0x29, 0x50,
+	0xe5, 0x00, 0xc7, 0x86, 0x6e, 0xb9, 0x22, 0xef, 0xae, 0xb3, 0x05, 0x1b, 0xf7, 0x58, 0xa6, 0xe0,
+	0x6a, 0x16, 0x7f, 0xaf, 0x01, 0x9b, 0x94, 0x91, 0x1e, 0xa4, 0xa7, 0x3c, 0x43, 0x0c, 0xf5, 0x9f,
+	0x84, 0x35, 0xd5, 0x74, 0x2a, 0x97, 0x11, 0x1f, 0xe5, 0xb7, 0xb5, 0x51, 0x2e, 0xd7, 0xcc, 0x17,
+	0x53, 0xaa, 0xaf, 0xa6, 0xd5, 0xb4, 0x00, 0xb6, 0x77, 0x61, 0xb3, 0xb2, 0xe8, 0x79, 0xf8, 0xdf,
+	0xe9, 0xc2, 0xd6, 0x3d, 0x96, 0x69, 0x6c, 0xac, 0x31, 0xe8, 0x82, 0x06, 0x46, 0xbe, 0x4c, 0x33,
+	0x2f, 0xc9, 0x72, 0xbe, 0x14, 0x49, 0xeb, 0x45, 0x58, 0x0e, 0x83, 0x34, 0x63, 0x51, 0xcf, 0xf3,
+	0xfd, 0x84, 0xa5, 0x5c, 0xe4, 0x75, 0xdc, 0x25, 0x0e, 0xdd, 0xe1, 0x40, 0xe7, 0x9f, 0x35, 0x60,
+	0xbb, 0x84, 0x4a, 0x0c, 0xd6, 0x03, 0xe8, 0xe4, 0x52, 0x81, 0x0f, 0xd2, 0x4d, 0x6d, 0x90, 0xaa,
+	0xea, 0xdc, 0x2c, 0x88, 0x86, 0xbc, 0x01, 0xfb, 0xc7, 0xb0, 0xfc, 0x65, 0x2f, 0xe8, 0x6f, 0x83,
+	0x2d, 0x78, 0x43, 0x4a, 0xe4, 0x1f, 0x79, 0x43, 0x26, 0xf9, 0xca, 0x86, 0xb6, 0x14, 0xe0, 0x02,
+	0x87, 0x4a, 0x3b, 0x97, 0xe1, 0x62, 0x65, 0x4d, 0xc1, 0x58, 0xb7, 0x60, 0xfd, 0x1e, 0xcb, 0x64,
+	0x96, 0x1c, 0xfc, 0x7a, 0x29, 0xe0, 0xbc, 0x03, 0x1b, 0x66, 0x05, 0x31, 0x84, 0x97, 0xa0, 0x93,
+	0x6f, 0x22, 0x82, 0xb7, 0x15, 0xc0, 0x79, 0x0b, 0x36, 0xb5, 0x5a, 0x8f, 0x1e, 0xef, 0xb9, 0x8c,
+	0x57, 0xbb, 0x00, 0xed, 0x38, 0x1b, 0xf5, 0xfa, 0xb1, 0x2f, 0x49, 0x9f, 0x8f, 0xb3, 0xd1, 0x6e,
+	0xec, 0x33, 0xc1, 0x1a, 0x5a, 0x1d, 0xc5, 0x1a, 0x7f, 0x8b, 0x4f, 0xa5, 0x99, 0x25, 0xe8, 0xf8,
+	0x01, 0x74, 0x64, 0x83, 0x72, 0x2a, 0xbf, 0xae, 0x4d, 0x65, 0x55, 0x9d, 0x9b, 0x8f, 0x38, 0x46,
+	0x31, 0x93, 0x6d, 0x41, 0x40, 0x6a, 0x7f, 0x17, 0x96, 0x8c, 0xac, 0xb3, 0x38, 0xbb, 0xa3, 0x4f,
+	0xd9, 0x3b, 0xb0, 0x75, 0x27, 0x48, 0xf5, 0x1d, 0x77, 0x9a, 0xe9, 0xfa, 0x14, 0x96, 0xf7, 0xbc,
+	0x20, 0x49, 0xf7, 0xc7, 0xa3, 0x51, 0x4c, 0xec, 0xfd, 0x32, 0xac, 0xe4, 0xdb, 0xfa, 0x08, 0xf3,
+	0x44, 0xa5, 0x65, 0x05, 0xa6, 0x1a, 0xd6, 0x0b, 0xb0, 0x24, 0xb7, 0x73, 0x5e, 0x8c, 0x93, 0xb4,
+	0x28, 0x80, 0x54, 0xc8, 0xf9, 0x79, 0xcb, 0x18, 0x3a, 0x43, 0xb1, 0xb0, 0xa0, 0x15, 0x79, 0x4a,
+	0xad, 0xa0, 0x6f, 0x9d, 0x11, 0x9a, 0xe6, 0x76, 0xd0, 0x85, 0xf9, 0x63, 0x96, 0x1c, 0xc4, 0x29,
+	0x23, 0x9d, 0xa1, 0xed, 0xca, 0x24, 0x12, 0x32, 0x4e, 0x83, 0x68, 0xd0, 0x4b, 0xbd, 0xc8, 0x3f,
+	0x88, 0x9f, 0x92, 0x86, 0xd0, 0x76, 0x17, 0x09, 0xb8, 0xcf, 0x61, 0xd6, 0x75, 0x58, 0x3c, 0xca,
+	0xb2, 0x51, 0x0f, 0x55, 0x97, 0x78, 0x9c, 0x09, 0x85, 0x60, 0x01, 0x61, 0x8f, 0x39, 0x08, 0x17,
+	0x36, 0x15, 0x19, 0xa7, 0x2c, 0xf1, 0x06, 0x2c, 0xca, 0xba, 0x73, 0x7c, 0x61, 0x23, 0xf4, 0x43,
+	0x09, 0xb4, 0x2e, 0x03, 0x50, 0xb1, 0x51, 0x12, 0x3f, 0x3d, 0xed, 0xce, 0x73, 0xd6, 0x43, 0xc8,
+	0x1e, 0x02, 0x70, 0xfc, 0x0e, 0xbc, 0x94, 0x49, 0xd5, 0x23, 0x60, 0x69, 0xb7, 0xcd, 0xc7, 0x0f,
+	0xc1, 0xbb, 0x0a, 0x6a, 0xf5, 0x50, 0xef, 0x10, 0xa3, 0xde, 0xf3, 0xd2, 0x94, 0x65, 0x69, 0xb7,
+	0x43, 0x0c, 0xf4, 0x4e, 0x05, 0x03, 0x15, 0xf4, 0x0f, 0x51, 0x6f, 0x87, 0xaa, 0x29, 0xfd, 0xc3,
+	0x80, 0xa2, 0xbe, 0xe5, 0x8d, 0xb3, 0x23, 0x16, 0x65, 0xb8, 0x7b, 0x20, 0x92, 0x51, 0xd0, 0x05,
+	0x1a, 0x9b, 0x55, 0x23, 0x63, 0x67, 0x14, 0xd8, 0x9f, 0xa0, 0x72, 0x51, 0x6e, 0xb5, 0x82, 0x05,
+	0x5f, 0x37, 0x45, 0xc9, 0x96, 0x24, 0xd6, 0xe4, 0x23, 0x9d, 0x35, 0x4f, 0x60, 0xf5, 0x1e, 0xcb,
+	0x1e, 0x07, 0xfd, 0x27, 0x2c, 0x99, 0x82, 0x29, 0xad, 0x57, 0xa0, 0x85, 0x1c, 0x25, 0x10, 0x6c,
+	0xa8, 0x9d, 0x50, 0x68, 0x6c, 0x88, 0xc8, 0xa5, 0x12, 0x38, 0x17, 0x34, 0x72, 0xbd, 0xec, 0x74,
+	0xc4, 0xf9, 0xa2, 0xe3, 0x76, 0x08, 0xf2, 0xf8, 0x74, 0xc4, 0x9c, 0x8f, 0x60, 0x51, 0xaf, 0x84,
+	0x42, 0xc3, 0x67, 0x61, 0x30, 0x0c, 0x32, 0x96, 0x48, 0xa1, 0xa1, 0x00, 0xc8, 0x8f, 0x38, 0x45,
+	0x82, 0x8f, 0xe9, 0x1b, 0xd7, 0xdb, 0x67, 0xe3, 0x38, 0x93, 0x6d, 0xf3, 0x84, 0xf3, 0x97, 0x9a,
+	0xb0, 0x2c, 0xbb, 0x23, 0x98, 0x59, 0xd2, 0xdc, 0x38, 0x93, 0xe6, 0xeb, 0xb0, 0x18, 0x7a, 0x69,
+	0xd6, 0x1b, 0x8f, 0x7c, 0x4f, 0xaa, 0x36, 0x33, 0xee, 0x02, 0xc2, 0x3e, 0xe4, 0x20, 0xe4, 0x68,
+	0xa9, 0xb9, 0xd2, 0xda, 0x12, 0xd8, 0x17, 0xfb, 0x7a, 0x67, 0x2c, 0x68, 0x61, 0x1d, 0xe2, 0xf6,
+	0x86, 0x4b, 0xdf, 0x08, 0x3b, 0x0a, 0x06, 0x47, 0xc4, 0xdd, 0x0d, 0x97, 0xbe, 0x71, 0x06, 0xc3,
+	0xf8, 0x84, 0x78, 0xb9, 0xe1, 0xe2, 0x27, 0x42, 0x0e, 0x02, 0x9f, 0x58, 0xb7, 0xe1, 0xe2, 0x27,
+	0x42, 0xbc, 0xf4, 0x09, 0x31, 0x6a, 0xc3, 0xc5, 0x4f, 0xd4, 0xfa, 0x8f, 0xe3, 0x70, 0x3c, 0x64,
+	0xdd, 0x0e, 0x01, 0x45, 0xca, 0xba, 0x08, 0x9d, 0x51, 0x12, 0xf4, 0x59, 0xcf, 0xcb, 0x8e, 0x88,
+	0x99, 0x1a, 0x6e, 0x9b, 0x00, 0x3b, 0xd9, 0x91, 0xb3, 0x0e, 0x6b, 0x6a, 0xa2, 0x95, 0xf4, 0xfc,
+	0x18, 0xe6, 0x05, 0x64, 0xe2, 0xa4, 0xbf, 0x01, 0xf3, 0x19, 0x2f, 0xd6, 0x6d, 0x5e, 0x9b, 0xd1,
+	0x19, 0xcb, 0x1c, 0x69, 0x57, 0x16, 0x73, 0x7e, 0x19, 0x2c, 0x1d, 0x9b, 0x98, 0x88, 0x1b, 0x79,
+	0x3b, 0x5c, 0x1c, 0xaf, 0x98, 0xed, 0xa4, 0x79, 0x03, 0x9f, 0xd3, 0x66, 0xf4, 0x28, 0xf1, 0x51,
+	0x90, 0xc4, 0x4f, 0x9e, 0x2b, 0x6b, 0x3e, 0x84, 0x25, 0x85, 0xf8, 0x7e, 0xc6, 0x86, 0x38, 0xe0,
+	0xde, 0x30, 0x1e, 0x47, 0x19, 0xe1, 0x6c, 0xb8, 0x22, 0x85, 0x1c, 0x48, 0xe3, 0x4b, 0x28, 0x1b,
+	0x2e, 0x4f, 0x58, 0xcb, 0xd0, 0x0c, 0x7c, 0x71, 0x78, 0x6a, 0x06, 0xbe, 0xf3, 0x7f, 0x1b, 0xb0,
+	0xa6, 0x75, 0xe4, 0xdc, 0x4c, 0x59, 0xe2, 0xb8, 0x66, 0x05, 0xc7, 0xdd, 0x80, 0xd6, 0x41, 0xe0,
+	0xe3, 0x99, 0x0d, 0xc7, 0x75, 0x53, 0x36, 0x67, 0xf4, 0xc3, 0xa5, 0x22, 0x58, 0xd4, 0x4b, 0x9f,
+	0xa4, 0xdd, 0xd6, 0xc4, 0xa2, 0x58, 0xa4, 0xb4, 0x1e, 0x66, 0xcb, 0xeb, 0xc1, 0x1c, 0xcb, 0xb9,
+	0xe2, 0x58, 0x72, 0x6d, 0x55, 0xb5, 0xad, 0x38, 0xaf, 0x0f, 0x90, 0x03, 0x27, 0x4e, 0xeb, 0xbb,
+	0x00, 0xb1, 0x2a, 0x29, 0xf8, 0xef, 0x42, 0x89, 0x68, 0xc5, 0x82, 0x5a, 0x61, 0xe7, 0x87, 0xa4,
+	0x6a, 0xe8, 0xc8, 0xc5, 0xe0, 0xbf, 0x65, 0xb4, 0xc9, 0x79, 0xd1, 0x2a, 0xb5, 0x99, 0x1a, 0x8d,
+	0xbd, 0x4d, 0x8d, 0xed, 0xf4, 0xfb, 0x38, 0xf5, 0xda, 0xc1, 0x7c, 0xe2, 0x1e, 0xfe, 0x11, 0xcc,
+	0x8b, 0x1a, 0x82, 0x2d, 0x78, 0x81, 0x66, 0xe0, 0x5b, 0xdf, 0x05, 0xd0, 0xf6, 0x21, 0xde, 0xaf,
+	0x8b, 0x92, 0x06, 0x51, 0x49, 0x72, 0x03, 0xa1, 0xd3, 0x8a, 0x3b, 0x87, 0xb0, 0x5e, 0x51, 0x04,
+	0x49, 0x51, 0xc7, 0x6a, 0x41, 0x8a, 0x4c, 0x5b, 0x57, 0x61, 0x21, 0x8b, 0x33, 0x2f, 0xec, 0xe5,
+	0x3b, 0x44, 0xc3, 0x05, 0x02, 0x7d, 0x84, 0x10, 0x12, 0x50, 0x71, 0xc8, 0x39, 0x17, 0x05, 0x54,
+	0x1c, 0xfa, 0x8e, 0x47, 0x8a, 0x97, 0xd1, 0x69, 0x31, 0x84, 0x93, 0xa6, 0xec, 0x35, 0x68, 0x7b,
+	0xbc, 0x8a, 0xec, 0xd8, 0x4a, 0xa1, 0x63, 0xae, 0x2a, 0xe0, 0x58, 0xb4, 0x03, 0xed, 0xc6, 0xd1,
+	0x61, 0x30, 0x90, 0xdc, 0xf1, 0x32, 0xac, 0x69, 0xb0, 0x5c, 0x27, 0xf1, 0xbd, 0xcc, 0x23, 0x6c,
+	0x8b, 0x2e, 0x7d, 0x3b, 0xbf, 0xd1, 0x80, 0xd5, 0xbd, 0x38, 0xc9, 0x0e, 0xe3, 0x30, 0x88, 0x85,
+	0x7a, 0x8f, 0xea, 0x88, 0x54, 0xff, 0x85, 0x1e, 0x29, 0x92, 0x28, 0x21, 0xfb, 0x71, 0x10, 0x71,
+	0x5e, 0x6d, 0x8a, 0x01, 0x8a, 0x83, 0x08, 0x59, 0xd5, 0xba, 0x06, 0x0b, 0x3e, 0x4b, 0xfb, 0x49,
+	0x30, 0xc2, 0xe3, 0x9c, 0x10, 0x0b, 0x3a, 0x08, 0x1b, 0x3e, 0xf0, 0x42, 0x2f, 0xea, 0x33, 0x21,
+	0xd9, 0x65, 0xd2, 0xd9, 0x24, 0x71, 0xa5, 0x28, 0xd1, 0x4e, 0xd6, 0x26, 0x58, 0x74, 0xe5, 0x9b,
+	0xd0, 0x19, 0x49, 0xa0, 0x60, 0xbf, 0xae, 0xda, 0xab, 0x0b, 0xdd, 0x71, 0xf3, 0xa2, 0xce, 0x5d,
+	0xb0, 0xf5, 0xf6, 0xf6, 0xc7, 0xc3, 0xa1, 0x97, 0x9c, 0x4a, 0x46, 0x7c, 0x19, 0x56, 0x74, 0xcb,
+	0x4a, 0x20, 0xb4, 0xde, 0x8e, 0xbb, 0x7c, 0x98, 0x1b, 0x55, 0x90, 0x7b, 0xfe, 0x6b, 0x03, 0x5a,
+	0xbb, 0x71, 0x10, 0xe1, 0x90, 0x62, 0xf7, 0xa5, 0x9a, 0x87, 0xdf, 0x7a, 0x27, 0x9b, 0x46, 0x27,
+	0xf5, 0x71, 0x9d, 0x31, 0xc7, 0xf5, 0x0a, 0xc0, 0x88, 0x25, 0x7d, 0x16, 0x65, 0xde, 0x40, 0x8e,
+	0x8d, 0x06, 0xb1, 0xbe, 0x07, 0x0b, 0x44, 0x19, 0xb1, 0x5e, 0xda, 0x9d, 0xbd, 0x36, 0x63, 0x1e,
+	0xa3, 0x83, 0xe8, 0x26, 0xda, 0x7d, 0x88, 0x0f, 0x85, 0xca, 0x04, 0x87, 0x0a, 0x60, 0x7f, 0x0f,
+	0x56, 0x0a, 0xd9, 0x67, 0xa9, 0xdf, 0x0d, 0x5d, 0xc7, 0x39, 0x02, 0xeb, 0xd1, 0xe1, 0x61, 0x18,
+	0x44, 0x0c, 0x31, 0x89, 0x41, 0x9b, 0xc0, 0x25, 0xf5, 0x23, 0x60, 0xf6, 0x73, 0xa6, 0xd8, 0x4f,
+	0xe7, 0x21, 0xac, 0x3d, 0x8a, 0x2a, 0x10, 0xc9, 0xe6, 0x1a, 0x93, 0x9a, 0x6b, 0x96, 0x9a, 0xfb,
+	0x00, 0x16, 0x35, 0xc2, 0x53, 0xeb, 0xdb, 0xd0, 0x11, 0x34, 0xaa, 0x03, 0x8d, 0xad, 0xa4, 0x56,
+	0xa9, 0x87, 0x6e, 0x5e, 0xd8, 0xf9, 0xed, 0x06, 0x2c, 0xe4, 0x94, 0xa1, 0x09, 0x6f, 0x16, 0x27,
+	0x5b, 0xb6, 0x72, 0x45, 0xb5, 0x92, 0x97, 0xa1, 0x69, 0x11, 0x93, 0xc1, 0x0b, 0xdb, 0xfb, 0x00,
+	0x39, 0xb0, 0x62, 0x0a, 0x6e, 0x99, 0xea, 0xe7, 0x85, 0x72, 0xab, 0x92, 0x34, 0x6d, 0x76, 0x7e,
+	0x77, 0x0e, 0x2e, 0x56, 0x32, 0xb5, 0x58, 0x2b, 0x5f, 0x87, 0x05, 0xbe, 0x66, 0x51, 0x52, 0x49,
+	0x82, 0x17, 0x75, 0xde, 0x71, 0x81, 0xd6, 0x30, 0xe5, 0x5b, 0x6f, 0xc2, 0x12, 0xa6, 0xd2, 0x5e,
+	0xcc, 0x07, 0xa4, 0xdb, 0xac, 0xa8, 0xb0, 0x48, 0x45, 0xc4, 0x90, 0x59, 0x23, 0xd8, 0x34, 0xaa,
+	0xf4, 0x52, 0x4e, 0x82, 0xd8, 0x4c, 0xdf, 0xd3, 0x54, 0xfe, 0x3a, 0x2a, 0x6f, 0xee, 0x6a, 0x0d,
+	0x8a, 0x3c, 0x3e, 0x74, 0xeb, 0xfd, 0x72, 0x8e, 0x75, 0x0b, 0x16, 0x05, 0x46, 0x1a, 0x99, 0x6e,
+	0xab, 0x82, 0xc6, 0x05, 0x5e, 0x91, 0x0a, 0x58, 0x43, 0xd8, 0xd0, 0x2b, 0x28, 0x0a, 0xf9, 0x4a,
+	0xfa, 0xee, 0xf4, 0x14, 0x46, 0x25, 0x02, 0xad, 0x7e, 0x29, 0xa3, 0x4a, 0x92, 0xcc, 0x55, 0x49,
+	0x12, 0xeb, 0xb1, 0x58, 0xd8, 0x62, 0x72, 0xe6, 0x4b, 0x46, 0xa5, 0x5a, 0x72, 0x70, 0x41, 0xf3,
+	0x29, 0xd3, 0xd6, 0xbb, 0x98, 0x43, 0x1b, 0xda, 0xe3, 0x88, 0x94, 0x29, 0xbf, 0xdb, 0x26, 0xbc,
+	0x2a, 0x6d, 0xff, 0x09, 0xe8, 0xd6, 0x8d, 0x75, 0x05, 0x47, 0xbe, 0x6a, 0x72, 0xe4, 0x46, 0xc5,
+	0x6a, 0x49, 0x75, 0x1b, 0xec, 0x27, 0xb0, 0x5d, 0x33, 0x4e, 0xe7, 0x30, 0xdc, 0x3c, 0x8a, 0x2a,
+	0xdb, 0x16, 0x52, 0x4c, 0xeb, 0xf4, 0xb9, 0xa4, 0xd8, 0x5f, 0x68, 0x80, 0xbd, 0xe3, 0xfb, 0xa5,
+	0xed, 0x21, 0x37, 0xd3, 0x3c, 0xef, 0x4d, 0xef, 0x32, 0x5c, 0xac, 0x24, 0x48, 0xd8, 0x93, 0x9e,
+	0xc2, 0x65, 0x97, 0x0d, 0xe3, 0x63, 0xf6, 0xbc, 0x49, 0x76, 0xae, 0xc1, 0x95, 0x3a, 0xcc, 0x82,
+	0x36, 0x32, 0xb0, 0x9a, 0x17, 0x14, 0x4a, 0x35, 0xfd, 0x6f, 0x0d, 0x58, 0x32, 0x72, 0xbe, 0x34,
+	0x6b, 0xc8, 0xeb, 0x60, 0x25, 0x2c, 0xcd, 0x7a, 0xa3, 0x38, 0x0c, 0xd1, 0x28, 0xe2, 0xa3, 0xc9,
+	0x58, 0x5c, 0x9a, 0xac, 0x62, 0xce, 0x1e, 0xcf, 0xb8, 0x83, 0x70, 0x6b, 0x1b, 0xe6, 0xbd, 0x51,
+	0xd0, 0x43, 0x06, 0xe1, 0x16, 0x91, 0x39, 0x6f, 0x14, 0xfc, 0x90, 0x9d, 0x5a, 0x0e, 0x2c, 0x89,
+	0x8c, 0x5e, 0xc8, 0x8e, 0x59, 0x48, 0x5a, 0xf7, 0x8c, 0xbb, 0xc0, 0xb3, 0x1f, 0x20, 0xc8, 0xba,
+	0x01, 0xab, 0xa3, 0x24, 0x40, 0xee, 0xcd, 0x6f, 0x67, 0xe6, 0x89, 0x9a, 0x15, 0x01, 0x97, 0xbd,
+	0x73, 0x7e, 0x02, 0x17, 0x2a, 0xc6, 0x42, 0x48, 0xdf, 0x5f, 0x82, 0x15, 0xf3, 0x8e, 0x47, 0x4a,
+	0x60, 0x75, 0x6e, 0x30, 0x2a, 0xba, 0xcb, 0x87, 0x46, 0x3b, 0x42, 0xff, 0xa7, 0x32, 0xae, 0x97,
+	0x29, 0xab, 0xa2, 0xf3, 0x19, 0x6c, 0xe4, 0xc0, 0xdd, 0x38, 0x3a, 0x66, 0x49, 0x8a, 0xdc, 0x66,
+	0x41, 0xeb, 0x30, 0x89, 0xa5, 0x49, 0x9c, 0xbe, 0x51, 0x73, 0xce, 0x62, 0xc1, 0x06, 0xcd, 0x2c,
+	0xc6, 0x32, 0x89, 0x97, 0xc9, 0xfd, 0x97, 0xbe, 0xf1, 0xa4, 0x12, 0x50, 0x23, 0xac, 0x47, 0x79,
+	0x9c, 0x55, 0x17, 0x04, 0x0c, 0xb1, 0x38, 0x1f, 0x91, 0x02, 0xaf, 0x93, 0x22, 0xfa, 0x88, 0xda,
+	0x09, 0xf5, 0x11, 0x6b, 0xca, 0xfe, 0x5d, 0x32, 0xfa, 0x57, 0x20, 0xd3, 0x85, 0x43, 0x05, 0x75,
+	0xfe, 0x47, 0x13, 0x16, 0xe9, 0xcc, 0x70, 0x87, 0x65, 0x5e, 0x10, 0x4e, 0x3e, 0xcd, 0xf0, 0x53,
+	0x40, 0x53, 0x9d, 0x02, 0x5e, 0x80, 0x25, 0xdd, 0x24, 0x75, 0x2a, 0xcd, 0x09, 0x9a, 0x41, 0xea,
+	0x14, 0xad, 0x5f, 0x64, 0xdc, 0xc8, 0x4b, 0x71, 0x9e, 0x59, 0x22, 0xa8, 0x2a, 0x66, 0x1e, 0xc5,
+	0x66, 0x0b, 0x47, 0x31, 0xcc, 0xa6, 0xe3, 0x4c, 0x2f, 0x0d, 0x7c, 0x75, 0x52, 0x23, 0xc8, 0x7e,
+	0xe0, 0x6b, 0xd9, 0x54, 0x7b, 0x5e, 0xcb, 0xa6, 0xda, 0x78, 0x0a, 0x4d, 0x18, 0xbf, 0xaa, 0xa1,
+	0x1b, 0xc7, 0x36, 0x31, 0xdd, 0xa2, 0x04, 0xa2, 0xa5, 0x0e, 0x0f, 0xca, 0xe2, 0x7a, 0xa1, 0xc3,
+	0x39, 0x96, 0xa7, 0xf2, 0x83, 0x32, 0xe8, 0x07, 0xe5, 0xfc, 0x58, 0xbd, 0x60, 0x1c, 0xab, 0xaf,
+	0xc2, 0x42, 0x3c, 0x62, 0x51, 0x4f, 0x18, 0x39, 0x16, 0x29, 0x13, 0x10, 0xf4, 0x11, 0x41, 0x84,
+	0xd1, 0x8a, 0xc6, 0x3c, 0x9d, 0xc6, 0x32, 0x60, 0x0e, 0x4c, 0xb3, 0x38, 0x30, 0xf2, 0x28, 0x3e,
+	0x73, 0xd6, 0x51, 0xdc, 0xd9, 0x81, 0x35, 0x0d, 0xb1, 0x60, 0x9f, 0xd7, 0x61, 0x8e, 0x86, 0x49,
+	0x72, 0xce, 0x86, 0x71, 0x90, 0x14, 0x4c, 0xe1, 0x8a, 0x32, 0xce, 0x07, 0x74, 0x8b, 0x4b, 0x59,
+	0xd3, 0x90, 0x8e, 0x46, 0x71, 0x9a, 0x15, 0xc5, 0x35, 0xf3, 0x94, 0xbe, 0xef, 0x3b, 0xbf, 0x33,
+	0x03, 0xd6, 0xfe, 0xf8, 0x60, 0x18, 0x4c, 0xdf, 0xda, 0xf4, 0x26, 0x12, 0x0b, 0x5a, 0xc4, 0x26,
+	0x9c, 0x1d, 0xe9, 0xbb, 0xc0, 0x21, 0xad, 0x22, 0x87, 0xe4, 0xd3, 0x39, 0x5b, 0x6d, 0x25, 0x99,
+	0xd3, 0x27, 0x1f, 0x45, 0x7c, 0x18, 0xb0, 0x28, 0xeb, 0x09, 0x73, 0x17, 0x8a, 0x78, 0x02, 0xdc,
+	0xf7, 0x91, 0x03, 0xd2, 0x0c, 0x57, 0xe3, 0xe0, 0x14, 0xb3, 0xb9, 0x91, 0x16, 0x24, 0xe8, 0xbe,
+	0x6f, 0xdd, 0x84, 0xf5, 0x91, 0x97, 0x64, 0x81, 0x17, 0xf6, 0x0e, 0x83, 0x30, 0x44, 0x89, 0x1a,
+	0xf4, 0x4f, 0x05, 0xd7, 0xad, 0x89, 0xac, 0xf7, 0x83, 0x30, 0xdc, 0xa3, 0x0c, 0xeb, 0x0d, 0xd8,
+	0x30, 0xca, 0x4b, 0x53, 0x33, 0x50, 0x05, 0x4b, 0xab, 0x20, 0x2d, 0xce, 0x0e, 0x2c, 0x61, 0xa1,
+	0x5e, 0x10, 0xe1, 0x25, 0x77, 0x9f, 0x11, 0x8f, 0x76, 0xdc, 0x05, 0x04, 0xde, 0x8f, 0xde, 0x47,
+	0x10, 0x0e, 0x08, 0x7b, 0x3a, 0x0a, 0x12, 0x96, 0xf6, 0xbc, 0xac, 0xbb, 0x28, 0x6f, 0x3a, 0x08,
+	0xb2, 0x93, 0x39, 0xbf, 0xd5, 0x80, 0x75, 0x63, 0x82, 0x04, 0xc3, 0x5c, 0x87, 0x45, 0x3e, 0x8e,
+	0xa3, 0xd0, 0xeb, 0xab, 0x6b, 0x95, 0x05, 0x82, 0xed, 0x11, 0x68, 0xc2, 0xb4, 0xa3, 0x30, 0xe8,
+	0xc7, 0x49, 0xc2, 0x42, 0xbe, 0x16, 0x85, 0xa9, 0xa9, 0xe3, 0x2e, 0x69, 0xd0, 0xfb, 0xbe, 0x39,
+	0xbe, 0x2d, 0x73, 0x7c, 0x9d, 0x3f, 0xdb, 0x80, 0x8d, 0xfd, 0x60, 0x38, 0x0e, 0xbd, 0x8c, 0x7d,
+	0x05, 0xcc, 0x93, 0x73, 0xc2, 0x8c, 0xc1, 0x09, 0x92, 0xa9, 0x5a, 0x39, 0x53, 0x39, 0xff, 0xab,
+	0x01, 0x9b, 0x05, 0x52, 0x94, 0xe2, 0x6f, 0xae, 0xab, 0x1a, 0x4b, 0x95, 0x28, 0xa4, 0x21, 0x6d,
+	0x1a, 0x48, 0x5f, 0x80, 0xa5, 0x61, 0x10, 0x05, 0xc3, 0xf1, 0xb0, 0xc7, 0xd9, 0x90, 0xd3, 0xb4,
+	0x28, 0x80, 0x7b, 0x08, 0xa3, 0x42, 0xde, 0x53, 0xad, 0x50, 0x4b, 0x14, 0xf2, 0x9e, 0xe6, 0x85,
+	0x90, 0x89, 0xd4, 0xe1, 0xac, 0x37, 0xf0, 0x82, 0xa8, 0x17, 0xc6, 0x69, 0x2a, 0xd8, 0xdd, 0xca,
+	0xf3, 0xee, 0x79, 0x41, 0xf4, 0x20, 0x4e, 0x53, 0x4d, 0x1e, 0xce, 0xe9, 0xf2, 0x10, 0x75, 0xb9,
+	0xd5, 0x8f, 0x8f, 0xbc, 0x90, 0xdd, 0x8e, 0x87, 0x07, 0x5f, 0xee, 0xd8, 0x5f, 0x87, 0x45, 0x6e,
+	0x04, 0xce, 0xbc, 0x64, 0xc0, 0xe4, 0x0c, 0x2c, 0x10, 0xec, 0x31, 0x81, 0x2a, 0xa7, 0xe1, 0xbf,
+	0x37, 0xc0, 0xda, 0x45, 0xad, 0x2e, 0x9c, 0x9a, 0x1f, 0x50, 0xaa, 0x72, 0x23, 0x4e, 0xce, 0xa5,
+	0x1d, 0x01, 0xb9, 0x6f, 0xb2, 0xf0, 0x8c, 0xc9, 0xc2, 0xb2, 0x37, 0xad, 0x73, 0x5a, 0x6a, 0x4b,
+	0x5b, 0xda, 0x8b, 0xb0, 0x7c, 0xe2, 0x85, 0x21, 0xcb, 0xd4, 0x7d, 0xaf, 0xb8, 0x16, 0xe2, 0x50,
+	0x69, 0x10, 0x92, 0x1d, 0x9e, 0xd7, 0x3a, 0xbc, 0x09, 0xeb, 0x46, 0x7f, 0x85, 0x62, 0xf8, 0x0e,
+	0x6c, 0x71, 0xf0, 0x4e, 0x18, 0x4e, 0xbd, 0xc1, 0x38, 0x7f, 0xb5, 0x09, 0xdb, 0xa5, 0x6a, 0x4a,
+	0x83, 0x32, 0xd9, 0xf8, 0x25, 0xd5, 0xdd, 0xea, 0x0a, 0x37, 0x45, 0x52, 0xd4, 0xb2, 0xff, 0x45,
+	0x03, 0xe6, 0x38, 0x68, 0xe2, 0x6c, 0x7c, 0x22, 0x85, 0x8a, 0x60, 0x38, 0x7e, 0xec, 0xfd, 0xd6,
+	0x74, 0xc8, 0xf8, 0x8f, 0x7e, 0xc7, 0xbf, 0x10, 0xe7, 0x10, 0xfb, 0x97, 0x60, 0xb5, 0x58, 0xe0,
+	0x5c, 0xf7, 0x9f, 0xdc, 0xc4, 0x77, 0xf7, 0x98, 0x69, 0x77, 0xfa, 0xbf, 0xd7, 0x80, 0x95, 0xdd,
+	0x38, 0xf2, 0x03, 0x94, 0x57, 0x7b, 0x5e, 0xe2, 0x0d, 0x53, 0xe1, 0x56, 0xc2, 0x41, 0xa2, 0xe5,
+	0x1c, 0x50, 0x63, 0x6d, 0xbf, 0x0c, 0xd0, 0x3f, 0x62, 0xfd, 0x27, 0x3d, 0x61, 0xfe, 0xe6, 0xbe,
+	0x28, 0x08, 0xb9, 0x8d, 0xc6, 0xee, 0xaf, 0xc3, 0x7a, 0x9e, 0xdd, 0xf3, 0x22, 0xbf, 0x27, 0x6c,
+	0xdf, 0x74, 0xd5, 0xa6, 0xca, 0xed, 0x44, 0xfe, 0x0e, 0x1a, 0xbc, 0x6f, 0xc0, 0xaa, 0x32, 0xf9,
+	0xf6, 0x8c, 0xdd, 0x6c, 0x45, 0xc1, 0x77, 0x08, 0xec, 0xfc, 0xef, 0x06, 0xac, 0x69, 0xbd, 0x12,
+	0xb3, 0x9d, 0x5b, 0x79, 0xc9, 0xf8, 0x6f, 0x4c, 0x59, 0xb3, 0x30, 0x65, 0x16, 0xb4, 0x02, 0x74,
+	0xff, 0x10, 0x7b, 0x2c, 0x7e, 0x5b, 0xb7, 0x61, 0x55, 0xf5, 0xb8, 0x37, 0xa2, 0x61, 0x11, 0xcb,
+	0x64, 0x3b, 0xb7, 0x0e, 0x18, 0xa3, 0xe6, 0xae, 0xf4, 0x0b, 0xc3, 0x28, 0x97, 0xd7, 0xec, 0x54,
+	0x82, 0xba, 0x4f, 0xa3, 0x2d, 0xe4, 0x13, 0x4f, 0x71, 0xaa, 0x59, 0x7f, 0x8c, 0x36, 0x7f, 0x7e,
+	0x6a, 0x50, 0x69, 0xe7, 0x0f, 0x1b, 0xb0, 0xb2, 0xe3, 0xfb, 0xd4, 0xef, 0x69, 0xc4, 0x84, 0xec,
+	0x65, 0xf3, 0x8c, 0x5e, 0xce, 0x3c, 0x63, 0x2f, 0xbf, 0xb0, 0x10, 0xa9, 0x19, 0x04, 0xc7, 0x81,
+	0xd5, 0xbc, 0x9f, 0xd5, 0xd3, 0xeb, 0x7c, 0x0d, 0x2c, 0x7e, 0xd2, 0x34, 0x86, 0xa3, 0x58, 0x6a,
+	0x13, 0xd6, 0x8d, 0x52, 0x42, 0xd6, 0xbc, 0x0f, 0xaf, 0xa0, 0x95, 0x3b, 0x39, 0x1d, 0x65, 0xb1,
+	0xd4, 0xec, 0xef, 0xb0, 0x51, 0x9c, 0x06, 0x52, 0x72, 0xb1, 0xa9, 0xa4, 0xcf, 0xbf, 0x6a, 0xc0,
+	0x8d, 0x29, 0x1a, 0x12, 0x5d, 0xf8, 0xb4, 0x6c, 0x44, 0xfc, 0xbe, 0xee, 0x6b, 0x35, 0x55, 0x2b,
+	0x37, 0x15, 0x44, 0xb8, 0xbc, 0xa8, 0x26, 0xed, 0xf7, 0x60, 0xd9, 0xcc, 0x3c, 0x97, 0xa8, 0x08,
+	0xe1, 0xa5, 0x33, 0x88, 0x98, 0x86, 0xe7, 0x5e, 0x82, 0xe5, 0xbe, 0xd1, 0x84, 0x40, 0x54, 0x80,
+	0x3a, 0xbb, 0xf0, 0xf2, 0x99, 0xd8, 0xc4, 0xb0, 0xd5, 0x1a, 0x2b, 0x9c, 0xbf, 0xdf, 0x82, 0xed,
+	0x8f, 0x83, 0xec, 0xc8, 0x4f, 0xbc, 0x13, 0xc9, 0x7d, 0xd3, 0x10, 0x59, 0xb0, 0x63, 0x34, 0xcb,
+	0xa6, 0x97, 0x57, 0x61, 0x2d, 0x8e, 0x18, 0x29, 0xab, 0xbd, 0x91, 0x97, 0xa6, 0x27, 0x71, 0x22,
+	0xf7, 0xd2, 0x95, 0x38, 0x62, 0xa8, 0xaa, 0xee, 0x09, 0x70, 0x61, 0x37, 0x6e, 0x15, 0x77, 0xe3,
+	0x55, 0x98, 0x19, 0x05, 0x91, 0xb8, 0xc0, 0xc3, 0x4f, 0xdc, 0x3b, 0xb3, 0xc4, 0xf3, 0xb5, 0x96,
+	0xc5, 0xde, 0x49, 0x50, 0xd5, 0xae, 0x7e, 0xa5, 0x34, 0x5f, 0xb8, 0x52, 0xd2, 0xc6, 0xa4, 0x6d,
+	0x1a, 0x70, 0xae, 0xc2, 0x82, 0xf8, 0xec, 0x65, 0xde, 0x40, 0xe8, 0xe5, 0x20, 0x40, 0x8f, 0xbd,
+	0x81, 0xa6, 0xad, 0x81, 0xa1, 0xad, 0x5d, 0x06, 0x38, 0x64, 0xac, 0x67, 0x9c, 0x0b, 0x3b, 0x87,
+	0x8c, 0x71, 0xa1, 0x8b, 0x5a, 0xed, 0x81, 0x17, 0x3d, 0xe9, 0x91, 0x39, 0x86, 0x2b, 0xdc, 0x6d,
+	0x04, 0xa0, 0x23, 0x13, 0xaa, 0x3e, 0x94, 0x29, 0x69, 0x5a, 0xe2, 0x23, 0x8a, 0xb0, 0x9d, 0xdc,
+	0xb0, 0x44, 0x45, 0xfa, 0x41, 0x76, 0xda, 0x5d, 0xce, 0xeb, 0xef, 0x06, 0xd9, 0xa9, 0xaa, 0x4f,
+	0x63, 0x96, 0x9c, 0x76, 0x57, 0xf2, 0xfa, 0xbb, 0x1c, 0x84, 0xe4, 0xa5, 0x27, 0xc1, 0x21, 0xe3,
+	0x5e, 0x4a, 0xab, 0x7c, 0x94, 0x09, 0x82, 0xae, 0x41, 0xa8, 0x46, 0x9e, 0x04, 0x89, 0x76, 0x4e,
+	0x5f, 0xe3, 0xa7, 0x79, 0x04, 0x4a, 0xd6, 0x70, 0x5e, 0x85, 0x55, 0xc9, 0x2e, 0xba, 0x23, 0x6f,
+	0xc2, 0xd2, 0x71, 0x98, 0x49, 0x47, 0x5e, 0x9e, 0x72, 0xde, 0x24, 0x17, 0x9d, 0x07, 0xf1, 0x60,
+	0x90, 0x9f, 0x24, 0x05, 0x6b, 0x6d, 0xc1, 0x5c, 0x48, 0x70, 0x59, 0x85, 0xa7, 0x9c, 0x08, 0xba,
+	0xe5, 0x2a, 0xf9, 0x15, 0x5a, 0x10, 0x1d, 0xc6, 0xe2, 0xc4, 0x41, 0xdf, 0xb8, 0x16, 0x7d, 0x76,
+	0x30, 0x1e, 0x48, 0x87, 0x3c, 0x4a, 0x60, 0xc9, 0x13, 0x2f, 0x89, 0xc4, 0x86, 0x4a, 0xdf, 0x58,
+	0x92, 0x25, 0x49, 0x9c, 0x88, 0xdd, 0x93, 0x27, 0x9c, 0x7b, 0xb0, 0xbd, 0x7f, 0x3e, 0x12, 0xb1,
+	0x21, 0x6e, 0xb8, 0x12, 0xcb, 0x9f, 0x12, 0x8e, 0x0f, 0x16, 0x6f, 0x88, 0x2c, 0x58, 0x53, 0x39,
+	0x4a, 0x4e, 0xdc, 0x5e, 0x15, 0x96, 0x19, 0x1d, 0xcb, 0x0f, 0x0d, 0xa7, 0x27, 0x72, 0x8c, 0x99,
+	0x66, 0xb1, 0x6e, 0xc0, 0x2c, 0xed, 0x18, 0x92, 0x64, 0x4a, 0x38, 0x7f, 0xd0, 0x80, 0x6e, 0xb9,
+	0x35, 0xe5, 0x76, 0x59, 0x76, 0x22, 0xe2, 0xf2, 0xf6, 0x1b, 0x15, 0x4e, 0x44, 0x46, 0xdd, 0xe9,
+	0xbc, 0x88, 0xbe, 0x52, 0xc7, 0xa0, 0xcf, 0x61, 0x5d, 0x27, 0xed, 0xb9, 0x9a, 0x59, 0x7e, 0xbd,
+	0x41, 0x26, 0x49, 0x75, 0xce, 0xdb, 0xcf, 0x12, 0xe6, 0x0d, 0x9f, 0xab, 0x0f, 0xc8, 0x2f, 0xc3,
+	0x75, 0xdd, 0x45, 0xf0, 0xdc, 0x94, 0x38, 0xbf, 0x4a, 0x37, 0xe7, 0xdc, 0xaf, 0xe5, 0x8f, 0x81,
+	0xfe, 0xf7, 0xe0, 0x8a, 0x46, 0xff, 0x39, 0xc9, 0x70, 0xfe, 0x72, 0x83, 0xcc, 0xb6, 0x3b, 0x63,
+	0x3f, 0xc8, 0x0c, 0xcd, 0x06, 0xe5, 0x5f, 0xe6, 0x25, 0x59, 0xcf, 0xf7, 0x32, 0xa6, 0x96, 0x23,
+	0x42, 0xee, 0x78, 0x19, 0x59, 0xab, 0x58, 0xe4, 0xf3, 0x4c, 0x61, 0xb6, 0x60, 0x91, 0x2f, 0xb3,
+	0xf8, 0xf9, 0xe4, 0xe0, 0xd4, 0x38, 0x0e, 0xde, 0x26, 0x6d, 0x80, 0xfc, 0xbc, 0x48, 0xae, 0xcc,
+	0xba, 0x3c, 0x81, 0xc2, 0x23, 0x3e, 0x3c, 0xc4, 0x25, 0x37, 0x4b, 0x60, 0x91, 0x72, 0x76, 0x61,
+	0xb3, 0x40, 0x9a, 0x58, 0x6f, 0xaf, 0xc2, 0x1c, 0x43, 0x40, 0xc9, 0xa1, 0x43, 0x2b, 0x2b, 0x4a,
+	0x38, 0x7f, 0x93, 0x73, 0xd8, 0x07, 0x41, 0x9a, 0xc5, 0x49, 0xd0, 0xdf, 0xf5, 0x22, 0x3f, 0x64,
+	0xe9, 0x97, 0x3b, 0x43, 0x97, 0xa0, 0x93, 0x60, 0x95, 0x34, 0xf8, 0x9c, 0x09, 0x77, 0xa0, 0x1c,
+	0x80, 0xbb, 0xff, 0x20, 0xf1, 0xa2, 0x71, 0xe8, 0x25, 0xb8, 0x17, 0xb5, 0xb8, 0x09, 0x5f, 0x03,
+	0x39, 0x77, 0xc0, 0xae, 0x22, 0x51, 0xf4, 0xf6, 0x25, 0x98, 0xeb, 0x13, 0x48, 0xf4, 0x76, 0x59,
+	0x3b, 0xe9, 0xf9, 0x21, 0x73, 0x45, 0xae, 0xf3, 0xa7, 0x1b, 0x30, 0xc7, 0x41, 0x28, 0xd3, 0xd5,
+	0x5b, 0x91, 0x19, 0x97, 0xbe, 0xa5, 0x07, 0x5a, 0x33, 0xf7, 0x40, 0x93, 0x7e, 0x6a, 0x33, 0x9a,
+	0x9f, 0x9a, 0x05, 0xad, 0x78, 0xc4, 0x22, 0xe9, 0xcf, 0x86, 0xdf, 0x38, 0x6b, 0xfd, 0x30, 0x4e,
+	0x99, 0x38, 0x1f, 0xf1, 0x84, 0xe6, 0x9b, 0x36, 0xa7, 0xfb, 0xa6, 0x39, 0xdf, 0x34, 0x04, 0xe5,
+	0x07, 0xcc, 0x0b, 0xb3, 0xa3, 0x69, 0x38, 0xf1, 0xc7, 0x70, 0xa1, 0xa2, 0x9e, 0x18, 0x83, 0x77,
+	0x4c, 0x47, 0x63, 0xc3, 0x33, 0xad, 0x50, 0x25, 0x2f, 0xe8, 0xfc, 0xcf, 0x06, 0x2c, 0x9b, 0xb9,
+	0x13, 0x27, 0xdc, 0x86, 0x76, 0xc2, 0x09, 0xe5, 0x6e, 0xb4, 0x2d, 0x57, 0xa5, 0xb1, 0xb7, 0xb4,
+	0x09, 0xf2, 0xd3, 0x4b, 0xcb, 0x15, 0x29, 0xee, 0xae, 0x18, 0xf1, 0x93, 0x5b, 0xcb, 0xa5, 0x6f,
+	0x5c, 0x3a, 0xe4, 0x4b, 0xc5, 0xb7, 0x50, 0x71, 0x0a, 0x41, 0xc8, 0x5d, 0x04, 0x58, 0x2f, 0xc1,
+	0x4a, 0x9e, 0xcd, 0x2d, 0xec, 0xfc, 0x5a, 0x67, 0x49, 0x95, 0x21, 0x13, 0xfb, 0x3b, 0xba, 0x7f,
+	0xfa, 0x7c, 0xa1, 0xcf, 0x22, 0x43, 0xf5, 0x59, 0x16, 0x74, 0x7e, 0xb7, 0x01, 0xcb, 0x66, 0x2e,
+	0xf5, 0x59, 0x40, 0x54, 0x9f, 0x45, 0xfa, 0x99, 0xfa, 0xbc, 0x09, 0x73, 0xa3, 0x6f, 0xbc, 0xd1,
+	0x13, 0xe7, 0x55, 0x3c, 0x9f, 0x7f, 0xe3, 0x8d, 0x87, 0x1c, 0xfc, 0x2e, 0x81, 0x05, 0x9f, 0x8c,
+	0xde, 0x55, 0xe0, 0x77, 0x11, 0x2c, 0xad, 0xc2, 0xef, 0xbe, 0xfb, 0x30, 0x75, 0x7e, 0x02, 0x9b,
+	0x1f, 0xb3, 0x83, 0x34, 0xee, 0x3f, 0xe1, 0x4f, 0x1c, 0xf4, 0x5b, 0x48, 0x9c, 0x8f, 0x88, 0x85,
+	0x52, 0xfd, 0x16, 0xc9, 0xe9, 0x17, 0x24, 0x2e, 0x05, 0x14, 0xea, 0x95, 0x08, 0xd2, 0xa9, 0x1c,
+	0x9b, 0x76, 0x61, 0x29, 0xd5, 0x2b, 0x09, 0x2b, 0xcb, 0x65, 0x89, 0xb4, 0xb2, 0x69, 0xd7, 0xac,
+	0xe3, 0xfc, 0xf5, 0x06, 0x5c, 0xae, 0xa3, 0xe1, 0x0b, 0x6f, 0xb2, 0x25, 0x0a, 0x67, 0x9e, 0x81,
+	0xc2, 0xdf, 0xe6, 0x4f, 0x49, 0x7e, 0x48, 0x77, 0xe0, 0xcf, 0x7d, 0xef, 0x42, 0x24, 0x41, 0x94,
+	0xb1, 0xe4, 0xd8, 0x0b, 0xa5, 0xe5, 0x5a, 0xa6, 0x9d, 0xff, 0xd0, 0x84, 0x25, 0xa2, 0x6b, 0xaa,
+	0xf9, 0x7a, 0x1e, 0x24, 0xe5, 0x7b, 0x22, 0x2d, 0x5a, 0x7e, 0xc2, 0xe2, 0x7b, 0x22, 0x2d, 0x58,
+	0x34, 0x50, 0xa1, 0x68, 0xd4, 0xd7, 0x74, 0x87, 0x20, 0x94, 0x2d, 0x45, 0xeb, 0xbc, 0x26, 0x5a,
+	0xa5, 0x08, 0x6e, 0x97, 0x5d, 0x85, 0x3b, 0xb9, 0xa0, 0x56, 0x02, 0x18, 0xaa, 0x05, 0xf0, 0x82,
+	0xe1, 0x1c, 0x5c, 0x74, 0xe5, 0x5c, 0x2c, 0xb9, 0x72, 0xe2, 0xb3, 0x18, 0xba, 0x09, 0x1e, 0x47,
+	0x7e, 0x10, 0x0d, 0xf6, 0xbc, 0xd3, 0xa1, 0x66, 0xb0, 0x7b, 0x3e, 0xe3, 0x6c, 0xea, 0x17, 0xad,
+	0x49, 0xfa, 0xc5, 0xac, 0xa1, 0x5f, 0x38, 0xc7, 0xb0, 0x6c, 0x12, 0xae, 0xae, 0x89, 0x1b, 0xda,
+	0x35, 0x71, 0xdd, 0x25, 0x81, 0x7e, 0xca, 0x9d, 0x29, 0x9c, 0x72, 0x2f, 0x41, 0x07, 0xa7, 0x2e,
+	0xcd, 0xbc, 0xe1, 0x48, 0x92, 0xa4, 0x00, 0xce, 0x7f, 0x6a, 0xd0, 0x36, 0x5d, 0x1a, 0xb4, 0xe7,
+	0xc9, 0x9d, 0x6f, 0x41, 0x7b, 0x24, 0x10, 0x77, 0x5b, 0xe6, 0x96, 0x60, 0xd2, 0xe5, 0xaa, 0x72,
+	0xc8, 0x3d, 0xe4, 0xb4, 0x23, 0xc5, 0x32, 0x25, 0xf8, 0x85, 0x45, 0x9c, 0x30, 0x5f, 0x30, 0xaa,
+	0x48, 0x39, 0xff, 0xa5, 0x41, 0x4e, 0x5a, 0x8f, 0x59, 0xff, 0x08, 0xdf, 0xbb, 0x85, 0x3b, 0x91,
+	0x17, 0x9e, 0xa6, 0x41, 0xfa, 0x8b, 0x22, 0x17, 0x70, 0x92, 0x82, 0xc8, 0x0f, 0xfa, 0x5e, 0x96,
+	0x6f, 0xae, 0x0a, 0x80, 0xdd, 0x1a, 0xb1, 0x24, 0x88, 0x55, 0xb7, 0x78, 0x8a, 0x96, 0x10, 0x71,
+	0xc3, 0x3c, 0x81, 0x79, 0xc2, 0xf9, 0x1e, 0xac, 0xdc, 0x97, 0x55, 0xf7, 0x59, 0x12, 0xb0, 0xb4,
+	0xd2, 0x03, 0x04, 0x57, 0x1a, 0xf7, 0x67, 0xc4, 0x5d, 0xa0, 0xe1, 0x8a, 0x94, 0xf3, 0xef, 0x9a,
+	0x70, 0xa9, 0x7a, 0xac, 0x7e, 0x51, 0x24, 0xd6, 0xb3, 0x0d, 0xd6, 0x15, 0x00, 0xc5, 0xf6, 0x5c,
+	0xf5, 0x98, 0x71, 0x35, 0x48, 0x2e, 0x8f, 0xda, 0x34, 0x1c, 0x3c, 0x61, 0xdd, 0x82, 0xb9, 0x94,
+	0xc6, 0x50, 0x3c, 0xa0, 0x51, 0x06, 0xde, 0xc2, 0x10, 0xbb, 0xa2, 0x18, 0xb1, 0x60, 0x30, 0x88,
+	0xbc, 0x50, 0x5c, 0xce, 0x8a, 0x94, 0xf3, 0x10, 0xb6, 0xf1, 0xfe, 0x81, 0x21, 0xfb, 0x3e, 0x1a,
+	0xb1, 0x28, 0x88, 0x06, 0xb7, 0x85, 0x1f, 0xe5, 0x24, 0xb7, 0xe7, 0x9a, 0x15, 0xef, 0xfc, 0x46,
+	0x93, 0xd6, 0xad, 0xf0, 0x47, 0x56, 0x2d, 0x4f, 0xb9, 0x05, 0x6b, 0x42, 0xaa, 0x39, 0x49, 0x48,
+	0xcd, 0x98, 0x87, 0xa0, 0x1f, 0xc0, 0x6a, 0xcc, 0x49, 0xef, 0x09, 0x27, 0x2a, 0xb9, 0x60, 0xaf,
+	0xca, 0x61, 0xa9, 0xe9, 0xa3, 0xbb, 0x12, 0x1b, 0x69, 0xba, 0x2c, 0xc9, 0xe2, 0x90, 0x25, 0x98,
+	0x12, 0x8b, 0x38, 0x07, 0x4c, 0xed, 0xc1, 0xe7, 0xfc, 0x7e, 0x03, 0x96, 0x15, 0x4e, 0x6e, 0x3e,
+	0x30, 0x04, 0x5e, 0xa3, 0x20, 0xf0, 0xe8, 0x14, 0x91, 0xab, 0x1e, 0xf4, 0x3d, 0x51, 0x7c, 0xe6,
+	0x13, 0xd0, 0x32, 0x44, 0xae, 0xe6, 0x57, 0x36, 0x6b, 0xba, 0xc5, 0xe2, 0xc1, 0x89, 0x1d, 0x32,
+	0xac, 0xae, 0xfc, 0x54, 0x14, 0xa0, 0x68, 0x36, 0x9d, 0x2f, 0xbb, 0x7f, 0xfd, 0x7e, 0x0b, 0x56,
+	0x55, 0x97, 0xa6, 0xe1, 0x91, 0x2e, 0xcc, 0x8b, 0xd1, 0x95, 0x0e, 0xbf, 0x22, 0x89, 0xb5, 0x7c,
+	0x6e, 0x0f, 0x4e, 0xc5, 0x81, 0x48, 0xa5, 0x91, 0x90, 0x13, 0x61, 0xc7, 0x43, 0xdf, 0x47, 0xe1,
+	0x71, 0xa4, 0x81, 0xb0, 0xeb, 0x64, 0x4c, 0x95, 0xba, 0xaf, 0x48, 0x91, 0x93, 0x13, 0x63, 0x52,
+	0xf5, 0xa5, 0x6f, 0xa4, 0xe1, 0x90, 0xcb, 0x6a, 0xa1, 0x0a, 0xc8, 0x24, 0xe6, 0xe0, 0x52, 0xc2,
+	0x1c, 0xae, 0x10, 0xc8, 0x24, 0x57, 0xd3, 0xb9, 0xe9, 0x46, 0x28, 0x06, 0x2a, 0x4d, 0xc3, 0x14,
+	0xa4, 0xfd, 0x84, 0x8d, 0x3c, 0xec, 0x32, 0xd7, 0x11, 0x74, 0x10, 0xae, 0xe7, 0x84, 0xf5, 0xe3,
+	0xa8, 0x1f, 0xa0, 0x13, 0xdb, 0x02, 0xd9, 0xf4, 0x34, 0x88, 0xf5, 0x00, 0x16, 0x05, 0x22, 0x7a,
+	0xb0, 0xdf, 0x5d, 0x34, 0x9f, 0xc8, 0x17, 0x47, 0xf8, 0xe6, 0x2e, 0x2f, 0x8c, 0x3e, 0x8e, 0xe2,
+	0x0e, 0xb1, 0x9f, 0x43, 0xac, 0xef, 0x43, 0x7b, 0x14, 0x85, 0xbc, 0xa5, 0x25, 0x6a, 0xe9, 0xc5,
+	0xda, 0x96, 0xf6, 0xa2, 0x30, 0x6f, 0x65, 0x7e, 0xc4, 0x53, 0x78, 0x0b, 0x59, 0x44, 0x71, 0x1e,
+	0x07, 0x4a, 0xfb, 0x3b, 0xb0, 0xa8, 0x37, 0x7c, 0x9e, 0xba, 0xce, 0x1f, 0xb5, 0xe0, 0x62, 0xa5,
+	0xb4, 0x98, 0x42, 0xa4, 0x3f, 0xbb, 0xb8, 0x78, 0x03, 0x7d, 0x0c, 0xb3, 0x24, 0x50, 0x52, 0x62,
+	0xab, 0x34, 0x64, 0x62, 0x8c, 0x44, 0x31, 0xeb, 0x1d, 0x68, 0x2b, 0xc1, 0x32, 0x6b, 0xbe, 0x2b,
+	0x28, 0x8e, 0xb2, 0xab, 0x4a, 0x4e, 0xef, 0xee, 0xfb, 0x53, 0x58, 0xd7, 0x59, 0xc2, 0x74, 0xfb,
+	0xfd, 0x8e, 0x66, 0xd5, 0xac, 0x1b, 0x28, 0x9d, 0x49, 0x74, 0xef, 0xdf, 0xb5, 0x7e, 0x11, 0x6e,
+	0x7d, 0x0a, 0x2b, 0x92, 0x61, 0x24, 0x9e, 0x36, 0xe1, 0xf9, 0xe6, 0x34, 0x78, 0xc4, 0x4c, 0xeb,
+	0x38, 0x96, 0x46, 0x3a, 0xcc, 0x70, 0x32, 0xee, 0x14, 0x9c, 0x8c, 0xef, 0xc0, 0x56, 0x35, 0xa1,
+	0xe7, 0x62, 0xb8, 0xef, 0x83, 0x55, 0x26, 0xe3, 0x5c, 0x6c, 0xf7, 0x6f, 0x9a, 0x70, 0xf1, 0x23,
+	0x2f, 0x0c, 0x90, 0x39, 0x76, 0x13, 0xe6, 0xb3, 0x08, 0xdd, 0x94, 0xa6, 0xd3, 0x24, 0x78, 0xab,
+	0x81, 0xaf, 0x3d, 0xb4, 0x0f, 0xfc, 0xdc, 0x86, 0x2f, 0x8c, 0xe2, 0x94, 0xb0, 0xde, 0x24, 0xcf,
+	0x96, 0x61, 0x90, 0xa6, 0x78, 0xfe, 0xeb, 0x1d, 0xb3, 0x24, 0x38, 0x0c, 0x98, 0x2f, 0x0c, 0xfd,
+	0xeb, 0x5a, 0xde, 0x47, 0x22, 0x8b, 0xb4, 0x6b, 0xe6, 0xf1, 0x27, 0x61, 0x6d, 0x97, 0xbe, 0xb1,
+	0x71, 0x92, 0x70, 0x24, 0xd8, 0xda, 0x2e, 0x4f, 0x20, 0x91, 0x52, 0x28, 0xca, 0xcb, 0x64, 0x99,
+	0x26, 0xb7, 0xcd, 0x51, 0xef, 0xe4, 0x28, 0xc8, 0x58, 0x18, 0xa4, 0x99, 0xf0, 0xf6, 0x5e, 0x08,
+	0x46, 0x1f, 0x4b, 0x10, 0x55, 0xf7, 0x12, 0x94, 0xc6, 0xa9, 0x9c, 0x27, 0x99, 0xb6, 0xde, 0x86,
+	0x4d, 0xf3, 0x19, 0xad, 0xb0, 0x90, 0x8b, 0xa7, 0xb4, 0x1b, 0x46, 0xa6, 0x30, 0x73, 0x3b, 0xdf,
+	0x32, 0x4c, 0x4a, 0x0f, 0xbc, 0x6c, 0xca, 0x0b, 0x3b, 0xbc, 0xf1, 0xdf, 0x2a, 0x54, 0x93, 0x0e,
+	0xf3, 0x93, 0x26, 0x62, 0x1b, 0xe6, 0xe9, 0xe4, 0x35, 0x4c, 0xa5, 0x0a, 0x82, 0xc9, 0x87, 0x74,
+	0x19, 0x35, 0x64, 0x7e, 0xe0, 0x45, 0xbd, 0xa1, 0xda, 0x5d, 0x38, 0xc0, 0xb0, 0x9b, 0xb4, 0x74,
+	0xbb, 0x09, 0xc6, 0x3e, 0xf0, 0x86, 0xa3, 0x50, 0xec, 0x29, 0x33, 0xae, 0x4c, 0x6a, 0x76, 0x19,
+	0xa1, 0xb6, 0xf1, 0x54, 0xe9, 0xe0, 0x27, 0x36, 0xcc, 0xc2, 0x1b, 0x3e, 0xcd, 0x34, 0xd5, 0x2e,
+	0x98, 0xa6, 0x9c, 0x4f, 0x48, 0x53, 0x2a, 0x0d, 0x98, 0xe0, 0xc1, 0xf7, 0xca, 0x46, 0xb8, 0x2b,
+	0x45, 0x23, 0x9c, 0x39, 0x5a, 0xba, 0x31, 0xee, 0x0f, 0x1b, 0x14, 0x3a, 0x62, 0x18, 0x64, 0x8f,
+	0x13, 0x2f, 0x4a, 0x0f, 0x73, 0xd7, 0xa3, 0x17, 0x60, 0x09, 0xbd, 0x7f, 0x7b, 0x85, 0x71, 0x5d,
+	0x44, 0xa0, 0x6c, 0x97, 0x3f, 0x6a, 0xeb, 0x15, 0xae, 0x80, 0x20, 0x8b, 0xef, 0x6a, 0xd6, 0xbb,
+	0x67, 0xd1, 0x4c, 0x22, 0x96, 0x9d, 0xc4, 0xc9, 0x13, 0x79, 0xc8, 0x14, 0x49, 0xfd, 0xc2, 0x73,
+	0x6e, 0xe2, 0x85, 0xe7, 0x7c, 0xf1, 0xc2, 0xd3, 0xf9, 0x7b, 0x2d, 0x58, 0x91, 0x5d, 0x94, 0x8e,
+	0xc2, 0xc5, 0x27, 0x81, 0xa5, 0x2e, 0x37, 0xcf, 0xee, 0xf2, 0xcc, 0xc4, 0x2e, 0xb7, 0x6a, 0xbb,
+	0x3c, 0x5b, 0xd7, 0xe5, 0xb9, 0xda, 0x2e, 0xcf, 0x4f, 0xec, 0x72, 0xbb, 0xea, 0x8e, 0xb7, 0xd2,
+	0x1b, 0x98, 0x6e, 0x49, 0xa5, 0x96, 0x84, 0xb7, 0xd5, 0x20, 0x6f, 0x49, 0x25, 0xf0, 0xbe, 0x6f,
+	0xad, 0xc3, 0x6c, 0xf6, 0xb4, 0x17, 0x70, 0xc5, 0x04, 0xf5, 0xcc, 0xa7, 0xfc, 0x16, 0xfb, 0x90,
+	0x49, 0x8f, 0x60, 0xfc, 0xa4, 0x21, 0x63, 0xac, 0xc7, 0xd2, 0x2c, 0x18, 0x12, 0x7b, 0x2f, 0xf1,
+	0x00, 0x03, 0x87, 0x8c, 0xdd, 0x95, 0x30, 0xae, 0x27, 0xf5, 0x59, 0x70, 0xcc, 0xfc, 0xee, 0xb2,
+	0xd4, 0x93, 0x78, 0x3a, 0x17, 0x88, 0x2b, 0xba, 0x40, 0x44, 0x9d, 0x2b, 0x61, 0xd4, 0x20, 0xbf,
+	0xe4, 0x95, 0x49, 0xcc, 0x91, 0x2b, 0x89, 0x5f, 0xee, 0xca, 0x24, 0xfa, 0x0e, 0x79, 0x23, 0x74,
+	0x93, 0xf7, 0xc2, 0x9e, 0x70, 0x02, 0xed, 0x5a, 0xfc, 0xb2, 0x5e, 0xc2, 0xef, 0x72, 0x30, 0x0d,
+	0x1d, 0x81, 0x98, 0x8f, 0xf7, 0x21, 0xeb, 0x62, 0xe8, 0x04, 0xe8, 0xf6, 0xa9, 0xf3, 0x22, 0xbd,
+	0x27, 0x94, 0xfc, 0x92, 0x96, 0x1d, 0x4b, 0x88, 0x61, 0x9c, 0x87, 0xb0, 0x61, 0x16, 0x13, 0x6b,
+	0xf2, 0x1b, 0xd0, 0xc9, 0x24, 0xb0, 0xdb, 0x30, 0xcf, 0x5d, 0x05, 0x26, 0x74, 0xf3, 0x92, 0xce,
+	0x2b, 0xb0, 0xb5, 0xc3, 0x69, 0x28, 0x2e, 0xc6, 0x22, 0xe2, 0x7f, 0xdf, 0x04, 0x20, 0xa7, 0xc8,
+	0x9d, 0x90, 0x25, 0xd9, 0xb9, 0xbc, 0x9e, 0xa6, 0xbe, 0x06, 0x2c, 0x9c, 0x70, 0x5b, 0xc5, 0x13,
+	0xae, 0xe1, 0x2d, 0x36, 0x5b, 0xf4, 0x16, 0xc3, 0x43, 0xcc, 0x51, 0xc2, 0x52, 0x7a, 0xd2, 0x3a,
+	0x27, 0x8e, 0x47, 0x12, 0x80, 0x1a, 0x8f, 0x3a, 0x51, 0x08, 0x8f, 0x4f, 0xae, 0x75, 0x2f, 0x2b,
+	0x30, 0x75, 0x8f, 0x0e, 0xfe, 0x71, 0xc6, 0x04, 0x77, 0xd3, 0xb7, 0x74, 0x18, 0x3a, 0xe6, 0xef,
+	0xef, 0xdb, 0xae, 0x48, 0x61, 0xa3, 0x59, 0x12, 0xe0, 0x0d, 0x37, 0xc6, 0xdd, 0xd0, 0xfc, 0xdd,
+	0x97, 0x15, 0x98, 0x37, 0xaa, 0x71, 0xd7, 0x82, 0xc1, 0x5d, 0xce, 0x1f, 0x35, 0x60, 0x63, 0xc7,
+	0xe7, 0xc5, 0x68, 0x68, 0x9f, 0xab, 0x81, 0xc5, 0x18, 0xd1, 0xd6, 0xc4, 0x11, 0x9d, 0x9d, 0x62,
+	0x44, 0xe7, 0x26, 0x8e, 0xe8, 0x7c, 0x3e, 0xa2, 0xce, 0xb7, 0xc9, 0xde, 0x9c, 0xf7, 0x5a, 0x31,
+	0x3c, 0x2e, 0x14, 0x1a, 0x5c, 0x7c, 0xf8, 0x76, 0x2a, 0xfc, 0x16, 0x80, 0x83, 0x1e, 0x45, 0x21,
+	0x5e, 0x92, 0x6d, 0x15, 0x6b, 0xe6, 0xd7, 0x81, 0x1e, 0x41, 0x8a, 0xd7, 0x81, 0xda, 0xe0, 0x8a,
+	0x12, 0xce, 0x0d, 0xd8, 0x16, 0x0f, 0x86, 0x4a, 0x03, 0x5f, 0xf4, 0xe5, 0xb2, 0xa1, 0x5b, 0x2e,
+	0xca, 0x51, 0x3a, 0x7f, 0x30, 0x03, 0xd6, 0xe3, 0xc4, 0x0b, 0xf0, 0x0d, 0xcf, 0x7e, 0x16, 0x8f,
+	0x44, 0xb0, 0xb1, 0x49, 0x47, 0xcf, 0x4a, 0xdd, 0x0f, 0x2f, 0x83, 0xd0, 0xe8, 0xdb, 0x3b, 0xf1,
+	0x32, 0x96, 0xf4, 0x86, 0x5e, 0xf2, 0x44, 0xe8, 0x07, 0x4b, 0x08, 0xfe, 0x18, 0xa1, 0x0f, 0xbd,
+	0xe4, 0x09, 0x1d, 0x4f, 0x13, 0xef, 0xc4, 0x8f, 0x4f, 0xe4, 0xdd, 0x9c, 0x4a, 0xa3, 0x38, 0x92,
+	0xdf, 0x3d, 0xe1, 0x9a, 0x2c, 0x5d, 0x19, 0x25, 0x7c, 0x8f, 0x83, 0xad, 0x7b, 0xfa, 0x16, 0x3e,
+	0x67, 0x1e, 0xf3, 0xca, 0xfd, 0x51, 0xbb, 0xba, 0x0a, 0x77, 0x24, 0xd3, 0x86, 0x4e, 0x3d, 0x6f,
+	0xea, 0xd4, 0xc4, 0x3e, 0x72, 0x19, 0xd0, 0x72, 0x6a, 0xbb, 0x39, 0x00, 0xb5, 0x94, 0x7c, 0xed,
+	0x78, 0x99, 0xd8, 0x31, 0x16, 0x14, 0x6c, 0x87, 0x14, 0x02, 0xee, 0x12, 0xdb, 0x3b, 0xf2, 0x42,
+	0x5c, 0x3b, 0x5c, 0xc9, 0xe3, 0x6e, 0xaf, 0xe9, 0x07, 0x04, 0xd3, 0xc5, 0xf3, 0x82, 0x21, 0x9e,
+	0xd1, 0x2f, 0xcd, 0x24, 0xfc, 0x5c, 0x9a, 0x38, 0x8f, 0x40, 0xa4, 0x0f, 0x86, 0x74, 0x64, 0x25,
+	0x86, 0x48, 0xab, 0xf3, 0xfe, 0x4f, 0x13, 0x96, 0x1f, 0x7a, 0xc9, 0x20, 0x88, 0xf6, 0xe2, 0x34,
+	0xc8, 0x5d, 0x2b, 0xbf, 0x62, 0x07, 0x0a, 0xee, 0xf0, 0xfc, 0xb9, 0xf4, 0x62, 0xa7, 0x6f, 0x83,
+	0x0b, 0x67, 0xcb, 0x6a, 0xc1, 0x90, 0xc8, 0x94, 0xb7, 0xb6, 0x3c, 0x65, 0x7d, 0x1d, 0xac, 0xa1,
+	0x17, 0x44, 0x19, 0x8b, 0xf0, 0xa0, 0xd8, 0x13, 0x65, 0xb8, 0xa4, 0x5c, 0xd3, 0x72, 0x78, 0x1f,
+	0x71, 0x12, 0x79, 0x11, 0x7c, 0x49, 0x15, 0xc4, 0xc2, 0x5c, 0xb1, 0xc0, 0x61, 0x2e, 0x82, 0xb0,
+	0x8b, 0xc8, 0xce, 0x42, 0x42, 0x70, 0xa3, 0x45, 0x07, 0x21, 0x5c, 0x38, 0xbc, 0x06, 0x6b, 0x61,
+	0xf0, 0xd9, 0x18, 0x0f, 0x3c, 0xe4, 0x1a, 0xaa, 0x09, 0xd1, 0x55, 0x2d, 0x83, 0x17, 0x46, 0x13,
+	0x67, 0x1a, 0x87, 0x6a, 0xb2, 0xdb, 0xae, 0x4a, 0x3b, 0x17, 0x49, 0xc9, 0x37, 0xc7, 0x5e, 0xf9,
+	0x1e, 0xbb, 0x60, 0x57, 0x65, 0xe6, 0xb7, 0xca, 0x23, 0x09, 0x2c, 0xde, 0x2a, 0x9b, 0x75, 0xdc,
+	0xbc, 0xa0, 0xf3, 0xf3, 0x06, 0x05, 0xbd, 0xdb, 0x49, 0x0e, 0x82, 0x2c, 0xf1, 0x06, 0xec, 0x11,
+	0x1d, 0x36, 0xc6, 0x51, 0x90, 0x05, 0xb9, 0x63, 0xc1, 0xa5, 0xa2, 0xae, 0xac, 0x47, 0xc6, 0xc2,
+	0xe7, 0x81, 0xc3, 0x00, 0x3b, 0x1d, 0x1f, 0x06, 0x99, 0x5a, 0xb3, 0x9c, 0x15, 0x57, 0x87, 0x41,
+	0xb4, 0x47, 0x19, 0x72, 0xd1, 0x4a, 0x3b, 0xdc, 0x4c, 0x6e, 0x87, 0x73, 0xfe, 0x41, 0x03, 0x16,
+	0x15, 0x05, 0x0f, 0xd8, 0xe0, 0x2b, 0x7c, 0x0c, 0xa4, 0xbc, 0xb1, 0x5b, 0xd5, 0x4f, 0xba, 0x4c,
+	0xfd, 0xf2, 0x02, 0xb4, 0x51, 0x4d, 0xa3, 0xfb, 0x98, 0x39, 0x61, 0xde, 0x62, 0xfc, 0x59, 0xde,
+	0x3f, 0x6f, 0xc2, 0x46, 0xc5, 0xa8, 0x9d, 0xaa, 0x0e, 0x36, 0xf2, 0x0e, 0x22, 0xcd, 0x21, 0x1b,
+	0xc8, 0x7b, 0x57, 0x45, 0xb3, 0xde, 0x67, 0x97, 0x4a, 0x3c, 0x93, 0xe2, 0x8f, 0x96, 0x6f, 0x1a,
+	0x63, 0x49, 0x3d, 0x4f, 0xe1, 0xc3, 0x8f, 0x41, 0x12, 0xa7, 0x69, 0x71, 0x6a, 0x78, 0x4f, 0x2c,
+	0xca, 0x33, 0x27, 0xe7, 0x75, 0xb0, 0x22, 0x96, 0x15, 0xcb, 0xf3, 0x85, 0xb3, 0x1a, 0xe1, 0x86,
+	0xa5, 0x97, 0x26, 0xe1, 0xc7, 0x15, 0xae, 0x1e, 0xea, 0xb7, 0x62, 0xdd, 0x48, 0xd8, 0xfb, 0x8c,
+	0x71, 0x43, 0x64, 0xc6, 0x03, 0x2e, 0x72, 0xd9, 0xa8, 0xd2, 0xce, 0x80, 0xae, 0xb5, 0xeb, 0x38,
+	0x4f, 0x70, 0xf5, 0x6d, 0x58, 0x8a, 0xf5, 0x8c, 0xe2, 0x33, 0xc7, 0xaa, 0x29, 0x70, 0xcd, 0x2a,
+	0xc8, 0xe3, 0x68, 0x05, 0x7b, 0x90, 0x2f, 0xc4, 0x3f, 0x06, 0xcf, 0xa6, 0xff, 0xd8, 0x80, 0x75,
+	0x8d, 0x82, 0xe7, 0x7b, 0xab, 0x52, 0xf1, 0x74, 0x26, 0x5f, 0x09, 0xb3, 0xd5, 0x2b, 0x61, 0xce,
+	0xe0, 0x31, 0xc3, 0xb8, 0xce, 0xaf, 0x9d, 0x72, 0x80, 0xf3, 0x4f, 0x1a, 0xb4, 0xcf, 0xa0, 0xed,
+	0xff, 0x7e, 0x94, 0xb1, 0x84, 0xa5, 0xd9, 0xff, 0x27, 0xf7, 0xaf, 0x7f, 0xa3, 0x01, 0x8b, 0x3a,
+	0xd9, 0x93, 0x82, 0x26, 0x55, 0x68, 0x3c, 0x93, 0x96, 0xeb, 0x2b, 0xb0, 0x1a, 0xc6, 0x18, 0x43,
+	0xee, 0x28, 0x4e, 0x32, 0xb1, 0xb5, 0xf0, 0x85, 0xbb, 0x8c, 0xf0, 0x7d, 0x04, 0xf3, 0xdd, 0xc5,
+	0x18, 0xdc, 0xd9, 0xe2, 0x55, 0xed, 0x3f, 0xe5, 0xb1, 0x02, 0xcd, 0xc1, 0x7d, 0x9e, 0xdc, 0xf3,
+	0x2e, 0xae, 0x41, 0x16, 0xf5, 0x02, 0x81, 0x5d, 0x58, 0x75, 0xf3, 0x07, 0xa3, 0x3a, 0x65, 0x8b,
+	0xb1, 0x96, 0x72, 0xde, 0xa3, 0xfd, 0x6c, 0x5f, 0xbc, 0x80, 0x7c, 0xc0, 0xfc, 0x81, 0x76, 0x2c,
+	0x2c, 0x3c, 0x97, 0x6c, 0x14, 0x9f, 0x4b, 0x3a, 0xbf, 0x0a, 0x2b, 0xb2, 0xea, 0x34, 0xf7, 0x21,
+	0xea, 0x6e, 0xb8, 0xa9, 0xdf, 0x0d, 0xd3, 0x29, 0x3a, 0x65, 0x09, 0x9e, 0xa2, 0x67, 0xe4, 0x29,
+	0x9a, 0xa7, 0x71, 0xe0, 0x55, 0x08, 0x42, 0x31, 0x37, 0x39, 0x00, 0xe5, 0xc6, 0xb2, 0x49, 0xfa,
+	0x99, 0x24, 0x4f, 0x3c, 0x42, 0xbe, 0xad, 0x59, 0xb9, 0x67, 0xcc, 0xd3, 0x6d, 0xa1, 0x9b, 0xb9,
+	0x91, 0xdb, 0xf9, 0x11, 0x6d, 0xfa, 0xa5, 0x11, 0x14, 0xf3, 0xff, 0x06, 0xcc, 0x87, 0x1c, 0x54,
+	0xdc, 0xf2, 0xcd, 0x1a, 0xae, 0x2c, 0x26, 0x42, 0xfe, 0xdc, 0x7d, 0x3a, 0x8a, 0xd3, 0x71, 0x92,
+	0x3f, 0x6c, 0xff, 0x9d, 0x06, 0xb4, 0x25, 0x70, 0xe2, 0x20, 0xa3, 0x28, 0x19, 0xc5, 0x72, 0x7f,
+	0xa7, 0x6f, 0x7e, 0xb7, 0x95, 0x04, 0xc7, 0x1e, 0x9e, 0x6f, 0xa4, 0x4d, 0x50, 0x07, 0xa1, 0xce,
+	0x1a, 0x31, 0xb9, 0x6f, 0xe1, 0x27, 0xae, 0xb3, 0x23, 0x24, 0x49, 0x9a, 0x62, 0x45, 0x0a, 0xe1,
+	0xe2, 0x09, 0xa0, 0x10, 0x40, 0x3c, 0xe5, 0xbc, 0x2f, 0xa2, 0x76, 0x2a, 0xba, 0xc5, 0x08, 0xdc,
+	0x44, 0xdd, 0x44, 0x00, 0xc5, 0x18, 0xac, 0xe6, 0x76, 0x3c, 0x9e, 0xe1, 0xe6, 0x45, 0x9c, 0x7f,
+	0xd9, 0x00, 0xeb, 0x61, 0xec, 0x07, 0x87, 0xa7, 0x5f, 0xc2, 0x63, 0xe6, 0x2f, 0xcf, 0x2a, 0x70,
+	0x2e, 0x69, 0x8c, 0xaf, 0xd0, 0xd6, 0x8d, 0x4e, 0xa4, 0x79, 0x2c, 0x52, 0x49, 0x69, 0xc3, 0xa4,
+	0x94, 0x5f, 0xba, 0xf1, 0x97, 0xbb, 0xdc, 0xb4, 0xae, 0xd2, 0xe6, 0xa3, 0xdb, 0x99, 0xc2, 0xa3,
+	0xe6, 0xf2, 0xc3, 0xdd, 0x56, 0xd5, 0xc3, 0x5d, 0x0a, 0x12, 0xc1, 0xdb, 0xeb, 0x49, 0x1a, 0xf8,
+	0x65, 0x0e, 0x05, 0x89, 0xe0, 0x39, 0x8f, 0x38, 0x31, 0xa9, 0xf3, 0xb7, 0x1b, 0x00, 0xee, 0xde,
+	0xee, 0x3e, 0x23, 0xf3, 0x7c, 0xc9, 0xa2, 0x48, 0x37, 0xfd, 0x19, 0x4b, 0x0e, 0xbd, 0xbe, 0x3a,
+	0x50, 0x28, 0xc0, 0x84, 0x80, 0x4e, 0x5a, 0x88, 0x5d, 0x4e, 0xa4, 0x4c, 0x62, 0x17, 0xc9, 0x56,
+	0x9c, 0x32, 0x26, 0xad, 0x2e, 0x6d, 0x04, 0xec, 0x33, 0x16, 0x19, 0x7e, 0x83, 0xdc, 0x0a, 0xad,
+	0xd2, 0xce, 0x36, 0x9d, 0xf1, 0x73, 0x5a, 0xd5, 0x8a, 0xf9, 0xc7, 0x0d, 0xd8, 0x2a, 0xe6, 0x28,
+	0x9e, 0x6c, 0xa7, 0x02, 0x56, 0x3c, 0xc5, 0xe7, 0xc5, 0x5d, 0x55, 0x86, 0xcc, 0x05, 0x61, 0x18,
+	0x9f, 0x30, 0xbf, 0x17, 0x8c, 0xb8, 0x96, 0x88, 0x76, 0x35, 0x0e, 0xba, 0x3f, 0x4a, 0xf9, 0x09,
+	0xe5, 0x69, 0x4f, 0x35, 0xca, 0xdd, 0x72, 0x17, 0x86, 0xde, 0x53, 0x89, 0x1b, 0x0d, 0x19, 0x22,
+	0x5b, 0xbd, 0x12, 0xe7, 0x43, 0xb0, 0x2c, 0xc0, 0xe2, 0x85, 0x38, 0x37, 0x1a, 0x1c, 0xc7, 0x4f,
+	0x98, 0x46, 0x4a, 0x8d, 0xb9, 0xec, 0x3e, 0xf5, 0xfd, 0x8e, 0x97, 0x79, 0x3f, 0x1e, 0x7b, 0x21,
+	0x6a, 0x56, 0xd3, 0x3d, 0x39, 0xa0, 0xf7, 0x89, 0xf2, 0x02, 0x87, 0x12, 0xce, 0x7d, 0xe8, 0x70,
+	0x77, 0xde, 0x7b, 0xde, 0x68, 0xaa, 0x68, 0x19, 0x5d, 0x98, 0xa7, 0xcb, 0x9b, 0x68, 0x20, 0x7a,
+	0x2b, 0x93, 0xce, 0xbf, 0x6e, 0xd2, 0x1b, 0x58, 0x3f, 0x64, 0xdc, 0x05, 0x43, 0xd0, 0xf6, 0x45,
+	0x8e, 0xa8, 0x96, 0xb6, 0x8c, 0x3b, 0x62, 0xc1, 0x4e, 0xf2, 0x44, 0x91, 0x1d, 0x98, 0x2d, 0x75,
+	0x60, 0x4e, 0x75, 0x80, 0xc8, 0x19, 0x71, 0xd5, 0x97, 0x2b, 0x4c, 0x2a, 0xad, 0xf9, 0x2b, 0xb5,
+	0x75, 0x7f, 0x25, 0xbc, 0xdb, 0x3e, 0xf0, 0xfa, 0x4f, 0xf0, 0xad, 0xbf, 0x50, 0x98, 0x67, 0x5c,
+	0x0d, 0x62, 0xbd, 0x08, 0xad, 0x81, 0x37, 0x4a, 0xbb, 0x40, 0x4c, 0xb5, 0x66, 0xfa, 0x4e, 0xdf,
+	0xf3, 0x46, 0x2e, 0x65, 0xe7, 0xc6, 0xe1, 0x85, 0xa2, 0x71, 0x18, 0xe7, 0x42, 0x78, 0xd1, 0x75,
+	0x5c, 0x99, 0x74, 0x1e, 0xc2, 0x56, 0x71, 0x9e, 0x05, 0x27, 0xbf, 0xad, 0xdc, 0x60, 0x1a, 0x66,
+	0xa4, 0xbf, 0x8a, 0x09, 0x90, 0xae, 0x30, 0xce, 0x53, 0x80, 0xdc, 0x77, 0xbd, 0xf2, 0x3c, 0x74,
+	0x05, 0x20, 0xa0, 0x5b, 0xc1, 0xc3, 0x80, 0xc9, 0xc0, 0x90, 0x1a, 0x84, 0x26, 0x9f, 0xa5, 0xa9,
+	0xa7, 0x2e, 0x0a, 0x64, 0xf2, 0x0c, 0xaf, 0xb6, 0x03, 0xe8, 0xdc, 0xdb, 0x7d, 0xbc, 0x4f, 0x2e,
+	0x15, 0x88, 0xf8, 0xc3, 0x0f, 0xef, 0xdf, 0x91, 0x88, 0xf1, 0x5b, 0x39, 0x44, 0x35, 0x35, 0x87,
+	0x28, 0x9a, 0xfc, 0xec, 0x28, 0x9f, 0xfc, 0xec, 0x08, 0x05, 0x69, 0xc4, 0x9e, 0x66, 0xbd, 0x64,
+	0x2c, 0x2d, 0x8a, 0xf3, 0x98, 0x76, 0xc7, 0x91, 0x73, 0x07, 0xb6, 0x15, 0x8e, 0xbb, 0xfc, 0x75,
+	0xa9, 0x5c, 0x16, 0x37, 0x60, 0x8e, 0xbb, 0x73, 0x88, 0xf0, 0x98, 0x6a, 0x82, 0x54, 0x05, 0x57,
+	0x14, 0x70, 0x76, 0x60, 0x43, 0x01, 0x35, 0xd3, 0xcb, 0x79, 0x9a, 0xb8, 0x00, 0xdb, 0x46, 0x13,
+	0x3b, 0xa1, 0x7c, 0x7d, 0x44, 0x66, 0x9f, 0x3c, 0x0b, 0xad, 0x5f, 0x32, 0x47, 0xaf, 0xf4, 0x20,
+	0x48, 0x33, 0xad, 0xd2, 0xdf, 0x69, 0x68, 0xb5, 0x3e, 0x1c, 0x85, 0xb1, 0xe7, 0xeb, 0x9a, 0x1a,
+	0x81, 0x7b, 0x9a, 0x3b, 0x19, 0x70, 0x10, 0xbd, 0x61, 0xcb, 0x0b, 0x50, 0xac, 0xc3, 0xa6, 0x5e,
+	0x00, 0xf9, 0x4a, 0x45, 0x41, 0x9c, 0xc9, 0xa3, 0x20, 0xe2, 0x0a, 0xf1, 0x92, 0xfe, 0x11, 0xdd,
+	0x6f, 0xf0, 0x2b, 0x5b, 0x95, 0xc6, 0x79, 0x8e, 0x8f, 0x59, 0x72, 0x92, 0x04, 0x42, 0x67, 0x6f,
+	0xbb, 0x39, 0xc0, 0xb9, 0x07, 0x76, 0x3e, 0x1e, 0xcc, 0xf3, 0xe5, 0xd7, 0xb9, 0xc7, 0xf0, 0x36,
+	0x6c, 0x2a, 0xe0, 0x8f, 0xc7, 0x2c, 0x39, 0x7d, 0x86, 0x36, 0x7e, 0x00, 0x5d, 0x05, 0xdc, 0x19,
+	0x67, 0xf1, 0x03, 0x6d, 0xe0, 0xb6, 0x8c, 0x66, 0x3a, 0xb2, 0x8e, 0x76, 0xc7, 0xc4, 0xa5, 0xa4,
+	0x48, 0x39, 0x9f, 0x1a, 0x73, 0xca, 0x27, 0x2e, 0x7f, 0x6b, 0xa7, 0x62, 0xe0, 0xeb, 0xd7, 0x52,
+	0xaf, 0xc1, 0x3c, 0x6f, 0x54, 0x9a, 0x17, 0x2a, 0x48, 0x95, 0x25, 0x9c, 0x18, 0xb6, 0x8a, 0xfd,
+	0x3d, 0xa3, 0xf9, 0x7c, 0x20, 0x9a, 0x67, 0x0c, 0x84, 0x31, 0xc7, 0x1d, 0x11, 0xe9, 0xf2, 0x7d,
+	0x6d, 0x70, 0x44, 0x14, 0xf7, 0x33, 0x51, 0xca, 0x76, 0x9a, 0x79, 0x3b, 0x6f, 0xfd, 0x95, 0x3d,
+	0x58, 0xbe, 0x17, 0xf3, 0x27, 0xaf, 0x8f, 0x13, 0xcf, 0x67, 0x89, 0xf5, 0x08, 0xe6, 0xc5, 0xff,
+	0x5d, 0x58, 0x5b, 0xa5, 0x3f, 0xc0, 0xa0, 0xe1, 0xb7, 0xb7, 0x6b, 0xfe, 0x18, 0xc3, 0x59, 0xff,
+	0xf9, 0xbf, 0xfd, 0xcf, 0xbf, 0xd9, 0x5c, 0xb2, 0x16, 0x6e, 0x1d, 0xbf, 0x79, 0x6b, 0xc0, 0x32,
+	0x7a, 0x52, 0x38, 0x80, 0x25, 0xe3, 0x2f, 0x0a, 0xac, 0x4b, 0xc6, 0xdf, 0x0c, 0x14, 0xfe, 0xb9,
+	0xc0, 0xbe, 0x3c, 0xf1, 0x4f, 0x08, 0x9c, 0x0b, 0x84, 0x62, 0xdd, 0x5a, 0x13, 0x28, 0xf2, 0x7f,
+	0x1f, 0xb0, 0x3e, 0x83, 0x95, 0xbb, 0x14, 0x75, 0x4b, 0x35, 0x6a, 0x5d, 0xcd, 0x1b, 0xab, 0xfc,
+	0xe7, 0x05, 0xfb, 0x5a, 0x7d, 0x01, 0x81, 0xf0, 0x22, 0x21, 0xdc, 0xb4, 0xd6, 0x11, 0x21, 0x8f,
+	0xea, 0xa5, 0x70, 0x5a, 0x29, 0xac, 0x8a, 0x58, 0xee, 0x5f, 0x2a, 0xce, 0x4b, 0x84, 0x73, 0xcb,
+	0xda, 0x40, 0x9c, 0x7e, 0x90, 0x9a, 0x48, 0x63, 0x0a, 0x1a, 0xa4, 0xff, 0xf7, 0x80, 0x75, 0xa5,
+	0xf6, 0x4f, 0x09, 0x38, 0xca, 0xab, 0x67, 0xfc, 0x69, 0x81, 0xd9, 0xcb, 0x01, 0xc3, 0xb2, 0xea,
+	0xbd, 0x88, 0xf5, 0x9b, 0xfc, 0x61, 0x63, 0xe5, 0xbf, 0x64, 0x58, 0x2f, 0x9f, 0xfd, 0xd7, 0x1c,
+	0x9c, 0x86, 0x57, 0xa6, 0xfd, 0x0f, 0x0f, 0xe7, 0x6b, 0x44, 0xcc, 0x15, 0xeb, 0x92, 0x20, 0xc6,
+	0xf8, 0xdf, 0x0e, 0xf9, 0xcf, 0x20, 0x56, 0x1f, 0x16, 0xf5, 0x3f, 0x1c, 0xb0, 0x2e, 0x56, 0xbc,
+	0xa3, 0x54, 0xc8, 0x2f, 0x55, 0x67, 0x0a, 0x84, 0x5d, 0x42, 0x68, 0x59, 0xab, 0x02, 0x61, 0x6e,
+	0x85, 0xfd, 0x1c, 0x56, 0x0a, 0xc1, 0xfa, 0x2d, 0xa7, 0x30, 0x7d, 0x15, 0x7f, 0xbc, 0x60, 0xbf,
+	0x30, 0xb1, 0x8c, 0xc0, 0x7a, 0x85, 0xb0, 0x76, 0xbf, 0xd3, 0x78, 0xd5, 0x59, 0xd7, 0x26, 0x5a,
+	0x22, 0xb7, 0x52, 0x9a, 0x67, 0x3d, 0xae, 0xfc, 0x54, 0xb8, 0xaf, 0x9e, 0x11, 0x94, 0xbe, 0x34,
+	0xd7, 0x12, 0x21, 0xad, 0xd6, 0x14, 0x2c, 0xad, 0xde, 0xa3, 0xc7, 0x7b, 0xf4, 0x94, 0x79, 0x1a,
+	0xbc, 0x97, 0xab, 0xff, 0x4d, 0x41, 0xfc, 0xa1, 0x83, 0x63, 0x13, 0xd6, 0x0d, 0xcb, 0x2a, 0x60,
+	0x8d, 0xb3, 0x91, 0x95, 0xc2, 0x7a, 0x19, 0xa9, 0xc9, 0xd5, 0x15, 0x7f, 0xf7, 0x60, 0x5f, 0xad,
+	0xcd, 0x3f, 0xa3, 0xa7, 0x71, 0x36, 0x4a, 0xad, 0xa7, 0xf8, 0x08, 0xea, 0xab, 0x99, 0xd9, 0xcb,
+	0x84, 0x77, 0x1b, 0x67, 0xd6, 0xca, 0xc5, 0x86, 0x9a, 0xd8, 0x8f, 0xa1, 0xa3, 0x5e, 0x83, 0x5a,
+	0x5d, 0xad, 0x13, 0x46, 0xe4, 0x7d, 0xbb, 0x26, 0xae, 0xba, 0xe4, 0x56, 0x6c, 0x7d, 0x49, 0x74,
+	0x8c, 0x07, 0x4a, 0xb7, 0x7e, 0x02, 0xa0, 0x5a, 0x49, 0xad, 0x0b, 0xa5, 0x96, 0xd5, 0xc8, 0xd9,
+	0x55, 0x59, 0xf2, 0x2f, 0x65, 0xa8, 0xf9, 0x55, 0x6b, 0xd9, 0x68, 0x5b, 0xae, 0x37, 0xf5, 0xf8,
+	0xd5, 0x58, 0x6f, 0xc5, 0xd0, 0xec, 0x76, 0x7d, 0x4c, 0x6e, 0x39, 0x29, 0x48, 0xbe, 0x5c, 0x6f,
+	0x2a, 0x9a, 0x8a, 0xd8, 0x2c, 0x54, 0x25, 0x73, 0xb3, 0x28, 0x05, 0x0e, 0xb7, 0x2f, 0xd7, 0xe4,
+	0xd6, 0x6c, 0x16, 0x71, 0xde, 0xee, 0x13, 0x58, 0xce, 0x3d, 0x06, 0x69, 0x6d, 0x5d, 0x2e, 0x7b,
+	0x12, 0xea, 0x9b, 0xde, 0x95, 0xba, 0xec, 0xb4, 0x9a, 0xbf, 0x45, 0xb4, 0x05, 0x5a, 0x54, 0xa7,
+	0xfc, 0x01, 0x6d, 0x5e, 0x8b, 0x5b, 0xca, 0xbf, 0x28, 0xca, 0x6b, 0x84, 0xd2, 0xb6, 0xba, 0x65,
+	0x94, 0x29, 0x21, 0x78, 0xa3, 0x21, 0x78, 0x8d, 0x07, 0xcf, 0x36, 0x78, 0xcd, 0x88, 0xb1, 0x6d,
+	0x5f, 0xa8, 0xc8, 0x11, 0x58, 0x36, 0x09, 0xcb, 0x8a, 0xb5, 0xa4, 0xa4, 0x31, 0xb5, 0xc5, 0xd9,
+	0x41, 0xc5, 0xd4, 0x34, 0xd8, 0xa1, 0x18, 0xfa, 0xda, 0xbe, 0x54, 0x9d, 0x59, 0x23, 0x7e, 0x55,
+	0x88, 0x6b, 0xeb, 0xd7, 0xcc, 0x48, 0xda, 0xd2, 0xc1, 0xce, 0x99, 0x18, 0x53, 0xb6, 0xb4, 0x50,
+	0x6b, 0xe3, 0xce, 0x3a, 0x57, 0x09, 0xf3, 0x05, 0x6b, 0xbb, 0x88, 0x59, 0x84, 0xd4, 0xb5, 0x7e,
+	0xde, 0x80, 0xf5, 0x8a, 0xb0, 0xa6, 0x39, 0x05, 0xf5, 0x41, 0x58, 0xed, 0x17, 0x26, 0x96, 0x11,
+	0x14, 0x38, 0x44, 0xc1, 0x25, 0x5c, 0x0d, 0x44, 0x84, 0xe7, 0xfb, 0x8a, 0x08, 0x69, 0x7f, 0xf9,
+	0xf3, 0x0d, 0xd8, 0xaa, 0x0e, 0x61, 0x6a, 0x29, 0xb7, 0xe9, 0x89, 0xc1, 0x55, 0xed, 0x97, 0xce,
+	0x2a, 0x26, 0xa8, 0x79, 0x91, 0xa8, 0xb9, 0x8a, 0xd4, 0xd8, 0x48, 0x4d, 0x42, 0xc5, 0x4b, 0x04,
+	0x9d, 0x50, 0xb0, 0x23, 0x33, 0x48, 0xa8, 0xa5, 0xa9, 0x35, 0xd5, 0xb1, 0x54, 0xed, 0xeb, 0x13,
+	0x4a, 0x98, 0x92, 0xd3, 0xda, 0x14, 0x13, 0x42, 0x91, 0x35, 0x55, 0xb4, 0x51, 0x21, 0x1e, 0xf2,
+	0x20, 0x9c, 0x86, 0x78, 0x28, 0xc5, 0x15, 0xb5, 0x2f, 0xd7, 0xe4, 0xd6, 0x88, 0x07, 0x42, 0x96,
+	0x50, 0xbb, 0x9f, 0x40, 0x47, 0x8a, 0x94, 0xd4, 0x58, 0x36, 0x46, 0x18, 0x30, 0xfb, 0x42, 0x45,
+	0x4e, 0xbd, 0x94, 0x16, 0xb1, 0xe9, 0x5c, 0x68, 0xcb, 0xe2, 0xd6, 0x76, 0xb1, 0x01, 0xd9, 0x72,
+	0x65, 0xdc, 0x48, 0x67, 0x9b, 0x1a, 0x5d, 0xc3, 0x46, 0x17, 0xf5, 0x46, 0xad, 0x03, 0x58, 0xd0,
+	0x82, 0x0b, 0x5a, 0x4a, 0xbe, 0x97, 0x43, 0x42, 0xda, 0x17, 0x2b, 0xf3, 0x4c, 0x29, 0x86, 0x08,
+	0x56, 0x10, 0x41, 0x4a, 0x65, 0x38, 0x8e, 0x9f, 0xc2, 0x92, 0x11, 0x9b, 0x2f, 0x1f, 0xfc, 0xaa,
+	0xe8, 0x81, 0xf6, 0xe5, 0x9a, 0x5c, 0x53, 0xc7, 0x45, 0x4c, 0x34, 0xfe, 0xa9, 0x28, 0xc5, 0x71,
+	0x7d, 0x0a, 0x1d, 0x15, 0x12, 0x2f, 0x1f, 0xff, 0x62, 0x94, 0xbc, 0xb3, 0x70, 0x14, 0xe7, 0xe0,
+	0x04, 0xeb, 0x1f, 0x60, 0x93, 0x07, 0xb0, 0xa0, 0x05, 0x7c, 0xcb, 0xc7, 0xab, 0x1c, 0xf5, 0xce,
+	0xbe, 0x58, 0x99, 0x57, 0x33, 0x5e, 0x7d, 0x2a, 0xc3, 0xfb, 0x90, 0xc0, 0x4a, 0x21, 0xd0, 0x5a,
+	0xae, 0xd1, 0x54, 0x87, 0x95, 0xb3, 0xaf, 0xd6, 0xe6, 0xd7, 0xe8, 0x8c, 0x1c, 0x1f, 0xda, 0x34,
+	0x39, 0x02, 0x2e, 0xee, 0x79, 0x18, 0x32, 0x83, 0x6f, 0x8d, 0x78, 0x6b, 0xf6, 0x85, 0x8a, 0x9c,
+	0x1a, 0x71, 0xcf, 0x63, 0x24, 0x58, 0x1f, 0x41, 0x5b, 0xc6, 0xbf, 0xca, 0x99, 0xb6, 0x10, 0xf9,
+	0xcb, 0xee, 0x96, 0x33, 0x44, 0xab, 0x45, 0xc6, 0xf5, 0x7c, 0x9f, 0x1a, 0xc6, 0x89, 0xd0, 0xa2,
+	0x61, 0xe5, 0x13, 0x51, 0x0e, 0xa4, 0x65, 0x5f, 0xac, 0xcc, 0xab, 0x99, 0x08, 0x2e, 0xb9, 0x38,
+	0x8e, 0x7f, 0xc8, 0x9f, 0x7a, 0x4f, 0x0e, 0x66, 0x65, 0xbd, 0x71, 0x8e, 0xb8, 0x57, 0x9c, 0xa0,
+	0x37, 0xcf, 0x1d, 0x29, 0xcb, 0x79, 0x85, 0xc8, 0x74, 0x90, 0xcc, 0xcb, 0x72, 0x3f, 0xa5, 0x9a,
+	0xe2, 0x21, 0x91, 0x8a, 0x9c, 0x65, 0xfd, 0xdd, 0x06, 0xff, 0xaf, 0xc6, 0x09, 0xed, 0x5a, 0x37,
+	0xa7, 0x24, 0x40, 0x12, 0x7c, 0x6b, 0xea, 0xf2, 0x82, 0xdc, 0x97, 0x88, 0xdc, 0x6b, 0x48, 0xee,
+	0xc5, 0x09, 0xe4, 0x5a, 0xbf, 0x02, 0x17, 0x55, 0xd0, 0x2b, 0xa3, 0x5d, 0x7c, 0x71, 0x9a, 0xe6,
+	0x47, 0xe2, 0x9a, 0xc8, 0x58, 0x76, 0xb7, 0x58, 0xa0, 0x76, 0x7f, 0x94, 0x6e, 0xc1, 0x9c, 0x8c,
+	0x43, 0x6a, 0x7e, 0x04, 0x6b, 0xb2, 0x1e, 0xbe, 0xaf, 0xf8, 0xc2, 0x38, 0x85, 0x5e, 0x85, 0x38,
+	0x37, 0x75, 0x9c, 0xf8, 0xfa, 0x84, 0x63, 0x4c, 0x29, 0x86, 0xa1, 0x11, 0xe6, 0x48, 0x3f, 0xf7,
+	0x57, 0x06, 0x40, 0xb2, 0xaf, 0xd5, 0x17, 0xa8, 0x3a, 0xf7, 0x0f, 0x58, 0xc6, 0x23, 0x24, 0xf9,
+	0x02, 0xc1, 0x31, 0xac, 0xee, 0xd7, 0x22, 0xdd, 0x7f, 0x66, 0xa4, 0x42, 0x07, 0xc2, 0xde, 0x12,
+	0xde, 0xb4, 0x88, 0x77, 0x00, 0x0b, 0x5a, 0x28, 0x26, 0x6d, 0x6f, 0x29, 0xc5, 0x67, 0x9a, 0x02,
+	0x5b, 0x69, 0x83, 0x21, 0x6c, 0x14, 0x8d, 0x09, 0x3b, 0x58, 0x8c, 0x81, 0x64, 0x5d, 0xad, 0x8f,
+	0x8e, 0x54, 0x46, 0x59, 0x19, 0x3e, 0xa9, 0xd4, 0x41, 0xed, 0x20, 0x48, 0xff, 0x87, 0x67, 0x9d,
+	0x82, 0x65, 0x9e, 0x04, 0xb1, 0x7e, 0xae, 0xd0, 0x56, 0x44, 0x3e, 0x9a, 0xee, 0x18, 0x78, 0x9d,
+	0x10, 0x5f, 0x44, 0xc4, 0x5b, 0xe5, 0x63, 0x20, 0xe2, 0xb6, 0x7e, 0x06, 0xeb, 0x05, 0xfb, 0xc2,
+	0x97, 0x84, 0xbb, 0xb8, 0x6e, 0x0a, 0xc6, 0x05, 0x42, 0x9e, 0xd1, 0x59, 0xbf, 0x10, 0xce, 0xc8,
+	0xba, 0x5e, 0x75, 0xa6, 0x32, 0x5c, 0x7b, 0x26, 0x9d, 0xee, 0xc4, 0x06, 0x65, 0x6d, 0x95, 0x8e,
+	0x5c, 0xf2, 0x44, 0xf2, 0xe7, 0x1a, 0xc6, 0x0b, 0x92, 0x22, 0xfa, 0x1b, 0x55, 0x87, 0xfa, 0x73,
+	0x93, 0x21, 0x04, 0x97, 0x75, 0xa5, 0x78, 0xf2, 0x2f, 0x91, 0x73, 0x04, 0x2b, 0xea, 0x10, 0x2c,
+	0x48, 0xb8, 0x52, 0x3a, 0x1d, 0x9b, 0x78, 0xeb, 0x0e, 0xe6, 0x45, 0x73, 0x83, 0x38, 0x39, 0x4b,
+	0x4c, 0xbf, 0x6e, 0xfe, 0x3b, 0xa5, 0x81, 0xf2, 0xa5, 0x8a, 0x5e, 0x9f, 0x07, 0xf5, 0x0b, 0x84,
+	0xfa, 0xb2, 0x75, 0xb1, 0xd0, 0xdf, 0x02, 0x09, 0x5c, 0x7f, 0xd6, 0xae, 0x91, 0x74, 0xfd, 0xb9,
+	0x14, 0xe0, 0xc9, 0xbe, 0x5c, 0x93, 0x5b, 0xa3, 0x3f, 0x7b, 0x58, 0x84, 0x6f, 0xb9, 0x19, 0xac,
+	0x16, 0xaf, 0x73, 0xb4, 0xa5, 0x5c, 0x7d, 0xd1, 0x63, 0x5f, 0x2b, 0x15, 0x28, 0xd8, 0xb6, 0x0b,
+	0xc7, 0x83, 0x7e, 0xc6, 0x4d, 0xe4, 0xb7, 0x44, 0x38, 0x52, 0x2b, 0x83, 0x95, 0xc2, 0x55, 0x8b,
+	0x36, 0x97, 0x95, 0x77, 0x30, 0x53, 0xe0, 0x2c, 0x89, 0x0f, 0x85, 0x76, 0xcc, 0x51, 0x3c, 0x85,
+	0xf5, 0x8a, 0x6b, 0x13, 0xed, 0x90, 0x5a, 0x7b, 0xa7, 0x62, 0x97, 0xa9, 0x33, 0xae, 0x0f, 0x4a,
+	0x86, 0xa4, 0x1c, 0x37, 0x3d, 0xb1, 0x1b, 0xc1, 0x4a, 0xe1, 0x5e, 0xa3, 0xa2, 0xbf, 0xc6, 0x4d,
+	0x95, 0x7d, 0xb5, 0x36, 0xbf, 0x72, 0x0f, 0x52, 0xf8, 0xc4, 0x25, 0x42, 0x08, 0xcb, 0x26, 0xa9,
+	0x9a, 0x0d, 0xa3, 0xea, 0xc6, 0xe7, 0xcc, 0x1e, 0x9a, 0x6b, 0x46, 0xa1, 0xfb, 0x8c, 0xda, 0x8e,
+	0x60, 0xc9, 0xb8, 0x8b, 0xd3, 0xd8, 0xb5, 0xe2, 0x96, 0x6f, 0x7a, 0xfe, 0xa9, 0x18, 0xcf, 0x14,
+	0x9b, 0xd7, 0xb9, 0x56, 0xdc, 0xfd, 0x59, 0x57, 0x2b, 0x51, 0xe6, 0x17, 0x7c, 0x5f, 0x1c, 0x6b,
+	0x0a, 0xab, 0xc5, 0xcb, 0xc3, 0x0a, 0xac, 0xe6, 0xb5, 0xe2, 0xd9, 0xf3, 0x78, 0x06, 0x52, 0x12,
+	0x46, 0xc5, 0xfb, 0xb5, 0xc7, 0xf1, 0x60, 0x10, 0x32, 0xab, 0xdc, 0xa3, 0xc2, 0x05, 0xdc, 0x14,
+	0x7d, 0x2e, 0xee, 0x7d, 0x39, 0x7a, 0x6f, 0x9c, 0xc5, 0xb4, 0x6e, 0x7e, 0x06, 0x56, 0x39, 0xa4,
+	0x99, 0xb1, 0xfd, 0x54, 0x47, 0x64, 0xb3, 0x9d, 0x49, 0x45, 0x6a, 0xf6, 0xa1, 0x23, 0x51, 0xae,
+	0x2f, 0xd0, 0x70, 0x13, 0x46, 0x21, 0xf2, 0x57, 0x95, 0x2e, 0x61, 0x44, 0x27, 0xb3, 0xaf, 0x4f,
+	0x28, 0x51, 0x63, 0xc2, 0x90, 0xa2, 0xf8, 0x88, 0xe3, 0xf8, 0x8b, 0x3c, 0xae, 0x4e, 0x75, 0xcc,
+	0xa7, 0xa9, 0x4c, 0xd0, 0xfa, 0x0e, 0x39, 0x39, 0x7c, 0x95, 0xb4, 0xe7, 0x58, 0xf2, 0xac, 0x71,
+	0x22, 0x8b, 0x1b, 0x21, 0x9e, 0xac, 0xdf, 0x6a, 0xc0, 0x85, 0x1d, 0xdf, 0xaf, 0xa1, 0xe9, 0xc5,
+	0x89, 0xe1, 0xa2, 0xd2, 0x67, 0x20, 0xab, 0x78, 0x0a, 0xf2, 0x7c, 0xbf, 0x86, 0xb2, 0xbf, 0xd6,
+	0x80, 0x4b, 0xfc, 0xb8, 0xf7, 0xdc, 0x88, 0x7b, 0x8d, 0x88, 0x7b, 0x11, 0x89, 0xbb, 0x96, 0x9f,
+	0x24, 0x6b, 0xe8, 0xf3, 0xc9, 0x8c, 0xac, 0xc5, 0xc6, 0x32, 0x6c, 0xba, 0xe5, 0x98, 0x59, 0xb6,
+	0xfa, 0xdf, 0x02, 0x23, 0x6e, 0x55, 0xc9, 0x7a, 0xfc, 0x04, 0x73, 0xd5, 0xb6, 0xcd, 0x57, 0x4a,
+	0x21, 0xaa, 0x90, 0xb1, 0x52, 0xaa, 0xc3, 0x34, 0xd9, 0xce, 0xa4, 0x22, 0x35, 0x2b, 0x45, 0x44,
+	0x9a, 0x50, 0xb1, 0x81, 0xfe, 0x14, 0x0f, 0xff, 0x58, 0x8a, 0x60, 0x63, 0xe9, 0x16, 0xd6, 0xba,
+	0x58, 0x40, 0xf6, 0xd7, 0x26, 0x17, 0xaa, 0xb1, 0x64, 0x67, 0xb2, 0xa4, 0x27, 0x91, 0xa1, 0x21,
+	0xb6, 0xe2, 0x89, 0xbf, 0x61, 0x0a, 0xae, 0x09, 0xdf, 0x62, 0xbf, 0x30, 0xb1, 0x4c, 0x8d, 0xc2,
+	0x9c, 0xdb, 0xd3, 0x53, 0x85, 0xec, 0xd7, 0x60, 0xbd, 0xe2, 0x01, 0xfe, 0xf9, 0xee, 0x8d, 0x26,
+	0xbc, 0xe0, 0x37, 0xcd, 0xd1, 0xc7, 0xa2, 0x60, 0x5f, 0xc3, 0xf4, 0x33, 0xe3, 0x76, 0x4e, 0x3c,
+	0xa4, 0xb6, 0xaa, 0x84, 0x92, 0xf9, 0x92, 0xdd, 0x76, 0x26, 0x15, 0xa9, 0x61, 0x04, 0x29, 0xb8,
+	0x42, 0x81, 0x66, 0x00, 0xcb, 0xe6, 0xe3, 0xec, 0x9c, 0xd7, 0x2b, 0x1f, 0x6d, 0xdb, 0x75, 0xaf,
+	0x4c, 0x4b, 0x7b, 0x13, 0xb7, 0x32, 0xca, 0x17, 0x0e, 0xe2, 0x6a, 0x41, 0x56, 0x32, 0x6f, 0x76,
+	0x8b, 0xaf, 0x60, 0xed, 0x4b, 0xd5, 0x99, 0x35, 0x57, 0x0b, 0x99, 0x6a, 0xb4, 0x07, 0x4b, 0xc6,
+	0xdb, 0xca, 0x5c, 0xb7, 0xa8, 0x7a, 0x72, 0x69, 0x57, 0x3c, 0x18, 0x2c, 0x99, 0x30, 0xd1, 0x76,
+	0x8f, 0xb9, 0xf4, 0x8e, 0x50, 0xdc, 0x30, 0xe5, 0xc5, 0x53, 0x43, 0x34, 0x94, 0x9f, 0x37, 0xda,
+	0x57, 0xea, 0xb2, 0x6b, 0x64, 0x44, 0x8e, 0x8b, 0x6c, 0x03, 0xc5, 0x87, 0x88, 0xb9, 0x0e, 0x51,
+	0xf3, 0x9a, 0xd1, 0xbe, 0x56, 0x5f, 0xa0, 0x46, 0xf7, 0x15, 0xf7, 0x01, 0x79, 0x27, 0x7f, 0xca,
+	0x4f, 0x4f, 0xda, 0x6b, 0x37, 0xf3, 0xf4, 0x54, 0x7e, 0x06, 0x97, 0xdf, 0x3d, 0x96, 0x1f, 0x13,
+	0x96, 0x4f, 0x50, 0xa2, 0x88, 0xd0, 0x93, 0xd6, 0x4a, 0x6f, 0xeb, 0x2c, 0xad, 0x0f, 0xe9, 0xf9,
+	0xf1, 0x15, 0x2d, 0x3d, 0x09, 0x4b, 0x0b, 0x48, 0xf9, 0x8a, 0x2b, 0xbc, 0x0e, 0x33, 0x56, 0x5c,
+	0xf5, 0xb3, 0x32, 0xdb, 0x99, 0x54, 0xa4, 0x66, 0xc5, 0xf1, 0xb7, 0x71, 0xea, 0x19, 0x19, 0xed,
+	0xcb, 0xb5, 0x8f, 0x79, 0x2c, 0xdd, 0xa1, 0x62, 0xe2, 0x4b, 0x33, 0xfb, 0xc6, 0x14, 0x25, 0x6b,
+	0x34, 0x06, 0x4f, 0x16, 0x37, 0x1e, 0xff, 0x58, 0xbf, 0x42, 0x7b, 0x42, 0xe9, 0xed, 0x8f, 0xb1,
+	0x27, 0xd4, 0xbd, 0x0c, 0xca, 0x0d, 0xb9, 0x15, 0x2f, 0x77, 0x4a, 0x5b, 0x81, 0xf6, 0xd0, 0x4f,
+	0xed, 0x87, 0xdc, 0x03, 0xc6, 0x78, 0x60, 0xa2, 0x73, 0x5d, 0xc5, 0x83, 0x19, 0xfb, 0x6a, 0x6d,
+	0x7e, 0xcd, 0xe1, 0x9d, 0x07, 0x7d, 0x12, 0xad, 0x73, 0x2e, 0x28, 0x3c, 0x17, 0x30, 0xb8, 0xa0,
+	0xfa, 0x31, 0x86, 0xed, 0x4c, 0x2a, 0x52, 0xc3, 0x05, 0xf2, 0xdd, 0x83, 0x78, 0x5b, 0xa0, 0x1c,
+	0x5d, 0x84, 0xaf, 0x7d, 0xc1, 0xd1, 0xc5, 0x7c, 0x71, 0x60, 0x5f, 0xaa, 0xce, 0xac, 0x75, 0x74,
+	0x91, 0x8d, 0x1e, 0xc0, 0x82, 0xe6, 0xfa, 0x9e, 0x1b, 0xf9, 0xca, 0x4e, 0xfd, 0xf6, 0xc5, 0xca,
+	0xbc, 0x1a, 0xfb, 0xde, 0x90, 0xca, 0xc8, 0x0b, 0xa4, 0x95, 0x42, 0x44, 0x81, 0x7c, 0xda, 0xaa,
+	0x43, 0x0d, 0xd4, 0x6f, 0x21, 0xc5, 0x8b, 0x10, 0x11, 0x2e, 0x41, 0xed, 0x21, 0x5c, 0xfa, 0x6a,
+	0x6e, 0xe4, 0x86, 0xf4, 0x2d, 0x3b, 0x9e, 0xdb, 0x57, 0xea, 0xb2, 0x6b, 0xa4, 0x6f, 0x32, 0xea,
+	0x2b, 0x4f, 0xf3, 0x23, 0x58, 0x2d, 0x3a, 0x7f, 0xeb, 0xd2, 0xb7, 0xd2, 0x2d, 0xdc, 0xae, 0x70,
+	0x5e, 0xaf, 0x90, 0xb7, 0x58, 0x37, 0x47, 0x25, 0xba, 0xa5, 0xf9, 0x14, 0x1b, 0xdd, 0x2a, 0xfb,
+	0x94, 0xdb, 0x57, 0xea, 0xb2, 0x6b, 0xba, 0x85, 0x6e, 0x81, 0x9f, 0xf1, 0x32, 0x07, 0xf8, 0xe2,
+	0x31, 0x8b, 0xdf, 0xfe, 0x7f, 0x03, 0x00, 0x3b, 0x2b, 0x89, 0x98, 0xb0, 0x8c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// GoCryptoTraderClient is the client API for GoCryptoTrader service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type GoCryptoTraderClient interface {
+	GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error)
+	GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
+	DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error)
+	GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error)
+	DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error)
+	GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error)
+	EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error)
+	GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error)
+	GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error)
+	GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error)
+	GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error)
+	GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error)
+	GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error)
+	RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error)
+	GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error)
+	GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error)
+	GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error)
+	GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error)
+	SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error)
+	SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
+	WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error)
+	GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error)
+	AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error)
+	RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error)
+	GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error)
+	WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error)
+	GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
+	SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error)
+	EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error)
+	GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error)
+	GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error)
+	GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error)
+	GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error)
+	GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error)
+	GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error)
+	GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error)
+	GetExchangeHealth(ctx context.Context, in *GetExchangeHealthRequest, opts ...grpc.CallOption) (*GetExchangeHealthResponse, error)
+	GetWebsocketSubscriptions(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetWebsocketSubscriptionsResponse, error)
+	AddWebsocketSubscriptions(ctx context.Context, in *WebsocketSubscriptionsRequest, opts ...grpc.CallOption) (*GetWebsocketSubscriptionsResponse, error)
+	RemoveWebsocketSubscriptions(ctx context.Context, in *WebsocketSubscriptionsRequest, opts ...grpc.CallOption) (*GetWebsocketSubscriptionsResponse, error)
+	GetKlineStream(ctx context.Context, in *GetKlineStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetKlineStreamClient, error)
+	GetFundingPayments(ctx context.Context, in *GetFundingPaymentsRequest, opts ...grpc.CallOption) (*GetFundingPaymentsResponse, error)
+	GetTechnicalAnalysis(ctx context.Context, in *GetTechnicalAnalysisRequest, opts ...grpc.CallOption) (*GetTechnicalAnalysisResponse, error)
+	GetAccountStatement(ctx context.Context, in *GetAccountStatementRequest, opts ...grpc.CallOption) (*GetAccountStatementResponse, error)
+	ValidateCredentials(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*ValidateCredentialsResponse, error)
+	GetExchangeLatency(ctx context.Context, in *GetExchangeLatencyRequest, opts ...grpc.CallOption) (*GetExchangeLatencyResponse, error)
+	SubmitTransfer(ctx context.Context, in *SubmitTransferRequest, opts ...grpc.CallOption) (*TransferDetails, error)
+	GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error)
+	AddPriceAlert(ctx context.Context, in *AddPriceAlertRequest, opts ...grpc.CallOption) (*PriceAlert, error)
+	GetPriceAlerts(ctx context.Context, in *GetPriceAlertsRequest, opts ...grpc.CallOption) (*GetPriceAlertsResponse, error)
+	RemovePriceAlert(ctx context.Context, in *RemovePriceAlertRequest, opts ...grpc.CallOption) (*RemovePriceAlertResponse, error)
+	GetTrailingStop(ctx context.Context, in *GetTrailingStopRequest, opts ...grpc.CallOption) (*TrailingStopStatus, error)
+	ResetTrailingStop(ctx context.Context, in *ResetTrailingStopRequest, opts ...grpc.CallOption) (*TrailingStopStatus, error)
+	GetMarginPositions(ctx context.Context, in *GetMarginPositionsRequest, opts ...grpc.CallOption) (*GetMarginPositionsResponse, error)
+	GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error)
+	GetLiquidationStream(ctx context.Context, in *GetLiquidationStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetLiquidationStreamClient, error)
+	GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error)
+	GetStrategyLedgers(ctx context.Context, in *GetStrategyLedgersRequest, opts ...grpc.CallOption) (*GetStrategyLedgersResponse, error)
+	GetExposures(ctx context.Context, in *GetExposuresRequest, opts ...grpc.CallOption) (*GetExposuresResponse, error)
+	ModifyOrder(ctx context.Context, in *ModifyOrderRequest, opts ...grpc.CallOption) (*ModifyOrderResponse, error)
+	ApproveTransfer(ctx context.Context, in *ApproveTransferRequest, opts ...grpc.CallOption) (*TransferDetails, error)
+	GetRPCSessions(ctx context.Context, in *GetRPCSessionsRequest, opts ...grpc.CallOption) (*GetRPCSessionsResponse, error)
+	RevokeRPCSession(ctx context.Context, in *RevokeRPCSessionRequest, opts ...grpc.CallOption) (*RPCSession, error)
+	GetDataQuality(ctx context.Context, in *GetDataQualityRequest, opts ...grpc.CallOption) (*GetDataQualityResponse, error)
+}
+
+type goCryptoTraderClient struct {
+	cc *grpc.ClientConn
+}
+
+func NewGoCryptoTraderClient(cc *grpc.ClientConn) GoCryptoTraderClient {
+	return &goCryptoTraderClient{cc}
+}
+
+func (c *goCryptoTraderClient) GetInfo(ctx context.Context, in *GetInfoRequest, opts ...grpc.CallOption) (*GetInfoResponse, error) {
+	out := new(GetInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetSubsystems(ctx context.Context, in *GetSubsystemsRequest, opts ...grpc.CallOption) (*GetSusbsytemsResponse, error) {
+	out := new(GetSusbsytemsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetSubsystems", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
+	out := new(GenericSubsystemResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableSubsystem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableSubsystem(ctx context.Context, in *GenericSubsystemRequest, opts ...grpc.CallOption) (*GenericSubsystemResponse, error) {
+	out := new(GenericSubsystemResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableSubsystem", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetRPCEndpoints(ctx context.Context, in *GetRPCEndpointsRequest, opts ...grpc.CallOption) (*GetRPCEndpointsResponse, error) {
+	out := new(GetRPCEndpointsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRPCEndpoints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCommunicationRelayers(ctx context.Context, in *GetCommunicationRelayersRequest, opts ...grpc.CallOption) (*GetCommunicationRelayersResponse, error) {
+	out := new(GetCommunicationRelayersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCommunicationRelayers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchanges(ctx context.Context, in *GetExchangesRequest, opts ...grpc.CallOption) (*GetExchangesResponse, error) {
+	out := new(GetExchangesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchanges", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeInfo(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeInfoResponse, error) {
+	out := new(GetExchangeInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOTPCode(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetExchangeOTPReponse, error) {
+	out := new(GetExchangeOTPReponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCode", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOTPCodes(ctx context.Context, in *GetExchangeOTPsRequest, opts ...grpc.CallOption) (*GetExchangeOTPsResponse, error) {
+	out := new(GetExchangeOTPsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableExchange(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchange", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTicker(ctx context.Context, in *GetTickerRequest, opts ...grpc.CallOption) (*TickerResponse, error) {
+	out := new(TickerResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTicker", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTickers(ctx context.Context, in *GetTickersRequest, opts ...grpc.CallOption) (*GetTickersResponse, error) {
+	out := new(GetTickersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTickers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbook(ctx context.Context, in *GetOrderbookRequest, opts ...grpc.CallOption) (*OrderbookResponse, error) {
+	out := new(OrderbookResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbook", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbooks(ctx context.Context, in *GetOrderbooksRequest, opts ...grpc.CallOption) (*GetOrderbooksResponse, error) {
+	out := new(GetOrderbooksResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrderbooks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAccountInfo(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (*GetAccountInfoResponse, error) {
+	out := new(GetAccountInfoResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAccountInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAccountInfoStream(ctx context.Context, in *GetAccountInfoRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetAccountInfoStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[0], "/gctrpc.GoCryptoTrader/GetAccountInfoStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetAccountInfoStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetAccountInfoStreamClient interface {
+	Recv() (*GetAccountInfoResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetAccountInfoStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetAccountInfoStreamClient) Recv() (*GetAccountInfoResponse, error) {
+	m := new(GetAccountInfoResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetConfig(ctx context.Context, in *GetConfigRequest, opts ...grpc.CallOption) (*GetConfigResponse, error) {
+	out := new(GetConfigResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolio(ctx context.Context, in *GetPortfolioRequest, opts ...grpc.CallOption) (*GetPortfolioResponse, error) {
+	out := new(GetPortfolioResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolio", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPortfolioSummary(ctx context.Context, in *GetPortfolioSummaryRequest, opts ...grpc.CallOption) (*GetPortfolioSummaryResponse, error) {
+	out := new(GetPortfolioSummaryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPortfolioSummary", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddPortfolioAddress(ctx context.Context, in *AddPortfolioAddressRequest, opts ...grpc.CallOption) (*AddPortfolioAddressResponse, error) {
+	out := new(AddPortfolioAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPortfolioAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemovePortfolioAddress(ctx context.Context, in *RemovePortfolioAddressRequest, opts ...grpc.CallOption) (*RemovePortfolioAddressResponse, error) {
+	out := new(RemovePortfolioAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemovePortfolioAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetForexProviders(ctx context.Context, in *GetForexProvidersRequest, opts ...grpc.CallOption) (*GetForexProvidersResponse, error) {
+	out := new(GetForexProvidersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexProviders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetForexRates(ctx context.Context, in *GetForexRatesRequest, opts ...grpc.CallOption) (*GetForexRatesResponse, error) {
+	out := new(GetForexRatesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetForexRates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrders(ctx context.Context, in *GetOrdersRequest, opts ...grpc.CallOption) (*GetOrdersResponse, error) {
+	out := new(GetOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrder(ctx context.Context, in *GetOrderRequest, opts ...grpc.CallOption) (*OrderDetails, error) {
+	out := new(OrderDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitOrder(ctx context.Context, in *SubmitOrderRequest, opts ...grpc.CallOption) (*SubmitOrderResponse, error) {
+	out := new(SubmitOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SimulateOrder(ctx context.Context, in *SimulateOrderRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
+	out := new(SimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SimulateOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WhaleBomb(ctx context.Context, in *WhaleBombRequest, opts ...grpc.CallOption) (*SimulateOrderResponse, error) {
+	out := new(SimulateOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WhaleBomb", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) CancelAllOrders(ctx context.Context, in *CancelAllOrdersRequest, opts ...grpc.CallOption) (*CancelAllOrdersResponse, error) {
+	out := new(CancelAllOrdersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/CancelAllOrders", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetEvents(ctx context.Context, in *GetEventsRequest, opts ...grpc.CallOption) (*GetEventsResponse, error) {
+	out := new(GetEventsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetEvents", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddEvent(ctx context.Context, in *AddEventRequest, opts ...grpc.CallOption) (*AddEventResponse, error) {
+	out := new(AddEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemoveEvent(ctx context.Context, in *RemoveEventRequest, opts ...grpc.CallOption) (*RemoveEventResponse, error) {
+	out := new(RemoveEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddresses(ctx context.Context, in *GetCryptocurrencyDepositAddressesRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	out := new(GetCryptocurrencyDepositAddressesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetCryptocurrencyDepositAddress(ctx context.Context, in *GetCryptocurrencyDepositAddressRequest, opts ...grpc.CallOption) (*GetCryptocurrencyDepositAddressResponse, error) {
+	out := new(GetCryptocurrencyDepositAddressResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WithdrawCryptocurrencyFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) WithdrawFiatFunds(ctx context.Context, in *WithdrawCurrencyRequest, opts ...grpc.CallOption) (*WithdrawResponse, error) {
+	out := new(WithdrawResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/WithdrawFiatFunds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetLoggerDetails(ctx context.Context, in *GetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
+	out := new(GetLoggerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetLoggerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetLoggerDetails(ctx context.Context, in *SetLoggerDetailsRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
+	out := new(GetLoggerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLoggerDetails", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SetLogLevel(ctx context.Context, in *SetLogLevelRequest, opts ...grpc.CallOption) (*GetLoggerDetailsResponse, error) {
+	out := new(GetLoggerDetailsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SetLogLevel", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangePairs(ctx context.Context, in *GetExchangePairsRequest, opts ...grpc.CallOption) (*GetExchangePairsResponse, error) {
+	out := new(GetExchangePairsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangePairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) EnableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/EnableExchangePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) DisableExchangePair(ctx context.Context, in *ExchangePairRequest, opts ...grpc.CallOption) (*GenericExchangeNameResponse, error) {
+	out := new(GenericExchangeNameResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/DisableExchangePair", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetOrderbookStream(ctx context.Context, in *GetOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[1], "/gctrpc.GoCryptoTrader/GetOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetOrderbookStreamClient interface {
+	Recv() (*OrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
+	m := new(OrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeOrderbookStream(ctx context.Context, in *GetExchangeOrderbookStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeOrderbookStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[2], "/gctrpc.GoCryptoTrader/GetExchangeOrderbookStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeOrderbookStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetExchangeOrderbookStreamClient interface {
+	Recv() (*OrderbookResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetExchangeOrderbookStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamClient) Recv() (*OrderbookResponse, error) {
+	m := new(OrderbookResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetTickerStream(ctx context.Context, in *GetTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetTickerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[3], "/gctrpc.GoCryptoTrader/GetTickerStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetTickerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetTickerStreamClient interface {
+	Recv() (*TickerResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetTickerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetTickerStreamClient) Recv() (*TickerResponse, error) {
+	m := new(TickerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeTickerStream(ctx context.Context, in *GetExchangeTickerStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetExchangeTickerStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[4], "/gctrpc.GoCryptoTrader/GetExchangeTickerStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetExchangeTickerStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetExchangeTickerStreamClient interface {
+	Recv() (*TickerResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamClient) Recv() (*TickerResponse, error) {
+	m := new(TickerResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetAuditEvent(ctx context.Context, in *GetAuditEventRequest, opts ...grpc.CallOption) (*GetAuditEventResponse, error) {
+	out := new(GetAuditEventResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAuditEvent", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptExecute(ctx context.Context, in *GCTScriptExecuteRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptExecute", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptUpload(ctx context.Context, in *GCTScriptUploadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptUpload", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptReadScript(ctx context.Context, in *GCTScriptReadScriptRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
+	out := new(GCTScriptQueryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptReadScript", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStatus(ctx context.Context, in *GCTScriptStatusRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
+	out := new(GCTScriptStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptQuery(ctx context.Context, in *GCTScriptQueryRequest, opts ...grpc.CallOption) (*GCTScriptQueryResponse, error) {
+	out := new(GCTScriptQueryResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptQuery", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStop(ctx context.Context, in *GCTScriptStopRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptStopAll(ctx context.Context, in *GCTScriptStopAllRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptStopAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptListAll(ctx context.Context, in *GCTScriptListAllRequest, opts ...grpc.CallOption) (*GCTScriptStatusResponse, error) {
+	out := new(GCTScriptStatusResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptListAll", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GCTScriptAutoLoadToggle(ctx context.Context, in *GCTScriptAutoLoadRequest, opts ...grpc.CallOption) (*GCTScriptGenericResponse, error) {
+	out := new(GCTScriptGenericResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetHistoricCandles(ctx context.Context, in *GetHistoricCandlesRequest, opts ...grpc.CallOption) (*GetHistoricCandlesResponse, error) {
+	out := new(GetHistoricCandlesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetHistoricCandles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeHealth(ctx context.Context, in *GetExchangeHealthRequest, opts ...grpc.CallOption) (*GetExchangeHealthResponse, error) {
+	out := new(GetExchangeHealthResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetWebsocketSubscriptions(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*GetWebsocketSubscriptionsResponse, error) {
+	out := new(GetWebsocketSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetWebsocketSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddWebsocketSubscriptions(ctx context.Context, in *WebsocketSubscriptionsRequest, opts ...grpc.CallOption) (*GetWebsocketSubscriptionsResponse, error) {
+	out := new(GetWebsocketSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddWebsocketSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemoveWebsocketSubscriptions(ctx context.Context, in *WebsocketSubscriptionsRequest, opts ...grpc.CallOption) (*GetWebsocketSubscriptionsResponse, error) {
+	out := new(GetWebsocketSubscriptionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemoveWebsocketSubscriptions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetKlineStream(ctx context.Context, in *GetKlineStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetKlineStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[5], "/gctrpc.GoCryptoTrader/GetKlineStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetKlineStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetKlineStreamClient interface {
+	Recv() (*KlineResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetKlineStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetKlineStreamClient) Recv() (*KlineResponse, error) {
+	m := new(KlineResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetFundingPayments(ctx context.Context, in *GetFundingPaymentsRequest, opts ...grpc.CallOption) (*GetFundingPaymentsResponse, error) {
+	out := new(GetFundingPaymentsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetFundingPayments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTechnicalAnalysis(ctx context.Context, in *GetTechnicalAnalysisRequest, opts ...grpc.CallOption) (*GetTechnicalAnalysisResponse, error) {
+	out := new(GetTechnicalAnalysisResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTechnicalAnalysis", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetAccountStatement(ctx context.Context, in *GetAccountStatementRequest, opts ...grpc.CallOption) (*GetAccountStatementResponse, error) {
+	out := new(GetAccountStatementResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetAccountStatement", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ValidateCredentials(ctx context.Context, in *GenericExchangeNameRequest, opts ...grpc.CallOption) (*ValidateCredentialsResponse, error) {
+	out := new(ValidateCredentialsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ValidateCredentials", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExchangeLatency(ctx context.Context, in *GetExchangeLatencyRequest, opts ...grpc.CallOption) (*GetExchangeLatencyResponse, error) {
+	out := new(GetExchangeLatencyResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExchangeLatency", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) SubmitTransfer(ctx context.Context, in *SubmitTransferRequest, opts ...grpc.CallOption) (*TransferDetails, error) {
+	out := new(TransferDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/SubmitTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTransfers(ctx context.Context, in *GetTransfersRequest, opts ...grpc.CallOption) (*GetTransfersResponse, error) {
+	out := new(GetTransfersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTransfers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) AddPriceAlert(ctx context.Context, in *AddPriceAlertRequest, opts ...grpc.CallOption) (*PriceAlert, error) {
+	out := new(PriceAlert)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/AddPriceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetPriceAlerts(ctx context.Context, in *GetPriceAlertsRequest, opts ...grpc.CallOption) (*GetPriceAlertsResponse, error) {
+	out := new(GetPriceAlertsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetPriceAlerts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RemovePriceAlert(ctx context.Context, in *RemovePriceAlertRequest, opts ...grpc.CallOption) (*RemovePriceAlertResponse, error) {
+	out := new(RemovePriceAlertResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RemovePriceAlert", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetTrailingStop(ctx context.Context, in *GetTrailingStopRequest, opts ...grpc.CallOption) (*TrailingStopStatus, error) {
+	out := new(TrailingStopStatus)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetTrailingStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ResetTrailingStop(ctx context.Context, in *ResetTrailingStopRequest, opts ...grpc.CallOption) (*TrailingStopStatus, error) {
+	out := new(TrailingStopStatus)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ResetTrailingStop", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetMarginPositions(ctx context.Context, in *GetMarginPositionsRequest, opts ...grpc.CallOption) (*GetMarginPositionsResponse, error) {
+	out := new(GetMarginPositionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetMarginPositions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetArbitrageOpportunities(ctx context.Context, in *GetArbitrageOpportunitiesRequest, opts ...grpc.CallOption) (*GetArbitrageOpportunitiesResponse, error) {
+	out := new(GetArbitrageOpportunitiesResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetLiquidationStream(ctx context.Context, in *GetLiquidationStreamRequest, opts ...grpc.CallOption) (GoCryptoTrader_GetLiquidationStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GoCryptoTrader_serviceDesc.Streams[6], "/gctrpc.GoCryptoTrader/GetLiquidationStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &goCryptoTraderGetLiquidationStreamClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GoCryptoTrader_GetLiquidationStreamClient interface {
+	Recv() (*LiquidationResponse, error)
+	grpc.ClientStream
+}
+
+type goCryptoTraderGetLiquidationStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *goCryptoTraderGetLiquidationStreamClient) Recv() (*LiquidationResponse, error) {
+	m := new(LiquidationResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *goCryptoTraderClient) GetOpenInterest(ctx context.Context, in *GetOpenInterestRequest, opts ...grpc.CallOption) (*GetOpenInterestResponse, error) {
+	out := new(GetOpenInterestResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetOpenInterest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetStrategyLedgers(ctx context.Context, in *GetStrategyLedgersRequest, opts ...grpc.CallOption) (*GetStrategyLedgersResponse, error) {
+	out := new(GetStrategyLedgersResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetStrategyLedgers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetExposures(ctx context.Context, in *GetExposuresRequest, opts ...grpc.CallOption) (*GetExposuresResponse, error) {
+	out := new(GetExposuresResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetExposures", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ModifyOrder(ctx context.Context, in *ModifyOrderRequest, opts ...grpc.CallOption) (*ModifyOrderResponse, error) {
+	out := new(ModifyOrderResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ModifyOrder", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) ApproveTransfer(ctx context.Context, in *ApproveTransferRequest, opts ...grpc.CallOption) (*TransferDetails, error) {
+	out := new(TransferDetails)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/ApproveTransfer", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetRPCSessions(ctx context.Context, in *GetRPCSessionsRequest, opts ...grpc.CallOption) (*GetRPCSessionsResponse, error) {
+	out := new(GetRPCSessionsResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetRPCSessions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) RevokeRPCSession(ctx context.Context, in *RevokeRPCSessionRequest, opts ...grpc.CallOption) (*RPCSession, error) {
+	out := new(RPCSession)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/RevokeRPCSession", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *goCryptoTraderClient) GetDataQuality(ctx context.Context, in *GetDataQualityRequest, opts ...grpc.CallOption) (*GetDataQualityResponse, error) {
+	out := new(GetDataQualityResponse)
+	err := c.cc.Invoke(ctx, "/gctrpc.GoCryptoTrader/GetDataQuality", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// GoCryptoTraderServer is the server API for GoCryptoTrader service.
+type GoCryptoTraderServer interface {
+	GetInfo(context.Context, *GetInfoRequest) (*GetInfoResponse, error)
+	GetSubsystems(context.Context, *GetSubsystemsRequest) (*GetSusbsytemsResponse, error)
+	EnableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
+	DisableSubsystem(context.Context, *GenericSubsystemRequest) (*GenericSubsystemResponse, error)
+	GetRPCEndpoints(context.Context, *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error)
+	GetCommunicationRelayers(context.Context, *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error)
+	GetExchanges(context.Context, *GetExchangesRequest) (*GetExchangesResponse, error)
+	DisableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
+	GetExchangeInfo(context.Context, *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error)
+	GetExchangeOTPCode(context.Context, *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error)
+	GetExchangeOTPCodes(context.Context, *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error)
+	EnableExchange(context.Context, *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error)
+	GetTicker(context.Context, *GetTickerRequest) (*TickerResponse, error)
+	GetTickers(context.Context, *GetTickersRequest) (*GetTickersResponse, error)
+	GetOrderbook(context.Context, *GetOrderbookRequest) (*OrderbookResponse, error)
+	GetOrderbooks(context.Context, *GetOrderbooksRequest) (*GetOrderbooksResponse, error)
+	GetAccountInfo(context.Context, *GetAccountInfoRequest) (*GetAccountInfoResponse, error)
+	GetAccountInfoStream(*GetAccountInfoRequest, GoCryptoTrader_GetAccountInfoStreamServer) error
+	GetConfig(context.Context, *GetConfigRequest) (*GetConfigResponse, error)
+	GetPortfolio(context.Context, *GetPortfolioRequest) (*GetPortfolioResponse, error)
+	GetPortfolioSummary(context.Context, *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error)
+	AddPortfolioAddress(context.Context, *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error)
+	RemovePortfolioAddress(context.Context, *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error)
+	GetForexProviders(context.Context, *GetForexProvidersRequest) (*GetForexProvidersResponse, error)
+	GetForexRates(context.Context, *GetForexRatesRequest) (*GetForexRatesResponse, error)
+	GetOrders(context.Context, *GetOrdersRequest) (*GetOrdersResponse, error)
+	GetOrder(context.Context, *GetOrderRequest) (*OrderDetails, error)
+	SubmitOrder(context.Context, *SubmitOrderRequest) (*SubmitOrderResponse, error)
+	SimulateOrder(context.Context, *SimulateOrderRequest) (*SimulateOrderResponse, error)
+	WhaleBomb(context.Context, *WhaleBombRequest) (*SimulateOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	CancelAllOrders(context.Context, *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error)
+	GetEvents(context.Context, *GetEventsRequest) (*GetEventsResponse, error)
+	AddEvent(context.Context, *AddEventRequest) (*AddEventResponse, error)
+	RemoveEvent(context.Context, *RemoveEventRequest) (*RemoveEventResponse, error)
+	GetCryptocurrencyDepositAddresses(context.Context, *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error)
+	GetCryptocurrencyDepositAddress(context.Context, *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error)
+	WithdrawCryptocurrencyFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
+	WithdrawFiatFunds(context.Context, *WithdrawCurrencyRequest) (*WithdrawResponse, error)
+	GetLoggerDetails(context.Context, *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	SetLoggerDetails(context.Context, *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error)
+	SetLogLevel(context.Context, *SetLogLevelRequest) (*GetLoggerDetailsResponse, error)
+	GetExchangePairs(context.Context, *GetExchangePairsRequest) (*GetExchangePairsResponse, error)
+	EnableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
+	DisableExchangePair(context.Context, *ExchangePairRequest) (*GenericExchangeNameResponse, error)
+	GetOrderbookStream(*GetOrderbookStreamRequest, GoCryptoTrader_GetOrderbookStreamServer) error
+	GetExchangeOrderbookStream(*GetExchangeOrderbookStreamRequest, GoCryptoTrader_GetExchangeOrderbookStreamServer) error
+	GetTickerStream(*GetTickerStreamRequest, GoCryptoTrader_GetTickerStreamServer) error
+	GetExchangeTickerStream(*GetExchangeTickerStreamRequest, GoCryptoTrader_GetExchangeTickerStreamServer) error
+	GetAuditEvent(context.Context, *GetAuditEventRequest) (*GetAuditEventResponse, error)
+	GCTScriptExecute(context.Context, *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptUpload(context.Context, *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptReadScript(context.Context, *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStatus(context.Context, *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptQuery(context.Context, *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error)
+	GCTScriptStop(context.Context, *GCTScriptStopRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptStopAll(context.Context, *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error)
+	GCTScriptListAll(context.Context, *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error)
+	GCTScriptAutoLoadToggle(context.Context, *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error)
+	GetHistoricCandles(context.Context, *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error)
+	GetExchangeHealth(context.Context, *GetExchangeHealthRequest) (*GetExchangeHealthResponse, error)
+	GetWebsocketSubscriptions(context.Context, *GenericExchangeNameRequest) (*GetWebsocketSubscriptionsResponse, error)
+	AddWebsocketSubscriptions(context.Context, *WebsocketSubscriptionsRequest) (*GetWebsocketSubscriptionsResponse, error)
+	RemoveWebsocketSubscriptions(context.Context, *WebsocketSubscriptionsRequest) (*GetWebsocketSubscriptionsResponse, error)
+	GetKlineStream(*GetKlineStreamRequest, GoCryptoTrader_GetKlineStreamServer) error
+	GetFundingPayments(context.Context, *GetFundingPaymentsRequest) (*GetFundingPaymentsResponse, error)
+	GetTechnicalAnalysis(context.Context, *GetTechnicalAnalysisRequest) (*GetTechnicalAnalysisResponse, error)
+	GetAccountStatement(context.Context, *GetAccountStatementRequest) (*GetAccountStatementResponse, error)
+	ValidateCredentials(context.Context, *GenericExchangeNameRequest) (*ValidateCredentialsResponse, error)
+	GetExchangeLatency(context.Context, *GetExchangeLatencyRequest) (*GetExchangeLatencyResponse, error)
+	SubmitTransfer(context.Context, *SubmitTransferRequest) (*TransferDetails, error)
+	GetTransfers(context.Context, *GetTransfersRequest) (*GetTransfersResponse, error)
+	AddPriceAlert(context.Context, *AddPriceAlertRequest) (*PriceAlert, error)
+	GetPriceAlerts(context.Context, *GetPriceAlertsRequest) (*GetPriceAlertsResponse, error)
+	RemovePriceAlert(context.Context, *RemovePriceAlertRequest) (*RemovePriceAlertResponse, error)
+	GetTrailingStop(context.Context, *GetTrailingStopRequest) (*TrailingStopStatus, error)
+	ResetTrailingStop(context.Context, *ResetTrailingStopRequest) (*TrailingStopStatus, error)
+	GetMarginPositions(context.Context, *GetMarginPositionsRequest) (*GetMarginPositionsResponse, error)
+	GetArbitrageOpportunities(context.Context, *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error)
+	GetLiquidationStream(*GetLiquidationStreamRequest, GoCryptoTrader_GetLiquidationStreamServer) error
+	GetOpenInterest(context.Context, *GetOpenInterestRequest) (*GetOpenInterestResponse, error)
+	GetStrategyLedgers(context.Context, *GetStrategyLedgersRequest) (*GetStrategyLedgersResponse, error)
+	GetExposures(context.Context, *GetExposuresRequest) (*GetExposuresResponse, error)
+	ModifyOrder(context.Context, *ModifyOrderRequest) (*ModifyOrderResponse, error)
+	ApproveTransfer(context.Context, *ApproveTransferRequest) (*TransferDetails, error)
+	GetRPCSessions(context.Context, *GetRPCSessionsRequest) (*GetRPCSessionsResponse, error)
+	RevokeRPCSession(context.Context, *RevokeRPCSessionRequest) (*RPCSession, error)
+	GetDataQuality(context.Context, *GetDataQualityRequest) (*GetDataQualityResponse, error)
+}
+
+// UnimplementedGoCryptoTraderServer can be embedded to have forward compatible implementations.
+type UnimplementedGoCryptoTraderServer struct {
+}
+
+func (*UnimplementedGoCryptoTraderServer) GetInfo(ctx context.Context, req *GetInfoRequest) (*GetInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetSubsystems(ctx context.Context, req *GetSubsystemsRequest) (*GetSusbsytemsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetSubsystems not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableSubsystem not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableSubsystem(ctx context.Context, req *GenericSubsystemRequest) (*GenericSubsystemResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableSubsystem not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetRPCEndpoints(ctx context.Context, req *GetRPCEndpointsRequest) (*GetRPCEndpointsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRPCEndpoints not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCommunicationRelayers(ctx context.Context, req *GetCommunicationRelayersRequest) (*GetCommunicationRelayersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCommunicationRelayers not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchanges(ctx context.Context, req *GetExchangesRequest) (*GetExchangesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchanges not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchange not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeInfo(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCode(ctx context.Context, req *GenericExchangeNameRequest) (*GetExchangeOTPReponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCode not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOTPCodes(ctx context.Context, req *GetExchangeOTPsRequest) (*GetExchangeOTPsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeOTPCodes not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableExchange(ctx context.Context, req *GenericExchangeNameRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchange not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTicker(ctx context.Context, req *GetTickerRequest) (*TickerResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTicker not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTickers(ctx context.Context, req *GetTickersRequest) (*GetTickersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTickers not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbook(ctx context.Context, req *GetOrderbookRequest) (*OrderbookResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbook not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbooks(ctx context.Context, req *GetOrderbooksRequest) (*GetOrderbooksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderbooks not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAccountInfo(ctx context.Context, req *GetAccountInfoRequest) (*GetAccountInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountInfo not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAccountInfoStream(req *GetAccountInfoRequest, srv GoCryptoTrader_GetAccountInfoStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetAccountInfoStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetConfig(ctx context.Context, req *GetConfigRequest) (*GetConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetConfig not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPortfolio(ctx context.Context, req *GetPortfolioRequest) (*GetPortfolioResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolio not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPortfolioSummary(ctx context.Context, req *GetPortfolioSummaryRequest) (*GetPortfolioSummaryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPortfolioSummary not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddPortfolioAddress(ctx context.Context, req *AddPortfolioAddressRequest) (*AddPortfolioAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPortfolioAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemovePortfolioAddress(ctx context.Context, req *RemovePortfolioAddressRequest) (*RemovePortfolioAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePortfolioAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetForexProviders(ctx context.Context, req *GetForexProvidersRequest) (*GetForexProvidersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexProviders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetForexRates(ctx context.Context, req *GetForexRatesRequest) (*GetForexRatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetForexRates not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrders(ctx context.Context, req *GetOrdersRequest) (*GetOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrder(ctx context.Context, req *GetOrderRequest) (*OrderDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SubmitOrder(ctx context.Context, req *SubmitOrderRequest) (*SubmitOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SimulateOrder(ctx context.Context, req *SimulateOrderRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SimulateOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WhaleBomb(ctx context.Context, req *WhaleBombRequest) (*SimulateOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WhaleBomb not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelOrder(ctx context.Context, req *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) CancelAllOrders(ctx context.Context, req *CancelAllOrdersRequest) (*CancelAllOrdersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelAllOrders not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetEvents(ctx context.Context, req *GetEventsRequest) (*GetEventsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetEvents not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddEvent(ctx context.Context, req *AddEventRequest) (*AddEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemoveEvent(ctx context.Context, req *RemoveEventRequest) (*RemoveEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddresses(ctx context.Context, req *GetCryptocurrencyDepositAddressesRequest) (*GetCryptocurrencyDepositAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddresses not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetCryptocurrencyDepositAddress(ctx context.Context, req *GetCryptocurrencyDepositAddressRequest) (*GetCryptocurrencyDepositAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCryptocurrencyDepositAddress not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WithdrawCryptocurrencyFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawCryptocurrencyFunds not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) WithdrawFiatFunds(ctx context.Context, req *WithdrawCurrencyRequest) (*WithdrawResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawFiatFunds not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetLoggerDetails(ctx context.Context, req *GetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetLoggerDetails not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SetLoggerDetails(ctx context.Context, req *SetLoggerDetailsRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLoggerDetails not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SetLogLevel(ctx context.Context, req *SetLogLevelRequest) (*GetLoggerDetailsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetLogLevel not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangePairs(ctx context.Context, req *GetExchangePairsRequest) (*GetExchangePairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangePairs not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) EnableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EnableExchangePair not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) DisableExchangePair(ctx context.Context, req *ExchangePairRequest) (*GenericExchangeNameResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DisableExchangePair not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOrderbookStream(req *GetOrderbookStreamRequest, srv GoCryptoTrader_GetOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeOrderbookStream(req *GetExchangeOrderbookStreamRequest, srv GoCryptoTrader_GetExchangeOrderbookStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeOrderbookStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTickerStream(req *GetTickerStreamRequest, srv GoCryptoTrader_GetTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetTickerStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeTickerStream(req *GetExchangeTickerStreamRequest, srv GoCryptoTrader_GetExchangeTickerStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetExchangeTickerStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAuditEvent(ctx context.Context, req *GetAuditEventRequest) (*GetAuditEventResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAuditEvent not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptExecute(ctx context.Context, req *GCTScriptExecuteRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptExecute not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptUpload(ctx context.Context, req *GCTScriptUploadRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptUpload not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptReadScript(ctx context.Context, req *GCTScriptReadScriptRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptReadScript not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStatus(ctx context.Context, req *GCTScriptStatusRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStatus not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptQuery(ctx context.Context, req *GCTScriptQueryRequest) (*GCTScriptQueryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptQuery not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStop(ctx context.Context, req *GCTScriptStopRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStop not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptStopAll(ctx context.Context, req *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptListAll(ctx context.Context, req *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(ctx context.Context, req *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetHistoricCandles(ctx context.Context, req *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeHealth(ctx context.Context, req *GetExchangeHealthRequest) (*GetExchangeHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeHealth not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetWebsocketSubscriptions(ctx context.Context, req *GenericExchangeNameRequest) (*GetWebsocketSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetWebsocketSubscriptions not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddWebsocketSubscriptions(ctx context.Context, req *WebsocketSubscriptionsRequest) (*GetWebsocketSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddWebsocketSubscriptions not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemoveWebsocketSubscriptions(ctx context.Context, req *WebsocketSubscriptionsRequest) (*GetWebsocketSubscriptionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveWebsocketSubscriptions not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetKlineStream(req *GetKlineStreamRequest, srv GoCryptoTrader_GetKlineStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetKlineStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetFundingPayments(ctx context.Context, req *GetFundingPaymentsRequest) (*GetFundingPaymentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetFundingPayments not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTechnicalAnalysis(ctx context.Context, req *GetTechnicalAnalysisRequest) (*GetTechnicalAnalysisResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTechnicalAnalysis not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetAccountStatement(ctx context.Context, req *GetAccountStatementRequest) (*GetAccountStatementResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAccountStatement not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ValidateCredentials(ctx context.Context, req *GenericExchangeNameRequest) (*ValidateCredentialsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValidateCredentials not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExchangeLatency(ctx context.Context, req *GetExchangeLatencyRequest) (*GetExchangeLatencyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExchangeLatency not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) SubmitTransfer(ctx context.Context, req *SubmitTransferRequest) (*TransferDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitTransfer not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTransfers(ctx context.Context, req *GetTransfersRequest) (*GetTransfersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTransfers not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) AddPriceAlert(ctx context.Context, req *AddPriceAlertRequest) (*PriceAlert, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddPriceAlert not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetPriceAlerts(ctx context.Context, req *GetPriceAlertsRequest) (*GetPriceAlertsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPriceAlerts not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RemovePriceAlert(ctx context.Context, req *RemovePriceAlertRequest) (*RemovePriceAlertResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePriceAlert not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetTrailingStop(ctx context.Context, req *GetTrailingStopRequest) (*TrailingStopStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetTrailingStop not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ResetTrailingStop(ctx context.Context, req *ResetTrailingStopRequest) (*TrailingStopStatus, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResetTrailingStop not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetMarginPositions(ctx context.Context, req *GetMarginPositionsRequest) (*GetMarginPositionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetMarginPositions not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetArbitrageOpportunities(ctx context.Context, req *GetArbitrageOpportunitiesRequest) (*GetArbitrageOpportunitiesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetArbitrageOpportunities not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetLiquidationStream(req *GetLiquidationStreamRequest, srv GoCryptoTrader_GetLiquidationStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GetLiquidationStream not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetOpenInterest(ctx context.Context, req *GetOpenInterestRequest) (*GetOpenInterestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOpenInterest not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetStrategyLedgers(ctx context.Context, req *GetStrategyLedgersRequest) (*GetStrategyLedgersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetStrategyLedgers not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetExposures(ctx context.Context, req *GetExposuresRequest) (*GetExposuresResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetExposures not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ModifyOrder(ctx context.Context, req *ModifyOrderRequest) (*ModifyOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ModifyOrder not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) ApproveTransfer(ctx context.Context, req *ApproveTransferRequest) (*TransferDetails, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApproveTransfer not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetRPCSessions(ctx context.Context, req *GetRPCSessionsRequest) (*GetRPCSessionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetRPCSessions not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) RevokeRPCSession(ctx context.Context, req *RevokeRPCSessionRequest) (*RPCSession, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeRPCSession not implemented")
+}
+func (*UnimplementedGoCryptoTraderServer) GetDataQuality(ctx context.Context, req *GetDataQualityRequest) (*GetDataQualityResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDataQuality not implemented")
+}
+
+func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
+	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+}
+
+func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetSubsystemsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericSubsystemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRPCEndpointsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCommunicationRelayersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeOTPsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickerRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTickersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbookRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderbooksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetAccountInfoRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+}
+
+type GoCryptoTrader_GetAccountInfoStreamServer interface {
+	Send(*GetAccountInfoResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetAccountInfoStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPortfolioSummaryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePortfolioAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptStopAll(ctx context.Context, req *GCTScriptStopAllRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptStopAll not implemented")
+
+func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexProvidersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptListAll(ctx context.Context, req *GCTScriptListAllRequest) (*GCTScriptStatusResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptListAll not implemented")
+
+func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetForexRatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGoCryptoTraderServer) GCTScriptAutoLoadToggle(ctx context.Context, req *GCTScriptAutoLoadRequest) (*GCTScriptGenericResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GCTScriptAutoLoadToggle not implemented")
+
+func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrdersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
-func (*UnimplementedGoCryptoTraderServer) GetHistoricCandles(ctx context.Context, req *GetHistoricCandlesRequest) (*GetHistoricCandlesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GetHistoricCandles not implemented")
+
+func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func RegisterGoCryptoTraderServer(s *grpc.Server, srv GoCryptoTraderServer) {
-	s.RegisterService(&_GoCryptoTrader_serviceDesc, srv)
+func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetInfoRequest)
+func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SimulateOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetInfo(ctx, req.(*GetInfoRequest))
+		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetSubsystems_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetSubsystemsRequest)
+func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WhaleBombRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, in)
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetSubsystems",
+		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetSubsystems(ctx, req.(*GetSubsystemsRequest))
+		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableSubsystem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericSubsystemRequest)
+func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelAllOrdersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, in)
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableSubsystem",
+		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableSubsystem(ctx, req.(*GenericSubsystemRequest))
+		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetRPCEndpoints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetRPCEndpointsRequest)
+func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetEventsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, in)
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCEndpoints",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetRPCEndpoints(ctx, req.(*GetRPCEndpointsRequest))
+		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCommunicationRelayers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCommunicationRelayersRequest)
+func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, in)
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCommunicationRelayers",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCommunicationRelayers(ctx, req.(*GetCommunicationRelayersRequest))
+		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchanges_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangesRequest)
+func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, in)
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchanges",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchanges(ctx, req.(*GetExchangesRequest))
+		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetCryptocurrencyDepositAddressRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeInfo(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCode",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCode(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangeOTPCodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangeOTPsRequest)
+func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WithdrawCurrencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, in)
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeOTPCodes",
+		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangeOTPCodes(ctx, req.(*GetExchangeOTPsRequest))
+		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawCurrencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableExchange_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GenericExchangeNameRequest)
+func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, in)
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchange",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableExchange(ctx, req.(*GenericExchangeNameRequest))
+		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTicker_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickerRequest)
+func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLoggerDetailsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTicker",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTicker(ctx, req.(*GetTickerRequest))
+		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetTickers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetTickersRequest)
+func _GoCryptoTrader_SetLogLevel_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetLogLevelRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, in)
+		return srv.(GoCryptoTraderServer).SetLogLevel(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetTickers",
+		FullMethod: "/gctrpc.GoCryptoTrader/SetLogLevel",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetTickers(ctx, req.(*GetTickersRequest))
+		return srv.(GoCryptoTraderServer).SetLogLevel(ctx, req.(*SetLogLevelRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbook_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbookRequest)
+func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangePairsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbook",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbook(ctx, req.(*GetOrderbookRequest))
+		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbooks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderbooksRequest)
+func _GoCryptoTrader_EnableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, in)
+		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrderbooks",
+		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrderbooks(ctx, req.(*GetOrderbooksRequest))
+		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, req.(*ExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAccountInfoRequest)
+func _GoCryptoTrader_DisableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ExchangePairRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, in)
+		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountInfo",
+		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchangePair",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAccountInfo(ctx, req.(*GetAccountInfoRequest))
+		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, req.(*ExchangePairRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAccountInfoStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetAccountInfoRequest)
+func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetOrderbookStreamRequest)
 	if err := stream.RecvMsg(m); err != nil {
 		return err
 	}
-	return srv.(GoCryptoTraderServer).GetAccountInfoStream(m, &goCryptoTraderGetAccountInfoStreamServer{stream})
+	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
 }
 
-type GoCryptoTrader_GetAccountInfoStreamServer interface {
-	Send(*GetAccountInfoResponse) error
+type GoCryptoTrader_GetOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
 	grpc.ServerStream
 }
 
-type goCryptoTraderGetAccountInfoStreamServer struct {
+type goCryptoTraderGetOrderbookStreamServer struct {
 	grpc.ServerStream
 }
 
-func (x *goCryptoTraderGetAccountInfoStreamServer) Send(m *GetAccountInfoResponse) error {
+func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
 	return x.ServerStream.SendMsg(m)
 }
 
-func _GoCryptoTrader_GetConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetConfigRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, in)
+func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeOrderbookStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetConfig",
+	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
+}
+
+type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
+	Send(*OrderbookResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeOrderbookStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetConfig(ctx, req.(*GetConfigRequest))
+	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
+}
+
+type GoCryptoTrader_GetTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetExchangeTickerStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
 }
 
-func _GoCryptoTrader_GetPortfolio_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioRequest)
+type GoCryptoTrader_GetExchangeTickerStreamServer interface {
+	Send(*TickerResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetExchangeTickerStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAuditEventRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolio",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolio(ctx, req.(*GetPortfolioRequest))
+		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetPortfolioSummary_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetPortfolioSummaryRequest)
+func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptExecuteRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetPortfolioSummary",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetPortfolioSummary(ctx, req.(*GetPortfolioSummaryRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddPortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddPortfolioAddressRequest)
+func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptUploadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddPortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddPortfolioAddress(ctx, req.(*AddPortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemovePortfolioAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemovePortfolioAddressRequest)
+func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptReadScriptRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemovePortfolioAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemovePortfolioAddress(ctx, req.(*RemovePortfolioAddressRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexProviders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexProvidersRequest)
+func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStatusRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexProviders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexProviders(ctx, req.(*GetForexProvidersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetForexRates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetForexRatesRequest)
+func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptQueryRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetForexRates",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetForexRates(ctx, req.(*GetForexRatesRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrdersRequest)
+func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrders(ctx, req.(*GetOrdersRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetOrderRequest)
+func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptStopAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetOrder(ctx, req.(*GetOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SubmitOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SubmitOrderRequest)
+func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptListAllRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SubmitOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SubmitOrder(ctx, req.(*SubmitOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SimulateOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SimulateOrderRequest)
+func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GCTScriptAutoLoadRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SimulateOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SimulateOrder(ctx, req.(*SimulateOrderRequest))
+		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WhaleBomb_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WhaleBombRequest)
+func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetHistoricCandlesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, in)
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WhaleBomb",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WhaleBomb(ctx, req.(*WhaleBombRequest))
+		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelOrderRequest)
+func _GoCryptoTrader_GetExchangeHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeHealthRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangeHealth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelOrder",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeHealth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+		return srv.(GoCryptoTraderServer).GetExchangeHealth(ctx, req.(*GetExchangeHealthRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_CancelAllOrders_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(CancelAllOrdersRequest)
+func _GoCryptoTrader_GetWebsocketSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, in)
+		return srv.(GoCryptoTraderServer).GetWebsocketSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/CancelAllOrders",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetWebsocketSubscriptions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).CancelAllOrders(ctx, req.(*CancelAllOrdersRequest))
+		return srv.(GoCryptoTraderServer).GetWebsocketSubscriptions(ctx, req.(*GenericExchangeNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetEvents_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetEventsRequest)
+func _GoCryptoTrader_AddWebsocketSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, in)
+		return srv.(GoCryptoTraderServer).AddWebsocketSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetEvents",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddWebsocketSubscriptions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetEvents(ctx, req.(*GetEventsRequest))
+		return srv.(GoCryptoTraderServer).AddWebsocketSubscriptions(ctx, req.(*WebsocketSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_AddEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(AddEventRequest)
+func _GoCryptoTrader_RemoveWebsocketSubscriptions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(WebsocketSubscriptionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).RemoveWebsocketSubscriptions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/AddEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemoveWebsocketSubscriptions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).AddEvent(ctx, req.(*AddEventRequest))
+		return srv.(GoCryptoTraderServer).RemoveWebsocketSubscriptions(ctx, req.(*WebsocketSubscriptionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_RemoveEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(RemoveEventRequest)
+func _GoCryptoTrader_GetKlineStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetKlineStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetKlineStream(m, &goCryptoTraderGetKlineStreamServer{stream})
+}
+
+type GoCryptoTrader_GetKlineStreamServer interface {
+	Send(*KlineResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetKlineStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetKlineStreamServer) Send(m *KlineResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetFundingPayments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetFundingPaymentsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).GetFundingPayments(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/RemoveEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetFundingPayments",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).RemoveEvent(ctx, req.(*RemoveEventRequest))
+		return srv.(GoCryptoTraderServer).GetFundingPayments(ctx, req.(*GetFundingPaymentsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressesRequest)
+func _GoCryptoTrader_GetTechnicalAnalysis_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTechnicalAnalysisRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, in)
+		return srv.(GoCryptoTraderServer).GetTechnicalAnalysis(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddresses",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTechnicalAnalysis",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddresses(ctx, req.(*GetCryptocurrencyDepositAddressesRequest))
+		return srv.(GoCryptoTraderServer).GetTechnicalAnalysis(ctx, req.(*GetTechnicalAnalysisRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetCryptocurrencyDepositAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetCryptocurrencyDepositAddressRequest)
+func _GoCryptoTrader_GetAccountStatement_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAccountStatementRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, in)
+		return srv.(GoCryptoTraderServer).GetAccountStatement(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetCryptocurrencyDepositAddress",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetAccountStatement",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetCryptocurrencyDepositAddress(ctx, req.(*GetCryptocurrencyDepositAddressRequest))
+		return srv.(GoCryptoTraderServer).GetAccountStatement(ctx, req.(*GetAccountStatementRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawCryptocurrencyFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCurrencyRequest)
+func _GoCryptoTrader_ValidateCredentials_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GenericExchangeNameRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).ValidateCredentials(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawCryptocurrencyFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/ValidateCredentials",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawCryptocurrencyFunds(ctx, req.(*WithdrawCurrencyRequest))
+		return srv.(GoCryptoTraderServer).ValidateCredentials(ctx, req.(*GenericExchangeNameRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_WithdrawFiatFunds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(WithdrawCurrencyRequest)
+func _GoCryptoTrader_GetExchangeLatency_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExchangeLatencyRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExchangeLatency(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/WithdrawFiatFunds",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangeLatency",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).WithdrawFiatFunds(ctx, req.(*WithdrawCurrencyRequest))
+		return srv.(GoCryptoTraderServer).GetExchangeLatency(ctx, req.(*GetExchangeLatencyRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetLoggerDetailsRequest)
+func _GoCryptoTrader_SubmitTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SubmitTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).SubmitTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/SubmitTransfer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetLoggerDetails(ctx, req.(*GetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).SubmitTransfer(ctx, req.(*SubmitTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_SetLoggerDetails_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(SetLoggerDetailsRequest)
+func _GoCryptoTrader_GetTransfers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTransfersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, in)
+		return srv.(GoCryptoTraderServer).GetTransfers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/SetLoggerDetails",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTransfers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).SetLoggerDetails(ctx, req.(*SetLoggerDetailsRequest))
+		return srv.(GoCryptoTraderServer).GetTransfers(ctx, req.(*GetTransfersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetExchangePairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetExchangePairsRequest)
+func _GoCryptoTrader_AddPriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddPriceAlertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, in)
+		return srv.(GoCryptoTraderServer).AddPriceAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetExchangePairs",
+		FullMethod: "/gctrpc.GoCryptoTrader/AddPriceAlert",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetExchangePairs(ctx, req.(*GetExchangePairsRequest))
+		return srv.(GoCryptoTraderServer).AddPriceAlert(ctx, req.(*AddPriceAlertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_EnableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangePairRequest)
+func _GoCryptoTrader_GetPriceAlerts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPriceAlertsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).GetPriceAlerts(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/EnableExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetPriceAlerts",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).EnableExchangePair(ctx, req.(*ExchangePairRequest))
+		return srv.(GoCryptoTraderServer).GetPriceAlerts(ctx, req.(*GetPriceAlertsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_DisableExchangePair_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(ExchangePairRequest)
+func _GoCryptoTrader_RemovePriceAlert_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePriceAlertRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, in)
+		return srv.(GoCryptoTraderServer).RemovePriceAlert(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/DisableExchangePair",
+		FullMethod: "/gctrpc.GoCryptoTrader/RemovePriceAlert",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).DisableExchangePair(ctx, req.(*ExchangePairRequest))
+		return srv.(GoCryptoTraderServer).RemovePriceAlert(ctx, req.(*RemovePriceAlertRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+func _GoCryptoTrader_GetTrailingStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTrailingStopRequest)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	return srv.(GoCryptoTraderServer).GetOrderbookStream(m, &goCryptoTraderGetOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeOrderbookStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeOrderbookStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	if interceptor == nil {
+		return srv.(GoCryptoTraderServer).GetTrailingStop(ctx, in)
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeOrderbookStream(m, &goCryptoTraderGetExchangeOrderbookStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeOrderbookStreamServer interface {
-	Send(*OrderbookResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeOrderbookStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeOrderbookStreamServer) Send(m *OrderbookResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gctrpc.GoCryptoTrader/GetTrailingStop",
 	}
-	return srv.(GoCryptoTraderServer).GetTickerStream(m, &goCryptoTraderGetTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
-}
-
-func _GoCryptoTrader_GetExchangeTickerStream_Handler(srv interface{}, stream grpc.ServerStream) error {
-	m := new(GetExchangeTickerStreamRequest)
-	if err := stream.RecvMsg(m); err != nil {
-		return err
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GoCryptoTraderServer).GetTrailingStop(ctx, req.(*GetTrailingStopRequest))
 	}
-	return srv.(GoCryptoTraderServer).GetExchangeTickerStream(m, &goCryptoTraderGetExchangeTickerStreamServer{stream})
-}
-
-type GoCryptoTrader_GetExchangeTickerStreamServer interface {
-	Send(*TickerResponse) error
-	grpc.ServerStream
-}
-
-type goCryptoTraderGetExchangeTickerStreamServer struct {
-	grpc.ServerStream
-}
-
-func (x *goCryptoTraderGetExchangeTickerStreamServer) Send(m *TickerResponse) error {
-	return x.ServerStream.SendMsg(m)
+	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetAuditEvent_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetAuditEventRequest)
+func _GoCryptoTrader_ResetTrailingStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResetTrailingStopRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, in)
+		return srv.(GoCryptoTraderServer).ResetTrailingStop(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetAuditEvent",
+		FullMethod: "/gctrpc.GoCryptoTrader/ResetTrailingStop",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetAuditEvent(ctx, req.(*GetAuditEventRequest))
+		return srv.(GoCryptoTraderServer).ResetTrailingStop(ctx, req.(*ResetTrailingStopRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptExecute_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptExecuteRequest)
+func _GoCryptoTrader_GetMarginPositions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetMarginPositionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, in)
+		return srv.(GoCryptoTraderServer).GetMarginPositions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptExecute",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetMarginPositions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptExecute(ctx, req.(*GCTScriptExecuteRequest))
+		return srv.(GoCryptoTraderServer).GetMarginPositions(ctx, req.(*GetMarginPositionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptUpload_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptUploadRequest)
+func _GoCryptoTrader_GetArbitrageOpportunities_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetArbitrageOpportunitiesRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, in)
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptUpload",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetArbitrageOpportunities",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptUpload(ctx, req.(*GCTScriptUploadRequest))
+		return srv.(GoCryptoTraderServer).GetArbitrageOpportunities(ctx, req.(*GetArbitrageOpportunitiesRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptReadScript_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptReadScriptRequest)
+func _GoCryptoTrader_GetLiquidationStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GetLiquidationStreamRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(GoCryptoTraderServer).GetLiquidationStream(m, &goCryptoTraderGetLiquidationStreamServer{stream})
+}
+
+type GoCryptoTrader_GetLiquidationStreamServer interface {
+	Send(*LiquidationResponse) error
+	grpc.ServerStream
+}
+
+type goCryptoTraderGetLiquidationStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *goCryptoTraderGetLiquidationStreamServer) Send(m *LiquidationResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _GoCryptoTrader_GetOpenInterest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOpenInterestRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, in)
+		return srv.(GoCryptoTraderServer).GetOpenInterest(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptReadScript",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetOpenInterest",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptReadScript(ctx, req.(*GCTScriptReadScriptRequest))
+		return srv.(GoCryptoTraderServer).GetOpenInterest(ctx, req.(*GetOpenInterestRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStatusRequest)
+func _GoCryptoTrader_GetStrategyLedgers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetStrategyLedgersRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, in)
+		return srv.(GoCryptoTraderServer).GetStrategyLedgers(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStatus",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetStrategyLedgers",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStatus(ctx, req.(*GCTScriptStatusRequest))
+		return srv.(GoCryptoTraderServer).GetStrategyLedgers(ctx, req.(*GetStrategyLedgersRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptQuery_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptQueryRequest)
+func _GoCryptoTrader_GetExposures_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetExposuresRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, in)
+		return srv.(GoCryptoTraderServer).GetExposures(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptQuery",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetExposures",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptQuery(ctx, req.(*GCTScriptQueryRequest))
+		return srv.(GoCryptoTraderServer).GetExposures(ctx, req.(*GetExposuresRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStop_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopRequest)
+func _GoCryptoTrader_ModifyOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ModifyOrderRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, in)
+		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStop",
+		FullMethod: "/gctrpc.GoCryptoTrader/ModifyOrder",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStop(ctx, req.(*GCTScriptStopRequest))
+		return srv.(GoCryptoTraderServer).ModifyOrder(ctx, req.(*ModifyOrderRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptStopAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptStopAllRequest)
+func _GoCryptoTrader_ApproveTransfer_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApproveTransferRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, in)
+		return srv.(GoCryptoTraderServer).ApproveTransfer(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptStopAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/ApproveTransfer",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptStopAll(ctx, req.(*GCTScriptStopAllRequest))
+		return srv.(GoCryptoTraderServer).ApproveTransfer(ctx, req.(*ApproveTransferRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptListAll_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptListAllRequest)
+func _GoCryptoTrader_GetRPCSessions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetRPCSessionsRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, in)
+		return srv.(GoCryptoTraderServer).GetRPCSessions(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptListAll",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetRPCSessions",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptListAll(ctx, req.(*GCTScriptListAllRequest))
+		return srv.(GoCryptoTraderServer).GetRPCSessions(ctx, req.(*GetRPCSessionsRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GCTScriptAutoLoadToggle_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GCTScriptAutoLoadRequest)
+func _GoCryptoTrader_RevokeRPCSession_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RevokeRPCSessionRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, in)
+		return srv.(GoCryptoTraderServer).RevokeRPCSession(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GCTScriptAutoLoadToggle",
+		FullMethod: "/gctrpc.GoCryptoTrader/RevokeRPCSession",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GCTScriptAutoLoadToggle(ctx, req.(*GCTScriptAutoLoadRequest))
+		return srv.(GoCryptoTraderServer).RevokeRPCSession(ctx, req.(*RevokeRPCSessionRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GoCryptoTrader_GetHistoricCandles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GetHistoricCandlesRequest)
+func _GoCryptoTrader_GetDataQuality_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDataQualityRequest)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, in)
+		return srv.(GoCryptoTraderServer).GetDataQuality(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/gctrpc.GoCryptoTrader/GetHistoricCandles",
+		FullMethod: "/gctrpc.GoCryptoTrader/GetDataQuality",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GoCryptoTraderServer).GetHistoricCandles(ctx, req.(*GetHistoricCandlesRequest))
+		return srv.(GoCryptoTraderServer).GetDataQuality(ctx, req.(*GetDataQualityRequest))
 	}
 	return interceptor(ctx, in, info, handler)
 }
@@ -8536,6 +14462,10 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "SetLoggerDetails",
 			Handler:    _GoCryptoTrader_SetLoggerDetails_Handler,
 		},
+		{
+			MethodName: "SetLogLevel",
+			Handler:    _GoCryptoTrader_SetLogLevel_Handler,
+		},
 		{
 			MethodName: "GetExchangePairs",
 			Handler:    _GoCryptoTrader_GetExchangePairs_Handler,
@@ -8592,6 +14522,110 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetHistoricCandles",
 			Handler:    _GoCryptoTrader_GetHistoricCandles_Handler,
 		},
+		{
+			MethodName: "GetExchangeHealth",
+			Handler:    _GoCryptoTrader_GetExchangeHealth_Handler,
+		},
+		{
+			MethodName: "GetWebsocketSubscriptions",
+			Handler:    _GoCryptoTrader_GetWebsocketSubscriptions_Handler,
+		},
+		{
+			MethodName: "AddWebsocketSubscriptions",
+			Handler:    _GoCryptoTrader_AddWebsocketSubscriptions_Handler,
+		},
+		{
+			MethodName: "RemoveWebsocketSubscriptions",
+			Handler:    _GoCryptoTrader_RemoveWebsocketSubscriptions_Handler,
+		},
+		{
+			MethodName: "GetFundingPayments",
+			Handler:    _GoCryptoTrader_GetFundingPayments_Handler,
+		},
+		{
+			MethodName: "GetTechnicalAnalysis",
+			Handler:    _GoCryptoTrader_GetTechnicalAnalysis_Handler,
+		},
+		{
+			MethodName: "GetAccountStatement",
+			Handler:    _GoCryptoTrader_GetAccountStatement_Handler,
+		},
+		{
+			MethodName: "ValidateCredentials",
+			Handler:    _GoCryptoTrader_ValidateCredentials_Handler,
+		},
+		{
+			MethodName: "GetExchangeLatency",
+			Handler:    _GoCryptoTrader_GetExchangeLatency_Handler,
+		},
+		{
+			MethodName: "SubmitTransfer",
+			Handler:    _GoCryptoTrader_SubmitTransfer_Handler,
+		},
+		{
+			MethodName: "GetTransfers",
+			Handler:    _GoCryptoTrader_GetTransfers_Handler,
+		},
+		{
+			MethodName: "AddPriceAlert",
+			Handler:    _GoCryptoTrader_AddPriceAlert_Handler,
+		},
+		{
+			MethodName: "GetPriceAlerts",
+			Handler:    _GoCryptoTrader_GetPriceAlerts_Handler,
+		},
+		{
+			MethodName: "RemovePriceAlert",
+			Handler:    _GoCryptoTrader_RemovePriceAlert_Handler,
+		},
+		{
+			MethodName: "GetTrailingStop",
+			Handler:    _GoCryptoTrader_GetTrailingStop_Handler,
+		},
+		{
+			MethodName: "ResetTrailingStop",
+			Handler:    _GoCryptoTrader_ResetTrailingStop_Handler,
+		},
+		{
+			MethodName: "GetMarginPositions",
+			Handler:    _GoCryptoTrader_GetMarginPositions_Handler,
+		},
+		{
+			MethodName: "GetArbitrageOpportunities",
+			Handler:    _GoCryptoTrader_GetArbitrageOpportunities_Handler,
+		},
+		{
+			MethodName: "GetOpenInterest",
+			Handler:    _GoCryptoTrader_GetOpenInterest_Handler,
+		},
+		{
+			MethodName: "GetStrategyLedgers",
+			Handler:    _GoCryptoTrader_GetStrategyLedgers_Handler,
+		},
+		{
+			MethodName: "GetExposures",
+			Handler:    _GoCryptoTrader_GetExposures_Handler,
+		},
+		{
+			MethodName: "ModifyOrder",
+			Handler:    _GoCryptoTrader_ModifyOrder_Handler,
+		},
+		{
+			MethodName: "ApproveTransfer",
+			Handler:    _GoCryptoTrader_ApproveTransfer_Handler,
+		},
+		{
+			MethodName: "GetRPCSessions",
+			Handler:    _GoCryptoTrader_GetRPCSessions_Handler,
+		},
+		{
+			MethodName: "RevokeRPCSession",
+			Handler:    _GoCryptoTrader_RevokeRPCSession_Handler,
+		},
+		{
+			MethodName: "GetDataQuality",
+			Handler:    _GoCryptoTrader_GetDataQuality_Handler,
+		},
 	},
 	Streams: []grpc.StreamDesc{
 		{
@@ -8619,6 +14653,16 @@ var _GoCryptoTrader_serviceDesc = grpc.ServiceDesc{
 			Handler:       _GoCryptoTrader_GetExchangeTickerStream_Handler,
 			ServerStreams: true,
 		},
+		{
+			StreamName:    "GetKlineStream",
+			Handler:       _GoCryptoTrader_GetKlineStream_Handler,
+			ServerStreams: true,
+		},
+		{
+			StreamName:    "GetLiquidationStream",
+			Handler:       _GoCryptoTrader_GetLiquidationStream_Handler,
+			ServerStreams: true,
+		},
 	},
 	Metadata: "rpc.proto",
 }
@@ -5,6 +5,7 @@ import (
 	"strings"
 
 	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -24,6 +25,7 @@ var exchangeModule = map[string]objects.Object{
 	"ordersubmit":    &objects.UserFunction{Name: "ordersubmit", Value: ExchangeOrderSubmit},
 	"withdrawcrypto": &objects.UserFunction{Name: "withdrawcrypto", Value: ExchangeWithdrawCrypto},
 	"withdrawfiat":   &objects.UserFunction{Name: "withdrawfiat", Value: ExchangeWithdrawFiat},
+	"marketstatus":   &objects.UserFunction{Name: "marketstatus", Value: ExchangeMarketStatus},
 }
 
 // ExchangeOrderbook returns orderbook for requested exchange & currencypair
@@ -209,8 +211,8 @@ func ExchangeAccountInfo(args ...objects.Object) (objects.Object, error) {
 		for y := range rtnValue.Accounts[x].Currencies {
 			temp := make(map[string]objects.Object, 3)
 			temp["name"] = &objects.String{Value: rtnValue.Accounts[x].Currencies[y].CurrencyName.String()}
-			temp["total"] = &objects.Float{Value: rtnValue.Accounts[x].Currencies[y].TotalValue}
-			temp["hold"] = &objects.Float{Value: rtnValue.Accounts[x].Currencies[y].Hold}
+			temp["total"] = &objects.Float{Value: rtnValue.Accounts[x].Currencies[y].TotalValue.Float64()}
+			temp["hold"] = &objects.Float{Value: rtnValue.Accounts[x].Currencies[y].Hold.Float64()}
 			funds.Value = append(funds.Value, &objects.Map{Value: temp})
 		}
 	}
@@ -247,9 +249,9 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 	for x := range orderDetails.Trades {
 		temp := make(map[string]objects.Object, 7)
 		temp["timestamp"] = &objects.Time{Value: orderDetails.Trades[x].Timestamp}
-		temp["price"] = &objects.Float{Value: orderDetails.Trades[x].Price}
-		temp["fee"] = &objects.Float{Value: orderDetails.Trades[x].Fee}
-		temp["amount"] = &objects.Float{Value: orderDetails.Trades[x].Amount}
+		temp["price"] = &objects.Float{Value: orderDetails.Trades[x].Price.Float64()}
+		temp["fee"] = &objects.Float{Value: orderDetails.Trades[x].Fee.Float64()}
+		temp["amount"] = &objects.Float{Value: orderDetails.Trades[x].Amount.Float64()}
 		temp["type"] = &objects.String{Value: orderDetails.Trades[x].Type.String()}
 		temp["side"] = &objects.String{Value: orderDetails.Trades[x].Side.String()}
 		temp["description"] = &objects.String{Value: orderDetails.Trades[x].Description}
@@ -261,11 +263,11 @@ func ExchangeOrderQuery(args ...objects.Object) (objects.Object, error) {
 	data["id"] = &objects.String{Value: orderDetails.ID}
 	data["accountid"] = &objects.String{Value: orderDetails.AccountID}
 	data["currencypair"] = &objects.String{Value: orderDetails.CurrencyPair.String()}
-	data["price"] = &objects.Float{Value: orderDetails.Price}
-	data["amount"] = &objects.Float{Value: orderDetails.Amount}
-	data["amountexecuted"] = &objects.Float{Value: orderDetails.ExecutedAmount}
-	data["amountremaining"] = &objects.Float{Value: orderDetails.RemainingAmount}
-	data["fee"] = &objects.Float{Value: orderDetails.Fee}
+	data["price"] = &objects.Float{Value: orderDetails.Price.Float64()}
+	data["amount"] = &objects.Float{Value: orderDetails.Amount.Float64()}
+	data["amountexecuted"] = &objects.Float{Value: orderDetails.ExecutedAmount.Float64()}
+	data["amountremaining"] = &objects.Float{Value: orderDetails.RemainingAmount.Float64()}
+	data["fee"] = &objects.Float{Value: orderDetails.Fee.Float64()}
 	data["side"] = &objects.String{Value: orderDetails.OrderSide.String()}
 	data["type"] = &objects.String{Value: orderDetails.OrderType.String()}
 	data["date"] = &objects.String{Value: orderDetails.OrderDate.String()}
@@ -303,9 +305,10 @@ func ExchangeOrderCancel(args ...objects.Object) (objects.Object, error) {
 	return objects.FalseValue, nil
 }
 
-// ExchangeOrderSubmit submit order on exchange
+// ExchangeOrderSubmit submit order on exchange, optionally reserving its cost
+// from a strategy's virtual balances
 func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
-	if len(args) != 8 {
+	if len(args) != 8 && len(args) != 9 {
 		return nil, objects.ErrWrongNumArguments
 	}
 
@@ -341,15 +344,23 @@ func ExchangeOrderSubmit(args ...objects.Object) (objects.Object, error) {
 	if !ok {
 		return nil, fmt.Errorf(ErrParameterConvertFailed, orderClientID)
 	}
+	var strategyID string
+	if len(args) == 9 {
+		strategyID, ok = objects.ToString(args[8])
+		if !ok {
+			return nil, fmt.Errorf(ErrParameterConvertFailed, strategyID)
+		}
+	}
 	pair := currency.NewPairDelimiter(currencyPair, delimiter)
 
 	tempSubmit := &order.Submit{
-		Pair:      pair,
-		OrderType: order.Type(orderType),
-		OrderSide: order.Side(orderSide),
-		Price:     orderPrice,
-		Amount:    orderAmount,
-		ClientID:  orderClientID,
+		Pair:       pair,
+		OrderType:  order.Type(orderType),
+		OrderSide:  order.Side(orderSide),
+		Price:      decimal.NewFromFloat(orderPrice),
+		Amount:     decimal.NewFromFloat(orderAmount),
+		ClientID:   orderClientID,
+		StrategyID: strategyID,
 	}
 
 	err := tempSubmit.Validate()
@@ -496,3 +507,33 @@ func ExchangeWithdrawFiat(args ...objects.Object) (objects.Object, error) {
 
 	return &objects.String{Value: rtn}, nil
 }
+
+// ExchangeMarketStatus returns whether an exchange is open according to its
+// trading calendar, why it is closed and when that next changes
+func ExchangeMarketStatus(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 1 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	exchangeName, ok := objects.ToString(args[0])
+	if !ok {
+		return nil, fmt.Errorf(ErrParameterConvertFailed, exchangeName)
+	}
+
+	s, err := wrappers.GetWrapper().MarketStatus(exchangeName)
+	if err != nil {
+		return nil, err
+	}
+
+	data := make(map[string]objects.Object, 3)
+	data["open"] = objects.FalseValue
+	if s.Open {
+		data["open"] = objects.TrueValue
+	}
+	data["reason"] = &objects.String{Value: s.Reason}
+	data["until"] = &objects.Time{Value: s.Until}
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
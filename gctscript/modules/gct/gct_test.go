@@ -208,6 +208,13 @@ func TestExchangeOrderSubmit(t *testing.T) {
 	if err != nil {
 		t.Fatal(err)
 	}
+
+	_, err = ExchangeOrderSubmit(exch, currencyPair, delimiter,
+		orderType, orderSide, orderPrice, orderAmount, orderID,
+		&objects.String{Value: "momentum"})
+	if err != nil {
+		t.Fatal(err)
+	}
 }
 
 func TestAllModuleNames(t *testing.T) {
@@ -218,6 +225,27 @@ func TestAllModuleNames(t *testing.T) {
 	}
 }
 
+func TestExchangeMarketStatus(t *testing.T) {
+	t.Parallel()
+	_, err := ExchangeMarketStatus()
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	status, err := ExchangeMarketStatus(exch)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if status.(*objects.Map).Value["open"] != objects.TrueValue {
+		t.Errorf("expected %v to be open", exch)
+	}
+
+	_, err = ExchangeMarketStatus(exchError)
+	if err != nil && !errors.Is(err, errTestFailed) {
+		t.Fatal(err)
+	}
+}
+
 func TestExchangeDepositAddress(t *testing.T) {
 	_, err := ExchangeDepositAddress()
 	if !errors.Is(err, objects.ErrWrongNumArguments) {
@@ -268,3 +296,34 @@ func TestExchangeWithdrawFiat(t *testing.T) {
 		t.Fatal(err)
 	}
 }
+
+func TestPortfolioAddresses(t *testing.T) {
+	_, err := PortfolioAddresses(exch)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	x, err := PortfolioAddresses()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(x.(*objects.Array).Value) != 1 {
+		t.Fatalf("expected 1 address received %v", x)
+	}
+}
+
+func TestPortfolioSummary(t *testing.T) {
+	_, err := PortfolioSummary(exch)
+	if !errors.Is(err, objects.ErrWrongNumArguments) {
+		t.Fatal(err)
+	}
+
+	x, err := PortfolioSummary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	totals := x.(*objects.Map).Value["totals"].(*objects.Array)
+	if len(totals.Value) != 1 {
+		t.Fatalf("expected 1 coin total received %v", totals)
+	}
+}
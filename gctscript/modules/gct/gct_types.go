@@ -11,5 +11,6 @@ const (
 
 // Modules map of all loadable modules
 var Modules = map[string]map[string]tengo.Object{
-	"exchange": exchangeModule,
+	"exchange":  exchangeModule,
+	"portfolio": portfolioModule,
 }
@@ -0,0 +1,63 @@
+package gct
+
+import (
+	objects "github.com/d5/tengo/v2"
+	"github.com/thrasher-corp/gocryptotrader/gctscript/wrappers"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+var portfolioModule = map[string]objects.Object{
+	"addresses": &objects.UserFunction{Name: "addresses", Value: PortfolioAddresses},
+	"summary":   &objects.UserFunction{Name: "summary", Value: PortfolioSummary},
+}
+
+// PortfolioAddresses returns all addresses tracked by the portfolio
+func PortfolioAddresses(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 0 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	rtnValue := wrappers.GetWrapper().PortfolioAddresses()
+
+	var r objects.Array
+	for x := range rtnValue {
+		temp := make(map[string]objects.Object, 4)
+		temp["address"] = &objects.String{Value: rtnValue[x].Address}
+		temp["coin"] = &objects.String{Value: rtnValue[x].CoinType.String()}
+		temp["balance"] = &objects.Float{Value: rtnValue[x].Balance.Float64()}
+		temp["description"] = &objects.String{Value: rtnValue[x].Description}
+		r.Value = append(r.Value, &objects.Map{Value: temp})
+	}
+	return &r, nil
+}
+
+// PortfolioSummary returns the portfolio coin totals split by offline and
+// online holdings
+func PortfolioSummary(args ...objects.Object) (objects.Object, error) {
+	if len(args) != 0 {
+		return nil, objects.ErrWrongNumArguments
+	}
+
+	rtnValue := wrappers.GetWrapper().PortfolioSummary()
+
+	data := make(map[string]objects.Object, 3)
+	data["totals"] = portfolioCoins(rtnValue.Totals)
+	data["offline"] = portfolioCoins(rtnValue.Offline)
+	data["online"] = portfolioCoins(rtnValue.Online)
+
+	return &objects.Map{
+		Value: data,
+	}, nil
+}
+
+func portfolioCoins(coins []portfolio.Coin) *objects.Array {
+	var r objects.Array
+	for x := range coins {
+		temp := make(map[string]objects.Object, 3)
+		temp["coin"] = &objects.String{Value: coins[x].Coin.String()}
+		temp["balance"] = &objects.Float{Value: coins[x].Balance.Float64()}
+		temp["percentage"] = &objects.Float{Value: coins[x].Percentage}
+		r.Value = append(r.Value, &objects.Map{Value: temp})
+	}
+	return &r
+}
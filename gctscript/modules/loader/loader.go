@@ -7,9 +7,16 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/gctscript/modules/gct"
 )
 
+// unsafeModules are standard library modules that give scripts access to the
+// host and are excluded from sandboxed module maps
+var unsafeModules = map[string]bool{
+	"os": true,
+}
+
 // GetModuleMap returns the module map that includes all modules
-// for the given module names.
-func GetModuleMap() *tengo.ModuleMap {
+// for the given module names, sandboxed excludes modules that can access the
+// host system
+func GetModuleMap(sandboxed bool) *tengo.ModuleMap {
 	modules := tengo.NewModuleMap()
 
 	gctModuleList := gct.AllModuleNames()
@@ -21,6 +28,9 @@ func GetModuleMap() *tengo.ModuleMap {
 
 	stdLib := stdlib.AllModuleNames()
 	for _, name := range stdLib {
+		if sandboxed && unsafeModules[name] {
+			continue
+		}
 		if mod := stdlib.BuiltinModules[name]; mod != nil {
 			modules.AddBuiltinModule(name, mod)
 		}
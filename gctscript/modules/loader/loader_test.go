@@ -6,7 +6,7 @@ import (
 )
 
 func TestGetModuleMap(t *testing.T) {
-	x := GetModuleMap()
+	x := GetModuleMap(false)
 	xType := reflect.TypeOf(x).String()
 	if xType != "*tengo.ModuleMap" {
 		t.Fatalf("GetModuleMap() should return pointer to ModuleMap instead received: %v", x)
@@ -16,3 +16,16 @@ func TestGetModuleMap(t *testing.T) {
 		t.Fatal("expected GetModuleMap() to contain module results instead received 0 value")
 	}
 }
+
+func TestGetModuleMapSandboxed(t *testing.T) {
+	if GetModuleMap(false).Get("os") == nil {
+		t.Fatal("expected os module to be loaded when not sandboxed")
+	}
+	x := GetModuleMap(true)
+	if x.Get("os") != nil {
+		t.Fatal("expected os module to be excluded when sandboxed")
+	}
+	if x.Get("exchange") == nil || x.Get("portfolio") == nil {
+		t.Fatal("expected gct modules to be loaded when sandboxed")
+	}
+}
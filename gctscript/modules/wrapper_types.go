@@ -1,6 +1,7 @@
 package modules
 
 import (
+	"github.com/thrasher-corp/gocryptotrader/calendar"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -8,6 +9,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
 )
 
 // Wrapper instance of GCT to use for modules
@@ -16,6 +18,7 @@ var Wrapper GCT
 // GCT interface requirements
 type GCT interface {
 	Exchange
+	Portfolio
 }
 
 // Exchange interface requirements
@@ -32,6 +35,13 @@ type Exchange interface {
 	DepositAddress(exch string, currencyCode currency.Code) (string, error)
 	WithdrawalFiatFunds(exch, bankaccountid string, request *withdraw.FiatRequest) (out string, err error)
 	WithdrawalCryptoFunds(exch string, request *withdraw.CryptoRequest) (out string, err error)
+	MarketStatus(exch string) (calendar.Status, error)
+}
+
+// Portfolio interface requirements
+type Portfolio interface {
+	PortfolioAddresses() []portfolio.Address
+	PortfolioSummary() portfolio.Summary
 }
 
 // SetModuleWrapper link the wrapper and interface to use for modules
@@ -13,6 +13,8 @@ type Config struct {
 	ScriptTimeout      time.Duration `json:"timeout"`
 	MaxVirtualMachines uint8         `json:"max_virtual_machines"`
 	AllowImports       bool          `json:"allow_imports"`
+	AllowOSModule      bool          `json:"allow_os_module"`
+	HotReload          bool          `json:"hot_reload"`
 	AutoLoad           []string      `json:"auto_load"`
 	Verbose            bool          `json:"verbose"`
 }
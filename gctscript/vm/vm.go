@@ -85,16 +85,62 @@ func (vm *VM) Load(file string) error {
 
 	vm.File = file
 	vm.Path = filepath.Dir(file)
-	vm.Script = tengo.NewScript(code)
-	vm.Script.SetImports(loader.GetModuleMap())
+	vm.Script = vm.newScript(code)
 	vm.Hash = vm.getHash()
+	vm.event(StatusSuccess, TypeLoad)
+	return nil
+}
+
+// newScript creates a tengo script from code with the configured module set
+func (vm *VM) newScript(code []byte) *tengo.Script {
+	script := tengo.NewScript(code)
+	script.SetImports(loader.GetModuleMap(!GCTScriptConfig.AllowOSModule))
 
 	if GCTScriptConfig.AllowImports {
 		if GCTScriptConfig.Verbose {
 			log.Debugf(log.GCTScriptMgr, "File imports enabled for vm: %v", vm.ID)
 		}
-		vm.Script.EnableFileImport(true)
+		script.EnableFileImport(true)
 	}
+	return script
+}
+
+// reload recompiles the script when its file has changed since it was last
+// loaded, the previously compiled byte code keeps running if this fails
+func (vm *VM) reload() error {
+	code, err := vm.read()
+	if err != nil {
+		return &Error{
+			Action: "Reload: Read",
+			Script: vm.File,
+			Cause:  err,
+		}
+	}
+
+	hash := vm.hash(code)
+	if hash == vm.Hash {
+		return nil
+	}
+	// Store the new hash regardless of outcome so a broken script is only
+	// reported once per change
+	vm.Hash = hash
+
+	script := vm.newScript(code)
+	compiled, err := script.Compile()
+	if err != nil {
+		vm.event(StatusFailure, TypeLoad)
+		return &Error{
+			Action: "Reload: Compile",
+			Script: vm.File,
+			Cause:  err,
+		}
+	}
+
+	if GCTScriptConfig.Verbose {
+		log.Debugf(log.GCTScriptMgr, "Reloaded script: %s ID: %v", vm.ShortName(), vm.ID)
+	}
+	vm.Script = script
+	vm.Compiled = compiled
 	vm.event(StatusSuccess, TypeLoad)
 	return nil
 }
@@ -284,6 +330,10 @@ func (vm *VM) getHash() string {
 	if err != nil {
 		log.Errorln(log.GCTScriptMgr, err)
 	}
+	return vm.hash(contents)
+}
+
+func (vm *VM) hash(contents []byte) string {
 	contents = append(contents, vm.ShortName()...)
 	return hex.EncodeToString(crypto.GetSHA256(contents))
 }
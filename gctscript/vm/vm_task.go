@@ -16,6 +16,11 @@ func (vm *VM) runner() {
 			select {
 			case <-waitTime.C:
 				vm.NextRun = time.Now().Add(vm.T)
+				if GCTScriptConfig.HotReload {
+					if err := vm.reload(); err != nil {
+						log.Error(log.GCTScriptMgr, err)
+					}
+				}
 				err := vm.RunCtx()
 				if err != nil {
 					log.Error(log.GCTScriptMgr, err)
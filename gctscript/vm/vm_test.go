@@ -2,6 +2,7 @@ package vm
 
 import (
 	"errors"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"reflect"
@@ -455,6 +456,99 @@ func TestAutoload(t *testing.T) {
 	}
 }
 
+func TestVMReload(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "reload.gct")
+	err = ioutil.WriteFile(file, []byte("x := 1"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testVM := NewVM()
+	err = testVM.Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	compiled, hash := testVM.Compiled, testVM.Hash
+	err = testVM.reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testVM.Compiled != compiled {
+		t.Fatal("expected unchanged script to not be recompiled")
+	}
+
+	err = ioutil.WriteFile(file, []byte("x := 2"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.reload()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if testVM.Compiled == compiled || testVM.Hash == hash {
+		t.Fatal("expected changed script to be recompiled")
+	}
+
+	compiled = testVM.Compiled
+	err = ioutil.WriteFile(file, []byte("x := "), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.reload()
+	if err == nil {
+		t.Fatal("expected broken script to fail reload")
+	}
+	if testVM.Compiled != compiled {
+		t.Fatal("expected previous byte code to be kept on failed reload")
+	}
+}
+
+func TestVMSandbox(t *testing.T) {
+	dir, err := ioutil.TempDir("", "gctscript")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	file := filepath.Join(dir, "sandbox.gct")
+	err = ioutil.WriteFile(file, []byte(`os := import("os")`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	testVM := NewVM()
+	err = testVM.Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err == nil {
+		t.Fatal("expected os module import to fail when sandboxed")
+	}
+
+	GCTScriptConfig.AllowOSModule = true
+	defer func() { GCTScriptConfig.AllowOSModule = false }()
+	err = testVM.Load(file)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = testVM.Compile()
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
 func TestVMCount(t *testing.T) {
 	var c vmscount
 	c.add()
@@ -1,10 +1,13 @@
 package exchange
 
 import (
+	"context"
 	"errors"
 	"fmt"
 	"strconv"
+	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/calendar"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
@@ -56,7 +59,7 @@ func (e Exchange) Ticker(exch string, pair currency.Pair, item asset.Item) (*tic
 		return nil, err
 	}
 
-	return ex.FetchTicker(pair, item)
+	return ex.FetchTicker(context.Background(), pair, item)
 }
 
 // Pairs returns either all or enabled currency pairs
@@ -79,7 +82,7 @@ func (e Exchange) QueryOrder(exch, orderID string) (*order.Detail, error) {
 		return nil, err
 	}
 
-	r, err := ex.GetOrderInfo(orderID)
+	r, err := ex.GetOrderInfo(context.Background(), orderID)
 	if err != nil {
 		return nil, err
 	}
@@ -125,7 +128,7 @@ func (e Exchange) AccountInformation(exch string) (account.Holdings, error) {
 		return account.Holdings{}, err
 	}
 
-	accountInfo, err := ex.FetchAccountInfo()
+	accountInfo, err := ex.FetchAccountInfo(context.Background())
 	if err != nil {
 		return account.Holdings{}, err
 	}
@@ -177,7 +180,7 @@ func (e Exchange) WithdrawalFiatFunds(exch, bankaccountid string, request *withd
 		return "", err
 	}
 
-	return ex.WithdrawFiatFunds(request)
+	return engine.WithdrawFiatFundsByExchange(ex.GetName(), request)
 }
 
 // WithdrawalCryptoFunds withdraw funds from exchange to requested Crypto source
@@ -200,5 +203,12 @@ func (e Exchange) WithdrawalCryptoFunds(exch string, request *withdraw.CryptoReq
 	if err != nil {
 		return "", err
 	}
-	return ex.WithdrawCryptocurrencyFunds(request)
+	return engine.WithdrawCryptocurrencyFundsByExchange(ex.GetName(), request)
+}
+
+// MarketStatus returns whether an exchange is open according to its trading
+// calendar. Exchanges without a calendar are always open
+func (e Exchange) MarketStatus(exch string) (calendar.Status, error) {
+	s, _ := engine.Bot.CalendarManager.Status(exch, time.Now())
+	return s, nil
 }
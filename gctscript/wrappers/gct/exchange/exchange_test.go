@@ -6,6 +6,7 @@ import (
 	"path/filepath"
 	"testing"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/engine"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -145,10 +146,10 @@ func TestExchange_SubmitOrder(t *testing.T) {
 		Pair:         currency.NewPairDelimiter(pairs, delimiter),
 		OrderType:    orderType,
 		OrderSide:    orderSide,
-		TriggerPrice: 0,
-		TargetAmount: 0,
-		Price:        orderPrice,
-		Amount:       orderAmount,
+		TriggerPrice: decimal.Zero,
+		TargetAmount: decimal.Zero,
+		Price:        decimal.NewFromInt(orderPrice),
+		Amount:       decimal.NewFromInt(orderAmount),
 		ClientID:     orderClientID,
 	}
 	_, err := exchangeTest.SubmitOrder(exchName, tempOrder)
@@ -0,0 +1,21 @@
+package portfolio
+
+import (
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+// Portfolio implements all required portfolio methods for Wrapper
+type Portfolio struct{}
+
+// PortfolioAddresses returns a copy of all tracked portfolio addresses
+func (p Portfolio) PortfolioAddresses() []portfolio.Address {
+	addresses := portfolio.GetPortfolio().Addresses
+	resp := make([]portfolio.Address, len(addresses))
+	copy(resp, addresses)
+	return resp
+}
+
+// PortfolioSummary returns the current portfolio summary
+func (p Portfolio) PortfolioSummary() portfolio.Summary {
+	return portfolio.GetPortfolio().GetPortfolioSummary()
+}
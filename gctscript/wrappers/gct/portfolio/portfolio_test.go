@@ -0,0 +1,37 @@
+package portfolio
+
+import (
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
+)
+
+func TestPortfolio(t *testing.T) {
+	portfolio.GetPortfolio().Seed(portfolio.Base{
+		Addresses: []portfolio.Address{
+			{
+				Address:     "1JCe8z4jJVNXSjohjM4i9Hh813dLCNx2Sy",
+				CoinType:    currency.BTC,
+				Balance:     decimal.NewFromInt(2),
+				Description: portfolio.PortfolioAddressPersonal,
+			},
+		},
+	})
+
+	var p Portfolio
+	addresses := p.PortfolioAddresses()
+	if len(addresses) != 1 {
+		t.Fatalf("expected 1 address received %v", len(addresses))
+	}
+	addresses[0].Address = "changed"
+	if portfolio.GetPortfolio().Addresses[0].Address == "changed" {
+		t.Fatal("expected PortfolioAddresses to return a copy")
+	}
+
+	summary := p.PortfolioSummary()
+	if len(summary.Totals) != 1 || !summary.Totals[0].Balance.Equal(decimal.NewFromInt(2)) {
+		t.Fatalf("unexpected portfolio summary totals %+v", summary.Totals)
+	}
+}
@@ -3,6 +3,8 @@ package validator
 import (
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/calendar"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/account"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
@@ -10,6 +12,7 @@ import (
 	"github.com/thrasher-corp/gocryptotrader/exchanges/orderbook"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/ticker"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/withdraw"
+	"github.com/thrasher-corp/gocryptotrader/portfolio"
 )
 
 // Exchanges validator for test execution/scripts
@@ -104,21 +107,21 @@ func (w Wrapper) QueryOrder(exch, _ string) (*order.Detail, error) {
 		OrderType:       "limit",
 		OrderDate:       time.Now(),
 		Status:          "cancelled",
-		Price:           1,
-		Amount:          2,
-		ExecutedAmount:  1,
-		RemainingAmount: 0,
-		Fee:             0,
+		Price:           decimal.NewFromInt(1),
+		Amount:          decimal.NewFromInt(2),
+		ExecutedAmount:  decimal.NewFromInt(1),
+		RemainingAmount: decimal.Zero,
+		Fee:             decimal.Zero,
 		Trades: []order.TradeHistory{
 			{
 				Timestamp:   time.Now(),
 				TID:         "",
-				Price:       1,
-				Amount:      2,
+				Price:       decimal.NewFromInt(1),
+				Amount:      decimal.NewFromInt(2),
 				Exchange:    exch,
 				Type:        "limit",
 				Side:        "ask",
-				Fee:         0,
+				Fee:         decimal.Zero,
 				Description: "",
 			},
 		},
@@ -174,8 +177,8 @@ func (w Wrapper) AccountInformation(exch string) (account.Holdings, error) {
 								AssocExchange: nil,
 							},
 						},
-						TotalValue: 100,
-						Hold:       0,
+						TotalValue: decimal.NewFromInt(100),
+						Hold:       decimal.Zero,
 					},
 				},
 			},
@@ -209,3 +212,44 @@ func (w Wrapper) WithdrawalFiatFunds(exch, _ string, _ *withdraw.FiatRequest) (o
 
 	return "123", nil
 }
+
+// MarketStatus validator for test execution/scripts
+func (w Wrapper) MarketStatus(exch string) (calendar.Status, error) {
+	if exch == exchError.String() {
+		return calendar.Status{}, errTestFailed
+	}
+
+	return calendar.Status{Open: true}, nil
+}
+
+// PortfolioAddresses validator for test execution/scripts
+func (w Wrapper) PortfolioAddresses() []portfolio.Address {
+	return []portfolio.Address{
+		{
+			Address:     "1JCe8z4jJVNXSjohjM4i9Hh813dLCNx2Sy",
+			CoinType:    currency.BTC,
+			Balance:     decimal.NewFromInt(1),
+			Description: portfolio.PortfolioAddressPersonal,
+		},
+	}
+}
+
+// PortfolioSummary validator for test execution/scripts
+func (w Wrapper) PortfolioSummary() portfolio.Summary {
+	return portfolio.Summary{
+		Totals: []portfolio.Coin{
+			{
+				Coin:       currency.BTC,
+				Balance:    decimal.NewFromInt(1),
+				Percentage: 100,
+			},
+		},
+		Offline: []portfolio.Coin{
+			{
+				Coin:       currency.BTC,
+				Balance:    decimal.NewFromInt(1),
+				Percentage: 100,
+			},
+		},
+	}
+}
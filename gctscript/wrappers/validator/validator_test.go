@@ -3,6 +3,7 @@ package validator
 import (
 	"testing"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/order"
@@ -97,6 +98,22 @@ func TestWrapper_DepositAddress(t *testing.T) {
 	}
 }
 
+func TestWrapper_MarketStatus(t *testing.T) {
+	t.Parallel()
+	_, err := testWrapper.MarketStatus(exchError.String())
+	if err == nil {
+		t.Fatal("expected MarketStatus to return error on invalid name")
+	}
+
+	s, err := testWrapper.MarketStatus(exchName)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.Open {
+		t.Error("expected the exchange to be open")
+	}
+}
+
 func TestWrapper_Orderbook(t *testing.T) {
 	t.Parallel()
 	c := currency.NewPairDelimiter(pairs, delimiter)
@@ -149,10 +166,10 @@ func TestWrapper_SubmitOrder(t *testing.T) {
 		Pair:         currency.NewPairDelimiter(pairs, delimiter),
 		OrderType:    orderType,
 		OrderSide:    orderSide,
-		TriggerPrice: 0,
-		TargetAmount: 0,
-		Price:        orderPrice,
-		Amount:       orderAmount,
+		TriggerPrice: decimal.Zero,
+		TargetAmount: decimal.Zero,
+		Price:        decimal.NewFromInt(orderPrice),
+		Amount:       decimal.NewFromInt(orderAmount),
 		ClientID:     orderClientID,
 	}
 	_, err := testWrapper.SubmitOrder("true", tempOrder)
@@ -203,3 +220,15 @@ func TestWrapper_WithdrawalFiatFunds(t *testing.T) {
 		t.Fatal("expected WithdrawalCryptoFunds to return error with invalid name")
 	}
 }
+
+func TestWrapper_PortfolioAddresses(t *testing.T) {
+	if len(testWrapper.PortfolioAddresses()) != 1 {
+		t.Fatal("expected PortfolioAddresses to return one address")
+	}
+}
+
+func TestWrapper_PortfolioSummary(t *testing.T) {
+	if len(testWrapper.PortfolioSummary().Totals) != 1 {
+		t.Fatal("expected PortfolioSummary to return one coin total")
+	}
+}
@@ -4,6 +4,7 @@ go 1.12
 
 require (
 	github.com/d5/tengo/v2 v2.0.2
+	github.com/decred/dcrd/dcrec/secp256k1/v4 v4.0.1
 	github.com/gofrs/uuid v3.2.0+incompatible
 	github.com/golang/protobuf v1.3.3
 	github.com/google/go-querystring v1.0.0
@@ -23,7 +24,7 @@ require (
 	github.com/urfave/cli v1.22.2
 	github.com/volatiletech/null v8.0.0+incompatible
 	golang.org/x/crypto v0.0.0-20190605123033-f99c8df09eb5
-	golang.org/x/sys v0.0.0-20191003212358-c178f38b412c // indirect
+	golang.org/x/sys v0.0.0-20191003212358-c178f38b412c
 	golang.org/x/time v0.0.0-20190308202827-9d24e82272b4
 	google.golang.org/genproto v0.0.0-20191002211648-c459b9ce5143
 	google.golang.org/grpc v1.27.1
@@ -1,9 +1,12 @@
 package log
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
 	"io"
+	"strconv"
+	"strings"
 	"time"
 )
 
@@ -16,9 +19,102 @@ func newLogger(c *Config) *Logger {
 		WarnHeader:        c.AdvancedSettings.Headers.Warn,
 		DebugHeader:       c.AdvancedSettings.Headers.Debug,
 		ShowLogSystemName: *c.AdvancedSettings.ShowLogSystemName,
+		JSON:              strings.EqualFold(c.AdvancedSettings.Format, FormatJSON),
+		SampleRate:        c.AdvancedSettings.SampleRate,
 	}
 }
 
+// newEvent writes a log line for a level in the configured format
+func (l *Logger) newEvent(level, data string, f *Fields, sl *subLogger) error {
+	if l.JSON {
+		return l.newJSONLogEvent(level, data, sl.name, f, sl.output)
+	}
+	return l.newLogEvent(appendFields(data, f), l.header(level), sl.name, sl.output)
+}
+
+func (l *Logger) header(level string) string {
+	switch level {
+	case levelInfo:
+		return l.InfoHeader
+	case levelWarn:
+		return l.WarnHeader
+	case levelDebug:
+		return l.DebugHeader
+	case levelError:
+		return l.ErrorHeader
+	}
+	return ""
+}
+
+// appendFields appends non empty fields to a text log line in key=value form
+func appendFields(data string, f *Fields) string {
+	if f == nil {
+		return data
+	}
+	newline := strings.HasSuffix(data, "\n")
+	data = strings.TrimSuffix(data, "\n")
+	if f.Exchange != "" {
+		data += " exchange=" + f.Exchange
+	}
+	if f.Pair != "" {
+		data += " pair=" + f.Pair
+	}
+	if f.CorrelationID != "" {
+		data += " correlation_id=" + f.CorrelationID
+	}
+	if f.Error != nil {
+		data += " error=" + strconv.Quote(f.Error.Error())
+	}
+	if newline {
+		data += "\n"
+	}
+	return data
+}
+
+func (l *Logger) newJSONLogEvent(level, data, slName string, f *Fields, w io.Writer) error {
+	if w == nil {
+		return errors.New("io.Writer not set")
+	}
+
+	entry := jsonEvent{
+		Timestamp: time.Now().UTC().Format(time.RFC3339Nano),
+		Level:     level,
+		Subsystem: slName,
+		Message:   strings.TrimSuffix(data, "\n"),
+	}
+	if f != nil {
+		entry.Exchange = f.Exchange
+		entry.Pair = f.Pair
+		entry.CorrelationID = f.CorrelationID
+		if f.Error != nil {
+			entry.Error = f.Error.Error()
+		}
+	}
+
+	e := eventPool.Get().(*Event)
+	e.output = w
+	var err error
+	e.data, err = appendJSON(e.data, &entry)
+	if err == nil {
+		_, err = e.output.Write(e.data)
+	}
+
+	e.data = e.data[:0]
+	eventPool.Put(e)
+
+	return err
+}
+
+// appendJSON appends the JSON encoding of v and a newline to b
+func appendJSON(b []byte, v interface{}) ([]byte, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return b, err
+	}
+	b = append(b, data...)
+	return append(b, '\n'), nil
+}
+
 func (l *Logger) newLogEvent(data, header, slName string, w io.Writer) error {
 	if w == nil {
 		return errors.New("io.Writer not set")
@@ -50,6 +146,7 @@ func (l *Logger) newLogEvent(data, header, slName string, w io.Writer) error {
 
 // CloseLogger is called on shutdown of application
 func CloseLogger() error {
+	closeRemoteSinks()
 	err := GlobalLogFile.Close()
 	if err != nil {
 		return err
@@ -84,3 +181,52 @@ func SetLevel(s, level string) (*Levels, error) {
 
 	return &logger.Levels, nil
 }
+
+// ExchangeLevel returns the level override for an exchange, the bool is false
+// when log lines for the exchange follow their sub logger levels
+func ExchangeLevel(exchName string) (Levels, bool) {
+	exchangeLevelsMtx.RLock()
+	defer exchangeLevelsMtx.RUnlock()
+	l, ok := exchangeLevels[strings.ToLower(exchName)]
+	return l, ok
+}
+
+// SetExchangeLevel sets an independent level for log lines tagged with an
+// exchange, overriding the level of the sub logger they are written to. An
+// empty level removes the override
+func SetExchangeLevel(exchName, level string) (*Levels, error) {
+	if exchName == "" {
+		return nil, errors.New("exchange name cannot be empty")
+	}
+	exchangeLevelsMtx.Lock()
+	defer exchangeLevelsMtx.Unlock()
+	if level == "" {
+		delete(exchangeLevels, strings.ToLower(exchName))
+		return &Levels{}, nil
+	}
+	level = strings.ToUpper(level)
+	if err := validLevel(level); err != nil {
+		return nil, err
+	}
+	l := splitLevel(level)
+	exchangeLevels[strings.ToLower(exchName)] = l
+	return &l, nil
+}
+
+// ExchangeDebugEnabled returns true when debug output has been enabled for an
+// exchange through its level override
+func ExchangeDebugEnabled(exchName string) bool {
+	l, ok := ExchangeLevel(exchName)
+	return ok && l.Debug
+}
+
+func validLevel(level string) error {
+	for _, l := range strings.Split(level, "|") {
+		switch l {
+		case levelInfo, levelDebug, levelWarn, levelError:
+		default:
+			return fmt.Errorf("invalid log level %q", l)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,222 @@
+package log
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"log"
+	"net"
+	"os"
+	"strings"
+	"sync/atomic"
+	"time"
+)
+
+// newRemoteSink returns a remote sink and starts its delivery routine
+func newRemoteSink(cfg *RemoteSinkConfig) *remoteSink {
+	s := &remoteSink{
+		name:     strings.ToLower(cfg.Name),
+		sinkType: strings.ToLower(cfg.Type),
+		network:  strings.ToLower(cfg.Network),
+		address:  cfg.Address,
+		shutdown: make(chan struct{}),
+	}
+	switch {
+	case s.sinkType == SinkTCP:
+		s.network = "tcp"
+	case s.network == "":
+		s.network = "udp"
+	}
+	bufferSize := cfg.BufferSize
+	if bufferSize <= 0 {
+		bufferSize = defaultSinkBufferSize
+	}
+	s.queue = make(chan []byte, bufferSize)
+	s.hostname, _ = os.Hostname()
+
+	s.wg.Add(1)
+	go s.run()
+	return s
+}
+
+// setupRemoteSinks closes any running remote sinks and starts the configured
+// sinks
+func setupRemoteSinks(cfgs []RemoteSinkConfig) {
+	closeRemoteSinks()
+
+	remoteSinksMu.Lock()
+	defer remoteSinksMu.Unlock()
+	for i := range cfgs {
+		s := newRemoteSink(&cfgs[i])
+		remoteSinks[s.name] = s
+	}
+}
+
+// getRemoteSink returns a configured remote sink by name
+func getRemoteSink(name string) (*remoteSink, bool) {
+	remoteSinksMu.Lock()
+	defer remoteSinksMu.Unlock()
+	s, ok := remoteSinks[strings.ToLower(name)]
+	return s, ok
+}
+
+// closeRemoteSinks flushes and closes all remote sinks
+func closeRemoteSinks() {
+	remoteSinksMu.Lock()
+	defer remoteSinksMu.Unlock()
+	for k := range remoteSinks {
+		remoteSinks[k].Close()
+		delete(remoteSinks, k)
+	}
+}
+
+// Write queues a log line for delivery, dropping it if the queue is full
+func (s *remoteSink) Write(p []byte) (int, error) {
+	b := make([]byte, len(p))
+	copy(b, p)
+	select {
+	case <-s.shutdown:
+	case s.queue <- b:
+	default:
+		atomic.AddInt64(&s.dropped, 1)
+	}
+	return len(p), nil
+}
+
+// Close stops the delivery routine once queued log lines have been sent
+func (s *remoteSink) Close() {
+	select {
+	case <-s.shutdown:
+		return
+	default:
+		close(s.shutdown)
+	}
+	s.wg.Wait()
+}
+
+func (s *remoteSink) run() {
+	defer s.wg.Done()
+	for {
+		select {
+		case b := <-s.queue:
+			s.send(b)
+		case <-s.shutdown:
+			for {
+				select {
+				case b := <-s.queue:
+					s.send(b)
+				default:
+					if s.conn != nil {
+						_ = s.conn.Close()
+					}
+					return
+				}
+			}
+		}
+	}
+}
+
+// send delivers a log line, the line is dropped if the server is unavailable
+func (s *remoteSink) send(b []byte) {
+	if s.conn == nil {
+		if time.Now().Before(s.nextDial) {
+			atomic.AddInt64(&s.dropped, 1)
+			return
+		}
+		conn, err := net.DialTimeout(s.network, s.address, sinkDialTimeout)
+		if err != nil {
+			s.failed(err)
+			atomic.AddInt64(&s.dropped, 1)
+			return
+		}
+		s.conn = conn
+	}
+
+	_ = s.conn.SetWriteDeadline(time.Now().Add(sinkWriteTimeout))
+	_, err := s.conn.Write(s.format(b))
+	if err != nil {
+		_ = s.conn.Close()
+		s.conn = nil
+		s.failed(err)
+		atomic.AddInt64(&s.dropped, 1)
+		return
+	}
+
+	if s.reporting {
+		s.reporting = false
+		log.Printf("Logger remote sink %s reconnected, %d log lines dropped\n",
+			s.name, atomic.SwapInt64(&s.dropped, 0))
+	}
+}
+
+// failed delays reconnection attempts and reports the first failure, the
+// standard library logger is used to avoid writing back into this sink
+func (s *remoteSink) failed(err error) {
+	s.nextDial = time.Now().Add(sinkRetryDelay)
+	if !s.reporting {
+		s.reporting = true
+		log.Printf("Logger remote sink %s unavailable, dropping log lines until reconnected: %v\n",
+			s.name, err)
+	}
+}
+
+// format frames a log line for the sink type. Log lines written with the JSON
+// format have their level and fields mapped to the remote message
+func (s *remoteSink) format(b []byte) []byte {
+	line := bytes.TrimRight(b, "\n")
+	var entry jsonEvent
+	if json.Unmarshal(line, &entry) != nil || entry.Level == "" {
+		entry = jsonEvent{Message: string(line)}
+	}
+
+	switch s.sinkType {
+	case SinkSyslog:
+		msgID := entry.Subsystem
+		if msgID == "" {
+			msgID = "-"
+		}
+		// RFC 5424 with the user facility
+		return []byte(fmt.Sprintf("<%d>1 %s %s %s %d %s - %s\n",
+			8+syslogSeverity(entry.Level),
+			time.Now().UTC().Format(time.RFC3339Nano),
+			s.hostname,
+			sinkAppName,
+			os.Getpid(),
+			msgID,
+			line))
+	case SinkGELF:
+		msg, err := json.Marshal(gelfMessage{
+			Version:      gelfVersion,
+			Host:         s.hostname,
+			ShortMessage: entry.Message,
+			Timestamp:    float64(time.Now().UnixNano()) / float64(time.Second),
+			Level:        syslogSeverity(entry.Level),
+			Subsystem:    entry.Subsystem,
+			Exchange:     entry.Exchange,
+			Pair:         entry.Pair,
+			Error:        entry.Error,
+		})
+		if err != nil {
+			return nil
+		}
+		if s.network != "udp" {
+			// GELF TCP messages are null byte delimited
+			msg = append(msg, 0)
+		}
+		return msg
+	}
+	return append(line, '\n')
+}
+
+// syslogSeverity maps a log level to a syslog severity, which GELF also uses
+func syslogSeverity(level string) int {
+	switch level {
+	case levelError:
+		return 3
+	case levelWarn:
+		return 4
+	case levelDebug:
+		return 7
+	}
+	return 6
+}
@@ -0,0 +1,74 @@
+package log
+
+import (
+	"net"
+	"sync"
+	"time"
+)
+
+// Remote sink types
+const (
+	SinkSyslog = "syslog"
+	SinkGELF   = "gelf"
+	SinkTCP    = "tcp"
+)
+
+const (
+	defaultSinkBufferSize = 1000
+	sinkDialTimeout       = time.Second * 5
+	sinkWriteTimeout      = time.Second * 5
+	sinkRetryDelay        = time.Second * 10
+	sinkAppName           = "gocryptotrader"
+	gelfVersion           = "1.1"
+)
+
+// remoteSinks holds the configured remote sinks by name
+var (
+	remoteSinks   = map[string]*remoteSink{}
+	remoteSinksMu sync.Mutex
+)
+
+// RemoteSinkConfig defines a remote log output which can be referenced by
+// name in a logger output, for example "console|graylog"
+type RemoteSinkConfig struct {
+	Name    string `json:"name"`
+	Type    string `json:"type"`
+	Network string `json:"network,omitempty"`
+	Address string `json:"address"`
+	// BufferSize is the amount of log lines queued while the sink is slow or
+	// unavailable, further log lines are dropped
+	BufferSize int `json:"bufferSize,omitempty"`
+}
+
+// remoteSink is an io.Writer which queues log lines and delivers them to a
+// remote log server in the background, so a slow or unavailable server never
+// blocks the caller
+type remoteSink struct {
+	name     string
+	sinkType string
+	network  string
+	address  string
+	hostname string
+
+	queue    chan []byte
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	dropped  int64
+
+	conn      net.Conn
+	nextDial  time.Time
+	reporting bool
+}
+
+// gelfMessage is a Graylog Extended Log Format message
+type gelfMessage struct {
+	Version      string  `json:"version"`
+	Host         string  `json:"host"`
+	ShortMessage string  `json:"short_message"`
+	Timestamp    float64 `json:"timestamp"`
+	Level        int     `json:"level"`
+	Subsystem    string  `json:"_subsystem,omitempty"`
+	Exchange     string  `json:"_exchange,omitempty"`
+	Pair         string  `json:"_pair,omitempty"`
+	Error        string  `json:"_error,omitempty"`
+}
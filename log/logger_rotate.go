@@ -1,9 +1,14 @@
 package log
 
 import (
+	"compress/gzip"
 	"fmt"
+	"io"
+	"io/ioutil"
 	"os"
 	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common/file"
@@ -77,13 +82,20 @@ func (r *Rotate) openNew() error {
 	_, err := os.Stat(name)
 
 	if err == nil {
-		timestamp := time.Now().Format("2006-01-02T15-04-05")
+		timestamp := time.Now().Format(backupTimeFormat)
 		newName := filepath.Join(LogPath, timestamp+"-"+r.FileName)
 
 		err = file.Move(name, newName)
 		if err != nil {
 			return fmt.Errorf("can't rename log file: %s", err)
 		}
+		if r.Compress || r.MaxBackups > 0 || r.MaxAge > 0 {
+			go func() {
+				if errMill := r.mill(newName); errMill != nil {
+					displayError(errMill)
+				}
+			}()
+		}
 	}
 
 	file, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
@@ -132,3 +144,103 @@ func (r *Rotate) maxSize() int64 {
 	}
 	return r.MaxSize * int64(megabyte)
 }
+
+// mill compresses a newly rotated log file and removes rotated log files
+// exceeding the max backups or max age
+func (r *Rotate) mill(rotated string) error {
+	r.millMu.Lock()
+	defer r.millMu.Unlock()
+
+	if r.Compress {
+		err := compressFile(rotated)
+		if err != nil {
+			return fmt.Errorf("can't compress log file: %s", err)
+		}
+	}
+
+	backups, err := r.backups()
+	if err != nil {
+		return err
+	}
+
+	var cutoff time.Time
+	if r.MaxAge > 0 {
+		cutoff = time.Now().Add(-time.Duration(r.MaxAge) * time.Hour * 24)
+	}
+	for i := range backups {
+		if (r.MaxBackups > 0 && i >= r.MaxBackups) ||
+			(!cutoff.IsZero() && backups[i].timestamp.Before(cutoff)) {
+			err = os.Remove(filepath.Join(LogPath, backups[i].name))
+			if err != nil && !os.IsNotExist(err) {
+				return fmt.Errorf("can't remove old log file: %s", err)
+			}
+		}
+	}
+	return nil
+}
+
+// backups returns the rotated log files, newest first
+func (r *Rotate) backups() ([]backup, error) {
+	files, err := ioutil.ReadDir(LogPath)
+	if err != nil {
+		return nil, fmt.Errorf("can't read log directory: %s", err)
+	}
+
+	suffix := "-" + r.FileName
+	var backups []backup
+	for i := range files {
+		if files[i].IsDir() {
+			continue
+		}
+		name := strings.TrimSuffix(files[i].Name(), compressSuffix)
+		if !strings.HasSuffix(name, suffix) {
+			continue
+		}
+		t, errParse := time.ParseInLocation(backupTimeFormat,
+			strings.TrimSuffix(name, suffix), time.Local)
+		if errParse != nil {
+			continue
+		}
+		backups = append(backups, backup{name: files[i].Name(), timestamp: t})
+	}
+
+	sort.Slice(backups, func(i, j int) bool {
+		return backups[i].timestamp.After(backups[j].timestamp)
+	})
+	return backups, nil
+}
+
+// compressFile gzips a file and removes the original
+func compressFile(name string) error {
+	src, err := os.Open(name)
+	if err != nil {
+		return err
+	}
+
+	err = gzipTo(name+compressSuffix, src)
+	if errClose := src.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		_ = os.Remove(name + compressSuffix)
+		return err
+	}
+	return os.Remove(name)
+}
+
+func gzipTo(name string, src io.Reader) error {
+	dst, err := os.OpenFile(name, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0600)
+	if err != nil {
+		return err
+	}
+
+	gz := gzip.NewWriter(dst)
+	_, err = io.Copy(gz, src)
+	if err == nil {
+		err = gz.Close()
+	}
+	if errClose := dst.Close(); err == nil {
+		err = errClose
+	}
+	return err
+}
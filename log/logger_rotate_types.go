@@ -3,11 +3,15 @@ package log
 import (
 	"os"
 	"sync"
+	"time"
 )
 
 const (
 	defaultMaxSize = 250
 	megabyte       = 1024 * 1024
+
+	backupTimeFormat = "2006-01-02T15-04-05"
+	compressSuffix   = ".gz"
 )
 
 // Rotate struct for each instance of Rotate
@@ -15,8 +19,21 @@ type Rotate struct {
 	FileName string
 	Rotate   *bool
 	MaxSize  int64
+	// MaxBackups is the number of rotated log files to keep, 0 keeps all
+	MaxBackups int
+	// MaxAge is the number of days to keep rotated log files, 0 keeps all
+	MaxAge int
+	// Compress gzips rotated log files
+	Compress bool
 
 	size   int64
 	output *os.File
 	mu     sync.Mutex
+	millMu sync.Mutex
+}
+
+// backup is a rotated log file
+type backup struct {
+	name      string
+	timestamp time.Time
 }
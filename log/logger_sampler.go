@@ -0,0 +1,73 @@
+package log
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"sync/atomic"
+)
+
+// sampleCounters holds the number of lines logged per sampled key
+var sampleCounters sync.Map // map[string]*uint64
+
+// Sample returns a logger which writes 1 in every N lines logged for key,
+// where N is the configured sample rate, so very chatty paths such as per
+// message websocket logging remain usable at the debug level. Written lines
+// are annotated with the number of lines logged for the key so far
+func Sample(sl *subLogger, key string) SampledLogger {
+	return SampledLogger{fl: FieldLogger{sl: sl}, key: key}
+}
+
+// Sample returns a sampled logger which attaches the fields of fl, lines are
+// sampled separately for each exchange
+func (fl FieldLogger) Sample(key string) SampledLogger {
+	return SampledLogger{fl: fl, key: key}
+}
+
+// Infof formats and writes a sampled info log line
+func (s SampledLogger) Infof(data string, v ...interface{}) {
+	if s.fl.sl == nil || !enabled() || !s.fl.levels().Info {
+		return
+	}
+	s.write(levelInfo, data, v)
+}
+
+// Debugf formats and writes a sampled debug log line
+func (s SampledLogger) Debugf(data string, v ...interface{}) {
+	if s.fl.sl == nil || !enabled() || !s.fl.levels().Debug {
+		return
+	}
+	s.write(levelDebug, data, v)
+}
+
+func (s SampledLogger) write(level, data string, v []interface{}) {
+	rate := logger.SampleRate
+	if rate <= 1 {
+		displayError(logger.newEvent(level, fmt.Sprintf(data, v...), s.fl.fields, s.fl.sl))
+		return
+	}
+	n := s.next()
+	if (n-1)%rate != 0 {
+		return
+	}
+	msg := fmt.Sprintf(data, v...)
+	newline := strings.HasSuffix(msg, "\n")
+	msg = fmt.Sprintf("%s [sampled 1/%d, %d total]", strings.TrimSuffix(msg, "\n"), rate, n)
+	if newline {
+		msg += "\n"
+	}
+	displayError(logger.newEvent(level, msg, s.fl.fields, s.fl.sl))
+}
+
+// next increments and returns the count of lines logged for the key
+func (s SampledLogger) next() uint64 {
+	key := s.fl.sl.name + spacer + s.key
+	if s.fl.fields != nil && s.fl.fields.Exchange != "" {
+		key += spacer + s.fl.fields.Exchange
+	}
+	c, ok := sampleCounters.Load(key)
+	if !ok {
+		c, _ = sampleCounters.LoadOrStore(key, new(uint64))
+	}
+	return atomic.AddUint64(c.(*uint64), 1)
+}
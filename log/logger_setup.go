@@ -26,6 +26,10 @@ func getWriters(s *SubLoggerConfig) io.Writer {
 				m.Add(GlobalLogFile)
 			}
 		default:
+			if sink, ok := getRemoteSink(outputWriters[x]); ok {
+				m.Add(sink)
+				continue
+			}
 			m.Add(ioutil.Discard)
 		}
 	}
@@ -44,8 +48,10 @@ func GenDefaultSettings() (log Config) {
 			FileName: "log.txt",
 			Rotate:   convert.BoolPtr(false),
 			MaxSize:  0,
+			Compress: convert.BoolPtr(false),
 		},
 		AdvancedSettings: advancedSettings{
+			Format:            FormatText,
 			ShowLogSystemName: convert.BoolPtr(false),
 			Spacer:            spacer,
 			TimeStampFormat:   timestampFormat,
@@ -89,12 +95,19 @@ func SetupSubLoggers(s []SubLoggerConfig) {
 func SetupGlobalLogger() {
 	if FileLoggingConfiguredCorrectly {
 		GlobalLogFile = &Rotate{
-			FileName: GlobalLogConfig.LoggerFileConfig.FileName,
-			MaxSize:  GlobalLogConfig.LoggerFileConfig.MaxSize,
-			Rotate:   GlobalLogConfig.LoggerFileConfig.Rotate,
+			FileName:   GlobalLogConfig.LoggerFileConfig.FileName,
+			MaxSize:    GlobalLogConfig.LoggerFileConfig.MaxSize,
+			Rotate:     GlobalLogConfig.LoggerFileConfig.Rotate,
+			MaxBackups: GlobalLogConfig.LoggerFileConfig.MaxBackups,
+			MaxAge:     GlobalLogConfig.LoggerFileConfig.MaxAge,
+		}
+		if GlobalLogConfig.LoggerFileConfig.Compress != nil {
+			GlobalLogFile.Compress = *GlobalLogConfig.LoggerFileConfig.Compress
 		}
 	}
 
+	setupRemoteSinks(GlobalLogConfig.RemoteSinks)
+
 	for x := range subLoggers {
 		subLoggers[x].Levels = splitLevel(GlobalLogConfig.Level)
 		subLoggers[x].output = getWriters(&GlobalLogConfig.SubLoggerConfig)
@@ -107,13 +120,13 @@ func splitLevel(level string) (l Levels) {
 	enabledLevels := strings.Split(level, "|")
 	for x := range enabledLevels {
 		switch level := enabledLevels[x]; level {
-		case "DEBUG":
+		case levelDebug:
 			l.Debug = true
-		case "INFO":
+		case levelInfo:
 			l.Info = true
-		case "WARN":
+		case levelWarn:
 			l.Warn = true
-		case "ERROR":
+		case levelError:
 			l.Error = true
 		}
 	}
@@ -1,11 +1,17 @@
 package log
 
 import (
+	"bufio"
 	"bytes"
+	"encoding/json"
+	"errors"
 	"io/ioutil"
+	"net"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
+	"time"
 )
 
 var (
@@ -268,3 +274,302 @@ func TestSubLoggerName(t *testing.T) {
 		t.Error("Unexpected SUBLOGGER in output")
 	}
 }
+
+func TestNewJSONLogEvent(t *testing.T) {
+	w := &bytes.Buffer{}
+	l := Logger{JSON: true}
+	sl := subLogger{"EXCHANGE", splitLevel("INFO|WARN|DEBUG|ERROR"), w}
+	err := l.newEvent(levelError, "order rejected\n", &Fields{
+		Exchange:      "Binance",
+		Pair:          "BTC-USDT",
+		CorrelationID: "abc-123",
+		Error:         errors.New("insufficient funds"),
+	}, &sl)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var entry jsonEvent
+	err = json.Unmarshal(w.Bytes(), &entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if entry.Level != levelError ||
+		entry.Subsystem != "EXCHANGE" ||
+		entry.Exchange != "Binance" ||
+		entry.Pair != "BTC-USDT" ||
+		entry.CorrelationID != "abc-123" ||
+		entry.Message != "order rejected" ||
+		entry.Error != "insufficient funds" {
+		t.Errorf("unexpected JSON log entry %+v", entry)
+	}
+	if _, err = time.Parse(time.RFC3339Nano, entry.Timestamp); err != nil {
+		t.Error(err)
+	}
+
+	err = l.newJSONLogEvent(levelInfo, "out", "SUBLOGGER", nil, nil)
+	if err == nil {
+		t.Error("Error expected with output is set to nil")
+	}
+}
+
+func TestWithFields(t *testing.T) {
+	SetupTest()
+	w := &bytes.Buffer{}
+	sl := subLogger{"EXCHANGE", splitLevel("INFO|ERROR"), w}
+
+	WithFields(&sl, Fields{Exchange: "Binance", Error: errors.New("timeout")}).Errorf("failed %d", 1)
+	if !strings.Contains(w.String(), `failed 1 exchange=Binance error="timeout"`) {
+		t.Errorf("unexpected output %s", w.String())
+	}
+
+	w.Reset()
+	WithFields(&sl, Fields{Exchange: "Binance", CorrelationID: "abc-123"}).Infof("submitted\n")
+	if !strings.Contains(w.String(), "submitted exchange=Binance correlation_id=abc-123\n") {
+		t.Errorf("unexpected output %s", w.String())
+	}
+
+	w.Reset()
+	WithFields(&sl, Fields{}).Debugf("hidden")
+	if w.String() != "" {
+		t.Error("Expected output buffer to be empty but Debugf wrote to output")
+	}
+}
+
+func TestSetExchangeLevel(t *testing.T) {
+	SetupTest()
+	w := &bytes.Buffer{}
+	sl := subLogger{"EXCHANGE", splitLevel("INFO|ERROR"), w}
+
+	_, err := SetExchangeLevel("Binance", "INFO|VERBOSE")
+	if err == nil {
+		t.Error("Expected error on invalid level")
+	}
+	_, err = SetExchangeLevel("", "DEBUG")
+	if err == nil {
+		t.Error("Expected error on empty exchange name")
+	}
+
+	l, err := SetExchangeLevel("Binance", "debug|error")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !l.Debug || !l.Error || l.Info || l.Warn {
+		t.Errorf("failed to set level correctly %+v", l)
+	}
+	if !ExchangeDebugEnabled("BINANCE") {
+		t.Error("Expected debug to be enabled for Binance")
+	}
+
+	WithFields(&sl, Fields{Exchange: "Binance"}).Debugf("shown")
+	if !strings.Contains(w.String(), "shown exchange=Binance") {
+		t.Errorf("unexpected output %s", w.String())
+	}
+	w.Reset()
+	WithFields(&sl, Fields{Exchange: "Binance"}).Infof("hidden")
+	WithFields(&sl, Fields{Exchange: "Bitstamp"}).Debugf("hidden")
+	if w.String() != "" {
+		t.Errorf("Expected output buffer to be empty, received %s", w.String())
+	}
+
+	_, err = SetExchangeLevel("Binance", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := ExchangeLevel("Binance"); ok {
+		t.Error("Expected exchange level override to be removed")
+	}
+}
+
+func TestSample(t *testing.T) {
+	SetupTest()
+	w := &bytes.Buffer{}
+	sl := subLogger{"WEBSOCKET", splitLevel("INFO|DEBUG"), w}
+	oldRate := logger.SampleRate
+	defer func() { logger.SampleRate = oldRate }()
+
+	logger.SampleRate = 0
+	for i := 0; i < 3; i++ {
+		Sample(&sl, "all").Debugf("message %d\n", i)
+	}
+	if n := strings.Count(w.String(), "message"); n != 3 {
+		t.Errorf("expected every line to be written when sampling is disabled, received %d", n)
+	}
+
+	w.Reset()
+	logger.SampleRate = 3
+	for i := 1; i <= 7; i++ {
+		WithFields(&sl, Fields{Exchange: "Binance"}).Sample("received").Debugf("message %d\n", i)
+	}
+	out := w.String()
+	if n := strings.Count(out, "message"); n != 3 {
+		t.Errorf("expected 3 sampled lines, received %d: %s", n, out)
+	}
+	for _, expected := range []string{
+		"message 1 [sampled 1/3, 1 total] exchange=Binance",
+		"message 4 [sampled 1/3, 4 total] exchange=Binance",
+		"message 7 [sampled 1/3, 7 total] exchange=Binance",
+	} {
+		if !strings.Contains(out, expected) {
+			t.Errorf("expected output to contain %q, received %s", expected, out)
+		}
+	}
+
+	// Each exchange is sampled separately
+	w.Reset()
+	WithFields(&sl, Fields{Exchange: "Bitstamp"}).Sample("received").Debugf("message 1\n")
+	if !strings.Contains(w.String(), "[sampled 1/3, 1 total]") {
+		t.Errorf("unexpected output %s", w.String())
+	}
+}
+
+func TestSetErrorHook(t *testing.T) {
+	SetupTest()
+	var received []string
+	SetErrorHook(func(subLogger, message string) {
+		received = append(received, subLogger+": "+message)
+	})
+	defer SetErrorHook(nil)
+
+	sl := subLogger{"EXCHANGE", splitLevel("INFO|ERROR"), ioutil.Discard}
+	Errorf(&sl, "failed %d", 1)
+	Errorln(&sl, "failed", 2)
+	WithFields(&sl, Fields{Exchange: "Binance"}).Errorf("failed %d", 3)
+	Infof(&sl, "not an error")
+
+	expected := []string{"EXCHANGE: failed 1", "EXCHANGE: failed 2", "EXCHANGE: failed 3 exchange=Binance"}
+	if len(received) != len(expected) {
+		t.Fatalf("expected %v, received %v", expected, received)
+	}
+	for i := range expected {
+		if received[i] != expected[i] {
+			t.Errorf("expected %s, received %s", expected[i], received[i])
+		}
+	}
+}
+
+func TestRotateMill(t *testing.T) {
+	tempDir, err := ioutil.TempDir("", "gct-logs")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(tempDir)
+	oldPath := LogPath
+	LogPath = tempDir
+	defer func() { LogPath = oldPath }()
+
+	now := time.Now()
+	var names []string
+	for _, age := range []time.Duration{0, time.Hour, time.Hour * 2, time.Hour * 24 * 10} {
+		name := filepath.Join(tempDir, now.Add(-age).Format(backupTimeFormat)+"-log.txt")
+		err = ioutil.WriteFile(name, []byte("log line\n"), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		names = append(names, name)
+	}
+	err = ioutil.WriteFile(filepath.Join(tempDir, "log.txt"), []byte("current\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	r := Rotate{FileName: "log.txt", MaxBackups: 2, MaxAge: 7, Compress: true}
+	err = r.mill(names[0])
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err = os.Stat(names[0] + compressSuffix); err != nil {
+		t.Error("expected newest rotated log file to be compressed")
+	}
+	if _, err = os.Stat(names[1]); err != nil {
+		t.Error("expected second rotated log file to be kept")
+	}
+	for _, removed := range names[2:] {
+		if _, err = os.Stat(removed); !os.IsNotExist(err) {
+			t.Errorf("expected %s to be removed", removed)
+		}
+	}
+	if _, err = os.Stat(filepath.Join(tempDir, "log.txt")); err != nil {
+		t.Error("current log file should not be removed")
+	}
+}
+
+func TestRemoteSink(t *testing.T) {
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer l.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, errAccept := l.Accept()
+		if errAccept != nil {
+			return
+		}
+		defer conn.Close()
+		line, _ := bufio.NewReader(conn).ReadString(0)
+		received <- line
+	}()
+
+	s := newRemoteSink(&RemoteSinkConfig{
+		Name:    "graylog",
+		Type:    SinkGELF,
+		Network: "tcp",
+		Address: l.Addr().String(),
+	})
+	defer s.Close()
+	_, err = s.Write([]byte(`{"timestamp":"2020-03-01T00:00:00Z","level":"WARN","subsystem":"ORDER","exchange":"Binance","message":"rejected"}` + "\n"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	select {
+	case line := <-received:
+		var msg gelfMessage
+		err = json.Unmarshal([]byte(strings.TrimSuffix(line, "\x00")), &msg)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if msg.Version != gelfVersion ||
+			msg.ShortMessage != "rejected" ||
+			msg.Level != 4 ||
+			msg.Subsystem != "ORDER" ||
+			msg.Exchange != "Binance" {
+			t.Errorf("unexpected GELF message %+v", msg)
+		}
+	case <-time.After(time.Second * 5):
+		t.Fatal("timed out waiting for remote sink delivery")
+	}
+}
+
+func TestRemoteSinkFormat(t *testing.T) {
+	s := remoteSink{sinkType: SinkSyslog, hostname: "bot"}
+	out := string(s.format([]byte("[ERROR] | 01/03/2020 | failed\n")))
+	if !strings.HasPrefix(out, "<14>1 ") || !strings.HasSuffix(out, " - [ERROR] | 01/03/2020 | failed\n") {
+		t.Errorf("unexpected syslog message %q", out)
+	}
+
+	s.sinkType = SinkTCP
+	if out = string(s.format([]byte("line\n"))); out != "line\n" {
+		t.Errorf("unexpected TCP message %q", out)
+	}
+}
+
+func TestRemoteSinkNonBlocking(t *testing.T) {
+	s := &remoteSink{
+		name:     "unreachable",
+		queue:    make(chan []byte, 1),
+		shutdown: make(chan struct{}),
+	}
+	for i := 0; i < 3; i++ {
+		_, err := s.Write([]byte("line\n"))
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	if s.dropped != 2 {
+		t.Errorf("expected 2 dropped log lines, got %d", s.dropped)
+	}
+}
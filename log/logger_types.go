@@ -10,6 +10,18 @@ const (
 	spacer          = " | "
 	// DefaultMaxFileSize for logger rotation file
 	DefaultMaxFileSize int64 = 100
+
+	// FormatText outputs human readable log lines using the configured
+	// headers, spacer and timestamp format
+	FormatText = "text"
+	// FormatJSON outputs a JSON object per log line for ingestion by log
+	// aggregators
+	FormatJSON = "json"
+
+	levelInfo  = "INFO"
+	levelWarn  = "WARN"
+	levelDebug = "DEBUG"
+	levelError = "ERROR"
 )
 
 var (
@@ -31,22 +43,30 @@ var (
 
 	// LogPath system path to store log files in
 	LogPath string
+
+	exchangeLevels    = make(map[string]Levels)
+	exchangeLevelsMtx sync.RWMutex
 )
 
 // Config holds configuration settings loaded from bot config
 type Config struct {
 	Enabled *bool `json:"enabled"`
 	SubLoggerConfig
-	LoggerFileConfig *loggerFileConfig `json:"fileSettings,omitempty"`
-	AdvancedSettings advancedSettings  `json:"advancedSettings"`
-	SubLoggers       []SubLoggerConfig `json:"subloggers,omitempty"`
+	LoggerFileConfig *loggerFileConfig  `json:"fileSettings,omitempty"`
+	AdvancedSettings advancedSettings   `json:"advancedSettings"`
+	SubLoggers       []SubLoggerConfig  `json:"subloggers,omitempty"`
+	RemoteSinks      []RemoteSinkConfig `json:"remoteSinks,omitempty"`
 }
 
 type advancedSettings struct {
+	Format            string  `json:"format,omitempty"`
 	ShowLogSystemName *bool   `json:"showLogSystemName"`
 	Spacer            string  `json:"spacer"`
 	TimeStampFormat   string  `json:"timeStampFormat"`
 	Headers           headers `json:"headers"`
+	// SampleRate writes 1 in every SampleRate lines of sampled log paths,
+	// 0 or 1 writes every line
+	SampleRate uint64 `json:"sampleRate,omitempty"`
 }
 
 type headers struct {
@@ -64,19 +84,28 @@ type SubLoggerConfig struct {
 }
 
 type loggerFileConfig struct {
-	FileName string `json:"filename,omitempty"`
-	Rotate   *bool  `json:"rotate,omitempty"`
-	MaxSize  int64  `json:"maxsize,omitempty"`
+	FileName   string `json:"filename,omitempty"`
+	Rotate     *bool  `json:"rotate,omitempty"`
+	MaxSize    int64  `json:"maxsize,omitempty"`
+	MaxBackups int    `json:"maxbackups,omitempty"`
+	MaxAge     int    `json:"maxage,omitempty"`
+	Compress   *bool  `json:"compress,omitempty"`
 }
 
 // Logger each instance of logger settings
 type Logger struct {
 	ShowLogSystemName                                bool
+	JSON                                             bool
+	SampleRate                                       uint64
 	Timestamp                                        string
 	InfoHeader, ErrorHeader, DebugHeader, WarnHeader string
 	Spacer                                           string
 }
 
+// ErrorHook is called with the sub logger name and message of each error log
+// line
+type ErrorHook func(subLogger, message string)
+
 // Levels flags for each sub logger type
 type Levels struct {
 	Info, Debug, Warn, Error bool
@@ -88,6 +117,40 @@ type subLogger struct {
 	output io.Writer
 }
 
+// Fields holds structured context attached to a log line, empty fields are
+// omitted from the output
+type Fields struct {
+	Exchange      string
+	Pair          string
+	CorrelationID string
+	Error         error
+}
+
+// FieldLogger writes log lines with structured fields to a sub logger
+type FieldLogger struct {
+	sl     *subLogger
+	fields *Fields
+}
+
+// SampledLogger writes 1 in every N log lines for a key so very chatty paths
+// remain usable at the debug level
+type SampledLogger struct {
+	fl  FieldLogger
+	key string
+}
+
+// jsonEvent is a log line written when the JSON format is enabled
+type jsonEvent struct {
+	Timestamp     string `json:"timestamp"`
+	Level         string `json:"level"`
+	Subsystem     string `json:"subsystem"`
+	Exchange      string `json:"exchange,omitempty"`
+	Pair          string `json:"pair,omitempty"`
+	CorrelationID string `json:"correlation_id,omitempty"`
+	Message       string `json:"message"`
+	Error         string `json:"error,omitempty"`
+}
+
 // Event holds the data sent to the log and which multiwriter to send to
 type Event struct {
 	data   []byte
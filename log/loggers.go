@@ -3,8 +3,12 @@ package log
 import (
 	"fmt"
 	"log"
+	"strings"
+	"sync/atomic"
 )
 
+var errorHook atomic.Value // ErrorHook
+
 // Info takes a pointer subLogger struct and string sends to newLogEvent
 func Info(sl *subLogger, data string) {
 	if sl == nil || !enabled() {
@@ -15,7 +19,7 @@ func Info(sl *subLogger, data string) {
 		return
 	}
 
-	displayError(logger.newLogEvent(data, logger.InfoHeader, sl.name, sl.output))
+	displayError(logger.newEvent(levelInfo, data, nil, sl))
 }
 
 // Infoln takes a pointer subLogger struct and interface sends to newLogEvent
@@ -28,7 +32,7 @@ func Infoln(sl *subLogger, v ...interface{}) {
 		return
 	}
 
-	displayError(logger.newLogEvent(fmt.Sprintln(v...), logger.InfoHeader, sl.name, sl.output))
+	displayError(logger.newEvent(levelInfo, fmt.Sprintln(v...), nil, sl))
 }
 
 // Infof takes a pointer subLogger struct, string & interface formats and sends to Info()
@@ -54,7 +58,7 @@ func Debug(sl *subLogger, data string) {
 		return
 	}
 
-	displayError(logger.newLogEvent(data, logger.DebugHeader, sl.name, sl.output))
+	displayError(logger.newEvent(levelDebug, data, nil, sl))
 }
 
 // Debugln  takes a pointer subLogger struct, string and interface sends to newLogEvent
@@ -67,7 +71,7 @@ func Debugln(sl *subLogger, v ...interface{}) {
 		return
 	}
 
-	displayError(logger.newLogEvent(fmt.Sprintln(v...), logger.DebugHeader, sl.name, sl.output))
+	displayError(logger.newEvent(levelDebug, fmt.Sprintln(v...), nil, sl))
 }
 
 // Debugf takes a pointer subLogger struct, string & interface formats and sends to Info()
@@ -93,7 +97,7 @@ func Warn(sl *subLogger, data string) {
 		return
 	}
 
-	displayError(logger.newLogEvent(data, logger.WarnHeader, sl.name, sl.output))
+	displayError(logger.newEvent(levelWarn, data, nil, sl))
 }
 
 // Warnln takes a pointer subLogger struct & interface formats and sends to newLogEvent()
@@ -106,7 +110,7 @@ func Warnln(sl *subLogger, v ...interface{}) {
 		return
 	}
 
-	displayError(logger.newLogEvent(fmt.Sprintln(v...), logger.WarnHeader, sl.name, sl.output))
+	displayError(logger.newEvent(levelWarn, fmt.Sprintln(v...), nil, sl))
 }
 
 // Warnf takes a pointer subLogger struct, string & interface formats and sends to Warn()
@@ -132,7 +136,9 @@ func Error(sl *subLogger, data ...interface{}) {
 		return
 	}
 
-	displayError(logger.newLogEvent(fmt.Sprint(data...), logger.ErrorHeader, sl.name, sl.output))
+	msg := fmt.Sprint(data...)
+	displayError(logger.newEvent(levelError, msg, nil, sl))
+	callErrorHook(sl, msg)
 }
 
 // Errorln takes a pointer subLogger struct, string & interface formats and sends to newLogEvent()
@@ -145,7 +151,9 @@ func Errorln(sl *subLogger, v ...interface{}) {
 		return
 	}
 
-	displayError(logger.newLogEvent(fmt.Sprintln(v...), logger.ErrorHeader, sl.name, sl.output))
+	msg := fmt.Sprintln(v...)
+	displayError(logger.newEvent(levelError, msg, nil, sl))
+	callErrorHook(sl, msg)
 }
 
 // Errorf takes a pointer subLogger struct, string & interface formats and sends to Debug()
@@ -161,6 +169,70 @@ func Errorf(sl *subLogger, data string, v ...interface{}) {
 	Error(sl, fmt.Sprintf(data, v...))
 }
 
+// WithFields returns a logger which attaches structured fields, such as the
+// exchange, pair and error, to each log line written to a sub logger
+func WithFields(sl *subLogger, f Fields) FieldLogger {
+	return FieldLogger{sl: sl, fields: &f}
+}
+
+// Infof formats and writes an info log line with fields
+func (fl FieldLogger) Infof(data string, v ...interface{}) {
+	if fl.sl == nil || !enabled() || !fl.levels().Info {
+		return
+	}
+	displayError(logger.newEvent(levelInfo, fmt.Sprintf(data, v...), fl.fields, fl.sl))
+}
+
+// Debugf formats and writes a debug log line with fields
+func (fl FieldLogger) Debugf(data string, v ...interface{}) {
+	if fl.sl == nil || !enabled() || !fl.levels().Debug {
+		return
+	}
+	displayError(logger.newEvent(levelDebug, fmt.Sprintf(data, v...), fl.fields, fl.sl))
+}
+
+// Warnf formats and writes a warning log line with fields
+func (fl FieldLogger) Warnf(data string, v ...interface{}) {
+	if fl.sl == nil || !enabled() || !fl.levels().Warn {
+		return
+	}
+	displayError(logger.newEvent(levelWarn, fmt.Sprintf(data, v...), fl.fields, fl.sl))
+}
+
+// Errorf formats and writes an error log line with fields
+func (fl FieldLogger) Errorf(data string, v ...interface{}) {
+	if fl.sl == nil || !enabled() || !fl.levels().Error {
+		return
+	}
+	msg := fmt.Sprintf(data, v...)
+	displayError(logger.newEvent(levelError, msg, fl.fields, fl.sl))
+	callErrorHook(fl.sl, appendFields(msg, fl.fields))
+}
+
+// levels returns the exchange level override when one is set for the
+// exchange field, otherwise the sub logger levels
+func (fl FieldLogger) levels() Levels {
+	if fl.fields != nil && fl.fields.Exchange != "" {
+		if l, ok := ExchangeLevel(fl.fields.Exchange); ok {
+			return l
+		}
+	}
+	return fl.sl.Levels
+}
+
+// SetErrorHook sets a function which is called with every error log line
+// written, such as to forward errors to an error reporting service. A nil hook
+// removes it
+func SetErrorHook(h ErrorHook) {
+	errorHook.Store(h)
+}
+
+func callErrorHook(sl *subLogger, message string) {
+	if h, _ := errorHook.Load().(ErrorHook); h != nil {
+		h(sl.name, strings.TrimSuffix(message, "\n"))
+	}
+}
+
 func displayError(err error) {
 	if err != nil {
 		log.Printf("Logger write error: %v\n", err)
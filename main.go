@@ -1,32 +1,44 @@
 package main
 
 import (
+	"context"
+	"encoding/json"
 	"flag"
 	"fmt"
 	"log"
 	"os"
+	"path/filepath"
 	"runtime"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/file"
 	"github.com/thrasher-corp/gocryptotrader/config"
 	"github.com/thrasher-corp/gocryptotrader/core"
 	"github.com/thrasher-corp/gocryptotrader/dispatch"
 	"github.com/thrasher-corp/gocryptotrader/engine"
+	"github.com/thrasher-corp/gocryptotrader/errorreport"
 	"github.com/thrasher-corp/gocryptotrader/exchanges/request"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
 	"github.com/thrasher-corp/gocryptotrader/gctscript"
 	gctscriptVM "github.com/thrasher-corp/gocryptotrader/gctscript/vm"
 	gctlog "github.com/thrasher-corp/gocryptotrader/log"
-	"github.com/thrasher-corp/gocryptotrader/signaler"
+	"github.com/thrasher-corp/gocryptotrader/service"
+	"github.com/thrasher-corp/gocryptotrader/update"
 )
 
 func main() {
+	defer errorreport.Recover()
+
 	// Handle flags
 	var settings engine.Settings
 	versionFlag := flag.Bool("version", false, "retrieves current GoCryptoTrader version")
+	serviceAction := flag.String("service", "", "manages the GoCryptoTrader service: install, uninstall, start, stop or status. Flags given alongside install are passed to the service")
+	serviceName := flag.String("servicename", service.DefaultName, "the name of the GoCryptoTrader service")
 
 	// Core settings
 	flag.StringVar(&settings.ConfigFile, "config", config.DefaultFilePath(), "config file to load")
+	flag.StringVar(&settings.ConfigKeyFile, "configkeyfile", "", "file containing the config encryption key, used instead of prompting for it")
 	flag.StringVar(&settings.DataDir, "datadir", common.GetDefaultDataDir(runtime.GOOS), "default data directory for GoCryptoTrader files")
 	flag.IntVar(&settings.GoMaxProcs, "gomaxprocs", runtime.GOMAXPROCS(-1), "sets the runtime GOMAXPROCS value")
 	flag.BoolVar(&settings.EnableDryRun, "dryrun", false, "dry runs bot, doesn't save config file")
@@ -45,6 +57,7 @@ func main() {
 	flag.BoolVar(&settings.EnableCoinmarketcapAnalysis, "coinmarketcap", false, "overrides config and runs currency analysis")
 	flag.BoolVar(&settings.EnableEventManager, "eventmanager", true, "enables the event manager")
 	flag.BoolVar(&settings.EnableOrderManager, "ordermanager", true, "enables the order manager")
+	flag.DurationVar(&settings.ShutdownTimeout, "shutdowntimeout", engine.DefaultShutdownTimeout, "sets how long in-flight orders are given to resolve on shutdown before exchange requests are aborted")
 	flag.BoolVar(&settings.EnableDepositAddressManager, "depositaddressmanager", true, "enables the deposit address manager")
 	flag.BoolVar(&settings.EnableConnectivityMonitor, "connectivitymonitor", true, "enables the connectivity monitor")
 	flag.BoolVar(&settings.EnableDatabaseManager, "databasemanager", true, "enables database manager")
@@ -79,11 +92,21 @@ func main() {
 	flag.BoolVar(&settings.ExchangePurgeCredentials, "exchangepurgecredentials", false, "purges the stored exchange API credentials")
 	flag.BoolVar(&settings.EnableExchangeHTTPRateLimiter, "ratelimiter", true, "enables the rate limiter for HTTP requests")
 	flag.IntVar(&settings.MaxHTTPRequestJobsLimit, "requestjobslimit", int(request.DefaultMaxRequestJobs), "sets the max amount of jobs the HTTP request package stores")
-	flag.IntVar(&settings.RequestTimeoutRetryAttempts, "exchangehttptimeoutretryattempts", request.DefaultTimeoutRetryAttempts, "sets the amount of retry attempts after a HTTP request times out")
+	flag.IntVar(&settings.RequestTimeoutRetryAttempts, "exchangehttptimeoutretryattempts", request.DefaultTimeoutRetryAttempts, "sets the amount of retry attempts after a HTTP request fails with a network error, 429 or 5xx status")
 	flag.DurationVar(&settings.ExchangeHTTPTimeout, "exchangehttptimeout", time.Duration(0), "sets the exchangs HTTP timeout value for HTTP requests")
 	flag.StringVar(&settings.ExchangeHTTPUserAgent, "exchangehttpuseragent", "", "sets the exchanges HTTP user agent")
 	flag.StringVar(&settings.ExchangeHTTPProxy, "exchangehttpproxy", "", "sets the exchanges HTTP proxy server")
 	flag.BoolVar(&settings.EnableExchangeHTTPDebugging, "exchangehttpdebugging", false, "sets the exchanges HTTP debugging")
+	flag.StringVar(&settings.WebsocketCaptureDir, "websocketcapture", "", "records the raw websocket frames of each exchange to a capture file in this directory")
+	flag.StringVar(&settings.WebsocketReplayDir, "websocketreplay", "", "replays the websocket frames captured in this directory instead of connecting to exchanges")
+	flag.StringVar(&settings.HTTPRecordDir, "httprecord", "", "records the exchanges HTTP responses to a fixture file per exchange in this directory")
+	flag.StringVar(&settings.HTTPReplayDir, "httpreplay", "", "serves the exchanges HTTP responses recorded in this directory instead of sending requests")
+	flag.IntVar(&settings.WebsocketQueueCapacity, "websocketqueuecapacity", wshandler.DefaultQueueCapacity, "sets how much websocket data may wait to be processed before tickers are dropped and orderbook updates coalesced")
+	flag.DurationVar(&settings.ChaosLatency, "chaoslatency", time.Duration(0), "chaos testing: delays each exchange REST request and websocket message by this latency")
+	flag.DurationVar(&settings.ChaosJitter, "chaosjitter", time.Duration(0), "chaos testing: adds a random delay up to this jitter to each exchange REST request and websocket message")
+	flag.Float64Var(&settings.ChaosRESTErrorRate, "chaosresterrors", 0, "chaos testing: the fraction of exchange REST requests failed with an injected error, between 0 and 1")
+	flag.Float64Var(&settings.ChaosWebsocketDropRate, "chaoswebsocketdrops", 0, "chaos testing: the fraction of exchange websocket messages dropped, between 0 and 1")
+	flag.StringVar(&settings.ChaosExchanges, "chaosexchanges", "", "chaos testing: a comma separated list of the exchanges faults are injected into, all exchanges when empty")
 
 	// Common tuning settings
 	flag.DurationVar(&settings.GlobalHTTPTimeout, "globalhttptimeout", time.Duration(0), "sets common HTTP timeout value for HTTP requests")
@@ -100,10 +123,36 @@ func main() {
 		os.Exit(0)
 	}
 
+	if flag.Arg(0) == "update" {
+		runUpdate(flag.Args()[1:])
+		os.Exit(0)
+	}
+
+	if flag.Arg(0) == "config" {
+		runConfig(&settings, flag.Args()[1:])
+		os.Exit(0)
+	}
+
+	if *serviceAction != "" {
+		result, err := service.Control(*serviceName, *serviceAction, serviceArgs(&settings))
+		if err != nil {
+			log.Fatalf("Unable to %s service %s. Error: %s\n", *serviceAction, *serviceName, err)
+		}
+		fmt.Println(result)
+		os.Exit(0)
+	}
+
 	fmt.Println(core.Banner)
 	fmt.Println(core.Version(false))
 
 	var err error
+	if !file.Exists(settings.ConfigFile) && isTerminal(os.Stdin) {
+		err = engine.RunSetupWizard(settings.ConfigFile, settings.ConfigKeyFile, os.Stdin, os.Stdout)
+		if err != nil {
+			log.Fatalf("Unable to create config. Error: %s\n", err)
+		}
+	}
+
 	settings.CheckParamInteraction = true
 	engine.Bot, err = engine.NewFromSettings(&settings)
 	if engine.Bot == nil || err != nil {
@@ -113,13 +162,140 @@ func main() {
 	gctscript.Setup()
 
 	engine.PrintSettings(&engine.Bot.Settings)
-	if err = engine.Bot.Start(); err != nil {
+	if err = service.Run(*serviceName, engine.Bot); err != nil {
 		gctlog.Errorf(gctlog.Global, "Unable to start bot engine. Error: %s\n", err)
 		os.Exit(1)
 	}
-
-	interrupt := signaler.WaitForInterrupt()
-	gctlog.Infof(gctlog.Global, "Captured %v, shutdown requested.\n", interrupt)
-	engine.Bot.Stop()
 	gctlog.Infoln(gctlog.Global, "Exiting.")
 }
+
+// isTerminal reports whether f is a terminal, which setup questions can be
+// asked on
+func isTerminal(f *os.File) bool {
+	info, err := f.Stat()
+	return err == nil && info.Mode()&os.ModeCharDevice != 0
+}
+
+// runUpdate handles the update command, replacing the running binary with
+// the latest release or rolling it back
+func runUpdate(args []string) {
+	var opts update.Options
+	fs := flag.NewFlagSet("update", flag.ExitOnError)
+	fs.BoolVar(&opts.Check, "check", false, "only reports whether a newer release is available")
+	fs.BoolVar(&opts.Force, "force", false, "installs the latest release even if it isn't newer")
+	fs.BoolVar(&opts.Rollback, "rollback", false, "restores the binary replaced by the last update")
+	fs.BoolVar(&opts.SkipSignature, "skipsignature", false, "updates with only the release checksums verified, for builds without a release public key")
+	_ = fs.Parse(args)
+
+	u, err := update.New("gocryptotrader")
+	if err != nil {
+		log.Fatalf("Unable to update. Error: %s\n", err)
+	}
+	err = u.Run(context.Background(), opts, os.Stdout)
+	if err != nil {
+		log.Fatalf("Unable to update. Error: %s\n", err)
+	}
+}
+
+// runConfig handles the config command, printing the settings which differ
+// from their defaults or exporting the config with its credentials redacted
+func runConfig(settings *engine.Settings, args []string) {
+	fs := flag.NewFlagSet("config", flag.ExitOnError)
+	out := fs.String("out", "", "the file the redacted config is exported to, stdout if empty")
+	fs.Usage = func() {
+		fmt.Fprintln(fs.Output(), "Usage: gocryptotrader config diff|export [-out file]")
+		fs.PrintDefaults()
+	}
+	if len(args) == 0 {
+		fs.Usage()
+		os.Exit(2)
+	}
+	action := args[0]
+	_ = fs.Parse(args[1:])
+
+	// Config check warnings are logged to stderr, leaving stdout for the
+	// diff or export
+	logCfg := gctlog.GenDefaultSettings()
+	logCfg.Level = "WARN|ERROR"
+	logCfg.Output = "stderr"
+	gctlog.GlobalLogConfig = &logCfg
+	gctlog.SetupGlobalLogger()
+
+	config.SetEncryptionKeyFile(settings.ConfigKeyFile)
+	var cfg config.Config
+	err := cfg.ReadConfig(settings.ConfigFile, true)
+	if err != nil {
+		log.Fatalf("Unable to read config file %s. Error: %s\n", settings.ConfigFile, err)
+	}
+
+	switch action {
+	case "diff":
+		err = cfg.CheckConfig()
+		if err != nil {
+			log.Fatalf("Config is invalid. Error: %s\n", err)
+		}
+		var defaults *config.Config
+		defaults, err = engine.DefaultConfig(&cfg)
+		if err != nil {
+			log.Fatalf("Unable to determine config defaults. Error: %s\n", err)
+		}
+		var diffs []config.Difference
+		diffs, err = cfg.Diff(defaults)
+		if err != nil {
+			log.Fatalf("Unable to diff config. Error: %s\n", err)
+		}
+		for i := range diffs {
+			value, _ := json.Marshal(diffs[i].Value)
+			def, _ := json.Marshal(diffs[i].Default)
+			fmt.Printf("%s: %s (default %s)\n", diffs[i].Path, value, def)
+		}
+	case "export":
+		redacted, err := cfg.Redacted()
+		if err != nil {
+			log.Fatalf("Unable to redact config. Error: %s\n", err)
+		}
+		data, err := json.MarshalIndent(redacted, "", " ")
+		if err != nil {
+			log.Fatalf("Unable to export config. Error: %s\n", err)
+		}
+		if *out == "" {
+			fmt.Println(string(data))
+			return
+		}
+		err = file.Write(*out, data)
+		if err != nil {
+			log.Fatalf("Unable to write %s. Error: %s\n", *out, err)
+		}
+		fmt.Printf("Exported config with its credentials redacted to %s.\n", *out)
+	default:
+		fs.Usage()
+		os.Exit(2)
+	}
+}
+
+// serviceArgs returns the flags the service is run with, being the flags set
+// on the command line other than the service flags. The config file and data
+// directory are always set as absolute paths as the service is run by another
+// user from another working directory.
+func serviceArgs(settings *engine.Settings) []string {
+	var args []string
+	flag.Visit(func(f *flag.Flag) {
+		switch f.Name {
+		case "service", "servicename", "config", "datadir":
+			return
+		}
+		args = append(args, "-"+f.Name+"="+f.Value.String())
+	})
+	paths := []struct{ name, path string }{
+		{"config", settings.ConfigFile},
+		{"datadir", settings.DataDir},
+	}
+	for i := range paths {
+		path := paths[i].path
+		if abs, err := filepath.Abs(path); err == nil {
+			path = abs
+		}
+		args = append(args, "-"+paths[i].name+"="+path)
+	}
+	return args
+}
@@ -0,0 +1,150 @@
+package metrics
+
+import (
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewHealthTracker returns an empty exchange health tracker
+func NewHealthTracker() *HealthTracker {
+	return &HealthTracker{exchanges: make(map[string]*exchangeHealth)}
+}
+
+// Record records a completed REST request. A request errors when err is set or
+// the status code is not 2xx and counts as a ban when the exchange responds
+// with a rate limit or ban status code
+func (h *HealthTracker) Record(exchange, endpoint string, latency time.Duration, statusCode int, err error) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	e, ok := h.exchanges[exchange]
+	if !ok {
+		e = &exchangeHealth{endpoints: make(map[string]*endpointHealth)}
+		h.exchanges[exchange] = e
+	}
+	ep, ok := e.endpoints[endpoint]
+	if !ok {
+		if len(e.endpoints) >= maxHealthEndpoints {
+			endpoint = otherEndpoint
+			ep = e.endpoints[endpoint]
+		}
+		if ep == nil {
+			ep = &endpointHealth{}
+			e.endpoints[endpoint] = ep
+		}
+	}
+
+	e.requests++
+	ep.requests++
+	if len(ep.samples) < healthSamples {
+		ep.samples = append(ep.samples, latency)
+	} else {
+		ep.samples[ep.next] = latency
+		ep.next = (ep.next + 1) % healthSamples
+	}
+
+	if err == nil && statusCode >= http.StatusOK && statusCode < http.StatusMultipleChoices {
+		return
+	}
+	e.errors++
+	ep.errors++
+	e.lastErrorTime = time.Now()
+	if err != nil {
+		e.lastError = err.Error()
+	} else {
+		e.lastError = "HTTP status " + strconv.Itoa(statusCode)
+	}
+	if IsBanStatus(statusCode) {
+		e.bans++
+	}
+}
+
+// Summary returns the health summary of an exchange
+func (h *HealthTracker) Summary(exchange string) (ExchangeHealth, bool) {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	for name, e := range h.exchanges {
+		if strings.EqualFold(name, exchange) {
+			return e.summary(name), true
+		}
+	}
+	return ExchangeHealth{}, false
+}
+
+// Summaries returns the health summary of every tracked exchange sorted by
+// name
+func (h *HealthTracker) Summaries() []ExchangeHealth {
+	h.mtx.RLock()
+	defer h.mtx.RUnlock()
+	resp := make([]ExchangeHealth, 0, len(h.exchanges))
+	for name, e := range h.exchanges {
+		resp = append(resp, e.summary(name))
+	}
+	sort.Slice(resp, func(i, j int) bool { return resp[i].Exchange < resp[j].Exchange })
+	return resp
+}
+
+func (e *exchangeHealth) summary(name string) ExchangeHealth {
+	s := ExchangeHealth{
+		Exchange:      name,
+		Requests:      e.requests,
+		Errors:        e.errors,
+		Bans:          e.bans,
+		LastError:     e.lastError,
+		LastErrorTime: e.lastErrorTime,
+	}
+	for endpoint, ep := range e.endpoints {
+		samples := make([]time.Duration, len(ep.samples))
+		copy(samples, ep.samples)
+		sort.Slice(samples, func(i, j int) bool { return samples[i] < samples[j] })
+		s.Endpoints = append(s.Endpoints, EndpointHealth{
+			Endpoint: endpoint,
+			Requests: ep.requests,
+			Errors:   ep.errors,
+			P50:      percentile(samples, 50),
+			P90:      percentile(samples, 90),
+			P99:      percentile(samples, 99),
+		})
+	}
+	sort.Slice(s.Endpoints, func(i, j int) bool { return s.Endpoints[i].Endpoint < s.Endpoints[j].Endpoint })
+	return s
+}
+
+// percentile returns the nearest rank percentile of sorted samples
+func percentile(sorted []time.Duration, p int) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	rank := (p*len(sorted) + 99) / 100
+	if rank < 1 {
+		rank = 1
+	}
+	return sorted[rank-1]
+}
+
+// IsBanStatus returns true for HTTP status codes exchanges use to signal rate
+// limit violations or IP bans
+func IsBanStatus(statusCode int) bool {
+	return statusCode == http.StatusTooManyRequests || statusCode == http.StatusTeapot
+}
+
+// RecordRESTRequest records a completed exchange REST request in the latency
+// histogram, the error and ban counters and the default health tracker. A zero
+// status code means no response was received
+func RecordRESTRequest(exchange, endpoint string, start time.Time, statusCode int, err error) {
+	latency := time.Since(start)
+	status := "error"
+	if statusCode != 0 {
+		status = strconv.Itoa(statusCode)
+	}
+	RESTRequestDuration.Observe(latency.Seconds(), exchange, status)
+	if err != nil || statusCode < http.StatusOK || statusCode >= http.StatusMultipleChoices {
+		RESTErrors.Inc(exchange)
+	}
+	if IsBanStatus(statusCode) {
+		RESTBans.Inc(exchange)
+	}
+	Health.Record(exchange, endpoint, latency, statusCode, err)
+}
@@ -0,0 +1,75 @@
+package metrics
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestHealthTracker(t *testing.T) {
+	h := NewHealthTracker()
+	for i := 1; i <= 100; i++ {
+		h.Record("Bitstamp", "/api/v2/ticker", time.Duration(i)*time.Millisecond, http.StatusOK, nil)
+	}
+	h.Record("Bitstamp", "/api/v2/balance", time.Second, http.StatusTooManyRequests, nil)
+	h.Record("Bitstamp", "/api/v2/balance", time.Second, 0, errors.New("timeout"))
+
+	if _, ok := h.Summary("Kraken"); ok {
+		t.Error("expected no summary for untracked exchange")
+	}
+	s, ok := h.Summary("bitstamp")
+	if !ok {
+		t.Fatal("expected summary for Bitstamp")
+	}
+	if s.Requests != 102 || s.Errors != 2 || s.Bans != 1 {
+		t.Errorf("unexpected counts %+v", s)
+	}
+	if s.LastError != "timeout" || s.LastErrorTime.IsZero() {
+		t.Errorf("unexpected last error %v %v", s.LastError, s.LastErrorTime)
+	}
+	if len(s.Endpoints) != 2 || s.Endpoints[0].Endpoint != "/api/v2/balance" {
+		t.Fatalf("unexpected endpoints %+v", s.Endpoints)
+	}
+	ticker := s.Endpoints[1]
+	if ticker.P50 != 50*time.Millisecond ||
+		ticker.P90 != 90*time.Millisecond ||
+		ticker.P99 != 99*time.Millisecond {
+		t.Errorf("unexpected percentiles %v %v %v", ticker.P50, ticker.P90, ticker.P99)
+	}
+	if len(h.Summaries()) != 1 {
+		t.Error("expected one exchange summary")
+	}
+}
+
+func TestHealthTrackerLimits(t *testing.T) {
+	h := NewHealthTracker()
+	for i := 0; i < maxHealthEndpoints+5; i++ {
+		h.Record("Bitstamp", "/order/"+strconv.Itoa(i), time.Millisecond, http.StatusOK, nil)
+	}
+	s, _ := h.Summary("Bitstamp")
+	if len(s.Endpoints) != maxHealthEndpoints+1 {
+		t.Errorf("expected %d endpoints, received %d", maxHealthEndpoints+1, len(s.Endpoints))
+	}
+
+	for i := 0; i < healthSamples+10; i++ {
+		h.Record("Kraken", "/0/public/Time", time.Duration(i), http.StatusOK, nil)
+	}
+	if n := len(h.exchanges["Kraken"].endpoints["/0/public/Time"].samples); n != healthSamples {
+		t.Errorf("expected %d samples, received %d", healthSamples, n)
+	}
+}
+
+func TestRecordRESTRequest(t *testing.T) {
+	RecordRESTRequest("TestExchange", "/ticker", time.Now(), http.StatusTeapot, nil)
+	if RESTErrors.Value("TestExchange") != 1 || RESTBans.Value("TestExchange") != 1 {
+		t.Error("expected error and ban to be counted")
+	}
+	if RESTRequestDuration.Count("TestExchange", "418") != 1 {
+		t.Error("expected latency to be observed")
+	}
+	if _, ok := Health.Summary("TestExchange"); !ok {
+		t.Error("expected request to be tracked")
+	}
+}
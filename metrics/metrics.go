@@ -0,0 +1,344 @@
+// Package metrics provides counters, gauges and histograms which are exposed
+// in the Prometheus text exposition format
+package metrics
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// NewRegistry returns an empty registry
+func NewRegistry() *Registry {
+	return &Registry{names: make(map[string]struct{})}
+}
+
+// Register adds metrics to the registry, it panics if a metric name is
+// already registered as this is a programming error
+func (r *Registry) Register(cs ...collector) {
+	r.mtx.Lock()
+	defer r.mtx.Unlock()
+	for i := range cs {
+		name := cs[i].desc().name
+		if _, ok := r.names[name]; ok {
+			panic("metrics: duplicate metric registered " + name)
+		}
+		r.names[name] = struct{}{}
+		r.collectors = append(r.collectors, cs[i])
+	}
+}
+
+// WriteTo writes all registered metrics in the Prometheus text format
+func (r *Registry) WriteTo(out io.Writer) (int64, error) {
+	r.mtx.RLock()
+	cs := make([]collector, len(r.collectors))
+	copy(cs, r.collectors)
+	r.mtx.RUnlock()
+
+	sort.Slice(cs, func(i, j int) bool {
+		return cs[i].desc().name < cs[j].desc().name
+	})
+
+	w := &writer{w: bufio.NewWriter(out)}
+	for i := range cs {
+		d := cs[i].desc()
+		w.printf("# HELP %s %s\n", d.name, escapeHelp(d.help))
+		w.printf("# TYPE %s %s\n", d.name, d.typ)
+		cs[i].write(w)
+	}
+	if w.err == nil {
+		w.err = w.w.Flush()
+	}
+	return w.n, w.err
+}
+
+// Handler returns a HTTP handler which serves the registry metrics
+func (r *Registry) Handler() http.Handler {
+	return http.HandlerFunc(func(rw http.ResponseWriter, _ *http.Request) {
+		rw.Header().Set("Content-Type", ContentType)
+		_, _ = r.WriteTo(rw)
+	})
+}
+
+// NewCounterVec returns a counter partitioned by the label names
+func NewCounterVec(name, help string, labels ...string) *CounterVec {
+	return &CounterVec{
+		d:      desc{name: name, help: help, typ: typeCounter, labels: labels},
+		series: make(map[string]*counterSeries),
+	}
+}
+
+func (c *CounterVec) desc() *desc {
+	return &c.d
+}
+
+// Inc increments the counter for the label values by one
+func (c *CounterVec) Inc(labelValues ...string) {
+	c.Add(1, labelValues...)
+}
+
+// Add adds a non negative value to the counter for the label values
+func (c *CounterVec) Add(v float64, labelValues ...string) {
+	if v < 0 {
+		return
+	}
+	c.mtx.Lock()
+	key := seriesKey(labelValues)
+	s, ok := c.series[key]
+	if !ok {
+		s = &counterSeries{labels: copyLabels(labelValues)}
+		c.series[key] = s
+	}
+	s.value += v
+	c.mtx.Unlock()
+}
+
+// Value returns the counter value for the label values
+func (c *CounterVec) Value(labelValues ...string) float64 {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	if s, ok := c.series[seriesKey(labelValues)]; ok {
+		return s.value
+	}
+	return 0
+}
+
+func (c *CounterVec) write(w *writer) {
+	c.mtx.Lock()
+	defer c.mtx.Unlock()
+	for _, k := range sortedKeys(c.series) {
+		s := c.series[k]
+		w.sample(c.d.name, c.d.labels, s.labels, "", "", s.value)
+	}
+}
+
+// NewGaugeVec returns a gauge partitioned by the label names
+func NewGaugeVec(name, help string, labels ...string) *GaugeVec {
+	return &GaugeVec{
+		d:      desc{name: name, help: help, typ: typeGauge, labels: labels},
+		series: make(map[string]*gaugeSeries),
+	}
+}
+
+func (g *GaugeVec) desc() *desc {
+	return &g.d
+}
+
+// Set sets the gauge for the label values
+func (g *GaugeVec) Set(v float64, labelValues ...string) {
+	g.mtx.Lock()
+	key := seriesKey(labelValues)
+	s, ok := g.series[key]
+	if !ok {
+		s = &gaugeSeries{labels: copyLabels(labelValues)}
+		g.series[key] = s
+	}
+	s.value = v
+	g.mtx.Unlock()
+}
+
+// Value returns the gauge value for the label values
+func (g *GaugeVec) Value(labelValues ...string) float64 {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	if s, ok := g.series[seriesKey(labelValues)]; ok {
+		return s.value
+	}
+	return 0
+}
+
+func (g *GaugeVec) write(w *writer) {
+	g.mtx.Lock()
+	defer g.mtx.Unlock()
+	for _, k := range sortedKeys(g.series) {
+		s := g.series[k]
+		w.sample(g.d.name, g.d.labels, s.labels, "", "", s.value)
+	}
+}
+
+// NewHistogramVec returns a histogram partitioned by the label names, the
+// default buckets are used if none are supplied
+func NewHistogramVec(name, help string, buckets []float64, labels ...string) *HistogramVec {
+	if len(buckets) == 0 {
+		buckets = DefaultBuckets
+	}
+	b := make([]float64, len(buckets))
+	copy(b, buckets)
+	sort.Float64s(b)
+	return &HistogramVec{
+		d:       desc{name: name, help: help, typ: typeHistogram, labels: labels},
+		buckets: b,
+		series:  make(map[string]*histogramSeries),
+	}
+}
+
+func (h *HistogramVec) desc() *desc {
+	return &h.d
+}
+
+// Observe records a value for the label values
+func (h *HistogramVec) Observe(v float64, labelValues ...string) {
+	h.mtx.Lock()
+	key := seriesKey(labelValues)
+	s, ok := h.series[key]
+	if !ok {
+		s = &histogramSeries{
+			labels: copyLabels(labelValues),
+			counts: make([]uint64, len(h.buckets)),
+		}
+		h.series[key] = s
+	}
+	for i := range h.buckets {
+		if v <= h.buckets[i] {
+			s.counts[i]++
+		}
+	}
+	s.sum += v
+	s.count++
+	h.mtx.Unlock()
+}
+
+// ObserveSince records the seconds elapsed since start for the label values,
+// it can be deferred at the start of an operation
+func (h *HistogramVec) ObserveSince(start time.Time, labelValues ...string) {
+	h.Observe(time.Since(start).Seconds(), labelValues...)
+}
+
+// Count returns the amount of observations for the label values
+func (h *HistogramVec) Count(labelValues ...string) uint64 {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	if s, ok := h.series[seriesKey(labelValues)]; ok {
+		return s.count
+	}
+	return 0
+}
+
+func (h *HistogramVec) write(w *writer) {
+	h.mtx.Lock()
+	defer h.mtx.Unlock()
+	for _, k := range sortedKeys(h.series) {
+		s := h.series[k]
+		for i := range h.buckets {
+			w.sample(h.d.name+"_bucket", h.d.labels, s.labels,
+				"le", formatFloat(h.buckets[i]), float64(s.counts[i]))
+		}
+		w.sample(h.d.name+"_bucket", h.d.labels, s.labels, "le", "+Inf", float64(s.count))
+		w.sample(h.d.name+"_sum", h.d.labels, s.labels, "", "", s.sum)
+		w.sample(h.d.name+"_count", h.d.labels, s.labels, "", "", float64(s.count))
+	}
+}
+
+// writer tracks the bytes written and first error encountered
+type writer struct {
+	w   *bufio.Writer
+	n   int64
+	err error
+}
+
+func (w *writer) printf(format string, a ...interface{}) {
+	if w.err != nil {
+		return
+	}
+	n, err := fmt.Fprintf(w.w, format, a...)
+	w.n += int64(n)
+	w.err = err
+}
+
+// sample writes a single sample line, with an optional extra label such as a
+// histogram bucket bound
+func (w *writer) sample(name string, labelNames, labelValues []string, extraName, extraValue string, v float64) {
+	var sb strings.Builder
+	sb.WriteString(name)
+	if len(labelNames) > 0 || extraName != "" {
+		sb.WriteByte('{')
+		for i := range labelNames {
+			if i > 0 {
+				sb.WriteByte(',')
+			}
+			var value string
+			if i < len(labelValues) {
+				value = labelValues[i]
+			}
+			sb.WriteString(labelNames[i])
+			sb.WriteString(`="`)
+			sb.WriteString(escapeLabel(value))
+			sb.WriteByte('"')
+		}
+		if extraName != "" {
+			if len(labelNames) > 0 {
+				sb.WriteByte(',')
+			}
+			sb.WriteString(extraName)
+			sb.WriteString(`="`)
+			sb.WriteString(extraValue)
+			sb.WriteByte('"')
+		}
+		sb.WriteByte('}')
+	}
+	sb.WriteByte(' ')
+	sb.WriteString(formatFloat(v))
+	sb.WriteByte('\n')
+	w.printf("%s", sb.String())
+}
+
+func formatFloat(v float64) string {
+	switch {
+	case math.IsInf(v, 1):
+		return "+Inf"
+	case math.IsInf(v, -1):
+		return "-Inf"
+	case math.IsNaN(v):
+		return "NaN"
+	}
+	return strconv.FormatFloat(v, 'g', -1, 64)
+}
+
+var (
+	labelEscaper = strings.NewReplacer(`\`, `\\`, "\n", `\n`, `"`, `\"`)
+	helpEscaper  = strings.NewReplacer(`\`, `\\`, "\n", `\n`)
+)
+
+func escapeLabel(s string) string {
+	return labelEscaper.Replace(s)
+}
+
+func escapeHelp(s string) string {
+	return helpEscaper.Replace(s)
+}
+
+func seriesKey(labelValues []string) string {
+	return strings.Join(labelValues, labelSeparator)
+}
+
+func copyLabels(labelValues []string) []string {
+	l := make([]string, len(labelValues))
+	copy(l, labelValues)
+	return l
+}
+
+func sortedKeys(m interface{}) []string {
+	var keys []string
+	switch series := m.(type) {
+	case map[string]*counterSeries:
+		for k := range series {
+			keys = append(keys, k)
+		}
+	case map[string]*gaugeSeries:
+		for k := range series {
+			keys = append(keys, k)
+		}
+	case map[string]*histogramSeries:
+		for k := range series {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	return keys
+}
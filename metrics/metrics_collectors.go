@@ -0,0 +1,113 @@
+package metrics
+
+// Metrics recorded across the codebase, all are registered with the default
+// registry
+var (
+	// RESTRequestDuration is the exchange REST request latency partitioned by
+	// exchange and HTTP status code, or "error" if no response was received
+	RESTRequestDuration = NewHistogramVec("gct_exchange_rest_request_duration_seconds",
+		"Exchange REST request latency in seconds.", nil, "exchange", "status")
+	// RESTErrors counts failed exchange REST requests, including non 2xx
+	// responses
+	RESTErrors = NewCounterVec("gct_exchange_rest_errors_total",
+		"Exchange REST requests which failed or returned a non 2xx status.", "exchange")
+	// RESTBans counts exchange REST responses signalling a rate limit
+	// violation or IP ban
+	RESTBans = NewCounterVec("gct_exchange_rest_bans_total",
+		"Exchange REST responses signalling a rate limit violation or ban.", "exchange")
+	// RESTRetries counts exchange REST requests retried after a transient
+	// failure partitioned by the reason for the retry
+	RESTRetries = NewCounterVec("gct_exchange_rest_retries_total",
+		"Exchange REST requests retried after a transient failure.", "exchange", "reason")
+	// RESTCircuitOpens counts exchange REST endpoints failing fast after
+	// consecutive failures
+	RESTCircuitOpens = NewCounterVec("gct_exchange_rest_circuit_opens_total",
+		"Exchange REST endpoint circuit breakers opened after consecutive failures.", "exchange")
+	// RESTCacheRequests counts exchange REST requests opted into the response
+	// cache partitioned by whether they were served from the cache
+	RESTCacheRequests = NewCounterVec("gct_exchange_rest_cache_requests_total",
+		"Exchange REST requests opted into the response cache by hit, revalidated or miss.", "exchange", "result")
+	// ExchangeLatency is the median round trip latency to each exchange
+	// measured by the latency monitor
+	ExchangeLatency = NewGaugeVec("gct_exchange_latency_seconds",
+		"Median round trip latency to the exchange in seconds.", "exchange")
+	// RateLimitWaitDuration is the time spent waiting on exchange rate limits
+	RateLimitWaitDuration = NewHistogramVec("gct_exchange_rate_limit_wait_seconds",
+		"Time spent waiting on exchange REST rate limits in seconds.",
+		[]float64{.001, .01, .05, .1, .25, .5, 1, 2.5, 5, 10, 30}, "exchange")
+	// WebsocketMessages counts websocket messages received per exchange
+	WebsocketMessages = NewCounterVec("gct_exchange_websocket_messages_total",
+		"Websocket messages received.", "exchange")
+	// WebsocketErrors counts websocket errors per exchange partitioned by
+	// their kind, such as auth_failed or rate_limited
+	WebsocketErrors = NewCounterVec("gct_exchange_websocket_errors_total",
+		"Websocket errors reported by exchanges by kind.", "exchange", "kind")
+	// WebsocketQueueDropped counts websocket data dropped from a full
+	// processing queue, only tickers are ever dropped
+	WebsocketQueueDropped = NewCounterVec("gct_exchange_websocket_queue_dropped_total",
+		"Websocket data dropped from a full processing queue.", "exchange")
+	// WebsocketQueueCoalesced counts websocket orderbook updates merged into
+	// an update for the same orderbook already waiting to be processed
+	WebsocketQueueCoalesced = NewCounterVec("gct_exchange_websocket_queue_coalesced_total",
+		"Websocket orderbook updates coalesced with a pending update.", "exchange")
+	// OrderSubmissions counts orders accepted by exchanges
+	OrderSubmissions = NewCounterVec("gct_order_submissions_total",
+		"Orders submitted and accepted by the exchange.", "exchange")
+	// OrderRejections counts failed order submissions
+	OrderRejections = NewCounterVec("gct_order_rejections_total",
+		"Order submissions rejected or failed.", "exchange")
+	// OrderCorrections counts tracked orders corrected when reconciled
+	// against the exchange, partitioned by whether the order was added,
+	// updated or closed
+	OrderCorrections = NewCounterVec("gct_order_corrections_total",
+		"Tracked orders corrected when reconciled against the exchange.", "exchange", "reason")
+	// DatabaseQueryDuration is the database repository query latency
+	// partitioned by operation
+	DatabaseQueryDuration = NewHistogramVec("gct_database_query_duration_seconds",
+		"Database query latency in seconds.", nil, "operation")
+	// SubsystemUp reports whether each engine subsystem is running
+	SubsystemUp = NewGaugeVec("gct_subsystem_up",
+		"Whether an engine subsystem is running (1) or stopped (0).", "subsystem")
+	// Goroutines is the number of goroutines started by each package, sampled
+	// by the resource monitor
+	Goroutines = NewGaugeVec("gct_goroutines",
+		"Goroutines by the package which started them.", "subsystem")
+	// HeapInUseBytes is the heap in use by each allocating package, estimated
+	// by the resource monitor from the sampled heap profile
+	HeapInUseBytes = NewGaugeVec("gct_heap_inuse_bytes",
+		"Sampled heap in use in bytes by allocating package.", "subsystem")
+	// OpenFileDescriptors is the number of file descriptors held by the
+	// process, sampled by the resource monitor
+	OpenFileDescriptors = NewGaugeVec("gct_open_file_descriptors",
+		"Open file descriptors held by the process.")
+
+	// DefaultRegistry holds the metrics above
+	DefaultRegistry = NewRegistry()
+	// Health tracks per endpoint REST latency and errors for each exchange
+	Health = NewHealthTracker()
+)
+
+func init() {
+	DefaultRegistry.Register(
+		RESTRequestDuration,
+		RESTErrors,
+		RESTBans,
+		RESTRetries,
+		RESTCircuitOpens,
+		RESTCacheRequests,
+		ExchangeLatency,
+		RateLimitWaitDuration,
+		WebsocketMessages,
+		WebsocketErrors,
+		WebsocketQueueDropped,
+		WebsocketQueueCoalesced,
+		OrderSubmissions,
+		OrderRejections,
+		OrderCorrections,
+		DatabaseQueryDuration,
+		SubsystemUp,
+		Goroutines,
+		HeapInUseBytes,
+		OpenFileDescriptors,
+	)
+}
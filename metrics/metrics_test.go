@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"bytes"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestCounterVec(t *testing.T) {
+	c := NewCounterVec("test_total", "help", "exchange")
+	c.Inc("Bitstamp")
+	c.Add(2, "Bitstamp")
+	c.Add(-1, "Bitstamp")
+	c.Inc("Kraken")
+	if v := c.Value("Bitstamp"); v != 3 {
+		t.Errorf("expected 3, received %v", v)
+	}
+	if v := c.Value("Kraken"); v != 1 {
+		t.Errorf("expected 1, received %v", v)
+	}
+	if v := c.Value("Binance"); v != 0 {
+		t.Errorf("expected 0, received %v", v)
+	}
+}
+
+func TestGaugeVec(t *testing.T) {
+	g := NewGaugeVec("test_up", "help", "subsystem")
+	g.Set(1, "comms")
+	g.Set(0, "comms")
+	if v := g.Value("comms"); v != 0 {
+		t.Errorf("expected 0, received %v", v)
+	}
+}
+
+func TestHistogramVec(t *testing.T) {
+	h := NewHistogramVec("test_seconds", "help", []float64{1, 0.1}, "op")
+	h.Observe(0.05, "select")
+	h.Observe(0.5, "select")
+	h.Observe(5, "select")
+	if c := h.Count("select"); c != 3 {
+		t.Fatalf("expected 3, received %v", c)
+	}
+
+	r := NewRegistry()
+	r.Register(h)
+	var b bytes.Buffer
+	if _, err := r.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP test_seconds help
+# TYPE test_seconds histogram
+test_seconds_bucket{op="select",le="0.1"} 1
+test_seconds_bucket{op="select",le="1"} 2
+test_seconds_bucket{op="select",le="+Inf"} 3
+test_seconds_sum{op="select"} 5.55
+test_seconds_count{op="select"} 3
+`
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
+
+func TestRegistryWriteTo(t *testing.T) {
+	r := NewRegistry()
+	c := NewCounterVec("b_total", "counts\nthings", "exchange")
+	g := NewGaugeVec("a_gauge", "a gauge")
+	r.Register(c, g)
+	c.Inc(`Bit"stamp`)
+	c.Inc("Alpha")
+	g.Set(2.5)
+
+	var b bytes.Buffer
+	if _, err := r.WriteTo(&b); err != nil {
+		t.Fatal(err)
+	}
+	expected := `# HELP a_gauge a gauge
+# TYPE a_gauge gauge
+a_gauge 2.5
+# HELP b_total counts\nthings
+# TYPE b_total counter
+b_total{exchange="Alpha"} 1
+b_total{exchange="Bit\"stamp"} 1
+`
+	if b.String() != expected {
+		t.Errorf("unexpected output:\n%s", b.String())
+	}
+}
+
+func TestRegisterDuplicate(t *testing.T) {
+	r := NewRegistry()
+	r.Register(NewCounterVec("dupe", "help"))
+	defer func() {
+		if recover() == nil {
+			t.Error("expected panic on duplicate registration")
+		}
+	}()
+	r.Register(NewGaugeVec("dupe", "help"))
+}
+
+func TestHandler(t *testing.T) {
+	OrderSubmissions.Inc("Bitstamp")
+	rec := httptest.NewRecorder()
+	DefaultRegistry.Handler().ServeHTTP(rec, httptest.NewRequest("GET", "/metrics", nil))
+	if ct := rec.Header().Get("Content-Type"); ct != ContentType {
+		t.Errorf("unexpected content type %s", ct)
+	}
+	if !strings.Contains(rec.Body.String(), `gct_order_submissions_total{exchange="Bitstamp"} 1`) {
+		t.Errorf("order submission metric not found:\n%s", rec.Body.String())
+	}
+}
@@ -0,0 +1,131 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+)
+
+// Metric types as defined by the Prometheus text exposition format
+const (
+	typeCounter   = "counter"
+	typeGauge     = "gauge"
+	typeHistogram = "histogram"
+
+	// ContentType is the Prometheus text exposition format content type
+	ContentType = "text/plain; version=0.0.4; charset=utf-8"
+
+	labelSeparator = "\xff"
+
+	// healthSamples is the number of recent latency samples kept per endpoint
+	healthSamples = 1000
+	// maxHealthEndpoints caps the endpoints tracked per exchange, further
+	// endpoints are grouped under otherEndpoint
+	maxHealthEndpoints = 100
+	otherEndpoint      = "other"
+)
+
+// DefaultBuckets are the default histogram buckets in seconds, matching the
+// Prometheus client defaults
+var DefaultBuckets = []float64{.005, .01, .025, .05, .1, .25, .5, 1, 2.5, 5, 10}
+
+// collector is implemented by all metric types so a registry can write them
+type collector interface {
+	desc() *desc
+	write(w *writer)
+}
+
+// Registry holds a set of metrics which are written together
+type Registry struct {
+	mtx        sync.RWMutex
+	collectors []collector
+	names      map[string]struct{}
+}
+
+// desc describes a metric and its label names
+type desc struct {
+	name   string
+	help   string
+	typ    string
+	labels []string
+}
+
+// CounterVec is a set of counters partitioned by label values
+type CounterVec struct {
+	d      desc
+	mtx    sync.Mutex
+	series map[string]*counterSeries
+}
+
+type counterSeries struct {
+	labels []string
+	value  float64
+}
+
+// GaugeVec is a set of gauges partitioned by label values
+type GaugeVec struct {
+	d      desc
+	mtx    sync.Mutex
+	series map[string]*gaugeSeries
+}
+
+type gaugeSeries struct {
+	labels []string
+	value  float64
+}
+
+// HistogramVec is a set of histograms partitioned by label values
+type HistogramVec struct {
+	d       desc
+	buckets []float64
+	mtx     sync.Mutex
+	series  map[string]*histogramSeries
+}
+
+type histogramSeries struct {
+	labels []string
+	counts []uint64
+	sum    float64
+	count  uint64
+}
+
+// HealthTracker keeps rolling REST latency samples and error and ban counts
+// per exchange endpoint so degrading venues can be spotted
+type HealthTracker struct {
+	mtx       sync.RWMutex
+	exchanges map[string]*exchangeHealth
+}
+
+type exchangeHealth struct {
+	requests, errors, bans uint64
+	lastError              string
+	lastErrorTime          time.Time
+	endpoints              map[string]*endpointHealth
+}
+
+type endpointHealth struct {
+	requests, errors uint64
+	samples          []time.Duration
+	next             int
+}
+
+// ExchangeHealth summarises the REST health of an exchange
+type ExchangeHealth struct {
+	Exchange      string
+	Requests      uint64
+	Errors        uint64
+	Bans          uint64
+	LastError     string
+	LastErrorTime time.Time
+	Endpoints     []EndpointHealth
+}
+
+// EndpointHealth summarises the latency percentiles and errors of an
+// exchange endpoint over its most recent requests
+type EndpointHealth struct {
+	Endpoint string
+	Requests uint64
+	Errors   uint64
+	P50      time.Duration
+	P90      time.Duration
+	P99      time.Duration
+}
@@ -3,63 +3,178 @@ package ntpclient
 import (
 	"encoding/binary"
 	"net"
+	"sort"
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
 
-type ntppacket struct {
-	Settings       uint8  // leap yr indicator, ver number, and mode
-	Stratum        uint8  // stratum of local clock
-	Poll           int8   // poll exponent
-	Precision      int8   // precision exponent
-	RootDelay      uint32 // root delay
-	RootDispersion uint32 // root dispersion
-	ReferenceID    uint32 // reference id
-	RefTimeSec     uint32 // reference timestamp sec
-	RefTimeFrac    uint32 // reference timestamp fractional
-	OrigTimeSec    uint32 // origin time secs
-	OrigTimeFrac   uint32 // origin time fractional
-	RxTimeSec      uint32 // receive time secs
-	RxTimeFrac     uint32 // receive time frac
-	TxTimeSec      uint32 // transmit time secs
-	TxTimeFrac     uint32 // transmit time frac
-}
-
 // NTPClient create's a new NTPClient and returns local based on ntp servers provided timestamp
 // if no server can be reached will return local time in UTC()
 func NTPClient(pool []string) time.Time {
-	for i := range pool {
-		con, err := net.DialTimeout("udp", pool[i], 5*time.Second)
-		if err != nil {
-			log.Warnf(log.TimeMgr, "Unable to connect to hosts %v attempting next", pool[i])
-			continue
-		}
+	c := Client{Pool: pool}
+	offset, err := c.Offset()
+	if err != nil {
+		log.Warnf(log.TimeMgr, "%v, using current system time\n", err)
+		return time.Now().UTC()
+	}
+	return time.Now().Add(offset)
+}
 
-		if err := con.SetDeadline(time.Now().Add(5 * time.Second)); err != nil {
-			log.Warnf(log.TimeMgr, "Unable to SetDeadline. Error: %s\n", err)
-			con.Close()
-			continue
-		}
+// Offset queries servers from the pool and returns the median of their
+// offsets from the local clock, after rejecting outliers. Servers which fail
+// are retried and then replaced by the next server in the pool.
+func (c *Client) Offset() (time.Duration, error) {
+	if len(c.Pool) == 0 {
+		return 0, ErrNoServers
+	}
+	samples := c.Samples
+	if samples <= 0 {
+		samples = DefaultSamples
+	}
+	if samples > len(c.Pool) {
+		samples = len(c.Pool)
+	}
+	retries := c.Retries
+	switch {
+	case retries < 0:
+		retries = 0
+	case retries == 0:
+		retries = DefaultRetries
+	}
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = DefaultTimeout
+	}
 
-		req := &ntppacket{Settings: 0x1B}
-		if err := binary.Write(con, binary.BigEndian, req); err != nil {
-			con.Close()
-			continue
+	c.mtx.Lock()
+	start := c.next % len(c.Pool)
+	c.next = start + 1
+	c.mtx.Unlock()
+
+	offsets := make([]time.Duration, 0, samples)
+	for i := 0; i < len(c.Pool) && len(offsets) < samples; i++ {
+		server := c.Pool[(start+i)%len(c.Pool)]
+		for attempt := 0; attempt <= retries; attempt++ {
+			offset, err := Query(server, timeout)
+			if err == nil {
+				offsets = append(offsets, offset)
+				break
+			}
+			log.Warnf(log.TimeMgr, "NTP server %s query attempt %d failed: %v\n",
+				server, attempt+1, err)
 		}
+	}
+	if len(offsets) == 0 {
+		return 0, ErrNoResponse
+	}
+	if len(offsets) < samples {
+		log.Warnf(log.TimeMgr, "Only %d of %d NTP servers responded\n", len(offsets), samples)
+	}
+	return medianOffset(offsets), nil
+}
+
+// Query returns the offset of the local clock from an NTP server, being the
+// amount the local clock is behind the server, corrected for network delay
+func Query(server string, timeout time.Duration) (time.Duration, error) {
+	con, err := net.DialTimeout("udp", server, timeout)
+	if err != nil {
+		return 0, err
+	}
+	defer con.Close()
+
+	err = con.SetDeadline(time.Now().Add(timeout))
+	if err != nil {
+		return 0, err
+	}
+
+	sent := time.Now()
+	secs, frac := toNTPTime(sent)
+	req := &ntppacket{Settings: 0x1B, TxTimeSec: secs, TxTimeFrac: frac}
+	err = binary.Write(con, binary.BigEndian, req)
+	if err != nil {
+		return 0, err
+	}
+
+	rsp := &ntppacket{}
+	err = binary.Read(con, binary.BigEndian, rsp)
+	if err != nil {
+		return 0, err
+	}
+	received := time.Now()
+
+	if rsp.Settings&0x7 != 4 || rsp.Stratum == 0 || rsp.TxTimeSec == 0 {
+		return 0, errInvalidResponse
+	}
+	if rsp.OrigTimeSec != secs || rsp.OrigTimeFrac != frac {
+		return 0, errOriginMismatch
+	}
+	return clockOffset(sent,
+		fromNTPTime(rsp.RxTimeSec, rsp.RxTimeFrac),
+		fromNTPTime(rsp.TxTimeSec, rsp.TxTimeFrac),
+		received), nil
+}
+
+// clockOffset returns the clock offset from the request sent and response
+// received local times and the server receive and transmit times, see RFC 5905
+func clockOffset(sent, serverReceived, serverSent, received time.Time) time.Duration {
+	return (serverReceived.Sub(sent) + serverSent.Sub(received)) / 2
+}
 
-		rsp := &ntppacket{}
-		if err := binary.Read(con, binary.BigEndian, rsp); err != nil {
-			con.Close()
+// medianOffset returns the median of offsets after rejecting those further
+// from the median than outlierDeviations median absolute deviations
+func medianOffset(offsets []time.Duration) time.Duration {
+	med := median(offsets)
+	deviations := make([]time.Duration, len(offsets))
+	for i := range offsets {
+		deviations[i] = absDuration(offsets[i] - med)
+	}
+	limit := median(deviations) * outlierDeviations
+	if limit < minOutlierDeviation {
+		limit = minOutlierDeviation
+	}
+
+	accepted := make([]time.Duration, 0, len(offsets))
+	for i := range offsets {
+		if deviations[i] > limit {
+			log.Warnf(log.TimeMgr, "Rejecting NTP offset %v as an outlier from the median offset %v\n",
+				offsets[i], med)
 			continue
 		}
+		accepted = append(accepted, offsets[i])
+	}
+	return median(accepted)
+}
 
-		secs := float64(rsp.TxTimeSec) - 2208988800
-		nanos := (int64(rsp.TxTimeFrac) * 1e9) >> 32
+// median returns the median of values without modifying them
+func median(values []time.Duration) time.Duration {
+	sorted := make([]time.Duration, len(values))
+	copy(sorted, values)
+	sort.Slice(sorted, func(i, j int) bool { return sorted[i] < sorted[j] })
+	mid := len(sorted) / 2
+	if len(sorted)%2 == 0 {
+		return (sorted[mid-1] + sorted[mid]) / 2
+	}
+	return sorted[mid]
+}
 
-		con.Close()
-		return time.Unix(int64(secs), nanos)
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
 	}
-	log.Warnln(log.TimeMgr, "No valid NTP servers found, using current system time")
-	return time.Now().UTC()
+	return d
+}
+
+// toNTPTime converts t to NTP seconds and fractional seconds
+func toNTPTime(t time.Time) (secs, frac uint32) {
+	nanos := t.UnixNano() + ntpEpochOffset*int64(time.Second)
+	secs = uint32(nanos / int64(time.Second))
+	frac = uint32((uint64(nanos%int64(time.Second)) << 32) / uint64(time.Second))
+	return
+}
+
+// fromNTPTime converts NTP seconds and fractional seconds to a time
+func fromNTPTime(secs, frac uint32) time.Time {
+	nanos := (int64(frac) * int64(time.Second)) >> 32
+	return time.Unix(int64(secs)-ntpEpochOffset, nanos)
 }
@@ -1,6 +1,9 @@
 package ntpclient
 
 import (
+	"bytes"
+	"encoding/binary"
+	"net"
 	"reflect"
 	"testing"
 	"time"
@@ -24,3 +27,132 @@ func TestNTPClient(t *testing.T) {
 		t.Errorf("NTPClient returned incorrect time received: %v", v.UTC().Format(timeFormat))
 	}
 }
+
+// fakeServer starts an NTP server on localhost whose clock is offset from the
+// local clock, returning its connection
+func fakeServer(t *testing.T, offset time.Duration) net.PacketConn {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	go func() {
+		buf := make([]byte, 48)
+		for {
+			_, addr, err := conn.ReadFrom(buf)
+			if err != nil {
+				return
+			}
+			var req ntppacket
+			if err = binary.Read(bytes.NewReader(buf), binary.BigEndian, &req); err != nil {
+				continue
+			}
+			now := time.Now().Add(offset)
+			rsp := ntppacket{
+				Settings:     0x24,
+				Stratum:      2,
+				OrigTimeSec:  req.TxTimeSec,
+				OrigTimeFrac: req.TxTimeFrac,
+			}
+			rsp.RxTimeSec, rsp.RxTimeFrac = toNTPTime(now)
+			rsp.TxTimeSec, rsp.TxTimeFrac = toNTPTime(now)
+			var out bytes.Buffer
+			if err = binary.Write(&out, binary.BigEndian, &rsp); err != nil {
+				continue
+			}
+			_, _ = conn.WriteTo(out.Bytes(), addr)
+		}
+	}()
+	return conn
+}
+
+// unusedAddress returns a localhost UDP address nothing is listening on
+func unusedAddress(t *testing.T) string {
+	t.Helper()
+	conn, err := net.ListenPacket("udp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	addr := conn.LocalAddr().String()
+	conn.Close()
+	return addr
+}
+
+func withinTolerance(got, expected time.Duration) bool {
+	diff := got - expected
+	return diff > -50*time.Millisecond && diff < 50*time.Millisecond
+}
+
+func TestQuery(t *testing.T) {
+	t.Parallel()
+	server := fakeServer(t, time.Second*2)
+	defer server.Close()
+	offset, err := Query(server.LocalAddr().String(), time.Second)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !withinTolerance(offset, time.Second*2) {
+		t.Errorf("expected offset of 2s, got %v", offset)
+	}
+}
+
+func TestOffset(t *testing.T) {
+	t.Parallel()
+	pool := []string{unusedAddress(t)}
+	for _, offset := range []time.Duration{time.Second, time.Second + 10*time.Millisecond, time.Hour} {
+		server := fakeServer(t, offset)
+		defer server.Close()
+		pool = append(pool, server.LocalAddr().String())
+	}
+	c := Client{
+		Pool:    pool,
+		Samples: 3,
+		Retries: -1,
+		Timeout: time.Second,
+	}
+	offset, err := c.Offset()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !withinTolerance(offset, time.Second) {
+		t.Errorf("expected the failed server to be replaced and the outlier rejected, got offset %v", offset)
+	}
+	if c.next != 1 {
+		t.Errorf("expected the next query to start at the next server, got %d", c.next)
+	}
+
+	c = Client{Pool: []string{unusedAddress(t)}, Retries: -1, Timeout: time.Second}
+	if _, err = c.Offset(); err != ErrNoResponse {
+		t.Errorf("expected %v, got %v", ErrNoResponse, err)
+	}
+	c = Client{}
+	if _, err = c.Offset(); err != ErrNoServers {
+		t.Errorf("expected %v, got %v", ErrNoServers, err)
+	}
+}
+
+func TestMedianOffset(t *testing.T) {
+	t.Parallel()
+	for _, tc := range []struct {
+		offsets  []time.Duration
+		expected time.Duration
+	}{
+		{[]time.Duration{time.Second}, time.Second},
+		{[]time.Duration{time.Second, 3 * time.Second}, 2 * time.Second},
+		{[]time.Duration{10 * time.Millisecond, 20 * time.Millisecond, time.Minute}, 15 * time.Millisecond},
+		{[]time.Duration{-time.Hour, 0, 5 * time.Millisecond, 10 * time.Millisecond, time.Hour}, 5 * time.Millisecond},
+	} {
+		if got := medianOffset(tc.offsets); got != tc.expected {
+			t.Errorf("medianOffset(%v) expected %v, got %v", tc.offsets, tc.expected, got)
+		}
+	}
+}
+
+func TestNTPTimeConversion(t *testing.T) {
+	t.Parallel()
+	now := time.Now()
+	got := fromNTPTime(toNTPTime(now))
+	if diff := got.Sub(now); diff < -time.Nanosecond || diff > time.Nanosecond {
+		t.Errorf("expected %v, got %v", now, got)
+	}
+}
@@ -0,0 +1,78 @@
+package ntpclient
+
+import (
+	"errors"
+	"sync"
+	"time"
+)
+
+// Const vars for the NTP client
+const (
+	// DefaultSamples is the amount of servers queried for an offset
+	DefaultSamples = 3
+	// DefaultRetries is how many times a server is retried before moving on
+	// to the next server in the pool
+	DefaultRetries = 1
+	// DefaultTimeout is how long a server is given to respond
+	DefaultTimeout = 5 * time.Second
+
+	// ntpEpochOffset is the amount of seconds between the NTP epoch of 1900
+	// and the unix epoch
+	ntpEpochOffset = 2208988800
+	// outlierDeviations is how many median absolute deviations a sample may
+	// be from the median before it's rejected
+	outlierDeviations = 3
+	// minOutlierDeviation is the deviation from the median below which a
+	// sample is never rejected, so closely agreeing servers are all used
+	minOutlierDeviation = 25 * time.Millisecond
+)
+
+var (
+	// DefaultPool is the pool of servers used when none are configured
+	DefaultPool = []string{"pool.ntp.org:123", "time.google.com:123", "time.cloudflare.com:123"}
+
+	// ErrNoServers is returned when no server is configured
+	ErrNoServers = errors.New("no NTP servers configured")
+	// ErrNoResponse is returned when no server in the pool responded
+	ErrNoResponse = errors.New("no NTP server responded")
+
+	errInvalidResponse = errors.New("invalid NTP response")
+	errOriginMismatch  = errors.New("NTP response origin timestamp does not match request")
+)
+
+// Client queries a pool of NTP servers for the offset of the local clock,
+// rotating through the pool between queries so no single server is trusted
+type Client struct {
+	// Pool is the list of host:port NTP servers to query
+	Pool []string
+	// Samples is the amount of servers queried for an offset, defaults to
+	// DefaultSamples
+	Samples int
+	// Retries is how many times a server is retried on failure, defaults to
+	// DefaultRetries and is disabled when negative
+	Retries int
+	// Timeout is how long a server is given to respond, defaults to
+	// DefaultTimeout
+	Timeout time.Duration
+
+	mtx  sync.Mutex
+	next int
+}
+
+type ntppacket struct {
+	Settings       uint8  // leap yr indicator, ver number, and mode
+	Stratum        uint8  // stratum of local clock
+	Poll           int8   // poll exponent
+	Precision      int8   // precision exponent
+	RootDelay      uint32 // root delay
+	RootDispersion uint32 // root dispersion
+	ReferenceID    uint32 // reference id
+	RefTimeSec     uint32 // reference timestamp sec
+	RefTimeFrac    uint32 // reference timestamp fractional
+	OrigTimeSec    uint32 // origin time secs
+	OrigTimeFrac   uint32 // origin time fractional
+	RxTimeSec      uint32 // receive time secs
+	RxTimeFrac     uint32 // receive time frac
+	TxTimeSec      uint32 // transmit time secs
+	TxTimeFrac     uint32 // transmit time frac
+}
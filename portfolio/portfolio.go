@@ -7,6 +7,7 @@ import (
 	"time"
 
 	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 	"github.com/thrasher-corp/gocryptotrader/log"
 )
@@ -47,13 +48,13 @@ func GetEthereumBalance(address string) (EthplorerResponse, error) {
 
 // GetCryptoIDAddress queries CryptoID for an address balance for a
 // specified cryptocurrency
-func GetCryptoIDAddress(address string, coinType currency.Code) (float64, error) {
+func GetCryptoIDAddress(address string, coinType currency.Code) (decimal.Decimal, error) {
 	ok, err := common.IsValidCryptoAddress(address, coinType.String())
 	if !ok || err != nil {
-		return 0, errors.New("invalid address")
+		return decimal.Zero, errors.New("invalid address")
 	}
 
-	var result interface{}
+	var result decimal.Decimal
 	url := fmt.Sprintf("%s/%s/api.dws?q=getbalance&a=%s",
 		cryptoIDAPIURL,
 		coinType.Lower(),
@@ -61,14 +62,14 @@ func GetCryptoIDAddress(address string, coinType currency.Code) (float64, error)
 
 	err = common.SendHTTPGetRequest(url, true, Verbose, &result)
 	if err != nil {
-		return 0, err
+		return decimal.Zero, err
 	}
-	return result.(float64), nil
+	return result, nil
 }
 
 // GetAddressBalance acceses the portfolio base and returns the balance by passed
 // in address, coin type and description
-func (p *Base) GetAddressBalance(address, description string, coinType currency.Code) (float64, bool) {
+func (p *Base) GetAddressBalance(address, description string, coinType currency.Code) (decimal.Decimal, bool) {
 	for x := range p.Addresses {
 		if p.Addresses[x].Address == address &&
 			p.Addresses[x].Description == description &&
@@ -76,7 +77,7 @@ func (p *Base) GetAddressBalance(address, description string, coinType currency.
 			return p.Addresses[x].Balance, true
 		}
 	}
-	return 0, false
+	return decimal.Zero, false
 }
 
 // ExchangeExists checks to see if an exchange exists in the portfolio base
@@ -112,7 +113,7 @@ func (p *Base) ExchangeAddressExists(exchangeName string, coinType currency.Code
 }
 
 // AddExchangeAddress adds an exchange address to the portfolio base
-func (p *Base) AddExchangeAddress(exchangeName string, coinType currency.Code, balance float64) {
+func (p *Base) AddExchangeAddress(exchangeName string, coinType currency.Code, balance decimal.Decimal) {
 	if p.ExchangeAddressExists(exchangeName, coinType) {
 		p.UpdateExchangeAddressBalance(exchangeName, coinType, balance)
 	} else {
@@ -124,7 +125,7 @@ func (p *Base) AddExchangeAddress(exchangeName string, coinType currency.Code, b
 }
 
 // UpdateAddressBalance updates the portfolio base balance
-func (p *Base) UpdateAddressBalance(address string, amount float64) {
+func (p *Base) UpdateAddressBalance(address string, amount decimal.Decimal) {
 	for x := range p.Addresses {
 		if p.Addresses[x].Address == address {
 			p.Addresses[x].Balance = amount
@@ -144,7 +145,7 @@ func (p *Base) RemoveExchangeAddress(exchangeName string, coinType currency.Code
 
 // UpdateExchangeAddressBalance updates the portfolio balance when checked
 // against correct exchangeName and coinType.
-func (p *Base) UpdateExchangeAddressBalance(exchangeName string, coinType currency.Code, balance float64) {
+func (p *Base) UpdateExchangeAddressBalance(exchangeName string, coinType currency.Code, balance decimal.Decimal) {
 	for x := range p.Addresses {
 		if p.Addresses[x].Address == exchangeName && p.Addresses[x].CoinType == coinType {
 			p.Addresses[x].Balance = balance
@@ -153,7 +154,7 @@ func (p *Base) UpdateExchangeAddressBalance(exchangeName string, coinType curren
 }
 
 // AddAddress adds an address to the portfolio base
-func (p *Base) AddAddress(address, description string, coinType currency.Code, balance float64) error {
+func (p *Base) AddAddress(address, description string, coinType currency.Code, balance decimal.Decimal) error {
 	if address == "" {
 		return errors.New("address is empty")
 	}
@@ -171,7 +172,7 @@ func (p *Base) AddAddress(address, description string, coinType currency.Code, b
 				Balance: balance, Description: description},
 		)
 	} else {
-		if balance <= 0 {
+		if balance.Sign() <= 0 {
 			p.RemoveAddress(address, description, coinType)
 		} else {
 			p.UpdateAddressBalance(address, balance)
@@ -241,8 +242,8 @@ func (p *Base) UpdatePortfolio(addresses []string, coinType currency.Code) error
 }
 
 // GetPortfolioByExchange returns currency portfolio amount by exchange
-func (p *Base) GetPortfolioByExchange(exchangeName string) map[currency.Code]float64 {
-	result := make(map[currency.Code]float64)
+func (p *Base) GetPortfolioByExchange(exchangeName string) map[currency.Code]decimal.Decimal {
+	result := make(map[currency.Code]decimal.Decimal)
 	for x := range p.Addresses {
 		if strings.Contains(p.Addresses[x].Address, exchangeName) {
 			result[p.Addresses[x].CoinType] = p.Addresses[x].Balance
@@ -252,8 +253,8 @@ func (p *Base) GetPortfolioByExchange(exchangeName string) map[currency.Code]flo
 }
 
 // GetExchangePortfolio returns current portfolio base information
-func (p *Base) GetExchangePortfolio() map[currency.Code]float64 {
-	result := make(map[currency.Code]float64)
+func (p *Base) GetExchangePortfolio() map[currency.Code]decimal.Decimal {
+	result := make(map[currency.Code]decimal.Decimal)
 	for _, x := range p.Addresses {
 		if x.Description != PortfolioAddressExchange {
 			continue
@@ -262,15 +263,15 @@ func (p *Base) GetExchangePortfolio() map[currency.Code]float64 {
 		if !ok {
 			result[x.CoinType] = x.Balance
 		} else {
-			result[x.CoinType] = x.Balance + balance
+			result[x.CoinType] = x.Balance.Add(balance)
 		}
 	}
 	return result
 }
 
 // GetPersonalPortfolio returns current portfolio base information
-func (p *Base) GetPersonalPortfolio() map[currency.Code]float64 {
-	result := make(map[currency.Code]float64)
+func (p *Base) GetPersonalPortfolio() map[currency.Code]decimal.Decimal {
+	result := make(map[currency.Code]decimal.Decimal)
 	for _, x := range p.Addresses {
 		if x.Description == PortfolioAddressExchange {
 			continue
@@ -279,7 +280,7 @@ func (p *Base) GetPersonalPortfolio() map[currency.Code]float64 {
 		if !ok {
 			result[x.CoinType] = x.Balance
 		} else {
-			result[x.CoinType] = x.Balance + balance
+			result[x.CoinType] = x.Balance.Add(balance)
 		}
 	}
 	return result
@@ -287,19 +288,18 @@ func (p *Base) GetPersonalPortfolio() map[currency.Code]float64 {
 
 // getPercentage returns the percentage of the target coin amount against the
 // total coin amount.
-func getPercentage(input map[currency.Code]float64, target currency.Code, totals map[currency.Code]float64) float64 {
-	subtotal := input[target]
-	total := totals[target]
-	percentage := (subtotal / total) * 100 / 1
-	return percentage
+func getPercentage(input map[currency.Code]decimal.Decimal, target currency.Code, totals map[currency.Code]decimal.Decimal) float64 {
+	return getPercentageSpecific(input[target], target, totals)
 }
 
 // getPercentageSpecific returns the percentage a specific value of a target coin amount
 // against the total coin amount.
-func getPercentageSpecific(input float64, target currency.Code, totals map[currency.Code]float64) float64 {
-	total := totals[target]
-	percentage := (input / total) * 100 / 1
-	return percentage
+func getPercentageSpecific(input decimal.Decimal, target currency.Code, totals map[currency.Code]decimal.Decimal) float64 {
+	percentage, err := input.Div(totals[target])
+	if err != nil {
+		return 0
+	}
+	return percentage.Float64() * 100
 }
 
 // GetPortfolioSummary returns the complete portfolio summary, showing
@@ -307,7 +307,7 @@ func getPercentageSpecific(input float64, target currency.Code, totals map[curre
 func (p *Base) GetPortfolioSummary() Summary {
 	personalHoldings := p.GetPersonalPortfolio()
 	exchangeHoldings := p.GetExchangePortfolio()
-	totalCoins := make(map[currency.Code]float64)
+	totalCoins := make(map[currency.Code]decimal.Decimal)
 
 	for x, y := range personalHoldings {
 		totalCoins[x] = y
@@ -318,7 +318,7 @@ func (p *Base) GetPortfolioSummary() Summary {
 		if !ok {
 			totalCoins[x] = y
 		} else {
-			totalCoins[x] = y + balance
+			totalCoins[x] = y.Add(balance)
 		}
 	}
 
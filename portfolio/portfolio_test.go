@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
 	"github.com/thrasher-corp/gocryptotrader/currency"
 )
 
@@ -43,13 +44,13 @@ func TestGetAddressBalance(t *testing.T) {
 	balance := float64(1000)
 
 	portfolio := Base{}
-	portfolio.AddAddress(ltcAddress, description, ltc, balance)
+	portfolio.AddAddress(ltcAddress, description, ltc, decimal.NewFromFloat(balance))
 
 	addBalance, _ := portfolio.GetAddressBalance("LdP8Qox1VAhCzLJNqrr74YovaWYyNBUWvL",
 		description,
 		ltc)
 
-	if addBalance != balance {
+	if !addBalance.Equal(decimal.NewFromFloat(balance)) {
 		t.Error("Portfolio GetAddressBalance() Error: Incorrect value")
 	}
 
@@ -57,7 +58,7 @@ func TestGetAddressBalance(t *testing.T) {
 		description,
 		ltc)
 
-	if addBalance != 0 {
+	if !addBalance.IsZero() {
 		t.Error("Portfolio GetAddressBalance() Error: Incorrect value")
 	}
 	if found {
@@ -70,7 +71,7 @@ func TestExchangeExists(t *testing.T) {
 	newBase.AddAddress("someaddress",
 		currency.LTC.String(),
 		currency.NewCode("LTCWALLETTEST"),
-		0.02)
+		decimal.NewFromFloat(0.02))
 
 	if !newBase.ExchangeExists("someaddress") {
 		t.Error("portfolio_test.go - AddressExists error")
@@ -85,7 +86,7 @@ func TestAddressExists(t *testing.T) {
 	newbase.AddAddress("someaddress",
 		currency.LTC.String(),
 		currency.NewCode("LTCWALLETTEST"),
-		0.02)
+		decimal.NewFromFloat(0.02))
 
 	if !newbase.AddressExists("someaddress") {
 		t.Error("portfolio_test.go - AddressExists error")
@@ -100,7 +101,7 @@ func TestExchangeAddressExists(t *testing.T) {
 	newbase.AddAddress("someaddress",
 		currency.LTC.String(),
 		currency.LTC,
-		0.02)
+		decimal.NewFromFloat(0.02))
 
 	if !newbase.ExchangeAddressExists("someaddress", currency.LTC) {
 		t.Error("portfolio_test.go - ExchangeAddressExists error")
@@ -112,8 +113,8 @@ func TestExchangeAddressExists(t *testing.T) {
 
 func TestAddExchangeAddress(t *testing.T) {
 	newbase := Base{}
-	newbase.AddExchangeAddress("OKEX", currency.BTC, 100)
-	newbase.AddExchangeAddress("OKEX", currency.BTC, 200)
+	newbase.AddExchangeAddress("OKEX", currency.BTC, decimal.NewFromInt(100))
+	newbase.AddExchangeAddress("OKEX", currency.BTC, decimal.NewFromInt(200))
 
 	if !newbase.ExchangeAddressExists("OKEX", currency.BTC) {
 		t.Error("TestExchangeAddressExists address doesn't exist")
@@ -125,13 +126,13 @@ func TestUpdateAddressBalance(t *testing.T) {
 	newbase.AddAddress("someaddress",
 		currency.LTC.String(),
 		currency.NewCode("LTCWALLETTEST"),
-		0.02)
+		decimal.NewFromFloat(0.02))
 
-	newbase.UpdateAddressBalance("someaddress", 0.03)
+	newbase.UpdateAddressBalance("someaddress", decimal.NewFromFloat(0.03))
 
 	value := newbase.GetPortfolioSummary()
 	if value.Totals[0].Coin != currency.LTC &&
-		value.Totals[0].Balance != 0.03 {
+		!value.Totals[0].Balance.Equal(decimal.NewFromFloat(0.03)) {
 		t.Error("portfolio_test.go - UpdateUpdateAddressBalance error")
 	}
 }
@@ -153,7 +154,7 @@ func TestRemoveAddress(t *testing.T) {
 	newbase.AddAddress("someaddr",
 		currency.LTC.String(),
 		currency.NewCode("LTCWALLETTEST"),
-		420)
+		decimal.NewFromInt(420))
 
 	if !newbase.AddressExists("someaddr") {
 		t.Error("portfolio_test.go - TestRemoveAddress")
@@ -172,7 +173,7 @@ func TestRemoveExchangeAddress(t *testing.T) {
 	exchangeName := "BallerExchange"
 	coinType := currency.LTC
 
-	newbase.AddExchangeAddress(exchangeName, coinType, 420)
+	newbase.AddExchangeAddress(exchangeName, coinType, decimal.NewFromInt(420))
 
 	if !newbase.ExchangeAddressExists(exchangeName, coinType) {
 		t.Error("portfolio_test.go - TestRemoveAddress")
@@ -186,29 +187,29 @@ func TestRemoveExchangeAddress(t *testing.T) {
 
 func TestUpdateExchangeAddressBalance(t *testing.T) {
 	newbase := Base{}
-	newbase.AddExchangeAddress("someaddress", currency.LTC, 0.02)
+	newbase.AddExchangeAddress("someaddress", currency.LTC, decimal.NewFromFloat(0.02))
 	portfolio := GetPortfolio()
 	portfolio.Seed(newbase)
-	portfolio.UpdateExchangeAddressBalance("someaddress", currency.LTC, 0.04)
+	portfolio.UpdateExchangeAddressBalance("someaddress", currency.LTC, decimal.NewFromFloat(0.04))
 
 	value := portfolio.GetPortfolioSummary()
-	if value.Totals[0].Coin != currency.LTC && value.Totals[0].Balance != 0.04 {
+	if value.Totals[0].Coin != currency.LTC && !value.Totals[0].Balance.Equal(decimal.NewFromFloat(0.04)) {
 		t.Error("portfolio_test.go - UpdateExchangeAddressBalance error")
 	}
 }
 
 func TestAddAddress(t *testing.T) {
 	var newbase Base
-	if err := newbase.AddAddress("", "MEOW", currency.LTC, 1); err == nil {
+	if err := newbase.AddAddress("", "MEOW", currency.LTC, decimal.NewFromInt(1)); err == nil {
 		t.Error("invalid address should throw an error")
 	}
 
-	if err := newbase.AddAddress("Gibson", "", currency.NewCode(""), 1); err == nil {
+	if err := newbase.AddAddress("Gibson", "", currency.NewCode(""), decimal.NewFromInt(1)); err == nil {
 		t.Error("invalid coin type should throw an error")
 	}
 
 	// test adding an exchange address
-	err := newbase.AddAddress("COINUT", PortfolioAddressExchange, currency.LTC, 0)
+	err := newbase.AddAddress("COINUT", PortfolioAddressExchange, currency.LTC, decimal.Zero)
 	if err != nil {
 		t.Errorf("failed to add address: %v", err)
 	}
@@ -217,14 +218,14 @@ func TestAddAddress(t *testing.T) {
 	newbase.AddAddress("Gibson",
 		currency.LTC.String(),
 		currency.NewCode("LTCWALLETTEST"),
-		0.02)
+		decimal.NewFromFloat(0.02))
 
 	// test updating the balance and make sure it's reflected
 	newbase.AddAddress("Gibson", currency.LTC.String(),
-		currency.NewCode("LTCWALLETTEST"), 0.05)
+		currency.NewCode("LTCWALLETTEST"), decimal.NewFromFloat(0.05))
 	b, _ := newbase.GetAddressBalance("Gibson", "LTC",
 		currency.NewCode("LTCWALLETTEST"))
-	if b != 0.05 {
+	if !b.Equal(decimal.NewFromFloat(0.05)) {
 		t.Error("invalid portfolio amount")
 	}
 
@@ -239,7 +240,7 @@ func TestAddAddress(t *testing.T) {
 	newbase.AddAddress("Gibson",
 		currency.LTC.String(),
 		currency.NewCode("LTCWALLETTEST"),
-		-1)
+		decimal.NewFromInt(-1))
 
 	if newbase.AddressExists("Gibson") {
 		t.Error("portfolio_test.go - AddAddress error")
@@ -251,7 +252,7 @@ func TestUpdatePortfolio(t *testing.T) {
 	newbase.AddAddress("someaddress",
 		currency.LTC.String(),
 		currency.NewCode("LTCWALLETTEST"),
-		0.02)
+		decimal.NewFromFloat(0.02))
 
 	portfolio := GetPortfolio()
 	portfolio.Seed(newbase)
@@ -305,9 +306,9 @@ func TestUpdatePortfolio(t *testing.T) {
 
 func TestGetPortfolioByExchange(t *testing.T) {
 	newbase := Base{}
-	newbase.AddExchangeAddress("OKEX", currency.LTC, 0.07)
-	newbase.AddExchangeAddress("Bitfinex", currency.LTC, 0.05)
-	newbase.AddAddress("someaddress", "LTC", currency.NewCode(PortfolioAddressPersonal), 0.03)
+	newbase.AddExchangeAddress("OKEX", currency.LTC, decimal.NewFromFloat(0.07))
+	newbase.AddExchangeAddress("Bitfinex", currency.LTC, decimal.NewFromFloat(0.05))
+	newbase.AddAddress("someaddress", "LTC", currency.NewCode(PortfolioAddressPersonal), decimal.NewFromFloat(0.03))
 	portfolio := GetPortfolio()
 	portfolio.Seed(newbase)
 	value := portfolio.GetPortfolioByExchange("OKEX")
@@ -316,7 +317,7 @@ func TestGetPortfolioByExchange(t *testing.T) {
 		t.Error("portfolio_test.go - GetPortfolioByExchange error")
 	}
 
-	if result != 0.07 {
+	if !result.Equal(decimal.NewFromFloat(0.07)) {
 		t.Error("portfolio_test.go - GetPortfolioByExchange result != 0.10")
 	}
 
@@ -326,16 +327,16 @@ func TestGetPortfolioByExchange(t *testing.T) {
 		t.Error("portfolio_test.go - GetPortfolioByExchange error")
 	}
 
-	if result != 0.05 {
+	if !result.Equal(decimal.NewFromFloat(0.05)) {
 		t.Error("portfolio_test.go - GetPortfolioByExchange result != 0.05")
 	}
 }
 
 func TestGetExchangePortfolio(t *testing.T) {
 	newbase := Base{}
-	newbase.AddAddress("OKEX", PortfolioAddressExchange, currency.LTC, 0.03)
-	newbase.AddAddress("Bitfinex", PortfolioAddressExchange, currency.LTC, 0.05)
-	newbase.AddAddress("someaddress", PortfolioAddressPersonal, currency.LTC, 0.03)
+	newbase.AddAddress("OKEX", PortfolioAddressExchange, currency.LTC, decimal.NewFromFloat(0.03))
+	newbase.AddAddress("Bitfinex", PortfolioAddressExchange, currency.LTC, decimal.NewFromFloat(0.05))
+	newbase.AddAddress("someaddress", PortfolioAddressPersonal, currency.LTC, decimal.NewFromFloat(0.03))
 	portfolio := GetPortfolio()
 	portfolio.Seed(newbase)
 	value := portfolio.GetExchangePortfolio()
@@ -345,16 +346,16 @@ func TestGetExchangePortfolio(t *testing.T) {
 		t.Error("portfolio_test.go - GetExchangePortfolio error")
 	}
 
-	if result != 0.08 {
+	if !result.Equal(decimal.NewFromFloat(0.08)) {
 		t.Error("portfolio_test.go - GetExchangePortfolio result != 0.08")
 	}
 }
 
 func TestGetPersonalPortfolio(t *testing.T) {
 	newbase := Base{}
-	newbase.AddAddress("someaddress", PortfolioAddressPersonal, currency.N2O, 0.02)
-	newbase.AddAddress("anotheraddress", PortfolioAddressPersonal, currency.N2O, 0.03)
-	newbase.AddAddress("Exchange", PortfolioAddressExchange, currency.N2O, 0.01)
+	newbase.AddAddress("someaddress", PortfolioAddressPersonal, currency.N2O, decimal.NewFromFloat(0.02))
+	newbase.AddAddress("anotheraddress", PortfolioAddressPersonal, currency.N2O, decimal.NewFromFloat(0.03))
+	newbase.AddAddress("Exchange", PortfolioAddressExchange, currency.N2O, decimal.NewFromFloat(0.01))
 	portfolio := GetPortfolio()
 	portfolio.Seed(newbase)
 	value := portfolio.GetPersonalPortfolio()
@@ -363,7 +364,7 @@ func TestGetPersonalPortfolio(t *testing.T) {
 		t.Error("portfolio_test.go - GetPersonalPortfolio error")
 	}
 
-	if result != 0.05 {
+	if !result.Equal(decimal.NewFromFloat(0.05)) {
 		t.Error("portfolio_test.go - GetPersonalPortfolio result != 0.05")
 	}
 }
@@ -371,18 +372,18 @@ func TestGetPersonalPortfolio(t *testing.T) {
 func TestGetPortfolioSummary(t *testing.T) {
 	newbase := Base{}
 	// Personal holdings
-	newbase.AddAddress("someaddress", PortfolioAddressPersonal, currency.LTC, 1)
-	newbase.AddAddress("someaddress2", PortfolioAddressPersonal, currency.LTC, 2)
-	newbase.AddAddress("someaddress3", PortfolioAddressPersonal, currency.BTC, 100)
+	newbase.AddAddress("someaddress", PortfolioAddressPersonal, currency.LTC, decimal.NewFromInt(1))
+	newbase.AddAddress("someaddress2", PortfolioAddressPersonal, currency.LTC, decimal.NewFromInt(2))
+	newbase.AddAddress("someaddress3", PortfolioAddressPersonal, currency.BTC, decimal.NewFromInt(100))
 	newbase.AddAddress("0xde0b295669a9fd93d5f28d9ec85e40f4cb697bae",
-		PortfolioAddressPersonal, currency.ETH, 865346880000000000)
+		PortfolioAddressPersonal, currency.ETH, decimal.NewFromInt(865346880000000000))
 	newbase.AddAddress("0x9edc81c813b26165f607a8d1b8db87a02f34307f",
-		PortfolioAddressPersonal, currency.ETH, 165346880000000000)
+		PortfolioAddressPersonal, currency.ETH, decimal.NewFromInt(165346880000000000))
 
 	// Exchange holdings
-	newbase.AddExchangeAddress("Bitfinex", currency.LTC, 20)
-	newbase.AddExchangeAddress("Bitfinex", currency.BTC, 100)
-	newbase.AddExchangeAddress("OKEX", currency.ETH, 42)
+	newbase.AddExchangeAddress("Bitfinex", currency.LTC, decimal.NewFromInt(20))
+	newbase.AddExchangeAddress("Bitfinex", currency.BTC, decimal.NewFromInt(100))
+	newbase.AddExchangeAddress("OKEX", currency.ETH, decimal.NewFromInt(42))
 
 	portfolio := GetPortfolio()
 	portfolio.Seed(newbase)
@@ -405,19 +406,19 @@ func TestGetPortfolioSummary(t *testing.T) {
 		t.Error("portfolio_test.go - TestGetPortfolioSummary error")
 	}
 
-	if getTotalsVal(currency.LTC).Balance != 23 {
+	if !getTotalsVal(currency.LTC).Balance.Equal(decimal.NewFromInt(23)) {
 		t.Error("portfolio_test.go - TestGetPortfolioSummary error")
 	}
 
-	if getTotalsVal(currency.BTC).Balance != 200 {
+	if !getTotalsVal(currency.BTC).Balance.Equal(decimal.NewFromInt(200)) {
 		t.Error("portfolio_test.go - TestGetPortfolioSummary error")
 	}
 }
 
 func TestGetPortfolioGroupedCoin(t *testing.T) {
 	newbase := Base{}
-	newbase.AddAddress("someaddress", currency.LTC.String(), currency.LTC, 0.02)
-	newbase.AddAddress("Exchange", PortfolioAddressExchange, currency.LTC, 0.05)
+	newbase.AddAddress("someaddress", currency.LTC.String(), currency.LTC, decimal.NewFromFloat(0.02))
+	newbase.AddAddress("Exchange", PortfolioAddressExchange, currency.LTC, decimal.NewFromFloat(0.05))
 	portfolio := GetPortfolio()
 	portfolio.Seed(newbase)
 	value := portfolio.GetPortfolioGroupedCoin()
@@ -428,7 +429,7 @@ func TestGetPortfolioGroupedCoin(t *testing.T) {
 
 func TestSeed(t *testing.T) {
 	newbase := Base{}
-	newbase.AddAddress("someaddress", currency.LTC.String(), currency.LTC, 0.02)
+	newbase.AddAddress("someaddress", currency.LTC.String(), currency.LTC, decimal.NewFromFloat(0.02))
 	portfolio := GetPortfolio()
 	portfolio.Seed(newbase)
 
@@ -442,12 +443,12 @@ func TestStartPortfolioWatcher(t *testing.T) {
 	newBase.AddAddress("LX2LMYXtuv5tiYEMztSSoEZcafFPYJFRK1",
 		currency.LTC.String(),
 		currency.NewCode(PortfolioAddressPersonal),
-		0.02)
+		decimal.NewFromFloat(0.02))
 
 	newBase.AddAddress("Testy",
 		currency.LTC.String(),
 		currency.NewCode(PortfolioAddressPersonal),
-		0.02)
+		decimal.NewFromFloat(0.02))
 
 	portfolio := GetPortfolio()
 	portfolio.Seed(newBase)
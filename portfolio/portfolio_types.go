@@ -1,6 +1,9 @@
 package portfolio
 
-import "github.com/thrasher-corp/gocryptotrader/currency"
+import (
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
 
 // Base holds the portfolio base addresses
 type Base struct {
@@ -11,7 +14,7 @@ type Base struct {
 type Address struct {
 	Address     string
 	CoinType    currency.Code
-	Balance     float64
+	Balance     decimal.Decimal
 	Description string
 }
 
@@ -34,9 +37,9 @@ type EtherchainBalanceResponse struct {
 type EthplorerResponse struct {
 	Address string `json:"address"`
 	ETH     struct {
-		Balance  float64 `json:"balance"`
-		TotalIn  float64 `json:"totalIn"`
-		TotalOut float64 `json:"totalOut"`
+		Balance  decimal.Decimal `json:"balance"`
+		TotalIn  float64         `json:"totalIn"`
+		TotalOut float64         `json:"totalOut"`
 	} `json:"ETH"`
 	CountTxs     int `json:"countTxs"`
 	ContractInfo struct {
@@ -81,32 +84,32 @@ type ExchangeAccountInfo struct {
 // ExchangeAccountCurrencyInfo : Sub type to store currency name and value
 type ExchangeAccountCurrencyInfo struct {
 	CurrencyName string
-	TotalValue   float64
-	Hold         float64
+	TotalValue   decimal.Decimal
+	Hold         decimal.Decimal
 }
 
 // Coin stores a coin type, balance, address and percentage relative to the total
 // amount.
 type Coin struct {
-	Coin       currency.Code `json:"coin"`
-	Balance    float64       `json:"balance"`
-	Address    string        `json:"address,omitempty"`
-	Percentage float64       `json:"percentage,omitempty"`
+	Coin       currency.Code   `json:"coin"`
+	Balance    decimal.Decimal `json:"balance"`
+	Address    string          `json:"address,omitempty"`
+	Percentage float64         `json:"percentage,omitempty"`
 }
 
 // OfflineCoinSummary stores a coin types address, balance and percentage
 // relative to the total amount.
 type OfflineCoinSummary struct {
-	Address    string  `json:"address"`
-	Balance    float64 `json:"balance"`
-	Percentage float64 `json:"percentage,omitempty"`
+	Address    string          `json:"address"`
+	Balance    decimal.Decimal `json:"balance"`
+	Percentage float64         `json:"percentage,omitempty"`
 }
 
 // OnlineCoinSummary stores a coin types balance and percentage relative to the
 // total amount.
 type OnlineCoinSummary struct {
-	Balance    float64 `json:"balance"`
-	Percentage float64 `json:"percentage,omitempty"`
+	Balance    decimal.Decimal `json:"balance"`
+	Percentage float64         `json:"percentage,omitempty"`
 }
 
 // Summary Stores the entire portfolio summary
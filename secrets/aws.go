@@ -0,0 +1,171 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Name returns the name of the backend
+func (a *awsSecretsManager) Name() string {
+	return BackendAWSSecretsManager
+}
+
+// Get returns the current version of a secret stored as a JSON object
+func (a *awsSecretsManager) Get(ctx context.Context, name string) (map[string]string, error) {
+	payload, err := json.Marshal(map[string]string{"SecretId": fullName(a.prefix, name)})
+	if err != nil {
+		return nil, err
+	}
+	req, err := http.NewRequest(http.MethodPost, a.cfg.Endpoint+"/", bytes.NewReader(payload))
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Content-Type", "application/x-amz-json-1.1")
+	req.Header.Set("X-Amz-Target", awsTarget)
+	a.sign(req, payload, awsService)
+
+	resp, err := a.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		var awsErr struct {
+			Type    string `json:"__type"`
+			Message string `json:"message"`
+		}
+		_ = json.Unmarshal(body, &awsErr)
+		if strings.HasSuffix(awsErr.Type, "ResourceNotFoundException") {
+			return nil, ErrNotFound
+		}
+		return nil, fmt.Errorf("aws secrets manager returned status %d reading secret %s: %s %s",
+			resp.StatusCode, name, awsErr.Type, awsErr.Message)
+	}
+
+	var result struct {
+		SecretString *string `json:"SecretString"`
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	if result.SecretString == nil {
+		return nil, fmt.Errorf("secret %s has no string value", name)
+	}
+	return decodeValues([]byte(*result.SecretString))
+}
+
+// sign adds the AWS signature version 4 authorization headers to the request
+func (a *awsSecretsManager) sign(req *http.Request, payload []byte, service string) {
+	t := a.now().UTC()
+	amzDate := t.Format(awsTimeFormat)
+	date := t.Format(awsDateFormat)
+	payloadHash := hashHex(payload)
+
+	req.Header.Set("Host", req.URL.Host)
+	req.Header.Set("X-Amz-Date", amzDate)
+	if a.cfg.SessionToken != "" {
+		req.Header.Set("X-Amz-Security-Token", a.cfg.SessionToken)
+	}
+
+	signedHeaders, canonicalHeaders := canonicalHeaders(req.Header)
+	path := req.URL.EscapedPath()
+	if path == "" {
+		path = "/"
+	}
+	canonicalRequest := strings.Join([]string{
+		req.Method,
+		path,
+		canonicalQuery(req.URL.Query()),
+		canonicalHeaders,
+		signedHeaders,
+		payloadHash,
+	}, "\n")
+
+	scope := date + "/" + a.cfg.Region + "/" + service + "/aws4_request"
+	stringToSign := "AWS4-HMAC-SHA256\n" + amzDate + "\n" + scope + "\n" +
+		hashHex([]byte(canonicalRequest))
+
+	key := hmacSHA256([]byte("AWS4"+a.cfg.SecretAccessKey), []byte(date))
+	key = hmacSHA256(key, []byte(a.cfg.Region))
+	key = hmacSHA256(key, []byte(service))
+	key = hmacSHA256(key, []byte("aws4_request"))
+	signature := hex.EncodeToString(hmacSHA256(key, []byte(stringToSign)))
+
+	req.Header.Set("Authorization", "AWS4-HMAC-SHA256 Credential="+
+		a.cfg.AccessKeyID+"/"+scope+", SignedHeaders="+signedHeaders+
+		", Signature="+signature)
+	// The Host header is sent from req.Host, not the header map
+	req.Header.Del("Host")
+}
+
+// canonicalHeaders returns the signed header names and canonical header block
+func canonicalHeaders(h http.Header) (signed, canonical string) {
+	names := make([]string, 0, len(h))
+	values := make(map[string]string, len(h))
+	for k, v := range h {
+		name := strings.ToLower(k)
+		names = append(names, name)
+		trimmed := make([]string, len(v))
+		for i := range v {
+			trimmed[i] = strings.Join(strings.Fields(v[i]), " ")
+		}
+		values[name] = strings.Join(trimmed, ",")
+	}
+	sort.Strings(names)
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString(name + ":" + values[name] + "\n")
+	}
+	return strings.Join(names, ";"), b.String()
+}
+
+// canonicalQuery returns the query string sorted by key and value
+func canonicalQuery(q url.Values) string {
+	keys := make([]string, 0, len(q))
+	for k := range q {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	var params []string
+	for _, k := range keys {
+		v := append([]string(nil), q[k]...)
+		sort.Strings(v)
+		for i := range v {
+			params = append(params, awsEscape(k)+"="+awsEscape(v[i]))
+		}
+	}
+	return strings.Join(params, "&")
+}
+
+// awsEscape percent encodes all but the unreserved characters
+func awsEscape(s string) string {
+	return strings.Replace(url.QueryEscape(s), "+", "%20", -1)
+}
+
+func hashHex(data []byte) string {
+	h := sha256.Sum256(data)
+	return hex.EncodeToString(h[:])
+}
+
+func hmacSHA256(key, data []byte) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write(data) // nolint: errcheck
+	return h.Sum(nil)
+}
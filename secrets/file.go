@@ -0,0 +1,54 @@
+package secrets
+
+import (
+	"context"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// Name returns the name of the backend
+func (f *fileBackend) Name() string {
+	return BackendFile
+}
+
+// Get reads a secret from its directory of value files, or from its JSON file
+// when no such directory exists
+func (f *fileBackend) Get(_ context.Context, name string) (map[string]string, error) {
+	p := filepath.Join(f.dir, filepath.FromSlash(fullName(f.prefix, name)))
+	info, err := os.Stat(p)
+	if err == nil && info.IsDir() {
+		return readValueFiles(p)
+	}
+
+	data, err := ioutil.ReadFile(p + ".json")
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return decodeValues(data)
+}
+
+// readValueFiles reads every file in dir as a value named after the file,
+// skipping hidden files such as the links Kubernetes adds to secret volumes
+func readValueFiles(dir string) (map[string]string, error) {
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	values := make(map[string]string)
+	for _, fi := range files {
+		if fi.IsDir() || strings.HasPrefix(fi.Name(), ".") {
+			continue
+		}
+		data, err := ioutil.ReadFile(filepath.Join(dir, fi.Name()))
+		if err != nil {
+			return nil, err
+		}
+		values[fi.Name()] = strings.TrimRight(string(data), "\r\n")
+	}
+	return values, nil
+}
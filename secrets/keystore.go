@@ -0,0 +1,163 @@
+package secrets
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/thrasher-corp/gocryptotrader/common/crypto"
+)
+
+// Name returns the name of the backend
+func (k *keystore) Name() string {
+	return BackendKeystore
+}
+
+// Get reads a secret from the keystore, decrypting the file again only when
+// it has been modified since it was last read
+func (k *keystore) Get(_ context.Context, name string) (map[string]string, error) {
+	info, err := os.Stat(k.path)
+	if err != nil {
+		return nil, err
+	}
+
+	k.mtx.Lock()
+	defer k.mtx.Unlock()
+	if k.secrets == nil || !info.ModTime().Equal(k.modTime) {
+		k.secrets, err = ReadKeystore(k.path, k.key)
+		if err != nil {
+			return nil, err
+		}
+		k.modTime = info.ModTime()
+	}
+	values, ok := k.secrets[fullName(k.prefix, name)]
+	if !ok {
+		return nil, ErrNotFound
+	}
+	result := make(map[string]string, len(values))
+	for v := range values {
+		result[v] = values[v]
+	}
+	return result, nil
+}
+
+// ReadKeystoreKey reads the keystore key from a key file, or from the
+// GOCRYPTOTRADER_KEYSTORE_KEY environment variable when keyFile is empty.
+// Trailing whitespace such as a newline is not part of the key.
+func ReadKeystoreKey(keyFile string) ([]byte, error) {
+	var key []byte
+	if keyFile != "" {
+		data, err := ioutil.ReadFile(keyFile)
+		if err != nil {
+			return nil, fmt.Errorf("unable to read keystore key file: %s", err)
+		}
+		key = data
+	} else {
+		key = []byte(os.Getenv(KeystoreKeyEnvironmentVariable))
+	}
+	key = bytes.TrimRight(key, " \t\r\n")
+	if len(key) == 0 {
+		return nil, fmt.Errorf("keystore key must be set in a key file or the %s environment variable",
+			KeystoreKeyEnvironmentVariable)
+	}
+	return key, nil
+}
+
+// ReadKeystore decrypts a keystore file, returning its secrets by full name
+func ReadKeystore(path string, key []byte) (map[string]map[string]string, error) {
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	headerLen := len(keystoreHeader) + 1 + crypto.Argon2ParamsLength
+	if !bytes.HasPrefix(data, []byte(keystoreHeader)) || len(data) < headerLen+crypto.Argon2SaltLength {
+		return nil, fmt.Errorf("%s is not a keystore file", path)
+	}
+	if data[len(keystoreHeader)] != keystoreVersion {
+		return nil, fmt.Errorf("unsupported keystore version %d", data[len(keystoreHeader)])
+	}
+	params, err := crypto.ParseArgon2Params(data[len(keystoreHeader)+1:])
+	if err != nil {
+		return nil, err
+	}
+	dk, err := crypto.Argon2Key(key, data[headerLen:headerLen+crypto.Argon2SaltLength], params)
+	if err != nil {
+		return nil, err
+	}
+	plaintext, err := crypto.OpenAESGCM(dk, data, headerLen+crypto.Argon2SaltLength)
+	if err == crypto.ErrCiphertextTruncated {
+		return nil, fmt.Errorf("keystore %s is truncated", path)
+	}
+	if err != nil {
+		return nil, errors.New("unable to decrypt keystore, invalid key or corrupted file")
+	}
+	secrets := make(map[string]map[string]string)
+	err = json.Unmarshal(plaintext, &secrets)
+	if err != nil {
+		return nil, fmt.Errorf("keystore %s is invalid: %s", path, err)
+	}
+	return secrets, nil
+}
+
+// WriteKeystore encrypts secrets, keyed by their full name, into a keystore
+// file readable only by its owner. The key is derived with Argon2id and the
+// secrets encrypted with AES-256-GCM. The file is replaced atomically so a
+// failed write leaves the previous keystore intact.
+func WriteKeystore(path string, key []byte, secrets map[string]map[string]string) error {
+	plaintext, err := json.Marshal(secrets)
+	if err != nil {
+		return err
+	}
+	salt, err := crypto.GetRandomSalt(nil, crypto.Argon2SaltLength)
+	if err != nil {
+		return err
+	}
+	dk, err := crypto.Argon2Key(key, salt, crypto.DefaultArgon2Params)
+	if err != nil {
+		return err
+	}
+	header := append([]byte(keystoreHeader), keystoreVersion)
+	header = append(header, crypto.DefaultArgon2Params.Bytes()...)
+	header = append(header, salt...)
+	data, err := crypto.SealAESGCM(dk, header, plaintext)
+	if err != nil {
+		return err
+	}
+
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	return writeFileAtomic(path, data, keystoreFileMode)
+}
+
+// writeFileAtomic writes data to a temporary file in the same directory as
+// path, syncs it and renames it over path, so path holds either its previous
+// or its new contents even if the write is interrupted
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	f, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	tmp := f.Name()
+	defer os.Remove(tmp)
+
+	_, err = f.Write(data)
+	if err == nil {
+		err = f.Chmod(perm)
+	}
+	if err == nil {
+		err = f.Sync()
+	}
+	if errClose := f.Close(); err == nil {
+		err = errClose
+	}
+	if err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
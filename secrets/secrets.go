@@ -0,0 +1,134 @@
+// Package secrets loads credentials from HashiCorp Vault, AWS Secrets Manager,
+// injected secret files or an encrypted keystore file so they don't need to be
+// stored in the config file
+package secrets
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+)
+
+// New returns the backend set in the config
+func New(cfg *Config) (Backend, error) {
+	if cfg == nil {
+		return nil, errors.New("secrets config is nil")
+	}
+	prefix := strings.Trim(cfg.Prefix, "/")
+	switch cfg.Backend {
+	case BackendVault:
+		v := cfg.Vault
+		v.Address = envDefault(v.Address, "VAULT_ADDR")
+		v.Token = envDefault(v.Token, "VAULT_TOKEN")
+		v.Namespace = envDefault(v.Namespace, "VAULT_NAMESPACE")
+		if v.Mount == "" {
+			v.Mount = DefaultVaultMount
+		}
+		if v.Address == "" || v.Token == "" {
+			return nil, errors.New("vault address and token must be set")
+		}
+		v.Address = strings.TrimSuffix(v.Address, "/")
+		v.Mount = strings.Trim(v.Mount, "/")
+		return &vault{
+			cfg:    v,
+			prefix: prefix,
+			client: common.NewHTTPClientWithTimeout(defaultRequestTimeout),
+		}, nil
+	case BackendAWSSecretsManager:
+		a := cfg.AWS
+		a.Region = envDefault(a.Region, "AWS_REGION")
+		if a.AccessKeyID == "" && a.SecretAccessKey == "" {
+			a.AccessKeyID = os.Getenv("AWS_ACCESS_KEY_ID")
+			a.SecretAccessKey = os.Getenv("AWS_SECRET_ACCESS_KEY")
+			a.SessionToken = envDefault(a.SessionToken, "AWS_SESSION_TOKEN")
+		}
+		if a.Region == "" || a.AccessKeyID == "" || a.SecretAccessKey == "" {
+			return nil, errors.New("aws secrets manager region and credentials must be set")
+		}
+		if a.Endpoint == "" {
+			a.Endpoint = "https://secretsmanager." + a.Region + ".amazonaws.com"
+		}
+		a.Endpoint = strings.TrimSuffix(a.Endpoint, "/")
+		return &awsSecretsManager{
+			cfg:    a,
+			prefix: prefix,
+			client: common.NewHTTPClientWithTimeout(defaultRequestTimeout),
+			now:    time.Now,
+		}, nil
+	case BackendFile:
+		if cfg.File.Directory == "" {
+			return nil, errors.New("secrets file directory must be set")
+		}
+		return &fileBackend{dir: cfg.File.Directory, prefix: prefix}, nil
+	case BackendKeystore:
+		if cfg.Keystore.Path == "" {
+			return nil, errors.New("keystore path must be set")
+		}
+		key, err := ReadKeystoreKey(cfg.Keystore.KeyFile)
+		if err != nil {
+			return nil, err
+		}
+		return &keystore{path: cfg.Keystore.Path, key: key, prefix: prefix}, nil
+	default:
+		return nil, fmt.Errorf("unsupported secrets backend %q", cfg.Backend)
+	}
+}
+
+// GetRefreshInterval returns how often secrets are re-fetched, 0 when
+// refreshing is disabled
+func (c *Config) GetRefreshInterval() time.Duration {
+	switch {
+	case c.RefreshInterval < 0:
+		return 0
+	case c.RefreshInterval == 0:
+		return DefaultRefreshInterval
+	default:
+		return c.RefreshInterval
+	}
+}
+
+// SecretName returns the full name a secret is stored under in the backend
+func (c *Config) SecretName(name string) string {
+	return fullName(strings.Trim(c.Prefix, "/"), name)
+}
+
+// fullName returns the name of a secret under prefix
+func fullName(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return path.Join(prefix, name)
+}
+
+// decodeValues decodes a JSON object of secret values, values which aren't
+// strings are kept in their JSON form
+func decodeValues(data []byte) (map[string]string, error) {
+	var raw map[string]json.RawMessage
+	err := json.Unmarshal(data, &raw)
+	if err != nil {
+		return nil, fmt.Errorf("secret is not a JSON object: %s", err)
+	}
+	values := make(map[string]string, len(raw))
+	for k, v := range raw {
+		var s string
+		if json.Unmarshal(v, &s) == nil {
+			values[k] = s
+			continue
+		}
+		values[k] = string(v)
+	}
+	return values, nil
+}
+
+func envDefault(value, env string) string {
+	if value != "" {
+		return value
+	}
+	return os.Getenv(env)
+}
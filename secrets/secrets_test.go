@@ -0,0 +1,351 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+)
+
+func TestNew(t *testing.T) {
+	_, err := New(nil)
+	if err == nil {
+		t.Error("expected error on nil config")
+	}
+	_, err = New(&Config{Backend: "bogus"})
+	if err == nil {
+		t.Error("expected error on unsupported backend")
+	}
+	_, err = New(&Config{Backend: BackendFile})
+	if err == nil {
+		t.Error("expected error on missing directory")
+	}
+
+	b, err := New(&Config{
+		Backend: BackendVault,
+		Prefix:  "/gct/",
+		Vault:   VaultConfig{Address: "http://localhost:8200/", Token: "token"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	v := b.(*vault)
+	if v.cfg.Mount != DefaultVaultMount || v.cfg.Address != "http://localhost:8200" || v.prefix != "gct" {
+		t.Errorf("unexpected vault backend %+v", v)
+	}
+
+	b, err = New(&Config{
+		Backend: BackendAWSSecretsManager,
+		AWS: AWSSecretsManagerConfig{
+			Region:          "us-east-1",
+			AccessKeyID:     "id",
+			SecretAccessKey: "secret",
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if e := b.(*awsSecretsManager).cfg.Endpoint; e != "https://secretsmanager.us-east-1.amazonaws.com" {
+		t.Errorf("unexpected endpoint %s", e)
+	}
+}
+
+func TestGetRefreshInterval(t *testing.T) {
+	t.Parallel()
+	c := Config{}
+	if c.GetRefreshInterval() != DefaultRefreshInterval {
+		t.Error("expected default refresh interval")
+	}
+	c.RefreshInterval = -1
+	if c.GetRefreshInterval() != 0 {
+		t.Error("expected refreshing to be disabled")
+	}
+	c.RefreshInterval = time.Minute
+	if c.GetRefreshInterval() != time.Minute {
+		t.Error("expected configured refresh interval")
+	}
+}
+
+func TestVaultGet(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Vault-Token") != "token" || r.Header.Get("X-Vault-Namespace") != "ns" {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		if r.URL.Path != "/v1/kv/data/gct/exchanges/binance" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		w.Write([]byte(`{"data":{"data":{"key":"k","secret":"s"},"metadata":{"version":2}}}`)) // nolint: errcheck
+	}))
+	defer srv.Close()
+
+	b, err := New(&Config{
+		Backend: BackendVault,
+		Prefix:  "gct",
+		Vault:   VaultConfig{Address: srv.URL, Token: "token", Namespace: "ns", Mount: "kv"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := b.Get(context.Background(), "exchanges/binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["key"] != "k" || values["secret"] != "s" {
+		t.Errorf("unexpected values %v", values)
+	}
+	_, err = b.Get(context.Background(), "exchanges/bitstamp")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestAWSSecretsManagerGet(t *testing.T) {
+	t.Parallel()
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("X-Amz-Target") != awsTarget ||
+			r.Header.Get("X-Amz-Security-Token") != "session" ||
+			!strings.HasPrefix(r.Header.Get("Authorization"),
+				"AWS4-HMAC-SHA256 Credential=id/20200102/eu-west-1/secretsmanager/aws4_request") {
+			w.WriteHeader(http.StatusForbidden)
+			return
+		}
+		var req struct {
+			SecretID string `json:"SecretId"`
+		}
+		err := json.NewDecoder(r.Body).Decode(&req)
+		if err != nil || req.SecretID != "gct/database" {
+			w.WriteHeader(http.StatusBadRequest)
+			w.Write([]byte(`{"__type":"ResourceNotFoundException","message":"not found"}`)) // nolint: errcheck
+			return
+		}
+		w.Write([]byte(`{"Name":"gct/database","SecretString":"{\"username\":\"u\",\"password\":\"p\",\"port\":5432}"}`)) // nolint: errcheck
+	}))
+	defer srv.Close()
+
+	b, err := New(&Config{
+		Backend: BackendAWSSecretsManager,
+		Prefix:  "gct",
+		AWS: AWSSecretsManagerConfig{
+			Region:          "eu-west-1",
+			AccessKeyID:     "id",
+			SecretAccessKey: "secret",
+			SessionToken:    "session",
+			Endpoint:        srv.URL,
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	b.(*awsSecretsManager).now = func() time.Time {
+		return time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+	}
+	values, err := b.Get(context.Background(), "database")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["username"] != "u" || values["password"] != "p" || values["port"] != "5432" {
+		t.Errorf("unexpected values %v", values)
+	}
+	_, err = b.Get(context.Background(), "exchanges/binance")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestAWSSign(t *testing.T) {
+	t.Parallel()
+	// Example request from the AWS signature version 4 documentation
+	a := &awsSecretsManager{
+		cfg: AWSSecretsManagerConfig{
+			Region:          "us-east-1",
+			AccessKeyID:     "AKIDEXAMPLE",
+			SecretAccessKey: "wJalrXUtnFEMI/K7MDENG+bPxRfiCYEXAMPLEKEY",
+		},
+		now: func() time.Time {
+			return time.Date(2015, 8, 30, 12, 36, 0, 0, time.UTC)
+		},
+	}
+	req, err := http.NewRequest(http.MethodGet,
+		"https://iam.amazonaws.com/?Action=ListUsers&Version=2010-05-08", nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded; charset=utf-8")
+	a.sign(req, nil, "iam")
+	expected := "AWS4-HMAC-SHA256 Credential=AKIDEXAMPLE/20150830/us-east-1/iam/aws4_request, " +
+		"SignedHeaders=content-type;host;x-amz-date, " +
+		"Signature=5d672d79c15b13162d9279b0855cfba6789a8edb4c82c400e06b5924a6f2b5d7"
+	if auth := req.Header.Get("Authorization"); auth != expected {
+		t.Errorf("expected %s, got %s", expected, auth)
+	}
+}
+
+func TestFileGet(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	err = os.MkdirAll(filepath.Join(dir, "exchanges", "binance"), 0700)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "exchanges", "binance", "key"), []byte("k\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "exchanges", "binance", ".hidden"), []byte("x"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, "database.json"), []byte(`{"password":"p"}`), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	b, err := New(&Config{Backend: BackendFile, File: FileConfig{Directory: dir}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := b.Get(context.Background(), "exchanges/binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 1 || values["key"] != "k" {
+		t.Errorf("unexpected values %v", values)
+	}
+	values, err = b.Get(context.Background(), "database")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if values["password"] != "p" {
+		t.Errorf("unexpected values %v", values)
+	}
+	_, err = b.Get(context.Background(), "exchanges/bitstamp")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestKeystoreGet(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	keyFile := filepath.Join(dir, "key")
+	err = ioutil.WriteFile(keyFile, []byte("password\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "keystore", "keystore.dat")
+
+	_, err = New(&Config{Backend: BackendKeystore})
+	if err == nil {
+		t.Error("expected error on missing keystore path")
+	}
+	_, err = New(&Config{Backend: BackendKeystore, Keystore: KeystoreConfig{Path: path, KeyFile: filepath.Join(dir, "missing")}})
+	if err == nil {
+		t.Error("expected error on missing key file")
+	}
+
+	err = WriteKeystore(path, []byte("password"), map[string]map[string]string{
+		"gct/exchanges/binance": {"key": "k", "secret": "s"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(data), "binance") {
+		t.Error("expected keystore to be encrypted")
+	}
+	if _, err = ReadKeystore(path, []byte("wrong")); err == nil {
+		t.Error("expected error on invalid key")
+	}
+
+	b, err := New(&Config{Backend: BackendKeystore, Prefix: "gct", Keystore: KeystoreConfig{Path: path, KeyFile: keyFile}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	values, err := b.Get(context.Background(), "exchanges/binance")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(values) != 2 || values["key"] != "k" || values["secret"] != "s" {
+		t.Errorf("unexpected values %v", values)
+	}
+	_, err = b.Get(context.Background(), "exchanges/bitstamp")
+	if err != ErrNotFound {
+		t.Errorf("expected ErrNotFound, got %v", err)
+	}
+}
+
+func TestWriteKeystoreReplace(t *testing.T) {
+	t.Parallel()
+	dir, err := ioutil.TempDir("", "secrets")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	path := filepath.Join(dir, "keystore.dat")
+
+	for _, secret := range []string{"old", "new"} {
+		err = WriteKeystore(path, []byte("password"), map[string]map[string]string{
+			"exchanges/binance": {"secret": secret},
+		})
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	secrets, err := ReadKeystore(path, []byte("password"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secrets["exchanges/binance"]["secret"] != "new" {
+		t.Errorf("expected the keystore to be replaced, got %v", secrets)
+	}
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if info.Mode().Perm() != keystoreFileMode {
+		t.Errorf("expected keystore mode %o, got %o", keystoreFileMode, info.Mode().Perm())
+	}
+	files, err := ioutil.ReadDir(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(files) != 1 {
+		t.Errorf("expected only the keystore in %s, found %d files", dir, len(files))
+	}
+
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	// Raise the Argon2id memory parameter in the header past the maximum
+	copy(data[len(keystoreHeader)+1+4:], []byte{0xff, 0xff, 0xff, 0xff})
+	err = ioutil.WriteFile(path, data, keystoreFileMode)
+	if err != nil {
+		t.Fatal(err)
+	}
+	_, err = ReadKeystore(path, []byte("password"))
+	if err == nil || !strings.Contains(err.Error(), "exceed the maximum") {
+		t.Errorf("expected error reading excessive argon2 parameters, received %v", err)
+	}
+}
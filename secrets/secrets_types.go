@@ -0,0 +1,139 @@
+package secrets
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Supported secrets backends
+const (
+	BackendVault             = "vault"
+	BackendAWSSecretsManager = "awssecretsmanager"
+	BackendFile              = "file"
+	BackendKeystore          = "keystore"
+)
+
+// Const vars for secrets
+const (
+	// DefaultRefreshInterval is how often secrets are re-fetched so rotated
+	// credentials are picked up
+	DefaultRefreshInterval = time.Minute * 5
+	// DefaultVaultMount is the mount path of the Vault KV version 2 engine
+	DefaultVaultMount = "secret"
+
+	defaultRequestTimeout = time.Second * 10
+	awsService            = "secretsmanager"
+	awsTarget             = "secretsmanager.GetSecretValue"
+	awsTimeFormat         = "20060102T150405Z"
+	awsDateFormat         = "20060102"
+
+	// KeystoreKeyEnvironmentVariable holds the keystore key when no key file
+	// is set
+	KeystoreKeyEnvironmentVariable = "GOCRYPTOTRADER_KEYSTORE_KEY"
+	// keystoreHeader prefixes keystore files and is followed by the version,
+	// the Argon2id parameters and the salt
+	keystoreHeader   = "~GCT~KEYSTORE~"
+	keystoreVersion  = 1
+	keystoreFileMode = 0600
+)
+
+// ErrNotFound is returned when a secret doesn't exist in the backend
+var ErrNotFound = errors.New("secret not found")
+
+// Config defines the secrets backend credentials are loaded from instead of
+// the config file. Every secret is a set of named values stored under
+// Prefix joined with the secret name, e.g. gocryptotrader/exchanges/binance.
+type Config struct {
+	Enabled bool   `json:"enabled"`
+	Backend string `json:"backend"`
+	Prefix  string `json:"prefix"`
+	// RefreshInterval is how often secrets are re-fetched, 0 uses
+	// DefaultRefreshInterval and a negative interval disables refreshing
+	RefreshInterval time.Duration           `json:"refreshInterval"`
+	Vault           VaultConfig             `json:"vault"`
+	AWS             AWSSecretsManagerConfig `json:"awsSecretsManager"`
+	File            FileConfig              `json:"file"`
+	Keystore        KeystoreConfig          `json:"keystore"`
+}
+
+// VaultConfig defines the HashiCorp Vault server secrets are read from using
+// the KV version 2 engine. Empty values fall back to the VAULT_ADDR,
+// VAULT_TOKEN and VAULT_NAMESPACE environment variables.
+type VaultConfig struct {
+	Address   string `json:"address"`
+	Token     string `json:"token"`
+	Namespace string `json:"namespace"`
+	Mount     string `json:"mount"`
+}
+
+// AWSSecretsManagerConfig defines the AWS Secrets Manager region and
+// credentials, each secret being stored as a JSON object. Empty values fall
+// back to the AWS_REGION, AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY and
+// AWS_SESSION_TOKEN environment variables.
+type AWSSecretsManagerConfig struct {
+	Region          string `json:"region"`
+	AccessKeyID     string `json:"accessKeyID"`
+	SecretAccessKey string `json:"secretAccessKey"`
+	SessionToken    string `json:"sessionToken"`
+	// Endpoint overrides the regional endpoint, such as for a VPC endpoint
+	Endpoint string `json:"endpoint"`
+}
+
+// FileConfig defines the directory secret files are injected into, such as
+// a Kubernetes secret volume or Docker secrets mount. A secret is either a
+// JSON object file named after the secret with a .json extension, or a
+// directory holding a file per value.
+type FileConfig struct {
+	Directory string `json:"directory"`
+}
+
+// KeystoreConfig defines the encrypted keystore file secrets are read from,
+// which keeps credentials out of the config file so it can be shared. The key
+// is read from KeyFile, or the GOCRYPTOTRADER_KEYSTORE_KEY environment
+// variable when no key file is set.
+type KeystoreConfig struct {
+	Path    string `json:"path"`
+	KeyFile string `json:"keyFile"`
+}
+
+// Backend fetches secrets by name
+type Backend interface {
+	Name() string
+	Get(ctx context.Context, name string) (map[string]string, error)
+}
+
+// vault reads secrets from a Vault KV version 2 engine
+type vault struct {
+	cfg    VaultConfig
+	prefix string
+	client *http.Client
+}
+
+// awsSecretsManager reads secrets from AWS Secrets Manager, signing requests
+// with AWS signature version 4
+type awsSecretsManager struct {
+	cfg    AWSSecretsManagerConfig
+	prefix string
+	client *http.Client
+	now    func() time.Time
+}
+
+// keystore reads secrets from an encrypted keystore file, which is only
+// decrypted again once it has changed
+type keystore struct {
+	path    string
+	key     []byte
+	prefix  string
+	mtx     sync.Mutex
+	modTime time.Time
+	secrets map[string]map[string]string
+}
+
+// fileBackend reads secrets from injected files
+type fileBackend struct {
+	dir    string
+	prefix string
+}
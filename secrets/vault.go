@@ -0,0 +1,61 @@
+package secrets
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+)
+
+// Name returns the name of the backend
+func (v *vault) Name() string {
+	return BackendVault
+}
+
+// Get returns the latest version of a secret from the KV version 2 engine
+func (v *vault) Get(ctx context.Context, name string) (map[string]string, error) {
+	req, err := http.NewRequest(http.MethodGet,
+		v.cfg.Address+"/v1/"+v.cfg.Mount+"/data/"+fullName(v.prefix, name), nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("X-Vault-Token", v.cfg.Token)
+	if v.cfg.Namespace != "" {
+		req.Header.Set("X-Vault-Namespace", v.cfg.Namespace)
+	}
+
+	resp, err := v.client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+
+	switch resp.StatusCode {
+	case http.StatusOK:
+	case http.StatusNotFound:
+		return nil, ErrNotFound
+	default:
+		return nil, fmt.Errorf("vault returned status %d reading secret %s", resp.StatusCode, name)
+	}
+
+	var result struct {
+		Data struct {
+			Data json.RawMessage `json:"data"`
+		} `json:"data"`
+	}
+	err = json.Unmarshal(body, &result)
+	if err != nil {
+		return nil, err
+	}
+	if len(result.Data.Data) == 0 || string(result.Data.Data) == "null" {
+		// The latest version of the secret has been deleted
+		return nil, ErrNotFound
+	}
+	return decodeValues(result.Data.Data)
+}
@@ -0,0 +1,125 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common/file"
+)
+
+// systemdUnitDir is the directory systemd unit files are installed to
+var systemdUnitDir = "/etc/systemd/system"
+
+// watchdogSeconds is how long systemd waits for a watchdog notification
+// before restarting the service
+const watchdogSeconds = 60
+
+// Control performs a service management action through systemd, installing a
+// unit which runs the current executable with args
+func Control(name, action string, args []string) (string, error) {
+	unit := name + ".service"
+	path := filepath.Join(systemdUnitDir, unit)
+	switch action {
+	case ActionInstall:
+		exe, err := os.Executable()
+		if err != nil {
+			return "", err
+		}
+		exe, err = filepath.Abs(exe)
+		if err != nil {
+			return "", err
+		}
+		err = file.Write(path, []byte(unitFile(exe, args)))
+		if err != nil {
+			return "", err
+		}
+		if _, err = systemctl("daemon-reload"); err != nil {
+			return "", err
+		}
+		if _, err = systemctl("enable", unit); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Service %s installed to %s", name, path), nil
+	case ActionUninstall:
+		// Stopping an inactive unit succeeds, so a failure here is real
+		if _, err := systemctl("disable", "--now", unit); err != nil {
+			return "", err
+		}
+		err := os.Remove(path)
+		if err != nil {
+			return "", err
+		}
+		if _, err = systemctl("daemon-reload"); err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Service %s uninstalled", name), nil
+	case ActionStart, ActionStop:
+		if _, err := systemctl(action, unit); err != nil {
+			return "", err
+		}
+		state := "started"
+		if action == ActionStop {
+			state = "stopped"
+		}
+		return fmt.Sprintf("Service %s %s", name, state), nil
+	case ActionStatus:
+		// is-active exits non-zero for any state other than active while
+		// still printing the state
+		out, err := systemctl("is-active", unit)
+		if out != "" {
+			return out, nil
+		}
+		return "", err
+	}
+	return "", ErrUnknownAction
+}
+
+// systemctl runs systemctl with args, returning its trimmed output
+func systemctl(args ...string) (string, error) {
+	out, err := exec.Command("systemctl", args...).CombinedOutput()
+	output := strings.TrimSpace(string(out))
+	if err != nil {
+		return output, fmt.Errorf("systemctl %s: %v %s", strings.Join(args, " "), err, output)
+	}
+	return output, nil
+}
+
+// unitFile returns a systemd unit running exe with args, which notifies
+// systemd once started and is restarted when it fails or its watchdog lapses
+func unitFile(exe string, args []string) string {
+	execStart := make([]string, 0, len(args)+1)
+	execStart = append(execStart, systemdQuote(exe))
+	for i := range args {
+		execStart = append(execStart, systemdQuote(args[i]))
+	}
+	return fmt.Sprintf(`[Unit]
+Description=%s
+Wants=network-online.target
+After=network-online.target
+
+[Service]
+Type=notify
+NotifyAccess=main
+ExecStart=%s
+Restart=on-failure
+RestartSec=5
+WatchdogSec=%d
+TimeoutStopSec=%d
+
+[Install]
+WantedBy=multi-user.target
+`, description, strings.Join(execStart, " "), watchdogSeconds, int(controlTimeout.Seconds()))
+}
+
+// systemdQuote quotes s for use in a systemd command line, escaping the
+// specifier and variable expansion characters
+func systemdQuote(s string) string {
+	s = strings.NewReplacer("%", "%%", "$", "$$").Replace(s)
+	if s != "" && !strings.ContainsAny(s, " \t\"'\\;") {
+		return s
+	}
+	return `"` + strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s) + `"`
+}
@@ -0,0 +1,46 @@
+package service
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestUnitFile(t *testing.T) {
+	t.Parallel()
+	unit := unitFile("/opt/gct/gocryptotrader", []string{"-config=/etc/gct/config.json", "-exchangehttpuseragent=gct bot", "-datadir=/var/lib/50%"})
+	for _, s := range []string{
+		"Type=notify",
+		"WatchdogSec=60",
+		"Restart=on-failure",
+		`ExecStart=/opt/gct/gocryptotrader -config=/etc/gct/config.json "-exchangehttpuseragent=gct bot" -datadir=/var/lib/50%%`,
+		"WantedBy=multi-user.target",
+	} {
+		if !strings.Contains(unit, s) {
+			t.Errorf("expected %q in unit file:\n%s", s, unit)
+		}
+	}
+}
+
+func TestSystemdQuote(t *testing.T) {
+	t.Parallel()
+	for in, expected := range map[string]string{
+		"plain":     "plain",
+		"":          `""`,
+		"a b":       `"a b"`,
+		`say "hi"`:  `"say \"hi\""`,
+		`C:\path`:   `"C:\\path"`,
+		"$HOME":     "$$HOME",
+		"%h/config": "%%h/config",
+	} {
+		if got := systemdQuote(in); got != expected {
+			t.Errorf("systemdQuote(%q) expected %s, got %s", in, expected, got)
+		}
+	}
+}
+
+func TestControlUnknownAction(t *testing.T) {
+	t.Parallel()
+	if _, err := Control(DefaultName, "restart", nil); err != ErrUnknownAction {
+		t.Errorf("expected %v, got %v", ErrUnknownAction, err)
+	}
+}
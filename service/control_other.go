@@ -0,0 +1,13 @@
+// +build !linux,!windows
+
+package service
+
+// Control performs a service management action, which is unsupported on this
+// platform
+func Control(_, action string, _ []string) (string, error) {
+	switch action {
+	case ActionInstall, ActionUninstall, ActionStart, ActionStop, ActionStatus:
+		return "", ErrUnsupported
+	}
+	return "", ErrUnknownAction
+}
@@ -0,0 +1,76 @@
+package service
+
+import (
+	"io/ioutil"
+	"net"
+	"os"
+	"path/filepath"
+	"strconv"
+	"testing"
+	"time"
+)
+
+func TestNotify(t *testing.T) {
+	os.Unsetenv("NOTIFY_SOCKET")
+	if err := Notify(StateReady); err != nil {
+		t.Errorf("expected no error without a notification socket, got %v", err)
+	}
+
+	dir, err := ioutil.TempDir("", "notify")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	socket := filepath.Join(dir, "notify.sock")
+	conn, err := net.ListenUnixgram("unixgram", &net.UnixAddr{Name: socket, Net: "unixgram"})
+	if err != nil {
+		t.Skipf("unixgram sockets unavailable: %v", err)
+	}
+	defer conn.Close()
+
+	os.Setenv("NOTIFY_SOCKET", socket)
+	defer os.Unsetenv("NOTIFY_SOCKET")
+	err = Notify(StateReady, StatusRunning)
+	if err != nil {
+		t.Fatal(err)
+	}
+	buf := make([]byte, 256)
+	err = conn.SetReadDeadline(time.Now().Add(time.Second * 5))
+	if err != nil {
+		t.Fatal(err)
+	}
+	n, err := conn.Read(buf)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := string(buf[:n]); got != "READY=1\nSTATUS=Running" {
+		t.Errorf("unexpected notification %q", got)
+	}
+}
+
+func TestWatchdogInterval(t *testing.T) {
+	defer os.Unsetenv("WATCHDOG_USEC")
+	defer os.Unsetenv("WATCHDOG_PID")
+
+	os.Unsetenv("WATCHDOG_USEC")
+	os.Unsetenv("WATCHDOG_PID")
+	if d, err := WatchdogInterval(); err != nil || d != 0 {
+		t.Errorf("expected disabled watchdog, got %v %v", d, err)
+	}
+
+	os.Setenv("WATCHDOG_USEC", "30000000")
+	if d, err := WatchdogInterval(); err != nil || d != time.Second*30 {
+		t.Errorf("expected 30s watchdog, got %v %v", d, err)
+	}
+
+	os.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()+1))
+	if d, err := WatchdogInterval(); err != nil || d != 0 {
+		t.Errorf("expected watchdog for another process to be ignored, got %v %v", d, err)
+	}
+
+	os.Setenv("WATCHDOG_PID", strconv.Itoa(os.Getpid()))
+	os.Setenv("WATCHDOG_USEC", "-1")
+	if _, err := WatchdogInterval(); err == nil {
+		t.Error("expected error on invalid watchdog interval")
+	}
+}
@@ -0,0 +1,9 @@
+// +build !windows
+
+package service
+
+// Run starts s and blocks until it is stopped by a signal, notifying systemd
+// of its state when started by it
+func Run(_ string, s Service) error {
+	return runInteractive(s)
+}
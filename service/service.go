@@ -0,0 +1,73 @@
+// Package service runs GoCryptoTrader under a native service manager, being
+// systemd on Linux and the service control manager on Windows, and manages
+// its registration with them
+package service
+
+import (
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"github.com/thrasher-corp/gocryptotrader/signaler"
+)
+
+// runInteractive starts s, notifying the service manager if there is one,
+// and stops it once a signal is received
+func runInteractive(s Service) error {
+	notify(StatusStarting)
+	err := s.Start()
+	if err != nil {
+		notify(StatusStopping)
+		return err
+	}
+	notify(StateReady, StatusRunning)
+
+	stopWatchdog := startWatchdog(s)
+	interrupt := signaler.WaitForInterrupt()
+	log.Infof(log.Global, "Captured %v, shutdown requested.\n", interrupt)
+	close(stopWatchdog)
+
+	notify(StateStopping, StatusStopping)
+	s.Stop()
+	return nil
+}
+
+// startWatchdog notifies the service manager watchdog at half its interval
+// while s is alive, returning a channel which stops it when closed
+func startWatchdog(s Service) chan struct{} {
+	stop := make(chan struct{})
+	interval, err := WatchdogInterval()
+	if err != nil {
+		log.Errorf(log.Global, "Service watchdog disabled: %v\n", err)
+		return stop
+	}
+	if interval == 0 {
+		return stop
+	}
+	log.Debugf(log.Global, "Notifying service watchdog every %v\n", interval/2)
+	go func() {
+		t := time.NewTicker(interval / 2)
+		defer t.Stop()
+		for {
+			select {
+			case <-stop:
+				return
+			case <-t.C:
+				if l, ok := s.(LivenessChecker); ok && !l.Alive() {
+					log.Errorln(log.Global, "Service is not alive, withholding watchdog notification")
+					continue
+				}
+				notify(StateWatchdog)
+			}
+		}
+	}()
+	return stop
+}
+
+// notify sends state to the service manager, logging failures as the service
+// keeps running regardless
+func notify(state ...string) {
+	err := Notify(state...)
+	if err != nil {
+		log.Errorf(log.Global, "Unable to notify service manager: %v\n", err)
+	}
+}
@@ -0,0 +1,59 @@
+package service
+
+import (
+	"errors"
+	"time"
+)
+
+// Supported service management actions
+const (
+	ActionInstall   = "install"
+	ActionUninstall = "uninstall"
+	ActionStart     = "start"
+	ActionStop      = "stop"
+	ActionStatus    = "status"
+)
+
+// Const vars for services
+const (
+	// DefaultName is the name the service is registered under
+	DefaultName = "gocryptotrader"
+
+	displayName = "GoCryptoTrader"
+	description = "GoCryptoTrader cryptocurrency trading bot"
+	// controlTimeout is how long a start or stop is waited on
+	controlTimeout = time.Minute
+)
+
+// Service manager notification states, see sd_notify(3)
+const (
+	StateReady    = "READY=1"
+	StateStopping = "STOPPING=1"
+	StateWatchdog = "WATCHDOG=1"
+
+	StatusStarting = "STATUS=Starting"
+	StatusRunning  = "STATUS=Running"
+	StatusStopping = "STATUS=Stopping"
+)
+
+var (
+	// ErrUnsupported is returned when service management isn't supported on
+	// the platform
+	ErrUnsupported = errors.New("service management is not supported on this platform")
+	// ErrUnknownAction is returned for an unsupported service action
+	ErrUnknownAction = errors.New("unknown service action, must be install, uninstall, start, stop or status")
+)
+
+// Service is the application run as a service, started once and stopped
+// when the service manager or a signal requests it
+type Service interface {
+	Start() error
+	Stop()
+}
+
+// LivenessChecker is implemented by a Service which can report whether it is
+// functioning. A service manager watchdog is only notified while it is alive,
+// so a service which stops functioning is restarted.
+type LivenessChecker interface {
+	Alive() bool
+}
@@ -0,0 +1,168 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+	"golang.org/x/sys/windows/svc"
+	"golang.org/x/sys/windows/svc/mgr"
+)
+
+// handler handles service control manager requests for a Service
+type handler struct {
+	s Service
+}
+
+// Run starts s and blocks until it is stopped, by the service control manager
+// when run as a Windows service or otherwise by a signal
+func Run(name string, s Service) error {
+	interactive, err := svc.IsAnInteractiveSession()
+	if err != nil {
+		return err
+	}
+	if interactive {
+		return runInteractive(s)
+	}
+	return svc.Run(name, &handler{s: s})
+}
+
+// Execute starts the service and stops it when requested by the service
+// control manager
+func (h *handler) Execute(_ []string, r <-chan svc.ChangeRequest, changes chan<- svc.Status) (bool, uint32) {
+	changes <- svc.Status{State: svc.StartPending}
+	err := h.s.Start()
+	if err != nil {
+		log.Errorf(log.Global, "Unable to start service. Error: %v\n", err)
+		return true, 1
+	}
+	changes <- svc.Status{State: svc.Running, Accepts: svc.AcceptStop | svc.AcceptShutdown}
+
+	for c := range r {
+		switch c.Cmd {
+		case svc.Interrogate:
+			changes <- c.CurrentStatus
+		case svc.Stop, svc.Shutdown:
+			log.Infoln(log.Global, "Service stop requested.")
+			changes <- svc.Status{State: svc.StopPending, WaitHint: uint32(controlTimeout / time.Millisecond)}
+			h.s.Stop()
+			return false, 0
+		default:
+			log.Warnf(log.Global, "Unexpected service control request %d\n", c.Cmd)
+		}
+	}
+	return false, 0
+}
+
+// Control performs a service management action, registering the service with
+// args as its arguments when installing it
+func Control(name, action string, args []string) (string, error) {
+	m, err := mgr.Connect()
+	if err != nil {
+		return "", err
+	}
+	defer m.Disconnect()
+
+	if action == ActionInstall {
+		exe, err := os.Executable()
+		if err != nil {
+			return "", err
+		}
+		exe, err = filepath.Abs(exe)
+		if err != nil {
+			return "", err
+		}
+		s, err := m.CreateService(name, exe, mgr.Config{
+			DisplayName: displayName,
+			Description: description,
+			StartType:   mgr.StartAutomatic,
+		}, args...)
+		if err != nil {
+			return "", err
+		}
+		defer s.Close()
+		restart := mgr.RecoveryAction{Type: mgr.ServiceRestart, Delay: time.Second * 5}
+		err = s.SetRecoveryActions([]mgr.RecoveryAction{restart, restart, restart},
+			uint32((time.Hour * 24).Seconds()))
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Service %s installed", name), nil
+	}
+
+	s, err := m.OpenService(name)
+	if err != nil {
+		return "", fmt.Errorf("service %s is not installed: %v", name, err)
+	}
+	defer s.Close()
+
+	switch action {
+	case ActionUninstall:
+		err = s.Delete()
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Service %s uninstalled", name), nil
+	case ActionStart:
+		err = s.Start()
+		if err != nil {
+			return "", err
+		}
+		return waitForState(s, name, svc.Running)
+	case ActionStop:
+		_, err = s.Control(svc.Stop)
+		if err != nil {
+			return "", err
+		}
+		return waitForState(s, name, svc.Stopped)
+	case ActionStatus:
+		status, err := s.Query()
+		if err != nil {
+			return "", err
+		}
+		return stateName(status.State), nil
+	}
+	return "", ErrUnknownAction
+}
+
+// waitForState waits for the service to reach state
+func waitForState(s *mgr.Service, name string, state svc.State) (string, error) {
+	deadline := time.Now().Add(controlTimeout)
+	for {
+		status, err := s.Query()
+		if err != nil {
+			return "", err
+		}
+		if status.State == state {
+			return fmt.Sprintf("Service %s %s", name, stateName(state)), nil
+		}
+		if time.Now().After(deadline) {
+			return "", fmt.Errorf("service %s did not become %s within %v, currently %s",
+				name, stateName(state), controlTimeout, stateName(status.State))
+		}
+		time.Sleep(time.Millisecond * 300)
+	}
+}
+
+// stateName returns the name of a service state
+func stateName(state svc.State) string {
+	switch state {
+	case svc.Stopped:
+		return "stopped"
+	case svc.StartPending:
+		return "starting"
+	case svc.StopPending:
+		return "stopping"
+	case svc.Running:
+		return "running"
+	case svc.ContinuePending:
+		return "continuing"
+	case svc.PausePending:
+		return "pausing"
+	case svc.Paused:
+		return "paused"
+	}
+	return "unknown"
+}
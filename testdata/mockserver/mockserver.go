@@ -0,0 +1,185 @@
+// Package mockserver serves the REST responses and websocket frames recorded
+// for exchanges in testdata behind their real wrappers, so engine integration
+// tests and user strategies can run against an exchange without reaching its
+// live API
+package mockserver
+
+import (
+	"errors"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strings"
+
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/mock"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/websocket/wshandler"
+)
+
+const (
+	restDirectory      = "http_mock"
+	websocketDirectory = "websocket_capture"
+	restFileExtension  = ".json"
+)
+
+var errNoFixtures = errors.New("no recorded fixtures")
+
+// Server serves an exchange's recorded REST responses
+type Server struct {
+	Exchange string
+	URL      string
+	Client   *http.Client
+}
+
+// Directory returns the testdata directory holding the recorded fixtures,
+// found relative to this package so it resolves from any test's working
+// directory
+func Directory() string {
+	_, file, _, _ := runtime.Caller(0)
+	return filepath.Dir(filepath.Dir(file))
+}
+
+// RESTFixture returns the path of an exchange's recorded REST responses
+func RESTFixture(exchangeName string) string {
+	name := strings.ToLower(exchangeName)
+	return filepath.Join(Directory(), restDirectory, name, name+restFileExtension)
+}
+
+// WebsocketFixture returns the path of an exchange's captured websocket
+// frames
+func WebsocketFixture(exchangeName string) string {
+	return wshandler.CaptureFile(filepath.Join(Directory(), websocketDirectory), exchangeName)
+}
+
+// Exchanges returns the lower case names of the exchanges with recorded REST
+// responses or websocket frames
+func Exchanges() ([]string, error) {
+	names := make(map[string]struct{})
+	dirs, err := ioutil.ReadDir(filepath.Join(Directory(), restDirectory))
+	if err != nil {
+		return nil, err
+	}
+	for i := range dirs {
+		if dirs[i].IsDir() && fileExists(RESTFixture(dirs[i].Name())) {
+			names[dirs[i].Name()] = struct{}{}
+		}
+	}
+	captures, err := ioutil.ReadDir(filepath.Join(Directory(), websocketDirectory))
+	if err != nil {
+		return nil, err
+	}
+	for i := range captures {
+		name := strings.TrimSuffix(captures[i].Name(), filepath.Ext(captures[i].Name()))
+		if !captures[i].IsDir() && fileExists(WebsocketFixture(name)) {
+			names[name] = struct{}{}
+		}
+	}
+	exchanges := make([]string, 0, len(names))
+	for name := range names {
+		exchanges = append(exchanges, name)
+	}
+	sort.Strings(exchanges)
+	return exchanges, nil
+}
+
+// endpoint returns an exchange endpoint on the server. The endpoint's path is
+// kept as responses are recorded against the full request path
+func (s *Server) endpoint(endpoint string) (string, error) {
+	u, err := url.Parse(endpoint)
+	if err != nil {
+		return "", err
+	}
+	return s.URL + strings.TrimSuffix(u.Path, "/"), nil
+}
+
+// HasFixtures reports whether REST responses or websocket frames were
+// recorded for an exchange
+func HasFixtures(exchangeName string) bool {
+	return fileExists(RESTFixture(exchangeName)) ||
+		fileExists(WebsocketFixture(exchangeName))
+}
+
+// New starts a server replaying an exchange's recorded REST responses.
+// Requests without a recorded response fail the running test binary, as the
+// underlying VCR server does
+func New(exchangeName string) (*Server, error) {
+	path := RESTFixture(exchangeName)
+	// The VCR server creates an empty fixture when none exists, which would
+	// leave every request unanswered
+	if !fileExists(path) {
+		return nil, fmt.Errorf("%s REST %v", exchangeName, errNoFixtures)
+	}
+	serverURL, client, err := mock.NewVCRServer(path)
+	if err != nil {
+		return nil, err
+	}
+	return &Server{Exchange: exchangeName, URL: serverURL, Client: client}, nil
+}
+
+// Attach points an exchange's REST requests at a server replaying its
+// recorded responses and, when frames were captured for it, makes websocket
+// connections replay them instead of dialling the exchange. Websocket
+// replaying is enabled for every exchange, so those without captured frames
+// fail to connect rather than reaching the live API. The server is nil when
+// only websocket frames were captured
+func Attach(exch exchange.IBotExchange) (*Server, error) {
+	name := exch.GetName()
+	if !HasFixtures(name) {
+		return nil, fmt.Errorf("%s %v", name, errNoFixtures)
+	}
+
+	var s *Server
+	if fileExists(RESTFixture(name)) {
+		var err error
+		s, err = New(name)
+		if err != nil {
+			return nil, err
+		}
+		b := exch.GetBase()
+		b.SetHTTPClient(s.Client)
+		if b.API.Endpoints.URL, err = s.endpoint(b.API.Endpoints.URL); err != nil {
+			return nil, err
+		}
+		if b.API.Endpoints.URLSecondary != "" {
+			b.API.Endpoints.URLSecondary, err = s.endpoint(b.API.Endpoints.URLSecondary)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+	wshandler.SetReplayDirectory(filepath.Join(Directory(), websocketDirectory))
+	return s, nil
+}
+
+// DetachWebsockets stops new websocket connections replaying captured frames,
+// REST requests keep being served by the exchanges' servers
+func DetachWebsockets() {
+	wshandler.SetReplayDirectory("")
+}
+
+// AttachAll attaches every exchange with recorded fixtures, such as those
+// loaded by the engine, returning the names of the exchanges attached.
+// Exchanges without fixtures are left untouched
+func AttachAll(exchanges []exchange.IBotExchange) ([]string, error) {
+	var attached []string
+	for i := range exchanges {
+		if !HasFixtures(exchanges[i].GetName()) {
+			continue
+		}
+		if _, err := Attach(exchanges[i]); err != nil {
+			return attached, err
+		}
+		attached = append(attached, exchanges[i].GetName())
+	}
+	return attached, nil
+}
+
+func fileExists(path string) bool {
+	info, err := os.Stat(path)
+	return err == nil && !info.IsDir()
+}
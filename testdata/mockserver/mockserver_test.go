@@ -0,0 +1,92 @@
+package mockserver
+
+import (
+	"context"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/config"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	exchange "github.com/thrasher-corp/gocryptotrader/exchanges"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/asset"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/bitstamp"
+	"github.com/thrasher-corp/gocryptotrader/exchanges/kraken"
+)
+
+func setupExchange(t *testing.T, exch exchange.IBotExchange) {
+	t.Helper()
+	cfg := config.GetConfig()
+	if err := cfg.LoadConfig("../configtest.json", true); err != nil {
+		t.Fatal(err)
+	}
+	exchCfg, err := cfg.GetExchangeConfig(exch.GetName())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = exch.Setup(exchCfg); err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestExchanges(t *testing.T) {
+	exchanges, err := Exchanges()
+	if err != nil {
+		t.Fatal(err)
+	}
+	var bitstampFound bool
+	for i := range exchanges {
+		if !HasFixtures(exchanges[i]) {
+			t.Errorf("expected %s to have fixtures", exchanges[i])
+		}
+		bitstampFound = bitstampFound || exchanges[i] == "bitstamp"
+	}
+	if !bitstampFound {
+		t.Errorf("expected bitstamp in %v", exchanges)
+	}
+	if HasFixtures("Kraken") {
+		t.Error("expected no Kraken fixtures")
+	}
+}
+
+func TestNew(t *testing.T) {
+	if _, err := New("Kraken"); err == nil {
+		t.Error("expected an error without recorded responses")
+	}
+	s, err := New("Bitstamp")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s.URL == "" || s.Client == nil {
+		t.Errorf("expected a running server, received %+v", s)
+	}
+}
+
+func TestAttach(t *testing.T) {
+	defer DetachWebsockets()
+
+	var k kraken.Kraken
+	k.SetDefaults()
+	if _, err := Attach(&k); err == nil {
+		t.Error("expected an error without recorded fixtures")
+	}
+
+	var b bitstamp.Bitstamp
+	b.SetDefaults()
+	setupExchange(t, &b)
+	attached, err := AttachAll([]exchange.IBotExchange{&k, &b})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(attached) != 1 || attached[0] != b.Name {
+		t.Fatalf("expected only Bitstamp to be attached, received %v", attached)
+	}
+
+	// The real wrapper fetches the ticker from the recorded responses
+	tick, err := b.UpdateTicker(context.Background(),
+		currency.NewPair(currency.BTC, currency.USD), asset.Spot)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tick.Last == 0 {
+		t.Errorf("expected the recorded ticker, received %+v", tick)
+	}
+}
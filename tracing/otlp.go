@@ -0,0 +1,186 @@
+package tracing
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strconv"
+	"strings"
+)
+
+// NewOTLPExporter returns an exporter which posts spans to the OTLP/HTTP
+// traces endpoint of the configured collector
+func NewOTLPExporter(cfg *Config) *OTLPExporter {
+	endpoint := cfg.Endpoint
+	if endpoint == "" {
+		endpoint = DefaultEndpoint
+	}
+	endpoint = strings.TrimSuffix(endpoint, "/")
+	if !strings.HasSuffix(endpoint, tracesPath) {
+		endpoint += tracesPath
+	}
+	serviceName := cfg.ServiceName
+	if serviceName == "" {
+		serviceName = DefaultServiceName
+	}
+	return &OTLPExporter{
+		URL:         endpoint,
+		ServiceName: serviceName,
+		Headers:     cfg.Headers,
+		Client:      &http.Client{Timeout: defaultExportTimeout},
+	}
+}
+
+// ExportSpans sends a batch of spans to the collector
+func (o *OTLPExporter) ExportSpans(spans []SpanData) error {
+	payload, err := json.Marshal(o.encode(spans))
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequest(http.MethodPost, o.URL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range o.Headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := o.Client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 512))
+		return fmt.Errorf("collector returned status %d: %s", resp.StatusCode, body)
+	}
+	_, _ = io.Copy(ioutil.Discard, resp.Body)
+	return nil
+}
+
+// otlpRequest is the OTLP ExportTraceServiceRequest in its JSON encoding
+type otlpRequest struct {
+	ResourceSpans []otlpResourceSpans `json:"resourceSpans"`
+}
+
+type otlpResourceSpans struct {
+	Resource   otlpResource     `json:"resource"`
+	ScopeSpans []otlpScopeSpans `json:"scopeSpans"`
+}
+
+type otlpResource struct {
+	Attributes []otlpKeyValue `json:"attributes"`
+}
+
+type otlpScopeSpans struct {
+	Scope otlpScope  `json:"scope"`
+	Spans []otlpSpan `json:"spans"`
+}
+
+type otlpScope struct {
+	Name string `json:"name"`
+}
+
+type otlpSpan struct {
+	TraceID           string         `json:"traceId"`
+	SpanID            string         `json:"spanId"`
+	ParentSpanID      string         `json:"parentSpanId,omitempty"`
+	Name              string         `json:"name"`
+	Kind              SpanKind       `json:"kind"`
+	StartTimeUnixNano string         `json:"startTimeUnixNano"`
+	EndTimeUnixNano   string         `json:"endTimeUnixNano"`
+	Attributes        []otlpKeyValue `json:"attributes,omitempty"`
+	Events            []otlpEvent    `json:"events,omitempty"`
+	Status            otlpStatus     `json:"status"`
+}
+
+type otlpEvent struct {
+	TimeUnixNano string         `json:"timeUnixNano"`
+	Name         string         `json:"name"`
+	Attributes   []otlpKeyValue `json:"attributes,omitempty"`
+}
+
+type otlpStatus struct {
+	Code    StatusCode `json:"code,omitempty"`
+	Message string     `json:"message,omitempty"`
+}
+
+type otlpKeyValue struct {
+	Key   string       `json:"key"`
+	Value otlpAnyValue `json:"value"`
+}
+
+type otlpAnyValue struct {
+	StringValue *string  `json:"stringValue,omitempty"`
+	IntValue    *string  `json:"intValue,omitempty"`
+	DoubleValue *float64 `json:"doubleValue,omitempty"`
+	BoolValue   *bool    `json:"boolValue,omitempty"`
+}
+
+func (o *OTLPExporter) encode(spans []SpanData) *otlpRequest {
+	out := make([]otlpSpan, len(spans))
+	for i := range spans {
+		out[i] = otlpSpan{
+			TraceID:           spans[i].SpanContext.TraceID.String(),
+			SpanID:            spans[i].SpanContext.SpanID.String(),
+			Name:              spans[i].Name,
+			Kind:              spans[i].Kind,
+			StartTimeUnixNano: strconv.FormatInt(spans[i].Start.UnixNano(), 10),
+			EndTimeUnixNano:   strconv.FormatInt(spans[i].End.UnixNano(), 10),
+			Attributes:        encodeAttributes(spans[i].Attributes),
+			Status:            otlpStatus{Code: spans[i].Status, Message: spans[i].Message},
+		}
+		if spans[i].Parent != (SpanID{}) {
+			out[i].ParentSpanID = spans[i].Parent.String()
+		}
+		for j := range spans[i].Events {
+			out[i].Events = append(out[i].Events, otlpEvent{
+				TimeUnixNano: strconv.FormatInt(spans[i].Events[j].Time.UnixNano(), 10),
+				Name:         spans[i].Events[j].Name,
+				Attributes:   encodeAttributes(spans[i].Events[j].Attributes),
+			})
+		}
+	}
+	return &otlpRequest{
+		ResourceSpans: []otlpResourceSpans{{
+			Resource: otlpResource{
+				Attributes: encodeAttributes([]Attribute{String("service.name", o.ServiceName)}),
+			},
+			ScopeSpans: []otlpScopeSpans{{
+				Scope: otlpScope{Name: "github.com/thrasher-corp/gocryptotrader/tracing"},
+				Spans: out,
+			}},
+		}},
+	}
+}
+
+func encodeAttributes(attrs []Attribute) []otlpKeyValue {
+	if len(attrs) == 0 {
+		return nil
+	}
+	kvs := make([]otlpKeyValue, len(attrs))
+	for i := range attrs {
+		kvs[i].Key = attrs[i].Key
+		switch v := attrs[i].Value.(type) {
+		case string:
+			kvs[i].Value.StringValue = &v
+		case int64:
+			s := strconv.FormatInt(v, 10)
+			kvs[i].Value.IntValue = &s
+		case int:
+			s := strconv.Itoa(v)
+			kvs[i].Value.IntValue = &s
+		case float64:
+			kvs[i].Value.DoubleValue = &v
+		case bool:
+			kvs[i].Value.BoolValue = &v
+		default:
+			s := fmt.Sprint(v)
+			kvs[i].Value.StringValue = &s
+		}
+	}
+	return kvs
+}
@@ -0,0 +1,395 @@
+// Package tracing records spans for the order lifecycle and exports them to an
+// OpenTelemetry compatible backend such as Jaeger or Tempo
+package tracing
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/log"
+)
+
+type spanKey struct{}
+
+var (
+	global atomic.Value // *provider
+
+	ordersMtx sync.Mutex
+	orders    = make(map[string]trackedOrder)
+
+	errAlreadyStarted = errors.New("tracing already started")
+)
+
+// Setup starts exporting spans with the OTLP exporter using the supplied
+// config
+func Setup(cfg *Config) error {
+	if cfg == nil {
+		return errors.New("tracing config is nil")
+	}
+	if !cfg.Enabled {
+		return nil
+	}
+	return Start(NewOTLPExporter(cfg), cfg.Verbose)
+}
+
+// Start starts batching ended spans to the exporter
+func Start(e Exporter, verbose bool) error {
+	if e == nil {
+		return errors.New("tracing exporter is nil")
+	}
+	if Enabled() {
+		return errAlreadyStarted
+	}
+	p := &provider{
+		exporter: e,
+		queue:    make(chan SpanData, defaultQueueSize),
+		flush:    make(chan chan struct{}),
+		shutdown: make(chan struct{}),
+		verbose:  verbose,
+	}
+	p.wg.Add(1)
+	go p.run()
+	global.Store(p)
+	return nil
+}
+
+// Shutdown exports any queued spans and stops tracing
+func Shutdown() {
+	p := getProvider()
+	if p == nil {
+		return
+	}
+	global.Store((*provider)(nil))
+	close(p.shutdown)
+	p.wg.Wait()
+}
+
+// Flush blocks until all queued spans have been exported
+func Flush() {
+	p := getProvider()
+	if p == nil {
+		return
+	}
+	done := make(chan struct{})
+	select {
+	case p.flush <- done:
+		<-done
+	case <-p.shutdown:
+	}
+}
+
+// Enabled returns whether spans are being recorded
+func Enabled() bool {
+	return getProvider() != nil
+}
+
+func getProvider() *provider {
+	p, _ := global.Load().(*provider)
+	return p
+}
+
+// StartSpan starts a span which is a child of the span in ctx, if any, and
+// returns a context holding the new span. If tracing is disabled the returned
+// span is nil and ctx is returned unchanged
+func StartSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	return startSpan(ctx, name, KindInternal, attrs)
+}
+
+// StartClientSpan starts a span for an outbound request to a remote service
+func StartClientSpan(ctx context.Context, name string, attrs ...Attribute) (context.Context, *Span) {
+	return startSpan(ctx, name, KindClient, attrs)
+}
+
+func startSpan(ctx context.Context, name string, kind SpanKind, attrs []Attribute) (context.Context, *Span) {
+	p := getProvider()
+	if p == nil {
+		return ctx, nil
+	}
+	if ctx == nil {
+		ctx = context.Background()
+	}
+	s := &Span{
+		name:     name,
+		kind:     kind,
+		start:    time.Now(),
+		attrs:    attrs,
+		provider: p,
+	}
+	if parent, ok := ctx.Value(spanKey{}).(SpanContext); ok {
+		s.sc.TraceID = parent.TraceID
+		s.parent = parent.SpanID
+	} else {
+		s.sc.TraceID = newTraceID()
+	}
+	s.sc.SpanID = newSpanID()
+	return context.WithValue(ctx, spanKey{}, s.sc), s
+}
+
+// ContextWithSpanContext returns a context which parents new spans to sc, for
+// joining work which was started elsewhere to its trace
+func ContextWithSpanContext(ctx context.Context, sc SpanContext) context.Context {
+	if !sc.IsValid() {
+		return ctx
+	}
+	return context.WithValue(ctx, spanKey{}, sc)
+}
+
+// SpanContextFromContext returns the span context stored in ctx
+func SpanContextFromContext(ctx context.Context) (SpanContext, bool) {
+	if ctx == nil {
+		return SpanContext{}, false
+	}
+	sc, ok := ctx.Value(spanKey{}).(SpanContext)
+	return sc, ok
+}
+
+// SpanContext returns the identifiers of the span
+func (s *Span) SpanContext() SpanContext {
+	if s == nil {
+		return SpanContext{}
+	}
+	return s.sc
+}
+
+// SetAttributes adds attributes to the span
+func (s *Span) SetAttributes(attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.mtx.Lock()
+	s.attrs = append(s.attrs, attrs...)
+	s.mtx.Unlock()
+}
+
+// AddEvent records a timestamped event on the span
+func (s *Span) AddEvent(name string, attrs ...Attribute) {
+	if s == nil {
+		return
+	}
+	s.mtx.Lock()
+	s.events = append(s.events, Event{Name: name, Time: time.Now(), Attributes: attrs})
+	s.mtx.Unlock()
+}
+
+// RecordError marks the span as failed and records the error as an event, a
+// nil error is ignored
+func (s *Span) RecordError(err error) {
+	if s == nil || err == nil {
+		return
+	}
+	s.mtx.Lock()
+	s.status = StatusError
+	s.message = err.Error()
+	s.events = append(s.events, Event{
+		Name:       "exception",
+		Time:       time.Now(),
+		Attributes: []Attribute{String("exception.message", err.Error())},
+	})
+	s.mtx.Unlock()
+}
+
+// SetStatus sets the outcome of the span
+func (s *Span) SetStatus(code StatusCode, message string) {
+	if s == nil {
+		return
+	}
+	s.mtx.Lock()
+	s.status = code
+	s.message = message
+	s.mtx.Unlock()
+}
+
+// End completes the span and queues it for export, subsequent calls are
+// ignored
+func (s *Span) End() {
+	if s == nil {
+		return
+	}
+	s.mtx.Lock()
+	if s.ended {
+		s.mtx.Unlock()
+		return
+	}
+	s.ended = true
+	s.end = time.Now()
+	data := SpanData{
+		Name:        s.name,
+		Kind:        s.kind,
+		SpanContext: s.sc,
+		Parent:      s.parent,
+		Start:       s.start,
+		End:         s.end,
+		Attributes:  s.attrs,
+		Events:      s.events,
+		Status:      s.status,
+		Message:     s.message,
+	}
+	s.mtx.Unlock()
+	s.provider.enqueue(&data)
+}
+
+// TrackOrder stores the span context of an acknowledged order so that
+// websocket updates for the order can be joined to the same trace
+func TrackOrder(exchName, orderID string, sc SpanContext) {
+	if orderID == "" || !sc.IsValid() {
+		return
+	}
+	now := time.Now()
+	ordersMtx.Lock()
+	if len(orders) >= maxTrackedOrders {
+		for k, v := range orders {
+			if now.Sub(v.added) > orderTrackingTTL {
+				delete(orders, k)
+			}
+		}
+		if len(orders) >= maxTrackedOrders {
+			// Drop an arbitrary order rather than growing unbounded
+			for k := range orders {
+				delete(orders, k)
+				break
+			}
+		}
+	}
+	orders[orderKey(exchName, orderID)] = trackedOrder{sc: sc, added: now}
+	ordersMtx.Unlock()
+}
+
+// OrderEvent records an update for a tracked order, such as a websocket fill,
+// as a span in the order's trace. Updates for untracked orders are ignored
+func OrderEvent(exchName, orderID, name string, attrs ...Attribute) {
+	if !Enabled() || orderID == "" {
+		return
+	}
+	ordersMtx.Lock()
+	o, ok := orders[orderKey(exchName, orderID)]
+	if ok && time.Since(o.added) > orderTrackingTTL {
+		delete(orders, orderKey(exchName, orderID))
+		ok = false
+	}
+	ordersMtx.Unlock()
+	if !ok {
+		return
+	}
+	attrs = append(attrs, String("exchange", exchName), String("order.id", orderID))
+	_, s := StartSpan(ContextWithSpanContext(context.Background(), o.sc), name, attrs...)
+	s.End()
+}
+
+func orderKey(exchName, orderID string) string {
+	return exchName + ":" + orderID
+}
+
+// IsValid returns whether the span context has non zero identifiers
+func (sc SpanContext) IsValid() bool {
+	return sc.TraceID != TraceID{} && sc.SpanID != SpanID{}
+}
+
+// String returns the hex encoded trace ID
+func (t TraceID) String() string {
+	return hex.EncodeToString(t[:])
+}
+
+// String returns the hex encoded span ID
+func (s SpanID) String() string {
+	return hex.EncodeToString(s[:])
+}
+
+// String returns a string attribute
+func String(key, value string) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Int64 returns an integer attribute
+func Int64(key string, value int64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Float64 returns a float attribute
+func Float64(key string, value float64) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+// Bool returns a boolean attribute
+func Bool(key string, value bool) Attribute {
+	return Attribute{Key: key, Value: value}
+}
+
+func newTraceID() (t TraceID) {
+	for t == (TraceID{}) {
+		_, _ = rand.Read(t[:])
+	}
+	return
+}
+
+func newSpanID() (s SpanID) {
+	for s == (SpanID{}) {
+		_, _ = rand.Read(s[:])
+	}
+	return
+}
+
+// enqueue hands an ended span to the batcher, dropping it if the queue is full
+// so tracing never blocks trading
+func (p *provider) enqueue(s *SpanData) {
+	select {
+	case p.queue <- *s:
+	default:
+		if p.verbose {
+			log.Warnf(log.Global, "Tracing: span queue full, dropping span %s\n", s.Name)
+		}
+	}
+}
+
+func (p *provider) run() {
+	defer p.wg.Done()
+	t := time.NewTicker(defaultFlushInterval)
+	defer t.Stop()
+	batch := make([]SpanData, 0, defaultBatchSize)
+	export := func() {
+		if len(batch) == 0 {
+			return
+		}
+		if err := p.exporter.ExportSpans(batch); err != nil {
+			log.Errorf(log.Global, "Tracing: failed to export %d spans: %v\n", len(batch), err)
+		} else if p.verbose {
+			log.Debugf(log.Global, "Tracing: exported %d spans\n", len(batch))
+		}
+		batch = make([]SpanData, 0, defaultBatchSize)
+	}
+	drain := func() {
+		for {
+			select {
+			case s := <-p.queue:
+				batch = append(batch, s)
+				if len(batch) >= defaultBatchSize {
+					export()
+				}
+			default:
+				export()
+				return
+			}
+		}
+	}
+	for {
+		select {
+		case s := <-p.queue:
+			batch = append(batch, s)
+			if len(batch) >= defaultBatchSize {
+				export()
+			}
+		case <-t.C:
+			export()
+		case done := <-p.flush:
+			drain()
+			close(done)
+		case <-p.shutdown:
+			drain()
+			return
+		}
+	}
+}
@@ -0,0 +1,158 @@
+package tracing
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"sync"
+	"testing"
+)
+
+type testExporter struct {
+	mtx   sync.Mutex
+	spans []SpanData
+}
+
+func (e *testExporter) ExportSpans(s []SpanData) error {
+	e.mtx.Lock()
+	e.spans = append(e.spans, s...)
+	e.mtx.Unlock()
+	return nil
+}
+
+func (e *testExporter) get(name string) *SpanData {
+	e.mtx.Lock()
+	defer e.mtx.Unlock()
+	for i := range e.spans {
+		if e.spans[i].Name == name {
+			return &e.spans[i]
+		}
+	}
+	return nil
+}
+
+func TestDisabled(t *testing.T) {
+	ctx, s := StartSpan(context.Background(), "noop")
+	if s != nil {
+		t.Fatal("expected nil span when tracing is disabled")
+	}
+	if _, ok := SpanContextFromContext(ctx); ok {
+		t.Error("expected no span context when tracing is disabled")
+	}
+	// Methods on a nil span must not panic
+	s.SetAttributes(String("a", "b"))
+	s.AddEvent("event")
+	s.RecordError(errors.New("error"))
+	s.SetStatus(StatusOK, "")
+	s.End()
+	OrderEvent("Bitstamp", "1", "ws.fill")
+}
+
+func TestOrderLifecycle(t *testing.T) {
+	e := &testExporter{}
+	if err := Start(e, false); err != nil {
+		t.Fatal(err)
+	}
+	defer Shutdown()
+	if err := Start(e, false); err != errAlreadyStarted {
+		t.Errorf("expected %v, received %v", errAlreadyStarted, err)
+	}
+
+	ctx, root := StartSpan(context.Background(), "order.submit", String("exchange", "CoinbasePro"))
+	_, child := StartClientSpan(ctx, "HTTP POST")
+	child.RecordError(errors.New("timeout"))
+	child.End()
+	child.End()
+	root.AddEvent("ack", String("order.id", "abc"))
+	TrackOrder("CoinbasePro", "abc", root.SpanContext())
+	root.End()
+	OrderEvent("CoinbasePro", "abc", "ws.fill", Float64("price", 1))
+	OrderEvent("CoinbasePro", "untracked", "ws.untracked")
+	Flush()
+
+	r := e.get("order.submit")
+	h := e.get("HTTP POST")
+	f := e.get("ws.fill")
+	if r == nil || h == nil || f == nil {
+		t.Fatalf("expected all spans to be exported, received %d", len(e.spans))
+	}
+	if e.get("ws.untracked") != nil {
+		t.Error("untracked order event should not be exported")
+	}
+	if len(e.spans) != 3 {
+		t.Errorf("expected 3 spans, received %d", len(e.spans))
+	}
+	if h.SpanContext.TraceID != r.SpanContext.TraceID || h.Parent != r.SpanContext.SpanID {
+		t.Error("HTTP span should be a child of the submit span")
+	}
+	if f.SpanContext.TraceID != r.SpanContext.TraceID || f.Parent != r.SpanContext.SpanID {
+		t.Error("fill span should be joined to the submit trace")
+	}
+	if h.Status != StatusError || h.Message != "timeout" || h.Kind != KindClient {
+		t.Error("unexpected HTTP span status")
+	}
+	if r.Parent != (SpanID{}) {
+		t.Error("submit span should be a root span")
+	}
+}
+
+func TestOTLPExporter(t *testing.T) {
+	var received otlpRequest
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != tracesPath {
+			t.Errorf("unexpected path %s", r.URL.Path)
+		}
+		if r.Header.Get("Authorization") != "Bearer token" {
+			t.Error("expected configured header")
+		}
+		body, err := ioutil.ReadAll(r.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = json.Unmarshal(body, &received); err != nil {
+			t.Fatal(err)
+		}
+	}))
+	defer srv.Close()
+
+	e := NewOTLPExporter(&Config{
+		Endpoint: srv.URL + "/",
+		Headers:  map[string]string{"Authorization": "Bearer token"},
+	})
+	if e.ServiceName != DefaultServiceName {
+		t.Errorf("expected %s, received %s", DefaultServiceName, e.ServiceName)
+	}
+	sc := SpanContext{TraceID: newTraceID(), SpanID: newSpanID()}
+	err := e.ExportSpans([]SpanData{{
+		Name:        "order.submit",
+		Kind:        KindInternal,
+		SpanContext: sc,
+		Attributes:  []Attribute{String("exchange", "Bitstamp"), Int64("attempt", 1), Bool("auth", true)},
+		Status:      StatusError,
+		Message:     "rejected",
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(received.ResourceSpans) != 1 ||
+		len(received.ResourceSpans[0].ScopeSpans) != 1 ||
+		len(received.ResourceSpans[0].ScopeSpans[0].Spans) != 1 {
+		t.Fatal("unexpected request structure")
+	}
+	s := received.ResourceSpans[0].ScopeSpans[0].Spans[0]
+	if s.TraceID != sc.TraceID.String() || s.SpanID != sc.SpanID.String() || s.ParentSpanID != "" {
+		t.Error("unexpected span identifiers")
+	}
+	if s.Status.Code != StatusError || len(s.Attributes) != 3 ||
+		*s.Attributes[1].Value.IntValue != "1" || !*s.Attributes[2].Value.BoolValue {
+		t.Errorf("unexpected span encoding %+v", s)
+	}
+
+	srv.Close()
+	if err = e.ExportSpans(nil); err == nil {
+		t.Error("expected error exporting to a closed collector")
+	}
+}
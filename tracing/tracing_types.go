@@ -0,0 +1,141 @@
+package tracing
+
+import (
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Const vars for tracing
+const (
+	DefaultEndpoint    = "http://localhost:4318"
+	DefaultServiceName = "gocryptotrader"
+
+	defaultQueueSize     = 2048
+	defaultBatchSize     = 512
+	defaultFlushInterval = time.Second * 5
+	defaultExportTimeout = time.Second * 10
+	tracesPath           = "/v1/traces"
+
+	// orderTrackingTTL is how long an acknowledged order is kept so that
+	// later fills can be joined to its trace
+	orderTrackingTTL = time.Hour * 24
+	maxTrackedOrders = 10000
+)
+
+// Span kinds as defined by OTLP
+const (
+	KindInternal SpanKind = 1
+	KindServer   SpanKind = 2
+	KindClient   SpanKind = 3
+)
+
+// Span status codes as defined by OTLP
+const (
+	StatusUnset StatusCode = iota
+	StatusOK
+	StatusError
+)
+
+// Config defines the tracing configuration, spans are exported with the
+// OTLP/HTTP JSON protocol which is accepted by Jaeger, Tempo and the
+// OpenTelemetry collector
+type Config struct {
+	Enabled     bool              `json:"enabled"`
+	Endpoint    string            `json:"endpoint"`
+	ServiceName string            `json:"serviceName"`
+	Headers     map[string]string `json:"headers,omitempty"`
+	Verbose     bool              `json:"verbose"`
+}
+
+// TraceID is a unique identifier of a trace
+type TraceID [16]byte
+
+// SpanID is a unique identifier of a span within a trace
+type SpanID [8]byte
+
+// SpanKind describes the relationship of a span to its parent
+type SpanKind int
+
+// StatusCode is the outcome of a span
+type StatusCode int
+
+// SpanContext identifies a span so children can be joined to it
+type SpanContext struct {
+	TraceID TraceID
+	SpanID  SpanID
+}
+
+// Attribute is a key value pair attached to spans and events
+type Attribute struct {
+	Key   string
+	Value interface{}
+}
+
+// Event is a timestamped annotation on a span
+type Event struct {
+	Name       string
+	Time       time.Time
+	Attributes []Attribute
+}
+
+// Span is a timed operation within a trace. A nil span is valid and all of
+// its methods are no-ops, which is returned when tracing is disabled
+type Span struct {
+	mtx      sync.Mutex
+	name     string
+	kind     SpanKind
+	sc       SpanContext
+	parent   SpanID
+	start    time.Time
+	end      time.Time
+	attrs    []Attribute
+	events   []Event
+	status   StatusCode
+	message  string
+	ended    bool
+	provider *provider
+}
+
+// SpanData is an immutable snapshot of an ended span for exporting
+type SpanData struct {
+	Name        string
+	Kind        SpanKind
+	SpanContext SpanContext
+	Parent      SpanID
+	Start       time.Time
+	End         time.Time
+	Attributes  []Attribute
+	Events      []Event
+	Status      StatusCode
+	Message     string
+}
+
+// Exporter sends ended spans to a tracing backend
+type Exporter interface {
+	ExportSpans([]SpanData) error
+}
+
+// provider batches ended spans and hands them to the exporter
+type provider struct {
+	exporter Exporter
+	queue    chan SpanData
+	flush    chan chan struct{}
+	shutdown chan struct{}
+	wg       sync.WaitGroup
+	verbose  bool
+}
+
+// trackedOrder joins asynchronous order updates to the submitting trace
+type trackedOrder struct {
+	sc    SpanContext
+	added time.Time
+}
+
+// OTLPExporter exports spans to an OTLP/HTTP endpoint using JSON encoding
+type OTLPExporter struct {
+	URL         string
+	ServiceName string
+	Headers     map[string]string
+	Client      *http.Client
+}
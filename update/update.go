@@ -0,0 +1,352 @@
+// Package update replaces GoCryptoTrader binaries with the matching platform
+// binary of the latest GitHub release, verifying the release's signed
+// checksums and keeping the replaced binary so the update can be rolled back
+package update
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"runtime"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/core"
+	"golang.org/x/crypto/ed25519"
+)
+
+// New returns an updater for the running binary, releases of which are
+// prefixed with binary
+func New(binary string) (*Updater, error) {
+	path, err := os.Executable()
+	if err != nil {
+		return nil, err
+	}
+	path, err = filepath.EvalSymlinks(path)
+	if err != nil {
+		return nil, err
+	}
+	u := &Updater{
+		Binary:     binary,
+		Path:       path,
+		Repository: DefaultRepository,
+		APIURL:     DefaultAPIURL,
+		Client:     &http.Client{Timeout: requestTimeout},
+		verify:     runVersion,
+	}
+	if PublicKey != "" {
+		key, err := hex.DecodeString(PublicKey)
+		if err != nil || len(key) != ed25519.PublicKeySize {
+			return nil, errInvalidPublicKey
+		}
+		u.PublicKey = key
+	}
+	return u, nil
+}
+
+// Run performs the update actions in opts, reporting progress to out
+func (u *Updater) Run(ctx context.Context, opts Options, out io.Writer) error {
+	if opts.Rollback {
+		err := u.Rollback()
+		if err != nil {
+			return err
+		}
+		fmt.Fprintf(out, "Rolled back %s to the previous binary.\n", u.Path)
+		return nil
+	}
+
+	r, err := u.Latest(ctx)
+	if err != nil {
+		return err
+	}
+	current := core.MajorVersion + "." + core.MinorVersion
+	newer := Newer(r.TagName, current)
+	switch {
+	case opts.Check && newer:
+		fmt.Fprintf(out, "%s %s is available, running v%s.\n", u.Binary, r.TagName, current)
+		return nil
+	case !newer && !opts.Force:
+		fmt.Fprintf(out, "%s v%s is up to date, the latest release is %s.\n", u.Binary, current, r.TagName)
+		return nil
+	case opts.Check:
+		return nil
+	}
+
+	fmt.Fprintf(out, "Updating %s to %s...\n", u.Path, r.TagName)
+	err = u.Apply(ctx, r, opts.SkipSignature)
+	if err != nil {
+		return err
+	}
+	fmt.Fprintf(out, "Updated %s to %s, the previous binary is kept at %s.\n", u.Path, r.TagName, u.Path+BackupSuffix)
+	return nil
+}
+
+// Latest returns the repository's latest release
+func (u *Updater) Latest(ctx context.Context) (*Release, error) {
+	data, err := u.fetch(ctx, fmt.Sprintf("%s/repos/%s/releases/latest", u.APIURL, u.Repository))
+	if err != nil {
+		return nil, err
+	}
+	var r Release
+	err = json.Unmarshal(data, &r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to read latest release: %s", err)
+	}
+	return &r, nil
+}
+
+// Apply downloads the release's binary for the platform, verifies it against
+// the release's checksums and swaps it in, keeping the binary it replaces.
+// The checksums signature must verify unless skipSignature is set.
+func (u *Updater) Apply(ctx context.Context, r *Release, skipSignature bool) error {
+	if u.PublicKey == nil && !skipSignature {
+		return ErrUnsigned
+	}
+	name := AssetName(u.Binary, runtime.GOOS, runtime.GOARCH)
+	asset, err := r.asset(name)
+	if err != nil {
+		return err
+	}
+	sumsAsset, err := r.asset(ChecksumsAsset)
+	if err != nil {
+		return err
+	}
+	sums, err := u.fetch(ctx, sumsAsset.BrowserDownloadURL)
+	if err != nil {
+		return err
+	}
+	if u.PublicKey != nil {
+		var sigAsset *Asset
+		sigAsset, err = r.asset(ChecksumsAsset + SignatureSuffix)
+		if err != nil {
+			return err
+		}
+		var sig []byte
+		sig, err = u.fetch(ctx, sigAsset.BrowserDownloadURL)
+		if err != nil {
+			return err
+		}
+		// The signature is raw bytes, any of which may be whitespace, so it
+		// is verified exactly as served
+		if len(sig) != ed25519.SignatureSize || !ed25519.Verify(u.PublicKey, sums, sig) {
+			return errSignatureInvalid
+		}
+	}
+	want, err := checksum(sums, name)
+	if err != nil {
+		return err
+	}
+
+	newPath := u.Path + newSuffix
+	err = u.download(ctx, asset, newPath, want)
+	if err != nil {
+		os.Remove(newPath)
+		return err
+	}
+	if u.verify != nil {
+		err = u.verify(newPath)
+		if err != nil {
+			os.Remove(newPath)
+			return fmt.Errorf("%v: %v", errVerificationFails, err)
+		}
+	}
+	return swap(u.Path, newPath)
+}
+
+// Rollback restores the binary replaced by the last update, keeping the
+// updated binary as the backup so rolling back again reinstates it
+func (u *Updater) Rollback() error {
+	backup := u.Path + BackupSuffix
+	if _, err := os.Stat(backup); err != nil {
+		if os.IsNotExist(err) {
+			return ErrNoBackup
+		}
+		return err
+	}
+	tmp := u.Path + newSuffix
+	err := os.Rename(u.Path, tmp)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(backup, u.Path)
+	if err != nil {
+		if restoreErr := os.Rename(tmp, u.Path); restoreErr != nil {
+			return fmt.Errorf("%v, unable to restore %s from %s: %v", err, u.Path, tmp, restoreErr)
+		}
+		return err
+	}
+	return os.Rename(tmp, backup)
+}
+
+// AssetName returns the release asset name of binary for a platform
+func AssetName(binary, goos, goarch string) string {
+	name := binary + "_" + goos + "_" + goarch
+	if goos == "windows" {
+		name += ".exe"
+	}
+	return name
+}
+
+// Newer reports whether a release tag is a later version than current,
+// comparing dot separated numbers with any leading v and pre-release suffix
+// ignored
+func Newer(tag, current string) bool {
+	t, c := parseVersion(tag), parseVersion(current)
+	for i := 0; i < len(t) || i < len(c); i++ {
+		var a, b int
+		if i < len(t) {
+			a = t[i]
+		}
+		if i < len(c) {
+			b = c[i]
+		}
+		if a != b {
+			return a > b
+		}
+	}
+	return false
+}
+
+func parseVersion(v string) []int {
+	v = strings.TrimPrefix(strings.TrimSpace(v), "v")
+	if i := strings.IndexAny(v, "-+"); i != -1 {
+		v = v[:i]
+	}
+	parts := strings.Split(v, ".")
+	result := make([]int, len(parts))
+	for i := range parts {
+		result[i], _ = strconv.Atoi(parts[i])
+	}
+	return result
+}
+
+func (r *Release) asset(name string) (*Asset, error) {
+	for i := range r.Assets {
+		if r.Assets[i].Name == name {
+			return &r.Assets[i], nil
+		}
+	}
+	return nil, fmt.Errorf("%v %s in %s", errNoAsset, name, r.TagName)
+}
+
+// checksum returns the SHA-256 checksum listed for name in a sha256sum
+// formatted checksums file
+func checksum(sums []byte, name string) ([]byte, error) {
+	s := bufio.NewScanner(bytes.NewReader(sums))
+	for s.Scan() {
+		fields := strings.Fields(s.Text())
+		if len(fields) != 2 || strings.TrimPrefix(fields[1], "*") != name {
+			continue
+		}
+		sum, err := hex.DecodeString(fields[0])
+		if err != nil || len(sum) != sha256.Size {
+			return nil, fmt.Errorf("invalid checksum for asset %s", name)
+		}
+		return sum, nil
+	}
+	return nil, fmt.Errorf("%v %s", errChecksumMissing, name)
+}
+
+func (u *Updater) request(ctx context.Context, url string) (*http.Response, error) {
+	req, err := http.NewRequest(http.MethodGet, url, nil)
+	if err != nil {
+		return nil, err
+	}
+	req = req.WithContext(ctx)
+	req.Header.Set("Accept", "application/vnd.github.v3+json")
+	req.Header.Set("User-Agent", u.Binary)
+	resp, err := u.Client.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	if resp.StatusCode != http.StatusOK {
+		resp.Body.Close()
+		return nil, fmt.Errorf("%v %s from %s", errUnexpectedStatus, resp.Status, url)
+	}
+	return resp, nil
+}
+
+// fetch returns a small response body such as the release or its checksums
+func (u *Updater) fetch(ctx context.Context, url string) ([]byte, error) {
+	resp, err := u.request(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	return ioutil.ReadAll(io.LimitReader(resp.Body, maxMetadataSize))
+}
+
+// download writes an asset to path with the permissions of the binary it
+// replaces, failing if its checksum isn't want
+func (u *Updater) download(ctx context.Context, a *Asset, path string, want []byte) error {
+	mode := os.FileMode(0755)
+	if info, err := os.Stat(u.Path); err == nil {
+		mode = info.Mode().Perm()
+	}
+	resp, err := u.request(ctx, a.BrowserDownloadURL)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, mode)
+	if err != nil {
+		return err
+	}
+	h := sha256.New()
+	_, err = io.Copy(io.MultiWriter(f, h), resp.Body)
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+	if err != nil {
+		return err
+	}
+	if !bytes.Equal(h.Sum(nil), want) {
+		return fmt.Errorf("%v %s", errChecksumMismatch, a.Name)
+	}
+	return nil
+}
+
+// swap moves the binary at path to its backup and newPath in its place,
+// restoring the binary if the move fails
+func swap(path, newPath string) error {
+	backup := path + BackupSuffix
+	err := os.Remove(backup)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	err = os.Rename(path, backup)
+	if err != nil {
+		return err
+	}
+	err = os.Rename(newPath, path)
+	if err != nil {
+		if restoreErr := os.Rename(backup, path); restoreErr != nil {
+			return fmt.Errorf("%v, unable to restore %s from %s: %v", err, path, backup, restoreErr)
+		}
+		return err
+	}
+	return nil
+}
+
+// runVersion checks a binary runs by printing its version
+func runVersion(path string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	out, err := exec.CommandContext(ctx, path, "--version").CombinedOutput()
+	if err != nil {
+		return fmt.Errorf("%v %s", err, bytes.TrimSpace(out))
+	}
+	return nil
+}
@@ -0,0 +1,275 @@
+package update
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"runtime"
+	"strings"
+	"testing"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+var newBinary = []byte("new binary")
+
+// testRelease serves a release of newBinary for the platform with its
+// checksums signed by priv, returning the updater and its binary's path
+func testRelease(t *testing.T, priv ed25519.PrivateKey, sums string) (*Updater, func()) {
+	t.Helper()
+	dir, err := ioutil.TempDir("", "update")
+	if err != nil {
+		t.Fatal(err)
+	}
+	path := filepath.Join(dir, "gocryptotrader")
+	err = ioutil.WriteFile(path, []byte("old binary"), 0755)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	name := AssetName("gocryptotrader", runtime.GOOS, runtime.GOARCH)
+	if sums == "" {
+		sum := sha256.Sum256(newBinary)
+		sums = hex.EncodeToString(sum[:]) + "  " + name + "\n"
+	}
+	mux := http.NewServeMux()
+	srv := httptest.NewServer(mux)
+	release := Release{
+		TagName: "v99.0",
+		Assets: []Asset{
+			{Name: name, BrowserDownloadURL: srv.URL + "/binary"},
+			{Name: ChecksumsAsset, BrowserDownloadURL: srv.URL + "/sums"},
+			{Name: ChecksumsAsset + SignatureSuffix, BrowserDownloadURL: srv.URL + "/sig"},
+		},
+	}
+	mux.HandleFunc("/repos/"+DefaultRepository+"/releases/latest", func(w http.ResponseWriter, _ *http.Request) {
+		json.NewEncoder(w).Encode(release)
+	})
+	mux.HandleFunc("/binary", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(newBinary)
+	})
+	mux.HandleFunc("/sums", func(w http.ResponseWriter, _ *http.Request) {
+		fmt.Fprint(w, sums)
+	})
+	mux.HandleFunc("/sig", func(w http.ResponseWriter, _ *http.Request) {
+		w.Write(ed25519.Sign(priv, []byte(sums)))
+	})
+
+	u := &Updater{
+		Binary:     "gocryptotrader",
+		Path:       path,
+		Repository: DefaultRepository,
+		APIURL:     srv.URL,
+		Client:     srv.Client(),
+		verify:     func(string) error { return nil },
+	}
+	return u, func() {
+		srv.Close()
+		os.RemoveAll(dir)
+	}
+}
+
+func readFile(t *testing.T, path string) []byte {
+	t.Helper()
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return data
+}
+
+func TestNewer(t *testing.T) {
+	t.Parallel()
+	tests := []struct {
+		tag, current string
+		expected     bool
+	}{
+		{"v0.2", "0.1", true},
+		{"v0.1", "0.1", false},
+		{"v0.1.1", "0.1", true},
+		{"v0.10", "0.9", true},
+		{"v1.0.0-rc1", "1.0", false},
+		{"v0.1", "0.2", false},
+	}
+	for i := range tests {
+		if Newer(tests[i].tag, tests[i].current) != tests[i].expected {
+			t.Errorf("Newer(%s, %s) expected %v", tests[i].tag, tests[i].current, tests[i].expected)
+		}
+	}
+}
+
+func TestChecksum(t *testing.T) {
+	t.Parallel()
+	sum := sha256.Sum256(newBinary)
+	sums := []byte("00  other\n" + hex.EncodeToString(sum[:]) + " *gocryptotrader_linux_amd64\n")
+	got, err := checksum(sums, "gocryptotrader_linux_amd64")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, sum[:]) {
+		t.Error("unexpected checksum")
+	}
+	_, err = checksum(sums, "gctcli_linux_amd64")
+	if err == nil || !strings.HasPrefix(err.Error(), errChecksumMissing.Error()) {
+		t.Errorf("expected %v, received %v", errChecksumMissing, err)
+	}
+	_, err = checksum(sums, "other")
+	if err == nil {
+		t.Error("expected an invalid checksum error")
+	}
+}
+
+func TestRunUpdateAndRollback(t *testing.T) {
+	t.Parallel()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, cleanup := testRelease(t, priv, "")
+	defer cleanup()
+	u.PublicKey = pub
+
+	var out bytes.Buffer
+	err = u.Run(context.Background(), Options{Check: true}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !strings.Contains(out.String(), "v99.0 is available") {
+		t.Errorf("unexpected check output %q", out.String())
+	}
+	if string(readFile(t, u.Path)) != "old binary" {
+		t.Fatal("check should not update the binary")
+	}
+
+	err = u.Run(context.Background(), Options{}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(readFile(t, u.Path), newBinary) {
+		t.Error("binary was not updated")
+	}
+	if string(readFile(t, u.Path+BackupSuffix)) != "old binary" {
+		t.Error("replaced binary was not kept")
+	}
+
+	err = u.Run(context.Background(), Options{Rollback: true}, &out)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if string(readFile(t, u.Path)) != "old binary" {
+		t.Error("binary was not rolled back")
+	}
+	if !bytes.Equal(readFile(t, u.Path+BackupSuffix), newBinary) {
+		t.Error("rolled back binary should become the backup")
+	}
+}
+
+func TestApplyVerification(t *testing.T) {
+	t.Parallel()
+	pub, priv, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u, cleanup := testRelease(t, priv, "")
+	defer cleanup()
+	r, err := u.Latest(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = u.Apply(context.Background(), r, false)
+	if err != ErrUnsigned {
+		t.Errorf("expected %v, received %v", ErrUnsigned, err)
+	}
+
+	otherPub, _, err := ed25519.GenerateKey(nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	u.PublicKey = otherPub
+	err = u.Apply(context.Background(), r, false)
+	if err != errSignatureInvalid {
+		t.Errorf("expected %v, received %v", errSignatureInvalid, err)
+	}
+
+	u.PublicKey = pub
+	u.verify = func(string) error { return fmt.Errorf("exec format error") }
+	err = u.Apply(context.Background(), r, false)
+	if err == nil || !strings.HasPrefix(err.Error(), errVerificationFails.Error()) {
+		t.Errorf("expected %v, received %v", errVerificationFails, err)
+	}
+	if string(readFile(t, u.Path)) != "old binary" {
+		t.Error("binary should not be replaced when verification fails")
+	}
+	if _, err = os.Stat(u.Path + newSuffix); !os.IsNotExist(err) {
+		t.Error("failed download should be removed")
+	}
+
+	err = u.Rollback()
+	if err != ErrNoBackup {
+		t.Errorf("expected %v, received %v", ErrNoBackup, err)
+	}
+}
+
+func TestApplyChecksumMismatch(t *testing.T) {
+	t.Parallel()
+	name := AssetName("gocryptotrader", runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256([]byte("tampered binary"))
+	u, cleanup := testRelease(t, nil, hex.EncodeToString(sum[:])+"  "+name+"\n")
+	defer cleanup()
+	r, err := u.Latest(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = u.Apply(context.Background(), r, true)
+	if err == nil || !strings.HasPrefix(err.Error(), errChecksumMismatch.Error()) {
+		t.Errorf("expected %v, received %v", errChecksumMismatch, err)
+	}
+	if string(readFile(t, u.Path)) != "old binary" {
+		t.Error("binary should not be replaced on a checksum mismatch")
+	}
+}
+
+func TestApplyWhitespaceSignature(t *testing.T) {
+	t.Parallel()
+	name := AssetName("gocryptotrader", runtime.GOOS, runtime.GOARCH)
+	sum := sha256.Sum256(newBinary)
+	sums := hex.EncodeToString(sum[:]) + "  " + name + "\n"
+	// Find a key whose signature ends in a whitespace byte, which must not
+	// be trimmed before verifying
+	var pub ed25519.PublicKey
+	var priv ed25519.PrivateKey
+	for {
+		var err error
+		pub, priv, err = ed25519.GenerateKey(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sig := ed25519.Sign(priv, []byte(sums))
+		if len(bytes.TrimSpace(sig)) != ed25519.SignatureSize {
+			break
+		}
+	}
+	u, cleanup := testRelease(t, priv, sums)
+	defer cleanup()
+	u.PublicKey = pub
+	r, err := u.Latest(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = u.Apply(context.Background(), r, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(readFile(t, u.Path), newBinary) {
+		t.Error("binary was not updated")
+	}
+}
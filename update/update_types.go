@@ -0,0 +1,96 @@
+package update
+
+import (
+	"errors"
+	"net/http"
+	"time"
+
+	"golang.org/x/crypto/ed25519"
+)
+
+// Const vars for updates
+const (
+	// DefaultRepository is the GitHub repository releases are fetched from
+	DefaultRepository = "thrasher-corp/gocryptotrader"
+	// DefaultAPIURL is the GitHub API releases are looked up with
+	DefaultAPIURL = "https://api.github.com"
+	// ChecksumsAsset is the release asset listing the SHA-256 checksum of
+	// every other asset, in the format written by sha256sum
+	ChecksumsAsset = "checksums.txt"
+	// SignatureSuffix is appended to the checksums asset name for its
+	// detached ed25519 signature
+	SignatureSuffix = ".sig"
+	// BackupSuffix is appended to the binary's path for the copy of the
+	// binary it replaced, which rolling back restores
+	BackupSuffix = ".old"
+
+	newSuffix      = ".new"
+	requestTimeout = 5 * time.Minute
+	// maxMetadataSize limits the release and checksums responses read
+	maxMetadataSize = 1 << 20
+)
+
+// PublicKey is the hex encoded ed25519 key releases are signed with, set at
+// build time with
+// -ldflags "-X github.com/thrasher-corp/gocryptotrader/update.PublicKey=<key>"
+var PublicKey string
+
+var (
+	// ErrUnsigned is returned when updating a build without a release public
+	// key, unless signatures are skipped
+	ErrUnsigned = errors.New("binary was built without a release public key, unable to verify the release signature")
+	// ErrNoBackup is returned when rolling back without a previous binary
+	ErrNoBackup = errors.New("no previous binary to roll back to")
+
+	errNoAsset           = errors.New("release has no asset")
+	errChecksumMissing   = errors.New("release checksums do not list asset")
+	errChecksumMismatch  = errors.New("checksum mismatch for asset")
+	errSignatureInvalid  = errors.New("release checksums signature is invalid")
+	errInvalidPublicKey  = errors.New("release public key is invalid")
+	errUnexpectedStatus  = errors.New("unexpected response status")
+	errVerificationFails = errors.New("downloaded binary failed to run")
+)
+
+// Release is a GitHub release
+type Release struct {
+	TagName string  `json:"tag_name"`
+	Name    string  `json:"name"`
+	Assets  []Asset `json:"assets"`
+}
+
+// Asset is a file attached to a GitHub release
+type Asset struct {
+	Name               string `json:"name"`
+	Size               int64  `json:"size"`
+	BrowserDownloadURL string `json:"browser_download_url"`
+}
+
+// Options are the actions of an update run
+type Options struct {
+	// Check only reports whether a newer release is available
+	Check bool
+	// Force installs the latest release even when it isn't newer
+	Force bool
+	// Rollback restores the binary replaced by the last update
+	Rollback bool
+	// SkipSignature updates with only the checksums verified, for builds
+	// without a release public key
+	SkipSignature bool
+}
+
+// Updater replaces a binary with the matching platform binary of the
+// latest GitHub release
+type Updater struct {
+	// Binary is the name release assets are prefixed with
+	Binary string
+	// Path is the binary replaced
+	Path       string
+	Repository string
+	APIURL     string
+	// PublicKey verifies the release checksums signature, which isn't
+	// checked when nil
+	PublicKey ed25519.PublicKey
+	Client    *http.Client
+	// verify checks a downloaded binary runs before it is swapped in
+	verify func(path string) error
+}
@@ -0,0 +1,390 @@
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"encoding/hex"
+	"fmt"
+	"math"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"golang.org/x/crypto/ripemd160"
+)
+
+const (
+	bitcoinTxVersion = 2
+	// bitcoinSequence signals replace by fee so a stuck transaction can be
+	// bumped from another wallet holding the key
+	bitcoinSequence = 0xfffffffd
+	sigHashAll      = 0x01
+	// Virtual sizes of a transaction's fixed fields, with the segwit marker,
+	// and of a signed native segwit input
+	bitcoinTxOverheadVSize = 11
+	bitcoinInputVSize      = 68
+)
+
+// bitcoinParams are the address prefixes of a Bitcoin network
+type bitcoinParams struct {
+	hrp          string
+	pubKeyHashID byte
+	scriptHashID byte
+	privateKeyID byte
+}
+
+var (
+	bitcoinMainnet = bitcoinParams{hrp: "bc", pubKeyHashID: 0x00, scriptHashID: 0x05, privateKeyID: 0x80}
+	bitcoinTestnet = bitcoinParams{hrp: "tb", pubKeyHashID: 0x6f, scriptHashID: 0xc4, privateKeyID: 0xef}
+)
+
+// bitcoinWallet spends the native segwit outputs of a key, reading them
+// from and broadcasting to an Esplora API
+type bitcoinWallet struct {
+	client
+	params     bitcoinParams
+	key        *privateKey
+	pubKeyHash []byte
+	maxFee     float64
+}
+
+func newBitcoinWallet(s *Settings) (*bitcoinWallet, error) {
+	params := bitcoinMainnet
+	apiURL := DefaultBitcoinAPIURL
+	if s.Testnet {
+		params = bitcoinTestnet
+		apiURL = DefaultBitcoinTestnetAPIURL
+	}
+	key, err := parseBitcoinKey(params, s.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	pubKeyHash := hash160(key.pub.compressed())
+	address, err := segwitAddress(params.hrp, 0, pubKeyHash)
+	if err != nil {
+		return nil, err
+	}
+	if !strings.EqualFold(address, s.Address) {
+		return nil, errAddressMismatch
+	}
+	code := s.Currency
+	if code.IsEmpty() {
+		code = currency.BTC
+	}
+	if s.APIURL != "" {
+		apiURL = s.APIURL
+	}
+	return &bitcoinWallet{
+		client:     newClient(s.Name, address, code, apiURL),
+		params:     params,
+		key:        key,
+		pubKeyHash: pubKeyHash,
+		maxFee:     s.MaxFee,
+	}, nil
+}
+
+// parseBitcoinKey parses a WIF encoded private key for the network, or a
+// hex encoded one. Keys for uncompressed public keys are rejected as they
+// can't spend native segwit outputs
+func parseBitcoinKey(params bitcoinParams, s string) (*privateKey, error) {
+	if b, err := base58CheckDecode(strings.TrimSpace(s)); err == nil {
+		if b[0] != params.privateKeyID || len(b) != 34 || b[33] != 0x01 {
+			return nil, errInvalidPrivateKey
+		}
+		return newPrivateKey(b[1:33])
+	}
+	return parseHexKey(s)
+}
+
+func hash160(b []byte) []byte {
+	sha := sha256.Sum256(b)
+	h := ripemd160.New()
+	h.Write(sha[:])
+	return h.Sum(nil)
+}
+
+// outputScript returns the output script paying an address on the network
+func (p bitcoinParams) outputScript(addr string) ([]byte, error) {
+	if strings.HasPrefix(strings.ToLower(addr), p.hrp+"1") {
+		version, program, err := decodeSegwitAddress(p.hrp, addr)
+		if err != nil {
+			return nil, err
+		}
+		op := version
+		if version > 0 {
+			op = 0x50 + version
+		}
+		return append([]byte{op, byte(len(program))}, program...), nil
+	}
+	b, err := base58CheckDecode(addr)
+	if err != nil || len(b) != 21 {
+		return nil, errInvalidAddress
+	}
+	switch b[0] {
+	case p.pubKeyHashID:
+		// OP_DUP OP_HASH160 <hash> OP_EQUALVERIFY OP_CHECKSIG
+		return append(append([]byte{0x76, 0xa9, 0x14}, b[1:]...), 0x88, 0xac), nil
+	case p.scriptHashID:
+		// OP_HASH160 <hash> OP_EQUAL
+		return append(append([]byte{0xa9, 0x14}, b[1:]...), 0x87), nil
+	}
+	return nil, errUnsupportedAddress
+}
+
+// esploraUTXO is an unspent output returned by an Esplora API
+type esploraUTXO struct {
+	TxID   string `json:"txid"`
+	Vout   uint32 `json:"vout"`
+	Value  int64  `json:"value"`
+	Status struct {
+		Confirmed bool `json:"confirmed"`
+	} `json:"status"`
+}
+
+// Send signs and broadcasts a transaction paying an amount of BTC to an
+// address, spending the wallet's largest confirmed outputs first and
+// returning any change to the wallet. The fee is paid on top of the amount
+func (b *bitcoinWallet) Send(ctx context.Context, to string, amount decimal.Decimal) (*Transaction, error) {
+	if amount.Sign() <= 0 {
+		return nil, errInvalidAmount
+	}
+	script, err := b.params.outputScript(to)
+	if err != nil {
+		return nil, fmt.Errorf("%v: %v", errInvalidAddress, err)
+	}
+	sats := amount.Mul(decimal.New(1, bitcoinDecimals)).Round(0).IntPart()
+	if sats < bitcoinDustLimit {
+		return nil, fmt.Errorf("amount is below the %d satoshi dust limit", bitcoinDustLimit)
+	}
+
+	var utxos []esploraUTXO
+	err = b.sendRequest(ctx, http.MethodGet, "/address/"+b.address+"/utxo", nil, &utxos)
+	if err != nil {
+		return nil, err
+	}
+	var estimates map[string]float64
+	err = b.sendRequest(ctx, http.MethodGet, "/fee-estimates", nil, &estimates)
+	if err != nil {
+		return nil, err
+	}
+	feeRate, ok := estimates[bitcoinConfirmationTarget]
+	if !ok || feeRate <= 0 {
+		return nil, fmt.Errorf("no fee estimate for %s blocks", bitcoinConfirmationTarget)
+	}
+
+	tx, fee, err := b.buildTransaction(utxos, script, sats, feeRate)
+	if err != nil {
+		return nil, err
+	}
+	feeAmount := float64(fee) / math.Pow10(bitcoinDecimals)
+	if b.maxFee > 0 && feeAmount > b.maxFee {
+		return nil, fmt.Errorf("%v: %v %s", errFeeExceedsMaximum, feeAmount, b.code)
+	}
+	if err = tx.sign(b.key, b.pubKeyHash); err != nil {
+		return nil, err
+	}
+	err = b.sendRequest(ctx, http.MethodPost, "/tx",
+		hex.EncodeToString(tx.serialize(true)), nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Transaction{TxID: tx.txID(), Fee: feeAmount}, nil
+}
+
+// buildTransaction selects the confirmed outputs paying an amount to a script
+// and the fee at a rate in satoshis per virtual byte, adding a change output
+// unless the change is dust. It returns the unsigned transaction and its fee
+func (b *bitcoinWallet) buildTransaction(utxos []esploraUTXO, script []byte, amount int64, feeRate float64) (*bitcoinTx, int64, error) {
+	sort.Slice(utxos, func(i, j int) bool { return utxos[i].Value > utxos[j].Value })
+	change := append([]byte{0x00, 0x14}, b.pubKeyHash...)
+	outputsVSize := outputVSize(script) + outputVSize(change)
+	tx := &bitcoinTx{
+		version: bitcoinTxVersion,
+		outputs: []bitcoinOutput{{value: amount, script: script}},
+	}
+	var total int64
+	for i := range utxos {
+		if !utxos[i].Status.Confirmed {
+			continue
+		}
+		hash, err := hex.DecodeString(utxos[i].TxID)
+		if err != nil || len(hash) != 32 {
+			return nil, 0, fmt.Errorf("invalid output transaction ID %s", utxos[i].TxID)
+		}
+		in := bitcoinInput{index: utxos[i].Vout, sequence: bitcoinSequence, value: utxos[i].Value}
+		for j := range hash {
+			in.hash[j] = hash[len(hash)-1-j]
+		}
+		tx.inputs = append(tx.inputs, in)
+		total += utxos[i].Value
+
+		vsize := bitcoinTxOverheadVSize + bitcoinInputVSize*len(tx.inputs) + outputsVSize
+		fee := int64(math.Ceil(feeRate * float64(vsize)))
+		if total < amount+fee {
+			continue
+		}
+		if remainder := total - amount - fee; remainder >= bitcoinDustLimit {
+			tx.outputs = append(tx.outputs, bitcoinOutput{value: remainder, script: change})
+			return tx, fee, nil
+		}
+		// Without a change output the dust is left to the fee
+		return tx, total - amount, nil
+	}
+	return nil, 0, errInsufficientFunds
+}
+
+func outputVSize(script []byte) int {
+	return 8 + 1 + len(script)
+}
+
+// bitcoinTx is a transaction spending native segwit outputs
+type bitcoinTx struct {
+	version  uint32
+	inputs   []bitcoinInput
+	outputs  []bitcoinOutput
+	locktime uint32
+}
+
+// bitcoinInput spends an output, hash being the transaction ID in internal
+// byte order
+type bitcoinInput struct {
+	hash     [32]byte
+	index    uint32
+	sequence uint32
+	value    int64
+	witness  [][]byte
+}
+
+type bitcoinOutput struct {
+	value  int64
+	script []byte
+}
+
+// sign signs every input with the key paying the public key hash
+func (t *bitcoinTx) sign(key *privateKey, pubKeyHash []byte) error {
+	pub := key.pub.compressed()
+	for i := range t.inputs {
+		hash, err := t.sigHash(i, pubKeyHash)
+		if err != nil {
+			return err
+		}
+		r, s, _ := key.sign(hash)
+		t.inputs[i].witness = [][]byte{append(derSignature(r, s), sigHashAll), pub}
+	}
+	return nil
+}
+
+// sigHash returns the BIP 143 signature hash of an input paying a public key
+// hash, signing all inputs and outputs
+func (t *bitcoinTx) sigHash(i int, pubKeyHash []byte) ([]byte, error) {
+	if i < 0 || i >= len(t.inputs) {
+		return nil, fmt.Errorf("input %d out of range", i)
+	}
+	var prevouts, sequences, outputs bytes.Buffer
+	for j := range t.inputs {
+		prevouts.Write(t.inputs[j].hash[:])
+		writeUint32(&prevouts, t.inputs[j].index)
+		writeUint32(&sequences, t.inputs[j].sequence)
+	}
+	for j := range t.outputs {
+		t.outputs[j].write(&outputs)
+	}
+
+	var b bytes.Buffer
+	writeUint32(&b, t.version)
+	b.Write(doubleSHA256(prevouts.Bytes()))
+	b.Write(doubleSHA256(sequences.Bytes()))
+	b.Write(t.inputs[i].hash[:])
+	writeUint32(&b, t.inputs[i].index)
+	// OP_DUP OP_HASH160 <hash> OP_EQUALVERIFY OP_CHECKSIG
+	b.Write([]byte{0x19, 0x76, 0xa9, 0x14})
+	b.Write(pubKeyHash)
+	b.Write([]byte{0x88, 0xac})
+	writeUint64(&b, uint64(t.inputs[i].value))
+	writeUint32(&b, t.inputs[i].sequence)
+	b.Write(doubleSHA256(outputs.Bytes()))
+	writeUint32(&b, t.locktime)
+	writeUint32(&b, sigHashAll)
+	return doubleSHA256(b.Bytes()), nil
+}
+
+// serialize returns the transaction encoded with or without its witnesses
+func (t *bitcoinTx) serialize(witness bool) []byte {
+	var b bytes.Buffer
+	writeUint32(&b, t.version)
+	if witness {
+		b.Write([]byte{0x00, 0x01})
+	}
+	writeVarInt(&b, uint64(len(t.inputs)))
+	for i := range t.inputs {
+		b.Write(t.inputs[i].hash[:])
+		writeUint32(&b, t.inputs[i].index)
+		b.WriteByte(0x00)
+		writeUint32(&b, t.inputs[i].sequence)
+	}
+	writeVarInt(&b, uint64(len(t.outputs)))
+	for i := range t.outputs {
+		t.outputs[i].write(&b)
+	}
+	if witness {
+		for i := range t.inputs {
+			writeVarInt(&b, uint64(len(t.inputs[i].witness)))
+			for j := range t.inputs[i].witness {
+				writeVarInt(&b, uint64(len(t.inputs[i].witness[j])))
+				b.Write(t.inputs[i].witness[j])
+			}
+		}
+	}
+	writeUint32(&b, t.locktime)
+	return b.Bytes()
+}
+
+func (o *bitcoinOutput) write(b *bytes.Buffer) {
+	writeUint64(b, uint64(o.value))
+	writeVarInt(b, uint64(len(o.script)))
+	b.Write(o.script)
+}
+
+// txID returns the transaction ID, the reversed hash of the transaction
+// without witnesses
+func (t *bitcoinTx) txID() string {
+	hash := doubleSHA256(t.serialize(false))
+	for i, j := 0, len(hash)-1; i < j; i, j = i+1, j-1 {
+		hash[i], hash[j] = hash[j], hash[i]
+	}
+	return hex.EncodeToString(hash)
+}
+
+func writeUint32(b *bytes.Buffer, v uint32) {
+	var buf [4]byte
+	binary.LittleEndian.PutUint32(buf[:], v)
+	b.Write(buf[:])
+}
+
+func writeUint64(b *bytes.Buffer, v uint64) {
+	var buf [8]byte
+	binary.LittleEndian.PutUint64(buf[:], v)
+	b.Write(buf[:])
+}
+
+func writeVarInt(b *bytes.Buffer, v uint64) {
+	switch {
+	case v < 0xfd:
+		b.WriteByte(byte(v))
+	case v <= math.MaxUint16:
+		b.WriteByte(0xfd)
+		var buf [2]byte
+		binary.LittleEndian.PutUint16(buf[:], uint16(v))
+		b.Write(buf[:])
+	case v <= math.MaxUint32:
+		b.WriteByte(0xfe)
+		writeUint32(b, uint32(v))
+	default:
+		b.WriteByte(0xff)
+		writeUint64(b, v)
+	}
+}
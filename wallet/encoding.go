@@ -0,0 +1,169 @@
+package wallet
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"errors"
+	"math/big"
+	"strings"
+)
+
+const (
+	base58Alphabet = "123456789ABCDEFGHJKLMNPQRSTUVWXYZabcdefghijkmnopqrstuvwxyz"
+	bech32Alphabet = "qpzry9x8gf2tvdw0s3jn54khce6mua7l"
+	bech32Const    = 1
+	bech32mConst   = 0x2bc830a3
+)
+
+var errInvalidChecksum = errors.New("invalid checksum")
+
+func doubleSHA256(b []byte) []byte {
+	first := sha256.Sum256(b)
+	second := sha256.Sum256(first[:])
+	return second[:]
+}
+
+// base58CheckDecode decodes a base58 string, verifying and removing its four
+// byte checksum
+func base58CheckDecode(s string) ([]byte, error) {
+	n := new(big.Int)
+	radix := big.NewInt(58)
+	for _, c := range s {
+		i := strings.IndexRune(base58Alphabet, c)
+		if i < 0 {
+			return nil, errors.New("invalid base58 character")
+		}
+		n.Mul(n, radix).Add(n, big.NewInt(int64(i)))
+	}
+	var leading int
+	for leading < len(s) && s[leading] == base58Alphabet[0] {
+		leading++
+	}
+	b := append(make([]byte, leading), n.Bytes()...)
+	if len(b) < 5 {
+		return nil, errInvalidChecksum
+	}
+	payload, checksum := b[:len(b)-4], b[len(b)-4:]
+	if !bytes.Equal(doubleSHA256(payload)[:4], checksum) {
+		return nil, errInvalidChecksum
+	}
+	return payload, nil
+}
+
+func bech32Polymod(values []byte) uint32 {
+	generator := [5]uint32{0x3b6a57b2, 0x26508e6d, 0x1ea119fa, 0x3d4233dd, 0x2a1462b3}
+	chk := uint32(1)
+	for _, v := range values {
+		top := chk >> 25
+		chk = (chk&0x1ffffff)<<5 ^ uint32(v)
+		for i := range generator {
+			if (top>>uint(i))&1 == 1 {
+				chk ^= generator[i]
+			}
+		}
+	}
+	return chk
+}
+
+func bech32HRPExpand(hrp string) []byte {
+	resp := make([]byte, 0, len(hrp)*2+1)
+	for i := range hrp {
+		resp = append(resp, hrp[i]>>5)
+	}
+	resp = append(resp, 0)
+	for i := range hrp {
+		resp = append(resp, hrp[i]&31)
+	}
+	return resp
+}
+
+// convertBits regroups a slice of from bit values into to bit values,
+// padding the last value when converting to larger groups
+func convertBits(data []byte, from, to uint, pad bool) ([]byte, error) {
+	var acc, bits uint
+	var resp []byte
+	maxValue := uint(1)<<to - 1
+	for _, v := range data {
+		if uint(v)>>from != 0 {
+			return nil, errors.New("invalid data value")
+		}
+		acc = acc<<from | uint(v)
+		bits += from
+		for bits >= to {
+			bits -= to
+			resp = append(resp, byte(acc>>bits&maxValue))
+		}
+	}
+	if pad {
+		if bits > 0 {
+			resp = append(resp, byte(acc<<(to-bits)&maxValue))
+		}
+	} else if bits >= from || acc<<(to-bits)&maxValue != 0 {
+		return nil, errors.New("invalid padding")
+	}
+	return resp, nil
+}
+
+// segwitAddress encodes a segwit program as a bech32 address for version 0
+// or bech32m for later versions
+func segwitAddress(hrp string, version byte, program []byte) (string, error) {
+	data, err := convertBits(program, 8, 5, true)
+	if err != nil {
+		return "", err
+	}
+	data = append([]byte{version}, data...)
+	constant := uint32(bech32Const)
+	if version > 0 {
+		constant = bech32mConst
+	}
+	values := append(bech32HRPExpand(hrp), data...)
+	polymod := bech32Polymod(append(values, 0, 0, 0, 0, 0, 0)) ^ constant
+	for i := 0; i < 6; i++ {
+		data = append(data, byte(polymod>>uint(5*(5-i))&31))
+	}
+	var sb strings.Builder
+	sb.WriteString(hrp)
+	sb.WriteByte('1')
+	for _, v := range data {
+		sb.WriteByte(bech32Alphabet[v])
+	}
+	return sb.String(), nil
+}
+
+// decodeSegwitAddress decodes a bech32 or bech32m segwit address with the
+// human readable part hrp, returning its version and program
+func decodeSegwitAddress(hrp, addr string) (byte, []byte, error) {
+	if strings.ToLower(addr) != addr && strings.ToUpper(addr) != addr {
+		return 0, nil, errors.New("mixed case address")
+	}
+	addr = strings.ToLower(addr)
+	sep := strings.LastIndexByte(addr, '1')
+	if sep < 1 || addr[:sep] != hrp || len(addr)-sep-1 < 7 {
+		return 0, nil, errUnsupportedAddress
+	}
+	data := make([]byte, 0, len(addr)-sep-1)
+	for i := sep + 1; i < len(addr); i++ {
+		v := strings.IndexByte(bech32Alphabet, addr[i])
+		if v < 0 {
+			return 0, nil, errors.New("invalid bech32 character")
+		}
+		data = append(data, byte(v))
+	}
+	version := data[0]
+	constant := uint32(bech32Const)
+	if version > 0 {
+		constant = bech32mConst
+	}
+	if bech32Polymod(append(bech32HRPExpand(hrp), data...)) != constant {
+		return 0, nil, errInvalidChecksum
+	}
+	program, err := convertBits(data[1:len(data)-6], 5, 8, false)
+	if err != nil {
+		return 0, nil, err
+	}
+	if version > 16 || len(program) < 2 || len(program) > 40 ||
+		(version == 0 && len(program) != 20 && len(program) != 32) {
+		return 0, nil, errUnsupportedAddress
+	}
+	return version, program, nil
+}
@@ -0,0 +1,275 @@
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"net/http"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+	"golang.org/x/crypto/sha3"
+)
+
+// evmWallet sends the native currency of an EVM chain from an account with
+// EIP-155 transactions, using a JSON-RPC node for the nonce, gas and
+// broadcast
+type evmWallet struct {
+	client
+	chainID *big.Int
+	key     *privateKey
+	maxFee  float64
+}
+
+func newEVMWallet(s *Settings) (*evmWallet, error) {
+	if s.APIURL == "" {
+		return nil, errRPCNodeRequired
+	}
+	if s.ChainID <= 0 {
+		return nil, fmt.Errorf("invalid chain ID %d", s.ChainID)
+	}
+	key, err := parseHexKey(s.PrivateKey)
+	if err != nil {
+		return nil, err
+	}
+	address := evmAddress(key.pub)
+	if !strings.EqualFold(address, s.Address) {
+		return nil, errAddressMismatch
+	}
+	code := s.Currency
+	if code.IsEmpty() {
+		code = currency.ETH
+	}
+	return &evmWallet{
+		client:  newClient(s.Name, address, code, s.APIURL),
+		chainID: big.NewInt(s.ChainID),
+		key:     key,
+		maxFee:  s.MaxFee,
+	}, nil
+}
+
+func keccak256(data ...[]byte) []byte {
+	h := sha3.NewLegacyKeccak256()
+	for i := range data {
+		h.Write(data[i])
+	}
+	return h.Sum(nil)
+}
+
+// evmAddress returns the EIP-55 checksummed account address of a public key
+func evmAddress(pub publicKey) string {
+	return checksumAddress(keccak256(pub.uncompressed())[12:])
+}
+
+func checksumAddress(b []byte) string {
+	lower := hex.EncodeToString(b)
+	hash := keccak256([]byte(lower))
+	resp := []byte(lower)
+	for i := range resp {
+		nibble := hash[i/2] >> 4
+		if i%2 == 1 {
+			nibble = hash[i/2] & 0x0f
+		}
+		if resp[i] >= 'a' && nibble >= 8 {
+			resp[i] -= 'a' - 'A'
+		}
+	}
+	return "0x" + string(resp)
+}
+
+// parseEVMAddress decodes an account address, verifying its EIP-55 checksum
+// when it is mixed case
+func parseEVMAddress(addr string) ([]byte, error) {
+	if len(addr) != 42 || !strings.HasPrefix(addr, "0x") {
+		return nil, errInvalidAddress
+	}
+	b, err := hex.DecodeString(addr[2:])
+	if err != nil {
+		return nil, errInvalidAddress
+	}
+	body := addr[2:]
+	if body != strings.ToLower(body) && body != strings.ToUpper(body) &&
+		checksumAddress(b) != addr {
+		return nil, fmt.Errorf("%v: checksum mismatch", errInvalidAddress)
+	}
+	return b, nil
+}
+
+// Send signs and broadcasts a transaction paying an amount of the chain's
+// native currency to an address. The fee is paid on top of the amount
+func (e *evmWallet) Send(ctx context.Context, to string, amount decimal.Decimal) (*Transaction, error) {
+	if amount.Sign() <= 0 {
+		return nil, errInvalidAmount
+	}
+	recipient, err := parseEVMAddress(to)
+	if err != nil {
+		return nil, err
+	}
+	value := toWei(amount)
+
+	var chainID hexBig
+	if err = e.call(ctx, "eth_chainId", []interface{}{}, &chainID); err != nil {
+		return nil, err
+	}
+	if chainID.Int().Cmp(e.chainID) != 0 {
+		return nil, fmt.Errorf("%v: %v", errChainIDMismatch, chainID.Int())
+	}
+	var nonce, gasPrice, gas hexBig
+	if err = e.call(ctx, "eth_getTransactionCount", []interface{}{e.address, "pending"}, &nonce); err != nil {
+		return nil, err
+	}
+	if err = e.call(ctx, "eth_gasPrice", []interface{}{}, &gasPrice); err != nil {
+		return nil, err
+	}
+	call := map[string]string{
+		"from":  e.address,
+		"to":    to,
+		"value": "0x" + value.Text(16),
+	}
+	if err = e.call(ctx, "eth_estimateGas", []interface{}{call}, &gas); err != nil {
+		return nil, err
+	}
+
+	fee := fromWei(new(big.Int).Mul(gas.Int(), gasPrice.Int()))
+	if e.maxFee > 0 && fee > e.maxFee {
+		return nil, fmt.Errorf("%v: %v %s", errFeeExceedsMaximum, fee, e.code)
+	}
+	tx := &evmTx{
+		nonce:    nonce.Int(),
+		gasPrice: gasPrice.Int(),
+		gas:      gas.Int(),
+		to:       recipient,
+		value:    value,
+	}
+	raw := tx.sign(e.key, e.chainID)
+	var txID string
+	if err = e.call(ctx, "eth_sendRawTransaction", []interface{}{"0x" + hex.EncodeToString(raw)}, &txID); err != nil {
+		return nil, err
+	}
+	return &Transaction{TxID: txID, Fee: fee}, nil
+}
+
+// rpcResponse is a JSON-RPC 2.0 response
+type rpcResponse struct {
+	Result json.RawMessage `json:"result"`
+	Error  *struct {
+		Code    int    `json:"code"`
+		Message string `json:"message"`
+	} `json:"error"`
+}
+
+// call calls a JSON-RPC method on the node
+func (e *evmWallet) call(ctx context.Context, method string, params, result interface{}) error {
+	var resp rpcResponse
+	err := e.sendRequest(ctx, http.MethodPost, "", map[string]interface{}{
+		"jsonrpc": "2.0",
+		"id":      1,
+		"method":  method,
+		"params":  params,
+	}, &resp)
+	if err != nil {
+		return err
+	}
+	if resp.Error != nil {
+		return fmt.Errorf("%s %s error %d: %s", e.name, method, resp.Error.Code, resp.Error.Message)
+	}
+	return json.Unmarshal(resp.Result, result)
+}
+
+// hexBig is a hex encoded quantity returned by a JSON-RPC node
+type hexBig big.Int
+
+// UnmarshalJSON decodes a 0x prefixed hex quantity
+func (h *hexBig) UnmarshalJSON(data []byte) error {
+	var s string
+	if err := json.Unmarshal(data, &s); err != nil {
+		return err
+	}
+	if _, ok := (*big.Int)(h).SetString(strings.TrimPrefix(s, "0x"), 16); !ok {
+		return fmt.Errorf("invalid hex quantity %q", s)
+	}
+	return nil
+}
+
+// Int returns the quantity as a big.Int
+func (h *hexBig) Int() *big.Int {
+	return (*big.Int)(h)
+}
+
+// toWei converts an amount to wei, truncating beyond 18 decimals
+func toWei(amount decimal.Decimal) *big.Int {
+	parts := strings.SplitN(amount.String(), ".", 2)
+	fraction := ""
+	if len(parts) == 2 {
+		fraction = parts[1]
+	}
+	fraction += strings.Repeat("0", evmDecimals)
+	wei, _ := new(big.Int).SetString(parts[0]+fraction[:evmDecimals], 10)
+	return wei
+}
+
+func fromWei(wei *big.Int) float64 {
+	f, _ := new(big.Float).Quo(new(big.Float).SetInt(wei), big.NewFloat(1e18)).Float64()
+	return f
+}
+
+// evmTx is a legacy transaction sending value to an address
+type evmTx struct {
+	nonce    *big.Int
+	gasPrice *big.Int
+	gas      *big.Int
+	to       []byte
+	value    *big.Int
+}
+
+// sign returns the RLP encoding of the transaction signed for a chain as
+// defined by EIP-155
+func (t *evmTx) sign(key *privateKey, chainID *big.Int) []byte {
+	fields := [][]byte{
+		rlpInt(t.nonce),
+		rlpInt(t.gasPrice),
+		rlpInt(t.gas),
+		rlpBytes(t.to),
+		rlpInt(t.value),
+		rlpBytes(nil),
+	}
+	unsigned := append(fields, rlpInt(chainID), rlpInt(new(big.Int)), rlpInt(new(big.Int)))
+	r, s, recoveryID := key.sign(keccak256(rlpList(unsigned...)))
+	v := new(big.Int).Lsh(chainID, 1)
+	v.Add(v, big.NewInt(35+int64(recoveryID)))
+	return rlpList(append(fields[:6:6], rlpInt(v), rlpInt(r), rlpInt(s))...)
+}
+
+// rlpBytes returns the RLP encoding of a byte string
+func rlpBytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return b
+	}
+	return append(rlpLength(len(b), 0x80), b...)
+}
+
+// rlpInt returns the RLP encoding of an integer as a minimal big endian byte
+// string
+func rlpInt(i *big.Int) []byte {
+	return rlpBytes(i.Bytes())
+}
+
+// rlpList returns the RLP encoding of a list of encoded items
+func rlpList(items ...[]byte) []byte {
+	var body []byte
+	for i := range items {
+		body = append(body, items[i]...)
+	}
+	return append(rlpLength(len(body), 0xc0), body...)
+}
+
+func rlpLength(n int, offset byte) []byte {
+	if n < 56 {
+		return []byte{offset + byte(n)}
+	}
+	l := big.NewInt(int64(n)).Bytes()
+	return append([]byte{offset + 55 + byte(len(l))}, l...)
+}
@@ -0,0 +1,77 @@
+package wallet
+
+import (
+	"encoding/hex"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	"github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+)
+
+// privateKey is a key on the secp256k1 curve used by Bitcoin and EVM chains
+// and its public key. Keys sign hot wallet withdrawals, so signing uses
+// dcrd's constant time secp256k1 implementation rather than math/big
+type privateKey struct {
+	key *secp256k1.PrivateKey
+	pub publicKey
+}
+
+// publicKey is a secp256k1 public key
+type publicKey struct {
+	key *secp256k1.PublicKey
+}
+
+// compressed returns the 33 byte SEC encoding of the public key
+func (p publicKey) compressed() []byte {
+	return p.key.SerializeCompressed()
+}
+
+// uncompressed returns the 64 byte concatenated coordinates of the public
+// key, without the SEC prefix
+func (p publicKey) uncompressed() []byte {
+	return p.key.SerializeUncompressed()[1:]
+}
+
+func newPrivateKey(b []byte) (*privateKey, error) {
+	var d secp256k1.ModNScalar
+	if len(b) != 32 || d.SetByteSlice(b) || d.IsZero() {
+		return nil, errInvalidPrivateKey
+	}
+	key := secp256k1.NewPrivateKey(&d)
+	return &privateKey{
+		key: key,
+		pub: publicKey{key: key.PubKey()},
+	}, nil
+}
+
+// parseHexKey parses a 32 byte hex encoded private key
+func parseHexKey(s string) (*privateKey, error) {
+	b, err := hex.DecodeString(strings.TrimPrefix(strings.TrimSpace(s), "0x"))
+	if err != nil {
+		return nil, errInvalidPrivateKey
+	}
+	return newPrivateKey(b)
+}
+
+// sign signs a 32 byte hash with a deterministic RFC 6979 nonce, returning
+// the low S signature and the recovery ID of the public key
+func (k *privateKey) sign(hash []byte) (r, s *big.Int, recoveryID byte) {
+	// The compact signature is the recovery code, 27 plus the recovery ID,
+	// followed by R and S
+	sig := ecdsa.SignCompact(k.key, hash, false)
+	return new(big.Int).SetBytes(sig[1:33]), new(big.Int).SetBytes(sig[33:65]), sig[0] - 27
+}
+
+// derSignature returns the DER encoding of a signature
+func derSignature(r, s *big.Int) []byte {
+	encode := func(i *big.Int) []byte {
+		b := i.Bytes()
+		if len(b) == 0 || b[0]&0x80 != 0 {
+			b = append([]byte{0x00}, b...)
+		}
+		return append([]byte{0x02, byte(len(b))}, b...)
+	}
+	body := append(encode(r), encode(s)...)
+	return append([]byte{0x30, byte(len(body))}, body...)
+}
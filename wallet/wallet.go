@@ -0,0 +1,130 @@
+// Package wallet signs and broadcasts transactions from hot wallet keys so
+// funds held on chain can be moved without an exchange, supporting native
+// segwit Bitcoin addresses and EVM chain accounts
+package wallet
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/thrasher-corp/gocryptotrader/common"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// New returns the wallet for the settings' chain, verifying the private key
+// controls the wallet address
+func New(s *Settings) (Wallet, error) {
+	if s == nil {
+		return nil, errors.New("wallet settings are nil")
+	}
+	if s.Name == "" {
+		return nil, errNameUnset
+	}
+	if s.PrivateKey == "" {
+		return nil, fmt.Errorf("wallet %s: %v", s.Name, errInvalidPrivateKey)
+	}
+	var (
+		w   Wallet
+		err error
+	)
+	switch strings.ToLower(s.Chain) {
+	case ChainBitcoin:
+		w, err = newBitcoinWallet(s)
+	case ChainEVM:
+		w, err = newEVMWallet(s)
+	default:
+		return nil, fmt.Errorf("wallet %s: unsupported chain %q", s.Name, s.Chain)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("wallet %s: %v", s.Name, err)
+	}
+	return w, nil
+}
+
+// client holds what is common to every wallet and sends its API requests
+type client struct {
+	name    string
+	address string
+	code    currency.Code
+	apiURL  string
+	http    *http.Client
+}
+
+func newClient(name, address string, code currency.Code, apiURL string) client {
+	return client{
+		name:    name,
+		address: address,
+		code:    code,
+		apiURL:  strings.TrimSuffix(apiURL, "/"),
+		http:    common.NewHTTPClientWithTimeout(defaultRequestTimeout),
+	}
+}
+
+// Name returns the wallet name
+func (c *client) Name() string {
+	return c.name
+}
+
+// Address returns the address the wallet sends from
+func (c *client) Address() string {
+	return c.address
+}
+
+// Currency returns the currency the wallet sends
+func (c *client) Currency() currency.Code {
+	return c.code
+}
+
+// sendRequest sends a request to the wallet's API, decoding a JSON response
+// into result when it isn't nil. A string body is sent as plain text and any
+// other body as JSON. Requests aren't retried as a broadcast may have been
+// received
+func (c *client) sendRequest(ctx context.Context, method, path string, body, result interface{}) error {
+	var payload io.Reader
+	contentType := "application/json"
+	switch b := body.(type) {
+	case nil:
+	case string:
+		payload = strings.NewReader(b)
+		contentType = "text/plain"
+	default:
+		encoded, err := json.Marshal(b)
+		if err != nil {
+			return err
+		}
+		payload = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequest(method, c.apiURL+path, payload)
+	if err != nil {
+		return err
+	}
+	req = req.WithContext(ctx)
+	if payload != nil {
+		req.Header.Set("Content-Type", contentType)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	contents, err := ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode != http.StatusOK {
+		return fmt.Errorf("%s returned status %d: %s", c.name, resp.StatusCode,
+			strings.TrimSpace(string(contents)))
+	}
+	if result == nil {
+		return nil
+	}
+	return json.Unmarshal(contents, result)
+}
@@ -0,0 +1,406 @@
+package wallet
+
+import (
+	"context"
+	"encoding/hex"
+	"encoding/json"
+	"io/ioutil"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+const (
+	// The private key 1, its public key being the generator point
+	testKeyHex       = "0000000000000000000000000000000000000000000000000000000000000001"
+	testKeyWIF       = "KwDiBf89QgGbjEhKnhXJuH7LrciVrZi3qYjgd9M7rFU73sVHnoWn"
+	testBTCAddress   = "bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t4"
+	testEVMAddress   = "0x7E5F4552091A69125d5DfCb7b8C2659029395Bdf"
+	testPubKeyHash   = "751e76e8199196d454941c45d1b3a323f1433bd6"
+	testRecipientEVM = "0x3535353535353535353535353535353535353535"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+	if _, err := New(nil); err == nil {
+		t.Error("expected an error for nil settings")
+	}
+	if _, err := New(&Settings{Chain: ChainBitcoin, PrivateKey: testKeyHex}); err != errNameUnset {
+		t.Errorf("expected %v, received %v", errNameUnset, err)
+	}
+	if _, err := New(&Settings{Name: "cold", Chain: "solana", PrivateKey: testKeyHex}); err == nil {
+		t.Error("expected an error for an unsupported chain")
+	}
+	_, err := New(&Settings{Name: "cold", Chain: ChainBitcoin, PrivateKey: testKeyHex,
+		Address: "bc1qar0srrr7xfkvy5l643lydnw9re59gtzzwf5mdq"})
+	if err == nil || !strings.Contains(err.Error(), errAddressMismatch.Error()) {
+		t.Errorf("expected %v, received %v", errAddressMismatch, err)
+	}
+	_, err = New(&Settings{Name: "hot", Chain: ChainEVM, PrivateKey: testKeyHex,
+		Address: testEVMAddress, ChainID: 1})
+	if err == nil || !strings.Contains(err.Error(), errRPCNodeRequired.Error()) {
+		t.Errorf("expected %v, received %v", errRPCNodeRequired, err)
+	}
+
+	w, err := New(&Settings{Name: "cold", Chain: ChainBitcoin, PrivateKey: testKeyWIF, Address: testBTCAddress})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Name() != "cold" || w.Address() != testBTCAddress || !w.Currency().Match(currency.BTC) {
+		t.Errorf("unexpected wallet %s %s %s", w.Name(), w.Address(), w.Currency())
+	}
+	w, err = New(&Settings{Name: "hot", Chain: ChainEVM, PrivateKey: "0x" + testKeyHex,
+		Address: strings.ToLower(testEVMAddress), ChainID: 1, APIURL: "http://localhost"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if w.Address() != testEVMAddress || !w.Currency().Match(currency.ETH) {
+		t.Errorf("unexpected wallet %s %s", w.Address(), w.Currency())
+	}
+}
+
+func TestParseBitcoinKey(t *testing.T) {
+	t.Parallel()
+	for _, s := range []string{testKeyHex, testKeyWIF} {
+		k, err := parseBitcoinKey(bitcoinMainnet, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if d := hex.EncodeToString(k.key.Serialize()); d != testKeyHex {
+			t.Errorf("expected key 1 from %s, received %s", s, d)
+		}
+	}
+	if _, err := parseBitcoinKey(bitcoinTestnet, testKeyWIF); err != errInvalidPrivateKey {
+		t.Errorf("expected %v for a mainnet key on testnet, received %v", errInvalidPrivateKey, err)
+	}
+	// Uncompressed WIF of the same key
+	if _, err := parseBitcoinKey(bitcoinMainnet, "5HpHagT65TZzG1PH3CSu63k8DbpvD8s5ip4nEB3kEsreAnchuDf"); err != errInvalidPrivateKey {
+		t.Errorf("expected %v for an uncompressed key, received %v", errInvalidPrivateKey, err)
+	}
+	if _, err := parseHexKey(strings.Repeat("ff", 32)); err != errInvalidPrivateKey {
+		t.Errorf("expected %v for a key above the curve order, received %v", errInvalidPrivateKey, err)
+	}
+}
+
+func TestOutputScript(t *testing.T) {
+	t.Parallel()
+	for addr, script := range map[string]string{
+		testBTCAddress:                                                   "0014" + testPubKeyHash,
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2":                             "76a91477bff20c60e522dfaa3350c39b030a5d004e839a88ac",
+		"3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy":                             "a914b472a266d0bd89c13706a4132ccfb16f7c3b9fcb87",
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqzk5jj0": "512079be667ef9dcbbac55a06295ce870b07029bfcdb2dce28d959f2815b16f81798",
+		"BC1QW508D6QEJXTDG4Y5R3ZARVARY0C5XW7KV8F3T4":                     "0014" + testPubKeyHash,
+		"bc1qrp33g0q5c5txsp9arysrx4k6zdkfs4nce4xj0gdcccefvpysxf3qccfmv3": "00201863143c14c5166804bd19203356da136c985678cd4d27a1b8c6329604903262",
+	} {
+		resp, err := bitcoinMainnet.outputScript(addr)
+		if err != nil {
+			t.Errorf("%s: %v", addr, err)
+			continue
+		}
+		if hex.EncodeToString(resp) != script {
+			t.Errorf("%s: expected %s, received %x", addr, script, resp)
+		}
+	}
+	for _, addr := range []string{
+		"bc1qw508d6qejxtdg4y5r3zarvary0c5xw7kv8f3t5",
+		"bc1qw508d6qejxtdg4y5R3zarvary0c5xw7kv8f3t4",
+		// Taproot encoded with bech32 rather than bech32m
+		"bc1p0xlxvlhemja6c4dqv22uapctqupfhlxm9h8z3k2e72q4k9hcz7vqh2y7hd",
+		"tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx",
+		"1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN3",
+	} {
+		if _, err := bitcoinMainnet.outputScript(addr); err == nil {
+			t.Errorf("expected an error for %s", addr)
+		}
+	}
+}
+
+func TestSegwitAddress(t *testing.T) {
+	t.Parallel()
+	program, _ := hex.DecodeString(testPubKeyHash)
+	addr, err := segwitAddress("bc", 0, program)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != testBTCAddress {
+		t.Errorf("expected %s, received %s", testBTCAddress, addr)
+	}
+	addr, err = segwitAddress("tb", 0, program)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr != "tb1qw508d6qejxtdg4y5r3zarvary0c5xw7kxpjzsx" {
+		t.Errorf("unexpected testnet address %s", addr)
+	}
+}
+
+// TestBitcoinSigHash checks the native P2WPKH example of BIP 143
+func TestBitcoinSigHash(t *testing.T) {
+	t.Parallel()
+	input := func(hash string, index, sequence uint32, value int64) bitcoinInput {
+		in := bitcoinInput{index: index, sequence: sequence, value: value}
+		b, _ := hex.DecodeString(hash)
+		copy(in.hash[:], b)
+		return in
+	}
+	output := func(value int64, script string) bitcoinOutput {
+		b, _ := hex.DecodeString(script)
+		return bitcoinOutput{value: value, script: b}
+	}
+	tx := &bitcoinTx{
+		version: 1,
+		inputs: []bitcoinInput{
+			input("fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f", 0, 0xffffffee, 625000000),
+			input("ef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a", 1, 0xffffffff, 600000000),
+		},
+		outputs: []bitcoinOutput{
+			output(112340000, "76a9148280b37df378db99f66f85c95a783a76ac7a6d5988ac"),
+			output(223450000, "76a9143bde42dbee7e4dbe6a21b2d50ce2f0167faa815988ac"),
+		},
+		locktime: 17,
+	}
+	unsigned := "0100000002fff7f7881a8099afa6940d42d1e7f6362bec38171ea3edf433541db4e4ad969f0000000000eeffffffef51e1b804cc89d182d279655c3aa89e815b1b309fe287d9b2b55d57b90ec68a0100000000ffffffff02202cb206000000001976a9148280b37df378db99f66f85c95a783a76ac7a6d5988ac9093510d000000001976a9143bde42dbee7e4dbe6a21b2d50ce2f0167faa815988ac11000000"
+	if resp := hex.EncodeToString(tx.serialize(false)); resp != unsigned {
+		t.Fatalf("expected %s, received %s", unsigned, resp)
+	}
+
+	key, err := parseHexKey("619c335025c7f4012e556c2a58b2506e30b8511b53ade95ea316fd8c3286feb9")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pub := hex.EncodeToString(key.pub.compressed()); pub != "025476c2e83188368da1ff3e292e7acafcdb3566bb0ad253f62fc70f07aeee6357" {
+		t.Errorf("unexpected public key %s", pub)
+	}
+	pubKeyHash := hash160(key.pub.compressed())
+	if hex.EncodeToString(pubKeyHash) != "1d0f172a0ecb48aee1be1f2687d2963ae33f71a1" {
+		t.Errorf("unexpected public key hash %x", pubKeyHash)
+	}
+	hash, err := tx.sigHash(1, pubKeyHash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if resp := hex.EncodeToString(hash); resp != "c37af31116d1b27caf68aae9e3ac82f1477929014d5b917657d0eb49478cb670" {
+		t.Errorf("unexpected signature hash %s", resp)
+	}
+	r, s, _ := key.sign(hash)
+	sig := "304402203609e17b84f6a7d30c80bfa610b5b4542f32a8a0d5447a12fb1366d7f01cc44a0220573a954c4518331561406f90300e8f3358f51928d43c212a8caed02de67eebee"
+	if resp := hex.EncodeToString(derSignature(r, s)); resp != sig {
+		t.Errorf("expected signature %s, received %s", sig, resp)
+	}
+	if _, err = tx.sigHash(2, pubKeyHash); err == nil {
+		t.Error("expected an error for an input out of range")
+	}
+}
+
+func TestBuildTransaction(t *testing.T) {
+	t.Parallel()
+	w, err := newBitcoinWallet(&Settings{Name: "cold", PrivateKey: testKeyHex, Address: testBTCAddress})
+	if err != nil {
+		t.Fatal(err)
+	}
+	script, _ := bitcoinMainnet.outputScript("3J98t1WpEZ73CNmQviecrnyiWrnqRhWNLy")
+	utxo := func(id byte, value int64, confirmed bool) esploraUTXO {
+		u := esploraUTXO{TxID: strings.Repeat(hex.EncodeToString([]byte{id}), 32), Value: value}
+		u.Status.Confirmed = confirmed
+		return u
+	}
+	utxos := []esploraUTXO{utxo(1, 20000, true), utxo(2, 90000, false), utxo(3, 60000, true)}
+
+	// 11 + 68 + 32 + 31 vbytes at 2 sat/vB
+	tx, fee, err := w.buildTransaction(utxos, script, 50000, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if fee != 284 || len(tx.inputs) != 1 || tx.inputs[0].hash[0] != 3 {
+		t.Errorf("expected the largest confirmed output to pay a 284 fee, received %v %+v", fee, tx.inputs)
+	}
+	if len(tx.outputs) != 2 || tx.outputs[0].value != 50000 || tx.outputs[1].value != 60000-50000-284 {
+		t.Errorf("unexpected outputs %+v", tx.outputs)
+	}
+
+	// The 20000 output is needed, leaving change below the dust limit
+	tx, fee, err = w.buildTransaction(utxos, script, 79400, 2)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(tx.inputs) != 2 || len(tx.outputs) != 1 || fee != 600 {
+		t.Errorf("expected the dust change to be paid as fees, received %v %+v", fee, tx.outputs)
+	}
+
+	if _, _, err = w.buildTransaction(utxos, script, 80000, 2); err != errInsufficientFunds {
+		t.Errorf("expected %v, received %v", errInsufficientFunds, err)
+	}
+}
+
+func TestBitcoinSend(t *testing.T) {
+	t.Parallel()
+	var broadcast string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		switch r.URL.Path {
+		case "/address/" + testBTCAddress + "/utxo":
+			w.Write([]byte(`[{"txid":"` + strings.Repeat("ab", 32) + `","vout":1,"value":100000,"status":{"confirmed":true}}]`))
+		case "/fee-estimates":
+			w.Write([]byte(`{"1":20.5,"6":10.1,"144":1}`))
+		case "/tx":
+			b, _ := ioutil.ReadAll(r.Body)
+			broadcast = string(b)
+			w.Write([]byte("ok"))
+		default:
+			http.NotFound(w, r)
+		}
+	}))
+	defer srv.Close()
+
+	w, err := New(&Settings{Name: "cold", Chain: ChainBitcoin, PrivateKey: testKeyHex,
+		Address: testBTCAddress, APIURL: srv.URL, MaxFee: 0.0001})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := w.Send(context.Background(), "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", decimal.RequireFromString("0.0005"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	// 11 + 68 + 34 + 31 vbytes at 10.1 sat/vB
+	if tx.Fee != 0.00001455 || len(tx.TxID) != 64 {
+		t.Errorf("unexpected transaction %+v", tx)
+	}
+	if !strings.HasPrefix(broadcast, "0200000000010") || !strings.HasSuffix(broadcast, "00000000") {
+		t.Errorf("unexpected broadcast transaction %s", broadcast)
+	}
+
+	if _, err = w.Send(context.Background(), "1BvBMSEYstWetqTFn5Au4m4GFg7xJaNVN2", decimal.RequireFromString("0.000001")); err == nil {
+		t.Error("expected an error for a dust amount")
+	}
+	w.(*bitcoinWallet).maxFee = 0.00001
+	if _, err = w.Send(context.Background(), testBTCAddress, decimal.RequireFromString("0.0005")); err == nil ||
+		!strings.Contains(err.Error(), errFeeExceedsMaximum.Error()) {
+		t.Errorf("expected %v, received %v", errFeeExceedsMaximum, err)
+	}
+}
+
+// TestEVMSign checks the example transaction of EIP-155
+func TestEVMSign(t *testing.T) {
+	t.Parallel()
+	key, err := parseHexKey(strings.Repeat("46", 32))
+	if err != nil {
+		t.Fatal(err)
+	}
+	to, err := parseEVMAddress(testRecipientEVM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx := &evmTx{
+		nonce:    big.NewInt(9),
+		gasPrice: big.NewInt(20000000000),
+		gas:      big.NewInt(21000),
+		to:       to,
+		value:    toWei(decimal.NewFromInt(1)),
+	}
+	expected := "f86c098504a817c800825208943535353535353535353535353535353535353535880de0b6b3a76400008025a028ef61340bd939bc2195fe537567866003e1a15d3c71ff63e1590620aa636276a067cbe9d8997f761aecb703304b3800ccf555c9f3dc64214b297fb1966a3b6d83"
+	if resp := hex.EncodeToString(tx.sign(key, big.NewInt(1))); resp != expected {
+		t.Errorf("expected %s, received %s", expected, resp)
+	}
+}
+
+func TestEVMAddress(t *testing.T) {
+	t.Parallel()
+	key, err := parseHexKey(testKeyHex)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if addr := evmAddress(key.pub); addr != testEVMAddress {
+		t.Errorf("expected %s, received %s", testEVMAddress, addr)
+	}
+	for _, addr := range []string{testEVMAddress, strings.ToLower(testEVMAddress), "0x" + strings.ToUpper(testEVMAddress[2:])} {
+		if _, err = parseEVMAddress(addr); err != nil {
+			t.Errorf("%s: %v", addr, err)
+		}
+	}
+	for _, addr := range []string{"0x7e5F4552091A69125d5DfCb7b8C2659029395Bdf", "0x7E5F45", testBTCAddress} {
+		if _, err = parseEVMAddress(addr); err == nil {
+			t.Errorf("expected an error for %s", addr)
+		}
+	}
+}
+
+func TestToWei(t *testing.T) {
+	t.Parallel()
+	for amount, wei := range map[string]string{
+		"1":                     "1000000000000000000",
+		"0.1":                   "100000000000000000",
+		"1.23456789":            "1234567890000000000",
+		"0.0000000001":          "100000000",
+		"12345":                 "12345000000000000000000",
+		"0.0000000000000000019": "1",
+	} {
+		if resp := toWei(decimal.RequireFromString(amount)).String(); resp != wei {
+			t.Errorf("%v: expected %s, received %s", amount, wei, resp)
+		}
+	}
+	if f := fromWei(big.NewInt(21000 * 20000000000)); f != 0.00042 {
+		t.Errorf("expected 0.00042, received %v", f)
+	}
+}
+
+func TestEVMSend(t *testing.T) {
+	t.Parallel()
+	var raw string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			Method string            `json:"method"`
+			Params []json.RawMessage `json:"params"`
+		}
+		if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+		result := map[string]string{
+			"eth_chainId":             `"0x1"`,
+			"eth_getTransactionCount": `"0x9"`,
+			"eth_gasPrice":            `"0x4a817c800"`,
+			"eth_estimateGas":         `"0x5208"`,
+		}[req.Method]
+		if req.Method == "eth_sendRawTransaction" {
+			json.Unmarshal(req.Params[0], &raw)
+			result = `"0xabc"`
+		}
+		if result == "" {
+			w.Write([]byte(`{"jsonrpc":"2.0","id":1,"error":{"code":-32601,"message":"method not found"}}`))
+			return
+		}
+		w.Write([]byte(`{"jsonrpc":"2.0","id":1,"result":` + result + `}`))
+	}))
+	defer srv.Close()
+
+	w, err := New(&Settings{Name: "hot", Chain: ChainEVM, PrivateKey: strings.Repeat("46", 32),
+		Address: "0x9d8A62f656a8d1615C1294fd71e9CFb3E4855A4F", APIURL: srv.URL, ChainID: 1})
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err := w.Send(context.Background(), testRecipientEVM, decimal.NewFromInt(1))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if tx.TxID != "0xabc" || tx.Fee != 0.00042 {
+		t.Errorf("unexpected transaction %+v", tx)
+	}
+	if !strings.HasPrefix(raw, "0xf86c09") {
+		t.Errorf("unexpected raw transaction %s", raw)
+	}
+
+	w.(*evmWallet).maxFee = 0.0001
+	if _, err = w.Send(context.Background(), testRecipientEVM, decimal.NewFromInt(1)); err == nil ||
+		!strings.Contains(err.Error(), errFeeExceedsMaximum.Error()) {
+		t.Errorf("expected %v, received %v", errFeeExceedsMaximum, err)
+	}
+	w.(*evmWallet).chainID = big.NewInt(137)
+	if _, err = w.Send(context.Background(), testRecipientEVM, decimal.NewFromInt(1)); err == nil ||
+		!strings.Contains(err.Error(), errChainIDMismatch.Error()) {
+		t.Errorf("expected %v, received %v", errChainIDMismatch, err)
+	}
+}
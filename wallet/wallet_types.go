@@ -0,0 +1,80 @@
+package wallet
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/thrasher-corp/gocryptotrader/common/decimal"
+	"github.com/thrasher-corp/gocryptotrader/currency"
+)
+
+// Supported chains
+const (
+	ChainBitcoin = "bitcoin"
+	ChainEVM     = "evm"
+)
+
+// Const vars for wallets
+const (
+	// DefaultBitcoinAPIURL is the Esplora API unspent outputs are read from
+	// and transactions broadcast to
+	DefaultBitcoinAPIURL = "https://blockstream.info/api"
+	// DefaultBitcoinTestnetAPIURL is the Esplora API used on testnet
+	DefaultBitcoinTestnetAPIURL = "https://blockstream.info/testnet/api"
+
+	defaultRequestTimeout = time.Second * 15
+	// bitcoinConfirmationTarget is the number of blocks the fee rate is
+	// estimated to confirm a transaction within
+	bitcoinConfirmationTarget = "6"
+	bitcoinDustLimit          = 546
+	bitcoinDecimals           = 8
+	evmDecimals               = 18
+)
+
+var (
+	errNameUnset          = errors.New("wallet name must be set")
+	errAddressMismatch    = errors.New("private key does not match the wallet address")
+	errInvalidAmount      = errors.New("amount must be greater than zero")
+	errInvalidAddress     = errors.New("invalid destination address")
+	errInsufficientFunds  = errors.New("insufficient confirmed funds")
+	errFeeExceedsMaximum  = errors.New("network fee exceeds the wallet maximum")
+	errRPCNodeRequired    = errors.New("an RPC node URL must be set for EVM wallets")
+	errChainIDMismatch    = errors.New("RPC node chain ID does not match the wallet chain ID")
+	errInvalidPrivateKey  = errors.New("invalid private key")
+	errUnsupportedAddress = errors.New("unsupported address type")
+)
+
+// Wallet signs and broadcasts transactions sending its chain's native
+// currency from a single address
+type Wallet interface {
+	Name() string
+	Address() string
+	Currency() currency.Code
+	Send(ctx context.Context, to string, amount decimal.Decimal) (*Transaction, error)
+}
+
+// Settings defines a hot wallet. The address must be the one the private key
+// controls: a native segwit address on Bitcoin, or the account address on EVM
+// chains
+type Settings struct {
+	Name       string
+	Chain      string
+	Currency   currency.Code
+	Address    string
+	PrivateKey string
+	// APIURL is the Esplora API for Bitcoin wallets, defaulting to
+	// Blockstream's, or the JSON-RPC node for EVM wallets
+	APIURL  string
+	ChainID int64
+	Testnet bool
+	// MaxFee is the largest network fee a transaction may pay in the
+	// wallet's currency, 0 doesn't limit the fee
+	MaxFee float64
+}
+
+// Transaction is a broadcast transaction and the network fee it pays
+type Transaction struct {
+	TxID string
+	Fee  float64
+}